@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FunctionType tells whether a function is available to bots or users only. It comes from the checks in TDLib Requests.cpp
+type FunctionType string
+
+const (
+	FunctionTypeCommon FunctionType = "common"
+	FunctionTypeUser   FunctionType = "user"
+	FunctionTypeBot    FunctionType = "bot"
+)
+
+var (
+	ErrUserOnlyMethod = errors.New("the method is available to users only")
+	ErrBotOnlyMethod  = errors.New("the method is available to bots only")
+)
+
+const (
+	accountTypeUnknown int32 = iota
+	accountTypeUser
+	accountTypeBot
+)
+
+// GetFunctionType returns the type of the TDLib function
+func GetFunctionType(name string) FunctionType {
+	functionType, ok := functionTypes[name]
+	if !ok {
+		return FunctionTypeCommon
+	}
+
+	return functionType
+}
+
+// IsBot reports whether the client is authorized as a bot
+func (client *Client) IsBot() bool {
+	return client.accountType.Load() == accountTypeBot
+}
+
+// detectAccountType loads the type of the authorized account. Until it is known, methods are not checked
+func (client *Client) detectAccountType(ctx context.Context) error {
+	me, err := client.GetMe(ctx)
+	if err != nil {
+		return err
+	}
+
+	if me.Type != nil && me.Type.UserTypeConstructor() == ConstructorUserTypeBot {
+		client.accountType.Store(accountTypeBot)
+	} else {
+		client.accountType.Store(accountTypeUser)
+	}
+
+	return nil
+}
+
+// checkFunctionType rejects user-only methods for bots and bot-only methods for users
+func (client *Client) checkFunctionType(name string) error {
+	switch client.accountType.Load() {
+	case accountTypeBot:
+		if GetFunctionType(name) == FunctionTypeUser {
+			return fmt.Errorf("%s: %w", name, ErrUserOnlyMethod)
+		}
+
+	case accountTypeUser:
+		if GetFunctionType(name) == FunctionTypeBot {
+			return fmt.Errorf("%s: %w", name, ErrBotOnlyMethod)
+		}
+	}
+
+	return nil
+}
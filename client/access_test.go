@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckFunctionType(t *testing.T) {
+	tests := []struct {
+		name        string
+		accountType int32
+		req         Request
+		err         error
+	}{
+		{"unknown account", accountTypeUnknown, &SearchChatsRequest{}, nil},
+		{"user method from user", accountTypeUser, &SearchChatsRequest{}, nil},
+		{"user method from bot", accountTypeBot, &SearchChatsRequest{}, ErrUserOnlyMethod},
+		{"bot method from user", accountTypeUser, &AnswerCallbackQueryRequest{}, ErrBotOnlyMethod},
+		{"bot method from bot", accountTypeBot, &AnswerCallbackQueryRequest{}, nil},
+		{"common method from bot", accountTypeBot, &GetMeRequest{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{}
+			client.accountType.Store(tt.accountType)
+
+			err := client.checkFunctionType(tt.req.GetFunctionName())
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSendRejectsUserOnlyMethodForBot(t *testing.T) {
+	client := &Client{}
+	client.accountType.Store(accountTypeBot)
+
+	_, err := client.SearchChats(context.Background(), &SearchChatsRequest{Query: "query", Limit: 10})
+	if !errors.Is(err, ErrUserOnlyMethod) {
+		t.Errorf("error = %v, want %v", err, ErrUserOnlyMethod)
+	}
+}
@@ -2,37 +2,56 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type Client struct {
-	jsonClient      *JsonClient
-	extraGenerator  ExtraGenerator
-	responses       chan *Response
-	resultHandler   ResultHandler
-	catchersStore   *sync.Map
-	fallbackTimeout time.Duration
-	isClosed        bool
+	jsonClient            *JsonClient
+	extraGenerator        ExtraGenerator
+	responses             chan *Response
+	resultHandler         ResultHandler
+	catchersStore         *sync.Map
+	fallbackTimeout       time.Duration
+	isClosed              bool
+	done                  chan struct{}
+	startActions          []startAction
+	connectionState       *connectionStateTracker
+	validateRequests      bool
+	strictUnmarshal       bool
+	unmarshalErrorHandler func(data json.RawMessage, err error)
+	accountType           atomic.Int32
 }
 
+var ErrClientClosed = errors.New("client is closed")
+
+// Option configures the client before it is started.
+// Options that need a running client (proxy, TDLib options, log verbosity) are deferred as start actions:
+// they are run in order once TDLib parameters are set, and their errors are returned from NewClient.
 type Option func(*Client)
 
-func WithExtraGenerator(extraGenerator ExtraGenerator) Option {
+type startAction func(client *Client) error
+
+func withStartAction(action startAction) Option {
 	return func(client *Client) {
-		client.extraGenerator = extraGenerator
+		client.startActions = append(client.startActions, action)
 	}
 }
 
-func WithFallbackTimeout(timeout time.Duration) Option {
+func WithExtraGenerator(extraGenerator ExtraGenerator) Option {
 	return func(client *Client) {
-		client.fallbackTimeout = timeout
+		client.extraGenerator = extraGenerator
 	}
 }
 
-func WithProxy(req *AddProxyRequest) Option {
+func WithFallbackTimeout(timeout time.Duration) Option {
 	return func(client *Client) {
-		client.AddProxy(context.Background(), req)
+		client.fallbackTimeout = timeout
 	}
 }
 
@@ -42,6 +61,54 @@ func WithResultHandler(resultHandler ResultHandler) Option {
 	}
 }
 
+func WithProxy(req *AddProxyRequest) Option {
+	return withStartAction(func(client *Client) error {
+		_, err := client.AddProxy(context.Background(), req)
+		if err != nil {
+			return fmt.Errorf("add proxy %s:%d: %w", req.Server, req.Port, err)
+		}
+
+		return nil
+	})
+}
+
+// WithOptions sets TDLib options (see https://core.telegram.org/tdlib/options) in the alphabetical order of their names
+func WithOptions(options map[string]OptionValue) Option {
+	return withStartAction(func(client *Client) error {
+		names := make([]string, 0, len(options))
+		for name := range options {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			_, err := client.SetOption(context.Background(), &SetOptionRequest{
+				Name:  name,
+				Value: options[name],
+			})
+			if err != nil {
+				return fmt.Errorf("set option %s: %w", name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// WithLogVerbosity sets the verbosity level of the internal TDLib log
+func WithLogVerbosity(level int32) Option {
+	return withStartAction(func(client *Client) error {
+		_, err := SetLogVerbosityLevel(&SetLogVerbosityLevelRequest{
+			NewVerbosityLevel: level,
+		})
+		if err != nil {
+			return fmt.Errorf("set log verbosity level %d: %w", level, err)
+		}
+
+		return nil
+	})
+}
+
 type ResultHandler interface {
 	OnResult(result Type)
 }
@@ -61,46 +128,126 @@ func NewCallbackResultHandler(callback func(result Type)) *CallbackResultHandler
 }
 
 func NewClient(authorizationStateHandler AuthorizationStateHandler, options ...Option) (*Client, error) {
+	return NewClientWithContext(context.Background(), authorizationStateHandler, options...)
+}
+
+// NewClientWithContext is NewClient which closes the client and returns ctx.Err() if ctx is done before the client is authorized.
+// An authorization state handler which waits for user input doesn't return until it gets it
+func NewClientWithContext(ctx context.Context, authorizationStateHandler AuthorizationStateHandler, options ...Option) (*Client, error) {
 	client := &Client{
-		jsonClient:    NewJsonClient(),
-		responses:     make(chan *Response, 1000),
-		catchersStore: &sync.Map{},
-		isClosed:      false,
+		jsonClient:      NewJsonClient(),
+		responses:       make(chan *Response, 1000),
+		catchersStore:   &sync.Map{},
+		isClosed:        false,
+		done:            make(chan struct{}),
+		connectionState: newConnectionStateTracker(),
 	}
 
 	client.extraGenerator = UuidV4Generator()
 	client.resultHandler = NewCallbackResultHandler(func(result Type) {})
 	client.fallbackTimeout = 60 * time.Second
+	client.unmarshalErrorHandler = func(data json.RawMessage, err error) {}
+
+	for _, option := range options {
+		option(client)
+	}
 
 	tdlibInstance.addClient(client)
 	go client.receiver()
 
-	for _, option := range options {
-		go option(client)
+	startActionsHandler := &startActionsHandler{
+		AuthorizationStateHandler: authorizationStateHandler,
+		actions:                   client.startActions,
+	}
+
+	authorized := make(chan error, 1)
+	go func() {
+		authorized <- Authorize(client, startActionsHandler)
+	}()
+
+	select {
+	case err := <-authorized:
+		if err != nil {
+			return nil, err
+		}
+
+	case <-ctx.Done():
+		client.Close(context.Background())
+		return nil, ctx.Err()
 	}
 
-	err := Authorize(client, authorizationStateHandler)
+	// authorization state can be ready from the start if the client has been authorized before
+	err := startActionsHandler.run(client)
 	if err != nil {
+		client.Close(context.Background())
 		return nil, err
 	}
 
+	// the methods are not checked against the account type if it can't be loaded
+	client.detectAccountType(context.Background())
+
 	return client, nil
 }
 
+// startActionsHandler runs start actions as soon as TDLib parameters are set, so that a proxy is used for authorization
+type startActionsHandler struct {
+	AuthorizationStateHandler
+	actions []startAction
+}
+
+func (handler *startActionsHandler) Handle(client *Client, state AuthorizationState) error {
+	switch state.AuthorizationStateConstructor() {
+	case ConstructorAuthorizationStateWaitTdlibParameters,
+		ConstructorAuthorizationStateLoggingOut,
+		ConstructorAuthorizationStateClosing,
+		ConstructorAuthorizationStateClosed:
+
+	default:
+		err := handler.run(client)
+		if err != nil {
+			return err
+		}
+	}
+
+	return handler.AuthorizationStateHandler.Handle(client, state)
+}
+
+func (handler *startActionsHandler) run(client *Client) error {
+	actions := handler.actions
+	handler.actions = nil
+
+	for _, action := range actions {
+		err := action(client)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (client *Client) receiver() {
 	for response := range client.responses {
+		handled := response
 		if response.MetaExtra != "" {
 			value, ok := client.catchersStore.Load(response.MetaExtra)
 			if ok {
 				value.(chan *Response) <- response
+				// the decoded value belongs to the caller, the result handler gets its own one
+				handled = &Response{meta: response.meta, Data: response.Data}
 			}
 		}
 
-		typ, err := UnmarshalType(response.Data)
+		typ, err := client.unmarshalType(handled)
 		if err != nil {
+			client.unmarshalErrorHandler(response.Data, err)
 			continue
 		}
 
+		if typ.GetConstructor() == ConstructorUpdateConnectionState {
+			client.connectionState.set(typ.(*UpdateConnectionState).State)
+		}
+
 		client.resultHandler.OnResult(typ)
 
 		if typ.GetConstructor() == ConstructorUpdateAuthorizationState &&
@@ -109,9 +256,28 @@ func (client *Client) receiver() {
 			close(client.responses)
 		}
 	}
+
+	close(client.done)
+}
+
+// Done returns a channel that is closed when the client reaches authorizationStateClosed
+func (client *Client) Done() <-chan struct{} {
+	return client.done
 }
 
 func (client *Client) Send(ctx context.Context, req Request) (*Response, error) {
+	err := client.checkFunctionType(req.GetFunctionName())
+	if err != nil {
+		return nil, err
+	}
+
+	if client.validateRequests {
+		err = validateRequest(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	req.SetExtra(client.extraGenerator())
 	req.SetType(req.GetFunctionName())
 
@@ -124,7 +290,7 @@ func (client *Client) Send(ctx context.Context, req Request) (*Response, error)
 		close(catcher)
 	}()
 
-	err := client.jsonClient.Send(req)
+	err = client.jsonClient.Send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +311,13 @@ func (client *Client) Send(ctx context.Context, req Request) (*Response, error)
 }
 
 func (client *Client) Execute(req Request) (*Response, error) {
+	if client.validateRequests {
+		err := validateRequest(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	req.SetExtra(client.extraGenerator())
 	req.SetType(req.GetFunctionName())
 
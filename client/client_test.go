@@ -0,0 +1,165 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type stubAuthorizationStateHandler struct {
+	states []string
+}
+
+func (handler *stubAuthorizationStateHandler) Handle(client *Client, state AuthorizationState) error {
+	handler.states = append(handler.states, state.AuthorizationStateConstructor())
+	return nil
+}
+
+func (handler *stubAuthorizationStateHandler) Close() {}
+
+func TestStartActionsHandler(t *testing.T) {
+	var calls []string
+
+	action := func(name string, err error) startAction {
+		return func(client *Client) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+
+	actionErr := errors.New("action error")
+
+	authorizationStateHandler := &stubAuthorizationStateHandler{}
+	handler := &startActionsHandler{
+		AuthorizationStateHandler: authorizationStateHandler,
+		actions: []startAction{
+			action("first", nil),
+			action("second", actionErr),
+			action("third", nil),
+		},
+	}
+
+	err := handler.Handle(nil, &AuthorizationStateWaitTdlibParameters{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if len(calls) != 0 {
+		t.Fatalf("actions were run before TDLib parameters are set: %v", calls)
+	}
+
+	err = handler.Handle(nil, &AuthorizationStateWaitPhoneNumber{})
+	if !errors.Is(err, actionErr) {
+		t.Fatalf("Handle() error = %v, want %v", err, actionErr)
+	}
+
+	err = handler.Handle(nil, &AuthorizationStateWaitPhoneNumber{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	wantCalls := []string{"first", "second"}
+	if !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("actions = %v, want %v", calls, wantCalls)
+	}
+
+	wantStates := []string{ConstructorAuthorizationStateWaitTdlibParameters, ConstructorAuthorizationStateWaitPhoneNumber}
+	if !reflect.DeepEqual(authorizationStateHandler.states, wantStates) {
+		t.Errorf("handled states = %v, want %v", authorizationStateHandler.states, wantStates)
+	}
+}
+
+func TestUpdateHandler(t *testing.T) {
+	var newMessage *UpdateNewMessage
+	var other []string
+
+	handler := &UpdateHandler{
+		OnNewMessage: func(update *UpdateNewMessage) {
+			newMessage = update
+		},
+		OnOther: func(result Type) {
+			other = append(other, result.GetConstructor())
+		},
+	}
+
+	update := &UpdateNewMessage{Message: &Message{Id: 1}}
+	handler.OnResult(update)
+	handler.OnResult(&UpdateConnectionState{State: &ConnectionStateReady{}})
+	handler.OnResult(&Ok{})
+
+	if newMessage != update {
+		t.Errorf("OnNewMessage got %v, want %v", newMessage, update)
+	}
+
+	wantOther := []string{ConstructorUpdateConnectionState, ConstructorOk}
+	if !reflect.DeepEqual(other, wantOther) {
+		t.Errorf("OnOther got %v, want %v", other, wantOther)
+	}
+}
+
+func TestReceiverDoesNotShareCaughtResult(t *testing.T) {
+	results := make(chan Type, 1)
+
+	client := &Client{
+		responses:       make(chan *Response, 1),
+		catchersStore:   &sync.Map{},
+		done:            make(chan struct{}),
+		connectionState: newConnectionStateTracker(),
+		resultHandler: NewCallbackResultHandler(func(result Type) {
+			results <- result
+		}),
+		unmarshalErrorHandler: func(data json.RawMessage, err error) {
+			t.Errorf("unmarshal error: %s", err)
+		},
+	}
+
+	catcher := make(chan *Response, 1)
+	client.catchersStore.Store("1", catcher)
+
+	go client.receiver()
+	defer close(client.responses)
+
+	response, err := decodeResponse([]byte(`{"@type":"chat","@extra":"1","id":5,"title":"chat"}`))
+	if err != nil {
+		t.Fatalf("decode error: %s", err)
+	}
+	client.responses <- response
+
+	caught := (<-catcher).Type
+	handled := <-results
+
+	if caught.(*Chat) == handled.(*Chat) {
+		t.Errorf("the caller and the result handler share %p", caught)
+	}
+	if !reflect.DeepEqual(caught, handled) {
+		t.Errorf("handled %+v, want %+v", handled, caught)
+	}
+}
+
+func TestNullableFieldsOmitted(t *testing.T) {
+	data, err := json.Marshal(&SendMessageRequest{
+		ChatId:              1,
+		InputMessageContent: &InputMessageText{Text: &FormattedText{Text: "text"}},
+	})
+	if err != nil {
+		t.Fatalf("marshal error: %s", err)
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		t.Fatalf("unmarshal error: %s", err)
+	}
+
+	for _, field := range []string{"reply_to", "options", "reply_markup"} {
+		if _, ok := fields[field]; ok {
+			t.Errorf("nullable field %s is not omitted: %s", field, data)
+		}
+	}
+	for _, field := range []string{"chat_id", "message_thread_id", "input_message_content"} {
+		if _, ok := fields[field]; !ok {
+			t.Errorf("field %s is omitted: %s", field, data)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type connectionStateTracker struct {
+	mu      sync.RWMutex
+	state   ConnectionState
+	since   time.Time
+	changed chan struct{}
+}
+
+func newConnectionStateTracker() *connectionStateTracker {
+	return &connectionStateTracker{
+		changed: make(chan struct{}),
+	}
+}
+
+func (tracker *connectionStateTracker) set(state ConnectionState) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	tracker.state = state
+	tracker.since = time.Now()
+
+	close(tracker.changed)
+	tracker.changed = make(chan struct{})
+}
+
+func (tracker *connectionStateTracker) get() (ConnectionState, time.Time, <-chan struct{}) {
+	tracker.mu.RLock()
+	defer tracker.mu.RUnlock()
+
+	return tracker.state, tracker.since, tracker.changed
+}
+
+// ConnectionState returns the last known connection state or nil if updateConnectionState has not been received yet
+func (client *Client) ConnectionState() ConnectionState {
+	state, _, _ := client.connectionState.get()
+	return state
+}
+
+// ConnectionStateSince returns the time when the current connection state was received
+func (client *Client) ConnectionStateSince() time.Time {
+	_, since, _ := client.connectionState.get()
+	return since
+}
+
+// ConnectionStateChanged returns a channel that is closed on the next connection state change
+func (client *Client) ConnectionStateChanged() <-chan struct{} {
+	_, _, changed := client.connectionState.get()
+	return changed
+}
+
+// WaitConnected blocks until the connection state is connectionStateReady
+func (client *Client) WaitConnected(ctx context.Context) error {
+	for {
+		state, _, changed := client.connectionState.get()
+		if state != nil && state.ConnectionStateConstructor() == ConstructorConnectionStateReady {
+			return nil
+		}
+
+		select {
+		case <-changed:
+
+		case <-client.Done():
+			return ErrClientClosed
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClient_WaitConnected(t *testing.T) {
+	client := &Client{
+		done:            make(chan struct{}),
+		connectionState: newConnectionStateTracker(),
+	}
+
+	if client.ConnectionState() != nil {
+		t.Fatalf("ConnectionState() = %v, want nil", client.ConnectionState())
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- client.WaitConnected(context.Background())
+	}()
+
+	changed := client.ConnectionStateChanged()
+	client.connectionState.set(&ConnectionStateConnecting{})
+
+	select {
+	case <-changed:
+	default:
+		t.Fatalf("ConnectionStateChanged() channel is not closed")
+	}
+
+	select {
+	case err := <-errs:
+		t.Fatalf("WaitConnected() returned %v before connectionStateReady", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	client.connectionState.set(&ConnectionStateReady{})
+
+	select {
+	case err := <-errs:
+		if err != nil {
+			t.Errorf("WaitConnected() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("WaitConnected() did not return after connectionStateReady")
+	}
+
+	if client.ConnectionState().ConnectionStateConstructor() != ConstructorConnectionStateReady {
+		t.Errorf("ConnectionState() = %s, want %s", client.ConnectionState().ConnectionStateConstructor(), ConstructorConnectionStateReady)
+	}
+}
+
+func TestClient_WaitConnectedClosed(t *testing.T) {
+	client := &Client{
+		done:            make(chan struct{}),
+		connectionState: newConnectionStateTracker(),
+	}
+
+	close(client.done)
+
+	err := client.WaitConnected(context.Background())
+	if !errors.Is(err, ErrClientClosed) {
+		t.Errorf("WaitConnected() error = %v, want %v", err, ErrClientClosed)
+	}
+}
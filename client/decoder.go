@@ -0,0 +1,773 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var errUnexpectedEnd = errors.New("unexpected end of JSON input")
+
+// errConstructorFound stops reading an object when its @type is found
+var errConstructorFound = errors.New("constructor found")
+
+// jsonDecoder reads JSON values in place. The generated decoders read the fields of an object while scanning it,
+// so nested objects are not unmarshalled into json.RawMessage first
+type jsonDecoder struct {
+	data []byte
+	pos  int
+}
+
+// unmarshalJSON decodes data which must contain a single JSON value
+func unmarshalJSON[T any](data []byte, decode func(decoder *jsonDecoder) (T, error)) (T, error) {
+	decoder := &jsonDecoder{data: data}
+
+	value, err := decode(decoder)
+	if err != nil {
+		return value, err
+	}
+
+	return value, decoder.end()
+}
+
+// unmarshalObject decodes data which must contain a single JSON object into an entity
+func unmarshalObject(data []byte, decode func(decoder *jsonDecoder) error) error {
+	decoder := &jsonDecoder{data: data}
+
+	err := decode(decoder)
+	if err != nil {
+		return err
+	}
+
+	return decoder.end()
+}
+
+// decodeResponse decodes a response in one pass: the metadata is read by the decoder of the constructor along with the fields.
+// Responses which can't be decoded or have unknown constructors are routed by the metadata read by parseResponse
+func decodeResponse(data []byte) (*Response, error) {
+	typ, err := unmarshalJSON(data, decodeType)
+	if err != nil {
+		return parseResponse(data)
+	}
+
+	entity, ok := typ.(interface{ getMeta() *meta })
+	if !ok {
+		resp, err := parseResponse(data)
+		if err != nil {
+			return nil, err
+		}
+		resp.Type = typ
+
+		return resp, nil
+	}
+
+	return &Response{
+		meta: *entity.getMeta(),
+		Data: data,
+		Type: typ,
+	}, nil
+}
+
+// parseResponse reads the metadata of a response without decoding the object
+func parseResponse(data []byte) (*Response, error) {
+	resp := &Response{
+		Data: data,
+	}
+
+	err := unmarshalObject(data, func(decoder *jsonDecoder) error {
+		return decoder.readObject(func(key []byte) error {
+			return decoder.readMeta(&resp.meta, key, "")
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (decoder *jsonDecoder) syntaxError(expected string) error {
+	if decoder.pos >= len(decoder.data) {
+		return errUnexpectedEnd
+	}
+
+	return fmt.Errorf("invalid character %q at offset %d, expected %s", decoder.data[decoder.pos], decoder.pos, expected)
+}
+
+func (decoder *jsonDecoder) typeError(goType string) error {
+	value := "value"
+	switch decoder.next() {
+	case '{':
+		value = "object"
+	case '[':
+		value = "array"
+	case '"':
+		value = "string"
+	case 't', 'f':
+		value = "bool"
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		value = "number"
+	case 0:
+		return errUnexpectedEnd
+	}
+
+	return fmt.Errorf("cannot unmarshal JSON %s at offset %d into %s", value, decoder.pos, goType)
+}
+
+// next skips the whitespace and returns the next byte or 0 at the end of the input
+func (decoder *jsonDecoder) next() byte {
+	for decoder.pos < len(decoder.data) {
+		switch decoder.data[decoder.pos] {
+		case ' ', '\t', '\n', '\r':
+			decoder.pos++
+		default:
+			return decoder.data[decoder.pos]
+		}
+	}
+
+	return 0
+}
+
+func (decoder *jsonDecoder) end() error {
+	if decoder.next() != 0 {
+		return decoder.syntaxError("end of input")
+	}
+
+	return nil
+}
+
+func (decoder *jsonDecoder) literal(value string) bool {
+	if decoder.next() != value[0] || !bytes.HasPrefix(decoder.data[decoder.pos:], []byte(value)) {
+		return false
+	}
+	decoder.pos += len(value)
+
+	return true
+}
+
+// readNull reads null if it is the next value
+func (decoder *jsonDecoder) readNull() bool {
+	return decoder.literal("null")
+}
+
+// readObject calls read for every key of an object. read must read the value of the key. null is an empty object
+func (decoder *jsonDecoder) readObject(read func(key []byte) error) error {
+	if decoder.readNull() {
+		return nil
+	}
+
+	if decoder.next() != '{' {
+		return decoder.typeError("object")
+	}
+	decoder.pos++
+
+	if decoder.next() == '}' {
+		decoder.pos++
+		return nil
+	}
+
+	for {
+		if decoder.next() != '"' {
+			return decoder.syntaxError("object key")
+		}
+
+		key, err := decoder.readStringBytes()
+		if err != nil {
+			return err
+		}
+
+		if decoder.next() != ':' {
+			return decoder.syntaxError("':' after object key")
+		}
+		decoder.pos++
+
+		err = read(key)
+		if err != nil {
+			return err
+		}
+
+		switch decoder.next() {
+		case ',':
+			decoder.pos++
+
+		case '}':
+			decoder.pos++
+			return nil
+
+		default:
+			return decoder.syntaxError("',' or '}' after object value")
+		}
+	}
+}
+
+// readArray calls read for every element of an array. read must read the element. null is an empty array
+func (decoder *jsonDecoder) readArray(read func(i int) error) error {
+	if decoder.readNull() {
+		return nil
+	}
+
+	if decoder.next() != '[' {
+		return decoder.typeError("array")
+	}
+	decoder.pos++
+
+	if decoder.next() == ']' {
+		decoder.pos++
+		return nil
+	}
+
+	for i := 0; ; i++ {
+		err := read(i)
+		if err != nil {
+			return err
+		}
+
+		switch decoder.next() {
+		case ',':
+			decoder.pos++
+
+		case ']':
+			decoder.pos++
+			return nil
+
+		default:
+			return decoder.syntaxError("',' or ']' after array element")
+		}
+	}
+}
+
+// readMeta reads the metadata fields and skips the unknown fields. The generated decoders pass their constructor,
+// which is used as @type without allocating a string for every object
+func (decoder *jsonDecoder) readMeta(meta *meta, key []byte, constructor string) error {
+	var err error
+	switch string(key) {
+	case "@type":
+		if constructor == "" {
+			meta.MetaType, err = decoder.readString()
+			break
+		}
+
+		meta.MetaType = constructor
+		err = decoder.skip()
+
+	case "@extra":
+		meta.MetaExtra, err = decoder.readString()
+
+	case "@client_id":
+		var clientId int64
+		clientId, err = decoder.readInt64()
+		meta.MetaClientId = int(clientId)
+
+	default:
+		err = decoder.skip()
+	}
+
+	return err
+}
+
+// readConstructor returns the @type of the next object without reading the object
+func (decoder *jsonDecoder) readConstructor() ([]byte, error) {
+	if decoder.next() != '{' {
+		return nil, decoder.typeError("object")
+	}
+
+	start := decoder.pos
+	defer func() {
+		decoder.pos = start
+	}()
+
+	var constructor []byte
+
+	// TDLib writes @type first, so the loop usually stops at the first key
+	err := decoder.readObject(func(key []byte) error {
+		if string(key) != "@type" {
+			return decoder.skip()
+		}
+
+		var err error
+		constructor, err = decoder.readStringBytes()
+		if err != nil {
+			return err
+		}
+
+		return errConstructorFound
+	})
+	if err != nil && err != errConstructorFound {
+		return nil, err
+	}
+
+	return constructor, nil
+}
+
+// readStringBytes reads a string. The result refers to the input if the string has no escape sequences
+func (decoder *jsonDecoder) readStringBytes() ([]byte, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	if decoder.next() != '"' {
+		return nil, decoder.typeError("string")
+	}
+
+	start := decoder.pos + 1
+	ascii := true
+	for i := start; i < len(decoder.data); i++ {
+		switch c := decoder.data[i]; {
+		case c == '"':
+			value := decoder.data[start:i]
+			if !ascii && !utf8.Valid(value) {
+				return decoder.unquote()
+			}
+			decoder.pos = i + 1
+
+			return value, nil
+
+		case c == '\\':
+			return decoder.unquote()
+
+		case c < 0x20:
+			decoder.pos = i
+			return nil, decoder.syntaxError("string character")
+
+		case c >= utf8.RuneSelf:
+			ascii = false
+		}
+	}
+
+	decoder.pos = len(decoder.data)
+
+	return nil, errUnexpectedEnd
+}
+
+// unquote reads a string with escape sequences or invalid UTF-8, which is replaced by U+FFFD
+func (decoder *jsonDecoder) unquote() ([]byte, error) {
+	data := decoder.data
+	value := make([]byte, 0, 64)
+
+	i := decoder.pos + 1
+	for i < len(data) {
+		c := data[i]
+		switch {
+		case c == '"':
+			decoder.pos = i + 1
+			return value, nil
+
+		case c < 0x20:
+			decoder.pos = i
+			return nil, decoder.syntaxError("string character")
+
+		case c == '\\':
+			if i+1 >= len(data) {
+				decoder.pos = len(data)
+				return nil, errUnexpectedEnd
+			}
+
+			switch data[i+1] {
+			case '"', '\\', '/':
+				value = append(value, data[i+1])
+			case 'b':
+				value = append(value, '\b')
+			case 'f':
+				value = append(value, '\f')
+			case 'n':
+				value = append(value, '\n')
+			case 'r':
+				value = append(value, '\r')
+			case 't':
+				value = append(value, '\t')
+			case 'u':
+				r, ok := readHexRune(data[i+2:])
+				if !ok {
+					decoder.pos = i + 1
+					return nil, decoder.syntaxError("escape sequence")
+				}
+				i += 4
+
+				if utf16.IsSurrogate(r) {
+					r2, ok := rune(0), false
+					if i+7 < len(data) && data[i+2] == '\\' && data[i+3] == 'u' {
+						r2, ok = readHexRune(data[i+4:])
+					}
+
+					r = utf16.DecodeRune(r, r2)
+					if ok && r != utf8.RuneError {
+						i += 6
+					} else {
+						r = utf8.RuneError
+					}
+				}
+
+				value = utf8.AppendRune(value, r)
+			default:
+				decoder.pos = i + 1
+				return nil, decoder.syntaxError("escape sequence")
+			}
+			i += 2
+
+		case c < utf8.RuneSelf:
+			value = append(value, c)
+			i++
+
+		default:
+			r, size := utf8.DecodeRune(data[i:])
+			value = utf8.AppendRune(value, r)
+			i += size
+		}
+	}
+
+	decoder.pos = len(data)
+
+	return nil, errUnexpectedEnd
+}
+
+func readHexRune(data []byte) (rune, bool) {
+	if len(data) < 4 {
+		return 0, false
+	}
+
+	var r rune
+	for _, c := range data[:4] {
+		switch {
+		case '0' <= c && c <= '9':
+			c = c - '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return 0, false
+		}
+		r = r*16 + rune(c)
+	}
+
+	return r, true
+}
+
+func (decoder *jsonDecoder) readString() (string, error) {
+	value, err := decoder.readStringBytes()
+	return string(value), err
+}
+
+// readNumber reads the bytes of a number
+func (decoder *jsonDecoder) readNumber() ([]byte, error) {
+	data := decoder.data
+	start := decoder.pos
+	i := start
+
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+
+	switch {
+	case i < len(data) && data[i] == '0':
+		i++
+	case i < len(data) && '1' <= data[i] && data[i] <= '9':
+		for i < len(data) && '0' <= data[i] && data[i] <= '9' {
+			i++
+		}
+	default:
+		decoder.pos = i
+		return nil, decoder.syntaxError("digit")
+	}
+
+	if i < len(data) && data[i] == '.' {
+		i++
+		if i >= len(data) || data[i] < '0' || data[i] > '9' {
+			decoder.pos = i
+			return nil, decoder.syntaxError("digit")
+		}
+		for i < len(data) && '0' <= data[i] && data[i] <= '9' {
+			i++
+		}
+	}
+
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		if i >= len(data) || data[i] < '0' || data[i] > '9' {
+			decoder.pos = i
+			return nil, decoder.syntaxError("digit")
+		}
+		for i < len(data) && '0' <= data[i] && data[i] <= '9' {
+			i++
+		}
+	}
+
+	decoder.pos = i
+
+	return data[start:i], nil
+}
+
+func (decoder *jsonDecoder) isNumber() bool {
+	c := decoder.next()
+	return c == '-' || ('0' <= c && c <= '9')
+}
+
+// parseInt parses a decimal integer without allocations
+func parseInt(data []byte, bitSize int) (int64, error) {
+	digits := data
+	negative := len(digits) > 0 && digits[0] == '-'
+	if negative || (len(digits) > 0 && digits[0] == '+') {
+		digits = digits[1:]
+	}
+
+	if len(digits) == 0 || len(digits) > 19 {
+		return strconv.ParseInt(string(data), 10, bitSize)
+	}
+
+	var value uint64
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return strconv.ParseInt(string(data), 10, bitSize)
+		}
+		value = value*10 + uint64(c-'0')
+	}
+
+	limit := uint64(1) << (bitSize - 1)
+	if value > limit || (value == limit && !negative) {
+		return strconv.ParseInt(string(data), 10, bitSize)
+	}
+
+	if negative {
+		return -int64(value), nil
+	}
+
+	return int64(value), nil
+}
+
+func (decoder *jsonDecoder) readInt(bitSize int) (int64, error) {
+	if decoder.readNull() {
+		return 0, nil
+	}
+
+	if !decoder.isNumber() {
+		return 0, decoder.typeError(fmt.Sprintf("int%d", bitSize))
+	}
+
+	number, err := decoder.readNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	return parseInt(number, bitSize)
+}
+
+func (decoder *jsonDecoder) readInt32() (int32, error) {
+	value, err := decoder.readInt(32)
+	return int32(value), err
+}
+
+func (decoder *jsonDecoder) readInt64() (int64, error) {
+	return decoder.readInt(64)
+}
+
+// readJsonInt64 reads a string or a number
+func (decoder *jsonDecoder) readJsonInt64() (JsonInt64, error) {
+	if decoder.next() != '"' {
+		value, err := decoder.readInt(64)
+		return JsonInt64(value), err
+	}
+
+	data, err := decoder.readStringBytes()
+	if err != nil {
+		return 0, err
+	}
+
+	value, err := parseInt(data, 64)
+
+	return JsonInt64(value), err
+}
+
+func (decoder *jsonDecoder) readFloat64() (float64, error) {
+	if decoder.readNull() {
+		return 0, nil
+	}
+
+	if !decoder.isNumber() {
+		return 0, decoder.typeError("float64")
+	}
+
+	number, err := decoder.readNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(string(number), 64)
+}
+
+func (decoder *jsonDecoder) readBool() (bool, error) {
+	switch {
+	case decoder.literal("true"):
+		return true, nil
+
+	case decoder.literal("false"), decoder.readNull():
+		return false, nil
+	}
+
+	return false, decoder.typeError("bool")
+}
+
+// readBytes reads a base64 string
+func (decoder *jsonDecoder) readBytes() ([]byte, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	data, err := decoder.readStringBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+	n, err := base64.StdEncoding.Decode(value, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return value[:n], nil
+}
+
+// skip skips the next value
+func (decoder *jsonDecoder) skip() error {
+	switch c := decoder.next(); {
+	case c == '{':
+		return decoder.readObject(func(key []byte) error {
+			return decoder.skip()
+		})
+
+	case c == '[':
+		return decoder.readArray(func(i int) error {
+			return decoder.skip()
+		})
+
+	case c == '"':
+		for i := decoder.pos + 1; i < len(decoder.data); i++ {
+			switch decoder.data[i] {
+			case '"':
+				decoder.pos = i + 1
+				return nil
+
+			case '\\':
+				i++
+			}
+		}
+		decoder.pos = len(decoder.data)
+
+		return errUnexpectedEnd
+
+	case c == '-' || ('0' <= c && c <= '9'):
+		_, err := decoder.readNumber()
+		return err
+
+	case decoder.literal("true"), decoder.literal("false"), decoder.readNull():
+		return nil
+	}
+
+	return decoder.syntaxError("value")
+}
+
+// readRaw returns a copy of the next value
+func (decoder *jsonDecoder) readRaw() (json.RawMessage, error) {
+	decoder.next()
+	start := decoder.pos
+
+	err := decoder.skip()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(json.RawMessage(nil), decoder.data[start:decoder.pos]...), nil
+}
+
+// readValue reads a value of a type without a reader by encoding/json
+func readValue[T any](decoder *jsonDecoder) (T, error) {
+	var value T
+
+	data, err := decoder.readRaw()
+	if err != nil {
+		return value, err
+	}
+
+	err = json.Unmarshal(data, &value)
+
+	return value, err
+}
+
+// readNullable reads a value which may be null
+func readNullable[T any](decoder *jsonDecoder, read func(decoder *jsonDecoder) (T, error)) (*T, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	value, err := read(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+// readList reads an array. Errors are prefixed with the index of the failed element
+func readList[T any](decoder *jsonDecoder, read func(decoder *jsonDecoder) (T, error)) ([]T, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	list := []T{}
+	err := decoder.readArray(func(i int) error {
+		value, err := read(decoder)
+		if err != nil {
+			return wrapUnmarshalError("["+strconv.Itoa(i)+"]", err)
+		}
+		list = append(list, value)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// listReader makes a reader of an array from the reader of its elements
+func listReader[T any](read func(decoder *jsonDecoder) (T, error)) func(decoder *jsonDecoder) ([]T, error) {
+	return func(decoder *jsonDecoder) ([]T, error) {
+		return readList(decoder, read)
+	}
+}
+
+// decodeEntity reads an object of a constructor. null is nil
+func decodeEntity[T any, P interface {
+	*T
+	decodeJSON(decoder *jsonDecoder) error
+}](decoder *jsonDecoder) (P, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	entity := P(new(T))
+	err := entity.decodeJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// unknownType reads an object of a constructor unknown to this version of the library
+func (decoder *jsonDecoder) unknownType(constructor []byte) (*UnknownType, error) {
+	data, err := decoder.readRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnknownType{Constructor: string(constructor), Data: data}, nil
+}
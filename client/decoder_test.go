@@ -0,0 +1,228 @@
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+)
+
+var codecFixtures = []string{"updateNewMessage", "updateNewChat", "chats", "messages"}
+
+func readFixture(tb testing.TB, name string) []byte {
+	data, err := os.ReadFile("testdata/" + name + ".json")
+	if err != nil {
+		tb.Fatalf("fixture error: %s", err)
+	}
+
+	return data
+}
+
+// withoutNulls removes the null fields, which are omitted or written as null depending on the field
+func withoutNulls(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range value {
+			if item != nil {
+				result[key] = withoutNulls(item)
+			}
+		}
+		return result
+
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			result[i] = withoutNulls(item)
+		}
+		return result
+	}
+
+	return value
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	for _, name := range codecFixtures {
+		t.Run(name, func(t *testing.T) {
+			data := readFixture(t, name)
+
+			typ, err := UnmarshalType(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %s", err)
+			}
+			if typ.GetConstructor() != name {
+				t.Fatalf("constructor = %s, want %s", typ.GetConstructor(), name)
+			}
+
+			marshaled, err := json.Marshal(typ)
+			if err != nil {
+				t.Fatalf("marshal error: %s", err)
+			}
+
+			var want, got any
+			_ = json.Unmarshal(data, &want)
+			err = json.Unmarshal(marshaled, &got)
+			if err != nil {
+				t.Fatalf("marshaled JSON is invalid: %s", err)
+			}
+
+			if !reflect.DeepEqual(withoutNulls(got), withoutNulls(want)) {
+				t.Errorf("round trip differs:\n got %s\nwant %s", marshaled, data)
+			}
+		})
+	}
+}
+
+func TestDecoderMatchesEncodingJson(t *testing.T) {
+	strings := []string{
+		`"text"`,
+		`"Привет, мир 🚀"`,
+		`"escapes \" \\ \/ \b \f \n \r \t"`,
+		`"Aé世🚀"`,
+		`"lone surrogates \ud83d \ude80 \ud83dx"`,
+		"\"invalid utf-8 \xff\xfe\"",
+		`""`,
+	}
+	for _, data := range strings {
+		var want string
+		_ = json.Unmarshal([]byte(data), &want)
+
+		got, err := unmarshalJSON([]byte(data), (*jsonDecoder).readString)
+		if err != nil || got != want {
+			t.Errorf("string %s = %q, %v, want %q", data, got, err, want)
+		}
+	}
+
+	numbers := []string{`0`, `-1`, `1.5`, `-0.25e-3`, `1E+21`, `123456789012345678`}
+	for _, data := range numbers {
+		var want float64
+		_ = json.Unmarshal([]byte(data), &want)
+
+		got, err := unmarshalJSON([]byte(data), (*jsonDecoder).readFloat64)
+		if err != nil || got != want {
+			t.Errorf("number %s = %v, %v, want %v", data, got, err, want)
+		}
+	}
+
+	var fields struct {
+		Id    int64     `json:"id"`
+		Order JsonInt64 `json:"order"`
+		Data  []byte    `json:"data"`
+	}
+	data := []byte(` { "order" : "-9223372036854775808" , "unknown": [{"a": [1, "]"]}, null, true], "id": 9007199254740993, "data": "AQID" } `)
+	_ = json.Unmarshal(data, &fields)
+
+	var got struct {
+		id    int64
+		order JsonInt64
+		data  []byte
+	}
+	err := unmarshalObject(data, func(decoder *jsonDecoder) error {
+		return decoder.readObject(func(key []byte) error {
+			var err error
+			switch string(key) {
+			case "id":
+				got.id, err = decoder.readInt64()
+			case "order":
+				got.order, err = decoder.readJsonInt64()
+			case "data":
+				got.data, err = decoder.readBytes()
+			default:
+				err = decoder.skip()
+			}
+			return err
+		})
+	})
+	if err != nil || got.id != fields.Id || got.order != fields.Order || string(got.data) != string(fields.Data) {
+		t.Errorf("object = %+v, %v, want %+v", got, err, fields)
+	}
+}
+
+func TestUnmarshalInvalidJson(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`{"@type":"ok"`,
+		`{"@type":"ok"} {}`,
+		`{"@type":"message","id":"1"}`,
+		`{"@type":"message","date":2147483648}`,
+		`{"@type":"message","content":{"@type":"messageText","text":{"text":"\x"}}}`,
+		`[1]`,
+	} {
+		_, err := UnmarshalType(json.RawMessage(data))
+		if err == nil {
+			t.Errorf("%s: expected an error", data)
+		}
+	}
+}
+
+func TestDecodeResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		constructor string
+		decoded     bool
+	}{
+		{"known", `{"@type":"updateNewMessage","message":{"@type":"message","id":1,"@extra":"nested"},"@extra":"1","@client_id":2}`, ConstructorUpdateNewMessage, true},
+		{"unknown constructor", `{"@type":"updateFromTheFuture","value":{"@extra":"nested"},"@extra":"1","@client_id":2}`, "updateFromTheFuture", true},
+		{"invalid field", `{"@type":"updateNewMessage","message":{"@type":"message","id":"1"},"@extra":"1","@client_id":2}`, ConstructorUpdateNewMessage, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := decodeResponse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if resp.MetaType != tt.constructor || resp.MetaExtra != "1" || resp.MetaClientId != 2 || string(resp.Data) != tt.data {
+				t.Errorf("response = %+v", resp)
+			}
+			if (resp.Type != nil) != tt.decoded {
+				t.Fatalf("type = %T, decoded %t", resp.Type, tt.decoded)
+			}
+			if tt.decoded && resp.Type.GetConstructor() != tt.constructor {
+				t.Errorf("constructor = %s, want %s", resp.Type.GetConstructor(), tt.constructor)
+			}
+		})
+	}
+
+	_, err := decodeResponse([]byte(`{"@type":"ok"`))
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	resp, err := parseResponse([]byte(`{"@type":"ok","value":{"@extra":"nested"},"@extra":"1","@client_id":2}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.MetaType != "ok" || resp.MetaExtra != "1" || resp.MetaClientId != 2 {
+		t.Errorf("meta = %+v", resp.meta)
+	}
+}
+
+// BenchmarkReceive measures the path of a response from TDLib to the result handler.
+func BenchmarkReceive(b *testing.B) {
+	for _, name := range codecFixtures {
+		data := readFixture(b, name)
+
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+
+			for range b.N {
+				resp, err := decodeResponse(data)
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				_, err = unmarshalResult(resp, UnmarshalType)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+)
+
+// jsonEncodable is implemented by the generated types and requests
+type jsonEncodable interface {
+	encodeJSON(encoder *jsonEncoder)
+}
+
+// jsonEncoder appends JSON values to a buffer without reflection
+type jsonEncoder struct {
+	buf []byte
+	err error
+}
+
+// marshalRequest marshals the request with the generated encoder if it has one
+func marshalRequest(req Request) ([]byte, error) {
+	value, ok := req.(jsonEncodable)
+	if !ok {
+		return json.Marshal(req)
+	}
+
+	return marshalJSON(value)
+}
+
+func marshalJSON(value jsonEncodable) ([]byte, error) {
+	encoder := &jsonEncoder{}
+	value.encodeJSON(encoder)
+	if encoder.err != nil {
+		return nil, encoder.err
+	}
+
+	return encoder.buf, nil
+}
+
+func (encoder *jsonEncoder) setError(err error) {
+	if encoder.err == nil {
+		encoder.err = err
+	}
+}
+
+func (encoder *jsonEncoder) writeRaw(value string) {
+	encoder.buf = append(encoder.buf, value...)
+}
+
+// writeMeta writes @extra and @client_id of a received object
+func (encoder *jsonEncoder) writeMeta(meta *meta) {
+	encoder.writeExtra(meta.MetaExtra)
+	if meta.MetaClientId != 0 {
+		encoder.writeRaw(`,"@client_id":`)
+		encoder.writeInt64(int64(meta.MetaClientId))
+	}
+}
+
+func (encoder *jsonEncoder) writeExtra(extra string) {
+	if extra != "" {
+		encoder.writeRaw(`,"@extra":`)
+		encoder.writeString(extra)
+	}
+}
+
+const hexDigits = "0123456789abcdef"
+
+// writeString writes a string with the escaping of encoding/json
+func (encoder *jsonEncoder) writeString(value string) {
+	buf := append(encoder.buf, '"')
+
+	start := 0
+	for i := 0; i < len(value); {
+		c := value[i]
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+
+			buf = append(buf, value[start:i]...)
+			switch c {
+			case '"', '\\':
+				buf = append(buf, '\\', c)
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(value[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf = append(buf, value[start:i]...)
+			buf = append(buf, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+
+		if r == '\u2028' || r == '\u2029' {
+			buf = append(buf, value[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+
+		i += size
+	}
+
+	buf = append(buf, value[start:]...)
+	encoder.buf = append(buf, '"')
+}
+
+func (encoder *jsonEncoder) writeInt32(value int32) {
+	encoder.buf = strconv.AppendInt(encoder.buf, int64(value), 10)
+}
+
+func (encoder *jsonEncoder) writeInt64(value int64) {
+	encoder.buf = strconv.AppendInt(encoder.buf, value, 10)
+}
+
+func (encoder *jsonEncoder) writeJsonInt64(value JsonInt64) {
+	encoder.buf = append(encoder.buf, '"')
+	encoder.buf = strconv.AppendInt(encoder.buf, int64(value), 10)
+	encoder.buf = append(encoder.buf, '"')
+}
+
+// writeFloat64 writes a number in the format of encoding/json
+func (encoder *jsonEncoder) writeFloat64(value float64) {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		encoder.setError(&json.UnsupportedValueError{Value: reflect.ValueOf(value), Str: strconv.FormatFloat(value, 'g', -1, 64)})
+		encoder.writeRaw("null")
+		return
+	}
+
+	format := byte('f')
+	if abs := math.Abs(value); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+
+	buf := strconv.AppendFloat(encoder.buf, value, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(buf)
+		if n >= 4 && buf[n-4] == 'e' && buf[n-3] == '-' && buf[n-2] == '0' {
+			buf[n-2] = buf[n-1]
+			buf = buf[:n-1]
+		}
+	}
+	encoder.buf = buf
+}
+
+func (encoder *jsonEncoder) writeBool(value bool) {
+	if value {
+		encoder.writeRaw("true")
+	} else {
+		encoder.writeRaw("false")
+	}
+}
+
+// writeBytes writes a base64 string
+func (encoder *jsonEncoder) writeBytes(value []byte) {
+	if value == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.buf = append(encoder.buf, '"')
+	encoder.buf = base64.StdEncoding.AppendEncode(encoder.buf, value)
+	encoder.buf = append(encoder.buf, '"')
+}
+
+// writeType writes a value of a class or a constructor. Values without the generated encoder are marshalled by encoding/json
+func (encoder *jsonEncoder) writeType(value any) {
+	if value == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encodable, ok := value.(jsonEncodable)
+	if ok {
+		encodable.encodeJSON(encoder)
+		return
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		encoder.setError(err)
+		encoder.writeRaw("null")
+		return
+	}
+	encoder.buf = append(encoder.buf, data...)
+}
+
+// writeJSON writes compacted JSON
+func (encoder *jsonEncoder) writeJSON(data []byte) {
+	buf := bytes.NewBuffer(encoder.buf)
+
+	err := json.Compact(buf, data)
+	if err != nil {
+		encoder.setError(err)
+		encoder.writeRaw("null")
+		return
+	}
+	encoder.buf = buf.Bytes()
+}
+
+func writeList[T any](encoder *jsonEncoder, values []T, write func(encoder *jsonEncoder, value T)) {
+	if values == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.buf = append(encoder.buf, '[')
+	for i, value := range values {
+		if i > 0 {
+			encoder.buf = append(encoder.buf, ',')
+		}
+		write(encoder, value)
+	}
+	encoder.buf = append(encoder.buf, ']')
+}
+
+// listWriter makes a writer of an array from the writer of its elements
+func listWriter[T any](write func(encoder *jsonEncoder, value T)) func(encoder *jsonEncoder, values []T) {
+	return func(encoder *jsonEncoder, values []T) {
+		writeList(encoder, values, write)
+	}
+}
+
+func writeTypeValue[T any](encoder *jsonEncoder, value T) {
+	encoder.writeType(value)
+}
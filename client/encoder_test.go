@@ -0,0 +1,107 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEncoderMatchesEncodingJson(t *testing.T) {
+	for _, value := range []string{"text", "<a href=\"x\">&amp;</a>", "\x00\x1f\b\f\n\r\t\\", "\u2028\u2029 🚀"} {
+		want, _ := json.Marshal(value)
+
+		encoder := &jsonEncoder{}
+		encoder.writeString(value)
+		if string(encoder.buf) != string(want) {
+			t.Errorf("string %q = %s, want %s", value, encoder.buf, want)
+		}
+	}
+
+	// invalid UTF-8 is replaced by U+FFFD, escaped or not depending on the Go version
+	encoder := &jsonEncoder{}
+	encoder.writeString("invalid \xff utf-8")
+	var value string
+	err := json.Unmarshal(encoder.buf, &value)
+	if err != nil || value != "invalid \ufffd utf-8" {
+		t.Errorf("invalid UTF-8 = %s, %v", encoder.buf, err)
+	}
+
+	for _, value := range []float64{0, 1, -1.5, 0.1, 1e-7, 123456789, 1e20, 1e21, -2.5e-10, math.MaxFloat64} {
+		want, _ := json.Marshal(value)
+
+		encoder := &jsonEncoder{}
+		encoder.writeFloat64(value)
+		if string(encoder.buf) != string(want) {
+			t.Errorf("float %v = %s, want %s", value, encoder.buf, want)
+		}
+	}
+
+	_, err = json.Marshal(&Location{Latitude: math.NaN()})
+	var unsupportedErr *json.UnsupportedValueError
+	if !errors.As(err, &unsupportedErr) {
+		t.Errorf("NaN error = %v, want *json.UnsupportedValueError", err)
+	}
+}
+
+func TestMarshalRequest(t *testing.T) {
+	req := &SendMessageRequest{
+		ChatId: 1,
+		InputMessageContent: &InputMessageText{
+			Text: &FormattedText{Text: "text", Entities: []*TextEntity{{Offset: 0, Length: 4, Type: &TextEntityTypeBold{}}}},
+		},
+		ReplyMarkup: &ReplyMarkupInlineKeyboard{
+			Rows: [][]*InlineKeyboardButton{{{Text: "button", Type: &InlineKeyboardButtonTypeCallback{Data: []byte{1, 2, 3}}}}},
+		},
+	}
+	req.SetExtra("extra")
+
+	data, err := marshalRequest(req)
+	if err != nil {
+		t.Fatalf("marshal error: %s", err)
+	}
+
+	want := `{"@type":"sendMessage","@extra":"extra","chat_id":1,"message_thread_id":0,` +
+		`"reply_markup":{"@type":"replyMarkupInlineKeyboard","rows":[[{"@type":"inlineKeyboardButton","text":"button","type":{"@type":"inlineKeyboardButtonTypeCallback","data":"AQID"}}]]},` +
+		`"input_message_content":{"@type":"inputMessageText","text":{"@type":"formattedText","text":"text","entities":[{"@type":"textEntity","offset":0,"length":4,"type":{"@type":"textEntityTypeBold"}}]},"clear_draft":false}}`
+	if string(data) != want {
+		t.Errorf("request = %s\nwant %s", data, want)
+	}
+}
+
+// BenchmarkMarshal measures marshalling of a type by its MarshalJSON and of a request sent to TDLib.
+func BenchmarkMarshal(b *testing.B) {
+	chat, _ := UnmarshalType(readFixture(b, "updateNewChat"))
+	message, _ := UnmarshalType(readFixture(b, "updateNewMessage"))
+
+	req := &SendMessageRequest{
+		ChatId:              1,
+		InputMessageContent: &InputMessageText{Text: message.(*UpdateNewMessage).Message.Content.(*MessageText).Text},
+		ReplyMarkup:         message.(*UpdateNewMessage).Message.ReplyMarkup,
+	}
+	req.SetExtra("1")
+	req.SetType(req.GetFunctionName())
+
+	values := []struct {
+		name    string
+		marshal func() ([]byte, error)
+	}{
+		{"chat", chat.(*UpdateNewChat).Chat.MarshalJSON},
+		{"sendMessage", func() ([]byte, error) {
+			return marshalRequest(req)
+		}},
+	}
+
+	for _, value := range values {
+		b.Run(value.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for range b.N {
+				_, err := value.marshal()
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,2277 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type GetAuthorizationStateRequest struct {
+	request
+}
+
+func (req GetAuthorizationStateRequest) GetFunctionName() string {
+	return "getAuthorizationState"
+}
+
+func (req *GetAuthorizationStateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAuthorizationStateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAuthorizationState"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetAuthorizationStateRequest) Validate() error {
+	return nil
+}
+
+// Returns the current authorization state. This is an offline method. For informational purposes only. Use updateAuthorizationState instead to maintain the current authorization state. Can be called before initialization
+func (client *Client) GetAuthorizationState(ctx context.Context) (AuthorizationState, error) {
+	req := &GetAuthorizationStateRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAuthorizationState)
+}
+
+type SetAuthenticationPhoneNumberRequest struct {
+	request
+	// The phone number of the user, in international format
+	PhoneNumber string `json:"phone_number"`
+	// Settings for the authentication of the user's phone number; pass null to use default settings
+	Settings *PhoneNumberAuthenticationSettings `json:"settings,omitempty"`
+}
+
+func (req SetAuthenticationPhoneNumberRequest) GetFunctionName() string {
+	return "setAuthenticationPhoneNumber"
+}
+
+func (req *SetAuthenticationPhoneNumberRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAuthenticationPhoneNumberRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAuthenticationPhoneNumber"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(req.PhoneNumber)
+	if req.Settings != nil {
+		encoder.writeRaw(`,"settings":`)
+		req.Settings.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetAuthenticationPhoneNumberRequest) Validate() error {
+	return nil
+}
+
+// Sets the phone number of the user and sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitPremiumPurchase, authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
+func (client *Client) SetAuthenticationPhoneNumber(ctx context.Context, req *SetAuthenticationPhoneNumberRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckAuthenticationPremiumPurchaseRequest struct {
+	request
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+}
+
+func (req CheckAuthenticationPremiumPurchaseRequest) GetFunctionName() string {
+	return "checkAuthenticationPremiumPurchase"
+}
+
+func (req *CheckAuthenticationPremiumPurchaseRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckAuthenticationPremiumPurchaseRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkAuthenticationPremiumPurchase"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(req.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(req.Amount)
+	encoder.writeRaw("}")
+}
+
+func (req CheckAuthenticationPremiumPurchaseRequest) Validate() error {
+	return nil
+}
+
+// Checks whether an in-store purchase of Telegram Premium is possible before authorization. Works only when the current authorization state is authorizationStateWaitPremiumPurchase
+func (client *Client) CheckAuthenticationPremiumPurchase(ctx context.Context, req *CheckAuthenticationPremiumPurchaseRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetAuthenticationPremiumPurchaseTransactionRequest struct {
+	request
+	// Information about the transaction
+	Transaction StoreTransaction `json:"transaction"`
+	// Pass true if this is a restore of a Telegram Premium purchase; only for App Store
+	IsRestore bool `json:"is_restore"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+}
+
+func (req SetAuthenticationPremiumPurchaseTransactionRequest) GetFunctionName() string {
+	return "setAuthenticationPremiumPurchaseTransaction"
+}
+
+func (req *SetAuthenticationPremiumPurchaseTransactionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAuthenticationPremiumPurchaseTransactionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAuthenticationPremiumPurchaseTransaction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"transaction":`)
+	encoder.writeType(req.Transaction)
+	encoder.writeRaw(`,"is_restore":`)
+	encoder.writeBool(req.IsRestore)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(req.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(req.Amount)
+	encoder.writeRaw("}")
+}
+
+func (req SetAuthenticationPremiumPurchaseTransactionRequest) Validate() error {
+	return nil
+}
+
+// Informs server about an in-store purchase of Telegram Premium before authorization. Works only when the current authorization state is authorizationStateWaitPremiumPurchase
+func (client *Client) SetAuthenticationPremiumPurchaseTransaction(ctx context.Context, req *SetAuthenticationPremiumPurchaseTransactionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetAuthenticationEmailAddressRequest struct {
+	request
+	// The email address of the user
+	EmailAddress string `json:"email_address"`
+}
+
+func (req SetAuthenticationEmailAddressRequest) GetFunctionName() string {
+	return "setAuthenticationEmailAddress"
+}
+
+func (req *SetAuthenticationEmailAddressRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAuthenticationEmailAddressRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAuthenticationEmailAddress"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"email_address":`)
+	encoder.writeString(req.EmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (req SetAuthenticationEmailAddressRequest) Validate() error {
+	return nil
+}
+
+// Sets the email address of the user and sends an authentication code to the email address. Works only when the current authorization state is authorizationStateWaitEmailAddress
+func (client *Client) SetAuthenticationEmailAddress(ctx context.Context, req *SetAuthenticationEmailAddressRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ResendAuthenticationCodeRequest struct {
+	request
+	// Reason of code resending; pass null if unknown
+	Reason ResendCodeReason `json:"reason,omitempty"`
+}
+
+func (req ResendAuthenticationCodeRequest) GetFunctionName() string {
+	return "resendAuthenticationCode"
+}
+
+func (req *ResendAuthenticationCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResendAuthenticationCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendAuthenticationCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Reason != nil {
+		encoder.writeRaw(`,"reason":`)
+		encoder.writeType(req.Reason)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req ResendAuthenticationCodeRequest) Validate() error {
+	return nil
+}
+
+// Resends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitCode, the next_code_type of the result is not null and the server-specified timeout has passed, or when the current authorization state is authorizationStateWaitEmailCode
+func (client *Client) ResendAuthenticationCode(ctx context.Context, req *ResendAuthenticationCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckAuthenticationEmailCodeRequest struct {
+	request
+	// Email address authentication to check
+	Code EmailAddressAuthentication `json:"code"`
+}
+
+func (req CheckAuthenticationEmailCodeRequest) GetFunctionName() string {
+	return "checkAuthenticationEmailCode"
+}
+
+func (req *CheckAuthenticationEmailCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckAuthenticationEmailCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkAuthenticationEmailCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeType(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req CheckAuthenticationEmailCodeRequest) Validate() error {
+	return nil
+}
+
+// Checks the authentication of an email address. Works only when the current authorization state is authorizationStateWaitEmailCode
+func (client *Client) CheckAuthenticationEmailCode(ctx context.Context, req *CheckAuthenticationEmailCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckAuthenticationCodeRequest struct {
+	request
+	// Authentication code to check
+	Code string `json:"code"`
+}
+
+func (req CheckAuthenticationCodeRequest) GetFunctionName() string {
+	return "checkAuthenticationCode"
+}
+
+func (req *CheckAuthenticationCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckAuthenticationCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkAuthenticationCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req CheckAuthenticationCodeRequest) Validate() error {
+	return nil
+}
+
+// Checks the authentication code. Works only when the current authorization state is authorizationStateWaitCode
+func (client *Client) CheckAuthenticationCode(ctx context.Context, req *CheckAuthenticationCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RequestQrCodeAuthenticationRequest struct {
+	request
+	// List of user identifiers of other users currently using the application
+	OtherUserIds []int64 `json:"other_user_ids"`
+}
+
+func (req RequestQrCodeAuthenticationRequest) GetFunctionName() string {
+	return "requestQrCodeAuthentication"
+}
+
+func (req *RequestQrCodeAuthenticationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RequestQrCodeAuthenticationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"requestQrCodeAuthentication"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"other_user_ids":`)
+	writeList(encoder, req.OtherUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req RequestQrCodeAuthenticationRequest) Validate() error {
+	return nil
+}
+
+// Requests QR code authentication by scanning a QR code on another logged in device. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitPremiumPurchase, authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
+func (client *Client) RequestQrCodeAuthentication(ctx context.Context, req *RequestQrCodeAuthenticationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ResetAuthenticationEmailAddressRequest struct {
+	request
+}
+
+func (req ResetAuthenticationEmailAddressRequest) GetFunctionName() string {
+	return "resetAuthenticationEmailAddress"
+}
+
+func (req *ResetAuthenticationEmailAddressRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResetAuthenticationEmailAddressRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetAuthenticationEmailAddress"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResetAuthenticationEmailAddressRequest) Validate() error {
+	return nil
+}
+
+// Resets the login email address. May return an error with a message "TASK_ALREADY_EXISTS" if reset is still pending. Works only when the current authorization state is authorizationStateWaitEmailCode and authorization_state.can_reset_email_address == true
+func (client *Client) ResetAuthenticationEmailAddress(ctx context.Context) (*Ok, error) {
+	req := &ResetAuthenticationEmailAddressRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckAuthenticationPasswordRequest struct {
+	request
+	// The 2-step verification password to check
+	Password string `json:"password"`
+}
+
+func (req CheckAuthenticationPasswordRequest) GetFunctionName() string {
+	return "checkAuthenticationPassword"
+}
+
+func (req *CheckAuthenticationPasswordRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckAuthenticationPasswordRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkAuthenticationPassword"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req CheckAuthenticationPasswordRequest) Validate() error {
+	return nil
+}
+
+// Checks the 2-step verification password for correctness. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) CheckAuthenticationPassword(ctx context.Context, req *CheckAuthenticationPasswordRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RequestAuthenticationPasswordRecoveryRequest struct {
+	request
+}
+
+func (req RequestAuthenticationPasswordRecoveryRequest) GetFunctionName() string {
+	return "requestAuthenticationPasswordRecovery"
+}
+
+func (req *RequestAuthenticationPasswordRecoveryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RequestAuthenticationPasswordRecoveryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"requestAuthenticationPasswordRecovery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req RequestAuthenticationPasswordRecoveryRequest) Validate() error {
+	return nil
+}
+
+// Requests to send a 2-step verification password recovery code to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) RequestAuthenticationPasswordRecovery(ctx context.Context) (*Ok, error) {
+	req := &RequestAuthenticationPasswordRecoveryRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckAuthenticationPasswordRecoveryCodeRequest struct {
+	request
+	// Recovery code to check
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (req CheckAuthenticationPasswordRecoveryCodeRequest) GetFunctionName() string {
+	return "checkAuthenticationPasswordRecoveryCode"
+}
+
+func (req *CheckAuthenticationPasswordRecoveryCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckAuthenticationPasswordRecoveryCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkAuthenticationPasswordRecoveryCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"recovery_code":`)
+	encoder.writeString(req.RecoveryCode)
+	encoder.writeRaw("}")
+}
+
+func (req CheckAuthenticationPasswordRecoveryCodeRequest) Validate() error {
+	return nil
+}
+
+// Checks whether a 2-step verification password recovery code sent to an email address is valid. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) CheckAuthenticationPasswordRecoveryCode(ctx context.Context, req *CheckAuthenticationPasswordRecoveryCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RecoverAuthenticationPasswordRequest struct {
+	request
+	// Recovery code to check
+	RecoveryCode string `json:"recovery_code"`
+	// New 2-step verification password of the user; may be empty to remove the password
+	NewPassword string `json:"new_password"`
+	// New password hint; may be empty
+	NewHint string `json:"new_hint"`
+}
+
+func (req RecoverAuthenticationPasswordRequest) GetFunctionName() string {
+	return "recoverAuthenticationPassword"
+}
+
+func (req *RecoverAuthenticationPasswordRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RecoverAuthenticationPasswordRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"recoverAuthenticationPassword"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"recovery_code":`)
+	encoder.writeString(req.RecoveryCode)
+	encoder.writeRaw(`,"new_password":`)
+	encoder.writeString(req.NewPassword)
+	encoder.writeRaw(`,"new_hint":`)
+	encoder.writeString(req.NewHint)
+	encoder.writeRaw("}")
+}
+
+func (req RecoverAuthenticationPasswordRequest) Validate() error {
+	return nil
+}
+
+// Recovers the 2-step verification password with a password recovery code sent to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) RecoverAuthenticationPassword(ctx context.Context, req *RecoverAuthenticationPasswordRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendAuthenticationFirebaseSmsRequest struct {
+	request
+	// Play Integrity API or SafetyNet Attestation API token for the Android application, or secret from push notification for the iOS application
+	Token string `json:"token"`
+}
+
+func (req SendAuthenticationFirebaseSmsRequest) GetFunctionName() string {
+	return "sendAuthenticationFirebaseSms"
+}
+
+func (req *SendAuthenticationFirebaseSmsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendAuthenticationFirebaseSmsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendAuthenticationFirebaseSms"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(req.Token)
+	encoder.writeRaw("}")
+}
+
+func (req SendAuthenticationFirebaseSmsRequest) Validate() error {
+	return nil
+}
+
+// Sends Firebase Authentication SMS to the phone number of the user. Works only when the current authorization state is authorizationStateWaitCode and the server returned code of the type authenticationCodeTypeFirebaseAndroid or authenticationCodeTypeFirebaseIos
+func (client *Client) SendAuthenticationFirebaseSms(ctx context.Context, req *SendAuthenticationFirebaseSmsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportAuthenticationCodeMissingRequest struct {
+	request
+	// Current mobile network code
+	MobileNetworkCode string `json:"mobile_network_code"`
+}
+
+func (req ReportAuthenticationCodeMissingRequest) GetFunctionName() string {
+	return "reportAuthenticationCodeMissing"
+}
+
+func (req *ReportAuthenticationCodeMissingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportAuthenticationCodeMissingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportAuthenticationCodeMissing"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"mobile_network_code":`)
+	encoder.writeString(req.MobileNetworkCode)
+	encoder.writeRaw("}")
+}
+
+func (req ReportAuthenticationCodeMissingRequest) Validate() error {
+	return nil
+}
+
+// Reports that authentication code wasn't delivered via SMS; for official mobile applications only. Works only when the current authorization state is authorizationStateWaitCode
+func (client *Client) ReportAuthenticationCodeMissing(ctx context.Context, req *ReportAuthenticationCodeMissingRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckAuthenticationBotTokenRequest struct {
+	request
+	// The bot token
+	Token string `json:"token"`
+}
+
+func (req CheckAuthenticationBotTokenRequest) GetFunctionName() string {
+	return "checkAuthenticationBotToken"
+}
+
+func (req *CheckAuthenticationBotTokenRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckAuthenticationBotTokenRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkAuthenticationBotToken"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(req.Token)
+	encoder.writeRaw("}")
+}
+
+func (req CheckAuthenticationBotTokenRequest) Validate() error {
+	return nil
+}
+
+// Checks the authentication token of a bot; to log in as a bot. Works only when the current authorization state is authorizationStateWaitPhoneNumber. Can be used instead of setAuthenticationPhoneNumber and checkAuthenticationCode to log in
+func (client *Client) CheckAuthenticationBotToken(ctx context.Context, req *CheckAuthenticationBotTokenRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ConfirmQrCodeAuthenticationRequest struct {
+	request
+	// A link from a QR code. The link must be scanned by the in-app camera
+	Link string `json:"link"`
+}
+
+func (req ConfirmQrCodeAuthenticationRequest) GetFunctionName() string {
+	return "confirmQrCodeAuthentication"
+}
+
+func (req *ConfirmQrCodeAuthenticationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ConfirmQrCodeAuthenticationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"confirmQrCodeAuthentication"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(req.Link)
+	encoder.writeRaw("}")
+}
+
+func (req ConfirmQrCodeAuthenticationRequest) Validate() error {
+	return nil
+}
+
+// Confirms QR code authentication on another device. Returns created session on success
+func (client *Client) ConfirmQrCodeAuthentication(ctx context.Context, req *ConfirmQrCodeAuthenticationRequest) (*Session, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSession)
+}
+
+type GetPasswordStateRequest struct {
+	request
+}
+
+func (req GetPasswordStateRequest) GetFunctionName() string {
+	return "getPasswordState"
+}
+
+func (req *GetPasswordStateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPasswordStateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPasswordState"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetPasswordStateRequest) Validate() error {
+	return nil
+}
+
+// Returns the current state of 2-step verification
+//
+// Available to users only
+func (client *Client) GetPasswordState(ctx context.Context) (*PasswordState, error) {
+	req := &GetPasswordStateRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPasswordState)
+}
+
+type SetPasswordRequest struct {
+	request
+	// Previous 2-step verification password of the user
+	OldPassword string `json:"old_password"`
+	// New 2-step verification password of the user; may be empty to remove the password
+	NewPassword string `json:"new_password"`
+	// New password hint; may be empty
+	NewHint string `json:"new_hint"`
+	// Pass true to change also the recovery email address
+	SetRecoveryEmailAddress bool `json:"set_recovery_email_address"`
+	// New recovery email address; may be empty
+	NewRecoveryEmailAddress string `json:"new_recovery_email_address"`
+}
+
+func (req SetPasswordRequest) GetFunctionName() string {
+	return "setPassword"
+}
+
+func (req *SetPasswordRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPasswordRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPassword"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"old_password":`)
+	encoder.writeString(req.OldPassword)
+	encoder.writeRaw(`,"new_password":`)
+	encoder.writeString(req.NewPassword)
+	encoder.writeRaw(`,"new_hint":`)
+	encoder.writeString(req.NewHint)
+	encoder.writeRaw(`,"set_recovery_email_address":`)
+	encoder.writeBool(req.SetRecoveryEmailAddress)
+	encoder.writeRaw(`,"new_recovery_email_address":`)
+	encoder.writeString(req.NewRecoveryEmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (req SetPasswordRequest) Validate() error {
+	return nil
+}
+
+// Changes the 2-step verification password for the current user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed
+//
+// Available to users only
+func (client *Client) SetPassword(ctx context.Context, req *SetPasswordRequest) (*PasswordState, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPasswordState)
+}
+
+type SetLoginEmailAddressRequest struct {
+	request
+	// New login email address
+	NewLoginEmailAddress string `json:"new_login_email_address"`
+}
+
+func (req SetLoginEmailAddressRequest) GetFunctionName() string {
+	return "setLoginEmailAddress"
+}
+
+func (req *SetLoginEmailAddressRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetLoginEmailAddressRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setLoginEmailAddress"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"new_login_email_address":`)
+	encoder.writeString(req.NewLoginEmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (req SetLoginEmailAddressRequest) Validate() error {
+	return nil
+}
+
+// Changes the login email address of the user. The email address can be changed only if the current user already has login email and passwordState.login_email_address_pattern is non-empty. The change will not be applied until the new login email address is confirmed with checkLoginEmailAddressCode. To use Apple ID/Google ID instead of an email address, call checkLoginEmailAddressCode directly
+//
+// Available to users only
+func (client *Client) SetLoginEmailAddress(ctx context.Context, req *SetLoginEmailAddressRequest) (*EmailAddressAuthenticationCodeInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmailAddressAuthenticationCodeInfo)
+}
+
+type ResendLoginEmailAddressCodeRequest struct {
+	request
+}
+
+func (req ResendLoginEmailAddressCodeRequest) GetFunctionName() string {
+	return "resendLoginEmailAddressCode"
+}
+
+func (req *ResendLoginEmailAddressCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResendLoginEmailAddressCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendLoginEmailAddressCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResendLoginEmailAddressCodeRequest) Validate() error {
+	return nil
+}
+
+// Resends the login email address verification code
+//
+// Available to users only
+func (client *Client) ResendLoginEmailAddressCode(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	req := &ResendLoginEmailAddressCodeRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmailAddressAuthenticationCodeInfo)
+}
+
+type CheckLoginEmailAddressCodeRequest struct {
+	request
+	// Email address authentication to check
+	Code EmailAddressAuthentication `json:"code"`
+}
+
+func (req CheckLoginEmailAddressCodeRequest) GetFunctionName() string {
+	return "checkLoginEmailAddressCode"
+}
+
+func (req *CheckLoginEmailAddressCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckLoginEmailAddressCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkLoginEmailAddressCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeType(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req CheckLoginEmailAddressCodeRequest) Validate() error {
+	return nil
+}
+
+// Checks the login email address authentication
+//
+// Available to users only
+func (client *Client) CheckLoginEmailAddressCode(ctx context.Context, req *CheckLoginEmailAddressCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RequestPasswordRecoveryRequest struct {
+	request
+}
+
+func (req RequestPasswordRecoveryRequest) GetFunctionName() string {
+	return "requestPasswordRecovery"
+}
+
+func (req *RequestPasswordRecoveryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RequestPasswordRecoveryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"requestPasswordRecovery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req RequestPasswordRecoveryRequest) Validate() error {
+	return nil
+}
+
+// Requests to send a 2-step verification password recovery code to an email address that was previously set up
+//
+// Available to users only
+func (client *Client) RequestPasswordRecovery(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	req := &RequestPasswordRecoveryRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmailAddressAuthenticationCodeInfo)
+}
+
+type CheckPasswordRecoveryCodeRequest struct {
+	request
+	// Recovery code to check
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (req CheckPasswordRecoveryCodeRequest) GetFunctionName() string {
+	return "checkPasswordRecoveryCode"
+}
+
+func (req *CheckPasswordRecoveryCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckPasswordRecoveryCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkPasswordRecoveryCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"recovery_code":`)
+	encoder.writeString(req.RecoveryCode)
+	encoder.writeRaw("}")
+}
+
+func (req CheckPasswordRecoveryCodeRequest) Validate() error {
+	return nil
+}
+
+// Checks whether a 2-step verification password recovery code sent to an email address is valid
+//
+// Available to users only
+func (client *Client) CheckPasswordRecoveryCode(ctx context.Context, req *CheckPasswordRecoveryCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RecoverPasswordRequest struct {
+	request
+	// Recovery code to check
+	RecoveryCode string `json:"recovery_code"`
+	// New 2-step verification password of the user; may be empty to remove the password
+	NewPassword string `json:"new_password"`
+	// New password hint; may be empty
+	NewHint string `json:"new_hint"`
+}
+
+func (req RecoverPasswordRequest) GetFunctionName() string {
+	return "recoverPassword"
+}
+
+func (req *RecoverPasswordRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RecoverPasswordRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"recoverPassword"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"recovery_code":`)
+	encoder.writeString(req.RecoveryCode)
+	encoder.writeRaw(`,"new_password":`)
+	encoder.writeString(req.NewPassword)
+	encoder.writeRaw(`,"new_hint":`)
+	encoder.writeString(req.NewHint)
+	encoder.writeRaw("}")
+}
+
+func (req RecoverPasswordRequest) Validate() error {
+	return nil
+}
+
+// Recovers the 2-step verification password using a recovery code sent to an email address that was previously set up
+//
+// Available to users only
+func (client *Client) RecoverPassword(ctx context.Context, req *RecoverPasswordRequest) (*PasswordState, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPasswordState)
+}
+
+type ResetPasswordRequest struct {
+	request
+}
+
+func (req ResetPasswordRequest) GetFunctionName() string {
+	return "resetPassword"
+}
+
+func (req *ResetPasswordRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResetPasswordRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetPassword"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResetPasswordRequest) Validate() error {
+	return nil
+}
+
+// Removes 2-step verification password without previous password and access to recovery email address. The password can't be reset immediately and the request needs to be repeated after the specified time
+//
+// Available to users only
+func (client *Client) ResetPassword(ctx context.Context) (ResetPasswordResult, error) {
+	req := &ResetPasswordRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalResetPasswordResult)
+}
+
+type CancelPasswordResetRequest struct {
+	request
+}
+
+func (req CancelPasswordResetRequest) GetFunctionName() string {
+	return "cancelPasswordReset"
+}
+
+func (req *CancelPasswordResetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CancelPasswordResetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"cancelPasswordReset"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req CancelPasswordResetRequest) Validate() error {
+	return nil
+}
+
+// Cancels reset of 2-step verification password. The method can be called if passwordState.pending_reset_date > 0
+//
+// Available to users only
+func (client *Client) CancelPasswordReset(ctx context.Context) (*Ok, error) {
+	req := &CancelPasswordResetRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CreateTemporaryPasswordRequest struct {
+	request
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+	// Time during which the temporary password will be valid, in seconds; must be between 60 and 86400
+	ValidFor int32 `json:"valid_for"`
+}
+
+func (req CreateTemporaryPasswordRequest) GetFunctionName() string {
+	return "createTemporaryPassword"
+}
+
+func (req *CreateTemporaryPasswordRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateTemporaryPasswordRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createTemporaryPassword"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw(`,"valid_for":`)
+	encoder.writeInt32(req.ValidFor)
+	encoder.writeRaw("}")
+}
+
+func (req CreateTemporaryPasswordRequest) Validate() error {
+	if err := validateMin("valid_for", int64(req.ValidFor), 60); err != nil {
+		return err
+	}
+	if err := validateMax("valid_for", int64(req.ValidFor), 86400); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a new temporary password for processing payments
+//
+// Available to users only
+func (client *Client) CreateTemporaryPassword(ctx context.Context, req *CreateTemporaryPasswordRequest) (*TemporaryPasswordState, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTemporaryPasswordState)
+}
+
+type GetTemporaryPasswordStateRequest struct {
+	request
+}
+
+func (req GetTemporaryPasswordStateRequest) GetFunctionName() string {
+	return "getTemporaryPasswordState"
+}
+
+func (req *GetTemporaryPasswordStateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetTemporaryPasswordStateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getTemporaryPasswordState"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetTemporaryPasswordStateRequest) Validate() error {
+	return nil
+}
+
+// Returns information about the current temporary password
+//
+// Available to users only
+func (client *Client) GetTemporaryPasswordState(ctx context.Context) (*TemporaryPasswordState, error) {
+	req := &GetTemporaryPasswordStateRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTemporaryPasswordState)
+}
+
+type GetLoginUrlInfoRequest struct {
+	request
+	// Chat identifier of the message with the button
+	ChatId int64 `json:"chat_id"`
+	// Message identifier of the message with the button. The message must not be scheduled
+	MessageId int64 `json:"message_id"`
+	// Button identifier
+	ButtonId int64 `json:"button_id"`
+}
+
+func (req GetLoginUrlInfoRequest) GetFunctionName() string {
+	return "getLoginUrlInfo"
+}
+
+func (req *GetLoginUrlInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLoginUrlInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLoginUrlInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"button_id":`)
+	encoder.writeInt64(req.ButtonId)
+	encoder.writeRaw("}")
+}
+
+func (req GetLoginUrlInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a button of type inlineKeyboardButtonTypeLoginUrl. The method needs to be called when the user presses the button
+//
+// Available to users only
+func (client *Client) GetLoginUrlInfo(ctx context.Context, req *GetLoginUrlInfoRequest) (LoginUrlInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLoginUrlInfo)
+}
+
+type GetLoginUrlRequest struct {
+	request
+	// Chat identifier of the message with the button
+	ChatId int64 `json:"chat_id"`
+	// Message identifier of the message with the button
+	MessageId int64 `json:"message_id"`
+	// Button identifier
+	ButtonId int64 `json:"button_id"`
+	// Pass true to allow the bot to send messages to the current user
+	AllowWriteAccess bool `json:"allow_write_access"`
+}
+
+func (req GetLoginUrlRequest) GetFunctionName() string {
+	return "getLoginUrl"
+}
+
+func (req *GetLoginUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLoginUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLoginUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"button_id":`)
+	encoder.writeInt64(req.ButtonId)
+	encoder.writeRaw(`,"allow_write_access":`)
+	encoder.writeBool(req.AllowWriteAccess)
+	encoder.writeRaw("}")
+}
+
+func (req GetLoginUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTP URL which can be used to automatically authorize the user on a website after clicking an inline button of type inlineKeyboardButtonTypeLoginUrl. Use the method getLoginUrlInfo to find whether a prior user confirmation is needed. If an error is returned, then the button must be handled as an ordinary URL button
+//
+// Available to users only
+func (client *Client) GetLoginUrl(ctx context.Context, req *GetLoginUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type GetActiveSessionsRequest struct {
+	request
+}
+
+func (req GetActiveSessionsRequest) GetFunctionName() string {
+	return "getActiveSessions"
+}
+
+func (req *GetActiveSessionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetActiveSessionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getActiveSessions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetActiveSessionsRequest) Validate() error {
+	return nil
+}
+
+// Returns all active sessions of the current user
+//
+// Available to users only
+func (client *Client) GetActiveSessions(ctx context.Context) (*Sessions, error) {
+	req := &GetActiveSessionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSessions)
+}
+
+type TerminateSessionRequest struct {
+	request
+	// Session identifier
+	SessionId JsonInt64 `json:"session_id"`
+}
+
+func (req TerminateSessionRequest) GetFunctionName() string {
+	return "terminateSession"
+}
+
+func (req *TerminateSessionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TerminateSessionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"terminateSession"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"session_id":`)
+	encoder.writeJsonInt64(req.SessionId)
+	encoder.writeRaw("}")
+}
+
+func (req TerminateSessionRequest) Validate() error {
+	return nil
+}
+
+// Terminates a session of the current user
+//
+// Available to users only
+func (client *Client) TerminateSession(ctx context.Context, req *TerminateSessionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type TerminateAllOtherSessionsRequest struct {
+	request
+}
+
+func (req TerminateAllOtherSessionsRequest) GetFunctionName() string {
+	return "terminateAllOtherSessions"
+}
+
+func (req *TerminateAllOtherSessionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TerminateAllOtherSessionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"terminateAllOtherSessions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req TerminateAllOtherSessionsRequest) Validate() error {
+	return nil
+}
+
+// Terminates all other sessions of the current user
+//
+// Available to users only
+func (client *Client) TerminateAllOtherSessions(ctx context.Context) (*Ok, error) {
+	req := &TerminateAllOtherSessionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ConfirmSessionRequest struct {
+	request
+	// Session identifier
+	SessionId JsonInt64 `json:"session_id"`
+}
+
+func (req ConfirmSessionRequest) GetFunctionName() string {
+	return "confirmSession"
+}
+
+func (req *ConfirmSessionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ConfirmSessionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"confirmSession"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"session_id":`)
+	encoder.writeJsonInt64(req.SessionId)
+	encoder.writeRaw("}")
+}
+
+func (req ConfirmSessionRequest) Validate() error {
+	return nil
+}
+
+// Confirms an unconfirmed session of the current user from another device
+//
+// Available to users only
+func (client *Client) ConfirmSession(ctx context.Context, req *ConfirmSessionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSessionCanAcceptCallsRequest struct {
+	request
+	// Session identifier
+	SessionId JsonInt64 `json:"session_id"`
+	// Pass true to allow accepting incoming calls by the session; pass false otherwise
+	CanAcceptCalls bool `json:"can_accept_calls"`
+}
+
+func (req ToggleSessionCanAcceptCallsRequest) GetFunctionName() string {
+	return "toggleSessionCanAcceptCalls"
+}
+
+func (req *ToggleSessionCanAcceptCallsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSessionCanAcceptCallsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSessionCanAcceptCalls"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"session_id":`)
+	encoder.writeJsonInt64(req.SessionId)
+	encoder.writeRaw(`,"can_accept_calls":`)
+	encoder.writeBool(req.CanAcceptCalls)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSessionCanAcceptCallsRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a session can accept incoming calls
+//
+// Available to users only
+func (client *Client) ToggleSessionCanAcceptCalls(ctx context.Context, req *ToggleSessionCanAcceptCallsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSessionCanAcceptSecretChatsRequest struct {
+	request
+	// Session identifier
+	SessionId JsonInt64 `json:"session_id"`
+	// Pass true to allow accepting secret chats by the session; pass false otherwise
+	CanAcceptSecretChats bool `json:"can_accept_secret_chats"`
+}
+
+func (req ToggleSessionCanAcceptSecretChatsRequest) GetFunctionName() string {
+	return "toggleSessionCanAcceptSecretChats"
+}
+
+func (req *ToggleSessionCanAcceptSecretChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSessionCanAcceptSecretChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSessionCanAcceptSecretChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"session_id":`)
+	encoder.writeJsonInt64(req.SessionId)
+	encoder.writeRaw(`,"can_accept_secret_chats":`)
+	encoder.writeBool(req.CanAcceptSecretChats)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSessionCanAcceptSecretChatsRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a session can accept incoming secret chats
+//
+// Available to users only
+func (client *Client) ToggleSessionCanAcceptSecretChats(ctx context.Context, req *ToggleSessionCanAcceptSecretChatsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetInactiveSessionTtlRequest struct {
+	request
+	// New number of days of inactivity before sessions will be automatically terminated; 1-366 days
+	InactiveSessionTtlDays int32 `json:"inactive_session_ttl_days"`
+}
+
+func (req SetInactiveSessionTtlRequest) GetFunctionName() string {
+	return "setInactiveSessionTtl"
+}
+
+func (req *SetInactiveSessionTtlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetInactiveSessionTtlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setInactiveSessionTtl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inactive_session_ttl_days":`)
+	encoder.writeInt32(req.InactiveSessionTtlDays)
+	encoder.writeRaw("}")
+}
+
+func (req SetInactiveSessionTtlRequest) Validate() error {
+	return nil
+}
+
+// Changes the period of inactivity after which sessions will automatically be terminated
+//
+// Available to users only
+func (client *Client) SetInactiveSessionTtl(ctx context.Context, req *SetInactiveSessionTtlRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetPassportElementRequest struct {
+	request
+	// Telegram Passport element type
+	Type PassportElementType `json:"type"`
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req GetPassportElementRequest) GetFunctionName() string {
+	return "getPassportElement"
+}
+
+func (req *GetPassportElementRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPassportElementRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPassportElement"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req GetPassportElementRequest) Validate() error {
+	return nil
+}
+
+// Returns one of the available Telegram Passport elements
+//
+// Available to users only
+func (client *Client) GetPassportElement(ctx context.Context, req *GetPassportElementRequest) (PassportElement, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPassportElement)
+}
+
+type GetAllPassportElementsRequest struct {
+	request
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req GetAllPassportElementsRequest) GetFunctionName() string {
+	return "getAllPassportElements"
+}
+
+func (req *GetAllPassportElementsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAllPassportElementsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAllPassportElements"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req GetAllPassportElementsRequest) Validate() error {
+	return nil
+}
+
+// Returns all available Telegram Passport elements
+//
+// Available to users only
+func (client *Client) GetAllPassportElements(ctx context.Context, req *GetAllPassportElementsRequest) (*PassportElements, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPassportElements)
+}
+
+type SetPassportElementRequest struct {
+	request
+	// Input Telegram Passport element
+	Element InputPassportElement `json:"element"`
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req SetPassportElementRequest) GetFunctionName() string {
+	return "setPassportElement"
+}
+
+func (req *SetPassportElementRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPassportElementRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPassportElement"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"element":`)
+	encoder.writeType(req.Element)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req SetPassportElementRequest) Validate() error {
+	if err := validateNested("element", req.Element); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Adds an element to the user's Telegram Passport. May return an error with a message "PHONE_VERIFICATION_NEEDED" or "EMAIL_VERIFICATION_NEEDED" if the chosen phone number or the chosen email address must be verified first
+//
+// Available to users only
+func (client *Client) SetPassportElement(ctx context.Context, req *SetPassportElementRequest) (PassportElement, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPassportElement)
+}
+
+type DeletePassportElementRequest struct {
+	request
+	// Element type
+	Type PassportElementType `json:"type"`
+}
+
+func (req DeletePassportElementRequest) GetFunctionName() string {
+	return "deletePassportElement"
+}
+
+func (req *DeletePassportElementRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeletePassportElementRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deletePassportElement"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req DeletePassportElementRequest) Validate() error {
+	return nil
+}
+
+// Deletes a Telegram Passport element
+//
+// Available to users only
+func (client *Client) DeletePassportElement(ctx context.Context, req *DeletePassportElementRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetPassportElementErrorsRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// The errors
+	Errors []*InputPassportElementError `json:"errors"`
+}
+
+func (req SetPassportElementErrorsRequest) GetFunctionName() string {
+	return "setPassportElementErrors"
+}
+
+func (req *SetPassportElementErrorsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPassportElementErrorsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPassportElementErrors"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"errors":`)
+	writeList(encoder, req.Errors, writeTypeValue[*InputPassportElementError])
+	encoder.writeRaw("}")
+}
+
+func (req SetPassportElementErrorsRequest) Validate() error {
+	return nil
+}
+
+// Informs the user that some of the elements in their Telegram Passport contain errors; for bots only. The user will not be able to resend the elements, until the errors are fixed
+//
+// Available to bots only
+func (client *Client) SetPassportElementErrors(ctx context.Context, req *SetPassportElementErrorsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetPassportAuthorizationFormRequest struct {
+	request
+	// User identifier of the service's bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Telegram Passport element types requested by the service
+	Scope string `json:"scope"`
+	// Service's public key
+	PublicKey string `json:"public_key"`
+	// Unique request identifier provided by the service
+	Nonce string `json:"nonce"`
+}
+
+func (req GetPassportAuthorizationFormRequest) GetFunctionName() string {
+	return "getPassportAuthorizationForm"
+}
+
+func (req *GetPassportAuthorizationFormRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPassportAuthorizationFormRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPassportAuthorizationForm"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"scope":`)
+	encoder.writeString(req.Scope)
+	encoder.writeRaw(`,"public_key":`)
+	encoder.writeString(req.PublicKey)
+	encoder.writeRaw(`,"nonce":`)
+	encoder.writeString(req.Nonce)
+	encoder.writeRaw("}")
+}
+
+func (req GetPassportAuthorizationFormRequest) Validate() error {
+	return nil
+}
+
+// Returns a Telegram Passport authorization form for sharing data with a service
+//
+// Available to users only
+func (client *Client) GetPassportAuthorizationForm(ctx context.Context, req *GetPassportAuthorizationFormRequest) (*PassportAuthorizationForm, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPassportAuthorizationForm)
+}
+
+type GetPassportAuthorizationFormAvailableElementsRequest struct {
+	request
+	// Authorization form identifier
+	AuthorizationFormId int32 `json:"authorization_form_id"`
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req GetPassportAuthorizationFormAvailableElementsRequest) GetFunctionName() string {
+	return "getPassportAuthorizationFormAvailableElements"
+}
+
+func (req *GetPassportAuthorizationFormAvailableElementsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPassportAuthorizationFormAvailableElementsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPassportAuthorizationFormAvailableElements"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"authorization_form_id":`)
+	encoder.writeInt32(req.AuthorizationFormId)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req GetPassportAuthorizationFormAvailableElementsRequest) Validate() error {
+	return nil
+}
+
+// Returns already available Telegram Passport elements suitable for completing a Telegram Passport authorization form. Result can be received only once for each authorization form
+//
+// Available to users only
+func (client *Client) GetPassportAuthorizationFormAvailableElements(ctx context.Context, req *GetPassportAuthorizationFormAvailableElementsRequest) (*PassportElementsWithErrors, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPassportElementsWithErrors)
+}
+
+type SendPassportAuthorizationFormRequest struct {
+	request
+	// Authorization form identifier
+	AuthorizationFormId int32 `json:"authorization_form_id"`
+	// Types of Telegram Passport elements chosen by user to complete the authorization form
+	Types []PassportElementType `json:"types"`
+}
+
+func (req SendPassportAuthorizationFormRequest) GetFunctionName() string {
+	return "sendPassportAuthorizationForm"
+}
+
+func (req *SendPassportAuthorizationFormRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendPassportAuthorizationFormRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendPassportAuthorizationForm"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"authorization_form_id":`)
+	encoder.writeInt32(req.AuthorizationFormId)
+	encoder.writeRaw(`,"types":`)
+	writeList(encoder, req.Types, writeTypeValue[PassportElementType])
+	encoder.writeRaw("}")
+}
+
+func (req SendPassportAuthorizationFormRequest) Validate() error {
+	return nil
+}
+
+// Sends a Telegram Passport authorization form, effectively sharing data with the service. This method must be called after getPassportAuthorizationFormAvailableElements if some previously available elements are going to be reused
+//
+// Available to users only
+func (client *Client) SendPassportAuthorizationForm(ctx context.Context, req *SendPassportAuthorizationFormRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
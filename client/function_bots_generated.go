@@ -0,0 +1,2298 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type GetBotSimilarBotsRequest struct {
+	request
+	// User identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req GetBotSimilarBotsRequest) GetFunctionName() string {
+	return "getBotSimilarBots"
+}
+
+func (req *GetBotSimilarBotsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBotSimilarBotsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBotSimilarBots"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetBotSimilarBotsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of bots similar to the given bot
+//
+// Available to users only
+func (client *Client) GetBotSimilarBots(ctx context.Context, req *GetBotSimilarBotsRequest) (*Users, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUsers)
+}
+
+type GetBotSimilarBotCountRequest struct {
+	request
+	// User identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Pass true to get the number of bots without sending network requests, or -1 if the number of bots is unknown locally
+	ReturnLocal bool `json:"return_local"`
+}
+
+func (req GetBotSimilarBotCountRequest) GetFunctionName() string {
+	return "getBotSimilarBotCount"
+}
+
+func (req *GetBotSimilarBotCountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBotSimilarBotCountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBotSimilarBotCount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"return_local":`)
+	encoder.writeBool(req.ReturnLocal)
+	encoder.writeRaw("}")
+}
+
+func (req GetBotSimilarBotCountRequest) Validate() error {
+	return nil
+}
+
+// Returns approximate number of bots similar to the given bot
+//
+// Available to users only
+func (client *Client) GetBotSimilarBotCount(ctx context.Context, req *GetBotSimilarBotCountRequest) (*Count, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCount)
+}
+
+type OpenBotSimilarBotRequest struct {
+	request
+	// Identifier of the original bot, which similar bots were requested
+	BotUserId int64 `json:"bot_user_id"`
+	// Identifier of the opened bot
+	OpenedBotUserId int64 `json:"opened_bot_user_id"`
+}
+
+func (req OpenBotSimilarBotRequest) GetFunctionName() string {
+	return "openBotSimilarBot"
+}
+
+func (req *OpenBotSimilarBotRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OpenBotSimilarBotRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"openBotSimilarBot"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"opened_bot_user_id":`)
+	encoder.writeInt64(req.OpenedBotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req OpenBotSimilarBotRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that a bot was opened from the list of similar bots
+//
+// Available to users only
+func (client *Client) OpenBotSimilarBot(ctx context.Context, req *OpenBotSimilarBotRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetInlineQueryResultsRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Identifier of the chat where the query was sent
+	ChatId int64 `json:"chat_id"`
+	// Location of the user; pass null if unknown or the bot doesn't need user's location
+	UserLocation *Location `json:"user_location,omitempty"`
+	// Text of the query
+	Query string `json:"query"`
+	// Offset of the first entry to return; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+}
+
+func (req GetInlineQueryResultsRequest) GetFunctionName() string {
+	return "getInlineQueryResults"
+}
+
+func (req *GetInlineQueryResultsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetInlineQueryResultsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getInlineQueryResults"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	if req.UserLocation != nil {
+		encoder.writeRaw(`,"user_location":`)
+		req.UserLocation.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw("}")
+}
+
+func (req GetInlineQueryResultsRequest) Validate() error {
+	return nil
+}
+
+// Sends an inline query to a bot and returns its results. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+//
+// Available to users only
+func (client *Client) GetInlineQueryResults(ctx context.Context, req *GetInlineQueryResultsRequest) (*InlineQueryResults, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalInlineQueryResults)
+}
+
+type AnswerInlineQueryRequest struct {
+	request
+	// Identifier of the inline query
+	InlineQueryId JsonInt64 `json:"inline_query_id"`
+	// Pass true if results may be cached and returned only for the user that sent the query. By default, results may be returned to any user who sends the same query
+	IsPersonal bool `json:"is_personal"`
+	// Button to be shown above inline query results; pass null if none
+	Button *InlineQueryResultsButton `json:"button,omitempty"`
+	// The results of the query
+	Results []InputInlineQueryResult `json:"results"`
+	// Allowed time to cache the results of the query, in seconds
+	CacheTime int32 `json:"cache_time"`
+	// Offset for the next inline query; pass an empty string if there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (req AnswerInlineQueryRequest) GetFunctionName() string {
+	return "answerInlineQuery"
+}
+
+func (req *AnswerInlineQueryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AnswerInlineQueryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"answerInlineQuery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_query_id":`)
+	encoder.writeJsonInt64(req.InlineQueryId)
+	encoder.writeRaw(`,"is_personal":`)
+	encoder.writeBool(req.IsPersonal)
+	if req.Button != nil {
+		encoder.writeRaw(`,"button":`)
+		req.Button.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"results":`)
+	writeList(encoder, req.Results, writeTypeValue[InputInlineQueryResult])
+	encoder.writeRaw(`,"cache_time":`)
+	encoder.writeInt32(req.CacheTime)
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(req.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (req AnswerInlineQueryRequest) Validate() error {
+	for i, item := range req.Results {
+		if err := validateNested(indexField("results", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sets the result of an inline query; for bots only
+//
+// Available to bots only
+func (client *Client) AnswerInlineQuery(ctx context.Context, req *AnswerInlineQueryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetGrossingWebAppBotsRequest struct {
+	request
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of bots to be returned; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetGrossingWebAppBotsRequest) GetFunctionName() string {
+	return "getGrossingWebAppBots"
+}
+
+func (req *GetGrossingWebAppBotsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGrossingWebAppBotsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGrossingWebAppBots"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetGrossingWebAppBotsRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the most grossing Web App bots
+//
+// Available to users only
+func (client *Client) GetGrossingWebAppBots(ctx context.Context, req *GetGrossingWebAppBotsRequest) (*FoundUsers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundUsers)
+}
+
+type SearchWebAppRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Short name of the Web App
+	WebAppShortName string `json:"web_app_short_name"`
+}
+
+func (req SearchWebAppRequest) GetFunctionName() string {
+	return "searchWebApp"
+}
+
+func (req *SearchWebAppRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchWebAppRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchWebApp"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"web_app_short_name":`)
+	encoder.writeString(req.WebAppShortName)
+	encoder.writeRaw("}")
+}
+
+func (req SearchWebAppRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a Web App by its short name. Returns a 404 error if the Web App is not found
+//
+// Available to users only
+func (client *Client) SearchWebApp(ctx context.Context, req *SearchWebAppRequest) (*FoundWebApp, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundWebApp)
+}
+
+type GetWebAppPlaceholderRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req GetWebAppPlaceholderRequest) GetFunctionName() string {
+	return "getWebAppPlaceholder"
+}
+
+func (req *GetWebAppPlaceholderRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetWebAppPlaceholderRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getWebAppPlaceholder"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetWebAppPlaceholderRequest) Validate() error {
+	return nil
+}
+
+// Returns a default placeholder for Web Apps of a bot. This is an offline method. Returns a 404 error if the placeholder isn't known
+//
+// Available to users only
+func (client *Client) GetWebAppPlaceholder(ctx context.Context, req *GetWebAppPlaceholderRequest) (*Outline, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOutline)
+}
+
+type GetWebAppLinkUrlRequest struct {
+	request
+	// Identifier of the chat in which the link was clicked; pass 0 if none
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Short name of the Web App
+	WebAppShortName string `json:"web_app_short_name"`
+	// Start parameter from internalLinkTypeWebApp
+	StartParameter string `json:"start_parameter"`
+	// Pass true if the current user allowed the bot to send them messages
+	AllowWriteAccess bool `json:"allow_write_access"`
+	// Parameters to use to open the Web App
+	Parameters *WebAppOpenParameters `json:"parameters"`
+}
+
+func (req GetWebAppLinkUrlRequest) GetFunctionName() string {
+	return "getWebAppLinkUrl"
+}
+
+func (req *GetWebAppLinkUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetWebAppLinkUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getWebAppLinkUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"web_app_short_name":`)
+	encoder.writeString(req.WebAppShortName)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(req.StartParameter)
+	encoder.writeRaw(`,"allow_write_access":`)
+	encoder.writeBool(req.AllowWriteAccess)
+	encoder.writeRaw(`,"parameters":`)
+	req.Parameters.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetWebAppLinkUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS URL of a Web App to open after a link of the type internalLinkTypeWebApp is clicked
+//
+// Available to users only
+func (client *Client) GetWebAppLinkUrl(ctx context.Context, req *GetWebAppLinkUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type GetMainWebAppRequest struct {
+	request
+	// Identifier of the chat in which the Web App is opened; pass 0 if none
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the target bot. If the bot is restricted for the current user, then show an error instead of calling the method
+	BotUserId int64 `json:"bot_user_id"`
+	// Start parameter from internalLinkTypeMainWebApp
+	StartParameter string `json:"start_parameter"`
+	// Parameters to use to open the Web App
+	Parameters *WebAppOpenParameters `json:"parameters"`
+}
+
+func (req GetMainWebAppRequest) GetFunctionName() string {
+	return "getMainWebApp"
+}
+
+func (req *GetMainWebAppRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMainWebAppRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMainWebApp"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(req.StartParameter)
+	encoder.writeRaw(`,"parameters":`)
+	req.Parameters.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetMainWebAppRequest) Validate() error {
+	return nil
+}
+
+// Returns information needed to open the main Web App of a bot
+//
+// Available to users only
+func (client *Client) GetMainWebApp(ctx context.Context, req *GetMainWebAppRequest) (*MainWebApp, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMainWebApp)
+}
+
+type GetWebAppUrlRequest struct {
+	request
+	// Identifier of the target bot. If the bot is restricted for the current user, then show an error instead of calling the method
+	BotUserId int64 `json:"bot_user_id"`
+	// The URL from a keyboardButtonTypeWebApp button, inlineQueryResultsButtonTypeWebApp button, or an empty string when the bot is opened from the side menu
+	Url string `json:"url"`
+	// Parameters to use to open the Web App
+	Parameters *WebAppOpenParameters `json:"parameters"`
+}
+
+func (req GetWebAppUrlRequest) GetFunctionName() string {
+	return "getWebAppUrl"
+}
+
+func (req *GetWebAppUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetWebAppUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getWebAppUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw(`,"parameters":`)
+	req.Parameters.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetWebAppUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS URL of a Web App to open from the side menu, a keyboardButtonTypeWebApp button, or an inlineQueryResultsButtonTypeWebApp button
+//
+// Available to users only
+func (client *Client) GetWebAppUrl(ctx context.Context, req *GetWebAppUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type SendWebAppDataRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Text of the keyboardButtonTypeWebApp button, which opened the Web App
+	ButtonText string `json:"button_text"`
+	// The data
+	Data string `json:"data"`
+}
+
+func (req SendWebAppDataRequest) GetFunctionName() string {
+	return "sendWebAppData"
+}
+
+func (req *SendWebAppDataRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendWebAppDataRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendWebAppData"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"button_text":`)
+	encoder.writeString(req.ButtonText)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(req.Data)
+	encoder.writeRaw("}")
+}
+
+func (req SendWebAppDataRequest) Validate() error {
+	return nil
+}
+
+// Sends data received from a keyboardButtonTypeWebApp Web App to a bot
+//
+// Available to users only
+func (client *Client) SendWebAppData(ctx context.Context, req *SendWebAppDataRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type OpenWebAppRequest struct {
+	request
+	// Identifier of the chat in which the Web App is opened. The Web App can't be opened in secret chats
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the bot, providing the Web App. If the bot is restricted for the current user, then show an error instead of calling the method
+	BotUserId int64 `json:"bot_user_id"`
+	// The URL from an inlineKeyboardButtonTypeWebApp button, a botMenuButton button, an internalLinkTypeAttachmentMenuBot link, or an empty string otherwise
+	Url string `json:"url"`
+	// If not 0, the message thread identifier in which the message will be sent
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Information about the message or story to be replied in the message sent by the Web App; pass null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Parameters to use to open the Web App
+	Parameters *WebAppOpenParameters `json:"parameters"`
+}
+
+func (req OpenWebAppRequest) GetFunctionName() string {
+	return "openWebApp"
+}
+
+func (req *OpenWebAppRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OpenWebAppRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"openWebApp"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	if req.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(req.ReplyTo)
+	}
+	encoder.writeRaw(`,"parameters":`)
+	req.Parameters.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req OpenWebAppRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that a Web App is being opened from the attachment menu, a botMenuButton button, an internalLinkTypeAttachmentMenuBot link, or an inlineKeyboardButtonTypeWebApp button. For each bot, a confirmation alert about data sent to the bot must be shown once
+//
+// Available to users only
+func (client *Client) OpenWebApp(ctx context.Context, req *OpenWebAppRequest) (*WebAppInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalWebAppInfo)
+}
+
+type CloseWebAppRequest struct {
+	request
+	// Identifier of Web App launch, received from openWebApp
+	WebAppLaunchId JsonInt64 `json:"web_app_launch_id"`
+}
+
+func (req CloseWebAppRequest) GetFunctionName() string {
+	return "closeWebApp"
+}
+
+func (req *CloseWebAppRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CloseWebAppRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"closeWebApp"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"web_app_launch_id":`)
+	encoder.writeJsonInt64(req.WebAppLaunchId)
+	encoder.writeRaw("}")
+}
+
+func (req CloseWebAppRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that a previously opened Web App was closed
+//
+// Available to users only
+func (client *Client) CloseWebApp(ctx context.Context, req *CloseWebAppRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AnswerWebAppQueryRequest struct {
+	request
+	// Identifier of the Web App query
+	WebAppQueryId string `json:"web_app_query_id"`
+	// The result of the query
+	Result InputInlineQueryResult `json:"result"`
+}
+
+func (req AnswerWebAppQueryRequest) GetFunctionName() string {
+	return "answerWebAppQuery"
+}
+
+func (req *AnswerWebAppQueryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AnswerWebAppQueryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"answerWebAppQuery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"web_app_query_id":`)
+	encoder.writeString(req.WebAppQueryId)
+	encoder.writeRaw(`,"result":`)
+	encoder.writeType(req.Result)
+	encoder.writeRaw("}")
+}
+
+func (req AnswerWebAppQueryRequest) Validate() error {
+	if err := validateNested("result", req.Result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sets the result of interaction with a Web App and sends corresponding message on behalf of the user to the chat from which the query originated; for bots only
+//
+// Available to bots only
+func (client *Client) AnswerWebAppQuery(ctx context.Context, req *AnswerWebAppQueryRequest) (*SentWebAppMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSentWebAppMessage)
+}
+
+type CheckWebAppFileDownloadRequest struct {
+	request
+	// Identifier of the bot, providing the Web App
+	BotUserId int64 `json:"bot_user_id"`
+	// Name of the file
+	FileName string `json:"file_name"`
+	// URL of the file
+	Url string `json:"url"`
+}
+
+func (req CheckWebAppFileDownloadRequest) GetFunctionName() string {
+	return "checkWebAppFileDownload"
+}
+
+func (req *CheckWebAppFileDownloadRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckWebAppFileDownloadRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkWebAppFileDownload"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"file_name":`)
+	encoder.writeString(req.FileName)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw("}")
+}
+
+func (req CheckWebAppFileDownloadRequest) Validate() error {
+	return nil
+}
+
+// Checks whether a file can be downloaded and saved locally by Web App request
+//
+// Available to users only
+func (client *Client) CheckWebAppFileDownload(ctx context.Context, req *CheckWebAppFileDownloadRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetCallbackQueryAnswerRequest struct {
+	request
+	// Identifier of the chat with the message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message from which the query originated. The message must not be scheduled
+	MessageId int64 `json:"message_id"`
+	// Query payload
+	Payload CallbackQueryPayload `json:"payload"`
+}
+
+func (req GetCallbackQueryAnswerRequest) GetFunctionName() string {
+	return "getCallbackQueryAnswer"
+}
+
+func (req *GetCallbackQueryAnswerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCallbackQueryAnswerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCallbackQueryAnswer"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeType(req.Payload)
+	encoder.writeRaw("}")
+}
+
+func (req GetCallbackQueryAnswerRequest) Validate() error {
+	return nil
+}
+
+// Sends a callback query to a bot and returns an answer. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+//
+// Available to users only
+func (client *Client) GetCallbackQueryAnswer(ctx context.Context, req *GetCallbackQueryAnswerRequest) (*CallbackQueryAnswer, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCallbackQueryAnswer)
+}
+
+type AnswerCallbackQueryRequest struct {
+	request
+	// Identifier of the callback query
+	CallbackQueryId JsonInt64 `json:"callback_query_id"`
+	// Text of the answer
+	Text string `json:"text"`
+	// Pass true to show an alert to the user instead of a toast notification
+	ShowAlert bool `json:"show_alert"`
+	// URL to be opened
+	Url string `json:"url"`
+	// Time during which the result of the query can be cached, in seconds
+	CacheTime int32 `json:"cache_time"`
+}
+
+func (req AnswerCallbackQueryRequest) GetFunctionName() string {
+	return "answerCallbackQuery"
+}
+
+func (req *AnswerCallbackQueryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AnswerCallbackQueryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"answerCallbackQuery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"callback_query_id":`)
+	encoder.writeJsonInt64(req.CallbackQueryId)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw(`,"show_alert":`)
+	encoder.writeBool(req.ShowAlert)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw(`,"cache_time":`)
+	encoder.writeInt32(req.CacheTime)
+	encoder.writeRaw("}")
+}
+
+func (req AnswerCallbackQueryRequest) Validate() error {
+	return nil
+}
+
+// Sets the result of a callback query; for bots only
+//
+// Available to bots only
+func (client *Client) AnswerCallbackQuery(ctx context.Context, req *AnswerCallbackQueryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetGameScoreRequest struct {
+	request
+	// The chat to which the message with the game belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Pass true to edit the game message to include the current scoreboard
+	EditMessage bool `json:"edit_message"`
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// The new score
+	Score int32 `json:"score"`
+	// Pass true to update the score even if it decreases. If the score is 0, the user will be deleted from the high score table
+	Force bool `json:"force"`
+}
+
+func (req SetGameScoreRequest) GetFunctionName() string {
+	return "setGameScore"
+}
+
+func (req *SetGameScoreRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetGameScoreRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setGameScore"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"edit_message":`)
+	encoder.writeBool(req.EditMessage)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"score":`)
+	encoder.writeInt32(req.Score)
+	encoder.writeRaw(`,"force":`)
+	encoder.writeBool(req.Force)
+	encoder.writeRaw("}")
+}
+
+func (req SetGameScoreRequest) Validate() error {
+	return nil
+}
+
+// Updates the game score of the specified user in the game; for bots only
+//
+// Available to bots only
+func (client *Client) SetGameScore(ctx context.Context, req *SetGameScoreRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type SetInlineGameScoreRequest struct {
+	request
+	// Inline message identifier
+	InlineMessageId string `json:"inline_message_id"`
+	// Pass true to edit the game message to include the current scoreboard
+	EditMessage bool `json:"edit_message"`
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// The new score
+	Score int32 `json:"score"`
+	// Pass true to update the score even if it decreases. If the score is 0, the user will be deleted from the high score table
+	Force bool `json:"force"`
+}
+
+func (req SetInlineGameScoreRequest) GetFunctionName() string {
+	return "setInlineGameScore"
+}
+
+func (req *SetInlineGameScoreRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetInlineGameScoreRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setInlineGameScore"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(req.InlineMessageId)
+	encoder.writeRaw(`,"edit_message":`)
+	encoder.writeBool(req.EditMessage)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"score":`)
+	encoder.writeInt32(req.Score)
+	encoder.writeRaw(`,"force":`)
+	encoder.writeBool(req.Force)
+	encoder.writeRaw("}")
+}
+
+func (req SetInlineGameScoreRequest) Validate() error {
+	return nil
+}
+
+// Updates the game score of the specified user in a game; for bots only
+//
+// Available to bots only
+func (client *Client) SetInlineGameScore(ctx context.Context, req *SetInlineGameScoreRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetGameHighScoresRequest struct {
+	request
+	// The chat that contains the message with the game
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetGameHighScoresRequest) GetFunctionName() string {
+	return "getGameHighScores"
+}
+
+func (req *GetGameHighScoresRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGameHighScoresRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGameHighScores"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetGameHighScoresRequest) Validate() error {
+	return nil
+}
+
+// Returns the high scores for a game and some part of the high score table in the range of the specified user; for bots only
+//
+// Available to bots only
+func (client *Client) GetGameHighScores(ctx context.Context, req *GetGameHighScoresRequest) (*GameHighScores, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGameHighScores)
+}
+
+type GetInlineGameHighScoresRequest struct {
+	request
+	// Inline message identifier
+	InlineMessageId string `json:"inline_message_id"`
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetInlineGameHighScoresRequest) GetFunctionName() string {
+	return "getInlineGameHighScores"
+}
+
+func (req *GetInlineGameHighScoresRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetInlineGameHighScoresRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getInlineGameHighScores"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(req.InlineMessageId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetInlineGameHighScoresRequest) Validate() error {
+	return nil
+}
+
+// Returns game high scores and some part of the high score table in the range of the specified user; for bots only
+//
+// Available to bots only
+func (client *Client) GetInlineGameHighScores(ctx context.Context, req *GetInlineGameHighScoresRequest) (*GameHighScores, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGameHighScores)
+}
+
+type GetAttachmentMenuBotRequest struct {
+	request
+	// Bot's user identifier
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req GetAttachmentMenuBotRequest) GetFunctionName() string {
+	return "getAttachmentMenuBot"
+}
+
+func (req *GetAttachmentMenuBotRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAttachmentMenuBotRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAttachmentMenuBot"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetAttachmentMenuBotRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a bot that can be added to attachment or side menu
+//
+// Available to users only
+func (client *Client) GetAttachmentMenuBot(ctx context.Context, req *GetAttachmentMenuBotRequest) (*AttachmentMenuBot, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAttachmentMenuBot)
+}
+
+type ToggleBotIsAddedToAttachmentMenuRequest struct {
+	request
+	// Bot's user identifier
+	BotUserId int64 `json:"bot_user_id"`
+	// Pass true to add the bot to attachment menu; pass false to remove the bot from attachment menu
+	IsAdded bool `json:"is_added"`
+	// Pass true if the current user allowed the bot to send them messages. Ignored if is_added is false
+	AllowWriteAccess bool `json:"allow_write_access"`
+}
+
+func (req ToggleBotIsAddedToAttachmentMenuRequest) GetFunctionName() string {
+	return "toggleBotIsAddedToAttachmentMenu"
+}
+
+func (req *ToggleBotIsAddedToAttachmentMenuRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleBotIsAddedToAttachmentMenuRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleBotIsAddedToAttachmentMenu"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"is_added":`)
+	encoder.writeBool(req.IsAdded)
+	encoder.writeRaw(`,"allow_write_access":`)
+	encoder.writeBool(req.AllowWriteAccess)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleBotIsAddedToAttachmentMenuRequest) Validate() error {
+	return nil
+}
+
+// Adds or removes a bot to attachment and side menu. Bot can be added to the menu, only if userTypeBot.can_be_added_to_attachment_menu == true
+//
+// Available to users only
+func (client *Client) ToggleBotIsAddedToAttachmentMenu(ctx context.Context, req *ToggleBotIsAddedToAttachmentMenuRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetRecentInlineBotsRequest struct {
+	request
+}
+
+func (req GetRecentInlineBotsRequest) GetFunctionName() string {
+	return "getRecentInlineBots"
+}
+
+func (req *GetRecentInlineBotsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecentInlineBotsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecentInlineBots"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecentInlineBotsRequest) Validate() error {
+	return nil
+}
+
+// Returns up to 20 recently used inline bots in the order of their last usage
+//
+// Available to users only
+func (client *Client) GetRecentInlineBots(ctx context.Context) (*Users, error) {
+	req := &GetRecentInlineBotsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUsers)
+}
+
+type GetOwnedBotsRequest struct {
+	request
+}
+
+func (req GetOwnedBotsRequest) GetFunctionName() string {
+	return "getOwnedBots"
+}
+
+func (req *GetOwnedBotsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetOwnedBotsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getOwnedBots"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetOwnedBotsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of bots owned by the current user
+//
+// Available to users only
+func (client *Client) GetOwnedBots(ctx context.Context) (*Users, error) {
+	req := &GetOwnedBotsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUsers)
+}
+
+type GetWebPageInstantViewRequest struct {
+	request
+	// The web page URL
+	Url string `json:"url"`
+	// Pass true to get only locally available information without sending network requests
+	OnlyLocal bool `json:"only_local"`
+}
+
+func (req GetWebPageInstantViewRequest) GetFunctionName() string {
+	return "getWebPageInstantView"
+}
+
+func (req *GetWebPageInstantViewRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetWebPageInstantViewRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getWebPageInstantView"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw(`,"only_local":`)
+	encoder.writeBool(req.OnlyLocal)
+	encoder.writeRaw("}")
+}
+
+func (req GetWebPageInstantViewRequest) Validate() error {
+	return nil
+}
+
+// Returns an instant view version of a web page if available. This is an offline method if only_local is true. Returns a 404 error if the web page has no instant view page
+//
+// Available to users only
+func (client *Client) GetWebPageInstantView(ctx context.Context, req *GetWebPageInstantViewRequest) (*WebPageInstantView, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalWebPageInstantView)
+}
+
+type SendWebAppCustomRequestRequest struct {
+	request
+	// Identifier of the bot
+	BotUserId int64 `json:"bot_user_id"`
+	// The method name
+	Method string `json:"method"`
+	// JSON-serialized method parameters
+	Parameters string `json:"parameters"`
+}
+
+func (req SendWebAppCustomRequestRequest) GetFunctionName() string {
+	return "sendWebAppCustomRequest"
+}
+
+func (req *SendWebAppCustomRequestRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendWebAppCustomRequestRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendWebAppCustomRequest"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"method":`)
+	encoder.writeString(req.Method)
+	encoder.writeRaw(`,"parameters":`)
+	encoder.writeString(req.Parameters)
+	encoder.writeRaw("}")
+}
+
+func (req SendWebAppCustomRequestRequest) Validate() error {
+	return nil
+}
+
+// Sends a custom request from a Web App
+//
+// Available to users only
+func (client *Client) SendWebAppCustomRequest(ctx context.Context, req *SendWebAppCustomRequestRequest) (*CustomRequestResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCustomRequestResult)
+}
+
+type GetBotMediaPreviewsRequest struct {
+	request
+	// Identifier of the target bot. The bot must have the main Web App
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req GetBotMediaPreviewsRequest) GetFunctionName() string {
+	return "getBotMediaPreviews"
+}
+
+func (req *GetBotMediaPreviewsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBotMediaPreviewsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBotMediaPreviews"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetBotMediaPreviewsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of media previews of a bot
+//
+// Available to users only
+func (client *Client) GetBotMediaPreviews(ctx context.Context, req *GetBotMediaPreviewsRequest) (*BotMediaPreviews, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBotMediaPreviews)
+}
+
+type GetBotMediaPreviewInfoRequest struct {
+	request
+	// Identifier of the target bot. The bot must be owned and must have the main Web App
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code for which to get previews. If empty, then default previews are returned
+	LanguageCode string `json:"language_code"`
+}
+
+func (req GetBotMediaPreviewInfoRequest) GetFunctionName() string {
+	return "getBotMediaPreviewInfo"
+}
+
+func (req *GetBotMediaPreviewInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBotMediaPreviewInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBotMediaPreviewInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetBotMediaPreviewInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of media previews for the given language and the list of languages for which the bot has dedicated previews
+//
+// Available to users only
+func (client *Client) GetBotMediaPreviewInfo(ctx context.Context, req *GetBotMediaPreviewInfoRequest) (*BotMediaPreviewInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBotMediaPreviewInfo)
+}
+
+type AddBotMediaPreviewRequest struct {
+	request
+	// Identifier of the target bot. The bot must be owned and must have the main Web App
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code for which preview is added. If empty, then the preview will be shown to all users for whose languages there are no dedicated previews. If non-empty, then there must be an official language pack of the same name, which is returned by getLocalizationTargetInfo
+	LanguageCode string `json:"language_code"`
+	// Content of the added preview
+	Content InputStoryContent `json:"content"`
+}
+
+func (req AddBotMediaPreviewRequest) GetFunctionName() string {
+	return "addBotMediaPreview"
+}
+
+func (req *AddBotMediaPreviewRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddBotMediaPreviewRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addBotMediaPreview"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(req.Content)
+	encoder.writeRaw("}")
+}
+
+func (req AddBotMediaPreviewRequest) Validate() error {
+	return nil
+}
+
+// Adds a new media preview to the beginning of the list of media previews of a bot. Returns the added preview after addition is completed server-side. The total number of previews must not exceed getOption("bot_media_preview_count_max") for the given language
+//
+// Available to users only
+func (client *Client) AddBotMediaPreview(ctx context.Context, req *AddBotMediaPreviewRequest) (*BotMediaPreview, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBotMediaPreview)
+}
+
+type EditBotMediaPreviewRequest struct {
+	request
+	// Identifier of the target bot. The bot must be owned and must have the main Web App
+	BotUserId int64 `json:"bot_user_id"`
+	// Language code of the media preview to edit
+	LanguageCode string `json:"language_code"`
+	// File identifier of the media to replace
+	FileId int32 `json:"file_id"`
+	// Content of the new preview
+	Content InputStoryContent `json:"content"`
+}
+
+func (req EditBotMediaPreviewRequest) GetFunctionName() string {
+	return "editBotMediaPreview"
+}
+
+func (req *EditBotMediaPreviewRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBotMediaPreviewRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBotMediaPreview"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(req.Content)
+	encoder.writeRaw("}")
+}
+
+func (req EditBotMediaPreviewRequest) Validate() error {
+	return nil
+}
+
+// Replaces media preview in the list of media previews of a bot. Returns the new preview after edit is completed server-side
+//
+// Available to users only
+func (client *Client) EditBotMediaPreview(ctx context.Context, req *EditBotMediaPreviewRequest) (*BotMediaPreview, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBotMediaPreview)
+}
+
+type ReorderBotMediaPreviewsRequest struct {
+	request
+	// Identifier of the target bot. The bot must be owned and must have the main Web App
+	BotUserId int64 `json:"bot_user_id"`
+	// Language code of the media previews to reorder
+	LanguageCode string `json:"language_code"`
+	// File identifiers of the media in the new order
+	FileIds []int32 `json:"file_ids"`
+}
+
+func (req ReorderBotMediaPreviewsRequest) GetFunctionName() string {
+	return "reorderBotMediaPreviews"
+}
+
+func (req *ReorderBotMediaPreviewsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReorderBotMediaPreviewsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reorderBotMediaPreviews"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"file_ids":`)
+	writeList(encoder, req.FileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (req ReorderBotMediaPreviewsRequest) Validate() error {
+	return nil
+}
+
+// Changes order of media previews in the list of media previews of a bot
+//
+// Available to users only
+func (client *Client) ReorderBotMediaPreviews(ctx context.Context, req *ReorderBotMediaPreviewsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteBotMediaPreviewsRequest struct {
+	request
+	// Identifier of the target bot. The bot must be owned and must have the main Web App
+	BotUserId int64 `json:"bot_user_id"`
+	// Language code of the media previews to delete
+	LanguageCode string `json:"language_code"`
+	// File identifiers of the media to delete
+	FileIds []int32 `json:"file_ids"`
+}
+
+func (req DeleteBotMediaPreviewsRequest) GetFunctionName() string {
+	return "deleteBotMediaPreviews"
+}
+
+func (req *DeleteBotMediaPreviewsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteBotMediaPreviewsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteBotMediaPreviews"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"file_ids":`)
+	writeList(encoder, req.FileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteBotMediaPreviewsRequest) Validate() error {
+	return nil
+}
+
+// Delete media previews from the list of media previews of a bot
+//
+// Available to users only
+func (client *Client) DeleteBotMediaPreviews(ctx context.Context, req *DeleteBotMediaPreviewsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBotNameRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code. If empty, the name will be shown to all users for whose languages there is no dedicated name
+	LanguageCode string `json:"language_code"`
+	// New bot's name on the specified language; 0-64 characters; must be non-empty if language code is empty
+	Name string `json:"name"`
+}
+
+func (req SetBotNameRequest) GetFunctionName() string {
+	return "setBotName"
+}
+
+func (req *SetBotNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBotNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBotName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req SetBotNameRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sets the name of a bot. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) SetBotName(ctx context.Context, req *SetBotNameRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBotNameRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code"`
+}
+
+func (req GetBotNameRequest) GetFunctionName() string {
+	return "getBotName"
+}
+
+func (req *GetBotNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBotNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBotName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetBotNameRequest) Validate() error {
+	return nil
+}
+
+// Returns the name of a bot in the given language. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) GetBotName(ctx context.Context, req *GetBotNameRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type ToggleBotUsernameIsActiveRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// The username to change
+	Username string `json:"username"`
+	// Pass true to activate the username; pass false to disable it
+	IsActive bool `json:"is_active"`
+}
+
+func (req ToggleBotUsernameIsActiveRequest) GetFunctionName() string {
+	return "toggleBotUsernameIsActive"
+}
+
+func (req *ToggleBotUsernameIsActiveRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleBotUsernameIsActiveRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleBotUsernameIsActive"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw(`,"is_active":`)
+	encoder.writeBool(req.IsActive)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleBotUsernameIsActiveRequest) Validate() error {
+	return nil
+}
+
+// Changes active state for a username of a bot. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached. Can be called only if userTypeBot.can_be_edited == true
+//
+// Available to users only
+func (client *Client) ToggleBotUsernameIsActive(ctx context.Context, req *ToggleBotUsernameIsActiveRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReorderBotActiveUsernamesRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// The new order of active usernames. All currently active usernames must be specified
+	Usernames []string `json:"usernames"`
+}
+
+func (req ReorderBotActiveUsernamesRequest) GetFunctionName() string {
+	return "reorderBotActiveUsernames"
+}
+
+func (req *ReorderBotActiveUsernamesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReorderBotActiveUsernamesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reorderBotActiveUsernames"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"usernames":`)
+	writeList(encoder, req.Usernames, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req ReorderBotActiveUsernamesRequest) Validate() error {
+	return nil
+}
+
+// Changes order of active usernames of a bot. Can be called only if userTypeBot.can_be_edited == true
+//
+// Available to users only
+func (client *Client) ReorderBotActiveUsernames(ctx context.Context, req *ReorderBotActiveUsernamesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBotInfoDescriptionRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code. If empty, the description will be shown to all users for whose languages there is no dedicated description
+	LanguageCode string `json:"language_code"`
+	// New bot's description on the specified language
+	Description string `json:"description"`
+}
+
+func (req SetBotInfoDescriptionRequest) GetFunctionName() string {
+	return "setBotInfoDescription"
+}
+
+func (req *SetBotInfoDescriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBotInfoDescriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBotInfoDescription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(req.Description)
+	encoder.writeRaw("}")
+}
+
+func (req SetBotInfoDescriptionRequest) Validate() error {
+	return nil
+}
+
+// Sets the text shown in the chat with a bot if the chat is empty. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) SetBotInfoDescription(ctx context.Context, req *SetBotInfoDescriptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBotInfoDescriptionRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code"`
+}
+
+func (req GetBotInfoDescriptionRequest) GetFunctionName() string {
+	return "getBotInfoDescription"
+}
+
+func (req *GetBotInfoDescriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBotInfoDescriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBotInfoDescription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetBotInfoDescriptionRequest) Validate() error {
+	return nil
+}
+
+// Returns the text shown in the chat with a bot if the chat is empty in the given language. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) GetBotInfoDescription(ctx context.Context, req *GetBotInfoDescriptionRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type SetBotInfoShortDescriptionRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code. If empty, the short description will be shown to all users for whose languages there is no dedicated description
+	LanguageCode string `json:"language_code"`
+	// New bot's short description on the specified language
+	ShortDescription string `json:"short_description"`
+}
+
+func (req SetBotInfoShortDescriptionRequest) GetFunctionName() string {
+	return "setBotInfoShortDescription"
+}
+
+func (req *SetBotInfoShortDescriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBotInfoShortDescriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBotInfoShortDescription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"short_description":`)
+	encoder.writeString(req.ShortDescription)
+	encoder.writeRaw("}")
+}
+
+func (req SetBotInfoShortDescriptionRequest) Validate() error {
+	return nil
+}
+
+// Sets the text shown on a bot's profile page and sent together with the link when users share the bot. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) SetBotInfoShortDescription(ctx context.Context, req *SetBotInfoShortDescriptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBotInfoShortDescriptionRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code"`
+}
+
+func (req GetBotInfoShortDescriptionRequest) GetFunctionName() string {
+	return "getBotInfoShortDescription"
+}
+
+func (req *GetBotInfoShortDescriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBotInfoShortDescriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBotInfoShortDescription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetBotInfoShortDescriptionRequest) Validate() error {
+	return nil
+}
+
+// Returns the text shown on a bot's profile page and sent together with the link when users share the bot in the given language. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) GetBotInfoShortDescription(ctx context.Context, req *GetBotInfoShortDescriptionRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
@@ -0,0 +1,2077 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type SendBusinessMessageRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which to send the request
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Target chat
+	ChatId int64 `json:"chat_id"`
+	// Information about the message to be replied; pass null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Pass true to disable notification for the message
+	DisableNotification bool `json:"disable_notification"`
+	// Pass true if the content of the message must be protected from forwarding and saving
+	ProtectContent bool `json:"protect_content"`
+	// Identifier of the effect to apply to the message
+	EffectId JsonInt64 `json:"effect_id"`
+	// Markup for replying to the message; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req SendBusinessMessageRequest) GetFunctionName() string {
+	return "sendBusinessMessage"
+}
+
+func (req *SendBusinessMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendBusinessMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendBusinessMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	if req.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(req.ReplyTo)
+	}
+	encoder.writeRaw(`,"disable_notification":`)
+	encoder.writeBool(req.DisableNotification)
+	encoder.writeRaw(`,"protect_content":`)
+	encoder.writeBool(req.ProtectContent)
+	encoder.writeRaw(`,"effect_id":`)
+	encoder.writeJsonInt64(req.EffectId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req SendBusinessMessageRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sends a message on behalf of a business account; for bots only. Returns the message after it was sent
+//
+// Available to bots only
+func (client *Client) SendBusinessMessage(ctx context.Context, req *SendBusinessMessageRequest) (*BusinessMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessage)
+}
+
+type SendBusinessMessageAlbumRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which to send the request
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Target chat
+	ChatId int64 `json:"chat_id"`
+	// Information about the message to be replied; pass null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Pass true to disable notification for the message
+	DisableNotification bool `json:"disable_notification"`
+	// Pass true if the content of the message must be protected from forwarding and saving
+	ProtectContent bool `json:"protect_content"`
+	// Identifier of the effect to apply to the message
+	EffectId JsonInt64 `json:"effect_id"`
+	// Contents of messages to be sent. At most 10 messages can be added to an album. All messages must have the same value of show_caption_above_media
+	InputMessageContents []InputMessageContent `json:"input_message_contents"`
+}
+
+func (req SendBusinessMessageAlbumRequest) GetFunctionName() string {
+	return "sendBusinessMessageAlbum"
+}
+
+func (req *SendBusinessMessageAlbumRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendBusinessMessageAlbumRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendBusinessMessageAlbum"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	if req.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(req.ReplyTo)
+	}
+	encoder.writeRaw(`,"disable_notification":`)
+	encoder.writeBool(req.DisableNotification)
+	encoder.writeRaw(`,"protect_content":`)
+	encoder.writeBool(req.ProtectContent)
+	encoder.writeRaw(`,"effect_id":`)
+	encoder.writeJsonInt64(req.EffectId)
+	encoder.writeRaw(`,"input_message_contents":`)
+	writeList(encoder, req.InputMessageContents, writeTypeValue[InputMessageContent])
+	encoder.writeRaw("}")
+}
+
+func (req SendBusinessMessageAlbumRequest) Validate() error {
+	for i, item := range req.InputMessageContents {
+		if err := validateNested(indexField("input_message_contents", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sends 2-10 messages grouped together into an album on behalf of a business account; for bots only. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+//
+// Available to bots only
+func (client *Client) SendBusinessMessageAlbum(ctx context.Context, req *SendBusinessMessageAlbumRequest) (*BusinessMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessages)
+}
+
+type EditBusinessMessageTextRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which the message was sent
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New text content of the message. Must be of type inputMessageText
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req EditBusinessMessageTextRequest) GetFunctionName() string {
+	return "editBusinessMessageText"
+}
+
+func (req *EditBusinessMessageTextRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBusinessMessageTextRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBusinessMessageText"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req EditBusinessMessageTextRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the text of a text or game message sent on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) EditBusinessMessageText(ctx context.Context, req *EditBusinessMessageTextRequest) (*BusinessMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessage)
+}
+
+type EditBusinessMessageLiveLocationRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which the message was sent
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New location content of the message; pass null to stop sharing the live location
+	Location *Location `json:"location,omitempty"`
+	// New time relative to the message send date, for which the location can be updated, in seconds. If 0x7FFFFFFF specified, then the location can be updated forever. Otherwise, must not exceed the current live_period by more than a day, and the live location expiration date must remain in the next 90 days. Pass 0 to keep the current live_period
+	LivePeriod int32 `json:"live_period"`
+	// The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+	Heading int32 `json:"heading"`
+	// The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled
+	ProximityAlertRadius int32 `json:"proximity_alert_radius"`
+}
+
+func (req EditBusinessMessageLiveLocationRequest) GetFunctionName() string {
+	return "editBusinessMessageLiveLocation"
+}
+
+func (req *EditBusinessMessageLiveLocationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBusinessMessageLiveLocationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBusinessMessageLiveLocation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	if req.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		req.Location.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"live_period":`)
+	encoder.writeInt32(req.LivePeriod)
+	encoder.writeRaw(`,"heading":`)
+	encoder.writeInt32(req.Heading)
+	encoder.writeRaw(`,"proximity_alert_radius":`)
+	encoder.writeInt32(req.ProximityAlertRadius)
+	encoder.writeRaw("}")
+}
+
+func (req EditBusinessMessageLiveLocationRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the content of a live location in a message sent on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) EditBusinessMessageLiveLocation(ctx context.Context, req *EditBusinessMessageLiveLocationRequest) (*BusinessMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessage)
+}
+
+type EditBusinessMessageMediaRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which the message was sent
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New content of the message. Must be one of the following types: inputMessageAnimation, inputMessageAudio, inputMessageDocument, inputMessagePhoto or inputMessageVideo
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req EditBusinessMessageMediaRequest) GetFunctionName() string {
+	return "editBusinessMessageMedia"
+}
+
+func (req *EditBusinessMessageMediaRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBusinessMessageMediaRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBusinessMessageMedia"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req EditBusinessMessageMediaRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the media content of a message with a text, an animation, an audio, a document, a photo or a video in a message sent on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) EditBusinessMessageMedia(ctx context.Context, req *EditBusinessMessageMediaRequest) (*BusinessMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessage)
+}
+
+type EditBusinessMessageCaptionRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which the message was sent
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New message content caption; pass null to remove caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+	// Pass true to show the caption above the media; otherwise, the caption will be shown below the media. May be true only for animation, photo, and video messages
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+}
+
+func (req EditBusinessMessageCaptionRequest) GetFunctionName() string {
+	return "editBusinessMessageCaption"
+}
+
+func (req *EditBusinessMessageCaptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBusinessMessageCaptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBusinessMessageCaption"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	if req.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		req.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(req.ShowCaptionAboveMedia)
+	encoder.writeRaw("}")
+}
+
+func (req EditBusinessMessageCaptionRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the caption of a message sent on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) EditBusinessMessageCaption(ctx context.Context, req *EditBusinessMessageCaptionRequest) (*BusinessMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessage)
+}
+
+type EditBusinessMessageReplyMarkupRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which the message was sent
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (req EditBusinessMessageReplyMarkupRequest) GetFunctionName() string {
+	return "editBusinessMessageReplyMarkup"
+}
+
+func (req *EditBusinessMessageReplyMarkupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBusinessMessageReplyMarkupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBusinessMessageReplyMarkup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req EditBusinessMessageReplyMarkupRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the reply markup of a message sent on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) EditBusinessMessageReplyMarkup(ctx context.Context, req *EditBusinessMessageReplyMarkupRequest) (*BusinessMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessage)
+}
+
+type StopBusinessPollRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which the message with the poll was sent
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message containing the poll
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (req StopBusinessPollRequest) GetFunctionName() string {
+	return "stopBusinessPoll"
+}
+
+func (req *StopBusinessPollRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *StopBusinessPollRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stopBusinessPoll"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req StopBusinessPollRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Stops a poll sent on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) StopBusinessPoll(ctx context.Context, req *StopBusinessPollRequest) (*BusinessMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessMessage)
+}
+
+type SetBusinessMessageIsPinnedRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which the message was sent
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Pass true to pin the message, pass false to unpin it
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (req SetBusinessMessageIsPinnedRequest) GetFunctionName() string {
+	return "setBusinessMessageIsPinned"
+}
+
+func (req *SetBusinessMessageIsPinnedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessMessageIsPinnedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessMessageIsPinned"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(req.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessMessageIsPinnedRequest) Validate() error {
+	return nil
+}
+
+// Pins or unpins a message sent on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) SetBusinessMessageIsPinned(ctx context.Context, req *SetBusinessMessageIsPinnedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReadBusinessMessageRequest struct {
+	request
+	// Unique identifier of business connection through which the message was received
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req ReadBusinessMessageRequest) GetFunctionName() string {
+	return "readBusinessMessage"
+}
+
+func (req *ReadBusinessMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReadBusinessMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readBusinessMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req ReadBusinessMessageRequest) Validate() error {
+	return nil
+}
+
+// Reads a message on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) ReadBusinessMessage(ctx context.Context, req *ReadBusinessMessageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteBusinessMessagesRequest struct {
+	request
+	// Unique identifier of business connection through which the messages were received
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Identifier of the messages
+	MessageIds []int64 `json:"message_ids"`
+}
+
+func (req DeleteBusinessMessagesRequest) GetFunctionName() string {
+	return "deleteBusinessMessages"
+}
+
+func (req *DeleteBusinessMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteBusinessMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteBusinessMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteBusinessMessagesRequest) Validate() error {
+	return nil
+}
+
+// Deletes messages on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) DeleteBusinessMessages(ctx context.Context, req *DeleteBusinessMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditBusinessStoryRequest struct {
+	request
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Identifier of the story to edit
+	StoryId int32 `json:"story_id"`
+	// New content of the story
+	Content InputStoryContent `json:"content"`
+	// New clickable rectangle areas to be shown on the story media
+	Areas *InputStoryAreas `json:"areas"`
+	// New story caption
+	Caption *FormattedText `json:"caption"`
+	// The new privacy settings for the story
+	PrivacySettings StoryPrivacySettings `json:"privacy_settings"`
+}
+
+func (req EditBusinessStoryRequest) GetFunctionName() string {
+	return "editBusinessStory"
+}
+
+func (req *EditBusinessStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBusinessStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBusinessStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(req.Content)
+	encoder.writeRaw(`,"areas":`)
+	req.Areas.encodeJSON(encoder)
+	encoder.writeRaw(`,"caption":`)
+	req.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"privacy_settings":`)
+	encoder.writeType(req.PrivacySettings)
+	encoder.writeRaw("}")
+}
+
+func (req EditBusinessStoryRequest) Validate() error {
+	return nil
+}
+
+// Changes a story sent by the bot on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) EditBusinessStory(ctx context.Context, req *EditBusinessStoryRequest) (*Story, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStory)
+}
+
+type DeleteBusinessStoryRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Identifier of the story to delete
+	StoryId int32 `json:"story_id"`
+}
+
+func (req DeleteBusinessStoryRequest) GetFunctionName() string {
+	return "deleteBusinessStory"
+}
+
+func (req *DeleteBusinessStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteBusinessStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteBusinessStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteBusinessStoryRequest) Validate() error {
+	return nil
+}
+
+// Deletes a story sent by the bot on behalf of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) DeleteBusinessStory(ctx context.Context, req *DeleteBusinessStoryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessAccountNameRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The new value of the first name for the business account; 1-64 characters
+	FirstName string `json:"first_name"`
+	// The new value of the optional last name for the business account; 0-64 characters
+	LastName string `json:"last_name"`
+}
+
+func (req SetBusinessAccountNameRequest) GetFunctionName() string {
+	return "setBusinessAccountName"
+}
+
+func (req *SetBusinessAccountNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessAccountNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessAccountName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"first_name":`)
+	encoder.writeString(req.FirstName)
+	encoder.writeRaw(`,"last_name":`)
+	encoder.writeString(req.LastName)
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessAccountNameRequest) Validate() error {
+	if err := validateLength("first_name", req.FirstName, 1, 64); err != nil {
+		return err
+	}
+
+	if err := validateLength("last_name", req.LastName, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes the first and last name of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) SetBusinessAccountName(ctx context.Context, req *SetBusinessAccountNameRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessAccountBioRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The new value of the bio; 0-getOption("bio_length_max") characters without line feeds
+	Bio string `json:"bio"`
+}
+
+func (req SetBusinessAccountBioRequest) GetFunctionName() string {
+	return "setBusinessAccountBio"
+}
+
+func (req *SetBusinessAccountBioRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessAccountBioRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessAccountBio"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"bio":`)
+	encoder.writeString(req.Bio)
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessAccountBioRequest) Validate() error {
+	return nil
+}
+
+// Changes the bio of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) SetBusinessAccountBio(ctx context.Context, req *SetBusinessAccountBioRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessAccountProfilePhotoRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Profile photo to set; pass null to remove the photo
+	Photo InputChatPhoto `json:"photo,omitempty"`
+	// Pass true to set the public photo, which will be visible even the main photo is hidden by privacy settings
+	IsPublic bool `json:"is_public"`
+}
+
+func (req SetBusinessAccountProfilePhotoRequest) GetFunctionName() string {
+	return "setBusinessAccountProfilePhoto"
+}
+
+func (req *SetBusinessAccountProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessAccountProfilePhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessAccountProfilePhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	if req.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		encoder.writeType(req.Photo)
+	}
+	encoder.writeRaw(`,"is_public":`)
+	encoder.writeBool(req.IsPublic)
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessAccountProfilePhotoRequest) Validate() error {
+	return nil
+}
+
+// Changes a profile photo of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) SetBusinessAccountProfilePhoto(ctx context.Context, req *SetBusinessAccountProfilePhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessAccountUsernameRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The new value of the username
+	Username string `json:"username"`
+}
+
+func (req SetBusinessAccountUsernameRequest) GetFunctionName() string {
+	return "setBusinessAccountUsername"
+}
+
+func (req *SetBusinessAccountUsernameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessAccountUsernameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessAccountUsername"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessAccountUsernameRequest) Validate() error {
+	return nil
+}
+
+// Changes the editable username of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) SetBusinessAccountUsername(ctx context.Context, req *SetBusinessAccountUsernameRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessAccountGiftSettingsRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The new settings
+	Settings *GiftSettings `json:"settings"`
+}
+
+func (req SetBusinessAccountGiftSettingsRequest) GetFunctionName() string {
+	return "setBusinessAccountGiftSettings"
+}
+
+func (req *SetBusinessAccountGiftSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessAccountGiftSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessAccountGiftSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"settings":`)
+	req.Settings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessAccountGiftSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes settings for gift receiving of a business account; for bots only
+//
+// Available to bots only
+func (client *Client) SetBusinessAccountGiftSettings(ctx context.Context, req *SetBusinessAccountGiftSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBusinessAccountStarAmountRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+}
+
+func (req GetBusinessAccountStarAmountRequest) GetFunctionName() string {
+	return "getBusinessAccountStarAmount"
+}
+
+func (req *GetBusinessAccountStarAmountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBusinessAccountStarAmountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBusinessAccountStarAmount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw("}")
+}
+
+func (req GetBusinessAccountStarAmountRequest) Validate() error {
+	return nil
+}
+
+// Returns the amount of Telegram Stars owned by a business account; for bots only
+//
+// Available to bots only
+func (client *Client) GetBusinessAccountStarAmount(ctx context.Context, req *GetBusinessAccountStarAmountRequest) (*StarAmount, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarAmount)
+}
+
+type TransferBusinessAccountStarsRequest struct {
+	request
+	// Unique identifier of business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Number of Telegram Stars to transfer
+	StarCount int64 `json:"star_count"`
+}
+
+func (req TransferBusinessAccountStarsRequest) GetFunctionName() string {
+	return "transferBusinessAccountStars"
+}
+
+func (req *TransferBusinessAccountStarsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TransferBusinessAccountStarsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"transferBusinessAccountStars"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(req.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (req TransferBusinessAccountStarsRequest) Validate() error {
+	return nil
+}
+
+// Transfer Telegram Stars from the business account to the business bot; for bots only
+//
+// Available to bots only
+func (client *Client) TransferBusinessAccountStars(ctx context.Context, req *TransferBusinessAccountStarsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBusinessConnectionRequest struct {
+	request
+	// Identifier of the business connection to return
+	ConnectionId string `json:"connection_id"`
+}
+
+func (req GetBusinessConnectionRequest) GetFunctionName() string {
+	return "getBusinessConnection"
+}
+
+func (req *GetBusinessConnectionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBusinessConnectionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBusinessConnection"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"connection_id":`)
+	encoder.writeString(req.ConnectionId)
+	encoder.writeRaw("}")
+}
+
+func (req GetBusinessConnectionRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a business connection by its identifier; for bots only
+//
+// Available to bots only
+func (client *Client) GetBusinessConnection(ctx context.Context, req *GetBusinessConnectionRequest) (*BusinessConnection, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessConnection)
+}
+
+type SetBusinessLocationRequest struct {
+	request
+	// The new location of the business; pass null to remove the location
+	Location *BusinessLocation `json:"location,omitempty"`
+}
+
+func (req SetBusinessLocationRequest) GetFunctionName() string {
+	return "setBusinessLocation"
+}
+
+func (req *SetBusinessLocationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessLocationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessLocation"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		req.Location.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessLocationRequest) Validate() error {
+	if err := validateNested("location", req.Location); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes the business location of the current user. Requires Telegram Business subscription
+//
+// Available to users only
+func (client *Client) SetBusinessLocation(ctx context.Context, req *SetBusinessLocationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessOpeningHoursRequest struct {
+	request
+	// The new opening hours of the business; pass null to remove the opening hours; up to 28 time intervals can be specified
+	OpeningHours *BusinessOpeningHours `json:"opening_hours,omitempty"`
+}
+
+func (req SetBusinessOpeningHoursRequest) GetFunctionName() string {
+	return "setBusinessOpeningHours"
+}
+
+func (req *SetBusinessOpeningHoursRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessOpeningHoursRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessOpeningHours"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.OpeningHours != nil {
+		encoder.writeRaw(`,"opening_hours":`)
+		req.OpeningHours.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessOpeningHoursRequest) Validate() error {
+	return nil
+}
+
+// Changes the business opening hours of the current user. Requires Telegram Business subscription
+//
+// Available to users only
+func (client *Client) SetBusinessOpeningHours(ctx context.Context, req *SetBusinessOpeningHoursRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessGreetingMessageSettingsRequest struct {
+	request
+	// The new settings for the greeting message of the business; pass null to disable the greeting message
+	GreetingMessageSettings *BusinessGreetingMessageSettings `json:"greeting_message_settings,omitempty"`
+}
+
+func (req SetBusinessGreetingMessageSettingsRequest) GetFunctionName() string {
+	return "setBusinessGreetingMessageSettings"
+}
+
+func (req *SetBusinessGreetingMessageSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessGreetingMessageSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessGreetingMessageSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.GreetingMessageSettings != nil {
+		encoder.writeRaw(`,"greeting_message_settings":`)
+		req.GreetingMessageSettings.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessGreetingMessageSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes the business greeting message settings of the current user. Requires Telegram Business subscription
+//
+// Available to users only
+func (client *Client) SetBusinessGreetingMessageSettings(ctx context.Context, req *SetBusinessGreetingMessageSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessAwayMessageSettingsRequest struct {
+	request
+	// The new settings for the away message of the business; pass null to disable the away message
+	AwayMessageSettings *BusinessAwayMessageSettings `json:"away_message_settings,omitempty"`
+}
+
+func (req SetBusinessAwayMessageSettingsRequest) GetFunctionName() string {
+	return "setBusinessAwayMessageSettings"
+}
+
+func (req *SetBusinessAwayMessageSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessAwayMessageSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessAwayMessageSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.AwayMessageSettings != nil {
+		encoder.writeRaw(`,"away_message_settings":`)
+		req.AwayMessageSettings.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessAwayMessageSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes the business away message settings of the current user. Requires Telegram Business subscription
+//
+// Available to users only
+func (client *Client) SetBusinessAwayMessageSettings(ctx context.Context, req *SetBusinessAwayMessageSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBusinessStartPageRequest struct {
+	request
+	// The new start page of the business; pass null to remove custom start page
+	StartPage *InputBusinessStartPage `json:"start_page,omitempty"`
+}
+
+func (req SetBusinessStartPageRequest) GetFunctionName() string {
+	return "setBusinessStartPage"
+}
+
+func (req *SetBusinessStartPageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessStartPageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessStartPage"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.StartPage != nil {
+		encoder.writeRaw(`,"start_page":`)
+		req.StartPage.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessStartPageRequest) Validate() error {
+	return nil
+}
+
+// Changes the business start page of the current user. Requires Telegram Business subscription
+//
+// Available to users only
+func (client *Client) SetBusinessStartPage(ctx context.Context, req *SetBusinessStartPageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBusinessConnectedBotRequest struct {
+	request
+}
+
+func (req GetBusinessConnectedBotRequest) GetFunctionName() string {
+	return "getBusinessConnectedBot"
+}
+
+func (req *GetBusinessConnectedBotRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBusinessConnectedBotRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBusinessConnectedBot"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetBusinessConnectedBotRequest) Validate() error {
+	return nil
+}
+
+// Returns the business bot that is connected to the current user account. Returns a 404 error if there is no connected bot
+//
+// Available to users only
+func (client *Client) GetBusinessConnectedBot(ctx context.Context) (*BusinessConnectedBot, error) {
+	req := &GetBusinessConnectedBotRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessConnectedBot)
+}
+
+type SetBusinessConnectedBotRequest struct {
+	request
+	// Connection settings for the bot
+	Bot *BusinessConnectedBot `json:"bot"`
+}
+
+func (req SetBusinessConnectedBotRequest) GetFunctionName() string {
+	return "setBusinessConnectedBot"
+}
+
+func (req *SetBusinessConnectedBotRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBusinessConnectedBotRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBusinessConnectedBot"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot":`)
+	req.Bot.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetBusinessConnectedBotRequest) Validate() error {
+	return nil
+}
+
+// Adds or changes business bot that is connected to the current user account
+//
+// Available to users only
+func (client *Client) SetBusinessConnectedBot(ctx context.Context, req *SetBusinessConnectedBotRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteBusinessConnectedBotRequest struct {
+	request
+	// Unique user identifier for the bot
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req DeleteBusinessConnectedBotRequest) GetFunctionName() string {
+	return "deleteBusinessConnectedBot"
+}
+
+func (req *DeleteBusinessConnectedBotRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteBusinessConnectedBotRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteBusinessConnectedBot"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteBusinessConnectedBotRequest) Validate() error {
+	return nil
+}
+
+// Deletes the business bot that is connected to the current user account
+//
+// Available to users only
+func (client *Client) DeleteBusinessConnectedBot(ctx context.Context, req *DeleteBusinessConnectedBotRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleBusinessConnectedBotChatIsPausedRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Pass true to pause the connected bot in the chat; pass false to resume the bot
+	IsPaused bool `json:"is_paused"`
+}
+
+func (req ToggleBusinessConnectedBotChatIsPausedRequest) GetFunctionName() string {
+	return "toggleBusinessConnectedBotChatIsPaused"
+}
+
+func (req *ToggleBusinessConnectedBotChatIsPausedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleBusinessConnectedBotChatIsPausedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleBusinessConnectedBotChatIsPaused"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"is_paused":`)
+	encoder.writeBool(req.IsPaused)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleBusinessConnectedBotChatIsPausedRequest) Validate() error {
+	return nil
+}
+
+// Pauses or resumes the connected business bot in a specific chat
+//
+// Available to users only
+func (client *Client) ToggleBusinessConnectedBotChatIsPaused(ctx context.Context, req *ToggleBusinessConnectedBotChatIsPausedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveBusinessConnectedBotFromChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req RemoveBusinessConnectedBotFromChatRequest) GetFunctionName() string {
+	return "removeBusinessConnectedBotFromChat"
+}
+
+func (req *RemoveBusinessConnectedBotFromChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveBusinessConnectedBotFromChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeBusinessConnectedBotFromChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveBusinessConnectedBotFromChatRequest) Validate() error {
+	return nil
+}
+
+// Removes the connected business bot from a specific chat by adding the chat to businessRecipients.excluded_chat_ids
+//
+// Available to users only
+func (client *Client) RemoveBusinessConnectedBotFromChat(ctx context.Context, req *RemoveBusinessConnectedBotFromChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBusinessChatLinksRequest struct {
+	request
+}
+
+func (req GetBusinessChatLinksRequest) GetFunctionName() string {
+	return "getBusinessChatLinks"
+}
+
+func (req *GetBusinessChatLinksRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBusinessChatLinksRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBusinessChatLinks"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetBusinessChatLinksRequest) Validate() error {
+	return nil
+}
+
+// Returns business chat links created for the current account
+//
+// Available to users only
+func (client *Client) GetBusinessChatLinks(ctx context.Context) (*BusinessChatLinks, error) {
+	req := &GetBusinessChatLinksRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessChatLinks)
+}
+
+type CreateBusinessChatLinkRequest struct {
+	request
+	// Information about the link to create
+	LinkInfo *InputBusinessChatLink `json:"link_info"`
+}
+
+func (req CreateBusinessChatLinkRequest) GetFunctionName() string {
+	return "createBusinessChatLink"
+}
+
+func (req *CreateBusinessChatLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateBusinessChatLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createBusinessChatLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link_info":`)
+	req.LinkInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req CreateBusinessChatLinkRequest) Validate() error {
+	return nil
+}
+
+// Creates a business chat link for the current account. Requires Telegram Business subscription. There can be up to getOption("business_chat_link_count_max") links created. Returns the created link
+//
+// Available to users only
+func (client *Client) CreateBusinessChatLink(ctx context.Context, req *CreateBusinessChatLinkRequest) (*BusinessChatLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessChatLink)
+}
+
+type EditBusinessChatLinkRequest struct {
+	request
+	// The link to edit
+	Link string `json:"link"`
+	// New description of the link
+	LinkInfo *InputBusinessChatLink `json:"link_info"`
+}
+
+func (req EditBusinessChatLinkRequest) GetFunctionName() string {
+	return "editBusinessChatLink"
+}
+
+func (req *EditBusinessChatLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditBusinessChatLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editBusinessChatLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(req.Link)
+	encoder.writeRaw(`,"link_info":`)
+	req.LinkInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req EditBusinessChatLinkRequest) Validate() error {
+	return nil
+}
+
+// Edits a business chat link of the current account. Requires Telegram Business subscription. Returns the edited link
+//
+// Available to users only
+func (client *Client) EditBusinessChatLink(ctx context.Context, req *EditBusinessChatLinkRequest) (*BusinessChatLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessChatLink)
+}
+
+type DeleteBusinessChatLinkRequest struct {
+	request
+	// The link to delete
+	Link string `json:"link"`
+}
+
+func (req DeleteBusinessChatLinkRequest) GetFunctionName() string {
+	return "deleteBusinessChatLink"
+}
+
+func (req *DeleteBusinessChatLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteBusinessChatLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteBusinessChatLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(req.Link)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteBusinessChatLinkRequest) Validate() error {
+	return nil
+}
+
+// Deletes a business chat link of the current account
+//
+// Available to users only
+func (client *Client) DeleteBusinessChatLink(ctx context.Context, req *DeleteBusinessChatLinkRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBusinessChatLinkInfoRequest struct {
+	request
+	// Name of the link
+	LinkName string `json:"link_name"`
+}
+
+func (req GetBusinessChatLinkInfoRequest) GetFunctionName() string {
+	return "getBusinessChatLinkInfo"
+}
+
+func (req *GetBusinessChatLinkInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBusinessChatLinkInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBusinessChatLinkInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link_name":`)
+	encoder.writeString(req.LinkName)
+	encoder.writeRaw("}")
+}
+
+func (req GetBusinessChatLinkInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a business chat link
+//
+// Available to users only
+func (client *Client) GetBusinessChatLinkInfo(ctx context.Context, req *GetBusinessChatLinkInfoRequest) (*BusinessChatLinkInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessChatLinkInfo)
+}
+
+type GetBusinessFeaturesRequest struct {
+	request
+	// Source of the request; pass null if the method is called from settings or some non-standard source
+	Source BusinessFeature `json:"source,omitempty"`
+}
+
+func (req GetBusinessFeaturesRequest) GetFunctionName() string {
+	return "getBusinessFeatures"
+}
+
+func (req *GetBusinessFeaturesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBusinessFeaturesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBusinessFeatures"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Source != nil {
+		encoder.writeRaw(`,"source":`)
+		encoder.writeType(req.Source)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req GetBusinessFeaturesRequest) Validate() error {
+	return nil
+}
+
+// Returns information about features, available to Business users
+//
+// Available to users only
+func (client *Client) GetBusinessFeatures(ctx context.Context, req *GetBusinessFeaturesRequest) (*BusinessFeatures, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBusinessFeatures)
+}
@@ -0,0 +1,2179 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type SearchCallMessagesRequest struct {
+	request
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of messages to be returned; up to 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+	// Pass true to search only for messages with missed/declined calls
+	OnlyMissed bool `json:"only_missed"`
+}
+
+func (req SearchCallMessagesRequest) GetFunctionName() string {
+	return "searchCallMessages"
+}
+
+func (req *SearchCallMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchCallMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchCallMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw(`,"only_missed":`)
+	encoder.writeBool(req.OnlyMissed)
+	encoder.writeRaw("}")
+}
+
+func (req SearchCallMessagesRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for call messages. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available to users only
+func (client *Client) SearchCallMessages(ctx context.Context, req *SearchCallMessagesRequest) (*FoundMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundMessages)
+}
+
+type DeleteAllCallMessagesRequest struct {
+	request
+	// Pass true to delete the messages for all users
+	Revoke bool `json:"revoke"`
+}
+
+func (req DeleteAllCallMessagesRequest) GetFunctionName() string {
+	return "deleteAllCallMessages"
+}
+
+func (req *DeleteAllCallMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteAllCallMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteAllCallMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"revoke":`)
+	encoder.writeBool(req.Revoke)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteAllCallMessagesRequest) Validate() error {
+	return nil
+}
+
+// Deletes all call messages
+//
+// Available to users only
+func (client *Client) DeleteAllCallMessages(ctx context.Context, req *DeleteAllCallMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CreateCallRequest struct {
+	request
+	// Identifier of the user to be called
+	UserId int64 `json:"user_id"`
+	// The call protocols supported by the application
+	Protocol *CallProtocol `json:"protocol"`
+	// Pass true to create a video call
+	IsVideo bool `json:"is_video"`
+	// Identifier of the group call to which the user will be added after exchanging private key via the call; pass 0 if none
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req CreateCallRequest) GetFunctionName() string {
+	return "createCall"
+}
+
+func (req *CreateCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"protocol":`)
+	req.Protocol.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_video":`)
+	encoder.writeBool(req.IsVideo)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req CreateCallRequest) Validate() error {
+	return nil
+}
+
+// Creates a new call
+//
+// Available to users only
+func (client *Client) CreateCall(ctx context.Context, req *CreateCallRequest) (*CallId, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCallId)
+}
+
+type AcceptCallRequest struct {
+	request
+	// Call identifier
+	CallId int32 `json:"call_id"`
+	// The call protocols supported by the application
+	Protocol *CallProtocol `json:"protocol"`
+}
+
+func (req AcceptCallRequest) GetFunctionName() string {
+	return "acceptCall"
+}
+
+func (req *AcceptCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AcceptCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"acceptCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(req.CallId)
+	encoder.writeRaw(`,"protocol":`)
+	req.Protocol.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req AcceptCallRequest) Validate() error {
+	return nil
+}
+
+// Accepts an incoming call
+//
+// Available to users only
+func (client *Client) AcceptCall(ctx context.Context, req *AcceptCallRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendCallSignalingDataRequest struct {
+	request
+	// Call identifier
+	CallId int32 `json:"call_id"`
+	// The data
+	Data []byte `json:"data"`
+}
+
+func (req SendCallSignalingDataRequest) GetFunctionName() string {
+	return "sendCallSignalingData"
+}
+
+func (req *SendCallSignalingDataRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendCallSignalingDataRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendCallSignalingData"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(req.CallId)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(req.Data)
+	encoder.writeRaw("}")
+}
+
+func (req SendCallSignalingDataRequest) Validate() error {
+	return nil
+}
+
+// Sends call signaling data
+//
+// Available to users only
+func (client *Client) SendCallSignalingData(ctx context.Context, req *SendCallSignalingDataRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DiscardCallRequest struct {
+	request
+	// Call identifier
+	CallId int32 `json:"call_id"`
+	// Pass true if the user was disconnected
+	IsDisconnected bool `json:"is_disconnected"`
+	// The call duration, in seconds
+	Duration int32 `json:"duration"`
+	// Pass true if the call was a video call
+	IsVideo bool `json:"is_video"`
+	// Identifier of the connection used during the call
+	ConnectionId JsonInt64 `json:"connection_id"`
+}
+
+func (req DiscardCallRequest) GetFunctionName() string {
+	return "discardCall"
+}
+
+func (req *DiscardCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DiscardCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"discardCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(req.CallId)
+	encoder.writeRaw(`,"is_disconnected":`)
+	encoder.writeBool(req.IsDisconnected)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(req.Duration)
+	encoder.writeRaw(`,"is_video":`)
+	encoder.writeBool(req.IsVideo)
+	encoder.writeRaw(`,"connection_id":`)
+	encoder.writeJsonInt64(req.ConnectionId)
+	encoder.writeRaw("}")
+}
+
+func (req DiscardCallRequest) Validate() error {
+	return nil
+}
+
+// Discards a call
+//
+// Available to users only
+func (client *Client) DiscardCall(ctx context.Context, req *DiscardCallRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendCallRatingRequest struct {
+	request
+	// Call identifier
+	CallId int32 `json:"call_id"`
+	// Call rating; 1-5
+	Rating int32 `json:"rating"`
+	// An optional user comment if the rating is less than 5
+	Comment string `json:"comment"`
+	// List of the exact types of problems with the call, specified by the user
+	Problems []CallProblem `json:"problems"`
+}
+
+func (req SendCallRatingRequest) GetFunctionName() string {
+	return "sendCallRating"
+}
+
+func (req *SendCallRatingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendCallRatingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendCallRating"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(req.CallId)
+	encoder.writeRaw(`,"rating":`)
+	encoder.writeInt32(req.Rating)
+	encoder.writeRaw(`,"comment":`)
+	encoder.writeString(req.Comment)
+	encoder.writeRaw(`,"problems":`)
+	writeList(encoder, req.Problems, writeTypeValue[CallProblem])
+	encoder.writeRaw("}")
+}
+
+func (req SendCallRatingRequest) Validate() error {
+	return nil
+}
+
+// Sends a call rating
+//
+// Available to users only
+func (client *Client) SendCallRating(ctx context.Context, req *SendCallRatingRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendCallDebugInformationRequest struct {
+	request
+	// Call identifier
+	CallId int32 `json:"call_id"`
+	// Debug information in application-specific format
+	DebugInformation string `json:"debug_information"`
+}
+
+func (req SendCallDebugInformationRequest) GetFunctionName() string {
+	return "sendCallDebugInformation"
+}
+
+func (req *SendCallDebugInformationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendCallDebugInformationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendCallDebugInformation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(req.CallId)
+	encoder.writeRaw(`,"debug_information":`)
+	encoder.writeString(req.DebugInformation)
+	encoder.writeRaw("}")
+}
+
+func (req SendCallDebugInformationRequest) Validate() error {
+	return nil
+}
+
+// Sends debug information for a call to Telegram servers
+//
+// Available to users only
+func (client *Client) SendCallDebugInformation(ctx context.Context, req *SendCallDebugInformationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendCallLogRequest struct {
+	request
+	// Call identifier
+	CallId int32 `json:"call_id"`
+	// Call log file. Only inputFileLocal and inputFileGenerated are supported
+	LogFile InputFile `json:"log_file"`
+}
+
+func (req SendCallLogRequest) GetFunctionName() string {
+	return "sendCallLog"
+}
+
+func (req *SendCallLogRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendCallLogRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendCallLog"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(req.CallId)
+	encoder.writeRaw(`,"log_file":`)
+	encoder.writeType(req.LogFile)
+	encoder.writeRaw("}")
+}
+
+func (req SendCallLogRequest) Validate() error {
+	return nil
+}
+
+// Sends log file for a call to Telegram servers
+//
+// Available to users only
+func (client *Client) SendCallLog(ctx context.Context, req *SendCallLogRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CreateGroupCallRequest struct {
+	request
+	// Call identifier
+	CallId int32 `json:"call_id"`
+}
+
+func (req CreateGroupCallRequest) GetFunctionName() string {
+	return "createGroupCall"
+}
+
+func (req *CreateGroupCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateGroupCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createGroupCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(req.CallId)
+	encoder.writeRaw("}")
+}
+
+func (req CreateGroupCallRequest) Validate() error {
+	return nil
+}
+
+// Creates a group call from a one-to-one call
+//
+// Available to users only
+func (client *Client) CreateGroupCall(ctx context.Context, req *CreateGroupCallRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetGroupCallRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req GetGroupCallRequest) GetFunctionName() string {
+	return "getGroupCall"
+}
+
+func (req *GetGroupCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGroupCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGroupCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req GetGroupCallRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a group call
+//
+// Available to users only
+func (client *Client) GetGroupCall(ctx context.Context, req *GetGroupCallRequest) (*GroupCall, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGroupCall)
+}
+
+type StartScheduledGroupCallRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req StartScheduledGroupCallRequest) GetFunctionName() string {
+	return "startScheduledGroupCall"
+}
+
+func (req *StartScheduledGroupCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *StartScheduledGroupCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"startScheduledGroupCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req StartScheduledGroupCallRequest) Validate() error {
+	return nil
+}
+
+// Starts a scheduled group call
+//
+// Available to users only
+func (client *Client) StartScheduledGroupCall(ctx context.Context, req *StartScheduledGroupCallRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGroupCallEnabledStartNotificationRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// New value of the enabled_start_notification setting
+	EnabledStartNotification bool `json:"enabled_start_notification"`
+}
+
+func (req ToggleGroupCallEnabledStartNotificationRequest) GetFunctionName() string {
+	return "toggleGroupCallEnabledStartNotification"
+}
+
+func (req *ToggleGroupCallEnabledStartNotificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGroupCallEnabledStartNotificationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGroupCallEnabledStartNotification"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"enabled_start_notification":`)
+	encoder.writeBool(req.EnabledStartNotification)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGroupCallEnabledStartNotificationRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether the current user will receive a notification when the group call starts; scheduled group calls only
+//
+// Available to users only
+func (client *Client) ToggleGroupCallEnabledStartNotification(ctx context.Context, req *ToggleGroupCallEnabledStartNotificationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type JoinGroupCallRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Identifier of a group call participant, which will be used to join the call; pass null to join as self; video chats only
+	ParticipantId MessageSender `json:"participant_id,omitempty"`
+	// Caller audio channel synchronization source identifier; received from tgcalls
+	AudioSourceId int32 `json:"audio_source_id"`
+	// Group call join payload; received from tgcalls
+	Payload string `json:"payload"`
+	// Pass true to join the call with muted microphone
+	IsMuted bool `json:"is_muted"`
+	// Pass true if the user's video is enabled
+	IsMyVideoEnabled bool `json:"is_my_video_enabled"`
+	// If non-empty, invite hash to be used to join the group call without being muted by administrators
+	InviteHash string `json:"invite_hash"`
+	// Fingerprint of the encryption key for E2E group calls not bound to a chat; pass 0 for voice chats
+	KeyFingerprint JsonInt64 `json:"key_fingerprint"`
+}
+
+func (req JoinGroupCallRequest) GetFunctionName() string {
+	return "joinGroupCall"
+}
+
+func (req *JoinGroupCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *JoinGroupCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"joinGroupCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	if req.ParticipantId != nil {
+		encoder.writeRaw(`,"participant_id":`)
+		encoder.writeType(req.ParticipantId)
+	}
+	encoder.writeRaw(`,"audio_source_id":`)
+	encoder.writeInt32(req.AudioSourceId)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeString(req.Payload)
+	encoder.writeRaw(`,"is_muted":`)
+	encoder.writeBool(req.IsMuted)
+	encoder.writeRaw(`,"is_my_video_enabled":`)
+	encoder.writeBool(req.IsMyVideoEnabled)
+	encoder.writeRaw(`,"invite_hash":`)
+	encoder.writeString(req.InviteHash)
+	encoder.writeRaw(`,"key_fingerprint":`)
+	encoder.writeJsonInt64(req.KeyFingerprint)
+	encoder.writeRaw("}")
+}
+
+func (req JoinGroupCallRequest) Validate() error {
+	return nil
+}
+
+// Joins an active group call. Returns join response payload for tgcalls
+//
+// Available to users only
+func (client *Client) JoinGroupCall(ctx context.Context, req *JoinGroupCallRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type StartGroupCallScreenSharingRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Screen sharing audio channel synchronization source identifier; received from tgcalls
+	AudioSourceId int32 `json:"audio_source_id"`
+	// Group call join payload; received from tgcalls
+	Payload string `json:"payload"`
+}
+
+func (req StartGroupCallScreenSharingRequest) GetFunctionName() string {
+	return "startGroupCallScreenSharing"
+}
+
+func (req *StartGroupCallScreenSharingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *StartGroupCallScreenSharingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"startGroupCallScreenSharing"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"audio_source_id":`)
+	encoder.writeInt32(req.AudioSourceId)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeString(req.Payload)
+	encoder.writeRaw("}")
+}
+
+func (req StartGroupCallScreenSharingRequest) Validate() error {
+	return nil
+}
+
+// Starts screen sharing in a joined group call. Returns join response payload for tgcalls
+//
+// Available to users only
+func (client *Client) StartGroupCallScreenSharing(ctx context.Context, req *StartGroupCallScreenSharingRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type ToggleGroupCallScreenSharingIsPausedRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Pass true to pause screen sharing; pass false to unpause it
+	IsPaused bool `json:"is_paused"`
+}
+
+func (req ToggleGroupCallScreenSharingIsPausedRequest) GetFunctionName() string {
+	return "toggleGroupCallScreenSharingIsPaused"
+}
+
+func (req *ToggleGroupCallScreenSharingIsPausedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGroupCallScreenSharingIsPausedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGroupCallScreenSharingIsPaused"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"is_paused":`)
+	encoder.writeBool(req.IsPaused)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGroupCallScreenSharingIsPausedRequest) Validate() error {
+	return nil
+}
+
+// Pauses or unpauses screen sharing in a joined group call
+//
+// Available to users only
+func (client *Client) ToggleGroupCallScreenSharingIsPaused(ctx context.Context, req *ToggleGroupCallScreenSharingIsPausedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EndGroupCallScreenSharingRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req EndGroupCallScreenSharingRequest) GetFunctionName() string {
+	return "endGroupCallScreenSharing"
+}
+
+func (req *EndGroupCallScreenSharingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EndGroupCallScreenSharingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"endGroupCallScreenSharing"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req EndGroupCallScreenSharingRequest) Validate() error {
+	return nil
+}
+
+// Ends screen sharing in a joined group call
+//
+// Available to users only
+func (client *Client) EndGroupCallScreenSharing(ctx context.Context, req *EndGroupCallScreenSharingRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetGroupCallTitleRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// New group call title; 1-64 characters
+	Title string `json:"title"`
+}
+
+func (req SetGroupCallTitleRequest) GetFunctionName() string {
+	return "setGroupCallTitle"
+}
+
+func (req *SetGroupCallTitleRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetGroupCallTitleRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setGroupCallTitle"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw("}")
+}
+
+func (req SetGroupCallTitleRequest) Validate() error {
+	if err := validateLength("title", req.Title, 1, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sets group call title. Requires groupCall.can_be_managed group call flag
+//
+// Available to users only
+func (client *Client) SetGroupCallTitle(ctx context.Context, req *SetGroupCallTitleRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGroupCallMuteNewParticipantsRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// New value of the mute_new_participants setting
+	MuteNewParticipants bool `json:"mute_new_participants"`
+}
+
+func (req ToggleGroupCallMuteNewParticipantsRequest) GetFunctionName() string {
+	return "toggleGroupCallMuteNewParticipants"
+}
+
+func (req *ToggleGroupCallMuteNewParticipantsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGroupCallMuteNewParticipantsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGroupCallMuteNewParticipants"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"mute_new_participants":`)
+	encoder.writeBool(req.MuteNewParticipants)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGroupCallMuteNewParticipantsRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether new participants of a group call can be unmuted only by administrators of the group call. Requires groupCall.can_toggle_mute_new_participants group call flag
+//
+// Available to users only
+func (client *Client) ToggleGroupCallMuteNewParticipants(ctx context.Context, req *ToggleGroupCallMuteNewParticipantsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type InviteGroupCallParticipantsRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// User identifiers. At most 10 users can be invited simultaneously
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (req InviteGroupCallParticipantsRequest) GetFunctionName() string {
+	return "inviteGroupCallParticipants"
+}
+
+func (req *InviteGroupCallParticipantsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *InviteGroupCallParticipantsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inviteGroupCallParticipants"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, req.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req InviteGroupCallParticipantsRequest) Validate() error {
+	return nil
+}
+
+// Invites users to an active group call. Sends a service message of type messageInviteVideoChatParticipants for video chats
+//
+// Available to users only
+func (client *Client) InviteGroupCallParticipants(ctx context.Context, req *InviteGroupCallParticipantsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetGroupCallInviteLinkRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Pass true if the invite link needs to contain an invite hash, passing which to joinGroupCall would allow the invited user to unmute themselves. Requires groupCall.can_be_managed group call flag
+	CanSelfUnmute bool `json:"can_self_unmute"`
+}
+
+func (req GetGroupCallInviteLinkRequest) GetFunctionName() string {
+	return "getGroupCallInviteLink"
+}
+
+func (req *GetGroupCallInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGroupCallInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGroupCallInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"can_self_unmute":`)
+	encoder.writeBool(req.CanSelfUnmute)
+	encoder.writeRaw("}")
+}
+
+func (req GetGroupCallInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns invite link to a video chat in a public chat
+//
+// Available to users only
+func (client *Client) GetGroupCallInviteLink(ctx context.Context, req *GetGroupCallInviteLinkRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type RevokeGroupCallInviteLinkRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req RevokeGroupCallInviteLinkRequest) GetFunctionName() string {
+	return "revokeGroupCallInviteLink"
+}
+
+func (req *RevokeGroupCallInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RevokeGroupCallInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"revokeGroupCallInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req RevokeGroupCallInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Revokes invite link for a group call. Requires groupCall.can_be_managed group call flag
+//
+// Available to users only
+func (client *Client) RevokeGroupCallInviteLink(ctx context.Context, req *RevokeGroupCallInviteLinkRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type StartGroupCallRecordingRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Group call recording title; 0-64 characters
+	Title string `json:"title"`
+	// Pass true to record a video file instead of an audio file
+	RecordVideo bool `json:"record_video"`
+	// Pass true to use portrait orientation for video instead of landscape one
+	UsePortraitOrientation bool `json:"use_portrait_orientation"`
+}
+
+func (req StartGroupCallRecordingRequest) GetFunctionName() string {
+	return "startGroupCallRecording"
+}
+
+func (req *StartGroupCallRecordingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *StartGroupCallRecordingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"startGroupCallRecording"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw(`,"record_video":`)
+	encoder.writeBool(req.RecordVideo)
+	encoder.writeRaw(`,"use_portrait_orientation":`)
+	encoder.writeBool(req.UsePortraitOrientation)
+	encoder.writeRaw("}")
+}
+
+func (req StartGroupCallRecordingRequest) Validate() error {
+	if err := validateLength("title", req.Title, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Starts recording of an active group call. Requires groupCall.can_be_managed group call flag
+//
+// Available to users only
+func (client *Client) StartGroupCallRecording(ctx context.Context, req *StartGroupCallRecordingRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EndGroupCallRecordingRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req EndGroupCallRecordingRequest) GetFunctionName() string {
+	return "endGroupCallRecording"
+}
+
+func (req *EndGroupCallRecordingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EndGroupCallRecordingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"endGroupCallRecording"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req EndGroupCallRecordingRequest) Validate() error {
+	return nil
+}
+
+// Ends recording of an active group call. Requires groupCall.can_be_managed group call flag
+//
+// Available to users only
+func (client *Client) EndGroupCallRecording(ctx context.Context, req *EndGroupCallRecordingRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGroupCallIsMyVideoPausedRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Pass true if the current user's video is paused
+	IsMyVideoPaused bool `json:"is_my_video_paused"`
+}
+
+func (req ToggleGroupCallIsMyVideoPausedRequest) GetFunctionName() string {
+	return "toggleGroupCallIsMyVideoPaused"
+}
+
+func (req *ToggleGroupCallIsMyVideoPausedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGroupCallIsMyVideoPausedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGroupCallIsMyVideoPaused"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"is_my_video_paused":`)
+	encoder.writeBool(req.IsMyVideoPaused)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGroupCallIsMyVideoPausedRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether current user's video is paused
+//
+// Available to users only
+func (client *Client) ToggleGroupCallIsMyVideoPaused(ctx context.Context, req *ToggleGroupCallIsMyVideoPausedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGroupCallIsMyVideoEnabledRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Pass true if the current user's video is enabled
+	IsMyVideoEnabled bool `json:"is_my_video_enabled"`
+}
+
+func (req ToggleGroupCallIsMyVideoEnabledRequest) GetFunctionName() string {
+	return "toggleGroupCallIsMyVideoEnabled"
+}
+
+func (req *ToggleGroupCallIsMyVideoEnabledRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGroupCallIsMyVideoEnabledRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGroupCallIsMyVideoEnabled"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"is_my_video_enabled":`)
+	encoder.writeBool(req.IsMyVideoEnabled)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGroupCallIsMyVideoEnabledRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether current user's video is enabled
+//
+// Available to users only
+func (client *Client) ToggleGroupCallIsMyVideoEnabled(ctx context.Context, req *ToggleGroupCallIsMyVideoEnabledRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetGroupCallParticipantIsSpeakingRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Group call participant's synchronization audio source identifier, or 0 for the current user
+	AudioSource int32 `json:"audio_source"`
+	// Pass true if the user is speaking
+	IsSpeaking bool `json:"is_speaking"`
+}
+
+func (req SetGroupCallParticipantIsSpeakingRequest) GetFunctionName() string {
+	return "setGroupCallParticipantIsSpeaking"
+}
+
+func (req *SetGroupCallParticipantIsSpeakingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetGroupCallParticipantIsSpeakingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setGroupCallParticipantIsSpeaking"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"audio_source":`)
+	encoder.writeInt32(req.AudioSource)
+	encoder.writeRaw(`,"is_speaking":`)
+	encoder.writeBool(req.IsSpeaking)
+	encoder.writeRaw("}")
+}
+
+func (req SetGroupCallParticipantIsSpeakingRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that speaking state of a participant of an active group has changed
+//
+// Available to users only
+func (client *Client) SetGroupCallParticipantIsSpeaking(ctx context.Context, req *SetGroupCallParticipantIsSpeakingRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGroupCallParticipantIsMutedRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Participant identifier
+	ParticipantId MessageSender `json:"participant_id"`
+	// Pass true to mute the user; pass false to unmute them
+	IsMuted bool `json:"is_muted"`
+}
+
+func (req ToggleGroupCallParticipantIsMutedRequest) GetFunctionName() string {
+	return "toggleGroupCallParticipantIsMuted"
+}
+
+func (req *ToggleGroupCallParticipantIsMutedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGroupCallParticipantIsMutedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGroupCallParticipantIsMuted"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"participant_id":`)
+	encoder.writeType(req.ParticipantId)
+	encoder.writeRaw(`,"is_muted":`)
+	encoder.writeBool(req.IsMuted)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGroupCallParticipantIsMutedRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a participant of an active group call is muted, unmuted, or allowed to unmute themselves
+//
+// Available to users only
+func (client *Client) ToggleGroupCallParticipantIsMuted(ctx context.Context, req *ToggleGroupCallParticipantIsMutedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetGroupCallParticipantVolumeLevelRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Participant identifier
+	ParticipantId MessageSender `json:"participant_id"`
+	// New participant's volume level; 1-20000 in hundreds of percents
+	VolumeLevel int32 `json:"volume_level"`
+}
+
+func (req SetGroupCallParticipantVolumeLevelRequest) GetFunctionName() string {
+	return "setGroupCallParticipantVolumeLevel"
+}
+
+func (req *SetGroupCallParticipantVolumeLevelRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetGroupCallParticipantVolumeLevelRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setGroupCallParticipantVolumeLevel"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"participant_id":`)
+	encoder.writeType(req.ParticipantId)
+	encoder.writeRaw(`,"volume_level":`)
+	encoder.writeInt32(req.VolumeLevel)
+	encoder.writeRaw("}")
+}
+
+func (req SetGroupCallParticipantVolumeLevelRequest) Validate() error {
+	return nil
+}
+
+// Changes volume level of a participant of an active group call. If the current user can manage the group call, then the participant's volume level will be changed for all users with the default volume level
+//
+// Available to users only
+func (client *Client) SetGroupCallParticipantVolumeLevel(ctx context.Context, req *SetGroupCallParticipantVolumeLevelRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGroupCallParticipantIsHandRaisedRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Participant identifier
+	ParticipantId MessageSender `json:"participant_id"`
+	// Pass true if the user's hand needs to be raised. Only self hand can be raised. Requires groupCall.can_be_managed group call flag to lower other's hand
+	IsHandRaised bool `json:"is_hand_raised"`
+}
+
+func (req ToggleGroupCallParticipantIsHandRaisedRequest) GetFunctionName() string {
+	return "toggleGroupCallParticipantIsHandRaised"
+}
+
+func (req *ToggleGroupCallParticipantIsHandRaisedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGroupCallParticipantIsHandRaisedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGroupCallParticipantIsHandRaised"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"participant_id":`)
+	encoder.writeType(req.ParticipantId)
+	encoder.writeRaw(`,"is_hand_raised":`)
+	encoder.writeBool(req.IsHandRaised)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGroupCallParticipantIsHandRaisedRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a group call participant hand is rased
+//
+// Available to users only
+func (client *Client) ToggleGroupCallParticipantIsHandRaised(ctx context.Context, req *ToggleGroupCallParticipantIsHandRaisedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type LoadGroupCallParticipantsRequest struct {
+	request
+	// Group call identifier. The group call must be previously received through getGroupCall and must be joined or being joined
+	GroupCallId int32 `json:"group_call_id"`
+	// The maximum number of participants to load; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req LoadGroupCallParticipantsRequest) GetFunctionName() string {
+	return "loadGroupCallParticipants"
+}
+
+func (req *LoadGroupCallParticipantsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LoadGroupCallParticipantsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loadGroupCallParticipants"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req LoadGroupCallParticipantsRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Loads more participants of a group call. The loaded participants will be received through updates. Use the field groupCall.loaded_all_participants to check whether all participants have already been loaded
+//
+// Available to users only
+func (client *Client) LoadGroupCallParticipants(ctx context.Context, req *LoadGroupCallParticipantsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type LeaveGroupCallRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req LeaveGroupCallRequest) GetFunctionName() string {
+	return "leaveGroupCall"
+}
+
+func (req *LeaveGroupCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LeaveGroupCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"leaveGroupCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req LeaveGroupCallRequest) Validate() error {
+	return nil
+}
+
+// Leaves a group call
+//
+// Available to users only
+func (client *Client) LeaveGroupCall(ctx context.Context, req *LeaveGroupCallRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EndGroupCallRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req EndGroupCallRequest) GetFunctionName() string {
+	return "endGroupCall"
+}
+
+func (req *EndGroupCallRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EndGroupCallRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"endGroupCall"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req EndGroupCallRequest) Validate() error {
+	return nil
+}
+
+// Ends a group call. Requires groupCall.can_be_managed
+//
+// Available to users only
+func (client *Client) EndGroupCall(ctx context.Context, req *EndGroupCallRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetGroupCallStreamsRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (req GetGroupCallStreamsRequest) GetFunctionName() string {
+	return "getGroupCallStreams"
+}
+
+func (req *GetGroupCallStreamsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGroupCallStreamsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGroupCallStreams"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (req GetGroupCallStreamsRequest) Validate() error {
+	return nil
+}
+
+// Returns information about available group call streams
+//
+// Available to users only
+func (client *Client) GetGroupCallStreams(ctx context.Context, req *GetGroupCallStreamsRequest) (*GroupCallStreams, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGroupCallStreams)
+}
+
+type GetGroupCallStreamSegmentRequest struct {
+	request
+	// Group call identifier
+	GroupCallId int32 `json:"group_call_id"`
+	// Point in time when the stream segment begins; Unix timestamp in milliseconds
+	TimeOffset int64 `json:"time_offset"`
+	// Segment duration scale; 0-1. Segment's duration is 1000/(2**scale) milliseconds
+	Scale int32 `json:"scale"`
+	// Identifier of an audio/video channel to get as received from tgcalls
+	ChannelId int32 `json:"channel_id"`
+	// Video quality as received from tgcalls; pass null to get the worst available quality
+	VideoQuality GroupCallVideoQuality `json:"video_quality,omitempty"`
+}
+
+func (req GetGroupCallStreamSegmentRequest) GetFunctionName() string {
+	return "getGroupCallStreamSegment"
+}
+
+func (req *GetGroupCallStreamSegmentRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGroupCallStreamSegmentRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGroupCallStreamSegment"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(req.GroupCallId)
+	encoder.writeRaw(`,"time_offset":`)
+	encoder.writeInt64(req.TimeOffset)
+	encoder.writeRaw(`,"scale":`)
+	encoder.writeInt32(req.Scale)
+	encoder.writeRaw(`,"channel_id":`)
+	encoder.writeInt32(req.ChannelId)
+	if req.VideoQuality != nil {
+		encoder.writeRaw(`,"video_quality":`)
+		encoder.writeType(req.VideoQuality)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req GetGroupCallStreamSegmentRequest) Validate() error {
+	return nil
+}
+
+// Returns a file with a segment of a group call stream in a modified OGG format for audio or MPEG-4 format for video
+//
+// Available to users only
+func (client *Client) GetGroupCallStreamSegment(ctx context.Context, req *GetGroupCallStreamSegmentRequest) (*FilePart, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFilePart)
+}
+
+type TestCallEmptyRequest struct {
+	request
+}
+
+func (req TestCallEmptyRequest) GetFunctionName() string {
+	return "testCallEmpty"
+}
+
+func (req *TestCallEmptyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestCallEmptyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testCallEmpty"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req TestCallEmptyRequest) Validate() error {
+	return nil
+}
+
+// Does nothing; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallEmpty(ctx context.Context) (*Ok, error) {
+	req := &TestCallEmptyRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type TestCallStringRequest struct {
+	request
+	// String to return
+	X string `json:"x"`
+}
+
+func (req TestCallStringRequest) GetFunctionName() string {
+	return "testCallString"
+}
+
+func (req *TestCallStringRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestCallStringRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testCallString"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"x":`)
+	encoder.writeString(req.X)
+	encoder.writeRaw("}")
+}
+
+func (req TestCallStringRequest) Validate() error {
+	return nil
+}
+
+// Returns the received string; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallString(ctx context.Context, req *TestCallStringRequest) (*TestString, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTestString)
+}
+
+type TestCallBytesRequest struct {
+	request
+	// Bytes to return
+	X []byte `json:"x"`
+}
+
+func (req TestCallBytesRequest) GetFunctionName() string {
+	return "testCallBytes"
+}
+
+func (req *TestCallBytesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestCallBytesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testCallBytes"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"x":`)
+	encoder.writeBytes(req.X)
+	encoder.writeRaw("}")
+}
+
+func (req TestCallBytesRequest) Validate() error {
+	return nil
+}
+
+// Returns the received bytes; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallBytes(ctx context.Context, req *TestCallBytesRequest) (*TestBytes, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTestBytes)
+}
+
+type TestCallVectorIntRequest struct {
+	request
+	// Vector of numbers to return
+	X []int32 `json:"x"`
+}
+
+func (req TestCallVectorIntRequest) GetFunctionName() string {
+	return "testCallVectorInt"
+}
+
+func (req *TestCallVectorIntRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestCallVectorIntRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testCallVectorInt"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"x":`)
+	writeList(encoder, req.X, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (req TestCallVectorIntRequest) Validate() error {
+	return nil
+}
+
+// Returns the received vector of numbers; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorInt(ctx context.Context, req *TestCallVectorIntRequest) (*TestVectorInt, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTestVectorInt)
+}
+
+type TestCallVectorIntObjectRequest struct {
+	request
+	// Vector of objects to return
+	X []*TestInt `json:"x"`
+}
+
+func (req TestCallVectorIntObjectRequest) GetFunctionName() string {
+	return "testCallVectorIntObject"
+}
+
+func (req *TestCallVectorIntObjectRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestCallVectorIntObjectRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testCallVectorIntObject"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"x":`)
+	writeList(encoder, req.X, writeTypeValue[*TestInt])
+	encoder.writeRaw("}")
+}
+
+func (req TestCallVectorIntObjectRequest) Validate() error {
+	return nil
+}
+
+// Returns the received vector of objects containing a number; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorIntObject(ctx context.Context, req *TestCallVectorIntObjectRequest) (*TestVectorIntObject, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTestVectorIntObject)
+}
+
+type TestCallVectorStringRequest struct {
+	request
+	// Vector of strings to return
+	X []string `json:"x"`
+}
+
+func (req TestCallVectorStringRequest) GetFunctionName() string {
+	return "testCallVectorString"
+}
+
+func (req *TestCallVectorStringRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestCallVectorStringRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testCallVectorString"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"x":`)
+	writeList(encoder, req.X, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req TestCallVectorStringRequest) Validate() error {
+	return nil
+}
+
+// Returns the received vector of strings; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorString(ctx context.Context, req *TestCallVectorStringRequest) (*TestVectorString, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTestVectorString)
+}
+
+type TestCallVectorStringObjectRequest struct {
+	request
+	// Vector of objects to return
+	X []*TestString `json:"x"`
+}
+
+func (req TestCallVectorStringObjectRequest) GetFunctionName() string {
+	return "testCallVectorStringObject"
+}
+
+func (req *TestCallVectorStringObjectRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestCallVectorStringObjectRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testCallVectorStringObject"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"x":`)
+	writeList(encoder, req.X, writeTypeValue[*TestString])
+	encoder.writeRaw("}")
+}
+
+func (req TestCallVectorStringObjectRequest) Validate() error {
+	return nil
+}
+
+// Returns the received vector of objects containing a string; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorStringObject(ctx context.Context, req *TestCallVectorStringObjectRequest) (*TestVectorStringObject, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTestVectorStringObject)
+}
@@ -0,0 +1,8417 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type GetBasicGroupRequest struct {
+	request
+	// Basic group identifier
+	BasicGroupId int64 `json:"basic_group_id"`
+}
+
+func (req GetBasicGroupRequest) GetFunctionName() string {
+	return "getBasicGroup"
+}
+
+func (req *GetBasicGroupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBasicGroupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBasicGroup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"basic_group_id":`)
+	encoder.writeInt64(req.BasicGroupId)
+	encoder.writeRaw("}")
+}
+
+func (req GetBasicGroupRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a basic group by its identifier. This is an offline method if the current user is not a bot
+func (client *Client) GetBasicGroup(ctx context.Context, req *GetBasicGroupRequest) (*BasicGroup, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBasicGroup)
+}
+
+type GetBasicGroupFullInfoRequest struct {
+	request
+	// Basic group identifier
+	BasicGroupId int64 `json:"basic_group_id"`
+}
+
+func (req GetBasicGroupFullInfoRequest) GetFunctionName() string {
+	return "getBasicGroupFullInfo"
+}
+
+func (req *GetBasicGroupFullInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBasicGroupFullInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBasicGroupFullInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"basic_group_id":`)
+	encoder.writeInt64(req.BasicGroupId)
+	encoder.writeRaw("}")
+}
+
+func (req GetBasicGroupFullInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns full information about a basic group by its identifier
+func (client *Client) GetBasicGroupFullInfo(ctx context.Context, req *GetBasicGroupFullInfoRequest) (*BasicGroupFullInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBasicGroupFullInfo)
+}
+
+type GetSupergroupRequest struct {
+	request
+	// Supergroup or channel identifier
+	SupergroupId int64 `json:"supergroup_id"`
+}
+
+func (req GetSupergroupRequest) GetFunctionName() string {
+	return "getSupergroup"
+}
+
+func (req *GetSupergroupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSupergroupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSupergroup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (req GetSupergroupRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a supergroup or a channel by its identifier. This is an offline method if the current user is not a bot
+func (client *Client) GetSupergroup(ctx context.Context, req *GetSupergroupRequest) (*Supergroup, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSupergroup)
+}
+
+type GetSupergroupFullInfoRequest struct {
+	request
+	// Supergroup or channel identifier
+	SupergroupId int64 `json:"supergroup_id"`
+}
+
+func (req GetSupergroupFullInfoRequest) GetFunctionName() string {
+	return "getSupergroupFullInfo"
+}
+
+func (req *GetSupergroupFullInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSupergroupFullInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSupergroupFullInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (req GetSupergroupFullInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns full information about a supergroup or a channel by its identifier, cached for up to 1 minute
+func (client *Client) GetSupergroupFullInfo(ctx context.Context, req *GetSupergroupFullInfoRequest) (*SupergroupFullInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSupergroupFullInfo)
+}
+
+type GetSecretChatRequest struct {
+	request
+	// Secret chat identifier
+	SecretChatId int32 `json:"secret_chat_id"`
+}
+
+func (req GetSecretChatRequest) GetFunctionName() string {
+	return "getSecretChat"
+}
+
+func (req *GetSecretChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSecretChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSecretChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"secret_chat_id":`)
+	encoder.writeInt32(req.SecretChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetSecretChatRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a secret chat by its identifier. This is an offline method
+func (client *Client) GetSecretChat(ctx context.Context, req *GetSecretChatRequest) (*SecretChat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSecretChat)
+}
+
+type GetChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatRequest) GetFunctionName() string {
+	return "getChat"
+}
+
+func (req *GetChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a chat by its identifier. This is an offline method if the current user is not a bot
+func (client *Client) GetChat(ctx context.Context, req *GetChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type LoadChatsRequest struct {
+	request
+	// The chat list in which to load chats; pass null to load chats from the main chat list
+	ChatList ChatList `json:"chat_list,omitempty"`
+	// The maximum number of chats to be loaded. For optimal performance, the number of loaded chats is chosen by TDLib and can be smaller than the specified limit, even if the end of the list is not reached
+	Limit int32 `json:"limit"`
+}
+
+func (req LoadChatsRequest) GetFunctionName() string {
+	return "loadChats"
+}
+
+func (req *LoadChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LoadChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loadChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.ChatList != nil {
+		encoder.writeRaw(`,"chat_list":`)
+		encoder.writeType(req.ChatList)
+	}
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req LoadChatsRequest) Validate() error {
+	return nil
+}
+
+// Loads more chats from a chat list. The loaded chats and their positions in the chat list will be sent through updates. Chats are sorted by the pair (chat.position.order, chat.id) in descending order. Returns a 404 error if all chats have been loaded
+//
+// Available to users only
+func (client *Client) LoadChats(ctx context.Context, req *LoadChatsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatsRequest struct {
+	request
+	// The chat list in which to return chats; pass null to get chats from the main chat list
+	ChatList ChatList `json:"chat_list,omitempty"`
+	// The maximum number of chats to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatsRequest) GetFunctionName() string {
+	return "getChats"
+}
+
+func (req *GetChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.ChatList != nil {
+		encoder.writeRaw(`,"chat_list":`)
+		encoder.writeType(req.ChatList)
+	}
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns an ordered list of chats from the beginning of a chat list. For informational purposes only. Use loadChats and updates processing instead to maintain chat lists in a consistent state
+//
+// Available to users only
+func (client *Client) GetChats(ctx context.Context, req *GetChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type SearchPublicChatRequest struct {
+	request
+	// Username to be resolved
+	Username string `json:"username"`
+}
+
+func (req SearchPublicChatRequest) GetFunctionName() string {
+	return "searchPublicChat"
+}
+
+func (req *SearchPublicChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchPublicChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchPublicChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw("}")
+}
+
+func (req SearchPublicChatRequest) Validate() error {
+	return nil
+}
+
+// Searches a public chat by its username. Currently, only private chats, supergroups and channels can be public. Returns the chat if found; otherwise, an error is returned
+func (client *Client) SearchPublicChat(ctx context.Context, req *SearchPublicChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type SearchPublicChatsRequest struct {
+	request
+	// Query to search for
+	Query string `json:"query"`
+}
+
+func (req SearchPublicChatsRequest) GetFunctionName() string {
+	return "searchPublicChats"
+}
+
+func (req *SearchPublicChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchPublicChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchPublicChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw("}")
+}
+
+func (req SearchPublicChatsRequest) Validate() error {
+	return nil
+}
+
+// Searches public chats by looking for specified query in their username and title. Currently, only private chats, supergroups and channels can be public. Returns a meaningful number of results. Excludes private chats with contacts and chats from the chat list from the results
+//
+// Available to users only
+func (client *Client) SearchPublicChats(ctx context.Context, req *SearchPublicChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type SearchChatsRequest struct {
+	request
+	// Query to search for. If the query is empty, returns up to 50 recently found chats
+	Query string `json:"query"`
+	// The maximum number of chats to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchChatsRequest) GetFunctionName() string {
+	return "searchChats"
+}
+
+func (req *SearchChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchChatsRequest) Validate() error {
+	return nil
+}
+
+// Searches for the specified query in the title and username of already known chats. This is an offline method. Returns chats in the order seen in the main chat list
+//
+// Available to users only
+func (client *Client) SearchChats(ctx context.Context, req *SearchChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type SearchChatsOnServerRequest struct {
+	request
+	// Query to search for
+	Query string `json:"query"`
+	// The maximum number of chats to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchChatsOnServerRequest) GetFunctionName() string {
+	return "searchChatsOnServer"
+}
+
+func (req *SearchChatsOnServerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchChatsOnServerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchChatsOnServer"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchChatsOnServerRequest) Validate() error {
+	return nil
+}
+
+// Searches for the specified query in the title and username of already known chats via request to the server. Returns chats in the order seen in the main chat list
+//
+// Available to users only
+func (client *Client) SearchChatsOnServer(ctx context.Context, req *SearchChatsOnServerRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetRecommendedChatsRequest struct {
+	request
+}
+
+func (req GetRecommendedChatsRequest) GetFunctionName() string {
+	return "getRecommendedChats"
+}
+
+func (req *GetRecommendedChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecommendedChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecommendedChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecommendedChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of channel chats recommended to the current user
+//
+// Available to users only
+func (client *Client) GetRecommendedChats(ctx context.Context) (*Chats, error) {
+	req := &GetRecommendedChatsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetChatSimilarChatsRequest struct {
+	request
+	// Identifier of the target chat; must be an identifier of a channel chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatSimilarChatsRequest) GetFunctionName() string {
+	return "getChatSimilarChats"
+}
+
+func (req *GetChatSimilarChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatSimilarChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatSimilarChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatSimilarChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of chats similar to the given chat
+//
+// Available to users only
+func (client *Client) GetChatSimilarChats(ctx context.Context, req *GetChatSimilarChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetChatSimilarChatCountRequest struct {
+	request
+	// Identifier of the target chat; must be an identifier of a channel chat
+	ChatId int64 `json:"chat_id"`
+	// Pass true to get the number of chats without sending network requests, or -1 if the number of chats is unknown locally
+	ReturnLocal bool `json:"return_local"`
+}
+
+func (req GetChatSimilarChatCountRequest) GetFunctionName() string {
+	return "getChatSimilarChatCount"
+}
+
+func (req *GetChatSimilarChatCountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatSimilarChatCountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatSimilarChatCount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"return_local":`)
+	encoder.writeBool(req.ReturnLocal)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatSimilarChatCountRequest) Validate() error {
+	return nil
+}
+
+// Returns approximate number of chats similar to the given chat
+//
+// Available to users only
+func (client *Client) GetChatSimilarChatCount(ctx context.Context, req *GetChatSimilarChatCountRequest) (*Count, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCount)
+}
+
+type OpenChatSimilarChatRequest struct {
+	request
+	// Identifier of the original chat, which similar chats were requested
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the opened chat
+	OpenedChatId int64 `json:"opened_chat_id"`
+}
+
+func (req OpenChatSimilarChatRequest) GetFunctionName() string {
+	return "openChatSimilarChat"
+}
+
+func (req *OpenChatSimilarChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OpenChatSimilarChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"openChatSimilarChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"opened_chat_id":`)
+	encoder.writeInt64(req.OpenedChatId)
+	encoder.writeRaw("}")
+}
+
+func (req OpenChatSimilarChatRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that a chat was opened from the list of similar chats. The method is independent of openChat and closeChat methods
+//
+// Available to users only
+func (client *Client) OpenChatSimilarChat(ctx context.Context, req *OpenChatSimilarChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetTopChatsRequest struct {
+	request
+	// Category of chats to be returned
+	Category TopChatCategory `json:"category"`
+	// The maximum number of chats to be returned; up to 30
+	Limit int32 `json:"limit"`
+}
+
+func (req GetTopChatsRequest) GetFunctionName() string {
+	return "getTopChats"
+}
+
+func (req *GetTopChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetTopChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getTopChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"category":`)
+	encoder.writeType(req.Category)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetTopChatsRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 30); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns a list of frequently used chats
+//
+// Available to users only
+func (client *Client) GetTopChats(ctx context.Context, req *GetTopChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type RemoveTopChatRequest struct {
+	request
+	// Category of frequently used chats
+	Category TopChatCategory `json:"category"`
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req RemoveTopChatRequest) GetFunctionName() string {
+	return "removeTopChat"
+}
+
+func (req *RemoveTopChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveTopChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeTopChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"category":`)
+	encoder.writeType(req.Category)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveTopChatRequest) Validate() error {
+	return nil
+}
+
+// Removes a chat from the list of frequently used chats. Supported only if the chat info database is enabled
+//
+// Available to users only
+func (client *Client) RemoveTopChat(ctx context.Context, req *RemoveTopChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SearchRecentlyFoundChatsRequest struct {
+	request
+	// Query to search for
+	Query string `json:"query"`
+	// The maximum number of chats to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchRecentlyFoundChatsRequest) GetFunctionName() string {
+	return "searchRecentlyFoundChats"
+}
+
+func (req *SearchRecentlyFoundChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchRecentlyFoundChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchRecentlyFoundChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchRecentlyFoundChatsRequest) Validate() error {
+	return nil
+}
+
+// Searches for the specified query in the title and username of up to 50 recently found chats. This is an offline method
+//
+// Available to users only
+func (client *Client) SearchRecentlyFoundChats(ctx context.Context, req *SearchRecentlyFoundChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type AddRecentlyFoundChatRequest struct {
+	request
+	// Identifier of the chat to add
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req AddRecentlyFoundChatRequest) GetFunctionName() string {
+	return "addRecentlyFoundChat"
+}
+
+func (req *AddRecentlyFoundChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddRecentlyFoundChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addRecentlyFoundChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req AddRecentlyFoundChatRequest) Validate() error {
+	return nil
+}
+
+// Adds a chat to the list of recently found chats. The chat is added to the beginning of the list. If the chat is already in the list, it will be removed from the list first
+//
+// Available to users only
+func (client *Client) AddRecentlyFoundChat(ctx context.Context, req *AddRecentlyFoundChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveRecentlyFoundChatRequest struct {
+	request
+	// Identifier of the chat to be removed
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req RemoveRecentlyFoundChatRequest) GetFunctionName() string {
+	return "removeRecentlyFoundChat"
+}
+
+func (req *RemoveRecentlyFoundChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveRecentlyFoundChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeRecentlyFoundChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveRecentlyFoundChatRequest) Validate() error {
+	return nil
+}
+
+// Removes a chat from the list of recently found chats
+//
+// Available to users only
+func (client *Client) RemoveRecentlyFoundChat(ctx context.Context, req *RemoveRecentlyFoundChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ClearRecentlyFoundChatsRequest struct {
+	request
+}
+
+func (req ClearRecentlyFoundChatsRequest) GetFunctionName() string {
+	return "clearRecentlyFoundChats"
+}
+
+func (req *ClearRecentlyFoundChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearRecentlyFoundChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearRecentlyFoundChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ClearRecentlyFoundChatsRequest) Validate() error {
+	return nil
+}
+
+// Clears the list of recently found chats
+//
+// Available to users only
+func (client *Client) ClearRecentlyFoundChats(ctx context.Context) (*Ok, error) {
+	req := &ClearRecentlyFoundChatsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetRecentlyOpenedChatsRequest struct {
+	request
+	// The maximum number of chats to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req GetRecentlyOpenedChatsRequest) GetFunctionName() string {
+	return "getRecentlyOpenedChats"
+}
+
+func (req *GetRecentlyOpenedChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecentlyOpenedChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecentlyOpenedChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecentlyOpenedChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns recently opened chats. This is an offline method. Returns chats in the order of last opening
+//
+// Available to users only
+func (client *Client) GetRecentlyOpenedChats(ctx context.Context, req *GetRecentlyOpenedChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type CheckChatUsernameRequest struct {
+	request
+	// Chat identifier; must be identifier of a supergroup chat, or a channel chat, or a private chat with self, or 0 if the chat is being created
+	ChatId int64 `json:"chat_id"`
+	// Username to be checked
+	Username string `json:"username"`
+}
+
+func (req CheckChatUsernameRequest) GetFunctionName() string {
+	return "checkChatUsername"
+}
+
+func (req *CheckChatUsernameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckChatUsernameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatUsername"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw("}")
+}
+
+func (req CheckChatUsernameRequest) Validate() error {
+	return nil
+}
+
+// Checks whether a username can be set for a chat
+//
+// Available to users only
+func (client *Client) CheckChatUsername(ctx context.Context, req *CheckChatUsernameRequest) (CheckChatUsernameResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCheckChatUsernameResult)
+}
+
+type GetCreatedPublicChatsRequest struct {
+	request
+	// Type of the public chats to return
+	Type PublicChatType `json:"type"`
+}
+
+func (req GetCreatedPublicChatsRequest) GetFunctionName() string {
+	return "getCreatedPublicChats"
+}
+
+func (req *GetCreatedPublicChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCreatedPublicChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCreatedPublicChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req GetCreatedPublicChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of public chats of the specified type, owned by the user
+//
+// Available to users only
+func (client *Client) GetCreatedPublicChats(ctx context.Context, req *GetCreatedPublicChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type CheckCreatedPublicChatsLimitRequest struct {
+	request
+	// Type of the public chats, for which to check the limit
+	Type PublicChatType `json:"type"`
+}
+
+func (req CheckCreatedPublicChatsLimitRequest) GetFunctionName() string {
+	return "checkCreatedPublicChatsLimit"
+}
+
+func (req *CheckCreatedPublicChatsLimitRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckCreatedPublicChatsLimitRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkCreatedPublicChatsLimit"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req CheckCreatedPublicChatsLimitRequest) Validate() error {
+	return nil
+}
+
+// Checks whether the maximum number of owned public chats has been reached. Returns corresponding error if the limit was reached. The limit can be increased with Telegram Premium
+//
+// Available to users only
+func (client *Client) CheckCreatedPublicChatsLimit(ctx context.Context, req *CheckCreatedPublicChatsLimitRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetSuitableDiscussionChatsRequest struct {
+	request
+}
+
+func (req GetSuitableDiscussionChatsRequest) GetFunctionName() string {
+	return "getSuitableDiscussionChats"
+}
+
+func (req *GetSuitableDiscussionChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSuitableDiscussionChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSuitableDiscussionChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetSuitableDiscussionChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of basic group and supergroup chats, which can be used as a discussion group for a channel. Returned basic group chats must be first upgraded to supergroups before they can be set as a discussion group. To set a returned supergroup as a discussion group, access to its old messages must be enabled using toggleSupergroupIsAllHistoryAvailable first
+//
+// Available to users only
+func (client *Client) GetSuitableDiscussionChats(ctx context.Context) (*Chats, error) {
+	req := &GetSuitableDiscussionChatsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetInactiveSupergroupChatsRequest struct {
+	request
+}
+
+func (req GetInactiveSupergroupChatsRequest) GetFunctionName() string {
+	return "getInactiveSupergroupChats"
+}
+
+func (req *GetInactiveSupergroupChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetInactiveSupergroupChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getInactiveSupergroupChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetInactiveSupergroupChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of recently inactive supergroups and channels. Can be used when user reaches limit on the number of joined supergroups and channels and receives CHANNELS_TOO_MUCH error. Also, the limit can be increased with Telegram Premium
+//
+// Available to users only
+func (client *Client) GetInactiveSupergroupChats(ctx context.Context) (*Chats, error) {
+	req := &GetInactiveSupergroupChatsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetSuitablePersonalChatsRequest struct {
+	request
+}
+
+func (req GetSuitablePersonalChatsRequest) GetFunctionName() string {
+	return "getSuitablePersonalChats"
+}
+
+func (req *GetSuitablePersonalChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSuitablePersonalChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSuitablePersonalChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetSuitablePersonalChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of channel chats, which can be used as a personal chat
+//
+// Available to users only
+func (client *Client) GetSuitablePersonalChats(ctx context.Context) (*Chats, error) {
+	req := &GetSuitablePersonalChatsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetGroupsInCommonRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Chat identifier starting from which to return chats; use 0 for the first request
+	OffsetChatId int64 `json:"offset_chat_id"`
+	// The maximum number of chats to be returned; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetGroupsInCommonRequest) GetFunctionName() string {
+	return "getGroupsInCommon"
+}
+
+func (req *GetGroupsInCommonRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGroupsInCommonRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGroupsInCommon"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"offset_chat_id":`)
+	encoder.writeInt64(req.OffsetChatId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetGroupsInCommonRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns a list of common group chats with a given user. Chats are sorted by their type and creation date
+//
+// Available to users only
+func (client *Client) GetGroupsInCommon(ctx context.Context, req *GetGroupsInCommonRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetChatHistoryRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message starting from which history must be fetched; use 0 to get results from the last message
+	FromMessageId int64 `json:"from_message_id"`
+	// Specify 0 to get results from exactly the message from_message_id or a negative offset up to 99 to get additionally some newer messages
+	Offset int32 `json:"offset"`
+	// The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than or equal to -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+	// Pass true to get only messages that are available without sending network requests
+	OnlyLocal bool `json:"only_local"`
+}
+
+func (req GetChatHistoryRequest) GetFunctionName() string {
+	return "getChatHistory"
+}
+
+func (req *GetChatHistoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatHistoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatHistory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"from_message_id":`)
+	encoder.writeInt64(req.FromMessageId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw(`,"only_local":`)
+	encoder.writeBool(req.OnlyLocal)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatHistoryRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns messages in a chat. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib. This is an offline method if only_local is true
+//
+// Available to users only
+func (client *Client) GetChatHistory(ctx context.Context, req *GetChatHistoryRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type DeleteChatHistoryRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Pass true to remove the chat from all chat lists
+	RemoveFromChatList bool `json:"remove_from_chat_list"`
+	// Pass true to delete chat history for all users
+	Revoke bool `json:"revoke"`
+}
+
+func (req DeleteChatHistoryRequest) GetFunctionName() string {
+	return "deleteChatHistory"
+}
+
+func (req *DeleteChatHistoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatHistoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChatHistory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"remove_from_chat_list":`)
+	encoder.writeBool(req.RemoveFromChatList)
+	encoder.writeRaw(`,"revoke":`)
+	encoder.writeBool(req.Revoke)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatHistoryRequest) Validate() error {
+	return nil
+}
+
+// Deletes all messages in the chat. Use chat.can_be_deleted_only_for_self and chat.can_be_deleted_for_all_users fields to find whether and how the method can be applied to the chat
+//
+// Available to users only
+func (client *Client) DeleteChatHistory(ctx context.Context, req *DeleteChatHistoryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req DeleteChatRequest) GetFunctionName() string {
+	return "deleteChat"
+}
+
+func (req *DeleteChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatRequest) Validate() error {
+	return nil
+}
+
+// Deletes a chat along with all messages in the corresponding chat for all chat members. For group chats this will release the usernames and remove all members. Use the field chat.can_be_deleted_for_all_users to find whether the method can be applied to the chat
+//
+// Available to users only
+func (client *Client) DeleteChat(ctx context.Context, req *DeleteChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetSearchSponsoredChatsRequest struct {
+	request
+	// Query the user searches for
+	Query string `json:"query"`
+}
+
+func (req GetSearchSponsoredChatsRequest) GetFunctionName() string {
+	return "getSearchSponsoredChats"
+}
+
+func (req *GetSearchSponsoredChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSearchSponsoredChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSearchSponsoredChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw("}")
+}
+
+func (req GetSearchSponsoredChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns sponsored chats to be shown in the search results
+//
+// Available to users only
+func (client *Client) GetSearchSponsoredChats(ctx context.Context, req *GetSearchSponsoredChatsRequest) (*SponsoredChats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSponsoredChats)
+}
+
+type ViewSponsoredChatRequest struct {
+	request
+	// Unique identifier of the sponsored chat
+	SponsoredChatUniqueId int64 `json:"sponsored_chat_unique_id"`
+}
+
+func (req ViewSponsoredChatRequest) GetFunctionName() string {
+	return "viewSponsoredChat"
+}
+
+func (req *ViewSponsoredChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ViewSponsoredChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"viewSponsoredChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sponsored_chat_unique_id":`)
+	encoder.writeInt64(req.SponsoredChatUniqueId)
+	encoder.writeRaw("}")
+}
+
+func (req ViewSponsoredChatRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the user fully viewed a sponsored chat
+//
+// Available to users only
+func (client *Client) ViewSponsoredChat(ctx context.Context, req *ViewSponsoredChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type OpenSponsoredChatRequest struct {
+	request
+	// Unique identifier of the sponsored chat
+	SponsoredChatUniqueId int64 `json:"sponsored_chat_unique_id"`
+}
+
+func (req OpenSponsoredChatRequest) GetFunctionName() string {
+	return "openSponsoredChat"
+}
+
+func (req *OpenSponsoredChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OpenSponsoredChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"openSponsoredChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sponsored_chat_unique_id":`)
+	encoder.writeInt64(req.SponsoredChatUniqueId)
+	encoder.writeRaw("}")
+}
+
+func (req OpenSponsoredChatRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the user opened a sponsored chat
+//
+// Available to users only
+func (client *Client) OpenSponsoredChat(ctx context.Context, req *OpenSponsoredChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportSponsoredChatRequest struct {
+	request
+	// Unique identifier of the sponsored chat
+	SponsoredChatUniqueId int64 `json:"sponsored_chat_unique_id"`
+	// Option identifier chosen by the user; leave empty for the initial request
+	OptionId []byte `json:"option_id"`
+}
+
+func (req ReportSponsoredChatRequest) GetFunctionName() string {
+	return "reportSponsoredChat"
+}
+
+func (req *ReportSponsoredChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportSponsoredChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSponsoredChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sponsored_chat_unique_id":`)
+	encoder.writeInt64(req.SponsoredChatUniqueId)
+	encoder.writeRaw(`,"option_id":`)
+	encoder.writeBytes(req.OptionId)
+	encoder.writeRaw("}")
+}
+
+func (req ReportSponsoredChatRequest) Validate() error {
+	return nil
+}
+
+// Reports a sponsored chat to Telegram moderators
+//
+// Available to users only
+func (client *Client) ReportSponsoredChat(ctx context.Context, req *ReportSponsoredChatRequest) (ReportSponsoredResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalReportSponsoredResult)
+}
+
+type RemoveNotificationGroupRequest struct {
+	request
+	// Notification group identifier
+	NotificationGroupId int32 `json:"notification_group_id"`
+	// The maximum identifier of removed notifications
+	MaxNotificationId int32 `json:"max_notification_id"`
+}
+
+func (req RemoveNotificationGroupRequest) GetFunctionName() string {
+	return "removeNotificationGroup"
+}
+
+func (req *RemoveNotificationGroupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveNotificationGroupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeNotificationGroup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"notification_group_id":`)
+	encoder.writeInt32(req.NotificationGroupId)
+	encoder.writeRaw(`,"max_notification_id":`)
+	encoder.writeInt32(req.MaxNotificationId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveNotificationGroupRequest) Validate() error {
+	return nil
+}
+
+// Removes a group of active notifications. Needs to be called only if the notification group is removed by the current user
+//
+// Available to users only
+func (client *Client) RemoveNotificationGroup(ctx context.Context, req *RemoveNotificationGroupRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetForumTopicDefaultIconsRequest struct {
+	request
+}
+
+func (req GetForumTopicDefaultIconsRequest) GetFunctionName() string {
+	return "getForumTopicDefaultIcons"
+}
+
+func (req *GetForumTopicDefaultIconsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetForumTopicDefaultIconsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getForumTopicDefaultIcons"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetForumTopicDefaultIconsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of custom emoji, which can be used as forum topic icon by all users
+func (client *Client) GetForumTopicDefaultIcons(ctx context.Context) (*Stickers, error) {
+	req := &GetForumTopicDefaultIconsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type CreateForumTopicRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Name of the topic; 1-128 characters
+	Name string `json:"name"`
+	// Icon of the topic. Icon color must be one of 0x6FB9F0, 0xFFD67E, 0xCB86DB, 0x8EEE98, 0xFF93B2, or 0xFB6F5F. Telegram Premium users can use any custom emoji as topic icon, other users can use only a custom emoji returned by getForumTopicDefaultIcons
+	Icon *ForumTopicIcon `json:"icon"`
+}
+
+func (req CreateForumTopicRequest) GetFunctionName() string {
+	return "createForumTopic"
+}
+
+func (req *CreateForumTopicRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateForumTopicRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createForumTopic"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"icon":`)
+	req.Icon.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req CreateForumTopicRequest) Validate() error {
+	if err := validateLength("name", req.Name, 1, 128); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a topic in a forum supergroup chat; requires can_manage_topics administrator or can_create_topics member right in the supergroup
+func (client *Client) CreateForumTopic(ctx context.Context, req *CreateForumTopicRequest) (*ForumTopicInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalForumTopicInfo)
+}
+
+type EditForumTopicRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// New name of the topic; 0-128 characters. If empty, the previous topic name is kept
+	Name string `json:"name"`
+	// Pass true to edit the icon of the topic. Icon of the General topic can't be edited
+	EditIconCustomEmoji bool `json:"edit_icon_custom_emoji"`
+	// Identifier of the new custom emoji for topic icon; pass 0 to remove the custom emoji. Ignored if edit_icon_custom_emoji is false. Telegram Premium users can use any custom emoji, other users can use only a custom emoji returned by getForumTopicDefaultIcons
+	IconCustomEmojiId JsonInt64 `json:"icon_custom_emoji_id"`
+}
+
+func (req EditForumTopicRequest) GetFunctionName() string {
+	return "editForumTopic"
+}
+
+func (req *EditForumTopicRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditForumTopicRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editForumTopic"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"edit_icon_custom_emoji":`)
+	encoder.writeBool(req.EditIconCustomEmoji)
+	encoder.writeRaw(`,"icon_custom_emoji_id":`)
+	encoder.writeJsonInt64(req.IconCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (req EditForumTopicRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 128); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits title and icon of a topic in a forum supergroup chat; requires can_manage_topics right in the supergroup unless the user is creator of the topic
+func (client *Client) EditForumTopic(ctx context.Context, req *EditForumTopicRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetForumTopicRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (req GetForumTopicRequest) GetFunctionName() string {
+	return "getForumTopic"
+}
+
+func (req *GetForumTopicRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetForumTopicRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getForumTopic"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (req GetForumTopicRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a forum topic
+func (client *Client) GetForumTopic(ctx context.Context, req *GetForumTopicRequest) (*ForumTopic, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalForumTopic)
+}
+
+type GetForumTopicLinkRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (req GetForumTopicLinkRequest) GetFunctionName() string {
+	return "getForumTopicLink"
+}
+
+func (req *GetForumTopicLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetForumTopicLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getForumTopicLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (req GetForumTopicLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS link to a topic in a forum chat. This is an offline method
+func (client *Client) GetForumTopicLink(ctx context.Context, req *GetForumTopicLinkRequest) (*MessageLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageLink)
+}
+
+type GetForumTopicsRequest struct {
+	request
+	// Identifier of the forum chat
+	ChatId int64 `json:"chat_id"`
+	// Query to search for in the forum topic's name
+	Query string `json:"query"`
+	// The date starting from which the results need to be fetched. Use 0 or any date in the future to get results from the last topic
+	OffsetDate int32 `json:"offset_date"`
+	// The message identifier of the last message in the last found topic, or 0 for the first request
+	OffsetMessageId int64 `json:"offset_message_id"`
+	// The message thread identifier of the last found topic, or 0 for the first request
+	OffsetMessageThreadId int64 `json:"offset_message_thread_id"`
+	// The maximum number of forum topics to be returned; up to 100. For optimal performance, the number of returned forum topics is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetForumTopicsRequest) GetFunctionName() string {
+	return "getForumTopics"
+}
+
+func (req *GetForumTopicsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetForumTopicsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getForumTopics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"offset_date":`)
+	encoder.writeInt32(req.OffsetDate)
+	encoder.writeRaw(`,"offset_message_id":`)
+	encoder.writeInt64(req.OffsetMessageId)
+	encoder.writeRaw(`,"offset_message_thread_id":`)
+	encoder.writeInt64(req.OffsetMessageThreadId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetForumTopicsRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns found forum topics in a forum chat. This is a temporary method for getting information about topic list from the server
+//
+// Available to users only
+func (client *Client) GetForumTopics(ctx context.Context, req *GetForumTopicsRequest) (*ForumTopics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalForumTopics)
+}
+
+type SetForumTopicNotificationSettingsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// New notification settings for the forum topic. If the topic is muted for more than 366 days, it is considered to be muted forever
+	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
+}
+
+func (req SetForumTopicNotificationSettingsRequest) GetFunctionName() string {
+	return "setForumTopicNotificationSettings"
+}
+
+func (req *SetForumTopicNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetForumTopicNotificationSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setForumTopicNotificationSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"notification_settings":`)
+	req.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetForumTopicNotificationSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes the notification settings of a forum topic
+//
+// Available to users only
+func (client *Client) SetForumTopicNotificationSettings(ctx context.Context, req *SetForumTopicNotificationSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleForumTopicIsClosedRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Pass true to close the topic; pass false to reopen it
+	IsClosed bool `json:"is_closed"`
+}
+
+func (req ToggleForumTopicIsClosedRequest) GetFunctionName() string {
+	return "toggleForumTopicIsClosed"
+}
+
+func (req *ToggleForumTopicIsClosedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleForumTopicIsClosedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleForumTopicIsClosed"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"is_closed":`)
+	encoder.writeBool(req.IsClosed)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleForumTopicIsClosedRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a topic is closed in a forum supergroup chat; requires can_manage_topics right in the supergroup unless the user is creator of the topic
+func (client *Client) ToggleForumTopicIsClosed(ctx context.Context, req *ToggleForumTopicIsClosedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGeneralForumTopicIsHiddenRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Pass true to hide and close the General topic; pass false to unhide it
+	IsHidden bool `json:"is_hidden"`
+}
+
+func (req ToggleGeneralForumTopicIsHiddenRequest) GetFunctionName() string {
+	return "toggleGeneralForumTopicIsHidden"
+}
+
+func (req *ToggleGeneralForumTopicIsHiddenRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGeneralForumTopicIsHiddenRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGeneralForumTopicIsHidden"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"is_hidden":`)
+	encoder.writeBool(req.IsHidden)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGeneralForumTopicIsHiddenRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a General topic is hidden in a forum supergroup chat; requires can_manage_topics right in the supergroup
+func (client *Client) ToggleGeneralForumTopicIsHidden(ctx context.Context, req *ToggleGeneralForumTopicIsHiddenRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleForumTopicIsPinnedRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Pass true to pin the topic; pass false to unpin it
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (req ToggleForumTopicIsPinnedRequest) GetFunctionName() string {
+	return "toggleForumTopicIsPinned"
+}
+
+func (req *ToggleForumTopicIsPinnedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleForumTopicIsPinnedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleForumTopicIsPinned"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(req.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleForumTopicIsPinnedRequest) Validate() error {
+	return nil
+}
+
+// Changes the pinned state of a forum topic; requires can_manage_topics right in the supergroup. There can be up to getOption("pinned_forum_topic_count_max") pinned forum topics
+//
+// Available to users only
+func (client *Client) ToggleForumTopicIsPinned(ctx context.Context, req *ToggleForumTopicIsPinnedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetPinnedForumTopicsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new list of pinned forum topics
+	MessageThreadIds []int64 `json:"message_thread_ids"`
+}
+
+func (req SetPinnedForumTopicsRequest) GetFunctionName() string {
+	return "setPinnedForumTopics"
+}
+
+func (req *SetPinnedForumTopicsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPinnedForumTopicsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPinnedForumTopics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_ids":`)
+	writeList(encoder, req.MessageThreadIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req SetPinnedForumTopicsRequest) Validate() error {
+	return nil
+}
+
+// Changes the order of pinned forum topics; requires can_manage_topics right in the supergroup
+//
+// Available to users only
+func (client *Client) SetPinnedForumTopics(ctx context.Context, req *SetPinnedForumTopicsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteForumTopicRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (req DeleteForumTopicRequest) GetFunctionName() string {
+	return "deleteForumTopic"
+}
+
+func (req *DeleteForumTopicRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteForumTopicRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteForumTopic"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteForumTopicRequest) Validate() error {
+	return nil
+}
+
+// Deletes all messages in a forum topic; requires can_delete_messages administrator right in the supergroup unless the user is creator of the topic, the topic has no messages from other users and has at most 11 messages
+func (client *Client) DeleteForumTopic(ctx context.Context, req *DeleteForumTopicRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ShareChatWithBotRequest struct {
+	request
+	// Identifier of the chat with the bot
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message with the button
+	MessageId int64 `json:"message_id"`
+	// Identifier of the button
+	ButtonId int32 `json:"button_id"`
+	// Identifier of the shared chat
+	SharedChatId int64 `json:"shared_chat_id"`
+	// Pass true to check that the chat can be shared by the button instead of actually sharing it. Doesn't check bot_is_member and bot_administrator_rights restrictions. If the bot must be a member, then all chats from getGroupsInCommon and all chats, where the user can add the bot, are suitable. In the latter case the bot will be automatically added to the chat. If the bot must be an administrator, then all chats, where the bot already has requested rights or can be added to administrators by the user, are suitable. In the latter case the bot will be automatically granted requested rights
+	OnlyCheck bool `json:"only_check"`
+}
+
+func (req ShareChatWithBotRequest) GetFunctionName() string {
+	return "shareChatWithBot"
+}
+
+func (req *ShareChatWithBotRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ShareChatWithBotRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"shareChatWithBot"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"button_id":`)
+	encoder.writeInt32(req.ButtonId)
+	encoder.writeRaw(`,"shared_chat_id":`)
+	encoder.writeInt64(req.SharedChatId)
+	encoder.writeRaw(`,"only_check":`)
+	encoder.writeBool(req.OnlyCheck)
+	encoder.writeRaw("}")
+}
+
+func (req ShareChatWithBotRequest) Validate() error {
+	return nil
+}
+
+// Shares a chat after pressing a keyboardButtonTypeRequestChat button with the bot
+//
+// Available to users only
+func (client *Client) ShareChatWithBot(ctx context.Context, req *ShareChatWithBotRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendChatActionRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// If not 0, the message thread identifier in which the action was performed
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Unique identifier of business connection on behalf of which to send the request; for bots only
+	BusinessConnectionId string `json:"business_connection_id"`
+	// The action description; pass null to cancel the currently active action
+	Action ChatAction `json:"action,omitempty"`
+}
+
+func (req SendChatActionRequest) GetFunctionName() string {
+	return "sendChatAction"
+}
+
+func (req *SendChatActionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendChatActionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendChatAction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	if req.Action != nil {
+		encoder.writeRaw(`,"action":`)
+		encoder.writeType(req.Action)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SendChatActionRequest) Validate() error {
+	return nil
+}
+
+// Sends a notification about user activity in a chat
+func (client *Client) SendChatAction(ctx context.Context, req *SendChatActionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type OpenChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req OpenChatRequest) GetFunctionName() string {
+	return "openChat"
+}
+
+func (req *OpenChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OpenChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"openChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req OpenChatRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the chat is opened by the user. Many useful activities depend on the chat being opened or closed (e.g., in supergroups and channels all updates are received only for opened chats)
+//
+// Available to users only
+func (client *Client) OpenChat(ctx context.Context, req *OpenChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CloseChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req CloseChatRequest) GetFunctionName() string {
+	return "closeChat"
+}
+
+func (req *CloseChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CloseChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"closeChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req CloseChatRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the chat is closed by the user. Many useful activities depend on the chat being opened or closed
+//
+// Available to users only
+func (client *Client) CloseChat(ctx context.Context, req *CloseChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReadAllChatMentionsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req ReadAllChatMentionsRequest) GetFunctionName() string {
+	return "readAllChatMentions"
+}
+
+func (req *ReadAllChatMentionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReadAllChatMentionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readAllChatMentions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req ReadAllChatMentionsRequest) Validate() error {
+	return nil
+}
+
+// Marks all mentions in a chat as read
+//
+// Available to users only
+func (client *Client) ReadAllChatMentions(ctx context.Context, req *ReadAllChatMentionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CreatePrivateChatRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Pass true to create the chat without a network request. In this case all information about the chat except its type, title and photo can be incorrect
+	Force bool `json:"force"`
+}
+
+func (req CreatePrivateChatRequest) GetFunctionName() string {
+	return "createPrivateChat"
+}
+
+func (req *CreatePrivateChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreatePrivateChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createPrivateChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"force":`)
+	encoder.writeBool(req.Force)
+	encoder.writeRaw("}")
+}
+
+func (req CreatePrivateChatRequest) Validate() error {
+	return nil
+}
+
+// Returns an existing chat corresponding to a given user
+func (client *Client) CreatePrivateChat(ctx context.Context, req *CreatePrivateChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type CreateBasicGroupChatRequest struct {
+	request
+	// Basic group identifier
+	BasicGroupId int64 `json:"basic_group_id"`
+	// Pass true to create the chat without a network request. In this case all information about the chat except its type, title and photo can be incorrect
+	Force bool `json:"force"`
+}
+
+func (req CreateBasicGroupChatRequest) GetFunctionName() string {
+	return "createBasicGroupChat"
+}
+
+func (req *CreateBasicGroupChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateBasicGroupChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createBasicGroupChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"basic_group_id":`)
+	encoder.writeInt64(req.BasicGroupId)
+	encoder.writeRaw(`,"force":`)
+	encoder.writeBool(req.Force)
+	encoder.writeRaw("}")
+}
+
+func (req CreateBasicGroupChatRequest) Validate() error {
+	return nil
+}
+
+// Returns an existing chat corresponding to a known basic group
+func (client *Client) CreateBasicGroupChat(ctx context.Context, req *CreateBasicGroupChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type CreateSupergroupChatRequest struct {
+	request
+	// Supergroup or channel identifier
+	SupergroupId int64 `json:"supergroup_id"`
+	// Pass true to create the chat without a network request. In this case all information about the chat except its type, title and photo can be incorrect
+	Force bool `json:"force"`
+}
+
+func (req CreateSupergroupChatRequest) GetFunctionName() string {
+	return "createSupergroupChat"
+}
+
+func (req *CreateSupergroupChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateSupergroupChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createSupergroupChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"force":`)
+	encoder.writeBool(req.Force)
+	encoder.writeRaw("}")
+}
+
+func (req CreateSupergroupChatRequest) Validate() error {
+	return nil
+}
+
+// Returns an existing chat corresponding to a known supergroup or channel
+func (client *Client) CreateSupergroupChat(ctx context.Context, req *CreateSupergroupChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type CreateSecretChatRequest struct {
+	request
+	// Secret chat identifier
+	SecretChatId int32 `json:"secret_chat_id"`
+}
+
+func (req CreateSecretChatRequest) GetFunctionName() string {
+	return "createSecretChat"
+}
+
+func (req *CreateSecretChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateSecretChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createSecretChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"secret_chat_id":`)
+	encoder.writeInt32(req.SecretChatId)
+	encoder.writeRaw("}")
+}
+
+func (req CreateSecretChatRequest) Validate() error {
+	return nil
+}
+
+// Returns an existing chat corresponding to a known secret chat
+func (client *Client) CreateSecretChat(ctx context.Context, req *CreateSecretChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type CreateNewBasicGroupChatRequest struct {
+	request
+	// Identifiers of users to be added to the basic group; may be empty to create a basic group without other members
+	UserIds []int64 `json:"user_ids"`
+	// Title of the new basic group; 1-128 characters
+	Title string `json:"title"`
+	// Message auto-delete time value, in seconds; must be from 0 up to 365 * 86400 and be divisible by 86400. If 0, then messages aren't deleted automatically
+	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
+}
+
+func (req CreateNewBasicGroupChatRequest) GetFunctionName() string {
+	return "createNewBasicGroupChat"
+}
+
+func (req *CreateNewBasicGroupChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateNewBasicGroupChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createNewBasicGroupChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, req.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw(`,"message_auto_delete_time":`)
+	encoder.writeInt32(req.MessageAutoDeleteTime)
+	encoder.writeRaw("}")
+}
+
+func (req CreateNewBasicGroupChatRequest) Validate() error {
+	if err := validateLength("title", req.Title, 1, 128); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a new basic group and sends a corresponding messageBasicGroupChatCreate. Returns information about the newly created chat
+//
+// Available to users only
+func (client *Client) CreateNewBasicGroupChat(ctx context.Context, req *CreateNewBasicGroupChatRequest) (*CreatedBasicGroupChat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCreatedBasicGroupChat)
+}
+
+type CreateNewSupergroupChatRequest struct {
+	request
+	// Title of the new chat; 1-128 characters
+	Title string `json:"title"`
+	// Pass true to create a forum supergroup chat
+	IsForum bool `json:"is_forum"`
+	// Pass true to create a channel chat; ignored if a forum is created
+	IsChannel bool `json:"is_channel"`
+	// Chat description; 0-255 characters
+	Description string `json:"description"`
+	// Chat location if a location-based supergroup is being created; pass null to create an ordinary supergroup chat
+	Location *ChatLocation `json:"location,omitempty"`
+	// Message auto-delete time value, in seconds; must be from 0 up to 365 * 86400 and be divisible by 86400. If 0, then messages aren't deleted automatically
+	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
+	// Pass true to create a supergroup for importing messages using importMessages
+	ForImport bool `json:"for_import"`
+}
+
+func (req CreateNewSupergroupChatRequest) GetFunctionName() string {
+	return "createNewSupergroupChat"
+}
+
+func (req *CreateNewSupergroupChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateNewSupergroupChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createNewSupergroupChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw(`,"is_forum":`)
+	encoder.writeBool(req.IsForum)
+	encoder.writeRaw(`,"is_channel":`)
+	encoder.writeBool(req.IsChannel)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(req.Description)
+	if req.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		req.Location.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"message_auto_delete_time":`)
+	encoder.writeInt32(req.MessageAutoDeleteTime)
+	encoder.writeRaw(`,"for_import":`)
+	encoder.writeBool(req.ForImport)
+	encoder.writeRaw("}")
+}
+
+func (req CreateNewSupergroupChatRequest) Validate() error {
+	if err := validateLength("title", req.Title, 1, 128); err != nil {
+		return err
+	}
+
+	if err := validateLength("description", req.Description, 0, 255); err != nil {
+		return err
+	}
+
+	if err := validateNested("location", req.Location); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a new supergroup or channel and sends a corresponding messageSupergroupChatCreate. Returns the newly created chat
+//
+// Available to users only
+func (client *Client) CreateNewSupergroupChat(ctx context.Context, req *CreateNewSupergroupChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type CreateNewSecretChatRequest struct {
+	request
+	// Identifier of the target user
+	UserId int64 `json:"user_id"`
+}
+
+func (req CreateNewSecretChatRequest) GetFunctionName() string {
+	return "createNewSecretChat"
+}
+
+func (req *CreateNewSecretChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateNewSecretChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createNewSecretChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req CreateNewSecretChatRequest) Validate() error {
+	return nil
+}
+
+// Creates a new secret chat. Returns the newly created chat
+//
+// Available to users only
+func (client *Client) CreateNewSecretChat(ctx context.Context, req *CreateNewSecretChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type UpgradeBasicGroupChatToSupergroupChatRequest struct {
+	request
+	// Identifier of the chat to upgrade
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req UpgradeBasicGroupChatToSupergroupChatRequest) GetFunctionName() string {
+	return "upgradeBasicGroupChatToSupergroupChat"
+}
+
+func (req *UpgradeBasicGroupChatToSupergroupChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *UpgradeBasicGroupChatToSupergroupChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradeBasicGroupChatToSupergroupChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req UpgradeBasicGroupChatToSupergroupChatRequest) Validate() error {
+	return nil
+}
+
+// Creates a new supergroup from an existing basic group and sends a corresponding messageChatUpgradeTo and messageChatUpgradeFrom; requires owner privileges. Deactivates the original basic group
+//
+// Available to users only
+func (client *Client) UpgradeBasicGroupChatToSupergroupChat(ctx context.Context, req *UpgradeBasicGroupChatToSupergroupChatRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type GetChatListsToAddChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatListsToAddChatRequest) GetFunctionName() string {
+	return "getChatListsToAddChat"
+}
+
+func (req *GetChatListsToAddChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatListsToAddChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatListsToAddChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatListsToAddChatRequest) Validate() error {
+	return nil
+}
+
+// Returns chat lists to which the chat can be added. This is an offline method
+//
+// Available to users only
+func (client *Client) GetChatListsToAddChat(ctx context.Context, req *GetChatListsToAddChatRequest) (*ChatLists, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatLists)
+}
+
+type AddChatToListRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The chat list. Use getChatListsToAddChat to get suitable chat lists
+	ChatList ChatList `json:"chat_list"`
+}
+
+func (req AddChatToListRequest) GetFunctionName() string {
+	return "addChatToList"
+}
+
+func (req *AddChatToListRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddChatToListRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addChatToList"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(req.ChatList)
+	encoder.writeRaw("}")
+}
+
+func (req AddChatToListRequest) Validate() error {
+	return nil
+}
+
+// Adds a chat to a chat list. A chat can't be simultaneously in Main and Archive chat lists, so it is automatically removed from another one if needed
+//
+// Available to users only
+func (client *Client) AddChatToList(ctx context.Context, req *AddChatToListRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatFolderRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+}
+
+func (req GetChatFolderRequest) GetFunctionName() string {
+	return "getChatFolder"
+}
+
+func (req *GetChatFolderRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatFolderRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatFolder"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatFolderRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a chat folder by its identifier
+//
+// Available to users only
+func (client *Client) GetChatFolder(ctx context.Context, req *GetChatFolderRequest) (*ChatFolder, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolder)
+}
+
+type CreateChatFolderRequest struct {
+	request
+	// The new chat folder
+	Folder *ChatFolder `json:"folder"`
+}
+
+func (req CreateChatFolderRequest) GetFunctionName() string {
+	return "createChatFolder"
+}
+
+func (req *CreateChatFolderRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateChatFolderRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createChatFolder"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"folder":`)
+	req.Folder.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req CreateChatFolderRequest) Validate() error {
+	if err := validateNested("folder", req.Folder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates new chat folder. Returns information about the created chat folder. There can be up to getOption("chat_folder_count_max") chat folders, but the limit can be increased with Telegram Premium
+//
+// Available to users only
+func (client *Client) CreateChatFolder(ctx context.Context, req *CreateChatFolderRequest) (*ChatFolderInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolderInfo)
+}
+
+type EditChatFolderRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+	// The edited chat folder
+	Folder *ChatFolder `json:"folder"`
+}
+
+func (req EditChatFolderRequest) GetFunctionName() string {
+	return "editChatFolder"
+}
+
+func (req *EditChatFolderRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditChatFolderRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editChatFolder"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw(`,"folder":`)
+	req.Folder.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req EditChatFolderRequest) Validate() error {
+	if err := validateNested("folder", req.Folder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits existing chat folder. Returns information about the edited chat folder
+//
+// Available to users only
+func (client *Client) EditChatFolder(ctx context.Context, req *EditChatFolderRequest) (*ChatFolderInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolderInfo)
+}
+
+type DeleteChatFolderRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+	// Identifiers of the chats to leave. The chats must be pinned or always included in the folder
+	LeaveChatIds []int64 `json:"leave_chat_ids"`
+}
+
+func (req DeleteChatFolderRequest) GetFunctionName() string {
+	return "deleteChatFolder"
+}
+
+func (req *DeleteChatFolderRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatFolderRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChatFolder"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw(`,"leave_chat_ids":`)
+	writeList(encoder, req.LeaveChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatFolderRequest) Validate() error {
+	return nil
+}
+
+// Deletes existing chat folder
+//
+// Available to users only
+func (client *Client) DeleteChatFolder(ctx context.Context, req *DeleteChatFolderRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatFolderChatsToLeaveRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+}
+
+func (req GetChatFolderChatsToLeaveRequest) GetFunctionName() string {
+	return "getChatFolderChatsToLeave"
+}
+
+func (req *GetChatFolderChatsToLeaveRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatFolderChatsToLeaveRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatFolderChatsToLeave"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatFolderChatsToLeaveRequest) Validate() error {
+	return nil
+}
+
+// Returns identifiers of pinned or always included chats from a chat folder, which are suggested to be left when the chat folder is deleted
+//
+// Available to users only
+func (client *Client) GetChatFolderChatsToLeave(ctx context.Context, req *GetChatFolderChatsToLeaveRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type GetChatFolderChatCountRequest struct {
+	request
+	// The new chat folder
+	Folder *ChatFolder `json:"folder"`
+}
+
+func (req GetChatFolderChatCountRequest) GetFunctionName() string {
+	return "getChatFolderChatCount"
+}
+
+func (req *GetChatFolderChatCountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatFolderChatCountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatFolderChatCount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"folder":`)
+	req.Folder.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatFolderChatCountRequest) Validate() error {
+	if err := validateNested("folder", req.Folder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns approximate number of chats in a being created chat folder. Main and archive chat lists must be fully preloaded for this function to work correctly
+//
+// Available to users only
+func (client *Client) GetChatFolderChatCount(ctx context.Context, req *GetChatFolderChatCountRequest) (*Count, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCount)
+}
+
+type ReorderChatFoldersRequest struct {
+	request
+	// Identifiers of chat folders in the new correct order
+	ChatFolderIds []int32 `json:"chat_folder_ids"`
+	// Position of the main chat list among chat folders, 0-based. Can be non-zero only for Premium users
+	MainChatListPosition int32 `json:"main_chat_list_position"`
+}
+
+func (req ReorderChatFoldersRequest) GetFunctionName() string {
+	return "reorderChatFolders"
+}
+
+func (req *ReorderChatFoldersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReorderChatFoldersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reorderChatFolders"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_ids":`)
+	writeList(encoder, req.ChatFolderIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"main_chat_list_position":`)
+	encoder.writeInt32(req.MainChatListPosition)
+	encoder.writeRaw("}")
+}
+
+func (req ReorderChatFoldersRequest) Validate() error {
+	return nil
+}
+
+// Changes the order of chat folders
+//
+// Available to users only
+func (client *Client) ReorderChatFolders(ctx context.Context, req *ReorderChatFoldersRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleChatFolderTagsRequest struct {
+	request
+	// Pass true to enable folder tags; pass false to disable them
+	AreTagsEnabled bool `json:"are_tags_enabled"`
+}
+
+func (req ToggleChatFolderTagsRequest) GetFunctionName() string {
+	return "toggleChatFolderTags"
+}
+
+func (req *ToggleChatFolderTagsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatFolderTagsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatFolderTags"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"are_tags_enabled":`)
+	encoder.writeBool(req.AreTagsEnabled)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatFolderTagsRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether chat folder tags are enabled
+//
+// Available to users only
+func (client *Client) ToggleChatFolderTags(ctx context.Context, req *ToggleChatFolderTagsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetRecommendedChatFoldersRequest struct {
+	request
+}
+
+func (req GetRecommendedChatFoldersRequest) GetFunctionName() string {
+	return "getRecommendedChatFolders"
+}
+
+func (req *GetRecommendedChatFoldersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecommendedChatFoldersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecommendedChatFolders"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecommendedChatFoldersRequest) Validate() error {
+	return nil
+}
+
+// Returns recommended chat folders for the current user
+//
+// Available to users only
+func (client *Client) GetRecommendedChatFolders(ctx context.Context) (*RecommendedChatFolders, error) {
+	req := &GetRecommendedChatFoldersRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalRecommendedChatFolders)
+}
+
+type GetChatFolderDefaultIconNameRequest struct {
+	request
+	// Chat folder
+	Folder *ChatFolder `json:"folder"`
+}
+
+func (req GetChatFolderDefaultIconNameRequest) GetFunctionName() string {
+	return "getChatFolderDefaultIconName"
+}
+
+func (req *GetChatFolderDefaultIconNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatFolderDefaultIconNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatFolderDefaultIconName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"folder":`)
+	req.Folder.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatFolderDefaultIconNameRequest) Validate() error {
+	if err := validateNested("folder", req.Folder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns default icon name for a folder. Can be called synchronously
+func GetChatFolderDefaultIconName(req *GetChatFolderDefaultIconNameRequest) (*ChatFolderIcon, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolderIcon)
+}
+
+// deprecated
+// Returns default icon name for a folder. Can be called synchronously
+func (client *Client) GetChatFolderDefaultIconName(req *GetChatFolderDefaultIconNameRequest) (*ChatFolderIcon, error) {
+	return GetChatFolderDefaultIconName(req)
+}
+
+type GetChatsForChatFolderInviteLinkRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+}
+
+func (req GetChatsForChatFolderInviteLinkRequest) GetFunctionName() string {
+	return "getChatsForChatFolderInviteLink"
+}
+
+func (req *GetChatsForChatFolderInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatsForChatFolderInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatsForChatFolderInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatsForChatFolderInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns identifiers of chats from a chat folder, suitable for adding to a chat folder invite link
+//
+// Available to users only
+func (client *Client) GetChatsForChatFolderInviteLink(ctx context.Context, req *GetChatsForChatFolderInviteLinkRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type CreateChatFolderInviteLinkRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+	// Name of the link; 0-32 characters
+	Name string `json:"name"`
+	// Identifiers of chats to be accessible by the invite link. Use getChatsForChatFolderInviteLink to get suitable chats. Basic groups will be automatically converted to supergroups before link creation
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (req CreateChatFolderInviteLinkRequest) GetFunctionName() string {
+	return "createChatFolderInviteLink"
+}
+
+func (req *CreateChatFolderInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateChatFolderInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createChatFolderInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, req.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req CreateChatFolderInviteLinkRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a new invite link for a chat folder. A link can be created for a chat folder if it has only pinned and included chats
+//
+// Available to users only
+func (client *Client) CreateChatFolderInviteLink(ctx context.Context, req *CreateChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolderInviteLink)
+}
+
+type GetChatFolderInviteLinksRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+}
+
+func (req GetChatFolderInviteLinksRequest) GetFunctionName() string {
+	return "getChatFolderInviteLinks"
+}
+
+func (req *GetChatFolderInviteLinksRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatFolderInviteLinksRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatFolderInviteLinks"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatFolderInviteLinksRequest) Validate() error {
+	return nil
+}
+
+// Returns invite links created by the current user for a shareable chat folder
+//
+// Available to users only
+func (client *Client) GetChatFolderInviteLinks(ctx context.Context, req *GetChatFolderInviteLinksRequest) (*ChatFolderInviteLinks, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolderInviteLinks)
+}
+
+type EditChatFolderInviteLinkRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+	// Invite link to be edited
+	InviteLink string `json:"invite_link"`
+	// New name of the link; 0-32 characters
+	Name string `json:"name"`
+	// New identifiers of chats to be accessible by the invite link. Use getChatsForChatFolderInviteLink to get suitable chats. Basic groups will be automatically converted to supergroups before link editing
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (req EditChatFolderInviteLinkRequest) GetFunctionName() string {
+	return "editChatFolderInviteLink"
+}
+
+func (req *EditChatFolderInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditChatFolderInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editChatFolderInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, req.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req EditChatFolderInviteLinkRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits an invite link for a chat folder
+//
+// Available to users only
+func (client *Client) EditChatFolderInviteLink(ctx context.Context, req *EditChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolderInviteLink)
+}
+
+type DeleteChatFolderInviteLinkRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+	// Invite link to be deleted
+	InviteLink string `json:"invite_link"`
+}
+
+func (req DeleteChatFolderInviteLinkRequest) GetFunctionName() string {
+	return "deleteChatFolderInviteLink"
+}
+
+func (req *DeleteChatFolderInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatFolderInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChatFolderInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatFolderInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Deletes an invite link for a chat folder
+//
+// Available to users only
+func (client *Client) DeleteChatFolderInviteLink(ctx context.Context, req *DeleteChatFolderInviteLinkRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckChatFolderInviteLinkRequest struct {
+	request
+	// Invite link to be checked
+	InviteLink string `json:"invite_link"`
+}
+
+func (req CheckChatFolderInviteLinkRequest) GetFunctionName() string {
+	return "checkChatFolderInviteLink"
+}
+
+func (req *CheckChatFolderInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckChatFolderInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatFolderInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (req CheckChatFolderInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Checks the validity of an invite link for a chat folder and returns information about the corresponding chat folder
+//
+// Available to users only
+func (client *Client) CheckChatFolderInviteLink(ctx context.Context, req *CheckChatFolderInviteLinkRequest) (*ChatFolderInviteLinkInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatFolderInviteLinkInfo)
+}
+
+type AddChatFolderByInviteLinkRequest struct {
+	request
+	// Invite link for the chat folder
+	InviteLink string `json:"invite_link"`
+	// Identifiers of the chats added to the chat folder. The chats are automatically joined if they aren't joined yet
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (req AddChatFolderByInviteLinkRequest) GetFunctionName() string {
+	return "addChatFolderByInviteLink"
+}
+
+func (req *AddChatFolderByInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddChatFolderByInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addChatFolderByInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, req.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req AddChatFolderByInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Adds a chat folder by an invite link
+//
+// Available to users only
+func (client *Client) AddChatFolderByInviteLink(ctx context.Context, req *AddChatFolderByInviteLinkRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatFolderNewChatsRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+}
+
+func (req GetChatFolderNewChatsRequest) GetFunctionName() string {
+	return "getChatFolderNewChats"
+}
+
+func (req *GetChatFolderNewChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatFolderNewChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatFolderNewChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatFolderNewChatsRequest) Validate() error {
+	return nil
+}
+
+// Returns new chats added to a shareable chat folder by its owner. The method must be called at most once in getOption("chat_folder_new_chats_update_period") for the given chat folder
+//
+// Available to users only
+func (client *Client) GetChatFolderNewChats(ctx context.Context, req *GetChatFolderNewChatsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type ProcessChatFolderNewChatsRequest struct {
+	request
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+	// Identifiers of the new chats, which are added to the chat folder. The chats are automatically joined if they aren't joined yet
+	AddedChatIds []int64 `json:"added_chat_ids"`
+}
+
+func (req ProcessChatFolderNewChatsRequest) GetFunctionName() string {
+	return "processChatFolderNewChats"
+}
+
+func (req *ProcessChatFolderNewChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ProcessChatFolderNewChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"processChatFolderNewChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(req.ChatFolderId)
+	encoder.writeRaw(`,"added_chat_ids":`)
+	writeList(encoder, req.AddedChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req ProcessChatFolderNewChatsRequest) Validate() error {
+	return nil
+}
+
+// Process new chats added to a shareable chat folder by its owner
+//
+// Available to users only
+func (client *Client) ProcessChatFolderNewChats(ctx context.Context, req *ProcessChatFolderNewChatsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetArchiveChatListSettingsRequest struct {
+	request
+}
+
+func (req GetArchiveChatListSettingsRequest) GetFunctionName() string {
+	return "getArchiveChatListSettings"
+}
+
+func (req *GetArchiveChatListSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetArchiveChatListSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getArchiveChatListSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetArchiveChatListSettingsRequest) Validate() error {
+	return nil
+}
+
+// Returns settings for automatic moving of chats to and from the Archive chat lists
+//
+// Available to users only
+func (client *Client) GetArchiveChatListSettings(ctx context.Context) (*ArchiveChatListSettings, error) {
+	req := &GetArchiveChatListSettingsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalArchiveChatListSettings)
+}
+
+type SetArchiveChatListSettingsRequest struct {
+	request
+	// New settings
+	Settings *ArchiveChatListSettings `json:"settings"`
+}
+
+func (req SetArchiveChatListSettingsRequest) GetFunctionName() string {
+	return "setArchiveChatListSettings"
+}
+
+func (req *SetArchiveChatListSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetArchiveChatListSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setArchiveChatListSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"settings":`)
+	req.Settings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetArchiveChatListSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes settings for automatic moving of chats to and from the Archive chat lists
+//
+// Available to users only
+func (client *Client) SetArchiveChatListSettings(ctx context.Context, req *SetArchiveChatListSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatTitleRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New title of the chat; 1-128 characters
+	Title string `json:"title"`
+}
+
+func (req SetChatTitleRequest) GetFunctionName() string {
+	return "setChatTitle"
+}
+
+func (req *SetChatTitleRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatTitleRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatTitle"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatTitleRequest) Validate() error {
+	if err := validateLength("title", req.Title, 1, 128); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes the chat title. Supported only for basic groups, supergroups and channels. Requires can_change_info member right
+func (client *Client) SetChatTitle(ctx context.Context, req *SetChatTitleRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatPhotoRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New chat photo; pass null to delete the chat photo
+	Photo InputChatPhoto `json:"photo,omitempty"`
+}
+
+func (req SetChatPhotoRequest) GetFunctionName() string {
+	return "setChatPhoto"
+}
+
+func (req *SetChatPhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatPhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatPhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	if req.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		encoder.writeType(req.Photo)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetChatPhotoRequest) Validate() error {
+	return nil
+}
+
+// Changes the photo of a chat. Supported only for basic groups, supergroups and channels. Requires can_change_info member right
+func (client *Client) SetChatPhoto(ctx context.Context, req *SetChatPhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatAccentColorRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the accent color to use. The chat must have at least accentColor.min_channel_chat_boost_level boost level to pass the corresponding color
+	AccentColorId int32 `json:"accent_color_id"`
+	// Identifier of a custom emoji to be shown on the reply header and link preview background; 0 if none. Use chatBoostLevelFeatures.can_set_background_custom_emoji to check whether a custom emoji can be set
+	BackgroundCustomEmojiId JsonInt64 `json:"background_custom_emoji_id"`
+}
+
+func (req SetChatAccentColorRequest) GetFunctionName() string {
+	return "setChatAccentColor"
+}
+
+func (req *SetChatAccentColorRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatAccentColorRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatAccentColor"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(req.AccentColorId)
+	encoder.writeRaw(`,"background_custom_emoji_id":`)
+	encoder.writeJsonInt64(req.BackgroundCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatAccentColorRequest) Validate() error {
+	return nil
+}
+
+// Changes accent color and background custom emoji of a channel chat. Requires can_change_info administrator right
+//
+// Available to users only
+func (client *Client) SetChatAccentColor(ctx context.Context, req *SetChatAccentColorRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatProfileAccentColorRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the accent color to use for profile; pass -1 if none. The chat must have at least profileAccentColor.min_supergroup_chat_boost_level for supergroups or profileAccentColor.min_channel_chat_boost_level for channels boost level to pass the corresponding color
+	ProfileAccentColorId int32 `json:"profile_accent_color_id"`
+	// Identifier of a custom emoji to be shown on the chat's profile photo background; 0 if none. Use chatBoostLevelFeatures.can_set_profile_background_custom_emoji to check whether a custom emoji can be set
+	ProfileBackgroundCustomEmojiId JsonInt64 `json:"profile_background_custom_emoji_id"`
+}
+
+func (req SetChatProfileAccentColorRequest) GetFunctionName() string {
+	return "setChatProfileAccentColor"
+}
+
+func (req *SetChatProfileAccentColorRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatProfileAccentColorRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatProfileAccentColor"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"profile_accent_color_id":`)
+	encoder.writeInt32(req.ProfileAccentColorId)
+	encoder.writeRaw(`,"profile_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(req.ProfileBackgroundCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatProfileAccentColorRequest) Validate() error {
+	return nil
+}
+
+// Changes accent color and background custom emoji for profile of a supergroup or channel chat. Requires can_change_info administrator right
+//
+// Available to users only
+func (client *Client) SetChatProfileAccentColor(ctx context.Context, req *SetChatProfileAccentColorRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatPermissionsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New non-administrator members permissions in the chat
+	Permissions *ChatPermissions `json:"permissions"`
+}
+
+func (req SetChatPermissionsRequest) GetFunctionName() string {
+	return "setChatPermissions"
+}
+
+func (req *SetChatPermissionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatPermissionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatPermissions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"permissions":`)
+	req.Permissions.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatPermissionsRequest) Validate() error {
+	return nil
+}
+
+// Changes the chat members permissions. Supported only for basic groups and supergroups. Requires can_restrict_members administrator right
+func (client *Client) SetChatPermissions(ctx context.Context, req *SetChatPermissionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatBackgroundRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The input background to use; pass null to create a new filled or chat theme background
+	Background InputBackground `json:"background,omitempty"`
+	// Background type; pass null to use default background type for the chosen background; backgroundTypeChatTheme isn't supported for private and secret chats. Use chatBoostLevelFeatures.chat_theme_background_count and chatBoostLevelFeatures.can_set_custom_background to check whether the background type can be set in the boosted chat
+	Type BackgroundType `json:"type,omitempty"`
+	// Dimming of the background in dark themes, as a percentage; 0-100. Applied only to Wallpaper and Fill types of background
+	DarkThemeDimming int32 `json:"dark_theme_dimming"`
+	// Pass true to set background only for self; pass false to set background for all chat users. Always false for backgrounds set in boosted chats. Background can be set for both users only by Telegram Premium users and if set background isn't of the type inputBackgroundPrevious
+	OnlyForSelf bool `json:"only_for_self"`
+}
+
+func (req SetChatBackgroundRequest) GetFunctionName() string {
+	return "setChatBackground"
+}
+
+func (req *SetChatBackgroundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatBackgroundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatBackground"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	if req.Background != nil {
+		encoder.writeRaw(`,"background":`)
+		encoder.writeType(req.Background)
+	}
+	if req.Type != nil {
+		encoder.writeRaw(`,"type":`)
+		encoder.writeType(req.Type)
+	}
+	encoder.writeRaw(`,"dark_theme_dimming":`)
+	encoder.writeInt32(req.DarkThemeDimming)
+	encoder.writeRaw(`,"only_for_self":`)
+	encoder.writeBool(req.OnlyForSelf)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatBackgroundRequest) Validate() error {
+	return nil
+}
+
+// Sets the background in a specific chat. Supported only in private and secret chats with non-deleted users, and in chats with sufficient boost level and can_change_info administrator right
+//
+// Available to users only
+func (client *Client) SetChatBackground(ctx context.Context, req *SetChatBackgroundRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteChatBackgroundRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Pass true to restore previously set background. Can be used only in private and secret chats with non-deleted users if userFullInfo.set_chat_background == true. Supposed to be used from messageChatSetBackground messages with the currently set background that was set for both sides by the other user
+	RestorePrevious bool `json:"restore_previous"`
+}
+
+func (req DeleteChatBackgroundRequest) GetFunctionName() string {
+	return "deleteChatBackground"
+}
+
+func (req *DeleteChatBackgroundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatBackgroundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChatBackground"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"restore_previous":`)
+	encoder.writeBool(req.RestorePrevious)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatBackgroundRequest) Validate() error {
+	return nil
+}
+
+// Deletes background in a specific chat
+//
+// Available to users only
+func (client *Client) DeleteChatBackground(ctx context.Context, req *DeleteChatBackgroundRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatThemeRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Name of the new chat theme; pass an empty string to return the default theme
+	ThemeName string `json:"theme_name"`
+}
+
+func (req SetChatThemeRequest) GetFunctionName() string {
+	return "setChatTheme"
+}
+
+func (req *SetChatThemeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatThemeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatTheme"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"theme_name":`)
+	encoder.writeString(req.ThemeName)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatThemeRequest) Validate() error {
+	return nil
+}
+
+// Changes the chat theme. Supported only in private and secret chats
+//
+// Available to users only
+func (client *Client) SetChatTheme(ctx context.Context, req *SetChatThemeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatNotificationSettingsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New notification settings for the chat. If the chat is muted for more than 366 days, it is considered to be muted forever
+	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
+}
+
+func (req SetChatNotificationSettingsRequest) GetFunctionName() string {
+	return "setChatNotificationSettings"
+}
+
+func (req *SetChatNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatNotificationSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatNotificationSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"notification_settings":`)
+	req.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatNotificationSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes the notification settings of a chat. Notification settings of a chat with the current user (Saved Messages) can't be changed
+//
+// Available to users only
+func (client *Client) SetChatNotificationSettings(ctx context.Context, req *SetChatNotificationSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleChatHasProtectedContentRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of has_protected_content
+	HasProtectedContent bool `json:"has_protected_content"`
+}
+
+func (req ToggleChatHasProtectedContentRequest) GetFunctionName() string {
+	return "toggleChatHasProtectedContent"
+}
+
+func (req *ToggleChatHasProtectedContentRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatHasProtectedContentRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatHasProtectedContent"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"has_protected_content":`)
+	encoder.writeBool(req.HasProtectedContent)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatHasProtectedContentRequest) Validate() error {
+	return nil
+}
+
+// Changes the ability of users to save, forward, or copy chat content. Supported only for basic groups, supergroups and channels. Requires owner privileges
+//
+// Available to users only
+func (client *Client) ToggleChatHasProtectedContent(ctx context.Context, req *ToggleChatHasProtectedContentRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleChatViewAsTopicsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of view_as_topics
+	ViewAsTopics bool `json:"view_as_topics"`
+}
+
+func (req ToggleChatViewAsTopicsRequest) GetFunctionName() string {
+	return "toggleChatViewAsTopics"
+}
+
+func (req *ToggleChatViewAsTopicsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatViewAsTopicsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatViewAsTopics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"view_as_topics":`)
+	encoder.writeBool(req.ViewAsTopics)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatViewAsTopicsRequest) Validate() error {
+	return nil
+}
+
+// Changes the view_as_topics setting of a forum chat or Saved Messages
+//
+// Available to users only
+func (client *Client) ToggleChatViewAsTopics(ctx context.Context, req *ToggleChatViewAsTopicsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleChatIsTranslatableRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of is_translatable
+	IsTranslatable bool `json:"is_translatable"`
+}
+
+func (req ToggleChatIsTranslatableRequest) GetFunctionName() string {
+	return "toggleChatIsTranslatable"
+}
+
+func (req *ToggleChatIsTranslatableRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatIsTranslatableRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatIsTranslatable"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"is_translatable":`)
+	encoder.writeBool(req.IsTranslatable)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatIsTranslatableRequest) Validate() error {
+	return nil
+}
+
+// Changes the translatable state of a chat
+//
+// Available to users only
+func (client *Client) ToggleChatIsTranslatable(ctx context.Context, req *ToggleChatIsTranslatableRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleChatIsMarkedAsUnreadRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of is_marked_as_unread
+	IsMarkedAsUnread bool `json:"is_marked_as_unread"`
+}
+
+func (req ToggleChatIsMarkedAsUnreadRequest) GetFunctionName() string {
+	return "toggleChatIsMarkedAsUnread"
+}
+
+func (req *ToggleChatIsMarkedAsUnreadRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatIsMarkedAsUnreadRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatIsMarkedAsUnread"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"is_marked_as_unread":`)
+	encoder.writeBool(req.IsMarkedAsUnread)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatIsMarkedAsUnreadRequest) Validate() error {
+	return nil
+}
+
+// Changes the marked as unread state of a chat
+//
+// Available to users only
+func (client *Client) ToggleChatIsMarkedAsUnread(ctx context.Context, req *ToggleChatIsMarkedAsUnreadRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleChatDefaultDisableNotificationRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of default_disable_notification
+	DefaultDisableNotification bool `json:"default_disable_notification"`
+}
+
+func (req ToggleChatDefaultDisableNotificationRequest) GetFunctionName() string {
+	return "toggleChatDefaultDisableNotification"
+}
+
+func (req *ToggleChatDefaultDisableNotificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatDefaultDisableNotificationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatDefaultDisableNotification"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"default_disable_notification":`)
+	encoder.writeBool(req.DefaultDisableNotification)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatDefaultDisableNotificationRequest) Validate() error {
+	return nil
+}
+
+// Changes the value of the default disable_notification parameter, used when a message is sent to a chat
+//
+// Available to users only
+func (client *Client) ToggleChatDefaultDisableNotification(ctx context.Context, req *ToggleChatDefaultDisableNotificationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatClientDataRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of client_data
+	ClientData string `json:"client_data"`
+}
+
+func (req SetChatClientDataRequest) GetFunctionName() string {
+	return "setChatClientData"
+}
+
+func (req *SetChatClientDataRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatClientDataRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatClientData"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"client_data":`)
+	encoder.writeString(req.ClientData)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatClientDataRequest) Validate() error {
+	return nil
+}
+
+// Changes application-specific data associated with a chat
+func (client *Client) SetChatClientData(ctx context.Context, req *SetChatClientDataRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatDescriptionRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// New chat description; 0-255 characters
+	Description string `json:"description"`
+}
+
+func (req SetChatDescriptionRequest) GetFunctionName() string {
+	return "setChatDescription"
+}
+
+func (req *SetChatDescriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatDescriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatDescription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(req.Description)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatDescriptionRequest) Validate() error {
+	if err := validateLength("description", req.Description, 0, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes information about a chat. Available for basic groups, supergroups, and channels. Requires can_change_info member right
+func (client *Client) SetChatDescription(ctx context.Context, req *SetChatDescriptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatDiscussionGroupRequest struct {
+	request
+	// Identifier of the channel chat. Pass 0 to remove a link from the supergroup passed in the second argument to a linked channel chat (requires can_pin_messages member right in the supergroup)
+	ChatId int64 `json:"chat_id"`
+	// Identifier of a new channel's discussion group. Use 0 to remove the discussion group. Use the method getSuitableDiscussionChats to find all suitable groups. Basic group chats must be first upgraded to supergroup chats. If new chat members don't have access to old messages in the supergroup, then toggleSupergroupIsAllHistoryAvailable must be used first to change that
+	DiscussionChatId int64 `json:"discussion_chat_id"`
+}
+
+func (req SetChatDiscussionGroupRequest) GetFunctionName() string {
+	return "setChatDiscussionGroup"
+}
+
+func (req *SetChatDiscussionGroupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatDiscussionGroupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatDiscussionGroup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"discussion_chat_id":`)
+	encoder.writeInt64(req.DiscussionChatId)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatDiscussionGroupRequest) Validate() error {
+	return nil
+}
+
+// Changes the discussion group of a channel chat; requires can_change_info administrator right in the channel if it is specified
+//
+// Available to users only
+func (client *Client) SetChatDiscussionGroup(ctx context.Context, req *SetChatDiscussionGroupRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatLocationRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New location for the chat; must be valid and not null
+	Location *ChatLocation `json:"location"`
+}
+
+func (req SetChatLocationRequest) GetFunctionName() string {
+	return "setChatLocation"
+}
+
+func (req *SetChatLocationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatLocationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatLocation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"location":`)
+	req.Location.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatLocationRequest) Validate() error {
+	if err := validateNested("location", req.Location); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes the location of a chat. Available only for some location-based supergroups, use supergroupFullInfo.can_set_location to check whether the method is allowed to use
+//
+// Available to users only
+func (client *Client) SetChatLocation(ctx context.Context, req *SetChatLocationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatSlowModeDelayRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New slow mode delay for the chat, in seconds; must be one of 0, 10, 30, 60, 300, 900, 3600
+	SlowModeDelay int32 `json:"slow_mode_delay"`
+}
+
+func (req SetChatSlowModeDelayRequest) GetFunctionName() string {
+	return "setChatSlowModeDelay"
+}
+
+func (req *SetChatSlowModeDelayRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatSlowModeDelayRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatSlowModeDelay"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"slow_mode_delay":`)
+	encoder.writeInt32(req.SlowModeDelay)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatSlowModeDelayRequest) Validate() error {
+	return nil
+}
+
+// Changes the slow mode delay of a chat. Available only for supergroups; requires can_restrict_members right
+//
+// Available to users only
+func (client *Client) SetChatSlowModeDelay(ctx context.Context, req *SetChatSlowModeDelayRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type JoinChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req JoinChatRequest) GetFunctionName() string {
+	return "joinChat"
+}
+
+func (req *JoinChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *JoinChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"joinChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req JoinChatRequest) Validate() error {
+	return nil
+}
+
+// Adds the current user as a new member to a chat. Private and secret chats can't be joined using this method. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+//
+// Available to users only
+func (client *Client) JoinChat(ctx context.Context, req *JoinChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type LeaveChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req LeaveChatRequest) GetFunctionName() string {
+	return "leaveChat"
+}
+
+func (req *LeaveChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LeaveChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"leaveChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req LeaveChatRequest) Validate() error {
+	return nil
+}
+
+// Removes the current user from chat members. Private and secret chats can't be left using this method
+func (client *Client) LeaveChat(ctx context.Context, req *LeaveChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AddChatMemberRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+	// The number of earlier messages from the chat to be forwarded to the new member; up to 100. Ignored for supergroups and channels, or if the added user is a bot
+	ForwardLimit int32 `json:"forward_limit"`
+}
+
+func (req AddChatMemberRequest) GetFunctionName() string {
+	return "addChatMember"
+}
+
+func (req *AddChatMemberRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddChatMemberRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addChatMember"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"forward_limit":`)
+	encoder.writeInt32(req.ForwardLimit)
+	encoder.writeRaw("}")
+}
+
+func (req AddChatMemberRequest) Validate() error {
+	if err := validateMax("forward_limit", int64(req.ForwardLimit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Adds a new member to a chat; requires can_invite_users member right. Members can't be added to private or secret chats. Returns information about members that weren't added
+//
+// Available to users only
+func (client *Client) AddChatMember(ctx context.Context, req *AddChatMemberRequest) (*FailedToAddMembers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFailedToAddMembers)
+}
+
+type AddChatMembersRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifiers of the users to be added to the chat. The maximum number of added users is 20 for supergroups and 100 for channels
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (req AddChatMembersRequest) GetFunctionName() string {
+	return "addChatMembers"
+}
+
+func (req *AddChatMembersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddChatMembersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addChatMembers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, req.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req AddChatMembersRequest) Validate() error {
+	return nil
+}
+
+// Adds multiple new members to a chat; requires can_invite_users member right. Currently, this method is only available for supergroups and channels. This method can't be used to join a chat. Members can't be added to a channel if it has more than 200 members. Returns information about members that weren't added
+//
+// Available to users only
+func (client *Client) AddChatMembers(ctx context.Context, req *AddChatMembersRequest) (*FailedToAddMembers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFailedToAddMembers)
+}
+
+type SetChatMemberStatusRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Member identifier. Chats can be only banned and unbanned in supergroups and channels
+	MemberId MessageSender `json:"member_id"`
+	// The new status of the member in the chat
+	Status ChatMemberStatus `json:"status"`
+}
+
+func (req SetChatMemberStatusRequest) GetFunctionName() string {
+	return "setChatMemberStatus"
+}
+
+func (req *SetChatMemberStatusRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatMemberStatusRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatMemberStatus"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"member_id":`)
+	encoder.writeType(req.MemberId)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(req.Status)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatMemberStatusRequest) Validate() error {
+	if err := validateNested("status", req.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes the status of a chat member; requires can_invite_users member right to add a chat member, can_promote_members administrator right to change administrator rights of the member, and can_restrict_members administrator right to change restrictions of a user. This function is currently not suitable for transferring chat ownership; use transferChatOwnership instead. Use addChatMember or banChatMember if some additional parameters needs to be passed
+func (client *Client) SetChatMemberStatus(ctx context.Context, req *SetChatMemberStatusRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type BanChatMemberRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Member identifier
+	MemberId MessageSender `json:"member_id"`
+	// Point in time (Unix timestamp) when the user will be unbanned; 0 if never. If the user is banned for more than 366 days or for less than 30 seconds from the current time, the user is considered to be banned forever. Ignored in basic groups and if a chat is banned
+	BannedUntilDate int32 `json:"banned_until_date"`
+	// Pass true to delete all messages in the chat for the user that is being removed. Always true for supergroups and channels
+	RevokeMessages bool `json:"revoke_messages"`
+}
+
+func (req BanChatMemberRequest) GetFunctionName() string {
+	return "banChatMember"
+}
+
+func (req *BanChatMemberRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *BanChatMemberRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"banChatMember"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"member_id":`)
+	encoder.writeType(req.MemberId)
+	encoder.writeRaw(`,"banned_until_date":`)
+	encoder.writeInt32(req.BannedUntilDate)
+	encoder.writeRaw(`,"revoke_messages":`)
+	encoder.writeBool(req.RevokeMessages)
+	encoder.writeRaw("}")
+}
+
+func (req BanChatMemberRequest) Validate() error {
+	return nil
+}
+
+// Bans a member in a chat; requires can_restrict_members administrator right. Members can't be banned in private or secret chats. In supergroups and channels, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first
+func (client *Client) BanChatMember(ctx context.Context, req *BanChatMemberRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type TransferChatOwnershipRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the user to which transfer the ownership. The ownership can't be transferred to a bot or to a deleted user
+	UserId int64 `json:"user_id"`
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req TransferChatOwnershipRequest) GetFunctionName() string {
+	return "transferChatOwnership"
+}
+
+func (req *TransferChatOwnershipRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TransferChatOwnershipRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"transferChatOwnership"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req TransferChatOwnershipRequest) Validate() error {
+	return nil
+}
+
+// Changes the owner of a chat; requires owner privileges in the chat. Use the method canTransferOwnership to check whether the ownership can be transferred from the current session. Available only for supergroups and channel chats
+//
+// Available to users only
+func (client *Client) TransferChatOwnership(ctx context.Context, req *TransferChatOwnershipRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatMemberRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Member identifier
+	MemberId MessageSender `json:"member_id"`
+}
+
+func (req GetChatMemberRequest) GetFunctionName() string {
+	return "getChatMember"
+}
+
+func (req *GetChatMemberRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatMemberRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatMember"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"member_id":`)
+	encoder.writeType(req.MemberId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatMemberRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a single member of a chat
+func (client *Client) GetChatMember(ctx context.Context, req *GetChatMemberRequest) (*ChatMember, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatMember)
+}
+
+type SearchChatMembersRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Query to search for
+	Query string `json:"query"`
+	// The maximum number of users to be returned; up to 200
+	Limit int32 `json:"limit"`
+	// The type of users to search for; pass null to search among all chat members
+	Filter ChatMembersFilter `json:"filter,omitempty"`
+}
+
+func (req SearchChatMembersRequest) GetFunctionName() string {
+	return "searchChatMembers"
+}
+
+func (req *SearchChatMembersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchChatMembersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchChatMembers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	if req.Filter != nil {
+		encoder.writeRaw(`,"filter":`)
+		encoder.writeType(req.Filter)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SearchChatMembersRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 200); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for a specified query in the first name, last name and usernames of the members of a specified chat. Requires administrator rights if the chat is a channel
+func (client *Client) SearchChatMembers(ctx context.Context, req *SearchChatMembersRequest) (*ChatMembers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatMembers)
+}
+
+type GetChatAdministratorsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatAdministratorsRequest) GetFunctionName() string {
+	return "getChatAdministrators"
+}
+
+func (req *GetChatAdministratorsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatAdministratorsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatAdministrators"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatAdministratorsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of administrators of the chat with their custom titles
+func (client *Client) GetChatAdministrators(ctx context.Context, req *GetChatAdministratorsRequest) (*ChatAdministrators, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatAdministrators)
+}
+
+type GetChatNotificationSettingsExceptionsRequest struct {
+	request
+	// If specified, only chats from the scope will be returned; pass null to return chats from all scopes
+	Scope NotificationSettingsScope `json:"scope,omitempty"`
+	// Pass true to include in the response chats with only non-default sound
+	CompareSound bool `json:"compare_sound"`
+}
+
+func (req GetChatNotificationSettingsExceptionsRequest) GetFunctionName() string {
+	return "getChatNotificationSettingsExceptions"
+}
+
+func (req *GetChatNotificationSettingsExceptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatNotificationSettingsExceptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatNotificationSettingsExceptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Scope != nil {
+		encoder.writeRaw(`,"scope":`)
+		encoder.writeType(req.Scope)
+	}
+	encoder.writeRaw(`,"compare_sound":`)
+	encoder.writeBool(req.CompareSound)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatNotificationSettingsExceptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of chats with non-default notification settings for new messages
+//
+// Available to users only
+func (client *Client) GetChatNotificationSettingsExceptions(ctx context.Context, req *GetChatNotificationSettingsExceptionsRequest) (*Chats, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type ToggleChatIsPinnedRequest struct {
+	request
+	// Chat list in which to change the pinned state of the chat
+	ChatList ChatList `json:"chat_list"`
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Pass true to pin the chat; pass false to unpin it
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (req ToggleChatIsPinnedRequest) GetFunctionName() string {
+	return "toggleChatIsPinned"
+}
+
+func (req *ToggleChatIsPinnedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatIsPinnedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatIsPinned"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(req.ChatList)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(req.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatIsPinnedRequest) Validate() error {
+	return nil
+}
+
+// Changes the pinned state of a chat. There can be up to getOption("pinned_chat_count_max")/getOption("pinned_archived_chat_count_max") pinned non-secret chats and the same number of secret chats in the main/archive chat list. The limit can be increased with Telegram Premium
+//
+// Available to users only
+func (client *Client) ToggleChatIsPinned(ctx context.Context, req *ToggleChatIsPinnedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetPinnedChatsRequest struct {
+	request
+	// Chat list in which to change the order of pinned chats
+	ChatList ChatList `json:"chat_list"`
+	// The new list of pinned chats
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (req SetPinnedChatsRequest) GetFunctionName() string {
+	return "setPinnedChats"
+}
+
+func (req *SetPinnedChatsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPinnedChatsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPinnedChats"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(req.ChatList)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, req.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req SetPinnedChatsRequest) Validate() error {
+	return nil
+}
+
+// Changes the order of pinned chats
+//
+// Available to users only
+func (client *Client) SetPinnedChats(ctx context.Context, req *SetPinnedChatsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReadChatListRequest struct {
+	request
+	// Chat list in which to mark all chats as read
+	ChatList ChatList `json:"chat_list"`
+}
+
+func (req ReadChatListRequest) GetFunctionName() string {
+	return "readChatList"
+}
+
+func (req *ReadChatListRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReadChatListRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readChatList"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(req.ChatList)
+	encoder.writeRaw("}")
+}
+
+func (req ReadChatListRequest) Validate() error {
+	return nil
+}
+
+// Traverse all chats in a chat list and marks all messages in the chats as read
+//
+// Available to users only
+func (client *Client) ReadChatList(ctx context.Context, req *ReadChatListRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReplacePrimaryChatInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req ReplacePrimaryChatInviteLinkRequest) GetFunctionName() string {
+	return "replacePrimaryChatInviteLink"
+}
+
+func (req *ReplacePrimaryChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReplacePrimaryChatInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"replacePrimaryChatInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req ReplacePrimaryChatInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Replaces current primary invite link for a chat with a new primary invite link. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right
+func (client *Client) ReplacePrimaryChatInviteLink(ctx context.Context, req *ReplacePrimaryChatInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLink)
+}
+
+type CreateChatInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link name; 0-32 characters
+	Name string `json:"name"`
+	// Point in time (Unix timestamp) when the link will expire; pass 0 if never
+	ExpirationDate int32 `json:"expiration_date"`
+	// The maximum number of chat members that can join the chat via the link simultaneously; 0-99999; pass 0 if not limited
+	MemberLimit int32 `json:"member_limit"`
+	// Pass true if users joining the chat via the link need to be approved by chat administrators. In this case, member_limit must be 0
+	CreatesJoinRequest bool `json:"creates_join_request"`
+}
+
+func (req CreateChatInviteLinkRequest) GetFunctionName() string {
+	return "createChatInviteLink"
+}
+
+func (req *CreateChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateChatInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createChatInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(req.ExpirationDate)
+	encoder.writeRaw(`,"member_limit":`)
+	encoder.writeInt32(req.MemberLimit)
+	encoder.writeRaw(`,"creates_join_request":`)
+	encoder.writeBool(req.CreatesJoinRequest)
+	encoder.writeRaw("}")
+}
+
+func (req CreateChatInviteLinkRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a new invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat
+func (client *Client) CreateChatInviteLink(ctx context.Context, req *CreateChatInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLink)
+}
+
+type EditChatInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link to be edited
+	InviteLink string `json:"invite_link"`
+	// Invite link name; 0-32 characters
+	Name string `json:"name"`
+	// Point in time (Unix timestamp) when the link will expire; pass 0 if never
+	ExpirationDate int32 `json:"expiration_date"`
+	// The maximum number of chat members that can join the chat via the link simultaneously; 0-99999; pass 0 if not limited
+	MemberLimit int32 `json:"member_limit"`
+	// Pass true if users joining the chat via the link need to be approved by chat administrators. In this case, member_limit must be 0
+	CreatesJoinRequest bool `json:"creates_join_request"`
+}
+
+func (req EditChatInviteLinkRequest) GetFunctionName() string {
+	return "editChatInviteLink"
+}
+
+func (req *EditChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditChatInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editChatInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(req.ExpirationDate)
+	encoder.writeRaw(`,"member_limit":`)
+	encoder.writeInt32(req.MemberLimit)
+	encoder.writeRaw(`,"creates_join_request":`)
+	encoder.writeBool(req.CreatesJoinRequest)
+	encoder.writeRaw("}")
+}
+
+func (req EditChatInviteLinkRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits a non-primary invite link for a chat. Available for basic groups, supergroups, and channels. If the link creates a subscription, then expiration_date, member_limit and creates_join_request must not be used. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+func (client *Client) EditChatInviteLink(ctx context.Context, req *EditChatInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLink)
+}
+
+type GetChatInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link to get
+	InviteLink string `json:"invite_link"`
+}
+
+func (req GetChatInviteLinkRequest) GetFunctionName() string {
+	return "getChatInviteLink"
+}
+
+func (req *GetChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns information about an invite link. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+//
+// Available to users only
+func (client *Client) GetChatInviteLink(ctx context.Context, req *GetChatInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLink)
+}
+
+type GetChatInviteLinkCountsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatInviteLinkCountsRequest) GetFunctionName() string {
+	return "getChatInviteLinkCounts"
+}
+
+func (req *GetChatInviteLinkCountsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatInviteLinkCountsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatInviteLinkCounts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatInviteLinkCountsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of chat administrators with number of their invite links. Requires owner privileges in the chat
+//
+// Available to users only
+func (client *Client) GetChatInviteLinkCounts(ctx context.Context, req *GetChatInviteLinkCountsRequest) (*ChatInviteLinkCounts, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLinkCounts)
+}
+
+type GetChatInviteLinksRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// User identifier of a chat administrator. Must be an identifier of the current user for non-owner
+	CreatorUserId int64 `json:"creator_user_id"`
+	// Pass true if revoked links needs to be returned instead of active or expired
+	IsRevoked bool `json:"is_revoked"`
+	// Creation date of an invite link starting after which to return invite links; use 0 to get results from the beginning
+	OffsetDate int32 `json:"offset_date"`
+	// Invite link starting after which to return invite links; use empty string to get results from the beginning
+	OffsetInviteLink string `json:"offset_invite_link"`
+	// The maximum number of invite links to return; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatInviteLinksRequest) GetFunctionName() string {
+	return "getChatInviteLinks"
+}
+
+func (req *GetChatInviteLinksRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatInviteLinksRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatInviteLinks"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"creator_user_id":`)
+	encoder.writeInt64(req.CreatorUserId)
+	encoder.writeRaw(`,"is_revoked":`)
+	encoder.writeBool(req.IsRevoked)
+	encoder.writeRaw(`,"offset_date":`)
+	encoder.writeInt32(req.OffsetDate)
+	encoder.writeRaw(`,"offset_invite_link":`)
+	encoder.writeString(req.OffsetInviteLink)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatInviteLinksRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns invite links for a chat created by specified administrator. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+//
+// Available to users only
+func (client *Client) GetChatInviteLinks(ctx context.Context, req *GetChatInviteLinksRequest) (*ChatInviteLinks, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLinks)
+}
+
+type GetChatInviteLinkMembersRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link for which to return chat members
+	InviteLink string `json:"invite_link"`
+	// Pass true if the link is a subscription link and only members with expired subscription must be returned
+	OnlyWithExpiredSubscription bool `json:"only_with_expired_subscription"`
+	// A chat member from which to return next chat members; pass null to get results from the beginning
+	OffsetMember *ChatInviteLinkMember `json:"offset_member,omitempty"`
+	// The maximum number of chat members to return; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatInviteLinkMembersRequest) GetFunctionName() string {
+	return "getChatInviteLinkMembers"
+}
+
+func (req *GetChatInviteLinkMembersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatInviteLinkMembersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatInviteLinkMembers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw(`,"only_with_expired_subscription":`)
+	encoder.writeBool(req.OnlyWithExpiredSubscription)
+	if req.OffsetMember != nil {
+		encoder.writeRaw(`,"offset_member":`)
+		req.OffsetMember.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatInviteLinkMembersRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns chat members joined a chat via an invite link. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available to users only
+func (client *Client) GetChatInviteLinkMembers(ctx context.Context, req *GetChatInviteLinkMembersRequest) (*ChatInviteLinkMembers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLinkMembers)
+}
+
+type RevokeChatInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link to be revoked
+	InviteLink string `json:"invite_link"`
+}
+
+func (req RevokeChatInviteLinkRequest) GetFunctionName() string {
+	return "revokeChatInviteLink"
+}
+
+func (req *RevokeChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RevokeChatInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"revokeChatInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (req RevokeChatInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Revokes invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links. If a primary link is revoked, then additionally to the revoked link returns new primary link
+func (client *Client) RevokeChatInviteLink(ctx context.Context, req *RevokeChatInviteLinkRequest) (*ChatInviteLinks, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLinks)
+}
+
+type DeleteRevokedChatInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link to revoke
+	InviteLink string `json:"invite_link"`
+}
+
+func (req DeleteRevokedChatInviteLinkRequest) GetFunctionName() string {
+	return "deleteRevokedChatInviteLink"
+}
+
+func (req *DeleteRevokedChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteRevokedChatInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteRevokedChatInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteRevokedChatInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Deletes revoked chat invite links. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available to users only
+func (client *Client) DeleteRevokedChatInviteLink(ctx context.Context, req *DeleteRevokedChatInviteLinkRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteAllRevokedChatInviteLinksRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// User identifier of a chat administrator, which links will be deleted. Must be an identifier of the current user for non-owner
+	CreatorUserId int64 `json:"creator_user_id"`
+}
+
+func (req DeleteAllRevokedChatInviteLinksRequest) GetFunctionName() string {
+	return "deleteAllRevokedChatInviteLinks"
+}
+
+func (req *DeleteAllRevokedChatInviteLinksRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteAllRevokedChatInviteLinksRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteAllRevokedChatInviteLinks"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"creator_user_id":`)
+	encoder.writeInt64(req.CreatorUserId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteAllRevokedChatInviteLinksRequest) Validate() error {
+	return nil
+}
+
+// Deletes all revoked chat invite links created by a given chat administrator. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available to users only
+func (client *Client) DeleteAllRevokedChatInviteLinks(ctx context.Context, req *DeleteAllRevokedChatInviteLinksRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckChatInviteLinkRequest struct {
+	request
+	// Invite link to be checked
+	InviteLink string `json:"invite_link"`
+}
+
+func (req CheckChatInviteLinkRequest) GetFunctionName() string {
+	return "checkChatInviteLink"
+}
+
+func (req *CheckChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckChatInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (req CheckChatInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Checks the validity of an invite link for a chat and returns information about the corresponding chat
+//
+// Available to users only
+func (client *Client) CheckChatInviteLink(ctx context.Context, req *CheckChatInviteLinkRequest) (*ChatInviteLinkInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLinkInfo)
+}
+
+type JoinChatByInviteLinkRequest struct {
+	request
+	// Invite link to use
+	InviteLink string `json:"invite_link"`
+}
+
+func (req JoinChatByInviteLinkRequest) GetFunctionName() string {
+	return "joinChatByInviteLink"
+}
+
+func (req *JoinChatByInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *JoinChatByInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"joinChatByInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (req JoinChatByInviteLinkRequest) Validate() error {
+	return nil
+}
+
+// Uses an invite link to add the current user to the chat if possible. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+//
+// Available to users only
+func (client *Client) JoinChatByInviteLink(ctx context.Context, req *JoinChatByInviteLinkRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type GetChatJoinRequestsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link for which to return join requests. If empty, all join requests will be returned. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+	InviteLink string `json:"invite_link"`
+	// A query to search for in the first names, last names and usernames of the users to return
+	Query string `json:"query"`
+	// A chat join request from which to return next requests; pass null to get results from the beginning
+	OffsetRequest *ChatJoinRequest `json:"offset_request,omitempty"`
+	// The maximum number of requests to join the chat to return
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatJoinRequestsRequest) GetFunctionName() string {
+	return "getChatJoinRequests"
+}
+
+func (req *GetChatJoinRequestsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatJoinRequestsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatJoinRequests"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	if req.OffsetRequest != nil {
+		encoder.writeRaw(`,"offset_request":`)
+		req.OffsetRequest.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatJoinRequestsRequest) Validate() error {
+	return nil
+}
+
+// Returns pending join requests in a chat
+//
+// Available to users only
+func (client *Client) GetChatJoinRequests(ctx context.Context, req *GetChatJoinRequestsRequest) (*ChatJoinRequests, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatJoinRequests)
+}
+
+type ProcessChatJoinRequestRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the user that sent the request
+	UserId int64 `json:"user_id"`
+	// Pass true to approve the request; pass false to decline it
+	Approve bool `json:"approve"`
+}
+
+func (req ProcessChatJoinRequestRequest) GetFunctionName() string {
+	return "processChatJoinRequest"
+}
+
+func (req *ProcessChatJoinRequestRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ProcessChatJoinRequestRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"processChatJoinRequest"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"approve":`)
+	encoder.writeBool(req.Approve)
+	encoder.writeRaw("}")
+}
+
+func (req ProcessChatJoinRequestRequest) Validate() error {
+	return nil
+}
+
+// Handles a pending join request in a chat
+func (client *Client) ProcessChatJoinRequest(ctx context.Context, req *ProcessChatJoinRequestRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ProcessChatJoinRequestsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link for which to process join requests. If empty, all join requests will be processed. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+	InviteLink string `json:"invite_link"`
+	// Pass true to approve all requests; pass false to decline them
+	Approve bool `json:"approve"`
+}
+
+func (req ProcessChatJoinRequestsRequest) GetFunctionName() string {
+	return "processChatJoinRequests"
+}
+
+func (req *ProcessChatJoinRequestsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ProcessChatJoinRequestsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"processChatJoinRequests"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw(`,"approve":`)
+	encoder.writeBool(req.Approve)
+	encoder.writeRaw("}")
+}
+
+func (req ProcessChatJoinRequestsRequest) Validate() error {
+	return nil
+}
+
+// Handles all pending join requests for a given link in a chat
+//
+// Available to users only
+func (client *Client) ProcessChatJoinRequests(ctx context.Context, req *ProcessChatJoinRequestsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetVideoChatAvailableParticipantsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetVideoChatAvailableParticipantsRequest) GetFunctionName() string {
+	return "getVideoChatAvailableParticipants"
+}
+
+func (req *GetVideoChatAvailableParticipantsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetVideoChatAvailableParticipantsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getVideoChatAvailableParticipants"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetVideoChatAvailableParticipantsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of participant identifiers, on whose behalf a video chat in the chat can be joined
+//
+// Available to users only
+func (client *Client) GetVideoChatAvailableParticipants(ctx context.Context, req *GetVideoChatAvailableParticipantsRequest) (*MessageSenders, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageSenders)
+}
+
+type SetVideoChatDefaultParticipantRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Default group call participant identifier to join the video chats
+	DefaultParticipantId MessageSender `json:"default_participant_id"`
+}
+
+func (req SetVideoChatDefaultParticipantRequest) GetFunctionName() string {
+	return "setVideoChatDefaultParticipant"
+}
+
+func (req *SetVideoChatDefaultParticipantRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetVideoChatDefaultParticipantRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setVideoChatDefaultParticipant"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"default_participant_id":`)
+	encoder.writeType(req.DefaultParticipantId)
+	encoder.writeRaw("}")
+}
+
+func (req SetVideoChatDefaultParticipantRequest) Validate() error {
+	return nil
+}
+
+// Changes default participant identifier, on whose behalf a video chat in the chat will be joined
+//
+// Available to users only
+func (client *Client) SetVideoChatDefaultParticipant(ctx context.Context, req *SetVideoChatDefaultParticipantRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CreateVideoChatRequest struct {
+	request
+	// Identifier of a chat in which the video chat will be created
+	ChatId int64 `json:"chat_id"`
+	// Group call title; if empty, chat title will be used
+	Title string `json:"title"`
+	// Point in time (Unix timestamp) when the group call is expected to be started by an administrator; 0 to start the video chat immediately. The date must be at least 10 seconds and at most 8 days in the future
+	StartDate int32 `json:"start_date"`
+	// Pass true to create an RTMP stream instead of an ordinary video chat
+	IsRtmpStream bool `json:"is_rtmp_stream"`
+}
+
+func (req CreateVideoChatRequest) GetFunctionName() string {
+	return "createVideoChat"
+}
+
+func (req *CreateVideoChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateVideoChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createVideoChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw(`,"start_date":`)
+	encoder.writeInt32(req.StartDate)
+	encoder.writeRaw(`,"is_rtmp_stream":`)
+	encoder.writeBool(req.IsRtmpStream)
+	encoder.writeRaw("}")
+}
+
+func (req CreateVideoChatRequest) Validate() error {
+	return nil
+}
+
+// Creates a video chat (a group call bound to a chat). Available only for basic groups, supergroups and channels; requires can_manage_video_chats administrator right
+//
+// Available to users only
+func (client *Client) CreateVideoChat(ctx context.Context, req *CreateVideoChatRequest) (*GroupCallId, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGroupCallId)
+}
+
+type GetVideoChatRtmpUrlRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetVideoChatRtmpUrlRequest) GetFunctionName() string {
+	return "getVideoChatRtmpUrl"
+}
+
+func (req *GetVideoChatRtmpUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetVideoChatRtmpUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getVideoChatRtmpUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetVideoChatRtmpUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns RTMP URL for streaming to the chat; requires can_manage_video_chats administrator right
+//
+// Available to users only
+func (client *Client) GetVideoChatRtmpUrl(ctx context.Context, req *GetVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalRtmpUrl)
+}
+
+type ReplaceVideoChatRtmpUrlRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req ReplaceVideoChatRtmpUrlRequest) GetFunctionName() string {
+	return "replaceVideoChatRtmpUrl"
+}
+
+func (req *ReplaceVideoChatRtmpUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReplaceVideoChatRtmpUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"replaceVideoChatRtmpUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req ReplaceVideoChatRtmpUrlRequest) Validate() error {
+	return nil
+}
+
+// Replaces the current RTMP URL for streaming to the chat; requires owner privileges
+//
+// Available to users only
+func (client *Client) ReplaceVideoChatRtmpUrl(ctx context.Context, req *ReplaceVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalRtmpUrl)
+}
+
+type SetPersonalChatRequest struct {
+	request
+	// Identifier of the new personal chat; pass 0 to remove the chat. Use getSuitablePersonalChats to get suitable chats
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req SetPersonalChatRequest) GetFunctionName() string {
+	return "setPersonalChat"
+}
+
+func (req *SetPersonalChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPersonalChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPersonalChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req SetPersonalChatRequest) Validate() error {
+	return nil
+}
+
+// Changes the personal chat of the current user
+//
+// Available to users only
+func (client *Client) SetPersonalChat(ctx context.Context, req *SetPersonalChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetDefaultGroupAdministratorRightsRequest struct {
+	request
+	// Default administrator rights for adding the bot to basic group and supergroup chats; pass null to remove default rights
+	DefaultGroupAdministratorRights *ChatAdministratorRights `json:"default_group_administrator_rights,omitempty"`
+}
+
+func (req SetDefaultGroupAdministratorRightsRequest) GetFunctionName() string {
+	return "setDefaultGroupAdministratorRights"
+}
+
+func (req *SetDefaultGroupAdministratorRightsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetDefaultGroupAdministratorRightsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setDefaultGroupAdministratorRights"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.DefaultGroupAdministratorRights != nil {
+		encoder.writeRaw(`,"default_group_administrator_rights":`)
+		req.DefaultGroupAdministratorRights.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetDefaultGroupAdministratorRightsRequest) Validate() error {
+	return nil
+}
+
+// Sets default administrator rights for adding the bot to basic group and supergroup chats; for bots only
+//
+// Available to bots only
+func (client *Client) SetDefaultGroupAdministratorRights(ctx context.Context, req *SetDefaultGroupAdministratorRightsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetDefaultChannelAdministratorRightsRequest struct {
+	request
+	// Default administrator rights for adding the bot to channels; pass null to remove default rights
+	DefaultChannelAdministratorRights *ChatAdministratorRights `json:"default_channel_administrator_rights,omitempty"`
+}
+
+func (req SetDefaultChannelAdministratorRightsRequest) GetFunctionName() string {
+	return "setDefaultChannelAdministratorRights"
+}
+
+func (req *SetDefaultChannelAdministratorRightsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetDefaultChannelAdministratorRightsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setDefaultChannelAdministratorRights"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.DefaultChannelAdministratorRights != nil {
+		encoder.writeRaw(`,"default_channel_administrator_rights":`)
+		req.DefaultChannelAdministratorRights.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetDefaultChannelAdministratorRightsRequest) Validate() error {
+	return nil
+}
+
+// Sets default administrator rights for adding the bot to channel chats; for bots only
+//
+// Available to bots only
+func (client *Client) SetDefaultChannelAdministratorRights(ctx context.Context, req *SetDefaultChannelAdministratorRightsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetSupergroupUsernameRequest struct {
+	request
+	// Identifier of the supergroup or channel
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of the username. Use an empty string to remove the username. The username can't be completely removed if there is another active or disabled username
+	Username string `json:"username"`
+}
+
+func (req SetSupergroupUsernameRequest) GetFunctionName() string {
+	return "setSupergroupUsername"
+}
+
+func (req *SetSupergroupUsernameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetSupergroupUsernameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setSupergroupUsername"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw("}")
+}
+
+func (req SetSupergroupUsernameRequest) Validate() error {
+	return nil
+}
+
+// Changes the editable username of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available to users only
+func (client *Client) SetSupergroupUsername(ctx context.Context, req *SetSupergroupUsernameRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupUsernameIsActiveRequest struct {
+	request
+	// Identifier of the supergroup or channel
+	SupergroupId int64 `json:"supergroup_id"`
+	// The username to change
+	Username string `json:"username"`
+	// Pass true to activate the username; pass false to disable it
+	IsActive bool `json:"is_active"`
+}
+
+func (req ToggleSupergroupUsernameIsActiveRequest) GetFunctionName() string {
+	return "toggleSupergroupUsernameIsActive"
+}
+
+func (req *ToggleSupergroupUsernameIsActiveRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupUsernameIsActiveRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupUsernameIsActive"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw(`,"is_active":`)
+	encoder.writeBool(req.IsActive)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupUsernameIsActiveRequest) Validate() error {
+	return nil
+}
+
+// Changes active state for a username of a supergroup or channel, requires owner privileges in the supergroup or channel. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+//
+// Available to users only
+func (client *Client) ToggleSupergroupUsernameIsActive(ctx context.Context, req *ToggleSupergroupUsernameIsActiveRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DisableAllSupergroupUsernamesRequest struct {
+	request
+	// Identifier of the supergroup or channel
+	SupergroupId int64 `json:"supergroup_id"`
+}
+
+func (req DisableAllSupergroupUsernamesRequest) GetFunctionName() string {
+	return "disableAllSupergroupUsernames"
+}
+
+func (req *DisableAllSupergroupUsernamesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DisableAllSupergroupUsernamesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"disableAllSupergroupUsernames"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (req DisableAllSupergroupUsernamesRequest) Validate() error {
+	return nil
+}
+
+// Disables all active non-editable usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available to users only
+func (client *Client) DisableAllSupergroupUsernames(ctx context.Context, req *DisableAllSupergroupUsernamesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReorderSupergroupActiveUsernamesRequest struct {
+	request
+	// Identifier of the supergroup or channel
+	SupergroupId int64 `json:"supergroup_id"`
+	// The new order of active usernames. All currently active usernames must be specified
+	Usernames []string `json:"usernames"`
+}
+
+func (req ReorderSupergroupActiveUsernamesRequest) GetFunctionName() string {
+	return "reorderSupergroupActiveUsernames"
+}
+
+func (req *ReorderSupergroupActiveUsernamesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReorderSupergroupActiveUsernamesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reorderSupergroupActiveUsernames"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"usernames":`)
+	writeList(encoder, req.Usernames, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req ReorderSupergroupActiveUsernamesRequest) Validate() error {
+	return nil
+}
+
+// Changes order of active usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available to users only
+func (client *Client) ReorderSupergroupActiveUsernames(ctx context.Context, req *ReorderSupergroupActiveUsernamesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupJoinByRequestRequest struct {
+	request
+	// Identifier of the supergroup that isn't a broadcast group
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of join_by_request
+	JoinByRequest bool `json:"join_by_request"`
+}
+
+func (req ToggleSupergroupJoinByRequestRequest) GetFunctionName() string {
+	return "toggleSupergroupJoinByRequest"
+}
+
+func (req *ToggleSupergroupJoinByRequestRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupJoinByRequestRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupJoinByRequest"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"join_by_request":`)
+	encoder.writeBool(req.JoinByRequest)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupJoinByRequestRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether all users directly joining the supergroup need to be approved by supergroup administrators; requires can_restrict_members administrator right
+//
+// Available to users only
+func (client *Client) ToggleSupergroupJoinByRequest(ctx context.Context, req *ToggleSupergroupJoinByRequestRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupIsAllHistoryAvailableRequest struct {
+	request
+	// The identifier of the supergroup
+	SupergroupId int64 `json:"supergroup_id"`
+	// The new value of is_all_history_available
+	IsAllHistoryAvailable bool `json:"is_all_history_available"`
+}
+
+func (req ToggleSupergroupIsAllHistoryAvailableRequest) GetFunctionName() string {
+	return "toggleSupergroupIsAllHistoryAvailable"
+}
+
+func (req *ToggleSupergroupIsAllHistoryAvailableRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupIsAllHistoryAvailableRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupIsAllHistoryAvailable"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"is_all_history_available":`)
+	encoder.writeBool(req.IsAllHistoryAvailable)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupIsAllHistoryAvailableRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether the message history of a supergroup is available to new members; requires can_change_info member right
+//
+// Available to users only
+func (client *Client) ToggleSupergroupIsAllHistoryAvailable(ctx context.Context, req *ToggleSupergroupIsAllHistoryAvailableRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupHasHiddenMembersRequest struct {
+	request
+	// Identifier of the supergroup
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of has_hidden_members
+	HasHiddenMembers bool `json:"has_hidden_members"`
+}
+
+func (req ToggleSupergroupHasHiddenMembersRequest) GetFunctionName() string {
+	return "toggleSupergroupHasHiddenMembers"
+}
+
+func (req *ToggleSupergroupHasHiddenMembersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupHasHiddenMembersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupHasHiddenMembers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"has_hidden_members":`)
+	encoder.writeBool(req.HasHiddenMembers)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupHasHiddenMembersRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether non-administrators can receive only administrators and bots using getSupergroupMembers or searchChatMembers. Can be called only if supergroupFullInfo.can_hide_members == true
+//
+// Available to users only
+func (client *Client) ToggleSupergroupHasHiddenMembers(ctx context.Context, req *ToggleSupergroupHasHiddenMembersRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupHasAggressiveAntiSpamEnabledRequest struct {
+	request
+	// The identifier of the supergroup, which isn't a broadcast group
+	SupergroupId int64 `json:"supergroup_id"`
+	// The new value of has_aggressive_anti_spam_enabled
+	HasAggressiveAntiSpamEnabled bool `json:"has_aggressive_anti_spam_enabled"`
+}
+
+func (req ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) GetFunctionName() string {
+	return "toggleSupergroupHasAggressiveAntiSpamEnabled"
+}
+
+func (req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupHasAggressiveAntiSpamEnabled"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"has_aggressive_anti_spam_enabled":`)
+	encoder.writeBool(req.HasAggressiveAntiSpamEnabled)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether aggressive anti-spam checks are enabled in the supergroup. Can be called only if supergroupFullInfo.can_toggle_aggressive_anti_spam == true
+//
+// Available to users only
+func (client *Client) ToggleSupergroupHasAggressiveAntiSpamEnabled(ctx context.Context, req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupIsForumRequest struct {
+	request
+	// Identifier of the supergroup
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of is_forum
+	IsForum bool `json:"is_forum"`
+}
+
+func (req ToggleSupergroupIsForumRequest) GetFunctionName() string {
+	return "toggleSupergroupIsForum"
+}
+
+func (req *ToggleSupergroupIsForumRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupIsForumRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupIsForum"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"is_forum":`)
+	encoder.writeBool(req.IsForum)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupIsForumRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether the supergroup is a forum; requires owner privileges in the supergroup. Discussion supergroups can't be converted to forums
+//
+// Available to users only
+func (client *Client) ToggleSupergroupIsForum(ctx context.Context, req *ToggleSupergroupIsForumRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupIsBroadcastGroupRequest struct {
+	request
+	// Identifier of the supergroup
+	SupergroupId int64 `json:"supergroup_id"`
+}
+
+func (req ToggleSupergroupIsBroadcastGroupRequest) GetFunctionName() string {
+	return "toggleSupergroupIsBroadcastGroup"
+}
+
+func (req *ToggleSupergroupIsBroadcastGroupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupIsBroadcastGroupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupIsBroadcastGroup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupIsBroadcastGroupRequest) Validate() error {
+	return nil
+}
+
+// Upgrades supergroup to a broadcast group; requires owner privileges in the supergroup
+//
+// Available to users only
+func (client *Client) ToggleSupergroupIsBroadcastGroup(ctx context.Context, req *ToggleSupergroupIsBroadcastGroupRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportSupergroupSpamRequest struct {
+	request
+	// Supergroup identifier
+	SupergroupId int64 `json:"supergroup_id"`
+	// Identifiers of messages to report. Use messageProperties.can_report_supergroup_spam to check whether the message can be reported
+	MessageIds []int64 `json:"message_ids"`
+}
+
+func (req ReportSupergroupSpamRequest) GetFunctionName() string {
+	return "reportSupergroupSpam"
+}
+
+func (req *ReportSupergroupSpamRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportSupergroupSpamRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSupergroupSpam"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req ReportSupergroupSpamRequest) Validate() error {
+	return nil
+}
+
+// Reports messages in a supergroup as spam; requires administrator rights in the supergroup
+//
+// Available to users only
+func (client *Client) ReportSupergroupSpam(ctx context.Context, req *ReportSupergroupSpamRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportSupergroupAntiSpamFalsePositiveRequest struct {
+	request
+	// Supergroup identifier
+	SupergroupId int64 `json:"supergroup_id"`
+	// Identifier of the erroneously deleted message from chatEventMessageDeleted
+	MessageId int64 `json:"message_id"`
+}
+
+func (req ReportSupergroupAntiSpamFalsePositiveRequest) GetFunctionName() string {
+	return "reportSupergroupAntiSpamFalsePositive"
+}
+
+func (req *ReportSupergroupAntiSpamFalsePositiveRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportSupergroupAntiSpamFalsePositiveRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSupergroupAntiSpamFalsePositive"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req ReportSupergroupAntiSpamFalsePositiveRequest) Validate() error {
+	return nil
+}
+
+// Reports a false deletion of a message by aggressive anti-spam checks; requires administrator rights in the supergroup. Can be called only for messages from chatEventMessageDeleted with can_report_anti_spam_false_positive == true
+//
+// Available to users only
+func (client *Client) ReportSupergroupAntiSpamFalsePositive(ctx context.Context, req *ReportSupergroupAntiSpamFalsePositiveRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetSupergroupMembersRequest struct {
+	request
+	// Identifier of the supergroup or channel
+	SupergroupId int64 `json:"supergroup_id"`
+	// The type of users to return; pass null to use supergroupMembersFilterRecent
+	Filter SupergroupMembersFilter `json:"filter,omitempty"`
+	// Number of users to skip
+	Offset int32 `json:"offset"`
+	// The maximum number of users to be returned; up to 200
+	Limit int32 `json:"limit"`
+}
+
+func (req GetSupergroupMembersRequest) GetFunctionName() string {
+	return "getSupergroupMembers"
+}
+
+func (req *GetSupergroupMembersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSupergroupMembersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSupergroupMembers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	if req.Filter != nil {
+		encoder.writeRaw(`,"filter":`)
+		encoder.writeType(req.Filter)
+	}
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetSupergroupMembersRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 200); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns information about members or banned users in a supergroup or channel. Can be used only if supergroupFullInfo.can_get_members == true; additionally, administrator privileges may be required for some filters
+func (client *Client) GetSupergroupMembers(ctx context.Context, req *GetSupergroupMembersRequest) (*ChatMembers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatMembers)
+}
+
+type CloseSecretChatRequest struct {
+	request
+	// Secret chat identifier
+	SecretChatId int32 `json:"secret_chat_id"`
+}
+
+func (req CloseSecretChatRequest) GetFunctionName() string {
+	return "closeSecretChat"
+}
+
+func (req *CloseSecretChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CloseSecretChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"closeSecretChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"secret_chat_id":`)
+	encoder.writeInt32(req.SecretChatId)
+	encoder.writeRaw("}")
+}
+
+func (req CloseSecretChatRequest) Validate() error {
+	return nil
+}
+
+// Closes a secret chat, effectively transferring its state to secretChatStateClosed
+func (client *Client) CloseSecretChat(ctx context.Context, req *CloseSecretChatRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatEventLogRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Search query by which to filter events
+	Query string `json:"query"`
+	// Identifier of an event from which to return results. Use 0 to get results from the latest events
+	FromEventId JsonInt64 `json:"from_event_id"`
+	// The maximum number of events to return; up to 100
+	Limit int32 `json:"limit"`
+	// The types of events to return; pass null to get chat events of all types
+	Filters *ChatEventLogFilters `json:"filters,omitempty"`
+	// User identifiers by which to filter events. By default, events relating to all users will be returned
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (req GetChatEventLogRequest) GetFunctionName() string {
+	return "getChatEventLog"
+}
+
+func (req *GetChatEventLogRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatEventLogRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatEventLog"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"from_event_id":`)
+	encoder.writeJsonInt64(req.FromEventId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	if req.Filters != nil {
+		encoder.writeRaw(`,"filters":`)
+		req.Filters.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, req.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatEventLogRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns a list of service actions taken by chat members and administrators in the last 48 hours. Available only for supergroups and channels. Requires administrator rights. Returns results in reverse chronological order (i.e., in order of decreasing event_id)
+//
+// Available to users only
+func (client *Client) GetChatEventLog(ctx context.Context, req *GetChatEventLogRequest) (*ChatEvents, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatEvents)
+}
+
+type SetNewChatPrivacySettingsRequest struct {
+	request
+	// New settings
+	Settings *NewChatPrivacySettings `json:"settings"`
+}
+
+func (req SetNewChatPrivacySettingsRequest) GetFunctionName() string {
+	return "setNewChatPrivacySettings"
+}
+
+func (req *SetNewChatPrivacySettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetNewChatPrivacySettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setNewChatPrivacySettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"settings":`)
+	req.Settings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetNewChatPrivacySettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes privacy settings for new chat creation; can be used only if getOption("can_set_new_chat_privacy_settings")
+//
+// Available to users only
+func (client *Client) SetNewChatPrivacySettings(ctx context.Context, req *SetNewChatPrivacySettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetNewChatPrivacySettingsRequest struct {
+	request
+}
+
+func (req GetNewChatPrivacySettingsRequest) GetFunctionName() string {
+	return "getNewChatPrivacySettings"
+}
+
+func (req *GetNewChatPrivacySettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetNewChatPrivacySettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getNewChatPrivacySettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetNewChatPrivacySettingsRequest) Validate() error {
+	return nil
+}
+
+// Returns privacy settings for new chat creation
+//
+// Available to users only
+func (client *Client) GetNewChatPrivacySettings(ctx context.Context) (*NewChatPrivacySettings, error) {
+	req := &GetNewChatPrivacySettingsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalNewChatPrivacySettings)
+}
+
+type RemoveChatActionBarRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req RemoveChatActionBarRequest) GetFunctionName() string {
+	return "removeChatActionBar"
+}
+
+func (req *RemoveChatActionBarRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveChatActionBarRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeChatActionBar"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveChatActionBarRequest) Validate() error {
+	return nil
+}
+
+// Removes a chat action bar without any other action
+//
+// Available to users only
+func (client *Client) RemoveChatActionBar(ctx context.Context, req *RemoveChatActionBarRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportChatRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Option identifier chosen by the user; leave empty for the initial request
+	OptionId []byte `json:"option_id"`
+	// Identifiers of reported messages. Use messageProperties.can_report_chat to check whether the message can be reported
+	MessageIds []int64 `json:"message_ids"`
+	// Additional report details if asked by the server; 0-1024 characters; leave empty for the initial request
+	Text string `json:"text"`
+}
+
+func (req ReportChatRequest) GetFunctionName() string {
+	return "reportChat"
+}
+
+func (req *ReportChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"option_id":`)
+	encoder.writeBytes(req.OptionId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw("}")
+}
+
+func (req ReportChatRequest) Validate() error {
+	if err := validateLength("text", req.Text, 0, 1024); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reports a chat to the Telegram moderators. A chat can be reported only from the chat action bar, or if chat.can_be_reported
+//
+// Available to users only
+func (client *Client) ReportChat(ctx context.Context, req *ReportChatRequest) (ReportChatResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalReportChatResult)
+}
+
+type ReportChatPhotoRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the photo to report. Only full photos from chatPhoto can be reported
+	FileId int32 `json:"file_id"`
+	// The reason for reporting the chat photo
+	Reason ReportReason `json:"reason"`
+	// Additional report details; 0-1024 characters
+	Text string `json:"text"`
+}
+
+func (req ReportChatPhotoRequest) GetFunctionName() string {
+	return "reportChatPhoto"
+}
+
+func (req *ReportChatPhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportChatPhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportChatPhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"reason":`)
+	encoder.writeType(req.Reason)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw("}")
+}
+
+func (req ReportChatPhotoRequest) Validate() error {
+	if err := validateLength("text", req.Text, 0, 1024); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reports a chat photo to the Telegram moderators. A chat photo can be reported only if chat.can_be_reported
+//
+// Available to users only
+func (client *Client) ReportChatPhoto(ctx context.Context, req *ReportChatPhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatStatisticsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Pass true if a dark theme is used by the application
+	IsDark bool `json:"is_dark"`
+}
+
+func (req GetChatStatisticsRequest) GetFunctionName() string {
+	return "getChatStatistics"
+}
+
+func (req *GetChatStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(req.IsDark)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns detailed statistics about a chat. Currently, this method can be used only for supergroups and channels. Can be used only if supergroupFullInfo.can_get_statistics == true
+//
+// Available to users only
+func (client *Client) GetChatStatistics(ctx context.Context, req *GetChatStatisticsRequest) (ChatStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatStatistics)
+}
@@ -0,0 +1,7579 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+// functionTypes are the bot or user restrictions of functions. Common functions are omitted
+var functionTypes = map[string]FunctionType{
+	"getPasswordState":                              FunctionTypeUser,
+	"setPassword":                                   FunctionTypeUser,
+	"setLoginEmailAddress":                          FunctionTypeUser,
+	"resendLoginEmailAddressCode":                   FunctionTypeUser,
+	"checkLoginEmailAddressCode":                    FunctionTypeUser,
+	"getRecoveryEmailAddress":                       FunctionTypeUser,
+	"setRecoveryEmailAddress":                       FunctionTypeUser,
+	"checkRecoveryEmailAddressCode":                 FunctionTypeUser,
+	"resendRecoveryEmailAddressCode":                FunctionTypeUser,
+	"cancelRecoveryEmailAddressVerification":        FunctionTypeUser,
+	"requestPasswordRecovery":                       FunctionTypeUser,
+	"checkPasswordRecoveryCode":                     FunctionTypeUser,
+	"recoverPassword":                               FunctionTypeUser,
+	"resetPassword":                                 FunctionTypeUser,
+	"cancelPasswordReset":                           FunctionTypeUser,
+	"createTemporaryPassword":                       FunctionTypeUser,
+	"getTemporaryPasswordState":                     FunctionTypeUser,
+	"getCallbackQueryMessage":                       FunctionTypeBot,
+	"getMessageThread":                              FunctionTypeUser,
+	"getMessageReadDate":                            FunctionTypeUser,
+	"getMessageViewers":                             FunctionTypeUser,
+	"loadChats":                                     FunctionTypeUser,
+	"getChats":                                      FunctionTypeUser,
+	"searchPublicChats":                             FunctionTypeUser,
+	"searchChats":                                   FunctionTypeUser,
+	"searchChatsOnServer":                           FunctionTypeUser,
+	"getRecommendedChats":                           FunctionTypeUser,
+	"getChatSimilarChats":                           FunctionTypeUser,
+	"getChatSimilarChatCount":                       FunctionTypeUser,
+	"openChatSimilarChat":                           FunctionTypeUser,
+	"getBotSimilarBots":                             FunctionTypeUser,
+	"getBotSimilarBotCount":                         FunctionTypeUser,
+	"openBotSimilarBot":                             FunctionTypeUser,
+	"getTopChats":                                   FunctionTypeUser,
+	"removeTopChat":                                 FunctionTypeUser,
+	"searchRecentlyFoundChats":                      FunctionTypeUser,
+	"addRecentlyFoundChat":                          FunctionTypeUser,
+	"removeRecentlyFoundChat":                       FunctionTypeUser,
+	"clearRecentlyFoundChats":                       FunctionTypeUser,
+	"getRecentlyOpenedChats":                        FunctionTypeUser,
+	"checkChatUsername":                             FunctionTypeUser,
+	"getCreatedPublicChats":                         FunctionTypeUser,
+	"checkCreatedPublicChatsLimit":                  FunctionTypeUser,
+	"getSuitableDiscussionChats":                    FunctionTypeUser,
+	"getInactiveSupergroupChats":                    FunctionTypeUser,
+	"getSuitablePersonalChats":                      FunctionTypeUser,
+	"loadSavedMessagesTopics":                       FunctionTypeUser,
+	"getSavedMessagesTopicHistory":                  FunctionTypeUser,
+	"getSavedMessagesTopicMessageByDate":            FunctionTypeUser,
+	"deleteSavedMessagesTopicHistory":               FunctionTypeUser,
+	"deleteSavedMessagesTopicMessagesByDate":        FunctionTypeUser,
+	"toggleSavedMessagesTopicIsPinned":              FunctionTypeUser,
+	"setPinnedSavedMessagesTopics":                  FunctionTypeUser,
+	"getGroupsInCommon":                             FunctionTypeUser,
+	"getChatHistory":                                FunctionTypeUser,
+	"getMessageThreadHistory":                       FunctionTypeUser,
+	"deleteChatHistory":                             FunctionTypeUser,
+	"deleteChat":                                    FunctionTypeUser,
+	"searchChatMessages":                            FunctionTypeUser,
+	"searchMessages":                                FunctionTypeUser,
+	"searchSecretMessages":                          FunctionTypeUser,
+	"searchSavedMessages":                           FunctionTypeUser,
+	"searchCallMessages":                            FunctionTypeUser,
+	"searchOutgoingDocumentMessages":                FunctionTypeUser,
+	"searchPublicMessagesByTag":                     FunctionTypeUser,
+	"searchPublicStoriesByTag":                      FunctionTypeUser,
+	"searchPublicStoriesByLocation":                 FunctionTypeUser,
+	"searchPublicStoriesByVenue":                    FunctionTypeUser,
+	"getSearchedForTags":                            FunctionTypeUser,
+	"removeSearchedForTag":                          FunctionTypeUser,
+	"clearSearchedForTags":                          FunctionTypeUser,
+	"deleteAllCallMessages":                         FunctionTypeUser,
+	"searchChatRecentLocationMessages":              FunctionTypeUser,
+	"getChatMessageByDate":                          FunctionTypeUser,
+	"getChatSparseMessagePositions":                 FunctionTypeUser,
+	"getChatMessageCalendar":                        FunctionTypeUser,
+	"getChatMessageCount":                           FunctionTypeUser,
+	"getChatMessagePosition":                        FunctionTypeUser,
+	"getChatScheduledMessages":                      FunctionTypeUser,
+	"getChatSponsoredMessages":                      FunctionTypeUser,
+	"clickChatSponsoredMessage":                     FunctionTypeUser,
+	"reportChatSponsoredMessage":                    FunctionTypeUser,
+	"getSearchSponsoredChats":                       FunctionTypeUser,
+	"viewSponsoredChat":                             FunctionTypeUser,
+	"openSponsoredChat":                             FunctionTypeUser,
+	"reportSponsoredChat":                           FunctionTypeUser,
+	"removeNotification":                            FunctionTypeUser,
+	"removeNotificationGroup":                       FunctionTypeUser,
+	"getMessageEmbeddingCode":                       FunctionTypeUser,
+	"translateText":                                 FunctionTypeUser,
+	"translateMessageText":                          FunctionTypeUser,
+	"recognizeSpeech":                               FunctionTypeUser,
+	"rateSpeechRecognition":                         FunctionTypeUser,
+	"getChatAvailableMessageSenders":                FunctionTypeUser,
+	"setChatMessageSender":                          FunctionTypeUser,
+	"sendBotStartMessage":                           FunctionTypeUser,
+	"sendInlineQueryResultMessage":                  FunctionTypeUser,
+	"addLocalMessage":                               FunctionTypeUser,
+	"deleteChatMessagesBySender":                    FunctionTypeUser,
+	"deleteChatMessagesByDate":                      FunctionTypeUser,
+	"editMessageReplyMarkup":                        FunctionTypeBot,
+	"editInlineMessageText":                         FunctionTypeBot,
+	"editInlineMessageLiveLocation":                 FunctionTypeBot,
+	"editInlineMessageMedia":                        FunctionTypeBot,
+	"editInlineMessageCaption":                      FunctionTypeBot,
+	"editInlineMessageReplyMarkup":                  FunctionTypeBot,
+	"editMessageSchedulingState":                    FunctionTypeUser,
+	"setMessageFactCheck":                           FunctionTypeUser,
+	"sendBusinessMessage":                           FunctionTypeBot,
+	"sendBusinessMessageAlbum":                      FunctionTypeBot,
+	"editBusinessMessageText":                       FunctionTypeBot,
+	"editBusinessMessageLiveLocation":               FunctionTypeBot,
+	"editBusinessMessageMedia":                      FunctionTypeBot,
+	"editBusinessMessageCaption":                    FunctionTypeBot,
+	"editBusinessMessageReplyMarkup":                FunctionTypeBot,
+	"stopBusinessPoll":                              FunctionTypeBot,
+	"setBusinessMessageIsPinned":                    FunctionTypeBot,
+	"readBusinessMessage":                           FunctionTypeBot,
+	"deleteBusinessMessages":                        FunctionTypeBot,
+	"editBusinessStory":                             FunctionTypeBot,
+	"deleteBusinessStory":                           FunctionTypeBot,
+	"setBusinessAccountName":                        FunctionTypeBot,
+	"setBusinessAccountBio":                         FunctionTypeBot,
+	"setBusinessAccountProfilePhoto":                FunctionTypeBot,
+	"setBusinessAccountUsername":                    FunctionTypeBot,
+	"setBusinessAccountGiftSettings":                FunctionTypeBot,
+	"getBusinessAccountStarAmount":                  FunctionTypeBot,
+	"transferBusinessAccountStars":                  FunctionTypeBot,
+	"loadQuickReplyShortcuts":                       FunctionTypeUser,
+	"setQuickReplyShortcutName":                     FunctionTypeUser,
+	"deleteQuickReplyShortcut":                      FunctionTypeUser,
+	"reorderQuickReplyShortcuts":                    FunctionTypeUser,
+	"loadQuickReplyShortcutMessages":                FunctionTypeUser,
+	"deleteQuickReplyShortcutMessages":              FunctionTypeUser,
+	"addQuickReplyShortcutMessage":                  FunctionTypeUser,
+	"addQuickReplyShortcutInlineQueryResultMessage": FunctionTypeUser,
+	"addQuickReplyShortcutMessageAlbum":             FunctionTypeUser,
+	"readdQuickReplyShortcutMessages":               FunctionTypeUser,
+	"editQuickReplyMessage":                         FunctionTypeUser,
+	"getForumTopics":                                FunctionTypeUser,
+	"setForumTopicNotificationSettings":             FunctionTypeUser,
+	"toggleForumTopicIsPinned":                      FunctionTypeUser,
+	"setPinnedForumTopics":                          FunctionTypeUser,
+	"getEmojiReaction":                              FunctionTypeUser,
+	"getCustomEmojiReactionAnimations":              FunctionTypeUser,
+	"getMessageAvailableReactions":                  FunctionTypeUser,
+	"clearRecentReactions":                          FunctionTypeUser,
+	"addMessageReaction":                            FunctionTypeUser,
+	"removeMessageReaction":                         FunctionTypeUser,
+	"getChatAvailablePaidMessageReactionSenders":    FunctionTypeUser,
+	"addPendingPaidMessageReaction":                 FunctionTypeUser,
+	"commitPendingPaidMessageReactions":             FunctionTypeUser,
+	"removePendingPaidMessageReactions":             FunctionTypeUser,
+	"setPaidMessageReactionType":                    FunctionTypeUser,
+	"setMessageReactions":                           FunctionTypeBot,
+	"getMessageAddedReactions":                      FunctionTypeUser,
+	"setDefaultReactionType":                        FunctionTypeUser,
+	"getSavedMessagesTags":                          FunctionTypeUser,
+	"setSavedMessagesTagLabel":                      FunctionTypeUser,
+	"getMessageEffect":                              FunctionTypeUser,
+	"setPollAnswer":                                 FunctionTypeUser,
+	"getPollVoters":                                 FunctionTypeUser,
+	"hideSuggestedAction":                           FunctionTypeUser,
+	"hideContactCloseBirthdays":                     FunctionTypeUser,
+	"getBusinessConnection":                         FunctionTypeBot,
+	"getLoginUrlInfo":                               FunctionTypeUser,
+	"getLoginUrl":                                   FunctionTypeUser,
+	"shareUsersWithBot":                             FunctionTypeUser,
+	"shareChatWithBot":                              FunctionTypeUser,
+	"getInlineQueryResults":                         FunctionTypeUser,
+	"answerInlineQuery":                             FunctionTypeBot,
+	"savePreparedInlineMessage":                     FunctionTypeBot,
+	"getPreparedInlineMessage":                      FunctionTypeUser,
+	"getGrossingWebAppBots":                         FunctionTypeUser,
+	"searchWebApp":                                  FunctionTypeUser,
+	"getWebAppPlaceholder":                          FunctionTypeUser,
+	"getWebAppLinkUrl":                              FunctionTypeUser,
+	"getMainWebApp":                                 FunctionTypeUser,
+	"getWebAppUrl":                                  FunctionTypeUser,
+	"sendWebAppData":                                FunctionTypeUser,
+	"openWebApp":                                    FunctionTypeUser,
+	"closeWebApp":                                   FunctionTypeUser,
+	"answerWebAppQuery":                             FunctionTypeBot,
+	"checkWebAppFileDownload":                       FunctionTypeUser,
+	"getCallbackQueryAnswer":                        FunctionTypeUser,
+	"answerCallbackQuery":                           FunctionTypeBot,
+	"answerShippingQuery":                           FunctionTypeBot,
+	"answerPreCheckoutQuery":                        FunctionTypeBot,
+	"setGameScore":                                  FunctionTypeBot,
+	"setInlineGameScore":                            FunctionTypeBot,
+	"getGameHighScores":                             FunctionTypeBot,
+	"getInlineGameHighScores":                       FunctionTypeBot,
+	"deleteChatReplyMarkup":                         FunctionTypeUser,
+	"openChat":                                      FunctionTypeUser,
+	"closeChat":                                     FunctionTypeUser,
+	"viewMessages":                                  FunctionTypeUser,
+	"openMessageContent":                            FunctionTypeUser,
+	"clickAnimatedEmojiMessage":                     FunctionTypeUser,
+	"getExternalLinkInfo":                           FunctionTypeUser,
+	"getExternalLink":                               FunctionTypeUser,
+	"readAllChatMentions":                           FunctionTypeUser,
+	"readAllMessageThreadMentions":                  FunctionTypeUser,
+	"readAllChatReactions":                          FunctionTypeUser,
+	"readAllMessageThreadReactions":                 FunctionTypeUser,
+	"createNewBasicGroupChat":                       FunctionTypeUser,
+	"createNewSupergroupChat":                       FunctionTypeUser,
+	"createNewSecretChat":                           FunctionTypeUser,
+	"upgradeBasicGroupChatToSupergroupChat":         FunctionTypeUser,
+	"getChatListsToAddChat":                         FunctionTypeUser,
+	"addChatToList":                                 FunctionTypeUser,
+	"getChatFolder":                                 FunctionTypeUser,
+	"createChatFolder":                              FunctionTypeUser,
+	"editChatFolder":                                FunctionTypeUser,
+	"deleteChatFolder":                              FunctionTypeUser,
+	"getChatFolderChatsToLeave":                     FunctionTypeUser,
+	"getChatFolderChatCount":                        FunctionTypeUser,
+	"reorderChatFolders":                            FunctionTypeUser,
+	"toggleChatFolderTags":                          FunctionTypeUser,
+	"getRecommendedChatFolders":                     FunctionTypeUser,
+	"getChatsForChatFolderInviteLink":               FunctionTypeUser,
+	"createChatFolderInviteLink":                    FunctionTypeUser,
+	"getChatFolderInviteLinks":                      FunctionTypeUser,
+	"editChatFolderInviteLink":                      FunctionTypeUser,
+	"deleteChatFolderInviteLink":                    FunctionTypeUser,
+	"checkChatFolderInviteLink":                     FunctionTypeUser,
+	"addChatFolderByInviteLink":                     FunctionTypeUser,
+	"getChatFolderNewChats":                         FunctionTypeUser,
+	"processChatFolderNewChats":                     FunctionTypeUser,
+	"getArchiveChatListSettings":                    FunctionTypeUser,
+	"setArchiveChatListSettings":                    FunctionTypeUser,
+	"setChatAccentColor":                            FunctionTypeUser,
+	"setChatProfileAccentColor":                     FunctionTypeUser,
+	"setChatMessageAutoDeleteTime":                  FunctionTypeUser,
+	"setChatEmojiStatus":                            FunctionTypeUser,
+	"setChatBackground":                             FunctionTypeUser,
+	"deleteChatBackground":                          FunctionTypeUser,
+	"setChatTheme":                                  FunctionTypeUser,
+	"setChatDraftMessage":                           FunctionTypeUser,
+	"setChatNotificationSettings":                   FunctionTypeUser,
+	"toggleChatHasProtectedContent":                 FunctionTypeUser,
+	"toggleChatViewAsTopics":                        FunctionTypeUser,
+	"toggleChatIsTranslatable":                      FunctionTypeUser,
+	"toggleChatIsMarkedAsUnread":                    FunctionTypeUser,
+	"toggleChatDefaultDisableNotification":          FunctionTypeUser,
+	"setChatDiscussionGroup":                        FunctionTypeUser,
+	"setChatLocation":                               FunctionTypeUser,
+	"setChatSlowModeDelay":                          FunctionTypeUser,
+	"joinChat":                                      FunctionTypeUser,
+	"addChatMember":                                 FunctionTypeUser,
+	"addChatMembers":                                FunctionTypeUser,
+	"canTransferOwnership":                          FunctionTypeUser,
+	"transferChatOwnership":                         FunctionTypeUser,
+	"clearAllDraftMessages":                         FunctionTypeUser,
+	"getSavedNotificationSound":                     FunctionTypeUser,
+	"getSavedNotificationSounds":                    FunctionTypeUser,
+	"addSavedNotificationSound":                     FunctionTypeUser,
+	"removeSavedNotificationSound":                  FunctionTypeUser,
+	"getChatNotificationSettingsExceptions":         FunctionTypeUser,
+	"getScopeNotificationSettings":                  FunctionTypeUser,
+	"setScopeNotificationSettings":                  FunctionTypeUser,
+	"setReactionNotificationSettings":               FunctionTypeUser,
+	"resetAllNotificationSettings":                  FunctionTypeUser,
+	"toggleChatIsPinned":                            FunctionTypeUser,
+	"setPinnedChats":                                FunctionTypeUser,
+	"readChatList":                                  FunctionTypeUser,
+	"getCurrentWeather":                             FunctionTypeUser,
+	"getStory":                                      FunctionTypeUser,
+	"getChatsToSendStories":                         FunctionTypeUser,
+	"canSendStory":                                  FunctionTypeUser,
+	"editStory":                                     FunctionTypeUser,
+	"editStoryCover":                                FunctionTypeUser,
+	"setStoryPrivacySettings":                       FunctionTypeUser,
+	"toggleStoryIsPostedToChatPage":                 FunctionTypeUser,
+	"deleteStory":                                   FunctionTypeUser,
+	"getStoryNotificationSettingsExceptions":        FunctionTypeUser,
+	"loadActiveStories":                             FunctionTypeUser,
+	"setChatActiveStoriesList":                      FunctionTypeUser,
+	"getChatActiveStories":                          FunctionTypeUser,
+	"getChatPostedToChatPageStories":                FunctionTypeUser,
+	"getChatArchivedStories":                        FunctionTypeUser,
+	"setChatPinnedStories":                          FunctionTypeUser,
+	"openStory":                                     FunctionTypeUser,
+	"closeStory":                                    FunctionTypeUser,
+	"getStoryAvailableReactions":                    FunctionTypeUser,
+	"setStoryReaction":                              FunctionTypeUser,
+	"getStoryInteractions":                          FunctionTypeUser,
+	"getChatStoryInteractions":                      FunctionTypeUser,
+	"reportStory":                                   FunctionTypeUser,
+	"getStoryPublicForwards":                        FunctionTypeUser,
+	"getChatBoostLevelFeatures":                     FunctionTypeUser,
+	"getChatBoostFeatures":                          FunctionTypeUser,
+	"getAvailableChatBoostSlots":                    FunctionTypeUser,
+	"getChatBoostStatus":                            FunctionTypeUser,
+	"boostChat":                                     FunctionTypeUser,
+	"getChatBoosts":                                 FunctionTypeUser,
+	"getUserChatBoosts":                             FunctionTypeBot,
+	"getAttachmentMenuBot":                          FunctionTypeUser,
+	"toggleBotIsAddedToAttachmentMenu":              FunctionTypeUser,
+	"getThemedEmojiStatuses":                        FunctionTypeUser,
+	"getRecentEmojiStatuses":                        FunctionTypeUser,
+	"getUpgradedGiftEmojiStatuses":                  FunctionTypeUser,
+	"getDefaultEmojiStatuses":                       FunctionTypeUser,
+	"clearRecentEmojiStatuses":                      FunctionTypeUser,
+	"getThemedChatEmojiStatuses":                    FunctionTypeUser,
+	"getDefaultChatEmojiStatuses":                   FunctionTypeUser,
+	"getDisallowedChatEmojiStatuses":                FunctionTypeUser,
+	"setApplicationVerificationToken":               FunctionTypeUser,
+	"getMessageFileType":                            FunctionTypeUser,
+	"getMessageImportConfirmationText":              FunctionTypeUser,
+	"importMessages":                                FunctionTypeUser,
+	"getChatInviteLink":                             FunctionTypeUser,
+	"getChatInviteLinkCounts":                       FunctionTypeUser,
+	"getChatInviteLinks":                            FunctionTypeUser,
+	"getChatInviteLinkMembers":                      FunctionTypeUser,
+	"deleteRevokedChatInviteLink":                   FunctionTypeUser,
+	"deleteAllRevokedChatInviteLinks":               FunctionTypeUser,
+	"checkChatInviteLink":                           FunctionTypeUser,
+	"joinChatByInviteLink":                          FunctionTypeUser,
+	"getChatJoinRequests":                           FunctionTypeUser,
+	"processChatJoinRequests":                       FunctionTypeUser,
+	"createCall":                                    FunctionTypeUser,
+	"acceptCall":                                    FunctionTypeUser,
+	"sendCallSignalingData":                         FunctionTypeUser,
+	"discardCall":                                   FunctionTypeUser,
+	"sendCallRating":                                FunctionTypeUser,
+	"sendCallDebugInformation":                      FunctionTypeUser,
+	"sendCallLog":                                   FunctionTypeUser,
+	"getVideoChatAvailableParticipants":             FunctionTypeUser,
+	"setVideoChatDefaultParticipant":                FunctionTypeUser,
+	"createVideoChat":                               FunctionTypeUser,
+	"createGroupCall":                               FunctionTypeUser,
+	"getVideoChatRtmpUrl":                           FunctionTypeUser,
+	"replaceVideoChatRtmpUrl":                       FunctionTypeUser,
+	"getGroupCall":                                  FunctionTypeUser,
+	"startScheduledGroupCall":                       FunctionTypeUser,
+	"toggleGroupCallEnabledStartNotification":       FunctionTypeUser,
+	"joinGroupCall":                                 FunctionTypeUser,
+	"startGroupCallScreenSharing":                   FunctionTypeUser,
+	"toggleGroupCallScreenSharingIsPaused":          FunctionTypeUser,
+	"endGroupCallScreenSharing":                     FunctionTypeUser,
+	"setGroupCallTitle":                             FunctionTypeUser,
+	"toggleGroupCallMuteNewParticipants":            FunctionTypeUser,
+	"inviteGroupCallParticipants":                   FunctionTypeUser,
+	"getGroupCallInviteLink":                        FunctionTypeUser,
+	"revokeGroupCallInviteLink":                     FunctionTypeUser,
+	"startGroupCallRecording":                       FunctionTypeUser,
+	"endGroupCallRecording":                         FunctionTypeUser,
+	"toggleGroupCallIsMyVideoPaused":                FunctionTypeUser,
+	"toggleGroupCallIsMyVideoEnabled":               FunctionTypeUser,
+	"setGroupCallParticipantIsSpeaking":             FunctionTypeUser,
+	"toggleGroupCallParticipantIsMuted":             FunctionTypeUser,
+	"setGroupCallParticipantVolumeLevel":            FunctionTypeUser,
+	"toggleGroupCallParticipantIsHandRaised":        FunctionTypeUser,
+	"loadGroupCallParticipants":                     FunctionTypeUser,
+	"leaveGroupCall":                                FunctionTypeUser,
+	"endGroupCall":                                  FunctionTypeUser,
+	"getGroupCallStreams":                           FunctionTypeUser,
+	"getGroupCallStreamSegment":                     FunctionTypeUser,
+	"setMessageSenderBlockList":                     FunctionTypeUser,
+	"blockMessageSenderFromReplies":                 FunctionTypeUser,
+	"getBlockedMessageSenders":                      FunctionTypeUser,
+	"addContact":                                    FunctionTypeUser,
+	"importContacts":                                FunctionTypeUser,
+	"getContacts":                                   FunctionTypeUser,
+	"searchContacts":                                FunctionTypeUser,
+	"removeContacts":                                FunctionTypeUser,
+	"getImportedContactCount":                       FunctionTypeUser,
+	"changeImportedContacts":                        FunctionTypeUser,
+	"clearImportedContacts":                         FunctionTypeUser,
+	"setCloseFriends":                               FunctionTypeUser,
+	"getCloseFriends":                               FunctionTypeUser,
+	"setUserPersonalProfilePhoto":                   FunctionTypeUser,
+	"suggestUserProfilePhoto":                       FunctionTypeUser,
+	"toggleBotCanManageEmojiStatus":                 FunctionTypeUser,
+	"setUserEmojiStatus":                            FunctionTypeBot,
+	"searchUserByPhoneNumber":                       FunctionTypeUser,
+	"sharePhoneNumber":                              FunctionTypeUser,
+	"getStickerOutline":                             FunctionTypeUser,
+	"getStickers":                                   FunctionTypeUser,
+	"getAllStickerEmojis":                           FunctionTypeUser,
+	"searchStickers":                                FunctionTypeUser,
+	"getGreetingStickers":                           FunctionTypeUser,
+	"getPremiumStickers":                            FunctionTypeUser,
+	"getInstalledStickerSets":                       FunctionTypeUser,
+	"getArchivedStickerSets":                        FunctionTypeUser,
+	"getTrendingStickerSets":                        FunctionTypeUser,
+	"getAttachedStickerSets":                        FunctionTypeUser,
+	"changeStickerSet":                              FunctionTypeUser,
+	"viewTrendingStickerSets":                       FunctionTypeUser,
+	"reorderInstalledStickerSets":                   FunctionTypeUser,
+	"getRecentStickers":                             FunctionTypeUser,
+	"addRecentSticker":                              FunctionTypeUser,
+	"removeRecentSticker":                           FunctionTypeUser,
+	"clearRecentStickers":                           FunctionTypeUser,
+	"getFavoriteStickers":                           FunctionTypeUser,
+	"addFavoriteSticker":                            FunctionTypeUser,
+	"removeFavoriteSticker":                         FunctionTypeUser,
+	"getStickerEmojis":                              FunctionTypeUser,
+	"searchEmojis":                                  FunctionTypeUser,
+	"getKeywordEmojis":                              FunctionTypeUser,
+	"getEmojiCategories":                            FunctionTypeUser,
+	"getAnimatedEmoji":                              FunctionTypeUser,
+	"getEmojiSuggestionsUrl":                        FunctionTypeUser,
+	"getDefaultChatPhotoCustomEmojiStickers":        FunctionTypeUser,
+	"getDefaultProfilePhotoCustomEmojiStickers":     FunctionTypeUser,
+	"getDefaultBackgroundCustomEmojiStickers":       FunctionTypeUser,
+	"getSavedAnimations":                            FunctionTypeUser,
+	"addSavedAnimation":                             FunctionTypeUser,
+	"removeSavedAnimation":                          FunctionTypeUser,
+	"getRecentInlineBots":                           FunctionTypeUser,
+	"getOwnedBots":                                  FunctionTypeUser,
+	"searchHashtags":                                FunctionTypeUser,
+	"removeRecentHashtag":                           FunctionTypeUser,
+	"getLinkPreview":                                FunctionTypeUser,
+	"getWebPageInstantView":                         FunctionTypeUser,
+	"setProfilePhoto":                               FunctionTypeUser,
+	"deleteProfilePhoto":                            FunctionTypeUser,
+	"setAccentColor":                                FunctionTypeUser,
+	"setProfileAccentColor":                         FunctionTypeUser,
+	"setName":                                       FunctionTypeUser,
+	"setBio":                                        FunctionTypeUser,
+	"setUsername":                                   FunctionTypeUser,
+	"toggleUsernameIsActive":                        FunctionTypeUser,
+	"reorderActiveUsernames":                        FunctionTypeUser,
+	"setBirthdate":                                  FunctionTypeUser,
+	"setPersonalChat":                               FunctionTypeUser,
+	"setEmojiStatus":                                FunctionTypeUser,
+	"toggleHasSponsoredMessagesEnabled":             FunctionTypeUser,
+	"setBusinessLocation":                           FunctionTypeUser,
+	"setBusinessOpeningHours":                       FunctionTypeUser,
+	"setBusinessGreetingMessageSettings":            FunctionTypeUser,
+	"setBusinessAwayMessageSettings":                FunctionTypeUser,
+	"setBusinessStartPage":                          FunctionTypeUser,
+	"sendPhoneNumberCode":                           FunctionTypeUser,
+	"sendPhoneNumberFirebaseSms":                    FunctionTypeUser,
+	"reportPhoneNumberCodeMissing":                  FunctionTypeUser,
+	"resendPhoneNumberCode":                         FunctionTypeUser,
+	"checkPhoneNumberCode":                          FunctionTypeUser,
+	"getBusinessConnectedBot":                       FunctionTypeUser,
+	"setBusinessConnectedBot":                       FunctionTypeUser,
+	"deleteBusinessConnectedBot":                    FunctionTypeUser,
+	"toggleBusinessConnectedBotChatIsPaused":        FunctionTypeUser,
+	"removeBusinessConnectedBotFromChat":            FunctionTypeUser,
+	"getBusinessChatLinks":                          FunctionTypeUser,
+	"createBusinessChatLink":                        FunctionTypeUser,
+	"editBusinessChatLink":                          FunctionTypeUser,
+	"deleteBusinessChatLink":                        FunctionTypeUser,
+	"getBusinessChatLinkInfo":                       FunctionTypeUser,
+	"getUserLink":                                   FunctionTypeUser,
+	"searchUserByToken":                             FunctionTypeUser,
+	"setCommands":                                   FunctionTypeBot,
+	"deleteCommands":                                FunctionTypeBot,
+	"getCommands":                                   FunctionTypeBot,
+	"setMenuButton":                                 FunctionTypeBot,
+	"getMenuButton":                                 FunctionTypeBot,
+	"setDefaultGroupAdministratorRights":            FunctionTypeBot,
+	"setDefaultChannelAdministratorRights":          FunctionTypeBot,
+	"canBotSendMessages":                            FunctionTypeUser,
+	"allowBotToSendMessages":                        FunctionTypeUser,
+	"sendWebAppCustomRequest":                       FunctionTypeUser,
+	"getBotMediaPreviews":                           FunctionTypeUser,
+	"getBotMediaPreviewInfo":                        FunctionTypeUser,
+	"addBotMediaPreview":                            FunctionTypeUser,
+	"editBotMediaPreview":                           FunctionTypeUser,
+	"reorderBotMediaPreviews":                       FunctionTypeUser,
+	"deleteBotMediaPreviews":                        FunctionTypeUser,
+	"toggleBotUsernameIsActive":                     FunctionTypeUser,
+	"reorderBotActiveUsernames":                     FunctionTypeUser,
+	"getActiveSessions":                             FunctionTypeUser,
+	"terminateSession":                              FunctionTypeUser,
+	"terminateAllOtherSessions":                     FunctionTypeUser,
+	"confirmSession":                                FunctionTypeUser,
+	"toggleSessionCanAcceptCalls":                   FunctionTypeUser,
+	"toggleSessionCanAcceptSecretChats":             FunctionTypeUser,
+	"setInactiveSessionTtl":                         FunctionTypeUser,
+	"getConnectedWebsites":                          FunctionTypeUser,
+	"disconnectWebsite":                             FunctionTypeUser,
+	"disconnectAllWebsites":                         FunctionTypeUser,
+	"setSupergroupUsername":                         FunctionTypeUser,
+	"toggleSupergroupUsernameIsActive":              FunctionTypeUser,
+	"disableAllSupergroupUsernames":                 FunctionTypeUser,
+	"reorderSupergroupActiveUsernames":              FunctionTypeUser,
+	"toggleSupergroupSignMessages":                  FunctionTypeUser,
+	"toggleSupergroupJoinToSendMessages":            FunctionTypeUser,
+	"toggleSupergroupJoinByRequest":                 FunctionTypeUser,
+	"toggleSupergroupIsAllHistoryAvailable":         FunctionTypeUser,
+	"toggleSupergroupCanHaveSponsoredMessages":      FunctionTypeUser,
+	"toggleSupergroupHasHiddenMembers":              FunctionTypeUser,
+	"toggleSupergroupHasAggressiveAntiSpamEnabled":  FunctionTypeUser,
+	"toggleSupergroupIsForum":                       FunctionTypeUser,
+	"toggleSupergroupIsBroadcastGroup":              FunctionTypeUser,
+	"reportSupergroupSpam":                          FunctionTypeUser,
+	"reportSupergroupAntiSpamFalsePositive":         FunctionTypeUser,
+	"getChatEventLog":                               FunctionTypeUser,
+	"getPaymentForm":                                FunctionTypeUser,
+	"validateOrderInfo":                             FunctionTypeUser,
+	"sendPaymentForm":                               FunctionTypeUser,
+	"getPaymentReceipt":                             FunctionTypeUser,
+	"getSavedOrderInfo":                             FunctionTypeUser,
+	"deleteSavedOrderInfo":                          FunctionTypeUser,
+	"deleteSavedCredentials":                        FunctionTypeUser,
+	"setGiftSettings":                               FunctionTypeUser,
+	"toggleGiftIsSaved":                             FunctionTypeUser,
+	"setPinnedGifts":                                FunctionTypeUser,
+	"toggleChatGiftNotifications":                   FunctionTypeUser,
+	"getGiftUpgradePreview":                         FunctionTypeUser,
+	"getReceivedGift":                               FunctionTypeUser,
+	"getUpgradedGift":                               FunctionTypeUser,
+	"getUpgradedGiftWithdrawalUrl":                  FunctionTypeUser,
+	"createInvoiceLink":                             FunctionTypeBot,
+	"refundStarPayment":                             FunctionTypeBot,
+	"getSupportUser":                                FunctionTypeUser,
+	"getBackgroundUrl":                              FunctionTypeUser,
+	"searchBackground":                              FunctionTypeUser,
+	"setDefaultBackground":                          FunctionTypeUser,
+	"deleteDefaultBackground":                       FunctionTypeUser,
+	"getInstalledBackgrounds":                       FunctionTypeUser,
+	"removeInstalledBackground":                     FunctionTypeUser,
+	"resetInstalledBackgrounds":                     FunctionTypeUser,
+	"getLocalizationTargetInfo":                     FunctionTypeUser,
+	"getLanguagePackInfo":                           FunctionTypeUser,
+	"getLanguagePackStrings":                        FunctionTypeUser,
+	"synchronizeLanguagePack":                       FunctionTypeUser,
+	"addCustomServerLanguagePack":                   FunctionTypeUser,
+	"setCustomLanguagePack":                         FunctionTypeUser,
+	"editCustomLanguagePackInfo":                    FunctionTypeUser,
+	"setCustomLanguagePackString":                   FunctionTypeUser,
+	"deleteLanguagePack":                            FunctionTypeUser,
+	"registerDevice":                                FunctionTypeUser,
+	"processPushNotification":                       FunctionTypeUser,
+	"getRecentlyVisitedTMeUrls":                     FunctionTypeUser,
+	"setUserPrivacySettingRules":                    FunctionTypeUser,
+	"getUserPrivacySettingRules":                    FunctionTypeUser,
+	"setReadDatePrivacySettings":                    FunctionTypeUser,
+	"getReadDatePrivacySettings":                    FunctionTypeUser,
+	"setNewChatPrivacySettings":                     FunctionTypeUser,
+	"getNewChatPrivacySettings":                     FunctionTypeUser,
+	"getPaidMessageRevenue":                         FunctionTypeUser,
+	"allowUnpaidMessagesFromUser":                   FunctionTypeUser,
+	"setChatPaidMessageStarCount":                   FunctionTypeUser,
+	"canSendMessageToUser":                          FunctionTypeUser,
+	"setAccountTtl":                                 FunctionTypeUser,
+	"getAccountTtl":                                 FunctionTypeUser,
+	"deleteAccount":                                 FunctionTypeUser,
+	"setDefaultMessageAutoDeleteTime":               FunctionTypeUser,
+	"getDefaultMessageAutoDeleteTime":               FunctionTypeUser,
+	"removeChatActionBar":                           FunctionTypeUser,
+	"reportChat":                                    FunctionTypeUser,
+	"reportChatPhoto":                               FunctionTypeUser,
+	"reportMessageReactions":                        FunctionTypeUser,
+	"getChatRevenueStatistics":                      FunctionTypeUser,
+	"getChatRevenueWithdrawalUrl":                   FunctionTypeUser,
+	"getChatRevenueTransactions":                    FunctionTypeUser,
+	"getStarRevenueStatistics":                      FunctionTypeUser,
+	"getStarWithdrawalUrl":                          FunctionTypeUser,
+	"getStarAdAccountUrl":                           FunctionTypeUser,
+	"getChatStatistics":                             FunctionTypeUser,
+	"getMessageStatistics":                          FunctionTypeUser,
+	"getMessagePublicForwards":                      FunctionTypeUser,
+	"getStoryStatistics":                            FunctionTypeUser,
+	"getStatisticalGraph":                           FunctionTypeUser,
+	"getStorageStatisticsFast":                      FunctionTypeUser,
+	"getAutoDownloadSettingsPresets":                FunctionTypeUser,
+	"setAutoDownloadSettings":                       FunctionTypeUser,
+	"getAutosaveSettings":                           FunctionTypeUser,
+	"setAutosaveSettings":                           FunctionTypeUser,
+	"clearAutosaveSettingsExceptions":               FunctionTypeUser,
+	"getBankCardInfo":                               FunctionTypeUser,
+	"getPassportElement":                            FunctionTypeUser,
+	"getAllPassportElements":                        FunctionTypeUser,
+	"setPassportElement":                            FunctionTypeUser,
+	"deletePassportElement":                         FunctionTypeUser,
+	"setPassportElementErrors":                      FunctionTypeBot,
+	"getPreferredCountryLanguage":                   FunctionTypeUser,
+	"sendEmailAddressVerificationCode":              FunctionTypeUser,
+	"resendEmailAddressVerificationCode":            FunctionTypeUser,
+	"checkEmailAddressVerificationCode":             FunctionTypeUser,
+	"getPassportAuthorizationForm":                  FunctionTypeUser,
+	"getPassportAuthorizationFormAvailableElements": FunctionTypeUser,
+	"sendPassportAuthorizationForm":                 FunctionTypeUser,
+	"setBotUpdatesStatus":                           FunctionTypeBot,
+	"getOwnedStickerSets":                           FunctionTypeUser,
+	"getPremiumLimit":                               FunctionTypeUser,
+	"getPremiumFeatures":                            FunctionTypeUser,
+	"getPremiumStickerExamples":                     FunctionTypeUser,
+	"getPremiumInfoSticker":                         FunctionTypeUser,
+	"viewPremiumFeature":                            FunctionTypeUser,
+	"clickPremiumSubscriptionButton":                FunctionTypeUser,
+	"getPremiumState":                               FunctionTypeUser,
+	"getPremiumGiftPaymentOptions":                  FunctionTypeUser,
+	"getPremiumGiveawayPaymentOptions":              FunctionTypeUser,
+	"checkPremiumGiftCode":                          FunctionTypeUser,
+	"applyPremiumGiftCode":                          FunctionTypeUser,
+	"giftPremiumWithStars":                          FunctionTypeBot,
+	"launchPrepaidGiveaway":                         FunctionTypeUser,
+	"getGiveawayInfo":                               FunctionTypeUser,
+	"getStarPaymentOptions":                         FunctionTypeUser,
+	"getStarGiftPaymentOptions":                     FunctionTypeUser,
+	"getStarGiveawayPaymentOptions":                 FunctionTypeUser,
+	"getStarSubscriptions":                          FunctionTypeUser,
+	"canPurchaseFromStore":                          FunctionTypeUser,
+	"assignStoreTransaction":                        FunctionTypeUser,
+	"editStarSubscription":                          FunctionTypeUser,
+	"editUserStarSubscription":                      FunctionTypeBot,
+	"reuseStarSubscription":                         FunctionTypeUser,
+	"setChatAffiliateProgram":                       FunctionTypeUser,
+	"searchChatAffiliateProgram":                    FunctionTypeUser,
+	"searchAffiliatePrograms":                       FunctionTypeUser,
+	"connectAffiliateProgram":                       FunctionTypeUser,
+	"disconnectAffiliateProgram":                    FunctionTypeUser,
+	"getConnectedAffiliateProgram":                  FunctionTypeUser,
+	"getConnectedAffiliatePrograms":                 FunctionTypeUser,
+	"getBusinessFeatures":                           FunctionTypeUser,
+	"acceptTermsOfService":                          FunctionTypeUser,
+	"sendCustomRequest":                             FunctionTypeBot,
+	"answerCustomQuery":                             FunctionTypeBot,
+	"getApplicationConfig":                          FunctionTypeUser,
+	"saveApplicationLogEvent":                       FunctionTypeUser,
+	"getApplicationDownloadLink":                    FunctionTypeUser,
+	"getUserSupportInfo":                            FunctionTypeUser,
+	"setUserSupportInfo":                            FunctionTypeUser,
+	"getSupportName":                                FunctionTypeUser,
+}
+
+type SetTdlibParametersRequest struct {
+	request
+	// Pass true to use Telegram test environment instead of the production environment
+	UseTestDc bool `json:"use_test_dc"`
+	// The path to the directory for the persistent database; if empty, the current working directory will be used
+	DatabaseDirectory string `json:"database_directory"`
+	// The path to the directory for storing files; if empty, database_directory will be used
+	FilesDirectory string `json:"files_directory"`
+	// Encryption key for the database. If the encryption key is invalid, then an error with code 401 will be returned
+	DatabaseEncryptionKey []byte `json:"database_encryption_key"`
+	// Pass true to keep information about downloaded and uploaded files between application restarts
+	UseFileDatabase bool `json:"use_file_database"`
+	// Pass true to keep cache of users, basic groups, supergroups, channels and secret chats between restarts. Implies use_file_database
+	UseChatInfoDatabase bool `json:"use_chat_info_database"`
+	// Pass true to keep cache of chats and messages between restarts. Implies use_chat_info_database
+	UseMessageDatabase bool `json:"use_message_database"`
+	// Pass true to enable support for secret chats
+	UseSecretChats bool `json:"use_secret_chats"`
+	// Application identifier for Telegram API access, which can be obtained at https://my.telegram.org
+	ApiId int32 `json:"api_id"`
+	// Application identifier hash for Telegram API access, which can be obtained at https://my.telegram.org
+	ApiHash string `json:"api_hash"`
+	// IETF language tag of the user's operating system language; must be non-empty
+	SystemLanguageCode string `json:"system_language_code"`
+	// Model of the device the application is being run on; must be non-empty
+	DeviceModel string `json:"device_model"`
+	// Version of the operating system the application is being run on. If empty, the version is automatically detected by TDLib
+	SystemVersion string `json:"system_version"`
+	// Application version; must be non-empty
+	ApplicationVersion string `json:"application_version"`
+}
+
+func (req SetTdlibParametersRequest) GetFunctionName() string {
+	return "setTdlibParameters"
+}
+
+func (req *SetTdlibParametersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetTdlibParametersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setTdlibParameters"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"use_test_dc":`)
+	encoder.writeBool(req.UseTestDc)
+	encoder.writeRaw(`,"database_directory":`)
+	encoder.writeString(req.DatabaseDirectory)
+	encoder.writeRaw(`,"files_directory":`)
+	encoder.writeString(req.FilesDirectory)
+	encoder.writeRaw(`,"database_encryption_key":`)
+	encoder.writeBytes(req.DatabaseEncryptionKey)
+	encoder.writeRaw(`,"use_file_database":`)
+	encoder.writeBool(req.UseFileDatabase)
+	encoder.writeRaw(`,"use_chat_info_database":`)
+	encoder.writeBool(req.UseChatInfoDatabase)
+	encoder.writeRaw(`,"use_message_database":`)
+	encoder.writeBool(req.UseMessageDatabase)
+	encoder.writeRaw(`,"use_secret_chats":`)
+	encoder.writeBool(req.UseSecretChats)
+	encoder.writeRaw(`,"api_id":`)
+	encoder.writeInt32(req.ApiId)
+	encoder.writeRaw(`,"api_hash":`)
+	encoder.writeString(req.ApiHash)
+	encoder.writeRaw(`,"system_language_code":`)
+	encoder.writeString(req.SystemLanguageCode)
+	encoder.writeRaw(`,"device_model":`)
+	encoder.writeString(req.DeviceModel)
+	encoder.writeRaw(`,"system_version":`)
+	encoder.writeString(req.SystemVersion)
+	encoder.writeRaw(`,"application_version":`)
+	encoder.writeString(req.ApplicationVersion)
+	encoder.writeRaw("}")
+}
+
+func (req SetTdlibParametersRequest) Validate() error {
+	if err := validateLength("system_language_code", req.SystemLanguageCode, 1, -1); err != nil {
+		return err
+	}
+
+	if err := validateLength("device_model", req.DeviceModel, 1, -1); err != nil {
+		return err
+	}
+
+	if err := validateLength("application_version", req.ApplicationVersion, 1, -1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sets the parameters for TDLib initialization. Works only when the current authorization state is authorizationStateWaitTdlibParameters
+func (client *Client) SetTdlibParameters(ctx context.Context, req *SetTdlibParametersRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type LogOutRequest struct {
+	request
+}
+
+func (req LogOutRequest) GetFunctionName() string {
+	return "logOut"
+}
+
+func (req *LogOutRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LogOutRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"logOut"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req LogOutRequest) Validate() error {
+	return nil
+}
+
+// Closes the TDLib instance after a proper logout. Requires an available network connection. All local data will be destroyed. After the logout completes, updateAuthorizationState with authorizationStateClosed will be sent
+func (client *Client) LogOut(ctx context.Context) (*Ok, error) {
+	req := &LogOutRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CloseRequest struct {
+	request
+}
+
+func (req CloseRequest) GetFunctionName() string {
+	return "close"
+}
+
+func (req *CloseRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CloseRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"close"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req CloseRequest) Validate() error {
+	return nil
+}
+
+// Closes the TDLib instance. All databases will be flushed to disk and properly closed. After the close completes, updateAuthorizationState with authorizationStateClosed will be sent. Can be called before initialization
+func (client *Client) Close(ctx context.Context) (*Ok, error) {
+	req := &CloseRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DestroyRequest struct {
+	request
+}
+
+func (req DestroyRequest) GetFunctionName() string {
+	return "destroy"
+}
+
+func (req *DestroyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DestroyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"destroy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req DestroyRequest) Validate() error {
+	return nil
+}
+
+// Closes the TDLib instance, destroying all local data without a proper logout. The current user session will remain in the list of all active sessions. All local data will be destroyed. After the destruction completes updateAuthorizationState with authorizationStateClosed will be sent. Can be called before authorization
+func (client *Client) Destroy(ctx context.Context) (*Ok, error) {
+	req := &DestroyRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetCurrentStateRequest struct {
+	request
+}
+
+func (req GetCurrentStateRequest) GetFunctionName() string {
+	return "getCurrentState"
+}
+
+func (req *GetCurrentStateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCurrentStateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCurrentState"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetCurrentStateRequest) Validate() error {
+	return nil
+}
+
+// Returns all updates needed to restore current TDLib state, i.e. all actual updateAuthorizationState/updateUser/updateNewChat and others. This is especially useful if TDLib is run in a separate process. Can be called before initialization
+func (client *Client) GetCurrentState(ctx context.Context) (*Updates, error) {
+	req := &GetCurrentStateRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUpdates)
+}
+
+type SetDatabaseEncryptionKeyRequest struct {
+	request
+	// New encryption key
+	NewEncryptionKey []byte `json:"new_encryption_key"`
+}
+
+func (req SetDatabaseEncryptionKeyRequest) GetFunctionName() string {
+	return "setDatabaseEncryptionKey"
+}
+
+func (req *SetDatabaseEncryptionKeyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetDatabaseEncryptionKeyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setDatabaseEncryptionKey"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"new_encryption_key":`)
+	encoder.writeBytes(req.NewEncryptionKey)
+	encoder.writeRaw("}")
+}
+
+func (req SetDatabaseEncryptionKeyRequest) Validate() error {
+	return nil
+}
+
+// Changes the database encryption key. Usually the encryption key is never changed and is stored in some OS keychain
+func (client *Client) SetDatabaseEncryptionKey(ctx context.Context, req *SetDatabaseEncryptionKeyRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetRecoveryEmailAddressRequest struct {
+	request
+	// The 2-step verification password for the current user
+	Password string `json:"password"`
+}
+
+func (req GetRecoveryEmailAddressRequest) GetFunctionName() string {
+	return "getRecoveryEmailAddress"
+}
+
+func (req *GetRecoveryEmailAddressRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecoveryEmailAddressRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecoveryEmailAddress"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecoveryEmailAddressRequest) Validate() error {
+	return nil
+}
+
+// Returns a 2-step verification recovery email address that was previously set up. This method can be used to verify a password provided by the user
+//
+// Available to users only
+func (client *Client) GetRecoveryEmailAddress(ctx context.Context, req *GetRecoveryEmailAddressRequest) (*RecoveryEmailAddress, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalRecoveryEmailAddress)
+}
+
+type SetRecoveryEmailAddressRequest struct {
+	request
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+	// New recovery email address
+	NewRecoveryEmailAddress string `json:"new_recovery_email_address"`
+}
+
+func (req SetRecoveryEmailAddressRequest) GetFunctionName() string {
+	return "setRecoveryEmailAddress"
+}
+
+func (req *SetRecoveryEmailAddressRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetRecoveryEmailAddressRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setRecoveryEmailAddress"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw(`,"new_recovery_email_address":`)
+	encoder.writeString(req.NewRecoveryEmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (req SetRecoveryEmailAddressRequest) Validate() error {
+	return nil
+}
+
+// Changes the 2-step verification recovery email address of the user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed. If new_recovery_email_address is the same as the email address that is currently set up, this call succeeds immediately and aborts all other requests waiting for an email confirmation
+//
+// Available to users only
+func (client *Client) SetRecoveryEmailAddress(ctx context.Context, req *SetRecoveryEmailAddressRequest) (*PasswordState, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPasswordState)
+}
+
+type CheckRecoveryEmailAddressCodeRequest struct {
+	request
+	// Verification code to check
+	Code string `json:"code"`
+}
+
+func (req CheckRecoveryEmailAddressCodeRequest) GetFunctionName() string {
+	return "checkRecoveryEmailAddressCode"
+}
+
+func (req *CheckRecoveryEmailAddressCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckRecoveryEmailAddressCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkRecoveryEmailAddressCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req CheckRecoveryEmailAddressCodeRequest) Validate() error {
+	return nil
+}
+
+// Checks the 2-step verification recovery email address verification code
+//
+// Available to users only
+func (client *Client) CheckRecoveryEmailAddressCode(ctx context.Context, req *CheckRecoveryEmailAddressCodeRequest) (*PasswordState, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPasswordState)
+}
+
+type ResendRecoveryEmailAddressCodeRequest struct {
+	request
+}
+
+func (req ResendRecoveryEmailAddressCodeRequest) GetFunctionName() string {
+	return "resendRecoveryEmailAddressCode"
+}
+
+func (req *ResendRecoveryEmailAddressCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResendRecoveryEmailAddressCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendRecoveryEmailAddressCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResendRecoveryEmailAddressCodeRequest) Validate() error {
+	return nil
+}
+
+// Resends the 2-step verification recovery email address verification code
+//
+// Available to users only
+func (client *Client) ResendRecoveryEmailAddressCode(ctx context.Context) (*PasswordState, error) {
+	req := &ResendRecoveryEmailAddressCodeRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPasswordState)
+}
+
+type CancelRecoveryEmailAddressVerificationRequest struct {
+	request
+}
+
+func (req CancelRecoveryEmailAddressVerificationRequest) GetFunctionName() string {
+	return "cancelRecoveryEmailAddressVerification"
+}
+
+func (req *CancelRecoveryEmailAddressVerificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CancelRecoveryEmailAddressVerificationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"cancelRecoveryEmailAddressVerification"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req CancelRecoveryEmailAddressVerificationRequest) Validate() error {
+	return nil
+}
+
+// Cancels verification of the 2-step verification recovery email address
+//
+// Available to users only
+func (client *Client) CancelRecoveryEmailAddressVerification(ctx context.Context) (*PasswordState, error) {
+	req := &CancelRecoveryEmailAddressVerificationRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPasswordState)
+}
+
+type GetMeRequest struct {
+	request
+}
+
+func (req GetMeRequest) GetFunctionName() string {
+	return "getMe"
+}
+
+func (req *GetMeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMe"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetMeRequest) Validate() error {
+	return nil
+}
+
+// Returns the current user
+func (client *Client) GetMe(ctx context.Context) (*User, error) {
+	req := &GetMeRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUser)
+}
+
+type GetSearchedForTagsRequest struct {
+	request
+	// Prefix of hashtags or cashtags to return
+	TagPrefix string `json:"tag_prefix"`
+	// The maximum number of items to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req GetSearchedForTagsRequest) GetFunctionName() string {
+	return "getSearchedForTags"
+}
+
+func (req *GetSearchedForTagsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSearchedForTagsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSearchedForTags"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"tag_prefix":`)
+	encoder.writeString(req.TagPrefix)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetSearchedForTagsRequest) Validate() error {
+	return nil
+}
+
+// Returns recently searched for hashtags or cashtags by their prefix
+//
+// Available to users only
+func (client *Client) GetSearchedForTags(ctx context.Context, req *GetSearchedForTagsRequest) (*Hashtags, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHashtags)
+}
+
+type RemoveSearchedForTagRequest struct {
+	request
+	// Hashtag or cashtag to delete
+	Tag string `json:"tag"`
+}
+
+func (req RemoveSearchedForTagRequest) GetFunctionName() string {
+	return "removeSearchedForTag"
+}
+
+func (req *RemoveSearchedForTagRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveSearchedForTagRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeSearchedForTag"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"tag":`)
+	encoder.writeString(req.Tag)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveSearchedForTagRequest) Validate() error {
+	return nil
+}
+
+// Removes a hashtag or a cashtag from the list of recently searched for hashtags or cashtags
+//
+// Available to users only
+func (client *Client) RemoveSearchedForTag(ctx context.Context, req *RemoveSearchedForTagRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ClearSearchedForTagsRequest struct {
+	request
+	// Pass true to clear the list of recently searched for cashtags; otherwise, the list of recently searched for hashtags will be cleared
+	ClearCashtags bool `json:"clear_cashtags"`
+}
+
+func (req ClearSearchedForTagsRequest) GetFunctionName() string {
+	return "clearSearchedForTags"
+}
+
+func (req *ClearSearchedForTagsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearSearchedForTagsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearSearchedForTags"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"clear_cashtags":`)
+	encoder.writeBool(req.ClearCashtags)
+	encoder.writeRaw("}")
+}
+
+func (req ClearSearchedForTagsRequest) Validate() error {
+	return nil
+}
+
+// Clears the list of recently searched for hashtags or cashtags
+//
+// Available to users only
+func (client *Client) ClearSearchedForTags(ctx context.Context, req *ClearSearchedForTagsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveNotificationRequest struct {
+	request
+	// Identifier of notification group to which the notification belongs
+	NotificationGroupId int32 `json:"notification_group_id"`
+	// Identifier of removed notification
+	NotificationId int32 `json:"notification_id"`
+}
+
+func (req RemoveNotificationRequest) GetFunctionName() string {
+	return "removeNotification"
+}
+
+func (req *RemoveNotificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveNotificationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeNotification"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"notification_group_id":`)
+	encoder.writeInt32(req.NotificationGroupId)
+	encoder.writeRaw(`,"notification_id":`)
+	encoder.writeInt32(req.NotificationId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveNotificationRequest) Validate() error {
+	return nil
+}
+
+// Removes an active notification from notification list. Needs to be called only if the notification is removed by the current user
+//
+// Available to users only
+func (client *Client) RemoveNotification(ctx context.Context, req *RemoveNotificationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RecognizeSpeechRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_recognize_speech to check whether the message is suitable
+	MessageId int64 `json:"message_id"`
+}
+
+func (req RecognizeSpeechRequest) GetFunctionName() string {
+	return "recognizeSpeech"
+}
+
+func (req *RecognizeSpeechRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RecognizeSpeechRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"recognizeSpeech"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req RecognizeSpeechRequest) Validate() error {
+	return nil
+}
+
+// Recognizes speech in a video note or a voice note message
+//
+// Available to users only
+func (client *Client) RecognizeSpeech(ctx context.Context, req *RecognizeSpeechRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RateSpeechRecognitionRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Pass true if the speech recognition is good
+	IsGood bool `json:"is_good"`
+}
+
+func (req RateSpeechRecognitionRequest) GetFunctionName() string {
+	return "rateSpeechRecognition"
+}
+
+func (req *RateSpeechRecognitionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RateSpeechRecognitionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"rateSpeechRecognition"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"is_good":`)
+	encoder.writeBool(req.IsGood)
+	encoder.writeRaw("}")
+}
+
+func (req RateSpeechRecognitionRequest) Validate() error {
+	return nil
+}
+
+// Rates recognized speech in a video note or a voice note message
+//
+// Available to users only
+func (client *Client) RateSpeechRecognition(ctx context.Context, req *RateSpeechRecognitionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SearchQuoteRequest struct {
+	request
+	// Text in which to search for the quote
+	Text *FormattedText `json:"text"`
+	// Quote to search for
+	Quote *FormattedText `json:"quote"`
+	// Approximate quote position in UTF-16 code units
+	QuotePosition int32 `json:"quote_position"`
+}
+
+func (req SearchQuoteRequest) GetFunctionName() string {
+	return "searchQuote"
+}
+
+func (req *SearchQuoteRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchQuoteRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchQuote"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	req.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"quote":`)
+	req.Quote.encodeJSON(encoder)
+	encoder.writeRaw(`,"quote_position":`)
+	encoder.writeInt32(req.QuotePosition)
+	encoder.writeRaw("}")
+}
+
+func (req SearchQuoteRequest) Validate() error {
+	return nil
+}
+
+// Searches for a given quote in a text. Returns found quote start position in UTF-16 code units. Returns a 404 error if the quote is not found. Can be called synchronously
+func SearchQuote(req *SearchQuoteRequest) (*FoundPosition, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundPosition)
+}
+
+// deprecated
+// Searches for a given quote in a text. Returns found quote start position in UTF-16 code units. Returns a 404 error if the quote is not found. Can be called synchronously
+func (client *Client) SearchQuote(req *SearchQuoteRequest) (*FoundPosition, error) {
+	return SearchQuote(req)
+}
+
+type ParseMarkdownRequest struct {
+	request
+	// The text to parse. For example, "__italic__ ~~strikethrough~~ ||spoiler|| **bold** `code` ```pre``` __[italic__ text_url](telegram.org) __italic**bold italic__bold**"
+	Text *FormattedText `json:"text"`
+}
+
+func (req ParseMarkdownRequest) GetFunctionName() string {
+	return "parseMarkdown"
+}
+
+func (req *ParseMarkdownRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ParseMarkdownRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"parseMarkdown"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	req.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req ParseMarkdownRequest) Validate() error {
+	return nil
+}
+
+// Parses Markdown entities in a human-friendly format, ignoring markup errors. Can be called synchronously
+func ParseMarkdown(req *ParseMarkdownRequest) (*FormattedText, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFormattedText)
+}
+
+// deprecated
+// Parses Markdown entities in a human-friendly format, ignoring markup errors. Can be called synchronously
+func (client *Client) ParseMarkdown(req *ParseMarkdownRequest) (*FormattedText, error) {
+	return ParseMarkdown(req)
+}
+
+type GetLanguagePackStringRequest struct {
+	request
+	// Path to the language pack database in which strings are stored
+	LanguagePackDatabasePath string `json:"language_pack_database_path"`
+	// Localization target to which the language pack belongs
+	LocalizationTarget string `json:"localization_target"`
+	// Language pack identifier
+	LanguagePackId string `json:"language_pack_id"`
+	// Language pack key of the string to be returned
+	Key string `json:"key"`
+}
+
+func (req GetLanguagePackStringRequest) GetFunctionName() string {
+	return "getLanguagePackString"
+}
+
+func (req *GetLanguagePackStringRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLanguagePackStringRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLanguagePackString"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_pack_database_path":`)
+	encoder.writeString(req.LanguagePackDatabasePath)
+	encoder.writeRaw(`,"localization_target":`)
+	encoder.writeString(req.LocalizationTarget)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(req.LanguagePackId)
+	encoder.writeRaw(`,"key":`)
+	encoder.writeString(req.Key)
+	encoder.writeRaw("}")
+}
+
+func (req GetLanguagePackStringRequest) Validate() error {
+	return nil
+}
+
+// Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously
+func GetLanguagePackString(req *GetLanguagePackStringRequest) (LanguagePackStringValue, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLanguagePackStringValue)
+}
+
+// deprecated
+// Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously
+func (client *Client) GetLanguagePackString(req *GetLanguagePackStringRequest) (LanguagePackStringValue, error) {
+	return GetLanguagePackString(req)
+}
+
+type GetJsonValueRequest struct {
+	request
+	// The JSON-serialized string
+	Json string `json:"json"`
+}
+
+func (req GetJsonValueRequest) GetFunctionName() string {
+	return "getJsonValue"
+}
+
+func (req *GetJsonValueRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetJsonValueRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getJsonValue"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"json":`)
+	encoder.writeString(req.Json)
+	encoder.writeRaw("}")
+}
+
+func (req GetJsonValueRequest) Validate() error {
+	return nil
+}
+
+// Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously
+func GetJsonValue(req *GetJsonValueRequest) (JsonValue, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalJsonValue)
+}
+
+// deprecated
+// Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously
+func (client *Client) GetJsonValue(req *GetJsonValueRequest) (JsonValue, error) {
+	return GetJsonValue(req)
+}
+
+type GetJsonStringRequest struct {
+	request
+	// The JsonValue object
+	JsonValue JsonValue `json:"json_value"`
+}
+
+func (req GetJsonStringRequest) GetFunctionName() string {
+	return "getJsonString"
+}
+
+func (req *GetJsonStringRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetJsonStringRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getJsonString"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"json_value":`)
+	encoder.writeType(req.JsonValue)
+	encoder.writeRaw("}")
+}
+
+func (req GetJsonStringRequest) Validate() error {
+	return nil
+}
+
+// Converts a JsonValue object to corresponding JSON-serialized string. Can be called synchronously
+func GetJsonString(req *GetJsonStringRequest) (*Text, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+// deprecated
+// Converts a JsonValue object to corresponding JSON-serialized string. Can be called synchronously
+func (client *Client) GetJsonString(req *GetJsonStringRequest) (*Text, error) {
+	return GetJsonString(req)
+}
+
+type GetThemeParametersJsonStringRequest struct {
+	request
+	// Theme parameters to convert to JSON
+	Theme *ThemeParameters `json:"theme"`
+}
+
+func (req GetThemeParametersJsonStringRequest) GetFunctionName() string {
+	return "getThemeParametersJsonString"
+}
+
+func (req *GetThemeParametersJsonStringRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetThemeParametersJsonStringRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getThemeParametersJsonString"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"theme":`)
+	req.Theme.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetThemeParametersJsonStringRequest) Validate() error {
+	return nil
+}
+
+// Converts a themeParameters object to corresponding JSON-serialized string. Can be called synchronously
+func GetThemeParametersJsonString(req *GetThemeParametersJsonStringRequest) (*Text, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+// deprecated
+// Converts a themeParameters object to corresponding JSON-serialized string. Can be called synchronously
+func (client *Client) GetThemeParametersJsonString(req *GetThemeParametersJsonStringRequest) (*Text, error) {
+	return GetThemeParametersJsonString(req)
+}
+
+type HideSuggestedActionRequest struct {
+	request
+	// Suggested action to hide
+	Action SuggestedAction `json:"action"`
+}
+
+func (req HideSuggestedActionRequest) GetFunctionName() string {
+	return "hideSuggestedAction"
+}
+
+func (req *HideSuggestedActionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *HideSuggestedActionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"hideSuggestedAction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"action":`)
+	encoder.writeType(req.Action)
+	encoder.writeRaw("}")
+}
+
+func (req HideSuggestedActionRequest) Validate() error {
+	return nil
+}
+
+// Hides a suggested action
+//
+// Available to users only
+func (client *Client) HideSuggestedAction(ctx context.Context, req *HideSuggestedActionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AnswerShippingQueryRequest struct {
+	request
+	// Identifier of the shipping query
+	ShippingQueryId JsonInt64 `json:"shipping_query_id"`
+	// Available shipping options
+	ShippingOptions []*ShippingOption `json:"shipping_options"`
+	// An error message, empty on success
+	ErrorMessage string `json:"error_message"`
+}
+
+func (req AnswerShippingQueryRequest) GetFunctionName() string {
+	return "answerShippingQuery"
+}
+
+func (req *AnswerShippingQueryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AnswerShippingQueryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"answerShippingQuery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shipping_query_id":`)
+	encoder.writeJsonInt64(req.ShippingQueryId)
+	encoder.writeRaw(`,"shipping_options":`)
+	writeList(encoder, req.ShippingOptions, writeTypeValue[*ShippingOption])
+	encoder.writeRaw(`,"error_message":`)
+	encoder.writeString(req.ErrorMessage)
+	encoder.writeRaw("}")
+}
+
+func (req AnswerShippingQueryRequest) Validate() error {
+	return nil
+}
+
+// Sets the result of a shipping query; for bots only
+//
+// Available to bots only
+func (client *Client) AnswerShippingQuery(ctx context.Context, req *AnswerShippingQueryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AnswerPreCheckoutQueryRequest struct {
+	request
+	// Identifier of the pre-checkout query
+	PreCheckoutQueryId JsonInt64 `json:"pre_checkout_query_id"`
+	// An error message, empty on success
+	ErrorMessage string `json:"error_message"`
+}
+
+func (req AnswerPreCheckoutQueryRequest) GetFunctionName() string {
+	return "answerPreCheckoutQuery"
+}
+
+func (req *AnswerPreCheckoutQueryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AnswerPreCheckoutQueryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"answerPreCheckoutQuery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"pre_checkout_query_id":`)
+	encoder.writeJsonInt64(req.PreCheckoutQueryId)
+	encoder.writeRaw(`,"error_message":`)
+	encoder.writeString(req.ErrorMessage)
+	encoder.writeRaw("}")
+}
+
+func (req AnswerPreCheckoutQueryRequest) Validate() error {
+	return nil
+}
+
+// Sets the result of a pre-checkout query; for bots only
+//
+// Available to bots only
+func (client *Client) AnswerPreCheckoutQuery(ctx context.Context, req *AnswerPreCheckoutQueryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetInternalLinkRequest struct {
+	request
+	// Expected type of the link
+	Type InternalLinkType `json:"type"`
+	// Pass true to create an HTTPS link (only available for some link types); pass false to create a tg: link
+	IsHttp bool `json:"is_http"`
+}
+
+func (req GetInternalLinkRequest) GetFunctionName() string {
+	return "getInternalLink"
+}
+
+func (req *GetInternalLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetInternalLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getInternalLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw(`,"is_http":`)
+	encoder.writeBool(req.IsHttp)
+	encoder.writeRaw("}")
+}
+
+func (req GetInternalLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS or a tg: link with the given type. Can be called before authorization
+func (client *Client) GetInternalLink(ctx context.Context, req *GetInternalLinkRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type GetInternalLinkTypeRequest struct {
+	request
+	// The link
+	Link string `json:"link"`
+}
+
+func (req GetInternalLinkTypeRequest) GetFunctionName() string {
+	return "getInternalLinkType"
+}
+
+func (req *GetInternalLinkTypeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetInternalLinkTypeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getInternalLinkType"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(req.Link)
+	encoder.writeRaw("}")
+}
+
+func (req GetInternalLinkTypeRequest) Validate() error {
+	return nil
+}
+
+// Returns information about the type of internal link. Returns a 404 error if the link is not internal. Can be called before authorization
+func (client *Client) GetInternalLinkType(ctx context.Context, req *GetInternalLinkTypeRequest) (InternalLinkType, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalInternalLinkType)
+}
+
+type GetExternalLinkInfoRequest struct {
+	request
+	// The link
+	Link string `json:"link"`
+}
+
+func (req GetExternalLinkInfoRequest) GetFunctionName() string {
+	return "getExternalLinkInfo"
+}
+
+func (req *GetExternalLinkInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetExternalLinkInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getExternalLinkInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(req.Link)
+	encoder.writeRaw("}")
+}
+
+func (req GetExternalLinkInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about an action to be done when the current user clicks an external link. Don't use this method for links from secret chats if link preview is disabled in secret chats
+//
+// Available to users only
+func (client *Client) GetExternalLinkInfo(ctx context.Context, req *GetExternalLinkInfoRequest) (LoginUrlInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLoginUrlInfo)
+}
+
+type GetExternalLinkRequest struct {
+	request
+	// The HTTP link
+	Link string `json:"link"`
+	// Pass true if the current user allowed the bot, returned in getExternalLinkInfo, to send them messages
+	AllowWriteAccess bool `json:"allow_write_access"`
+}
+
+func (req GetExternalLinkRequest) GetFunctionName() string {
+	return "getExternalLink"
+}
+
+func (req *GetExternalLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetExternalLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getExternalLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(req.Link)
+	encoder.writeRaw(`,"allow_write_access":`)
+	encoder.writeBool(req.AllowWriteAccess)
+	encoder.writeRaw("}")
+}
+
+func (req GetExternalLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTP URL which can be used to automatically authorize the current user on a website after clicking an HTTP link. Use the method getExternalLinkInfo to find whether a prior user confirmation is needed
+//
+// Available to users only
+func (client *Client) GetExternalLink(ctx context.Context, req *GetExternalLinkRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type CanTransferOwnershipRequest struct {
+	request
+}
+
+func (req CanTransferOwnershipRequest) GetFunctionName() string {
+	return "canTransferOwnership"
+}
+
+func (req *CanTransferOwnershipRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CanTransferOwnershipRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canTransferOwnership"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req CanTransferOwnershipRequest) Validate() error {
+	return nil
+}
+
+// Checks whether the current session can be used to transfer a chat ownership to another user
+//
+// Available to users only
+func (client *Client) CanTransferOwnership(ctx context.Context) (CanTransferOwnershipResult, error) {
+	req := &CanTransferOwnershipRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCanTransferOwnershipResult)
+}
+
+type GetSavedNotificationSoundRequest struct {
+	request
+	// Identifier of the notification sound
+	NotificationSoundId JsonInt64 `json:"notification_sound_id"`
+}
+
+func (req GetSavedNotificationSoundRequest) GetFunctionName() string {
+	return "getSavedNotificationSound"
+}
+
+func (req *GetSavedNotificationSoundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSavedNotificationSoundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSavedNotificationSound"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"notification_sound_id":`)
+	encoder.writeJsonInt64(req.NotificationSoundId)
+	encoder.writeRaw("}")
+}
+
+func (req GetSavedNotificationSoundRequest) Validate() error {
+	return nil
+}
+
+// Returns saved notification sound by its identifier. Returns a 404 error if there is no saved notification sound with the specified identifier
+//
+// Available to users only
+func (client *Client) GetSavedNotificationSound(ctx context.Context, req *GetSavedNotificationSoundRequest) (*NotificationSounds, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalNotificationSounds)
+}
+
+type GetSavedNotificationSoundsRequest struct {
+	request
+}
+
+func (req GetSavedNotificationSoundsRequest) GetFunctionName() string {
+	return "getSavedNotificationSounds"
+}
+
+func (req *GetSavedNotificationSoundsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSavedNotificationSoundsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSavedNotificationSounds"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetSavedNotificationSoundsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of saved notification sounds. If a sound isn't in the list, then default sound needs to be used
+//
+// Available to users only
+func (client *Client) GetSavedNotificationSounds(ctx context.Context) (*NotificationSounds, error) {
+	req := &GetSavedNotificationSoundsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalNotificationSounds)
+}
+
+type AddSavedNotificationSoundRequest struct {
+	request
+	// Notification sound file to add
+	Sound InputFile `json:"sound"`
+}
+
+func (req AddSavedNotificationSoundRequest) GetFunctionName() string {
+	return "addSavedNotificationSound"
+}
+
+func (req *AddSavedNotificationSoundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddSavedNotificationSoundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addSavedNotificationSound"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sound":`)
+	encoder.writeType(req.Sound)
+	encoder.writeRaw("}")
+}
+
+func (req AddSavedNotificationSoundRequest) Validate() error {
+	return nil
+}
+
+// Adds a new notification sound to the list of saved notification sounds. The new notification sound is added to the top of the list. If it is already in the list, its position isn't changed
+//
+// Available to users only
+func (client *Client) AddSavedNotificationSound(ctx context.Context, req *AddSavedNotificationSoundRequest) (*NotificationSound, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalNotificationSound)
+}
+
+type RemoveSavedNotificationSoundRequest struct {
+	request
+	// Identifier of the notification sound
+	NotificationSoundId JsonInt64 `json:"notification_sound_id"`
+}
+
+func (req RemoveSavedNotificationSoundRequest) GetFunctionName() string {
+	return "removeSavedNotificationSound"
+}
+
+func (req *RemoveSavedNotificationSoundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveSavedNotificationSoundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeSavedNotificationSound"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"notification_sound_id":`)
+	encoder.writeJsonInt64(req.NotificationSoundId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveSavedNotificationSoundRequest) Validate() error {
+	return nil
+}
+
+// Removes a notification sound from the list of saved notification sounds
+//
+// Available to users only
+func (client *Client) RemoveSavedNotificationSound(ctx context.Context, req *RemoveSavedNotificationSoundRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetScopeNotificationSettingsRequest struct {
+	request
+	// Types of chats for which to return the notification settings information
+	Scope NotificationSettingsScope `json:"scope"`
+}
+
+func (req GetScopeNotificationSettingsRequest) GetFunctionName() string {
+	return "getScopeNotificationSettings"
+}
+
+func (req *GetScopeNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetScopeNotificationSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getScopeNotificationSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"scope":`)
+	encoder.writeType(req.Scope)
+	encoder.writeRaw("}")
+}
+
+func (req GetScopeNotificationSettingsRequest) Validate() error {
+	return nil
+}
+
+// Returns the notification settings for chats of a given type
+//
+// Available to users only
+func (client *Client) GetScopeNotificationSettings(ctx context.Context, req *GetScopeNotificationSettingsRequest) (*ScopeNotificationSettings, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalScopeNotificationSettings)
+}
+
+type SetScopeNotificationSettingsRequest struct {
+	request
+	// Types of chats for which to change the notification settings
+	Scope NotificationSettingsScope `json:"scope"`
+	// The new notification settings for the given scope
+	NotificationSettings *ScopeNotificationSettings `json:"notification_settings"`
+}
+
+func (req SetScopeNotificationSettingsRequest) GetFunctionName() string {
+	return "setScopeNotificationSettings"
+}
+
+func (req *SetScopeNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetScopeNotificationSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setScopeNotificationSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"scope":`)
+	encoder.writeType(req.Scope)
+	encoder.writeRaw(`,"notification_settings":`)
+	req.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetScopeNotificationSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes notification settings for chats of a given type
+//
+// Available to users only
+func (client *Client) SetScopeNotificationSettings(ctx context.Context, req *SetScopeNotificationSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ResetAllNotificationSettingsRequest struct {
+	request
+}
+
+func (req ResetAllNotificationSettingsRequest) GetFunctionName() string {
+	return "resetAllNotificationSettings"
+}
+
+func (req *ResetAllNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResetAllNotificationSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetAllNotificationSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResetAllNotificationSettingsRequest) Validate() error {
+	return nil
+}
+
+// Resets all chat and scope notification settings to their default values. By default, all chats are unmuted and message previews are shown
+//
+// Available to users only
+func (client *Client) ResetAllNotificationSettings(ctx context.Context) (*Ok, error) {
+	req := &ResetAllNotificationSettingsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetCurrentWeatherRequest struct {
+	request
+	// The location
+	Location *Location `json:"location"`
+}
+
+func (req GetCurrentWeatherRequest) GetFunctionName() string {
+	return "getCurrentWeather"
+}
+
+func (req *GetCurrentWeatherRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCurrentWeatherRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCurrentWeather"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"location":`)
+	req.Location.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetCurrentWeatherRequest) Validate() error {
+	return nil
+}
+
+// Returns the current weather in the given location
+//
+// Available to users only
+func (client *Client) GetCurrentWeather(ctx context.Context, req *GetCurrentWeatherRequest) (*CurrentWeather, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCurrentWeather)
+}
+
+type SetApplicationVerificationTokenRequest struct {
+	request
+	// Unique identifier for the verification process as received from updateApplicationVerificationRequired or updateApplicationRecaptchaVerificationRequired
+	VerificationId int64 `json:"verification_id"`
+	// Play Integrity API token for the Android application, or secret from push notification for the iOS application for application verification, or reCAPTCHA token for reCAPTCHA verifications; pass an empty string to abort verification and receive error VERIFICATION_FAILED for the request
+	Token string `json:"token"`
+}
+
+func (req SetApplicationVerificationTokenRequest) GetFunctionName() string {
+	return "setApplicationVerificationToken"
+}
+
+func (req *SetApplicationVerificationTokenRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetApplicationVerificationTokenRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setApplicationVerificationToken"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"verification_id":`)
+	encoder.writeInt64(req.VerificationId)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(req.Token)
+	encoder.writeRaw("}")
+}
+
+func (req SetApplicationVerificationTokenRequest) Validate() error {
+	return nil
+}
+
+// Application or reCAPTCHA verification has been completed. Can be called before authorization
+//
+// Available to users only
+func (client *Client) SetApplicationVerificationToken(ctx context.Context, req *SetApplicationVerificationTokenRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetCloseFriendsRequest struct {
+	request
+	// User identifiers of close friends; the users must be contacts of the current user
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (req SetCloseFriendsRequest) GetFunctionName() string {
+	return "setCloseFriends"
+}
+
+func (req *SetCloseFriendsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetCloseFriendsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setCloseFriends"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, req.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req SetCloseFriendsRequest) Validate() error {
+	return nil
+}
+
+// Changes the list of close friends of the current user
+//
+// Available to users only
+func (client *Client) SetCloseFriends(ctx context.Context, req *SetCloseFriendsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetCloseFriendsRequest struct {
+	request
+}
+
+func (req GetCloseFriendsRequest) GetFunctionName() string {
+	return "getCloseFriends"
+}
+
+func (req *GetCloseFriendsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCloseFriendsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCloseFriends"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetCloseFriendsRequest) Validate() error {
+	return nil
+}
+
+// Returns all close friends of the current user
+//
+// Available to users only
+func (client *Client) GetCloseFriends(ctx context.Context) (*Users, error) {
+	req := &GetCloseFriendsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUsers)
+}
+
+type SharePhoneNumberRequest struct {
+	request
+	// Identifier of the user with whom to share the phone number. The user must be a mutual contact
+	UserId int64 `json:"user_id"`
+}
+
+func (req SharePhoneNumberRequest) GetFunctionName() string {
+	return "sharePhoneNumber"
+}
+
+func (req *SharePhoneNumberRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SharePhoneNumberRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sharePhoneNumber"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req SharePhoneNumberRequest) Validate() error {
+	return nil
+}
+
+// Shares the phone number of the current user with a mutual contact. Supposed to be called when the user clicks on chatActionBarSharePhoneNumber
+//
+// Available to users only
+func (client *Client) SharePhoneNumber(ctx context.Context, req *SharePhoneNumberRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SearchHashtagsRequest struct {
+	request
+	// Hashtag prefix to search for
+	Prefix string `json:"prefix"`
+	// The maximum number of hashtags to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchHashtagsRequest) GetFunctionName() string {
+	return "searchHashtags"
+}
+
+func (req *SearchHashtagsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchHashtagsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchHashtags"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"prefix":`)
+	encoder.writeString(req.Prefix)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchHashtagsRequest) Validate() error {
+	return nil
+}
+
+// Searches for recently used hashtags by their prefix
+//
+// Available to users only
+func (client *Client) SearchHashtags(ctx context.Context, req *SearchHashtagsRequest) (*Hashtags, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHashtags)
+}
+
+type RemoveRecentHashtagRequest struct {
+	request
+	// Hashtag to delete
+	Hashtag string `json:"hashtag"`
+}
+
+func (req RemoveRecentHashtagRequest) GetFunctionName() string {
+	return "removeRecentHashtag"
+}
+
+func (req *RemoveRecentHashtagRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveRecentHashtagRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeRecentHashtag"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"hashtag":`)
+	encoder.writeString(req.Hashtag)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveRecentHashtagRequest) Validate() error {
+	return nil
+}
+
+// Removes a hashtag from the list of recently used hashtags
+//
+// Available to users only
+func (client *Client) RemoveRecentHashtag(ctx context.Context, req *RemoveRecentHashtagRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetLinkPreviewRequest struct {
+	request
+	// Message text with formatting
+	Text *FormattedText `json:"text"`
+	// Options to be used for generation of the link preview; pass null to use default link preview options
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+}
+
+func (req GetLinkPreviewRequest) GetFunctionName() string {
+	return "getLinkPreview"
+}
+
+func (req *GetLinkPreviewRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLinkPreviewRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLinkPreview"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	req.Text.encodeJSON(encoder)
+	if req.LinkPreviewOptions != nil {
+		encoder.writeRaw(`,"link_preview_options":`)
+		req.LinkPreviewOptions.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req GetLinkPreviewRequest) Validate() error {
+	return nil
+}
+
+// Returns a link preview by the text of a message. Do not call this function too often. Returns a 404 error if the text has no link preview
+//
+// Available to users only
+func (client *Client) GetLinkPreview(ctx context.Context, req *GetLinkPreviewRequest) (*LinkPreview, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLinkPreview)
+}
+
+type SetAccentColorRequest struct {
+	request
+	// Identifier of the accent color to use
+	AccentColorId int32 `json:"accent_color_id"`
+	// Identifier of a custom emoji to be shown on the reply header and link preview background; 0 if none
+	BackgroundCustomEmojiId JsonInt64 `json:"background_custom_emoji_id"`
+}
+
+func (req SetAccentColorRequest) GetFunctionName() string {
+	return "setAccentColor"
+}
+
+func (req *SetAccentColorRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAccentColorRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAccentColor"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(req.AccentColorId)
+	encoder.writeRaw(`,"background_custom_emoji_id":`)
+	encoder.writeJsonInt64(req.BackgroundCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (req SetAccentColorRequest) Validate() error {
+	return nil
+}
+
+// Changes accent color and background custom emoji for the current user; for Telegram Premium users only
+//
+// Available to users only
+func (client *Client) SetAccentColor(ctx context.Context, req *SetAccentColorRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetNameRequest struct {
+	request
+	// The new value of the first name for the current user; 1-64 characters
+	FirstName string `json:"first_name"`
+	// The new value of the optional last name for the current user; 0-64 characters
+	LastName string `json:"last_name"`
+}
+
+func (req SetNameRequest) GetFunctionName() string {
+	return "setName"
+}
+
+func (req *SetNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"first_name":`)
+	encoder.writeString(req.FirstName)
+	encoder.writeRaw(`,"last_name":`)
+	encoder.writeString(req.LastName)
+	encoder.writeRaw("}")
+}
+
+func (req SetNameRequest) Validate() error {
+	if err := validateLength("first_name", req.FirstName, 1, 64); err != nil {
+		return err
+	}
+
+	if err := validateLength("last_name", req.LastName, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes the first and last name of the current user
+//
+// Available to users only
+func (client *Client) SetName(ctx context.Context, req *SetNameRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBioRequest struct {
+	request
+	// The new value of the user bio; 0-getOption("bio_length_max") characters without line feeds
+	Bio string `json:"bio"`
+}
+
+func (req SetBioRequest) GetFunctionName() string {
+	return "setBio"
+}
+
+func (req *SetBioRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBioRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBio"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bio":`)
+	encoder.writeString(req.Bio)
+	encoder.writeRaw("}")
+}
+
+func (req SetBioRequest) Validate() error {
+	return nil
+}
+
+// Changes the bio of the current user
+//
+// Available to users only
+func (client *Client) SetBio(ctx context.Context, req *SetBioRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetUsernameRequest struct {
+	request
+	// The new value of the username. Use an empty string to remove the username. The username can't be completely removed if there is another active or disabled username
+	Username string `json:"username"`
+}
+
+func (req SetUsernameRequest) GetFunctionName() string {
+	return "setUsername"
+}
+
+func (req *SetUsernameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetUsernameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setUsername"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw("}")
+}
+
+func (req SetUsernameRequest) Validate() error {
+	return nil
+}
+
+// Changes the editable username of the current user
+//
+// Available to users only
+func (client *Client) SetUsername(ctx context.Context, req *SetUsernameRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleUsernameIsActiveRequest struct {
+	request
+	// The username to change
+	Username string `json:"username"`
+	// Pass true to activate the username; pass false to disable it
+	IsActive bool `json:"is_active"`
+}
+
+func (req ToggleUsernameIsActiveRequest) GetFunctionName() string {
+	return "toggleUsernameIsActive"
+}
+
+func (req *ToggleUsernameIsActiveRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleUsernameIsActiveRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleUsernameIsActive"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw(`,"is_active":`)
+	encoder.writeBool(req.IsActive)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleUsernameIsActiveRequest) Validate() error {
+	return nil
+}
+
+// Changes active state for a username of the current user. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+//
+// Available to users only
+func (client *Client) ToggleUsernameIsActive(ctx context.Context, req *ToggleUsernameIsActiveRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReorderActiveUsernamesRequest struct {
+	request
+	// The new order of active usernames. All currently active usernames must be specified
+	Usernames []string `json:"usernames"`
+}
+
+func (req ReorderActiveUsernamesRequest) GetFunctionName() string {
+	return "reorderActiveUsernames"
+}
+
+func (req *ReorderActiveUsernamesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReorderActiveUsernamesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reorderActiveUsernames"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"usernames":`)
+	writeList(encoder, req.Usernames, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req ReorderActiveUsernamesRequest) Validate() error {
+	return nil
+}
+
+// Changes order of active usernames of the current user
+//
+// Available to users only
+func (client *Client) ReorderActiveUsernames(ctx context.Context, req *ReorderActiveUsernamesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetBirthdateRequest struct {
+	request
+	// The new value of the current user's birthdate; pass null to remove the birthdate
+	Birthdate *Birthdate `json:"birthdate,omitempty"`
+}
+
+func (req SetBirthdateRequest) GetFunctionName() string {
+	return "setBirthdate"
+}
+
+func (req *SetBirthdateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBirthdateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBirthdate"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Birthdate != nil {
+		encoder.writeRaw(`,"birthdate":`)
+		req.Birthdate.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetBirthdateRequest) Validate() error {
+	return nil
+}
+
+// Changes the birthdate of the current user
+//
+// Available to users only
+func (client *Client) SetBirthdate(ctx context.Context, req *SetBirthdateRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendPhoneNumberCodeRequest struct {
+	request
+	// The phone number, in international format
+	PhoneNumber string `json:"phone_number"`
+	// Settings for the authentication of the user's phone number; pass null to use default settings
+	Settings *PhoneNumberAuthenticationSettings `json:"settings,omitempty"`
+	// Type of the request for which the code is sent
+	Type PhoneNumberCodeType `json:"type"`
+}
+
+func (req SendPhoneNumberCodeRequest) GetFunctionName() string {
+	return "sendPhoneNumberCode"
+}
+
+func (req *SendPhoneNumberCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendPhoneNumberCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendPhoneNumberCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(req.PhoneNumber)
+	if req.Settings != nil {
+		encoder.writeRaw(`,"settings":`)
+		req.Settings.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req SendPhoneNumberCodeRequest) Validate() error {
+	return nil
+}
+
+// Sends a code to the specified phone number. Aborts previous phone number verification if there was one. On success, returns information about the sent code
+//
+// Available to users only
+func (client *Client) SendPhoneNumberCode(ctx context.Context, req *SendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAuthenticationCodeInfo)
+}
+
+type SendPhoneNumberFirebaseSmsRequest struct {
+	request
+	// Play Integrity API or SafetyNet Attestation API token for the Android application, or secret from push notification for the iOS application
+	Token string `json:"token"`
+}
+
+func (req SendPhoneNumberFirebaseSmsRequest) GetFunctionName() string {
+	return "sendPhoneNumberFirebaseSms"
+}
+
+func (req *SendPhoneNumberFirebaseSmsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendPhoneNumberFirebaseSmsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendPhoneNumberFirebaseSms"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(req.Token)
+	encoder.writeRaw("}")
+}
+
+func (req SendPhoneNumberFirebaseSmsRequest) Validate() error {
+	return nil
+}
+
+// Sends Firebase Authentication SMS to the specified phone number. Works only when received a code of the type authenticationCodeTypeFirebaseAndroid or authenticationCodeTypeFirebaseIos
+//
+// Available to users only
+func (client *Client) SendPhoneNumberFirebaseSms(ctx context.Context, req *SendPhoneNumberFirebaseSmsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportPhoneNumberCodeMissingRequest struct {
+	request
+	// Current mobile network code
+	MobileNetworkCode string `json:"mobile_network_code"`
+}
+
+func (req ReportPhoneNumberCodeMissingRequest) GetFunctionName() string {
+	return "reportPhoneNumberCodeMissing"
+}
+
+func (req *ReportPhoneNumberCodeMissingRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportPhoneNumberCodeMissingRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportPhoneNumberCodeMissing"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"mobile_network_code":`)
+	encoder.writeString(req.MobileNetworkCode)
+	encoder.writeRaw("}")
+}
+
+func (req ReportPhoneNumberCodeMissingRequest) Validate() error {
+	return nil
+}
+
+// Reports that authentication code wasn't delivered via SMS to the specified phone number; for official mobile applications only
+//
+// Available to users only
+func (client *Client) ReportPhoneNumberCodeMissing(ctx context.Context, req *ReportPhoneNumberCodeMissingRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ResendPhoneNumberCodeRequest struct {
+	request
+	// Reason of code resending; pass null if unknown
+	Reason ResendCodeReason `json:"reason,omitempty"`
+}
+
+func (req ResendPhoneNumberCodeRequest) GetFunctionName() string {
+	return "resendPhoneNumberCode"
+}
+
+func (req *ResendPhoneNumberCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResendPhoneNumberCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendPhoneNumberCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Reason != nil {
+		encoder.writeRaw(`,"reason":`)
+		encoder.writeType(req.Reason)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req ResendPhoneNumberCodeRequest) Validate() error {
+	return nil
+}
+
+// Resends the authentication code sent to a phone number. Works only if the previously received authenticationCodeInfo next_code_type was not null and the server-specified timeout has passed
+//
+// Available to users only
+func (client *Client) ResendPhoneNumberCode(ctx context.Context, req *ResendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAuthenticationCodeInfo)
+}
+
+type CheckPhoneNumberCodeRequest struct {
+	request
+	// Authentication code to check
+	Code string `json:"code"`
+}
+
+func (req CheckPhoneNumberCodeRequest) GetFunctionName() string {
+	return "checkPhoneNumberCode"
+}
+
+func (req *CheckPhoneNumberCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckPhoneNumberCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkPhoneNumberCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req CheckPhoneNumberCodeRequest) Validate() error {
+	return nil
+}
+
+// Check the authentication code and completes the request for which the code was sent if appropriate
+//
+// Available to users only
+func (client *Client) CheckPhoneNumberCode(ctx context.Context, req *CheckPhoneNumberCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetCommandsRequest struct {
+	request
+	// The scope to which the commands are relevant; pass null to change commands in the default bot command scope
+	Scope BotCommandScope `json:"scope,omitempty"`
+	// A two-letter ISO 639-1 language code. If empty, the commands will be applied to all users from the given scope, for which language there are no dedicated commands
+	LanguageCode string `json:"language_code"`
+	// List of the bot's commands
+	Commands []*BotCommand `json:"commands"`
+}
+
+func (req SetCommandsRequest) GetFunctionName() string {
+	return "setCommands"
+}
+
+func (req *SetCommandsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetCommandsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setCommands"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Scope != nil {
+		encoder.writeRaw(`,"scope":`)
+		encoder.writeType(req.Scope)
+	}
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"commands":`)
+	writeList(encoder, req.Commands, writeTypeValue[*BotCommand])
+	encoder.writeRaw("}")
+}
+
+func (req SetCommandsRequest) Validate() error {
+	return nil
+}
+
+// Sets the list of commands supported by the bot for the given user scope and language; for bots only
+//
+// Available to bots only
+func (client *Client) SetCommands(ctx context.Context, req *SetCommandsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteCommandsRequest struct {
+	request
+	// The scope to which the commands are relevant; pass null to delete commands in the default bot command scope
+	Scope BotCommandScope `json:"scope,omitempty"`
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code"`
+}
+
+func (req DeleteCommandsRequest) GetFunctionName() string {
+	return "deleteCommands"
+}
+
+func (req *DeleteCommandsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteCommandsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteCommands"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Scope != nil {
+		encoder.writeRaw(`,"scope":`)
+		encoder.writeType(req.Scope)
+	}
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteCommandsRequest) Validate() error {
+	return nil
+}
+
+// Deletes commands supported by the bot for the given user scope and language; for bots only
+//
+// Available to bots only
+func (client *Client) DeleteCommands(ctx context.Context, req *DeleteCommandsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetCommandsRequest struct {
+	request
+	// The scope to which the commands are relevant; pass null to get commands in the default bot command scope
+	Scope BotCommandScope `json:"scope,omitempty"`
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code"`
+}
+
+func (req GetCommandsRequest) GetFunctionName() string {
+	return "getCommands"
+}
+
+func (req *GetCommandsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCommandsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCommands"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Scope != nil {
+		encoder.writeRaw(`,"scope":`)
+		encoder.writeType(req.Scope)
+	}
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetCommandsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of commands supported by the bot for the given user scope and language; for bots only
+//
+// Available to bots only
+func (client *Client) GetCommands(ctx context.Context, req *GetCommandsRequest) (*BotCommands, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBotCommands)
+}
+
+type SetMenuButtonRequest struct {
+	request
+	// Identifier of the user or 0 to set menu button for all users
+	UserId int64 `json:"user_id"`
+	// New menu button
+	MenuButton *BotMenuButton `json:"menu_button"`
+}
+
+func (req SetMenuButtonRequest) GetFunctionName() string {
+	return "setMenuButton"
+}
+
+func (req *SetMenuButtonRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetMenuButtonRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setMenuButton"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"menu_button":`)
+	req.MenuButton.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetMenuButtonRequest) Validate() error {
+	return nil
+}
+
+// Sets menu button for the given user or for all users; for bots only
+//
+// Available to bots only
+func (client *Client) SetMenuButton(ctx context.Context, req *SetMenuButtonRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetMenuButtonRequest struct {
+	request
+	// Identifier of the user or 0 to get the default menu button
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetMenuButtonRequest) GetFunctionName() string {
+	return "getMenuButton"
+}
+
+func (req *GetMenuButtonRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMenuButtonRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMenuButton"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMenuButtonRequest) Validate() error {
+	return nil
+}
+
+// Returns menu button set by the bot for the given user; for bots only
+//
+// Available to bots only
+func (client *Client) GetMenuButton(ctx context.Context, req *GetMenuButtonRequest) (*BotMenuButton, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBotMenuButton)
+}
+
+type GetConnectedWebsitesRequest struct {
+	request
+}
+
+func (req GetConnectedWebsitesRequest) GetFunctionName() string {
+	return "getConnectedWebsites"
+}
+
+func (req *GetConnectedWebsitesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetConnectedWebsitesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getConnectedWebsites"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetConnectedWebsitesRequest) Validate() error {
+	return nil
+}
+
+// Returns all website where the current user used Telegram to log in
+//
+// Available to users only
+func (client *Client) GetConnectedWebsites(ctx context.Context) (*ConnectedWebsites, error) {
+	req := &GetConnectedWebsitesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalConnectedWebsites)
+}
+
+type DisconnectWebsiteRequest struct {
+	request
+	// Website identifier
+	WebsiteId JsonInt64 `json:"website_id"`
+}
+
+func (req DisconnectWebsiteRequest) GetFunctionName() string {
+	return "disconnectWebsite"
+}
+
+func (req *DisconnectWebsiteRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DisconnectWebsiteRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"disconnectWebsite"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"website_id":`)
+	encoder.writeJsonInt64(req.WebsiteId)
+	encoder.writeRaw("}")
+}
+
+func (req DisconnectWebsiteRequest) Validate() error {
+	return nil
+}
+
+// Disconnects website from the current user's Telegram account
+//
+// Available to users only
+func (client *Client) DisconnectWebsite(ctx context.Context, req *DisconnectWebsiteRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DisconnectAllWebsitesRequest struct {
+	request
+}
+
+func (req DisconnectAllWebsitesRequest) GetFunctionName() string {
+	return "disconnectAllWebsites"
+}
+
+func (req *DisconnectAllWebsitesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DisconnectAllWebsitesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"disconnectAllWebsites"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req DisconnectAllWebsitesRequest) Validate() error {
+	return nil
+}
+
+// Disconnects all websites from the current user's Telegram account
+//
+// Available to users only
+func (client *Client) DisconnectAllWebsites(ctx context.Context) (*Ok, error) {
+	req := &DisconnectAllWebsitesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetTimeZonesRequest struct {
+	request
+}
+
+func (req GetTimeZonesRequest) GetFunctionName() string {
+	return "getTimeZones"
+}
+
+func (req *GetTimeZonesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetTimeZonesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getTimeZones"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetTimeZonesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of supported time zones
+func (client *Client) GetTimeZones(ctx context.Context) (*TimeZones, error) {
+	req := &GetTimeZonesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTimeZones)
+}
+
+type ValidateOrderInfoRequest struct {
+	request
+	// The invoice
+	InputInvoice InputInvoice `json:"input_invoice"`
+	// The order information, provided by the user; pass null if empty
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+	// Pass true to save the order information
+	AllowSave bool `json:"allow_save"`
+}
+
+func (req ValidateOrderInfoRequest) GetFunctionName() string {
+	return "validateOrderInfo"
+}
+
+func (req *ValidateOrderInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ValidateOrderInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"validateOrderInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"input_invoice":`)
+	encoder.writeType(req.InputInvoice)
+	if req.OrderInfo != nil {
+		encoder.writeRaw(`,"order_info":`)
+		req.OrderInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"allow_save":`)
+	encoder.writeBool(req.AllowSave)
+	encoder.writeRaw("}")
+}
+
+func (req ValidateOrderInfoRequest) Validate() error {
+	if err := validateNested("input_invoice", req.InputInvoice); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validates the order information provided by a user and returns the available shipping options for a flexible invoice
+//
+// Available to users only
+func (client *Client) ValidateOrderInfo(ctx context.Context, req *ValidateOrderInfoRequest) (*ValidatedOrderInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalValidatedOrderInfo)
+}
+
+type GetSavedOrderInfoRequest struct {
+	request
+}
+
+func (req GetSavedOrderInfoRequest) GetFunctionName() string {
+	return "getSavedOrderInfo"
+}
+
+func (req *GetSavedOrderInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSavedOrderInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSavedOrderInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetSavedOrderInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns saved order information. Returns a 404 error if there is no saved order information
+//
+// Available to users only
+func (client *Client) GetSavedOrderInfo(ctx context.Context) (*OrderInfo, error) {
+	req := &GetSavedOrderInfoRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOrderInfo)
+}
+
+type DeleteSavedOrderInfoRequest struct {
+	request
+}
+
+func (req DeleteSavedOrderInfoRequest) GetFunctionName() string {
+	return "deleteSavedOrderInfo"
+}
+
+func (req *DeleteSavedOrderInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteSavedOrderInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteSavedOrderInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteSavedOrderInfoRequest) Validate() error {
+	return nil
+}
+
+// Deletes saved order information
+//
+// Available to users only
+func (client *Client) DeleteSavedOrderInfo(ctx context.Context) (*Ok, error) {
+	req := &DeleteSavedOrderInfoRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteSavedCredentialsRequest struct {
+	request
+}
+
+func (req DeleteSavedCredentialsRequest) GetFunctionName() string {
+	return "deleteSavedCredentials"
+}
+
+func (req *DeleteSavedCredentialsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteSavedCredentialsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteSavedCredentials"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteSavedCredentialsRequest) Validate() error {
+	return nil
+}
+
+// Deletes saved credentials for all payment provider bots
+//
+// Available to users only
+func (client *Client) DeleteSavedCredentials(ctx context.Context) (*Ok, error) {
+	req := &DeleteSavedCredentialsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetLocalizationTargetInfoRequest struct {
+	request
+	// Pass true to get only locally available information without sending network requests
+	OnlyLocal bool `json:"only_local"`
+}
+
+func (req GetLocalizationTargetInfoRequest) GetFunctionName() string {
+	return "getLocalizationTargetInfo"
+}
+
+func (req *GetLocalizationTargetInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLocalizationTargetInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLocalizationTargetInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"only_local":`)
+	encoder.writeBool(req.OnlyLocal)
+	encoder.writeRaw("}")
+}
+
+func (req GetLocalizationTargetInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about the current localization target. This is an offline method if only_local is true. Can be called before authorization
+//
+// Available to users only
+func (client *Client) GetLocalizationTargetInfo(ctx context.Context, req *GetLocalizationTargetInfoRequest) (*LocalizationTargetInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLocalizationTargetInfo)
+}
+
+type GetLanguagePackInfoRequest struct {
+	request
+	// Language pack identifier
+	LanguagePackId string `json:"language_pack_id"`
+}
+
+func (req GetLanguagePackInfoRequest) GetFunctionName() string {
+	return "getLanguagePackInfo"
+}
+
+func (req *GetLanguagePackInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLanguagePackInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLanguagePackInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(req.LanguagePackId)
+	encoder.writeRaw("}")
+}
+
+func (req GetLanguagePackInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a language pack. Returned language pack identifier may be different from a provided one. Can be called before authorization
+//
+// Available to users only
+func (client *Client) GetLanguagePackInfo(ctx context.Context, req *GetLanguagePackInfoRequest) (*LanguagePackInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLanguagePackInfo)
+}
+
+type GetLanguagePackStringsRequest struct {
+	request
+	// Language pack identifier of the strings to be returned
+	LanguagePackId string `json:"language_pack_id"`
+	// Language pack keys of the strings to be returned; leave empty to request all available strings
+	Keys []string `json:"keys"`
+}
+
+func (req GetLanguagePackStringsRequest) GetFunctionName() string {
+	return "getLanguagePackStrings"
+}
+
+func (req *GetLanguagePackStringsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLanguagePackStringsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLanguagePackStrings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(req.LanguagePackId)
+	encoder.writeRaw(`,"keys":`)
+	writeList(encoder, req.Keys, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req GetLanguagePackStringsRequest) Validate() error {
+	return nil
+}
+
+// Returns strings from a language pack in the current localization target by their keys. Can be called before authorization
+//
+// Available to users only
+func (client *Client) GetLanguagePackStrings(ctx context.Context, req *GetLanguagePackStringsRequest) (*LanguagePackStrings, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLanguagePackStrings)
+}
+
+type SynchronizeLanguagePackRequest struct {
+	request
+	// Language pack identifier
+	LanguagePackId string `json:"language_pack_id"`
+}
+
+func (req SynchronizeLanguagePackRequest) GetFunctionName() string {
+	return "synchronizeLanguagePack"
+}
+
+func (req *SynchronizeLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SynchronizeLanguagePackRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"synchronizeLanguagePack"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(req.LanguagePackId)
+	encoder.writeRaw("}")
+}
+
+func (req SynchronizeLanguagePackRequest) Validate() error {
+	return nil
+}
+
+// Fetches the latest versions of all strings from a language pack in the current localization target from the server. This method doesn't need to be called explicitly for the current used/base language packs. Can be called before authorization
+//
+// Available to users only
+func (client *Client) SynchronizeLanguagePack(ctx context.Context, req *SynchronizeLanguagePackRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AddCustomServerLanguagePackRequest struct {
+	request
+	// Identifier of a language pack to be added
+	LanguagePackId string `json:"language_pack_id"`
+}
+
+func (req AddCustomServerLanguagePackRequest) GetFunctionName() string {
+	return "addCustomServerLanguagePack"
+}
+
+func (req *AddCustomServerLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddCustomServerLanguagePackRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addCustomServerLanguagePack"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(req.LanguagePackId)
+	encoder.writeRaw("}")
+}
+
+func (req AddCustomServerLanguagePackRequest) Validate() error {
+	return nil
+}
+
+// Adds a custom server language pack to the list of installed language packs in current localization target. Can be called before authorization
+//
+// Available to users only
+func (client *Client) AddCustomServerLanguagePack(ctx context.Context, req *AddCustomServerLanguagePackRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetCustomLanguagePackRequest struct {
+	request
+	// Information about the language pack. Language pack identifier must start with 'X', consist only of English letters, digits and hyphens, and must not exceed 64 characters. Can be called before authorization
+	Info *LanguagePackInfo `json:"info"`
+	// Strings of the new language pack
+	Strings []*LanguagePackString `json:"strings"`
+}
+
+func (req SetCustomLanguagePackRequest) GetFunctionName() string {
+	return "setCustomLanguagePack"
+}
+
+func (req *SetCustomLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetCustomLanguagePackRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setCustomLanguagePack"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"info":`)
+	req.Info.encodeJSON(encoder)
+	encoder.writeRaw(`,"strings":`)
+	writeList(encoder, req.Strings, writeTypeValue[*LanguagePackString])
+	encoder.writeRaw("}")
+}
+
+func (req SetCustomLanguagePackRequest) Validate() error {
+	return nil
+}
+
+// Adds or changes a custom local language pack to the current localization target
+//
+// Available to users only
+func (client *Client) SetCustomLanguagePack(ctx context.Context, req *SetCustomLanguagePackRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditCustomLanguagePackInfoRequest struct {
+	request
+	// New information about the custom local language pack
+	Info *LanguagePackInfo `json:"info"`
+}
+
+func (req EditCustomLanguagePackInfoRequest) GetFunctionName() string {
+	return "editCustomLanguagePackInfo"
+}
+
+func (req *EditCustomLanguagePackInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditCustomLanguagePackInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editCustomLanguagePackInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"info":`)
+	req.Info.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req EditCustomLanguagePackInfoRequest) Validate() error {
+	return nil
+}
+
+// Edits information about a custom local language pack in the current localization target. Can be called before authorization
+//
+// Available to users only
+func (client *Client) EditCustomLanguagePackInfo(ctx context.Context, req *EditCustomLanguagePackInfoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetCustomLanguagePackStringRequest struct {
+	request
+	// Identifier of a previously added custom local language pack in the current localization target
+	LanguagePackId string `json:"language_pack_id"`
+	// New language pack string
+	NewString *LanguagePackString `json:"new_string"`
+}
+
+func (req SetCustomLanguagePackStringRequest) GetFunctionName() string {
+	return "setCustomLanguagePackString"
+}
+
+func (req *SetCustomLanguagePackStringRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetCustomLanguagePackStringRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setCustomLanguagePackString"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(req.LanguagePackId)
+	encoder.writeRaw(`,"new_string":`)
+	req.NewString.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetCustomLanguagePackStringRequest) Validate() error {
+	return nil
+}
+
+// Adds, edits or deletes a string in a custom local language pack. Can be called before authorization
+//
+// Available to users only
+func (client *Client) SetCustomLanguagePackString(ctx context.Context, req *SetCustomLanguagePackStringRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteLanguagePackRequest struct {
+	request
+	// Identifier of the language pack to delete
+	LanguagePackId string `json:"language_pack_id"`
+}
+
+func (req DeleteLanguagePackRequest) GetFunctionName() string {
+	return "deleteLanguagePack"
+}
+
+func (req *DeleteLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteLanguagePackRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteLanguagePack"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(req.LanguagePackId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteLanguagePackRequest) Validate() error {
+	return nil
+}
+
+// Deletes all information about a language pack in the current localization target. The language pack which is currently in use (including base language pack) or is being synchronized can't be deleted. Can be called before authorization
+//
+// Available to users only
+func (client *Client) DeleteLanguagePack(ctx context.Context, req *DeleteLanguagePackRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RegisterDeviceRequest struct {
+	request
+	// Device token
+	DeviceToken DeviceToken `json:"device_token"`
+	// List of user identifiers of other users currently using the application
+	OtherUserIds []int64 `json:"other_user_ids"`
+}
+
+func (req RegisterDeviceRequest) GetFunctionName() string {
+	return "registerDevice"
+}
+
+func (req *RegisterDeviceRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RegisterDeviceRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"registerDevice"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"device_token":`)
+	encoder.writeType(req.DeviceToken)
+	encoder.writeRaw(`,"other_user_ids":`)
+	writeList(encoder, req.OtherUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req RegisterDeviceRequest) Validate() error {
+	return nil
+}
+
+// Registers the currently used device for receiving push notifications. Returns a globally unique identifier of the push notification subscription
+//
+// Available to users only
+func (client *Client) RegisterDevice(ctx context.Context, req *RegisterDeviceRequest) (*PushReceiverId, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPushReceiverId)
+}
+
+type ProcessPushNotificationRequest struct {
+	request
+	// JSON-encoded push notification payload with all fields sent by the server, and "google.sent_time" and "google.notification.sound" fields added
+	Payload string `json:"payload"`
+}
+
+func (req ProcessPushNotificationRequest) GetFunctionName() string {
+	return "processPushNotification"
+}
+
+func (req *ProcessPushNotificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ProcessPushNotificationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"processPushNotification"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeString(req.Payload)
+	encoder.writeRaw("}")
+}
+
+func (req ProcessPushNotificationRequest) Validate() error {
+	return nil
+}
+
+// Handles a push notification. Returns error with code 406 if the push notification is not supported and connection to the server is required to fetch new data. Can be called before authorization
+//
+// Available to users only
+func (client *Client) ProcessPushNotification(ctx context.Context, req *ProcessPushNotificationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetPushReceiverIdRequest struct {
+	request
+	// JSON-encoded push notification payload
+	Payload string `json:"payload"`
+}
+
+func (req GetPushReceiverIdRequest) GetFunctionName() string {
+	return "getPushReceiverId"
+}
+
+func (req *GetPushReceiverIdRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPushReceiverIdRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPushReceiverId"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeString(req.Payload)
+	encoder.writeRaw("}")
+}
+
+func (req GetPushReceiverIdRequest) Validate() error {
+	return nil
+}
+
+// Returns a globally unique push notification subscription identifier for identification of an account, which has received a push notification. Can be called synchronously
+func GetPushReceiverId(req *GetPushReceiverIdRequest) (*PushReceiverId, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPushReceiverId)
+}
+
+// deprecated
+// Returns a globally unique push notification subscription identifier for identification of an account, which has received a push notification. Can be called synchronously
+func (client *Client) GetPushReceiverId(req *GetPushReceiverIdRequest) (*PushReceiverId, error) {
+	return GetPushReceiverId(req)
+}
+
+type GetRecentlyVisitedTMeUrlsRequest struct {
+	request
+	// Google Play referrer to identify the user
+	Referrer string `json:"referrer"`
+}
+
+func (req GetRecentlyVisitedTMeUrlsRequest) GetFunctionName() string {
+	return "getRecentlyVisitedTMeUrls"
+}
+
+func (req *GetRecentlyVisitedTMeUrlsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecentlyVisitedTMeUrlsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecentlyVisitedTMeUrls"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"referrer":`)
+	encoder.writeString(req.Referrer)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecentlyVisitedTMeUrlsRequest) Validate() error {
+	return nil
+}
+
+// Returns t.me URLs recently visited by a newly registered user
+//
+// Available to users only
+func (client *Client) GetRecentlyVisitedTMeUrls(ctx context.Context, req *GetRecentlyVisitedTMeUrlsRequest) (*TMeUrls, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTMeUrls)
+}
+
+type GetOptionRequest struct {
+	request
+	// The name of the option
+	Name string `json:"name"`
+}
+
+func (req GetOptionRequest) GetFunctionName() string {
+	return "getOption"
+}
+
+func (req *GetOptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetOptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getOption"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req GetOptionRequest) Validate() error {
+	return nil
+}
+
+// Returns the value of an option by its name. (Check the list of available options on https://core.telegram.org/tdlib/options.) Can be called before authorization. Can be called synchronously for options "version" and "commit_hash"
+func GetOption(req *GetOptionRequest) (OptionValue, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOptionValue)
+}
+
+// deprecated
+// Returns the value of an option by its name. (Check the list of available options on https://core.telegram.org/tdlib/options.) Can be called before authorization. Can be called synchronously for options "version" and "commit_hash"
+func (client *Client) GetOption(req *GetOptionRequest) (OptionValue, error) {
+	return GetOption(req)
+}
+
+type SetOptionRequest struct {
+	request
+	// The name of the option
+	Name string `json:"name"`
+	// The new value of the option; pass null to reset option value to a default value
+	Value OptionValue `json:"value,omitempty"`
+}
+
+func (req SetOptionRequest) GetFunctionName() string {
+	return "setOption"
+}
+
+func (req *SetOptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetOptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setOption"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	if req.Value != nil {
+		encoder.writeRaw(`,"value":`)
+		encoder.writeType(req.Value)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetOptionRequest) Validate() error {
+	return nil
+}
+
+// Sets the value of an option. (Check the list of available options on https://core.telegram.org/tdlib/options.) Only writable options can be set. Can be called before authorization
+func (client *Client) SetOption(ctx context.Context, req *SetOptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetAccountTtlRequest struct {
+	request
+	// New account TTL
+	Ttl *AccountTtl `json:"ttl"`
+}
+
+func (req SetAccountTtlRequest) GetFunctionName() string {
+	return "setAccountTtl"
+}
+
+func (req *SetAccountTtlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAccountTtlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAccountTtl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"ttl":`)
+	req.Ttl.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetAccountTtlRequest) Validate() error {
+	return nil
+}
+
+// Changes the period of inactivity after which the account of the current user will automatically be deleted
+//
+// Available to users only
+func (client *Client) SetAccountTtl(ctx context.Context, req *SetAccountTtlRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetAccountTtlRequest struct {
+	request
+}
+
+func (req GetAccountTtlRequest) GetFunctionName() string {
+	return "getAccountTtl"
+}
+
+func (req *GetAccountTtlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAccountTtlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAccountTtl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetAccountTtlRequest) Validate() error {
+	return nil
+}
+
+// Returns the period of inactivity after which the account of the current user will automatically be deleted
+//
+// Available to users only
+func (client *Client) GetAccountTtl(ctx context.Context) (*AccountTtl, error) {
+	req := &GetAccountTtlRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAccountTtl)
+}
+
+type DeleteAccountRequest struct {
+	request
+	// The reason why the account was deleted; optional
+	Reason string `json:"reason"`
+	// The 2-step verification password of the current user. If the current user isn't authorized, then an empty string can be passed and account deletion can be canceled within one week
+	Password string `json:"password"`
+}
+
+func (req DeleteAccountRequest) GetFunctionName() string {
+	return "deleteAccount"
+}
+
+func (req *DeleteAccountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteAccountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteAccount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"reason":`)
+	encoder.writeString(req.Reason)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteAccountRequest) Validate() error {
+	return nil
+}
+
+// Deletes the account of the current user, deleting all information associated with the user from the server. The phone number of the account can be used to create a new account. Can be called before authorization when the current authorization state is authorizationStateWaitPassword
+//
+// Available to users only
+func (client *Client) DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetStatisticalGraphRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The token for graph loading
+	Token string `json:"token"`
+	// X-value for zoomed in graph or 0 otherwise
+	X int64 `json:"x"`
+}
+
+func (req GetStatisticalGraphRequest) GetFunctionName() string {
+	return "getStatisticalGraph"
+}
+
+func (req *GetStatisticalGraphRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStatisticalGraphRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStatisticalGraph"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(req.Token)
+	encoder.writeRaw(`,"x":`)
+	encoder.writeInt64(req.X)
+	encoder.writeRaw("}")
+}
+
+func (req GetStatisticalGraphRequest) Validate() error {
+	return nil
+}
+
+// Loads an asynchronous or a zoomed in statistical graph
+//
+// Available to users only
+func (client *Client) GetStatisticalGraph(ctx context.Context, req *GetStatisticalGraphRequest) (StatisticalGraph, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStatisticalGraph)
+}
+
+type GetStorageStatisticsRequest struct {
+	request
+	// The maximum number of chats with the largest storage usage for which separate statistics need to be returned. All other chats will be grouped in entries with chat_id == 0. If the chat info database is not used, the chat_limit is ignored and is always set to 0
+	ChatLimit int32 `json:"chat_limit"`
+}
+
+func (req GetStorageStatisticsRequest) GetFunctionName() string {
+	return "getStorageStatistics"
+}
+
+func (req *GetStorageStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStorageStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStorageStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_limit":`)
+	encoder.writeInt32(req.ChatLimit)
+	encoder.writeRaw("}")
+}
+
+func (req GetStorageStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns storage usage statistics. Can be called before authorization
+func (client *Client) GetStorageStatistics(ctx context.Context, req *GetStorageStatisticsRequest) (*StorageStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStorageStatistics)
+}
+
+type GetStorageStatisticsFastRequest struct {
+	request
+}
+
+func (req GetStorageStatisticsFastRequest) GetFunctionName() string {
+	return "getStorageStatisticsFast"
+}
+
+func (req *GetStorageStatisticsFastRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStorageStatisticsFastRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStorageStatisticsFast"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetStorageStatisticsFastRequest) Validate() error {
+	return nil
+}
+
+// Quickly returns approximate storage usage statistics. Can be called before authorization
+//
+// Available to users only
+func (client *Client) GetStorageStatisticsFast(ctx context.Context) (*StorageStatisticsFast, error) {
+	req := &GetStorageStatisticsFastRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStorageStatisticsFast)
+}
+
+type GetDatabaseStatisticsRequest struct {
+	request
+}
+
+func (req GetDatabaseStatisticsRequest) GetFunctionName() string {
+	return "getDatabaseStatistics"
+}
+
+func (req *GetDatabaseStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDatabaseStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDatabaseStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDatabaseStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns database statistics
+func (client *Client) GetDatabaseStatistics(ctx context.Context) (*DatabaseStatistics, error) {
+	req := &GetDatabaseStatisticsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalDatabaseStatistics)
+}
+
+type OptimizeStorageRequest struct {
+	request
+	// Limit on the total size of files after deletion, in bytes. Pass -1 to use the default limit
+	Size int64 `json:"size"`
+	// Limit on the time that has passed since the last time a file was accessed (or creation time for some filesystems). Pass -1 to use the default limit
+	Ttl int32 `json:"ttl"`
+	// Limit on the total number of files after deletion. Pass -1 to use the default limit
+	Count int32 `json:"count"`
+	// The amount of time after the creation of a file during which it can't be deleted, in seconds. Pass -1 to use the default value
+	ImmunityDelay int32 `json:"immunity_delay"`
+	// If non-empty, only files with the given types are considered. By default, all types except thumbnails, profile photos, stickers and wallpapers are deleted
+	FileTypes []FileType `json:"file_types"`
+	// If non-empty, only files from the given chats are considered. Use 0 as chat identifier to delete files not belonging to any chat (e.g., profile photos)
+	ChatIds []int64 `json:"chat_ids"`
+	// If non-empty, files from the given chats are excluded. Use 0 as chat identifier to exclude all files not belonging to any chat (e.g., profile photos)
+	ExcludeChatIds []int64 `json:"exclude_chat_ids"`
+	// Pass true if statistics about the files that were deleted must be returned instead of the whole storage usage statistics. Affects only returned statistics
+	ReturnDeletedFileStatistics bool `json:"return_deleted_file_statistics"`
+	// Same as in getStorageStatistics. Affects only returned statistics
+	ChatLimit int32 `json:"chat_limit"`
+}
+
+func (req OptimizeStorageRequest) GetFunctionName() string {
+	return "optimizeStorage"
+}
+
+func (req *OptimizeStorageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OptimizeStorageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"optimizeStorage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"size":`)
+	encoder.writeInt64(req.Size)
+	encoder.writeRaw(`,"ttl":`)
+	encoder.writeInt32(req.Ttl)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt32(req.Count)
+	encoder.writeRaw(`,"immunity_delay":`)
+	encoder.writeInt32(req.ImmunityDelay)
+	encoder.writeRaw(`,"file_types":`)
+	writeList(encoder, req.FileTypes, writeTypeValue[FileType])
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, req.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"exclude_chat_ids":`)
+	writeList(encoder, req.ExcludeChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"return_deleted_file_statistics":`)
+	encoder.writeBool(req.ReturnDeletedFileStatistics)
+	encoder.writeRaw(`,"chat_limit":`)
+	encoder.writeInt32(req.ChatLimit)
+	encoder.writeRaw("}")
+}
+
+func (req OptimizeStorageRequest) Validate() error {
+	return nil
+}
+
+// Optimizes storage usage, i.e. deletes some files and returns new storage usage statistics. Secret thumbnails can't be deleted
+func (client *Client) OptimizeStorage(ctx context.Context, req *OptimizeStorageRequest) (*StorageStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStorageStatistics)
+}
+
+type SetNetworkTypeRequest struct {
+	request
+	// The new network type; pass null to set network type to networkTypeOther
+	Type NetworkType `json:"type,omitempty"`
+}
+
+func (req SetNetworkTypeRequest) GetFunctionName() string {
+	return "setNetworkType"
+}
+
+func (req *SetNetworkTypeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetNetworkTypeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setNetworkType"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Type != nil {
+		encoder.writeRaw(`,"type":`)
+		encoder.writeType(req.Type)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetNetworkTypeRequest) Validate() error {
+	return nil
+}
+
+// Sets the current network type. Can be called before authorization. Calling this method forces all network connections to reopen, mitigating the delay in switching between different networks, so it must be called whenever the network is changed, even if the network type remains the same. Network type is used to check whether the library can use the network at all and also for collecting detailed network data usage statistics
+func (client *Client) SetNetworkType(ctx context.Context, req *SetNetworkTypeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetNetworkStatisticsRequest struct {
+	request
+	// Pass true to get statistics only for the current library launch
+	OnlyCurrent bool `json:"only_current"`
+}
+
+func (req GetNetworkStatisticsRequest) GetFunctionName() string {
+	return "getNetworkStatistics"
+}
+
+func (req *GetNetworkStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetNetworkStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getNetworkStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"only_current":`)
+	encoder.writeBool(req.OnlyCurrent)
+	encoder.writeRaw("}")
+}
+
+func (req GetNetworkStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns network data usage statistics. Can be called before authorization
+func (client *Client) GetNetworkStatistics(ctx context.Context, req *GetNetworkStatisticsRequest) (*NetworkStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalNetworkStatistics)
+}
+
+type AddNetworkStatisticsRequest struct {
+	request
+	// The network statistics entry with the data to be added to statistics
+	Entry NetworkStatisticsEntry `json:"entry"`
+}
+
+func (req AddNetworkStatisticsRequest) GetFunctionName() string {
+	return "addNetworkStatistics"
+}
+
+func (req *AddNetworkStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddNetworkStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addNetworkStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"entry":`)
+	encoder.writeType(req.Entry)
+	encoder.writeRaw("}")
+}
+
+func (req AddNetworkStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Adds the specified data to data usage statistics. Can be called before authorization
+func (client *Client) AddNetworkStatistics(ctx context.Context, req *AddNetworkStatisticsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ResetNetworkStatisticsRequest struct {
+	request
+}
+
+func (req ResetNetworkStatisticsRequest) GetFunctionName() string {
+	return "resetNetworkStatistics"
+}
+
+func (req *ResetNetworkStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResetNetworkStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetNetworkStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResetNetworkStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Resets all network data usage statistics to zero. Can be called before authorization
+func (client *Client) ResetNetworkStatistics(ctx context.Context) (*Ok, error) {
+	req := &ResetNetworkStatisticsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetAutosaveSettingsRequest struct {
+	request
+}
+
+func (req GetAutosaveSettingsRequest) GetFunctionName() string {
+	return "getAutosaveSettings"
+}
+
+func (req *GetAutosaveSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAutosaveSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAutosaveSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetAutosaveSettingsRequest) Validate() error {
+	return nil
+}
+
+// Returns autosave settings for the current user
+//
+// Available to users only
+func (client *Client) GetAutosaveSettings(ctx context.Context) (*AutosaveSettings, error) {
+	req := &GetAutosaveSettingsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAutosaveSettings)
+}
+
+type SetAutosaveSettingsRequest struct {
+	request
+	// Autosave settings scope
+	Scope AutosaveSettingsScope `json:"scope"`
+	// New autosave settings for the scope; pass null to set autosave settings to default
+	Settings *ScopeAutosaveSettings `json:"settings,omitempty"`
+}
+
+func (req SetAutosaveSettingsRequest) GetFunctionName() string {
+	return "setAutosaveSettings"
+}
+
+func (req *SetAutosaveSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAutosaveSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAutosaveSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"scope":`)
+	encoder.writeType(req.Scope)
+	if req.Settings != nil {
+		encoder.writeRaw(`,"settings":`)
+		req.Settings.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetAutosaveSettingsRequest) Validate() error {
+	return nil
+}
+
+// Sets autosave settings for the given scope. The method is guaranteed to work only after at least one call to getAutosaveSettings
+//
+// Available to users only
+func (client *Client) SetAutosaveSettings(ctx context.Context, req *SetAutosaveSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ClearAutosaveSettingsExceptionsRequest struct {
+	request
+}
+
+func (req ClearAutosaveSettingsExceptionsRequest) GetFunctionName() string {
+	return "clearAutosaveSettingsExceptions"
+}
+
+func (req *ClearAutosaveSettingsExceptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearAutosaveSettingsExceptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearAutosaveSettingsExceptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ClearAutosaveSettingsExceptionsRequest) Validate() error {
+	return nil
+}
+
+// Clears the list of all autosave settings exceptions. The method is guaranteed to work only after at least one call to getAutosaveSettings
+//
+// Available to users only
+func (client *Client) ClearAutosaveSettingsExceptions(ctx context.Context) (*Ok, error) {
+	req := &ClearAutosaveSettingsExceptionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBankCardInfoRequest struct {
+	request
+	// The bank card number
+	BankCardNumber string `json:"bank_card_number"`
+}
+
+func (req GetBankCardInfoRequest) GetFunctionName() string {
+	return "getBankCardInfo"
+}
+
+func (req *GetBankCardInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBankCardInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBankCardInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bank_card_number":`)
+	encoder.writeString(req.BankCardNumber)
+	encoder.writeRaw("}")
+}
+
+func (req GetBankCardInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a bank card
+//
+// Available to users only
+func (client *Client) GetBankCardInfo(ctx context.Context, req *GetBankCardInfoRequest) (*BankCardInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBankCardInfo)
+}
+
+type GetPreferredCountryLanguageRequest struct {
+	request
+	// A two-letter ISO 3166-1 alpha-2 country code
+	CountryCode string `json:"country_code"`
+}
+
+func (req GetPreferredCountryLanguageRequest) GetFunctionName() string {
+	return "getPreferredCountryLanguage"
+}
+
+func (req *GetPreferredCountryLanguageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPreferredCountryLanguageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPreferredCountryLanguage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"country_code":`)
+	encoder.writeString(req.CountryCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetPreferredCountryLanguageRequest) Validate() error {
+	return nil
+}
+
+// Returns an IETF language tag of the language preferred in the country, which must be used to fill native fields in Telegram Passport personal details. Returns a 404 error if unknown
+//
+// Available to users only
+func (client *Client) GetPreferredCountryLanguage(ctx context.Context, req *GetPreferredCountryLanguageRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type SendEmailAddressVerificationCodeRequest struct {
+	request
+	// Email address
+	EmailAddress string `json:"email_address"`
+}
+
+func (req SendEmailAddressVerificationCodeRequest) GetFunctionName() string {
+	return "sendEmailAddressVerificationCode"
+}
+
+func (req *SendEmailAddressVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendEmailAddressVerificationCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendEmailAddressVerificationCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"email_address":`)
+	encoder.writeString(req.EmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (req SendEmailAddressVerificationCodeRequest) Validate() error {
+	return nil
+}
+
+// Sends a code to verify an email address to be added to a user's Telegram Passport
+//
+// Available to users only
+func (client *Client) SendEmailAddressVerificationCode(ctx context.Context, req *SendEmailAddressVerificationCodeRequest) (*EmailAddressAuthenticationCodeInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmailAddressAuthenticationCodeInfo)
+}
+
+type ResendEmailAddressVerificationCodeRequest struct {
+	request
+}
+
+func (req ResendEmailAddressVerificationCodeRequest) GetFunctionName() string {
+	return "resendEmailAddressVerificationCode"
+}
+
+func (req *ResendEmailAddressVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResendEmailAddressVerificationCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendEmailAddressVerificationCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResendEmailAddressVerificationCodeRequest) Validate() error {
+	return nil
+}
+
+// Resends the code to verify an email address to be added to a user's Telegram Passport
+//
+// Available to users only
+func (client *Client) ResendEmailAddressVerificationCode(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	req := &ResendEmailAddressVerificationCodeRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmailAddressAuthenticationCodeInfo)
+}
+
+type CheckEmailAddressVerificationCodeRequest struct {
+	request
+	// Verification code to check
+	Code string `json:"code"`
+}
+
+func (req CheckEmailAddressVerificationCodeRequest) GetFunctionName() string {
+	return "checkEmailAddressVerificationCode"
+}
+
+func (req *CheckEmailAddressVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckEmailAddressVerificationCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkEmailAddressVerificationCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req CheckEmailAddressVerificationCodeRequest) Validate() error {
+	return nil
+}
+
+// Checks the email address verification code for Telegram Passport
+//
+// Available to users only
+func (client *Client) CheckEmailAddressVerificationCode(ctx context.Context, req *CheckEmailAddressVerificationCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CanPurchaseFromStoreRequest struct {
+	request
+	// Transaction purpose
+	Purpose StorePaymentPurpose `json:"purpose"`
+}
+
+func (req CanPurchaseFromStoreRequest) GetFunctionName() string {
+	return "canPurchaseFromStore"
+}
+
+func (req *CanPurchaseFromStoreRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CanPurchaseFromStoreRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canPurchaseFromStore"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"purpose":`)
+	encoder.writeType(req.Purpose)
+	encoder.writeRaw("}")
+}
+
+func (req CanPurchaseFromStoreRequest) Validate() error {
+	if err := validateNested("purpose", req.Purpose); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Checks whether an in-store purchase is possible. Must be called before any in-store purchase. For official applications only
+//
+// Available to users only
+func (client *Client) CanPurchaseFromStore(ctx context.Context, req *CanPurchaseFromStoreRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AcceptTermsOfServiceRequest struct {
+	request
+	// Terms of service identifier
+	TermsOfServiceId string `json:"terms_of_service_id"`
+}
+
+func (req AcceptTermsOfServiceRequest) GetFunctionName() string {
+	return "acceptTermsOfService"
+}
+
+func (req *AcceptTermsOfServiceRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AcceptTermsOfServiceRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"acceptTermsOfService"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"terms_of_service_id":`)
+	encoder.writeString(req.TermsOfServiceId)
+	encoder.writeRaw("}")
+}
+
+func (req AcceptTermsOfServiceRequest) Validate() error {
+	return nil
+}
+
+// Accepts Telegram terms of services
+//
+// Available to users only
+func (client *Client) AcceptTermsOfService(ctx context.Context, req *AcceptTermsOfServiceRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SearchStringsByPrefixRequest struct {
+	request
+	// The strings to search in for the query
+	Strings []string `json:"strings"`
+	// Query to search for
+	Query string `json:"query"`
+	// The maximum number of objects to return
+	Limit int32 `json:"limit"`
+	// Pass true to receive no results for an empty query
+	ReturnNoneForEmptyQuery bool `json:"return_none_for_empty_query"`
+}
+
+func (req SearchStringsByPrefixRequest) GetFunctionName() string {
+	return "searchStringsByPrefix"
+}
+
+func (req *SearchStringsByPrefixRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchStringsByPrefixRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchStringsByPrefix"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"strings":`)
+	writeList(encoder, req.Strings, (*jsonEncoder).writeString)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw(`,"return_none_for_empty_query":`)
+	encoder.writeBool(req.ReturnNoneForEmptyQuery)
+	encoder.writeRaw("}")
+}
+
+func (req SearchStringsByPrefixRequest) Validate() error {
+	return nil
+}
+
+// Searches specified query by word prefixes in the provided strings. Returns 0-based positions of strings that matched. Can be called synchronously
+func SearchStringsByPrefix(req *SearchStringsByPrefixRequest) (*FoundPositions, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundPositions)
+}
+
+// deprecated
+// Searches specified query by word prefixes in the provided strings. Returns 0-based positions of strings that matched. Can be called synchronously
+func (client *Client) SearchStringsByPrefix(req *SearchStringsByPrefixRequest) (*FoundPositions, error) {
+	return SearchStringsByPrefix(req)
+}
+
+type SendCustomRequestRequest struct {
+	request
+	// The method name
+	Method string `json:"method"`
+	// JSON-serialized method parameters
+	Parameters string `json:"parameters"`
+}
+
+func (req SendCustomRequestRequest) GetFunctionName() string {
+	return "sendCustomRequest"
+}
+
+func (req *SendCustomRequestRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendCustomRequestRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendCustomRequest"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"method":`)
+	encoder.writeString(req.Method)
+	encoder.writeRaw(`,"parameters":`)
+	encoder.writeString(req.Parameters)
+	encoder.writeRaw("}")
+}
+
+func (req SendCustomRequestRequest) Validate() error {
+	return nil
+}
+
+// Sends a custom request; for bots only
+//
+// Available to bots only
+func (client *Client) SendCustomRequest(ctx context.Context, req *SendCustomRequestRequest) (*CustomRequestResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCustomRequestResult)
+}
+
+type AnswerCustomQueryRequest struct {
+	request
+	// Identifier of a custom query
+	CustomQueryId JsonInt64 `json:"custom_query_id"`
+	// JSON-serialized answer to the query
+	Data string `json:"data"`
+}
+
+func (req AnswerCustomQueryRequest) GetFunctionName() string {
+	return "answerCustomQuery"
+}
+
+func (req *AnswerCustomQueryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AnswerCustomQueryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"answerCustomQuery"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"custom_query_id":`)
+	encoder.writeJsonInt64(req.CustomQueryId)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(req.Data)
+	encoder.writeRaw("}")
+}
+
+func (req AnswerCustomQueryRequest) Validate() error {
+	return nil
+}
+
+// Answers a custom query; for bots only
+//
+// Available to bots only
+func (client *Client) AnswerCustomQuery(ctx context.Context, req *AnswerCustomQueryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetAlarmRequest struct {
+	request
+	// Number of seconds before the function returns
+	Seconds float64 `json:"seconds"`
+}
+
+func (req SetAlarmRequest) GetFunctionName() string {
+	return "setAlarm"
+}
+
+func (req *SetAlarmRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAlarmRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAlarm"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"seconds":`)
+	encoder.writeFloat64(req.Seconds)
+	encoder.writeRaw("}")
+}
+
+func (req SetAlarmRequest) Validate() error {
+	return nil
+}
+
+// Succeeds after a specified amount of time has passed. Can be called before initialization
+func (client *Client) SetAlarm(ctx context.Context, req *SetAlarmRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetCountriesRequest struct {
+	request
+}
+
+func (req GetCountriesRequest) GetFunctionName() string {
+	return "getCountries"
+}
+
+func (req *GetCountriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCountriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCountries"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetCountriesRequest) Validate() error {
+	return nil
+}
+
+// Returns information about existing countries. Can be called before authorization
+func (client *Client) GetCountries(ctx context.Context) (*Countries, error) {
+	req := &GetCountriesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCountries)
+}
+
+type GetCountryCodeRequest struct {
+	request
+}
+
+func (req GetCountryCodeRequest) GetFunctionName() string {
+	return "getCountryCode"
+}
+
+func (req *GetCountryCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCountryCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCountryCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetCountryCodeRequest) Validate() error {
+	return nil
+}
+
+// Uses the current IP address to find the current country. Returns two-letter ISO 3166-1 alpha-2 country code. Can be called before authorization
+func (client *Client) GetCountryCode(ctx context.Context) (*Text, error) {
+	req := &GetCountryCodeRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type GetPhoneNumberInfoRequest struct {
+	request
+	// The phone number prefix
+	PhoneNumberPrefix string `json:"phone_number_prefix"`
+}
+
+func (req GetPhoneNumberInfoRequest) GetFunctionName() string {
+	return "getPhoneNumberInfo"
+}
+
+func (req *GetPhoneNumberInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPhoneNumberInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPhoneNumberInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"phone_number_prefix":`)
+	encoder.writeString(req.PhoneNumberPrefix)
+	encoder.writeRaw("}")
+}
+
+func (req GetPhoneNumberInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a phone number by its prefix. Can be called before authorization
+func (client *Client) GetPhoneNumberInfo(ctx context.Context, req *GetPhoneNumberInfoRequest) (*PhoneNumberInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPhoneNumberInfo)
+}
+
+type GetPhoneNumberInfoSyncRequest struct {
+	request
+	// A two-letter ISO 639-1 language code for country information localization
+	LanguageCode string `json:"language_code"`
+	// The phone number prefix
+	PhoneNumberPrefix string `json:"phone_number_prefix"`
+}
+
+func (req GetPhoneNumberInfoSyncRequest) GetFunctionName() string {
+	return "getPhoneNumberInfoSync"
+}
+
+func (req *GetPhoneNumberInfoSyncRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPhoneNumberInfoSyncRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPhoneNumberInfoSync"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw(`,"phone_number_prefix":`)
+	encoder.writeString(req.PhoneNumberPrefix)
+	encoder.writeRaw("}")
+}
+
+func (req GetPhoneNumberInfoSyncRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a phone number by its prefix synchronously. getCountries must be called at least once after changing localization to the specified language if properly localized country information is expected. Can be called synchronously
+func GetPhoneNumberInfoSync(req *GetPhoneNumberInfoSyncRequest) (*PhoneNumberInfo, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPhoneNumberInfo)
+}
+
+// deprecated
+// Returns information about a phone number by its prefix synchronously. getCountries must be called at least once after changing localization to the specified language if properly localized country information is expected. Can be called synchronously
+func (client *Client) GetPhoneNumberInfoSync(req *GetPhoneNumberInfoSyncRequest) (*PhoneNumberInfo, error) {
+	return GetPhoneNumberInfoSync(req)
+}
+
+type GetCollectibleItemInfoRequest struct {
+	request
+	// Type of the collectible item. The item must be used by a user and must be visible to the current user
+	Type CollectibleItemType `json:"type"`
+}
+
+func (req GetCollectibleItemInfoRequest) GetFunctionName() string {
+	return "getCollectibleItemInfo"
+}
+
+func (req *GetCollectibleItemInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCollectibleItemInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCollectibleItemInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req GetCollectibleItemInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a given collectible item that was purchased at https://fragment.com
+func (client *Client) GetCollectibleItemInfo(ctx context.Context, req *GetCollectibleItemInfoRequest) (*CollectibleItemInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCollectibleItemInfo)
+}
+
+type GetDeepLinkInfoRequest struct {
+	request
+	// The link
+	Link string `json:"link"`
+}
+
+func (req GetDeepLinkInfoRequest) GetFunctionName() string {
+	return "getDeepLinkInfo"
+}
+
+func (req *GetDeepLinkInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDeepLinkInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDeepLinkInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(req.Link)
+	encoder.writeRaw("}")
+}
+
+func (req GetDeepLinkInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a tg:// deep link. Use "tg://need_update_for_some_feature" or "tg:some_unsupported_feature" for testing. Returns a 404 error for unknown links. Can be called before authorization
+func (client *Client) GetDeepLinkInfo(ctx context.Context, req *GetDeepLinkInfoRequest) (*DeepLinkInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalDeepLinkInfo)
+}
+
+type GetApplicationConfigRequest struct {
+	request
+}
+
+func (req GetApplicationConfigRequest) GetFunctionName() string {
+	return "getApplicationConfig"
+}
+
+func (req *GetApplicationConfigRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetApplicationConfigRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getApplicationConfig"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetApplicationConfigRequest) Validate() error {
+	return nil
+}
+
+// Returns application config, provided by the server. Can be called before authorization
+//
+// Available to users only
+func (client *Client) GetApplicationConfig(ctx context.Context) (JsonValue, error) {
+	req := &GetApplicationConfigRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalJsonValue)
+}
+
+type SaveApplicationLogEventRequest struct {
+	request
+	// Event type
+	Type string `json:"type"`
+	// Optional chat identifier, associated with the event
+	ChatId int64 `json:"chat_id"`
+	// The log event data
+	Data JsonValue `json:"data"`
+}
+
+func (req SaveApplicationLogEventRequest) GetFunctionName() string {
+	return "saveApplicationLogEvent"
+}
+
+func (req *SaveApplicationLogEventRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SaveApplicationLogEventRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"saveApplicationLogEvent"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeString(req.Type)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeType(req.Data)
+	encoder.writeRaw("}")
+}
+
+func (req SaveApplicationLogEventRequest) Validate() error {
+	return nil
+}
+
+// Saves application log event on the server. Can be called before authorization
+//
+// Available to users only
+func (client *Client) SaveApplicationLogEvent(ctx context.Context, req *SaveApplicationLogEventRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AddProxyRequest struct {
+	request
+	// Proxy server domain or IP address
+	Server string `json:"server"`
+	// Proxy server port
+	Port int32 `json:"port"`
+	// Pass true to immediately enable the proxy
+	Enable bool `json:"enable"`
+	// Proxy type
+	Type ProxyType `json:"type"`
+}
+
+func (req AddProxyRequest) GetFunctionName() string {
+	return "addProxy"
+}
+
+func (req *AddProxyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddProxyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addProxy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"server":`)
+	encoder.writeString(req.Server)
+	encoder.writeRaw(`,"port":`)
+	encoder.writeInt32(req.Port)
+	encoder.writeRaw(`,"enable":`)
+	encoder.writeBool(req.Enable)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req AddProxyRequest) Validate() error {
+	return nil
+}
+
+// Adds a proxy server for network requests. Can be called before authorization
+func (client *Client) AddProxy(ctx context.Context, req *AddProxyRequest) (*Proxy, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalProxy)
+}
+
+type EditProxyRequest struct {
+	request
+	// Proxy identifier
+	ProxyId int32 `json:"proxy_id"`
+	// Proxy server domain or IP address
+	Server string `json:"server"`
+	// Proxy server port
+	Port int32 `json:"port"`
+	// Pass true to immediately enable the proxy
+	Enable bool `json:"enable"`
+	// Proxy type
+	Type ProxyType `json:"type"`
+}
+
+func (req EditProxyRequest) GetFunctionName() string {
+	return "editProxy"
+}
+
+func (req *EditProxyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditProxyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editProxy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"proxy_id":`)
+	encoder.writeInt32(req.ProxyId)
+	encoder.writeRaw(`,"server":`)
+	encoder.writeString(req.Server)
+	encoder.writeRaw(`,"port":`)
+	encoder.writeInt32(req.Port)
+	encoder.writeRaw(`,"enable":`)
+	encoder.writeBool(req.Enable)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req EditProxyRequest) Validate() error {
+	return nil
+}
+
+// Edits an existing proxy server for network requests. Can be called before authorization
+func (client *Client) EditProxy(ctx context.Context, req *EditProxyRequest) (*Proxy, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalProxy)
+}
+
+type EnableProxyRequest struct {
+	request
+	// Proxy identifier
+	ProxyId int32 `json:"proxy_id"`
+}
+
+func (req EnableProxyRequest) GetFunctionName() string {
+	return "enableProxy"
+}
+
+func (req *EnableProxyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EnableProxyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"enableProxy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"proxy_id":`)
+	encoder.writeInt32(req.ProxyId)
+	encoder.writeRaw("}")
+}
+
+func (req EnableProxyRequest) Validate() error {
+	return nil
+}
+
+// Enables a proxy. Only one proxy can be enabled at a time. Can be called before authorization
+func (client *Client) EnableProxy(ctx context.Context, req *EnableProxyRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DisableProxyRequest struct {
+	request
+}
+
+func (req DisableProxyRequest) GetFunctionName() string {
+	return "disableProxy"
+}
+
+func (req *DisableProxyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DisableProxyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"disableProxy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req DisableProxyRequest) Validate() error {
+	return nil
+}
+
+// Disables the currently enabled proxy. Can be called before authorization
+func (client *Client) DisableProxy(ctx context.Context) (*Ok, error) {
+	req := &DisableProxyRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveProxyRequest struct {
+	request
+	// Proxy identifier
+	ProxyId int32 `json:"proxy_id"`
+}
+
+func (req RemoveProxyRequest) GetFunctionName() string {
+	return "removeProxy"
+}
+
+func (req *RemoveProxyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveProxyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeProxy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"proxy_id":`)
+	encoder.writeInt32(req.ProxyId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveProxyRequest) Validate() error {
+	return nil
+}
+
+// Removes a proxy server. Can be called before authorization
+func (client *Client) RemoveProxy(ctx context.Context, req *RemoveProxyRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetProxiesRequest struct {
+	request
+}
+
+func (req GetProxiesRequest) GetFunctionName() string {
+	return "getProxies"
+}
+
+func (req *GetProxiesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetProxiesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getProxies"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetProxiesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of proxies that are currently set up. Can be called before authorization
+func (client *Client) GetProxies(ctx context.Context) (*Proxies, error) {
+	req := &GetProxiesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalProxies)
+}
+
+type GetProxyLinkRequest struct {
+	request
+	// Proxy identifier
+	ProxyId int32 `json:"proxy_id"`
+}
+
+func (req GetProxyLinkRequest) GetFunctionName() string {
+	return "getProxyLink"
+}
+
+func (req *GetProxyLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetProxyLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getProxyLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"proxy_id":`)
+	encoder.writeInt32(req.ProxyId)
+	encoder.writeRaw("}")
+}
+
+func (req GetProxyLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS link, which can be used to add a proxy. Available only for SOCKS5 and MTProto proxies. Can be called before authorization
+func (client *Client) GetProxyLink(ctx context.Context, req *GetProxyLinkRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type PingProxyRequest struct {
+	request
+	// Proxy identifier. Use 0 to ping a Telegram server without a proxy
+	ProxyId int32 `json:"proxy_id"`
+}
+
+func (req PingProxyRequest) GetFunctionName() string {
+	return "pingProxy"
+}
+
+func (req *PingProxyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *PingProxyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pingProxy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"proxy_id":`)
+	encoder.writeInt32(req.ProxyId)
+	encoder.writeRaw("}")
+}
+
+func (req PingProxyRequest) Validate() error {
+	return nil
+}
+
+// Computes time needed to receive a response from a Telegram server through a proxy. Can be called before authorization
+func (client *Client) PingProxy(ctx context.Context, req *PingProxyRequest) (*Seconds, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSeconds)
+}
+
+type SetLogStreamRequest struct {
+	request
+	// New log stream
+	LogStream LogStream `json:"log_stream"`
+}
+
+func (req SetLogStreamRequest) GetFunctionName() string {
+	return "setLogStream"
+}
+
+func (req *SetLogStreamRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetLogStreamRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setLogStream"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"log_stream":`)
+	encoder.writeType(req.LogStream)
+	encoder.writeRaw("}")
+}
+
+func (req SetLogStreamRequest) Validate() error {
+	return nil
+}
+
+// Sets new log stream for internal logging of TDLib. Can be called synchronously
+func SetLogStream(req *SetLogStreamRequest) (*Ok, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+// deprecated
+// Sets new log stream for internal logging of TDLib. Can be called synchronously
+func (client *Client) SetLogStream(req *SetLogStreamRequest) (*Ok, error) {
+	return SetLogStream(req)
+}
+
+type GetLogStreamRequest struct {
+	request
+}
+
+func (req GetLogStreamRequest) GetFunctionName() string {
+	return "getLogStream"
+}
+
+func (req *GetLogStreamRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLogStreamRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLogStream"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetLogStreamRequest) Validate() error {
+	return nil
+}
+
+// Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously
+func GetLogStream() (LogStream, error) {
+	req := &GetLogStreamRequest{}
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLogStream)
+}
+
+// deprecated
+// Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously
+func (client *Client) GetLogStream() (LogStream, error) {
+	return GetLogStream()
+}
+
+type SetLogVerbosityLevelRequest struct {
+	request
+	// New value of the verbosity level for logging. Value 0 corresponds to fatal errors, value 1 corresponds to errors, value 2 corresponds to warnings and debug warnings, value 3 corresponds to informational, value 4 corresponds to debug, value 5 corresponds to verbose debug, value greater than 5 and up to 1023 can be used to enable even more logging
+	NewVerbosityLevel int32 `json:"new_verbosity_level"`
+}
+
+func (req SetLogVerbosityLevelRequest) GetFunctionName() string {
+	return "setLogVerbosityLevel"
+}
+
+func (req *SetLogVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetLogVerbosityLevelRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setLogVerbosityLevel"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"new_verbosity_level":`)
+	encoder.writeInt32(req.NewVerbosityLevel)
+	encoder.writeRaw("}")
+}
+
+func (req SetLogVerbosityLevelRequest) Validate() error {
+	return nil
+}
+
+// Sets the verbosity level of the internal logging of TDLib. Can be called synchronously
+func SetLogVerbosityLevel(req *SetLogVerbosityLevelRequest) (*Ok, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+// deprecated
+// Sets the verbosity level of the internal logging of TDLib. Can be called synchronously
+func (client *Client) SetLogVerbosityLevel(req *SetLogVerbosityLevelRequest) (*Ok, error) {
+	return SetLogVerbosityLevel(req)
+}
+
+type GetLogVerbosityLevelRequest struct {
+	request
+}
+
+func (req GetLogVerbosityLevelRequest) GetFunctionName() string {
+	return "getLogVerbosityLevel"
+}
+
+func (req *GetLogVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLogVerbosityLevelRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLogVerbosityLevel"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetLogVerbosityLevelRequest) Validate() error {
+	return nil
+}
+
+// Returns current verbosity level of the internal logging of TDLib. Can be called synchronously
+func GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
+	req := &GetLogVerbosityLevelRequest{}
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLogVerbosityLevel)
+}
+
+// deprecated
+// Returns current verbosity level of the internal logging of TDLib. Can be called synchronously
+func (client *Client) GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
+	return GetLogVerbosityLevel()
+}
+
+type GetLogTagsRequest struct {
+	request
+}
+
+func (req GetLogTagsRequest) GetFunctionName() string {
+	return "getLogTags"
+}
+
+func (req *GetLogTagsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLogTagsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLogTags"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetLogTagsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of available TDLib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. Can be called synchronously
+func GetLogTags() (*LogTags, error) {
+	req := &GetLogTagsRequest{}
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLogTags)
+}
+
+// deprecated
+// Returns the list of available TDLib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. Can be called synchronously
+func (client *Client) GetLogTags() (*LogTags, error) {
+	return GetLogTags()
+}
+
+type SetLogTagVerbosityLevelRequest struct {
+	request
+	// Logging tag to change verbosity level
+	Tag string `json:"tag"`
+	// New verbosity level; 1-1024
+	NewVerbosityLevel int32 `json:"new_verbosity_level"`
+}
+
+func (req SetLogTagVerbosityLevelRequest) GetFunctionName() string {
+	return "setLogTagVerbosityLevel"
+}
+
+func (req *SetLogTagVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetLogTagVerbosityLevelRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setLogTagVerbosityLevel"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"tag":`)
+	encoder.writeString(req.Tag)
+	encoder.writeRaw(`,"new_verbosity_level":`)
+	encoder.writeInt32(req.NewVerbosityLevel)
+	encoder.writeRaw("}")
+}
+
+func (req SetLogTagVerbosityLevelRequest) Validate() error {
+	return nil
+}
+
+// Sets the verbosity level for a specified TDLib internal log tag. Can be called synchronously
+func SetLogTagVerbosityLevel(req *SetLogTagVerbosityLevelRequest) (*Ok, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+// deprecated
+// Sets the verbosity level for a specified TDLib internal log tag. Can be called synchronously
+func (client *Client) SetLogTagVerbosityLevel(req *SetLogTagVerbosityLevelRequest) (*Ok, error) {
+	return SetLogTagVerbosityLevel(req)
+}
+
+type GetLogTagVerbosityLevelRequest struct {
+	request
+	// Logging tag to change verbosity level
+	Tag string `json:"tag"`
+}
+
+func (req GetLogTagVerbosityLevelRequest) GetFunctionName() string {
+	return "getLogTagVerbosityLevel"
+}
+
+func (req *GetLogTagVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetLogTagVerbosityLevelRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getLogTagVerbosityLevel"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"tag":`)
+	encoder.writeString(req.Tag)
+	encoder.writeRaw("}")
+}
+
+func (req GetLogTagVerbosityLevelRequest) Validate() error {
+	return nil
+}
+
+// Returns current verbosity level for a specified TDLib internal log tag. Can be called synchronously
+func GetLogTagVerbosityLevel(req *GetLogTagVerbosityLevelRequest) (*LogVerbosityLevel, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalLogVerbosityLevel)
+}
+
+// deprecated
+// Returns current verbosity level for a specified TDLib internal log tag. Can be called synchronously
+func (client *Client) GetLogTagVerbosityLevel(req *GetLogTagVerbosityLevelRequest) (*LogVerbosityLevel, error) {
+	return GetLogTagVerbosityLevel(req)
+}
+
+type GetSupportNameRequest struct {
+	request
+}
+
+func (req GetSupportNameRequest) GetFunctionName() string {
+	return "getSupportName"
+}
+
+func (req *GetSupportNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSupportNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSupportName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetSupportNameRequest) Validate() error {
+	return nil
+}
+
+// Returns localized name of the Telegram support user; for Telegram support only
+//
+// Available to users only
+func (client *Client) GetSupportName(ctx context.Context) (*Text, error) {
+	req := &GetSupportNameRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type TestSquareIntRequest struct {
+	request
+	// Number to square
+	X int32 `json:"x"`
+}
+
+func (req TestSquareIntRequest) GetFunctionName() string {
+	return "testSquareInt"
+}
+
+func (req *TestSquareIntRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestSquareIntRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testSquareInt"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"x":`)
+	encoder.writeInt32(req.X)
+	encoder.writeRaw("}")
+}
+
+func (req TestSquareIntRequest) Validate() error {
+	return nil
+}
+
+// Returns the squared received number; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestSquareInt(ctx context.Context, req *TestSquareIntRequest) (*TestInt, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTestInt)
+}
+
+type TestNetworkRequest struct {
+	request
+}
+
+func (req TestNetworkRequest) GetFunctionName() string {
+	return "testNetwork"
+}
+
+func (req *TestNetworkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestNetworkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testNetwork"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req TestNetworkRequest) Validate() error {
+	return nil
+}
+
+// Sends a simple network request to the Telegram servers; for testing only. Can be called before authorization
+func (client *Client) TestNetwork(ctx context.Context) (*Ok, error) {
+	req := &TestNetworkRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type TestProxyRequest struct {
+	request
+	// Proxy server domain or IP address
+	Server string `json:"server"`
+	// Proxy server port
+	Port int32 `json:"port"`
+	// Proxy type
+	Type ProxyType `json:"type"`
+	// Identifier of a datacenter with which to test connection
+	DcId int32 `json:"dc_id"`
+	// The maximum overall timeout for the request
+	Timeout float64 `json:"timeout"`
+}
+
+func (req TestProxyRequest) GetFunctionName() string {
+	return "testProxy"
+}
+
+func (req *TestProxyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestProxyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testProxy"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"server":`)
+	encoder.writeString(req.Server)
+	encoder.writeRaw(`,"port":`)
+	encoder.writeInt32(req.Port)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw(`,"dc_id":`)
+	encoder.writeInt32(req.DcId)
+	encoder.writeRaw(`,"timeout":`)
+	encoder.writeFloat64(req.Timeout)
+	encoder.writeRaw("}")
+}
+
+func (req TestProxyRequest) Validate() error {
+	return nil
+}
+
+// Sends a simple network request to the Telegram servers via proxy; for testing only. Can be called before authorization
+func (client *Client) TestProxy(ctx context.Context, req *TestProxyRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type TestGetDifferenceRequest struct {
+	request
+}
+
+func (req TestGetDifferenceRequest) GetFunctionName() string {
+	return "testGetDifference"
+}
+
+func (req *TestGetDifferenceRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestGetDifferenceRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testGetDifference"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req TestGetDifferenceRequest) Validate() error {
+	return nil
+}
+
+// Forces an updates.getDifference call to the Telegram servers; for testing only
+func (client *Client) TestGetDifference(ctx context.Context) (*Ok, error) {
+	req := &TestGetDifferenceRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type TestReturnErrorRequest struct {
+	request
+	// The error to be returned
+	Error *Error `json:"error"`
+}
+
+func (req TestReturnErrorRequest) GetFunctionName() string {
+	return "testReturnError"
+}
+
+func (req *TestReturnErrorRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestReturnErrorRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testReturnError"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"error":`)
+	req.Error.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req TestReturnErrorRequest) Validate() error {
+	return nil
+}
+
+// Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously
+func TestReturnError(req *TestReturnErrorRequest) (*Error, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalError)
+}
+
+// deprecated
+// Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously
+func (client *Client) TestReturnError(req *TestReturnErrorRequest) (*Error, error) {
+	return TestReturnError(req)
+}
@@ -0,0 +1,1816 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type GetFileRequest struct {
+	request
+	// Identifier of the file to get
+	FileId int32 `json:"file_id"`
+}
+
+func (req GetFileRequest) GetFunctionName() string {
+	return "getFile"
+}
+
+func (req *GetFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw("}")
+}
+
+func (req GetFileRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a file. This is an offline method
+func (client *Client) GetFile(ctx context.Context, req *GetFileRequest) (*File, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFile)
+}
+
+type GetRemoteFileRequest struct {
+	request
+	// Remote identifier of the file to get
+	RemoteFileId string `json:"remote_file_id"`
+	// File type; pass null if unknown
+	FileType FileType `json:"file_type,omitempty"`
+}
+
+func (req GetRemoteFileRequest) GetFunctionName() string {
+	return "getRemoteFile"
+}
+
+func (req *GetRemoteFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRemoteFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRemoteFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"remote_file_id":`)
+	encoder.writeString(req.RemoteFileId)
+	if req.FileType != nil {
+		encoder.writeRaw(`,"file_type":`)
+		encoder.writeType(req.FileType)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req GetRemoteFileRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a file by its remote identifier. This is an offline method. Can be used to register a URL as a file for further uploading, or sending as a message. Even the request succeeds, the file can be used only if it is still accessible to the user. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application
+func (client *Client) GetRemoteFile(ctx context.Context, req *GetRemoteFileRequest) (*File, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFile)
+}
+
+type GetFileMimeTypeRequest struct {
+	request
+	// The name of the file or path to the file
+	FileName string `json:"file_name"`
+}
+
+func (req GetFileMimeTypeRequest) GetFunctionName() string {
+	return "getFileMimeType"
+}
+
+func (req *GetFileMimeTypeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetFileMimeTypeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getFileMimeType"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_name":`)
+	encoder.writeString(req.FileName)
+	encoder.writeRaw("}")
+}
+
+func (req GetFileMimeTypeRequest) Validate() error {
+	return nil
+}
+
+// Returns the MIME type of a file, guessed by its extension. Returns an empty string on failure. Can be called synchronously
+func GetFileMimeType(req *GetFileMimeTypeRequest) (*Text, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+// deprecated
+// Returns the MIME type of a file, guessed by its extension. Returns an empty string on failure. Can be called synchronously
+func (client *Client) GetFileMimeType(req *GetFileMimeTypeRequest) (*Text, error) {
+	return GetFileMimeType(req)
+}
+
+type GetFileExtensionRequest struct {
+	request
+	// The MIME type of the file
+	MimeType string `json:"mime_type"`
+}
+
+func (req GetFileExtensionRequest) GetFunctionName() string {
+	return "getFileExtension"
+}
+
+func (req *GetFileExtensionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetFileExtensionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getFileExtension"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(req.MimeType)
+	encoder.writeRaw("}")
+}
+
+func (req GetFileExtensionRequest) Validate() error {
+	return nil
+}
+
+// Returns the extension of a file, guessed by its MIME type. Returns an empty string on failure. Can be called synchronously
+func GetFileExtension(req *GetFileExtensionRequest) (*Text, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+// deprecated
+// Returns the extension of a file, guessed by its MIME type. Returns an empty string on failure. Can be called synchronously
+func (client *Client) GetFileExtension(req *GetFileExtensionRequest) (*Text, error) {
+	return GetFileExtension(req)
+}
+
+type CleanFileNameRequest struct {
+	request
+	// File name or path to the file
+	FileName string `json:"file_name"`
+}
+
+func (req CleanFileNameRequest) GetFunctionName() string {
+	return "cleanFileName"
+}
+
+func (req *CleanFileNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CleanFileNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"cleanFileName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_name":`)
+	encoder.writeString(req.FileName)
+	encoder.writeRaw("}")
+}
+
+func (req CleanFileNameRequest) Validate() error {
+	return nil
+}
+
+// Removes potentially dangerous characters from the name of a file. Returns an empty string on failure. Can be called synchronously
+func CleanFileName(req *CleanFileNameRequest) (*Text, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+// deprecated
+// Removes potentially dangerous characters from the name of a file. Returns an empty string on failure. Can be called synchronously
+func (client *Client) CleanFileName(req *CleanFileNameRequest) (*Text, error) {
+	return CleanFileName(req)
+}
+
+type DownloadFileRequest struct {
+	request
+	// Identifier of the file to download
+	FileId int32 `json:"file_id"`
+	// Priority of the download (1-32). The higher the priority, the earlier the file will be downloaded. If the priorities of two files are equal, then the last one for which downloadFile/addFileToDownloads was called will be downloaded first
+	Priority int32 `json:"priority"`
+	// The starting position from which the file needs to be downloaded
+	Offset int64 `json:"offset"`
+	// Number of bytes which need to be downloaded starting from the "offset" position before the download will automatically be canceled; use 0 to download without a limit
+	Limit int64 `json:"limit"`
+	// Pass true to return response only after the file download has succeeded, has failed, has been canceled, or a new downloadFile request with different offset/limit parameters was sent; pass false to return file state immediately, just after the download has been started
+	Synchronous bool `json:"synchronous"`
+}
+
+func (req DownloadFileRequest) GetFunctionName() string {
+	return "downloadFile"
+}
+
+func (req *DownloadFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DownloadFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"downloadFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"priority":`)
+	encoder.writeInt32(req.Priority)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt64(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt64(req.Limit)
+	encoder.writeRaw(`,"synchronous":`)
+	encoder.writeBool(req.Synchronous)
+	encoder.writeRaw("}")
+}
+
+func (req DownloadFileRequest) Validate() error {
+	return nil
+}
+
+// Downloads a file from the cloud. Download progress and completion of the download will be notified through updateFile updates
+func (client *Client) DownloadFile(ctx context.Context, req *DownloadFileRequest) (*File, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFile)
+}
+
+type GetFileDownloadedPrefixSizeRequest struct {
+	request
+	// Identifier of the file
+	FileId int32 `json:"file_id"`
+	// Offset from which downloaded prefix size needs to be calculated
+	Offset int64 `json:"offset"`
+}
+
+func (req GetFileDownloadedPrefixSizeRequest) GetFunctionName() string {
+	return "getFileDownloadedPrefixSize"
+}
+
+func (req *GetFileDownloadedPrefixSizeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetFileDownloadedPrefixSizeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getFileDownloadedPrefixSize"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt64(req.Offset)
+	encoder.writeRaw("}")
+}
+
+func (req GetFileDownloadedPrefixSizeRequest) Validate() error {
+	return nil
+}
+
+// Returns file downloaded prefix size from a given offset, in bytes
+func (client *Client) GetFileDownloadedPrefixSize(ctx context.Context, req *GetFileDownloadedPrefixSizeRequest) (*FileDownloadedPrefixSize, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFileDownloadedPrefixSize)
+}
+
+type CancelDownloadFileRequest struct {
+	request
+	// Identifier of a file to stop downloading
+	FileId int32 `json:"file_id"`
+	// Pass true to stop downloading only if it hasn't been started, i.e. request hasn't been sent to server
+	OnlyIfPending bool `json:"only_if_pending"`
+}
+
+func (req CancelDownloadFileRequest) GetFunctionName() string {
+	return "cancelDownloadFile"
+}
+
+func (req *CancelDownloadFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CancelDownloadFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"cancelDownloadFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"only_if_pending":`)
+	encoder.writeBool(req.OnlyIfPending)
+	encoder.writeRaw("}")
+}
+
+func (req CancelDownloadFileRequest) Validate() error {
+	return nil
+}
+
+// Stops the downloading of a file. If a file has already been downloaded, does nothing
+func (client *Client) CancelDownloadFile(ctx context.Context, req *CancelDownloadFileRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetSuggestedFileNameRequest struct {
+	request
+	// Identifier of the file
+	FileId int32 `json:"file_id"`
+	// Directory in which the file is expected to be saved
+	Directory string `json:"directory"`
+}
+
+func (req GetSuggestedFileNameRequest) GetFunctionName() string {
+	return "getSuggestedFileName"
+}
+
+func (req *GetSuggestedFileNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSuggestedFileNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSuggestedFileName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"directory":`)
+	encoder.writeString(req.Directory)
+	encoder.writeRaw("}")
+}
+
+func (req GetSuggestedFileNameRequest) Validate() error {
+	return nil
+}
+
+// Returns suggested name for saving a file in a given directory
+func (client *Client) GetSuggestedFileName(ctx context.Context, req *GetSuggestedFileNameRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type PreliminaryUploadFileRequest struct {
+	request
+	// File to upload
+	File InputFile `json:"file"`
+	// File type; pass null if unknown
+	FileType FileType `json:"file_type,omitempty"`
+	// Priority of the upload (1-32). The higher the priority, the earlier the file will be uploaded. If the priorities of two files are equal, then the first one for which preliminaryUploadFile was called will be uploaded first
+	Priority int32 `json:"priority"`
+}
+
+func (req PreliminaryUploadFileRequest) GetFunctionName() string {
+	return "preliminaryUploadFile"
+}
+
+func (req *PreliminaryUploadFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *PreliminaryUploadFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"preliminaryUploadFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file":`)
+	encoder.writeType(req.File)
+	if req.FileType != nil {
+		encoder.writeRaw(`,"file_type":`)
+		encoder.writeType(req.FileType)
+	}
+	encoder.writeRaw(`,"priority":`)
+	encoder.writeInt32(req.Priority)
+	encoder.writeRaw("}")
+}
+
+func (req PreliminaryUploadFileRequest) Validate() error {
+	return nil
+}
+
+// Preliminary uploads a file to the cloud before sending it in a message, which can be useful for uploading of being recorded voice and video notes. In all other cases there is no need to preliminary upload a file. Updates updateFile will be used to notify about upload progress. The upload will not be completed until the file is sent in a message
+func (client *Client) PreliminaryUploadFile(ctx context.Context, req *PreliminaryUploadFileRequest) (*File, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFile)
+}
+
+type CancelPreliminaryUploadFileRequest struct {
+	request
+	// Identifier of the file to stop uploading
+	FileId int32 `json:"file_id"`
+}
+
+func (req CancelPreliminaryUploadFileRequest) GetFunctionName() string {
+	return "cancelPreliminaryUploadFile"
+}
+
+func (req *CancelPreliminaryUploadFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CancelPreliminaryUploadFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"cancelPreliminaryUploadFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw("}")
+}
+
+func (req CancelPreliminaryUploadFileRequest) Validate() error {
+	return nil
+}
+
+// Stops the preliminary uploading of a file. Supported only for files uploaded by using preliminaryUploadFile
+func (client *Client) CancelPreliminaryUploadFile(ctx context.Context, req *CancelPreliminaryUploadFileRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type WriteGeneratedFilePartRequest struct {
+	request
+	// The identifier of the generation process
+	GenerationId JsonInt64 `json:"generation_id"`
+	// The offset from which to write the data to the file
+	Offset int64 `json:"offset"`
+	// The data to write
+	Data []byte `json:"data"`
+}
+
+func (req WriteGeneratedFilePartRequest) GetFunctionName() string {
+	return "writeGeneratedFilePart"
+}
+
+func (req *WriteGeneratedFilePartRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *WriteGeneratedFilePartRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"writeGeneratedFilePart"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"generation_id":`)
+	encoder.writeJsonInt64(req.GenerationId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt64(req.Offset)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(req.Data)
+	encoder.writeRaw("}")
+}
+
+func (req WriteGeneratedFilePartRequest) Validate() error {
+	return nil
+}
+
+// Writes a part of a generated file. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct write to the destination file
+func (client *Client) WriteGeneratedFilePart(ctx context.Context, req *WriteGeneratedFilePartRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetFileGenerationProgressRequest struct {
+	request
+	// The identifier of the generation process
+	GenerationId JsonInt64 `json:"generation_id"`
+	// Expected size of the generated file, in bytes; 0 if unknown
+	ExpectedSize int64 `json:"expected_size"`
+	// The number of bytes already generated
+	LocalPrefixSize int64 `json:"local_prefix_size"`
+}
+
+func (req SetFileGenerationProgressRequest) GetFunctionName() string {
+	return "setFileGenerationProgress"
+}
+
+func (req *SetFileGenerationProgressRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetFileGenerationProgressRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setFileGenerationProgress"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"generation_id":`)
+	encoder.writeJsonInt64(req.GenerationId)
+	encoder.writeRaw(`,"expected_size":`)
+	encoder.writeInt64(req.ExpectedSize)
+	encoder.writeRaw(`,"local_prefix_size":`)
+	encoder.writeInt64(req.LocalPrefixSize)
+	encoder.writeRaw("}")
+}
+
+func (req SetFileGenerationProgressRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib on a file generation progress
+func (client *Client) SetFileGenerationProgress(ctx context.Context, req *SetFileGenerationProgressRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type FinishFileGenerationRequest struct {
+	request
+	// The identifier of the generation process
+	GenerationId JsonInt64 `json:"generation_id"`
+	// If passed, the file generation has failed and must be terminated; pass null if the file generation succeeded
+	Error *Error `json:"error,omitempty"`
+}
+
+func (req FinishFileGenerationRequest) GetFunctionName() string {
+	return "finishFileGeneration"
+}
+
+func (req *FinishFileGenerationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *FinishFileGenerationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"finishFileGeneration"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"generation_id":`)
+	encoder.writeJsonInt64(req.GenerationId)
+	if req.Error != nil {
+		encoder.writeRaw(`,"error":`)
+		req.Error.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req FinishFileGenerationRequest) Validate() error {
+	return nil
+}
+
+// Finishes the file generation
+func (client *Client) FinishFileGeneration(ctx context.Context, req *FinishFileGenerationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReadFilePartRequest struct {
+	request
+	// Identifier of the file. The file must be located in the TDLib file cache
+	FileId int32 `json:"file_id"`
+	// The offset from which to read the file
+	Offset int64 `json:"offset"`
+	// Number of bytes to read. An error will be returned if there are not enough bytes available in the file from the specified position. Pass 0 to read all available data from the specified position
+	Count int64 `json:"count"`
+}
+
+func (req ReadFilePartRequest) GetFunctionName() string {
+	return "readFilePart"
+}
+
+func (req *ReadFilePartRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReadFilePartRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readFilePart"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt64(req.Offset)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt64(req.Count)
+	encoder.writeRaw("}")
+}
+
+func (req ReadFilePartRequest) Validate() error {
+	return nil
+}
+
+// Reads a part of a file from the TDLib file cache and returns read bytes. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct read from the file
+func (client *Client) ReadFilePart(ctx context.Context, req *ReadFilePartRequest) (*FilePart, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFilePart)
+}
+
+type DeleteFileRequest struct {
+	request
+	// Identifier of the file to delete
+	FileId int32 `json:"file_id"`
+}
+
+func (req DeleteFileRequest) GetFunctionName() string {
+	return "deleteFile"
+}
+
+func (req *DeleteFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteFileRequest) Validate() error {
+	return nil
+}
+
+// Deletes a file from the TDLib file cache
+func (client *Client) DeleteFile(ctx context.Context, req *DeleteFileRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AddFileToDownloadsRequest struct {
+	request
+	// Identifier of the file to download
+	FileId int32 `json:"file_id"`
+	// Chat identifier of the message with the file
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Priority of the download (1-32). The higher the priority, the earlier the file will be downloaded. If the priorities of two files are equal, then the last one for which downloadFile/addFileToDownloads was called will be downloaded first
+	Priority int32 `json:"priority"`
+}
+
+func (req AddFileToDownloadsRequest) GetFunctionName() string {
+	return "addFileToDownloads"
+}
+
+func (req *AddFileToDownloadsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddFileToDownloadsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addFileToDownloads"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"priority":`)
+	encoder.writeInt32(req.Priority)
+	encoder.writeRaw("}")
+}
+
+func (req AddFileToDownloadsRequest) Validate() error {
+	return nil
+}
+
+// Adds a file from a message to the list of file downloads. Download progress and completion of the download will be notified through updateFile updates. If message database is used, the list of file downloads is persistent across application restarts. The downloading is independent of download using downloadFile, i.e. it continues if downloadFile is canceled or is used to download a part of the file
+func (client *Client) AddFileToDownloads(ctx context.Context, req *AddFileToDownloadsRequest) (*File, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFile)
+}
+
+type ToggleDownloadIsPausedRequest struct {
+	request
+	// Identifier of the downloaded file
+	FileId int32 `json:"file_id"`
+	// Pass true if the download is paused
+	IsPaused bool `json:"is_paused"`
+}
+
+func (req ToggleDownloadIsPausedRequest) GetFunctionName() string {
+	return "toggleDownloadIsPaused"
+}
+
+func (req *ToggleDownloadIsPausedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleDownloadIsPausedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleDownloadIsPaused"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"is_paused":`)
+	encoder.writeBool(req.IsPaused)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleDownloadIsPausedRequest) Validate() error {
+	return nil
+}
+
+// Changes pause state of a file in the file download list
+func (client *Client) ToggleDownloadIsPaused(ctx context.Context, req *ToggleDownloadIsPausedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleAllDownloadsArePausedRequest struct {
+	request
+	// Pass true to pause all downloads; pass false to unpause them
+	ArePaused bool `json:"are_paused"`
+}
+
+func (req ToggleAllDownloadsArePausedRequest) GetFunctionName() string {
+	return "toggleAllDownloadsArePaused"
+}
+
+func (req *ToggleAllDownloadsArePausedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleAllDownloadsArePausedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleAllDownloadsArePaused"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"are_paused":`)
+	encoder.writeBool(req.ArePaused)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleAllDownloadsArePausedRequest) Validate() error {
+	return nil
+}
+
+// Changes pause state of all files in the file download list
+func (client *Client) ToggleAllDownloadsArePaused(ctx context.Context, req *ToggleAllDownloadsArePausedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveFileFromDownloadsRequest struct {
+	request
+	// Identifier of the downloaded file
+	FileId int32 `json:"file_id"`
+	// Pass true to delete the file from the TDLib file cache
+	DeleteFromCache bool `json:"delete_from_cache"`
+}
+
+func (req RemoveFileFromDownloadsRequest) GetFunctionName() string {
+	return "removeFileFromDownloads"
+}
+
+func (req *RemoveFileFromDownloadsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveFileFromDownloadsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeFileFromDownloads"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw(`,"delete_from_cache":`)
+	encoder.writeBool(req.DeleteFromCache)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveFileFromDownloadsRequest) Validate() error {
+	return nil
+}
+
+// Removes a file from the file download list
+func (client *Client) RemoveFileFromDownloads(ctx context.Context, req *RemoveFileFromDownloadsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveAllFilesFromDownloadsRequest struct {
+	request
+	// Pass true to remove only active downloads, including paused
+	OnlyActive bool `json:"only_active"`
+	// Pass true to remove only completed downloads
+	OnlyCompleted bool `json:"only_completed"`
+	// Pass true to delete the file from the TDLib file cache
+	DeleteFromCache bool `json:"delete_from_cache"`
+}
+
+func (req RemoveAllFilesFromDownloadsRequest) GetFunctionName() string {
+	return "removeAllFilesFromDownloads"
+}
+
+func (req *RemoveAllFilesFromDownloadsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveAllFilesFromDownloadsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeAllFilesFromDownloads"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"only_active":`)
+	encoder.writeBool(req.OnlyActive)
+	encoder.writeRaw(`,"only_completed":`)
+	encoder.writeBool(req.OnlyCompleted)
+	encoder.writeRaw(`,"delete_from_cache":`)
+	encoder.writeBool(req.DeleteFromCache)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveAllFilesFromDownloadsRequest) Validate() error {
+	return nil
+}
+
+// Removes all files from the file download list
+func (client *Client) RemoveAllFilesFromDownloads(ctx context.Context, req *RemoveAllFilesFromDownloadsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SearchFileDownloadsRequest struct {
+	request
+	// Query to search for; may be empty to return all downloaded files
+	Query string `json:"query"`
+	// Pass true to search only for active downloads, including paused
+	OnlyActive bool `json:"only_active"`
+	// Pass true to search only for completed downloads
+	OnlyCompleted bool `json:"only_completed"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of files to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchFileDownloadsRequest) GetFunctionName() string {
+	return "searchFileDownloads"
+}
+
+func (req *SearchFileDownloadsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchFileDownloadsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchFileDownloads"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"only_active":`)
+	encoder.writeBool(req.OnlyActive)
+	encoder.writeRaw(`,"only_completed":`)
+	encoder.writeBool(req.OnlyCompleted)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchFileDownloadsRequest) Validate() error {
+	return nil
+}
+
+// Searches for files in the file download list or recently downloaded files from the list
+func (client *Client) SearchFileDownloads(ctx context.Context, req *SearchFileDownloadsRequest) (*FoundFileDownloads, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundFileDownloads)
+}
+
+type GetSavedAnimationsRequest struct {
+	request
+}
+
+func (req GetSavedAnimationsRequest) GetFunctionName() string {
+	return "getSavedAnimations"
+}
+
+func (req *GetSavedAnimationsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSavedAnimationsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSavedAnimations"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetSavedAnimationsRequest) Validate() error {
+	return nil
+}
+
+// Returns saved animations
+//
+// Available to users only
+func (client *Client) GetSavedAnimations(ctx context.Context) (*Animations, error) {
+	req := &GetSavedAnimationsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAnimations)
+}
+
+type AddSavedAnimationRequest struct {
+	request
+	// The animation file to be added. Only animations known to the server (i.e., successfully sent via a message) can be added to the list
+	Animation InputFile `json:"animation"`
+}
+
+func (req AddSavedAnimationRequest) GetFunctionName() string {
+	return "addSavedAnimation"
+}
+
+func (req *AddSavedAnimationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddSavedAnimationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addSavedAnimation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"animation":`)
+	encoder.writeType(req.Animation)
+	encoder.writeRaw("}")
+}
+
+func (req AddSavedAnimationRequest) Validate() error {
+	return nil
+}
+
+// Manually adds a new animation to the list of saved animations. The new animation is added to the beginning of the list. If the animation was already in the list, it is removed first. Only non-secret video animations with MIME type "video/mp4" can be added to the list
+//
+// Available to users only
+func (client *Client) AddSavedAnimation(ctx context.Context, req *AddSavedAnimationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveSavedAnimationRequest struct {
+	request
+	// Animation file to be removed
+	Animation InputFile `json:"animation"`
+}
+
+func (req RemoveSavedAnimationRequest) GetFunctionName() string {
+	return "removeSavedAnimation"
+}
+
+func (req *RemoveSavedAnimationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveSavedAnimationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeSavedAnimation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"animation":`)
+	encoder.writeType(req.Animation)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveSavedAnimationRequest) Validate() error {
+	return nil
+}
+
+// Removes an animation from the list of saved animations
+//
+// Available to users only
+func (client *Client) RemoveSavedAnimation(ctx context.Context, req *RemoveSavedAnimationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBackgroundUrlRequest struct {
+	request
+	// Background name
+	Name string `json:"name"`
+	// Background type; backgroundTypeChatTheme isn't supported
+	Type BackgroundType `json:"type"`
+}
+
+func (req GetBackgroundUrlRequest) GetFunctionName() string {
+	return "getBackgroundUrl"
+}
+
+func (req *GetBackgroundUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBackgroundUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBackgroundUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req GetBackgroundUrlRequest) Validate() error {
+	return nil
+}
+
+// Constructs a persistent HTTP URL for a background
+//
+// Available to users only
+func (client *Client) GetBackgroundUrl(ctx context.Context, req *GetBackgroundUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type SearchBackgroundRequest struct {
+	request
+	// The name of the background
+	Name string `json:"name"`
+}
+
+func (req SearchBackgroundRequest) GetFunctionName() string {
+	return "searchBackground"
+}
+
+func (req *SearchBackgroundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchBackgroundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchBackground"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req SearchBackgroundRequest) Validate() error {
+	return nil
+}
+
+// Searches for a background by its name
+//
+// Available to users only
+func (client *Client) SearchBackground(ctx context.Context, req *SearchBackgroundRequest) (*Background, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBackground)
+}
+
+type SetDefaultBackgroundRequest struct {
+	request
+	// The input background to use; pass null to create a new filled background
+	Background InputBackground `json:"background,omitempty"`
+	// Background type; pass null to use the default type of the remote background; backgroundTypeChatTheme isn't supported
+	Type BackgroundType `json:"type,omitempty"`
+	// Pass true if the background is set for a dark theme
+	ForDarkTheme bool `json:"for_dark_theme"`
+}
+
+func (req SetDefaultBackgroundRequest) GetFunctionName() string {
+	return "setDefaultBackground"
+}
+
+func (req *SetDefaultBackgroundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetDefaultBackgroundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setDefaultBackground"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Background != nil {
+		encoder.writeRaw(`,"background":`)
+		encoder.writeType(req.Background)
+	}
+	if req.Type != nil {
+		encoder.writeRaw(`,"type":`)
+		encoder.writeType(req.Type)
+	}
+	encoder.writeRaw(`,"for_dark_theme":`)
+	encoder.writeBool(req.ForDarkTheme)
+	encoder.writeRaw("}")
+}
+
+func (req SetDefaultBackgroundRequest) Validate() error {
+	return nil
+}
+
+// Sets default background for chats; adds the background to the list of installed backgrounds
+//
+// Available to users only
+func (client *Client) SetDefaultBackground(ctx context.Context, req *SetDefaultBackgroundRequest) (*Background, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBackground)
+}
+
+type DeleteDefaultBackgroundRequest struct {
+	request
+	// Pass true if the background is deleted for a dark theme
+	ForDarkTheme bool `json:"for_dark_theme"`
+}
+
+func (req DeleteDefaultBackgroundRequest) GetFunctionName() string {
+	return "deleteDefaultBackground"
+}
+
+func (req *DeleteDefaultBackgroundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteDefaultBackgroundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteDefaultBackground"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"for_dark_theme":`)
+	encoder.writeBool(req.ForDarkTheme)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteDefaultBackgroundRequest) Validate() error {
+	return nil
+}
+
+// Deletes default background for chats
+//
+// Available to users only
+func (client *Client) DeleteDefaultBackground(ctx context.Context, req *DeleteDefaultBackgroundRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetInstalledBackgroundsRequest struct {
+	request
+	// Pass true to order returned backgrounds for a dark theme
+	ForDarkTheme bool `json:"for_dark_theme"`
+}
+
+func (req GetInstalledBackgroundsRequest) GetFunctionName() string {
+	return "getInstalledBackgrounds"
+}
+
+func (req *GetInstalledBackgroundsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetInstalledBackgroundsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getInstalledBackgrounds"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"for_dark_theme":`)
+	encoder.writeBool(req.ForDarkTheme)
+	encoder.writeRaw("}")
+}
+
+func (req GetInstalledBackgroundsRequest) Validate() error {
+	return nil
+}
+
+// Returns backgrounds installed by the user
+//
+// Available to users only
+func (client *Client) GetInstalledBackgrounds(ctx context.Context, req *GetInstalledBackgroundsRequest) (*Backgrounds, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalBackgrounds)
+}
+
+type RemoveInstalledBackgroundRequest struct {
+	request
+	// The background identifier
+	BackgroundId JsonInt64 `json:"background_id"`
+}
+
+func (req RemoveInstalledBackgroundRequest) GetFunctionName() string {
+	return "removeInstalledBackground"
+}
+
+func (req *RemoveInstalledBackgroundRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveInstalledBackgroundRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeInstalledBackground"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"background_id":`)
+	encoder.writeJsonInt64(req.BackgroundId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveInstalledBackgroundRequest) Validate() error {
+	return nil
+}
+
+// Removes background from the list of installed backgrounds
+//
+// Available to users only
+func (client *Client) RemoveInstalledBackground(ctx context.Context, req *RemoveInstalledBackgroundRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ResetInstalledBackgroundsRequest struct {
+	request
+}
+
+func (req ResetInstalledBackgroundsRequest) GetFunctionName() string {
+	return "resetInstalledBackgrounds"
+}
+
+func (req *ResetInstalledBackgroundsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResetInstalledBackgroundsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetInstalledBackgrounds"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ResetInstalledBackgroundsRequest) Validate() error {
+	return nil
+}
+
+// Resets list of installed backgrounds to its default value
+//
+// Available to users only
+func (client *Client) ResetInstalledBackgrounds(ctx context.Context) (*Ok, error) {
+	req := &ResetInstalledBackgroundsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetAutoDownloadSettingsPresetsRequest struct {
+	request
+}
+
+func (req GetAutoDownloadSettingsPresetsRequest) GetFunctionName() string {
+	return "getAutoDownloadSettingsPresets"
+}
+
+func (req *GetAutoDownloadSettingsPresetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAutoDownloadSettingsPresetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAutoDownloadSettingsPresets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetAutoDownloadSettingsPresetsRequest) Validate() error {
+	return nil
+}
+
+// Returns auto-download settings presets for the current user
+//
+// Available to users only
+func (client *Client) GetAutoDownloadSettingsPresets(ctx context.Context) (*AutoDownloadSettingsPresets, error) {
+	req := &GetAutoDownloadSettingsPresetsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAutoDownloadSettingsPresets)
+}
+
+type SetAutoDownloadSettingsRequest struct {
+	request
+	// New user auto-download settings
+	Settings *AutoDownloadSettings `json:"settings"`
+	// Type of the network for which the new settings are relevant
+	Type NetworkType `json:"type"`
+}
+
+func (req SetAutoDownloadSettingsRequest) GetFunctionName() string {
+	return "setAutoDownloadSettings"
+}
+
+func (req *SetAutoDownloadSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetAutoDownloadSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setAutoDownloadSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"settings":`)
+	req.Settings.encodeJSON(encoder)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req SetAutoDownloadSettingsRequest) Validate() error {
+	return nil
+}
+
+// Sets auto-download settings
+//
+// Available to users only
+func (client *Client) SetAutoDownloadSettings(ctx context.Context, req *SetAutoDownloadSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetMapThumbnailFileRequest struct {
+	request
+	// Location of the map center
+	Location *Location `json:"location"`
+	// Map zoom level; 13-20
+	Zoom int32 `json:"zoom"`
+	// Map width in pixels before applying scale; 16-1024
+	Width int32 `json:"width"`
+	// Map height in pixels before applying scale; 16-1024
+	Height int32 `json:"height"`
+	// Map scale; 1-3
+	Scale int32 `json:"scale"`
+	// Identifier of a chat in which the thumbnail will be shown. Use 0 if unknown
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetMapThumbnailFileRequest) GetFunctionName() string {
+	return "getMapThumbnailFile"
+}
+
+func (req *GetMapThumbnailFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMapThumbnailFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMapThumbnailFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"location":`)
+	req.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"zoom":`)
+	encoder.writeInt32(req.Zoom)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(req.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(req.Height)
+	encoder.writeRaw(`,"scale":`)
+	encoder.writeInt32(req.Scale)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMapThumbnailFileRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a file with a map thumbnail in PNG format. Only map thumbnail files with size less than 1MB can be downloaded
+func (client *Client) GetMapThumbnailFile(ctx context.Context, req *GetMapThumbnailFileRequest) (*File, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFile)
+}
+
+type GetApplicationDownloadLinkRequest struct {
+	request
+}
+
+func (req GetApplicationDownloadLinkRequest) GetFunctionName() string {
+	return "getApplicationDownloadLink"
+}
+
+func (req *GetApplicationDownloadLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetApplicationDownloadLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getApplicationDownloadLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetApplicationDownloadLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns the link for downloading official Telegram application to be used when the current user invites friends to Telegram
+//
+// Available to users only
+func (client *Client) GetApplicationDownloadLink(ctx context.Context) (*HttpUrl, error) {
+	req := &GetApplicationDownloadLinkRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
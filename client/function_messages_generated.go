@@ -0,0 +1,6675 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type GetMessageRequest struct {
+	request
+	// Identifier of the chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message to get
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetMessageRequest) GetFunctionName() string {
+	return "getMessage"
+}
+
+func (req *GetMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a message. Returns a 404 error if the message doesn't exist
+func (client *Client) GetMessage(ctx context.Context, req *GetMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type GetMessageLocallyRequest struct {
+	request
+	// Identifier of the chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message to get
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetMessageLocallyRequest) GetFunctionName() string {
+	return "getMessageLocally"
+}
+
+func (req *GetMessageLocallyRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageLocallyRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageLocally"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageLocallyRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a message, if it is available without sending network request. Returns a 404 error if message isn't available locally. This is an offline method
+func (client *Client) GetMessageLocally(ctx context.Context, req *GetMessageLocallyRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type GetRepliedMessageRequest struct {
+	request
+	// Identifier of the chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the reply message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetRepliedMessageRequest) GetFunctionName() string {
+	return "getRepliedMessage"
+}
+
+func (req *GetRepliedMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRepliedMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRepliedMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetRepliedMessageRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a non-bundled message that is replied by a given message. Also, returns the pinned message, the game message, the invoice message, the message with a previously set same background, the giveaway message, and the topic creation message for messages of the types messagePinMessage, messageGameScore, messagePaymentSuccessful, messageChatSetBackground, messageGiveawayCompleted and topic messages without non-bundled replied message respectively. Returns a 404 error if the message doesn't exist
+func (client *Client) GetRepliedMessage(ctx context.Context, req *GetRepliedMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type GetChatPinnedMessageRequest struct {
+	request
+	// Identifier of the chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatPinnedMessageRequest) GetFunctionName() string {
+	return "getChatPinnedMessage"
+}
+
+func (req *GetChatPinnedMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatPinnedMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatPinnedMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatPinnedMessageRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a newest pinned message in the chat. Returns a 404 error if the message doesn't exist
+func (client *Client) GetChatPinnedMessage(ctx context.Context, req *GetChatPinnedMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type GetCallbackQueryMessageRequest struct {
+	request
+	// Identifier of the chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Identifier of the callback query
+	CallbackQueryId JsonInt64 `json:"callback_query_id"`
+}
+
+func (req GetCallbackQueryMessageRequest) GetFunctionName() string {
+	return "getCallbackQueryMessage"
+}
+
+func (req *GetCallbackQueryMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCallbackQueryMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCallbackQueryMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"callback_query_id":`)
+	encoder.writeJsonInt64(req.CallbackQueryId)
+	encoder.writeRaw("}")
+}
+
+func (req GetCallbackQueryMessageRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a message with the callback button that originated a callback query; for bots only
+//
+// Available to bots only
+func (client *Client) GetCallbackQueryMessage(ctx context.Context, req *GetCallbackQueryMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type GetMessagesRequest struct {
+	request
+	// Identifier of the chat the messages belong to
+	ChatId int64 `json:"chat_id"`
+	// Identifiers of the messages to get
+	MessageIds []int64 `json:"message_ids"`
+}
+
+func (req GetMessagesRequest) GetFunctionName() string {
+	return "getMessages"
+}
+
+func (req *GetMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessagesRequest) Validate() error {
+	return nil
+}
+
+// Returns information about messages. If a message is not found, returns null on the corresponding position of the result
+func (client *Client) GetMessages(ctx context.Context, req *GetMessagesRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type GetMessagePropertiesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetMessagePropertiesRequest) GetFunctionName() string {
+	return "getMessageProperties"
+}
+
+func (req *GetMessagePropertiesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessagePropertiesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageProperties"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessagePropertiesRequest) Validate() error {
+	return nil
+}
+
+// Returns properties of a message. This is an offline method
+func (client *Client) GetMessageProperties(ctx context.Context, req *GetMessagePropertiesRequest) (*MessageProperties, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageProperties)
+}
+
+type GetMessageThreadRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetMessageThreadRequest) GetFunctionName() string {
+	return "getMessageThread"
+}
+
+func (req *GetMessageThreadRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageThreadRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageThread"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageThreadRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a message thread. Can be used only if messageProperties.can_get_message_thread == true
+//
+// Available to users only
+func (client *Client) GetMessageThread(ctx context.Context, req *GetMessageThreadRequest) (*MessageThreadInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageThreadInfo)
+}
+
+type GetMessageReadDateRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetMessageReadDateRequest) GetFunctionName() string {
+	return "getMessageReadDate"
+}
+
+func (req *GetMessageReadDateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageReadDateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageReadDate"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageReadDateRequest) Validate() error {
+	return nil
+}
+
+// Returns read date of a recent outgoing message in a private chat. The method can be called if messageProperties.can_get_read_date == true
+//
+// Available to users only
+func (client *Client) GetMessageReadDate(ctx context.Context, req *GetMessageReadDateRequest) (MessageReadDate, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageReadDate)
+}
+
+type GetMessageViewersRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetMessageViewersRequest) GetFunctionName() string {
+	return "getMessageViewers"
+}
+
+func (req *GetMessageViewersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageViewersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageViewers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageViewersRequest) Validate() error {
+	return nil
+}
+
+// Returns viewers of a recent outgoing message in a basic group or a supergroup chat. For video notes and voice notes only users, opened content of the message, are returned. The method can be called if messageProperties.can_get_viewers == true
+//
+// Available to users only
+func (client *Client) GetMessageViewers(ctx context.Context, req *GetMessageViewersRequest) (*MessageViewers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageViewers)
+}
+
+type LoadSavedMessagesTopicsRequest struct {
+	request
+	// The maximum number of topics to be loaded. For optimal performance, the number of loaded topics is chosen by TDLib and can be smaller than the specified limit, even if the end of the list is not reached
+	Limit int32 `json:"limit"`
+}
+
+func (req LoadSavedMessagesTopicsRequest) GetFunctionName() string {
+	return "loadSavedMessagesTopics"
+}
+
+func (req *LoadSavedMessagesTopicsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LoadSavedMessagesTopicsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loadSavedMessagesTopics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req LoadSavedMessagesTopicsRequest) Validate() error {
+	return nil
+}
+
+// Loads more Saved Messages topics. The loaded topics will be sent through updateSavedMessagesTopic. Topics are sorted by their topic.order in descending order. Returns a 404 error if all topics have been loaded
+//
+// Available to users only
+func (client *Client) LoadSavedMessagesTopics(ctx context.Context, req *LoadSavedMessagesTopicsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetSavedMessagesTopicHistoryRequest struct {
+	request
+	// Identifier of Saved Messages topic which messages will be fetched
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// Identifier of the message starting from which messages must be fetched; use 0 to get results from the last message
+	FromMessageId int64 `json:"from_message_id"`
+	// Specify 0 to get results from exactly the message from_message_id or a negative offset up to 99 to get additionally some newer messages
+	Offset int32 `json:"offset"`
+	// The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than or equal to -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetSavedMessagesTopicHistoryRequest) GetFunctionName() string {
+	return "getSavedMessagesTopicHistory"
+}
+
+func (req *GetSavedMessagesTopicHistoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSavedMessagesTopicHistoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSavedMessagesTopicHistory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw(`,"from_message_id":`)
+	encoder.writeInt64(req.FromMessageId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetSavedMessagesTopicHistoryRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns messages in a Saved Messages topic. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id)
+//
+// Available to users only
+func (client *Client) GetSavedMessagesTopicHistory(ctx context.Context, req *GetSavedMessagesTopicHistoryRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type GetSavedMessagesTopicMessageByDateRequest struct {
+	request
+	// Identifier of Saved Messages topic which message will be returned
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// Point in time (Unix timestamp) relative to which to search for messages
+	Date int32 `json:"date"`
+}
+
+func (req GetSavedMessagesTopicMessageByDateRequest) GetFunctionName() string {
+	return "getSavedMessagesTopicMessageByDate"
+}
+
+func (req *GetSavedMessagesTopicMessageByDateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSavedMessagesTopicMessageByDateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSavedMessagesTopicMessageByDate"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(req.Date)
+	encoder.writeRaw("}")
+}
+
+func (req GetSavedMessagesTopicMessageByDateRequest) Validate() error {
+	return nil
+}
+
+// Returns the last message sent in a Saved Messages topic no later than the specified date
+//
+// Available to users only
+func (client *Client) GetSavedMessagesTopicMessageByDate(ctx context.Context, req *GetSavedMessagesTopicMessageByDateRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type DeleteSavedMessagesTopicHistoryRequest struct {
+	request
+	// Identifier of Saved Messages topic which messages will be deleted
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+}
+
+func (req DeleteSavedMessagesTopicHistoryRequest) GetFunctionName() string {
+	return "deleteSavedMessagesTopicHistory"
+}
+
+func (req *DeleteSavedMessagesTopicHistoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteSavedMessagesTopicHistoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteSavedMessagesTopicHistory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteSavedMessagesTopicHistoryRequest) Validate() error {
+	return nil
+}
+
+// Deletes all messages in a Saved Messages topic
+//
+// Available to users only
+func (client *Client) DeleteSavedMessagesTopicHistory(ctx context.Context, req *DeleteSavedMessagesTopicHistoryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteSavedMessagesTopicMessagesByDateRequest struct {
+	request
+	// Identifier of Saved Messages topic which messages will be deleted
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// The minimum date of the messages to delete
+	MinDate int32 `json:"min_date"`
+	// The maximum date of the messages to delete
+	MaxDate int32 `json:"max_date"`
+}
+
+func (req DeleteSavedMessagesTopicMessagesByDateRequest) GetFunctionName() string {
+	return "deleteSavedMessagesTopicMessagesByDate"
+}
+
+func (req *DeleteSavedMessagesTopicMessagesByDateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteSavedMessagesTopicMessagesByDateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteSavedMessagesTopicMessagesByDate"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw(`,"min_date":`)
+	encoder.writeInt32(req.MinDate)
+	encoder.writeRaw(`,"max_date":`)
+	encoder.writeInt32(req.MaxDate)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteSavedMessagesTopicMessagesByDateRequest) Validate() error {
+	return nil
+}
+
+// Deletes all messages between the specified dates in a Saved Messages topic. Messages sent in the last 30 seconds will not be deleted
+//
+// Available to users only
+func (client *Client) DeleteSavedMessagesTopicMessagesByDate(ctx context.Context, req *DeleteSavedMessagesTopicMessagesByDateRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSavedMessagesTopicIsPinnedRequest struct {
+	request
+	// Identifier of Saved Messages topic to pin or unpin
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// Pass true to pin the topic; pass false to unpin it
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (req ToggleSavedMessagesTopicIsPinnedRequest) GetFunctionName() string {
+	return "toggleSavedMessagesTopicIsPinned"
+}
+
+func (req *ToggleSavedMessagesTopicIsPinnedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSavedMessagesTopicIsPinnedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSavedMessagesTopicIsPinned"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(req.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSavedMessagesTopicIsPinnedRequest) Validate() error {
+	return nil
+}
+
+// Changes the pinned state of a Saved Messages topic. There can be up to getOption("pinned_saved_messages_topic_count_max") pinned topics. The limit can be increased with Telegram Premium
+//
+// Available to users only
+func (client *Client) ToggleSavedMessagesTopicIsPinned(ctx context.Context, req *ToggleSavedMessagesTopicIsPinnedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetPinnedSavedMessagesTopicsRequest struct {
+	request
+	// Identifiers of the new pinned Saved Messages topics
+	SavedMessagesTopicIds []int64 `json:"saved_messages_topic_ids"`
+}
+
+func (req SetPinnedSavedMessagesTopicsRequest) GetFunctionName() string {
+	return "setPinnedSavedMessagesTopics"
+}
+
+func (req *SetPinnedSavedMessagesTopicsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPinnedSavedMessagesTopicsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPinnedSavedMessagesTopics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_ids":`)
+	writeList(encoder, req.SavedMessagesTopicIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req SetPinnedSavedMessagesTopicsRequest) Validate() error {
+	return nil
+}
+
+// Changes the order of pinned Saved Messages topics
+//
+// Available to users only
+func (client *Client) SetPinnedSavedMessagesTopics(ctx context.Context, req *SetPinnedSavedMessagesTopicsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetMessageThreadHistoryRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier, which thread history needs to be returned
+	MessageId int64 `json:"message_id"`
+	// Identifier of the message starting from which history must be fetched; use 0 to get results from the last message
+	FromMessageId int64 `json:"from_message_id"`
+	// Specify 0 to get results from exactly the message from_message_id or a negative offset up to 99 to get additionally some newer messages
+	Offset int32 `json:"offset"`
+	// The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than or equal to -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetMessageThreadHistoryRequest) GetFunctionName() string {
+	return "getMessageThreadHistory"
+}
+
+func (req *GetMessageThreadHistoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageThreadHistoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageThreadHistory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"from_message_id":`)
+	encoder.writeInt64(req.FromMessageId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageThreadHistoryRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns messages in a message thread of a message. Can be used only if messageProperties.can_get_message_thread == true. Message thread of a channel message is in the channel's linked supergroup. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available to users only
+func (client *Client) GetMessageThreadHistory(ctx context.Context, req *GetMessageThreadHistoryRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type SearchChatMessagesRequest struct {
+	request
+	// Identifier of the chat in which to search messages
+	ChatId int64 `json:"chat_id"`
+	// Query to search for
+	Query string `json:"query"`
+	// Identifier of the sender of messages to search for; pass null to search for messages from any sender. Not supported in secret chats
+	SenderId MessageSender `json:"sender_id,omitempty"`
+	// Identifier of the message starting from which history must be fetched; use 0 to get results from the last message
+	FromMessageId int64 `json:"from_message_id"`
+	// Specify 0 to get results from exactly the message from_message_id or a negative offset to get the specified message and some newer messages
+	Offset int32 `json:"offset"`
+	// The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+	// Additional filter for messages to search; pass null to search for all messages
+	Filter SearchMessagesFilter `json:"filter,omitempty"`
+	// If not 0, only messages in the specified thread will be returned; supergroups only
+	MessageThreadId int64 `json:"message_thread_id"`
+	// If not 0, only messages in the specified Saved Messages topic will be returned; pass 0 to return all messages, or for chats other than Saved Messages
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+}
+
+func (req SearchChatMessagesRequest) GetFunctionName() string {
+	return "searchChatMessages"
+}
+
+func (req *SearchChatMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchChatMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchChatMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	if req.SenderId != nil {
+		encoder.writeRaw(`,"sender_id":`)
+		encoder.writeType(req.SenderId)
+	}
+	encoder.writeRaw(`,"from_message_id":`)
+	encoder.writeInt64(req.FromMessageId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	if req.Filter != nil {
+		encoder.writeRaw(`,"filter":`)
+		encoder.writeType(req.Filter)
+	}
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw("}")
+}
+
+func (req SearchChatMessagesRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for messages with given words in the chat. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. Cannot be used in secret chats with a non-empty query (searchSecretMessages must be used instead), or without an enabled message database. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit. A combination of query, sender_id, filter and message_thread_id search criteria is expected to be supported, only if it is required for Telegram official application implementation
+//
+// Available to users only
+func (client *Client) SearchChatMessages(ctx context.Context, req *SearchChatMessagesRequest) (*FoundChatMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundChatMessages)
+}
+
+type SearchMessagesRequest struct {
+	request
+	// Chat list in which to search messages; pass null to search in all chats regardless of their chat list. Only Main and Archive chat lists are supported
+	ChatList ChatList `json:"chat_list,omitempty"`
+	// Query to search for
+	Query string `json:"query"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of messages to be returned; up to 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+	// Additional filter for messages to search; pass null to search for all messages. Filters searchMessagesFilterMention, searchMessagesFilterUnreadMention, searchMessagesFilterUnreadReaction, searchMessagesFilterFailedToSend, and searchMessagesFilterPinned are unsupported in this function
+	Filter SearchMessagesFilter `json:"filter,omitempty"`
+	// Additional filter for type of the chat of the searched messages; pass null to search for messages in all chats
+	ChatTypeFilter SearchMessagesChatTypeFilter `json:"chat_type_filter,omitempty"`
+	// If not 0, the minimum date of the messages to return
+	MinDate int32 `json:"min_date"`
+	// If not 0, the maximum date of the messages to return
+	MaxDate int32 `json:"max_date"`
+}
+
+func (req SearchMessagesRequest) GetFunctionName() string {
+	return "searchMessages"
+}
+
+func (req *SearchMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.ChatList != nil {
+		encoder.writeRaw(`,"chat_list":`)
+		encoder.writeType(req.ChatList)
+	}
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	if req.Filter != nil {
+		encoder.writeRaw(`,"filter":`)
+		encoder.writeType(req.Filter)
+	}
+	if req.ChatTypeFilter != nil {
+		encoder.writeRaw(`,"chat_type_filter":`)
+		encoder.writeType(req.ChatTypeFilter)
+	}
+	encoder.writeRaw(`,"min_date":`)
+	encoder.writeInt32(req.MinDate)
+	encoder.writeRaw(`,"max_date":`)
+	encoder.writeInt32(req.MaxDate)
+	encoder.writeRaw("}")
+}
+
+func (req SearchMessagesRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for messages in all chats except secret chats. Returns the results in reverse chronological order (i.e., in order of decreasing (date, chat_id, message_id)). For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+//
+// Available to users only
+func (client *Client) SearchMessages(ctx context.Context, req *SearchMessagesRequest) (*FoundMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundMessages)
+}
+
+type SearchSecretMessagesRequest struct {
+	request
+	// Identifier of the chat in which to search. Specify 0 to search in all secret chats
+	ChatId int64 `json:"chat_id"`
+	// Query to search for. If empty, searchChatMessages must be used instead
+	Query string `json:"query"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of messages to be returned; up to 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+	// Additional filter for messages to search; pass null to search for all messages
+	Filter SearchMessagesFilter `json:"filter,omitempty"`
+}
+
+func (req SearchSecretMessagesRequest) GetFunctionName() string {
+	return "searchSecretMessages"
+}
+
+func (req *SearchSecretMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchSecretMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchSecretMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	if req.Filter != nil {
+		encoder.writeRaw(`,"filter":`)
+		encoder.writeType(req.Filter)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SearchSecretMessagesRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for messages in secret chats. Returns the results in reverse chronological order. For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available to users only
+func (client *Client) SearchSecretMessages(ctx context.Context, req *SearchSecretMessagesRequest) (*FoundMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundMessages)
+}
+
+type SearchSavedMessagesRequest struct {
+	request
+	// If not 0, only messages in the specified Saved Messages topic will be considered; pass 0 to consider all messages
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// Tag to search for; pass null to return all suitable messages
+	Tag ReactionType `json:"tag,omitempty"`
+	// Query to search for
+	Query string `json:"query"`
+	// Identifier of the message starting from which messages must be fetched; use 0 to get results from the last message
+	FromMessageId int64 `json:"from_message_id"`
+	// Specify 0 to get results from exactly the message from_message_id or a negative offset to get the specified message and some newer messages
+	Offset int32 `json:"offset"`
+	// The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchSavedMessagesRequest) GetFunctionName() string {
+	return "searchSavedMessages"
+}
+
+func (req *SearchSavedMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchSavedMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchSavedMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	if req.Tag != nil {
+		encoder.writeRaw(`,"tag":`)
+		encoder.writeType(req.Tag)
+	}
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"from_message_id":`)
+	encoder.writeInt64(req.FromMessageId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchSavedMessagesRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for messages tagged by the given reaction and with the given words in the Saved Messages chat; for Telegram Premium users only. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+//
+// Available to users only
+func (client *Client) SearchSavedMessages(ctx context.Context, req *SearchSavedMessagesRequest) (*FoundChatMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundChatMessages)
+}
+
+type SearchOutgoingDocumentMessagesRequest struct {
+	request
+	// Query to search for in document file name and message caption
+	Query string `json:"query"`
+	// The maximum number of messages to be returned; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchOutgoingDocumentMessagesRequest) GetFunctionName() string {
+	return "searchOutgoingDocumentMessages"
+}
+
+func (req *SearchOutgoingDocumentMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchOutgoingDocumentMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchOutgoingDocumentMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchOutgoingDocumentMessagesRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for outgoing messages with content of the type messageDocument in all chats except secret chats. Returns the results in reverse chronological order
+//
+// Available to users only
+func (client *Client) SearchOutgoingDocumentMessages(ctx context.Context, req *SearchOutgoingDocumentMessagesRequest) (*FoundMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundMessages)
+}
+
+type SearchPublicMessagesByTagRequest struct {
+	request
+	// Hashtag or cashtag to search for
+	Tag string `json:"tag"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of messages to be returned; up to 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchPublicMessagesByTagRequest) GetFunctionName() string {
+	return "searchPublicMessagesByTag"
+}
+
+func (req *SearchPublicMessagesByTagRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchPublicMessagesByTagRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchPublicMessagesByTag"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"tag":`)
+	encoder.writeString(req.Tag)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchPublicMessagesByTagRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for public channel posts containing the given hashtag or cashtag. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+//
+// Available to users only
+func (client *Client) SearchPublicMessagesByTag(ctx context.Context, req *SearchPublicMessagesByTagRequest) (*FoundMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundMessages)
+}
+
+type SearchChatRecentLocationMessagesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The maximum number of messages to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchChatRecentLocationMessagesRequest) GetFunctionName() string {
+	return "searchChatRecentLocationMessages"
+}
+
+func (req *SearchChatRecentLocationMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchChatRecentLocationMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchChatRecentLocationMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchChatRecentLocationMessagesRequest) Validate() error {
+	return nil
+}
+
+// Returns information about the recent locations of chat members that were sent to the chat. Returns up to 1 location message per user
+//
+// Available to users only
+func (client *Client) SearchChatRecentLocationMessages(ctx context.Context, req *SearchChatRecentLocationMessagesRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type GetChatMessageByDateRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Point in time (Unix timestamp) relative to which to search for messages
+	Date int32 `json:"date"`
+}
+
+func (req GetChatMessageByDateRequest) GetFunctionName() string {
+	return "getChatMessageByDate"
+}
+
+func (req *GetChatMessageByDateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatMessageByDateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatMessageByDate"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(req.Date)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatMessageByDateRequest) Validate() error {
+	return nil
+}
+
+// Returns the last message sent in a chat no later than the specified date. Returns a 404 error if such message doesn't exist
+//
+// Available to users only
+func (client *Client) GetChatMessageByDate(ctx context.Context, req *GetChatMessageByDateRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type GetChatSparseMessagePositionsRequest struct {
+	request
+	// Identifier of the chat in which to return information about message positions
+	ChatId int64 `json:"chat_id"`
+	// Filter for message content. Filters searchMessagesFilterEmpty, searchMessagesFilterMention, searchMessagesFilterUnreadMention, and searchMessagesFilterUnreadReaction are unsupported in this function
+	Filter SearchMessagesFilter `json:"filter"`
+	// The message identifier from which to return information about message positions
+	FromMessageId int64 `json:"from_message_id"`
+	// The expected number of message positions to be returned; 50-2000. A smaller number of positions can be returned, if there are not enough appropriate messages
+	Limit int32 `json:"limit"`
+	// If not 0, only messages in the specified Saved Messages topic will be considered; pass 0 to consider all messages, or for chats other than Saved Messages
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+}
+
+func (req GetChatSparseMessagePositionsRequest) GetFunctionName() string {
+	return "getChatSparseMessagePositions"
+}
+
+func (req *GetChatSparseMessagePositionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatSparseMessagePositionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatSparseMessagePositions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"filter":`)
+	encoder.writeType(req.Filter)
+	encoder.writeRaw(`,"from_message_id":`)
+	encoder.writeInt64(req.FromMessageId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatSparseMessagePositionsRequest) Validate() error {
+	return nil
+}
+
+// Returns sparse positions of messages of the specified type in the chat to be used for shared media scroll implementation. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). Cannot be used in secret chats or with searchMessagesFilterFailedToSend filter without an enabled message database
+//
+// Available to users only
+func (client *Client) GetChatSparseMessagePositions(ctx context.Context, req *GetChatSparseMessagePositionsRequest) (*MessagePositions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessagePositions)
+}
+
+type GetChatMessageCalendarRequest struct {
+	request
+	// Identifier of the chat in which to return information about messages
+	ChatId int64 `json:"chat_id"`
+	// Filter for message content. Filters searchMessagesFilterEmpty, searchMessagesFilterMention, searchMessagesFilterUnreadMention, and searchMessagesFilterUnreadReaction are unsupported in this function
+	Filter SearchMessagesFilter `json:"filter"`
+	// The message identifier from which to return information about messages; use 0 to get results from the last message
+	FromMessageId int64 `json:"from_message_id"`
+	// If not0, only messages in the specified Saved Messages topic will be considered; pass 0 to consider all messages, or for chats other than Saved Messages
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+}
+
+func (req GetChatMessageCalendarRequest) GetFunctionName() string {
+	return "getChatMessageCalendar"
+}
+
+func (req *GetChatMessageCalendarRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatMessageCalendarRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatMessageCalendar"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"filter":`)
+	encoder.writeType(req.Filter)
+	encoder.writeRaw(`,"from_message_id":`)
+	encoder.writeInt64(req.FromMessageId)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatMessageCalendarRequest) Validate() error {
+	return nil
+}
+
+// Returns information about the next messages of the specified type in the chat split by days. Returns the results in reverse chronological order. Can return partial result for the last returned day. Behavior of this method depends on the value of the option "utc_time_offset"
+//
+// Available to users only
+func (client *Client) GetChatMessageCalendar(ctx context.Context, req *GetChatMessageCalendarRequest) (*MessageCalendar, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageCalendar)
+}
+
+type GetChatMessageCountRequest struct {
+	request
+	// Identifier of the chat in which to count messages
+	ChatId int64 `json:"chat_id"`
+	// Filter for message content; searchMessagesFilterEmpty is unsupported in this function
+	Filter SearchMessagesFilter `json:"filter"`
+	// If not 0, only messages in the specified Saved Messages topic will be counted; pass 0 to count all messages, or for chats other than Saved Messages
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// Pass true to get the number of messages without sending network requests, or -1 if the number of messages is unknown locally
+	ReturnLocal bool `json:"return_local"`
+}
+
+func (req GetChatMessageCountRequest) GetFunctionName() string {
+	return "getChatMessageCount"
+}
+
+func (req *GetChatMessageCountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatMessageCountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatMessageCount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"filter":`)
+	encoder.writeType(req.Filter)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw(`,"return_local":`)
+	encoder.writeBool(req.ReturnLocal)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatMessageCountRequest) Validate() error {
+	return nil
+}
+
+// Returns approximate number of messages of the specified type in the chat
+//
+// Available to users only
+func (client *Client) GetChatMessageCount(ctx context.Context, req *GetChatMessageCountRequest) (*Count, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCount)
+}
+
+type GetChatMessagePositionRequest struct {
+	request
+	// Identifier of the chat in which to find message position
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Filter for message content; searchMessagesFilterEmpty, searchMessagesFilterUnreadMention, searchMessagesFilterUnreadReaction, and searchMessagesFilterFailedToSend are unsupported in this function
+	Filter SearchMessagesFilter `json:"filter"`
+	// If not 0, only messages in the specified thread will be considered; supergroups only
+	MessageThreadId int64 `json:"message_thread_id"`
+	// If not 0, only messages in the specified Saved Messages topic will be considered; pass 0 to consider all relevant messages, or for chats other than Saved Messages
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+}
+
+func (req GetChatMessagePositionRequest) GetFunctionName() string {
+	return "getChatMessagePosition"
+}
+
+func (req *GetChatMessagePositionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatMessagePositionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatMessagePosition"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"filter":`)
+	encoder.writeType(req.Filter)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatMessagePositionRequest) Validate() error {
+	return nil
+}
+
+// Returns approximate 1-based position of a message among messages, which can be found by the specified filter in the chat. Cannot be used in secret chats
+//
+// Available to users only
+func (client *Client) GetChatMessagePosition(ctx context.Context, req *GetChatMessagePositionRequest) (*Count, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCount)
+}
+
+type GetChatScheduledMessagesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatScheduledMessagesRequest) GetFunctionName() string {
+	return "getChatScheduledMessages"
+}
+
+func (req *GetChatScheduledMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatScheduledMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatScheduledMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatScheduledMessagesRequest) Validate() error {
+	return nil
+}
+
+// Returns all scheduled messages in a chat. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id)
+//
+// Available to users only
+func (client *Client) GetChatScheduledMessages(ctx context.Context, req *GetChatScheduledMessagesRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type GetChatSponsoredMessagesRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatSponsoredMessagesRequest) GetFunctionName() string {
+	return "getChatSponsoredMessages"
+}
+
+func (req *GetChatSponsoredMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatSponsoredMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatSponsoredMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatSponsoredMessagesRequest) Validate() error {
+	return nil
+}
+
+// Returns sponsored messages to be shown in a chat; for channel chats and chats with bots only
+//
+// Available to users only
+func (client *Client) GetChatSponsoredMessages(ctx context.Context, req *GetChatSponsoredMessagesRequest) (*SponsoredMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSponsoredMessages)
+}
+
+type ClickChatSponsoredMessageRequest struct {
+	request
+	// Chat identifier of the sponsored message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the sponsored message
+	MessageId int64 `json:"message_id"`
+	// Pass true if the media was clicked in the sponsored message
+	IsMediaClick bool `json:"is_media_click"`
+	// Pass true if the user expanded the video from the sponsored message fullscreen before the click
+	FromFullscreen bool `json:"from_fullscreen"`
+}
+
+func (req ClickChatSponsoredMessageRequest) GetFunctionName() string {
+	return "clickChatSponsoredMessage"
+}
+
+func (req *ClickChatSponsoredMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClickChatSponsoredMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clickChatSponsoredMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"is_media_click":`)
+	encoder.writeBool(req.IsMediaClick)
+	encoder.writeRaw(`,"from_fullscreen":`)
+	encoder.writeBool(req.FromFullscreen)
+	encoder.writeRaw("}")
+}
+
+func (req ClickChatSponsoredMessageRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the user opened the sponsored chat via the button, the name, the chat photo, a mention in the sponsored message text, or the media in the sponsored message
+//
+// Available to users only
+func (client *Client) ClickChatSponsoredMessage(ctx context.Context, req *ClickChatSponsoredMessageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportChatSponsoredMessageRequest struct {
+	request
+	// Chat identifier of the sponsored message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the sponsored message
+	MessageId int64 `json:"message_id"`
+	// Option identifier chosen by the user; leave empty for the initial request
+	OptionId []byte `json:"option_id"`
+}
+
+func (req ReportChatSponsoredMessageRequest) GetFunctionName() string {
+	return "reportChatSponsoredMessage"
+}
+
+func (req *ReportChatSponsoredMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportChatSponsoredMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportChatSponsoredMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"option_id":`)
+	encoder.writeBytes(req.OptionId)
+	encoder.writeRaw("}")
+}
+
+func (req ReportChatSponsoredMessageRequest) Validate() error {
+	return nil
+}
+
+// Reports a sponsored message to Telegram moderators
+//
+// Available to users only
+func (client *Client) ReportChatSponsoredMessage(ctx context.Context, req *ReportChatSponsoredMessageRequest) (ReportSponsoredResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalReportSponsoredResult)
+}
+
+type GetMessageLinkRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// If not 0, timestamp from which the video/audio/video note/voice note/story playing must start, in seconds. The media can be in the message content or in its link preview
+	MediaTimestamp int32 `json:"media_timestamp"`
+	// Pass true to create a link for the whole media album
+	ForAlbum bool `json:"for_album"`
+	// Pass true to create a link to the message as a channel post comment, in a message thread, or a forum topic
+	InMessageThread bool `json:"in_message_thread"`
+}
+
+func (req GetMessageLinkRequest) GetFunctionName() string {
+	return "getMessageLink"
+}
+
+func (req *GetMessageLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"media_timestamp":`)
+	encoder.writeInt32(req.MediaTimestamp)
+	encoder.writeRaw(`,"for_album":`)
+	encoder.writeBool(req.ForAlbum)
+	encoder.writeRaw(`,"in_message_thread":`)
+	encoder.writeBool(req.InMessageThread)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS link to a message in a chat. Available only if messageProperties.can_get_link, or if messageProperties.can_get_media_timestamp_links and a media timestamp link is generated. This is an offline method
+func (client *Client) GetMessageLink(ctx context.Context, req *GetMessageLinkRequest) (*MessageLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageLink)
+}
+
+type GetMessageEmbeddingCodeRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Pass true to return an HTML code for embedding of the whole media album
+	ForAlbum bool `json:"for_album"`
+}
+
+func (req GetMessageEmbeddingCodeRequest) GetFunctionName() string {
+	return "getMessageEmbeddingCode"
+}
+
+func (req *GetMessageEmbeddingCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageEmbeddingCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageEmbeddingCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"for_album":`)
+	encoder.writeBool(req.ForAlbum)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageEmbeddingCodeRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTML code for embedding the message. Available only if messageProperties.can_get_embedding_code
+//
+// Available to users only
+func (client *Client) GetMessageEmbeddingCode(ctx context.Context, req *GetMessageEmbeddingCodeRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type GetMessageLinkInfoRequest struct {
+	request
+	// The message link
+	Url string `json:"url"`
+}
+
+func (req GetMessageLinkInfoRequest) GetFunctionName() string {
+	return "getMessageLinkInfo"
+}
+
+func (req *GetMessageLinkInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageLinkInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageLinkInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageLinkInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a public or private message link. Can be called for any internal link of the type internalLinkTypeMessage
+func (client *Client) GetMessageLinkInfo(ctx context.Context, req *GetMessageLinkInfoRequest) (*MessageLinkInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageLinkInfo)
+}
+
+type TranslateTextRequest struct {
+	request
+	// Text to translate
+	Text *FormattedText `json:"text"`
+	// Language code of the language to which the message is translated. Must be one of "af", "sq", "am", "ar", "hy", "az", "eu", "be", "bn", "bs", "bg", "ca", "ceb", "zh-CN", "zh", "zh-Hans", "zh-TW", "zh-Hant", "co", "hr", "cs", "da", "nl", "en", "eo", "et", "fi", "fr", "fy", "gl", "ka", "de", "el", "gu", "ht", "ha", "haw", "he", "iw", "hi", "hmn", "hu", "is", "ig", "id", "in", "ga", "it", "ja", "jv", "kn", "kk", "km", "rw", "ko", "ku", "ky", "lo", "la", "lv", "lt", "lb", "mk", "mg", "ms", "ml", "mt", "mi", "mr", "mn", "my", "ne", "no", "ny", "or", "ps", "fa", "pl", "pt", "pa", "ro", "ru", "sm", "gd", "sr", "st", "sn", "sd", "si", "sk", "sl", "so", "es", "su", "sw", "sv", "tl", "tg", "ta", "tt", "te", "th", "tr", "tk", "uk", "ur", "ug", "uz", "vi", "cy", "xh", "yi", "ji", "yo", "zu"
+	ToLanguageCode string `json:"to_language_code"`
+}
+
+func (req TranslateTextRequest) GetFunctionName() string {
+	return "translateText"
+}
+
+func (req *TranslateTextRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TranslateTextRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"translateText"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	req.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"to_language_code":`)
+	encoder.writeString(req.ToLanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req TranslateTextRequest) Validate() error {
+	return nil
+}
+
+// Translates a text to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+//
+// Available to users only
+func (client *Client) TranslateText(ctx context.Context, req *TranslateTextRequest) (*FormattedText, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFormattedText)
+}
+
+type TranslateMessageTextRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Language code of the language to which the message is translated. Must be one of "af", "sq", "am", "ar", "hy", "az", "eu", "be", "bn", "bs", "bg", "ca", "ceb", "zh-CN", "zh", "zh-Hans", "zh-TW", "zh-Hant", "co", "hr", "cs", "da", "nl", "en", "eo", "et", "fi", "fr", "fy", "gl", "ka", "de", "el", "gu", "ht", "ha", "haw", "he", "iw", "hi", "hmn", "hu", "is", "ig", "id", "in", "ga", "it", "ja", "jv", "kn", "kk", "km", "rw", "ko", "ku", "ky", "lo", "la", "lv", "lt", "lb", "mk", "mg", "ms", "ml", "mt", "mi", "mr", "mn", "my", "ne", "no", "ny", "or", "ps", "fa", "pl", "pt", "pa", "ro", "ru", "sm", "gd", "sr", "st", "sn", "sd", "si", "sk", "sl", "so", "es", "su", "sw", "sv", "tl", "tg", "ta", "tt", "te", "th", "tr", "tk", "uk", "ur", "ug", "uz", "vi", "cy", "xh", "yi", "ji", "yo", "zu"
+	ToLanguageCode string `json:"to_language_code"`
+}
+
+func (req TranslateMessageTextRequest) GetFunctionName() string {
+	return "translateMessageText"
+}
+
+func (req *TranslateMessageTextRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TranslateMessageTextRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"translateMessageText"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"to_language_code":`)
+	encoder.writeString(req.ToLanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req TranslateMessageTextRequest) Validate() error {
+	return nil
+}
+
+// Extracts text or caption of the given message and translates it to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+//
+// Available to users only
+func (client *Client) TranslateMessageText(ctx context.Context, req *TranslateMessageTextRequest) (*FormattedText, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFormattedText)
+}
+
+type GetChatAvailableMessageSendersRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatAvailableMessageSendersRequest) GetFunctionName() string {
+	return "getChatAvailableMessageSenders"
+}
+
+func (req *GetChatAvailableMessageSendersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatAvailableMessageSendersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatAvailableMessageSenders"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatAvailableMessageSendersRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of message sender identifiers, which can be used to send messages in a chat
+//
+// Available to users only
+func (client *Client) GetChatAvailableMessageSenders(ctx context.Context, req *GetChatAvailableMessageSendersRequest) (*ChatMessageSenders, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatMessageSenders)
+}
+
+type SetChatMessageSenderRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New message sender for the chat
+	MessageSenderId MessageSender `json:"message_sender_id"`
+}
+
+func (req SetChatMessageSenderRequest) GetFunctionName() string {
+	return "setChatMessageSender"
+}
+
+func (req *SetChatMessageSenderRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatMessageSenderRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatMessageSender"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_sender_id":`)
+	encoder.writeType(req.MessageSenderId)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatMessageSenderRequest) Validate() error {
+	return nil
+}
+
+// Selects a message sender to send messages in a chat
+//
+// Available to users only
+func (client *Client) SetChatMessageSender(ctx context.Context, req *SetChatMessageSenderRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SendMessageRequest struct {
+	request
+	// Target chat
+	ChatId int64 `json:"chat_id"`
+	// If not 0, the message thread identifier in which the message will be sent
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Information about the message or story to be replied; pass null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Options to be used to send the message; pass null to use default options
+	Options *MessageSendOptions `json:"options,omitempty"`
+	// Markup for replying to the message; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req SendMessageRequest) GetFunctionName() string {
+	return "sendMessage"
+}
+
+func (req *SendMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	if req.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(req.ReplyTo)
+	}
+	if req.Options != nil {
+		encoder.writeRaw(`,"options":`)
+		req.Options.encodeJSON(encoder)
+	}
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req SendMessageRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sends a message. Returns the sent message
+func (client *Client) SendMessage(ctx context.Context, req *SendMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type SendMessageAlbumRequest struct {
+	request
+	// Target chat
+	ChatId int64 `json:"chat_id"`
+	// If not 0, the message thread identifier in which the messages will be sent
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Information about the message or story to be replied; pass null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Options to be used to send the messages; pass null to use default options
+	Options *MessageSendOptions `json:"options,omitempty"`
+	// Contents of messages to be sent. At most 10 messages can be added to an album. All messages must have the same value of show_caption_above_media
+	InputMessageContents []InputMessageContent `json:"input_message_contents"`
+}
+
+func (req SendMessageAlbumRequest) GetFunctionName() string {
+	return "sendMessageAlbum"
+}
+
+func (req *SendMessageAlbumRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendMessageAlbumRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendMessageAlbum"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	if req.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(req.ReplyTo)
+	}
+	if req.Options != nil {
+		encoder.writeRaw(`,"options":`)
+		req.Options.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"input_message_contents":`)
+	writeList(encoder, req.InputMessageContents, writeTypeValue[InputMessageContent])
+	encoder.writeRaw("}")
+}
+
+func (req SendMessageAlbumRequest) Validate() error {
+	for i, item := range req.InputMessageContents {
+		if err := validateNested(indexField("input_message_contents", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sends 2-10 messages grouped together into an album. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+func (client *Client) SendMessageAlbum(ctx context.Context, req *SendMessageAlbumRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type SendBotStartMessageRequest struct {
+	request
+	// Identifier of the bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Identifier of the target chat
+	ChatId int64 `json:"chat_id"`
+	// A hidden parameter sent to the bot for deep linking purposes (https://core.telegram.org/bots#deep-linking)
+	Parameter string `json:"parameter"`
+}
+
+func (req SendBotStartMessageRequest) GetFunctionName() string {
+	return "sendBotStartMessage"
+}
+
+func (req *SendBotStartMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendBotStartMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendBotStartMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"parameter":`)
+	encoder.writeString(req.Parameter)
+	encoder.writeRaw("}")
+}
+
+func (req SendBotStartMessageRequest) Validate() error {
+	return nil
+}
+
+// Invites a bot to a chat (if it is not yet a member) and sends it the /start command; requires can_invite_users member right. Bots can't be invited to a private chat other than the chat with the bot. Bots can't be invited to channels (although they can be added as admins) and secret chats. Returns the sent message
+//
+// Available to users only
+func (client *Client) SendBotStartMessage(ctx context.Context, req *SendBotStartMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type SendInlineQueryResultMessageRequest struct {
+	request
+	// Target chat
+	ChatId int64 `json:"chat_id"`
+	// If not 0, the message thread identifier in which the message will be sent
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Information about the message or story to be replied; pass null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Options to be used to send the message; pass null to use default options
+	Options *MessageSendOptions `json:"options,omitempty"`
+	// Identifier of the inline query
+	QueryId JsonInt64 `json:"query_id"`
+	// Identifier of the inline query result
+	ResultId string `json:"result_id"`
+	// Pass true to hide the bot, via which the message is sent. Can be used only for bots getOption("animation_search_bot_username"), getOption("photo_search_bot_username"), and getOption("venue_search_bot_username")
+	HideViaBot bool `json:"hide_via_bot"`
+}
+
+func (req SendInlineQueryResultMessageRequest) GetFunctionName() string {
+	return "sendInlineQueryResultMessage"
+}
+
+func (req *SendInlineQueryResultMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendInlineQueryResultMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendInlineQueryResultMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	if req.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(req.ReplyTo)
+	}
+	if req.Options != nil {
+		encoder.writeRaw(`,"options":`)
+		req.Options.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"query_id":`)
+	encoder.writeJsonInt64(req.QueryId)
+	encoder.writeRaw(`,"result_id":`)
+	encoder.writeString(req.ResultId)
+	encoder.writeRaw(`,"hide_via_bot":`)
+	encoder.writeBool(req.HideViaBot)
+	encoder.writeRaw("}")
+}
+
+func (req SendInlineQueryResultMessageRequest) Validate() error {
+	return nil
+}
+
+// Sends the result of an inline query as a message. Returns the sent message. Always clears a chat draft message
+//
+// Available to users only
+func (client *Client) SendInlineQueryResultMessage(ctx context.Context, req *SendInlineQueryResultMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type ForwardMessagesRequest struct {
+	request
+	// Identifier of the chat to which to forward messages
+	ChatId int64 `json:"chat_id"`
+	// If not 0, the message thread identifier in which the message will be sent; for forum threads only
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Identifier of the chat from which to forward messages
+	FromChatId int64 `json:"from_chat_id"`
+	// Identifiers of the messages to forward. Message identifiers must be in a strictly increasing order. At most 100 messages can be forwarded simultaneously. A message can be forwarded only if messageProperties.can_be_forwarded
+	MessageIds []int64 `json:"message_ids"`
+	// Options to be used to send the messages; pass null to use default options
+	Options *MessageSendOptions `json:"options,omitempty"`
+	// Pass true to copy content of the messages without reference to the original sender. Always true if the messages are forwarded to a secret chat or are local. Use messageProperties.can_be_saved and messageProperties.can_be_copied_to_secret_chat to check whether the message is suitable
+	SendCopy bool `json:"send_copy"`
+	// Pass true to remove media captions of message copies. Ignored if send_copy is false
+	RemoveCaption bool `json:"remove_caption"`
+}
+
+func (req ForwardMessagesRequest) GetFunctionName() string {
+	return "forwardMessages"
+}
+
+func (req *ForwardMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ForwardMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"forwardMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw(`,"from_chat_id":`)
+	encoder.writeInt64(req.FromChatId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	if req.Options != nil {
+		encoder.writeRaw(`,"options":`)
+		req.Options.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"send_copy":`)
+	encoder.writeBool(req.SendCopy)
+	encoder.writeRaw(`,"remove_caption":`)
+	encoder.writeBool(req.RemoveCaption)
+	encoder.writeRaw("}")
+}
+
+func (req ForwardMessagesRequest) Validate() error {
+	return nil
+}
+
+// Forwards previously sent messages. Returns the forwarded messages in the same order as the message identifiers passed in message_ids. If a message can't be forwarded, null will be returned instead of the message
+func (client *Client) ForwardMessages(ctx context.Context, req *ForwardMessagesRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type SendQuickReplyShortcutMessagesRequest struct {
+	request
+	// Identifier of the chat to which to send messages. The chat must be a private chat with a regular user
+	ChatId int64 `json:"chat_id"`
+	// Unique identifier of the quick reply shortcut
+	ShortcutId int32 `json:"shortcut_id"`
+	// Non-persistent identifier, which will be returned back in messageSendingStatePending object and can be used to match sent messages and corresponding updateNewMessage updates
+	SendingId int32 `json:"sending_id"`
+}
+
+func (req SendQuickReplyShortcutMessagesRequest) GetFunctionName() string {
+	return "sendQuickReplyShortcutMessages"
+}
+
+func (req *SendQuickReplyShortcutMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendQuickReplyShortcutMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendQuickReplyShortcutMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(req.ShortcutId)
+	encoder.writeRaw(`,"sending_id":`)
+	encoder.writeInt32(req.SendingId)
+	encoder.writeRaw("}")
+}
+
+func (req SendQuickReplyShortcutMessagesRequest) Validate() error {
+	return nil
+}
+
+// Sends messages from a quick reply shortcut. Requires Telegram Business subscription. Can't be used to send paid messages
+func (client *Client) SendQuickReplyShortcutMessages(ctx context.Context, req *SendQuickReplyShortcutMessagesRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type ResendMessagesRequest struct {
+	request
+	// Identifier of the chat to send messages
+	ChatId int64 `json:"chat_id"`
+	// Identifiers of the messages to resend. Message identifiers must be in a strictly increasing order
+	MessageIds []int64 `json:"message_ids"`
+	// New manually chosen quote from the message to be replied; pass null if none. Ignored if more than one message is re-sent, or if messageSendingStateFailed.need_another_reply_quote == false
+	Quote *InputTextQuote `json:"quote,omitempty"`
+	// The number of Telegram Stars the user agreed to pay to send the messages. Ignored if messageSendingStateFailed.required_paid_message_star_count == 0
+	PaidMessageStarCount int64 `json:"paid_message_star_count"`
+}
+
+func (req ResendMessagesRequest) GetFunctionName() string {
+	return "resendMessages"
+}
+
+func (req *ResendMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ResendMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	if req.Quote != nil {
+		encoder.writeRaw(`,"quote":`)
+		req.Quote.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"paid_message_star_count":`)
+	encoder.writeInt64(req.PaidMessageStarCount)
+	encoder.writeRaw("}")
+}
+
+func (req ResendMessagesRequest) Validate() error {
+	return nil
+}
+
+// Resends messages which failed to send. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed. If a message is re-sent, the corresponding failed to send message is deleted. Returns the sent messages in the same order as the message identifiers passed in message_ids. If a message can't be re-sent, null will be returned instead of the message
+func (client *Client) ResendMessages(ctx context.Context, req *ResendMessagesRequest) (*Messages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessages)
+}
+
+type AddLocalMessageRequest struct {
+	request
+	// Target chat
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the sender of the message
+	SenderId MessageSender `json:"sender_id"`
+	// Information about the message or story to be replied; pass null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Pass true to disable notification for the message
+	DisableNotification bool `json:"disable_notification"`
+	// The content of the message to be added
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req AddLocalMessageRequest) GetFunctionName() string {
+	return "addLocalMessage"
+}
+
+func (req *AddLocalMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddLocalMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addLocalMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(req.SenderId)
+	if req.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(req.ReplyTo)
+	}
+	encoder.writeRaw(`,"disable_notification":`)
+	encoder.writeBool(req.DisableNotification)
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req AddLocalMessageRequest) Validate() error {
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Adds a local message to a chat. The message is persistent across application restarts only if the message database is used. Returns the added message
+//
+// Available to users only
+func (client *Client) AddLocalMessage(ctx context.Context, req *AddLocalMessageRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type DeleteMessagesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifiers of the messages to be deleted. Use messageProperties.can_be_deleted_only_for_self and messageProperties.can_be_deleted_for_all_users to get suitable messages
+	MessageIds []int64 `json:"message_ids"`
+	// Pass true to delete messages for all chat members. Always true for supergroups, channels and secret chats
+	Revoke bool `json:"revoke"`
+}
+
+func (req DeleteMessagesRequest) GetFunctionName() string {
+	return "deleteMessages"
+}
+
+func (req *DeleteMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"revoke":`)
+	encoder.writeBool(req.Revoke)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteMessagesRequest) Validate() error {
+	return nil
+}
+
+// Deletes messages
+func (client *Client) DeleteMessages(ctx context.Context, req *DeleteMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteChatMessagesBySenderRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the sender of messages to delete
+	SenderId MessageSender `json:"sender_id"`
+}
+
+func (req DeleteChatMessagesBySenderRequest) GetFunctionName() string {
+	return "deleteChatMessagesBySender"
+}
+
+func (req *DeleteChatMessagesBySenderRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatMessagesBySenderRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChatMessagesBySender"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(req.SenderId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatMessagesBySenderRequest) Validate() error {
+	return nil
+}
+
+// Deletes all messages sent by the specified message sender in a chat. Supported only for supergroups; requires can_delete_messages administrator right
+//
+// Available to users only
+func (client *Client) DeleteChatMessagesBySender(ctx context.Context, req *DeleteChatMessagesBySenderRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteChatMessagesByDateRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The minimum date of the messages to delete
+	MinDate int32 `json:"min_date"`
+	// The maximum date of the messages to delete
+	MaxDate int32 `json:"max_date"`
+	// Pass true to delete chat messages for all users; private chats only
+	Revoke bool `json:"revoke"`
+}
+
+func (req DeleteChatMessagesByDateRequest) GetFunctionName() string {
+	return "deleteChatMessagesByDate"
+}
+
+func (req *DeleteChatMessagesByDateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatMessagesByDateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChatMessagesByDate"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"min_date":`)
+	encoder.writeInt32(req.MinDate)
+	encoder.writeRaw(`,"max_date":`)
+	encoder.writeInt32(req.MaxDate)
+	encoder.writeRaw(`,"revoke":`)
+	encoder.writeBool(req.Revoke)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatMessagesByDateRequest) Validate() error {
+	return nil
+}
+
+// Deletes all messages between the specified dates in a chat. Supported only for private chats and basic groups. Messages sent in the last 30 seconds will not be deleted
+//
+// Available to users only
+func (client *Client) DeleteChatMessagesByDate(ctx context.Context, req *DeleteChatMessagesByDateRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditMessageTextRequest struct {
+	request
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_be_edited to check whether the message can be edited
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New text content of the message. Must be of type inputMessageText
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req EditMessageTextRequest) GetFunctionName() string {
+	return "editMessageText"
+}
+
+func (req *EditMessageTextRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditMessageTextRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editMessageText"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req EditMessageTextRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the text of a message (or a text of a game message). Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageText(ctx context.Context, req *EditMessageTextRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type EditMessageLiveLocationRequest struct {
+	request
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_be_edited to check whether the message can be edited
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New location content of the message; pass null to stop sharing the live location
+	Location *Location `json:"location,omitempty"`
+	// New time relative to the message send date, for which the location can be updated, in seconds. If 0x7FFFFFFF specified, then the location can be updated forever. Otherwise, must not exceed the current live_period by more than a day, and the live location expiration date must remain in the next 90 days. Pass 0 to keep the current live_period
+	LivePeriod int32 `json:"live_period"`
+	// The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+	Heading int32 `json:"heading"`
+	// The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled
+	ProximityAlertRadius int32 `json:"proximity_alert_radius"`
+}
+
+func (req EditMessageLiveLocationRequest) GetFunctionName() string {
+	return "editMessageLiveLocation"
+}
+
+func (req *EditMessageLiveLocationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditMessageLiveLocationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editMessageLiveLocation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	if req.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		req.Location.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"live_period":`)
+	encoder.writeInt32(req.LivePeriod)
+	encoder.writeRaw(`,"heading":`)
+	encoder.writeInt32(req.Heading)
+	encoder.writeRaw(`,"proximity_alert_radius":`)
+	encoder.writeInt32(req.ProximityAlertRadius)
+	encoder.writeRaw("}")
+}
+
+func (req EditMessageLiveLocationRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the message content of a live location. Messages can be edited for a limited period of time specified in the live location. Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageLiveLocation(ctx context.Context, req *EditMessageLiveLocationRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type EditMessageMediaRequest struct {
+	request
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_edit_media to check whether the message can be edited
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New content of the message. Must be one of the following types: inputMessageAnimation, inputMessageAudio, inputMessageDocument, inputMessagePhoto or inputMessageVideo
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req EditMessageMediaRequest) GetFunctionName() string {
+	return "editMessageMedia"
+}
+
+func (req *EditMessageMediaRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditMessageMediaRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editMessageMedia"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req EditMessageMediaRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the media content of a message, including message caption. If only the caption needs to be edited, use editMessageCaption instead. The type of message content in an album can't be changed with exception of replacing a photo with a video or vice versa. Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageMedia(ctx context.Context, req *EditMessageMediaRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type EditMessageCaptionRequest struct {
+	request
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_be_edited to check whether the message can be edited
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New message content caption; 0-getOption("message_caption_length_max") characters; pass null to remove caption
+	Caption *FormattedText `json:"caption,omitempty"`
+	// Pass true to show the caption above the media; otherwise, the caption will be shown below the media. May be true only for animation, photo, and video messages
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+}
+
+func (req EditMessageCaptionRequest) GetFunctionName() string {
+	return "editMessageCaption"
+}
+
+func (req *EditMessageCaptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditMessageCaptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editMessageCaption"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	if req.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		req.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(req.ShowCaptionAboveMedia)
+	encoder.writeRaw("}")
+}
+
+func (req EditMessageCaptionRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the message content caption. Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageCaption(ctx context.Context, req *EditMessageCaptionRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type EditMessageReplyMarkupRequest struct {
+	request
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_be_edited to check whether the message can be edited
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (req EditMessageReplyMarkupRequest) GetFunctionName() string {
+	return "editMessageReplyMarkup"
+}
+
+func (req *EditMessageReplyMarkupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditMessageReplyMarkupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editMessageReplyMarkup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req EditMessageReplyMarkupRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the message reply markup; for bots only. Returns the edited message after the edit is completed on the server side
+//
+// Available to bots only
+func (client *Client) EditMessageReplyMarkup(ctx context.Context, req *EditMessageReplyMarkupRequest) (*Message, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessage)
+}
+
+type EditInlineMessageTextRequest struct {
+	request
+	// Inline message identifier
+	InlineMessageId string `json:"inline_message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New text content of the message. Must be of type inputMessageText
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req EditInlineMessageTextRequest) GetFunctionName() string {
+	return "editInlineMessageText"
+}
+
+func (req *EditInlineMessageTextRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditInlineMessageTextRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editInlineMessageText"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(req.InlineMessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req EditInlineMessageTextRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the text of an inline text or game message sent via a bot; for bots only
+//
+// Available to bots only
+func (client *Client) EditInlineMessageText(ctx context.Context, req *EditInlineMessageTextRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditInlineMessageLiveLocationRequest struct {
+	request
+	// Inline message identifier
+	InlineMessageId string `json:"inline_message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New location content of the message; pass null to stop sharing the live location
+	Location *Location `json:"location,omitempty"`
+	// New time relative to the message send date, for which the location can be updated, in seconds. If 0x7FFFFFFF specified, then the location can be updated forever. Otherwise, must not exceed the current live_period by more than a day, and the live location expiration date must remain in the next 90 days. Pass 0 to keep the current live_period
+	LivePeriod int32 `json:"live_period"`
+	// The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+	Heading int32 `json:"heading"`
+	// The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled
+	ProximityAlertRadius int32 `json:"proximity_alert_radius"`
+}
+
+func (req EditInlineMessageLiveLocationRequest) GetFunctionName() string {
+	return "editInlineMessageLiveLocation"
+}
+
+func (req *EditInlineMessageLiveLocationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditInlineMessageLiveLocationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editInlineMessageLiveLocation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(req.InlineMessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	if req.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		req.Location.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"live_period":`)
+	encoder.writeInt32(req.LivePeriod)
+	encoder.writeRaw(`,"heading":`)
+	encoder.writeInt32(req.Heading)
+	encoder.writeRaw(`,"proximity_alert_radius":`)
+	encoder.writeInt32(req.ProximityAlertRadius)
+	encoder.writeRaw("}")
+}
+
+func (req EditInlineMessageLiveLocationRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the content of a live location in an inline message sent via a bot; for bots only
+//
+// Available to bots only
+func (client *Client) EditInlineMessageLiveLocation(ctx context.Context, req *EditInlineMessageLiveLocationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditInlineMessageMediaRequest struct {
+	request
+	// Inline message identifier
+	InlineMessageId string `json:"inline_message_id"`
+	// The new message reply markup; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New content of the message. Must be one of the following types: inputMessageAnimation, inputMessageAudio, inputMessageDocument, inputMessagePhoto or inputMessageVideo
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req EditInlineMessageMediaRequest) GetFunctionName() string {
+	return "editInlineMessageMedia"
+}
+
+func (req *EditInlineMessageMediaRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditInlineMessageMediaRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editInlineMessageMedia"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(req.InlineMessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req EditInlineMessageMediaRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the media content of a message with a text, an animation, an audio, a document, a photo or a video in an inline message sent via a bot; for bots only
+//
+// Available to bots only
+func (client *Client) EditInlineMessageMedia(ctx context.Context, req *EditInlineMessageMediaRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditInlineMessageCaptionRequest struct {
+	request
+	// Inline message identifier
+	InlineMessageId string `json:"inline_message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// New message content caption; pass null to remove caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+	// Pass true to show the caption above the media; otherwise, the caption will be shown below the media. May be true only for animation, photo, and video messages
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+}
+
+func (req EditInlineMessageCaptionRequest) GetFunctionName() string {
+	return "editInlineMessageCaption"
+}
+
+func (req *EditInlineMessageCaptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditInlineMessageCaptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editInlineMessageCaption"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(req.InlineMessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	if req.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		req.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(req.ShowCaptionAboveMedia)
+	encoder.writeRaw("}")
+}
+
+func (req EditInlineMessageCaptionRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the caption of an inline message sent via a bot; for bots only
+//
+// Available to bots only
+func (client *Client) EditInlineMessageCaption(ctx context.Context, req *EditInlineMessageCaptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditInlineMessageReplyMarkupRequest struct {
+	request
+	// Inline message identifier
+	InlineMessageId string `json:"inline_message_id"`
+	// The new message reply markup; pass null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (req EditInlineMessageReplyMarkupRequest) GetFunctionName() string {
+	return "editInlineMessageReplyMarkup"
+}
+
+func (req *EditInlineMessageReplyMarkupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditInlineMessageReplyMarkupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editInlineMessageReplyMarkup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(req.InlineMessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req EditInlineMessageReplyMarkupRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits the reply markup of an inline message sent via a bot; for bots only
+//
+// Available to bots only
+func (client *Client) EditInlineMessageReplyMarkup(ctx context.Context, req *EditInlineMessageReplyMarkupRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditMessageSchedulingStateRequest struct {
+	request
+	// The chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_edit_scheduling_state to check whether the message is suitable
+	MessageId int64 `json:"message_id"`
+	// The new message scheduling state; pass null to send the message immediately. Must be null for messages in the state messageSchedulingStateSendWhenVideoProcessed
+	SchedulingState MessageSchedulingState `json:"scheduling_state,omitempty"`
+}
+
+func (req EditMessageSchedulingStateRequest) GetFunctionName() string {
+	return "editMessageSchedulingState"
+}
+
+func (req *EditMessageSchedulingStateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditMessageSchedulingStateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editMessageSchedulingState"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.SchedulingState != nil {
+		encoder.writeRaw(`,"scheduling_state":`)
+		encoder.writeType(req.SchedulingState)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req EditMessageSchedulingStateRequest) Validate() error {
+	return nil
+}
+
+// Edits the time when a scheduled message will be sent. Scheduling state of all messages in the same album or forwarded together with the message will be also changed
+//
+// Available to users only
+func (client *Client) EditMessageSchedulingState(ctx context.Context, req *EditMessageSchedulingStateRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetMessageFactCheckRequest struct {
+	request
+	// The channel chat the message belongs to
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// New text of the fact-check; 0-getOption("fact_check_length_max") characters; pass null to remove it. Only Bold, Italic, and TextUrl entities with https://t.me/ links are supported
+	Text *FormattedText `json:"text,omitempty"`
+}
+
+func (req SetMessageFactCheckRequest) GetFunctionName() string {
+	return "setMessageFactCheck"
+}
+
+func (req *SetMessageFactCheckRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetMessageFactCheckRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setMessageFactCheck"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.Text != nil {
+		encoder.writeRaw(`,"text":`)
+		req.Text.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetMessageFactCheckRequest) Validate() error {
+	return nil
+}
+
+// Changes the fact-check of a message. Can be only used if messageProperties.can_set_fact_check == true
+//
+// Available to users only
+func (client *Client) SetMessageFactCheck(ctx context.Context, req *SetMessageFactCheckRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CheckQuickReplyShortcutNameRequest struct {
+	request
+	// The name of the shortcut; 1-32 characters
+	Name string `json:"name"`
+}
+
+func (req CheckQuickReplyShortcutNameRequest) GetFunctionName() string {
+	return "checkQuickReplyShortcutName"
+}
+
+func (req *CheckQuickReplyShortcutNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckQuickReplyShortcutNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkQuickReplyShortcutName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req CheckQuickReplyShortcutNameRequest) Validate() error {
+	if err := validateLength("name", req.Name, 1, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Checks validness of a name for a quick reply shortcut. Can be called synchronously
+func CheckQuickReplyShortcutName(req *CheckQuickReplyShortcutNameRequest) (*Ok, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+// deprecated
+// Checks validness of a name for a quick reply shortcut. Can be called synchronously
+func (client *Client) CheckQuickReplyShortcutName(req *CheckQuickReplyShortcutNameRequest) (*Ok, error) {
+	return CheckQuickReplyShortcutName(req)
+}
+
+type LoadQuickReplyShortcutsRequest struct {
+	request
+}
+
+func (req LoadQuickReplyShortcutsRequest) GetFunctionName() string {
+	return "loadQuickReplyShortcuts"
+}
+
+func (req *LoadQuickReplyShortcutsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LoadQuickReplyShortcutsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loadQuickReplyShortcuts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req LoadQuickReplyShortcutsRequest) Validate() error {
+	return nil
+}
+
+// Loads quick reply shortcuts created by the current user. The loaded data will be sent through updateQuickReplyShortcut and updateQuickReplyShortcuts
+//
+// Available to users only
+func (client *Client) LoadQuickReplyShortcuts(ctx context.Context) (*Ok, error) {
+	req := &LoadQuickReplyShortcutsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetQuickReplyShortcutNameRequest struct {
+	request
+	// Unique identifier of the quick reply shortcut
+	ShortcutId int32 `json:"shortcut_id"`
+	// New name for the shortcut. Use checkQuickReplyShortcutName to check its validness
+	Name string `json:"name"`
+}
+
+func (req SetQuickReplyShortcutNameRequest) GetFunctionName() string {
+	return "setQuickReplyShortcutName"
+}
+
+func (req *SetQuickReplyShortcutNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetQuickReplyShortcutNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setQuickReplyShortcutName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(req.ShortcutId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req SetQuickReplyShortcutNameRequest) Validate() error {
+	return nil
+}
+
+// Changes name of a quick reply shortcut
+//
+// Available to users only
+func (client *Client) SetQuickReplyShortcutName(ctx context.Context, req *SetQuickReplyShortcutNameRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteQuickReplyShortcutRequest struct {
+	request
+	// Unique identifier of the quick reply shortcut
+	ShortcutId int32 `json:"shortcut_id"`
+}
+
+func (req DeleteQuickReplyShortcutRequest) GetFunctionName() string {
+	return "deleteQuickReplyShortcut"
+}
+
+func (req *DeleteQuickReplyShortcutRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteQuickReplyShortcutRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteQuickReplyShortcut"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(req.ShortcutId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteQuickReplyShortcutRequest) Validate() error {
+	return nil
+}
+
+// Deletes a quick reply shortcut
+//
+// Available to users only
+func (client *Client) DeleteQuickReplyShortcut(ctx context.Context, req *DeleteQuickReplyShortcutRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReorderQuickReplyShortcutsRequest struct {
+	request
+	// The new order of quick reply shortcuts
+	ShortcutIds []int32 `json:"shortcut_ids"`
+}
+
+func (req ReorderQuickReplyShortcutsRequest) GetFunctionName() string {
+	return "reorderQuickReplyShortcuts"
+}
+
+func (req *ReorderQuickReplyShortcutsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReorderQuickReplyShortcutsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reorderQuickReplyShortcuts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_ids":`)
+	writeList(encoder, req.ShortcutIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (req ReorderQuickReplyShortcutsRequest) Validate() error {
+	return nil
+}
+
+// Changes the order of quick reply shortcuts
+//
+// Available to users only
+func (client *Client) ReorderQuickReplyShortcuts(ctx context.Context, req *ReorderQuickReplyShortcutsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type LoadQuickReplyShortcutMessagesRequest struct {
+	request
+	// Unique identifier of the quick reply shortcut
+	ShortcutId int32 `json:"shortcut_id"`
+}
+
+func (req LoadQuickReplyShortcutMessagesRequest) GetFunctionName() string {
+	return "loadQuickReplyShortcutMessages"
+}
+
+func (req *LoadQuickReplyShortcutMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LoadQuickReplyShortcutMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loadQuickReplyShortcutMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(req.ShortcutId)
+	encoder.writeRaw("}")
+}
+
+func (req LoadQuickReplyShortcutMessagesRequest) Validate() error {
+	return nil
+}
+
+// Loads quick reply messages that can be sent by a given quick reply shortcut. The loaded messages will be sent through updateQuickReplyShortcutMessages
+//
+// Available to users only
+func (client *Client) LoadQuickReplyShortcutMessages(ctx context.Context, req *LoadQuickReplyShortcutMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteQuickReplyShortcutMessagesRequest struct {
+	request
+	// Unique identifier of the quick reply shortcut to which the messages belong
+	ShortcutId int32 `json:"shortcut_id"`
+	// Unique identifiers of the messages
+	MessageIds []int64 `json:"message_ids"`
+}
+
+func (req DeleteQuickReplyShortcutMessagesRequest) GetFunctionName() string {
+	return "deleteQuickReplyShortcutMessages"
+}
+
+func (req *DeleteQuickReplyShortcutMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteQuickReplyShortcutMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteQuickReplyShortcutMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(req.ShortcutId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteQuickReplyShortcutMessagesRequest) Validate() error {
+	return nil
+}
+
+// Deletes specified quick reply messages
+//
+// Available to users only
+func (client *Client) DeleteQuickReplyShortcutMessages(ctx context.Context, req *DeleteQuickReplyShortcutMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AddQuickReplyShortcutMessageRequest struct {
+	request
+	// Name of the target shortcut
+	ShortcutName string `json:"shortcut_name"`
+	// Identifier of a quick reply message in the same shortcut to be replied; pass 0 if none
+	ReplyToMessageId int64 `json:"reply_to_message_id"`
+	// The content of the message to be added; inputMessagePoll, inputMessageForwarded and inputMessageLocation with live_period aren't supported
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req AddQuickReplyShortcutMessageRequest) GetFunctionName() string {
+	return "addQuickReplyShortcutMessage"
+}
+
+func (req *AddQuickReplyShortcutMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddQuickReplyShortcutMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addQuickReplyShortcutMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_name":`)
+	encoder.writeString(req.ShortcutName)
+	encoder.writeRaw(`,"reply_to_message_id":`)
+	encoder.writeInt64(req.ReplyToMessageId)
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req AddQuickReplyShortcutMessageRequest) Validate() error {
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Adds a message to a quick reply shortcut. If shortcut doesn't exist and there are less than getOption("quick_reply_shortcut_count_max") shortcuts, then a new shortcut is created. The shortcut must not contain more than getOption("quick_reply_shortcut_message_count_max") messages after adding the new message. Returns the added message
+//
+// Available to users only
+func (client *Client) AddQuickReplyShortcutMessage(ctx context.Context, req *AddQuickReplyShortcutMessageRequest) (*QuickReplyMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalQuickReplyMessage)
+}
+
+type AddQuickReplyShortcutInlineQueryResultMessageRequest struct {
+	request
+	// Name of the target shortcut
+	ShortcutName string `json:"shortcut_name"`
+	// Identifier of a quick reply message in the same shortcut to be replied; pass 0 if none
+	ReplyToMessageId int64 `json:"reply_to_message_id"`
+	// Identifier of the inline query
+	QueryId JsonInt64 `json:"query_id"`
+	// Identifier of the inline query result
+	ResultId string `json:"result_id"`
+	// Pass true to hide the bot, via which the message is sent. Can be used only for bots getOption("animation_search_bot_username"), getOption("photo_search_bot_username"), and getOption("venue_search_bot_username")
+	HideViaBot bool `json:"hide_via_bot"`
+}
+
+func (req AddQuickReplyShortcutInlineQueryResultMessageRequest) GetFunctionName() string {
+	return "addQuickReplyShortcutInlineQueryResultMessage"
+}
+
+func (req *AddQuickReplyShortcutInlineQueryResultMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddQuickReplyShortcutInlineQueryResultMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addQuickReplyShortcutInlineQueryResultMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_name":`)
+	encoder.writeString(req.ShortcutName)
+	encoder.writeRaw(`,"reply_to_message_id":`)
+	encoder.writeInt64(req.ReplyToMessageId)
+	encoder.writeRaw(`,"query_id":`)
+	encoder.writeJsonInt64(req.QueryId)
+	encoder.writeRaw(`,"result_id":`)
+	encoder.writeString(req.ResultId)
+	encoder.writeRaw(`,"hide_via_bot":`)
+	encoder.writeBool(req.HideViaBot)
+	encoder.writeRaw("}")
+}
+
+func (req AddQuickReplyShortcutInlineQueryResultMessageRequest) Validate() error {
+	return nil
+}
+
+// Adds a message to a quick reply shortcut via inline bot. If shortcut doesn't exist and there are less than getOption("quick_reply_shortcut_count_max") shortcuts, then a new shortcut is created. The shortcut must not contain more than getOption("quick_reply_shortcut_message_count_max") messages after adding the new message. Returns the added message
+//
+// Available to users only
+func (client *Client) AddQuickReplyShortcutInlineQueryResultMessage(ctx context.Context, req *AddQuickReplyShortcutInlineQueryResultMessageRequest) (*QuickReplyMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalQuickReplyMessage)
+}
+
+type AddQuickReplyShortcutMessageAlbumRequest struct {
+	request
+	// Name of the target shortcut
+	ShortcutName string `json:"shortcut_name"`
+	// Identifier of a quick reply message in the same shortcut to be replied; pass 0 if none
+	ReplyToMessageId int64 `json:"reply_to_message_id"`
+	// Contents of messages to be sent. At most 10 messages can be added to an album. All messages must have the same value of show_caption_above_media
+	InputMessageContents []InputMessageContent `json:"input_message_contents"`
+}
+
+func (req AddQuickReplyShortcutMessageAlbumRequest) GetFunctionName() string {
+	return "addQuickReplyShortcutMessageAlbum"
+}
+
+func (req *AddQuickReplyShortcutMessageAlbumRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddQuickReplyShortcutMessageAlbumRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addQuickReplyShortcutMessageAlbum"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_name":`)
+	encoder.writeString(req.ShortcutName)
+	encoder.writeRaw(`,"reply_to_message_id":`)
+	encoder.writeInt64(req.ReplyToMessageId)
+	encoder.writeRaw(`,"input_message_contents":`)
+	writeList(encoder, req.InputMessageContents, writeTypeValue[InputMessageContent])
+	encoder.writeRaw("}")
+}
+
+func (req AddQuickReplyShortcutMessageAlbumRequest) Validate() error {
+	for i, item := range req.InputMessageContents {
+		if err := validateNested(indexField("input_message_contents", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Adds 2-10 messages grouped together into an album to a quick reply shortcut. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+//
+// Available to users only
+func (client *Client) AddQuickReplyShortcutMessageAlbum(ctx context.Context, req *AddQuickReplyShortcutMessageAlbumRequest) (*QuickReplyMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalQuickReplyMessages)
+}
+
+type ReaddQuickReplyShortcutMessagesRequest struct {
+	request
+	// Name of the target shortcut
+	ShortcutName string `json:"shortcut_name"`
+	// Identifiers of the quick reply messages to readd. Message identifiers must be in a strictly increasing order
+	MessageIds []int64 `json:"message_ids"`
+}
+
+func (req ReaddQuickReplyShortcutMessagesRequest) GetFunctionName() string {
+	return "readdQuickReplyShortcutMessages"
+}
+
+func (req *ReaddQuickReplyShortcutMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReaddQuickReplyShortcutMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readdQuickReplyShortcutMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_name":`)
+	encoder.writeString(req.ShortcutName)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req ReaddQuickReplyShortcutMessagesRequest) Validate() error {
+	return nil
+}
+
+// Readds quick reply messages which failed to add. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed. If a message is readded, the corresponding failed to send message is deleted. Returns the sent messages in the same order as the message identifiers passed in message_ids. If a message can't be readded, null will be returned instead of the message
+//
+// Available to users only
+func (client *Client) ReaddQuickReplyShortcutMessages(ctx context.Context, req *ReaddQuickReplyShortcutMessagesRequest) (*QuickReplyMessages, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalQuickReplyMessages)
+}
+
+type EditQuickReplyMessageRequest struct {
+	request
+	// Unique identifier of the quick reply shortcut with the message
+	ShortcutId int32 `json:"shortcut_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// New content of the message. Must be one of the following types: inputMessageText, inputMessageAnimation, inputMessageAudio, inputMessageDocument, inputMessagePhoto or inputMessageVideo
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (req EditQuickReplyMessageRequest) GetFunctionName() string {
+	return "editQuickReplyMessage"
+}
+
+func (req *EditQuickReplyMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditQuickReplyMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editQuickReplyMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(req.ShortcutId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(req.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (req EditQuickReplyMessageRequest) Validate() error {
+	if err := validateNested("input_message_content", req.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Asynchronously edits the text, media or caption of a quick reply message. Use quickReplyMessage.can_be_edited to check whether a message can be edited. Media message can be edited only to a media message. The type of message content in an album can't be changed with exception of replacing a photo with a video or vice versa
+//
+// Available to users only
+func (client *Client) EditQuickReplyMessage(ctx context.Context, req *EditQuickReplyMessageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetSavedMessagesTagsRequest struct {
+	request
+	// Identifier of Saved Messages topic which tags will be returned; pass 0 to get all Saved Messages tags
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+}
+
+func (req GetSavedMessagesTagsRequest) GetFunctionName() string {
+	return "getSavedMessagesTags"
+}
+
+func (req *GetSavedMessagesTagsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSavedMessagesTagsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSavedMessagesTags"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(req.SavedMessagesTopicId)
+	encoder.writeRaw("}")
+}
+
+func (req GetSavedMessagesTagsRequest) Validate() error {
+	return nil
+}
+
+// Returns tags used in Saved Messages or a Saved Messages topic
+//
+// Available to users only
+func (client *Client) GetSavedMessagesTags(ctx context.Context, req *GetSavedMessagesTagsRequest) (*SavedMessagesTags, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSavedMessagesTags)
+}
+
+type SetSavedMessagesTagLabelRequest struct {
+	request
+	// The tag which label will be changed
+	Tag ReactionType `json:"tag"`
+	// New label for the tag; 0-12 characters
+	Label string `json:"label"`
+}
+
+func (req SetSavedMessagesTagLabelRequest) GetFunctionName() string {
+	return "setSavedMessagesTagLabel"
+}
+
+func (req *SetSavedMessagesTagLabelRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetSavedMessagesTagLabelRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setSavedMessagesTagLabel"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"tag":`)
+	encoder.writeType(req.Tag)
+	encoder.writeRaw(`,"label":`)
+	encoder.writeString(req.Label)
+	encoder.writeRaw("}")
+}
+
+func (req SetSavedMessagesTagLabelRequest) Validate() error {
+	if err := validateLength("label", req.Label, 0, 12); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes label of a Saved Messages tag; for Telegram Premium users only
+//
+// Available to users only
+func (client *Client) SetSavedMessagesTagLabel(ctx context.Context, req *SetSavedMessagesTagLabelRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetMessageEffectRequest struct {
+	request
+	// Unique identifier of the effect
+	EffectId JsonInt64 `json:"effect_id"`
+}
+
+func (req GetMessageEffectRequest) GetFunctionName() string {
+	return "getMessageEffect"
+}
+
+func (req *GetMessageEffectRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageEffectRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageEffect"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"effect_id":`)
+	encoder.writeJsonInt64(req.EffectId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageEffectRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a message effect. Returns a 404 error if the effect is not found
+//
+// Available to users only
+func (client *Client) GetMessageEffect(ctx context.Context, req *GetMessageEffectRequest) (*MessageEffect, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageEffect)
+}
+
+type GetTextEntitiesRequest struct {
+	request
+	// The text in which to look for entities
+	Text string `json:"text"`
+}
+
+func (req GetTextEntitiesRequest) GetFunctionName() string {
+	return "getTextEntities"
+}
+
+func (req *GetTextEntitiesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetTextEntitiesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getTextEntities"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw("}")
+}
+
+func (req GetTextEntitiesRequest) Validate() error {
+	return nil
+}
+
+// Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) found in the text. Can be called synchronously
+func GetTextEntities(req *GetTextEntitiesRequest) (*TextEntities, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTextEntities)
+}
+
+// deprecated
+// Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) found in the text. Can be called synchronously
+func (client *Client) GetTextEntities(req *GetTextEntitiesRequest) (*TextEntities, error) {
+	return GetTextEntities(req)
+}
+
+type ParseTextEntitiesRequest struct {
+	request
+	// The text to parse
+	Text string `json:"text"`
+	// Text parse mode
+	ParseMode TextParseMode `json:"parse_mode"`
+}
+
+func (req ParseTextEntitiesRequest) GetFunctionName() string {
+	return "parseTextEntities"
+}
+
+func (req *ParseTextEntitiesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ParseTextEntitiesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"parseTextEntities"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw(`,"parse_mode":`)
+	encoder.writeType(req.ParseMode)
+	encoder.writeRaw("}")
+}
+
+func (req ParseTextEntitiesRequest) Validate() error {
+	return nil
+}
+
+// Parses Bold, Italic, Underline, Strikethrough, Spoiler, CustomEmoji, BlockQuote, ExpandableBlockQuote, Code, Pre, PreCode, TextUrl and MentionName entities from a marked-up text. Can be called synchronously
+func ParseTextEntities(req *ParseTextEntitiesRequest) (*FormattedText, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFormattedText)
+}
+
+// deprecated
+// Parses Bold, Italic, Underline, Strikethrough, Spoiler, CustomEmoji, BlockQuote, ExpandableBlockQuote, Code, Pre, PreCode, TextUrl and MentionName entities from a marked-up text. Can be called synchronously
+func (client *Client) ParseTextEntities(req *ParseTextEntitiesRequest) (*FormattedText, error) {
+	return ParseTextEntities(req)
+}
+
+type GetMarkdownTextRequest struct {
+	request
+	// The text
+	Text *FormattedText `json:"text"`
+}
+
+func (req GetMarkdownTextRequest) GetFunctionName() string {
+	return "getMarkdownText"
+}
+
+func (req *GetMarkdownTextRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMarkdownTextRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMarkdownText"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	req.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GetMarkdownTextRequest) Validate() error {
+	return nil
+}
+
+// Replaces text entities with Markdown formatting in a human-friendly format. Entities that can't be represented in Markdown unambiguously are kept as is. Can be called synchronously
+func GetMarkdownText(req *GetMarkdownTextRequest) (*FormattedText, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFormattedText)
+}
+
+// deprecated
+// Replaces text entities with Markdown formatting in a human-friendly format. Entities that can't be represented in Markdown unambiguously are kept as is. Can be called synchronously
+func (client *Client) GetMarkdownText(req *GetMarkdownTextRequest) (*FormattedText, error) {
+	return GetMarkdownText(req)
+}
+
+type SetPollAnswerRequest struct {
+	request
+	// Identifier of the chat to which the poll belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message containing the poll
+	MessageId int64 `json:"message_id"`
+	// 0-based identifiers of answer options, chosen by the user. User can choose more than 1 answer option only is the poll allows multiple answers
+	OptionIds []int32 `json:"option_ids"`
+}
+
+func (req SetPollAnswerRequest) GetFunctionName() string {
+	return "setPollAnswer"
+}
+
+func (req *SetPollAnswerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPollAnswerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPollAnswer"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"option_ids":`)
+	writeList(encoder, req.OptionIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (req SetPollAnswerRequest) Validate() error {
+	return nil
+}
+
+// Changes the user answer to a poll. A poll in quiz mode can be answered only once
+//
+// Available to users only
+func (client *Client) SetPollAnswer(ctx context.Context, req *SetPollAnswerRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetPollVotersRequest struct {
+	request
+	// Identifier of the chat to which the poll belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message containing the poll
+	MessageId int64 `json:"message_id"`
+	// 0-based identifier of the answer option
+	OptionId int32 `json:"option_id"`
+	// Number of voters to skip in the result; must be non-negative
+	Offset int32 `json:"offset"`
+	// The maximum number of voters to be returned; must be positive and can't be greater than 50. For optimal performance, the number of returned voters is chosen by TDLib and can be smaller than the specified limit, even if the end of the voter list has not been reached
+	Limit int32 `json:"limit"`
+}
+
+func (req GetPollVotersRequest) GetFunctionName() string {
+	return "getPollVoters"
+}
+
+func (req *GetPollVotersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPollVotersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPollVoters"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"option_id":`)
+	encoder.writeInt32(req.OptionId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetPollVotersRequest) Validate() error {
+	if err := validateMin("offset", int64(req.Offset), 0); err != nil {
+		return err
+	}
+
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 50); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns message senders voted for the specified option in a non-anonymous polls. For optimal performance, the number of returned users is chosen by TDLib
+//
+// Available to users only
+func (client *Client) GetPollVoters(ctx context.Context, req *GetPollVotersRequest) (*MessageSenders, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageSenders)
+}
+
+type StopPollRequest struct {
+	request
+	// Identifier of the chat to which the poll belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message containing the poll. Use messageProperties.can_be_edited to check whether the poll can be stopped
+	MessageId int64 `json:"message_id"`
+	// The new message reply markup; pass null if none; for bots only
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (req StopPollRequest) GetFunctionName() string {
+	return "stopPoll"
+}
+
+func (req *StopPollRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *StopPollRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stopPoll"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(req.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req StopPollRequest) Validate() error {
+	if err := validateNested("reply_markup", req.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Stops a poll
+func (client *Client) StopPoll(ctx context.Context, req *StopPollRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SavePreparedInlineMessageRequest struct {
+	request
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+	// The description of the message
+	Result InputInlineQueryResult `json:"result"`
+	// Types of the chats to which the message can be sent
+	ChatTypes *TargetChatTypes `json:"chat_types"`
+}
+
+func (req SavePreparedInlineMessageRequest) GetFunctionName() string {
+	return "savePreparedInlineMessage"
+}
+
+func (req *SavePreparedInlineMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SavePreparedInlineMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savePreparedInlineMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"result":`)
+	encoder.writeType(req.Result)
+	encoder.writeRaw(`,"chat_types":`)
+	req.ChatTypes.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SavePreparedInlineMessageRequest) Validate() error {
+	if err := validateNested("result", req.Result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Saves an inline message to be sent by the given user; for bots only
+//
+// Available to bots only
+func (client *Client) SavePreparedInlineMessage(ctx context.Context, req *SavePreparedInlineMessageRequest) (*PreparedInlineMessageId, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPreparedInlineMessageId)
+}
+
+type GetPreparedInlineMessageRequest struct {
+	request
+	// Identifier of the bot that created the message
+	BotUserId int64 `json:"bot_user_id"`
+	// Identifier of the prepared message
+	PreparedMessageId string `json:"prepared_message_id"`
+}
+
+func (req GetPreparedInlineMessageRequest) GetFunctionName() string {
+	return "getPreparedInlineMessage"
+}
+
+func (req *GetPreparedInlineMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPreparedInlineMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPreparedInlineMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"prepared_message_id":`)
+	encoder.writeString(req.PreparedMessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetPreparedInlineMessageRequest) Validate() error {
+	return nil
+}
+
+// Saves an inline message to be sent by the given user
+//
+// Available to users only
+func (client *Client) GetPreparedInlineMessage(ctx context.Context, req *GetPreparedInlineMessageRequest) (*PreparedInlineMessage, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPreparedInlineMessage)
+}
+
+type DeleteChatReplyMarkupRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The message identifier of the used keyboard
+	MessageId int64 `json:"message_id"`
+}
+
+func (req DeleteChatReplyMarkupRequest) GetFunctionName() string {
+	return "deleteChatReplyMarkup"
+}
+
+func (req *DeleteChatReplyMarkupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteChatReplyMarkupRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteChatReplyMarkup"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteChatReplyMarkupRequest) Validate() error {
+	return nil
+}
+
+// Deletes the default reply markup from a chat. Must be called after a one-time keyboard or a replyMarkupForceReply reply markup has been used. An updateChatReplyMarkup update will be sent if the reply markup is changed
+//
+// Available to users only
+func (client *Client) DeleteChatReplyMarkup(ctx context.Context, req *DeleteChatReplyMarkupRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ViewMessagesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The identifiers of the messages being viewed
+	MessageIds []int64 `json:"message_ids"`
+	// Source of the message view; pass null to guess the source based on chat open state
+	Source MessageSource `json:"source,omitempty"`
+	// Pass true to mark as read the specified messages even the chat is closed
+	ForceRead bool `json:"force_read"`
+}
+
+func (req ViewMessagesRequest) GetFunctionName() string {
+	return "viewMessages"
+}
+
+func (req *ViewMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ViewMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"viewMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, req.MessageIds, (*jsonEncoder).writeInt64)
+	if req.Source != nil {
+		encoder.writeRaw(`,"source":`)
+		encoder.writeType(req.Source)
+	}
+	encoder.writeRaw(`,"force_read":`)
+	encoder.writeBool(req.ForceRead)
+	encoder.writeRaw("}")
+}
+
+func (req ViewMessagesRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that messages are being viewed by the user. Sponsored messages must be marked as viewed only when the entire text of the message is shown on the screen (excluding the button). Many useful activities depend on whether the messages are currently being viewed or not (e.g., marking messages as read, incrementing a view counter, updating a view counter, removing deleted messages in supergroups and channels)
+//
+// Available to users only
+func (client *Client) ViewMessages(ctx context.Context, req *ViewMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type OpenMessageContentRequest struct {
+	request
+	// Chat identifier of the message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message with the opened content
+	MessageId int64 `json:"message_id"`
+}
+
+func (req OpenMessageContentRequest) GetFunctionName() string {
+	return "openMessageContent"
+}
+
+func (req *OpenMessageContentRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OpenMessageContentRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"openMessageContent"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req OpenMessageContentRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the message content has been opened (e.g., the user has opened a photo, video, document, location or venue, or has listened to an audio file or voice note message). An updateMessageContentOpened update will be generated if something has changed
+//
+// Available to users only
+func (client *Client) OpenMessageContent(ctx context.Context, req *OpenMessageContentRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReadAllMessageThreadMentionsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier in which mentions are marked as read
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (req ReadAllMessageThreadMentionsRequest) GetFunctionName() string {
+	return "readAllMessageThreadMentions"
+}
+
+func (req *ReadAllMessageThreadMentionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReadAllMessageThreadMentionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readAllMessageThreadMentions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (req ReadAllMessageThreadMentionsRequest) Validate() error {
+	return nil
+}
+
+// Marks all mentions in a forum topic as read
+//
+// Available to users only
+func (client *Client) ReadAllMessageThreadMentions(ctx context.Context, req *ReadAllMessageThreadMentionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatMessageAutoDeleteTimeRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New time value, in seconds; unless the chat is secret, it must be from 0 up to 365 * 86400 and be divisible by 86400. If 0, then messages aren't deleted automatically
+	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
+}
+
+func (req SetChatMessageAutoDeleteTimeRequest) GetFunctionName() string {
+	return "setChatMessageAutoDeleteTime"
+}
+
+func (req *SetChatMessageAutoDeleteTimeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatMessageAutoDeleteTimeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatMessageAutoDeleteTime"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_auto_delete_time":`)
+	encoder.writeInt32(req.MessageAutoDeleteTime)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatMessageAutoDeleteTimeRequest) Validate() error {
+	return nil
+}
+
+// Changes the message auto-delete or self-destruct (for secret chats) time in a chat. Requires change_info administrator right in basic groups, supergroups and channels. Message auto-delete time can't be changed in a chat with the current user (Saved Messages) and the chat 777000 (Telegram).
+//
+// Available to users only
+func (client *Client) SetChatMessageAutoDeleteTime(ctx context.Context, req *SetChatMessageAutoDeleteTimeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatDraftMessageRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// If not 0, the message thread identifier in which the draft was changed
+	MessageThreadId int64 `json:"message_thread_id"`
+	// New draft message; pass null to remove the draft. All files in draft message content must be of the type inputFileLocal. Media thumbnails and captions are ignored
+	DraftMessage *DraftMessage `json:"draft_message,omitempty"`
+}
+
+func (req SetChatDraftMessageRequest) GetFunctionName() string {
+	return "setChatDraftMessage"
+}
+
+func (req *SetChatDraftMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatDraftMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatDraftMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	if req.DraftMessage != nil {
+		encoder.writeRaw(`,"draft_message":`)
+		req.DraftMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetChatDraftMessageRequest) Validate() error {
+	if err := validateNested("draft_message", req.DraftMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Changes the draft message in a chat
+//
+// Available to users only
+func (client *Client) SetChatDraftMessage(ctx context.Context, req *SetChatDraftMessageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type PinChatMessageRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the new pinned message
+	MessageId int64 `json:"message_id"`
+	// Pass true to disable notification about the pinned message. Notifications are always disabled in channels and private chats
+	DisableNotification bool `json:"disable_notification"`
+	// Pass true to pin the message only for self; private chats only
+	OnlyForSelf bool `json:"only_for_self"`
+}
+
+func (req PinChatMessageRequest) GetFunctionName() string {
+	return "pinChatMessage"
+}
+
+func (req *PinChatMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *PinChatMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pinChatMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"disable_notification":`)
+	encoder.writeBool(req.DisableNotification)
+	encoder.writeRaw(`,"only_for_self":`)
+	encoder.writeBool(req.OnlyForSelf)
+	encoder.writeRaw("}")
+}
+
+func (req PinChatMessageRequest) Validate() error {
+	return nil
+}
+
+// Pins a message in a chat. A message can be pinned only if messageProperties.can_be_pinned
+func (client *Client) PinChatMessage(ctx context.Context, req *PinChatMessageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type UnpinChatMessageRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the removed pinned message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req UnpinChatMessageRequest) GetFunctionName() string {
+	return "unpinChatMessage"
+}
+
+func (req *UnpinChatMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *UnpinChatMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"unpinChatMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req UnpinChatMessageRequest) Validate() error {
+	return nil
+}
+
+// Removes a pinned message from a chat; requires can_pin_messages member right if the chat is a basic group or supergroup, or can_edit_messages administrator right if the chat is a channel
+func (client *Client) UnpinChatMessage(ctx context.Context, req *UnpinChatMessageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type UnpinAllChatMessagesRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req UnpinAllChatMessagesRequest) GetFunctionName() string {
+	return "unpinAllChatMessages"
+}
+
+func (req *UnpinAllChatMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *UnpinAllChatMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"unpinAllChatMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req UnpinAllChatMessagesRequest) Validate() error {
+	return nil
+}
+
+// Removes all pinned messages from a chat; requires can_pin_messages member right if the chat is a basic group or supergroup, or can_edit_messages administrator right if the chat is a channel
+func (client *Client) UnpinAllChatMessages(ctx context.Context, req *UnpinAllChatMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type UnpinAllMessageThreadMessagesRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier in which messages will be unpinned
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (req UnpinAllMessageThreadMessagesRequest) GetFunctionName() string {
+	return "unpinAllMessageThreadMessages"
+}
+
+func (req *UnpinAllMessageThreadMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *UnpinAllMessageThreadMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"unpinAllMessageThreadMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (req UnpinAllMessageThreadMessagesRequest) Validate() error {
+	return nil
+}
+
+// Removes all pinned messages from a forum topic; requires can_pin_messages member right in the supergroup
+func (client *Client) UnpinAllMessageThreadMessages(ctx context.Context, req *UnpinAllMessageThreadMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ClearAllDraftMessagesRequest struct {
+	request
+	// Pass true to keep local message drafts in secret chats
+	ExcludeSecretChats bool `json:"exclude_secret_chats"`
+}
+
+func (req ClearAllDraftMessagesRequest) GetFunctionName() string {
+	return "clearAllDraftMessages"
+}
+
+func (req *ClearAllDraftMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearAllDraftMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearAllDraftMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"exclude_secret_chats":`)
+	encoder.writeBool(req.ExcludeSecretChats)
+	encoder.writeRaw("}")
+}
+
+func (req ClearAllDraftMessagesRequest) Validate() error {
+	return nil
+}
+
+// Clears message drafts in all chats
+//
+// Available to users only
+func (client *Client) ClearAllDraftMessages(ctx context.Context, req *ClearAllDraftMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetMessageFileTypeRequest struct {
+	request
+	// Beginning of the message file; up to 100 first lines
+	MessageFileHead string `json:"message_file_head"`
+}
+
+func (req GetMessageFileTypeRequest) GetFunctionName() string {
+	return "getMessageFileType"
+}
+
+func (req *GetMessageFileTypeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageFileTypeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageFileType"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"message_file_head":`)
+	encoder.writeString(req.MessageFileHead)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageFileTypeRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a file with messages exported from another application
+//
+// Available to users only
+func (client *Client) GetMessageFileType(ctx context.Context, req *GetMessageFileTypeRequest) (MessageFileType, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageFileType)
+}
+
+type GetMessageImportConfirmationTextRequest struct {
+	request
+	// Identifier of a chat to which the messages will be imported. It must be an identifier of a private chat with a mutual contact or an identifier of a supergroup chat with can_change_info member right
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetMessageImportConfirmationTextRequest) GetFunctionName() string {
+	return "getMessageImportConfirmationText"
+}
+
+func (req *GetMessageImportConfirmationTextRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageImportConfirmationTextRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageImportConfirmationText"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageImportConfirmationTextRequest) Validate() error {
+	return nil
+}
+
+// Returns a confirmation text to be shown to the user before starting message import
+//
+// Available to users only
+func (client *Client) GetMessageImportConfirmationText(ctx context.Context, req *GetMessageImportConfirmationTextRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type ImportMessagesRequest struct {
+	request
+	// Identifier of a chat to which the messages will be imported. It must be an identifier of a private chat with a mutual contact or an identifier of a supergroup chat with can_change_info member right
+	ChatId int64 `json:"chat_id"`
+	// File with messages to import. Only inputFileLocal and inputFileGenerated are supported. The file must not be previously uploaded
+	MessageFile InputFile `json:"message_file"`
+	// Files used in the imported messages. Only inputFileLocal and inputFileGenerated are supported. The files must not be previously uploaded
+	AttachedFiles []InputFile `json:"attached_files"`
+}
+
+func (req ImportMessagesRequest) GetFunctionName() string {
+	return "importMessages"
+}
+
+func (req *ImportMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ImportMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"importMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_file":`)
+	encoder.writeType(req.MessageFile)
+	encoder.writeRaw(`,"attached_files":`)
+	writeList(encoder, req.AttachedFiles, writeTypeValue[InputFile])
+	encoder.writeRaw("}")
+}
+
+func (req ImportMessagesRequest) Validate() error {
+	return nil
+}
+
+// Imports messages exported from another app
+//
+// Available to users only
+func (client *Client) ImportMessages(ctx context.Context, req *ImportMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetMessageSenderBlockListRequest struct {
+	request
+	// Identifier of a message sender to block/unblock
+	SenderId MessageSender `json:"sender_id"`
+	// New block list for the message sender; pass null to unblock the message sender
+	BlockList BlockList `json:"block_list,omitempty"`
+}
+
+func (req SetMessageSenderBlockListRequest) GetFunctionName() string {
+	return "setMessageSenderBlockList"
+}
+
+func (req *SetMessageSenderBlockListRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetMessageSenderBlockListRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setMessageSenderBlockList"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(req.SenderId)
+	if req.BlockList != nil {
+		encoder.writeRaw(`,"block_list":`)
+		encoder.writeType(req.BlockList)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetMessageSenderBlockListRequest) Validate() error {
+	return nil
+}
+
+// Changes the block list of a message sender. Currently, only users and supergroup chats can be blocked
+//
+// Available to users only
+func (client *Client) SetMessageSenderBlockList(ctx context.Context, req *SetMessageSenderBlockListRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type BlockMessageSenderFromRepliesRequest struct {
+	request
+	// The identifier of an incoming message in the Replies chat
+	MessageId int64 `json:"message_id"`
+	// Pass true to delete the message
+	DeleteMessage bool `json:"delete_message"`
+	// Pass true to delete all messages from the same sender
+	DeleteAllMessages bool `json:"delete_all_messages"`
+	// Pass true to report the sender to the Telegram moderators
+	ReportSpam bool `json:"report_spam"`
+}
+
+func (req BlockMessageSenderFromRepliesRequest) GetFunctionName() string {
+	return "blockMessageSenderFromReplies"
+}
+
+func (req *BlockMessageSenderFromRepliesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *BlockMessageSenderFromRepliesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"blockMessageSenderFromReplies"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"delete_message":`)
+	encoder.writeBool(req.DeleteMessage)
+	encoder.writeRaw(`,"delete_all_messages":`)
+	encoder.writeBool(req.DeleteAllMessages)
+	encoder.writeRaw(`,"report_spam":`)
+	encoder.writeBool(req.ReportSpam)
+	encoder.writeRaw("}")
+}
+
+func (req BlockMessageSenderFromRepliesRequest) Validate() error {
+	return nil
+}
+
+// Blocks an original sender of a message in the Replies chat
+//
+// Available to users only
+func (client *Client) BlockMessageSenderFromReplies(ctx context.Context, req *BlockMessageSenderFromRepliesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetBlockedMessageSendersRequest struct {
+	request
+	// Block list from which to return users
+	BlockList BlockList `json:"block_list"`
+	// Number of users and chats to skip in the result; must be non-negative
+	Offset int32 `json:"offset"`
+	// The maximum number of users and chats to return; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetBlockedMessageSendersRequest) GetFunctionName() string {
+	return "getBlockedMessageSenders"
+}
+
+func (req *GetBlockedMessageSendersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetBlockedMessageSendersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getBlockedMessageSenders"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"block_list":`)
+	encoder.writeType(req.BlockList)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetBlockedMessageSendersRequest) Validate() error {
+	if err := validateMin("offset", int64(req.Offset), 0); err != nil {
+		return err
+	}
+
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns users and chats that were blocked by the current user
+//
+// Available to users only
+func (client *Client) GetBlockedMessageSenders(ctx context.Context, req *GetBlockedMessageSendersRequest) (*MessageSenders, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageSenders)
+}
+
+type ToggleHasSponsoredMessagesEnabledRequest struct {
+	request
+	// Pass true to enable sponsored messages for the current user; false to disable them
+	HasSponsoredMessagesEnabled bool `json:"has_sponsored_messages_enabled"`
+}
+
+func (req ToggleHasSponsoredMessagesEnabledRequest) GetFunctionName() string {
+	return "toggleHasSponsoredMessagesEnabled"
+}
+
+func (req *ToggleHasSponsoredMessagesEnabledRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleHasSponsoredMessagesEnabledRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleHasSponsoredMessagesEnabled"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"has_sponsored_messages_enabled":`)
+	encoder.writeBool(req.HasSponsoredMessagesEnabled)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleHasSponsoredMessagesEnabledRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether the current user has sponsored messages enabled. The setting has no effect for users without Telegram Premium for which sponsored messages are always enabled
+//
+// Available to users only
+func (client *Client) ToggleHasSponsoredMessagesEnabled(ctx context.Context, req *ToggleHasSponsoredMessagesEnabledRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CanBotSendMessagesRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req CanBotSendMessagesRequest) GetFunctionName() string {
+	return "canBotSendMessages"
+}
+
+func (req *CanBotSendMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CanBotSendMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canBotSendMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req CanBotSendMessagesRequest) Validate() error {
+	return nil
+}
+
+// Checks whether the specified bot can send messages to the user. Returns a 404 error if can't and the access can be granted by call to allowBotToSendMessages
+//
+// Available to users only
+func (client *Client) CanBotSendMessages(ctx context.Context, req *CanBotSendMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AllowBotToSendMessagesRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req AllowBotToSendMessagesRequest) GetFunctionName() string {
+	return "allowBotToSendMessages"
+}
+
+func (req *AllowBotToSendMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AllowBotToSendMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"allowBotToSendMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req AllowBotToSendMessagesRequest) Validate() error {
+	return nil
+}
+
+// Allows the specified bot to send messages to the user
+//
+// Available to users only
+func (client *Client) AllowBotToSendMessages(ctx context.Context, req *AllowBotToSendMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetMessageSenderBotVerificationRequest struct {
+	request
+	// Identifier of the owned bot, which will verify the user or the chat
+	BotUserId int64 `json:"bot_user_id"`
+	// Identifier of the user or the supergroup or channel chat, which will be verified by the bot
+	VerifiedId MessageSender `json:"verified_id"`
+	// Custom description of verification reason; 0-getOption("bot_verification_custom_description_length_max"). If empty, then "was verified by organization "organization_name"" will be used as description. Can be specified only if the bot is allowed to provide custom description
+	CustomDescription string `json:"custom_description"`
+}
+
+func (req SetMessageSenderBotVerificationRequest) GetFunctionName() string {
+	return "setMessageSenderBotVerification"
+}
+
+func (req *SetMessageSenderBotVerificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetMessageSenderBotVerificationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setMessageSenderBotVerification"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"verified_id":`)
+	encoder.writeType(req.VerifiedId)
+	encoder.writeRaw(`,"custom_description":`)
+	encoder.writeString(req.CustomDescription)
+	encoder.writeRaw("}")
+}
+
+func (req SetMessageSenderBotVerificationRequest) Validate() error {
+	return nil
+}
+
+// Changes the verification status of a user or a chat by an owned bot
+func (client *Client) SetMessageSenderBotVerification(ctx context.Context, req *SetMessageSenderBotVerificationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveMessageSenderBotVerificationRequest struct {
+	request
+	// Identifier of the owned bot, which verified the user or the chat
+	BotUserId int64 `json:"bot_user_id"`
+	// Identifier of the user or the supergroup or channel chat, which verification is removed
+	VerifiedId MessageSender `json:"verified_id"`
+}
+
+func (req RemoveMessageSenderBotVerificationRequest) GetFunctionName() string {
+	return "removeMessageSenderBotVerification"
+}
+
+func (req *RemoveMessageSenderBotVerificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveMessageSenderBotVerificationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeMessageSenderBotVerification"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"verified_id":`)
+	encoder.writeType(req.VerifiedId)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveMessageSenderBotVerificationRequest) Validate() error {
+	return nil
+}
+
+// Removes the verification status of a user or a chat by an owned bot
+func (client *Client) RemoveMessageSenderBotVerification(ctx context.Context, req *RemoveMessageSenderBotVerificationRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupSignMessagesRequest struct {
+	request
+	// Identifier of the channel
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of sign_messages
+	SignMessages bool `json:"sign_messages"`
+	// New value of show_message_sender
+	ShowMessageSender bool `json:"show_message_sender"`
+}
+
+func (req ToggleSupergroupSignMessagesRequest) GetFunctionName() string {
+	return "toggleSupergroupSignMessages"
+}
+
+func (req *ToggleSupergroupSignMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupSignMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupSignMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"sign_messages":`)
+	encoder.writeBool(req.SignMessages)
+	encoder.writeRaw(`,"show_message_sender":`)
+	encoder.writeBool(req.ShowMessageSender)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupSignMessagesRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether sender signature or link to the account is added to sent messages in a channel; requires can_change_info member right
+//
+// Available to users only
+func (client *Client) ToggleSupergroupSignMessages(ctx context.Context, req *ToggleSupergroupSignMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupJoinToSendMessagesRequest struct {
+	request
+	// Identifier of the supergroup that isn't a broadcast group
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of join_to_send_messages
+	JoinToSendMessages bool `json:"join_to_send_messages"`
+}
+
+func (req ToggleSupergroupJoinToSendMessagesRequest) GetFunctionName() string {
+	return "toggleSupergroupJoinToSendMessages"
+}
+
+func (req *ToggleSupergroupJoinToSendMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupJoinToSendMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupJoinToSendMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"join_to_send_messages":`)
+	encoder.writeBool(req.JoinToSendMessages)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupJoinToSendMessagesRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether joining is mandatory to send messages to a discussion supergroup; requires can_restrict_members administrator right
+//
+// Available to users only
+func (client *Client) ToggleSupergroupJoinToSendMessages(ctx context.Context, req *ToggleSupergroupJoinToSendMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleSupergroupCanHaveSponsoredMessagesRequest struct {
+	request
+	// The identifier of the channel
+	SupergroupId int64 `json:"supergroup_id"`
+	// The new value of can_have_sponsored_messages
+	CanHaveSponsoredMessages bool `json:"can_have_sponsored_messages"`
+}
+
+func (req ToggleSupergroupCanHaveSponsoredMessagesRequest) GetFunctionName() string {
+	return "toggleSupergroupCanHaveSponsoredMessages"
+}
+
+func (req *ToggleSupergroupCanHaveSponsoredMessagesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleSupergroupCanHaveSponsoredMessagesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleSupergroupCanHaveSponsoredMessages"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"can_have_sponsored_messages":`)
+	encoder.writeBool(req.CanHaveSponsoredMessages)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleSupergroupCanHaveSponsoredMessagesRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether sponsored messages are shown in the channel chat; requires owner privileges in the channel. The chat must have at least chatBoostFeatures.min_sponsored_message_disable_boost_level boost level to disable sponsored messages
+//
+// Available to users only
+func (client *Client) ToggleSupergroupCanHaveSponsoredMessages(ctx context.Context, req *ToggleSupergroupCanHaveSponsoredMessagesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AllowUnpaidMessagesFromUserRequest struct {
+	request
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+	// Pass true to refund the user previously paid messages
+	RefundPayments bool `json:"refund_payments"`
+}
+
+func (req AllowUnpaidMessagesFromUserRequest) GetFunctionName() string {
+	return "allowUnpaidMessagesFromUser"
+}
+
+func (req *AllowUnpaidMessagesFromUserRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AllowUnpaidMessagesFromUserRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"allowUnpaidMessagesFromUser"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"refund_payments":`)
+	encoder.writeBool(req.RefundPayments)
+	encoder.writeRaw("}")
+}
+
+func (req AllowUnpaidMessagesFromUserRequest) Validate() error {
+	return nil
+}
+
+// Allows the specified user to send unpaid private messages to the current user by adding a rule to userPrivacySettingAllowUnpaidMessages
+//
+// Available to users only
+func (client *Client) AllowUnpaidMessagesFromUser(ctx context.Context, req *AllowUnpaidMessagesFromUserRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CanSendMessageToUserRequest struct {
+	request
+	// Identifier of the other user
+	UserId int64 `json:"user_id"`
+	// Pass true to get only locally available information without sending network requests
+	OnlyLocal bool `json:"only_local"`
+}
+
+func (req CanSendMessageToUserRequest) GetFunctionName() string {
+	return "canSendMessageToUser"
+}
+
+func (req *CanSendMessageToUserRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CanSendMessageToUserRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendMessageToUser"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"only_local":`)
+	encoder.writeBool(req.OnlyLocal)
+	encoder.writeRaw("}")
+}
+
+func (req CanSendMessageToUserRequest) Validate() error {
+	return nil
+}
+
+// Check whether the current user can message another user or try to create a chat with them
+//
+// Available to users only
+func (client *Client) CanSendMessageToUser(ctx context.Context, req *CanSendMessageToUserRequest) (CanSendMessageToUserResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCanSendMessageToUserResult)
+}
+
+type SetDefaultMessageAutoDeleteTimeRequest struct {
+	request
+	// New default message auto-delete time; must be from 0 up to 365 * 86400 and be divisible by 86400. If 0, then messages aren't deleted automatically
+	MessageAutoDeleteTime *MessageAutoDeleteTime `json:"message_auto_delete_time"`
+}
+
+func (req SetDefaultMessageAutoDeleteTimeRequest) GetFunctionName() string {
+	return "setDefaultMessageAutoDeleteTime"
+}
+
+func (req *SetDefaultMessageAutoDeleteTimeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetDefaultMessageAutoDeleteTimeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setDefaultMessageAutoDeleteTime"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"message_auto_delete_time":`)
+	req.MessageAutoDeleteTime.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetDefaultMessageAutoDeleteTimeRequest) Validate() error {
+	return nil
+}
+
+// Changes the default message auto-delete time for new chats
+//
+// Available to users only
+func (client *Client) SetDefaultMessageAutoDeleteTime(ctx context.Context, req *SetDefaultMessageAutoDeleteTimeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetDefaultMessageAutoDeleteTimeRequest struct {
+	request
+}
+
+func (req GetDefaultMessageAutoDeleteTimeRequest) GetFunctionName() string {
+	return "getDefaultMessageAutoDeleteTime"
+}
+
+func (req *GetDefaultMessageAutoDeleteTimeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDefaultMessageAutoDeleteTimeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDefaultMessageAutoDeleteTime"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDefaultMessageAutoDeleteTimeRequest) Validate() error {
+	return nil
+}
+
+// Returns default message auto-delete time setting for new chats
+//
+// Available to users only
+func (client *Client) GetDefaultMessageAutoDeleteTime(ctx context.Context) (*MessageAutoDeleteTime, error) {
+	req := &GetDefaultMessageAutoDeleteTimeRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageAutoDeleteTime)
+}
+
+type GetMessageStatisticsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Pass true if a dark theme is used by the application
+	IsDark bool `json:"is_dark"`
+}
+
+func (req GetMessageStatisticsRequest) GetFunctionName() string {
+	return "getMessageStatistics"
+}
+
+func (req *GetMessageStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(req.IsDark)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns detailed statistics about a message. Can be used only if messageProperties.can_get_statistics == true
+//
+// Available to users only
+func (client *Client) GetMessageStatistics(ctx context.Context, req *GetMessageStatisticsRequest) (*MessageStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageStatistics)
+}
+
+type GetMessagePublicForwardsRequest struct {
+	request
+	// Chat identifier of the message
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of messages and stories to be returned; must be positive and can't be greater than 100. For optimal performance, the number of returned objects is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetMessagePublicForwardsRequest) GetFunctionName() string {
+	return "getMessagePublicForwards"
+}
+
+func (req *GetMessagePublicForwardsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessagePublicForwardsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessagePublicForwards"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessagePublicForwardsRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns forwarded copies of a channel message to different public channels and public reposts as a story. Can be used only if messageProperties.can_get_statistics == true. For optimal performance, the number of returned messages and stories is chosen by TDLib
+//
+// Available to users only
+func (client *Client) GetMessagePublicForwards(ctx context.Context, req *GetMessagePublicForwardsRequest) (*PublicForwards, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPublicForwards)
+}
+
+type AddLogMessageRequest struct {
+	request
+	// The minimum verbosity level needed for the message to be logged; 0-1023
+	VerbosityLevel int32 `json:"verbosity_level"`
+	// Text of a message to log
+	Text string `json:"text"`
+}
+
+func (req AddLogMessageRequest) GetFunctionName() string {
+	return "addLogMessage"
+}
+
+func (req *AddLogMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddLogMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addLogMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"verbosity_level":`)
+	encoder.writeInt32(req.VerbosityLevel)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw("}")
+}
+
+func (req AddLogMessageRequest) Validate() error {
+	return nil
+}
+
+// Adds a message to TDLib internal log. Can be called synchronously
+func AddLogMessage(req *AddLogMessageRequest) (*Ok, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+// deprecated
+// Adds a message to TDLib internal log. Can be called synchronously
+func (client *Client) AddLogMessage(req *AddLogMessageRequest) (*Ok, error) {
+	return AddLogMessage(req)
+}
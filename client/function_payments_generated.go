@@ -0,0 +1,3922 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type GetChatAvailablePaidMessageReactionSendersRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatAvailablePaidMessageReactionSendersRequest) GetFunctionName() string {
+	return "getChatAvailablePaidMessageReactionSenders"
+}
+
+func (req *GetChatAvailablePaidMessageReactionSendersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatAvailablePaidMessageReactionSendersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatAvailablePaidMessageReactionSenders"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatAvailablePaidMessageReactionSendersRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of message sender identifiers, which can be used to send a paid reaction in a chat
+//
+// Available to users only
+func (client *Client) GetChatAvailablePaidMessageReactionSenders(ctx context.Context, req *GetChatAvailablePaidMessageReactionSendersRequest) (*MessageSenders, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalMessageSenders)
+}
+
+type AddPendingPaidMessageReactionRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Number of Telegram Stars to be used for the reaction. The total number of pending paid reactions must not exceed getOption("paid_reaction_star_count_max")
+	StarCount int64 `json:"star_count"`
+	// Type of the paid reaction; pass null if the user didn't choose reaction type explicitly, for example, the reaction is set from the message bubble
+	Type PaidReactionType `json:"type,omitempty"`
+}
+
+func (req AddPendingPaidMessageReactionRequest) GetFunctionName() string {
+	return "addPendingPaidMessageReaction"
+}
+
+func (req *AddPendingPaidMessageReactionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddPendingPaidMessageReactionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addPendingPaidMessageReaction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(req.StarCount)
+	if req.Type != nil {
+		encoder.writeRaw(`,"type":`)
+		encoder.writeType(req.Type)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req AddPendingPaidMessageReactionRequest) Validate() error {
+	return nil
+}
+
+// Adds the paid message reaction to a message. Use getMessageAvailableReactions to check whether the reaction is available for the message
+//
+// Available to users only
+func (client *Client) AddPendingPaidMessageReaction(ctx context.Context, req *AddPendingPaidMessageReactionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CommitPendingPaidMessageReactionsRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req CommitPendingPaidMessageReactionsRequest) GetFunctionName() string {
+	return "commitPendingPaidMessageReactions"
+}
+
+func (req *CommitPendingPaidMessageReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CommitPendingPaidMessageReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"commitPendingPaidMessageReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req CommitPendingPaidMessageReactionsRequest) Validate() error {
+	return nil
+}
+
+// Applies all pending paid reactions on a message
+//
+// Available to users only
+func (client *Client) CommitPendingPaidMessageReactions(ctx context.Context, req *CommitPendingPaidMessageReactionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemovePendingPaidMessageReactionsRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req RemovePendingPaidMessageReactionsRequest) GetFunctionName() string {
+	return "removePendingPaidMessageReactions"
+}
+
+func (req *RemovePendingPaidMessageReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemovePendingPaidMessageReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removePendingPaidMessageReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req RemovePendingPaidMessageReactionsRequest) Validate() error {
+	return nil
+}
+
+// Removes all pending paid reactions on a message
+//
+// Available to users only
+func (client *Client) RemovePendingPaidMessageReactions(ctx context.Context, req *RemovePendingPaidMessageReactionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetPaidMessageReactionTypeRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// New type of the paid reaction
+	Type PaidReactionType `json:"type"`
+}
+
+func (req SetPaidMessageReactionTypeRequest) GetFunctionName() string {
+	return "setPaidMessageReactionType"
+}
+
+func (req *SetPaidMessageReactionTypeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPaidMessageReactionTypeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPaidMessageReactionType"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(req.Type)
+	encoder.writeRaw("}")
+}
+
+func (req SetPaidMessageReactionTypeRequest) Validate() error {
+	return nil
+}
+
+// Changes type of paid message reaction of the current user on a message. The message must have paid reaction added by the current user
+//
+// Available to users only
+func (client *Client) SetPaidMessageReactionType(ctx context.Context, req *SetPaidMessageReactionTypeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatBoostLevelFeaturesRequest struct {
+	request
+	// Pass true to get the list of features for channels; pass false to get the list of features for supergroups
+	IsChannel bool `json:"is_channel"`
+	// Chat boost level
+	Level int32 `json:"level"`
+}
+
+func (req GetChatBoostLevelFeaturesRequest) GetFunctionName() string {
+	return "getChatBoostLevelFeatures"
+}
+
+func (req *GetChatBoostLevelFeaturesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatBoostLevelFeaturesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatBoostLevelFeatures"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"is_channel":`)
+	encoder.writeBool(req.IsChannel)
+	encoder.writeRaw(`,"level":`)
+	encoder.writeInt32(req.Level)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatBoostLevelFeaturesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of features available on the specific chat boost level. This is an offline method
+//
+// Available to users only
+func (client *Client) GetChatBoostLevelFeatures(ctx context.Context, req *GetChatBoostLevelFeaturesRequest) (*ChatBoostLevelFeatures, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatBoostLevelFeatures)
+}
+
+type GetChatBoostFeaturesRequest struct {
+	request
+	// Pass true to get the list of features for channels; pass false to get the list of features for supergroups
+	IsChannel bool `json:"is_channel"`
+}
+
+func (req GetChatBoostFeaturesRequest) GetFunctionName() string {
+	return "getChatBoostFeatures"
+}
+
+func (req *GetChatBoostFeaturesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatBoostFeaturesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatBoostFeatures"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"is_channel":`)
+	encoder.writeBool(req.IsChannel)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatBoostFeaturesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of features available for different chat boost levels. This is an offline method
+//
+// Available to users only
+func (client *Client) GetChatBoostFeatures(ctx context.Context, req *GetChatBoostFeaturesRequest) (*ChatBoostFeatures, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatBoostFeatures)
+}
+
+type GetAvailableChatBoostSlotsRequest struct {
+	request
+}
+
+func (req GetAvailableChatBoostSlotsRequest) GetFunctionName() string {
+	return "getAvailableChatBoostSlots"
+}
+
+func (req *GetAvailableChatBoostSlotsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAvailableChatBoostSlotsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAvailableChatBoostSlots"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetAvailableChatBoostSlotsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of available chat boost slots for the current user
+//
+// Available to users only
+func (client *Client) GetAvailableChatBoostSlots(ctx context.Context) (*ChatBoostSlots, error) {
+	req := &GetAvailableChatBoostSlotsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatBoostSlots)
+}
+
+type GetChatBoostStatusRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatBoostStatusRequest) GetFunctionName() string {
+	return "getChatBoostStatus"
+}
+
+func (req *GetChatBoostStatusRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatBoostStatusRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatBoostStatus"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatBoostStatusRequest) Validate() error {
+	return nil
+}
+
+// Returns the current boost status for a supergroup or a channel chat
+//
+// Available to users only
+func (client *Client) GetChatBoostStatus(ctx context.Context, req *GetChatBoostStatusRequest) (*ChatBoostStatus, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatBoostStatus)
+}
+
+type BoostChatRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Identifiers of boost slots of the current user from which to apply boosts to the chat
+	SlotIds []int32 `json:"slot_ids"`
+}
+
+func (req BoostChatRequest) GetFunctionName() string {
+	return "boostChat"
+}
+
+func (req *BoostChatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *BoostChatRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"boostChat"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"slot_ids":`)
+	writeList(encoder, req.SlotIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (req BoostChatRequest) Validate() error {
+	return nil
+}
+
+// Boosts a chat and returns the list of available chat boost slots for the current user after the boost
+//
+// Available to users only
+func (client *Client) BoostChat(ctx context.Context, req *BoostChatRequest) (*ChatBoostSlots, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatBoostSlots)
+}
+
+type GetChatBoostLinkRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatBoostLinkRequest) GetFunctionName() string {
+	return "getChatBoostLink"
+}
+
+func (req *GetChatBoostLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatBoostLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatBoostLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatBoostLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS link to boost the specified supergroup or channel chat
+func (client *Client) GetChatBoostLink(ctx context.Context, req *GetChatBoostLinkRequest) (*ChatBoostLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatBoostLink)
+}
+
+type GetChatBoostLinkInfoRequest struct {
+	request
+	// The link to boost a chat
+	Url string `json:"url"`
+}
+
+func (req GetChatBoostLinkInfoRequest) GetFunctionName() string {
+	return "getChatBoostLinkInfo"
+}
+
+func (req *GetChatBoostLinkInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatBoostLinkInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatBoostLinkInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatBoostLinkInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a link to boost a chat. Can be called for any internal link of the type internalLinkTypeChatBoost
+func (client *Client) GetChatBoostLinkInfo(ctx context.Context, req *GetChatBoostLinkInfoRequest) (*ChatBoostLinkInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatBoostLinkInfo)
+}
+
+type GetChatBoostsRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Pass true to receive only boosts received from gift codes and giveaways created by the chat
+	OnlyGiftCodes bool `json:"only_gift_codes"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of boosts to be returned; up to 100. For optimal performance, the number of returned boosts can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatBoostsRequest) GetFunctionName() string {
+	return "getChatBoosts"
+}
+
+func (req *GetChatBoostsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatBoostsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatBoosts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"only_gift_codes":`)
+	encoder.writeBool(req.OnlyGiftCodes)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatBoostsRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the list of boosts applied to a chat; requires administrator rights in the chat
+//
+// Available to users only
+func (client *Client) GetChatBoosts(ctx context.Context, req *GetChatBoostsRequest) (*FoundChatBoosts, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundChatBoosts)
+}
+
+type GetUserChatBoostsRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetUserChatBoostsRequest) GetFunctionName() string {
+	return "getUserChatBoosts"
+}
+
+func (req *GetUserChatBoostsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUserChatBoostsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUserChatBoosts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetUserChatBoostsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of boosts applied to a chat by a given user; requires administrator rights in the chat; for bots only
+//
+// Available to bots only
+func (client *Client) GetUserChatBoosts(ctx context.Context, req *GetUserChatBoostsRequest) (*FoundChatBoosts, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundChatBoosts)
+}
+
+type GetUpgradedGiftEmojiStatusesRequest struct {
+	request
+}
+
+func (req GetUpgradedGiftEmojiStatusesRequest) GetFunctionName() string {
+	return "getUpgradedGiftEmojiStatuses"
+}
+
+func (req *GetUpgradedGiftEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUpgradedGiftEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUpgradedGiftEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetUpgradedGiftEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Returns available upgraded gift emoji statuses for self status
+//
+// Available to users only
+func (client *Client) GetUpgradedGiftEmojiStatuses(ctx context.Context) (*EmojiStatuses, error) {
+	req := &GetUpgradedGiftEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiStatuses)
+}
+
+type CreateChatSubscriptionInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link name; 0-32 characters
+	Name string `json:"name"`
+	// Information about subscription plan that will be applied to the users joining the chat via the link. Subscription period must be 2592000 in production environment, and 60 or 300 if Telegram test environment is used
+	SubscriptionPricing *StarSubscriptionPricing `json:"subscription_pricing"`
+}
+
+func (req CreateChatSubscriptionInviteLinkRequest) GetFunctionName() string {
+	return "createChatSubscriptionInviteLink"
+}
+
+func (req *CreateChatSubscriptionInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateChatSubscriptionInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createChatSubscriptionInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"subscription_pricing":`)
+	req.SubscriptionPricing.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req CreateChatSubscriptionInviteLinkRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a new subscription invite link for a channel chat. Requires can_invite_users right in the chat
+func (client *Client) CreateChatSubscriptionInviteLink(ctx context.Context, req *CreateChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLink)
+}
+
+type EditChatSubscriptionInviteLinkRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Invite link to be edited
+	InviteLink string `json:"invite_link"`
+	// Invite link name; 0-32 characters
+	Name string `json:"name"`
+}
+
+func (req EditChatSubscriptionInviteLinkRequest) GetFunctionName() string {
+	return "editChatSubscriptionInviteLink"
+}
+
+func (req *EditChatSubscriptionInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditChatSubscriptionInviteLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editChatSubscriptionInviteLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(req.InviteLink)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req EditChatSubscriptionInviteLinkRequest) Validate() error {
+	if err := validateLength("name", req.Name, 0, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Edits a subscription invite link for a channel chat. Requires can_invite_users right in the chat for own links and owner privileges for other links
+func (client *Client) EditChatSubscriptionInviteLink(ctx context.Context, req *EditChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatInviteLink)
+}
+
+type GetPremiumStickersRequest struct {
+	request
+	// The maximum number of stickers to be returned; 0-100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetPremiumStickersRequest) GetFunctionName() string {
+	return "getPremiumStickers"
+}
+
+func (req *GetPremiumStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns premium stickers from regular sticker sets
+//
+// Available to users only
+func (client *Client) GetPremiumStickers(ctx context.Context, req *GetPremiumStickersRequest) (*Stickers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type SetSupergroupUnrestrictBoostCountRequest struct {
+	request
+	// Identifier of the supergroup
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of the unrestrict_boost_count supergroup setting; 0-8. Use 0 to remove the setting
+	UnrestrictBoostCount int32 `json:"unrestrict_boost_count"`
+}
+
+func (req SetSupergroupUnrestrictBoostCountRequest) GetFunctionName() string {
+	return "setSupergroupUnrestrictBoostCount"
+}
+
+func (req *SetSupergroupUnrestrictBoostCountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetSupergroupUnrestrictBoostCountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setSupergroupUnrestrictBoostCount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"unrestrict_boost_count":`)
+	encoder.writeInt32(req.UnrestrictBoostCount)
+	encoder.writeRaw("}")
+}
+
+func (req SetSupergroupUnrestrictBoostCountRequest) Validate() error {
+	return nil
+}
+
+// Changes the number of times the supergroup must be boosted by a user to ignore slow mode and chat permission restrictions; requires can_restrict_members administrator right
+func (client *Client) SetSupergroupUnrestrictBoostCount(ctx context.Context, req *SetSupergroupUnrestrictBoostCountRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetPaymentFormRequest struct {
+	request
+	// The invoice
+	InputInvoice InputInvoice `json:"input_invoice"`
+	// Preferred payment form theme; pass null to use the default theme
+	Theme *ThemeParameters `json:"theme,omitempty"`
+}
+
+func (req GetPaymentFormRequest) GetFunctionName() string {
+	return "getPaymentForm"
+}
+
+func (req *GetPaymentFormRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPaymentFormRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPaymentForm"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"input_invoice":`)
+	encoder.writeType(req.InputInvoice)
+	if req.Theme != nil {
+		encoder.writeRaw(`,"theme":`)
+		req.Theme.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req GetPaymentFormRequest) Validate() error {
+	if err := validateNested("input_invoice", req.InputInvoice); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns an invoice payment form. This method must be called when the user presses inline button of the type inlineKeyboardButtonTypeBuy, or wants to buy access to media in a messagePaidMedia message
+//
+// Available to users only
+func (client *Client) GetPaymentForm(ctx context.Context, req *GetPaymentFormRequest) (*PaymentForm, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPaymentForm)
+}
+
+type SendPaymentFormRequest struct {
+	request
+	// The invoice
+	InputInvoice InputInvoice `json:"input_invoice"`
+	// Payment form identifier returned by getPaymentForm
+	PaymentFormId JsonInt64 `json:"payment_form_id"`
+	// Identifier returned by validateOrderInfo, or an empty string
+	OrderInfoId string `json:"order_info_id"`
+	// Identifier of a chosen shipping option, if applicable
+	ShippingOptionId string `json:"shipping_option_id"`
+	// The credentials chosen by user for payment; pass null for a payment in Telegram Stars
+	Credentials InputCredentials `json:"credentials,omitempty"`
+	// Chosen by the user amount of tip in the smallest units of the currency
+	TipAmount int64 `json:"tip_amount"`
+}
+
+func (req SendPaymentFormRequest) GetFunctionName() string {
+	return "sendPaymentForm"
+}
+
+func (req *SendPaymentFormRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendPaymentFormRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendPaymentForm"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"input_invoice":`)
+	encoder.writeType(req.InputInvoice)
+	encoder.writeRaw(`,"payment_form_id":`)
+	encoder.writeJsonInt64(req.PaymentFormId)
+	encoder.writeRaw(`,"order_info_id":`)
+	encoder.writeString(req.OrderInfoId)
+	encoder.writeRaw(`,"shipping_option_id":`)
+	encoder.writeString(req.ShippingOptionId)
+	if req.Credentials != nil {
+		encoder.writeRaw(`,"credentials":`)
+		encoder.writeType(req.Credentials)
+	}
+	encoder.writeRaw(`,"tip_amount":`)
+	encoder.writeInt64(req.TipAmount)
+	encoder.writeRaw("}")
+}
+
+func (req SendPaymentFormRequest) Validate() error {
+	if err := validateNested("input_invoice", req.InputInvoice); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sends a filled-out payment form to the bot for final verification
+//
+// Available to users only
+func (client *Client) SendPaymentForm(ctx context.Context, req *SendPaymentFormRequest) (*PaymentResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPaymentResult)
+}
+
+type GetPaymentReceiptRequest struct {
+	request
+	// Chat identifier of the messagePaymentSuccessful message
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetPaymentReceiptRequest) GetFunctionName() string {
+	return "getPaymentReceipt"
+}
+
+func (req *GetPaymentReceiptRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPaymentReceiptRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPaymentReceipt"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetPaymentReceiptRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a successful payment
+//
+// Available to users only
+func (client *Client) GetPaymentReceipt(ctx context.Context, req *GetPaymentReceiptRequest) (*PaymentReceipt, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPaymentReceipt)
+}
+
+type SetGiftSettingsRequest struct {
+	request
+	// The new settings
+	Settings *GiftSettings `json:"settings"`
+}
+
+func (req SetGiftSettingsRequest) GetFunctionName() string {
+	return "setGiftSettings"
+}
+
+func (req *SetGiftSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetGiftSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setGiftSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"settings":`)
+	req.Settings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetGiftSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes settings for gift receiving for the current user
+//
+// Available to users only
+func (client *Client) SetGiftSettings(ctx context.Context, req *SetGiftSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetAvailableGiftsRequest struct {
+	request
+}
+
+func (req GetAvailableGiftsRequest) GetFunctionName() string {
+	return "getAvailableGifts"
+}
+
+func (req *GetAvailableGiftsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAvailableGiftsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAvailableGifts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetAvailableGiftsRequest) Validate() error {
+	return nil
+}
+
+// Returns gifts that can be sent to other users and channel chats
+func (client *Client) GetAvailableGifts(ctx context.Context) (*Gifts, error) {
+	req := &GetAvailableGiftsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGifts)
+}
+
+type SendGiftRequest struct {
+	request
+	// Identifier of the gift to send
+	GiftId JsonInt64 `json:"gift_id"`
+	// Identifier of the user or the channel chat that will receive the gift
+	OwnerId MessageSender `json:"owner_id"`
+	// Text to show along with the gift; 0-getOption("gift_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities are allowed. Must be empty if the receiver enabled paid messages
+	Text *FormattedText `json:"text"`
+	// Pass true to show gift text and sender only to the gift receiver; otherwise, everyone will be able to see them
+	IsPrivate bool `json:"is_private"`
+	// Pass true to additionally pay for the gift upgrade and allow the receiver to upgrade it for free
+	PayForUpgrade bool `json:"pay_for_upgrade"`
+}
+
+func (req SendGiftRequest) GetFunctionName() string {
+	return "sendGift"
+}
+
+func (req *SendGiftRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendGiftRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendGift"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"gift_id":`)
+	encoder.writeJsonInt64(req.GiftId)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(req.OwnerId)
+	encoder.writeRaw(`,"text":`)
+	req.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_private":`)
+	encoder.writeBool(req.IsPrivate)
+	encoder.writeRaw(`,"pay_for_upgrade":`)
+	encoder.writeBool(req.PayForUpgrade)
+	encoder.writeRaw("}")
+}
+
+func (req SendGiftRequest) Validate() error {
+	return nil
+}
+
+// Sends a gift to another user or channel chat. May return an error with a message "STARGIFT_USAGE_LIMITED" if the gift was sold out
+func (client *Client) SendGift(ctx context.Context, req *SendGiftRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SellGiftRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which to send the request; for bots only
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Identifier of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+}
+
+func (req SellGiftRequest) GetFunctionName() string {
+	return "sellGift"
+}
+
+func (req *SellGiftRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SellGiftRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sellGift"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(req.ReceivedGiftId)
+	encoder.writeRaw("}")
+}
+
+func (req SellGiftRequest) Validate() error {
+	return nil
+}
+
+// Sells a gift for Telegram Stars
+func (client *Client) SellGift(ctx context.Context, req *SellGiftRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleGiftIsSavedRequest struct {
+	request
+	// Identifier of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+	// Pass true to display the gift on the user's or the channel's profile page; pass false to remove it from the profile page
+	IsSaved bool `json:"is_saved"`
+}
+
+func (req ToggleGiftIsSavedRequest) GetFunctionName() string {
+	return "toggleGiftIsSaved"
+}
+
+func (req *ToggleGiftIsSavedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleGiftIsSavedRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleGiftIsSaved"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(req.ReceivedGiftId)
+	encoder.writeRaw(`,"is_saved":`)
+	encoder.writeBool(req.IsSaved)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleGiftIsSavedRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a gift is shown on the current user's or the channel's profile page; requires can_post_messages administrator right in the channel chat
+//
+// Available to users only
+func (client *Client) ToggleGiftIsSaved(ctx context.Context, req *ToggleGiftIsSavedRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetPinnedGiftsRequest struct {
+	request
+	// Identifier of the user or the channel chat that received the gifts
+	OwnerId MessageSender `json:"owner_id"`
+	// New list of pinned gifts. All gifts must be upgraded and saved on the profile page first. There can be up to getOption("pinned_gift_count_max") pinned gifts
+	ReceivedGiftIds []string `json:"received_gift_ids"`
+}
+
+func (req SetPinnedGiftsRequest) GetFunctionName() string {
+	return "setPinnedGifts"
+}
+
+func (req *SetPinnedGiftsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetPinnedGiftsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setPinnedGifts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(req.OwnerId)
+	encoder.writeRaw(`,"received_gift_ids":`)
+	writeList(encoder, req.ReceivedGiftIds, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req SetPinnedGiftsRequest) Validate() error {
+	return nil
+}
+
+// Changes the list of pinned gifts on the current user's or the channel's profile page; requires can_post_messages administrator right in the channel chat
+//
+// Available to users only
+func (client *Client) SetPinnedGifts(ctx context.Context, req *SetPinnedGiftsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleChatGiftNotificationsRequest struct {
+	request
+	// Identifier of the channel chat
+	ChatId int64 `json:"chat_id"`
+	// Pass true to enable notifications about new gifts owned by the channel chat; pass false to disable the notifications
+	AreEnabled bool `json:"are_enabled"`
+}
+
+func (req ToggleChatGiftNotificationsRequest) GetFunctionName() string {
+	return "toggleChatGiftNotifications"
+}
+
+func (req *ToggleChatGiftNotificationsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleChatGiftNotificationsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleChatGiftNotifications"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"are_enabled":`)
+	encoder.writeBool(req.AreEnabled)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleChatGiftNotificationsRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether notifications for new gifts received by a channel chat are sent to the current user; requires can_post_messages administrator right in the chat
+//
+// Available to users only
+func (client *Client) ToggleChatGiftNotifications(ctx context.Context, req *ToggleChatGiftNotificationsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetGiftUpgradePreviewRequest struct {
+	request
+	// Identifier of the gift
+	GiftId JsonInt64 `json:"gift_id"`
+}
+
+func (req GetGiftUpgradePreviewRequest) GetFunctionName() string {
+	return "getGiftUpgradePreview"
+}
+
+func (req *GetGiftUpgradePreviewRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGiftUpgradePreviewRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGiftUpgradePreview"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"gift_id":`)
+	encoder.writeJsonInt64(req.GiftId)
+	encoder.writeRaw("}")
+}
+
+func (req GetGiftUpgradePreviewRequest) Validate() error {
+	return nil
+}
+
+// Returns examples of possible upgraded gifts for a regular gift
+//
+// Available to users only
+func (client *Client) GetGiftUpgradePreview(ctx context.Context, req *GetGiftUpgradePreviewRequest) (*GiftUpgradePreview, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGiftUpgradePreview)
+}
+
+type UpgradeGiftRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which to send the request; for bots only
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Identifier of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+	// Pass true to keep the original gift text, sender and receiver in the upgraded gift
+	KeepOriginalDetails bool `json:"keep_original_details"`
+	// The amount of Telegram Stars required to pay for the upgrade. It the gift has prepaid_upgrade_star_count > 0, then pass 0, otherwise, pass gift.upgrade_star_count
+	StarCount int64 `json:"star_count"`
+}
+
+func (req UpgradeGiftRequest) GetFunctionName() string {
+	return "upgradeGift"
+}
+
+func (req *UpgradeGiftRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *UpgradeGiftRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradeGift"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(req.ReceivedGiftId)
+	encoder.writeRaw(`,"keep_original_details":`)
+	encoder.writeBool(req.KeepOriginalDetails)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(req.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (req UpgradeGiftRequest) Validate() error {
+	return nil
+}
+
+// Upgrades a regular gift
+func (client *Client) UpgradeGift(ctx context.Context, req *UpgradeGiftRequest) (*UpgradeGiftResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUpgradeGiftResult)
+}
+
+type TransferGiftRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which to send the request; for bots only
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Identifier of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+	// Identifier of the user or the channel chat that will receive the gift
+	NewOwnerId MessageSender `json:"new_owner_id"`
+	// The amount of Telegram Stars required to pay for the transfer
+	StarCount int64 `json:"star_count"`
+}
+
+func (req TransferGiftRequest) GetFunctionName() string {
+	return "transferGift"
+}
+
+func (req *TransferGiftRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TransferGiftRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"transferGift"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(req.ReceivedGiftId)
+	encoder.writeRaw(`,"new_owner_id":`)
+	encoder.writeType(req.NewOwnerId)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(req.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (req TransferGiftRequest) Validate() error {
+	return nil
+}
+
+// Sends an upgraded gift to another user or a channel chat
+func (client *Client) TransferGift(ctx context.Context, req *TransferGiftRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetReceivedGiftsRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which to send the request; for bots only
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Identifier of the gift receiver
+	OwnerId MessageSender `json:"owner_id"`
+	// Pass true to exclude gifts that aren't saved to the chat's profile page. Always true for gifts received by other users and channel chats without can_post_messages administrator right
+	ExcludeUnsaved bool `json:"exclude_unsaved"`
+	// Pass true to exclude gifts that are saved to the chat's profile page. Always false for gifts received by other users and channel chats without can_post_messages administrator right
+	ExcludeSaved bool `json:"exclude_saved"`
+	// Pass true to exclude gifts that can be purchased unlimited number of times
+	ExcludeUnlimited bool `json:"exclude_unlimited"`
+	// Pass true to exclude gifts that can be purchased limited number of times
+	ExcludeLimited bool `json:"exclude_limited"`
+	// Pass true to exclude upgraded gifts
+	ExcludeUpgraded bool `json:"exclude_upgraded"`
+	// Pass true to sort results by gift price instead of send date
+	SortByPrice bool `json:"sort_by_price"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of gifts to be returned; must be positive and can't be greater than 100. For optimal performance, the number of returned objects is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetReceivedGiftsRequest) GetFunctionName() string {
+	return "getReceivedGifts"
+}
+
+func (req *GetReceivedGiftsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetReceivedGiftsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getReceivedGifts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(req.OwnerId)
+	encoder.writeRaw(`,"exclude_unsaved":`)
+	encoder.writeBool(req.ExcludeUnsaved)
+	encoder.writeRaw(`,"exclude_saved":`)
+	encoder.writeBool(req.ExcludeSaved)
+	encoder.writeRaw(`,"exclude_unlimited":`)
+	encoder.writeBool(req.ExcludeUnlimited)
+	encoder.writeRaw(`,"exclude_limited":`)
+	encoder.writeBool(req.ExcludeLimited)
+	encoder.writeRaw(`,"exclude_upgraded":`)
+	encoder.writeBool(req.ExcludeUpgraded)
+	encoder.writeRaw(`,"sort_by_price":`)
+	encoder.writeBool(req.SortByPrice)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetReceivedGiftsRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns gifts received by the given user or chat
+func (client *Client) GetReceivedGifts(ctx context.Context, req *GetReceivedGiftsRequest) (*ReceivedGifts, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalReceivedGifts)
+}
+
+type GetReceivedGiftRequest struct {
+	request
+	// Identifier of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+}
+
+func (req GetReceivedGiftRequest) GetFunctionName() string {
+	return "getReceivedGift"
+}
+
+func (req *GetReceivedGiftRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetReceivedGiftRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getReceivedGift"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(req.ReceivedGiftId)
+	encoder.writeRaw("}")
+}
+
+func (req GetReceivedGiftRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a received gift
+//
+// Available to users only
+func (client *Client) GetReceivedGift(ctx context.Context, req *GetReceivedGiftRequest) (*ReceivedGift, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalReceivedGift)
+}
+
+type GetUpgradedGiftRequest struct {
+	request
+	// Unique name of the upgraded gift
+	Name string `json:"name"`
+}
+
+func (req GetUpgradedGiftRequest) GetFunctionName() string {
+	return "getUpgradedGift"
+}
+
+func (req *GetUpgradedGiftRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUpgradedGiftRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUpgradedGift"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req GetUpgradedGiftRequest) Validate() error {
+	return nil
+}
+
+// Returns information about an upgraded gift by its name
+//
+// Available to users only
+func (client *Client) GetUpgradedGift(ctx context.Context, req *GetUpgradedGiftRequest) (*UpgradedGift, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUpgradedGift)
+}
+
+type GetUpgradedGiftWithdrawalUrlRequest struct {
+	request
+	// Identifier of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req GetUpgradedGiftWithdrawalUrlRequest) GetFunctionName() string {
+	return "getUpgradedGiftWithdrawalUrl"
+}
+
+func (req *GetUpgradedGiftWithdrawalUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUpgradedGiftWithdrawalUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUpgradedGiftWithdrawalUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(req.ReceivedGiftId)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req GetUpgradedGiftWithdrawalUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns a URL for upgraded gift withdrawal in the TON blockchain as an NFT; requires owner privileges for gifts owned by a chat
+//
+// Available to users only
+func (client *Client) GetUpgradedGiftWithdrawalUrl(ctx context.Context, req *GetUpgradedGiftWithdrawalUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type CreateInvoiceLinkRequest struct {
+	request
+	// Unique identifier of business connection on behalf of which to send the request
+	BusinessConnectionId string `json:"business_connection_id"`
+	// Information about the invoice of the type inputMessageInvoice
+	Invoice InputMessageContent `json:"invoice"`
+}
+
+func (req CreateInvoiceLinkRequest) GetFunctionName() string {
+	return "createInvoiceLink"
+}
+
+func (req *CreateInvoiceLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateInvoiceLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createInvoiceLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"business_connection_id":`)
+	encoder.writeString(req.BusinessConnectionId)
+	encoder.writeRaw(`,"invoice":`)
+	encoder.writeType(req.Invoice)
+	encoder.writeRaw("}")
+}
+
+func (req CreateInvoiceLinkRequest) Validate() error {
+	if err := validateNested("invoice", req.Invoice); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a link for the given invoice; for bots only
+//
+// Available to bots only
+func (client *Client) CreateInvoiceLink(ctx context.Context, req *CreateInvoiceLinkRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type RefundStarPaymentRequest struct {
+	request
+	// Identifier of the user that did the payment
+	UserId int64 `json:"user_id"`
+	// Telegram payment identifier
+	TelegramPaymentChargeId string `json:"telegram_payment_charge_id"`
+}
+
+func (req RefundStarPaymentRequest) GetFunctionName() string {
+	return "refundStarPayment"
+}
+
+func (req *RefundStarPaymentRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RefundStarPaymentRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"refundStarPayment"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"telegram_payment_charge_id":`)
+	encoder.writeString(req.TelegramPaymentChargeId)
+	encoder.writeRaw("}")
+}
+
+func (req RefundStarPaymentRequest) Validate() error {
+	return nil
+}
+
+// Refunds a previously done payment in Telegram Stars; for bots only
+//
+// Available to bots only
+func (client *Client) RefundStarPayment(ctx context.Context, req *RefundStarPaymentRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetPaidMessageRevenueRequest struct {
+	request
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetPaidMessageRevenueRequest) GetFunctionName() string {
+	return "getPaidMessageRevenue"
+}
+
+func (req *GetPaidMessageRevenueRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPaidMessageRevenueRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPaidMessageRevenue"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetPaidMessageRevenueRequest) Validate() error {
+	return nil
+}
+
+// Returns the total number of Telegram Stars received by the current user for paid messages from the given user
+//
+// Available to users only
+func (client *Client) GetPaidMessageRevenue(ctx context.Context, req *GetPaidMessageRevenueRequest) (*StarCount, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarCount)
+}
+
+type SetChatPaidMessageStarCountRequest struct {
+	request
+	// Identifier of the supergroup chat
+	ChatId int64 `json:"chat_id"`
+	// The new number of Telegram Stars that must be paid for each message that is sent to the supergroup chat unless the sender is an administrator of the chat; 0-getOption("paid_message_star_count_max"). The supergroup will receive getOption("paid_message_earnings_per_mille") Telegram Stars for each 1000 Telegram Stars paid for message sending
+	PaidMessageStarCount int64 `json:"paid_message_star_count"`
+}
+
+func (req SetChatPaidMessageStarCountRequest) GetFunctionName() string {
+	return "setChatPaidMessageStarCount"
+}
+
+func (req *SetChatPaidMessageStarCountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatPaidMessageStarCountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatPaidMessageStarCount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"paid_message_star_count":`)
+	encoder.writeInt64(req.PaidMessageStarCount)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatPaidMessageStarCountRequest) Validate() error {
+	return nil
+}
+
+// Changes the amount of Telegram Stars that must be paid to send a message to a supergroup chat; requires can_restrict_members administrator right and supergroupFullInfo.can_enable_paid_messages
+//
+// Available to users only
+func (client *Client) SetChatPaidMessageStarCount(ctx context.Context, req *SetChatPaidMessageStarCountRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatRevenueStatisticsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Pass true if a dark theme is used by the application
+	IsDark bool `json:"is_dark"`
+}
+
+func (req GetChatRevenueStatisticsRequest) GetFunctionName() string {
+	return "getChatRevenueStatistics"
+}
+
+func (req *GetChatRevenueStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatRevenueStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatRevenueStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(req.IsDark)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatRevenueStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns detailed revenue statistics about a chat. Currently, this method can be used only for channels if supergroupFullInfo.can_get_revenue_statistics == true or bots if userFullInfo.bot_info.can_get_revenue_statistics == true
+//
+// Available to users only
+func (client *Client) GetChatRevenueStatistics(ctx context.Context, req *GetChatRevenueStatisticsRequest) (*ChatRevenueStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatRevenueStatistics)
+}
+
+type GetChatRevenueWithdrawalUrlRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req GetChatRevenueWithdrawalUrlRequest) GetFunctionName() string {
+	return "getChatRevenueWithdrawalUrl"
+}
+
+func (req *GetChatRevenueWithdrawalUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatRevenueWithdrawalUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatRevenueWithdrawalUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatRevenueWithdrawalUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns a URL for chat revenue withdrawal; requires owner privileges in the channel chat or the bot. Currently, this method can be used only if getOption("can_withdraw_chat_revenue") for channels with supergroupFullInfo.can_get_revenue_statistics == true or bots with userFullInfo.bot_info.can_get_revenue_statistics == true
+//
+// Available to users only
+func (client *Client) GetChatRevenueWithdrawalUrl(ctx context.Context, req *GetChatRevenueWithdrawalUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type GetChatRevenueTransactionsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Number of transactions to skip
+	Offset int32 `json:"offset"`
+	// The maximum number of transactions to be returned; up to 200
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatRevenueTransactionsRequest) GetFunctionName() string {
+	return "getChatRevenueTransactions"
+}
+
+func (req *GetChatRevenueTransactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatRevenueTransactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatRevenueTransactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatRevenueTransactionsRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 200); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the list of revenue transactions for a chat. Currently, this method can be used only for channels if supergroupFullInfo.can_get_revenue_statistics == true or bots if userFullInfo.bot_info.can_get_revenue_statistics == true
+//
+// Available to users only
+func (client *Client) GetChatRevenueTransactions(ctx context.Context, req *GetChatRevenueTransactionsRequest) (*ChatRevenueTransactions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatRevenueTransactions)
+}
+
+type GetStarRevenueStatisticsRequest struct {
+	request
+	// Identifier of the owner of the Telegram Stars; can be identifier of the current user, an owned bot, or a supergroup or a channel chat with supergroupFullInfo.can_get_star_revenue_statistics == true
+	OwnerId MessageSender `json:"owner_id"`
+	// Pass true if a dark theme is used by the application
+	IsDark bool `json:"is_dark"`
+}
+
+func (req GetStarRevenueStatisticsRequest) GetFunctionName() string {
+	return "getStarRevenueStatistics"
+}
+
+func (req *GetStarRevenueStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarRevenueStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarRevenueStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(req.OwnerId)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(req.IsDark)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarRevenueStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns detailed Telegram Star revenue statistics
+//
+// Available to users only
+func (client *Client) GetStarRevenueStatistics(ctx context.Context, req *GetStarRevenueStatisticsRequest) (*StarRevenueStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarRevenueStatistics)
+}
+
+type GetStarWithdrawalUrlRequest struct {
+	request
+	// Identifier of the owner of the Telegram Stars; can be identifier of the current user, an owned bot, or an owned supergroup or channel chat
+	OwnerId MessageSender `json:"owner_id"`
+	// The number of Telegram Stars to withdraw. Must be at least getOption("star_withdrawal_count_min")
+	StarCount int64 `json:"star_count"`
+	// The 2-step verification password of the current user
+	Password string `json:"password"`
+}
+
+func (req GetStarWithdrawalUrlRequest) GetFunctionName() string {
+	return "getStarWithdrawalUrl"
+}
+
+func (req *GetStarWithdrawalUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarWithdrawalUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarWithdrawalUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(req.OwnerId)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(req.StarCount)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(req.Password)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarWithdrawalUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns a URL for Telegram Star withdrawal
+//
+// Available to users only
+func (client *Client) GetStarWithdrawalUrl(ctx context.Context, req *GetStarWithdrawalUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type GetStarAdAccountUrlRequest struct {
+	request
+	// Identifier of the owner of the Telegram Stars; can be identifier of an owned bot, or identifier of an owned channel chat
+	OwnerId MessageSender `json:"owner_id"`
+}
+
+func (req GetStarAdAccountUrlRequest) GetFunctionName() string {
+	return "getStarAdAccountUrl"
+}
+
+func (req *GetStarAdAccountUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarAdAccountUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarAdAccountUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(req.OwnerId)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarAdAccountUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns a URL for a Telegram Ad platform account that can be used to set up advertisements for the chat paid in the owned Telegram Stars
+//
+// Available to users only
+func (client *Client) GetStarAdAccountUrl(ctx context.Context, req *GetStarAdAccountUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type GetPremiumLimitRequest struct {
+	request
+	// Type of the limit
+	LimitType PremiumLimitType `json:"limit_type"`
+}
+
+func (req GetPremiumLimitRequest) GetFunctionName() string {
+	return "getPremiumLimit"
+}
+
+func (req *GetPremiumLimitRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumLimitRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumLimit"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"limit_type":`)
+	encoder.writeType(req.LimitType)
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumLimitRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a limit, increased for Premium users. Returns a 404 error if the limit is unknown
+//
+// Available to users only
+func (client *Client) GetPremiumLimit(ctx context.Context, req *GetPremiumLimitRequest) (*PremiumLimit, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPremiumLimit)
+}
+
+type GetPremiumFeaturesRequest struct {
+	request
+	// Source of the request; pass null if the method is called from some non-standard source
+	Source PremiumSource `json:"source,omitempty"`
+}
+
+func (req GetPremiumFeaturesRequest) GetFunctionName() string {
+	return "getPremiumFeatures"
+}
+
+func (req *GetPremiumFeaturesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumFeaturesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumFeatures"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Source != nil {
+		encoder.writeRaw(`,"source":`)
+		encoder.writeType(req.Source)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumFeaturesRequest) Validate() error {
+	return nil
+}
+
+// Returns information about features, available to Premium users
+//
+// Available to users only
+func (client *Client) GetPremiumFeatures(ctx context.Context, req *GetPremiumFeaturesRequest) (*PremiumFeatures, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPremiumFeatures)
+}
+
+type GetPremiumStickerExamplesRequest struct {
+	request
+}
+
+func (req GetPremiumStickerExamplesRequest) GetFunctionName() string {
+	return "getPremiumStickerExamples"
+}
+
+func (req *GetPremiumStickerExamplesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumStickerExamplesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumStickerExamples"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumStickerExamplesRequest) Validate() error {
+	return nil
+}
+
+// Returns examples of premium stickers for demonstration purposes
+//
+// Available to users only
+func (client *Client) GetPremiumStickerExamples(ctx context.Context) (*Stickers, error) {
+	req := &GetPremiumStickerExamplesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetPremiumInfoStickerRequest struct {
+	request
+	// Number of months the Telegram Premium subscription will be active
+	MonthCount int32 `json:"month_count"`
+}
+
+func (req GetPremiumInfoStickerRequest) GetFunctionName() string {
+	return "getPremiumInfoSticker"
+}
+
+func (req *GetPremiumInfoStickerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumInfoStickerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumInfoSticker"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(req.MonthCount)
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumInfoStickerRequest) Validate() error {
+	return nil
+}
+
+// Returns the sticker to be used as representation of the Telegram Premium subscription
+//
+// Available to users only
+func (client *Client) GetPremiumInfoSticker(ctx context.Context, req *GetPremiumInfoStickerRequest) (*Sticker, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSticker)
+}
+
+type ViewPremiumFeatureRequest struct {
+	request
+	// The viewed premium feature
+	Feature PremiumFeature `json:"feature"`
+}
+
+func (req ViewPremiumFeatureRequest) GetFunctionName() string {
+	return "viewPremiumFeature"
+}
+
+func (req *ViewPremiumFeatureRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ViewPremiumFeatureRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"viewPremiumFeature"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"feature":`)
+	encoder.writeType(req.Feature)
+	encoder.writeRaw("}")
+}
+
+func (req ViewPremiumFeatureRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the user viewed detailed information about a Premium feature on the Premium features screen
+//
+// Available to users only
+func (client *Client) ViewPremiumFeature(ctx context.Context, req *ViewPremiumFeatureRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ClickPremiumSubscriptionButtonRequest struct {
+	request
+}
+
+func (req ClickPremiumSubscriptionButtonRequest) GetFunctionName() string {
+	return "clickPremiumSubscriptionButton"
+}
+
+func (req *ClickPremiumSubscriptionButtonRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClickPremiumSubscriptionButtonRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clickPremiumSubscriptionButton"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ClickPremiumSubscriptionButtonRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that the user clicked Premium subscription button on the Premium features screen
+//
+// Available to users only
+func (client *Client) ClickPremiumSubscriptionButton(ctx context.Context) (*Ok, error) {
+	req := &ClickPremiumSubscriptionButtonRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetPremiumStateRequest struct {
+	request
+}
+
+func (req GetPremiumStateRequest) GetFunctionName() string {
+	return "getPremiumState"
+}
+
+func (req *GetPremiumStateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumStateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumState"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumStateRequest) Validate() error {
+	return nil
+}
+
+// Returns state of Telegram Premium subscription and promotion videos for Premium features
+//
+// Available to users only
+func (client *Client) GetPremiumState(ctx context.Context) (*PremiumState, error) {
+	req := &GetPremiumStateRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPremiumState)
+}
+
+type GetPremiumGiftPaymentOptionsRequest struct {
+	request
+}
+
+func (req GetPremiumGiftPaymentOptionsRequest) GetFunctionName() string {
+	return "getPremiumGiftPaymentOptions"
+}
+
+func (req *GetPremiumGiftPaymentOptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumGiftPaymentOptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumGiftPaymentOptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumGiftPaymentOptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns available options for gifting Telegram Premium to a user
+//
+// Available to users only
+func (client *Client) GetPremiumGiftPaymentOptions(ctx context.Context) (*PremiumGiftPaymentOptions, error) {
+	req := &GetPremiumGiftPaymentOptionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPremiumGiftPaymentOptions)
+}
+
+type GetPremiumGiveawayPaymentOptionsRequest struct {
+	request
+	// Identifier of the supergroup or channel chat, which will be automatically boosted by receivers of the gift codes and which is administered by the user
+	BoostedChatId int64 `json:"boosted_chat_id"`
+}
+
+func (req GetPremiumGiveawayPaymentOptionsRequest) GetFunctionName() string {
+	return "getPremiumGiveawayPaymentOptions"
+}
+
+func (req *GetPremiumGiveawayPaymentOptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetPremiumGiveawayPaymentOptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getPremiumGiveawayPaymentOptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"boosted_chat_id":`)
+	encoder.writeInt64(req.BoostedChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetPremiumGiveawayPaymentOptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns available options for creating of Telegram Premium giveaway or manual distribution of Telegram Premium among chat members
+//
+// Available to users only
+func (client *Client) GetPremiumGiveawayPaymentOptions(ctx context.Context, req *GetPremiumGiveawayPaymentOptionsRequest) (*PremiumGiveawayPaymentOptions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPremiumGiveawayPaymentOptions)
+}
+
+type CheckPremiumGiftCodeRequest struct {
+	request
+	// The code to check
+	Code string `json:"code"`
+}
+
+func (req CheckPremiumGiftCodeRequest) GetFunctionName() string {
+	return "checkPremiumGiftCode"
+}
+
+func (req *CheckPremiumGiftCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckPremiumGiftCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkPremiumGiftCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req CheckPremiumGiftCodeRequest) Validate() error {
+	return nil
+}
+
+// Return information about a Telegram Premium gift code
+//
+// Available to users only
+func (client *Client) CheckPremiumGiftCode(ctx context.Context, req *CheckPremiumGiftCodeRequest) (*PremiumGiftCodeInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPremiumGiftCodeInfo)
+}
+
+type ApplyPremiumGiftCodeRequest struct {
+	request
+	// The code to apply
+	Code string `json:"code"`
+}
+
+func (req ApplyPremiumGiftCodeRequest) GetFunctionName() string {
+	return "applyPremiumGiftCode"
+}
+
+func (req *ApplyPremiumGiftCodeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ApplyPremiumGiftCodeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"applyPremiumGiftCode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(req.Code)
+	encoder.writeRaw("}")
+}
+
+func (req ApplyPremiumGiftCodeRequest) Validate() error {
+	return nil
+}
+
+// Applies a Telegram Premium gift code
+//
+// Available to users only
+func (client *Client) ApplyPremiumGiftCode(ctx context.Context, req *ApplyPremiumGiftCodeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GiftPremiumWithStarsRequest struct {
+	request
+	// Identifier of the user which will receive Telegram Premium
+	UserId int64 `json:"user_id"`
+	// The number of Telegram Stars to pay for subscription
+	StarCount int64 `json:"star_count"`
+	// Number of months the Telegram Premium subscription will be active for the user
+	MonthCount int32 `json:"month_count"`
+	// Text to show to the user receiving Telegram Premium; 0-getOption("gift_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities are allowed
+	Text *FormattedText `json:"text"`
+}
+
+func (req GiftPremiumWithStarsRequest) GetFunctionName() string {
+	return "giftPremiumWithStars"
+}
+
+func (req *GiftPremiumWithStarsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GiftPremiumWithStarsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giftPremiumWithStars"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(req.StarCount)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(req.MonthCount)
+	encoder.writeRaw(`,"text":`)
+	req.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req GiftPremiumWithStarsRequest) Validate() error {
+	return nil
+}
+
+// Allows to buy a Telegram Premium subscription for another user with payment in Telegram Stars; for bots only
+//
+// Available to bots only
+func (client *Client) GiftPremiumWithStars(ctx context.Context, req *GiftPremiumWithStarsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type LaunchPrepaidGiveawayRequest struct {
+	request
+	// Unique identifier of the prepaid giveaway
+	GiveawayId JsonInt64 `json:"giveaway_id"`
+	// Giveaway parameters
+	Parameters *GiveawayParameters `json:"parameters"`
+	// The number of users to receive giveaway prize
+	WinnerCount int32 `json:"winner_count"`
+	// The number of Telegram Stars to be distributed through the giveaway; pass 0 for Telegram Premium giveaways
+	StarCount int64 `json:"star_count"`
+}
+
+func (req LaunchPrepaidGiveawayRequest) GetFunctionName() string {
+	return "launchPrepaidGiveaway"
+}
+
+func (req *LaunchPrepaidGiveawayRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LaunchPrepaidGiveawayRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"launchPrepaidGiveaway"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"giveaway_id":`)
+	encoder.writeJsonInt64(req.GiveawayId)
+	encoder.writeRaw(`,"parameters":`)
+	req.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(req.WinnerCount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(req.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (req LaunchPrepaidGiveawayRequest) Validate() error {
+	if err := validateNested("parameters", req.Parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Launches a prepaid giveaway
+//
+// Available to users only
+func (client *Client) LaunchPrepaidGiveaway(ctx context.Context, req *LaunchPrepaidGiveawayRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetGiveawayInfoRequest struct {
+	request
+	// Identifier of the channel chat which started the giveaway
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the giveaway or a giveaway winners message in the chat
+	MessageId int64 `json:"message_id"`
+}
+
+func (req GetGiveawayInfoRequest) GetFunctionName() string {
+	return "getGiveawayInfo"
+}
+
+func (req *GetGiveawayInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGiveawayInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGiveawayInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req GetGiveawayInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a giveaway
+//
+// Available to users only
+func (client *Client) GetGiveawayInfo(ctx context.Context, req *GetGiveawayInfoRequest) (GiveawayInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalGiveawayInfo)
+}
+
+type GetStarPaymentOptionsRequest struct {
+	request
+}
+
+func (req GetStarPaymentOptionsRequest) GetFunctionName() string {
+	return "getStarPaymentOptions"
+}
+
+func (req *GetStarPaymentOptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarPaymentOptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarPaymentOptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarPaymentOptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns available options for Telegram Stars purchase
+//
+// Available to users only
+func (client *Client) GetStarPaymentOptions(ctx context.Context) (*StarPaymentOptions, error) {
+	req := &GetStarPaymentOptionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarPaymentOptions)
+}
+
+type GetStarGiftPaymentOptionsRequest struct {
+	request
+	// Identifier of the user that will receive Telegram Stars; pass 0 to get options for an unspecified user
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetStarGiftPaymentOptionsRequest) GetFunctionName() string {
+	return "getStarGiftPaymentOptions"
+}
+
+func (req *GetStarGiftPaymentOptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarGiftPaymentOptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarGiftPaymentOptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarGiftPaymentOptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns available options for Telegram Stars gifting
+//
+// Available to users only
+func (client *Client) GetStarGiftPaymentOptions(ctx context.Context, req *GetStarGiftPaymentOptionsRequest) (*StarPaymentOptions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarPaymentOptions)
+}
+
+type GetStarGiveawayPaymentOptionsRequest struct {
+	request
+}
+
+func (req GetStarGiveawayPaymentOptionsRequest) GetFunctionName() string {
+	return "getStarGiveawayPaymentOptions"
+}
+
+func (req *GetStarGiveawayPaymentOptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarGiveawayPaymentOptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarGiveawayPaymentOptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarGiveawayPaymentOptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns available options for Telegram Star giveaway creation
+//
+// Available to users only
+func (client *Client) GetStarGiveawayPaymentOptions(ctx context.Context) (*StarGiveawayPaymentOptions, error) {
+	req := &GetStarGiveawayPaymentOptionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarGiveawayPaymentOptions)
+}
+
+type GetStarTransactionsRequest struct {
+	request
+	// Identifier of the owner of the Telegram Stars; can be the identifier of the current user, identifier of an owned bot, or identifier of a supergroup or a channel chat with supergroupFullInfo.can_get_star_revenue_statistics == true
+	OwnerId MessageSender `json:"owner_id"`
+	// If non-empty, only transactions related to the Star Subscription will be returned
+	SubscriptionId string `json:"subscription_id"`
+	// Direction of the transactions to receive; pass null to get all transactions
+	Direction StarTransactionDirection `json:"direction,omitempty"`
+	// Offset of the first transaction to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of transactions to return
+	Limit int32 `json:"limit"`
+}
+
+func (req GetStarTransactionsRequest) GetFunctionName() string {
+	return "getStarTransactions"
+}
+
+func (req *GetStarTransactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarTransactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarTransactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(req.OwnerId)
+	encoder.writeRaw(`,"subscription_id":`)
+	encoder.writeString(req.SubscriptionId)
+	if req.Direction != nil {
+		encoder.writeRaw(`,"direction":`)
+		encoder.writeType(req.Direction)
+	}
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarTransactionsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of Telegram Star transactions for the specified owner
+func (client *Client) GetStarTransactions(ctx context.Context, req *GetStarTransactionsRequest) (*StarTransactions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarTransactions)
+}
+
+type GetStarSubscriptionsRequest struct {
+	request
+	// Pass true to receive only expiring subscriptions for which there are no enough Telegram Stars to extend
+	OnlyExpiring bool `json:"only_expiring"`
+	// Offset of the first subscription to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+}
+
+func (req GetStarSubscriptionsRequest) GetFunctionName() string {
+	return "getStarSubscriptions"
+}
+
+func (req *GetStarSubscriptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStarSubscriptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStarSubscriptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"only_expiring":`)
+	encoder.writeBool(req.OnlyExpiring)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw("}")
+}
+
+func (req GetStarSubscriptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of Telegram Star subscriptions for the current user
+//
+// Available to users only
+func (client *Client) GetStarSubscriptions(ctx context.Context, req *GetStarSubscriptionsRequest) (*StarSubscriptions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStarSubscriptions)
+}
+
+type AssignStoreTransactionRequest struct {
+	request
+	// Information about the transaction
+	Transaction StoreTransaction `json:"transaction"`
+	// Transaction purpose
+	Purpose StorePaymentPurpose `json:"purpose"`
+}
+
+func (req AssignStoreTransactionRequest) GetFunctionName() string {
+	return "assignStoreTransaction"
+}
+
+func (req *AssignStoreTransactionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AssignStoreTransactionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"assignStoreTransaction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"transaction":`)
+	encoder.writeType(req.Transaction)
+	encoder.writeRaw(`,"purpose":`)
+	encoder.writeType(req.Purpose)
+	encoder.writeRaw("}")
+}
+
+func (req AssignStoreTransactionRequest) Validate() error {
+	if err := validateNested("purpose", req.Purpose); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Informs server about an in-store purchase. For official applications only
+//
+// Available to users only
+func (client *Client) AssignStoreTransaction(ctx context.Context, req *AssignStoreTransactionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditStarSubscriptionRequest struct {
+	request
+	// Identifier of the subscription to change
+	SubscriptionId string `json:"subscription_id"`
+	// New value of is_canceled
+	IsCanceled bool `json:"is_canceled"`
+}
+
+func (req EditStarSubscriptionRequest) GetFunctionName() string {
+	return "editStarSubscription"
+}
+
+func (req *EditStarSubscriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditStarSubscriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editStarSubscription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"subscription_id":`)
+	encoder.writeString(req.SubscriptionId)
+	encoder.writeRaw(`,"is_canceled":`)
+	encoder.writeBool(req.IsCanceled)
+	encoder.writeRaw("}")
+}
+
+func (req EditStarSubscriptionRequest) Validate() error {
+	return nil
+}
+
+// Cancels or re-enables Telegram Star subscription
+//
+// Available to users only
+func (client *Client) EditStarSubscription(ctx context.Context, req *EditStarSubscriptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditUserStarSubscriptionRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Telegram payment identifier of the subscription
+	TelegramPaymentChargeId string `json:"telegram_payment_charge_id"`
+	// Pass true to cancel the subscription; pass false to allow the user to enable it
+	IsCanceled bool `json:"is_canceled"`
+}
+
+func (req EditUserStarSubscriptionRequest) GetFunctionName() string {
+	return "editUserStarSubscription"
+}
+
+func (req *EditUserStarSubscriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditUserStarSubscriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editUserStarSubscription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"telegram_payment_charge_id":`)
+	encoder.writeString(req.TelegramPaymentChargeId)
+	encoder.writeRaw(`,"is_canceled":`)
+	encoder.writeBool(req.IsCanceled)
+	encoder.writeRaw("}")
+}
+
+func (req EditUserStarSubscriptionRequest) Validate() error {
+	return nil
+}
+
+// Cancels or re-enables Telegram Star subscription for a user; for bots only
+//
+// Available to bots only
+func (client *Client) EditUserStarSubscription(ctx context.Context, req *EditUserStarSubscriptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReuseStarSubscriptionRequest struct {
+	request
+	// Identifier of the subscription
+	SubscriptionId string `json:"subscription_id"`
+}
+
+func (req ReuseStarSubscriptionRequest) GetFunctionName() string {
+	return "reuseStarSubscription"
+}
+
+func (req *ReuseStarSubscriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReuseStarSubscriptionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reuseStarSubscription"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"subscription_id":`)
+	encoder.writeString(req.SubscriptionId)
+	encoder.writeRaw("}")
+}
+
+func (req ReuseStarSubscriptionRequest) Validate() error {
+	return nil
+}
+
+// Reuses an active Telegram Star subscription to a channel chat and joins the chat again
+//
+// Available to users only
+func (client *Client) ReuseStarSubscription(ctx context.Context, req *ReuseStarSubscriptionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatAffiliateProgramRequest struct {
+	request
+	// Identifier of the chat with an owned bot for which affiliate program is changed
+	ChatId int64 `json:"chat_id"`
+	// Parameters of the affiliate program; pass null to close the currently active program. If there is an active program, then commission and program duration can only be increased. If the active program is scheduled to be closed, then it can't be changed anymore
+	Parameters *AffiliateProgramParameters `json:"parameters,omitempty"`
+}
+
+func (req SetChatAffiliateProgramRequest) GetFunctionName() string {
+	return "setChatAffiliateProgram"
+}
+
+func (req *SetChatAffiliateProgramRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatAffiliateProgramRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatAffiliateProgram"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	if req.Parameters != nil {
+		encoder.writeRaw(`,"parameters":`)
+		req.Parameters.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetChatAffiliateProgramRequest) Validate() error {
+	return nil
+}
+
+// Changes affiliate program for a bot
+//
+// Available to users only
+func (client *Client) SetChatAffiliateProgram(ctx context.Context, req *SetChatAffiliateProgramRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SearchChatAffiliateProgramRequest struct {
+	request
+	// Username of the chat
+	Username string `json:"username"`
+	// The referrer from an internalLinkTypeChatAffiliateProgram link
+	Referrer string `json:"referrer"`
+}
+
+func (req SearchChatAffiliateProgramRequest) GetFunctionName() string {
+	return "searchChatAffiliateProgram"
+}
+
+func (req *SearchChatAffiliateProgramRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchChatAffiliateProgramRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchChatAffiliateProgram"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(req.Username)
+	encoder.writeRaw(`,"referrer":`)
+	encoder.writeString(req.Referrer)
+	encoder.writeRaw("}")
+}
+
+func (req SearchChatAffiliateProgramRequest) Validate() error {
+	return nil
+}
+
+// Searches a chat with an affiliate program. Returns the chat if found and the program is active
+//
+// Available to users only
+func (client *Client) SearchChatAffiliateProgram(ctx context.Context, req *SearchChatAffiliateProgramRequest) (*Chat, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChat)
+}
+
+type SearchAffiliateProgramsRequest struct {
+	request
+	// The affiliate for which affiliate programs are searched for
+	Affiliate AffiliateType `json:"affiliate"`
+	// Sort order for the results
+	SortOrder AffiliateProgramSortOrder `json:"sort_order"`
+	// Offset of the first affiliate program to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of affiliate programs to return
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchAffiliateProgramsRequest) GetFunctionName() string {
+	return "searchAffiliatePrograms"
+}
+
+func (req *SearchAffiliateProgramsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchAffiliateProgramsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchAffiliatePrograms"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"affiliate":`)
+	encoder.writeType(req.Affiliate)
+	encoder.writeRaw(`,"sort_order":`)
+	encoder.writeType(req.SortOrder)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchAffiliateProgramsRequest) Validate() error {
+	return nil
+}
+
+// Searches affiliate programs that can be connected to the given affiliate
+//
+// Available to users only
+func (client *Client) SearchAffiliatePrograms(ctx context.Context, req *SearchAffiliateProgramsRequest) (*FoundAffiliatePrograms, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundAffiliatePrograms)
+}
+
+type ConnectAffiliateProgramRequest struct {
+	request
+	// The affiliate to which the affiliate program will be connected
+	Affiliate AffiliateType `json:"affiliate"`
+	// Identifier of the bot, which affiliate program is connected
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req ConnectAffiliateProgramRequest) GetFunctionName() string {
+	return "connectAffiliateProgram"
+}
+
+func (req *ConnectAffiliateProgramRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ConnectAffiliateProgramRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectAffiliateProgram"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"affiliate":`)
+	encoder.writeType(req.Affiliate)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req ConnectAffiliateProgramRequest) Validate() error {
+	return nil
+}
+
+// Connects an affiliate program to the given affiliate. Returns information about the connected affiliate program
+//
+// Available to users only
+func (client *Client) ConnectAffiliateProgram(ctx context.Context, req *ConnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalConnectedAffiliateProgram)
+}
+
+type DisconnectAffiliateProgramRequest struct {
+	request
+	// The affiliate to which the affiliate program is connected
+	Affiliate AffiliateType `json:"affiliate"`
+	// The referral link of the affiliate program
+	Url string `json:"url"`
+}
+
+func (req DisconnectAffiliateProgramRequest) GetFunctionName() string {
+	return "disconnectAffiliateProgram"
+}
+
+func (req *DisconnectAffiliateProgramRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DisconnectAffiliateProgramRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"disconnectAffiliateProgram"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"affiliate":`)
+	encoder.writeType(req.Affiliate)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(req.Url)
+	encoder.writeRaw("}")
+}
+
+func (req DisconnectAffiliateProgramRequest) Validate() error {
+	return nil
+}
+
+// Disconnects an affiliate program from the given affiliate and immediately deactivates its referral link. Returns updated information about the disconnected affiliate program
+//
+// Available to users only
+func (client *Client) DisconnectAffiliateProgram(ctx context.Context, req *DisconnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalConnectedAffiliateProgram)
+}
+
+type GetConnectedAffiliateProgramRequest struct {
+	request
+	// The affiliate to which the affiliate program will be connected
+	Affiliate AffiliateType `json:"affiliate"`
+	// Identifier of the bot that created the program
+	BotUserId int64 `json:"bot_user_id"`
+}
+
+func (req GetConnectedAffiliateProgramRequest) GetFunctionName() string {
+	return "getConnectedAffiliateProgram"
+}
+
+func (req *GetConnectedAffiliateProgramRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetConnectedAffiliateProgramRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getConnectedAffiliateProgram"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"affiliate":`)
+	encoder.writeType(req.Affiliate)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetConnectedAffiliateProgramRequest) Validate() error {
+	return nil
+}
+
+// Returns an affiliate program that were connected to the given affiliate by identifier of the bot that created the program
+//
+// Available to users only
+func (client *Client) GetConnectedAffiliateProgram(ctx context.Context, req *GetConnectedAffiliateProgramRequest) (*ConnectedAffiliateProgram, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalConnectedAffiliateProgram)
+}
+
+type GetConnectedAffiliateProgramsRequest struct {
+	request
+	// The affiliate to which the affiliate program were connected
+	Affiliate AffiliateType `json:"affiliate"`
+	// Offset of the first affiliate program to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of affiliate programs to return
+	Limit int32 `json:"limit"`
+}
+
+func (req GetConnectedAffiliateProgramsRequest) GetFunctionName() string {
+	return "getConnectedAffiliatePrograms"
+}
+
+func (req *GetConnectedAffiliateProgramsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetConnectedAffiliateProgramsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getConnectedAffiliatePrograms"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"affiliate":`)
+	encoder.writeType(req.Affiliate)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetConnectedAffiliateProgramsRequest) Validate() error {
+	return nil
+}
+
+// Returns affiliate programs that were connected to the given affiliate
+//
+// Available to users only
+func (client *Client) GetConnectedAffiliatePrograms(ctx context.Context, req *GetConnectedAffiliateProgramsRequest) (*ConnectedAffiliatePrograms, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalConnectedAffiliatePrograms)
+}
@@ -0,0 +1,3995 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type GetEmojiReactionRequest struct {
+	request
+	// Text representation of the reaction
+	Emoji string `json:"emoji"`
+}
+
+func (req GetEmojiReactionRequest) GetFunctionName() string {
+	return "getEmojiReaction"
+}
+
+func (req *GetEmojiReactionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetEmojiReactionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getEmojiReaction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(req.Emoji)
+	encoder.writeRaw("}")
+}
+
+func (req GetEmojiReactionRequest) Validate() error {
+	return nil
+}
+
+// Returns information about an emoji reaction. Returns a 404 error if the reaction is not found
+//
+// Available to users only
+func (client *Client) GetEmojiReaction(ctx context.Context, req *GetEmojiReactionRequest) (*EmojiReaction, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiReaction)
+}
+
+type GetCustomEmojiReactionAnimationsRequest struct {
+	request
+}
+
+func (req GetCustomEmojiReactionAnimationsRequest) GetFunctionName() string {
+	return "getCustomEmojiReactionAnimations"
+}
+
+func (req *GetCustomEmojiReactionAnimationsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCustomEmojiReactionAnimationsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCustomEmojiReactionAnimations"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetCustomEmojiReactionAnimationsRequest) Validate() error {
+	return nil
+}
+
+// Returns TGS stickers with generic animations for custom emoji reactions
+//
+// Available to users only
+func (client *Client) GetCustomEmojiReactionAnimations(ctx context.Context) (*Stickers, error) {
+	req := &GetCustomEmojiReactionAnimationsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetMessageAvailableReactionsRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Number of reaction per row, 5-25
+	RowSize int32 `json:"row_size"`
+}
+
+func (req GetMessageAvailableReactionsRequest) GetFunctionName() string {
+	return "getMessageAvailableReactions"
+}
+
+func (req *GetMessageAvailableReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageAvailableReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageAvailableReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"row_size":`)
+	encoder.writeInt32(req.RowSize)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageAvailableReactionsRequest) Validate() error {
+	return nil
+}
+
+// Returns reactions, which can be added to a message. The list can change after updateActiveEmojiReactions, updateChatAvailableReactions for the chat, or updateMessageInteractionInfo for the message
+//
+// Available to users only
+func (client *Client) GetMessageAvailableReactions(ctx context.Context, req *GetMessageAvailableReactionsRequest) (*AvailableReactions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAvailableReactions)
+}
+
+type ClearRecentReactionsRequest struct {
+	request
+}
+
+func (req ClearRecentReactionsRequest) GetFunctionName() string {
+	return "clearRecentReactions"
+}
+
+func (req *ClearRecentReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearRecentReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearRecentReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ClearRecentReactionsRequest) Validate() error {
+	return nil
+}
+
+// Clears the list of recently used reactions
+//
+// Available to users only
+func (client *Client) ClearRecentReactions(ctx context.Context) (*Ok, error) {
+	req := &ClearRecentReactionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AddMessageReactionRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Type of the reaction to add. Use addPendingPaidMessageReaction instead to add the paid reaction
+	ReactionType ReactionType `json:"reaction_type"`
+	// Pass true if the reaction is added with a big animation
+	IsBig bool `json:"is_big"`
+	// Pass true if the reaction needs to be added to recent reactions; tags are never added to the list of recent reactions
+	UpdateRecentReactions bool `json:"update_recent_reactions"`
+}
+
+func (req AddMessageReactionRequest) GetFunctionName() string {
+	return "addMessageReaction"
+}
+
+func (req *AddMessageReactionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddMessageReactionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addMessageReaction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"reaction_type":`)
+	encoder.writeType(req.ReactionType)
+	encoder.writeRaw(`,"is_big":`)
+	encoder.writeBool(req.IsBig)
+	encoder.writeRaw(`,"update_recent_reactions":`)
+	encoder.writeBool(req.UpdateRecentReactions)
+	encoder.writeRaw("}")
+}
+
+func (req AddMessageReactionRequest) Validate() error {
+	return nil
+}
+
+// Adds a reaction or a tag to a message. Use getMessageAvailableReactions to receive the list of available reactions for the message
+//
+// Available to users only
+func (client *Client) AddMessageReaction(ctx context.Context, req *AddMessageReactionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveMessageReactionRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Type of the reaction to remove. The paid reaction can't be removed
+	ReactionType ReactionType `json:"reaction_type"`
+}
+
+func (req RemoveMessageReactionRequest) GetFunctionName() string {
+	return "removeMessageReaction"
+}
+
+func (req *RemoveMessageReactionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveMessageReactionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeMessageReaction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"reaction_type":`)
+	encoder.writeType(req.ReactionType)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveMessageReactionRequest) Validate() error {
+	return nil
+}
+
+// Removes a reaction from a message. A chosen reaction can always be removed
+//
+// Available to users only
+func (client *Client) RemoveMessageReaction(ctx context.Context, req *RemoveMessageReactionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetMessageReactionsRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+	// Types of the reaction to set; pass an empty list to remove the reactions
+	ReactionTypes []ReactionType `json:"reaction_types"`
+	// Pass true if the reactions are added with a big animation
+	IsBig bool `json:"is_big"`
+}
+
+func (req SetMessageReactionsRequest) GetFunctionName() string {
+	return "setMessageReactions"
+}
+
+func (req *SetMessageReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetMessageReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setMessageReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"reaction_types":`)
+	writeList(encoder, req.ReactionTypes, writeTypeValue[ReactionType])
+	encoder.writeRaw(`,"is_big":`)
+	encoder.writeBool(req.IsBig)
+	encoder.writeRaw("}")
+}
+
+func (req SetMessageReactionsRequest) Validate() error {
+	return nil
+}
+
+// Sets reactions on a message; for bots only
+//
+// Available to bots only
+func (client *Client) SetMessageReactions(ctx context.Context, req *SetMessageReactionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetMessageAddedReactionsRequest struct {
+	request
+	// Identifier of the chat to which the message belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use message.interaction_info.reactions.can_get_added_reactions to check whether added reactions can be received for the message
+	MessageId int64 `json:"message_id"`
+	// Type of the reactions to return; pass null to return all added reactions; reactionTypePaid isn't supported
+	ReactionType ReactionType `json:"reaction_type,omitempty"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of reactions to be returned; must be positive and can't be greater than 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetMessageAddedReactionsRequest) GetFunctionName() string {
+	return "getMessageAddedReactions"
+}
+
+func (req *GetMessageAddedReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetMessageAddedReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getMessageAddedReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	if req.ReactionType != nil {
+		encoder.writeRaw(`,"reaction_type":`)
+		encoder.writeType(req.ReactionType)
+	}
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetMessageAddedReactionsRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns reactions added for a message, along with their sender
+//
+// Available to users only
+func (client *Client) GetMessageAddedReactions(ctx context.Context, req *GetMessageAddedReactionsRequest) (*AddedReactions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAddedReactions)
+}
+
+type SetDefaultReactionTypeRequest struct {
+	request
+	// New type of the default reaction. The paid reaction can't be set as default
+	ReactionType ReactionType `json:"reaction_type"`
+}
+
+func (req SetDefaultReactionTypeRequest) GetFunctionName() string {
+	return "setDefaultReactionType"
+}
+
+func (req *SetDefaultReactionTypeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetDefaultReactionTypeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setDefaultReactionType"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"reaction_type":`)
+	encoder.writeType(req.ReactionType)
+	encoder.writeRaw("}")
+}
+
+func (req SetDefaultReactionTypeRequest) Validate() error {
+	return nil
+}
+
+// Changes type of default reaction for the current user
+//
+// Available to users only
+func (client *Client) SetDefaultReactionType(ctx context.Context, req *SetDefaultReactionTypeRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetCountryFlagEmojiRequest struct {
+	request
+	// A two-letter ISO 3166-1 alpha-2 country code as received from getCountries
+	CountryCode string `json:"country_code"`
+}
+
+func (req GetCountryFlagEmojiRequest) GetFunctionName() string {
+	return "getCountryFlagEmoji"
+}
+
+func (req *GetCountryFlagEmojiRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCountryFlagEmojiRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCountryFlagEmoji"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"country_code":`)
+	encoder.writeString(req.CountryCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetCountryFlagEmojiRequest) Validate() error {
+	return nil
+}
+
+// Returns an emoji for the given country. Returns an empty string on failure. Can be called synchronously
+func GetCountryFlagEmoji(req *GetCountryFlagEmojiRequest) (*Text, error) {
+	result, err := Execute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+// deprecated
+// Returns an emoji for the given country. Returns an empty string on failure. Can be called synchronously
+func (client *Client) GetCountryFlagEmoji(req *GetCountryFlagEmojiRequest) (*Text, error) {
+	return GetCountryFlagEmoji(req)
+}
+
+type ClickAnimatedEmojiMessageRequest struct {
+	request
+	// Chat identifier of the message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the clicked message
+	MessageId int64 `json:"message_id"`
+}
+
+func (req ClickAnimatedEmojiMessageRequest) GetFunctionName() string {
+	return "clickAnimatedEmojiMessage"
+}
+
+func (req *ClickAnimatedEmojiMessageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClickAnimatedEmojiMessageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clickAnimatedEmojiMessage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (req ClickAnimatedEmojiMessageRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that a message with an animated emoji was clicked by the user. Returns a big animated sticker to be played or a 404 error if usual animation needs to be played
+//
+// Available to users only
+func (client *Client) ClickAnimatedEmojiMessage(ctx context.Context, req *ClickAnimatedEmojiMessageRequest) (*Sticker, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalSticker)
+}
+
+type ReadAllChatReactionsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req ReadAllChatReactionsRequest) GetFunctionName() string {
+	return "readAllChatReactions"
+}
+
+func (req *ReadAllChatReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReadAllChatReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readAllChatReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req ReadAllChatReactionsRequest) Validate() error {
+	return nil
+}
+
+// Marks all reactions in a chat or a forum topic as read
+//
+// Available to users only
+func (client *Client) ReadAllChatReactions(ctx context.Context, req *ReadAllChatReactionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReadAllMessageThreadReactionsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier in which reactions are marked as read
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (req ReadAllMessageThreadReactionsRequest) GetFunctionName() string {
+	return "readAllMessageThreadReactions"
+}
+
+func (req *ReadAllMessageThreadReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReadAllMessageThreadReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readAllMessageThreadReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(req.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (req ReadAllMessageThreadReactionsRequest) Validate() error {
+	return nil
+}
+
+// Marks all reactions in a forum topic as read
+//
+// Available to users only
+func (client *Client) ReadAllMessageThreadReactions(ctx context.Context, req *ReadAllMessageThreadReactionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatEmojiStatusRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New emoji status; pass null to remove emoji status
+	EmojiStatus *EmojiStatus `json:"emoji_status,omitempty"`
+}
+
+func (req SetChatEmojiStatusRequest) GetFunctionName() string {
+	return "setChatEmojiStatus"
+}
+
+func (req *SetChatEmojiStatusRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatEmojiStatusRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatEmojiStatus"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	if req.EmojiStatus != nil {
+		encoder.writeRaw(`,"emoji_status":`)
+		req.EmojiStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetChatEmojiStatusRequest) Validate() error {
+	return nil
+}
+
+// Changes the emoji status of a chat. Use chatBoostLevelFeatures.can_set_emoji_status to check whether an emoji status can be set. Requires can_change_info administrator right
+//
+// Available to users only
+func (client *Client) SetChatEmojiStatus(ctx context.Context, req *SetChatEmojiStatusRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatAvailableReactionsRequest struct {
+	request
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// Reactions available in the chat. All explicitly specified emoji reactions must be active. In channel chats up to the chat's boost level custom emoji reactions can be explicitly specified
+	AvailableReactions ChatAvailableReactions `json:"available_reactions"`
+}
+
+func (req SetChatAvailableReactionsRequest) GetFunctionName() string {
+	return "setChatAvailableReactions"
+}
+
+func (req *SetChatAvailableReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatAvailableReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatAvailableReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"available_reactions":`)
+	encoder.writeType(req.AvailableReactions)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatAvailableReactionsRequest) Validate() error {
+	return nil
+}
+
+// Changes reactions, available in a chat. Available for basic groups, supergroups, and channels. Requires can_change_info member right
+func (client *Client) SetChatAvailableReactions(ctx context.Context, req *SetChatAvailableReactionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetReactionNotificationSettingsRequest struct {
+	request
+	// The new notification settings for reactions
+	NotificationSettings *ReactionNotificationSettings `json:"notification_settings"`
+}
+
+func (req SetReactionNotificationSettingsRequest) GetFunctionName() string {
+	return "setReactionNotificationSettings"
+}
+
+func (req *SetReactionNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetReactionNotificationSettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setReactionNotificationSettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"notification_settings":`)
+	req.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetReactionNotificationSettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes notification settings for reactions
+//
+// Available to users only
+func (client *Client) SetReactionNotificationSettings(ctx context.Context, req *SetReactionNotificationSettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetThemedEmojiStatusesRequest struct {
+	request
+}
+
+func (req GetThemedEmojiStatusesRequest) GetFunctionName() string {
+	return "getThemedEmojiStatuses"
+}
+
+func (req *GetThemedEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetThemedEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getThemedEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetThemedEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Returns up to 8 emoji statuses, which must be shown right after the default Premium Badge in the emoji status list for self status
+//
+// Available to users only
+func (client *Client) GetThemedEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	req := &GetThemedEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiStatusCustomEmojis)
+}
+
+type GetRecentEmojiStatusesRequest struct {
+	request
+}
+
+func (req GetRecentEmojiStatusesRequest) GetFunctionName() string {
+	return "getRecentEmojiStatuses"
+}
+
+func (req *GetRecentEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecentEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecentEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecentEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Returns recent emoji statuses for self status
+//
+// Available to users only
+func (client *Client) GetRecentEmojiStatuses(ctx context.Context) (*EmojiStatuses, error) {
+	req := &GetRecentEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiStatuses)
+}
+
+type GetDefaultEmojiStatusesRequest struct {
+	request
+}
+
+func (req GetDefaultEmojiStatusesRequest) GetFunctionName() string {
+	return "getDefaultEmojiStatuses"
+}
+
+func (req *GetDefaultEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDefaultEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDefaultEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDefaultEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Returns default emoji statuses for self status
+//
+// Available to users only
+func (client *Client) GetDefaultEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	req := &GetDefaultEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiStatusCustomEmojis)
+}
+
+type ClearRecentEmojiStatusesRequest struct {
+	request
+}
+
+func (req ClearRecentEmojiStatusesRequest) GetFunctionName() string {
+	return "clearRecentEmojiStatuses"
+}
+
+func (req *ClearRecentEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearRecentEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearRecentEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ClearRecentEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Clears the list of recently used emoji statuses for self status
+//
+// Available to users only
+func (client *Client) ClearRecentEmojiStatuses(ctx context.Context) (*Ok, error) {
+	req := &ClearRecentEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetThemedChatEmojiStatusesRequest struct {
+	request
+}
+
+func (req GetThemedChatEmojiStatusesRequest) GetFunctionName() string {
+	return "getThemedChatEmojiStatuses"
+}
+
+func (req *GetThemedChatEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetThemedChatEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getThemedChatEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetThemedChatEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Returns up to 8 emoji statuses, which must be shown in the emoji status list for chats
+//
+// Available to users only
+func (client *Client) GetThemedChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	req := &GetThemedChatEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiStatusCustomEmojis)
+}
+
+type GetDefaultChatEmojiStatusesRequest struct {
+	request
+}
+
+func (req GetDefaultChatEmojiStatusesRequest) GetFunctionName() string {
+	return "getDefaultChatEmojiStatuses"
+}
+
+func (req *GetDefaultChatEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDefaultChatEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDefaultChatEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDefaultChatEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Returns default emoji statuses for chats
+//
+// Available to users only
+func (client *Client) GetDefaultChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	req := &GetDefaultChatEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiStatusCustomEmojis)
+}
+
+type GetDisallowedChatEmojiStatusesRequest struct {
+	request
+}
+
+func (req GetDisallowedChatEmojiStatusesRequest) GetFunctionName() string {
+	return "getDisallowedChatEmojiStatuses"
+}
+
+func (req *GetDisallowedChatEmojiStatusesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDisallowedChatEmojiStatusesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDisallowedChatEmojiStatuses"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDisallowedChatEmojiStatusesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of emoji statuses, which can't be used as chat emoji status, even they are from a sticker set with is_allowed_as_chat_emoji_status == true
+//
+// Available to users only
+func (client *Client) GetDisallowedChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	req := &GetDisallowedChatEmojiStatusesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiStatusCustomEmojis)
+}
+
+type ToggleBotCanManageEmojiStatusRequest struct {
+	request
+	// User identifier of the bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Pass true if the bot is allowed to change emoji status of the user; pass false otherwise
+	CanManageEmojiStatus bool `json:"can_manage_emoji_status"`
+}
+
+func (req ToggleBotCanManageEmojiStatusRequest) GetFunctionName() string {
+	return "toggleBotCanManageEmojiStatus"
+}
+
+func (req *ToggleBotCanManageEmojiStatusRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleBotCanManageEmojiStatusRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleBotCanManageEmojiStatus"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	encoder.writeRaw(`,"can_manage_emoji_status":`)
+	encoder.writeBool(req.CanManageEmojiStatus)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleBotCanManageEmojiStatusRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether the bot can manage emoji status of the current user
+//
+// Available to users only
+func (client *Client) ToggleBotCanManageEmojiStatus(ctx context.Context, req *ToggleBotCanManageEmojiStatusRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetUserEmojiStatusRequest struct {
+	request
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+	// New emoji status; pass null to switch to the default badge
+	EmojiStatus *EmojiStatus `json:"emoji_status,omitempty"`
+}
+
+func (req SetUserEmojiStatusRequest) GetFunctionName() string {
+	return "setUserEmojiStatus"
+}
+
+func (req *SetUserEmojiStatusRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetUserEmojiStatusRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setUserEmojiStatus"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	if req.EmojiStatus != nil {
+		encoder.writeRaw(`,"emoji_status":`)
+		req.EmojiStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetUserEmojiStatusRequest) Validate() error {
+	return nil
+}
+
+// Changes the emoji status of a user; for bots only
+//
+// Available to bots only
+func (client *Client) SetUserEmojiStatus(ctx context.Context, req *SetUserEmojiStatusRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetStickerOutlineRequest struct {
+	request
+	// File identifier of the sticker
+	StickerFileId int32 `json:"sticker_file_id"`
+	// Pass true to get the outline scaled for animated emoji
+	ForAnimatedEmoji bool `json:"for_animated_emoji"`
+	// Pass true to get the outline scaled for clicked animated emoji message
+	ForClickedAnimatedEmojiMessage bool `json:"for_clicked_animated_emoji_message"`
+}
+
+func (req GetStickerOutlineRequest) GetFunctionName() string {
+	return "getStickerOutline"
+}
+
+func (req *GetStickerOutlineRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStickerOutlineRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStickerOutline"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_file_id":`)
+	encoder.writeInt32(req.StickerFileId)
+	encoder.writeRaw(`,"for_animated_emoji":`)
+	encoder.writeBool(req.ForAnimatedEmoji)
+	encoder.writeRaw(`,"for_clicked_animated_emoji_message":`)
+	encoder.writeBool(req.ForClickedAnimatedEmojiMessage)
+	encoder.writeRaw("}")
+}
+
+func (req GetStickerOutlineRequest) Validate() error {
+	return nil
+}
+
+// Returns outline of a sticker. This is an offline method. Returns a 404 error if the outline isn't known
+//
+// Available to users only
+func (client *Client) GetStickerOutline(ctx context.Context, req *GetStickerOutlineRequest) (*Outline, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOutline)
+}
+
+type GetStickersRequest struct {
+	request
+	// Type of the stickers to return
+	StickerType StickerType `json:"sticker_type"`
+	// Search query; a space-separated list of emojis or a keyword prefix. If empty, returns all known installed stickers
+	Query string `json:"query"`
+	// The maximum number of stickers to be returned
+	Limit int32 `json:"limit"`
+	// Chat identifier for which to return stickers. Available custom emoji stickers may be different for different chats
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetStickersRequest) GetFunctionName() string {
+	return "getStickers"
+}
+
+func (req *GetStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns stickers from the installed sticker sets that correspond to any of the given emoji or can be found by sticker-specific keywords. If the query is non-empty, then favorite, recently used or trending stickers may also be returned
+//
+// Available to users only
+func (client *Client) GetStickers(ctx context.Context, req *GetStickersRequest) (*Stickers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetAllStickerEmojisRequest struct {
+	request
+	// Type of the stickers to search for
+	StickerType StickerType `json:"sticker_type"`
+	// Search query
+	Query string `json:"query"`
+	// Chat identifier for which to find stickers
+	ChatId int64 `json:"chat_id"`
+	// Pass true if only main emoji for each found sticker must be included in the result
+	ReturnOnlyMainEmoji bool `json:"return_only_main_emoji"`
+}
+
+func (req GetAllStickerEmojisRequest) GetFunctionName() string {
+	return "getAllStickerEmojis"
+}
+
+func (req *GetAllStickerEmojisRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAllStickerEmojisRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAllStickerEmojis"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"return_only_main_emoji":`)
+	encoder.writeBool(req.ReturnOnlyMainEmoji)
+	encoder.writeRaw("}")
+}
+
+func (req GetAllStickerEmojisRequest) Validate() error {
+	return nil
+}
+
+// Returns unique emoji that correspond to stickers to be found by the getStickers(sticker_type, query, 1000000, chat_id)
+//
+// Available to users only
+func (client *Client) GetAllStickerEmojis(ctx context.Context, req *GetAllStickerEmojisRequest) (*Emojis, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojis)
+}
+
+type SearchStickersRequest struct {
+	request
+	// Type of the stickers to return
+	StickerType StickerType `json:"sticker_type"`
+	// Space-separated list of emojis to search for
+	Emojis string `json:"emojis"`
+	// Query to search for; may be empty to search for emoji only
+	Query string `json:"query"`
+	// List of possible IETF language tags of the user's input language; may be empty if unknown
+	InputLanguageCodes []string `json:"input_language_codes"`
+	// The offset from which to return the stickers; must be non-negative
+	Offset int32 `json:"offset"`
+	// The maximum number of stickers to be returned; 0-100
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchStickersRequest) GetFunctionName() string {
+	return "searchStickers"
+}
+
+func (req *SearchStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"emojis":`)
+	encoder.writeString(req.Emojis)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"input_language_codes":`)
+	writeList(encoder, req.InputLanguageCodes, (*jsonEncoder).writeString)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchStickersRequest) Validate() error {
+	if err := validateMin("offset", int64(req.Offset), 0); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for stickers from public sticker sets that correspond to any of the given emoji
+//
+// Available to users only
+func (client *Client) SearchStickers(ctx context.Context, req *SearchStickersRequest) (*Stickers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetGreetingStickersRequest struct {
+	request
+}
+
+func (req GetGreetingStickersRequest) GetFunctionName() string {
+	return "getGreetingStickers"
+}
+
+func (req *GetGreetingStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetGreetingStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getGreetingStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetGreetingStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns greeting stickers from regular sticker sets that can be used for the start page of other users
+//
+// Available to users only
+func (client *Client) GetGreetingStickers(ctx context.Context) (*Stickers, error) {
+	req := &GetGreetingStickersRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetInstalledStickerSetsRequest struct {
+	request
+	// Type of the sticker sets to return
+	StickerType StickerType `json:"sticker_type"`
+}
+
+func (req GetInstalledStickerSetsRequest) GetFunctionName() string {
+	return "getInstalledStickerSets"
+}
+
+func (req *GetInstalledStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetInstalledStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getInstalledStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw("}")
+}
+
+func (req GetInstalledStickerSetsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of installed sticker sets
+//
+// Available to users only
+func (client *Client) GetInstalledStickerSets(ctx context.Context, req *GetInstalledStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSets)
+}
+
+type GetArchivedStickerSetsRequest struct {
+	request
+	// Type of the sticker sets to return
+	StickerType StickerType `json:"sticker_type"`
+	// Identifier of the sticker set from which to return the result; use 0 to get results from the beginning
+	OffsetStickerSetId JsonInt64 `json:"offset_sticker_set_id"`
+	// The maximum number of sticker sets to return; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetArchivedStickerSetsRequest) GetFunctionName() string {
+	return "getArchivedStickerSets"
+}
+
+func (req *GetArchivedStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetArchivedStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getArchivedStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"offset_sticker_set_id":`)
+	encoder.writeJsonInt64(req.OffsetStickerSetId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetArchivedStickerSetsRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns a list of archived sticker sets
+//
+// Available to users only
+func (client *Client) GetArchivedStickerSets(ctx context.Context, req *GetArchivedStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSets)
+}
+
+type GetTrendingStickerSetsRequest struct {
+	request
+	// Type of the sticker sets to return
+	StickerType StickerType `json:"sticker_type"`
+	// The offset from which to return the sticker sets; must be non-negative
+	Offset int32 `json:"offset"`
+	// The maximum number of sticker sets to be returned; up to 100. For optimal performance, the number of returned sticker sets is chosen by TDLib and can be smaller than the specified limit, even if the end of the list has not been reached
+	Limit int32 `json:"limit"`
+}
+
+func (req GetTrendingStickerSetsRequest) GetFunctionName() string {
+	return "getTrendingStickerSets"
+}
+
+func (req *GetTrendingStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetTrendingStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getTrendingStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetTrendingStickerSetsRequest) Validate() error {
+	if err := validateMin("offset", int64(req.Offset), 0); err != nil {
+		return err
+	}
+
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns a list of trending sticker sets. For optimal performance, the number of returned sticker sets is chosen by TDLib
+//
+// Available to users only
+func (client *Client) GetTrendingStickerSets(ctx context.Context, req *GetTrendingStickerSetsRequest) (*TrendingStickerSets, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalTrendingStickerSets)
+}
+
+type GetAttachedStickerSetsRequest struct {
+	request
+	// File identifier
+	FileId int32 `json:"file_id"`
+}
+
+func (req GetAttachedStickerSetsRequest) GetFunctionName() string {
+	return "getAttachedStickerSets"
+}
+
+func (req *GetAttachedStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAttachedStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAttachedStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(req.FileId)
+	encoder.writeRaw("}")
+}
+
+func (req GetAttachedStickerSetsRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of sticker sets attached to a file, including regular, mask, and emoji sticker sets. Currently, only animations, photos, and videos can have attached sticker sets
+//
+// Available to users only
+func (client *Client) GetAttachedStickerSets(ctx context.Context, req *GetAttachedStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSets)
+}
+
+type GetStickerSetRequest struct {
+	request
+	// Identifier of the sticker set
+	SetId JsonInt64 `json:"set_id"`
+}
+
+func (req GetStickerSetRequest) GetFunctionName() string {
+	return "getStickerSet"
+}
+
+func (req *GetStickerSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStickerSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStickerSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"set_id":`)
+	encoder.writeJsonInt64(req.SetId)
+	encoder.writeRaw("}")
+}
+
+func (req GetStickerSetRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a sticker set by its identifier
+func (client *Client) GetStickerSet(ctx context.Context, req *GetStickerSetRequest) (*StickerSet, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSet)
+}
+
+type GetStickerSetNameRequest struct {
+	request
+	// Identifier of the sticker set
+	SetId JsonInt64 `json:"set_id"`
+}
+
+func (req GetStickerSetNameRequest) GetFunctionName() string {
+	return "getStickerSetName"
+}
+
+func (req *GetStickerSetNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStickerSetNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStickerSetName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"set_id":`)
+	encoder.writeJsonInt64(req.SetId)
+	encoder.writeRaw("}")
+}
+
+func (req GetStickerSetNameRequest) Validate() error {
+	return nil
+}
+
+// Returns name of a sticker set by its identifier
+func (client *Client) GetStickerSetName(ctx context.Context, req *GetStickerSetNameRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type SearchStickerSetRequest struct {
+	request
+	// Name of the sticker set
+	Name string `json:"name"`
+	// Pass true to ignore local cache of sticker sets and always send a network request
+	IgnoreCache bool `json:"ignore_cache"`
+}
+
+func (req SearchStickerSetRequest) GetFunctionName() string {
+	return "searchStickerSet"
+}
+
+func (req *SearchStickerSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchStickerSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchStickerSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"ignore_cache":`)
+	encoder.writeBool(req.IgnoreCache)
+	encoder.writeRaw("}")
+}
+
+func (req SearchStickerSetRequest) Validate() error {
+	return nil
+}
+
+// Searches for a sticker set by its name
+func (client *Client) SearchStickerSet(ctx context.Context, req *SearchStickerSetRequest) (*StickerSet, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSet)
+}
+
+type SearchInstalledStickerSetsRequest struct {
+	request
+	// Type of the sticker sets to search for
+	StickerType StickerType `json:"sticker_type"`
+	// Query to search for
+	Query string `json:"query"`
+	// The maximum number of sticker sets to return
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchInstalledStickerSetsRequest) GetFunctionName() string {
+	return "searchInstalledStickerSets"
+}
+
+func (req *SearchInstalledStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchInstalledStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchInstalledStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchInstalledStickerSetsRequest) Validate() error {
+	return nil
+}
+
+// Searches for installed sticker sets by looking for specified query in their title and name
+func (client *Client) SearchInstalledStickerSets(ctx context.Context, req *SearchInstalledStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSets)
+}
+
+type SearchStickerSetsRequest struct {
+	request
+	// Type of the sticker sets to return
+	StickerType StickerType `json:"sticker_type"`
+	// Query to search for
+	Query string `json:"query"`
+}
+
+func (req SearchStickerSetsRequest) GetFunctionName() string {
+	return "searchStickerSets"
+}
+
+func (req *SearchStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw("}")
+}
+
+func (req SearchStickerSetsRequest) Validate() error {
+	return nil
+}
+
+// Searches for sticker sets by looking for specified query in their title and name. Excludes installed sticker sets from the results
+func (client *Client) SearchStickerSets(ctx context.Context, req *SearchStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSets)
+}
+
+type ChangeStickerSetRequest struct {
+	request
+	// Identifier of the sticker set
+	SetId JsonInt64 `json:"set_id"`
+	// The new value of is_installed
+	IsInstalled bool `json:"is_installed"`
+	// The new value of is_archived. A sticker set can't be installed and archived simultaneously
+	IsArchived bool `json:"is_archived"`
+}
+
+func (req ChangeStickerSetRequest) GetFunctionName() string {
+	return "changeStickerSet"
+}
+
+func (req *ChangeStickerSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ChangeStickerSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"changeStickerSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"set_id":`)
+	encoder.writeJsonInt64(req.SetId)
+	encoder.writeRaw(`,"is_installed":`)
+	encoder.writeBool(req.IsInstalled)
+	encoder.writeRaw(`,"is_archived":`)
+	encoder.writeBool(req.IsArchived)
+	encoder.writeRaw("}")
+}
+
+func (req ChangeStickerSetRequest) Validate() error {
+	return nil
+}
+
+// Installs/uninstalls or activates/archives a sticker set
+//
+// Available to users only
+func (client *Client) ChangeStickerSet(ctx context.Context, req *ChangeStickerSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ViewTrendingStickerSetsRequest struct {
+	request
+	// Identifiers of viewed trending sticker sets
+	StickerSetIds []JsonInt64 `json:"sticker_set_ids"`
+}
+
+func (req ViewTrendingStickerSetsRequest) GetFunctionName() string {
+	return "viewTrendingStickerSets"
+}
+
+func (req *ViewTrendingStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ViewTrendingStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"viewTrendingStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_set_ids":`)
+	writeList(encoder, req.StickerSetIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw("}")
+}
+
+func (req ViewTrendingStickerSetsRequest) Validate() error {
+	return nil
+}
+
+// Informs the server that some trending sticker sets have been viewed by the user
+//
+// Available to users only
+func (client *Client) ViewTrendingStickerSets(ctx context.Context, req *ViewTrendingStickerSetsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReorderInstalledStickerSetsRequest struct {
+	request
+	// Type of the sticker sets to reorder
+	StickerType StickerType `json:"sticker_type"`
+	// Identifiers of installed sticker sets in the new correct order
+	StickerSetIds []JsonInt64 `json:"sticker_set_ids"`
+}
+
+func (req ReorderInstalledStickerSetsRequest) GetFunctionName() string {
+	return "reorderInstalledStickerSets"
+}
+
+func (req *ReorderInstalledStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReorderInstalledStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reorderInstalledStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"sticker_set_ids":`)
+	writeList(encoder, req.StickerSetIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw("}")
+}
+
+func (req ReorderInstalledStickerSetsRequest) Validate() error {
+	return nil
+}
+
+// Changes the order of installed sticker sets
+//
+// Available to users only
+func (client *Client) ReorderInstalledStickerSets(ctx context.Context, req *ReorderInstalledStickerSetsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetRecentStickersRequest struct {
+	request
+	// Pass true to return stickers and masks that were recently attached to photos or video files; pass false to return recently sent stickers
+	IsAttached bool `json:"is_attached"`
+}
+
+func (req GetRecentStickersRequest) GetFunctionName() string {
+	return "getRecentStickers"
+}
+
+func (req *GetRecentStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetRecentStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getRecentStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"is_attached":`)
+	encoder.writeBool(req.IsAttached)
+	encoder.writeRaw("}")
+}
+
+func (req GetRecentStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns a list of recently used stickers
+//
+// Available to users only
+func (client *Client) GetRecentStickers(ctx context.Context, req *GetRecentStickersRequest) (*Stickers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type AddRecentStickerRequest struct {
+	request
+	// Pass true to add the sticker to the list of stickers recently attached to photo or video files; pass false to add the sticker to the list of recently sent stickers
+	IsAttached bool `json:"is_attached"`
+	// Sticker file to add
+	Sticker InputFile `json:"sticker"`
+}
+
+func (req AddRecentStickerRequest) GetFunctionName() string {
+	return "addRecentSticker"
+}
+
+func (req *AddRecentStickerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddRecentStickerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addRecentSticker"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"is_attached":`)
+	encoder.writeBool(req.IsAttached)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw("}")
+}
+
+func (req AddRecentStickerRequest) Validate() error {
+	return nil
+}
+
+// Manually adds a new sticker to the list of recently used stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set or in WEBP or WEBM format can be added to this list. Emoji stickers can't be added to recent stickers
+//
+// Available to users only
+func (client *Client) AddRecentSticker(ctx context.Context, req *AddRecentStickerRequest) (*Stickers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type RemoveRecentStickerRequest struct {
+	request
+	// Pass true to remove the sticker from the list of stickers recently attached to photo or video files; pass false to remove the sticker from the list of recently sent stickers
+	IsAttached bool `json:"is_attached"`
+	// Sticker file to delete
+	Sticker InputFile `json:"sticker"`
+}
+
+func (req RemoveRecentStickerRequest) GetFunctionName() string {
+	return "removeRecentSticker"
+}
+
+func (req *RemoveRecentStickerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveRecentStickerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeRecentSticker"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"is_attached":`)
+	encoder.writeBool(req.IsAttached)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveRecentStickerRequest) Validate() error {
+	return nil
+}
+
+// Removes a sticker from the list of recently used stickers
+//
+// Available to users only
+func (client *Client) RemoveRecentSticker(ctx context.Context, req *RemoveRecentStickerRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ClearRecentStickersRequest struct {
+	request
+	// Pass true to clear the list of stickers recently attached to photo or video files; pass false to clear the list of recently sent stickers
+	IsAttached bool `json:"is_attached"`
+}
+
+func (req ClearRecentStickersRequest) GetFunctionName() string {
+	return "clearRecentStickers"
+}
+
+func (req *ClearRecentStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearRecentStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearRecentStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"is_attached":`)
+	encoder.writeBool(req.IsAttached)
+	encoder.writeRaw("}")
+}
+
+func (req ClearRecentStickersRequest) Validate() error {
+	return nil
+}
+
+// Clears the list of recently used stickers
+//
+// Available to users only
+func (client *Client) ClearRecentStickers(ctx context.Context, req *ClearRecentStickersRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetFavoriteStickersRequest struct {
+	request
+}
+
+func (req GetFavoriteStickersRequest) GetFunctionName() string {
+	return "getFavoriteStickers"
+}
+
+func (req *GetFavoriteStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetFavoriteStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getFavoriteStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetFavoriteStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns favorite stickers
+//
+// Available to users only
+func (client *Client) GetFavoriteStickers(ctx context.Context) (*Stickers, error) {
+	req := &GetFavoriteStickersRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type AddFavoriteStickerRequest struct {
+	request
+	// Sticker file to add
+	Sticker InputFile `json:"sticker"`
+}
+
+func (req AddFavoriteStickerRequest) GetFunctionName() string {
+	return "addFavoriteSticker"
+}
+
+func (req *AddFavoriteStickerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddFavoriteStickerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addFavoriteSticker"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw("}")
+}
+
+func (req AddFavoriteStickerRequest) Validate() error {
+	return nil
+}
+
+// Adds a new sticker to the list of favorite stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set or in WEBP or WEBM format can be added to this list. Emoji stickers can't be added to favorite stickers
+//
+// Available to users only
+func (client *Client) AddFavoriteSticker(ctx context.Context, req *AddFavoriteStickerRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveFavoriteStickerRequest struct {
+	request
+	// Sticker file to delete from the list
+	Sticker InputFile `json:"sticker"`
+}
+
+func (req RemoveFavoriteStickerRequest) GetFunctionName() string {
+	return "removeFavoriteSticker"
+}
+
+func (req *RemoveFavoriteStickerRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveFavoriteStickerRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeFavoriteSticker"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveFavoriteStickerRequest) Validate() error {
+	return nil
+}
+
+// Removes a sticker from the list of favorite stickers
+//
+// Available to users only
+func (client *Client) RemoveFavoriteSticker(ctx context.Context, req *RemoveFavoriteStickerRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetStickerEmojisRequest struct {
+	request
+	// Sticker file identifier
+	Sticker InputFile `json:"sticker"`
+}
+
+func (req GetStickerEmojisRequest) GetFunctionName() string {
+	return "getStickerEmojis"
+}
+
+func (req *GetStickerEmojisRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStickerEmojisRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStickerEmojis"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw("}")
+}
+
+func (req GetStickerEmojisRequest) Validate() error {
+	return nil
+}
+
+// Returns emoji corresponding to a sticker. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object
+//
+// Available to users only
+func (client *Client) GetStickerEmojis(ctx context.Context, req *GetStickerEmojisRequest) (*Emojis, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojis)
+}
+
+type SearchEmojisRequest struct {
+	request
+	// Text to search for
+	Text string `json:"text"`
+	// List of possible IETF language tags of the user's input language; may be empty if unknown
+	InputLanguageCodes []string `json:"input_language_codes"`
+}
+
+func (req SearchEmojisRequest) GetFunctionName() string {
+	return "searchEmojis"
+}
+
+func (req *SearchEmojisRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchEmojisRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchEmojis"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw(`,"input_language_codes":`)
+	writeList(encoder, req.InputLanguageCodes, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req SearchEmojisRequest) Validate() error {
+	return nil
+}
+
+// Searches for emojis by keywords. Supported only if the file database is enabled. Order of results is unspecified
+//
+// Available to users only
+func (client *Client) SearchEmojis(ctx context.Context, req *SearchEmojisRequest) (*EmojiKeywords, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiKeywords)
+}
+
+type GetKeywordEmojisRequest struct {
+	request
+	// Text to search for
+	Text string `json:"text"`
+	// List of possible IETF language tags of the user's input language; may be empty if unknown
+	InputLanguageCodes []string `json:"input_language_codes"`
+}
+
+func (req GetKeywordEmojisRequest) GetFunctionName() string {
+	return "getKeywordEmojis"
+}
+
+func (req *GetKeywordEmojisRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetKeywordEmojisRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getKeywordEmojis"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw(`,"input_language_codes":`)
+	writeList(encoder, req.InputLanguageCodes, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req GetKeywordEmojisRequest) Validate() error {
+	return nil
+}
+
+// Return emojis matching the keyword. Supported only if the file database is enabled. Order of results is unspecified
+//
+// Available to users only
+func (client *Client) GetKeywordEmojis(ctx context.Context, req *GetKeywordEmojisRequest) (*Emojis, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojis)
+}
+
+type GetEmojiCategoriesRequest struct {
+	request
+	// Type of emoji categories to return; pass null to get default emoji categories
+	Type EmojiCategoryType `json:"type,omitempty"`
+}
+
+func (req GetEmojiCategoriesRequest) GetFunctionName() string {
+	return "getEmojiCategories"
+}
+
+func (req *GetEmojiCategoriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetEmojiCategoriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getEmojiCategories"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.Type != nil {
+		encoder.writeRaw(`,"type":`)
+		encoder.writeType(req.Type)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req GetEmojiCategoriesRequest) Validate() error {
+	return nil
+}
+
+// Returns available emoji categories
+//
+// Available to users only
+func (client *Client) GetEmojiCategories(ctx context.Context, req *GetEmojiCategoriesRequest) (*EmojiCategories, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalEmojiCategories)
+}
+
+type GetAnimatedEmojiRequest struct {
+	request
+	// The emoji
+	Emoji string `json:"emoji"`
+}
+
+func (req GetAnimatedEmojiRequest) GetFunctionName() string {
+	return "getAnimatedEmoji"
+}
+
+func (req *GetAnimatedEmojiRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetAnimatedEmojiRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getAnimatedEmoji"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(req.Emoji)
+	encoder.writeRaw("}")
+}
+
+func (req GetAnimatedEmojiRequest) Validate() error {
+	return nil
+}
+
+// Returns an animated emoji corresponding to a given emoji. Returns a 404 error if the emoji has no animated emoji
+//
+// Available to users only
+func (client *Client) GetAnimatedEmoji(ctx context.Context, req *GetAnimatedEmojiRequest) (*AnimatedEmoji, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAnimatedEmoji)
+}
+
+type GetEmojiSuggestionsUrlRequest struct {
+	request
+	// Language code for which the emoji replacements will be suggested
+	LanguageCode string `json:"language_code"`
+}
+
+func (req GetEmojiSuggestionsUrlRequest) GetFunctionName() string {
+	return "getEmojiSuggestionsUrl"
+}
+
+func (req *GetEmojiSuggestionsUrlRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetEmojiSuggestionsUrlRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getEmojiSuggestionsUrl"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(req.LanguageCode)
+	encoder.writeRaw("}")
+}
+
+func (req GetEmojiSuggestionsUrlRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTP URL which can be used to automatically log in to the translation platform and suggest new emoji replacements. The URL will be valid for 30 seconds after generation
+//
+// Available to users only
+func (client *Client) GetEmojiSuggestionsUrl(ctx context.Context, req *GetEmojiSuggestionsUrlRequest) (*HttpUrl, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalHttpUrl)
+}
+
+type GetCustomEmojiStickersRequest struct {
+	request
+	// Identifiers of custom emoji stickers. At most 200 custom emoji stickers can be received simultaneously
+	CustomEmojiIds []JsonInt64 `json:"custom_emoji_ids"`
+}
+
+func (req GetCustomEmojiStickersRequest) GetFunctionName() string {
+	return "getCustomEmojiStickers"
+}
+
+func (req *GetCustomEmojiStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetCustomEmojiStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getCustomEmojiStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"custom_emoji_ids":`)
+	writeList(encoder, req.CustomEmojiIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw("}")
+}
+
+func (req GetCustomEmojiStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of custom emoji stickers by their identifiers. Stickers are returned in arbitrary order. Only found stickers are returned
+func (client *Client) GetCustomEmojiStickers(ctx context.Context, req *GetCustomEmojiStickersRequest) (*Stickers, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetDefaultChatPhotoCustomEmojiStickersRequest struct {
+	request
+}
+
+func (req GetDefaultChatPhotoCustomEmojiStickersRequest) GetFunctionName() string {
+	return "getDefaultChatPhotoCustomEmojiStickers"
+}
+
+func (req *GetDefaultChatPhotoCustomEmojiStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDefaultChatPhotoCustomEmojiStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDefaultChatPhotoCustomEmojiStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDefaultChatPhotoCustomEmojiStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns default list of custom emoji stickers for placing on a chat photo
+//
+// Available to users only
+func (client *Client) GetDefaultChatPhotoCustomEmojiStickers(ctx context.Context) (*Stickers, error) {
+	req := &GetDefaultChatPhotoCustomEmojiStickersRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetDefaultProfilePhotoCustomEmojiStickersRequest struct {
+	request
+}
+
+func (req GetDefaultProfilePhotoCustomEmojiStickersRequest) GetFunctionName() string {
+	return "getDefaultProfilePhotoCustomEmojiStickers"
+}
+
+func (req *GetDefaultProfilePhotoCustomEmojiStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDefaultProfilePhotoCustomEmojiStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDefaultProfilePhotoCustomEmojiStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDefaultProfilePhotoCustomEmojiStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns default list of custom emoji stickers for placing on a profile photo
+//
+// Available to users only
+func (client *Client) GetDefaultProfilePhotoCustomEmojiStickers(ctx context.Context) (*Stickers, error) {
+	req := &GetDefaultProfilePhotoCustomEmojiStickersRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type GetDefaultBackgroundCustomEmojiStickersRequest struct {
+	request
+}
+
+func (req GetDefaultBackgroundCustomEmojiStickersRequest) GetFunctionName() string {
+	return "getDefaultBackgroundCustomEmojiStickers"
+}
+
+func (req *GetDefaultBackgroundCustomEmojiStickersRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetDefaultBackgroundCustomEmojiStickersRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getDefaultBackgroundCustomEmojiStickers"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetDefaultBackgroundCustomEmojiStickersRequest) Validate() error {
+	return nil
+}
+
+// Returns default list of custom emoji stickers for reply background
+//
+// Available to users only
+func (client *Client) GetDefaultBackgroundCustomEmojiStickers(ctx context.Context) (*Stickers, error) {
+	req := &GetDefaultBackgroundCustomEmojiStickersRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickers)
+}
+
+type SetEmojiStatusRequest struct {
+	request
+	// New emoji status; pass null to switch to the default badge
+	EmojiStatus *EmojiStatus `json:"emoji_status,omitempty"`
+}
+
+func (req SetEmojiStatusRequest) GetFunctionName() string {
+	return "setEmojiStatus"
+}
+
+func (req *SetEmojiStatusRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetEmojiStatusRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setEmojiStatus"`)
+	encoder.writeExtra(req.MetaExtra)
+	if req.EmojiStatus != nil {
+		encoder.writeRaw(`,"emoji_status":`)
+		req.EmojiStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetEmojiStatusRequest) Validate() error {
+	return nil
+}
+
+// Changes the emoji status of the current user; for Telegram Premium users only
+//
+// Available to users only
+func (client *Client) SetEmojiStatus(ctx context.Context, req *SetEmojiStatusRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetSupergroupStickerSetRequest struct {
+	request
+	// Identifier of the supergroup
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of the supergroup sticker set identifier. Use 0 to remove the supergroup sticker set
+	StickerSetId JsonInt64 `json:"sticker_set_id"`
+}
+
+func (req SetSupergroupStickerSetRequest) GetFunctionName() string {
+	return "setSupergroupStickerSet"
+}
+
+func (req *SetSupergroupStickerSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetSupergroupStickerSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setSupergroupStickerSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"sticker_set_id":`)
+	encoder.writeJsonInt64(req.StickerSetId)
+	encoder.writeRaw("}")
+}
+
+func (req SetSupergroupStickerSetRequest) Validate() error {
+	return nil
+}
+
+// Changes the sticker set of a supergroup; requires can_change_info administrator right
+func (client *Client) SetSupergroupStickerSet(ctx context.Context, req *SetSupergroupStickerSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetSupergroupCustomEmojiStickerSetRequest struct {
+	request
+	// Identifier of the supergroup
+	SupergroupId int64 `json:"supergroup_id"`
+	// New value of the custom emoji sticker set identifier for the supergroup. Use 0 to remove the custom emoji sticker set in the supergroup
+	CustomEmojiStickerSetId JsonInt64 `json:"custom_emoji_sticker_set_id"`
+}
+
+func (req SetSupergroupCustomEmojiStickerSetRequest) GetFunctionName() string {
+	return "setSupergroupCustomEmojiStickerSet"
+}
+
+func (req *SetSupergroupCustomEmojiStickerSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetSupergroupCustomEmojiStickerSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setSupergroupCustomEmojiStickerSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(req.SupergroupId)
+	encoder.writeRaw(`,"custom_emoji_sticker_set_id":`)
+	encoder.writeJsonInt64(req.CustomEmojiStickerSetId)
+	encoder.writeRaw("}")
+}
+
+func (req SetSupergroupCustomEmojiStickerSetRequest) Validate() error {
+	return nil
+}
+
+// Changes the custom emoji sticker set of a supergroup; requires can_change_info administrator right. The chat must have at least chatBoostFeatures.min_custom_emoji_sticker_set_boost_level boost level to pass the corresponding color
+func (client *Client) SetSupergroupCustomEmojiStickerSet(ctx context.Context, req *SetSupergroupCustomEmojiStickerSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReportMessageReactionsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Identifier of the sender, which added the reaction
+	SenderId MessageSender `json:"sender_id"`
+}
+
+func (req ReportMessageReactionsRequest) GetFunctionName() string {
+	return "reportMessageReactions"
+}
+
+func (req *ReportMessageReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportMessageReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportMessageReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(req.SenderId)
+	encoder.writeRaw("}")
+}
+
+func (req ReportMessageReactionsRequest) Validate() error {
+	return nil
+}
+
+// Reports reactions set on a message to the Telegram moderators. Reactions on a message can be reported only if messageProperties.can_report_reactions
+//
+// Available to users only
+func (client *Client) ReportMessageReactions(ctx context.Context, req *ReportMessageReactionsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type UploadStickerFileRequest struct {
+	request
+	// Sticker file owner; ignored for regular users
+	UserId int64 `json:"user_id"`
+	// Sticker format
+	StickerFormat StickerFormat `json:"sticker_format"`
+	// File file to upload; must fit in a 512x512 square. For WEBP stickers the file must be in WEBP or PNG format, which will be converted to WEBP server-side. See https://core.telegram.org/animated_stickers#technical-requirements for technical requirements
+	Sticker InputFile `json:"sticker"`
+}
+
+func (req UploadStickerFileRequest) GetFunctionName() string {
+	return "uploadStickerFile"
+}
+
+func (req *UploadStickerFileRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *UploadStickerFileRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"uploadStickerFile"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"sticker_format":`)
+	encoder.writeType(req.StickerFormat)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw("}")
+}
+
+func (req UploadStickerFileRequest) Validate() error {
+	return nil
+}
+
+// Uploads a file with a sticker; returns the uploaded file
+func (client *Client) UploadStickerFile(ctx context.Context, req *UploadStickerFileRequest) (*File, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFile)
+}
+
+type GetSuggestedStickerSetNameRequest struct {
+	request
+	// Sticker set title; 1-64 characters
+	Title string `json:"title"`
+}
+
+func (req GetSuggestedStickerSetNameRequest) GetFunctionName() string {
+	return "getSuggestedStickerSetName"
+}
+
+func (req *GetSuggestedStickerSetNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSuggestedStickerSetNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSuggestedStickerSetName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw("}")
+}
+
+func (req GetSuggestedStickerSetNameRequest) Validate() error {
+	if err := validateLength("title", req.Title, 1, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns a suggested name for a new sticker set with a given title
+func (client *Client) GetSuggestedStickerSetName(ctx context.Context, req *GetSuggestedStickerSetNameRequest) (*Text, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalText)
+}
+
+type CheckStickerSetNameRequest struct {
+	request
+	// Name to be checked
+	Name string `json:"name"`
+}
+
+func (req CheckStickerSetNameRequest) GetFunctionName() string {
+	return "checkStickerSetName"
+}
+
+func (req *CheckStickerSetNameRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CheckStickerSetNameRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkStickerSetName"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req CheckStickerSetNameRequest) Validate() error {
+	return nil
+}
+
+// Checks whether a name can be used for a new sticker set
+func (client *Client) CheckStickerSetName(ctx context.Context, req *CheckStickerSetNameRequest) (CheckStickerSetNameResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCheckStickerSetNameResult)
+}
+
+type CreateNewStickerSetRequest struct {
+	request
+	// Sticker set owner; ignored for regular users
+	UserId int64 `json:"user_id"`
+	// Sticker set title; 1-64 characters
+	Title string `json:"title"`
+	// Sticker set name. Can contain only English letters, digits and underscores. Must end with *"_by_<bot username>"* (*<bot_username>* is case insensitive) for bots; 0-64 characters. If empty, then the name returned by getSuggestedStickerSetName will be used automatically
+	Name string `json:"name"`
+	// Type of the stickers in the set
+	StickerType StickerType `json:"sticker_type"`
+	// Pass true if stickers in the sticker set must be repainted; for custom emoji sticker sets only
+	NeedsRepainting bool `json:"needs_repainting"`
+	// List of stickers to be added to the set; 1-200 stickers for custom emoji sticker sets, and 1-120 stickers otherwise. For TGS stickers, uploadStickerFile must be used before the sticker is shown
+	Stickers []*InputSticker `json:"stickers"`
+	// Source of the sticker set; may be empty if unknown
+	Source string `json:"source"`
+}
+
+func (req CreateNewStickerSetRequest) GetFunctionName() string {
+	return "createNewStickerSet"
+}
+
+func (req *CreateNewStickerSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CreateNewStickerSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createNewStickerSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(req.StickerType)
+	encoder.writeRaw(`,"needs_repainting":`)
+	encoder.writeBool(req.NeedsRepainting)
+	encoder.writeRaw(`,"stickers":`)
+	writeList(encoder, req.Stickers, writeTypeValue[*InputSticker])
+	encoder.writeRaw(`,"source":`)
+	encoder.writeString(req.Source)
+	encoder.writeRaw("}")
+}
+
+func (req CreateNewStickerSetRequest) Validate() error {
+	if err := validateLength("title", req.Title, 1, 64); err != nil {
+		return err
+	}
+
+	if err := validateLength("name", req.Name, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Creates a new sticker set. Returns the newly created sticker set
+func (client *Client) CreateNewStickerSet(ctx context.Context, req *CreateNewStickerSetRequest) (*StickerSet, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSet)
+}
+
+type AddStickerToSetRequest struct {
+	request
+	// Sticker set owner; ignored for regular users
+	UserId int64 `json:"user_id"`
+	// Sticker set name. The sticker set must be owned by the current user, and contain less than 200 stickers for custom emoji sticker sets and less than 120 otherwise
+	Name string `json:"name"`
+	// Sticker to add to the set
+	Sticker *InputSticker `json:"sticker"`
+}
+
+func (req AddStickerToSetRequest) GetFunctionName() string {
+	return "addStickerToSet"
+}
+
+func (req *AddStickerToSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddStickerToSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addStickerToSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"sticker":`)
+	req.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req AddStickerToSetRequest) Validate() error {
+	return nil
+}
+
+// Adds a new sticker to a set
+func (client *Client) AddStickerToSet(ctx context.Context, req *AddStickerToSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ReplaceStickerInSetRequest struct {
+	request
+	// Sticker set owner; ignored for regular users
+	UserId int64 `json:"user_id"`
+	// Sticker set name. The sticker set must be owned by the current user
+	Name string `json:"name"`
+	// Sticker to remove from the set
+	OldSticker InputFile `json:"old_sticker"`
+	// Sticker to add to the set
+	NewSticker *InputSticker `json:"new_sticker"`
+}
+
+func (req ReplaceStickerInSetRequest) GetFunctionName() string {
+	return "replaceStickerInSet"
+}
+
+func (req *ReplaceStickerInSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReplaceStickerInSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"replaceStickerInSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"old_sticker":`)
+	encoder.writeType(req.OldSticker)
+	encoder.writeRaw(`,"new_sticker":`)
+	req.NewSticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req ReplaceStickerInSetRequest) Validate() error {
+	return nil
+}
+
+// Replaces existing sticker in a set. The function is equivalent to removeStickerFromSet, then addStickerToSet, then setStickerPositionInSet
+func (client *Client) ReplaceStickerInSet(ctx context.Context, req *ReplaceStickerInSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetStickerSetThumbnailRequest struct {
+	request
+	// Sticker set owner; ignored for regular users
+	UserId int64 `json:"user_id"`
+	// Sticker set name. The sticker set must be owned by the current user
+	Name string `json:"name"`
+	// Thumbnail to set; pass null to remove the sticker set thumbnail
+	Thumbnail InputFile `json:"thumbnail,omitempty"`
+	// Format of the thumbnail; pass null if thumbnail is removed
+	Format StickerFormat `json:"format,omitempty"`
+}
+
+func (req SetStickerSetThumbnailRequest) GetFunctionName() string {
+	return "setStickerSetThumbnail"
+}
+
+func (req *SetStickerSetThumbnailRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStickerSetThumbnailRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStickerSetThumbnail"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	if req.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		encoder.writeType(req.Thumbnail)
+	}
+	if req.Format != nil {
+		encoder.writeRaw(`,"format":`)
+		encoder.writeType(req.Format)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetStickerSetThumbnailRequest) Validate() error {
+	return nil
+}
+
+// Sets a sticker set thumbnail
+func (client *Client) SetStickerSetThumbnail(ctx context.Context, req *SetStickerSetThumbnailRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetCustomEmojiStickerSetThumbnailRequest struct {
+	request
+	// Sticker set name. The sticker set must be owned by the current user
+	Name string `json:"name"`
+	// Identifier of the custom emoji from the sticker set, which will be set as sticker set thumbnail; pass 0 to remove the sticker set thumbnail
+	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
+}
+
+func (req SetCustomEmojiStickerSetThumbnailRequest) GetFunctionName() string {
+	return "setCustomEmojiStickerSetThumbnail"
+}
+
+func (req *SetCustomEmojiStickerSetThumbnailRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetCustomEmojiStickerSetThumbnailRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setCustomEmojiStickerSetThumbnail"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"custom_emoji_id":`)
+	encoder.writeJsonInt64(req.CustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (req SetCustomEmojiStickerSetThumbnailRequest) Validate() error {
+	return nil
+}
+
+// Sets a custom emoji sticker set thumbnail
+func (client *Client) SetCustomEmojiStickerSetThumbnail(ctx context.Context, req *SetCustomEmojiStickerSetThumbnailRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetStickerSetTitleRequest struct {
+	request
+	// Sticker set name. The sticker set must be owned by the current user
+	Name string `json:"name"`
+	// New sticker set title
+	Title string `json:"title"`
+}
+
+func (req SetStickerSetTitleRequest) GetFunctionName() string {
+	return "setStickerSetTitle"
+}
+
+func (req *SetStickerSetTitleRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStickerSetTitleRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStickerSetTitle"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(req.Title)
+	encoder.writeRaw("}")
+}
+
+func (req SetStickerSetTitleRequest) Validate() error {
+	return nil
+}
+
+// Sets a sticker set title
+func (client *Client) SetStickerSetTitle(ctx context.Context, req *SetStickerSetTitleRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteStickerSetRequest struct {
+	request
+	// Sticker set name. The sticker set must be owned by the current user
+	Name string `json:"name"`
+}
+
+func (req DeleteStickerSetRequest) GetFunctionName() string {
+	return "deleteStickerSet"
+}
+
+func (req *DeleteStickerSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteStickerSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteStickerSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(req.Name)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteStickerSetRequest) Validate() error {
+	return nil
+}
+
+// Completely deletes a sticker set
+func (client *Client) DeleteStickerSet(ctx context.Context, req *DeleteStickerSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetStickerPositionInSetRequest struct {
+	request
+	// Sticker
+	Sticker InputFile `json:"sticker"`
+	// New position of the sticker in the set, 0-based
+	Position int32 `json:"position"`
+}
+
+func (req SetStickerPositionInSetRequest) GetFunctionName() string {
+	return "setStickerPositionInSet"
+}
+
+func (req *SetStickerPositionInSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStickerPositionInSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStickerPositionInSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw(`,"position":`)
+	encoder.writeInt32(req.Position)
+	encoder.writeRaw("}")
+}
+
+func (req SetStickerPositionInSetRequest) Validate() error {
+	return nil
+}
+
+// Changes the position of a sticker in the set to which it belongs. The sticker set must be owned by the current user
+func (client *Client) SetStickerPositionInSet(ctx context.Context, req *SetStickerPositionInSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type RemoveStickerFromSetRequest struct {
+	request
+	// Sticker to remove from the set
+	Sticker InputFile `json:"sticker"`
+}
+
+func (req RemoveStickerFromSetRequest) GetFunctionName() string {
+	return "removeStickerFromSet"
+}
+
+func (req *RemoveStickerFromSetRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveStickerFromSetRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeStickerFromSet"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveStickerFromSetRequest) Validate() error {
+	return nil
+}
+
+// Removes a sticker from the set to which it belongs. The sticker set must be owned by the current user
+func (client *Client) RemoveStickerFromSet(ctx context.Context, req *RemoveStickerFromSetRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetStickerEmojisRequest struct {
+	request
+	// Sticker
+	Sticker InputFile `json:"sticker"`
+	// New string with 1-20 emoji corresponding to the sticker
+	Emojis string `json:"emojis"`
+}
+
+func (req SetStickerEmojisRequest) GetFunctionName() string {
+	return "setStickerEmojis"
+}
+
+func (req *SetStickerEmojisRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStickerEmojisRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStickerEmojis"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw(`,"emojis":`)
+	encoder.writeString(req.Emojis)
+	encoder.writeRaw("}")
+}
+
+func (req SetStickerEmojisRequest) Validate() error {
+	return nil
+}
+
+// Changes the list of emojis corresponding to a sticker. The sticker must belong to a regular or custom emoji sticker set that is owned by the current user
+func (client *Client) SetStickerEmojis(ctx context.Context, req *SetStickerEmojisRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetStickerKeywordsRequest struct {
+	request
+	// Sticker
+	Sticker InputFile `json:"sticker"`
+	// List of up to 20 keywords with total length up to 64 characters, which can be used to find the sticker
+	Keywords []string `json:"keywords"`
+}
+
+func (req SetStickerKeywordsRequest) GetFunctionName() string {
+	return "setStickerKeywords"
+}
+
+func (req *SetStickerKeywordsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStickerKeywordsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStickerKeywords"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	encoder.writeRaw(`,"keywords":`)
+	writeList(encoder, req.Keywords, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (req SetStickerKeywordsRequest) Validate() error {
+	return nil
+}
+
+// Changes the list of keywords of a sticker. The sticker must belong to a regular or custom emoji sticker set that is owned by the current user
+func (client *Client) SetStickerKeywords(ctx context.Context, req *SetStickerKeywordsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetStickerMaskPositionRequest struct {
+	request
+	// Sticker
+	Sticker InputFile `json:"sticker"`
+	// Position where the mask is placed; pass null to remove mask position
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+}
+
+func (req SetStickerMaskPositionRequest) GetFunctionName() string {
+	return "setStickerMaskPosition"
+}
+
+func (req *SetStickerMaskPositionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStickerMaskPositionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStickerMaskPosition"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(req.Sticker)
+	if req.MaskPosition != nil {
+		encoder.writeRaw(`,"mask_position":`)
+		req.MaskPosition.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetStickerMaskPositionRequest) Validate() error {
+	return nil
+}
+
+// Changes the mask position of a mask sticker. The sticker must belong to a mask sticker set that is owned by the current user
+func (client *Client) SetStickerMaskPosition(ctx context.Context, req *SetStickerMaskPositionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetOwnedStickerSetsRequest struct {
+	request
+	// Identifier of the sticker set from which to return owned sticker sets; use 0 to get results from the beginning
+	OffsetStickerSetId JsonInt64 `json:"offset_sticker_set_id"`
+	// The maximum number of sticker sets to be returned; must be positive and can't be greater than 100. For optimal performance, the number of returned objects is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetOwnedStickerSetsRequest) GetFunctionName() string {
+	return "getOwnedStickerSets"
+}
+
+func (req *GetOwnedStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetOwnedStickerSetsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getOwnedStickerSets"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"offset_sticker_set_id":`)
+	encoder.writeJsonInt64(req.OffsetStickerSetId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetOwnedStickerSetsRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns sticker sets owned by the current user
+//
+// Available to users only
+func (client *Client) GetOwnedStickerSets(ctx context.Context, req *GetOwnedStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStickerSets)
+}
@@ -0,0 +1,1627 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type SearchPublicStoriesByTagRequest struct {
+	request
+	// Identifier of the chat that posted the stories to search for; pass 0 to search stories in all chats
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Hashtag or cashtag to search for
+	Tag string `json:"tag"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of stories to be returned; up to 100. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchPublicStoriesByTagRequest) GetFunctionName() string {
+	return "searchPublicStoriesByTag"
+}
+
+func (req *SearchPublicStoriesByTagRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchPublicStoriesByTagRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchPublicStoriesByTag"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"tag":`)
+	encoder.writeString(req.Tag)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchPublicStoriesByTagRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for public stories containing the given hashtag or cashtag. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+//
+// Available to users only
+func (client *Client) SearchPublicStoriesByTag(ctx context.Context, req *SearchPublicStoriesByTagRequest) (*FoundStories, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundStories)
+}
+
+type SearchPublicStoriesByLocationRequest struct {
+	request
+	// Address of the location
+	Address *LocationAddress `json:"address"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of stories to be returned; up to 100. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchPublicStoriesByLocationRequest) GetFunctionName() string {
+	return "searchPublicStoriesByLocation"
+}
+
+func (req *SearchPublicStoriesByLocationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchPublicStoriesByLocationRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchPublicStoriesByLocation"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"address":`)
+	req.Address.encodeJSON(encoder)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchPublicStoriesByLocationRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for public stories by the given address location. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+//
+// Available to users only
+func (client *Client) SearchPublicStoriesByLocation(ctx context.Context, req *SearchPublicStoriesByLocationRequest) (*FoundStories, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundStories)
+}
+
+type SearchPublicStoriesByVenueRequest struct {
+	request
+	// Provider of the venue
+	VenueProvider string `json:"venue_provider"`
+	// Identifier of the venue in the provider database
+	VenueId string `json:"venue_id"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of stories to be returned; up to 100. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchPublicStoriesByVenueRequest) GetFunctionName() string {
+	return "searchPublicStoriesByVenue"
+}
+
+func (req *SearchPublicStoriesByVenueRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchPublicStoriesByVenueRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchPublicStoriesByVenue"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"venue_provider":`)
+	encoder.writeString(req.VenueProvider)
+	encoder.writeRaw(`,"venue_id":`)
+	encoder.writeString(req.VenueId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchPublicStoriesByVenueRequest) Validate() error {
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Searches for public stories from the given venue. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+//
+// Available to users only
+func (client *Client) SearchPublicStoriesByVenue(ctx context.Context, req *SearchPublicStoriesByVenueRequest) (*FoundStories, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalFoundStories)
+}
+
+type GetStoryRequest struct {
+	request
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+	// Pass true to get only locally available information without sending network requests
+	OnlyLocal bool `json:"only_local"`
+}
+
+func (req GetStoryRequest) GetFunctionName() string {
+	return "getStory"
+}
+
+func (req *GetStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"only_local":`)
+	encoder.writeBool(req.OnlyLocal)
+	encoder.writeRaw("}")
+}
+
+func (req GetStoryRequest) Validate() error {
+	return nil
+}
+
+// Returns a story
+//
+// Available to users only
+func (client *Client) GetStory(ctx context.Context, req *GetStoryRequest) (*Story, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStory)
+}
+
+type GetChatsToSendStoriesRequest struct {
+	request
+}
+
+func (req GetChatsToSendStoriesRequest) GetFunctionName() string {
+	return "getChatsToSendStories"
+}
+
+func (req *GetChatsToSendStoriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatsToSendStoriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatsToSendStories"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatsToSendStoriesRequest) Validate() error {
+	return nil
+}
+
+// Returns supergroup and channel chats in which the current user has the right to post stories. The chats must be rechecked with canSendStory before actually trying to post a story there
+//
+// Available to users only
+func (client *Client) GetChatsToSendStories(ctx context.Context) (*Chats, error) {
+	req := &GetChatsToSendStoriesRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type CanSendStoryRequest struct {
+	request
+	// Chat identifier. Pass Saved Messages chat identifier when posting a story on behalf of the current user
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req CanSendStoryRequest) GetFunctionName() string {
+	return "canSendStory"
+}
+
+func (req *CanSendStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CanSendStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req CanSendStoryRequest) Validate() error {
+	return nil
+}
+
+// Checks whether the current user can send a story on behalf of a chat; requires can_post_stories right for supergroup and channel chats
+//
+// Available to users only
+func (client *Client) CanSendStory(ctx context.Context, req *CanSendStoryRequest) (CanSendStoryResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCanSendStoryResult)
+}
+
+type SendStoryRequest struct {
+	request
+	// Identifier of the chat that will post the story. Pass Saved Messages chat identifier when posting a story on behalf of the current user
+	ChatId int64 `json:"chat_id"`
+	// Content of the story
+	Content InputStoryContent `json:"content"`
+	// Clickable rectangle areas to be shown on the story media; pass null if none
+	Areas *InputStoryAreas `json:"areas,omitempty"`
+	// Story caption; pass null to use an empty caption; 0-getOption("story_caption_length_max") characters; can have entities only if getOption("can_use_text_entities_in_story_caption")
+	Caption *FormattedText `json:"caption,omitempty"`
+	// The privacy settings for the story; ignored for stories sent to supergroup and channel chats
+	PrivacySettings StoryPrivacySettings `json:"privacy_settings"`
+	// Period after which the story is moved to archive, in seconds; must be one of 6 * 3600, 12 * 3600, 86400, or 2 * 86400 for Telegram Premium users, and 86400 otherwise
+	ActivePeriod int32 `json:"active_period"`
+	// Full identifier of the original story, which content was used to create the story; pass null if the story isn't repost of another story
+	FromStoryFullId *StoryFullId `json:"from_story_full_id,omitempty"`
+	// Pass true to keep the story accessible after expiration
+	IsPostedToChatPage bool `json:"is_posted_to_chat_page"`
+	// Pass true if the content of the story must be protected from forwarding and screenshotting
+	ProtectContent bool `json:"protect_content"`
+}
+
+func (req SendStoryRequest) GetFunctionName() string {
+	return "sendStory"
+}
+
+func (req *SendStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SendStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sendStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(req.Content)
+	if req.Areas != nil {
+		encoder.writeRaw(`,"areas":`)
+		req.Areas.encodeJSON(encoder)
+	}
+	if req.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		req.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"privacy_settings":`)
+	encoder.writeType(req.PrivacySettings)
+	encoder.writeRaw(`,"active_period":`)
+	encoder.writeInt32(req.ActivePeriod)
+	if req.FromStoryFullId != nil {
+		encoder.writeRaw(`,"from_story_full_id":`)
+		req.FromStoryFullId.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_posted_to_chat_page":`)
+	encoder.writeBool(req.IsPostedToChatPage)
+	encoder.writeRaw(`,"protect_content":`)
+	encoder.writeBool(req.ProtectContent)
+	encoder.writeRaw("}")
+}
+
+func (req SendStoryRequest) Validate() error {
+	return nil
+}
+
+// Sends a new story to a chat; requires can_post_stories right for supergroup and channel chats. Returns a temporary story
+func (client *Client) SendStory(ctx context.Context, req *SendStoryRequest) (*Story, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStory)
+}
+
+type EditStoryRequest struct {
+	request
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Identifier of the story to edit
+	StoryId int32 `json:"story_id"`
+	// New content of the story; pass null to keep the current content
+	Content InputStoryContent `json:"content,omitempty"`
+	// New clickable rectangle areas to be shown on the story media; pass null to keep the current areas. Areas can't be edited if story content isn't changed
+	Areas *InputStoryAreas `json:"areas,omitempty"`
+	// New story caption; pass null to keep the current caption
+	Caption *FormattedText `json:"caption,omitempty"`
+}
+
+func (req EditStoryRequest) GetFunctionName() string {
+	return "editStory"
+}
+
+func (req *EditStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	if req.Content != nil {
+		encoder.writeRaw(`,"content":`)
+		encoder.writeType(req.Content)
+	}
+	if req.Areas != nil {
+		encoder.writeRaw(`,"areas":`)
+		req.Areas.encodeJSON(encoder)
+	}
+	if req.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		req.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req EditStoryRequest) Validate() error {
+	return nil
+}
+
+// Changes content and caption of a story. Can be called only if story.can_be_edited == true
+//
+// Available to users only
+func (client *Client) EditStory(ctx context.Context, req *EditStoryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type EditStoryCoverRequest struct {
+	request
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Identifier of the story to edit
+	StoryId int32 `json:"story_id"`
+	// New timestamp of the frame, which will be used as video thumbnail
+	CoverFrameTimestamp float64 `json:"cover_frame_timestamp"`
+}
+
+func (req EditStoryCoverRequest) GetFunctionName() string {
+	return "editStoryCover"
+}
+
+func (req *EditStoryCoverRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *EditStoryCoverRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"editStoryCover"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"cover_frame_timestamp":`)
+	encoder.writeFloat64(req.CoverFrameTimestamp)
+	encoder.writeRaw("}")
+}
+
+func (req EditStoryCoverRequest) Validate() error {
+	return nil
+}
+
+// Changes cover of a video story. Can be called only if story.can_be_edited == true and the story isn't being edited now
+//
+// Available to users only
+func (client *Client) EditStoryCover(ctx context.Context, req *EditStoryCoverRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetStoryPrivacySettingsRequest struct {
+	request
+	// Identifier of the story
+	StoryId int32 `json:"story_id"`
+	// The new privacy settings for the story
+	PrivacySettings StoryPrivacySettings `json:"privacy_settings"`
+}
+
+func (req SetStoryPrivacySettingsRequest) GetFunctionName() string {
+	return "setStoryPrivacySettings"
+}
+
+func (req *SetStoryPrivacySettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStoryPrivacySettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStoryPrivacySettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"privacy_settings":`)
+	encoder.writeType(req.PrivacySettings)
+	encoder.writeRaw("}")
+}
+
+func (req SetStoryPrivacySettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes privacy settings of a story. The method can be called only for stories posted on behalf of the current user and if story.can_be_edited == true
+//
+// Available to users only
+func (client *Client) SetStoryPrivacySettings(ctx context.Context, req *SetStoryPrivacySettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ToggleStoryIsPostedToChatPageRequest struct {
+	request
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Identifier of the story
+	StoryId int32 `json:"story_id"`
+	// Pass true to make the story accessible after expiration; pass false to make it private
+	IsPostedToChatPage bool `json:"is_posted_to_chat_page"`
+}
+
+func (req ToggleStoryIsPostedToChatPageRequest) GetFunctionName() string {
+	return "toggleStoryIsPostedToChatPage"
+}
+
+func (req *ToggleStoryIsPostedToChatPageRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ToggleStoryIsPostedToChatPageRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"toggleStoryIsPostedToChatPage"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"is_posted_to_chat_page":`)
+	encoder.writeBool(req.IsPostedToChatPage)
+	encoder.writeRaw("}")
+}
+
+func (req ToggleStoryIsPostedToChatPageRequest) Validate() error {
+	return nil
+}
+
+// Toggles whether a story is accessible after expiration. Can be called only if story.can_toggle_is_posted_to_chat_page == true
+//
+// Available to users only
+func (client *Client) ToggleStoryIsPostedToChatPage(ctx context.Context, req *ToggleStoryIsPostedToChatPageRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteStoryRequest struct {
+	request
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Identifier of the story to delete
+	StoryId int32 `json:"story_id"`
+}
+
+func (req DeleteStoryRequest) GetFunctionName() string {
+	return "deleteStory"
+}
+
+func (req *DeleteStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteStoryRequest) Validate() error {
+	return nil
+}
+
+// Deletes a previously sent story. Can be called only if story.can_be_deleted == true
+//
+// Available to users only
+func (client *Client) DeleteStory(ctx context.Context, req *DeleteStoryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetStoryNotificationSettingsExceptionsRequest struct {
+	request
+}
+
+func (req GetStoryNotificationSettingsExceptionsRequest) GetFunctionName() string {
+	return "getStoryNotificationSettingsExceptions"
+}
+
+func (req *GetStoryNotificationSettingsExceptionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStoryNotificationSettingsExceptionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStoryNotificationSettingsExceptions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetStoryNotificationSettingsExceptionsRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of chats with non-default notification settings for stories
+//
+// Available to users only
+func (client *Client) GetStoryNotificationSettingsExceptions(ctx context.Context) (*Chats, error) {
+	req := &GetStoryNotificationSettingsExceptionsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChats)
+}
+
+type LoadActiveStoriesRequest struct {
+	request
+	// The story list in which to load active stories
+	StoryList StoryList `json:"story_list"`
+}
+
+func (req LoadActiveStoriesRequest) GetFunctionName() string {
+	return "loadActiveStories"
+}
+
+func (req *LoadActiveStoriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *LoadActiveStoriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loadActiveStories"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_list":`)
+	encoder.writeType(req.StoryList)
+	encoder.writeRaw("}")
+}
+
+func (req LoadActiveStoriesRequest) Validate() error {
+	return nil
+}
+
+// Loads more active stories from a story list. The loaded stories will be sent through updates. Active stories are sorted by the pair (active_stories.order, active_stories.story_sender_chat_id) in descending order. Returns a 404 error if all active stories have been loaded
+//
+// Available to users only
+func (client *Client) LoadActiveStories(ctx context.Context, req *LoadActiveStoriesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetChatActiveStoriesListRequest struct {
+	request
+	// Identifier of the chat that posted stories
+	ChatId int64 `json:"chat_id"`
+	// New list for active stories posted by the chat
+	StoryList StoryList `json:"story_list"`
+}
+
+func (req SetChatActiveStoriesListRequest) GetFunctionName() string {
+	return "setChatActiveStoriesList"
+}
+
+func (req *SetChatActiveStoriesListRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatActiveStoriesListRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatActiveStoriesList"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"story_list":`)
+	encoder.writeType(req.StoryList)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatActiveStoriesListRequest) Validate() error {
+	return nil
+}
+
+// Changes story list in which stories from the chat are shown
+//
+// Available to users only
+func (client *Client) SetChatActiveStoriesList(ctx context.Context, req *SetChatActiveStoriesListRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetChatActiveStoriesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (req GetChatActiveStoriesRequest) GetFunctionName() string {
+	return "getChatActiveStories"
+}
+
+func (req *GetChatActiveStoriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatActiveStoriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatActiveStories"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatActiveStoriesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of active stories posted by the given chat
+//
+// Available to users only
+func (client *Client) GetChatActiveStories(ctx context.Context, req *GetChatActiveStoriesRequest) (*ChatActiveStories, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatActiveStories)
+}
+
+type GetChatPostedToChatPageStoriesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the story starting from which stories must be returned; use 0 to get results from pinned and the newest story
+	FromStoryId int32 `json:"from_story_id"`
+	// The maximum number of stories to be returned. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatPostedToChatPageStoriesRequest) GetFunctionName() string {
+	return "getChatPostedToChatPageStories"
+}
+
+func (req *GetChatPostedToChatPageStoriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatPostedToChatPageStoriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatPostedToChatPageStories"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"from_story_id":`)
+	encoder.writeInt32(req.FromStoryId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatPostedToChatPageStoriesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of stories that posted by the given chat to its chat page. If from_story_id == 0, then pinned stories are returned first. Then, stories are returned in reverse chronological order (i.e., in order of decreasing story_id). For optimal performance, the number of returned stories is chosen by TDLib
+//
+// Available to users only
+func (client *Client) GetChatPostedToChatPageStories(ctx context.Context, req *GetChatPostedToChatPageStoriesRequest) (*Stories, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStories)
+}
+
+type GetChatArchivedStoriesRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the story starting from which stories must be returned; use 0 to get results from the last story
+	FromStoryId int32 `json:"from_story_id"`
+	// The maximum number of stories to be returned. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatArchivedStoriesRequest) GetFunctionName() string {
+	return "getChatArchivedStories"
+}
+
+func (req *GetChatArchivedStoriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatArchivedStoriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatArchivedStories"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"from_story_id":`)
+	encoder.writeInt32(req.FromStoryId)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatArchivedStoriesRequest) Validate() error {
+	return nil
+}
+
+// Returns the list of all stories posted by the given chat; requires can_edit_stories right in the chat. The stories are returned in reverse chronological order (i.e., in order of decreasing story_id). For optimal performance, the number of returned stories is chosen by TDLib
+//
+// Available to users only
+func (client *Client) GetChatArchivedStories(ctx context.Context, req *GetChatArchivedStoriesRequest) (*Stories, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStories)
+}
+
+type SetChatPinnedStoriesRequest struct {
+	request
+	// Identifier of the chat that posted the stories
+	ChatId int64 `json:"chat_id"`
+	// New list of pinned stories. All stories must be posted to the chat page first. There can be up to getOption("pinned_story_count_max") pinned stories on a chat page
+	StoryIds []int32 `json:"story_ids"`
+}
+
+func (req SetChatPinnedStoriesRequest) GetFunctionName() string {
+	return "setChatPinnedStories"
+}
+
+func (req *SetChatPinnedStoriesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetChatPinnedStoriesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setChatPinnedStories"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"story_ids":`)
+	writeList(encoder, req.StoryIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (req SetChatPinnedStoriesRequest) Validate() error {
+	return nil
+}
+
+// Changes the list of pinned stories on a chat page; requires can_edit_stories right in the chat
+//
+// Available to users only
+func (client *Client) SetChatPinnedStories(ctx context.Context, req *SetChatPinnedStoriesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type OpenStoryRequest struct {
+	request
+	// The identifier of the sender of the opened story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// The identifier of the story
+	StoryId int32 `json:"story_id"`
+}
+
+func (req OpenStoryRequest) GetFunctionName() string {
+	return "openStory"
+}
+
+func (req *OpenStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *OpenStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"openStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (req OpenStoryRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that a story is opened and is being viewed by the user
+//
+// Available to users only
+func (client *Client) OpenStory(ctx context.Context, req *OpenStoryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type CloseStoryRequest struct {
+	request
+	// The identifier of the sender of the story to close
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// The identifier of the story
+	StoryId int32 `json:"story_id"`
+}
+
+func (req CloseStoryRequest) GetFunctionName() string {
+	return "closeStory"
+}
+
+func (req *CloseStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *CloseStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"closeStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (req CloseStoryRequest) Validate() error {
+	return nil
+}
+
+// Informs TDLib that a story is closed by the user
+//
+// Available to users only
+func (client *Client) CloseStory(ctx context.Context, req *CloseStoryRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetStoryAvailableReactionsRequest struct {
+	request
+	// Number of reaction per row, 5-25
+	RowSize int32 `json:"row_size"`
+}
+
+func (req GetStoryAvailableReactionsRequest) GetFunctionName() string {
+	return "getStoryAvailableReactions"
+}
+
+func (req *GetStoryAvailableReactionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStoryAvailableReactionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStoryAvailableReactions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"row_size":`)
+	encoder.writeInt32(req.RowSize)
+	encoder.writeRaw("}")
+}
+
+func (req GetStoryAvailableReactionsRequest) Validate() error {
+	return nil
+}
+
+// Returns reactions, which can be chosen for a story
+//
+// Available to users only
+func (client *Client) GetStoryAvailableReactions(ctx context.Context, req *GetStoryAvailableReactionsRequest) (*AvailableReactions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalAvailableReactions)
+}
+
+type SetStoryReactionRequest struct {
+	request
+	// The identifier of the sender of the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// The identifier of the story
+	StoryId int32 `json:"story_id"`
+	// Type of the reaction to set; pass null to remove the reaction. Custom emoji reactions can be used only by Telegram Premium users. Paid reactions can't be set
+	ReactionType ReactionType `json:"reaction_type,omitempty"`
+	// Pass true if the reaction needs to be added to recent reactions
+	UpdateRecentReactions bool `json:"update_recent_reactions"`
+}
+
+func (req SetStoryReactionRequest) GetFunctionName() string {
+	return "setStoryReaction"
+}
+
+func (req *SetStoryReactionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetStoryReactionRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setStoryReaction"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	if req.ReactionType != nil {
+		encoder.writeRaw(`,"reaction_type":`)
+		encoder.writeType(req.ReactionType)
+	}
+	encoder.writeRaw(`,"update_recent_reactions":`)
+	encoder.writeBool(req.UpdateRecentReactions)
+	encoder.writeRaw("}")
+}
+
+func (req SetStoryReactionRequest) Validate() error {
+	return nil
+}
+
+// Changes chosen reaction on a story that has already been sent
+//
+// Available to users only
+func (client *Client) SetStoryReaction(ctx context.Context, req *SetStoryReactionRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetStoryInteractionsRequest struct {
+	request
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+	// Query to search for in names, usernames and titles; may be empty to get all relevant interactions
+	Query string `json:"query"`
+	// Pass true to get only interactions by contacts; pass false to get all relevant interactions
+	OnlyContacts bool `json:"only_contacts"`
+	// Pass true to get forwards and reposts first, then reactions, then other views; pass false to get interactions sorted just by interaction date
+	PreferForwards bool `json:"prefer_forwards"`
+	// Pass true to get interactions with reaction first; pass false to get interactions sorted just by interaction date. Ignored if prefer_forwards == true
+	PreferWithReaction bool `json:"prefer_with_reaction"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of story interactions to return
+	Limit int32 `json:"limit"`
+}
+
+func (req GetStoryInteractionsRequest) GetFunctionName() string {
+	return "getStoryInteractions"
+}
+
+func (req *GetStoryInteractionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStoryInteractionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStoryInteractions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"only_contacts":`)
+	encoder.writeBool(req.OnlyContacts)
+	encoder.writeRaw(`,"prefer_forwards":`)
+	encoder.writeBool(req.PreferForwards)
+	encoder.writeRaw(`,"prefer_with_reaction":`)
+	encoder.writeBool(req.PreferWithReaction)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetStoryInteractionsRequest) Validate() error {
+	return nil
+}
+
+// Returns interactions with a story. The method can be called only for stories posted on behalf of the current user
+//
+// Available to users only
+func (client *Client) GetStoryInteractions(ctx context.Context, req *GetStoryInteractionsRequest) (*StoryInteractions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStoryInteractions)
+}
+
+type GetChatStoryInteractionsRequest struct {
+	request
+	// The identifier of the sender of the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+	// Pass the default heart reaction or a suggested reaction type to receive only interactions with the specified reaction type; pass null to receive all interactions; reactionTypePaid isn't supported
+	ReactionType ReactionType `json:"reaction_type,omitempty"`
+	// Pass true to get forwards and reposts first, then reactions, then other views; pass false to get interactions sorted just by interaction date
+	PreferForwards bool `json:"prefer_forwards"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of story interactions to return
+	Limit int32 `json:"limit"`
+}
+
+func (req GetChatStoryInteractionsRequest) GetFunctionName() string {
+	return "getChatStoryInteractions"
+}
+
+func (req *GetChatStoryInteractionsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetChatStoryInteractionsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getChatStoryInteractions"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	if req.ReactionType != nil {
+		encoder.writeRaw(`,"reaction_type":`)
+		encoder.writeType(req.ReactionType)
+	}
+	encoder.writeRaw(`,"prefer_forwards":`)
+	encoder.writeBool(req.PreferForwards)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetChatStoryInteractionsRequest) Validate() error {
+	return nil
+}
+
+// Returns interactions with a story posted in a chat. Can be used only if story is posted on behalf of a chat and the user is an administrator in the chat
+//
+// Available to users only
+func (client *Client) GetChatStoryInteractions(ctx context.Context, req *GetChatStoryInteractionsRequest) (*StoryInteractions, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStoryInteractions)
+}
+
+type ReportStoryRequest struct {
+	request
+	// The identifier of the sender of the story to report
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// The identifier of the story to report
+	StoryId int32 `json:"story_id"`
+	// Option identifier chosen by the user; leave empty for the initial request
+	OptionId []byte `json:"option_id"`
+	// Additional report details; 0-1024 characters; leave empty for the initial request
+	Text string `json:"text"`
+}
+
+func (req ReportStoryRequest) GetFunctionName() string {
+	return "reportStory"
+}
+
+func (req *ReportStoryRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ReportStoryRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportStory"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"option_id":`)
+	encoder.writeBytes(req.OptionId)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(req.Text)
+	encoder.writeRaw("}")
+}
+
+func (req ReportStoryRequest) Validate() error {
+	if err := validateLength("text", req.Text, 0, 1024); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reports a story to the Telegram moderators
+//
+// Available to users only
+func (client *Client) ReportStory(ctx context.Context, req *ReportStoryRequest) (ReportStoryResult, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalReportStoryResult)
+}
+
+type ActivateStoryStealthModeRequest struct {
+	request
+}
+
+func (req ActivateStoryStealthModeRequest) GetFunctionName() string {
+	return "activateStoryStealthMode"
+}
+
+func (req *ActivateStoryStealthModeRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ActivateStoryStealthModeRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"activateStoryStealthMode"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ActivateStoryStealthModeRequest) Validate() error {
+	return nil
+}
+
+// Activates stealth mode for stories, which hides all views of stories from the current user in the last "story_stealth_mode_past_period" seconds and for the next "story_stealth_mode_future_period" seconds; for Telegram Premium users only
+func (client *Client) ActivateStoryStealthMode(ctx context.Context) (*Ok, error) {
+	req := &ActivateStoryStealthModeRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetStoryPublicForwardsRequest struct {
+	request
+	// The identifier of the sender of the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// The identifier of the story
+	StoryId int32 `json:"story_id"`
+	// Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset"`
+	// The maximum number of messages and stories to be returned; must be positive and can't be greater than 100. For optimal performance, the number of returned objects is chosen by TDLib and can be smaller than the specified limit
+	Limit int32 `json:"limit"`
+}
+
+func (req GetStoryPublicForwardsRequest) GetFunctionName() string {
+	return "getStoryPublicForwards"
+}
+
+func (req *GetStoryPublicForwardsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStoryPublicForwardsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStoryPublicForwards"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(req.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetStoryPublicForwardsRequest) Validate() error {
+	if err := validateMin("limit", int64(req.Limit), 1); err != nil {
+		return err
+	}
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns forwards of a story as a message to public chats and reposts by public channels. Can be used only if the story is posted on behalf of the current user or story.can_get_statistics == true. For optimal performance, the number of returned messages and stories is chosen by TDLib
+//
+// Available to users only
+func (client *Client) GetStoryPublicForwards(ctx context.Context, req *GetStoryPublicForwardsRequest) (*PublicForwards, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalPublicForwards)
+}
+
+type GetStoryStatisticsRequest struct {
+	request
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+	// Pass true if a dark theme is used by the application
+	IsDark bool `json:"is_dark"`
+}
+
+func (req GetStoryStatisticsRequest) GetFunctionName() string {
+	return "getStoryStatistics"
+}
+
+func (req *GetStoryStatisticsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetStoryStatisticsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getStoryStatistics"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(req.StoryId)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(req.IsDark)
+	encoder.writeRaw("}")
+}
+
+func (req GetStoryStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Returns detailed statistics about a story. Can be used only if story.can_get_statistics == true
+//
+// Available to users only
+func (client *Client) GetStoryStatistics(ctx context.Context, req *GetStoryStatisticsRequest) (*StoryStatistics, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalStoryStatistics)
+}
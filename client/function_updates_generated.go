@@ -0,0 +1,99 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type SetBotUpdatesStatusRequest struct {
+	request
+	// The number of pending updates
+	PendingUpdateCount int32 `json:"pending_update_count"`
+	// The last error message
+	ErrorMessage string `json:"error_message"`
+}
+
+func (req SetBotUpdatesStatusRequest) GetFunctionName() string {
+	return "setBotUpdatesStatus"
+}
+
+func (req *SetBotUpdatesStatusRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBotUpdatesStatusRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBotUpdatesStatus"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"pending_update_count":`)
+	encoder.writeInt32(req.PendingUpdateCount)
+	encoder.writeRaw(`,"error_message":`)
+	encoder.writeString(req.ErrorMessage)
+	encoder.writeRaw("}")
+}
+
+func (req SetBotUpdatesStatusRequest) Validate() error {
+	return nil
+}
+
+// Informs the server about the number of pending bot updates if they haven't been processed for a long time; for bots only
+//
+// Available to bots only
+func (client *Client) SetBotUpdatesStatus(ctx context.Context, req *SetBotUpdatesStatusRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type TestUseUpdateRequest struct {
+	request
+}
+
+func (req TestUseUpdateRequest) GetFunctionName() string {
+	return "testUseUpdate"
+}
+
+func (req *TestUseUpdateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *TestUseUpdateRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testUseUpdate"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req TestUseUpdateRequest) Validate() error {
+	return nil
+}
+
+// Does nothing and ensures that the Update object is used; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestUseUpdate(ctx context.Context) (Update, error) {
+	req := &TestUseUpdateRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUpdate)
+}
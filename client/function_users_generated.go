@@ -0,0 +1,1493 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+type RegisterUserRequest struct {
+	request
+	// The first name of the user; 1-64 characters
+	FirstName string `json:"first_name"`
+	// The last name of the user; 0-64 characters
+	LastName string `json:"last_name"`
+	// Pass true to disable notification about the current user joining Telegram for other users that added them to contact list
+	DisableNotification bool `json:"disable_notification"`
+}
+
+func (req RegisterUserRequest) GetFunctionName() string {
+	return "registerUser"
+}
+
+func (req *RegisterUserRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RegisterUserRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"registerUser"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"first_name":`)
+	encoder.writeString(req.FirstName)
+	encoder.writeRaw(`,"last_name":`)
+	encoder.writeString(req.LastName)
+	encoder.writeRaw(`,"disable_notification":`)
+	encoder.writeBool(req.DisableNotification)
+	encoder.writeRaw("}")
+}
+
+func (req RegisterUserRequest) Validate() error {
+	if err := validateLength("first_name", req.FirstName, 1, 64); err != nil {
+		return err
+	}
+
+	if err := validateLength("last_name", req.LastName, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Finishes user registration. Works only when the current authorization state is authorizationStateWaitRegistration
+func (client *Client) RegisterUser(ctx context.Context, req *RegisterUserRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetUserRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetUserRequest) GetFunctionName() string {
+	return "getUser"
+}
+
+func (req *GetUserRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUserRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUser"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetUserRequest) Validate() error {
+	return nil
+}
+
+// Returns information about a user by their identifier. This is an offline method if the current user is not a bot
+func (client *Client) GetUser(ctx context.Context, req *GetUserRequest) (*User, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUser)
+}
+
+type GetUserFullInfoRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetUserFullInfoRequest) GetFunctionName() string {
+	return "getUserFullInfo"
+}
+
+func (req *GetUserFullInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUserFullInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUserFullInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetUserFullInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns full information about a user by their identifier
+func (client *Client) GetUserFullInfo(ctx context.Context, req *GetUserFullInfoRequest) (*UserFullInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUserFullInfo)
+}
+
+type HideContactCloseBirthdaysRequest struct {
+	request
+}
+
+func (req HideContactCloseBirthdaysRequest) GetFunctionName() string {
+	return "hideContactCloseBirthdays"
+}
+
+func (req *HideContactCloseBirthdaysRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *HideContactCloseBirthdaysRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"hideContactCloseBirthdays"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req HideContactCloseBirthdaysRequest) Validate() error {
+	return nil
+}
+
+// Hides the list of contacts that have close birthdays for 24 hours
+//
+// Available to users only
+func (client *Client) HideContactCloseBirthdays(ctx context.Context) (*Ok, error) {
+	req := &HideContactCloseBirthdaysRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ShareUsersWithBotRequest struct {
+	request
+	// Identifier of the chat with the bot
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message with the button
+	MessageId int64 `json:"message_id"`
+	// Identifier of the button
+	ButtonId int32 `json:"button_id"`
+	// Identifiers of the shared users
+	SharedUserIds []int64 `json:"shared_user_ids"`
+	// Pass true to check that the users can be shared by the button instead of actually sharing them
+	OnlyCheck bool `json:"only_check"`
+}
+
+func (req ShareUsersWithBotRequest) GetFunctionName() string {
+	return "shareUsersWithBot"
+}
+
+func (req *ShareUsersWithBotRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ShareUsersWithBotRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"shareUsersWithBot"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(req.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(req.MessageId)
+	encoder.writeRaw(`,"button_id":`)
+	encoder.writeInt32(req.ButtonId)
+	encoder.writeRaw(`,"shared_user_ids":`)
+	writeList(encoder, req.SharedUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"only_check":`)
+	encoder.writeBool(req.OnlyCheck)
+	encoder.writeRaw("}")
+}
+
+func (req ShareUsersWithBotRequest) Validate() error {
+	return nil
+}
+
+// Shares users after pressing a keyboardButtonTypeRequestUsers button with the bot
+//
+// Available to users only
+func (client *Client) ShareUsersWithBot(ctx context.Context, req *ShareUsersWithBotRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type AddContactRequest struct {
+	request
+	// The contact to add or edit; phone number may be empty and needs to be specified only if known, vCard is ignored
+	Contact *Contact `json:"contact"`
+	// Pass true to share the current user's phone number with the new contact. A corresponding rule to userPrivacySettingShowPhoneNumber will be added if needed. Use the field userFullInfo.need_phone_number_privacy_exception to check whether the current user needs to be asked to share their phone number
+	SharePhoneNumber bool `json:"share_phone_number"`
+}
+
+func (req AddContactRequest) GetFunctionName() string {
+	return "addContact"
+}
+
+func (req *AddContactRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *AddContactRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addContact"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"contact":`)
+	req.Contact.encodeJSON(encoder)
+	encoder.writeRaw(`,"share_phone_number":`)
+	encoder.writeBool(req.SharePhoneNumber)
+	encoder.writeRaw("}")
+}
+
+func (req AddContactRequest) Validate() error {
+	if err := validateNested("contact", req.Contact); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Adds a user to the contact list or edits an existing contact by their user identifier
+//
+// Available to users only
+func (client *Client) AddContact(ctx context.Context, req *AddContactRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type ImportContactsRequest struct {
+	request
+	// The list of contacts to import or edit; contacts' vCard are ignored and are not imported
+	Contacts []*Contact `json:"contacts"`
+}
+
+func (req ImportContactsRequest) GetFunctionName() string {
+	return "importContacts"
+}
+
+func (req *ImportContactsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ImportContactsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"importContacts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"contacts":`)
+	writeList(encoder, req.Contacts, writeTypeValue[*Contact])
+	encoder.writeRaw("}")
+}
+
+func (req ImportContactsRequest) Validate() error {
+	for i, item := range req.Contacts {
+		if err := validateNested(indexField("contacts", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Adds new contacts or edits existing contacts by their phone numbers; contacts' user identifiers are ignored
+//
+// Available to users only
+func (client *Client) ImportContacts(ctx context.Context, req *ImportContactsRequest) (*ImportedContacts, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalImportedContacts)
+}
+
+type GetContactsRequest struct {
+	request
+}
+
+func (req GetContactsRequest) GetFunctionName() string {
+	return "getContacts"
+}
+
+func (req *GetContactsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetContactsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getContacts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetContactsRequest) Validate() error {
+	return nil
+}
+
+// Returns all contacts of the user
+//
+// Available to users only
+func (client *Client) GetContacts(ctx context.Context) (*Users, error) {
+	req := &GetContactsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUsers)
+}
+
+type SearchContactsRequest struct {
+	request
+	// Query to search for; may be empty to return all contacts
+	Query string `json:"query"`
+	// The maximum number of users to be returned
+	Limit int32 `json:"limit"`
+}
+
+func (req SearchContactsRequest) GetFunctionName() string {
+	return "searchContacts"
+}
+
+func (req *SearchContactsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchContactsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchContacts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(req.Query)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req SearchContactsRequest) Validate() error {
+	return nil
+}
+
+// Searches for the specified query in the first names, last names and usernames of the known user contacts
+//
+// Available to users only
+func (client *Client) SearchContacts(ctx context.Context, req *SearchContactsRequest) (*Users, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUsers)
+}
+
+type RemoveContactsRequest struct {
+	request
+	// Identifiers of users to be deleted
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (req RemoveContactsRequest) GetFunctionName() string {
+	return "removeContacts"
+}
+
+func (req *RemoveContactsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *RemoveContactsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"removeContacts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, req.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (req RemoveContactsRequest) Validate() error {
+	return nil
+}
+
+// Removes users from the contact list
+//
+// Available to users only
+func (client *Client) RemoveContacts(ctx context.Context, req *RemoveContactsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetImportedContactCountRequest struct {
+	request
+}
+
+func (req GetImportedContactCountRequest) GetFunctionName() string {
+	return "getImportedContactCount"
+}
+
+func (req *GetImportedContactCountRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetImportedContactCountRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getImportedContactCount"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetImportedContactCountRequest) Validate() error {
+	return nil
+}
+
+// Returns the total number of imported contacts
+//
+// Available to users only
+func (client *Client) GetImportedContactCount(ctx context.Context) (*Count, error) {
+	req := &GetImportedContactCountRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalCount)
+}
+
+type ChangeImportedContactsRequest struct {
+	request
+	// The new list of contacts, contact's vCard are ignored and are not imported
+	Contacts []*Contact `json:"contacts"`
+}
+
+func (req ChangeImportedContactsRequest) GetFunctionName() string {
+	return "changeImportedContacts"
+}
+
+func (req *ChangeImportedContactsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ChangeImportedContactsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"changeImportedContacts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"contacts":`)
+	writeList(encoder, req.Contacts, writeTypeValue[*Contact])
+	encoder.writeRaw("}")
+}
+
+func (req ChangeImportedContactsRequest) Validate() error {
+	for i, item := range req.Contacts {
+		if err := validateNested(indexField("contacts", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Changes imported contacts using the list of contacts saved on the device. Imports newly added contacts and, if at least the file database is enabled, deletes recently deleted contacts. Query result depends on the result of the previous query, so only one query is possible at the same time
+//
+// Available to users only
+func (client *Client) ChangeImportedContacts(ctx context.Context, req *ChangeImportedContactsRequest) (*ImportedContacts, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalImportedContacts)
+}
+
+type ClearImportedContactsRequest struct {
+	request
+}
+
+func (req ClearImportedContactsRequest) GetFunctionName() string {
+	return "clearImportedContacts"
+}
+
+func (req *ClearImportedContactsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *ClearImportedContactsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"clearImportedContacts"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req ClearImportedContactsRequest) Validate() error {
+	return nil
+}
+
+// Clears all imported contacts, contact list remains unchanged
+//
+// Available to users only
+func (client *Client) ClearImportedContacts(ctx context.Context) (*Ok, error) {
+	req := &ClearImportedContactsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetUserPersonalProfilePhotoRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Profile photo to set; pass null to delete the photo; inputChatPhotoPrevious isn't supported in this function
+	Photo InputChatPhoto `json:"photo,omitempty"`
+}
+
+func (req SetUserPersonalProfilePhotoRequest) GetFunctionName() string {
+	return "setUserPersonalProfilePhoto"
+}
+
+func (req *SetUserPersonalProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetUserPersonalProfilePhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setUserPersonalProfilePhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	if req.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		encoder.writeType(req.Photo)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetUserPersonalProfilePhotoRequest) Validate() error {
+	return nil
+}
+
+// Changes a personal profile photo of a contact user
+//
+// Available to users only
+func (client *Client) SetUserPersonalProfilePhoto(ctx context.Context, req *SetUserPersonalProfilePhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SuggestUserProfilePhotoRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Profile photo to suggest; inputChatPhotoPrevious isn't supported in this function
+	Photo InputChatPhoto `json:"photo"`
+}
+
+func (req SuggestUserProfilePhotoRequest) GetFunctionName() string {
+	return "suggestUserProfilePhoto"
+}
+
+func (req *SuggestUserProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SuggestUserProfilePhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestUserProfilePhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"photo":`)
+	encoder.writeType(req.Photo)
+	encoder.writeRaw("}")
+}
+
+func (req SuggestUserProfilePhotoRequest) Validate() error {
+	return nil
+}
+
+// Suggests a profile photo to another regular user with common messages and allowing non-paid messages
+//
+// Available to users only
+func (client *Client) SuggestUserProfilePhoto(ctx context.Context, req *SuggestUserProfilePhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SearchUserByPhoneNumberRequest struct {
+	request
+	// Phone number to search for
+	PhoneNumber string `json:"phone_number"`
+	// Pass true to get only locally available information without sending network requests
+	OnlyLocal bool `json:"only_local"`
+}
+
+func (req SearchUserByPhoneNumberRequest) GetFunctionName() string {
+	return "searchUserByPhoneNumber"
+}
+
+func (req *SearchUserByPhoneNumberRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchUserByPhoneNumberRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchUserByPhoneNumber"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(req.PhoneNumber)
+	encoder.writeRaw(`,"only_local":`)
+	encoder.writeBool(req.OnlyLocal)
+	encoder.writeRaw("}")
+}
+
+func (req SearchUserByPhoneNumberRequest) Validate() error {
+	return nil
+}
+
+// Searches a user by their phone number. Returns a 404 error if the user can't be found
+//
+// Available to users only
+func (client *Client) SearchUserByPhoneNumber(ctx context.Context, req *SearchUserByPhoneNumberRequest) (*User, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUser)
+}
+
+type GetUserProfilePhotosRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// The number of photos to skip; must be non-negative
+	Offset int32 `json:"offset"`
+	// The maximum number of photos to be returned; up to 100
+	Limit int32 `json:"limit"`
+}
+
+func (req GetUserProfilePhotosRequest) GetFunctionName() string {
+	return "getUserProfilePhotos"
+}
+
+func (req *GetUserProfilePhotosRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUserProfilePhotosRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUserProfilePhotos"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(req.Offset)
+	encoder.writeRaw(`,"limit":`)
+	encoder.writeInt32(req.Limit)
+	encoder.writeRaw("}")
+}
+
+func (req GetUserProfilePhotosRequest) Validate() error {
+	if err := validateMin("offset", int64(req.Offset), 0); err != nil {
+		return err
+	}
+
+	if err := validateMax("limit", int64(req.Limit), 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the profile photos of a user. Personal and public photo aren't returned
+func (client *Client) GetUserProfilePhotos(ctx context.Context, req *GetUserProfilePhotosRequest) (*ChatPhotos, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalChatPhotos)
+}
+
+type SetProfilePhotoRequest struct {
+	request
+	// Profile photo to set
+	Photo InputChatPhoto `json:"photo"`
+	// Pass true to set the public photo, which will be visible even the main photo is hidden by privacy settings
+	IsPublic bool `json:"is_public"`
+}
+
+func (req SetProfilePhotoRequest) GetFunctionName() string {
+	return "setProfilePhoto"
+}
+
+func (req *SetProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetProfilePhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setProfilePhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"photo":`)
+	encoder.writeType(req.Photo)
+	encoder.writeRaw(`,"is_public":`)
+	encoder.writeBool(req.IsPublic)
+	encoder.writeRaw("}")
+}
+
+func (req SetProfilePhotoRequest) Validate() error {
+	return nil
+}
+
+// Changes a profile photo for the current user
+//
+// Available to users only
+func (client *Client) SetProfilePhoto(ctx context.Context, req *SetProfilePhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type DeleteProfilePhotoRequest struct {
+	request
+	// Identifier of the profile photo to delete
+	ProfilePhotoId JsonInt64 `json:"profile_photo_id"`
+}
+
+func (req DeleteProfilePhotoRequest) GetFunctionName() string {
+	return "deleteProfilePhoto"
+}
+
+func (req *DeleteProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *DeleteProfilePhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deleteProfilePhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"profile_photo_id":`)
+	encoder.writeJsonInt64(req.ProfilePhotoId)
+	encoder.writeRaw("}")
+}
+
+func (req DeleteProfilePhotoRequest) Validate() error {
+	return nil
+}
+
+// Deletes a profile photo
+//
+// Available to users only
+func (client *Client) DeleteProfilePhoto(ctx context.Context, req *DeleteProfilePhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type SetProfileAccentColorRequest struct {
+	request
+	// Identifier of the accent color to use for profile; pass -1 if none
+	ProfileAccentColorId int32 `json:"profile_accent_color_id"`
+	// Identifier of a custom emoji to be shown on the user's profile photo background; 0 if none
+	ProfileBackgroundCustomEmojiId JsonInt64 `json:"profile_background_custom_emoji_id"`
+}
+
+func (req SetProfileAccentColorRequest) GetFunctionName() string {
+	return "setProfileAccentColor"
+}
+
+func (req *SetProfileAccentColorRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetProfileAccentColorRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setProfileAccentColor"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"profile_accent_color_id":`)
+	encoder.writeInt32(req.ProfileAccentColorId)
+	encoder.writeRaw(`,"profile_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(req.ProfileBackgroundCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (req SetProfileAccentColorRequest) Validate() error {
+	return nil
+}
+
+// Changes accent color and background custom emoji for profile of the current user; for Telegram Premium users only
+//
+// Available to users only
+func (client *Client) SetProfileAccentColor(ctx context.Context, req *SetProfileAccentColorRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetUserLinkRequest struct {
+	request
+}
+
+func (req GetUserLinkRequest) GetFunctionName() string {
+	return "getUserLink"
+}
+
+func (req *GetUserLinkRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUserLinkRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUserLink"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetUserLinkRequest) Validate() error {
+	return nil
+}
+
+// Returns an HTTPS link, which can be used to get information about the current user
+//
+// Available to users only
+func (client *Client) GetUserLink(ctx context.Context) (*UserLink, error) {
+	req := &GetUserLinkRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUserLink)
+}
+
+type SearchUserByTokenRequest struct {
+	request
+	// Token to search for
+	Token string `json:"token"`
+}
+
+func (req SearchUserByTokenRequest) GetFunctionName() string {
+	return "searchUserByToken"
+}
+
+func (req *SearchUserByTokenRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SearchUserByTokenRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchUserByToken"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(req.Token)
+	encoder.writeRaw("}")
+}
+
+func (req SearchUserByTokenRequest) Validate() error {
+	return nil
+}
+
+// Searches a user by a token from the user's link
+//
+// Available to users only
+func (client *Client) SearchUserByToken(ctx context.Context, req *SearchUserByTokenRequest) (*User, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUser)
+}
+
+type SetBotProfilePhotoRequest struct {
+	request
+	// Identifier of the target bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Profile photo to set; pass null to delete the chat photo
+	Photo InputChatPhoto `json:"photo,omitempty"`
+}
+
+func (req SetBotProfilePhotoRequest) GetFunctionName() string {
+	return "setBotProfilePhoto"
+}
+
+func (req *SetBotProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetBotProfilePhotoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setBotProfilePhoto"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(req.BotUserId)
+	if req.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		encoder.writeType(req.Photo)
+	}
+	encoder.writeRaw("}")
+}
+
+func (req SetBotProfilePhotoRequest) Validate() error {
+	return nil
+}
+
+// Changes a profile photo for a bot
+func (client *Client) SetBotProfilePhoto(ctx context.Context, req *SetBotProfilePhotoRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetSupportUserRequest struct {
+	request
+}
+
+func (req GetSupportUserRequest) GetFunctionName() string {
+	return "getSupportUser"
+}
+
+func (req *GetSupportUserRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetSupportUserRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getSupportUser"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetSupportUserRequest) Validate() error {
+	return nil
+}
+
+// Returns a user that can be contacted to get support
+//
+// Available to users only
+func (client *Client) GetSupportUser(ctx context.Context) (*User, error) {
+	req := &GetSupportUserRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUser)
+}
+
+type SetUserPrivacySettingRulesRequest struct {
+	request
+	// The privacy setting
+	Setting UserPrivacySetting `json:"setting"`
+	// The new privacy rules
+	Rules *UserPrivacySettingRules `json:"rules"`
+}
+
+func (req SetUserPrivacySettingRulesRequest) GetFunctionName() string {
+	return "setUserPrivacySettingRules"
+}
+
+func (req *SetUserPrivacySettingRulesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetUserPrivacySettingRulesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setUserPrivacySettingRules"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"setting":`)
+	encoder.writeType(req.Setting)
+	encoder.writeRaw(`,"rules":`)
+	req.Rules.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetUserPrivacySettingRulesRequest) Validate() error {
+	return nil
+}
+
+// Changes user privacy settings
+//
+// Available to users only
+func (client *Client) SetUserPrivacySettingRules(ctx context.Context, req *SetUserPrivacySettingRulesRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetUserPrivacySettingRulesRequest struct {
+	request
+	// The privacy setting
+	Setting UserPrivacySetting `json:"setting"`
+}
+
+func (req GetUserPrivacySettingRulesRequest) GetFunctionName() string {
+	return "getUserPrivacySettingRules"
+}
+
+func (req *GetUserPrivacySettingRulesRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUserPrivacySettingRulesRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUserPrivacySettingRules"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"setting":`)
+	encoder.writeType(req.Setting)
+	encoder.writeRaw("}")
+}
+
+func (req GetUserPrivacySettingRulesRequest) Validate() error {
+	return nil
+}
+
+// Returns the current privacy settings
+//
+// Available to users only
+func (client *Client) GetUserPrivacySettingRules(ctx context.Context, req *GetUserPrivacySettingRulesRequest) (*UserPrivacySettingRules, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUserPrivacySettingRules)
+}
+
+type SetReadDatePrivacySettingsRequest struct {
+	request
+	// New settings
+	Settings *ReadDatePrivacySettings `json:"settings"`
+}
+
+func (req SetReadDatePrivacySettingsRequest) GetFunctionName() string {
+	return "setReadDatePrivacySettings"
+}
+
+func (req *SetReadDatePrivacySettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetReadDatePrivacySettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setReadDatePrivacySettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"settings":`)
+	req.Settings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetReadDatePrivacySettingsRequest) Validate() error {
+	return nil
+}
+
+// Changes privacy settings for message read date
+//
+// Available to users only
+func (client *Client) SetReadDatePrivacySettings(ctx context.Context, req *SetReadDatePrivacySettingsRequest) (*Ok, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalOk)
+}
+
+type GetReadDatePrivacySettingsRequest struct {
+	request
+}
+
+func (req GetReadDatePrivacySettingsRequest) GetFunctionName() string {
+	return "getReadDatePrivacySettings"
+}
+
+func (req *GetReadDatePrivacySettingsRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetReadDatePrivacySettingsRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getReadDatePrivacySettings"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw("}")
+}
+
+func (req GetReadDatePrivacySettingsRequest) Validate() error {
+	return nil
+}
+
+// Returns privacy settings for message read date
+//
+// Available to users only
+func (client *Client) GetReadDatePrivacySettings(ctx context.Context) (*ReadDatePrivacySettings, error) {
+	req := &GetReadDatePrivacySettingsRequest{}
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalReadDatePrivacySettings)
+}
+
+type GetUserSupportInfoRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (req GetUserSupportInfoRequest) GetFunctionName() string {
+	return "getUserSupportInfo"
+}
+
+func (req *GetUserSupportInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *GetUserSupportInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"getUserSupportInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw("}")
+}
+
+func (req GetUserSupportInfoRequest) Validate() error {
+	return nil
+}
+
+// Returns support information for the given user; for Telegram support only
+//
+// Available to users only
+func (client *Client) GetUserSupportInfo(ctx context.Context, req *GetUserSupportInfoRequest) (*UserSupportInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUserSupportInfo)
+}
+
+type SetUserSupportInfoRequest struct {
+	request
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// New information message
+	Message *FormattedText `json:"message"`
+}
+
+func (req SetUserSupportInfoRequest) GetFunctionName() string {
+	return "setUserSupportInfo"
+}
+
+func (req *SetUserSupportInfoRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(req)
+}
+
+func (req *SetUserSupportInfoRequest) encodeJSON(encoder *jsonEncoder) {
+	if req == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"setUserSupportInfo"`)
+	encoder.writeExtra(req.MetaExtra)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(req.UserId)
+	encoder.writeRaw(`,"message":`)
+	req.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (req SetUserSupportInfoRequest) Validate() error {
+	return nil
+}
+
+// Sets support information for the given user; for Telegram support only
+//
+// Available to users only
+func (client *Client) SetUserSupportInfo(ctx context.Context, req *SetUserSupportInfoRequest) (*UserSupportInfo, error) {
+	result, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MetaType == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return unmarshalResult(result, UnmarshalUserSupportInfo)
+}
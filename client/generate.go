@@ -0,0 +1,4 @@
+package client
+
+// The version is TAG in the Makefile. Run `make schema-update` first to upgrade the schema.
+//go:generate make -C .. generate-code
@@ -0,0 +1,1644 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+// TDLib is the set of asynchronous TDLib methods. It is implemented by Client and Mock
+type TDLib interface {
+	// Returns the current authorization state. This is an offline method. For informational purposes only. Use updateAuthorizationState instead to maintain the current authorization state. Can be called before initialization
+	GetAuthorizationState(ctx context.Context) (AuthorizationState, error)
+	// Sets the parameters for TDLib initialization. Works only when the current authorization state is authorizationStateWaitTdlibParameters
+	SetTdlibParameters(ctx context.Context, req *SetTdlibParametersRequest) (*Ok, error)
+	// Sets the phone number of the user and sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitPremiumPurchase, authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
+	SetAuthenticationPhoneNumber(ctx context.Context, req *SetAuthenticationPhoneNumberRequest) (*Ok, error)
+	// Checks whether an in-store purchase of Telegram Premium is possible before authorization. Works only when the current authorization state is authorizationStateWaitPremiumPurchase
+	CheckAuthenticationPremiumPurchase(ctx context.Context, req *CheckAuthenticationPremiumPurchaseRequest) (*Ok, error)
+	// Informs server about an in-store purchase of Telegram Premium before authorization. Works only when the current authorization state is authorizationStateWaitPremiumPurchase
+	SetAuthenticationPremiumPurchaseTransaction(ctx context.Context, req *SetAuthenticationPremiumPurchaseTransactionRequest) (*Ok, error)
+	// Sets the email address of the user and sends an authentication code to the email address. Works only when the current authorization state is authorizationStateWaitEmailAddress
+	SetAuthenticationEmailAddress(ctx context.Context, req *SetAuthenticationEmailAddressRequest) (*Ok, error)
+	// Resends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitCode, the next_code_type of the result is not null and the server-specified timeout has passed, or when the current authorization state is authorizationStateWaitEmailCode
+	ResendAuthenticationCode(ctx context.Context, req *ResendAuthenticationCodeRequest) (*Ok, error)
+	// Checks the authentication of an email address. Works only when the current authorization state is authorizationStateWaitEmailCode
+	CheckAuthenticationEmailCode(ctx context.Context, req *CheckAuthenticationEmailCodeRequest) (*Ok, error)
+	// Checks the authentication code. Works only when the current authorization state is authorizationStateWaitCode
+	CheckAuthenticationCode(ctx context.Context, req *CheckAuthenticationCodeRequest) (*Ok, error)
+	// Requests QR code authentication by scanning a QR code on another logged in device. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitPremiumPurchase, authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
+	RequestQrCodeAuthentication(ctx context.Context, req *RequestQrCodeAuthenticationRequest) (*Ok, error)
+	// Finishes user registration. Works only when the current authorization state is authorizationStateWaitRegistration
+	RegisterUser(ctx context.Context, req *RegisterUserRequest) (*Ok, error)
+	// Resets the login email address. May return an error with a message "TASK_ALREADY_EXISTS" if reset is still pending. Works only when the current authorization state is authorizationStateWaitEmailCode and authorization_state.can_reset_email_address == true
+	ResetAuthenticationEmailAddress(ctx context.Context) (*Ok, error)
+	// Checks the 2-step verification password for correctness. Works only when the current authorization state is authorizationStateWaitPassword
+	CheckAuthenticationPassword(ctx context.Context, req *CheckAuthenticationPasswordRequest) (*Ok, error)
+	// Requests to send a 2-step verification password recovery code to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+	RequestAuthenticationPasswordRecovery(ctx context.Context) (*Ok, error)
+	// Checks whether a 2-step verification password recovery code sent to an email address is valid. Works only when the current authorization state is authorizationStateWaitPassword
+	CheckAuthenticationPasswordRecoveryCode(ctx context.Context, req *CheckAuthenticationPasswordRecoveryCodeRequest) (*Ok, error)
+	// Recovers the 2-step verification password with a password recovery code sent to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+	RecoverAuthenticationPassword(ctx context.Context, req *RecoverAuthenticationPasswordRequest) (*Ok, error)
+	// Sends Firebase Authentication SMS to the phone number of the user. Works only when the current authorization state is authorizationStateWaitCode and the server returned code of the type authenticationCodeTypeFirebaseAndroid or authenticationCodeTypeFirebaseIos
+	SendAuthenticationFirebaseSms(ctx context.Context, req *SendAuthenticationFirebaseSmsRequest) (*Ok, error)
+	// Reports that authentication code wasn't delivered via SMS; for official mobile applications only. Works only when the current authorization state is authorizationStateWaitCode
+	ReportAuthenticationCodeMissing(ctx context.Context, req *ReportAuthenticationCodeMissingRequest) (*Ok, error)
+	// Checks the authentication token of a bot; to log in as a bot. Works only when the current authorization state is authorizationStateWaitPhoneNumber. Can be used instead of setAuthenticationPhoneNumber and checkAuthenticationCode to log in
+	CheckAuthenticationBotToken(ctx context.Context, req *CheckAuthenticationBotTokenRequest) (*Ok, error)
+	// Closes the TDLib instance after a proper logout. Requires an available network connection. All local data will be destroyed. After the logout completes, updateAuthorizationState with authorizationStateClosed will be sent
+	LogOut(ctx context.Context) (*Ok, error)
+	// Closes the TDLib instance. All databases will be flushed to disk and properly closed. After the close completes, updateAuthorizationState with authorizationStateClosed will be sent. Can be called before initialization
+	Close(ctx context.Context) (*Ok, error)
+	// Closes the TDLib instance, destroying all local data without a proper logout. The current user session will remain in the list of all active sessions. All local data will be destroyed. After the destruction completes updateAuthorizationState with authorizationStateClosed will be sent. Can be called before authorization
+	Destroy(ctx context.Context) (*Ok, error)
+	// Confirms QR code authentication on another device. Returns created session on success
+	ConfirmQrCodeAuthentication(ctx context.Context, req *ConfirmQrCodeAuthenticationRequest) (*Session, error)
+	// Returns all updates needed to restore current TDLib state, i.e. all actual updateAuthorizationState/updateUser/updateNewChat and others. This is especially useful if TDLib is run in a separate process. Can be called before initialization
+	GetCurrentState(ctx context.Context) (*Updates, error)
+	// Changes the database encryption key. Usually the encryption key is never changed and is stored in some OS keychain
+	SetDatabaseEncryptionKey(ctx context.Context, req *SetDatabaseEncryptionKeyRequest) (*Ok, error)
+	// Returns the current state of 2-step verification
+	GetPasswordState(ctx context.Context) (*PasswordState, error)
+	// Changes the 2-step verification password for the current user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed
+	SetPassword(ctx context.Context, req *SetPasswordRequest) (*PasswordState, error)
+	// Changes the login email address of the user. The email address can be changed only if the current user already has login email and passwordState.login_email_address_pattern is non-empty. The change will not be applied until the new login email address is confirmed with checkLoginEmailAddressCode. To use Apple ID/Google ID instead of an email address, call checkLoginEmailAddressCode directly
+	SetLoginEmailAddress(ctx context.Context, req *SetLoginEmailAddressRequest) (*EmailAddressAuthenticationCodeInfo, error)
+	// Resends the login email address verification code
+	ResendLoginEmailAddressCode(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error)
+	// Checks the login email address authentication
+	CheckLoginEmailAddressCode(ctx context.Context, req *CheckLoginEmailAddressCodeRequest) (*Ok, error)
+	// Returns a 2-step verification recovery email address that was previously set up. This method can be used to verify a password provided by the user
+	GetRecoveryEmailAddress(ctx context.Context, req *GetRecoveryEmailAddressRequest) (*RecoveryEmailAddress, error)
+	// Changes the 2-step verification recovery email address of the user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed. If new_recovery_email_address is the same as the email address that is currently set up, this call succeeds immediately and aborts all other requests waiting for an email confirmation
+	SetRecoveryEmailAddress(ctx context.Context, req *SetRecoveryEmailAddressRequest) (*PasswordState, error)
+	// Checks the 2-step verification recovery email address verification code
+	CheckRecoveryEmailAddressCode(ctx context.Context, req *CheckRecoveryEmailAddressCodeRequest) (*PasswordState, error)
+	// Resends the 2-step verification recovery email address verification code
+	ResendRecoveryEmailAddressCode(ctx context.Context) (*PasswordState, error)
+	// Cancels verification of the 2-step verification recovery email address
+	CancelRecoveryEmailAddressVerification(ctx context.Context) (*PasswordState, error)
+	// Requests to send a 2-step verification password recovery code to an email address that was previously set up
+	RequestPasswordRecovery(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error)
+	// Checks whether a 2-step verification password recovery code sent to an email address is valid
+	CheckPasswordRecoveryCode(ctx context.Context, req *CheckPasswordRecoveryCodeRequest) (*Ok, error)
+	// Recovers the 2-step verification password using a recovery code sent to an email address that was previously set up
+	RecoverPassword(ctx context.Context, req *RecoverPasswordRequest) (*PasswordState, error)
+	// Removes 2-step verification password without previous password and access to recovery email address. The password can't be reset immediately and the request needs to be repeated after the specified time
+	ResetPassword(ctx context.Context) (ResetPasswordResult, error)
+	// Cancels reset of 2-step verification password. The method can be called if passwordState.pending_reset_date > 0
+	CancelPasswordReset(ctx context.Context) (*Ok, error)
+	// Creates a new temporary password for processing payments
+	CreateTemporaryPassword(ctx context.Context, req *CreateTemporaryPasswordRequest) (*TemporaryPasswordState, error)
+	// Returns information about the current temporary password
+	GetTemporaryPasswordState(ctx context.Context) (*TemporaryPasswordState, error)
+	// Returns the current user
+	GetMe(ctx context.Context) (*User, error)
+	// Returns information about a user by their identifier. This is an offline method if the current user is not a bot
+	GetUser(ctx context.Context, req *GetUserRequest) (*User, error)
+	// Returns full information about a user by their identifier
+	GetUserFullInfo(ctx context.Context, req *GetUserFullInfoRequest) (*UserFullInfo, error)
+	// Returns information about a basic group by its identifier. This is an offline method if the current user is not a bot
+	GetBasicGroup(ctx context.Context, req *GetBasicGroupRequest) (*BasicGroup, error)
+	// Returns full information about a basic group by its identifier
+	GetBasicGroupFullInfo(ctx context.Context, req *GetBasicGroupFullInfoRequest) (*BasicGroupFullInfo, error)
+	// Returns information about a supergroup or a channel by its identifier. This is an offline method if the current user is not a bot
+	GetSupergroup(ctx context.Context, req *GetSupergroupRequest) (*Supergroup, error)
+	// Returns full information about a supergroup or a channel by its identifier, cached for up to 1 minute
+	GetSupergroupFullInfo(ctx context.Context, req *GetSupergroupFullInfoRequest) (*SupergroupFullInfo, error)
+	// Returns information about a secret chat by its identifier. This is an offline method
+	GetSecretChat(ctx context.Context, req *GetSecretChatRequest) (*SecretChat, error)
+	// Returns information about a chat by its identifier. This is an offline method if the current user is not a bot
+	GetChat(ctx context.Context, req *GetChatRequest) (*Chat, error)
+	// Returns information about a message. Returns a 404 error if the message doesn't exist
+	GetMessage(ctx context.Context, req *GetMessageRequest) (*Message, error)
+	// Returns information about a message, if it is available without sending network request. Returns a 404 error if message isn't available locally. This is an offline method
+	GetMessageLocally(ctx context.Context, req *GetMessageLocallyRequest) (*Message, error)
+	// Returns information about a non-bundled message that is replied by a given message. Also, returns the pinned message, the game message, the invoice message, the message with a previously set same background, the giveaway message, and the topic creation message for messages of the types messagePinMessage, messageGameScore, messagePaymentSuccessful, messageChatSetBackground, messageGiveawayCompleted and topic messages without non-bundled replied message respectively. Returns a 404 error if the message doesn't exist
+	GetRepliedMessage(ctx context.Context, req *GetRepliedMessageRequest) (*Message, error)
+	// Returns information about a newest pinned message in the chat. Returns a 404 error if the message doesn't exist
+	GetChatPinnedMessage(ctx context.Context, req *GetChatPinnedMessageRequest) (*Message, error)
+	// Returns information about a message with the callback button that originated a callback query; for bots only
+	GetCallbackQueryMessage(ctx context.Context, req *GetCallbackQueryMessageRequest) (*Message, error)
+	// Returns information about messages. If a message is not found, returns null on the corresponding position of the result
+	GetMessages(ctx context.Context, req *GetMessagesRequest) (*Messages, error)
+	// Returns properties of a message. This is an offline method
+	GetMessageProperties(ctx context.Context, req *GetMessagePropertiesRequest) (*MessageProperties, error)
+	// Returns information about a message thread. Can be used only if messageProperties.can_get_message_thread == true
+	GetMessageThread(ctx context.Context, req *GetMessageThreadRequest) (*MessageThreadInfo, error)
+	// Returns read date of a recent outgoing message in a private chat. The method can be called if messageProperties.can_get_read_date == true
+	GetMessageReadDate(ctx context.Context, req *GetMessageReadDateRequest) (MessageReadDate, error)
+	// Returns viewers of a recent outgoing message in a basic group or a supergroup chat. For video notes and voice notes only users, opened content of the message, are returned. The method can be called if messageProperties.can_get_viewers == true
+	GetMessageViewers(ctx context.Context, req *GetMessageViewersRequest) (*MessageViewers, error)
+	// Returns information about a file. This is an offline method
+	GetFile(ctx context.Context, req *GetFileRequest) (*File, error)
+	// Returns information about a file by its remote identifier. This is an offline method. Can be used to register a URL as a file for further uploading, or sending as a message. Even the request succeeds, the file can be used only if it is still accessible to the user. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application
+	GetRemoteFile(ctx context.Context, req *GetRemoteFileRequest) (*File, error)
+	// Loads more chats from a chat list. The loaded chats and their positions in the chat list will be sent through updates. Chats are sorted by the pair (chat.position.order, chat.id) in descending order. Returns a 404 error if all chats have been loaded
+	LoadChats(ctx context.Context, req *LoadChatsRequest) (*Ok, error)
+	// Returns an ordered list of chats from the beginning of a chat list. For informational purposes only. Use loadChats and updates processing instead to maintain chat lists in a consistent state
+	GetChats(ctx context.Context, req *GetChatsRequest) (*Chats, error)
+	// Searches a public chat by its username. Currently, only private chats, supergroups and channels can be public. Returns the chat if found; otherwise, an error is returned
+	SearchPublicChat(ctx context.Context, req *SearchPublicChatRequest) (*Chat, error)
+	// Searches public chats by looking for specified query in their username and title. Currently, only private chats, supergroups and channels can be public. Returns a meaningful number of results. Excludes private chats with contacts and chats from the chat list from the results
+	SearchPublicChats(ctx context.Context, req *SearchPublicChatsRequest) (*Chats, error)
+	// Searches for the specified query in the title and username of already known chats. This is an offline method. Returns chats in the order seen in the main chat list
+	SearchChats(ctx context.Context, req *SearchChatsRequest) (*Chats, error)
+	// Searches for the specified query in the title and username of already known chats via request to the server. Returns chats in the order seen in the main chat list
+	SearchChatsOnServer(ctx context.Context, req *SearchChatsOnServerRequest) (*Chats, error)
+	// Returns a list of channel chats recommended to the current user
+	GetRecommendedChats(ctx context.Context) (*Chats, error)
+	// Returns a list of chats similar to the given chat
+	GetChatSimilarChats(ctx context.Context, req *GetChatSimilarChatsRequest) (*Chats, error)
+	// Returns approximate number of chats similar to the given chat
+	GetChatSimilarChatCount(ctx context.Context, req *GetChatSimilarChatCountRequest) (*Count, error)
+	// Informs TDLib that a chat was opened from the list of similar chats. The method is independent of openChat and closeChat methods
+	OpenChatSimilarChat(ctx context.Context, req *OpenChatSimilarChatRequest) (*Ok, error)
+	// Returns a list of bots similar to the given bot
+	GetBotSimilarBots(ctx context.Context, req *GetBotSimilarBotsRequest) (*Users, error)
+	// Returns approximate number of bots similar to the given bot
+	GetBotSimilarBotCount(ctx context.Context, req *GetBotSimilarBotCountRequest) (*Count, error)
+	// Informs TDLib that a bot was opened from the list of similar bots
+	OpenBotSimilarBot(ctx context.Context, req *OpenBotSimilarBotRequest) (*Ok, error)
+	// Returns a list of frequently used chats
+	GetTopChats(ctx context.Context, req *GetTopChatsRequest) (*Chats, error)
+	// Removes a chat from the list of frequently used chats. Supported only if the chat info database is enabled
+	RemoveTopChat(ctx context.Context, req *RemoveTopChatRequest) (*Ok, error)
+	// Searches for the specified query in the title and username of up to 50 recently found chats. This is an offline method
+	SearchRecentlyFoundChats(ctx context.Context, req *SearchRecentlyFoundChatsRequest) (*Chats, error)
+	// Adds a chat to the list of recently found chats. The chat is added to the beginning of the list. If the chat is already in the list, it will be removed from the list first
+	AddRecentlyFoundChat(ctx context.Context, req *AddRecentlyFoundChatRequest) (*Ok, error)
+	// Removes a chat from the list of recently found chats
+	RemoveRecentlyFoundChat(ctx context.Context, req *RemoveRecentlyFoundChatRequest) (*Ok, error)
+	// Clears the list of recently found chats
+	ClearRecentlyFoundChats(ctx context.Context) (*Ok, error)
+	// Returns recently opened chats. This is an offline method. Returns chats in the order of last opening
+	GetRecentlyOpenedChats(ctx context.Context, req *GetRecentlyOpenedChatsRequest) (*Chats, error)
+	// Checks whether a username can be set for a chat
+	CheckChatUsername(ctx context.Context, req *CheckChatUsernameRequest) (CheckChatUsernameResult, error)
+	// Returns a list of public chats of the specified type, owned by the user
+	GetCreatedPublicChats(ctx context.Context, req *GetCreatedPublicChatsRequest) (*Chats, error)
+	// Checks whether the maximum number of owned public chats has been reached. Returns corresponding error if the limit was reached. The limit can be increased with Telegram Premium
+	CheckCreatedPublicChatsLimit(ctx context.Context, req *CheckCreatedPublicChatsLimitRequest) (*Ok, error)
+	// Returns a list of basic group and supergroup chats, which can be used as a discussion group for a channel. Returned basic group chats must be first upgraded to supergroups before they can be set as a discussion group. To set a returned supergroup as a discussion group, access to its old messages must be enabled using toggleSupergroupIsAllHistoryAvailable first
+	GetSuitableDiscussionChats(ctx context.Context) (*Chats, error)
+	// Returns a list of recently inactive supergroups and channels. Can be used when user reaches limit on the number of joined supergroups and channels and receives CHANNELS_TOO_MUCH error. Also, the limit can be increased with Telegram Premium
+	GetInactiveSupergroupChats(ctx context.Context) (*Chats, error)
+	// Returns a list of channel chats, which can be used as a personal chat
+	GetSuitablePersonalChats(ctx context.Context) (*Chats, error)
+	// Loads more Saved Messages topics. The loaded topics will be sent through updateSavedMessagesTopic. Topics are sorted by their topic.order in descending order. Returns a 404 error if all topics have been loaded
+	LoadSavedMessagesTopics(ctx context.Context, req *LoadSavedMessagesTopicsRequest) (*Ok, error)
+	// Returns messages in a Saved Messages topic. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id)
+	GetSavedMessagesTopicHistory(ctx context.Context, req *GetSavedMessagesTopicHistoryRequest) (*Messages, error)
+	// Returns the last message sent in a Saved Messages topic no later than the specified date
+	GetSavedMessagesTopicMessageByDate(ctx context.Context, req *GetSavedMessagesTopicMessageByDateRequest) (*Message, error)
+	// Deletes all messages in a Saved Messages topic
+	DeleteSavedMessagesTopicHistory(ctx context.Context, req *DeleteSavedMessagesTopicHistoryRequest) (*Ok, error)
+	// Deletes all messages between the specified dates in a Saved Messages topic. Messages sent in the last 30 seconds will not be deleted
+	DeleteSavedMessagesTopicMessagesByDate(ctx context.Context, req *DeleteSavedMessagesTopicMessagesByDateRequest) (*Ok, error)
+	// Changes the pinned state of a Saved Messages topic. There can be up to getOption("pinned_saved_messages_topic_count_max") pinned topics. The limit can be increased with Telegram Premium
+	ToggleSavedMessagesTopicIsPinned(ctx context.Context, req *ToggleSavedMessagesTopicIsPinnedRequest) (*Ok, error)
+	// Changes the order of pinned Saved Messages topics
+	SetPinnedSavedMessagesTopics(ctx context.Context, req *SetPinnedSavedMessagesTopicsRequest) (*Ok, error)
+	// Returns a list of common group chats with a given user. Chats are sorted by their type and creation date
+	GetGroupsInCommon(ctx context.Context, req *GetGroupsInCommonRequest) (*Chats, error)
+	// Returns messages in a chat. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib. This is an offline method if only_local is true
+	GetChatHistory(ctx context.Context, req *GetChatHistoryRequest) (*Messages, error)
+	// Returns messages in a message thread of a message. Can be used only if messageProperties.can_get_message_thread == true. Message thread of a channel message is in the channel's linked supergroup. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+	GetMessageThreadHistory(ctx context.Context, req *GetMessageThreadHistoryRequest) (*Messages, error)
+	// Deletes all messages in the chat. Use chat.can_be_deleted_only_for_self and chat.can_be_deleted_for_all_users fields to find whether and how the method can be applied to the chat
+	DeleteChatHistory(ctx context.Context, req *DeleteChatHistoryRequest) (*Ok, error)
+	// Deletes a chat along with all messages in the corresponding chat for all chat members. For group chats this will release the usernames and remove all members. Use the field chat.can_be_deleted_for_all_users to find whether the method can be applied to the chat
+	DeleteChat(ctx context.Context, req *DeleteChatRequest) (*Ok, error)
+	// Searches for messages with given words in the chat. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. Cannot be used in secret chats with a non-empty query (searchSecretMessages must be used instead), or without an enabled message database. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit. A combination of query, sender_id, filter and message_thread_id search criteria is expected to be supported, only if it is required for Telegram official application implementation
+	SearchChatMessages(ctx context.Context, req *SearchChatMessagesRequest) (*FoundChatMessages, error)
+	// Searches for messages in all chats except secret chats. Returns the results in reverse chronological order (i.e., in order of decreasing (date, chat_id, message_id)). For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	SearchMessages(ctx context.Context, req *SearchMessagesRequest) (*FoundMessages, error)
+	// Searches for messages in secret chats. Returns the results in reverse chronological order. For optimal performance, the number of returned messages is chosen by TDLib
+	SearchSecretMessages(ctx context.Context, req *SearchSecretMessagesRequest) (*FoundMessages, error)
+	// Searches for messages tagged by the given reaction and with the given words in the Saved Messages chat; for Telegram Premium users only. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	SearchSavedMessages(ctx context.Context, req *SearchSavedMessagesRequest) (*FoundChatMessages, error)
+	// Searches for call messages. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+	SearchCallMessages(ctx context.Context, req *SearchCallMessagesRequest) (*FoundMessages, error)
+	// Searches for outgoing messages with content of the type messageDocument in all chats except secret chats. Returns the results in reverse chronological order
+	SearchOutgoingDocumentMessages(ctx context.Context, req *SearchOutgoingDocumentMessagesRequest) (*FoundMessages, error)
+	// Searches for public channel posts containing the given hashtag or cashtag. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+	SearchPublicMessagesByTag(ctx context.Context, req *SearchPublicMessagesByTagRequest) (*FoundMessages, error)
+	// Searches for public stories containing the given hashtag or cashtag. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	SearchPublicStoriesByTag(ctx context.Context, req *SearchPublicStoriesByTagRequest) (*FoundStories, error)
+	// Searches for public stories by the given address location. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	SearchPublicStoriesByLocation(ctx context.Context, req *SearchPublicStoriesByLocationRequest) (*FoundStories, error)
+	// Searches for public stories from the given venue. For optimal performance, the number of returned stories is chosen by TDLib and can be smaller than the specified limit
+	SearchPublicStoriesByVenue(ctx context.Context, req *SearchPublicStoriesByVenueRequest) (*FoundStories, error)
+	// Returns recently searched for hashtags or cashtags by their prefix
+	GetSearchedForTags(ctx context.Context, req *GetSearchedForTagsRequest) (*Hashtags, error)
+	// Removes a hashtag or a cashtag from the list of recently searched for hashtags or cashtags
+	RemoveSearchedForTag(ctx context.Context, req *RemoveSearchedForTagRequest) (*Ok, error)
+	// Clears the list of recently searched for hashtags or cashtags
+	ClearSearchedForTags(ctx context.Context, req *ClearSearchedForTagsRequest) (*Ok, error)
+	// Deletes all call messages
+	DeleteAllCallMessages(ctx context.Context, req *DeleteAllCallMessagesRequest) (*Ok, error)
+	// Returns information about the recent locations of chat members that were sent to the chat. Returns up to 1 location message per user
+	SearchChatRecentLocationMessages(ctx context.Context, req *SearchChatRecentLocationMessagesRequest) (*Messages, error)
+	// Returns the last message sent in a chat no later than the specified date. Returns a 404 error if such message doesn't exist
+	GetChatMessageByDate(ctx context.Context, req *GetChatMessageByDateRequest) (*Message, error)
+	// Returns sparse positions of messages of the specified type in the chat to be used for shared media scroll implementation. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). Cannot be used in secret chats or with searchMessagesFilterFailedToSend filter without an enabled message database
+	GetChatSparseMessagePositions(ctx context.Context, req *GetChatSparseMessagePositionsRequest) (*MessagePositions, error)
+	// Returns information about the next messages of the specified type in the chat split by days. Returns the results in reverse chronological order. Can return partial result for the last returned day. Behavior of this method depends on the value of the option "utc_time_offset"
+	GetChatMessageCalendar(ctx context.Context, req *GetChatMessageCalendarRequest) (*MessageCalendar, error)
+	// Returns approximate number of messages of the specified type in the chat
+	GetChatMessageCount(ctx context.Context, req *GetChatMessageCountRequest) (*Count, error)
+	// Returns approximate 1-based position of a message among messages, which can be found by the specified filter in the chat. Cannot be used in secret chats
+	GetChatMessagePosition(ctx context.Context, req *GetChatMessagePositionRequest) (*Count, error)
+	// Returns all scheduled messages in a chat. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id)
+	GetChatScheduledMessages(ctx context.Context, req *GetChatScheduledMessagesRequest) (*Messages, error)
+	// Returns sponsored messages to be shown in a chat; for channel chats and chats with bots only
+	GetChatSponsoredMessages(ctx context.Context, req *GetChatSponsoredMessagesRequest) (*SponsoredMessages, error)
+	// Informs TDLib that the user opened the sponsored chat via the button, the name, the chat photo, a mention in the sponsored message text, or the media in the sponsored message
+	ClickChatSponsoredMessage(ctx context.Context, req *ClickChatSponsoredMessageRequest) (*Ok, error)
+	// Reports a sponsored message to Telegram moderators
+	ReportChatSponsoredMessage(ctx context.Context, req *ReportChatSponsoredMessageRequest) (ReportSponsoredResult, error)
+	// Returns sponsored chats to be shown in the search results
+	GetSearchSponsoredChats(ctx context.Context, req *GetSearchSponsoredChatsRequest) (*SponsoredChats, error)
+	// Informs TDLib that the user fully viewed a sponsored chat
+	ViewSponsoredChat(ctx context.Context, req *ViewSponsoredChatRequest) (*Ok, error)
+	// Informs TDLib that the user opened a sponsored chat
+	OpenSponsoredChat(ctx context.Context, req *OpenSponsoredChatRequest) (*Ok, error)
+	// Reports a sponsored chat to Telegram moderators
+	ReportSponsoredChat(ctx context.Context, req *ReportSponsoredChatRequest) (ReportSponsoredResult, error)
+	// Removes an active notification from notification list. Needs to be called only if the notification is removed by the current user
+	RemoveNotification(ctx context.Context, req *RemoveNotificationRequest) (*Ok, error)
+	// Removes a group of active notifications. Needs to be called only if the notification group is removed by the current user
+	RemoveNotificationGroup(ctx context.Context, req *RemoveNotificationGroupRequest) (*Ok, error)
+	// Returns an HTTPS link to a message in a chat. Available only if messageProperties.can_get_link, or if messageProperties.can_get_media_timestamp_links and a media timestamp link is generated. This is an offline method
+	GetMessageLink(ctx context.Context, req *GetMessageLinkRequest) (*MessageLink, error)
+	// Returns an HTML code for embedding the message. Available only if messageProperties.can_get_embedding_code
+	GetMessageEmbeddingCode(ctx context.Context, req *GetMessageEmbeddingCodeRequest) (*Text, error)
+	// Returns information about a public or private message link. Can be called for any internal link of the type internalLinkTypeMessage
+	GetMessageLinkInfo(ctx context.Context, req *GetMessageLinkInfoRequest) (*MessageLinkInfo, error)
+	// Translates a text to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+	TranslateText(ctx context.Context, req *TranslateTextRequest) (*FormattedText, error)
+	// Extracts text or caption of the given message and translates it to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+	TranslateMessageText(ctx context.Context, req *TranslateMessageTextRequest) (*FormattedText, error)
+	// Recognizes speech in a video note or a voice note message
+	RecognizeSpeech(ctx context.Context, req *RecognizeSpeechRequest) (*Ok, error)
+	// Rates recognized speech in a video note or a voice note message
+	RateSpeechRecognition(ctx context.Context, req *RateSpeechRecognitionRequest) (*Ok, error)
+	// Returns the list of message sender identifiers, which can be used to send messages in a chat
+	GetChatAvailableMessageSenders(ctx context.Context, req *GetChatAvailableMessageSendersRequest) (*ChatMessageSenders, error)
+	// Selects a message sender to send messages in a chat
+	SetChatMessageSender(ctx context.Context, req *SetChatMessageSenderRequest) (*Ok, error)
+	// Sends a message. Returns the sent message
+	SendMessage(ctx context.Context, req *SendMessageRequest) (*Message, error)
+	// Sends 2-10 messages grouped together into an album. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+	SendMessageAlbum(ctx context.Context, req *SendMessageAlbumRequest) (*Messages, error)
+	// Invites a bot to a chat (if it is not yet a member) and sends it the /start command; requires can_invite_users member right. Bots can't be invited to a private chat other than the chat with the bot. Bots can't be invited to channels (although they can be added as admins) and secret chats. Returns the sent message
+	SendBotStartMessage(ctx context.Context, req *SendBotStartMessageRequest) (*Message, error)
+	// Sends the result of an inline query as a message. Returns the sent message. Always clears a chat draft message
+	SendInlineQueryResultMessage(ctx context.Context, req *SendInlineQueryResultMessageRequest) (*Message, error)
+	// Forwards previously sent messages. Returns the forwarded messages in the same order as the message identifiers passed in message_ids. If a message can't be forwarded, null will be returned instead of the message
+	ForwardMessages(ctx context.Context, req *ForwardMessagesRequest) (*Messages, error)
+	// Sends messages from a quick reply shortcut. Requires Telegram Business subscription. Can't be used to send paid messages
+	SendQuickReplyShortcutMessages(ctx context.Context, req *SendQuickReplyShortcutMessagesRequest) (*Messages, error)
+	// Resends messages which failed to send. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed. If a message is re-sent, the corresponding failed to send message is deleted. Returns the sent messages in the same order as the message identifiers passed in message_ids. If a message can't be re-sent, null will be returned instead of the message
+	ResendMessages(ctx context.Context, req *ResendMessagesRequest) (*Messages, error)
+	// Adds a local message to a chat. The message is persistent across application restarts only if the message database is used. Returns the added message
+	AddLocalMessage(ctx context.Context, req *AddLocalMessageRequest) (*Message, error)
+	// Deletes messages
+	DeleteMessages(ctx context.Context, req *DeleteMessagesRequest) (*Ok, error)
+	// Deletes all messages sent by the specified message sender in a chat. Supported only for supergroups; requires can_delete_messages administrator right
+	DeleteChatMessagesBySender(ctx context.Context, req *DeleteChatMessagesBySenderRequest) (*Ok, error)
+	// Deletes all messages between the specified dates in a chat. Supported only for private chats and basic groups. Messages sent in the last 30 seconds will not be deleted
+	DeleteChatMessagesByDate(ctx context.Context, req *DeleteChatMessagesByDateRequest) (*Ok, error)
+	// Edits the text of a message (or a text of a game message). Returns the edited message after the edit is completed on the server side
+	EditMessageText(ctx context.Context, req *EditMessageTextRequest) (*Message, error)
+	// Edits the message content of a live location. Messages can be edited for a limited period of time specified in the live location. Returns the edited message after the edit is completed on the server side
+	EditMessageLiveLocation(ctx context.Context, req *EditMessageLiveLocationRequest) (*Message, error)
+	// Edits the media content of a message, including message caption. If only the caption needs to be edited, use editMessageCaption instead. The type of message content in an album can't be changed with exception of replacing a photo with a video or vice versa. Returns the edited message after the edit is completed on the server side
+	EditMessageMedia(ctx context.Context, req *EditMessageMediaRequest) (*Message, error)
+	// Edits the message content caption. Returns the edited message after the edit is completed on the server side
+	EditMessageCaption(ctx context.Context, req *EditMessageCaptionRequest) (*Message, error)
+	// Edits the message reply markup; for bots only. Returns the edited message after the edit is completed on the server side
+	EditMessageReplyMarkup(ctx context.Context, req *EditMessageReplyMarkupRequest) (*Message, error)
+	// Edits the text of an inline text or game message sent via a bot; for bots only
+	EditInlineMessageText(ctx context.Context, req *EditInlineMessageTextRequest) (*Ok, error)
+	// Edits the content of a live location in an inline message sent via a bot; for bots only
+	EditInlineMessageLiveLocation(ctx context.Context, req *EditInlineMessageLiveLocationRequest) (*Ok, error)
+	// Edits the media content of a message with a text, an animation, an audio, a document, a photo or a video in an inline message sent via a bot; for bots only
+	EditInlineMessageMedia(ctx context.Context, req *EditInlineMessageMediaRequest) (*Ok, error)
+	// Edits the caption of an inline message sent via a bot; for bots only
+	EditInlineMessageCaption(ctx context.Context, req *EditInlineMessageCaptionRequest) (*Ok, error)
+	// Edits the reply markup of an inline message sent via a bot; for bots only
+	EditInlineMessageReplyMarkup(ctx context.Context, req *EditInlineMessageReplyMarkupRequest) (*Ok, error)
+	// Edits the time when a scheduled message will be sent. Scheduling state of all messages in the same album or forwarded together with the message will be also changed
+	EditMessageSchedulingState(ctx context.Context, req *EditMessageSchedulingStateRequest) (*Ok, error)
+	// Changes the fact-check of a message. Can be only used if messageProperties.can_set_fact_check == true
+	SetMessageFactCheck(ctx context.Context, req *SetMessageFactCheckRequest) (*Ok, error)
+	// Sends a message on behalf of a business account; for bots only. Returns the message after it was sent
+	SendBusinessMessage(ctx context.Context, req *SendBusinessMessageRequest) (*BusinessMessage, error)
+	// Sends 2-10 messages grouped together into an album on behalf of a business account; for bots only. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+	SendBusinessMessageAlbum(ctx context.Context, req *SendBusinessMessageAlbumRequest) (*BusinessMessages, error)
+	// Edits the text of a text or game message sent on behalf of a business account; for bots only
+	EditBusinessMessageText(ctx context.Context, req *EditBusinessMessageTextRequest) (*BusinessMessage, error)
+	// Edits the content of a live location in a message sent on behalf of a business account; for bots only
+	EditBusinessMessageLiveLocation(ctx context.Context, req *EditBusinessMessageLiveLocationRequest) (*BusinessMessage, error)
+	// Edits the media content of a message with a text, an animation, an audio, a document, a photo or a video in a message sent on behalf of a business account; for bots only
+	EditBusinessMessageMedia(ctx context.Context, req *EditBusinessMessageMediaRequest) (*BusinessMessage, error)
+	// Edits the caption of a message sent on behalf of a business account; for bots only
+	EditBusinessMessageCaption(ctx context.Context, req *EditBusinessMessageCaptionRequest) (*BusinessMessage, error)
+	// Edits the reply markup of a message sent on behalf of a business account; for bots only
+	EditBusinessMessageReplyMarkup(ctx context.Context, req *EditBusinessMessageReplyMarkupRequest) (*BusinessMessage, error)
+	// Stops a poll sent on behalf of a business account; for bots only
+	StopBusinessPoll(ctx context.Context, req *StopBusinessPollRequest) (*BusinessMessage, error)
+	// Pins or unpins a message sent on behalf of a business account; for bots only
+	SetBusinessMessageIsPinned(ctx context.Context, req *SetBusinessMessageIsPinnedRequest) (*Ok, error)
+	// Reads a message on behalf of a business account; for bots only
+	ReadBusinessMessage(ctx context.Context, req *ReadBusinessMessageRequest) (*Ok, error)
+	// Deletes messages on behalf of a business account; for bots only
+	DeleteBusinessMessages(ctx context.Context, req *DeleteBusinessMessagesRequest) (*Ok, error)
+	// Changes a story sent by the bot on behalf of a business account; for bots only
+	EditBusinessStory(ctx context.Context, req *EditBusinessStoryRequest) (*Story, error)
+	// Deletes a story sent by the bot on behalf of a business account; for bots only
+	DeleteBusinessStory(ctx context.Context, req *DeleteBusinessStoryRequest) (*Ok, error)
+	// Changes the first and last name of a business account; for bots only
+	SetBusinessAccountName(ctx context.Context, req *SetBusinessAccountNameRequest) (*Ok, error)
+	// Changes the bio of a business account; for bots only
+	SetBusinessAccountBio(ctx context.Context, req *SetBusinessAccountBioRequest) (*Ok, error)
+	// Changes a profile photo of a business account; for bots only
+	SetBusinessAccountProfilePhoto(ctx context.Context, req *SetBusinessAccountProfilePhotoRequest) (*Ok, error)
+	// Changes the editable username of a business account; for bots only
+	SetBusinessAccountUsername(ctx context.Context, req *SetBusinessAccountUsernameRequest) (*Ok, error)
+	// Changes settings for gift receiving of a business account; for bots only
+	SetBusinessAccountGiftSettings(ctx context.Context, req *SetBusinessAccountGiftSettingsRequest) (*Ok, error)
+	// Returns the amount of Telegram Stars owned by a business account; for bots only
+	GetBusinessAccountStarAmount(ctx context.Context, req *GetBusinessAccountStarAmountRequest) (*StarAmount, error)
+	// Transfer Telegram Stars from the business account to the business bot; for bots only
+	TransferBusinessAccountStars(ctx context.Context, req *TransferBusinessAccountStarsRequest) (*Ok, error)
+	// Loads quick reply shortcuts created by the current user. The loaded data will be sent through updateQuickReplyShortcut and updateQuickReplyShortcuts
+	LoadQuickReplyShortcuts(ctx context.Context) (*Ok, error)
+	// Changes name of a quick reply shortcut
+	SetQuickReplyShortcutName(ctx context.Context, req *SetQuickReplyShortcutNameRequest) (*Ok, error)
+	// Deletes a quick reply shortcut
+	DeleteQuickReplyShortcut(ctx context.Context, req *DeleteQuickReplyShortcutRequest) (*Ok, error)
+	// Changes the order of quick reply shortcuts
+	ReorderQuickReplyShortcuts(ctx context.Context, req *ReorderQuickReplyShortcutsRequest) (*Ok, error)
+	// Loads quick reply messages that can be sent by a given quick reply shortcut. The loaded messages will be sent through updateQuickReplyShortcutMessages
+	LoadQuickReplyShortcutMessages(ctx context.Context, req *LoadQuickReplyShortcutMessagesRequest) (*Ok, error)
+	// Deletes specified quick reply messages
+	DeleteQuickReplyShortcutMessages(ctx context.Context, req *DeleteQuickReplyShortcutMessagesRequest) (*Ok, error)
+	// Adds a message to a quick reply shortcut. If shortcut doesn't exist and there are less than getOption("quick_reply_shortcut_count_max") shortcuts, then a new shortcut is created. The shortcut must not contain more than getOption("quick_reply_shortcut_message_count_max") messages after adding the new message. Returns the added message
+	AddQuickReplyShortcutMessage(ctx context.Context, req *AddQuickReplyShortcutMessageRequest) (*QuickReplyMessage, error)
+	// Adds a message to a quick reply shortcut via inline bot. If shortcut doesn't exist and there are less than getOption("quick_reply_shortcut_count_max") shortcuts, then a new shortcut is created. The shortcut must not contain more than getOption("quick_reply_shortcut_message_count_max") messages after adding the new message. Returns the added message
+	AddQuickReplyShortcutInlineQueryResultMessage(ctx context.Context, req *AddQuickReplyShortcutInlineQueryResultMessageRequest) (*QuickReplyMessage, error)
+	// Adds 2-10 messages grouped together into an album to a quick reply shortcut. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+	AddQuickReplyShortcutMessageAlbum(ctx context.Context, req *AddQuickReplyShortcutMessageAlbumRequest) (*QuickReplyMessages, error)
+	// Readds quick reply messages which failed to add. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed. If a message is readded, the corresponding failed to send message is deleted. Returns the sent messages in the same order as the message identifiers passed in message_ids. If a message can't be readded, null will be returned instead of the message
+	ReaddQuickReplyShortcutMessages(ctx context.Context, req *ReaddQuickReplyShortcutMessagesRequest) (*QuickReplyMessages, error)
+	// Asynchronously edits the text, media or caption of a quick reply message. Use quickReplyMessage.can_be_edited to check whether a message can be edited. Media message can be edited only to a media message. The type of message content in an album can't be changed with exception of replacing a photo with a video or vice versa
+	EditQuickReplyMessage(ctx context.Context, req *EditQuickReplyMessageRequest) (*Ok, error)
+	// Returns the list of custom emoji, which can be used as forum topic icon by all users
+	GetForumTopicDefaultIcons(ctx context.Context) (*Stickers, error)
+	// Creates a topic in a forum supergroup chat; requires can_manage_topics administrator or can_create_topics member right in the supergroup
+	CreateForumTopic(ctx context.Context, req *CreateForumTopicRequest) (*ForumTopicInfo, error)
+	// Edits title and icon of a topic in a forum supergroup chat; requires can_manage_topics right in the supergroup unless the user is creator of the topic
+	EditForumTopic(ctx context.Context, req *EditForumTopicRequest) (*Ok, error)
+	// Returns information about a forum topic
+	GetForumTopic(ctx context.Context, req *GetForumTopicRequest) (*ForumTopic, error)
+	// Returns an HTTPS link to a topic in a forum chat. This is an offline method
+	GetForumTopicLink(ctx context.Context, req *GetForumTopicLinkRequest) (*MessageLink, error)
+	// Returns found forum topics in a forum chat. This is a temporary method for getting information about topic list from the server
+	GetForumTopics(ctx context.Context, req *GetForumTopicsRequest) (*ForumTopics, error)
+	// Changes the notification settings of a forum topic
+	SetForumTopicNotificationSettings(ctx context.Context, req *SetForumTopicNotificationSettingsRequest) (*Ok, error)
+	// Toggles whether a topic is closed in a forum supergroup chat; requires can_manage_topics right in the supergroup unless the user is creator of the topic
+	ToggleForumTopicIsClosed(ctx context.Context, req *ToggleForumTopicIsClosedRequest) (*Ok, error)
+	// Toggles whether a General topic is hidden in a forum supergroup chat; requires can_manage_topics right in the supergroup
+	ToggleGeneralForumTopicIsHidden(ctx context.Context, req *ToggleGeneralForumTopicIsHiddenRequest) (*Ok, error)
+	// Changes the pinned state of a forum topic; requires can_manage_topics right in the supergroup. There can be up to getOption("pinned_forum_topic_count_max") pinned forum topics
+	ToggleForumTopicIsPinned(ctx context.Context, req *ToggleForumTopicIsPinnedRequest) (*Ok, error)
+	// Changes the order of pinned forum topics; requires can_manage_topics right in the supergroup
+	SetPinnedForumTopics(ctx context.Context, req *SetPinnedForumTopicsRequest) (*Ok, error)
+	// Deletes all messages in a forum topic; requires can_delete_messages administrator right in the supergroup unless the user is creator of the topic, the topic has no messages from other users and has at most 11 messages
+	DeleteForumTopic(ctx context.Context, req *DeleteForumTopicRequest) (*Ok, error)
+	// Returns information about an emoji reaction. Returns a 404 error if the reaction is not found
+	GetEmojiReaction(ctx context.Context, req *GetEmojiReactionRequest) (*EmojiReaction, error)
+	// Returns TGS stickers with generic animations for custom emoji reactions
+	GetCustomEmojiReactionAnimations(ctx context.Context) (*Stickers, error)
+	// Returns reactions, which can be added to a message. The list can change after updateActiveEmojiReactions, updateChatAvailableReactions for the chat, or updateMessageInteractionInfo for the message
+	GetMessageAvailableReactions(ctx context.Context, req *GetMessageAvailableReactionsRequest) (*AvailableReactions, error)
+	// Clears the list of recently used reactions
+	ClearRecentReactions(ctx context.Context) (*Ok, error)
+	// Adds a reaction or a tag to a message. Use getMessageAvailableReactions to receive the list of available reactions for the message
+	AddMessageReaction(ctx context.Context, req *AddMessageReactionRequest) (*Ok, error)
+	// Removes a reaction from a message. A chosen reaction can always be removed
+	RemoveMessageReaction(ctx context.Context, req *RemoveMessageReactionRequest) (*Ok, error)
+	// Returns the list of message sender identifiers, which can be used to send a paid reaction in a chat
+	GetChatAvailablePaidMessageReactionSenders(ctx context.Context, req *GetChatAvailablePaidMessageReactionSendersRequest) (*MessageSenders, error)
+	// Adds the paid message reaction to a message. Use getMessageAvailableReactions to check whether the reaction is available for the message
+	AddPendingPaidMessageReaction(ctx context.Context, req *AddPendingPaidMessageReactionRequest) (*Ok, error)
+	// Applies all pending paid reactions on a message
+	CommitPendingPaidMessageReactions(ctx context.Context, req *CommitPendingPaidMessageReactionsRequest) (*Ok, error)
+	// Removes all pending paid reactions on a message
+	RemovePendingPaidMessageReactions(ctx context.Context, req *RemovePendingPaidMessageReactionsRequest) (*Ok, error)
+	// Changes type of paid message reaction of the current user on a message. The message must have paid reaction added by the current user
+	SetPaidMessageReactionType(ctx context.Context, req *SetPaidMessageReactionTypeRequest) (*Ok, error)
+	// Sets reactions on a message; for bots only
+	SetMessageReactions(ctx context.Context, req *SetMessageReactionsRequest) (*Ok, error)
+	// Returns reactions added for a message, along with their sender
+	GetMessageAddedReactions(ctx context.Context, req *GetMessageAddedReactionsRequest) (*AddedReactions, error)
+	// Changes type of default reaction for the current user
+	SetDefaultReactionType(ctx context.Context, req *SetDefaultReactionTypeRequest) (*Ok, error)
+	// Returns tags used in Saved Messages or a Saved Messages topic
+	GetSavedMessagesTags(ctx context.Context, req *GetSavedMessagesTagsRequest) (*SavedMessagesTags, error)
+	// Changes label of a Saved Messages tag; for Telegram Premium users only
+	SetSavedMessagesTagLabel(ctx context.Context, req *SetSavedMessagesTagLabelRequest) (*Ok, error)
+	// Returns information about a message effect. Returns a 404 error if the effect is not found
+	GetMessageEffect(ctx context.Context, req *GetMessageEffectRequest) (*MessageEffect, error)
+	// Changes the user answer to a poll. A poll in quiz mode can be answered only once
+	SetPollAnswer(ctx context.Context, req *SetPollAnswerRequest) (*Ok, error)
+	// Returns message senders voted for the specified option in a non-anonymous polls. For optimal performance, the number of returned users is chosen by TDLib
+	GetPollVoters(ctx context.Context, req *GetPollVotersRequest) (*MessageSenders, error)
+	// Stops a poll
+	StopPoll(ctx context.Context, req *StopPollRequest) (*Ok, error)
+	// Hides a suggested action
+	HideSuggestedAction(ctx context.Context, req *HideSuggestedActionRequest) (*Ok, error)
+	// Hides the list of contacts that have close birthdays for 24 hours
+	HideContactCloseBirthdays(ctx context.Context) (*Ok, error)
+	// Returns information about a business connection by its identifier; for bots only
+	GetBusinessConnection(ctx context.Context, req *GetBusinessConnectionRequest) (*BusinessConnection, error)
+	// Returns information about a button of type inlineKeyboardButtonTypeLoginUrl. The method needs to be called when the user presses the button
+	GetLoginUrlInfo(ctx context.Context, req *GetLoginUrlInfoRequest) (LoginUrlInfo, error)
+	// Returns an HTTP URL which can be used to automatically authorize the user on a website after clicking an inline button of type inlineKeyboardButtonTypeLoginUrl. Use the method getLoginUrlInfo to find whether a prior user confirmation is needed. If an error is returned, then the button must be handled as an ordinary URL button
+	GetLoginUrl(ctx context.Context, req *GetLoginUrlRequest) (*HttpUrl, error)
+	// Shares users after pressing a keyboardButtonTypeRequestUsers button with the bot
+	ShareUsersWithBot(ctx context.Context, req *ShareUsersWithBotRequest) (*Ok, error)
+	// Shares a chat after pressing a keyboardButtonTypeRequestChat button with the bot
+	ShareChatWithBot(ctx context.Context, req *ShareChatWithBotRequest) (*Ok, error)
+	// Sends an inline query to a bot and returns its results. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+	GetInlineQueryResults(ctx context.Context, req *GetInlineQueryResultsRequest) (*InlineQueryResults, error)
+	// Sets the result of an inline query; for bots only
+	AnswerInlineQuery(ctx context.Context, req *AnswerInlineQueryRequest) (*Ok, error)
+	// Saves an inline message to be sent by the given user; for bots only
+	SavePreparedInlineMessage(ctx context.Context, req *SavePreparedInlineMessageRequest) (*PreparedInlineMessageId, error)
+	// Saves an inline message to be sent by the given user
+	GetPreparedInlineMessage(ctx context.Context, req *GetPreparedInlineMessageRequest) (*PreparedInlineMessage, error)
+	// Returns the most grossing Web App bots
+	GetGrossingWebAppBots(ctx context.Context, req *GetGrossingWebAppBotsRequest) (*FoundUsers, error)
+	// Returns information about a Web App by its short name. Returns a 404 error if the Web App is not found
+	SearchWebApp(ctx context.Context, req *SearchWebAppRequest) (*FoundWebApp, error)
+	// Returns a default placeholder for Web Apps of a bot. This is an offline method. Returns a 404 error if the placeholder isn't known
+	GetWebAppPlaceholder(ctx context.Context, req *GetWebAppPlaceholderRequest) (*Outline, error)
+	// Returns an HTTPS URL of a Web App to open after a link of the type internalLinkTypeWebApp is clicked
+	GetWebAppLinkUrl(ctx context.Context, req *GetWebAppLinkUrlRequest) (*HttpUrl, error)
+	// Returns information needed to open the main Web App of a bot
+	GetMainWebApp(ctx context.Context, req *GetMainWebAppRequest) (*MainWebApp, error)
+	// Returns an HTTPS URL of a Web App to open from the side menu, a keyboardButtonTypeWebApp button, or an inlineQueryResultsButtonTypeWebApp button
+	GetWebAppUrl(ctx context.Context, req *GetWebAppUrlRequest) (*HttpUrl, error)
+	// Sends data received from a keyboardButtonTypeWebApp Web App to a bot
+	SendWebAppData(ctx context.Context, req *SendWebAppDataRequest) (*Ok, error)
+	// Informs TDLib that a Web App is being opened from the attachment menu, a botMenuButton button, an internalLinkTypeAttachmentMenuBot link, or an inlineKeyboardButtonTypeWebApp button. For each bot, a confirmation alert about data sent to the bot must be shown once
+	OpenWebApp(ctx context.Context, req *OpenWebAppRequest) (*WebAppInfo, error)
+	// Informs TDLib that a previously opened Web App was closed
+	CloseWebApp(ctx context.Context, req *CloseWebAppRequest) (*Ok, error)
+	// Sets the result of interaction with a Web App and sends corresponding message on behalf of the user to the chat from which the query originated; for bots only
+	AnswerWebAppQuery(ctx context.Context, req *AnswerWebAppQueryRequest) (*SentWebAppMessage, error)
+	// Checks whether a file can be downloaded and saved locally by Web App request
+	CheckWebAppFileDownload(ctx context.Context, req *CheckWebAppFileDownloadRequest) (*Ok, error)
+	// Sends a callback query to a bot and returns an answer. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+	GetCallbackQueryAnswer(ctx context.Context, req *GetCallbackQueryAnswerRequest) (*CallbackQueryAnswer, error)
+	// Sets the result of a callback query; for bots only
+	AnswerCallbackQuery(ctx context.Context, req *AnswerCallbackQueryRequest) (*Ok, error)
+	// Sets the result of a shipping query; for bots only
+	AnswerShippingQuery(ctx context.Context, req *AnswerShippingQueryRequest) (*Ok, error)
+	// Sets the result of a pre-checkout query; for bots only
+	AnswerPreCheckoutQuery(ctx context.Context, req *AnswerPreCheckoutQueryRequest) (*Ok, error)
+	// Updates the game score of the specified user in the game; for bots only
+	SetGameScore(ctx context.Context, req *SetGameScoreRequest) (*Message, error)
+	// Updates the game score of the specified user in a game; for bots only
+	SetInlineGameScore(ctx context.Context, req *SetInlineGameScoreRequest) (*Ok, error)
+	// Returns the high scores for a game and some part of the high score table in the range of the specified user; for bots only
+	GetGameHighScores(ctx context.Context, req *GetGameHighScoresRequest) (*GameHighScores, error)
+	// Returns game high scores and some part of the high score table in the range of the specified user; for bots only
+	GetInlineGameHighScores(ctx context.Context, req *GetInlineGameHighScoresRequest) (*GameHighScores, error)
+	// Deletes the default reply markup from a chat. Must be called after a one-time keyboard or a replyMarkupForceReply reply markup has been used. An updateChatReplyMarkup update will be sent if the reply markup is changed
+	DeleteChatReplyMarkup(ctx context.Context, req *DeleteChatReplyMarkupRequest) (*Ok, error)
+	// Sends a notification about user activity in a chat
+	SendChatAction(ctx context.Context, req *SendChatActionRequest) (*Ok, error)
+	// Informs TDLib that the chat is opened by the user. Many useful activities depend on the chat being opened or closed (e.g., in supergroups and channels all updates are received only for opened chats)
+	OpenChat(ctx context.Context, req *OpenChatRequest) (*Ok, error)
+	// Informs TDLib that the chat is closed by the user. Many useful activities depend on the chat being opened or closed
+	CloseChat(ctx context.Context, req *CloseChatRequest) (*Ok, error)
+	// Informs TDLib that messages are being viewed by the user. Sponsored messages must be marked as viewed only when the entire text of the message is shown on the screen (excluding the button). Many useful activities depend on whether the messages are currently being viewed or not (e.g., marking messages as read, incrementing a view counter, updating a view counter, removing deleted messages in supergroups and channels)
+	ViewMessages(ctx context.Context, req *ViewMessagesRequest) (*Ok, error)
+	// Informs TDLib that the message content has been opened (e.g., the user has opened a photo, video, document, location or venue, or has listened to an audio file or voice note message). An updateMessageContentOpened update will be generated if something has changed
+	OpenMessageContent(ctx context.Context, req *OpenMessageContentRequest) (*Ok, error)
+	// Informs TDLib that a message with an animated emoji was clicked by the user. Returns a big animated sticker to be played or a 404 error if usual animation needs to be played
+	ClickAnimatedEmojiMessage(ctx context.Context, req *ClickAnimatedEmojiMessageRequest) (*Sticker, error)
+	// Returns an HTTPS or a tg: link with the given type. Can be called before authorization
+	GetInternalLink(ctx context.Context, req *GetInternalLinkRequest) (*HttpUrl, error)
+	// Returns information about the type of internal link. Returns a 404 error if the link is not internal. Can be called before authorization
+	GetInternalLinkType(ctx context.Context, req *GetInternalLinkTypeRequest) (InternalLinkType, error)
+	// Returns information about an action to be done when the current user clicks an external link. Don't use this method for links from secret chats if link preview is disabled in secret chats
+	GetExternalLinkInfo(ctx context.Context, req *GetExternalLinkInfoRequest) (LoginUrlInfo, error)
+	// Returns an HTTP URL which can be used to automatically authorize the current user on a website after clicking an HTTP link. Use the method getExternalLinkInfo to find whether a prior user confirmation is needed
+	GetExternalLink(ctx context.Context, req *GetExternalLinkRequest) (*HttpUrl, error)
+	// Marks all mentions in a chat as read
+	ReadAllChatMentions(ctx context.Context, req *ReadAllChatMentionsRequest) (*Ok, error)
+	// Marks all mentions in a forum topic as read
+	ReadAllMessageThreadMentions(ctx context.Context, req *ReadAllMessageThreadMentionsRequest) (*Ok, error)
+	// Marks all reactions in a chat or a forum topic as read
+	ReadAllChatReactions(ctx context.Context, req *ReadAllChatReactionsRequest) (*Ok, error)
+	// Marks all reactions in a forum topic as read
+	ReadAllMessageThreadReactions(ctx context.Context, req *ReadAllMessageThreadReactionsRequest) (*Ok, error)
+	// Returns an existing chat corresponding to a given user
+	CreatePrivateChat(ctx context.Context, req *CreatePrivateChatRequest) (*Chat, error)
+	// Returns an existing chat corresponding to a known basic group
+	CreateBasicGroupChat(ctx context.Context, req *CreateBasicGroupChatRequest) (*Chat, error)
+	// Returns an existing chat corresponding to a known supergroup or channel
+	CreateSupergroupChat(ctx context.Context, req *CreateSupergroupChatRequest) (*Chat, error)
+	// Returns an existing chat corresponding to a known secret chat
+	CreateSecretChat(ctx context.Context, req *CreateSecretChatRequest) (*Chat, error)
+	// Creates a new basic group and sends a corresponding messageBasicGroupChatCreate. Returns information about the newly created chat
+	CreateNewBasicGroupChat(ctx context.Context, req *CreateNewBasicGroupChatRequest) (*CreatedBasicGroupChat, error)
+	// Creates a new supergroup or channel and sends a corresponding messageSupergroupChatCreate. Returns the newly created chat
+	CreateNewSupergroupChat(ctx context.Context, req *CreateNewSupergroupChatRequest) (*Chat, error)
+	// Creates a new secret chat. Returns the newly created chat
+	CreateNewSecretChat(ctx context.Context, req *CreateNewSecretChatRequest) (*Chat, error)
+	// Creates a new supergroup from an existing basic group and sends a corresponding messageChatUpgradeTo and messageChatUpgradeFrom; requires owner privileges. Deactivates the original basic group
+	UpgradeBasicGroupChatToSupergroupChat(ctx context.Context, req *UpgradeBasicGroupChatToSupergroupChatRequest) (*Chat, error)
+	// Returns chat lists to which the chat can be added. This is an offline method
+	GetChatListsToAddChat(ctx context.Context, req *GetChatListsToAddChatRequest) (*ChatLists, error)
+	// Adds a chat to a chat list. A chat can't be simultaneously in Main and Archive chat lists, so it is automatically removed from another one if needed
+	AddChatToList(ctx context.Context, req *AddChatToListRequest) (*Ok, error)
+	// Returns information about a chat folder by its identifier
+	GetChatFolder(ctx context.Context, req *GetChatFolderRequest) (*ChatFolder, error)
+	// Creates new chat folder. Returns information about the created chat folder. There can be up to getOption("chat_folder_count_max") chat folders, but the limit can be increased with Telegram Premium
+	CreateChatFolder(ctx context.Context, req *CreateChatFolderRequest) (*ChatFolderInfo, error)
+	// Edits existing chat folder. Returns information about the edited chat folder
+	EditChatFolder(ctx context.Context, req *EditChatFolderRequest) (*ChatFolderInfo, error)
+	// Deletes existing chat folder
+	DeleteChatFolder(ctx context.Context, req *DeleteChatFolderRequest) (*Ok, error)
+	// Returns identifiers of pinned or always included chats from a chat folder, which are suggested to be left when the chat folder is deleted
+	GetChatFolderChatsToLeave(ctx context.Context, req *GetChatFolderChatsToLeaveRequest) (*Chats, error)
+	// Returns approximate number of chats in a being created chat folder. Main and archive chat lists must be fully preloaded for this function to work correctly
+	GetChatFolderChatCount(ctx context.Context, req *GetChatFolderChatCountRequest) (*Count, error)
+	// Changes the order of chat folders
+	ReorderChatFolders(ctx context.Context, req *ReorderChatFoldersRequest) (*Ok, error)
+	// Toggles whether chat folder tags are enabled
+	ToggleChatFolderTags(ctx context.Context, req *ToggleChatFolderTagsRequest) (*Ok, error)
+	// Returns recommended chat folders for the current user
+	GetRecommendedChatFolders(ctx context.Context) (*RecommendedChatFolders, error)
+	// Returns identifiers of chats from a chat folder, suitable for adding to a chat folder invite link
+	GetChatsForChatFolderInviteLink(ctx context.Context, req *GetChatsForChatFolderInviteLinkRequest) (*Chats, error)
+	// Creates a new invite link for a chat folder. A link can be created for a chat folder if it has only pinned and included chats
+	CreateChatFolderInviteLink(ctx context.Context, req *CreateChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error)
+	// Returns invite links created by the current user for a shareable chat folder
+	GetChatFolderInviteLinks(ctx context.Context, req *GetChatFolderInviteLinksRequest) (*ChatFolderInviteLinks, error)
+	// Edits an invite link for a chat folder
+	EditChatFolderInviteLink(ctx context.Context, req *EditChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error)
+	// Deletes an invite link for a chat folder
+	DeleteChatFolderInviteLink(ctx context.Context, req *DeleteChatFolderInviteLinkRequest) (*Ok, error)
+	// Checks the validity of an invite link for a chat folder and returns information about the corresponding chat folder
+	CheckChatFolderInviteLink(ctx context.Context, req *CheckChatFolderInviteLinkRequest) (*ChatFolderInviteLinkInfo, error)
+	// Adds a chat folder by an invite link
+	AddChatFolderByInviteLink(ctx context.Context, req *AddChatFolderByInviteLinkRequest) (*Ok, error)
+	// Returns new chats added to a shareable chat folder by its owner. The method must be called at most once in getOption("chat_folder_new_chats_update_period") for the given chat folder
+	GetChatFolderNewChats(ctx context.Context, req *GetChatFolderNewChatsRequest) (*Chats, error)
+	// Process new chats added to a shareable chat folder by its owner
+	ProcessChatFolderNewChats(ctx context.Context, req *ProcessChatFolderNewChatsRequest) (*Ok, error)
+	// Returns settings for automatic moving of chats to and from the Archive chat lists
+	GetArchiveChatListSettings(ctx context.Context) (*ArchiveChatListSettings, error)
+	// Changes settings for automatic moving of chats to and from the Archive chat lists
+	SetArchiveChatListSettings(ctx context.Context, req *SetArchiveChatListSettingsRequest) (*Ok, error)
+	// Changes the chat title. Supported only for basic groups, supergroups and channels. Requires can_change_info member right
+	SetChatTitle(ctx context.Context, req *SetChatTitleRequest) (*Ok, error)
+	// Changes the photo of a chat. Supported only for basic groups, supergroups and channels. Requires can_change_info member right
+	SetChatPhoto(ctx context.Context, req *SetChatPhotoRequest) (*Ok, error)
+	// Changes accent color and background custom emoji of a channel chat. Requires can_change_info administrator right
+	SetChatAccentColor(ctx context.Context, req *SetChatAccentColorRequest) (*Ok, error)
+	// Changes accent color and background custom emoji for profile of a supergroup or channel chat. Requires can_change_info administrator right
+	SetChatProfileAccentColor(ctx context.Context, req *SetChatProfileAccentColorRequest) (*Ok, error)
+	// Changes the message auto-delete or self-destruct (for secret chats) time in a chat. Requires change_info administrator right in basic groups, supergroups and channels. Message auto-delete time can't be changed in a chat with the current user (Saved Messages) and the chat 777000 (Telegram).
+	SetChatMessageAutoDeleteTime(ctx context.Context, req *SetChatMessageAutoDeleteTimeRequest) (*Ok, error)
+	// Changes the emoji status of a chat. Use chatBoostLevelFeatures.can_set_emoji_status to check whether an emoji status can be set. Requires can_change_info administrator right
+	SetChatEmojiStatus(ctx context.Context, req *SetChatEmojiStatusRequest) (*Ok, error)
+	// Changes the chat members permissions. Supported only for basic groups and supergroups. Requires can_restrict_members administrator right
+	SetChatPermissions(ctx context.Context, req *SetChatPermissionsRequest) (*Ok, error)
+	// Sets the background in a specific chat. Supported only in private and secret chats with non-deleted users, and in chats with sufficient boost level and can_change_info administrator right
+	SetChatBackground(ctx context.Context, req *SetChatBackgroundRequest) (*Ok, error)
+	// Deletes background in a specific chat
+	DeleteChatBackground(ctx context.Context, req *DeleteChatBackgroundRequest) (*Ok, error)
+	// Changes the chat theme. Supported only in private and secret chats
+	SetChatTheme(ctx context.Context, req *SetChatThemeRequest) (*Ok, error)
+	// Changes the draft message in a chat
+	SetChatDraftMessage(ctx context.Context, req *SetChatDraftMessageRequest) (*Ok, error)
+	// Changes the notification settings of a chat. Notification settings of a chat with the current user (Saved Messages) can't be changed
+	SetChatNotificationSettings(ctx context.Context, req *SetChatNotificationSettingsRequest) (*Ok, error)
+	// Changes the ability of users to save, forward, or copy chat content. Supported only for basic groups, supergroups and channels. Requires owner privileges
+	ToggleChatHasProtectedContent(ctx context.Context, req *ToggleChatHasProtectedContentRequest) (*Ok, error)
+	// Changes the view_as_topics setting of a forum chat or Saved Messages
+	ToggleChatViewAsTopics(ctx context.Context, req *ToggleChatViewAsTopicsRequest) (*Ok, error)
+	// Changes the translatable state of a chat
+	ToggleChatIsTranslatable(ctx context.Context, req *ToggleChatIsTranslatableRequest) (*Ok, error)
+	// Changes the marked as unread state of a chat
+	ToggleChatIsMarkedAsUnread(ctx context.Context, req *ToggleChatIsMarkedAsUnreadRequest) (*Ok, error)
+	// Changes the value of the default disable_notification parameter, used when a message is sent to a chat
+	ToggleChatDefaultDisableNotification(ctx context.Context, req *ToggleChatDefaultDisableNotificationRequest) (*Ok, error)
+	// Changes reactions, available in a chat. Available for basic groups, supergroups, and channels. Requires can_change_info member right
+	SetChatAvailableReactions(ctx context.Context, req *SetChatAvailableReactionsRequest) (*Ok, error)
+	// Changes application-specific data associated with a chat
+	SetChatClientData(ctx context.Context, req *SetChatClientDataRequest) (*Ok, error)
+	// Changes information about a chat. Available for basic groups, supergroups, and channels. Requires can_change_info member right
+	SetChatDescription(ctx context.Context, req *SetChatDescriptionRequest) (*Ok, error)
+	// Changes the discussion group of a channel chat; requires can_change_info administrator right in the channel if it is specified
+	SetChatDiscussionGroup(ctx context.Context, req *SetChatDiscussionGroupRequest) (*Ok, error)
+	// Changes the location of a chat. Available only for some location-based supergroups, use supergroupFullInfo.can_set_location to check whether the method is allowed to use
+	SetChatLocation(ctx context.Context, req *SetChatLocationRequest) (*Ok, error)
+	// Changes the slow mode delay of a chat. Available only for supergroups; requires can_restrict_members right
+	SetChatSlowModeDelay(ctx context.Context, req *SetChatSlowModeDelayRequest) (*Ok, error)
+	// Pins a message in a chat. A message can be pinned only if messageProperties.can_be_pinned
+	PinChatMessage(ctx context.Context, req *PinChatMessageRequest) (*Ok, error)
+	// Removes a pinned message from a chat; requires can_pin_messages member right if the chat is a basic group or supergroup, or can_edit_messages administrator right if the chat is a channel
+	UnpinChatMessage(ctx context.Context, req *UnpinChatMessageRequest) (*Ok, error)
+	// Removes all pinned messages from a chat; requires can_pin_messages member right if the chat is a basic group or supergroup, or can_edit_messages administrator right if the chat is a channel
+	UnpinAllChatMessages(ctx context.Context, req *UnpinAllChatMessagesRequest) (*Ok, error)
+	// Removes all pinned messages from a forum topic; requires can_pin_messages member right in the supergroup
+	UnpinAllMessageThreadMessages(ctx context.Context, req *UnpinAllMessageThreadMessagesRequest) (*Ok, error)
+	// Adds the current user as a new member to a chat. Private and secret chats can't be joined using this method. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+	JoinChat(ctx context.Context, req *JoinChatRequest) (*Ok, error)
+	// Removes the current user from chat members. Private and secret chats can't be left using this method
+	LeaveChat(ctx context.Context, req *LeaveChatRequest) (*Ok, error)
+	// Adds a new member to a chat; requires can_invite_users member right. Members can't be added to private or secret chats. Returns information about members that weren't added
+	AddChatMember(ctx context.Context, req *AddChatMemberRequest) (*FailedToAddMembers, error)
+	// Adds multiple new members to a chat; requires can_invite_users member right. Currently, this method is only available for supergroups and channels. This method can't be used to join a chat. Members can't be added to a channel if it has more than 200 members. Returns information about members that weren't added
+	AddChatMembers(ctx context.Context, req *AddChatMembersRequest) (*FailedToAddMembers, error)
+	// Changes the status of a chat member; requires can_invite_users member right to add a chat member, can_promote_members administrator right to change administrator rights of the member, and can_restrict_members administrator right to change restrictions of a user. This function is currently not suitable for transferring chat ownership; use transferChatOwnership instead. Use addChatMember or banChatMember if some additional parameters needs to be passed
+	SetChatMemberStatus(ctx context.Context, req *SetChatMemberStatusRequest) (*Ok, error)
+	// Bans a member in a chat; requires can_restrict_members administrator right. Members can't be banned in private or secret chats. In supergroups and channels, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first
+	BanChatMember(ctx context.Context, req *BanChatMemberRequest) (*Ok, error)
+	// Checks whether the current session can be used to transfer a chat ownership to another user
+	CanTransferOwnership(ctx context.Context) (CanTransferOwnershipResult, error)
+	// Changes the owner of a chat; requires owner privileges in the chat. Use the method canTransferOwnership to check whether the ownership can be transferred from the current session. Available only for supergroups and channel chats
+	TransferChatOwnership(ctx context.Context, req *TransferChatOwnershipRequest) (*Ok, error)
+	// Returns information about a single member of a chat
+	GetChatMember(ctx context.Context, req *GetChatMemberRequest) (*ChatMember, error)
+	// Searches for a specified query in the first name, last name and usernames of the members of a specified chat. Requires administrator rights if the chat is a channel
+	SearchChatMembers(ctx context.Context, req *SearchChatMembersRequest) (*ChatMembers, error)
+	// Returns a list of administrators of the chat with their custom titles
+	GetChatAdministrators(ctx context.Context, req *GetChatAdministratorsRequest) (*ChatAdministrators, error)
+	// Clears message drafts in all chats
+	ClearAllDraftMessages(ctx context.Context, req *ClearAllDraftMessagesRequest) (*Ok, error)
+	// Returns saved notification sound by its identifier. Returns a 404 error if there is no saved notification sound with the specified identifier
+	GetSavedNotificationSound(ctx context.Context, req *GetSavedNotificationSoundRequest) (*NotificationSounds, error)
+	// Returns the list of saved notification sounds. If a sound isn't in the list, then default sound needs to be used
+	GetSavedNotificationSounds(ctx context.Context) (*NotificationSounds, error)
+	// Adds a new notification sound to the list of saved notification sounds. The new notification sound is added to the top of the list. If it is already in the list, its position isn't changed
+	AddSavedNotificationSound(ctx context.Context, req *AddSavedNotificationSoundRequest) (*NotificationSound, error)
+	// Removes a notification sound from the list of saved notification sounds
+	RemoveSavedNotificationSound(ctx context.Context, req *RemoveSavedNotificationSoundRequest) (*Ok, error)
+	// Returns the list of chats with non-default notification settings for new messages
+	GetChatNotificationSettingsExceptions(ctx context.Context, req *GetChatNotificationSettingsExceptionsRequest) (*Chats, error)
+	// Returns the notification settings for chats of a given type
+	GetScopeNotificationSettings(ctx context.Context, req *GetScopeNotificationSettingsRequest) (*ScopeNotificationSettings, error)
+	// Changes notification settings for chats of a given type
+	SetScopeNotificationSettings(ctx context.Context, req *SetScopeNotificationSettingsRequest) (*Ok, error)
+	// Changes notification settings for reactions
+	SetReactionNotificationSettings(ctx context.Context, req *SetReactionNotificationSettingsRequest) (*Ok, error)
+	// Resets all chat and scope notification settings to their default values. By default, all chats are unmuted and message previews are shown
+	ResetAllNotificationSettings(ctx context.Context) (*Ok, error)
+	// Changes the pinned state of a chat. There can be up to getOption("pinned_chat_count_max")/getOption("pinned_archived_chat_count_max") pinned non-secret chats and the same number of secret chats in the main/archive chat list. The limit can be increased with Telegram Premium
+	ToggleChatIsPinned(ctx context.Context, req *ToggleChatIsPinnedRequest) (*Ok, error)
+	// Changes the order of pinned chats
+	SetPinnedChats(ctx context.Context, req *SetPinnedChatsRequest) (*Ok, error)
+	// Traverse all chats in a chat list and marks all messages in the chats as read
+	ReadChatList(ctx context.Context, req *ReadChatListRequest) (*Ok, error)
+	// Returns the current weather in the given location
+	GetCurrentWeather(ctx context.Context, req *GetCurrentWeatherRequest) (*CurrentWeather, error)
+	// Returns a story
+	GetStory(ctx context.Context, req *GetStoryRequest) (*Story, error)
+	// Returns supergroup and channel chats in which the current user has the right to post stories. The chats must be rechecked with canSendStory before actually trying to post a story there
+	GetChatsToSendStories(ctx context.Context) (*Chats, error)
+	// Checks whether the current user can send a story on behalf of a chat; requires can_post_stories right for supergroup and channel chats
+	CanSendStory(ctx context.Context, req *CanSendStoryRequest) (CanSendStoryResult, error)
+	// Sends a new story to a chat; requires can_post_stories right for supergroup and channel chats. Returns a temporary story
+	SendStory(ctx context.Context, req *SendStoryRequest) (*Story, error)
+	// Changes content and caption of a story. Can be called only if story.can_be_edited == true
+	EditStory(ctx context.Context, req *EditStoryRequest) (*Ok, error)
+	// Changes cover of a video story. Can be called only if story.can_be_edited == true and the story isn't being edited now
+	EditStoryCover(ctx context.Context, req *EditStoryCoverRequest) (*Ok, error)
+	// Changes privacy settings of a story. The method can be called only for stories posted on behalf of the current user and if story.can_be_edited == true
+	SetStoryPrivacySettings(ctx context.Context, req *SetStoryPrivacySettingsRequest) (*Ok, error)
+	// Toggles whether a story is accessible after expiration. Can be called only if story.can_toggle_is_posted_to_chat_page == true
+	ToggleStoryIsPostedToChatPage(ctx context.Context, req *ToggleStoryIsPostedToChatPageRequest) (*Ok, error)
+	// Deletes a previously sent story. Can be called only if story.can_be_deleted == true
+	DeleteStory(ctx context.Context, req *DeleteStoryRequest) (*Ok, error)
+	// Returns the list of chats with non-default notification settings for stories
+	GetStoryNotificationSettingsExceptions(ctx context.Context) (*Chats, error)
+	// Loads more active stories from a story list. The loaded stories will be sent through updates. Active stories are sorted by the pair (active_stories.order, active_stories.story_sender_chat_id) in descending order. Returns a 404 error if all active stories have been loaded
+	LoadActiveStories(ctx context.Context, req *LoadActiveStoriesRequest) (*Ok, error)
+	// Changes story list in which stories from the chat are shown
+	SetChatActiveStoriesList(ctx context.Context, req *SetChatActiveStoriesListRequest) (*Ok, error)
+	// Returns the list of active stories posted by the given chat
+	GetChatActiveStories(ctx context.Context, req *GetChatActiveStoriesRequest) (*ChatActiveStories, error)
+	// Returns the list of stories that posted by the given chat to its chat page. If from_story_id == 0, then pinned stories are returned first. Then, stories are returned in reverse chronological order (i.e., in order of decreasing story_id). For optimal performance, the number of returned stories is chosen by TDLib
+	GetChatPostedToChatPageStories(ctx context.Context, req *GetChatPostedToChatPageStoriesRequest) (*Stories, error)
+	// Returns the list of all stories posted by the given chat; requires can_edit_stories right in the chat. The stories are returned in reverse chronological order (i.e., in order of decreasing story_id). For optimal performance, the number of returned stories is chosen by TDLib
+	GetChatArchivedStories(ctx context.Context, req *GetChatArchivedStoriesRequest) (*Stories, error)
+	// Changes the list of pinned stories on a chat page; requires can_edit_stories right in the chat
+	SetChatPinnedStories(ctx context.Context, req *SetChatPinnedStoriesRequest) (*Ok, error)
+	// Informs TDLib that a story is opened and is being viewed by the user
+	OpenStory(ctx context.Context, req *OpenStoryRequest) (*Ok, error)
+	// Informs TDLib that a story is closed by the user
+	CloseStory(ctx context.Context, req *CloseStoryRequest) (*Ok, error)
+	// Returns reactions, which can be chosen for a story
+	GetStoryAvailableReactions(ctx context.Context, req *GetStoryAvailableReactionsRequest) (*AvailableReactions, error)
+	// Changes chosen reaction on a story that has already been sent
+	SetStoryReaction(ctx context.Context, req *SetStoryReactionRequest) (*Ok, error)
+	// Returns interactions with a story. The method can be called only for stories posted on behalf of the current user
+	GetStoryInteractions(ctx context.Context, req *GetStoryInteractionsRequest) (*StoryInteractions, error)
+	// Returns interactions with a story posted in a chat. Can be used only if story is posted on behalf of a chat and the user is an administrator in the chat
+	GetChatStoryInteractions(ctx context.Context, req *GetChatStoryInteractionsRequest) (*StoryInteractions, error)
+	// Reports a story to the Telegram moderators
+	ReportStory(ctx context.Context, req *ReportStoryRequest) (ReportStoryResult, error)
+	// Activates stealth mode for stories, which hides all views of stories from the current user in the last "story_stealth_mode_past_period" seconds and for the next "story_stealth_mode_future_period" seconds; for Telegram Premium users only
+	ActivateStoryStealthMode(ctx context.Context) (*Ok, error)
+	// Returns forwards of a story as a message to public chats and reposts by public channels. Can be used only if the story is posted on behalf of the current user or story.can_get_statistics == true. For optimal performance, the number of returned messages and stories is chosen by TDLib
+	GetStoryPublicForwards(ctx context.Context, req *GetStoryPublicForwardsRequest) (*PublicForwards, error)
+	// Returns the list of features available on the specific chat boost level. This is an offline method
+	GetChatBoostLevelFeatures(ctx context.Context, req *GetChatBoostLevelFeaturesRequest) (*ChatBoostLevelFeatures, error)
+	// Returns the list of features available for different chat boost levels. This is an offline method
+	GetChatBoostFeatures(ctx context.Context, req *GetChatBoostFeaturesRequest) (*ChatBoostFeatures, error)
+	// Returns the list of available chat boost slots for the current user
+	GetAvailableChatBoostSlots(ctx context.Context) (*ChatBoostSlots, error)
+	// Returns the current boost status for a supergroup or a channel chat
+	GetChatBoostStatus(ctx context.Context, req *GetChatBoostStatusRequest) (*ChatBoostStatus, error)
+	// Boosts a chat and returns the list of available chat boost slots for the current user after the boost
+	BoostChat(ctx context.Context, req *BoostChatRequest) (*ChatBoostSlots, error)
+	// Returns an HTTPS link to boost the specified supergroup or channel chat
+	GetChatBoostLink(ctx context.Context, req *GetChatBoostLinkRequest) (*ChatBoostLink, error)
+	// Returns information about a link to boost a chat. Can be called for any internal link of the type internalLinkTypeChatBoost
+	GetChatBoostLinkInfo(ctx context.Context, req *GetChatBoostLinkInfoRequest) (*ChatBoostLinkInfo, error)
+	// Returns the list of boosts applied to a chat; requires administrator rights in the chat
+	GetChatBoosts(ctx context.Context, req *GetChatBoostsRequest) (*FoundChatBoosts, error)
+	// Returns the list of boosts applied to a chat by a given user; requires administrator rights in the chat; for bots only
+	GetUserChatBoosts(ctx context.Context, req *GetUserChatBoostsRequest) (*FoundChatBoosts, error)
+	// Returns information about a bot that can be added to attachment or side menu
+	GetAttachmentMenuBot(ctx context.Context, req *GetAttachmentMenuBotRequest) (*AttachmentMenuBot, error)
+	// Adds or removes a bot to attachment and side menu. Bot can be added to the menu, only if userTypeBot.can_be_added_to_attachment_menu == true
+	ToggleBotIsAddedToAttachmentMenu(ctx context.Context, req *ToggleBotIsAddedToAttachmentMenuRequest) (*Ok, error)
+	// Returns up to 8 emoji statuses, which must be shown right after the default Premium Badge in the emoji status list for self status
+	GetThemedEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	// Returns recent emoji statuses for self status
+	GetRecentEmojiStatuses(ctx context.Context) (*EmojiStatuses, error)
+	// Returns available upgraded gift emoji statuses for self status
+	GetUpgradedGiftEmojiStatuses(ctx context.Context) (*EmojiStatuses, error)
+	// Returns default emoji statuses for self status
+	GetDefaultEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	// Clears the list of recently used emoji statuses for self status
+	ClearRecentEmojiStatuses(ctx context.Context) (*Ok, error)
+	// Returns up to 8 emoji statuses, which must be shown in the emoji status list for chats
+	GetThemedChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	// Returns default emoji statuses for chats
+	GetDefaultChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	// Returns the list of emoji statuses, which can't be used as chat emoji status, even they are from a sticker set with is_allowed_as_chat_emoji_status == true
+	GetDisallowedChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	// Downloads a file from the cloud. Download progress and completion of the download will be notified through updateFile updates
+	DownloadFile(ctx context.Context, req *DownloadFileRequest) (*File, error)
+	// Returns file downloaded prefix size from a given offset, in bytes
+	GetFileDownloadedPrefixSize(ctx context.Context, req *GetFileDownloadedPrefixSizeRequest) (*FileDownloadedPrefixSize, error)
+	// Stops the downloading of a file. If a file has already been downloaded, does nothing
+	CancelDownloadFile(ctx context.Context, req *CancelDownloadFileRequest) (*Ok, error)
+	// Returns suggested name for saving a file in a given directory
+	GetSuggestedFileName(ctx context.Context, req *GetSuggestedFileNameRequest) (*Text, error)
+	// Preliminary uploads a file to the cloud before sending it in a message, which can be useful for uploading of being recorded voice and video notes. In all other cases there is no need to preliminary upload a file. Updates updateFile will be used to notify about upload progress. The upload will not be completed until the file is sent in a message
+	PreliminaryUploadFile(ctx context.Context, req *PreliminaryUploadFileRequest) (*File, error)
+	// Stops the preliminary uploading of a file. Supported only for files uploaded by using preliminaryUploadFile
+	CancelPreliminaryUploadFile(ctx context.Context, req *CancelPreliminaryUploadFileRequest) (*Ok, error)
+	// Writes a part of a generated file. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct write to the destination file
+	WriteGeneratedFilePart(ctx context.Context, req *WriteGeneratedFilePartRequest) (*Ok, error)
+	// Informs TDLib on a file generation progress
+	SetFileGenerationProgress(ctx context.Context, req *SetFileGenerationProgressRequest) (*Ok, error)
+	// Finishes the file generation
+	FinishFileGeneration(ctx context.Context, req *FinishFileGenerationRequest) (*Ok, error)
+	// Reads a part of a file from the TDLib file cache and returns read bytes. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct read from the file
+	ReadFilePart(ctx context.Context, req *ReadFilePartRequest) (*FilePart, error)
+	// Deletes a file from the TDLib file cache
+	DeleteFile(ctx context.Context, req *DeleteFileRequest) (*Ok, error)
+	// Adds a file from a message to the list of file downloads. Download progress and completion of the download will be notified through updateFile updates. If message database is used, the list of file downloads is persistent across application restarts. The downloading is independent of download using downloadFile, i.e. it continues if downloadFile is canceled or is used to download a part of the file
+	AddFileToDownloads(ctx context.Context, req *AddFileToDownloadsRequest) (*File, error)
+	// Changes pause state of a file in the file download list
+	ToggleDownloadIsPaused(ctx context.Context, req *ToggleDownloadIsPausedRequest) (*Ok, error)
+	// Changes pause state of all files in the file download list
+	ToggleAllDownloadsArePaused(ctx context.Context, req *ToggleAllDownloadsArePausedRequest) (*Ok, error)
+	// Removes a file from the file download list
+	RemoveFileFromDownloads(ctx context.Context, req *RemoveFileFromDownloadsRequest) (*Ok, error)
+	// Removes all files from the file download list
+	RemoveAllFilesFromDownloads(ctx context.Context, req *RemoveAllFilesFromDownloadsRequest) (*Ok, error)
+	// Searches for files in the file download list or recently downloaded files from the list
+	SearchFileDownloads(ctx context.Context, req *SearchFileDownloadsRequest) (*FoundFileDownloads, error)
+	// Application or reCAPTCHA verification has been completed. Can be called before authorization
+	SetApplicationVerificationToken(ctx context.Context, req *SetApplicationVerificationTokenRequest) (*Ok, error)
+	// Returns information about a file with messages exported from another application
+	GetMessageFileType(ctx context.Context, req *GetMessageFileTypeRequest) (MessageFileType, error)
+	// Returns a confirmation text to be shown to the user before starting message import
+	GetMessageImportConfirmationText(ctx context.Context, req *GetMessageImportConfirmationTextRequest) (*Text, error)
+	// Imports messages exported from another app
+	ImportMessages(ctx context.Context, req *ImportMessagesRequest) (*Ok, error)
+	// Replaces current primary invite link for a chat with a new primary invite link. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right
+	ReplacePrimaryChatInviteLink(ctx context.Context, req *ReplacePrimaryChatInviteLinkRequest) (*ChatInviteLink, error)
+	// Creates a new invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat
+	CreateChatInviteLink(ctx context.Context, req *CreateChatInviteLinkRequest) (*ChatInviteLink, error)
+	// Creates a new subscription invite link for a channel chat. Requires can_invite_users right in the chat
+	CreateChatSubscriptionInviteLink(ctx context.Context, req *CreateChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error)
+	// Edits a non-primary invite link for a chat. Available for basic groups, supergroups, and channels. If the link creates a subscription, then expiration_date, member_limit and creates_join_request must not be used. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+	EditChatInviteLink(ctx context.Context, req *EditChatInviteLinkRequest) (*ChatInviteLink, error)
+	// Edits a subscription invite link for a channel chat. Requires can_invite_users right in the chat for own links and owner privileges for other links
+	EditChatSubscriptionInviteLink(ctx context.Context, req *EditChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error)
+	// Returns information about an invite link. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+	GetChatInviteLink(ctx context.Context, req *GetChatInviteLinkRequest) (*ChatInviteLink, error)
+	// Returns the list of chat administrators with number of their invite links. Requires owner privileges in the chat
+	GetChatInviteLinkCounts(ctx context.Context, req *GetChatInviteLinkCountsRequest) (*ChatInviteLinkCounts, error)
+	// Returns invite links for a chat created by specified administrator. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+	GetChatInviteLinks(ctx context.Context, req *GetChatInviteLinksRequest) (*ChatInviteLinks, error)
+	// Returns chat members joined a chat via an invite link. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+	GetChatInviteLinkMembers(ctx context.Context, req *GetChatInviteLinkMembersRequest) (*ChatInviteLinkMembers, error)
+	// Revokes invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links. If a primary link is revoked, then additionally to the revoked link returns new primary link
+	RevokeChatInviteLink(ctx context.Context, req *RevokeChatInviteLinkRequest) (*ChatInviteLinks, error)
+	// Deletes revoked chat invite links. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+	DeleteRevokedChatInviteLink(ctx context.Context, req *DeleteRevokedChatInviteLinkRequest) (*Ok, error)
+	// Deletes all revoked chat invite links created by a given chat administrator. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+	DeleteAllRevokedChatInviteLinks(ctx context.Context, req *DeleteAllRevokedChatInviteLinksRequest) (*Ok, error)
+	// Checks the validity of an invite link for a chat and returns information about the corresponding chat
+	CheckChatInviteLink(ctx context.Context, req *CheckChatInviteLinkRequest) (*ChatInviteLinkInfo, error)
+	// Uses an invite link to add the current user to the chat if possible. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+	JoinChatByInviteLink(ctx context.Context, req *JoinChatByInviteLinkRequest) (*Chat, error)
+	// Returns pending join requests in a chat
+	GetChatJoinRequests(ctx context.Context, req *GetChatJoinRequestsRequest) (*ChatJoinRequests, error)
+	// Handles a pending join request in a chat
+	ProcessChatJoinRequest(ctx context.Context, req *ProcessChatJoinRequestRequest) (*Ok, error)
+	// Handles all pending join requests for a given link in a chat
+	ProcessChatJoinRequests(ctx context.Context, req *ProcessChatJoinRequestsRequest) (*Ok, error)
+	// Creates a new call
+	CreateCall(ctx context.Context, req *CreateCallRequest) (*CallId, error)
+	// Accepts an incoming call
+	AcceptCall(ctx context.Context, req *AcceptCallRequest) (*Ok, error)
+	// Sends call signaling data
+	SendCallSignalingData(ctx context.Context, req *SendCallSignalingDataRequest) (*Ok, error)
+	// Discards a call
+	DiscardCall(ctx context.Context, req *DiscardCallRequest) (*Ok, error)
+	// Sends a call rating
+	SendCallRating(ctx context.Context, req *SendCallRatingRequest) (*Ok, error)
+	// Sends debug information for a call to Telegram servers
+	SendCallDebugInformation(ctx context.Context, req *SendCallDebugInformationRequest) (*Ok, error)
+	// Sends log file for a call to Telegram servers
+	SendCallLog(ctx context.Context, req *SendCallLogRequest) (*Ok, error)
+	// Returns the list of participant identifiers, on whose behalf a video chat in the chat can be joined
+	GetVideoChatAvailableParticipants(ctx context.Context, req *GetVideoChatAvailableParticipantsRequest) (*MessageSenders, error)
+	// Changes default participant identifier, on whose behalf a video chat in the chat will be joined
+	SetVideoChatDefaultParticipant(ctx context.Context, req *SetVideoChatDefaultParticipantRequest) (*Ok, error)
+	// Creates a video chat (a group call bound to a chat). Available only for basic groups, supergroups and channels; requires can_manage_video_chats administrator right
+	CreateVideoChat(ctx context.Context, req *CreateVideoChatRequest) (*GroupCallId, error)
+	// Creates a group call from a one-to-one call
+	CreateGroupCall(ctx context.Context, req *CreateGroupCallRequest) (*Ok, error)
+	// Returns RTMP URL for streaming to the chat; requires can_manage_video_chats administrator right
+	GetVideoChatRtmpUrl(ctx context.Context, req *GetVideoChatRtmpUrlRequest) (*RtmpUrl, error)
+	// Replaces the current RTMP URL for streaming to the chat; requires owner privileges
+	ReplaceVideoChatRtmpUrl(ctx context.Context, req *ReplaceVideoChatRtmpUrlRequest) (*RtmpUrl, error)
+	// Returns information about a group call
+	GetGroupCall(ctx context.Context, req *GetGroupCallRequest) (*GroupCall, error)
+	// Starts a scheduled group call
+	StartScheduledGroupCall(ctx context.Context, req *StartScheduledGroupCallRequest) (*Ok, error)
+	// Toggles whether the current user will receive a notification when the group call starts; scheduled group calls only
+	ToggleGroupCallEnabledStartNotification(ctx context.Context, req *ToggleGroupCallEnabledStartNotificationRequest) (*Ok, error)
+	// Joins an active group call. Returns join response payload for tgcalls
+	JoinGroupCall(ctx context.Context, req *JoinGroupCallRequest) (*Text, error)
+	// Starts screen sharing in a joined group call. Returns join response payload for tgcalls
+	StartGroupCallScreenSharing(ctx context.Context, req *StartGroupCallScreenSharingRequest) (*Text, error)
+	// Pauses or unpauses screen sharing in a joined group call
+	ToggleGroupCallScreenSharingIsPaused(ctx context.Context, req *ToggleGroupCallScreenSharingIsPausedRequest) (*Ok, error)
+	// Ends screen sharing in a joined group call
+	EndGroupCallScreenSharing(ctx context.Context, req *EndGroupCallScreenSharingRequest) (*Ok, error)
+	// Sets group call title. Requires groupCall.can_be_managed group call flag
+	SetGroupCallTitle(ctx context.Context, req *SetGroupCallTitleRequest) (*Ok, error)
+	// Toggles whether new participants of a group call can be unmuted only by administrators of the group call. Requires groupCall.can_toggle_mute_new_participants group call flag
+	ToggleGroupCallMuteNewParticipants(ctx context.Context, req *ToggleGroupCallMuteNewParticipantsRequest) (*Ok, error)
+	// Invites users to an active group call. Sends a service message of type messageInviteVideoChatParticipants for video chats
+	InviteGroupCallParticipants(ctx context.Context, req *InviteGroupCallParticipantsRequest) (*Ok, error)
+	// Returns invite link to a video chat in a public chat
+	GetGroupCallInviteLink(ctx context.Context, req *GetGroupCallInviteLinkRequest) (*HttpUrl, error)
+	// Revokes invite link for a group call. Requires groupCall.can_be_managed group call flag
+	RevokeGroupCallInviteLink(ctx context.Context, req *RevokeGroupCallInviteLinkRequest) (*Ok, error)
+	// Starts recording of an active group call. Requires groupCall.can_be_managed group call flag
+	StartGroupCallRecording(ctx context.Context, req *StartGroupCallRecordingRequest) (*Ok, error)
+	// Ends recording of an active group call. Requires groupCall.can_be_managed group call flag
+	EndGroupCallRecording(ctx context.Context, req *EndGroupCallRecordingRequest) (*Ok, error)
+	// Toggles whether current user's video is paused
+	ToggleGroupCallIsMyVideoPaused(ctx context.Context, req *ToggleGroupCallIsMyVideoPausedRequest) (*Ok, error)
+	// Toggles whether current user's video is enabled
+	ToggleGroupCallIsMyVideoEnabled(ctx context.Context, req *ToggleGroupCallIsMyVideoEnabledRequest) (*Ok, error)
+	// Informs TDLib that speaking state of a participant of an active group has changed
+	SetGroupCallParticipantIsSpeaking(ctx context.Context, req *SetGroupCallParticipantIsSpeakingRequest) (*Ok, error)
+	// Toggles whether a participant of an active group call is muted, unmuted, or allowed to unmute themselves
+	ToggleGroupCallParticipantIsMuted(ctx context.Context, req *ToggleGroupCallParticipantIsMutedRequest) (*Ok, error)
+	// Changes volume level of a participant of an active group call. If the current user can manage the group call, then the participant's volume level will be changed for all users with the default volume level
+	SetGroupCallParticipantVolumeLevel(ctx context.Context, req *SetGroupCallParticipantVolumeLevelRequest) (*Ok, error)
+	// Toggles whether a group call participant hand is rased
+	ToggleGroupCallParticipantIsHandRaised(ctx context.Context, req *ToggleGroupCallParticipantIsHandRaisedRequest) (*Ok, error)
+	// Loads more participants of a group call. The loaded participants will be received through updates. Use the field groupCall.loaded_all_participants to check whether all participants have already been loaded
+	LoadGroupCallParticipants(ctx context.Context, req *LoadGroupCallParticipantsRequest) (*Ok, error)
+	// Leaves a group call
+	LeaveGroupCall(ctx context.Context, req *LeaveGroupCallRequest) (*Ok, error)
+	// Ends a group call. Requires groupCall.can_be_managed
+	EndGroupCall(ctx context.Context, req *EndGroupCallRequest) (*Ok, error)
+	// Returns information about available group call streams
+	GetGroupCallStreams(ctx context.Context, req *GetGroupCallStreamsRequest) (*GroupCallStreams, error)
+	// Returns a file with a segment of a group call stream in a modified OGG format for audio or MPEG-4 format for video
+	GetGroupCallStreamSegment(ctx context.Context, req *GetGroupCallStreamSegmentRequest) (*FilePart, error)
+	// Changes the block list of a message sender. Currently, only users and supergroup chats can be blocked
+	SetMessageSenderBlockList(ctx context.Context, req *SetMessageSenderBlockListRequest) (*Ok, error)
+	// Blocks an original sender of a message in the Replies chat
+	BlockMessageSenderFromReplies(ctx context.Context, req *BlockMessageSenderFromRepliesRequest) (*Ok, error)
+	// Returns users and chats that were blocked by the current user
+	GetBlockedMessageSenders(ctx context.Context, req *GetBlockedMessageSendersRequest) (*MessageSenders, error)
+	// Adds a user to the contact list or edits an existing contact by their user identifier
+	AddContact(ctx context.Context, req *AddContactRequest) (*Ok, error)
+	// Adds new contacts or edits existing contacts by their phone numbers; contacts' user identifiers are ignored
+	ImportContacts(ctx context.Context, req *ImportContactsRequest) (*ImportedContacts, error)
+	// Returns all contacts of the user
+	GetContacts(ctx context.Context) (*Users, error)
+	// Searches for the specified query in the first names, last names and usernames of the known user contacts
+	SearchContacts(ctx context.Context, req *SearchContactsRequest) (*Users, error)
+	// Removes users from the contact list
+	RemoveContacts(ctx context.Context, req *RemoveContactsRequest) (*Ok, error)
+	// Returns the total number of imported contacts
+	GetImportedContactCount(ctx context.Context) (*Count, error)
+	// Changes imported contacts using the list of contacts saved on the device. Imports newly added contacts and, if at least the file database is enabled, deletes recently deleted contacts. Query result depends on the result of the previous query, so only one query is possible at the same time
+	ChangeImportedContacts(ctx context.Context, req *ChangeImportedContactsRequest) (*ImportedContacts, error)
+	// Clears all imported contacts, contact list remains unchanged
+	ClearImportedContacts(ctx context.Context) (*Ok, error)
+	// Changes the list of close friends of the current user
+	SetCloseFriends(ctx context.Context, req *SetCloseFriendsRequest) (*Ok, error)
+	// Returns all close friends of the current user
+	GetCloseFriends(ctx context.Context) (*Users, error)
+	// Changes a personal profile photo of a contact user
+	SetUserPersonalProfilePhoto(ctx context.Context, req *SetUserPersonalProfilePhotoRequest) (*Ok, error)
+	// Suggests a profile photo to another regular user with common messages and allowing non-paid messages
+	SuggestUserProfilePhoto(ctx context.Context, req *SuggestUserProfilePhotoRequest) (*Ok, error)
+	// Toggles whether the bot can manage emoji status of the current user
+	ToggleBotCanManageEmojiStatus(ctx context.Context, req *ToggleBotCanManageEmojiStatusRequest) (*Ok, error)
+	// Changes the emoji status of a user; for bots only
+	SetUserEmojiStatus(ctx context.Context, req *SetUserEmojiStatusRequest) (*Ok, error)
+	// Searches a user by their phone number. Returns a 404 error if the user can't be found
+	SearchUserByPhoneNumber(ctx context.Context, req *SearchUserByPhoneNumberRequest) (*User, error)
+	// Shares the phone number of the current user with a mutual contact. Supposed to be called when the user clicks on chatActionBarSharePhoneNumber
+	SharePhoneNumber(ctx context.Context, req *SharePhoneNumberRequest) (*Ok, error)
+	// Returns the profile photos of a user. Personal and public photo aren't returned
+	GetUserProfilePhotos(ctx context.Context, req *GetUserProfilePhotosRequest) (*ChatPhotos, error)
+	// Returns outline of a sticker. This is an offline method. Returns a 404 error if the outline isn't known
+	GetStickerOutline(ctx context.Context, req *GetStickerOutlineRequest) (*Outline, error)
+	// Returns stickers from the installed sticker sets that correspond to any of the given emoji or can be found by sticker-specific keywords. If the query is non-empty, then favorite, recently used or trending stickers may also be returned
+	GetStickers(ctx context.Context, req *GetStickersRequest) (*Stickers, error)
+	// Returns unique emoji that correspond to stickers to be found by the getStickers(sticker_type, query, 1000000, chat_id)
+	GetAllStickerEmojis(ctx context.Context, req *GetAllStickerEmojisRequest) (*Emojis, error)
+	// Searches for stickers from public sticker sets that correspond to any of the given emoji
+	SearchStickers(ctx context.Context, req *SearchStickersRequest) (*Stickers, error)
+	// Returns greeting stickers from regular sticker sets that can be used for the start page of other users
+	GetGreetingStickers(ctx context.Context) (*Stickers, error)
+	// Returns premium stickers from regular sticker sets
+	GetPremiumStickers(ctx context.Context, req *GetPremiumStickersRequest) (*Stickers, error)
+	// Returns a list of installed sticker sets
+	GetInstalledStickerSets(ctx context.Context, req *GetInstalledStickerSetsRequest) (*StickerSets, error)
+	// Returns a list of archived sticker sets
+	GetArchivedStickerSets(ctx context.Context, req *GetArchivedStickerSetsRequest) (*StickerSets, error)
+	// Returns a list of trending sticker sets. For optimal performance, the number of returned sticker sets is chosen by TDLib
+	GetTrendingStickerSets(ctx context.Context, req *GetTrendingStickerSetsRequest) (*TrendingStickerSets, error)
+	// Returns a list of sticker sets attached to a file, including regular, mask, and emoji sticker sets. Currently, only animations, photos, and videos can have attached sticker sets
+	GetAttachedStickerSets(ctx context.Context, req *GetAttachedStickerSetsRequest) (*StickerSets, error)
+	// Returns information about a sticker set by its identifier
+	GetStickerSet(ctx context.Context, req *GetStickerSetRequest) (*StickerSet, error)
+	// Returns name of a sticker set by its identifier
+	GetStickerSetName(ctx context.Context, req *GetStickerSetNameRequest) (*Text, error)
+	// Searches for a sticker set by its name
+	SearchStickerSet(ctx context.Context, req *SearchStickerSetRequest) (*StickerSet, error)
+	// Searches for installed sticker sets by looking for specified query in their title and name
+	SearchInstalledStickerSets(ctx context.Context, req *SearchInstalledStickerSetsRequest) (*StickerSets, error)
+	// Searches for sticker sets by looking for specified query in their title and name. Excludes installed sticker sets from the results
+	SearchStickerSets(ctx context.Context, req *SearchStickerSetsRequest) (*StickerSets, error)
+	// Installs/uninstalls or activates/archives a sticker set
+	ChangeStickerSet(ctx context.Context, req *ChangeStickerSetRequest) (*Ok, error)
+	// Informs the server that some trending sticker sets have been viewed by the user
+	ViewTrendingStickerSets(ctx context.Context, req *ViewTrendingStickerSetsRequest) (*Ok, error)
+	// Changes the order of installed sticker sets
+	ReorderInstalledStickerSets(ctx context.Context, req *ReorderInstalledStickerSetsRequest) (*Ok, error)
+	// Returns a list of recently used stickers
+	GetRecentStickers(ctx context.Context, req *GetRecentStickersRequest) (*Stickers, error)
+	// Manually adds a new sticker to the list of recently used stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set or in WEBP or WEBM format can be added to this list. Emoji stickers can't be added to recent stickers
+	AddRecentSticker(ctx context.Context, req *AddRecentStickerRequest) (*Stickers, error)
+	// Removes a sticker from the list of recently used stickers
+	RemoveRecentSticker(ctx context.Context, req *RemoveRecentStickerRequest) (*Ok, error)
+	// Clears the list of recently used stickers
+	ClearRecentStickers(ctx context.Context, req *ClearRecentStickersRequest) (*Ok, error)
+	// Returns favorite stickers
+	GetFavoriteStickers(ctx context.Context) (*Stickers, error)
+	// Adds a new sticker to the list of favorite stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set or in WEBP or WEBM format can be added to this list. Emoji stickers can't be added to favorite stickers
+	AddFavoriteSticker(ctx context.Context, req *AddFavoriteStickerRequest) (*Ok, error)
+	// Removes a sticker from the list of favorite stickers
+	RemoveFavoriteSticker(ctx context.Context, req *RemoveFavoriteStickerRequest) (*Ok, error)
+	// Returns emoji corresponding to a sticker. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object
+	GetStickerEmojis(ctx context.Context, req *GetStickerEmojisRequest) (*Emojis, error)
+	// Searches for emojis by keywords. Supported only if the file database is enabled. Order of results is unspecified
+	SearchEmojis(ctx context.Context, req *SearchEmojisRequest) (*EmojiKeywords, error)
+	// Return emojis matching the keyword. Supported only if the file database is enabled. Order of results is unspecified
+	GetKeywordEmojis(ctx context.Context, req *GetKeywordEmojisRequest) (*Emojis, error)
+	// Returns available emoji categories
+	GetEmojiCategories(ctx context.Context, req *GetEmojiCategoriesRequest) (*EmojiCategories, error)
+	// Returns an animated emoji corresponding to a given emoji. Returns a 404 error if the emoji has no animated emoji
+	GetAnimatedEmoji(ctx context.Context, req *GetAnimatedEmojiRequest) (*AnimatedEmoji, error)
+	// Returns an HTTP URL which can be used to automatically log in to the translation platform and suggest new emoji replacements. The URL will be valid for 30 seconds after generation
+	GetEmojiSuggestionsUrl(ctx context.Context, req *GetEmojiSuggestionsUrlRequest) (*HttpUrl, error)
+	// Returns the list of custom emoji stickers by their identifiers. Stickers are returned in arbitrary order. Only found stickers are returned
+	GetCustomEmojiStickers(ctx context.Context, req *GetCustomEmojiStickersRequest) (*Stickers, error)
+	// Returns default list of custom emoji stickers for placing on a chat photo
+	GetDefaultChatPhotoCustomEmojiStickers(ctx context.Context) (*Stickers, error)
+	// Returns default list of custom emoji stickers for placing on a profile photo
+	GetDefaultProfilePhotoCustomEmojiStickers(ctx context.Context) (*Stickers, error)
+	// Returns default list of custom emoji stickers for reply background
+	GetDefaultBackgroundCustomEmojiStickers(ctx context.Context) (*Stickers, error)
+	// Returns saved animations
+	GetSavedAnimations(ctx context.Context) (*Animations, error)
+	// Manually adds a new animation to the list of saved animations. The new animation is added to the beginning of the list. If the animation was already in the list, it is removed first. Only non-secret video animations with MIME type "video/mp4" can be added to the list
+	AddSavedAnimation(ctx context.Context, req *AddSavedAnimationRequest) (*Ok, error)
+	// Removes an animation from the list of saved animations
+	RemoveSavedAnimation(ctx context.Context, req *RemoveSavedAnimationRequest) (*Ok, error)
+	// Returns up to 20 recently used inline bots in the order of their last usage
+	GetRecentInlineBots(ctx context.Context) (*Users, error)
+	// Returns the list of bots owned by the current user
+	GetOwnedBots(ctx context.Context) (*Users, error)
+	// Searches for recently used hashtags by their prefix
+	SearchHashtags(ctx context.Context, req *SearchHashtagsRequest) (*Hashtags, error)
+	// Removes a hashtag from the list of recently used hashtags
+	RemoveRecentHashtag(ctx context.Context, req *RemoveRecentHashtagRequest) (*Ok, error)
+	// Returns a link preview by the text of a message. Do not call this function too often. Returns a 404 error if the text has no link preview
+	GetLinkPreview(ctx context.Context, req *GetLinkPreviewRequest) (*LinkPreview, error)
+	// Returns an instant view version of a web page if available. This is an offline method if only_local is true. Returns a 404 error if the web page has no instant view page
+	GetWebPageInstantView(ctx context.Context, req *GetWebPageInstantViewRequest) (*WebPageInstantView, error)
+	// Changes a profile photo for the current user
+	SetProfilePhoto(ctx context.Context, req *SetProfilePhotoRequest) (*Ok, error)
+	// Deletes a profile photo
+	DeleteProfilePhoto(ctx context.Context, req *DeleteProfilePhotoRequest) (*Ok, error)
+	// Changes accent color and background custom emoji for the current user; for Telegram Premium users only
+	SetAccentColor(ctx context.Context, req *SetAccentColorRequest) (*Ok, error)
+	// Changes accent color and background custom emoji for profile of the current user; for Telegram Premium users only
+	SetProfileAccentColor(ctx context.Context, req *SetProfileAccentColorRequest) (*Ok, error)
+	// Changes the first and last name of the current user
+	SetName(ctx context.Context, req *SetNameRequest) (*Ok, error)
+	// Changes the bio of the current user
+	SetBio(ctx context.Context, req *SetBioRequest) (*Ok, error)
+	// Changes the editable username of the current user
+	SetUsername(ctx context.Context, req *SetUsernameRequest) (*Ok, error)
+	// Changes active state for a username of the current user. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+	ToggleUsernameIsActive(ctx context.Context, req *ToggleUsernameIsActiveRequest) (*Ok, error)
+	// Changes order of active usernames of the current user
+	ReorderActiveUsernames(ctx context.Context, req *ReorderActiveUsernamesRequest) (*Ok, error)
+	// Changes the birthdate of the current user
+	SetBirthdate(ctx context.Context, req *SetBirthdateRequest) (*Ok, error)
+	// Changes the personal chat of the current user
+	SetPersonalChat(ctx context.Context, req *SetPersonalChatRequest) (*Ok, error)
+	// Changes the emoji status of the current user; for Telegram Premium users only
+	SetEmojiStatus(ctx context.Context, req *SetEmojiStatusRequest) (*Ok, error)
+	// Toggles whether the current user has sponsored messages enabled. The setting has no effect for users without Telegram Premium for which sponsored messages are always enabled
+	ToggleHasSponsoredMessagesEnabled(ctx context.Context, req *ToggleHasSponsoredMessagesEnabledRequest) (*Ok, error)
+	// Changes the business location of the current user. Requires Telegram Business subscription
+	SetBusinessLocation(ctx context.Context, req *SetBusinessLocationRequest) (*Ok, error)
+	// Changes the business opening hours of the current user. Requires Telegram Business subscription
+	SetBusinessOpeningHours(ctx context.Context, req *SetBusinessOpeningHoursRequest) (*Ok, error)
+	// Changes the business greeting message settings of the current user. Requires Telegram Business subscription
+	SetBusinessGreetingMessageSettings(ctx context.Context, req *SetBusinessGreetingMessageSettingsRequest) (*Ok, error)
+	// Changes the business away message settings of the current user. Requires Telegram Business subscription
+	SetBusinessAwayMessageSettings(ctx context.Context, req *SetBusinessAwayMessageSettingsRequest) (*Ok, error)
+	// Changes the business start page of the current user. Requires Telegram Business subscription
+	SetBusinessStartPage(ctx context.Context, req *SetBusinessStartPageRequest) (*Ok, error)
+	// Sends a code to the specified phone number. Aborts previous phone number verification if there was one. On success, returns information about the sent code
+	SendPhoneNumberCode(ctx context.Context, req *SendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error)
+	// Sends Firebase Authentication SMS to the specified phone number. Works only when received a code of the type authenticationCodeTypeFirebaseAndroid or authenticationCodeTypeFirebaseIos
+	SendPhoneNumberFirebaseSms(ctx context.Context, req *SendPhoneNumberFirebaseSmsRequest) (*Ok, error)
+	// Reports that authentication code wasn't delivered via SMS to the specified phone number; for official mobile applications only
+	ReportPhoneNumberCodeMissing(ctx context.Context, req *ReportPhoneNumberCodeMissingRequest) (*Ok, error)
+	// Resends the authentication code sent to a phone number. Works only if the previously received authenticationCodeInfo next_code_type was not null and the server-specified timeout has passed
+	ResendPhoneNumberCode(ctx context.Context, req *ResendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error)
+	// Check the authentication code and completes the request for which the code was sent if appropriate
+	CheckPhoneNumberCode(ctx context.Context, req *CheckPhoneNumberCodeRequest) (*Ok, error)
+	// Returns the business bot that is connected to the current user account. Returns a 404 error if there is no connected bot
+	GetBusinessConnectedBot(ctx context.Context) (*BusinessConnectedBot, error)
+	// Adds or changes business bot that is connected to the current user account
+	SetBusinessConnectedBot(ctx context.Context, req *SetBusinessConnectedBotRequest) (*Ok, error)
+	// Deletes the business bot that is connected to the current user account
+	DeleteBusinessConnectedBot(ctx context.Context, req *DeleteBusinessConnectedBotRequest) (*Ok, error)
+	// Pauses or resumes the connected business bot in a specific chat
+	ToggleBusinessConnectedBotChatIsPaused(ctx context.Context, req *ToggleBusinessConnectedBotChatIsPausedRequest) (*Ok, error)
+	// Removes the connected business bot from a specific chat by adding the chat to businessRecipients.excluded_chat_ids
+	RemoveBusinessConnectedBotFromChat(ctx context.Context, req *RemoveBusinessConnectedBotFromChatRequest) (*Ok, error)
+	// Returns business chat links created for the current account
+	GetBusinessChatLinks(ctx context.Context) (*BusinessChatLinks, error)
+	// Creates a business chat link for the current account. Requires Telegram Business subscription. There can be up to getOption("business_chat_link_count_max") links created. Returns the created link
+	CreateBusinessChatLink(ctx context.Context, req *CreateBusinessChatLinkRequest) (*BusinessChatLink, error)
+	// Edits a business chat link of the current account. Requires Telegram Business subscription. Returns the edited link
+	EditBusinessChatLink(ctx context.Context, req *EditBusinessChatLinkRequest) (*BusinessChatLink, error)
+	// Deletes a business chat link of the current account
+	DeleteBusinessChatLink(ctx context.Context, req *DeleteBusinessChatLinkRequest) (*Ok, error)
+	// Returns information about a business chat link
+	GetBusinessChatLinkInfo(ctx context.Context, req *GetBusinessChatLinkInfoRequest) (*BusinessChatLinkInfo, error)
+	// Returns an HTTPS link, which can be used to get information about the current user
+	GetUserLink(ctx context.Context) (*UserLink, error)
+	// Searches a user by a token from the user's link
+	SearchUserByToken(ctx context.Context, req *SearchUserByTokenRequest) (*User, error)
+	// Sets the list of commands supported by the bot for the given user scope and language; for bots only
+	SetCommands(ctx context.Context, req *SetCommandsRequest) (*Ok, error)
+	// Deletes commands supported by the bot for the given user scope and language; for bots only
+	DeleteCommands(ctx context.Context, req *DeleteCommandsRequest) (*Ok, error)
+	// Returns the list of commands supported by the bot for the given user scope and language; for bots only
+	GetCommands(ctx context.Context, req *GetCommandsRequest) (*BotCommands, error)
+	// Sets menu button for the given user or for all users; for bots only
+	SetMenuButton(ctx context.Context, req *SetMenuButtonRequest) (*Ok, error)
+	// Returns menu button set by the bot for the given user; for bots only
+	GetMenuButton(ctx context.Context, req *GetMenuButtonRequest) (*BotMenuButton, error)
+	// Sets default administrator rights for adding the bot to basic group and supergroup chats; for bots only
+	SetDefaultGroupAdministratorRights(ctx context.Context, req *SetDefaultGroupAdministratorRightsRequest) (*Ok, error)
+	// Sets default administrator rights for adding the bot to channel chats; for bots only
+	SetDefaultChannelAdministratorRights(ctx context.Context, req *SetDefaultChannelAdministratorRightsRequest) (*Ok, error)
+	// Checks whether the specified bot can send messages to the user. Returns a 404 error if can't and the access can be granted by call to allowBotToSendMessages
+	CanBotSendMessages(ctx context.Context, req *CanBotSendMessagesRequest) (*Ok, error)
+	// Allows the specified bot to send messages to the user
+	AllowBotToSendMessages(ctx context.Context, req *AllowBotToSendMessagesRequest) (*Ok, error)
+	// Sends a custom request from a Web App
+	SendWebAppCustomRequest(ctx context.Context, req *SendWebAppCustomRequestRequest) (*CustomRequestResult, error)
+	// Returns the list of media previews of a bot
+	GetBotMediaPreviews(ctx context.Context, req *GetBotMediaPreviewsRequest) (*BotMediaPreviews, error)
+	// Returns the list of media previews for the given language and the list of languages for which the bot has dedicated previews
+	GetBotMediaPreviewInfo(ctx context.Context, req *GetBotMediaPreviewInfoRequest) (*BotMediaPreviewInfo, error)
+	// Adds a new media preview to the beginning of the list of media previews of a bot. Returns the added preview after addition is completed server-side. The total number of previews must not exceed getOption("bot_media_preview_count_max") for the given language
+	AddBotMediaPreview(ctx context.Context, req *AddBotMediaPreviewRequest) (*BotMediaPreview, error)
+	// Replaces media preview in the list of media previews of a bot. Returns the new preview after edit is completed server-side
+	EditBotMediaPreview(ctx context.Context, req *EditBotMediaPreviewRequest) (*BotMediaPreview, error)
+	// Changes order of media previews in the list of media previews of a bot
+	ReorderBotMediaPreviews(ctx context.Context, req *ReorderBotMediaPreviewsRequest) (*Ok, error)
+	// Delete media previews from the list of media previews of a bot
+	DeleteBotMediaPreviews(ctx context.Context, req *DeleteBotMediaPreviewsRequest) (*Ok, error)
+	// Sets the name of a bot. Can be called only if userTypeBot.can_be_edited == true
+	SetBotName(ctx context.Context, req *SetBotNameRequest) (*Ok, error)
+	// Returns the name of a bot in the given language. Can be called only if userTypeBot.can_be_edited == true
+	GetBotName(ctx context.Context, req *GetBotNameRequest) (*Text, error)
+	// Changes a profile photo for a bot
+	SetBotProfilePhoto(ctx context.Context, req *SetBotProfilePhotoRequest) (*Ok, error)
+	// Changes active state for a username of a bot. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached. Can be called only if userTypeBot.can_be_edited == true
+	ToggleBotUsernameIsActive(ctx context.Context, req *ToggleBotUsernameIsActiveRequest) (*Ok, error)
+	// Changes order of active usernames of a bot. Can be called only if userTypeBot.can_be_edited == true
+	ReorderBotActiveUsernames(ctx context.Context, req *ReorderBotActiveUsernamesRequest) (*Ok, error)
+	// Sets the text shown in the chat with a bot if the chat is empty. Can be called only if userTypeBot.can_be_edited == true
+	SetBotInfoDescription(ctx context.Context, req *SetBotInfoDescriptionRequest) (*Ok, error)
+	// Returns the text shown in the chat with a bot if the chat is empty in the given language. Can be called only if userTypeBot.can_be_edited == true
+	GetBotInfoDescription(ctx context.Context, req *GetBotInfoDescriptionRequest) (*Text, error)
+	// Sets the text shown on a bot's profile page and sent together with the link when users share the bot. Can be called only if userTypeBot.can_be_edited == true
+	SetBotInfoShortDescription(ctx context.Context, req *SetBotInfoShortDescriptionRequest) (*Ok, error)
+	// Returns the text shown on a bot's profile page and sent together with the link when users share the bot in the given language. Can be called only if userTypeBot.can_be_edited == true
+	GetBotInfoShortDescription(ctx context.Context, req *GetBotInfoShortDescriptionRequest) (*Text, error)
+	// Changes the verification status of a user or a chat by an owned bot
+	SetMessageSenderBotVerification(ctx context.Context, req *SetMessageSenderBotVerificationRequest) (*Ok, error)
+	// Removes the verification status of a user or a chat by an owned bot
+	RemoveMessageSenderBotVerification(ctx context.Context, req *RemoveMessageSenderBotVerificationRequest) (*Ok, error)
+	// Returns all active sessions of the current user
+	GetActiveSessions(ctx context.Context) (*Sessions, error)
+	// Terminates a session of the current user
+	TerminateSession(ctx context.Context, req *TerminateSessionRequest) (*Ok, error)
+	// Terminates all other sessions of the current user
+	TerminateAllOtherSessions(ctx context.Context) (*Ok, error)
+	// Confirms an unconfirmed session of the current user from another device
+	ConfirmSession(ctx context.Context, req *ConfirmSessionRequest) (*Ok, error)
+	// Toggles whether a session can accept incoming calls
+	ToggleSessionCanAcceptCalls(ctx context.Context, req *ToggleSessionCanAcceptCallsRequest) (*Ok, error)
+	// Toggles whether a session can accept incoming secret chats
+	ToggleSessionCanAcceptSecretChats(ctx context.Context, req *ToggleSessionCanAcceptSecretChatsRequest) (*Ok, error)
+	// Changes the period of inactivity after which sessions will automatically be terminated
+	SetInactiveSessionTtl(ctx context.Context, req *SetInactiveSessionTtlRequest) (*Ok, error)
+	// Returns all website where the current user used Telegram to log in
+	GetConnectedWebsites(ctx context.Context) (*ConnectedWebsites, error)
+	// Disconnects website from the current user's Telegram account
+	DisconnectWebsite(ctx context.Context, req *DisconnectWebsiteRequest) (*Ok, error)
+	// Disconnects all websites from the current user's Telegram account
+	DisconnectAllWebsites(ctx context.Context) (*Ok, error)
+	// Changes the editable username of a supergroup or channel, requires owner privileges in the supergroup or channel
+	SetSupergroupUsername(ctx context.Context, req *SetSupergroupUsernameRequest) (*Ok, error)
+	// Changes active state for a username of a supergroup or channel, requires owner privileges in the supergroup or channel. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+	ToggleSupergroupUsernameIsActive(ctx context.Context, req *ToggleSupergroupUsernameIsActiveRequest) (*Ok, error)
+	// Disables all active non-editable usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+	DisableAllSupergroupUsernames(ctx context.Context, req *DisableAllSupergroupUsernamesRequest) (*Ok, error)
+	// Changes order of active usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+	ReorderSupergroupActiveUsernames(ctx context.Context, req *ReorderSupergroupActiveUsernamesRequest) (*Ok, error)
+	// Changes the sticker set of a supergroup; requires can_change_info administrator right
+	SetSupergroupStickerSet(ctx context.Context, req *SetSupergroupStickerSetRequest) (*Ok, error)
+	// Changes the custom emoji sticker set of a supergroup; requires can_change_info administrator right. The chat must have at least chatBoostFeatures.min_custom_emoji_sticker_set_boost_level boost level to pass the corresponding color
+	SetSupergroupCustomEmojiStickerSet(ctx context.Context, req *SetSupergroupCustomEmojiStickerSetRequest) (*Ok, error)
+	// Changes the number of times the supergroup must be boosted by a user to ignore slow mode and chat permission restrictions; requires can_restrict_members administrator right
+	SetSupergroupUnrestrictBoostCount(ctx context.Context, req *SetSupergroupUnrestrictBoostCountRequest) (*Ok, error)
+	// Toggles whether sender signature or link to the account is added to sent messages in a channel; requires can_change_info member right
+	ToggleSupergroupSignMessages(ctx context.Context, req *ToggleSupergroupSignMessagesRequest) (*Ok, error)
+	// Toggles whether joining is mandatory to send messages to a discussion supergroup; requires can_restrict_members administrator right
+	ToggleSupergroupJoinToSendMessages(ctx context.Context, req *ToggleSupergroupJoinToSendMessagesRequest) (*Ok, error)
+	// Toggles whether all users directly joining the supergroup need to be approved by supergroup administrators; requires can_restrict_members administrator right
+	ToggleSupergroupJoinByRequest(ctx context.Context, req *ToggleSupergroupJoinByRequestRequest) (*Ok, error)
+	// Toggles whether the message history of a supergroup is available to new members; requires can_change_info member right
+	ToggleSupergroupIsAllHistoryAvailable(ctx context.Context, req *ToggleSupergroupIsAllHistoryAvailableRequest) (*Ok, error)
+	// Toggles whether sponsored messages are shown in the channel chat; requires owner privileges in the channel. The chat must have at least chatBoostFeatures.min_sponsored_message_disable_boost_level boost level to disable sponsored messages
+	ToggleSupergroupCanHaveSponsoredMessages(ctx context.Context, req *ToggleSupergroupCanHaveSponsoredMessagesRequest) (*Ok, error)
+	// Toggles whether non-administrators can receive only administrators and bots using getSupergroupMembers or searchChatMembers. Can be called only if supergroupFullInfo.can_hide_members == true
+	ToggleSupergroupHasHiddenMembers(ctx context.Context, req *ToggleSupergroupHasHiddenMembersRequest) (*Ok, error)
+	// Toggles whether aggressive anti-spam checks are enabled in the supergroup. Can be called only if supergroupFullInfo.can_toggle_aggressive_anti_spam == true
+	ToggleSupergroupHasAggressiveAntiSpamEnabled(ctx context.Context, req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) (*Ok, error)
+	// Toggles whether the supergroup is a forum; requires owner privileges in the supergroup. Discussion supergroups can't be converted to forums
+	ToggleSupergroupIsForum(ctx context.Context, req *ToggleSupergroupIsForumRequest) (*Ok, error)
+	// Upgrades supergroup to a broadcast group; requires owner privileges in the supergroup
+	ToggleSupergroupIsBroadcastGroup(ctx context.Context, req *ToggleSupergroupIsBroadcastGroupRequest) (*Ok, error)
+	// Reports messages in a supergroup as spam; requires administrator rights in the supergroup
+	ReportSupergroupSpam(ctx context.Context, req *ReportSupergroupSpamRequest) (*Ok, error)
+	// Reports a false deletion of a message by aggressive anti-spam checks; requires administrator rights in the supergroup. Can be called only for messages from chatEventMessageDeleted with can_report_anti_spam_false_positive == true
+	ReportSupergroupAntiSpamFalsePositive(ctx context.Context, req *ReportSupergroupAntiSpamFalsePositiveRequest) (*Ok, error)
+	// Returns information about members or banned users in a supergroup or channel. Can be used only if supergroupFullInfo.can_get_members == true; additionally, administrator privileges may be required for some filters
+	GetSupergroupMembers(ctx context.Context, req *GetSupergroupMembersRequest) (*ChatMembers, error)
+	// Closes a secret chat, effectively transferring its state to secretChatStateClosed
+	CloseSecretChat(ctx context.Context, req *CloseSecretChatRequest) (*Ok, error)
+	// Returns a list of service actions taken by chat members and administrators in the last 48 hours. Available only for supergroups and channels. Requires administrator rights. Returns results in reverse chronological order (i.e., in order of decreasing event_id)
+	GetChatEventLog(ctx context.Context, req *GetChatEventLogRequest) (*ChatEvents, error)
+	// Returns the list of supported time zones
+	GetTimeZones(ctx context.Context) (*TimeZones, error)
+	// Returns an invoice payment form. This method must be called when the user presses inline button of the type inlineKeyboardButtonTypeBuy, or wants to buy access to media in a messagePaidMedia message
+	GetPaymentForm(ctx context.Context, req *GetPaymentFormRequest) (*PaymentForm, error)
+	// Validates the order information provided by a user and returns the available shipping options for a flexible invoice
+	ValidateOrderInfo(ctx context.Context, req *ValidateOrderInfoRequest) (*ValidatedOrderInfo, error)
+	// Sends a filled-out payment form to the bot for final verification
+	SendPaymentForm(ctx context.Context, req *SendPaymentFormRequest) (*PaymentResult, error)
+	// Returns information about a successful payment
+	GetPaymentReceipt(ctx context.Context, req *GetPaymentReceiptRequest) (*PaymentReceipt, error)
+	// Returns saved order information. Returns a 404 error if there is no saved order information
+	GetSavedOrderInfo(ctx context.Context) (*OrderInfo, error)
+	// Deletes saved order information
+	DeleteSavedOrderInfo(ctx context.Context) (*Ok, error)
+	// Deletes saved credentials for all payment provider bots
+	DeleteSavedCredentials(ctx context.Context) (*Ok, error)
+	// Changes settings for gift receiving for the current user
+	SetGiftSettings(ctx context.Context, req *SetGiftSettingsRequest) (*Ok, error)
+	// Returns gifts that can be sent to other users and channel chats
+	GetAvailableGifts(ctx context.Context) (*Gifts, error)
+	// Sends a gift to another user or channel chat. May return an error with a message "STARGIFT_USAGE_LIMITED" if the gift was sold out
+	SendGift(ctx context.Context, req *SendGiftRequest) (*Ok, error)
+	// Sells a gift for Telegram Stars
+	SellGift(ctx context.Context, req *SellGiftRequest) (*Ok, error)
+	// Toggles whether a gift is shown on the current user's or the channel's profile page; requires can_post_messages administrator right in the channel chat
+	ToggleGiftIsSaved(ctx context.Context, req *ToggleGiftIsSavedRequest) (*Ok, error)
+	// Changes the list of pinned gifts on the current user's or the channel's profile page; requires can_post_messages administrator right in the channel chat
+	SetPinnedGifts(ctx context.Context, req *SetPinnedGiftsRequest) (*Ok, error)
+	// Toggles whether notifications for new gifts received by a channel chat are sent to the current user; requires can_post_messages administrator right in the chat
+	ToggleChatGiftNotifications(ctx context.Context, req *ToggleChatGiftNotificationsRequest) (*Ok, error)
+	// Returns examples of possible upgraded gifts for a regular gift
+	GetGiftUpgradePreview(ctx context.Context, req *GetGiftUpgradePreviewRequest) (*GiftUpgradePreview, error)
+	// Upgrades a regular gift
+	UpgradeGift(ctx context.Context, req *UpgradeGiftRequest) (*UpgradeGiftResult, error)
+	// Sends an upgraded gift to another user or a channel chat
+	TransferGift(ctx context.Context, req *TransferGiftRequest) (*Ok, error)
+	// Returns gifts received by the given user or chat
+	GetReceivedGifts(ctx context.Context, req *GetReceivedGiftsRequest) (*ReceivedGifts, error)
+	// Returns information about a received gift
+	GetReceivedGift(ctx context.Context, req *GetReceivedGiftRequest) (*ReceivedGift, error)
+	// Returns information about an upgraded gift by its name
+	GetUpgradedGift(ctx context.Context, req *GetUpgradedGiftRequest) (*UpgradedGift, error)
+	// Returns a URL for upgraded gift withdrawal in the TON blockchain as an NFT; requires owner privileges for gifts owned by a chat
+	GetUpgradedGiftWithdrawalUrl(ctx context.Context, req *GetUpgradedGiftWithdrawalUrlRequest) (*HttpUrl, error)
+	// Creates a link for the given invoice; for bots only
+	CreateInvoiceLink(ctx context.Context, req *CreateInvoiceLinkRequest) (*HttpUrl, error)
+	// Refunds a previously done payment in Telegram Stars; for bots only
+	RefundStarPayment(ctx context.Context, req *RefundStarPaymentRequest) (*Ok, error)
+	// Returns a user that can be contacted to get support
+	GetSupportUser(ctx context.Context) (*User, error)
+	// Constructs a persistent HTTP URL for a background
+	GetBackgroundUrl(ctx context.Context, req *GetBackgroundUrlRequest) (*HttpUrl, error)
+	// Searches for a background by its name
+	SearchBackground(ctx context.Context, req *SearchBackgroundRequest) (*Background, error)
+	// Sets default background for chats; adds the background to the list of installed backgrounds
+	SetDefaultBackground(ctx context.Context, req *SetDefaultBackgroundRequest) (*Background, error)
+	// Deletes default background for chats
+	DeleteDefaultBackground(ctx context.Context, req *DeleteDefaultBackgroundRequest) (*Ok, error)
+	// Returns backgrounds installed by the user
+	GetInstalledBackgrounds(ctx context.Context, req *GetInstalledBackgroundsRequest) (*Backgrounds, error)
+	// Removes background from the list of installed backgrounds
+	RemoveInstalledBackground(ctx context.Context, req *RemoveInstalledBackgroundRequest) (*Ok, error)
+	// Resets list of installed backgrounds to its default value
+	ResetInstalledBackgrounds(ctx context.Context) (*Ok, error)
+	// Returns information about the current localization target. This is an offline method if only_local is true. Can be called before authorization
+	GetLocalizationTargetInfo(ctx context.Context, req *GetLocalizationTargetInfoRequest) (*LocalizationTargetInfo, error)
+	// Returns information about a language pack. Returned language pack identifier may be different from a provided one. Can be called before authorization
+	GetLanguagePackInfo(ctx context.Context, req *GetLanguagePackInfoRequest) (*LanguagePackInfo, error)
+	// Returns strings from a language pack in the current localization target by their keys. Can be called before authorization
+	GetLanguagePackStrings(ctx context.Context, req *GetLanguagePackStringsRequest) (*LanguagePackStrings, error)
+	// Fetches the latest versions of all strings from a language pack in the current localization target from the server. This method doesn't need to be called explicitly for the current used/base language packs. Can be called before authorization
+	SynchronizeLanguagePack(ctx context.Context, req *SynchronizeLanguagePackRequest) (*Ok, error)
+	// Adds a custom server language pack to the list of installed language packs in current localization target. Can be called before authorization
+	AddCustomServerLanguagePack(ctx context.Context, req *AddCustomServerLanguagePackRequest) (*Ok, error)
+	// Adds or changes a custom local language pack to the current localization target
+	SetCustomLanguagePack(ctx context.Context, req *SetCustomLanguagePackRequest) (*Ok, error)
+	// Edits information about a custom local language pack in the current localization target. Can be called before authorization
+	EditCustomLanguagePackInfo(ctx context.Context, req *EditCustomLanguagePackInfoRequest) (*Ok, error)
+	// Adds, edits or deletes a string in a custom local language pack. Can be called before authorization
+	SetCustomLanguagePackString(ctx context.Context, req *SetCustomLanguagePackStringRequest) (*Ok, error)
+	// Deletes all information about a language pack in the current localization target. The language pack which is currently in use (including base language pack) or is being synchronized can't be deleted. Can be called before authorization
+	DeleteLanguagePack(ctx context.Context, req *DeleteLanguagePackRequest) (*Ok, error)
+	// Registers the currently used device for receiving push notifications. Returns a globally unique identifier of the push notification subscription
+	RegisterDevice(ctx context.Context, req *RegisterDeviceRequest) (*PushReceiverId, error)
+	// Handles a push notification. Returns error with code 406 if the push notification is not supported and connection to the server is required to fetch new data. Can be called before authorization
+	ProcessPushNotification(ctx context.Context, req *ProcessPushNotificationRequest) (*Ok, error)
+	// Returns t.me URLs recently visited by a newly registered user
+	GetRecentlyVisitedTMeUrls(ctx context.Context, req *GetRecentlyVisitedTMeUrlsRequest) (*TMeUrls, error)
+	// Changes user privacy settings
+	SetUserPrivacySettingRules(ctx context.Context, req *SetUserPrivacySettingRulesRequest) (*Ok, error)
+	// Returns the current privacy settings
+	GetUserPrivacySettingRules(ctx context.Context, req *GetUserPrivacySettingRulesRequest) (*UserPrivacySettingRules, error)
+	// Changes privacy settings for message read date
+	SetReadDatePrivacySettings(ctx context.Context, req *SetReadDatePrivacySettingsRequest) (*Ok, error)
+	// Returns privacy settings for message read date
+	GetReadDatePrivacySettings(ctx context.Context) (*ReadDatePrivacySettings, error)
+	// Changes privacy settings for new chat creation; can be used only if getOption("can_set_new_chat_privacy_settings")
+	SetNewChatPrivacySettings(ctx context.Context, req *SetNewChatPrivacySettingsRequest) (*Ok, error)
+	// Returns privacy settings for new chat creation
+	GetNewChatPrivacySettings(ctx context.Context) (*NewChatPrivacySettings, error)
+	// Returns the total number of Telegram Stars received by the current user for paid messages from the given user
+	GetPaidMessageRevenue(ctx context.Context, req *GetPaidMessageRevenueRequest) (*StarCount, error)
+	// Allows the specified user to send unpaid private messages to the current user by adding a rule to userPrivacySettingAllowUnpaidMessages
+	AllowUnpaidMessagesFromUser(ctx context.Context, req *AllowUnpaidMessagesFromUserRequest) (*Ok, error)
+	// Changes the amount of Telegram Stars that must be paid to send a message to a supergroup chat; requires can_restrict_members administrator right and supergroupFullInfo.can_enable_paid_messages
+	SetChatPaidMessageStarCount(ctx context.Context, req *SetChatPaidMessageStarCountRequest) (*Ok, error)
+	// Check whether the current user can message another user or try to create a chat with them
+	CanSendMessageToUser(ctx context.Context, req *CanSendMessageToUserRequest) (CanSendMessageToUserResult, error)
+	// Sets the value of an option. (Check the list of available options on https://core.telegram.org/tdlib/options.) Only writable options can be set. Can be called before authorization
+	SetOption(ctx context.Context, req *SetOptionRequest) (*Ok, error)
+	// Changes the period of inactivity after which the account of the current user will automatically be deleted
+	SetAccountTtl(ctx context.Context, req *SetAccountTtlRequest) (*Ok, error)
+	// Returns the period of inactivity after which the account of the current user will automatically be deleted
+	GetAccountTtl(ctx context.Context) (*AccountTtl, error)
+	// Deletes the account of the current user, deleting all information associated with the user from the server. The phone number of the account can be used to create a new account. Can be called before authorization when the current authorization state is authorizationStateWaitPassword
+	DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*Ok, error)
+	// Changes the default message auto-delete time for new chats
+	SetDefaultMessageAutoDeleteTime(ctx context.Context, req *SetDefaultMessageAutoDeleteTimeRequest) (*Ok, error)
+	// Returns default message auto-delete time setting for new chats
+	GetDefaultMessageAutoDeleteTime(ctx context.Context) (*MessageAutoDeleteTime, error)
+	// Removes a chat action bar without any other action
+	RemoveChatActionBar(ctx context.Context, req *RemoveChatActionBarRequest) (*Ok, error)
+	// Reports a chat to the Telegram moderators. A chat can be reported only from the chat action bar, or if chat.can_be_reported
+	ReportChat(ctx context.Context, req *ReportChatRequest) (ReportChatResult, error)
+	// Reports a chat photo to the Telegram moderators. A chat photo can be reported only if chat.can_be_reported
+	ReportChatPhoto(ctx context.Context, req *ReportChatPhotoRequest) (*Ok, error)
+	// Reports reactions set on a message to the Telegram moderators. Reactions on a message can be reported only if messageProperties.can_report_reactions
+	ReportMessageReactions(ctx context.Context, req *ReportMessageReactionsRequest) (*Ok, error)
+	// Returns detailed revenue statistics about a chat. Currently, this method can be used only for channels if supergroupFullInfo.can_get_revenue_statistics == true or bots if userFullInfo.bot_info.can_get_revenue_statistics == true
+	GetChatRevenueStatistics(ctx context.Context, req *GetChatRevenueStatisticsRequest) (*ChatRevenueStatistics, error)
+	// Returns a URL for chat revenue withdrawal; requires owner privileges in the channel chat or the bot. Currently, this method can be used only if getOption("can_withdraw_chat_revenue") for channels with supergroupFullInfo.can_get_revenue_statistics == true or bots with userFullInfo.bot_info.can_get_revenue_statistics == true
+	GetChatRevenueWithdrawalUrl(ctx context.Context, req *GetChatRevenueWithdrawalUrlRequest) (*HttpUrl, error)
+	// Returns the list of revenue transactions for a chat. Currently, this method can be used only for channels if supergroupFullInfo.can_get_revenue_statistics == true or bots if userFullInfo.bot_info.can_get_revenue_statistics == true
+	GetChatRevenueTransactions(ctx context.Context, req *GetChatRevenueTransactionsRequest) (*ChatRevenueTransactions, error)
+	// Returns detailed Telegram Star revenue statistics
+	GetStarRevenueStatistics(ctx context.Context, req *GetStarRevenueStatisticsRequest) (*StarRevenueStatistics, error)
+	// Returns a URL for Telegram Star withdrawal
+	GetStarWithdrawalUrl(ctx context.Context, req *GetStarWithdrawalUrlRequest) (*HttpUrl, error)
+	// Returns a URL for a Telegram Ad platform account that can be used to set up advertisements for the chat paid in the owned Telegram Stars
+	GetStarAdAccountUrl(ctx context.Context, req *GetStarAdAccountUrlRequest) (*HttpUrl, error)
+	// Returns detailed statistics about a chat. Currently, this method can be used only for supergroups and channels. Can be used only if supergroupFullInfo.can_get_statistics == true
+	GetChatStatistics(ctx context.Context, req *GetChatStatisticsRequest) (ChatStatistics, error)
+	// Returns detailed statistics about a message. Can be used only if messageProperties.can_get_statistics == true
+	GetMessageStatistics(ctx context.Context, req *GetMessageStatisticsRequest) (*MessageStatistics, error)
+	// Returns forwarded copies of a channel message to different public channels and public reposts as a story. Can be used only if messageProperties.can_get_statistics == true. For optimal performance, the number of returned messages and stories is chosen by TDLib
+	GetMessagePublicForwards(ctx context.Context, req *GetMessagePublicForwardsRequest) (*PublicForwards, error)
+	// Returns detailed statistics about a story. Can be used only if story.can_get_statistics == true
+	GetStoryStatistics(ctx context.Context, req *GetStoryStatisticsRequest) (*StoryStatistics, error)
+	// Loads an asynchronous or a zoomed in statistical graph
+	GetStatisticalGraph(ctx context.Context, req *GetStatisticalGraphRequest) (StatisticalGraph, error)
+	// Returns storage usage statistics. Can be called before authorization
+	GetStorageStatistics(ctx context.Context, req *GetStorageStatisticsRequest) (*StorageStatistics, error)
+	// Quickly returns approximate storage usage statistics. Can be called before authorization
+	GetStorageStatisticsFast(ctx context.Context) (*StorageStatisticsFast, error)
+	// Returns database statistics
+	GetDatabaseStatistics(ctx context.Context) (*DatabaseStatistics, error)
+	// Optimizes storage usage, i.e. deletes some files and returns new storage usage statistics. Secret thumbnails can't be deleted
+	OptimizeStorage(ctx context.Context, req *OptimizeStorageRequest) (*StorageStatistics, error)
+	// Sets the current network type. Can be called before authorization. Calling this method forces all network connections to reopen, mitigating the delay in switching between different networks, so it must be called whenever the network is changed, even if the network type remains the same. Network type is used to check whether the library can use the network at all and also for collecting detailed network data usage statistics
+	SetNetworkType(ctx context.Context, req *SetNetworkTypeRequest) (*Ok, error)
+	// Returns network data usage statistics. Can be called before authorization
+	GetNetworkStatistics(ctx context.Context, req *GetNetworkStatisticsRequest) (*NetworkStatistics, error)
+	// Adds the specified data to data usage statistics. Can be called before authorization
+	AddNetworkStatistics(ctx context.Context, req *AddNetworkStatisticsRequest) (*Ok, error)
+	// Resets all network data usage statistics to zero. Can be called before authorization
+	ResetNetworkStatistics(ctx context.Context) (*Ok, error)
+	// Returns auto-download settings presets for the current user
+	GetAutoDownloadSettingsPresets(ctx context.Context) (*AutoDownloadSettingsPresets, error)
+	// Sets auto-download settings
+	SetAutoDownloadSettings(ctx context.Context, req *SetAutoDownloadSettingsRequest) (*Ok, error)
+	// Returns autosave settings for the current user
+	GetAutosaveSettings(ctx context.Context) (*AutosaveSettings, error)
+	// Sets autosave settings for the given scope. The method is guaranteed to work only after at least one call to getAutosaveSettings
+	SetAutosaveSettings(ctx context.Context, req *SetAutosaveSettingsRequest) (*Ok, error)
+	// Clears the list of all autosave settings exceptions. The method is guaranteed to work only after at least one call to getAutosaveSettings
+	ClearAutosaveSettingsExceptions(ctx context.Context) (*Ok, error)
+	// Returns information about a bank card
+	GetBankCardInfo(ctx context.Context, req *GetBankCardInfoRequest) (*BankCardInfo, error)
+	// Returns one of the available Telegram Passport elements
+	GetPassportElement(ctx context.Context, req *GetPassportElementRequest) (PassportElement, error)
+	// Returns all available Telegram Passport elements
+	GetAllPassportElements(ctx context.Context, req *GetAllPassportElementsRequest) (*PassportElements, error)
+	// Adds an element to the user's Telegram Passport. May return an error with a message "PHONE_VERIFICATION_NEEDED" or "EMAIL_VERIFICATION_NEEDED" if the chosen phone number or the chosen email address must be verified first
+	SetPassportElement(ctx context.Context, req *SetPassportElementRequest) (PassportElement, error)
+	// Deletes a Telegram Passport element
+	DeletePassportElement(ctx context.Context, req *DeletePassportElementRequest) (*Ok, error)
+	// Informs the user that some of the elements in their Telegram Passport contain errors; for bots only. The user will not be able to resend the elements, until the errors are fixed
+	SetPassportElementErrors(ctx context.Context, req *SetPassportElementErrorsRequest) (*Ok, error)
+	// Returns an IETF language tag of the language preferred in the country, which must be used to fill native fields in Telegram Passport personal details. Returns a 404 error if unknown
+	GetPreferredCountryLanguage(ctx context.Context, req *GetPreferredCountryLanguageRequest) (*Text, error)
+	// Sends a code to verify an email address to be added to a user's Telegram Passport
+	SendEmailAddressVerificationCode(ctx context.Context, req *SendEmailAddressVerificationCodeRequest) (*EmailAddressAuthenticationCodeInfo, error)
+	// Resends the code to verify an email address to be added to a user's Telegram Passport
+	ResendEmailAddressVerificationCode(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error)
+	// Checks the email address verification code for Telegram Passport
+	CheckEmailAddressVerificationCode(ctx context.Context, req *CheckEmailAddressVerificationCodeRequest) (*Ok, error)
+	// Returns a Telegram Passport authorization form for sharing data with a service
+	GetPassportAuthorizationForm(ctx context.Context, req *GetPassportAuthorizationFormRequest) (*PassportAuthorizationForm, error)
+	// Returns already available Telegram Passport elements suitable for completing a Telegram Passport authorization form. Result can be received only once for each authorization form
+	GetPassportAuthorizationFormAvailableElements(ctx context.Context, req *GetPassportAuthorizationFormAvailableElementsRequest) (*PassportElementsWithErrors, error)
+	// Sends a Telegram Passport authorization form, effectively sharing data with the service. This method must be called after getPassportAuthorizationFormAvailableElements if some previously available elements are going to be reused
+	SendPassportAuthorizationForm(ctx context.Context, req *SendPassportAuthorizationFormRequest) (*Ok, error)
+	// Informs the server about the number of pending bot updates if they haven't been processed for a long time; for bots only
+	SetBotUpdatesStatus(ctx context.Context, req *SetBotUpdatesStatusRequest) (*Ok, error)
+	// Uploads a file with a sticker; returns the uploaded file
+	UploadStickerFile(ctx context.Context, req *UploadStickerFileRequest) (*File, error)
+	// Returns a suggested name for a new sticker set with a given title
+	GetSuggestedStickerSetName(ctx context.Context, req *GetSuggestedStickerSetNameRequest) (*Text, error)
+	// Checks whether a name can be used for a new sticker set
+	CheckStickerSetName(ctx context.Context, req *CheckStickerSetNameRequest) (CheckStickerSetNameResult, error)
+	// Creates a new sticker set. Returns the newly created sticker set
+	CreateNewStickerSet(ctx context.Context, req *CreateNewStickerSetRequest) (*StickerSet, error)
+	// Adds a new sticker to a set
+	AddStickerToSet(ctx context.Context, req *AddStickerToSetRequest) (*Ok, error)
+	// Replaces existing sticker in a set. The function is equivalent to removeStickerFromSet, then addStickerToSet, then setStickerPositionInSet
+	ReplaceStickerInSet(ctx context.Context, req *ReplaceStickerInSetRequest) (*Ok, error)
+	// Sets a sticker set thumbnail
+	SetStickerSetThumbnail(ctx context.Context, req *SetStickerSetThumbnailRequest) (*Ok, error)
+	// Sets a custom emoji sticker set thumbnail
+	SetCustomEmojiStickerSetThumbnail(ctx context.Context, req *SetCustomEmojiStickerSetThumbnailRequest) (*Ok, error)
+	// Sets a sticker set title
+	SetStickerSetTitle(ctx context.Context, req *SetStickerSetTitleRequest) (*Ok, error)
+	// Completely deletes a sticker set
+	DeleteStickerSet(ctx context.Context, req *DeleteStickerSetRequest) (*Ok, error)
+	// Changes the position of a sticker in the set to which it belongs. The sticker set must be owned by the current user
+	SetStickerPositionInSet(ctx context.Context, req *SetStickerPositionInSetRequest) (*Ok, error)
+	// Removes a sticker from the set to which it belongs. The sticker set must be owned by the current user
+	RemoveStickerFromSet(ctx context.Context, req *RemoveStickerFromSetRequest) (*Ok, error)
+	// Changes the list of emojis corresponding to a sticker. The sticker must belong to a regular or custom emoji sticker set that is owned by the current user
+	SetStickerEmojis(ctx context.Context, req *SetStickerEmojisRequest) (*Ok, error)
+	// Changes the list of keywords of a sticker. The sticker must belong to a regular or custom emoji sticker set that is owned by the current user
+	SetStickerKeywords(ctx context.Context, req *SetStickerKeywordsRequest) (*Ok, error)
+	// Changes the mask position of a mask sticker. The sticker must belong to a mask sticker set that is owned by the current user
+	SetStickerMaskPosition(ctx context.Context, req *SetStickerMaskPositionRequest) (*Ok, error)
+	// Returns sticker sets owned by the current user
+	GetOwnedStickerSets(ctx context.Context, req *GetOwnedStickerSetsRequest) (*StickerSets, error)
+	// Returns information about a file with a map thumbnail in PNG format. Only map thumbnail files with size less than 1MB can be downloaded
+	GetMapThumbnailFile(ctx context.Context, req *GetMapThumbnailFileRequest) (*File, error)
+	// Returns information about a limit, increased for Premium users. Returns a 404 error if the limit is unknown
+	GetPremiumLimit(ctx context.Context, req *GetPremiumLimitRequest) (*PremiumLimit, error)
+	// Returns information about features, available to Premium users
+	GetPremiumFeatures(ctx context.Context, req *GetPremiumFeaturesRequest) (*PremiumFeatures, error)
+	// Returns examples of premium stickers for demonstration purposes
+	GetPremiumStickerExamples(ctx context.Context) (*Stickers, error)
+	// Returns the sticker to be used as representation of the Telegram Premium subscription
+	GetPremiumInfoSticker(ctx context.Context, req *GetPremiumInfoStickerRequest) (*Sticker, error)
+	// Informs TDLib that the user viewed detailed information about a Premium feature on the Premium features screen
+	ViewPremiumFeature(ctx context.Context, req *ViewPremiumFeatureRequest) (*Ok, error)
+	// Informs TDLib that the user clicked Premium subscription button on the Premium features screen
+	ClickPremiumSubscriptionButton(ctx context.Context) (*Ok, error)
+	// Returns state of Telegram Premium subscription and promotion videos for Premium features
+	GetPremiumState(ctx context.Context) (*PremiumState, error)
+	// Returns available options for gifting Telegram Premium to a user
+	GetPremiumGiftPaymentOptions(ctx context.Context) (*PremiumGiftPaymentOptions, error)
+	// Returns available options for creating of Telegram Premium giveaway or manual distribution of Telegram Premium among chat members
+	GetPremiumGiveawayPaymentOptions(ctx context.Context, req *GetPremiumGiveawayPaymentOptionsRequest) (*PremiumGiveawayPaymentOptions, error)
+	// Return information about a Telegram Premium gift code
+	CheckPremiumGiftCode(ctx context.Context, req *CheckPremiumGiftCodeRequest) (*PremiumGiftCodeInfo, error)
+	// Applies a Telegram Premium gift code
+	ApplyPremiumGiftCode(ctx context.Context, req *ApplyPremiumGiftCodeRequest) (*Ok, error)
+	// Allows to buy a Telegram Premium subscription for another user with payment in Telegram Stars; for bots only
+	GiftPremiumWithStars(ctx context.Context, req *GiftPremiumWithStarsRequest) (*Ok, error)
+	// Launches a prepaid giveaway
+	LaunchPrepaidGiveaway(ctx context.Context, req *LaunchPrepaidGiveawayRequest) (*Ok, error)
+	// Returns information about a giveaway
+	GetGiveawayInfo(ctx context.Context, req *GetGiveawayInfoRequest) (GiveawayInfo, error)
+	// Returns available options for Telegram Stars purchase
+	GetStarPaymentOptions(ctx context.Context) (*StarPaymentOptions, error)
+	// Returns available options for Telegram Stars gifting
+	GetStarGiftPaymentOptions(ctx context.Context, req *GetStarGiftPaymentOptionsRequest) (*StarPaymentOptions, error)
+	// Returns available options for Telegram Star giveaway creation
+	GetStarGiveawayPaymentOptions(ctx context.Context) (*StarGiveawayPaymentOptions, error)
+	// Returns the list of Telegram Star transactions for the specified owner
+	GetStarTransactions(ctx context.Context, req *GetStarTransactionsRequest) (*StarTransactions, error)
+	// Returns the list of Telegram Star subscriptions for the current user
+	GetStarSubscriptions(ctx context.Context, req *GetStarSubscriptionsRequest) (*StarSubscriptions, error)
+	// Checks whether an in-store purchase is possible. Must be called before any in-store purchase. For official applications only
+	CanPurchaseFromStore(ctx context.Context, req *CanPurchaseFromStoreRequest) (*Ok, error)
+	// Informs server about an in-store purchase. For official applications only
+	AssignStoreTransaction(ctx context.Context, req *AssignStoreTransactionRequest) (*Ok, error)
+	// Cancels or re-enables Telegram Star subscription
+	EditStarSubscription(ctx context.Context, req *EditStarSubscriptionRequest) (*Ok, error)
+	// Cancels or re-enables Telegram Star subscription for a user; for bots only
+	EditUserStarSubscription(ctx context.Context, req *EditUserStarSubscriptionRequest) (*Ok, error)
+	// Reuses an active Telegram Star subscription to a channel chat and joins the chat again
+	ReuseStarSubscription(ctx context.Context, req *ReuseStarSubscriptionRequest) (*Ok, error)
+	// Changes affiliate program for a bot
+	SetChatAffiliateProgram(ctx context.Context, req *SetChatAffiliateProgramRequest) (*Ok, error)
+	// Searches a chat with an affiliate program. Returns the chat if found and the program is active
+	SearchChatAffiliateProgram(ctx context.Context, req *SearchChatAffiliateProgramRequest) (*Chat, error)
+	// Searches affiliate programs that can be connected to the given affiliate
+	SearchAffiliatePrograms(ctx context.Context, req *SearchAffiliateProgramsRequest) (*FoundAffiliatePrograms, error)
+	// Connects an affiliate program to the given affiliate. Returns information about the connected affiliate program
+	ConnectAffiliateProgram(ctx context.Context, req *ConnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error)
+	// Disconnects an affiliate program from the given affiliate and immediately deactivates its referral link. Returns updated information about the disconnected affiliate program
+	DisconnectAffiliateProgram(ctx context.Context, req *DisconnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error)
+	// Returns an affiliate program that were connected to the given affiliate by identifier of the bot that created the program
+	GetConnectedAffiliateProgram(ctx context.Context, req *GetConnectedAffiliateProgramRequest) (*ConnectedAffiliateProgram, error)
+	// Returns affiliate programs that were connected to the given affiliate
+	GetConnectedAffiliatePrograms(ctx context.Context, req *GetConnectedAffiliateProgramsRequest) (*ConnectedAffiliatePrograms, error)
+	// Returns information about features, available to Business users
+	GetBusinessFeatures(ctx context.Context, req *GetBusinessFeaturesRequest) (*BusinessFeatures, error)
+	// Accepts Telegram terms of services
+	AcceptTermsOfService(ctx context.Context, req *AcceptTermsOfServiceRequest) (*Ok, error)
+	// Sends a custom request; for bots only
+	SendCustomRequest(ctx context.Context, req *SendCustomRequestRequest) (*CustomRequestResult, error)
+	// Answers a custom query; for bots only
+	AnswerCustomQuery(ctx context.Context, req *AnswerCustomQueryRequest) (*Ok, error)
+	// Succeeds after a specified amount of time has passed. Can be called before initialization
+	SetAlarm(ctx context.Context, req *SetAlarmRequest) (*Ok, error)
+	// Returns information about existing countries. Can be called before authorization
+	GetCountries(ctx context.Context) (*Countries, error)
+	// Uses the current IP address to find the current country. Returns two-letter ISO 3166-1 alpha-2 country code. Can be called before authorization
+	GetCountryCode(ctx context.Context) (*Text, error)
+	// Returns information about a phone number by its prefix. Can be called before authorization
+	GetPhoneNumberInfo(ctx context.Context, req *GetPhoneNumberInfoRequest) (*PhoneNumberInfo, error)
+	// Returns information about a given collectible item that was purchased at https://fragment.com
+	GetCollectibleItemInfo(ctx context.Context, req *GetCollectibleItemInfoRequest) (*CollectibleItemInfo, error)
+	// Returns information about a tg:// deep link. Use "tg://need_update_for_some_feature" or "tg:some_unsupported_feature" for testing. Returns a 404 error for unknown links. Can be called before authorization
+	GetDeepLinkInfo(ctx context.Context, req *GetDeepLinkInfoRequest) (*DeepLinkInfo, error)
+	// Returns application config, provided by the server. Can be called before authorization
+	GetApplicationConfig(ctx context.Context) (JsonValue, error)
+	// Saves application log event on the server. Can be called before authorization
+	SaveApplicationLogEvent(ctx context.Context, req *SaveApplicationLogEventRequest) (*Ok, error)
+	// Returns the link for downloading official Telegram application to be used when the current user invites friends to Telegram
+	GetApplicationDownloadLink(ctx context.Context) (*HttpUrl, error)
+	// Adds a proxy server for network requests. Can be called before authorization
+	AddProxy(ctx context.Context, req *AddProxyRequest) (*Proxy, error)
+	// Edits an existing proxy server for network requests. Can be called before authorization
+	EditProxy(ctx context.Context, req *EditProxyRequest) (*Proxy, error)
+	// Enables a proxy. Only one proxy can be enabled at a time. Can be called before authorization
+	EnableProxy(ctx context.Context, req *EnableProxyRequest) (*Ok, error)
+	// Disables the currently enabled proxy. Can be called before authorization
+	DisableProxy(ctx context.Context) (*Ok, error)
+	// Removes a proxy server. Can be called before authorization
+	RemoveProxy(ctx context.Context, req *RemoveProxyRequest) (*Ok, error)
+	// Returns the list of proxies that are currently set up. Can be called before authorization
+	GetProxies(ctx context.Context) (*Proxies, error)
+	// Returns an HTTPS link, which can be used to add a proxy. Available only for SOCKS5 and MTProto proxies. Can be called before authorization
+	GetProxyLink(ctx context.Context, req *GetProxyLinkRequest) (*HttpUrl, error)
+	// Computes time needed to receive a response from a Telegram server through a proxy. Can be called before authorization
+	PingProxy(ctx context.Context, req *PingProxyRequest) (*Seconds, error)
+	// Returns support information for the given user; for Telegram support only
+	GetUserSupportInfo(ctx context.Context, req *GetUserSupportInfoRequest) (*UserSupportInfo, error)
+	// Sets support information for the given user; for Telegram support only
+	SetUserSupportInfo(ctx context.Context, req *SetUserSupportInfoRequest) (*UserSupportInfo, error)
+	// Returns localized name of the Telegram support user; for Telegram support only
+	GetSupportName(ctx context.Context) (*Text, error)
+	// Does nothing; for testing only. This is an offline method. Can be called before authorization
+	TestCallEmpty(ctx context.Context) (*Ok, error)
+	// Returns the received string; for testing only. This is an offline method. Can be called before authorization
+	TestCallString(ctx context.Context, req *TestCallStringRequest) (*TestString, error)
+	// Returns the received bytes; for testing only. This is an offline method. Can be called before authorization
+	TestCallBytes(ctx context.Context, req *TestCallBytesRequest) (*TestBytes, error)
+	// Returns the received vector of numbers; for testing only. This is an offline method. Can be called before authorization
+	TestCallVectorInt(ctx context.Context, req *TestCallVectorIntRequest) (*TestVectorInt, error)
+	// Returns the received vector of objects containing a number; for testing only. This is an offline method. Can be called before authorization
+	TestCallVectorIntObject(ctx context.Context, req *TestCallVectorIntObjectRequest) (*TestVectorIntObject, error)
+	// Returns the received vector of strings; for testing only. This is an offline method. Can be called before authorization
+	TestCallVectorString(ctx context.Context, req *TestCallVectorStringRequest) (*TestVectorString, error)
+	// Returns the received vector of objects containing a string; for testing only. This is an offline method. Can be called before authorization
+	TestCallVectorStringObject(ctx context.Context, req *TestCallVectorStringObjectRequest) (*TestVectorStringObject, error)
+	// Returns the squared received number; for testing only. This is an offline method. Can be called before authorization
+	TestSquareInt(ctx context.Context, req *TestSquareIntRequest) (*TestInt, error)
+	// Sends a simple network request to the Telegram servers; for testing only. Can be called before authorization
+	TestNetwork(ctx context.Context) (*Ok, error)
+	// Sends a simple network request to the Telegram servers via proxy; for testing only. Can be called before authorization
+	TestProxy(ctx context.Context, req *TestProxyRequest) (*Ok, error)
+	// Forces an updates.getDifference call to the Telegram servers; for testing only
+	TestGetDifference(ctx context.Context) (*Ok, error)
+	// Does nothing and ensures that the Update object is used; for testing only. This is an offline method. Can be called before authorization
+	TestUseUpdate(ctx context.Context) (Update, error)
+}
+
+var _ TDLib = (*Client)(nil)
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrNotMocked = errors.New("method is not mocked")
+
+// MockCall is a recorded call of a Mock method
+type MockCall struct {
+	Request Request
+}
+
+// Method returns the TDLib name of the called method
+func (call MockCall) Method() string {
+	return call.Request.GetFunctionName()
+}
+
+type mockRecorder struct {
+	mu    sync.Mutex
+	calls []MockCall
+}
+
+func (recorder *mockRecorder) record(req Request) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.calls = append(recorder.calls, MockCall{Request: req})
+}
+
+// Calls returns the recorded calls in order
+func (recorder *mockRecorder) Calls() []MockCall {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	return append([]MockCall(nil), recorder.calls...)
+}
+
+// CallsOf returns the recorded calls of the TDLib method, e.g. "getMe"
+func (recorder *mockRecorder) CallsOf(method string) []MockCall {
+	var calls []MockCall
+	for _, call := range recorder.Calls() {
+		if call.Method() == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// ResetCalls forgets the recorded calls
+func (recorder *mockRecorder) ResetCalls() {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.calls = nil
+}
+
+func mockNotImplemented(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrNotMocked)
+}
@@ -0,0 +1,8991 @@
+// AUTOGENERATED
+package client
+
+import (
+	"context"
+)
+
+// Mock is a programmable TDLib implementation for tests.
+// A method calls the function in the field with the Func suffix, e.g. GetMeFunc for GetMe, and records the call.
+// Methods without a function return ErrNotMocked.
+type Mock struct {
+	mockRecorder
+	GetAuthorizationStateFunc                         func(ctx context.Context) (AuthorizationState, error)
+	SetTdlibParametersFunc                            func(ctx context.Context, req *SetTdlibParametersRequest) (*Ok, error)
+	SetAuthenticationPhoneNumberFunc                  func(ctx context.Context, req *SetAuthenticationPhoneNumberRequest) (*Ok, error)
+	CheckAuthenticationPremiumPurchaseFunc            func(ctx context.Context, req *CheckAuthenticationPremiumPurchaseRequest) (*Ok, error)
+	SetAuthenticationPremiumPurchaseTransactionFunc   func(ctx context.Context, req *SetAuthenticationPremiumPurchaseTransactionRequest) (*Ok, error)
+	SetAuthenticationEmailAddressFunc                 func(ctx context.Context, req *SetAuthenticationEmailAddressRequest) (*Ok, error)
+	ResendAuthenticationCodeFunc                      func(ctx context.Context, req *ResendAuthenticationCodeRequest) (*Ok, error)
+	CheckAuthenticationEmailCodeFunc                  func(ctx context.Context, req *CheckAuthenticationEmailCodeRequest) (*Ok, error)
+	CheckAuthenticationCodeFunc                       func(ctx context.Context, req *CheckAuthenticationCodeRequest) (*Ok, error)
+	RequestQrCodeAuthenticationFunc                   func(ctx context.Context, req *RequestQrCodeAuthenticationRequest) (*Ok, error)
+	RegisterUserFunc                                  func(ctx context.Context, req *RegisterUserRequest) (*Ok, error)
+	ResetAuthenticationEmailAddressFunc               func(ctx context.Context) (*Ok, error)
+	CheckAuthenticationPasswordFunc                   func(ctx context.Context, req *CheckAuthenticationPasswordRequest) (*Ok, error)
+	RequestAuthenticationPasswordRecoveryFunc         func(ctx context.Context) (*Ok, error)
+	CheckAuthenticationPasswordRecoveryCodeFunc       func(ctx context.Context, req *CheckAuthenticationPasswordRecoveryCodeRequest) (*Ok, error)
+	RecoverAuthenticationPasswordFunc                 func(ctx context.Context, req *RecoverAuthenticationPasswordRequest) (*Ok, error)
+	SendAuthenticationFirebaseSmsFunc                 func(ctx context.Context, req *SendAuthenticationFirebaseSmsRequest) (*Ok, error)
+	ReportAuthenticationCodeMissingFunc               func(ctx context.Context, req *ReportAuthenticationCodeMissingRequest) (*Ok, error)
+	CheckAuthenticationBotTokenFunc                   func(ctx context.Context, req *CheckAuthenticationBotTokenRequest) (*Ok, error)
+	LogOutFunc                                        func(ctx context.Context) (*Ok, error)
+	CloseFunc                                         func(ctx context.Context) (*Ok, error)
+	DestroyFunc                                       func(ctx context.Context) (*Ok, error)
+	ConfirmQrCodeAuthenticationFunc                   func(ctx context.Context, req *ConfirmQrCodeAuthenticationRequest) (*Session, error)
+	GetCurrentStateFunc                               func(ctx context.Context) (*Updates, error)
+	SetDatabaseEncryptionKeyFunc                      func(ctx context.Context, req *SetDatabaseEncryptionKeyRequest) (*Ok, error)
+	GetPasswordStateFunc                              func(ctx context.Context) (*PasswordState, error)
+	SetPasswordFunc                                   func(ctx context.Context, req *SetPasswordRequest) (*PasswordState, error)
+	SetLoginEmailAddressFunc                          func(ctx context.Context, req *SetLoginEmailAddressRequest) (*EmailAddressAuthenticationCodeInfo, error)
+	ResendLoginEmailAddressCodeFunc                   func(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error)
+	CheckLoginEmailAddressCodeFunc                    func(ctx context.Context, req *CheckLoginEmailAddressCodeRequest) (*Ok, error)
+	GetRecoveryEmailAddressFunc                       func(ctx context.Context, req *GetRecoveryEmailAddressRequest) (*RecoveryEmailAddress, error)
+	SetRecoveryEmailAddressFunc                       func(ctx context.Context, req *SetRecoveryEmailAddressRequest) (*PasswordState, error)
+	CheckRecoveryEmailAddressCodeFunc                 func(ctx context.Context, req *CheckRecoveryEmailAddressCodeRequest) (*PasswordState, error)
+	ResendRecoveryEmailAddressCodeFunc                func(ctx context.Context) (*PasswordState, error)
+	CancelRecoveryEmailAddressVerificationFunc        func(ctx context.Context) (*PasswordState, error)
+	RequestPasswordRecoveryFunc                       func(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error)
+	CheckPasswordRecoveryCodeFunc                     func(ctx context.Context, req *CheckPasswordRecoveryCodeRequest) (*Ok, error)
+	RecoverPasswordFunc                               func(ctx context.Context, req *RecoverPasswordRequest) (*PasswordState, error)
+	ResetPasswordFunc                                 func(ctx context.Context) (ResetPasswordResult, error)
+	CancelPasswordResetFunc                           func(ctx context.Context) (*Ok, error)
+	CreateTemporaryPasswordFunc                       func(ctx context.Context, req *CreateTemporaryPasswordRequest) (*TemporaryPasswordState, error)
+	GetTemporaryPasswordStateFunc                     func(ctx context.Context) (*TemporaryPasswordState, error)
+	GetMeFunc                                         func(ctx context.Context) (*User, error)
+	GetUserFunc                                       func(ctx context.Context, req *GetUserRequest) (*User, error)
+	GetUserFullInfoFunc                               func(ctx context.Context, req *GetUserFullInfoRequest) (*UserFullInfo, error)
+	GetBasicGroupFunc                                 func(ctx context.Context, req *GetBasicGroupRequest) (*BasicGroup, error)
+	GetBasicGroupFullInfoFunc                         func(ctx context.Context, req *GetBasicGroupFullInfoRequest) (*BasicGroupFullInfo, error)
+	GetSupergroupFunc                                 func(ctx context.Context, req *GetSupergroupRequest) (*Supergroup, error)
+	GetSupergroupFullInfoFunc                         func(ctx context.Context, req *GetSupergroupFullInfoRequest) (*SupergroupFullInfo, error)
+	GetSecretChatFunc                                 func(ctx context.Context, req *GetSecretChatRequest) (*SecretChat, error)
+	GetChatFunc                                       func(ctx context.Context, req *GetChatRequest) (*Chat, error)
+	GetMessageFunc                                    func(ctx context.Context, req *GetMessageRequest) (*Message, error)
+	GetMessageLocallyFunc                             func(ctx context.Context, req *GetMessageLocallyRequest) (*Message, error)
+	GetRepliedMessageFunc                             func(ctx context.Context, req *GetRepliedMessageRequest) (*Message, error)
+	GetChatPinnedMessageFunc                          func(ctx context.Context, req *GetChatPinnedMessageRequest) (*Message, error)
+	GetCallbackQueryMessageFunc                       func(ctx context.Context, req *GetCallbackQueryMessageRequest) (*Message, error)
+	GetMessagesFunc                                   func(ctx context.Context, req *GetMessagesRequest) (*Messages, error)
+	GetMessagePropertiesFunc                          func(ctx context.Context, req *GetMessagePropertiesRequest) (*MessageProperties, error)
+	GetMessageThreadFunc                              func(ctx context.Context, req *GetMessageThreadRequest) (*MessageThreadInfo, error)
+	GetMessageReadDateFunc                            func(ctx context.Context, req *GetMessageReadDateRequest) (MessageReadDate, error)
+	GetMessageViewersFunc                             func(ctx context.Context, req *GetMessageViewersRequest) (*MessageViewers, error)
+	GetFileFunc                                       func(ctx context.Context, req *GetFileRequest) (*File, error)
+	GetRemoteFileFunc                                 func(ctx context.Context, req *GetRemoteFileRequest) (*File, error)
+	LoadChatsFunc                                     func(ctx context.Context, req *LoadChatsRequest) (*Ok, error)
+	GetChatsFunc                                      func(ctx context.Context, req *GetChatsRequest) (*Chats, error)
+	SearchPublicChatFunc                              func(ctx context.Context, req *SearchPublicChatRequest) (*Chat, error)
+	SearchPublicChatsFunc                             func(ctx context.Context, req *SearchPublicChatsRequest) (*Chats, error)
+	SearchChatsFunc                                   func(ctx context.Context, req *SearchChatsRequest) (*Chats, error)
+	SearchChatsOnServerFunc                           func(ctx context.Context, req *SearchChatsOnServerRequest) (*Chats, error)
+	GetRecommendedChatsFunc                           func(ctx context.Context) (*Chats, error)
+	GetChatSimilarChatsFunc                           func(ctx context.Context, req *GetChatSimilarChatsRequest) (*Chats, error)
+	GetChatSimilarChatCountFunc                       func(ctx context.Context, req *GetChatSimilarChatCountRequest) (*Count, error)
+	OpenChatSimilarChatFunc                           func(ctx context.Context, req *OpenChatSimilarChatRequest) (*Ok, error)
+	GetBotSimilarBotsFunc                             func(ctx context.Context, req *GetBotSimilarBotsRequest) (*Users, error)
+	GetBotSimilarBotCountFunc                         func(ctx context.Context, req *GetBotSimilarBotCountRequest) (*Count, error)
+	OpenBotSimilarBotFunc                             func(ctx context.Context, req *OpenBotSimilarBotRequest) (*Ok, error)
+	GetTopChatsFunc                                   func(ctx context.Context, req *GetTopChatsRequest) (*Chats, error)
+	RemoveTopChatFunc                                 func(ctx context.Context, req *RemoveTopChatRequest) (*Ok, error)
+	SearchRecentlyFoundChatsFunc                      func(ctx context.Context, req *SearchRecentlyFoundChatsRequest) (*Chats, error)
+	AddRecentlyFoundChatFunc                          func(ctx context.Context, req *AddRecentlyFoundChatRequest) (*Ok, error)
+	RemoveRecentlyFoundChatFunc                       func(ctx context.Context, req *RemoveRecentlyFoundChatRequest) (*Ok, error)
+	ClearRecentlyFoundChatsFunc                       func(ctx context.Context) (*Ok, error)
+	GetRecentlyOpenedChatsFunc                        func(ctx context.Context, req *GetRecentlyOpenedChatsRequest) (*Chats, error)
+	CheckChatUsernameFunc                             func(ctx context.Context, req *CheckChatUsernameRequest) (CheckChatUsernameResult, error)
+	GetCreatedPublicChatsFunc                         func(ctx context.Context, req *GetCreatedPublicChatsRequest) (*Chats, error)
+	CheckCreatedPublicChatsLimitFunc                  func(ctx context.Context, req *CheckCreatedPublicChatsLimitRequest) (*Ok, error)
+	GetSuitableDiscussionChatsFunc                    func(ctx context.Context) (*Chats, error)
+	GetInactiveSupergroupChatsFunc                    func(ctx context.Context) (*Chats, error)
+	GetSuitablePersonalChatsFunc                      func(ctx context.Context) (*Chats, error)
+	LoadSavedMessagesTopicsFunc                       func(ctx context.Context, req *LoadSavedMessagesTopicsRequest) (*Ok, error)
+	GetSavedMessagesTopicHistoryFunc                  func(ctx context.Context, req *GetSavedMessagesTopicHistoryRequest) (*Messages, error)
+	GetSavedMessagesTopicMessageByDateFunc            func(ctx context.Context, req *GetSavedMessagesTopicMessageByDateRequest) (*Message, error)
+	DeleteSavedMessagesTopicHistoryFunc               func(ctx context.Context, req *DeleteSavedMessagesTopicHistoryRequest) (*Ok, error)
+	DeleteSavedMessagesTopicMessagesByDateFunc        func(ctx context.Context, req *DeleteSavedMessagesTopicMessagesByDateRequest) (*Ok, error)
+	ToggleSavedMessagesTopicIsPinnedFunc              func(ctx context.Context, req *ToggleSavedMessagesTopicIsPinnedRequest) (*Ok, error)
+	SetPinnedSavedMessagesTopicsFunc                  func(ctx context.Context, req *SetPinnedSavedMessagesTopicsRequest) (*Ok, error)
+	GetGroupsInCommonFunc                             func(ctx context.Context, req *GetGroupsInCommonRequest) (*Chats, error)
+	GetChatHistoryFunc                                func(ctx context.Context, req *GetChatHistoryRequest) (*Messages, error)
+	GetMessageThreadHistoryFunc                       func(ctx context.Context, req *GetMessageThreadHistoryRequest) (*Messages, error)
+	DeleteChatHistoryFunc                             func(ctx context.Context, req *DeleteChatHistoryRequest) (*Ok, error)
+	DeleteChatFunc                                    func(ctx context.Context, req *DeleteChatRequest) (*Ok, error)
+	SearchChatMessagesFunc                            func(ctx context.Context, req *SearchChatMessagesRequest) (*FoundChatMessages, error)
+	SearchMessagesFunc                                func(ctx context.Context, req *SearchMessagesRequest) (*FoundMessages, error)
+	SearchSecretMessagesFunc                          func(ctx context.Context, req *SearchSecretMessagesRequest) (*FoundMessages, error)
+	SearchSavedMessagesFunc                           func(ctx context.Context, req *SearchSavedMessagesRequest) (*FoundChatMessages, error)
+	SearchCallMessagesFunc                            func(ctx context.Context, req *SearchCallMessagesRequest) (*FoundMessages, error)
+	SearchOutgoingDocumentMessagesFunc                func(ctx context.Context, req *SearchOutgoingDocumentMessagesRequest) (*FoundMessages, error)
+	SearchPublicMessagesByTagFunc                     func(ctx context.Context, req *SearchPublicMessagesByTagRequest) (*FoundMessages, error)
+	SearchPublicStoriesByTagFunc                      func(ctx context.Context, req *SearchPublicStoriesByTagRequest) (*FoundStories, error)
+	SearchPublicStoriesByLocationFunc                 func(ctx context.Context, req *SearchPublicStoriesByLocationRequest) (*FoundStories, error)
+	SearchPublicStoriesByVenueFunc                    func(ctx context.Context, req *SearchPublicStoriesByVenueRequest) (*FoundStories, error)
+	GetSearchedForTagsFunc                            func(ctx context.Context, req *GetSearchedForTagsRequest) (*Hashtags, error)
+	RemoveSearchedForTagFunc                          func(ctx context.Context, req *RemoveSearchedForTagRequest) (*Ok, error)
+	ClearSearchedForTagsFunc                          func(ctx context.Context, req *ClearSearchedForTagsRequest) (*Ok, error)
+	DeleteAllCallMessagesFunc                         func(ctx context.Context, req *DeleteAllCallMessagesRequest) (*Ok, error)
+	SearchChatRecentLocationMessagesFunc              func(ctx context.Context, req *SearchChatRecentLocationMessagesRequest) (*Messages, error)
+	GetChatMessageByDateFunc                          func(ctx context.Context, req *GetChatMessageByDateRequest) (*Message, error)
+	GetChatSparseMessagePositionsFunc                 func(ctx context.Context, req *GetChatSparseMessagePositionsRequest) (*MessagePositions, error)
+	GetChatMessageCalendarFunc                        func(ctx context.Context, req *GetChatMessageCalendarRequest) (*MessageCalendar, error)
+	GetChatMessageCountFunc                           func(ctx context.Context, req *GetChatMessageCountRequest) (*Count, error)
+	GetChatMessagePositionFunc                        func(ctx context.Context, req *GetChatMessagePositionRequest) (*Count, error)
+	GetChatScheduledMessagesFunc                      func(ctx context.Context, req *GetChatScheduledMessagesRequest) (*Messages, error)
+	GetChatSponsoredMessagesFunc                      func(ctx context.Context, req *GetChatSponsoredMessagesRequest) (*SponsoredMessages, error)
+	ClickChatSponsoredMessageFunc                     func(ctx context.Context, req *ClickChatSponsoredMessageRequest) (*Ok, error)
+	ReportChatSponsoredMessageFunc                    func(ctx context.Context, req *ReportChatSponsoredMessageRequest) (ReportSponsoredResult, error)
+	GetSearchSponsoredChatsFunc                       func(ctx context.Context, req *GetSearchSponsoredChatsRequest) (*SponsoredChats, error)
+	ViewSponsoredChatFunc                             func(ctx context.Context, req *ViewSponsoredChatRequest) (*Ok, error)
+	OpenSponsoredChatFunc                             func(ctx context.Context, req *OpenSponsoredChatRequest) (*Ok, error)
+	ReportSponsoredChatFunc                           func(ctx context.Context, req *ReportSponsoredChatRequest) (ReportSponsoredResult, error)
+	RemoveNotificationFunc                            func(ctx context.Context, req *RemoveNotificationRequest) (*Ok, error)
+	RemoveNotificationGroupFunc                       func(ctx context.Context, req *RemoveNotificationGroupRequest) (*Ok, error)
+	GetMessageLinkFunc                                func(ctx context.Context, req *GetMessageLinkRequest) (*MessageLink, error)
+	GetMessageEmbeddingCodeFunc                       func(ctx context.Context, req *GetMessageEmbeddingCodeRequest) (*Text, error)
+	GetMessageLinkInfoFunc                            func(ctx context.Context, req *GetMessageLinkInfoRequest) (*MessageLinkInfo, error)
+	TranslateTextFunc                                 func(ctx context.Context, req *TranslateTextRequest) (*FormattedText, error)
+	TranslateMessageTextFunc                          func(ctx context.Context, req *TranslateMessageTextRequest) (*FormattedText, error)
+	RecognizeSpeechFunc                               func(ctx context.Context, req *RecognizeSpeechRequest) (*Ok, error)
+	RateSpeechRecognitionFunc                         func(ctx context.Context, req *RateSpeechRecognitionRequest) (*Ok, error)
+	GetChatAvailableMessageSendersFunc                func(ctx context.Context, req *GetChatAvailableMessageSendersRequest) (*ChatMessageSenders, error)
+	SetChatMessageSenderFunc                          func(ctx context.Context, req *SetChatMessageSenderRequest) (*Ok, error)
+	SendMessageFunc                                   func(ctx context.Context, req *SendMessageRequest) (*Message, error)
+	SendMessageAlbumFunc                              func(ctx context.Context, req *SendMessageAlbumRequest) (*Messages, error)
+	SendBotStartMessageFunc                           func(ctx context.Context, req *SendBotStartMessageRequest) (*Message, error)
+	SendInlineQueryResultMessageFunc                  func(ctx context.Context, req *SendInlineQueryResultMessageRequest) (*Message, error)
+	ForwardMessagesFunc                               func(ctx context.Context, req *ForwardMessagesRequest) (*Messages, error)
+	SendQuickReplyShortcutMessagesFunc                func(ctx context.Context, req *SendQuickReplyShortcutMessagesRequest) (*Messages, error)
+	ResendMessagesFunc                                func(ctx context.Context, req *ResendMessagesRequest) (*Messages, error)
+	AddLocalMessageFunc                               func(ctx context.Context, req *AddLocalMessageRequest) (*Message, error)
+	DeleteMessagesFunc                                func(ctx context.Context, req *DeleteMessagesRequest) (*Ok, error)
+	DeleteChatMessagesBySenderFunc                    func(ctx context.Context, req *DeleteChatMessagesBySenderRequest) (*Ok, error)
+	DeleteChatMessagesByDateFunc                      func(ctx context.Context, req *DeleteChatMessagesByDateRequest) (*Ok, error)
+	EditMessageTextFunc                               func(ctx context.Context, req *EditMessageTextRequest) (*Message, error)
+	EditMessageLiveLocationFunc                       func(ctx context.Context, req *EditMessageLiveLocationRequest) (*Message, error)
+	EditMessageMediaFunc                              func(ctx context.Context, req *EditMessageMediaRequest) (*Message, error)
+	EditMessageCaptionFunc                            func(ctx context.Context, req *EditMessageCaptionRequest) (*Message, error)
+	EditMessageReplyMarkupFunc                        func(ctx context.Context, req *EditMessageReplyMarkupRequest) (*Message, error)
+	EditInlineMessageTextFunc                         func(ctx context.Context, req *EditInlineMessageTextRequest) (*Ok, error)
+	EditInlineMessageLiveLocationFunc                 func(ctx context.Context, req *EditInlineMessageLiveLocationRequest) (*Ok, error)
+	EditInlineMessageMediaFunc                        func(ctx context.Context, req *EditInlineMessageMediaRequest) (*Ok, error)
+	EditInlineMessageCaptionFunc                      func(ctx context.Context, req *EditInlineMessageCaptionRequest) (*Ok, error)
+	EditInlineMessageReplyMarkupFunc                  func(ctx context.Context, req *EditInlineMessageReplyMarkupRequest) (*Ok, error)
+	EditMessageSchedulingStateFunc                    func(ctx context.Context, req *EditMessageSchedulingStateRequest) (*Ok, error)
+	SetMessageFactCheckFunc                           func(ctx context.Context, req *SetMessageFactCheckRequest) (*Ok, error)
+	SendBusinessMessageFunc                           func(ctx context.Context, req *SendBusinessMessageRequest) (*BusinessMessage, error)
+	SendBusinessMessageAlbumFunc                      func(ctx context.Context, req *SendBusinessMessageAlbumRequest) (*BusinessMessages, error)
+	EditBusinessMessageTextFunc                       func(ctx context.Context, req *EditBusinessMessageTextRequest) (*BusinessMessage, error)
+	EditBusinessMessageLiveLocationFunc               func(ctx context.Context, req *EditBusinessMessageLiveLocationRequest) (*BusinessMessage, error)
+	EditBusinessMessageMediaFunc                      func(ctx context.Context, req *EditBusinessMessageMediaRequest) (*BusinessMessage, error)
+	EditBusinessMessageCaptionFunc                    func(ctx context.Context, req *EditBusinessMessageCaptionRequest) (*BusinessMessage, error)
+	EditBusinessMessageReplyMarkupFunc                func(ctx context.Context, req *EditBusinessMessageReplyMarkupRequest) (*BusinessMessage, error)
+	StopBusinessPollFunc                              func(ctx context.Context, req *StopBusinessPollRequest) (*BusinessMessage, error)
+	SetBusinessMessageIsPinnedFunc                    func(ctx context.Context, req *SetBusinessMessageIsPinnedRequest) (*Ok, error)
+	ReadBusinessMessageFunc                           func(ctx context.Context, req *ReadBusinessMessageRequest) (*Ok, error)
+	DeleteBusinessMessagesFunc                        func(ctx context.Context, req *DeleteBusinessMessagesRequest) (*Ok, error)
+	EditBusinessStoryFunc                             func(ctx context.Context, req *EditBusinessStoryRequest) (*Story, error)
+	DeleteBusinessStoryFunc                           func(ctx context.Context, req *DeleteBusinessStoryRequest) (*Ok, error)
+	SetBusinessAccountNameFunc                        func(ctx context.Context, req *SetBusinessAccountNameRequest) (*Ok, error)
+	SetBusinessAccountBioFunc                         func(ctx context.Context, req *SetBusinessAccountBioRequest) (*Ok, error)
+	SetBusinessAccountProfilePhotoFunc                func(ctx context.Context, req *SetBusinessAccountProfilePhotoRequest) (*Ok, error)
+	SetBusinessAccountUsernameFunc                    func(ctx context.Context, req *SetBusinessAccountUsernameRequest) (*Ok, error)
+	SetBusinessAccountGiftSettingsFunc                func(ctx context.Context, req *SetBusinessAccountGiftSettingsRequest) (*Ok, error)
+	GetBusinessAccountStarAmountFunc                  func(ctx context.Context, req *GetBusinessAccountStarAmountRequest) (*StarAmount, error)
+	TransferBusinessAccountStarsFunc                  func(ctx context.Context, req *TransferBusinessAccountStarsRequest) (*Ok, error)
+	LoadQuickReplyShortcutsFunc                       func(ctx context.Context) (*Ok, error)
+	SetQuickReplyShortcutNameFunc                     func(ctx context.Context, req *SetQuickReplyShortcutNameRequest) (*Ok, error)
+	DeleteQuickReplyShortcutFunc                      func(ctx context.Context, req *DeleteQuickReplyShortcutRequest) (*Ok, error)
+	ReorderQuickReplyShortcutsFunc                    func(ctx context.Context, req *ReorderQuickReplyShortcutsRequest) (*Ok, error)
+	LoadQuickReplyShortcutMessagesFunc                func(ctx context.Context, req *LoadQuickReplyShortcutMessagesRequest) (*Ok, error)
+	DeleteQuickReplyShortcutMessagesFunc              func(ctx context.Context, req *DeleteQuickReplyShortcutMessagesRequest) (*Ok, error)
+	AddQuickReplyShortcutMessageFunc                  func(ctx context.Context, req *AddQuickReplyShortcutMessageRequest) (*QuickReplyMessage, error)
+	AddQuickReplyShortcutInlineQueryResultMessageFunc func(ctx context.Context, req *AddQuickReplyShortcutInlineQueryResultMessageRequest) (*QuickReplyMessage, error)
+	AddQuickReplyShortcutMessageAlbumFunc             func(ctx context.Context, req *AddQuickReplyShortcutMessageAlbumRequest) (*QuickReplyMessages, error)
+	ReaddQuickReplyShortcutMessagesFunc               func(ctx context.Context, req *ReaddQuickReplyShortcutMessagesRequest) (*QuickReplyMessages, error)
+	EditQuickReplyMessageFunc                         func(ctx context.Context, req *EditQuickReplyMessageRequest) (*Ok, error)
+	GetForumTopicDefaultIconsFunc                     func(ctx context.Context) (*Stickers, error)
+	CreateForumTopicFunc                              func(ctx context.Context, req *CreateForumTopicRequest) (*ForumTopicInfo, error)
+	EditForumTopicFunc                                func(ctx context.Context, req *EditForumTopicRequest) (*Ok, error)
+	GetForumTopicFunc                                 func(ctx context.Context, req *GetForumTopicRequest) (*ForumTopic, error)
+	GetForumTopicLinkFunc                             func(ctx context.Context, req *GetForumTopicLinkRequest) (*MessageLink, error)
+	GetForumTopicsFunc                                func(ctx context.Context, req *GetForumTopicsRequest) (*ForumTopics, error)
+	SetForumTopicNotificationSettingsFunc             func(ctx context.Context, req *SetForumTopicNotificationSettingsRequest) (*Ok, error)
+	ToggleForumTopicIsClosedFunc                      func(ctx context.Context, req *ToggleForumTopicIsClosedRequest) (*Ok, error)
+	ToggleGeneralForumTopicIsHiddenFunc               func(ctx context.Context, req *ToggleGeneralForumTopicIsHiddenRequest) (*Ok, error)
+	ToggleForumTopicIsPinnedFunc                      func(ctx context.Context, req *ToggleForumTopicIsPinnedRequest) (*Ok, error)
+	SetPinnedForumTopicsFunc                          func(ctx context.Context, req *SetPinnedForumTopicsRequest) (*Ok, error)
+	DeleteForumTopicFunc                              func(ctx context.Context, req *DeleteForumTopicRequest) (*Ok, error)
+	GetEmojiReactionFunc                              func(ctx context.Context, req *GetEmojiReactionRequest) (*EmojiReaction, error)
+	GetCustomEmojiReactionAnimationsFunc              func(ctx context.Context) (*Stickers, error)
+	GetMessageAvailableReactionsFunc                  func(ctx context.Context, req *GetMessageAvailableReactionsRequest) (*AvailableReactions, error)
+	ClearRecentReactionsFunc                          func(ctx context.Context) (*Ok, error)
+	AddMessageReactionFunc                            func(ctx context.Context, req *AddMessageReactionRequest) (*Ok, error)
+	RemoveMessageReactionFunc                         func(ctx context.Context, req *RemoveMessageReactionRequest) (*Ok, error)
+	GetChatAvailablePaidMessageReactionSendersFunc    func(ctx context.Context, req *GetChatAvailablePaidMessageReactionSendersRequest) (*MessageSenders, error)
+	AddPendingPaidMessageReactionFunc                 func(ctx context.Context, req *AddPendingPaidMessageReactionRequest) (*Ok, error)
+	CommitPendingPaidMessageReactionsFunc             func(ctx context.Context, req *CommitPendingPaidMessageReactionsRequest) (*Ok, error)
+	RemovePendingPaidMessageReactionsFunc             func(ctx context.Context, req *RemovePendingPaidMessageReactionsRequest) (*Ok, error)
+	SetPaidMessageReactionTypeFunc                    func(ctx context.Context, req *SetPaidMessageReactionTypeRequest) (*Ok, error)
+	SetMessageReactionsFunc                           func(ctx context.Context, req *SetMessageReactionsRequest) (*Ok, error)
+	GetMessageAddedReactionsFunc                      func(ctx context.Context, req *GetMessageAddedReactionsRequest) (*AddedReactions, error)
+	SetDefaultReactionTypeFunc                        func(ctx context.Context, req *SetDefaultReactionTypeRequest) (*Ok, error)
+	GetSavedMessagesTagsFunc                          func(ctx context.Context, req *GetSavedMessagesTagsRequest) (*SavedMessagesTags, error)
+	SetSavedMessagesTagLabelFunc                      func(ctx context.Context, req *SetSavedMessagesTagLabelRequest) (*Ok, error)
+	GetMessageEffectFunc                              func(ctx context.Context, req *GetMessageEffectRequest) (*MessageEffect, error)
+	SetPollAnswerFunc                                 func(ctx context.Context, req *SetPollAnswerRequest) (*Ok, error)
+	GetPollVotersFunc                                 func(ctx context.Context, req *GetPollVotersRequest) (*MessageSenders, error)
+	StopPollFunc                                      func(ctx context.Context, req *StopPollRequest) (*Ok, error)
+	HideSuggestedActionFunc                           func(ctx context.Context, req *HideSuggestedActionRequest) (*Ok, error)
+	HideContactCloseBirthdaysFunc                     func(ctx context.Context) (*Ok, error)
+	GetBusinessConnectionFunc                         func(ctx context.Context, req *GetBusinessConnectionRequest) (*BusinessConnection, error)
+	GetLoginUrlInfoFunc                               func(ctx context.Context, req *GetLoginUrlInfoRequest) (LoginUrlInfo, error)
+	GetLoginUrlFunc                                   func(ctx context.Context, req *GetLoginUrlRequest) (*HttpUrl, error)
+	ShareUsersWithBotFunc                             func(ctx context.Context, req *ShareUsersWithBotRequest) (*Ok, error)
+	ShareChatWithBotFunc                              func(ctx context.Context, req *ShareChatWithBotRequest) (*Ok, error)
+	GetInlineQueryResultsFunc                         func(ctx context.Context, req *GetInlineQueryResultsRequest) (*InlineQueryResults, error)
+	AnswerInlineQueryFunc                             func(ctx context.Context, req *AnswerInlineQueryRequest) (*Ok, error)
+	SavePreparedInlineMessageFunc                     func(ctx context.Context, req *SavePreparedInlineMessageRequest) (*PreparedInlineMessageId, error)
+	GetPreparedInlineMessageFunc                      func(ctx context.Context, req *GetPreparedInlineMessageRequest) (*PreparedInlineMessage, error)
+	GetGrossingWebAppBotsFunc                         func(ctx context.Context, req *GetGrossingWebAppBotsRequest) (*FoundUsers, error)
+	SearchWebAppFunc                                  func(ctx context.Context, req *SearchWebAppRequest) (*FoundWebApp, error)
+	GetWebAppPlaceholderFunc                          func(ctx context.Context, req *GetWebAppPlaceholderRequest) (*Outline, error)
+	GetWebAppLinkUrlFunc                              func(ctx context.Context, req *GetWebAppLinkUrlRequest) (*HttpUrl, error)
+	GetMainWebAppFunc                                 func(ctx context.Context, req *GetMainWebAppRequest) (*MainWebApp, error)
+	GetWebAppUrlFunc                                  func(ctx context.Context, req *GetWebAppUrlRequest) (*HttpUrl, error)
+	SendWebAppDataFunc                                func(ctx context.Context, req *SendWebAppDataRequest) (*Ok, error)
+	OpenWebAppFunc                                    func(ctx context.Context, req *OpenWebAppRequest) (*WebAppInfo, error)
+	CloseWebAppFunc                                   func(ctx context.Context, req *CloseWebAppRequest) (*Ok, error)
+	AnswerWebAppQueryFunc                             func(ctx context.Context, req *AnswerWebAppQueryRequest) (*SentWebAppMessage, error)
+	CheckWebAppFileDownloadFunc                       func(ctx context.Context, req *CheckWebAppFileDownloadRequest) (*Ok, error)
+	GetCallbackQueryAnswerFunc                        func(ctx context.Context, req *GetCallbackQueryAnswerRequest) (*CallbackQueryAnswer, error)
+	AnswerCallbackQueryFunc                           func(ctx context.Context, req *AnswerCallbackQueryRequest) (*Ok, error)
+	AnswerShippingQueryFunc                           func(ctx context.Context, req *AnswerShippingQueryRequest) (*Ok, error)
+	AnswerPreCheckoutQueryFunc                        func(ctx context.Context, req *AnswerPreCheckoutQueryRequest) (*Ok, error)
+	SetGameScoreFunc                                  func(ctx context.Context, req *SetGameScoreRequest) (*Message, error)
+	SetInlineGameScoreFunc                            func(ctx context.Context, req *SetInlineGameScoreRequest) (*Ok, error)
+	GetGameHighScoresFunc                             func(ctx context.Context, req *GetGameHighScoresRequest) (*GameHighScores, error)
+	GetInlineGameHighScoresFunc                       func(ctx context.Context, req *GetInlineGameHighScoresRequest) (*GameHighScores, error)
+	DeleteChatReplyMarkupFunc                         func(ctx context.Context, req *DeleteChatReplyMarkupRequest) (*Ok, error)
+	SendChatActionFunc                                func(ctx context.Context, req *SendChatActionRequest) (*Ok, error)
+	OpenChatFunc                                      func(ctx context.Context, req *OpenChatRequest) (*Ok, error)
+	CloseChatFunc                                     func(ctx context.Context, req *CloseChatRequest) (*Ok, error)
+	ViewMessagesFunc                                  func(ctx context.Context, req *ViewMessagesRequest) (*Ok, error)
+	OpenMessageContentFunc                            func(ctx context.Context, req *OpenMessageContentRequest) (*Ok, error)
+	ClickAnimatedEmojiMessageFunc                     func(ctx context.Context, req *ClickAnimatedEmojiMessageRequest) (*Sticker, error)
+	GetInternalLinkFunc                               func(ctx context.Context, req *GetInternalLinkRequest) (*HttpUrl, error)
+	GetInternalLinkTypeFunc                           func(ctx context.Context, req *GetInternalLinkTypeRequest) (InternalLinkType, error)
+	GetExternalLinkInfoFunc                           func(ctx context.Context, req *GetExternalLinkInfoRequest) (LoginUrlInfo, error)
+	GetExternalLinkFunc                               func(ctx context.Context, req *GetExternalLinkRequest) (*HttpUrl, error)
+	ReadAllChatMentionsFunc                           func(ctx context.Context, req *ReadAllChatMentionsRequest) (*Ok, error)
+	ReadAllMessageThreadMentionsFunc                  func(ctx context.Context, req *ReadAllMessageThreadMentionsRequest) (*Ok, error)
+	ReadAllChatReactionsFunc                          func(ctx context.Context, req *ReadAllChatReactionsRequest) (*Ok, error)
+	ReadAllMessageThreadReactionsFunc                 func(ctx context.Context, req *ReadAllMessageThreadReactionsRequest) (*Ok, error)
+	CreatePrivateChatFunc                             func(ctx context.Context, req *CreatePrivateChatRequest) (*Chat, error)
+	CreateBasicGroupChatFunc                          func(ctx context.Context, req *CreateBasicGroupChatRequest) (*Chat, error)
+	CreateSupergroupChatFunc                          func(ctx context.Context, req *CreateSupergroupChatRequest) (*Chat, error)
+	CreateSecretChatFunc                              func(ctx context.Context, req *CreateSecretChatRequest) (*Chat, error)
+	CreateNewBasicGroupChatFunc                       func(ctx context.Context, req *CreateNewBasicGroupChatRequest) (*CreatedBasicGroupChat, error)
+	CreateNewSupergroupChatFunc                       func(ctx context.Context, req *CreateNewSupergroupChatRequest) (*Chat, error)
+	CreateNewSecretChatFunc                           func(ctx context.Context, req *CreateNewSecretChatRequest) (*Chat, error)
+	UpgradeBasicGroupChatToSupergroupChatFunc         func(ctx context.Context, req *UpgradeBasicGroupChatToSupergroupChatRequest) (*Chat, error)
+	GetChatListsToAddChatFunc                         func(ctx context.Context, req *GetChatListsToAddChatRequest) (*ChatLists, error)
+	AddChatToListFunc                                 func(ctx context.Context, req *AddChatToListRequest) (*Ok, error)
+	GetChatFolderFunc                                 func(ctx context.Context, req *GetChatFolderRequest) (*ChatFolder, error)
+	CreateChatFolderFunc                              func(ctx context.Context, req *CreateChatFolderRequest) (*ChatFolderInfo, error)
+	EditChatFolderFunc                                func(ctx context.Context, req *EditChatFolderRequest) (*ChatFolderInfo, error)
+	DeleteChatFolderFunc                              func(ctx context.Context, req *DeleteChatFolderRequest) (*Ok, error)
+	GetChatFolderChatsToLeaveFunc                     func(ctx context.Context, req *GetChatFolderChatsToLeaveRequest) (*Chats, error)
+	GetChatFolderChatCountFunc                        func(ctx context.Context, req *GetChatFolderChatCountRequest) (*Count, error)
+	ReorderChatFoldersFunc                            func(ctx context.Context, req *ReorderChatFoldersRequest) (*Ok, error)
+	ToggleChatFolderTagsFunc                          func(ctx context.Context, req *ToggleChatFolderTagsRequest) (*Ok, error)
+	GetRecommendedChatFoldersFunc                     func(ctx context.Context) (*RecommendedChatFolders, error)
+	GetChatsForChatFolderInviteLinkFunc               func(ctx context.Context, req *GetChatsForChatFolderInviteLinkRequest) (*Chats, error)
+	CreateChatFolderInviteLinkFunc                    func(ctx context.Context, req *CreateChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error)
+	GetChatFolderInviteLinksFunc                      func(ctx context.Context, req *GetChatFolderInviteLinksRequest) (*ChatFolderInviteLinks, error)
+	EditChatFolderInviteLinkFunc                      func(ctx context.Context, req *EditChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error)
+	DeleteChatFolderInviteLinkFunc                    func(ctx context.Context, req *DeleteChatFolderInviteLinkRequest) (*Ok, error)
+	CheckChatFolderInviteLinkFunc                     func(ctx context.Context, req *CheckChatFolderInviteLinkRequest) (*ChatFolderInviteLinkInfo, error)
+	AddChatFolderByInviteLinkFunc                     func(ctx context.Context, req *AddChatFolderByInviteLinkRequest) (*Ok, error)
+	GetChatFolderNewChatsFunc                         func(ctx context.Context, req *GetChatFolderNewChatsRequest) (*Chats, error)
+	ProcessChatFolderNewChatsFunc                     func(ctx context.Context, req *ProcessChatFolderNewChatsRequest) (*Ok, error)
+	GetArchiveChatListSettingsFunc                    func(ctx context.Context) (*ArchiveChatListSettings, error)
+	SetArchiveChatListSettingsFunc                    func(ctx context.Context, req *SetArchiveChatListSettingsRequest) (*Ok, error)
+	SetChatTitleFunc                                  func(ctx context.Context, req *SetChatTitleRequest) (*Ok, error)
+	SetChatPhotoFunc                                  func(ctx context.Context, req *SetChatPhotoRequest) (*Ok, error)
+	SetChatAccentColorFunc                            func(ctx context.Context, req *SetChatAccentColorRequest) (*Ok, error)
+	SetChatProfileAccentColorFunc                     func(ctx context.Context, req *SetChatProfileAccentColorRequest) (*Ok, error)
+	SetChatMessageAutoDeleteTimeFunc                  func(ctx context.Context, req *SetChatMessageAutoDeleteTimeRequest) (*Ok, error)
+	SetChatEmojiStatusFunc                            func(ctx context.Context, req *SetChatEmojiStatusRequest) (*Ok, error)
+	SetChatPermissionsFunc                            func(ctx context.Context, req *SetChatPermissionsRequest) (*Ok, error)
+	SetChatBackgroundFunc                             func(ctx context.Context, req *SetChatBackgroundRequest) (*Ok, error)
+	DeleteChatBackgroundFunc                          func(ctx context.Context, req *DeleteChatBackgroundRequest) (*Ok, error)
+	SetChatThemeFunc                                  func(ctx context.Context, req *SetChatThemeRequest) (*Ok, error)
+	SetChatDraftMessageFunc                           func(ctx context.Context, req *SetChatDraftMessageRequest) (*Ok, error)
+	SetChatNotificationSettingsFunc                   func(ctx context.Context, req *SetChatNotificationSettingsRequest) (*Ok, error)
+	ToggleChatHasProtectedContentFunc                 func(ctx context.Context, req *ToggleChatHasProtectedContentRequest) (*Ok, error)
+	ToggleChatViewAsTopicsFunc                        func(ctx context.Context, req *ToggleChatViewAsTopicsRequest) (*Ok, error)
+	ToggleChatIsTranslatableFunc                      func(ctx context.Context, req *ToggleChatIsTranslatableRequest) (*Ok, error)
+	ToggleChatIsMarkedAsUnreadFunc                    func(ctx context.Context, req *ToggleChatIsMarkedAsUnreadRequest) (*Ok, error)
+	ToggleChatDefaultDisableNotificationFunc          func(ctx context.Context, req *ToggleChatDefaultDisableNotificationRequest) (*Ok, error)
+	SetChatAvailableReactionsFunc                     func(ctx context.Context, req *SetChatAvailableReactionsRequest) (*Ok, error)
+	SetChatClientDataFunc                             func(ctx context.Context, req *SetChatClientDataRequest) (*Ok, error)
+	SetChatDescriptionFunc                            func(ctx context.Context, req *SetChatDescriptionRequest) (*Ok, error)
+	SetChatDiscussionGroupFunc                        func(ctx context.Context, req *SetChatDiscussionGroupRequest) (*Ok, error)
+	SetChatLocationFunc                               func(ctx context.Context, req *SetChatLocationRequest) (*Ok, error)
+	SetChatSlowModeDelayFunc                          func(ctx context.Context, req *SetChatSlowModeDelayRequest) (*Ok, error)
+	PinChatMessageFunc                                func(ctx context.Context, req *PinChatMessageRequest) (*Ok, error)
+	UnpinChatMessageFunc                              func(ctx context.Context, req *UnpinChatMessageRequest) (*Ok, error)
+	UnpinAllChatMessagesFunc                          func(ctx context.Context, req *UnpinAllChatMessagesRequest) (*Ok, error)
+	UnpinAllMessageThreadMessagesFunc                 func(ctx context.Context, req *UnpinAllMessageThreadMessagesRequest) (*Ok, error)
+	JoinChatFunc                                      func(ctx context.Context, req *JoinChatRequest) (*Ok, error)
+	LeaveChatFunc                                     func(ctx context.Context, req *LeaveChatRequest) (*Ok, error)
+	AddChatMemberFunc                                 func(ctx context.Context, req *AddChatMemberRequest) (*FailedToAddMembers, error)
+	AddChatMembersFunc                                func(ctx context.Context, req *AddChatMembersRequest) (*FailedToAddMembers, error)
+	SetChatMemberStatusFunc                           func(ctx context.Context, req *SetChatMemberStatusRequest) (*Ok, error)
+	BanChatMemberFunc                                 func(ctx context.Context, req *BanChatMemberRequest) (*Ok, error)
+	CanTransferOwnershipFunc                          func(ctx context.Context) (CanTransferOwnershipResult, error)
+	TransferChatOwnershipFunc                         func(ctx context.Context, req *TransferChatOwnershipRequest) (*Ok, error)
+	GetChatMemberFunc                                 func(ctx context.Context, req *GetChatMemberRequest) (*ChatMember, error)
+	SearchChatMembersFunc                             func(ctx context.Context, req *SearchChatMembersRequest) (*ChatMembers, error)
+	GetChatAdministratorsFunc                         func(ctx context.Context, req *GetChatAdministratorsRequest) (*ChatAdministrators, error)
+	ClearAllDraftMessagesFunc                         func(ctx context.Context, req *ClearAllDraftMessagesRequest) (*Ok, error)
+	GetSavedNotificationSoundFunc                     func(ctx context.Context, req *GetSavedNotificationSoundRequest) (*NotificationSounds, error)
+	GetSavedNotificationSoundsFunc                    func(ctx context.Context) (*NotificationSounds, error)
+	AddSavedNotificationSoundFunc                     func(ctx context.Context, req *AddSavedNotificationSoundRequest) (*NotificationSound, error)
+	RemoveSavedNotificationSoundFunc                  func(ctx context.Context, req *RemoveSavedNotificationSoundRequest) (*Ok, error)
+	GetChatNotificationSettingsExceptionsFunc         func(ctx context.Context, req *GetChatNotificationSettingsExceptionsRequest) (*Chats, error)
+	GetScopeNotificationSettingsFunc                  func(ctx context.Context, req *GetScopeNotificationSettingsRequest) (*ScopeNotificationSettings, error)
+	SetScopeNotificationSettingsFunc                  func(ctx context.Context, req *SetScopeNotificationSettingsRequest) (*Ok, error)
+	SetReactionNotificationSettingsFunc               func(ctx context.Context, req *SetReactionNotificationSettingsRequest) (*Ok, error)
+	ResetAllNotificationSettingsFunc                  func(ctx context.Context) (*Ok, error)
+	ToggleChatIsPinnedFunc                            func(ctx context.Context, req *ToggleChatIsPinnedRequest) (*Ok, error)
+	SetPinnedChatsFunc                                func(ctx context.Context, req *SetPinnedChatsRequest) (*Ok, error)
+	ReadChatListFunc                                  func(ctx context.Context, req *ReadChatListRequest) (*Ok, error)
+	GetCurrentWeatherFunc                             func(ctx context.Context, req *GetCurrentWeatherRequest) (*CurrentWeather, error)
+	GetStoryFunc                                      func(ctx context.Context, req *GetStoryRequest) (*Story, error)
+	GetChatsToSendStoriesFunc                         func(ctx context.Context) (*Chats, error)
+	CanSendStoryFunc                                  func(ctx context.Context, req *CanSendStoryRequest) (CanSendStoryResult, error)
+	SendStoryFunc                                     func(ctx context.Context, req *SendStoryRequest) (*Story, error)
+	EditStoryFunc                                     func(ctx context.Context, req *EditStoryRequest) (*Ok, error)
+	EditStoryCoverFunc                                func(ctx context.Context, req *EditStoryCoverRequest) (*Ok, error)
+	SetStoryPrivacySettingsFunc                       func(ctx context.Context, req *SetStoryPrivacySettingsRequest) (*Ok, error)
+	ToggleStoryIsPostedToChatPageFunc                 func(ctx context.Context, req *ToggleStoryIsPostedToChatPageRequest) (*Ok, error)
+	DeleteStoryFunc                                   func(ctx context.Context, req *DeleteStoryRequest) (*Ok, error)
+	GetStoryNotificationSettingsExceptionsFunc        func(ctx context.Context) (*Chats, error)
+	LoadActiveStoriesFunc                             func(ctx context.Context, req *LoadActiveStoriesRequest) (*Ok, error)
+	SetChatActiveStoriesListFunc                      func(ctx context.Context, req *SetChatActiveStoriesListRequest) (*Ok, error)
+	GetChatActiveStoriesFunc                          func(ctx context.Context, req *GetChatActiveStoriesRequest) (*ChatActiveStories, error)
+	GetChatPostedToChatPageStoriesFunc                func(ctx context.Context, req *GetChatPostedToChatPageStoriesRequest) (*Stories, error)
+	GetChatArchivedStoriesFunc                        func(ctx context.Context, req *GetChatArchivedStoriesRequest) (*Stories, error)
+	SetChatPinnedStoriesFunc                          func(ctx context.Context, req *SetChatPinnedStoriesRequest) (*Ok, error)
+	OpenStoryFunc                                     func(ctx context.Context, req *OpenStoryRequest) (*Ok, error)
+	CloseStoryFunc                                    func(ctx context.Context, req *CloseStoryRequest) (*Ok, error)
+	GetStoryAvailableReactionsFunc                    func(ctx context.Context, req *GetStoryAvailableReactionsRequest) (*AvailableReactions, error)
+	SetStoryReactionFunc                              func(ctx context.Context, req *SetStoryReactionRequest) (*Ok, error)
+	GetStoryInteractionsFunc                          func(ctx context.Context, req *GetStoryInteractionsRequest) (*StoryInteractions, error)
+	GetChatStoryInteractionsFunc                      func(ctx context.Context, req *GetChatStoryInteractionsRequest) (*StoryInteractions, error)
+	ReportStoryFunc                                   func(ctx context.Context, req *ReportStoryRequest) (ReportStoryResult, error)
+	ActivateStoryStealthModeFunc                      func(ctx context.Context) (*Ok, error)
+	GetStoryPublicForwardsFunc                        func(ctx context.Context, req *GetStoryPublicForwardsRequest) (*PublicForwards, error)
+	GetChatBoostLevelFeaturesFunc                     func(ctx context.Context, req *GetChatBoostLevelFeaturesRequest) (*ChatBoostLevelFeatures, error)
+	GetChatBoostFeaturesFunc                          func(ctx context.Context, req *GetChatBoostFeaturesRequest) (*ChatBoostFeatures, error)
+	GetAvailableChatBoostSlotsFunc                    func(ctx context.Context) (*ChatBoostSlots, error)
+	GetChatBoostStatusFunc                            func(ctx context.Context, req *GetChatBoostStatusRequest) (*ChatBoostStatus, error)
+	BoostChatFunc                                     func(ctx context.Context, req *BoostChatRequest) (*ChatBoostSlots, error)
+	GetChatBoostLinkFunc                              func(ctx context.Context, req *GetChatBoostLinkRequest) (*ChatBoostLink, error)
+	GetChatBoostLinkInfoFunc                          func(ctx context.Context, req *GetChatBoostLinkInfoRequest) (*ChatBoostLinkInfo, error)
+	GetChatBoostsFunc                                 func(ctx context.Context, req *GetChatBoostsRequest) (*FoundChatBoosts, error)
+	GetUserChatBoostsFunc                             func(ctx context.Context, req *GetUserChatBoostsRequest) (*FoundChatBoosts, error)
+	GetAttachmentMenuBotFunc                          func(ctx context.Context, req *GetAttachmentMenuBotRequest) (*AttachmentMenuBot, error)
+	ToggleBotIsAddedToAttachmentMenuFunc              func(ctx context.Context, req *ToggleBotIsAddedToAttachmentMenuRequest) (*Ok, error)
+	GetThemedEmojiStatusesFunc                        func(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	GetRecentEmojiStatusesFunc                        func(ctx context.Context) (*EmojiStatuses, error)
+	GetUpgradedGiftEmojiStatusesFunc                  func(ctx context.Context) (*EmojiStatuses, error)
+	GetDefaultEmojiStatusesFunc                       func(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	ClearRecentEmojiStatusesFunc                      func(ctx context.Context) (*Ok, error)
+	GetThemedChatEmojiStatusesFunc                    func(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	GetDefaultChatEmojiStatusesFunc                   func(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	GetDisallowedChatEmojiStatusesFunc                func(ctx context.Context) (*EmojiStatusCustomEmojis, error)
+	DownloadFileFunc                                  func(ctx context.Context, req *DownloadFileRequest) (*File, error)
+	GetFileDownloadedPrefixSizeFunc                   func(ctx context.Context, req *GetFileDownloadedPrefixSizeRequest) (*FileDownloadedPrefixSize, error)
+	CancelDownloadFileFunc                            func(ctx context.Context, req *CancelDownloadFileRequest) (*Ok, error)
+	GetSuggestedFileNameFunc                          func(ctx context.Context, req *GetSuggestedFileNameRequest) (*Text, error)
+	PreliminaryUploadFileFunc                         func(ctx context.Context, req *PreliminaryUploadFileRequest) (*File, error)
+	CancelPreliminaryUploadFileFunc                   func(ctx context.Context, req *CancelPreliminaryUploadFileRequest) (*Ok, error)
+	WriteGeneratedFilePartFunc                        func(ctx context.Context, req *WriteGeneratedFilePartRequest) (*Ok, error)
+	SetFileGenerationProgressFunc                     func(ctx context.Context, req *SetFileGenerationProgressRequest) (*Ok, error)
+	FinishFileGenerationFunc                          func(ctx context.Context, req *FinishFileGenerationRequest) (*Ok, error)
+	ReadFilePartFunc                                  func(ctx context.Context, req *ReadFilePartRequest) (*FilePart, error)
+	DeleteFileFunc                                    func(ctx context.Context, req *DeleteFileRequest) (*Ok, error)
+	AddFileToDownloadsFunc                            func(ctx context.Context, req *AddFileToDownloadsRequest) (*File, error)
+	ToggleDownloadIsPausedFunc                        func(ctx context.Context, req *ToggleDownloadIsPausedRequest) (*Ok, error)
+	ToggleAllDownloadsArePausedFunc                   func(ctx context.Context, req *ToggleAllDownloadsArePausedRequest) (*Ok, error)
+	RemoveFileFromDownloadsFunc                       func(ctx context.Context, req *RemoveFileFromDownloadsRequest) (*Ok, error)
+	RemoveAllFilesFromDownloadsFunc                   func(ctx context.Context, req *RemoveAllFilesFromDownloadsRequest) (*Ok, error)
+	SearchFileDownloadsFunc                           func(ctx context.Context, req *SearchFileDownloadsRequest) (*FoundFileDownloads, error)
+	SetApplicationVerificationTokenFunc               func(ctx context.Context, req *SetApplicationVerificationTokenRequest) (*Ok, error)
+	GetMessageFileTypeFunc                            func(ctx context.Context, req *GetMessageFileTypeRequest) (MessageFileType, error)
+	GetMessageImportConfirmationTextFunc              func(ctx context.Context, req *GetMessageImportConfirmationTextRequest) (*Text, error)
+	ImportMessagesFunc                                func(ctx context.Context, req *ImportMessagesRequest) (*Ok, error)
+	ReplacePrimaryChatInviteLinkFunc                  func(ctx context.Context, req *ReplacePrimaryChatInviteLinkRequest) (*ChatInviteLink, error)
+	CreateChatInviteLinkFunc                          func(ctx context.Context, req *CreateChatInviteLinkRequest) (*ChatInviteLink, error)
+	CreateChatSubscriptionInviteLinkFunc              func(ctx context.Context, req *CreateChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error)
+	EditChatInviteLinkFunc                            func(ctx context.Context, req *EditChatInviteLinkRequest) (*ChatInviteLink, error)
+	EditChatSubscriptionInviteLinkFunc                func(ctx context.Context, req *EditChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error)
+	GetChatInviteLinkFunc                             func(ctx context.Context, req *GetChatInviteLinkRequest) (*ChatInviteLink, error)
+	GetChatInviteLinkCountsFunc                       func(ctx context.Context, req *GetChatInviteLinkCountsRequest) (*ChatInviteLinkCounts, error)
+	GetChatInviteLinksFunc                            func(ctx context.Context, req *GetChatInviteLinksRequest) (*ChatInviteLinks, error)
+	GetChatInviteLinkMembersFunc                      func(ctx context.Context, req *GetChatInviteLinkMembersRequest) (*ChatInviteLinkMembers, error)
+	RevokeChatInviteLinkFunc                          func(ctx context.Context, req *RevokeChatInviteLinkRequest) (*ChatInviteLinks, error)
+	DeleteRevokedChatInviteLinkFunc                   func(ctx context.Context, req *DeleteRevokedChatInviteLinkRequest) (*Ok, error)
+	DeleteAllRevokedChatInviteLinksFunc               func(ctx context.Context, req *DeleteAllRevokedChatInviteLinksRequest) (*Ok, error)
+	CheckChatInviteLinkFunc                           func(ctx context.Context, req *CheckChatInviteLinkRequest) (*ChatInviteLinkInfo, error)
+	JoinChatByInviteLinkFunc                          func(ctx context.Context, req *JoinChatByInviteLinkRequest) (*Chat, error)
+	GetChatJoinRequestsFunc                           func(ctx context.Context, req *GetChatJoinRequestsRequest) (*ChatJoinRequests, error)
+	ProcessChatJoinRequestFunc                        func(ctx context.Context, req *ProcessChatJoinRequestRequest) (*Ok, error)
+	ProcessChatJoinRequestsFunc                       func(ctx context.Context, req *ProcessChatJoinRequestsRequest) (*Ok, error)
+	CreateCallFunc                                    func(ctx context.Context, req *CreateCallRequest) (*CallId, error)
+	AcceptCallFunc                                    func(ctx context.Context, req *AcceptCallRequest) (*Ok, error)
+	SendCallSignalingDataFunc                         func(ctx context.Context, req *SendCallSignalingDataRequest) (*Ok, error)
+	DiscardCallFunc                                   func(ctx context.Context, req *DiscardCallRequest) (*Ok, error)
+	SendCallRatingFunc                                func(ctx context.Context, req *SendCallRatingRequest) (*Ok, error)
+	SendCallDebugInformationFunc                      func(ctx context.Context, req *SendCallDebugInformationRequest) (*Ok, error)
+	SendCallLogFunc                                   func(ctx context.Context, req *SendCallLogRequest) (*Ok, error)
+	GetVideoChatAvailableParticipantsFunc             func(ctx context.Context, req *GetVideoChatAvailableParticipantsRequest) (*MessageSenders, error)
+	SetVideoChatDefaultParticipantFunc                func(ctx context.Context, req *SetVideoChatDefaultParticipantRequest) (*Ok, error)
+	CreateVideoChatFunc                               func(ctx context.Context, req *CreateVideoChatRequest) (*GroupCallId, error)
+	CreateGroupCallFunc                               func(ctx context.Context, req *CreateGroupCallRequest) (*Ok, error)
+	GetVideoChatRtmpUrlFunc                           func(ctx context.Context, req *GetVideoChatRtmpUrlRequest) (*RtmpUrl, error)
+	ReplaceVideoChatRtmpUrlFunc                       func(ctx context.Context, req *ReplaceVideoChatRtmpUrlRequest) (*RtmpUrl, error)
+	GetGroupCallFunc                                  func(ctx context.Context, req *GetGroupCallRequest) (*GroupCall, error)
+	StartScheduledGroupCallFunc                       func(ctx context.Context, req *StartScheduledGroupCallRequest) (*Ok, error)
+	ToggleGroupCallEnabledStartNotificationFunc       func(ctx context.Context, req *ToggleGroupCallEnabledStartNotificationRequest) (*Ok, error)
+	JoinGroupCallFunc                                 func(ctx context.Context, req *JoinGroupCallRequest) (*Text, error)
+	StartGroupCallScreenSharingFunc                   func(ctx context.Context, req *StartGroupCallScreenSharingRequest) (*Text, error)
+	ToggleGroupCallScreenSharingIsPausedFunc          func(ctx context.Context, req *ToggleGroupCallScreenSharingIsPausedRequest) (*Ok, error)
+	EndGroupCallScreenSharingFunc                     func(ctx context.Context, req *EndGroupCallScreenSharingRequest) (*Ok, error)
+	SetGroupCallTitleFunc                             func(ctx context.Context, req *SetGroupCallTitleRequest) (*Ok, error)
+	ToggleGroupCallMuteNewParticipantsFunc            func(ctx context.Context, req *ToggleGroupCallMuteNewParticipantsRequest) (*Ok, error)
+	InviteGroupCallParticipantsFunc                   func(ctx context.Context, req *InviteGroupCallParticipantsRequest) (*Ok, error)
+	GetGroupCallInviteLinkFunc                        func(ctx context.Context, req *GetGroupCallInviteLinkRequest) (*HttpUrl, error)
+	RevokeGroupCallInviteLinkFunc                     func(ctx context.Context, req *RevokeGroupCallInviteLinkRequest) (*Ok, error)
+	StartGroupCallRecordingFunc                       func(ctx context.Context, req *StartGroupCallRecordingRequest) (*Ok, error)
+	EndGroupCallRecordingFunc                         func(ctx context.Context, req *EndGroupCallRecordingRequest) (*Ok, error)
+	ToggleGroupCallIsMyVideoPausedFunc                func(ctx context.Context, req *ToggleGroupCallIsMyVideoPausedRequest) (*Ok, error)
+	ToggleGroupCallIsMyVideoEnabledFunc               func(ctx context.Context, req *ToggleGroupCallIsMyVideoEnabledRequest) (*Ok, error)
+	SetGroupCallParticipantIsSpeakingFunc             func(ctx context.Context, req *SetGroupCallParticipantIsSpeakingRequest) (*Ok, error)
+	ToggleGroupCallParticipantIsMutedFunc             func(ctx context.Context, req *ToggleGroupCallParticipantIsMutedRequest) (*Ok, error)
+	SetGroupCallParticipantVolumeLevelFunc            func(ctx context.Context, req *SetGroupCallParticipantVolumeLevelRequest) (*Ok, error)
+	ToggleGroupCallParticipantIsHandRaisedFunc        func(ctx context.Context, req *ToggleGroupCallParticipantIsHandRaisedRequest) (*Ok, error)
+	LoadGroupCallParticipantsFunc                     func(ctx context.Context, req *LoadGroupCallParticipantsRequest) (*Ok, error)
+	LeaveGroupCallFunc                                func(ctx context.Context, req *LeaveGroupCallRequest) (*Ok, error)
+	EndGroupCallFunc                                  func(ctx context.Context, req *EndGroupCallRequest) (*Ok, error)
+	GetGroupCallStreamsFunc                           func(ctx context.Context, req *GetGroupCallStreamsRequest) (*GroupCallStreams, error)
+	GetGroupCallStreamSegmentFunc                     func(ctx context.Context, req *GetGroupCallStreamSegmentRequest) (*FilePart, error)
+	SetMessageSenderBlockListFunc                     func(ctx context.Context, req *SetMessageSenderBlockListRequest) (*Ok, error)
+	BlockMessageSenderFromRepliesFunc                 func(ctx context.Context, req *BlockMessageSenderFromRepliesRequest) (*Ok, error)
+	GetBlockedMessageSendersFunc                      func(ctx context.Context, req *GetBlockedMessageSendersRequest) (*MessageSenders, error)
+	AddContactFunc                                    func(ctx context.Context, req *AddContactRequest) (*Ok, error)
+	ImportContactsFunc                                func(ctx context.Context, req *ImportContactsRequest) (*ImportedContacts, error)
+	GetContactsFunc                                   func(ctx context.Context) (*Users, error)
+	SearchContactsFunc                                func(ctx context.Context, req *SearchContactsRequest) (*Users, error)
+	RemoveContactsFunc                                func(ctx context.Context, req *RemoveContactsRequest) (*Ok, error)
+	GetImportedContactCountFunc                       func(ctx context.Context) (*Count, error)
+	ChangeImportedContactsFunc                        func(ctx context.Context, req *ChangeImportedContactsRequest) (*ImportedContacts, error)
+	ClearImportedContactsFunc                         func(ctx context.Context) (*Ok, error)
+	SetCloseFriendsFunc                               func(ctx context.Context, req *SetCloseFriendsRequest) (*Ok, error)
+	GetCloseFriendsFunc                               func(ctx context.Context) (*Users, error)
+	SetUserPersonalProfilePhotoFunc                   func(ctx context.Context, req *SetUserPersonalProfilePhotoRequest) (*Ok, error)
+	SuggestUserProfilePhotoFunc                       func(ctx context.Context, req *SuggestUserProfilePhotoRequest) (*Ok, error)
+	ToggleBotCanManageEmojiStatusFunc                 func(ctx context.Context, req *ToggleBotCanManageEmojiStatusRequest) (*Ok, error)
+	SetUserEmojiStatusFunc                            func(ctx context.Context, req *SetUserEmojiStatusRequest) (*Ok, error)
+	SearchUserByPhoneNumberFunc                       func(ctx context.Context, req *SearchUserByPhoneNumberRequest) (*User, error)
+	SharePhoneNumberFunc                              func(ctx context.Context, req *SharePhoneNumberRequest) (*Ok, error)
+	GetUserProfilePhotosFunc                          func(ctx context.Context, req *GetUserProfilePhotosRequest) (*ChatPhotos, error)
+	GetStickerOutlineFunc                             func(ctx context.Context, req *GetStickerOutlineRequest) (*Outline, error)
+	GetStickersFunc                                   func(ctx context.Context, req *GetStickersRequest) (*Stickers, error)
+	GetAllStickerEmojisFunc                           func(ctx context.Context, req *GetAllStickerEmojisRequest) (*Emojis, error)
+	SearchStickersFunc                                func(ctx context.Context, req *SearchStickersRequest) (*Stickers, error)
+	GetGreetingStickersFunc                           func(ctx context.Context) (*Stickers, error)
+	GetPremiumStickersFunc                            func(ctx context.Context, req *GetPremiumStickersRequest) (*Stickers, error)
+	GetInstalledStickerSetsFunc                       func(ctx context.Context, req *GetInstalledStickerSetsRequest) (*StickerSets, error)
+	GetArchivedStickerSetsFunc                        func(ctx context.Context, req *GetArchivedStickerSetsRequest) (*StickerSets, error)
+	GetTrendingStickerSetsFunc                        func(ctx context.Context, req *GetTrendingStickerSetsRequest) (*TrendingStickerSets, error)
+	GetAttachedStickerSetsFunc                        func(ctx context.Context, req *GetAttachedStickerSetsRequest) (*StickerSets, error)
+	GetStickerSetFunc                                 func(ctx context.Context, req *GetStickerSetRequest) (*StickerSet, error)
+	GetStickerSetNameFunc                             func(ctx context.Context, req *GetStickerSetNameRequest) (*Text, error)
+	SearchStickerSetFunc                              func(ctx context.Context, req *SearchStickerSetRequest) (*StickerSet, error)
+	SearchInstalledStickerSetsFunc                    func(ctx context.Context, req *SearchInstalledStickerSetsRequest) (*StickerSets, error)
+	SearchStickerSetsFunc                             func(ctx context.Context, req *SearchStickerSetsRequest) (*StickerSets, error)
+	ChangeStickerSetFunc                              func(ctx context.Context, req *ChangeStickerSetRequest) (*Ok, error)
+	ViewTrendingStickerSetsFunc                       func(ctx context.Context, req *ViewTrendingStickerSetsRequest) (*Ok, error)
+	ReorderInstalledStickerSetsFunc                   func(ctx context.Context, req *ReorderInstalledStickerSetsRequest) (*Ok, error)
+	GetRecentStickersFunc                             func(ctx context.Context, req *GetRecentStickersRequest) (*Stickers, error)
+	AddRecentStickerFunc                              func(ctx context.Context, req *AddRecentStickerRequest) (*Stickers, error)
+	RemoveRecentStickerFunc                           func(ctx context.Context, req *RemoveRecentStickerRequest) (*Ok, error)
+	ClearRecentStickersFunc                           func(ctx context.Context, req *ClearRecentStickersRequest) (*Ok, error)
+	GetFavoriteStickersFunc                           func(ctx context.Context) (*Stickers, error)
+	AddFavoriteStickerFunc                            func(ctx context.Context, req *AddFavoriteStickerRequest) (*Ok, error)
+	RemoveFavoriteStickerFunc                         func(ctx context.Context, req *RemoveFavoriteStickerRequest) (*Ok, error)
+	GetStickerEmojisFunc                              func(ctx context.Context, req *GetStickerEmojisRequest) (*Emojis, error)
+	SearchEmojisFunc                                  func(ctx context.Context, req *SearchEmojisRequest) (*EmojiKeywords, error)
+	GetKeywordEmojisFunc                              func(ctx context.Context, req *GetKeywordEmojisRequest) (*Emojis, error)
+	GetEmojiCategoriesFunc                            func(ctx context.Context, req *GetEmojiCategoriesRequest) (*EmojiCategories, error)
+	GetAnimatedEmojiFunc                              func(ctx context.Context, req *GetAnimatedEmojiRequest) (*AnimatedEmoji, error)
+	GetEmojiSuggestionsUrlFunc                        func(ctx context.Context, req *GetEmojiSuggestionsUrlRequest) (*HttpUrl, error)
+	GetCustomEmojiStickersFunc                        func(ctx context.Context, req *GetCustomEmojiStickersRequest) (*Stickers, error)
+	GetDefaultChatPhotoCustomEmojiStickersFunc        func(ctx context.Context) (*Stickers, error)
+	GetDefaultProfilePhotoCustomEmojiStickersFunc     func(ctx context.Context) (*Stickers, error)
+	GetDefaultBackgroundCustomEmojiStickersFunc       func(ctx context.Context) (*Stickers, error)
+	GetSavedAnimationsFunc                            func(ctx context.Context) (*Animations, error)
+	AddSavedAnimationFunc                             func(ctx context.Context, req *AddSavedAnimationRequest) (*Ok, error)
+	RemoveSavedAnimationFunc                          func(ctx context.Context, req *RemoveSavedAnimationRequest) (*Ok, error)
+	GetRecentInlineBotsFunc                           func(ctx context.Context) (*Users, error)
+	GetOwnedBotsFunc                                  func(ctx context.Context) (*Users, error)
+	SearchHashtagsFunc                                func(ctx context.Context, req *SearchHashtagsRequest) (*Hashtags, error)
+	RemoveRecentHashtagFunc                           func(ctx context.Context, req *RemoveRecentHashtagRequest) (*Ok, error)
+	GetLinkPreviewFunc                                func(ctx context.Context, req *GetLinkPreviewRequest) (*LinkPreview, error)
+	GetWebPageInstantViewFunc                         func(ctx context.Context, req *GetWebPageInstantViewRequest) (*WebPageInstantView, error)
+	SetProfilePhotoFunc                               func(ctx context.Context, req *SetProfilePhotoRequest) (*Ok, error)
+	DeleteProfilePhotoFunc                            func(ctx context.Context, req *DeleteProfilePhotoRequest) (*Ok, error)
+	SetAccentColorFunc                                func(ctx context.Context, req *SetAccentColorRequest) (*Ok, error)
+	SetProfileAccentColorFunc                         func(ctx context.Context, req *SetProfileAccentColorRequest) (*Ok, error)
+	SetNameFunc                                       func(ctx context.Context, req *SetNameRequest) (*Ok, error)
+	SetBioFunc                                        func(ctx context.Context, req *SetBioRequest) (*Ok, error)
+	SetUsernameFunc                                   func(ctx context.Context, req *SetUsernameRequest) (*Ok, error)
+	ToggleUsernameIsActiveFunc                        func(ctx context.Context, req *ToggleUsernameIsActiveRequest) (*Ok, error)
+	ReorderActiveUsernamesFunc                        func(ctx context.Context, req *ReorderActiveUsernamesRequest) (*Ok, error)
+	SetBirthdateFunc                                  func(ctx context.Context, req *SetBirthdateRequest) (*Ok, error)
+	SetPersonalChatFunc                               func(ctx context.Context, req *SetPersonalChatRequest) (*Ok, error)
+	SetEmojiStatusFunc                                func(ctx context.Context, req *SetEmojiStatusRequest) (*Ok, error)
+	ToggleHasSponsoredMessagesEnabledFunc             func(ctx context.Context, req *ToggleHasSponsoredMessagesEnabledRequest) (*Ok, error)
+	SetBusinessLocationFunc                           func(ctx context.Context, req *SetBusinessLocationRequest) (*Ok, error)
+	SetBusinessOpeningHoursFunc                       func(ctx context.Context, req *SetBusinessOpeningHoursRequest) (*Ok, error)
+	SetBusinessGreetingMessageSettingsFunc            func(ctx context.Context, req *SetBusinessGreetingMessageSettingsRequest) (*Ok, error)
+	SetBusinessAwayMessageSettingsFunc                func(ctx context.Context, req *SetBusinessAwayMessageSettingsRequest) (*Ok, error)
+	SetBusinessStartPageFunc                          func(ctx context.Context, req *SetBusinessStartPageRequest) (*Ok, error)
+	SendPhoneNumberCodeFunc                           func(ctx context.Context, req *SendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error)
+	SendPhoneNumberFirebaseSmsFunc                    func(ctx context.Context, req *SendPhoneNumberFirebaseSmsRequest) (*Ok, error)
+	ReportPhoneNumberCodeMissingFunc                  func(ctx context.Context, req *ReportPhoneNumberCodeMissingRequest) (*Ok, error)
+	ResendPhoneNumberCodeFunc                         func(ctx context.Context, req *ResendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error)
+	CheckPhoneNumberCodeFunc                          func(ctx context.Context, req *CheckPhoneNumberCodeRequest) (*Ok, error)
+	GetBusinessConnectedBotFunc                       func(ctx context.Context) (*BusinessConnectedBot, error)
+	SetBusinessConnectedBotFunc                       func(ctx context.Context, req *SetBusinessConnectedBotRequest) (*Ok, error)
+	DeleteBusinessConnectedBotFunc                    func(ctx context.Context, req *DeleteBusinessConnectedBotRequest) (*Ok, error)
+	ToggleBusinessConnectedBotChatIsPausedFunc        func(ctx context.Context, req *ToggleBusinessConnectedBotChatIsPausedRequest) (*Ok, error)
+	RemoveBusinessConnectedBotFromChatFunc            func(ctx context.Context, req *RemoveBusinessConnectedBotFromChatRequest) (*Ok, error)
+	GetBusinessChatLinksFunc                          func(ctx context.Context) (*BusinessChatLinks, error)
+	CreateBusinessChatLinkFunc                        func(ctx context.Context, req *CreateBusinessChatLinkRequest) (*BusinessChatLink, error)
+	EditBusinessChatLinkFunc                          func(ctx context.Context, req *EditBusinessChatLinkRequest) (*BusinessChatLink, error)
+	DeleteBusinessChatLinkFunc                        func(ctx context.Context, req *DeleteBusinessChatLinkRequest) (*Ok, error)
+	GetBusinessChatLinkInfoFunc                       func(ctx context.Context, req *GetBusinessChatLinkInfoRequest) (*BusinessChatLinkInfo, error)
+	GetUserLinkFunc                                   func(ctx context.Context) (*UserLink, error)
+	SearchUserByTokenFunc                             func(ctx context.Context, req *SearchUserByTokenRequest) (*User, error)
+	SetCommandsFunc                                   func(ctx context.Context, req *SetCommandsRequest) (*Ok, error)
+	DeleteCommandsFunc                                func(ctx context.Context, req *DeleteCommandsRequest) (*Ok, error)
+	GetCommandsFunc                                   func(ctx context.Context, req *GetCommandsRequest) (*BotCommands, error)
+	SetMenuButtonFunc                                 func(ctx context.Context, req *SetMenuButtonRequest) (*Ok, error)
+	GetMenuButtonFunc                                 func(ctx context.Context, req *GetMenuButtonRequest) (*BotMenuButton, error)
+	SetDefaultGroupAdministratorRightsFunc            func(ctx context.Context, req *SetDefaultGroupAdministratorRightsRequest) (*Ok, error)
+	SetDefaultChannelAdministratorRightsFunc          func(ctx context.Context, req *SetDefaultChannelAdministratorRightsRequest) (*Ok, error)
+	CanBotSendMessagesFunc                            func(ctx context.Context, req *CanBotSendMessagesRequest) (*Ok, error)
+	AllowBotToSendMessagesFunc                        func(ctx context.Context, req *AllowBotToSendMessagesRequest) (*Ok, error)
+	SendWebAppCustomRequestFunc                       func(ctx context.Context, req *SendWebAppCustomRequestRequest) (*CustomRequestResult, error)
+	GetBotMediaPreviewsFunc                           func(ctx context.Context, req *GetBotMediaPreviewsRequest) (*BotMediaPreviews, error)
+	GetBotMediaPreviewInfoFunc                        func(ctx context.Context, req *GetBotMediaPreviewInfoRequest) (*BotMediaPreviewInfo, error)
+	AddBotMediaPreviewFunc                            func(ctx context.Context, req *AddBotMediaPreviewRequest) (*BotMediaPreview, error)
+	EditBotMediaPreviewFunc                           func(ctx context.Context, req *EditBotMediaPreviewRequest) (*BotMediaPreview, error)
+	ReorderBotMediaPreviewsFunc                       func(ctx context.Context, req *ReorderBotMediaPreviewsRequest) (*Ok, error)
+	DeleteBotMediaPreviewsFunc                        func(ctx context.Context, req *DeleteBotMediaPreviewsRequest) (*Ok, error)
+	SetBotNameFunc                                    func(ctx context.Context, req *SetBotNameRequest) (*Ok, error)
+	GetBotNameFunc                                    func(ctx context.Context, req *GetBotNameRequest) (*Text, error)
+	SetBotProfilePhotoFunc                            func(ctx context.Context, req *SetBotProfilePhotoRequest) (*Ok, error)
+	ToggleBotUsernameIsActiveFunc                     func(ctx context.Context, req *ToggleBotUsernameIsActiveRequest) (*Ok, error)
+	ReorderBotActiveUsernamesFunc                     func(ctx context.Context, req *ReorderBotActiveUsernamesRequest) (*Ok, error)
+	SetBotInfoDescriptionFunc                         func(ctx context.Context, req *SetBotInfoDescriptionRequest) (*Ok, error)
+	GetBotInfoDescriptionFunc                         func(ctx context.Context, req *GetBotInfoDescriptionRequest) (*Text, error)
+	SetBotInfoShortDescriptionFunc                    func(ctx context.Context, req *SetBotInfoShortDescriptionRequest) (*Ok, error)
+	GetBotInfoShortDescriptionFunc                    func(ctx context.Context, req *GetBotInfoShortDescriptionRequest) (*Text, error)
+	SetMessageSenderBotVerificationFunc               func(ctx context.Context, req *SetMessageSenderBotVerificationRequest) (*Ok, error)
+	RemoveMessageSenderBotVerificationFunc            func(ctx context.Context, req *RemoveMessageSenderBotVerificationRequest) (*Ok, error)
+	GetActiveSessionsFunc                             func(ctx context.Context) (*Sessions, error)
+	TerminateSessionFunc                              func(ctx context.Context, req *TerminateSessionRequest) (*Ok, error)
+	TerminateAllOtherSessionsFunc                     func(ctx context.Context) (*Ok, error)
+	ConfirmSessionFunc                                func(ctx context.Context, req *ConfirmSessionRequest) (*Ok, error)
+	ToggleSessionCanAcceptCallsFunc                   func(ctx context.Context, req *ToggleSessionCanAcceptCallsRequest) (*Ok, error)
+	ToggleSessionCanAcceptSecretChatsFunc             func(ctx context.Context, req *ToggleSessionCanAcceptSecretChatsRequest) (*Ok, error)
+	SetInactiveSessionTtlFunc                         func(ctx context.Context, req *SetInactiveSessionTtlRequest) (*Ok, error)
+	GetConnectedWebsitesFunc                          func(ctx context.Context) (*ConnectedWebsites, error)
+	DisconnectWebsiteFunc                             func(ctx context.Context, req *DisconnectWebsiteRequest) (*Ok, error)
+	DisconnectAllWebsitesFunc                         func(ctx context.Context) (*Ok, error)
+	SetSupergroupUsernameFunc                         func(ctx context.Context, req *SetSupergroupUsernameRequest) (*Ok, error)
+	ToggleSupergroupUsernameIsActiveFunc              func(ctx context.Context, req *ToggleSupergroupUsernameIsActiveRequest) (*Ok, error)
+	DisableAllSupergroupUsernamesFunc                 func(ctx context.Context, req *DisableAllSupergroupUsernamesRequest) (*Ok, error)
+	ReorderSupergroupActiveUsernamesFunc              func(ctx context.Context, req *ReorderSupergroupActiveUsernamesRequest) (*Ok, error)
+	SetSupergroupStickerSetFunc                       func(ctx context.Context, req *SetSupergroupStickerSetRequest) (*Ok, error)
+	SetSupergroupCustomEmojiStickerSetFunc            func(ctx context.Context, req *SetSupergroupCustomEmojiStickerSetRequest) (*Ok, error)
+	SetSupergroupUnrestrictBoostCountFunc             func(ctx context.Context, req *SetSupergroupUnrestrictBoostCountRequest) (*Ok, error)
+	ToggleSupergroupSignMessagesFunc                  func(ctx context.Context, req *ToggleSupergroupSignMessagesRequest) (*Ok, error)
+	ToggleSupergroupJoinToSendMessagesFunc            func(ctx context.Context, req *ToggleSupergroupJoinToSendMessagesRequest) (*Ok, error)
+	ToggleSupergroupJoinByRequestFunc                 func(ctx context.Context, req *ToggleSupergroupJoinByRequestRequest) (*Ok, error)
+	ToggleSupergroupIsAllHistoryAvailableFunc         func(ctx context.Context, req *ToggleSupergroupIsAllHistoryAvailableRequest) (*Ok, error)
+	ToggleSupergroupCanHaveSponsoredMessagesFunc      func(ctx context.Context, req *ToggleSupergroupCanHaveSponsoredMessagesRequest) (*Ok, error)
+	ToggleSupergroupHasHiddenMembersFunc              func(ctx context.Context, req *ToggleSupergroupHasHiddenMembersRequest) (*Ok, error)
+	ToggleSupergroupHasAggressiveAntiSpamEnabledFunc  func(ctx context.Context, req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) (*Ok, error)
+	ToggleSupergroupIsForumFunc                       func(ctx context.Context, req *ToggleSupergroupIsForumRequest) (*Ok, error)
+	ToggleSupergroupIsBroadcastGroupFunc              func(ctx context.Context, req *ToggleSupergroupIsBroadcastGroupRequest) (*Ok, error)
+	ReportSupergroupSpamFunc                          func(ctx context.Context, req *ReportSupergroupSpamRequest) (*Ok, error)
+	ReportSupergroupAntiSpamFalsePositiveFunc         func(ctx context.Context, req *ReportSupergroupAntiSpamFalsePositiveRequest) (*Ok, error)
+	GetSupergroupMembersFunc                          func(ctx context.Context, req *GetSupergroupMembersRequest) (*ChatMembers, error)
+	CloseSecretChatFunc                               func(ctx context.Context, req *CloseSecretChatRequest) (*Ok, error)
+	GetChatEventLogFunc                               func(ctx context.Context, req *GetChatEventLogRequest) (*ChatEvents, error)
+	GetTimeZonesFunc                                  func(ctx context.Context) (*TimeZones, error)
+	GetPaymentFormFunc                                func(ctx context.Context, req *GetPaymentFormRequest) (*PaymentForm, error)
+	ValidateOrderInfoFunc                             func(ctx context.Context, req *ValidateOrderInfoRequest) (*ValidatedOrderInfo, error)
+	SendPaymentFormFunc                               func(ctx context.Context, req *SendPaymentFormRequest) (*PaymentResult, error)
+	GetPaymentReceiptFunc                             func(ctx context.Context, req *GetPaymentReceiptRequest) (*PaymentReceipt, error)
+	GetSavedOrderInfoFunc                             func(ctx context.Context) (*OrderInfo, error)
+	DeleteSavedOrderInfoFunc                          func(ctx context.Context) (*Ok, error)
+	DeleteSavedCredentialsFunc                        func(ctx context.Context) (*Ok, error)
+	SetGiftSettingsFunc                               func(ctx context.Context, req *SetGiftSettingsRequest) (*Ok, error)
+	GetAvailableGiftsFunc                             func(ctx context.Context) (*Gifts, error)
+	SendGiftFunc                                      func(ctx context.Context, req *SendGiftRequest) (*Ok, error)
+	SellGiftFunc                                      func(ctx context.Context, req *SellGiftRequest) (*Ok, error)
+	ToggleGiftIsSavedFunc                             func(ctx context.Context, req *ToggleGiftIsSavedRequest) (*Ok, error)
+	SetPinnedGiftsFunc                                func(ctx context.Context, req *SetPinnedGiftsRequest) (*Ok, error)
+	ToggleChatGiftNotificationsFunc                   func(ctx context.Context, req *ToggleChatGiftNotificationsRequest) (*Ok, error)
+	GetGiftUpgradePreviewFunc                         func(ctx context.Context, req *GetGiftUpgradePreviewRequest) (*GiftUpgradePreview, error)
+	UpgradeGiftFunc                                   func(ctx context.Context, req *UpgradeGiftRequest) (*UpgradeGiftResult, error)
+	TransferGiftFunc                                  func(ctx context.Context, req *TransferGiftRequest) (*Ok, error)
+	GetReceivedGiftsFunc                              func(ctx context.Context, req *GetReceivedGiftsRequest) (*ReceivedGifts, error)
+	GetReceivedGiftFunc                               func(ctx context.Context, req *GetReceivedGiftRequest) (*ReceivedGift, error)
+	GetUpgradedGiftFunc                               func(ctx context.Context, req *GetUpgradedGiftRequest) (*UpgradedGift, error)
+	GetUpgradedGiftWithdrawalUrlFunc                  func(ctx context.Context, req *GetUpgradedGiftWithdrawalUrlRequest) (*HttpUrl, error)
+	CreateInvoiceLinkFunc                             func(ctx context.Context, req *CreateInvoiceLinkRequest) (*HttpUrl, error)
+	RefundStarPaymentFunc                             func(ctx context.Context, req *RefundStarPaymentRequest) (*Ok, error)
+	GetSupportUserFunc                                func(ctx context.Context) (*User, error)
+	GetBackgroundUrlFunc                              func(ctx context.Context, req *GetBackgroundUrlRequest) (*HttpUrl, error)
+	SearchBackgroundFunc                              func(ctx context.Context, req *SearchBackgroundRequest) (*Background, error)
+	SetDefaultBackgroundFunc                          func(ctx context.Context, req *SetDefaultBackgroundRequest) (*Background, error)
+	DeleteDefaultBackgroundFunc                       func(ctx context.Context, req *DeleteDefaultBackgroundRequest) (*Ok, error)
+	GetInstalledBackgroundsFunc                       func(ctx context.Context, req *GetInstalledBackgroundsRequest) (*Backgrounds, error)
+	RemoveInstalledBackgroundFunc                     func(ctx context.Context, req *RemoveInstalledBackgroundRequest) (*Ok, error)
+	ResetInstalledBackgroundsFunc                     func(ctx context.Context) (*Ok, error)
+	GetLocalizationTargetInfoFunc                     func(ctx context.Context, req *GetLocalizationTargetInfoRequest) (*LocalizationTargetInfo, error)
+	GetLanguagePackInfoFunc                           func(ctx context.Context, req *GetLanguagePackInfoRequest) (*LanguagePackInfo, error)
+	GetLanguagePackStringsFunc                        func(ctx context.Context, req *GetLanguagePackStringsRequest) (*LanguagePackStrings, error)
+	SynchronizeLanguagePackFunc                       func(ctx context.Context, req *SynchronizeLanguagePackRequest) (*Ok, error)
+	AddCustomServerLanguagePackFunc                   func(ctx context.Context, req *AddCustomServerLanguagePackRequest) (*Ok, error)
+	SetCustomLanguagePackFunc                         func(ctx context.Context, req *SetCustomLanguagePackRequest) (*Ok, error)
+	EditCustomLanguagePackInfoFunc                    func(ctx context.Context, req *EditCustomLanguagePackInfoRequest) (*Ok, error)
+	SetCustomLanguagePackStringFunc                   func(ctx context.Context, req *SetCustomLanguagePackStringRequest) (*Ok, error)
+	DeleteLanguagePackFunc                            func(ctx context.Context, req *DeleteLanguagePackRequest) (*Ok, error)
+	RegisterDeviceFunc                                func(ctx context.Context, req *RegisterDeviceRequest) (*PushReceiverId, error)
+	ProcessPushNotificationFunc                       func(ctx context.Context, req *ProcessPushNotificationRequest) (*Ok, error)
+	GetRecentlyVisitedTMeUrlsFunc                     func(ctx context.Context, req *GetRecentlyVisitedTMeUrlsRequest) (*TMeUrls, error)
+	SetUserPrivacySettingRulesFunc                    func(ctx context.Context, req *SetUserPrivacySettingRulesRequest) (*Ok, error)
+	GetUserPrivacySettingRulesFunc                    func(ctx context.Context, req *GetUserPrivacySettingRulesRequest) (*UserPrivacySettingRules, error)
+	SetReadDatePrivacySettingsFunc                    func(ctx context.Context, req *SetReadDatePrivacySettingsRequest) (*Ok, error)
+	GetReadDatePrivacySettingsFunc                    func(ctx context.Context) (*ReadDatePrivacySettings, error)
+	SetNewChatPrivacySettingsFunc                     func(ctx context.Context, req *SetNewChatPrivacySettingsRequest) (*Ok, error)
+	GetNewChatPrivacySettingsFunc                     func(ctx context.Context) (*NewChatPrivacySettings, error)
+	GetPaidMessageRevenueFunc                         func(ctx context.Context, req *GetPaidMessageRevenueRequest) (*StarCount, error)
+	AllowUnpaidMessagesFromUserFunc                   func(ctx context.Context, req *AllowUnpaidMessagesFromUserRequest) (*Ok, error)
+	SetChatPaidMessageStarCountFunc                   func(ctx context.Context, req *SetChatPaidMessageStarCountRequest) (*Ok, error)
+	CanSendMessageToUserFunc                          func(ctx context.Context, req *CanSendMessageToUserRequest) (CanSendMessageToUserResult, error)
+	SetOptionFunc                                     func(ctx context.Context, req *SetOptionRequest) (*Ok, error)
+	SetAccountTtlFunc                                 func(ctx context.Context, req *SetAccountTtlRequest) (*Ok, error)
+	GetAccountTtlFunc                                 func(ctx context.Context) (*AccountTtl, error)
+	DeleteAccountFunc                                 func(ctx context.Context, req *DeleteAccountRequest) (*Ok, error)
+	SetDefaultMessageAutoDeleteTimeFunc               func(ctx context.Context, req *SetDefaultMessageAutoDeleteTimeRequest) (*Ok, error)
+	GetDefaultMessageAutoDeleteTimeFunc               func(ctx context.Context) (*MessageAutoDeleteTime, error)
+	RemoveChatActionBarFunc                           func(ctx context.Context, req *RemoveChatActionBarRequest) (*Ok, error)
+	ReportChatFunc                                    func(ctx context.Context, req *ReportChatRequest) (ReportChatResult, error)
+	ReportChatPhotoFunc                               func(ctx context.Context, req *ReportChatPhotoRequest) (*Ok, error)
+	ReportMessageReactionsFunc                        func(ctx context.Context, req *ReportMessageReactionsRequest) (*Ok, error)
+	GetChatRevenueStatisticsFunc                      func(ctx context.Context, req *GetChatRevenueStatisticsRequest) (*ChatRevenueStatistics, error)
+	GetChatRevenueWithdrawalUrlFunc                   func(ctx context.Context, req *GetChatRevenueWithdrawalUrlRequest) (*HttpUrl, error)
+	GetChatRevenueTransactionsFunc                    func(ctx context.Context, req *GetChatRevenueTransactionsRequest) (*ChatRevenueTransactions, error)
+	GetStarRevenueStatisticsFunc                      func(ctx context.Context, req *GetStarRevenueStatisticsRequest) (*StarRevenueStatistics, error)
+	GetStarWithdrawalUrlFunc                          func(ctx context.Context, req *GetStarWithdrawalUrlRequest) (*HttpUrl, error)
+	GetStarAdAccountUrlFunc                           func(ctx context.Context, req *GetStarAdAccountUrlRequest) (*HttpUrl, error)
+	GetChatStatisticsFunc                             func(ctx context.Context, req *GetChatStatisticsRequest) (ChatStatistics, error)
+	GetMessageStatisticsFunc                          func(ctx context.Context, req *GetMessageStatisticsRequest) (*MessageStatistics, error)
+	GetMessagePublicForwardsFunc                      func(ctx context.Context, req *GetMessagePublicForwardsRequest) (*PublicForwards, error)
+	GetStoryStatisticsFunc                            func(ctx context.Context, req *GetStoryStatisticsRequest) (*StoryStatistics, error)
+	GetStatisticalGraphFunc                           func(ctx context.Context, req *GetStatisticalGraphRequest) (StatisticalGraph, error)
+	GetStorageStatisticsFunc                          func(ctx context.Context, req *GetStorageStatisticsRequest) (*StorageStatistics, error)
+	GetStorageStatisticsFastFunc                      func(ctx context.Context) (*StorageStatisticsFast, error)
+	GetDatabaseStatisticsFunc                         func(ctx context.Context) (*DatabaseStatistics, error)
+	OptimizeStorageFunc                               func(ctx context.Context, req *OptimizeStorageRequest) (*StorageStatistics, error)
+	SetNetworkTypeFunc                                func(ctx context.Context, req *SetNetworkTypeRequest) (*Ok, error)
+	GetNetworkStatisticsFunc                          func(ctx context.Context, req *GetNetworkStatisticsRequest) (*NetworkStatistics, error)
+	AddNetworkStatisticsFunc                          func(ctx context.Context, req *AddNetworkStatisticsRequest) (*Ok, error)
+	ResetNetworkStatisticsFunc                        func(ctx context.Context) (*Ok, error)
+	GetAutoDownloadSettingsPresetsFunc                func(ctx context.Context) (*AutoDownloadSettingsPresets, error)
+	SetAutoDownloadSettingsFunc                       func(ctx context.Context, req *SetAutoDownloadSettingsRequest) (*Ok, error)
+	GetAutosaveSettingsFunc                           func(ctx context.Context) (*AutosaveSettings, error)
+	SetAutosaveSettingsFunc                           func(ctx context.Context, req *SetAutosaveSettingsRequest) (*Ok, error)
+	ClearAutosaveSettingsExceptionsFunc               func(ctx context.Context) (*Ok, error)
+	GetBankCardInfoFunc                               func(ctx context.Context, req *GetBankCardInfoRequest) (*BankCardInfo, error)
+	GetPassportElementFunc                            func(ctx context.Context, req *GetPassportElementRequest) (PassportElement, error)
+	GetAllPassportElementsFunc                        func(ctx context.Context, req *GetAllPassportElementsRequest) (*PassportElements, error)
+	SetPassportElementFunc                            func(ctx context.Context, req *SetPassportElementRequest) (PassportElement, error)
+	DeletePassportElementFunc                         func(ctx context.Context, req *DeletePassportElementRequest) (*Ok, error)
+	SetPassportElementErrorsFunc                      func(ctx context.Context, req *SetPassportElementErrorsRequest) (*Ok, error)
+	GetPreferredCountryLanguageFunc                   func(ctx context.Context, req *GetPreferredCountryLanguageRequest) (*Text, error)
+	SendEmailAddressVerificationCodeFunc              func(ctx context.Context, req *SendEmailAddressVerificationCodeRequest) (*EmailAddressAuthenticationCodeInfo, error)
+	ResendEmailAddressVerificationCodeFunc            func(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error)
+	CheckEmailAddressVerificationCodeFunc             func(ctx context.Context, req *CheckEmailAddressVerificationCodeRequest) (*Ok, error)
+	GetPassportAuthorizationFormFunc                  func(ctx context.Context, req *GetPassportAuthorizationFormRequest) (*PassportAuthorizationForm, error)
+	GetPassportAuthorizationFormAvailableElementsFunc func(ctx context.Context, req *GetPassportAuthorizationFormAvailableElementsRequest) (*PassportElementsWithErrors, error)
+	SendPassportAuthorizationFormFunc                 func(ctx context.Context, req *SendPassportAuthorizationFormRequest) (*Ok, error)
+	SetBotUpdatesStatusFunc                           func(ctx context.Context, req *SetBotUpdatesStatusRequest) (*Ok, error)
+	UploadStickerFileFunc                             func(ctx context.Context, req *UploadStickerFileRequest) (*File, error)
+	GetSuggestedStickerSetNameFunc                    func(ctx context.Context, req *GetSuggestedStickerSetNameRequest) (*Text, error)
+	CheckStickerSetNameFunc                           func(ctx context.Context, req *CheckStickerSetNameRequest) (CheckStickerSetNameResult, error)
+	CreateNewStickerSetFunc                           func(ctx context.Context, req *CreateNewStickerSetRequest) (*StickerSet, error)
+	AddStickerToSetFunc                               func(ctx context.Context, req *AddStickerToSetRequest) (*Ok, error)
+	ReplaceStickerInSetFunc                           func(ctx context.Context, req *ReplaceStickerInSetRequest) (*Ok, error)
+	SetStickerSetThumbnailFunc                        func(ctx context.Context, req *SetStickerSetThumbnailRequest) (*Ok, error)
+	SetCustomEmojiStickerSetThumbnailFunc             func(ctx context.Context, req *SetCustomEmojiStickerSetThumbnailRequest) (*Ok, error)
+	SetStickerSetTitleFunc                            func(ctx context.Context, req *SetStickerSetTitleRequest) (*Ok, error)
+	DeleteStickerSetFunc                              func(ctx context.Context, req *DeleteStickerSetRequest) (*Ok, error)
+	SetStickerPositionInSetFunc                       func(ctx context.Context, req *SetStickerPositionInSetRequest) (*Ok, error)
+	RemoveStickerFromSetFunc                          func(ctx context.Context, req *RemoveStickerFromSetRequest) (*Ok, error)
+	SetStickerEmojisFunc                              func(ctx context.Context, req *SetStickerEmojisRequest) (*Ok, error)
+	SetStickerKeywordsFunc                            func(ctx context.Context, req *SetStickerKeywordsRequest) (*Ok, error)
+	SetStickerMaskPositionFunc                        func(ctx context.Context, req *SetStickerMaskPositionRequest) (*Ok, error)
+	GetOwnedStickerSetsFunc                           func(ctx context.Context, req *GetOwnedStickerSetsRequest) (*StickerSets, error)
+	GetMapThumbnailFileFunc                           func(ctx context.Context, req *GetMapThumbnailFileRequest) (*File, error)
+	GetPremiumLimitFunc                               func(ctx context.Context, req *GetPremiumLimitRequest) (*PremiumLimit, error)
+	GetPremiumFeaturesFunc                            func(ctx context.Context, req *GetPremiumFeaturesRequest) (*PremiumFeatures, error)
+	GetPremiumStickerExamplesFunc                     func(ctx context.Context) (*Stickers, error)
+	GetPremiumInfoStickerFunc                         func(ctx context.Context, req *GetPremiumInfoStickerRequest) (*Sticker, error)
+	ViewPremiumFeatureFunc                            func(ctx context.Context, req *ViewPremiumFeatureRequest) (*Ok, error)
+	ClickPremiumSubscriptionButtonFunc                func(ctx context.Context) (*Ok, error)
+	GetPremiumStateFunc                               func(ctx context.Context) (*PremiumState, error)
+	GetPremiumGiftPaymentOptionsFunc                  func(ctx context.Context) (*PremiumGiftPaymentOptions, error)
+	GetPremiumGiveawayPaymentOptionsFunc              func(ctx context.Context, req *GetPremiumGiveawayPaymentOptionsRequest) (*PremiumGiveawayPaymentOptions, error)
+	CheckPremiumGiftCodeFunc                          func(ctx context.Context, req *CheckPremiumGiftCodeRequest) (*PremiumGiftCodeInfo, error)
+	ApplyPremiumGiftCodeFunc                          func(ctx context.Context, req *ApplyPremiumGiftCodeRequest) (*Ok, error)
+	GiftPremiumWithStarsFunc                          func(ctx context.Context, req *GiftPremiumWithStarsRequest) (*Ok, error)
+	LaunchPrepaidGiveawayFunc                         func(ctx context.Context, req *LaunchPrepaidGiveawayRequest) (*Ok, error)
+	GetGiveawayInfoFunc                               func(ctx context.Context, req *GetGiveawayInfoRequest) (GiveawayInfo, error)
+	GetStarPaymentOptionsFunc                         func(ctx context.Context) (*StarPaymentOptions, error)
+	GetStarGiftPaymentOptionsFunc                     func(ctx context.Context, req *GetStarGiftPaymentOptionsRequest) (*StarPaymentOptions, error)
+	GetStarGiveawayPaymentOptionsFunc                 func(ctx context.Context) (*StarGiveawayPaymentOptions, error)
+	GetStarTransactionsFunc                           func(ctx context.Context, req *GetStarTransactionsRequest) (*StarTransactions, error)
+	GetStarSubscriptionsFunc                          func(ctx context.Context, req *GetStarSubscriptionsRequest) (*StarSubscriptions, error)
+	CanPurchaseFromStoreFunc                          func(ctx context.Context, req *CanPurchaseFromStoreRequest) (*Ok, error)
+	AssignStoreTransactionFunc                        func(ctx context.Context, req *AssignStoreTransactionRequest) (*Ok, error)
+	EditStarSubscriptionFunc                          func(ctx context.Context, req *EditStarSubscriptionRequest) (*Ok, error)
+	EditUserStarSubscriptionFunc                      func(ctx context.Context, req *EditUserStarSubscriptionRequest) (*Ok, error)
+	ReuseStarSubscriptionFunc                         func(ctx context.Context, req *ReuseStarSubscriptionRequest) (*Ok, error)
+	SetChatAffiliateProgramFunc                       func(ctx context.Context, req *SetChatAffiliateProgramRequest) (*Ok, error)
+	SearchChatAffiliateProgramFunc                    func(ctx context.Context, req *SearchChatAffiliateProgramRequest) (*Chat, error)
+	SearchAffiliateProgramsFunc                       func(ctx context.Context, req *SearchAffiliateProgramsRequest) (*FoundAffiliatePrograms, error)
+	ConnectAffiliateProgramFunc                       func(ctx context.Context, req *ConnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error)
+	DisconnectAffiliateProgramFunc                    func(ctx context.Context, req *DisconnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error)
+	GetConnectedAffiliateProgramFunc                  func(ctx context.Context, req *GetConnectedAffiliateProgramRequest) (*ConnectedAffiliateProgram, error)
+	GetConnectedAffiliateProgramsFunc                 func(ctx context.Context, req *GetConnectedAffiliateProgramsRequest) (*ConnectedAffiliatePrograms, error)
+	GetBusinessFeaturesFunc                           func(ctx context.Context, req *GetBusinessFeaturesRequest) (*BusinessFeatures, error)
+	AcceptTermsOfServiceFunc                          func(ctx context.Context, req *AcceptTermsOfServiceRequest) (*Ok, error)
+	SendCustomRequestFunc                             func(ctx context.Context, req *SendCustomRequestRequest) (*CustomRequestResult, error)
+	AnswerCustomQueryFunc                             func(ctx context.Context, req *AnswerCustomQueryRequest) (*Ok, error)
+	SetAlarmFunc                                      func(ctx context.Context, req *SetAlarmRequest) (*Ok, error)
+	GetCountriesFunc                                  func(ctx context.Context) (*Countries, error)
+	GetCountryCodeFunc                                func(ctx context.Context) (*Text, error)
+	GetPhoneNumberInfoFunc                            func(ctx context.Context, req *GetPhoneNumberInfoRequest) (*PhoneNumberInfo, error)
+	GetCollectibleItemInfoFunc                        func(ctx context.Context, req *GetCollectibleItemInfoRequest) (*CollectibleItemInfo, error)
+	GetDeepLinkInfoFunc                               func(ctx context.Context, req *GetDeepLinkInfoRequest) (*DeepLinkInfo, error)
+	GetApplicationConfigFunc                          func(ctx context.Context) (JsonValue, error)
+	SaveApplicationLogEventFunc                       func(ctx context.Context, req *SaveApplicationLogEventRequest) (*Ok, error)
+	GetApplicationDownloadLinkFunc                    func(ctx context.Context) (*HttpUrl, error)
+	AddProxyFunc                                      func(ctx context.Context, req *AddProxyRequest) (*Proxy, error)
+	EditProxyFunc                                     func(ctx context.Context, req *EditProxyRequest) (*Proxy, error)
+	EnableProxyFunc                                   func(ctx context.Context, req *EnableProxyRequest) (*Ok, error)
+	DisableProxyFunc                                  func(ctx context.Context) (*Ok, error)
+	RemoveProxyFunc                                   func(ctx context.Context, req *RemoveProxyRequest) (*Ok, error)
+	GetProxiesFunc                                    func(ctx context.Context) (*Proxies, error)
+	GetProxyLinkFunc                                  func(ctx context.Context, req *GetProxyLinkRequest) (*HttpUrl, error)
+	PingProxyFunc                                     func(ctx context.Context, req *PingProxyRequest) (*Seconds, error)
+	GetUserSupportInfoFunc                            func(ctx context.Context, req *GetUserSupportInfoRequest) (*UserSupportInfo, error)
+	SetUserSupportInfoFunc                            func(ctx context.Context, req *SetUserSupportInfoRequest) (*UserSupportInfo, error)
+	GetSupportNameFunc                                func(ctx context.Context) (*Text, error)
+	TestCallEmptyFunc                                 func(ctx context.Context) (*Ok, error)
+	TestCallStringFunc                                func(ctx context.Context, req *TestCallStringRequest) (*TestString, error)
+	TestCallBytesFunc                                 func(ctx context.Context, req *TestCallBytesRequest) (*TestBytes, error)
+	TestCallVectorIntFunc                             func(ctx context.Context, req *TestCallVectorIntRequest) (*TestVectorInt, error)
+	TestCallVectorIntObjectFunc                       func(ctx context.Context, req *TestCallVectorIntObjectRequest) (*TestVectorIntObject, error)
+	TestCallVectorStringFunc                          func(ctx context.Context, req *TestCallVectorStringRequest) (*TestVectorString, error)
+	TestCallVectorStringObjectFunc                    func(ctx context.Context, req *TestCallVectorStringObjectRequest) (*TestVectorStringObject, error)
+	TestSquareIntFunc                                 func(ctx context.Context, req *TestSquareIntRequest) (*TestInt, error)
+	TestNetworkFunc                                   func(ctx context.Context) (*Ok, error)
+	TestProxyFunc                                     func(ctx context.Context, req *TestProxyRequest) (*Ok, error)
+	TestGetDifferenceFunc                             func(ctx context.Context) (*Ok, error)
+	TestUseUpdateFunc                                 func(ctx context.Context) (Update, error)
+}
+
+var _ TDLib = (*Mock)(nil)
+
+func (mock *Mock) GetAuthorizationState(ctx context.Context) (AuthorizationState, error) {
+	mock.record(&GetAuthorizationStateRequest{})
+
+	if mock.GetAuthorizationStateFunc == nil {
+		return nil, mockNotImplemented("getAuthorizationState")
+	}
+
+	return mock.GetAuthorizationStateFunc(ctx)
+}
+
+func (mock *Mock) SetTdlibParameters(ctx context.Context, req *SetTdlibParametersRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetTdlibParametersFunc == nil {
+		return nil, mockNotImplemented("setTdlibParameters")
+	}
+
+	return mock.SetTdlibParametersFunc(ctx, req)
+}
+
+func (mock *Mock) SetAuthenticationPhoneNumber(ctx context.Context, req *SetAuthenticationPhoneNumberRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAuthenticationPhoneNumberFunc == nil {
+		return nil, mockNotImplemented("setAuthenticationPhoneNumber")
+	}
+
+	return mock.SetAuthenticationPhoneNumberFunc(ctx, req)
+}
+
+func (mock *Mock) CheckAuthenticationPremiumPurchase(ctx context.Context, req *CheckAuthenticationPremiumPurchaseRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckAuthenticationPremiumPurchaseFunc == nil {
+		return nil, mockNotImplemented("checkAuthenticationPremiumPurchase")
+	}
+
+	return mock.CheckAuthenticationPremiumPurchaseFunc(ctx, req)
+}
+
+func (mock *Mock) SetAuthenticationPremiumPurchaseTransaction(ctx context.Context, req *SetAuthenticationPremiumPurchaseTransactionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAuthenticationPremiumPurchaseTransactionFunc == nil {
+		return nil, mockNotImplemented("setAuthenticationPremiumPurchaseTransaction")
+	}
+
+	return mock.SetAuthenticationPremiumPurchaseTransactionFunc(ctx, req)
+}
+
+func (mock *Mock) SetAuthenticationEmailAddress(ctx context.Context, req *SetAuthenticationEmailAddressRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAuthenticationEmailAddressFunc == nil {
+		return nil, mockNotImplemented("setAuthenticationEmailAddress")
+	}
+
+	return mock.SetAuthenticationEmailAddressFunc(ctx, req)
+}
+
+func (mock *Mock) ResendAuthenticationCode(ctx context.Context, req *ResendAuthenticationCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ResendAuthenticationCodeFunc == nil {
+		return nil, mockNotImplemented("resendAuthenticationCode")
+	}
+
+	return mock.ResendAuthenticationCodeFunc(ctx, req)
+}
+
+func (mock *Mock) CheckAuthenticationEmailCode(ctx context.Context, req *CheckAuthenticationEmailCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckAuthenticationEmailCodeFunc == nil {
+		return nil, mockNotImplemented("checkAuthenticationEmailCode")
+	}
+
+	return mock.CheckAuthenticationEmailCodeFunc(ctx, req)
+}
+
+func (mock *Mock) CheckAuthenticationCode(ctx context.Context, req *CheckAuthenticationCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckAuthenticationCodeFunc == nil {
+		return nil, mockNotImplemented("checkAuthenticationCode")
+	}
+
+	return mock.CheckAuthenticationCodeFunc(ctx, req)
+}
+
+func (mock *Mock) RequestQrCodeAuthentication(ctx context.Context, req *RequestQrCodeAuthenticationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RequestQrCodeAuthenticationFunc == nil {
+		return nil, mockNotImplemented("requestQrCodeAuthentication")
+	}
+
+	return mock.RequestQrCodeAuthenticationFunc(ctx, req)
+}
+
+func (mock *Mock) RegisterUser(ctx context.Context, req *RegisterUserRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RegisterUserFunc == nil {
+		return nil, mockNotImplemented("registerUser")
+	}
+
+	return mock.RegisterUserFunc(ctx, req)
+}
+
+func (mock *Mock) ResetAuthenticationEmailAddress(ctx context.Context) (*Ok, error) {
+	mock.record(&ResetAuthenticationEmailAddressRequest{})
+
+	if mock.ResetAuthenticationEmailAddressFunc == nil {
+		return nil, mockNotImplemented("resetAuthenticationEmailAddress")
+	}
+
+	return mock.ResetAuthenticationEmailAddressFunc(ctx)
+}
+
+func (mock *Mock) CheckAuthenticationPassword(ctx context.Context, req *CheckAuthenticationPasswordRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckAuthenticationPasswordFunc == nil {
+		return nil, mockNotImplemented("checkAuthenticationPassword")
+	}
+
+	return mock.CheckAuthenticationPasswordFunc(ctx, req)
+}
+
+func (mock *Mock) RequestAuthenticationPasswordRecovery(ctx context.Context) (*Ok, error) {
+	mock.record(&RequestAuthenticationPasswordRecoveryRequest{})
+
+	if mock.RequestAuthenticationPasswordRecoveryFunc == nil {
+		return nil, mockNotImplemented("requestAuthenticationPasswordRecovery")
+	}
+
+	return mock.RequestAuthenticationPasswordRecoveryFunc(ctx)
+}
+
+func (mock *Mock) CheckAuthenticationPasswordRecoveryCode(ctx context.Context, req *CheckAuthenticationPasswordRecoveryCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckAuthenticationPasswordRecoveryCodeFunc == nil {
+		return nil, mockNotImplemented("checkAuthenticationPasswordRecoveryCode")
+	}
+
+	return mock.CheckAuthenticationPasswordRecoveryCodeFunc(ctx, req)
+}
+
+func (mock *Mock) RecoverAuthenticationPassword(ctx context.Context, req *RecoverAuthenticationPasswordRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RecoverAuthenticationPasswordFunc == nil {
+		return nil, mockNotImplemented("recoverAuthenticationPassword")
+	}
+
+	return mock.RecoverAuthenticationPasswordFunc(ctx, req)
+}
+
+func (mock *Mock) SendAuthenticationFirebaseSms(ctx context.Context, req *SendAuthenticationFirebaseSmsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendAuthenticationFirebaseSmsFunc == nil {
+		return nil, mockNotImplemented("sendAuthenticationFirebaseSms")
+	}
+
+	return mock.SendAuthenticationFirebaseSmsFunc(ctx, req)
+}
+
+func (mock *Mock) ReportAuthenticationCodeMissing(ctx context.Context, req *ReportAuthenticationCodeMissingRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReportAuthenticationCodeMissingFunc == nil {
+		return nil, mockNotImplemented("reportAuthenticationCodeMissing")
+	}
+
+	return mock.ReportAuthenticationCodeMissingFunc(ctx, req)
+}
+
+func (mock *Mock) CheckAuthenticationBotToken(ctx context.Context, req *CheckAuthenticationBotTokenRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckAuthenticationBotTokenFunc == nil {
+		return nil, mockNotImplemented("checkAuthenticationBotToken")
+	}
+
+	return mock.CheckAuthenticationBotTokenFunc(ctx, req)
+}
+
+func (mock *Mock) LogOut(ctx context.Context) (*Ok, error) {
+	mock.record(&LogOutRequest{})
+
+	if mock.LogOutFunc == nil {
+		return nil, mockNotImplemented("logOut")
+	}
+
+	return mock.LogOutFunc(ctx)
+}
+
+func (mock *Mock) Close(ctx context.Context) (*Ok, error) {
+	mock.record(&CloseRequest{})
+
+	if mock.CloseFunc == nil {
+		return nil, mockNotImplemented("close")
+	}
+
+	return mock.CloseFunc(ctx)
+}
+
+func (mock *Mock) Destroy(ctx context.Context) (*Ok, error) {
+	mock.record(&DestroyRequest{})
+
+	if mock.DestroyFunc == nil {
+		return nil, mockNotImplemented("destroy")
+	}
+
+	return mock.DestroyFunc(ctx)
+}
+
+func (mock *Mock) ConfirmQrCodeAuthentication(ctx context.Context, req *ConfirmQrCodeAuthenticationRequest) (*Session, error) {
+	mock.record(req)
+
+	if mock.ConfirmQrCodeAuthenticationFunc == nil {
+		return nil, mockNotImplemented("confirmQrCodeAuthentication")
+	}
+
+	return mock.ConfirmQrCodeAuthenticationFunc(ctx, req)
+}
+
+func (mock *Mock) GetCurrentState(ctx context.Context) (*Updates, error) {
+	mock.record(&GetCurrentStateRequest{})
+
+	if mock.GetCurrentStateFunc == nil {
+		return nil, mockNotImplemented("getCurrentState")
+	}
+
+	return mock.GetCurrentStateFunc(ctx)
+}
+
+func (mock *Mock) SetDatabaseEncryptionKey(ctx context.Context, req *SetDatabaseEncryptionKeyRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetDatabaseEncryptionKeyFunc == nil {
+		return nil, mockNotImplemented("setDatabaseEncryptionKey")
+	}
+
+	return mock.SetDatabaseEncryptionKeyFunc(ctx, req)
+}
+
+func (mock *Mock) GetPasswordState(ctx context.Context) (*PasswordState, error) {
+	mock.record(&GetPasswordStateRequest{})
+
+	if mock.GetPasswordStateFunc == nil {
+		return nil, mockNotImplemented("getPasswordState")
+	}
+
+	return mock.GetPasswordStateFunc(ctx)
+}
+
+func (mock *Mock) SetPassword(ctx context.Context, req *SetPasswordRequest) (*PasswordState, error) {
+	mock.record(req)
+
+	if mock.SetPasswordFunc == nil {
+		return nil, mockNotImplemented("setPassword")
+	}
+
+	return mock.SetPasswordFunc(ctx, req)
+}
+
+func (mock *Mock) SetLoginEmailAddress(ctx context.Context, req *SetLoginEmailAddressRequest) (*EmailAddressAuthenticationCodeInfo, error) {
+	mock.record(req)
+
+	if mock.SetLoginEmailAddressFunc == nil {
+		return nil, mockNotImplemented("setLoginEmailAddress")
+	}
+
+	return mock.SetLoginEmailAddressFunc(ctx, req)
+}
+
+func (mock *Mock) ResendLoginEmailAddressCode(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	mock.record(&ResendLoginEmailAddressCodeRequest{})
+
+	if mock.ResendLoginEmailAddressCodeFunc == nil {
+		return nil, mockNotImplemented("resendLoginEmailAddressCode")
+	}
+
+	return mock.ResendLoginEmailAddressCodeFunc(ctx)
+}
+
+func (mock *Mock) CheckLoginEmailAddressCode(ctx context.Context, req *CheckLoginEmailAddressCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckLoginEmailAddressCodeFunc == nil {
+		return nil, mockNotImplemented("checkLoginEmailAddressCode")
+	}
+
+	return mock.CheckLoginEmailAddressCodeFunc(ctx, req)
+}
+
+func (mock *Mock) GetRecoveryEmailAddress(ctx context.Context, req *GetRecoveryEmailAddressRequest) (*RecoveryEmailAddress, error) {
+	mock.record(req)
+
+	if mock.GetRecoveryEmailAddressFunc == nil {
+		return nil, mockNotImplemented("getRecoveryEmailAddress")
+	}
+
+	return mock.GetRecoveryEmailAddressFunc(ctx, req)
+}
+
+func (mock *Mock) SetRecoveryEmailAddress(ctx context.Context, req *SetRecoveryEmailAddressRequest) (*PasswordState, error) {
+	mock.record(req)
+
+	if mock.SetRecoveryEmailAddressFunc == nil {
+		return nil, mockNotImplemented("setRecoveryEmailAddress")
+	}
+
+	return mock.SetRecoveryEmailAddressFunc(ctx, req)
+}
+
+func (mock *Mock) CheckRecoveryEmailAddressCode(ctx context.Context, req *CheckRecoveryEmailAddressCodeRequest) (*PasswordState, error) {
+	mock.record(req)
+
+	if mock.CheckRecoveryEmailAddressCodeFunc == nil {
+		return nil, mockNotImplemented("checkRecoveryEmailAddressCode")
+	}
+
+	return mock.CheckRecoveryEmailAddressCodeFunc(ctx, req)
+}
+
+func (mock *Mock) ResendRecoveryEmailAddressCode(ctx context.Context) (*PasswordState, error) {
+	mock.record(&ResendRecoveryEmailAddressCodeRequest{})
+
+	if mock.ResendRecoveryEmailAddressCodeFunc == nil {
+		return nil, mockNotImplemented("resendRecoveryEmailAddressCode")
+	}
+
+	return mock.ResendRecoveryEmailAddressCodeFunc(ctx)
+}
+
+func (mock *Mock) CancelRecoveryEmailAddressVerification(ctx context.Context) (*PasswordState, error) {
+	mock.record(&CancelRecoveryEmailAddressVerificationRequest{})
+
+	if mock.CancelRecoveryEmailAddressVerificationFunc == nil {
+		return nil, mockNotImplemented("cancelRecoveryEmailAddressVerification")
+	}
+
+	return mock.CancelRecoveryEmailAddressVerificationFunc(ctx)
+}
+
+func (mock *Mock) RequestPasswordRecovery(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	mock.record(&RequestPasswordRecoveryRequest{})
+
+	if mock.RequestPasswordRecoveryFunc == nil {
+		return nil, mockNotImplemented("requestPasswordRecovery")
+	}
+
+	return mock.RequestPasswordRecoveryFunc(ctx)
+}
+
+func (mock *Mock) CheckPasswordRecoveryCode(ctx context.Context, req *CheckPasswordRecoveryCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckPasswordRecoveryCodeFunc == nil {
+		return nil, mockNotImplemented("checkPasswordRecoveryCode")
+	}
+
+	return mock.CheckPasswordRecoveryCodeFunc(ctx, req)
+}
+
+func (mock *Mock) RecoverPassword(ctx context.Context, req *RecoverPasswordRequest) (*PasswordState, error) {
+	mock.record(req)
+
+	if mock.RecoverPasswordFunc == nil {
+		return nil, mockNotImplemented("recoverPassword")
+	}
+
+	return mock.RecoverPasswordFunc(ctx, req)
+}
+
+func (mock *Mock) ResetPassword(ctx context.Context) (ResetPasswordResult, error) {
+	mock.record(&ResetPasswordRequest{})
+
+	if mock.ResetPasswordFunc == nil {
+		return nil, mockNotImplemented("resetPassword")
+	}
+
+	return mock.ResetPasswordFunc(ctx)
+}
+
+func (mock *Mock) CancelPasswordReset(ctx context.Context) (*Ok, error) {
+	mock.record(&CancelPasswordResetRequest{})
+
+	if mock.CancelPasswordResetFunc == nil {
+		return nil, mockNotImplemented("cancelPasswordReset")
+	}
+
+	return mock.CancelPasswordResetFunc(ctx)
+}
+
+func (mock *Mock) CreateTemporaryPassword(ctx context.Context, req *CreateTemporaryPasswordRequest) (*TemporaryPasswordState, error) {
+	mock.record(req)
+
+	if mock.CreateTemporaryPasswordFunc == nil {
+		return nil, mockNotImplemented("createTemporaryPassword")
+	}
+
+	return mock.CreateTemporaryPasswordFunc(ctx, req)
+}
+
+func (mock *Mock) GetTemporaryPasswordState(ctx context.Context) (*TemporaryPasswordState, error) {
+	mock.record(&GetTemporaryPasswordStateRequest{})
+
+	if mock.GetTemporaryPasswordStateFunc == nil {
+		return nil, mockNotImplemented("getTemporaryPasswordState")
+	}
+
+	return mock.GetTemporaryPasswordStateFunc(ctx)
+}
+
+func (mock *Mock) GetMe(ctx context.Context) (*User, error) {
+	mock.record(&GetMeRequest{})
+
+	if mock.GetMeFunc == nil {
+		return nil, mockNotImplemented("getMe")
+	}
+
+	return mock.GetMeFunc(ctx)
+}
+
+func (mock *Mock) GetUser(ctx context.Context, req *GetUserRequest) (*User, error) {
+	mock.record(req)
+
+	if mock.GetUserFunc == nil {
+		return nil, mockNotImplemented("getUser")
+	}
+
+	return mock.GetUserFunc(ctx, req)
+}
+
+func (mock *Mock) GetUserFullInfo(ctx context.Context, req *GetUserFullInfoRequest) (*UserFullInfo, error) {
+	mock.record(req)
+
+	if mock.GetUserFullInfoFunc == nil {
+		return nil, mockNotImplemented("getUserFullInfo")
+	}
+
+	return mock.GetUserFullInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetBasicGroup(ctx context.Context, req *GetBasicGroupRequest) (*BasicGroup, error) {
+	mock.record(req)
+
+	if mock.GetBasicGroupFunc == nil {
+		return nil, mockNotImplemented("getBasicGroup")
+	}
+
+	return mock.GetBasicGroupFunc(ctx, req)
+}
+
+func (mock *Mock) GetBasicGroupFullInfo(ctx context.Context, req *GetBasicGroupFullInfoRequest) (*BasicGroupFullInfo, error) {
+	mock.record(req)
+
+	if mock.GetBasicGroupFullInfoFunc == nil {
+		return nil, mockNotImplemented("getBasicGroupFullInfo")
+	}
+
+	return mock.GetBasicGroupFullInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetSupergroup(ctx context.Context, req *GetSupergroupRequest) (*Supergroup, error) {
+	mock.record(req)
+
+	if mock.GetSupergroupFunc == nil {
+		return nil, mockNotImplemented("getSupergroup")
+	}
+
+	return mock.GetSupergroupFunc(ctx, req)
+}
+
+func (mock *Mock) GetSupergroupFullInfo(ctx context.Context, req *GetSupergroupFullInfoRequest) (*SupergroupFullInfo, error) {
+	mock.record(req)
+
+	if mock.GetSupergroupFullInfoFunc == nil {
+		return nil, mockNotImplemented("getSupergroupFullInfo")
+	}
+
+	return mock.GetSupergroupFullInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetSecretChat(ctx context.Context, req *GetSecretChatRequest) (*SecretChat, error) {
+	mock.record(req)
+
+	if mock.GetSecretChatFunc == nil {
+		return nil, mockNotImplemented("getSecretChat")
+	}
+
+	return mock.GetSecretChatFunc(ctx, req)
+}
+
+func (mock *Mock) GetChat(ctx context.Context, req *GetChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.GetChatFunc == nil {
+		return nil, mockNotImplemented("getChat")
+	}
+
+	return mock.GetChatFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessage(ctx context.Context, req *GetMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.GetMessageFunc == nil {
+		return nil, mockNotImplemented("getMessage")
+	}
+
+	return mock.GetMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageLocally(ctx context.Context, req *GetMessageLocallyRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.GetMessageLocallyFunc == nil {
+		return nil, mockNotImplemented("getMessageLocally")
+	}
+
+	return mock.GetMessageLocallyFunc(ctx, req)
+}
+
+func (mock *Mock) GetRepliedMessage(ctx context.Context, req *GetRepliedMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.GetRepliedMessageFunc == nil {
+		return nil, mockNotImplemented("getRepliedMessage")
+	}
+
+	return mock.GetRepliedMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatPinnedMessage(ctx context.Context, req *GetChatPinnedMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.GetChatPinnedMessageFunc == nil {
+		return nil, mockNotImplemented("getChatPinnedMessage")
+	}
+
+	return mock.GetChatPinnedMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetCallbackQueryMessage(ctx context.Context, req *GetCallbackQueryMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.GetCallbackQueryMessageFunc == nil {
+		return nil, mockNotImplemented("getCallbackQueryMessage")
+	}
+
+	return mock.GetCallbackQueryMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessages(ctx context.Context, req *GetMessagesRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.GetMessagesFunc == nil {
+		return nil, mockNotImplemented("getMessages")
+	}
+
+	return mock.GetMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageProperties(ctx context.Context, req *GetMessagePropertiesRequest) (*MessageProperties, error) {
+	mock.record(req)
+
+	if mock.GetMessagePropertiesFunc == nil {
+		return nil, mockNotImplemented("getMessageProperties")
+	}
+
+	return mock.GetMessagePropertiesFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageThread(ctx context.Context, req *GetMessageThreadRequest) (*MessageThreadInfo, error) {
+	mock.record(req)
+
+	if mock.GetMessageThreadFunc == nil {
+		return nil, mockNotImplemented("getMessageThread")
+	}
+
+	return mock.GetMessageThreadFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageReadDate(ctx context.Context, req *GetMessageReadDateRequest) (MessageReadDate, error) {
+	mock.record(req)
+
+	if mock.GetMessageReadDateFunc == nil {
+		return nil, mockNotImplemented("getMessageReadDate")
+	}
+
+	return mock.GetMessageReadDateFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageViewers(ctx context.Context, req *GetMessageViewersRequest) (*MessageViewers, error) {
+	mock.record(req)
+
+	if mock.GetMessageViewersFunc == nil {
+		return nil, mockNotImplemented("getMessageViewers")
+	}
+
+	return mock.GetMessageViewersFunc(ctx, req)
+}
+
+func (mock *Mock) GetFile(ctx context.Context, req *GetFileRequest) (*File, error) {
+	mock.record(req)
+
+	if mock.GetFileFunc == nil {
+		return nil, mockNotImplemented("getFile")
+	}
+
+	return mock.GetFileFunc(ctx, req)
+}
+
+func (mock *Mock) GetRemoteFile(ctx context.Context, req *GetRemoteFileRequest) (*File, error) {
+	mock.record(req)
+
+	if mock.GetRemoteFileFunc == nil {
+		return nil, mockNotImplemented("getRemoteFile")
+	}
+
+	return mock.GetRemoteFileFunc(ctx, req)
+}
+
+func (mock *Mock) LoadChats(ctx context.Context, req *LoadChatsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LoadChatsFunc == nil {
+		return nil, mockNotImplemented("loadChats")
+	}
+
+	return mock.LoadChatsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChats(ctx context.Context, req *GetChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetChatsFunc == nil {
+		return nil, mockNotImplemented("getChats")
+	}
+
+	return mock.GetChatsFunc(ctx, req)
+}
+
+func (mock *Mock) SearchPublicChat(ctx context.Context, req *SearchPublicChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.SearchPublicChatFunc == nil {
+		return nil, mockNotImplemented("searchPublicChat")
+	}
+
+	return mock.SearchPublicChatFunc(ctx, req)
+}
+
+func (mock *Mock) SearchPublicChats(ctx context.Context, req *SearchPublicChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.SearchPublicChatsFunc == nil {
+		return nil, mockNotImplemented("searchPublicChats")
+	}
+
+	return mock.SearchPublicChatsFunc(ctx, req)
+}
+
+func (mock *Mock) SearchChats(ctx context.Context, req *SearchChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.SearchChatsFunc == nil {
+		return nil, mockNotImplemented("searchChats")
+	}
+
+	return mock.SearchChatsFunc(ctx, req)
+}
+
+func (mock *Mock) SearchChatsOnServer(ctx context.Context, req *SearchChatsOnServerRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.SearchChatsOnServerFunc == nil {
+		return nil, mockNotImplemented("searchChatsOnServer")
+	}
+
+	return mock.SearchChatsOnServerFunc(ctx, req)
+}
+
+func (mock *Mock) GetRecommendedChats(ctx context.Context) (*Chats, error) {
+	mock.record(&GetRecommendedChatsRequest{})
+
+	if mock.GetRecommendedChatsFunc == nil {
+		return nil, mockNotImplemented("getRecommendedChats")
+	}
+
+	return mock.GetRecommendedChatsFunc(ctx)
+}
+
+func (mock *Mock) GetChatSimilarChats(ctx context.Context, req *GetChatSimilarChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetChatSimilarChatsFunc == nil {
+		return nil, mockNotImplemented("getChatSimilarChats")
+	}
+
+	return mock.GetChatSimilarChatsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatSimilarChatCount(ctx context.Context, req *GetChatSimilarChatCountRequest) (*Count, error) {
+	mock.record(req)
+
+	if mock.GetChatSimilarChatCountFunc == nil {
+		return nil, mockNotImplemented("getChatSimilarChatCount")
+	}
+
+	return mock.GetChatSimilarChatCountFunc(ctx, req)
+}
+
+func (mock *Mock) OpenChatSimilarChat(ctx context.Context, req *OpenChatSimilarChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.OpenChatSimilarChatFunc == nil {
+		return nil, mockNotImplemented("openChatSimilarChat")
+	}
+
+	return mock.OpenChatSimilarChatFunc(ctx, req)
+}
+
+func (mock *Mock) GetBotSimilarBots(ctx context.Context, req *GetBotSimilarBotsRequest) (*Users, error) {
+	mock.record(req)
+
+	if mock.GetBotSimilarBotsFunc == nil {
+		return nil, mockNotImplemented("getBotSimilarBots")
+	}
+
+	return mock.GetBotSimilarBotsFunc(ctx, req)
+}
+
+func (mock *Mock) GetBotSimilarBotCount(ctx context.Context, req *GetBotSimilarBotCountRequest) (*Count, error) {
+	mock.record(req)
+
+	if mock.GetBotSimilarBotCountFunc == nil {
+		return nil, mockNotImplemented("getBotSimilarBotCount")
+	}
+
+	return mock.GetBotSimilarBotCountFunc(ctx, req)
+}
+
+func (mock *Mock) OpenBotSimilarBot(ctx context.Context, req *OpenBotSimilarBotRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.OpenBotSimilarBotFunc == nil {
+		return nil, mockNotImplemented("openBotSimilarBot")
+	}
+
+	return mock.OpenBotSimilarBotFunc(ctx, req)
+}
+
+func (mock *Mock) GetTopChats(ctx context.Context, req *GetTopChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetTopChatsFunc == nil {
+		return nil, mockNotImplemented("getTopChats")
+	}
+
+	return mock.GetTopChatsFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveTopChat(ctx context.Context, req *RemoveTopChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveTopChatFunc == nil {
+		return nil, mockNotImplemented("removeTopChat")
+	}
+
+	return mock.RemoveTopChatFunc(ctx, req)
+}
+
+func (mock *Mock) SearchRecentlyFoundChats(ctx context.Context, req *SearchRecentlyFoundChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.SearchRecentlyFoundChatsFunc == nil {
+		return nil, mockNotImplemented("searchRecentlyFoundChats")
+	}
+
+	return mock.SearchRecentlyFoundChatsFunc(ctx, req)
+}
+
+func (mock *Mock) AddRecentlyFoundChat(ctx context.Context, req *AddRecentlyFoundChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddRecentlyFoundChatFunc == nil {
+		return nil, mockNotImplemented("addRecentlyFoundChat")
+	}
+
+	return mock.AddRecentlyFoundChatFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveRecentlyFoundChat(ctx context.Context, req *RemoveRecentlyFoundChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveRecentlyFoundChatFunc == nil {
+		return nil, mockNotImplemented("removeRecentlyFoundChat")
+	}
+
+	return mock.RemoveRecentlyFoundChatFunc(ctx, req)
+}
+
+func (mock *Mock) ClearRecentlyFoundChats(ctx context.Context) (*Ok, error) {
+	mock.record(&ClearRecentlyFoundChatsRequest{})
+
+	if mock.ClearRecentlyFoundChatsFunc == nil {
+		return nil, mockNotImplemented("clearRecentlyFoundChats")
+	}
+
+	return mock.ClearRecentlyFoundChatsFunc(ctx)
+}
+
+func (mock *Mock) GetRecentlyOpenedChats(ctx context.Context, req *GetRecentlyOpenedChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetRecentlyOpenedChatsFunc == nil {
+		return nil, mockNotImplemented("getRecentlyOpenedChats")
+	}
+
+	return mock.GetRecentlyOpenedChatsFunc(ctx, req)
+}
+
+func (mock *Mock) CheckChatUsername(ctx context.Context, req *CheckChatUsernameRequest) (CheckChatUsernameResult, error) {
+	mock.record(req)
+
+	if mock.CheckChatUsernameFunc == nil {
+		return nil, mockNotImplemented("checkChatUsername")
+	}
+
+	return mock.CheckChatUsernameFunc(ctx, req)
+}
+
+func (mock *Mock) GetCreatedPublicChats(ctx context.Context, req *GetCreatedPublicChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetCreatedPublicChatsFunc == nil {
+		return nil, mockNotImplemented("getCreatedPublicChats")
+	}
+
+	return mock.GetCreatedPublicChatsFunc(ctx, req)
+}
+
+func (mock *Mock) CheckCreatedPublicChatsLimit(ctx context.Context, req *CheckCreatedPublicChatsLimitRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckCreatedPublicChatsLimitFunc == nil {
+		return nil, mockNotImplemented("checkCreatedPublicChatsLimit")
+	}
+
+	return mock.CheckCreatedPublicChatsLimitFunc(ctx, req)
+}
+
+func (mock *Mock) GetSuitableDiscussionChats(ctx context.Context) (*Chats, error) {
+	mock.record(&GetSuitableDiscussionChatsRequest{})
+
+	if mock.GetSuitableDiscussionChatsFunc == nil {
+		return nil, mockNotImplemented("getSuitableDiscussionChats")
+	}
+
+	return mock.GetSuitableDiscussionChatsFunc(ctx)
+}
+
+func (mock *Mock) GetInactiveSupergroupChats(ctx context.Context) (*Chats, error) {
+	mock.record(&GetInactiveSupergroupChatsRequest{})
+
+	if mock.GetInactiveSupergroupChatsFunc == nil {
+		return nil, mockNotImplemented("getInactiveSupergroupChats")
+	}
+
+	return mock.GetInactiveSupergroupChatsFunc(ctx)
+}
+
+func (mock *Mock) GetSuitablePersonalChats(ctx context.Context) (*Chats, error) {
+	mock.record(&GetSuitablePersonalChatsRequest{})
+
+	if mock.GetSuitablePersonalChatsFunc == nil {
+		return nil, mockNotImplemented("getSuitablePersonalChats")
+	}
+
+	return mock.GetSuitablePersonalChatsFunc(ctx)
+}
+
+func (mock *Mock) LoadSavedMessagesTopics(ctx context.Context, req *LoadSavedMessagesTopicsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LoadSavedMessagesTopicsFunc == nil {
+		return nil, mockNotImplemented("loadSavedMessagesTopics")
+	}
+
+	return mock.LoadSavedMessagesTopicsFunc(ctx, req)
+}
+
+func (mock *Mock) GetSavedMessagesTopicHistory(ctx context.Context, req *GetSavedMessagesTopicHistoryRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.GetSavedMessagesTopicHistoryFunc == nil {
+		return nil, mockNotImplemented("getSavedMessagesTopicHistory")
+	}
+
+	return mock.GetSavedMessagesTopicHistoryFunc(ctx, req)
+}
+
+func (mock *Mock) GetSavedMessagesTopicMessageByDate(ctx context.Context, req *GetSavedMessagesTopicMessageByDateRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.GetSavedMessagesTopicMessageByDateFunc == nil {
+		return nil, mockNotImplemented("getSavedMessagesTopicMessageByDate")
+	}
+
+	return mock.GetSavedMessagesTopicMessageByDateFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteSavedMessagesTopicHistory(ctx context.Context, req *DeleteSavedMessagesTopicHistoryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteSavedMessagesTopicHistoryFunc == nil {
+		return nil, mockNotImplemented("deleteSavedMessagesTopicHistory")
+	}
+
+	return mock.DeleteSavedMessagesTopicHistoryFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteSavedMessagesTopicMessagesByDate(ctx context.Context, req *DeleteSavedMessagesTopicMessagesByDateRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteSavedMessagesTopicMessagesByDateFunc == nil {
+		return nil, mockNotImplemented("deleteSavedMessagesTopicMessagesByDate")
+	}
+
+	return mock.DeleteSavedMessagesTopicMessagesByDateFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSavedMessagesTopicIsPinned(ctx context.Context, req *ToggleSavedMessagesTopicIsPinnedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSavedMessagesTopicIsPinnedFunc == nil {
+		return nil, mockNotImplemented("toggleSavedMessagesTopicIsPinned")
+	}
+
+	return mock.ToggleSavedMessagesTopicIsPinnedFunc(ctx, req)
+}
+
+func (mock *Mock) SetPinnedSavedMessagesTopics(ctx context.Context, req *SetPinnedSavedMessagesTopicsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPinnedSavedMessagesTopicsFunc == nil {
+		return nil, mockNotImplemented("setPinnedSavedMessagesTopics")
+	}
+
+	return mock.SetPinnedSavedMessagesTopicsFunc(ctx, req)
+}
+
+func (mock *Mock) GetGroupsInCommon(ctx context.Context, req *GetGroupsInCommonRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetGroupsInCommonFunc == nil {
+		return nil, mockNotImplemented("getGroupsInCommon")
+	}
+
+	return mock.GetGroupsInCommonFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatHistory(ctx context.Context, req *GetChatHistoryRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.GetChatHistoryFunc == nil {
+		return nil, mockNotImplemented("getChatHistory")
+	}
+
+	return mock.GetChatHistoryFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageThreadHistory(ctx context.Context, req *GetMessageThreadHistoryRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.GetMessageThreadHistoryFunc == nil {
+		return nil, mockNotImplemented("getMessageThreadHistory")
+	}
+
+	return mock.GetMessageThreadHistoryFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChatHistory(ctx context.Context, req *DeleteChatHistoryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatHistoryFunc == nil {
+		return nil, mockNotImplemented("deleteChatHistory")
+	}
+
+	return mock.DeleteChatHistoryFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChat(ctx context.Context, req *DeleteChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatFunc == nil {
+		return nil, mockNotImplemented("deleteChat")
+	}
+
+	return mock.DeleteChatFunc(ctx, req)
+}
+
+func (mock *Mock) SearchChatMessages(ctx context.Context, req *SearchChatMessagesRequest) (*FoundChatMessages, error) {
+	mock.record(req)
+
+	if mock.SearchChatMessagesFunc == nil {
+		return nil, mockNotImplemented("searchChatMessages")
+	}
+
+	return mock.SearchChatMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SearchMessages(ctx context.Context, req *SearchMessagesRequest) (*FoundMessages, error) {
+	mock.record(req)
+
+	if mock.SearchMessagesFunc == nil {
+		return nil, mockNotImplemented("searchMessages")
+	}
+
+	return mock.SearchMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SearchSecretMessages(ctx context.Context, req *SearchSecretMessagesRequest) (*FoundMessages, error) {
+	mock.record(req)
+
+	if mock.SearchSecretMessagesFunc == nil {
+		return nil, mockNotImplemented("searchSecretMessages")
+	}
+
+	return mock.SearchSecretMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SearchSavedMessages(ctx context.Context, req *SearchSavedMessagesRequest) (*FoundChatMessages, error) {
+	mock.record(req)
+
+	if mock.SearchSavedMessagesFunc == nil {
+		return nil, mockNotImplemented("searchSavedMessages")
+	}
+
+	return mock.SearchSavedMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SearchCallMessages(ctx context.Context, req *SearchCallMessagesRequest) (*FoundMessages, error) {
+	mock.record(req)
+
+	if mock.SearchCallMessagesFunc == nil {
+		return nil, mockNotImplemented("searchCallMessages")
+	}
+
+	return mock.SearchCallMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SearchOutgoingDocumentMessages(ctx context.Context, req *SearchOutgoingDocumentMessagesRequest) (*FoundMessages, error) {
+	mock.record(req)
+
+	if mock.SearchOutgoingDocumentMessagesFunc == nil {
+		return nil, mockNotImplemented("searchOutgoingDocumentMessages")
+	}
+
+	return mock.SearchOutgoingDocumentMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SearchPublicMessagesByTag(ctx context.Context, req *SearchPublicMessagesByTagRequest) (*FoundMessages, error) {
+	mock.record(req)
+
+	if mock.SearchPublicMessagesByTagFunc == nil {
+		return nil, mockNotImplemented("searchPublicMessagesByTag")
+	}
+
+	return mock.SearchPublicMessagesByTagFunc(ctx, req)
+}
+
+func (mock *Mock) SearchPublicStoriesByTag(ctx context.Context, req *SearchPublicStoriesByTagRequest) (*FoundStories, error) {
+	mock.record(req)
+
+	if mock.SearchPublicStoriesByTagFunc == nil {
+		return nil, mockNotImplemented("searchPublicStoriesByTag")
+	}
+
+	return mock.SearchPublicStoriesByTagFunc(ctx, req)
+}
+
+func (mock *Mock) SearchPublicStoriesByLocation(ctx context.Context, req *SearchPublicStoriesByLocationRequest) (*FoundStories, error) {
+	mock.record(req)
+
+	if mock.SearchPublicStoriesByLocationFunc == nil {
+		return nil, mockNotImplemented("searchPublicStoriesByLocation")
+	}
+
+	return mock.SearchPublicStoriesByLocationFunc(ctx, req)
+}
+
+func (mock *Mock) SearchPublicStoriesByVenue(ctx context.Context, req *SearchPublicStoriesByVenueRequest) (*FoundStories, error) {
+	mock.record(req)
+
+	if mock.SearchPublicStoriesByVenueFunc == nil {
+		return nil, mockNotImplemented("searchPublicStoriesByVenue")
+	}
+
+	return mock.SearchPublicStoriesByVenueFunc(ctx, req)
+}
+
+func (mock *Mock) GetSearchedForTags(ctx context.Context, req *GetSearchedForTagsRequest) (*Hashtags, error) {
+	mock.record(req)
+
+	if mock.GetSearchedForTagsFunc == nil {
+		return nil, mockNotImplemented("getSearchedForTags")
+	}
+
+	return mock.GetSearchedForTagsFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveSearchedForTag(ctx context.Context, req *RemoveSearchedForTagRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveSearchedForTagFunc == nil {
+		return nil, mockNotImplemented("removeSearchedForTag")
+	}
+
+	return mock.RemoveSearchedForTagFunc(ctx, req)
+}
+
+func (mock *Mock) ClearSearchedForTags(ctx context.Context, req *ClearSearchedForTagsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ClearSearchedForTagsFunc == nil {
+		return nil, mockNotImplemented("clearSearchedForTags")
+	}
+
+	return mock.ClearSearchedForTagsFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteAllCallMessages(ctx context.Context, req *DeleteAllCallMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteAllCallMessagesFunc == nil {
+		return nil, mockNotImplemented("deleteAllCallMessages")
+	}
+
+	return mock.DeleteAllCallMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SearchChatRecentLocationMessages(ctx context.Context, req *SearchChatRecentLocationMessagesRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.SearchChatRecentLocationMessagesFunc == nil {
+		return nil, mockNotImplemented("searchChatRecentLocationMessages")
+	}
+
+	return mock.SearchChatRecentLocationMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatMessageByDate(ctx context.Context, req *GetChatMessageByDateRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.GetChatMessageByDateFunc == nil {
+		return nil, mockNotImplemented("getChatMessageByDate")
+	}
+
+	return mock.GetChatMessageByDateFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatSparseMessagePositions(ctx context.Context, req *GetChatSparseMessagePositionsRequest) (*MessagePositions, error) {
+	mock.record(req)
+
+	if mock.GetChatSparseMessagePositionsFunc == nil {
+		return nil, mockNotImplemented("getChatSparseMessagePositions")
+	}
+
+	return mock.GetChatSparseMessagePositionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatMessageCalendar(ctx context.Context, req *GetChatMessageCalendarRequest) (*MessageCalendar, error) {
+	mock.record(req)
+
+	if mock.GetChatMessageCalendarFunc == nil {
+		return nil, mockNotImplemented("getChatMessageCalendar")
+	}
+
+	return mock.GetChatMessageCalendarFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatMessageCount(ctx context.Context, req *GetChatMessageCountRequest) (*Count, error) {
+	mock.record(req)
+
+	if mock.GetChatMessageCountFunc == nil {
+		return nil, mockNotImplemented("getChatMessageCount")
+	}
+
+	return mock.GetChatMessageCountFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatMessagePosition(ctx context.Context, req *GetChatMessagePositionRequest) (*Count, error) {
+	mock.record(req)
+
+	if mock.GetChatMessagePositionFunc == nil {
+		return nil, mockNotImplemented("getChatMessagePosition")
+	}
+
+	return mock.GetChatMessagePositionFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatScheduledMessages(ctx context.Context, req *GetChatScheduledMessagesRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.GetChatScheduledMessagesFunc == nil {
+		return nil, mockNotImplemented("getChatScheduledMessages")
+	}
+
+	return mock.GetChatScheduledMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatSponsoredMessages(ctx context.Context, req *GetChatSponsoredMessagesRequest) (*SponsoredMessages, error) {
+	mock.record(req)
+
+	if mock.GetChatSponsoredMessagesFunc == nil {
+		return nil, mockNotImplemented("getChatSponsoredMessages")
+	}
+
+	return mock.GetChatSponsoredMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) ClickChatSponsoredMessage(ctx context.Context, req *ClickChatSponsoredMessageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ClickChatSponsoredMessageFunc == nil {
+		return nil, mockNotImplemented("clickChatSponsoredMessage")
+	}
+
+	return mock.ClickChatSponsoredMessageFunc(ctx, req)
+}
+
+func (mock *Mock) ReportChatSponsoredMessage(ctx context.Context, req *ReportChatSponsoredMessageRequest) (ReportSponsoredResult, error) {
+	mock.record(req)
+
+	if mock.ReportChatSponsoredMessageFunc == nil {
+		return nil, mockNotImplemented("reportChatSponsoredMessage")
+	}
+
+	return mock.ReportChatSponsoredMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetSearchSponsoredChats(ctx context.Context, req *GetSearchSponsoredChatsRequest) (*SponsoredChats, error) {
+	mock.record(req)
+
+	if mock.GetSearchSponsoredChatsFunc == nil {
+		return nil, mockNotImplemented("getSearchSponsoredChats")
+	}
+
+	return mock.GetSearchSponsoredChatsFunc(ctx, req)
+}
+
+func (mock *Mock) ViewSponsoredChat(ctx context.Context, req *ViewSponsoredChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ViewSponsoredChatFunc == nil {
+		return nil, mockNotImplemented("viewSponsoredChat")
+	}
+
+	return mock.ViewSponsoredChatFunc(ctx, req)
+}
+
+func (mock *Mock) OpenSponsoredChat(ctx context.Context, req *OpenSponsoredChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.OpenSponsoredChatFunc == nil {
+		return nil, mockNotImplemented("openSponsoredChat")
+	}
+
+	return mock.OpenSponsoredChatFunc(ctx, req)
+}
+
+func (mock *Mock) ReportSponsoredChat(ctx context.Context, req *ReportSponsoredChatRequest) (ReportSponsoredResult, error) {
+	mock.record(req)
+
+	if mock.ReportSponsoredChatFunc == nil {
+		return nil, mockNotImplemented("reportSponsoredChat")
+	}
+
+	return mock.ReportSponsoredChatFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveNotification(ctx context.Context, req *RemoveNotificationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveNotificationFunc == nil {
+		return nil, mockNotImplemented("removeNotification")
+	}
+
+	return mock.RemoveNotificationFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveNotificationGroup(ctx context.Context, req *RemoveNotificationGroupRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveNotificationGroupFunc == nil {
+		return nil, mockNotImplemented("removeNotificationGroup")
+	}
+
+	return mock.RemoveNotificationGroupFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageLink(ctx context.Context, req *GetMessageLinkRequest) (*MessageLink, error) {
+	mock.record(req)
+
+	if mock.GetMessageLinkFunc == nil {
+		return nil, mockNotImplemented("getMessageLink")
+	}
+
+	return mock.GetMessageLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageEmbeddingCode(ctx context.Context, req *GetMessageEmbeddingCodeRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetMessageEmbeddingCodeFunc == nil {
+		return nil, mockNotImplemented("getMessageEmbeddingCode")
+	}
+
+	return mock.GetMessageEmbeddingCodeFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageLinkInfo(ctx context.Context, req *GetMessageLinkInfoRequest) (*MessageLinkInfo, error) {
+	mock.record(req)
+
+	if mock.GetMessageLinkInfoFunc == nil {
+		return nil, mockNotImplemented("getMessageLinkInfo")
+	}
+
+	return mock.GetMessageLinkInfoFunc(ctx, req)
+}
+
+func (mock *Mock) TranslateText(ctx context.Context, req *TranslateTextRequest) (*FormattedText, error) {
+	mock.record(req)
+
+	if mock.TranslateTextFunc == nil {
+		return nil, mockNotImplemented("translateText")
+	}
+
+	return mock.TranslateTextFunc(ctx, req)
+}
+
+func (mock *Mock) TranslateMessageText(ctx context.Context, req *TranslateMessageTextRequest) (*FormattedText, error) {
+	mock.record(req)
+
+	if mock.TranslateMessageTextFunc == nil {
+		return nil, mockNotImplemented("translateMessageText")
+	}
+
+	return mock.TranslateMessageTextFunc(ctx, req)
+}
+
+func (mock *Mock) RecognizeSpeech(ctx context.Context, req *RecognizeSpeechRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RecognizeSpeechFunc == nil {
+		return nil, mockNotImplemented("recognizeSpeech")
+	}
+
+	return mock.RecognizeSpeechFunc(ctx, req)
+}
+
+func (mock *Mock) RateSpeechRecognition(ctx context.Context, req *RateSpeechRecognitionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RateSpeechRecognitionFunc == nil {
+		return nil, mockNotImplemented("rateSpeechRecognition")
+	}
+
+	return mock.RateSpeechRecognitionFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatAvailableMessageSenders(ctx context.Context, req *GetChatAvailableMessageSendersRequest) (*ChatMessageSenders, error) {
+	mock.record(req)
+
+	if mock.GetChatAvailableMessageSendersFunc == nil {
+		return nil, mockNotImplemented("getChatAvailableMessageSenders")
+	}
+
+	return mock.GetChatAvailableMessageSendersFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatMessageSender(ctx context.Context, req *SetChatMessageSenderRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatMessageSenderFunc == nil {
+		return nil, mockNotImplemented("setChatMessageSender")
+	}
+
+	return mock.SetChatMessageSenderFunc(ctx, req)
+}
+
+func (mock *Mock) SendMessage(ctx context.Context, req *SendMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.SendMessageFunc == nil {
+		return nil, mockNotImplemented("sendMessage")
+	}
+
+	return mock.SendMessageFunc(ctx, req)
+}
+
+func (mock *Mock) SendMessageAlbum(ctx context.Context, req *SendMessageAlbumRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.SendMessageAlbumFunc == nil {
+		return nil, mockNotImplemented("sendMessageAlbum")
+	}
+
+	return mock.SendMessageAlbumFunc(ctx, req)
+}
+
+func (mock *Mock) SendBotStartMessage(ctx context.Context, req *SendBotStartMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.SendBotStartMessageFunc == nil {
+		return nil, mockNotImplemented("sendBotStartMessage")
+	}
+
+	return mock.SendBotStartMessageFunc(ctx, req)
+}
+
+func (mock *Mock) SendInlineQueryResultMessage(ctx context.Context, req *SendInlineQueryResultMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.SendInlineQueryResultMessageFunc == nil {
+		return nil, mockNotImplemented("sendInlineQueryResultMessage")
+	}
+
+	return mock.SendInlineQueryResultMessageFunc(ctx, req)
+}
+
+func (mock *Mock) ForwardMessages(ctx context.Context, req *ForwardMessagesRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.ForwardMessagesFunc == nil {
+		return nil, mockNotImplemented("forwardMessages")
+	}
+
+	return mock.ForwardMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SendQuickReplyShortcutMessages(ctx context.Context, req *SendQuickReplyShortcutMessagesRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.SendQuickReplyShortcutMessagesFunc == nil {
+		return nil, mockNotImplemented("sendQuickReplyShortcutMessages")
+	}
+
+	return mock.SendQuickReplyShortcutMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) ResendMessages(ctx context.Context, req *ResendMessagesRequest) (*Messages, error) {
+	mock.record(req)
+
+	if mock.ResendMessagesFunc == nil {
+		return nil, mockNotImplemented("resendMessages")
+	}
+
+	return mock.ResendMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) AddLocalMessage(ctx context.Context, req *AddLocalMessageRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.AddLocalMessageFunc == nil {
+		return nil, mockNotImplemented("addLocalMessage")
+	}
+
+	return mock.AddLocalMessageFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteMessages(ctx context.Context, req *DeleteMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteMessagesFunc == nil {
+		return nil, mockNotImplemented("deleteMessages")
+	}
+
+	return mock.DeleteMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChatMessagesBySender(ctx context.Context, req *DeleteChatMessagesBySenderRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatMessagesBySenderFunc == nil {
+		return nil, mockNotImplemented("deleteChatMessagesBySender")
+	}
+
+	return mock.DeleteChatMessagesBySenderFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChatMessagesByDate(ctx context.Context, req *DeleteChatMessagesByDateRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatMessagesByDateFunc == nil {
+		return nil, mockNotImplemented("deleteChatMessagesByDate")
+	}
+
+	return mock.DeleteChatMessagesByDateFunc(ctx, req)
+}
+
+func (mock *Mock) EditMessageText(ctx context.Context, req *EditMessageTextRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.EditMessageTextFunc == nil {
+		return nil, mockNotImplemented("editMessageText")
+	}
+
+	return mock.EditMessageTextFunc(ctx, req)
+}
+
+func (mock *Mock) EditMessageLiveLocation(ctx context.Context, req *EditMessageLiveLocationRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.EditMessageLiveLocationFunc == nil {
+		return nil, mockNotImplemented("editMessageLiveLocation")
+	}
+
+	return mock.EditMessageLiveLocationFunc(ctx, req)
+}
+
+func (mock *Mock) EditMessageMedia(ctx context.Context, req *EditMessageMediaRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.EditMessageMediaFunc == nil {
+		return nil, mockNotImplemented("editMessageMedia")
+	}
+
+	return mock.EditMessageMediaFunc(ctx, req)
+}
+
+func (mock *Mock) EditMessageCaption(ctx context.Context, req *EditMessageCaptionRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.EditMessageCaptionFunc == nil {
+		return nil, mockNotImplemented("editMessageCaption")
+	}
+
+	return mock.EditMessageCaptionFunc(ctx, req)
+}
+
+func (mock *Mock) EditMessageReplyMarkup(ctx context.Context, req *EditMessageReplyMarkupRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.EditMessageReplyMarkupFunc == nil {
+		return nil, mockNotImplemented("editMessageReplyMarkup")
+	}
+
+	return mock.EditMessageReplyMarkupFunc(ctx, req)
+}
+
+func (mock *Mock) EditInlineMessageText(ctx context.Context, req *EditInlineMessageTextRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditInlineMessageTextFunc == nil {
+		return nil, mockNotImplemented("editInlineMessageText")
+	}
+
+	return mock.EditInlineMessageTextFunc(ctx, req)
+}
+
+func (mock *Mock) EditInlineMessageLiveLocation(ctx context.Context, req *EditInlineMessageLiveLocationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditInlineMessageLiveLocationFunc == nil {
+		return nil, mockNotImplemented("editInlineMessageLiveLocation")
+	}
+
+	return mock.EditInlineMessageLiveLocationFunc(ctx, req)
+}
+
+func (mock *Mock) EditInlineMessageMedia(ctx context.Context, req *EditInlineMessageMediaRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditInlineMessageMediaFunc == nil {
+		return nil, mockNotImplemented("editInlineMessageMedia")
+	}
+
+	return mock.EditInlineMessageMediaFunc(ctx, req)
+}
+
+func (mock *Mock) EditInlineMessageCaption(ctx context.Context, req *EditInlineMessageCaptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditInlineMessageCaptionFunc == nil {
+		return nil, mockNotImplemented("editInlineMessageCaption")
+	}
+
+	return mock.EditInlineMessageCaptionFunc(ctx, req)
+}
+
+func (mock *Mock) EditInlineMessageReplyMarkup(ctx context.Context, req *EditInlineMessageReplyMarkupRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditInlineMessageReplyMarkupFunc == nil {
+		return nil, mockNotImplemented("editInlineMessageReplyMarkup")
+	}
+
+	return mock.EditInlineMessageReplyMarkupFunc(ctx, req)
+}
+
+func (mock *Mock) EditMessageSchedulingState(ctx context.Context, req *EditMessageSchedulingStateRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditMessageSchedulingStateFunc == nil {
+		return nil, mockNotImplemented("editMessageSchedulingState")
+	}
+
+	return mock.EditMessageSchedulingStateFunc(ctx, req)
+}
+
+func (mock *Mock) SetMessageFactCheck(ctx context.Context, req *SetMessageFactCheckRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetMessageFactCheckFunc == nil {
+		return nil, mockNotImplemented("setMessageFactCheck")
+	}
+
+	return mock.SetMessageFactCheckFunc(ctx, req)
+}
+
+func (mock *Mock) SendBusinessMessage(ctx context.Context, req *SendBusinessMessageRequest) (*BusinessMessage, error) {
+	mock.record(req)
+
+	if mock.SendBusinessMessageFunc == nil {
+		return nil, mockNotImplemented("sendBusinessMessage")
+	}
+
+	return mock.SendBusinessMessageFunc(ctx, req)
+}
+
+func (mock *Mock) SendBusinessMessageAlbum(ctx context.Context, req *SendBusinessMessageAlbumRequest) (*BusinessMessages, error) {
+	mock.record(req)
+
+	if mock.SendBusinessMessageAlbumFunc == nil {
+		return nil, mockNotImplemented("sendBusinessMessageAlbum")
+	}
+
+	return mock.SendBusinessMessageAlbumFunc(ctx, req)
+}
+
+func (mock *Mock) EditBusinessMessageText(ctx context.Context, req *EditBusinessMessageTextRequest) (*BusinessMessage, error) {
+	mock.record(req)
+
+	if mock.EditBusinessMessageTextFunc == nil {
+		return nil, mockNotImplemented("editBusinessMessageText")
+	}
+
+	return mock.EditBusinessMessageTextFunc(ctx, req)
+}
+
+func (mock *Mock) EditBusinessMessageLiveLocation(ctx context.Context, req *EditBusinessMessageLiveLocationRequest) (*BusinessMessage, error) {
+	mock.record(req)
+
+	if mock.EditBusinessMessageLiveLocationFunc == nil {
+		return nil, mockNotImplemented("editBusinessMessageLiveLocation")
+	}
+
+	return mock.EditBusinessMessageLiveLocationFunc(ctx, req)
+}
+
+func (mock *Mock) EditBusinessMessageMedia(ctx context.Context, req *EditBusinessMessageMediaRequest) (*BusinessMessage, error) {
+	mock.record(req)
+
+	if mock.EditBusinessMessageMediaFunc == nil {
+		return nil, mockNotImplemented("editBusinessMessageMedia")
+	}
+
+	return mock.EditBusinessMessageMediaFunc(ctx, req)
+}
+
+func (mock *Mock) EditBusinessMessageCaption(ctx context.Context, req *EditBusinessMessageCaptionRequest) (*BusinessMessage, error) {
+	mock.record(req)
+
+	if mock.EditBusinessMessageCaptionFunc == nil {
+		return nil, mockNotImplemented("editBusinessMessageCaption")
+	}
+
+	return mock.EditBusinessMessageCaptionFunc(ctx, req)
+}
+
+func (mock *Mock) EditBusinessMessageReplyMarkup(ctx context.Context, req *EditBusinessMessageReplyMarkupRequest) (*BusinessMessage, error) {
+	mock.record(req)
+
+	if mock.EditBusinessMessageReplyMarkupFunc == nil {
+		return nil, mockNotImplemented("editBusinessMessageReplyMarkup")
+	}
+
+	return mock.EditBusinessMessageReplyMarkupFunc(ctx, req)
+}
+
+func (mock *Mock) StopBusinessPoll(ctx context.Context, req *StopBusinessPollRequest) (*BusinessMessage, error) {
+	mock.record(req)
+
+	if mock.StopBusinessPollFunc == nil {
+		return nil, mockNotImplemented("stopBusinessPoll")
+	}
+
+	return mock.StopBusinessPollFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessMessageIsPinned(ctx context.Context, req *SetBusinessMessageIsPinnedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessMessageIsPinnedFunc == nil {
+		return nil, mockNotImplemented("setBusinessMessageIsPinned")
+	}
+
+	return mock.SetBusinessMessageIsPinnedFunc(ctx, req)
+}
+
+func (mock *Mock) ReadBusinessMessage(ctx context.Context, req *ReadBusinessMessageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReadBusinessMessageFunc == nil {
+		return nil, mockNotImplemented("readBusinessMessage")
+	}
+
+	return mock.ReadBusinessMessageFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteBusinessMessages(ctx context.Context, req *DeleteBusinessMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteBusinessMessagesFunc == nil {
+		return nil, mockNotImplemented("deleteBusinessMessages")
+	}
+
+	return mock.DeleteBusinessMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) EditBusinessStory(ctx context.Context, req *EditBusinessStoryRequest) (*Story, error) {
+	mock.record(req)
+
+	if mock.EditBusinessStoryFunc == nil {
+		return nil, mockNotImplemented("editBusinessStory")
+	}
+
+	return mock.EditBusinessStoryFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteBusinessStory(ctx context.Context, req *DeleteBusinessStoryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteBusinessStoryFunc == nil {
+		return nil, mockNotImplemented("deleteBusinessStory")
+	}
+
+	return mock.DeleteBusinessStoryFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessAccountName(ctx context.Context, req *SetBusinessAccountNameRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessAccountNameFunc == nil {
+		return nil, mockNotImplemented("setBusinessAccountName")
+	}
+
+	return mock.SetBusinessAccountNameFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessAccountBio(ctx context.Context, req *SetBusinessAccountBioRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessAccountBioFunc == nil {
+		return nil, mockNotImplemented("setBusinessAccountBio")
+	}
+
+	return mock.SetBusinessAccountBioFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessAccountProfilePhoto(ctx context.Context, req *SetBusinessAccountProfilePhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessAccountProfilePhotoFunc == nil {
+		return nil, mockNotImplemented("setBusinessAccountProfilePhoto")
+	}
+
+	return mock.SetBusinessAccountProfilePhotoFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessAccountUsername(ctx context.Context, req *SetBusinessAccountUsernameRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessAccountUsernameFunc == nil {
+		return nil, mockNotImplemented("setBusinessAccountUsername")
+	}
+
+	return mock.SetBusinessAccountUsernameFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessAccountGiftSettings(ctx context.Context, req *SetBusinessAccountGiftSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessAccountGiftSettingsFunc == nil {
+		return nil, mockNotImplemented("setBusinessAccountGiftSettings")
+	}
+
+	return mock.SetBusinessAccountGiftSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) GetBusinessAccountStarAmount(ctx context.Context, req *GetBusinessAccountStarAmountRequest) (*StarAmount, error) {
+	mock.record(req)
+
+	if mock.GetBusinessAccountStarAmountFunc == nil {
+		return nil, mockNotImplemented("getBusinessAccountStarAmount")
+	}
+
+	return mock.GetBusinessAccountStarAmountFunc(ctx, req)
+}
+
+func (mock *Mock) TransferBusinessAccountStars(ctx context.Context, req *TransferBusinessAccountStarsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.TransferBusinessAccountStarsFunc == nil {
+		return nil, mockNotImplemented("transferBusinessAccountStars")
+	}
+
+	return mock.TransferBusinessAccountStarsFunc(ctx, req)
+}
+
+func (mock *Mock) LoadQuickReplyShortcuts(ctx context.Context) (*Ok, error) {
+	mock.record(&LoadQuickReplyShortcutsRequest{})
+
+	if mock.LoadQuickReplyShortcutsFunc == nil {
+		return nil, mockNotImplemented("loadQuickReplyShortcuts")
+	}
+
+	return mock.LoadQuickReplyShortcutsFunc(ctx)
+}
+
+func (mock *Mock) SetQuickReplyShortcutName(ctx context.Context, req *SetQuickReplyShortcutNameRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetQuickReplyShortcutNameFunc == nil {
+		return nil, mockNotImplemented("setQuickReplyShortcutName")
+	}
+
+	return mock.SetQuickReplyShortcutNameFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteQuickReplyShortcut(ctx context.Context, req *DeleteQuickReplyShortcutRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteQuickReplyShortcutFunc == nil {
+		return nil, mockNotImplemented("deleteQuickReplyShortcut")
+	}
+
+	return mock.DeleteQuickReplyShortcutFunc(ctx, req)
+}
+
+func (mock *Mock) ReorderQuickReplyShortcuts(ctx context.Context, req *ReorderQuickReplyShortcutsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReorderQuickReplyShortcutsFunc == nil {
+		return nil, mockNotImplemented("reorderQuickReplyShortcuts")
+	}
+
+	return mock.ReorderQuickReplyShortcutsFunc(ctx, req)
+}
+
+func (mock *Mock) LoadQuickReplyShortcutMessages(ctx context.Context, req *LoadQuickReplyShortcutMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LoadQuickReplyShortcutMessagesFunc == nil {
+		return nil, mockNotImplemented("loadQuickReplyShortcutMessages")
+	}
+
+	return mock.LoadQuickReplyShortcutMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteQuickReplyShortcutMessages(ctx context.Context, req *DeleteQuickReplyShortcutMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteQuickReplyShortcutMessagesFunc == nil {
+		return nil, mockNotImplemented("deleteQuickReplyShortcutMessages")
+	}
+
+	return mock.DeleteQuickReplyShortcutMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) AddQuickReplyShortcutMessage(ctx context.Context, req *AddQuickReplyShortcutMessageRequest) (*QuickReplyMessage, error) {
+	mock.record(req)
+
+	if mock.AddQuickReplyShortcutMessageFunc == nil {
+		return nil, mockNotImplemented("addQuickReplyShortcutMessage")
+	}
+
+	return mock.AddQuickReplyShortcutMessageFunc(ctx, req)
+}
+
+func (mock *Mock) AddQuickReplyShortcutInlineQueryResultMessage(ctx context.Context, req *AddQuickReplyShortcutInlineQueryResultMessageRequest) (*QuickReplyMessage, error) {
+	mock.record(req)
+
+	if mock.AddQuickReplyShortcutInlineQueryResultMessageFunc == nil {
+		return nil, mockNotImplemented("addQuickReplyShortcutInlineQueryResultMessage")
+	}
+
+	return mock.AddQuickReplyShortcutInlineQueryResultMessageFunc(ctx, req)
+}
+
+func (mock *Mock) AddQuickReplyShortcutMessageAlbum(ctx context.Context, req *AddQuickReplyShortcutMessageAlbumRequest) (*QuickReplyMessages, error) {
+	mock.record(req)
+
+	if mock.AddQuickReplyShortcutMessageAlbumFunc == nil {
+		return nil, mockNotImplemented("addQuickReplyShortcutMessageAlbum")
+	}
+
+	return mock.AddQuickReplyShortcutMessageAlbumFunc(ctx, req)
+}
+
+func (mock *Mock) ReaddQuickReplyShortcutMessages(ctx context.Context, req *ReaddQuickReplyShortcutMessagesRequest) (*QuickReplyMessages, error) {
+	mock.record(req)
+
+	if mock.ReaddQuickReplyShortcutMessagesFunc == nil {
+		return nil, mockNotImplemented("readdQuickReplyShortcutMessages")
+	}
+
+	return mock.ReaddQuickReplyShortcutMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) EditQuickReplyMessage(ctx context.Context, req *EditQuickReplyMessageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditQuickReplyMessageFunc == nil {
+		return nil, mockNotImplemented("editQuickReplyMessage")
+	}
+
+	return mock.EditQuickReplyMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetForumTopicDefaultIcons(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetForumTopicDefaultIconsRequest{})
+
+	if mock.GetForumTopicDefaultIconsFunc == nil {
+		return nil, mockNotImplemented("getForumTopicDefaultIcons")
+	}
+
+	return mock.GetForumTopicDefaultIconsFunc(ctx)
+}
+
+func (mock *Mock) CreateForumTopic(ctx context.Context, req *CreateForumTopicRequest) (*ForumTopicInfo, error) {
+	mock.record(req)
+
+	if mock.CreateForumTopicFunc == nil {
+		return nil, mockNotImplemented("createForumTopic")
+	}
+
+	return mock.CreateForumTopicFunc(ctx, req)
+}
+
+func (mock *Mock) EditForumTopic(ctx context.Context, req *EditForumTopicRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditForumTopicFunc == nil {
+		return nil, mockNotImplemented("editForumTopic")
+	}
+
+	return mock.EditForumTopicFunc(ctx, req)
+}
+
+func (mock *Mock) GetForumTopic(ctx context.Context, req *GetForumTopicRequest) (*ForumTopic, error) {
+	mock.record(req)
+
+	if mock.GetForumTopicFunc == nil {
+		return nil, mockNotImplemented("getForumTopic")
+	}
+
+	return mock.GetForumTopicFunc(ctx, req)
+}
+
+func (mock *Mock) GetForumTopicLink(ctx context.Context, req *GetForumTopicLinkRequest) (*MessageLink, error) {
+	mock.record(req)
+
+	if mock.GetForumTopicLinkFunc == nil {
+		return nil, mockNotImplemented("getForumTopicLink")
+	}
+
+	return mock.GetForumTopicLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetForumTopics(ctx context.Context, req *GetForumTopicsRequest) (*ForumTopics, error) {
+	mock.record(req)
+
+	if mock.GetForumTopicsFunc == nil {
+		return nil, mockNotImplemented("getForumTopics")
+	}
+
+	return mock.GetForumTopicsFunc(ctx, req)
+}
+
+func (mock *Mock) SetForumTopicNotificationSettings(ctx context.Context, req *SetForumTopicNotificationSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetForumTopicNotificationSettingsFunc == nil {
+		return nil, mockNotImplemented("setForumTopicNotificationSettings")
+	}
+
+	return mock.SetForumTopicNotificationSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleForumTopicIsClosed(ctx context.Context, req *ToggleForumTopicIsClosedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleForumTopicIsClosedFunc == nil {
+		return nil, mockNotImplemented("toggleForumTopicIsClosed")
+	}
+
+	return mock.ToggleForumTopicIsClosedFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGeneralForumTopicIsHidden(ctx context.Context, req *ToggleGeneralForumTopicIsHiddenRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGeneralForumTopicIsHiddenFunc == nil {
+		return nil, mockNotImplemented("toggleGeneralForumTopicIsHidden")
+	}
+
+	return mock.ToggleGeneralForumTopicIsHiddenFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleForumTopicIsPinned(ctx context.Context, req *ToggleForumTopicIsPinnedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleForumTopicIsPinnedFunc == nil {
+		return nil, mockNotImplemented("toggleForumTopicIsPinned")
+	}
+
+	return mock.ToggleForumTopicIsPinnedFunc(ctx, req)
+}
+
+func (mock *Mock) SetPinnedForumTopics(ctx context.Context, req *SetPinnedForumTopicsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPinnedForumTopicsFunc == nil {
+		return nil, mockNotImplemented("setPinnedForumTopics")
+	}
+
+	return mock.SetPinnedForumTopicsFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteForumTopic(ctx context.Context, req *DeleteForumTopicRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteForumTopicFunc == nil {
+		return nil, mockNotImplemented("deleteForumTopic")
+	}
+
+	return mock.DeleteForumTopicFunc(ctx, req)
+}
+
+func (mock *Mock) GetEmojiReaction(ctx context.Context, req *GetEmojiReactionRequest) (*EmojiReaction, error) {
+	mock.record(req)
+
+	if mock.GetEmojiReactionFunc == nil {
+		return nil, mockNotImplemented("getEmojiReaction")
+	}
+
+	return mock.GetEmojiReactionFunc(ctx, req)
+}
+
+func (mock *Mock) GetCustomEmojiReactionAnimations(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetCustomEmojiReactionAnimationsRequest{})
+
+	if mock.GetCustomEmojiReactionAnimationsFunc == nil {
+		return nil, mockNotImplemented("getCustomEmojiReactionAnimations")
+	}
+
+	return mock.GetCustomEmojiReactionAnimationsFunc(ctx)
+}
+
+func (mock *Mock) GetMessageAvailableReactions(ctx context.Context, req *GetMessageAvailableReactionsRequest) (*AvailableReactions, error) {
+	mock.record(req)
+
+	if mock.GetMessageAvailableReactionsFunc == nil {
+		return nil, mockNotImplemented("getMessageAvailableReactions")
+	}
+
+	return mock.GetMessageAvailableReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) ClearRecentReactions(ctx context.Context) (*Ok, error) {
+	mock.record(&ClearRecentReactionsRequest{})
+
+	if mock.ClearRecentReactionsFunc == nil {
+		return nil, mockNotImplemented("clearRecentReactions")
+	}
+
+	return mock.ClearRecentReactionsFunc(ctx)
+}
+
+func (mock *Mock) AddMessageReaction(ctx context.Context, req *AddMessageReactionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddMessageReactionFunc == nil {
+		return nil, mockNotImplemented("addMessageReaction")
+	}
+
+	return mock.AddMessageReactionFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveMessageReaction(ctx context.Context, req *RemoveMessageReactionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveMessageReactionFunc == nil {
+		return nil, mockNotImplemented("removeMessageReaction")
+	}
+
+	return mock.RemoveMessageReactionFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatAvailablePaidMessageReactionSenders(ctx context.Context, req *GetChatAvailablePaidMessageReactionSendersRequest) (*MessageSenders, error) {
+	mock.record(req)
+
+	if mock.GetChatAvailablePaidMessageReactionSendersFunc == nil {
+		return nil, mockNotImplemented("getChatAvailablePaidMessageReactionSenders")
+	}
+
+	return mock.GetChatAvailablePaidMessageReactionSendersFunc(ctx, req)
+}
+
+func (mock *Mock) AddPendingPaidMessageReaction(ctx context.Context, req *AddPendingPaidMessageReactionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddPendingPaidMessageReactionFunc == nil {
+		return nil, mockNotImplemented("addPendingPaidMessageReaction")
+	}
+
+	return mock.AddPendingPaidMessageReactionFunc(ctx, req)
+}
+
+func (mock *Mock) CommitPendingPaidMessageReactions(ctx context.Context, req *CommitPendingPaidMessageReactionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CommitPendingPaidMessageReactionsFunc == nil {
+		return nil, mockNotImplemented("commitPendingPaidMessageReactions")
+	}
+
+	return mock.CommitPendingPaidMessageReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) RemovePendingPaidMessageReactions(ctx context.Context, req *RemovePendingPaidMessageReactionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemovePendingPaidMessageReactionsFunc == nil {
+		return nil, mockNotImplemented("removePendingPaidMessageReactions")
+	}
+
+	return mock.RemovePendingPaidMessageReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) SetPaidMessageReactionType(ctx context.Context, req *SetPaidMessageReactionTypeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPaidMessageReactionTypeFunc == nil {
+		return nil, mockNotImplemented("setPaidMessageReactionType")
+	}
+
+	return mock.SetPaidMessageReactionTypeFunc(ctx, req)
+}
+
+func (mock *Mock) SetMessageReactions(ctx context.Context, req *SetMessageReactionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetMessageReactionsFunc == nil {
+		return nil, mockNotImplemented("setMessageReactions")
+	}
+
+	return mock.SetMessageReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageAddedReactions(ctx context.Context, req *GetMessageAddedReactionsRequest) (*AddedReactions, error) {
+	mock.record(req)
+
+	if mock.GetMessageAddedReactionsFunc == nil {
+		return nil, mockNotImplemented("getMessageAddedReactions")
+	}
+
+	return mock.GetMessageAddedReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) SetDefaultReactionType(ctx context.Context, req *SetDefaultReactionTypeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetDefaultReactionTypeFunc == nil {
+		return nil, mockNotImplemented("setDefaultReactionType")
+	}
+
+	return mock.SetDefaultReactionTypeFunc(ctx, req)
+}
+
+func (mock *Mock) GetSavedMessagesTags(ctx context.Context, req *GetSavedMessagesTagsRequest) (*SavedMessagesTags, error) {
+	mock.record(req)
+
+	if mock.GetSavedMessagesTagsFunc == nil {
+		return nil, mockNotImplemented("getSavedMessagesTags")
+	}
+
+	return mock.GetSavedMessagesTagsFunc(ctx, req)
+}
+
+func (mock *Mock) SetSavedMessagesTagLabel(ctx context.Context, req *SetSavedMessagesTagLabelRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetSavedMessagesTagLabelFunc == nil {
+		return nil, mockNotImplemented("setSavedMessagesTagLabel")
+	}
+
+	return mock.SetSavedMessagesTagLabelFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageEffect(ctx context.Context, req *GetMessageEffectRequest) (*MessageEffect, error) {
+	mock.record(req)
+
+	if mock.GetMessageEffectFunc == nil {
+		return nil, mockNotImplemented("getMessageEffect")
+	}
+
+	return mock.GetMessageEffectFunc(ctx, req)
+}
+
+func (mock *Mock) SetPollAnswer(ctx context.Context, req *SetPollAnswerRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPollAnswerFunc == nil {
+		return nil, mockNotImplemented("setPollAnswer")
+	}
+
+	return mock.SetPollAnswerFunc(ctx, req)
+}
+
+func (mock *Mock) GetPollVoters(ctx context.Context, req *GetPollVotersRequest) (*MessageSenders, error) {
+	mock.record(req)
+
+	if mock.GetPollVotersFunc == nil {
+		return nil, mockNotImplemented("getPollVoters")
+	}
+
+	return mock.GetPollVotersFunc(ctx, req)
+}
+
+func (mock *Mock) StopPoll(ctx context.Context, req *StopPollRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.StopPollFunc == nil {
+		return nil, mockNotImplemented("stopPoll")
+	}
+
+	return mock.StopPollFunc(ctx, req)
+}
+
+func (mock *Mock) HideSuggestedAction(ctx context.Context, req *HideSuggestedActionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.HideSuggestedActionFunc == nil {
+		return nil, mockNotImplemented("hideSuggestedAction")
+	}
+
+	return mock.HideSuggestedActionFunc(ctx, req)
+}
+
+func (mock *Mock) HideContactCloseBirthdays(ctx context.Context) (*Ok, error) {
+	mock.record(&HideContactCloseBirthdaysRequest{})
+
+	if mock.HideContactCloseBirthdaysFunc == nil {
+		return nil, mockNotImplemented("hideContactCloseBirthdays")
+	}
+
+	return mock.HideContactCloseBirthdaysFunc(ctx)
+}
+
+func (mock *Mock) GetBusinessConnection(ctx context.Context, req *GetBusinessConnectionRequest) (*BusinessConnection, error) {
+	mock.record(req)
+
+	if mock.GetBusinessConnectionFunc == nil {
+		return nil, mockNotImplemented("getBusinessConnection")
+	}
+
+	return mock.GetBusinessConnectionFunc(ctx, req)
+}
+
+func (mock *Mock) GetLoginUrlInfo(ctx context.Context, req *GetLoginUrlInfoRequest) (LoginUrlInfo, error) {
+	mock.record(req)
+
+	if mock.GetLoginUrlInfoFunc == nil {
+		return nil, mockNotImplemented("getLoginUrlInfo")
+	}
+
+	return mock.GetLoginUrlInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetLoginUrl(ctx context.Context, req *GetLoginUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetLoginUrlFunc == nil {
+		return nil, mockNotImplemented("getLoginUrl")
+	}
+
+	return mock.GetLoginUrlFunc(ctx, req)
+}
+
+func (mock *Mock) ShareUsersWithBot(ctx context.Context, req *ShareUsersWithBotRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ShareUsersWithBotFunc == nil {
+		return nil, mockNotImplemented("shareUsersWithBot")
+	}
+
+	return mock.ShareUsersWithBotFunc(ctx, req)
+}
+
+func (mock *Mock) ShareChatWithBot(ctx context.Context, req *ShareChatWithBotRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ShareChatWithBotFunc == nil {
+		return nil, mockNotImplemented("shareChatWithBot")
+	}
+
+	return mock.ShareChatWithBotFunc(ctx, req)
+}
+
+func (mock *Mock) GetInlineQueryResults(ctx context.Context, req *GetInlineQueryResultsRequest) (*InlineQueryResults, error) {
+	mock.record(req)
+
+	if mock.GetInlineQueryResultsFunc == nil {
+		return nil, mockNotImplemented("getInlineQueryResults")
+	}
+
+	return mock.GetInlineQueryResultsFunc(ctx, req)
+}
+
+func (mock *Mock) AnswerInlineQuery(ctx context.Context, req *AnswerInlineQueryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AnswerInlineQueryFunc == nil {
+		return nil, mockNotImplemented("answerInlineQuery")
+	}
+
+	return mock.AnswerInlineQueryFunc(ctx, req)
+}
+
+func (mock *Mock) SavePreparedInlineMessage(ctx context.Context, req *SavePreparedInlineMessageRequest) (*PreparedInlineMessageId, error) {
+	mock.record(req)
+
+	if mock.SavePreparedInlineMessageFunc == nil {
+		return nil, mockNotImplemented("savePreparedInlineMessage")
+	}
+
+	return mock.SavePreparedInlineMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetPreparedInlineMessage(ctx context.Context, req *GetPreparedInlineMessageRequest) (*PreparedInlineMessage, error) {
+	mock.record(req)
+
+	if mock.GetPreparedInlineMessageFunc == nil {
+		return nil, mockNotImplemented("getPreparedInlineMessage")
+	}
+
+	return mock.GetPreparedInlineMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetGrossingWebAppBots(ctx context.Context, req *GetGrossingWebAppBotsRequest) (*FoundUsers, error) {
+	mock.record(req)
+
+	if mock.GetGrossingWebAppBotsFunc == nil {
+		return nil, mockNotImplemented("getGrossingWebAppBots")
+	}
+
+	return mock.GetGrossingWebAppBotsFunc(ctx, req)
+}
+
+func (mock *Mock) SearchWebApp(ctx context.Context, req *SearchWebAppRequest) (*FoundWebApp, error) {
+	mock.record(req)
+
+	if mock.SearchWebAppFunc == nil {
+		return nil, mockNotImplemented("searchWebApp")
+	}
+
+	return mock.SearchWebAppFunc(ctx, req)
+}
+
+func (mock *Mock) GetWebAppPlaceholder(ctx context.Context, req *GetWebAppPlaceholderRequest) (*Outline, error) {
+	mock.record(req)
+
+	if mock.GetWebAppPlaceholderFunc == nil {
+		return nil, mockNotImplemented("getWebAppPlaceholder")
+	}
+
+	return mock.GetWebAppPlaceholderFunc(ctx, req)
+}
+
+func (mock *Mock) GetWebAppLinkUrl(ctx context.Context, req *GetWebAppLinkUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetWebAppLinkUrlFunc == nil {
+		return nil, mockNotImplemented("getWebAppLinkUrl")
+	}
+
+	return mock.GetWebAppLinkUrlFunc(ctx, req)
+}
+
+func (mock *Mock) GetMainWebApp(ctx context.Context, req *GetMainWebAppRequest) (*MainWebApp, error) {
+	mock.record(req)
+
+	if mock.GetMainWebAppFunc == nil {
+		return nil, mockNotImplemented("getMainWebApp")
+	}
+
+	return mock.GetMainWebAppFunc(ctx, req)
+}
+
+func (mock *Mock) GetWebAppUrl(ctx context.Context, req *GetWebAppUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetWebAppUrlFunc == nil {
+		return nil, mockNotImplemented("getWebAppUrl")
+	}
+
+	return mock.GetWebAppUrlFunc(ctx, req)
+}
+
+func (mock *Mock) SendWebAppData(ctx context.Context, req *SendWebAppDataRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendWebAppDataFunc == nil {
+		return nil, mockNotImplemented("sendWebAppData")
+	}
+
+	return mock.SendWebAppDataFunc(ctx, req)
+}
+
+func (mock *Mock) OpenWebApp(ctx context.Context, req *OpenWebAppRequest) (*WebAppInfo, error) {
+	mock.record(req)
+
+	if mock.OpenWebAppFunc == nil {
+		return nil, mockNotImplemented("openWebApp")
+	}
+
+	return mock.OpenWebAppFunc(ctx, req)
+}
+
+func (mock *Mock) CloseWebApp(ctx context.Context, req *CloseWebAppRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CloseWebAppFunc == nil {
+		return nil, mockNotImplemented("closeWebApp")
+	}
+
+	return mock.CloseWebAppFunc(ctx, req)
+}
+
+func (mock *Mock) AnswerWebAppQuery(ctx context.Context, req *AnswerWebAppQueryRequest) (*SentWebAppMessage, error) {
+	mock.record(req)
+
+	if mock.AnswerWebAppQueryFunc == nil {
+		return nil, mockNotImplemented("answerWebAppQuery")
+	}
+
+	return mock.AnswerWebAppQueryFunc(ctx, req)
+}
+
+func (mock *Mock) CheckWebAppFileDownload(ctx context.Context, req *CheckWebAppFileDownloadRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckWebAppFileDownloadFunc == nil {
+		return nil, mockNotImplemented("checkWebAppFileDownload")
+	}
+
+	return mock.CheckWebAppFileDownloadFunc(ctx, req)
+}
+
+func (mock *Mock) GetCallbackQueryAnswer(ctx context.Context, req *GetCallbackQueryAnswerRequest) (*CallbackQueryAnswer, error) {
+	mock.record(req)
+
+	if mock.GetCallbackQueryAnswerFunc == nil {
+		return nil, mockNotImplemented("getCallbackQueryAnswer")
+	}
+
+	return mock.GetCallbackQueryAnswerFunc(ctx, req)
+}
+
+func (mock *Mock) AnswerCallbackQuery(ctx context.Context, req *AnswerCallbackQueryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AnswerCallbackQueryFunc == nil {
+		return nil, mockNotImplemented("answerCallbackQuery")
+	}
+
+	return mock.AnswerCallbackQueryFunc(ctx, req)
+}
+
+func (mock *Mock) AnswerShippingQuery(ctx context.Context, req *AnswerShippingQueryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AnswerShippingQueryFunc == nil {
+		return nil, mockNotImplemented("answerShippingQuery")
+	}
+
+	return mock.AnswerShippingQueryFunc(ctx, req)
+}
+
+func (mock *Mock) AnswerPreCheckoutQuery(ctx context.Context, req *AnswerPreCheckoutQueryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AnswerPreCheckoutQueryFunc == nil {
+		return nil, mockNotImplemented("answerPreCheckoutQuery")
+	}
+
+	return mock.AnswerPreCheckoutQueryFunc(ctx, req)
+}
+
+func (mock *Mock) SetGameScore(ctx context.Context, req *SetGameScoreRequest) (*Message, error) {
+	mock.record(req)
+
+	if mock.SetGameScoreFunc == nil {
+		return nil, mockNotImplemented("setGameScore")
+	}
+
+	return mock.SetGameScoreFunc(ctx, req)
+}
+
+func (mock *Mock) SetInlineGameScore(ctx context.Context, req *SetInlineGameScoreRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetInlineGameScoreFunc == nil {
+		return nil, mockNotImplemented("setInlineGameScore")
+	}
+
+	return mock.SetInlineGameScoreFunc(ctx, req)
+}
+
+func (mock *Mock) GetGameHighScores(ctx context.Context, req *GetGameHighScoresRequest) (*GameHighScores, error) {
+	mock.record(req)
+
+	if mock.GetGameHighScoresFunc == nil {
+		return nil, mockNotImplemented("getGameHighScores")
+	}
+
+	return mock.GetGameHighScoresFunc(ctx, req)
+}
+
+func (mock *Mock) GetInlineGameHighScores(ctx context.Context, req *GetInlineGameHighScoresRequest) (*GameHighScores, error) {
+	mock.record(req)
+
+	if mock.GetInlineGameHighScoresFunc == nil {
+		return nil, mockNotImplemented("getInlineGameHighScores")
+	}
+
+	return mock.GetInlineGameHighScoresFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChatReplyMarkup(ctx context.Context, req *DeleteChatReplyMarkupRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatReplyMarkupFunc == nil {
+		return nil, mockNotImplemented("deleteChatReplyMarkup")
+	}
+
+	return mock.DeleteChatReplyMarkupFunc(ctx, req)
+}
+
+func (mock *Mock) SendChatAction(ctx context.Context, req *SendChatActionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendChatActionFunc == nil {
+		return nil, mockNotImplemented("sendChatAction")
+	}
+
+	return mock.SendChatActionFunc(ctx, req)
+}
+
+func (mock *Mock) OpenChat(ctx context.Context, req *OpenChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.OpenChatFunc == nil {
+		return nil, mockNotImplemented("openChat")
+	}
+
+	return mock.OpenChatFunc(ctx, req)
+}
+
+func (mock *Mock) CloseChat(ctx context.Context, req *CloseChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CloseChatFunc == nil {
+		return nil, mockNotImplemented("closeChat")
+	}
+
+	return mock.CloseChatFunc(ctx, req)
+}
+
+func (mock *Mock) ViewMessages(ctx context.Context, req *ViewMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ViewMessagesFunc == nil {
+		return nil, mockNotImplemented("viewMessages")
+	}
+
+	return mock.ViewMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) OpenMessageContent(ctx context.Context, req *OpenMessageContentRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.OpenMessageContentFunc == nil {
+		return nil, mockNotImplemented("openMessageContent")
+	}
+
+	return mock.OpenMessageContentFunc(ctx, req)
+}
+
+func (mock *Mock) ClickAnimatedEmojiMessage(ctx context.Context, req *ClickAnimatedEmojiMessageRequest) (*Sticker, error) {
+	mock.record(req)
+
+	if mock.ClickAnimatedEmojiMessageFunc == nil {
+		return nil, mockNotImplemented("clickAnimatedEmojiMessage")
+	}
+
+	return mock.ClickAnimatedEmojiMessageFunc(ctx, req)
+}
+
+func (mock *Mock) GetInternalLink(ctx context.Context, req *GetInternalLinkRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetInternalLinkFunc == nil {
+		return nil, mockNotImplemented("getInternalLink")
+	}
+
+	return mock.GetInternalLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetInternalLinkType(ctx context.Context, req *GetInternalLinkTypeRequest) (InternalLinkType, error) {
+	mock.record(req)
+
+	if mock.GetInternalLinkTypeFunc == nil {
+		return nil, mockNotImplemented("getInternalLinkType")
+	}
+
+	return mock.GetInternalLinkTypeFunc(ctx, req)
+}
+
+func (mock *Mock) GetExternalLinkInfo(ctx context.Context, req *GetExternalLinkInfoRequest) (LoginUrlInfo, error) {
+	mock.record(req)
+
+	if mock.GetExternalLinkInfoFunc == nil {
+		return nil, mockNotImplemented("getExternalLinkInfo")
+	}
+
+	return mock.GetExternalLinkInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetExternalLink(ctx context.Context, req *GetExternalLinkRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetExternalLinkFunc == nil {
+		return nil, mockNotImplemented("getExternalLink")
+	}
+
+	return mock.GetExternalLinkFunc(ctx, req)
+}
+
+func (mock *Mock) ReadAllChatMentions(ctx context.Context, req *ReadAllChatMentionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReadAllChatMentionsFunc == nil {
+		return nil, mockNotImplemented("readAllChatMentions")
+	}
+
+	return mock.ReadAllChatMentionsFunc(ctx, req)
+}
+
+func (mock *Mock) ReadAllMessageThreadMentions(ctx context.Context, req *ReadAllMessageThreadMentionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReadAllMessageThreadMentionsFunc == nil {
+		return nil, mockNotImplemented("readAllMessageThreadMentions")
+	}
+
+	return mock.ReadAllMessageThreadMentionsFunc(ctx, req)
+}
+
+func (mock *Mock) ReadAllChatReactions(ctx context.Context, req *ReadAllChatReactionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReadAllChatReactionsFunc == nil {
+		return nil, mockNotImplemented("readAllChatReactions")
+	}
+
+	return mock.ReadAllChatReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) ReadAllMessageThreadReactions(ctx context.Context, req *ReadAllMessageThreadReactionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReadAllMessageThreadReactionsFunc == nil {
+		return nil, mockNotImplemented("readAllMessageThreadReactions")
+	}
+
+	return mock.ReadAllMessageThreadReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) CreatePrivateChat(ctx context.Context, req *CreatePrivateChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.CreatePrivateChatFunc == nil {
+		return nil, mockNotImplemented("createPrivateChat")
+	}
+
+	return mock.CreatePrivateChatFunc(ctx, req)
+}
+
+func (mock *Mock) CreateBasicGroupChat(ctx context.Context, req *CreateBasicGroupChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.CreateBasicGroupChatFunc == nil {
+		return nil, mockNotImplemented("createBasicGroupChat")
+	}
+
+	return mock.CreateBasicGroupChatFunc(ctx, req)
+}
+
+func (mock *Mock) CreateSupergroupChat(ctx context.Context, req *CreateSupergroupChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.CreateSupergroupChatFunc == nil {
+		return nil, mockNotImplemented("createSupergroupChat")
+	}
+
+	return mock.CreateSupergroupChatFunc(ctx, req)
+}
+
+func (mock *Mock) CreateSecretChat(ctx context.Context, req *CreateSecretChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.CreateSecretChatFunc == nil {
+		return nil, mockNotImplemented("createSecretChat")
+	}
+
+	return mock.CreateSecretChatFunc(ctx, req)
+}
+
+func (mock *Mock) CreateNewBasicGroupChat(ctx context.Context, req *CreateNewBasicGroupChatRequest) (*CreatedBasicGroupChat, error) {
+	mock.record(req)
+
+	if mock.CreateNewBasicGroupChatFunc == nil {
+		return nil, mockNotImplemented("createNewBasicGroupChat")
+	}
+
+	return mock.CreateNewBasicGroupChatFunc(ctx, req)
+}
+
+func (mock *Mock) CreateNewSupergroupChat(ctx context.Context, req *CreateNewSupergroupChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.CreateNewSupergroupChatFunc == nil {
+		return nil, mockNotImplemented("createNewSupergroupChat")
+	}
+
+	return mock.CreateNewSupergroupChatFunc(ctx, req)
+}
+
+func (mock *Mock) CreateNewSecretChat(ctx context.Context, req *CreateNewSecretChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.CreateNewSecretChatFunc == nil {
+		return nil, mockNotImplemented("createNewSecretChat")
+	}
+
+	return mock.CreateNewSecretChatFunc(ctx, req)
+}
+
+func (mock *Mock) UpgradeBasicGroupChatToSupergroupChat(ctx context.Context, req *UpgradeBasicGroupChatToSupergroupChatRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.UpgradeBasicGroupChatToSupergroupChatFunc == nil {
+		return nil, mockNotImplemented("upgradeBasicGroupChatToSupergroupChat")
+	}
+
+	return mock.UpgradeBasicGroupChatToSupergroupChatFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatListsToAddChat(ctx context.Context, req *GetChatListsToAddChatRequest) (*ChatLists, error) {
+	mock.record(req)
+
+	if mock.GetChatListsToAddChatFunc == nil {
+		return nil, mockNotImplemented("getChatListsToAddChat")
+	}
+
+	return mock.GetChatListsToAddChatFunc(ctx, req)
+}
+
+func (mock *Mock) AddChatToList(ctx context.Context, req *AddChatToListRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddChatToListFunc == nil {
+		return nil, mockNotImplemented("addChatToList")
+	}
+
+	return mock.AddChatToListFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatFolder(ctx context.Context, req *GetChatFolderRequest) (*ChatFolder, error) {
+	mock.record(req)
+
+	if mock.GetChatFolderFunc == nil {
+		return nil, mockNotImplemented("getChatFolder")
+	}
+
+	return mock.GetChatFolderFunc(ctx, req)
+}
+
+func (mock *Mock) CreateChatFolder(ctx context.Context, req *CreateChatFolderRequest) (*ChatFolderInfo, error) {
+	mock.record(req)
+
+	if mock.CreateChatFolderFunc == nil {
+		return nil, mockNotImplemented("createChatFolder")
+	}
+
+	return mock.CreateChatFolderFunc(ctx, req)
+}
+
+func (mock *Mock) EditChatFolder(ctx context.Context, req *EditChatFolderRequest) (*ChatFolderInfo, error) {
+	mock.record(req)
+
+	if mock.EditChatFolderFunc == nil {
+		return nil, mockNotImplemented("editChatFolder")
+	}
+
+	return mock.EditChatFolderFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChatFolder(ctx context.Context, req *DeleteChatFolderRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatFolderFunc == nil {
+		return nil, mockNotImplemented("deleteChatFolder")
+	}
+
+	return mock.DeleteChatFolderFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatFolderChatsToLeave(ctx context.Context, req *GetChatFolderChatsToLeaveRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetChatFolderChatsToLeaveFunc == nil {
+		return nil, mockNotImplemented("getChatFolderChatsToLeave")
+	}
+
+	return mock.GetChatFolderChatsToLeaveFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatFolderChatCount(ctx context.Context, req *GetChatFolderChatCountRequest) (*Count, error) {
+	mock.record(req)
+
+	if mock.GetChatFolderChatCountFunc == nil {
+		return nil, mockNotImplemented("getChatFolderChatCount")
+	}
+
+	return mock.GetChatFolderChatCountFunc(ctx, req)
+}
+
+func (mock *Mock) ReorderChatFolders(ctx context.Context, req *ReorderChatFoldersRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReorderChatFoldersFunc == nil {
+		return nil, mockNotImplemented("reorderChatFolders")
+	}
+
+	return mock.ReorderChatFoldersFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleChatFolderTags(ctx context.Context, req *ToggleChatFolderTagsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatFolderTagsFunc == nil {
+		return nil, mockNotImplemented("toggleChatFolderTags")
+	}
+
+	return mock.ToggleChatFolderTagsFunc(ctx, req)
+}
+
+func (mock *Mock) GetRecommendedChatFolders(ctx context.Context) (*RecommendedChatFolders, error) {
+	mock.record(&GetRecommendedChatFoldersRequest{})
+
+	if mock.GetRecommendedChatFoldersFunc == nil {
+		return nil, mockNotImplemented("getRecommendedChatFolders")
+	}
+
+	return mock.GetRecommendedChatFoldersFunc(ctx)
+}
+
+func (mock *Mock) GetChatsForChatFolderInviteLink(ctx context.Context, req *GetChatsForChatFolderInviteLinkRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetChatsForChatFolderInviteLinkFunc == nil {
+		return nil, mockNotImplemented("getChatsForChatFolderInviteLink")
+	}
+
+	return mock.GetChatsForChatFolderInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) CreateChatFolderInviteLink(ctx context.Context, req *CreateChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
+	mock.record(req)
+
+	if mock.CreateChatFolderInviteLinkFunc == nil {
+		return nil, mockNotImplemented("createChatFolderInviteLink")
+	}
+
+	return mock.CreateChatFolderInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatFolderInviteLinks(ctx context.Context, req *GetChatFolderInviteLinksRequest) (*ChatFolderInviteLinks, error) {
+	mock.record(req)
+
+	if mock.GetChatFolderInviteLinksFunc == nil {
+		return nil, mockNotImplemented("getChatFolderInviteLinks")
+	}
+
+	return mock.GetChatFolderInviteLinksFunc(ctx, req)
+}
+
+func (mock *Mock) EditChatFolderInviteLink(ctx context.Context, req *EditChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
+	mock.record(req)
+
+	if mock.EditChatFolderInviteLinkFunc == nil {
+		return nil, mockNotImplemented("editChatFolderInviteLink")
+	}
+
+	return mock.EditChatFolderInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChatFolderInviteLink(ctx context.Context, req *DeleteChatFolderInviteLinkRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatFolderInviteLinkFunc == nil {
+		return nil, mockNotImplemented("deleteChatFolderInviteLink")
+	}
+
+	return mock.DeleteChatFolderInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) CheckChatFolderInviteLink(ctx context.Context, req *CheckChatFolderInviteLinkRequest) (*ChatFolderInviteLinkInfo, error) {
+	mock.record(req)
+
+	if mock.CheckChatFolderInviteLinkFunc == nil {
+		return nil, mockNotImplemented("checkChatFolderInviteLink")
+	}
+
+	return mock.CheckChatFolderInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) AddChatFolderByInviteLink(ctx context.Context, req *AddChatFolderByInviteLinkRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddChatFolderByInviteLinkFunc == nil {
+		return nil, mockNotImplemented("addChatFolderByInviteLink")
+	}
+
+	return mock.AddChatFolderByInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatFolderNewChats(ctx context.Context, req *GetChatFolderNewChatsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetChatFolderNewChatsFunc == nil {
+		return nil, mockNotImplemented("getChatFolderNewChats")
+	}
+
+	return mock.GetChatFolderNewChatsFunc(ctx, req)
+}
+
+func (mock *Mock) ProcessChatFolderNewChats(ctx context.Context, req *ProcessChatFolderNewChatsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ProcessChatFolderNewChatsFunc == nil {
+		return nil, mockNotImplemented("processChatFolderNewChats")
+	}
+
+	return mock.ProcessChatFolderNewChatsFunc(ctx, req)
+}
+
+func (mock *Mock) GetArchiveChatListSettings(ctx context.Context) (*ArchiveChatListSettings, error) {
+	mock.record(&GetArchiveChatListSettingsRequest{})
+
+	if mock.GetArchiveChatListSettingsFunc == nil {
+		return nil, mockNotImplemented("getArchiveChatListSettings")
+	}
+
+	return mock.GetArchiveChatListSettingsFunc(ctx)
+}
+
+func (mock *Mock) SetArchiveChatListSettings(ctx context.Context, req *SetArchiveChatListSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetArchiveChatListSettingsFunc == nil {
+		return nil, mockNotImplemented("setArchiveChatListSettings")
+	}
+
+	return mock.SetArchiveChatListSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatTitle(ctx context.Context, req *SetChatTitleRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatTitleFunc == nil {
+		return nil, mockNotImplemented("setChatTitle")
+	}
+
+	return mock.SetChatTitleFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatPhoto(ctx context.Context, req *SetChatPhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatPhotoFunc == nil {
+		return nil, mockNotImplemented("setChatPhoto")
+	}
+
+	return mock.SetChatPhotoFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatAccentColor(ctx context.Context, req *SetChatAccentColorRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatAccentColorFunc == nil {
+		return nil, mockNotImplemented("setChatAccentColor")
+	}
+
+	return mock.SetChatAccentColorFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatProfileAccentColor(ctx context.Context, req *SetChatProfileAccentColorRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatProfileAccentColorFunc == nil {
+		return nil, mockNotImplemented("setChatProfileAccentColor")
+	}
+
+	return mock.SetChatProfileAccentColorFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatMessageAutoDeleteTime(ctx context.Context, req *SetChatMessageAutoDeleteTimeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatMessageAutoDeleteTimeFunc == nil {
+		return nil, mockNotImplemented("setChatMessageAutoDeleteTime")
+	}
+
+	return mock.SetChatMessageAutoDeleteTimeFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatEmojiStatus(ctx context.Context, req *SetChatEmojiStatusRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatEmojiStatusFunc == nil {
+		return nil, mockNotImplemented("setChatEmojiStatus")
+	}
+
+	return mock.SetChatEmojiStatusFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatPermissions(ctx context.Context, req *SetChatPermissionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatPermissionsFunc == nil {
+		return nil, mockNotImplemented("setChatPermissions")
+	}
+
+	return mock.SetChatPermissionsFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatBackground(ctx context.Context, req *SetChatBackgroundRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatBackgroundFunc == nil {
+		return nil, mockNotImplemented("setChatBackground")
+	}
+
+	return mock.SetChatBackgroundFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteChatBackground(ctx context.Context, req *DeleteChatBackgroundRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteChatBackgroundFunc == nil {
+		return nil, mockNotImplemented("deleteChatBackground")
+	}
+
+	return mock.DeleteChatBackgroundFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatTheme(ctx context.Context, req *SetChatThemeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatThemeFunc == nil {
+		return nil, mockNotImplemented("setChatTheme")
+	}
+
+	return mock.SetChatThemeFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatDraftMessage(ctx context.Context, req *SetChatDraftMessageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatDraftMessageFunc == nil {
+		return nil, mockNotImplemented("setChatDraftMessage")
+	}
+
+	return mock.SetChatDraftMessageFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatNotificationSettings(ctx context.Context, req *SetChatNotificationSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatNotificationSettingsFunc == nil {
+		return nil, mockNotImplemented("setChatNotificationSettings")
+	}
+
+	return mock.SetChatNotificationSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleChatHasProtectedContent(ctx context.Context, req *ToggleChatHasProtectedContentRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatHasProtectedContentFunc == nil {
+		return nil, mockNotImplemented("toggleChatHasProtectedContent")
+	}
+
+	return mock.ToggleChatHasProtectedContentFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleChatViewAsTopics(ctx context.Context, req *ToggleChatViewAsTopicsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatViewAsTopicsFunc == nil {
+		return nil, mockNotImplemented("toggleChatViewAsTopics")
+	}
+
+	return mock.ToggleChatViewAsTopicsFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleChatIsTranslatable(ctx context.Context, req *ToggleChatIsTranslatableRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatIsTranslatableFunc == nil {
+		return nil, mockNotImplemented("toggleChatIsTranslatable")
+	}
+
+	return mock.ToggleChatIsTranslatableFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleChatIsMarkedAsUnread(ctx context.Context, req *ToggleChatIsMarkedAsUnreadRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatIsMarkedAsUnreadFunc == nil {
+		return nil, mockNotImplemented("toggleChatIsMarkedAsUnread")
+	}
+
+	return mock.ToggleChatIsMarkedAsUnreadFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleChatDefaultDisableNotification(ctx context.Context, req *ToggleChatDefaultDisableNotificationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatDefaultDisableNotificationFunc == nil {
+		return nil, mockNotImplemented("toggleChatDefaultDisableNotification")
+	}
+
+	return mock.ToggleChatDefaultDisableNotificationFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatAvailableReactions(ctx context.Context, req *SetChatAvailableReactionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatAvailableReactionsFunc == nil {
+		return nil, mockNotImplemented("setChatAvailableReactions")
+	}
+
+	return mock.SetChatAvailableReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatClientData(ctx context.Context, req *SetChatClientDataRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatClientDataFunc == nil {
+		return nil, mockNotImplemented("setChatClientData")
+	}
+
+	return mock.SetChatClientDataFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatDescription(ctx context.Context, req *SetChatDescriptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatDescriptionFunc == nil {
+		return nil, mockNotImplemented("setChatDescription")
+	}
+
+	return mock.SetChatDescriptionFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatDiscussionGroup(ctx context.Context, req *SetChatDiscussionGroupRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatDiscussionGroupFunc == nil {
+		return nil, mockNotImplemented("setChatDiscussionGroup")
+	}
+
+	return mock.SetChatDiscussionGroupFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatLocation(ctx context.Context, req *SetChatLocationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatLocationFunc == nil {
+		return nil, mockNotImplemented("setChatLocation")
+	}
+
+	return mock.SetChatLocationFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatSlowModeDelay(ctx context.Context, req *SetChatSlowModeDelayRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatSlowModeDelayFunc == nil {
+		return nil, mockNotImplemented("setChatSlowModeDelay")
+	}
+
+	return mock.SetChatSlowModeDelayFunc(ctx, req)
+}
+
+func (mock *Mock) PinChatMessage(ctx context.Context, req *PinChatMessageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.PinChatMessageFunc == nil {
+		return nil, mockNotImplemented("pinChatMessage")
+	}
+
+	return mock.PinChatMessageFunc(ctx, req)
+}
+
+func (mock *Mock) UnpinChatMessage(ctx context.Context, req *UnpinChatMessageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.UnpinChatMessageFunc == nil {
+		return nil, mockNotImplemented("unpinChatMessage")
+	}
+
+	return mock.UnpinChatMessageFunc(ctx, req)
+}
+
+func (mock *Mock) UnpinAllChatMessages(ctx context.Context, req *UnpinAllChatMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.UnpinAllChatMessagesFunc == nil {
+		return nil, mockNotImplemented("unpinAllChatMessages")
+	}
+
+	return mock.UnpinAllChatMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) UnpinAllMessageThreadMessages(ctx context.Context, req *UnpinAllMessageThreadMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.UnpinAllMessageThreadMessagesFunc == nil {
+		return nil, mockNotImplemented("unpinAllMessageThreadMessages")
+	}
+
+	return mock.UnpinAllMessageThreadMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) JoinChat(ctx context.Context, req *JoinChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.JoinChatFunc == nil {
+		return nil, mockNotImplemented("joinChat")
+	}
+
+	return mock.JoinChatFunc(ctx, req)
+}
+
+func (mock *Mock) LeaveChat(ctx context.Context, req *LeaveChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LeaveChatFunc == nil {
+		return nil, mockNotImplemented("leaveChat")
+	}
+
+	return mock.LeaveChatFunc(ctx, req)
+}
+
+func (mock *Mock) AddChatMember(ctx context.Context, req *AddChatMemberRequest) (*FailedToAddMembers, error) {
+	mock.record(req)
+
+	if mock.AddChatMemberFunc == nil {
+		return nil, mockNotImplemented("addChatMember")
+	}
+
+	return mock.AddChatMemberFunc(ctx, req)
+}
+
+func (mock *Mock) AddChatMembers(ctx context.Context, req *AddChatMembersRequest) (*FailedToAddMembers, error) {
+	mock.record(req)
+
+	if mock.AddChatMembersFunc == nil {
+		return nil, mockNotImplemented("addChatMembers")
+	}
+
+	return mock.AddChatMembersFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatMemberStatus(ctx context.Context, req *SetChatMemberStatusRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatMemberStatusFunc == nil {
+		return nil, mockNotImplemented("setChatMemberStatus")
+	}
+
+	return mock.SetChatMemberStatusFunc(ctx, req)
+}
+
+func (mock *Mock) BanChatMember(ctx context.Context, req *BanChatMemberRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.BanChatMemberFunc == nil {
+		return nil, mockNotImplemented("banChatMember")
+	}
+
+	return mock.BanChatMemberFunc(ctx, req)
+}
+
+func (mock *Mock) CanTransferOwnership(ctx context.Context) (CanTransferOwnershipResult, error) {
+	mock.record(&CanTransferOwnershipRequest{})
+
+	if mock.CanTransferOwnershipFunc == nil {
+		return nil, mockNotImplemented("canTransferOwnership")
+	}
+
+	return mock.CanTransferOwnershipFunc(ctx)
+}
+
+func (mock *Mock) TransferChatOwnership(ctx context.Context, req *TransferChatOwnershipRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.TransferChatOwnershipFunc == nil {
+		return nil, mockNotImplemented("transferChatOwnership")
+	}
+
+	return mock.TransferChatOwnershipFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatMember(ctx context.Context, req *GetChatMemberRequest) (*ChatMember, error) {
+	mock.record(req)
+
+	if mock.GetChatMemberFunc == nil {
+		return nil, mockNotImplemented("getChatMember")
+	}
+
+	return mock.GetChatMemberFunc(ctx, req)
+}
+
+func (mock *Mock) SearchChatMembers(ctx context.Context, req *SearchChatMembersRequest) (*ChatMembers, error) {
+	mock.record(req)
+
+	if mock.SearchChatMembersFunc == nil {
+		return nil, mockNotImplemented("searchChatMembers")
+	}
+
+	return mock.SearchChatMembersFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatAdministrators(ctx context.Context, req *GetChatAdministratorsRequest) (*ChatAdministrators, error) {
+	mock.record(req)
+
+	if mock.GetChatAdministratorsFunc == nil {
+		return nil, mockNotImplemented("getChatAdministrators")
+	}
+
+	return mock.GetChatAdministratorsFunc(ctx, req)
+}
+
+func (mock *Mock) ClearAllDraftMessages(ctx context.Context, req *ClearAllDraftMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ClearAllDraftMessagesFunc == nil {
+		return nil, mockNotImplemented("clearAllDraftMessages")
+	}
+
+	return mock.ClearAllDraftMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) GetSavedNotificationSound(ctx context.Context, req *GetSavedNotificationSoundRequest) (*NotificationSounds, error) {
+	mock.record(req)
+
+	if mock.GetSavedNotificationSoundFunc == nil {
+		return nil, mockNotImplemented("getSavedNotificationSound")
+	}
+
+	return mock.GetSavedNotificationSoundFunc(ctx, req)
+}
+
+func (mock *Mock) GetSavedNotificationSounds(ctx context.Context) (*NotificationSounds, error) {
+	mock.record(&GetSavedNotificationSoundsRequest{})
+
+	if mock.GetSavedNotificationSoundsFunc == nil {
+		return nil, mockNotImplemented("getSavedNotificationSounds")
+	}
+
+	return mock.GetSavedNotificationSoundsFunc(ctx)
+}
+
+func (mock *Mock) AddSavedNotificationSound(ctx context.Context, req *AddSavedNotificationSoundRequest) (*NotificationSound, error) {
+	mock.record(req)
+
+	if mock.AddSavedNotificationSoundFunc == nil {
+		return nil, mockNotImplemented("addSavedNotificationSound")
+	}
+
+	return mock.AddSavedNotificationSoundFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveSavedNotificationSound(ctx context.Context, req *RemoveSavedNotificationSoundRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveSavedNotificationSoundFunc == nil {
+		return nil, mockNotImplemented("removeSavedNotificationSound")
+	}
+
+	return mock.RemoveSavedNotificationSoundFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatNotificationSettingsExceptions(ctx context.Context, req *GetChatNotificationSettingsExceptionsRequest) (*Chats, error) {
+	mock.record(req)
+
+	if mock.GetChatNotificationSettingsExceptionsFunc == nil {
+		return nil, mockNotImplemented("getChatNotificationSettingsExceptions")
+	}
+
+	return mock.GetChatNotificationSettingsExceptionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetScopeNotificationSettings(ctx context.Context, req *GetScopeNotificationSettingsRequest) (*ScopeNotificationSettings, error) {
+	mock.record(req)
+
+	if mock.GetScopeNotificationSettingsFunc == nil {
+		return nil, mockNotImplemented("getScopeNotificationSettings")
+	}
+
+	return mock.GetScopeNotificationSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) SetScopeNotificationSettings(ctx context.Context, req *SetScopeNotificationSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetScopeNotificationSettingsFunc == nil {
+		return nil, mockNotImplemented("setScopeNotificationSettings")
+	}
+
+	return mock.SetScopeNotificationSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) SetReactionNotificationSettings(ctx context.Context, req *SetReactionNotificationSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetReactionNotificationSettingsFunc == nil {
+		return nil, mockNotImplemented("setReactionNotificationSettings")
+	}
+
+	return mock.SetReactionNotificationSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) ResetAllNotificationSettings(ctx context.Context) (*Ok, error) {
+	mock.record(&ResetAllNotificationSettingsRequest{})
+
+	if mock.ResetAllNotificationSettingsFunc == nil {
+		return nil, mockNotImplemented("resetAllNotificationSettings")
+	}
+
+	return mock.ResetAllNotificationSettingsFunc(ctx)
+}
+
+func (mock *Mock) ToggleChatIsPinned(ctx context.Context, req *ToggleChatIsPinnedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatIsPinnedFunc == nil {
+		return nil, mockNotImplemented("toggleChatIsPinned")
+	}
+
+	return mock.ToggleChatIsPinnedFunc(ctx, req)
+}
+
+func (mock *Mock) SetPinnedChats(ctx context.Context, req *SetPinnedChatsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPinnedChatsFunc == nil {
+		return nil, mockNotImplemented("setPinnedChats")
+	}
+
+	return mock.SetPinnedChatsFunc(ctx, req)
+}
+
+func (mock *Mock) ReadChatList(ctx context.Context, req *ReadChatListRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReadChatListFunc == nil {
+		return nil, mockNotImplemented("readChatList")
+	}
+
+	return mock.ReadChatListFunc(ctx, req)
+}
+
+func (mock *Mock) GetCurrentWeather(ctx context.Context, req *GetCurrentWeatherRequest) (*CurrentWeather, error) {
+	mock.record(req)
+
+	if mock.GetCurrentWeatherFunc == nil {
+		return nil, mockNotImplemented("getCurrentWeather")
+	}
+
+	return mock.GetCurrentWeatherFunc(ctx, req)
+}
+
+func (mock *Mock) GetStory(ctx context.Context, req *GetStoryRequest) (*Story, error) {
+	mock.record(req)
+
+	if mock.GetStoryFunc == nil {
+		return nil, mockNotImplemented("getStory")
+	}
+
+	return mock.GetStoryFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatsToSendStories(ctx context.Context) (*Chats, error) {
+	mock.record(&GetChatsToSendStoriesRequest{})
+
+	if mock.GetChatsToSendStoriesFunc == nil {
+		return nil, mockNotImplemented("getChatsToSendStories")
+	}
+
+	return mock.GetChatsToSendStoriesFunc(ctx)
+}
+
+func (mock *Mock) CanSendStory(ctx context.Context, req *CanSendStoryRequest) (CanSendStoryResult, error) {
+	mock.record(req)
+
+	if mock.CanSendStoryFunc == nil {
+		return nil, mockNotImplemented("canSendStory")
+	}
+
+	return mock.CanSendStoryFunc(ctx, req)
+}
+
+func (mock *Mock) SendStory(ctx context.Context, req *SendStoryRequest) (*Story, error) {
+	mock.record(req)
+
+	if mock.SendStoryFunc == nil {
+		return nil, mockNotImplemented("sendStory")
+	}
+
+	return mock.SendStoryFunc(ctx, req)
+}
+
+func (mock *Mock) EditStory(ctx context.Context, req *EditStoryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditStoryFunc == nil {
+		return nil, mockNotImplemented("editStory")
+	}
+
+	return mock.EditStoryFunc(ctx, req)
+}
+
+func (mock *Mock) EditStoryCover(ctx context.Context, req *EditStoryCoverRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditStoryCoverFunc == nil {
+		return nil, mockNotImplemented("editStoryCover")
+	}
+
+	return mock.EditStoryCoverFunc(ctx, req)
+}
+
+func (mock *Mock) SetStoryPrivacySettings(ctx context.Context, req *SetStoryPrivacySettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStoryPrivacySettingsFunc == nil {
+		return nil, mockNotImplemented("setStoryPrivacySettings")
+	}
+
+	return mock.SetStoryPrivacySettingsFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleStoryIsPostedToChatPage(ctx context.Context, req *ToggleStoryIsPostedToChatPageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleStoryIsPostedToChatPageFunc == nil {
+		return nil, mockNotImplemented("toggleStoryIsPostedToChatPage")
+	}
+
+	return mock.ToggleStoryIsPostedToChatPageFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteStory(ctx context.Context, req *DeleteStoryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteStoryFunc == nil {
+		return nil, mockNotImplemented("deleteStory")
+	}
+
+	return mock.DeleteStoryFunc(ctx, req)
+}
+
+func (mock *Mock) GetStoryNotificationSettingsExceptions(ctx context.Context) (*Chats, error) {
+	mock.record(&GetStoryNotificationSettingsExceptionsRequest{})
+
+	if mock.GetStoryNotificationSettingsExceptionsFunc == nil {
+		return nil, mockNotImplemented("getStoryNotificationSettingsExceptions")
+	}
+
+	return mock.GetStoryNotificationSettingsExceptionsFunc(ctx)
+}
+
+func (mock *Mock) LoadActiveStories(ctx context.Context, req *LoadActiveStoriesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LoadActiveStoriesFunc == nil {
+		return nil, mockNotImplemented("loadActiveStories")
+	}
+
+	return mock.LoadActiveStoriesFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatActiveStoriesList(ctx context.Context, req *SetChatActiveStoriesListRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatActiveStoriesListFunc == nil {
+		return nil, mockNotImplemented("setChatActiveStoriesList")
+	}
+
+	return mock.SetChatActiveStoriesListFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatActiveStories(ctx context.Context, req *GetChatActiveStoriesRequest) (*ChatActiveStories, error) {
+	mock.record(req)
+
+	if mock.GetChatActiveStoriesFunc == nil {
+		return nil, mockNotImplemented("getChatActiveStories")
+	}
+
+	return mock.GetChatActiveStoriesFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatPostedToChatPageStories(ctx context.Context, req *GetChatPostedToChatPageStoriesRequest) (*Stories, error) {
+	mock.record(req)
+
+	if mock.GetChatPostedToChatPageStoriesFunc == nil {
+		return nil, mockNotImplemented("getChatPostedToChatPageStories")
+	}
+
+	return mock.GetChatPostedToChatPageStoriesFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatArchivedStories(ctx context.Context, req *GetChatArchivedStoriesRequest) (*Stories, error) {
+	mock.record(req)
+
+	if mock.GetChatArchivedStoriesFunc == nil {
+		return nil, mockNotImplemented("getChatArchivedStories")
+	}
+
+	return mock.GetChatArchivedStoriesFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatPinnedStories(ctx context.Context, req *SetChatPinnedStoriesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatPinnedStoriesFunc == nil {
+		return nil, mockNotImplemented("setChatPinnedStories")
+	}
+
+	return mock.SetChatPinnedStoriesFunc(ctx, req)
+}
+
+func (mock *Mock) OpenStory(ctx context.Context, req *OpenStoryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.OpenStoryFunc == nil {
+		return nil, mockNotImplemented("openStory")
+	}
+
+	return mock.OpenStoryFunc(ctx, req)
+}
+
+func (mock *Mock) CloseStory(ctx context.Context, req *CloseStoryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CloseStoryFunc == nil {
+		return nil, mockNotImplemented("closeStory")
+	}
+
+	return mock.CloseStoryFunc(ctx, req)
+}
+
+func (mock *Mock) GetStoryAvailableReactions(ctx context.Context, req *GetStoryAvailableReactionsRequest) (*AvailableReactions, error) {
+	mock.record(req)
+
+	if mock.GetStoryAvailableReactionsFunc == nil {
+		return nil, mockNotImplemented("getStoryAvailableReactions")
+	}
+
+	return mock.GetStoryAvailableReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) SetStoryReaction(ctx context.Context, req *SetStoryReactionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStoryReactionFunc == nil {
+		return nil, mockNotImplemented("setStoryReaction")
+	}
+
+	return mock.SetStoryReactionFunc(ctx, req)
+}
+
+func (mock *Mock) GetStoryInteractions(ctx context.Context, req *GetStoryInteractionsRequest) (*StoryInteractions, error) {
+	mock.record(req)
+
+	if mock.GetStoryInteractionsFunc == nil {
+		return nil, mockNotImplemented("getStoryInteractions")
+	}
+
+	return mock.GetStoryInteractionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatStoryInteractions(ctx context.Context, req *GetChatStoryInteractionsRequest) (*StoryInteractions, error) {
+	mock.record(req)
+
+	if mock.GetChatStoryInteractionsFunc == nil {
+		return nil, mockNotImplemented("getChatStoryInteractions")
+	}
+
+	return mock.GetChatStoryInteractionsFunc(ctx, req)
+}
+
+func (mock *Mock) ReportStory(ctx context.Context, req *ReportStoryRequest) (ReportStoryResult, error) {
+	mock.record(req)
+
+	if mock.ReportStoryFunc == nil {
+		return nil, mockNotImplemented("reportStory")
+	}
+
+	return mock.ReportStoryFunc(ctx, req)
+}
+
+func (mock *Mock) ActivateStoryStealthMode(ctx context.Context) (*Ok, error) {
+	mock.record(&ActivateStoryStealthModeRequest{})
+
+	if mock.ActivateStoryStealthModeFunc == nil {
+		return nil, mockNotImplemented("activateStoryStealthMode")
+	}
+
+	return mock.ActivateStoryStealthModeFunc(ctx)
+}
+
+func (mock *Mock) GetStoryPublicForwards(ctx context.Context, req *GetStoryPublicForwardsRequest) (*PublicForwards, error) {
+	mock.record(req)
+
+	if mock.GetStoryPublicForwardsFunc == nil {
+		return nil, mockNotImplemented("getStoryPublicForwards")
+	}
+
+	return mock.GetStoryPublicForwardsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatBoostLevelFeatures(ctx context.Context, req *GetChatBoostLevelFeaturesRequest) (*ChatBoostLevelFeatures, error) {
+	mock.record(req)
+
+	if mock.GetChatBoostLevelFeaturesFunc == nil {
+		return nil, mockNotImplemented("getChatBoostLevelFeatures")
+	}
+
+	return mock.GetChatBoostLevelFeaturesFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatBoostFeatures(ctx context.Context, req *GetChatBoostFeaturesRequest) (*ChatBoostFeatures, error) {
+	mock.record(req)
+
+	if mock.GetChatBoostFeaturesFunc == nil {
+		return nil, mockNotImplemented("getChatBoostFeatures")
+	}
+
+	return mock.GetChatBoostFeaturesFunc(ctx, req)
+}
+
+func (mock *Mock) GetAvailableChatBoostSlots(ctx context.Context) (*ChatBoostSlots, error) {
+	mock.record(&GetAvailableChatBoostSlotsRequest{})
+
+	if mock.GetAvailableChatBoostSlotsFunc == nil {
+		return nil, mockNotImplemented("getAvailableChatBoostSlots")
+	}
+
+	return mock.GetAvailableChatBoostSlotsFunc(ctx)
+}
+
+func (mock *Mock) GetChatBoostStatus(ctx context.Context, req *GetChatBoostStatusRequest) (*ChatBoostStatus, error) {
+	mock.record(req)
+
+	if mock.GetChatBoostStatusFunc == nil {
+		return nil, mockNotImplemented("getChatBoostStatus")
+	}
+
+	return mock.GetChatBoostStatusFunc(ctx, req)
+}
+
+func (mock *Mock) BoostChat(ctx context.Context, req *BoostChatRequest) (*ChatBoostSlots, error) {
+	mock.record(req)
+
+	if mock.BoostChatFunc == nil {
+		return nil, mockNotImplemented("boostChat")
+	}
+
+	return mock.BoostChatFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatBoostLink(ctx context.Context, req *GetChatBoostLinkRequest) (*ChatBoostLink, error) {
+	mock.record(req)
+
+	if mock.GetChatBoostLinkFunc == nil {
+		return nil, mockNotImplemented("getChatBoostLink")
+	}
+
+	return mock.GetChatBoostLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatBoostLinkInfo(ctx context.Context, req *GetChatBoostLinkInfoRequest) (*ChatBoostLinkInfo, error) {
+	mock.record(req)
+
+	if mock.GetChatBoostLinkInfoFunc == nil {
+		return nil, mockNotImplemented("getChatBoostLinkInfo")
+	}
+
+	return mock.GetChatBoostLinkInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatBoosts(ctx context.Context, req *GetChatBoostsRequest) (*FoundChatBoosts, error) {
+	mock.record(req)
+
+	if mock.GetChatBoostsFunc == nil {
+		return nil, mockNotImplemented("getChatBoosts")
+	}
+
+	return mock.GetChatBoostsFunc(ctx, req)
+}
+
+func (mock *Mock) GetUserChatBoosts(ctx context.Context, req *GetUserChatBoostsRequest) (*FoundChatBoosts, error) {
+	mock.record(req)
+
+	if mock.GetUserChatBoostsFunc == nil {
+		return nil, mockNotImplemented("getUserChatBoosts")
+	}
+
+	return mock.GetUserChatBoostsFunc(ctx, req)
+}
+
+func (mock *Mock) GetAttachmentMenuBot(ctx context.Context, req *GetAttachmentMenuBotRequest) (*AttachmentMenuBot, error) {
+	mock.record(req)
+
+	if mock.GetAttachmentMenuBotFunc == nil {
+		return nil, mockNotImplemented("getAttachmentMenuBot")
+	}
+
+	return mock.GetAttachmentMenuBotFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleBotIsAddedToAttachmentMenu(ctx context.Context, req *ToggleBotIsAddedToAttachmentMenuRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleBotIsAddedToAttachmentMenuFunc == nil {
+		return nil, mockNotImplemented("toggleBotIsAddedToAttachmentMenu")
+	}
+
+	return mock.ToggleBotIsAddedToAttachmentMenuFunc(ctx, req)
+}
+
+func (mock *Mock) GetThemedEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	mock.record(&GetThemedEmojiStatusesRequest{})
+
+	if mock.GetThemedEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("getThemedEmojiStatuses")
+	}
+
+	return mock.GetThemedEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) GetRecentEmojiStatuses(ctx context.Context) (*EmojiStatuses, error) {
+	mock.record(&GetRecentEmojiStatusesRequest{})
+
+	if mock.GetRecentEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("getRecentEmojiStatuses")
+	}
+
+	return mock.GetRecentEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) GetUpgradedGiftEmojiStatuses(ctx context.Context) (*EmojiStatuses, error) {
+	mock.record(&GetUpgradedGiftEmojiStatusesRequest{})
+
+	if mock.GetUpgradedGiftEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("getUpgradedGiftEmojiStatuses")
+	}
+
+	return mock.GetUpgradedGiftEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) GetDefaultEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	mock.record(&GetDefaultEmojiStatusesRequest{})
+
+	if mock.GetDefaultEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("getDefaultEmojiStatuses")
+	}
+
+	return mock.GetDefaultEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) ClearRecentEmojiStatuses(ctx context.Context) (*Ok, error) {
+	mock.record(&ClearRecentEmojiStatusesRequest{})
+
+	if mock.ClearRecentEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("clearRecentEmojiStatuses")
+	}
+
+	return mock.ClearRecentEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) GetThemedChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	mock.record(&GetThemedChatEmojiStatusesRequest{})
+
+	if mock.GetThemedChatEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("getThemedChatEmojiStatuses")
+	}
+
+	return mock.GetThemedChatEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) GetDefaultChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	mock.record(&GetDefaultChatEmojiStatusesRequest{})
+
+	if mock.GetDefaultChatEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("getDefaultChatEmojiStatuses")
+	}
+
+	return mock.GetDefaultChatEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) GetDisallowedChatEmojiStatuses(ctx context.Context) (*EmojiStatusCustomEmojis, error) {
+	mock.record(&GetDisallowedChatEmojiStatusesRequest{})
+
+	if mock.GetDisallowedChatEmojiStatusesFunc == nil {
+		return nil, mockNotImplemented("getDisallowedChatEmojiStatuses")
+	}
+
+	return mock.GetDisallowedChatEmojiStatusesFunc(ctx)
+}
+
+func (mock *Mock) DownloadFile(ctx context.Context, req *DownloadFileRequest) (*File, error) {
+	mock.record(req)
+
+	if mock.DownloadFileFunc == nil {
+		return nil, mockNotImplemented("downloadFile")
+	}
+
+	return mock.DownloadFileFunc(ctx, req)
+}
+
+func (mock *Mock) GetFileDownloadedPrefixSize(ctx context.Context, req *GetFileDownloadedPrefixSizeRequest) (*FileDownloadedPrefixSize, error) {
+	mock.record(req)
+
+	if mock.GetFileDownloadedPrefixSizeFunc == nil {
+		return nil, mockNotImplemented("getFileDownloadedPrefixSize")
+	}
+
+	return mock.GetFileDownloadedPrefixSizeFunc(ctx, req)
+}
+
+func (mock *Mock) CancelDownloadFile(ctx context.Context, req *CancelDownloadFileRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CancelDownloadFileFunc == nil {
+		return nil, mockNotImplemented("cancelDownloadFile")
+	}
+
+	return mock.CancelDownloadFileFunc(ctx, req)
+}
+
+func (mock *Mock) GetSuggestedFileName(ctx context.Context, req *GetSuggestedFileNameRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetSuggestedFileNameFunc == nil {
+		return nil, mockNotImplemented("getSuggestedFileName")
+	}
+
+	return mock.GetSuggestedFileNameFunc(ctx, req)
+}
+
+func (mock *Mock) PreliminaryUploadFile(ctx context.Context, req *PreliminaryUploadFileRequest) (*File, error) {
+	mock.record(req)
+
+	if mock.PreliminaryUploadFileFunc == nil {
+		return nil, mockNotImplemented("preliminaryUploadFile")
+	}
+
+	return mock.PreliminaryUploadFileFunc(ctx, req)
+}
+
+func (mock *Mock) CancelPreliminaryUploadFile(ctx context.Context, req *CancelPreliminaryUploadFileRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CancelPreliminaryUploadFileFunc == nil {
+		return nil, mockNotImplemented("cancelPreliminaryUploadFile")
+	}
+
+	return mock.CancelPreliminaryUploadFileFunc(ctx, req)
+}
+
+func (mock *Mock) WriteGeneratedFilePart(ctx context.Context, req *WriteGeneratedFilePartRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.WriteGeneratedFilePartFunc == nil {
+		return nil, mockNotImplemented("writeGeneratedFilePart")
+	}
+
+	return mock.WriteGeneratedFilePartFunc(ctx, req)
+}
+
+func (mock *Mock) SetFileGenerationProgress(ctx context.Context, req *SetFileGenerationProgressRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetFileGenerationProgressFunc == nil {
+		return nil, mockNotImplemented("setFileGenerationProgress")
+	}
+
+	return mock.SetFileGenerationProgressFunc(ctx, req)
+}
+
+func (mock *Mock) FinishFileGeneration(ctx context.Context, req *FinishFileGenerationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.FinishFileGenerationFunc == nil {
+		return nil, mockNotImplemented("finishFileGeneration")
+	}
+
+	return mock.FinishFileGenerationFunc(ctx, req)
+}
+
+func (mock *Mock) ReadFilePart(ctx context.Context, req *ReadFilePartRequest) (*FilePart, error) {
+	mock.record(req)
+
+	if mock.ReadFilePartFunc == nil {
+		return nil, mockNotImplemented("readFilePart")
+	}
+
+	return mock.ReadFilePartFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteFile(ctx context.Context, req *DeleteFileRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteFileFunc == nil {
+		return nil, mockNotImplemented("deleteFile")
+	}
+
+	return mock.DeleteFileFunc(ctx, req)
+}
+
+func (mock *Mock) AddFileToDownloads(ctx context.Context, req *AddFileToDownloadsRequest) (*File, error) {
+	mock.record(req)
+
+	if mock.AddFileToDownloadsFunc == nil {
+		return nil, mockNotImplemented("addFileToDownloads")
+	}
+
+	return mock.AddFileToDownloadsFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleDownloadIsPaused(ctx context.Context, req *ToggleDownloadIsPausedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleDownloadIsPausedFunc == nil {
+		return nil, mockNotImplemented("toggleDownloadIsPaused")
+	}
+
+	return mock.ToggleDownloadIsPausedFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleAllDownloadsArePaused(ctx context.Context, req *ToggleAllDownloadsArePausedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleAllDownloadsArePausedFunc == nil {
+		return nil, mockNotImplemented("toggleAllDownloadsArePaused")
+	}
+
+	return mock.ToggleAllDownloadsArePausedFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveFileFromDownloads(ctx context.Context, req *RemoveFileFromDownloadsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveFileFromDownloadsFunc == nil {
+		return nil, mockNotImplemented("removeFileFromDownloads")
+	}
+
+	return mock.RemoveFileFromDownloadsFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveAllFilesFromDownloads(ctx context.Context, req *RemoveAllFilesFromDownloadsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveAllFilesFromDownloadsFunc == nil {
+		return nil, mockNotImplemented("removeAllFilesFromDownloads")
+	}
+
+	return mock.RemoveAllFilesFromDownloadsFunc(ctx, req)
+}
+
+func (mock *Mock) SearchFileDownloads(ctx context.Context, req *SearchFileDownloadsRequest) (*FoundFileDownloads, error) {
+	mock.record(req)
+
+	if mock.SearchFileDownloadsFunc == nil {
+		return nil, mockNotImplemented("searchFileDownloads")
+	}
+
+	return mock.SearchFileDownloadsFunc(ctx, req)
+}
+
+func (mock *Mock) SetApplicationVerificationToken(ctx context.Context, req *SetApplicationVerificationTokenRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetApplicationVerificationTokenFunc == nil {
+		return nil, mockNotImplemented("setApplicationVerificationToken")
+	}
+
+	return mock.SetApplicationVerificationTokenFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageFileType(ctx context.Context, req *GetMessageFileTypeRequest) (MessageFileType, error) {
+	mock.record(req)
+
+	if mock.GetMessageFileTypeFunc == nil {
+		return nil, mockNotImplemented("getMessageFileType")
+	}
+
+	return mock.GetMessageFileTypeFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageImportConfirmationText(ctx context.Context, req *GetMessageImportConfirmationTextRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetMessageImportConfirmationTextFunc == nil {
+		return nil, mockNotImplemented("getMessageImportConfirmationText")
+	}
+
+	return mock.GetMessageImportConfirmationTextFunc(ctx, req)
+}
+
+func (mock *Mock) ImportMessages(ctx context.Context, req *ImportMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ImportMessagesFunc == nil {
+		return nil, mockNotImplemented("importMessages")
+	}
+
+	return mock.ImportMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) ReplacePrimaryChatInviteLink(ctx context.Context, req *ReplacePrimaryChatInviteLinkRequest) (*ChatInviteLink, error) {
+	mock.record(req)
+
+	if mock.ReplacePrimaryChatInviteLinkFunc == nil {
+		return nil, mockNotImplemented("replacePrimaryChatInviteLink")
+	}
+
+	return mock.ReplacePrimaryChatInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) CreateChatInviteLink(ctx context.Context, req *CreateChatInviteLinkRequest) (*ChatInviteLink, error) {
+	mock.record(req)
+
+	if mock.CreateChatInviteLinkFunc == nil {
+		return nil, mockNotImplemented("createChatInviteLink")
+	}
+
+	return mock.CreateChatInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) CreateChatSubscriptionInviteLink(ctx context.Context, req *CreateChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error) {
+	mock.record(req)
+
+	if mock.CreateChatSubscriptionInviteLinkFunc == nil {
+		return nil, mockNotImplemented("createChatSubscriptionInviteLink")
+	}
+
+	return mock.CreateChatSubscriptionInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) EditChatInviteLink(ctx context.Context, req *EditChatInviteLinkRequest) (*ChatInviteLink, error) {
+	mock.record(req)
+
+	if mock.EditChatInviteLinkFunc == nil {
+		return nil, mockNotImplemented("editChatInviteLink")
+	}
+
+	return mock.EditChatInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) EditChatSubscriptionInviteLink(ctx context.Context, req *EditChatSubscriptionInviteLinkRequest) (*ChatInviteLink, error) {
+	mock.record(req)
+
+	if mock.EditChatSubscriptionInviteLinkFunc == nil {
+		return nil, mockNotImplemented("editChatSubscriptionInviteLink")
+	}
+
+	return mock.EditChatSubscriptionInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatInviteLink(ctx context.Context, req *GetChatInviteLinkRequest) (*ChatInviteLink, error) {
+	mock.record(req)
+
+	if mock.GetChatInviteLinkFunc == nil {
+		return nil, mockNotImplemented("getChatInviteLink")
+	}
+
+	return mock.GetChatInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatInviteLinkCounts(ctx context.Context, req *GetChatInviteLinkCountsRequest) (*ChatInviteLinkCounts, error) {
+	mock.record(req)
+
+	if mock.GetChatInviteLinkCountsFunc == nil {
+		return nil, mockNotImplemented("getChatInviteLinkCounts")
+	}
+
+	return mock.GetChatInviteLinkCountsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatInviteLinks(ctx context.Context, req *GetChatInviteLinksRequest) (*ChatInviteLinks, error) {
+	mock.record(req)
+
+	if mock.GetChatInviteLinksFunc == nil {
+		return nil, mockNotImplemented("getChatInviteLinks")
+	}
+
+	return mock.GetChatInviteLinksFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatInviteLinkMembers(ctx context.Context, req *GetChatInviteLinkMembersRequest) (*ChatInviteLinkMembers, error) {
+	mock.record(req)
+
+	if mock.GetChatInviteLinkMembersFunc == nil {
+		return nil, mockNotImplemented("getChatInviteLinkMembers")
+	}
+
+	return mock.GetChatInviteLinkMembersFunc(ctx, req)
+}
+
+func (mock *Mock) RevokeChatInviteLink(ctx context.Context, req *RevokeChatInviteLinkRequest) (*ChatInviteLinks, error) {
+	mock.record(req)
+
+	if mock.RevokeChatInviteLinkFunc == nil {
+		return nil, mockNotImplemented("revokeChatInviteLink")
+	}
+
+	return mock.RevokeChatInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteRevokedChatInviteLink(ctx context.Context, req *DeleteRevokedChatInviteLinkRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteRevokedChatInviteLinkFunc == nil {
+		return nil, mockNotImplemented("deleteRevokedChatInviteLink")
+	}
+
+	return mock.DeleteRevokedChatInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteAllRevokedChatInviteLinks(ctx context.Context, req *DeleteAllRevokedChatInviteLinksRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteAllRevokedChatInviteLinksFunc == nil {
+		return nil, mockNotImplemented("deleteAllRevokedChatInviteLinks")
+	}
+
+	return mock.DeleteAllRevokedChatInviteLinksFunc(ctx, req)
+}
+
+func (mock *Mock) CheckChatInviteLink(ctx context.Context, req *CheckChatInviteLinkRequest) (*ChatInviteLinkInfo, error) {
+	mock.record(req)
+
+	if mock.CheckChatInviteLinkFunc == nil {
+		return nil, mockNotImplemented("checkChatInviteLink")
+	}
+
+	return mock.CheckChatInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) JoinChatByInviteLink(ctx context.Context, req *JoinChatByInviteLinkRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.JoinChatByInviteLinkFunc == nil {
+		return nil, mockNotImplemented("joinChatByInviteLink")
+	}
+
+	return mock.JoinChatByInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatJoinRequests(ctx context.Context, req *GetChatJoinRequestsRequest) (*ChatJoinRequests, error) {
+	mock.record(req)
+
+	if mock.GetChatJoinRequestsFunc == nil {
+		return nil, mockNotImplemented("getChatJoinRequests")
+	}
+
+	return mock.GetChatJoinRequestsFunc(ctx, req)
+}
+
+func (mock *Mock) ProcessChatJoinRequest(ctx context.Context, req *ProcessChatJoinRequestRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ProcessChatJoinRequestFunc == nil {
+		return nil, mockNotImplemented("processChatJoinRequest")
+	}
+
+	return mock.ProcessChatJoinRequestFunc(ctx, req)
+}
+
+func (mock *Mock) ProcessChatJoinRequests(ctx context.Context, req *ProcessChatJoinRequestsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ProcessChatJoinRequestsFunc == nil {
+		return nil, mockNotImplemented("processChatJoinRequests")
+	}
+
+	return mock.ProcessChatJoinRequestsFunc(ctx, req)
+}
+
+func (mock *Mock) CreateCall(ctx context.Context, req *CreateCallRequest) (*CallId, error) {
+	mock.record(req)
+
+	if mock.CreateCallFunc == nil {
+		return nil, mockNotImplemented("createCall")
+	}
+
+	return mock.CreateCallFunc(ctx, req)
+}
+
+func (mock *Mock) AcceptCall(ctx context.Context, req *AcceptCallRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AcceptCallFunc == nil {
+		return nil, mockNotImplemented("acceptCall")
+	}
+
+	return mock.AcceptCallFunc(ctx, req)
+}
+
+func (mock *Mock) SendCallSignalingData(ctx context.Context, req *SendCallSignalingDataRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendCallSignalingDataFunc == nil {
+		return nil, mockNotImplemented("sendCallSignalingData")
+	}
+
+	return mock.SendCallSignalingDataFunc(ctx, req)
+}
+
+func (mock *Mock) DiscardCall(ctx context.Context, req *DiscardCallRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DiscardCallFunc == nil {
+		return nil, mockNotImplemented("discardCall")
+	}
+
+	return mock.DiscardCallFunc(ctx, req)
+}
+
+func (mock *Mock) SendCallRating(ctx context.Context, req *SendCallRatingRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendCallRatingFunc == nil {
+		return nil, mockNotImplemented("sendCallRating")
+	}
+
+	return mock.SendCallRatingFunc(ctx, req)
+}
+
+func (mock *Mock) SendCallDebugInformation(ctx context.Context, req *SendCallDebugInformationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendCallDebugInformationFunc == nil {
+		return nil, mockNotImplemented("sendCallDebugInformation")
+	}
+
+	return mock.SendCallDebugInformationFunc(ctx, req)
+}
+
+func (mock *Mock) SendCallLog(ctx context.Context, req *SendCallLogRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendCallLogFunc == nil {
+		return nil, mockNotImplemented("sendCallLog")
+	}
+
+	return mock.SendCallLogFunc(ctx, req)
+}
+
+func (mock *Mock) GetVideoChatAvailableParticipants(ctx context.Context, req *GetVideoChatAvailableParticipantsRequest) (*MessageSenders, error) {
+	mock.record(req)
+
+	if mock.GetVideoChatAvailableParticipantsFunc == nil {
+		return nil, mockNotImplemented("getVideoChatAvailableParticipants")
+	}
+
+	return mock.GetVideoChatAvailableParticipantsFunc(ctx, req)
+}
+
+func (mock *Mock) SetVideoChatDefaultParticipant(ctx context.Context, req *SetVideoChatDefaultParticipantRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetVideoChatDefaultParticipantFunc == nil {
+		return nil, mockNotImplemented("setVideoChatDefaultParticipant")
+	}
+
+	return mock.SetVideoChatDefaultParticipantFunc(ctx, req)
+}
+
+func (mock *Mock) CreateVideoChat(ctx context.Context, req *CreateVideoChatRequest) (*GroupCallId, error) {
+	mock.record(req)
+
+	if mock.CreateVideoChatFunc == nil {
+		return nil, mockNotImplemented("createVideoChat")
+	}
+
+	return mock.CreateVideoChatFunc(ctx, req)
+}
+
+func (mock *Mock) CreateGroupCall(ctx context.Context, req *CreateGroupCallRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CreateGroupCallFunc == nil {
+		return nil, mockNotImplemented("createGroupCall")
+	}
+
+	return mock.CreateGroupCallFunc(ctx, req)
+}
+
+func (mock *Mock) GetVideoChatRtmpUrl(ctx context.Context, req *GetVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
+	mock.record(req)
+
+	if mock.GetVideoChatRtmpUrlFunc == nil {
+		return nil, mockNotImplemented("getVideoChatRtmpUrl")
+	}
+
+	return mock.GetVideoChatRtmpUrlFunc(ctx, req)
+}
+
+func (mock *Mock) ReplaceVideoChatRtmpUrl(ctx context.Context, req *ReplaceVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
+	mock.record(req)
+
+	if mock.ReplaceVideoChatRtmpUrlFunc == nil {
+		return nil, mockNotImplemented("replaceVideoChatRtmpUrl")
+	}
+
+	return mock.ReplaceVideoChatRtmpUrlFunc(ctx, req)
+}
+
+func (mock *Mock) GetGroupCall(ctx context.Context, req *GetGroupCallRequest) (*GroupCall, error) {
+	mock.record(req)
+
+	if mock.GetGroupCallFunc == nil {
+		return nil, mockNotImplemented("getGroupCall")
+	}
+
+	return mock.GetGroupCallFunc(ctx, req)
+}
+
+func (mock *Mock) StartScheduledGroupCall(ctx context.Context, req *StartScheduledGroupCallRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.StartScheduledGroupCallFunc == nil {
+		return nil, mockNotImplemented("startScheduledGroupCall")
+	}
+
+	return mock.StartScheduledGroupCallFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGroupCallEnabledStartNotification(ctx context.Context, req *ToggleGroupCallEnabledStartNotificationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGroupCallEnabledStartNotificationFunc == nil {
+		return nil, mockNotImplemented("toggleGroupCallEnabledStartNotification")
+	}
+
+	return mock.ToggleGroupCallEnabledStartNotificationFunc(ctx, req)
+}
+
+func (mock *Mock) JoinGroupCall(ctx context.Context, req *JoinGroupCallRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.JoinGroupCallFunc == nil {
+		return nil, mockNotImplemented("joinGroupCall")
+	}
+
+	return mock.JoinGroupCallFunc(ctx, req)
+}
+
+func (mock *Mock) StartGroupCallScreenSharing(ctx context.Context, req *StartGroupCallScreenSharingRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.StartGroupCallScreenSharingFunc == nil {
+		return nil, mockNotImplemented("startGroupCallScreenSharing")
+	}
+
+	return mock.StartGroupCallScreenSharingFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGroupCallScreenSharingIsPaused(ctx context.Context, req *ToggleGroupCallScreenSharingIsPausedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGroupCallScreenSharingIsPausedFunc == nil {
+		return nil, mockNotImplemented("toggleGroupCallScreenSharingIsPaused")
+	}
+
+	return mock.ToggleGroupCallScreenSharingIsPausedFunc(ctx, req)
+}
+
+func (mock *Mock) EndGroupCallScreenSharing(ctx context.Context, req *EndGroupCallScreenSharingRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EndGroupCallScreenSharingFunc == nil {
+		return nil, mockNotImplemented("endGroupCallScreenSharing")
+	}
+
+	return mock.EndGroupCallScreenSharingFunc(ctx, req)
+}
+
+func (mock *Mock) SetGroupCallTitle(ctx context.Context, req *SetGroupCallTitleRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetGroupCallTitleFunc == nil {
+		return nil, mockNotImplemented("setGroupCallTitle")
+	}
+
+	return mock.SetGroupCallTitleFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGroupCallMuteNewParticipants(ctx context.Context, req *ToggleGroupCallMuteNewParticipantsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGroupCallMuteNewParticipantsFunc == nil {
+		return nil, mockNotImplemented("toggleGroupCallMuteNewParticipants")
+	}
+
+	return mock.ToggleGroupCallMuteNewParticipantsFunc(ctx, req)
+}
+
+func (mock *Mock) InviteGroupCallParticipants(ctx context.Context, req *InviteGroupCallParticipantsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.InviteGroupCallParticipantsFunc == nil {
+		return nil, mockNotImplemented("inviteGroupCallParticipants")
+	}
+
+	return mock.InviteGroupCallParticipantsFunc(ctx, req)
+}
+
+func (mock *Mock) GetGroupCallInviteLink(ctx context.Context, req *GetGroupCallInviteLinkRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetGroupCallInviteLinkFunc == nil {
+		return nil, mockNotImplemented("getGroupCallInviteLink")
+	}
+
+	return mock.GetGroupCallInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) RevokeGroupCallInviteLink(ctx context.Context, req *RevokeGroupCallInviteLinkRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RevokeGroupCallInviteLinkFunc == nil {
+		return nil, mockNotImplemented("revokeGroupCallInviteLink")
+	}
+
+	return mock.RevokeGroupCallInviteLinkFunc(ctx, req)
+}
+
+func (mock *Mock) StartGroupCallRecording(ctx context.Context, req *StartGroupCallRecordingRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.StartGroupCallRecordingFunc == nil {
+		return nil, mockNotImplemented("startGroupCallRecording")
+	}
+
+	return mock.StartGroupCallRecordingFunc(ctx, req)
+}
+
+func (mock *Mock) EndGroupCallRecording(ctx context.Context, req *EndGroupCallRecordingRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EndGroupCallRecordingFunc == nil {
+		return nil, mockNotImplemented("endGroupCallRecording")
+	}
+
+	return mock.EndGroupCallRecordingFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGroupCallIsMyVideoPaused(ctx context.Context, req *ToggleGroupCallIsMyVideoPausedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGroupCallIsMyVideoPausedFunc == nil {
+		return nil, mockNotImplemented("toggleGroupCallIsMyVideoPaused")
+	}
+
+	return mock.ToggleGroupCallIsMyVideoPausedFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGroupCallIsMyVideoEnabled(ctx context.Context, req *ToggleGroupCallIsMyVideoEnabledRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGroupCallIsMyVideoEnabledFunc == nil {
+		return nil, mockNotImplemented("toggleGroupCallIsMyVideoEnabled")
+	}
+
+	return mock.ToggleGroupCallIsMyVideoEnabledFunc(ctx, req)
+}
+
+func (mock *Mock) SetGroupCallParticipantIsSpeaking(ctx context.Context, req *SetGroupCallParticipantIsSpeakingRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetGroupCallParticipantIsSpeakingFunc == nil {
+		return nil, mockNotImplemented("setGroupCallParticipantIsSpeaking")
+	}
+
+	return mock.SetGroupCallParticipantIsSpeakingFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGroupCallParticipantIsMuted(ctx context.Context, req *ToggleGroupCallParticipantIsMutedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGroupCallParticipantIsMutedFunc == nil {
+		return nil, mockNotImplemented("toggleGroupCallParticipantIsMuted")
+	}
+
+	return mock.ToggleGroupCallParticipantIsMutedFunc(ctx, req)
+}
+
+func (mock *Mock) SetGroupCallParticipantVolumeLevel(ctx context.Context, req *SetGroupCallParticipantVolumeLevelRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetGroupCallParticipantVolumeLevelFunc == nil {
+		return nil, mockNotImplemented("setGroupCallParticipantVolumeLevel")
+	}
+
+	return mock.SetGroupCallParticipantVolumeLevelFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGroupCallParticipantIsHandRaised(ctx context.Context, req *ToggleGroupCallParticipantIsHandRaisedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGroupCallParticipantIsHandRaisedFunc == nil {
+		return nil, mockNotImplemented("toggleGroupCallParticipantIsHandRaised")
+	}
+
+	return mock.ToggleGroupCallParticipantIsHandRaisedFunc(ctx, req)
+}
+
+func (mock *Mock) LoadGroupCallParticipants(ctx context.Context, req *LoadGroupCallParticipantsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LoadGroupCallParticipantsFunc == nil {
+		return nil, mockNotImplemented("loadGroupCallParticipants")
+	}
+
+	return mock.LoadGroupCallParticipantsFunc(ctx, req)
+}
+
+func (mock *Mock) LeaveGroupCall(ctx context.Context, req *LeaveGroupCallRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LeaveGroupCallFunc == nil {
+		return nil, mockNotImplemented("leaveGroupCall")
+	}
+
+	return mock.LeaveGroupCallFunc(ctx, req)
+}
+
+func (mock *Mock) EndGroupCall(ctx context.Context, req *EndGroupCallRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EndGroupCallFunc == nil {
+		return nil, mockNotImplemented("endGroupCall")
+	}
+
+	return mock.EndGroupCallFunc(ctx, req)
+}
+
+func (mock *Mock) GetGroupCallStreams(ctx context.Context, req *GetGroupCallStreamsRequest) (*GroupCallStreams, error) {
+	mock.record(req)
+
+	if mock.GetGroupCallStreamsFunc == nil {
+		return nil, mockNotImplemented("getGroupCallStreams")
+	}
+
+	return mock.GetGroupCallStreamsFunc(ctx, req)
+}
+
+func (mock *Mock) GetGroupCallStreamSegment(ctx context.Context, req *GetGroupCallStreamSegmentRequest) (*FilePart, error) {
+	mock.record(req)
+
+	if mock.GetGroupCallStreamSegmentFunc == nil {
+		return nil, mockNotImplemented("getGroupCallStreamSegment")
+	}
+
+	return mock.GetGroupCallStreamSegmentFunc(ctx, req)
+}
+
+func (mock *Mock) SetMessageSenderBlockList(ctx context.Context, req *SetMessageSenderBlockListRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetMessageSenderBlockListFunc == nil {
+		return nil, mockNotImplemented("setMessageSenderBlockList")
+	}
+
+	return mock.SetMessageSenderBlockListFunc(ctx, req)
+}
+
+func (mock *Mock) BlockMessageSenderFromReplies(ctx context.Context, req *BlockMessageSenderFromRepliesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.BlockMessageSenderFromRepliesFunc == nil {
+		return nil, mockNotImplemented("blockMessageSenderFromReplies")
+	}
+
+	return mock.BlockMessageSenderFromRepliesFunc(ctx, req)
+}
+
+func (mock *Mock) GetBlockedMessageSenders(ctx context.Context, req *GetBlockedMessageSendersRequest) (*MessageSenders, error) {
+	mock.record(req)
+
+	if mock.GetBlockedMessageSendersFunc == nil {
+		return nil, mockNotImplemented("getBlockedMessageSenders")
+	}
+
+	return mock.GetBlockedMessageSendersFunc(ctx, req)
+}
+
+func (mock *Mock) AddContact(ctx context.Context, req *AddContactRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddContactFunc == nil {
+		return nil, mockNotImplemented("addContact")
+	}
+
+	return mock.AddContactFunc(ctx, req)
+}
+
+func (mock *Mock) ImportContacts(ctx context.Context, req *ImportContactsRequest) (*ImportedContacts, error) {
+	mock.record(req)
+
+	if mock.ImportContactsFunc == nil {
+		return nil, mockNotImplemented("importContacts")
+	}
+
+	return mock.ImportContactsFunc(ctx, req)
+}
+
+func (mock *Mock) GetContacts(ctx context.Context) (*Users, error) {
+	mock.record(&GetContactsRequest{})
+
+	if mock.GetContactsFunc == nil {
+		return nil, mockNotImplemented("getContacts")
+	}
+
+	return mock.GetContactsFunc(ctx)
+}
+
+func (mock *Mock) SearchContacts(ctx context.Context, req *SearchContactsRequest) (*Users, error) {
+	mock.record(req)
+
+	if mock.SearchContactsFunc == nil {
+		return nil, mockNotImplemented("searchContacts")
+	}
+
+	return mock.SearchContactsFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveContacts(ctx context.Context, req *RemoveContactsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveContactsFunc == nil {
+		return nil, mockNotImplemented("removeContacts")
+	}
+
+	return mock.RemoveContactsFunc(ctx, req)
+}
+
+func (mock *Mock) GetImportedContactCount(ctx context.Context) (*Count, error) {
+	mock.record(&GetImportedContactCountRequest{})
+
+	if mock.GetImportedContactCountFunc == nil {
+		return nil, mockNotImplemented("getImportedContactCount")
+	}
+
+	return mock.GetImportedContactCountFunc(ctx)
+}
+
+func (mock *Mock) ChangeImportedContacts(ctx context.Context, req *ChangeImportedContactsRequest) (*ImportedContacts, error) {
+	mock.record(req)
+
+	if mock.ChangeImportedContactsFunc == nil {
+		return nil, mockNotImplemented("changeImportedContacts")
+	}
+
+	return mock.ChangeImportedContactsFunc(ctx, req)
+}
+
+func (mock *Mock) ClearImportedContacts(ctx context.Context) (*Ok, error) {
+	mock.record(&ClearImportedContactsRequest{})
+
+	if mock.ClearImportedContactsFunc == nil {
+		return nil, mockNotImplemented("clearImportedContacts")
+	}
+
+	return mock.ClearImportedContactsFunc(ctx)
+}
+
+func (mock *Mock) SetCloseFriends(ctx context.Context, req *SetCloseFriendsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetCloseFriendsFunc == nil {
+		return nil, mockNotImplemented("setCloseFriends")
+	}
+
+	return mock.SetCloseFriendsFunc(ctx, req)
+}
+
+func (mock *Mock) GetCloseFriends(ctx context.Context) (*Users, error) {
+	mock.record(&GetCloseFriendsRequest{})
+
+	if mock.GetCloseFriendsFunc == nil {
+		return nil, mockNotImplemented("getCloseFriends")
+	}
+
+	return mock.GetCloseFriendsFunc(ctx)
+}
+
+func (mock *Mock) SetUserPersonalProfilePhoto(ctx context.Context, req *SetUserPersonalProfilePhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetUserPersonalProfilePhotoFunc == nil {
+		return nil, mockNotImplemented("setUserPersonalProfilePhoto")
+	}
+
+	return mock.SetUserPersonalProfilePhotoFunc(ctx, req)
+}
+
+func (mock *Mock) SuggestUserProfilePhoto(ctx context.Context, req *SuggestUserProfilePhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SuggestUserProfilePhotoFunc == nil {
+		return nil, mockNotImplemented("suggestUserProfilePhoto")
+	}
+
+	return mock.SuggestUserProfilePhotoFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleBotCanManageEmojiStatus(ctx context.Context, req *ToggleBotCanManageEmojiStatusRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleBotCanManageEmojiStatusFunc == nil {
+		return nil, mockNotImplemented("toggleBotCanManageEmojiStatus")
+	}
+
+	return mock.ToggleBotCanManageEmojiStatusFunc(ctx, req)
+}
+
+func (mock *Mock) SetUserEmojiStatus(ctx context.Context, req *SetUserEmojiStatusRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetUserEmojiStatusFunc == nil {
+		return nil, mockNotImplemented("setUserEmojiStatus")
+	}
+
+	return mock.SetUserEmojiStatusFunc(ctx, req)
+}
+
+func (mock *Mock) SearchUserByPhoneNumber(ctx context.Context, req *SearchUserByPhoneNumberRequest) (*User, error) {
+	mock.record(req)
+
+	if mock.SearchUserByPhoneNumberFunc == nil {
+		return nil, mockNotImplemented("searchUserByPhoneNumber")
+	}
+
+	return mock.SearchUserByPhoneNumberFunc(ctx, req)
+}
+
+func (mock *Mock) SharePhoneNumber(ctx context.Context, req *SharePhoneNumberRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SharePhoneNumberFunc == nil {
+		return nil, mockNotImplemented("sharePhoneNumber")
+	}
+
+	return mock.SharePhoneNumberFunc(ctx, req)
+}
+
+func (mock *Mock) GetUserProfilePhotos(ctx context.Context, req *GetUserProfilePhotosRequest) (*ChatPhotos, error) {
+	mock.record(req)
+
+	if mock.GetUserProfilePhotosFunc == nil {
+		return nil, mockNotImplemented("getUserProfilePhotos")
+	}
+
+	return mock.GetUserProfilePhotosFunc(ctx, req)
+}
+
+func (mock *Mock) GetStickerOutline(ctx context.Context, req *GetStickerOutlineRequest) (*Outline, error) {
+	mock.record(req)
+
+	if mock.GetStickerOutlineFunc == nil {
+		return nil, mockNotImplemented("getStickerOutline")
+	}
+
+	return mock.GetStickerOutlineFunc(ctx, req)
+}
+
+func (mock *Mock) GetStickers(ctx context.Context, req *GetStickersRequest) (*Stickers, error) {
+	mock.record(req)
+
+	if mock.GetStickersFunc == nil {
+		return nil, mockNotImplemented("getStickers")
+	}
+
+	return mock.GetStickersFunc(ctx, req)
+}
+
+func (mock *Mock) GetAllStickerEmojis(ctx context.Context, req *GetAllStickerEmojisRequest) (*Emojis, error) {
+	mock.record(req)
+
+	if mock.GetAllStickerEmojisFunc == nil {
+		return nil, mockNotImplemented("getAllStickerEmojis")
+	}
+
+	return mock.GetAllStickerEmojisFunc(ctx, req)
+}
+
+func (mock *Mock) SearchStickers(ctx context.Context, req *SearchStickersRequest) (*Stickers, error) {
+	mock.record(req)
+
+	if mock.SearchStickersFunc == nil {
+		return nil, mockNotImplemented("searchStickers")
+	}
+
+	return mock.SearchStickersFunc(ctx, req)
+}
+
+func (mock *Mock) GetGreetingStickers(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetGreetingStickersRequest{})
+
+	if mock.GetGreetingStickersFunc == nil {
+		return nil, mockNotImplemented("getGreetingStickers")
+	}
+
+	return mock.GetGreetingStickersFunc(ctx)
+}
+
+func (mock *Mock) GetPremiumStickers(ctx context.Context, req *GetPremiumStickersRequest) (*Stickers, error) {
+	mock.record(req)
+
+	if mock.GetPremiumStickersFunc == nil {
+		return nil, mockNotImplemented("getPremiumStickers")
+	}
+
+	return mock.GetPremiumStickersFunc(ctx, req)
+}
+
+func (mock *Mock) GetInstalledStickerSets(ctx context.Context, req *GetInstalledStickerSetsRequest) (*StickerSets, error) {
+	mock.record(req)
+
+	if mock.GetInstalledStickerSetsFunc == nil {
+		return nil, mockNotImplemented("getInstalledStickerSets")
+	}
+
+	return mock.GetInstalledStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) GetArchivedStickerSets(ctx context.Context, req *GetArchivedStickerSetsRequest) (*StickerSets, error) {
+	mock.record(req)
+
+	if mock.GetArchivedStickerSetsFunc == nil {
+		return nil, mockNotImplemented("getArchivedStickerSets")
+	}
+
+	return mock.GetArchivedStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) GetTrendingStickerSets(ctx context.Context, req *GetTrendingStickerSetsRequest) (*TrendingStickerSets, error) {
+	mock.record(req)
+
+	if mock.GetTrendingStickerSetsFunc == nil {
+		return nil, mockNotImplemented("getTrendingStickerSets")
+	}
+
+	return mock.GetTrendingStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) GetAttachedStickerSets(ctx context.Context, req *GetAttachedStickerSetsRequest) (*StickerSets, error) {
+	mock.record(req)
+
+	if mock.GetAttachedStickerSetsFunc == nil {
+		return nil, mockNotImplemented("getAttachedStickerSets")
+	}
+
+	return mock.GetAttachedStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStickerSet(ctx context.Context, req *GetStickerSetRequest) (*StickerSet, error) {
+	mock.record(req)
+
+	if mock.GetStickerSetFunc == nil {
+		return nil, mockNotImplemented("getStickerSet")
+	}
+
+	return mock.GetStickerSetFunc(ctx, req)
+}
+
+func (mock *Mock) GetStickerSetName(ctx context.Context, req *GetStickerSetNameRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetStickerSetNameFunc == nil {
+		return nil, mockNotImplemented("getStickerSetName")
+	}
+
+	return mock.GetStickerSetNameFunc(ctx, req)
+}
+
+func (mock *Mock) SearchStickerSet(ctx context.Context, req *SearchStickerSetRequest) (*StickerSet, error) {
+	mock.record(req)
+
+	if mock.SearchStickerSetFunc == nil {
+		return nil, mockNotImplemented("searchStickerSet")
+	}
+
+	return mock.SearchStickerSetFunc(ctx, req)
+}
+
+func (mock *Mock) SearchInstalledStickerSets(ctx context.Context, req *SearchInstalledStickerSetsRequest) (*StickerSets, error) {
+	mock.record(req)
+
+	if mock.SearchInstalledStickerSetsFunc == nil {
+		return nil, mockNotImplemented("searchInstalledStickerSets")
+	}
+
+	return mock.SearchInstalledStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) SearchStickerSets(ctx context.Context, req *SearchStickerSetsRequest) (*StickerSets, error) {
+	mock.record(req)
+
+	if mock.SearchStickerSetsFunc == nil {
+		return nil, mockNotImplemented("searchStickerSets")
+	}
+
+	return mock.SearchStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) ChangeStickerSet(ctx context.Context, req *ChangeStickerSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ChangeStickerSetFunc == nil {
+		return nil, mockNotImplemented("changeStickerSet")
+	}
+
+	return mock.ChangeStickerSetFunc(ctx, req)
+}
+
+func (mock *Mock) ViewTrendingStickerSets(ctx context.Context, req *ViewTrendingStickerSetsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ViewTrendingStickerSetsFunc == nil {
+		return nil, mockNotImplemented("viewTrendingStickerSets")
+	}
+
+	return mock.ViewTrendingStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) ReorderInstalledStickerSets(ctx context.Context, req *ReorderInstalledStickerSetsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReorderInstalledStickerSetsFunc == nil {
+		return nil, mockNotImplemented("reorderInstalledStickerSets")
+	}
+
+	return mock.ReorderInstalledStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) GetRecentStickers(ctx context.Context, req *GetRecentStickersRequest) (*Stickers, error) {
+	mock.record(req)
+
+	if mock.GetRecentStickersFunc == nil {
+		return nil, mockNotImplemented("getRecentStickers")
+	}
+
+	return mock.GetRecentStickersFunc(ctx, req)
+}
+
+func (mock *Mock) AddRecentSticker(ctx context.Context, req *AddRecentStickerRequest) (*Stickers, error) {
+	mock.record(req)
+
+	if mock.AddRecentStickerFunc == nil {
+		return nil, mockNotImplemented("addRecentSticker")
+	}
+
+	return mock.AddRecentStickerFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveRecentSticker(ctx context.Context, req *RemoveRecentStickerRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveRecentStickerFunc == nil {
+		return nil, mockNotImplemented("removeRecentSticker")
+	}
+
+	return mock.RemoveRecentStickerFunc(ctx, req)
+}
+
+func (mock *Mock) ClearRecentStickers(ctx context.Context, req *ClearRecentStickersRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ClearRecentStickersFunc == nil {
+		return nil, mockNotImplemented("clearRecentStickers")
+	}
+
+	return mock.ClearRecentStickersFunc(ctx, req)
+}
+
+func (mock *Mock) GetFavoriteStickers(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetFavoriteStickersRequest{})
+
+	if mock.GetFavoriteStickersFunc == nil {
+		return nil, mockNotImplemented("getFavoriteStickers")
+	}
+
+	return mock.GetFavoriteStickersFunc(ctx)
+}
+
+func (mock *Mock) AddFavoriteSticker(ctx context.Context, req *AddFavoriteStickerRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddFavoriteStickerFunc == nil {
+		return nil, mockNotImplemented("addFavoriteSticker")
+	}
+
+	return mock.AddFavoriteStickerFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveFavoriteSticker(ctx context.Context, req *RemoveFavoriteStickerRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveFavoriteStickerFunc == nil {
+		return nil, mockNotImplemented("removeFavoriteSticker")
+	}
+
+	return mock.RemoveFavoriteStickerFunc(ctx, req)
+}
+
+func (mock *Mock) GetStickerEmojis(ctx context.Context, req *GetStickerEmojisRequest) (*Emojis, error) {
+	mock.record(req)
+
+	if mock.GetStickerEmojisFunc == nil {
+		return nil, mockNotImplemented("getStickerEmojis")
+	}
+
+	return mock.GetStickerEmojisFunc(ctx, req)
+}
+
+func (mock *Mock) SearchEmojis(ctx context.Context, req *SearchEmojisRequest) (*EmojiKeywords, error) {
+	mock.record(req)
+
+	if mock.SearchEmojisFunc == nil {
+		return nil, mockNotImplemented("searchEmojis")
+	}
+
+	return mock.SearchEmojisFunc(ctx, req)
+}
+
+func (mock *Mock) GetKeywordEmojis(ctx context.Context, req *GetKeywordEmojisRequest) (*Emojis, error) {
+	mock.record(req)
+
+	if mock.GetKeywordEmojisFunc == nil {
+		return nil, mockNotImplemented("getKeywordEmojis")
+	}
+
+	return mock.GetKeywordEmojisFunc(ctx, req)
+}
+
+func (mock *Mock) GetEmojiCategories(ctx context.Context, req *GetEmojiCategoriesRequest) (*EmojiCategories, error) {
+	mock.record(req)
+
+	if mock.GetEmojiCategoriesFunc == nil {
+		return nil, mockNotImplemented("getEmojiCategories")
+	}
+
+	return mock.GetEmojiCategoriesFunc(ctx, req)
+}
+
+func (mock *Mock) GetAnimatedEmoji(ctx context.Context, req *GetAnimatedEmojiRequest) (*AnimatedEmoji, error) {
+	mock.record(req)
+
+	if mock.GetAnimatedEmojiFunc == nil {
+		return nil, mockNotImplemented("getAnimatedEmoji")
+	}
+
+	return mock.GetAnimatedEmojiFunc(ctx, req)
+}
+
+func (mock *Mock) GetEmojiSuggestionsUrl(ctx context.Context, req *GetEmojiSuggestionsUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetEmojiSuggestionsUrlFunc == nil {
+		return nil, mockNotImplemented("getEmojiSuggestionsUrl")
+	}
+
+	return mock.GetEmojiSuggestionsUrlFunc(ctx, req)
+}
+
+func (mock *Mock) GetCustomEmojiStickers(ctx context.Context, req *GetCustomEmojiStickersRequest) (*Stickers, error) {
+	mock.record(req)
+
+	if mock.GetCustomEmojiStickersFunc == nil {
+		return nil, mockNotImplemented("getCustomEmojiStickers")
+	}
+
+	return mock.GetCustomEmojiStickersFunc(ctx, req)
+}
+
+func (mock *Mock) GetDefaultChatPhotoCustomEmojiStickers(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetDefaultChatPhotoCustomEmojiStickersRequest{})
+
+	if mock.GetDefaultChatPhotoCustomEmojiStickersFunc == nil {
+		return nil, mockNotImplemented("getDefaultChatPhotoCustomEmojiStickers")
+	}
+
+	return mock.GetDefaultChatPhotoCustomEmojiStickersFunc(ctx)
+}
+
+func (mock *Mock) GetDefaultProfilePhotoCustomEmojiStickers(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetDefaultProfilePhotoCustomEmojiStickersRequest{})
+
+	if mock.GetDefaultProfilePhotoCustomEmojiStickersFunc == nil {
+		return nil, mockNotImplemented("getDefaultProfilePhotoCustomEmojiStickers")
+	}
+
+	return mock.GetDefaultProfilePhotoCustomEmojiStickersFunc(ctx)
+}
+
+func (mock *Mock) GetDefaultBackgroundCustomEmojiStickers(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetDefaultBackgroundCustomEmojiStickersRequest{})
+
+	if mock.GetDefaultBackgroundCustomEmojiStickersFunc == nil {
+		return nil, mockNotImplemented("getDefaultBackgroundCustomEmojiStickers")
+	}
+
+	return mock.GetDefaultBackgroundCustomEmojiStickersFunc(ctx)
+}
+
+func (mock *Mock) GetSavedAnimations(ctx context.Context) (*Animations, error) {
+	mock.record(&GetSavedAnimationsRequest{})
+
+	if mock.GetSavedAnimationsFunc == nil {
+		return nil, mockNotImplemented("getSavedAnimations")
+	}
+
+	return mock.GetSavedAnimationsFunc(ctx)
+}
+
+func (mock *Mock) AddSavedAnimation(ctx context.Context, req *AddSavedAnimationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddSavedAnimationFunc == nil {
+		return nil, mockNotImplemented("addSavedAnimation")
+	}
+
+	return mock.AddSavedAnimationFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveSavedAnimation(ctx context.Context, req *RemoveSavedAnimationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveSavedAnimationFunc == nil {
+		return nil, mockNotImplemented("removeSavedAnimation")
+	}
+
+	return mock.RemoveSavedAnimationFunc(ctx, req)
+}
+
+func (mock *Mock) GetRecentInlineBots(ctx context.Context) (*Users, error) {
+	mock.record(&GetRecentInlineBotsRequest{})
+
+	if mock.GetRecentInlineBotsFunc == nil {
+		return nil, mockNotImplemented("getRecentInlineBots")
+	}
+
+	return mock.GetRecentInlineBotsFunc(ctx)
+}
+
+func (mock *Mock) GetOwnedBots(ctx context.Context) (*Users, error) {
+	mock.record(&GetOwnedBotsRequest{})
+
+	if mock.GetOwnedBotsFunc == nil {
+		return nil, mockNotImplemented("getOwnedBots")
+	}
+
+	return mock.GetOwnedBotsFunc(ctx)
+}
+
+func (mock *Mock) SearchHashtags(ctx context.Context, req *SearchHashtagsRequest) (*Hashtags, error) {
+	mock.record(req)
+
+	if mock.SearchHashtagsFunc == nil {
+		return nil, mockNotImplemented("searchHashtags")
+	}
+
+	return mock.SearchHashtagsFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveRecentHashtag(ctx context.Context, req *RemoveRecentHashtagRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveRecentHashtagFunc == nil {
+		return nil, mockNotImplemented("removeRecentHashtag")
+	}
+
+	return mock.RemoveRecentHashtagFunc(ctx, req)
+}
+
+func (mock *Mock) GetLinkPreview(ctx context.Context, req *GetLinkPreviewRequest) (*LinkPreview, error) {
+	mock.record(req)
+
+	if mock.GetLinkPreviewFunc == nil {
+		return nil, mockNotImplemented("getLinkPreview")
+	}
+
+	return mock.GetLinkPreviewFunc(ctx, req)
+}
+
+func (mock *Mock) GetWebPageInstantView(ctx context.Context, req *GetWebPageInstantViewRequest) (*WebPageInstantView, error) {
+	mock.record(req)
+
+	if mock.GetWebPageInstantViewFunc == nil {
+		return nil, mockNotImplemented("getWebPageInstantView")
+	}
+
+	return mock.GetWebPageInstantViewFunc(ctx, req)
+}
+
+func (mock *Mock) SetProfilePhoto(ctx context.Context, req *SetProfilePhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetProfilePhotoFunc == nil {
+		return nil, mockNotImplemented("setProfilePhoto")
+	}
+
+	return mock.SetProfilePhotoFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteProfilePhoto(ctx context.Context, req *DeleteProfilePhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteProfilePhotoFunc == nil {
+		return nil, mockNotImplemented("deleteProfilePhoto")
+	}
+
+	return mock.DeleteProfilePhotoFunc(ctx, req)
+}
+
+func (mock *Mock) SetAccentColor(ctx context.Context, req *SetAccentColorRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAccentColorFunc == nil {
+		return nil, mockNotImplemented("setAccentColor")
+	}
+
+	return mock.SetAccentColorFunc(ctx, req)
+}
+
+func (mock *Mock) SetProfileAccentColor(ctx context.Context, req *SetProfileAccentColorRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetProfileAccentColorFunc == nil {
+		return nil, mockNotImplemented("setProfileAccentColor")
+	}
+
+	return mock.SetProfileAccentColorFunc(ctx, req)
+}
+
+func (mock *Mock) SetName(ctx context.Context, req *SetNameRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetNameFunc == nil {
+		return nil, mockNotImplemented("setName")
+	}
+
+	return mock.SetNameFunc(ctx, req)
+}
+
+func (mock *Mock) SetBio(ctx context.Context, req *SetBioRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBioFunc == nil {
+		return nil, mockNotImplemented("setBio")
+	}
+
+	return mock.SetBioFunc(ctx, req)
+}
+
+func (mock *Mock) SetUsername(ctx context.Context, req *SetUsernameRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetUsernameFunc == nil {
+		return nil, mockNotImplemented("setUsername")
+	}
+
+	return mock.SetUsernameFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleUsernameIsActive(ctx context.Context, req *ToggleUsernameIsActiveRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleUsernameIsActiveFunc == nil {
+		return nil, mockNotImplemented("toggleUsernameIsActive")
+	}
+
+	return mock.ToggleUsernameIsActiveFunc(ctx, req)
+}
+
+func (mock *Mock) ReorderActiveUsernames(ctx context.Context, req *ReorderActiveUsernamesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReorderActiveUsernamesFunc == nil {
+		return nil, mockNotImplemented("reorderActiveUsernames")
+	}
+
+	return mock.ReorderActiveUsernamesFunc(ctx, req)
+}
+
+func (mock *Mock) SetBirthdate(ctx context.Context, req *SetBirthdateRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBirthdateFunc == nil {
+		return nil, mockNotImplemented("setBirthdate")
+	}
+
+	return mock.SetBirthdateFunc(ctx, req)
+}
+
+func (mock *Mock) SetPersonalChat(ctx context.Context, req *SetPersonalChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPersonalChatFunc == nil {
+		return nil, mockNotImplemented("setPersonalChat")
+	}
+
+	return mock.SetPersonalChatFunc(ctx, req)
+}
+
+func (mock *Mock) SetEmojiStatus(ctx context.Context, req *SetEmojiStatusRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetEmojiStatusFunc == nil {
+		return nil, mockNotImplemented("setEmojiStatus")
+	}
+
+	return mock.SetEmojiStatusFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleHasSponsoredMessagesEnabled(ctx context.Context, req *ToggleHasSponsoredMessagesEnabledRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleHasSponsoredMessagesEnabledFunc == nil {
+		return nil, mockNotImplemented("toggleHasSponsoredMessagesEnabled")
+	}
+
+	return mock.ToggleHasSponsoredMessagesEnabledFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessLocation(ctx context.Context, req *SetBusinessLocationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessLocationFunc == nil {
+		return nil, mockNotImplemented("setBusinessLocation")
+	}
+
+	return mock.SetBusinessLocationFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessOpeningHours(ctx context.Context, req *SetBusinessOpeningHoursRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessOpeningHoursFunc == nil {
+		return nil, mockNotImplemented("setBusinessOpeningHours")
+	}
+
+	return mock.SetBusinessOpeningHoursFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessGreetingMessageSettings(ctx context.Context, req *SetBusinessGreetingMessageSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessGreetingMessageSettingsFunc == nil {
+		return nil, mockNotImplemented("setBusinessGreetingMessageSettings")
+	}
+
+	return mock.SetBusinessGreetingMessageSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessAwayMessageSettings(ctx context.Context, req *SetBusinessAwayMessageSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessAwayMessageSettingsFunc == nil {
+		return nil, mockNotImplemented("setBusinessAwayMessageSettings")
+	}
+
+	return mock.SetBusinessAwayMessageSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) SetBusinessStartPage(ctx context.Context, req *SetBusinessStartPageRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessStartPageFunc == nil {
+		return nil, mockNotImplemented("setBusinessStartPage")
+	}
+
+	return mock.SetBusinessStartPageFunc(ctx, req)
+}
+
+func (mock *Mock) SendPhoneNumberCode(ctx context.Context, req *SendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error) {
+	mock.record(req)
+
+	if mock.SendPhoneNumberCodeFunc == nil {
+		return nil, mockNotImplemented("sendPhoneNumberCode")
+	}
+
+	return mock.SendPhoneNumberCodeFunc(ctx, req)
+}
+
+func (mock *Mock) SendPhoneNumberFirebaseSms(ctx context.Context, req *SendPhoneNumberFirebaseSmsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendPhoneNumberFirebaseSmsFunc == nil {
+		return nil, mockNotImplemented("sendPhoneNumberFirebaseSms")
+	}
+
+	return mock.SendPhoneNumberFirebaseSmsFunc(ctx, req)
+}
+
+func (mock *Mock) ReportPhoneNumberCodeMissing(ctx context.Context, req *ReportPhoneNumberCodeMissingRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReportPhoneNumberCodeMissingFunc == nil {
+		return nil, mockNotImplemented("reportPhoneNumberCodeMissing")
+	}
+
+	return mock.ReportPhoneNumberCodeMissingFunc(ctx, req)
+}
+
+func (mock *Mock) ResendPhoneNumberCode(ctx context.Context, req *ResendPhoneNumberCodeRequest) (*AuthenticationCodeInfo, error) {
+	mock.record(req)
+
+	if mock.ResendPhoneNumberCodeFunc == nil {
+		return nil, mockNotImplemented("resendPhoneNumberCode")
+	}
+
+	return mock.ResendPhoneNumberCodeFunc(ctx, req)
+}
+
+func (mock *Mock) CheckPhoneNumberCode(ctx context.Context, req *CheckPhoneNumberCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckPhoneNumberCodeFunc == nil {
+		return nil, mockNotImplemented("checkPhoneNumberCode")
+	}
+
+	return mock.CheckPhoneNumberCodeFunc(ctx, req)
+}
+
+func (mock *Mock) GetBusinessConnectedBot(ctx context.Context) (*BusinessConnectedBot, error) {
+	mock.record(&GetBusinessConnectedBotRequest{})
+
+	if mock.GetBusinessConnectedBotFunc == nil {
+		return nil, mockNotImplemented("getBusinessConnectedBot")
+	}
+
+	return mock.GetBusinessConnectedBotFunc(ctx)
+}
+
+func (mock *Mock) SetBusinessConnectedBot(ctx context.Context, req *SetBusinessConnectedBotRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBusinessConnectedBotFunc == nil {
+		return nil, mockNotImplemented("setBusinessConnectedBot")
+	}
+
+	return mock.SetBusinessConnectedBotFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteBusinessConnectedBot(ctx context.Context, req *DeleteBusinessConnectedBotRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteBusinessConnectedBotFunc == nil {
+		return nil, mockNotImplemented("deleteBusinessConnectedBot")
+	}
+
+	return mock.DeleteBusinessConnectedBotFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleBusinessConnectedBotChatIsPaused(ctx context.Context, req *ToggleBusinessConnectedBotChatIsPausedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleBusinessConnectedBotChatIsPausedFunc == nil {
+		return nil, mockNotImplemented("toggleBusinessConnectedBotChatIsPaused")
+	}
+
+	return mock.ToggleBusinessConnectedBotChatIsPausedFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveBusinessConnectedBotFromChat(ctx context.Context, req *RemoveBusinessConnectedBotFromChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveBusinessConnectedBotFromChatFunc == nil {
+		return nil, mockNotImplemented("removeBusinessConnectedBotFromChat")
+	}
+
+	return mock.RemoveBusinessConnectedBotFromChatFunc(ctx, req)
+}
+
+func (mock *Mock) GetBusinessChatLinks(ctx context.Context) (*BusinessChatLinks, error) {
+	mock.record(&GetBusinessChatLinksRequest{})
+
+	if mock.GetBusinessChatLinksFunc == nil {
+		return nil, mockNotImplemented("getBusinessChatLinks")
+	}
+
+	return mock.GetBusinessChatLinksFunc(ctx)
+}
+
+func (mock *Mock) CreateBusinessChatLink(ctx context.Context, req *CreateBusinessChatLinkRequest) (*BusinessChatLink, error) {
+	mock.record(req)
+
+	if mock.CreateBusinessChatLinkFunc == nil {
+		return nil, mockNotImplemented("createBusinessChatLink")
+	}
+
+	return mock.CreateBusinessChatLinkFunc(ctx, req)
+}
+
+func (mock *Mock) EditBusinessChatLink(ctx context.Context, req *EditBusinessChatLinkRequest) (*BusinessChatLink, error) {
+	mock.record(req)
+
+	if mock.EditBusinessChatLinkFunc == nil {
+		return nil, mockNotImplemented("editBusinessChatLink")
+	}
+
+	return mock.EditBusinessChatLinkFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteBusinessChatLink(ctx context.Context, req *DeleteBusinessChatLinkRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteBusinessChatLinkFunc == nil {
+		return nil, mockNotImplemented("deleteBusinessChatLink")
+	}
+
+	return mock.DeleteBusinessChatLinkFunc(ctx, req)
+}
+
+func (mock *Mock) GetBusinessChatLinkInfo(ctx context.Context, req *GetBusinessChatLinkInfoRequest) (*BusinessChatLinkInfo, error) {
+	mock.record(req)
+
+	if mock.GetBusinessChatLinkInfoFunc == nil {
+		return nil, mockNotImplemented("getBusinessChatLinkInfo")
+	}
+
+	return mock.GetBusinessChatLinkInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetUserLink(ctx context.Context) (*UserLink, error) {
+	mock.record(&GetUserLinkRequest{})
+
+	if mock.GetUserLinkFunc == nil {
+		return nil, mockNotImplemented("getUserLink")
+	}
+
+	return mock.GetUserLinkFunc(ctx)
+}
+
+func (mock *Mock) SearchUserByToken(ctx context.Context, req *SearchUserByTokenRequest) (*User, error) {
+	mock.record(req)
+
+	if mock.SearchUserByTokenFunc == nil {
+		return nil, mockNotImplemented("searchUserByToken")
+	}
+
+	return mock.SearchUserByTokenFunc(ctx, req)
+}
+
+func (mock *Mock) SetCommands(ctx context.Context, req *SetCommandsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetCommandsFunc == nil {
+		return nil, mockNotImplemented("setCommands")
+	}
+
+	return mock.SetCommandsFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteCommands(ctx context.Context, req *DeleteCommandsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteCommandsFunc == nil {
+		return nil, mockNotImplemented("deleteCommands")
+	}
+
+	return mock.DeleteCommandsFunc(ctx, req)
+}
+
+func (mock *Mock) GetCommands(ctx context.Context, req *GetCommandsRequest) (*BotCommands, error) {
+	mock.record(req)
+
+	if mock.GetCommandsFunc == nil {
+		return nil, mockNotImplemented("getCommands")
+	}
+
+	return mock.GetCommandsFunc(ctx, req)
+}
+
+func (mock *Mock) SetMenuButton(ctx context.Context, req *SetMenuButtonRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetMenuButtonFunc == nil {
+		return nil, mockNotImplemented("setMenuButton")
+	}
+
+	return mock.SetMenuButtonFunc(ctx, req)
+}
+
+func (mock *Mock) GetMenuButton(ctx context.Context, req *GetMenuButtonRequest) (*BotMenuButton, error) {
+	mock.record(req)
+
+	if mock.GetMenuButtonFunc == nil {
+		return nil, mockNotImplemented("getMenuButton")
+	}
+
+	return mock.GetMenuButtonFunc(ctx, req)
+}
+
+func (mock *Mock) SetDefaultGroupAdministratorRights(ctx context.Context, req *SetDefaultGroupAdministratorRightsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetDefaultGroupAdministratorRightsFunc == nil {
+		return nil, mockNotImplemented("setDefaultGroupAdministratorRights")
+	}
+
+	return mock.SetDefaultGroupAdministratorRightsFunc(ctx, req)
+}
+
+func (mock *Mock) SetDefaultChannelAdministratorRights(ctx context.Context, req *SetDefaultChannelAdministratorRightsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetDefaultChannelAdministratorRightsFunc == nil {
+		return nil, mockNotImplemented("setDefaultChannelAdministratorRights")
+	}
+
+	return mock.SetDefaultChannelAdministratorRightsFunc(ctx, req)
+}
+
+func (mock *Mock) CanBotSendMessages(ctx context.Context, req *CanBotSendMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CanBotSendMessagesFunc == nil {
+		return nil, mockNotImplemented("canBotSendMessages")
+	}
+
+	return mock.CanBotSendMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) AllowBotToSendMessages(ctx context.Context, req *AllowBotToSendMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AllowBotToSendMessagesFunc == nil {
+		return nil, mockNotImplemented("allowBotToSendMessages")
+	}
+
+	return mock.AllowBotToSendMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) SendWebAppCustomRequest(ctx context.Context, req *SendWebAppCustomRequestRequest) (*CustomRequestResult, error) {
+	mock.record(req)
+
+	if mock.SendWebAppCustomRequestFunc == nil {
+		return nil, mockNotImplemented("sendWebAppCustomRequest")
+	}
+
+	return mock.SendWebAppCustomRequestFunc(ctx, req)
+}
+
+func (mock *Mock) GetBotMediaPreviews(ctx context.Context, req *GetBotMediaPreviewsRequest) (*BotMediaPreviews, error) {
+	mock.record(req)
+
+	if mock.GetBotMediaPreviewsFunc == nil {
+		return nil, mockNotImplemented("getBotMediaPreviews")
+	}
+
+	return mock.GetBotMediaPreviewsFunc(ctx, req)
+}
+
+func (mock *Mock) GetBotMediaPreviewInfo(ctx context.Context, req *GetBotMediaPreviewInfoRequest) (*BotMediaPreviewInfo, error) {
+	mock.record(req)
+
+	if mock.GetBotMediaPreviewInfoFunc == nil {
+		return nil, mockNotImplemented("getBotMediaPreviewInfo")
+	}
+
+	return mock.GetBotMediaPreviewInfoFunc(ctx, req)
+}
+
+func (mock *Mock) AddBotMediaPreview(ctx context.Context, req *AddBotMediaPreviewRequest) (*BotMediaPreview, error) {
+	mock.record(req)
+
+	if mock.AddBotMediaPreviewFunc == nil {
+		return nil, mockNotImplemented("addBotMediaPreview")
+	}
+
+	return mock.AddBotMediaPreviewFunc(ctx, req)
+}
+
+func (mock *Mock) EditBotMediaPreview(ctx context.Context, req *EditBotMediaPreviewRequest) (*BotMediaPreview, error) {
+	mock.record(req)
+
+	if mock.EditBotMediaPreviewFunc == nil {
+		return nil, mockNotImplemented("editBotMediaPreview")
+	}
+
+	return mock.EditBotMediaPreviewFunc(ctx, req)
+}
+
+func (mock *Mock) ReorderBotMediaPreviews(ctx context.Context, req *ReorderBotMediaPreviewsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReorderBotMediaPreviewsFunc == nil {
+		return nil, mockNotImplemented("reorderBotMediaPreviews")
+	}
+
+	return mock.ReorderBotMediaPreviewsFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteBotMediaPreviews(ctx context.Context, req *DeleteBotMediaPreviewsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteBotMediaPreviewsFunc == nil {
+		return nil, mockNotImplemented("deleteBotMediaPreviews")
+	}
+
+	return mock.DeleteBotMediaPreviewsFunc(ctx, req)
+}
+
+func (mock *Mock) SetBotName(ctx context.Context, req *SetBotNameRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBotNameFunc == nil {
+		return nil, mockNotImplemented("setBotName")
+	}
+
+	return mock.SetBotNameFunc(ctx, req)
+}
+
+func (mock *Mock) GetBotName(ctx context.Context, req *GetBotNameRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetBotNameFunc == nil {
+		return nil, mockNotImplemented("getBotName")
+	}
+
+	return mock.GetBotNameFunc(ctx, req)
+}
+
+func (mock *Mock) SetBotProfilePhoto(ctx context.Context, req *SetBotProfilePhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBotProfilePhotoFunc == nil {
+		return nil, mockNotImplemented("setBotProfilePhoto")
+	}
+
+	return mock.SetBotProfilePhotoFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleBotUsernameIsActive(ctx context.Context, req *ToggleBotUsernameIsActiveRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleBotUsernameIsActiveFunc == nil {
+		return nil, mockNotImplemented("toggleBotUsernameIsActive")
+	}
+
+	return mock.ToggleBotUsernameIsActiveFunc(ctx, req)
+}
+
+func (mock *Mock) ReorderBotActiveUsernames(ctx context.Context, req *ReorderBotActiveUsernamesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReorderBotActiveUsernamesFunc == nil {
+		return nil, mockNotImplemented("reorderBotActiveUsernames")
+	}
+
+	return mock.ReorderBotActiveUsernamesFunc(ctx, req)
+}
+
+func (mock *Mock) SetBotInfoDescription(ctx context.Context, req *SetBotInfoDescriptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBotInfoDescriptionFunc == nil {
+		return nil, mockNotImplemented("setBotInfoDescription")
+	}
+
+	return mock.SetBotInfoDescriptionFunc(ctx, req)
+}
+
+func (mock *Mock) GetBotInfoDescription(ctx context.Context, req *GetBotInfoDescriptionRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetBotInfoDescriptionFunc == nil {
+		return nil, mockNotImplemented("getBotInfoDescription")
+	}
+
+	return mock.GetBotInfoDescriptionFunc(ctx, req)
+}
+
+func (mock *Mock) SetBotInfoShortDescription(ctx context.Context, req *SetBotInfoShortDescriptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBotInfoShortDescriptionFunc == nil {
+		return nil, mockNotImplemented("setBotInfoShortDescription")
+	}
+
+	return mock.SetBotInfoShortDescriptionFunc(ctx, req)
+}
+
+func (mock *Mock) GetBotInfoShortDescription(ctx context.Context, req *GetBotInfoShortDescriptionRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetBotInfoShortDescriptionFunc == nil {
+		return nil, mockNotImplemented("getBotInfoShortDescription")
+	}
+
+	return mock.GetBotInfoShortDescriptionFunc(ctx, req)
+}
+
+func (mock *Mock) SetMessageSenderBotVerification(ctx context.Context, req *SetMessageSenderBotVerificationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetMessageSenderBotVerificationFunc == nil {
+		return nil, mockNotImplemented("setMessageSenderBotVerification")
+	}
+
+	return mock.SetMessageSenderBotVerificationFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveMessageSenderBotVerification(ctx context.Context, req *RemoveMessageSenderBotVerificationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveMessageSenderBotVerificationFunc == nil {
+		return nil, mockNotImplemented("removeMessageSenderBotVerification")
+	}
+
+	return mock.RemoveMessageSenderBotVerificationFunc(ctx, req)
+}
+
+func (mock *Mock) GetActiveSessions(ctx context.Context) (*Sessions, error) {
+	mock.record(&GetActiveSessionsRequest{})
+
+	if mock.GetActiveSessionsFunc == nil {
+		return nil, mockNotImplemented("getActiveSessions")
+	}
+
+	return mock.GetActiveSessionsFunc(ctx)
+}
+
+func (mock *Mock) TerminateSession(ctx context.Context, req *TerminateSessionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.TerminateSessionFunc == nil {
+		return nil, mockNotImplemented("terminateSession")
+	}
+
+	return mock.TerminateSessionFunc(ctx, req)
+}
+
+func (mock *Mock) TerminateAllOtherSessions(ctx context.Context) (*Ok, error) {
+	mock.record(&TerminateAllOtherSessionsRequest{})
+
+	if mock.TerminateAllOtherSessionsFunc == nil {
+		return nil, mockNotImplemented("terminateAllOtherSessions")
+	}
+
+	return mock.TerminateAllOtherSessionsFunc(ctx)
+}
+
+func (mock *Mock) ConfirmSession(ctx context.Context, req *ConfirmSessionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ConfirmSessionFunc == nil {
+		return nil, mockNotImplemented("confirmSession")
+	}
+
+	return mock.ConfirmSessionFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSessionCanAcceptCalls(ctx context.Context, req *ToggleSessionCanAcceptCallsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSessionCanAcceptCallsFunc == nil {
+		return nil, mockNotImplemented("toggleSessionCanAcceptCalls")
+	}
+
+	return mock.ToggleSessionCanAcceptCallsFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSessionCanAcceptSecretChats(ctx context.Context, req *ToggleSessionCanAcceptSecretChatsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSessionCanAcceptSecretChatsFunc == nil {
+		return nil, mockNotImplemented("toggleSessionCanAcceptSecretChats")
+	}
+
+	return mock.ToggleSessionCanAcceptSecretChatsFunc(ctx, req)
+}
+
+func (mock *Mock) SetInactiveSessionTtl(ctx context.Context, req *SetInactiveSessionTtlRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetInactiveSessionTtlFunc == nil {
+		return nil, mockNotImplemented("setInactiveSessionTtl")
+	}
+
+	return mock.SetInactiveSessionTtlFunc(ctx, req)
+}
+
+func (mock *Mock) GetConnectedWebsites(ctx context.Context) (*ConnectedWebsites, error) {
+	mock.record(&GetConnectedWebsitesRequest{})
+
+	if mock.GetConnectedWebsitesFunc == nil {
+		return nil, mockNotImplemented("getConnectedWebsites")
+	}
+
+	return mock.GetConnectedWebsitesFunc(ctx)
+}
+
+func (mock *Mock) DisconnectWebsite(ctx context.Context, req *DisconnectWebsiteRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DisconnectWebsiteFunc == nil {
+		return nil, mockNotImplemented("disconnectWebsite")
+	}
+
+	return mock.DisconnectWebsiteFunc(ctx, req)
+}
+
+func (mock *Mock) DisconnectAllWebsites(ctx context.Context) (*Ok, error) {
+	mock.record(&DisconnectAllWebsitesRequest{})
+
+	if mock.DisconnectAllWebsitesFunc == nil {
+		return nil, mockNotImplemented("disconnectAllWebsites")
+	}
+
+	return mock.DisconnectAllWebsitesFunc(ctx)
+}
+
+func (mock *Mock) SetSupergroupUsername(ctx context.Context, req *SetSupergroupUsernameRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetSupergroupUsernameFunc == nil {
+		return nil, mockNotImplemented("setSupergroupUsername")
+	}
+
+	return mock.SetSupergroupUsernameFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupUsernameIsActive(ctx context.Context, req *ToggleSupergroupUsernameIsActiveRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupUsernameIsActiveFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupUsernameIsActive")
+	}
+
+	return mock.ToggleSupergroupUsernameIsActiveFunc(ctx, req)
+}
+
+func (mock *Mock) DisableAllSupergroupUsernames(ctx context.Context, req *DisableAllSupergroupUsernamesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DisableAllSupergroupUsernamesFunc == nil {
+		return nil, mockNotImplemented("disableAllSupergroupUsernames")
+	}
+
+	return mock.DisableAllSupergroupUsernamesFunc(ctx, req)
+}
+
+func (mock *Mock) ReorderSupergroupActiveUsernames(ctx context.Context, req *ReorderSupergroupActiveUsernamesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReorderSupergroupActiveUsernamesFunc == nil {
+		return nil, mockNotImplemented("reorderSupergroupActiveUsernames")
+	}
+
+	return mock.ReorderSupergroupActiveUsernamesFunc(ctx, req)
+}
+
+func (mock *Mock) SetSupergroupStickerSet(ctx context.Context, req *SetSupergroupStickerSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetSupergroupStickerSetFunc == nil {
+		return nil, mockNotImplemented("setSupergroupStickerSet")
+	}
+
+	return mock.SetSupergroupStickerSetFunc(ctx, req)
+}
+
+func (mock *Mock) SetSupergroupCustomEmojiStickerSet(ctx context.Context, req *SetSupergroupCustomEmojiStickerSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetSupergroupCustomEmojiStickerSetFunc == nil {
+		return nil, mockNotImplemented("setSupergroupCustomEmojiStickerSet")
+	}
+
+	return mock.SetSupergroupCustomEmojiStickerSetFunc(ctx, req)
+}
+
+func (mock *Mock) SetSupergroupUnrestrictBoostCount(ctx context.Context, req *SetSupergroupUnrestrictBoostCountRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetSupergroupUnrestrictBoostCountFunc == nil {
+		return nil, mockNotImplemented("setSupergroupUnrestrictBoostCount")
+	}
+
+	return mock.SetSupergroupUnrestrictBoostCountFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupSignMessages(ctx context.Context, req *ToggleSupergroupSignMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupSignMessagesFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupSignMessages")
+	}
+
+	return mock.ToggleSupergroupSignMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupJoinToSendMessages(ctx context.Context, req *ToggleSupergroupJoinToSendMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupJoinToSendMessagesFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupJoinToSendMessages")
+	}
+
+	return mock.ToggleSupergroupJoinToSendMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupJoinByRequest(ctx context.Context, req *ToggleSupergroupJoinByRequestRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupJoinByRequestFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupJoinByRequest")
+	}
+
+	return mock.ToggleSupergroupJoinByRequestFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupIsAllHistoryAvailable(ctx context.Context, req *ToggleSupergroupIsAllHistoryAvailableRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupIsAllHistoryAvailableFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupIsAllHistoryAvailable")
+	}
+
+	return mock.ToggleSupergroupIsAllHistoryAvailableFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupCanHaveSponsoredMessages(ctx context.Context, req *ToggleSupergroupCanHaveSponsoredMessagesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupCanHaveSponsoredMessagesFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupCanHaveSponsoredMessages")
+	}
+
+	return mock.ToggleSupergroupCanHaveSponsoredMessagesFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupHasHiddenMembers(ctx context.Context, req *ToggleSupergroupHasHiddenMembersRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupHasHiddenMembersFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupHasHiddenMembers")
+	}
+
+	return mock.ToggleSupergroupHasHiddenMembersFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupHasAggressiveAntiSpamEnabled(ctx context.Context, req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupHasAggressiveAntiSpamEnabledFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupHasAggressiveAntiSpamEnabled")
+	}
+
+	return mock.ToggleSupergroupHasAggressiveAntiSpamEnabledFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupIsForum(ctx context.Context, req *ToggleSupergroupIsForumRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupIsForumFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupIsForum")
+	}
+
+	return mock.ToggleSupergroupIsForumFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleSupergroupIsBroadcastGroup(ctx context.Context, req *ToggleSupergroupIsBroadcastGroupRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleSupergroupIsBroadcastGroupFunc == nil {
+		return nil, mockNotImplemented("toggleSupergroupIsBroadcastGroup")
+	}
+
+	return mock.ToggleSupergroupIsBroadcastGroupFunc(ctx, req)
+}
+
+func (mock *Mock) ReportSupergroupSpam(ctx context.Context, req *ReportSupergroupSpamRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReportSupergroupSpamFunc == nil {
+		return nil, mockNotImplemented("reportSupergroupSpam")
+	}
+
+	return mock.ReportSupergroupSpamFunc(ctx, req)
+}
+
+func (mock *Mock) ReportSupergroupAntiSpamFalsePositive(ctx context.Context, req *ReportSupergroupAntiSpamFalsePositiveRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReportSupergroupAntiSpamFalsePositiveFunc == nil {
+		return nil, mockNotImplemented("reportSupergroupAntiSpamFalsePositive")
+	}
+
+	return mock.ReportSupergroupAntiSpamFalsePositiveFunc(ctx, req)
+}
+
+func (mock *Mock) GetSupergroupMembers(ctx context.Context, req *GetSupergroupMembersRequest) (*ChatMembers, error) {
+	mock.record(req)
+
+	if mock.GetSupergroupMembersFunc == nil {
+		return nil, mockNotImplemented("getSupergroupMembers")
+	}
+
+	return mock.GetSupergroupMembersFunc(ctx, req)
+}
+
+func (mock *Mock) CloseSecretChat(ctx context.Context, req *CloseSecretChatRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CloseSecretChatFunc == nil {
+		return nil, mockNotImplemented("closeSecretChat")
+	}
+
+	return mock.CloseSecretChatFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatEventLog(ctx context.Context, req *GetChatEventLogRequest) (*ChatEvents, error) {
+	mock.record(req)
+
+	if mock.GetChatEventLogFunc == nil {
+		return nil, mockNotImplemented("getChatEventLog")
+	}
+
+	return mock.GetChatEventLogFunc(ctx, req)
+}
+
+func (mock *Mock) GetTimeZones(ctx context.Context) (*TimeZones, error) {
+	mock.record(&GetTimeZonesRequest{})
+
+	if mock.GetTimeZonesFunc == nil {
+		return nil, mockNotImplemented("getTimeZones")
+	}
+
+	return mock.GetTimeZonesFunc(ctx)
+}
+
+func (mock *Mock) GetPaymentForm(ctx context.Context, req *GetPaymentFormRequest) (*PaymentForm, error) {
+	mock.record(req)
+
+	if mock.GetPaymentFormFunc == nil {
+		return nil, mockNotImplemented("getPaymentForm")
+	}
+
+	return mock.GetPaymentFormFunc(ctx, req)
+}
+
+func (mock *Mock) ValidateOrderInfo(ctx context.Context, req *ValidateOrderInfoRequest) (*ValidatedOrderInfo, error) {
+	mock.record(req)
+
+	if mock.ValidateOrderInfoFunc == nil {
+		return nil, mockNotImplemented("validateOrderInfo")
+	}
+
+	return mock.ValidateOrderInfoFunc(ctx, req)
+}
+
+func (mock *Mock) SendPaymentForm(ctx context.Context, req *SendPaymentFormRequest) (*PaymentResult, error) {
+	mock.record(req)
+
+	if mock.SendPaymentFormFunc == nil {
+		return nil, mockNotImplemented("sendPaymentForm")
+	}
+
+	return mock.SendPaymentFormFunc(ctx, req)
+}
+
+func (mock *Mock) GetPaymentReceipt(ctx context.Context, req *GetPaymentReceiptRequest) (*PaymentReceipt, error) {
+	mock.record(req)
+
+	if mock.GetPaymentReceiptFunc == nil {
+		return nil, mockNotImplemented("getPaymentReceipt")
+	}
+
+	return mock.GetPaymentReceiptFunc(ctx, req)
+}
+
+func (mock *Mock) GetSavedOrderInfo(ctx context.Context) (*OrderInfo, error) {
+	mock.record(&GetSavedOrderInfoRequest{})
+
+	if mock.GetSavedOrderInfoFunc == nil {
+		return nil, mockNotImplemented("getSavedOrderInfo")
+	}
+
+	return mock.GetSavedOrderInfoFunc(ctx)
+}
+
+func (mock *Mock) DeleteSavedOrderInfo(ctx context.Context) (*Ok, error) {
+	mock.record(&DeleteSavedOrderInfoRequest{})
+
+	if mock.DeleteSavedOrderInfoFunc == nil {
+		return nil, mockNotImplemented("deleteSavedOrderInfo")
+	}
+
+	return mock.DeleteSavedOrderInfoFunc(ctx)
+}
+
+func (mock *Mock) DeleteSavedCredentials(ctx context.Context) (*Ok, error) {
+	mock.record(&DeleteSavedCredentialsRequest{})
+
+	if mock.DeleteSavedCredentialsFunc == nil {
+		return nil, mockNotImplemented("deleteSavedCredentials")
+	}
+
+	return mock.DeleteSavedCredentialsFunc(ctx)
+}
+
+func (mock *Mock) SetGiftSettings(ctx context.Context, req *SetGiftSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetGiftSettingsFunc == nil {
+		return nil, mockNotImplemented("setGiftSettings")
+	}
+
+	return mock.SetGiftSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) GetAvailableGifts(ctx context.Context) (*Gifts, error) {
+	mock.record(&GetAvailableGiftsRequest{})
+
+	if mock.GetAvailableGiftsFunc == nil {
+		return nil, mockNotImplemented("getAvailableGifts")
+	}
+
+	return mock.GetAvailableGiftsFunc(ctx)
+}
+
+func (mock *Mock) SendGift(ctx context.Context, req *SendGiftRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendGiftFunc == nil {
+		return nil, mockNotImplemented("sendGift")
+	}
+
+	return mock.SendGiftFunc(ctx, req)
+}
+
+func (mock *Mock) SellGift(ctx context.Context, req *SellGiftRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SellGiftFunc == nil {
+		return nil, mockNotImplemented("sellGift")
+	}
+
+	return mock.SellGiftFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleGiftIsSaved(ctx context.Context, req *ToggleGiftIsSavedRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleGiftIsSavedFunc == nil {
+		return nil, mockNotImplemented("toggleGiftIsSaved")
+	}
+
+	return mock.ToggleGiftIsSavedFunc(ctx, req)
+}
+
+func (mock *Mock) SetPinnedGifts(ctx context.Context, req *SetPinnedGiftsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPinnedGiftsFunc == nil {
+		return nil, mockNotImplemented("setPinnedGifts")
+	}
+
+	return mock.SetPinnedGiftsFunc(ctx, req)
+}
+
+func (mock *Mock) ToggleChatGiftNotifications(ctx context.Context, req *ToggleChatGiftNotificationsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ToggleChatGiftNotificationsFunc == nil {
+		return nil, mockNotImplemented("toggleChatGiftNotifications")
+	}
+
+	return mock.ToggleChatGiftNotificationsFunc(ctx, req)
+}
+
+func (mock *Mock) GetGiftUpgradePreview(ctx context.Context, req *GetGiftUpgradePreviewRequest) (*GiftUpgradePreview, error) {
+	mock.record(req)
+
+	if mock.GetGiftUpgradePreviewFunc == nil {
+		return nil, mockNotImplemented("getGiftUpgradePreview")
+	}
+
+	return mock.GetGiftUpgradePreviewFunc(ctx, req)
+}
+
+func (mock *Mock) UpgradeGift(ctx context.Context, req *UpgradeGiftRequest) (*UpgradeGiftResult, error) {
+	mock.record(req)
+
+	if mock.UpgradeGiftFunc == nil {
+		return nil, mockNotImplemented("upgradeGift")
+	}
+
+	return mock.UpgradeGiftFunc(ctx, req)
+}
+
+func (mock *Mock) TransferGift(ctx context.Context, req *TransferGiftRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.TransferGiftFunc == nil {
+		return nil, mockNotImplemented("transferGift")
+	}
+
+	return mock.TransferGiftFunc(ctx, req)
+}
+
+func (mock *Mock) GetReceivedGifts(ctx context.Context, req *GetReceivedGiftsRequest) (*ReceivedGifts, error) {
+	mock.record(req)
+
+	if mock.GetReceivedGiftsFunc == nil {
+		return nil, mockNotImplemented("getReceivedGifts")
+	}
+
+	return mock.GetReceivedGiftsFunc(ctx, req)
+}
+
+func (mock *Mock) GetReceivedGift(ctx context.Context, req *GetReceivedGiftRequest) (*ReceivedGift, error) {
+	mock.record(req)
+
+	if mock.GetReceivedGiftFunc == nil {
+		return nil, mockNotImplemented("getReceivedGift")
+	}
+
+	return mock.GetReceivedGiftFunc(ctx, req)
+}
+
+func (mock *Mock) GetUpgradedGift(ctx context.Context, req *GetUpgradedGiftRequest) (*UpgradedGift, error) {
+	mock.record(req)
+
+	if mock.GetUpgradedGiftFunc == nil {
+		return nil, mockNotImplemented("getUpgradedGift")
+	}
+
+	return mock.GetUpgradedGiftFunc(ctx, req)
+}
+
+func (mock *Mock) GetUpgradedGiftWithdrawalUrl(ctx context.Context, req *GetUpgradedGiftWithdrawalUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetUpgradedGiftWithdrawalUrlFunc == nil {
+		return nil, mockNotImplemented("getUpgradedGiftWithdrawalUrl")
+	}
+
+	return mock.GetUpgradedGiftWithdrawalUrlFunc(ctx, req)
+}
+
+func (mock *Mock) CreateInvoiceLink(ctx context.Context, req *CreateInvoiceLinkRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.CreateInvoiceLinkFunc == nil {
+		return nil, mockNotImplemented("createInvoiceLink")
+	}
+
+	return mock.CreateInvoiceLinkFunc(ctx, req)
+}
+
+func (mock *Mock) RefundStarPayment(ctx context.Context, req *RefundStarPaymentRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RefundStarPaymentFunc == nil {
+		return nil, mockNotImplemented("refundStarPayment")
+	}
+
+	return mock.RefundStarPaymentFunc(ctx, req)
+}
+
+func (mock *Mock) GetSupportUser(ctx context.Context) (*User, error) {
+	mock.record(&GetSupportUserRequest{})
+
+	if mock.GetSupportUserFunc == nil {
+		return nil, mockNotImplemented("getSupportUser")
+	}
+
+	return mock.GetSupportUserFunc(ctx)
+}
+
+func (mock *Mock) GetBackgroundUrl(ctx context.Context, req *GetBackgroundUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetBackgroundUrlFunc == nil {
+		return nil, mockNotImplemented("getBackgroundUrl")
+	}
+
+	return mock.GetBackgroundUrlFunc(ctx, req)
+}
+
+func (mock *Mock) SearchBackground(ctx context.Context, req *SearchBackgroundRequest) (*Background, error) {
+	mock.record(req)
+
+	if mock.SearchBackgroundFunc == nil {
+		return nil, mockNotImplemented("searchBackground")
+	}
+
+	return mock.SearchBackgroundFunc(ctx, req)
+}
+
+func (mock *Mock) SetDefaultBackground(ctx context.Context, req *SetDefaultBackgroundRequest) (*Background, error) {
+	mock.record(req)
+
+	if mock.SetDefaultBackgroundFunc == nil {
+		return nil, mockNotImplemented("setDefaultBackground")
+	}
+
+	return mock.SetDefaultBackgroundFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteDefaultBackground(ctx context.Context, req *DeleteDefaultBackgroundRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteDefaultBackgroundFunc == nil {
+		return nil, mockNotImplemented("deleteDefaultBackground")
+	}
+
+	return mock.DeleteDefaultBackgroundFunc(ctx, req)
+}
+
+func (mock *Mock) GetInstalledBackgrounds(ctx context.Context, req *GetInstalledBackgroundsRequest) (*Backgrounds, error) {
+	mock.record(req)
+
+	if mock.GetInstalledBackgroundsFunc == nil {
+		return nil, mockNotImplemented("getInstalledBackgrounds")
+	}
+
+	return mock.GetInstalledBackgroundsFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveInstalledBackground(ctx context.Context, req *RemoveInstalledBackgroundRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveInstalledBackgroundFunc == nil {
+		return nil, mockNotImplemented("removeInstalledBackground")
+	}
+
+	return mock.RemoveInstalledBackgroundFunc(ctx, req)
+}
+
+func (mock *Mock) ResetInstalledBackgrounds(ctx context.Context) (*Ok, error) {
+	mock.record(&ResetInstalledBackgroundsRequest{})
+
+	if mock.ResetInstalledBackgroundsFunc == nil {
+		return nil, mockNotImplemented("resetInstalledBackgrounds")
+	}
+
+	return mock.ResetInstalledBackgroundsFunc(ctx)
+}
+
+func (mock *Mock) GetLocalizationTargetInfo(ctx context.Context, req *GetLocalizationTargetInfoRequest) (*LocalizationTargetInfo, error) {
+	mock.record(req)
+
+	if mock.GetLocalizationTargetInfoFunc == nil {
+		return nil, mockNotImplemented("getLocalizationTargetInfo")
+	}
+
+	return mock.GetLocalizationTargetInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetLanguagePackInfo(ctx context.Context, req *GetLanguagePackInfoRequest) (*LanguagePackInfo, error) {
+	mock.record(req)
+
+	if mock.GetLanguagePackInfoFunc == nil {
+		return nil, mockNotImplemented("getLanguagePackInfo")
+	}
+
+	return mock.GetLanguagePackInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetLanguagePackStrings(ctx context.Context, req *GetLanguagePackStringsRequest) (*LanguagePackStrings, error) {
+	mock.record(req)
+
+	if mock.GetLanguagePackStringsFunc == nil {
+		return nil, mockNotImplemented("getLanguagePackStrings")
+	}
+
+	return mock.GetLanguagePackStringsFunc(ctx, req)
+}
+
+func (mock *Mock) SynchronizeLanguagePack(ctx context.Context, req *SynchronizeLanguagePackRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SynchronizeLanguagePackFunc == nil {
+		return nil, mockNotImplemented("synchronizeLanguagePack")
+	}
+
+	return mock.SynchronizeLanguagePackFunc(ctx, req)
+}
+
+func (mock *Mock) AddCustomServerLanguagePack(ctx context.Context, req *AddCustomServerLanguagePackRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddCustomServerLanguagePackFunc == nil {
+		return nil, mockNotImplemented("addCustomServerLanguagePack")
+	}
+
+	return mock.AddCustomServerLanguagePackFunc(ctx, req)
+}
+
+func (mock *Mock) SetCustomLanguagePack(ctx context.Context, req *SetCustomLanguagePackRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetCustomLanguagePackFunc == nil {
+		return nil, mockNotImplemented("setCustomLanguagePack")
+	}
+
+	return mock.SetCustomLanguagePackFunc(ctx, req)
+}
+
+func (mock *Mock) EditCustomLanguagePackInfo(ctx context.Context, req *EditCustomLanguagePackInfoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditCustomLanguagePackInfoFunc == nil {
+		return nil, mockNotImplemented("editCustomLanguagePackInfo")
+	}
+
+	return mock.EditCustomLanguagePackInfoFunc(ctx, req)
+}
+
+func (mock *Mock) SetCustomLanguagePackString(ctx context.Context, req *SetCustomLanguagePackStringRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetCustomLanguagePackStringFunc == nil {
+		return nil, mockNotImplemented("setCustomLanguagePackString")
+	}
+
+	return mock.SetCustomLanguagePackStringFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteLanguagePack(ctx context.Context, req *DeleteLanguagePackRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteLanguagePackFunc == nil {
+		return nil, mockNotImplemented("deleteLanguagePack")
+	}
+
+	return mock.DeleteLanguagePackFunc(ctx, req)
+}
+
+func (mock *Mock) RegisterDevice(ctx context.Context, req *RegisterDeviceRequest) (*PushReceiverId, error) {
+	mock.record(req)
+
+	if mock.RegisterDeviceFunc == nil {
+		return nil, mockNotImplemented("registerDevice")
+	}
+
+	return mock.RegisterDeviceFunc(ctx, req)
+}
+
+func (mock *Mock) ProcessPushNotification(ctx context.Context, req *ProcessPushNotificationRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ProcessPushNotificationFunc == nil {
+		return nil, mockNotImplemented("processPushNotification")
+	}
+
+	return mock.ProcessPushNotificationFunc(ctx, req)
+}
+
+func (mock *Mock) GetRecentlyVisitedTMeUrls(ctx context.Context, req *GetRecentlyVisitedTMeUrlsRequest) (*TMeUrls, error) {
+	mock.record(req)
+
+	if mock.GetRecentlyVisitedTMeUrlsFunc == nil {
+		return nil, mockNotImplemented("getRecentlyVisitedTMeUrls")
+	}
+
+	return mock.GetRecentlyVisitedTMeUrlsFunc(ctx, req)
+}
+
+func (mock *Mock) SetUserPrivacySettingRules(ctx context.Context, req *SetUserPrivacySettingRulesRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetUserPrivacySettingRulesFunc == nil {
+		return nil, mockNotImplemented("setUserPrivacySettingRules")
+	}
+
+	return mock.SetUserPrivacySettingRulesFunc(ctx, req)
+}
+
+func (mock *Mock) GetUserPrivacySettingRules(ctx context.Context, req *GetUserPrivacySettingRulesRequest) (*UserPrivacySettingRules, error) {
+	mock.record(req)
+
+	if mock.GetUserPrivacySettingRulesFunc == nil {
+		return nil, mockNotImplemented("getUserPrivacySettingRules")
+	}
+
+	return mock.GetUserPrivacySettingRulesFunc(ctx, req)
+}
+
+func (mock *Mock) SetReadDatePrivacySettings(ctx context.Context, req *SetReadDatePrivacySettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetReadDatePrivacySettingsFunc == nil {
+		return nil, mockNotImplemented("setReadDatePrivacySettings")
+	}
+
+	return mock.SetReadDatePrivacySettingsFunc(ctx, req)
+}
+
+func (mock *Mock) GetReadDatePrivacySettings(ctx context.Context) (*ReadDatePrivacySettings, error) {
+	mock.record(&GetReadDatePrivacySettingsRequest{})
+
+	if mock.GetReadDatePrivacySettingsFunc == nil {
+		return nil, mockNotImplemented("getReadDatePrivacySettings")
+	}
+
+	return mock.GetReadDatePrivacySettingsFunc(ctx)
+}
+
+func (mock *Mock) SetNewChatPrivacySettings(ctx context.Context, req *SetNewChatPrivacySettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetNewChatPrivacySettingsFunc == nil {
+		return nil, mockNotImplemented("setNewChatPrivacySettings")
+	}
+
+	return mock.SetNewChatPrivacySettingsFunc(ctx, req)
+}
+
+func (mock *Mock) GetNewChatPrivacySettings(ctx context.Context) (*NewChatPrivacySettings, error) {
+	mock.record(&GetNewChatPrivacySettingsRequest{})
+
+	if mock.GetNewChatPrivacySettingsFunc == nil {
+		return nil, mockNotImplemented("getNewChatPrivacySettings")
+	}
+
+	return mock.GetNewChatPrivacySettingsFunc(ctx)
+}
+
+func (mock *Mock) GetPaidMessageRevenue(ctx context.Context, req *GetPaidMessageRevenueRequest) (*StarCount, error) {
+	mock.record(req)
+
+	if mock.GetPaidMessageRevenueFunc == nil {
+		return nil, mockNotImplemented("getPaidMessageRevenue")
+	}
+
+	return mock.GetPaidMessageRevenueFunc(ctx, req)
+}
+
+func (mock *Mock) AllowUnpaidMessagesFromUser(ctx context.Context, req *AllowUnpaidMessagesFromUserRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AllowUnpaidMessagesFromUserFunc == nil {
+		return nil, mockNotImplemented("allowUnpaidMessagesFromUser")
+	}
+
+	return mock.AllowUnpaidMessagesFromUserFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatPaidMessageStarCount(ctx context.Context, req *SetChatPaidMessageStarCountRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatPaidMessageStarCountFunc == nil {
+		return nil, mockNotImplemented("setChatPaidMessageStarCount")
+	}
+
+	return mock.SetChatPaidMessageStarCountFunc(ctx, req)
+}
+
+func (mock *Mock) CanSendMessageToUser(ctx context.Context, req *CanSendMessageToUserRequest) (CanSendMessageToUserResult, error) {
+	mock.record(req)
+
+	if mock.CanSendMessageToUserFunc == nil {
+		return nil, mockNotImplemented("canSendMessageToUser")
+	}
+
+	return mock.CanSendMessageToUserFunc(ctx, req)
+}
+
+func (mock *Mock) SetOption(ctx context.Context, req *SetOptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetOptionFunc == nil {
+		return nil, mockNotImplemented("setOption")
+	}
+
+	return mock.SetOptionFunc(ctx, req)
+}
+
+func (mock *Mock) SetAccountTtl(ctx context.Context, req *SetAccountTtlRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAccountTtlFunc == nil {
+		return nil, mockNotImplemented("setAccountTtl")
+	}
+
+	return mock.SetAccountTtlFunc(ctx, req)
+}
+
+func (mock *Mock) GetAccountTtl(ctx context.Context) (*AccountTtl, error) {
+	mock.record(&GetAccountTtlRequest{})
+
+	if mock.GetAccountTtlFunc == nil {
+		return nil, mockNotImplemented("getAccountTtl")
+	}
+
+	return mock.GetAccountTtlFunc(ctx)
+}
+
+func (mock *Mock) DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteAccountFunc == nil {
+		return nil, mockNotImplemented("deleteAccount")
+	}
+
+	return mock.DeleteAccountFunc(ctx, req)
+}
+
+func (mock *Mock) SetDefaultMessageAutoDeleteTime(ctx context.Context, req *SetDefaultMessageAutoDeleteTimeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetDefaultMessageAutoDeleteTimeFunc == nil {
+		return nil, mockNotImplemented("setDefaultMessageAutoDeleteTime")
+	}
+
+	return mock.SetDefaultMessageAutoDeleteTimeFunc(ctx, req)
+}
+
+func (mock *Mock) GetDefaultMessageAutoDeleteTime(ctx context.Context) (*MessageAutoDeleteTime, error) {
+	mock.record(&GetDefaultMessageAutoDeleteTimeRequest{})
+
+	if mock.GetDefaultMessageAutoDeleteTimeFunc == nil {
+		return nil, mockNotImplemented("getDefaultMessageAutoDeleteTime")
+	}
+
+	return mock.GetDefaultMessageAutoDeleteTimeFunc(ctx)
+}
+
+func (mock *Mock) RemoveChatActionBar(ctx context.Context, req *RemoveChatActionBarRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveChatActionBarFunc == nil {
+		return nil, mockNotImplemented("removeChatActionBar")
+	}
+
+	return mock.RemoveChatActionBarFunc(ctx, req)
+}
+
+func (mock *Mock) ReportChat(ctx context.Context, req *ReportChatRequest) (ReportChatResult, error) {
+	mock.record(req)
+
+	if mock.ReportChatFunc == nil {
+		return nil, mockNotImplemented("reportChat")
+	}
+
+	return mock.ReportChatFunc(ctx, req)
+}
+
+func (mock *Mock) ReportChatPhoto(ctx context.Context, req *ReportChatPhotoRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReportChatPhotoFunc == nil {
+		return nil, mockNotImplemented("reportChatPhoto")
+	}
+
+	return mock.ReportChatPhotoFunc(ctx, req)
+}
+
+func (mock *Mock) ReportMessageReactions(ctx context.Context, req *ReportMessageReactionsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReportMessageReactionsFunc == nil {
+		return nil, mockNotImplemented("reportMessageReactions")
+	}
+
+	return mock.ReportMessageReactionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatRevenueStatistics(ctx context.Context, req *GetChatRevenueStatisticsRequest) (*ChatRevenueStatistics, error) {
+	mock.record(req)
+
+	if mock.GetChatRevenueStatisticsFunc == nil {
+		return nil, mockNotImplemented("getChatRevenueStatistics")
+	}
+
+	return mock.GetChatRevenueStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatRevenueWithdrawalUrl(ctx context.Context, req *GetChatRevenueWithdrawalUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetChatRevenueWithdrawalUrlFunc == nil {
+		return nil, mockNotImplemented("getChatRevenueWithdrawalUrl")
+	}
+
+	return mock.GetChatRevenueWithdrawalUrlFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatRevenueTransactions(ctx context.Context, req *GetChatRevenueTransactionsRequest) (*ChatRevenueTransactions, error) {
+	mock.record(req)
+
+	if mock.GetChatRevenueTransactionsFunc == nil {
+		return nil, mockNotImplemented("getChatRevenueTransactions")
+	}
+
+	return mock.GetChatRevenueTransactionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStarRevenueStatistics(ctx context.Context, req *GetStarRevenueStatisticsRequest) (*StarRevenueStatistics, error) {
+	mock.record(req)
+
+	if mock.GetStarRevenueStatisticsFunc == nil {
+		return nil, mockNotImplemented("getStarRevenueStatistics")
+	}
+
+	return mock.GetStarRevenueStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStarWithdrawalUrl(ctx context.Context, req *GetStarWithdrawalUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetStarWithdrawalUrlFunc == nil {
+		return nil, mockNotImplemented("getStarWithdrawalUrl")
+	}
+
+	return mock.GetStarWithdrawalUrlFunc(ctx, req)
+}
+
+func (mock *Mock) GetStarAdAccountUrl(ctx context.Context, req *GetStarAdAccountUrlRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetStarAdAccountUrlFunc == nil {
+		return nil, mockNotImplemented("getStarAdAccountUrl")
+	}
+
+	return mock.GetStarAdAccountUrlFunc(ctx, req)
+}
+
+func (mock *Mock) GetChatStatistics(ctx context.Context, req *GetChatStatisticsRequest) (ChatStatistics, error) {
+	mock.record(req)
+
+	if mock.GetChatStatisticsFunc == nil {
+		return nil, mockNotImplemented("getChatStatistics")
+	}
+
+	return mock.GetChatStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessageStatistics(ctx context.Context, req *GetMessageStatisticsRequest) (*MessageStatistics, error) {
+	mock.record(req)
+
+	if mock.GetMessageStatisticsFunc == nil {
+		return nil, mockNotImplemented("getMessageStatistics")
+	}
+
+	return mock.GetMessageStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) GetMessagePublicForwards(ctx context.Context, req *GetMessagePublicForwardsRequest) (*PublicForwards, error) {
+	mock.record(req)
+
+	if mock.GetMessagePublicForwardsFunc == nil {
+		return nil, mockNotImplemented("getMessagePublicForwards")
+	}
+
+	return mock.GetMessagePublicForwardsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStoryStatistics(ctx context.Context, req *GetStoryStatisticsRequest) (*StoryStatistics, error) {
+	mock.record(req)
+
+	if mock.GetStoryStatisticsFunc == nil {
+		return nil, mockNotImplemented("getStoryStatistics")
+	}
+
+	return mock.GetStoryStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStatisticalGraph(ctx context.Context, req *GetStatisticalGraphRequest) (StatisticalGraph, error) {
+	mock.record(req)
+
+	if mock.GetStatisticalGraphFunc == nil {
+		return nil, mockNotImplemented("getStatisticalGraph")
+	}
+
+	return mock.GetStatisticalGraphFunc(ctx, req)
+}
+
+func (mock *Mock) GetStorageStatistics(ctx context.Context, req *GetStorageStatisticsRequest) (*StorageStatistics, error) {
+	mock.record(req)
+
+	if mock.GetStorageStatisticsFunc == nil {
+		return nil, mockNotImplemented("getStorageStatistics")
+	}
+
+	return mock.GetStorageStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStorageStatisticsFast(ctx context.Context) (*StorageStatisticsFast, error) {
+	mock.record(&GetStorageStatisticsFastRequest{})
+
+	if mock.GetStorageStatisticsFastFunc == nil {
+		return nil, mockNotImplemented("getStorageStatisticsFast")
+	}
+
+	return mock.GetStorageStatisticsFastFunc(ctx)
+}
+
+func (mock *Mock) GetDatabaseStatistics(ctx context.Context) (*DatabaseStatistics, error) {
+	mock.record(&GetDatabaseStatisticsRequest{})
+
+	if mock.GetDatabaseStatisticsFunc == nil {
+		return nil, mockNotImplemented("getDatabaseStatistics")
+	}
+
+	return mock.GetDatabaseStatisticsFunc(ctx)
+}
+
+func (mock *Mock) OptimizeStorage(ctx context.Context, req *OptimizeStorageRequest) (*StorageStatistics, error) {
+	mock.record(req)
+
+	if mock.OptimizeStorageFunc == nil {
+		return nil, mockNotImplemented("optimizeStorage")
+	}
+
+	return mock.OptimizeStorageFunc(ctx, req)
+}
+
+func (mock *Mock) SetNetworkType(ctx context.Context, req *SetNetworkTypeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetNetworkTypeFunc == nil {
+		return nil, mockNotImplemented("setNetworkType")
+	}
+
+	return mock.SetNetworkTypeFunc(ctx, req)
+}
+
+func (mock *Mock) GetNetworkStatistics(ctx context.Context, req *GetNetworkStatisticsRequest) (*NetworkStatistics, error) {
+	mock.record(req)
+
+	if mock.GetNetworkStatisticsFunc == nil {
+		return nil, mockNotImplemented("getNetworkStatistics")
+	}
+
+	return mock.GetNetworkStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) AddNetworkStatistics(ctx context.Context, req *AddNetworkStatisticsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddNetworkStatisticsFunc == nil {
+		return nil, mockNotImplemented("addNetworkStatistics")
+	}
+
+	return mock.AddNetworkStatisticsFunc(ctx, req)
+}
+
+func (mock *Mock) ResetNetworkStatistics(ctx context.Context) (*Ok, error) {
+	mock.record(&ResetNetworkStatisticsRequest{})
+
+	if mock.ResetNetworkStatisticsFunc == nil {
+		return nil, mockNotImplemented("resetNetworkStatistics")
+	}
+
+	return mock.ResetNetworkStatisticsFunc(ctx)
+}
+
+func (mock *Mock) GetAutoDownloadSettingsPresets(ctx context.Context) (*AutoDownloadSettingsPresets, error) {
+	mock.record(&GetAutoDownloadSettingsPresetsRequest{})
+
+	if mock.GetAutoDownloadSettingsPresetsFunc == nil {
+		return nil, mockNotImplemented("getAutoDownloadSettingsPresets")
+	}
+
+	return mock.GetAutoDownloadSettingsPresetsFunc(ctx)
+}
+
+func (mock *Mock) SetAutoDownloadSettings(ctx context.Context, req *SetAutoDownloadSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAutoDownloadSettingsFunc == nil {
+		return nil, mockNotImplemented("setAutoDownloadSettings")
+	}
+
+	return mock.SetAutoDownloadSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) GetAutosaveSettings(ctx context.Context) (*AutosaveSettings, error) {
+	mock.record(&GetAutosaveSettingsRequest{})
+
+	if mock.GetAutosaveSettingsFunc == nil {
+		return nil, mockNotImplemented("getAutosaveSettings")
+	}
+
+	return mock.GetAutosaveSettingsFunc(ctx)
+}
+
+func (mock *Mock) SetAutosaveSettings(ctx context.Context, req *SetAutosaveSettingsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAutosaveSettingsFunc == nil {
+		return nil, mockNotImplemented("setAutosaveSettings")
+	}
+
+	return mock.SetAutosaveSettingsFunc(ctx, req)
+}
+
+func (mock *Mock) ClearAutosaveSettingsExceptions(ctx context.Context) (*Ok, error) {
+	mock.record(&ClearAutosaveSettingsExceptionsRequest{})
+
+	if mock.ClearAutosaveSettingsExceptionsFunc == nil {
+		return nil, mockNotImplemented("clearAutosaveSettingsExceptions")
+	}
+
+	return mock.ClearAutosaveSettingsExceptionsFunc(ctx)
+}
+
+func (mock *Mock) GetBankCardInfo(ctx context.Context, req *GetBankCardInfoRequest) (*BankCardInfo, error) {
+	mock.record(req)
+
+	if mock.GetBankCardInfoFunc == nil {
+		return nil, mockNotImplemented("getBankCardInfo")
+	}
+
+	return mock.GetBankCardInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetPassportElement(ctx context.Context, req *GetPassportElementRequest) (PassportElement, error) {
+	mock.record(req)
+
+	if mock.GetPassportElementFunc == nil {
+		return nil, mockNotImplemented("getPassportElement")
+	}
+
+	return mock.GetPassportElementFunc(ctx, req)
+}
+
+func (mock *Mock) GetAllPassportElements(ctx context.Context, req *GetAllPassportElementsRequest) (*PassportElements, error) {
+	mock.record(req)
+
+	if mock.GetAllPassportElementsFunc == nil {
+		return nil, mockNotImplemented("getAllPassportElements")
+	}
+
+	return mock.GetAllPassportElementsFunc(ctx, req)
+}
+
+func (mock *Mock) SetPassportElement(ctx context.Context, req *SetPassportElementRequest) (PassportElement, error) {
+	mock.record(req)
+
+	if mock.SetPassportElementFunc == nil {
+		return nil, mockNotImplemented("setPassportElement")
+	}
+
+	return mock.SetPassportElementFunc(ctx, req)
+}
+
+func (mock *Mock) DeletePassportElement(ctx context.Context, req *DeletePassportElementRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeletePassportElementFunc == nil {
+		return nil, mockNotImplemented("deletePassportElement")
+	}
+
+	return mock.DeletePassportElementFunc(ctx, req)
+}
+
+func (mock *Mock) SetPassportElementErrors(ctx context.Context, req *SetPassportElementErrorsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetPassportElementErrorsFunc == nil {
+		return nil, mockNotImplemented("setPassportElementErrors")
+	}
+
+	return mock.SetPassportElementErrorsFunc(ctx, req)
+}
+
+func (mock *Mock) GetPreferredCountryLanguage(ctx context.Context, req *GetPreferredCountryLanguageRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetPreferredCountryLanguageFunc == nil {
+		return nil, mockNotImplemented("getPreferredCountryLanguage")
+	}
+
+	return mock.GetPreferredCountryLanguageFunc(ctx, req)
+}
+
+func (mock *Mock) SendEmailAddressVerificationCode(ctx context.Context, req *SendEmailAddressVerificationCodeRequest) (*EmailAddressAuthenticationCodeInfo, error) {
+	mock.record(req)
+
+	if mock.SendEmailAddressVerificationCodeFunc == nil {
+		return nil, mockNotImplemented("sendEmailAddressVerificationCode")
+	}
+
+	return mock.SendEmailAddressVerificationCodeFunc(ctx, req)
+}
+
+func (mock *Mock) ResendEmailAddressVerificationCode(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	mock.record(&ResendEmailAddressVerificationCodeRequest{})
+
+	if mock.ResendEmailAddressVerificationCodeFunc == nil {
+		return nil, mockNotImplemented("resendEmailAddressVerificationCode")
+	}
+
+	return mock.ResendEmailAddressVerificationCodeFunc(ctx)
+}
+
+func (mock *Mock) CheckEmailAddressVerificationCode(ctx context.Context, req *CheckEmailAddressVerificationCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CheckEmailAddressVerificationCodeFunc == nil {
+		return nil, mockNotImplemented("checkEmailAddressVerificationCode")
+	}
+
+	return mock.CheckEmailAddressVerificationCodeFunc(ctx, req)
+}
+
+func (mock *Mock) GetPassportAuthorizationForm(ctx context.Context, req *GetPassportAuthorizationFormRequest) (*PassportAuthorizationForm, error) {
+	mock.record(req)
+
+	if mock.GetPassportAuthorizationFormFunc == nil {
+		return nil, mockNotImplemented("getPassportAuthorizationForm")
+	}
+
+	return mock.GetPassportAuthorizationFormFunc(ctx, req)
+}
+
+func (mock *Mock) GetPassportAuthorizationFormAvailableElements(ctx context.Context, req *GetPassportAuthorizationFormAvailableElementsRequest) (*PassportElementsWithErrors, error) {
+	mock.record(req)
+
+	if mock.GetPassportAuthorizationFormAvailableElementsFunc == nil {
+		return nil, mockNotImplemented("getPassportAuthorizationFormAvailableElements")
+	}
+
+	return mock.GetPassportAuthorizationFormAvailableElementsFunc(ctx, req)
+}
+
+func (mock *Mock) SendPassportAuthorizationForm(ctx context.Context, req *SendPassportAuthorizationFormRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SendPassportAuthorizationFormFunc == nil {
+		return nil, mockNotImplemented("sendPassportAuthorizationForm")
+	}
+
+	return mock.SendPassportAuthorizationFormFunc(ctx, req)
+}
+
+func (mock *Mock) SetBotUpdatesStatus(ctx context.Context, req *SetBotUpdatesStatusRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetBotUpdatesStatusFunc == nil {
+		return nil, mockNotImplemented("setBotUpdatesStatus")
+	}
+
+	return mock.SetBotUpdatesStatusFunc(ctx, req)
+}
+
+func (mock *Mock) UploadStickerFile(ctx context.Context, req *UploadStickerFileRequest) (*File, error) {
+	mock.record(req)
+
+	if mock.UploadStickerFileFunc == nil {
+		return nil, mockNotImplemented("uploadStickerFile")
+	}
+
+	return mock.UploadStickerFileFunc(ctx, req)
+}
+
+func (mock *Mock) GetSuggestedStickerSetName(ctx context.Context, req *GetSuggestedStickerSetNameRequest) (*Text, error) {
+	mock.record(req)
+
+	if mock.GetSuggestedStickerSetNameFunc == nil {
+		return nil, mockNotImplemented("getSuggestedStickerSetName")
+	}
+
+	return mock.GetSuggestedStickerSetNameFunc(ctx, req)
+}
+
+func (mock *Mock) CheckStickerSetName(ctx context.Context, req *CheckStickerSetNameRequest) (CheckStickerSetNameResult, error) {
+	mock.record(req)
+
+	if mock.CheckStickerSetNameFunc == nil {
+		return nil, mockNotImplemented("checkStickerSetName")
+	}
+
+	return mock.CheckStickerSetNameFunc(ctx, req)
+}
+
+func (mock *Mock) CreateNewStickerSet(ctx context.Context, req *CreateNewStickerSetRequest) (*StickerSet, error) {
+	mock.record(req)
+
+	if mock.CreateNewStickerSetFunc == nil {
+		return nil, mockNotImplemented("createNewStickerSet")
+	}
+
+	return mock.CreateNewStickerSetFunc(ctx, req)
+}
+
+func (mock *Mock) AddStickerToSet(ctx context.Context, req *AddStickerToSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AddStickerToSetFunc == nil {
+		return nil, mockNotImplemented("addStickerToSet")
+	}
+
+	return mock.AddStickerToSetFunc(ctx, req)
+}
+
+func (mock *Mock) ReplaceStickerInSet(ctx context.Context, req *ReplaceStickerInSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReplaceStickerInSetFunc == nil {
+		return nil, mockNotImplemented("replaceStickerInSet")
+	}
+
+	return mock.ReplaceStickerInSetFunc(ctx, req)
+}
+
+func (mock *Mock) SetStickerSetThumbnail(ctx context.Context, req *SetStickerSetThumbnailRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStickerSetThumbnailFunc == nil {
+		return nil, mockNotImplemented("setStickerSetThumbnail")
+	}
+
+	return mock.SetStickerSetThumbnailFunc(ctx, req)
+}
+
+func (mock *Mock) SetCustomEmojiStickerSetThumbnail(ctx context.Context, req *SetCustomEmojiStickerSetThumbnailRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetCustomEmojiStickerSetThumbnailFunc == nil {
+		return nil, mockNotImplemented("setCustomEmojiStickerSetThumbnail")
+	}
+
+	return mock.SetCustomEmojiStickerSetThumbnailFunc(ctx, req)
+}
+
+func (mock *Mock) SetStickerSetTitle(ctx context.Context, req *SetStickerSetTitleRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStickerSetTitleFunc == nil {
+		return nil, mockNotImplemented("setStickerSetTitle")
+	}
+
+	return mock.SetStickerSetTitleFunc(ctx, req)
+}
+
+func (mock *Mock) DeleteStickerSet(ctx context.Context, req *DeleteStickerSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.DeleteStickerSetFunc == nil {
+		return nil, mockNotImplemented("deleteStickerSet")
+	}
+
+	return mock.DeleteStickerSetFunc(ctx, req)
+}
+
+func (mock *Mock) SetStickerPositionInSet(ctx context.Context, req *SetStickerPositionInSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStickerPositionInSetFunc == nil {
+		return nil, mockNotImplemented("setStickerPositionInSet")
+	}
+
+	return mock.SetStickerPositionInSetFunc(ctx, req)
+}
+
+func (mock *Mock) RemoveStickerFromSet(ctx context.Context, req *RemoveStickerFromSetRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveStickerFromSetFunc == nil {
+		return nil, mockNotImplemented("removeStickerFromSet")
+	}
+
+	return mock.RemoveStickerFromSetFunc(ctx, req)
+}
+
+func (mock *Mock) SetStickerEmojis(ctx context.Context, req *SetStickerEmojisRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStickerEmojisFunc == nil {
+		return nil, mockNotImplemented("setStickerEmojis")
+	}
+
+	return mock.SetStickerEmojisFunc(ctx, req)
+}
+
+func (mock *Mock) SetStickerKeywords(ctx context.Context, req *SetStickerKeywordsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStickerKeywordsFunc == nil {
+		return nil, mockNotImplemented("setStickerKeywords")
+	}
+
+	return mock.SetStickerKeywordsFunc(ctx, req)
+}
+
+func (mock *Mock) SetStickerMaskPosition(ctx context.Context, req *SetStickerMaskPositionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetStickerMaskPositionFunc == nil {
+		return nil, mockNotImplemented("setStickerMaskPosition")
+	}
+
+	return mock.SetStickerMaskPositionFunc(ctx, req)
+}
+
+func (mock *Mock) GetOwnedStickerSets(ctx context.Context, req *GetOwnedStickerSetsRequest) (*StickerSets, error) {
+	mock.record(req)
+
+	if mock.GetOwnedStickerSetsFunc == nil {
+		return nil, mockNotImplemented("getOwnedStickerSets")
+	}
+
+	return mock.GetOwnedStickerSetsFunc(ctx, req)
+}
+
+func (mock *Mock) GetMapThumbnailFile(ctx context.Context, req *GetMapThumbnailFileRequest) (*File, error) {
+	mock.record(req)
+
+	if mock.GetMapThumbnailFileFunc == nil {
+		return nil, mockNotImplemented("getMapThumbnailFile")
+	}
+
+	return mock.GetMapThumbnailFileFunc(ctx, req)
+}
+
+func (mock *Mock) GetPremiumLimit(ctx context.Context, req *GetPremiumLimitRequest) (*PremiumLimit, error) {
+	mock.record(req)
+
+	if mock.GetPremiumLimitFunc == nil {
+		return nil, mockNotImplemented("getPremiumLimit")
+	}
+
+	return mock.GetPremiumLimitFunc(ctx, req)
+}
+
+func (mock *Mock) GetPremiumFeatures(ctx context.Context, req *GetPremiumFeaturesRequest) (*PremiumFeatures, error) {
+	mock.record(req)
+
+	if mock.GetPremiumFeaturesFunc == nil {
+		return nil, mockNotImplemented("getPremiumFeatures")
+	}
+
+	return mock.GetPremiumFeaturesFunc(ctx, req)
+}
+
+func (mock *Mock) GetPremiumStickerExamples(ctx context.Context) (*Stickers, error) {
+	mock.record(&GetPremiumStickerExamplesRequest{})
+
+	if mock.GetPremiumStickerExamplesFunc == nil {
+		return nil, mockNotImplemented("getPremiumStickerExamples")
+	}
+
+	return mock.GetPremiumStickerExamplesFunc(ctx)
+}
+
+func (mock *Mock) GetPremiumInfoSticker(ctx context.Context, req *GetPremiumInfoStickerRequest) (*Sticker, error) {
+	mock.record(req)
+
+	if mock.GetPremiumInfoStickerFunc == nil {
+		return nil, mockNotImplemented("getPremiumInfoSticker")
+	}
+
+	return mock.GetPremiumInfoStickerFunc(ctx, req)
+}
+
+func (mock *Mock) ViewPremiumFeature(ctx context.Context, req *ViewPremiumFeatureRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ViewPremiumFeatureFunc == nil {
+		return nil, mockNotImplemented("viewPremiumFeature")
+	}
+
+	return mock.ViewPremiumFeatureFunc(ctx, req)
+}
+
+func (mock *Mock) ClickPremiumSubscriptionButton(ctx context.Context) (*Ok, error) {
+	mock.record(&ClickPremiumSubscriptionButtonRequest{})
+
+	if mock.ClickPremiumSubscriptionButtonFunc == nil {
+		return nil, mockNotImplemented("clickPremiumSubscriptionButton")
+	}
+
+	return mock.ClickPremiumSubscriptionButtonFunc(ctx)
+}
+
+func (mock *Mock) GetPremiumState(ctx context.Context) (*PremiumState, error) {
+	mock.record(&GetPremiumStateRequest{})
+
+	if mock.GetPremiumStateFunc == nil {
+		return nil, mockNotImplemented("getPremiumState")
+	}
+
+	return mock.GetPremiumStateFunc(ctx)
+}
+
+func (mock *Mock) GetPremiumGiftPaymentOptions(ctx context.Context) (*PremiumGiftPaymentOptions, error) {
+	mock.record(&GetPremiumGiftPaymentOptionsRequest{})
+
+	if mock.GetPremiumGiftPaymentOptionsFunc == nil {
+		return nil, mockNotImplemented("getPremiumGiftPaymentOptions")
+	}
+
+	return mock.GetPremiumGiftPaymentOptionsFunc(ctx)
+}
+
+func (mock *Mock) GetPremiumGiveawayPaymentOptions(ctx context.Context, req *GetPremiumGiveawayPaymentOptionsRequest) (*PremiumGiveawayPaymentOptions, error) {
+	mock.record(req)
+
+	if mock.GetPremiumGiveawayPaymentOptionsFunc == nil {
+		return nil, mockNotImplemented("getPremiumGiveawayPaymentOptions")
+	}
+
+	return mock.GetPremiumGiveawayPaymentOptionsFunc(ctx, req)
+}
+
+func (mock *Mock) CheckPremiumGiftCode(ctx context.Context, req *CheckPremiumGiftCodeRequest) (*PremiumGiftCodeInfo, error) {
+	mock.record(req)
+
+	if mock.CheckPremiumGiftCodeFunc == nil {
+		return nil, mockNotImplemented("checkPremiumGiftCode")
+	}
+
+	return mock.CheckPremiumGiftCodeFunc(ctx, req)
+}
+
+func (mock *Mock) ApplyPremiumGiftCode(ctx context.Context, req *ApplyPremiumGiftCodeRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ApplyPremiumGiftCodeFunc == nil {
+		return nil, mockNotImplemented("applyPremiumGiftCode")
+	}
+
+	return mock.ApplyPremiumGiftCodeFunc(ctx, req)
+}
+
+func (mock *Mock) GiftPremiumWithStars(ctx context.Context, req *GiftPremiumWithStarsRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.GiftPremiumWithStarsFunc == nil {
+		return nil, mockNotImplemented("giftPremiumWithStars")
+	}
+
+	return mock.GiftPremiumWithStarsFunc(ctx, req)
+}
+
+func (mock *Mock) LaunchPrepaidGiveaway(ctx context.Context, req *LaunchPrepaidGiveawayRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.LaunchPrepaidGiveawayFunc == nil {
+		return nil, mockNotImplemented("launchPrepaidGiveaway")
+	}
+
+	return mock.LaunchPrepaidGiveawayFunc(ctx, req)
+}
+
+func (mock *Mock) GetGiveawayInfo(ctx context.Context, req *GetGiveawayInfoRequest) (GiveawayInfo, error) {
+	mock.record(req)
+
+	if mock.GetGiveawayInfoFunc == nil {
+		return nil, mockNotImplemented("getGiveawayInfo")
+	}
+
+	return mock.GetGiveawayInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetStarPaymentOptions(ctx context.Context) (*StarPaymentOptions, error) {
+	mock.record(&GetStarPaymentOptionsRequest{})
+
+	if mock.GetStarPaymentOptionsFunc == nil {
+		return nil, mockNotImplemented("getStarPaymentOptions")
+	}
+
+	return mock.GetStarPaymentOptionsFunc(ctx)
+}
+
+func (mock *Mock) GetStarGiftPaymentOptions(ctx context.Context, req *GetStarGiftPaymentOptionsRequest) (*StarPaymentOptions, error) {
+	mock.record(req)
+
+	if mock.GetStarGiftPaymentOptionsFunc == nil {
+		return nil, mockNotImplemented("getStarGiftPaymentOptions")
+	}
+
+	return mock.GetStarGiftPaymentOptionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStarGiveawayPaymentOptions(ctx context.Context) (*StarGiveawayPaymentOptions, error) {
+	mock.record(&GetStarGiveawayPaymentOptionsRequest{})
+
+	if mock.GetStarGiveawayPaymentOptionsFunc == nil {
+		return nil, mockNotImplemented("getStarGiveawayPaymentOptions")
+	}
+
+	return mock.GetStarGiveawayPaymentOptionsFunc(ctx)
+}
+
+func (mock *Mock) GetStarTransactions(ctx context.Context, req *GetStarTransactionsRequest) (*StarTransactions, error) {
+	mock.record(req)
+
+	if mock.GetStarTransactionsFunc == nil {
+		return nil, mockNotImplemented("getStarTransactions")
+	}
+
+	return mock.GetStarTransactionsFunc(ctx, req)
+}
+
+func (mock *Mock) GetStarSubscriptions(ctx context.Context, req *GetStarSubscriptionsRequest) (*StarSubscriptions, error) {
+	mock.record(req)
+
+	if mock.GetStarSubscriptionsFunc == nil {
+		return nil, mockNotImplemented("getStarSubscriptions")
+	}
+
+	return mock.GetStarSubscriptionsFunc(ctx, req)
+}
+
+func (mock *Mock) CanPurchaseFromStore(ctx context.Context, req *CanPurchaseFromStoreRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.CanPurchaseFromStoreFunc == nil {
+		return nil, mockNotImplemented("canPurchaseFromStore")
+	}
+
+	return mock.CanPurchaseFromStoreFunc(ctx, req)
+}
+
+func (mock *Mock) AssignStoreTransaction(ctx context.Context, req *AssignStoreTransactionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AssignStoreTransactionFunc == nil {
+		return nil, mockNotImplemented("assignStoreTransaction")
+	}
+
+	return mock.AssignStoreTransactionFunc(ctx, req)
+}
+
+func (mock *Mock) EditStarSubscription(ctx context.Context, req *EditStarSubscriptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditStarSubscriptionFunc == nil {
+		return nil, mockNotImplemented("editStarSubscription")
+	}
+
+	return mock.EditStarSubscriptionFunc(ctx, req)
+}
+
+func (mock *Mock) EditUserStarSubscription(ctx context.Context, req *EditUserStarSubscriptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EditUserStarSubscriptionFunc == nil {
+		return nil, mockNotImplemented("editUserStarSubscription")
+	}
+
+	return mock.EditUserStarSubscriptionFunc(ctx, req)
+}
+
+func (mock *Mock) ReuseStarSubscription(ctx context.Context, req *ReuseStarSubscriptionRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.ReuseStarSubscriptionFunc == nil {
+		return nil, mockNotImplemented("reuseStarSubscription")
+	}
+
+	return mock.ReuseStarSubscriptionFunc(ctx, req)
+}
+
+func (mock *Mock) SetChatAffiliateProgram(ctx context.Context, req *SetChatAffiliateProgramRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetChatAffiliateProgramFunc == nil {
+		return nil, mockNotImplemented("setChatAffiliateProgram")
+	}
+
+	return mock.SetChatAffiliateProgramFunc(ctx, req)
+}
+
+func (mock *Mock) SearchChatAffiliateProgram(ctx context.Context, req *SearchChatAffiliateProgramRequest) (*Chat, error) {
+	mock.record(req)
+
+	if mock.SearchChatAffiliateProgramFunc == nil {
+		return nil, mockNotImplemented("searchChatAffiliateProgram")
+	}
+
+	return mock.SearchChatAffiliateProgramFunc(ctx, req)
+}
+
+func (mock *Mock) SearchAffiliatePrograms(ctx context.Context, req *SearchAffiliateProgramsRequest) (*FoundAffiliatePrograms, error) {
+	mock.record(req)
+
+	if mock.SearchAffiliateProgramsFunc == nil {
+		return nil, mockNotImplemented("searchAffiliatePrograms")
+	}
+
+	return mock.SearchAffiliateProgramsFunc(ctx, req)
+}
+
+func (mock *Mock) ConnectAffiliateProgram(ctx context.Context, req *ConnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error) {
+	mock.record(req)
+
+	if mock.ConnectAffiliateProgramFunc == nil {
+		return nil, mockNotImplemented("connectAffiliateProgram")
+	}
+
+	return mock.ConnectAffiliateProgramFunc(ctx, req)
+}
+
+func (mock *Mock) DisconnectAffiliateProgram(ctx context.Context, req *DisconnectAffiliateProgramRequest) (*ConnectedAffiliateProgram, error) {
+	mock.record(req)
+
+	if mock.DisconnectAffiliateProgramFunc == nil {
+		return nil, mockNotImplemented("disconnectAffiliateProgram")
+	}
+
+	return mock.DisconnectAffiliateProgramFunc(ctx, req)
+}
+
+func (mock *Mock) GetConnectedAffiliateProgram(ctx context.Context, req *GetConnectedAffiliateProgramRequest) (*ConnectedAffiliateProgram, error) {
+	mock.record(req)
+
+	if mock.GetConnectedAffiliateProgramFunc == nil {
+		return nil, mockNotImplemented("getConnectedAffiliateProgram")
+	}
+
+	return mock.GetConnectedAffiliateProgramFunc(ctx, req)
+}
+
+func (mock *Mock) GetConnectedAffiliatePrograms(ctx context.Context, req *GetConnectedAffiliateProgramsRequest) (*ConnectedAffiliatePrograms, error) {
+	mock.record(req)
+
+	if mock.GetConnectedAffiliateProgramsFunc == nil {
+		return nil, mockNotImplemented("getConnectedAffiliatePrograms")
+	}
+
+	return mock.GetConnectedAffiliateProgramsFunc(ctx, req)
+}
+
+func (mock *Mock) GetBusinessFeatures(ctx context.Context, req *GetBusinessFeaturesRequest) (*BusinessFeatures, error) {
+	mock.record(req)
+
+	if mock.GetBusinessFeaturesFunc == nil {
+		return nil, mockNotImplemented("getBusinessFeatures")
+	}
+
+	return mock.GetBusinessFeaturesFunc(ctx, req)
+}
+
+func (mock *Mock) AcceptTermsOfService(ctx context.Context, req *AcceptTermsOfServiceRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AcceptTermsOfServiceFunc == nil {
+		return nil, mockNotImplemented("acceptTermsOfService")
+	}
+
+	return mock.AcceptTermsOfServiceFunc(ctx, req)
+}
+
+func (mock *Mock) SendCustomRequest(ctx context.Context, req *SendCustomRequestRequest) (*CustomRequestResult, error) {
+	mock.record(req)
+
+	if mock.SendCustomRequestFunc == nil {
+		return nil, mockNotImplemented("sendCustomRequest")
+	}
+
+	return mock.SendCustomRequestFunc(ctx, req)
+}
+
+func (mock *Mock) AnswerCustomQuery(ctx context.Context, req *AnswerCustomQueryRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.AnswerCustomQueryFunc == nil {
+		return nil, mockNotImplemented("answerCustomQuery")
+	}
+
+	return mock.AnswerCustomQueryFunc(ctx, req)
+}
+
+func (mock *Mock) SetAlarm(ctx context.Context, req *SetAlarmRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SetAlarmFunc == nil {
+		return nil, mockNotImplemented("setAlarm")
+	}
+
+	return mock.SetAlarmFunc(ctx, req)
+}
+
+func (mock *Mock) GetCountries(ctx context.Context) (*Countries, error) {
+	mock.record(&GetCountriesRequest{})
+
+	if mock.GetCountriesFunc == nil {
+		return nil, mockNotImplemented("getCountries")
+	}
+
+	return mock.GetCountriesFunc(ctx)
+}
+
+func (mock *Mock) GetCountryCode(ctx context.Context) (*Text, error) {
+	mock.record(&GetCountryCodeRequest{})
+
+	if mock.GetCountryCodeFunc == nil {
+		return nil, mockNotImplemented("getCountryCode")
+	}
+
+	return mock.GetCountryCodeFunc(ctx)
+}
+
+func (mock *Mock) GetPhoneNumberInfo(ctx context.Context, req *GetPhoneNumberInfoRequest) (*PhoneNumberInfo, error) {
+	mock.record(req)
+
+	if mock.GetPhoneNumberInfoFunc == nil {
+		return nil, mockNotImplemented("getPhoneNumberInfo")
+	}
+
+	return mock.GetPhoneNumberInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetCollectibleItemInfo(ctx context.Context, req *GetCollectibleItemInfoRequest) (*CollectibleItemInfo, error) {
+	mock.record(req)
+
+	if mock.GetCollectibleItemInfoFunc == nil {
+		return nil, mockNotImplemented("getCollectibleItemInfo")
+	}
+
+	return mock.GetCollectibleItemInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetDeepLinkInfo(ctx context.Context, req *GetDeepLinkInfoRequest) (*DeepLinkInfo, error) {
+	mock.record(req)
+
+	if mock.GetDeepLinkInfoFunc == nil {
+		return nil, mockNotImplemented("getDeepLinkInfo")
+	}
+
+	return mock.GetDeepLinkInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetApplicationConfig(ctx context.Context) (JsonValue, error) {
+	mock.record(&GetApplicationConfigRequest{})
+
+	if mock.GetApplicationConfigFunc == nil {
+		return nil, mockNotImplemented("getApplicationConfig")
+	}
+
+	return mock.GetApplicationConfigFunc(ctx)
+}
+
+func (mock *Mock) SaveApplicationLogEvent(ctx context.Context, req *SaveApplicationLogEventRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.SaveApplicationLogEventFunc == nil {
+		return nil, mockNotImplemented("saveApplicationLogEvent")
+	}
+
+	return mock.SaveApplicationLogEventFunc(ctx, req)
+}
+
+func (mock *Mock) GetApplicationDownloadLink(ctx context.Context) (*HttpUrl, error) {
+	mock.record(&GetApplicationDownloadLinkRequest{})
+
+	if mock.GetApplicationDownloadLinkFunc == nil {
+		return nil, mockNotImplemented("getApplicationDownloadLink")
+	}
+
+	return mock.GetApplicationDownloadLinkFunc(ctx)
+}
+
+func (mock *Mock) AddProxy(ctx context.Context, req *AddProxyRequest) (*Proxy, error) {
+	mock.record(req)
+
+	if mock.AddProxyFunc == nil {
+		return nil, mockNotImplemented("addProxy")
+	}
+
+	return mock.AddProxyFunc(ctx, req)
+}
+
+func (mock *Mock) EditProxy(ctx context.Context, req *EditProxyRequest) (*Proxy, error) {
+	mock.record(req)
+
+	if mock.EditProxyFunc == nil {
+		return nil, mockNotImplemented("editProxy")
+	}
+
+	return mock.EditProxyFunc(ctx, req)
+}
+
+func (mock *Mock) EnableProxy(ctx context.Context, req *EnableProxyRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.EnableProxyFunc == nil {
+		return nil, mockNotImplemented("enableProxy")
+	}
+
+	return mock.EnableProxyFunc(ctx, req)
+}
+
+func (mock *Mock) DisableProxy(ctx context.Context) (*Ok, error) {
+	mock.record(&DisableProxyRequest{})
+
+	if mock.DisableProxyFunc == nil {
+		return nil, mockNotImplemented("disableProxy")
+	}
+
+	return mock.DisableProxyFunc(ctx)
+}
+
+func (mock *Mock) RemoveProxy(ctx context.Context, req *RemoveProxyRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.RemoveProxyFunc == nil {
+		return nil, mockNotImplemented("removeProxy")
+	}
+
+	return mock.RemoveProxyFunc(ctx, req)
+}
+
+func (mock *Mock) GetProxies(ctx context.Context) (*Proxies, error) {
+	mock.record(&GetProxiesRequest{})
+
+	if mock.GetProxiesFunc == nil {
+		return nil, mockNotImplemented("getProxies")
+	}
+
+	return mock.GetProxiesFunc(ctx)
+}
+
+func (mock *Mock) GetProxyLink(ctx context.Context, req *GetProxyLinkRequest) (*HttpUrl, error) {
+	mock.record(req)
+
+	if mock.GetProxyLinkFunc == nil {
+		return nil, mockNotImplemented("getProxyLink")
+	}
+
+	return mock.GetProxyLinkFunc(ctx, req)
+}
+
+func (mock *Mock) PingProxy(ctx context.Context, req *PingProxyRequest) (*Seconds, error) {
+	mock.record(req)
+
+	if mock.PingProxyFunc == nil {
+		return nil, mockNotImplemented("pingProxy")
+	}
+
+	return mock.PingProxyFunc(ctx, req)
+}
+
+func (mock *Mock) GetUserSupportInfo(ctx context.Context, req *GetUserSupportInfoRequest) (*UserSupportInfo, error) {
+	mock.record(req)
+
+	if mock.GetUserSupportInfoFunc == nil {
+		return nil, mockNotImplemented("getUserSupportInfo")
+	}
+
+	return mock.GetUserSupportInfoFunc(ctx, req)
+}
+
+func (mock *Mock) SetUserSupportInfo(ctx context.Context, req *SetUserSupportInfoRequest) (*UserSupportInfo, error) {
+	mock.record(req)
+
+	if mock.SetUserSupportInfoFunc == nil {
+		return nil, mockNotImplemented("setUserSupportInfo")
+	}
+
+	return mock.SetUserSupportInfoFunc(ctx, req)
+}
+
+func (mock *Mock) GetSupportName(ctx context.Context) (*Text, error) {
+	mock.record(&GetSupportNameRequest{})
+
+	if mock.GetSupportNameFunc == nil {
+		return nil, mockNotImplemented("getSupportName")
+	}
+
+	return mock.GetSupportNameFunc(ctx)
+}
+
+func (mock *Mock) TestCallEmpty(ctx context.Context) (*Ok, error) {
+	mock.record(&TestCallEmptyRequest{})
+
+	if mock.TestCallEmptyFunc == nil {
+		return nil, mockNotImplemented("testCallEmpty")
+	}
+
+	return mock.TestCallEmptyFunc(ctx)
+}
+
+func (mock *Mock) TestCallString(ctx context.Context, req *TestCallStringRequest) (*TestString, error) {
+	mock.record(req)
+
+	if mock.TestCallStringFunc == nil {
+		return nil, mockNotImplemented("testCallString")
+	}
+
+	return mock.TestCallStringFunc(ctx, req)
+}
+
+func (mock *Mock) TestCallBytes(ctx context.Context, req *TestCallBytesRequest) (*TestBytes, error) {
+	mock.record(req)
+
+	if mock.TestCallBytesFunc == nil {
+		return nil, mockNotImplemented("testCallBytes")
+	}
+
+	return mock.TestCallBytesFunc(ctx, req)
+}
+
+func (mock *Mock) TestCallVectorInt(ctx context.Context, req *TestCallVectorIntRequest) (*TestVectorInt, error) {
+	mock.record(req)
+
+	if mock.TestCallVectorIntFunc == nil {
+		return nil, mockNotImplemented("testCallVectorInt")
+	}
+
+	return mock.TestCallVectorIntFunc(ctx, req)
+}
+
+func (mock *Mock) TestCallVectorIntObject(ctx context.Context, req *TestCallVectorIntObjectRequest) (*TestVectorIntObject, error) {
+	mock.record(req)
+
+	if mock.TestCallVectorIntObjectFunc == nil {
+		return nil, mockNotImplemented("testCallVectorIntObject")
+	}
+
+	return mock.TestCallVectorIntObjectFunc(ctx, req)
+}
+
+func (mock *Mock) TestCallVectorString(ctx context.Context, req *TestCallVectorStringRequest) (*TestVectorString, error) {
+	mock.record(req)
+
+	if mock.TestCallVectorStringFunc == nil {
+		return nil, mockNotImplemented("testCallVectorString")
+	}
+
+	return mock.TestCallVectorStringFunc(ctx, req)
+}
+
+func (mock *Mock) TestCallVectorStringObject(ctx context.Context, req *TestCallVectorStringObjectRequest) (*TestVectorStringObject, error) {
+	mock.record(req)
+
+	if mock.TestCallVectorStringObjectFunc == nil {
+		return nil, mockNotImplemented("testCallVectorStringObject")
+	}
+
+	return mock.TestCallVectorStringObjectFunc(ctx, req)
+}
+
+func (mock *Mock) TestSquareInt(ctx context.Context, req *TestSquareIntRequest) (*TestInt, error) {
+	mock.record(req)
+
+	if mock.TestSquareIntFunc == nil {
+		return nil, mockNotImplemented("testSquareInt")
+	}
+
+	return mock.TestSquareIntFunc(ctx, req)
+}
+
+func (mock *Mock) TestNetwork(ctx context.Context) (*Ok, error) {
+	mock.record(&TestNetworkRequest{})
+
+	if mock.TestNetworkFunc == nil {
+		return nil, mockNotImplemented("testNetwork")
+	}
+
+	return mock.TestNetworkFunc(ctx)
+}
+
+func (mock *Mock) TestProxy(ctx context.Context, req *TestProxyRequest) (*Ok, error) {
+	mock.record(req)
+
+	if mock.TestProxyFunc == nil {
+		return nil, mockNotImplemented("testProxy")
+	}
+
+	return mock.TestProxyFunc(ctx, req)
+}
+
+func (mock *Mock) TestGetDifference(ctx context.Context) (*Ok, error) {
+	mock.record(&TestGetDifferenceRequest{})
+
+	if mock.TestGetDifferenceFunc == nil {
+		return nil, mockNotImplemented("testGetDifference")
+	}
+
+	return mock.TestGetDifferenceFunc(ctx)
+}
+
+func (mock *Mock) TestUseUpdate(ctx context.Context) (Update, error) {
+	mock.record(&TestUseUpdateRequest{})
+
+	if mock.TestUseUpdateFunc == nil {
+		return nil, mockNotImplemented("testUseUpdate")
+	}
+
+	return mock.TestUseUpdateFunc(ctx)
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMock(t *testing.T) {
+	mock := &Mock{
+		GetMeFunc: func(ctx context.Context) (*User, error) {
+			return &User{Id: 42}, nil
+		},
+	}
+
+	var tdlib TDLib = mock
+
+	me, err := tdlib.GetMe(context.Background())
+	if err != nil {
+		t.Fatalf("GetMe: %s", err)
+	}
+	if me.Id != 42 {
+		t.Errorf("unexpected user id: %d", me.Id)
+	}
+
+	_, err = tdlib.GetChat(context.Background(), &GetChatRequest{ChatId: 7})
+	if !errors.Is(err, ErrNotMocked) {
+		t.Errorf("expected ErrNotMocked, got %v", err)
+	}
+
+	calls := mock.Calls()
+	if len(calls) != 2 || calls[0].Method() != "getMe" || calls[1].Method() != "getChat" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+
+	getChatCalls := mock.CallsOf("getChat")
+	if len(getChatCalls) != 1 || getChatCalls[0].Request.(*GetChatRequest).ChatId != 7 {
+		t.Errorf("unexpected getChat calls: %+v", getChatCalls)
+	}
+
+	mock.ResetCalls()
+	if len(mock.Calls()) != 0 {
+		t.Errorf("calls are not reset")
+	}
+}
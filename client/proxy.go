@@ -0,0 +1,367 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type proxyClient interface {
+	GetProxies(ctx context.Context) (*Proxies, error)
+	AddProxy(ctx context.Context, req *AddProxyRequest) (*Proxy, error)
+	PingProxy(ctx context.Context, req *PingProxyRequest) (*Seconds, error)
+	EnableProxy(ctx context.Context, req *EnableProxyRequest) (*Ok, error)
+}
+
+type ProxyManagerOption func(*ProxyManager)
+
+// WithPingInterval sets how often all proxies are measured
+func WithPingInterval(interval time.Duration) ProxyManagerOption {
+	return func(manager *ProxyManager) {
+		manager.pingInterval = interval
+	}
+}
+
+// WithPingTimeout sets the timeout of a single proxy ping
+func WithPingTimeout(timeout time.Duration) ProxyManagerOption {
+	return func(manager *ProxyManager) {
+		manager.pingTimeout = timeout
+	}
+}
+
+// WithConnectingTimeout sets how long the connection may stay in connectionStateConnectingToProxy before the proxy is switched
+func WithConnectingTimeout(timeout time.Duration) ProxyManagerOption {
+	return func(manager *ProxyManager) {
+		manager.connectingTimeout = timeout
+	}
+}
+
+// WithProxySwitchHandler sets the callback that is called when another proxy is enabled
+func WithProxySwitchHandler(switchHandler func(proxy *Proxy, latency time.Duration)) ProxyManagerOption {
+	return func(manager *ProxyManager) {
+		manager.switchHandler = switchHandler
+	}
+}
+
+// WithProxyErrorHandler sets the callback that is called when a proxy can't be enabled. The manager keeps running and retries
+// on the next ping or when the connection is stuck again
+func WithProxyErrorHandler(errorHandler func(err error)) ProxyManagerOption {
+	return func(manager *ProxyManager) {
+		manager.errorHandler = errorHandler
+	}
+}
+
+// ProxyManager registers a pool of proxies, periodically pings them and enables the fastest healthy one.
+// The proxy is switched when the connection is stuck in connectionStateConnectingToProxy, again and again while it stays stuck.
+// Proxies which are already added to TDLib are reused, so Run can be called for every new client.
+type ProxyManager struct {
+	proxies           []*AddProxyRequest
+	pingInterval      time.Duration
+	pingTimeout       time.Duration
+	connectingTimeout time.Duration
+	switchHandler     func(proxy *Proxy, latency time.Duration)
+	errorHandler      func(err error)
+
+	mu              sync.Mutex
+	connectionState ConnectionState
+	connectingTimer *time.Timer
+	stuck           chan struct{}
+
+	registered []*Proxy
+	latencies  map[int32]time.Duration
+	failed     map[int32]bool
+	enabledId  int32
+}
+
+func NewProxyManager(proxies []*AddProxyRequest, options ...ProxyManagerOption) *ProxyManager {
+	manager := &ProxyManager{
+		proxies:           proxies,
+		pingInterval:      5 * time.Minute,
+		pingTimeout:       10 * time.Second,
+		connectingTimeout: 30 * time.Second,
+		switchHandler:     func(proxy *Proxy, latency time.Duration) {},
+		errorHandler:      func(err error) {},
+		stuck:             make(chan struct{}, 1),
+		latencies:         map[int32]time.Duration{},
+		failed:            map[int32]bool{},
+	}
+
+	for _, option := range options {
+		option(manager)
+	}
+
+	return manager
+}
+
+// Run registers the proxies and keeps the fastest healthy one enabled until ctx is done or the client is closed.
+// Only an error of the registration stops it, errors of enabling a proxy are passed to the error handler
+func (manager *ProxyManager) Run(ctx context.Context, client *Client) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-client.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	manager.reset()
+	go manager.watchConnectionState(ctx, client)
+
+	return manager.run(ctx, client)
+}
+
+// reset forgets the connection state of the previous client and a proxy switch it has requested
+func (manager *ProxyManager) reset() {
+	manager.mu.Lock()
+	manager.connectionState = nil
+	manager.armConnectingTimer()
+	manager.mu.Unlock()
+
+	select {
+	case <-manager.stuck:
+	default:
+	}
+}
+
+func (manager *ProxyManager) run(ctx context.Context, client proxyClient) error {
+	err := manager.register(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	manager.measure(ctx, client)
+	err = manager.enableFastest(ctx, client)
+	if err != nil {
+		manager.errorHandler(err)
+	}
+
+	ticker := time.NewTicker(manager.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-ticker.C:
+			manager.mu.Lock()
+			manager.failed = map[int32]bool{}
+			manager.mu.Unlock()
+
+			manager.measure(ctx, client)
+
+		case <-manager.stuck:
+			manager.mu.Lock()
+			manager.failed[manager.enabledId] = true
+			manager.mu.Unlock()
+		}
+
+		err = manager.enableFastest(ctx, client)
+		if err != nil {
+			manager.errorHandler(err)
+		}
+
+		// the connection may stay stuck with the next proxy too, which doesn't change the connection state
+		manager.mu.Lock()
+		manager.armConnectingTimer()
+		manager.mu.Unlock()
+	}
+}
+
+func (manager *ProxyManager) watchConnectionState(ctx context.Context, client *Client) {
+	for {
+		state, _, changed := client.connectionState.get()
+		if state != nil {
+			manager.onConnectionState(state)
+		}
+
+		select {
+		case <-changed:
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (manager *ProxyManager) onConnectionState(state ConnectionState) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	manager.connectionState = state
+	manager.armConnectingTimer()
+}
+
+// armConnectingTimer restarts the timeout of connectionStateConnectingToProxy if the connection is in this state.
+// The caller must hold manager.mu
+func (manager *ProxyManager) armConnectingTimer() {
+	if manager.connectingTimer != nil {
+		manager.connectingTimer.Stop()
+		manager.connectingTimer = nil
+	}
+
+	if manager.connectionState == nil || manager.connectionState.ConnectionStateConstructor() != ConstructorConnectionStateConnectingToProxy {
+		return
+	}
+
+	manager.connectingTimer = time.AfterFunc(manager.connectingTimeout, func() {
+		select {
+		case manager.stuck <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// Latencies returns the last measured latencies of healthy proxies by proxy identifier
+func (manager *ProxyManager) Latencies() map[int32]time.Duration {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	latencies := make(map[int32]time.Duration, len(manager.latencies))
+	for id, latency := range manager.latencies {
+		latencies[id] = latency
+	}
+
+	return latencies
+}
+
+// register adds the proxies which aren't added to TDLib yet
+func (manager *ProxyManager) register(ctx context.Context, client proxyClient) error {
+	existing, err := client.GetProxies(ctx)
+	if err != nil {
+		return fmt.Errorf("get proxies: %w", err)
+	}
+
+	registered := make([]*Proxy, 0, len(manager.proxies))
+	var enabledId int32
+
+	for _, req := range manager.proxies {
+		proxy := findProxy(existing.Proxies, req)
+		if proxy != nil {
+			if proxy.IsEnabled {
+				enabledId = proxy.Id
+			}
+			registered = append(registered, proxy)
+			continue
+		}
+
+		proxy, err = client.AddProxy(ctx, &AddProxyRequest{
+			Server: req.Server,
+			Port:   req.Port,
+			Enable: false,
+			Type:   req.Type,
+		})
+		if err != nil {
+			return fmt.Errorf("add proxy %s:%d: %w", req.Server, req.Port, err)
+		}
+
+		registered = append(registered, proxy)
+	}
+
+	manager.mu.Lock()
+	manager.registered = registered
+	manager.enabledId = enabledId
+	manager.mu.Unlock()
+
+	return nil
+}
+
+// findProxy returns the added proxy with the server, the port and the type of the request
+func findProxy(proxies []*Proxy, req *AddProxyRequest) *Proxy {
+	reqType, err := json.Marshal(req.Type)
+	if err != nil {
+		return nil
+	}
+
+	for _, proxy := range proxies {
+		if proxy.Server != req.Server || proxy.Port != req.Port {
+			continue
+		}
+
+		proxyType, err := json.Marshal(proxy.Type)
+		if err == nil && bytes.Equal(proxyType, reqType) {
+			return proxy
+		}
+	}
+
+	return nil
+}
+
+func (manager *ProxyManager) measure(ctx context.Context, client proxyClient) {
+	latencies := map[int32]time.Duration{}
+
+	for _, proxy := range manager.registered {
+		pingCtx, cancel := context.WithTimeout(ctx, manager.pingTimeout)
+		seconds, err := client.PingProxy(pingCtx, &PingProxyRequest{
+			ProxyId: proxy.Id,
+		})
+		cancel()
+		if err != nil {
+			continue
+		}
+
+		latencies[proxy.Id] = time.Duration(seconds.Seconds * float64(time.Second))
+	}
+
+	manager.mu.Lock()
+	manager.latencies = latencies
+	manager.mu.Unlock()
+}
+
+func (manager *ProxyManager) enableFastest(ctx context.Context, client proxyClient) error {
+	proxy, latency := manager.fastest()
+	if proxy == nil || proxy.Id == manager.enabledId {
+		return nil
+	}
+
+	_, err := client.EnableProxy(ctx, &EnableProxyRequest{
+		ProxyId: proxy.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("enable proxy %s:%d: %w", proxy.Server, proxy.Port, err)
+	}
+
+	manager.mu.Lock()
+	manager.enabledId = proxy.Id
+	manager.mu.Unlock()
+
+	manager.switchHandler(proxy, latency)
+
+	return nil
+}
+
+// fastest returns the healthy proxy with the lowest latency. Failed proxies are used only if there are no others.
+func (manager *ProxyManager) fastest() (*Proxy, time.Duration) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	var fastestProxy *Proxy
+	var fastestLatency time.Duration
+	var fastestFailed bool
+
+	for _, proxy := range manager.registered {
+		latency, ok := manager.latencies[proxy.Id]
+		if !ok {
+			continue
+		}
+
+		failed := manager.failed[proxy.Id]
+
+		switch {
+		case fastestProxy == nil,
+			fastestFailed && !failed,
+			fastestFailed == failed && latency < fastestLatency:
+			fastestProxy = proxy
+			fastestLatency = latency
+			fastestFailed = failed
+		}
+	}
+
+	return fastestProxy, fastestLatency
+}
@@ -0,0 +1,271 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeProxyClient struct {
+	mu      sync.Mutex
+	pings   map[string]float64
+	proxies []*Proxy
+	enabled chan string
+	// enableErrors is the number of the next EnableProxy calls which fail
+	enableErrors int
+}
+
+func newFakeProxyClient(pings map[string]float64) *fakeProxyClient {
+	return &fakeProxyClient{
+		pings:   pings,
+		enabled: make(chan string, 10),
+	}
+}
+
+func (client *fakeProxyClient) GetProxies(ctx context.Context) (*Proxies, error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	proxies := make([]*Proxy, 0, len(client.proxies))
+	for _, proxy := range client.proxies {
+		copied := *proxy
+		proxies = append(proxies, &copied)
+	}
+
+	return &Proxies{Proxies: proxies}, nil
+}
+
+func (client *fakeProxyClient) AddProxy(ctx context.Context, req *AddProxyRequest) (*Proxy, error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	proxy := &Proxy{
+		Id:     int32(len(client.proxies) + 1),
+		Server: req.Server,
+		Port:   req.Port,
+		Type:   req.Type,
+	}
+	client.proxies = append(client.proxies, proxy)
+
+	copied := *proxy
+
+	return &copied, nil
+}
+
+func (client *fakeProxyClient) server(id int32) string {
+	for _, proxy := range client.proxies {
+		if proxy.Id == id {
+			return proxy.Server
+		}
+	}
+
+	return ""
+}
+
+func (client *fakeProxyClient) PingProxy(ctx context.Context, req *PingProxyRequest) (*Seconds, error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	seconds, ok := client.pings[client.server(req.ProxyId)]
+	if !ok {
+		return nil, errors.New("ping timeout")
+	}
+
+	return &Seconds{Seconds: seconds}, nil
+}
+
+func (client *fakeProxyClient) EnableProxy(ctx context.Context, req *EnableProxyRequest) (*Ok, error) {
+	client.mu.Lock()
+	if client.enableErrors > 0 {
+		client.enableErrors--
+		client.mu.Unlock()
+		return nil, errors.New("network error")
+	}
+	for _, proxy := range client.proxies {
+		proxy.IsEnabled = proxy.Id == req.ProxyId
+	}
+	server := client.server(req.ProxyId)
+	client.mu.Unlock()
+
+	client.enabled <- server
+
+	return &Ok{}, nil
+}
+
+func (client *fakeProxyClient) waitEnabled(t *testing.T, want string) {
+	t.Helper()
+
+	select {
+	case server := <-client.enabled:
+		if server != want {
+			t.Fatalf("enabled proxy = %s, want %s", server, want)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("proxy %s was not enabled", want)
+	}
+}
+
+var testProxies = []*AddProxyRequest{
+	{Server: "slow", Port: 1080, Type: &ProxyTypeSocks5{}},
+	{Server: "fast", Port: 8080, Type: &ProxyTypeHttp{}},
+	{Server: "medium", Port: 1080, Type: &ProxyTypeSocks5{Username: "user"}},
+	{Server: "dead", Port: 443, Type: &ProxyTypeMtproto{Secret: "00"}},
+}
+
+func newTestProxyClient() *fakeProxyClient {
+	return newFakeProxyClient(map[string]float64{
+		"slow":   0.3,
+		"fast":   0.1,
+		"medium": 0.2,
+	})
+}
+
+func TestProxyManager(t *testing.T) {
+	fakeClient := newTestProxyClient()
+
+	manager := NewProxyManager(testProxies, WithPingInterval(time.Hour), WithConnectingTimeout(10*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- manager.run(ctx, fakeClient)
+	}()
+
+	fakeClient.waitEnabled(t, "fast")
+
+	if len(manager.Latencies()) != 3 {
+		t.Errorf("Latencies() = %v, want 3 healthy proxies", manager.Latencies())
+	}
+
+	manager.onConnectionState(&ConnectionStateConnectingToProxy{})
+
+	// the connection stays stuck after the first switch, so the proxy is switched again without a new connection state
+	fakeClient.waitEnabled(t, "medium")
+	fakeClient.waitEnabled(t, "slow")
+
+	manager.onConnectionState(&ConnectionStateReady{})
+
+	cancel()
+
+	err := <-errs
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("run() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestProxyManager_RepeatedRun(t *testing.T) {
+	fakeClient := newTestProxyClient()
+
+	for i := 0; i < 2; i++ {
+		manager := NewProxyManager(testProxies, WithPingInterval(time.Hour))
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		errs := make(chan error, 1)
+		go func() {
+			errs <- manager.run(ctx, fakeClient)
+		}()
+
+		if i == 0 {
+			fakeClient.waitEnabled(t, "fast")
+		} else {
+			select {
+			case server := <-fakeClient.enabled:
+				t.Errorf("proxy %s is enabled again", server)
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+
+		cancel()
+		<-errs
+	}
+
+	proxies, _ := fakeClient.GetProxies(context.Background())
+	if len(proxies.Proxies) != len(testProxies) {
+		t.Errorf("%d proxies are added, want %d", len(proxies.Proxies), len(testProxies))
+	}
+}
+
+func TestProxyManager_InitialConnectionState(t *testing.T) {
+	manager := NewProxyManager(nil, WithConnectingTimeout(10*time.Millisecond))
+
+	client := &Client{connectionState: newConnectionStateTracker()}
+	client.connectionState.set(&ConnectionStateConnectingToProxy{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go manager.watchConnectionState(ctx, client)
+
+	select {
+	case <-manager.stuck:
+	case <-time.After(time.Second):
+		t.Errorf("proxy switch is not requested for the connection state at the start")
+	}
+}
+
+func TestProxyManager_ConnectionRecovered(t *testing.T) {
+	manager := NewProxyManager(nil, WithConnectingTimeout(10*time.Millisecond))
+
+	manager.onConnectionState(&ConnectionStateConnectingToProxy{})
+	manager.onConnectionState(&ConnectionStateReady{})
+
+	select {
+	case <-manager.stuck:
+		t.Errorf("proxy switch requested after the connection has been recovered")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestProxyManager_EnableError(t *testing.T) {
+	fakeClient := newTestProxyClient()
+	fakeClient.enableErrors = 2
+
+	errs := make(chan error, 10)
+	manager := NewProxyManager(testProxies, WithPingInterval(10*time.Millisecond), WithProxyErrorHandler(func(err error) {
+		errs <- err
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- manager.run(ctx, fakeClient)
+	}()
+
+	// the failed attempts are reported and the proxy is enabled after the next pings
+	fakeClient.waitEnabled(t, "fast")
+
+	if len(errs) != 2 {
+		t.Errorf("%d errors are reported, want 2", len(errs))
+	}
+
+	cancel()
+
+	err := <-done
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("run() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestProxyManager_ResetDrainsStuck(t *testing.T) {
+	manager := NewProxyManager(nil, WithConnectingTimeout(10*time.Millisecond))
+
+	// the previous client was stuck
+	manager.onConnectionState(&ConnectionStateConnectingToProxy{})
+	manager.stuck <- struct{}{}
+
+	manager.reset()
+
+	select {
+	case <-manager.stuck:
+		t.Errorf("proxy switch of the previous client is requested")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// rawRequest is a request of a method which may be missing in the generated code
+type rawRequest struct {
+	request
+	method string
+	params any
+}
+
+func (req *rawRequest) GetFunctionName() string {
+	return req.method
+}
+
+// MarshalJSON marshals the parameters as a JSON object with @type and @extra
+func (req *rawRequest) MarshalJSON() ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+
+	if req.params != nil {
+		data, err := json.Marshal(req.params)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(data, &fields)
+		if err != nil || fields == nil {
+			return nil, fmt.Errorf("%s: parameters must be a JSON object", req.method)
+		}
+	}
+
+	fields["@type"], _ = json.Marshal(req.method)
+	if req.GetExtra() != "" {
+		fields["@extra"], _ = json.Marshal(req.GetExtra())
+	}
+
+	return json.Marshal(fields)
+}
+
+// SendRaw sends a request of the method with the parameters, which are marshalled to a JSON object, e.g. a map or a struct.
+// It allows to call methods which are missing in the generated code.
+// The result is unmarshalled if its constructor is known, otherwise the returned Type is nil. The raw JSON of the result is always returned
+func (client *Client) SendRaw(ctx context.Context, method string, params any) (Type, json.RawMessage, error) {
+	result, err := client.Send(ctx, &rawRequest{method: method, params: params})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unmarshalRawResult(result)
+}
+
+// ExecuteRaw synchronously executes a request of the method with the parameters. See Client.SendRaw
+func ExecuteRaw(method string, params any) (Type, json.RawMessage, error) {
+	result, err := Execute(&rawRequest{method: method, params: params})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unmarshalRawResult(result)
+}
+
+func unmarshalRawResult(result *Response) (Type, json.RawMessage, error) {
+	if result.MetaType == "error" {
+		return nil, result.Data, buildResponseError(result.Data)
+	}
+
+	typ, err := unmarshalResult(result, UnmarshalType)
+	if err != nil {
+		return nil, result.Data, err
+	}
+
+	if _, ok := typ.(*UnknownType); ok {
+		return nil, result.Data, nil
+	}
+
+	return typ, result.Data, nil
+}
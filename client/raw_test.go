@@ -0,0 +1,62 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRawRequestMarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  any
+		want    string
+		wantErr bool
+	}{
+		{"nil", nil, `{"@extra":"1","@type":"getNewMethod"}`, false},
+		{"map", map[string]any{"chat_id": 42, "@type": "ignored"}, `{"@extra":"1","@type":"getNewMethod","chat_id":42}`, false},
+		{"struct", struct {
+			Limit int32 `json:"limit"`
+		}{10}, `{"@extra":"1","@type":"getNewMethod","limit":10}`, false},
+		{"raw", json.RawMessage(`{"query":"q"}`), `{"@extra":"1","@type":"getNewMethod","query":"q"}`, false},
+		{"not an object", []int{1}, "", true},
+		{"null", json.RawMessage(`null`), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &rawRequest{method: "getNewMethod", params: tt.params}
+			req.SetExtra("1")
+
+			data, err := json.Marshal(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(data) != tt.want {
+				t.Errorf("got %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalRawResult(t *testing.T) {
+	typ, data, err := unmarshalRawResult(&Response{meta: meta{MetaType: ConstructorOk}, Data: json.RawMessage(`{"@type":"ok","@extra":"1"}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := typ.(*Ok); !ok || len(data) == 0 {
+		t.Errorf("unexpected result: %T %s", typ, data)
+	}
+
+	unknown := json.RawMessage(`{"@type":"newObject","value":1}`)
+	typ, data, err = unmarshalRawResult(&Response{meta: meta{MetaType: "newObject"}, Data: unknown})
+	if err != nil || typ != nil || string(data) != string(unknown) {
+		t.Errorf("unexpected result: %v %s %v", typ, data, err)
+	}
+
+	_, _, err = unmarshalRawResult(&Response{meta: meta{MetaType: "error"}, Data: json.RawMessage(`{"@type":"error","code":400,"message":"Method not found"}`)})
+	var responseError ResponseError
+	if !errors.As(err, &responseError) || responseError.Err.Code != 400 {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
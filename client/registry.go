@@ -0,0 +1,142 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrUnknownFunction = errors.New("unknown function")
+
+// FieldInfo describes a field of a constructor or a request
+type FieldInfo struct {
+	// TDLib name of the field, e.g. "chat_id"
+	Name string
+	// Name of the struct field, e.g. "ChatId"
+	GoName string
+	// TL type of the field, e.g. "vector<int53>"
+	Type        string
+	Description string
+	Nullable    bool
+}
+
+// ConstructorInfo describes a constructor of the schema
+type ConstructorInfo struct {
+	Name string
+	// Class or result type of the constructor, e.g. TypeMessageContent
+	Type        string
+	Description string
+	Fields      []FieldInfo
+	new         func() Type
+}
+
+// FunctionInfo describes a TDLib method
+type FunctionInfo struct {
+	Name        string
+	Description string
+	// TL type of the result, e.g. "Messages"
+	ResultType    string
+	IsSynchronous bool
+	Fields        []FieldInfo
+	new           func() Request
+}
+
+type registryIndex struct {
+	constructors map[string]*ConstructorInfo
+	classes      map[string][]string
+	functions    map[string]*FunctionInfo
+}
+
+var registry = sync.OnceValue(func() *registryIndex {
+	index := &registryIndex{
+		constructors: map[string]*ConstructorInfo{},
+		classes:      map[string][]string{},
+		functions:    map[string]*FunctionInfo{},
+	}
+
+	for _, info := range constructorInfos {
+		index.constructors[info.Name] = info
+		index.classes[info.Type] = append(index.classes[info.Type], info.Name)
+	}
+
+	for _, info := range functionInfos {
+		index.functions[info.Name] = info
+	}
+
+	return index
+})
+
+// Constructors returns the names of all constructors in the schema order
+func Constructors() []string {
+	names := make([]string, len(constructorInfos))
+	for i, info := range constructorInfos {
+		names[i] = info.Name
+	}
+
+	return names
+}
+
+// ConstructorsOf returns the constructors of the class, e.g. ConstructorsOf(TypeMessageContent)
+func ConstructorsOf(class string) []string {
+	return append([]string(nil), registry().classes[class]...)
+}
+
+// ClassOf returns the class of the constructor
+func ClassOf(constructor string) (string, bool) {
+	info, ok := registry().constructors[constructor]
+	if !ok {
+		return "", false
+	}
+
+	return info.Type, true
+}
+
+// GetConstructorInfo returns the description and the fields of the constructor
+func GetConstructorInfo(constructor string) (ConstructorInfo, bool) {
+	info, ok := registry().constructors[constructor]
+	if !ok {
+		return ConstructorInfo{}, false
+	}
+
+	return *info, true
+}
+
+// NewByConstructor returns an empty value of the constructor, e.g. *MessageText for ConstructorMessageText
+func NewByConstructor(constructor string) (Type, error) {
+	info, ok := registry().constructors[constructor]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownConstructor, constructor)
+	}
+
+	return info.new(), nil
+}
+
+// Functions returns the names of all TDLib methods in the schema order
+func Functions() []string {
+	names := make([]string, len(functionInfos))
+	for i, info := range functionInfos {
+		names[i] = info.Name
+	}
+
+	return names
+}
+
+// GetFunctionInfo returns the description, the result type and the fields of the TDLib method
+func GetFunctionInfo(function string) (FunctionInfo, bool) {
+	info, ok := registry().functions[function]
+	if !ok {
+		return FunctionInfo{}, false
+	}
+
+	return *info, true
+}
+
+// NewRequestByFunction returns an empty request of the TDLib method, e.g. *GetChatRequest for "getChat"
+func NewRequestByFunction(function string) (Request, error) {
+	info, ok := registry().functions[function]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFunction, function)
+	}
+
+	return info.new(), nil
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNewByConstructor(t *testing.T) {
+	value, err := NewByConstructor(ConstructorMessageText)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := value.(*MessageText); !ok {
+		t.Errorf("unexpected type %T", value)
+	}
+
+	_, err = NewByConstructor("unknownConstructor")
+	if !errors.Is(err, ErrUnknownConstructor) {
+		t.Errorf("expected ErrUnknownConstructor, got %v", err)
+	}
+
+	for _, constructor := range Constructors() {
+		value, err := NewByConstructor(constructor)
+		if err != nil {
+			t.Fatalf("%s: %s", constructor, err)
+		}
+		if value.GetConstructor() != constructor {
+			t.Errorf("%s: constructor = %s", constructor, value.GetConstructor())
+		}
+
+		class, _ := ClassOf(constructor)
+		if value.GetType() != class {
+			t.Errorf("%s: type = %s, class = %s", constructor, value.GetType(), class)
+		}
+	}
+}
+
+func TestConstructorsOf(t *testing.T) {
+	constructors := ConstructorsOf(TypeMessageContent)
+	if !slices.Contains(constructors, ConstructorMessageText) || !slices.Contains(constructors, ConstructorMessagePhoto) {
+		t.Errorf("unexpected constructors: %v", constructors)
+	}
+
+	for _, constructor := range constructors {
+		value, _ := NewByConstructor(constructor)
+		if _, ok := value.(MessageContent); !ok {
+			t.Errorf("%s doesn't implement MessageContent", constructor)
+		}
+	}
+
+	if ConstructorsOf("UnknownClass") != nil {
+		t.Errorf("unexpected constructors of an unknown class")
+	}
+}
+
+func TestGetConstructorInfo(t *testing.T) {
+	info, ok := GetConstructorInfo(ConstructorMessageText)
+	if !ok {
+		t.Fatalf("messageText is not found")
+	}
+	if info.Type != TypeMessageContent || info.Description == "" {
+		t.Errorf("unexpected info: %+v", info)
+	}
+	if len(info.Fields) == 0 || info.Fields[0].Name != "text" || info.Fields[0].GoName != "Text" || info.Fields[0].Type != "formattedText" {
+		t.Errorf("unexpected fields: %+v", info.Fields)
+	}
+
+	if _, ok := GetConstructorInfo("unknownConstructor"); ok {
+		t.Errorf("unknown constructor is found")
+	}
+}
+
+func TestGetFunctionInfo(t *testing.T) {
+	info, ok := GetFunctionInfo("sendMessage")
+	if !ok {
+		t.Fatalf("sendMessage is not found")
+	}
+	if info.ResultType != "Message" || info.IsSynchronous {
+		t.Errorf("unexpected info: %+v", info)
+	}
+
+	var nullable []string
+	for _, field := range info.Fields {
+		if field.Nullable {
+			nullable = append(nullable, field.Name)
+		}
+	}
+	if !slices.Contains(nullable, "reply_markup") || slices.Contains(nullable, "chat_id") {
+		t.Errorf("unexpected nullable fields: %v", nullable)
+	}
+
+	req, err := NewRequestByFunction("getChat")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if req.GetFunctionName() != "getChat" {
+		t.Errorf("function name = %s", req.GetFunctionName())
+	}
+
+	_, err = NewRequestByFunction("unknownFunction")
+	if !errors.Is(err, ErrUnknownFunction) {
+		t.Errorf("expected ErrUnknownFunction, got %v", err)
+	}
+}
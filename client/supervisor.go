@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ClientFactory creates and authorizes a new client. The supervisor passes the context of Run and its own options (the result handler which
+// dispatches to the handlers of the supervisor), they must be forwarded to NewClientWithContext. Other options (e.g. WithStrictUnmarshal)
+// are passed by the factory itself. A fresh AuthorizationStateHandler should be created on every call.
+type ClientFactory func(ctx context.Context, options ...Option) (*Client, error)
+
+type SupervisorOption func(*Supervisor)
+
+// WithBackoff sets the minimal and maximal delay between client restarts
+func WithBackoff(minBackoff time.Duration, maxBackoff time.Duration) SupervisorOption {
+	return func(supervisor *Supervisor) {
+		supervisor.minBackoff = minBackoff
+		supervisor.maxBackoff = maxBackoff
+	}
+}
+
+// WithRestartHandler sets the callback that is called every time a new client is ready
+func WithRestartHandler(restartHandler func(client *Client)) SupervisorOption {
+	return func(supervisor *Supervisor) {
+		supervisor.restartHandler = restartHandler
+	}
+}
+
+// WithSupervisorResultHandler sets the result handler that is attached to every client created by the supervisor
+func WithSupervisorResultHandler(resultHandler ResultHandler) SupervisorOption {
+	return func(supervisor *Supervisor) {
+		supervisor.resultHandler = resultHandler
+	}
+}
+
+// WithCloseTimeout sets how long Run waits for authorizationStateClosed after ctx is done
+func WithCloseTimeout(timeout time.Duration) SupervisorOption {
+	return func(supervisor *Supervisor) {
+		supervisor.closeTimeout = timeout
+	}
+}
+
+// WithSupervisorErrorHandler sets the callback that is called when the client factory fails or the client isn't closed in time
+func WithSupervisorErrorHandler(errorHandler func(err error)) SupervisorOption {
+	return func(supervisor *Supervisor) {
+		supervisor.errorHandler = errorHandler
+	}
+}
+
+// Supervisor keeps a client alive. When the client is closed (logout, session termination from another device,
+// authorization failure) a new one is created with exponential backoff.
+// The result handlers of the supervisor are attached to every client, so they survive restarts.
+type Supervisor struct {
+	factory        ClientFactory
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+	closeTimeout   time.Duration
+	restartHandler func(client *Client)
+	resultHandler  ResultHandler
+	errorHandler   func(err error)
+	closeClient    func(ctx context.Context, client *Client) error
+	after          func(d time.Duration) <-chan time.Time
+
+	mu     sync.RWMutex
+	client *Client
+	ready  chan struct{}
+
+	handlersMu    sync.RWMutex
+	handlers      []supervisorHandler
+	nextHandlerId int
+}
+
+type supervisorHandler struct {
+	id      int
+	handler ResultHandler
+}
+
+func NewSupervisor(factory ClientFactory, options ...SupervisorOption) *Supervisor {
+	supervisor := &Supervisor{
+		factory:        factory,
+		minBackoff:     1 * time.Second,
+		maxBackoff:     5 * time.Minute,
+		closeTimeout:   30 * time.Second,
+		restartHandler: func(client *Client) {},
+		resultHandler:  NewCallbackResultHandler(func(result Type) {}),
+		errorHandler:   func(err error) {},
+		closeClient: func(ctx context.Context, client *Client) error {
+			_, err := client.Close(ctx)
+			return err
+		},
+		after: time.After,
+		ready: make(chan struct{}),
+	}
+
+	for _, option := range options {
+		option(supervisor)
+	}
+
+	return supervisor
+}
+
+// Run creates the client and recreates it every time it is closed. Blocks until ctx is done, then closes the current client
+// and waits for authorizationStateClosed for at most the close timeout.
+func (supervisor *Supervisor) Run(ctx context.Context) error {
+	backoff := supervisor.minBackoff
+
+	for {
+		startedAt := time.Now()
+
+		client, err := supervisor.factory(ctx, WithResultHandler(NewCallbackResultHandler(supervisor.onResult)))
+		if err != nil {
+			if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+				return err
+			}
+			supervisor.errorHandler(err)
+		} else {
+			supervisor.setClient(client)
+			supervisor.restartHandler(client)
+
+			select {
+			case <-client.Done():
+				supervisor.setClient(nil)
+
+			case <-ctx.Done():
+				supervisor.setClient(nil)
+				supervisor.close(client)
+				return ctx.Err()
+			}
+
+			if time.Since(startedAt) > supervisor.maxBackoff {
+				backoff = supervisor.minBackoff
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-supervisor.after(backoff):
+		}
+
+		backoff = nextBackoff(backoff, supervisor.maxBackoff)
+	}
+}
+
+func (supervisor *Supervisor) close(client *Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), supervisor.closeTimeout)
+	defer cancel()
+
+	err := supervisor.closeClient(ctx, client)
+	if err != nil {
+		supervisor.errorHandler(fmt.Errorf("close client: %w", err))
+		return
+	}
+
+	select {
+	case <-client.Done():
+
+	case <-ctx.Done():
+		supervisor.errorHandler(fmt.Errorf("close client: %w", ctx.Err()))
+	}
+}
+
+// AddResultHandler attaches the handler to the current client and to every client created after a restart.
+// The returned function detaches it
+func (supervisor *Supervisor) AddResultHandler(handler ResultHandler) func() {
+	supervisor.handlersMu.Lock()
+	defer supervisor.handlersMu.Unlock()
+
+	supervisor.nextHandlerId++
+	id := supervisor.nextHandlerId
+	supervisor.handlers = append(supervisor.handlers, supervisorHandler{id: id, handler: handler})
+
+	return func() {
+		supervisor.handlersMu.Lock()
+		defer supervisor.handlersMu.Unlock()
+
+		for i, entry := range supervisor.handlers {
+			if entry.id == id {
+				supervisor.handlers = append(supervisor.handlers[:i:i], supervisor.handlers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (supervisor *Supervisor) onResult(result Type) {
+	supervisor.resultHandler.OnResult(result)
+
+	supervisor.handlersMu.RLock()
+	handlers := supervisor.handlers
+	supervisor.handlersMu.RUnlock()
+
+	for _, entry := range handlers {
+		entry.handler.OnResult(result)
+	}
+}
+
+// Client returns the current client or nil if the client is being restarted
+func (supervisor *Supervisor) Client() *Client {
+	supervisor.mu.RLock()
+	defer supervisor.mu.RUnlock()
+
+	return supervisor.client
+}
+
+// WaitClient blocks until the client is ready
+func (supervisor *Supervisor) WaitClient(ctx context.Context) (*Client, error) {
+	for {
+		supervisor.mu.RLock()
+		client := supervisor.client
+		ready := supervisor.ready
+		supervisor.mu.RUnlock()
+
+		if client != nil {
+			return client, nil
+		}
+
+		select {
+		case <-ready:
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (supervisor *Supervisor) setClient(client *Client) {
+	supervisor.mu.Lock()
+	defer supervisor.mu.Unlock()
+
+	supervisor.client = client
+
+	if client != nil {
+		close(supervisor.ready)
+	} else {
+		supervisor.ready = make(chan struct{})
+	}
+}
+
+func nextBackoff(backoff time.Duration, maxBackoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+
+	return backoff
+}
@@ -0,0 +1,278 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNextBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff time.Duration
+		max     time.Duration
+		want    time.Duration
+	}{
+		{"double", time.Second, time.Minute, 2 * time.Second},
+		{"capped", 40 * time.Second, time.Minute, time.Minute},
+		{"at_max", time.Minute, time.Minute, time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nextBackoff(tt.backoff, tt.max)
+			if got != tt.want {
+				t.Errorf("nextBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupervisor_RetriesFactoryErrors(t *testing.T) {
+	factoryErr := errors.New("factory error")
+
+	var calls int
+	var errs int
+
+	supervisor := NewSupervisor(
+		func(ctx context.Context, options ...Option) (*Client, error) {
+			calls++
+			return nil, factoryErr
+		},
+		WithBackoff(time.Millisecond, 4*time.Millisecond),
+		WithSupervisorErrorHandler(func(err error) {
+			if !errors.Is(err, factoryErr) {
+				t.Errorf("unexpected error: %s", err)
+			}
+			errs++
+		}),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := supervisor.Run(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Run() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if calls < 2 {
+		t.Errorf("factory called %d times, want at least 2", calls)
+	}
+
+	if errs != calls {
+		t.Errorf("error handler called %d times, want %d", errs, calls)
+	}
+
+	if supervisor.Client() != nil {
+		t.Errorf("Client() = %v, want nil", supervisor.Client())
+	}
+}
+
+func TestSupervisor_WaitClientCanceled(t *testing.T) {
+	supervisor := NewSupervisor(func(ctx context.Context, options ...Option) (*Client, error) {
+		return nil, errors.New("factory error")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := supervisor.WaitClient(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitClient() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func newFakeSupervisedClient(options ...Option) *Client {
+	client := &Client{
+		done:          make(chan struct{}),
+		resultHandler: NewCallbackResultHandler(func(result Type) {}),
+	}
+	for _, option := range options {
+		option(client)
+	}
+
+	return client
+}
+
+func TestSupervisor_RecreatesClosedClient(t *testing.T) {
+	factoryErr := errors.New("factory error")
+
+	clients := make(chan *Client, 10)
+	var calls int
+
+	var mu sync.Mutex
+	var delays []time.Duration
+
+	supervisor := NewSupervisor(
+		func(ctx context.Context, options ...Option) (*Client, error) {
+			calls++
+			if calls <= 2 {
+				return nil, factoryErr
+			}
+
+			return newFakeSupervisedClient(options...), nil
+		},
+		WithBackoff(time.Millisecond, 4*time.Millisecond),
+		WithRestartHandler(func(client *Client) {
+			clients <- client
+		}),
+	)
+	supervisor.after = func(d time.Duration) <-chan time.Time {
+		mu.Lock()
+		delays = append(delays, d)
+		mu.Unlock()
+
+		return time.After(0)
+	}
+	supervisor.closeClient = func(ctx context.Context, client *Client) error {
+		close(client.done)
+		return nil
+	}
+
+	var results []string
+	remove := supervisor.AddResultHandler(NewCallbackResultHandler(func(result Type) {
+		results = append(results, result.GetConstructor())
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- supervisor.Run(ctx)
+	}()
+
+	first := <-clients
+	first.resultHandler.OnResult(&Ok{})
+
+	// the client lives longer than the maximal backoff, so the backoff is reset after it is closed
+	time.Sleep(10 * time.Millisecond)
+	close(first.done)
+
+	second := <-clients
+	if second == first {
+		t.Fatalf("the closed client is not recreated")
+	}
+
+	waited, err := supervisor.WaitClient(ctx)
+	if err != nil || waited != second {
+		t.Errorf("WaitClient() = %p, %v, want the new client %p", waited, err, second)
+	}
+
+	second.resultHandler.OnResult(&Error{})
+	remove()
+	second.resultHandler.OnResult(&Ok{})
+
+	cancel()
+
+	err = <-errs
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+
+	if calls != 4 {
+		t.Errorf("factory called %d times, want 4", calls)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	wantDelays := []time.Duration{time.Millisecond, 2 * time.Millisecond, time.Millisecond}
+	if !reflect.DeepEqual(delays, wantDelays) {
+		t.Errorf("delays = %v, want %v", delays, wantDelays)
+	}
+
+	wantResults := []string{ConstructorOk, ConstructorError}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("results = %v, want %v", results, wantResults)
+	}
+}
+
+func TestSupervisor_CloseTimeout(t *testing.T) {
+	clients := make(chan *Client, 1)
+
+	var closeErr error
+
+	supervisor := NewSupervisor(
+		func(ctx context.Context, options ...Option) (*Client, error) {
+			return newFakeSupervisedClient(options...), nil
+		},
+		WithCloseTimeout(10*time.Millisecond),
+		WithRestartHandler(func(client *Client) {
+			clients <- client
+		}),
+		WithSupervisorErrorHandler(func(err error) {
+			closeErr = err
+		}),
+	)
+	// authorizationStateClosed is never received
+	supervisor.closeClient = func(ctx context.Context, client *Client) error {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- supervisor.Run(ctx)
+	}()
+
+	<-clients
+	cancel()
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("Run() hangs after ctx is done")
+	}
+
+	if !errors.Is(closeErr, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", closeErr, context.DeadlineExceeded)
+	}
+}
+
+func TestSupervisor_FactoryCanceled(t *testing.T) {
+	var errs int
+
+	supervisor := NewSupervisor(
+		func(ctx context.Context, options ...Option) (*Client, error) {
+			// the authorization waits until ctx is done
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		WithSupervisorErrorHandler(func(err error) {
+			errs++
+		}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- supervisor.Run(ctx)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Run() is not canceled while the factory is blocked")
+	}
+
+	if errs != 0 {
+		t.Errorf("error handler called %d times for the cancellation", errs)
+	}
+}
@@ -96,21 +96,13 @@ func (instance *tdlib) receive(timeout float64) (*Response, error) {
 
 	data := []byte(C.GoString(result))
 
-	var resp Response
-	err := json.Unmarshal(data, &resp)
-	if err != nil {
-		return nil, err
-	}
-
-	resp.Data = data
-
-	return &resp, nil
+	return decodeResponse(data)
 }
 
 func Execute(req Request) (*Response, error) {
 	req.SetType(req.GetFunctionName())
 
-	data, err := json.Marshal(req)
+	data, err := marshalRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -124,15 +116,7 @@ func Execute(req Request) (*Response, error) {
 
 	data = []byte(C.GoString(result))
 
-	var resp Response
-	err = json.Unmarshal(data, &resp)
-	if err != nil {
-		return nil, err
-	}
-
-	resp.Data = data
-
-	return &resp, nil
+	return decodeResponse(data)
 }
 
 type JsonClient struct {
@@ -147,7 +131,7 @@ func NewJsonClient() *JsonClient {
 
 // Sends request to the TDLib client. May be called from any thread.
 func (jsonClient *JsonClient) Send(req Request) error {
-	data, err := json.Marshal(req)
+	data, err := marshalRequest(req)
 	if err != nil {
 		return err
 	}
@@ -174,6 +158,10 @@ type meta struct {
 	MetaClientId int    `json:"@client_id"`
 }
 
+func (meta *meta) getMeta() *meta {
+	return meta
+}
+
 type reqMeta struct {
 	MetaType  string `json:"@type"`
 	MetaExtra string `json:"@extra"`
@@ -210,6 +198,8 @@ func (req *request) GetType() string {
 type Response struct {
 	meta
 	Data json.RawMessage
+	// Type is Data decoded by the receiver or nil if Data can't be decoded. The result handler gets a separately decoded value
+	Type Type
 }
 
 type ResponseError struct {
@@ -0,0 +1,7311 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeAuthenticationCodeType             = "AuthenticationCodeType"
+	TypeEmailAddressAuthentication         = "EmailAddressAuthentication"
+	TypeAuthorizationState                 = "AuthorizationState"
+	TypeLoginUrlInfo                       = "LoginUrlInfo"
+	TypePassportElementType                = "PassportElementType"
+	TypePassportElement                    = "PassportElement"
+	TypeInputPassportElement               = "InputPassportElement"
+	TypePassportElementErrorSource         = "PassportElementErrorSource"
+	TypeInputPassportElementErrorSource    = "InputPassportElementErrorSource"
+	TypeFirebaseAuthenticationSettings     = "FirebaseAuthenticationSettings"
+	TypeResetPasswordResult                = "ResetPasswordResult"
+	TypeSessionType                        = "SessionType"
+	TypeAuthenticationCodeInfo             = "AuthenticationCodeInfo"
+	TypeEmailAddressAuthenticationCodeInfo = "EmailAddressAuthenticationCodeInfo"
+	TypePasswordState                      = "PasswordState"
+	TypeTemporaryPasswordState             = "TemporaryPasswordState"
+	TypePassportElements                   = "PassportElements"
+	TypePassportElementError               = "PassportElementError"
+	TypePassportSuitableElement            = "PassportSuitableElement"
+	TypePassportRequiredElement            = "PassportRequiredElement"
+	TypePassportAuthorizationForm          = "PassportAuthorizationForm"
+	TypePassportElementsWithErrors         = "PassportElementsWithErrors"
+	TypeEncryptedPassportElement           = "EncryptedPassportElement"
+	TypeInputPassportElementError          = "InputPassportElementError"
+	TypePhoneNumberAuthenticationSettings  = "PhoneNumberAuthenticationSettings"
+	TypeSession                            = "Session"
+	TypeSessions                           = "Sessions"
+	TypeUnconfirmedSession                 = "UnconfirmedSession"
+)
+
+const (
+	ConstructorAuthenticationCodeTypeTelegramMessage           = "authenticationCodeTypeTelegramMessage"
+	ConstructorAuthenticationCodeTypeSms                       = "authenticationCodeTypeSms"
+	ConstructorAuthenticationCodeTypeSmsWord                   = "authenticationCodeTypeSmsWord"
+	ConstructorAuthenticationCodeTypeSmsPhrase                 = "authenticationCodeTypeSmsPhrase"
+	ConstructorAuthenticationCodeTypeCall                      = "authenticationCodeTypeCall"
+	ConstructorAuthenticationCodeTypeFlashCall                 = "authenticationCodeTypeFlashCall"
+	ConstructorAuthenticationCodeTypeMissedCall                = "authenticationCodeTypeMissedCall"
+	ConstructorAuthenticationCodeTypeFragment                  = "authenticationCodeTypeFragment"
+	ConstructorAuthenticationCodeTypeFirebaseAndroid           = "authenticationCodeTypeFirebaseAndroid"
+	ConstructorAuthenticationCodeTypeFirebaseIos               = "authenticationCodeTypeFirebaseIos"
+	ConstructorAuthenticationCodeInfo                          = "authenticationCodeInfo"
+	ConstructorEmailAddressAuthenticationCodeInfo              = "emailAddressAuthenticationCodeInfo"
+	ConstructorEmailAddressAuthenticationCode                  = "emailAddressAuthenticationCode"
+	ConstructorEmailAddressAuthenticationAppleId               = "emailAddressAuthenticationAppleId"
+	ConstructorEmailAddressAuthenticationGoogleId              = "emailAddressAuthenticationGoogleId"
+	ConstructorAuthorizationStateWaitTdlibParameters           = "authorizationStateWaitTdlibParameters"
+	ConstructorAuthorizationStateWaitPhoneNumber               = "authorizationStateWaitPhoneNumber"
+	ConstructorAuthorizationStateWaitPremiumPurchase           = "authorizationStateWaitPremiumPurchase"
+	ConstructorAuthorizationStateWaitEmailAddress              = "authorizationStateWaitEmailAddress"
+	ConstructorAuthorizationStateWaitEmailCode                 = "authorizationStateWaitEmailCode"
+	ConstructorAuthorizationStateWaitCode                      = "authorizationStateWaitCode"
+	ConstructorAuthorizationStateWaitOtherDeviceConfirmation   = "authorizationStateWaitOtherDeviceConfirmation"
+	ConstructorAuthorizationStateWaitRegistration              = "authorizationStateWaitRegistration"
+	ConstructorAuthorizationStateWaitPassword                  = "authorizationStateWaitPassword"
+	ConstructorAuthorizationStateReady                         = "authorizationStateReady"
+	ConstructorAuthorizationStateLoggingOut                    = "authorizationStateLoggingOut"
+	ConstructorAuthorizationStateClosing                       = "authorizationStateClosing"
+	ConstructorAuthorizationStateClosed                        = "authorizationStateClosed"
+	ConstructorPasswordState                                   = "passwordState"
+	ConstructorTemporaryPasswordState                          = "temporaryPasswordState"
+	ConstructorLoginUrlInfoOpen                                = "loginUrlInfoOpen"
+	ConstructorLoginUrlInfoRequestConfirmation                 = "loginUrlInfoRequestConfirmation"
+	ConstructorPassportElementTypePersonalDetails              = "passportElementTypePersonalDetails"
+	ConstructorPassportElementTypePassport                     = "passportElementTypePassport"
+	ConstructorPassportElementTypeDriverLicense                = "passportElementTypeDriverLicense"
+	ConstructorPassportElementTypeIdentityCard                 = "passportElementTypeIdentityCard"
+	ConstructorPassportElementTypeInternalPassport             = "passportElementTypeInternalPassport"
+	ConstructorPassportElementTypeAddress                      = "passportElementTypeAddress"
+	ConstructorPassportElementTypeUtilityBill                  = "passportElementTypeUtilityBill"
+	ConstructorPassportElementTypeBankStatement                = "passportElementTypeBankStatement"
+	ConstructorPassportElementTypeRentalAgreement              = "passportElementTypeRentalAgreement"
+	ConstructorPassportElementTypePassportRegistration         = "passportElementTypePassportRegistration"
+	ConstructorPassportElementTypeTemporaryRegistration        = "passportElementTypeTemporaryRegistration"
+	ConstructorPassportElementTypePhoneNumber                  = "passportElementTypePhoneNumber"
+	ConstructorPassportElementTypeEmailAddress                 = "passportElementTypeEmailAddress"
+	ConstructorPassportElementPersonalDetails                  = "passportElementPersonalDetails"
+	ConstructorPassportElementPassport                         = "passportElementPassport"
+	ConstructorPassportElementDriverLicense                    = "passportElementDriverLicense"
+	ConstructorPassportElementIdentityCard                     = "passportElementIdentityCard"
+	ConstructorPassportElementInternalPassport                 = "passportElementInternalPassport"
+	ConstructorPassportElementAddress                          = "passportElementAddress"
+	ConstructorPassportElementUtilityBill                      = "passportElementUtilityBill"
+	ConstructorPassportElementBankStatement                    = "passportElementBankStatement"
+	ConstructorPassportElementRentalAgreement                  = "passportElementRentalAgreement"
+	ConstructorPassportElementPassportRegistration             = "passportElementPassportRegistration"
+	ConstructorPassportElementTemporaryRegistration            = "passportElementTemporaryRegistration"
+	ConstructorPassportElementPhoneNumber                      = "passportElementPhoneNumber"
+	ConstructorPassportElementEmailAddress                     = "passportElementEmailAddress"
+	ConstructorInputPassportElementPersonalDetails             = "inputPassportElementPersonalDetails"
+	ConstructorInputPassportElementPassport                    = "inputPassportElementPassport"
+	ConstructorInputPassportElementDriverLicense               = "inputPassportElementDriverLicense"
+	ConstructorInputPassportElementIdentityCard                = "inputPassportElementIdentityCard"
+	ConstructorInputPassportElementInternalPassport            = "inputPassportElementInternalPassport"
+	ConstructorInputPassportElementAddress                     = "inputPassportElementAddress"
+	ConstructorInputPassportElementUtilityBill                 = "inputPassportElementUtilityBill"
+	ConstructorInputPassportElementBankStatement               = "inputPassportElementBankStatement"
+	ConstructorInputPassportElementRentalAgreement             = "inputPassportElementRentalAgreement"
+	ConstructorInputPassportElementPassportRegistration        = "inputPassportElementPassportRegistration"
+	ConstructorInputPassportElementTemporaryRegistration       = "inputPassportElementTemporaryRegistration"
+	ConstructorInputPassportElementPhoneNumber                 = "inputPassportElementPhoneNumber"
+	ConstructorInputPassportElementEmailAddress                = "inputPassportElementEmailAddress"
+	ConstructorPassportElements                                = "passportElements"
+	ConstructorPassportElementErrorSourceUnspecified           = "passportElementErrorSourceUnspecified"
+	ConstructorPassportElementErrorSourceDataField             = "passportElementErrorSourceDataField"
+	ConstructorPassportElementErrorSourceFrontSide             = "passportElementErrorSourceFrontSide"
+	ConstructorPassportElementErrorSourceReverseSide           = "passportElementErrorSourceReverseSide"
+	ConstructorPassportElementErrorSourceSelfie                = "passportElementErrorSourceSelfie"
+	ConstructorPassportElementErrorSourceTranslationFile       = "passportElementErrorSourceTranslationFile"
+	ConstructorPassportElementErrorSourceTranslationFiles      = "passportElementErrorSourceTranslationFiles"
+	ConstructorPassportElementErrorSourceFile                  = "passportElementErrorSourceFile"
+	ConstructorPassportElementErrorSourceFiles                 = "passportElementErrorSourceFiles"
+	ConstructorPassportElementError                            = "passportElementError"
+	ConstructorPassportSuitableElement                         = "passportSuitableElement"
+	ConstructorPassportRequiredElement                         = "passportRequiredElement"
+	ConstructorPassportAuthorizationForm                       = "passportAuthorizationForm"
+	ConstructorPassportElementsWithErrors                      = "passportElementsWithErrors"
+	ConstructorEncryptedPassportElement                        = "encryptedPassportElement"
+	ConstructorInputPassportElementErrorSourceUnspecified      = "inputPassportElementErrorSourceUnspecified"
+	ConstructorInputPassportElementErrorSourceDataField        = "inputPassportElementErrorSourceDataField"
+	ConstructorInputPassportElementErrorSourceFrontSide        = "inputPassportElementErrorSourceFrontSide"
+	ConstructorInputPassportElementErrorSourceReverseSide      = "inputPassportElementErrorSourceReverseSide"
+	ConstructorInputPassportElementErrorSourceSelfie           = "inputPassportElementErrorSourceSelfie"
+	ConstructorInputPassportElementErrorSourceTranslationFile  = "inputPassportElementErrorSourceTranslationFile"
+	ConstructorInputPassportElementErrorSourceTranslationFiles = "inputPassportElementErrorSourceTranslationFiles"
+	ConstructorInputPassportElementErrorSourceFile             = "inputPassportElementErrorSourceFile"
+	ConstructorInputPassportElementErrorSourceFiles            = "inputPassportElementErrorSourceFiles"
+	ConstructorInputPassportElementError                       = "inputPassportElementError"
+	ConstructorFirebaseAuthenticationSettingsAndroid           = "firebaseAuthenticationSettingsAndroid"
+	ConstructorFirebaseAuthenticationSettingsIos               = "firebaseAuthenticationSettingsIos"
+	ConstructorPhoneNumberAuthenticationSettings               = "phoneNumberAuthenticationSettings"
+	ConstructorResetPasswordResultOk                           = "resetPasswordResultOk"
+	ConstructorResetPasswordResultPending                      = "resetPasswordResultPending"
+	ConstructorResetPasswordResultDeclined                     = "resetPasswordResultDeclined"
+	ConstructorSessionTypeAndroid                              = "sessionTypeAndroid"
+	ConstructorSessionTypeApple                                = "sessionTypeApple"
+	ConstructorSessionTypeBrave                                = "sessionTypeBrave"
+	ConstructorSessionTypeChrome                               = "sessionTypeChrome"
+	ConstructorSessionTypeEdge                                 = "sessionTypeEdge"
+	ConstructorSessionTypeFirefox                              = "sessionTypeFirefox"
+	ConstructorSessionTypeIpad                                 = "sessionTypeIpad"
+	ConstructorSessionTypeIphone                               = "sessionTypeIphone"
+	ConstructorSessionTypeLinux                                = "sessionTypeLinux"
+	ConstructorSessionTypeMac                                  = "sessionTypeMac"
+	ConstructorSessionTypeOpera                                = "sessionTypeOpera"
+	ConstructorSessionTypeSafari                               = "sessionTypeSafari"
+	ConstructorSessionTypeUbuntu                               = "sessionTypeUbuntu"
+	ConstructorSessionTypeUnknown                              = "sessionTypeUnknown"
+	ConstructorSessionTypeVivaldi                              = "sessionTypeVivaldi"
+	ConstructorSessionTypeWindows                              = "sessionTypeWindows"
+	ConstructorSessionTypeXbox                                 = "sessionTypeXbox"
+	ConstructorSession                                         = "session"
+	ConstructorSessions                                        = "sessions"
+	ConstructorUnconfirmedSession                              = "unconfirmedSession"
+)
+
+// Provides information about the method by which an authentication code is delivered to the user
+type AuthenticationCodeType interface {
+	AuthenticationCodeTypeConstructor() string
+}
+
+// Contains authentication data for an email address
+type EmailAddressAuthentication interface {
+	EmailAddressAuthenticationConstructor() string
+}
+
+// Represents the current authorization state of the TDLib client
+type AuthorizationState interface {
+	AuthorizationStateConstructor() string
+}
+
+// Contains information about an inline button of type inlineKeyboardButtonTypeLoginUrl
+type LoginUrlInfo interface {
+	LoginUrlInfoConstructor() string
+}
+
+// Contains the type of Telegram Passport element
+type PassportElementType interface {
+	PassportElementTypeConstructor() string
+}
+
+// Contains information about a Telegram Passport element
+type PassportElement interface {
+	PassportElementConstructor() string
+}
+
+// Contains information about a Telegram Passport element to be saved
+type InputPassportElement interface {
+	InputPassportElementConstructor() string
+}
+
+// Contains the description of an error in a Telegram Passport element
+type PassportElementErrorSource interface {
+	PassportElementErrorSourceConstructor() string
+}
+
+// Contains the description of an error in a Telegram Passport element; for bots only
+type InputPassportElementErrorSource interface {
+	InputPassportElementErrorSourceConstructor() string
+}
+
+// Contains settings for Firebase Authentication in the official applications
+type FirebaseAuthenticationSettings interface {
+	FirebaseAuthenticationSettingsConstructor() string
+}
+
+// Represents result of 2-step verification password reset
+type ResetPasswordResult interface {
+	ResetPasswordResultConstructor() string
+}
+
+// Represents the type of session
+type SessionType interface {
+	SessionTypeConstructor() string
+}
+
+func (entity *UnknownType) AuthenticationCodeTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) EmailAddressAuthenticationConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) AuthorizationStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) LoginUrlInfoConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PassportElementTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PassportElementConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputPassportElementConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PassportElementErrorSourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputPassportElementErrorSourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) FirebaseAuthenticationSettingsConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ResetPasswordResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SessionTypeConstructor() string {
+	return entity.Constructor
+}
+
+// A digit-only authentication code is delivered via a private Telegram message, which can be viewed from another active session
+type AuthenticationCodeTypeTelegramMessage struct {
+	meta
+	// Length of the code
+	Length int32 `json:"length"`
+}
+
+func (*AuthenticationCodeTypeTelegramMessage) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeTelegramMessage) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeTelegramMessage
+}
+
+func (*AuthenticationCodeTypeTelegramMessage) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeTelegramMessage
+}
+
+func (entity *AuthenticationCodeTypeTelegramMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeTelegramMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeTelegramMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeTelegramMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeTelegramMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeTelegramMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A digit-only authentication code is delivered via an SMS message to the specified phone number; non-official applications may not receive this type of code
+type AuthenticationCodeTypeSms struct {
+	meta
+	// Length of the code
+	Length int32 `json:"length"`
+}
+
+func (*AuthenticationCodeTypeSms) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeSms) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeSms
+}
+
+func (*AuthenticationCodeTypeSms) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeSms
+}
+
+func (entity *AuthenticationCodeTypeSms) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeSms) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeSms) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeSms"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeSms) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeSms)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authentication code is a word delivered via an SMS message to the specified phone number; non-official applications may not receive this type of code
+type AuthenticationCodeTypeSmsWord struct {
+	meta
+	// The first letters of the word if known
+	FirstLetter string `json:"first_letter"`
+}
+
+func (*AuthenticationCodeTypeSmsWord) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeSmsWord) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeSmsWord
+}
+
+func (*AuthenticationCodeTypeSmsWord) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeSmsWord
+}
+
+func (entity *AuthenticationCodeTypeSmsWord) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeSmsWord) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeSmsWord) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeSmsWord"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"first_letter":`)
+	encoder.writeString(entity.FirstLetter)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeSmsWord) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "first_letter":
+			entity.FirstLetter, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeSmsWord)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authentication code is a phrase from multiple words delivered via an SMS message to the specified phone number; non-official applications may not receive this type of code
+type AuthenticationCodeTypeSmsPhrase struct {
+	meta
+	// The first word of the phrase if known
+	FirstWord string `json:"first_word"`
+}
+
+func (*AuthenticationCodeTypeSmsPhrase) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeSmsPhrase) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeSmsPhrase
+}
+
+func (*AuthenticationCodeTypeSmsPhrase) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeSmsPhrase
+}
+
+func (entity *AuthenticationCodeTypeSmsPhrase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeSmsPhrase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeSmsPhrase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeSmsPhrase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"first_word":`)
+	encoder.writeString(entity.FirstWord)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeSmsPhrase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "first_word":
+			entity.FirstWord, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeSmsPhrase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A digit-only authentication code is delivered via a phone call to the specified phone number
+type AuthenticationCodeTypeCall struct {
+	meta
+	// Length of the code
+	Length int32 `json:"length"`
+}
+
+func (*AuthenticationCodeTypeCall) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeCall) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeCall
+}
+
+func (*AuthenticationCodeTypeCall) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeCall
+}
+
+func (entity *AuthenticationCodeTypeCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authentication code is delivered by an immediately canceled call to the specified phone number. The phone number that calls is the code that must be entered automatically
+type AuthenticationCodeTypeFlashCall struct {
+	meta
+	// Pattern of the phone number from which the call will be made
+	Pattern string `json:"pattern"`
+}
+
+func (*AuthenticationCodeTypeFlashCall) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeFlashCall) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeFlashCall
+}
+
+func (*AuthenticationCodeTypeFlashCall) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeFlashCall
+}
+
+func (entity *AuthenticationCodeTypeFlashCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeFlashCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeFlashCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeFlashCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"pattern":`)
+	encoder.writeString(entity.Pattern)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeFlashCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "pattern":
+			entity.Pattern, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeFlashCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authentication code is delivered by an immediately canceled call to the specified phone number. The last digits of the phone number that calls are the code that must be entered manually by the user
+type AuthenticationCodeTypeMissedCall struct {
+	meta
+	// Prefix of the phone number from which the call will be made
+	PhoneNumberPrefix string `json:"phone_number_prefix"`
+	// Number of digits in the code, excluding the prefix
+	Length int32 `json:"length"`
+}
+
+func (*AuthenticationCodeTypeMissedCall) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeMissedCall) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeMissedCall
+}
+
+func (*AuthenticationCodeTypeMissedCall) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeMissedCall
+}
+
+func (entity *AuthenticationCodeTypeMissedCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeMissedCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeMissedCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeMissedCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"phone_number_prefix":`)
+	encoder.writeString(entity.PhoneNumberPrefix)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeMissedCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "phone_number_prefix":
+			entity.PhoneNumberPrefix, err = decoder.readString()
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeMissedCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A digit-only authentication code is delivered to https://fragment.com. The user must be logged in there via a wallet owning the phone number's NFT
+type AuthenticationCodeTypeFragment struct {
+	meta
+	// URL to open to receive the code
+	Url string `json:"url"`
+	// Length of the code
+	Length int32 `json:"length"`
+}
+
+func (*AuthenticationCodeTypeFragment) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeFragment) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeFragment
+}
+
+func (*AuthenticationCodeTypeFragment) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeFragment
+}
+
+func (entity *AuthenticationCodeTypeFragment) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeFragment) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeFragment) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeFragment"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeFragment) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeFragment)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A digit-only authentication code is delivered via Firebase Authentication to the official Android application
+type AuthenticationCodeTypeFirebaseAndroid struct {
+	meta
+	// Parameters to be used for device verification
+	DeviceVerificationParameters FirebaseDeviceVerificationParameters `json:"device_verification_parameters"`
+	// Length of the code
+	Length int32 `json:"length"`
+}
+
+func (*AuthenticationCodeTypeFirebaseAndroid) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeFirebaseAndroid) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeFirebaseAndroid
+}
+
+func (*AuthenticationCodeTypeFirebaseAndroid) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeFirebaseAndroid
+}
+
+func (entity *AuthenticationCodeTypeFirebaseAndroid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeFirebaseAndroid) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeFirebaseAndroid) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeFirebaseAndroid"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"device_verification_parameters":`)
+	encoder.writeType(entity.DeviceVerificationParameters)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeFirebaseAndroid) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "device_verification_parameters":
+			entity.DeviceVerificationParameters, err = decodeFirebaseDeviceVerificationParameters(decoder)
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeFirebaseAndroid)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A digit-only authentication code is delivered via Firebase Authentication to the official iOS application
+type AuthenticationCodeTypeFirebaseIos struct {
+	meta
+	// Receipt of successful application token validation to compare with receipt from push notification
+	Receipt string `json:"receipt"`
+	// Time after the next authentication method is expected to be used if verification push notification isn't received, in seconds
+	PushTimeout int32 `json:"push_timeout"`
+	// Length of the code
+	Length int32 `json:"length"`
+}
+
+func (*AuthenticationCodeTypeFirebaseIos) GetType() string {
+	return TypeAuthenticationCodeType
+}
+
+func (*AuthenticationCodeTypeFirebaseIos) GetConstructor() string {
+	return ConstructorAuthenticationCodeTypeFirebaseIos
+}
+
+func (*AuthenticationCodeTypeFirebaseIos) AuthenticationCodeTypeConstructor() string {
+	return ConstructorAuthenticationCodeTypeFirebaseIos
+}
+
+func (entity *AuthenticationCodeTypeFirebaseIos) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeTypeFirebaseIos) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeTypeFirebaseIos) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeTypeFirebaseIos"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"receipt":`)
+	encoder.writeString(entity.Receipt)
+	encoder.writeRaw(`,"push_timeout":`)
+	encoder.writeInt32(entity.PushTimeout)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeTypeFirebaseIos) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "receipt":
+			entity.Receipt, err = decoder.readString()
+
+		case "push_timeout":
+			entity.PushTimeout, err = decoder.readInt32()
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeTypeFirebaseIos)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about the authentication code that was sent
+type AuthenticationCodeInfo struct {
+	meta
+	// A phone number that is being authenticated
+	PhoneNumber string `json:"phone_number"`
+	// The way the code was sent to the user
+	Type AuthenticationCodeType `json:"type"`
+	// The way the next code will be sent to the user; may be null
+	NextType AuthenticationCodeType `json:"next_type,omitempty"`
+	// Timeout before the code can be re-sent, in seconds
+	Timeout int32 `json:"timeout"`
+}
+
+func (*AuthenticationCodeInfo) GetType() string {
+	return TypeAuthenticationCodeInfo
+}
+
+func (*AuthenticationCodeInfo) GetConstructor() string {
+	return ConstructorAuthenticationCodeInfo
+}
+
+func (entity *AuthenticationCodeInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthenticationCodeInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthenticationCodeInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authenticationCodeInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	if entity.NextType != nil {
+		encoder.writeRaw(`,"next_type":`)
+		encoder.writeType(entity.NextType)
+	}
+	encoder.writeRaw(`,"timeout":`)
+	encoder.writeInt32(entity.Timeout)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthenticationCodeInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		case "type":
+			entity.Type, err = decodeAuthenticationCodeType(decoder)
+
+		case "next_type":
+			entity.NextType, err = decodeAuthenticationCodeType(decoder)
+
+		case "timeout":
+			entity.Timeout, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthenticationCodeInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about the email address authentication code that was sent
+type EmailAddressAuthenticationCodeInfo struct {
+	meta
+	// Pattern of the email address to which an authentication code was sent
+	EmailAddressPattern string `json:"email_address_pattern"`
+	// Length of the code; 0 if unknown
+	Length int32 `json:"length"`
+}
+
+func (*EmailAddressAuthenticationCodeInfo) GetType() string {
+	return TypeEmailAddressAuthenticationCodeInfo
+}
+
+func (*EmailAddressAuthenticationCodeInfo) GetConstructor() string {
+	return ConstructorEmailAddressAuthenticationCodeInfo
+}
+
+func (entity *EmailAddressAuthenticationCodeInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmailAddressAuthenticationCodeInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmailAddressAuthenticationCodeInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emailAddressAuthenticationCodeInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"email_address_pattern":`)
+	encoder.writeString(entity.EmailAddressPattern)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmailAddressAuthenticationCodeInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "email_address_pattern":
+			entity.EmailAddressPattern, err = decoder.readString()
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmailAddressAuthenticationCodeInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authentication code delivered to a user's email address
+type EmailAddressAuthenticationCode struct {
+	meta
+	// The code
+	Code string `json:"code"`
+}
+
+func (*EmailAddressAuthenticationCode) GetType() string {
+	return TypeEmailAddressAuthentication
+}
+
+func (*EmailAddressAuthenticationCode) GetConstructor() string {
+	return ConstructorEmailAddressAuthenticationCode
+}
+
+func (*EmailAddressAuthenticationCode) EmailAddressAuthenticationConstructor() string {
+	return ConstructorEmailAddressAuthenticationCode
+}
+
+func (entity *EmailAddressAuthenticationCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmailAddressAuthenticationCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmailAddressAuthenticationCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emailAddressAuthenticationCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(entity.Code)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmailAddressAuthenticationCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "code":
+			entity.Code, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmailAddressAuthenticationCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authentication token received through Apple ID
+type EmailAddressAuthenticationAppleId struct {
+	meta
+	// The token
+	Token string `json:"token"`
+}
+
+func (*EmailAddressAuthenticationAppleId) GetType() string {
+	return TypeEmailAddressAuthentication
+}
+
+func (*EmailAddressAuthenticationAppleId) GetConstructor() string {
+	return ConstructorEmailAddressAuthenticationAppleId
+}
+
+func (*EmailAddressAuthenticationAppleId) EmailAddressAuthenticationConstructor() string {
+	return ConstructorEmailAddressAuthenticationAppleId
+}
+
+func (entity *EmailAddressAuthenticationAppleId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmailAddressAuthenticationAppleId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmailAddressAuthenticationAppleId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emailAddressAuthenticationAppleId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmailAddressAuthenticationAppleId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmailAddressAuthenticationAppleId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authentication token received through Google ID
+type EmailAddressAuthenticationGoogleId struct {
+	meta
+	// The token
+	Token string `json:"token"`
+}
+
+func (*EmailAddressAuthenticationGoogleId) GetType() string {
+	return TypeEmailAddressAuthentication
+}
+
+func (*EmailAddressAuthenticationGoogleId) GetConstructor() string {
+	return ConstructorEmailAddressAuthenticationGoogleId
+}
+
+func (*EmailAddressAuthenticationGoogleId) EmailAddressAuthenticationConstructor() string {
+	return ConstructorEmailAddressAuthenticationGoogleId
+}
+
+func (entity *EmailAddressAuthenticationGoogleId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmailAddressAuthenticationGoogleId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmailAddressAuthenticationGoogleId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emailAddressAuthenticationGoogleId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmailAddressAuthenticationGoogleId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmailAddressAuthenticationGoogleId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Initialization parameters are needed. Call setTdlibParameters to provide them
+type AuthorizationStateWaitTdlibParameters struct {
+	meta
+}
+
+func (*AuthorizationStateWaitTdlibParameters) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitTdlibParameters) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitTdlibParameters
+}
+
+func (*AuthorizationStateWaitTdlibParameters) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitTdlibParameters
+}
+
+func (entity *AuthorizationStateWaitTdlibParameters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitTdlibParameters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitTdlibParameters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitTdlibParameters"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitTdlibParameters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitTdlibParameters)
+	})
+}
+
+// TDLib needs the user's phone number to authorize. Call setAuthenticationPhoneNumber to provide the phone number, or use requestQrCodeAuthentication or checkAuthenticationBotToken for other authentication options
+type AuthorizationStateWaitPhoneNumber struct {
+	meta
+}
+
+func (*AuthorizationStateWaitPhoneNumber) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitPhoneNumber) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitPhoneNumber
+}
+
+func (*AuthorizationStateWaitPhoneNumber) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitPhoneNumber
+}
+
+func (entity *AuthorizationStateWaitPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitPhoneNumber)
+	})
+}
+
+// The user must buy Telegram Premium as an in-store purchase to log in. Call checkAuthenticationPremiumPurchase and then setAuthenticationPremiumPurchaseTransaction
+type AuthorizationStateWaitPremiumPurchase struct {
+	meta
+	// Identifier of the store product that must be bought
+	StoreProductId string `json:"store_product_id"`
+}
+
+func (*AuthorizationStateWaitPremiumPurchase) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitPremiumPurchase) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitPremiumPurchase
+}
+
+func (*AuthorizationStateWaitPremiumPurchase) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitPremiumPurchase
+}
+
+func (entity *AuthorizationStateWaitPremiumPurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitPremiumPurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitPremiumPurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitPremiumPurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"store_product_id":`)
+	encoder.writeString(entity.StoreProductId)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitPremiumPurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "store_product_id":
+			entity.StoreProductId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitPremiumPurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// TDLib needs the user's email address to authorize. Call setAuthenticationEmailAddress to provide the email address, or directly call checkAuthenticationEmailCode with Apple ID/Google ID token if allowed
+type AuthorizationStateWaitEmailAddress struct {
+	meta
+	// True, if authorization through Apple ID is allowed
+	AllowAppleId bool `json:"allow_apple_id"`
+	// True, if authorization through Google ID is allowed
+	AllowGoogleId bool `json:"allow_google_id"`
+}
+
+func (*AuthorizationStateWaitEmailAddress) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitEmailAddress) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitEmailAddress
+}
+
+func (*AuthorizationStateWaitEmailAddress) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitEmailAddress
+}
+
+func (entity *AuthorizationStateWaitEmailAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitEmailAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitEmailAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitEmailAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"allow_apple_id":`)
+	encoder.writeBool(entity.AllowAppleId)
+	encoder.writeRaw(`,"allow_google_id":`)
+	encoder.writeBool(entity.AllowGoogleId)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitEmailAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "allow_apple_id":
+			entity.AllowAppleId, err = decoder.readBool()
+
+		case "allow_google_id":
+			entity.AllowGoogleId, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitEmailAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// TDLib needs the user's authentication code sent to an email address to authorize. Call checkAuthenticationEmailCode to provide the code
+type AuthorizationStateWaitEmailCode struct {
+	meta
+	// True, if authorization through Apple ID is allowed
+	AllowAppleId bool `json:"allow_apple_id"`
+	// True, if authorization through Google ID is allowed
+	AllowGoogleId bool `json:"allow_google_id"`
+	// Information about the sent authentication code
+	CodeInfo *EmailAddressAuthenticationCodeInfo `json:"code_info"`
+	// Reset state of the email address; may be null if the email address can't be reset
+	EmailAddressResetState EmailAddressResetState `json:"email_address_reset_state,omitempty"`
+}
+
+func (*AuthorizationStateWaitEmailCode) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitEmailCode) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitEmailCode
+}
+
+func (*AuthorizationStateWaitEmailCode) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitEmailCode
+}
+
+func (entity *AuthorizationStateWaitEmailCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitEmailCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitEmailCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitEmailCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"allow_apple_id":`)
+	encoder.writeBool(entity.AllowAppleId)
+	encoder.writeRaw(`,"allow_google_id":`)
+	encoder.writeBool(entity.AllowGoogleId)
+	encoder.writeRaw(`,"code_info":`)
+	entity.CodeInfo.encodeJSON(encoder)
+	if entity.EmailAddressResetState != nil {
+		encoder.writeRaw(`,"email_address_reset_state":`)
+		encoder.writeType(entity.EmailAddressResetState)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitEmailCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "allow_apple_id":
+			entity.AllowAppleId, err = decoder.readBool()
+
+		case "allow_google_id":
+			entity.AllowGoogleId, err = decoder.readBool()
+
+		case "code_info":
+			entity.CodeInfo, err = decodeEntity[EmailAddressAuthenticationCodeInfo](decoder)
+
+		case "email_address_reset_state":
+			entity.EmailAddressResetState, err = decodeEmailAddressResetState(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitEmailCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// TDLib needs the user's authentication code to authorize. Call checkAuthenticationCode to check the code
+type AuthorizationStateWaitCode struct {
+	meta
+	// Information about the authorization code that was sent
+	CodeInfo *AuthenticationCodeInfo `json:"code_info"`
+}
+
+func (*AuthorizationStateWaitCode) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitCode) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitCode
+}
+
+func (*AuthorizationStateWaitCode) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitCode
+}
+
+func (entity *AuthorizationStateWaitCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"code_info":`)
+	entity.CodeInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "code_info":
+			entity.CodeInfo, err = decodeEntity[AuthenticationCodeInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user needs to confirm authorization on another logged in device by scanning a QR code with the provided link
+type AuthorizationStateWaitOtherDeviceConfirmation struct {
+	meta
+	// A tg:// URL for the QR code. The link will be updated frequently
+	Link string `json:"link"`
+}
+
+func (*AuthorizationStateWaitOtherDeviceConfirmation) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitOtherDeviceConfirmation) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitOtherDeviceConfirmation
+}
+
+func (*AuthorizationStateWaitOtherDeviceConfirmation) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitOtherDeviceConfirmation
+}
+
+func (entity *AuthorizationStateWaitOtherDeviceConfirmation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitOtherDeviceConfirmation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitOtherDeviceConfirmation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitOtherDeviceConfirmation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(entity.Link)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitOtherDeviceConfirmation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "link":
+			entity.Link, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitOtherDeviceConfirmation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is unregistered and need to accept terms of service and enter their first name and last name to finish registration. Call registerUser to accept the terms of service and provide the data
+type AuthorizationStateWaitRegistration struct {
+	meta
+	// Telegram terms of service
+	TermsOfService *TermsOfService `json:"terms_of_service"`
+}
+
+func (*AuthorizationStateWaitRegistration) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitRegistration) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitRegistration
+}
+
+func (*AuthorizationStateWaitRegistration) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitRegistration
+}
+
+func (entity *AuthorizationStateWaitRegistration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitRegistration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitRegistration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitRegistration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"terms_of_service":`)
+	entity.TermsOfService.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitRegistration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "terms_of_service":
+			entity.TermsOfService, err = decodeEntity[TermsOfService](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitRegistration)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user has been authorized, but needs to enter a 2-step verification password to start using the application. Call checkAuthenticationPassword to provide the password, or requestAuthenticationPasswordRecovery to recover the password, or deleteAccount to delete the account after a week
+type AuthorizationStateWaitPassword struct {
+	meta
+	// Hint for the password; may be empty
+	PasswordHint string `json:"password_hint"`
+	// True, if a recovery email address has been set up
+	HasRecoveryEmailAddress bool `json:"has_recovery_email_address"`
+	// True, if some Telegram Passport elements were saved
+	HasPassportData bool `json:"has_passport_data"`
+	// Pattern of the email address to which the recovery email was sent; empty until a recovery email has been sent
+	RecoveryEmailAddressPattern string `json:"recovery_email_address_pattern"`
+}
+
+func (*AuthorizationStateWaitPassword) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateWaitPassword) GetConstructor() string {
+	return ConstructorAuthorizationStateWaitPassword
+}
+
+func (*AuthorizationStateWaitPassword) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateWaitPassword
+}
+
+func (entity *AuthorizationStateWaitPassword) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateWaitPassword) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateWaitPassword) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateWaitPassword"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"password_hint":`)
+	encoder.writeString(entity.PasswordHint)
+	encoder.writeRaw(`,"has_recovery_email_address":`)
+	encoder.writeBool(entity.HasRecoveryEmailAddress)
+	encoder.writeRaw(`,"has_passport_data":`)
+	encoder.writeBool(entity.HasPassportData)
+	encoder.writeRaw(`,"recovery_email_address_pattern":`)
+	encoder.writeString(entity.RecoveryEmailAddressPattern)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateWaitPassword) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "password_hint":
+			entity.PasswordHint, err = decoder.readString()
+
+		case "has_recovery_email_address":
+			entity.HasRecoveryEmailAddress, err = decoder.readBool()
+
+		case "has_passport_data":
+			entity.HasPassportData, err = decoder.readBool()
+
+		case "recovery_email_address_pattern":
+			entity.RecoveryEmailAddressPattern, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateWaitPassword)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user has been successfully authorized. TDLib is now ready to answer general requests
+type AuthorizationStateReady struct {
+	meta
+}
+
+func (*AuthorizationStateReady) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateReady) GetConstructor() string {
+	return ConstructorAuthorizationStateReady
+}
+
+func (*AuthorizationStateReady) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateReady
+}
+
+func (entity *AuthorizationStateReady) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateReady) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateReady) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateReady"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateReady) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateReady)
+	})
+}
+
+// The user is currently logging out
+type AuthorizationStateLoggingOut struct {
+	meta
+}
+
+func (*AuthorizationStateLoggingOut) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateLoggingOut) GetConstructor() string {
+	return ConstructorAuthorizationStateLoggingOut
+}
+
+func (*AuthorizationStateLoggingOut) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateLoggingOut
+}
+
+func (entity *AuthorizationStateLoggingOut) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateLoggingOut) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateLoggingOut) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateLoggingOut"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateLoggingOut) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateLoggingOut)
+	})
+}
+
+// TDLib is closing, all subsequent queries will be answered with the error 500. Note that closing TDLib can take a while. All resources will be freed only after authorizationStateClosed has been received
+type AuthorizationStateClosing struct {
+	meta
+}
+
+func (*AuthorizationStateClosing) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateClosing) GetConstructor() string {
+	return ConstructorAuthorizationStateClosing
+}
+
+func (*AuthorizationStateClosing) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateClosing
+}
+
+func (entity *AuthorizationStateClosing) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateClosing) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateClosing) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateClosing"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateClosing) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateClosing)
+	})
+}
+
+// TDLib client is in its final state. All databases are closed and all resources are released. No other updates will be received after this. All queries will be responded to with error code 500. To continue working, one must create a new instance of the TDLib client
+type AuthorizationStateClosed struct {
+	meta
+}
+
+func (*AuthorizationStateClosed) GetType() string {
+	return TypeAuthorizationState
+}
+
+func (*AuthorizationStateClosed) GetConstructor() string {
+	return ConstructorAuthorizationStateClosed
+}
+
+func (*AuthorizationStateClosed) AuthorizationStateConstructor() string {
+	return ConstructorAuthorizationStateClosed
+}
+
+func (entity *AuthorizationStateClosed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AuthorizationStateClosed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AuthorizationStateClosed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"authorizationStateClosed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AuthorizationStateClosed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAuthorizationStateClosed)
+	})
+}
+
+// Represents the current state of 2-step verification
+type PasswordState struct {
+	meta
+	// True, if a 2-step verification password is set
+	HasPassword bool `json:"has_password"`
+	// Hint for the password; may be empty
+	PasswordHint string `json:"password_hint"`
+	// True, if a recovery email is set
+	HasRecoveryEmailAddress bool `json:"has_recovery_email_address"`
+	// True, if some Telegram Passport elements were saved
+	HasPassportData bool `json:"has_passport_data"`
+	// Information about the recovery email address to which the confirmation email was sent; may be null
+	RecoveryEmailAddressCodeInfo *EmailAddressAuthenticationCodeInfo `json:"recovery_email_address_code_info,omitempty"`
+	// Pattern of the email address set up for logging in
+	LoginEmailAddressPattern string `json:"login_email_address_pattern"`
+	// If not 0, point in time (Unix timestamp) after which the 2-step verification password can be reset immediately using resetPassword
+	PendingResetDate int32 `json:"pending_reset_date"`
+}
+
+func (*PasswordState) GetType() string {
+	return TypePasswordState
+}
+
+func (*PasswordState) GetConstructor() string {
+	return ConstructorPasswordState
+}
+
+func (entity *PasswordState) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PasswordState) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PasswordState) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passwordState"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"has_password":`)
+	encoder.writeBool(entity.HasPassword)
+	encoder.writeRaw(`,"password_hint":`)
+	encoder.writeString(entity.PasswordHint)
+	encoder.writeRaw(`,"has_recovery_email_address":`)
+	encoder.writeBool(entity.HasRecoveryEmailAddress)
+	encoder.writeRaw(`,"has_passport_data":`)
+	encoder.writeBool(entity.HasPassportData)
+	if entity.RecoveryEmailAddressCodeInfo != nil {
+		encoder.writeRaw(`,"recovery_email_address_code_info":`)
+		entity.RecoveryEmailAddressCodeInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"login_email_address_pattern":`)
+	encoder.writeString(entity.LoginEmailAddressPattern)
+	encoder.writeRaw(`,"pending_reset_date":`)
+	encoder.writeInt32(entity.PendingResetDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *PasswordState) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "has_password":
+			entity.HasPassword, err = decoder.readBool()
+
+		case "password_hint":
+			entity.PasswordHint, err = decoder.readString()
+
+		case "has_recovery_email_address":
+			entity.HasRecoveryEmailAddress, err = decoder.readBool()
+
+		case "has_passport_data":
+			entity.HasPassportData, err = decoder.readBool()
+
+		case "recovery_email_address_code_info":
+			entity.RecoveryEmailAddressCodeInfo, err = decodeEntity[EmailAddressAuthenticationCodeInfo](decoder)
+
+		case "login_email_address_pattern":
+			entity.LoginEmailAddressPattern, err = decoder.readString()
+
+		case "pending_reset_date":
+			entity.PendingResetDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPasswordState)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns information about the availability of a temporary password, which can be used for payments
+type TemporaryPasswordState struct {
+	meta
+	// True, if a temporary password is available
+	HasPassword bool `json:"has_password"`
+	// Time left before the temporary password expires, in seconds
+	ValidFor int32 `json:"valid_for"`
+}
+
+func (*TemporaryPasswordState) GetType() string {
+	return TypeTemporaryPasswordState
+}
+
+func (*TemporaryPasswordState) GetConstructor() string {
+	return ConstructorTemporaryPasswordState
+}
+
+func (entity *TemporaryPasswordState) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TemporaryPasswordState) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TemporaryPasswordState) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"temporaryPasswordState"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"has_password":`)
+	encoder.writeBool(entity.HasPassword)
+	encoder.writeRaw(`,"valid_for":`)
+	encoder.writeInt32(entity.ValidFor)
+	encoder.writeRaw("}")
+}
+
+func (entity *TemporaryPasswordState) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "has_password":
+			entity.HasPassword, err = decoder.readBool()
+
+		case "valid_for":
+			entity.ValidFor, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTemporaryPasswordState)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An HTTP URL needs to be open
+type LoginUrlInfoOpen struct {
+	meta
+	// The URL to open
+	Url string `json:"url"`
+	// True, if there is no need to show an ordinary open URL confirmation
+	SkipConfirmation bool `json:"skip_confirmation"`
+}
+
+func (*LoginUrlInfoOpen) GetType() string {
+	return TypeLoginUrlInfo
+}
+
+func (*LoginUrlInfoOpen) GetConstructor() string {
+	return ConstructorLoginUrlInfoOpen
+}
+
+func (*LoginUrlInfoOpen) LoginUrlInfoConstructor() string {
+	return ConstructorLoginUrlInfoOpen
+}
+
+func (entity *LoginUrlInfoOpen) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LoginUrlInfoOpen) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LoginUrlInfoOpen) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loginUrlInfoOpen"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"skip_confirmation":`)
+	encoder.writeBool(entity.SkipConfirmation)
+	encoder.writeRaw("}")
+}
+
+func (entity *LoginUrlInfoOpen) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "skip_confirmation":
+			entity.SkipConfirmation, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLoginUrlInfoOpen)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An authorization confirmation dialog needs to be shown to the user
+type LoginUrlInfoRequestConfirmation struct {
+	meta
+	// An HTTP URL to be opened
+	Url string `json:"url"`
+	// A domain of the URL
+	Domain string `json:"domain"`
+	// User identifier of a bot linked with the website
+	BotUserId int64 `json:"bot_user_id"`
+	// True, if the user must be asked for the permission to the bot to send them messages
+	RequestWriteAccess bool `json:"request_write_access"`
+}
+
+func (*LoginUrlInfoRequestConfirmation) GetType() string {
+	return TypeLoginUrlInfo
+}
+
+func (*LoginUrlInfoRequestConfirmation) GetConstructor() string {
+	return ConstructorLoginUrlInfoRequestConfirmation
+}
+
+func (*LoginUrlInfoRequestConfirmation) LoginUrlInfoConstructor() string {
+	return ConstructorLoginUrlInfoRequestConfirmation
+}
+
+func (entity *LoginUrlInfoRequestConfirmation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LoginUrlInfoRequestConfirmation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LoginUrlInfoRequestConfirmation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"loginUrlInfoRequestConfirmation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"domain":`)
+	encoder.writeString(entity.Domain)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"request_write_access":`)
+	encoder.writeBool(entity.RequestWriteAccess)
+	encoder.writeRaw("}")
+}
+
+func (entity *LoginUrlInfoRequestConfirmation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "domain":
+			entity.Domain, err = decoder.readString()
+
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "request_write_access":
+			entity.RequestWriteAccess, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLoginUrlInfoRequestConfirmation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's personal details
+type PassportElementTypePersonalDetails struct {
+	meta
+}
+
+func (*PassportElementTypePersonalDetails) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypePersonalDetails) GetConstructor() string {
+	return ConstructorPassportElementTypePersonalDetails
+}
+
+func (*PassportElementTypePersonalDetails) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypePersonalDetails
+}
+
+func (entity *PassportElementTypePersonalDetails) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypePersonalDetails) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypePersonalDetails) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypePersonalDetails"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypePersonalDetails) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypePersonalDetails)
+	})
+}
+
+// A Telegram Passport element containing the user's passport
+type PassportElementTypePassport struct {
+	meta
+}
+
+func (*PassportElementTypePassport) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypePassport) GetConstructor() string {
+	return ConstructorPassportElementTypePassport
+}
+
+func (*PassportElementTypePassport) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypePassport
+}
+
+func (entity *PassportElementTypePassport) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypePassport) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypePassport) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypePassport"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypePassport) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypePassport)
+	})
+}
+
+// A Telegram Passport element containing the user's driver license
+type PassportElementTypeDriverLicense struct {
+	meta
+}
+
+func (*PassportElementTypeDriverLicense) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeDriverLicense) GetConstructor() string {
+	return ConstructorPassportElementTypeDriverLicense
+}
+
+func (*PassportElementTypeDriverLicense) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeDriverLicense
+}
+
+func (entity *PassportElementTypeDriverLicense) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeDriverLicense) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeDriverLicense) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeDriverLicense"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeDriverLicense) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeDriverLicense)
+	})
+}
+
+// A Telegram Passport element containing the user's identity card
+type PassportElementTypeIdentityCard struct {
+	meta
+}
+
+func (*PassportElementTypeIdentityCard) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeIdentityCard) GetConstructor() string {
+	return ConstructorPassportElementTypeIdentityCard
+}
+
+func (*PassportElementTypeIdentityCard) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeIdentityCard
+}
+
+func (entity *PassportElementTypeIdentityCard) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeIdentityCard) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeIdentityCard) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeIdentityCard"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeIdentityCard) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeIdentityCard)
+	})
+}
+
+// A Telegram Passport element containing the user's internal passport
+type PassportElementTypeInternalPassport struct {
+	meta
+}
+
+func (*PassportElementTypeInternalPassport) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeInternalPassport) GetConstructor() string {
+	return ConstructorPassportElementTypeInternalPassport
+}
+
+func (*PassportElementTypeInternalPassport) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeInternalPassport
+}
+
+func (entity *PassportElementTypeInternalPassport) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeInternalPassport) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeInternalPassport) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeInternalPassport"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeInternalPassport) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeInternalPassport)
+	})
+}
+
+// A Telegram Passport element containing the user's address
+type PassportElementTypeAddress struct {
+	meta
+}
+
+func (*PassportElementTypeAddress) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeAddress) GetConstructor() string {
+	return ConstructorPassportElementTypeAddress
+}
+
+func (*PassportElementTypeAddress) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeAddress
+}
+
+func (entity *PassportElementTypeAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeAddress)
+	})
+}
+
+// A Telegram Passport element containing the user's utility bill
+type PassportElementTypeUtilityBill struct {
+	meta
+}
+
+func (*PassportElementTypeUtilityBill) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeUtilityBill) GetConstructor() string {
+	return ConstructorPassportElementTypeUtilityBill
+}
+
+func (*PassportElementTypeUtilityBill) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeUtilityBill
+}
+
+func (entity *PassportElementTypeUtilityBill) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeUtilityBill) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeUtilityBill) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeUtilityBill"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeUtilityBill) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeUtilityBill)
+	})
+}
+
+// A Telegram Passport element containing the user's bank statement
+type PassportElementTypeBankStatement struct {
+	meta
+}
+
+func (*PassportElementTypeBankStatement) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeBankStatement) GetConstructor() string {
+	return ConstructorPassportElementTypeBankStatement
+}
+
+func (*PassportElementTypeBankStatement) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeBankStatement
+}
+
+func (entity *PassportElementTypeBankStatement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeBankStatement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeBankStatement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeBankStatement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeBankStatement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeBankStatement)
+	})
+}
+
+// A Telegram Passport element containing the user's rental agreement
+type PassportElementTypeRentalAgreement struct {
+	meta
+}
+
+func (*PassportElementTypeRentalAgreement) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeRentalAgreement) GetConstructor() string {
+	return ConstructorPassportElementTypeRentalAgreement
+}
+
+func (*PassportElementTypeRentalAgreement) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeRentalAgreement
+}
+
+func (entity *PassportElementTypeRentalAgreement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeRentalAgreement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeRentalAgreement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeRentalAgreement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeRentalAgreement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeRentalAgreement)
+	})
+}
+
+// A Telegram Passport element containing the registration page of the user's passport
+type PassportElementTypePassportRegistration struct {
+	meta
+}
+
+func (*PassportElementTypePassportRegistration) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypePassportRegistration) GetConstructor() string {
+	return ConstructorPassportElementTypePassportRegistration
+}
+
+func (*PassportElementTypePassportRegistration) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypePassportRegistration
+}
+
+func (entity *PassportElementTypePassportRegistration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypePassportRegistration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypePassportRegistration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypePassportRegistration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypePassportRegistration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypePassportRegistration)
+	})
+}
+
+// A Telegram Passport element containing the user's temporary registration
+type PassportElementTypeTemporaryRegistration struct {
+	meta
+}
+
+func (*PassportElementTypeTemporaryRegistration) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeTemporaryRegistration) GetConstructor() string {
+	return ConstructorPassportElementTypeTemporaryRegistration
+}
+
+func (*PassportElementTypeTemporaryRegistration) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeTemporaryRegistration
+}
+
+func (entity *PassportElementTypeTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeTemporaryRegistration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeTemporaryRegistration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeTemporaryRegistration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeTemporaryRegistration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeTemporaryRegistration)
+	})
+}
+
+// A Telegram Passport element containing the user's phone number
+type PassportElementTypePhoneNumber struct {
+	meta
+}
+
+func (*PassportElementTypePhoneNumber) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypePhoneNumber) GetConstructor() string {
+	return ConstructorPassportElementTypePhoneNumber
+}
+
+func (*PassportElementTypePhoneNumber) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypePhoneNumber
+}
+
+func (entity *PassportElementTypePhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypePhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypePhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypePhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypePhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypePhoneNumber)
+	})
+}
+
+// A Telegram Passport element containing the user's email address
+type PassportElementTypeEmailAddress struct {
+	meta
+}
+
+func (*PassportElementTypeEmailAddress) GetType() string {
+	return TypePassportElementType
+}
+
+func (*PassportElementTypeEmailAddress) GetConstructor() string {
+	return ConstructorPassportElementTypeEmailAddress
+}
+
+func (*PassportElementTypeEmailAddress) PassportElementTypeConstructor() string {
+	return ConstructorPassportElementTypeEmailAddress
+}
+
+func (entity *PassportElementTypeEmailAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTypeEmailAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTypeEmailAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTypeEmailAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTypeEmailAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementTypeEmailAddress)
+	})
+}
+
+// A Telegram Passport element containing the user's personal details
+type PassportElementPersonalDetails struct {
+	meta
+	// Personal details of the user
+	PersonalDetails *PersonalDetails `json:"personal_details"`
+}
+
+func (*PassportElementPersonalDetails) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementPersonalDetails) GetConstructor() string {
+	return ConstructorPassportElementPersonalDetails
+}
+
+func (*PassportElementPersonalDetails) PassportElementConstructor() string {
+	return ConstructorPassportElementPersonalDetails
+}
+
+func (entity *PassportElementPersonalDetails) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementPersonalDetails) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementPersonalDetails) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementPersonalDetails"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"personal_details":`)
+	entity.PersonalDetails.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementPersonalDetails) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "personal_details":
+			entity.PersonalDetails, err = decodeEntity[PersonalDetails](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementPersonalDetails)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PassportElementPersonalDetails) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("personal_details", entity.PersonalDetails); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element containing the user's passport
+type PassportElementPassport struct {
+	meta
+	// Passport
+	Passport *IdentityDocument `json:"passport"`
+}
+
+func (*PassportElementPassport) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementPassport) GetConstructor() string {
+	return ConstructorPassportElementPassport
+}
+
+func (*PassportElementPassport) PassportElementConstructor() string {
+	return ConstructorPassportElementPassport
+}
+
+func (entity *PassportElementPassport) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementPassport) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementPassport) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementPassport"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"passport":`)
+	entity.Passport.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementPassport) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "passport":
+			entity.Passport, err = decodeEntity[IdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementPassport)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PassportElementPassport) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("passport", entity.Passport); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element containing the user's driver license
+type PassportElementDriverLicense struct {
+	meta
+	// Driver license
+	DriverLicense *IdentityDocument `json:"driver_license"`
+}
+
+func (*PassportElementDriverLicense) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementDriverLicense) GetConstructor() string {
+	return ConstructorPassportElementDriverLicense
+}
+
+func (*PassportElementDriverLicense) PassportElementConstructor() string {
+	return ConstructorPassportElementDriverLicense
+}
+
+func (entity *PassportElementDriverLicense) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementDriverLicense) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementDriverLicense) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementDriverLicense"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"driver_license":`)
+	entity.DriverLicense.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementDriverLicense) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "driver_license":
+			entity.DriverLicense, err = decodeEntity[IdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementDriverLicense)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PassportElementDriverLicense) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("driver_license", entity.DriverLicense); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element containing the user's identity card
+type PassportElementIdentityCard struct {
+	meta
+	// Identity card
+	IdentityCard *IdentityDocument `json:"identity_card"`
+}
+
+func (*PassportElementIdentityCard) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementIdentityCard) GetConstructor() string {
+	return ConstructorPassportElementIdentityCard
+}
+
+func (*PassportElementIdentityCard) PassportElementConstructor() string {
+	return ConstructorPassportElementIdentityCard
+}
+
+func (entity *PassportElementIdentityCard) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementIdentityCard) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementIdentityCard) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementIdentityCard"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"identity_card":`)
+	entity.IdentityCard.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementIdentityCard) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "identity_card":
+			entity.IdentityCard, err = decodeEntity[IdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementIdentityCard)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PassportElementIdentityCard) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("identity_card", entity.IdentityCard); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element containing the user's internal passport
+type PassportElementInternalPassport struct {
+	meta
+	// Internal passport
+	InternalPassport *IdentityDocument `json:"internal_passport"`
+}
+
+func (*PassportElementInternalPassport) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementInternalPassport) GetConstructor() string {
+	return ConstructorPassportElementInternalPassport
+}
+
+func (*PassportElementInternalPassport) PassportElementConstructor() string {
+	return ConstructorPassportElementInternalPassport
+}
+
+func (entity *PassportElementInternalPassport) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementInternalPassport) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementInternalPassport) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementInternalPassport"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"internal_passport":`)
+	entity.InternalPassport.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementInternalPassport) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "internal_passport":
+			entity.InternalPassport, err = decodeEntity[IdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementInternalPassport)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PassportElementInternalPassport) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("internal_passport", entity.InternalPassport); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element containing the user's address
+type PassportElementAddress struct {
+	meta
+	// Address
+	Address *Address `json:"address"`
+}
+
+func (*PassportElementAddress) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementAddress) GetConstructor() string {
+	return ConstructorPassportElementAddress
+}
+
+func (*PassportElementAddress) PassportElementConstructor() string {
+	return ConstructorPassportElementAddress
+}
+
+func (entity *PassportElementAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"address":`)
+	entity.Address.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "address":
+			entity.Address, err = decodeEntity[Address](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's utility bill
+type PassportElementUtilityBill struct {
+	meta
+	// Utility bill
+	UtilityBill *PersonalDocument `json:"utility_bill"`
+}
+
+func (*PassportElementUtilityBill) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementUtilityBill) GetConstructor() string {
+	return ConstructorPassportElementUtilityBill
+}
+
+func (*PassportElementUtilityBill) PassportElementConstructor() string {
+	return ConstructorPassportElementUtilityBill
+}
+
+func (entity *PassportElementUtilityBill) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementUtilityBill) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementUtilityBill) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementUtilityBill"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"utility_bill":`)
+	entity.UtilityBill.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementUtilityBill) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "utility_bill":
+			entity.UtilityBill, err = decodeEntity[PersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementUtilityBill)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's bank statement
+type PassportElementBankStatement struct {
+	meta
+	// Bank statement
+	BankStatement *PersonalDocument `json:"bank_statement"`
+}
+
+func (*PassportElementBankStatement) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementBankStatement) GetConstructor() string {
+	return ConstructorPassportElementBankStatement
+}
+
+func (*PassportElementBankStatement) PassportElementConstructor() string {
+	return ConstructorPassportElementBankStatement
+}
+
+func (entity *PassportElementBankStatement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementBankStatement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementBankStatement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementBankStatement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bank_statement":`)
+	entity.BankStatement.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementBankStatement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bank_statement":
+			entity.BankStatement, err = decodeEntity[PersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementBankStatement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's rental agreement
+type PassportElementRentalAgreement struct {
+	meta
+	// Rental agreement
+	RentalAgreement *PersonalDocument `json:"rental_agreement"`
+}
+
+func (*PassportElementRentalAgreement) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementRentalAgreement) GetConstructor() string {
+	return ConstructorPassportElementRentalAgreement
+}
+
+func (*PassportElementRentalAgreement) PassportElementConstructor() string {
+	return ConstructorPassportElementRentalAgreement
+}
+
+func (entity *PassportElementRentalAgreement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementRentalAgreement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementRentalAgreement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementRentalAgreement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"rental_agreement":`)
+	entity.RentalAgreement.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementRentalAgreement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "rental_agreement":
+			entity.RentalAgreement, err = decodeEntity[PersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementRentalAgreement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's passport registration pages
+type PassportElementPassportRegistration struct {
+	meta
+	// Passport registration pages
+	PassportRegistration *PersonalDocument `json:"passport_registration"`
+}
+
+func (*PassportElementPassportRegistration) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementPassportRegistration) GetConstructor() string {
+	return ConstructorPassportElementPassportRegistration
+}
+
+func (*PassportElementPassportRegistration) PassportElementConstructor() string {
+	return ConstructorPassportElementPassportRegistration
+}
+
+func (entity *PassportElementPassportRegistration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementPassportRegistration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementPassportRegistration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementPassportRegistration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"passport_registration":`)
+	entity.PassportRegistration.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementPassportRegistration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "passport_registration":
+			entity.PassportRegistration, err = decodeEntity[PersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementPassportRegistration)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's temporary registration
+type PassportElementTemporaryRegistration struct {
+	meta
+	// Temporary registration
+	TemporaryRegistration *PersonalDocument `json:"temporary_registration"`
+}
+
+func (*PassportElementTemporaryRegistration) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementTemporaryRegistration) GetConstructor() string {
+	return ConstructorPassportElementTemporaryRegistration
+}
+
+func (*PassportElementTemporaryRegistration) PassportElementConstructor() string {
+	return ConstructorPassportElementTemporaryRegistration
+}
+
+func (entity *PassportElementTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementTemporaryRegistration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementTemporaryRegistration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementTemporaryRegistration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"temporary_registration":`)
+	entity.TemporaryRegistration.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementTemporaryRegistration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "temporary_registration":
+			entity.TemporaryRegistration, err = decodeEntity[PersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementTemporaryRegistration)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's phone number
+type PassportElementPhoneNumber struct {
+	meta
+	// Phone number
+	PhoneNumber string `json:"phone_number"`
+}
+
+func (*PassportElementPhoneNumber) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementPhoneNumber) GetConstructor() string {
+	return ConstructorPassportElementPhoneNumber
+}
+
+func (*PassportElementPhoneNumber) PassportElementConstructor() string {
+	return ConstructorPassportElementPhoneNumber
+}
+
+func (entity *PassportElementPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementPhoneNumber)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element containing the user's email address
+type PassportElementEmailAddress struct {
+	meta
+	// Email address
+	EmailAddress string `json:"email_address"`
+}
+
+func (*PassportElementEmailAddress) GetType() string {
+	return TypePassportElement
+}
+
+func (*PassportElementEmailAddress) GetConstructor() string {
+	return ConstructorPassportElementEmailAddress
+}
+
+func (*PassportElementEmailAddress) PassportElementConstructor() string {
+	return ConstructorPassportElementEmailAddress
+}
+
+func (entity *PassportElementEmailAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementEmailAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementEmailAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementEmailAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"email_address":`)
+	encoder.writeString(entity.EmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementEmailAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "email_address":
+			entity.EmailAddress, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementEmailAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's personal details
+type InputPassportElementPersonalDetails struct {
+	meta
+	// Personal details of the user
+	PersonalDetails *PersonalDetails `json:"personal_details"`
+}
+
+func (*InputPassportElementPersonalDetails) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementPersonalDetails) GetConstructor() string {
+	return ConstructorInputPassportElementPersonalDetails
+}
+
+func (*InputPassportElementPersonalDetails) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementPersonalDetails
+}
+
+func (entity *InputPassportElementPersonalDetails) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementPersonalDetails) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementPersonalDetails) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementPersonalDetails"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"personal_details":`)
+	entity.PersonalDetails.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementPersonalDetails) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "personal_details":
+			entity.PersonalDetails, err = decodeEntity[PersonalDetails](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementPersonalDetails)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputPassportElementPersonalDetails) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("personal_details", entity.PersonalDetails); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element to be saved containing the user's passport
+type InputPassportElementPassport struct {
+	meta
+	// The passport to be saved
+	Passport *InputIdentityDocument `json:"passport"`
+}
+
+func (*InputPassportElementPassport) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementPassport) GetConstructor() string {
+	return ConstructorInputPassportElementPassport
+}
+
+func (*InputPassportElementPassport) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementPassport
+}
+
+func (entity *InputPassportElementPassport) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementPassport) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementPassport) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementPassport"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"passport":`)
+	entity.Passport.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementPassport) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "passport":
+			entity.Passport, err = decodeEntity[InputIdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementPassport)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputPassportElementPassport) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("passport", entity.Passport); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element to be saved containing the user's driver license
+type InputPassportElementDriverLicense struct {
+	meta
+	// The driver license to be saved
+	DriverLicense *InputIdentityDocument `json:"driver_license"`
+}
+
+func (*InputPassportElementDriverLicense) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementDriverLicense) GetConstructor() string {
+	return ConstructorInputPassportElementDriverLicense
+}
+
+func (*InputPassportElementDriverLicense) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementDriverLicense
+}
+
+func (entity *InputPassportElementDriverLicense) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementDriverLicense) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementDriverLicense) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementDriverLicense"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"driver_license":`)
+	entity.DriverLicense.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementDriverLicense) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "driver_license":
+			entity.DriverLicense, err = decodeEntity[InputIdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementDriverLicense)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputPassportElementDriverLicense) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("driver_license", entity.DriverLicense); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element to be saved containing the user's identity card
+type InputPassportElementIdentityCard struct {
+	meta
+	// The identity card to be saved
+	IdentityCard *InputIdentityDocument `json:"identity_card"`
+}
+
+func (*InputPassportElementIdentityCard) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementIdentityCard) GetConstructor() string {
+	return ConstructorInputPassportElementIdentityCard
+}
+
+func (*InputPassportElementIdentityCard) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementIdentityCard
+}
+
+func (entity *InputPassportElementIdentityCard) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementIdentityCard) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementIdentityCard) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementIdentityCard"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"identity_card":`)
+	entity.IdentityCard.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementIdentityCard) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "identity_card":
+			entity.IdentityCard, err = decodeEntity[InputIdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementIdentityCard)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputPassportElementIdentityCard) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("identity_card", entity.IdentityCard); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element to be saved containing the user's internal passport
+type InputPassportElementInternalPassport struct {
+	meta
+	// The internal passport to be saved
+	InternalPassport *InputIdentityDocument `json:"internal_passport"`
+}
+
+func (*InputPassportElementInternalPassport) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementInternalPassport) GetConstructor() string {
+	return ConstructorInputPassportElementInternalPassport
+}
+
+func (*InputPassportElementInternalPassport) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementInternalPassport
+}
+
+func (entity *InputPassportElementInternalPassport) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementInternalPassport) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementInternalPassport) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementInternalPassport"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"internal_passport":`)
+	entity.InternalPassport.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementInternalPassport) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "internal_passport":
+			entity.InternalPassport, err = decodeEntity[InputIdentityDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementInternalPassport)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputPassportElementInternalPassport) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("internal_passport", entity.InternalPassport); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A Telegram Passport element to be saved containing the user's address
+type InputPassportElementAddress struct {
+	meta
+	// The address to be saved
+	Address *Address `json:"address"`
+}
+
+func (*InputPassportElementAddress) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementAddress) GetConstructor() string {
+	return ConstructorInputPassportElementAddress
+}
+
+func (*InputPassportElementAddress) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementAddress
+}
+
+func (entity *InputPassportElementAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"address":`)
+	entity.Address.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "address":
+			entity.Address, err = decodeEntity[Address](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's utility bill
+type InputPassportElementUtilityBill struct {
+	meta
+	// The utility bill to be saved
+	UtilityBill *InputPersonalDocument `json:"utility_bill"`
+}
+
+func (*InputPassportElementUtilityBill) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementUtilityBill) GetConstructor() string {
+	return ConstructorInputPassportElementUtilityBill
+}
+
+func (*InputPassportElementUtilityBill) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementUtilityBill
+}
+
+func (entity *InputPassportElementUtilityBill) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementUtilityBill) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementUtilityBill) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementUtilityBill"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"utility_bill":`)
+	entity.UtilityBill.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementUtilityBill) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "utility_bill":
+			entity.UtilityBill, err = decodeEntity[InputPersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementUtilityBill)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's bank statement
+type InputPassportElementBankStatement struct {
+	meta
+	// The bank statement to be saved
+	BankStatement *InputPersonalDocument `json:"bank_statement"`
+}
+
+func (*InputPassportElementBankStatement) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementBankStatement) GetConstructor() string {
+	return ConstructorInputPassportElementBankStatement
+}
+
+func (*InputPassportElementBankStatement) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementBankStatement
+}
+
+func (entity *InputPassportElementBankStatement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementBankStatement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementBankStatement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementBankStatement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bank_statement":`)
+	entity.BankStatement.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementBankStatement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bank_statement":
+			entity.BankStatement, err = decodeEntity[InputPersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementBankStatement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's rental agreement
+type InputPassportElementRentalAgreement struct {
+	meta
+	// The rental agreement to be saved
+	RentalAgreement *InputPersonalDocument `json:"rental_agreement"`
+}
+
+func (*InputPassportElementRentalAgreement) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementRentalAgreement) GetConstructor() string {
+	return ConstructorInputPassportElementRentalAgreement
+}
+
+func (*InputPassportElementRentalAgreement) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementRentalAgreement
+}
+
+func (entity *InputPassportElementRentalAgreement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementRentalAgreement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementRentalAgreement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementRentalAgreement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"rental_agreement":`)
+	entity.RentalAgreement.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementRentalAgreement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "rental_agreement":
+			entity.RentalAgreement, err = decodeEntity[InputPersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementRentalAgreement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's passport registration
+type InputPassportElementPassportRegistration struct {
+	meta
+	// The passport registration page to be saved
+	PassportRegistration *InputPersonalDocument `json:"passport_registration"`
+}
+
+func (*InputPassportElementPassportRegistration) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementPassportRegistration) GetConstructor() string {
+	return ConstructorInputPassportElementPassportRegistration
+}
+
+func (*InputPassportElementPassportRegistration) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementPassportRegistration
+}
+
+func (entity *InputPassportElementPassportRegistration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementPassportRegistration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementPassportRegistration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementPassportRegistration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"passport_registration":`)
+	entity.PassportRegistration.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementPassportRegistration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "passport_registration":
+			entity.PassportRegistration, err = decodeEntity[InputPersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementPassportRegistration)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's temporary registration
+type InputPassportElementTemporaryRegistration struct {
+	meta
+	// The temporary registration document to be saved
+	TemporaryRegistration *InputPersonalDocument `json:"temporary_registration"`
+}
+
+func (*InputPassportElementTemporaryRegistration) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementTemporaryRegistration) GetConstructor() string {
+	return ConstructorInputPassportElementTemporaryRegistration
+}
+
+func (*InputPassportElementTemporaryRegistration) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementTemporaryRegistration
+}
+
+func (entity *InputPassportElementTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementTemporaryRegistration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementTemporaryRegistration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementTemporaryRegistration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"temporary_registration":`)
+	entity.TemporaryRegistration.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementTemporaryRegistration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "temporary_registration":
+			entity.TemporaryRegistration, err = decodeEntity[InputPersonalDocument](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementTemporaryRegistration)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's phone number
+type InputPassportElementPhoneNumber struct {
+	meta
+	// The phone number to be saved
+	PhoneNumber string `json:"phone_number"`
+}
+
+func (*InputPassportElementPhoneNumber) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementPhoneNumber) GetConstructor() string {
+	return ConstructorInputPassportElementPhoneNumber
+}
+
+func (*InputPassportElementPhoneNumber) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementPhoneNumber
+}
+
+func (entity *InputPassportElementPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementPhoneNumber)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Passport element to be saved containing the user's email address
+type InputPassportElementEmailAddress struct {
+	meta
+	// The email address to be saved
+	EmailAddress string `json:"email_address"`
+}
+
+func (*InputPassportElementEmailAddress) GetType() string {
+	return TypeInputPassportElement
+}
+
+func (*InputPassportElementEmailAddress) GetConstructor() string {
+	return ConstructorInputPassportElementEmailAddress
+}
+
+func (*InputPassportElementEmailAddress) InputPassportElementConstructor() string {
+	return ConstructorInputPassportElementEmailAddress
+}
+
+func (entity *InputPassportElementEmailAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementEmailAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementEmailAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementEmailAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"email_address":`)
+	encoder.writeString(entity.EmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementEmailAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "email_address":
+			entity.EmailAddress, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementEmailAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about saved Telegram Passport elements
+type PassportElements struct {
+	meta
+	// Telegram Passport elements
+	Elements []PassportElement `json:"elements"`
+}
+
+func (*PassportElements) GetType() string {
+	return TypePassportElements
+}
+
+func (*PassportElements) GetConstructor() string {
+	return ConstructorPassportElements
+}
+
+func (entity *PassportElements) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElements) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElements) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElements"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"elements":`)
+	writeList(encoder, entity.Elements, writeTypeValue[PassportElement])
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElements) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "elements":
+			entity.Elements, err = readList(decoder, decodePassportElement)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElements)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PassportElements) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Elements {
+		if err := validateNested(indexField("elements", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The element contains an error in an unspecified place. The error will be considered resolved when new data is added
+type PassportElementErrorSourceUnspecified struct {
+	meta
+}
+
+func (*PassportElementErrorSourceUnspecified) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceUnspecified) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceUnspecified
+}
+
+func (*PassportElementErrorSourceUnspecified) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceUnspecified
+}
+
+func (entity *PassportElementErrorSourceUnspecified) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceUnspecified) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceUnspecified) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceUnspecified"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceUnspecified) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceUnspecified)
+	})
+}
+
+// One of the data fields contains an error. The error will be considered resolved when the value of the field changes
+type PassportElementErrorSourceDataField struct {
+	meta
+	// Field name
+	FieldName string `json:"field_name"`
+}
+
+func (*PassportElementErrorSourceDataField) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceDataField) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceDataField
+}
+
+func (*PassportElementErrorSourceDataField) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceDataField
+}
+
+func (entity *PassportElementErrorSourceDataField) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceDataField) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceDataField) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceDataField"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"field_name":`)
+	encoder.writeString(entity.FieldName)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceDataField) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "field_name":
+			entity.FieldName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceDataField)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The front side of the document contains an error. The error will be considered resolved when the file with the front side changes
+type PassportElementErrorSourceFrontSide struct {
+	meta
+}
+
+func (*PassportElementErrorSourceFrontSide) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceFrontSide) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceFrontSide
+}
+
+func (*PassportElementErrorSourceFrontSide) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceFrontSide
+}
+
+func (entity *PassportElementErrorSourceFrontSide) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceFrontSide) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceFrontSide) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceFrontSide"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceFrontSide) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceFrontSide)
+	})
+}
+
+// The reverse side of the document contains an error. The error will be considered resolved when the file with the reverse side changes
+type PassportElementErrorSourceReverseSide struct {
+	meta
+}
+
+func (*PassportElementErrorSourceReverseSide) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceReverseSide) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceReverseSide
+}
+
+func (*PassportElementErrorSourceReverseSide) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceReverseSide
+}
+
+func (entity *PassportElementErrorSourceReverseSide) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceReverseSide) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceReverseSide) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceReverseSide"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceReverseSide) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceReverseSide)
+	})
+}
+
+// The selfie with the document contains an error. The error will be considered resolved when the file with the selfie changes
+type PassportElementErrorSourceSelfie struct {
+	meta
+}
+
+func (*PassportElementErrorSourceSelfie) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceSelfie) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceSelfie
+}
+
+func (*PassportElementErrorSourceSelfie) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceSelfie
+}
+
+func (entity *PassportElementErrorSourceSelfie) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceSelfie) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceSelfie) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceSelfie"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceSelfie) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceSelfie)
+	})
+}
+
+// One of files with the translation of the document contains an error. The error will be considered resolved when the file changes
+type PassportElementErrorSourceTranslationFile struct {
+	meta
+	// Index of a file with the error
+	FileIndex int32 `json:"file_index"`
+}
+
+func (*PassportElementErrorSourceTranslationFile) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceTranslationFile) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceTranslationFile
+}
+
+func (*PassportElementErrorSourceTranslationFile) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceTranslationFile
+}
+
+func (entity *PassportElementErrorSourceTranslationFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceTranslationFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceTranslationFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceTranslationFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_index":`)
+	encoder.writeInt32(entity.FileIndex)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceTranslationFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_index":
+			entity.FileIndex, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceTranslationFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The translation of the document contains an error. The error will be considered resolved when the list of translation files changes
+type PassportElementErrorSourceTranslationFiles struct {
+	meta
+}
+
+func (*PassportElementErrorSourceTranslationFiles) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceTranslationFiles) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceTranslationFiles
+}
+
+func (*PassportElementErrorSourceTranslationFiles) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceTranslationFiles
+}
+
+func (entity *PassportElementErrorSourceTranslationFiles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceTranslationFiles) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceTranslationFiles) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceTranslationFiles"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceTranslationFiles) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceTranslationFiles)
+	})
+}
+
+// The file contains an error. The error will be considered resolved when the file changes
+type PassportElementErrorSourceFile struct {
+	meta
+	// Index of a file with the error
+	FileIndex int32 `json:"file_index"`
+}
+
+func (*PassportElementErrorSourceFile) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceFile) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceFile
+}
+
+func (*PassportElementErrorSourceFile) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceFile
+}
+
+func (entity *PassportElementErrorSourceFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_index":`)
+	encoder.writeInt32(entity.FileIndex)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_index":
+			entity.FileIndex, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of attached files contains an error. The error will be considered resolved when the list of files changes
+type PassportElementErrorSourceFiles struct {
+	meta
+}
+
+func (*PassportElementErrorSourceFiles) GetType() string {
+	return TypePassportElementErrorSource
+}
+
+func (*PassportElementErrorSourceFiles) GetConstructor() string {
+	return ConstructorPassportElementErrorSourceFiles
+}
+
+func (*PassportElementErrorSourceFiles) PassportElementErrorSourceConstructor() string {
+	return ConstructorPassportElementErrorSourceFiles
+}
+
+func (entity *PassportElementErrorSourceFiles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementErrorSourceFiles) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementErrorSourceFiles) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementErrorSourceFiles"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementErrorSourceFiles) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPassportElementErrorSourceFiles)
+	})
+}
+
+// Contains the description of an error in a Telegram Passport element
+type PassportElementError struct {
+	meta
+	// Type of the Telegram Passport element which has the error
+	Type PassportElementType `json:"type"`
+	// Error message
+	Message string `json:"message"`
+	// Error source
+	Source PassportElementErrorSource `json:"source"`
+}
+
+func (*PassportElementError) GetType() string {
+	return TypePassportElementError
+}
+
+func (*PassportElementError) GetConstructor() string {
+	return ConstructorPassportElementError
+}
+
+func (entity *PassportElementError) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementError) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementError) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementError"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"message":`)
+	encoder.writeString(entity.Message)
+	encoder.writeRaw(`,"source":`)
+	encoder.writeType(entity.Source)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementError) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodePassportElementType(decoder)
+
+		case "message":
+			entity.Message, err = decoder.readString()
+
+		case "source":
+			entity.Source, err = decodePassportElementErrorSource(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementError)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a Telegram Passport element that was requested by a service
+type PassportSuitableElement struct {
+	meta
+	// Type of the element
+	Type PassportElementType `json:"type"`
+	// True, if a selfie is required with the identity document
+	IsSelfieRequired bool `json:"is_selfie_required"`
+	// True, if a certified English translation is required with the document
+	IsTranslationRequired bool `json:"is_translation_required"`
+	// True, if personal details must include the user's name in the language of their country of residence
+	IsNativeNameRequired bool `json:"is_native_name_required"`
+}
+
+func (*PassportSuitableElement) GetType() string {
+	return TypePassportSuitableElement
+}
+
+func (*PassportSuitableElement) GetConstructor() string {
+	return ConstructorPassportSuitableElement
+}
+
+func (entity *PassportSuitableElement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportSuitableElement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportSuitableElement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportSuitableElement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"is_selfie_required":`)
+	encoder.writeBool(entity.IsSelfieRequired)
+	encoder.writeRaw(`,"is_translation_required":`)
+	encoder.writeBool(entity.IsTranslationRequired)
+	encoder.writeRaw(`,"is_native_name_required":`)
+	encoder.writeBool(entity.IsNativeNameRequired)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportSuitableElement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodePassportElementType(decoder)
+
+		case "is_selfie_required":
+			entity.IsSelfieRequired, err = decoder.readBool()
+
+		case "is_translation_required":
+			entity.IsTranslationRequired, err = decoder.readBool()
+
+		case "is_native_name_required":
+			entity.IsNativeNameRequired, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportSuitableElement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a description of the required Telegram Passport element that was requested by a service
+type PassportRequiredElement struct {
+	meta
+	// List of Telegram Passport elements any of which is enough to provide
+	SuitableElements []*PassportSuitableElement `json:"suitable_elements"`
+}
+
+func (*PassportRequiredElement) GetType() string {
+	return TypePassportRequiredElement
+}
+
+func (*PassportRequiredElement) GetConstructor() string {
+	return ConstructorPassportRequiredElement
+}
+
+func (entity *PassportRequiredElement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportRequiredElement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportRequiredElement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportRequiredElement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"suitable_elements":`)
+	writeList(encoder, entity.SuitableElements, writeTypeValue[*PassportSuitableElement])
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportRequiredElement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "suitable_elements":
+			entity.SuitableElements, err = readList(decoder, decodeEntity[PassportSuitableElement])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportRequiredElement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a Telegram Passport authorization form that was requested
+type PassportAuthorizationForm struct {
+	meta
+	// Unique identifier of the authorization form
+	Id int32 `json:"id"`
+	// Telegram Passport elements that must be provided to complete the form
+	RequiredElements []*PassportRequiredElement `json:"required_elements"`
+	// URL for the privacy policy of the service; may be empty
+	PrivacyPolicyUrl string `json:"privacy_policy_url"`
+}
+
+func (*PassportAuthorizationForm) GetType() string {
+	return TypePassportAuthorizationForm
+}
+
+func (*PassportAuthorizationForm) GetConstructor() string {
+	return ConstructorPassportAuthorizationForm
+}
+
+func (entity *PassportAuthorizationForm) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportAuthorizationForm) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportAuthorizationForm) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportAuthorizationForm"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"required_elements":`)
+	writeList(encoder, entity.RequiredElements, writeTypeValue[*PassportRequiredElement])
+	encoder.writeRaw(`,"privacy_policy_url":`)
+	encoder.writeString(entity.PrivacyPolicyUrl)
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportAuthorizationForm) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "required_elements":
+			entity.RequiredElements, err = readList(decoder, decodeEntity[PassportRequiredElement])
+
+		case "privacy_policy_url":
+			entity.PrivacyPolicyUrl, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportAuthorizationForm)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a Telegram Passport elements and corresponding errors
+type PassportElementsWithErrors struct {
+	meta
+	// Telegram Passport elements
+	Elements []PassportElement `json:"elements"`
+	// Errors in the elements that are already available
+	Errors []*PassportElementError `json:"errors"`
+}
+
+func (*PassportElementsWithErrors) GetType() string {
+	return TypePassportElementsWithErrors
+}
+
+func (*PassportElementsWithErrors) GetConstructor() string {
+	return ConstructorPassportElementsWithErrors
+}
+
+func (entity *PassportElementsWithErrors) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PassportElementsWithErrors) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PassportElementsWithErrors) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"passportElementsWithErrors"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"elements":`)
+	writeList(encoder, entity.Elements, writeTypeValue[PassportElement])
+	encoder.writeRaw(`,"errors":`)
+	writeList(encoder, entity.Errors, writeTypeValue[*PassportElementError])
+	encoder.writeRaw("}")
+}
+
+func (entity *PassportElementsWithErrors) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "elements":
+			entity.Elements, err = readList(decoder, decodePassportElement)
+
+		case "errors":
+			entity.Errors, err = readList(decoder, decodeEntity[PassportElementError])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPassportElementsWithErrors)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PassportElementsWithErrors) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Elements {
+		if err := validateNested(indexField("elements", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains information about an encrypted Telegram Passport element; for bots only
+type EncryptedPassportElement struct {
+	meta
+	// Type of Telegram Passport element
+	Type PassportElementType `json:"type"`
+	// Encrypted JSON-encoded data about the user
+	Data []byte `json:"data"`
+	// The front side of an identity document
+	FrontSide *DatedFile `json:"front_side"`
+	// The reverse side of an identity document; may be null
+	ReverseSide *DatedFile `json:"reverse_side,omitempty"`
+	// Selfie with the document; may be null
+	Selfie *DatedFile `json:"selfie,omitempty"`
+	// List of files containing a certified English translation of the document
+	Translation []*DatedFile `json:"translation"`
+	// List of attached files
+	Files []*DatedFile `json:"files"`
+	// Unencrypted data, phone number or email address
+	Value string `json:"value"`
+	// Hash of the entire element
+	Hash string `json:"hash"`
+}
+
+func (*EncryptedPassportElement) GetType() string {
+	return TypeEncryptedPassportElement
+}
+
+func (*EncryptedPassportElement) GetConstructor() string {
+	return ConstructorEncryptedPassportElement
+}
+
+func (entity *EncryptedPassportElement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EncryptedPassportElement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EncryptedPassportElement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"encryptedPassportElement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw(`,"front_side":`)
+	entity.FrontSide.encodeJSON(encoder)
+	if entity.ReverseSide != nil {
+		encoder.writeRaw(`,"reverse_side":`)
+		entity.ReverseSide.encodeJSON(encoder)
+	}
+	if entity.Selfie != nil {
+		encoder.writeRaw(`,"selfie":`)
+		entity.Selfie.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"translation":`)
+	writeList(encoder, entity.Translation, writeTypeValue[*DatedFile])
+	encoder.writeRaw(`,"files":`)
+	writeList(encoder, entity.Files, writeTypeValue[*DatedFile])
+	encoder.writeRaw(`,"value":`)
+	encoder.writeString(entity.Value)
+	encoder.writeRaw(`,"hash":`)
+	encoder.writeString(entity.Hash)
+	encoder.writeRaw("}")
+}
+
+func (entity *EncryptedPassportElement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodePassportElementType(decoder)
+
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		case "front_side":
+			entity.FrontSide, err = decodeEntity[DatedFile](decoder)
+
+		case "reverse_side":
+			entity.ReverseSide, err = decodeEntity[DatedFile](decoder)
+
+		case "selfie":
+			entity.Selfie, err = decodeEntity[DatedFile](decoder)
+
+		case "translation":
+			entity.Translation, err = readList(decoder, decodeEntity[DatedFile])
+
+		case "files":
+			entity.Files, err = readList(decoder, decodeEntity[DatedFile])
+
+		case "value":
+			entity.Value, err = decoder.readString()
+
+		case "hash":
+			entity.Hash, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEncryptedPassportElement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The element contains an error in an unspecified place. The error will be considered resolved when new data is added
+type InputPassportElementErrorSourceUnspecified struct {
+	meta
+	// Current hash of the entire element
+	ElementHash []byte `json:"element_hash"`
+}
+
+func (*InputPassportElementErrorSourceUnspecified) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceUnspecified) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceUnspecified
+}
+
+func (*InputPassportElementErrorSourceUnspecified) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceUnspecified
+}
+
+func (entity *InputPassportElementErrorSourceUnspecified) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceUnspecified) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceUnspecified) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceUnspecified"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"element_hash":`)
+	encoder.writeBytes(entity.ElementHash)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceUnspecified) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "element_hash":
+			entity.ElementHash, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceUnspecified)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A data field contains an error. The error is considered resolved when the field's value changes
+type InputPassportElementErrorSourceDataField struct {
+	meta
+	// Field name
+	FieldName string `json:"field_name"`
+	// Current data hash
+	DataHash []byte `json:"data_hash"`
+}
+
+func (*InputPassportElementErrorSourceDataField) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceDataField) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceDataField
+}
+
+func (*InputPassportElementErrorSourceDataField) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceDataField
+}
+
+func (entity *InputPassportElementErrorSourceDataField) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceDataField) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceDataField) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceDataField"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"field_name":`)
+	encoder.writeString(entity.FieldName)
+	encoder.writeRaw(`,"data_hash":`)
+	encoder.writeBytes(entity.DataHash)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceDataField) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "field_name":
+			entity.FieldName, err = decoder.readString()
+
+		case "data_hash":
+			entity.DataHash, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceDataField)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The front side of the document contains an error. The error is considered resolved when the file with the front side of the document changes
+type InputPassportElementErrorSourceFrontSide struct {
+	meta
+	// Current hash of the file containing the front side
+	FileHash []byte `json:"file_hash"`
+}
+
+func (*InputPassportElementErrorSourceFrontSide) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceFrontSide) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceFrontSide
+}
+
+func (*InputPassportElementErrorSourceFrontSide) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceFrontSide
+}
+
+func (entity *InputPassportElementErrorSourceFrontSide) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceFrontSide) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceFrontSide) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceFrontSide"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_hash":`)
+	encoder.writeBytes(entity.FileHash)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceFrontSide) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_hash":
+			entity.FileHash, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceFrontSide)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The reverse side of the document contains an error. The error is considered resolved when the file with the reverse side of the document changes
+type InputPassportElementErrorSourceReverseSide struct {
+	meta
+	// Current hash of the file containing the reverse side
+	FileHash []byte `json:"file_hash"`
+}
+
+func (*InputPassportElementErrorSourceReverseSide) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceReverseSide) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceReverseSide
+}
+
+func (*InputPassportElementErrorSourceReverseSide) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceReverseSide
+}
+
+func (entity *InputPassportElementErrorSourceReverseSide) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceReverseSide) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceReverseSide) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceReverseSide"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_hash":`)
+	encoder.writeBytes(entity.FileHash)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceReverseSide) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_hash":
+			entity.FileHash, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceReverseSide)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The selfie contains an error. The error is considered resolved when the file with the selfie changes
+type InputPassportElementErrorSourceSelfie struct {
+	meta
+	// Current hash of the file containing the selfie
+	FileHash []byte `json:"file_hash"`
+}
+
+func (*InputPassportElementErrorSourceSelfie) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceSelfie) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceSelfie
+}
+
+func (*InputPassportElementErrorSourceSelfie) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceSelfie
+}
+
+func (entity *InputPassportElementErrorSourceSelfie) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceSelfie) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceSelfie) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceSelfie"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_hash":`)
+	encoder.writeBytes(entity.FileHash)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceSelfie) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_hash":
+			entity.FileHash, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceSelfie)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// One of the files containing the translation of the document contains an error. The error is considered resolved when the file with the translation changes
+type InputPassportElementErrorSourceTranslationFile struct {
+	meta
+	// Current hash of the file containing the translation
+	FileHash []byte `json:"file_hash"`
+}
+
+func (*InputPassportElementErrorSourceTranslationFile) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceTranslationFile) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceTranslationFile
+}
+
+func (*InputPassportElementErrorSourceTranslationFile) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceTranslationFile
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceTranslationFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_hash":`)
+	encoder.writeBytes(entity.FileHash)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_hash":
+			entity.FileHash, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceTranslationFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The translation of the document contains an error. The error is considered resolved when the list of files changes
+type InputPassportElementErrorSourceTranslationFiles struct {
+	meta
+	// Current hashes of all files with the translation
+	FileHashes [][]byte `json:"file_hashes"`
+}
+
+func (*InputPassportElementErrorSourceTranslationFiles) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceTranslationFiles) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceTranslationFiles
+}
+
+func (*InputPassportElementErrorSourceTranslationFiles) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceTranslationFiles
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFiles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFiles) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFiles) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceTranslationFiles"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_hashes":`)
+	writeList(encoder, entity.FileHashes, (*jsonEncoder).writeBytes)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceTranslationFiles) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_hashes":
+			entity.FileHashes, err = readList(decoder, (*jsonDecoder).readBytes)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceTranslationFiles)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The file contains an error. The error is considered resolved when the file changes
+type InputPassportElementErrorSourceFile struct {
+	meta
+	// Current hash of the file which has the error
+	FileHash []byte `json:"file_hash"`
+}
+
+func (*InputPassportElementErrorSourceFile) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceFile) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceFile
+}
+
+func (*InputPassportElementErrorSourceFile) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceFile
+}
+
+func (entity *InputPassportElementErrorSourceFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_hash":`)
+	encoder.writeBytes(entity.FileHash)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_hash":
+			entity.FileHash, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of attached files contains an error. The error is considered resolved when the file list changes
+type InputPassportElementErrorSourceFiles struct {
+	meta
+	// Current hashes of all attached files
+	FileHashes [][]byte `json:"file_hashes"`
+}
+
+func (*InputPassportElementErrorSourceFiles) GetType() string {
+	return TypeInputPassportElementErrorSource
+}
+
+func (*InputPassportElementErrorSourceFiles) GetConstructor() string {
+	return ConstructorInputPassportElementErrorSourceFiles
+}
+
+func (*InputPassportElementErrorSourceFiles) InputPassportElementErrorSourceConstructor() string {
+	return ConstructorInputPassportElementErrorSourceFiles
+}
+
+func (entity *InputPassportElementErrorSourceFiles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementErrorSourceFiles) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementErrorSourceFiles) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementErrorSourceFiles"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_hashes":`)
+	writeList(encoder, entity.FileHashes, (*jsonEncoder).writeBytes)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementErrorSourceFiles) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_hashes":
+			entity.FileHashes, err = readList(decoder, (*jsonDecoder).readBytes)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementErrorSourceFiles)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains the description of an error in a Telegram Passport element; for bots only
+type InputPassportElementError struct {
+	meta
+	// Type of Telegram Passport element that has the error
+	Type PassportElementType `json:"type"`
+	// Error message
+	Message string `json:"message"`
+	// Error source
+	Source InputPassportElementErrorSource `json:"source"`
+}
+
+func (*InputPassportElementError) GetType() string {
+	return TypeInputPassportElementError
+}
+
+func (*InputPassportElementError) GetConstructor() string {
+	return ConstructorInputPassportElementError
+}
+
+func (entity *InputPassportElementError) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPassportElementError) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPassportElementError) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPassportElementError"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"message":`)
+	encoder.writeString(entity.Message)
+	encoder.writeRaw(`,"source":`)
+	encoder.writeType(entity.Source)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPassportElementError) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodePassportElementType(decoder)
+
+		case "message":
+			entity.Message, err = decoder.readString()
+
+		case "source":
+			entity.Source, err = decodeInputPassportElementErrorSource(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPassportElementError)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Settings for Firebase Authentication in the official Android application
+type FirebaseAuthenticationSettingsAndroid struct {
+	meta
+}
+
+func (*FirebaseAuthenticationSettingsAndroid) GetType() string {
+	return TypeFirebaseAuthenticationSettings
+}
+
+func (*FirebaseAuthenticationSettingsAndroid) GetConstructor() string {
+	return ConstructorFirebaseAuthenticationSettingsAndroid
+}
+
+func (*FirebaseAuthenticationSettingsAndroid) FirebaseAuthenticationSettingsConstructor() string {
+	return ConstructorFirebaseAuthenticationSettingsAndroid
+}
+
+func (entity *FirebaseAuthenticationSettingsAndroid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FirebaseAuthenticationSettingsAndroid) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FirebaseAuthenticationSettingsAndroid) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"firebaseAuthenticationSettingsAndroid"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FirebaseAuthenticationSettingsAndroid) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFirebaseAuthenticationSettingsAndroid)
+	})
+}
+
+// Settings for Firebase Authentication in the official iOS application
+type FirebaseAuthenticationSettingsIos struct {
+	meta
+	// Device token from Apple Push Notification service
+	DeviceToken string `json:"device_token"`
+	// True, if App Sandbox is enabled
+	IsAppSandbox bool `json:"is_app_sandbox"`
+}
+
+func (*FirebaseAuthenticationSettingsIos) GetType() string {
+	return TypeFirebaseAuthenticationSettings
+}
+
+func (*FirebaseAuthenticationSettingsIos) GetConstructor() string {
+	return ConstructorFirebaseAuthenticationSettingsIos
+}
+
+func (*FirebaseAuthenticationSettingsIos) FirebaseAuthenticationSettingsConstructor() string {
+	return ConstructorFirebaseAuthenticationSettingsIos
+}
+
+func (entity *FirebaseAuthenticationSettingsIos) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FirebaseAuthenticationSettingsIos) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FirebaseAuthenticationSettingsIos) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"firebaseAuthenticationSettingsIos"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"device_token":`)
+	encoder.writeString(entity.DeviceToken)
+	encoder.writeRaw(`,"is_app_sandbox":`)
+	encoder.writeBool(entity.IsAppSandbox)
+	encoder.writeRaw("}")
+}
+
+func (entity *FirebaseAuthenticationSettingsIos) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "device_token":
+			entity.DeviceToken, err = decoder.readString()
+
+		case "is_app_sandbox":
+			entity.IsAppSandbox, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFirebaseAuthenticationSettingsIos)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains settings for the authentication of the user's phone number
+type PhoneNumberAuthenticationSettings struct {
+	meta
+	// Pass true if the authentication code may be sent via a flash call to the specified phone number
+	AllowFlashCall bool `json:"allow_flash_call"`
+	// Pass true if the authentication code may be sent via a missed call to the specified phone number
+	AllowMissedCall bool `json:"allow_missed_call"`
+	// Pass true if the authenticated phone number is used on the current device
+	IsCurrentPhoneNumber bool `json:"is_current_phone_number"`
+	// Pass true if there is a SIM card in the current device, but it is not possible to check whether phone number matches
+	HasUnknownPhoneNumber bool `json:"has_unknown_phone_number"`
+	// For official applications only. True, if the application can use Android SMS Retriever API (requires Google Play Services >= 10.2) to automatically receive the authentication code from the SMS. See https://developers.google.com/identity/sms-retriever/ for more details
+	AllowSmsRetrieverApi bool `json:"allow_sms_retriever_api"`
+	// For official Android and iOS applications only; pass null otherwise. Settings for Firebase Authentication
+	FirebaseAuthenticationSettings FirebaseAuthenticationSettings `json:"firebase_authentication_settings,omitempty"`
+	// List of up to 20 authentication tokens, recently received in updateOption("authentication_token") in previously logged out sessions
+	AuthenticationTokens []string `json:"authentication_tokens"`
+}
+
+func (*PhoneNumberAuthenticationSettings) GetType() string {
+	return TypePhoneNumberAuthenticationSettings
+}
+
+func (*PhoneNumberAuthenticationSettings) GetConstructor() string {
+	return ConstructorPhoneNumberAuthenticationSettings
+}
+
+func (entity *PhoneNumberAuthenticationSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PhoneNumberAuthenticationSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PhoneNumberAuthenticationSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"phoneNumberAuthenticationSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"allow_flash_call":`)
+	encoder.writeBool(entity.AllowFlashCall)
+	encoder.writeRaw(`,"allow_missed_call":`)
+	encoder.writeBool(entity.AllowMissedCall)
+	encoder.writeRaw(`,"is_current_phone_number":`)
+	encoder.writeBool(entity.IsCurrentPhoneNumber)
+	encoder.writeRaw(`,"has_unknown_phone_number":`)
+	encoder.writeBool(entity.HasUnknownPhoneNumber)
+	encoder.writeRaw(`,"allow_sms_retriever_api":`)
+	encoder.writeBool(entity.AllowSmsRetrieverApi)
+	if entity.FirebaseAuthenticationSettings != nil {
+		encoder.writeRaw(`,"firebase_authentication_settings":`)
+		encoder.writeType(entity.FirebaseAuthenticationSettings)
+	}
+	encoder.writeRaw(`,"authentication_tokens":`)
+	writeList(encoder, entity.AuthenticationTokens, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *PhoneNumberAuthenticationSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "allow_flash_call":
+			entity.AllowFlashCall, err = decoder.readBool()
+
+		case "allow_missed_call":
+			entity.AllowMissedCall, err = decoder.readBool()
+
+		case "is_current_phone_number":
+			entity.IsCurrentPhoneNumber, err = decoder.readBool()
+
+		case "has_unknown_phone_number":
+			entity.HasUnknownPhoneNumber, err = decoder.readBool()
+
+		case "allow_sms_retriever_api":
+			entity.AllowSmsRetrieverApi, err = decoder.readBool()
+
+		case "firebase_authentication_settings":
+			entity.FirebaseAuthenticationSettings, err = decodeFirebaseAuthenticationSettings(decoder)
+
+		case "authentication_tokens":
+			entity.AuthenticationTokens, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPhoneNumberAuthenticationSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The password was reset
+type ResetPasswordResultOk struct {
+	meta
+}
+
+func (*ResetPasswordResultOk) GetType() string {
+	return TypeResetPasswordResult
+}
+
+func (*ResetPasswordResultOk) GetConstructor() string {
+	return ConstructorResetPasswordResultOk
+}
+
+func (*ResetPasswordResultOk) ResetPasswordResultConstructor() string {
+	return ConstructorResetPasswordResultOk
+}
+
+func (entity *ResetPasswordResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ResetPasswordResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ResetPasswordResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetPasswordResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ResetPasswordResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorResetPasswordResultOk)
+	})
+}
+
+// The password reset request is pending
+type ResetPasswordResultPending struct {
+	meta
+	// Point in time (Unix timestamp) after which the password can be reset immediately using resetPassword
+	PendingResetDate int32 `json:"pending_reset_date"`
+}
+
+func (*ResetPasswordResultPending) GetType() string {
+	return TypeResetPasswordResult
+}
+
+func (*ResetPasswordResultPending) GetConstructor() string {
+	return ConstructorResetPasswordResultPending
+}
+
+func (*ResetPasswordResultPending) ResetPasswordResultConstructor() string {
+	return ConstructorResetPasswordResultPending
+}
+
+func (entity *ResetPasswordResultPending) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ResetPasswordResultPending) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ResetPasswordResultPending) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetPasswordResultPending"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"pending_reset_date":`)
+	encoder.writeInt32(entity.PendingResetDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ResetPasswordResultPending) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "pending_reset_date":
+			entity.PendingResetDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorResetPasswordResultPending)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The password reset request was declined
+type ResetPasswordResultDeclined struct {
+	meta
+	// Point in time (Unix timestamp) when the password reset can be retried
+	RetryDate int32 `json:"retry_date"`
+}
+
+func (*ResetPasswordResultDeclined) GetType() string {
+	return TypeResetPasswordResult
+}
+
+func (*ResetPasswordResultDeclined) GetConstructor() string {
+	return ConstructorResetPasswordResultDeclined
+}
+
+func (*ResetPasswordResultDeclined) ResetPasswordResultConstructor() string {
+	return ConstructorResetPasswordResultDeclined
+}
+
+func (entity *ResetPasswordResultDeclined) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ResetPasswordResultDeclined) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ResetPasswordResultDeclined) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resetPasswordResultDeclined"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"retry_date":`)
+	encoder.writeInt32(entity.RetryDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ResetPasswordResultDeclined) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "retry_date":
+			entity.RetryDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorResetPasswordResultDeclined)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The session is running on an Android device
+type SessionTypeAndroid struct {
+	meta
+}
+
+func (*SessionTypeAndroid) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeAndroid) GetConstructor() string {
+	return ConstructorSessionTypeAndroid
+}
+
+func (*SessionTypeAndroid) SessionTypeConstructor() string {
+	return ConstructorSessionTypeAndroid
+}
+
+func (entity *SessionTypeAndroid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeAndroid) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeAndroid) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeAndroid"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeAndroid) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeAndroid)
+	})
+}
+
+// The session is running on a generic Apple device
+type SessionTypeApple struct {
+	meta
+}
+
+func (*SessionTypeApple) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeApple) GetConstructor() string {
+	return ConstructorSessionTypeApple
+}
+
+func (*SessionTypeApple) SessionTypeConstructor() string {
+	return ConstructorSessionTypeApple
+}
+
+func (entity *SessionTypeApple) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeApple) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeApple) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeApple"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeApple) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeApple)
+	})
+}
+
+// The session is running on the Brave browser
+type SessionTypeBrave struct {
+	meta
+}
+
+func (*SessionTypeBrave) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeBrave) GetConstructor() string {
+	return ConstructorSessionTypeBrave
+}
+
+func (*SessionTypeBrave) SessionTypeConstructor() string {
+	return ConstructorSessionTypeBrave
+}
+
+func (entity *SessionTypeBrave) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeBrave) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeBrave) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeBrave"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeBrave) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeBrave)
+	})
+}
+
+// The session is running on the Chrome browser
+type SessionTypeChrome struct {
+	meta
+}
+
+func (*SessionTypeChrome) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeChrome) GetConstructor() string {
+	return ConstructorSessionTypeChrome
+}
+
+func (*SessionTypeChrome) SessionTypeConstructor() string {
+	return ConstructorSessionTypeChrome
+}
+
+func (entity *SessionTypeChrome) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeChrome) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeChrome) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeChrome"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeChrome) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeChrome)
+	})
+}
+
+// The session is running on the Edge browser
+type SessionTypeEdge struct {
+	meta
+}
+
+func (*SessionTypeEdge) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeEdge) GetConstructor() string {
+	return ConstructorSessionTypeEdge
+}
+
+func (*SessionTypeEdge) SessionTypeConstructor() string {
+	return ConstructorSessionTypeEdge
+}
+
+func (entity *SessionTypeEdge) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeEdge) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeEdge) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeEdge"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeEdge) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeEdge)
+	})
+}
+
+// The session is running on the Firefox browser
+type SessionTypeFirefox struct {
+	meta
+}
+
+func (*SessionTypeFirefox) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeFirefox) GetConstructor() string {
+	return ConstructorSessionTypeFirefox
+}
+
+func (*SessionTypeFirefox) SessionTypeConstructor() string {
+	return ConstructorSessionTypeFirefox
+}
+
+func (entity *SessionTypeFirefox) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeFirefox) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeFirefox) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeFirefox"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeFirefox) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeFirefox)
+	})
+}
+
+// The session is running on an iPad device
+type SessionTypeIpad struct {
+	meta
+}
+
+func (*SessionTypeIpad) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeIpad) GetConstructor() string {
+	return ConstructorSessionTypeIpad
+}
+
+func (*SessionTypeIpad) SessionTypeConstructor() string {
+	return ConstructorSessionTypeIpad
+}
+
+func (entity *SessionTypeIpad) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeIpad) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeIpad) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeIpad"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeIpad) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeIpad)
+	})
+}
+
+// The session is running on an iPhone device
+type SessionTypeIphone struct {
+	meta
+}
+
+func (*SessionTypeIphone) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeIphone) GetConstructor() string {
+	return ConstructorSessionTypeIphone
+}
+
+func (*SessionTypeIphone) SessionTypeConstructor() string {
+	return ConstructorSessionTypeIphone
+}
+
+func (entity *SessionTypeIphone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeIphone) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeIphone) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeIphone"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeIphone) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeIphone)
+	})
+}
+
+// The session is running on a Linux device
+type SessionTypeLinux struct {
+	meta
+}
+
+func (*SessionTypeLinux) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeLinux) GetConstructor() string {
+	return ConstructorSessionTypeLinux
+}
+
+func (*SessionTypeLinux) SessionTypeConstructor() string {
+	return ConstructorSessionTypeLinux
+}
+
+func (entity *SessionTypeLinux) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeLinux) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeLinux) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeLinux"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeLinux) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeLinux)
+	})
+}
+
+// The session is running on a Mac device
+type SessionTypeMac struct {
+	meta
+}
+
+func (*SessionTypeMac) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeMac) GetConstructor() string {
+	return ConstructorSessionTypeMac
+}
+
+func (*SessionTypeMac) SessionTypeConstructor() string {
+	return ConstructorSessionTypeMac
+}
+
+func (entity *SessionTypeMac) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeMac) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeMac) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeMac"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeMac) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeMac)
+	})
+}
+
+// The session is running on the Opera browser
+type SessionTypeOpera struct {
+	meta
+}
+
+func (*SessionTypeOpera) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeOpera) GetConstructor() string {
+	return ConstructorSessionTypeOpera
+}
+
+func (*SessionTypeOpera) SessionTypeConstructor() string {
+	return ConstructorSessionTypeOpera
+}
+
+func (entity *SessionTypeOpera) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeOpera) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeOpera) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeOpera"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeOpera) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeOpera)
+	})
+}
+
+// The session is running on the Safari browser
+type SessionTypeSafari struct {
+	meta
+}
+
+func (*SessionTypeSafari) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeSafari) GetConstructor() string {
+	return ConstructorSessionTypeSafari
+}
+
+func (*SessionTypeSafari) SessionTypeConstructor() string {
+	return ConstructorSessionTypeSafari
+}
+
+func (entity *SessionTypeSafari) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeSafari) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeSafari) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeSafari"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeSafari) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeSafari)
+	})
+}
+
+// The session is running on an Ubuntu device
+type SessionTypeUbuntu struct {
+	meta
+}
+
+func (*SessionTypeUbuntu) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeUbuntu) GetConstructor() string {
+	return ConstructorSessionTypeUbuntu
+}
+
+func (*SessionTypeUbuntu) SessionTypeConstructor() string {
+	return ConstructorSessionTypeUbuntu
+}
+
+func (entity *SessionTypeUbuntu) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeUbuntu) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeUbuntu) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeUbuntu"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeUbuntu) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeUbuntu)
+	})
+}
+
+// The session is running on an unknown type of device
+type SessionTypeUnknown struct {
+	meta
+}
+
+func (*SessionTypeUnknown) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeUnknown) GetConstructor() string {
+	return ConstructorSessionTypeUnknown
+}
+
+func (*SessionTypeUnknown) SessionTypeConstructor() string {
+	return ConstructorSessionTypeUnknown
+}
+
+func (entity *SessionTypeUnknown) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeUnknown) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeUnknown) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeUnknown"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeUnknown) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeUnknown)
+	})
+}
+
+// The session is running on the Vivaldi browser
+type SessionTypeVivaldi struct {
+	meta
+}
+
+func (*SessionTypeVivaldi) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeVivaldi) GetConstructor() string {
+	return ConstructorSessionTypeVivaldi
+}
+
+func (*SessionTypeVivaldi) SessionTypeConstructor() string {
+	return ConstructorSessionTypeVivaldi
+}
+
+func (entity *SessionTypeVivaldi) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeVivaldi) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeVivaldi) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeVivaldi"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeVivaldi) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeVivaldi)
+	})
+}
+
+// The session is running on a Windows device
+type SessionTypeWindows struct {
+	meta
+}
+
+func (*SessionTypeWindows) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeWindows) GetConstructor() string {
+	return ConstructorSessionTypeWindows
+}
+
+func (*SessionTypeWindows) SessionTypeConstructor() string {
+	return ConstructorSessionTypeWindows
+}
+
+func (entity *SessionTypeWindows) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeWindows) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeWindows) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeWindows"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeWindows) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeWindows)
+	})
+}
+
+// The session is running on an Xbox console
+type SessionTypeXbox struct {
+	meta
+}
+
+func (*SessionTypeXbox) GetType() string {
+	return TypeSessionType
+}
+
+func (*SessionTypeXbox) GetConstructor() string {
+	return ConstructorSessionTypeXbox
+}
+
+func (*SessionTypeXbox) SessionTypeConstructor() string {
+	return ConstructorSessionTypeXbox
+}
+
+func (entity *SessionTypeXbox) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SessionTypeXbox) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SessionTypeXbox) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessionTypeXbox"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SessionTypeXbox) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSessionTypeXbox)
+	})
+}
+
+// Contains information about one session in a Telegram application used by the current user. Sessions must be shown to the user in the returned order
+type Session struct {
+	meta
+	// Session identifier
+	Id JsonInt64 `json:"id"`
+	// True, if this session is the current session
+	IsCurrent bool `json:"is_current"`
+	// True, if a 2-step verification password is needed to complete authorization of the session
+	IsPasswordPending bool `json:"is_password_pending"`
+	// True, if the session wasn't confirmed from another session
+	IsUnconfirmed bool `json:"is_unconfirmed"`
+	// True, if incoming secret chats can be accepted by the session
+	CanAcceptSecretChats bool `json:"can_accept_secret_chats"`
+	// True, if incoming calls can be accepted by the session
+	CanAcceptCalls bool `json:"can_accept_calls"`
+	// Session type based on the system and application version, which can be used to display a corresponding icon
+	Type SessionType `json:"type"`
+	// Telegram API identifier, as provided by the application
+	ApiId int32 `json:"api_id"`
+	// Name of the application, as provided by the application
+	ApplicationName string `json:"application_name"`
+	// The version of the application, as provided by the application
+	ApplicationVersion string `json:"application_version"`
+	// True, if the application is an official application or uses the api_id of an official application
+	IsOfficialApplication bool `json:"is_official_application"`
+	// Model of the device the application has been run or is running on, as provided by the application
+	DeviceModel string `json:"device_model"`
+	// Operating system the application has been run or is running on, as provided by the application
+	Platform string `json:"platform"`
+	// Version of the operating system the application has been run or is running on, as provided by the application
+	SystemVersion string `json:"system_version"`
+	// Point in time (Unix timestamp) when the user has logged in
+	LogInDate int32 `json:"log_in_date"`
+	// Point in time (Unix timestamp) when the session was last used
+	LastActiveDate int32 `json:"last_active_date"`
+	// IP address from which the session was created, in human-readable format
+	IpAddress string `json:"ip_address"`
+	// A human-readable description of the location from which the session was created, based on the IP address
+	Location string `json:"location"`
+}
+
+func (*Session) GetType() string {
+	return TypeSession
+}
+
+func (*Session) GetConstructor() string {
+	return ConstructorSession
+}
+
+func (entity *Session) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Session) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Session) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"session"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"is_current":`)
+	encoder.writeBool(entity.IsCurrent)
+	encoder.writeRaw(`,"is_password_pending":`)
+	encoder.writeBool(entity.IsPasswordPending)
+	encoder.writeRaw(`,"is_unconfirmed":`)
+	encoder.writeBool(entity.IsUnconfirmed)
+	encoder.writeRaw(`,"can_accept_secret_chats":`)
+	encoder.writeBool(entity.CanAcceptSecretChats)
+	encoder.writeRaw(`,"can_accept_calls":`)
+	encoder.writeBool(entity.CanAcceptCalls)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"api_id":`)
+	encoder.writeInt32(entity.ApiId)
+	encoder.writeRaw(`,"application_name":`)
+	encoder.writeString(entity.ApplicationName)
+	encoder.writeRaw(`,"application_version":`)
+	encoder.writeString(entity.ApplicationVersion)
+	encoder.writeRaw(`,"is_official_application":`)
+	encoder.writeBool(entity.IsOfficialApplication)
+	encoder.writeRaw(`,"device_model":`)
+	encoder.writeString(entity.DeviceModel)
+	encoder.writeRaw(`,"platform":`)
+	encoder.writeString(entity.Platform)
+	encoder.writeRaw(`,"system_version":`)
+	encoder.writeString(entity.SystemVersion)
+	encoder.writeRaw(`,"log_in_date":`)
+	encoder.writeInt32(entity.LogInDate)
+	encoder.writeRaw(`,"last_active_date":`)
+	encoder.writeInt32(entity.LastActiveDate)
+	encoder.writeRaw(`,"ip_address":`)
+	encoder.writeString(entity.IpAddress)
+	encoder.writeRaw(`,"location":`)
+	encoder.writeString(entity.Location)
+	encoder.writeRaw("}")
+}
+
+func (entity *Session) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "is_current":
+			entity.IsCurrent, err = decoder.readBool()
+
+		case "is_password_pending":
+			entity.IsPasswordPending, err = decoder.readBool()
+
+		case "is_unconfirmed":
+			entity.IsUnconfirmed, err = decoder.readBool()
+
+		case "can_accept_secret_chats":
+			entity.CanAcceptSecretChats, err = decoder.readBool()
+
+		case "can_accept_calls":
+			entity.CanAcceptCalls, err = decoder.readBool()
+
+		case "type":
+			entity.Type, err = decodeSessionType(decoder)
+
+		case "api_id":
+			entity.ApiId, err = decoder.readInt32()
+
+		case "application_name":
+			entity.ApplicationName, err = decoder.readString()
+
+		case "application_version":
+			entity.ApplicationVersion, err = decoder.readString()
+
+		case "is_official_application":
+			entity.IsOfficialApplication, err = decoder.readBool()
+
+		case "device_model":
+			entity.DeviceModel, err = decoder.readString()
+
+		case "platform":
+			entity.Platform, err = decoder.readString()
+
+		case "system_version":
+			entity.SystemVersion, err = decoder.readString()
+
+		case "log_in_date":
+			entity.LogInDate, err = decoder.readInt32()
+
+		case "last_active_date":
+			entity.LastActiveDate, err = decoder.readInt32()
+
+		case "ip_address":
+			entity.IpAddress, err = decoder.readString()
+
+		case "location":
+			entity.Location, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSession)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of sessions
+type Sessions struct {
+	meta
+	// List of sessions
+	Sessions []*Session `json:"sessions"`
+	// Number of days of inactivity before sessions will automatically be terminated; 1-366 days
+	InactiveSessionTtlDays int32 `json:"inactive_session_ttl_days"`
+}
+
+func (*Sessions) GetType() string {
+	return TypeSessions
+}
+
+func (*Sessions) GetConstructor() string {
+	return ConstructorSessions
+}
+
+func (entity *Sessions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Sessions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Sessions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sessions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sessions":`)
+	writeList(encoder, entity.Sessions, writeTypeValue[*Session])
+	encoder.writeRaw(`,"inactive_session_ttl_days":`)
+	encoder.writeInt32(entity.InactiveSessionTtlDays)
+	encoder.writeRaw("}")
+}
+
+func (entity *Sessions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sessions":
+			entity.Sessions, err = readList(decoder, decodeEntity[Session])
+
+		case "inactive_session_ttl_days":
+			entity.InactiveSessionTtlDays, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSessions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an unconfirmed session
+type UnconfirmedSession struct {
+	meta
+	// Session identifier
+	Id JsonInt64 `json:"id"`
+	// Point in time (Unix timestamp) when the user has logged in
+	LogInDate int32 `json:"log_in_date"`
+	// Model of the device that was used for the session creation, as provided by the application
+	DeviceModel string `json:"device_model"`
+	// A human-readable description of the location from which the session was created, based on the IP address
+	Location string `json:"location"`
+}
+
+func (*UnconfirmedSession) GetType() string {
+	return TypeUnconfirmedSession
+}
+
+func (*UnconfirmedSession) GetConstructor() string {
+	return ConstructorUnconfirmedSession
+}
+
+func (entity *UnconfirmedSession) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UnconfirmedSession) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UnconfirmedSession) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"unconfirmedSession"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"log_in_date":`)
+	encoder.writeInt32(entity.LogInDate)
+	encoder.writeRaw(`,"device_model":`)
+	encoder.writeString(entity.DeviceModel)
+	encoder.writeRaw(`,"location":`)
+	encoder.writeString(entity.Location)
+	encoder.writeRaw("}")
+}
+
+func (entity *UnconfirmedSession) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "log_in_date":
+			entity.LogInDate, err = decoder.readInt32()
+
+		case "device_model":
+			entity.DeviceModel, err = decoder.readString()
+
+		case "location":
+			entity.Location, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUnconfirmedSession)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,5437 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeWebAppOpenMode               = "WebAppOpenMode"
+	TypeBotWriteAccessAllowReason    = "BotWriteAccessAllowReason"
+	TypeInputInlineQueryResult       = "InputInlineQueryResult"
+	TypeInlineQueryResult            = "InlineQueryResult"
+	TypeInlineQueryResultsButtonType = "InlineQueryResultsButtonType"
+	TypeCallbackQueryPayload         = "CallbackQueryPayload"
+	TypeBotCommandScope              = "BotCommandScope"
+	TypeGame                         = "Game"
+	TypeWebApp                       = "WebApp"
+	TypeBotCommand                   = "BotCommand"
+	TypeBotCommands                  = "BotCommands"
+	TypeBotMenuButton                = "BotMenuButton"
+	TypeBotVerificationParameters    = "BotVerificationParameters"
+	TypeBotVerification              = "BotVerification"
+	TypeBotInfo                      = "BotInfo"
+	TypeFoundWebApp                  = "FoundWebApp"
+	TypeWebAppInfo                   = "WebAppInfo"
+	TypeMainWebApp                   = "MainWebApp"
+	TypeWebAppOpenParameters         = "WebAppOpenParameters"
+	TypeWebPageInstantView           = "WebPageInstantView"
+	TypeBotMediaPreview              = "BotMediaPreview"
+	TypeBotMediaPreviews             = "BotMediaPreviews"
+	TypeBotMediaPreviewInfo          = "BotMediaPreviewInfo"
+	TypeAttachmentMenuBotColor       = "AttachmentMenuBotColor"
+	TypeAttachmentMenuBot            = "AttachmentMenuBot"
+	TypeInlineQueryResultsButton     = "InlineQueryResultsButton"
+	TypeInlineQueryResults           = "InlineQueryResults"
+	TypeCallbackQueryAnswer          = "CallbackQueryAnswer"
+	TypeGameHighScore                = "GameHighScore"
+	TypeGameHighScores               = "GameHighScores"
+)
+
+const (
+	ConstructorGame                                           = "game"
+	ConstructorWebApp                                         = "webApp"
+	ConstructorBotCommand                                     = "botCommand"
+	ConstructorBotCommands                                    = "botCommands"
+	ConstructorBotMenuButton                                  = "botMenuButton"
+	ConstructorBotVerificationParameters                      = "botVerificationParameters"
+	ConstructorBotVerification                                = "botVerification"
+	ConstructorBotInfo                                        = "botInfo"
+	ConstructorWebAppOpenModeCompact                          = "webAppOpenModeCompact"
+	ConstructorWebAppOpenModeFullSize                         = "webAppOpenModeFullSize"
+	ConstructorWebAppOpenModeFullScreen                       = "webAppOpenModeFullScreen"
+	ConstructorFoundWebApp                                    = "foundWebApp"
+	ConstructorWebAppInfo                                     = "webAppInfo"
+	ConstructorMainWebApp                                     = "mainWebApp"
+	ConstructorWebAppOpenParameters                           = "webAppOpenParameters"
+	ConstructorWebPageInstantView                             = "webPageInstantView"
+	ConstructorBotMediaPreview                                = "botMediaPreview"
+	ConstructorBotMediaPreviews                               = "botMediaPreviews"
+	ConstructorBotMediaPreviewInfo                            = "botMediaPreviewInfo"
+	ConstructorAttachmentMenuBotColor                         = "attachmentMenuBotColor"
+	ConstructorAttachmentMenuBot                              = "attachmentMenuBot"
+	ConstructorBotWriteAccessAllowReasonConnectedWebsite      = "botWriteAccessAllowReasonConnectedWebsite"
+	ConstructorBotWriteAccessAllowReasonAddedToAttachmentMenu = "botWriteAccessAllowReasonAddedToAttachmentMenu"
+	ConstructorBotWriteAccessAllowReasonLaunchedWebApp        = "botWriteAccessAllowReasonLaunchedWebApp"
+	ConstructorBotWriteAccessAllowReasonAcceptedRequest       = "botWriteAccessAllowReasonAcceptedRequest"
+	ConstructorInputInlineQueryResultAnimation                = "inputInlineQueryResultAnimation"
+	ConstructorInputInlineQueryResultArticle                  = "inputInlineQueryResultArticle"
+	ConstructorInputInlineQueryResultAudio                    = "inputInlineQueryResultAudio"
+	ConstructorInputInlineQueryResultContact                  = "inputInlineQueryResultContact"
+	ConstructorInputInlineQueryResultDocument                 = "inputInlineQueryResultDocument"
+	ConstructorInputInlineQueryResultGame                     = "inputInlineQueryResultGame"
+	ConstructorInputInlineQueryResultLocation                 = "inputInlineQueryResultLocation"
+	ConstructorInputInlineQueryResultPhoto                    = "inputInlineQueryResultPhoto"
+	ConstructorInputInlineQueryResultSticker                  = "inputInlineQueryResultSticker"
+	ConstructorInputInlineQueryResultVenue                    = "inputInlineQueryResultVenue"
+	ConstructorInputInlineQueryResultVideo                    = "inputInlineQueryResultVideo"
+	ConstructorInputInlineQueryResultVoiceNote                = "inputInlineQueryResultVoiceNote"
+	ConstructorInlineQueryResultArticle                       = "inlineQueryResultArticle"
+	ConstructorInlineQueryResultContact                       = "inlineQueryResultContact"
+	ConstructorInlineQueryResultLocation                      = "inlineQueryResultLocation"
+	ConstructorInlineQueryResultVenue                         = "inlineQueryResultVenue"
+	ConstructorInlineQueryResultGame                          = "inlineQueryResultGame"
+	ConstructorInlineQueryResultAnimation                     = "inlineQueryResultAnimation"
+	ConstructorInlineQueryResultAudio                         = "inlineQueryResultAudio"
+	ConstructorInlineQueryResultDocument                      = "inlineQueryResultDocument"
+	ConstructorInlineQueryResultPhoto                         = "inlineQueryResultPhoto"
+	ConstructorInlineQueryResultSticker                       = "inlineQueryResultSticker"
+	ConstructorInlineQueryResultVideo                         = "inlineQueryResultVideo"
+	ConstructorInlineQueryResultVoiceNote                     = "inlineQueryResultVoiceNote"
+	ConstructorInlineQueryResultsButtonTypeStartBot           = "inlineQueryResultsButtonTypeStartBot"
+	ConstructorInlineQueryResultsButtonTypeWebApp             = "inlineQueryResultsButtonTypeWebApp"
+	ConstructorInlineQueryResultsButton                       = "inlineQueryResultsButton"
+	ConstructorInlineQueryResults                             = "inlineQueryResults"
+	ConstructorCallbackQueryPayloadData                       = "callbackQueryPayloadData"
+	ConstructorCallbackQueryPayloadDataWithPassword           = "callbackQueryPayloadDataWithPassword"
+	ConstructorCallbackQueryPayloadGame                       = "callbackQueryPayloadGame"
+	ConstructorCallbackQueryAnswer                            = "callbackQueryAnswer"
+	ConstructorGameHighScore                                  = "gameHighScore"
+	ConstructorGameHighScores                                 = "gameHighScores"
+	ConstructorBotCommandScopeDefault                         = "botCommandScopeDefault"
+	ConstructorBotCommandScopeAllPrivateChats                 = "botCommandScopeAllPrivateChats"
+	ConstructorBotCommandScopeAllGroupChats                   = "botCommandScopeAllGroupChats"
+	ConstructorBotCommandScopeAllChatAdministrators           = "botCommandScopeAllChatAdministrators"
+	ConstructorBotCommandScopeChat                            = "botCommandScopeChat"
+	ConstructorBotCommandScopeChatAdministrators              = "botCommandScopeChatAdministrators"
+	ConstructorBotCommandScopeChatMember                      = "botCommandScopeChatMember"
+)
+
+// Describes mode in which a Web App is opened
+type WebAppOpenMode interface {
+	WebAppOpenModeConstructor() string
+}
+
+// Describes a reason why a bot was allowed to write messages to the current user
+type BotWriteAccessAllowReason interface {
+	BotWriteAccessAllowReasonConstructor() string
+}
+
+// Represents a single result of an inline query; for bots only
+type InputInlineQueryResult interface {
+	InputInlineQueryResultConstructor() string
+}
+
+// Represents a single result of an inline query
+type InlineQueryResult interface {
+	InlineQueryResultConstructor() string
+}
+
+// Represents type of button in results of inline query
+type InlineQueryResultsButtonType interface {
+	InlineQueryResultsButtonTypeConstructor() string
+}
+
+// Represents a payload of a callback query
+type CallbackQueryPayload interface {
+	CallbackQueryPayloadConstructor() string
+}
+
+// Represents the scope to which bot commands are relevant
+type BotCommandScope interface {
+	BotCommandScopeConstructor() string
+}
+
+func (entity *UnknownType) WebAppOpenModeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) BotWriteAccessAllowReasonConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputInlineQueryResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InlineQueryResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InlineQueryResultsButtonTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CallbackQueryPayloadConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) BotCommandScopeConstructor() string {
+	return entity.Constructor
+}
+
+// Describes a game. Use getInternalLink with internalLinkTypeGame to share the game
+type Game struct {
+	meta
+	// Unique game identifier
+	Id JsonInt64 `json:"id"`
+	// Game short name
+	ShortName string `json:"short_name"`
+	// Game title
+	Title string `json:"title"`
+	// Game text, usually containing scoreboards for a game
+	Text *FormattedText `json:"text"`
+	// Game description
+	Description string `json:"description"`
+	// Game photo
+	Photo *Photo `json:"photo"`
+	// Game animation; may be null
+	Animation *Animation `json:"animation,omitempty"`
+}
+
+func (*Game) GetType() string {
+	return TypeGame
+}
+
+func (*Game) GetConstructor() string {
+	return ConstructorGame
+}
+
+func (entity *Game) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Game) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Game) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"game"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"short_name":`)
+	encoder.writeString(entity.ShortName)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	if entity.Animation != nil {
+		encoder.writeRaw(`,"animation":`)
+		entity.Animation.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *Game) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "short_name":
+			entity.ShortName, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a Web App. Use getInternalLink with internalLinkTypeWebApp to share the Web App
+type WebApp struct {
+	meta
+	// Web App short name
+	ShortName string `json:"short_name"`
+	// Web App title
+	Title string `json:"title"`
+	// Web App description
+	Description string `json:"description"`
+	// Web App photo
+	Photo *Photo `json:"photo"`
+	// Web App animation; may be null
+	Animation *Animation `json:"animation,omitempty"`
+}
+
+func (*WebApp) GetType() string {
+	return TypeWebApp
+}
+
+func (*WebApp) GetConstructor() string {
+	return ConstructorWebApp
+}
+
+func (entity *WebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *WebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *WebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"webApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"short_name":`)
+	encoder.writeString(entity.ShortName)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	if entity.Animation != nil {
+		encoder.writeRaw(`,"animation":`)
+		entity.Animation.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *WebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "short_name":
+			entity.ShortName, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a command supported by a bot
+type BotCommand struct {
+	meta
+	// Text of the bot command
+	Command string `json:"command"`
+	// Description of the bot command
+	Description string `json:"description"`
+}
+
+func (*BotCommand) GetType() string {
+	return TypeBotCommand
+}
+
+func (*BotCommand) GetConstructor() string {
+	return ConstructorBotCommand
+}
+
+func (entity *BotCommand) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommand) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommand) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommand"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"command":`)
+	encoder.writeString(entity.Command)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommand) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "command":
+			entity.Command, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotCommand)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of bot commands
+type BotCommands struct {
+	meta
+	// Bot's user identifier
+	BotUserId int64 `json:"bot_user_id"`
+	// List of bot commands
+	Commands []*BotCommand `json:"commands"`
+}
+
+func (*BotCommands) GetType() string {
+	return TypeBotCommands
+}
+
+func (*BotCommands) GetConstructor() string {
+	return ConstructorBotCommands
+}
+
+func (entity *BotCommands) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommands) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommands) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommands"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"commands":`)
+	writeList(encoder, entity.Commands, writeTypeValue[*BotCommand])
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommands) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "commands":
+			entity.Commands, err = readList(decoder, decodeEntity[BotCommand])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotCommands)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a button to be shown instead of bot commands menu button
+type BotMenuButton struct {
+	meta
+	// Text of the button
+	Text string `json:"text"`
+	// URL of a Web App to open when the button is pressed. If the link is of the type internalLinkTypeWebApp, then it must be processed accordingly. Otherwise, the link must be passed to openWebApp
+	Url string `json:"url"`
+}
+
+func (*BotMenuButton) GetType() string {
+	return TypeBotMenuButton
+}
+
+func (*BotMenuButton) GetConstructor() string {
+	return ConstructorBotMenuButton
+}
+
+func (entity *BotMenuButton) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotMenuButton) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotMenuButton) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botMenuButton"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotMenuButton) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotMenuButton)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes parameters of verification that is provided by a bot
+type BotVerificationParameters struct {
+	meta
+	// Identifier of the custom emoji that is used as the verification sign
+	IconCustomEmojiId JsonInt64 `json:"icon_custom_emoji_id"`
+	// Name of the organization that provides verification
+	OrganizationName string `json:"organization_name"`
+	// Default custom description of verification reason to be used as placeholder in setMessageSenderBotVerification; may be null if none
+	DefaultCustomDescription *FormattedText `json:"default_custom_description,omitempty"`
+	// True, if the bot is allowed to provide custom description for verified entities
+	CanSetCustomDescription bool `json:"can_set_custom_description"`
+}
+
+func (*BotVerificationParameters) GetType() string {
+	return TypeBotVerificationParameters
+}
+
+func (*BotVerificationParameters) GetConstructor() string {
+	return ConstructorBotVerificationParameters
+}
+
+func (entity *BotVerificationParameters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotVerificationParameters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotVerificationParameters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botVerificationParameters"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"icon_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.IconCustomEmojiId)
+	encoder.writeRaw(`,"organization_name":`)
+	encoder.writeString(entity.OrganizationName)
+	if entity.DefaultCustomDescription != nil {
+		encoder.writeRaw(`,"default_custom_description":`)
+		entity.DefaultCustomDescription.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"can_set_custom_description":`)
+	encoder.writeBool(entity.CanSetCustomDescription)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotVerificationParameters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "icon_custom_emoji_id":
+			entity.IconCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "organization_name":
+			entity.OrganizationName, err = decoder.readString()
+
+		case "default_custom_description":
+			entity.DefaultCustomDescription, err = decodeEntity[FormattedText](decoder)
+
+		case "can_set_custom_description":
+			entity.CanSetCustomDescription, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotVerificationParameters)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes verification status provided by a bot
+type BotVerification struct {
+	meta
+	// Identifier of the bot that provided the verification
+	BotUserId int64 `json:"bot_user_id"`
+	// Identifier of the custom emoji that is used as the verification sign
+	IconCustomEmojiId JsonInt64 `json:"icon_custom_emoji_id"`
+	// Custom description of verification reason set by the bot. Can contain only Mention, Hashtag, Cashtag, PhoneNumber, BankCardNumber, Url, and EmailAddress entities
+	CustomDescription *FormattedText `json:"custom_description"`
+}
+
+func (*BotVerification) GetType() string {
+	return TypeBotVerification
+}
+
+func (*BotVerification) GetConstructor() string {
+	return ConstructorBotVerification
+}
+
+func (entity *BotVerification) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotVerification) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotVerification) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botVerification"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"icon_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.IconCustomEmojiId)
+	encoder.writeRaw(`,"custom_description":`)
+	entity.CustomDescription.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotVerification) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "icon_custom_emoji_id":
+			entity.IconCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "custom_description":
+			entity.CustomDescription, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotVerification)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a bot
+type BotInfo struct {
+	meta
+	// The text that is shown on the bot's profile page and is sent together with the link when users share the bot
+	ShortDescription string `json:"short_description"`
+	// The text shown in the chat with the bot if the chat is empty
+	Description string `json:"description"`
+	// Photo shown in the chat with the bot if the chat is empty; may be null
+	Photo *Photo `json:"photo,omitempty"`
+	// Animation shown in the chat with the bot if the chat is empty; may be null
+	Animation *Animation `json:"animation,omitempty"`
+	// Information about a button to show instead of the bot commands menu button; may be null if ordinary bot commands menu must be shown
+	MenuButton *BotMenuButton `json:"menu_button,omitempty"`
+	// List of the bot commands
+	Commands []*BotCommand `json:"commands"`
+	// The HTTP link to the privacy policy of the bot. If empty, then /privacy command must be used if supported by the bot. If the command isn't supported, then https://telegram.org/privacy-tpa must be opened
+	PrivacyPolicyUrl string `json:"privacy_policy_url"`
+	// Default administrator rights for adding the bot to basic group and supergroup chats; may be null
+	DefaultGroupAdministratorRights *ChatAdministratorRights `json:"default_group_administrator_rights,omitempty"`
+	// Default administrator rights for adding the bot to channels; may be null
+	DefaultChannelAdministratorRights *ChatAdministratorRights `json:"default_channel_administrator_rights,omitempty"`
+	// Information about the affiliate program of the bot; may be null if none
+	AffiliateProgram *AffiliateProgramInfo `json:"affiliate_program,omitempty"`
+	// Default light background color for bot Web Apps; -1 if not specified
+	WebAppBackgroundLightColor int32 `json:"web_app_background_light_color"`
+	// Default dark background color for bot Web Apps; -1 if not specified
+	WebAppBackgroundDarkColor int32 `json:"web_app_background_dark_color"`
+	// Default light header color for bot Web Apps; -1 if not specified
+	WebAppHeaderLightColor int32 `json:"web_app_header_light_color"`
+	// Default dark header color for bot Web Apps; -1 if not specified
+	WebAppHeaderDarkColor int32 `json:"web_app_header_dark_color"`
+	// Parameters of the verification that can be provided by the bot; may be null if none or the current user isn't the owner of the bot
+	VerificationParameters *BotVerificationParameters `json:"verification_parameters,omitempty"`
+	// True, if the bot's revenue statistics are available to the current user
+	CanGetRevenueStatistics bool `json:"can_get_revenue_statistics"`
+	// True, if the bot can manage emoji status of the current user
+	CanManageEmojiStatus bool `json:"can_manage_emoji_status"`
+	// True, if the bot has media previews
+	HasMediaPreviews bool `json:"has_media_previews"`
+	// The internal link, which can be used to edit bot commands; may be null
+	EditCommandsLink InternalLinkType `json:"edit_commands_link,omitempty"`
+	// The internal link, which can be used to edit bot description; may be null
+	EditDescriptionLink InternalLinkType `json:"edit_description_link,omitempty"`
+	// The internal link, which can be used to edit the photo or animation shown in the chat with the bot if the chat is empty; may be null
+	EditDescriptionMediaLink InternalLinkType `json:"edit_description_media_link,omitempty"`
+	// The internal link, which can be used to edit bot settings; may be null
+	EditSettingsLink InternalLinkType `json:"edit_settings_link,omitempty"`
+}
+
+func (*BotInfo) GetType() string {
+	return TypeBotInfo
+}
+
+func (*BotInfo) GetConstructor() string {
+	return ConstructorBotInfo
+}
+
+func (entity *BotInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"short_description":`)
+	encoder.writeString(entity.ShortDescription)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	if entity.Animation != nil {
+		encoder.writeRaw(`,"animation":`)
+		entity.Animation.encodeJSON(encoder)
+	}
+	if entity.MenuButton != nil {
+		encoder.writeRaw(`,"menu_button":`)
+		entity.MenuButton.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"commands":`)
+	writeList(encoder, entity.Commands, writeTypeValue[*BotCommand])
+	encoder.writeRaw(`,"privacy_policy_url":`)
+	encoder.writeString(entity.PrivacyPolicyUrl)
+	if entity.DefaultGroupAdministratorRights != nil {
+		encoder.writeRaw(`,"default_group_administrator_rights":`)
+		entity.DefaultGroupAdministratorRights.encodeJSON(encoder)
+	}
+	if entity.DefaultChannelAdministratorRights != nil {
+		encoder.writeRaw(`,"default_channel_administrator_rights":`)
+		entity.DefaultChannelAdministratorRights.encodeJSON(encoder)
+	}
+	if entity.AffiliateProgram != nil {
+		encoder.writeRaw(`,"affiliate_program":`)
+		entity.AffiliateProgram.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"web_app_background_light_color":`)
+	encoder.writeInt32(entity.WebAppBackgroundLightColor)
+	encoder.writeRaw(`,"web_app_background_dark_color":`)
+	encoder.writeInt32(entity.WebAppBackgroundDarkColor)
+	encoder.writeRaw(`,"web_app_header_light_color":`)
+	encoder.writeInt32(entity.WebAppHeaderLightColor)
+	encoder.writeRaw(`,"web_app_header_dark_color":`)
+	encoder.writeInt32(entity.WebAppHeaderDarkColor)
+	if entity.VerificationParameters != nil {
+		encoder.writeRaw(`,"verification_parameters":`)
+		entity.VerificationParameters.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"can_get_revenue_statistics":`)
+	encoder.writeBool(entity.CanGetRevenueStatistics)
+	encoder.writeRaw(`,"can_manage_emoji_status":`)
+	encoder.writeBool(entity.CanManageEmojiStatus)
+	encoder.writeRaw(`,"has_media_previews":`)
+	encoder.writeBool(entity.HasMediaPreviews)
+	if entity.EditCommandsLink != nil {
+		encoder.writeRaw(`,"edit_commands_link":`)
+		encoder.writeType(entity.EditCommandsLink)
+	}
+	if entity.EditDescriptionLink != nil {
+		encoder.writeRaw(`,"edit_description_link":`)
+		encoder.writeType(entity.EditDescriptionLink)
+	}
+	if entity.EditDescriptionMediaLink != nil {
+		encoder.writeRaw(`,"edit_description_media_link":`)
+		encoder.writeType(entity.EditDescriptionMediaLink)
+	}
+	if entity.EditSettingsLink != nil {
+		encoder.writeRaw(`,"edit_settings_link":`)
+		encoder.writeType(entity.EditSettingsLink)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *BotInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "short_description":
+			entity.ShortDescription, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		case "menu_button":
+			entity.MenuButton, err = decodeEntity[BotMenuButton](decoder)
+
+		case "commands":
+			entity.Commands, err = readList(decoder, decodeEntity[BotCommand])
+
+		case "privacy_policy_url":
+			entity.PrivacyPolicyUrl, err = decoder.readString()
+
+		case "default_group_administrator_rights":
+			entity.DefaultGroupAdministratorRights, err = decodeEntity[ChatAdministratorRights](decoder)
+
+		case "default_channel_administrator_rights":
+			entity.DefaultChannelAdministratorRights, err = decodeEntity[ChatAdministratorRights](decoder)
+
+		case "affiliate_program":
+			entity.AffiliateProgram, err = decodeEntity[AffiliateProgramInfo](decoder)
+
+		case "web_app_background_light_color":
+			entity.WebAppBackgroundLightColor, err = decoder.readInt32()
+
+		case "web_app_background_dark_color":
+			entity.WebAppBackgroundDarkColor, err = decoder.readInt32()
+
+		case "web_app_header_light_color":
+			entity.WebAppHeaderLightColor, err = decoder.readInt32()
+
+		case "web_app_header_dark_color":
+			entity.WebAppHeaderDarkColor, err = decoder.readInt32()
+
+		case "verification_parameters":
+			entity.VerificationParameters, err = decodeEntity[BotVerificationParameters](decoder)
+
+		case "can_get_revenue_statistics":
+			entity.CanGetRevenueStatistics, err = decoder.readBool()
+
+		case "can_manage_emoji_status":
+			entity.CanManageEmojiStatus, err = decoder.readBool()
+
+		case "has_media_previews":
+			entity.HasMediaPreviews, err = decoder.readBool()
+
+		case "edit_commands_link":
+			entity.EditCommandsLink, err = decodeInternalLinkType(decoder)
+
+		case "edit_description_link":
+			entity.EditDescriptionLink, err = decodeInternalLinkType(decoder)
+
+		case "edit_description_media_link":
+			entity.EditDescriptionMediaLink, err = decodeInternalLinkType(decoder)
+
+		case "edit_settings_link":
+			entity.EditSettingsLink, err = decodeInternalLinkType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The Web App is opened in the compact mode
+type WebAppOpenModeCompact struct {
+	meta
+}
+
+func (*WebAppOpenModeCompact) GetType() string {
+	return TypeWebAppOpenMode
+}
+
+func (*WebAppOpenModeCompact) GetConstructor() string {
+	return ConstructorWebAppOpenModeCompact
+}
+
+func (*WebAppOpenModeCompact) WebAppOpenModeConstructor() string {
+	return ConstructorWebAppOpenModeCompact
+}
+
+func (entity *WebAppOpenModeCompact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *WebAppOpenModeCompact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *WebAppOpenModeCompact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"webAppOpenModeCompact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *WebAppOpenModeCompact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorWebAppOpenModeCompact)
+	})
+}
+
+// The Web App is opened in the full-size mode
+type WebAppOpenModeFullSize struct {
+	meta
+}
+
+func (*WebAppOpenModeFullSize) GetType() string {
+	return TypeWebAppOpenMode
+}
+
+func (*WebAppOpenModeFullSize) GetConstructor() string {
+	return ConstructorWebAppOpenModeFullSize
+}
+
+func (*WebAppOpenModeFullSize) WebAppOpenModeConstructor() string {
+	return ConstructorWebAppOpenModeFullSize
+}
+
+func (entity *WebAppOpenModeFullSize) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *WebAppOpenModeFullSize) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *WebAppOpenModeFullSize) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"webAppOpenModeFullSize"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *WebAppOpenModeFullSize) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorWebAppOpenModeFullSize)
+	})
+}
+
+// The Web App is opened in the full-screen mode
+type WebAppOpenModeFullScreen struct {
+	meta
+}
+
+func (*WebAppOpenModeFullScreen) GetType() string {
+	return TypeWebAppOpenMode
+}
+
+func (*WebAppOpenModeFullScreen) GetConstructor() string {
+	return ConstructorWebAppOpenModeFullScreen
+}
+
+func (*WebAppOpenModeFullScreen) WebAppOpenModeConstructor() string {
+	return ConstructorWebAppOpenModeFullScreen
+}
+
+func (entity *WebAppOpenModeFullScreen) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *WebAppOpenModeFullScreen) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *WebAppOpenModeFullScreen) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"webAppOpenModeFullScreen"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *WebAppOpenModeFullScreen) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorWebAppOpenModeFullScreen)
+	})
+}
+
+// Contains information about a Web App found by its short name
+type FoundWebApp struct {
+	meta
+	// The Web App
+	WebApp *WebApp `json:"web_app"`
+	// True, if the user must be asked for the permission to the bot to send them messages
+	RequestWriteAccess bool `json:"request_write_access"`
+	// True, if there is no need to show an ordinary open URL confirmation before opening the Web App. The field must be ignored and confirmation must be shown anyway if the Web App link was hidden
+	SkipConfirmation bool `json:"skip_confirmation"`
+}
+
+func (*FoundWebApp) GetType() string {
+	return TypeFoundWebApp
+}
+
+func (*FoundWebApp) GetConstructor() string {
+	return ConstructorFoundWebApp
+}
+
+func (entity *FoundWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"web_app":`)
+	entity.WebApp.encodeJSON(encoder)
+	encoder.writeRaw(`,"request_write_access":`)
+	encoder.writeBool(entity.RequestWriteAccess)
+	encoder.writeRaw(`,"skip_confirmation":`)
+	encoder.writeBool(entity.SkipConfirmation)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "web_app":
+			entity.WebApp, err = decodeEntity[WebApp](decoder)
+
+		case "request_write_access":
+			entity.RequestWriteAccess, err = decoder.readBool()
+
+		case "skip_confirmation":
+			entity.SkipConfirmation, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a Web App
+type WebAppInfo struct {
+	meta
+	// Unique identifier for the Web App launch
+	LaunchId JsonInt64 `json:"launch_id"`
+	// A Web App URL to open in a web view
+	Url string `json:"url"`
+}
+
+func (*WebAppInfo) GetType() string {
+	return TypeWebAppInfo
+}
+
+func (*WebAppInfo) GetConstructor() string {
+	return ConstructorWebAppInfo
+}
+
+func (entity *WebAppInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *WebAppInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *WebAppInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"webAppInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"launch_id":`)
+	encoder.writeJsonInt64(entity.LaunchId)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *WebAppInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "launch_id":
+			entity.LaunchId, err = decoder.readJsonInt64()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorWebAppInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about the main Web App of a bot
+type MainWebApp struct {
+	meta
+	// URL of the Web App to open
+	Url string `json:"url"`
+	// The mode in which the Web App must be opened
+	Mode WebAppOpenMode `json:"mode"`
+}
+
+func (*MainWebApp) GetType() string {
+	return TypeMainWebApp
+}
+
+func (*MainWebApp) GetConstructor() string {
+	return ConstructorMainWebApp
+}
+
+func (entity *MainWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MainWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MainWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"mainWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"mode":`)
+	encoder.writeType(entity.Mode)
+	encoder.writeRaw("}")
+}
+
+func (entity *MainWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "mode":
+			entity.Mode, err = decodeWebAppOpenMode(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMainWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Options to be used when a Web App is opened
+type WebAppOpenParameters struct {
+	meta
+	// Preferred Web App theme; pass null to use the default theme
+	Theme *ThemeParameters `json:"theme,omitempty"`
+	// Short name of the current application; 0-64 English letters, digits, and underscores
+	ApplicationName string `json:"application_name"`
+	// The mode in which the Web App is opened; pass null to open in webAppOpenModeFullSize
+	Mode WebAppOpenMode `json:"mode,omitempty"`
+}
+
+func (*WebAppOpenParameters) GetType() string {
+	return TypeWebAppOpenParameters
+}
+
+func (*WebAppOpenParameters) GetConstructor() string {
+	return ConstructorWebAppOpenParameters
+}
+
+func (entity *WebAppOpenParameters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *WebAppOpenParameters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *WebAppOpenParameters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"webAppOpenParameters"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Theme != nil {
+		encoder.writeRaw(`,"theme":`)
+		entity.Theme.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"application_name":`)
+	encoder.writeString(entity.ApplicationName)
+	if entity.Mode != nil {
+		encoder.writeRaw(`,"mode":`)
+		encoder.writeType(entity.Mode)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *WebAppOpenParameters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "theme":
+			entity.Theme, err = decodeEntity[ThemeParameters](decoder)
+
+		case "application_name":
+			entity.ApplicationName, err = decoder.readString()
+
+		case "mode":
+			entity.Mode, err = decodeWebAppOpenMode(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorWebAppOpenParameters)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an instant view page for a web page
+type WebPageInstantView struct {
+	meta
+	// Content of the instant view page
+	PageBlocks []PageBlock `json:"page_blocks"`
+	// Number of the instant view views; 0 if unknown
+	ViewCount int32 `json:"view_count"`
+	// Version of the instant view; currently, can be 1 or 2
+	Version int32 `json:"version"`
+	// True, if the instant view must be shown from right to left
+	IsRtl bool `json:"is_rtl"`
+	// True, if the instant view contains the full page. A network request might be needed to get the full instant view
+	IsFull bool `json:"is_full"`
+	// An internal link to be opened to leave feedback about the instant view
+	FeedbackLink InternalLinkType `json:"feedback_link"`
+}
+
+func (*WebPageInstantView) GetType() string {
+	return TypeWebPageInstantView
+}
+
+func (*WebPageInstantView) GetConstructor() string {
+	return ConstructorWebPageInstantView
+}
+
+func (entity *WebPageInstantView) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *WebPageInstantView) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *WebPageInstantView) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"webPageInstantView"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"page_blocks":`)
+	writeList(encoder, entity.PageBlocks, writeTypeValue[PageBlock])
+	encoder.writeRaw(`,"view_count":`)
+	encoder.writeInt32(entity.ViewCount)
+	encoder.writeRaw(`,"version":`)
+	encoder.writeInt32(entity.Version)
+	encoder.writeRaw(`,"is_rtl":`)
+	encoder.writeBool(entity.IsRtl)
+	encoder.writeRaw(`,"is_full":`)
+	encoder.writeBool(entity.IsFull)
+	encoder.writeRaw(`,"feedback_link":`)
+	encoder.writeType(entity.FeedbackLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *WebPageInstantView) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "page_blocks":
+			entity.PageBlocks, err = readList(decoder, decodePageBlock)
+
+		case "view_count":
+			entity.ViewCount, err = decoder.readInt32()
+
+		case "version":
+			entity.Version, err = decoder.readInt32()
+
+		case "is_rtl":
+			entity.IsRtl, err = decoder.readBool()
+
+		case "is_full":
+			entity.IsFull, err = decoder.readBool()
+
+		case "feedback_link":
+			entity.FeedbackLink, err = decodeInternalLinkType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorWebPageInstantView)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes media previews of a bot
+type BotMediaPreview struct {
+	meta
+	// Point in time (Unix timestamp) when the preview was added or changed last time
+	Date int32 `json:"date"`
+	// Content of the preview
+	Content StoryContent `json:"content"`
+}
+
+func (*BotMediaPreview) GetType() string {
+	return TypeBotMediaPreview
+}
+
+func (*BotMediaPreview) GetConstructor() string {
+	return ConstructorBotMediaPreview
+}
+
+func (entity *BotMediaPreview) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotMediaPreview) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotMediaPreview) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botMediaPreview"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(entity.Content)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotMediaPreview) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "content":
+			entity.Content, err = decodeStoryContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotMediaPreview)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of media previews of a bot
+type BotMediaPreviews struct {
+	meta
+	// List of media previews
+	Previews []*BotMediaPreview `json:"previews"`
+}
+
+func (*BotMediaPreviews) GetType() string {
+	return TypeBotMediaPreviews
+}
+
+func (*BotMediaPreviews) GetConstructor() string {
+	return ConstructorBotMediaPreviews
+}
+
+func (entity *BotMediaPreviews) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotMediaPreviews) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotMediaPreviews) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botMediaPreviews"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"previews":`)
+	writeList(encoder, entity.Previews, writeTypeValue[*BotMediaPreview])
+	encoder.writeRaw("}")
+}
+
+func (entity *BotMediaPreviews) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "previews":
+			entity.Previews, err = readList(decoder, decodeEntity[BotMediaPreview])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotMediaPreviews)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of media previews of a bot for the given language and the list of languages for which the bot has dedicated previews
+type BotMediaPreviewInfo struct {
+	meta
+	// List of media previews
+	Previews []*BotMediaPreview `json:"previews"`
+	// List of language codes for which the bot has dedicated previews
+	LanguageCodes []string `json:"language_codes"`
+}
+
+func (*BotMediaPreviewInfo) GetType() string {
+	return TypeBotMediaPreviewInfo
+}
+
+func (*BotMediaPreviewInfo) GetConstructor() string {
+	return ConstructorBotMediaPreviewInfo
+}
+
+func (entity *BotMediaPreviewInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotMediaPreviewInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotMediaPreviewInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botMediaPreviewInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"previews":`)
+	writeList(encoder, entity.Previews, writeTypeValue[*BotMediaPreview])
+	encoder.writeRaw(`,"language_codes":`)
+	writeList(encoder, entity.LanguageCodes, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotMediaPreviewInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "previews":
+			entity.Previews, err = readList(decoder, decodeEntity[BotMediaPreview])
+
+		case "language_codes":
+			entity.LanguageCodes, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotMediaPreviewInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a color to highlight a bot added to attachment menu
+type AttachmentMenuBotColor struct {
+	meta
+	// Color in the RGB format for light themes
+	LightColor int32 `json:"light_color"`
+	// Color in the RGB format for dark themes
+	DarkColor int32 `json:"dark_color"`
+}
+
+func (*AttachmentMenuBotColor) GetType() string {
+	return TypeAttachmentMenuBotColor
+}
+
+func (*AttachmentMenuBotColor) GetConstructor() string {
+	return ConstructorAttachmentMenuBotColor
+}
+
+func (entity *AttachmentMenuBotColor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AttachmentMenuBotColor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AttachmentMenuBotColor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"attachmentMenuBotColor"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"light_color":`)
+	encoder.writeInt32(entity.LightColor)
+	encoder.writeRaw(`,"dark_color":`)
+	encoder.writeInt32(entity.DarkColor)
+	encoder.writeRaw("}")
+}
+
+func (entity *AttachmentMenuBotColor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "light_color":
+			entity.LightColor, err = decoder.readInt32()
+
+		case "dark_color":
+			entity.DarkColor, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAttachmentMenuBotColor)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a bot, which can be added to attachment or side menu
+type AttachmentMenuBot struct {
+	meta
+	// User identifier of the bot
+	BotUserId int64 `json:"bot_user_id"`
+	// True, if the bot supports opening from attachment menu in the chat with the bot
+	SupportsSelfChat bool `json:"supports_self_chat"`
+	// True, if the bot supports opening from attachment menu in private chats with ordinary users
+	SupportsUserChats bool `json:"supports_user_chats"`
+	// True, if the bot supports opening from attachment menu in private chats with other bots
+	SupportsBotChats bool `json:"supports_bot_chats"`
+	// True, if the bot supports opening from attachment menu in basic group and supergroup chats
+	SupportsGroupChats bool `json:"supports_group_chats"`
+	// True, if the bot supports opening from attachment menu in channel chats
+	SupportsChannelChats bool `json:"supports_channel_chats"`
+	// True, if the user must be asked for the permission to send messages to the bot
+	RequestWriteAccess bool `json:"request_write_access"`
+	// True, if the bot was explicitly added by the user. If the bot isn't added, then on the first bot launch toggleBotIsAddedToAttachmentMenu must be called and the bot must be added or removed
+	IsAdded bool `json:"is_added"`
+	// True, if the bot must be shown in the attachment menu
+	ShowInAttachmentMenu bool `json:"show_in_attachment_menu"`
+	// True, if the bot must be shown in the side menu
+	ShowInSideMenu bool `json:"show_in_side_menu"`
+	// True, if a disclaimer, why the bot is shown in the side menu, is needed
+	ShowDisclaimerInSideMenu bool `json:"show_disclaimer_in_side_menu"`
+	// Name for the bot in attachment menu
+	Name string `json:"name"`
+	// Color to highlight selected name of the bot if appropriate; may be null
+	NameColor *AttachmentMenuBotColor `json:"name_color,omitempty"`
+	// Default icon for the bot in SVG format; may be null
+	DefaultIcon *File `json:"default_icon,omitempty"`
+	// Icon for the bot in SVG format for the official iOS app; may be null
+	IosStaticIcon *File `json:"ios_static_icon,omitempty"`
+	// Icon for the bot in TGS format for the official iOS app; may be null
+	IosAnimatedIcon *File `json:"ios_animated_icon,omitempty"`
+	// Icon for the bot in PNG format for the official iOS app side menu; may be null
+	IosSideMenuIcon *File `json:"ios_side_menu_icon,omitempty"`
+	// Icon for the bot in TGS format for the official Android app; may be null
+	AndroidIcon *File `json:"android_icon,omitempty"`
+	// Icon for the bot in SVG format for the official Android app side menu; may be null
+	AndroidSideMenuIcon *File `json:"android_side_menu_icon,omitempty"`
+	// Icon for the bot in TGS format for the official native macOS app; may be null
+	MacosIcon *File `json:"macos_icon,omitempty"`
+	// Icon for the bot in PNG format for the official macOS app side menu; may be null
+	MacosSideMenuIcon *File `json:"macos_side_menu_icon,omitempty"`
+	// Color to highlight selected icon of the bot if appropriate; may be null
+	IconColor *AttachmentMenuBotColor `json:"icon_color,omitempty"`
+	// Default placeholder for opened Web Apps in SVG format; may be null
+	WebAppPlaceholder *File `json:"web_app_placeholder,omitempty"`
+}
+
+func (*AttachmentMenuBot) GetType() string {
+	return TypeAttachmentMenuBot
+}
+
+func (*AttachmentMenuBot) GetConstructor() string {
+	return ConstructorAttachmentMenuBot
+}
+
+func (entity *AttachmentMenuBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AttachmentMenuBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AttachmentMenuBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"attachmentMenuBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"supports_self_chat":`)
+	encoder.writeBool(entity.SupportsSelfChat)
+	encoder.writeRaw(`,"supports_user_chats":`)
+	encoder.writeBool(entity.SupportsUserChats)
+	encoder.writeRaw(`,"supports_bot_chats":`)
+	encoder.writeBool(entity.SupportsBotChats)
+	encoder.writeRaw(`,"supports_group_chats":`)
+	encoder.writeBool(entity.SupportsGroupChats)
+	encoder.writeRaw(`,"supports_channel_chats":`)
+	encoder.writeBool(entity.SupportsChannelChats)
+	encoder.writeRaw(`,"request_write_access":`)
+	encoder.writeBool(entity.RequestWriteAccess)
+	encoder.writeRaw(`,"is_added":`)
+	encoder.writeBool(entity.IsAdded)
+	encoder.writeRaw(`,"show_in_attachment_menu":`)
+	encoder.writeBool(entity.ShowInAttachmentMenu)
+	encoder.writeRaw(`,"show_in_side_menu":`)
+	encoder.writeBool(entity.ShowInSideMenu)
+	encoder.writeRaw(`,"show_disclaimer_in_side_menu":`)
+	encoder.writeBool(entity.ShowDisclaimerInSideMenu)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	if entity.NameColor != nil {
+		encoder.writeRaw(`,"name_color":`)
+		entity.NameColor.encodeJSON(encoder)
+	}
+	if entity.DefaultIcon != nil {
+		encoder.writeRaw(`,"default_icon":`)
+		entity.DefaultIcon.encodeJSON(encoder)
+	}
+	if entity.IosStaticIcon != nil {
+		encoder.writeRaw(`,"ios_static_icon":`)
+		entity.IosStaticIcon.encodeJSON(encoder)
+	}
+	if entity.IosAnimatedIcon != nil {
+		encoder.writeRaw(`,"ios_animated_icon":`)
+		entity.IosAnimatedIcon.encodeJSON(encoder)
+	}
+	if entity.IosSideMenuIcon != nil {
+		encoder.writeRaw(`,"ios_side_menu_icon":`)
+		entity.IosSideMenuIcon.encodeJSON(encoder)
+	}
+	if entity.AndroidIcon != nil {
+		encoder.writeRaw(`,"android_icon":`)
+		entity.AndroidIcon.encodeJSON(encoder)
+	}
+	if entity.AndroidSideMenuIcon != nil {
+		encoder.writeRaw(`,"android_side_menu_icon":`)
+		entity.AndroidSideMenuIcon.encodeJSON(encoder)
+	}
+	if entity.MacosIcon != nil {
+		encoder.writeRaw(`,"macos_icon":`)
+		entity.MacosIcon.encodeJSON(encoder)
+	}
+	if entity.MacosSideMenuIcon != nil {
+		encoder.writeRaw(`,"macos_side_menu_icon":`)
+		entity.MacosSideMenuIcon.encodeJSON(encoder)
+	}
+	if entity.IconColor != nil {
+		encoder.writeRaw(`,"icon_color":`)
+		entity.IconColor.encodeJSON(encoder)
+	}
+	if entity.WebAppPlaceholder != nil {
+		encoder.writeRaw(`,"web_app_placeholder":`)
+		entity.WebAppPlaceholder.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *AttachmentMenuBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "supports_self_chat":
+			entity.SupportsSelfChat, err = decoder.readBool()
+
+		case "supports_user_chats":
+			entity.SupportsUserChats, err = decoder.readBool()
+
+		case "supports_bot_chats":
+			entity.SupportsBotChats, err = decoder.readBool()
+
+		case "supports_group_chats":
+			entity.SupportsGroupChats, err = decoder.readBool()
+
+		case "supports_channel_chats":
+			entity.SupportsChannelChats, err = decoder.readBool()
+
+		case "request_write_access":
+			entity.RequestWriteAccess, err = decoder.readBool()
+
+		case "is_added":
+			entity.IsAdded, err = decoder.readBool()
+
+		case "show_in_attachment_menu":
+			entity.ShowInAttachmentMenu, err = decoder.readBool()
+
+		case "show_in_side_menu":
+			entity.ShowInSideMenu, err = decoder.readBool()
+
+		case "show_disclaimer_in_side_menu":
+			entity.ShowDisclaimerInSideMenu, err = decoder.readBool()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "name_color":
+			entity.NameColor, err = decodeEntity[AttachmentMenuBotColor](decoder)
+
+		case "default_icon":
+			entity.DefaultIcon, err = decodeEntity[File](decoder)
+
+		case "ios_static_icon":
+			entity.IosStaticIcon, err = decodeEntity[File](decoder)
+
+		case "ios_animated_icon":
+			entity.IosAnimatedIcon, err = decodeEntity[File](decoder)
+
+		case "ios_side_menu_icon":
+			entity.IosSideMenuIcon, err = decodeEntity[File](decoder)
+
+		case "android_icon":
+			entity.AndroidIcon, err = decodeEntity[File](decoder)
+
+		case "android_side_menu_icon":
+			entity.AndroidSideMenuIcon, err = decodeEntity[File](decoder)
+
+		case "macos_icon":
+			entity.MacosIcon, err = decodeEntity[File](decoder)
+
+		case "macos_side_menu_icon":
+			entity.MacosSideMenuIcon, err = decodeEntity[File](decoder)
+
+		case "icon_color":
+			entity.IconColor, err = decodeEntity[AttachmentMenuBotColor](decoder)
+
+		case "web_app_placeholder":
+			entity.WebAppPlaceholder, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAttachmentMenuBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user connected a website by logging in using Telegram Login Widget on it
+type BotWriteAccessAllowReasonConnectedWebsite struct {
+	meta
+	// Domain name of the connected website
+	DomainName string `json:"domain_name"`
+}
+
+func (*BotWriteAccessAllowReasonConnectedWebsite) GetType() string {
+	return TypeBotWriteAccessAllowReason
+}
+
+func (*BotWriteAccessAllowReasonConnectedWebsite) GetConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonConnectedWebsite
+}
+
+func (*BotWriteAccessAllowReasonConnectedWebsite) BotWriteAccessAllowReasonConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonConnectedWebsite
+}
+
+func (entity *BotWriteAccessAllowReasonConnectedWebsite) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotWriteAccessAllowReasonConnectedWebsite) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotWriteAccessAllowReasonConnectedWebsite) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botWriteAccessAllowReasonConnectedWebsite"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"domain_name":`)
+	encoder.writeString(entity.DomainName)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotWriteAccessAllowReasonConnectedWebsite) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "domain_name":
+			entity.DomainName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotWriteAccessAllowReasonConnectedWebsite)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user added the bot to attachment or side menu using toggleBotIsAddedToAttachmentMenu
+type BotWriteAccessAllowReasonAddedToAttachmentMenu struct {
+	meta
+}
+
+func (*BotWriteAccessAllowReasonAddedToAttachmentMenu) GetType() string {
+	return TypeBotWriteAccessAllowReason
+}
+
+func (*BotWriteAccessAllowReasonAddedToAttachmentMenu) GetConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonAddedToAttachmentMenu
+}
+
+func (*BotWriteAccessAllowReasonAddedToAttachmentMenu) BotWriteAccessAllowReasonConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonAddedToAttachmentMenu
+}
+
+func (entity *BotWriteAccessAllowReasonAddedToAttachmentMenu) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotWriteAccessAllowReasonAddedToAttachmentMenu) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotWriteAccessAllowReasonAddedToAttachmentMenu) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botWriteAccessAllowReasonAddedToAttachmentMenu"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotWriteAccessAllowReasonAddedToAttachmentMenu) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBotWriteAccessAllowReasonAddedToAttachmentMenu)
+	})
+}
+
+// The user launched a Web App using getWebAppLinkUrl
+type BotWriteAccessAllowReasonLaunchedWebApp struct {
+	meta
+	// Information about the Web App
+	WebApp *WebApp `json:"web_app"`
+}
+
+func (*BotWriteAccessAllowReasonLaunchedWebApp) GetType() string {
+	return TypeBotWriteAccessAllowReason
+}
+
+func (*BotWriteAccessAllowReasonLaunchedWebApp) GetConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonLaunchedWebApp
+}
+
+func (*BotWriteAccessAllowReasonLaunchedWebApp) BotWriteAccessAllowReasonConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonLaunchedWebApp
+}
+
+func (entity *BotWriteAccessAllowReasonLaunchedWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotWriteAccessAllowReasonLaunchedWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotWriteAccessAllowReasonLaunchedWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botWriteAccessAllowReasonLaunchedWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"web_app":`)
+	entity.WebApp.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotWriteAccessAllowReasonLaunchedWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "web_app":
+			entity.WebApp, err = decodeEntity[WebApp](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotWriteAccessAllowReasonLaunchedWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user accepted bot's request to send messages with allowBotToSendMessages
+type BotWriteAccessAllowReasonAcceptedRequest struct {
+	meta
+}
+
+func (*BotWriteAccessAllowReasonAcceptedRequest) GetType() string {
+	return TypeBotWriteAccessAllowReason
+}
+
+func (*BotWriteAccessAllowReasonAcceptedRequest) GetConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonAcceptedRequest
+}
+
+func (*BotWriteAccessAllowReasonAcceptedRequest) BotWriteAccessAllowReasonConstructor() string {
+	return ConstructorBotWriteAccessAllowReasonAcceptedRequest
+}
+
+func (entity *BotWriteAccessAllowReasonAcceptedRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotWriteAccessAllowReasonAcceptedRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotWriteAccessAllowReasonAcceptedRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botWriteAccessAllowReasonAcceptedRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotWriteAccessAllowReasonAcceptedRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBotWriteAccessAllowReasonAcceptedRequest)
+	})
+}
+
+// Represents a link to an animated GIF or an animated (i.e., without sound) H.264/MPEG-4 AVC video
+type InputInlineQueryResultAnimation struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Title of the query result
+	Title string `json:"title"`
+	// URL of the result thumbnail (JPEG, GIF, or MPEG4), if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// MIME type of the video thumbnail. If non-empty, must be one of "image/jpeg", "image/gif" and "video/mp4"
+	ThumbnailMimeType string `json:"thumbnail_mime_type"`
+	// The URL of the video file (file size must not exceed 1MB)
+	VideoUrl string `json:"video_url"`
+	// MIME type of the video file. Must be one of "image/gif" and "video/mp4"
+	VideoMimeType string `json:"video_mime_type"`
+	// Duration of the video, in seconds
+	VideoDuration int32 `json:"video_duration"`
+	// Width of the video
+	VideoWidth int32 `json:"video_width"`
+	// Height of the video
+	VideoHeight int32 `json:"video_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageAnimation, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultAnimation) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultAnimation) GetConstructor() string {
+	return ConstructorInputInlineQueryResultAnimation
+}
+
+func (*InputInlineQueryResultAnimation) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultAnimation
+}
+
+func (entity *InputInlineQueryResultAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"thumbnail_mime_type":`)
+	encoder.writeString(entity.ThumbnailMimeType)
+	encoder.writeRaw(`,"video_url":`)
+	encoder.writeString(entity.VideoUrl)
+	encoder.writeRaw(`,"video_mime_type":`)
+	encoder.writeString(entity.VideoMimeType)
+	encoder.writeRaw(`,"video_duration":`)
+	encoder.writeInt32(entity.VideoDuration)
+	encoder.writeRaw(`,"video_width":`)
+	encoder.writeInt32(entity.VideoWidth)
+	encoder.writeRaw(`,"video_height":`)
+	encoder.writeInt32(entity.VideoHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "thumbnail_mime_type":
+			entity.ThumbnailMimeType, err = decoder.readString()
+
+		case "video_url":
+			entity.VideoUrl, err = decoder.readString()
+
+		case "video_mime_type":
+			entity.VideoMimeType, err = decoder.readString()
+
+		case "video_duration":
+			entity.VideoDuration, err = decoder.readInt32()
+
+		case "video_width":
+			entity.VideoWidth, err = decoder.readInt32()
+
+		case "video_height":
+			entity.VideoHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultAnimation) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a link to an article or web page
+type InputInlineQueryResultArticle struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// URL of the result, if it exists
+	Url string `json:"url"`
+	// Title of the result
+	Title string `json:"title"`
+	// A short description of the result
+	Description string `json:"description"`
+	// URL of the result thumbnail, if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Thumbnail width, if known
+	ThumbnailWidth int32 `json:"thumbnail_width"`
+	// Thumbnail height, if known
+	ThumbnailHeight int32 `json:"thumbnail_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultArticle) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultArticle) GetConstructor() string {
+	return ConstructorInputInlineQueryResultArticle
+}
+
+func (*InputInlineQueryResultArticle) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultArticle
+}
+
+func (entity *InputInlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultArticle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultArticle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultArticle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"thumbnail_width":`)
+	encoder.writeInt32(entity.ThumbnailWidth)
+	encoder.writeRaw(`,"thumbnail_height":`)
+	encoder.writeInt32(entity.ThumbnailHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultArticle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "thumbnail_width":
+			entity.ThumbnailWidth, err = decoder.readInt32()
+
+		case "thumbnail_height":
+			entity.ThumbnailHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultArticle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultArticle) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a link to an MP3 audio file
+type InputInlineQueryResultAudio struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Title of the audio file
+	Title string `json:"title"`
+	// Performer of the audio file
+	Performer string `json:"performer"`
+	// The URL of the audio file
+	AudioUrl string `json:"audio_url"`
+	// Audio file duration, in seconds
+	AudioDuration int32 `json:"audio_duration"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageAudio, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultAudio) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultAudio) GetConstructor() string {
+	return ConstructorInputInlineQueryResultAudio
+}
+
+func (*InputInlineQueryResultAudio) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultAudio
+}
+
+func (entity *InputInlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"performer":`)
+	encoder.writeString(entity.Performer)
+	encoder.writeRaw(`,"audio_url":`)
+	encoder.writeString(entity.AudioUrl)
+	encoder.writeRaw(`,"audio_duration":`)
+	encoder.writeInt32(entity.AudioDuration)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "performer":
+			entity.Performer, err = decoder.readString()
+
+		case "audio_url":
+			entity.AudioUrl, err = decoder.readString()
+
+		case "audio_duration":
+			entity.AudioDuration, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultAudio) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a user contact
+type InputInlineQueryResultContact struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// User contact
+	Contact *Contact `json:"contact"`
+	// URL of the result thumbnail, if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Thumbnail width, if known
+	ThumbnailWidth int32 `json:"thumbnail_width"`
+	// Thumbnail height, if known
+	ThumbnailHeight int32 `json:"thumbnail_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultContact) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultContact) GetConstructor() string {
+	return ConstructorInputInlineQueryResultContact
+}
+
+func (*InputInlineQueryResultContact) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultContact
+}
+
+func (entity *InputInlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultContact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultContact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultContact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"contact":`)
+	entity.Contact.encodeJSON(encoder)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"thumbnail_width":`)
+	encoder.writeInt32(entity.ThumbnailWidth)
+	encoder.writeRaw(`,"thumbnail_height":`)
+	encoder.writeInt32(entity.ThumbnailHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultContact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "contact":
+			entity.Contact, err = decodeEntity[Contact](decoder)
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "thumbnail_width":
+			entity.ThumbnailWidth, err = decoder.readInt32()
+
+		case "thumbnail_height":
+			entity.ThumbnailHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultContact)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultContact) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("contact", entity.Contact); err != nil {
+		return err
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a link to a file
+type InputInlineQueryResultDocument struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Title of the resulting file
+	Title string `json:"title"`
+	// Short description of the result, if known
+	Description string `json:"description"`
+	// URL of the file
+	DocumentUrl string `json:"document_url"`
+	// MIME type of the file content; only "application/pdf" and "application/zip" are currently allowed
+	MimeType string `json:"mime_type"`
+	// The URL of the file thumbnail, if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Width of the thumbnail
+	ThumbnailWidth int32 `json:"thumbnail_width"`
+	// Height of the thumbnail
+	ThumbnailHeight int32 `json:"thumbnail_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageDocument, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultDocument) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultDocument) GetConstructor() string {
+	return ConstructorInputInlineQueryResultDocument
+}
+
+func (*InputInlineQueryResultDocument) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultDocument
+}
+
+func (entity *InputInlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"document_url":`)
+	encoder.writeString(entity.DocumentUrl)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"thumbnail_width":`)
+	encoder.writeInt32(entity.ThumbnailWidth)
+	encoder.writeRaw(`,"thumbnail_height":`)
+	encoder.writeInt32(entity.ThumbnailHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "document_url":
+			entity.DocumentUrl, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "thumbnail_width":
+			entity.ThumbnailWidth, err = decoder.readInt32()
+
+		case "thumbnail_height":
+			entity.ThumbnailHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultDocument) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a game
+type InputInlineQueryResultGame struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Short name of the game
+	GameShortName string `json:"game_short_name"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (*InputInlineQueryResultGame) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultGame) GetConstructor() string {
+	return ConstructorInputInlineQueryResultGame
+}
+
+func (*InputInlineQueryResultGame) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultGame
+}
+
+func (entity *InputInlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"game_short_name":`)
+	encoder.writeString(entity.GameShortName)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "game_short_name":
+			entity.GameShortName, err = decoder.readString()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultGame) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a point on the map
+type InputInlineQueryResultLocation struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Location result
+	Location *Location `json:"location"`
+	// Amount of time relative to the message sent time until the location can be updated, in seconds
+	LivePeriod int32 `json:"live_period"`
+	// Title of the result
+	Title string `json:"title"`
+	// URL of the result thumbnail, if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Thumbnail width, if known
+	ThumbnailWidth int32 `json:"thumbnail_width"`
+	// Thumbnail height, if known
+	ThumbnailHeight int32 `json:"thumbnail_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultLocation) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultLocation) GetConstructor() string {
+	return ConstructorInputInlineQueryResultLocation
+}
+
+func (*InputInlineQueryResultLocation) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultLocation
+}
+
+func (entity *InputInlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"live_period":`)
+	encoder.writeInt32(entity.LivePeriod)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"thumbnail_width":`)
+	encoder.writeInt32(entity.ThumbnailWidth)
+	encoder.writeRaw(`,"thumbnail_height":`)
+	encoder.writeInt32(entity.ThumbnailHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "live_period":
+			entity.LivePeriod, err = decoder.readInt32()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "thumbnail_width":
+			entity.ThumbnailWidth, err = decoder.readInt32()
+
+		case "thumbnail_height":
+			entity.ThumbnailHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultLocation) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents link to a JPEG image
+type InputInlineQueryResultPhoto struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Title of the result, if known
+	Title string `json:"title"`
+	// A short description of the result, if known
+	Description string `json:"description"`
+	// URL of the photo thumbnail, if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// The URL of the JPEG photo (photo size must not exceed 5MB)
+	PhotoUrl string `json:"photo_url"`
+	// Width of the photo
+	PhotoWidth int32 `json:"photo_width"`
+	// Height of the photo
+	PhotoHeight int32 `json:"photo_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessagePhoto, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultPhoto) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultPhoto) GetConstructor() string {
+	return ConstructorInputInlineQueryResultPhoto
+}
+
+func (*InputInlineQueryResultPhoto) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultPhoto
+}
+
+func (entity *InputInlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"photo_url":`)
+	encoder.writeString(entity.PhotoUrl)
+	encoder.writeRaw(`,"photo_width":`)
+	encoder.writeInt32(entity.PhotoWidth)
+	encoder.writeRaw(`,"photo_height":`)
+	encoder.writeInt32(entity.PhotoHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "photo_url":
+			entity.PhotoUrl, err = decoder.readString()
+
+		case "photo_width":
+			entity.PhotoWidth, err = decoder.readInt32()
+
+		case "photo_height":
+			entity.PhotoHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultPhoto) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a link to a WEBP, TGS, or WEBM sticker
+type InputInlineQueryResultSticker struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// URL of the sticker thumbnail, if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// The URL of the WEBP, TGS, or WEBM sticker (sticker file size must not exceed 5MB)
+	StickerUrl string `json:"sticker_url"`
+	// Width of the sticker
+	StickerWidth int32 `json:"sticker_width"`
+	// Height of the sticker
+	StickerHeight int32 `json:"sticker_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageSticker, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultSticker) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultSticker) GetConstructor() string {
+	return ConstructorInputInlineQueryResultSticker
+}
+
+func (*InputInlineQueryResultSticker) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultSticker
+}
+
+func (entity *InputInlineQueryResultSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"sticker_url":`)
+	encoder.writeString(entity.StickerUrl)
+	encoder.writeRaw(`,"sticker_width":`)
+	encoder.writeInt32(entity.StickerWidth)
+	encoder.writeRaw(`,"sticker_height":`)
+	encoder.writeInt32(entity.StickerHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "sticker_url":
+			entity.StickerUrl, err = decoder.readString()
+
+		case "sticker_width":
+			entity.StickerWidth, err = decoder.readInt32()
+
+		case "sticker_height":
+			entity.StickerHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultSticker) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents information about a venue
+type InputInlineQueryResultVenue struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Venue result
+	Venue *Venue `json:"venue"`
+	// URL of the result thumbnail, if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Thumbnail width, if known
+	ThumbnailWidth int32 `json:"thumbnail_width"`
+	// Thumbnail height, if known
+	ThumbnailHeight int32 `json:"thumbnail_height"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultVenue) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultVenue) GetConstructor() string {
+	return ConstructorInputInlineQueryResultVenue
+}
+
+func (*InputInlineQueryResultVenue) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultVenue
+}
+
+func (entity *InputInlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultVenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultVenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultVenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"venue":`)
+	entity.Venue.encodeJSON(encoder)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"thumbnail_width":`)
+	encoder.writeInt32(entity.ThumbnailWidth)
+	encoder.writeRaw(`,"thumbnail_height":`)
+	encoder.writeInt32(entity.ThumbnailHeight)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultVenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "venue":
+			entity.Venue, err = decodeEntity[Venue](decoder)
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "thumbnail_width":
+			entity.ThumbnailWidth, err = decoder.readInt32()
+
+		case "thumbnail_height":
+			entity.ThumbnailHeight, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultVenue) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a link to a page containing an embedded video player or a video file
+type InputInlineQueryResultVideo struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Title of the result
+	Title string `json:"title"`
+	// A short description of the result, if known
+	Description string `json:"description"`
+	// The URL of the video thumbnail (JPEG), if it exists
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// URL of the embedded video player or video file
+	VideoUrl string `json:"video_url"`
+	// MIME type of the content of the video URL, only "text/html" or "video/mp4" are currently supported
+	MimeType string `json:"mime_type"`
+	// Width of the video
+	VideoWidth int32 `json:"video_width"`
+	// Height of the video
+	VideoHeight int32 `json:"video_height"`
+	// Video duration, in seconds
+	VideoDuration int32 `json:"video_duration"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageVideo, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultVideo) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultVideo) GetConstructor() string {
+	return ConstructorInputInlineQueryResultVideo
+}
+
+func (*InputInlineQueryResultVideo) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultVideo
+}
+
+func (entity *InputInlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"thumbnail_url":`)
+	encoder.writeString(entity.ThumbnailUrl)
+	encoder.writeRaw(`,"video_url":`)
+	encoder.writeString(entity.VideoUrl)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	encoder.writeRaw(`,"video_width":`)
+	encoder.writeInt32(entity.VideoWidth)
+	encoder.writeRaw(`,"video_height":`)
+	encoder.writeInt32(entity.VideoHeight)
+	encoder.writeRaw(`,"video_duration":`)
+	encoder.writeInt32(entity.VideoDuration)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "thumbnail_url":
+			entity.ThumbnailUrl, err = decoder.readString()
+
+		case "video_url":
+			entity.VideoUrl, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "video_width":
+			entity.VideoWidth, err = decoder.readInt32()
+
+		case "video_height":
+			entity.VideoHeight, err = decoder.readInt32()
+
+		case "video_duration":
+			entity.VideoDuration, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultVideo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a link to an opus-encoded audio file within an OGG container, single channel audio
+type InputInlineQueryResultVoiceNote struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Title of the voice note
+	Title string `json:"title"`
+	// The URL of the voice note file
+	VoiceNoteUrl string `json:"voice_note_url"`
+	// Duration of the voice note, in seconds
+	VoiceNoteDuration int32 `json:"voice_note_duration"`
+	// The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+	// The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageVoiceNote, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+}
+
+func (*InputInlineQueryResultVoiceNote) GetType() string {
+	return TypeInputInlineQueryResult
+}
+
+func (*InputInlineQueryResultVoiceNote) GetConstructor() string {
+	return ConstructorInputInlineQueryResultVoiceNote
+}
+
+func (*InputInlineQueryResultVoiceNote) InputInlineQueryResultConstructor() string {
+	return ConstructorInputInlineQueryResultVoiceNote
+}
+
+func (entity *InputInlineQueryResultVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInlineQueryResultVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInlineQueryResultVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInlineQueryResultVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"voice_note_url":`)
+	encoder.writeString(entity.VoiceNoteUrl)
+	encoder.writeRaw(`,"voice_note_duration":`)
+	encoder.writeInt32(entity.VoiceNoteDuration)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw(`,"input_message_content":`)
+	encoder.writeType(entity.InputMessageContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInlineQueryResultVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "voice_note_url":
+			entity.VoiceNoteUrl, err = decoder.readString()
+
+		case "voice_note_duration":
+			entity.VoiceNoteDuration, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		case "input_message_content":
+			entity.InputMessageContent, err = decodeInputMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInlineQueryResultVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInlineQueryResultVoiceNote) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	if err := validateNested("input_message_content", entity.InputMessageContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a link to an article or web page
+type InlineQueryResultArticle struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// URL of the result, if it exists
+	Url string `json:"url"`
+	// Title of the result
+	Title string `json:"title"`
+	// A short description of the result
+	Description string `json:"description"`
+	// Result thumbnail in JPEG format; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+}
+
+func (*InlineQueryResultArticle) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultArticle) GetConstructor() string {
+	return ConstructorInlineQueryResultArticle
+}
+
+func (*InlineQueryResultArticle) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultArticle
+}
+
+func (entity *InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultArticle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultArticle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultArticle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultArticle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultArticle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a user contact
+type InlineQueryResultContact struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// A user contact
+	Contact *Contact `json:"contact"`
+	// Result thumbnail in JPEG format; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+}
+
+func (*InlineQueryResultContact) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultContact) GetConstructor() string {
+	return ConstructorInlineQueryResultContact
+}
+
+func (*InlineQueryResultContact) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultContact
+}
+
+func (entity *InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultContact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultContact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultContact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"contact":`)
+	entity.Contact.encodeJSON(encoder)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultContact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "contact":
+			entity.Contact, err = decodeEntity[Contact](decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultContact)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InlineQueryResultContact) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("contact", entity.Contact); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a point on the map
+type InlineQueryResultLocation struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Location result
+	Location *Location `json:"location"`
+	// Title of the result
+	Title string `json:"title"`
+	// Result thumbnail in JPEG format; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+}
+
+func (*InlineQueryResultLocation) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultLocation) GetConstructor() string {
+	return ConstructorInlineQueryResultLocation
+}
+
+func (*InlineQueryResultLocation) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultLocation
+}
+
+func (entity *InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents information about a venue
+type InlineQueryResultVenue struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Venue result
+	Venue *Venue `json:"venue"`
+	// Result thumbnail in JPEG format; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+}
+
+func (*InlineQueryResultVenue) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultVenue) GetConstructor() string {
+	return ConstructorInlineQueryResultVenue
+}
+
+func (*InlineQueryResultVenue) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultVenue
+}
+
+func (entity *InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultVenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultVenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultVenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"venue":`)
+	entity.Venue.encodeJSON(encoder)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultVenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "venue":
+			entity.Venue, err = decodeEntity[Venue](decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents information about a game
+type InlineQueryResultGame struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Game result
+	Game *Game `json:"game"`
+}
+
+func (*InlineQueryResultGame) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultGame) GetConstructor() string {
+	return ConstructorInlineQueryResultGame
+}
+
+func (*InlineQueryResultGame) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultGame
+}
+
+func (entity *InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"game":`)
+	entity.Game.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "game":
+			entity.Game, err = decodeEntity[Game](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an animation file
+type InlineQueryResultAnimation struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Animation file
+	Animation *Animation `json:"animation"`
+	// Animation title
+	Title string `json:"title"`
+}
+
+func (*InlineQueryResultAnimation) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultAnimation) GetConstructor() string {
+	return ConstructorInlineQueryResultAnimation
+}
+
+func (*InlineQueryResultAnimation) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultAnimation
+}
+
+func (entity *InlineQueryResultAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"animation":`)
+	entity.Animation.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an audio file
+type InlineQueryResultAudio struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Audio file
+	Audio *Audio `json:"audio"`
+}
+
+func (*InlineQueryResultAudio) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultAudio) GetConstructor() string {
+	return ConstructorInlineQueryResultAudio
+}
+
+func (*InlineQueryResultAudio) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultAudio
+}
+
+func (entity *InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"audio":`)
+	entity.Audio.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "audio":
+			entity.Audio, err = decodeEntity[Audio](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a document
+type InlineQueryResultDocument struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Document
+	Document *Document `json:"document"`
+	// Document title
+	Title string `json:"title"`
+	// Document description
+	Description string `json:"description"`
+}
+
+func (*InlineQueryResultDocument) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultDocument) GetConstructor() string {
+	return ConstructorInlineQueryResultDocument
+}
+
+func (*InlineQueryResultDocument) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultDocument
+}
+
+func (entity *InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"document":`)
+	entity.Document.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "document":
+			entity.Document, err = decodeEntity[Document](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a photo
+type InlineQueryResultPhoto struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Photo
+	Photo *Photo `json:"photo"`
+	// Title of the result, if known
+	Title string `json:"title"`
+	// A short description of the result, if known
+	Description string `json:"description"`
+}
+
+func (*InlineQueryResultPhoto) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultPhoto) GetConstructor() string {
+	return ConstructorInlineQueryResultPhoto
+}
+
+func (*InlineQueryResultPhoto) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultPhoto
+}
+
+func (entity *InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a sticker
+type InlineQueryResultSticker struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Sticker
+	Sticker *Sticker `json:"sticker"`
+}
+
+func (*InlineQueryResultSticker) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultSticker) GetConstructor() string {
+	return ConstructorInlineQueryResultSticker
+}
+
+func (*InlineQueryResultSticker) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultSticker
+}
+
+func (entity *InlineQueryResultSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a video
+type InlineQueryResultVideo struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Video
+	Video *Video `json:"video"`
+	// Title of the video
+	Title string `json:"title"`
+	// Description of the video
+	Description string `json:"description"`
+}
+
+func (*InlineQueryResultVideo) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultVideo) GetConstructor() string {
+	return ConstructorInlineQueryResultVideo
+}
+
+func (*InlineQueryResultVideo) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultVideo
+}
+
+func (entity *InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "video":
+			entity.Video, err = decodeEntity[Video](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a voice note
+type InlineQueryResultVoiceNote struct {
+	meta
+	// Unique identifier of the query result
+	Id string `json:"id"`
+	// Voice note
+	VoiceNote *VoiceNote `json:"voice_note"`
+	// Title of the voice note
+	Title string `json:"title"`
+}
+
+func (*InlineQueryResultVoiceNote) GetType() string {
+	return TypeInlineQueryResult
+}
+
+func (*InlineQueryResultVoiceNote) GetConstructor() string {
+	return ConstructorInlineQueryResultVoiceNote
+}
+
+func (*InlineQueryResultVoiceNote) InlineQueryResultConstructor() string {
+	return ConstructorInlineQueryResultVoiceNote
+}
+
+func (entity *InlineQueryResultVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"voice_note":`)
+	entity.VoiceNote.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "voice_note":
+			entity.VoiceNote, err = decodeEntity[VoiceNote](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes the button that opens a private chat with the bot and sends a start message to the bot with the given parameter
+type InlineQueryResultsButtonTypeStartBot struct {
+	meta
+	// The parameter for the bot start message
+	Parameter string `json:"parameter"`
+}
+
+func (*InlineQueryResultsButtonTypeStartBot) GetType() string {
+	return TypeInlineQueryResultsButtonType
+}
+
+func (*InlineQueryResultsButtonTypeStartBot) GetConstructor() string {
+	return ConstructorInlineQueryResultsButtonTypeStartBot
+}
+
+func (*InlineQueryResultsButtonTypeStartBot) InlineQueryResultsButtonTypeConstructor() string {
+	return ConstructorInlineQueryResultsButtonTypeStartBot
+}
+
+func (entity *InlineQueryResultsButtonTypeStartBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultsButtonTypeStartBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultsButtonTypeStartBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultsButtonTypeStartBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"parameter":`)
+	encoder.writeString(entity.Parameter)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultsButtonTypeStartBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "parameter":
+			entity.Parameter, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultsButtonTypeStartBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes the button that opens a Web App by calling getWebAppUrl
+type InlineQueryResultsButtonTypeWebApp struct {
+	meta
+	// An HTTP URL to pass to getWebAppUrl
+	Url string `json:"url"`
+}
+
+func (*InlineQueryResultsButtonTypeWebApp) GetType() string {
+	return TypeInlineQueryResultsButtonType
+}
+
+func (*InlineQueryResultsButtonTypeWebApp) GetConstructor() string {
+	return ConstructorInlineQueryResultsButtonTypeWebApp
+}
+
+func (*InlineQueryResultsButtonTypeWebApp) InlineQueryResultsButtonTypeConstructor() string {
+	return ConstructorInlineQueryResultsButtonTypeWebApp
+}
+
+func (entity *InlineQueryResultsButtonTypeWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultsButtonTypeWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultsButtonTypeWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultsButtonTypeWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultsButtonTypeWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultsButtonTypeWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a button to be shown above inline query results
+type InlineQueryResultsButton struct {
+	meta
+	// The text of the button
+	Text string `json:"text"`
+	// Type of the button
+	Type InlineQueryResultsButtonType `json:"type"`
+}
+
+func (*InlineQueryResultsButton) GetType() string {
+	return TypeInlineQueryResultsButton
+}
+
+func (*InlineQueryResultsButton) GetConstructor() string {
+	return ConstructorInlineQueryResultsButton
+}
+
+func (entity *InlineQueryResultsButton) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResultsButton) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResultsButton) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResultsButton"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResultsButton) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "type":
+			entity.Type, err = decodeInlineQueryResultsButtonType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResultsButton)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents the results of the inline query. Use sendInlineQueryResultMessage to send the result of the query
+type InlineQueryResults struct {
+	meta
+	// Unique identifier of the inline query
+	InlineQueryId JsonInt64 `json:"inline_query_id"`
+	// Button to be shown above inline query results; may be null
+	Button *InlineQueryResultsButton `json:"button,omitempty"`
+	// Results of the query
+	Results []InlineQueryResult `json:"results"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*InlineQueryResults) GetType() string {
+	return TypeInlineQueryResults
+}
+
+func (*InlineQueryResults) GetConstructor() string {
+	return ConstructorInlineQueryResults
+}
+
+func (entity *InlineQueryResults) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineQueryResults) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineQueryResults) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineQueryResults"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"inline_query_id":`)
+	encoder.writeJsonInt64(entity.InlineQueryId)
+	if entity.Button != nil {
+		encoder.writeRaw(`,"button":`)
+		entity.Button.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"results":`)
+	writeList(encoder, entity.Results, writeTypeValue[InlineQueryResult])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineQueryResults) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "inline_query_id":
+			entity.InlineQueryId, err = decoder.readJsonInt64()
+
+		case "button":
+			entity.Button, err = decodeEntity[InlineQueryResultsButton](decoder)
+
+		case "results":
+			entity.Results, err = readList(decoder, decodeInlineQueryResult)
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineQueryResults)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InlineQueryResults) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Results {
+		if err := validateNested(indexField("results", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The payload for a general callback button
+type CallbackQueryPayloadData struct {
+	meta
+	// Data that was attached to the callback button
+	Data []byte `json:"data"`
+}
+
+func (*CallbackQueryPayloadData) GetType() string {
+	return TypeCallbackQueryPayload
+}
+
+func (*CallbackQueryPayloadData) GetConstructor() string {
+	return ConstructorCallbackQueryPayloadData
+}
+
+func (*CallbackQueryPayloadData) CallbackQueryPayloadConstructor() string {
+	return ConstructorCallbackQueryPayloadData
+}
+
+func (entity *CallbackQueryPayloadData) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallbackQueryPayloadData) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallbackQueryPayloadData) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callbackQueryPayloadData"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallbackQueryPayloadData) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallbackQueryPayloadData)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The payload for a callback button requiring password
+type CallbackQueryPayloadDataWithPassword struct {
+	meta
+	// The 2-step verification password for the current user
+	Password string `json:"password"`
+	// Data that was attached to the callback button
+	Data []byte `json:"data"`
+}
+
+func (*CallbackQueryPayloadDataWithPassword) GetType() string {
+	return TypeCallbackQueryPayload
+}
+
+func (*CallbackQueryPayloadDataWithPassword) GetConstructor() string {
+	return ConstructorCallbackQueryPayloadDataWithPassword
+}
+
+func (*CallbackQueryPayloadDataWithPassword) CallbackQueryPayloadConstructor() string {
+	return ConstructorCallbackQueryPayloadDataWithPassword
+}
+
+func (entity *CallbackQueryPayloadDataWithPassword) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallbackQueryPayloadDataWithPassword) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallbackQueryPayloadDataWithPassword) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callbackQueryPayloadDataWithPassword"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(entity.Password)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallbackQueryPayloadDataWithPassword) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "password":
+			entity.Password, err = decoder.readString()
+
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallbackQueryPayloadDataWithPassword)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The payload for a game callback button
+type CallbackQueryPayloadGame struct {
+	meta
+	// A short name of the game that was attached to the callback button
+	GameShortName string `json:"game_short_name"`
+}
+
+func (*CallbackQueryPayloadGame) GetType() string {
+	return TypeCallbackQueryPayload
+}
+
+func (*CallbackQueryPayloadGame) GetConstructor() string {
+	return ConstructorCallbackQueryPayloadGame
+}
+
+func (*CallbackQueryPayloadGame) CallbackQueryPayloadConstructor() string {
+	return ConstructorCallbackQueryPayloadGame
+}
+
+func (entity *CallbackQueryPayloadGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallbackQueryPayloadGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallbackQueryPayloadGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callbackQueryPayloadGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"game_short_name":`)
+	encoder.writeString(entity.GameShortName)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallbackQueryPayloadGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "game_short_name":
+			entity.GameShortName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallbackQueryPayloadGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a bot's answer to a callback query
+type CallbackQueryAnswer struct {
+	meta
+	// Text of the answer
+	Text string `json:"text"`
+	// True, if an alert must be shown to the user instead of a toast notification
+	ShowAlert bool `json:"show_alert"`
+	// URL to be opened
+	Url string `json:"url"`
+}
+
+func (*CallbackQueryAnswer) GetType() string {
+	return TypeCallbackQueryAnswer
+}
+
+func (*CallbackQueryAnswer) GetConstructor() string {
+	return ConstructorCallbackQueryAnswer
+}
+
+func (entity *CallbackQueryAnswer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallbackQueryAnswer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallbackQueryAnswer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callbackQueryAnswer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"show_alert":`)
+	encoder.writeBool(entity.ShowAlert)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallbackQueryAnswer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "show_alert":
+			entity.ShowAlert, err = decoder.readBool()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallbackQueryAnswer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains one row of the game high score table
+type GameHighScore struct {
+	meta
+	// Position in the high score table
+	Position int32 `json:"position"`
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// User score
+	Score int32 `json:"score"`
+}
+
+func (*GameHighScore) GetType() string {
+	return TypeGameHighScore
+}
+
+func (*GameHighScore) GetConstructor() string {
+	return ConstructorGameHighScore
+}
+
+func (entity *GameHighScore) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GameHighScore) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GameHighScore) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"gameHighScore"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"position":`)
+	encoder.writeInt32(entity.Position)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"score":`)
+	encoder.writeInt32(entity.Score)
+	encoder.writeRaw("}")
+}
+
+func (entity *GameHighScore) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "position":
+			entity.Position, err = decoder.readInt32()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "score":
+			entity.Score, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGameHighScore)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of game high scores
+type GameHighScores struct {
+	meta
+	// A list of game high scores
+	Scores []*GameHighScore `json:"scores"`
+}
+
+func (*GameHighScores) GetType() string {
+	return TypeGameHighScores
+}
+
+func (*GameHighScores) GetConstructor() string {
+	return ConstructorGameHighScores
+}
+
+func (entity *GameHighScores) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GameHighScores) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GameHighScores) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"gameHighScores"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"scores":`)
+	writeList(encoder, entity.Scores, writeTypeValue[*GameHighScore])
+	encoder.writeRaw("}")
+}
+
+func (entity *GameHighScores) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "scores":
+			entity.Scores, err = readList(decoder, decodeEntity[GameHighScore])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGameHighScores)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A scope covering all users
+type BotCommandScopeDefault struct {
+	meta
+}
+
+func (*BotCommandScopeDefault) GetType() string {
+	return TypeBotCommandScope
+}
+
+func (*BotCommandScopeDefault) GetConstructor() string {
+	return ConstructorBotCommandScopeDefault
+}
+
+func (*BotCommandScopeDefault) BotCommandScopeConstructor() string {
+	return ConstructorBotCommandScopeDefault
+}
+
+func (entity *BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommandScopeDefault) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommandScopeDefault) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommandScopeDefault"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommandScopeDefault) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBotCommandScopeDefault)
+	})
+}
+
+// A scope covering all private chats
+type BotCommandScopeAllPrivateChats struct {
+	meta
+}
+
+func (*BotCommandScopeAllPrivateChats) GetType() string {
+	return TypeBotCommandScope
+}
+
+func (*BotCommandScopeAllPrivateChats) GetConstructor() string {
+	return ConstructorBotCommandScopeAllPrivateChats
+}
+
+func (*BotCommandScopeAllPrivateChats) BotCommandScopeConstructor() string {
+	return ConstructorBotCommandScopeAllPrivateChats
+}
+
+func (entity *BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommandScopeAllPrivateChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommandScopeAllPrivateChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommandScopeAllPrivateChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommandScopeAllPrivateChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBotCommandScopeAllPrivateChats)
+	})
+}
+
+// A scope covering all group and supergroup chats
+type BotCommandScopeAllGroupChats struct {
+	meta
+}
+
+func (*BotCommandScopeAllGroupChats) GetType() string {
+	return TypeBotCommandScope
+}
+
+func (*BotCommandScopeAllGroupChats) GetConstructor() string {
+	return ConstructorBotCommandScopeAllGroupChats
+}
+
+func (*BotCommandScopeAllGroupChats) BotCommandScopeConstructor() string {
+	return ConstructorBotCommandScopeAllGroupChats
+}
+
+func (entity *BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommandScopeAllGroupChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommandScopeAllGroupChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommandScopeAllGroupChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommandScopeAllGroupChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBotCommandScopeAllGroupChats)
+	})
+}
+
+// A scope covering all group and supergroup chat administrators
+type BotCommandScopeAllChatAdministrators struct {
+	meta
+}
+
+func (*BotCommandScopeAllChatAdministrators) GetType() string {
+	return TypeBotCommandScope
+}
+
+func (*BotCommandScopeAllChatAdministrators) GetConstructor() string {
+	return ConstructorBotCommandScopeAllChatAdministrators
+}
+
+func (*BotCommandScopeAllChatAdministrators) BotCommandScopeConstructor() string {
+	return ConstructorBotCommandScopeAllChatAdministrators
+}
+
+func (entity *BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommandScopeAllChatAdministrators) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommandScopeAllChatAdministrators) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommandScopeAllChatAdministrators"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommandScopeAllChatAdministrators) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBotCommandScopeAllChatAdministrators)
+	})
+}
+
+// A scope covering all members of a chat
+type BotCommandScopeChat struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*BotCommandScopeChat) GetType() string {
+	return TypeBotCommandScope
+}
+
+func (*BotCommandScopeChat) GetConstructor() string {
+	return ConstructorBotCommandScopeChat
+}
+
+func (*BotCommandScopeChat) BotCommandScopeConstructor() string {
+	return ConstructorBotCommandScopeChat
+}
+
+func (entity *BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommandScopeChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommandScopeChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommandScopeChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommandScopeChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotCommandScopeChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A scope covering all administrators of a chat
+type BotCommandScopeChatAdministrators struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*BotCommandScopeChatAdministrators) GetType() string {
+	return TypeBotCommandScope
+}
+
+func (*BotCommandScopeChatAdministrators) GetConstructor() string {
+	return ConstructorBotCommandScopeChatAdministrators
+}
+
+func (*BotCommandScopeChatAdministrators) BotCommandScopeConstructor() string {
+	return ConstructorBotCommandScopeChatAdministrators
+}
+
+func (entity *BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommandScopeChatAdministrators) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommandScopeChatAdministrators) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommandScopeChatAdministrators"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommandScopeChatAdministrators) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotCommandScopeChatAdministrators)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A scope covering a member of a chat
+type BotCommandScopeChatMember struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (*BotCommandScopeChatMember) GetType() string {
+	return TypeBotCommandScope
+}
+
+func (*BotCommandScopeChatMember) GetConstructor() string {
+	return ConstructorBotCommandScopeChatMember
+}
+
+func (*BotCommandScopeChatMember) BotCommandScopeConstructor() string {
+	return ConstructorBotCommandScopeChatMember
+}
+
+func (entity *BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BotCommandScopeChatMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BotCommandScopeChatMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"botCommandScopeChatMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *BotCommandScopeChatMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBotCommandScopeChatMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,2290 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeBusinessAwayMessageSchedule       = "BusinessAwayMessageSchedule"
+	TypeBusinessFeature                   = "BusinessFeature"
+	TypeBusinessLocation                  = "BusinessLocation"
+	TypeBusinessRecipients                = "BusinessRecipients"
+	TypeBusinessAwayMessageSettings       = "BusinessAwayMessageSettings"
+	TypeBusinessGreetingMessageSettings   = "BusinessGreetingMessageSettings"
+	TypeBusinessBotRights                 = "BusinessBotRights"
+	TypeBusinessConnectedBot              = "BusinessConnectedBot"
+	TypeBusinessStartPage                 = "BusinessStartPage"
+	TypeInputBusinessStartPage            = "InputBusinessStartPage"
+	TypeBusinessOpeningHoursInterval      = "BusinessOpeningHoursInterval"
+	TypeBusinessOpeningHours              = "BusinessOpeningHours"
+	TypeBusinessInfo                      = "BusinessInfo"
+	TypeBusinessChatLink                  = "BusinessChatLink"
+	TypeBusinessChatLinks                 = "BusinessChatLinks"
+	TypeInputBusinessChatLink             = "InputBusinessChatLink"
+	TypeBusinessChatLinkInfo              = "BusinessChatLinkInfo"
+	TypeBusinessMessage                   = "BusinessMessage"
+	TypeBusinessMessages                  = "BusinessMessages"
+	TypeBusinessBotManageBar              = "BusinessBotManageBar"
+	TypeBusinessConnection                = "BusinessConnection"
+	TypeBusinessFeatures                  = "BusinessFeatures"
+	TypeBusinessFeaturePromotionAnimation = "BusinessFeaturePromotionAnimation"
+)
+
+const (
+	ConstructorBusinessAwayMessageScheduleAlways                = "businessAwayMessageScheduleAlways"
+	ConstructorBusinessAwayMessageScheduleOutsideOfOpeningHours = "businessAwayMessageScheduleOutsideOfOpeningHours"
+	ConstructorBusinessAwayMessageScheduleCustom                = "businessAwayMessageScheduleCustom"
+	ConstructorBusinessLocation                                 = "businessLocation"
+	ConstructorBusinessRecipients                               = "businessRecipients"
+	ConstructorBusinessAwayMessageSettings                      = "businessAwayMessageSettings"
+	ConstructorBusinessGreetingMessageSettings                  = "businessGreetingMessageSettings"
+	ConstructorBusinessBotRights                                = "businessBotRights"
+	ConstructorBusinessConnectedBot                             = "businessConnectedBot"
+	ConstructorBusinessStartPage                                = "businessStartPage"
+	ConstructorInputBusinessStartPage                           = "inputBusinessStartPage"
+	ConstructorBusinessOpeningHoursInterval                     = "businessOpeningHoursInterval"
+	ConstructorBusinessOpeningHours                             = "businessOpeningHours"
+	ConstructorBusinessInfo                                     = "businessInfo"
+	ConstructorBusinessChatLink                                 = "businessChatLink"
+	ConstructorBusinessChatLinks                                = "businessChatLinks"
+	ConstructorInputBusinessChatLink                            = "inputBusinessChatLink"
+	ConstructorBusinessChatLinkInfo                             = "businessChatLinkInfo"
+	ConstructorBusinessMessage                                  = "businessMessage"
+	ConstructorBusinessMessages                                 = "businessMessages"
+	ConstructorBusinessBotManageBar                             = "businessBotManageBar"
+	ConstructorBusinessConnection                               = "businessConnection"
+	ConstructorBusinessFeatureLocation                          = "businessFeatureLocation"
+	ConstructorBusinessFeatureOpeningHours                      = "businessFeatureOpeningHours"
+	ConstructorBusinessFeatureQuickReplies                      = "businessFeatureQuickReplies"
+	ConstructorBusinessFeatureGreetingMessage                   = "businessFeatureGreetingMessage"
+	ConstructorBusinessFeatureAwayMessage                       = "businessFeatureAwayMessage"
+	ConstructorBusinessFeatureAccountLinks                      = "businessFeatureAccountLinks"
+	ConstructorBusinessFeatureStartPage                         = "businessFeatureStartPage"
+	ConstructorBusinessFeatureBots                              = "businessFeatureBots"
+	ConstructorBusinessFeatureEmojiStatus                       = "businessFeatureEmojiStatus"
+	ConstructorBusinessFeatureChatFolderTags                    = "businessFeatureChatFolderTags"
+	ConstructorBusinessFeatureUpgradedStories                   = "businessFeatureUpgradedStories"
+	ConstructorBusinessFeatures                                 = "businessFeatures"
+	ConstructorBusinessFeaturePromotionAnimation                = "businessFeaturePromotionAnimation"
+)
+
+// Describes conditions for sending of away messages by a Telegram Business account
+type BusinessAwayMessageSchedule interface {
+	BusinessAwayMessageScheduleConstructor() string
+}
+
+// Describes a feature available to Business user accounts
+type BusinessFeature interface {
+	BusinessFeatureConstructor() string
+}
+
+func (entity *UnknownType) BusinessAwayMessageScheduleConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) BusinessFeatureConstructor() string {
+	return entity.Constructor
+}
+
+// Send away messages always
+type BusinessAwayMessageScheduleAlways struct {
+	meta
+}
+
+func (*BusinessAwayMessageScheduleAlways) GetType() string {
+	return TypeBusinessAwayMessageSchedule
+}
+
+func (*BusinessAwayMessageScheduleAlways) GetConstructor() string {
+	return ConstructorBusinessAwayMessageScheduleAlways
+}
+
+func (*BusinessAwayMessageScheduleAlways) BusinessAwayMessageScheduleConstructor() string {
+	return ConstructorBusinessAwayMessageScheduleAlways
+}
+
+func (entity *BusinessAwayMessageScheduleAlways) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessAwayMessageScheduleAlways) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessAwayMessageScheduleAlways) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessAwayMessageScheduleAlways"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessAwayMessageScheduleAlways) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessAwayMessageScheduleAlways)
+	})
+}
+
+// Send away messages outside of the business opening hours
+type BusinessAwayMessageScheduleOutsideOfOpeningHours struct {
+	meta
+}
+
+func (*BusinessAwayMessageScheduleOutsideOfOpeningHours) GetType() string {
+	return TypeBusinessAwayMessageSchedule
+}
+
+func (*BusinessAwayMessageScheduleOutsideOfOpeningHours) GetConstructor() string {
+	return ConstructorBusinessAwayMessageScheduleOutsideOfOpeningHours
+}
+
+func (*BusinessAwayMessageScheduleOutsideOfOpeningHours) BusinessAwayMessageScheduleConstructor() string {
+	return ConstructorBusinessAwayMessageScheduleOutsideOfOpeningHours
+}
+
+func (entity *BusinessAwayMessageScheduleOutsideOfOpeningHours) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessAwayMessageScheduleOutsideOfOpeningHours) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessAwayMessageScheduleOutsideOfOpeningHours) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessAwayMessageScheduleOutsideOfOpeningHours"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessAwayMessageScheduleOutsideOfOpeningHours) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessAwayMessageScheduleOutsideOfOpeningHours)
+	})
+}
+
+// Send away messages only in the specified time span
+type BusinessAwayMessageScheduleCustom struct {
+	meta
+	// Point in time (Unix timestamp) when the away messages will start to be sent
+	StartDate int32 `json:"start_date"`
+	// Point in time (Unix timestamp) when the away messages will stop to be sent
+	EndDate int32 `json:"end_date"`
+}
+
+func (*BusinessAwayMessageScheduleCustom) GetType() string {
+	return TypeBusinessAwayMessageSchedule
+}
+
+func (*BusinessAwayMessageScheduleCustom) GetConstructor() string {
+	return ConstructorBusinessAwayMessageScheduleCustom
+}
+
+func (*BusinessAwayMessageScheduleCustom) BusinessAwayMessageScheduleConstructor() string {
+	return ConstructorBusinessAwayMessageScheduleCustom
+}
+
+func (entity *BusinessAwayMessageScheduleCustom) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessAwayMessageScheduleCustom) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessAwayMessageScheduleCustom) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessAwayMessageScheduleCustom"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"start_date":`)
+	encoder.writeInt32(entity.StartDate)
+	encoder.writeRaw(`,"end_date":`)
+	encoder.writeInt32(entity.EndDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessAwayMessageScheduleCustom) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "start_date":
+			entity.StartDate, err = decoder.readInt32()
+
+		case "end_date":
+			entity.EndDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessAwayMessageScheduleCustom)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a location of a business
+type BusinessLocation struct {
+	meta
+	// The location; may be null if not specified
+	Location *Location `json:"location,omitempty"`
+	// Location address; 1-96 characters
+	Address string `json:"address"`
+}
+
+func (*BusinessLocation) GetType() string {
+	return TypeBusinessLocation
+}
+
+func (*BusinessLocation) GetConstructor() string {
+	return ConstructorBusinessLocation
+}
+
+func (entity *BusinessLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessLocation"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		entity.Location.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"address":`)
+	encoder.writeString(entity.Address)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "address":
+			entity.Address, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *BusinessLocation) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("address", entity.Address, 1, 96); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Describes private chats chosen for automatic interaction with a business
+type BusinessRecipients struct {
+	meta
+	// Identifiers of selected private chats
+	ChatIds []int64 `json:"chat_ids"`
+	// Identifiers of private chats that are always excluded; for businessConnectedBot only
+	ExcludedChatIds []int64 `json:"excluded_chat_ids"`
+	// True, if all existing private chats are selected
+	SelectExistingChats bool `json:"select_existing_chats"`
+	// True, if all new private chats are selected
+	SelectNewChats bool `json:"select_new_chats"`
+	// True, if all private chats with contacts are selected
+	SelectContacts bool `json:"select_contacts"`
+	// True, if all private chats with non-contacts are selected
+	SelectNonContacts bool `json:"select_non_contacts"`
+	// If true, then all private chats except the selected are chosen. Otherwise, only the selected chats are chosen
+	ExcludeSelected bool `json:"exclude_selected"`
+}
+
+func (*BusinessRecipients) GetType() string {
+	return TypeBusinessRecipients
+}
+
+func (*BusinessRecipients) GetConstructor() string {
+	return ConstructorBusinessRecipients
+}
+
+func (entity *BusinessRecipients) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessRecipients) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessRecipients) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessRecipients"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, entity.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"excluded_chat_ids":`)
+	writeList(encoder, entity.ExcludedChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"select_existing_chats":`)
+	encoder.writeBool(entity.SelectExistingChats)
+	encoder.writeRaw(`,"select_new_chats":`)
+	encoder.writeBool(entity.SelectNewChats)
+	encoder.writeRaw(`,"select_contacts":`)
+	encoder.writeBool(entity.SelectContacts)
+	encoder.writeRaw(`,"select_non_contacts":`)
+	encoder.writeBool(entity.SelectNonContacts)
+	encoder.writeRaw(`,"exclude_selected":`)
+	encoder.writeBool(entity.ExcludeSelected)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessRecipients) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_ids":
+			entity.ChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "excluded_chat_ids":
+			entity.ExcludedChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "select_existing_chats":
+			entity.SelectExistingChats, err = decoder.readBool()
+
+		case "select_new_chats":
+			entity.SelectNewChats, err = decoder.readBool()
+
+		case "select_contacts":
+			entity.SelectContacts, err = decoder.readBool()
+
+		case "select_non_contacts":
+			entity.SelectNonContacts, err = decoder.readBool()
+
+		case "exclude_selected":
+			entity.ExcludeSelected, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessRecipients)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes settings for messages that are automatically sent by a Telegram Business account when it is away
+type BusinessAwayMessageSettings struct {
+	meta
+	// Unique quick reply shortcut identifier for the away messages
+	ShortcutId int32 `json:"shortcut_id"`
+	// Chosen recipients of the away messages
+	Recipients *BusinessRecipients `json:"recipients"`
+	// Settings used to check whether the current user is away
+	Schedule BusinessAwayMessageSchedule `json:"schedule"`
+	// True, if the messages must not be sent if the account was online in the last 10 minutes
+	OfflineOnly bool `json:"offline_only"`
+}
+
+func (*BusinessAwayMessageSettings) GetType() string {
+	return TypeBusinessAwayMessageSettings
+}
+
+func (*BusinessAwayMessageSettings) GetConstructor() string {
+	return ConstructorBusinessAwayMessageSettings
+}
+
+func (entity *BusinessAwayMessageSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessAwayMessageSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessAwayMessageSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessAwayMessageSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(entity.ShortcutId)
+	encoder.writeRaw(`,"recipients":`)
+	entity.Recipients.encodeJSON(encoder)
+	encoder.writeRaw(`,"schedule":`)
+	encoder.writeType(entity.Schedule)
+	encoder.writeRaw(`,"offline_only":`)
+	encoder.writeBool(entity.OfflineOnly)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessAwayMessageSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "shortcut_id":
+			entity.ShortcutId, err = decoder.readInt32()
+
+		case "recipients":
+			entity.Recipients, err = decodeEntity[BusinessRecipients](decoder)
+
+		case "schedule":
+			entity.Schedule, err = decodeBusinessAwayMessageSchedule(decoder)
+
+		case "offline_only":
+			entity.OfflineOnly, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessAwayMessageSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes settings for greeting messages that are automatically sent by a Telegram Business account as response to incoming messages in an inactive private chat
+type BusinessGreetingMessageSettings struct {
+	meta
+	// Unique quick reply shortcut identifier for the greeting messages
+	ShortcutId int32 `json:"shortcut_id"`
+	// Chosen recipients of the greeting messages
+	Recipients *BusinessRecipients `json:"recipients"`
+	// The number of days after which a chat will be considered as inactive; currently, must be on of 7, 14, 21, or 28
+	InactivityDays int32 `json:"inactivity_days"`
+}
+
+func (*BusinessGreetingMessageSettings) GetType() string {
+	return TypeBusinessGreetingMessageSettings
+}
+
+func (*BusinessGreetingMessageSettings) GetConstructor() string {
+	return ConstructorBusinessGreetingMessageSettings
+}
+
+func (entity *BusinessGreetingMessageSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessGreetingMessageSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessGreetingMessageSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessGreetingMessageSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(entity.ShortcutId)
+	encoder.writeRaw(`,"recipients":`)
+	entity.Recipients.encodeJSON(encoder)
+	encoder.writeRaw(`,"inactivity_days":`)
+	encoder.writeInt32(entity.InactivityDays)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessGreetingMessageSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "shortcut_id":
+			entity.ShortcutId, err = decoder.readInt32()
+
+		case "recipients":
+			entity.Recipients, err = decodeEntity[BusinessRecipients](decoder)
+
+		case "inactivity_days":
+			entity.InactivityDays, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessGreetingMessageSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes rights of a business bot
+type BusinessBotRights struct {
+	meta
+	// True, if the bot can send and edit messages in the private chats that had incoming messages in the last 24 hours
+	CanReply bool `json:"can_reply"`
+	// True, if the bot can mark incoming private messages as read
+	CanReadMessages bool `json:"can_read_messages"`
+	// True, if the bot can delete sent messages
+	CanDeleteSentMessages bool `json:"can_delete_sent_messages"`
+	// True, if the bot can delete any message
+	CanDeleteAllMessages bool `json:"can_delete_all_messages"`
+	// True, if the bot can edit name of the business account
+	CanEditName bool `json:"can_edit_name"`
+	// True, if the bot can edit bio of the business account
+	CanEditBio bool `json:"can_edit_bio"`
+	// True, if the bot can edit profile photo of the business account
+	CanEditProfilePhoto bool `json:"can_edit_profile_photo"`
+	// True, if the bot can edit username of the business account
+	CanEditUsername bool `json:"can_edit_username"`
+	// True, if the bot can view gifts and amount of Telegram Stars owned by the business account
+	CanViewGiftsAndStars bool `json:"can_view_gifts_and_stars"`
+	// True, if the bot can sell regular gifts received by the business account
+	CanSellGifts bool `json:"can_sell_gifts"`
+	// True, if the bot can change gift receiving settings of the business account
+	CanChangeGiftSettings bool `json:"can_change_gift_settings"`
+	// True, if the bot can transfer and upgrade gifts received by the business account
+	CanTransferAndUpgradeGifts bool `json:"can_transfer_and_upgrade_gifts"`
+	// True, if the bot can transfer Telegram Stars received by the business account to account of the bot, or use them to upgrade and transfer gifts
+	CanTransferStars bool `json:"can_transfer_stars"`
+	// True, if the bot can send, edit and delete stories
+	CanManageStories bool `json:"can_manage_stories"`
+}
+
+func (*BusinessBotRights) GetType() string {
+	return TypeBusinessBotRights
+}
+
+func (*BusinessBotRights) GetConstructor() string {
+	return ConstructorBusinessBotRights
+}
+
+func (entity *BusinessBotRights) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessBotRights) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessBotRights) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessBotRights"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_reply":`)
+	encoder.writeBool(entity.CanReply)
+	encoder.writeRaw(`,"can_read_messages":`)
+	encoder.writeBool(entity.CanReadMessages)
+	encoder.writeRaw(`,"can_delete_sent_messages":`)
+	encoder.writeBool(entity.CanDeleteSentMessages)
+	encoder.writeRaw(`,"can_delete_all_messages":`)
+	encoder.writeBool(entity.CanDeleteAllMessages)
+	encoder.writeRaw(`,"can_edit_name":`)
+	encoder.writeBool(entity.CanEditName)
+	encoder.writeRaw(`,"can_edit_bio":`)
+	encoder.writeBool(entity.CanEditBio)
+	encoder.writeRaw(`,"can_edit_profile_photo":`)
+	encoder.writeBool(entity.CanEditProfilePhoto)
+	encoder.writeRaw(`,"can_edit_username":`)
+	encoder.writeBool(entity.CanEditUsername)
+	encoder.writeRaw(`,"can_view_gifts_and_stars":`)
+	encoder.writeBool(entity.CanViewGiftsAndStars)
+	encoder.writeRaw(`,"can_sell_gifts":`)
+	encoder.writeBool(entity.CanSellGifts)
+	encoder.writeRaw(`,"can_change_gift_settings":`)
+	encoder.writeBool(entity.CanChangeGiftSettings)
+	encoder.writeRaw(`,"can_transfer_and_upgrade_gifts":`)
+	encoder.writeBool(entity.CanTransferAndUpgradeGifts)
+	encoder.writeRaw(`,"can_transfer_stars":`)
+	encoder.writeBool(entity.CanTransferStars)
+	encoder.writeRaw(`,"can_manage_stories":`)
+	encoder.writeBool(entity.CanManageStories)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessBotRights) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_reply":
+			entity.CanReply, err = decoder.readBool()
+
+		case "can_read_messages":
+			entity.CanReadMessages, err = decoder.readBool()
+
+		case "can_delete_sent_messages":
+			entity.CanDeleteSentMessages, err = decoder.readBool()
+
+		case "can_delete_all_messages":
+			entity.CanDeleteAllMessages, err = decoder.readBool()
+
+		case "can_edit_name":
+			entity.CanEditName, err = decoder.readBool()
+
+		case "can_edit_bio":
+			entity.CanEditBio, err = decoder.readBool()
+
+		case "can_edit_profile_photo":
+			entity.CanEditProfilePhoto, err = decoder.readBool()
+
+		case "can_edit_username":
+			entity.CanEditUsername, err = decoder.readBool()
+
+		case "can_view_gifts_and_stars":
+			entity.CanViewGiftsAndStars, err = decoder.readBool()
+
+		case "can_sell_gifts":
+			entity.CanSellGifts, err = decoder.readBool()
+
+		case "can_change_gift_settings":
+			entity.CanChangeGiftSettings, err = decoder.readBool()
+
+		case "can_transfer_and_upgrade_gifts":
+			entity.CanTransferAndUpgradeGifts, err = decoder.readBool()
+
+		case "can_transfer_stars":
+			entity.CanTransferStars, err = decoder.readBool()
+
+		case "can_manage_stories":
+			entity.CanManageStories, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessBotRights)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a bot connected to a business account
+type BusinessConnectedBot struct {
+	meta
+	// User identifier of the bot
+	BotUserId int64 `json:"bot_user_id"`
+	// Private chats that will be accessible to the bot
+	Recipients *BusinessRecipients `json:"recipients"`
+	// Rights of the bot
+	Rights *BusinessBotRights `json:"rights"`
+}
+
+func (*BusinessConnectedBot) GetType() string {
+	return TypeBusinessConnectedBot
+}
+
+func (*BusinessConnectedBot) GetConstructor() string {
+	return ConstructorBusinessConnectedBot
+}
+
+func (entity *BusinessConnectedBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessConnectedBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessConnectedBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessConnectedBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"recipients":`)
+	entity.Recipients.encodeJSON(encoder)
+	encoder.writeRaw(`,"rights":`)
+	entity.Rights.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessConnectedBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "recipients":
+			entity.Recipients, err = decodeEntity[BusinessRecipients](decoder)
+
+		case "rights":
+			entity.Rights, err = decodeEntity[BusinessBotRights](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessConnectedBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes settings for a business account start page
+type BusinessStartPage struct {
+	meta
+	// Title text of the start page
+	Title string `json:"title"`
+	// Message text of the start page
+	Message string `json:"message"`
+	// Greeting sticker of the start page; may be null if none
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*BusinessStartPage) GetType() string {
+	return TypeBusinessStartPage
+}
+
+func (*BusinessStartPage) GetConstructor() string {
+	return ConstructorBusinessStartPage
+}
+
+func (entity *BusinessStartPage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessStartPage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessStartPage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessStartPage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"message":`)
+	encoder.writeString(entity.Message)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessStartPage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "message":
+			entity.Message, err = decoder.readString()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessStartPage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes settings for a business account start page to set
+type InputBusinessStartPage struct {
+	meta
+	// Title text of the start page; 0-getOption("business_start_page_title_length_max") characters
+	Title string `json:"title"`
+	// Message text of the start page; 0-getOption("business_start_page_message_length_max") characters
+	Message string `json:"message"`
+	// Greeting sticker of the start page; pass null if none. The sticker must belong to a sticker set and must not be a custom emoji
+	Sticker InputFile `json:"sticker,omitempty"`
+}
+
+func (*InputBusinessStartPage) GetType() string {
+	return TypeInputBusinessStartPage
+}
+
+func (*InputBusinessStartPage) GetConstructor() string {
+	return ConstructorInputBusinessStartPage
+}
+
+func (entity *InputBusinessStartPage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputBusinessStartPage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputBusinessStartPage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputBusinessStartPage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"message":`)
+	encoder.writeString(entity.Message)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		encoder.writeType(entity.Sticker)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputBusinessStartPage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "message":
+			entity.Message, err = decoder.readString()
+
+		case "sticker":
+			entity.Sticker, err = decodeInputFile(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputBusinessStartPage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an interval of time when the business is open
+type BusinessOpeningHoursInterval struct {
+	meta
+	// The minute's sequence number in a week, starting on Monday, marking the start of the time interval during which the business is open; 0-7*24*60
+	StartMinute int32 `json:"start_minute"`
+	// The minute's sequence number in a week, starting on Monday, marking the end of the time interval during which the business is open; 1-8*24*60
+	EndMinute int32 `json:"end_minute"`
+}
+
+func (*BusinessOpeningHoursInterval) GetType() string {
+	return TypeBusinessOpeningHoursInterval
+}
+
+func (*BusinessOpeningHoursInterval) GetConstructor() string {
+	return ConstructorBusinessOpeningHoursInterval
+}
+
+func (entity *BusinessOpeningHoursInterval) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessOpeningHoursInterval) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessOpeningHoursInterval) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessOpeningHoursInterval"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"start_minute":`)
+	encoder.writeInt32(entity.StartMinute)
+	encoder.writeRaw(`,"end_minute":`)
+	encoder.writeInt32(entity.EndMinute)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessOpeningHoursInterval) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "start_minute":
+			entity.StartMinute, err = decoder.readInt32()
+
+		case "end_minute":
+			entity.EndMinute, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessOpeningHoursInterval)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes opening hours of a business
+type BusinessOpeningHours struct {
+	meta
+	// Unique time zone identifier
+	TimeZoneId string `json:"time_zone_id"`
+	// Intervals of the time when the business is open
+	OpeningHours []*BusinessOpeningHoursInterval `json:"opening_hours"`
+}
+
+func (*BusinessOpeningHours) GetType() string {
+	return TypeBusinessOpeningHours
+}
+
+func (*BusinessOpeningHours) GetConstructor() string {
+	return ConstructorBusinessOpeningHours
+}
+
+func (entity *BusinessOpeningHours) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessOpeningHours) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessOpeningHours) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessOpeningHours"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"time_zone_id":`)
+	encoder.writeString(entity.TimeZoneId)
+	encoder.writeRaw(`,"opening_hours":`)
+	writeList(encoder, entity.OpeningHours, writeTypeValue[*BusinessOpeningHoursInterval])
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessOpeningHours) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "time_zone_id":
+			entity.TimeZoneId, err = decoder.readString()
+
+		case "opening_hours":
+			entity.OpeningHours, err = readList(decoder, decodeEntity[BusinessOpeningHoursInterval])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessOpeningHours)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a Telegram Business account
+type BusinessInfo struct {
+	meta
+	// Location of the business; may be null if none
+	Location *BusinessLocation `json:"location,omitempty"`
+	// Opening hours of the business; may be null if none. The hours are guaranteed to be valid and has already been split by week days
+	OpeningHours *BusinessOpeningHours `json:"opening_hours,omitempty"`
+	// Opening hours of the business in the local time; may be null if none. The hours are guaranteed to be valid and has already been split by week days. Local time zone identifier will be empty. An updateUserFullInfo update is not triggered when value of this field changes
+	LocalOpeningHours *BusinessOpeningHours `json:"local_opening_hours,omitempty"`
+	// Time left before the business will open the next time, in seconds; 0 if unknown. An updateUserFullInfo update is not triggered when value of this field changes
+	NextOpenIn int32 `json:"next_open_in"`
+	// Time left before the business will close the next time, in seconds; 0 if unknown. An updateUserFullInfo update is not triggered when value of this field changes
+	NextCloseIn int32 `json:"next_close_in"`
+	// The greeting message; may be null if none or the Business account is not of the current user
+	GreetingMessageSettings *BusinessGreetingMessageSettings `json:"greeting_message_settings,omitempty"`
+	// The away message; may be null if none or the Business account is not of the current user
+	AwayMessageSettings *BusinessAwayMessageSettings `json:"away_message_settings,omitempty"`
+	// Information about start page of the account; may be null if none
+	StartPage *BusinessStartPage `json:"start_page,omitempty"`
+}
+
+func (*BusinessInfo) GetType() string {
+	return TypeBusinessInfo
+}
+
+func (*BusinessInfo) GetConstructor() string {
+	return ConstructorBusinessInfo
+}
+
+func (entity *BusinessInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessInfo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		entity.Location.encodeJSON(encoder)
+	}
+	if entity.OpeningHours != nil {
+		encoder.writeRaw(`,"opening_hours":`)
+		entity.OpeningHours.encodeJSON(encoder)
+	}
+	if entity.LocalOpeningHours != nil {
+		encoder.writeRaw(`,"local_opening_hours":`)
+		entity.LocalOpeningHours.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"next_open_in":`)
+	encoder.writeInt32(entity.NextOpenIn)
+	encoder.writeRaw(`,"next_close_in":`)
+	encoder.writeInt32(entity.NextCloseIn)
+	if entity.GreetingMessageSettings != nil {
+		encoder.writeRaw(`,"greeting_message_settings":`)
+		entity.GreetingMessageSettings.encodeJSON(encoder)
+	}
+	if entity.AwayMessageSettings != nil {
+		encoder.writeRaw(`,"away_message_settings":`)
+		entity.AwayMessageSettings.encodeJSON(encoder)
+	}
+	if entity.StartPage != nil {
+		encoder.writeRaw(`,"start_page":`)
+		entity.StartPage.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[BusinessLocation](decoder)
+
+		case "opening_hours":
+			entity.OpeningHours, err = decodeEntity[BusinessOpeningHours](decoder)
+
+		case "local_opening_hours":
+			entity.LocalOpeningHours, err = decodeEntity[BusinessOpeningHours](decoder)
+
+		case "next_open_in":
+			entity.NextOpenIn, err = decoder.readInt32()
+
+		case "next_close_in":
+			entity.NextCloseIn, err = decoder.readInt32()
+
+		case "greeting_message_settings":
+			entity.GreetingMessageSettings, err = decodeEntity[BusinessGreetingMessageSettings](decoder)
+
+		case "away_message_settings":
+			entity.AwayMessageSettings, err = decodeEntity[BusinessAwayMessageSettings](decoder)
+
+		case "start_page":
+			entity.StartPage, err = decodeEntity[BusinessStartPage](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *BusinessInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("location", entity.Location); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains information about a business chat link
+type BusinessChatLink struct {
+	meta
+	// The HTTPS link
+	Link string `json:"link"`
+	// Message draft text that will be added to the input field
+	Text *FormattedText `json:"text"`
+	// Link title
+	Title string `json:"title"`
+	// Number of times the link was used
+	ViewCount int32 `json:"view_count"`
+}
+
+func (*BusinessChatLink) GetType() string {
+	return TypeBusinessChatLink
+}
+
+func (*BusinessChatLink) GetConstructor() string {
+	return ConstructorBusinessChatLink
+}
+
+func (entity *BusinessChatLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessChatLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessChatLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessChatLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(entity.Link)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"view_count":`)
+	encoder.writeInt32(entity.ViewCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessChatLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "link":
+			entity.Link, err = decoder.readString()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "view_count":
+			entity.ViewCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessChatLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of business chat links created by the user
+type BusinessChatLinks struct {
+	meta
+	// List of links
+	Links []*BusinessChatLink `json:"links"`
+}
+
+func (*BusinessChatLinks) GetType() string {
+	return TypeBusinessChatLinks
+}
+
+func (*BusinessChatLinks) GetConstructor() string {
+	return ConstructorBusinessChatLinks
+}
+
+func (entity *BusinessChatLinks) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessChatLinks) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessChatLinks) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessChatLinks"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"links":`)
+	writeList(encoder, entity.Links, writeTypeValue[*BusinessChatLink])
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessChatLinks) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "links":
+			entity.Links, err = readList(decoder, decodeEntity[BusinessChatLink])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessChatLinks)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a business chat link to create or edit
+type InputBusinessChatLink struct {
+	meta
+	// Message draft text that will be added to the input field
+	Text *FormattedText `json:"text"`
+	// Link title
+	Title string `json:"title"`
+}
+
+func (*InputBusinessChatLink) GetType() string {
+	return TypeInputBusinessChatLink
+}
+
+func (*InputBusinessChatLink) GetConstructor() string {
+	return ConstructorInputBusinessChatLink
+}
+
+func (entity *InputBusinessChatLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputBusinessChatLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputBusinessChatLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputBusinessChatLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputBusinessChatLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputBusinessChatLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a business chat link
+type BusinessChatLinkInfo struct {
+	meta
+	// Identifier of the private chat that created the link
+	ChatId int64 `json:"chat_id"`
+	// Message draft text that must be added to the input field
+	Text *FormattedText `json:"text"`
+}
+
+func (*BusinessChatLinkInfo) GetType() string {
+	return TypeBusinessChatLinkInfo
+}
+
+func (*BusinessChatLinkInfo) GetConstructor() string {
+	return ConstructorBusinessChatLinkInfo
+}
+
+func (entity *BusinessChatLinkInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessChatLinkInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessChatLinkInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessChatLinkInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessChatLinkInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessChatLinkInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a message from a business account as received by a bot
+type BusinessMessage struct {
+	meta
+	// The message
+	Message *Message `json:"message"`
+	// Message that is replied by the message in the same chat; may be null if none
+	ReplyToMessage *Message `json:"reply_to_message,omitempty"`
+}
+
+func (*BusinessMessage) GetType() string {
+	return TypeBusinessMessage
+}
+
+func (*BusinessMessage) GetConstructor() string {
+	return ConstructorBusinessMessage
+}
+
+func (entity *BusinessMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	if entity.ReplyToMessage != nil {
+		encoder.writeRaw(`,"reply_to_message":`)
+		entity.ReplyToMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		case "reply_to_message":
+			entity.ReplyToMessage, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *BusinessMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	if err := validateNested("reply_to_message", entity.ReplyToMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of messages from a business account as received by a bot
+type BusinessMessages struct {
+	meta
+	// List of business messages
+	Messages []*BusinessMessage `json:"messages"`
+}
+
+func (*BusinessMessages) GetType() string {
+	return TypeBusinessMessages
+}
+
+func (*BusinessMessages) GetConstructor() string {
+	return ConstructorBusinessMessages
+}
+
+func (entity *BusinessMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"messages":`)
+	writeList(encoder, entity.Messages, writeTypeValue[*BusinessMessage])
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[BusinessMessage])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *BusinessMessages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains information about a business bot that manages the chat
+type BusinessBotManageBar struct {
+	meta
+	// User identifier of the bot
+	BotUserId int64 `json:"bot_user_id"`
+	// URL to be opened to manage the bot
+	ManageUrl string `json:"manage_url"`
+	// True, if the bot is paused. Use toggleBusinessConnectedBotChatIsPaused to change the value of the field
+	IsBotPaused bool `json:"is_bot_paused"`
+	// True, if the bot can reply
+	CanBotReply bool `json:"can_bot_reply"`
+}
+
+func (*BusinessBotManageBar) GetType() string {
+	return TypeBusinessBotManageBar
+}
+
+func (*BusinessBotManageBar) GetConstructor() string {
+	return ConstructorBusinessBotManageBar
+}
+
+func (entity *BusinessBotManageBar) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessBotManageBar) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessBotManageBar) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessBotManageBar"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"manage_url":`)
+	encoder.writeString(entity.ManageUrl)
+	encoder.writeRaw(`,"is_bot_paused":`)
+	encoder.writeBool(entity.IsBotPaused)
+	encoder.writeRaw(`,"can_bot_reply":`)
+	encoder.writeBool(entity.CanBotReply)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessBotManageBar) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "manage_url":
+			entity.ManageUrl, err = decoder.readString()
+
+		case "is_bot_paused":
+			entity.IsBotPaused, err = decoder.readBool()
+
+		case "can_bot_reply":
+			entity.CanBotReply, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessBotManageBar)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a connection of the bot with a business account
+type BusinessConnection struct {
+	meta
+	// Unique identifier of the connection
+	Id string `json:"id"`
+	// Identifier of the business user that created the connection
+	UserId int64 `json:"user_id"`
+	// Chat identifier of the private chat with the user
+	UserChatId int64 `json:"user_chat_id"`
+	// Point in time (Unix timestamp) when the connection was established
+	Date int32 `json:"date"`
+	// Rights of the bot; may be null if the connection was disabled
+	Rights *BusinessBotRights `json:"rights,omitempty"`
+	// True, if the connection is enabled; false otherwise
+	IsEnabled bool `json:"is_enabled"`
+}
+
+func (*BusinessConnection) GetType() string {
+	return TypeBusinessConnection
+}
+
+func (*BusinessConnection) GetConstructor() string {
+	return ConstructorBusinessConnection
+}
+
+func (entity *BusinessConnection) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessConnection) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessConnection) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessConnection"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"user_chat_id":`)
+	encoder.writeInt64(entity.UserChatId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	if entity.Rights != nil {
+		encoder.writeRaw(`,"rights":`)
+		entity.Rights.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_enabled":`)
+	encoder.writeBool(entity.IsEnabled)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessConnection) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "user_chat_id":
+			entity.UserChatId, err = decoder.readInt64()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "rights":
+			entity.Rights, err = decodeEntity[BusinessBotRights](decoder)
+
+		case "is_enabled":
+			entity.IsEnabled, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessConnection)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The ability to set location
+type BusinessFeatureLocation struct {
+	meta
+}
+
+func (*BusinessFeatureLocation) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureLocation) GetConstructor() string {
+	return ConstructorBusinessFeatureLocation
+}
+
+func (*BusinessFeatureLocation) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureLocation
+}
+
+func (entity *BusinessFeatureLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureLocation)
+	})
+}
+
+// The ability to set opening hours
+type BusinessFeatureOpeningHours struct {
+	meta
+}
+
+func (*BusinessFeatureOpeningHours) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureOpeningHours) GetConstructor() string {
+	return ConstructorBusinessFeatureOpeningHours
+}
+
+func (*BusinessFeatureOpeningHours) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureOpeningHours
+}
+
+func (entity *BusinessFeatureOpeningHours) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureOpeningHours) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureOpeningHours) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureOpeningHours"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureOpeningHours) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureOpeningHours)
+	})
+}
+
+// The ability to use quick replies
+type BusinessFeatureQuickReplies struct {
+	meta
+}
+
+func (*BusinessFeatureQuickReplies) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureQuickReplies) GetConstructor() string {
+	return ConstructorBusinessFeatureQuickReplies
+}
+
+func (*BusinessFeatureQuickReplies) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureQuickReplies
+}
+
+func (entity *BusinessFeatureQuickReplies) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureQuickReplies) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureQuickReplies) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureQuickReplies"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureQuickReplies) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureQuickReplies)
+	})
+}
+
+// The ability to set up a greeting message
+type BusinessFeatureGreetingMessage struct {
+	meta
+}
+
+func (*BusinessFeatureGreetingMessage) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureGreetingMessage) GetConstructor() string {
+	return ConstructorBusinessFeatureGreetingMessage
+}
+
+func (*BusinessFeatureGreetingMessage) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureGreetingMessage
+}
+
+func (entity *BusinessFeatureGreetingMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureGreetingMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureGreetingMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureGreetingMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureGreetingMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureGreetingMessage)
+	})
+}
+
+// The ability to set up an away message
+type BusinessFeatureAwayMessage struct {
+	meta
+}
+
+func (*BusinessFeatureAwayMessage) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureAwayMessage) GetConstructor() string {
+	return ConstructorBusinessFeatureAwayMessage
+}
+
+func (*BusinessFeatureAwayMessage) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureAwayMessage
+}
+
+func (entity *BusinessFeatureAwayMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureAwayMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureAwayMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureAwayMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureAwayMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureAwayMessage)
+	})
+}
+
+// The ability to create links to the business account with predefined message text
+type BusinessFeatureAccountLinks struct {
+	meta
+}
+
+func (*BusinessFeatureAccountLinks) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureAccountLinks) GetConstructor() string {
+	return ConstructorBusinessFeatureAccountLinks
+}
+
+func (*BusinessFeatureAccountLinks) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureAccountLinks
+}
+
+func (entity *BusinessFeatureAccountLinks) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureAccountLinks) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureAccountLinks) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureAccountLinks"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureAccountLinks) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureAccountLinks)
+	})
+}
+
+// The ability to customize start page
+type BusinessFeatureStartPage struct {
+	meta
+}
+
+func (*BusinessFeatureStartPage) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureStartPage) GetConstructor() string {
+	return ConstructorBusinessFeatureStartPage
+}
+
+func (*BusinessFeatureStartPage) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureStartPage
+}
+
+func (entity *BusinessFeatureStartPage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureStartPage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureStartPage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureStartPage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureStartPage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureStartPage)
+	})
+}
+
+// The ability to connect a bot to the account
+type BusinessFeatureBots struct {
+	meta
+}
+
+func (*BusinessFeatureBots) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureBots) GetConstructor() string {
+	return ConstructorBusinessFeatureBots
+}
+
+func (*BusinessFeatureBots) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureBots
+}
+
+func (entity *BusinessFeatureBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureBots)
+	})
+}
+
+// The ability to show an emoji status along with the business name
+type BusinessFeatureEmojiStatus struct {
+	meta
+}
+
+func (*BusinessFeatureEmojiStatus) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureEmojiStatus) GetConstructor() string {
+	return ConstructorBusinessFeatureEmojiStatus
+}
+
+func (*BusinessFeatureEmojiStatus) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureEmojiStatus
+}
+
+func (entity *BusinessFeatureEmojiStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureEmojiStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureEmojiStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureEmojiStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureEmojiStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureEmojiStatus)
+	})
+}
+
+// The ability to display folder names for each chat in the chat list
+type BusinessFeatureChatFolderTags struct {
+	meta
+}
+
+func (*BusinessFeatureChatFolderTags) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureChatFolderTags) GetConstructor() string {
+	return ConstructorBusinessFeatureChatFolderTags
+}
+
+func (*BusinessFeatureChatFolderTags) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureChatFolderTags
+}
+
+func (entity *BusinessFeatureChatFolderTags) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureChatFolderTags) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureChatFolderTags) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureChatFolderTags"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureChatFolderTags) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureChatFolderTags)
+	})
+}
+
+// Allowed to use many additional features for stories
+type BusinessFeatureUpgradedStories struct {
+	meta
+}
+
+func (*BusinessFeatureUpgradedStories) GetType() string {
+	return TypeBusinessFeature
+}
+
+func (*BusinessFeatureUpgradedStories) GetConstructor() string {
+	return ConstructorBusinessFeatureUpgradedStories
+}
+
+func (*BusinessFeatureUpgradedStories) BusinessFeatureConstructor() string {
+	return ConstructorBusinessFeatureUpgradedStories
+}
+
+func (entity *BusinessFeatureUpgradedStories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatureUpgradedStories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatureUpgradedStories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatureUpgradedStories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatureUpgradedStories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatureUpgradedStories)
+	})
+}
+
+// Contains information about features, available to Business user accounts
+type BusinessFeatures struct {
+	meta
+	// The list of available business features
+	Features []BusinessFeature `json:"features"`
+}
+
+func (*BusinessFeatures) GetType() string {
+	return TypeBusinessFeatures
+}
+
+func (*BusinessFeatures) GetConstructor() string {
+	return ConstructorBusinessFeatures
+}
+
+func (entity *BusinessFeatures) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeatures) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeatures) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeatures"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"features":`)
+	writeList(encoder, entity.Features, writeTypeValue[BusinessFeature])
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeatures) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "features":
+			entity.Features, err = readList(decoder, decodeBusinessFeature)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessFeatures)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a promotion animation for a Business feature
+type BusinessFeaturePromotionAnimation struct {
+	meta
+	// Business feature
+	Feature BusinessFeature `json:"feature"`
+	// Promotion animation for the feature
+	Animation *Animation `json:"animation"`
+}
+
+func (*BusinessFeaturePromotionAnimation) GetType() string {
+	return TypeBusinessFeaturePromotionAnimation
+}
+
+func (*BusinessFeaturePromotionAnimation) GetConstructor() string {
+	return ConstructorBusinessFeaturePromotionAnimation
+}
+
+func (entity *BusinessFeaturePromotionAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BusinessFeaturePromotionAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BusinessFeaturePromotionAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"businessFeaturePromotionAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"feature":`)
+	encoder.writeType(entity.Feature)
+	encoder.writeRaw(`,"animation":`)
+	entity.Animation.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *BusinessFeaturePromotionAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "feature":
+			entity.Feature, err = decodeBusinessFeature(decoder)
+
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBusinessFeaturePromotionAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,2461 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeCallDiscardReason             = "CallDiscardReason"
+	TypeCallServerType                = "CallServerType"
+	TypeCallState                     = "CallState"
+	TypeGroupCallVideoQuality         = "GroupCallVideoQuality"
+	TypeCallProblem                   = "CallProblem"
+	TypeCallProtocol                  = "CallProtocol"
+	TypeCallServer                    = "CallServer"
+	TypeCallId                        = "CallId"
+	TypeGroupCallId                   = "GroupCallId"
+	TypeGroupCallStream               = "GroupCallStream"
+	TypeGroupCallStreams              = "GroupCallStreams"
+	TypeGroupCallRecentSpeaker        = "GroupCallRecentSpeaker"
+	TypeGroupCall                     = "GroupCall"
+	TypeGroupCallVideoSourceGroup     = "GroupCallVideoSourceGroup"
+	TypeGroupCallParticipantVideoInfo = "GroupCallParticipantVideoInfo"
+	TypeGroupCallParticipant          = "GroupCallParticipant"
+	TypeCall                          = "Call"
+)
+
+const (
+	ConstructorCallDiscardReasonEmpty          = "callDiscardReasonEmpty"
+	ConstructorCallDiscardReasonMissed         = "callDiscardReasonMissed"
+	ConstructorCallDiscardReasonDeclined       = "callDiscardReasonDeclined"
+	ConstructorCallDiscardReasonDisconnected   = "callDiscardReasonDisconnected"
+	ConstructorCallDiscardReasonHungUp         = "callDiscardReasonHungUp"
+	ConstructorCallDiscardReasonAllowGroupCall = "callDiscardReasonAllowGroupCall"
+	ConstructorCallProtocol                    = "callProtocol"
+	ConstructorCallServerTypeTelegramReflector = "callServerTypeTelegramReflector"
+	ConstructorCallServerTypeWebrtc            = "callServerTypeWebrtc"
+	ConstructorCallServer                      = "callServer"
+	ConstructorCallId                          = "callId"
+	ConstructorGroupCallId                     = "groupCallId"
+	ConstructorCallStatePending                = "callStatePending"
+	ConstructorCallStateExchangingKeys         = "callStateExchangingKeys"
+	ConstructorCallStateReady                  = "callStateReady"
+	ConstructorCallStateHangingUp              = "callStateHangingUp"
+	ConstructorCallStateDiscarded              = "callStateDiscarded"
+	ConstructorCallStateError                  = "callStateError"
+	ConstructorGroupCallVideoQualityThumbnail  = "groupCallVideoQualityThumbnail"
+	ConstructorGroupCallVideoQualityMedium     = "groupCallVideoQualityMedium"
+	ConstructorGroupCallVideoQualityFull       = "groupCallVideoQualityFull"
+	ConstructorGroupCallStream                 = "groupCallStream"
+	ConstructorGroupCallStreams                = "groupCallStreams"
+	ConstructorGroupCallRecentSpeaker          = "groupCallRecentSpeaker"
+	ConstructorGroupCall                       = "groupCall"
+	ConstructorGroupCallVideoSourceGroup       = "groupCallVideoSourceGroup"
+	ConstructorGroupCallParticipantVideoInfo   = "groupCallParticipantVideoInfo"
+	ConstructorGroupCallParticipant            = "groupCallParticipant"
+	ConstructorCallProblemEcho                 = "callProblemEcho"
+	ConstructorCallProblemNoise                = "callProblemNoise"
+	ConstructorCallProblemInterruptions        = "callProblemInterruptions"
+	ConstructorCallProblemDistortedSpeech      = "callProblemDistortedSpeech"
+	ConstructorCallProblemSilentLocal          = "callProblemSilentLocal"
+	ConstructorCallProblemSilentRemote         = "callProblemSilentRemote"
+	ConstructorCallProblemDropped              = "callProblemDropped"
+	ConstructorCallProblemDistortedVideo       = "callProblemDistortedVideo"
+	ConstructorCallProblemPixelatedVideo       = "callProblemPixelatedVideo"
+	ConstructorCall                            = "call"
+)
+
+// Describes the reason why a call was discarded
+type CallDiscardReason interface {
+	CallDiscardReasonConstructor() string
+}
+
+// Describes the type of call server
+type CallServerType interface {
+	CallServerTypeConstructor() string
+}
+
+// Describes the current call state
+type CallState interface {
+	CallStateConstructor() string
+}
+
+// Describes the quality of a group call video
+type GroupCallVideoQuality interface {
+	GroupCallVideoQualityConstructor() string
+}
+
+// Describes the exact type of problem with a call
+type CallProblem interface {
+	CallProblemConstructor() string
+}
+
+func (entity *UnknownType) CallDiscardReasonConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CallServerTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CallStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) GroupCallVideoQualityConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CallProblemConstructor() string {
+	return entity.Constructor
+}
+
+// The call wasn't discarded, or the reason is unknown
+type CallDiscardReasonEmpty struct {
+	meta
+}
+
+func (*CallDiscardReasonEmpty) GetType() string {
+	return TypeCallDiscardReason
+}
+
+func (*CallDiscardReasonEmpty) GetConstructor() string {
+	return ConstructorCallDiscardReasonEmpty
+}
+
+func (*CallDiscardReasonEmpty) CallDiscardReasonConstructor() string {
+	return ConstructorCallDiscardReasonEmpty
+}
+
+func (entity *CallDiscardReasonEmpty) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallDiscardReasonEmpty) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallDiscardReasonEmpty) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callDiscardReasonEmpty"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallDiscardReasonEmpty) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallDiscardReasonEmpty)
+	})
+}
+
+// The call was ended before the conversation started. It was canceled by the caller or missed by the other party
+type CallDiscardReasonMissed struct {
+	meta
+}
+
+func (*CallDiscardReasonMissed) GetType() string {
+	return TypeCallDiscardReason
+}
+
+func (*CallDiscardReasonMissed) GetConstructor() string {
+	return ConstructorCallDiscardReasonMissed
+}
+
+func (*CallDiscardReasonMissed) CallDiscardReasonConstructor() string {
+	return ConstructorCallDiscardReasonMissed
+}
+
+func (entity *CallDiscardReasonMissed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallDiscardReasonMissed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallDiscardReasonMissed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callDiscardReasonMissed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallDiscardReasonMissed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallDiscardReasonMissed)
+	})
+}
+
+// The call was ended before the conversation started. It was declined by the other party
+type CallDiscardReasonDeclined struct {
+	meta
+}
+
+func (*CallDiscardReasonDeclined) GetType() string {
+	return TypeCallDiscardReason
+}
+
+func (*CallDiscardReasonDeclined) GetConstructor() string {
+	return ConstructorCallDiscardReasonDeclined
+}
+
+func (*CallDiscardReasonDeclined) CallDiscardReasonConstructor() string {
+	return ConstructorCallDiscardReasonDeclined
+}
+
+func (entity *CallDiscardReasonDeclined) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallDiscardReasonDeclined) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallDiscardReasonDeclined) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callDiscardReasonDeclined"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallDiscardReasonDeclined) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallDiscardReasonDeclined)
+	})
+}
+
+// The call was ended during the conversation because the users were disconnected
+type CallDiscardReasonDisconnected struct {
+	meta
+}
+
+func (*CallDiscardReasonDisconnected) GetType() string {
+	return TypeCallDiscardReason
+}
+
+func (*CallDiscardReasonDisconnected) GetConstructor() string {
+	return ConstructorCallDiscardReasonDisconnected
+}
+
+func (*CallDiscardReasonDisconnected) CallDiscardReasonConstructor() string {
+	return ConstructorCallDiscardReasonDisconnected
+}
+
+func (entity *CallDiscardReasonDisconnected) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallDiscardReasonDisconnected) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallDiscardReasonDisconnected) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callDiscardReasonDisconnected"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallDiscardReasonDisconnected) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallDiscardReasonDisconnected)
+	})
+}
+
+// The call was ended because one of the parties hung up
+type CallDiscardReasonHungUp struct {
+	meta
+}
+
+func (*CallDiscardReasonHungUp) GetType() string {
+	return TypeCallDiscardReason
+}
+
+func (*CallDiscardReasonHungUp) GetConstructor() string {
+	return ConstructorCallDiscardReasonHungUp
+}
+
+func (*CallDiscardReasonHungUp) CallDiscardReasonConstructor() string {
+	return ConstructorCallDiscardReasonHungUp
+}
+
+func (entity *CallDiscardReasonHungUp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallDiscardReasonHungUp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallDiscardReasonHungUp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callDiscardReasonHungUp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallDiscardReasonHungUp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallDiscardReasonHungUp)
+	})
+}
+
+// The call was ended because it has been used successfully to transfer private encryption key for the associated group call
+type CallDiscardReasonAllowGroupCall struct {
+	meta
+	// Encrypted using the call private key encryption key for the associated group call
+	EncryptedGroupCallKey []byte `json:"encrypted_group_call_key"`
+}
+
+func (*CallDiscardReasonAllowGroupCall) GetType() string {
+	return TypeCallDiscardReason
+}
+
+func (*CallDiscardReasonAllowGroupCall) GetConstructor() string {
+	return ConstructorCallDiscardReasonAllowGroupCall
+}
+
+func (*CallDiscardReasonAllowGroupCall) CallDiscardReasonConstructor() string {
+	return ConstructorCallDiscardReasonAllowGroupCall
+}
+
+func (entity *CallDiscardReasonAllowGroupCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallDiscardReasonAllowGroupCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallDiscardReasonAllowGroupCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callDiscardReasonAllowGroupCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"encrypted_group_call_key":`)
+	encoder.writeBytes(entity.EncryptedGroupCallKey)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallDiscardReasonAllowGroupCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "encrypted_group_call_key":
+			entity.EncryptedGroupCallKey, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallDiscardReasonAllowGroupCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Specifies the supported call protocols
+type CallProtocol struct {
+	meta
+	// True, if UDP peer-to-peer connections are supported
+	UdpP2p bool `json:"udp_p2p"`
+	// True, if connection through UDP reflectors is supported
+	UdpReflector bool `json:"udp_reflector"`
+	// The minimum supported API layer; use 65
+	MinLayer int32 `json:"min_layer"`
+	// The maximum supported API layer; use 92
+	MaxLayer int32 `json:"max_layer"`
+	// List of supported tgcalls versions
+	LibraryVersions []string `json:"library_versions"`
+}
+
+func (*CallProtocol) GetType() string {
+	return TypeCallProtocol
+}
+
+func (*CallProtocol) GetConstructor() string {
+	return ConstructorCallProtocol
+}
+
+func (entity *CallProtocol) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProtocol) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProtocol) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProtocol"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"udp_p2p":`)
+	encoder.writeBool(entity.UdpP2p)
+	encoder.writeRaw(`,"udp_reflector":`)
+	encoder.writeBool(entity.UdpReflector)
+	encoder.writeRaw(`,"min_layer":`)
+	encoder.writeInt32(entity.MinLayer)
+	encoder.writeRaw(`,"max_layer":`)
+	encoder.writeInt32(entity.MaxLayer)
+	encoder.writeRaw(`,"library_versions":`)
+	writeList(encoder, entity.LibraryVersions, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProtocol) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "udp_p2p":
+			entity.UdpP2p, err = decoder.readBool()
+
+		case "udp_reflector":
+			entity.UdpReflector, err = decoder.readBool()
+
+		case "min_layer":
+			entity.MinLayer, err = decoder.readInt32()
+
+		case "max_layer":
+			entity.MaxLayer, err = decoder.readInt32()
+
+		case "library_versions":
+			entity.LibraryVersions, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallProtocol)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram call reflector
+type CallServerTypeTelegramReflector struct {
+	meta
+	// A peer tag to be used with the reflector
+	PeerTag []byte `json:"peer_tag"`
+	// True, if the server uses TCP instead of UDP
+	IsTcp bool `json:"is_tcp"`
+}
+
+func (*CallServerTypeTelegramReflector) GetType() string {
+	return TypeCallServerType
+}
+
+func (*CallServerTypeTelegramReflector) GetConstructor() string {
+	return ConstructorCallServerTypeTelegramReflector
+}
+
+func (*CallServerTypeTelegramReflector) CallServerTypeConstructor() string {
+	return ConstructorCallServerTypeTelegramReflector
+}
+
+func (entity *CallServerTypeTelegramReflector) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallServerTypeTelegramReflector) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallServerTypeTelegramReflector) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callServerTypeTelegramReflector"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"peer_tag":`)
+	encoder.writeBytes(entity.PeerTag)
+	encoder.writeRaw(`,"is_tcp":`)
+	encoder.writeBool(entity.IsTcp)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallServerTypeTelegramReflector) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "peer_tag":
+			entity.PeerTag, err = decoder.readBytes()
+
+		case "is_tcp":
+			entity.IsTcp, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallServerTypeTelegramReflector)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A WebRTC server
+type CallServerTypeWebrtc struct {
+	meta
+	// Username to be used for authentication
+	Username string `json:"username"`
+	// Authentication password
+	Password string `json:"password"`
+	// True, if the server supports TURN
+	SupportsTurn bool `json:"supports_turn"`
+	// True, if the server supports STUN
+	SupportsStun bool `json:"supports_stun"`
+}
+
+func (*CallServerTypeWebrtc) GetType() string {
+	return TypeCallServerType
+}
+
+func (*CallServerTypeWebrtc) GetConstructor() string {
+	return ConstructorCallServerTypeWebrtc
+}
+
+func (*CallServerTypeWebrtc) CallServerTypeConstructor() string {
+	return ConstructorCallServerTypeWebrtc
+}
+
+func (entity *CallServerTypeWebrtc) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallServerTypeWebrtc) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallServerTypeWebrtc) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callServerTypeWebrtc"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(entity.Password)
+	encoder.writeRaw(`,"supports_turn":`)
+	encoder.writeBool(entity.SupportsTurn)
+	encoder.writeRaw(`,"supports_stun":`)
+	encoder.writeBool(entity.SupportsStun)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallServerTypeWebrtc) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		case "password":
+			entity.Password, err = decoder.readString()
+
+		case "supports_turn":
+			entity.SupportsTurn, err = decoder.readBool()
+
+		case "supports_stun":
+			entity.SupportsStun, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallServerTypeWebrtc)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a server for relaying call data
+type CallServer struct {
+	meta
+	// Server identifier
+	Id JsonInt64 `json:"id"`
+	// Server IPv4 address
+	IpAddress string `json:"ip_address"`
+	// Server IPv6 address
+	Ipv6Address string `json:"ipv6_address"`
+	// Server port number
+	Port int32 `json:"port"`
+	// Server type
+	Type CallServerType `json:"type"`
+}
+
+func (*CallServer) GetType() string {
+	return TypeCallServer
+}
+
+func (*CallServer) GetConstructor() string {
+	return ConstructorCallServer
+}
+
+func (entity *CallServer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallServer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallServer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callServer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"ip_address":`)
+	encoder.writeString(entity.IpAddress)
+	encoder.writeRaw(`,"ipv6_address":`)
+	encoder.writeString(entity.Ipv6Address)
+	encoder.writeRaw(`,"port":`)
+	encoder.writeInt32(entity.Port)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallServer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "ip_address":
+			entity.IpAddress, err = decoder.readString()
+
+		case "ipv6_address":
+			entity.Ipv6Address, err = decoder.readString()
+
+		case "port":
+			entity.Port, err = decoder.readInt32()
+
+		case "type":
+			entity.Type, err = decodeCallServerType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallServer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains the call identifier
+type CallId struct {
+	meta
+	// Call identifier
+	Id int32 `json:"id"`
+}
+
+func (*CallId) GetType() string {
+	return TypeCallId
+}
+
+func (*CallId) GetConstructor() string {
+	return ConstructorCallId
+}
+
+func (entity *CallId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains the group call identifier
+type GroupCallId struct {
+	meta
+	// Group call identifier
+	Id int32 `json:"id"`
+}
+
+func (*GroupCallId) GetType() string {
+	return TypeGroupCallId
+}
+
+func (*GroupCallId) GetConstructor() string {
+	return ConstructorGroupCallId
+}
+
+func (entity *GroupCallId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCallId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The call is pending, waiting to be accepted by a user
+type CallStatePending struct {
+	meta
+	// True, if the call has already been created by the server
+	IsCreated bool `json:"is_created"`
+	// True, if the call has already been received by the other party
+	IsReceived bool `json:"is_received"`
+}
+
+func (*CallStatePending) GetType() string {
+	return TypeCallState
+}
+
+func (*CallStatePending) GetConstructor() string {
+	return ConstructorCallStatePending
+}
+
+func (*CallStatePending) CallStateConstructor() string {
+	return ConstructorCallStatePending
+}
+
+func (entity *CallStatePending) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallStatePending) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallStatePending) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callStatePending"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_created":`)
+	encoder.writeBool(entity.IsCreated)
+	encoder.writeRaw(`,"is_received":`)
+	encoder.writeBool(entity.IsReceived)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallStatePending) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_created":
+			entity.IsCreated, err = decoder.readBool()
+
+		case "is_received":
+			entity.IsReceived, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallStatePending)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The call has been answered and encryption keys are being exchanged
+type CallStateExchangingKeys struct {
+	meta
+}
+
+func (*CallStateExchangingKeys) GetType() string {
+	return TypeCallState
+}
+
+func (*CallStateExchangingKeys) GetConstructor() string {
+	return ConstructorCallStateExchangingKeys
+}
+
+func (*CallStateExchangingKeys) CallStateConstructor() string {
+	return ConstructorCallStateExchangingKeys
+}
+
+func (entity *CallStateExchangingKeys) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallStateExchangingKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallStateExchangingKeys) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callStateExchangingKeys"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallStateExchangingKeys) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallStateExchangingKeys)
+	})
+}
+
+// The call is ready to use
+type CallStateReady struct {
+	meta
+	// Call protocols supported by the other call participant
+	Protocol *CallProtocol `json:"protocol"`
+	// List of available call servers
+	Servers []*CallServer `json:"servers"`
+	// A JSON-encoded call config
+	Config string `json:"config"`
+	// Call encryption key
+	EncryptionKey []byte `json:"encryption_key"`
+	// Encryption key fingerprint represented as 4 emoji
+	Emojis []string `json:"emojis"`
+	// True, if peer-to-peer connection is allowed by users privacy settings
+	AllowP2p bool `json:"allow_p2p"`
+	// Custom JSON-encoded call parameters to be passed to tgcalls
+	CustomParameters string `json:"custom_parameters"`
+}
+
+func (*CallStateReady) GetType() string {
+	return TypeCallState
+}
+
+func (*CallStateReady) GetConstructor() string {
+	return ConstructorCallStateReady
+}
+
+func (*CallStateReady) CallStateConstructor() string {
+	return ConstructorCallStateReady
+}
+
+func (entity *CallStateReady) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallStateReady) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallStateReady) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callStateReady"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"protocol":`)
+	entity.Protocol.encodeJSON(encoder)
+	encoder.writeRaw(`,"servers":`)
+	writeList(encoder, entity.Servers, writeTypeValue[*CallServer])
+	encoder.writeRaw(`,"config":`)
+	encoder.writeString(entity.Config)
+	encoder.writeRaw(`,"encryption_key":`)
+	encoder.writeBytes(entity.EncryptionKey)
+	encoder.writeRaw(`,"emojis":`)
+	writeList(encoder, entity.Emojis, (*jsonEncoder).writeString)
+	encoder.writeRaw(`,"allow_p2p":`)
+	encoder.writeBool(entity.AllowP2p)
+	encoder.writeRaw(`,"custom_parameters":`)
+	encoder.writeString(entity.CustomParameters)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallStateReady) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "protocol":
+			entity.Protocol, err = decodeEntity[CallProtocol](decoder)
+
+		case "servers":
+			entity.Servers, err = readList(decoder, decodeEntity[CallServer])
+
+		case "config":
+			entity.Config, err = decoder.readString()
+
+		case "encryption_key":
+			entity.EncryptionKey, err = decoder.readBytes()
+
+		case "emojis":
+			entity.Emojis, err = readList(decoder, (*jsonDecoder).readString)
+
+		case "allow_p2p":
+			entity.AllowP2p, err = decoder.readBool()
+
+		case "custom_parameters":
+			entity.CustomParameters, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallStateReady)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The call is hanging up after discardCall has been called
+type CallStateHangingUp struct {
+	meta
+}
+
+func (*CallStateHangingUp) GetType() string {
+	return TypeCallState
+}
+
+func (*CallStateHangingUp) GetConstructor() string {
+	return ConstructorCallStateHangingUp
+}
+
+func (*CallStateHangingUp) CallStateConstructor() string {
+	return ConstructorCallStateHangingUp
+}
+
+func (entity *CallStateHangingUp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallStateHangingUp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallStateHangingUp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callStateHangingUp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallStateHangingUp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallStateHangingUp)
+	})
+}
+
+// The call has ended successfully
+type CallStateDiscarded struct {
+	meta
+	// The reason why the call has ended
+	Reason CallDiscardReason `json:"reason"`
+	// True, if the call rating must be sent to the server
+	NeedRating bool `json:"need_rating"`
+	// True, if the call debug information must be sent to the server
+	NeedDebugInformation bool `json:"need_debug_information"`
+	// True, if the call log must be sent to the server
+	NeedLog bool `json:"need_log"`
+}
+
+func (*CallStateDiscarded) GetType() string {
+	return TypeCallState
+}
+
+func (*CallStateDiscarded) GetConstructor() string {
+	return ConstructorCallStateDiscarded
+}
+
+func (*CallStateDiscarded) CallStateConstructor() string {
+	return ConstructorCallStateDiscarded
+}
+
+func (entity *CallStateDiscarded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallStateDiscarded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallStateDiscarded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callStateDiscarded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reason":`)
+	encoder.writeType(entity.Reason)
+	encoder.writeRaw(`,"need_rating":`)
+	encoder.writeBool(entity.NeedRating)
+	encoder.writeRaw(`,"need_debug_information":`)
+	encoder.writeBool(entity.NeedDebugInformation)
+	encoder.writeRaw(`,"need_log":`)
+	encoder.writeBool(entity.NeedLog)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallStateDiscarded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reason":
+			entity.Reason, err = decodeCallDiscardReason(decoder)
+
+		case "need_rating":
+			entity.NeedRating, err = decoder.readBool()
+
+		case "need_debug_information":
+			entity.NeedDebugInformation, err = decoder.readBool()
+
+		case "need_log":
+			entity.NeedLog, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallStateDiscarded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The call has ended with an error
+type CallStateError struct {
+	meta
+	// Error. An error with the code 4005000 will be returned if an outgoing call is missed because of an expired timeout
+	Error *Error `json:"error"`
+}
+
+func (*CallStateError) GetType() string {
+	return TypeCallState
+}
+
+func (*CallStateError) GetConstructor() string {
+	return ConstructorCallStateError
+}
+
+func (*CallStateError) CallStateConstructor() string {
+	return ConstructorCallStateError
+}
+
+func (entity *CallStateError) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallStateError) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallStateError) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callStateError"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"error":`)
+	entity.Error.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallStateError) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "error":
+			entity.Error, err = decodeEntity[Error](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCallStateError)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The worst available video quality
+type GroupCallVideoQualityThumbnail struct {
+	meta
+}
+
+func (*GroupCallVideoQualityThumbnail) GetType() string {
+	return TypeGroupCallVideoQuality
+}
+
+func (*GroupCallVideoQualityThumbnail) GetConstructor() string {
+	return ConstructorGroupCallVideoQualityThumbnail
+}
+
+func (*GroupCallVideoQualityThumbnail) GroupCallVideoQualityConstructor() string {
+	return ConstructorGroupCallVideoQualityThumbnail
+}
+
+func (entity *GroupCallVideoQualityThumbnail) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallVideoQualityThumbnail) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallVideoQualityThumbnail) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallVideoQualityThumbnail"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallVideoQualityThumbnail) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorGroupCallVideoQualityThumbnail)
+	})
+}
+
+// The medium video quality
+type GroupCallVideoQualityMedium struct {
+	meta
+}
+
+func (*GroupCallVideoQualityMedium) GetType() string {
+	return TypeGroupCallVideoQuality
+}
+
+func (*GroupCallVideoQualityMedium) GetConstructor() string {
+	return ConstructorGroupCallVideoQualityMedium
+}
+
+func (*GroupCallVideoQualityMedium) GroupCallVideoQualityConstructor() string {
+	return ConstructorGroupCallVideoQualityMedium
+}
+
+func (entity *GroupCallVideoQualityMedium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallVideoQualityMedium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallVideoQualityMedium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallVideoQualityMedium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallVideoQualityMedium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorGroupCallVideoQualityMedium)
+	})
+}
+
+// The best available video quality
+type GroupCallVideoQualityFull struct {
+	meta
+}
+
+func (*GroupCallVideoQualityFull) GetType() string {
+	return TypeGroupCallVideoQuality
+}
+
+func (*GroupCallVideoQualityFull) GetConstructor() string {
+	return ConstructorGroupCallVideoQualityFull
+}
+
+func (*GroupCallVideoQualityFull) GroupCallVideoQualityConstructor() string {
+	return ConstructorGroupCallVideoQualityFull
+}
+
+func (entity *GroupCallVideoQualityFull) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallVideoQualityFull) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallVideoQualityFull) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallVideoQualityFull"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallVideoQualityFull) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorGroupCallVideoQualityFull)
+	})
+}
+
+// Describes an available stream in a group call
+type GroupCallStream struct {
+	meta
+	// Identifier of an audio/video channel
+	ChannelId int32 `json:"channel_id"`
+	// Scale of segment durations in the stream. The duration is 1000/(2**scale) milliseconds
+	Scale int32 `json:"scale"`
+	// Point in time when the stream currently ends; Unix timestamp in milliseconds
+	TimeOffset int64 `json:"time_offset"`
+}
+
+func (*GroupCallStream) GetType() string {
+	return TypeGroupCallStream
+}
+
+func (*GroupCallStream) GetConstructor() string {
+	return ConstructorGroupCallStream
+}
+
+func (entity *GroupCallStream) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallStream) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallStream) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallStream"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"channel_id":`)
+	encoder.writeInt32(entity.ChannelId)
+	encoder.writeRaw(`,"scale":`)
+	encoder.writeInt32(entity.Scale)
+	encoder.writeRaw(`,"time_offset":`)
+	encoder.writeInt64(entity.TimeOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallStream) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "channel_id":
+			entity.ChannelId, err = decoder.readInt32()
+
+		case "scale":
+			entity.Scale, err = decoder.readInt32()
+
+		case "time_offset":
+			entity.TimeOffset, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCallStream)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of group call streams
+type GroupCallStreams struct {
+	meta
+	// A list of group call streams
+	Streams []*GroupCallStream `json:"streams"`
+}
+
+func (*GroupCallStreams) GetType() string {
+	return TypeGroupCallStreams
+}
+
+func (*GroupCallStreams) GetConstructor() string {
+	return ConstructorGroupCallStreams
+}
+
+func (entity *GroupCallStreams) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallStreams) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallStreams) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallStreams"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"streams":`)
+	writeList(encoder, entity.Streams, writeTypeValue[*GroupCallStream])
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallStreams) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "streams":
+			entity.Streams, err = readList(decoder, decodeEntity[GroupCallStream])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCallStreams)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a recently speaking participant in a group call
+type GroupCallRecentSpeaker struct {
+	meta
+	// Group call participant identifier
+	ParticipantId MessageSender `json:"participant_id"`
+	// True, is the user has spoken recently
+	IsSpeaking bool `json:"is_speaking"`
+}
+
+func (*GroupCallRecentSpeaker) GetType() string {
+	return TypeGroupCallRecentSpeaker
+}
+
+func (*GroupCallRecentSpeaker) GetConstructor() string {
+	return ConstructorGroupCallRecentSpeaker
+}
+
+func (entity *GroupCallRecentSpeaker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallRecentSpeaker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallRecentSpeaker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallRecentSpeaker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"participant_id":`)
+	encoder.writeType(entity.ParticipantId)
+	encoder.writeRaw(`,"is_speaking":`)
+	encoder.writeBool(entity.IsSpeaking)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallRecentSpeaker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "participant_id":
+			entity.ParticipantId, err = decodeMessageSender(decoder)
+
+		case "is_speaking":
+			entity.IsSpeaking, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCallRecentSpeaker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a group call
+type GroupCall struct {
+	meta
+	// Group call identifier
+	Id int32 `json:"id"`
+	// Identifier of one-to-one call from which the group call was created; 0 if unknown
+	FromCallId int32 `json:"from_call_id"`
+	// Group call title
+	Title string `json:"title"`
+	// Point in time (Unix timestamp) when the group call is expected to be started by an administrator; 0 if it is already active or was ended
+	ScheduledStartDate int32 `json:"scheduled_start_date"`
+	// True, if the group call is scheduled and the current user will receive a notification when the group call starts
+	EnabledStartNotification bool `json:"enabled_start_notification"`
+	// True, if the call is active
+	IsActive bool `json:"is_active"`
+	// True, if the chat is an RTMP stream instead of an ordinary video chat
+	IsRtmpStream bool `json:"is_rtmp_stream"`
+	// True, if the call is joined
+	IsJoined bool `json:"is_joined"`
+	// True, if user was kicked from the call because of network loss and the call needs to be rejoined
+	NeedRejoin bool `json:"need_rejoin"`
+	// True, if the current user can manage the group call
+	CanBeManaged bool `json:"can_be_managed"`
+	// Number of participants in the group call
+	ParticipantCount int32 `json:"participant_count"`
+	// True, if group call participants, which are muted, aren't returned in participant list
+	HasHiddenListeners bool `json:"has_hidden_listeners"`
+	// True, if all group call participants are loaded
+	LoadedAllParticipants bool `json:"loaded_all_participants"`
+	// At most 3 recently speaking users in the group call
+	RecentSpeakers []*GroupCallRecentSpeaker `json:"recent_speakers"`
+	// True, if the current user's video is enabled
+	IsMyVideoEnabled bool `json:"is_my_video_enabled"`
+	// True, if the current user's video is paused
+	IsMyVideoPaused bool `json:"is_my_video_paused"`
+	// True, if the current user can broadcast video or share screen
+	CanEnableVideo bool `json:"can_enable_video"`
+	// True, if only group call administrators can unmute new participants
+	MuteNewParticipants bool `json:"mute_new_participants"`
+	// True, if the current user can enable or disable mute_new_participants setting
+	CanToggleMuteNewParticipants bool `json:"can_toggle_mute_new_participants"`
+	// Duration of the ongoing group call recording, in seconds; 0 if none. An updateGroupCall update is not triggered when value of this field changes, but the same recording goes on
+	RecordDuration int32 `json:"record_duration"`
+	// True, if a video file is being recorded for the call
+	IsVideoRecorded bool `json:"is_video_recorded"`
+	// Call duration, in seconds; for ended calls only
+	Duration int32 `json:"duration"`
+}
+
+func (*GroupCall) GetType() string {
+	return TypeGroupCall
+}
+
+func (*GroupCall) GetConstructor() string {
+	return ConstructorGroupCall
+}
+
+func (entity *GroupCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"from_call_id":`)
+	encoder.writeInt32(entity.FromCallId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"scheduled_start_date":`)
+	encoder.writeInt32(entity.ScheduledStartDate)
+	encoder.writeRaw(`,"enabled_start_notification":`)
+	encoder.writeBool(entity.EnabledStartNotification)
+	encoder.writeRaw(`,"is_active":`)
+	encoder.writeBool(entity.IsActive)
+	encoder.writeRaw(`,"is_rtmp_stream":`)
+	encoder.writeBool(entity.IsRtmpStream)
+	encoder.writeRaw(`,"is_joined":`)
+	encoder.writeBool(entity.IsJoined)
+	encoder.writeRaw(`,"need_rejoin":`)
+	encoder.writeBool(entity.NeedRejoin)
+	encoder.writeRaw(`,"can_be_managed":`)
+	encoder.writeBool(entity.CanBeManaged)
+	encoder.writeRaw(`,"participant_count":`)
+	encoder.writeInt32(entity.ParticipantCount)
+	encoder.writeRaw(`,"has_hidden_listeners":`)
+	encoder.writeBool(entity.HasHiddenListeners)
+	encoder.writeRaw(`,"loaded_all_participants":`)
+	encoder.writeBool(entity.LoadedAllParticipants)
+	encoder.writeRaw(`,"recent_speakers":`)
+	writeList(encoder, entity.RecentSpeakers, writeTypeValue[*GroupCallRecentSpeaker])
+	encoder.writeRaw(`,"is_my_video_enabled":`)
+	encoder.writeBool(entity.IsMyVideoEnabled)
+	encoder.writeRaw(`,"is_my_video_paused":`)
+	encoder.writeBool(entity.IsMyVideoPaused)
+	encoder.writeRaw(`,"can_enable_video":`)
+	encoder.writeBool(entity.CanEnableVideo)
+	encoder.writeRaw(`,"mute_new_participants":`)
+	encoder.writeBool(entity.MuteNewParticipants)
+	encoder.writeRaw(`,"can_toggle_mute_new_participants":`)
+	encoder.writeBool(entity.CanToggleMuteNewParticipants)
+	encoder.writeRaw(`,"record_duration":`)
+	encoder.writeInt32(entity.RecordDuration)
+	encoder.writeRaw(`,"is_video_recorded":`)
+	encoder.writeBool(entity.IsVideoRecorded)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "from_call_id":
+			entity.FromCallId, err = decoder.readInt32()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "scheduled_start_date":
+			entity.ScheduledStartDate, err = decoder.readInt32()
+
+		case "enabled_start_notification":
+			entity.EnabledStartNotification, err = decoder.readBool()
+
+		case "is_active":
+			entity.IsActive, err = decoder.readBool()
+
+		case "is_rtmp_stream":
+			entity.IsRtmpStream, err = decoder.readBool()
+
+		case "is_joined":
+			entity.IsJoined, err = decoder.readBool()
+
+		case "need_rejoin":
+			entity.NeedRejoin, err = decoder.readBool()
+
+		case "can_be_managed":
+			entity.CanBeManaged, err = decoder.readBool()
+
+		case "participant_count":
+			entity.ParticipantCount, err = decoder.readInt32()
+
+		case "has_hidden_listeners":
+			entity.HasHiddenListeners, err = decoder.readBool()
+
+		case "loaded_all_participants":
+			entity.LoadedAllParticipants, err = decoder.readBool()
+
+		case "recent_speakers":
+			entity.RecentSpeakers, err = readList(decoder, decodeEntity[GroupCallRecentSpeaker])
+
+		case "is_my_video_enabled":
+			entity.IsMyVideoEnabled, err = decoder.readBool()
+
+		case "is_my_video_paused":
+			entity.IsMyVideoPaused, err = decoder.readBool()
+
+		case "can_enable_video":
+			entity.CanEnableVideo, err = decoder.readBool()
+
+		case "mute_new_participants":
+			entity.MuteNewParticipants, err = decoder.readBool()
+
+		case "can_toggle_mute_new_participants":
+			entity.CanToggleMuteNewParticipants, err = decoder.readBool()
+
+		case "record_duration":
+			entity.RecordDuration, err = decoder.readInt32()
+
+		case "is_video_recorded":
+			entity.IsVideoRecorded, err = decoder.readBool()
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a group of video synchronization source identifiers
+type GroupCallVideoSourceGroup struct {
+	meta
+	// The semantics of sources, one of "SIM" or "FID"
+	Semantics string `json:"semantics"`
+	// The list of synchronization source identifiers
+	SourceIds []int32 `json:"source_ids"`
+}
+
+func (*GroupCallVideoSourceGroup) GetType() string {
+	return TypeGroupCallVideoSourceGroup
+}
+
+func (*GroupCallVideoSourceGroup) GetConstructor() string {
+	return ConstructorGroupCallVideoSourceGroup
+}
+
+func (entity *GroupCallVideoSourceGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallVideoSourceGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallVideoSourceGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallVideoSourceGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"semantics":`)
+	encoder.writeString(entity.Semantics)
+	encoder.writeRaw(`,"source_ids":`)
+	writeList(encoder, entity.SourceIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallVideoSourceGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "semantics":
+			entity.Semantics, err = decoder.readString()
+
+		case "source_ids":
+			entity.SourceIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCallVideoSourceGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a group call participant's video channel
+type GroupCallParticipantVideoInfo struct {
+	meta
+	// List of synchronization source groups of the video
+	SourceGroups []*GroupCallVideoSourceGroup `json:"source_groups"`
+	// Video channel endpoint identifier
+	EndpointId string `json:"endpoint_id"`
+	// True, if the video is paused. This flag needs to be ignored, if new video frames are received
+	IsPaused bool `json:"is_paused"`
+}
+
+func (*GroupCallParticipantVideoInfo) GetType() string {
+	return TypeGroupCallParticipantVideoInfo
+}
+
+func (*GroupCallParticipantVideoInfo) GetConstructor() string {
+	return ConstructorGroupCallParticipantVideoInfo
+}
+
+func (entity *GroupCallParticipantVideoInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallParticipantVideoInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallParticipantVideoInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallParticipantVideoInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"source_groups":`)
+	writeList(encoder, entity.SourceGroups, writeTypeValue[*GroupCallVideoSourceGroup])
+	encoder.writeRaw(`,"endpoint_id":`)
+	encoder.writeString(entity.EndpointId)
+	encoder.writeRaw(`,"is_paused":`)
+	encoder.writeBool(entity.IsPaused)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallParticipantVideoInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "source_groups":
+			entity.SourceGroups, err = readList(decoder, decodeEntity[GroupCallVideoSourceGroup])
+
+		case "endpoint_id":
+			entity.EndpointId, err = decoder.readString()
+
+		case "is_paused":
+			entity.IsPaused, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCallParticipantVideoInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a group call participant
+type GroupCallParticipant struct {
+	meta
+	// Identifier of the group call participant
+	ParticipantId MessageSender `json:"participant_id"`
+	// User's audio channel synchronization source identifier
+	AudioSourceId int32 `json:"audio_source_id"`
+	// User's screen sharing audio channel synchronization source identifier
+	ScreenSharingAudioSourceId int32 `json:"screen_sharing_audio_source_id"`
+	// Information about user's video channel; may be null if there is no active video
+	VideoInfo *GroupCallParticipantVideoInfo `json:"video_info,omitempty"`
+	// Information about user's screen sharing video channel; may be null if there is no active screen sharing video
+	ScreenSharingVideoInfo *GroupCallParticipantVideoInfo `json:"screen_sharing_video_info,omitempty"`
+	// The participant user's bio or the participant chat's description
+	Bio string `json:"bio"`
+	// True, if the participant is the current user
+	IsCurrentUser bool `json:"is_current_user"`
+	// True, if the participant is speaking as set by setGroupCallParticipantIsSpeaking
+	IsSpeaking bool `json:"is_speaking"`
+	// True, if the participant hand is raised
+	IsHandRaised bool `json:"is_hand_raised"`
+	// True, if the current user can mute the participant for all other group call participants
+	CanBeMutedForAllUsers bool `json:"can_be_muted_for_all_users"`
+	// True, if the current user can allow the participant to unmute themselves or unmute the participant (if the participant is the current user)
+	CanBeUnmutedForAllUsers bool `json:"can_be_unmuted_for_all_users"`
+	// True, if the current user can mute the participant only for self
+	CanBeMutedForCurrentUser bool `json:"can_be_muted_for_current_user"`
+	// True, if the current user can unmute the participant for self
+	CanBeUnmutedForCurrentUser bool `json:"can_be_unmuted_for_current_user"`
+	// True, if the participant is muted for all users
+	IsMutedForAllUsers bool `json:"is_muted_for_all_users"`
+	// True, if the participant is muted for the current user
+	IsMutedForCurrentUser bool `json:"is_muted_for_current_user"`
+	// True, if the participant is muted for all users, but can unmute themselves
+	CanUnmuteSelf bool `json:"can_unmute_self"`
+	// Participant's volume level; 1-20000 in hundreds of percents
+	VolumeLevel int32 `json:"volume_level"`
+	// User's order in the group call participant list. Orders must be compared lexicographically. The bigger is order, the higher is user in the list. If order is empty, the user must be removed from the participant list
+	Order string `json:"order"`
+}
+
+func (*GroupCallParticipant) GetType() string {
+	return TypeGroupCallParticipant
+}
+
+func (*GroupCallParticipant) GetConstructor() string {
+	return ConstructorGroupCallParticipant
+}
+
+func (entity *GroupCallParticipant) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GroupCallParticipant) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GroupCallParticipant) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"groupCallParticipant"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"participant_id":`)
+	encoder.writeType(entity.ParticipantId)
+	encoder.writeRaw(`,"audio_source_id":`)
+	encoder.writeInt32(entity.AudioSourceId)
+	encoder.writeRaw(`,"screen_sharing_audio_source_id":`)
+	encoder.writeInt32(entity.ScreenSharingAudioSourceId)
+	if entity.VideoInfo != nil {
+		encoder.writeRaw(`,"video_info":`)
+		entity.VideoInfo.encodeJSON(encoder)
+	}
+	if entity.ScreenSharingVideoInfo != nil {
+		encoder.writeRaw(`,"screen_sharing_video_info":`)
+		entity.ScreenSharingVideoInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"bio":`)
+	encoder.writeString(entity.Bio)
+	encoder.writeRaw(`,"is_current_user":`)
+	encoder.writeBool(entity.IsCurrentUser)
+	encoder.writeRaw(`,"is_speaking":`)
+	encoder.writeBool(entity.IsSpeaking)
+	encoder.writeRaw(`,"is_hand_raised":`)
+	encoder.writeBool(entity.IsHandRaised)
+	encoder.writeRaw(`,"can_be_muted_for_all_users":`)
+	encoder.writeBool(entity.CanBeMutedForAllUsers)
+	encoder.writeRaw(`,"can_be_unmuted_for_all_users":`)
+	encoder.writeBool(entity.CanBeUnmutedForAllUsers)
+	encoder.writeRaw(`,"can_be_muted_for_current_user":`)
+	encoder.writeBool(entity.CanBeMutedForCurrentUser)
+	encoder.writeRaw(`,"can_be_unmuted_for_current_user":`)
+	encoder.writeBool(entity.CanBeUnmutedForCurrentUser)
+	encoder.writeRaw(`,"is_muted_for_all_users":`)
+	encoder.writeBool(entity.IsMutedForAllUsers)
+	encoder.writeRaw(`,"is_muted_for_current_user":`)
+	encoder.writeBool(entity.IsMutedForCurrentUser)
+	encoder.writeRaw(`,"can_unmute_self":`)
+	encoder.writeBool(entity.CanUnmuteSelf)
+	encoder.writeRaw(`,"volume_level":`)
+	encoder.writeInt32(entity.VolumeLevel)
+	encoder.writeRaw(`,"order":`)
+	encoder.writeString(entity.Order)
+	encoder.writeRaw("}")
+}
+
+func (entity *GroupCallParticipant) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "participant_id":
+			entity.ParticipantId, err = decodeMessageSender(decoder)
+
+		case "audio_source_id":
+			entity.AudioSourceId, err = decoder.readInt32()
+
+		case "screen_sharing_audio_source_id":
+			entity.ScreenSharingAudioSourceId, err = decoder.readInt32()
+
+		case "video_info":
+			entity.VideoInfo, err = decodeEntity[GroupCallParticipantVideoInfo](decoder)
+
+		case "screen_sharing_video_info":
+			entity.ScreenSharingVideoInfo, err = decodeEntity[GroupCallParticipantVideoInfo](decoder)
+
+		case "bio":
+			entity.Bio, err = decoder.readString()
+
+		case "is_current_user":
+			entity.IsCurrentUser, err = decoder.readBool()
+
+		case "is_speaking":
+			entity.IsSpeaking, err = decoder.readBool()
+
+		case "is_hand_raised":
+			entity.IsHandRaised, err = decoder.readBool()
+
+		case "can_be_muted_for_all_users":
+			entity.CanBeMutedForAllUsers, err = decoder.readBool()
+
+		case "can_be_unmuted_for_all_users":
+			entity.CanBeUnmutedForAllUsers, err = decoder.readBool()
+
+		case "can_be_muted_for_current_user":
+			entity.CanBeMutedForCurrentUser, err = decoder.readBool()
+
+		case "can_be_unmuted_for_current_user":
+			entity.CanBeUnmutedForCurrentUser, err = decoder.readBool()
+
+		case "is_muted_for_all_users":
+			entity.IsMutedForAllUsers, err = decoder.readBool()
+
+		case "is_muted_for_current_user":
+			entity.IsMutedForCurrentUser, err = decoder.readBool()
+
+		case "can_unmute_self":
+			entity.CanUnmuteSelf, err = decoder.readBool()
+
+		case "volume_level":
+			entity.VolumeLevel, err = decoder.readInt32()
+
+		case "order":
+			entity.Order, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGroupCallParticipant)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user heard their own voice
+type CallProblemEcho struct {
+	meta
+}
+
+func (*CallProblemEcho) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemEcho) GetConstructor() string {
+	return ConstructorCallProblemEcho
+}
+
+func (*CallProblemEcho) CallProblemConstructor() string {
+	return ConstructorCallProblemEcho
+}
+
+func (entity *CallProblemEcho) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemEcho) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemEcho) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemEcho"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemEcho) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemEcho)
+	})
+}
+
+// The user heard background noise
+type CallProblemNoise struct {
+	meta
+}
+
+func (*CallProblemNoise) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemNoise) GetConstructor() string {
+	return ConstructorCallProblemNoise
+}
+
+func (*CallProblemNoise) CallProblemConstructor() string {
+	return ConstructorCallProblemNoise
+}
+
+func (entity *CallProblemNoise) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemNoise) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemNoise) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemNoise"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemNoise) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemNoise)
+	})
+}
+
+// The other side kept disappearing
+type CallProblemInterruptions struct {
+	meta
+}
+
+func (*CallProblemInterruptions) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemInterruptions) GetConstructor() string {
+	return ConstructorCallProblemInterruptions
+}
+
+func (*CallProblemInterruptions) CallProblemConstructor() string {
+	return ConstructorCallProblemInterruptions
+}
+
+func (entity *CallProblemInterruptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemInterruptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemInterruptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemInterruptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemInterruptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemInterruptions)
+	})
+}
+
+// The speech was distorted
+type CallProblemDistortedSpeech struct {
+	meta
+}
+
+func (*CallProblemDistortedSpeech) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemDistortedSpeech) GetConstructor() string {
+	return ConstructorCallProblemDistortedSpeech
+}
+
+func (*CallProblemDistortedSpeech) CallProblemConstructor() string {
+	return ConstructorCallProblemDistortedSpeech
+}
+
+func (entity *CallProblemDistortedSpeech) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemDistortedSpeech) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemDistortedSpeech) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemDistortedSpeech"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemDistortedSpeech) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemDistortedSpeech)
+	})
+}
+
+// The user couldn't hear the other side
+type CallProblemSilentLocal struct {
+	meta
+}
+
+func (*CallProblemSilentLocal) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemSilentLocal) GetConstructor() string {
+	return ConstructorCallProblemSilentLocal
+}
+
+func (*CallProblemSilentLocal) CallProblemConstructor() string {
+	return ConstructorCallProblemSilentLocal
+}
+
+func (entity *CallProblemSilentLocal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemSilentLocal) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemSilentLocal) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemSilentLocal"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemSilentLocal) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemSilentLocal)
+	})
+}
+
+// The other side couldn't hear the user
+type CallProblemSilentRemote struct {
+	meta
+}
+
+func (*CallProblemSilentRemote) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemSilentRemote) GetConstructor() string {
+	return ConstructorCallProblemSilentRemote
+}
+
+func (*CallProblemSilentRemote) CallProblemConstructor() string {
+	return ConstructorCallProblemSilentRemote
+}
+
+func (entity *CallProblemSilentRemote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemSilentRemote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemSilentRemote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemSilentRemote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemSilentRemote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemSilentRemote)
+	})
+}
+
+// The call ended unexpectedly
+type CallProblemDropped struct {
+	meta
+}
+
+func (*CallProblemDropped) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemDropped) GetConstructor() string {
+	return ConstructorCallProblemDropped
+}
+
+func (*CallProblemDropped) CallProblemConstructor() string {
+	return ConstructorCallProblemDropped
+}
+
+func (entity *CallProblemDropped) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemDropped) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemDropped) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemDropped"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemDropped) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemDropped)
+	})
+}
+
+// The video was distorted
+type CallProblemDistortedVideo struct {
+	meta
+}
+
+func (*CallProblemDistortedVideo) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemDistortedVideo) GetConstructor() string {
+	return ConstructorCallProblemDistortedVideo
+}
+
+func (*CallProblemDistortedVideo) CallProblemConstructor() string {
+	return ConstructorCallProblemDistortedVideo
+}
+
+func (entity *CallProblemDistortedVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemDistortedVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemDistortedVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemDistortedVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemDistortedVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemDistortedVideo)
+	})
+}
+
+// The video was pixelated
+type CallProblemPixelatedVideo struct {
+	meta
+}
+
+func (*CallProblemPixelatedVideo) GetType() string {
+	return TypeCallProblem
+}
+
+func (*CallProblemPixelatedVideo) GetConstructor() string {
+	return ConstructorCallProblemPixelatedVideo
+}
+
+func (*CallProblemPixelatedVideo) CallProblemConstructor() string {
+	return ConstructorCallProblemPixelatedVideo
+}
+
+func (entity *CallProblemPixelatedVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CallProblemPixelatedVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CallProblemPixelatedVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"callProblemPixelatedVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CallProblemPixelatedVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCallProblemPixelatedVideo)
+	})
+}
+
+// Describes a call
+type Call struct {
+	meta
+	// Call identifier, not persistent
+	Id int32 `json:"id"`
+	// User identifier of the other call participant
+	UserId int64 `json:"user_id"`
+	// True, if the call is outgoing
+	IsOutgoing bool `json:"is_outgoing"`
+	// True, if the call is a video call
+	IsVideo bool `json:"is_video"`
+	// Call state
+	State CallState `json:"state"`
+	// Identifier of the group call associated with the call; 0 if the group call isn't created yet. The group call can be received through the method getGroupCall
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (*Call) GetType() string {
+	return TypeCall
+}
+
+func (*Call) GetConstructor() string {
+	return ConstructorCall
+}
+
+func (entity *Call) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Call) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Call) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"call"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"is_outgoing":`)
+	encoder.writeBool(entity.IsOutgoing)
+	encoder.writeRaw(`,"is_video":`)
+	encoder.writeBool(entity.IsVideo)
+	encoder.writeRaw(`,"state":`)
+	encoder.writeType(entity.State)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (entity *Call) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "is_outgoing":
+			entity.IsOutgoing, err = decoder.readBool()
+
+		case "is_video":
+			entity.IsVideo, err = decoder.readBool()
+
+		case "state":
+			entity.State, err = decodeCallState(decoder)
+
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
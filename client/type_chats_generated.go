@@ -0,0 +1,14530 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeInputChatPhoto                         = "InputChatPhoto"
+	TypeChatMemberStatus                       = "ChatMemberStatus"
+	TypeChatMembersFilter                      = "ChatMembersFilter"
+	TypeSupergroupMembersFilter                = "SupergroupMembersFilter"
+	TypeInviteLinkChatType                     = "InviteLinkChatType"
+	TypeSecretChatState                        = "SecretChatState"
+	TypeChatType                               = "ChatType"
+	TypeChatList                               = "ChatList"
+	TypeChatSource                             = "ChatSource"
+	TypePublicChatType                         = "PublicChatType"
+	TypeChatActionBar                          = "ChatActionBar"
+	TypeChatAction                             = "ChatAction"
+	TypeTargetChat                             = "TargetChat"
+	TypeChatEventAction                        = "ChatEventAction"
+	TypeCheckChatUsernameResult                = "CheckChatUsernameResult"
+	TypeNotificationGroupType                  = "NotificationGroupType"
+	TypeReportChatResult                       = "ReportChatResult"
+	TypeTopChatCategory                        = "TopChatCategory"
+	TypeChatStatisticsObjectType               = "ChatStatisticsObjectType"
+	TypeChatStatistics                         = "ChatStatistics"
+	TypeChatBackground                         = "ChatBackground"
+	TypeChatPhotoInfo                          = "ChatPhotoInfo"
+	TypeChatLocation                           = "ChatLocation"
+	TypeAnimatedChatPhoto                      = "AnimatedChatPhoto"
+	TypeChatPhoto                              = "ChatPhoto"
+	TypeChatPhotos                             = "ChatPhotos"
+	TypeChatPermissions                        = "ChatPermissions"
+	TypeChatAdministratorRights                = "ChatAdministratorRights"
+	TypeChatAdministrator                      = "ChatAdministrator"
+	TypeChatAdministrators                     = "ChatAdministrators"
+	TypeChatMember                             = "ChatMember"
+	TypeChatMembers                            = "ChatMembers"
+	TypeChatInviteLink                         = "ChatInviteLink"
+	TypeChatInviteLinks                        = "ChatInviteLinks"
+	TypeChatInviteLinkCount                    = "ChatInviteLinkCount"
+	TypeChatInviteLinkCounts                   = "ChatInviteLinkCounts"
+	TypeChatInviteLinkMember                   = "ChatInviteLinkMember"
+	TypeChatInviteLinkMembers                  = "ChatInviteLinkMembers"
+	TypeChatInviteLinkInfo                     = "ChatInviteLinkInfo"
+	TypeChatJoinRequest                        = "ChatJoinRequest"
+	TypeChatJoinRequests                       = "ChatJoinRequests"
+	TypeChatJoinRequestsInfo                   = "ChatJoinRequestsInfo"
+	TypeBasicGroup                             = "BasicGroup"
+	TypeBasicGroupFullInfo                     = "BasicGroupFullInfo"
+	TypeSupergroup                             = "Supergroup"
+	TypeSupergroupFullInfo                     = "SupergroupFullInfo"
+	TypeSecretChat                             = "SecretChat"
+	TypeSponsoredChat                          = "SponsoredChat"
+	TypeSponsoredChats                         = "SponsoredChats"
+	TypeChatNotificationSettings               = "ChatNotificationSettings"
+	TypeChatFolderIcon                         = "ChatFolderIcon"
+	TypeChatFolderName                         = "ChatFolderName"
+	TypeChatFolder                             = "ChatFolder"
+	TypeChatFolderInfo                         = "ChatFolderInfo"
+	TypeChatFolderInviteLink                   = "ChatFolderInviteLink"
+	TypeChatFolderInviteLinks                  = "ChatFolderInviteLinks"
+	TypeChatFolderInviteLinkInfo               = "ChatFolderInviteLinkInfo"
+	TypeRecommendedChatFolder                  = "RecommendedChatFolder"
+	TypeRecommendedChatFolders                 = "RecommendedChatFolders"
+	TypeArchiveChatListSettings                = "ArchiveChatListSettings"
+	TypeChatLists                              = "ChatLists"
+	TypeChatPosition                           = "ChatPosition"
+	TypeVideoChat                              = "VideoChat"
+	TypeChat                                   = "Chat"
+	TypeChats                                  = "Chats"
+	TypeCreatedBasicGroupChat                  = "CreatedBasicGroupChat"
+	TypeForumTopicIcon                         = "ForumTopicIcon"
+	TypeForumTopicInfo                         = "ForumTopicInfo"
+	TypeForumTopic                             = "ForumTopic"
+	TypeForumTopics                            = "ForumTopics"
+	TypeSharedChat                             = "SharedChat"
+	TypeTargetChatTypes                        = "TargetChatTypes"
+	TypeChatEvent                              = "ChatEvent"
+	TypeChatEvents                             = "ChatEvents"
+	TypeChatEventLogFilters                    = "ChatEventLogFilters"
+	TypeChatTheme                              = "ChatTheme"
+	TypeNotificationGroup                      = "NotificationGroup"
+	TypeNewChatPrivacySettings                 = "NewChatPrivacySettings"
+	TypeStorageStatisticsByChat                = "StorageStatisticsByChat"
+	TypeChatStatisticsInteractionInfo          = "ChatStatisticsInteractionInfo"
+	TypeChatStatisticsAdministratorActionsInfo = "ChatStatisticsAdministratorActionsInfo"
+	TypeChatStatisticsInviterInfo              = "ChatStatisticsInviterInfo"
+)
+
+const (
+	ConstructorChatBackground                                  = "chatBackground"
+	ConstructorChatPhotoInfo                                   = "chatPhotoInfo"
+	ConstructorChatLocation                                    = "chatLocation"
+	ConstructorAnimatedChatPhoto                               = "animatedChatPhoto"
+	ConstructorChatPhoto                                       = "chatPhoto"
+	ConstructorChatPhotos                                      = "chatPhotos"
+	ConstructorInputChatPhotoPrevious                          = "inputChatPhotoPrevious"
+	ConstructorInputChatPhotoStatic                            = "inputChatPhotoStatic"
+	ConstructorInputChatPhotoAnimation                         = "inputChatPhotoAnimation"
+	ConstructorInputChatPhotoSticker                           = "inputChatPhotoSticker"
+	ConstructorChatPermissions                                 = "chatPermissions"
+	ConstructorChatAdministratorRights                         = "chatAdministratorRights"
+	ConstructorChatAdministrator                               = "chatAdministrator"
+	ConstructorChatAdministrators                              = "chatAdministrators"
+	ConstructorChatMemberStatusCreator                         = "chatMemberStatusCreator"
+	ConstructorChatMemberStatusAdministrator                   = "chatMemberStatusAdministrator"
+	ConstructorChatMemberStatusMember                          = "chatMemberStatusMember"
+	ConstructorChatMemberStatusRestricted                      = "chatMemberStatusRestricted"
+	ConstructorChatMemberStatusLeft                            = "chatMemberStatusLeft"
+	ConstructorChatMemberStatusBanned                          = "chatMemberStatusBanned"
+	ConstructorChatMember                                      = "chatMember"
+	ConstructorChatMembers                                     = "chatMembers"
+	ConstructorChatMembersFilterContacts                       = "chatMembersFilterContacts"
+	ConstructorChatMembersFilterAdministrators                 = "chatMembersFilterAdministrators"
+	ConstructorChatMembersFilterMembers                        = "chatMembersFilterMembers"
+	ConstructorChatMembersFilterMention                        = "chatMembersFilterMention"
+	ConstructorChatMembersFilterRestricted                     = "chatMembersFilterRestricted"
+	ConstructorChatMembersFilterBanned                         = "chatMembersFilterBanned"
+	ConstructorChatMembersFilterBots                           = "chatMembersFilterBots"
+	ConstructorSupergroupMembersFilterRecent                   = "supergroupMembersFilterRecent"
+	ConstructorSupergroupMembersFilterContacts                 = "supergroupMembersFilterContacts"
+	ConstructorSupergroupMembersFilterAdministrators           = "supergroupMembersFilterAdministrators"
+	ConstructorSupergroupMembersFilterSearch                   = "supergroupMembersFilterSearch"
+	ConstructorSupergroupMembersFilterRestricted               = "supergroupMembersFilterRestricted"
+	ConstructorSupergroupMembersFilterBanned                   = "supergroupMembersFilterBanned"
+	ConstructorSupergroupMembersFilterMention                  = "supergroupMembersFilterMention"
+	ConstructorSupergroupMembersFilterBots                     = "supergroupMembersFilterBots"
+	ConstructorChatInviteLink                                  = "chatInviteLink"
+	ConstructorChatInviteLinks                                 = "chatInviteLinks"
+	ConstructorChatInviteLinkCount                             = "chatInviteLinkCount"
+	ConstructorChatInviteLinkCounts                            = "chatInviteLinkCounts"
+	ConstructorChatInviteLinkMember                            = "chatInviteLinkMember"
+	ConstructorChatInviteLinkMembers                           = "chatInviteLinkMembers"
+	ConstructorInviteLinkChatTypeBasicGroup                    = "inviteLinkChatTypeBasicGroup"
+	ConstructorInviteLinkChatTypeSupergroup                    = "inviteLinkChatTypeSupergroup"
+	ConstructorInviteLinkChatTypeChannel                       = "inviteLinkChatTypeChannel"
+	ConstructorChatInviteLinkInfo                              = "chatInviteLinkInfo"
+	ConstructorChatJoinRequest                                 = "chatJoinRequest"
+	ConstructorChatJoinRequests                                = "chatJoinRequests"
+	ConstructorChatJoinRequestsInfo                            = "chatJoinRequestsInfo"
+	ConstructorBasicGroup                                      = "basicGroup"
+	ConstructorBasicGroupFullInfo                              = "basicGroupFullInfo"
+	ConstructorSupergroup                                      = "supergroup"
+	ConstructorSupergroupFullInfo                              = "supergroupFullInfo"
+	ConstructorSecretChatStatePending                          = "secretChatStatePending"
+	ConstructorSecretChatStateReady                            = "secretChatStateReady"
+	ConstructorSecretChatStateClosed                           = "secretChatStateClosed"
+	ConstructorSecretChat                                      = "secretChat"
+	ConstructorSponsoredChat                                   = "sponsoredChat"
+	ConstructorSponsoredChats                                  = "sponsoredChats"
+	ConstructorChatNotificationSettings                        = "chatNotificationSettings"
+	ConstructorChatTypePrivate                                 = "chatTypePrivate"
+	ConstructorChatTypeBasicGroup                              = "chatTypeBasicGroup"
+	ConstructorChatTypeSupergroup                              = "chatTypeSupergroup"
+	ConstructorChatTypeSecret                                  = "chatTypeSecret"
+	ConstructorChatFolderIcon                                  = "chatFolderIcon"
+	ConstructorChatFolderName                                  = "chatFolderName"
+	ConstructorChatFolder                                      = "chatFolder"
+	ConstructorChatFolderInfo                                  = "chatFolderInfo"
+	ConstructorChatFolderInviteLink                            = "chatFolderInviteLink"
+	ConstructorChatFolderInviteLinks                           = "chatFolderInviteLinks"
+	ConstructorChatFolderInviteLinkInfo                        = "chatFolderInviteLinkInfo"
+	ConstructorRecommendedChatFolder                           = "recommendedChatFolder"
+	ConstructorRecommendedChatFolders                          = "recommendedChatFolders"
+	ConstructorArchiveChatListSettings                         = "archiveChatListSettings"
+	ConstructorChatListMain                                    = "chatListMain"
+	ConstructorChatListArchive                                 = "chatListArchive"
+	ConstructorChatListFolder                                  = "chatListFolder"
+	ConstructorChatLists                                       = "chatLists"
+	ConstructorChatSourceMtprotoProxy                          = "chatSourceMtprotoProxy"
+	ConstructorChatSourcePublicServiceAnnouncement             = "chatSourcePublicServiceAnnouncement"
+	ConstructorChatPosition                                    = "chatPosition"
+	ConstructorVideoChat                                       = "videoChat"
+	ConstructorChat                                            = "chat"
+	ConstructorChats                                           = "chats"
+	ConstructorCreatedBasicGroupChat                           = "createdBasicGroupChat"
+	ConstructorPublicChatTypeHasUsername                       = "publicChatTypeHasUsername"
+	ConstructorPublicChatTypeIsLocationBased                   = "publicChatTypeIsLocationBased"
+	ConstructorChatActionBarReportSpam                         = "chatActionBarReportSpam"
+	ConstructorChatActionBarInviteMembers                      = "chatActionBarInviteMembers"
+	ConstructorChatActionBarReportAddBlock                     = "chatActionBarReportAddBlock"
+	ConstructorChatActionBarAddContact                         = "chatActionBarAddContact"
+	ConstructorChatActionBarSharePhoneNumber                   = "chatActionBarSharePhoneNumber"
+	ConstructorChatActionBarJoinRequest                        = "chatActionBarJoinRequest"
+	ConstructorForumTopicIcon                                  = "forumTopicIcon"
+	ConstructorForumTopicInfo                                  = "forumTopicInfo"
+	ConstructorForumTopic                                      = "forumTopic"
+	ConstructorForumTopics                                     = "forumTopics"
+	ConstructorSharedChat                                      = "sharedChat"
+	ConstructorChatActionTyping                                = "chatActionTyping"
+	ConstructorChatActionRecordingVideo                        = "chatActionRecordingVideo"
+	ConstructorChatActionUploadingVideo                        = "chatActionUploadingVideo"
+	ConstructorChatActionRecordingVoiceNote                    = "chatActionRecordingVoiceNote"
+	ConstructorChatActionUploadingVoiceNote                    = "chatActionUploadingVoiceNote"
+	ConstructorChatActionUploadingPhoto                        = "chatActionUploadingPhoto"
+	ConstructorChatActionUploadingDocument                     = "chatActionUploadingDocument"
+	ConstructorChatActionChoosingSticker                       = "chatActionChoosingSticker"
+	ConstructorChatActionChoosingLocation                      = "chatActionChoosingLocation"
+	ConstructorChatActionChoosingContact                       = "chatActionChoosingContact"
+	ConstructorChatActionStartPlayingGame                      = "chatActionStartPlayingGame"
+	ConstructorChatActionRecordingVideoNote                    = "chatActionRecordingVideoNote"
+	ConstructorChatActionUploadingVideoNote                    = "chatActionUploadingVideoNote"
+	ConstructorChatActionWatchingAnimations                    = "chatActionWatchingAnimations"
+	ConstructorChatActionCancel                                = "chatActionCancel"
+	ConstructorTargetChatTypes                                 = "targetChatTypes"
+	ConstructorTargetChatCurrent                               = "targetChatCurrent"
+	ConstructorTargetChatChosen                                = "targetChatChosen"
+	ConstructorTargetChatInternalLink                          = "targetChatInternalLink"
+	ConstructorChatEventMessageEdited                          = "chatEventMessageEdited"
+	ConstructorChatEventMessageDeleted                         = "chatEventMessageDeleted"
+	ConstructorChatEventMessagePinned                          = "chatEventMessagePinned"
+	ConstructorChatEventMessageUnpinned                        = "chatEventMessageUnpinned"
+	ConstructorChatEventPollStopped                            = "chatEventPollStopped"
+	ConstructorChatEventMemberJoined                           = "chatEventMemberJoined"
+	ConstructorChatEventMemberJoinedByInviteLink               = "chatEventMemberJoinedByInviteLink"
+	ConstructorChatEventMemberJoinedByRequest                  = "chatEventMemberJoinedByRequest"
+	ConstructorChatEventMemberInvited                          = "chatEventMemberInvited"
+	ConstructorChatEventMemberLeft                             = "chatEventMemberLeft"
+	ConstructorChatEventMemberPromoted                         = "chatEventMemberPromoted"
+	ConstructorChatEventMemberRestricted                       = "chatEventMemberRestricted"
+	ConstructorChatEventMemberSubscriptionExtended             = "chatEventMemberSubscriptionExtended"
+	ConstructorChatEventAvailableReactionsChanged              = "chatEventAvailableReactionsChanged"
+	ConstructorChatEventBackgroundChanged                      = "chatEventBackgroundChanged"
+	ConstructorChatEventDescriptionChanged                     = "chatEventDescriptionChanged"
+	ConstructorChatEventEmojiStatusChanged                     = "chatEventEmojiStatusChanged"
+	ConstructorChatEventLinkedChatChanged                      = "chatEventLinkedChatChanged"
+	ConstructorChatEventLocationChanged                        = "chatEventLocationChanged"
+	ConstructorChatEventMessageAutoDeleteTimeChanged           = "chatEventMessageAutoDeleteTimeChanged"
+	ConstructorChatEventPermissionsChanged                     = "chatEventPermissionsChanged"
+	ConstructorChatEventPhotoChanged                           = "chatEventPhotoChanged"
+	ConstructorChatEventSlowModeDelayChanged                   = "chatEventSlowModeDelayChanged"
+	ConstructorChatEventStickerSetChanged                      = "chatEventStickerSetChanged"
+	ConstructorChatEventCustomEmojiStickerSetChanged           = "chatEventCustomEmojiStickerSetChanged"
+	ConstructorChatEventTitleChanged                           = "chatEventTitleChanged"
+	ConstructorChatEventUsernameChanged                        = "chatEventUsernameChanged"
+	ConstructorChatEventActiveUsernamesChanged                 = "chatEventActiveUsernamesChanged"
+	ConstructorChatEventAccentColorChanged                     = "chatEventAccentColorChanged"
+	ConstructorChatEventProfileAccentColorChanged              = "chatEventProfileAccentColorChanged"
+	ConstructorChatEventHasProtectedContentToggled             = "chatEventHasProtectedContentToggled"
+	ConstructorChatEventInvitesToggled                         = "chatEventInvitesToggled"
+	ConstructorChatEventIsAllHistoryAvailableToggled           = "chatEventIsAllHistoryAvailableToggled"
+	ConstructorChatEventHasAggressiveAntiSpamEnabledToggled    = "chatEventHasAggressiveAntiSpamEnabledToggled"
+	ConstructorChatEventSignMessagesToggled                    = "chatEventSignMessagesToggled"
+	ConstructorChatEventShowMessageSenderToggled               = "chatEventShowMessageSenderToggled"
+	ConstructorChatEventInviteLinkEdited                       = "chatEventInviteLinkEdited"
+	ConstructorChatEventInviteLinkRevoked                      = "chatEventInviteLinkRevoked"
+	ConstructorChatEventInviteLinkDeleted                      = "chatEventInviteLinkDeleted"
+	ConstructorChatEventVideoChatCreated                       = "chatEventVideoChatCreated"
+	ConstructorChatEventVideoChatEnded                         = "chatEventVideoChatEnded"
+	ConstructorChatEventVideoChatMuteNewParticipantsToggled    = "chatEventVideoChatMuteNewParticipantsToggled"
+	ConstructorChatEventVideoChatParticipantIsMutedToggled     = "chatEventVideoChatParticipantIsMutedToggled"
+	ConstructorChatEventVideoChatParticipantVolumeLevelChanged = "chatEventVideoChatParticipantVolumeLevelChanged"
+	ConstructorChatEventIsForumToggled                         = "chatEventIsForumToggled"
+	ConstructorChatEventForumTopicCreated                      = "chatEventForumTopicCreated"
+	ConstructorChatEventForumTopicEdited                       = "chatEventForumTopicEdited"
+	ConstructorChatEventForumTopicToggleIsClosed               = "chatEventForumTopicToggleIsClosed"
+	ConstructorChatEventForumTopicToggleIsHidden               = "chatEventForumTopicToggleIsHidden"
+	ConstructorChatEventForumTopicDeleted                      = "chatEventForumTopicDeleted"
+	ConstructorChatEventForumTopicPinned                       = "chatEventForumTopicPinned"
+	ConstructorChatEvent                                       = "chatEvent"
+	ConstructorChatEvents                                      = "chatEvents"
+	ConstructorChatEventLogFilters                             = "chatEventLogFilters"
+	ConstructorChatTheme                                       = "chatTheme"
+	ConstructorCheckChatUsernameResultOk                       = "checkChatUsernameResultOk"
+	ConstructorCheckChatUsernameResultUsernameInvalid          = "checkChatUsernameResultUsernameInvalid"
+	ConstructorCheckChatUsernameResultUsernameOccupied         = "checkChatUsernameResultUsernameOccupied"
+	ConstructorCheckChatUsernameResultUsernamePurchasable      = "checkChatUsernameResultUsernamePurchasable"
+	ConstructorCheckChatUsernameResultPublicChatsTooMany       = "checkChatUsernameResultPublicChatsTooMany"
+	ConstructorCheckChatUsernameResultPublicGroupsUnavailable  = "checkChatUsernameResultPublicGroupsUnavailable"
+	ConstructorNotificationGroupTypeMessages                   = "notificationGroupTypeMessages"
+	ConstructorNotificationGroupTypeMentions                   = "notificationGroupTypeMentions"
+	ConstructorNotificationGroupTypeSecretChat                 = "notificationGroupTypeSecretChat"
+	ConstructorNotificationGroupTypeCalls                      = "notificationGroupTypeCalls"
+	ConstructorNotificationGroup                               = "notificationGroup"
+	ConstructorNewChatPrivacySettings                          = "newChatPrivacySettings"
+	ConstructorReportChatResultOk                              = "reportChatResultOk"
+	ConstructorReportChatResultOptionRequired                  = "reportChatResultOptionRequired"
+	ConstructorReportChatResultTextRequired                    = "reportChatResultTextRequired"
+	ConstructorReportChatResultMessagesRequired                = "reportChatResultMessagesRequired"
+	ConstructorStorageStatisticsByChat                         = "storageStatisticsByChat"
+	ConstructorTopChatCategoryUsers                            = "topChatCategoryUsers"
+	ConstructorTopChatCategoryBots                             = "topChatCategoryBots"
+	ConstructorTopChatCategoryGroups                           = "topChatCategoryGroups"
+	ConstructorTopChatCategoryChannels                         = "topChatCategoryChannels"
+	ConstructorTopChatCategoryInlineBots                       = "topChatCategoryInlineBots"
+	ConstructorTopChatCategoryWebAppBots                       = "topChatCategoryWebAppBots"
+	ConstructorTopChatCategoryCalls                            = "topChatCategoryCalls"
+	ConstructorTopChatCategoryForwardChats                     = "topChatCategoryForwardChats"
+	ConstructorChatStatisticsObjectTypeMessage                 = "chatStatisticsObjectTypeMessage"
+	ConstructorChatStatisticsObjectTypeStory                   = "chatStatisticsObjectTypeStory"
+	ConstructorChatStatisticsInteractionInfo                   = "chatStatisticsInteractionInfo"
+	ConstructorChatStatisticsAdministratorActionsInfo          = "chatStatisticsAdministratorActionsInfo"
+	ConstructorChatStatisticsInviterInfo                       = "chatStatisticsInviterInfo"
+	ConstructorChatStatisticsSupergroup                        = "chatStatisticsSupergroup"
+	ConstructorChatStatisticsChannel                           = "chatStatisticsChannel"
+)
+
+// Describes a photo to be set as a user profile or chat photo
+type InputChatPhoto interface {
+	InputChatPhotoConstructor() string
+}
+
+// Provides information about the status of a member in a chat
+type ChatMemberStatus interface {
+	ChatMemberStatusConstructor() string
+}
+
+// Specifies the kind of chat members to return in searchChatMembers
+type ChatMembersFilter interface {
+	ChatMembersFilterConstructor() string
+}
+
+// Specifies the kind of chat members to return in getSupergroupMembers
+type SupergroupMembersFilter interface {
+	SupergroupMembersFilterConstructor() string
+}
+
+// Describes the type of chat to which points an invite link
+type InviteLinkChatType interface {
+	InviteLinkChatTypeConstructor() string
+}
+
+// Describes the current secret chat state
+type SecretChatState interface {
+	SecretChatStateConstructor() string
+}
+
+// Describes the type of chat
+type ChatType interface {
+	ChatTypeConstructor() string
+}
+
+// Describes a list of chats
+type ChatList interface {
+	ChatListConstructor() string
+}
+
+// Describes a reason why an external chat is shown in a chat list
+type ChatSource interface {
+	ChatSourceConstructor() string
+}
+
+// Describes type of public chat
+type PublicChatType interface {
+	PublicChatTypeConstructor() string
+}
+
+// Describes actions which must be possible to do through a chat action bar
+type ChatActionBar interface {
+	ChatActionBarConstructor() string
+}
+
+// Describes the different types of activity in a chat
+type ChatAction interface {
+	ChatActionConstructor() string
+}
+
+// Describes the target chat to be opened
+type TargetChat interface {
+	TargetChatConstructor() string
+}
+
+// Represents a chat event
+type ChatEventAction interface {
+	ChatEventActionConstructor() string
+}
+
+// Represents result of checking whether a username can be set for a chat
+type CheckChatUsernameResult interface {
+	CheckChatUsernameResultConstructor() string
+}
+
+// Describes the type of notifications in a notification group
+type NotificationGroupType interface {
+	NotificationGroupTypeConstructor() string
+}
+
+// Describes result of chat report
+type ReportChatResult interface {
+	ReportChatResultConstructor() string
+}
+
+// Represents the categories of chats for which a list of frequently used chats can be retrieved
+type TopChatCategory interface {
+	TopChatCategoryConstructor() string
+}
+
+// Describes type of object, for which statistics are provided
+type ChatStatisticsObjectType interface {
+	ChatStatisticsObjectTypeConstructor() string
+}
+
+// Contains a detailed statistics about a chat
+type ChatStatistics interface {
+	ChatStatisticsConstructor() string
+}
+
+func (entity *UnknownType) InputChatPhotoConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatMemberStatusConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatMembersFilterConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SupergroupMembersFilterConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InviteLinkChatTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SecretChatStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatListConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatSourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PublicChatTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatActionBarConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatActionConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) TargetChatConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatEventActionConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CheckChatUsernameResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) NotificationGroupTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReportChatResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) TopChatCategoryConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatStatisticsObjectTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatStatisticsConstructor() string {
+	return entity.Constructor
+}
+
+// Describes a background set for a specific chat
+type ChatBackground struct {
+	meta
+	// The background
+	Background *Background `json:"background"`
+	// Dimming of the background in dark themes, as a percentage; 0-100. Applied only to Wallpaper and Fill types of background
+	DarkThemeDimming int32 `json:"dark_theme_dimming"`
+}
+
+func (*ChatBackground) GetType() string {
+	return TypeChatBackground
+}
+
+func (*ChatBackground) GetConstructor() string {
+	return ConstructorChatBackground
+}
+
+func (entity *ChatBackground) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBackground) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBackground) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBackground"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"background":`)
+	entity.Background.encodeJSON(encoder)
+	encoder.writeRaw(`,"dark_theme_dimming":`)
+	encoder.writeInt32(entity.DarkThemeDimming)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBackground) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "background":
+			entity.Background, err = decodeEntity[Background](decoder)
+
+		case "dark_theme_dimming":
+			entity.DarkThemeDimming, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains basic information about the photo of a chat
+type ChatPhotoInfo struct {
+	meta
+	// A small (160x160) chat photo variant in JPEG format. The file can be downloaded only before the photo is changed
+	Small *File `json:"small"`
+	// A big (640x640) chat photo variant in JPEG format. The file can be downloaded only before the photo is changed
+	Big *File `json:"big"`
+	// Chat photo minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// True, if the photo has animated variant
+	HasAnimation bool `json:"has_animation"`
+	// True, if the photo is visible only for the current user
+	IsPersonal bool `json:"is_personal"`
+}
+
+func (*ChatPhotoInfo) GetType() string {
+	return TypeChatPhotoInfo
+}
+
+func (*ChatPhotoInfo) GetConstructor() string {
+	return ConstructorChatPhotoInfo
+}
+
+func (entity *ChatPhotoInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPhotoInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPhotoInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPhotoInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"small":`)
+	entity.Small.encodeJSON(encoder)
+	encoder.writeRaw(`,"big":`)
+	entity.Big.encodeJSON(encoder)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"has_animation":`)
+	encoder.writeBool(entity.HasAnimation)
+	encoder.writeRaw(`,"is_personal":`)
+	encoder.writeBool(entity.IsPersonal)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPhotoInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "small":
+			entity.Small, err = decodeEntity[File](decoder)
+
+		case "big":
+			entity.Big, err = decodeEntity[File](decoder)
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "has_animation":
+			entity.HasAnimation, err = decoder.readBool()
+
+		case "is_personal":
+			entity.IsPersonal, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPhotoInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a location to which a chat is connected
+type ChatLocation struct {
+	meta
+	// The location
+	Location *Location `json:"location"`
+	// Location address; 1-64 characters, as defined by the chat owner
+	Address string `json:"address"`
+}
+
+func (*ChatLocation) GetType() string {
+	return TypeChatLocation
+}
+
+func (*ChatLocation) GetConstructor() string {
+	return ConstructorChatLocation
+}
+
+func (entity *ChatLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"address":`)
+	encoder.writeString(entity.Address)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "address":
+			entity.Address, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatLocation) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("address", entity.Address, 1, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Animated variant of a chat photo in MPEG4 format
+type AnimatedChatPhoto struct {
+	meta
+	// Animation width and height
+	Length int32 `json:"length"`
+	// Information about the animation file
+	File *File `json:"file"`
+	// Timestamp of the frame, used as a static chat photo
+	MainFrameTimestamp float64 `json:"main_frame_timestamp"`
+}
+
+func (*AnimatedChatPhoto) GetType() string {
+	return TypeAnimatedChatPhoto
+}
+
+func (*AnimatedChatPhoto) GetConstructor() string {
+	return ConstructorAnimatedChatPhoto
+}
+
+func (entity *AnimatedChatPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AnimatedChatPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AnimatedChatPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"animatedChatPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw(`,"file":`)
+	entity.File.encodeJSON(encoder)
+	encoder.writeRaw(`,"main_frame_timestamp":`)
+	encoder.writeFloat64(entity.MainFrameTimestamp)
+	encoder.writeRaw("}")
+}
+
+func (entity *AnimatedChatPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		case "file":
+			entity.File, err = decodeEntity[File](decoder)
+
+		case "main_frame_timestamp":
+			entity.MainFrameTimestamp, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAnimatedChatPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a chat or user profile photo
+type ChatPhoto struct {
+	meta
+	// Unique photo identifier
+	Id JsonInt64 `json:"id"`
+	// Point in time (Unix timestamp) when the photo has been added
+	AddedDate int32 `json:"added_date"`
+	// Photo minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// Available variants of the photo in JPEG format, in different size
+	Sizes []*PhotoSize `json:"sizes"`
+	// A big (up to 1280x1280) animated variant of the photo in MPEG4 format; may be null
+	Animation *AnimatedChatPhoto `json:"animation,omitempty"`
+	// A small (160x160) animated variant of the photo in MPEG4 format; may be null even the big animation is available
+	SmallAnimation *AnimatedChatPhoto `json:"small_animation,omitempty"`
+	// Sticker-based version of the chat photo; may be null
+	Sticker *ChatPhotoSticker `json:"sticker,omitempty"`
+}
+
+func (*ChatPhoto) GetType() string {
+	return TypeChatPhoto
+}
+
+func (*ChatPhoto) GetConstructor() string {
+	return ConstructorChatPhoto
+}
+
+func (entity *ChatPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"added_date":`)
+	encoder.writeInt32(entity.AddedDate)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"sizes":`)
+	writeList(encoder, entity.Sizes, writeTypeValue[*PhotoSize])
+	if entity.Animation != nil {
+		encoder.writeRaw(`,"animation":`)
+		entity.Animation.encodeJSON(encoder)
+	}
+	if entity.SmallAnimation != nil {
+		encoder.writeRaw(`,"small_animation":`)
+		entity.SmallAnimation.encodeJSON(encoder)
+	}
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "added_date":
+			entity.AddedDate, err = decoder.readInt32()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "sizes":
+			entity.Sizes, err = readList(decoder, decodeEntity[PhotoSize])
+
+		case "animation":
+			entity.Animation, err = decodeEntity[AnimatedChatPhoto](decoder)
+
+		case "small_animation":
+			entity.SmallAnimation, err = decodeEntity[AnimatedChatPhoto](decoder)
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[ChatPhotoSticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of chat or user profile photos
+type ChatPhotos struct {
+	meta
+	// Total number of photos
+	TotalCount int32 `json:"total_count"`
+	// List of photos
+	Photos []*ChatPhoto `json:"photos"`
+}
+
+func (*ChatPhotos) GetType() string {
+	return TypeChatPhotos
+}
+
+func (*ChatPhotos) GetConstructor() string {
+	return ConstructorChatPhotos
+}
+
+func (entity *ChatPhotos) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPhotos) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPhotos) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPhotos"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"photos":`)
+	writeList(encoder, entity.Photos, writeTypeValue[*ChatPhoto])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPhotos) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "photos":
+			entity.Photos, err = readList(decoder, decodeEntity[ChatPhoto])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPhotos)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A previously used profile photo of the current user
+type InputChatPhotoPrevious struct {
+	meta
+	// Identifier of the current user's profile photo to reuse
+	ChatPhotoId JsonInt64 `json:"chat_photo_id"`
+}
+
+func (*InputChatPhotoPrevious) GetType() string {
+	return TypeInputChatPhoto
+}
+
+func (*InputChatPhotoPrevious) GetConstructor() string {
+	return ConstructorInputChatPhotoPrevious
+}
+
+func (*InputChatPhotoPrevious) InputChatPhotoConstructor() string {
+	return ConstructorInputChatPhotoPrevious
+}
+
+func (entity *InputChatPhotoPrevious) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputChatPhotoPrevious) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputChatPhotoPrevious) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputChatPhotoPrevious"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_photo_id":`)
+	encoder.writeJsonInt64(entity.ChatPhotoId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputChatPhotoPrevious) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_photo_id":
+			entity.ChatPhotoId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputChatPhotoPrevious)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A static photo in JPEG format
+type InputChatPhotoStatic struct {
+	meta
+	// Photo to be set as profile photo. Only inputFileLocal and inputFileGenerated are allowed
+	Photo InputFile `json:"photo"`
+}
+
+func (*InputChatPhotoStatic) GetType() string {
+	return TypeInputChatPhoto
+}
+
+func (*InputChatPhotoStatic) GetConstructor() string {
+	return ConstructorInputChatPhotoStatic
+}
+
+func (*InputChatPhotoStatic) InputChatPhotoConstructor() string {
+	return ConstructorInputChatPhotoStatic
+}
+
+func (entity *InputChatPhotoStatic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputChatPhotoStatic) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputChatPhotoStatic) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputChatPhotoStatic"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	encoder.writeType(entity.Photo)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputChatPhotoStatic) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeInputFile(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputChatPhotoStatic)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An animation in MPEG4 format; must be square, at most 10 seconds long, have width between 160 and 1280 and be at most 2MB in size
+type InputChatPhotoAnimation struct {
+	meta
+	// Animation to be set as profile photo. Only inputFileLocal and inputFileGenerated are allowed
+	Animation InputFile `json:"animation"`
+	// Timestamp of the frame, which will be used as static chat photo
+	MainFrameTimestamp float64 `json:"main_frame_timestamp"`
+}
+
+func (*InputChatPhotoAnimation) GetType() string {
+	return TypeInputChatPhoto
+}
+
+func (*InputChatPhotoAnimation) GetConstructor() string {
+	return ConstructorInputChatPhotoAnimation
+}
+
+func (*InputChatPhotoAnimation) InputChatPhotoConstructor() string {
+	return ConstructorInputChatPhotoAnimation
+}
+
+func (entity *InputChatPhotoAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputChatPhotoAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputChatPhotoAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputChatPhotoAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"animation":`)
+	encoder.writeType(entity.Animation)
+	encoder.writeRaw(`,"main_frame_timestamp":`)
+	encoder.writeFloat64(entity.MainFrameTimestamp)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputChatPhotoAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animation":
+			entity.Animation, err = decodeInputFile(decoder)
+
+		case "main_frame_timestamp":
+			entity.MainFrameTimestamp, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputChatPhotoAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A sticker on a custom background
+type InputChatPhotoSticker struct {
+	meta
+	// Information about the sticker
+	Sticker *ChatPhotoSticker `json:"sticker"`
+}
+
+func (*InputChatPhotoSticker) GetType() string {
+	return TypeInputChatPhoto
+}
+
+func (*InputChatPhotoSticker) GetConstructor() string {
+	return ConstructorInputChatPhotoSticker
+}
+
+func (*InputChatPhotoSticker) InputChatPhotoConstructor() string {
+	return ConstructorInputChatPhotoSticker
+}
+
+func (entity *InputChatPhotoSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputChatPhotoSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputChatPhotoSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputChatPhotoSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputChatPhotoSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeEntity[ChatPhotoSticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputChatPhotoSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes actions that a user is allowed to take in a chat
+type ChatPermissions struct {
+	meta
+	// True, if the user can send text messages, contacts, giveaways, giveaway winners, invoices, locations, and venues
+	CanSendBasicMessages bool `json:"can_send_basic_messages"`
+	// True, if the user can send music files
+	CanSendAudios bool `json:"can_send_audios"`
+	// True, if the user can send documents
+	CanSendDocuments bool `json:"can_send_documents"`
+	// True, if the user can send photos
+	CanSendPhotos bool `json:"can_send_photos"`
+	// True, if the user can send videos
+	CanSendVideos bool `json:"can_send_videos"`
+	// True, if the user can send video notes
+	CanSendVideoNotes bool `json:"can_send_video_notes"`
+	// True, if the user can send voice notes
+	CanSendVoiceNotes bool `json:"can_send_voice_notes"`
+	// True, if the user can send polls
+	CanSendPolls bool `json:"can_send_polls"`
+	// True, if the user can send animations, games, stickers, and dice and use inline bots
+	CanSendOtherMessages bool `json:"can_send_other_messages"`
+	// True, if the user may add a link preview to their messages
+	CanAddLinkPreviews bool `json:"can_add_link_previews"`
+	// True, if the user can change the chat title, photo, and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+	// True, if the user can invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+	// True, if the user can pin messages
+	CanPinMessages bool `json:"can_pin_messages"`
+	// True, if the user can create topics
+	CanCreateTopics bool `json:"can_create_topics"`
+}
+
+func (*ChatPermissions) GetType() string {
+	return TypeChatPermissions
+}
+
+func (*ChatPermissions) GetConstructor() string {
+	return ConstructorChatPermissions
+}
+
+func (entity *ChatPermissions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPermissions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPermissions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPermissions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_send_basic_messages":`)
+	encoder.writeBool(entity.CanSendBasicMessages)
+	encoder.writeRaw(`,"can_send_audios":`)
+	encoder.writeBool(entity.CanSendAudios)
+	encoder.writeRaw(`,"can_send_documents":`)
+	encoder.writeBool(entity.CanSendDocuments)
+	encoder.writeRaw(`,"can_send_photos":`)
+	encoder.writeBool(entity.CanSendPhotos)
+	encoder.writeRaw(`,"can_send_videos":`)
+	encoder.writeBool(entity.CanSendVideos)
+	encoder.writeRaw(`,"can_send_video_notes":`)
+	encoder.writeBool(entity.CanSendVideoNotes)
+	encoder.writeRaw(`,"can_send_voice_notes":`)
+	encoder.writeBool(entity.CanSendVoiceNotes)
+	encoder.writeRaw(`,"can_send_polls":`)
+	encoder.writeBool(entity.CanSendPolls)
+	encoder.writeRaw(`,"can_send_other_messages":`)
+	encoder.writeBool(entity.CanSendOtherMessages)
+	encoder.writeRaw(`,"can_add_link_previews":`)
+	encoder.writeBool(entity.CanAddLinkPreviews)
+	encoder.writeRaw(`,"can_change_info":`)
+	encoder.writeBool(entity.CanChangeInfo)
+	encoder.writeRaw(`,"can_invite_users":`)
+	encoder.writeBool(entity.CanInviteUsers)
+	encoder.writeRaw(`,"can_pin_messages":`)
+	encoder.writeBool(entity.CanPinMessages)
+	encoder.writeRaw(`,"can_create_topics":`)
+	encoder.writeBool(entity.CanCreateTopics)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPermissions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_send_basic_messages":
+			entity.CanSendBasicMessages, err = decoder.readBool()
+
+		case "can_send_audios":
+			entity.CanSendAudios, err = decoder.readBool()
+
+		case "can_send_documents":
+			entity.CanSendDocuments, err = decoder.readBool()
+
+		case "can_send_photos":
+			entity.CanSendPhotos, err = decoder.readBool()
+
+		case "can_send_videos":
+			entity.CanSendVideos, err = decoder.readBool()
+
+		case "can_send_video_notes":
+			entity.CanSendVideoNotes, err = decoder.readBool()
+
+		case "can_send_voice_notes":
+			entity.CanSendVoiceNotes, err = decoder.readBool()
+
+		case "can_send_polls":
+			entity.CanSendPolls, err = decoder.readBool()
+
+		case "can_send_other_messages":
+			entity.CanSendOtherMessages, err = decoder.readBool()
+
+		case "can_add_link_previews":
+			entity.CanAddLinkPreviews, err = decoder.readBool()
+
+		case "can_change_info":
+			entity.CanChangeInfo, err = decoder.readBool()
+
+		case "can_invite_users":
+			entity.CanInviteUsers, err = decoder.readBool()
+
+		case "can_pin_messages":
+			entity.CanPinMessages, err = decoder.readBool()
+
+		case "can_create_topics":
+			entity.CanCreateTopics, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPermissions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes rights of the administrator
+type ChatAdministratorRights struct {
+	meta
+	// True, if the administrator can access the chat event log, get boost list, see hidden supergroup and channel members, report supergroup spam messages and ignore slow mode. Implied by any other privilege; applicable to supergroups and channels only
+	CanManageChat bool `json:"can_manage_chat"`
+	// True, if the administrator can change the chat title, photo, and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+	// True, if the administrator can create channel posts or view channel statistics; applicable to channels only
+	CanPostMessages bool `json:"can_post_messages"`
+	// True, if the administrator can edit messages of other users and pin messages; applicable to channels only
+	CanEditMessages bool `json:"can_edit_messages"`
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+	// True, if the administrator can invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+	// True, if the administrator can restrict, ban, or unban chat members or view supergroup statistics; always true for channels
+	CanRestrictMembers bool `json:"can_restrict_members"`
+	// True, if the administrator can pin messages; applicable to basic groups and supergroups only
+	CanPinMessages bool `json:"can_pin_messages"`
+	// True, if the administrator can create, rename, close, reopen, hide, and unhide forum topics; applicable to forum supergroups only
+	CanManageTopics bool `json:"can_manage_topics"`
+	// True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that were directly or indirectly promoted by them
+	CanPromoteMembers bool `json:"can_promote_members"`
+	// True, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	// True, if the administrator can create new chat stories, or edit and delete posted stories; applicable to supergroups and channels only
+	CanPostStories bool `json:"can_post_stories"`
+	// True, if the administrator can edit stories posted by other users, post stories to the chat page, pin chat stories, and access story archive; applicable to supergroups and channels only
+	CanEditStories bool `json:"can_edit_stories"`
+	// True, if the administrator can delete stories posted by other users; applicable to supergroups and channels only
+	CanDeleteStories bool `json:"can_delete_stories"`
+	// True, if the administrator isn't shown in the chat member list and sends messages anonymously; applicable to supergroups only
+	IsAnonymous bool `json:"is_anonymous"`
+}
+
+func (*ChatAdministratorRights) GetType() string {
+	return TypeChatAdministratorRights
+}
+
+func (*ChatAdministratorRights) GetConstructor() string {
+	return ConstructorChatAdministratorRights
+}
+
+func (entity *ChatAdministratorRights) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatAdministratorRights) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatAdministratorRights) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatAdministratorRights"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_manage_chat":`)
+	encoder.writeBool(entity.CanManageChat)
+	encoder.writeRaw(`,"can_change_info":`)
+	encoder.writeBool(entity.CanChangeInfo)
+	encoder.writeRaw(`,"can_post_messages":`)
+	encoder.writeBool(entity.CanPostMessages)
+	encoder.writeRaw(`,"can_edit_messages":`)
+	encoder.writeBool(entity.CanEditMessages)
+	encoder.writeRaw(`,"can_delete_messages":`)
+	encoder.writeBool(entity.CanDeleteMessages)
+	encoder.writeRaw(`,"can_invite_users":`)
+	encoder.writeBool(entity.CanInviteUsers)
+	encoder.writeRaw(`,"can_restrict_members":`)
+	encoder.writeBool(entity.CanRestrictMembers)
+	encoder.writeRaw(`,"can_pin_messages":`)
+	encoder.writeBool(entity.CanPinMessages)
+	encoder.writeRaw(`,"can_manage_topics":`)
+	encoder.writeBool(entity.CanManageTopics)
+	encoder.writeRaw(`,"can_promote_members":`)
+	encoder.writeBool(entity.CanPromoteMembers)
+	encoder.writeRaw(`,"can_manage_video_chats":`)
+	encoder.writeBool(entity.CanManageVideoChats)
+	encoder.writeRaw(`,"can_post_stories":`)
+	encoder.writeBool(entity.CanPostStories)
+	encoder.writeRaw(`,"can_edit_stories":`)
+	encoder.writeBool(entity.CanEditStories)
+	encoder.writeRaw(`,"can_delete_stories":`)
+	encoder.writeBool(entity.CanDeleteStories)
+	encoder.writeRaw(`,"is_anonymous":`)
+	encoder.writeBool(entity.IsAnonymous)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatAdministratorRights) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_manage_chat":
+			entity.CanManageChat, err = decoder.readBool()
+
+		case "can_change_info":
+			entity.CanChangeInfo, err = decoder.readBool()
+
+		case "can_post_messages":
+			entity.CanPostMessages, err = decoder.readBool()
+
+		case "can_edit_messages":
+			entity.CanEditMessages, err = decoder.readBool()
+
+		case "can_delete_messages":
+			entity.CanDeleteMessages, err = decoder.readBool()
+
+		case "can_invite_users":
+			entity.CanInviteUsers, err = decoder.readBool()
+
+		case "can_restrict_members":
+			entity.CanRestrictMembers, err = decoder.readBool()
+
+		case "can_pin_messages":
+			entity.CanPinMessages, err = decoder.readBool()
+
+		case "can_manage_topics":
+			entity.CanManageTopics, err = decoder.readBool()
+
+		case "can_promote_members":
+			entity.CanPromoteMembers, err = decoder.readBool()
+
+		case "can_manage_video_chats":
+			entity.CanManageVideoChats, err = decoder.readBool()
+
+		case "can_post_stories":
+			entity.CanPostStories, err = decoder.readBool()
+
+		case "can_edit_stories":
+			entity.CanEditStories, err = decoder.readBool()
+
+		case "can_delete_stories":
+			entity.CanDeleteStories, err = decoder.readBool()
+
+		case "is_anonymous":
+			entity.IsAnonymous, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatAdministratorRights)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a chat administrator
+type ChatAdministrator struct {
+	meta
+	// User identifier of the administrator
+	UserId int64 `json:"user_id"`
+	// Custom title of the administrator
+	CustomTitle string `json:"custom_title"`
+	// True, if the user is the owner of the chat
+	IsOwner bool `json:"is_owner"`
+}
+
+func (*ChatAdministrator) GetType() string {
+	return TypeChatAdministrator
+}
+
+func (*ChatAdministrator) GetConstructor() string {
+	return ConstructorChatAdministrator
+}
+
+func (entity *ChatAdministrator) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatAdministrator) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatAdministrator) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatAdministrator"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"custom_title":`)
+	encoder.writeString(entity.CustomTitle)
+	encoder.writeRaw(`,"is_owner":`)
+	encoder.writeBool(entity.IsOwner)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatAdministrator) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "custom_title":
+			entity.CustomTitle, err = decoder.readString()
+
+		case "is_owner":
+			entity.IsOwner, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatAdministrator)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of chat administrators
+type ChatAdministrators struct {
+	meta
+	// A list of chat administrators
+	Administrators []*ChatAdministrator `json:"administrators"`
+}
+
+func (*ChatAdministrators) GetType() string {
+	return TypeChatAdministrators
+}
+
+func (*ChatAdministrators) GetConstructor() string {
+	return ConstructorChatAdministrators
+}
+
+func (entity *ChatAdministrators) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatAdministrators) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatAdministrators) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatAdministrators"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"administrators":`)
+	writeList(encoder, entity.Administrators, writeTypeValue[*ChatAdministrator])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatAdministrators) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "administrators":
+			entity.Administrators, err = readList(decoder, decodeEntity[ChatAdministrator])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatAdministrators)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is the owner of the chat and has all the administrator privileges
+type ChatMemberStatusCreator struct {
+	meta
+	// A custom title of the owner; 0-16 characters without emoji; applicable to supergroups only
+	CustomTitle string `json:"custom_title"`
+	// True, if the creator isn't shown in the chat member list and sends messages anonymously; applicable to supergroups only
+	IsAnonymous bool `json:"is_anonymous"`
+	// True, if the user is a member of the chat
+	IsMember bool `json:"is_member"`
+}
+
+func (*ChatMemberStatusCreator) GetType() string {
+	return TypeChatMemberStatus
+}
+
+func (*ChatMemberStatusCreator) GetConstructor() string {
+	return ConstructorChatMemberStatusCreator
+}
+
+func (*ChatMemberStatusCreator) ChatMemberStatusConstructor() string {
+	return ConstructorChatMemberStatusCreator
+}
+
+func (entity *ChatMemberStatusCreator) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMemberStatusCreator) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMemberStatusCreator) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMemberStatusCreator"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_title":`)
+	encoder.writeString(entity.CustomTitle)
+	encoder.writeRaw(`,"is_anonymous":`)
+	encoder.writeBool(entity.IsAnonymous)
+	encoder.writeRaw(`,"is_member":`)
+	encoder.writeBool(entity.IsMember)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMemberStatusCreator) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_title":
+			entity.CustomTitle, err = decoder.readString()
+
+		case "is_anonymous":
+			entity.IsAnonymous, err = decoder.readBool()
+
+		case "is_member":
+			entity.IsMember, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMemberStatusCreator)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatMemberStatusCreator) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("custom_title", entity.CustomTitle, 0, 16); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The user is a member of the chat and has some additional privileges. In basic groups, administrators can edit and delete messages sent by others, add new members, ban unprivileged members, and manage video chats. In supergroups and channels, there are more detailed options for administrator privileges
+type ChatMemberStatusAdministrator struct {
+	meta
+	// A custom title of the administrator; 0-16 characters without emoji; applicable to supergroups only
+	CustomTitle string `json:"custom_title"`
+	// True, if the current user can edit the administrator privileges for the called user
+	CanBeEdited bool `json:"can_be_edited"`
+	// Rights of the administrator
+	Rights *ChatAdministratorRights `json:"rights"`
+}
+
+func (*ChatMemberStatusAdministrator) GetType() string {
+	return TypeChatMemberStatus
+}
+
+func (*ChatMemberStatusAdministrator) GetConstructor() string {
+	return ConstructorChatMemberStatusAdministrator
+}
+
+func (*ChatMemberStatusAdministrator) ChatMemberStatusConstructor() string {
+	return ConstructorChatMemberStatusAdministrator
+}
+
+func (entity *ChatMemberStatusAdministrator) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMemberStatusAdministrator) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMemberStatusAdministrator) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMemberStatusAdministrator"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_title":`)
+	encoder.writeString(entity.CustomTitle)
+	encoder.writeRaw(`,"can_be_edited":`)
+	encoder.writeBool(entity.CanBeEdited)
+	encoder.writeRaw(`,"rights":`)
+	entity.Rights.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMemberStatusAdministrator) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_title":
+			entity.CustomTitle, err = decoder.readString()
+
+		case "can_be_edited":
+			entity.CanBeEdited, err = decoder.readBool()
+
+		case "rights":
+			entity.Rights, err = decodeEntity[ChatAdministratorRights](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMemberStatusAdministrator)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatMemberStatusAdministrator) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("custom_title", entity.CustomTitle, 0, 16); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The user is a member of the chat, without any additional privileges or restrictions
+type ChatMemberStatusMember struct {
+	meta
+	// Point in time (Unix timestamp) when the user will be removed from the chat because of the expired subscription; 0 if never. Ignored in setChatMemberStatus
+	MemberUntilDate int32 `json:"member_until_date"`
+}
+
+func (*ChatMemberStatusMember) GetType() string {
+	return TypeChatMemberStatus
+}
+
+func (*ChatMemberStatusMember) GetConstructor() string {
+	return ConstructorChatMemberStatusMember
+}
+
+func (*ChatMemberStatusMember) ChatMemberStatusConstructor() string {
+	return ConstructorChatMemberStatusMember
+}
+
+func (entity *ChatMemberStatusMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMemberStatusMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMemberStatusMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMemberStatusMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"member_until_date":`)
+	encoder.writeInt32(entity.MemberUntilDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMemberStatusMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "member_until_date":
+			entity.MemberUntilDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMemberStatusMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is under certain restrictions in the chat. Not supported in basic groups and channels
+type ChatMemberStatusRestricted struct {
+	meta
+	// True, if the user is a member of the chat
+	IsMember bool `json:"is_member"`
+	// Point in time (Unix timestamp) when restrictions will be lifted from the user; 0 if never. If the user is restricted for more than 366 days or for less than 30 seconds from the current time, the user is considered to be restricted forever
+	RestrictedUntilDate int32 `json:"restricted_until_date"`
+	// User permissions in the chat
+	Permissions *ChatPermissions `json:"permissions"`
+}
+
+func (*ChatMemberStatusRestricted) GetType() string {
+	return TypeChatMemberStatus
+}
+
+func (*ChatMemberStatusRestricted) GetConstructor() string {
+	return ConstructorChatMemberStatusRestricted
+}
+
+func (*ChatMemberStatusRestricted) ChatMemberStatusConstructor() string {
+	return ConstructorChatMemberStatusRestricted
+}
+
+func (entity *ChatMemberStatusRestricted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMemberStatusRestricted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMemberStatusRestricted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMemberStatusRestricted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_member":`)
+	encoder.writeBool(entity.IsMember)
+	encoder.writeRaw(`,"restricted_until_date":`)
+	encoder.writeInt32(entity.RestrictedUntilDate)
+	encoder.writeRaw(`,"permissions":`)
+	entity.Permissions.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMemberStatusRestricted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_member":
+			entity.IsMember, err = decoder.readBool()
+
+		case "restricted_until_date":
+			entity.RestrictedUntilDate, err = decoder.readInt32()
+
+		case "permissions":
+			entity.Permissions, err = decodeEntity[ChatPermissions](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMemberStatusRestricted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user or the chat is not a chat member
+type ChatMemberStatusLeft struct {
+	meta
+}
+
+func (*ChatMemberStatusLeft) GetType() string {
+	return TypeChatMemberStatus
+}
+
+func (*ChatMemberStatusLeft) GetConstructor() string {
+	return ConstructorChatMemberStatusLeft
+}
+
+func (*ChatMemberStatusLeft) ChatMemberStatusConstructor() string {
+	return ConstructorChatMemberStatusLeft
+}
+
+func (entity *ChatMemberStatusLeft) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMemberStatusLeft) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMemberStatusLeft) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMemberStatusLeft"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMemberStatusLeft) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatMemberStatusLeft)
+	})
+}
+
+// The user or the chat was banned (and hence is not a member of the chat). Implies the user can't return to the chat, view messages, or be used as a participant identifier to join a video chat of the chat
+type ChatMemberStatusBanned struct {
+	meta
+	// Point in time (Unix timestamp) when the user will be unbanned; 0 if never. If the user is banned for more than 366 days or for less than 30 seconds from the current time, the user is considered to be banned forever. Always 0 in basic groups
+	BannedUntilDate int32 `json:"banned_until_date"`
+}
+
+func (*ChatMemberStatusBanned) GetType() string {
+	return TypeChatMemberStatus
+}
+
+func (*ChatMemberStatusBanned) GetConstructor() string {
+	return ConstructorChatMemberStatusBanned
+}
+
+func (*ChatMemberStatusBanned) ChatMemberStatusConstructor() string {
+	return ConstructorChatMemberStatusBanned
+}
+
+func (entity *ChatMemberStatusBanned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMemberStatusBanned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMemberStatusBanned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMemberStatusBanned"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"banned_until_date":`)
+	encoder.writeInt32(entity.BannedUntilDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMemberStatusBanned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "banned_until_date":
+			entity.BannedUntilDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMemberStatusBanned)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a user or a chat as a member of another chat
+type ChatMember struct {
+	meta
+	// Identifier of the chat member. Currently, other chats can be only Left or Banned. Only supergroups and channels can have other chats as Left or Banned members and these chats must be supergroups or channels
+	MemberId MessageSender `json:"member_id"`
+	// Identifier of a user that invited/promoted/banned this member in the chat; 0 if unknown
+	InviterUserId int64 `json:"inviter_user_id"`
+	// Point in time (Unix timestamp) when the user joined/was promoted/was banned in the chat
+	JoinedChatDate int32 `json:"joined_chat_date"`
+	// Status of the member in the chat
+	Status ChatMemberStatus `json:"status"`
+}
+
+func (*ChatMember) GetType() string {
+	return TypeChatMember
+}
+
+func (*ChatMember) GetConstructor() string {
+	return ConstructorChatMember
+}
+
+func (entity *ChatMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"member_id":`)
+	encoder.writeType(entity.MemberId)
+	encoder.writeRaw(`,"inviter_user_id":`)
+	encoder.writeInt64(entity.InviterUserId)
+	encoder.writeRaw(`,"joined_chat_date":`)
+	encoder.writeInt32(entity.JoinedChatDate)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(entity.Status)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "member_id":
+			entity.MemberId, err = decodeMessageSender(decoder)
+
+		case "inviter_user_id":
+			entity.InviterUserId, err = decoder.readInt64()
+
+		case "joined_chat_date":
+			entity.JoinedChatDate, err = decoder.readInt32()
+
+		case "status":
+			entity.Status, err = decodeChatMemberStatus(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatMember) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("status", entity.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of chat members
+type ChatMembers struct {
+	meta
+	// Approximate total number of chat members found
+	TotalCount int32 `json:"total_count"`
+	// A list of chat members
+	Members []*ChatMember `json:"members"`
+}
+
+func (*ChatMembers) GetType() string {
+	return TypeChatMembers
+}
+
+func (*ChatMembers) GetConstructor() string {
+	return ConstructorChatMembers
+}
+
+func (entity *ChatMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"members":`)
+	writeList(encoder, entity.Members, writeTypeValue[*ChatMember])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "members":
+			entity.Members, err = readList(decoder, decodeEntity[ChatMember])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMembers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatMembers) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Members {
+		if err := validateNested(indexField("members", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns contacts of the user
+type ChatMembersFilterContacts struct {
+	meta
+}
+
+func (*ChatMembersFilterContacts) GetType() string {
+	return TypeChatMembersFilter
+}
+
+func (*ChatMembersFilterContacts) GetConstructor() string {
+	return ConstructorChatMembersFilterContacts
+}
+
+func (*ChatMembersFilterContacts) ChatMembersFilterConstructor() string {
+	return ConstructorChatMembersFilterContacts
+}
+
+func (entity *ChatMembersFilterContacts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembersFilterContacts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembersFilterContacts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembersFilterContacts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembersFilterContacts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatMembersFilterContacts)
+	})
+}
+
+// Returns the owner and administrators
+type ChatMembersFilterAdministrators struct {
+	meta
+}
+
+func (*ChatMembersFilterAdministrators) GetType() string {
+	return TypeChatMembersFilter
+}
+
+func (*ChatMembersFilterAdministrators) GetConstructor() string {
+	return ConstructorChatMembersFilterAdministrators
+}
+
+func (*ChatMembersFilterAdministrators) ChatMembersFilterConstructor() string {
+	return ConstructorChatMembersFilterAdministrators
+}
+
+func (entity *ChatMembersFilterAdministrators) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembersFilterAdministrators) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembersFilterAdministrators) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembersFilterAdministrators"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembersFilterAdministrators) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatMembersFilterAdministrators)
+	})
+}
+
+// Returns all chat members, including restricted chat members
+type ChatMembersFilterMembers struct {
+	meta
+}
+
+func (*ChatMembersFilterMembers) GetType() string {
+	return TypeChatMembersFilter
+}
+
+func (*ChatMembersFilterMembers) GetConstructor() string {
+	return ConstructorChatMembersFilterMembers
+}
+
+func (*ChatMembersFilterMembers) ChatMembersFilterConstructor() string {
+	return ConstructorChatMembersFilterMembers
+}
+
+func (entity *ChatMembersFilterMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembersFilterMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembersFilterMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembersFilterMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembersFilterMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatMembersFilterMembers)
+	})
+}
+
+// Returns users which can be mentioned in the chat
+type ChatMembersFilterMention struct {
+	meta
+	// If non-zero, the identifier of the current message thread
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (*ChatMembersFilterMention) GetType() string {
+	return TypeChatMembersFilter
+}
+
+func (*ChatMembersFilterMention) GetConstructor() string {
+	return ConstructorChatMembersFilterMention
+}
+
+func (*ChatMembersFilterMention) ChatMembersFilterConstructor() string {
+	return ConstructorChatMembersFilterMention
+}
+
+func (entity *ChatMembersFilterMention) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembersFilterMention) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembersFilterMention) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembersFilterMention"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembersFilterMention) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMembersFilterMention)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns users under certain restrictions in the chat; can be used only by administrators in a supergroup
+type ChatMembersFilterRestricted struct {
+	meta
+}
+
+func (*ChatMembersFilterRestricted) GetType() string {
+	return TypeChatMembersFilter
+}
+
+func (*ChatMembersFilterRestricted) GetConstructor() string {
+	return ConstructorChatMembersFilterRestricted
+}
+
+func (*ChatMembersFilterRestricted) ChatMembersFilterConstructor() string {
+	return ConstructorChatMembersFilterRestricted
+}
+
+func (entity *ChatMembersFilterRestricted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembersFilterRestricted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembersFilterRestricted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembersFilterRestricted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembersFilterRestricted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatMembersFilterRestricted)
+	})
+}
+
+// Returns users banned from the chat; can be used only by administrators in a supergroup or in a channel
+type ChatMembersFilterBanned struct {
+	meta
+}
+
+func (*ChatMembersFilterBanned) GetType() string {
+	return TypeChatMembersFilter
+}
+
+func (*ChatMembersFilterBanned) GetConstructor() string {
+	return ConstructorChatMembersFilterBanned
+}
+
+func (*ChatMembersFilterBanned) ChatMembersFilterConstructor() string {
+	return ConstructorChatMembersFilterBanned
+}
+
+func (entity *ChatMembersFilterBanned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembersFilterBanned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembersFilterBanned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembersFilterBanned"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembersFilterBanned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatMembersFilterBanned)
+	})
+}
+
+// Returns bot members of the chat
+type ChatMembersFilterBots struct {
+	meta
+}
+
+func (*ChatMembersFilterBots) GetType() string {
+	return TypeChatMembersFilter
+}
+
+func (*ChatMembersFilterBots) GetConstructor() string {
+	return ConstructorChatMembersFilterBots
+}
+
+func (*ChatMembersFilterBots) ChatMembersFilterConstructor() string {
+	return ConstructorChatMembersFilterBots
+}
+
+func (entity *ChatMembersFilterBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMembersFilterBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMembersFilterBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMembersFilterBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMembersFilterBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatMembersFilterBots)
+	})
+}
+
+// Returns recently active users in reverse chronological order
+type SupergroupMembersFilterRecent struct {
+	meta
+}
+
+func (*SupergroupMembersFilterRecent) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterRecent) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterRecent
+}
+
+func (*SupergroupMembersFilterRecent) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterRecent
+}
+
+func (entity *SupergroupMembersFilterRecent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterRecent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterRecent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterRecent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterRecent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterRecent)
+	})
+}
+
+// Returns contacts of the user, which are members of the supergroup or channel
+type SupergroupMembersFilterContacts struct {
+	meta
+	// Query to search for
+	Query string `json:"query"`
+}
+
+func (*SupergroupMembersFilterContacts) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterContacts) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterContacts
+}
+
+func (*SupergroupMembersFilterContacts) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterContacts
+}
+
+func (entity *SupergroupMembersFilterContacts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterContacts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterContacts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterContacts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterContacts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterContacts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns the owner and administrators
+type SupergroupMembersFilterAdministrators struct {
+	meta
+}
+
+func (*SupergroupMembersFilterAdministrators) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterAdministrators) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterAdministrators
+}
+
+func (*SupergroupMembersFilterAdministrators) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterAdministrators
+}
+
+func (entity *SupergroupMembersFilterAdministrators) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterAdministrators) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterAdministrators) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterAdministrators"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterAdministrators) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterAdministrators)
+	})
+}
+
+// Used to search for supergroup or channel members via a (string) query
+type SupergroupMembersFilterSearch struct {
+	meta
+	// Query to search for
+	Query string `json:"query"`
+}
+
+func (*SupergroupMembersFilterSearch) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterSearch) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterSearch
+}
+
+func (*SupergroupMembersFilterSearch) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterSearch
+}
+
+func (entity *SupergroupMembersFilterSearch) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterSearch) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterSearch) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterSearch"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterSearch) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterSearch)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns restricted supergroup members; can be used only by administrators
+type SupergroupMembersFilterRestricted struct {
+	meta
+	// Query to search for
+	Query string `json:"query"`
+}
+
+func (*SupergroupMembersFilterRestricted) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterRestricted) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterRestricted
+}
+
+func (*SupergroupMembersFilterRestricted) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterRestricted
+}
+
+func (entity *SupergroupMembersFilterRestricted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterRestricted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterRestricted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterRestricted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterRestricted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterRestricted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns users banned from the supergroup or channel; can be used only by administrators
+type SupergroupMembersFilterBanned struct {
+	meta
+	// Query to search for
+	Query string `json:"query"`
+}
+
+func (*SupergroupMembersFilterBanned) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterBanned) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterBanned
+}
+
+func (*SupergroupMembersFilterBanned) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterBanned
+}
+
+func (entity *SupergroupMembersFilterBanned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterBanned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterBanned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterBanned"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterBanned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterBanned)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns users which can be mentioned in the supergroup
+type SupergroupMembersFilterMention struct {
+	meta
+	// Query to search for
+	Query string `json:"query"`
+	// If non-zero, the identifier of the current message thread
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+func (*SupergroupMembersFilterMention) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterMention) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterMention
+}
+
+func (*SupergroupMembersFilterMention) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterMention
+}
+
+func (entity *SupergroupMembersFilterMention) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterMention) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterMention) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterMention"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterMention) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterMention)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns bot members of the supergroup or channel
+type SupergroupMembersFilterBots struct {
+	meta
+}
+
+func (*SupergroupMembersFilterBots) GetType() string {
+	return TypeSupergroupMembersFilter
+}
+
+func (*SupergroupMembersFilterBots) GetConstructor() string {
+	return ConstructorSupergroupMembersFilterBots
+}
+
+func (*SupergroupMembersFilterBots) SupergroupMembersFilterConstructor() string {
+	return ConstructorSupergroupMembersFilterBots
+}
+
+func (entity *SupergroupMembersFilterBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupMembersFilterBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupMembersFilterBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupMembersFilterBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupMembersFilterBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSupergroupMembersFilterBots)
+	})
+}
+
+// Contains a chat invite link
+type ChatInviteLink struct {
+	meta
+	// Chat invite link
+	InviteLink string `json:"invite_link"`
+	// Name of the link
+	Name string `json:"name"`
+	// User identifier of an administrator created the link
+	CreatorUserId int64 `json:"creator_user_id"`
+	// Point in time (Unix timestamp) when the link was created
+	Date int32 `json:"date"`
+	// Point in time (Unix timestamp) when the link was last edited; 0 if never or unknown
+	EditDate int32 `json:"edit_date"`
+	// Point in time (Unix timestamp) when the link will expire; 0 if never
+	ExpirationDate int32 `json:"expiration_date"`
+	// Information about subscription plan that is applied to the users joining the chat by the link; may be null if the link doesn't require subscription
+	SubscriptionPricing *StarSubscriptionPricing `json:"subscription_pricing,omitempty"`
+	// The maximum number of members, which can join the chat using the link simultaneously; 0 if not limited. Always 0 if the link requires approval
+	MemberLimit int32 `json:"member_limit"`
+	// Number of chat members, which joined the chat using the link
+	MemberCount int32 `json:"member_count"`
+	// Number of chat members, which joined the chat using the link, but have already left because of expired subscription; for subscription links only
+	ExpiredMemberCount int32 `json:"expired_member_count"`
+	// Number of pending join requests created using this link
+	PendingJoinRequestCount int32 `json:"pending_join_request_count"`
+	// True, if the link only creates join request. If true, total number of joining members will be unlimited
+	CreatesJoinRequest bool `json:"creates_join_request"`
+	// True, if the link is primary. Primary invite link can't have name, expiration date, or usage limit. There is exactly one primary invite link for each administrator with can_invite_users right at a given time
+	IsPrimary bool `json:"is_primary"`
+	// True, if the link was revoked
+	IsRevoked bool `json:"is_revoked"`
+}
+
+func (*ChatInviteLink) GetType() string {
+	return TypeChatInviteLink
+}
+
+func (*ChatInviteLink) GetConstructor() string {
+	return ConstructorChatInviteLink
+}
+
+func (entity *ChatInviteLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(entity.InviteLink)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"creator_user_id":`)
+	encoder.writeInt64(entity.CreatorUserId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"edit_date":`)
+	encoder.writeInt32(entity.EditDate)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(entity.ExpirationDate)
+	if entity.SubscriptionPricing != nil {
+		encoder.writeRaw(`,"subscription_pricing":`)
+		entity.SubscriptionPricing.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"member_limit":`)
+	encoder.writeInt32(entity.MemberLimit)
+	encoder.writeRaw(`,"member_count":`)
+	encoder.writeInt32(entity.MemberCount)
+	encoder.writeRaw(`,"expired_member_count":`)
+	encoder.writeInt32(entity.ExpiredMemberCount)
+	encoder.writeRaw(`,"pending_join_request_count":`)
+	encoder.writeInt32(entity.PendingJoinRequestCount)
+	encoder.writeRaw(`,"creates_join_request":`)
+	encoder.writeBool(entity.CreatesJoinRequest)
+	encoder.writeRaw(`,"is_primary":`)
+	encoder.writeBool(entity.IsPrimary)
+	encoder.writeRaw(`,"is_revoked":`)
+	encoder.writeBool(entity.IsRevoked)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "creator_user_id":
+			entity.CreatorUserId, err = decoder.readInt64()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "edit_date":
+			entity.EditDate, err = decoder.readInt32()
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decoder.readInt32()
+
+		case "subscription_pricing":
+			entity.SubscriptionPricing, err = decodeEntity[StarSubscriptionPricing](decoder)
+
+		case "member_limit":
+			entity.MemberLimit, err = decoder.readInt32()
+
+		case "member_count":
+			entity.MemberCount, err = decoder.readInt32()
+
+		case "expired_member_count":
+			entity.ExpiredMemberCount, err = decoder.readInt32()
+
+		case "pending_join_request_count":
+			entity.PendingJoinRequestCount, err = decoder.readInt32()
+
+		case "creates_join_request":
+			entity.CreatesJoinRequest, err = decoder.readBool()
+
+		case "is_primary":
+			entity.IsPrimary, err = decoder.readBool()
+
+		case "is_revoked":
+			entity.IsRevoked, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of chat invite links
+type ChatInviteLinks struct {
+	meta
+	// Approximate total number of chat invite links found
+	TotalCount int32 `json:"total_count"`
+	// List of invite links
+	InviteLinks []*ChatInviteLink `json:"invite_links"`
+}
+
+func (*ChatInviteLinks) GetType() string {
+	return TypeChatInviteLinks
+}
+
+func (*ChatInviteLinks) GetConstructor() string {
+	return ConstructorChatInviteLinks
+}
+
+func (entity *ChatInviteLinks) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLinks) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLinks) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLinks"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"invite_links":`)
+	writeList(encoder, entity.InviteLinks, writeTypeValue[*ChatInviteLink])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLinks) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "invite_links":
+			entity.InviteLinks, err = readList(decoder, decodeEntity[ChatInviteLink])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLinks)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a chat administrator with a number of active and revoked chat invite links
+type ChatInviteLinkCount struct {
+	meta
+	// Administrator's user identifier
+	UserId int64 `json:"user_id"`
+	// Number of active invite links
+	InviteLinkCount int32 `json:"invite_link_count"`
+	// Number of revoked invite links
+	RevokedInviteLinkCount int32 `json:"revoked_invite_link_count"`
+}
+
+func (*ChatInviteLinkCount) GetType() string {
+	return TypeChatInviteLinkCount
+}
+
+func (*ChatInviteLinkCount) GetConstructor() string {
+	return ConstructorChatInviteLinkCount
+}
+
+func (entity *ChatInviteLinkCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLinkCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLinkCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLinkCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"invite_link_count":`)
+	encoder.writeInt32(entity.InviteLinkCount)
+	encoder.writeRaw(`,"revoked_invite_link_count":`)
+	encoder.writeInt32(entity.RevokedInviteLinkCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLinkCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "invite_link_count":
+			entity.InviteLinkCount, err = decoder.readInt32()
+
+		case "revoked_invite_link_count":
+			entity.RevokedInviteLinkCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLinkCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of chat invite link counts
+type ChatInviteLinkCounts struct {
+	meta
+	// List of invite link counts
+	InviteLinkCounts []*ChatInviteLinkCount `json:"invite_link_counts"`
+}
+
+func (*ChatInviteLinkCounts) GetType() string {
+	return TypeChatInviteLinkCounts
+}
+
+func (*ChatInviteLinkCounts) GetConstructor() string {
+	return ConstructorChatInviteLinkCounts
+}
+
+func (entity *ChatInviteLinkCounts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLinkCounts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLinkCounts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLinkCounts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link_counts":`)
+	writeList(encoder, entity.InviteLinkCounts, writeTypeValue[*ChatInviteLinkCount])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLinkCounts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link_counts":
+			entity.InviteLinkCounts, err = readList(decoder, decodeEntity[ChatInviteLinkCount])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLinkCounts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a chat member joined a chat via an invite link
+type ChatInviteLinkMember struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Point in time (Unix timestamp) when the user joined the chat
+	JoinedChatDate int32 `json:"joined_chat_date"`
+	// True, if the user has joined the chat using an invite link for a chat folder
+	ViaChatFolderInviteLink bool `json:"via_chat_folder_invite_link"`
+	// User identifier of the chat administrator, approved user join request
+	ApproverUserId int64 `json:"approver_user_id"`
+}
+
+func (*ChatInviteLinkMember) GetType() string {
+	return TypeChatInviteLinkMember
+}
+
+func (*ChatInviteLinkMember) GetConstructor() string {
+	return ConstructorChatInviteLinkMember
+}
+
+func (entity *ChatInviteLinkMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLinkMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLinkMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLinkMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"joined_chat_date":`)
+	encoder.writeInt32(entity.JoinedChatDate)
+	encoder.writeRaw(`,"via_chat_folder_invite_link":`)
+	encoder.writeBool(entity.ViaChatFolderInviteLink)
+	encoder.writeRaw(`,"approver_user_id":`)
+	encoder.writeInt64(entity.ApproverUserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLinkMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "joined_chat_date":
+			entity.JoinedChatDate, err = decoder.readInt32()
+
+		case "via_chat_folder_invite_link":
+			entity.ViaChatFolderInviteLink, err = decoder.readBool()
+
+		case "approver_user_id":
+			entity.ApproverUserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLinkMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of chat members joined a chat via an invite link
+type ChatInviteLinkMembers struct {
+	meta
+	// Approximate total number of chat members found
+	TotalCount int32 `json:"total_count"`
+	// List of chat members, joined a chat via an invite link
+	Members []*ChatInviteLinkMember `json:"members"`
+}
+
+func (*ChatInviteLinkMembers) GetType() string {
+	return TypeChatInviteLinkMembers
+}
+
+func (*ChatInviteLinkMembers) GetConstructor() string {
+	return ConstructorChatInviteLinkMembers
+}
+
+func (entity *ChatInviteLinkMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLinkMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLinkMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLinkMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"members":`)
+	writeList(encoder, entity.Members, writeTypeValue[*ChatInviteLinkMember])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLinkMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "members":
+			entity.Members, err = readList(decoder, decodeEntity[ChatInviteLinkMember])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLinkMembers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is an invite link for a basic group
+type InviteLinkChatTypeBasicGroup struct {
+	meta
+}
+
+func (*InviteLinkChatTypeBasicGroup) GetType() string {
+	return TypeInviteLinkChatType
+}
+
+func (*InviteLinkChatTypeBasicGroup) GetConstructor() string {
+	return ConstructorInviteLinkChatTypeBasicGroup
+}
+
+func (*InviteLinkChatTypeBasicGroup) InviteLinkChatTypeConstructor() string {
+	return ConstructorInviteLinkChatTypeBasicGroup
+}
+
+func (entity *InviteLinkChatTypeBasicGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InviteLinkChatTypeBasicGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InviteLinkChatTypeBasicGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inviteLinkChatTypeBasicGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InviteLinkChatTypeBasicGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInviteLinkChatTypeBasicGroup)
+	})
+}
+
+// The link is an invite link for a supergroup
+type InviteLinkChatTypeSupergroup struct {
+	meta
+}
+
+func (*InviteLinkChatTypeSupergroup) GetType() string {
+	return TypeInviteLinkChatType
+}
+
+func (*InviteLinkChatTypeSupergroup) GetConstructor() string {
+	return ConstructorInviteLinkChatTypeSupergroup
+}
+
+func (*InviteLinkChatTypeSupergroup) InviteLinkChatTypeConstructor() string {
+	return ConstructorInviteLinkChatTypeSupergroup
+}
+
+func (entity *InviteLinkChatTypeSupergroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InviteLinkChatTypeSupergroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InviteLinkChatTypeSupergroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inviteLinkChatTypeSupergroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InviteLinkChatTypeSupergroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInviteLinkChatTypeSupergroup)
+	})
+}
+
+// The link is an invite link for a channel
+type InviteLinkChatTypeChannel struct {
+	meta
+}
+
+func (*InviteLinkChatTypeChannel) GetType() string {
+	return TypeInviteLinkChatType
+}
+
+func (*InviteLinkChatTypeChannel) GetConstructor() string {
+	return ConstructorInviteLinkChatTypeChannel
+}
+
+func (*InviteLinkChatTypeChannel) InviteLinkChatTypeConstructor() string {
+	return ConstructorInviteLinkChatTypeChannel
+}
+
+func (entity *InviteLinkChatTypeChannel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InviteLinkChatTypeChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InviteLinkChatTypeChannel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inviteLinkChatTypeChannel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InviteLinkChatTypeChannel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInviteLinkChatTypeChannel)
+	})
+}
+
+// Contains information about a chat invite link
+type ChatInviteLinkInfo struct {
+	meta
+	// Chat identifier of the invite link; 0 if the user has no access to the chat before joining
+	ChatId int64 `json:"chat_id"`
+	// If non-zero, the amount of time for which read access to the chat will remain available, in seconds
+	AccessibleFor int32 `json:"accessible_for"`
+	// Type of the chat
+	Type InviteLinkChatType `json:"type"`
+	// Title of the chat
+	Title string `json:"title"`
+	// Chat photo; may be null
+	Photo *ChatPhotoInfo `json:"photo,omitempty"`
+	// Identifier of the accent color for chat title and background of chat photo
+	AccentColorId int32 `json:"accent_color_id"`
+	// Chat description
+	Description string `json:"description"`
+	// Number of members in the chat
+	MemberCount int32 `json:"member_count"`
+	// User identifiers of some chat members that may be known to the current user
+	MemberUserIds []int64 `json:"member_user_ids"`
+	// Information about subscription plan that must be paid by the user to use the link; may be null if the link doesn't require subscription
+	SubscriptionInfo *ChatInviteLinkSubscriptionInfo `json:"subscription_info,omitempty"`
+	// True, if the link only creates join request
+	CreatesJoinRequest bool `json:"creates_join_request"`
+	// True, if the chat is a public supergroup or channel, i.e. it has a username or it is a location-based supergroup
+	IsPublic bool `json:"is_public"`
+	// Information about verification status of the chat; may be null if none
+	VerificationStatus *VerificationStatus `json:"verification_status,omitempty"`
+}
+
+func (*ChatInviteLinkInfo) GetType() string {
+	return TypeChatInviteLinkInfo
+}
+
+func (*ChatInviteLinkInfo) GetConstructor() string {
+	return ConstructorChatInviteLinkInfo
+}
+
+func (entity *ChatInviteLinkInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLinkInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLinkInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLinkInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"accessible_for":`)
+	encoder.writeInt32(entity.AccessibleFor)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(entity.AccentColorId)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"member_count":`)
+	encoder.writeInt32(entity.MemberCount)
+	encoder.writeRaw(`,"member_user_ids":`)
+	writeList(encoder, entity.MemberUserIds, (*jsonEncoder).writeInt64)
+	if entity.SubscriptionInfo != nil {
+		encoder.writeRaw(`,"subscription_info":`)
+		entity.SubscriptionInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"creates_join_request":`)
+	encoder.writeBool(entity.CreatesJoinRequest)
+	encoder.writeRaw(`,"is_public":`)
+	encoder.writeBool(entity.IsPublic)
+	if entity.VerificationStatus != nil {
+		encoder.writeRaw(`,"verification_status":`)
+		entity.VerificationStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLinkInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "accessible_for":
+			entity.AccessibleFor, err = decoder.readInt32()
+
+		case "type":
+			entity.Type, err = decodeInviteLinkChatType(decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhotoInfo](decoder)
+
+		case "accent_color_id":
+			entity.AccentColorId, err = decoder.readInt32()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "member_count":
+			entity.MemberCount, err = decoder.readInt32()
+
+		case "member_user_ids":
+			entity.MemberUserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "subscription_info":
+			entity.SubscriptionInfo, err = decodeEntity[ChatInviteLinkSubscriptionInfo](decoder)
+
+		case "creates_join_request":
+			entity.CreatesJoinRequest, err = decoder.readBool()
+
+		case "is_public":
+			entity.IsPublic, err = decoder.readBool()
+
+		case "verification_status":
+			entity.VerificationStatus, err = decodeEntity[VerificationStatus](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLinkInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a user that sent a join request and waits for administrator approval
+type ChatJoinRequest struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Point in time (Unix timestamp) when the user sent the join request
+	Date int32 `json:"date"`
+	// A short bio of the user
+	Bio string `json:"bio"`
+}
+
+func (*ChatJoinRequest) GetType() string {
+	return TypeChatJoinRequest
+}
+
+func (*ChatJoinRequest) GetConstructor() string {
+	return ConstructorChatJoinRequest
+}
+
+func (entity *ChatJoinRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatJoinRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatJoinRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatJoinRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"bio":`)
+	encoder.writeString(entity.Bio)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatJoinRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "bio":
+			entity.Bio, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatJoinRequest)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of requests to join a chat
+type ChatJoinRequests struct {
+	meta
+	// Approximate total number of requests found
+	TotalCount int32 `json:"total_count"`
+	// List of the requests
+	Requests []*ChatJoinRequest `json:"requests"`
+}
+
+func (*ChatJoinRequests) GetType() string {
+	return TypeChatJoinRequests
+}
+
+func (*ChatJoinRequests) GetConstructor() string {
+	return ConstructorChatJoinRequests
+}
+
+func (entity *ChatJoinRequests) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatJoinRequests) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatJoinRequests) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatJoinRequests"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"requests":`)
+	writeList(encoder, entity.Requests, writeTypeValue[*ChatJoinRequest])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatJoinRequests) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "requests":
+			entity.Requests, err = readList(decoder, decodeEntity[ChatJoinRequest])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatJoinRequests)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about pending join requests for a chat
+type ChatJoinRequestsInfo struct {
+	meta
+	// Total number of pending join requests
+	TotalCount int32 `json:"total_count"`
+	// Identifiers of at most 3 users sent the newest pending join requests
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (*ChatJoinRequestsInfo) GetType() string {
+	return TypeChatJoinRequestsInfo
+}
+
+func (*ChatJoinRequestsInfo) GetConstructor() string {
+	return ConstructorChatJoinRequestsInfo
+}
+
+func (entity *ChatJoinRequestsInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatJoinRequestsInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatJoinRequestsInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatJoinRequestsInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatJoinRequestsInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatJoinRequestsInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a basic group of 0-200 users (must be upgraded to a supergroup to accommodate more than 200 users)
+type BasicGroup struct {
+	meta
+	// Group identifier
+	Id int64 `json:"id"`
+	// Number of members in the group
+	MemberCount int32 `json:"member_count"`
+	// Status of the current user in the group
+	Status ChatMemberStatus `json:"status"`
+	// True, if the group is active
+	IsActive bool `json:"is_active"`
+	// Identifier of the supergroup to which this group was upgraded; 0 if none
+	UpgradedToSupergroupId int64 `json:"upgraded_to_supergroup_id"`
+}
+
+func (*BasicGroup) GetType() string {
+	return TypeBasicGroup
+}
+
+func (*BasicGroup) GetConstructor() string {
+	return ConstructorBasicGroup
+}
+
+func (entity *BasicGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BasicGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BasicGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"basicGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	encoder.writeRaw(`,"member_count":`)
+	encoder.writeInt32(entity.MemberCount)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(entity.Status)
+	encoder.writeRaw(`,"is_active":`)
+	encoder.writeBool(entity.IsActive)
+	encoder.writeRaw(`,"upgraded_to_supergroup_id":`)
+	encoder.writeInt64(entity.UpgradedToSupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (entity *BasicGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "member_count":
+			entity.MemberCount, err = decoder.readInt32()
+
+		case "status":
+			entity.Status, err = decodeChatMemberStatus(decoder)
+
+		case "is_active":
+			entity.IsActive, err = decoder.readBool()
+
+		case "upgraded_to_supergroup_id":
+			entity.UpgradedToSupergroupId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBasicGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *BasicGroup) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("status", entity.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains full information about a basic group
+type BasicGroupFullInfo struct {
+	meta
+	// Chat photo; may be null if empty or unknown. If non-null, then it is the same photo as in chat.photo
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// Group description. Updated only after the basic group is opened
+	Description string `json:"description"`
+	// User identifier of the creator of the group; 0 if unknown
+	CreatorUserId int64 `json:"creator_user_id"`
+	// Group members
+	Members []*ChatMember `json:"members"`
+	// True, if non-administrators and non-bots can be hidden in responses to getSupergroupMembers and searchChatMembers for non-administrators after upgrading the basic group to a supergroup
+	CanHideMembers bool `json:"can_hide_members"`
+	// True, if aggressive anti-spam checks can be enabled or disabled in the supergroup after upgrading the basic group to a supergroup
+	CanToggleAggressiveAntiSpam bool `json:"can_toggle_aggressive_anti_spam"`
+	// Primary invite link for this group; may be null. For chat administrators with can_invite_users right only. Updated only after the basic group is opened
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+	// List of commands of bots in the group
+	BotCommands []*BotCommands `json:"bot_commands"`
+}
+
+func (*BasicGroupFullInfo) GetType() string {
+	return TypeBasicGroupFullInfo
+}
+
+func (*BasicGroupFullInfo) GetConstructor() string {
+	return ConstructorBasicGroupFullInfo
+}
+
+func (entity *BasicGroupFullInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BasicGroupFullInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BasicGroupFullInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"basicGroupFullInfo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"creator_user_id":`)
+	encoder.writeInt64(entity.CreatorUserId)
+	encoder.writeRaw(`,"members":`)
+	writeList(encoder, entity.Members, writeTypeValue[*ChatMember])
+	encoder.writeRaw(`,"can_hide_members":`)
+	encoder.writeBool(entity.CanHideMembers)
+	encoder.writeRaw(`,"can_toggle_aggressive_anti_spam":`)
+	encoder.writeBool(entity.CanToggleAggressiveAntiSpam)
+	if entity.InviteLink != nil {
+		encoder.writeRaw(`,"invite_link":`)
+		entity.InviteLink.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"bot_commands":`)
+	writeList(encoder, entity.BotCommands, writeTypeValue[*BotCommands])
+	encoder.writeRaw("}")
+}
+
+func (entity *BasicGroupFullInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "creator_user_id":
+			entity.CreatorUserId, err = decoder.readInt64()
+
+		case "members":
+			entity.Members, err = readList(decoder, decodeEntity[ChatMember])
+
+		case "can_hide_members":
+			entity.CanHideMembers, err = decoder.readBool()
+
+		case "can_toggle_aggressive_anti_spam":
+			entity.CanToggleAggressiveAntiSpam, err = decoder.readBool()
+
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		case "bot_commands":
+			entity.BotCommands, err = readList(decoder, decodeEntity[BotCommands])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBasicGroupFullInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *BasicGroupFullInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Members {
+		if err := validateNested(indexField("members", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Represents a supergroup or channel with zero or more members (subscribers in the case of channels). From the point of view of the system, a channel is a special kind of a supergroup: only administrators can post and see the list of members, and posts from all administrators use the name and photo of the channel instead of individual names and profile photos. Unlike supergroups, channels can have an unlimited number of subscribers
+type Supergroup struct {
+	meta
+	// Supergroup or channel identifier
+	Id int64 `json:"id"`
+	// Usernames of the supergroup or channel; may be null
+	Usernames *Usernames `json:"usernames,omitempty"`
+	// Point in time (Unix timestamp) when the current user joined, or the point in time when the supergroup or channel was created, in case the user is not a member
+	Date int32 `json:"date"`
+	// Status of the current user in the supergroup or channel; custom title will always be empty
+	Status ChatMemberStatus `json:"status"`
+	// Number of members in the supergroup or channel; 0 if unknown. Currently, it is guaranteed to be known only if the supergroup or channel was received through getChatSimilarChats, getChatsToSendStories, getCreatedPublicChats, getGroupsInCommon, getInactiveSupergroupChats, getRecommendedChats, getSuitableDiscussionChats, getUserPrivacySettingRules, getVideoChatAvailableParticipants, searchPublicChats, or in chatFolderInviteLinkInfo.missing_chat_ids, or in userFullInfo.personal_chat_id, or for chats with messages or stories from publicForwards and foundStories
+	MemberCount int32 `json:"member_count"`
+	// Approximate boost level for the chat
+	BoostLevel int32 `json:"boost_level"`
+	// True, if the channel has a discussion group, or the supergroup is the designated discussion group for a channel
+	HasLinkedChat bool `json:"has_linked_chat"`
+	// True, if the supergroup is connected to a location, i.e. the supergroup is a location-based supergroup
+	HasLocation bool `json:"has_location"`
+	// True, if messages sent to the channel contains name of the sender. This field is only applicable to channels
+	SignMessages bool `json:"sign_messages"`
+	// True, if messages sent to the channel have information about the sender user. This field is only applicable to channels
+	ShowMessageSender bool `json:"show_message_sender"`
+	// True, if users need to join the supergroup before they can send messages. Always true for channels and non-discussion supergroups
+	JoinToSendMessages bool `json:"join_to_send_messages"`
+	// True, if all users directly joining the supergroup need to be approved by supergroup administrators. Always false for channels and supergroups without username, location, or a linked chat
+	JoinByRequest bool `json:"join_by_request"`
+	// True, if the slow mode is enabled in the supergroup
+	IsSlowModeEnabled bool `json:"is_slow_mode_enabled"`
+	// True, if the supergroup is a channel
+	IsChannel bool `json:"is_channel"`
+	// True, if the supergroup is a broadcast group, i.e. only administrators can send messages and there is no limit on the number of members
+	IsBroadcastGroup bool `json:"is_broadcast_group"`
+	// True, if the supergroup is a forum with topics
+	IsForum bool `json:"is_forum"`
+	// Information about verification status of the supergroup or channel; may be null if none
+	VerificationStatus *VerificationStatus `json:"verification_status,omitempty"`
+	// True, if content of media messages in the supergroup or channel chat must be hidden with 18+ spoiler
+	HasSensitiveContent bool `json:"has_sensitive_content"`
+	// If non-empty, contains a human-readable description of the reason why access to this supergroup or channel must be restricted
+	RestrictionReason string `json:"restriction_reason"`
+	// Number of Telegram Stars that must be paid by non-administrator users of the supergroup chat for each sent message
+	PaidMessageStarCount int64 `json:"paid_message_star_count"`
+	// True, if the supergroup or channel has non-expired stories available to the current user
+	HasActiveStories bool `json:"has_active_stories"`
+	// True, if the supergroup or channel has unread non-expired stories available to the current user
+	HasUnreadActiveStories bool `json:"has_unread_active_stories"`
+}
+
+func (*Supergroup) GetType() string {
+	return TypeSupergroup
+}
+
+func (*Supergroup) GetConstructor() string {
+	return ConstructorSupergroup
+}
+
+func (entity *Supergroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Supergroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Supergroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	if entity.Usernames != nil {
+		encoder.writeRaw(`,"usernames":`)
+		entity.Usernames.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(entity.Status)
+	encoder.writeRaw(`,"member_count":`)
+	encoder.writeInt32(entity.MemberCount)
+	encoder.writeRaw(`,"boost_level":`)
+	encoder.writeInt32(entity.BoostLevel)
+	encoder.writeRaw(`,"has_linked_chat":`)
+	encoder.writeBool(entity.HasLinkedChat)
+	encoder.writeRaw(`,"has_location":`)
+	encoder.writeBool(entity.HasLocation)
+	encoder.writeRaw(`,"sign_messages":`)
+	encoder.writeBool(entity.SignMessages)
+	encoder.writeRaw(`,"show_message_sender":`)
+	encoder.writeBool(entity.ShowMessageSender)
+	encoder.writeRaw(`,"join_to_send_messages":`)
+	encoder.writeBool(entity.JoinToSendMessages)
+	encoder.writeRaw(`,"join_by_request":`)
+	encoder.writeBool(entity.JoinByRequest)
+	encoder.writeRaw(`,"is_slow_mode_enabled":`)
+	encoder.writeBool(entity.IsSlowModeEnabled)
+	encoder.writeRaw(`,"is_channel":`)
+	encoder.writeBool(entity.IsChannel)
+	encoder.writeRaw(`,"is_broadcast_group":`)
+	encoder.writeBool(entity.IsBroadcastGroup)
+	encoder.writeRaw(`,"is_forum":`)
+	encoder.writeBool(entity.IsForum)
+	if entity.VerificationStatus != nil {
+		encoder.writeRaw(`,"verification_status":`)
+		entity.VerificationStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"has_sensitive_content":`)
+	encoder.writeBool(entity.HasSensitiveContent)
+	encoder.writeRaw(`,"restriction_reason":`)
+	encoder.writeString(entity.RestrictionReason)
+	encoder.writeRaw(`,"paid_message_star_count":`)
+	encoder.writeInt64(entity.PaidMessageStarCount)
+	encoder.writeRaw(`,"has_active_stories":`)
+	encoder.writeBool(entity.HasActiveStories)
+	encoder.writeRaw(`,"has_unread_active_stories":`)
+	encoder.writeBool(entity.HasUnreadActiveStories)
+	encoder.writeRaw("}")
+}
+
+func (entity *Supergroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "usernames":
+			entity.Usernames, err = decodeEntity[Usernames](decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "status":
+			entity.Status, err = decodeChatMemberStatus(decoder)
+
+		case "member_count":
+			entity.MemberCount, err = decoder.readInt32()
+
+		case "boost_level":
+			entity.BoostLevel, err = decoder.readInt32()
+
+		case "has_linked_chat":
+			entity.HasLinkedChat, err = decoder.readBool()
+
+		case "has_location":
+			entity.HasLocation, err = decoder.readBool()
+
+		case "sign_messages":
+			entity.SignMessages, err = decoder.readBool()
+
+		case "show_message_sender":
+			entity.ShowMessageSender, err = decoder.readBool()
+
+		case "join_to_send_messages":
+			entity.JoinToSendMessages, err = decoder.readBool()
+
+		case "join_by_request":
+			entity.JoinByRequest, err = decoder.readBool()
+
+		case "is_slow_mode_enabled":
+			entity.IsSlowModeEnabled, err = decoder.readBool()
+
+		case "is_channel":
+			entity.IsChannel, err = decoder.readBool()
+
+		case "is_broadcast_group":
+			entity.IsBroadcastGroup, err = decoder.readBool()
+
+		case "is_forum":
+			entity.IsForum, err = decoder.readBool()
+
+		case "verification_status":
+			entity.VerificationStatus, err = decodeEntity[VerificationStatus](decoder)
+
+		case "has_sensitive_content":
+			entity.HasSensitiveContent, err = decoder.readBool()
+
+		case "restriction_reason":
+			entity.RestrictionReason, err = decoder.readString()
+
+		case "paid_message_star_count":
+			entity.PaidMessageStarCount, err = decoder.readInt64()
+
+		case "has_active_stories":
+			entity.HasActiveStories, err = decoder.readBool()
+
+		case "has_unread_active_stories":
+			entity.HasUnreadActiveStories, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSupergroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Supergroup) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("status", entity.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains full information about a supergroup or channel
+type SupergroupFullInfo struct {
+	meta
+	// Chat photo; may be null if empty or unknown. If non-null, then it is the same photo as in chat.photo
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// Supergroup or channel description
+	Description string `json:"description"`
+	// Number of members in the supergroup or channel; 0 if unknown
+	MemberCount int32 `json:"member_count"`
+	// Number of privileged users in the supergroup or channel; 0 if unknown
+	AdministratorCount int32 `json:"administrator_count"`
+	// Number of restricted users in the supergroup; 0 if unknown
+	RestrictedCount int32 `json:"restricted_count"`
+	// Number of users banned from chat; 0 if unknown
+	BannedCount int32 `json:"banned_count"`
+	// Chat identifier of a discussion group for the channel, or a channel, for which the supergroup is the designated discussion group; 0 if none or unknown
+	LinkedChatId int64 `json:"linked_chat_id"`
+	// Delay between consecutive sent messages for non-administrator supergroup members, in seconds
+	SlowModeDelay int32 `json:"slow_mode_delay"`
+	// Time left before next message can be sent in the supergroup, in seconds. An updateSupergroupFullInfo update is not triggered when value of this field changes, but both new and old values are non-zero
+	SlowModeDelayExpiresIn float64 `json:"slow_mode_delay_expires_in"`
+	// True, if paid messages can be enabled in the supergroup chat; for supergroup only
+	CanEnablePaidMessages bool `json:"can_enable_paid_messages"`
+	// True, if paid reaction can be enabled in the channel chat; for channels only
+	CanEnablePaidReaction bool `json:"can_enable_paid_reaction"`
+	// True, if members of the chat can be retrieved via getSupergroupMembers or searchChatMembers
+	CanGetMembers bool `json:"can_get_members"`
+	// True, if non-administrators can receive only administrators and bots using getSupergroupMembers or searchChatMembers
+	HasHiddenMembers bool `json:"has_hidden_members"`
+	// True, if non-administrators and non-bots can be hidden in responses to getSupergroupMembers and searchChatMembers for non-administrators
+	CanHideMembers bool `json:"can_hide_members"`
+	// True, if the supergroup sticker set can be changed
+	CanSetStickerSet bool `json:"can_set_sticker_set"`
+	// True, if the supergroup location can be changed
+	CanSetLocation bool `json:"can_set_location"`
+	// True, if the supergroup or channel statistics are available
+	CanGetStatistics bool `json:"can_get_statistics"`
+	// True, if the supergroup or channel revenue statistics are available
+	CanGetRevenueStatistics bool `json:"can_get_revenue_statistics"`
+	// True, if the supergroup or channel Telegram Star revenue statistics are available
+	CanGetStarRevenueStatistics bool `json:"can_get_star_revenue_statistics"`
+	// True, if the user can send a gift to the supergroup or channel using sendGift or transferGift
+	CanSendGift bool `json:"can_send_gift"`
+	// True, if aggressive anti-spam checks can be enabled or disabled in the supergroup
+	CanToggleAggressiveAntiSpam bool `json:"can_toggle_aggressive_anti_spam"`
+	// True, if new chat members will have access to old messages. In public, discussion, of forum groups and all channels, old messages are always available, so this option affects only private non-forum supergroups without a linked chat. The value of this field is only available to chat administrators
+	IsAllHistoryAvailable bool `json:"is_all_history_available"`
+	// True, if the chat can have sponsored messages. The value of this field is only available to the owner of the chat
+	CanHaveSponsoredMessages bool `json:"can_have_sponsored_messages"`
+	// True, if aggressive anti-spam checks are enabled in the supergroup. The value of this field is only available to chat administrators
+	HasAggressiveAntiSpamEnabled bool `json:"has_aggressive_anti_spam_enabled"`
+	// True, if paid media can be sent and forwarded to the channel chat; for channels only
+	HasPaidMediaAllowed bool `json:"has_paid_media_allowed"`
+	// True, if the supergroup or channel has pinned stories
+	HasPinnedStories bool `json:"has_pinned_stories"`
+	// Number of saved to profile gifts for channels without can_post_messages administrator right, otherwise, the total number of received gifts
+	GiftCount int32 `json:"gift_count"`
+	// Number of times the current user boosted the supergroup or channel
+	MyBoostCount int32 `json:"my_boost_count"`
+	// Number of times the supergroup must be boosted by a user to ignore slow mode and chat permission restrictions; 0 if unspecified
+	UnrestrictBoostCount int32 `json:"unrestrict_boost_count"`
+	// Identifier of the supergroup sticker set that must be shown before user sticker sets; 0 if none
+	StickerSetId JsonInt64 `json:"sticker_set_id"`
+	// Identifier of the custom emoji sticker set that can be used in the supergroup without Telegram Premium subscription; 0 if none
+	CustomEmojiStickerSetId JsonInt64 `json:"custom_emoji_sticker_set_id"`
+	// Location to which the supergroup is connected; may be null if none
+	Location *ChatLocation `json:"location,omitempty"`
+	// Primary invite link for the chat; may be null. For chat administrators with can_invite_users right only
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+	// List of commands of bots in the group
+	BotCommands []*BotCommands `json:"bot_commands"`
+	// Information about verification status of the supergroup or the channel provided by a bot; may be null if none or unknown
+	BotVerification *BotVerification `json:"bot_verification,omitempty"`
+	// Identifier of the basic group from which supergroup was upgraded; 0 if none
+	UpgradedFromBasicGroupId int64 `json:"upgraded_from_basic_group_id"`
+	// Identifier of the last message in the basic group from which supergroup was upgraded; 0 if none
+	UpgradedFromMaxMessageId int64 `json:"upgraded_from_max_message_id"`
+}
+
+func (*SupergroupFullInfo) GetType() string {
+	return TypeSupergroupFullInfo
+}
+
+func (*SupergroupFullInfo) GetConstructor() string {
+	return ConstructorSupergroupFullInfo
+}
+
+func (entity *SupergroupFullInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SupergroupFullInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SupergroupFullInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"supergroupFullInfo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"member_count":`)
+	encoder.writeInt32(entity.MemberCount)
+	encoder.writeRaw(`,"administrator_count":`)
+	encoder.writeInt32(entity.AdministratorCount)
+	encoder.writeRaw(`,"restricted_count":`)
+	encoder.writeInt32(entity.RestrictedCount)
+	encoder.writeRaw(`,"banned_count":`)
+	encoder.writeInt32(entity.BannedCount)
+	encoder.writeRaw(`,"linked_chat_id":`)
+	encoder.writeInt64(entity.LinkedChatId)
+	encoder.writeRaw(`,"slow_mode_delay":`)
+	encoder.writeInt32(entity.SlowModeDelay)
+	encoder.writeRaw(`,"slow_mode_delay_expires_in":`)
+	encoder.writeFloat64(entity.SlowModeDelayExpiresIn)
+	encoder.writeRaw(`,"can_enable_paid_messages":`)
+	encoder.writeBool(entity.CanEnablePaidMessages)
+	encoder.writeRaw(`,"can_enable_paid_reaction":`)
+	encoder.writeBool(entity.CanEnablePaidReaction)
+	encoder.writeRaw(`,"can_get_members":`)
+	encoder.writeBool(entity.CanGetMembers)
+	encoder.writeRaw(`,"has_hidden_members":`)
+	encoder.writeBool(entity.HasHiddenMembers)
+	encoder.writeRaw(`,"can_hide_members":`)
+	encoder.writeBool(entity.CanHideMembers)
+	encoder.writeRaw(`,"can_set_sticker_set":`)
+	encoder.writeBool(entity.CanSetStickerSet)
+	encoder.writeRaw(`,"can_set_location":`)
+	encoder.writeBool(entity.CanSetLocation)
+	encoder.writeRaw(`,"can_get_statistics":`)
+	encoder.writeBool(entity.CanGetStatistics)
+	encoder.writeRaw(`,"can_get_revenue_statistics":`)
+	encoder.writeBool(entity.CanGetRevenueStatistics)
+	encoder.writeRaw(`,"can_get_star_revenue_statistics":`)
+	encoder.writeBool(entity.CanGetStarRevenueStatistics)
+	encoder.writeRaw(`,"can_send_gift":`)
+	encoder.writeBool(entity.CanSendGift)
+	encoder.writeRaw(`,"can_toggle_aggressive_anti_spam":`)
+	encoder.writeBool(entity.CanToggleAggressiveAntiSpam)
+	encoder.writeRaw(`,"is_all_history_available":`)
+	encoder.writeBool(entity.IsAllHistoryAvailable)
+	encoder.writeRaw(`,"can_have_sponsored_messages":`)
+	encoder.writeBool(entity.CanHaveSponsoredMessages)
+	encoder.writeRaw(`,"has_aggressive_anti_spam_enabled":`)
+	encoder.writeBool(entity.HasAggressiveAntiSpamEnabled)
+	encoder.writeRaw(`,"has_paid_media_allowed":`)
+	encoder.writeBool(entity.HasPaidMediaAllowed)
+	encoder.writeRaw(`,"has_pinned_stories":`)
+	encoder.writeBool(entity.HasPinnedStories)
+	encoder.writeRaw(`,"gift_count":`)
+	encoder.writeInt32(entity.GiftCount)
+	encoder.writeRaw(`,"my_boost_count":`)
+	encoder.writeInt32(entity.MyBoostCount)
+	encoder.writeRaw(`,"unrestrict_boost_count":`)
+	encoder.writeInt32(entity.UnrestrictBoostCount)
+	encoder.writeRaw(`,"sticker_set_id":`)
+	encoder.writeJsonInt64(entity.StickerSetId)
+	encoder.writeRaw(`,"custom_emoji_sticker_set_id":`)
+	encoder.writeJsonInt64(entity.CustomEmojiStickerSetId)
+	if entity.Location != nil {
+		encoder.writeRaw(`,"location":`)
+		entity.Location.encodeJSON(encoder)
+	}
+	if entity.InviteLink != nil {
+		encoder.writeRaw(`,"invite_link":`)
+		entity.InviteLink.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"bot_commands":`)
+	writeList(encoder, entity.BotCommands, writeTypeValue[*BotCommands])
+	if entity.BotVerification != nil {
+		encoder.writeRaw(`,"bot_verification":`)
+		entity.BotVerification.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"upgraded_from_basic_group_id":`)
+	encoder.writeInt64(entity.UpgradedFromBasicGroupId)
+	encoder.writeRaw(`,"upgraded_from_max_message_id":`)
+	encoder.writeInt64(entity.UpgradedFromMaxMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *SupergroupFullInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "member_count":
+			entity.MemberCount, err = decoder.readInt32()
+
+		case "administrator_count":
+			entity.AdministratorCount, err = decoder.readInt32()
+
+		case "restricted_count":
+			entity.RestrictedCount, err = decoder.readInt32()
+
+		case "banned_count":
+			entity.BannedCount, err = decoder.readInt32()
+
+		case "linked_chat_id":
+			entity.LinkedChatId, err = decoder.readInt64()
+
+		case "slow_mode_delay":
+			entity.SlowModeDelay, err = decoder.readInt32()
+
+		case "slow_mode_delay_expires_in":
+			entity.SlowModeDelayExpiresIn, err = decoder.readFloat64()
+
+		case "can_enable_paid_messages":
+			entity.CanEnablePaidMessages, err = decoder.readBool()
+
+		case "can_enable_paid_reaction":
+			entity.CanEnablePaidReaction, err = decoder.readBool()
+
+		case "can_get_members":
+			entity.CanGetMembers, err = decoder.readBool()
+
+		case "has_hidden_members":
+			entity.HasHiddenMembers, err = decoder.readBool()
+
+		case "can_hide_members":
+			entity.CanHideMembers, err = decoder.readBool()
+
+		case "can_set_sticker_set":
+			entity.CanSetStickerSet, err = decoder.readBool()
+
+		case "can_set_location":
+			entity.CanSetLocation, err = decoder.readBool()
+
+		case "can_get_statistics":
+			entity.CanGetStatistics, err = decoder.readBool()
+
+		case "can_get_revenue_statistics":
+			entity.CanGetRevenueStatistics, err = decoder.readBool()
+
+		case "can_get_star_revenue_statistics":
+			entity.CanGetStarRevenueStatistics, err = decoder.readBool()
+
+		case "can_send_gift":
+			entity.CanSendGift, err = decoder.readBool()
+
+		case "can_toggle_aggressive_anti_spam":
+			entity.CanToggleAggressiveAntiSpam, err = decoder.readBool()
+
+		case "is_all_history_available":
+			entity.IsAllHistoryAvailable, err = decoder.readBool()
+
+		case "can_have_sponsored_messages":
+			entity.CanHaveSponsoredMessages, err = decoder.readBool()
+
+		case "has_aggressive_anti_spam_enabled":
+			entity.HasAggressiveAntiSpamEnabled, err = decoder.readBool()
+
+		case "has_paid_media_allowed":
+			entity.HasPaidMediaAllowed, err = decoder.readBool()
+
+		case "has_pinned_stories":
+			entity.HasPinnedStories, err = decoder.readBool()
+
+		case "gift_count":
+			entity.GiftCount, err = decoder.readInt32()
+
+		case "my_boost_count":
+			entity.MyBoostCount, err = decoder.readInt32()
+
+		case "unrestrict_boost_count":
+			entity.UnrestrictBoostCount, err = decoder.readInt32()
+
+		case "sticker_set_id":
+			entity.StickerSetId, err = decoder.readJsonInt64()
+
+		case "custom_emoji_sticker_set_id":
+			entity.CustomEmojiStickerSetId, err = decoder.readJsonInt64()
+
+		case "location":
+			entity.Location, err = decodeEntity[ChatLocation](decoder)
+
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		case "bot_commands":
+			entity.BotCommands, err = readList(decoder, decodeEntity[BotCommands])
+
+		case "bot_verification":
+			entity.BotVerification, err = decodeEntity[BotVerification](decoder)
+
+		case "upgraded_from_basic_group_id":
+			entity.UpgradedFromBasicGroupId, err = decoder.readInt64()
+
+		case "upgraded_from_max_message_id":
+			entity.UpgradedFromMaxMessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSupergroupFullInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *SupergroupFullInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("location", entity.Location); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The secret chat is not yet created; waiting for the other user to get online
+type SecretChatStatePending struct {
+	meta
+}
+
+func (*SecretChatStatePending) GetType() string {
+	return TypeSecretChatState
+}
+
+func (*SecretChatStatePending) GetConstructor() string {
+	return ConstructorSecretChatStatePending
+}
+
+func (*SecretChatStatePending) SecretChatStateConstructor() string {
+	return ConstructorSecretChatStatePending
+}
+
+func (entity *SecretChatStatePending) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SecretChatStatePending) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SecretChatStatePending) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"secretChatStatePending"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SecretChatStatePending) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSecretChatStatePending)
+	})
+}
+
+// The secret chat is ready to use
+type SecretChatStateReady struct {
+	meta
+}
+
+func (*SecretChatStateReady) GetType() string {
+	return TypeSecretChatState
+}
+
+func (*SecretChatStateReady) GetConstructor() string {
+	return ConstructorSecretChatStateReady
+}
+
+func (*SecretChatStateReady) SecretChatStateConstructor() string {
+	return ConstructorSecretChatStateReady
+}
+
+func (entity *SecretChatStateReady) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SecretChatStateReady) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SecretChatStateReady) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"secretChatStateReady"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SecretChatStateReady) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSecretChatStateReady)
+	})
+}
+
+// The secret chat is closed
+type SecretChatStateClosed struct {
+	meta
+}
+
+func (*SecretChatStateClosed) GetType() string {
+	return TypeSecretChatState
+}
+
+func (*SecretChatStateClosed) GetConstructor() string {
+	return ConstructorSecretChatStateClosed
+}
+
+func (*SecretChatStateClosed) SecretChatStateConstructor() string {
+	return ConstructorSecretChatStateClosed
+}
+
+func (entity *SecretChatStateClosed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SecretChatStateClosed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SecretChatStateClosed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"secretChatStateClosed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SecretChatStateClosed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSecretChatStateClosed)
+	})
+}
+
+// Represents a secret chat
+type SecretChat struct {
+	meta
+	// Secret chat identifier
+	Id int32 `json:"id"`
+	// Identifier of the chat partner
+	UserId int64 `json:"user_id"`
+	// State of the secret chat
+	State SecretChatState `json:"state"`
+	// True, if the chat was created by the current user; false otherwise
+	IsOutbound bool `json:"is_outbound"`
+	// Hash of the currently used key for comparison with the hash of the chat partner's key. This is a string of 36 little-endian bytes, which must be split into groups of 2 bits, each denoting a pixel of one of 4 colors FFFFFF, D5E6F3, 2D5775, and 2F99C9. The pixels must be used to make a 12x12 square image filled from left to right, top to bottom. Alternatively, the first 32 bytes of the hash can be converted to the hexadecimal format and printed as 32 2-digit hex numbers
+	KeyHash []byte `json:"key_hash"`
+	// Secret chat layer; determines features supported by the chat partner's application. Nested text entities and underline and strikethrough entities are supported if the layer >= 101, files bigger than 2000MB are supported if the layer >= 143, spoiler and custom emoji text entities are supported if the layer >= 144
+	Layer int32 `json:"layer"`
+}
+
+func (*SecretChat) GetType() string {
+	return TypeSecretChat
+}
+
+func (*SecretChat) GetConstructor() string {
+	return ConstructorSecretChat
+}
+
+func (entity *SecretChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SecretChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SecretChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"secretChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"state":`)
+	encoder.writeType(entity.State)
+	encoder.writeRaw(`,"is_outbound":`)
+	encoder.writeBool(entity.IsOutbound)
+	encoder.writeRaw(`,"key_hash":`)
+	encoder.writeBytes(entity.KeyHash)
+	encoder.writeRaw(`,"layer":`)
+	encoder.writeInt32(entity.Layer)
+	encoder.writeRaw("}")
+}
+
+func (entity *SecretChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "state":
+			entity.State, err = decodeSecretChatState(decoder)
+
+		case "is_outbound":
+			entity.IsOutbound, err = decoder.readBool()
+
+		case "key_hash":
+			entity.KeyHash, err = decoder.readBytes()
+
+		case "layer":
+			entity.Layer, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSecretChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a sponsored chat
+type SponsoredChat struct {
+	meta
+	// Unique identifier of this result
+	UniqueId int64 `json:"unique_id"`
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Additional optional information about the sponsor to be shown along with the chat
+	SponsorInfo string `json:"sponsor_info"`
+	// If non-empty, additional information about the sponsored chat to be shown along with the chat
+	AdditionalInfo string `json:"additional_info"`
+}
+
+func (*SponsoredChat) GetType() string {
+	return TypeSponsoredChat
+}
+
+func (*SponsoredChat) GetConstructor() string {
+	return ConstructorSponsoredChat
+}
+
+func (entity *SponsoredChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SponsoredChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SponsoredChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sponsoredChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"unique_id":`)
+	encoder.writeInt64(entity.UniqueId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"sponsor_info":`)
+	encoder.writeString(entity.SponsorInfo)
+	encoder.writeRaw(`,"additional_info":`)
+	encoder.writeString(entity.AdditionalInfo)
+	encoder.writeRaw("}")
+}
+
+func (entity *SponsoredChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "unique_id":
+			entity.UniqueId, err = decoder.readInt64()
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "sponsor_info":
+			entity.SponsorInfo, err = decoder.readString()
+
+		case "additional_info":
+			entity.AdditionalInfo, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSponsoredChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of sponsored chats
+type SponsoredChats struct {
+	meta
+	// List of sponsored chats
+	Chats []*SponsoredChat `json:"chats"`
+}
+
+func (*SponsoredChats) GetType() string {
+	return TypeSponsoredChats
+}
+
+func (*SponsoredChats) GetConstructor() string {
+	return ConstructorSponsoredChats
+}
+
+func (entity *SponsoredChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SponsoredChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SponsoredChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sponsoredChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chats":`)
+	writeList(encoder, entity.Chats, writeTypeValue[*SponsoredChat])
+	encoder.writeRaw("}")
+}
+
+func (entity *SponsoredChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chats":
+			entity.Chats, err = readList(decoder, decodeEntity[SponsoredChat])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSponsoredChats)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about notification settings for a chat or a forum topic
+type ChatNotificationSettings struct {
+	meta
+	// If true, the value for the relevant type of chat or the forum chat is used instead of mute_for
+	UseDefaultMuteFor bool `json:"use_default_mute_for"`
+	// Time left before notifications will be unmuted, in seconds
+	MuteFor int32 `json:"mute_for"`
+	// If true, the value for the relevant type of chat or the forum chat is used instead of sound_id
+	UseDefaultSound bool `json:"use_default_sound"`
+	// Identifier of the notification sound to be played for messages; 0 if sound is disabled
+	SoundId JsonInt64 `json:"sound_id"`
+	// If true, the value for the relevant type of chat or the forum chat is used instead of show_preview
+	UseDefaultShowPreview bool `json:"use_default_show_preview"`
+	// True, if message content must be displayed in notifications
+	ShowPreview bool `json:"show_preview"`
+	// If true, the value for the relevant type of chat is used instead of mute_stories
+	UseDefaultMuteStories bool `json:"use_default_mute_stories"`
+	// True, if story notifications are disabled for the chat
+	MuteStories bool `json:"mute_stories"`
+	// If true, the value for the relevant type of chat is used instead of story_sound_id
+	UseDefaultStorySound bool `json:"use_default_story_sound"`
+	// Identifier of the notification sound to be played for stories; 0 if sound is disabled
+	StorySoundId JsonInt64 `json:"story_sound_id"`
+	// If true, the value for the relevant type of chat is used instead of show_story_sender
+	UseDefaultShowStorySender bool `json:"use_default_show_story_sender"`
+	// True, if the sender of stories must be displayed in notifications
+	ShowStorySender bool `json:"show_story_sender"`
+	// If true, the value for the relevant type of chat or the forum chat is used instead of disable_pinned_message_notifications
+	UseDefaultDisablePinnedMessageNotifications bool `json:"use_default_disable_pinned_message_notifications"`
+	// If true, notifications for incoming pinned messages will be created as for an ordinary unread message
+	DisablePinnedMessageNotifications bool `json:"disable_pinned_message_notifications"`
+	// If true, the value for the relevant type of chat or the forum chat is used instead of disable_mention_notifications
+	UseDefaultDisableMentionNotifications bool `json:"use_default_disable_mention_notifications"`
+	// If true, notifications for messages with mentions will be created as for an ordinary unread message
+	DisableMentionNotifications bool `json:"disable_mention_notifications"`
+}
+
+func (*ChatNotificationSettings) GetType() string {
+	return TypeChatNotificationSettings
+}
+
+func (*ChatNotificationSettings) GetConstructor() string {
+	return ConstructorChatNotificationSettings
+}
+
+func (entity *ChatNotificationSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatNotificationSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatNotificationSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatNotificationSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"use_default_mute_for":`)
+	encoder.writeBool(entity.UseDefaultMuteFor)
+	encoder.writeRaw(`,"mute_for":`)
+	encoder.writeInt32(entity.MuteFor)
+	encoder.writeRaw(`,"use_default_sound":`)
+	encoder.writeBool(entity.UseDefaultSound)
+	encoder.writeRaw(`,"sound_id":`)
+	encoder.writeJsonInt64(entity.SoundId)
+	encoder.writeRaw(`,"use_default_show_preview":`)
+	encoder.writeBool(entity.UseDefaultShowPreview)
+	encoder.writeRaw(`,"show_preview":`)
+	encoder.writeBool(entity.ShowPreview)
+	encoder.writeRaw(`,"use_default_mute_stories":`)
+	encoder.writeBool(entity.UseDefaultMuteStories)
+	encoder.writeRaw(`,"mute_stories":`)
+	encoder.writeBool(entity.MuteStories)
+	encoder.writeRaw(`,"use_default_story_sound":`)
+	encoder.writeBool(entity.UseDefaultStorySound)
+	encoder.writeRaw(`,"story_sound_id":`)
+	encoder.writeJsonInt64(entity.StorySoundId)
+	encoder.writeRaw(`,"use_default_show_story_sender":`)
+	encoder.writeBool(entity.UseDefaultShowStorySender)
+	encoder.writeRaw(`,"show_story_sender":`)
+	encoder.writeBool(entity.ShowStorySender)
+	encoder.writeRaw(`,"use_default_disable_pinned_message_notifications":`)
+	encoder.writeBool(entity.UseDefaultDisablePinnedMessageNotifications)
+	encoder.writeRaw(`,"disable_pinned_message_notifications":`)
+	encoder.writeBool(entity.DisablePinnedMessageNotifications)
+	encoder.writeRaw(`,"use_default_disable_mention_notifications":`)
+	encoder.writeBool(entity.UseDefaultDisableMentionNotifications)
+	encoder.writeRaw(`,"disable_mention_notifications":`)
+	encoder.writeBool(entity.DisableMentionNotifications)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatNotificationSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "use_default_mute_for":
+			entity.UseDefaultMuteFor, err = decoder.readBool()
+
+		case "mute_for":
+			entity.MuteFor, err = decoder.readInt32()
+
+		case "use_default_sound":
+			entity.UseDefaultSound, err = decoder.readBool()
+
+		case "sound_id":
+			entity.SoundId, err = decoder.readJsonInt64()
+
+		case "use_default_show_preview":
+			entity.UseDefaultShowPreview, err = decoder.readBool()
+
+		case "show_preview":
+			entity.ShowPreview, err = decoder.readBool()
+
+		case "use_default_mute_stories":
+			entity.UseDefaultMuteStories, err = decoder.readBool()
+
+		case "mute_stories":
+			entity.MuteStories, err = decoder.readBool()
+
+		case "use_default_story_sound":
+			entity.UseDefaultStorySound, err = decoder.readBool()
+
+		case "story_sound_id":
+			entity.StorySoundId, err = decoder.readJsonInt64()
+
+		case "use_default_show_story_sender":
+			entity.UseDefaultShowStorySender, err = decoder.readBool()
+
+		case "show_story_sender":
+			entity.ShowStorySender, err = decoder.readBool()
+
+		case "use_default_disable_pinned_message_notifications":
+			entity.UseDefaultDisablePinnedMessageNotifications, err = decoder.readBool()
+
+		case "disable_pinned_message_notifications":
+			entity.DisablePinnedMessageNotifications, err = decoder.readBool()
+
+		case "use_default_disable_mention_notifications":
+			entity.UseDefaultDisableMentionNotifications, err = decoder.readBool()
+
+		case "disable_mention_notifications":
+			entity.DisableMentionNotifications, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatNotificationSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An ordinary chat with a user
+type ChatTypePrivate struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (*ChatTypePrivate) GetType() string {
+	return TypeChatType
+}
+
+func (*ChatTypePrivate) GetConstructor() string {
+	return ConstructorChatTypePrivate
+}
+
+func (*ChatTypePrivate) ChatTypeConstructor() string {
+	return ConstructorChatTypePrivate
+}
+
+func (entity *ChatTypePrivate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatTypePrivate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatTypePrivate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatTypePrivate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatTypePrivate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatTypePrivate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A basic group (a chat with 0-200 other users)
+type ChatTypeBasicGroup struct {
+	meta
+	// Basic group identifier
+	BasicGroupId int64 `json:"basic_group_id"`
+}
+
+func (*ChatTypeBasicGroup) GetType() string {
+	return TypeChatType
+}
+
+func (*ChatTypeBasicGroup) GetConstructor() string {
+	return ConstructorChatTypeBasicGroup
+}
+
+func (*ChatTypeBasicGroup) ChatTypeConstructor() string {
+	return ConstructorChatTypeBasicGroup
+}
+
+func (entity *ChatTypeBasicGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatTypeBasicGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatTypeBasicGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatTypeBasicGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"basic_group_id":`)
+	encoder.writeInt64(entity.BasicGroupId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatTypeBasicGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "basic_group_id":
+			entity.BasicGroupId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatTypeBasicGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A supergroup or channel (with unlimited members)
+type ChatTypeSupergroup struct {
+	meta
+	// Supergroup or channel identifier
+	SupergroupId int64 `json:"supergroup_id"`
+	// True, if the supergroup is a channel
+	IsChannel bool `json:"is_channel"`
+}
+
+func (*ChatTypeSupergroup) GetType() string {
+	return TypeChatType
+}
+
+func (*ChatTypeSupergroup) GetConstructor() string {
+	return ConstructorChatTypeSupergroup
+}
+
+func (*ChatTypeSupergroup) ChatTypeConstructor() string {
+	return ConstructorChatTypeSupergroup
+}
+
+func (entity *ChatTypeSupergroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatTypeSupergroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatTypeSupergroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatTypeSupergroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(entity.SupergroupId)
+	encoder.writeRaw(`,"is_channel":`)
+	encoder.writeBool(entity.IsChannel)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatTypeSupergroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "supergroup_id":
+			entity.SupergroupId, err = decoder.readInt64()
+
+		case "is_channel":
+			entity.IsChannel, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatTypeSupergroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A secret chat with a user
+type ChatTypeSecret struct {
+	meta
+	// Secret chat identifier
+	SecretChatId int32 `json:"secret_chat_id"`
+	// User identifier of the other user in the secret chat
+	UserId int64 `json:"user_id"`
+}
+
+func (*ChatTypeSecret) GetType() string {
+	return TypeChatType
+}
+
+func (*ChatTypeSecret) GetConstructor() string {
+	return ConstructorChatTypeSecret
+}
+
+func (*ChatTypeSecret) ChatTypeConstructor() string {
+	return ConstructorChatTypeSecret
+}
+
+func (entity *ChatTypeSecret) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatTypeSecret) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatTypeSecret) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatTypeSecret"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"secret_chat_id":`)
+	encoder.writeInt32(entity.SecretChatId)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatTypeSecret) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "secret_chat_id":
+			entity.SecretChatId, err = decoder.readInt32()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatTypeSecret)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an icon for a chat folder
+type ChatFolderIcon struct {
+	meta
+	// The chosen icon name for short folder representation; one of "All", "Unread", "Unmuted", "Bots", "Channels", "Groups", "Private", "Custom", "Setup", "Cat", "Crown", "Favorite", "Flower", "Game", "Home", "Love", "Mask", "Party", "Sport", "Study", "Trade", "Travel", "Work", "Airplane", "Book", "Light", "Like", "Money", "Note", "Palette"
+	Name string `json:"name"`
+}
+
+func (*ChatFolderIcon) GetType() string {
+	return TypeChatFolderIcon
+}
+
+func (*ChatFolderIcon) GetConstructor() string {
+	return ConstructorChatFolderIcon
+}
+
+func (entity *ChatFolderIcon) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatFolderIcon) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatFolderIcon) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatFolderIcon"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatFolderIcon) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatFolderIcon)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes name of a chat folder
+type ChatFolderName struct {
+	meta
+	// The text of the chat folder name; 1-12 characters without line feeds. May contain only CustomEmoji entities
+	Text *FormattedText `json:"text"`
+	// True, if custom emoji in the name must be animated
+	AnimateCustomEmoji bool `json:"animate_custom_emoji"`
+}
+
+func (*ChatFolderName) GetType() string {
+	return TypeChatFolderName
+}
+
+func (*ChatFolderName) GetConstructor() string {
+	return ConstructorChatFolderName
+}
+
+func (entity *ChatFolderName) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatFolderName) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatFolderName) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatFolderName"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"animate_custom_emoji":`)
+	encoder.writeBool(entity.AnimateCustomEmoji)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatFolderName) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "animate_custom_emoji":
+			entity.AnimateCustomEmoji, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatFolderName)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatFolderName) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateFormattedTextLength("text", entity.Text, 1, 12); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a folder for user chats
+type ChatFolder struct {
+	meta
+	// The name of the folder
+	Name *ChatFolderName `json:"name"`
+	// The chosen icon for the chat folder; may be null. If null, use getChatFolderDefaultIconName to get default icon name for the folder
+	Icon *ChatFolderIcon `json:"icon,omitempty"`
+	// The identifier of the chosen color for the chat folder icon; from -1 to 6. If -1, then color is disabled. Can't be changed if folder tags are disabled or the current user doesn't have Telegram Premium subscription
+	ColorId int32 `json:"color_id"`
+	// True, if at least one link has been created for the folder
+	IsShareable bool `json:"is_shareable"`
+	// The chat identifiers of pinned chats in the folder. There can be up to getOption("chat_folder_chosen_chat_count_max") pinned and always included non-secret chats and the same number of secret chats, but the limit can be increased with Telegram Premium
+	PinnedChatIds []int64 `json:"pinned_chat_ids"`
+	// The chat identifiers of always included chats in the folder. There can be up to getOption("chat_folder_chosen_chat_count_max") pinned and always included non-secret chats and the same number of secret chats, but the limit can be increased with Telegram Premium
+	IncludedChatIds []int64 `json:"included_chat_ids"`
+	// The chat identifiers of always excluded chats in the folder. There can be up to getOption("chat_folder_chosen_chat_count_max") always excluded non-secret chats and the same number of secret chats, but the limit can be increased with Telegram Premium
+	ExcludedChatIds []int64 `json:"excluded_chat_ids"`
+	// True, if muted chats need to be excluded
+	ExcludeMuted bool `json:"exclude_muted"`
+	// True, if read chats need to be excluded
+	ExcludeRead bool `json:"exclude_read"`
+	// True, if archived chats need to be excluded
+	ExcludeArchived bool `json:"exclude_archived"`
+	// True, if contacts need to be included
+	IncludeContacts bool `json:"include_contacts"`
+	// True, if non-contact users need to be included
+	IncludeNonContacts bool `json:"include_non_contacts"`
+	// True, if bots need to be included
+	IncludeBots bool `json:"include_bots"`
+	// True, if basic groups and supergroups need to be included
+	IncludeGroups bool `json:"include_groups"`
+	// True, if channels need to be included
+	IncludeChannels bool `json:"include_channels"`
+}
+
+func (*ChatFolder) GetType() string {
+	return TypeChatFolder
+}
+
+func (*ChatFolder) GetConstructor() string {
+	return ConstructorChatFolder
+}
+
+func (entity *ChatFolder) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatFolder) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatFolder) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatFolder"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	entity.Name.encodeJSON(encoder)
+	if entity.Icon != nil {
+		encoder.writeRaw(`,"icon":`)
+		entity.Icon.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"color_id":`)
+	encoder.writeInt32(entity.ColorId)
+	encoder.writeRaw(`,"is_shareable":`)
+	encoder.writeBool(entity.IsShareable)
+	encoder.writeRaw(`,"pinned_chat_ids":`)
+	writeList(encoder, entity.PinnedChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"included_chat_ids":`)
+	writeList(encoder, entity.IncludedChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"excluded_chat_ids":`)
+	writeList(encoder, entity.ExcludedChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"exclude_muted":`)
+	encoder.writeBool(entity.ExcludeMuted)
+	encoder.writeRaw(`,"exclude_read":`)
+	encoder.writeBool(entity.ExcludeRead)
+	encoder.writeRaw(`,"exclude_archived":`)
+	encoder.writeBool(entity.ExcludeArchived)
+	encoder.writeRaw(`,"include_contacts":`)
+	encoder.writeBool(entity.IncludeContacts)
+	encoder.writeRaw(`,"include_non_contacts":`)
+	encoder.writeBool(entity.IncludeNonContacts)
+	encoder.writeRaw(`,"include_bots":`)
+	encoder.writeBool(entity.IncludeBots)
+	encoder.writeRaw(`,"include_groups":`)
+	encoder.writeBool(entity.IncludeGroups)
+	encoder.writeRaw(`,"include_channels":`)
+	encoder.writeBool(entity.IncludeChannels)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatFolder) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decodeEntity[ChatFolderName](decoder)
+
+		case "icon":
+			entity.Icon, err = decodeEntity[ChatFolderIcon](decoder)
+
+		case "color_id":
+			entity.ColorId, err = decoder.readInt32()
+
+		case "is_shareable":
+			entity.IsShareable, err = decoder.readBool()
+
+		case "pinned_chat_ids":
+			entity.PinnedChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "included_chat_ids":
+			entity.IncludedChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "excluded_chat_ids":
+			entity.ExcludedChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "exclude_muted":
+			entity.ExcludeMuted, err = decoder.readBool()
+
+		case "exclude_read":
+			entity.ExcludeRead, err = decoder.readBool()
+
+		case "exclude_archived":
+			entity.ExcludeArchived, err = decoder.readBool()
+
+		case "include_contacts":
+			entity.IncludeContacts, err = decoder.readBool()
+
+		case "include_non_contacts":
+			entity.IncludeNonContacts, err = decoder.readBool()
+
+		case "include_bots":
+			entity.IncludeBots, err = decoder.readBool()
+
+		case "include_groups":
+			entity.IncludeGroups, err = decoder.readBool()
+
+		case "include_channels":
+			entity.IncludeChannels, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatFolder)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatFolder) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("name", entity.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains basic information about a chat folder
+type ChatFolderInfo struct {
+	meta
+	// Unique chat folder identifier
+	Id int32 `json:"id"`
+	// The name of the folder
+	Name *ChatFolderName `json:"name"`
+	// The chosen or default icon for the chat folder
+	Icon *ChatFolderIcon `json:"icon"`
+	// The identifier of the chosen color for the chat folder icon; from -1 to 6. If -1, then color is disabled
+	ColorId int32 `json:"color_id"`
+	// True, if at least one link has been created for the folder
+	IsShareable bool `json:"is_shareable"`
+	// True, if the chat folder has invite links created by the current user
+	HasMyInviteLinks bool `json:"has_my_invite_links"`
+}
+
+func (*ChatFolderInfo) GetType() string {
+	return TypeChatFolderInfo
+}
+
+func (*ChatFolderInfo) GetConstructor() string {
+	return ConstructorChatFolderInfo
+}
+
+func (entity *ChatFolderInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatFolderInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatFolderInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatFolderInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"name":`)
+	entity.Name.encodeJSON(encoder)
+	encoder.writeRaw(`,"icon":`)
+	entity.Icon.encodeJSON(encoder)
+	encoder.writeRaw(`,"color_id":`)
+	encoder.writeInt32(entity.ColorId)
+	encoder.writeRaw(`,"is_shareable":`)
+	encoder.writeBool(entity.IsShareable)
+	encoder.writeRaw(`,"has_my_invite_links":`)
+	encoder.writeBool(entity.HasMyInviteLinks)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatFolderInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "name":
+			entity.Name, err = decodeEntity[ChatFolderName](decoder)
+
+		case "icon":
+			entity.Icon, err = decodeEntity[ChatFolderIcon](decoder)
+
+		case "color_id":
+			entity.ColorId, err = decoder.readInt32()
+
+		case "is_shareable":
+			entity.IsShareable, err = decoder.readBool()
+
+		case "has_my_invite_links":
+			entity.HasMyInviteLinks, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatFolderInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatFolderInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("name", entity.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a chat folder invite link
+type ChatFolderInviteLink struct {
+	meta
+	// The chat folder invite link
+	InviteLink string `json:"invite_link"`
+	// Name of the link
+	Name string `json:"name"`
+	// Identifiers of chats, included in the link
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (*ChatFolderInviteLink) GetType() string {
+	return TypeChatFolderInviteLink
+}
+
+func (*ChatFolderInviteLink) GetConstructor() string {
+	return ConstructorChatFolderInviteLink
+}
+
+func (entity *ChatFolderInviteLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatFolderInviteLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatFolderInviteLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatFolderInviteLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(entity.InviteLink)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, entity.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatFolderInviteLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "chat_ids":
+			entity.ChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatFolderInviteLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of chat folder invite links
+type ChatFolderInviteLinks struct {
+	meta
+	// List of the invite links
+	InviteLinks []*ChatFolderInviteLink `json:"invite_links"`
+}
+
+func (*ChatFolderInviteLinks) GetType() string {
+	return TypeChatFolderInviteLinks
+}
+
+func (*ChatFolderInviteLinks) GetConstructor() string {
+	return ConstructorChatFolderInviteLinks
+}
+
+func (entity *ChatFolderInviteLinks) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatFolderInviteLinks) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatFolderInviteLinks) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatFolderInviteLinks"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_links":`)
+	writeList(encoder, entity.InviteLinks, writeTypeValue[*ChatFolderInviteLink])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatFolderInviteLinks) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_links":
+			entity.InviteLinks, err = readList(decoder, decodeEntity[ChatFolderInviteLink])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatFolderInviteLinks)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an invite link to a chat folder
+type ChatFolderInviteLinkInfo struct {
+	meta
+	// Basic information about the chat folder; chat folder identifier will be 0 if the user didn't have the chat folder yet
+	ChatFolderInfo *ChatFolderInfo `json:"chat_folder_info"`
+	// Identifiers of the chats from the link, which aren't added to the folder yet
+	MissingChatIds []int64 `json:"missing_chat_ids"`
+	// Identifiers of the chats from the link, which are added to the folder already
+	AddedChatIds []int64 `json:"added_chat_ids"`
+}
+
+func (*ChatFolderInviteLinkInfo) GetType() string {
+	return TypeChatFolderInviteLinkInfo
+}
+
+func (*ChatFolderInviteLinkInfo) GetConstructor() string {
+	return ConstructorChatFolderInviteLinkInfo
+}
+
+func (entity *ChatFolderInviteLinkInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatFolderInviteLinkInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatFolderInviteLinkInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatFolderInviteLinkInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_folder_info":`)
+	entity.ChatFolderInfo.encodeJSON(encoder)
+	encoder.writeRaw(`,"missing_chat_ids":`)
+	writeList(encoder, entity.MissingChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"added_chat_ids":`)
+	writeList(encoder, entity.AddedChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatFolderInviteLinkInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_folder_info":
+			entity.ChatFolderInfo, err = decodeEntity[ChatFolderInfo](decoder)
+
+		case "missing_chat_ids":
+			entity.MissingChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "added_chat_ids":
+			entity.AddedChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatFolderInviteLinkInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatFolderInviteLinkInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("chat_folder_info", entity.ChatFolderInfo); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Describes a recommended chat folder
+type RecommendedChatFolder struct {
+	meta
+	// The chat folder
+	Folder *ChatFolder `json:"folder"`
+	// Chat folder description
+	Description string `json:"description"`
+}
+
+func (*RecommendedChatFolder) GetType() string {
+	return TypeRecommendedChatFolder
+}
+
+func (*RecommendedChatFolder) GetConstructor() string {
+	return ConstructorRecommendedChatFolder
+}
+
+func (entity *RecommendedChatFolder) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RecommendedChatFolder) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RecommendedChatFolder) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"recommendedChatFolder"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"folder":`)
+	entity.Folder.encodeJSON(encoder)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw("}")
+}
+
+func (entity *RecommendedChatFolder) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "folder":
+			entity.Folder, err = decodeEntity[ChatFolder](decoder)
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRecommendedChatFolder)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *RecommendedChatFolder) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("folder", entity.Folder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of recommended chat folders
+type RecommendedChatFolders struct {
+	meta
+	// List of recommended chat folders
+	ChatFolders []*RecommendedChatFolder `json:"chat_folders"`
+}
+
+func (*RecommendedChatFolders) GetType() string {
+	return TypeRecommendedChatFolders
+}
+
+func (*RecommendedChatFolders) GetConstructor() string {
+	return ConstructorRecommendedChatFolders
+}
+
+func (entity *RecommendedChatFolders) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RecommendedChatFolders) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RecommendedChatFolders) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"recommendedChatFolders"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_folders":`)
+	writeList(encoder, entity.ChatFolders, writeTypeValue[*RecommendedChatFolder])
+	encoder.writeRaw("}")
+}
+
+func (entity *RecommendedChatFolders) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_folders":
+			entity.ChatFolders, err = readList(decoder, decodeEntity[RecommendedChatFolder])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRecommendedChatFolders)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *RecommendedChatFolders) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.ChatFolders {
+		if err := validateNested(indexField("chat_folders", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains settings for automatic moving of chats to and from the Archive chat lists
+type ArchiveChatListSettings struct {
+	meta
+	// True, if new chats from non-contacts will be automatically archived and muted. Can be set to true only if the option "can_archive_and_mute_new_chats_from_unknown_users" is true
+	ArchiveAndMuteNewChatsFromUnknownUsers bool `json:"archive_and_mute_new_chats_from_unknown_users"`
+	// True, if unmuted chats will be kept in the Archive chat list when they get a new message
+	KeepUnmutedChatsArchived bool `json:"keep_unmuted_chats_archived"`
+	// True, if unmuted chats, that are always included or pinned in a folder, will be kept in the Archive chat list when they get a new message. Ignored if keep_unmuted_chats_archived == true
+	KeepChatsFromFoldersArchived bool `json:"keep_chats_from_folders_archived"`
+}
+
+func (*ArchiveChatListSettings) GetType() string {
+	return TypeArchiveChatListSettings
+}
+
+func (*ArchiveChatListSettings) GetConstructor() string {
+	return ConstructorArchiveChatListSettings
+}
+
+func (entity *ArchiveChatListSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ArchiveChatListSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ArchiveChatListSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"archiveChatListSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"archive_and_mute_new_chats_from_unknown_users":`)
+	encoder.writeBool(entity.ArchiveAndMuteNewChatsFromUnknownUsers)
+	encoder.writeRaw(`,"keep_unmuted_chats_archived":`)
+	encoder.writeBool(entity.KeepUnmutedChatsArchived)
+	encoder.writeRaw(`,"keep_chats_from_folders_archived":`)
+	encoder.writeBool(entity.KeepChatsFromFoldersArchived)
+	encoder.writeRaw("}")
+}
+
+func (entity *ArchiveChatListSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "archive_and_mute_new_chats_from_unknown_users":
+			entity.ArchiveAndMuteNewChatsFromUnknownUsers, err = decoder.readBool()
+
+		case "keep_unmuted_chats_archived":
+			entity.KeepUnmutedChatsArchived, err = decoder.readBool()
+
+		case "keep_chats_from_folders_archived":
+			entity.KeepChatsFromFoldersArchived, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorArchiveChatListSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A main list of chats
+type ChatListMain struct {
+	meta
+}
+
+func (*ChatListMain) GetType() string {
+	return TypeChatList
+}
+
+func (*ChatListMain) GetConstructor() string {
+	return ConstructorChatListMain
+}
+
+func (*ChatListMain) ChatListConstructor() string {
+	return ConstructorChatListMain
+}
+
+func (entity *ChatListMain) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatListMain) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatListMain) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatListMain"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatListMain) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatListMain)
+	})
+}
+
+// A list of chats usually located at the top of the main chat list. Unmuted chats are automatically moved from the Archive to the Main chat list when a new message arrives
+type ChatListArchive struct {
+	meta
+}
+
+func (*ChatListArchive) GetType() string {
+	return TypeChatList
+}
+
+func (*ChatListArchive) GetConstructor() string {
+	return ConstructorChatListArchive
+}
+
+func (*ChatListArchive) ChatListConstructor() string {
+	return ConstructorChatListArchive
+}
+
+func (entity *ChatListArchive) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatListArchive) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatListArchive) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatListArchive"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatListArchive) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatListArchive)
+	})
+}
+
+// A list of chats added to a chat folder
+type ChatListFolder struct {
+	meta
+	// Chat folder identifier
+	ChatFolderId int32 `json:"chat_folder_id"`
+}
+
+func (*ChatListFolder) GetType() string {
+	return TypeChatList
+}
+
+func (*ChatListFolder) GetConstructor() string {
+	return ConstructorChatListFolder
+}
+
+func (*ChatListFolder) ChatListConstructor() string {
+	return ConstructorChatListFolder
+}
+
+func (entity *ChatListFolder) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatListFolder) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatListFolder) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatListFolder"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_folder_id":`)
+	encoder.writeInt32(entity.ChatFolderId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatListFolder) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_folder_id":
+			entity.ChatFolderId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatListFolder)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of chat lists
+type ChatLists struct {
+	meta
+	// List of chat lists
+	ChatLists []ChatList `json:"chat_lists"`
+}
+
+func (*ChatLists) GetType() string {
+	return TypeChatLists
+}
+
+func (*ChatLists) GetConstructor() string {
+	return ConstructorChatLists
+}
+
+func (entity *ChatLists) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatLists) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatLists) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatLists"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_lists":`)
+	writeList(encoder, entity.ChatLists, writeTypeValue[ChatList])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatLists) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_lists":
+			entity.ChatLists, err = readList(decoder, decodeChatList)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatLists)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat is sponsored by the user's MTProxy server
+type ChatSourceMtprotoProxy struct {
+	meta
+}
+
+func (*ChatSourceMtprotoProxy) GetType() string {
+	return TypeChatSource
+}
+
+func (*ChatSourceMtprotoProxy) GetConstructor() string {
+	return ConstructorChatSourceMtprotoProxy
+}
+
+func (*ChatSourceMtprotoProxy) ChatSourceConstructor() string {
+	return ConstructorChatSourceMtprotoProxy
+}
+
+func (entity *ChatSourceMtprotoProxy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatSourceMtprotoProxy) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatSourceMtprotoProxy) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatSourceMtprotoProxy"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatSourceMtprotoProxy) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatSourceMtprotoProxy)
+	})
+}
+
+// The chat contains a public service announcement
+type ChatSourcePublicServiceAnnouncement struct {
+	meta
+	// The type of the announcement
+	Type string `json:"type"`
+	// The text of the announcement
+	Text string `json:"text"`
+}
+
+func (*ChatSourcePublicServiceAnnouncement) GetType() string {
+	return TypeChatSource
+}
+
+func (*ChatSourcePublicServiceAnnouncement) GetConstructor() string {
+	return ConstructorChatSourcePublicServiceAnnouncement
+}
+
+func (*ChatSourcePublicServiceAnnouncement) ChatSourceConstructor() string {
+	return ConstructorChatSourcePublicServiceAnnouncement
+}
+
+func (entity *ChatSourcePublicServiceAnnouncement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatSourcePublicServiceAnnouncement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatSourcePublicServiceAnnouncement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatSourcePublicServiceAnnouncement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeString(entity.Type)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatSourcePublicServiceAnnouncement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decoder.readString()
+
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatSourcePublicServiceAnnouncement)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a position of a chat in a chat list
+type ChatPosition struct {
+	meta
+	// The chat list
+	List ChatList `json:"list"`
+	// A parameter used to determine order of the chat in the chat list. Chats must be sorted by the pair (order, chat.id) in descending order
+	Order JsonInt64 `json:"order"`
+	// True, if the chat is pinned in the chat list
+	IsPinned bool `json:"is_pinned"`
+	// Source of the chat in the chat list; may be null
+	Source ChatSource `json:"source,omitempty"`
+}
+
+func (*ChatPosition) GetType() string {
+	return TypeChatPosition
+}
+
+func (*ChatPosition) GetConstructor() string {
+	return ConstructorChatPosition
+}
+
+func (entity *ChatPosition) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPosition) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPosition"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"list":`)
+	encoder.writeType(entity.List)
+	encoder.writeRaw(`,"order":`)
+	encoder.writeJsonInt64(entity.Order)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	if entity.Source != nil {
+		encoder.writeRaw(`,"source":`)
+		encoder.writeType(entity.Source)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPosition) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "list":
+			entity.List, err = decodeChatList(decoder)
+
+		case "order":
+			entity.Order, err = decoder.readJsonInt64()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		case "source":
+			entity.Source, err = decodeChatSource(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPosition)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a video chat
+type VideoChat struct {
+	meta
+	// Group call identifier of an active video chat; 0 if none. Full information about the video chat can be received through the method getGroupCall
+	GroupCallId int32 `json:"group_call_id"`
+	// True, if the video chat has participants
+	HasParticipants bool `json:"has_participants"`
+	// Default group call participant identifier to join the video chat; may be null
+	DefaultParticipantId MessageSender `json:"default_participant_id,omitempty"`
+}
+
+func (*VideoChat) GetType() string {
+	return TypeVideoChat
+}
+
+func (*VideoChat) GetConstructor() string {
+	return ConstructorVideoChat
+}
+
+func (entity *VideoChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *VideoChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *VideoChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"videoChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw(`,"has_participants":`)
+	encoder.writeBool(entity.HasParticipants)
+	if entity.DefaultParticipantId != nil {
+		encoder.writeRaw(`,"default_participant_id":`)
+		encoder.writeType(entity.DefaultParticipantId)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *VideoChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		case "has_participants":
+			entity.HasParticipants, err = decoder.readBool()
+
+		case "default_participant_id":
+			entity.DefaultParticipantId, err = decodeMessageSender(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVideoChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat. (Can be a private chat, basic group, supergroup, or secret chat)
+type Chat struct {
+	meta
+	// Chat unique identifier
+	Id int64 `json:"id"`
+	// Type of the chat
+	Type ChatType `json:"type"`
+	// Chat title
+	Title string `json:"title"`
+	// Chat photo; may be null
+	Photo *ChatPhotoInfo `json:"photo,omitempty"`
+	// Identifier of the accent color for message sender name, and backgrounds of chat photo, reply header, and link preview
+	AccentColorId int32 `json:"accent_color_id"`
+	// Identifier of a custom emoji to be shown on the reply header and link preview background for messages sent by the chat; 0 if none
+	BackgroundCustomEmojiId JsonInt64 `json:"background_custom_emoji_id"`
+	// Identifier of the profile accent color for the chat's profile; -1 if none
+	ProfileAccentColorId int32 `json:"profile_accent_color_id"`
+	// Identifier of a custom emoji to be shown on the background of the chat's profile; 0 if none
+	ProfileBackgroundCustomEmojiId JsonInt64 `json:"profile_background_custom_emoji_id"`
+	// Actions that non-administrator chat members are allowed to take in the chat
+	Permissions *ChatPermissions `json:"permissions"`
+	// Last message in the chat; may be null if none or unknown
+	LastMessage *Message `json:"last_message,omitempty"`
+	// Positions of the chat in chat lists
+	Positions []*ChatPosition `json:"positions"`
+	// Chat lists to which the chat belongs. A chat can have a non-zero position in a chat list even it doesn't belong to the chat list and have no position in a chat list even it belongs to the chat list
+	ChatLists []ChatList `json:"chat_lists"`
+	// Identifier of a user or chat that is selected to send messages in the chat; may be null if the user can't change message sender
+	MessageSenderId MessageSender `json:"message_sender_id,omitempty"`
+	// Block list to which the chat is added; may be null if none
+	BlockList BlockList `json:"block_list,omitempty"`
+	// True, if chat content can't be saved locally, forwarded, or copied
+	HasProtectedContent bool `json:"has_protected_content"`
+	// True, if translation of all messages in the chat must be suggested to the user
+	IsTranslatable bool `json:"is_translatable"`
+	// True, if the chat is marked as unread
+	IsMarkedAsUnread bool `json:"is_marked_as_unread"`
+	// True, if the chat is a forum supergroup that must be shown in the "View as topics" mode, or Saved Messages chat that must be shown in the "View as chats"
+	ViewAsTopics bool `json:"view_as_topics"`
+	// True, if the chat has scheduled messages
+	HasScheduledMessages bool `json:"has_scheduled_messages"`
+	// True, if the chat messages can be deleted only for the current user while other users will continue to see the messages
+	CanBeDeletedOnlyForSelf bool `json:"can_be_deleted_only_for_self"`
+	// True, if the chat messages can be deleted for all users
+	CanBeDeletedForAllUsers bool `json:"can_be_deleted_for_all_users"`
+	// True, if the chat can be reported to Telegram moderators through reportChat or reportChatPhoto
+	CanBeReported bool `json:"can_be_reported"`
+	// Default value of the disable_notification parameter, used when a message is sent to the chat
+	DefaultDisableNotification bool `json:"default_disable_notification"`
+	// Number of unread messages in the chat
+	UnreadCount int32 `json:"unread_count"`
+	// Identifier of the last read incoming message
+	LastReadInboxMessageId int64 `json:"last_read_inbox_message_id"`
+	// Identifier of the last read outgoing message
+	LastReadOutboxMessageId int64 `json:"last_read_outbox_message_id"`
+	// Number of unread messages with a mention/reply in the chat
+	UnreadMentionCount int32 `json:"unread_mention_count"`
+	// Number of messages with unread reactions in the chat
+	UnreadReactionCount int32 `json:"unread_reaction_count"`
+	// Notification settings for the chat
+	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
+	// Types of reaction, available in the chat
+	AvailableReactions ChatAvailableReactions `json:"available_reactions"`
+	// Current message auto-delete or self-destruct timer setting for the chat, in seconds; 0 if disabled. Self-destruct timer in secret chats starts after the message or its content is viewed. Auto-delete timer in other chats starts from the send date
+	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
+	// Emoji status to be shown along with chat title; may be null
+	EmojiStatus *EmojiStatus `json:"emoji_status,omitempty"`
+	// Background set for the chat; may be null if none
+	Background *ChatBackground `json:"background,omitempty"`
+	// If non-empty, name of a theme, set for the chat
+	ThemeName string `json:"theme_name"`
+	// Information about actions which must be possible to do through the chat action bar; may be null if none
+	ActionBar ChatActionBar `json:"action_bar,omitempty"`
+	// Information about bar for managing a business bot in the chat; may be null if none
+	BusinessBotManageBar *BusinessBotManageBar `json:"business_bot_manage_bar,omitempty"`
+	// Information about video chat of the chat
+	VideoChat *VideoChat `json:"video_chat"`
+	// Information about pending join requests; may be null if none
+	PendingJoinRequests *ChatJoinRequestsInfo `json:"pending_join_requests,omitempty"`
+	// Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
+	ReplyMarkupMessageId int64 `json:"reply_markup_message_id"`
+	// A draft of a message in the chat; may be null if none
+	DraftMessage *DraftMessage `json:"draft_message,omitempty"`
+	// Application-specific data associated with the chat. (For example, the chat scroll position or local chat notification settings can be stored here.) Persistent if the message database is used
+	ClientData string `json:"client_data"`
+}
+
+func (*Chat) GetType() string {
+	return TypeChat
+}
+
+func (*Chat) GetConstructor() string {
+	return ConstructorChat
+}
+
+func (entity *Chat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Chat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Chat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(entity.AccentColorId)
+	encoder.writeRaw(`,"background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.BackgroundCustomEmojiId)
+	encoder.writeRaw(`,"profile_accent_color_id":`)
+	encoder.writeInt32(entity.ProfileAccentColorId)
+	encoder.writeRaw(`,"profile_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.ProfileBackgroundCustomEmojiId)
+	encoder.writeRaw(`,"permissions":`)
+	entity.Permissions.encodeJSON(encoder)
+	if entity.LastMessage != nil {
+		encoder.writeRaw(`,"last_message":`)
+		entity.LastMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"positions":`)
+	writeList(encoder, entity.Positions, writeTypeValue[*ChatPosition])
+	encoder.writeRaw(`,"chat_lists":`)
+	writeList(encoder, entity.ChatLists, writeTypeValue[ChatList])
+	if entity.MessageSenderId != nil {
+		encoder.writeRaw(`,"message_sender_id":`)
+		encoder.writeType(entity.MessageSenderId)
+	}
+	if entity.BlockList != nil {
+		encoder.writeRaw(`,"block_list":`)
+		encoder.writeType(entity.BlockList)
+	}
+	encoder.writeRaw(`,"has_protected_content":`)
+	encoder.writeBool(entity.HasProtectedContent)
+	encoder.writeRaw(`,"is_translatable":`)
+	encoder.writeBool(entity.IsTranslatable)
+	encoder.writeRaw(`,"is_marked_as_unread":`)
+	encoder.writeBool(entity.IsMarkedAsUnread)
+	encoder.writeRaw(`,"view_as_topics":`)
+	encoder.writeBool(entity.ViewAsTopics)
+	encoder.writeRaw(`,"has_scheduled_messages":`)
+	encoder.writeBool(entity.HasScheduledMessages)
+	encoder.writeRaw(`,"can_be_deleted_only_for_self":`)
+	encoder.writeBool(entity.CanBeDeletedOnlyForSelf)
+	encoder.writeRaw(`,"can_be_deleted_for_all_users":`)
+	encoder.writeBool(entity.CanBeDeletedForAllUsers)
+	encoder.writeRaw(`,"can_be_reported":`)
+	encoder.writeBool(entity.CanBeReported)
+	encoder.writeRaw(`,"default_disable_notification":`)
+	encoder.writeBool(entity.DefaultDisableNotification)
+	encoder.writeRaw(`,"unread_count":`)
+	encoder.writeInt32(entity.UnreadCount)
+	encoder.writeRaw(`,"last_read_inbox_message_id":`)
+	encoder.writeInt64(entity.LastReadInboxMessageId)
+	encoder.writeRaw(`,"last_read_outbox_message_id":`)
+	encoder.writeInt64(entity.LastReadOutboxMessageId)
+	encoder.writeRaw(`,"unread_mention_count":`)
+	encoder.writeInt32(entity.UnreadMentionCount)
+	encoder.writeRaw(`,"unread_reaction_count":`)
+	encoder.writeInt32(entity.UnreadReactionCount)
+	encoder.writeRaw(`,"notification_settings":`)
+	entity.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw(`,"available_reactions":`)
+	encoder.writeType(entity.AvailableReactions)
+	encoder.writeRaw(`,"message_auto_delete_time":`)
+	encoder.writeInt32(entity.MessageAutoDeleteTime)
+	if entity.EmojiStatus != nil {
+		encoder.writeRaw(`,"emoji_status":`)
+		entity.EmojiStatus.encodeJSON(encoder)
+	}
+	if entity.Background != nil {
+		encoder.writeRaw(`,"background":`)
+		entity.Background.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"theme_name":`)
+	encoder.writeString(entity.ThemeName)
+	if entity.ActionBar != nil {
+		encoder.writeRaw(`,"action_bar":`)
+		encoder.writeType(entity.ActionBar)
+	}
+	if entity.BusinessBotManageBar != nil {
+		encoder.writeRaw(`,"business_bot_manage_bar":`)
+		entity.BusinessBotManageBar.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"video_chat":`)
+	entity.VideoChat.encodeJSON(encoder)
+	if entity.PendingJoinRequests != nil {
+		encoder.writeRaw(`,"pending_join_requests":`)
+		entity.PendingJoinRequests.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"reply_markup_message_id":`)
+	encoder.writeInt64(entity.ReplyMarkupMessageId)
+	if entity.DraftMessage != nil {
+		encoder.writeRaw(`,"draft_message":`)
+		entity.DraftMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"client_data":`)
+	encoder.writeString(entity.ClientData)
+	encoder.writeRaw("}")
+}
+
+func (entity *Chat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "type":
+			entity.Type, err = decodeChatType(decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhotoInfo](decoder)
+
+		case "accent_color_id":
+			entity.AccentColorId, err = decoder.readInt32()
+
+		case "background_custom_emoji_id":
+			entity.BackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "profile_accent_color_id":
+			entity.ProfileAccentColorId, err = decoder.readInt32()
+
+		case "profile_background_custom_emoji_id":
+			entity.ProfileBackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "permissions":
+			entity.Permissions, err = decodeEntity[ChatPermissions](decoder)
+
+		case "last_message":
+			entity.LastMessage, err = decodeEntity[Message](decoder)
+
+		case "positions":
+			entity.Positions, err = readList(decoder, decodeEntity[ChatPosition])
+
+		case "chat_lists":
+			entity.ChatLists, err = readList(decoder, decodeChatList)
+
+		case "message_sender_id":
+			entity.MessageSenderId, err = decodeMessageSender(decoder)
+
+		case "block_list":
+			entity.BlockList, err = decodeBlockList(decoder)
+
+		case "has_protected_content":
+			entity.HasProtectedContent, err = decoder.readBool()
+
+		case "is_translatable":
+			entity.IsTranslatable, err = decoder.readBool()
+
+		case "is_marked_as_unread":
+			entity.IsMarkedAsUnread, err = decoder.readBool()
+
+		case "view_as_topics":
+			entity.ViewAsTopics, err = decoder.readBool()
+
+		case "has_scheduled_messages":
+			entity.HasScheduledMessages, err = decoder.readBool()
+
+		case "can_be_deleted_only_for_self":
+			entity.CanBeDeletedOnlyForSelf, err = decoder.readBool()
+
+		case "can_be_deleted_for_all_users":
+			entity.CanBeDeletedForAllUsers, err = decoder.readBool()
+
+		case "can_be_reported":
+			entity.CanBeReported, err = decoder.readBool()
+
+		case "default_disable_notification":
+			entity.DefaultDisableNotification, err = decoder.readBool()
+
+		case "unread_count":
+			entity.UnreadCount, err = decoder.readInt32()
+
+		case "last_read_inbox_message_id":
+			entity.LastReadInboxMessageId, err = decoder.readInt64()
+
+		case "last_read_outbox_message_id":
+			entity.LastReadOutboxMessageId, err = decoder.readInt64()
+
+		case "unread_mention_count":
+			entity.UnreadMentionCount, err = decoder.readInt32()
+
+		case "unread_reaction_count":
+			entity.UnreadReactionCount, err = decoder.readInt32()
+
+		case "notification_settings":
+			entity.NotificationSettings, err = decodeEntity[ChatNotificationSettings](decoder)
+
+		case "available_reactions":
+			entity.AvailableReactions, err = decodeChatAvailableReactions(decoder)
+
+		case "message_auto_delete_time":
+			entity.MessageAutoDeleteTime, err = decoder.readInt32()
+
+		case "emoji_status":
+			entity.EmojiStatus, err = decodeEntity[EmojiStatus](decoder)
+
+		case "background":
+			entity.Background, err = decodeEntity[ChatBackground](decoder)
+
+		case "theme_name":
+			entity.ThemeName, err = decoder.readString()
+
+		case "action_bar":
+			entity.ActionBar, err = decodeChatActionBar(decoder)
+
+		case "business_bot_manage_bar":
+			entity.BusinessBotManageBar, err = decodeEntity[BusinessBotManageBar](decoder)
+
+		case "video_chat":
+			entity.VideoChat, err = decodeEntity[VideoChat](decoder)
+
+		case "pending_join_requests":
+			entity.PendingJoinRequests, err = decodeEntity[ChatJoinRequestsInfo](decoder)
+
+		case "reply_markup_message_id":
+			entity.ReplyMarkupMessageId, err = decoder.readInt64()
+
+		case "draft_message":
+			entity.DraftMessage, err = decodeEntity[DraftMessage](decoder)
+
+		case "client_data":
+			entity.ClientData, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Chat) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("last_message", entity.LastMessage); err != nil {
+		return err
+	}
+
+	if err := validateNested("draft_message", entity.DraftMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a list of chats
+type Chats struct {
+	meta
+	// Approximate total number of chats found
+	TotalCount int32 `json:"total_count"`
+	// List of chat identifiers
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (*Chats) GetType() string {
+	return TypeChats
+}
+
+func (*Chats) GetConstructor() string {
+	return ConstructorChats
+}
+
+func (entity *Chats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Chats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Chats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, entity.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *Chats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "chat_ids":
+			entity.ChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChats)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a newly created basic group chat
+type CreatedBasicGroupChat struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Information about failed to add members
+	FailedToAddMembers *FailedToAddMembers `json:"failed_to_add_members"`
+}
+
+func (*CreatedBasicGroupChat) GetType() string {
+	return TypeCreatedBasicGroupChat
+}
+
+func (*CreatedBasicGroupChat) GetConstructor() string {
+	return ConstructorCreatedBasicGroupChat
+}
+
+func (entity *CreatedBasicGroupChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CreatedBasicGroupChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CreatedBasicGroupChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"createdBasicGroupChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"failed_to_add_members":`)
+	entity.FailedToAddMembers.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *CreatedBasicGroupChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "failed_to_add_members":
+			entity.FailedToAddMembers, err = decodeEntity[FailedToAddMembers](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCreatedBasicGroupChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat is public, because it has an active username
+type PublicChatTypeHasUsername struct {
+	meta
+}
+
+func (*PublicChatTypeHasUsername) GetType() string {
+	return TypePublicChatType
+}
+
+func (*PublicChatTypeHasUsername) GetConstructor() string {
+	return ConstructorPublicChatTypeHasUsername
+}
+
+func (*PublicChatTypeHasUsername) PublicChatTypeConstructor() string {
+	return ConstructorPublicChatTypeHasUsername
+}
+
+func (entity *PublicChatTypeHasUsername) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PublicChatTypeHasUsername) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PublicChatTypeHasUsername) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"publicChatTypeHasUsername"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PublicChatTypeHasUsername) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPublicChatTypeHasUsername)
+	})
+}
+
+// The chat is public, because it is a location-based supergroup
+type PublicChatTypeIsLocationBased struct {
+	meta
+}
+
+func (*PublicChatTypeIsLocationBased) GetType() string {
+	return TypePublicChatType
+}
+
+func (*PublicChatTypeIsLocationBased) GetConstructor() string {
+	return ConstructorPublicChatTypeIsLocationBased
+}
+
+func (*PublicChatTypeIsLocationBased) PublicChatTypeConstructor() string {
+	return ConstructorPublicChatTypeIsLocationBased
+}
+
+func (entity *PublicChatTypeIsLocationBased) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PublicChatTypeIsLocationBased) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PublicChatTypeIsLocationBased) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"publicChatTypeIsLocationBased"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PublicChatTypeIsLocationBased) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPublicChatTypeIsLocationBased)
+	})
+}
+
+// The chat can be reported as spam using the method reportChat with an empty option_id and message_ids. If the chat is a private chat with a user with an emoji status, then a notice about emoji status usage must be shown
+type ChatActionBarReportSpam struct {
+	meta
+	// If true, the chat was automatically archived and can be moved back to the main chat list using addChatToList simultaneously with setting chat notification settings to default using setChatNotificationSettings
+	CanUnarchive bool `json:"can_unarchive"`
+}
+
+func (*ChatActionBarReportSpam) GetType() string {
+	return TypeChatActionBar
+}
+
+func (*ChatActionBarReportSpam) GetConstructor() string {
+	return ConstructorChatActionBarReportSpam
+}
+
+func (*ChatActionBarReportSpam) ChatActionBarConstructor() string {
+	return ConstructorChatActionBarReportSpam
+}
+
+func (entity *ChatActionBarReportSpam) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionBarReportSpam) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionBarReportSpam) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionBarReportSpam"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_unarchive":`)
+	encoder.writeBool(entity.CanUnarchive)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionBarReportSpam) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_unarchive":
+			entity.CanUnarchive, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionBarReportSpam)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat is a recently created group chat to which new members can be invited
+type ChatActionBarInviteMembers struct {
+	meta
+}
+
+func (*ChatActionBarInviteMembers) GetType() string {
+	return TypeChatActionBar
+}
+
+func (*ChatActionBarInviteMembers) GetConstructor() string {
+	return ConstructorChatActionBarInviteMembers
+}
+
+func (*ChatActionBarInviteMembers) ChatActionBarConstructor() string {
+	return ConstructorChatActionBarInviteMembers
+}
+
+func (entity *ChatActionBarInviteMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionBarInviteMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionBarInviteMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionBarInviteMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionBarInviteMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionBarInviteMembers)
+	})
+}
+
+// The chat is a private or secret chat, which can be reported using the method reportChat, or the other user can be blocked using the method setMessageSenderBlockList, or the other user can be added to the contact list using the method addContact. If the chat is a private chat with a user with an emoji status, then a notice about emoji status usage must be shown
+type ChatActionBarReportAddBlock struct {
+	meta
+	// If true, the chat was automatically archived and can be moved back to the main chat list using addChatToList simultaneously with setting chat notification settings to default using setChatNotificationSettings
+	CanUnarchive bool `json:"can_unarchive"`
+	// Basic information about the other user in the chat; may be null if unknown
+	AccountInfo *AccountInfo `json:"account_info,omitempty"`
+}
+
+func (*ChatActionBarReportAddBlock) GetType() string {
+	return TypeChatActionBar
+}
+
+func (*ChatActionBarReportAddBlock) GetConstructor() string {
+	return ConstructorChatActionBarReportAddBlock
+}
+
+func (*ChatActionBarReportAddBlock) ChatActionBarConstructor() string {
+	return ConstructorChatActionBarReportAddBlock
+}
+
+func (entity *ChatActionBarReportAddBlock) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionBarReportAddBlock) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionBarReportAddBlock) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionBarReportAddBlock"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_unarchive":`)
+	encoder.writeBool(entity.CanUnarchive)
+	if entity.AccountInfo != nil {
+		encoder.writeRaw(`,"account_info":`)
+		entity.AccountInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionBarReportAddBlock) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_unarchive":
+			entity.CanUnarchive, err = decoder.readBool()
+
+		case "account_info":
+			entity.AccountInfo, err = decodeEntity[AccountInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionBarReportAddBlock)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat is a private or secret chat and the other user can be added to the contact list using the method addContact
+type ChatActionBarAddContact struct {
+	meta
+}
+
+func (*ChatActionBarAddContact) GetType() string {
+	return TypeChatActionBar
+}
+
+func (*ChatActionBarAddContact) GetConstructor() string {
+	return ConstructorChatActionBarAddContact
+}
+
+func (*ChatActionBarAddContact) ChatActionBarConstructor() string {
+	return ConstructorChatActionBarAddContact
+}
+
+func (entity *ChatActionBarAddContact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionBarAddContact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionBarAddContact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionBarAddContact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionBarAddContact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionBarAddContact)
+	})
+}
+
+// The chat is a private or secret chat with a mutual contact and the user's phone number can be shared with the other user using the method sharePhoneNumber
+type ChatActionBarSharePhoneNumber struct {
+	meta
+}
+
+func (*ChatActionBarSharePhoneNumber) GetType() string {
+	return TypeChatActionBar
+}
+
+func (*ChatActionBarSharePhoneNumber) GetConstructor() string {
+	return ConstructorChatActionBarSharePhoneNumber
+}
+
+func (*ChatActionBarSharePhoneNumber) ChatActionBarConstructor() string {
+	return ConstructorChatActionBarSharePhoneNumber
+}
+
+func (entity *ChatActionBarSharePhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionBarSharePhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionBarSharePhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionBarSharePhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionBarSharePhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionBarSharePhoneNumber)
+	})
+}
+
+// The chat is a private chat with an administrator of a chat to which the user sent join request
+type ChatActionBarJoinRequest struct {
+	meta
+	// Title of the chat to which the join request was sent
+	Title string `json:"title"`
+	// True, if the join request was sent to a channel chat
+	IsChannel bool `json:"is_channel"`
+	// Point in time (Unix timestamp) when the join request was sent
+	RequestDate int32 `json:"request_date"`
+}
+
+func (*ChatActionBarJoinRequest) GetType() string {
+	return TypeChatActionBar
+}
+
+func (*ChatActionBarJoinRequest) GetConstructor() string {
+	return ConstructorChatActionBarJoinRequest
+}
+
+func (*ChatActionBarJoinRequest) ChatActionBarConstructor() string {
+	return ConstructorChatActionBarJoinRequest
+}
+
+func (entity *ChatActionBarJoinRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionBarJoinRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionBarJoinRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionBarJoinRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"is_channel":`)
+	encoder.writeBool(entity.IsChannel)
+	encoder.writeRaw(`,"request_date":`)
+	encoder.writeInt32(entity.RequestDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionBarJoinRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "is_channel":
+			entity.IsChannel, err = decoder.readBool()
+
+		case "request_date":
+			entity.RequestDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionBarJoinRequest)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a forum topic icon
+type ForumTopicIcon struct {
+	meta
+	// Color of the topic icon in RGB format
+	Color int32 `json:"color"`
+	// Unique identifier of the custom emoji shown on the topic icon; 0 if none
+	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
+}
+
+func (*ForumTopicIcon) GetType() string {
+	return TypeForumTopicIcon
+}
+
+func (*ForumTopicIcon) GetConstructor() string {
+	return ConstructorForumTopicIcon
+}
+
+func (entity *ForumTopicIcon) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ForumTopicIcon) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ForumTopicIcon) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"forumTopicIcon"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"color":`)
+	encoder.writeInt32(entity.Color)
+	encoder.writeRaw(`,"custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.CustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ForumTopicIcon) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "color":
+			entity.Color, err = decoder.readInt32()
+
+		case "custom_emoji_id":
+			entity.CustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorForumTopicIcon)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains basic information about a forum topic
+type ForumTopicInfo struct {
+	meta
+	// Identifier of the forum chat to which the topic belongs
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Name of the topic
+	Name string `json:"name"`
+	// Icon of the topic
+	Icon *ForumTopicIcon `json:"icon"`
+	// Point in time (Unix timestamp) when the topic was created
+	CreationDate int32 `json:"creation_date"`
+	// Identifier of the creator of the topic
+	CreatorId MessageSender `json:"creator_id"`
+	// True, if the topic is the General topic list
+	IsGeneral bool `json:"is_general"`
+	// True, if the topic was created by the current user
+	IsOutgoing bool `json:"is_outgoing"`
+	// True, if the topic is closed
+	IsClosed bool `json:"is_closed"`
+	// True, if the topic is hidden above the topic list and closed; for General topic only
+	IsHidden bool `json:"is_hidden"`
+}
+
+func (*ForumTopicInfo) GetType() string {
+	return TypeForumTopicInfo
+}
+
+func (*ForumTopicInfo) GetConstructor() string {
+	return ConstructorForumTopicInfo
+}
+
+func (entity *ForumTopicInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ForumTopicInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ForumTopicInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"forumTopicInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"icon":`)
+	entity.Icon.encodeJSON(encoder)
+	encoder.writeRaw(`,"creation_date":`)
+	encoder.writeInt32(entity.CreationDate)
+	encoder.writeRaw(`,"creator_id":`)
+	encoder.writeType(entity.CreatorId)
+	encoder.writeRaw(`,"is_general":`)
+	encoder.writeBool(entity.IsGeneral)
+	encoder.writeRaw(`,"is_outgoing":`)
+	encoder.writeBool(entity.IsOutgoing)
+	encoder.writeRaw(`,"is_closed":`)
+	encoder.writeBool(entity.IsClosed)
+	encoder.writeRaw(`,"is_hidden":`)
+	encoder.writeBool(entity.IsHidden)
+	encoder.writeRaw("}")
+}
+
+func (entity *ForumTopicInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "icon":
+			entity.Icon, err = decodeEntity[ForumTopicIcon](decoder)
+
+		case "creation_date":
+			entity.CreationDate, err = decoder.readInt32()
+
+		case "creator_id":
+			entity.CreatorId, err = decodeMessageSender(decoder)
+
+		case "is_general":
+			entity.IsGeneral, err = decoder.readBool()
+
+		case "is_outgoing":
+			entity.IsOutgoing, err = decoder.readBool()
+
+		case "is_closed":
+			entity.IsClosed, err = decoder.readBool()
+
+		case "is_hidden":
+			entity.IsHidden, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorForumTopicInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a forum topic
+type ForumTopic struct {
+	meta
+	// Basic information about the topic
+	Info *ForumTopicInfo `json:"info"`
+	// Last message in the topic; may be null if unknown
+	LastMessage *Message `json:"last_message,omitempty"`
+	// A parameter used to determine order of the topic in the topic list. Topics must be sorted by the order in descending order
+	Order JsonInt64 `json:"order"`
+	// True, if the topic is pinned in the topic list
+	IsPinned bool `json:"is_pinned"`
+	// Number of unread messages in the topic
+	UnreadCount int32 `json:"unread_count"`
+	// Identifier of the last read incoming message
+	LastReadInboxMessageId int64 `json:"last_read_inbox_message_id"`
+	// Identifier of the last read outgoing message
+	LastReadOutboxMessageId int64 `json:"last_read_outbox_message_id"`
+	// Number of unread messages with a mention/reply in the topic
+	UnreadMentionCount int32 `json:"unread_mention_count"`
+	// Number of messages with unread reactions in the topic
+	UnreadReactionCount int32 `json:"unread_reaction_count"`
+	// Notification settings for the topic
+	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
+	// A draft of a message in the topic; may be null if none
+	DraftMessage *DraftMessage `json:"draft_message,omitempty"`
+}
+
+func (*ForumTopic) GetType() string {
+	return TypeForumTopic
+}
+
+func (*ForumTopic) GetConstructor() string {
+	return ConstructorForumTopic
+}
+
+func (entity *ForumTopic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ForumTopic) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ForumTopic) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"forumTopic"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"info":`)
+	entity.Info.encodeJSON(encoder)
+	if entity.LastMessage != nil {
+		encoder.writeRaw(`,"last_message":`)
+		entity.LastMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"order":`)
+	encoder.writeJsonInt64(entity.Order)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw(`,"unread_count":`)
+	encoder.writeInt32(entity.UnreadCount)
+	encoder.writeRaw(`,"last_read_inbox_message_id":`)
+	encoder.writeInt64(entity.LastReadInboxMessageId)
+	encoder.writeRaw(`,"last_read_outbox_message_id":`)
+	encoder.writeInt64(entity.LastReadOutboxMessageId)
+	encoder.writeRaw(`,"unread_mention_count":`)
+	encoder.writeInt32(entity.UnreadMentionCount)
+	encoder.writeRaw(`,"unread_reaction_count":`)
+	encoder.writeInt32(entity.UnreadReactionCount)
+	encoder.writeRaw(`,"notification_settings":`)
+	entity.NotificationSettings.encodeJSON(encoder)
+	if entity.DraftMessage != nil {
+		encoder.writeRaw(`,"draft_message":`)
+		entity.DraftMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ForumTopic) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "info":
+			entity.Info, err = decodeEntity[ForumTopicInfo](decoder)
+
+		case "last_message":
+			entity.LastMessage, err = decodeEntity[Message](decoder)
+
+		case "order":
+			entity.Order, err = decoder.readJsonInt64()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		case "unread_count":
+			entity.UnreadCount, err = decoder.readInt32()
+
+		case "last_read_inbox_message_id":
+			entity.LastReadInboxMessageId, err = decoder.readInt64()
+
+		case "last_read_outbox_message_id":
+			entity.LastReadOutboxMessageId, err = decoder.readInt64()
+
+		case "unread_mention_count":
+			entity.UnreadMentionCount, err = decoder.readInt32()
+
+		case "unread_reaction_count":
+			entity.UnreadReactionCount, err = decoder.readInt32()
+
+		case "notification_settings":
+			entity.NotificationSettings, err = decodeEntity[ChatNotificationSettings](decoder)
+
+		case "draft_message":
+			entity.DraftMessage, err = decodeEntity[DraftMessage](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorForumTopic)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ForumTopic) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("last_message", entity.LastMessage); err != nil {
+		return err
+	}
+
+	if err := validateNested("draft_message", entity.DraftMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Describes a list of forum topics
+type ForumTopics struct {
+	meta
+	// Approximate total number of forum topics found
+	TotalCount int32 `json:"total_count"`
+	// List of forum topics
+	Topics []*ForumTopic `json:"topics"`
+	// Offset date for the next getForumTopics request
+	NextOffsetDate int32 `json:"next_offset_date"`
+	// Offset message identifier for the next getForumTopics request
+	NextOffsetMessageId int64 `json:"next_offset_message_id"`
+	// Offset message thread identifier for the next getForumTopics request
+	NextOffsetMessageThreadId int64 `json:"next_offset_message_thread_id"`
+}
+
+func (*ForumTopics) GetType() string {
+	return TypeForumTopics
+}
+
+func (*ForumTopics) GetConstructor() string {
+	return ConstructorForumTopics
+}
+
+func (entity *ForumTopics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ForumTopics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ForumTopics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"forumTopics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"topics":`)
+	writeList(encoder, entity.Topics, writeTypeValue[*ForumTopic])
+	encoder.writeRaw(`,"next_offset_date":`)
+	encoder.writeInt32(entity.NextOffsetDate)
+	encoder.writeRaw(`,"next_offset_message_id":`)
+	encoder.writeInt64(entity.NextOffsetMessageId)
+	encoder.writeRaw(`,"next_offset_message_thread_id":`)
+	encoder.writeInt64(entity.NextOffsetMessageThreadId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ForumTopics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "topics":
+			entity.Topics, err = readList(decoder, decodeEntity[ForumTopic])
+
+		case "next_offset_date":
+			entity.NextOffsetDate, err = decoder.readInt32()
+
+		case "next_offset_message_id":
+			entity.NextOffsetMessageId, err = decoder.readInt64()
+
+		case "next_offset_message_thread_id":
+			entity.NextOffsetMessageThreadId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorForumTopics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ForumTopics) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Topics {
+		if err := validateNested(indexField("topics", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains information about a chat shared with a bot
+type SharedChat struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Title of the chat; for bots only
+	Title string `json:"title"`
+	// Username of the chat; for bots only
+	Username string `json:"username"`
+	// Photo of the chat; for bots only; may be null
+	Photo *Photo `json:"photo,omitempty"`
+}
+
+func (*SharedChat) GetType() string {
+	return TypeSharedChat
+}
+
+func (*SharedChat) GetConstructor() string {
+	return ConstructorSharedChat
+}
+
+func (entity *SharedChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SharedChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SharedChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sharedChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *SharedChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSharedChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is typing a message
+type ChatActionTyping struct {
+	meta
+}
+
+func (*ChatActionTyping) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionTyping) GetConstructor() string {
+	return ConstructorChatActionTyping
+}
+
+func (*ChatActionTyping) ChatActionConstructor() string {
+	return ConstructorChatActionTyping
+}
+
+func (entity *ChatActionTyping) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionTyping) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionTyping) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionTyping"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionTyping) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionTyping)
+	})
+}
+
+// The user is recording a video
+type ChatActionRecordingVideo struct {
+	meta
+}
+
+func (*ChatActionRecordingVideo) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionRecordingVideo) GetConstructor() string {
+	return ConstructorChatActionRecordingVideo
+}
+
+func (*ChatActionRecordingVideo) ChatActionConstructor() string {
+	return ConstructorChatActionRecordingVideo
+}
+
+func (entity *ChatActionRecordingVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionRecordingVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionRecordingVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionRecordingVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionRecordingVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionRecordingVideo)
+	})
+}
+
+// The user is uploading a video
+type ChatActionUploadingVideo struct {
+	meta
+	// Upload progress, as a percentage
+	Progress int32 `json:"progress"`
+}
+
+func (*ChatActionUploadingVideo) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionUploadingVideo) GetConstructor() string {
+	return ConstructorChatActionUploadingVideo
+}
+
+func (*ChatActionUploadingVideo) ChatActionConstructor() string {
+	return ConstructorChatActionUploadingVideo
+}
+
+func (entity *ChatActionUploadingVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionUploadingVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionUploadingVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionUploadingVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"progress":`)
+	encoder.writeInt32(entity.Progress)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionUploadingVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "progress":
+			entity.Progress, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionUploadingVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is recording a voice note
+type ChatActionRecordingVoiceNote struct {
+	meta
+}
+
+func (*ChatActionRecordingVoiceNote) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionRecordingVoiceNote) GetConstructor() string {
+	return ConstructorChatActionRecordingVoiceNote
+}
+
+func (*ChatActionRecordingVoiceNote) ChatActionConstructor() string {
+	return ConstructorChatActionRecordingVoiceNote
+}
+
+func (entity *ChatActionRecordingVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionRecordingVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionRecordingVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionRecordingVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionRecordingVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionRecordingVoiceNote)
+	})
+}
+
+// The user is uploading a voice note
+type ChatActionUploadingVoiceNote struct {
+	meta
+	// Upload progress, as a percentage
+	Progress int32 `json:"progress"`
+}
+
+func (*ChatActionUploadingVoiceNote) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionUploadingVoiceNote) GetConstructor() string {
+	return ConstructorChatActionUploadingVoiceNote
+}
+
+func (*ChatActionUploadingVoiceNote) ChatActionConstructor() string {
+	return ConstructorChatActionUploadingVoiceNote
+}
+
+func (entity *ChatActionUploadingVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionUploadingVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionUploadingVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionUploadingVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"progress":`)
+	encoder.writeInt32(entity.Progress)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionUploadingVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "progress":
+			entity.Progress, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionUploadingVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is uploading a photo
+type ChatActionUploadingPhoto struct {
+	meta
+	// Upload progress, as a percentage
+	Progress int32 `json:"progress"`
+}
+
+func (*ChatActionUploadingPhoto) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionUploadingPhoto) GetConstructor() string {
+	return ConstructorChatActionUploadingPhoto
+}
+
+func (*ChatActionUploadingPhoto) ChatActionConstructor() string {
+	return ConstructorChatActionUploadingPhoto
+}
+
+func (entity *ChatActionUploadingPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionUploadingPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionUploadingPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionUploadingPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"progress":`)
+	encoder.writeInt32(entity.Progress)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionUploadingPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "progress":
+			entity.Progress, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionUploadingPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is uploading a document
+type ChatActionUploadingDocument struct {
+	meta
+	// Upload progress, as a percentage
+	Progress int32 `json:"progress"`
+}
+
+func (*ChatActionUploadingDocument) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionUploadingDocument) GetConstructor() string {
+	return ConstructorChatActionUploadingDocument
+}
+
+func (*ChatActionUploadingDocument) ChatActionConstructor() string {
+	return ConstructorChatActionUploadingDocument
+}
+
+func (entity *ChatActionUploadingDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionUploadingDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionUploadingDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionUploadingDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"progress":`)
+	encoder.writeInt32(entity.Progress)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionUploadingDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "progress":
+			entity.Progress, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionUploadingDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is picking a sticker to send
+type ChatActionChoosingSticker struct {
+	meta
+}
+
+func (*ChatActionChoosingSticker) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionChoosingSticker) GetConstructor() string {
+	return ConstructorChatActionChoosingSticker
+}
+
+func (*ChatActionChoosingSticker) ChatActionConstructor() string {
+	return ConstructorChatActionChoosingSticker
+}
+
+func (entity *ChatActionChoosingSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionChoosingSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionChoosingSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionChoosingSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionChoosingSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionChoosingSticker)
+	})
+}
+
+// The user is picking a location or venue to send
+type ChatActionChoosingLocation struct {
+	meta
+}
+
+func (*ChatActionChoosingLocation) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionChoosingLocation) GetConstructor() string {
+	return ConstructorChatActionChoosingLocation
+}
+
+func (*ChatActionChoosingLocation) ChatActionConstructor() string {
+	return ConstructorChatActionChoosingLocation
+}
+
+func (entity *ChatActionChoosingLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionChoosingLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionChoosingLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionChoosingLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionChoosingLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionChoosingLocation)
+	})
+}
+
+// The user is picking a contact to send
+type ChatActionChoosingContact struct {
+	meta
+}
+
+func (*ChatActionChoosingContact) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionChoosingContact) GetConstructor() string {
+	return ConstructorChatActionChoosingContact
+}
+
+func (*ChatActionChoosingContact) ChatActionConstructor() string {
+	return ConstructorChatActionChoosingContact
+}
+
+func (entity *ChatActionChoosingContact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionChoosingContact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionChoosingContact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionChoosingContact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionChoosingContact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionChoosingContact)
+	})
+}
+
+// The user has started to play a game
+type ChatActionStartPlayingGame struct {
+	meta
+}
+
+func (*ChatActionStartPlayingGame) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionStartPlayingGame) GetConstructor() string {
+	return ConstructorChatActionStartPlayingGame
+}
+
+func (*ChatActionStartPlayingGame) ChatActionConstructor() string {
+	return ConstructorChatActionStartPlayingGame
+}
+
+func (entity *ChatActionStartPlayingGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionStartPlayingGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionStartPlayingGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionStartPlayingGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionStartPlayingGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionStartPlayingGame)
+	})
+}
+
+// The user is recording a video note
+type ChatActionRecordingVideoNote struct {
+	meta
+}
+
+func (*ChatActionRecordingVideoNote) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionRecordingVideoNote) GetConstructor() string {
+	return ConstructorChatActionRecordingVideoNote
+}
+
+func (*ChatActionRecordingVideoNote) ChatActionConstructor() string {
+	return ConstructorChatActionRecordingVideoNote
+}
+
+func (entity *ChatActionRecordingVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionRecordingVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionRecordingVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionRecordingVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionRecordingVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionRecordingVideoNote)
+	})
+}
+
+// The user is uploading a video note
+type ChatActionUploadingVideoNote struct {
+	meta
+	// Upload progress, as a percentage
+	Progress int32 `json:"progress"`
+}
+
+func (*ChatActionUploadingVideoNote) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionUploadingVideoNote) GetConstructor() string {
+	return ConstructorChatActionUploadingVideoNote
+}
+
+func (*ChatActionUploadingVideoNote) ChatActionConstructor() string {
+	return ConstructorChatActionUploadingVideoNote
+}
+
+func (entity *ChatActionUploadingVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionUploadingVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionUploadingVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionUploadingVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"progress":`)
+	encoder.writeInt32(entity.Progress)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionUploadingVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "progress":
+			entity.Progress, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionUploadingVideoNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is watching animations sent by the other party by clicking on an animated emoji
+type ChatActionWatchingAnimations struct {
+	meta
+	// The animated emoji
+	Emoji string `json:"emoji"`
+}
+
+func (*ChatActionWatchingAnimations) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionWatchingAnimations) GetConstructor() string {
+	return ConstructorChatActionWatchingAnimations
+}
+
+func (*ChatActionWatchingAnimations) ChatActionConstructor() string {
+	return ConstructorChatActionWatchingAnimations
+}
+
+func (entity *ChatActionWatchingAnimations) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionWatchingAnimations) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionWatchingAnimations) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionWatchingAnimations"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionWatchingAnimations) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActionWatchingAnimations)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user has canceled the previous action
+type ChatActionCancel struct {
+	meta
+}
+
+func (*ChatActionCancel) GetType() string {
+	return TypeChatAction
+}
+
+func (*ChatActionCancel) GetConstructor() string {
+	return ConstructorChatActionCancel
+}
+
+func (*ChatActionCancel) ChatActionConstructor() string {
+	return ConstructorChatActionCancel
+}
+
+func (entity *ChatActionCancel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActionCancel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActionCancel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActionCancel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActionCancel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatActionCancel)
+	})
+}
+
+// Describes allowed types for the target chat
+type TargetChatTypes struct {
+	meta
+	// True, if private chats with ordinary users are allowed
+	AllowUserChats bool `json:"allow_user_chats"`
+	// True, if private chats with other bots are allowed
+	AllowBotChats bool `json:"allow_bot_chats"`
+	// True, if basic group and supergroup chats are allowed
+	AllowGroupChats bool `json:"allow_group_chats"`
+	// True, if channel chats are allowed
+	AllowChannelChats bool `json:"allow_channel_chats"`
+}
+
+func (*TargetChatTypes) GetType() string {
+	return TypeTargetChatTypes
+}
+
+func (*TargetChatTypes) GetConstructor() string {
+	return ConstructorTargetChatTypes
+}
+
+func (entity *TargetChatTypes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TargetChatTypes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TargetChatTypes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"targetChatTypes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"allow_user_chats":`)
+	encoder.writeBool(entity.AllowUserChats)
+	encoder.writeRaw(`,"allow_bot_chats":`)
+	encoder.writeBool(entity.AllowBotChats)
+	encoder.writeRaw(`,"allow_group_chats":`)
+	encoder.writeBool(entity.AllowGroupChats)
+	encoder.writeRaw(`,"allow_channel_chats":`)
+	encoder.writeBool(entity.AllowChannelChats)
+	encoder.writeRaw("}")
+}
+
+func (entity *TargetChatTypes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "allow_user_chats":
+			entity.AllowUserChats, err = decoder.readBool()
+
+		case "allow_bot_chats":
+			entity.AllowBotChats, err = decoder.readBool()
+
+		case "allow_group_chats":
+			entity.AllowGroupChats, err = decoder.readBool()
+
+		case "allow_channel_chats":
+			entity.AllowChannelChats, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTargetChatTypes)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The currently opened chat and forum topic must be kept
+type TargetChatCurrent struct {
+	meta
+}
+
+func (*TargetChatCurrent) GetType() string {
+	return TypeTargetChat
+}
+
+func (*TargetChatCurrent) GetConstructor() string {
+	return ConstructorTargetChatCurrent
+}
+
+func (*TargetChatCurrent) TargetChatConstructor() string {
+	return ConstructorTargetChatCurrent
+}
+
+func (entity *TargetChatCurrent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TargetChatCurrent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TargetChatCurrent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"targetChatCurrent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TargetChatCurrent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTargetChatCurrent)
+	})
+}
+
+// The chat needs to be chosen by the user among chats of the specified types
+type TargetChatChosen struct {
+	meta
+	// Allowed types for the chat
+	Types *TargetChatTypes `json:"types"`
+}
+
+func (*TargetChatChosen) GetType() string {
+	return TypeTargetChat
+}
+
+func (*TargetChatChosen) GetConstructor() string {
+	return ConstructorTargetChatChosen
+}
+
+func (*TargetChatChosen) TargetChatConstructor() string {
+	return ConstructorTargetChatChosen
+}
+
+func (entity *TargetChatChosen) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TargetChatChosen) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TargetChatChosen) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"targetChatChosen"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"types":`)
+	entity.Types.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *TargetChatChosen) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "types":
+			entity.Types, err = decodeEntity[TargetChatTypes](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTargetChatChosen)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat needs to be open with the provided internal link
+type TargetChatInternalLink struct {
+	meta
+	// An internal link pointing to the chat
+	Link InternalLinkType `json:"link"`
+}
+
+func (*TargetChatInternalLink) GetType() string {
+	return TypeTargetChat
+}
+
+func (*TargetChatInternalLink) GetConstructor() string {
+	return ConstructorTargetChatInternalLink
+}
+
+func (*TargetChatInternalLink) TargetChatConstructor() string {
+	return ConstructorTargetChatInternalLink
+}
+
+func (entity *TargetChatInternalLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TargetChatInternalLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TargetChatInternalLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"targetChatInternalLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeType(entity.Link)
+	encoder.writeRaw("}")
+}
+
+func (entity *TargetChatInternalLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "link":
+			entity.Link, err = decodeInternalLinkType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTargetChatInternalLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message was edited
+type ChatEventMessageEdited struct {
+	meta
+	// The original message before the edit
+	OldMessage *Message `json:"old_message"`
+	// The message after it was edited
+	NewMessage *Message `json:"new_message"`
+}
+
+func (*ChatEventMessageEdited) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMessageEdited) GetConstructor() string {
+	return ConstructorChatEventMessageEdited
+}
+
+func (*ChatEventMessageEdited) ChatEventActionConstructor() string {
+	return ConstructorChatEventMessageEdited
+}
+
+func (entity *ChatEventMessageEdited) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMessageEdited) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMessageEdited) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMessageEdited"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_message":`)
+	entity.OldMessage.encodeJSON(encoder)
+	encoder.writeRaw(`,"new_message":`)
+	entity.NewMessage.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMessageEdited) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_message":
+			entity.OldMessage, err = decodeEntity[Message](decoder)
+
+		case "new_message":
+			entity.NewMessage, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMessageEdited)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMessageEdited) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("old_message", entity.OldMessage); err != nil {
+		return err
+	}
+
+	if err := validateNested("new_message", entity.NewMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message was deleted
+type ChatEventMessageDeleted struct {
+	meta
+	// Deleted message
+	Message *Message `json:"message"`
+	// True, if the message deletion can be reported via reportSupergroupAntiSpamFalsePositive
+	CanReportAntiSpamFalsePositive bool `json:"can_report_anti_spam_false_positive"`
+}
+
+func (*ChatEventMessageDeleted) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMessageDeleted) GetConstructor() string {
+	return ConstructorChatEventMessageDeleted
+}
+
+func (*ChatEventMessageDeleted) ChatEventActionConstructor() string {
+	return ConstructorChatEventMessageDeleted
+}
+
+func (entity *ChatEventMessageDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMessageDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMessageDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMessageDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw(`,"can_report_anti_spam_false_positive":`)
+	encoder.writeBool(entity.CanReportAntiSpamFalsePositive)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMessageDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		case "can_report_anti_spam_false_positive":
+			entity.CanReportAntiSpamFalsePositive, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMessageDeleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMessageDeleted) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message was pinned
+type ChatEventMessagePinned struct {
+	meta
+	// Pinned message
+	Message *Message `json:"message"`
+}
+
+func (*ChatEventMessagePinned) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMessagePinned) GetConstructor() string {
+	return ConstructorChatEventMessagePinned
+}
+
+func (*ChatEventMessagePinned) ChatEventActionConstructor() string {
+	return ConstructorChatEventMessagePinned
+}
+
+func (entity *ChatEventMessagePinned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMessagePinned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMessagePinned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMessagePinned"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMessagePinned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMessagePinned)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMessagePinned) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message was unpinned
+type ChatEventMessageUnpinned struct {
+	meta
+	// Unpinned message
+	Message *Message `json:"message"`
+}
+
+func (*ChatEventMessageUnpinned) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMessageUnpinned) GetConstructor() string {
+	return ConstructorChatEventMessageUnpinned
+}
+
+func (*ChatEventMessageUnpinned) ChatEventActionConstructor() string {
+	return ConstructorChatEventMessageUnpinned
+}
+
+func (entity *ChatEventMessageUnpinned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMessageUnpinned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMessageUnpinned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMessageUnpinned"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMessageUnpinned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMessageUnpinned)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMessageUnpinned) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A poll in a message was stopped
+type ChatEventPollStopped struct {
+	meta
+	// The message with the poll
+	Message *Message `json:"message"`
+}
+
+func (*ChatEventPollStopped) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventPollStopped) GetConstructor() string {
+	return ConstructorChatEventPollStopped
+}
+
+func (*ChatEventPollStopped) ChatEventActionConstructor() string {
+	return ConstructorChatEventPollStopped
+}
+
+func (entity *ChatEventPollStopped) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventPollStopped) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventPollStopped) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventPollStopped"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventPollStopped) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventPollStopped)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventPollStopped) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A new member joined the chat
+type ChatEventMemberJoined struct {
+	meta
+}
+
+func (*ChatEventMemberJoined) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberJoined) GetConstructor() string {
+	return ConstructorChatEventMemberJoined
+}
+
+func (*ChatEventMemberJoined) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberJoined
+}
+
+func (entity *ChatEventMemberJoined) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberJoined) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberJoined) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberJoined"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberJoined) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberJoined)
+	})
+}
+
+// A new member joined the chat via an invite link
+type ChatEventMemberJoinedByInviteLink struct {
+	meta
+	// Invite link used to join the chat
+	InviteLink *ChatInviteLink `json:"invite_link"`
+	// True, if the user has joined the chat using an invite link for a chat folder
+	ViaChatFolderInviteLink bool `json:"via_chat_folder_invite_link"`
+}
+
+func (*ChatEventMemberJoinedByInviteLink) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberJoinedByInviteLink) GetConstructor() string {
+	return ConstructorChatEventMemberJoinedByInviteLink
+}
+
+func (*ChatEventMemberJoinedByInviteLink) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberJoinedByInviteLink
+}
+
+func (entity *ChatEventMemberJoinedByInviteLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberJoinedByInviteLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberJoinedByInviteLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberJoinedByInviteLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	entity.InviteLink.encodeJSON(encoder)
+	encoder.writeRaw(`,"via_chat_folder_invite_link":`)
+	encoder.writeBool(entity.ViaChatFolderInviteLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberJoinedByInviteLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		case "via_chat_folder_invite_link":
+			entity.ViaChatFolderInviteLink, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberJoinedByInviteLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new member was accepted to the chat by an administrator
+type ChatEventMemberJoinedByRequest struct {
+	meta
+	// User identifier of the chat administrator, approved user join request
+	ApproverUserId int64 `json:"approver_user_id"`
+	// Invite link used to join the chat; may be null
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+func (*ChatEventMemberJoinedByRequest) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberJoinedByRequest) GetConstructor() string {
+	return ConstructorChatEventMemberJoinedByRequest
+}
+
+func (*ChatEventMemberJoinedByRequest) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberJoinedByRequest
+}
+
+func (entity *ChatEventMemberJoinedByRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberJoinedByRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberJoinedByRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberJoinedByRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"approver_user_id":`)
+	encoder.writeInt64(entity.ApproverUserId)
+	if entity.InviteLink != nil {
+		encoder.writeRaw(`,"invite_link":`)
+		entity.InviteLink.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberJoinedByRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "approver_user_id":
+			entity.ApproverUserId, err = decoder.readInt64()
+
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberJoinedByRequest)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new chat member was invited
+type ChatEventMemberInvited struct {
+	meta
+	// New member user identifier
+	UserId int64 `json:"user_id"`
+	// New member status
+	Status ChatMemberStatus `json:"status"`
+}
+
+func (*ChatEventMemberInvited) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberInvited) GetConstructor() string {
+	return ConstructorChatEventMemberInvited
+}
+
+func (*ChatEventMemberInvited) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberInvited
+}
+
+func (entity *ChatEventMemberInvited) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberInvited) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberInvited) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberInvited"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(entity.Status)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberInvited) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "status":
+			entity.Status, err = decodeChatMemberStatus(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberInvited)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMemberInvited) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("status", entity.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A member left the chat
+type ChatEventMemberLeft struct {
+	meta
+}
+
+func (*ChatEventMemberLeft) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberLeft) GetConstructor() string {
+	return ConstructorChatEventMemberLeft
+}
+
+func (*ChatEventMemberLeft) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberLeft
+}
+
+func (entity *ChatEventMemberLeft) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberLeft) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberLeft) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberLeft"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberLeft) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberLeft)
+	})
+}
+
+// A chat member has gained/lost administrator status, or the list of their administrator privileges has changed
+type ChatEventMemberPromoted struct {
+	meta
+	// Affected chat member user identifier
+	UserId int64 `json:"user_id"`
+	// Previous status of the chat member
+	OldStatus ChatMemberStatus `json:"old_status"`
+	// New status of the chat member
+	NewStatus ChatMemberStatus `json:"new_status"`
+}
+
+func (*ChatEventMemberPromoted) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberPromoted) GetConstructor() string {
+	return ConstructorChatEventMemberPromoted
+}
+
+func (*ChatEventMemberPromoted) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberPromoted
+}
+
+func (entity *ChatEventMemberPromoted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberPromoted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberPromoted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberPromoted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"old_status":`)
+	encoder.writeType(entity.OldStatus)
+	encoder.writeRaw(`,"new_status":`)
+	encoder.writeType(entity.NewStatus)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberPromoted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "old_status":
+			entity.OldStatus, err = decodeChatMemberStatus(decoder)
+
+		case "new_status":
+			entity.NewStatus, err = decodeChatMemberStatus(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberPromoted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMemberPromoted) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("old_status", entity.OldStatus); err != nil {
+		return err
+	}
+
+	if err := validateNested("new_status", entity.NewStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A chat member was restricted/unrestricted or banned/unbanned, or the list of their restrictions has changed
+type ChatEventMemberRestricted struct {
+	meta
+	// Affected chat member identifier
+	MemberId MessageSender `json:"member_id"`
+	// Previous status of the chat member
+	OldStatus ChatMemberStatus `json:"old_status"`
+	// New status of the chat member
+	NewStatus ChatMemberStatus `json:"new_status"`
+}
+
+func (*ChatEventMemberRestricted) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberRestricted) GetConstructor() string {
+	return ConstructorChatEventMemberRestricted
+}
+
+func (*ChatEventMemberRestricted) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberRestricted
+}
+
+func (entity *ChatEventMemberRestricted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberRestricted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberRestricted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberRestricted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"member_id":`)
+	encoder.writeType(entity.MemberId)
+	encoder.writeRaw(`,"old_status":`)
+	encoder.writeType(entity.OldStatus)
+	encoder.writeRaw(`,"new_status":`)
+	encoder.writeType(entity.NewStatus)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberRestricted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "member_id":
+			entity.MemberId, err = decodeMessageSender(decoder)
+
+		case "old_status":
+			entity.OldStatus, err = decodeChatMemberStatus(decoder)
+
+		case "new_status":
+			entity.NewStatus, err = decodeChatMemberStatus(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberRestricted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMemberRestricted) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("old_status", entity.OldStatus); err != nil {
+		return err
+	}
+
+	if err := validateNested("new_status", entity.NewStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A chat member extended their subscription to the chat
+type ChatEventMemberSubscriptionExtended struct {
+	meta
+	// Affected chat member user identifier
+	UserId int64 `json:"user_id"`
+	// Previous status of the chat member
+	OldStatus ChatMemberStatus `json:"old_status"`
+	// New status of the chat member
+	NewStatus ChatMemberStatus `json:"new_status"`
+}
+
+func (*ChatEventMemberSubscriptionExtended) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMemberSubscriptionExtended) GetConstructor() string {
+	return ConstructorChatEventMemberSubscriptionExtended
+}
+
+func (*ChatEventMemberSubscriptionExtended) ChatEventActionConstructor() string {
+	return ConstructorChatEventMemberSubscriptionExtended
+}
+
+func (entity *ChatEventMemberSubscriptionExtended) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMemberSubscriptionExtended) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMemberSubscriptionExtended) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMemberSubscriptionExtended"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"old_status":`)
+	encoder.writeType(entity.OldStatus)
+	encoder.writeRaw(`,"new_status":`)
+	encoder.writeType(entity.NewStatus)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMemberSubscriptionExtended) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "old_status":
+			entity.OldStatus, err = decodeChatMemberStatus(decoder)
+
+		case "new_status":
+			entity.NewStatus, err = decodeChatMemberStatus(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMemberSubscriptionExtended)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventMemberSubscriptionExtended) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("old_status", entity.OldStatus); err != nil {
+		return err
+	}
+
+	if err := validateNested("new_status", entity.NewStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The chat available reactions were changed
+type ChatEventAvailableReactionsChanged struct {
+	meta
+	// Previous chat available reactions
+	OldAvailableReactions ChatAvailableReactions `json:"old_available_reactions"`
+	// New chat available reactions
+	NewAvailableReactions ChatAvailableReactions `json:"new_available_reactions"`
+}
+
+func (*ChatEventAvailableReactionsChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventAvailableReactionsChanged) GetConstructor() string {
+	return ConstructorChatEventAvailableReactionsChanged
+}
+
+func (*ChatEventAvailableReactionsChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventAvailableReactionsChanged
+}
+
+func (entity *ChatEventAvailableReactionsChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventAvailableReactionsChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventAvailableReactionsChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventAvailableReactionsChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_available_reactions":`)
+	encoder.writeType(entity.OldAvailableReactions)
+	encoder.writeRaw(`,"new_available_reactions":`)
+	encoder.writeType(entity.NewAvailableReactions)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventAvailableReactionsChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_available_reactions":
+			entity.OldAvailableReactions, err = decodeChatAvailableReactions(decoder)
+
+		case "new_available_reactions":
+			entity.NewAvailableReactions, err = decodeChatAvailableReactions(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventAvailableReactionsChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat background was changed
+type ChatEventBackgroundChanged struct {
+	meta
+	// Previous background; may be null if none
+	OldBackground *ChatBackground `json:"old_background,omitempty"`
+	// New background; may be null if none
+	NewBackground *ChatBackground `json:"new_background,omitempty"`
+}
+
+func (*ChatEventBackgroundChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventBackgroundChanged) GetConstructor() string {
+	return ConstructorChatEventBackgroundChanged
+}
+
+func (*ChatEventBackgroundChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventBackgroundChanged
+}
+
+func (entity *ChatEventBackgroundChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventBackgroundChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventBackgroundChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventBackgroundChanged"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.OldBackground != nil {
+		encoder.writeRaw(`,"old_background":`)
+		entity.OldBackground.encodeJSON(encoder)
+	}
+	if entity.NewBackground != nil {
+		encoder.writeRaw(`,"new_background":`)
+		entity.NewBackground.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventBackgroundChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_background":
+			entity.OldBackground, err = decodeEntity[ChatBackground](decoder)
+
+		case "new_background":
+			entity.NewBackground, err = decodeEntity[ChatBackground](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventBackgroundChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat description was changed
+type ChatEventDescriptionChanged struct {
+	meta
+	// Previous chat description
+	OldDescription string `json:"old_description"`
+	// New chat description
+	NewDescription string `json:"new_description"`
+}
+
+func (*ChatEventDescriptionChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventDescriptionChanged) GetConstructor() string {
+	return ConstructorChatEventDescriptionChanged
+}
+
+func (*ChatEventDescriptionChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventDescriptionChanged
+}
+
+func (entity *ChatEventDescriptionChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventDescriptionChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventDescriptionChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventDescriptionChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_description":`)
+	encoder.writeString(entity.OldDescription)
+	encoder.writeRaw(`,"new_description":`)
+	encoder.writeString(entity.NewDescription)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventDescriptionChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_description":
+			entity.OldDescription, err = decoder.readString()
+
+		case "new_description":
+			entity.NewDescription, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventDescriptionChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat emoji status was changed
+type ChatEventEmojiStatusChanged struct {
+	meta
+	// Previous emoji status; may be null if none
+	OldEmojiStatus *EmojiStatus `json:"old_emoji_status,omitempty"`
+	// New emoji status; may be null if none
+	NewEmojiStatus *EmojiStatus `json:"new_emoji_status,omitempty"`
+}
+
+func (*ChatEventEmojiStatusChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventEmojiStatusChanged) GetConstructor() string {
+	return ConstructorChatEventEmojiStatusChanged
+}
+
+func (*ChatEventEmojiStatusChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventEmojiStatusChanged
+}
+
+func (entity *ChatEventEmojiStatusChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventEmojiStatusChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventEmojiStatusChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventEmojiStatusChanged"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.OldEmojiStatus != nil {
+		encoder.writeRaw(`,"old_emoji_status":`)
+		entity.OldEmojiStatus.encodeJSON(encoder)
+	}
+	if entity.NewEmojiStatus != nil {
+		encoder.writeRaw(`,"new_emoji_status":`)
+		entity.NewEmojiStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventEmojiStatusChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_emoji_status":
+			entity.OldEmojiStatus, err = decodeEntity[EmojiStatus](decoder)
+
+		case "new_emoji_status":
+			entity.NewEmojiStatus, err = decodeEntity[EmojiStatus](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventEmojiStatusChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The linked chat of a supergroup was changed
+type ChatEventLinkedChatChanged struct {
+	meta
+	// Previous supergroup linked chat identifier
+	OldLinkedChatId int64 `json:"old_linked_chat_id"`
+	// New supergroup linked chat identifier
+	NewLinkedChatId int64 `json:"new_linked_chat_id"`
+}
+
+func (*ChatEventLinkedChatChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventLinkedChatChanged) GetConstructor() string {
+	return ConstructorChatEventLinkedChatChanged
+}
+
+func (*ChatEventLinkedChatChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventLinkedChatChanged
+}
+
+func (entity *ChatEventLinkedChatChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventLinkedChatChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventLinkedChatChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventLinkedChatChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_linked_chat_id":`)
+	encoder.writeInt64(entity.OldLinkedChatId)
+	encoder.writeRaw(`,"new_linked_chat_id":`)
+	encoder.writeInt64(entity.NewLinkedChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventLinkedChatChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_linked_chat_id":
+			entity.OldLinkedChatId, err = decoder.readInt64()
+
+		case "new_linked_chat_id":
+			entity.NewLinkedChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventLinkedChatChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The supergroup location was changed
+type ChatEventLocationChanged struct {
+	meta
+	// Previous location; may be null
+	OldLocation *ChatLocation `json:"old_location,omitempty"`
+	// New location; may be null
+	NewLocation *ChatLocation `json:"new_location,omitempty"`
+}
+
+func (*ChatEventLocationChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventLocationChanged) GetConstructor() string {
+	return ConstructorChatEventLocationChanged
+}
+
+func (*ChatEventLocationChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventLocationChanged
+}
+
+func (entity *ChatEventLocationChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventLocationChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventLocationChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventLocationChanged"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.OldLocation != nil {
+		encoder.writeRaw(`,"old_location":`)
+		entity.OldLocation.encodeJSON(encoder)
+	}
+	if entity.NewLocation != nil {
+		encoder.writeRaw(`,"new_location":`)
+		entity.NewLocation.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventLocationChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_location":
+			entity.OldLocation, err = decodeEntity[ChatLocation](decoder)
+
+		case "new_location":
+			entity.NewLocation, err = decodeEntity[ChatLocation](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventLocationChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEventLocationChanged) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("old_location", entity.OldLocation); err != nil {
+		return err
+	}
+
+	if err := validateNested("new_location", entity.NewLocation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The message auto-delete timer was changed
+type ChatEventMessageAutoDeleteTimeChanged struct {
+	meta
+	// Previous value of message_auto_delete_time
+	OldMessageAutoDeleteTime int32 `json:"old_message_auto_delete_time"`
+	// New value of message_auto_delete_time
+	NewMessageAutoDeleteTime int32 `json:"new_message_auto_delete_time"`
+}
+
+func (*ChatEventMessageAutoDeleteTimeChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventMessageAutoDeleteTimeChanged) GetConstructor() string {
+	return ConstructorChatEventMessageAutoDeleteTimeChanged
+}
+
+func (*ChatEventMessageAutoDeleteTimeChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventMessageAutoDeleteTimeChanged
+}
+
+func (entity *ChatEventMessageAutoDeleteTimeChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventMessageAutoDeleteTimeChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventMessageAutoDeleteTimeChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventMessageAutoDeleteTimeChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_message_auto_delete_time":`)
+	encoder.writeInt32(entity.OldMessageAutoDeleteTime)
+	encoder.writeRaw(`,"new_message_auto_delete_time":`)
+	encoder.writeInt32(entity.NewMessageAutoDeleteTime)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventMessageAutoDeleteTimeChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_message_auto_delete_time":
+			entity.OldMessageAutoDeleteTime, err = decoder.readInt32()
+
+		case "new_message_auto_delete_time":
+			entity.NewMessageAutoDeleteTime, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventMessageAutoDeleteTimeChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat permissions were changed
+type ChatEventPermissionsChanged struct {
+	meta
+	// Previous chat permissions
+	OldPermissions *ChatPermissions `json:"old_permissions"`
+	// New chat permissions
+	NewPermissions *ChatPermissions `json:"new_permissions"`
+}
+
+func (*ChatEventPermissionsChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventPermissionsChanged) GetConstructor() string {
+	return ConstructorChatEventPermissionsChanged
+}
+
+func (*ChatEventPermissionsChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventPermissionsChanged
+}
+
+func (entity *ChatEventPermissionsChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventPermissionsChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventPermissionsChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventPermissionsChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_permissions":`)
+	entity.OldPermissions.encodeJSON(encoder)
+	encoder.writeRaw(`,"new_permissions":`)
+	entity.NewPermissions.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventPermissionsChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_permissions":
+			entity.OldPermissions, err = decodeEntity[ChatPermissions](decoder)
+
+		case "new_permissions":
+			entity.NewPermissions, err = decodeEntity[ChatPermissions](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventPermissionsChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat photo was changed
+type ChatEventPhotoChanged struct {
+	meta
+	// Previous chat photo value; may be null
+	OldPhoto *ChatPhoto `json:"old_photo,omitempty"`
+	// New chat photo value; may be null
+	NewPhoto *ChatPhoto `json:"new_photo,omitempty"`
+}
+
+func (*ChatEventPhotoChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventPhotoChanged) GetConstructor() string {
+	return ConstructorChatEventPhotoChanged
+}
+
+func (*ChatEventPhotoChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventPhotoChanged
+}
+
+func (entity *ChatEventPhotoChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventPhotoChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventPhotoChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventPhotoChanged"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.OldPhoto != nil {
+		encoder.writeRaw(`,"old_photo":`)
+		entity.OldPhoto.encodeJSON(encoder)
+	}
+	if entity.NewPhoto != nil {
+		encoder.writeRaw(`,"new_photo":`)
+		entity.NewPhoto.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventPhotoChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_photo":
+			entity.OldPhoto, err = decodeEntity[ChatPhoto](decoder)
+
+		case "new_photo":
+			entity.NewPhoto, err = decodeEntity[ChatPhoto](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventPhotoChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The slow_mode_delay setting of a supergroup was changed
+type ChatEventSlowModeDelayChanged struct {
+	meta
+	// Previous value of slow_mode_delay, in seconds
+	OldSlowModeDelay int32 `json:"old_slow_mode_delay"`
+	// New value of slow_mode_delay, in seconds
+	NewSlowModeDelay int32 `json:"new_slow_mode_delay"`
+}
+
+func (*ChatEventSlowModeDelayChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventSlowModeDelayChanged) GetConstructor() string {
+	return ConstructorChatEventSlowModeDelayChanged
+}
+
+func (*ChatEventSlowModeDelayChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventSlowModeDelayChanged
+}
+
+func (entity *ChatEventSlowModeDelayChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventSlowModeDelayChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventSlowModeDelayChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventSlowModeDelayChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_slow_mode_delay":`)
+	encoder.writeInt32(entity.OldSlowModeDelay)
+	encoder.writeRaw(`,"new_slow_mode_delay":`)
+	encoder.writeInt32(entity.NewSlowModeDelay)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventSlowModeDelayChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_slow_mode_delay":
+			entity.OldSlowModeDelay, err = decoder.readInt32()
+
+		case "new_slow_mode_delay":
+			entity.NewSlowModeDelay, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventSlowModeDelayChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The supergroup sticker set was changed
+type ChatEventStickerSetChanged struct {
+	meta
+	// Previous identifier of the chat sticker set; 0 if none
+	OldStickerSetId JsonInt64 `json:"old_sticker_set_id"`
+	// New identifier of the chat sticker set; 0 if none
+	NewStickerSetId JsonInt64 `json:"new_sticker_set_id"`
+}
+
+func (*ChatEventStickerSetChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventStickerSetChanged) GetConstructor() string {
+	return ConstructorChatEventStickerSetChanged
+}
+
+func (*ChatEventStickerSetChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventStickerSetChanged
+}
+
+func (entity *ChatEventStickerSetChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventStickerSetChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventStickerSetChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventStickerSetChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_sticker_set_id":`)
+	encoder.writeJsonInt64(entity.OldStickerSetId)
+	encoder.writeRaw(`,"new_sticker_set_id":`)
+	encoder.writeJsonInt64(entity.NewStickerSetId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventStickerSetChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_sticker_set_id":
+			entity.OldStickerSetId, err = decoder.readJsonInt64()
+
+		case "new_sticker_set_id":
+			entity.NewStickerSetId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventStickerSetChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The supergroup sticker set with allowed custom emoji was changed
+type ChatEventCustomEmojiStickerSetChanged struct {
+	meta
+	// Previous identifier of the chat sticker set; 0 if none
+	OldStickerSetId JsonInt64 `json:"old_sticker_set_id"`
+	// New identifier of the chat sticker set; 0 if none
+	NewStickerSetId JsonInt64 `json:"new_sticker_set_id"`
+}
+
+func (*ChatEventCustomEmojiStickerSetChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventCustomEmojiStickerSetChanged) GetConstructor() string {
+	return ConstructorChatEventCustomEmojiStickerSetChanged
+}
+
+func (*ChatEventCustomEmojiStickerSetChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventCustomEmojiStickerSetChanged
+}
+
+func (entity *ChatEventCustomEmojiStickerSetChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventCustomEmojiStickerSetChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventCustomEmojiStickerSetChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventCustomEmojiStickerSetChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_sticker_set_id":`)
+	encoder.writeJsonInt64(entity.OldStickerSetId)
+	encoder.writeRaw(`,"new_sticker_set_id":`)
+	encoder.writeJsonInt64(entity.NewStickerSetId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventCustomEmojiStickerSetChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_sticker_set_id":
+			entity.OldStickerSetId, err = decoder.readJsonInt64()
+
+		case "new_sticker_set_id":
+			entity.NewStickerSetId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventCustomEmojiStickerSetChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat title was changed
+type ChatEventTitleChanged struct {
+	meta
+	// Previous chat title
+	OldTitle string `json:"old_title"`
+	// New chat title
+	NewTitle string `json:"new_title"`
+}
+
+func (*ChatEventTitleChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventTitleChanged) GetConstructor() string {
+	return ConstructorChatEventTitleChanged
+}
+
+func (*ChatEventTitleChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventTitleChanged
+}
+
+func (entity *ChatEventTitleChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventTitleChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventTitleChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventTitleChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_title":`)
+	encoder.writeString(entity.OldTitle)
+	encoder.writeRaw(`,"new_title":`)
+	encoder.writeString(entity.NewTitle)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventTitleChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_title":
+			entity.OldTitle, err = decoder.readString()
+
+		case "new_title":
+			entity.NewTitle, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventTitleChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat editable username was changed
+type ChatEventUsernameChanged struct {
+	meta
+	// Previous chat username
+	OldUsername string `json:"old_username"`
+	// New chat username
+	NewUsername string `json:"new_username"`
+}
+
+func (*ChatEventUsernameChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventUsernameChanged) GetConstructor() string {
+	return ConstructorChatEventUsernameChanged
+}
+
+func (*ChatEventUsernameChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventUsernameChanged
+}
+
+func (entity *ChatEventUsernameChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventUsernameChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventUsernameChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventUsernameChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_username":`)
+	encoder.writeString(entity.OldUsername)
+	encoder.writeRaw(`,"new_username":`)
+	encoder.writeString(entity.NewUsername)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventUsernameChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_username":
+			entity.OldUsername, err = decoder.readString()
+
+		case "new_username":
+			entity.NewUsername, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventUsernameChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat active usernames were changed
+type ChatEventActiveUsernamesChanged struct {
+	meta
+	// Previous list of active usernames
+	OldUsernames []string `json:"old_usernames"`
+	// New list of active usernames
+	NewUsernames []string `json:"new_usernames"`
+}
+
+func (*ChatEventActiveUsernamesChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventActiveUsernamesChanged) GetConstructor() string {
+	return ConstructorChatEventActiveUsernamesChanged
+}
+
+func (*ChatEventActiveUsernamesChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventActiveUsernamesChanged
+}
+
+func (entity *ChatEventActiveUsernamesChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventActiveUsernamesChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventActiveUsernamesChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventActiveUsernamesChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_usernames":`)
+	writeList(encoder, entity.OldUsernames, (*jsonEncoder).writeString)
+	encoder.writeRaw(`,"new_usernames":`)
+	writeList(encoder, entity.NewUsernames, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventActiveUsernamesChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_usernames":
+			entity.OldUsernames, err = readList(decoder, (*jsonDecoder).readString)
+
+		case "new_usernames":
+			entity.NewUsernames, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventActiveUsernamesChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat accent color or background custom emoji were changed
+type ChatEventAccentColorChanged struct {
+	meta
+	// Previous identifier of chat accent color
+	OldAccentColorId int32 `json:"old_accent_color_id"`
+	// Previous identifier of the custom emoji; 0 if none
+	OldBackgroundCustomEmojiId JsonInt64 `json:"old_background_custom_emoji_id"`
+	// New identifier of chat accent color
+	NewAccentColorId int32 `json:"new_accent_color_id"`
+	// New identifier of the custom emoji; 0 if none
+	NewBackgroundCustomEmojiId JsonInt64 `json:"new_background_custom_emoji_id"`
+}
+
+func (*ChatEventAccentColorChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventAccentColorChanged) GetConstructor() string {
+	return ConstructorChatEventAccentColorChanged
+}
+
+func (*ChatEventAccentColorChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventAccentColorChanged
+}
+
+func (entity *ChatEventAccentColorChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventAccentColorChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventAccentColorChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventAccentColorChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_accent_color_id":`)
+	encoder.writeInt32(entity.OldAccentColorId)
+	encoder.writeRaw(`,"old_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.OldBackgroundCustomEmojiId)
+	encoder.writeRaw(`,"new_accent_color_id":`)
+	encoder.writeInt32(entity.NewAccentColorId)
+	encoder.writeRaw(`,"new_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.NewBackgroundCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventAccentColorChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_accent_color_id":
+			entity.OldAccentColorId, err = decoder.readInt32()
+
+		case "old_background_custom_emoji_id":
+			entity.OldBackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "new_accent_color_id":
+			entity.NewAccentColorId, err = decoder.readInt32()
+
+		case "new_background_custom_emoji_id":
+			entity.NewBackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventAccentColorChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat's profile accent color or profile background custom emoji were changed
+type ChatEventProfileAccentColorChanged struct {
+	meta
+	// Previous identifier of chat's profile accent color; -1 if none
+	OldProfileAccentColorId int32 `json:"old_profile_accent_color_id"`
+	// Previous identifier of the custom emoji; 0 if none
+	OldProfileBackgroundCustomEmojiId JsonInt64 `json:"old_profile_background_custom_emoji_id"`
+	// New identifier of chat's profile accent color; -1 if none
+	NewProfileAccentColorId int32 `json:"new_profile_accent_color_id"`
+	// New identifier of the custom emoji; 0 if none
+	NewProfileBackgroundCustomEmojiId JsonInt64 `json:"new_profile_background_custom_emoji_id"`
+}
+
+func (*ChatEventProfileAccentColorChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventProfileAccentColorChanged) GetConstructor() string {
+	return ConstructorChatEventProfileAccentColorChanged
+}
+
+func (*ChatEventProfileAccentColorChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventProfileAccentColorChanged
+}
+
+func (entity *ChatEventProfileAccentColorChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventProfileAccentColorChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventProfileAccentColorChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventProfileAccentColorChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_profile_accent_color_id":`)
+	encoder.writeInt32(entity.OldProfileAccentColorId)
+	encoder.writeRaw(`,"old_profile_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.OldProfileBackgroundCustomEmojiId)
+	encoder.writeRaw(`,"new_profile_accent_color_id":`)
+	encoder.writeInt32(entity.NewProfileAccentColorId)
+	encoder.writeRaw(`,"new_profile_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.NewProfileBackgroundCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventProfileAccentColorChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_profile_accent_color_id":
+			entity.OldProfileAccentColorId, err = decoder.readInt32()
+
+		case "old_profile_background_custom_emoji_id":
+			entity.OldProfileBackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "new_profile_accent_color_id":
+			entity.NewProfileAccentColorId, err = decoder.readInt32()
+
+		case "new_profile_background_custom_emoji_id":
+			entity.NewProfileBackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventProfileAccentColorChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The has_protected_content setting of a channel was toggled
+type ChatEventHasProtectedContentToggled struct {
+	meta
+	// New value of has_protected_content
+	HasProtectedContent bool `json:"has_protected_content"`
+}
+
+func (*ChatEventHasProtectedContentToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventHasProtectedContentToggled) GetConstructor() string {
+	return ConstructorChatEventHasProtectedContentToggled
+}
+
+func (*ChatEventHasProtectedContentToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventHasProtectedContentToggled
+}
+
+func (entity *ChatEventHasProtectedContentToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventHasProtectedContentToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventHasProtectedContentToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventHasProtectedContentToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"has_protected_content":`)
+	encoder.writeBool(entity.HasProtectedContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventHasProtectedContentToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "has_protected_content":
+			entity.HasProtectedContent, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventHasProtectedContentToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The can_invite_users permission of a supergroup chat was toggled
+type ChatEventInvitesToggled struct {
+	meta
+	// New value of can_invite_users permission
+	CanInviteUsers bool `json:"can_invite_users"`
+}
+
+func (*ChatEventInvitesToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventInvitesToggled) GetConstructor() string {
+	return ConstructorChatEventInvitesToggled
+}
+
+func (*ChatEventInvitesToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventInvitesToggled
+}
+
+func (entity *ChatEventInvitesToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventInvitesToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventInvitesToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventInvitesToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_invite_users":`)
+	encoder.writeBool(entity.CanInviteUsers)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventInvitesToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_invite_users":
+			entity.CanInviteUsers, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventInvitesToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The is_all_history_available setting of a supergroup was toggled
+type ChatEventIsAllHistoryAvailableToggled struct {
+	meta
+	// New value of is_all_history_available
+	IsAllHistoryAvailable bool `json:"is_all_history_available"`
+}
+
+func (*ChatEventIsAllHistoryAvailableToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventIsAllHistoryAvailableToggled) GetConstructor() string {
+	return ConstructorChatEventIsAllHistoryAvailableToggled
+}
+
+func (*ChatEventIsAllHistoryAvailableToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventIsAllHistoryAvailableToggled
+}
+
+func (entity *ChatEventIsAllHistoryAvailableToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventIsAllHistoryAvailableToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventIsAllHistoryAvailableToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventIsAllHistoryAvailableToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_all_history_available":`)
+	encoder.writeBool(entity.IsAllHistoryAvailable)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventIsAllHistoryAvailableToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_all_history_available":
+			entity.IsAllHistoryAvailable, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventIsAllHistoryAvailableToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The has_aggressive_anti_spam_enabled setting of a supergroup was toggled
+type ChatEventHasAggressiveAntiSpamEnabledToggled struct {
+	meta
+	// New value of has_aggressive_anti_spam_enabled
+	HasAggressiveAntiSpamEnabled bool `json:"has_aggressive_anti_spam_enabled"`
+}
+
+func (*ChatEventHasAggressiveAntiSpamEnabledToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventHasAggressiveAntiSpamEnabledToggled) GetConstructor() string {
+	return ConstructorChatEventHasAggressiveAntiSpamEnabledToggled
+}
+
+func (*ChatEventHasAggressiveAntiSpamEnabledToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventHasAggressiveAntiSpamEnabledToggled
+}
+
+func (entity *ChatEventHasAggressiveAntiSpamEnabledToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventHasAggressiveAntiSpamEnabledToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventHasAggressiveAntiSpamEnabledToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventHasAggressiveAntiSpamEnabledToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"has_aggressive_anti_spam_enabled":`)
+	encoder.writeBool(entity.HasAggressiveAntiSpamEnabled)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventHasAggressiveAntiSpamEnabledToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "has_aggressive_anti_spam_enabled":
+			entity.HasAggressiveAntiSpamEnabled, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventHasAggressiveAntiSpamEnabledToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The sign_messages setting of a channel was toggled
+type ChatEventSignMessagesToggled struct {
+	meta
+	// New value of sign_messages
+	SignMessages bool `json:"sign_messages"`
+}
+
+func (*ChatEventSignMessagesToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventSignMessagesToggled) GetConstructor() string {
+	return ConstructorChatEventSignMessagesToggled
+}
+
+func (*ChatEventSignMessagesToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventSignMessagesToggled
+}
+
+func (entity *ChatEventSignMessagesToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventSignMessagesToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventSignMessagesToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventSignMessagesToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sign_messages":`)
+	encoder.writeBool(entity.SignMessages)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventSignMessagesToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sign_messages":
+			entity.SignMessages, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventSignMessagesToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The show_message_sender setting of a channel was toggled
+type ChatEventShowMessageSenderToggled struct {
+	meta
+	// New value of show_message_sender
+	ShowMessageSender bool `json:"show_message_sender"`
+}
+
+func (*ChatEventShowMessageSenderToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventShowMessageSenderToggled) GetConstructor() string {
+	return ConstructorChatEventShowMessageSenderToggled
+}
+
+func (*ChatEventShowMessageSenderToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventShowMessageSenderToggled
+}
+
+func (entity *ChatEventShowMessageSenderToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventShowMessageSenderToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventShowMessageSenderToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventShowMessageSenderToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"show_message_sender":`)
+	encoder.writeBool(entity.ShowMessageSender)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventShowMessageSenderToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "show_message_sender":
+			entity.ShowMessageSender, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventShowMessageSenderToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat invite link was edited
+type ChatEventInviteLinkEdited struct {
+	meta
+	// Previous information about the invite link
+	OldInviteLink *ChatInviteLink `json:"old_invite_link"`
+	// New information about the invite link
+	NewInviteLink *ChatInviteLink `json:"new_invite_link"`
+}
+
+func (*ChatEventInviteLinkEdited) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventInviteLinkEdited) GetConstructor() string {
+	return ConstructorChatEventInviteLinkEdited
+}
+
+func (*ChatEventInviteLinkEdited) ChatEventActionConstructor() string {
+	return ConstructorChatEventInviteLinkEdited
+}
+
+func (entity *ChatEventInviteLinkEdited) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventInviteLinkEdited) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventInviteLinkEdited) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventInviteLinkEdited"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_invite_link":`)
+	entity.OldInviteLink.encodeJSON(encoder)
+	encoder.writeRaw(`,"new_invite_link":`)
+	entity.NewInviteLink.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventInviteLinkEdited) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_invite_link":
+			entity.OldInviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		case "new_invite_link":
+			entity.NewInviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventInviteLinkEdited)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat invite link was revoked
+type ChatEventInviteLinkRevoked struct {
+	meta
+	// The invite link
+	InviteLink *ChatInviteLink `json:"invite_link"`
+}
+
+func (*ChatEventInviteLinkRevoked) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventInviteLinkRevoked) GetConstructor() string {
+	return ConstructorChatEventInviteLinkRevoked
+}
+
+func (*ChatEventInviteLinkRevoked) ChatEventActionConstructor() string {
+	return ConstructorChatEventInviteLinkRevoked
+}
+
+func (entity *ChatEventInviteLinkRevoked) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventInviteLinkRevoked) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventInviteLinkRevoked) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventInviteLinkRevoked"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	entity.InviteLink.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventInviteLinkRevoked) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventInviteLinkRevoked)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A revoked chat invite link was deleted
+type ChatEventInviteLinkDeleted struct {
+	meta
+	// The invite link
+	InviteLink *ChatInviteLink `json:"invite_link"`
+}
+
+func (*ChatEventInviteLinkDeleted) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventInviteLinkDeleted) GetConstructor() string {
+	return ConstructorChatEventInviteLinkDeleted
+}
+
+func (*ChatEventInviteLinkDeleted) ChatEventActionConstructor() string {
+	return ConstructorChatEventInviteLinkDeleted
+}
+
+func (entity *ChatEventInviteLinkDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventInviteLinkDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventInviteLinkDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventInviteLinkDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	entity.InviteLink.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventInviteLinkDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventInviteLinkDeleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video chat was created
+type ChatEventVideoChatCreated struct {
+	meta
+	// Identifier of the video chat. The video chat can be received through the method getGroupCall
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (*ChatEventVideoChatCreated) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventVideoChatCreated) GetConstructor() string {
+	return ConstructorChatEventVideoChatCreated
+}
+
+func (*ChatEventVideoChatCreated) ChatEventActionConstructor() string {
+	return ConstructorChatEventVideoChatCreated
+}
+
+func (entity *ChatEventVideoChatCreated) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventVideoChatCreated) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventVideoChatCreated) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventVideoChatCreated"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventVideoChatCreated) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventVideoChatCreated)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video chat was ended
+type ChatEventVideoChatEnded struct {
+	meta
+	// Identifier of the video chat. The video chat can be received through the method getGroupCall
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (*ChatEventVideoChatEnded) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventVideoChatEnded) GetConstructor() string {
+	return ConstructorChatEventVideoChatEnded
+}
+
+func (*ChatEventVideoChatEnded) ChatEventActionConstructor() string {
+	return ConstructorChatEventVideoChatEnded
+}
+
+func (entity *ChatEventVideoChatEnded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventVideoChatEnded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventVideoChatEnded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventVideoChatEnded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventVideoChatEnded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventVideoChatEnded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The mute_new_participants setting of a video chat was toggled
+type ChatEventVideoChatMuteNewParticipantsToggled struct {
+	meta
+	// New value of the mute_new_participants setting
+	MuteNewParticipants bool `json:"mute_new_participants"`
+}
+
+func (*ChatEventVideoChatMuteNewParticipantsToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventVideoChatMuteNewParticipantsToggled) GetConstructor() string {
+	return ConstructorChatEventVideoChatMuteNewParticipantsToggled
+}
+
+func (*ChatEventVideoChatMuteNewParticipantsToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventVideoChatMuteNewParticipantsToggled
+}
+
+func (entity *ChatEventVideoChatMuteNewParticipantsToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventVideoChatMuteNewParticipantsToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventVideoChatMuteNewParticipantsToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventVideoChatMuteNewParticipantsToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"mute_new_participants":`)
+	encoder.writeBool(entity.MuteNewParticipants)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventVideoChatMuteNewParticipantsToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "mute_new_participants":
+			entity.MuteNewParticipants, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventVideoChatMuteNewParticipantsToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video chat participant was muted or unmuted
+type ChatEventVideoChatParticipantIsMutedToggled struct {
+	meta
+	// Identifier of the affected group call participant
+	ParticipantId MessageSender `json:"participant_id"`
+	// New value of is_muted
+	IsMuted bool `json:"is_muted"`
+}
+
+func (*ChatEventVideoChatParticipantIsMutedToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventVideoChatParticipantIsMutedToggled) GetConstructor() string {
+	return ConstructorChatEventVideoChatParticipantIsMutedToggled
+}
+
+func (*ChatEventVideoChatParticipantIsMutedToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventVideoChatParticipantIsMutedToggled
+}
+
+func (entity *ChatEventVideoChatParticipantIsMutedToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventVideoChatParticipantIsMutedToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventVideoChatParticipantIsMutedToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventVideoChatParticipantIsMutedToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"participant_id":`)
+	encoder.writeType(entity.ParticipantId)
+	encoder.writeRaw(`,"is_muted":`)
+	encoder.writeBool(entity.IsMuted)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventVideoChatParticipantIsMutedToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "participant_id":
+			entity.ParticipantId, err = decodeMessageSender(decoder)
+
+		case "is_muted":
+			entity.IsMuted, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventVideoChatParticipantIsMutedToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video chat participant volume level was changed
+type ChatEventVideoChatParticipantVolumeLevelChanged struct {
+	meta
+	// Identifier of the affected group call participant
+	ParticipantId MessageSender `json:"participant_id"`
+	// New value of volume_level; 1-20000 in hundreds of percents
+	VolumeLevel int32 `json:"volume_level"`
+}
+
+func (*ChatEventVideoChatParticipantVolumeLevelChanged) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventVideoChatParticipantVolumeLevelChanged) GetConstructor() string {
+	return ConstructorChatEventVideoChatParticipantVolumeLevelChanged
+}
+
+func (*ChatEventVideoChatParticipantVolumeLevelChanged) ChatEventActionConstructor() string {
+	return ConstructorChatEventVideoChatParticipantVolumeLevelChanged
+}
+
+func (entity *ChatEventVideoChatParticipantVolumeLevelChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventVideoChatParticipantVolumeLevelChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventVideoChatParticipantVolumeLevelChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventVideoChatParticipantVolumeLevelChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"participant_id":`)
+	encoder.writeType(entity.ParticipantId)
+	encoder.writeRaw(`,"volume_level":`)
+	encoder.writeInt32(entity.VolumeLevel)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventVideoChatParticipantVolumeLevelChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "participant_id":
+			entity.ParticipantId, err = decodeMessageSender(decoder)
+
+		case "volume_level":
+			entity.VolumeLevel, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventVideoChatParticipantVolumeLevelChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The is_forum setting of a channel was toggled
+type ChatEventIsForumToggled struct {
+	meta
+	// New value of is_forum
+	IsForum bool `json:"is_forum"`
+}
+
+func (*ChatEventIsForumToggled) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventIsForumToggled) GetConstructor() string {
+	return ConstructorChatEventIsForumToggled
+}
+
+func (*ChatEventIsForumToggled) ChatEventActionConstructor() string {
+	return ConstructorChatEventIsForumToggled
+}
+
+func (entity *ChatEventIsForumToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventIsForumToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventIsForumToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventIsForumToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_forum":`)
+	encoder.writeBool(entity.IsForum)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventIsForumToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_forum":
+			entity.IsForum, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventIsForumToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new forum topic was created
+type ChatEventForumTopicCreated struct {
+	meta
+	// Information about the topic
+	TopicInfo *ForumTopicInfo `json:"topic_info"`
+}
+
+func (*ChatEventForumTopicCreated) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventForumTopicCreated) GetConstructor() string {
+	return ConstructorChatEventForumTopicCreated
+}
+
+func (*ChatEventForumTopicCreated) ChatEventActionConstructor() string {
+	return ConstructorChatEventForumTopicCreated
+}
+
+func (entity *ChatEventForumTopicCreated) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventForumTopicCreated) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventForumTopicCreated) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventForumTopicCreated"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"topic_info":`)
+	entity.TopicInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventForumTopicCreated) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "topic_info":
+			entity.TopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventForumTopicCreated)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forum topic was edited
+type ChatEventForumTopicEdited struct {
+	meta
+	// Old information about the topic
+	OldTopicInfo *ForumTopicInfo `json:"old_topic_info"`
+	// New information about the topic
+	NewTopicInfo *ForumTopicInfo `json:"new_topic_info"`
+}
+
+func (*ChatEventForumTopicEdited) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventForumTopicEdited) GetConstructor() string {
+	return ConstructorChatEventForumTopicEdited
+}
+
+func (*ChatEventForumTopicEdited) ChatEventActionConstructor() string {
+	return ConstructorChatEventForumTopicEdited
+}
+
+func (entity *ChatEventForumTopicEdited) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventForumTopicEdited) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventForumTopicEdited) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventForumTopicEdited"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_topic_info":`)
+	entity.OldTopicInfo.encodeJSON(encoder)
+	encoder.writeRaw(`,"new_topic_info":`)
+	entity.NewTopicInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventForumTopicEdited) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_topic_info":
+			entity.OldTopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		case "new_topic_info":
+			entity.NewTopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventForumTopicEdited)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forum topic was closed or reopened
+type ChatEventForumTopicToggleIsClosed struct {
+	meta
+	// New information about the topic
+	TopicInfo *ForumTopicInfo `json:"topic_info"`
+}
+
+func (*ChatEventForumTopicToggleIsClosed) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventForumTopicToggleIsClosed) GetConstructor() string {
+	return ConstructorChatEventForumTopicToggleIsClosed
+}
+
+func (*ChatEventForumTopicToggleIsClosed) ChatEventActionConstructor() string {
+	return ConstructorChatEventForumTopicToggleIsClosed
+}
+
+func (entity *ChatEventForumTopicToggleIsClosed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventForumTopicToggleIsClosed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventForumTopicToggleIsClosed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventForumTopicToggleIsClosed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"topic_info":`)
+	entity.TopicInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventForumTopicToggleIsClosed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "topic_info":
+			entity.TopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventForumTopicToggleIsClosed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The General forum topic was hidden or unhidden
+type ChatEventForumTopicToggleIsHidden struct {
+	meta
+	// New information about the topic
+	TopicInfo *ForumTopicInfo `json:"topic_info"`
+}
+
+func (*ChatEventForumTopicToggleIsHidden) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventForumTopicToggleIsHidden) GetConstructor() string {
+	return ConstructorChatEventForumTopicToggleIsHidden
+}
+
+func (*ChatEventForumTopicToggleIsHidden) ChatEventActionConstructor() string {
+	return ConstructorChatEventForumTopicToggleIsHidden
+}
+
+func (entity *ChatEventForumTopicToggleIsHidden) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventForumTopicToggleIsHidden) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventForumTopicToggleIsHidden) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventForumTopicToggleIsHidden"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"topic_info":`)
+	entity.TopicInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventForumTopicToggleIsHidden) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "topic_info":
+			entity.TopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventForumTopicToggleIsHidden)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forum topic was deleted
+type ChatEventForumTopicDeleted struct {
+	meta
+	// Information about the topic
+	TopicInfo *ForumTopicInfo `json:"topic_info"`
+}
+
+func (*ChatEventForumTopicDeleted) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventForumTopicDeleted) GetConstructor() string {
+	return ConstructorChatEventForumTopicDeleted
+}
+
+func (*ChatEventForumTopicDeleted) ChatEventActionConstructor() string {
+	return ConstructorChatEventForumTopicDeleted
+}
+
+func (entity *ChatEventForumTopicDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventForumTopicDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventForumTopicDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventForumTopicDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"topic_info":`)
+	entity.TopicInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventForumTopicDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "topic_info":
+			entity.TopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventForumTopicDeleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A pinned forum topic was changed
+type ChatEventForumTopicPinned struct {
+	meta
+	// Information about the old pinned topic; may be null
+	OldTopicInfo *ForumTopicInfo `json:"old_topic_info,omitempty"`
+	// Information about the new pinned topic; may be null
+	NewTopicInfo *ForumTopicInfo `json:"new_topic_info,omitempty"`
+}
+
+func (*ChatEventForumTopicPinned) GetType() string {
+	return TypeChatEventAction
+}
+
+func (*ChatEventForumTopicPinned) GetConstructor() string {
+	return ConstructorChatEventForumTopicPinned
+}
+
+func (*ChatEventForumTopicPinned) ChatEventActionConstructor() string {
+	return ConstructorChatEventForumTopicPinned
+}
+
+func (entity *ChatEventForumTopicPinned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventForumTopicPinned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventForumTopicPinned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventForumTopicPinned"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.OldTopicInfo != nil {
+		encoder.writeRaw(`,"old_topic_info":`)
+		entity.OldTopicInfo.encodeJSON(encoder)
+	}
+	if entity.NewTopicInfo != nil {
+		encoder.writeRaw(`,"new_topic_info":`)
+		entity.NewTopicInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventForumTopicPinned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_topic_info":
+			entity.OldTopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		case "new_topic_info":
+			entity.NewTopicInfo, err = decodeEntity[ForumTopicInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventForumTopicPinned)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a chat event
+type ChatEvent struct {
+	meta
+	// Chat event identifier
+	Id JsonInt64 `json:"id"`
+	// Point in time (Unix timestamp) when the event happened
+	Date int32 `json:"date"`
+	// Identifier of the user or chat who performed the action
+	MemberId MessageSender `json:"member_id"`
+	// The action
+	Action ChatEventAction `json:"action"`
+}
+
+func (*ChatEvent) GetType() string {
+	return TypeChatEvent
+}
+
+func (*ChatEvent) GetConstructor() string {
+	return ConstructorChatEvent
+}
+
+func (entity *ChatEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEvent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEvent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"member_id":`)
+	encoder.writeType(entity.MemberId)
+	encoder.writeRaw(`,"action":`)
+	encoder.writeType(entity.Action)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEvent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "member_id":
+			entity.MemberId, err = decodeMessageSender(decoder)
+
+		case "action":
+			entity.Action, err = decodeChatEventAction(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEvent)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEvent) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("action", entity.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of chat events
+type ChatEvents struct {
+	meta
+	// List of events
+	Events []*ChatEvent `json:"events"`
+}
+
+func (*ChatEvents) GetType() string {
+	return TypeChatEvents
+}
+
+func (*ChatEvents) GetConstructor() string {
+	return ConstructorChatEvents
+}
+
+func (entity *ChatEvents) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEvents) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEvents) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEvents"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"events":`)
+	writeList(encoder, entity.Events, writeTypeValue[*ChatEvent])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEvents) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "events":
+			entity.Events, err = readList(decoder, decodeEntity[ChatEvent])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEvents)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ChatEvents) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Events {
+		if err := validateNested(indexField("events", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Represents a set of filters used to obtain a chat event log
+type ChatEventLogFilters struct {
+	meta
+	// True, if message edits need to be returned
+	MessageEdits bool `json:"message_edits"`
+	// True, if message deletions need to be returned
+	MessageDeletions bool `json:"message_deletions"`
+	// True, if pin/unpin events need to be returned
+	MessagePins bool `json:"message_pins"`
+	// True, if members joining events need to be returned
+	MemberJoins bool `json:"member_joins"`
+	// True, if members leaving events need to be returned
+	MemberLeaves bool `json:"member_leaves"`
+	// True, if invited member events need to be returned
+	MemberInvites bool `json:"member_invites"`
+	// True, if member promotion/demotion events need to be returned
+	MemberPromotions bool `json:"member_promotions"`
+	// True, if member restricted/unrestricted/banned/unbanned events need to be returned
+	MemberRestrictions bool `json:"member_restrictions"`
+	// True, if changes in chat information need to be returned
+	InfoChanges bool `json:"info_changes"`
+	// True, if changes in chat settings need to be returned
+	SettingChanges bool `json:"setting_changes"`
+	// True, if changes to invite links need to be returned
+	InviteLinkChanges bool `json:"invite_link_changes"`
+	// True, if video chat actions need to be returned
+	VideoChatChanges bool `json:"video_chat_changes"`
+	// True, if forum-related actions need to be returned
+	ForumChanges bool `json:"forum_changes"`
+	// True, if subscription extensions need to be returned
+	SubscriptionExtensions bool `json:"subscription_extensions"`
+}
+
+func (*ChatEventLogFilters) GetType() string {
+	return TypeChatEventLogFilters
+}
+
+func (*ChatEventLogFilters) GetConstructor() string {
+	return ConstructorChatEventLogFilters
+}
+
+func (entity *ChatEventLogFilters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatEventLogFilters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatEventLogFilters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatEventLogFilters"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_edits":`)
+	encoder.writeBool(entity.MessageEdits)
+	encoder.writeRaw(`,"message_deletions":`)
+	encoder.writeBool(entity.MessageDeletions)
+	encoder.writeRaw(`,"message_pins":`)
+	encoder.writeBool(entity.MessagePins)
+	encoder.writeRaw(`,"member_joins":`)
+	encoder.writeBool(entity.MemberJoins)
+	encoder.writeRaw(`,"member_leaves":`)
+	encoder.writeBool(entity.MemberLeaves)
+	encoder.writeRaw(`,"member_invites":`)
+	encoder.writeBool(entity.MemberInvites)
+	encoder.writeRaw(`,"member_promotions":`)
+	encoder.writeBool(entity.MemberPromotions)
+	encoder.writeRaw(`,"member_restrictions":`)
+	encoder.writeBool(entity.MemberRestrictions)
+	encoder.writeRaw(`,"info_changes":`)
+	encoder.writeBool(entity.InfoChanges)
+	encoder.writeRaw(`,"setting_changes":`)
+	encoder.writeBool(entity.SettingChanges)
+	encoder.writeRaw(`,"invite_link_changes":`)
+	encoder.writeBool(entity.InviteLinkChanges)
+	encoder.writeRaw(`,"video_chat_changes":`)
+	encoder.writeBool(entity.VideoChatChanges)
+	encoder.writeRaw(`,"forum_changes":`)
+	encoder.writeBool(entity.ForumChanges)
+	encoder.writeRaw(`,"subscription_extensions":`)
+	encoder.writeBool(entity.SubscriptionExtensions)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatEventLogFilters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_edits":
+			entity.MessageEdits, err = decoder.readBool()
+
+		case "message_deletions":
+			entity.MessageDeletions, err = decoder.readBool()
+
+		case "message_pins":
+			entity.MessagePins, err = decoder.readBool()
+
+		case "member_joins":
+			entity.MemberJoins, err = decoder.readBool()
+
+		case "member_leaves":
+			entity.MemberLeaves, err = decoder.readBool()
+
+		case "member_invites":
+			entity.MemberInvites, err = decoder.readBool()
+
+		case "member_promotions":
+			entity.MemberPromotions, err = decoder.readBool()
+
+		case "member_restrictions":
+			entity.MemberRestrictions, err = decoder.readBool()
+
+		case "info_changes":
+			entity.InfoChanges, err = decoder.readBool()
+
+		case "setting_changes":
+			entity.SettingChanges, err = decoder.readBool()
+
+		case "invite_link_changes":
+			entity.InviteLinkChanges, err = decoder.readBool()
+
+		case "video_chat_changes":
+			entity.VideoChatChanges, err = decoder.readBool()
+
+		case "forum_changes":
+			entity.ForumChanges, err = decoder.readBool()
+
+		case "subscription_extensions":
+			entity.SubscriptionExtensions, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatEventLogFilters)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a chat theme
+type ChatTheme struct {
+	meta
+	// Theme name
+	Name string `json:"name"`
+	// Theme settings for a light chat theme
+	LightSettings *ThemeSettings `json:"light_settings"`
+	// Theme settings for a dark chat theme
+	DarkSettings *ThemeSettings `json:"dark_settings"`
+}
+
+func (*ChatTheme) GetType() string {
+	return TypeChatTheme
+}
+
+func (*ChatTheme) GetConstructor() string {
+	return ConstructorChatTheme
+}
+
+func (entity *ChatTheme) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatTheme) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatTheme) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatTheme"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"light_settings":`)
+	entity.LightSettings.encodeJSON(encoder)
+	encoder.writeRaw(`,"dark_settings":`)
+	entity.DarkSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatTheme) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "light_settings":
+			entity.LightSettings, err = decodeEntity[ThemeSettings](decoder)
+
+		case "dark_settings":
+			entity.DarkSettings, err = decodeEntity[ThemeSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatTheme)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The username can be set
+type CheckChatUsernameResultOk struct {
+	meta
+}
+
+func (*CheckChatUsernameResultOk) GetType() string {
+	return TypeCheckChatUsernameResult
+}
+
+func (*CheckChatUsernameResultOk) GetConstructor() string {
+	return ConstructorCheckChatUsernameResultOk
+}
+
+func (*CheckChatUsernameResultOk) CheckChatUsernameResultConstructor() string {
+	return ConstructorCheckChatUsernameResultOk
+}
+
+func (entity *CheckChatUsernameResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckChatUsernameResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckChatUsernameResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatUsernameResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckChatUsernameResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckChatUsernameResultOk)
+	})
+}
+
+// The username is invalid
+type CheckChatUsernameResultUsernameInvalid struct {
+	meta
+}
+
+func (*CheckChatUsernameResultUsernameInvalid) GetType() string {
+	return TypeCheckChatUsernameResult
+}
+
+func (*CheckChatUsernameResultUsernameInvalid) GetConstructor() string {
+	return ConstructorCheckChatUsernameResultUsernameInvalid
+}
+
+func (*CheckChatUsernameResultUsernameInvalid) CheckChatUsernameResultConstructor() string {
+	return ConstructorCheckChatUsernameResultUsernameInvalid
+}
+
+func (entity *CheckChatUsernameResultUsernameInvalid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckChatUsernameResultUsernameInvalid) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckChatUsernameResultUsernameInvalid) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatUsernameResultUsernameInvalid"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckChatUsernameResultUsernameInvalid) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckChatUsernameResultUsernameInvalid)
+	})
+}
+
+// The username is occupied
+type CheckChatUsernameResultUsernameOccupied struct {
+	meta
+}
+
+func (*CheckChatUsernameResultUsernameOccupied) GetType() string {
+	return TypeCheckChatUsernameResult
+}
+
+func (*CheckChatUsernameResultUsernameOccupied) GetConstructor() string {
+	return ConstructorCheckChatUsernameResultUsernameOccupied
+}
+
+func (*CheckChatUsernameResultUsernameOccupied) CheckChatUsernameResultConstructor() string {
+	return ConstructorCheckChatUsernameResultUsernameOccupied
+}
+
+func (entity *CheckChatUsernameResultUsernameOccupied) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckChatUsernameResultUsernameOccupied) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckChatUsernameResultUsernameOccupied) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatUsernameResultUsernameOccupied"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckChatUsernameResultUsernameOccupied) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckChatUsernameResultUsernameOccupied)
+	})
+}
+
+// The username can be purchased at https://fragment.com. Information about the username can be received using getCollectibleItemInfo
+type CheckChatUsernameResultUsernamePurchasable struct {
+	meta
+}
+
+func (*CheckChatUsernameResultUsernamePurchasable) GetType() string {
+	return TypeCheckChatUsernameResult
+}
+
+func (*CheckChatUsernameResultUsernamePurchasable) GetConstructor() string {
+	return ConstructorCheckChatUsernameResultUsernamePurchasable
+}
+
+func (*CheckChatUsernameResultUsernamePurchasable) CheckChatUsernameResultConstructor() string {
+	return ConstructorCheckChatUsernameResultUsernamePurchasable
+}
+
+func (entity *CheckChatUsernameResultUsernamePurchasable) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckChatUsernameResultUsernamePurchasable) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckChatUsernameResultUsernamePurchasable) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatUsernameResultUsernamePurchasable"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckChatUsernameResultUsernamePurchasable) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckChatUsernameResultUsernamePurchasable)
+	})
+}
+
+// The user has too many chats with username, one of them must be made private first
+type CheckChatUsernameResultPublicChatsTooMany struct {
+	meta
+}
+
+func (*CheckChatUsernameResultPublicChatsTooMany) GetType() string {
+	return TypeCheckChatUsernameResult
+}
+
+func (*CheckChatUsernameResultPublicChatsTooMany) GetConstructor() string {
+	return ConstructorCheckChatUsernameResultPublicChatsTooMany
+}
+
+func (*CheckChatUsernameResultPublicChatsTooMany) CheckChatUsernameResultConstructor() string {
+	return ConstructorCheckChatUsernameResultPublicChatsTooMany
+}
+
+func (entity *CheckChatUsernameResultPublicChatsTooMany) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckChatUsernameResultPublicChatsTooMany) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckChatUsernameResultPublicChatsTooMany) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatUsernameResultPublicChatsTooMany"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckChatUsernameResultPublicChatsTooMany) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckChatUsernameResultPublicChatsTooMany)
+	})
+}
+
+// The user can't be a member of a public supergroup
+type CheckChatUsernameResultPublicGroupsUnavailable struct {
+	meta
+}
+
+func (*CheckChatUsernameResultPublicGroupsUnavailable) GetType() string {
+	return TypeCheckChatUsernameResult
+}
+
+func (*CheckChatUsernameResultPublicGroupsUnavailable) GetConstructor() string {
+	return ConstructorCheckChatUsernameResultPublicGroupsUnavailable
+}
+
+func (*CheckChatUsernameResultPublicGroupsUnavailable) CheckChatUsernameResultConstructor() string {
+	return ConstructorCheckChatUsernameResultPublicGroupsUnavailable
+}
+
+func (entity *CheckChatUsernameResultPublicGroupsUnavailable) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckChatUsernameResultPublicGroupsUnavailable) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckChatUsernameResultPublicGroupsUnavailable) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkChatUsernameResultPublicGroupsUnavailable"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckChatUsernameResultPublicGroupsUnavailable) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckChatUsernameResultPublicGroupsUnavailable)
+	})
+}
+
+// A group containing notifications of type notificationTypeNewMessage and notificationTypeNewPushMessage with ordinary unread messages
+type NotificationGroupTypeMessages struct {
+	meta
+}
+
+func (*NotificationGroupTypeMessages) GetType() string {
+	return TypeNotificationGroupType
+}
+
+func (*NotificationGroupTypeMessages) GetConstructor() string {
+	return ConstructorNotificationGroupTypeMessages
+}
+
+func (*NotificationGroupTypeMessages) NotificationGroupTypeConstructor() string {
+	return ConstructorNotificationGroupTypeMessages
+}
+
+func (entity *NotificationGroupTypeMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationGroupTypeMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationGroupTypeMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationGroupTypeMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationGroupTypeMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationGroupTypeMessages)
+	})
+}
+
+// A group containing notifications of type notificationTypeNewMessage and notificationTypeNewPushMessage with unread mentions of the current user, replies to their messages, or a pinned message
+type NotificationGroupTypeMentions struct {
+	meta
+}
+
+func (*NotificationGroupTypeMentions) GetType() string {
+	return TypeNotificationGroupType
+}
+
+func (*NotificationGroupTypeMentions) GetConstructor() string {
+	return ConstructorNotificationGroupTypeMentions
+}
+
+func (*NotificationGroupTypeMentions) NotificationGroupTypeConstructor() string {
+	return ConstructorNotificationGroupTypeMentions
+}
+
+func (entity *NotificationGroupTypeMentions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationGroupTypeMentions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationGroupTypeMentions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationGroupTypeMentions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationGroupTypeMentions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationGroupTypeMentions)
+	})
+}
+
+// A group containing a notification of type notificationTypeNewSecretChat
+type NotificationGroupTypeSecretChat struct {
+	meta
+}
+
+func (*NotificationGroupTypeSecretChat) GetType() string {
+	return TypeNotificationGroupType
+}
+
+func (*NotificationGroupTypeSecretChat) GetConstructor() string {
+	return ConstructorNotificationGroupTypeSecretChat
+}
+
+func (*NotificationGroupTypeSecretChat) NotificationGroupTypeConstructor() string {
+	return ConstructorNotificationGroupTypeSecretChat
+}
+
+func (entity *NotificationGroupTypeSecretChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationGroupTypeSecretChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationGroupTypeSecretChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationGroupTypeSecretChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationGroupTypeSecretChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationGroupTypeSecretChat)
+	})
+}
+
+// A group containing notifications of type notificationTypeNewCall
+type NotificationGroupTypeCalls struct {
+	meta
+}
+
+func (*NotificationGroupTypeCalls) GetType() string {
+	return TypeNotificationGroupType
+}
+
+func (*NotificationGroupTypeCalls) GetConstructor() string {
+	return ConstructorNotificationGroupTypeCalls
+}
+
+func (*NotificationGroupTypeCalls) NotificationGroupTypeConstructor() string {
+	return ConstructorNotificationGroupTypeCalls
+}
+
+func (entity *NotificationGroupTypeCalls) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationGroupTypeCalls) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationGroupTypeCalls) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationGroupTypeCalls"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationGroupTypeCalls) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationGroupTypeCalls)
+	})
+}
+
+// Describes a group of notifications
+type NotificationGroup struct {
+	meta
+	// Unique persistent auto-incremented from 1 identifier of the notification group
+	Id int32 `json:"id"`
+	// Type of the group
+	Type NotificationGroupType `json:"type"`
+	// Identifier of a chat to which all notifications in the group belong
+	ChatId int64 `json:"chat_id"`
+	// Total number of active notifications in the group
+	TotalCount int32 `json:"total_count"`
+	// The list of active notifications
+	Notifications []*Notification `json:"notifications"`
+}
+
+func (*NotificationGroup) GetType() string {
+	return TypeNotificationGroup
+}
+
+func (*NotificationGroup) GetConstructor() string {
+	return ConstructorNotificationGroup
+}
+
+func (entity *NotificationGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"notifications":`)
+	writeList(encoder, entity.Notifications, writeTypeValue[*Notification])
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "type":
+			entity.Type, err = decodeNotificationGroupType(decoder)
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "notifications":
+			entity.Notifications, err = readList(decoder, decodeEntity[Notification])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNotificationGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *NotificationGroup) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Notifications {
+		if err := validateNested(indexField("notifications", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains privacy settings for chats with non-contacts
+type NewChatPrivacySettings struct {
+	meta
+	// True, if non-contacts users are able to write first to the current user. Telegram Premium subscribers are able to write first regardless of this setting
+	AllowNewChatsFromUnknownUsers bool `json:"allow_new_chats_from_unknown_users"`
+	// Number of Telegram Stars that must be paid for every incoming private message by non-contacts; 0-getOption("paid_message_star_count_max"). If positive, then allow_new_chats_from_unknown_users must be true. The current user will receive getOption("paid_message_earnings_per_mille") Telegram Stars for each 1000 Telegram Stars paid for message sending. Can be positive, only if getOption("can_enable_paid_messages") is true
+	IncomingPaidMessageStarCount int64 `json:"incoming_paid_message_star_count"`
+}
+
+func (*NewChatPrivacySettings) GetType() string {
+	return TypeNewChatPrivacySettings
+}
+
+func (*NewChatPrivacySettings) GetConstructor() string {
+	return ConstructorNewChatPrivacySettings
+}
+
+func (entity *NewChatPrivacySettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NewChatPrivacySettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NewChatPrivacySettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"newChatPrivacySettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"allow_new_chats_from_unknown_users":`)
+	encoder.writeBool(entity.AllowNewChatsFromUnknownUsers)
+	encoder.writeRaw(`,"incoming_paid_message_star_count":`)
+	encoder.writeInt64(entity.IncomingPaidMessageStarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *NewChatPrivacySettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "allow_new_chats_from_unknown_users":
+			entity.AllowNewChatsFromUnknownUsers, err = decoder.readBool()
+
+		case "incoming_paid_message_star_count":
+			entity.IncomingPaidMessageStarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNewChatPrivacySettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat was reported successfully
+type ReportChatResultOk struct {
+	meta
+}
+
+func (*ReportChatResultOk) GetType() string {
+	return TypeReportChatResult
+}
+
+func (*ReportChatResultOk) GetConstructor() string {
+	return ConstructorReportChatResultOk
+}
+
+func (*ReportChatResultOk) ReportChatResultConstructor() string {
+	return ConstructorReportChatResultOk
+}
+
+func (entity *ReportChatResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportChatResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportChatResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportChatResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportChatResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportChatResultOk)
+	})
+}
+
+// The user must choose an option to report the chat and repeat request with the chosen option
+type ReportChatResultOptionRequired struct {
+	meta
+	// Title for the option choice
+	Title string `json:"title"`
+	// List of available options
+	Options []*ReportOption `json:"options"`
+}
+
+func (*ReportChatResultOptionRequired) GetType() string {
+	return TypeReportChatResult
+}
+
+func (*ReportChatResultOptionRequired) GetConstructor() string {
+	return ConstructorReportChatResultOptionRequired
+}
+
+func (*ReportChatResultOptionRequired) ReportChatResultConstructor() string {
+	return ConstructorReportChatResultOptionRequired
+}
+
+func (entity *ReportChatResultOptionRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportChatResultOptionRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportChatResultOptionRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportChatResultOptionRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*ReportOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportChatResultOptionRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[ReportOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReportChatResultOptionRequired)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user must add additional text details to the report
+type ReportChatResultTextRequired struct {
+	meta
+	// Option identifier for the next reportChat request
+	OptionId []byte `json:"option_id"`
+	// True, if the user can skip text adding
+	IsOptional bool `json:"is_optional"`
+}
+
+func (*ReportChatResultTextRequired) GetType() string {
+	return TypeReportChatResult
+}
+
+func (*ReportChatResultTextRequired) GetConstructor() string {
+	return ConstructorReportChatResultTextRequired
+}
+
+func (*ReportChatResultTextRequired) ReportChatResultConstructor() string {
+	return ConstructorReportChatResultTextRequired
+}
+
+func (entity *ReportChatResultTextRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportChatResultTextRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportChatResultTextRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportChatResultTextRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"option_id":`)
+	encoder.writeBytes(entity.OptionId)
+	encoder.writeRaw(`,"is_optional":`)
+	encoder.writeBool(entity.IsOptional)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportChatResultTextRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "option_id":
+			entity.OptionId, err = decoder.readBytes()
+
+		case "is_optional":
+			entity.IsOptional, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReportChatResultTextRequired)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user must choose messages to report and repeat the reportChat request with the chosen messages
+type ReportChatResultMessagesRequired struct {
+	meta
+}
+
+func (*ReportChatResultMessagesRequired) GetType() string {
+	return TypeReportChatResult
+}
+
+func (*ReportChatResultMessagesRequired) GetConstructor() string {
+	return ConstructorReportChatResultMessagesRequired
+}
+
+func (*ReportChatResultMessagesRequired) ReportChatResultConstructor() string {
+	return ConstructorReportChatResultMessagesRequired
+}
+
+func (entity *ReportChatResultMessagesRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportChatResultMessagesRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportChatResultMessagesRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportChatResultMessagesRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportChatResultMessagesRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportChatResultMessagesRequired)
+	})
+}
+
+// Contains the storage usage statistics for a specific chat
+type StorageStatisticsByChat struct {
+	meta
+	// Chat identifier; 0 if none
+	ChatId int64 `json:"chat_id"`
+	// Total size of the files in the chat, in bytes
+	Size int64 `json:"size"`
+	// Total number of files in the chat
+	Count int32 `json:"count"`
+	// Statistics split by file types
+	ByFileType []*StorageStatisticsByFileType `json:"by_file_type"`
+}
+
+func (*StorageStatisticsByChat) GetType() string {
+	return TypeStorageStatisticsByChat
+}
+
+func (*StorageStatisticsByChat) GetConstructor() string {
+	return ConstructorStorageStatisticsByChat
+}
+
+func (entity *StorageStatisticsByChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorageStatisticsByChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorageStatisticsByChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storageStatisticsByChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"size":`)
+	encoder.writeInt64(entity.Size)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt32(entity.Count)
+	encoder.writeRaw(`,"by_file_type":`)
+	writeList(encoder, entity.ByFileType, writeTypeValue[*StorageStatisticsByFileType])
+	encoder.writeRaw("}")
+}
+
+func (entity *StorageStatisticsByChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "size":
+			entity.Size, err = decoder.readInt64()
+
+		case "count":
+			entity.Count, err = decoder.readInt32()
+
+		case "by_file_type":
+			entity.ByFileType, err = readList(decoder, decodeEntity[StorageStatisticsByFileType])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorageStatisticsByChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A category containing frequently used private chats with non-bot users
+type TopChatCategoryUsers struct {
+	meta
+}
+
+func (*TopChatCategoryUsers) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryUsers) GetConstructor() string {
+	return ConstructorTopChatCategoryUsers
+}
+
+func (*TopChatCategoryUsers) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryUsers
+}
+
+func (entity *TopChatCategoryUsers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryUsers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryUsers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryUsers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryUsers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryUsers)
+	})
+}
+
+// A category containing frequently used private chats with bot users
+type TopChatCategoryBots struct {
+	meta
+}
+
+func (*TopChatCategoryBots) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryBots) GetConstructor() string {
+	return ConstructorTopChatCategoryBots
+}
+
+func (*TopChatCategoryBots) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryBots
+}
+
+func (entity *TopChatCategoryBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryBots)
+	})
+}
+
+// A category containing frequently used basic groups and supergroups
+type TopChatCategoryGroups struct {
+	meta
+}
+
+func (*TopChatCategoryGroups) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryGroups) GetConstructor() string {
+	return ConstructorTopChatCategoryGroups
+}
+
+func (*TopChatCategoryGroups) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryGroups
+}
+
+func (entity *TopChatCategoryGroups) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryGroups) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryGroups) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryGroups"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryGroups) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryGroups)
+	})
+}
+
+// A category containing frequently used channels
+type TopChatCategoryChannels struct {
+	meta
+}
+
+func (*TopChatCategoryChannels) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryChannels) GetConstructor() string {
+	return ConstructorTopChatCategoryChannels
+}
+
+func (*TopChatCategoryChannels) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryChannels
+}
+
+func (entity *TopChatCategoryChannels) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryChannels) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryChannels) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryChannels"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryChannels) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryChannels)
+	})
+}
+
+// A category containing frequently used chats with inline bots sorted by their usage in inline mode
+type TopChatCategoryInlineBots struct {
+	meta
+}
+
+func (*TopChatCategoryInlineBots) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryInlineBots) GetConstructor() string {
+	return ConstructorTopChatCategoryInlineBots
+}
+
+func (*TopChatCategoryInlineBots) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryInlineBots
+}
+
+func (entity *TopChatCategoryInlineBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryInlineBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryInlineBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryInlineBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryInlineBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryInlineBots)
+	})
+}
+
+// A category containing frequently used chats with bots, which Web Apps were opened
+type TopChatCategoryWebAppBots struct {
+	meta
+}
+
+func (*TopChatCategoryWebAppBots) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryWebAppBots) GetConstructor() string {
+	return ConstructorTopChatCategoryWebAppBots
+}
+
+func (*TopChatCategoryWebAppBots) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryWebAppBots
+}
+
+func (entity *TopChatCategoryWebAppBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryWebAppBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryWebAppBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryWebAppBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryWebAppBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryWebAppBots)
+	})
+}
+
+// A category containing frequently used chats used for calls
+type TopChatCategoryCalls struct {
+	meta
+}
+
+func (*TopChatCategoryCalls) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryCalls) GetConstructor() string {
+	return ConstructorTopChatCategoryCalls
+}
+
+func (*TopChatCategoryCalls) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryCalls
+}
+
+func (entity *TopChatCategoryCalls) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryCalls) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryCalls) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryCalls"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryCalls) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryCalls)
+	})
+}
+
+// A category containing frequently used chats used to forward messages
+type TopChatCategoryForwardChats struct {
+	meta
+}
+
+func (*TopChatCategoryForwardChats) GetType() string {
+	return TypeTopChatCategory
+}
+
+func (*TopChatCategoryForwardChats) GetConstructor() string {
+	return ConstructorTopChatCategoryForwardChats
+}
+
+func (*TopChatCategoryForwardChats) TopChatCategoryConstructor() string {
+	return ConstructorTopChatCategoryForwardChats
+}
+
+func (entity *TopChatCategoryForwardChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TopChatCategoryForwardChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TopChatCategoryForwardChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"topChatCategoryForwardChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TopChatCategoryForwardChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTopChatCategoryForwardChats)
+	})
+}
+
+// Describes a message sent in the chat
+type ChatStatisticsObjectTypeMessage struct {
+	meta
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+}
+
+func (*ChatStatisticsObjectTypeMessage) GetType() string {
+	return TypeChatStatisticsObjectType
+}
+
+func (*ChatStatisticsObjectTypeMessage) GetConstructor() string {
+	return ConstructorChatStatisticsObjectTypeMessage
+}
+
+func (*ChatStatisticsObjectTypeMessage) ChatStatisticsObjectTypeConstructor() string {
+	return ConstructorChatStatisticsObjectTypeMessage
+}
+
+func (entity *ChatStatisticsObjectTypeMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsObjectTypeMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsObjectTypeMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsObjectTypeMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsObjectTypeMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsObjectTypeMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a story sent by the chat
+type ChatStatisticsObjectTypeStory struct {
+	meta
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+}
+
+func (*ChatStatisticsObjectTypeStory) GetType() string {
+	return TypeChatStatisticsObjectType
+}
+
+func (*ChatStatisticsObjectTypeStory) GetConstructor() string {
+	return ConstructorChatStatisticsObjectTypeStory
+}
+
+func (*ChatStatisticsObjectTypeStory) ChatStatisticsObjectTypeConstructor() string {
+	return ConstructorChatStatisticsObjectTypeStory
+}
+
+func (entity *ChatStatisticsObjectTypeStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsObjectTypeStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsObjectTypeStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsObjectTypeStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsObjectTypeStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsObjectTypeStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains statistics about interactions with a message sent in the chat or a story sent by the chat
+type ChatStatisticsInteractionInfo struct {
+	meta
+	// Type of the object
+	ObjectType ChatStatisticsObjectType `json:"object_type"`
+	// Number of times the object was viewed
+	ViewCount int32 `json:"view_count"`
+	// Number of times the object was forwarded
+	ForwardCount int32 `json:"forward_count"`
+	// Number of times reactions were added to the object
+	ReactionCount int32 `json:"reaction_count"`
+}
+
+func (*ChatStatisticsInteractionInfo) GetType() string {
+	return TypeChatStatisticsInteractionInfo
+}
+
+func (*ChatStatisticsInteractionInfo) GetConstructor() string {
+	return ConstructorChatStatisticsInteractionInfo
+}
+
+func (entity *ChatStatisticsInteractionInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsInteractionInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsInteractionInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsInteractionInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"object_type":`)
+	encoder.writeType(entity.ObjectType)
+	encoder.writeRaw(`,"view_count":`)
+	encoder.writeInt32(entity.ViewCount)
+	encoder.writeRaw(`,"forward_count":`)
+	encoder.writeInt32(entity.ForwardCount)
+	encoder.writeRaw(`,"reaction_count":`)
+	encoder.writeInt32(entity.ReactionCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsInteractionInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "object_type":
+			entity.ObjectType, err = decodeChatStatisticsObjectType(decoder)
+
+		case "view_count":
+			entity.ViewCount, err = decoder.readInt32()
+
+		case "forward_count":
+			entity.ForwardCount, err = decoder.readInt32()
+
+		case "reaction_count":
+			entity.ReactionCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsInteractionInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains statistics about administrator actions done by a user
+type ChatStatisticsAdministratorActionsInfo struct {
+	meta
+	// Administrator user identifier
+	UserId int64 `json:"user_id"`
+	// Number of messages deleted by the administrator
+	DeletedMessageCount int32 `json:"deleted_message_count"`
+	// Number of users banned by the administrator
+	BannedUserCount int32 `json:"banned_user_count"`
+	// Number of users restricted by the administrator
+	RestrictedUserCount int32 `json:"restricted_user_count"`
+}
+
+func (*ChatStatisticsAdministratorActionsInfo) GetType() string {
+	return TypeChatStatisticsAdministratorActionsInfo
+}
+
+func (*ChatStatisticsAdministratorActionsInfo) GetConstructor() string {
+	return ConstructorChatStatisticsAdministratorActionsInfo
+}
+
+func (entity *ChatStatisticsAdministratorActionsInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsAdministratorActionsInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsAdministratorActionsInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsAdministratorActionsInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"deleted_message_count":`)
+	encoder.writeInt32(entity.DeletedMessageCount)
+	encoder.writeRaw(`,"banned_user_count":`)
+	encoder.writeInt32(entity.BannedUserCount)
+	encoder.writeRaw(`,"restricted_user_count":`)
+	encoder.writeInt32(entity.RestrictedUserCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsAdministratorActionsInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "deleted_message_count":
+			entity.DeletedMessageCount, err = decoder.readInt32()
+
+		case "banned_user_count":
+			entity.BannedUserCount, err = decoder.readInt32()
+
+		case "restricted_user_count":
+			entity.RestrictedUserCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsAdministratorActionsInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains statistics about number of new members invited by a user
+type ChatStatisticsInviterInfo struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Number of new members invited by the user
+	AddedMemberCount int32 `json:"added_member_count"`
+}
+
+func (*ChatStatisticsInviterInfo) GetType() string {
+	return TypeChatStatisticsInviterInfo
+}
+
+func (*ChatStatisticsInviterInfo) GetConstructor() string {
+	return ConstructorChatStatisticsInviterInfo
+}
+
+func (entity *ChatStatisticsInviterInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsInviterInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsInviterInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsInviterInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"added_member_count":`)
+	encoder.writeInt32(entity.AddedMemberCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsInviterInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "added_member_count":
+			entity.AddedMemberCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsInviterInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A detailed statistics about a supergroup chat
+type ChatStatisticsSupergroup struct {
+	meta
+	// A period to which the statistics applies
+	Period *DateRange `json:"period"`
+	// Number of members in the chat
+	MemberCount *StatisticalValue `json:"member_count"`
+	// Number of messages sent to the chat
+	MessageCount *StatisticalValue `json:"message_count"`
+	// Number of users who viewed messages in the chat
+	ViewerCount *StatisticalValue `json:"viewer_count"`
+	// Number of users who sent messages to the chat
+	SenderCount *StatisticalValue `json:"sender_count"`
+	// A graph containing number of members in the chat
+	MemberCountGraph StatisticalGraph `json:"member_count_graph"`
+	// A graph containing number of members joined and left the chat
+	JoinGraph StatisticalGraph `json:"join_graph"`
+	// A graph containing number of new member joins per source
+	JoinBySourceGraph StatisticalGraph `json:"join_by_source_graph"`
+	// A graph containing distribution of active users per language
+	LanguageGraph StatisticalGraph `json:"language_graph"`
+	// A graph containing distribution of sent messages by content type
+	MessageContentGraph StatisticalGraph `json:"message_content_graph"`
+	// A graph containing number of different actions in the chat
+	ActionGraph StatisticalGraph `json:"action_graph"`
+	// A graph containing distribution of message views per hour
+	DayGraph StatisticalGraph `json:"day_graph"`
+	// A graph containing distribution of message views per day of week
+	WeekGraph StatisticalGraph `json:"week_graph"`
+	// List of users sent most messages in the last week
+	TopSenders []*ChatStatisticsMessageSenderInfo `json:"top_senders"`
+	// List of most active administrators in the last week
+	TopAdministrators []*ChatStatisticsAdministratorActionsInfo `json:"top_administrators"`
+	// List of most active inviters of new members in the last week
+	TopInviters []*ChatStatisticsInviterInfo `json:"top_inviters"`
+}
+
+func (*ChatStatisticsSupergroup) GetType() string {
+	return TypeChatStatistics
+}
+
+func (*ChatStatisticsSupergroup) GetConstructor() string {
+	return ConstructorChatStatisticsSupergroup
+}
+
+func (*ChatStatisticsSupergroup) ChatStatisticsConstructor() string {
+	return ConstructorChatStatisticsSupergroup
+}
+
+func (entity *ChatStatisticsSupergroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsSupergroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsSupergroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsSupergroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"period":`)
+	entity.Period.encodeJSON(encoder)
+	encoder.writeRaw(`,"member_count":`)
+	entity.MemberCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"message_count":`)
+	entity.MessageCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"viewer_count":`)
+	entity.ViewerCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"sender_count":`)
+	entity.SenderCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"member_count_graph":`)
+	encoder.writeType(entity.MemberCountGraph)
+	encoder.writeRaw(`,"join_graph":`)
+	encoder.writeType(entity.JoinGraph)
+	encoder.writeRaw(`,"join_by_source_graph":`)
+	encoder.writeType(entity.JoinBySourceGraph)
+	encoder.writeRaw(`,"language_graph":`)
+	encoder.writeType(entity.LanguageGraph)
+	encoder.writeRaw(`,"message_content_graph":`)
+	encoder.writeType(entity.MessageContentGraph)
+	encoder.writeRaw(`,"action_graph":`)
+	encoder.writeType(entity.ActionGraph)
+	encoder.writeRaw(`,"day_graph":`)
+	encoder.writeType(entity.DayGraph)
+	encoder.writeRaw(`,"week_graph":`)
+	encoder.writeType(entity.WeekGraph)
+	encoder.writeRaw(`,"top_senders":`)
+	writeList(encoder, entity.TopSenders, writeTypeValue[*ChatStatisticsMessageSenderInfo])
+	encoder.writeRaw(`,"top_administrators":`)
+	writeList(encoder, entity.TopAdministrators, writeTypeValue[*ChatStatisticsAdministratorActionsInfo])
+	encoder.writeRaw(`,"top_inviters":`)
+	writeList(encoder, entity.TopInviters, writeTypeValue[*ChatStatisticsInviterInfo])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsSupergroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "period":
+			entity.Period, err = decodeEntity[DateRange](decoder)
+
+		case "member_count":
+			entity.MemberCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "message_count":
+			entity.MessageCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "viewer_count":
+			entity.ViewerCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "sender_count":
+			entity.SenderCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "member_count_graph":
+			entity.MemberCountGraph, err = decodeStatisticalGraph(decoder)
+
+		case "join_graph":
+			entity.JoinGraph, err = decodeStatisticalGraph(decoder)
+
+		case "join_by_source_graph":
+			entity.JoinBySourceGraph, err = decodeStatisticalGraph(decoder)
+
+		case "language_graph":
+			entity.LanguageGraph, err = decodeStatisticalGraph(decoder)
+
+		case "message_content_graph":
+			entity.MessageContentGraph, err = decodeStatisticalGraph(decoder)
+
+		case "action_graph":
+			entity.ActionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "day_graph":
+			entity.DayGraph, err = decodeStatisticalGraph(decoder)
+
+		case "week_graph":
+			entity.WeekGraph, err = decodeStatisticalGraph(decoder)
+
+		case "top_senders":
+			entity.TopSenders, err = readList(decoder, decodeEntity[ChatStatisticsMessageSenderInfo])
+
+		case "top_administrators":
+			entity.TopAdministrators, err = readList(decoder, decodeEntity[ChatStatisticsAdministratorActionsInfo])
+
+		case "top_inviters":
+			entity.TopInviters, err = readList(decoder, decodeEntity[ChatStatisticsInviterInfo])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsSupergroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A detailed statistics about a channel chat
+type ChatStatisticsChannel struct {
+	meta
+	// A period to which the statistics applies
+	Period *DateRange `json:"period"`
+	// Number of members in the chat
+	MemberCount *StatisticalValue `json:"member_count"`
+	// Mean number of times the recently sent messages were viewed
+	MeanMessageViewCount *StatisticalValue `json:"mean_message_view_count"`
+	// Mean number of times the recently sent messages were shared
+	MeanMessageShareCount *StatisticalValue `json:"mean_message_share_count"`
+	// Mean number of times reactions were added to the recently sent messages
+	MeanMessageReactionCount *StatisticalValue `json:"mean_message_reaction_count"`
+	// Mean number of times the recently sent stories were viewed
+	MeanStoryViewCount *StatisticalValue `json:"mean_story_view_count"`
+	// Mean number of times the recently sent stories were shared
+	MeanStoryShareCount *StatisticalValue `json:"mean_story_share_count"`
+	// Mean number of times reactions were added to the recently sent stories
+	MeanStoryReactionCount *StatisticalValue `json:"mean_story_reaction_count"`
+	// A percentage of users with enabled notifications for the chat; 0-100
+	EnabledNotificationsPercentage float64 `json:"enabled_notifications_percentage"`
+	// A graph containing number of members in the chat
+	MemberCountGraph StatisticalGraph `json:"member_count_graph"`
+	// A graph containing number of members joined and left the chat
+	JoinGraph StatisticalGraph `json:"join_graph"`
+	// A graph containing number of members muted and unmuted the chat
+	MuteGraph StatisticalGraph `json:"mute_graph"`
+	// A graph containing number of message views in a given hour in the last two weeks
+	ViewCountByHourGraph StatisticalGraph `json:"view_count_by_hour_graph"`
+	// A graph containing number of message views per source
+	ViewCountBySourceGraph StatisticalGraph `json:"view_count_by_source_graph"`
+	// A graph containing number of new member joins per source
+	JoinBySourceGraph StatisticalGraph `json:"join_by_source_graph"`
+	// A graph containing number of users viewed chat messages per language
+	LanguageGraph StatisticalGraph `json:"language_graph"`
+	// A graph containing number of chat message views and shares
+	MessageInteractionGraph StatisticalGraph `json:"message_interaction_graph"`
+	// A graph containing number of reactions on messages
+	MessageReactionGraph StatisticalGraph `json:"message_reaction_graph"`
+	// A graph containing number of story views and shares
+	StoryInteractionGraph StatisticalGraph `json:"story_interaction_graph"`
+	// A graph containing number of reactions on stories
+	StoryReactionGraph StatisticalGraph `json:"story_reaction_graph"`
+	// A graph containing number of views of associated with the chat instant views
+	InstantViewInteractionGraph StatisticalGraph `json:"instant_view_interaction_graph"`
+	// Detailed statistics about number of views and shares of recently sent messages and stories
+	RecentInteractions []*ChatStatisticsInteractionInfo `json:"recent_interactions"`
+}
+
+func (*ChatStatisticsChannel) GetType() string {
+	return TypeChatStatistics
+}
+
+func (*ChatStatisticsChannel) GetConstructor() string {
+	return ConstructorChatStatisticsChannel
+}
+
+func (*ChatStatisticsChannel) ChatStatisticsConstructor() string {
+	return ConstructorChatStatisticsChannel
+}
+
+func (entity *ChatStatisticsChannel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsChannel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsChannel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"period":`)
+	entity.Period.encodeJSON(encoder)
+	encoder.writeRaw(`,"member_count":`)
+	entity.MemberCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"mean_message_view_count":`)
+	entity.MeanMessageViewCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"mean_message_share_count":`)
+	entity.MeanMessageShareCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"mean_message_reaction_count":`)
+	entity.MeanMessageReactionCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"mean_story_view_count":`)
+	entity.MeanStoryViewCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"mean_story_share_count":`)
+	entity.MeanStoryShareCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"mean_story_reaction_count":`)
+	entity.MeanStoryReactionCount.encodeJSON(encoder)
+	encoder.writeRaw(`,"enabled_notifications_percentage":`)
+	encoder.writeFloat64(entity.EnabledNotificationsPercentage)
+	encoder.writeRaw(`,"member_count_graph":`)
+	encoder.writeType(entity.MemberCountGraph)
+	encoder.writeRaw(`,"join_graph":`)
+	encoder.writeType(entity.JoinGraph)
+	encoder.writeRaw(`,"mute_graph":`)
+	encoder.writeType(entity.MuteGraph)
+	encoder.writeRaw(`,"view_count_by_hour_graph":`)
+	encoder.writeType(entity.ViewCountByHourGraph)
+	encoder.writeRaw(`,"view_count_by_source_graph":`)
+	encoder.writeType(entity.ViewCountBySourceGraph)
+	encoder.writeRaw(`,"join_by_source_graph":`)
+	encoder.writeType(entity.JoinBySourceGraph)
+	encoder.writeRaw(`,"language_graph":`)
+	encoder.writeType(entity.LanguageGraph)
+	encoder.writeRaw(`,"message_interaction_graph":`)
+	encoder.writeType(entity.MessageInteractionGraph)
+	encoder.writeRaw(`,"message_reaction_graph":`)
+	encoder.writeType(entity.MessageReactionGraph)
+	encoder.writeRaw(`,"story_interaction_graph":`)
+	encoder.writeType(entity.StoryInteractionGraph)
+	encoder.writeRaw(`,"story_reaction_graph":`)
+	encoder.writeType(entity.StoryReactionGraph)
+	encoder.writeRaw(`,"instant_view_interaction_graph":`)
+	encoder.writeType(entity.InstantViewInteractionGraph)
+	encoder.writeRaw(`,"recent_interactions":`)
+	writeList(encoder, entity.RecentInteractions, writeTypeValue[*ChatStatisticsInteractionInfo])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsChannel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "period":
+			entity.Period, err = decodeEntity[DateRange](decoder)
+
+		case "member_count":
+			entity.MemberCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "mean_message_view_count":
+			entity.MeanMessageViewCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "mean_message_share_count":
+			entity.MeanMessageShareCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "mean_message_reaction_count":
+			entity.MeanMessageReactionCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "mean_story_view_count":
+			entity.MeanStoryViewCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "mean_story_share_count":
+			entity.MeanStoryShareCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "mean_story_reaction_count":
+			entity.MeanStoryReactionCount, err = decodeEntity[StatisticalValue](decoder)
+
+		case "enabled_notifications_percentage":
+			entity.EnabledNotificationsPercentage, err = decoder.readFloat64()
+
+		case "member_count_graph":
+			entity.MemberCountGraph, err = decodeStatisticalGraph(decoder)
+
+		case "join_graph":
+			entity.JoinGraph, err = decodeStatisticalGraph(decoder)
+
+		case "mute_graph":
+			entity.MuteGraph, err = decodeStatisticalGraph(decoder)
+
+		case "view_count_by_hour_graph":
+			entity.ViewCountByHourGraph, err = decodeStatisticalGraph(decoder)
+
+		case "view_count_by_source_graph":
+			entity.ViewCountBySourceGraph, err = decodeStatisticalGraph(decoder)
+
+		case "join_by_source_graph":
+			entity.JoinBySourceGraph, err = decodeStatisticalGraph(decoder)
+
+		case "language_graph":
+			entity.LanguageGraph, err = decodeStatisticalGraph(decoder)
+
+		case "message_interaction_graph":
+			entity.MessageInteractionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "message_reaction_graph":
+			entity.MessageReactionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "story_interaction_graph":
+			entity.StoryInteractionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "story_reaction_graph":
+			entity.StoryReactionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "instant_view_interaction_graph":
+			entity.InstantViewInteractionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "recent_interactions":
+			entity.RecentInteractions, err = readList(decoder, decodeEntity[ChatStatisticsInteractionInfo])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsChannel)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
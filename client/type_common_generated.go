@@ -0,0 +1,20580 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeEmailAddressResetState               = "EmailAddressResetState"
+	TypeFirebaseDeviceVerificationParameters = "FirebaseDeviceVerificationParameters"
+	TypeMaskPoint                            = "MaskPoint"
+	TypeReportSponsoredResult                = "ReportSponsoredResult"
+	TypeNotificationSettingsScope            = "NotificationSettingsScope"
+	TypePageBlockHorizontalAlignment         = "PageBlockHorizontalAlignment"
+	TypePageBlockVerticalAlignment           = "PageBlockVerticalAlignment"
+	TypePageBlock                            = "PageBlock"
+	TypeLinkPreviewAlbumMedia                = "LinkPreviewAlbumMedia"
+	TypeLinkPreviewType                      = "LinkPreviewType"
+	TypeCollectibleItemType                  = "CollectibleItemType"
+	TypeInputCredentials                     = "InputCredentials"
+	TypeResendCodeReason                     = "ResendCodeReason"
+	TypeSpeechRecognitionResult              = "SpeechRecognitionResult"
+	TypeLanguagePackStringValue              = "LanguagePackStringValue"
+	TypeDeviceToken                          = "DeviceToken"
+	TypeCanTransferOwnershipResult           = "CanTransferOwnershipResult"
+	TypeNotificationType                     = "NotificationType"
+	TypeOptionValue                          = "OptionValue"
+	TypeJsonValue                            = "JsonValue"
+	TypeReportReason                         = "ReportReason"
+	TypeInternalLinkType                     = "InternalLinkType"
+	TypeBlockList                            = "BlockList"
+	TypeNetworkType                          = "NetworkType"
+	TypeNetworkStatisticsEntry               = "NetworkStatisticsEntry"
+	TypeAutosaveSettingsScope                = "AutosaveSettingsScope"
+	TypeConnectionState                      = "ConnectionState"
+	TypeTMeUrlType                           = "TMeUrlType"
+	TypeSuggestedAction                      = "SuggestedAction"
+	TypeProxyType                            = "ProxyType"
+	TypeStatisticalGraph                     = "StatisticalGraph"
+	TypeVectorPathCommand                    = "VectorPathCommand"
+	TypePhoneNumberCodeType                  = "PhoneNumberCodeType"
+	TypeLogStream                            = "LogStream"
+	TypeError                                = "Error"
+	TypeOk                                   = "Ok"
+	TypeTermsOfService                       = "TermsOfService"
+	TypeRecoveryEmailAddress                 = "RecoveryEmailAddress"
+	TypeMinithumbnail                        = "Minithumbnail"
+	TypeMaskPosition                         = "MaskPosition"
+	TypeClosedVectorPath                     = "ClosedVectorPath"
+	TypeOutline                              = "Outline"
+	TypeLocation                             = "Location"
+	TypeVenue                                = "Venue"
+	TypeVerificationStatus                   = "VerificationStatus"
+	TypeBirthdate                            = "Birthdate"
+	TypeProductInfo                          = "ProductInfo"
+	TypeAccentColor                          = "AccentColor"
+	TypeUsernames                            = "Usernames"
+	TypeFactCheck                            = "FactCheck"
+	TypeReportOption                         = "ReportOption"
+	TypeScopeNotificationSettings            = "ScopeNotificationSettings"
+	TypeFailedToAddMember                    = "FailedToAddMember"
+	TypeFailedToAddMembers                   = "FailedToAddMembers"
+	TypeAccountInfo                          = "AccountInfo"
+	TypeThemeParameters                      = "ThemeParameters"
+	TypeLinkPreviewOptions                   = "LinkPreviewOptions"
+	TypeThemeSettings                        = "ThemeSettings"
+	TypePageBlockCaption                     = "PageBlockCaption"
+	TypePageBlockListItem                    = "PageBlockListItem"
+	TypePageBlockTableCell                   = "PageBlockTableCell"
+	TypePageBlockRelatedArticle              = "PageBlockRelatedArticle"
+	TypeLinkPreview                          = "LinkPreview"
+	TypeCountryInfo                          = "CountryInfo"
+	TypeCountries                            = "Countries"
+	TypePhoneNumberInfo                      = "PhoneNumberInfo"
+	TypeCollectibleItemInfo                  = "CollectibleItemInfo"
+	TypeBankCardActionOpenUrl                = "BankCardActionOpenUrl"
+	TypeBankCardInfo                         = "BankCardInfo"
+	TypeAddress                              = "Address"
+	TypeLocationAddress                      = "LocationAddress"
+	TypeLabeledPricePart                     = "LabeledPricePart"
+	TypeOrderInfo                            = "OrderInfo"
+	TypeShippingOption                       = "ShippingOption"
+	TypeSavedCredentials                     = "SavedCredentials"
+	TypeValidatedOrderInfo                   = "ValidatedOrderInfo"
+	TypeDate                                 = "Date"
+	TypePersonalDetails                      = "PersonalDetails"
+	TypeEncryptedCredentials                 = "EncryptedCredentials"
+	TypeCurrentWeather                       = "CurrentWeather"
+	TypePublicForwards                       = "PublicForwards"
+	TypeRtmpUrl                              = "RtmpUrl"
+	TypeHttpUrl                              = "HttpUrl"
+	TypeCustomRequestResult                  = "CustomRequestResult"
+	TypeLanguagePackString                   = "LanguagePackString"
+	TypeLanguagePackStrings                  = "LanguagePackStrings"
+	TypeLanguagePackInfo                     = "LanguagePackInfo"
+	TypeLocalizationTargetInfo               = "LocalizationTargetInfo"
+	TypePushReceiverId                       = "PushReceiverId"
+	TypeTimeZone                             = "TimeZone"
+	TypeTimeZones                            = "TimeZones"
+	TypeHashtags                             = "Hashtags"
+	TypeNotificationSound                    = "NotificationSound"
+	TypeNotificationSounds                   = "NotificationSounds"
+	TypeNotification                         = "Notification"
+	TypeJsonObjectMember                     = "JsonObjectMember"
+	TypeAccountTtl                           = "AccountTtl"
+	TypeConnectedWebsite                     = "ConnectedWebsite"
+	TypeConnectedWebsites                    = "ConnectedWebsites"
+	TypeStorageStatistics                    = "StorageStatistics"
+	TypeStorageStatisticsFast                = "StorageStatisticsFast"
+	TypeDatabaseStatistics                   = "DatabaseStatistics"
+	TypeNetworkStatistics                    = "NetworkStatistics"
+	TypeScopeAutosaveSettings                = "ScopeAutosaveSettings"
+	TypeAutosaveSettingsException            = "AutosaveSettingsException"
+	TypeAutosaveSettings                     = "AutosaveSettings"
+	TypeFoundPosition                        = "FoundPosition"
+	TypeFoundPositions                       = "FoundPositions"
+	TypeTMeUrl                               = "TMeUrl"
+	TypeTMeUrls                              = "TMeUrls"
+	TypeCount                                = "Count"
+	TypeSeconds                              = "Seconds"
+	TypeDeepLinkInfo                         = "DeepLinkInfo"
+	TypeProxy                                = "Proxy"
+	TypeProxies                              = "Proxies"
+	TypeDateRange                            = "DateRange"
+	TypeStatisticalValue                     = "StatisticalValue"
+	TypePoint                                = "Point"
+	TypeLogVerbosityLevel                    = "LogVerbosityLevel"
+	TypeLogTags                              = "LogTags"
+	TypeTestInt                              = "TestInt"
+	TypeTestString                           = "TestString"
+	TypeTestBytes                            = "TestBytes"
+	TypeTestVectorInt                        = "TestVectorInt"
+	TypeTestVectorIntObject                  = "TestVectorIntObject"
+	TypeTestVectorString                     = "TestVectorString"
+	TypeTestVectorStringObject               = "TestVectorStringObject"
+)
+
+const (
+	ConstructorError                                                 = "error"
+	ConstructorOk                                                    = "ok"
+	ConstructorEmailAddressResetStateAvailable                       = "emailAddressResetStateAvailable"
+	ConstructorEmailAddressResetStatePending                         = "emailAddressResetStatePending"
+	ConstructorTermsOfService                                        = "termsOfService"
+	ConstructorFirebaseDeviceVerificationParametersSafetyNet         = "firebaseDeviceVerificationParametersSafetyNet"
+	ConstructorFirebaseDeviceVerificationParametersPlayIntegrity     = "firebaseDeviceVerificationParametersPlayIntegrity"
+	ConstructorRecoveryEmailAddress                                  = "recoveryEmailAddress"
+	ConstructorMinithumbnail                                         = "minithumbnail"
+	ConstructorMaskPointForehead                                     = "maskPointForehead"
+	ConstructorMaskPointEyes                                         = "maskPointEyes"
+	ConstructorMaskPointMouth                                        = "maskPointMouth"
+	ConstructorMaskPointChin                                         = "maskPointChin"
+	ConstructorMaskPosition                                          = "maskPosition"
+	ConstructorClosedVectorPath                                      = "closedVectorPath"
+	ConstructorOutline                                               = "outline"
+	ConstructorLocation                                              = "location"
+	ConstructorVenue                                                 = "venue"
+	ConstructorVerificationStatus                                    = "verificationStatus"
+	ConstructorBirthdate                                             = "birthdate"
+	ConstructorProductInfo                                           = "productInfo"
+	ConstructorAccentColor                                           = "accentColor"
+	ConstructorUsernames                                             = "usernames"
+	ConstructorFactCheck                                             = "factCheck"
+	ConstructorReportOption                                          = "reportOption"
+	ConstructorReportSponsoredResultOk                               = "reportSponsoredResultOk"
+	ConstructorReportSponsoredResultFailed                           = "reportSponsoredResultFailed"
+	ConstructorReportSponsoredResultOptionRequired                   = "reportSponsoredResultOptionRequired"
+	ConstructorReportSponsoredResultAdsHidden                        = "reportSponsoredResultAdsHidden"
+	ConstructorReportSponsoredResultPremiumRequired                  = "reportSponsoredResultPremiumRequired"
+	ConstructorNotificationSettingsScopePrivateChats                 = "notificationSettingsScopePrivateChats"
+	ConstructorNotificationSettingsScopeGroupChats                   = "notificationSettingsScopeGroupChats"
+	ConstructorNotificationSettingsScopeChannelChats                 = "notificationSettingsScopeChannelChats"
+	ConstructorScopeNotificationSettings                             = "scopeNotificationSettings"
+	ConstructorFailedToAddMember                                     = "failedToAddMember"
+	ConstructorFailedToAddMembers                                    = "failedToAddMembers"
+	ConstructorAccountInfo                                           = "accountInfo"
+	ConstructorThemeParameters                                       = "themeParameters"
+	ConstructorLinkPreviewOptions                                    = "linkPreviewOptions"
+	ConstructorThemeSettings                                         = "themeSettings"
+	ConstructorPageBlockCaption                                      = "pageBlockCaption"
+	ConstructorPageBlockListItem                                     = "pageBlockListItem"
+	ConstructorPageBlockHorizontalAlignmentLeft                      = "pageBlockHorizontalAlignmentLeft"
+	ConstructorPageBlockHorizontalAlignmentCenter                    = "pageBlockHorizontalAlignmentCenter"
+	ConstructorPageBlockHorizontalAlignmentRight                     = "pageBlockHorizontalAlignmentRight"
+	ConstructorPageBlockVerticalAlignmentTop                         = "pageBlockVerticalAlignmentTop"
+	ConstructorPageBlockVerticalAlignmentMiddle                      = "pageBlockVerticalAlignmentMiddle"
+	ConstructorPageBlockVerticalAlignmentBottom                      = "pageBlockVerticalAlignmentBottom"
+	ConstructorPageBlockTableCell                                    = "pageBlockTableCell"
+	ConstructorPageBlockRelatedArticle                               = "pageBlockRelatedArticle"
+	ConstructorPageBlockTitle                                        = "pageBlockTitle"
+	ConstructorPageBlockSubtitle                                     = "pageBlockSubtitle"
+	ConstructorPageBlockAuthorDate                                   = "pageBlockAuthorDate"
+	ConstructorPageBlockHeader                                       = "pageBlockHeader"
+	ConstructorPageBlockSubheader                                    = "pageBlockSubheader"
+	ConstructorPageBlockKicker                                       = "pageBlockKicker"
+	ConstructorPageBlockParagraph                                    = "pageBlockParagraph"
+	ConstructorPageBlockPreformatted                                 = "pageBlockPreformatted"
+	ConstructorPageBlockFooter                                       = "pageBlockFooter"
+	ConstructorPageBlockDivider                                      = "pageBlockDivider"
+	ConstructorPageBlockAnchor                                       = "pageBlockAnchor"
+	ConstructorPageBlockList                                         = "pageBlockList"
+	ConstructorPageBlockBlockQuote                                   = "pageBlockBlockQuote"
+	ConstructorPageBlockPullQuote                                    = "pageBlockPullQuote"
+	ConstructorPageBlockAnimation                                    = "pageBlockAnimation"
+	ConstructorPageBlockAudio                                        = "pageBlockAudio"
+	ConstructorPageBlockPhoto                                        = "pageBlockPhoto"
+	ConstructorPageBlockVideo                                        = "pageBlockVideo"
+	ConstructorPageBlockVoiceNote                                    = "pageBlockVoiceNote"
+	ConstructorPageBlockCover                                        = "pageBlockCover"
+	ConstructorPageBlockEmbedded                                     = "pageBlockEmbedded"
+	ConstructorPageBlockEmbeddedPost                                 = "pageBlockEmbeddedPost"
+	ConstructorPageBlockCollage                                      = "pageBlockCollage"
+	ConstructorPageBlockSlideshow                                    = "pageBlockSlideshow"
+	ConstructorPageBlockChatLink                                     = "pageBlockChatLink"
+	ConstructorPageBlockTable                                        = "pageBlockTable"
+	ConstructorPageBlockDetails                                      = "pageBlockDetails"
+	ConstructorPageBlockRelatedArticles                              = "pageBlockRelatedArticles"
+	ConstructorPageBlockMap                                          = "pageBlockMap"
+	ConstructorLinkPreviewAlbumMediaPhoto                            = "linkPreviewAlbumMediaPhoto"
+	ConstructorLinkPreviewAlbumMediaVideo                            = "linkPreviewAlbumMediaVideo"
+	ConstructorLinkPreviewTypeAlbum                                  = "linkPreviewTypeAlbum"
+	ConstructorLinkPreviewTypeAnimation                              = "linkPreviewTypeAnimation"
+	ConstructorLinkPreviewTypeApp                                    = "linkPreviewTypeApp"
+	ConstructorLinkPreviewTypeArticle                                = "linkPreviewTypeArticle"
+	ConstructorLinkPreviewTypeAudio                                  = "linkPreviewTypeAudio"
+	ConstructorLinkPreviewTypeBackground                             = "linkPreviewTypeBackground"
+	ConstructorLinkPreviewTypeChannelBoost                           = "linkPreviewTypeChannelBoost"
+	ConstructorLinkPreviewTypeChat                                   = "linkPreviewTypeChat"
+	ConstructorLinkPreviewTypeDocument                               = "linkPreviewTypeDocument"
+	ConstructorLinkPreviewTypeEmbeddedAnimationPlayer                = "linkPreviewTypeEmbeddedAnimationPlayer"
+	ConstructorLinkPreviewTypeEmbeddedAudioPlayer                    = "linkPreviewTypeEmbeddedAudioPlayer"
+	ConstructorLinkPreviewTypeEmbeddedVideoPlayer                    = "linkPreviewTypeEmbeddedVideoPlayer"
+	ConstructorLinkPreviewTypeExternalAudio                          = "linkPreviewTypeExternalAudio"
+	ConstructorLinkPreviewTypeExternalVideo                          = "linkPreviewTypeExternalVideo"
+	ConstructorLinkPreviewTypeInvoice                                = "linkPreviewTypeInvoice"
+	ConstructorLinkPreviewTypeMessage                                = "linkPreviewTypeMessage"
+	ConstructorLinkPreviewTypePhoto                                  = "linkPreviewTypePhoto"
+	ConstructorLinkPreviewTypePremiumGiftCode                        = "linkPreviewTypePremiumGiftCode"
+	ConstructorLinkPreviewTypeShareableChatFolder                    = "linkPreviewTypeShareableChatFolder"
+	ConstructorLinkPreviewTypeSticker                                = "linkPreviewTypeSticker"
+	ConstructorLinkPreviewTypeStickerSet                             = "linkPreviewTypeStickerSet"
+	ConstructorLinkPreviewTypeStory                                  = "linkPreviewTypeStory"
+	ConstructorLinkPreviewTypeSupergroupBoost                        = "linkPreviewTypeSupergroupBoost"
+	ConstructorLinkPreviewTypeTheme                                  = "linkPreviewTypeTheme"
+	ConstructorLinkPreviewTypeUnsupported                            = "linkPreviewTypeUnsupported"
+	ConstructorLinkPreviewTypeUpgradedGift                           = "linkPreviewTypeUpgradedGift"
+	ConstructorLinkPreviewTypeUser                                   = "linkPreviewTypeUser"
+	ConstructorLinkPreviewTypeVideo                                  = "linkPreviewTypeVideo"
+	ConstructorLinkPreviewTypeVideoChat                              = "linkPreviewTypeVideoChat"
+	ConstructorLinkPreviewTypeVideoNote                              = "linkPreviewTypeVideoNote"
+	ConstructorLinkPreviewTypeVoiceNote                              = "linkPreviewTypeVoiceNote"
+	ConstructorLinkPreviewTypeWebApp                                 = "linkPreviewTypeWebApp"
+	ConstructorLinkPreview                                           = "linkPreview"
+	ConstructorCountryInfo                                           = "countryInfo"
+	ConstructorCountries                                             = "countries"
+	ConstructorPhoneNumberInfo                                       = "phoneNumberInfo"
+	ConstructorCollectibleItemTypeUsername                           = "collectibleItemTypeUsername"
+	ConstructorCollectibleItemTypePhoneNumber                        = "collectibleItemTypePhoneNumber"
+	ConstructorCollectibleItemInfo                                   = "collectibleItemInfo"
+	ConstructorBankCardActionOpenUrl                                 = "bankCardActionOpenUrl"
+	ConstructorBankCardInfo                                          = "bankCardInfo"
+	ConstructorAddress                                               = "address"
+	ConstructorLocationAddress                                       = "locationAddress"
+	ConstructorLabeledPricePart                                      = "labeledPricePart"
+	ConstructorOrderInfo                                             = "orderInfo"
+	ConstructorShippingOption                                        = "shippingOption"
+	ConstructorSavedCredentials                                      = "savedCredentials"
+	ConstructorInputCredentialsSaved                                 = "inputCredentialsSaved"
+	ConstructorInputCredentialsNew                                   = "inputCredentialsNew"
+	ConstructorInputCredentialsApplePay                              = "inputCredentialsApplePay"
+	ConstructorInputCredentialsGooglePay                             = "inputCredentialsGooglePay"
+	ConstructorValidatedOrderInfo                                    = "validatedOrderInfo"
+	ConstructorDate                                                  = "date"
+	ConstructorPersonalDetails                                       = "personalDetails"
+	ConstructorEncryptedCredentials                                  = "encryptedCredentials"
+	ConstructorCurrentWeather                                        = "currentWeather"
+	ConstructorPublicForwards                                        = "publicForwards"
+	ConstructorResendCodeReasonUserRequest                           = "resendCodeReasonUserRequest"
+	ConstructorResendCodeReasonVerificationFailed                    = "resendCodeReasonVerificationFailed"
+	ConstructorRtmpUrl                                               = "rtmpUrl"
+	ConstructorSpeechRecognitionResultPending                        = "speechRecognitionResultPending"
+	ConstructorSpeechRecognitionResultText                           = "speechRecognitionResultText"
+	ConstructorSpeechRecognitionResultError                          = "speechRecognitionResultError"
+	ConstructorHttpUrl                                               = "httpUrl"
+	ConstructorCustomRequestResult                                   = "customRequestResult"
+	ConstructorLanguagePackStringValueOrdinary                       = "languagePackStringValueOrdinary"
+	ConstructorLanguagePackStringValuePluralized                     = "languagePackStringValuePluralized"
+	ConstructorLanguagePackStringValueDeleted                        = "languagePackStringValueDeleted"
+	ConstructorLanguagePackString                                    = "languagePackString"
+	ConstructorLanguagePackStrings                                   = "languagePackStrings"
+	ConstructorLanguagePackInfo                                      = "languagePackInfo"
+	ConstructorLocalizationTargetInfo                                = "localizationTargetInfo"
+	ConstructorDeviceTokenFirebaseCloudMessaging                     = "deviceTokenFirebaseCloudMessaging"
+	ConstructorDeviceTokenApplePush                                  = "deviceTokenApplePush"
+	ConstructorDeviceTokenApplePushVoIP                              = "deviceTokenApplePushVoIP"
+	ConstructorDeviceTokenWindowsPush                                = "deviceTokenWindowsPush"
+	ConstructorDeviceTokenMicrosoftPush                              = "deviceTokenMicrosoftPush"
+	ConstructorDeviceTokenMicrosoftPushVoIP                          = "deviceTokenMicrosoftPushVoIP"
+	ConstructorDeviceTokenWebPush                                    = "deviceTokenWebPush"
+	ConstructorDeviceTokenSimplePush                                 = "deviceTokenSimplePush"
+	ConstructorDeviceTokenUbuntuPush                                 = "deviceTokenUbuntuPush"
+	ConstructorDeviceTokenBlackBerryPush                             = "deviceTokenBlackBerryPush"
+	ConstructorDeviceTokenTizenPush                                  = "deviceTokenTizenPush"
+	ConstructorDeviceTokenHuaweiPush                                 = "deviceTokenHuaweiPush"
+	ConstructorPushReceiverId                                        = "pushReceiverId"
+	ConstructorTimeZone                                              = "timeZone"
+	ConstructorTimeZones                                             = "timeZones"
+	ConstructorHashtags                                              = "hashtags"
+	ConstructorCanTransferOwnershipResultOk                          = "canTransferOwnershipResultOk"
+	ConstructorCanTransferOwnershipResultPasswordNeeded              = "canTransferOwnershipResultPasswordNeeded"
+	ConstructorCanTransferOwnershipResultPasswordTooFresh            = "canTransferOwnershipResultPasswordTooFresh"
+	ConstructorCanTransferOwnershipResultSessionTooFresh             = "canTransferOwnershipResultSessionTooFresh"
+	ConstructorNotificationTypeNewMessage                            = "notificationTypeNewMessage"
+	ConstructorNotificationTypeNewSecretChat                         = "notificationTypeNewSecretChat"
+	ConstructorNotificationTypeNewCall                               = "notificationTypeNewCall"
+	ConstructorNotificationTypeNewPushMessage                        = "notificationTypeNewPushMessage"
+	ConstructorNotificationSound                                     = "notificationSound"
+	ConstructorNotificationSounds                                    = "notificationSounds"
+	ConstructorNotification                                          = "notification"
+	ConstructorOptionValueBoolean                                    = "optionValueBoolean"
+	ConstructorOptionValueEmpty                                      = "optionValueEmpty"
+	ConstructorOptionValueInteger                                    = "optionValueInteger"
+	ConstructorOptionValueString                                     = "optionValueString"
+	ConstructorJsonObjectMember                                      = "jsonObjectMember"
+	ConstructorJsonValueNull                                         = "jsonValueNull"
+	ConstructorJsonValueBoolean                                      = "jsonValueBoolean"
+	ConstructorJsonValueNumber                                       = "jsonValueNumber"
+	ConstructorJsonValueString                                       = "jsonValueString"
+	ConstructorJsonValueArray                                        = "jsonValueArray"
+	ConstructorJsonValueObject                                       = "jsonValueObject"
+	ConstructorAccountTtl                                            = "accountTtl"
+	ConstructorConnectedWebsite                                      = "connectedWebsite"
+	ConstructorConnectedWebsites                                     = "connectedWebsites"
+	ConstructorReportReasonSpam                                      = "reportReasonSpam"
+	ConstructorReportReasonViolence                                  = "reportReasonViolence"
+	ConstructorReportReasonPornography                               = "reportReasonPornography"
+	ConstructorReportReasonChildAbuse                                = "reportReasonChildAbuse"
+	ConstructorReportReasonCopyright                                 = "reportReasonCopyright"
+	ConstructorReportReasonUnrelatedLocation                         = "reportReasonUnrelatedLocation"
+	ConstructorReportReasonFake                                      = "reportReasonFake"
+	ConstructorReportReasonIllegalDrugs                              = "reportReasonIllegalDrugs"
+	ConstructorReportReasonPersonalDetails                           = "reportReasonPersonalDetails"
+	ConstructorReportReasonCustom                                    = "reportReasonCustom"
+	ConstructorInternalLinkTypeActiveSessions                        = "internalLinkTypeActiveSessions"
+	ConstructorInternalLinkTypeAttachmentMenuBot                     = "internalLinkTypeAttachmentMenuBot"
+	ConstructorInternalLinkTypeAuthenticationCode                    = "internalLinkTypeAuthenticationCode"
+	ConstructorInternalLinkTypeBackground                            = "internalLinkTypeBackground"
+	ConstructorInternalLinkTypeBotAddToChannel                       = "internalLinkTypeBotAddToChannel"
+	ConstructorInternalLinkTypeBotStart                              = "internalLinkTypeBotStart"
+	ConstructorInternalLinkTypeBotStartInGroup                       = "internalLinkTypeBotStartInGroup"
+	ConstructorInternalLinkTypeBusinessChat                          = "internalLinkTypeBusinessChat"
+	ConstructorInternalLinkTypeBuyStars                              = "internalLinkTypeBuyStars"
+	ConstructorInternalLinkTypeChangePhoneNumber                     = "internalLinkTypeChangePhoneNumber"
+	ConstructorInternalLinkTypeChatAffiliateProgram                  = "internalLinkTypeChatAffiliateProgram"
+	ConstructorInternalLinkTypeChatBoost                             = "internalLinkTypeChatBoost"
+	ConstructorInternalLinkTypeChatFolderInvite                      = "internalLinkTypeChatFolderInvite"
+	ConstructorInternalLinkTypeChatFolderSettings                    = "internalLinkTypeChatFolderSettings"
+	ConstructorInternalLinkTypeChatInvite                            = "internalLinkTypeChatInvite"
+	ConstructorInternalLinkTypeDefaultMessageAutoDeleteTimerSettings = "internalLinkTypeDefaultMessageAutoDeleteTimerSettings"
+	ConstructorInternalLinkTypeEditProfileSettings                   = "internalLinkTypeEditProfileSettings"
+	ConstructorInternalLinkTypeGame                                  = "internalLinkTypeGame"
+	ConstructorInternalLinkTypeInstantView                           = "internalLinkTypeInstantView"
+	ConstructorInternalLinkTypeInvoice                               = "internalLinkTypeInvoice"
+	ConstructorInternalLinkTypeLanguagePack                          = "internalLinkTypeLanguagePack"
+	ConstructorInternalLinkTypeLanguageSettings                      = "internalLinkTypeLanguageSettings"
+	ConstructorInternalLinkTypeMainWebApp                            = "internalLinkTypeMainWebApp"
+	ConstructorInternalLinkTypeMessage                               = "internalLinkTypeMessage"
+	ConstructorInternalLinkTypeMessageDraft                          = "internalLinkTypeMessageDraft"
+	ConstructorInternalLinkTypePassportDataRequest                   = "internalLinkTypePassportDataRequest"
+	ConstructorInternalLinkTypePhoneNumberConfirmation               = "internalLinkTypePhoneNumberConfirmation"
+	ConstructorInternalLinkTypePremiumFeatures                       = "internalLinkTypePremiumFeatures"
+	ConstructorInternalLinkTypePremiumGift                           = "internalLinkTypePremiumGift"
+	ConstructorInternalLinkTypePremiumGiftCode                       = "internalLinkTypePremiumGiftCode"
+	ConstructorInternalLinkTypePrivacyAndSecuritySettings            = "internalLinkTypePrivacyAndSecuritySettings"
+	ConstructorInternalLinkTypeProxy                                 = "internalLinkTypeProxy"
+	ConstructorInternalLinkTypePublicChat                            = "internalLinkTypePublicChat"
+	ConstructorInternalLinkTypeQrCodeAuthentication                  = "internalLinkTypeQrCodeAuthentication"
+	ConstructorInternalLinkTypeRestorePurchases                      = "internalLinkTypeRestorePurchases"
+	ConstructorInternalLinkTypeSettings                              = "internalLinkTypeSettings"
+	ConstructorInternalLinkTypeStickerSet                            = "internalLinkTypeStickerSet"
+	ConstructorInternalLinkTypeStory                                 = "internalLinkTypeStory"
+	ConstructorInternalLinkTypeTheme                                 = "internalLinkTypeTheme"
+	ConstructorInternalLinkTypeThemeSettings                         = "internalLinkTypeThemeSettings"
+	ConstructorInternalLinkTypeUnknownDeepLink                       = "internalLinkTypeUnknownDeepLink"
+	ConstructorInternalLinkTypeUnsupportedProxy                      = "internalLinkTypeUnsupportedProxy"
+	ConstructorInternalLinkTypeUpgradedGift                          = "internalLinkTypeUpgradedGift"
+	ConstructorInternalLinkTypeUserPhoneNumber                       = "internalLinkTypeUserPhoneNumber"
+	ConstructorInternalLinkTypeUserToken                             = "internalLinkTypeUserToken"
+	ConstructorInternalLinkTypeVideoChat                             = "internalLinkTypeVideoChat"
+	ConstructorInternalLinkTypeWebApp                                = "internalLinkTypeWebApp"
+	ConstructorBlockListMain                                         = "blockListMain"
+	ConstructorBlockListStories                                      = "blockListStories"
+	ConstructorStorageStatistics                                     = "storageStatistics"
+	ConstructorStorageStatisticsFast                                 = "storageStatisticsFast"
+	ConstructorDatabaseStatistics                                    = "databaseStatistics"
+	ConstructorNetworkTypeNone                                       = "networkTypeNone"
+	ConstructorNetworkTypeMobile                                     = "networkTypeMobile"
+	ConstructorNetworkTypeMobileRoaming                              = "networkTypeMobileRoaming"
+	ConstructorNetworkTypeWiFi                                       = "networkTypeWiFi"
+	ConstructorNetworkTypeOther                                      = "networkTypeOther"
+	ConstructorNetworkStatisticsEntryFile                            = "networkStatisticsEntryFile"
+	ConstructorNetworkStatisticsEntryCall                            = "networkStatisticsEntryCall"
+	ConstructorNetworkStatistics                                     = "networkStatistics"
+	ConstructorAutosaveSettingsScopePrivateChats                     = "autosaveSettingsScopePrivateChats"
+	ConstructorAutosaveSettingsScopeGroupChats                       = "autosaveSettingsScopeGroupChats"
+	ConstructorAutosaveSettingsScopeChannelChats                     = "autosaveSettingsScopeChannelChats"
+	ConstructorAutosaveSettingsScopeChat                             = "autosaveSettingsScopeChat"
+	ConstructorScopeAutosaveSettings                                 = "scopeAutosaveSettings"
+	ConstructorAutosaveSettingsException                             = "autosaveSettingsException"
+	ConstructorAutosaveSettings                                      = "autosaveSettings"
+	ConstructorConnectionStateWaitingForNetwork                      = "connectionStateWaitingForNetwork"
+	ConstructorConnectionStateConnectingToProxy                      = "connectionStateConnectingToProxy"
+	ConstructorConnectionStateConnecting                             = "connectionStateConnecting"
+	ConstructorConnectionStateUpdating                               = "connectionStateUpdating"
+	ConstructorConnectionStateReady                                  = "connectionStateReady"
+	ConstructorFoundPosition                                         = "foundPosition"
+	ConstructorFoundPositions                                        = "foundPositions"
+	ConstructorTMeUrlTypeUser                                        = "tMeUrlTypeUser"
+	ConstructorTMeUrlTypeSupergroup                                  = "tMeUrlTypeSupergroup"
+	ConstructorTMeUrlTypeChatInvite                                  = "tMeUrlTypeChatInvite"
+	ConstructorTMeUrlTypeStickerSet                                  = "tMeUrlTypeStickerSet"
+	ConstructorTMeUrl                                                = "tMeUrl"
+	ConstructorTMeUrls                                               = "tMeUrls"
+	ConstructorSuggestedActionEnableArchiveAndMuteNewChats           = "suggestedActionEnableArchiveAndMuteNewChats"
+	ConstructorSuggestedActionCheckPassword                          = "suggestedActionCheckPassword"
+	ConstructorSuggestedActionCheckPhoneNumber                       = "suggestedActionCheckPhoneNumber"
+	ConstructorSuggestedActionViewChecksHint                         = "suggestedActionViewChecksHint"
+	ConstructorSuggestedActionConvertToBroadcastGroup                = "suggestedActionConvertToBroadcastGroup"
+	ConstructorSuggestedActionSetPassword                            = "suggestedActionSetPassword"
+	ConstructorSuggestedActionUpgradePremium                         = "suggestedActionUpgradePremium"
+	ConstructorSuggestedActionRestorePremium                         = "suggestedActionRestorePremium"
+	ConstructorSuggestedActionSubscribeToAnnualPremium               = "suggestedActionSubscribeToAnnualPremium"
+	ConstructorSuggestedActionGiftPremiumForChristmas                = "suggestedActionGiftPremiumForChristmas"
+	ConstructorSuggestedActionSetBirthdate                           = "suggestedActionSetBirthdate"
+	ConstructorSuggestedActionSetProfilePhoto                        = "suggestedActionSetProfilePhoto"
+	ConstructorSuggestedActionExtendPremium                          = "suggestedActionExtendPremium"
+	ConstructorSuggestedActionExtendStarSubscriptions                = "suggestedActionExtendStarSubscriptions"
+	ConstructorCount                                                 = "count"
+	ConstructorSeconds                                               = "seconds"
+	ConstructorDeepLinkInfo                                          = "deepLinkInfo"
+	ConstructorProxyTypeSocks5                                       = "proxyTypeSocks5"
+	ConstructorProxyTypeHttp                                         = "proxyTypeHttp"
+	ConstructorProxyTypeMtproto                                      = "proxyTypeMtproto"
+	ConstructorProxy                                                 = "proxy"
+	ConstructorProxies                                               = "proxies"
+	ConstructorDateRange                                             = "dateRange"
+	ConstructorStatisticalValue                                      = "statisticalValue"
+	ConstructorStatisticalGraphData                                  = "statisticalGraphData"
+	ConstructorStatisticalGraphAsync                                 = "statisticalGraphAsync"
+	ConstructorStatisticalGraphError                                 = "statisticalGraphError"
+	ConstructorPoint                                                 = "point"
+	ConstructorVectorPathCommandLine                                 = "vectorPathCommandLine"
+	ConstructorVectorPathCommandCubicBezierCurve                     = "vectorPathCommandCubicBezierCurve"
+	ConstructorPhoneNumberCodeTypeChange                             = "phoneNumberCodeTypeChange"
+	ConstructorPhoneNumberCodeTypeVerify                             = "phoneNumberCodeTypeVerify"
+	ConstructorPhoneNumberCodeTypeConfirmOwnership                   = "phoneNumberCodeTypeConfirmOwnership"
+	ConstructorLogStreamDefault                                      = "logStreamDefault"
+	ConstructorLogStreamFile                                         = "logStreamFile"
+	ConstructorLogStreamEmpty                                        = "logStreamEmpty"
+	ConstructorLogVerbosityLevel                                     = "logVerbosityLevel"
+	ConstructorLogTags                                               = "logTags"
+	ConstructorTestInt                                               = "testInt"
+	ConstructorTestString                                            = "testString"
+	ConstructorTestBytes                                             = "testBytes"
+	ConstructorTestVectorInt                                         = "testVectorInt"
+	ConstructorTestVectorIntObject                                   = "testVectorIntObject"
+	ConstructorTestVectorString                                      = "testVectorString"
+	ConstructorTestVectorStringObject                                = "testVectorStringObject"
+)
+
+// Describes reset state of an email address
+type EmailAddressResetState interface {
+	EmailAddressResetStateConstructor() string
+}
+
+// Describes parameters to be used for device verification
+type FirebaseDeviceVerificationParameters interface {
+	FirebaseDeviceVerificationParametersConstructor() string
+}
+
+// Part of the face, relative to which a mask is placed
+type MaskPoint interface {
+	MaskPointConstructor() string
+}
+
+// Describes result of sponsored message or chat report
+type ReportSponsoredResult interface {
+	ReportSponsoredResultConstructor() string
+}
+
+// Describes the types of chats to which notification settings are relevant
+type NotificationSettingsScope interface {
+	NotificationSettingsScopeConstructor() string
+}
+
+// Describes a horizontal alignment of a table cell content
+type PageBlockHorizontalAlignment interface {
+	PageBlockHorizontalAlignmentConstructor() string
+}
+
+// Describes a Vertical alignment of a table cell content
+type PageBlockVerticalAlignment interface {
+	PageBlockVerticalAlignmentConstructor() string
+}
+
+// Describes a block of an instant view for a web page
+type PageBlock interface {
+	PageBlockConstructor() string
+}
+
+// Describes a media from a link preview album
+type LinkPreviewAlbumMedia interface {
+	LinkPreviewAlbumMediaConstructor() string
+}
+
+// Describes type of link preview
+type LinkPreviewType interface {
+	LinkPreviewTypeConstructor() string
+}
+
+// Describes a collectible item that can be purchased at https://fragment.com
+type CollectibleItemType interface {
+	CollectibleItemTypeConstructor() string
+}
+
+// Contains information about the payment method chosen by the user
+type InputCredentials interface {
+	InputCredentialsConstructor() string
+}
+
+// Describes the reason why a code needs to be re-sent
+type ResendCodeReason interface {
+	ResendCodeReasonConstructor() string
+}
+
+// Describes result of speech recognition in a voice note
+type SpeechRecognitionResult interface {
+	SpeechRecognitionResultConstructor() string
+}
+
+// Represents the value of a string in a language pack
+type LanguagePackStringValue interface {
+	LanguagePackStringValueConstructor() string
+}
+
+// Represents a data needed to subscribe for push notifications through registerDevice method. To use specific push notification service, the correct application platform must be specified and a valid server authentication data must be uploaded at https://my.telegram.org
+type DeviceToken interface {
+	DeviceTokenConstructor() string
+}
+
+// Represents result of checking whether the current session can be used to transfer a chat ownership to another user
+type CanTransferOwnershipResult interface {
+	CanTransferOwnershipResultConstructor() string
+}
+
+// Contains detailed information about a notification
+type NotificationType interface {
+	NotificationTypeConstructor() string
+}
+
+// Represents the value of an option
+type OptionValue interface {
+	OptionValueConstructor() string
+}
+
+// Represents a JSON value
+type JsonValue interface {
+	JsonValueConstructor() string
+}
+
+// Describes the reason why a chat is reported
+type ReportReason interface {
+	ReportReasonConstructor() string
+}
+
+// Describes an internal https://t.me or tg: link, which must be processed by the application in a special way
+type InternalLinkType interface {
+	InternalLinkTypeConstructor() string
+}
+
+// Describes type of block list
+type BlockList interface {
+	BlockListConstructor() string
+}
+
+// Represents the type of network
+type NetworkType interface {
+	NetworkTypeConstructor() string
+}
+
+// Contains statistics about network usage
+type NetworkStatisticsEntry interface {
+	NetworkStatisticsEntryConstructor() string
+}
+
+// Describes scope of autosave settings
+type AutosaveSettingsScope interface {
+	AutosaveSettingsScopeConstructor() string
+}
+
+// Describes the current state of the connection to Telegram servers
+type ConnectionState interface {
+	ConnectionStateConstructor() string
+}
+
+// Describes the type of URL linking to an internal Telegram entity
+type TMeUrlType interface {
+	TMeUrlTypeConstructor() string
+}
+
+// Describes an action suggested to the current user
+type SuggestedAction interface {
+	SuggestedActionConstructor() string
+}
+
+// Describes the type of proxy server
+type ProxyType interface {
+	ProxyTypeConstructor() string
+}
+
+// Describes a statistical graph
+type StatisticalGraph interface {
+	StatisticalGraphConstructor() string
+}
+
+// Represents a vector path command
+type VectorPathCommand interface {
+	VectorPathCommandConstructor() string
+}
+
+// Describes type of the request for which a code is sent to a phone number
+type PhoneNumberCodeType interface {
+	PhoneNumberCodeTypeConstructor() string
+}
+
+// Describes a stream to which TDLib internal log is written
+type LogStream interface {
+	LogStreamConstructor() string
+}
+
+func (entity *UnknownType) EmailAddressResetStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) FirebaseDeviceVerificationParametersConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MaskPointConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReportSponsoredResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) NotificationSettingsScopeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PageBlockHorizontalAlignmentConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PageBlockVerticalAlignmentConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PageBlockConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) LinkPreviewAlbumMediaConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) LinkPreviewTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CollectibleItemTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputCredentialsConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ResendCodeReasonConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SpeechRecognitionResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) LanguagePackStringValueConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) DeviceTokenConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CanTransferOwnershipResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) NotificationTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) OptionValueConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) JsonValueConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReportReasonConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InternalLinkTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) BlockListConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) NetworkTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) NetworkStatisticsEntryConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) AutosaveSettingsScopeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ConnectionStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) TMeUrlTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SuggestedActionConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ProxyTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StatisticalGraphConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) VectorPathCommandConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PhoneNumberCodeTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) LogStreamConstructor() string {
+	return entity.Constructor
+}
+
+// An object of this type can be returned on every function call, in case of an error
+type Error struct {
+	meta
+	// Error code; subject to future changes. If the error code is 406, the error message must not be processed in any way and must not be displayed to the user
+	Code int32 `json:"code"`
+	// Error message; subject to future changes
+	Message string `json:"message"`
+}
+
+func (*Error) GetType() string {
+	return TypeError
+}
+
+func (*Error) GetConstructor() string {
+	return ConstructorError
+}
+
+func (entity *Error) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Error) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Error) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"error"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeInt32(entity.Code)
+	encoder.writeRaw(`,"message":`)
+	encoder.writeString(entity.Message)
+	encoder.writeRaw("}")
+}
+
+func (entity *Error) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "code":
+			entity.Code, err = decoder.readInt32()
+
+		case "message":
+			entity.Message, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorError)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An object of this type is returned on a successful function call for certain functions
+type Ok struct {
+	meta
+}
+
+func (*Ok) GetType() string {
+	return TypeOk
+}
+
+func (*Ok) GetConstructor() string {
+	return ConstructorOk
+}
+
+func (entity *Ok) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Ok) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Ok) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"ok"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *Ok) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorOk)
+	})
+}
+
+// Email address can be reset after the given period. Call resetAuthenticationEmailAddress to reset it and allow the user to authorize with a code sent to the user's phone number
+type EmailAddressResetStateAvailable struct {
+	meta
+	// Time required to wait before the email address can be reset; 0 if the user is subscribed to Telegram Premium
+	WaitPeriod int32 `json:"wait_period"`
+}
+
+func (*EmailAddressResetStateAvailable) GetType() string {
+	return TypeEmailAddressResetState
+}
+
+func (*EmailAddressResetStateAvailable) GetConstructor() string {
+	return ConstructorEmailAddressResetStateAvailable
+}
+
+func (*EmailAddressResetStateAvailable) EmailAddressResetStateConstructor() string {
+	return ConstructorEmailAddressResetStateAvailable
+}
+
+func (entity *EmailAddressResetStateAvailable) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmailAddressResetStateAvailable) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmailAddressResetStateAvailable) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emailAddressResetStateAvailable"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"wait_period":`)
+	encoder.writeInt32(entity.WaitPeriod)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmailAddressResetStateAvailable) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "wait_period":
+			entity.WaitPeriod, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmailAddressResetStateAvailable)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Email address reset has already been requested. Call resetAuthenticationEmailAddress to check whether immediate reset is possible
+type EmailAddressResetStatePending struct {
+	meta
+	// Left time before the email address will be reset, in seconds. updateAuthorizationState is not sent when this field changes
+	ResetIn int32 `json:"reset_in"`
+}
+
+func (*EmailAddressResetStatePending) GetType() string {
+	return TypeEmailAddressResetState
+}
+
+func (*EmailAddressResetStatePending) GetConstructor() string {
+	return ConstructorEmailAddressResetStatePending
+}
+
+func (*EmailAddressResetStatePending) EmailAddressResetStateConstructor() string {
+	return ConstructorEmailAddressResetStatePending
+}
+
+func (entity *EmailAddressResetStatePending) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmailAddressResetStatePending) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmailAddressResetStatePending) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emailAddressResetStatePending"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reset_in":`)
+	encoder.writeInt32(entity.ResetIn)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmailAddressResetStatePending) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reset_in":
+			entity.ResetIn, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmailAddressResetStatePending)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains Telegram terms of service
+type TermsOfService struct {
+	meta
+	// Text of the terms of service
+	Text *FormattedText `json:"text"`
+	// The minimum age of a user to be able to accept the terms; 0 if age isn't restricted
+	MinUserAge int32 `json:"min_user_age"`
+	// True, if a blocking popup with terms of service must be shown to the user
+	ShowPopup bool `json:"show_popup"`
+}
+
+func (*TermsOfService) GetType() string {
+	return TypeTermsOfService
+}
+
+func (*TermsOfService) GetConstructor() string {
+	return ConstructorTermsOfService
+}
+
+func (entity *TermsOfService) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TermsOfService) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TermsOfService) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"termsOfService"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"min_user_age":`)
+	encoder.writeInt32(entity.MinUserAge)
+	encoder.writeRaw(`,"show_popup":`)
+	encoder.writeBool(entity.ShowPopup)
+	encoder.writeRaw("}")
+}
+
+func (entity *TermsOfService) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "min_user_age":
+			entity.MinUserAge, err = decoder.readInt32()
+
+		case "show_popup":
+			entity.ShowPopup, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTermsOfService)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Device verification must be performed with the SafetyNet Attestation API
+type FirebaseDeviceVerificationParametersSafetyNet struct {
+	meta
+	// Nonce to pass to the SafetyNet Attestation API
+	Nonce []byte `json:"nonce"`
+}
+
+func (*FirebaseDeviceVerificationParametersSafetyNet) GetType() string {
+	return TypeFirebaseDeviceVerificationParameters
+}
+
+func (*FirebaseDeviceVerificationParametersSafetyNet) GetConstructor() string {
+	return ConstructorFirebaseDeviceVerificationParametersSafetyNet
+}
+
+func (*FirebaseDeviceVerificationParametersSafetyNet) FirebaseDeviceVerificationParametersConstructor() string {
+	return ConstructorFirebaseDeviceVerificationParametersSafetyNet
+}
+
+func (entity *FirebaseDeviceVerificationParametersSafetyNet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FirebaseDeviceVerificationParametersSafetyNet) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FirebaseDeviceVerificationParametersSafetyNet) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"firebaseDeviceVerificationParametersSafetyNet"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"nonce":`)
+	encoder.writeBytes(entity.Nonce)
+	encoder.writeRaw("}")
+}
+
+func (entity *FirebaseDeviceVerificationParametersSafetyNet) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "nonce":
+			entity.Nonce, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFirebaseDeviceVerificationParametersSafetyNet)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Device verification must be performed with the classic Play Integrity verification (https://developer.android.com/google/play/integrity/classic)
+type FirebaseDeviceVerificationParametersPlayIntegrity struct {
+	meta
+	// Base64url-encoded nonce to pass to the Play Integrity API
+	Nonce string `json:"nonce"`
+	// Cloud project number to pass to the Play Integrity API
+	CloudProjectNumber JsonInt64 `json:"cloud_project_number"`
+}
+
+func (*FirebaseDeviceVerificationParametersPlayIntegrity) GetType() string {
+	return TypeFirebaseDeviceVerificationParameters
+}
+
+func (*FirebaseDeviceVerificationParametersPlayIntegrity) GetConstructor() string {
+	return ConstructorFirebaseDeviceVerificationParametersPlayIntegrity
+}
+
+func (*FirebaseDeviceVerificationParametersPlayIntegrity) FirebaseDeviceVerificationParametersConstructor() string {
+	return ConstructorFirebaseDeviceVerificationParametersPlayIntegrity
+}
+
+func (entity *FirebaseDeviceVerificationParametersPlayIntegrity) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FirebaseDeviceVerificationParametersPlayIntegrity) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FirebaseDeviceVerificationParametersPlayIntegrity) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"firebaseDeviceVerificationParametersPlayIntegrity"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"nonce":`)
+	encoder.writeString(entity.Nonce)
+	encoder.writeRaw(`,"cloud_project_number":`)
+	encoder.writeJsonInt64(entity.CloudProjectNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *FirebaseDeviceVerificationParametersPlayIntegrity) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "nonce":
+			entity.Nonce, err = decoder.readString()
+
+		case "cloud_project_number":
+			entity.CloudProjectNumber, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFirebaseDeviceVerificationParametersPlayIntegrity)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about the current recovery email address
+type RecoveryEmailAddress struct {
+	meta
+	// Recovery email address
+	RecoveryEmailAddress string `json:"recovery_email_address"`
+}
+
+func (*RecoveryEmailAddress) GetType() string {
+	return TypeRecoveryEmailAddress
+}
+
+func (*RecoveryEmailAddress) GetConstructor() string {
+	return ConstructorRecoveryEmailAddress
+}
+
+func (entity *RecoveryEmailAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RecoveryEmailAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RecoveryEmailAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"recoveryEmailAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"recovery_email_address":`)
+	encoder.writeString(entity.RecoveryEmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (entity *RecoveryEmailAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "recovery_email_address":
+			entity.RecoveryEmailAddress, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRecoveryEmailAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Thumbnail image of a very poor quality and low resolution
+type Minithumbnail struct {
+	meta
+	// Thumbnail width, usually doesn't exceed 40
+	Width int32 `json:"width"`
+	// Thumbnail height, usually doesn't exceed 40
+	Height int32 `json:"height"`
+	// The thumbnail in JPEG format
+	Data []byte `json:"data"`
+}
+
+func (*Minithumbnail) GetType() string {
+	return TypeMinithumbnail
+}
+
+func (*Minithumbnail) GetConstructor() string {
+	return ConstructorMinithumbnail
+}
+
+func (entity *Minithumbnail) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Minithumbnail) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Minithumbnail) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"minithumbnail"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *Minithumbnail) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMinithumbnail)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The mask is placed relatively to the forehead
+type MaskPointForehead struct {
+	meta
+}
+
+func (*MaskPointForehead) GetType() string {
+	return TypeMaskPoint
+}
+
+func (*MaskPointForehead) GetConstructor() string {
+	return ConstructorMaskPointForehead
+}
+
+func (*MaskPointForehead) MaskPointConstructor() string {
+	return ConstructorMaskPointForehead
+}
+
+func (entity *MaskPointForehead) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MaskPointForehead) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MaskPointForehead) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"maskPointForehead"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MaskPointForehead) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMaskPointForehead)
+	})
+}
+
+// The mask is placed relatively to the eyes
+type MaskPointEyes struct {
+	meta
+}
+
+func (*MaskPointEyes) GetType() string {
+	return TypeMaskPoint
+}
+
+func (*MaskPointEyes) GetConstructor() string {
+	return ConstructorMaskPointEyes
+}
+
+func (*MaskPointEyes) MaskPointConstructor() string {
+	return ConstructorMaskPointEyes
+}
+
+func (entity *MaskPointEyes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MaskPointEyes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MaskPointEyes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"maskPointEyes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MaskPointEyes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMaskPointEyes)
+	})
+}
+
+// The mask is placed relatively to the mouth
+type MaskPointMouth struct {
+	meta
+}
+
+func (*MaskPointMouth) GetType() string {
+	return TypeMaskPoint
+}
+
+func (*MaskPointMouth) GetConstructor() string {
+	return ConstructorMaskPointMouth
+}
+
+func (*MaskPointMouth) MaskPointConstructor() string {
+	return ConstructorMaskPointMouth
+}
+
+func (entity *MaskPointMouth) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MaskPointMouth) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MaskPointMouth) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"maskPointMouth"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MaskPointMouth) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMaskPointMouth)
+	})
+}
+
+// The mask is placed relatively to the chin
+type MaskPointChin struct {
+	meta
+}
+
+func (*MaskPointChin) GetType() string {
+	return TypeMaskPoint
+}
+
+func (*MaskPointChin) GetConstructor() string {
+	return ConstructorMaskPointChin
+}
+
+func (*MaskPointChin) MaskPointConstructor() string {
+	return ConstructorMaskPointChin
+}
+
+func (entity *MaskPointChin) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MaskPointChin) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MaskPointChin) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"maskPointChin"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MaskPointChin) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMaskPointChin)
+	})
+}
+
+// Position on a photo where a mask is placed
+type MaskPosition struct {
+	meta
+	// Part of the face, relative to which the mask is placed
+	Point MaskPoint `json:"point"`
+	// Shift by X-axis measured in widths of the mask scaled to the face size, from left to right. (For example, -1.0 will place the mask just to the left of the default mask position)
+	XShift float64 `json:"x_shift"`
+	// Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom. (For example, 1.0 will place the mask just below the default mask position)
+	YShift float64 `json:"y_shift"`
+	// Mask scaling coefficient. (For example, 2.0 means a doubled size)
+	Scale float64 `json:"scale"`
+}
+
+func (*MaskPosition) GetType() string {
+	return TypeMaskPosition
+}
+
+func (*MaskPosition) GetConstructor() string {
+	return ConstructorMaskPosition
+}
+
+func (entity *MaskPosition) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MaskPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MaskPosition) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"maskPosition"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"point":`)
+	encoder.writeType(entity.Point)
+	encoder.writeRaw(`,"x_shift":`)
+	encoder.writeFloat64(entity.XShift)
+	encoder.writeRaw(`,"y_shift":`)
+	encoder.writeFloat64(entity.YShift)
+	encoder.writeRaw(`,"scale":`)
+	encoder.writeFloat64(entity.Scale)
+	encoder.writeRaw("}")
+}
+
+func (entity *MaskPosition) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "point":
+			entity.Point, err = decodeMaskPoint(decoder)
+
+		case "x_shift":
+			entity.XShift, err = decoder.readFloat64()
+
+		case "y_shift":
+			entity.YShift, err = decoder.readFloat64()
+
+		case "scale":
+			entity.Scale, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMaskPosition)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a closed vector path. The path begins at the end point of the last command. The coordinate system origin is in the upper-left corner
+type ClosedVectorPath struct {
+	meta
+	// List of vector path commands
+	Commands []VectorPathCommand `json:"commands"`
+}
+
+func (*ClosedVectorPath) GetType() string {
+	return TypeClosedVectorPath
+}
+
+func (*ClosedVectorPath) GetConstructor() string {
+	return ConstructorClosedVectorPath
+}
+
+func (entity *ClosedVectorPath) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ClosedVectorPath) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ClosedVectorPath) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"closedVectorPath"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"commands":`)
+	writeList(encoder, entity.Commands, writeTypeValue[VectorPathCommand])
+	encoder.writeRaw("}")
+}
+
+func (entity *ClosedVectorPath) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "commands":
+			entity.Commands, err = readList(decoder, decodeVectorPathCommand)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorClosedVectorPath)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents outline of an image
+type Outline struct {
+	meta
+	// The list of closed vector paths
+	Paths []*ClosedVectorPath `json:"paths"`
+}
+
+func (*Outline) GetType() string {
+	return TypeOutline
+}
+
+func (*Outline) GetConstructor() string {
+	return ConstructorOutline
+}
+
+func (entity *Outline) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Outline) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Outline) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"outline"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"paths":`)
+	writeList(encoder, entity.Paths, writeTypeValue[*ClosedVectorPath])
+	encoder.writeRaw("}")
+}
+
+func (entity *Outline) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "paths":
+			entity.Paths, err = readList(decoder, decodeEntity[ClosedVectorPath])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorOutline)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a location on planet Earth
+type Location struct {
+	meta
+	// Latitude of the location in degrees; as defined by the sender
+	Latitude float64 `json:"latitude"`
+	// Longitude of the location, in degrees; as defined by the sender
+	Longitude float64 `json:"longitude"`
+	// The estimated horizontal accuracy of the location, in meters; as defined by the sender. 0 if unknown
+	HorizontalAccuracy float64 `json:"horizontal_accuracy"`
+}
+
+func (*Location) GetType() string {
+	return TypeLocation
+}
+
+func (*Location) GetConstructor() string {
+	return ConstructorLocation
+}
+
+func (entity *Location) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Location) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Location) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"location"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"latitude":`)
+	encoder.writeFloat64(entity.Latitude)
+	encoder.writeRaw(`,"longitude":`)
+	encoder.writeFloat64(entity.Longitude)
+	encoder.writeRaw(`,"horizontal_accuracy":`)
+	encoder.writeFloat64(entity.HorizontalAccuracy)
+	encoder.writeRaw("}")
+}
+
+func (entity *Location) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "latitude":
+			entity.Latitude, err = decoder.readFloat64()
+
+		case "longitude":
+			entity.Longitude, err = decoder.readFloat64()
+
+		case "horizontal_accuracy":
+			entity.HorizontalAccuracy, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a venue
+type Venue struct {
+	meta
+	// Venue location; as defined by the sender
+	Location *Location `json:"location"`
+	// Venue name; as defined by the sender
+	Title string `json:"title"`
+	// Venue address; as defined by the sender
+	Address string `json:"address"`
+	// Provider of the venue database; as defined by the sender. Currently, only "foursquare" and "gplaces" (Google Places) need to be supported
+	Provider string `json:"provider"`
+	// Identifier of the venue in the provider database; as defined by the sender
+	Id string `json:"id"`
+	// Type of the venue in the provider database; as defined by the sender
+	Type string `json:"type"`
+}
+
+func (*Venue) GetType() string {
+	return TypeVenue
+}
+
+func (*Venue) GetConstructor() string {
+	return ConstructorVenue
+}
+
+func (entity *Venue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Venue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Venue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"venue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"address":`)
+	encoder.writeString(entity.Address)
+	encoder.writeRaw(`,"provider":`)
+	encoder.writeString(entity.Provider)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeString(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *Venue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "address":
+			entity.Address, err = decoder.readString()
+
+		case "provider":
+			entity.Provider, err = decoder.readString()
+
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "type":
+			entity.Type, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about verification status of a chat or a user
+type VerificationStatus struct {
+	meta
+	// True, if the chat or the user is verified by Telegram
+	IsVerified bool `json:"is_verified"`
+	// True, if the chat or the user is marked as scam by Telegram
+	IsScam bool `json:"is_scam"`
+	// True, if the chat or the user is marked as fake by Telegram
+	IsFake bool `json:"is_fake"`
+	// Identifier of the custom emoji to be shown as verification sign provided by a bot for the user; 0 if none
+	BotVerificationIconCustomEmojiId JsonInt64 `json:"bot_verification_icon_custom_emoji_id"`
+}
+
+func (*VerificationStatus) GetType() string {
+	return TypeVerificationStatus
+}
+
+func (*VerificationStatus) GetConstructor() string {
+	return ConstructorVerificationStatus
+}
+
+func (entity *VerificationStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *VerificationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *VerificationStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"verificationStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_verified":`)
+	encoder.writeBool(entity.IsVerified)
+	encoder.writeRaw(`,"is_scam":`)
+	encoder.writeBool(entity.IsScam)
+	encoder.writeRaw(`,"is_fake":`)
+	encoder.writeBool(entity.IsFake)
+	encoder.writeRaw(`,"bot_verification_icon_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.BotVerificationIconCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *VerificationStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_verified":
+			entity.IsVerified, err = decoder.readBool()
+
+		case "is_scam":
+			entity.IsScam, err = decoder.readBool()
+
+		case "is_fake":
+			entity.IsFake, err = decoder.readBool()
+
+		case "bot_verification_icon_custom_emoji_id":
+			entity.BotVerificationIconCustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVerificationStatus)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a birthdate of a user
+type Birthdate struct {
+	meta
+	// Day of the month; 1-31
+	Day int32 `json:"day"`
+	// Month of the year; 1-12
+	Month int32 `json:"month"`
+	// Birth year; 0 if unknown
+	Year int32 `json:"year"`
+}
+
+func (*Birthdate) GetType() string {
+	return TypeBirthdate
+}
+
+func (*Birthdate) GetConstructor() string {
+	return ConstructorBirthdate
+}
+
+func (entity *Birthdate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Birthdate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Birthdate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"birthdate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"day":`)
+	encoder.writeInt32(entity.Day)
+	encoder.writeRaw(`,"month":`)
+	encoder.writeInt32(entity.Month)
+	encoder.writeRaw(`,"year":`)
+	encoder.writeInt32(entity.Year)
+	encoder.writeRaw("}")
+}
+
+func (entity *Birthdate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "day":
+			entity.Day, err = decoder.readInt32()
+
+		case "month":
+			entity.Month, err = decoder.readInt32()
+
+		case "year":
+			entity.Year, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBirthdate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a product that can be paid with invoice
+type ProductInfo struct {
+	meta
+	// Product title
+	Title string `json:"title"`
+	// Product description
+	Description *FormattedText `json:"description"`
+	// Product photo; may be null
+	Photo *Photo `json:"photo,omitempty"`
+}
+
+func (*ProductInfo) GetType() string {
+	return TypeProductInfo
+}
+
+func (*ProductInfo) GetConstructor() string {
+	return ConstructorProductInfo
+}
+
+func (entity *ProductInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ProductInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ProductInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"productInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	entity.Description.encodeJSON(encoder)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *ProductInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decodeEntity[FormattedText](decoder)
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProductInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about supported accent color for user/chat name, background of empty chat photo, replies to messages and link previews
+type AccentColor struct {
+	meta
+	// Accent color identifier
+	Id int32 `json:"id"`
+	// Identifier of a built-in color to use in places, where only one color is needed; 0-6
+	BuiltInAccentColorId int32 `json:"built_in_accent_color_id"`
+	// The list of 1-3 colors in RGB format, describing the accent color, as expected to be shown in light themes
+	LightThemeColors []int32 `json:"light_theme_colors"`
+	// The list of 1-3 colors in RGB format, describing the accent color, as expected to be shown in dark themes
+	DarkThemeColors []int32 `json:"dark_theme_colors"`
+	// The minimum chat boost level required to use the color in a channel chat
+	MinChannelChatBoostLevel int32 `json:"min_channel_chat_boost_level"`
+}
+
+func (*AccentColor) GetType() string {
+	return TypeAccentColor
+}
+
+func (*AccentColor) GetConstructor() string {
+	return ConstructorAccentColor
+}
+
+func (entity *AccentColor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AccentColor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AccentColor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"accentColor"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"built_in_accent_color_id":`)
+	encoder.writeInt32(entity.BuiltInAccentColorId)
+	encoder.writeRaw(`,"light_theme_colors":`)
+	writeList(encoder, entity.LightThemeColors, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"dark_theme_colors":`)
+	writeList(encoder, entity.DarkThemeColors, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"min_channel_chat_boost_level":`)
+	encoder.writeInt32(entity.MinChannelChatBoostLevel)
+	encoder.writeRaw("}")
+}
+
+func (entity *AccentColor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "built_in_accent_color_id":
+			entity.BuiltInAccentColorId, err = decoder.readInt32()
+
+		case "light_theme_colors":
+			entity.LightThemeColors, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "dark_theme_colors":
+			entity.DarkThemeColors, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "min_channel_chat_boost_level":
+			entity.MinChannelChatBoostLevel, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAccentColor)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes usernames assigned to a user, a supergroup, or a channel
+type Usernames struct {
+	meta
+	// List of active usernames; the first one must be shown as the primary username. The order of active usernames can be changed with reorderActiveUsernames, reorderBotActiveUsernames or reorderSupergroupActiveUsernames
+	ActiveUsernames []string `json:"active_usernames"`
+	// List of currently disabled usernames; the username can be activated with toggleUsernameIsActive, toggleBotUsernameIsActive, or toggleSupergroupUsernameIsActive
+	DisabledUsernames []string `json:"disabled_usernames"`
+	// The active username, which can be changed with setUsername or setSupergroupUsername. Information about other active usernames can be received using getCollectibleItemInfo
+	EditableUsername string `json:"editable_username"`
+}
+
+func (*Usernames) GetType() string {
+	return TypeUsernames
+}
+
+func (*Usernames) GetConstructor() string {
+	return ConstructorUsernames
+}
+
+func (entity *Usernames) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Usernames) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Usernames) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"usernames"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"active_usernames":`)
+	writeList(encoder, entity.ActiveUsernames, (*jsonEncoder).writeString)
+	encoder.writeRaw(`,"disabled_usernames":`)
+	writeList(encoder, entity.DisabledUsernames, (*jsonEncoder).writeString)
+	encoder.writeRaw(`,"editable_username":`)
+	encoder.writeString(entity.EditableUsername)
+	encoder.writeRaw("}")
+}
+
+func (entity *Usernames) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "active_usernames":
+			entity.ActiveUsernames, err = readList(decoder, (*jsonDecoder).readString)
+
+		case "disabled_usernames":
+			entity.DisabledUsernames, err = readList(decoder, (*jsonDecoder).readString)
+
+		case "editable_username":
+			entity.EditableUsername, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUsernames)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a fact-check added to the message by an independent checker
+type FactCheck struct {
+	meta
+	// Text of the fact-check
+	Text *FormattedText `json:"text"`
+	// A two-letter ISO 3166-1 alpha-2 country code of the country for which the fact-check is shown
+	CountryCode string `json:"country_code"`
+}
+
+func (*FactCheck) GetType() string {
+	return TypeFactCheck
+}
+
+func (*FactCheck) GetConstructor() string {
+	return ConstructorFactCheck
+}
+
+func (entity *FactCheck) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FactCheck) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FactCheck) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"factCheck"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"country_code":`)
+	encoder.writeString(entity.CountryCode)
+	encoder.writeRaw("}")
+}
+
+func (entity *FactCheck) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "country_code":
+			entity.CountryCode, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFactCheck)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option to report an entity to Telegram
+type ReportOption struct {
+	meta
+	// Unique identifier of the option
+	Id []byte `json:"id"`
+	// Text of the option
+	Text string `json:"text"`
+}
+
+func (*ReportOption) GetType() string {
+	return TypeReportOption
+}
+
+func (*ReportOption) GetConstructor() string {
+	return ConstructorReportOption
+}
+
+func (entity *ReportOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeBytes(entity.Id)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readBytes()
+
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReportOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message was reported successfully
+type ReportSponsoredResultOk struct {
+	meta
+}
+
+func (*ReportSponsoredResultOk) GetType() string {
+	return TypeReportSponsoredResult
+}
+
+func (*ReportSponsoredResultOk) GetConstructor() string {
+	return ConstructorReportSponsoredResultOk
+}
+
+func (*ReportSponsoredResultOk) ReportSponsoredResultConstructor() string {
+	return ConstructorReportSponsoredResultOk
+}
+
+func (entity *ReportSponsoredResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportSponsoredResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportSponsoredResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSponsoredResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportSponsoredResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportSponsoredResultOk)
+	})
+}
+
+// The sponsored message is too old or not found
+type ReportSponsoredResultFailed struct {
+	meta
+}
+
+func (*ReportSponsoredResultFailed) GetType() string {
+	return TypeReportSponsoredResult
+}
+
+func (*ReportSponsoredResultFailed) GetConstructor() string {
+	return ConstructorReportSponsoredResultFailed
+}
+
+func (*ReportSponsoredResultFailed) ReportSponsoredResultConstructor() string {
+	return ConstructorReportSponsoredResultFailed
+}
+
+func (entity *ReportSponsoredResultFailed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportSponsoredResultFailed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportSponsoredResultFailed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSponsoredResultFailed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportSponsoredResultFailed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportSponsoredResultFailed)
+	})
+}
+
+// The user must choose an option to report the message and repeat request with the chosen option
+type ReportSponsoredResultOptionRequired struct {
+	meta
+	// Title for the option choice
+	Title string `json:"title"`
+	// List of available options
+	Options []*ReportOption `json:"options"`
+}
+
+func (*ReportSponsoredResultOptionRequired) GetType() string {
+	return TypeReportSponsoredResult
+}
+
+func (*ReportSponsoredResultOptionRequired) GetConstructor() string {
+	return ConstructorReportSponsoredResultOptionRequired
+}
+
+func (*ReportSponsoredResultOptionRequired) ReportSponsoredResultConstructor() string {
+	return ConstructorReportSponsoredResultOptionRequired
+}
+
+func (entity *ReportSponsoredResultOptionRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportSponsoredResultOptionRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportSponsoredResultOptionRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSponsoredResultOptionRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*ReportOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportSponsoredResultOptionRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[ReportOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReportSponsoredResultOptionRequired)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Sponsored messages were hidden for the user in all chats
+type ReportSponsoredResultAdsHidden struct {
+	meta
+}
+
+func (*ReportSponsoredResultAdsHidden) GetType() string {
+	return TypeReportSponsoredResult
+}
+
+func (*ReportSponsoredResultAdsHidden) GetConstructor() string {
+	return ConstructorReportSponsoredResultAdsHidden
+}
+
+func (*ReportSponsoredResultAdsHidden) ReportSponsoredResultConstructor() string {
+	return ConstructorReportSponsoredResultAdsHidden
+}
+
+func (entity *ReportSponsoredResultAdsHidden) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportSponsoredResultAdsHidden) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportSponsoredResultAdsHidden) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSponsoredResultAdsHidden"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportSponsoredResultAdsHidden) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportSponsoredResultAdsHidden)
+	})
+}
+
+// The user asked to hide sponsored messages, but Telegram Premium is required for this
+type ReportSponsoredResultPremiumRequired struct {
+	meta
+}
+
+func (*ReportSponsoredResultPremiumRequired) GetType() string {
+	return TypeReportSponsoredResult
+}
+
+func (*ReportSponsoredResultPremiumRequired) GetConstructor() string {
+	return ConstructorReportSponsoredResultPremiumRequired
+}
+
+func (*ReportSponsoredResultPremiumRequired) ReportSponsoredResultConstructor() string {
+	return ConstructorReportSponsoredResultPremiumRequired
+}
+
+func (entity *ReportSponsoredResultPremiumRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportSponsoredResultPremiumRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportSponsoredResultPremiumRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportSponsoredResultPremiumRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportSponsoredResultPremiumRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportSponsoredResultPremiumRequired)
+	})
+}
+
+// Notification settings applied to all private and secret chats when the corresponding chat setting has a default value
+type NotificationSettingsScopePrivateChats struct {
+	meta
+}
+
+func (*NotificationSettingsScopePrivateChats) GetType() string {
+	return TypeNotificationSettingsScope
+}
+
+func (*NotificationSettingsScopePrivateChats) GetConstructor() string {
+	return ConstructorNotificationSettingsScopePrivateChats
+}
+
+func (*NotificationSettingsScopePrivateChats) NotificationSettingsScopeConstructor() string {
+	return ConstructorNotificationSettingsScopePrivateChats
+}
+
+func (entity *NotificationSettingsScopePrivateChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationSettingsScopePrivateChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationSettingsScopePrivateChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationSettingsScopePrivateChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationSettingsScopePrivateChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationSettingsScopePrivateChats)
+	})
+}
+
+// Notification settings applied to all basic group and supergroup chats when the corresponding chat setting has a default value
+type NotificationSettingsScopeGroupChats struct {
+	meta
+}
+
+func (*NotificationSettingsScopeGroupChats) GetType() string {
+	return TypeNotificationSettingsScope
+}
+
+func (*NotificationSettingsScopeGroupChats) GetConstructor() string {
+	return ConstructorNotificationSettingsScopeGroupChats
+}
+
+func (*NotificationSettingsScopeGroupChats) NotificationSettingsScopeConstructor() string {
+	return ConstructorNotificationSettingsScopeGroupChats
+}
+
+func (entity *NotificationSettingsScopeGroupChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationSettingsScopeGroupChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationSettingsScopeGroupChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationSettingsScopeGroupChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationSettingsScopeGroupChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationSettingsScopeGroupChats)
+	})
+}
+
+// Notification settings applied to all channel chats when the corresponding chat setting has a default value
+type NotificationSettingsScopeChannelChats struct {
+	meta
+}
+
+func (*NotificationSettingsScopeChannelChats) GetType() string {
+	return TypeNotificationSettingsScope
+}
+
+func (*NotificationSettingsScopeChannelChats) GetConstructor() string {
+	return ConstructorNotificationSettingsScopeChannelChats
+}
+
+func (*NotificationSettingsScopeChannelChats) NotificationSettingsScopeConstructor() string {
+	return ConstructorNotificationSettingsScopeChannelChats
+}
+
+func (entity *NotificationSettingsScopeChannelChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationSettingsScopeChannelChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationSettingsScopeChannelChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationSettingsScopeChannelChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationSettingsScopeChannelChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationSettingsScopeChannelChats)
+	})
+}
+
+// Contains information about notification settings for several chats
+type ScopeNotificationSettings struct {
+	meta
+	// Time left before notifications will be unmuted, in seconds
+	MuteFor int32 `json:"mute_for"`
+	// Identifier of the notification sound to be played; 0 if sound is disabled
+	SoundId JsonInt64 `json:"sound_id"`
+	// True, if message content must be displayed in notifications
+	ShowPreview bool `json:"show_preview"`
+	// If true, story notifications are received only for the first 5 chats from topChatCategoryUsers regardless of the value of mute_stories
+	UseDefaultMuteStories bool `json:"use_default_mute_stories"`
+	// True, if story notifications are disabled
+	MuteStories bool `json:"mute_stories"`
+	// Identifier of the notification sound to be played for stories; 0 if sound is disabled
+	StorySoundId JsonInt64 `json:"story_sound_id"`
+	// True, if the sender of stories must be displayed in notifications
+	ShowStorySender bool `json:"show_story_sender"`
+	// True, if notifications for incoming pinned messages will be created as for an ordinary unread message
+	DisablePinnedMessageNotifications bool `json:"disable_pinned_message_notifications"`
+	// True, if notifications for messages with mentions will be created as for an ordinary unread message
+	DisableMentionNotifications bool `json:"disable_mention_notifications"`
+}
+
+func (*ScopeNotificationSettings) GetType() string {
+	return TypeScopeNotificationSettings
+}
+
+func (*ScopeNotificationSettings) GetConstructor() string {
+	return ConstructorScopeNotificationSettings
+}
+
+func (entity *ScopeNotificationSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ScopeNotificationSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ScopeNotificationSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"scopeNotificationSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"mute_for":`)
+	encoder.writeInt32(entity.MuteFor)
+	encoder.writeRaw(`,"sound_id":`)
+	encoder.writeJsonInt64(entity.SoundId)
+	encoder.writeRaw(`,"show_preview":`)
+	encoder.writeBool(entity.ShowPreview)
+	encoder.writeRaw(`,"use_default_mute_stories":`)
+	encoder.writeBool(entity.UseDefaultMuteStories)
+	encoder.writeRaw(`,"mute_stories":`)
+	encoder.writeBool(entity.MuteStories)
+	encoder.writeRaw(`,"story_sound_id":`)
+	encoder.writeJsonInt64(entity.StorySoundId)
+	encoder.writeRaw(`,"show_story_sender":`)
+	encoder.writeBool(entity.ShowStorySender)
+	encoder.writeRaw(`,"disable_pinned_message_notifications":`)
+	encoder.writeBool(entity.DisablePinnedMessageNotifications)
+	encoder.writeRaw(`,"disable_mention_notifications":`)
+	encoder.writeBool(entity.DisableMentionNotifications)
+	encoder.writeRaw("}")
+}
+
+func (entity *ScopeNotificationSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "mute_for":
+			entity.MuteFor, err = decoder.readInt32()
+
+		case "sound_id":
+			entity.SoundId, err = decoder.readJsonInt64()
+
+		case "show_preview":
+			entity.ShowPreview, err = decoder.readBool()
+
+		case "use_default_mute_stories":
+			entity.UseDefaultMuteStories, err = decoder.readBool()
+
+		case "mute_stories":
+			entity.MuteStories, err = decoder.readBool()
+
+		case "story_sound_id":
+			entity.StorySoundId, err = decoder.readJsonInt64()
+
+		case "show_story_sender":
+			entity.ShowStorySender, err = decoder.readBool()
+
+		case "disable_pinned_message_notifications":
+			entity.DisablePinnedMessageNotifications, err = decoder.readBool()
+
+		case "disable_mention_notifications":
+			entity.DisableMentionNotifications, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorScopeNotificationSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a user that has failed to be added to a chat
+type FailedToAddMember struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// True, if subscription to Telegram Premium would have allowed to add the user to the chat
+	PremiumWouldAllowInvite bool `json:"premium_would_allow_invite"`
+	// True, if subscription to Telegram Premium is required to send the user chat invite link
+	PremiumRequiredToSendMessages bool `json:"premium_required_to_send_messages"`
+}
+
+func (*FailedToAddMember) GetType() string {
+	return TypeFailedToAddMember
+}
+
+func (*FailedToAddMember) GetConstructor() string {
+	return ConstructorFailedToAddMember
+}
+
+func (entity *FailedToAddMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FailedToAddMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FailedToAddMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"failedToAddMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"premium_would_allow_invite":`)
+	encoder.writeBool(entity.PremiumWouldAllowInvite)
+	encoder.writeRaw(`,"premium_required_to_send_messages":`)
+	encoder.writeBool(entity.PremiumRequiredToSendMessages)
+	encoder.writeRaw("}")
+}
+
+func (entity *FailedToAddMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "premium_would_allow_invite":
+			entity.PremiumWouldAllowInvite, err = decoder.readBool()
+
+		case "premium_required_to_send_messages":
+			entity.PremiumRequiredToSendMessages, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFailedToAddMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of users that has failed to be added to a chat
+type FailedToAddMembers struct {
+	meta
+	// Information about users that weren't added to the chat
+	FailedToAddMembers []*FailedToAddMember `json:"failed_to_add_members"`
+}
+
+func (*FailedToAddMembers) GetType() string {
+	return TypeFailedToAddMembers
+}
+
+func (*FailedToAddMembers) GetConstructor() string {
+	return ConstructorFailedToAddMembers
+}
+
+func (entity *FailedToAddMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FailedToAddMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FailedToAddMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"failedToAddMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"failed_to_add_members":`)
+	writeList(encoder, entity.FailedToAddMembers, writeTypeValue[*FailedToAddMember])
+	encoder.writeRaw("}")
+}
+
+func (entity *FailedToAddMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "failed_to_add_members":
+			entity.FailedToAddMembers, err = readList(decoder, decodeEntity[FailedToAddMember])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFailedToAddMembers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains basic information about another user that started a chat with the current user
+type AccountInfo struct {
+	meta
+	// Month when the user was registered in Telegram; 0-12; may be 0 if unknown
+	RegistrationMonth int32 `json:"registration_month"`
+	// Year when the user was registered in Telegram; 0-9999; may be 0 if unknown
+	RegistrationYear int32 `json:"registration_year"`
+	// A two-letter ISO 3166-1 alpha-2 country code based on the phone number of the user; may be empty if unknown
+	PhoneNumberCountryCode string `json:"phone_number_country_code"`
+	// Point in time (Unix timestamp) when the user changed name last time; 0 if unknown
+	LastNameChangeDate int32 `json:"last_name_change_date"`
+	// Point in time (Unix timestamp) when the user changed photo last time; 0 if unknown
+	LastPhotoChangeDate int32 `json:"last_photo_change_date"`
+}
+
+func (*AccountInfo) GetType() string {
+	return TypeAccountInfo
+}
+
+func (*AccountInfo) GetConstructor() string {
+	return ConstructorAccountInfo
+}
+
+func (entity *AccountInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AccountInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AccountInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"accountInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"registration_month":`)
+	encoder.writeInt32(entity.RegistrationMonth)
+	encoder.writeRaw(`,"registration_year":`)
+	encoder.writeInt32(entity.RegistrationYear)
+	encoder.writeRaw(`,"phone_number_country_code":`)
+	encoder.writeString(entity.PhoneNumberCountryCode)
+	encoder.writeRaw(`,"last_name_change_date":`)
+	encoder.writeInt32(entity.LastNameChangeDate)
+	encoder.writeRaw(`,"last_photo_change_date":`)
+	encoder.writeInt32(entity.LastPhotoChangeDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *AccountInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "registration_month":
+			entity.RegistrationMonth, err = decoder.readInt32()
+
+		case "registration_year":
+			entity.RegistrationYear, err = decoder.readInt32()
+
+		case "phone_number_country_code":
+			entity.PhoneNumberCountryCode, err = decoder.readString()
+
+		case "last_name_change_date":
+			entity.LastNameChangeDate, err = decoder.readInt32()
+
+		case "last_photo_change_date":
+			entity.LastPhotoChangeDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAccountInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains parameters of the application theme
+type ThemeParameters struct {
+	meta
+	// A color of the background in the RGB format
+	BackgroundColor int32 `json:"background_color"`
+	// A secondary color for the background in the RGB format
+	SecondaryBackgroundColor int32 `json:"secondary_background_color"`
+	// A color of the header background in the RGB format
+	HeaderBackgroundColor int32 `json:"header_background_color"`
+	// A color of the bottom bar background in the RGB format
+	BottomBarBackgroundColor int32 `json:"bottom_bar_background_color"`
+	// A color of the section background in the RGB format
+	SectionBackgroundColor int32 `json:"section_background_color"`
+	// A color of the section separator in the RGB format
+	SectionSeparatorColor int32 `json:"section_separator_color"`
+	// A color of text in the RGB format
+	TextColor int32 `json:"text_color"`
+	// An accent color of the text in the RGB format
+	AccentTextColor int32 `json:"accent_text_color"`
+	// A color of text on the section headers in the RGB format
+	SectionHeaderTextColor int32 `json:"section_header_text_color"`
+	// A color of the subtitle text in the RGB format
+	SubtitleTextColor int32 `json:"subtitle_text_color"`
+	// A color of the text for destructive actions in the RGB format
+	DestructiveTextColor int32 `json:"destructive_text_color"`
+	// A color of hints in the RGB format
+	HintColor int32 `json:"hint_color"`
+	// A color of links in the RGB format
+	LinkColor int32 `json:"link_color"`
+	// A color of the buttons in the RGB format
+	ButtonColor int32 `json:"button_color"`
+	// A color of text on the buttons in the RGB format
+	ButtonTextColor int32 `json:"button_text_color"`
+}
+
+func (*ThemeParameters) GetType() string {
+	return TypeThemeParameters
+}
+
+func (*ThemeParameters) GetConstructor() string {
+	return ConstructorThemeParameters
+}
+
+func (entity *ThemeParameters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThemeParameters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThemeParameters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"themeParameters"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"background_color":`)
+	encoder.writeInt32(entity.BackgroundColor)
+	encoder.writeRaw(`,"secondary_background_color":`)
+	encoder.writeInt32(entity.SecondaryBackgroundColor)
+	encoder.writeRaw(`,"header_background_color":`)
+	encoder.writeInt32(entity.HeaderBackgroundColor)
+	encoder.writeRaw(`,"bottom_bar_background_color":`)
+	encoder.writeInt32(entity.BottomBarBackgroundColor)
+	encoder.writeRaw(`,"section_background_color":`)
+	encoder.writeInt32(entity.SectionBackgroundColor)
+	encoder.writeRaw(`,"section_separator_color":`)
+	encoder.writeInt32(entity.SectionSeparatorColor)
+	encoder.writeRaw(`,"text_color":`)
+	encoder.writeInt32(entity.TextColor)
+	encoder.writeRaw(`,"accent_text_color":`)
+	encoder.writeInt32(entity.AccentTextColor)
+	encoder.writeRaw(`,"section_header_text_color":`)
+	encoder.writeInt32(entity.SectionHeaderTextColor)
+	encoder.writeRaw(`,"subtitle_text_color":`)
+	encoder.writeInt32(entity.SubtitleTextColor)
+	encoder.writeRaw(`,"destructive_text_color":`)
+	encoder.writeInt32(entity.DestructiveTextColor)
+	encoder.writeRaw(`,"hint_color":`)
+	encoder.writeInt32(entity.HintColor)
+	encoder.writeRaw(`,"link_color":`)
+	encoder.writeInt32(entity.LinkColor)
+	encoder.writeRaw(`,"button_color":`)
+	encoder.writeInt32(entity.ButtonColor)
+	encoder.writeRaw(`,"button_text_color":`)
+	encoder.writeInt32(entity.ButtonTextColor)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThemeParameters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "background_color":
+			entity.BackgroundColor, err = decoder.readInt32()
+
+		case "secondary_background_color":
+			entity.SecondaryBackgroundColor, err = decoder.readInt32()
+
+		case "header_background_color":
+			entity.HeaderBackgroundColor, err = decoder.readInt32()
+
+		case "bottom_bar_background_color":
+			entity.BottomBarBackgroundColor, err = decoder.readInt32()
+
+		case "section_background_color":
+			entity.SectionBackgroundColor, err = decoder.readInt32()
+
+		case "section_separator_color":
+			entity.SectionSeparatorColor, err = decoder.readInt32()
+
+		case "text_color":
+			entity.TextColor, err = decoder.readInt32()
+
+		case "accent_text_color":
+			entity.AccentTextColor, err = decoder.readInt32()
+
+		case "section_header_text_color":
+			entity.SectionHeaderTextColor, err = decoder.readInt32()
+
+		case "subtitle_text_color":
+			entity.SubtitleTextColor, err = decoder.readInt32()
+
+		case "destructive_text_color":
+			entity.DestructiveTextColor, err = decoder.readInt32()
+
+		case "hint_color":
+			entity.HintColor, err = decoder.readInt32()
+
+		case "link_color":
+			entity.LinkColor, err = decoder.readInt32()
+
+		case "button_color":
+			entity.ButtonColor, err = decoder.readInt32()
+
+		case "button_text_color":
+			entity.ButtonTextColor, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorThemeParameters)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Options to be used for generation of a link preview
+type LinkPreviewOptions struct {
+	meta
+	// True, if link preview must be disabled
+	IsDisabled bool `json:"is_disabled"`
+	// URL to use for link preview. If empty, then the first URL found in the message text will be used
+	Url string `json:"url"`
+	// True, if shown media preview must be small; ignored in secret chats or if the URL isn't explicitly specified
+	ForceSmallMedia bool `json:"force_small_media"`
+	// True, if shown media preview must be large; ignored in secret chats or if the URL isn't explicitly specified
+	ForceLargeMedia bool `json:"force_large_media"`
+	// True, if link preview must be shown above message text; otherwise, the link preview will be shown below the message text; ignored in secret chats
+	ShowAboveText bool `json:"show_above_text"`
+}
+
+func (*LinkPreviewOptions) GetType() string {
+	return TypeLinkPreviewOptions
+}
+
+func (*LinkPreviewOptions) GetConstructor() string {
+	return ConstructorLinkPreviewOptions
+}
+
+func (entity *LinkPreviewOptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewOptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewOptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewOptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_disabled":`)
+	encoder.writeBool(entity.IsDisabled)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"force_small_media":`)
+	encoder.writeBool(entity.ForceSmallMedia)
+	encoder.writeRaw(`,"force_large_media":`)
+	encoder.writeBool(entity.ForceLargeMedia)
+	encoder.writeRaw(`,"show_above_text":`)
+	encoder.writeBool(entity.ShowAboveText)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewOptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_disabled":
+			entity.IsDisabled, err = decoder.readBool()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "force_small_media":
+			entity.ForceSmallMedia, err = decoder.readBool()
+
+		case "force_large_media":
+			entity.ForceLargeMedia, err = decoder.readBool()
+
+		case "show_above_text":
+			entity.ShowAboveText, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewOptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes theme settings
+type ThemeSettings struct {
+	meta
+	// Theme accent color in ARGB format
+	AccentColor int32 `json:"accent_color"`
+	// The background to be used in chats; may be null
+	Background *Background `json:"background,omitempty"`
+	// The fill to be used as a background for outgoing messages
+	OutgoingMessageFill BackgroundFill `json:"outgoing_message_fill"`
+	// If true, the freeform gradient fill needs to be animated on every sent message
+	AnimateOutgoingMessageFill bool `json:"animate_outgoing_message_fill"`
+	// Accent color of outgoing messages in ARGB format
+	OutgoingMessageAccentColor int32 `json:"outgoing_message_accent_color"`
+}
+
+func (*ThemeSettings) GetType() string {
+	return TypeThemeSettings
+}
+
+func (*ThemeSettings) GetConstructor() string {
+	return ConstructorThemeSettings
+}
+
+func (entity *ThemeSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThemeSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThemeSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"themeSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"accent_color":`)
+	encoder.writeInt32(entity.AccentColor)
+	if entity.Background != nil {
+		encoder.writeRaw(`,"background":`)
+		entity.Background.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"outgoing_message_fill":`)
+	encoder.writeType(entity.OutgoingMessageFill)
+	encoder.writeRaw(`,"animate_outgoing_message_fill":`)
+	encoder.writeBool(entity.AnimateOutgoingMessageFill)
+	encoder.writeRaw(`,"outgoing_message_accent_color":`)
+	encoder.writeInt32(entity.OutgoingMessageAccentColor)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThemeSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "accent_color":
+			entity.AccentColor, err = decoder.readInt32()
+
+		case "background":
+			entity.Background, err = decodeEntity[Background](decoder)
+
+		case "outgoing_message_fill":
+			entity.OutgoingMessageFill, err = decodeBackgroundFill(decoder)
+
+		case "animate_outgoing_message_fill":
+			entity.AnimateOutgoingMessageFill, err = decoder.readBool()
+
+		case "outgoing_message_accent_color":
+			entity.OutgoingMessageAccentColor, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorThemeSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a caption of another block
+type PageBlockCaption struct {
+	meta
+	// Content of the caption
+	Text RichText `json:"text"`
+	// Block credit (like HTML tag <cite>)
+	Credit RichText `json:"credit"`
+}
+
+func (*PageBlockCaption) GetType() string {
+	return TypePageBlockCaption
+}
+
+func (*PageBlockCaption) GetConstructor() string {
+	return ConstructorPageBlockCaption
+}
+
+func (entity *PageBlockCaption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockCaption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockCaption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockCaption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"credit":`)
+	encoder.writeType(entity.Credit)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockCaption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "credit":
+			entity.Credit, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockCaption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an item of a list page block
+type PageBlockListItem struct {
+	meta
+	// Item label
+	Label string `json:"label"`
+	// Item blocks
+	PageBlocks []PageBlock `json:"page_blocks"`
+}
+
+func (*PageBlockListItem) GetType() string {
+	return TypePageBlockListItem
+}
+
+func (*PageBlockListItem) GetConstructor() string {
+	return ConstructorPageBlockListItem
+}
+
+func (entity *PageBlockListItem) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockListItem) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockListItem) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockListItem"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"label":`)
+	encoder.writeString(entity.Label)
+	encoder.writeRaw(`,"page_blocks":`)
+	writeList(encoder, entity.PageBlocks, writeTypeValue[PageBlock])
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockListItem) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "label":
+			entity.Label, err = decoder.readString()
+
+		case "page_blocks":
+			entity.PageBlocks, err = readList(decoder, decodePageBlock)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockListItem)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The content must be left-aligned
+type PageBlockHorizontalAlignmentLeft struct {
+	meta
+}
+
+func (*PageBlockHorizontalAlignmentLeft) GetType() string {
+	return TypePageBlockHorizontalAlignment
+}
+
+func (*PageBlockHorizontalAlignmentLeft) GetConstructor() string {
+	return ConstructorPageBlockHorizontalAlignmentLeft
+}
+
+func (*PageBlockHorizontalAlignmentLeft) PageBlockHorizontalAlignmentConstructor() string {
+	return ConstructorPageBlockHorizontalAlignmentLeft
+}
+
+func (entity *PageBlockHorizontalAlignmentLeft) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockHorizontalAlignmentLeft) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockHorizontalAlignmentLeft) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockHorizontalAlignmentLeft"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockHorizontalAlignmentLeft) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPageBlockHorizontalAlignmentLeft)
+	})
+}
+
+// The content must be center-aligned
+type PageBlockHorizontalAlignmentCenter struct {
+	meta
+}
+
+func (*PageBlockHorizontalAlignmentCenter) GetType() string {
+	return TypePageBlockHorizontalAlignment
+}
+
+func (*PageBlockHorizontalAlignmentCenter) GetConstructor() string {
+	return ConstructorPageBlockHorizontalAlignmentCenter
+}
+
+func (*PageBlockHorizontalAlignmentCenter) PageBlockHorizontalAlignmentConstructor() string {
+	return ConstructorPageBlockHorizontalAlignmentCenter
+}
+
+func (entity *PageBlockHorizontalAlignmentCenter) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockHorizontalAlignmentCenter) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockHorizontalAlignmentCenter) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockHorizontalAlignmentCenter"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockHorizontalAlignmentCenter) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPageBlockHorizontalAlignmentCenter)
+	})
+}
+
+// The content must be right-aligned
+type PageBlockHorizontalAlignmentRight struct {
+	meta
+}
+
+func (*PageBlockHorizontalAlignmentRight) GetType() string {
+	return TypePageBlockHorizontalAlignment
+}
+
+func (*PageBlockHorizontalAlignmentRight) GetConstructor() string {
+	return ConstructorPageBlockHorizontalAlignmentRight
+}
+
+func (*PageBlockHorizontalAlignmentRight) PageBlockHorizontalAlignmentConstructor() string {
+	return ConstructorPageBlockHorizontalAlignmentRight
+}
+
+func (entity *PageBlockHorizontalAlignmentRight) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockHorizontalAlignmentRight) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockHorizontalAlignmentRight) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockHorizontalAlignmentRight"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockHorizontalAlignmentRight) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPageBlockHorizontalAlignmentRight)
+	})
+}
+
+// The content must be top-aligned
+type PageBlockVerticalAlignmentTop struct {
+	meta
+}
+
+func (*PageBlockVerticalAlignmentTop) GetType() string {
+	return TypePageBlockVerticalAlignment
+}
+
+func (*PageBlockVerticalAlignmentTop) GetConstructor() string {
+	return ConstructorPageBlockVerticalAlignmentTop
+}
+
+func (*PageBlockVerticalAlignmentTop) PageBlockVerticalAlignmentConstructor() string {
+	return ConstructorPageBlockVerticalAlignmentTop
+}
+
+func (entity *PageBlockVerticalAlignmentTop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockVerticalAlignmentTop) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockVerticalAlignmentTop) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockVerticalAlignmentTop"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockVerticalAlignmentTop) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPageBlockVerticalAlignmentTop)
+	})
+}
+
+// The content must be middle-aligned
+type PageBlockVerticalAlignmentMiddle struct {
+	meta
+}
+
+func (*PageBlockVerticalAlignmentMiddle) GetType() string {
+	return TypePageBlockVerticalAlignment
+}
+
+func (*PageBlockVerticalAlignmentMiddle) GetConstructor() string {
+	return ConstructorPageBlockVerticalAlignmentMiddle
+}
+
+func (*PageBlockVerticalAlignmentMiddle) PageBlockVerticalAlignmentConstructor() string {
+	return ConstructorPageBlockVerticalAlignmentMiddle
+}
+
+func (entity *PageBlockVerticalAlignmentMiddle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockVerticalAlignmentMiddle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockVerticalAlignmentMiddle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockVerticalAlignmentMiddle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockVerticalAlignmentMiddle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPageBlockVerticalAlignmentMiddle)
+	})
+}
+
+// The content must be bottom-aligned
+type PageBlockVerticalAlignmentBottom struct {
+	meta
+}
+
+func (*PageBlockVerticalAlignmentBottom) GetType() string {
+	return TypePageBlockVerticalAlignment
+}
+
+func (*PageBlockVerticalAlignmentBottom) GetConstructor() string {
+	return ConstructorPageBlockVerticalAlignmentBottom
+}
+
+func (*PageBlockVerticalAlignmentBottom) PageBlockVerticalAlignmentConstructor() string {
+	return ConstructorPageBlockVerticalAlignmentBottom
+}
+
+func (entity *PageBlockVerticalAlignmentBottom) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockVerticalAlignmentBottom) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockVerticalAlignmentBottom) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockVerticalAlignmentBottom"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockVerticalAlignmentBottom) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPageBlockVerticalAlignmentBottom)
+	})
+}
+
+// Represents a cell of a table
+type PageBlockTableCell struct {
+	meta
+	// Cell text; may be null. If the text is null, then the cell must be invisible
+	Text RichText `json:"text,omitempty"`
+	// True, if it is a header cell
+	IsHeader bool `json:"is_header"`
+	// The number of columns the cell spans
+	Colspan int32 `json:"colspan"`
+	// The number of rows the cell spans
+	Rowspan int32 `json:"rowspan"`
+	// Horizontal cell content alignment
+	Align PageBlockHorizontalAlignment `json:"align"`
+	// Vertical cell content alignment
+	Valign PageBlockVerticalAlignment `json:"valign"`
+}
+
+func (*PageBlockTableCell) GetType() string {
+	return TypePageBlockTableCell
+}
+
+func (*PageBlockTableCell) GetConstructor() string {
+	return ConstructorPageBlockTableCell
+}
+
+func (entity *PageBlockTableCell) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockTableCell) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockTableCell) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockTableCell"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Text != nil {
+		encoder.writeRaw(`,"text":`)
+		encoder.writeType(entity.Text)
+	}
+	encoder.writeRaw(`,"is_header":`)
+	encoder.writeBool(entity.IsHeader)
+	encoder.writeRaw(`,"colspan":`)
+	encoder.writeInt32(entity.Colspan)
+	encoder.writeRaw(`,"rowspan":`)
+	encoder.writeInt32(entity.Rowspan)
+	encoder.writeRaw(`,"align":`)
+	encoder.writeType(entity.Align)
+	encoder.writeRaw(`,"valign":`)
+	encoder.writeType(entity.Valign)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockTableCell) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "is_header":
+			entity.IsHeader, err = decoder.readBool()
+
+		case "colspan":
+			entity.Colspan, err = decoder.readInt32()
+
+		case "rowspan":
+			entity.Rowspan, err = decoder.readInt32()
+
+		case "align":
+			entity.Align, err = decodePageBlockHorizontalAlignment(decoder)
+
+		case "valign":
+			entity.Valign, err = decodePageBlockVerticalAlignment(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockTableCell)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a related article
+type PageBlockRelatedArticle struct {
+	meta
+	// Related article URL
+	Url string `json:"url"`
+	// Article title; may be empty
+	Title string `json:"title"`
+	// Article description; may be empty
+	Description string `json:"description"`
+	// Article photo; may be null
+	Photo *Photo `json:"photo,omitempty"`
+	// Article author; may be empty
+	Author string `json:"author"`
+	// Point in time (Unix timestamp) when the article was published; 0 if unknown
+	PublishDate int32 `json:"publish_date"`
+}
+
+func (*PageBlockRelatedArticle) GetType() string {
+	return TypePageBlockRelatedArticle
+}
+
+func (*PageBlockRelatedArticle) GetConstructor() string {
+	return ConstructorPageBlockRelatedArticle
+}
+
+func (entity *PageBlockRelatedArticle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockRelatedArticle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockRelatedArticle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockRelatedArticle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"author":`)
+	encoder.writeString(entity.Author)
+	encoder.writeRaw(`,"publish_date":`)
+	encoder.writeInt32(entity.PublishDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockRelatedArticle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "author":
+			entity.Author, err = decoder.readString()
+
+		case "publish_date":
+			entity.PublishDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockRelatedArticle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The title of a page
+type PageBlockTitle struct {
+	meta
+	// Title
+	Title RichText `json:"title"`
+}
+
+func (*PageBlockTitle) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockTitle) GetConstructor() string {
+	return ConstructorPageBlockTitle
+}
+
+func (*PageBlockTitle) PageBlockConstructor() string {
+	return ConstructorPageBlockTitle
+}
+
+func (entity *PageBlockTitle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockTitle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockTitle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockTitle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeType(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockTitle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockTitle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The subtitle of a page
+type PageBlockSubtitle struct {
+	meta
+	// Subtitle
+	Subtitle RichText `json:"subtitle"`
+}
+
+func (*PageBlockSubtitle) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockSubtitle) GetConstructor() string {
+	return ConstructorPageBlockSubtitle
+}
+
+func (*PageBlockSubtitle) PageBlockConstructor() string {
+	return ConstructorPageBlockSubtitle
+}
+
+func (entity *PageBlockSubtitle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockSubtitle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockSubtitle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockSubtitle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"subtitle":`)
+	encoder.writeType(entity.Subtitle)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockSubtitle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "subtitle":
+			entity.Subtitle, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockSubtitle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The author and publishing date of a page
+type PageBlockAuthorDate struct {
+	meta
+	// Author
+	Author RichText `json:"author"`
+	// Point in time (Unix timestamp) when the article was published; 0 if unknown
+	PublishDate int32 `json:"publish_date"`
+}
+
+func (*PageBlockAuthorDate) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockAuthorDate) GetConstructor() string {
+	return ConstructorPageBlockAuthorDate
+}
+
+func (*PageBlockAuthorDate) PageBlockConstructor() string {
+	return ConstructorPageBlockAuthorDate
+}
+
+func (entity *PageBlockAuthorDate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockAuthorDate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockAuthorDate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockAuthorDate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"author":`)
+	encoder.writeType(entity.Author)
+	encoder.writeRaw(`,"publish_date":`)
+	encoder.writeInt32(entity.PublishDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockAuthorDate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "author":
+			entity.Author, err = decodeRichText(decoder)
+
+		case "publish_date":
+			entity.PublishDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockAuthorDate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A header
+type PageBlockHeader struct {
+	meta
+	// Header
+	Header RichText `json:"header"`
+}
+
+func (*PageBlockHeader) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockHeader) GetConstructor() string {
+	return ConstructorPageBlockHeader
+}
+
+func (*PageBlockHeader) PageBlockConstructor() string {
+	return ConstructorPageBlockHeader
+}
+
+func (entity *PageBlockHeader) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockHeader) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockHeader) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockHeader"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"header":`)
+	encoder.writeType(entity.Header)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockHeader) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "header":
+			entity.Header, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockHeader)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A subheader
+type PageBlockSubheader struct {
+	meta
+	// Subheader
+	Subheader RichText `json:"subheader"`
+}
+
+func (*PageBlockSubheader) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockSubheader) GetConstructor() string {
+	return ConstructorPageBlockSubheader
+}
+
+func (*PageBlockSubheader) PageBlockConstructor() string {
+	return ConstructorPageBlockSubheader
+}
+
+func (entity *PageBlockSubheader) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockSubheader) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockSubheader) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockSubheader"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"subheader":`)
+	encoder.writeType(entity.Subheader)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockSubheader) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "subheader":
+			entity.Subheader, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockSubheader)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A kicker
+type PageBlockKicker struct {
+	meta
+	// Kicker
+	Kicker RichText `json:"kicker"`
+}
+
+func (*PageBlockKicker) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockKicker) GetConstructor() string {
+	return ConstructorPageBlockKicker
+}
+
+func (*PageBlockKicker) PageBlockConstructor() string {
+	return ConstructorPageBlockKicker
+}
+
+func (entity *PageBlockKicker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockKicker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockKicker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockKicker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"kicker":`)
+	encoder.writeType(entity.Kicker)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockKicker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "kicker":
+			entity.Kicker, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockKicker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A text paragraph
+type PageBlockParagraph struct {
+	meta
+	// Paragraph text
+	Text RichText `json:"text"`
+}
+
+func (*PageBlockParagraph) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockParagraph) GetConstructor() string {
+	return ConstructorPageBlockParagraph
+}
+
+func (*PageBlockParagraph) PageBlockConstructor() string {
+	return ConstructorPageBlockParagraph
+}
+
+func (entity *PageBlockParagraph) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockParagraph) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockParagraph) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockParagraph"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockParagraph) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockParagraph)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A preformatted text paragraph
+type PageBlockPreformatted struct {
+	meta
+	// Paragraph text
+	Text RichText `json:"text"`
+	// Programming language for which the text needs to be formatted
+	Language string `json:"language"`
+}
+
+func (*PageBlockPreformatted) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockPreformatted) GetConstructor() string {
+	return ConstructorPageBlockPreformatted
+}
+
+func (*PageBlockPreformatted) PageBlockConstructor() string {
+	return ConstructorPageBlockPreformatted
+}
+
+func (entity *PageBlockPreformatted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockPreformatted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockPreformatted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockPreformatted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"language":`)
+	encoder.writeString(entity.Language)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockPreformatted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "language":
+			entity.Language, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockPreformatted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The footer of a page
+type PageBlockFooter struct {
+	meta
+	// Footer
+	Footer RichText `json:"footer"`
+}
+
+func (*PageBlockFooter) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockFooter) GetConstructor() string {
+	return ConstructorPageBlockFooter
+}
+
+func (*PageBlockFooter) PageBlockConstructor() string {
+	return ConstructorPageBlockFooter
+}
+
+func (entity *PageBlockFooter) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockFooter) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockFooter) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockFooter"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"footer":`)
+	encoder.writeType(entity.Footer)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockFooter) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "footer":
+			entity.Footer, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockFooter)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An empty block separating a page
+type PageBlockDivider struct {
+	meta
+}
+
+func (*PageBlockDivider) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockDivider) GetConstructor() string {
+	return ConstructorPageBlockDivider
+}
+
+func (*PageBlockDivider) PageBlockConstructor() string {
+	return ConstructorPageBlockDivider
+}
+
+func (entity *PageBlockDivider) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockDivider) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockDivider) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockDivider"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockDivider) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPageBlockDivider)
+	})
+}
+
+// An invisible anchor on a page, which can be used in a URL to open the page from the specified anchor
+type PageBlockAnchor struct {
+	meta
+	// Name of the anchor
+	Name string `json:"name"`
+}
+
+func (*PageBlockAnchor) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockAnchor) GetConstructor() string {
+	return ConstructorPageBlockAnchor
+}
+
+func (*PageBlockAnchor) PageBlockConstructor() string {
+	return ConstructorPageBlockAnchor
+}
+
+func (entity *PageBlockAnchor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockAnchor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockAnchor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockAnchor"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockAnchor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockAnchor)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A list of data blocks
+type PageBlockList struct {
+	meta
+	// The items of the list
+	Items []*PageBlockListItem `json:"items"`
+}
+
+func (*PageBlockList) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockList) GetConstructor() string {
+	return ConstructorPageBlockList
+}
+
+func (*PageBlockList) PageBlockConstructor() string {
+	return ConstructorPageBlockList
+}
+
+func (entity *PageBlockList) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockList) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockList) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockList"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"items":`)
+	writeList(encoder, entity.Items, writeTypeValue[*PageBlockListItem])
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockList) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "items":
+			entity.Items, err = readList(decoder, decodeEntity[PageBlockListItem])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockList)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A block quote
+type PageBlockBlockQuote struct {
+	meta
+	// Quote text
+	Text RichText `json:"text"`
+	// Quote credit
+	Credit RichText `json:"credit"`
+}
+
+func (*PageBlockBlockQuote) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockBlockQuote) GetConstructor() string {
+	return ConstructorPageBlockBlockQuote
+}
+
+func (*PageBlockBlockQuote) PageBlockConstructor() string {
+	return ConstructorPageBlockBlockQuote
+}
+
+func (entity *PageBlockBlockQuote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockBlockQuote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockBlockQuote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockBlockQuote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"credit":`)
+	encoder.writeType(entity.Credit)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockBlockQuote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "credit":
+			entity.Credit, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockBlockQuote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A pull quote
+type PageBlockPullQuote struct {
+	meta
+	// Quote text
+	Text RichText `json:"text"`
+	// Quote credit
+	Credit RichText `json:"credit"`
+}
+
+func (*PageBlockPullQuote) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockPullQuote) GetConstructor() string {
+	return ConstructorPageBlockPullQuote
+}
+
+func (*PageBlockPullQuote) PageBlockConstructor() string {
+	return ConstructorPageBlockPullQuote
+}
+
+func (entity *PageBlockPullQuote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockPullQuote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockPullQuote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockPullQuote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"credit":`)
+	encoder.writeType(entity.Credit)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockPullQuote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "credit":
+			entity.Credit, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockPullQuote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An animation
+type PageBlockAnimation struct {
+	meta
+	// Animation file; may be null
+	Animation *Animation `json:"animation,omitempty"`
+	// Animation caption
+	Caption *PageBlockCaption `json:"caption"`
+	// True, if the animation must be played automatically
+	NeedAutoplay bool `json:"need_autoplay"`
+}
+
+func (*PageBlockAnimation) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockAnimation) GetConstructor() string {
+	return ConstructorPageBlockAnimation
+}
+
+func (*PageBlockAnimation) PageBlockConstructor() string {
+	return ConstructorPageBlockAnimation
+}
+
+func (entity *PageBlockAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Animation != nil {
+		encoder.writeRaw(`,"animation":`)
+		entity.Animation.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"need_autoplay":`)
+	encoder.writeBool(entity.NeedAutoplay)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		case "need_autoplay":
+			entity.NeedAutoplay, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An audio file
+type PageBlockAudio struct {
+	meta
+	// Audio file; may be null
+	Audio *Audio `json:"audio,omitempty"`
+	// Audio file caption
+	Caption *PageBlockCaption `json:"caption"`
+}
+
+func (*PageBlockAudio) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockAudio) GetConstructor() string {
+	return ConstructorPageBlockAudio
+}
+
+func (*PageBlockAudio) PageBlockConstructor() string {
+	return ConstructorPageBlockAudio
+}
+
+func (entity *PageBlockAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockAudio"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Audio != nil {
+		encoder.writeRaw(`,"audio":`)
+		entity.Audio.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "audio":
+			entity.Audio, err = decodeEntity[Audio](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A photo
+type PageBlockPhoto struct {
+	meta
+	// Photo file; may be null
+	Photo *Photo `json:"photo,omitempty"`
+	// Photo caption
+	Caption *PageBlockCaption `json:"caption"`
+	// URL that needs to be opened when the photo is clicked
+	Url string `json:"url"`
+}
+
+func (*PageBlockPhoto) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockPhoto) GetConstructor() string {
+	return ConstructorPageBlockPhoto
+}
+
+func (*PageBlockPhoto) PageBlockConstructor() string {
+	return ConstructorPageBlockPhoto
+}
+
+func (entity *PageBlockPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video
+type PageBlockVideo struct {
+	meta
+	// Video file; may be null
+	Video *Video `json:"video,omitempty"`
+	// Video caption
+	Caption *PageBlockCaption `json:"caption"`
+	// True, if the video must be played automatically
+	NeedAutoplay bool `json:"need_autoplay"`
+	// True, if the video must be looped
+	IsLooped bool `json:"is_looped"`
+}
+
+func (*PageBlockVideo) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockVideo) GetConstructor() string {
+	return ConstructorPageBlockVideo
+}
+
+func (*PageBlockVideo) PageBlockConstructor() string {
+	return ConstructorPageBlockVideo
+}
+
+func (entity *PageBlockVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockVideo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Video != nil {
+		encoder.writeRaw(`,"video":`)
+		entity.Video.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"need_autoplay":`)
+	encoder.writeBool(entity.NeedAutoplay)
+	encoder.writeRaw(`,"is_looped":`)
+	encoder.writeBool(entity.IsLooped)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeEntity[Video](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		case "need_autoplay":
+			entity.NeedAutoplay, err = decoder.readBool()
+
+		case "is_looped":
+			entity.IsLooped, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A voice note
+type PageBlockVoiceNote struct {
+	meta
+	// Voice note; may be null
+	VoiceNote *VoiceNote `json:"voice_note,omitempty"`
+	// Voice note caption
+	Caption *PageBlockCaption `json:"caption"`
+}
+
+func (*PageBlockVoiceNote) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockVoiceNote) GetConstructor() string {
+	return ConstructorPageBlockVoiceNote
+}
+
+func (*PageBlockVoiceNote) PageBlockConstructor() string {
+	return ConstructorPageBlockVoiceNote
+}
+
+func (entity *PageBlockVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.VoiceNote != nil {
+		encoder.writeRaw(`,"voice_note":`)
+		entity.VoiceNote.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "voice_note":
+			entity.VoiceNote, err = decodeEntity[VoiceNote](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A page cover
+type PageBlockCover struct {
+	meta
+	// Cover
+	Cover PageBlock `json:"cover"`
+}
+
+func (*PageBlockCover) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockCover) GetConstructor() string {
+	return ConstructorPageBlockCover
+}
+
+func (*PageBlockCover) PageBlockConstructor() string {
+	return ConstructorPageBlockCover
+}
+
+func (entity *PageBlockCover) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockCover) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockCover) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockCover"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"cover":`)
+	encoder.writeType(entity.Cover)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockCover) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "cover":
+			entity.Cover, err = decodePageBlock(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockCover)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An embedded web page
+type PageBlockEmbedded struct {
+	meta
+	// URL of the embedded page, if available
+	Url string `json:"url"`
+	// HTML-markup of the embedded page
+	Html string `json:"html"`
+	// Poster photo, if available; may be null
+	PosterPhoto *Photo `json:"poster_photo,omitempty"`
+	// Block width; 0 if unknown
+	Width int32 `json:"width"`
+	// Block height; 0 if unknown
+	Height int32 `json:"height"`
+	// Block caption
+	Caption *PageBlockCaption `json:"caption"`
+	// True, if the block must be full width
+	IsFullWidth bool `json:"is_full_width"`
+	// True, if scrolling needs to be allowed
+	AllowScrolling bool `json:"allow_scrolling"`
+}
+
+func (*PageBlockEmbedded) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockEmbedded) GetConstructor() string {
+	return ConstructorPageBlockEmbedded
+}
+
+func (*PageBlockEmbedded) PageBlockConstructor() string {
+	return ConstructorPageBlockEmbedded
+}
+
+func (entity *PageBlockEmbedded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockEmbedded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockEmbedded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockEmbedded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"html":`)
+	encoder.writeString(entity.Html)
+	if entity.PosterPhoto != nil {
+		encoder.writeRaw(`,"poster_photo":`)
+		entity.PosterPhoto.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_full_width":`)
+	encoder.writeBool(entity.IsFullWidth)
+	encoder.writeRaw(`,"allow_scrolling":`)
+	encoder.writeBool(entity.AllowScrolling)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockEmbedded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "html":
+			entity.Html, err = decoder.readString()
+
+		case "poster_photo":
+			entity.PosterPhoto, err = decodeEntity[Photo](decoder)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		case "is_full_width":
+			entity.IsFullWidth, err = decoder.readBool()
+
+		case "allow_scrolling":
+			entity.AllowScrolling, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockEmbedded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An embedded post
+type PageBlockEmbeddedPost struct {
+	meta
+	// URL of the embedded post
+	Url string `json:"url"`
+	// Post author
+	Author string `json:"author"`
+	// Post author photo; may be null
+	AuthorPhoto *Photo `json:"author_photo,omitempty"`
+	// Point in time (Unix timestamp) when the post was created; 0 if unknown
+	Date int32 `json:"date"`
+	// Post content
+	PageBlocks []PageBlock `json:"page_blocks"`
+	// Post caption
+	Caption *PageBlockCaption `json:"caption"`
+}
+
+func (*PageBlockEmbeddedPost) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockEmbeddedPost) GetConstructor() string {
+	return ConstructorPageBlockEmbeddedPost
+}
+
+func (*PageBlockEmbeddedPost) PageBlockConstructor() string {
+	return ConstructorPageBlockEmbeddedPost
+}
+
+func (entity *PageBlockEmbeddedPost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockEmbeddedPost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockEmbeddedPost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockEmbeddedPost"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"author":`)
+	encoder.writeString(entity.Author)
+	if entity.AuthorPhoto != nil {
+		encoder.writeRaw(`,"author_photo":`)
+		entity.AuthorPhoto.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"page_blocks":`)
+	writeList(encoder, entity.PageBlocks, writeTypeValue[PageBlock])
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockEmbeddedPost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "author":
+			entity.Author, err = decoder.readString()
+
+		case "author_photo":
+			entity.AuthorPhoto, err = decodeEntity[Photo](decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "page_blocks":
+			entity.PageBlocks, err = readList(decoder, decodePageBlock)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockEmbeddedPost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A collage
+type PageBlockCollage struct {
+	meta
+	// Collage item contents
+	PageBlocks []PageBlock `json:"page_blocks"`
+	// Block caption
+	Caption *PageBlockCaption `json:"caption"`
+}
+
+func (*PageBlockCollage) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockCollage) GetConstructor() string {
+	return ConstructorPageBlockCollage
+}
+
+func (*PageBlockCollage) PageBlockConstructor() string {
+	return ConstructorPageBlockCollage
+}
+
+func (entity *PageBlockCollage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockCollage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockCollage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockCollage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"page_blocks":`)
+	writeList(encoder, entity.PageBlocks, writeTypeValue[PageBlock])
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockCollage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "page_blocks":
+			entity.PageBlocks, err = readList(decoder, decodePageBlock)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockCollage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A slideshow
+type PageBlockSlideshow struct {
+	meta
+	// Slideshow item contents
+	PageBlocks []PageBlock `json:"page_blocks"`
+	// Block caption
+	Caption *PageBlockCaption `json:"caption"`
+}
+
+func (*PageBlockSlideshow) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockSlideshow) GetConstructor() string {
+	return ConstructorPageBlockSlideshow
+}
+
+func (*PageBlockSlideshow) PageBlockConstructor() string {
+	return ConstructorPageBlockSlideshow
+}
+
+func (entity *PageBlockSlideshow) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockSlideshow) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockSlideshow) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockSlideshow"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"page_blocks":`)
+	writeList(encoder, entity.PageBlocks, writeTypeValue[PageBlock])
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockSlideshow) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "page_blocks":
+			entity.PageBlocks, err = readList(decoder, decodePageBlock)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockSlideshow)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A link to a chat
+type PageBlockChatLink struct {
+	meta
+	// Chat title
+	Title string `json:"title"`
+	// Chat photo; may be null
+	Photo *ChatPhotoInfo `json:"photo,omitempty"`
+	// Identifier of the accent color for chat title and background of chat photo
+	AccentColorId int32 `json:"accent_color_id"`
+	// Chat username by which all other information about the chat can be resolved
+	Username string `json:"username"`
+}
+
+func (*PageBlockChatLink) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockChatLink) GetConstructor() string {
+	return ConstructorPageBlockChatLink
+}
+
+func (*PageBlockChatLink) PageBlockConstructor() string {
+	return ConstructorPageBlockChatLink
+}
+
+func (entity *PageBlockChatLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockChatLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockChatLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockChatLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(entity.AccentColorId)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockChatLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhotoInfo](decoder)
+
+		case "accent_color_id":
+			entity.AccentColorId, err = decoder.readInt32()
+
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockChatLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A table
+type PageBlockTable struct {
+	meta
+	// Table caption
+	Caption RichText `json:"caption"`
+	// Table cells
+	Cells [][]*PageBlockTableCell `json:"cells"`
+	// True, if the table is bordered
+	IsBordered bool `json:"is_bordered"`
+	// True, if the table is striped
+	IsStriped bool `json:"is_striped"`
+}
+
+func (*PageBlockTable) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockTable) GetConstructor() string {
+	return ConstructorPageBlockTable
+}
+
+func (*PageBlockTable) PageBlockConstructor() string {
+	return ConstructorPageBlockTable
+}
+
+func (entity *PageBlockTable) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockTable) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockTable) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockTable"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"caption":`)
+	encoder.writeType(entity.Caption)
+	encoder.writeRaw(`,"cells":`)
+	writeList(encoder, entity.Cells, listWriter(writeTypeValue[*PageBlockTableCell]))
+	encoder.writeRaw(`,"is_bordered":`)
+	encoder.writeBool(entity.IsBordered)
+	encoder.writeRaw(`,"is_striped":`)
+	encoder.writeBool(entity.IsStriped)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockTable) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "caption":
+			entity.Caption, err = decodeRichText(decoder)
+
+		case "cells":
+			entity.Cells, err = readList(decoder, listReader(decodeEntity[PageBlockTableCell]))
+
+		case "is_bordered":
+			entity.IsBordered, err = decoder.readBool()
+
+		case "is_striped":
+			entity.IsStriped, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockTable)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A collapsible block
+type PageBlockDetails struct {
+	meta
+	// Always visible heading for the block
+	Header RichText `json:"header"`
+	// Block contents
+	PageBlocks []PageBlock `json:"page_blocks"`
+	// True, if the block is open by default
+	IsOpen bool `json:"is_open"`
+}
+
+func (*PageBlockDetails) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockDetails) GetConstructor() string {
+	return ConstructorPageBlockDetails
+}
+
+func (*PageBlockDetails) PageBlockConstructor() string {
+	return ConstructorPageBlockDetails
+}
+
+func (entity *PageBlockDetails) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockDetails) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockDetails) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockDetails"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"header":`)
+	encoder.writeType(entity.Header)
+	encoder.writeRaw(`,"page_blocks":`)
+	writeList(encoder, entity.PageBlocks, writeTypeValue[PageBlock])
+	encoder.writeRaw(`,"is_open":`)
+	encoder.writeBool(entity.IsOpen)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockDetails) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "header":
+			entity.Header, err = decodeRichText(decoder)
+
+		case "page_blocks":
+			entity.PageBlocks, err = readList(decoder, decodePageBlock)
+
+		case "is_open":
+			entity.IsOpen, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockDetails)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Related articles
+type PageBlockRelatedArticles struct {
+	meta
+	// Block header
+	Header RichText `json:"header"`
+	// List of related articles
+	Articles []*PageBlockRelatedArticle `json:"articles"`
+}
+
+func (*PageBlockRelatedArticles) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockRelatedArticles) GetConstructor() string {
+	return ConstructorPageBlockRelatedArticles
+}
+
+func (*PageBlockRelatedArticles) PageBlockConstructor() string {
+	return ConstructorPageBlockRelatedArticles
+}
+
+func (entity *PageBlockRelatedArticles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockRelatedArticles) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockRelatedArticles) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockRelatedArticles"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"header":`)
+	encoder.writeType(entity.Header)
+	encoder.writeRaw(`,"articles":`)
+	writeList(encoder, entity.Articles, writeTypeValue[*PageBlockRelatedArticle])
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockRelatedArticles) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "header":
+			entity.Header, err = decodeRichText(decoder)
+
+		case "articles":
+			entity.Articles, err = readList(decoder, decodeEntity[PageBlockRelatedArticle])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockRelatedArticles)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A map
+type PageBlockMap struct {
+	meta
+	// Location of the map center
+	Location *Location `json:"location"`
+	// Map zoom level
+	Zoom int32 `json:"zoom"`
+	// Map width
+	Width int32 `json:"width"`
+	// Map height
+	Height int32 `json:"height"`
+	// Block caption
+	Caption *PageBlockCaption `json:"caption"`
+}
+
+func (*PageBlockMap) GetType() string {
+	return TypePageBlock
+}
+
+func (*PageBlockMap) GetConstructor() string {
+	return ConstructorPageBlockMap
+}
+
+func (*PageBlockMap) PageBlockConstructor() string {
+	return ConstructorPageBlockMap
+}
+
+func (entity *PageBlockMap) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PageBlockMap) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PageBlockMap) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pageBlockMap"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"zoom":`)
+	encoder.writeInt32(entity.Zoom)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PageBlockMap) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "zoom":
+			entity.Zoom, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[PageBlockCaption](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPageBlockMap)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The media is a photo
+type LinkPreviewAlbumMediaPhoto struct {
+	meta
+	// Photo description
+	Photo *Photo `json:"photo"`
+}
+
+func (*LinkPreviewAlbumMediaPhoto) GetType() string {
+	return TypeLinkPreviewAlbumMedia
+}
+
+func (*LinkPreviewAlbumMediaPhoto) GetConstructor() string {
+	return ConstructorLinkPreviewAlbumMediaPhoto
+}
+
+func (*LinkPreviewAlbumMediaPhoto) LinkPreviewAlbumMediaConstructor() string {
+	return ConstructorLinkPreviewAlbumMediaPhoto
+}
+
+func (entity *LinkPreviewAlbumMediaPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewAlbumMediaPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewAlbumMediaPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewAlbumMediaPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewAlbumMediaPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewAlbumMediaPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The media is a video
+type LinkPreviewAlbumMediaVideo struct {
+	meta
+	// Video description
+	Video *Video `json:"video"`
+}
+
+func (*LinkPreviewAlbumMediaVideo) GetType() string {
+	return TypeLinkPreviewAlbumMedia
+}
+
+func (*LinkPreviewAlbumMediaVideo) GetConstructor() string {
+	return ConstructorLinkPreviewAlbumMediaVideo
+}
+
+func (*LinkPreviewAlbumMediaVideo) LinkPreviewAlbumMediaConstructor() string {
+	return ConstructorLinkPreviewAlbumMediaVideo
+}
+
+func (entity *LinkPreviewAlbumMediaVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewAlbumMediaVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewAlbumMediaVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewAlbumMediaVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewAlbumMediaVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeEntity[Video](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewAlbumMediaVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a media album consisting of photos and videos
+type LinkPreviewTypeAlbum struct {
+	meta
+	// The list of album media
+	Media []LinkPreviewAlbumMedia `json:"media"`
+	// Album caption
+	Caption string `json:"caption"`
+}
+
+func (*LinkPreviewTypeAlbum) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeAlbum) GetConstructor() string {
+	return ConstructorLinkPreviewTypeAlbum
+}
+
+func (*LinkPreviewTypeAlbum) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeAlbum
+}
+
+func (entity *LinkPreviewTypeAlbum) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeAlbum) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeAlbum) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeAlbum"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"media":`)
+	writeList(encoder, entity.Media, writeTypeValue[LinkPreviewAlbumMedia])
+	encoder.writeRaw(`,"caption":`)
+	encoder.writeString(entity.Caption)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeAlbum) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "media":
+			entity.Media, err = readList(decoder, decodeLinkPreviewAlbumMedia)
+
+		case "caption":
+			entity.Caption, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeAlbum)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an animation
+type LinkPreviewTypeAnimation struct {
+	meta
+	// The animation
+	Animation *Animation `json:"animation"`
+}
+
+func (*LinkPreviewTypeAnimation) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeAnimation) GetConstructor() string {
+	return ConstructorLinkPreviewTypeAnimation
+}
+
+func (*LinkPreviewTypeAnimation) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeAnimation
+}
+
+func (entity *LinkPreviewTypeAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"animation":`)
+	entity.Animation.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an app at App Store or Google Play
+type LinkPreviewTypeApp struct {
+	meta
+	// Photo for the app
+	Photo *Photo `json:"photo"`
+}
+
+func (*LinkPreviewTypeApp) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeApp) GetConstructor() string {
+	return ConstructorLinkPreviewTypeApp
+}
+
+func (*LinkPreviewTypeApp) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeApp
+}
+
+func (entity *LinkPreviewTypeApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a web site
+type LinkPreviewTypeArticle struct {
+	meta
+	// Article's main photo; may be null
+	Photo *Photo `json:"photo,omitempty"`
+}
+
+func (*LinkPreviewTypeArticle) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeArticle) GetConstructor() string {
+	return ConstructorLinkPreviewTypeArticle
+}
+
+func (*LinkPreviewTypeArticle) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeArticle
+}
+
+func (entity *LinkPreviewTypeArticle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeArticle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeArticle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeArticle"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeArticle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeArticle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an audio
+type LinkPreviewTypeAudio struct {
+	meta
+	// The audio description
+	Audio *Audio `json:"audio"`
+}
+
+func (*LinkPreviewTypeAudio) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeAudio) GetConstructor() string {
+	return ConstructorLinkPreviewTypeAudio
+}
+
+func (*LinkPreviewTypeAudio) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeAudio
+}
+
+func (entity *LinkPreviewTypeAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"audio":`)
+	entity.Audio.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "audio":
+			entity.Audio, err = decodeEntity[Audio](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a background. Link preview title and description are available only for filled backgrounds
+type LinkPreviewTypeBackground struct {
+	meta
+	// Document with the background; may be null for filled backgrounds
+	Document *Document `json:"document,omitempty"`
+	// Type of the background; may be null if unknown
+	BackgroundType BackgroundType `json:"background_type,omitempty"`
+}
+
+func (*LinkPreviewTypeBackground) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeBackground) GetConstructor() string {
+	return ConstructorLinkPreviewTypeBackground
+}
+
+func (*LinkPreviewTypeBackground) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeBackground
+}
+
+func (entity *LinkPreviewTypeBackground) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeBackground) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeBackground) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeBackground"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Document != nil {
+		encoder.writeRaw(`,"document":`)
+		entity.Document.encodeJSON(encoder)
+	}
+	if entity.BackgroundType != nil {
+		encoder.writeRaw(`,"background_type":`)
+		encoder.writeType(entity.BackgroundType)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeBackground) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "document":
+			entity.Document, err = decodeEntity[Document](decoder)
+
+		case "background_type":
+			entity.BackgroundType, err = decodeBackgroundType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to boost a channel chat
+type LinkPreviewTypeChannelBoost struct {
+	meta
+	// Photo of the chat; may be null
+	Photo *ChatPhoto `json:"photo,omitempty"`
+}
+
+func (*LinkPreviewTypeChannelBoost) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeChannelBoost) GetConstructor() string {
+	return ConstructorLinkPreviewTypeChannelBoost
+}
+
+func (*LinkPreviewTypeChannelBoost) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeChannelBoost
+}
+
+func (entity *LinkPreviewTypeChannelBoost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeChannelBoost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeChannelBoost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeChannelBoost"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeChannelBoost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeChannelBoost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a chat
+type LinkPreviewTypeChat struct {
+	meta
+	// Type of the chat
+	Type InviteLinkChatType `json:"type"`
+	// Photo of the chat; may be null
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// True, if the link only creates join request
+	CreatesJoinRequest bool `json:"creates_join_request"`
+}
+
+func (*LinkPreviewTypeChat) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeChat) GetConstructor() string {
+	return ConstructorLinkPreviewTypeChat
+}
+
+func (*LinkPreviewTypeChat) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeChat
+}
+
+func (entity *LinkPreviewTypeChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"creates_join_request":`)
+	encoder.writeBool(entity.CreatesJoinRequest)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeInviteLinkChatType(decoder)
+
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		case "creates_join_request":
+			entity.CreatesJoinRequest, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a general file
+type LinkPreviewTypeDocument struct {
+	meta
+	// The document description
+	Document *Document `json:"document"`
+}
+
+func (*LinkPreviewTypeDocument) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeDocument) GetConstructor() string {
+	return ConstructorLinkPreviewTypeDocument
+}
+
+func (*LinkPreviewTypeDocument) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeDocument
+}
+
+func (entity *LinkPreviewTypeDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"document":`)
+	entity.Document.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "document":
+			entity.Document, err = decodeEntity[Document](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an animation player
+type LinkPreviewTypeEmbeddedAnimationPlayer struct {
+	meta
+	// URL of the external animation player
+	Url string `json:"url"`
+	// Thumbnail of the animation; may be null if unknown
+	Thumbnail *Photo `json:"thumbnail,omitempty"`
+	// Duration of the animation, in seconds
+	Duration int32 `json:"duration"`
+	// Expected width of the embedded player
+	Width int32 `json:"width"`
+	// Expected height of the embedded player
+	Height int32 `json:"height"`
+}
+
+func (*LinkPreviewTypeEmbeddedAnimationPlayer) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeEmbeddedAnimationPlayer) GetConstructor() string {
+	return ConstructorLinkPreviewTypeEmbeddedAnimationPlayer
+}
+
+func (*LinkPreviewTypeEmbeddedAnimationPlayer) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeEmbeddedAnimationPlayer
+}
+
+func (entity *LinkPreviewTypeEmbeddedAnimationPlayer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeEmbeddedAnimationPlayer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeEmbeddedAnimationPlayer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeEmbeddedAnimationPlayer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeEmbeddedAnimationPlayer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Photo](decoder)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeEmbeddedAnimationPlayer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an audio player
+type LinkPreviewTypeEmbeddedAudioPlayer struct {
+	meta
+	// URL of the external audio player
+	Url string `json:"url"`
+	// Thumbnail of the audio; may be null if unknown
+	Thumbnail *Photo `json:"thumbnail,omitempty"`
+	// Duration of the audio, in seconds
+	Duration int32 `json:"duration"`
+	// Expected width of the embedded player
+	Width int32 `json:"width"`
+	// Expected height of the embedded player
+	Height int32 `json:"height"`
+}
+
+func (*LinkPreviewTypeEmbeddedAudioPlayer) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeEmbeddedAudioPlayer) GetConstructor() string {
+	return ConstructorLinkPreviewTypeEmbeddedAudioPlayer
+}
+
+func (*LinkPreviewTypeEmbeddedAudioPlayer) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeEmbeddedAudioPlayer
+}
+
+func (entity *LinkPreviewTypeEmbeddedAudioPlayer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeEmbeddedAudioPlayer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeEmbeddedAudioPlayer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeEmbeddedAudioPlayer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeEmbeddedAudioPlayer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Photo](decoder)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeEmbeddedAudioPlayer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a video player
+type LinkPreviewTypeEmbeddedVideoPlayer struct {
+	meta
+	// URL of the external video player
+	Url string `json:"url"`
+	// Thumbnail of the video; may be null if unknown
+	Thumbnail *Photo `json:"thumbnail,omitempty"`
+	// Duration of the video, in seconds
+	Duration int32 `json:"duration"`
+	// Expected width of the embedded player
+	Width int32 `json:"width"`
+	// Expected height of the embedded player
+	Height int32 `json:"height"`
+}
+
+func (*LinkPreviewTypeEmbeddedVideoPlayer) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeEmbeddedVideoPlayer) GetConstructor() string {
+	return ConstructorLinkPreviewTypeEmbeddedVideoPlayer
+}
+
+func (*LinkPreviewTypeEmbeddedVideoPlayer) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeEmbeddedVideoPlayer
+}
+
+func (entity *LinkPreviewTypeEmbeddedVideoPlayer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeEmbeddedVideoPlayer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeEmbeddedVideoPlayer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeEmbeddedVideoPlayer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeEmbeddedVideoPlayer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Photo](decoder)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeEmbeddedVideoPlayer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an audio file
+type LinkPreviewTypeExternalAudio struct {
+	meta
+	// URL of the audio file
+	Url string `json:"url"`
+	// MIME type of the audio file
+	MimeType string `json:"mime_type"`
+	// Duration of the audio, in seconds; 0 if unknown
+	Duration int32 `json:"duration"`
+}
+
+func (*LinkPreviewTypeExternalAudio) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeExternalAudio) GetConstructor() string {
+	return ConstructorLinkPreviewTypeExternalAudio
+}
+
+func (*LinkPreviewTypeExternalAudio) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeExternalAudio
+}
+
+func (entity *LinkPreviewTypeExternalAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeExternalAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeExternalAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeExternalAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeExternalAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeExternalAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a video file
+type LinkPreviewTypeExternalVideo struct {
+	meta
+	// URL of the video file
+	Url string `json:"url"`
+	// MIME type of the video file
+	MimeType string `json:"mime_type"`
+	// Expected width of the video preview; 0 if unknown
+	Width int32 `json:"width"`
+	// Expected height of the video preview; 0 if unknown
+	Height int32 `json:"height"`
+	// Duration of the video, in seconds; 0 if unknown
+	Duration int32 `json:"duration"`
+}
+
+func (*LinkPreviewTypeExternalVideo) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeExternalVideo) GetConstructor() string {
+	return ConstructorLinkPreviewTypeExternalVideo
+}
+
+func (*LinkPreviewTypeExternalVideo) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeExternalVideo
+}
+
+func (entity *LinkPreviewTypeExternalVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeExternalVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeExternalVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeExternalVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeExternalVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeExternalVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an invoice
+type LinkPreviewTypeInvoice struct {
+	meta
+}
+
+func (*LinkPreviewTypeInvoice) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeInvoice) GetConstructor() string {
+	return ConstructorLinkPreviewTypeInvoice
+}
+
+func (*LinkPreviewTypeInvoice) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeInvoice
+}
+
+func (entity *LinkPreviewTypeInvoice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeInvoice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeInvoice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeInvoice"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeInvoice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeInvoice)
+	})
+}
+
+// The link is a link to a text or a poll Telegram message
+type LinkPreviewTypeMessage struct {
+	meta
+}
+
+func (*LinkPreviewTypeMessage) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeMessage) GetConstructor() string {
+	return ConstructorLinkPreviewTypeMessage
+}
+
+func (*LinkPreviewTypeMessage) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeMessage
+}
+
+func (entity *LinkPreviewTypeMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeMessage)
+	})
+}
+
+// The link is a link to a photo
+type LinkPreviewTypePhoto struct {
+	meta
+	// The photo
+	Photo *Photo `json:"photo"`
+}
+
+func (*LinkPreviewTypePhoto) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypePhoto) GetConstructor() string {
+	return ConstructorLinkPreviewTypePhoto
+}
+
+func (*LinkPreviewTypePhoto) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypePhoto
+}
+
+func (entity *LinkPreviewTypePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypePhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a Telegram Premium gift code
+type LinkPreviewTypePremiumGiftCode struct {
+	meta
+}
+
+func (*LinkPreviewTypePremiumGiftCode) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypePremiumGiftCode) GetConstructor() string {
+	return ConstructorLinkPreviewTypePremiumGiftCode
+}
+
+func (*LinkPreviewTypePremiumGiftCode) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypePremiumGiftCode
+}
+
+func (entity *LinkPreviewTypePremiumGiftCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypePremiumGiftCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypePremiumGiftCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypePremiumGiftCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypePremiumGiftCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypePremiumGiftCode)
+	})
+}
+
+// The link is a link to a shareable chat folder
+type LinkPreviewTypeShareableChatFolder struct {
+	meta
+}
+
+func (*LinkPreviewTypeShareableChatFolder) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeShareableChatFolder) GetConstructor() string {
+	return ConstructorLinkPreviewTypeShareableChatFolder
+}
+
+func (*LinkPreviewTypeShareableChatFolder) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeShareableChatFolder
+}
+
+func (entity *LinkPreviewTypeShareableChatFolder) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeShareableChatFolder) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeShareableChatFolder) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeShareableChatFolder"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeShareableChatFolder) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeShareableChatFolder)
+	})
+}
+
+// The link is a link to a sticker
+type LinkPreviewTypeSticker struct {
+	meta
+	// The sticker. It can be an arbitrary WEBP image and can have dimensions bigger than 512
+	Sticker *Sticker `json:"sticker"`
+}
+
+func (*LinkPreviewTypeSticker) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeSticker) GetConstructor() string {
+	return ConstructorLinkPreviewTypeSticker
+}
+
+func (*LinkPreviewTypeSticker) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeSticker
+}
+
+func (entity *LinkPreviewTypeSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a sticker set
+type LinkPreviewTypeStickerSet struct {
+	meta
+	// Up to 4 stickers from the sticker set
+	Stickers []*Sticker `json:"stickers"`
+}
+
+func (*LinkPreviewTypeStickerSet) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeStickerSet) GetConstructor() string {
+	return ConstructorLinkPreviewTypeStickerSet
+}
+
+func (*LinkPreviewTypeStickerSet) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeStickerSet
+}
+
+func (entity *LinkPreviewTypeStickerSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeStickerSet) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeStickerSet) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeStickerSet"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"stickers":`)
+	writeList(encoder, entity.Stickers, writeTypeValue[*Sticker])
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeStickerSet) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "stickers":
+			entity.Stickers, err = readList(decoder, decodeEntity[Sticker])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeStickerSet)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a story. Link preview description is unavailable
+type LinkPreviewTypeStory struct {
+	meta
+	// The identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+}
+
+func (*LinkPreviewTypeStory) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeStory) GetConstructor() string {
+	return ConstructorLinkPreviewTypeStory
+}
+
+func (*LinkPreviewTypeStory) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeStory
+}
+
+func (entity *LinkPreviewTypeStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(entity.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_sender_chat_id":
+			entity.StorySenderChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to boost a supergroup chat
+type LinkPreviewTypeSupergroupBoost struct {
+	meta
+	// Photo of the chat; may be null
+	Photo *ChatPhoto `json:"photo,omitempty"`
+}
+
+func (*LinkPreviewTypeSupergroupBoost) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeSupergroupBoost) GetConstructor() string {
+	return ConstructorLinkPreviewTypeSupergroupBoost
+}
+
+func (*LinkPreviewTypeSupergroupBoost) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeSupergroupBoost
+}
+
+func (entity *LinkPreviewTypeSupergroupBoost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeSupergroupBoost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeSupergroupBoost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeSupergroupBoost"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeSupergroupBoost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeSupergroupBoost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a cloud theme. TDLib has no theme support yet
+type LinkPreviewTypeTheme struct {
+	meta
+	// The list of files with theme description
+	Documents []*Document `json:"documents"`
+	// Settings for the cloud theme; may be null if unknown
+	Settings *ThemeSettings `json:"settings,omitempty"`
+}
+
+func (*LinkPreviewTypeTheme) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeTheme) GetConstructor() string {
+	return ConstructorLinkPreviewTypeTheme
+}
+
+func (*LinkPreviewTypeTheme) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeTheme
+}
+
+func (entity *LinkPreviewTypeTheme) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeTheme) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeTheme) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeTheme"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"documents":`)
+	writeList(encoder, entity.Documents, writeTypeValue[*Document])
+	if entity.Settings != nil {
+		encoder.writeRaw(`,"settings":`)
+		entity.Settings.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeTheme) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "documents":
+			entity.Documents, err = readList(decoder, decodeEntity[Document])
+
+		case "settings":
+			entity.Settings, err = decodeEntity[ThemeSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeTheme)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link preview type is unsupported yet
+type LinkPreviewTypeUnsupported struct {
+	meta
+}
+
+func (*LinkPreviewTypeUnsupported) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeUnsupported) GetConstructor() string {
+	return ConstructorLinkPreviewTypeUnsupported
+}
+
+func (*LinkPreviewTypeUnsupported) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeUnsupported
+}
+
+func (entity *LinkPreviewTypeUnsupported) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeUnsupported) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeUnsupported) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeUnsupported"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeUnsupported) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeUnsupported)
+	})
+}
+
+// The link is a link to an upgraded gift
+type LinkPreviewTypeUpgradedGift struct {
+	meta
+	// The gift
+	Gift *UpgradedGift `json:"gift"`
+}
+
+func (*LinkPreviewTypeUpgradedGift) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeUpgradedGift) GetConstructor() string {
+	return ConstructorLinkPreviewTypeUpgradedGift
+}
+
+func (*LinkPreviewTypeUpgradedGift) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeUpgradedGift
+}
+
+func (entity *LinkPreviewTypeUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift":
+			entity.Gift, err = decodeEntity[UpgradedGift](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a user
+type LinkPreviewTypeUser struct {
+	meta
+	// Photo of the user; may be null if none
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// True, if the user is a bot
+	IsBot bool `json:"is_bot"`
+}
+
+func (*LinkPreviewTypeUser) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeUser) GetConstructor() string {
+	return ConstructorLinkPreviewTypeUser
+}
+
+func (*LinkPreviewTypeUser) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeUser
+}
+
+func (entity *LinkPreviewTypeUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeUser"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_bot":`)
+	encoder.writeBool(entity.IsBot)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		case "is_bot":
+			entity.IsBot, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a video
+type LinkPreviewTypeVideo struct {
+	meta
+	// The video description
+	Video *Video `json:"video"`
+	// Cover of the video; may be null if none
+	Cover *Photo `json:"cover,omitempty"`
+	// Timestamp from which the video playing must start, in seconds
+	StartTimestamp int32 `json:"start_timestamp"`
+}
+
+func (*LinkPreviewTypeVideo) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeVideo) GetConstructor() string {
+	return ConstructorLinkPreviewTypeVideo
+}
+
+func (*LinkPreviewTypeVideo) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeVideo
+}
+
+func (entity *LinkPreviewTypeVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	if entity.Cover != nil {
+		encoder.writeRaw(`,"cover":`)
+		entity.Cover.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"start_timestamp":`)
+	encoder.writeInt32(entity.StartTimestamp)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeEntity[Video](decoder)
+
+		case "cover":
+			entity.Cover, err = decodeEntity[Photo](decoder)
+
+		case "start_timestamp":
+			entity.StartTimestamp, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a video chat
+type LinkPreviewTypeVideoChat struct {
+	meta
+	// Photo of the chat with the video chat; may be null if none
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// True, if the video chat is expected to be a live stream in a channel or a broadcast group
+	IsLiveStream bool `json:"is_live_stream"`
+}
+
+func (*LinkPreviewTypeVideoChat) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeVideoChat) GetConstructor() string {
+	return ConstructorLinkPreviewTypeVideoChat
+}
+
+func (*LinkPreviewTypeVideoChat) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeVideoChat
+}
+
+func (entity *LinkPreviewTypeVideoChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeVideoChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeVideoChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeVideoChat"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_live_stream":`)
+	encoder.writeBool(entity.IsLiveStream)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeVideoChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		case "is_live_stream":
+			entity.IsLiveStream, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeVideoChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a video note message
+type LinkPreviewTypeVideoNote struct {
+	meta
+	// The video note
+	VideoNote *VideoNote `json:"video_note"`
+}
+
+func (*LinkPreviewTypeVideoNote) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeVideoNote) GetConstructor() string {
+	return ConstructorLinkPreviewTypeVideoNote
+}
+
+func (*LinkPreviewTypeVideoNote) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeVideoNote
+}
+
+func (entity *LinkPreviewTypeVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video_note":`)
+	entity.VideoNote.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video_note":
+			entity.VideoNote, err = decodeEntity[VideoNote](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeVideoNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a voice note message
+type LinkPreviewTypeVoiceNote struct {
+	meta
+	// The voice note
+	VoiceNote *VoiceNote `json:"voice_note"`
+}
+
+func (*LinkPreviewTypeVoiceNote) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeVoiceNote) GetConstructor() string {
+	return ConstructorLinkPreviewTypeVoiceNote
+}
+
+func (*LinkPreviewTypeVoiceNote) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeVoiceNote
+}
+
+func (entity *LinkPreviewTypeVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"voice_note":`)
+	entity.VoiceNote.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "voice_note":
+			entity.VoiceNote, err = decodeEntity[VoiceNote](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a Web App
+type LinkPreviewTypeWebApp struct {
+	meta
+	// Web App photo; may be null if none
+	Photo *Photo `json:"photo,omitempty"`
+}
+
+func (*LinkPreviewTypeWebApp) GetType() string {
+	return TypeLinkPreviewType
+}
+
+func (*LinkPreviewTypeWebApp) GetConstructor() string {
+	return ConstructorLinkPreviewTypeWebApp
+}
+
+func (*LinkPreviewTypeWebApp) LinkPreviewTypeConstructor() string {
+	return ConstructorLinkPreviewTypeWebApp
+}
+
+func (entity *LinkPreviewTypeWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreviewTypeWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreviewTypeWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreviewTypeWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreviewTypeWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreviewTypeWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a link preview
+type LinkPreview struct {
+	meta
+	// Original URL of the link
+	Url string `json:"url"`
+	// URL to display
+	DisplayUrl string `json:"display_url"`
+	// Short name of the site (e.g., Google Docs, App Store)
+	SiteName string `json:"site_name"`
+	// Title of the content
+	Title string `json:"title"`
+	// Description of the content
+	Description *FormattedText `json:"description"`
+	// Author of the content
+	Author string `json:"author"`
+	// Type of the link preview
+	Type LinkPreviewType `json:"type"`
+	// True, if size of media in the preview can be changed
+	HasLargeMedia bool `json:"has_large_media"`
+	// True, if large media preview must be shown; otherwise, the media preview must be shown small and only the first frame must be shown for videos
+	ShowLargeMedia bool `json:"show_large_media"`
+	// True, if media must be shown above link preview description; otherwise, the media must be shown below the description
+	ShowMediaAboveDescription bool `json:"show_media_above_description"`
+	// True, if there is no need to show an ordinary open URL confirmation, when opening the URL from the preview, because the URL is shown in the message text in clear
+	SkipConfirmation bool `json:"skip_confirmation"`
+	// True, if the link preview must be shown above message text; otherwise, the link preview must be shown below the message text
+	ShowAboveText bool `json:"show_above_text"`
+	// Version of instant view (currently, can be 1 or 2) for the web page; 0 if none
+	InstantViewVersion int32 `json:"instant_view_version"`
+}
+
+func (*LinkPreview) GetType() string {
+	return TypeLinkPreview
+}
+
+func (*LinkPreview) GetConstructor() string {
+	return ConstructorLinkPreview
+}
+
+func (entity *LinkPreview) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LinkPreview) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LinkPreview) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"linkPreview"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"display_url":`)
+	encoder.writeString(entity.DisplayUrl)
+	encoder.writeRaw(`,"site_name":`)
+	encoder.writeString(entity.SiteName)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	entity.Description.encodeJSON(encoder)
+	encoder.writeRaw(`,"author":`)
+	encoder.writeString(entity.Author)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"has_large_media":`)
+	encoder.writeBool(entity.HasLargeMedia)
+	encoder.writeRaw(`,"show_large_media":`)
+	encoder.writeBool(entity.ShowLargeMedia)
+	encoder.writeRaw(`,"show_media_above_description":`)
+	encoder.writeBool(entity.ShowMediaAboveDescription)
+	encoder.writeRaw(`,"skip_confirmation":`)
+	encoder.writeBool(entity.SkipConfirmation)
+	encoder.writeRaw(`,"show_above_text":`)
+	encoder.writeBool(entity.ShowAboveText)
+	encoder.writeRaw(`,"instant_view_version":`)
+	encoder.writeInt32(entity.InstantViewVersion)
+	encoder.writeRaw("}")
+}
+
+func (entity *LinkPreview) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "display_url":
+			entity.DisplayUrl, err = decoder.readString()
+
+		case "site_name":
+			entity.SiteName, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decodeEntity[FormattedText](decoder)
+
+		case "author":
+			entity.Author, err = decoder.readString()
+
+		case "type":
+			entity.Type, err = decodeLinkPreviewType(decoder)
+
+		case "has_large_media":
+			entity.HasLargeMedia, err = decoder.readBool()
+
+		case "show_large_media":
+			entity.ShowLargeMedia, err = decoder.readBool()
+
+		case "show_media_above_description":
+			entity.ShowMediaAboveDescription, err = decoder.readBool()
+
+		case "skip_confirmation":
+			entity.SkipConfirmation, err = decoder.readBool()
+
+		case "show_above_text":
+			entity.ShowAboveText, err = decoder.readBool()
+
+		case "instant_view_version":
+			entity.InstantViewVersion, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLinkPreview)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a country
+type CountryInfo struct {
+	meta
+	// A two-letter ISO 3166-1 alpha-2 country code
+	CountryCode string `json:"country_code"`
+	// Native name of the country
+	Name string `json:"name"`
+	// English name of the country
+	EnglishName string `json:"english_name"`
+	// True, if the country must be hidden from the list of all countries
+	IsHidden bool `json:"is_hidden"`
+	// List of country calling codes
+	CallingCodes []string `json:"calling_codes"`
+}
+
+func (*CountryInfo) GetType() string {
+	return TypeCountryInfo
+}
+
+func (*CountryInfo) GetConstructor() string {
+	return ConstructorCountryInfo
+}
+
+func (entity *CountryInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CountryInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CountryInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"countryInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"country_code":`)
+	encoder.writeString(entity.CountryCode)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"english_name":`)
+	encoder.writeString(entity.EnglishName)
+	encoder.writeRaw(`,"is_hidden":`)
+	encoder.writeBool(entity.IsHidden)
+	encoder.writeRaw(`,"calling_codes":`)
+	writeList(encoder, entity.CallingCodes, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *CountryInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "country_code":
+			entity.CountryCode, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "english_name":
+			entity.EnglishName, err = decoder.readString()
+
+		case "is_hidden":
+			entity.IsHidden, err = decoder.readBool()
+
+		case "calling_codes":
+			entity.CallingCodes, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCountryInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about countries
+type Countries struct {
+	meta
+	// The list of countries
+	Countries []*CountryInfo `json:"countries"`
+}
+
+func (*Countries) GetType() string {
+	return TypeCountries
+}
+
+func (*Countries) GetConstructor() string {
+	return ConstructorCountries
+}
+
+func (entity *Countries) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Countries) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Countries) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"countries"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"countries":`)
+	writeList(encoder, entity.Countries, writeTypeValue[*CountryInfo])
+	encoder.writeRaw("}")
+}
+
+func (entity *Countries) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "countries":
+			entity.Countries, err = readList(decoder, decodeEntity[CountryInfo])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCountries)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a phone number
+type PhoneNumberInfo struct {
+	meta
+	// Information about the country to which the phone number belongs; may be null
+	Country *CountryInfo `json:"country,omitempty"`
+	// The part of the phone number denoting country calling code or its part
+	CountryCallingCode string `json:"country_calling_code"`
+	// The phone number without country calling code formatted accordingly to local rules. Expected digits are returned as '-', but even more digits might be entered by the user
+	FormattedPhoneNumber string `json:"formatted_phone_number"`
+	// True, if the phone number was bought at https://fragment.com and isn't tied to a SIM card. Information about the phone number can be received using getCollectibleItemInfo
+	IsAnonymous bool `json:"is_anonymous"`
+}
+
+func (*PhoneNumberInfo) GetType() string {
+	return TypePhoneNumberInfo
+}
+
+func (*PhoneNumberInfo) GetConstructor() string {
+	return ConstructorPhoneNumberInfo
+}
+
+func (entity *PhoneNumberInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PhoneNumberInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PhoneNumberInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"phoneNumberInfo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Country != nil {
+		encoder.writeRaw(`,"country":`)
+		entity.Country.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"country_calling_code":`)
+	encoder.writeString(entity.CountryCallingCode)
+	encoder.writeRaw(`,"formatted_phone_number":`)
+	encoder.writeString(entity.FormattedPhoneNumber)
+	encoder.writeRaw(`,"is_anonymous":`)
+	encoder.writeBool(entity.IsAnonymous)
+	encoder.writeRaw("}")
+}
+
+func (entity *PhoneNumberInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "country":
+			entity.Country, err = decodeEntity[CountryInfo](decoder)
+
+		case "country_calling_code":
+			entity.CountryCallingCode, err = decoder.readString()
+
+		case "formatted_phone_number":
+			entity.FormattedPhoneNumber, err = decoder.readString()
+
+		case "is_anonymous":
+			entity.IsAnonymous, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPhoneNumberInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A username
+type CollectibleItemTypeUsername struct {
+	meta
+	// The username
+	Username string `json:"username"`
+}
+
+func (*CollectibleItemTypeUsername) GetType() string {
+	return TypeCollectibleItemType
+}
+
+func (*CollectibleItemTypeUsername) GetConstructor() string {
+	return ConstructorCollectibleItemTypeUsername
+}
+
+func (*CollectibleItemTypeUsername) CollectibleItemTypeConstructor() string {
+	return ConstructorCollectibleItemTypeUsername
+}
+
+func (entity *CollectibleItemTypeUsername) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CollectibleItemTypeUsername) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CollectibleItemTypeUsername) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"collectibleItemTypeUsername"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	encoder.writeRaw("}")
+}
+
+func (entity *CollectibleItemTypeUsername) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCollectibleItemTypeUsername)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A phone number
+type CollectibleItemTypePhoneNumber struct {
+	meta
+	// The phone number
+	PhoneNumber string `json:"phone_number"`
+}
+
+func (*CollectibleItemTypePhoneNumber) GetType() string {
+	return TypeCollectibleItemType
+}
+
+func (*CollectibleItemTypePhoneNumber) GetConstructor() string {
+	return ConstructorCollectibleItemTypePhoneNumber
+}
+
+func (*CollectibleItemTypePhoneNumber) CollectibleItemTypeConstructor() string {
+	return ConstructorCollectibleItemTypePhoneNumber
+}
+
+func (entity *CollectibleItemTypePhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CollectibleItemTypePhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CollectibleItemTypePhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"collectibleItemTypePhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *CollectibleItemTypePhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCollectibleItemTypePhoneNumber)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a collectible item and its last purchase
+type CollectibleItemInfo struct {
+	meta
+	// Point in time (Unix timestamp) when the item was purchased
+	PurchaseDate int32 `json:"purchase_date"`
+	// Currency for the paid amount
+	Currency string `json:"currency"`
+	// The paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Cryptocurrency used to pay for the item
+	Cryptocurrency string `json:"cryptocurrency"`
+	// The paid amount, in the smallest units of the cryptocurrency
+	CryptocurrencyAmount JsonInt64 `json:"cryptocurrency_amount"`
+	// Individual URL for the item on https://fragment.com
+	Url string `json:"url"`
+}
+
+func (*CollectibleItemInfo) GetType() string {
+	return TypeCollectibleItemInfo
+}
+
+func (*CollectibleItemInfo) GetConstructor() string {
+	return ConstructorCollectibleItemInfo
+}
+
+func (entity *CollectibleItemInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CollectibleItemInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CollectibleItemInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"collectibleItemInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"purchase_date":`)
+	encoder.writeInt32(entity.PurchaseDate)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"cryptocurrency":`)
+	encoder.writeString(entity.Cryptocurrency)
+	encoder.writeRaw(`,"cryptocurrency_amount":`)
+	encoder.writeJsonInt64(entity.CryptocurrencyAmount)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *CollectibleItemInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "purchase_date":
+			entity.PurchaseDate, err = decoder.readInt32()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "cryptocurrency":
+			entity.Cryptocurrency, err = decoder.readString()
+
+		case "cryptocurrency_amount":
+			entity.CryptocurrencyAmount, err = decoder.readJsonInt64()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCollectibleItemInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an action associated with a bank card number
+type BankCardActionOpenUrl struct {
+	meta
+	// Action text
+	Text string `json:"text"`
+	// The URL to be opened
+	Url string `json:"url"`
+}
+
+func (*BankCardActionOpenUrl) GetType() string {
+	return TypeBankCardActionOpenUrl
+}
+
+func (*BankCardActionOpenUrl) GetConstructor() string {
+	return ConstructorBankCardActionOpenUrl
+}
+
+func (entity *BankCardActionOpenUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BankCardActionOpenUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BankCardActionOpenUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"bankCardActionOpenUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *BankCardActionOpenUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBankCardActionOpenUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about a bank card
+type BankCardInfo struct {
+	meta
+	// Title of the bank card description
+	Title string `json:"title"`
+	// Actions that can be done with the bank card number
+	Actions []*BankCardActionOpenUrl `json:"actions"`
+}
+
+func (*BankCardInfo) GetType() string {
+	return TypeBankCardInfo
+}
+
+func (*BankCardInfo) GetConstructor() string {
+	return ConstructorBankCardInfo
+}
+
+func (entity *BankCardInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BankCardInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BankCardInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"bankCardInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"actions":`)
+	writeList(encoder, entity.Actions, writeTypeValue[*BankCardActionOpenUrl])
+	encoder.writeRaw("}")
+}
+
+func (entity *BankCardInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "actions":
+			entity.Actions, err = readList(decoder, decodeEntity[BankCardActionOpenUrl])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBankCardInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an address
+type Address struct {
+	meta
+	// A two-letter ISO 3166-1 alpha-2 country code
+	CountryCode string `json:"country_code"`
+	// State, if applicable
+	State string `json:"state"`
+	// City
+	City string `json:"city"`
+	// First line of the address
+	StreetLine1 string `json:"street_line1"`
+	// Second line of the address
+	StreetLine2 string `json:"street_line2"`
+	// Address postal code
+	PostalCode string `json:"postal_code"`
+}
+
+func (*Address) GetType() string {
+	return TypeAddress
+}
+
+func (*Address) GetConstructor() string {
+	return ConstructorAddress
+}
+
+func (entity *Address) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Address) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Address) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"address"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"country_code":`)
+	encoder.writeString(entity.CountryCode)
+	encoder.writeRaw(`,"state":`)
+	encoder.writeString(entity.State)
+	encoder.writeRaw(`,"city":`)
+	encoder.writeString(entity.City)
+	encoder.writeRaw(`,"street_line1":`)
+	encoder.writeString(entity.StreetLine1)
+	encoder.writeRaw(`,"street_line2":`)
+	encoder.writeString(entity.StreetLine2)
+	encoder.writeRaw(`,"postal_code":`)
+	encoder.writeString(entity.PostalCode)
+	encoder.writeRaw("}")
+}
+
+func (entity *Address) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "country_code":
+			entity.CountryCode, err = decoder.readString()
+
+		case "state":
+			entity.State, err = decoder.readString()
+
+		case "city":
+			entity.City, err = decoder.readString()
+
+		case "street_line1":
+			entity.StreetLine1, err = decoder.readString()
+
+		case "street_line2":
+			entity.StreetLine2, err = decoder.readString()
+
+		case "postal_code":
+			entity.PostalCode, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an address of a location
+type LocationAddress struct {
+	meta
+	// A two-letter ISO 3166-1 alpha-2 country code
+	CountryCode string `json:"country_code"`
+	// State, if applicable; empty if unknown
+	State string `json:"state"`
+	// City; empty if unknown
+	City string `json:"city"`
+	// The address; empty if unknown
+	Street string `json:"street"`
+}
+
+func (*LocationAddress) GetType() string {
+	return TypeLocationAddress
+}
+
+func (*LocationAddress) GetConstructor() string {
+	return ConstructorLocationAddress
+}
+
+func (entity *LocationAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LocationAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LocationAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"locationAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"country_code":`)
+	encoder.writeString(entity.CountryCode)
+	encoder.writeRaw(`,"state":`)
+	encoder.writeString(entity.State)
+	encoder.writeRaw(`,"city":`)
+	encoder.writeString(entity.City)
+	encoder.writeRaw(`,"street":`)
+	encoder.writeString(entity.Street)
+	encoder.writeRaw("}")
+}
+
+func (entity *LocationAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "country_code":
+			entity.CountryCode, err = decoder.readString()
+
+		case "state":
+			entity.State, err = decoder.readString()
+
+		case "city":
+			entity.City, err = decoder.readString()
+
+		case "street":
+			entity.Street, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLocationAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Portion of the price of a product (e.g., "delivery cost", "tax amount")
+type LabeledPricePart struct {
+	meta
+	// Label for this portion of the product price
+	Label string `json:"label"`
+	// Currency amount in the smallest units of the currency
+	Amount int64 `json:"amount"`
+}
+
+func (*LabeledPricePart) GetType() string {
+	return TypeLabeledPricePart
+}
+
+func (*LabeledPricePart) GetConstructor() string {
+	return ConstructorLabeledPricePart
+}
+
+func (entity *LabeledPricePart) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LabeledPricePart) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LabeledPricePart) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"labeledPricePart"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"label":`)
+	encoder.writeString(entity.Label)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw("}")
+}
+
+func (entity *LabeledPricePart) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "label":
+			entity.Label, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLabeledPricePart)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Order information
+type OrderInfo struct {
+	meta
+	// Name of the user
+	Name string `json:"name"`
+	// Phone number of the user
+	PhoneNumber string `json:"phone_number"`
+	// Email address of the user
+	EmailAddress string `json:"email_address"`
+	// Shipping address for this order; may be null
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+}
+
+func (*OrderInfo) GetType() string {
+	return TypeOrderInfo
+}
+
+func (*OrderInfo) GetConstructor() string {
+	return ConstructorOrderInfo
+}
+
+func (entity *OrderInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *OrderInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *OrderInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"orderInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw(`,"email_address":`)
+	encoder.writeString(entity.EmailAddress)
+	if entity.ShippingAddress != nil {
+		encoder.writeRaw(`,"shipping_address":`)
+		entity.ShippingAddress.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *OrderInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		case "email_address":
+			entity.EmailAddress, err = decoder.readString()
+
+		case "shipping_address":
+			entity.ShippingAddress, err = decodeEntity[Address](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorOrderInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// One shipping option
+type ShippingOption struct {
+	meta
+	// Shipping option identifier
+	Id string `json:"id"`
+	// Option title
+	Title string `json:"title"`
+	// A list of objects used to calculate the total shipping costs
+	PriceParts []*LabeledPricePart `json:"price_parts"`
+}
+
+func (*ShippingOption) GetType() string {
+	return TypeShippingOption
+}
+
+func (*ShippingOption) GetConstructor() string {
+	return ConstructorShippingOption
+}
+
+func (entity *ShippingOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ShippingOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ShippingOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"shippingOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"price_parts":`)
+	writeList(encoder, entity.PriceParts, writeTypeValue[*LabeledPricePart])
+	encoder.writeRaw("}")
+}
+
+func (entity *ShippingOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "price_parts":
+			entity.PriceParts, err = readList(decoder, decodeEntity[LabeledPricePart])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorShippingOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about saved payment credentials
+type SavedCredentials struct {
+	meta
+	// Unique identifier of the saved credentials
+	Id string `json:"id"`
+	// Title of the saved credentials
+	Title string `json:"title"`
+}
+
+func (*SavedCredentials) GetType() string {
+	return TypeSavedCredentials
+}
+
+func (*SavedCredentials) GetConstructor() string {
+	return ConstructorSavedCredentials
+}
+
+func (entity *SavedCredentials) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SavedCredentials) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SavedCredentials) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savedCredentials"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *SavedCredentials) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSavedCredentials)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Applies if a user chooses some previously saved payment credentials. To use their previously saved credentials, the user must have a valid temporary password
+type InputCredentialsSaved struct {
+	meta
+	// Identifier of the saved credentials
+	SavedCredentialsId string `json:"saved_credentials_id"`
+}
+
+func (*InputCredentialsSaved) GetType() string {
+	return TypeInputCredentials
+}
+
+func (*InputCredentialsSaved) GetConstructor() string {
+	return ConstructorInputCredentialsSaved
+}
+
+func (*InputCredentialsSaved) InputCredentialsConstructor() string {
+	return ConstructorInputCredentialsSaved
+}
+
+func (entity *InputCredentialsSaved) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputCredentialsSaved) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputCredentialsSaved) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputCredentialsSaved"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"saved_credentials_id":`)
+	encoder.writeString(entity.SavedCredentialsId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputCredentialsSaved) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "saved_credentials_id":
+			entity.SavedCredentialsId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputCredentialsSaved)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Applies if a user enters new credentials on a payment provider website
+type InputCredentialsNew struct {
+	meta
+	// JSON-encoded data with the credential identifier from the payment provider
+	Data string `json:"data"`
+	// True, if the credential identifier can be saved on the server side
+	AllowSave bool `json:"allow_save"`
+}
+
+func (*InputCredentialsNew) GetType() string {
+	return TypeInputCredentials
+}
+
+func (*InputCredentialsNew) GetConstructor() string {
+	return ConstructorInputCredentialsNew
+}
+
+func (*InputCredentialsNew) InputCredentialsConstructor() string {
+	return ConstructorInputCredentialsNew
+}
+
+func (entity *InputCredentialsNew) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputCredentialsNew) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputCredentialsNew) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputCredentialsNew"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(entity.Data)
+	encoder.writeRaw(`,"allow_save":`)
+	encoder.writeBool(entity.AllowSave)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputCredentialsNew) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readString()
+
+		case "allow_save":
+			entity.AllowSave, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputCredentialsNew)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Applies if a user enters new credentials using Apple Pay
+type InputCredentialsApplePay struct {
+	meta
+	// JSON-encoded data with the credential identifier
+	Data string `json:"data"`
+}
+
+func (*InputCredentialsApplePay) GetType() string {
+	return TypeInputCredentials
+}
+
+func (*InputCredentialsApplePay) GetConstructor() string {
+	return ConstructorInputCredentialsApplePay
+}
+
+func (*InputCredentialsApplePay) InputCredentialsConstructor() string {
+	return ConstructorInputCredentialsApplePay
+}
+
+func (entity *InputCredentialsApplePay) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputCredentialsApplePay) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputCredentialsApplePay) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputCredentialsApplePay"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputCredentialsApplePay) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputCredentialsApplePay)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Applies if a user enters new credentials using Google Pay
+type InputCredentialsGooglePay struct {
+	meta
+	// JSON-encoded data with the credential identifier
+	Data string `json:"data"`
+}
+
+func (*InputCredentialsGooglePay) GetType() string {
+	return TypeInputCredentials
+}
+
+func (*InputCredentialsGooglePay) GetConstructor() string {
+	return ConstructorInputCredentialsGooglePay
+}
+
+func (*InputCredentialsGooglePay) InputCredentialsConstructor() string {
+	return ConstructorInputCredentialsGooglePay
+}
+
+func (entity *InputCredentialsGooglePay) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputCredentialsGooglePay) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputCredentialsGooglePay) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputCredentialsGooglePay"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputCredentialsGooglePay) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputCredentialsGooglePay)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a temporary identifier of validated order information, which is stored for one hour, and the available shipping options
+type ValidatedOrderInfo struct {
+	meta
+	// Temporary identifier of the order information
+	OrderInfoId string `json:"order_info_id"`
+	// Available shipping options
+	ShippingOptions []*ShippingOption `json:"shipping_options"`
+}
+
+func (*ValidatedOrderInfo) GetType() string {
+	return TypeValidatedOrderInfo
+}
+
+func (*ValidatedOrderInfo) GetConstructor() string {
+	return ConstructorValidatedOrderInfo
+}
+
+func (entity *ValidatedOrderInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ValidatedOrderInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ValidatedOrderInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"validatedOrderInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"order_info_id":`)
+	encoder.writeString(entity.OrderInfoId)
+	encoder.writeRaw(`,"shipping_options":`)
+	writeList(encoder, entity.ShippingOptions, writeTypeValue[*ShippingOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *ValidatedOrderInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "order_info_id":
+			entity.OrderInfoId, err = decoder.readString()
+
+		case "shipping_options":
+			entity.ShippingOptions, err = readList(decoder, decodeEntity[ShippingOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorValidatedOrderInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a date according to the Gregorian calendar
+type Date struct {
+	meta
+	// Day of the month; 1-31
+	Day int32 `json:"day"`
+	// Month; 1-12
+	Month int32 `json:"month"`
+	// Year; 1-9999
+	Year int32 `json:"year"`
+}
+
+func (*Date) GetType() string {
+	return TypeDate
+}
+
+func (*Date) GetConstructor() string {
+	return ConstructorDate
+}
+
+func (entity *Date) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Date) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"date"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"day":`)
+	encoder.writeInt32(entity.Day)
+	encoder.writeRaw(`,"month":`)
+	encoder.writeInt32(entity.Month)
+	encoder.writeRaw(`,"year":`)
+	encoder.writeInt32(entity.Year)
+	encoder.writeRaw("}")
+}
+
+func (entity *Date) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "day":
+			entity.Day, err = decoder.readInt32()
+
+		case "month":
+			entity.Month, err = decoder.readInt32()
+
+		case "year":
+			entity.Year, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains the user's personal details
+type PersonalDetails struct {
+	meta
+	// First name of the user written in English; 1-255 characters
+	FirstName string `json:"first_name"`
+	// Middle name of the user written in English; 0-255 characters
+	MiddleName string `json:"middle_name"`
+	// Last name of the user written in English; 1-255 characters
+	LastName string `json:"last_name"`
+	// Native first name of the user; 1-255 characters
+	NativeFirstName string `json:"native_first_name"`
+	// Native middle name of the user; 0-255 characters
+	NativeMiddleName string `json:"native_middle_name"`
+	// Native last name of the user; 1-255 characters
+	NativeLastName string `json:"native_last_name"`
+	// Birthdate of the user
+	Birthdate *Date `json:"birthdate"`
+	// Gender of the user, "male" or "female"
+	Gender string `json:"gender"`
+	// A two-letter ISO 3166-1 alpha-2 country code of the user's country
+	CountryCode string `json:"country_code"`
+	// A two-letter ISO 3166-1 alpha-2 country code of the user's residence country
+	ResidenceCountryCode string `json:"residence_country_code"`
+}
+
+func (*PersonalDetails) GetType() string {
+	return TypePersonalDetails
+}
+
+func (*PersonalDetails) GetConstructor() string {
+	return ConstructorPersonalDetails
+}
+
+func (entity *PersonalDetails) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PersonalDetails) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PersonalDetails) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"personalDetails"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"first_name":`)
+	encoder.writeString(entity.FirstName)
+	encoder.writeRaw(`,"middle_name":`)
+	encoder.writeString(entity.MiddleName)
+	encoder.writeRaw(`,"last_name":`)
+	encoder.writeString(entity.LastName)
+	encoder.writeRaw(`,"native_first_name":`)
+	encoder.writeString(entity.NativeFirstName)
+	encoder.writeRaw(`,"native_middle_name":`)
+	encoder.writeString(entity.NativeMiddleName)
+	encoder.writeRaw(`,"native_last_name":`)
+	encoder.writeString(entity.NativeLastName)
+	encoder.writeRaw(`,"birthdate":`)
+	entity.Birthdate.encodeJSON(encoder)
+	encoder.writeRaw(`,"gender":`)
+	encoder.writeString(entity.Gender)
+	encoder.writeRaw(`,"country_code":`)
+	encoder.writeString(entity.CountryCode)
+	encoder.writeRaw(`,"residence_country_code":`)
+	encoder.writeString(entity.ResidenceCountryCode)
+	encoder.writeRaw("}")
+}
+
+func (entity *PersonalDetails) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "first_name":
+			entity.FirstName, err = decoder.readString()
+
+		case "middle_name":
+			entity.MiddleName, err = decoder.readString()
+
+		case "last_name":
+			entity.LastName, err = decoder.readString()
+
+		case "native_first_name":
+			entity.NativeFirstName, err = decoder.readString()
+
+		case "native_middle_name":
+			entity.NativeMiddleName, err = decoder.readString()
+
+		case "native_last_name":
+			entity.NativeLastName, err = decoder.readString()
+
+		case "birthdate":
+			entity.Birthdate, err = decodeEntity[Date](decoder)
+
+		case "gender":
+			entity.Gender, err = decoder.readString()
+
+		case "country_code":
+			entity.CountryCode, err = decoder.readString()
+
+		case "residence_country_code":
+			entity.ResidenceCountryCode, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPersonalDetails)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PersonalDetails) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("first_name", entity.FirstName, 1, 255); err != nil {
+		return err
+	}
+
+	if err := validateLength("middle_name", entity.MiddleName, 0, 255); err != nil {
+		return err
+	}
+
+	if err := validateLength("last_name", entity.LastName, 1, 255); err != nil {
+		return err
+	}
+
+	if err := validateLength("native_first_name", entity.NativeFirstName, 1, 255); err != nil {
+		return err
+	}
+
+	if err := validateLength("native_middle_name", entity.NativeMiddleName, 0, 255); err != nil {
+		return err
+	}
+
+	if err := validateLength("native_last_name", entity.NativeLastName, 1, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains encrypted Telegram Passport data credentials
+type EncryptedCredentials struct {
+	meta
+	// The encrypted credentials
+	Data []byte `json:"data"`
+	// The decrypted data hash
+	Hash []byte `json:"hash"`
+	// Secret for data decryption, encrypted with the service's public key
+	Secret []byte `json:"secret"`
+}
+
+func (*EncryptedCredentials) GetType() string {
+	return TypeEncryptedCredentials
+}
+
+func (*EncryptedCredentials) GetConstructor() string {
+	return ConstructorEncryptedCredentials
+}
+
+func (entity *EncryptedCredentials) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EncryptedCredentials) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EncryptedCredentials) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"encryptedCredentials"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw(`,"hash":`)
+	encoder.writeBytes(entity.Hash)
+	encoder.writeRaw(`,"secret":`)
+	encoder.writeBytes(entity.Secret)
+	encoder.writeRaw("}")
+}
+
+func (entity *EncryptedCredentials) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		case "hash":
+			entity.Hash, err = decoder.readBytes()
+
+		case "secret":
+			entity.Secret, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEncryptedCredentials)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes the current weather
+type CurrentWeather struct {
+	meta
+	// Temperature, in degree Celsius
+	Temperature float64 `json:"temperature"`
+	// Emoji representing the weather
+	Emoji string `json:"emoji"`
+}
+
+func (*CurrentWeather) GetType() string {
+	return TypeCurrentWeather
+}
+
+func (*CurrentWeather) GetConstructor() string {
+	return ConstructorCurrentWeather
+}
+
+func (entity *CurrentWeather) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CurrentWeather) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CurrentWeather) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"currentWeather"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"temperature":`)
+	encoder.writeFloat64(entity.Temperature)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw("}")
+}
+
+func (entity *CurrentWeather) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "temperature":
+			entity.Temperature, err = decoder.readFloat64()
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCurrentWeather)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of public forwards and reposts as a story of a message or a story
+type PublicForwards struct {
+	meta
+	// Approximate total number of messages and stories found
+	TotalCount int32 `json:"total_count"`
+	// List of found public forwards and reposts
+	Forwards []PublicForward `json:"forwards"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*PublicForwards) GetType() string {
+	return TypePublicForwards
+}
+
+func (*PublicForwards) GetConstructor() string {
+	return ConstructorPublicForwards
+}
+
+func (entity *PublicForwards) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PublicForwards) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PublicForwards) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"publicForwards"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"forwards":`)
+	writeList(encoder, entity.Forwards, writeTypeValue[PublicForward])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *PublicForwards) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "forwards":
+			entity.Forwards, err = readList(decoder, decodePublicForward)
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPublicForwards)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PublicForwards) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Forwards {
+		if err := validateNested(indexField("forwards", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The user requested to resend the code
+type ResendCodeReasonUserRequest struct {
+	meta
+}
+
+func (*ResendCodeReasonUserRequest) GetType() string {
+	return TypeResendCodeReason
+}
+
+func (*ResendCodeReasonUserRequest) GetConstructor() string {
+	return ConstructorResendCodeReasonUserRequest
+}
+
+func (*ResendCodeReasonUserRequest) ResendCodeReasonConstructor() string {
+	return ConstructorResendCodeReasonUserRequest
+}
+
+func (entity *ResendCodeReasonUserRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ResendCodeReasonUserRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ResendCodeReasonUserRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendCodeReasonUserRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ResendCodeReasonUserRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorResendCodeReasonUserRequest)
+	})
+}
+
+// The code is re-sent, because device verification has failed
+type ResendCodeReasonVerificationFailed struct {
+	meta
+	// Cause of the verification failure, for example, PLAY_SERVICES_NOT_AVAILABLE, APNS_RECEIVE_TIMEOUT, or APNS_INIT_FAILED
+	ErrorMessage string `json:"error_message"`
+}
+
+func (*ResendCodeReasonVerificationFailed) GetType() string {
+	return TypeResendCodeReason
+}
+
+func (*ResendCodeReasonVerificationFailed) GetConstructor() string {
+	return ConstructorResendCodeReasonVerificationFailed
+}
+
+func (*ResendCodeReasonVerificationFailed) ResendCodeReasonConstructor() string {
+	return ConstructorResendCodeReasonVerificationFailed
+}
+
+func (entity *ResendCodeReasonVerificationFailed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ResendCodeReasonVerificationFailed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ResendCodeReasonVerificationFailed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"resendCodeReasonVerificationFailed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"error_message":`)
+	encoder.writeString(entity.ErrorMessage)
+	encoder.writeRaw("}")
+}
+
+func (entity *ResendCodeReasonVerificationFailed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "error_message":
+			entity.ErrorMessage, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorResendCodeReasonVerificationFailed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an RTMP URL
+type RtmpUrl struct {
+	meta
+	// The URL
+	Url string `json:"url"`
+	// Stream key
+	StreamKey string `json:"stream_key"`
+}
+
+func (*RtmpUrl) GetType() string {
+	return TypeRtmpUrl
+}
+
+func (*RtmpUrl) GetConstructor() string {
+	return ConstructorRtmpUrl
+}
+
+func (entity *RtmpUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RtmpUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RtmpUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"rtmpUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"stream_key":`)
+	encoder.writeString(entity.StreamKey)
+	encoder.writeRaw("}")
+}
+
+func (entity *RtmpUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "stream_key":
+			entity.StreamKey, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRtmpUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The speech recognition is ongoing
+type SpeechRecognitionResultPending struct {
+	meta
+	// Partially recognized text
+	PartialText string `json:"partial_text"`
+}
+
+func (*SpeechRecognitionResultPending) GetType() string {
+	return TypeSpeechRecognitionResult
+}
+
+func (*SpeechRecognitionResultPending) GetConstructor() string {
+	return ConstructorSpeechRecognitionResultPending
+}
+
+func (*SpeechRecognitionResultPending) SpeechRecognitionResultConstructor() string {
+	return ConstructorSpeechRecognitionResultPending
+}
+
+func (entity *SpeechRecognitionResultPending) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SpeechRecognitionResultPending) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SpeechRecognitionResultPending) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"speechRecognitionResultPending"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"partial_text":`)
+	encoder.writeString(entity.PartialText)
+	encoder.writeRaw("}")
+}
+
+func (entity *SpeechRecognitionResultPending) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "partial_text":
+			entity.PartialText, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSpeechRecognitionResultPending)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The speech recognition successfully finished
+type SpeechRecognitionResultText struct {
+	meta
+	// Recognized text
+	Text string `json:"text"`
+}
+
+func (*SpeechRecognitionResultText) GetType() string {
+	return TypeSpeechRecognitionResult
+}
+
+func (*SpeechRecognitionResultText) GetConstructor() string {
+	return ConstructorSpeechRecognitionResultText
+}
+
+func (*SpeechRecognitionResultText) SpeechRecognitionResultConstructor() string {
+	return ConstructorSpeechRecognitionResultText
+}
+
+func (entity *SpeechRecognitionResultText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SpeechRecognitionResultText) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SpeechRecognitionResultText) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"speechRecognitionResultText"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *SpeechRecognitionResultText) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSpeechRecognitionResultText)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The speech recognition failed
+type SpeechRecognitionResultError struct {
+	meta
+	// Recognition error. An error with a message "MSG_VOICE_TOO_LONG" is returned when media duration is too big to be recognized
+	Error *Error `json:"error"`
+}
+
+func (*SpeechRecognitionResultError) GetType() string {
+	return TypeSpeechRecognitionResult
+}
+
+func (*SpeechRecognitionResultError) GetConstructor() string {
+	return ConstructorSpeechRecognitionResultError
+}
+
+func (*SpeechRecognitionResultError) SpeechRecognitionResultConstructor() string {
+	return ConstructorSpeechRecognitionResultError
+}
+
+func (entity *SpeechRecognitionResultError) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SpeechRecognitionResultError) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SpeechRecognitionResultError) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"speechRecognitionResultError"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"error":`)
+	entity.Error.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *SpeechRecognitionResultError) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "error":
+			entity.Error, err = decodeEntity[Error](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSpeechRecognitionResultError)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains an HTTP URL
+type HttpUrl struct {
+	meta
+	// The URL
+	Url string `json:"url"`
+}
+
+func (*HttpUrl) GetType() string {
+	return TypeHttpUrl
+}
+
+func (*HttpUrl) GetConstructor() string {
+	return ConstructorHttpUrl
+}
+
+func (entity *HttpUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *HttpUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *HttpUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"httpUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *HttpUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorHttpUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains the result of a custom request
+type CustomRequestResult struct {
+	meta
+	// A JSON-serialized result
+	Result string `json:"result"`
+}
+
+func (*CustomRequestResult) GetType() string {
+	return TypeCustomRequestResult
+}
+
+func (*CustomRequestResult) GetConstructor() string {
+	return ConstructorCustomRequestResult
+}
+
+func (entity *CustomRequestResult) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CustomRequestResult) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CustomRequestResult) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"customRequestResult"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"result":`)
+	encoder.writeString(entity.Result)
+	encoder.writeRaw("}")
+}
+
+func (entity *CustomRequestResult) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "result":
+			entity.Result, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCustomRequestResult)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An ordinary language pack string
+type LanguagePackStringValueOrdinary struct {
+	meta
+	// String value
+	Value string `json:"value"`
+}
+
+func (*LanguagePackStringValueOrdinary) GetType() string {
+	return TypeLanguagePackStringValue
+}
+
+func (*LanguagePackStringValueOrdinary) GetConstructor() string {
+	return ConstructorLanguagePackStringValueOrdinary
+}
+
+func (*LanguagePackStringValueOrdinary) LanguagePackStringValueConstructor() string {
+	return ConstructorLanguagePackStringValueOrdinary
+}
+
+func (entity *LanguagePackStringValueOrdinary) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LanguagePackStringValueOrdinary) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LanguagePackStringValueOrdinary) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"languagePackStringValueOrdinary"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeString(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *LanguagePackStringValueOrdinary) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLanguagePackStringValueOrdinary)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A language pack string which has different forms based on the number of some object it mentions. See https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html for more information
+type LanguagePackStringValuePluralized struct {
+	meta
+	// Value for zero objects
+	ZeroValue string `json:"zero_value"`
+	// Value for one object
+	OneValue string `json:"one_value"`
+	// Value for two objects
+	TwoValue string `json:"two_value"`
+	// Value for few objects
+	FewValue string `json:"few_value"`
+	// Value for many objects
+	ManyValue string `json:"many_value"`
+	// Default value
+	OtherValue string `json:"other_value"`
+}
+
+func (*LanguagePackStringValuePluralized) GetType() string {
+	return TypeLanguagePackStringValue
+}
+
+func (*LanguagePackStringValuePluralized) GetConstructor() string {
+	return ConstructorLanguagePackStringValuePluralized
+}
+
+func (*LanguagePackStringValuePluralized) LanguagePackStringValueConstructor() string {
+	return ConstructorLanguagePackStringValuePluralized
+}
+
+func (entity *LanguagePackStringValuePluralized) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LanguagePackStringValuePluralized) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LanguagePackStringValuePluralized) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"languagePackStringValuePluralized"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"zero_value":`)
+	encoder.writeString(entity.ZeroValue)
+	encoder.writeRaw(`,"one_value":`)
+	encoder.writeString(entity.OneValue)
+	encoder.writeRaw(`,"two_value":`)
+	encoder.writeString(entity.TwoValue)
+	encoder.writeRaw(`,"few_value":`)
+	encoder.writeString(entity.FewValue)
+	encoder.writeRaw(`,"many_value":`)
+	encoder.writeString(entity.ManyValue)
+	encoder.writeRaw(`,"other_value":`)
+	encoder.writeString(entity.OtherValue)
+	encoder.writeRaw("}")
+}
+
+func (entity *LanguagePackStringValuePluralized) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "zero_value":
+			entity.ZeroValue, err = decoder.readString()
+
+		case "one_value":
+			entity.OneValue, err = decoder.readString()
+
+		case "two_value":
+			entity.TwoValue, err = decoder.readString()
+
+		case "few_value":
+			entity.FewValue, err = decoder.readString()
+
+		case "many_value":
+			entity.ManyValue, err = decoder.readString()
+
+		case "other_value":
+			entity.OtherValue, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLanguagePackStringValuePluralized)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A deleted language pack string, the value must be taken from the built-in English language pack
+type LanguagePackStringValueDeleted struct {
+	meta
+}
+
+func (*LanguagePackStringValueDeleted) GetType() string {
+	return TypeLanguagePackStringValue
+}
+
+func (*LanguagePackStringValueDeleted) GetConstructor() string {
+	return ConstructorLanguagePackStringValueDeleted
+}
+
+func (*LanguagePackStringValueDeleted) LanguagePackStringValueConstructor() string {
+	return ConstructorLanguagePackStringValueDeleted
+}
+
+func (entity *LanguagePackStringValueDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LanguagePackStringValueDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LanguagePackStringValueDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"languagePackStringValueDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LanguagePackStringValueDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLanguagePackStringValueDeleted)
+	})
+}
+
+// Represents one language pack string
+type LanguagePackString struct {
+	meta
+	// String key
+	Key string `json:"key"`
+	// String value; pass null if the string needs to be taken from the built-in English language pack
+	Value LanguagePackStringValue `json:"value,omitempty"`
+}
+
+func (*LanguagePackString) GetType() string {
+	return TypeLanguagePackString
+}
+
+func (*LanguagePackString) GetConstructor() string {
+	return ConstructorLanguagePackString
+}
+
+func (entity *LanguagePackString) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LanguagePackString) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LanguagePackString) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"languagePackString"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"key":`)
+	encoder.writeString(entity.Key)
+	if entity.Value != nil {
+		encoder.writeRaw(`,"value":`)
+		encoder.writeType(entity.Value)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *LanguagePackString) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "key":
+			entity.Key, err = decoder.readString()
+
+		case "value":
+			entity.Value, err = decodeLanguagePackStringValue(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLanguagePackString)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of language pack strings
+type LanguagePackStrings struct {
+	meta
+	// A list of language pack strings
+	Strings []*LanguagePackString `json:"strings"`
+}
+
+func (*LanguagePackStrings) GetType() string {
+	return TypeLanguagePackStrings
+}
+
+func (*LanguagePackStrings) GetConstructor() string {
+	return ConstructorLanguagePackStrings
+}
+
+func (entity *LanguagePackStrings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LanguagePackStrings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LanguagePackStrings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"languagePackStrings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"strings":`)
+	writeList(encoder, entity.Strings, writeTypeValue[*LanguagePackString])
+	encoder.writeRaw("}")
+}
+
+func (entity *LanguagePackStrings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "strings":
+			entity.Strings, err = readList(decoder, decodeEntity[LanguagePackString])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLanguagePackStrings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a language pack
+type LanguagePackInfo struct {
+	meta
+	// Unique language pack identifier
+	Id string `json:"id"`
+	// Identifier of a base language pack; may be empty. If a string is missed in the language pack, then it must be fetched from base language pack. Unsupported in custom language packs
+	BaseLanguagePackId string `json:"base_language_pack_id"`
+	// Language name
+	Name string `json:"name"`
+	// Name of the language in that language
+	NativeName string `json:"native_name"`
+	// A language code to be used to apply plural forms. See https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html for more information
+	PluralCode string `json:"plural_code"`
+	// True, if the language pack is official
+	IsOfficial bool `json:"is_official"`
+	// True, if the language pack strings are RTL
+	IsRtl bool `json:"is_rtl"`
+	// True, if the language pack is a beta language pack
+	IsBeta bool `json:"is_beta"`
+	// True, if the language pack is installed by the current user
+	IsInstalled bool `json:"is_installed"`
+	// Total number of non-deleted strings from the language pack
+	TotalStringCount int32 `json:"total_string_count"`
+	// Total number of translated strings from the language pack
+	TranslatedStringCount int32 `json:"translated_string_count"`
+	// Total number of non-deleted strings from the language pack available locally
+	LocalStringCount int32 `json:"local_string_count"`
+	// Link to language translation interface; empty for custom local language packs
+	TranslationUrl string `json:"translation_url"`
+}
+
+func (*LanguagePackInfo) GetType() string {
+	return TypeLanguagePackInfo
+}
+
+func (*LanguagePackInfo) GetConstructor() string {
+	return ConstructorLanguagePackInfo
+}
+
+func (entity *LanguagePackInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LanguagePackInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LanguagePackInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"languagePackInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"base_language_pack_id":`)
+	encoder.writeString(entity.BaseLanguagePackId)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"native_name":`)
+	encoder.writeString(entity.NativeName)
+	encoder.writeRaw(`,"plural_code":`)
+	encoder.writeString(entity.PluralCode)
+	encoder.writeRaw(`,"is_official":`)
+	encoder.writeBool(entity.IsOfficial)
+	encoder.writeRaw(`,"is_rtl":`)
+	encoder.writeBool(entity.IsRtl)
+	encoder.writeRaw(`,"is_beta":`)
+	encoder.writeBool(entity.IsBeta)
+	encoder.writeRaw(`,"is_installed":`)
+	encoder.writeBool(entity.IsInstalled)
+	encoder.writeRaw(`,"total_string_count":`)
+	encoder.writeInt32(entity.TotalStringCount)
+	encoder.writeRaw(`,"translated_string_count":`)
+	encoder.writeInt32(entity.TranslatedStringCount)
+	encoder.writeRaw(`,"local_string_count":`)
+	encoder.writeInt32(entity.LocalStringCount)
+	encoder.writeRaw(`,"translation_url":`)
+	encoder.writeString(entity.TranslationUrl)
+	encoder.writeRaw("}")
+}
+
+func (entity *LanguagePackInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "base_language_pack_id":
+			entity.BaseLanguagePackId, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "native_name":
+			entity.NativeName, err = decoder.readString()
+
+		case "plural_code":
+			entity.PluralCode, err = decoder.readString()
+
+		case "is_official":
+			entity.IsOfficial, err = decoder.readBool()
+
+		case "is_rtl":
+			entity.IsRtl, err = decoder.readBool()
+
+		case "is_beta":
+			entity.IsBeta, err = decoder.readBool()
+
+		case "is_installed":
+			entity.IsInstalled, err = decoder.readBool()
+
+		case "total_string_count":
+			entity.TotalStringCount, err = decoder.readInt32()
+
+		case "translated_string_count":
+			entity.TranslatedStringCount, err = decoder.readInt32()
+
+		case "local_string_count":
+			entity.LocalStringCount, err = decoder.readInt32()
+
+		case "translation_url":
+			entity.TranslationUrl, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLanguagePackInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about the current localization target
+type LocalizationTargetInfo struct {
+	meta
+	// List of available language packs for this application
+	LanguagePacks []*LanguagePackInfo `json:"language_packs"`
+}
+
+func (*LocalizationTargetInfo) GetType() string {
+	return TypeLocalizationTargetInfo
+}
+
+func (*LocalizationTargetInfo) GetConstructor() string {
+	return ConstructorLocalizationTargetInfo
+}
+
+func (entity *LocalizationTargetInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LocalizationTargetInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LocalizationTargetInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"localizationTargetInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"language_packs":`)
+	writeList(encoder, entity.LanguagePacks, writeTypeValue[*LanguagePackInfo])
+	encoder.writeRaw("}")
+}
+
+func (entity *LocalizationTargetInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "language_packs":
+			entity.LanguagePacks, err = readList(decoder, decodeEntity[LanguagePackInfo])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLocalizationTargetInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Firebase Cloud Messaging
+type DeviceTokenFirebaseCloudMessaging struct {
+	meta
+	// Device registration token; may be empty to deregister a device
+	Token string `json:"token"`
+	// True, if push notifications must be additionally encrypted
+	Encrypt bool `json:"encrypt"`
+}
+
+func (*DeviceTokenFirebaseCloudMessaging) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenFirebaseCloudMessaging) GetConstructor() string {
+	return ConstructorDeviceTokenFirebaseCloudMessaging
+}
+
+func (*DeviceTokenFirebaseCloudMessaging) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenFirebaseCloudMessaging
+}
+
+func (entity *DeviceTokenFirebaseCloudMessaging) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenFirebaseCloudMessaging) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenFirebaseCloudMessaging) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenFirebaseCloudMessaging"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw(`,"encrypt":`)
+	encoder.writeBool(entity.Encrypt)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenFirebaseCloudMessaging) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		case "encrypt":
+			entity.Encrypt, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenFirebaseCloudMessaging)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Apple Push Notification service
+type DeviceTokenApplePush struct {
+	meta
+	// Device token; may be empty to deregister a device
+	DeviceToken string `json:"device_token"`
+	// True, if App Sandbox is enabled
+	IsAppSandbox bool `json:"is_app_sandbox"`
+}
+
+func (*DeviceTokenApplePush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenApplePush) GetConstructor() string {
+	return ConstructorDeviceTokenApplePush
+}
+
+func (*DeviceTokenApplePush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenApplePush
+}
+
+func (entity *DeviceTokenApplePush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenApplePush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenApplePush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenApplePush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"device_token":`)
+	encoder.writeString(entity.DeviceToken)
+	encoder.writeRaw(`,"is_app_sandbox":`)
+	encoder.writeBool(entity.IsAppSandbox)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenApplePush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "device_token":
+			entity.DeviceToken, err = decoder.readString()
+
+		case "is_app_sandbox":
+			entity.IsAppSandbox, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenApplePush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Apple Push Notification service VoIP notifications
+type DeviceTokenApplePushVoIP struct {
+	meta
+	// Device token; may be empty to deregister a device
+	DeviceToken string `json:"device_token"`
+	// True, if App Sandbox is enabled
+	IsAppSandbox bool `json:"is_app_sandbox"`
+	// True, if push notifications must be additionally encrypted
+	Encrypt bool `json:"encrypt"`
+}
+
+func (*DeviceTokenApplePushVoIP) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenApplePushVoIP) GetConstructor() string {
+	return ConstructorDeviceTokenApplePushVoIP
+}
+
+func (*DeviceTokenApplePushVoIP) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenApplePushVoIP
+}
+
+func (entity *DeviceTokenApplePushVoIP) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenApplePushVoIP) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenApplePushVoIP) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenApplePushVoIP"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"device_token":`)
+	encoder.writeString(entity.DeviceToken)
+	encoder.writeRaw(`,"is_app_sandbox":`)
+	encoder.writeBool(entity.IsAppSandbox)
+	encoder.writeRaw(`,"encrypt":`)
+	encoder.writeBool(entity.Encrypt)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenApplePushVoIP) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "device_token":
+			entity.DeviceToken, err = decoder.readString()
+
+		case "is_app_sandbox":
+			entity.IsAppSandbox, err = decoder.readBool()
+
+		case "encrypt":
+			entity.Encrypt, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenApplePushVoIP)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Windows Push Notification Services
+type DeviceTokenWindowsPush struct {
+	meta
+	// The access token that will be used to send notifications; may be empty to deregister a device
+	AccessToken string `json:"access_token"`
+}
+
+func (*DeviceTokenWindowsPush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenWindowsPush) GetConstructor() string {
+	return ConstructorDeviceTokenWindowsPush
+}
+
+func (*DeviceTokenWindowsPush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenWindowsPush
+}
+
+func (entity *DeviceTokenWindowsPush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenWindowsPush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenWindowsPush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenWindowsPush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"access_token":`)
+	encoder.writeString(entity.AccessToken)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenWindowsPush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "access_token":
+			entity.AccessToken, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenWindowsPush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Microsoft Push Notification Service
+type DeviceTokenMicrosoftPush struct {
+	meta
+	// Push notification channel URI; may be empty to deregister a device
+	ChannelUri string `json:"channel_uri"`
+}
+
+func (*DeviceTokenMicrosoftPush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenMicrosoftPush) GetConstructor() string {
+	return ConstructorDeviceTokenMicrosoftPush
+}
+
+func (*DeviceTokenMicrosoftPush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenMicrosoftPush
+}
+
+func (entity *DeviceTokenMicrosoftPush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenMicrosoftPush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenMicrosoftPush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenMicrosoftPush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"channel_uri":`)
+	encoder.writeString(entity.ChannelUri)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenMicrosoftPush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "channel_uri":
+			entity.ChannelUri, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenMicrosoftPush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Microsoft Push Notification Service VoIP channel
+type DeviceTokenMicrosoftPushVoIP struct {
+	meta
+	// Push notification channel URI; may be empty to deregister a device
+	ChannelUri string `json:"channel_uri"`
+}
+
+func (*DeviceTokenMicrosoftPushVoIP) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenMicrosoftPushVoIP) GetConstructor() string {
+	return ConstructorDeviceTokenMicrosoftPushVoIP
+}
+
+func (*DeviceTokenMicrosoftPushVoIP) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenMicrosoftPushVoIP
+}
+
+func (entity *DeviceTokenMicrosoftPushVoIP) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenMicrosoftPushVoIP) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenMicrosoftPushVoIP) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenMicrosoftPushVoIP"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"channel_uri":`)
+	encoder.writeString(entity.ChannelUri)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenMicrosoftPushVoIP) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "channel_uri":
+			entity.ChannelUri, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenMicrosoftPushVoIP)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for web Push API
+type DeviceTokenWebPush struct {
+	meta
+	// Absolute URL exposed by the push service where the application server can send push messages; may be empty to deregister a device
+	Endpoint string `json:"endpoint"`
+	// Base64url-encoded P-256 elliptic curve Diffie-Hellman public key
+	P256dhBase64url string `json:"p256dh_base64url"`
+	// Base64url-encoded authentication secret
+	AuthBase64url string `json:"auth_base64url"`
+}
+
+func (*DeviceTokenWebPush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenWebPush) GetConstructor() string {
+	return ConstructorDeviceTokenWebPush
+}
+
+func (*DeviceTokenWebPush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenWebPush
+}
+
+func (entity *DeviceTokenWebPush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenWebPush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenWebPush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenWebPush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"endpoint":`)
+	encoder.writeString(entity.Endpoint)
+	encoder.writeRaw(`,"p256dh_base64url":`)
+	encoder.writeString(entity.P256dhBase64url)
+	encoder.writeRaw(`,"auth_base64url":`)
+	encoder.writeString(entity.AuthBase64url)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenWebPush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "endpoint":
+			entity.Endpoint, err = decoder.readString()
+
+		case "p256dh_base64url":
+			entity.P256dhBase64url, err = decoder.readString()
+
+		case "auth_base64url":
+			entity.AuthBase64url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenWebPush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Simple Push API for Firefox OS
+type DeviceTokenSimplePush struct {
+	meta
+	// Absolute URL exposed by the push service where the application server can send push messages; may be empty to deregister a device
+	Endpoint string `json:"endpoint"`
+}
+
+func (*DeviceTokenSimplePush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenSimplePush) GetConstructor() string {
+	return ConstructorDeviceTokenSimplePush
+}
+
+func (*DeviceTokenSimplePush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenSimplePush
+}
+
+func (entity *DeviceTokenSimplePush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenSimplePush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenSimplePush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenSimplePush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"endpoint":`)
+	encoder.writeString(entity.Endpoint)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenSimplePush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "endpoint":
+			entity.Endpoint, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenSimplePush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Ubuntu Push Client service
+type DeviceTokenUbuntuPush struct {
+	meta
+	// Token; may be empty to deregister a device
+	Token string `json:"token"`
+}
+
+func (*DeviceTokenUbuntuPush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenUbuntuPush) GetConstructor() string {
+	return ConstructorDeviceTokenUbuntuPush
+}
+
+func (*DeviceTokenUbuntuPush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenUbuntuPush
+}
+
+func (entity *DeviceTokenUbuntuPush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenUbuntuPush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenUbuntuPush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenUbuntuPush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenUbuntuPush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenUbuntuPush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for BlackBerry Push Service
+type DeviceTokenBlackBerryPush struct {
+	meta
+	// Token; may be empty to deregister a device
+	Token string `json:"token"`
+}
+
+func (*DeviceTokenBlackBerryPush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenBlackBerryPush) GetConstructor() string {
+	return ConstructorDeviceTokenBlackBerryPush
+}
+
+func (*DeviceTokenBlackBerryPush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenBlackBerryPush
+}
+
+func (entity *DeviceTokenBlackBerryPush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenBlackBerryPush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenBlackBerryPush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenBlackBerryPush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenBlackBerryPush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenBlackBerryPush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for Tizen Push Service
+type DeviceTokenTizenPush struct {
+	meta
+	// Push service registration identifier; may be empty to deregister a device
+	RegId string `json:"reg_id"`
+}
+
+func (*DeviceTokenTizenPush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenTizenPush) GetConstructor() string {
+	return ConstructorDeviceTokenTizenPush
+}
+
+func (*DeviceTokenTizenPush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenTizenPush
+}
+
+func (entity *DeviceTokenTizenPush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenTizenPush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenTizenPush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenTizenPush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reg_id":`)
+	encoder.writeString(entity.RegId)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenTizenPush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reg_id":
+			entity.RegId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenTizenPush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A token for HUAWEI Push Service
+type DeviceTokenHuaweiPush struct {
+	meta
+	// Device registration token; may be empty to deregister a device
+	Token string `json:"token"`
+	// True, if push notifications must be additionally encrypted
+	Encrypt bool `json:"encrypt"`
+}
+
+func (*DeviceTokenHuaweiPush) GetType() string {
+	return TypeDeviceToken
+}
+
+func (*DeviceTokenHuaweiPush) GetConstructor() string {
+	return ConstructorDeviceTokenHuaweiPush
+}
+
+func (*DeviceTokenHuaweiPush) DeviceTokenConstructor() string {
+	return ConstructorDeviceTokenHuaweiPush
+}
+
+func (entity *DeviceTokenHuaweiPush) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeviceTokenHuaweiPush) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeviceTokenHuaweiPush) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deviceTokenHuaweiPush"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw(`,"encrypt":`)
+	encoder.writeBool(entity.Encrypt)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeviceTokenHuaweiPush) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		case "encrypt":
+			entity.Encrypt, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeviceTokenHuaweiPush)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a globally unique push receiver identifier, which can be used to identify which account has received a push notification
+type PushReceiverId struct {
+	meta
+	// The globally unique identifier of push notification subscription
+	Id JsonInt64 `json:"id"`
+}
+
+func (*PushReceiverId) GetType() string {
+	return TypePushReceiverId
+}
+
+func (*PushReceiverId) GetConstructor() string {
+	return ConstructorPushReceiverId
+}
+
+func (entity *PushReceiverId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushReceiverId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushReceiverId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushReceiverId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushReceiverId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushReceiverId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a time zone
+type TimeZone struct {
+	meta
+	// Unique time zone identifier
+	Id string `json:"id"`
+	// Time zone name
+	Name string `json:"name"`
+	// Current UTC time offset for the time zone
+	UtcTimeOffset int32 `json:"utc_time_offset"`
+}
+
+func (*TimeZone) GetType() string {
+	return TypeTimeZone
+}
+
+func (*TimeZone) GetConstructor() string {
+	return ConstructorTimeZone
+}
+
+func (entity *TimeZone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TimeZone) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TimeZone) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"timeZone"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"utc_time_offset":`)
+	encoder.writeInt32(entity.UtcTimeOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *TimeZone) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "utc_time_offset":
+			entity.UtcTimeOffset, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTimeZone)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of time zones
+type TimeZones struct {
+	meta
+	// A list of time zones
+	TimeZones []*TimeZone `json:"time_zones"`
+}
+
+func (*TimeZones) GetType() string {
+	return TypeTimeZones
+}
+
+func (*TimeZones) GetConstructor() string {
+	return ConstructorTimeZones
+}
+
+func (entity *TimeZones) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TimeZones) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TimeZones) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"timeZones"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"time_zones":`)
+	writeList(encoder, entity.TimeZones, writeTypeValue[*TimeZone])
+	encoder.writeRaw("}")
+}
+
+func (entity *TimeZones) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "time_zones":
+			entity.TimeZones, err = readList(decoder, decodeEntity[TimeZone])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTimeZones)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of hashtags
+type Hashtags struct {
+	meta
+	// A list of hashtags
+	Hashtags []string `json:"hashtags"`
+}
+
+func (*Hashtags) GetType() string {
+	return TypeHashtags
+}
+
+func (*Hashtags) GetConstructor() string {
+	return ConstructorHashtags
+}
+
+func (entity *Hashtags) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Hashtags) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Hashtags) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"hashtags"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"hashtags":`)
+	writeList(encoder, entity.Hashtags, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *Hashtags) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "hashtags":
+			entity.Hashtags, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorHashtags)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The session can be used
+type CanTransferOwnershipResultOk struct {
+	meta
+}
+
+func (*CanTransferOwnershipResultOk) GetType() string {
+	return TypeCanTransferOwnershipResult
+}
+
+func (*CanTransferOwnershipResultOk) GetConstructor() string {
+	return ConstructorCanTransferOwnershipResultOk
+}
+
+func (*CanTransferOwnershipResultOk) CanTransferOwnershipResultConstructor() string {
+	return ConstructorCanTransferOwnershipResultOk
+}
+
+func (entity *CanTransferOwnershipResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanTransferOwnershipResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanTransferOwnershipResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canTransferOwnershipResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanTransferOwnershipResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanTransferOwnershipResultOk)
+	})
+}
+
+// The 2-step verification needs to be enabled first
+type CanTransferOwnershipResultPasswordNeeded struct {
+	meta
+}
+
+func (*CanTransferOwnershipResultPasswordNeeded) GetType() string {
+	return TypeCanTransferOwnershipResult
+}
+
+func (*CanTransferOwnershipResultPasswordNeeded) GetConstructor() string {
+	return ConstructorCanTransferOwnershipResultPasswordNeeded
+}
+
+func (*CanTransferOwnershipResultPasswordNeeded) CanTransferOwnershipResultConstructor() string {
+	return ConstructorCanTransferOwnershipResultPasswordNeeded
+}
+
+func (entity *CanTransferOwnershipResultPasswordNeeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanTransferOwnershipResultPasswordNeeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanTransferOwnershipResultPasswordNeeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canTransferOwnershipResultPasswordNeeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanTransferOwnershipResultPasswordNeeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanTransferOwnershipResultPasswordNeeded)
+	})
+}
+
+// The 2-step verification was enabled recently, user needs to wait
+type CanTransferOwnershipResultPasswordTooFresh struct {
+	meta
+	// Time left before the session can be used to transfer ownership of a chat, in seconds
+	RetryAfter int32 `json:"retry_after"`
+}
+
+func (*CanTransferOwnershipResultPasswordTooFresh) GetType() string {
+	return TypeCanTransferOwnershipResult
+}
+
+func (*CanTransferOwnershipResultPasswordTooFresh) GetConstructor() string {
+	return ConstructorCanTransferOwnershipResultPasswordTooFresh
+}
+
+func (*CanTransferOwnershipResultPasswordTooFresh) CanTransferOwnershipResultConstructor() string {
+	return ConstructorCanTransferOwnershipResultPasswordTooFresh
+}
+
+func (entity *CanTransferOwnershipResultPasswordTooFresh) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanTransferOwnershipResultPasswordTooFresh) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanTransferOwnershipResultPasswordTooFresh) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canTransferOwnershipResultPasswordTooFresh"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"retry_after":`)
+	encoder.writeInt32(entity.RetryAfter)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanTransferOwnershipResultPasswordTooFresh) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "retry_after":
+			entity.RetryAfter, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCanTransferOwnershipResultPasswordTooFresh)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The session was created recently, user needs to wait
+type CanTransferOwnershipResultSessionTooFresh struct {
+	meta
+	// Time left before the session can be used to transfer ownership of a chat, in seconds
+	RetryAfter int32 `json:"retry_after"`
+}
+
+func (*CanTransferOwnershipResultSessionTooFresh) GetType() string {
+	return TypeCanTransferOwnershipResult
+}
+
+func (*CanTransferOwnershipResultSessionTooFresh) GetConstructor() string {
+	return ConstructorCanTransferOwnershipResultSessionTooFresh
+}
+
+func (*CanTransferOwnershipResultSessionTooFresh) CanTransferOwnershipResultConstructor() string {
+	return ConstructorCanTransferOwnershipResultSessionTooFresh
+}
+
+func (entity *CanTransferOwnershipResultSessionTooFresh) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanTransferOwnershipResultSessionTooFresh) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanTransferOwnershipResultSessionTooFresh) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canTransferOwnershipResultSessionTooFresh"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"retry_after":`)
+	encoder.writeInt32(entity.RetryAfter)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanTransferOwnershipResultSessionTooFresh) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "retry_after":
+			entity.RetryAfter, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCanTransferOwnershipResultSessionTooFresh)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// New message was received
+type NotificationTypeNewMessage struct {
+	meta
+	// The message
+	Message *Message `json:"message"`
+	// True, if message content must be displayed in notifications
+	ShowPreview bool `json:"show_preview"`
+}
+
+func (*NotificationTypeNewMessage) GetType() string {
+	return TypeNotificationType
+}
+
+func (*NotificationTypeNewMessage) GetConstructor() string {
+	return ConstructorNotificationTypeNewMessage
+}
+
+func (*NotificationTypeNewMessage) NotificationTypeConstructor() string {
+	return ConstructorNotificationTypeNewMessage
+}
+
+func (entity *NotificationTypeNewMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationTypeNewMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationTypeNewMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationTypeNewMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw(`,"show_preview":`)
+	encoder.writeBool(entity.ShowPreview)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationTypeNewMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		case "show_preview":
+			entity.ShowPreview, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNotificationTypeNewMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *NotificationTypeNewMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// New secret chat was created
+type NotificationTypeNewSecretChat struct {
+	meta
+}
+
+func (*NotificationTypeNewSecretChat) GetType() string {
+	return TypeNotificationType
+}
+
+func (*NotificationTypeNewSecretChat) GetConstructor() string {
+	return ConstructorNotificationTypeNewSecretChat
+}
+
+func (*NotificationTypeNewSecretChat) NotificationTypeConstructor() string {
+	return ConstructorNotificationTypeNewSecretChat
+}
+
+func (entity *NotificationTypeNewSecretChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationTypeNewSecretChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationTypeNewSecretChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationTypeNewSecretChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationTypeNewSecretChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNotificationTypeNewSecretChat)
+	})
+}
+
+// New call was received
+type NotificationTypeNewCall struct {
+	meta
+	// Call identifier
+	CallId int32 `json:"call_id"`
+}
+
+func (*NotificationTypeNewCall) GetType() string {
+	return TypeNotificationType
+}
+
+func (*NotificationTypeNewCall) GetConstructor() string {
+	return ConstructorNotificationTypeNewCall
+}
+
+func (*NotificationTypeNewCall) NotificationTypeConstructor() string {
+	return ConstructorNotificationTypeNewCall
+}
+
+func (entity *NotificationTypeNewCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationTypeNewCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationTypeNewCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationTypeNewCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(entity.CallId)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationTypeNewCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "call_id":
+			entity.CallId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNotificationTypeNewCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// New message was received through a push notification
+type NotificationTypeNewPushMessage struct {
+	meta
+	// The message identifier. The message will not be available in the chat history, but the identifier can be used in viewMessages, or as a message to be replied in the same chat
+	MessageId int64 `json:"message_id"`
+	// Identifier of the sender of the message. Corresponding user or chat may be inaccessible
+	SenderId MessageSender `json:"sender_id"`
+	// Name of the sender
+	SenderName string `json:"sender_name"`
+	// True, if the message is outgoing
+	IsOutgoing bool `json:"is_outgoing"`
+	// Push message content
+	Content PushMessageContent `json:"content"`
+}
+
+func (*NotificationTypeNewPushMessage) GetType() string {
+	return TypeNotificationType
+}
+
+func (*NotificationTypeNewPushMessage) GetConstructor() string {
+	return ConstructorNotificationTypeNewPushMessage
+}
+
+func (*NotificationTypeNewPushMessage) NotificationTypeConstructor() string {
+	return ConstructorNotificationTypeNewPushMessage
+}
+
+func (entity *NotificationTypeNewPushMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationTypeNewPushMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationTypeNewPushMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationTypeNewPushMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"sender_name":`)
+	encoder.writeString(entity.SenderName)
+	encoder.writeRaw(`,"is_outgoing":`)
+	encoder.writeBool(entity.IsOutgoing)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(entity.Content)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationTypeNewPushMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "sender_name":
+			entity.SenderName, err = decoder.readString()
+
+		case "is_outgoing":
+			entity.IsOutgoing, err = decoder.readBool()
+
+		case "content":
+			entity.Content, err = decodePushMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNotificationTypeNewPushMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a notification sound in MP3 format
+type NotificationSound struct {
+	meta
+	// Unique identifier of the notification sound
+	Id JsonInt64 `json:"id"`
+	// Duration of the sound, in seconds
+	Duration int32 `json:"duration"`
+	// Point in time (Unix timestamp) when the sound was created
+	Date int32 `json:"date"`
+	// Title of the notification sound
+	Title string `json:"title"`
+	// Arbitrary data, defined while the sound was uploaded
+	Data string `json:"data"`
+	// File containing the sound
+	Sound *File `json:"sound"`
+}
+
+func (*NotificationSound) GetType() string {
+	return TypeNotificationSound
+}
+
+func (*NotificationSound) GetConstructor() string {
+	return ConstructorNotificationSound
+}
+
+func (entity *NotificationSound) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationSound) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationSound) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationSound"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(entity.Data)
+	encoder.writeRaw(`,"sound":`)
+	entity.Sound.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationSound) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "data":
+			entity.Data, err = decoder.readString()
+
+		case "sound":
+			entity.Sound, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNotificationSound)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of notification sounds
+type NotificationSounds struct {
+	meta
+	// A list of notification sounds
+	NotificationSounds []*NotificationSound `json:"notification_sounds"`
+}
+
+func (*NotificationSounds) GetType() string {
+	return TypeNotificationSounds
+}
+
+func (*NotificationSounds) GetConstructor() string {
+	return ConstructorNotificationSounds
+}
+
+func (entity *NotificationSounds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NotificationSounds) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NotificationSounds) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notificationSounds"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"notification_sounds":`)
+	writeList(encoder, entity.NotificationSounds, writeTypeValue[*NotificationSound])
+	encoder.writeRaw("}")
+}
+
+func (entity *NotificationSounds) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "notification_sounds":
+			entity.NotificationSounds, err = readList(decoder, decodeEntity[NotificationSound])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNotificationSounds)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a notification
+type Notification struct {
+	meta
+	// Unique persistent identifier of this notification
+	Id int32 `json:"id"`
+	// Notification date
+	Date int32 `json:"date"`
+	// True, if the notification was explicitly sent without sound
+	IsSilent bool `json:"is_silent"`
+	// Notification type
+	Type NotificationType `json:"type"`
+}
+
+func (*Notification) GetType() string {
+	return TypeNotification
+}
+
+func (*Notification) GetConstructor() string {
+	return ConstructorNotification
+}
+
+func (entity *Notification) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Notification) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Notification) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"notification"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"is_silent":`)
+	encoder.writeBool(entity.IsSilent)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *Notification) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "is_silent":
+			entity.IsSilent, err = decoder.readBool()
+
+		case "type":
+			entity.Type, err = decodeNotificationType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNotification)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Notification) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("type", entity.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a boolean option
+type OptionValueBoolean struct {
+	meta
+	// The value of the option
+	Value bool `json:"value"`
+}
+
+func (*OptionValueBoolean) GetType() string {
+	return TypeOptionValue
+}
+
+func (*OptionValueBoolean) GetConstructor() string {
+	return ConstructorOptionValueBoolean
+}
+
+func (*OptionValueBoolean) OptionValueConstructor() string {
+	return ConstructorOptionValueBoolean
+}
+
+func (entity *OptionValueBoolean) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *OptionValueBoolean) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *OptionValueBoolean) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"optionValueBoolean"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeBool(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *OptionValueBoolean) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorOptionValueBoolean)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an unknown option or an option which has a default value
+type OptionValueEmpty struct {
+	meta
+}
+
+func (*OptionValueEmpty) GetType() string {
+	return TypeOptionValue
+}
+
+func (*OptionValueEmpty) GetConstructor() string {
+	return ConstructorOptionValueEmpty
+}
+
+func (*OptionValueEmpty) OptionValueConstructor() string {
+	return ConstructorOptionValueEmpty
+}
+
+func (entity *OptionValueEmpty) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *OptionValueEmpty) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *OptionValueEmpty) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"optionValueEmpty"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *OptionValueEmpty) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorOptionValueEmpty)
+	})
+}
+
+// Represents an integer option
+type OptionValueInteger struct {
+	meta
+	// The value of the option
+	Value JsonInt64 `json:"value"`
+}
+
+func (*OptionValueInteger) GetType() string {
+	return TypeOptionValue
+}
+
+func (*OptionValueInteger) GetConstructor() string {
+	return ConstructorOptionValueInteger
+}
+
+func (*OptionValueInteger) OptionValueConstructor() string {
+	return ConstructorOptionValueInteger
+}
+
+func (entity *OptionValueInteger) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *OptionValueInteger) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *OptionValueInteger) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"optionValueInteger"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeJsonInt64(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *OptionValueInteger) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorOptionValueInteger)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a string option
+type OptionValueString struct {
+	meta
+	// The value of the option
+	Value string `json:"value"`
+}
+
+func (*OptionValueString) GetType() string {
+	return TypeOptionValue
+}
+
+func (*OptionValueString) GetConstructor() string {
+	return ConstructorOptionValueString
+}
+
+func (*OptionValueString) OptionValueConstructor() string {
+	return ConstructorOptionValueString
+}
+
+func (entity *OptionValueString) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *OptionValueString) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *OptionValueString) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"optionValueString"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeString(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *OptionValueString) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorOptionValueString)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents one member of a JSON object
+type JsonObjectMember struct {
+	meta
+	// Member's key
+	Key string `json:"key"`
+	// Member's value
+	Value JsonValue `json:"value"`
+}
+
+func (*JsonObjectMember) GetType() string {
+	return TypeJsonObjectMember
+}
+
+func (*JsonObjectMember) GetConstructor() string {
+	return ConstructorJsonObjectMember
+}
+
+func (entity *JsonObjectMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *JsonObjectMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *JsonObjectMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"jsonObjectMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"key":`)
+	encoder.writeString(entity.Key)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeType(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *JsonObjectMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "key":
+			entity.Key, err = decoder.readString()
+
+		case "value":
+			entity.Value, err = decodeJsonValue(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorJsonObjectMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a null JSON value
+type JsonValueNull struct {
+	meta
+}
+
+func (*JsonValueNull) GetType() string {
+	return TypeJsonValue
+}
+
+func (*JsonValueNull) GetConstructor() string {
+	return ConstructorJsonValueNull
+}
+
+func (*JsonValueNull) JsonValueConstructor() string {
+	return ConstructorJsonValueNull
+}
+
+func (entity *JsonValueNull) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *JsonValueNull) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *JsonValueNull) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"jsonValueNull"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *JsonValueNull) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorJsonValueNull)
+	})
+}
+
+// Represents a boolean JSON value
+type JsonValueBoolean struct {
+	meta
+	// The value
+	Value bool `json:"value"`
+}
+
+func (*JsonValueBoolean) GetType() string {
+	return TypeJsonValue
+}
+
+func (*JsonValueBoolean) GetConstructor() string {
+	return ConstructorJsonValueBoolean
+}
+
+func (*JsonValueBoolean) JsonValueConstructor() string {
+	return ConstructorJsonValueBoolean
+}
+
+func (entity *JsonValueBoolean) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *JsonValueBoolean) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *JsonValueBoolean) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"jsonValueBoolean"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeBool(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *JsonValueBoolean) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorJsonValueBoolean)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a numeric JSON value
+type JsonValueNumber struct {
+	meta
+	// The value
+	Value float64 `json:"value"`
+}
+
+func (*JsonValueNumber) GetType() string {
+	return TypeJsonValue
+}
+
+func (*JsonValueNumber) GetConstructor() string {
+	return ConstructorJsonValueNumber
+}
+
+func (*JsonValueNumber) JsonValueConstructor() string {
+	return ConstructorJsonValueNumber
+}
+
+func (entity *JsonValueNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *JsonValueNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *JsonValueNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"jsonValueNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeFloat64(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *JsonValueNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorJsonValueNumber)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a string JSON value
+type JsonValueString struct {
+	meta
+	// The value
+	Value string `json:"value"`
+}
+
+func (*JsonValueString) GetType() string {
+	return TypeJsonValue
+}
+
+func (*JsonValueString) GetConstructor() string {
+	return ConstructorJsonValueString
+}
+
+func (*JsonValueString) JsonValueConstructor() string {
+	return ConstructorJsonValueString
+}
+
+func (entity *JsonValueString) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *JsonValueString) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *JsonValueString) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"jsonValueString"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeString(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *JsonValueString) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorJsonValueString)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a JSON array
+type JsonValueArray struct {
+	meta
+	// The list of array elements
+	Values []JsonValue `json:"values"`
+}
+
+func (*JsonValueArray) GetType() string {
+	return TypeJsonValue
+}
+
+func (*JsonValueArray) GetConstructor() string {
+	return ConstructorJsonValueArray
+}
+
+func (*JsonValueArray) JsonValueConstructor() string {
+	return ConstructorJsonValueArray
+}
+
+func (entity *JsonValueArray) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *JsonValueArray) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *JsonValueArray) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"jsonValueArray"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"values":`)
+	writeList(encoder, entity.Values, writeTypeValue[JsonValue])
+	encoder.writeRaw("}")
+}
+
+func (entity *JsonValueArray) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "values":
+			entity.Values, err = readList(decoder, decodeJsonValue)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorJsonValueArray)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a JSON object
+type JsonValueObject struct {
+	meta
+	// The list of object members
+	Members []*JsonObjectMember `json:"members"`
+}
+
+func (*JsonValueObject) GetType() string {
+	return TypeJsonValue
+}
+
+func (*JsonValueObject) GetConstructor() string {
+	return ConstructorJsonValueObject
+}
+
+func (*JsonValueObject) JsonValueConstructor() string {
+	return ConstructorJsonValueObject
+}
+
+func (entity *JsonValueObject) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *JsonValueObject) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *JsonValueObject) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"jsonValueObject"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"members":`)
+	writeList(encoder, entity.Members, writeTypeValue[*JsonObjectMember])
+	encoder.writeRaw("}")
+}
+
+func (entity *JsonValueObject) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "members":
+			entity.Members, err = readList(decoder, decodeEntity[JsonObjectMember])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorJsonValueObject)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about the period of inactivity after which the current user's account will automatically be deleted
+type AccountTtl struct {
+	meta
+	// Number of days of inactivity before the account will be flagged for deletion; 30-730 days
+	Days int32 `json:"days"`
+}
+
+func (*AccountTtl) GetType() string {
+	return TypeAccountTtl
+}
+
+func (*AccountTtl) GetConstructor() string {
+	return ConstructorAccountTtl
+}
+
+func (entity *AccountTtl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AccountTtl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AccountTtl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"accountTtl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"days":`)
+	encoder.writeInt32(entity.Days)
+	encoder.writeRaw("}")
+}
+
+func (entity *AccountTtl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "days":
+			entity.Days, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAccountTtl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about one website the current user is logged in with Telegram
+type ConnectedWebsite struct {
+	meta
+	// Website identifier
+	Id JsonInt64 `json:"id"`
+	// The domain name of the website
+	DomainName string `json:"domain_name"`
+	// User identifier of a bot linked with the website
+	BotUserId int64 `json:"bot_user_id"`
+	// The version of a browser used to log in
+	Browser string `json:"browser"`
+	// Operating system the browser is running on
+	Platform string `json:"platform"`
+	// Point in time (Unix timestamp) when the user was logged in
+	LogInDate int32 `json:"log_in_date"`
+	// Point in time (Unix timestamp) when obtained authorization was last used
+	LastActiveDate int32 `json:"last_active_date"`
+	// IP address from which the user was logged in, in human-readable format
+	IpAddress string `json:"ip_address"`
+	// Human-readable description of a country and a region from which the user was logged in, based on the IP address
+	Location string `json:"location"`
+}
+
+func (*ConnectedWebsite) GetType() string {
+	return TypeConnectedWebsite
+}
+
+func (*ConnectedWebsite) GetConstructor() string {
+	return ConstructorConnectedWebsite
+}
+
+func (entity *ConnectedWebsite) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectedWebsite) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectedWebsite) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectedWebsite"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"domain_name":`)
+	encoder.writeString(entity.DomainName)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"browser":`)
+	encoder.writeString(entity.Browser)
+	encoder.writeRaw(`,"platform":`)
+	encoder.writeString(entity.Platform)
+	encoder.writeRaw(`,"log_in_date":`)
+	encoder.writeInt32(entity.LogInDate)
+	encoder.writeRaw(`,"last_active_date":`)
+	encoder.writeInt32(entity.LastActiveDate)
+	encoder.writeRaw(`,"ip_address":`)
+	encoder.writeString(entity.IpAddress)
+	encoder.writeRaw(`,"location":`)
+	encoder.writeString(entity.Location)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectedWebsite) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "domain_name":
+			entity.DomainName, err = decoder.readString()
+
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "browser":
+			entity.Browser, err = decoder.readString()
+
+		case "platform":
+			entity.Platform, err = decoder.readString()
+
+		case "log_in_date":
+			entity.LogInDate, err = decoder.readInt32()
+
+		case "last_active_date":
+			entity.LastActiveDate, err = decoder.readInt32()
+
+		case "ip_address":
+			entity.IpAddress, err = decoder.readString()
+
+		case "location":
+			entity.Location, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorConnectedWebsite)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of websites the current user is logged in with Telegram
+type ConnectedWebsites struct {
+	meta
+	// List of connected websites
+	Websites []*ConnectedWebsite `json:"websites"`
+}
+
+func (*ConnectedWebsites) GetType() string {
+	return TypeConnectedWebsites
+}
+
+func (*ConnectedWebsites) GetConstructor() string {
+	return ConstructorConnectedWebsites
+}
+
+func (entity *ConnectedWebsites) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectedWebsites) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectedWebsites) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectedWebsites"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"websites":`)
+	writeList(encoder, entity.Websites, writeTypeValue[*ConnectedWebsite])
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectedWebsites) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "websites":
+			entity.Websites, err = readList(decoder, decodeEntity[ConnectedWebsite])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorConnectedWebsites)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat contains spam messages
+type ReportReasonSpam struct {
+	meta
+}
+
+func (*ReportReasonSpam) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonSpam) GetConstructor() string {
+	return ConstructorReportReasonSpam
+}
+
+func (*ReportReasonSpam) ReportReasonConstructor() string {
+	return ConstructorReportReasonSpam
+}
+
+func (entity *ReportReasonSpam) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonSpam) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonSpam) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonSpam"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonSpam) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonSpam)
+	})
+}
+
+// The chat promotes violence
+type ReportReasonViolence struct {
+	meta
+}
+
+func (*ReportReasonViolence) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonViolence) GetConstructor() string {
+	return ConstructorReportReasonViolence
+}
+
+func (*ReportReasonViolence) ReportReasonConstructor() string {
+	return ConstructorReportReasonViolence
+}
+
+func (entity *ReportReasonViolence) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonViolence) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonViolence) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonViolence"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonViolence) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonViolence)
+	})
+}
+
+// The chat contains pornographic messages
+type ReportReasonPornography struct {
+	meta
+}
+
+func (*ReportReasonPornography) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonPornography) GetConstructor() string {
+	return ConstructorReportReasonPornography
+}
+
+func (*ReportReasonPornography) ReportReasonConstructor() string {
+	return ConstructorReportReasonPornography
+}
+
+func (entity *ReportReasonPornography) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonPornography) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonPornography) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonPornography"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonPornography) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonPornography)
+	})
+}
+
+// The chat has child abuse related content
+type ReportReasonChildAbuse struct {
+	meta
+}
+
+func (*ReportReasonChildAbuse) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonChildAbuse) GetConstructor() string {
+	return ConstructorReportReasonChildAbuse
+}
+
+func (*ReportReasonChildAbuse) ReportReasonConstructor() string {
+	return ConstructorReportReasonChildAbuse
+}
+
+func (entity *ReportReasonChildAbuse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonChildAbuse) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonChildAbuse) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonChildAbuse"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonChildAbuse) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonChildAbuse)
+	})
+}
+
+// The chat contains copyrighted content
+type ReportReasonCopyright struct {
+	meta
+}
+
+func (*ReportReasonCopyright) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonCopyright) GetConstructor() string {
+	return ConstructorReportReasonCopyright
+}
+
+func (*ReportReasonCopyright) ReportReasonConstructor() string {
+	return ConstructorReportReasonCopyright
+}
+
+func (entity *ReportReasonCopyright) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonCopyright) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonCopyright) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonCopyright"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonCopyright) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonCopyright)
+	})
+}
+
+// The location-based chat is unrelated to its stated location
+type ReportReasonUnrelatedLocation struct {
+	meta
+}
+
+func (*ReportReasonUnrelatedLocation) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonUnrelatedLocation) GetConstructor() string {
+	return ConstructorReportReasonUnrelatedLocation
+}
+
+func (*ReportReasonUnrelatedLocation) ReportReasonConstructor() string {
+	return ConstructorReportReasonUnrelatedLocation
+}
+
+func (entity *ReportReasonUnrelatedLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonUnrelatedLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonUnrelatedLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonUnrelatedLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonUnrelatedLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonUnrelatedLocation)
+	})
+}
+
+// The chat represents a fake account
+type ReportReasonFake struct {
+	meta
+}
+
+func (*ReportReasonFake) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonFake) GetConstructor() string {
+	return ConstructorReportReasonFake
+}
+
+func (*ReportReasonFake) ReportReasonConstructor() string {
+	return ConstructorReportReasonFake
+}
+
+func (entity *ReportReasonFake) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonFake) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonFake) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonFake"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonFake) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonFake)
+	})
+}
+
+// The chat has illegal drugs related content
+type ReportReasonIllegalDrugs struct {
+	meta
+}
+
+func (*ReportReasonIllegalDrugs) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonIllegalDrugs) GetConstructor() string {
+	return ConstructorReportReasonIllegalDrugs
+}
+
+func (*ReportReasonIllegalDrugs) ReportReasonConstructor() string {
+	return ConstructorReportReasonIllegalDrugs
+}
+
+func (entity *ReportReasonIllegalDrugs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonIllegalDrugs) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonIllegalDrugs) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonIllegalDrugs"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonIllegalDrugs) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonIllegalDrugs)
+	})
+}
+
+// The chat contains messages with personal details
+type ReportReasonPersonalDetails struct {
+	meta
+}
+
+func (*ReportReasonPersonalDetails) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonPersonalDetails) GetConstructor() string {
+	return ConstructorReportReasonPersonalDetails
+}
+
+func (*ReportReasonPersonalDetails) ReportReasonConstructor() string {
+	return ConstructorReportReasonPersonalDetails
+}
+
+func (entity *ReportReasonPersonalDetails) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonPersonalDetails) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonPersonalDetails) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonPersonalDetails"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonPersonalDetails) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonPersonalDetails)
+	})
+}
+
+// A custom reason provided by the user
+type ReportReasonCustom struct {
+	meta
+}
+
+func (*ReportReasonCustom) GetType() string {
+	return TypeReportReason
+}
+
+func (*ReportReasonCustom) GetConstructor() string {
+	return ConstructorReportReasonCustom
+}
+
+func (*ReportReasonCustom) ReportReasonConstructor() string {
+	return ConstructorReportReasonCustom
+}
+
+func (entity *ReportReasonCustom) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportReasonCustom) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportReasonCustom) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportReasonCustom"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportReasonCustom) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportReasonCustom)
+	})
+}
+
+// The link is a link to the Devices section of the application. Use getActiveSessions to get the list of active sessions and show them to the user
+type InternalLinkTypeActiveSessions struct {
+	meta
+}
+
+func (*InternalLinkTypeActiveSessions) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeActiveSessions) GetConstructor() string {
+	return ConstructorInternalLinkTypeActiveSessions
+}
+
+func (*InternalLinkTypeActiveSessions) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeActiveSessions
+}
+
+func (entity *InternalLinkTypeActiveSessions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeActiveSessions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeActiveSessions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeActiveSessions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeActiveSessions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeActiveSessions)
+	})
+}
+
+// The link is a link to an attachment menu bot to be opened in the specified or a chosen chat. Process given target_chat to open the chat. Then, call searchPublicChat with the given bot username, check that the user is a bot and can be added to attachment menu. Then, use getAttachmentMenuBot to receive information about the bot. If the bot isn't added to attachment menu, then show a disclaimer about Mini Apps being third-party applications, ask the user to accept their Terms of service and confirm adding the bot to side and attachment menu. If the user accept the terms and confirms adding, then use toggleBotIsAddedToAttachmentMenu to add the bot. If the attachment menu bot can't be used in the opened chat, show an error to the user. If the bot is added to attachment menu and can be used in the chat, then use openWebApp with the given URL
+type InternalLinkTypeAttachmentMenuBot struct {
+	meta
+	// Target chat to be opened
+	TargetChat TargetChat `json:"target_chat"`
+	// Username of the bot
+	BotUsername string `json:"bot_username"`
+	// URL to be passed to openWebApp
+	Url string `json:"url"`
+}
+
+func (*InternalLinkTypeAttachmentMenuBot) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeAttachmentMenuBot) GetConstructor() string {
+	return ConstructorInternalLinkTypeAttachmentMenuBot
+}
+
+func (*InternalLinkTypeAttachmentMenuBot) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeAttachmentMenuBot
+}
+
+func (entity *InternalLinkTypeAttachmentMenuBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeAttachmentMenuBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeAttachmentMenuBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeAttachmentMenuBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"target_chat":`)
+	encoder.writeType(entity.TargetChat)
+	encoder.writeRaw(`,"bot_username":`)
+	encoder.writeString(entity.BotUsername)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeAttachmentMenuBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "target_chat":
+			entity.TargetChat, err = decodeTargetChat(decoder)
+
+		case "bot_username":
+			entity.BotUsername, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeAttachmentMenuBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link contains an authentication code. Call checkAuthenticationCode with the code if the current authorization state is authorizationStateWaitCode
+type InternalLinkTypeAuthenticationCode struct {
+	meta
+	// The authentication code
+	Code string `json:"code"`
+}
+
+func (*InternalLinkTypeAuthenticationCode) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeAuthenticationCode) GetConstructor() string {
+	return ConstructorInternalLinkTypeAuthenticationCode
+}
+
+func (*InternalLinkTypeAuthenticationCode) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeAuthenticationCode
+}
+
+func (entity *InternalLinkTypeAuthenticationCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeAuthenticationCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeAuthenticationCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeAuthenticationCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(entity.Code)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeAuthenticationCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "code":
+			entity.Code, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeAuthenticationCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a background. Call searchBackground with the given background name to process the link. If background is found and the user wants to apply it, then call setDefaultBackground
+type InternalLinkTypeBackground struct {
+	meta
+	// Name of the background
+	BackgroundName string `json:"background_name"`
+}
+
+func (*InternalLinkTypeBackground) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeBackground) GetConstructor() string {
+	return ConstructorInternalLinkTypeBackground
+}
+
+func (*InternalLinkTypeBackground) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeBackground
+}
+
+func (entity *InternalLinkTypeBackground) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeBackground) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeBackground) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeBackground"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"background_name":`)
+	encoder.writeString(entity.BackgroundName)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeBackground) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "background_name":
+			entity.BackgroundName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a Telegram bot, which is expected to be added to a channel chat as an administrator. Call searchPublicChat with the given bot username and check that the user is a bot, ask the current user to select a channel chat to add the bot to as an administrator. Then, call getChatMember to receive the current bot rights in the chat and if the bot already is an administrator, check that the current user can edit its administrator rights and combine received rights with the requested administrator rights. Then, show confirmation box to the user, and call setChatMemberStatus with the chosen chat and confirmed rights
+type InternalLinkTypeBotAddToChannel struct {
+	meta
+	// Username of the bot
+	BotUsername string `json:"bot_username"`
+	// Expected administrator rights for the bot
+	AdministratorRights *ChatAdministratorRights `json:"administrator_rights"`
+}
+
+func (*InternalLinkTypeBotAddToChannel) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeBotAddToChannel) GetConstructor() string {
+	return ConstructorInternalLinkTypeBotAddToChannel
+}
+
+func (*InternalLinkTypeBotAddToChannel) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeBotAddToChannel
+}
+
+func (entity *InternalLinkTypeBotAddToChannel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeBotAddToChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeBotAddToChannel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeBotAddToChannel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_username":`)
+	encoder.writeString(entity.BotUsername)
+	encoder.writeRaw(`,"administrator_rights":`)
+	entity.AdministratorRights.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeBotAddToChannel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_username":
+			entity.BotUsername, err = decoder.readString()
+
+		case "administrator_rights":
+			entity.AdministratorRights, err = decodeEntity[ChatAdministratorRights](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeBotAddToChannel)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a chat with a Telegram bot. Call searchPublicChat with the given bot username, check that the user is a bot, show START button in the chat with the bot, and then call sendBotStartMessage with the given start parameter after the button is pressed
+type InternalLinkTypeBotStart struct {
+	meta
+	// Username of the bot
+	BotUsername string `json:"bot_username"`
+	// The parameter to be passed to sendBotStartMessage
+	StartParameter string `json:"start_parameter"`
+	// True, if sendBotStartMessage must be called automatically without showing the START button
+	Autostart bool `json:"autostart"`
+}
+
+func (*InternalLinkTypeBotStart) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeBotStart) GetConstructor() string {
+	return ConstructorInternalLinkTypeBotStart
+}
+
+func (*InternalLinkTypeBotStart) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeBotStart
+}
+
+func (entity *InternalLinkTypeBotStart) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeBotStart) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeBotStart) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeBotStart"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_username":`)
+	encoder.writeString(entity.BotUsername)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(entity.StartParameter)
+	encoder.writeRaw(`,"autostart":`)
+	encoder.writeBool(entity.Autostart)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeBotStart) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_username":
+			entity.BotUsername, err = decoder.readString()
+
+		case "start_parameter":
+			entity.StartParameter, err = decoder.readString()
+
+		case "autostart":
+			entity.Autostart, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeBotStart)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a Telegram bot, which is expected to be added to a group chat. Call searchPublicChat with the given bot username, check that the user is a bot and can be added to groups, ask the current user to select a basic group or a supergroup chat to add the bot to, taking into account that bots can be added to a public supergroup only by administrators of the supergroup. If administrator rights are provided by the link, call getChatMember to receive the current bot rights in the chat and if the bot already is an administrator, check that the current user can edit its administrator rights, combine received rights with the requested administrator rights, show confirmation box to the user, and call setChatMemberStatus with the chosen chat and confirmed administrator rights. Before call to setChatMemberStatus it may be required to upgrade the chosen basic group chat to a supergroup chat. Then, if start_parameter isn't empty, call sendBotStartMessage with the given start parameter and the chosen chat; otherwise, just send /start message with bot's username added to the chat
+type InternalLinkTypeBotStartInGroup struct {
+	meta
+	// Username of the bot
+	BotUsername string `json:"bot_username"`
+	// The parameter to be passed to sendBotStartMessage
+	StartParameter string `json:"start_parameter"`
+	// Expected administrator rights for the bot; may be null
+	AdministratorRights *ChatAdministratorRights `json:"administrator_rights,omitempty"`
+}
+
+func (*InternalLinkTypeBotStartInGroup) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeBotStartInGroup) GetConstructor() string {
+	return ConstructorInternalLinkTypeBotStartInGroup
+}
+
+func (*InternalLinkTypeBotStartInGroup) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeBotStartInGroup
+}
+
+func (entity *InternalLinkTypeBotStartInGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeBotStartInGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeBotStartInGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeBotStartInGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_username":`)
+	encoder.writeString(entity.BotUsername)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(entity.StartParameter)
+	if entity.AdministratorRights != nil {
+		encoder.writeRaw(`,"administrator_rights":`)
+		entity.AdministratorRights.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeBotStartInGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_username":
+			entity.BotUsername, err = decoder.readString()
+
+		case "start_parameter":
+			entity.StartParameter, err = decoder.readString()
+
+		case "administrator_rights":
+			entity.AdministratorRights, err = decodeEntity[ChatAdministratorRights](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeBotStartInGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a business chat. Use getBusinessChatLinkInfo with the provided link name to get information about the link, then open received private chat and replace chat draft with the provided text
+type InternalLinkTypeBusinessChat struct {
+	meta
+	// Name of the link
+	LinkName string `json:"link_name"`
+}
+
+func (*InternalLinkTypeBusinessChat) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeBusinessChat) GetConstructor() string {
+	return ConstructorInternalLinkTypeBusinessChat
+}
+
+func (*InternalLinkTypeBusinessChat) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeBusinessChat
+}
+
+func (entity *InternalLinkTypeBusinessChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeBusinessChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeBusinessChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeBusinessChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"link_name":`)
+	encoder.writeString(entity.LinkName)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeBusinessChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "link_name":
+			entity.LinkName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeBusinessChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the Telegram Star purchase section of the application
+type InternalLinkTypeBuyStars struct {
+	meta
+	// The number of Telegram Stars that must be owned by the user
+	StarCount int64 `json:"star_count"`
+	// Purpose of Telegram Star purchase. Arbitrary string specified by the server, for example, "subs" if the Telegram Stars are required to extend channel subscriptions
+	Purpose string `json:"purpose"`
+}
+
+func (*InternalLinkTypeBuyStars) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeBuyStars) GetConstructor() string {
+	return ConstructorInternalLinkTypeBuyStars
+}
+
+func (*InternalLinkTypeBuyStars) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeBuyStars
+}
+
+func (entity *InternalLinkTypeBuyStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeBuyStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeBuyStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeBuyStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"purpose":`)
+	encoder.writeString(entity.Purpose)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeBuyStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "purpose":
+			entity.Purpose, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeBuyStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the change phone number section of the application
+type InternalLinkTypeChangePhoneNumber struct {
+	meta
+}
+
+func (*InternalLinkTypeChangePhoneNumber) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeChangePhoneNumber) GetConstructor() string {
+	return ConstructorInternalLinkTypeChangePhoneNumber
+}
+
+func (*InternalLinkTypeChangePhoneNumber) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeChangePhoneNumber
+}
+
+func (entity *InternalLinkTypeChangePhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeChangePhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeChangePhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeChangePhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeChangePhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeChangePhoneNumber)
+	})
+}
+
+// The link is an affiliate program link. Call searchChatAffiliateProgram with the given username and referrer to process the link
+type InternalLinkTypeChatAffiliateProgram struct {
+	meta
+	// Username to be passed to searchChatAffiliateProgram
+	Username string `json:"username"`
+	// Referrer to be passed to searchChatAffiliateProgram
+	Referrer string `json:"referrer"`
+}
+
+func (*InternalLinkTypeChatAffiliateProgram) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeChatAffiliateProgram) GetConstructor() string {
+	return ConstructorInternalLinkTypeChatAffiliateProgram
+}
+
+func (*InternalLinkTypeChatAffiliateProgram) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeChatAffiliateProgram
+}
+
+func (entity *InternalLinkTypeChatAffiliateProgram) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeChatAffiliateProgram) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeChatAffiliateProgram) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeChatAffiliateProgram"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	encoder.writeRaw(`,"referrer":`)
+	encoder.writeString(entity.Referrer)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeChatAffiliateProgram) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		case "referrer":
+			entity.Referrer, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeChatAffiliateProgram)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to boost a Telegram chat. Call getChatBoostLinkInfo with the given URL to process the link. If the chat is found, then call getChatBoostStatus and getAvailableChatBoostSlots to get the current boost status and check whether the chat can be boosted. If the user wants to boost the chat and the chat can be boosted, then call boostChat
+type InternalLinkTypeChatBoost struct {
+	meta
+	// URL to be passed to getChatBoostLinkInfo
+	Url string `json:"url"`
+}
+
+func (*InternalLinkTypeChatBoost) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeChatBoost) GetConstructor() string {
+	return ConstructorInternalLinkTypeChatBoost
+}
+
+func (*InternalLinkTypeChatBoost) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeChatBoost
+}
+
+func (entity *InternalLinkTypeChatBoost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeChatBoost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeChatBoost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeChatBoost"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeChatBoost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeChatBoost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is an invite link to a chat folder. Call checkChatFolderInviteLink with the given invite link to process the link. If the link is valid and the user wants to join the chat folder, then call addChatFolderByInviteLink
+type InternalLinkTypeChatFolderInvite struct {
+	meta
+	// Internal representation of the invite link
+	InviteLink string `json:"invite_link"`
+}
+
+func (*InternalLinkTypeChatFolderInvite) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeChatFolderInvite) GetConstructor() string {
+	return ConstructorInternalLinkTypeChatFolderInvite
+}
+
+func (*InternalLinkTypeChatFolderInvite) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeChatFolderInvite
+}
+
+func (entity *InternalLinkTypeChatFolderInvite) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeChatFolderInvite) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeChatFolderInvite) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeChatFolderInvite"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(entity.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeChatFolderInvite) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeChatFolderInvite)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the folder section of the application settings
+type InternalLinkTypeChatFolderSettings struct {
+	meta
+}
+
+func (*InternalLinkTypeChatFolderSettings) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeChatFolderSettings) GetConstructor() string {
+	return ConstructorInternalLinkTypeChatFolderSettings
+}
+
+func (*InternalLinkTypeChatFolderSettings) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeChatFolderSettings
+}
+
+func (entity *InternalLinkTypeChatFolderSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeChatFolderSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeChatFolderSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeChatFolderSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeChatFolderSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeChatFolderSettings)
+	})
+}
+
+// The link is a chat invite link. Call checkChatInviteLink with the given invite link to process the link. If the link is valid and the user wants to join the chat, then call joinChatByInviteLink
+type InternalLinkTypeChatInvite struct {
+	meta
+	// Internal representation of the invite link
+	InviteLink string `json:"invite_link"`
+}
+
+func (*InternalLinkTypeChatInvite) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeChatInvite) GetConstructor() string {
+	return ConstructorInternalLinkTypeChatInvite
+}
+
+func (*InternalLinkTypeChatInvite) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeChatInvite
+}
+
+func (entity *InternalLinkTypeChatInvite) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeChatInvite) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeChatInvite) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeChatInvite"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(entity.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeChatInvite) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeChatInvite)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the default message auto-delete timer settings section of the application settings
+type InternalLinkTypeDefaultMessageAutoDeleteTimerSettings struct {
+	meta
+}
+
+func (*InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) GetConstructor() string {
+	return ConstructorInternalLinkTypeDefaultMessageAutoDeleteTimerSettings
+}
+
+func (*InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeDefaultMessageAutoDeleteTimerSettings
+}
+
+func (entity *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeDefaultMessageAutoDeleteTimerSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeDefaultMessageAutoDeleteTimerSettings)
+	})
+}
+
+// The link is a link to the edit profile section of the application settings
+type InternalLinkTypeEditProfileSettings struct {
+	meta
+}
+
+func (*InternalLinkTypeEditProfileSettings) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeEditProfileSettings) GetConstructor() string {
+	return ConstructorInternalLinkTypeEditProfileSettings
+}
+
+func (*InternalLinkTypeEditProfileSettings) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeEditProfileSettings
+}
+
+func (entity *InternalLinkTypeEditProfileSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeEditProfileSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeEditProfileSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeEditProfileSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeEditProfileSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeEditProfileSettings)
+	})
+}
+
+// The link is a link to a game. Call searchPublicChat with the given bot username, check that the user is a bot, ask the current user to select a chat to send the game, and then call sendMessage with inputMessageGame
+type InternalLinkTypeGame struct {
+	meta
+	// Username of the bot that owns the game
+	BotUsername string `json:"bot_username"`
+	// Short name of the game
+	GameShortName string `json:"game_short_name"`
+}
+
+func (*InternalLinkTypeGame) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeGame) GetConstructor() string {
+	return ConstructorInternalLinkTypeGame
+}
+
+func (*InternalLinkTypeGame) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeGame
+}
+
+func (entity *InternalLinkTypeGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_username":`)
+	encoder.writeString(entity.BotUsername)
+	encoder.writeRaw(`,"game_short_name":`)
+	encoder.writeString(entity.GameShortName)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_username":
+			entity.BotUsername, err = decoder.readString()
+
+		case "game_short_name":
+			entity.GameShortName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link must be opened in an Instant View. Call getWebPageInstantView with the given URL to process the link. If Instant View is found, then show it, otherwise, open the fallback URL in an external browser
+type InternalLinkTypeInstantView struct {
+	meta
+	// URL to be passed to getWebPageInstantView
+	Url string `json:"url"`
+	// An URL to open if getWebPageInstantView fails
+	FallbackUrl string `json:"fallback_url"`
+}
+
+func (*InternalLinkTypeInstantView) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeInstantView) GetConstructor() string {
+	return ConstructorInternalLinkTypeInstantView
+}
+
+func (*InternalLinkTypeInstantView) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeInstantView
+}
+
+func (entity *InternalLinkTypeInstantView) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeInstantView) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeInstantView) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeInstantView"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"fallback_url":`)
+	encoder.writeString(entity.FallbackUrl)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeInstantView) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "fallback_url":
+			entity.FallbackUrl, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeInstantView)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an invoice. Call getPaymentForm with the given invoice name to process the link
+type InternalLinkTypeInvoice struct {
+	meta
+	// Name of the invoice
+	InvoiceName string `json:"invoice_name"`
+}
+
+func (*InternalLinkTypeInvoice) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeInvoice) GetConstructor() string {
+	return ConstructorInternalLinkTypeInvoice
+}
+
+func (*InternalLinkTypeInvoice) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeInvoice
+}
+
+func (entity *InternalLinkTypeInvoice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeInvoice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeInvoice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeInvoice"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invoice_name":`)
+	encoder.writeString(entity.InvoiceName)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeInvoice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invoice_name":
+			entity.InvoiceName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeInvoice)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a language pack. Call getLanguagePackInfo with the given language pack identifier to process the link. If the language pack is found and the user wants to apply it, then call setOption for the option "language_pack_id"
+type InternalLinkTypeLanguagePack struct {
+	meta
+	// Language pack identifier
+	LanguagePackId string `json:"language_pack_id"`
+}
+
+func (*InternalLinkTypeLanguagePack) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeLanguagePack) GetConstructor() string {
+	return ConstructorInternalLinkTypeLanguagePack
+}
+
+func (*InternalLinkTypeLanguagePack) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeLanguagePack
+}
+
+func (entity *InternalLinkTypeLanguagePack) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeLanguagePack) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeLanguagePack) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeLanguagePack"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(entity.LanguagePackId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeLanguagePack) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "language_pack_id":
+			entity.LanguagePackId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeLanguagePack)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the language section of the application settings
+type InternalLinkTypeLanguageSettings struct {
+	meta
+}
+
+func (*InternalLinkTypeLanguageSettings) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeLanguageSettings) GetConstructor() string {
+	return ConstructorInternalLinkTypeLanguageSettings
+}
+
+func (*InternalLinkTypeLanguageSettings) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeLanguageSettings
+}
+
+func (entity *InternalLinkTypeLanguageSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeLanguageSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeLanguageSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeLanguageSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeLanguageSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeLanguageSettings)
+	})
+}
+
+// The link is a link to the main Web App of a bot. Call searchPublicChat with the given bot username, check that the user is a bot and has the main Web App. If the bot can be added to attachment menu, then use getAttachmentMenuBot to receive information about the bot, then if the bot isn't added to side menu, show a disclaimer about Mini Apps being third-party applications, ask the user to accept their Terms of service and confirm adding the bot to side and attachment menu, then if the user accepts the terms and confirms adding, use toggleBotIsAddedToAttachmentMenu to add the bot. Then, use getMainWebApp with the given start parameter and mode and open the returned URL as a Web App
+type InternalLinkTypeMainWebApp struct {
+	meta
+	// Username of the bot
+	BotUsername string `json:"bot_username"`
+	// Start parameter to be passed to getMainWebApp
+	StartParameter string `json:"start_parameter"`
+	// The mode to be passed to getMainWebApp
+	Mode WebAppOpenMode `json:"mode"`
+}
+
+func (*InternalLinkTypeMainWebApp) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeMainWebApp) GetConstructor() string {
+	return ConstructorInternalLinkTypeMainWebApp
+}
+
+func (*InternalLinkTypeMainWebApp) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeMainWebApp
+}
+
+func (entity *InternalLinkTypeMainWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeMainWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeMainWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeMainWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_username":`)
+	encoder.writeString(entity.BotUsername)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(entity.StartParameter)
+	encoder.writeRaw(`,"mode":`)
+	encoder.writeType(entity.Mode)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeMainWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_username":
+			entity.BotUsername, err = decoder.readString()
+
+		case "start_parameter":
+			entity.StartParameter, err = decoder.readString()
+
+		case "mode":
+			entity.Mode, err = decodeWebAppOpenMode(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeMainWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a Telegram message or a forum topic. Call getMessageLinkInfo with the given URL to process the link, and then open received forum topic or chat and show the message there
+type InternalLinkTypeMessage struct {
+	meta
+	// URL to be passed to getMessageLinkInfo
+	Url string `json:"url"`
+}
+
+func (*InternalLinkTypeMessage) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeMessage) GetConstructor() string {
+	return ConstructorInternalLinkTypeMessage
+}
+
+func (*InternalLinkTypeMessage) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeMessage
+}
+
+func (entity *InternalLinkTypeMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link contains a message draft text. A share screen needs to be shown to the user, then the chosen chat must be opened and the text is added to the input field
+type InternalLinkTypeMessageDraft struct {
+	meta
+	// Message draft text
+	Text *FormattedText `json:"text"`
+	// True, if the first line of the text contains a link. If true, the input field needs to be focused and the text after the link must be selected
+	ContainsLink bool `json:"contains_link"`
+}
+
+func (*InternalLinkTypeMessageDraft) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeMessageDraft) GetConstructor() string {
+	return ConstructorInternalLinkTypeMessageDraft
+}
+
+func (*InternalLinkTypeMessageDraft) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeMessageDraft
+}
+
+func (entity *InternalLinkTypeMessageDraft) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeMessageDraft) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeMessageDraft) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeMessageDraft"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"contains_link":`)
+	encoder.writeBool(entity.ContainsLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeMessageDraft) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "contains_link":
+			entity.ContainsLink, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeMessageDraft)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link contains a request of Telegram passport data. Call getPassportAuthorizationForm with the given parameters to process the link if the link was received from outside of the application; otherwise, ignore it
+type InternalLinkTypePassportDataRequest struct {
+	meta
+	// User identifier of the service's bot; the corresponding user may be unknown yet
+	BotUserId int64 `json:"bot_user_id"`
+	// Telegram Passport element types requested by the service
+	Scope string `json:"scope"`
+	// Service's public key
+	PublicKey string `json:"public_key"`
+	// Unique request identifier provided by the service
+	Nonce string `json:"nonce"`
+	// An HTTP URL to open once the request is finished, canceled, or failed with the parameters tg_passport=success, tg_passport=cancel, or tg_passport=error&error=... respectively. If empty, then onActivityResult method must be used to return response on Android, or the link tgbot{bot_user_id}://passport/success or tgbot{bot_user_id}://passport/cancel must be opened otherwise
+	CallbackUrl string `json:"callback_url"`
+}
+
+func (*InternalLinkTypePassportDataRequest) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypePassportDataRequest) GetConstructor() string {
+	return ConstructorInternalLinkTypePassportDataRequest
+}
+
+func (*InternalLinkTypePassportDataRequest) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypePassportDataRequest
+}
+
+func (entity *InternalLinkTypePassportDataRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypePassportDataRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypePassportDataRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypePassportDataRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"scope":`)
+	encoder.writeString(entity.Scope)
+	encoder.writeRaw(`,"public_key":`)
+	encoder.writeString(entity.PublicKey)
+	encoder.writeRaw(`,"nonce":`)
+	encoder.writeString(entity.Nonce)
+	encoder.writeRaw(`,"callback_url":`)
+	encoder.writeString(entity.CallbackUrl)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypePassportDataRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "scope":
+			entity.Scope, err = decoder.readString()
+
+		case "public_key":
+			entity.PublicKey, err = decoder.readString()
+
+		case "nonce":
+			entity.Nonce, err = decoder.readString()
+
+		case "callback_url":
+			entity.CallbackUrl, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypePassportDataRequest)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link can be used to confirm ownership of a phone number to prevent account deletion. Call sendPhoneNumberCode with the given phone number and with phoneNumberCodeTypeConfirmOwnership with the given hash to process the link. If succeeded, call checkPhoneNumberCode to check entered by the user code, or resendPhoneNumberCode to resend it
+type InternalLinkTypePhoneNumberConfirmation struct {
+	meta
+	// Hash value from the link
+	Hash string `json:"hash"`
+	// Phone number value from the link
+	PhoneNumber string `json:"phone_number"`
+}
+
+func (*InternalLinkTypePhoneNumberConfirmation) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypePhoneNumberConfirmation) GetConstructor() string {
+	return ConstructorInternalLinkTypePhoneNumberConfirmation
+}
+
+func (*InternalLinkTypePhoneNumberConfirmation) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypePhoneNumberConfirmation
+}
+
+func (entity *InternalLinkTypePhoneNumberConfirmation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypePhoneNumberConfirmation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypePhoneNumberConfirmation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypePhoneNumberConfirmation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"hash":`)
+	encoder.writeString(entity.Hash)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypePhoneNumberConfirmation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "hash":
+			entity.Hash, err = decoder.readString()
+
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypePhoneNumberConfirmation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the Premium features screen of the application from which the user can subscribe to Telegram Premium. Call getPremiumFeatures with the given referrer to process the link
+type InternalLinkTypePremiumFeatures struct {
+	meta
+	// Referrer specified in the link
+	Referrer string `json:"referrer"`
+}
+
+func (*InternalLinkTypePremiumFeatures) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypePremiumFeatures) GetConstructor() string {
+	return ConstructorInternalLinkTypePremiumFeatures
+}
+
+func (*InternalLinkTypePremiumFeatures) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypePremiumFeatures
+}
+
+func (entity *InternalLinkTypePremiumFeatures) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypePremiumFeatures) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypePremiumFeatures) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypePremiumFeatures"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"referrer":`)
+	encoder.writeString(entity.Referrer)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypePremiumFeatures) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "referrer":
+			entity.Referrer, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypePremiumFeatures)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the screen for gifting Telegram Premium subscriptions to friends via inputInvoiceTelegram with telegramPaymentPurposePremiumGift payments or in-store purchases
+type InternalLinkTypePremiumGift struct {
+	meta
+	// Referrer specified in the link
+	Referrer string `json:"referrer"`
+}
+
+func (*InternalLinkTypePremiumGift) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypePremiumGift) GetConstructor() string {
+	return ConstructorInternalLinkTypePremiumGift
+}
+
+func (*InternalLinkTypePremiumGift) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypePremiumGift
+}
+
+func (entity *InternalLinkTypePremiumGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypePremiumGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypePremiumGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypePremiumGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"referrer":`)
+	encoder.writeString(entity.Referrer)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypePremiumGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "referrer":
+			entity.Referrer, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypePremiumGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link with a Telegram Premium gift code. Call checkPremiumGiftCode with the given code to process the link. If the code is valid and the user wants to apply it, then call applyPremiumGiftCode
+type InternalLinkTypePremiumGiftCode struct {
+	meta
+	// The Telegram Premium gift code
+	Code string `json:"code"`
+}
+
+func (*InternalLinkTypePremiumGiftCode) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypePremiumGiftCode) GetConstructor() string {
+	return ConstructorInternalLinkTypePremiumGiftCode
+}
+
+func (*InternalLinkTypePremiumGiftCode) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypePremiumGiftCode
+}
+
+func (entity *InternalLinkTypePremiumGiftCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypePremiumGiftCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypePremiumGiftCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypePremiumGiftCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(entity.Code)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypePremiumGiftCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "code":
+			entity.Code, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypePremiumGiftCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the privacy and security section of the application settings
+type InternalLinkTypePrivacyAndSecuritySettings struct {
+	meta
+}
+
+func (*InternalLinkTypePrivacyAndSecuritySettings) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypePrivacyAndSecuritySettings) GetConstructor() string {
+	return ConstructorInternalLinkTypePrivacyAndSecuritySettings
+}
+
+func (*InternalLinkTypePrivacyAndSecuritySettings) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypePrivacyAndSecuritySettings
+}
+
+func (entity *InternalLinkTypePrivacyAndSecuritySettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypePrivacyAndSecuritySettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypePrivacyAndSecuritySettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypePrivacyAndSecuritySettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypePrivacyAndSecuritySettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypePrivacyAndSecuritySettings)
+	})
+}
+
+// The link is a link to a proxy. Call addProxy with the given parameters to process the link and add the proxy
+type InternalLinkTypeProxy struct {
+	meta
+	// Proxy server domain or IP address
+	Server string `json:"server"`
+	// Proxy server port
+	Port int32 `json:"port"`
+	// Type of the proxy
+	Type ProxyType `json:"type"`
+}
+
+func (*InternalLinkTypeProxy) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeProxy) GetConstructor() string {
+	return ConstructorInternalLinkTypeProxy
+}
+
+func (*InternalLinkTypeProxy) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeProxy
+}
+
+func (entity *InternalLinkTypeProxy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeProxy) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeProxy) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeProxy"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"server":`)
+	encoder.writeString(entity.Server)
+	encoder.writeRaw(`,"port":`)
+	encoder.writeInt32(entity.Port)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeProxy) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "server":
+			entity.Server, err = decoder.readString()
+
+		case "port":
+			entity.Port, err = decoder.readInt32()
+
+		case "type":
+			entity.Type, err = decodeProxyType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeProxy)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a chat by its username. Call searchPublicChat with the given chat username to process the link. If the chat is found, open its profile information screen or the chat itself. If draft text isn't empty and the chat is a private chat with a regular user, then put the draft text in the input field
+type InternalLinkTypePublicChat struct {
+	meta
+	// Username of the chat
+	ChatUsername string `json:"chat_username"`
+	// Draft text for message to send in the chat
+	DraftText string `json:"draft_text"`
+	// True, if chat profile information screen must be opened; otherwise, the chat itself must be opened
+	OpenProfile bool `json:"open_profile"`
+}
+
+func (*InternalLinkTypePublicChat) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypePublicChat) GetConstructor() string {
+	return ConstructorInternalLinkTypePublicChat
+}
+
+func (*InternalLinkTypePublicChat) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypePublicChat
+}
+
+func (entity *InternalLinkTypePublicChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypePublicChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypePublicChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypePublicChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_username":`)
+	encoder.writeString(entity.ChatUsername)
+	encoder.writeRaw(`,"draft_text":`)
+	encoder.writeString(entity.DraftText)
+	encoder.writeRaw(`,"open_profile":`)
+	encoder.writeBool(entity.OpenProfile)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypePublicChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_username":
+			entity.ChatUsername, err = decoder.readString()
+
+		case "draft_text":
+			entity.DraftText, err = decoder.readString()
+
+		case "open_profile":
+			entity.OpenProfile, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypePublicChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link can be used to login the current user on another device, but it must be scanned from QR-code using in-app camera. An alert similar to "This code can be used to allow someone to log in to your Telegram account. To confirm Telegram login, please go to Settings > Devices > Scan QR and scan the code" needs to be shown
+type InternalLinkTypeQrCodeAuthentication struct {
+	meta
+}
+
+func (*InternalLinkTypeQrCodeAuthentication) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeQrCodeAuthentication) GetConstructor() string {
+	return ConstructorInternalLinkTypeQrCodeAuthentication
+}
+
+func (*InternalLinkTypeQrCodeAuthentication) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeQrCodeAuthentication
+}
+
+func (entity *InternalLinkTypeQrCodeAuthentication) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeQrCodeAuthentication) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeQrCodeAuthentication) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeQrCodeAuthentication"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeQrCodeAuthentication) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeQrCodeAuthentication)
+	})
+}
+
+// The link forces restore of App Store purchases when opened. For official iOS application only
+type InternalLinkTypeRestorePurchases struct {
+	meta
+}
+
+func (*InternalLinkTypeRestorePurchases) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeRestorePurchases) GetConstructor() string {
+	return ConstructorInternalLinkTypeRestorePurchases
+}
+
+func (*InternalLinkTypeRestorePurchases) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeRestorePurchases
+}
+
+func (entity *InternalLinkTypeRestorePurchases) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeRestorePurchases) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeRestorePurchases) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeRestorePurchases"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeRestorePurchases) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeRestorePurchases)
+	})
+}
+
+// The link is a link to application settings
+type InternalLinkTypeSettings struct {
+	meta
+}
+
+func (*InternalLinkTypeSettings) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeSettings) GetConstructor() string {
+	return ConstructorInternalLinkTypeSettings
+}
+
+func (*InternalLinkTypeSettings) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeSettings
+}
+
+func (entity *InternalLinkTypeSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeSettings)
+	})
+}
+
+// The link is a link to a sticker set. Call searchStickerSet with the given sticker set name to process the link and show the sticker set. If the sticker set is found and the user wants to add it, then call changeStickerSet
+type InternalLinkTypeStickerSet struct {
+	meta
+	// Name of the sticker set
+	StickerSetName string `json:"sticker_set_name"`
+	// True, if the sticker set is expected to contain custom emoji
+	ExpectCustomEmoji bool `json:"expect_custom_emoji"`
+}
+
+func (*InternalLinkTypeStickerSet) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeStickerSet) GetConstructor() string {
+	return ConstructorInternalLinkTypeStickerSet
+}
+
+func (*InternalLinkTypeStickerSet) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeStickerSet
+}
+
+func (entity *InternalLinkTypeStickerSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeStickerSet) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeStickerSet) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeStickerSet"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker_set_name":`)
+	encoder.writeString(entity.StickerSetName)
+	encoder.writeRaw(`,"expect_custom_emoji":`)
+	encoder.writeBool(entity.ExpectCustomEmoji)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeStickerSet) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker_set_name":
+			entity.StickerSetName, err = decoder.readString()
+
+		case "expect_custom_emoji":
+			entity.ExpectCustomEmoji, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeStickerSet)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a story. Call searchPublicChat with the given sender username, then call getStory with the received chat identifier and the given story identifier, then show the story if received
+type InternalLinkTypeStory struct {
+	meta
+	// Username of the sender of the story
+	StorySenderUsername string `json:"story_sender_username"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+}
+
+func (*InternalLinkTypeStory) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeStory) GetConstructor() string {
+	return ConstructorInternalLinkTypeStory
+}
+
+func (*InternalLinkTypeStory) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeStory
+}
+
+func (entity *InternalLinkTypeStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_sender_username":`)
+	encoder.writeString(entity.StorySenderUsername)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_sender_username":
+			entity.StorySenderUsername, err = decoder.readString()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a cloud theme. TDLib has no theme support yet
+type InternalLinkTypeTheme struct {
+	meta
+	// Name of the theme
+	ThemeName string `json:"theme_name"`
+}
+
+func (*InternalLinkTypeTheme) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeTheme) GetConstructor() string {
+	return ConstructorInternalLinkTypeTheme
+}
+
+func (*InternalLinkTypeTheme) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeTheme
+}
+
+func (entity *InternalLinkTypeTheme) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeTheme) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeTheme) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeTheme"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"theme_name":`)
+	encoder.writeString(entity.ThemeName)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeTheme) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "theme_name":
+			entity.ThemeName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeTheme)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to the theme section of the application settings
+type InternalLinkTypeThemeSettings struct {
+	meta
+}
+
+func (*InternalLinkTypeThemeSettings) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeThemeSettings) GetConstructor() string {
+	return ConstructorInternalLinkTypeThemeSettings
+}
+
+func (*InternalLinkTypeThemeSettings) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeThemeSettings
+}
+
+func (entity *InternalLinkTypeThemeSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeThemeSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeThemeSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeThemeSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeThemeSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeThemeSettings)
+	})
+}
+
+// The link is an unknown tg: link. Call getDeepLinkInfo to process the link
+type InternalLinkTypeUnknownDeepLink struct {
+	meta
+	// Link to be passed to getDeepLinkInfo
+	Link string `json:"link"`
+}
+
+func (*InternalLinkTypeUnknownDeepLink) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeUnknownDeepLink) GetConstructor() string {
+	return ConstructorInternalLinkTypeUnknownDeepLink
+}
+
+func (*InternalLinkTypeUnknownDeepLink) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeUnknownDeepLink
+}
+
+func (entity *InternalLinkTypeUnknownDeepLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeUnknownDeepLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeUnknownDeepLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeUnknownDeepLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(entity.Link)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeUnknownDeepLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "link":
+			entity.Link, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeUnknownDeepLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to an unsupported proxy. An alert can be shown to the user
+type InternalLinkTypeUnsupportedProxy struct {
+	meta
+}
+
+func (*InternalLinkTypeUnsupportedProxy) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeUnsupportedProxy) GetConstructor() string {
+	return ConstructorInternalLinkTypeUnsupportedProxy
+}
+
+func (*InternalLinkTypeUnsupportedProxy) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeUnsupportedProxy
+}
+
+func (entity *InternalLinkTypeUnsupportedProxy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeUnsupportedProxy) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeUnsupportedProxy) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeUnsupportedProxy"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeUnsupportedProxy) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeUnsupportedProxy)
+	})
+}
+
+// The link is a link to an upgraded gift. Call getUpgradedGift with the given name to process the link
+type InternalLinkTypeUpgradedGift struct {
+	meta
+	// Name of the unique gift
+	Name string `json:"name"`
+}
+
+func (*InternalLinkTypeUpgradedGift) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeUpgradedGift) GetConstructor() string {
+	return ConstructorInternalLinkTypeUpgradedGift
+}
+
+func (*InternalLinkTypeUpgradedGift) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeUpgradedGift
+}
+
+func (entity *InternalLinkTypeUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a user by its phone number. Call searchUserByPhoneNumber with the given phone number to process the link. If the user is found, then call createPrivateChat and open user's profile information screen or the chat itself. If draft text isn't empty, then put the draft text in the input field
+type InternalLinkTypeUserPhoneNumber struct {
+	meta
+	// Phone number of the user
+	PhoneNumber string `json:"phone_number"`
+	// Draft text for message to send in the chat
+	DraftText string `json:"draft_text"`
+	// True, if user's profile information screen must be opened; otherwise, the chat itself must be opened
+	OpenProfile bool `json:"open_profile"`
+}
+
+func (*InternalLinkTypeUserPhoneNumber) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeUserPhoneNumber) GetConstructor() string {
+	return ConstructorInternalLinkTypeUserPhoneNumber
+}
+
+func (*InternalLinkTypeUserPhoneNumber) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeUserPhoneNumber
+}
+
+func (entity *InternalLinkTypeUserPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeUserPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeUserPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeUserPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw(`,"draft_text":`)
+	encoder.writeString(entity.DraftText)
+	encoder.writeRaw(`,"open_profile":`)
+	encoder.writeBool(entity.OpenProfile)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeUserPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		case "draft_text":
+			entity.DraftText, err = decoder.readString()
+
+		case "open_profile":
+			entity.OpenProfile, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeUserPhoneNumber)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a user by a temporary token. Call searchUserByToken with the given token to process the link. If the user is found, then call createPrivateChat and open the chat
+type InternalLinkTypeUserToken struct {
+	meta
+	// The token
+	Token string `json:"token"`
+}
+
+func (*InternalLinkTypeUserToken) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeUserToken) GetConstructor() string {
+	return ConstructorInternalLinkTypeUserToken
+}
+
+func (*InternalLinkTypeUserToken) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeUserToken
+}
+
+func (entity *InternalLinkTypeUserToken) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeUserToken) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeUserToken) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeUserToken"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeUserToken) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeUserToken)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a video chat. Call searchPublicChat with the given chat username, and then joinGroupCall with the given invite hash to process the link
+type InternalLinkTypeVideoChat struct {
+	meta
+	// Username of the chat with the video chat
+	ChatUsername string `json:"chat_username"`
+	// If non-empty, invite hash to be used to join the video chat without being muted by administrators
+	InviteHash string `json:"invite_hash"`
+	// True, if the video chat is expected to be a live stream in a channel or a broadcast group
+	IsLiveStream bool `json:"is_live_stream"`
+}
+
+func (*InternalLinkTypeVideoChat) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeVideoChat) GetConstructor() string {
+	return ConstructorInternalLinkTypeVideoChat
+}
+
+func (*InternalLinkTypeVideoChat) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeVideoChat
+}
+
+func (entity *InternalLinkTypeVideoChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeVideoChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeVideoChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeVideoChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_username":`)
+	encoder.writeString(entity.ChatUsername)
+	encoder.writeRaw(`,"invite_hash":`)
+	encoder.writeString(entity.InviteHash)
+	encoder.writeRaw(`,"is_live_stream":`)
+	encoder.writeBool(entity.IsLiveStream)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeVideoChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_username":
+			entity.ChatUsername, err = decoder.readString()
+
+		case "invite_hash":
+			entity.InviteHash, err = decoder.readString()
+
+		case "is_live_stream":
+			entity.IsLiveStream, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeVideoChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The link is a link to a Web App. Call searchPublicChat with the given bot username, check that the user is a bot. If the bot is restricted for the current user, then show an error message. Otherwise, call searchWebApp with the received bot and the given web_app_short_name. Process received foundWebApp by showing a confirmation dialog if needed. If the bot can be added to attachment or side menu, but isn't added yet, then show a disclaimer about Mini Apps being third-party applications instead of the dialog and ask the user to accept their Terms of service. If the user accept the terms and confirms adding, then use toggleBotIsAddedToAttachmentMenu to add the bot. Then, call getWebAppLinkUrl and open the returned URL as a Web App
+type InternalLinkTypeWebApp struct {
+	meta
+	// Username of the bot that owns the Web App
+	BotUsername string `json:"bot_username"`
+	// Short name of the Web App
+	WebAppShortName string `json:"web_app_short_name"`
+	// Start parameter to be passed to getWebAppLinkUrl
+	StartParameter string `json:"start_parameter"`
+	// The mode in which the Web App must be opened
+	Mode WebAppOpenMode `json:"mode"`
+}
+
+func (*InternalLinkTypeWebApp) GetType() string {
+	return TypeInternalLinkType
+}
+
+func (*InternalLinkTypeWebApp) GetConstructor() string {
+	return ConstructorInternalLinkTypeWebApp
+}
+
+func (*InternalLinkTypeWebApp) InternalLinkTypeConstructor() string {
+	return ConstructorInternalLinkTypeWebApp
+}
+
+func (entity *InternalLinkTypeWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InternalLinkTypeWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InternalLinkTypeWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"internalLinkTypeWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_username":`)
+	encoder.writeString(entity.BotUsername)
+	encoder.writeRaw(`,"web_app_short_name":`)
+	encoder.writeString(entity.WebAppShortName)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(entity.StartParameter)
+	encoder.writeRaw(`,"mode":`)
+	encoder.writeType(entity.Mode)
+	encoder.writeRaw("}")
+}
+
+func (entity *InternalLinkTypeWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_username":
+			entity.BotUsername, err = decoder.readString()
+
+		case "web_app_short_name":
+			entity.WebAppShortName, err = decoder.readString()
+
+		case "start_parameter":
+			entity.StartParameter, err = decoder.readString()
+
+		case "mode":
+			entity.Mode, err = decodeWebAppOpenMode(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInternalLinkTypeWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The main block list that disallows writing messages to the current user, receiving their status and photo, viewing of stories, and some other actions
+type BlockListMain struct {
+	meta
+}
+
+func (*BlockListMain) GetType() string {
+	return TypeBlockList
+}
+
+func (*BlockListMain) GetConstructor() string {
+	return ConstructorBlockListMain
+}
+
+func (*BlockListMain) BlockListConstructor() string {
+	return ConstructorBlockListMain
+}
+
+func (entity *BlockListMain) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BlockListMain) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BlockListMain) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"blockListMain"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BlockListMain) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBlockListMain)
+	})
+}
+
+// The block list that disallows viewing of stories of the current user
+type BlockListStories struct {
+	meta
+}
+
+func (*BlockListStories) GetType() string {
+	return TypeBlockList
+}
+
+func (*BlockListStories) GetConstructor() string {
+	return ConstructorBlockListStories
+}
+
+func (*BlockListStories) BlockListConstructor() string {
+	return ConstructorBlockListStories
+}
+
+func (entity *BlockListStories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BlockListStories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BlockListStories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"blockListStories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *BlockListStories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorBlockListStories)
+	})
+}
+
+// Contains the exact storage usage statistics split by chats and file type
+type StorageStatistics struct {
+	meta
+	// Total size of files, in bytes
+	Size int64 `json:"size"`
+	// Total number of files
+	Count int32 `json:"count"`
+	// Statistics split by chats
+	ByChat []*StorageStatisticsByChat `json:"by_chat"`
+}
+
+func (*StorageStatistics) GetType() string {
+	return TypeStorageStatistics
+}
+
+func (*StorageStatistics) GetConstructor() string {
+	return ConstructorStorageStatistics
+}
+
+func (entity *StorageStatistics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorageStatistics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorageStatistics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storageStatistics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"size":`)
+	encoder.writeInt64(entity.Size)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt32(entity.Count)
+	encoder.writeRaw(`,"by_chat":`)
+	writeList(encoder, entity.ByChat, writeTypeValue[*StorageStatisticsByChat])
+	encoder.writeRaw("}")
+}
+
+func (entity *StorageStatistics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "size":
+			entity.Size, err = decoder.readInt64()
+
+		case "count":
+			entity.Count, err = decoder.readInt32()
+
+		case "by_chat":
+			entity.ByChat, err = readList(decoder, decodeEntity[StorageStatisticsByChat])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorageStatistics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains approximate storage usage statistics, excluding files of unknown file type
+type StorageStatisticsFast struct {
+	meta
+	// Approximate total size of files, in bytes
+	FilesSize int64 `json:"files_size"`
+	// Approximate number of files
+	FileCount int32 `json:"file_count"`
+	// Size of the database
+	DatabaseSize int64 `json:"database_size"`
+	// Size of the language pack database
+	LanguagePackDatabaseSize int64 `json:"language_pack_database_size"`
+	// Size of the TDLib internal log
+	LogSize int64 `json:"log_size"`
+}
+
+func (*StorageStatisticsFast) GetType() string {
+	return TypeStorageStatisticsFast
+}
+
+func (*StorageStatisticsFast) GetConstructor() string {
+	return ConstructorStorageStatisticsFast
+}
+
+func (entity *StorageStatisticsFast) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorageStatisticsFast) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorageStatisticsFast) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storageStatisticsFast"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"files_size":`)
+	encoder.writeInt64(entity.FilesSize)
+	encoder.writeRaw(`,"file_count":`)
+	encoder.writeInt32(entity.FileCount)
+	encoder.writeRaw(`,"database_size":`)
+	encoder.writeInt64(entity.DatabaseSize)
+	encoder.writeRaw(`,"language_pack_database_size":`)
+	encoder.writeInt64(entity.LanguagePackDatabaseSize)
+	encoder.writeRaw(`,"log_size":`)
+	encoder.writeInt64(entity.LogSize)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorageStatisticsFast) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "files_size":
+			entity.FilesSize, err = decoder.readInt64()
+
+		case "file_count":
+			entity.FileCount, err = decoder.readInt32()
+
+		case "database_size":
+			entity.DatabaseSize, err = decoder.readInt64()
+
+		case "language_pack_database_size":
+			entity.LanguagePackDatabaseSize, err = decoder.readInt64()
+
+		case "log_size":
+			entity.LogSize, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorageStatisticsFast)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains database statistics
+type DatabaseStatistics struct {
+	meta
+	// Database statistics in an unspecified human-readable format
+	Statistics string `json:"statistics"`
+}
+
+func (*DatabaseStatistics) GetType() string {
+	return TypeDatabaseStatistics
+}
+
+func (*DatabaseStatistics) GetConstructor() string {
+	return ConstructorDatabaseStatistics
+}
+
+func (entity *DatabaseStatistics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DatabaseStatistics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DatabaseStatistics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"databaseStatistics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"statistics":`)
+	encoder.writeString(entity.Statistics)
+	encoder.writeRaw("}")
+}
+
+func (entity *DatabaseStatistics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "statistics":
+			entity.Statistics, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDatabaseStatistics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The network is not available
+type NetworkTypeNone struct {
+	meta
+}
+
+func (*NetworkTypeNone) GetType() string {
+	return TypeNetworkType
+}
+
+func (*NetworkTypeNone) GetConstructor() string {
+	return ConstructorNetworkTypeNone
+}
+
+func (*NetworkTypeNone) NetworkTypeConstructor() string {
+	return ConstructorNetworkTypeNone
+}
+
+func (entity *NetworkTypeNone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkTypeNone) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkTypeNone) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkTypeNone"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkTypeNone) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNetworkTypeNone)
+	})
+}
+
+// A mobile network
+type NetworkTypeMobile struct {
+	meta
+}
+
+func (*NetworkTypeMobile) GetType() string {
+	return TypeNetworkType
+}
+
+func (*NetworkTypeMobile) GetConstructor() string {
+	return ConstructorNetworkTypeMobile
+}
+
+func (*NetworkTypeMobile) NetworkTypeConstructor() string {
+	return ConstructorNetworkTypeMobile
+}
+
+func (entity *NetworkTypeMobile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkTypeMobile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkTypeMobile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkTypeMobile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkTypeMobile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNetworkTypeMobile)
+	})
+}
+
+// A mobile roaming network
+type NetworkTypeMobileRoaming struct {
+	meta
+}
+
+func (*NetworkTypeMobileRoaming) GetType() string {
+	return TypeNetworkType
+}
+
+func (*NetworkTypeMobileRoaming) GetConstructor() string {
+	return ConstructorNetworkTypeMobileRoaming
+}
+
+func (*NetworkTypeMobileRoaming) NetworkTypeConstructor() string {
+	return ConstructorNetworkTypeMobileRoaming
+}
+
+func (entity *NetworkTypeMobileRoaming) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkTypeMobileRoaming) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkTypeMobileRoaming) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkTypeMobileRoaming"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkTypeMobileRoaming) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNetworkTypeMobileRoaming)
+	})
+}
+
+// A Wi-Fi network
+type NetworkTypeWiFi struct {
+	meta
+}
+
+func (*NetworkTypeWiFi) GetType() string {
+	return TypeNetworkType
+}
+
+func (*NetworkTypeWiFi) GetConstructor() string {
+	return ConstructorNetworkTypeWiFi
+}
+
+func (*NetworkTypeWiFi) NetworkTypeConstructor() string {
+	return ConstructorNetworkTypeWiFi
+}
+
+func (entity *NetworkTypeWiFi) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkTypeWiFi) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkTypeWiFi) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkTypeWiFi"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkTypeWiFi) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNetworkTypeWiFi)
+	})
+}
+
+// A different network type (e.g., Ethernet network)
+type NetworkTypeOther struct {
+	meta
+}
+
+func (*NetworkTypeOther) GetType() string {
+	return TypeNetworkType
+}
+
+func (*NetworkTypeOther) GetConstructor() string {
+	return ConstructorNetworkTypeOther
+}
+
+func (*NetworkTypeOther) NetworkTypeConstructor() string {
+	return ConstructorNetworkTypeOther
+}
+
+func (entity *NetworkTypeOther) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkTypeOther) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkTypeOther) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkTypeOther"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkTypeOther) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorNetworkTypeOther)
+	})
+}
+
+// Contains information about the total amount of data that was used to send and receive files
+type NetworkStatisticsEntryFile struct {
+	meta
+	// Type of the file the data is part of; pass null if the data isn't related to files
+	FileType FileType `json:"file_type,omitempty"`
+	// Type of the network the data was sent through. Call setNetworkType to maintain the actual network type
+	NetworkType NetworkType `json:"network_type"`
+	// Total number of bytes sent
+	SentBytes int64 `json:"sent_bytes"`
+	// Total number of bytes received
+	ReceivedBytes int64 `json:"received_bytes"`
+}
+
+func (*NetworkStatisticsEntryFile) GetType() string {
+	return TypeNetworkStatisticsEntry
+}
+
+func (*NetworkStatisticsEntryFile) GetConstructor() string {
+	return ConstructorNetworkStatisticsEntryFile
+}
+
+func (*NetworkStatisticsEntryFile) NetworkStatisticsEntryConstructor() string {
+	return ConstructorNetworkStatisticsEntryFile
+}
+
+func (entity *NetworkStatisticsEntryFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkStatisticsEntryFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkStatisticsEntryFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkStatisticsEntryFile"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.FileType != nil {
+		encoder.writeRaw(`,"file_type":`)
+		encoder.writeType(entity.FileType)
+	}
+	encoder.writeRaw(`,"network_type":`)
+	encoder.writeType(entity.NetworkType)
+	encoder.writeRaw(`,"sent_bytes":`)
+	encoder.writeInt64(entity.SentBytes)
+	encoder.writeRaw(`,"received_bytes":`)
+	encoder.writeInt64(entity.ReceivedBytes)
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkStatisticsEntryFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_type":
+			entity.FileType, err = decodeFileType(decoder)
+
+		case "network_type":
+			entity.NetworkType, err = decodeNetworkType(decoder)
+
+		case "sent_bytes":
+			entity.SentBytes, err = decoder.readInt64()
+
+		case "received_bytes":
+			entity.ReceivedBytes, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNetworkStatisticsEntryFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about the total amount of data that was used for calls
+type NetworkStatisticsEntryCall struct {
+	meta
+	// Type of the network the data was sent through. Call setNetworkType to maintain the actual network type
+	NetworkType NetworkType `json:"network_type"`
+	// Total number of bytes sent
+	SentBytes int64 `json:"sent_bytes"`
+	// Total number of bytes received
+	ReceivedBytes int64 `json:"received_bytes"`
+	// Total call duration, in seconds
+	Duration float64 `json:"duration"`
+}
+
+func (*NetworkStatisticsEntryCall) GetType() string {
+	return TypeNetworkStatisticsEntry
+}
+
+func (*NetworkStatisticsEntryCall) GetConstructor() string {
+	return ConstructorNetworkStatisticsEntryCall
+}
+
+func (*NetworkStatisticsEntryCall) NetworkStatisticsEntryConstructor() string {
+	return ConstructorNetworkStatisticsEntryCall
+}
+
+func (entity *NetworkStatisticsEntryCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkStatisticsEntryCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkStatisticsEntryCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkStatisticsEntryCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"network_type":`)
+	encoder.writeType(entity.NetworkType)
+	encoder.writeRaw(`,"sent_bytes":`)
+	encoder.writeInt64(entity.SentBytes)
+	encoder.writeRaw(`,"received_bytes":`)
+	encoder.writeInt64(entity.ReceivedBytes)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeFloat64(entity.Duration)
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkStatisticsEntryCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "network_type":
+			entity.NetworkType, err = decodeNetworkType(decoder)
+
+		case "sent_bytes":
+			entity.SentBytes, err = decoder.readInt64()
+
+		case "received_bytes":
+			entity.ReceivedBytes, err = decoder.readInt64()
+
+		case "duration":
+			entity.Duration, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNetworkStatisticsEntryCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A full list of available network statistic entries
+type NetworkStatistics struct {
+	meta
+	// Point in time (Unix timestamp) from which the statistics are collected
+	SinceDate int32 `json:"since_date"`
+	// Network statistics entries
+	Entries []NetworkStatisticsEntry `json:"entries"`
+}
+
+func (*NetworkStatistics) GetType() string {
+	return TypeNetworkStatistics
+}
+
+func (*NetworkStatistics) GetConstructor() string {
+	return ConstructorNetworkStatistics
+}
+
+func (entity *NetworkStatistics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *NetworkStatistics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *NetworkStatistics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"networkStatistics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"since_date":`)
+	encoder.writeInt32(entity.SinceDate)
+	encoder.writeRaw(`,"entries":`)
+	writeList(encoder, entity.Entries, writeTypeValue[NetworkStatisticsEntry])
+	encoder.writeRaw("}")
+}
+
+func (entity *NetworkStatistics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "since_date":
+			entity.SinceDate, err = decoder.readInt32()
+
+		case "entries":
+			entity.Entries, err = readList(decoder, decodeNetworkStatisticsEntry)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorNetworkStatistics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Autosave settings applied to all private chats without chat-specific settings
+type AutosaveSettingsScopePrivateChats struct {
+	meta
+}
+
+func (*AutosaveSettingsScopePrivateChats) GetType() string {
+	return TypeAutosaveSettingsScope
+}
+
+func (*AutosaveSettingsScopePrivateChats) GetConstructor() string {
+	return ConstructorAutosaveSettingsScopePrivateChats
+}
+
+func (*AutosaveSettingsScopePrivateChats) AutosaveSettingsScopeConstructor() string {
+	return ConstructorAutosaveSettingsScopePrivateChats
+}
+
+func (entity *AutosaveSettingsScopePrivateChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutosaveSettingsScopePrivateChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutosaveSettingsScopePrivateChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autosaveSettingsScopePrivateChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AutosaveSettingsScopePrivateChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAutosaveSettingsScopePrivateChats)
+	})
+}
+
+// Autosave settings applied to all basic group and supergroup chats without chat-specific settings
+type AutosaveSettingsScopeGroupChats struct {
+	meta
+}
+
+func (*AutosaveSettingsScopeGroupChats) GetType() string {
+	return TypeAutosaveSettingsScope
+}
+
+func (*AutosaveSettingsScopeGroupChats) GetConstructor() string {
+	return ConstructorAutosaveSettingsScopeGroupChats
+}
+
+func (*AutosaveSettingsScopeGroupChats) AutosaveSettingsScopeConstructor() string {
+	return ConstructorAutosaveSettingsScopeGroupChats
+}
+
+func (entity *AutosaveSettingsScopeGroupChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutosaveSettingsScopeGroupChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutosaveSettingsScopeGroupChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autosaveSettingsScopeGroupChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AutosaveSettingsScopeGroupChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAutosaveSettingsScopeGroupChats)
+	})
+}
+
+// Autosave settings applied to all channel chats without chat-specific settings
+type AutosaveSettingsScopeChannelChats struct {
+	meta
+}
+
+func (*AutosaveSettingsScopeChannelChats) GetType() string {
+	return TypeAutosaveSettingsScope
+}
+
+func (*AutosaveSettingsScopeChannelChats) GetConstructor() string {
+	return ConstructorAutosaveSettingsScopeChannelChats
+}
+
+func (*AutosaveSettingsScopeChannelChats) AutosaveSettingsScopeConstructor() string {
+	return ConstructorAutosaveSettingsScopeChannelChats
+}
+
+func (entity *AutosaveSettingsScopeChannelChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutosaveSettingsScopeChannelChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutosaveSettingsScopeChannelChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autosaveSettingsScopeChannelChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AutosaveSettingsScopeChannelChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAutosaveSettingsScopeChannelChats)
+	})
+}
+
+// Autosave settings applied to a chat
+type AutosaveSettingsScopeChat struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*AutosaveSettingsScopeChat) GetType() string {
+	return TypeAutosaveSettingsScope
+}
+
+func (*AutosaveSettingsScopeChat) GetConstructor() string {
+	return ConstructorAutosaveSettingsScopeChat
+}
+
+func (*AutosaveSettingsScopeChat) AutosaveSettingsScopeConstructor() string {
+	return ConstructorAutosaveSettingsScopeChat
+}
+
+func (entity *AutosaveSettingsScopeChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutosaveSettingsScopeChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutosaveSettingsScopeChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autosaveSettingsScopeChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *AutosaveSettingsScopeChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAutosaveSettingsScopeChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains autosave settings for an autosave settings scope
+type ScopeAutosaveSettings struct {
+	meta
+	// True, if photo autosave is enabled
+	AutosavePhotos bool `json:"autosave_photos"`
+	// True, if video autosave is enabled
+	AutosaveVideos bool `json:"autosave_videos"`
+	// The maximum size of a video file to be autosaved, in bytes; 512 KB - 4000 MB
+	MaxVideoFileSize int64 `json:"max_video_file_size"`
+}
+
+func (*ScopeAutosaveSettings) GetType() string {
+	return TypeScopeAutosaveSettings
+}
+
+func (*ScopeAutosaveSettings) GetConstructor() string {
+	return ConstructorScopeAutosaveSettings
+}
+
+func (entity *ScopeAutosaveSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ScopeAutosaveSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ScopeAutosaveSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"scopeAutosaveSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"autosave_photos":`)
+	encoder.writeBool(entity.AutosavePhotos)
+	encoder.writeRaw(`,"autosave_videos":`)
+	encoder.writeBool(entity.AutosaveVideos)
+	encoder.writeRaw(`,"max_video_file_size":`)
+	encoder.writeInt64(entity.MaxVideoFileSize)
+	encoder.writeRaw("}")
+}
+
+func (entity *ScopeAutosaveSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "autosave_photos":
+			entity.AutosavePhotos, err = decoder.readBool()
+
+		case "autosave_videos":
+			entity.AutosaveVideos, err = decoder.readBool()
+
+		case "max_video_file_size":
+			entity.MaxVideoFileSize, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorScopeAutosaveSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains autosave settings for a chat, which overrides default settings for the corresponding scope
+type AutosaveSettingsException struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Autosave settings for the chat
+	Settings *ScopeAutosaveSettings `json:"settings"`
+}
+
+func (*AutosaveSettingsException) GetType() string {
+	return TypeAutosaveSettingsException
+}
+
+func (*AutosaveSettingsException) GetConstructor() string {
+	return ConstructorAutosaveSettingsException
+}
+
+func (entity *AutosaveSettingsException) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutosaveSettingsException) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutosaveSettingsException) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autosaveSettingsException"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"settings":`)
+	entity.Settings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *AutosaveSettingsException) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "settings":
+			entity.Settings, err = decodeEntity[ScopeAutosaveSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAutosaveSettingsException)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes autosave settings
+type AutosaveSettings struct {
+	meta
+	// Default autosave settings for private chats
+	PrivateChatSettings *ScopeAutosaveSettings `json:"private_chat_settings"`
+	// Default autosave settings for basic group and supergroup chats
+	GroupSettings *ScopeAutosaveSettings `json:"group_settings"`
+	// Default autosave settings for channel chats
+	ChannelSettings *ScopeAutosaveSettings `json:"channel_settings"`
+	// Autosave settings for specific chats
+	Exceptions []*AutosaveSettingsException `json:"exceptions"`
+}
+
+func (*AutosaveSettings) GetType() string {
+	return TypeAutosaveSettings
+}
+
+func (*AutosaveSettings) GetConstructor() string {
+	return ConstructorAutosaveSettings
+}
+
+func (entity *AutosaveSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutosaveSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutosaveSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autosaveSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"private_chat_settings":`)
+	entity.PrivateChatSettings.encodeJSON(encoder)
+	encoder.writeRaw(`,"group_settings":`)
+	entity.GroupSettings.encodeJSON(encoder)
+	encoder.writeRaw(`,"channel_settings":`)
+	entity.ChannelSettings.encodeJSON(encoder)
+	encoder.writeRaw(`,"exceptions":`)
+	writeList(encoder, entity.Exceptions, writeTypeValue[*AutosaveSettingsException])
+	encoder.writeRaw("}")
+}
+
+func (entity *AutosaveSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "private_chat_settings":
+			entity.PrivateChatSettings, err = decodeEntity[ScopeAutosaveSettings](decoder)
+
+		case "group_settings":
+			entity.GroupSettings, err = decodeEntity[ScopeAutosaveSettings](decoder)
+
+		case "channel_settings":
+			entity.ChannelSettings, err = decodeEntity[ScopeAutosaveSettings](decoder)
+
+		case "exceptions":
+			entity.Exceptions, err = readList(decoder, decodeEntity[AutosaveSettingsException])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAutosaveSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Waiting for the network to become available. Use setNetworkType to change the available network type
+type ConnectionStateWaitingForNetwork struct {
+	meta
+}
+
+func (*ConnectionStateWaitingForNetwork) GetType() string {
+	return TypeConnectionState
+}
+
+func (*ConnectionStateWaitingForNetwork) GetConstructor() string {
+	return ConstructorConnectionStateWaitingForNetwork
+}
+
+func (*ConnectionStateWaitingForNetwork) ConnectionStateConstructor() string {
+	return ConstructorConnectionStateWaitingForNetwork
+}
+
+func (entity *ConnectionStateWaitingForNetwork) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectionStateWaitingForNetwork) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectionStateWaitingForNetwork) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectionStateWaitingForNetwork"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectionStateWaitingForNetwork) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorConnectionStateWaitingForNetwork)
+	})
+}
+
+// Establishing a connection with a proxy server
+type ConnectionStateConnectingToProxy struct {
+	meta
+}
+
+func (*ConnectionStateConnectingToProxy) GetType() string {
+	return TypeConnectionState
+}
+
+func (*ConnectionStateConnectingToProxy) GetConstructor() string {
+	return ConstructorConnectionStateConnectingToProxy
+}
+
+func (*ConnectionStateConnectingToProxy) ConnectionStateConstructor() string {
+	return ConstructorConnectionStateConnectingToProxy
+}
+
+func (entity *ConnectionStateConnectingToProxy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectionStateConnectingToProxy) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectionStateConnectingToProxy) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectionStateConnectingToProxy"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectionStateConnectingToProxy) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorConnectionStateConnectingToProxy)
+	})
+}
+
+// Establishing a connection to the Telegram servers
+type ConnectionStateConnecting struct {
+	meta
+}
+
+func (*ConnectionStateConnecting) GetType() string {
+	return TypeConnectionState
+}
+
+func (*ConnectionStateConnecting) GetConstructor() string {
+	return ConstructorConnectionStateConnecting
+}
+
+func (*ConnectionStateConnecting) ConnectionStateConstructor() string {
+	return ConstructorConnectionStateConnecting
+}
+
+func (entity *ConnectionStateConnecting) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectionStateConnecting) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectionStateConnecting) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectionStateConnecting"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectionStateConnecting) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorConnectionStateConnecting)
+	})
+}
+
+// Downloading data expected to be received while the application was offline
+type ConnectionStateUpdating struct {
+	meta
+}
+
+func (*ConnectionStateUpdating) GetType() string {
+	return TypeConnectionState
+}
+
+func (*ConnectionStateUpdating) GetConstructor() string {
+	return ConstructorConnectionStateUpdating
+}
+
+func (*ConnectionStateUpdating) ConnectionStateConstructor() string {
+	return ConstructorConnectionStateUpdating
+}
+
+func (entity *ConnectionStateUpdating) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectionStateUpdating) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectionStateUpdating) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectionStateUpdating"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectionStateUpdating) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorConnectionStateUpdating)
+	})
+}
+
+// There is a working connection to the Telegram servers
+type ConnectionStateReady struct {
+	meta
+}
+
+func (*ConnectionStateReady) GetType() string {
+	return TypeConnectionState
+}
+
+func (*ConnectionStateReady) GetConstructor() string {
+	return ConstructorConnectionStateReady
+}
+
+func (*ConnectionStateReady) ConnectionStateConstructor() string {
+	return ConstructorConnectionStateReady
+}
+
+func (entity *ConnectionStateReady) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectionStateReady) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectionStateReady) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectionStateReady"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectionStateReady) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorConnectionStateReady)
+	})
+}
+
+// Contains 0-based match position
+type FoundPosition struct {
+	meta
+	// The position of the match
+	Position int32 `json:"position"`
+}
+
+func (*FoundPosition) GetType() string {
+	return TypeFoundPosition
+}
+
+func (*FoundPosition) GetConstructor() string {
+	return ConstructorFoundPosition
+}
+
+func (entity *FoundPosition) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundPosition) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundPosition"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"position":`)
+	encoder.writeInt32(entity.Position)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundPosition) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "position":
+			entity.Position, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundPosition)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains 0-based positions of matched objects
+type FoundPositions struct {
+	meta
+	// Total number of matched objects
+	TotalCount int32 `json:"total_count"`
+	// The positions of the matched objects
+	Positions []int32 `json:"positions"`
+}
+
+func (*FoundPositions) GetType() string {
+	return TypeFoundPositions
+}
+
+func (*FoundPositions) GetConstructor() string {
+	return ConstructorFoundPositions
+}
+
+func (entity *FoundPositions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundPositions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundPositions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundPositions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"positions":`)
+	writeList(encoder, entity.Positions, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundPositions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "positions":
+			entity.Positions, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundPositions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A URL linking to a user
+type TMeUrlTypeUser struct {
+	meta
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+}
+
+func (*TMeUrlTypeUser) GetType() string {
+	return TypeTMeUrlType
+}
+
+func (*TMeUrlTypeUser) GetConstructor() string {
+	return ConstructorTMeUrlTypeUser
+}
+
+func (*TMeUrlTypeUser) TMeUrlTypeConstructor() string {
+	return ConstructorTMeUrlTypeUser
+}
+
+func (entity *TMeUrlTypeUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TMeUrlTypeUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TMeUrlTypeUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"tMeUrlTypeUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *TMeUrlTypeUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTMeUrlTypeUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A URL linking to a public supergroup or channel
+type TMeUrlTypeSupergroup struct {
+	meta
+	// Identifier of the supergroup or channel
+	SupergroupId int64 `json:"supergroup_id"`
+}
+
+func (*TMeUrlTypeSupergroup) GetType() string {
+	return TypeTMeUrlType
+}
+
+func (*TMeUrlTypeSupergroup) GetConstructor() string {
+	return ConstructorTMeUrlTypeSupergroup
+}
+
+func (*TMeUrlTypeSupergroup) TMeUrlTypeConstructor() string {
+	return ConstructorTMeUrlTypeSupergroup
+}
+
+func (entity *TMeUrlTypeSupergroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TMeUrlTypeSupergroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TMeUrlTypeSupergroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"tMeUrlTypeSupergroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(entity.SupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (entity *TMeUrlTypeSupergroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "supergroup_id":
+			entity.SupergroupId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTMeUrlTypeSupergroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat invite link
+type TMeUrlTypeChatInvite struct {
+	meta
+	// Information about the chat invite link
+	Info *ChatInviteLinkInfo `json:"info"`
+}
+
+func (*TMeUrlTypeChatInvite) GetType() string {
+	return TypeTMeUrlType
+}
+
+func (*TMeUrlTypeChatInvite) GetConstructor() string {
+	return ConstructorTMeUrlTypeChatInvite
+}
+
+func (*TMeUrlTypeChatInvite) TMeUrlTypeConstructor() string {
+	return ConstructorTMeUrlTypeChatInvite
+}
+
+func (entity *TMeUrlTypeChatInvite) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TMeUrlTypeChatInvite) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TMeUrlTypeChatInvite) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"tMeUrlTypeChatInvite"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"info":`)
+	entity.Info.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *TMeUrlTypeChatInvite) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "info":
+			entity.Info, err = decodeEntity[ChatInviteLinkInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTMeUrlTypeChatInvite)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A URL linking to a sticker set
+type TMeUrlTypeStickerSet struct {
+	meta
+	// Identifier of the sticker set
+	StickerSetId JsonInt64 `json:"sticker_set_id"`
+}
+
+func (*TMeUrlTypeStickerSet) GetType() string {
+	return TypeTMeUrlType
+}
+
+func (*TMeUrlTypeStickerSet) GetConstructor() string {
+	return ConstructorTMeUrlTypeStickerSet
+}
+
+func (*TMeUrlTypeStickerSet) TMeUrlTypeConstructor() string {
+	return ConstructorTMeUrlTypeStickerSet
+}
+
+func (entity *TMeUrlTypeStickerSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TMeUrlTypeStickerSet) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TMeUrlTypeStickerSet) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"tMeUrlTypeStickerSet"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker_set_id":`)
+	encoder.writeJsonInt64(entity.StickerSetId)
+	encoder.writeRaw("}")
+}
+
+func (entity *TMeUrlTypeStickerSet) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker_set_id":
+			entity.StickerSetId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTMeUrlTypeStickerSet)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a URL linking to an internal Telegram entity
+type TMeUrl struct {
+	meta
+	// URL
+	Url string `json:"url"`
+	// Type of the URL
+	Type TMeUrlType `json:"type"`
+}
+
+func (*TMeUrl) GetType() string {
+	return TypeTMeUrl
+}
+
+func (*TMeUrl) GetConstructor() string {
+	return ConstructorTMeUrl
+}
+
+func (entity *TMeUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TMeUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TMeUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"tMeUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *TMeUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "type":
+			entity.Type, err = decodeTMeUrlType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTMeUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of t.me URLs
+type TMeUrls struct {
+	meta
+	// List of URLs
+	Urls []*TMeUrl `json:"urls"`
+}
+
+func (*TMeUrls) GetType() string {
+	return TypeTMeUrls
+}
+
+func (*TMeUrls) GetConstructor() string {
+	return ConstructorTMeUrls
+}
+
+func (entity *TMeUrls) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TMeUrls) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TMeUrls) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"tMeUrls"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"urls":`)
+	writeList(encoder, entity.Urls, writeTypeValue[*TMeUrl])
+	encoder.writeRaw("}")
+}
+
+func (entity *TMeUrls) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "urls":
+			entity.Urls, err = readList(decoder, decodeEntity[TMeUrl])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTMeUrls)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Suggests the user to enable archive_and_mute_new_chats_from_unknown_users setting in archiveChatListSettings
+type SuggestedActionEnableArchiveAndMuteNewChats struct {
+	meta
+}
+
+func (*SuggestedActionEnableArchiveAndMuteNewChats) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionEnableArchiveAndMuteNewChats) GetConstructor() string {
+	return ConstructorSuggestedActionEnableArchiveAndMuteNewChats
+}
+
+func (*SuggestedActionEnableArchiveAndMuteNewChats) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionEnableArchiveAndMuteNewChats
+}
+
+func (entity *SuggestedActionEnableArchiveAndMuteNewChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionEnableArchiveAndMuteNewChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionEnableArchiveAndMuteNewChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionEnableArchiveAndMuteNewChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionEnableArchiveAndMuteNewChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionEnableArchiveAndMuteNewChats)
+	})
+}
+
+// Suggests the user to check whether they still remember their 2-step verification password
+type SuggestedActionCheckPassword struct {
+	meta
+}
+
+func (*SuggestedActionCheckPassword) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionCheckPassword) GetConstructor() string {
+	return ConstructorSuggestedActionCheckPassword
+}
+
+func (*SuggestedActionCheckPassword) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionCheckPassword
+}
+
+func (entity *SuggestedActionCheckPassword) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionCheckPassword) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionCheckPassword) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionCheckPassword"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionCheckPassword) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionCheckPassword)
+	})
+}
+
+// Suggests the user to check whether authorization phone number is correct and change the phone number if it is inaccessible
+type SuggestedActionCheckPhoneNumber struct {
+	meta
+}
+
+func (*SuggestedActionCheckPhoneNumber) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionCheckPhoneNumber) GetConstructor() string {
+	return ConstructorSuggestedActionCheckPhoneNumber
+}
+
+func (*SuggestedActionCheckPhoneNumber) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionCheckPhoneNumber
+}
+
+func (entity *SuggestedActionCheckPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionCheckPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionCheckPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionCheckPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionCheckPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionCheckPhoneNumber)
+	})
+}
+
+// Suggests the user to view a hint about the meaning of one and two check marks on sent messages
+type SuggestedActionViewChecksHint struct {
+	meta
+}
+
+func (*SuggestedActionViewChecksHint) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionViewChecksHint) GetConstructor() string {
+	return ConstructorSuggestedActionViewChecksHint
+}
+
+func (*SuggestedActionViewChecksHint) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionViewChecksHint
+}
+
+func (entity *SuggestedActionViewChecksHint) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionViewChecksHint) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionViewChecksHint) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionViewChecksHint"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionViewChecksHint) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionViewChecksHint)
+	})
+}
+
+// Suggests the user to convert specified supergroup to a broadcast group
+type SuggestedActionConvertToBroadcastGroup struct {
+	meta
+	// Supergroup identifier
+	SupergroupId int64 `json:"supergroup_id"`
+}
+
+func (*SuggestedActionConvertToBroadcastGroup) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionConvertToBroadcastGroup) GetConstructor() string {
+	return ConstructorSuggestedActionConvertToBroadcastGroup
+}
+
+func (*SuggestedActionConvertToBroadcastGroup) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionConvertToBroadcastGroup
+}
+
+func (entity *SuggestedActionConvertToBroadcastGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionConvertToBroadcastGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionConvertToBroadcastGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionConvertToBroadcastGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(entity.SupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionConvertToBroadcastGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "supergroup_id":
+			entity.SupergroupId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionConvertToBroadcastGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Suggests the user to set a 2-step verification password to be able to log in again
+type SuggestedActionSetPassword struct {
+	meta
+	// The number of days to pass between consecutive authorizations if the user declines to set password; if 0, then the user is advised to set the password for security reasons
+	AuthorizationDelay int32 `json:"authorization_delay"`
+}
+
+func (*SuggestedActionSetPassword) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionSetPassword) GetConstructor() string {
+	return ConstructorSuggestedActionSetPassword
+}
+
+func (*SuggestedActionSetPassword) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionSetPassword
+}
+
+func (entity *SuggestedActionSetPassword) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionSetPassword) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionSetPassword) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionSetPassword"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"authorization_delay":`)
+	encoder.writeInt32(entity.AuthorizationDelay)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionSetPassword) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "authorization_delay":
+			entity.AuthorizationDelay, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionSetPassword)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Suggests the user to upgrade the Premium subscription from monthly payments to annual payments
+type SuggestedActionUpgradePremium struct {
+	meta
+}
+
+func (*SuggestedActionUpgradePremium) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionUpgradePremium) GetConstructor() string {
+	return ConstructorSuggestedActionUpgradePremium
+}
+
+func (*SuggestedActionUpgradePremium) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionUpgradePremium
+}
+
+func (entity *SuggestedActionUpgradePremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionUpgradePremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionUpgradePremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionUpgradePremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionUpgradePremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionUpgradePremium)
+	})
+}
+
+// Suggests the user to restore a recently expired Premium subscription
+type SuggestedActionRestorePremium struct {
+	meta
+}
+
+func (*SuggestedActionRestorePremium) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionRestorePremium) GetConstructor() string {
+	return ConstructorSuggestedActionRestorePremium
+}
+
+func (*SuggestedActionRestorePremium) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionRestorePremium
+}
+
+func (entity *SuggestedActionRestorePremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionRestorePremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionRestorePremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionRestorePremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionRestorePremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionRestorePremium)
+	})
+}
+
+// Suggests the user to subscribe to the Premium subscription with annual payments
+type SuggestedActionSubscribeToAnnualPremium struct {
+	meta
+}
+
+func (*SuggestedActionSubscribeToAnnualPremium) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionSubscribeToAnnualPremium) GetConstructor() string {
+	return ConstructorSuggestedActionSubscribeToAnnualPremium
+}
+
+func (*SuggestedActionSubscribeToAnnualPremium) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionSubscribeToAnnualPremium
+}
+
+func (entity *SuggestedActionSubscribeToAnnualPremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionSubscribeToAnnualPremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionSubscribeToAnnualPremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionSubscribeToAnnualPremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionSubscribeToAnnualPremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionSubscribeToAnnualPremium)
+	})
+}
+
+// Suggests the user to gift Telegram Premium to friends for Christmas
+type SuggestedActionGiftPremiumForChristmas struct {
+	meta
+}
+
+func (*SuggestedActionGiftPremiumForChristmas) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionGiftPremiumForChristmas) GetConstructor() string {
+	return ConstructorSuggestedActionGiftPremiumForChristmas
+}
+
+func (*SuggestedActionGiftPremiumForChristmas) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionGiftPremiumForChristmas
+}
+
+func (entity *SuggestedActionGiftPremiumForChristmas) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionGiftPremiumForChristmas) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionGiftPremiumForChristmas) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionGiftPremiumForChristmas"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionGiftPremiumForChristmas) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionGiftPremiumForChristmas)
+	})
+}
+
+// Suggests the user to set birthdate
+type SuggestedActionSetBirthdate struct {
+	meta
+}
+
+func (*SuggestedActionSetBirthdate) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionSetBirthdate) GetConstructor() string {
+	return ConstructorSuggestedActionSetBirthdate
+}
+
+func (*SuggestedActionSetBirthdate) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionSetBirthdate
+}
+
+func (entity *SuggestedActionSetBirthdate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionSetBirthdate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionSetBirthdate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionSetBirthdate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionSetBirthdate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionSetBirthdate)
+	})
+}
+
+// Suggests the user to set profile photo
+type SuggestedActionSetProfilePhoto struct {
+	meta
+}
+
+func (*SuggestedActionSetProfilePhoto) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionSetProfilePhoto) GetConstructor() string {
+	return ConstructorSuggestedActionSetProfilePhoto
+}
+
+func (*SuggestedActionSetProfilePhoto) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionSetProfilePhoto
+}
+
+func (entity *SuggestedActionSetProfilePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionSetProfilePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionSetProfilePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionSetProfilePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionSetProfilePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionSetProfilePhoto)
+	})
+}
+
+// Suggests the user to extend their expiring Telegram Premium subscription
+type SuggestedActionExtendPremium struct {
+	meta
+	// A URL for managing Telegram Premium subscription
+	ManagePremiumSubscriptionUrl string `json:"manage_premium_subscription_url"`
+}
+
+func (*SuggestedActionExtendPremium) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionExtendPremium) GetConstructor() string {
+	return ConstructorSuggestedActionExtendPremium
+}
+
+func (*SuggestedActionExtendPremium) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionExtendPremium
+}
+
+func (entity *SuggestedActionExtendPremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionExtendPremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionExtendPremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionExtendPremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"manage_premium_subscription_url":`)
+	encoder.writeString(entity.ManagePremiumSubscriptionUrl)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionExtendPremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "manage_premium_subscription_url":
+			entity.ManagePremiumSubscriptionUrl, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionExtendPremium)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Suggests the user to extend their expiring Telegram Star subscriptions. Call getStarSubscriptions with only_expiring == true to get the number of expiring subscriptions and the number of required to buy Telegram Stars
+type SuggestedActionExtendStarSubscriptions struct {
+	meta
+}
+
+func (*SuggestedActionExtendStarSubscriptions) GetType() string {
+	return TypeSuggestedAction
+}
+
+func (*SuggestedActionExtendStarSubscriptions) GetConstructor() string {
+	return ConstructorSuggestedActionExtendStarSubscriptions
+}
+
+func (*SuggestedActionExtendStarSubscriptions) SuggestedActionConstructor() string {
+	return ConstructorSuggestedActionExtendStarSubscriptions
+}
+
+func (entity *SuggestedActionExtendStarSubscriptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SuggestedActionExtendStarSubscriptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SuggestedActionExtendStarSubscriptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"suggestedActionExtendStarSubscriptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SuggestedActionExtendStarSubscriptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSuggestedActionExtendStarSubscriptions)
+	})
+}
+
+// Contains a counter
+type Count struct {
+	meta
+	// Count
+	Count int32 `json:"count"`
+}
+
+func (*Count) GetType() string {
+	return TypeCount
+}
+
+func (*Count) GetConstructor() string {
+	return ConstructorCount
+}
+
+func (entity *Count) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Count) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Count) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"count"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt32(entity.Count)
+	encoder.writeRaw("}")
+}
+
+func (entity *Count) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "count":
+			entity.Count, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a value representing a number of seconds
+type Seconds struct {
+	meta
+	// Number of seconds
+	Seconds float64 `json:"seconds"`
+}
+
+func (*Seconds) GetType() string {
+	return TypeSeconds
+}
+
+func (*Seconds) GetConstructor() string {
+	return ConstructorSeconds
+}
+
+func (entity *Seconds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Seconds) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Seconds) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"seconds"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"seconds":`)
+	encoder.writeFloat64(entity.Seconds)
+	encoder.writeRaw("}")
+}
+
+func (entity *Seconds) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "seconds":
+			entity.Seconds, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSeconds)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a tg: deep link
+type DeepLinkInfo struct {
+	meta
+	// Text to be shown to the user
+	Text *FormattedText `json:"text"`
+	// True, if the user must be asked to update the application
+	NeedUpdateApplication bool `json:"need_update_application"`
+}
+
+func (*DeepLinkInfo) GetType() string {
+	return TypeDeepLinkInfo
+}
+
+func (*DeepLinkInfo) GetConstructor() string {
+	return ConstructorDeepLinkInfo
+}
+
+func (entity *DeepLinkInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DeepLinkInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DeepLinkInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"deepLinkInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"need_update_application":`)
+	encoder.writeBool(entity.NeedUpdateApplication)
+	encoder.writeRaw("}")
+}
+
+func (entity *DeepLinkInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "need_update_application":
+			entity.NeedUpdateApplication, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDeepLinkInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A SOCKS5 proxy server
+type ProxyTypeSocks5 struct {
+	meta
+	// Username for logging in; may be empty
+	Username string `json:"username"`
+	// Password for logging in; may be empty
+	Password string `json:"password"`
+}
+
+func (*ProxyTypeSocks5) GetType() string {
+	return TypeProxyType
+}
+
+func (*ProxyTypeSocks5) GetConstructor() string {
+	return ConstructorProxyTypeSocks5
+}
+
+func (*ProxyTypeSocks5) ProxyTypeConstructor() string {
+	return ConstructorProxyTypeSocks5
+}
+
+func (entity *ProxyTypeSocks5) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ProxyTypeSocks5) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ProxyTypeSocks5) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"proxyTypeSocks5"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(entity.Password)
+	encoder.writeRaw("}")
+}
+
+func (entity *ProxyTypeSocks5) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		case "password":
+			entity.Password, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProxyTypeSocks5)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A HTTP transparent proxy server
+type ProxyTypeHttp struct {
+	meta
+	// Username for logging in; may be empty
+	Username string `json:"username"`
+	// Password for logging in; may be empty
+	Password string `json:"password"`
+	// Pass true if the proxy supports only HTTP requests and doesn't support transparent TCP connections via HTTP CONNECT method
+	HttpOnly bool `json:"http_only"`
+}
+
+func (*ProxyTypeHttp) GetType() string {
+	return TypeProxyType
+}
+
+func (*ProxyTypeHttp) GetConstructor() string {
+	return ConstructorProxyTypeHttp
+}
+
+func (*ProxyTypeHttp) ProxyTypeConstructor() string {
+	return ConstructorProxyTypeHttp
+}
+
+func (entity *ProxyTypeHttp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ProxyTypeHttp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ProxyTypeHttp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"proxyTypeHttp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	encoder.writeRaw(`,"password":`)
+	encoder.writeString(entity.Password)
+	encoder.writeRaw(`,"http_only":`)
+	encoder.writeBool(entity.HttpOnly)
+	encoder.writeRaw("}")
+}
+
+func (entity *ProxyTypeHttp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		case "password":
+			entity.Password, err = decoder.readString()
+
+		case "http_only":
+			entity.HttpOnly, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProxyTypeHttp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An MTProto proxy server
+type ProxyTypeMtproto struct {
+	meta
+	// The proxy's secret in hexadecimal encoding
+	Secret string `json:"secret"`
+}
+
+func (*ProxyTypeMtproto) GetType() string {
+	return TypeProxyType
+}
+
+func (*ProxyTypeMtproto) GetConstructor() string {
+	return ConstructorProxyTypeMtproto
+}
+
+func (*ProxyTypeMtproto) ProxyTypeConstructor() string {
+	return ConstructorProxyTypeMtproto
+}
+
+func (entity *ProxyTypeMtproto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ProxyTypeMtproto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ProxyTypeMtproto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"proxyTypeMtproto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"secret":`)
+	encoder.writeString(entity.Secret)
+	encoder.writeRaw("}")
+}
+
+func (entity *ProxyTypeMtproto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "secret":
+			entity.Secret, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProxyTypeMtproto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a proxy server
+type Proxy struct {
+	meta
+	// Unique identifier of the proxy
+	Id int32 `json:"id"`
+	// Proxy server domain or IP address
+	Server string `json:"server"`
+	// Proxy server port
+	Port int32 `json:"port"`
+	// Point in time (Unix timestamp) when the proxy was last used; 0 if never
+	LastUsedDate int32 `json:"last_used_date"`
+	// True, if the proxy is enabled now
+	IsEnabled bool `json:"is_enabled"`
+	// Type of the proxy
+	Type ProxyType `json:"type"`
+}
+
+func (*Proxy) GetType() string {
+	return TypeProxy
+}
+
+func (*Proxy) GetConstructor() string {
+	return ConstructorProxy
+}
+
+func (entity *Proxy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Proxy) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Proxy) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"proxy"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"server":`)
+	encoder.writeString(entity.Server)
+	encoder.writeRaw(`,"port":`)
+	encoder.writeInt32(entity.Port)
+	encoder.writeRaw(`,"last_used_date":`)
+	encoder.writeInt32(entity.LastUsedDate)
+	encoder.writeRaw(`,"is_enabled":`)
+	encoder.writeBool(entity.IsEnabled)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *Proxy) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "server":
+			entity.Server, err = decoder.readString()
+
+		case "port":
+			entity.Port, err = decoder.readInt32()
+
+		case "last_used_date":
+			entity.LastUsedDate, err = decoder.readInt32()
+
+		case "is_enabled":
+			entity.IsEnabled, err = decoder.readBool()
+
+		case "type":
+			entity.Type, err = decodeProxyType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProxy)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of proxy servers
+type Proxies struct {
+	meta
+	// List of proxy servers
+	Proxies []*Proxy `json:"proxies"`
+}
+
+func (*Proxies) GetType() string {
+	return TypeProxies
+}
+
+func (*Proxies) GetConstructor() string {
+	return ConstructorProxies
+}
+
+func (entity *Proxies) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Proxies) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Proxies) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"proxies"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"proxies":`)
+	writeList(encoder, entity.Proxies, writeTypeValue[*Proxy])
+	encoder.writeRaw("}")
+}
+
+func (entity *Proxies) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "proxies":
+			entity.Proxies, err = readList(decoder, decodeEntity[Proxy])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProxies)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a date range
+type DateRange struct {
+	meta
+	// Point in time (Unix timestamp) at which the date range begins
+	StartDate int32 `json:"start_date"`
+	// Point in time (Unix timestamp) at which the date range ends
+	EndDate int32 `json:"end_date"`
+}
+
+func (*DateRange) GetType() string {
+	return TypeDateRange
+}
+
+func (*DateRange) GetConstructor() string {
+	return ConstructorDateRange
+}
+
+func (entity *DateRange) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DateRange) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DateRange) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"dateRange"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"start_date":`)
+	encoder.writeInt32(entity.StartDate)
+	encoder.writeRaw(`,"end_date":`)
+	encoder.writeInt32(entity.EndDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *DateRange) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "start_date":
+			entity.StartDate, err = decoder.readInt32()
+
+		case "end_date":
+			entity.EndDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDateRange)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A value with information about its recent changes
+type StatisticalValue struct {
+	meta
+	// The current value
+	Value float64 `json:"value"`
+	// The value for the previous day
+	PreviousValue float64 `json:"previous_value"`
+	// The growth rate of the value, as a percentage
+	GrowthRatePercentage float64 `json:"growth_rate_percentage"`
+}
+
+func (*StatisticalValue) GetType() string {
+	return TypeStatisticalValue
+}
+
+func (*StatisticalValue) GetConstructor() string {
+	return ConstructorStatisticalValue
+}
+
+func (entity *StatisticalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StatisticalValue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StatisticalValue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"statisticalValue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeFloat64(entity.Value)
+	encoder.writeRaw(`,"previous_value":`)
+	encoder.writeFloat64(entity.PreviousValue)
+	encoder.writeRaw(`,"growth_rate_percentage":`)
+	encoder.writeFloat64(entity.GrowthRatePercentage)
+	encoder.writeRaw("}")
+}
+
+func (entity *StatisticalValue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readFloat64()
+
+		case "previous_value":
+			entity.PreviousValue, err = decoder.readFloat64()
+
+		case "growth_rate_percentage":
+			entity.GrowthRatePercentage, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStatisticalValue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A graph data
+type StatisticalGraphData struct {
+	meta
+	// Graph data in JSON format
+	JsonData string `json:"json_data"`
+	// If non-empty, a token which can be used to receive a zoomed in graph
+	ZoomToken string `json:"zoom_token"`
+}
+
+func (*StatisticalGraphData) GetType() string {
+	return TypeStatisticalGraph
+}
+
+func (*StatisticalGraphData) GetConstructor() string {
+	return ConstructorStatisticalGraphData
+}
+
+func (*StatisticalGraphData) StatisticalGraphConstructor() string {
+	return ConstructorStatisticalGraphData
+}
+
+func (entity *StatisticalGraphData) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StatisticalGraphData) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StatisticalGraphData) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"statisticalGraphData"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"json_data":`)
+	encoder.writeString(entity.JsonData)
+	encoder.writeRaw(`,"zoom_token":`)
+	encoder.writeString(entity.ZoomToken)
+	encoder.writeRaw("}")
+}
+
+func (entity *StatisticalGraphData) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "json_data":
+			entity.JsonData, err = decoder.readString()
+
+		case "zoom_token":
+			entity.ZoomToken, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStatisticalGraphData)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The graph data to be asynchronously loaded through getStatisticalGraph
+type StatisticalGraphAsync struct {
+	meta
+	// The token to use for data loading
+	Token string `json:"token"`
+}
+
+func (*StatisticalGraphAsync) GetType() string {
+	return TypeStatisticalGraph
+}
+
+func (*StatisticalGraphAsync) GetConstructor() string {
+	return ConstructorStatisticalGraphAsync
+}
+
+func (*StatisticalGraphAsync) StatisticalGraphConstructor() string {
+	return ConstructorStatisticalGraphAsync
+}
+
+func (entity *StatisticalGraphAsync) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StatisticalGraphAsync) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StatisticalGraphAsync) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"statisticalGraphAsync"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"token":`)
+	encoder.writeString(entity.Token)
+	encoder.writeRaw("}")
+}
+
+func (entity *StatisticalGraphAsync) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "token":
+			entity.Token, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStatisticalGraphAsync)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An error message to be shown to the user instead of the graph
+type StatisticalGraphError struct {
+	meta
+	// The error message
+	ErrorMessage string `json:"error_message"`
+}
+
+func (*StatisticalGraphError) GetType() string {
+	return TypeStatisticalGraph
+}
+
+func (*StatisticalGraphError) GetConstructor() string {
+	return ConstructorStatisticalGraphError
+}
+
+func (*StatisticalGraphError) StatisticalGraphConstructor() string {
+	return ConstructorStatisticalGraphError
+}
+
+func (entity *StatisticalGraphError) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StatisticalGraphError) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StatisticalGraphError) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"statisticalGraphError"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"error_message":`)
+	encoder.writeString(entity.ErrorMessage)
+	encoder.writeRaw("}")
+}
+
+func (entity *StatisticalGraphError) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "error_message":
+			entity.ErrorMessage, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStatisticalGraphError)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A point on a Cartesian plane
+type Point struct {
+	meta
+	// The point's first coordinate
+	X float64 `json:"x"`
+	// The point's second coordinate
+	Y float64 `json:"y"`
+}
+
+func (*Point) GetType() string {
+	return TypePoint
+}
+
+func (*Point) GetConstructor() string {
+	return ConstructorPoint
+}
+
+func (entity *Point) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Point) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Point) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"point"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"x":`)
+	encoder.writeFloat64(entity.X)
+	encoder.writeRaw(`,"y":`)
+	encoder.writeFloat64(entity.Y)
+	encoder.writeRaw("}")
+}
+
+func (entity *Point) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "x":
+			entity.X, err = decoder.readFloat64()
+
+		case "y":
+			entity.Y, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPoint)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A straight line to a given point
+type VectorPathCommandLine struct {
+	meta
+	// The end point of the straight line
+	EndPoint *Point `json:"end_point"`
+}
+
+func (*VectorPathCommandLine) GetType() string {
+	return TypeVectorPathCommand
+}
+
+func (*VectorPathCommandLine) GetConstructor() string {
+	return ConstructorVectorPathCommandLine
+}
+
+func (*VectorPathCommandLine) VectorPathCommandConstructor() string {
+	return ConstructorVectorPathCommandLine
+}
+
+func (entity *VectorPathCommandLine) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *VectorPathCommandLine) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *VectorPathCommandLine) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"vectorPathCommandLine"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"end_point":`)
+	entity.EndPoint.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *VectorPathCommandLine) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "end_point":
+			entity.EndPoint, err = decodeEntity[Point](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVectorPathCommandLine)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A cubic Bézier curve to a given point
+type VectorPathCommandCubicBezierCurve struct {
+	meta
+	// The start control point of the curve
+	StartControlPoint *Point `json:"start_control_point"`
+	// The end control point of the curve
+	EndControlPoint *Point `json:"end_control_point"`
+	// The end point of the curve
+	EndPoint *Point `json:"end_point"`
+}
+
+func (*VectorPathCommandCubicBezierCurve) GetType() string {
+	return TypeVectorPathCommand
+}
+
+func (*VectorPathCommandCubicBezierCurve) GetConstructor() string {
+	return ConstructorVectorPathCommandCubicBezierCurve
+}
+
+func (*VectorPathCommandCubicBezierCurve) VectorPathCommandConstructor() string {
+	return ConstructorVectorPathCommandCubicBezierCurve
+}
+
+func (entity *VectorPathCommandCubicBezierCurve) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *VectorPathCommandCubicBezierCurve) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *VectorPathCommandCubicBezierCurve) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"vectorPathCommandCubicBezierCurve"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"start_control_point":`)
+	entity.StartControlPoint.encodeJSON(encoder)
+	encoder.writeRaw(`,"end_control_point":`)
+	entity.EndControlPoint.encodeJSON(encoder)
+	encoder.writeRaw(`,"end_point":`)
+	entity.EndPoint.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *VectorPathCommandCubicBezierCurve) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "start_control_point":
+			entity.StartControlPoint, err = decodeEntity[Point](decoder)
+
+		case "end_control_point":
+			entity.EndControlPoint, err = decodeEntity[Point](decoder)
+
+		case "end_point":
+			entity.EndPoint, err = decodeEntity[Point](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVectorPathCommandCubicBezierCurve)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Checks ownership of a new phone number to change the user's authentication phone number; for official Android and iOS applications only
+type PhoneNumberCodeTypeChange struct {
+	meta
+}
+
+func (*PhoneNumberCodeTypeChange) GetType() string {
+	return TypePhoneNumberCodeType
+}
+
+func (*PhoneNumberCodeTypeChange) GetConstructor() string {
+	return ConstructorPhoneNumberCodeTypeChange
+}
+
+func (*PhoneNumberCodeTypeChange) PhoneNumberCodeTypeConstructor() string {
+	return ConstructorPhoneNumberCodeTypeChange
+}
+
+func (entity *PhoneNumberCodeTypeChange) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PhoneNumberCodeTypeChange) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PhoneNumberCodeTypeChange) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"phoneNumberCodeTypeChange"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PhoneNumberCodeTypeChange) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPhoneNumberCodeTypeChange)
+	})
+}
+
+// Verifies ownership of a phone number to be added to the user's Telegram Passport
+type PhoneNumberCodeTypeVerify struct {
+	meta
+}
+
+func (*PhoneNumberCodeTypeVerify) GetType() string {
+	return TypePhoneNumberCodeType
+}
+
+func (*PhoneNumberCodeTypeVerify) GetConstructor() string {
+	return ConstructorPhoneNumberCodeTypeVerify
+}
+
+func (*PhoneNumberCodeTypeVerify) PhoneNumberCodeTypeConstructor() string {
+	return ConstructorPhoneNumberCodeTypeVerify
+}
+
+func (entity *PhoneNumberCodeTypeVerify) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PhoneNumberCodeTypeVerify) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PhoneNumberCodeTypeVerify) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"phoneNumberCodeTypeVerify"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PhoneNumberCodeTypeVerify) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPhoneNumberCodeTypeVerify)
+	})
+}
+
+// Confirms ownership of a phone number to prevent account deletion while handling links of the type internalLinkTypePhoneNumberConfirmation
+type PhoneNumberCodeTypeConfirmOwnership struct {
+	meta
+	// Hash value from the link
+	Hash string `json:"hash"`
+}
+
+func (*PhoneNumberCodeTypeConfirmOwnership) GetType() string {
+	return TypePhoneNumberCodeType
+}
+
+func (*PhoneNumberCodeTypeConfirmOwnership) GetConstructor() string {
+	return ConstructorPhoneNumberCodeTypeConfirmOwnership
+}
+
+func (*PhoneNumberCodeTypeConfirmOwnership) PhoneNumberCodeTypeConstructor() string {
+	return ConstructorPhoneNumberCodeTypeConfirmOwnership
+}
+
+func (entity *PhoneNumberCodeTypeConfirmOwnership) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PhoneNumberCodeTypeConfirmOwnership) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PhoneNumberCodeTypeConfirmOwnership) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"phoneNumberCodeTypeConfirmOwnership"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"hash":`)
+	encoder.writeString(entity.Hash)
+	encoder.writeRaw("}")
+}
+
+func (entity *PhoneNumberCodeTypeConfirmOwnership) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "hash":
+			entity.Hash, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPhoneNumberCodeTypeConfirmOwnership)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The log is written to stderr or an OS specific log
+type LogStreamDefault struct {
+	meta
+}
+
+func (*LogStreamDefault) GetType() string {
+	return TypeLogStream
+}
+
+func (*LogStreamDefault) GetConstructor() string {
+	return ConstructorLogStreamDefault
+}
+
+func (*LogStreamDefault) LogStreamConstructor() string {
+	return ConstructorLogStreamDefault
+}
+
+func (entity *LogStreamDefault) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LogStreamDefault) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LogStreamDefault) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"logStreamDefault"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LogStreamDefault) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLogStreamDefault)
+	})
+}
+
+// The log is written to a file
+type LogStreamFile struct {
+	meta
+	// Path to the file to where the internal TDLib log will be written
+	Path string `json:"path"`
+	// The maximum size of the file to where the internal TDLib log is written before the file will automatically be rotated, in bytes
+	MaxFileSize int64 `json:"max_file_size"`
+	// Pass true to additionally redirect stderr to the log file. Ignored on Windows
+	RedirectStderr bool `json:"redirect_stderr"`
+}
+
+func (*LogStreamFile) GetType() string {
+	return TypeLogStream
+}
+
+func (*LogStreamFile) GetConstructor() string {
+	return ConstructorLogStreamFile
+}
+
+func (*LogStreamFile) LogStreamConstructor() string {
+	return ConstructorLogStreamFile
+}
+
+func (entity *LogStreamFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LogStreamFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LogStreamFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"logStreamFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"path":`)
+	encoder.writeString(entity.Path)
+	encoder.writeRaw(`,"max_file_size":`)
+	encoder.writeInt64(entity.MaxFileSize)
+	encoder.writeRaw(`,"redirect_stderr":`)
+	encoder.writeBool(entity.RedirectStderr)
+	encoder.writeRaw("}")
+}
+
+func (entity *LogStreamFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "path":
+			entity.Path, err = decoder.readString()
+
+		case "max_file_size":
+			entity.MaxFileSize, err = decoder.readInt64()
+
+		case "redirect_stderr":
+			entity.RedirectStderr, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLogStreamFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The log is written nowhere
+type LogStreamEmpty struct {
+	meta
+}
+
+func (*LogStreamEmpty) GetType() string {
+	return TypeLogStream
+}
+
+func (*LogStreamEmpty) GetConstructor() string {
+	return ConstructorLogStreamEmpty
+}
+
+func (*LogStreamEmpty) LogStreamConstructor() string {
+	return ConstructorLogStreamEmpty
+}
+
+func (entity *LogStreamEmpty) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LogStreamEmpty) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LogStreamEmpty) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"logStreamEmpty"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *LogStreamEmpty) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorLogStreamEmpty)
+	})
+}
+
+// Contains a TDLib internal log verbosity level
+type LogVerbosityLevel struct {
+	meta
+	// Log verbosity level
+	VerbosityLevel int32 `json:"verbosity_level"`
+}
+
+func (*LogVerbosityLevel) GetType() string {
+	return TypeLogVerbosityLevel
+}
+
+func (*LogVerbosityLevel) GetConstructor() string {
+	return ConstructorLogVerbosityLevel
+}
+
+func (entity *LogVerbosityLevel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LogVerbosityLevel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LogVerbosityLevel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"logVerbosityLevel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"verbosity_level":`)
+	encoder.writeInt32(entity.VerbosityLevel)
+	encoder.writeRaw("}")
+}
+
+func (entity *LogVerbosityLevel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "verbosity_level":
+			entity.VerbosityLevel, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLogVerbosityLevel)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of available TDLib internal log tags
+type LogTags struct {
+	meta
+	// List of log tags
+	Tags []string `json:"tags"`
+}
+
+func (*LogTags) GetType() string {
+	return TypeLogTags
+}
+
+func (*LogTags) GetConstructor() string {
+	return ConstructorLogTags
+}
+
+func (entity *LogTags) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LogTags) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LogTags) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"logTags"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"tags":`)
+	writeList(encoder, entity.Tags, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *LogTags) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "tags":
+			entity.Tags, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLogTags)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A simple object containing a number; for testing only
+type TestInt struct {
+	meta
+	// Number
+	Value int32 `json:"value"`
+}
+
+func (*TestInt) GetType() string {
+	return TypeTestInt
+}
+
+func (*TestInt) GetConstructor() string {
+	return ConstructorTestInt
+}
+
+func (entity *TestInt) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TestInt) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TestInt) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testInt"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeInt32(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *TestInt) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTestInt)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A simple object containing a string; for testing only
+type TestString struct {
+	meta
+	// String
+	Value string `json:"value"`
+}
+
+func (*TestString) GetType() string {
+	return TypeTestString
+}
+
+func (*TestString) GetConstructor() string {
+	return ConstructorTestString
+}
+
+func (entity *TestString) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TestString) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TestString) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testString"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeString(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *TestString) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTestString)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A simple object containing a sequence of bytes; for testing only
+type TestBytes struct {
+	meta
+	// Bytes
+	Value []byte `json:"value"`
+}
+
+func (*TestBytes) GetType() string {
+	return TypeTestBytes
+}
+
+func (*TestBytes) GetConstructor() string {
+	return ConstructorTestBytes
+}
+
+func (entity *TestBytes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TestBytes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TestBytes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testBytes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeBytes(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *TestBytes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTestBytes)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A simple object containing a vector of numbers; for testing only
+type TestVectorInt struct {
+	meta
+	// Vector of numbers
+	Value []int32 `json:"value"`
+}
+
+func (*TestVectorInt) GetType() string {
+	return TypeTestVectorInt
+}
+
+func (*TestVectorInt) GetConstructor() string {
+	return ConstructorTestVectorInt
+}
+
+func (entity *TestVectorInt) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TestVectorInt) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TestVectorInt) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testVectorInt"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	writeList(encoder, entity.Value, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *TestVectorInt) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTestVectorInt)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A simple object containing a vector of objects that hold a number; for testing only
+type TestVectorIntObject struct {
+	meta
+	// Vector of objects
+	Value []*TestInt `json:"value"`
+}
+
+func (*TestVectorIntObject) GetType() string {
+	return TypeTestVectorIntObject
+}
+
+func (*TestVectorIntObject) GetConstructor() string {
+	return ConstructorTestVectorIntObject
+}
+
+func (entity *TestVectorIntObject) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TestVectorIntObject) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TestVectorIntObject) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testVectorIntObject"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	writeList(encoder, entity.Value, writeTypeValue[*TestInt])
+	encoder.writeRaw("}")
+}
+
+func (entity *TestVectorIntObject) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = readList(decoder, decodeEntity[TestInt])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTestVectorIntObject)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A simple object containing a vector of strings; for testing only
+type TestVectorString struct {
+	meta
+	// Vector of strings
+	Value []string `json:"value"`
+}
+
+func (*TestVectorString) GetType() string {
+	return TypeTestVectorString
+}
+
+func (*TestVectorString) GetConstructor() string {
+	return ConstructorTestVectorString
+}
+
+func (entity *TestVectorString) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TestVectorString) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TestVectorString) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testVectorString"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	writeList(encoder, entity.Value, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *TestVectorString) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTestVectorString)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A simple object containing a vector of objects that hold a string; for testing only
+type TestVectorStringObject struct {
+	meta
+	// Vector of objects
+	Value []*TestString `json:"value"`
+}
+
+func (*TestVectorStringObject) GetType() string {
+	return TypeTestVectorStringObject
+}
+
+func (*TestVectorStringObject) GetConstructor() string {
+	return ConstructorTestVectorStringObject
+}
+
+func (entity *TestVectorStringObject) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TestVectorStringObject) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TestVectorStringObject) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"testVectorStringObject"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"value":`)
+	writeList(encoder, entity.Value, writeTypeValue[*TestString])
+	encoder.writeRaw("}")
+}
+
+func (entity *TestVectorStringObject) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "value":
+			entity.Value, err = readList(decoder, decodeEntity[TestString])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTestVectorStringObject)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
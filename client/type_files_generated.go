@@ -0,0 +1,4766 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeInputFile                   = "InputFile"
+	TypeThumbnailFormat             = "ThumbnailFormat"
+	TypeBackgroundFill              = "BackgroundFill"
+	TypeBackgroundType              = "BackgroundType"
+	TypeInputBackground             = "InputBackground"
+	TypeFileType                    = "FileType"
+	TypeLocalFile                   = "LocalFile"
+	TypeRemoteFile                  = "RemoteFile"
+	TypeFile                        = "File"
+	TypePhotoSize                   = "PhotoSize"
+	TypeThumbnail                   = "Thumbnail"
+	TypeAnimation                   = "Animation"
+	TypeAudio                       = "Audio"
+	TypeDocument                    = "Document"
+	TypePhoto                       = "Photo"
+	TypeVideo                       = "Video"
+	TypeVideoNote                   = "VideoNote"
+	TypeVoiceNote                   = "VoiceNote"
+	TypeAlternativeVideo            = "AlternativeVideo"
+	TypeBackground                  = "Background"
+	TypeBackgrounds                 = "Backgrounds"
+	TypeFileDownload                = "FileDownload"
+	TypeDownloadedFileCounts        = "DownloadedFileCounts"
+	TypeFoundFileDownloads          = "FoundFileDownloads"
+	TypeDatedFile                   = "DatedFile"
+	TypeIdentityDocument            = "IdentityDocument"
+	TypeInputIdentityDocument       = "InputIdentityDocument"
+	TypePersonalDocument            = "PersonalDocument"
+	TypeInputPersonalDocument       = "InputPersonalDocument"
+	TypeInputThumbnail              = "InputThumbnail"
+	TypeAnimations                  = "Animations"
+	TypeFilePart                    = "FilePart"
+	TypeStorageStatisticsByFileType = "StorageStatisticsByFileType"
+	TypeAutoDownloadSettings        = "AutoDownloadSettings"
+	TypeAutoDownloadSettingsPresets = "AutoDownloadSettingsPresets"
+	TypeFileDownloadedPrefixSize    = "FileDownloadedPrefixSize"
+)
+
+const (
+	ConstructorLocalFile                        = "localFile"
+	ConstructorRemoteFile                       = "remoteFile"
+	ConstructorFile                             = "file"
+	ConstructorInputFileId                      = "inputFileId"
+	ConstructorInputFileRemote                  = "inputFileRemote"
+	ConstructorInputFileLocal                   = "inputFileLocal"
+	ConstructorInputFileGenerated               = "inputFileGenerated"
+	ConstructorPhotoSize                        = "photoSize"
+	ConstructorThumbnailFormatJpeg              = "thumbnailFormatJpeg"
+	ConstructorThumbnailFormatGif               = "thumbnailFormatGif"
+	ConstructorThumbnailFormatMpeg4             = "thumbnailFormatMpeg4"
+	ConstructorThumbnailFormatPng               = "thumbnailFormatPng"
+	ConstructorThumbnailFormatTgs               = "thumbnailFormatTgs"
+	ConstructorThumbnailFormatWebm              = "thumbnailFormatWebm"
+	ConstructorThumbnailFormatWebp              = "thumbnailFormatWebp"
+	ConstructorThumbnail                        = "thumbnail"
+	ConstructorAnimation                        = "animation"
+	ConstructorAudio                            = "audio"
+	ConstructorDocument                         = "document"
+	ConstructorPhoto                            = "photo"
+	ConstructorVideo                            = "video"
+	ConstructorVideoNote                        = "videoNote"
+	ConstructorVoiceNote                        = "voiceNote"
+	ConstructorAlternativeVideo                 = "alternativeVideo"
+	ConstructorBackground                       = "background"
+	ConstructorBackgrounds                      = "backgrounds"
+	ConstructorFileDownload                     = "fileDownload"
+	ConstructorDownloadedFileCounts             = "downloadedFileCounts"
+	ConstructorFoundFileDownloads               = "foundFileDownloads"
+	ConstructorDatedFile                        = "datedFile"
+	ConstructorIdentityDocument                 = "identityDocument"
+	ConstructorInputIdentityDocument            = "inputIdentityDocument"
+	ConstructorPersonalDocument                 = "personalDocument"
+	ConstructorInputPersonalDocument            = "inputPersonalDocument"
+	ConstructorInputThumbnail                   = "inputThumbnail"
+	ConstructorAnimations                       = "animations"
+	ConstructorBackgroundFillSolid              = "backgroundFillSolid"
+	ConstructorBackgroundFillGradient           = "backgroundFillGradient"
+	ConstructorBackgroundFillFreeformGradient   = "backgroundFillFreeformGradient"
+	ConstructorBackgroundTypeWallpaper          = "backgroundTypeWallpaper"
+	ConstructorBackgroundTypePattern            = "backgroundTypePattern"
+	ConstructorBackgroundTypeFill               = "backgroundTypeFill"
+	ConstructorBackgroundTypeChatTheme          = "backgroundTypeChatTheme"
+	ConstructorInputBackgroundLocal             = "inputBackgroundLocal"
+	ConstructorInputBackgroundRemote            = "inputBackgroundRemote"
+	ConstructorInputBackgroundPrevious          = "inputBackgroundPrevious"
+	ConstructorFilePart                         = "filePart"
+	ConstructorFileTypeNone                     = "fileTypeNone"
+	ConstructorFileTypeAnimation                = "fileTypeAnimation"
+	ConstructorFileTypeAudio                    = "fileTypeAudio"
+	ConstructorFileTypeDocument                 = "fileTypeDocument"
+	ConstructorFileTypeNotificationSound        = "fileTypeNotificationSound"
+	ConstructorFileTypePhoto                    = "fileTypePhoto"
+	ConstructorFileTypePhotoStory               = "fileTypePhotoStory"
+	ConstructorFileTypeProfilePhoto             = "fileTypeProfilePhoto"
+	ConstructorFileTypeSecret                   = "fileTypeSecret"
+	ConstructorFileTypeSecretThumbnail          = "fileTypeSecretThumbnail"
+	ConstructorFileTypeSecure                   = "fileTypeSecure"
+	ConstructorFileTypeSelfDestructingPhoto     = "fileTypeSelfDestructingPhoto"
+	ConstructorFileTypeSelfDestructingVideo     = "fileTypeSelfDestructingVideo"
+	ConstructorFileTypeSelfDestructingVideoNote = "fileTypeSelfDestructingVideoNote"
+	ConstructorFileTypeSelfDestructingVoiceNote = "fileTypeSelfDestructingVoiceNote"
+	ConstructorFileTypeSticker                  = "fileTypeSticker"
+	ConstructorFileTypeThumbnail                = "fileTypeThumbnail"
+	ConstructorFileTypeUnknown                  = "fileTypeUnknown"
+	ConstructorFileTypeVideo                    = "fileTypeVideo"
+	ConstructorFileTypeVideoNote                = "fileTypeVideoNote"
+	ConstructorFileTypeVideoStory               = "fileTypeVideoStory"
+	ConstructorFileTypeVoiceNote                = "fileTypeVoiceNote"
+	ConstructorFileTypeWallpaper                = "fileTypeWallpaper"
+	ConstructorStorageStatisticsByFileType      = "storageStatisticsByFileType"
+	ConstructorAutoDownloadSettings             = "autoDownloadSettings"
+	ConstructorAutoDownloadSettingsPresets      = "autoDownloadSettingsPresets"
+	ConstructorFileDownloadedPrefixSize         = "fileDownloadedPrefixSize"
+)
+
+// Points to a file
+type InputFile interface {
+	InputFileConstructor() string
+}
+
+// Describes format of a thumbnail
+type ThumbnailFormat interface {
+	ThumbnailFormatConstructor() string
+}
+
+// Describes a fill of a background
+type BackgroundFill interface {
+	BackgroundFillConstructor() string
+}
+
+// Describes the type of background
+type BackgroundType interface {
+	BackgroundTypeConstructor() string
+}
+
+// Contains information about background to set
+type InputBackground interface {
+	InputBackgroundConstructor() string
+}
+
+// Represents the type of file
+type FileType interface {
+	FileTypeConstructor() string
+}
+
+func (entity *UnknownType) InputFileConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ThumbnailFormatConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) BackgroundFillConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) BackgroundTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputBackgroundConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) FileTypeConstructor() string {
+	return entity.Constructor
+}
+
+// Represents a local file
+type LocalFile struct {
+	meta
+	// Local path to the locally available file part; may be empty
+	Path string `json:"path"`
+	// True, if it is possible to download or generate the file
+	CanBeDownloaded bool `json:"can_be_downloaded"`
+	// True, if the file can be deleted
+	CanBeDeleted bool `json:"can_be_deleted"`
+	// True, if the file is currently being downloaded (or a local copy is being generated by some other means)
+	IsDownloadingActive bool `json:"is_downloading_active"`
+	// True, if the local copy is fully available
+	IsDownloadingCompleted bool `json:"is_downloading_completed"`
+	// Download will be started from this offset. downloaded_prefix_size is calculated from this offset
+	DownloadOffset int64 `json:"download_offset"`
+	// If is_downloading_completed is false, then only some prefix of the file starting from download_offset is ready to be read. downloaded_prefix_size is the size of that prefix in bytes
+	DownloadedPrefixSize int64 `json:"downloaded_prefix_size"`
+	// Total downloaded file size, in bytes. Can be used only for calculating download progress. The actual file size may be bigger, and some parts of it may contain garbage
+	DownloadedSize int64 `json:"downloaded_size"`
+}
+
+func (*LocalFile) GetType() string {
+	return TypeLocalFile
+}
+
+func (*LocalFile) GetConstructor() string {
+	return ConstructorLocalFile
+}
+
+func (entity *LocalFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *LocalFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *LocalFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"localFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"path":`)
+	encoder.writeString(entity.Path)
+	encoder.writeRaw(`,"can_be_downloaded":`)
+	encoder.writeBool(entity.CanBeDownloaded)
+	encoder.writeRaw(`,"can_be_deleted":`)
+	encoder.writeBool(entity.CanBeDeleted)
+	encoder.writeRaw(`,"is_downloading_active":`)
+	encoder.writeBool(entity.IsDownloadingActive)
+	encoder.writeRaw(`,"is_downloading_completed":`)
+	encoder.writeBool(entity.IsDownloadingCompleted)
+	encoder.writeRaw(`,"download_offset":`)
+	encoder.writeInt64(entity.DownloadOffset)
+	encoder.writeRaw(`,"downloaded_prefix_size":`)
+	encoder.writeInt64(entity.DownloadedPrefixSize)
+	encoder.writeRaw(`,"downloaded_size":`)
+	encoder.writeInt64(entity.DownloadedSize)
+	encoder.writeRaw("}")
+}
+
+func (entity *LocalFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "path":
+			entity.Path, err = decoder.readString()
+
+		case "can_be_downloaded":
+			entity.CanBeDownloaded, err = decoder.readBool()
+
+		case "can_be_deleted":
+			entity.CanBeDeleted, err = decoder.readBool()
+
+		case "is_downloading_active":
+			entity.IsDownloadingActive, err = decoder.readBool()
+
+		case "is_downloading_completed":
+			entity.IsDownloadingCompleted, err = decoder.readBool()
+
+		case "download_offset":
+			entity.DownloadOffset, err = decoder.readInt64()
+
+		case "downloaded_prefix_size":
+			entity.DownloadedPrefixSize, err = decoder.readInt64()
+
+		case "downloaded_size":
+			entity.DownloadedSize, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorLocalFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a remote file
+type RemoteFile struct {
+	meta
+	// Remote file identifier; may be empty. Can be used by the current user across application restarts or even from other devices. Uniquely identifies a file, but a file can have a lot of different valid identifiers. If the identifier starts with "http://" or "https://", it represents the HTTP URL of the file. TDLib is currently unable to download files if only their URL is known. If downloadFile/addFileToDownloads is called on such a file or if it is sent to a secret chat, TDLib starts a file generation process by sending updateFileGenerationStart to the application with the HTTP URL in the original_path and "#url#" as the conversion string. Application must generate the file by downloading it to the specified location
+	Id string `json:"id"`
+	// Unique file identifier; may be empty if unknown. The unique file identifier which is the same for the same file even for different users and is persistent over time
+	UniqueId string `json:"unique_id"`
+	// True, if the file is currently being uploaded (or a remote copy is being generated by some other means)
+	IsUploadingActive bool `json:"is_uploading_active"`
+	// True, if a remote copy is fully available
+	IsUploadingCompleted bool `json:"is_uploading_completed"`
+	// Size of the remote available part of the file, in bytes; 0 if unknown
+	UploadedSize int64 `json:"uploaded_size"`
+}
+
+func (*RemoteFile) GetType() string {
+	return TypeRemoteFile
+}
+
+func (*RemoteFile) GetConstructor() string {
+	return ConstructorRemoteFile
+}
+
+func (entity *RemoteFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RemoteFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RemoteFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"remoteFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"unique_id":`)
+	encoder.writeString(entity.UniqueId)
+	encoder.writeRaw(`,"is_uploading_active":`)
+	encoder.writeBool(entity.IsUploadingActive)
+	encoder.writeRaw(`,"is_uploading_completed":`)
+	encoder.writeBool(entity.IsUploadingCompleted)
+	encoder.writeRaw(`,"uploaded_size":`)
+	encoder.writeInt64(entity.UploadedSize)
+	encoder.writeRaw("}")
+}
+
+func (entity *RemoteFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "unique_id":
+			entity.UniqueId, err = decoder.readString()
+
+		case "is_uploading_active":
+			entity.IsUploadingActive, err = decoder.readBool()
+
+		case "is_uploading_completed":
+			entity.IsUploadingCompleted, err = decoder.readBool()
+
+		case "uploaded_size":
+			entity.UploadedSize, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRemoteFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a file
+type File struct {
+	meta
+	// Unique file identifier
+	Id int32 `json:"id"`
+	// File size, in bytes; 0 if unknown
+	Size int64 `json:"size"`
+	// Approximate file size in bytes in case the exact file size is unknown. Can be used to show download/upload progress
+	ExpectedSize int64 `json:"expected_size"`
+	// Information about the local copy of the file
+	Local *LocalFile `json:"local"`
+	// Information about the remote copy of the file
+	Remote *RemoteFile `json:"remote"`
+}
+
+func (*File) GetType() string {
+	return TypeFile
+}
+
+func (*File) GetConstructor() string {
+	return ConstructorFile
+}
+
+func (entity *File) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *File) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *File) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"file"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"size":`)
+	encoder.writeInt64(entity.Size)
+	encoder.writeRaw(`,"expected_size":`)
+	encoder.writeInt64(entity.ExpectedSize)
+	encoder.writeRaw(`,"local":`)
+	entity.Local.encodeJSON(encoder)
+	encoder.writeRaw(`,"remote":`)
+	entity.Remote.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *File) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "size":
+			entity.Size, err = decoder.readInt64()
+
+		case "expected_size":
+			entity.ExpectedSize, err = decoder.readInt64()
+
+		case "local":
+			entity.Local, err = decodeEntity[LocalFile](decoder)
+
+		case "remote":
+			entity.Remote, err = decodeEntity[RemoteFile](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A file defined by its unique identifier
+type InputFileId struct {
+	meta
+	// Unique file identifier
+	Id int32 `json:"id"`
+}
+
+func (*InputFileId) GetType() string {
+	return TypeInputFile
+}
+
+func (*InputFileId) GetConstructor() string {
+	return ConstructorInputFileId
+}
+
+func (*InputFileId) InputFileConstructor() string {
+	return ConstructorInputFileId
+}
+
+func (entity *InputFileId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputFileId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputFileId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputFileId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputFileId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputFileId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A file defined by its remote identifier. The remote identifier is guaranteed to be usable only if the corresponding file is still accessible to the user and known to TDLib. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application
+type InputFileRemote struct {
+	meta
+	// Remote file identifier
+	Id string `json:"id"`
+}
+
+func (*InputFileRemote) GetType() string {
+	return TypeInputFile
+}
+
+func (*InputFileRemote) GetConstructor() string {
+	return ConstructorInputFileRemote
+}
+
+func (*InputFileRemote) InputFileConstructor() string {
+	return ConstructorInputFileRemote
+}
+
+func (entity *InputFileRemote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputFileRemote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputFileRemote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputFileRemote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputFileRemote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputFileRemote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A file defined by a local path
+type InputFileLocal struct {
+	meta
+	// Local path to the file
+	Path string `json:"path"`
+}
+
+func (*InputFileLocal) GetType() string {
+	return TypeInputFile
+}
+
+func (*InputFileLocal) GetConstructor() string {
+	return ConstructorInputFileLocal
+}
+
+func (*InputFileLocal) InputFileConstructor() string {
+	return ConstructorInputFileLocal
+}
+
+func (entity *InputFileLocal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputFileLocal) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputFileLocal) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputFileLocal"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"path":`)
+	encoder.writeString(entity.Path)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputFileLocal) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "path":
+			entity.Path, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputFileLocal)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A file generated by the application. The application must handle updates updateFileGenerationStart and updateFileGenerationStop to generate the file when asked by TDLib
+type InputFileGenerated struct {
+	meta
+	// Local path to a file from which the file is generated. The path doesn't have to be a valid path and is used by TDLib only to detect name and MIME type of the generated file
+	OriginalPath string `json:"original_path"`
+	// String specifying the conversion applied to the original file; must be persistent across application restarts. Conversions beginning with '#' are reserved for internal TDLib usage
+	Conversion string `json:"conversion"`
+	// Expected size of the generated file, in bytes; pass 0 if unknown
+	ExpectedSize int64 `json:"expected_size"`
+}
+
+func (*InputFileGenerated) GetType() string {
+	return TypeInputFile
+}
+
+func (*InputFileGenerated) GetConstructor() string {
+	return ConstructorInputFileGenerated
+}
+
+func (*InputFileGenerated) InputFileConstructor() string {
+	return ConstructorInputFileGenerated
+}
+
+func (entity *InputFileGenerated) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputFileGenerated) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputFileGenerated) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputFileGenerated"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"original_path":`)
+	encoder.writeString(entity.OriginalPath)
+	encoder.writeRaw(`,"conversion":`)
+	encoder.writeString(entity.Conversion)
+	encoder.writeRaw(`,"expected_size":`)
+	encoder.writeInt64(entity.ExpectedSize)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputFileGenerated) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "original_path":
+			entity.OriginalPath, err = decoder.readString()
+
+		case "conversion":
+			entity.Conversion, err = decoder.readString()
+
+		case "expected_size":
+			entity.ExpectedSize, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputFileGenerated)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an image in JPEG format
+type PhotoSize struct {
+	meta
+	// Image type (see https://core.telegram.org/constructor/photoSize)
+	Type string `json:"type"`
+	// Information about the image file
+	Photo *File `json:"photo"`
+	// Image width
+	Width int32 `json:"width"`
+	// Image height
+	Height int32 `json:"height"`
+	// Sizes of progressive JPEG file prefixes, which can be used to preliminarily show the image; in bytes
+	ProgressiveSizes []int32 `json:"progressive_sizes"`
+}
+
+func (*PhotoSize) GetType() string {
+	return TypePhotoSize
+}
+
+func (*PhotoSize) GetConstructor() string {
+	return ConstructorPhotoSize
+}
+
+func (entity *PhotoSize) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PhotoSize) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PhotoSize) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"photoSize"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeString(entity.Type)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"progressive_sizes":`)
+	writeList(encoder, entity.ProgressiveSizes, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *PhotoSize) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[File](decoder)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "progressive_sizes":
+			entity.ProgressiveSizes, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPhotoSize)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The thumbnail is in JPEG format
+type ThumbnailFormatJpeg struct {
+	meta
+}
+
+func (*ThumbnailFormatJpeg) GetType() string {
+	return TypeThumbnailFormat
+}
+
+func (*ThumbnailFormatJpeg) GetConstructor() string {
+	return ConstructorThumbnailFormatJpeg
+}
+
+func (*ThumbnailFormatJpeg) ThumbnailFormatConstructor() string {
+	return ConstructorThumbnailFormatJpeg
+}
+
+func (entity *ThumbnailFormatJpeg) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThumbnailFormatJpeg) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThumbnailFormatJpeg) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnailFormatJpeg"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThumbnailFormatJpeg) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorThumbnailFormatJpeg)
+	})
+}
+
+// The thumbnail is in static GIF format. It will be used only for some bot inline query results
+type ThumbnailFormatGif struct {
+	meta
+}
+
+func (*ThumbnailFormatGif) GetType() string {
+	return TypeThumbnailFormat
+}
+
+func (*ThumbnailFormatGif) GetConstructor() string {
+	return ConstructorThumbnailFormatGif
+}
+
+func (*ThumbnailFormatGif) ThumbnailFormatConstructor() string {
+	return ConstructorThumbnailFormatGif
+}
+
+func (entity *ThumbnailFormatGif) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThumbnailFormatGif) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThumbnailFormatGif) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnailFormatGif"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThumbnailFormatGif) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorThumbnailFormatGif)
+	})
+}
+
+// The thumbnail is in MPEG4 format. It will be used only for some animations and videos
+type ThumbnailFormatMpeg4 struct {
+	meta
+}
+
+func (*ThumbnailFormatMpeg4) GetType() string {
+	return TypeThumbnailFormat
+}
+
+func (*ThumbnailFormatMpeg4) GetConstructor() string {
+	return ConstructorThumbnailFormatMpeg4
+}
+
+func (*ThumbnailFormatMpeg4) ThumbnailFormatConstructor() string {
+	return ConstructorThumbnailFormatMpeg4
+}
+
+func (entity *ThumbnailFormatMpeg4) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThumbnailFormatMpeg4) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThumbnailFormatMpeg4) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnailFormatMpeg4"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThumbnailFormatMpeg4) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorThumbnailFormatMpeg4)
+	})
+}
+
+// The thumbnail is in PNG format. It will be used only for background patterns
+type ThumbnailFormatPng struct {
+	meta
+}
+
+func (*ThumbnailFormatPng) GetType() string {
+	return TypeThumbnailFormat
+}
+
+func (*ThumbnailFormatPng) GetConstructor() string {
+	return ConstructorThumbnailFormatPng
+}
+
+func (*ThumbnailFormatPng) ThumbnailFormatConstructor() string {
+	return ConstructorThumbnailFormatPng
+}
+
+func (entity *ThumbnailFormatPng) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThumbnailFormatPng) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThumbnailFormatPng) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnailFormatPng"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThumbnailFormatPng) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorThumbnailFormatPng)
+	})
+}
+
+// The thumbnail is in TGS format. It will be used only for sticker sets
+type ThumbnailFormatTgs struct {
+	meta
+}
+
+func (*ThumbnailFormatTgs) GetType() string {
+	return TypeThumbnailFormat
+}
+
+func (*ThumbnailFormatTgs) GetConstructor() string {
+	return ConstructorThumbnailFormatTgs
+}
+
+func (*ThumbnailFormatTgs) ThumbnailFormatConstructor() string {
+	return ConstructorThumbnailFormatTgs
+}
+
+func (entity *ThumbnailFormatTgs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThumbnailFormatTgs) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThumbnailFormatTgs) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnailFormatTgs"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThumbnailFormatTgs) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorThumbnailFormatTgs)
+	})
+}
+
+// The thumbnail is in WEBM format. It will be used only for sticker sets
+type ThumbnailFormatWebm struct {
+	meta
+}
+
+func (*ThumbnailFormatWebm) GetType() string {
+	return TypeThumbnailFormat
+}
+
+func (*ThumbnailFormatWebm) GetConstructor() string {
+	return ConstructorThumbnailFormatWebm
+}
+
+func (*ThumbnailFormatWebm) ThumbnailFormatConstructor() string {
+	return ConstructorThumbnailFormatWebm
+}
+
+func (entity *ThumbnailFormatWebm) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThumbnailFormatWebm) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThumbnailFormatWebm) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnailFormatWebm"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThumbnailFormatWebm) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorThumbnailFormatWebm)
+	})
+}
+
+// The thumbnail is in WEBP format. It will be used only for some stickers and sticker sets
+type ThumbnailFormatWebp struct {
+	meta
+}
+
+func (*ThumbnailFormatWebp) GetType() string {
+	return TypeThumbnailFormat
+}
+
+func (*ThumbnailFormatWebp) GetConstructor() string {
+	return ConstructorThumbnailFormatWebp
+}
+
+func (*ThumbnailFormatWebp) ThumbnailFormatConstructor() string {
+	return ConstructorThumbnailFormatWebp
+}
+
+func (entity *ThumbnailFormatWebp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ThumbnailFormatWebp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ThumbnailFormatWebp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnailFormatWebp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ThumbnailFormatWebp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorThumbnailFormatWebp)
+	})
+}
+
+// Represents a thumbnail
+type Thumbnail struct {
+	meta
+	// Thumbnail format
+	Format ThumbnailFormat `json:"format"`
+	// Thumbnail width
+	Width int32 `json:"width"`
+	// Thumbnail height
+	Height int32 `json:"height"`
+	// The thumbnail
+	File *File `json:"file"`
+}
+
+func (*Thumbnail) GetType() string {
+	return TypeThumbnail
+}
+
+func (*Thumbnail) GetConstructor() string {
+	return ConstructorThumbnail
+}
+
+func (entity *Thumbnail) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Thumbnail) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Thumbnail) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"thumbnail"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"format":`)
+	encoder.writeType(entity.Format)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"file":`)
+	entity.File.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *Thumbnail) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "format":
+			entity.Format, err = decodeThumbnailFormat(decoder)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "file":
+			entity.File, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorThumbnail)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an animation file. The animation must be encoded in GIF or MPEG4 format
+type Animation struct {
+	meta
+	// Duration of the animation, in seconds; as defined by the sender
+	Duration int32 `json:"duration"`
+	// Width of the animation
+	Width int32 `json:"width"`
+	// Height of the animation
+	Height int32 `json:"height"`
+	// Original name of the file; as defined by the sender
+	FileName string `json:"file_name"`
+	// MIME type of the file, usually "image/gif" or "video/mp4"
+	MimeType string `json:"mime_type"`
+	// True, if stickers were added to the animation. The list of corresponding sticker set can be received using getAttachedStickerSets
+	HasStickers bool `json:"has_stickers"`
+	// Animation minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// Animation thumbnail in JPEG or MPEG4 format; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// File containing the animation
+	Animation *File `json:"animation"`
+}
+
+func (*Animation) GetType() string {
+	return TypeAnimation
+}
+
+func (*Animation) GetConstructor() string {
+	return ConstructorAnimation
+}
+
+func (entity *Animation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Animation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Animation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"animation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"file_name":`)
+	encoder.writeString(entity.FileName)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	encoder.writeRaw(`,"has_stickers":`)
+	encoder.writeBool(entity.HasStickers)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"animation":`)
+	entity.Animation.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *Animation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "file_name":
+			entity.FileName, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "has_stickers":
+			entity.HasStickers, err = decoder.readBool()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "animation":
+			entity.Animation, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an audio file. Audio is usually in MP3 or M4A format
+type Audio struct {
+	meta
+	// Duration of the audio, in seconds; as defined by the sender
+	Duration int32 `json:"duration"`
+	// Title of the audio; as defined by the sender
+	Title string `json:"title"`
+	// Performer of the audio; as defined by the sender
+	Performer string `json:"performer"`
+	// Original name of the file; as defined by the sender
+	FileName string `json:"file_name"`
+	// The MIME type of the file; as defined by the sender
+	MimeType string `json:"mime_type"`
+	// The minithumbnail of the album cover; may be null
+	AlbumCoverMinithumbnail *Minithumbnail `json:"album_cover_minithumbnail,omitempty"`
+	// The thumbnail of the album cover in JPEG format; as defined by the sender. The full size thumbnail is expected to be extracted from the downloaded audio file; may be null
+	AlbumCoverThumbnail *Thumbnail `json:"album_cover_thumbnail,omitempty"`
+	// Album cover variants to use if the downloaded audio file contains no album cover. Provided thumbnail dimensions are approximate
+	ExternalAlbumCovers []*Thumbnail `json:"external_album_covers"`
+	// File containing the audio
+	Audio *File `json:"audio"`
+}
+
+func (*Audio) GetType() string {
+	return TypeAudio
+}
+
+func (*Audio) GetConstructor() string {
+	return ConstructorAudio
+}
+
+func (entity *Audio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Audio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Audio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"audio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"performer":`)
+	encoder.writeString(entity.Performer)
+	encoder.writeRaw(`,"file_name":`)
+	encoder.writeString(entity.FileName)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	if entity.AlbumCoverMinithumbnail != nil {
+		encoder.writeRaw(`,"album_cover_minithumbnail":`)
+		entity.AlbumCoverMinithumbnail.encodeJSON(encoder)
+	}
+	if entity.AlbumCoverThumbnail != nil {
+		encoder.writeRaw(`,"album_cover_thumbnail":`)
+		entity.AlbumCoverThumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"external_album_covers":`)
+	writeList(encoder, entity.ExternalAlbumCovers, writeTypeValue[*Thumbnail])
+	encoder.writeRaw(`,"audio":`)
+	entity.Audio.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *Audio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "performer":
+			entity.Performer, err = decoder.readString()
+
+		case "file_name":
+			entity.FileName, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "album_cover_minithumbnail":
+			entity.AlbumCoverMinithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "album_cover_thumbnail":
+			entity.AlbumCoverThumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "external_album_covers":
+			entity.ExternalAlbumCovers, err = readList(decoder, decodeEntity[Thumbnail])
+
+		case "audio":
+			entity.Audio, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a document of any type
+type Document struct {
+	meta
+	// Original name of the file; as defined by the sender
+	FileName string `json:"file_name"`
+	// MIME type of the file; as defined by the sender
+	MimeType string `json:"mime_type"`
+	// Document minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// Document thumbnail in JPEG or PNG format (PNG will be used only for background patterns); as defined by the sender; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// File containing the document
+	Document *File `json:"document"`
+}
+
+func (*Document) GetType() string {
+	return TypeDocument
+}
+
+func (*Document) GetConstructor() string {
+	return ConstructorDocument
+}
+
+func (entity *Document) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Document) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Document) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"document"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_name":`)
+	encoder.writeString(entity.FileName)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"document":`)
+	entity.Document.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *Document) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_name":
+			entity.FileName, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "document":
+			entity.Document, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a photo
+type Photo struct {
+	meta
+	// True, if stickers were added to the photo. The list of corresponding sticker sets can be received using getAttachedStickerSets
+	HasStickers bool `json:"has_stickers"`
+	// Photo minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// Available variants of the photo, in different sizes
+	Sizes []*PhotoSize `json:"sizes"`
+}
+
+func (*Photo) GetType() string {
+	return TypePhoto
+}
+
+func (*Photo) GetConstructor() string {
+	return ConstructorPhoto
+}
+
+func (entity *Photo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Photo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Photo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"photo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"has_stickers":`)
+	encoder.writeBool(entity.HasStickers)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"sizes":`)
+	writeList(encoder, entity.Sizes, writeTypeValue[*PhotoSize])
+	encoder.writeRaw("}")
+}
+
+func (entity *Photo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "has_stickers":
+			entity.HasStickers, err = decoder.readBool()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "sizes":
+			entity.Sizes, err = readList(decoder, decodeEntity[PhotoSize])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a video file
+type Video struct {
+	meta
+	// Duration of the video, in seconds; as defined by the sender
+	Duration int32 `json:"duration"`
+	// Video width; as defined by the sender
+	Width int32 `json:"width"`
+	// Video height; as defined by the sender
+	Height int32 `json:"height"`
+	// Original name of the file; as defined by the sender
+	FileName string `json:"file_name"`
+	// MIME type of the file; as defined by the sender
+	MimeType string `json:"mime_type"`
+	// True, if stickers were added to the video. The list of corresponding sticker sets can be received using getAttachedStickerSets
+	HasStickers bool `json:"has_stickers"`
+	// True, if the video is expected to be streamed
+	SupportsStreaming bool `json:"supports_streaming"`
+	// Video minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// Video thumbnail in JPEG or MPEG4 format; as defined by the sender; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// File containing the video
+	Video *File `json:"video"`
+}
+
+func (*Video) GetType() string {
+	return TypeVideo
+}
+
+func (*Video) GetConstructor() string {
+	return ConstructorVideo
+}
+
+func (entity *Video) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Video) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Video) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"video"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"file_name":`)
+	encoder.writeString(entity.FileName)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	encoder.writeRaw(`,"has_stickers":`)
+	encoder.writeBool(entity.HasStickers)
+	encoder.writeRaw(`,"supports_streaming":`)
+	encoder.writeBool(entity.SupportsStreaming)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *Video) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "file_name":
+			entity.FileName, err = decoder.readString()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "has_stickers":
+			entity.HasStickers, err = decoder.readBool()
+
+		case "supports_streaming":
+			entity.SupportsStreaming, err = decoder.readBool()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "video":
+			entity.Video, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a video note. The video must be equal in width and height, cropped to a circle, and stored in MPEG4 format
+type VideoNote struct {
+	meta
+	// Duration of the video, in seconds; as defined by the sender
+	Duration int32 `json:"duration"`
+	// A waveform representation of the video note's audio in 5-bit format; may be empty if unknown
+	Waveform []byte `json:"waveform"`
+	// Video width and height; as defined by the sender
+	Length int32 `json:"length"`
+	// Video minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// Video thumbnail in JPEG format; as defined by the sender; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// Result of speech recognition in the video note; may be null
+	SpeechRecognitionResult SpeechRecognitionResult `json:"speech_recognition_result,omitempty"`
+	// File containing the video
+	Video *File `json:"video"`
+}
+
+func (*VideoNote) GetType() string {
+	return TypeVideoNote
+}
+
+func (*VideoNote) GetConstructor() string {
+	return ConstructorVideoNote
+}
+
+func (entity *VideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *VideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *VideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"videoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"waveform":`)
+	encoder.writeBytes(entity.Waveform)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	if entity.SpeechRecognitionResult != nil {
+		encoder.writeRaw(`,"speech_recognition_result":`)
+		encoder.writeType(entity.SpeechRecognitionResult)
+	}
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *VideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "waveform":
+			entity.Waveform, err = decoder.readBytes()
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "speech_recognition_result":
+			entity.SpeechRecognitionResult, err = decodeSpeechRecognitionResult(decoder)
+
+		case "video":
+			entity.Video, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVideoNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a voice note
+type VoiceNote struct {
+	meta
+	// Duration of the voice note, in seconds; as defined by the sender
+	Duration int32 `json:"duration"`
+	// A waveform representation of the voice note in 5-bit format
+	Waveform []byte `json:"waveform"`
+	// MIME type of the file; as defined by the sender. Usually, one of "audio/ogg" for Opus in an OGG container, "audio/mpeg" for an MP3 audio, or "audio/mp4" for an M4A audio
+	MimeType string `json:"mime_type"`
+	// Result of speech recognition in the voice note; may be null
+	SpeechRecognitionResult SpeechRecognitionResult `json:"speech_recognition_result,omitempty"`
+	// File containing the voice note
+	Voice *File `json:"voice"`
+}
+
+func (*VoiceNote) GetType() string {
+	return TypeVoiceNote
+}
+
+func (*VoiceNote) GetConstructor() string {
+	return ConstructorVoiceNote
+}
+
+func (entity *VoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *VoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *VoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"voiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"waveform":`)
+	encoder.writeBytes(entity.Waveform)
+	encoder.writeRaw(`,"mime_type":`)
+	encoder.writeString(entity.MimeType)
+	if entity.SpeechRecognitionResult != nil {
+		encoder.writeRaw(`,"speech_recognition_result":`)
+		encoder.writeType(entity.SpeechRecognitionResult)
+	}
+	encoder.writeRaw(`,"voice":`)
+	entity.Voice.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *VoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "waveform":
+			entity.Waveform, err = decoder.readBytes()
+
+		case "mime_type":
+			entity.MimeType, err = decoder.readString()
+
+		case "speech_recognition_result":
+			entity.SpeechRecognitionResult, err = decodeSpeechRecognitionResult(decoder)
+
+		case "voice":
+			entity.Voice, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an alternative re-encoded quality of a video file
+type AlternativeVideo struct {
+	meta
+	// Unique identifier of the alternative video, which is used in the HLS file
+	Id JsonInt64 `json:"id"`
+	// Video width
+	Width int32 `json:"width"`
+	// Video height
+	Height int32 `json:"height"`
+	// Codec used for video file encoding, for example, "h264", "h265", or "av1"
+	Codec string `json:"codec"`
+	// HLS file describing the video
+	HlsFile *File `json:"hls_file"`
+	// File containing the video
+	Video *File `json:"video"`
+}
+
+func (*AlternativeVideo) GetType() string {
+	return TypeAlternativeVideo
+}
+
+func (*AlternativeVideo) GetConstructor() string {
+	return ConstructorAlternativeVideo
+}
+
+func (entity *AlternativeVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AlternativeVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AlternativeVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"alternativeVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"codec":`)
+	encoder.writeString(entity.Codec)
+	encoder.writeRaw(`,"hls_file":`)
+	entity.HlsFile.encodeJSON(encoder)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *AlternativeVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "codec":
+			entity.Codec, err = decoder.readString()
+
+		case "hls_file":
+			entity.HlsFile, err = decodeEntity[File](decoder)
+
+		case "video":
+			entity.Video, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAlternativeVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a chat background
+type Background struct {
+	meta
+	// Unique background identifier
+	Id JsonInt64 `json:"id"`
+	// True, if this is one of default backgrounds
+	IsDefault bool `json:"is_default"`
+	// True, if the background is dark and is recommended to be used with dark theme
+	IsDark bool `json:"is_dark"`
+	// Unique background name
+	Name string `json:"name"`
+	// Document with the background; may be null. Null only for filled and chat theme backgrounds
+	Document *Document `json:"document,omitempty"`
+	// Type of the background
+	Type BackgroundType `json:"type"`
+}
+
+func (*Background) GetType() string {
+	return TypeBackground
+}
+
+func (*Background) GetConstructor() string {
+	return ConstructorBackground
+}
+
+func (entity *Background) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Background) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Background) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"background"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"is_default":`)
+	encoder.writeBool(entity.IsDefault)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(entity.IsDark)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	if entity.Document != nil {
+		encoder.writeRaw(`,"document":`)
+		entity.Document.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *Background) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "is_default":
+			entity.IsDefault, err = decoder.readBool()
+
+		case "is_dark":
+			entity.IsDark, err = decoder.readBool()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "document":
+			entity.Document, err = decodeEntity[Document](decoder)
+
+		case "type":
+			entity.Type, err = decodeBackgroundType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of backgrounds
+type Backgrounds struct {
+	meta
+	// A list of backgrounds
+	Backgrounds []*Background `json:"backgrounds"`
+}
+
+func (*Backgrounds) GetType() string {
+	return TypeBackgrounds
+}
+
+func (*Backgrounds) GetConstructor() string {
+	return ConstructorBackgrounds
+}
+
+func (entity *Backgrounds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Backgrounds) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Backgrounds) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgrounds"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"backgrounds":`)
+	writeList(encoder, entity.Backgrounds, writeTypeValue[*Background])
+	encoder.writeRaw("}")
+}
+
+func (entity *Backgrounds) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "backgrounds":
+			entity.Backgrounds, err = readList(decoder, decodeEntity[Background])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgrounds)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a file added to file download list
+type FileDownload struct {
+	meta
+	// File identifier
+	FileId int32 `json:"file_id"`
+	// The message with the file
+	Message *Message `json:"message"`
+	// Point in time (Unix timestamp) when the file was added to the download list
+	AddDate int32 `json:"add_date"`
+	// Point in time (Unix timestamp) when the file downloading was completed; 0 if the file downloading isn't completed
+	CompleteDate int32 `json:"complete_date"`
+	// True, if downloading of the file is paused
+	IsPaused bool `json:"is_paused"`
+}
+
+func (*FileDownload) GetType() string {
+	return TypeFileDownload
+}
+
+func (*FileDownload) GetConstructor() string {
+	return ConstructorFileDownload
+}
+
+func (entity *FileDownload) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileDownload) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileDownload) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileDownload"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(entity.FileId)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw(`,"add_date":`)
+	encoder.writeInt32(entity.AddDate)
+	encoder.writeRaw(`,"complete_date":`)
+	encoder.writeInt32(entity.CompleteDate)
+	encoder.writeRaw(`,"is_paused":`)
+	encoder.writeBool(entity.IsPaused)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileDownload) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_id":
+			entity.FileId, err = decoder.readInt32()
+
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		case "add_date":
+			entity.AddDate, err = decoder.readInt32()
+
+		case "complete_date":
+			entity.CompleteDate, err = decoder.readInt32()
+
+		case "is_paused":
+			entity.IsPaused, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFileDownload)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *FileDownload) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains number of being downloaded and recently downloaded files found
+type DownloadedFileCounts struct {
+	meta
+	// Number of active file downloads found, including paused
+	ActiveCount int32 `json:"active_count"`
+	// Number of paused file downloads found
+	PausedCount int32 `json:"paused_count"`
+	// Number of completed file downloads found
+	CompletedCount int32 `json:"completed_count"`
+}
+
+func (*DownloadedFileCounts) GetType() string {
+	return TypeDownloadedFileCounts
+}
+
+func (*DownloadedFileCounts) GetConstructor() string {
+	return ConstructorDownloadedFileCounts
+}
+
+func (entity *DownloadedFileCounts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DownloadedFileCounts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DownloadedFileCounts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"downloadedFileCounts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"active_count":`)
+	encoder.writeInt32(entity.ActiveCount)
+	encoder.writeRaw(`,"paused_count":`)
+	encoder.writeInt32(entity.PausedCount)
+	encoder.writeRaw(`,"completed_count":`)
+	encoder.writeInt32(entity.CompletedCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *DownloadedFileCounts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "active_count":
+			entity.ActiveCount, err = decoder.readInt32()
+
+		case "paused_count":
+			entity.PausedCount, err = decoder.readInt32()
+
+		case "completed_count":
+			entity.CompletedCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDownloadedFileCounts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of downloaded files, found by a search
+type FoundFileDownloads struct {
+	meta
+	// Total number of suitable files, ignoring offset
+	TotalCounts *DownloadedFileCounts `json:"total_counts"`
+	// The list of files
+	Files []*FileDownload `json:"files"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*FoundFileDownloads) GetType() string {
+	return TypeFoundFileDownloads
+}
+
+func (*FoundFileDownloads) GetConstructor() string {
+	return ConstructorFoundFileDownloads
+}
+
+func (entity *FoundFileDownloads) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundFileDownloads) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundFileDownloads) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundFileDownloads"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_counts":`)
+	entity.TotalCounts.encodeJSON(encoder)
+	encoder.writeRaw(`,"files":`)
+	writeList(encoder, entity.Files, writeTypeValue[*FileDownload])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundFileDownloads) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_counts":
+			entity.TotalCounts, err = decodeEntity[DownloadedFileCounts](decoder)
+
+		case "files":
+			entity.Files, err = readList(decoder, decodeEntity[FileDownload])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundFileDownloads)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *FoundFileDownloads) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Files {
+		if err := validateNested(indexField("files", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// File with the date it was uploaded
+type DatedFile struct {
+	meta
+	// The file
+	File *File `json:"file"`
+	// Point in time (Unix timestamp) when the file was uploaded
+	Date int32 `json:"date"`
+}
+
+func (*DatedFile) GetType() string {
+	return TypeDatedFile
+}
+
+func (*DatedFile) GetConstructor() string {
+	return ConstructorDatedFile
+}
+
+func (entity *DatedFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DatedFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DatedFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"datedFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file":`)
+	entity.File.encodeJSON(encoder)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw("}")
+}
+
+func (entity *DatedFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file":
+			entity.File, err = decodeEntity[File](decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDatedFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An identity document
+type IdentityDocument struct {
+	meta
+	// Document number; 1-24 characters
+	Number string `json:"number"`
+	// Document expiration date; may be null if not applicable
+	ExpirationDate *Date `json:"expiration_date,omitempty"`
+	// Front side of the document
+	FrontSide *DatedFile `json:"front_side"`
+	// Reverse side of the document; only for driver license and identity card; may be null
+	ReverseSide *DatedFile `json:"reverse_side,omitempty"`
+	// Selfie with the document; may be null
+	Selfie *DatedFile `json:"selfie,omitempty"`
+	// List of files containing a certified English translation of the document
+	Translation []*DatedFile `json:"translation"`
+}
+
+func (*IdentityDocument) GetType() string {
+	return TypeIdentityDocument
+}
+
+func (*IdentityDocument) GetConstructor() string {
+	return ConstructorIdentityDocument
+}
+
+func (entity *IdentityDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *IdentityDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *IdentityDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"identityDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"number":`)
+	encoder.writeString(entity.Number)
+	if entity.ExpirationDate != nil {
+		encoder.writeRaw(`,"expiration_date":`)
+		entity.ExpirationDate.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"front_side":`)
+	entity.FrontSide.encodeJSON(encoder)
+	if entity.ReverseSide != nil {
+		encoder.writeRaw(`,"reverse_side":`)
+		entity.ReverseSide.encodeJSON(encoder)
+	}
+	if entity.Selfie != nil {
+		encoder.writeRaw(`,"selfie":`)
+		entity.Selfie.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"translation":`)
+	writeList(encoder, entity.Translation, writeTypeValue[*DatedFile])
+	encoder.writeRaw("}")
+}
+
+func (entity *IdentityDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "number":
+			entity.Number, err = decoder.readString()
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decodeEntity[Date](decoder)
+
+		case "front_side":
+			entity.FrontSide, err = decodeEntity[DatedFile](decoder)
+
+		case "reverse_side":
+			entity.ReverseSide, err = decodeEntity[DatedFile](decoder)
+
+		case "selfie":
+			entity.Selfie, err = decodeEntity[DatedFile](decoder)
+
+		case "translation":
+			entity.Translation, err = readList(decoder, decodeEntity[DatedFile])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorIdentityDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *IdentityDocument) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("number", entity.Number, 1, 24); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// An identity document to be saved to Telegram Passport
+type InputIdentityDocument struct {
+	meta
+	// Document number; 1-24 characters
+	Number string `json:"number"`
+	// Document expiration date; pass null if not applicable
+	ExpirationDate *Date `json:"expiration_date,omitempty"`
+	// Front side of the document
+	FrontSide InputFile `json:"front_side"`
+	// Reverse side of the document; only for driver license and identity card; pass null otherwise
+	ReverseSide InputFile `json:"reverse_side,omitempty"`
+	// Selfie with the document; pass null if unavailable
+	Selfie InputFile `json:"selfie,omitempty"`
+	// List of files containing a certified English translation of the document
+	Translation []InputFile `json:"translation"`
+}
+
+func (*InputIdentityDocument) GetType() string {
+	return TypeInputIdentityDocument
+}
+
+func (*InputIdentityDocument) GetConstructor() string {
+	return ConstructorInputIdentityDocument
+}
+
+func (entity *InputIdentityDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputIdentityDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputIdentityDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputIdentityDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"number":`)
+	encoder.writeString(entity.Number)
+	if entity.ExpirationDate != nil {
+		encoder.writeRaw(`,"expiration_date":`)
+		entity.ExpirationDate.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"front_side":`)
+	encoder.writeType(entity.FrontSide)
+	if entity.ReverseSide != nil {
+		encoder.writeRaw(`,"reverse_side":`)
+		encoder.writeType(entity.ReverseSide)
+	}
+	if entity.Selfie != nil {
+		encoder.writeRaw(`,"selfie":`)
+		encoder.writeType(entity.Selfie)
+	}
+	encoder.writeRaw(`,"translation":`)
+	writeList(encoder, entity.Translation, writeTypeValue[InputFile])
+	encoder.writeRaw("}")
+}
+
+func (entity *InputIdentityDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "number":
+			entity.Number, err = decoder.readString()
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decodeEntity[Date](decoder)
+
+		case "front_side":
+			entity.FrontSide, err = decodeInputFile(decoder)
+
+		case "reverse_side":
+			entity.ReverseSide, err = decodeInputFile(decoder)
+
+		case "selfie":
+			entity.Selfie, err = decodeInputFile(decoder)
+
+		case "translation":
+			entity.Translation, err = readList(decoder, decodeInputFile)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputIdentityDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputIdentityDocument) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("number", entity.Number, 1, 24); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A personal document, containing some information about a user
+type PersonalDocument struct {
+	meta
+	// List of files containing the pages of the document
+	Files []*DatedFile `json:"files"`
+	// List of files containing a certified English translation of the document
+	Translation []*DatedFile `json:"translation"`
+}
+
+func (*PersonalDocument) GetType() string {
+	return TypePersonalDocument
+}
+
+func (*PersonalDocument) GetConstructor() string {
+	return ConstructorPersonalDocument
+}
+
+func (entity *PersonalDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PersonalDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PersonalDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"personalDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"files":`)
+	writeList(encoder, entity.Files, writeTypeValue[*DatedFile])
+	encoder.writeRaw(`,"translation":`)
+	writeList(encoder, entity.Translation, writeTypeValue[*DatedFile])
+	encoder.writeRaw("}")
+}
+
+func (entity *PersonalDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "files":
+			entity.Files, err = readList(decoder, decodeEntity[DatedFile])
+
+		case "translation":
+			entity.Translation, err = readList(decoder, decodeEntity[DatedFile])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPersonalDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A personal document to be saved to Telegram Passport
+type InputPersonalDocument struct {
+	meta
+	// List of files containing the pages of the document
+	Files []InputFile `json:"files"`
+	// List of files containing a certified English translation of the document
+	Translation []InputFile `json:"translation"`
+}
+
+func (*InputPersonalDocument) GetType() string {
+	return TypeInputPersonalDocument
+}
+
+func (*InputPersonalDocument) GetConstructor() string {
+	return ConstructorInputPersonalDocument
+}
+
+func (entity *InputPersonalDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPersonalDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPersonalDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPersonalDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"files":`)
+	writeList(encoder, entity.Files, writeTypeValue[InputFile])
+	encoder.writeRaw(`,"translation":`)
+	writeList(encoder, entity.Translation, writeTypeValue[InputFile])
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPersonalDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "files":
+			entity.Files, err = readList(decoder, decodeInputFile)
+
+		case "translation":
+			entity.Translation, err = readList(decoder, decodeInputFile)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPersonalDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A thumbnail to be sent along with a file; must be in JPEG or WEBP format for stickers, and less than 200 KB in size
+type InputThumbnail struct {
+	meta
+	// Thumbnail file to send. Sending thumbnails by file_id is currently not supported
+	Thumbnail InputFile `json:"thumbnail"`
+	// Thumbnail width, usually shouldn't exceed 320. Use 0 if unknown
+	Width int32 `json:"width"`
+	// Thumbnail height, usually shouldn't exceed 320. Use 0 if unknown
+	Height int32 `json:"height"`
+}
+
+func (*InputThumbnail) GetType() string {
+	return TypeInputThumbnail
+}
+
+func (*InputThumbnail) GetConstructor() string {
+	return ConstructorInputThumbnail
+}
+
+func (entity *InputThumbnail) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputThumbnail) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputThumbnail) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputThumbnail"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"thumbnail":`)
+	encoder.writeType(entity.Thumbnail)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputThumbnail) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "thumbnail":
+			entity.Thumbnail, err = decodeInputFile(decoder)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputThumbnail)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of animations
+type Animations struct {
+	meta
+	// List of animations
+	Animations []*Animation `json:"animations"`
+}
+
+func (*Animations) GetType() string {
+	return TypeAnimations
+}
+
+func (*Animations) GetConstructor() string {
+	return ConstructorAnimations
+}
+
+func (entity *Animations) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Animations) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Animations) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"animations"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"animations":`)
+	writeList(encoder, entity.Animations, writeTypeValue[*Animation])
+	encoder.writeRaw("}")
+}
+
+func (entity *Animations) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animations":
+			entity.Animations, err = readList(decoder, decodeEntity[Animation])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAnimations)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a solid fill of a background
+type BackgroundFillSolid struct {
+	meta
+	// A color of the background in the RGB format
+	Color int32 `json:"color"`
+}
+
+func (*BackgroundFillSolid) GetType() string {
+	return TypeBackgroundFill
+}
+
+func (*BackgroundFillSolid) GetConstructor() string {
+	return ConstructorBackgroundFillSolid
+}
+
+func (*BackgroundFillSolid) BackgroundFillConstructor() string {
+	return ConstructorBackgroundFillSolid
+}
+
+func (entity *BackgroundFillSolid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BackgroundFillSolid) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BackgroundFillSolid) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgroundFillSolid"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"color":`)
+	encoder.writeInt32(entity.Color)
+	encoder.writeRaw("}")
+}
+
+func (entity *BackgroundFillSolid) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "color":
+			entity.Color, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgroundFillSolid)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a gradient fill of a background
+type BackgroundFillGradient struct {
+	meta
+	// A top color of the background in the RGB format
+	TopColor int32 `json:"top_color"`
+	// A bottom color of the background in the RGB format
+	BottomColor int32 `json:"bottom_color"`
+	// Clockwise rotation angle of the gradient, in degrees; 0-359. Must always be divisible by 45
+	RotationAngle int32 `json:"rotation_angle"`
+}
+
+func (*BackgroundFillGradient) GetType() string {
+	return TypeBackgroundFill
+}
+
+func (*BackgroundFillGradient) GetConstructor() string {
+	return ConstructorBackgroundFillGradient
+}
+
+func (*BackgroundFillGradient) BackgroundFillConstructor() string {
+	return ConstructorBackgroundFillGradient
+}
+
+func (entity *BackgroundFillGradient) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BackgroundFillGradient) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BackgroundFillGradient) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgroundFillGradient"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"top_color":`)
+	encoder.writeInt32(entity.TopColor)
+	encoder.writeRaw(`,"bottom_color":`)
+	encoder.writeInt32(entity.BottomColor)
+	encoder.writeRaw(`,"rotation_angle":`)
+	encoder.writeInt32(entity.RotationAngle)
+	encoder.writeRaw("}")
+}
+
+func (entity *BackgroundFillGradient) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "top_color":
+			entity.TopColor, err = decoder.readInt32()
+
+		case "bottom_color":
+			entity.BottomColor, err = decoder.readInt32()
+
+		case "rotation_angle":
+			entity.RotationAngle, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgroundFillGradient)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a freeform gradient fill of a background
+type BackgroundFillFreeformGradient struct {
+	meta
+	// A list of 3 or 4 colors of the freeform gradient in the RGB format
+	Colors []int32 `json:"colors"`
+}
+
+func (*BackgroundFillFreeformGradient) GetType() string {
+	return TypeBackgroundFill
+}
+
+func (*BackgroundFillFreeformGradient) GetConstructor() string {
+	return ConstructorBackgroundFillFreeformGradient
+}
+
+func (*BackgroundFillFreeformGradient) BackgroundFillConstructor() string {
+	return ConstructorBackgroundFillFreeformGradient
+}
+
+func (entity *BackgroundFillFreeformGradient) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BackgroundFillFreeformGradient) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BackgroundFillFreeformGradient) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgroundFillFreeformGradient"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"colors":`)
+	writeList(encoder, entity.Colors, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *BackgroundFillFreeformGradient) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "colors":
+			entity.Colors, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgroundFillFreeformGradient)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A wallpaper in JPEG format
+type BackgroundTypeWallpaper struct {
+	meta
+	// True, if the wallpaper must be downscaled to fit in 450x450 square and then box-blurred with radius 12
+	IsBlurred bool `json:"is_blurred"`
+	// True, if the background needs to be slightly moved when device is tilted
+	IsMoving bool `json:"is_moving"`
+}
+
+func (*BackgroundTypeWallpaper) GetType() string {
+	return TypeBackgroundType
+}
+
+func (*BackgroundTypeWallpaper) GetConstructor() string {
+	return ConstructorBackgroundTypeWallpaper
+}
+
+func (*BackgroundTypeWallpaper) BackgroundTypeConstructor() string {
+	return ConstructorBackgroundTypeWallpaper
+}
+
+func (entity *BackgroundTypeWallpaper) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BackgroundTypeWallpaper) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BackgroundTypeWallpaper) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgroundTypeWallpaper"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_blurred":`)
+	encoder.writeBool(entity.IsBlurred)
+	encoder.writeRaw(`,"is_moving":`)
+	encoder.writeBool(entity.IsMoving)
+	encoder.writeRaw("}")
+}
+
+func (entity *BackgroundTypeWallpaper) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_blurred":
+			entity.IsBlurred, err = decoder.readBool()
+
+		case "is_moving":
+			entity.IsMoving, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgroundTypeWallpaper)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A PNG or TGV (gzipped subset of SVG with MIME type "application/x-tgwallpattern") pattern to be combined with the background fill chosen by the user
+type BackgroundTypePattern struct {
+	meta
+	// Fill of the background
+	Fill BackgroundFill `json:"fill"`
+	// Intensity of the pattern when it is shown above the filled background; 0-100
+	Intensity int32 `json:"intensity"`
+	// True, if the background fill must be applied only to the pattern itself. All other pixels are black in this case. For dark themes only
+	IsInverted bool `json:"is_inverted"`
+	// True, if the background needs to be slightly moved when device is tilted
+	IsMoving bool `json:"is_moving"`
+}
+
+func (*BackgroundTypePattern) GetType() string {
+	return TypeBackgroundType
+}
+
+func (*BackgroundTypePattern) GetConstructor() string {
+	return ConstructorBackgroundTypePattern
+}
+
+func (*BackgroundTypePattern) BackgroundTypeConstructor() string {
+	return ConstructorBackgroundTypePattern
+}
+
+func (entity *BackgroundTypePattern) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BackgroundTypePattern) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BackgroundTypePattern) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgroundTypePattern"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"fill":`)
+	encoder.writeType(entity.Fill)
+	encoder.writeRaw(`,"intensity":`)
+	encoder.writeInt32(entity.Intensity)
+	encoder.writeRaw(`,"is_inverted":`)
+	encoder.writeBool(entity.IsInverted)
+	encoder.writeRaw(`,"is_moving":`)
+	encoder.writeBool(entity.IsMoving)
+	encoder.writeRaw("}")
+}
+
+func (entity *BackgroundTypePattern) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "fill":
+			entity.Fill, err = decodeBackgroundFill(decoder)
+
+		case "intensity":
+			entity.Intensity, err = decoder.readInt32()
+
+		case "is_inverted":
+			entity.IsInverted, err = decoder.readBool()
+
+		case "is_moving":
+			entity.IsMoving, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgroundTypePattern)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A filled background
+type BackgroundTypeFill struct {
+	meta
+	// The background fill
+	Fill BackgroundFill `json:"fill"`
+}
+
+func (*BackgroundTypeFill) GetType() string {
+	return TypeBackgroundType
+}
+
+func (*BackgroundTypeFill) GetConstructor() string {
+	return ConstructorBackgroundTypeFill
+}
+
+func (*BackgroundTypeFill) BackgroundTypeConstructor() string {
+	return ConstructorBackgroundTypeFill
+}
+
+func (entity *BackgroundTypeFill) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BackgroundTypeFill) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BackgroundTypeFill) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgroundTypeFill"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"fill":`)
+	encoder.writeType(entity.Fill)
+	encoder.writeRaw("}")
+}
+
+func (entity *BackgroundTypeFill) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "fill":
+			entity.Fill, err = decodeBackgroundFill(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgroundTypeFill)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A background from a chat theme; can be used only as a chat background in channels
+type BackgroundTypeChatTheme struct {
+	meta
+	// Name of the chat theme
+	ThemeName string `json:"theme_name"`
+}
+
+func (*BackgroundTypeChatTheme) GetType() string {
+	return TypeBackgroundType
+}
+
+func (*BackgroundTypeChatTheme) GetConstructor() string {
+	return ConstructorBackgroundTypeChatTheme
+}
+
+func (*BackgroundTypeChatTheme) BackgroundTypeConstructor() string {
+	return ConstructorBackgroundTypeChatTheme
+}
+
+func (entity *BackgroundTypeChatTheme) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *BackgroundTypeChatTheme) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *BackgroundTypeChatTheme) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"backgroundTypeChatTheme"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"theme_name":`)
+	encoder.writeString(entity.ThemeName)
+	encoder.writeRaw("}")
+}
+
+func (entity *BackgroundTypeChatTheme) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "theme_name":
+			entity.ThemeName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorBackgroundTypeChatTheme)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A background from a local file
+type InputBackgroundLocal struct {
+	meta
+	// Background file to use. Only inputFileLocal and inputFileGenerated are supported. The file must be in JPEG format for wallpapers and in PNG format for patterns
+	Background InputFile `json:"background"`
+}
+
+func (*InputBackgroundLocal) GetType() string {
+	return TypeInputBackground
+}
+
+func (*InputBackgroundLocal) GetConstructor() string {
+	return ConstructorInputBackgroundLocal
+}
+
+func (*InputBackgroundLocal) InputBackgroundConstructor() string {
+	return ConstructorInputBackgroundLocal
+}
+
+func (entity *InputBackgroundLocal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputBackgroundLocal) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputBackgroundLocal) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputBackgroundLocal"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"background":`)
+	encoder.writeType(entity.Background)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputBackgroundLocal) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "background":
+			entity.Background, err = decodeInputFile(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputBackgroundLocal)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A background from the server
+type InputBackgroundRemote struct {
+	meta
+	// The background identifier
+	BackgroundId JsonInt64 `json:"background_id"`
+}
+
+func (*InputBackgroundRemote) GetType() string {
+	return TypeInputBackground
+}
+
+func (*InputBackgroundRemote) GetConstructor() string {
+	return ConstructorInputBackgroundRemote
+}
+
+func (*InputBackgroundRemote) InputBackgroundConstructor() string {
+	return ConstructorInputBackgroundRemote
+}
+
+func (entity *InputBackgroundRemote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputBackgroundRemote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputBackgroundRemote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputBackgroundRemote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"background_id":`)
+	encoder.writeJsonInt64(entity.BackgroundId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputBackgroundRemote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "background_id":
+			entity.BackgroundId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputBackgroundRemote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A background previously set in the chat; for chat backgrounds only
+type InputBackgroundPrevious struct {
+	meta
+	// Identifier of the message with the background
+	MessageId int64 `json:"message_id"`
+}
+
+func (*InputBackgroundPrevious) GetType() string {
+	return TypeInputBackground
+}
+
+func (*InputBackgroundPrevious) GetConstructor() string {
+	return ConstructorInputBackgroundPrevious
+}
+
+func (*InputBackgroundPrevious) InputBackgroundConstructor() string {
+	return ConstructorInputBackgroundPrevious
+}
+
+func (entity *InputBackgroundPrevious) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputBackgroundPrevious) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputBackgroundPrevious) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputBackgroundPrevious"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputBackgroundPrevious) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputBackgroundPrevious)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a part of a file
+type FilePart struct {
+	meta
+	// File bytes
+	Data []byte `json:"data"`
+}
+
+func (*FilePart) GetType() string {
+	return TypeFilePart
+}
+
+func (*FilePart) GetConstructor() string {
+	return ConstructorFilePart
+}
+
+func (entity *FilePart) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FilePart) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FilePart) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"filePart"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *FilePart) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFilePart)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The data is not a file
+type FileTypeNone struct {
+	meta
+}
+
+func (*FileTypeNone) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeNone) GetConstructor() string {
+	return ConstructorFileTypeNone
+}
+
+func (*FileTypeNone) FileTypeConstructor() string {
+	return ConstructorFileTypeNone
+}
+
+func (entity *FileTypeNone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeNone) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeNone) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeNone"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeNone) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeNone)
+	})
+}
+
+// The file is an animation
+type FileTypeAnimation struct {
+	meta
+}
+
+func (*FileTypeAnimation) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeAnimation) GetConstructor() string {
+	return ConstructorFileTypeAnimation
+}
+
+func (*FileTypeAnimation) FileTypeConstructor() string {
+	return ConstructorFileTypeAnimation
+}
+
+func (entity *FileTypeAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeAnimation)
+	})
+}
+
+// The file is an audio file
+type FileTypeAudio struct {
+	meta
+}
+
+func (*FileTypeAudio) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeAudio) GetConstructor() string {
+	return ConstructorFileTypeAudio
+}
+
+func (*FileTypeAudio) FileTypeConstructor() string {
+	return ConstructorFileTypeAudio
+}
+
+func (entity *FileTypeAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeAudio)
+	})
+}
+
+// The file is a document
+type FileTypeDocument struct {
+	meta
+}
+
+func (*FileTypeDocument) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeDocument) GetConstructor() string {
+	return ConstructorFileTypeDocument
+}
+
+func (*FileTypeDocument) FileTypeConstructor() string {
+	return ConstructorFileTypeDocument
+}
+
+func (entity *FileTypeDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeDocument)
+	})
+}
+
+// The file is a notification sound
+type FileTypeNotificationSound struct {
+	meta
+}
+
+func (*FileTypeNotificationSound) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeNotificationSound) GetConstructor() string {
+	return ConstructorFileTypeNotificationSound
+}
+
+func (*FileTypeNotificationSound) FileTypeConstructor() string {
+	return ConstructorFileTypeNotificationSound
+}
+
+func (entity *FileTypeNotificationSound) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeNotificationSound) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeNotificationSound) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeNotificationSound"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeNotificationSound) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeNotificationSound)
+	})
+}
+
+// The file is a photo
+type FileTypePhoto struct {
+	meta
+}
+
+func (*FileTypePhoto) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypePhoto) GetConstructor() string {
+	return ConstructorFileTypePhoto
+}
+
+func (*FileTypePhoto) FileTypeConstructor() string {
+	return ConstructorFileTypePhoto
+}
+
+func (entity *FileTypePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypePhoto)
+	})
+}
+
+// The file is a photo published as a story
+type FileTypePhotoStory struct {
+	meta
+}
+
+func (*FileTypePhotoStory) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypePhotoStory) GetConstructor() string {
+	return ConstructorFileTypePhotoStory
+}
+
+func (*FileTypePhotoStory) FileTypeConstructor() string {
+	return ConstructorFileTypePhotoStory
+}
+
+func (entity *FileTypePhotoStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypePhotoStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypePhotoStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypePhotoStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypePhotoStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypePhotoStory)
+	})
+}
+
+// The file is a profile photo
+type FileTypeProfilePhoto struct {
+	meta
+}
+
+func (*FileTypeProfilePhoto) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeProfilePhoto) GetConstructor() string {
+	return ConstructorFileTypeProfilePhoto
+}
+
+func (*FileTypeProfilePhoto) FileTypeConstructor() string {
+	return ConstructorFileTypeProfilePhoto
+}
+
+func (entity *FileTypeProfilePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeProfilePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeProfilePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeProfilePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeProfilePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeProfilePhoto)
+	})
+}
+
+// The file was sent to a secret chat (the file type is not known to the server)
+type FileTypeSecret struct {
+	meta
+}
+
+func (*FileTypeSecret) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSecret) GetConstructor() string {
+	return ConstructorFileTypeSecret
+}
+
+func (*FileTypeSecret) FileTypeConstructor() string {
+	return ConstructorFileTypeSecret
+}
+
+func (entity *FileTypeSecret) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSecret) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSecret) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSecret"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSecret) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSecret)
+	})
+}
+
+// The file is a thumbnail of a file from a secret chat
+type FileTypeSecretThumbnail struct {
+	meta
+}
+
+func (*FileTypeSecretThumbnail) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSecretThumbnail) GetConstructor() string {
+	return ConstructorFileTypeSecretThumbnail
+}
+
+func (*FileTypeSecretThumbnail) FileTypeConstructor() string {
+	return ConstructorFileTypeSecretThumbnail
+}
+
+func (entity *FileTypeSecretThumbnail) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSecretThumbnail) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSecretThumbnail) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSecretThumbnail"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSecretThumbnail) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSecretThumbnail)
+	})
+}
+
+// The file is a file from Secure storage used for storing Telegram Passport files
+type FileTypeSecure struct {
+	meta
+}
+
+func (*FileTypeSecure) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSecure) GetConstructor() string {
+	return ConstructorFileTypeSecure
+}
+
+func (*FileTypeSecure) FileTypeConstructor() string {
+	return ConstructorFileTypeSecure
+}
+
+func (entity *FileTypeSecure) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSecure) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSecure) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSecure"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSecure) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSecure)
+	})
+}
+
+// The file is a self-destructing photo in a private chat
+type FileTypeSelfDestructingPhoto struct {
+	meta
+}
+
+func (*FileTypeSelfDestructingPhoto) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSelfDestructingPhoto) GetConstructor() string {
+	return ConstructorFileTypeSelfDestructingPhoto
+}
+
+func (*FileTypeSelfDestructingPhoto) FileTypeConstructor() string {
+	return ConstructorFileTypeSelfDestructingPhoto
+}
+
+func (entity *FileTypeSelfDestructingPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSelfDestructingPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSelfDestructingPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSelfDestructingPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSelfDestructingPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSelfDestructingPhoto)
+	})
+}
+
+// The file is a self-destructing video in a private chat
+type FileTypeSelfDestructingVideo struct {
+	meta
+}
+
+func (*FileTypeSelfDestructingVideo) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSelfDestructingVideo) GetConstructor() string {
+	return ConstructorFileTypeSelfDestructingVideo
+}
+
+func (*FileTypeSelfDestructingVideo) FileTypeConstructor() string {
+	return ConstructorFileTypeSelfDestructingVideo
+}
+
+func (entity *FileTypeSelfDestructingVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSelfDestructingVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSelfDestructingVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSelfDestructingVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSelfDestructingVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSelfDestructingVideo)
+	})
+}
+
+// The file is a self-destructing video note in a private chat
+type FileTypeSelfDestructingVideoNote struct {
+	meta
+}
+
+func (*FileTypeSelfDestructingVideoNote) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSelfDestructingVideoNote) GetConstructor() string {
+	return ConstructorFileTypeSelfDestructingVideoNote
+}
+
+func (*FileTypeSelfDestructingVideoNote) FileTypeConstructor() string {
+	return ConstructorFileTypeSelfDestructingVideoNote
+}
+
+func (entity *FileTypeSelfDestructingVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSelfDestructingVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSelfDestructingVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSelfDestructingVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSelfDestructingVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSelfDestructingVideoNote)
+	})
+}
+
+// The file is a self-destructing voice note in a private chat
+type FileTypeSelfDestructingVoiceNote struct {
+	meta
+}
+
+func (*FileTypeSelfDestructingVoiceNote) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSelfDestructingVoiceNote) GetConstructor() string {
+	return ConstructorFileTypeSelfDestructingVoiceNote
+}
+
+func (*FileTypeSelfDestructingVoiceNote) FileTypeConstructor() string {
+	return ConstructorFileTypeSelfDestructingVoiceNote
+}
+
+func (entity *FileTypeSelfDestructingVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSelfDestructingVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSelfDestructingVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSelfDestructingVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSelfDestructingVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSelfDestructingVoiceNote)
+	})
+}
+
+// The file is a sticker
+type FileTypeSticker struct {
+	meta
+}
+
+func (*FileTypeSticker) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeSticker) GetConstructor() string {
+	return ConstructorFileTypeSticker
+}
+
+func (*FileTypeSticker) FileTypeConstructor() string {
+	return ConstructorFileTypeSticker
+}
+
+func (entity *FileTypeSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeSticker)
+	})
+}
+
+// The file is a thumbnail of another file
+type FileTypeThumbnail struct {
+	meta
+}
+
+func (*FileTypeThumbnail) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeThumbnail) GetConstructor() string {
+	return ConstructorFileTypeThumbnail
+}
+
+func (*FileTypeThumbnail) FileTypeConstructor() string {
+	return ConstructorFileTypeThumbnail
+}
+
+func (entity *FileTypeThumbnail) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeThumbnail) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeThumbnail) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeThumbnail"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeThumbnail) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeThumbnail)
+	})
+}
+
+// The file type is not yet known
+type FileTypeUnknown struct {
+	meta
+}
+
+func (*FileTypeUnknown) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeUnknown) GetConstructor() string {
+	return ConstructorFileTypeUnknown
+}
+
+func (*FileTypeUnknown) FileTypeConstructor() string {
+	return ConstructorFileTypeUnknown
+}
+
+func (entity *FileTypeUnknown) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeUnknown) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeUnknown) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeUnknown"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeUnknown) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeUnknown)
+	})
+}
+
+// The file is a video
+type FileTypeVideo struct {
+	meta
+}
+
+func (*FileTypeVideo) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeVideo) GetConstructor() string {
+	return ConstructorFileTypeVideo
+}
+
+func (*FileTypeVideo) FileTypeConstructor() string {
+	return ConstructorFileTypeVideo
+}
+
+func (entity *FileTypeVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeVideo)
+	})
+}
+
+// The file is a video note
+type FileTypeVideoNote struct {
+	meta
+}
+
+func (*FileTypeVideoNote) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeVideoNote) GetConstructor() string {
+	return ConstructorFileTypeVideoNote
+}
+
+func (*FileTypeVideoNote) FileTypeConstructor() string {
+	return ConstructorFileTypeVideoNote
+}
+
+func (entity *FileTypeVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeVideoNote)
+	})
+}
+
+// The file is a video published as a story
+type FileTypeVideoStory struct {
+	meta
+}
+
+func (*FileTypeVideoStory) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeVideoStory) GetConstructor() string {
+	return ConstructorFileTypeVideoStory
+}
+
+func (*FileTypeVideoStory) FileTypeConstructor() string {
+	return ConstructorFileTypeVideoStory
+}
+
+func (entity *FileTypeVideoStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeVideoStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeVideoStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeVideoStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeVideoStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeVideoStory)
+	})
+}
+
+// The file is a voice note
+type FileTypeVoiceNote struct {
+	meta
+}
+
+func (*FileTypeVoiceNote) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeVoiceNote) GetConstructor() string {
+	return ConstructorFileTypeVoiceNote
+}
+
+func (*FileTypeVoiceNote) FileTypeConstructor() string {
+	return ConstructorFileTypeVoiceNote
+}
+
+func (entity *FileTypeVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeVoiceNote)
+	})
+}
+
+// The file is a wallpaper or a background pattern
+type FileTypeWallpaper struct {
+	meta
+}
+
+func (*FileTypeWallpaper) GetType() string {
+	return TypeFileType
+}
+
+func (*FileTypeWallpaper) GetConstructor() string {
+	return ConstructorFileTypeWallpaper
+}
+
+func (*FileTypeWallpaper) FileTypeConstructor() string {
+	return ConstructorFileTypeWallpaper
+}
+
+func (entity *FileTypeWallpaper) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileTypeWallpaper) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileTypeWallpaper) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileTypeWallpaper"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileTypeWallpaper) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorFileTypeWallpaper)
+	})
+}
+
+// Contains the storage usage statistics for a specific file type
+type StorageStatisticsByFileType struct {
+	meta
+	// File type
+	FileType FileType `json:"file_type"`
+	// Total size of the files, in bytes
+	Size int64 `json:"size"`
+	// Total number of files
+	Count int32 `json:"count"`
+}
+
+func (*StorageStatisticsByFileType) GetType() string {
+	return TypeStorageStatisticsByFileType
+}
+
+func (*StorageStatisticsByFileType) GetConstructor() string {
+	return ConstructorStorageStatisticsByFileType
+}
+
+func (entity *StorageStatisticsByFileType) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorageStatisticsByFileType) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorageStatisticsByFileType) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storageStatisticsByFileType"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_type":`)
+	encoder.writeType(entity.FileType)
+	encoder.writeRaw(`,"size":`)
+	encoder.writeInt64(entity.Size)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt32(entity.Count)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorageStatisticsByFileType) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_type":
+			entity.FileType, err = decodeFileType(decoder)
+
+		case "size":
+			entity.Size, err = decoder.readInt64()
+
+		case "count":
+			entity.Count, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorageStatisticsByFileType)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains auto-download settings
+type AutoDownloadSettings struct {
+	meta
+	// True, if the auto-download is enabled
+	IsAutoDownloadEnabled bool `json:"is_auto_download_enabled"`
+	// The maximum size of a photo file to be auto-downloaded, in bytes
+	MaxPhotoFileSize int32 `json:"max_photo_file_size"`
+	// The maximum size of a video file to be auto-downloaded, in bytes
+	MaxVideoFileSize int64 `json:"max_video_file_size"`
+	// The maximum size of other file types to be auto-downloaded, in bytes
+	MaxOtherFileSize int64 `json:"max_other_file_size"`
+	// The maximum suggested bitrate for uploaded videos, in kbit/s
+	VideoUploadBitrate int32 `json:"video_upload_bitrate"`
+	// True, if the beginning of video files needs to be preloaded for instant playback
+	PreloadLargeVideos bool `json:"preload_large_videos"`
+	// True, if the next audio track needs to be preloaded while the user is listening to an audio file
+	PreloadNextAudio bool `json:"preload_next_audio"`
+	// True, if stories needs to be preloaded
+	PreloadStories bool `json:"preload_stories"`
+	// True, if "use less data for calls" option needs to be enabled
+	UseLessDataForCalls bool `json:"use_less_data_for_calls"`
+}
+
+func (*AutoDownloadSettings) GetType() string {
+	return TypeAutoDownloadSettings
+}
+
+func (*AutoDownloadSettings) GetConstructor() string {
+	return ConstructorAutoDownloadSettings
+}
+
+func (entity *AutoDownloadSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutoDownloadSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutoDownloadSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autoDownloadSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_auto_download_enabled":`)
+	encoder.writeBool(entity.IsAutoDownloadEnabled)
+	encoder.writeRaw(`,"max_photo_file_size":`)
+	encoder.writeInt32(entity.MaxPhotoFileSize)
+	encoder.writeRaw(`,"max_video_file_size":`)
+	encoder.writeInt64(entity.MaxVideoFileSize)
+	encoder.writeRaw(`,"max_other_file_size":`)
+	encoder.writeInt64(entity.MaxOtherFileSize)
+	encoder.writeRaw(`,"video_upload_bitrate":`)
+	encoder.writeInt32(entity.VideoUploadBitrate)
+	encoder.writeRaw(`,"preload_large_videos":`)
+	encoder.writeBool(entity.PreloadLargeVideos)
+	encoder.writeRaw(`,"preload_next_audio":`)
+	encoder.writeBool(entity.PreloadNextAudio)
+	encoder.writeRaw(`,"preload_stories":`)
+	encoder.writeBool(entity.PreloadStories)
+	encoder.writeRaw(`,"use_less_data_for_calls":`)
+	encoder.writeBool(entity.UseLessDataForCalls)
+	encoder.writeRaw("}")
+}
+
+func (entity *AutoDownloadSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_auto_download_enabled":
+			entity.IsAutoDownloadEnabled, err = decoder.readBool()
+
+		case "max_photo_file_size":
+			entity.MaxPhotoFileSize, err = decoder.readInt32()
+
+		case "max_video_file_size":
+			entity.MaxVideoFileSize, err = decoder.readInt64()
+
+		case "max_other_file_size":
+			entity.MaxOtherFileSize, err = decoder.readInt64()
+
+		case "video_upload_bitrate":
+			entity.VideoUploadBitrate, err = decoder.readInt32()
+
+		case "preload_large_videos":
+			entity.PreloadLargeVideos, err = decoder.readBool()
+
+		case "preload_next_audio":
+			entity.PreloadNextAudio, err = decoder.readBool()
+
+		case "preload_stories":
+			entity.PreloadStories, err = decoder.readBool()
+
+		case "use_less_data_for_calls":
+			entity.UseLessDataForCalls, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAutoDownloadSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains auto-download settings presets for the current user
+type AutoDownloadSettingsPresets struct {
+	meta
+	// Preset with lowest settings; expected to be used by default when roaming
+	Low *AutoDownloadSettings `json:"low"`
+	// Preset with medium settings; expected to be used by default when using mobile data
+	Medium *AutoDownloadSettings `json:"medium"`
+	// Preset with highest settings; expected to be used by default when connected on Wi-Fi
+	High *AutoDownloadSettings `json:"high"`
+}
+
+func (*AutoDownloadSettingsPresets) GetType() string {
+	return TypeAutoDownloadSettingsPresets
+}
+
+func (*AutoDownloadSettingsPresets) GetConstructor() string {
+	return ConstructorAutoDownloadSettingsPresets
+}
+
+func (entity *AutoDownloadSettingsPresets) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AutoDownloadSettingsPresets) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AutoDownloadSettingsPresets) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"autoDownloadSettingsPresets"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"low":`)
+	entity.Low.encodeJSON(encoder)
+	encoder.writeRaw(`,"medium":`)
+	entity.Medium.encodeJSON(encoder)
+	encoder.writeRaw(`,"high":`)
+	entity.High.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *AutoDownloadSettingsPresets) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "low":
+			entity.Low, err = decodeEntity[AutoDownloadSettings](decoder)
+
+		case "medium":
+			entity.Medium, err = decodeEntity[AutoDownloadSettings](decoder)
+
+		case "high":
+			entity.High, err = decodeEntity[AutoDownloadSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAutoDownloadSettingsPresets)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains size of downloaded prefix of a file
+type FileDownloadedPrefixSize struct {
+	meta
+	// The prefix size, in bytes
+	Size int64 `json:"size"`
+}
+
+func (*FileDownloadedPrefixSize) GetType() string {
+	return TypeFileDownloadedPrefixSize
+}
+
+func (*FileDownloadedPrefixSize) GetConstructor() string {
+	return ConstructorFileDownloadedPrefixSize
+}
+
+func (entity *FileDownloadedPrefixSize) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FileDownloadedPrefixSize) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FileDownloadedPrefixSize) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"fileDownloadedPrefixSize"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"size":`)
+	encoder.writeInt64(entity.Size)
+	encoder.writeRaw("}")
+}
+
+func (entity *FileDownloadedPrefixSize) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "size":
+			entity.Size, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFileDownloadedPrefixSize)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
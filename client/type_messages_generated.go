@@ -0,0 +1,21916 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypePollType                        = "PollType"
+	TypeMessageSender                   = "MessageSender"
+	TypeMessageReadDate                 = "MessageReadDate"
+	TypeMessageOrigin                   = "MessageOrigin"
+	TypeMessageEffectType               = "MessageEffectType"
+	TypeMessageSendingState             = "MessageSendingState"
+	TypeMessageReplyTo                  = "MessageReplyTo"
+	TypeInputMessageReplyTo             = "InputMessageReplyTo"
+	TypeMessageSource                   = "MessageSource"
+	TypeKeyboardButtonType              = "KeyboardButtonType"
+	TypeInlineKeyboardButtonType        = "InlineKeyboardButtonType"
+	TypeReplyMarkup                     = "ReplyMarkup"
+	TypeSavedMessagesTopicType          = "SavedMessagesTopicType"
+	TypeRichText                        = "RichText"
+	TypeMessageContent                  = "MessageContent"
+	TypeTextEntityType                  = "TextEntityType"
+	TypeMessageSchedulingState          = "MessageSchedulingState"
+	TypeMessageSelfDestructType         = "MessageSelfDestructType"
+	TypeInputMessageContent             = "InputMessageContent"
+	TypeSearchMessagesFilter            = "SearchMessagesFilter"
+	TypeSearchMessagesChatTypeFilter    = "SearchMessagesChatTypeFilter"
+	TypePublicForward                   = "PublicForward"
+	TypeMessageFileType                 = "MessageFileType"
+	TypePushMessageContent              = "PushMessageContent"
+	TypeCanSendMessageToUserResult      = "CanSendMessageToUserResult"
+	TypeTextParseMode                   = "TextParseMode"
+	TypeTextEntity                      = "TextEntity"
+	TypeTextEntities                    = "TextEntities"
+	TypeFormattedText                   = "FormattedText"
+	TypePollOption                      = "PollOption"
+	TypePoll                            = "Poll"
+	TypeMessageSenders                  = "MessageSenders"
+	TypeChatMessageSender               = "ChatMessageSender"
+	TypeChatMessageSenders              = "ChatMessageSenders"
+	TypeMessageViewer                   = "MessageViewer"
+	TypeMessageViewers                  = "MessageViewers"
+	TypeForwardSource                   = "ForwardSource"
+	TypeMessageForwardInfo              = "MessageForwardInfo"
+	TypeMessageImportInfo               = "MessageImportInfo"
+	TypeMessageReplyInfo                = "MessageReplyInfo"
+	TypeMessageInteractionInfo          = "MessageInteractionInfo"
+	TypeMessageEffect                   = "MessageEffect"
+	TypeTextQuote                       = "TextQuote"
+	TypeInputTextQuote                  = "InputTextQuote"
+	TypeMessage                         = "Message"
+	TypeMessages                        = "Messages"
+	TypeFoundMessages                   = "FoundMessages"
+	TypeFoundChatMessages               = "FoundChatMessages"
+	TypeMessagePosition                 = "MessagePosition"
+	TypeMessagePositions                = "MessagePositions"
+	TypeMessageCalendarDay              = "MessageCalendarDay"
+	TypeMessageCalendar                 = "MessageCalendar"
+	TypeMessageSponsor                  = "MessageSponsor"
+	TypeSponsoredMessage                = "SponsoredMessage"
+	TypeSponsoredMessages               = "SponsoredMessages"
+	TypeDraftMessage                    = "DraftMessage"
+	TypeSavedMessagesTag                = "SavedMessagesTag"
+	TypeSavedMessagesTags               = "SavedMessagesTags"
+	TypeKeyboardButton                  = "KeyboardButton"
+	TypeInlineKeyboardButton            = "InlineKeyboardButton"
+	TypeMessageThreadInfo               = "MessageThreadInfo"
+	TypeSavedMessagesTopic              = "SavedMessagesTopic"
+	TypeMessageSendOptions              = "MessageSendOptions"
+	TypeMessageCopyOptions              = "MessageCopyOptions"
+	TypeMessageProperties               = "MessageProperties"
+	TypeQuickReplyMessage               = "QuickReplyMessage"
+	TypeQuickReplyMessages              = "QuickReplyMessages"
+	TypeQuickReplyShortcut              = "QuickReplyShortcut"
+	TypeSentWebAppMessage               = "SentWebAppMessage"
+	TypePreparedInlineMessageId         = "PreparedInlineMessageId"
+	TypePreparedInlineMessage           = "PreparedInlineMessage"
+	TypeMessageAutoDeleteTime           = "MessageAutoDeleteTime"
+	TypeMessageLink                     = "MessageLink"
+	TypeMessageLinkInfo                 = "MessageLinkInfo"
+	TypeText                            = "Text"
+	TypeChatStatisticsMessageSenderInfo = "ChatStatisticsMessageSenderInfo"
+	TypeMessageStatistics               = "MessageStatistics"
+)
+
+const (
+	ConstructorTextEntity                                      = "textEntity"
+	ConstructorTextEntities                                    = "textEntities"
+	ConstructorFormattedText                                   = "formattedText"
+	ConstructorPollOption                                      = "pollOption"
+	ConstructorPollTypeRegular                                 = "pollTypeRegular"
+	ConstructorPollTypeQuiz                                    = "pollTypeQuiz"
+	ConstructorPoll                                            = "poll"
+	ConstructorMessageSenderUser                               = "messageSenderUser"
+	ConstructorMessageSenderChat                               = "messageSenderChat"
+	ConstructorMessageSenders                                  = "messageSenders"
+	ConstructorChatMessageSender                               = "chatMessageSender"
+	ConstructorChatMessageSenders                              = "chatMessageSenders"
+	ConstructorMessageReadDateRead                             = "messageReadDateRead"
+	ConstructorMessageReadDateUnread                           = "messageReadDateUnread"
+	ConstructorMessageReadDateTooOld                           = "messageReadDateTooOld"
+	ConstructorMessageReadDateUserPrivacyRestricted            = "messageReadDateUserPrivacyRestricted"
+	ConstructorMessageReadDateMyPrivacyRestricted              = "messageReadDateMyPrivacyRestricted"
+	ConstructorMessageViewer                                   = "messageViewer"
+	ConstructorMessageViewers                                  = "messageViewers"
+	ConstructorMessageOriginUser                               = "messageOriginUser"
+	ConstructorMessageOriginHiddenUser                         = "messageOriginHiddenUser"
+	ConstructorMessageOriginChat                               = "messageOriginChat"
+	ConstructorMessageOriginChannel                            = "messageOriginChannel"
+	ConstructorForwardSource                                   = "forwardSource"
+	ConstructorMessageForwardInfo                              = "messageForwardInfo"
+	ConstructorMessageImportInfo                               = "messageImportInfo"
+	ConstructorMessageReplyInfo                                = "messageReplyInfo"
+	ConstructorMessageInteractionInfo                          = "messageInteractionInfo"
+	ConstructorMessageEffectTypeEmojiReaction                  = "messageEffectTypeEmojiReaction"
+	ConstructorMessageEffectTypePremiumSticker                 = "messageEffectTypePremiumSticker"
+	ConstructorMessageEffect                                   = "messageEffect"
+	ConstructorMessageSendingStatePending                      = "messageSendingStatePending"
+	ConstructorMessageSendingStateFailed                       = "messageSendingStateFailed"
+	ConstructorTextQuote                                       = "textQuote"
+	ConstructorInputTextQuote                                  = "inputTextQuote"
+	ConstructorMessageReplyToMessage                           = "messageReplyToMessage"
+	ConstructorMessageReplyToStory                             = "messageReplyToStory"
+	ConstructorInputMessageReplyToMessage                      = "inputMessageReplyToMessage"
+	ConstructorInputMessageReplyToExternalMessage              = "inputMessageReplyToExternalMessage"
+	ConstructorInputMessageReplyToStory                        = "inputMessageReplyToStory"
+	ConstructorMessage                                         = "message"
+	ConstructorMessages                                        = "messages"
+	ConstructorFoundMessages                                   = "foundMessages"
+	ConstructorFoundChatMessages                               = "foundChatMessages"
+	ConstructorMessagePosition                                 = "messagePosition"
+	ConstructorMessagePositions                                = "messagePositions"
+	ConstructorMessageCalendarDay                              = "messageCalendarDay"
+	ConstructorMessageCalendar                                 = "messageCalendar"
+	ConstructorMessageSourceChatHistory                        = "messageSourceChatHistory"
+	ConstructorMessageSourceMessageThreadHistory               = "messageSourceMessageThreadHistory"
+	ConstructorMessageSourceForumTopicHistory                  = "messageSourceForumTopicHistory"
+	ConstructorMessageSourceHistoryPreview                     = "messageSourceHistoryPreview"
+	ConstructorMessageSourceChatList                           = "messageSourceChatList"
+	ConstructorMessageSourceSearch                             = "messageSourceSearch"
+	ConstructorMessageSourceChatEventLog                       = "messageSourceChatEventLog"
+	ConstructorMessageSourceNotification                       = "messageSourceNotification"
+	ConstructorMessageSourceScreenshot                         = "messageSourceScreenshot"
+	ConstructorMessageSourceOther                              = "messageSourceOther"
+	ConstructorMessageSponsor                                  = "messageSponsor"
+	ConstructorSponsoredMessage                                = "sponsoredMessage"
+	ConstructorSponsoredMessages                               = "sponsoredMessages"
+	ConstructorDraftMessage                                    = "draftMessage"
+	ConstructorSavedMessagesTag                                = "savedMessagesTag"
+	ConstructorSavedMessagesTags                               = "savedMessagesTags"
+	ConstructorKeyboardButtonTypeText                          = "keyboardButtonTypeText"
+	ConstructorKeyboardButtonTypeRequestPhoneNumber            = "keyboardButtonTypeRequestPhoneNumber"
+	ConstructorKeyboardButtonTypeRequestLocation               = "keyboardButtonTypeRequestLocation"
+	ConstructorKeyboardButtonTypeRequestPoll                   = "keyboardButtonTypeRequestPoll"
+	ConstructorKeyboardButtonTypeRequestUsers                  = "keyboardButtonTypeRequestUsers"
+	ConstructorKeyboardButtonTypeRequestChat                   = "keyboardButtonTypeRequestChat"
+	ConstructorKeyboardButtonTypeWebApp                        = "keyboardButtonTypeWebApp"
+	ConstructorKeyboardButton                                  = "keyboardButton"
+	ConstructorInlineKeyboardButtonTypeUrl                     = "inlineKeyboardButtonTypeUrl"
+	ConstructorInlineKeyboardButtonTypeLoginUrl                = "inlineKeyboardButtonTypeLoginUrl"
+	ConstructorInlineKeyboardButtonTypeWebApp                  = "inlineKeyboardButtonTypeWebApp"
+	ConstructorInlineKeyboardButtonTypeCallback                = "inlineKeyboardButtonTypeCallback"
+	ConstructorInlineKeyboardButtonTypeCallbackWithPassword    = "inlineKeyboardButtonTypeCallbackWithPassword"
+	ConstructorInlineKeyboardButtonTypeCallbackGame            = "inlineKeyboardButtonTypeCallbackGame"
+	ConstructorInlineKeyboardButtonTypeSwitchInline            = "inlineKeyboardButtonTypeSwitchInline"
+	ConstructorInlineKeyboardButtonTypeBuy                     = "inlineKeyboardButtonTypeBuy"
+	ConstructorInlineKeyboardButtonTypeUser                    = "inlineKeyboardButtonTypeUser"
+	ConstructorInlineKeyboardButtonTypeCopyText                = "inlineKeyboardButtonTypeCopyText"
+	ConstructorInlineKeyboardButton                            = "inlineKeyboardButton"
+	ConstructorReplyMarkupRemoveKeyboard                       = "replyMarkupRemoveKeyboard"
+	ConstructorReplyMarkupForceReply                           = "replyMarkupForceReply"
+	ConstructorReplyMarkupShowKeyboard                         = "replyMarkupShowKeyboard"
+	ConstructorReplyMarkupInlineKeyboard                       = "replyMarkupInlineKeyboard"
+	ConstructorMessageThreadInfo                               = "messageThreadInfo"
+	ConstructorSavedMessagesTopicTypeMyNotes                   = "savedMessagesTopicTypeMyNotes"
+	ConstructorSavedMessagesTopicTypeAuthorHidden              = "savedMessagesTopicTypeAuthorHidden"
+	ConstructorSavedMessagesTopicTypeSavedFromChat             = "savedMessagesTopicTypeSavedFromChat"
+	ConstructorSavedMessagesTopic                              = "savedMessagesTopic"
+	ConstructorRichTextPlain                                   = "richTextPlain"
+	ConstructorRichTextBold                                    = "richTextBold"
+	ConstructorRichTextItalic                                  = "richTextItalic"
+	ConstructorRichTextUnderline                               = "richTextUnderline"
+	ConstructorRichTextStrikethrough                           = "richTextStrikethrough"
+	ConstructorRichTextFixed                                   = "richTextFixed"
+	ConstructorRichTextUrl                                     = "richTextUrl"
+	ConstructorRichTextEmailAddress                            = "richTextEmailAddress"
+	ConstructorRichTextSubscript                               = "richTextSubscript"
+	ConstructorRichTextSuperscript                             = "richTextSuperscript"
+	ConstructorRichTextMarked                                  = "richTextMarked"
+	ConstructorRichTextPhoneNumber                             = "richTextPhoneNumber"
+	ConstructorRichTextIcon                                    = "richTextIcon"
+	ConstructorRichTextReference                               = "richTextReference"
+	ConstructorRichTextAnchor                                  = "richTextAnchor"
+	ConstructorRichTextAnchorLink                              = "richTextAnchorLink"
+	ConstructorRichTexts                                       = "richTexts"
+	ConstructorMessageText                                     = "messageText"
+	ConstructorMessageAnimation                                = "messageAnimation"
+	ConstructorMessageAudio                                    = "messageAudio"
+	ConstructorMessageDocument                                 = "messageDocument"
+	ConstructorMessagePaidMedia                                = "messagePaidMedia"
+	ConstructorMessagePhoto                                    = "messagePhoto"
+	ConstructorMessageSticker                                  = "messageSticker"
+	ConstructorMessageVideo                                    = "messageVideo"
+	ConstructorMessageVideoNote                                = "messageVideoNote"
+	ConstructorMessageVoiceNote                                = "messageVoiceNote"
+	ConstructorMessageExpiredPhoto                             = "messageExpiredPhoto"
+	ConstructorMessageExpiredVideo                             = "messageExpiredVideo"
+	ConstructorMessageExpiredVideoNote                         = "messageExpiredVideoNote"
+	ConstructorMessageExpiredVoiceNote                         = "messageExpiredVoiceNote"
+	ConstructorMessageLocation                                 = "messageLocation"
+	ConstructorMessageVenue                                    = "messageVenue"
+	ConstructorMessageContact                                  = "messageContact"
+	ConstructorMessageAnimatedEmoji                            = "messageAnimatedEmoji"
+	ConstructorMessageDice                                     = "messageDice"
+	ConstructorMessageGame                                     = "messageGame"
+	ConstructorMessagePoll                                     = "messagePoll"
+	ConstructorMessageStory                                    = "messageStory"
+	ConstructorMessageInvoice                                  = "messageInvoice"
+	ConstructorMessageCall                                     = "messageCall"
+	ConstructorMessageVideoChatScheduled                       = "messageVideoChatScheduled"
+	ConstructorMessageVideoChatStarted                         = "messageVideoChatStarted"
+	ConstructorMessageVideoChatEnded                           = "messageVideoChatEnded"
+	ConstructorMessageInviteVideoChatParticipants              = "messageInviteVideoChatParticipants"
+	ConstructorMessageBasicGroupChatCreate                     = "messageBasicGroupChatCreate"
+	ConstructorMessageSupergroupChatCreate                     = "messageSupergroupChatCreate"
+	ConstructorMessageChatChangeTitle                          = "messageChatChangeTitle"
+	ConstructorMessageChatChangePhoto                          = "messageChatChangePhoto"
+	ConstructorMessageChatDeletePhoto                          = "messageChatDeletePhoto"
+	ConstructorMessageChatAddMembers                           = "messageChatAddMembers"
+	ConstructorMessageChatJoinByLink                           = "messageChatJoinByLink"
+	ConstructorMessageChatJoinByRequest                        = "messageChatJoinByRequest"
+	ConstructorMessageChatDeleteMember                         = "messageChatDeleteMember"
+	ConstructorMessageChatUpgradeTo                            = "messageChatUpgradeTo"
+	ConstructorMessageChatUpgradeFrom                          = "messageChatUpgradeFrom"
+	ConstructorMessagePinMessage                               = "messagePinMessage"
+	ConstructorMessageScreenshotTaken                          = "messageScreenshotTaken"
+	ConstructorMessageChatSetBackground                        = "messageChatSetBackground"
+	ConstructorMessageChatSetTheme                             = "messageChatSetTheme"
+	ConstructorMessageChatSetMessageAutoDeleteTime             = "messageChatSetMessageAutoDeleteTime"
+	ConstructorMessageChatBoost                                = "messageChatBoost"
+	ConstructorMessageForumTopicCreated                        = "messageForumTopicCreated"
+	ConstructorMessageForumTopicEdited                         = "messageForumTopicEdited"
+	ConstructorMessageForumTopicIsClosedToggled                = "messageForumTopicIsClosedToggled"
+	ConstructorMessageForumTopicIsHiddenToggled                = "messageForumTopicIsHiddenToggled"
+	ConstructorMessageSuggestProfilePhoto                      = "messageSuggestProfilePhoto"
+	ConstructorMessageCustomServiceAction                      = "messageCustomServiceAction"
+	ConstructorMessageGameScore                                = "messageGameScore"
+	ConstructorMessagePaymentSuccessful                        = "messagePaymentSuccessful"
+	ConstructorMessagePaymentSuccessfulBot                     = "messagePaymentSuccessfulBot"
+	ConstructorMessagePaymentRefunded                          = "messagePaymentRefunded"
+	ConstructorMessageGiftedPremium                            = "messageGiftedPremium"
+	ConstructorMessagePremiumGiftCode                          = "messagePremiumGiftCode"
+	ConstructorMessageGiveawayCreated                          = "messageGiveawayCreated"
+	ConstructorMessageGiveaway                                 = "messageGiveaway"
+	ConstructorMessageGiveawayCompleted                        = "messageGiveawayCompleted"
+	ConstructorMessageGiveawayWinners                          = "messageGiveawayWinners"
+	ConstructorMessageGiftedStars                              = "messageGiftedStars"
+	ConstructorMessageGiveawayPrizeStars                       = "messageGiveawayPrizeStars"
+	ConstructorMessageGift                                     = "messageGift"
+	ConstructorMessageUpgradedGift                             = "messageUpgradedGift"
+	ConstructorMessageRefundedUpgradedGift                     = "messageRefundedUpgradedGift"
+	ConstructorMessagePaidMessagesRefunded                     = "messagePaidMessagesRefunded"
+	ConstructorMessagePaidMessagePriceChanged                  = "messagePaidMessagePriceChanged"
+	ConstructorMessageContactRegistered                        = "messageContactRegistered"
+	ConstructorMessageUsersShared                              = "messageUsersShared"
+	ConstructorMessageChatShared                               = "messageChatShared"
+	ConstructorMessageBotWriteAccessAllowed                    = "messageBotWriteAccessAllowed"
+	ConstructorMessageWebAppDataSent                           = "messageWebAppDataSent"
+	ConstructorMessageWebAppDataReceived                       = "messageWebAppDataReceived"
+	ConstructorMessagePassportDataSent                         = "messagePassportDataSent"
+	ConstructorMessagePassportDataReceived                     = "messagePassportDataReceived"
+	ConstructorMessageProximityAlertTriggered                  = "messageProximityAlertTriggered"
+	ConstructorMessageUnsupported                              = "messageUnsupported"
+	ConstructorTextEntityTypeMention                           = "textEntityTypeMention"
+	ConstructorTextEntityTypeHashtag                           = "textEntityTypeHashtag"
+	ConstructorTextEntityTypeCashtag                           = "textEntityTypeCashtag"
+	ConstructorTextEntityTypeBotCommand                        = "textEntityTypeBotCommand"
+	ConstructorTextEntityTypeUrl                               = "textEntityTypeUrl"
+	ConstructorTextEntityTypeEmailAddress                      = "textEntityTypeEmailAddress"
+	ConstructorTextEntityTypePhoneNumber                       = "textEntityTypePhoneNumber"
+	ConstructorTextEntityTypeBankCardNumber                    = "textEntityTypeBankCardNumber"
+	ConstructorTextEntityTypeBold                              = "textEntityTypeBold"
+	ConstructorTextEntityTypeItalic                            = "textEntityTypeItalic"
+	ConstructorTextEntityTypeUnderline                         = "textEntityTypeUnderline"
+	ConstructorTextEntityTypeStrikethrough                     = "textEntityTypeStrikethrough"
+	ConstructorTextEntityTypeSpoiler                           = "textEntityTypeSpoiler"
+	ConstructorTextEntityTypeCode                              = "textEntityTypeCode"
+	ConstructorTextEntityTypePre                               = "textEntityTypePre"
+	ConstructorTextEntityTypePreCode                           = "textEntityTypePreCode"
+	ConstructorTextEntityTypeBlockQuote                        = "textEntityTypeBlockQuote"
+	ConstructorTextEntityTypeExpandableBlockQuote              = "textEntityTypeExpandableBlockQuote"
+	ConstructorTextEntityTypeTextUrl                           = "textEntityTypeTextUrl"
+	ConstructorTextEntityTypeMentionName                       = "textEntityTypeMentionName"
+	ConstructorTextEntityTypeCustomEmoji                       = "textEntityTypeCustomEmoji"
+	ConstructorTextEntityTypeMediaTimestamp                    = "textEntityTypeMediaTimestamp"
+	ConstructorMessageSchedulingStateSendAtDate                = "messageSchedulingStateSendAtDate"
+	ConstructorMessageSchedulingStateSendWhenOnline            = "messageSchedulingStateSendWhenOnline"
+	ConstructorMessageSchedulingStateSendWhenVideoProcessed    = "messageSchedulingStateSendWhenVideoProcessed"
+	ConstructorMessageSelfDestructTypeTimer                    = "messageSelfDestructTypeTimer"
+	ConstructorMessageSelfDestructTypeImmediately              = "messageSelfDestructTypeImmediately"
+	ConstructorMessageSendOptions                              = "messageSendOptions"
+	ConstructorMessageCopyOptions                              = "messageCopyOptions"
+	ConstructorInputMessageText                                = "inputMessageText"
+	ConstructorInputMessageAnimation                           = "inputMessageAnimation"
+	ConstructorInputMessageAudio                               = "inputMessageAudio"
+	ConstructorInputMessageDocument                            = "inputMessageDocument"
+	ConstructorInputMessagePaidMedia                           = "inputMessagePaidMedia"
+	ConstructorInputMessagePhoto                               = "inputMessagePhoto"
+	ConstructorInputMessageSticker                             = "inputMessageSticker"
+	ConstructorInputMessageVideo                               = "inputMessageVideo"
+	ConstructorInputMessageVideoNote                           = "inputMessageVideoNote"
+	ConstructorInputMessageVoiceNote                           = "inputMessageVoiceNote"
+	ConstructorInputMessageLocation                            = "inputMessageLocation"
+	ConstructorInputMessageVenue                               = "inputMessageVenue"
+	ConstructorInputMessageContact                             = "inputMessageContact"
+	ConstructorInputMessageDice                                = "inputMessageDice"
+	ConstructorInputMessageGame                                = "inputMessageGame"
+	ConstructorInputMessageInvoice                             = "inputMessageInvoice"
+	ConstructorInputMessagePoll                                = "inputMessagePoll"
+	ConstructorInputMessageStory                               = "inputMessageStory"
+	ConstructorInputMessageForwarded                           = "inputMessageForwarded"
+	ConstructorMessageProperties                               = "messageProperties"
+	ConstructorSearchMessagesFilterEmpty                       = "searchMessagesFilterEmpty"
+	ConstructorSearchMessagesFilterAnimation                   = "searchMessagesFilterAnimation"
+	ConstructorSearchMessagesFilterAudio                       = "searchMessagesFilterAudio"
+	ConstructorSearchMessagesFilterDocument                    = "searchMessagesFilterDocument"
+	ConstructorSearchMessagesFilterPhoto                       = "searchMessagesFilterPhoto"
+	ConstructorSearchMessagesFilterVideo                       = "searchMessagesFilterVideo"
+	ConstructorSearchMessagesFilterVoiceNote                   = "searchMessagesFilterVoiceNote"
+	ConstructorSearchMessagesFilterPhotoAndVideo               = "searchMessagesFilterPhotoAndVideo"
+	ConstructorSearchMessagesFilterUrl                         = "searchMessagesFilterUrl"
+	ConstructorSearchMessagesFilterChatPhoto                   = "searchMessagesFilterChatPhoto"
+	ConstructorSearchMessagesFilterVideoNote                   = "searchMessagesFilterVideoNote"
+	ConstructorSearchMessagesFilterVoiceAndVideoNote           = "searchMessagesFilterVoiceAndVideoNote"
+	ConstructorSearchMessagesFilterMention                     = "searchMessagesFilterMention"
+	ConstructorSearchMessagesFilterUnreadMention               = "searchMessagesFilterUnreadMention"
+	ConstructorSearchMessagesFilterUnreadReaction              = "searchMessagesFilterUnreadReaction"
+	ConstructorSearchMessagesFilterFailedToSend                = "searchMessagesFilterFailedToSend"
+	ConstructorSearchMessagesFilterPinned                      = "searchMessagesFilterPinned"
+	ConstructorSearchMessagesChatTypeFilterPrivate             = "searchMessagesChatTypeFilterPrivate"
+	ConstructorSearchMessagesChatTypeFilterGroup               = "searchMessagesChatTypeFilterGroup"
+	ConstructorSearchMessagesChatTypeFilterChannel             = "searchMessagesChatTypeFilterChannel"
+	ConstructorQuickReplyMessage                               = "quickReplyMessage"
+	ConstructorQuickReplyMessages                              = "quickReplyMessages"
+	ConstructorQuickReplyShortcut                              = "quickReplyShortcut"
+	ConstructorPublicForwardMessage                            = "publicForwardMessage"
+	ConstructorPublicForwardStory                              = "publicForwardStory"
+	ConstructorSentWebAppMessage                               = "sentWebAppMessage"
+	ConstructorPreparedInlineMessageId                         = "preparedInlineMessageId"
+	ConstructorPreparedInlineMessage                           = "preparedInlineMessage"
+	ConstructorMessageFileTypePrivate                          = "messageFileTypePrivate"
+	ConstructorMessageFileTypeGroup                            = "messageFileTypeGroup"
+	ConstructorMessageFileTypeUnknown                          = "messageFileTypeUnknown"
+	ConstructorPushMessageContentHidden                        = "pushMessageContentHidden"
+	ConstructorPushMessageContentAnimation                     = "pushMessageContentAnimation"
+	ConstructorPushMessageContentAudio                         = "pushMessageContentAudio"
+	ConstructorPushMessageContentContact                       = "pushMessageContentContact"
+	ConstructorPushMessageContentContactRegistered             = "pushMessageContentContactRegistered"
+	ConstructorPushMessageContentDocument                      = "pushMessageContentDocument"
+	ConstructorPushMessageContentGame                          = "pushMessageContentGame"
+	ConstructorPushMessageContentGameScore                     = "pushMessageContentGameScore"
+	ConstructorPushMessageContentInvoice                       = "pushMessageContentInvoice"
+	ConstructorPushMessageContentLocation                      = "pushMessageContentLocation"
+	ConstructorPushMessageContentPaidMedia                     = "pushMessageContentPaidMedia"
+	ConstructorPushMessageContentPhoto                         = "pushMessageContentPhoto"
+	ConstructorPushMessageContentPoll                          = "pushMessageContentPoll"
+	ConstructorPushMessageContentPremiumGiftCode               = "pushMessageContentPremiumGiftCode"
+	ConstructorPushMessageContentGiveaway                      = "pushMessageContentGiveaway"
+	ConstructorPushMessageContentGift                          = "pushMessageContentGift"
+	ConstructorPushMessageContentUpgradedGift                  = "pushMessageContentUpgradedGift"
+	ConstructorPushMessageContentScreenshotTaken               = "pushMessageContentScreenshotTaken"
+	ConstructorPushMessageContentSticker                       = "pushMessageContentSticker"
+	ConstructorPushMessageContentStory                         = "pushMessageContentStory"
+	ConstructorPushMessageContentText                          = "pushMessageContentText"
+	ConstructorPushMessageContentVideo                         = "pushMessageContentVideo"
+	ConstructorPushMessageContentVideoNote                     = "pushMessageContentVideoNote"
+	ConstructorPushMessageContentVoiceNote                     = "pushMessageContentVoiceNote"
+	ConstructorPushMessageContentBasicGroupChatCreate          = "pushMessageContentBasicGroupChatCreate"
+	ConstructorPushMessageContentVideoChatStarted              = "pushMessageContentVideoChatStarted"
+	ConstructorPushMessageContentVideoChatEnded                = "pushMessageContentVideoChatEnded"
+	ConstructorPushMessageContentInviteVideoChatParticipants   = "pushMessageContentInviteVideoChatParticipants"
+	ConstructorPushMessageContentChatAddMembers                = "pushMessageContentChatAddMembers"
+	ConstructorPushMessageContentChatChangePhoto               = "pushMessageContentChatChangePhoto"
+	ConstructorPushMessageContentChatChangeTitle               = "pushMessageContentChatChangeTitle"
+	ConstructorPushMessageContentChatSetBackground             = "pushMessageContentChatSetBackground"
+	ConstructorPushMessageContentChatSetTheme                  = "pushMessageContentChatSetTheme"
+	ConstructorPushMessageContentChatDeleteMember              = "pushMessageContentChatDeleteMember"
+	ConstructorPushMessageContentChatJoinByLink                = "pushMessageContentChatJoinByLink"
+	ConstructorPushMessageContentChatJoinByRequest             = "pushMessageContentChatJoinByRequest"
+	ConstructorPushMessageContentRecurringPayment              = "pushMessageContentRecurringPayment"
+	ConstructorPushMessageContentSuggestProfilePhoto           = "pushMessageContentSuggestProfilePhoto"
+	ConstructorPushMessageContentProximityAlertTriggered       = "pushMessageContentProximityAlertTriggered"
+	ConstructorPushMessageContentMessageForwards               = "pushMessageContentMessageForwards"
+	ConstructorPushMessageContentMediaAlbum                    = "pushMessageContentMediaAlbum"
+	ConstructorCanSendMessageToUserResultOk                    = "canSendMessageToUserResultOk"
+	ConstructorCanSendMessageToUserResultUserHasPaidMessages   = "canSendMessageToUserResultUserHasPaidMessages"
+	ConstructorCanSendMessageToUserResultUserIsDeleted         = "canSendMessageToUserResultUserIsDeleted"
+	ConstructorCanSendMessageToUserResultUserRestrictsNewChats = "canSendMessageToUserResultUserRestrictsNewChats"
+	ConstructorMessageAutoDeleteTime                           = "messageAutoDeleteTime"
+	ConstructorMessageLink                                     = "messageLink"
+	ConstructorMessageLinkInfo                                 = "messageLinkInfo"
+	ConstructorText                                            = "text"
+	ConstructorTextParseModeMarkdown                           = "textParseModeMarkdown"
+	ConstructorTextParseModeHTML                               = "textParseModeHTML"
+	ConstructorChatStatisticsMessageSenderInfo                 = "chatStatisticsMessageSenderInfo"
+	ConstructorMessageStatistics                               = "messageStatistics"
+)
+
+// Describes the type of poll
+type PollType interface {
+	PollTypeConstructor() string
+}
+
+// Contains information about the sender of a message
+type MessageSender interface {
+	MessageSenderConstructor() string
+}
+
+// Describes read date of a recent outgoing message in a private chat
+type MessageReadDate interface {
+	MessageReadDateConstructor() string
+}
+
+// Contains information about the origin of a message
+type MessageOrigin interface {
+	MessageOriginConstructor() string
+}
+
+// Describes type of emoji effect
+type MessageEffectType interface {
+	MessageEffectTypeConstructor() string
+}
+
+// Contains information about the sending state of the message
+type MessageSendingState interface {
+	MessageSendingStateConstructor() string
+}
+
+// Contains information about the message or the story a message is replying to
+type MessageReplyTo interface {
+	MessageReplyToConstructor() string
+}
+
+// Contains information about the message or the story to be replied
+type InputMessageReplyTo interface {
+	InputMessageReplyToConstructor() string
+}
+
+// Describes source of a message
+type MessageSource interface {
+	MessageSourceConstructor() string
+}
+
+// Describes a keyboard button type
+type KeyboardButtonType interface {
+	KeyboardButtonTypeConstructor() string
+}
+
+// Describes the type of inline keyboard button
+type InlineKeyboardButtonType interface {
+	InlineKeyboardButtonTypeConstructor() string
+}
+
+// Contains a description of a custom keyboard and actions that can be done with it to quickly reply to bots
+type ReplyMarkup interface {
+	ReplyMarkupConstructor() string
+}
+
+// Describes type of Saved Messages topic
+type SavedMessagesTopicType interface {
+	SavedMessagesTopicTypeConstructor() string
+}
+
+// Describes a formatted text object
+type RichText interface {
+	RichTextConstructor() string
+}
+
+// Contains the content of a message
+type MessageContent interface {
+	MessageContentConstructor() string
+}
+
+// Represents a part of the text which must be formatted differently
+type TextEntityType interface {
+	TextEntityTypeConstructor() string
+}
+
+// Contains information about the time when a scheduled message will be sent
+type MessageSchedulingState interface {
+	MessageSchedulingStateConstructor() string
+}
+
+// Describes when a message will be self-destructed
+type MessageSelfDestructType interface {
+	MessageSelfDestructTypeConstructor() string
+}
+
+// The content of a message to send
+type InputMessageContent interface {
+	InputMessageContentConstructor() string
+}
+
+// Represents a filter for message search results
+type SearchMessagesFilter interface {
+	SearchMessagesFilterConstructor() string
+}
+
+// Represents a filter for type of the chats in which to search messages
+type SearchMessagesChatTypeFilter interface {
+	SearchMessagesChatTypeFilterConstructor() string
+}
+
+// Describes a public forward or repost of a story
+type PublicForward interface {
+	PublicForwardConstructor() string
+}
+
+// Contains information about a file with messages exported from another app
+type MessageFileType interface {
+	MessageFileTypeConstructor() string
+}
+
+// Contains content of a push message notification
+type PushMessageContent interface {
+	PushMessageContentConstructor() string
+}
+
+// Describes result of canSendMessageToUser
+type CanSendMessageToUserResult interface {
+	CanSendMessageToUserResultConstructor() string
+}
+
+// Describes the way the text needs to be parsed for text entities
+type TextParseMode interface {
+	TextParseModeConstructor() string
+}
+
+func (entity *UnknownType) PollTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageSenderConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageReadDateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageOriginConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageEffectTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageSendingStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageReplyToConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputMessageReplyToConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageSourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) KeyboardButtonTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InlineKeyboardButtonTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReplyMarkupConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SavedMessagesTopicTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) RichTextConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageContentConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) TextEntityTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageSchedulingStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageSelfDestructTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputMessageContentConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SearchMessagesFilterConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SearchMessagesChatTypeFilterConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PublicForwardConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MessageFileTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PushMessageContentConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CanSendMessageToUserResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) TextParseModeConstructor() string {
+	return entity.Constructor
+}
+
+// Represents a part of the text that needs to be formatted in some unusual way
+type TextEntity struct {
+	meta
+	// Offset of the entity, in UTF-16 code units
+	Offset int32 `json:"offset"`
+	// Length of the entity, in UTF-16 code units
+	Length int32 `json:"length"`
+	// Type of the entity
+	Type TextEntityType `json:"type"`
+}
+
+func (*TextEntity) GetType() string {
+	return TypeTextEntity
+}
+
+func (*TextEntity) GetConstructor() string {
+	return ConstructorTextEntity
+}
+
+func (entity *TextEntity) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntity) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntity) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntity"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeInt32(entity.Offset)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntity) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "offset":
+			entity.Offset, err = decoder.readInt32()
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		case "type":
+			entity.Type, err = decodeTextEntityType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextEntity)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of text entities
+type TextEntities struct {
+	meta
+	// List of text entities
+	Entities []*TextEntity `json:"entities"`
+}
+
+func (*TextEntities) GetType() string {
+	return TypeTextEntities
+}
+
+func (*TextEntities) GetConstructor() string {
+	return ConstructorTextEntities
+}
+
+func (entity *TextEntities) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntities) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntities) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntities"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"entities":`)
+	writeList(encoder, entity.Entities, writeTypeValue[*TextEntity])
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntities) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "entities":
+			entity.Entities, err = readList(decoder, decodeEntity[TextEntity])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextEntities)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A text with some entities
+type FormattedText struct {
+	meta
+	// The text
+	Text string `json:"text"`
+	// Entities contained in the text. Entities can be nested, but must not mutually intersect with each other. Pre, Code and PreCode entities can't contain other entities. BlockQuote entities can't contain other BlockQuote entities. Bold, Italic, Underline, Strikethrough, and Spoiler entities can contain and can be part of any other entities. All other entities can't contain each other
+	Entities []*TextEntity `json:"entities"`
+}
+
+func (*FormattedText) GetType() string {
+	return TypeFormattedText
+}
+
+func (*FormattedText) GetConstructor() string {
+	return ConstructorFormattedText
+}
+
+func (entity *FormattedText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FormattedText) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FormattedText) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"formattedText"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"entities":`)
+	writeList(encoder, entity.Entities, writeTypeValue[*TextEntity])
+	encoder.writeRaw("}")
+}
+
+func (entity *FormattedText) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "entities":
+			entity.Entities, err = readList(decoder, decodeEntity[TextEntity])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFormattedText)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes one answer option of a poll
+type PollOption struct {
+	meta
+	// Option text; 1-100 characters. Only custom emoji entities are allowed
+	Text *FormattedText `json:"text"`
+	// Number of voters for this option, available only for closed or voted polls
+	VoterCount int32 `json:"voter_count"`
+	// The percentage of votes for this option; 0-100
+	VotePercentage int32 `json:"vote_percentage"`
+	// True, if the option was chosen by the user
+	IsChosen bool `json:"is_chosen"`
+	// True, if the option is being chosen by a pending setPollAnswer request
+	IsBeingChosen bool `json:"is_being_chosen"`
+}
+
+func (*PollOption) GetType() string {
+	return TypePollOption
+}
+
+func (*PollOption) GetConstructor() string {
+	return ConstructorPollOption
+}
+
+func (entity *PollOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PollOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PollOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pollOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"voter_count":`)
+	encoder.writeInt32(entity.VoterCount)
+	encoder.writeRaw(`,"vote_percentage":`)
+	encoder.writeInt32(entity.VotePercentage)
+	encoder.writeRaw(`,"is_chosen":`)
+	encoder.writeBool(entity.IsChosen)
+	encoder.writeRaw(`,"is_being_chosen":`)
+	encoder.writeBool(entity.IsBeingChosen)
+	encoder.writeRaw("}")
+}
+
+func (entity *PollOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "voter_count":
+			entity.VoterCount, err = decoder.readInt32()
+
+		case "vote_percentage":
+			entity.VotePercentage, err = decoder.readInt32()
+
+		case "is_chosen":
+			entity.IsChosen, err = decoder.readBool()
+
+		case "is_being_chosen":
+			entity.IsBeingChosen, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPollOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PollOption) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateFormattedTextLength("text", entity.Text, 1, 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A regular poll
+type PollTypeRegular struct {
+	meta
+	// True, if multiple answer options can be chosen simultaneously
+	AllowMultipleAnswers bool `json:"allow_multiple_answers"`
+}
+
+func (*PollTypeRegular) GetType() string {
+	return TypePollType
+}
+
+func (*PollTypeRegular) GetConstructor() string {
+	return ConstructorPollTypeRegular
+}
+
+func (*PollTypeRegular) PollTypeConstructor() string {
+	return ConstructorPollTypeRegular
+}
+
+func (entity *PollTypeRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PollTypeRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PollTypeRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pollTypeRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"allow_multiple_answers":`)
+	encoder.writeBool(entity.AllowMultipleAnswers)
+	encoder.writeRaw("}")
+}
+
+func (entity *PollTypeRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "allow_multiple_answers":
+			entity.AllowMultipleAnswers, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPollTypeRegular)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A poll in quiz mode, which has exactly one correct answer option and can be answered only once
+type PollTypeQuiz struct {
+	meta
+	// 0-based identifier of the correct answer option; -1 for a yet unanswered poll
+	CorrectOptionId int32 `json:"correct_option_id"`
+	// Text that is shown when the user chooses an incorrect answer or taps on the lamp icon; 0-200 characters with at most 2 line feeds; empty for a yet unanswered poll
+	Explanation *FormattedText `json:"explanation"`
+}
+
+func (*PollTypeQuiz) GetType() string {
+	return TypePollType
+}
+
+func (*PollTypeQuiz) GetConstructor() string {
+	return ConstructorPollTypeQuiz
+}
+
+func (*PollTypeQuiz) PollTypeConstructor() string {
+	return ConstructorPollTypeQuiz
+}
+
+func (entity *PollTypeQuiz) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PollTypeQuiz) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PollTypeQuiz) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pollTypeQuiz"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"correct_option_id":`)
+	encoder.writeInt32(entity.CorrectOptionId)
+	encoder.writeRaw(`,"explanation":`)
+	entity.Explanation.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PollTypeQuiz) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "correct_option_id":
+			entity.CorrectOptionId, err = decoder.readInt32()
+
+		case "explanation":
+			entity.Explanation, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPollTypeQuiz)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PollTypeQuiz) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateFormattedTextLength("explanation", entity.Explanation, 0, 200); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Describes a poll
+type Poll struct {
+	meta
+	// Unique poll identifier
+	Id JsonInt64 `json:"id"`
+	// Poll question; 1-300 characters. Only custom emoji entities are allowed
+	Question *FormattedText `json:"question"`
+	// List of poll answer options
+	Options []*PollOption `json:"options"`
+	// Total number of voters, participating in the poll
+	TotalVoterCount int32 `json:"total_voter_count"`
+	// Identifiers of recent voters, if the poll is non-anonymous
+	RecentVoterIds []MessageSender `json:"recent_voter_ids"`
+	// True, if the poll is anonymous
+	IsAnonymous bool `json:"is_anonymous"`
+	// Type of the poll
+	Type PollType `json:"type"`
+	// Amount of time the poll will be active after creation, in seconds
+	OpenPeriod int32 `json:"open_period"`
+	// Point in time (Unix timestamp) when the poll will automatically be closed
+	CloseDate int32 `json:"close_date"`
+	// True, if the poll is closed
+	IsClosed bool `json:"is_closed"`
+}
+
+func (*Poll) GetType() string {
+	return TypePoll
+}
+
+func (*Poll) GetConstructor() string {
+	return ConstructorPoll
+}
+
+func (entity *Poll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Poll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Poll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"poll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"question":`)
+	entity.Question.encodeJSON(encoder)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*PollOption])
+	encoder.writeRaw(`,"total_voter_count":`)
+	encoder.writeInt32(entity.TotalVoterCount)
+	encoder.writeRaw(`,"recent_voter_ids":`)
+	writeList(encoder, entity.RecentVoterIds, writeTypeValue[MessageSender])
+	encoder.writeRaw(`,"is_anonymous":`)
+	encoder.writeBool(entity.IsAnonymous)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"open_period":`)
+	encoder.writeInt32(entity.OpenPeriod)
+	encoder.writeRaw(`,"close_date":`)
+	encoder.writeInt32(entity.CloseDate)
+	encoder.writeRaw(`,"is_closed":`)
+	encoder.writeBool(entity.IsClosed)
+	encoder.writeRaw("}")
+}
+
+func (entity *Poll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "question":
+			entity.Question, err = decodeEntity[FormattedText](decoder)
+
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[PollOption])
+
+		case "total_voter_count":
+			entity.TotalVoterCount, err = decoder.readInt32()
+
+		case "recent_voter_ids":
+			entity.RecentVoterIds, err = readList(decoder, decodeMessageSender)
+
+		case "is_anonymous":
+			entity.IsAnonymous, err = decoder.readBool()
+
+		case "type":
+			entity.Type, err = decodePollType(decoder)
+
+		case "open_period":
+			entity.OpenPeriod, err = decoder.readInt32()
+
+		case "close_date":
+			entity.CloseDate, err = decoder.readInt32()
+
+		case "is_closed":
+			entity.IsClosed, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPoll)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Poll) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateFormattedTextLength("question", entity.Question, 1, 300); err != nil {
+		return err
+	}
+
+	for i, item := range entity.Options {
+		if err := validateNested(indexField("options", i), item); err != nil {
+			return err
+		}
+	}
+
+	if err := validateNested("type", entity.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The message was sent by a known user
+type MessageSenderUser struct {
+	meta
+	// Identifier of the user that sent the message
+	UserId int64 `json:"user_id"`
+}
+
+func (*MessageSenderUser) GetType() string {
+	return TypeMessageSender
+}
+
+func (*MessageSenderUser) GetConstructor() string {
+	return ConstructorMessageSenderUser
+}
+
+func (*MessageSenderUser) MessageSenderConstructor() string {
+	return ConstructorMessageSenderUser
+}
+
+func (entity *MessageSenderUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSenderUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSenderUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSenderUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSenderUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSenderUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message was sent on behalf of a chat
+type MessageSenderChat struct {
+	meta
+	// Identifier of the chat that sent the message
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*MessageSenderChat) GetType() string {
+	return TypeMessageSender
+}
+
+func (*MessageSenderChat) GetConstructor() string {
+	return ConstructorMessageSenderChat
+}
+
+func (*MessageSenderChat) MessageSenderConstructor() string {
+	return ConstructorMessageSenderChat
+}
+
+func (entity *MessageSenderChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSenderChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSenderChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSenderChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSenderChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSenderChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of message senders
+type MessageSenders struct {
+	meta
+	// Approximate total number of messages senders found
+	TotalCount int32 `json:"total_count"`
+	// List of message senders
+	Senders []MessageSender `json:"senders"`
+}
+
+func (*MessageSenders) GetType() string {
+	return TypeMessageSenders
+}
+
+func (*MessageSenders) GetConstructor() string {
+	return ConstructorMessageSenders
+}
+
+func (entity *MessageSenders) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSenders) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSenders) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSenders"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"senders":`)
+	writeList(encoder, entity.Senders, writeTypeValue[MessageSender])
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSenders) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "senders":
+			entity.Senders, err = readList(decoder, decodeMessageSender)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSenders)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a message sender, which can be used to send messages in a chat
+type ChatMessageSender struct {
+	meta
+	// The message sender
+	Sender MessageSender `json:"sender"`
+	// True, if Telegram Premium is needed to use the message sender
+	NeedsPremium bool `json:"needs_premium"`
+}
+
+func (*ChatMessageSender) GetType() string {
+	return TypeChatMessageSender
+}
+
+func (*ChatMessageSender) GetConstructor() string {
+	return ConstructorChatMessageSender
+}
+
+func (entity *ChatMessageSender) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMessageSender) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMessageSender) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMessageSender"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender":`)
+	encoder.writeType(entity.Sender)
+	encoder.writeRaw(`,"needs_premium":`)
+	encoder.writeBool(entity.NeedsPremium)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMessageSender) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender":
+			entity.Sender, err = decodeMessageSender(decoder)
+
+		case "needs_premium":
+			entity.NeedsPremium, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMessageSender)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of message senders, which can be used to send messages in a chat
+type ChatMessageSenders struct {
+	meta
+	// List of available message senders
+	Senders []*ChatMessageSender `json:"senders"`
+}
+
+func (*ChatMessageSenders) GetType() string {
+	return TypeChatMessageSenders
+}
+
+func (*ChatMessageSenders) GetConstructor() string {
+	return ConstructorChatMessageSenders
+}
+
+func (entity *ChatMessageSenders) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatMessageSenders) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatMessageSenders) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatMessageSenders"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"senders":`)
+	writeList(encoder, entity.Senders, writeTypeValue[*ChatMessageSender])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatMessageSenders) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "senders":
+			entity.Senders, err = readList(decoder, decodeEntity[ChatMessageSender])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatMessageSenders)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains read date of the message
+type MessageReadDateRead struct {
+	meta
+	// Point in time (Unix timestamp) when the message was read by the other user
+	ReadDate int32 `json:"read_date"`
+}
+
+func (*MessageReadDateRead) GetType() string {
+	return TypeMessageReadDate
+}
+
+func (*MessageReadDateRead) GetConstructor() string {
+	return ConstructorMessageReadDateRead
+}
+
+func (*MessageReadDateRead) MessageReadDateConstructor() string {
+	return ConstructorMessageReadDateRead
+}
+
+func (entity *MessageReadDateRead) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReadDateRead) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReadDateRead) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReadDateRead"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"read_date":`)
+	encoder.writeInt32(entity.ReadDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReadDateRead) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "read_date":
+			entity.ReadDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageReadDateRead)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message is unread yet
+type MessageReadDateUnread struct {
+	meta
+}
+
+func (*MessageReadDateUnread) GetType() string {
+	return TypeMessageReadDate
+}
+
+func (*MessageReadDateUnread) GetConstructor() string {
+	return ConstructorMessageReadDateUnread
+}
+
+func (*MessageReadDateUnread) MessageReadDateConstructor() string {
+	return ConstructorMessageReadDateUnread
+}
+
+func (entity *MessageReadDateUnread) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReadDateUnread) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReadDateUnread) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReadDateUnread"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReadDateUnread) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageReadDateUnread)
+	})
+}
+
+// The message is too old to get read date
+type MessageReadDateTooOld struct {
+	meta
+}
+
+func (*MessageReadDateTooOld) GetType() string {
+	return TypeMessageReadDate
+}
+
+func (*MessageReadDateTooOld) GetConstructor() string {
+	return ConstructorMessageReadDateTooOld
+}
+
+func (*MessageReadDateTooOld) MessageReadDateConstructor() string {
+	return ConstructorMessageReadDateTooOld
+}
+
+func (entity *MessageReadDateTooOld) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReadDateTooOld) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReadDateTooOld) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReadDateTooOld"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReadDateTooOld) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageReadDateTooOld)
+	})
+}
+
+// The read date is unknown due to privacy settings of the other user
+type MessageReadDateUserPrivacyRestricted struct {
+	meta
+}
+
+func (*MessageReadDateUserPrivacyRestricted) GetType() string {
+	return TypeMessageReadDate
+}
+
+func (*MessageReadDateUserPrivacyRestricted) GetConstructor() string {
+	return ConstructorMessageReadDateUserPrivacyRestricted
+}
+
+func (*MessageReadDateUserPrivacyRestricted) MessageReadDateConstructor() string {
+	return ConstructorMessageReadDateUserPrivacyRestricted
+}
+
+func (entity *MessageReadDateUserPrivacyRestricted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReadDateUserPrivacyRestricted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReadDateUserPrivacyRestricted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReadDateUserPrivacyRestricted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReadDateUserPrivacyRestricted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageReadDateUserPrivacyRestricted)
+	})
+}
+
+// The read date is unknown due to privacy settings of the current user, but will be known if the user subscribes to Telegram Premium
+type MessageReadDateMyPrivacyRestricted struct {
+	meta
+}
+
+func (*MessageReadDateMyPrivacyRestricted) GetType() string {
+	return TypeMessageReadDate
+}
+
+func (*MessageReadDateMyPrivacyRestricted) GetConstructor() string {
+	return ConstructorMessageReadDateMyPrivacyRestricted
+}
+
+func (*MessageReadDateMyPrivacyRestricted) MessageReadDateConstructor() string {
+	return ConstructorMessageReadDateMyPrivacyRestricted
+}
+
+func (entity *MessageReadDateMyPrivacyRestricted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReadDateMyPrivacyRestricted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReadDateMyPrivacyRestricted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReadDateMyPrivacyRestricted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReadDateMyPrivacyRestricted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageReadDateMyPrivacyRestricted)
+	})
+}
+
+// Represents a viewer of a message
+type MessageViewer struct {
+	meta
+	// User identifier of the viewer
+	UserId int64 `json:"user_id"`
+	// Approximate point in time (Unix timestamp) when the message was viewed
+	ViewDate int32 `json:"view_date"`
+}
+
+func (*MessageViewer) GetType() string {
+	return TypeMessageViewer
+}
+
+func (*MessageViewer) GetConstructor() string {
+	return ConstructorMessageViewer
+}
+
+func (entity *MessageViewer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageViewer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageViewer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageViewer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"view_date":`)
+	encoder.writeInt32(entity.ViewDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageViewer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "view_date":
+			entity.ViewDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageViewer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of message viewers
+type MessageViewers struct {
+	meta
+	// List of message viewers
+	Viewers []*MessageViewer `json:"viewers"`
+}
+
+func (*MessageViewers) GetType() string {
+	return TypeMessageViewers
+}
+
+func (*MessageViewers) GetConstructor() string {
+	return ConstructorMessageViewers
+}
+
+func (entity *MessageViewers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageViewers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageViewers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageViewers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"viewers":`)
+	writeList(encoder, entity.Viewers, writeTypeValue[*MessageViewer])
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageViewers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "viewers":
+			entity.Viewers, err = readList(decoder, decodeEntity[MessageViewer])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageViewers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message was originally sent by a known user
+type MessageOriginUser struct {
+	meta
+	// Identifier of the user that originally sent the message
+	SenderUserId int64 `json:"sender_user_id"`
+}
+
+func (*MessageOriginUser) GetType() string {
+	return TypeMessageOrigin
+}
+
+func (*MessageOriginUser) GetConstructor() string {
+	return ConstructorMessageOriginUser
+}
+
+func (*MessageOriginUser) MessageOriginConstructor() string {
+	return ConstructorMessageOriginUser
+}
+
+func (entity *MessageOriginUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageOriginUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageOriginUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageOriginUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageOriginUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageOriginUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message was originally sent by a user, which is hidden by their privacy settings
+type MessageOriginHiddenUser struct {
+	meta
+	// Name of the sender
+	SenderName string `json:"sender_name"`
+}
+
+func (*MessageOriginHiddenUser) GetType() string {
+	return TypeMessageOrigin
+}
+
+func (*MessageOriginHiddenUser) GetConstructor() string {
+	return ConstructorMessageOriginHiddenUser
+}
+
+func (*MessageOriginHiddenUser) MessageOriginConstructor() string {
+	return ConstructorMessageOriginHiddenUser
+}
+
+func (entity *MessageOriginHiddenUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageOriginHiddenUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageOriginHiddenUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageOriginHiddenUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_name":`)
+	encoder.writeString(entity.SenderName)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageOriginHiddenUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_name":
+			entity.SenderName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageOriginHiddenUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message was originally sent on behalf of a chat
+type MessageOriginChat struct {
+	meta
+	// Identifier of the chat that originally sent the message
+	SenderChatId int64 `json:"sender_chat_id"`
+	// For messages originally sent by an anonymous chat administrator, original message author signature
+	AuthorSignature string `json:"author_signature"`
+}
+
+func (*MessageOriginChat) GetType() string {
+	return TypeMessageOrigin
+}
+
+func (*MessageOriginChat) GetConstructor() string {
+	return ConstructorMessageOriginChat
+}
+
+func (*MessageOriginChat) MessageOriginConstructor() string {
+	return ConstructorMessageOriginChat
+}
+
+func (entity *MessageOriginChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageOriginChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageOriginChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageOriginChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_chat_id":`)
+	encoder.writeInt64(entity.SenderChatId)
+	encoder.writeRaw(`,"author_signature":`)
+	encoder.writeString(entity.AuthorSignature)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageOriginChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_chat_id":
+			entity.SenderChatId, err = decoder.readInt64()
+
+		case "author_signature":
+			entity.AuthorSignature, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageOriginChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message was originally a post in a channel
+type MessageOriginChannel struct {
+	meta
+	// Identifier of the channel chat to which the message was originally sent
+	ChatId int64 `json:"chat_id"`
+	// Message identifier of the original message
+	MessageId int64 `json:"message_id"`
+	// Original post author signature
+	AuthorSignature string `json:"author_signature"`
+}
+
+func (*MessageOriginChannel) GetType() string {
+	return TypeMessageOrigin
+}
+
+func (*MessageOriginChannel) GetConstructor() string {
+	return ConstructorMessageOriginChannel
+}
+
+func (*MessageOriginChannel) MessageOriginConstructor() string {
+	return ConstructorMessageOriginChannel
+}
+
+func (entity *MessageOriginChannel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageOriginChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageOriginChannel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageOriginChannel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"author_signature":`)
+	encoder.writeString(entity.AuthorSignature)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageOriginChannel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "author_signature":
+			entity.AuthorSignature, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageOriginChannel)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about the last message from which a new message was forwarded last time
+type ForwardSource struct {
+	meta
+	// Identifier of the chat to which the message that was forwarded belonged; may be 0 if unknown
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message; may be 0 if unknown
+	MessageId int64 `json:"message_id"`
+	// Identifier of the sender of the message; may be null if unknown or the new message was forwarded not to Saved Messages
+	SenderId MessageSender `json:"sender_id,omitempty"`
+	// Name of the sender of the message if the sender is hidden by their privacy settings
+	SenderName string `json:"sender_name"`
+	// Point in time (Unix timestamp) when the message is sent; 0 if unknown
+	Date int32 `json:"date"`
+	// True, if the message that was forwarded is outgoing; always false if sender is unknown
+	IsOutgoing bool `json:"is_outgoing"`
+}
+
+func (*ForwardSource) GetType() string {
+	return TypeForwardSource
+}
+
+func (*ForwardSource) GetConstructor() string {
+	return ConstructorForwardSource
+}
+
+func (entity *ForwardSource) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ForwardSource) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ForwardSource) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"forwardSource"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	if entity.SenderId != nil {
+		encoder.writeRaw(`,"sender_id":`)
+		encoder.writeType(entity.SenderId)
+	}
+	encoder.writeRaw(`,"sender_name":`)
+	encoder.writeString(entity.SenderName)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"is_outgoing":`)
+	encoder.writeBool(entity.IsOutgoing)
+	encoder.writeRaw("}")
+}
+
+func (entity *ForwardSource) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "sender_name":
+			entity.SenderName, err = decoder.readString()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "is_outgoing":
+			entity.IsOutgoing, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorForwardSource)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a forwarded message
+type MessageForwardInfo struct {
+	meta
+	// Origin of the forwarded message
+	Origin MessageOrigin `json:"origin"`
+	// Point in time (Unix timestamp) when the message was originally sent
+	Date int32 `json:"date"`
+	// For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, information about the source message from which the message was forwarded last time; may be null for other forwards or if unknown
+	Source *ForwardSource `json:"source,omitempty"`
+	// The type of public service announcement for the forwarded message
+	PublicServiceAnnouncementType string `json:"public_service_announcement_type"`
+}
+
+func (*MessageForwardInfo) GetType() string {
+	return TypeMessageForwardInfo
+}
+
+func (*MessageForwardInfo) GetConstructor() string {
+	return ConstructorMessageForwardInfo
+}
+
+func (entity *MessageForwardInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageForwardInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageForwardInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageForwardInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"origin":`)
+	encoder.writeType(entity.Origin)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	if entity.Source != nil {
+		encoder.writeRaw(`,"source":`)
+		entity.Source.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"public_service_announcement_type":`)
+	encoder.writeString(entity.PublicServiceAnnouncementType)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageForwardInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "origin":
+			entity.Origin, err = decodeMessageOrigin(decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "source":
+			entity.Source, err = decodeEntity[ForwardSource](decoder)
+
+		case "public_service_announcement_type":
+			entity.PublicServiceAnnouncementType, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageForwardInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a message created with importMessages
+type MessageImportInfo struct {
+	meta
+	// Name of the original sender
+	SenderName string `json:"sender_name"`
+	// Point in time (Unix timestamp) when the message was originally sent
+	Date int32 `json:"date"`
+}
+
+func (*MessageImportInfo) GetType() string {
+	return TypeMessageImportInfo
+}
+
+func (*MessageImportInfo) GetConstructor() string {
+	return ConstructorMessageImportInfo
+}
+
+func (entity *MessageImportInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageImportInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageImportInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageImportInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_name":`)
+	encoder.writeString(entity.SenderName)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageImportInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_name":
+			entity.SenderName, err = decoder.readString()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageImportInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about replies to a message
+type MessageReplyInfo struct {
+	meta
+	// Number of times the message was directly or indirectly replied
+	ReplyCount int32 `json:"reply_count"`
+	// Identifiers of at most 3 recent repliers to the message; available in channels with a discussion supergroup. The users and chats are expected to be inaccessible: only their photo and name will be available
+	RecentReplierIds []MessageSender `json:"recent_replier_ids"`
+	// Identifier of the last read incoming reply to the message
+	LastReadInboxMessageId int64 `json:"last_read_inbox_message_id"`
+	// Identifier of the last read outgoing reply to the message
+	LastReadOutboxMessageId int64 `json:"last_read_outbox_message_id"`
+	// Identifier of the last reply to the message
+	LastMessageId int64 `json:"last_message_id"`
+}
+
+func (*MessageReplyInfo) GetType() string {
+	return TypeMessageReplyInfo
+}
+
+func (*MessageReplyInfo) GetConstructor() string {
+	return ConstructorMessageReplyInfo
+}
+
+func (entity *MessageReplyInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReplyInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReplyInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReplyInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reply_count":`)
+	encoder.writeInt32(entity.ReplyCount)
+	encoder.writeRaw(`,"recent_replier_ids":`)
+	writeList(encoder, entity.RecentReplierIds, writeTypeValue[MessageSender])
+	encoder.writeRaw(`,"last_read_inbox_message_id":`)
+	encoder.writeInt64(entity.LastReadInboxMessageId)
+	encoder.writeRaw(`,"last_read_outbox_message_id":`)
+	encoder.writeInt64(entity.LastReadOutboxMessageId)
+	encoder.writeRaw(`,"last_message_id":`)
+	encoder.writeInt64(entity.LastMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReplyInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reply_count":
+			entity.ReplyCount, err = decoder.readInt32()
+
+		case "recent_replier_ids":
+			entity.RecentReplierIds, err = readList(decoder, decodeMessageSender)
+
+		case "last_read_inbox_message_id":
+			entity.LastReadInboxMessageId, err = decoder.readInt64()
+
+		case "last_read_outbox_message_id":
+			entity.LastReadOutboxMessageId, err = decoder.readInt64()
+
+		case "last_message_id":
+			entity.LastMessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageReplyInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about interactions with a message
+type MessageInteractionInfo struct {
+	meta
+	// Number of times the message was viewed
+	ViewCount int32 `json:"view_count"`
+	// Number of times the message was forwarded
+	ForwardCount int32 `json:"forward_count"`
+	// Information about direct or indirect replies to the message; may be null. Currently, available only in channels with a discussion supergroup and discussion supergroups for messages, which are not replies itself
+	ReplyInfo *MessageReplyInfo `json:"reply_info,omitempty"`
+	// The list of reactions or tags added to the message; may be null
+	Reactions *MessageReactions `json:"reactions,omitempty"`
+}
+
+func (*MessageInteractionInfo) GetType() string {
+	return TypeMessageInteractionInfo
+}
+
+func (*MessageInteractionInfo) GetConstructor() string {
+	return ConstructorMessageInteractionInfo
+}
+
+func (entity *MessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageInteractionInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageInteractionInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageInteractionInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"view_count":`)
+	encoder.writeInt32(entity.ViewCount)
+	encoder.writeRaw(`,"forward_count":`)
+	encoder.writeInt32(entity.ForwardCount)
+	if entity.ReplyInfo != nil {
+		encoder.writeRaw(`,"reply_info":`)
+		entity.ReplyInfo.encodeJSON(encoder)
+	}
+	if entity.Reactions != nil {
+		encoder.writeRaw(`,"reactions":`)
+		entity.Reactions.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageInteractionInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "view_count":
+			entity.ViewCount, err = decoder.readInt32()
+
+		case "forward_count":
+			entity.ForwardCount, err = decoder.readInt32()
+
+		case "reply_info":
+			entity.ReplyInfo, err = decodeEntity[MessageReplyInfo](decoder)
+
+		case "reactions":
+			entity.Reactions, err = decodeEntity[MessageReactions](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageInteractionInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An effect from an emoji reaction
+type MessageEffectTypeEmojiReaction struct {
+	meta
+	// Select animation for the effect in TGS format
+	SelectAnimation *Sticker `json:"select_animation"`
+	// Effect animation for the effect in TGS format
+	EffectAnimation *Sticker `json:"effect_animation"`
+}
+
+func (*MessageEffectTypeEmojiReaction) GetType() string {
+	return TypeMessageEffectType
+}
+
+func (*MessageEffectTypeEmojiReaction) GetConstructor() string {
+	return ConstructorMessageEffectTypeEmojiReaction
+}
+
+func (*MessageEffectTypeEmojiReaction) MessageEffectTypeConstructor() string {
+	return ConstructorMessageEffectTypeEmojiReaction
+}
+
+func (entity *MessageEffectTypeEmojiReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageEffectTypeEmojiReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageEffectTypeEmojiReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageEffectTypeEmojiReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"select_animation":`)
+	entity.SelectAnimation.encodeJSON(encoder)
+	encoder.writeRaw(`,"effect_animation":`)
+	entity.EffectAnimation.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageEffectTypeEmojiReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "select_animation":
+			entity.SelectAnimation, err = decodeEntity[Sticker](decoder)
+
+		case "effect_animation":
+			entity.EffectAnimation, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageEffectTypeEmojiReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An effect from a premium sticker
+type MessageEffectTypePremiumSticker struct {
+	meta
+	// The premium sticker. The effect can be found at sticker.full_type.premium_animation
+	Sticker *Sticker `json:"sticker"`
+}
+
+func (*MessageEffectTypePremiumSticker) GetType() string {
+	return TypeMessageEffectType
+}
+
+func (*MessageEffectTypePremiumSticker) GetConstructor() string {
+	return ConstructorMessageEffectTypePremiumSticker
+}
+
+func (*MessageEffectTypePremiumSticker) MessageEffectTypeConstructor() string {
+	return ConstructorMessageEffectTypePremiumSticker
+}
+
+func (entity *MessageEffectTypePremiumSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageEffectTypePremiumSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageEffectTypePremiumSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageEffectTypePremiumSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageEffectTypePremiumSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageEffectTypePremiumSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an effect added to a message
+type MessageEffect struct {
+	meta
+	// Unique identifier of the effect
+	Id JsonInt64 `json:"id"`
+	// Static icon for the effect in WEBP format; may be null if none
+	StaticIcon *Sticker `json:"static_icon,omitempty"`
+	// Emoji corresponding to the effect that can be used if static icon isn't available
+	Emoji string `json:"emoji"`
+	// True, if Telegram Premium subscription is required to use the effect
+	IsPremium bool `json:"is_premium"`
+	// Type of the effect
+	Type MessageEffectType `json:"type"`
+}
+
+func (*MessageEffect) GetType() string {
+	return TypeMessageEffect
+}
+
+func (*MessageEffect) GetConstructor() string {
+	return ConstructorMessageEffect
+}
+
+func (entity *MessageEffect) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageEffect) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageEffect) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageEffect"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	if entity.StaticIcon != nil {
+		encoder.writeRaw(`,"static_icon":`)
+		entity.StaticIcon.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"is_premium":`)
+	encoder.writeBool(entity.IsPremium)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageEffect) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "static_icon":
+			entity.StaticIcon, err = decodeEntity[Sticker](decoder)
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "is_premium":
+			entity.IsPremium, err = decoder.readBool()
+
+		case "type":
+			entity.Type, err = decodeMessageEffectType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageEffect)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message is being sent now, but has not yet been delivered to the server
+type MessageSendingStatePending struct {
+	meta
+	// Non-persistent message sending identifier, specified by the application
+	SendingId int32 `json:"sending_id"`
+}
+
+func (*MessageSendingStatePending) GetType() string {
+	return TypeMessageSendingState
+}
+
+func (*MessageSendingStatePending) GetConstructor() string {
+	return ConstructorMessageSendingStatePending
+}
+
+func (*MessageSendingStatePending) MessageSendingStateConstructor() string {
+	return ConstructorMessageSendingStatePending
+}
+
+func (entity *MessageSendingStatePending) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSendingStatePending) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSendingStatePending) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSendingStatePending"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sending_id":`)
+	encoder.writeInt32(entity.SendingId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSendingStatePending) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sending_id":
+			entity.SendingId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSendingStatePending)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message failed to be sent
+type MessageSendingStateFailed struct {
+	meta
+	// The cause of the message sending failure
+	Error *Error `json:"error"`
+	// True, if the message can be re-sent using resendMessages or readdQuickReplyShortcutMessages
+	CanRetry bool `json:"can_retry"`
+	// True, if the message can be re-sent only on behalf of a different sender
+	NeedAnotherSender bool `json:"need_another_sender"`
+	// True, if the message can be re-sent only if another quote is chosen in the message that is replied by the given message
+	NeedAnotherReplyQuote bool `json:"need_another_reply_quote"`
+	// True, if the message can be re-sent only if the message to be replied is removed. This will be done automatically by resendMessages
+	NeedDropReply bool `json:"need_drop_reply"`
+	// The number of Telegram Stars that must be paid to send the message; 0 if the current amount is correct
+	RequiredPaidMessageStarCount int64 `json:"required_paid_message_star_count"`
+	// Time left before the message can be re-sent, in seconds. No update is sent when this field changes
+	RetryAfter float64 `json:"retry_after"`
+}
+
+func (*MessageSendingStateFailed) GetType() string {
+	return TypeMessageSendingState
+}
+
+func (*MessageSendingStateFailed) GetConstructor() string {
+	return ConstructorMessageSendingStateFailed
+}
+
+func (*MessageSendingStateFailed) MessageSendingStateConstructor() string {
+	return ConstructorMessageSendingStateFailed
+}
+
+func (entity *MessageSendingStateFailed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSendingStateFailed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSendingStateFailed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSendingStateFailed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"error":`)
+	entity.Error.encodeJSON(encoder)
+	encoder.writeRaw(`,"can_retry":`)
+	encoder.writeBool(entity.CanRetry)
+	encoder.writeRaw(`,"need_another_sender":`)
+	encoder.writeBool(entity.NeedAnotherSender)
+	encoder.writeRaw(`,"need_another_reply_quote":`)
+	encoder.writeBool(entity.NeedAnotherReplyQuote)
+	encoder.writeRaw(`,"need_drop_reply":`)
+	encoder.writeBool(entity.NeedDropReply)
+	encoder.writeRaw(`,"required_paid_message_star_count":`)
+	encoder.writeInt64(entity.RequiredPaidMessageStarCount)
+	encoder.writeRaw(`,"retry_after":`)
+	encoder.writeFloat64(entity.RetryAfter)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSendingStateFailed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "error":
+			entity.Error, err = decodeEntity[Error](decoder)
+
+		case "can_retry":
+			entity.CanRetry, err = decoder.readBool()
+
+		case "need_another_sender":
+			entity.NeedAnotherSender, err = decoder.readBool()
+
+		case "need_another_reply_quote":
+			entity.NeedAnotherReplyQuote, err = decoder.readBool()
+
+		case "need_drop_reply":
+			entity.NeedDropReply, err = decoder.readBool()
+
+		case "required_paid_message_star_count":
+			entity.RequiredPaidMessageStarCount, err = decoder.readInt64()
+
+		case "retry_after":
+			entity.RetryAfter, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSendingStateFailed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes manually or automatically chosen quote from another message
+type TextQuote struct {
+	meta
+	// Text of the quote. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities can be present in the text
+	Text *FormattedText `json:"text"`
+	// Approximate quote position in the original message in UTF-16 code units as specified by the message sender
+	Position int32 `json:"position"`
+	// True, if the quote was manually chosen by the message sender
+	IsManual bool `json:"is_manual"`
+}
+
+func (*TextQuote) GetType() string {
+	return TypeTextQuote
+}
+
+func (*TextQuote) GetConstructor() string {
+	return ConstructorTextQuote
+}
+
+func (entity *TextQuote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextQuote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextQuote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textQuote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"position":`)
+	encoder.writeInt32(entity.Position)
+	encoder.writeRaw(`,"is_manual":`)
+	encoder.writeBool(entity.IsManual)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextQuote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "position":
+			entity.Position, err = decoder.readInt32()
+
+		case "is_manual":
+			entity.IsManual, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextQuote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes manually chosen quote from another message
+type InputTextQuote struct {
+	meta
+	// Text of the quote; 0-getOption("message_reply_quote_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities are allowed to be kept and must be kept in the quote
+	Text *FormattedText `json:"text"`
+	// Quote position in the original message in UTF-16 code units
+	Position int32 `json:"position"`
+}
+
+func (*InputTextQuote) GetType() string {
+	return TypeInputTextQuote
+}
+
+func (*InputTextQuote) GetConstructor() string {
+	return ConstructorInputTextQuote
+}
+
+func (entity *InputTextQuote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputTextQuote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputTextQuote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputTextQuote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"position":`)
+	encoder.writeInt32(entity.Position)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputTextQuote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "position":
+			entity.Position, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputTextQuote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a message replied by a given message
+type MessageReplyToMessage struct {
+	meta
+	// The identifier of the chat to which the message belongs; may be 0 if the replied message is in unknown chat
+	ChatId int64 `json:"chat_id"`
+	// The identifier of the message; may be 0 if the replied message is in unknown chat
+	MessageId int64 `json:"message_id"`
+	// Chosen quote from the replied message; may be null if none
+	Quote *TextQuote `json:"quote,omitempty"`
+	// Information about origin of the message if the message was from another chat or topic; may be null for messages from the same chat
+	Origin MessageOrigin `json:"origin,omitempty"`
+	// Point in time (Unix timestamp) when the message was sent if the message was from another chat or topic; 0 for messages from the same chat
+	OriginSendDate int32 `json:"origin_send_date"`
+	// Media content of the message if the message was from another chat or topic; may be null for messages from the same chat and messages without media. Can be only one of the following types: messageAnimation, messageAudio, messageContact, messageDice, messageDocument, messageGame, messageGiveaway, messageGiveawayWinners, messageInvoice, messageLocation, messagePaidMedia, messagePhoto, messagePoll, messageSticker, messageStory, messageText (for link preview), messageVenue, messageVideo, messageVideoNote, or messageVoiceNote
+	Content MessageContent `json:"content,omitempty"`
+}
+
+func (*MessageReplyToMessage) GetType() string {
+	return TypeMessageReplyTo
+}
+
+func (*MessageReplyToMessage) GetConstructor() string {
+	return ConstructorMessageReplyToMessage
+}
+
+func (*MessageReplyToMessage) MessageReplyToConstructor() string {
+	return ConstructorMessageReplyToMessage
+}
+
+func (entity *MessageReplyToMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReplyToMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReplyToMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReplyToMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	if entity.Quote != nil {
+		encoder.writeRaw(`,"quote":`)
+		entity.Quote.encodeJSON(encoder)
+	}
+	if entity.Origin != nil {
+		encoder.writeRaw(`,"origin":`)
+		encoder.writeType(entity.Origin)
+	}
+	encoder.writeRaw(`,"origin_send_date":`)
+	encoder.writeInt32(entity.OriginSendDate)
+	if entity.Content != nil {
+		encoder.writeRaw(`,"content":`)
+		encoder.writeType(entity.Content)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReplyToMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "quote":
+			entity.Quote, err = decodeEntity[TextQuote](decoder)
+
+		case "origin":
+			entity.Origin, err = decodeMessageOrigin(decoder)
+
+		case "origin_send_date":
+			entity.OriginSendDate, err = decoder.readInt32()
+
+		case "content":
+			entity.Content, err = decodeMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageReplyToMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageReplyToMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("content", entity.Content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Describes a story replied by a given message
+type MessageReplyToStory struct {
+	meta
+	// The identifier of the sender of the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// The identifier of the story
+	StoryId int32 `json:"story_id"`
+}
+
+func (*MessageReplyToStory) GetType() string {
+	return TypeMessageReplyTo
+}
+
+func (*MessageReplyToStory) GetConstructor() string {
+	return ConstructorMessageReplyToStory
+}
+
+func (*MessageReplyToStory) MessageReplyToConstructor() string {
+	return ConstructorMessageReplyToStory
+}
+
+func (entity *MessageReplyToStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReplyToStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReplyToStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReplyToStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(entity.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReplyToStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_sender_chat_id":
+			entity.StorySenderChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageReplyToStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a message to be replied in the same chat and forum topic
+type InputMessageReplyToMessage struct {
+	meta
+	// The identifier of the message to be replied in the same chat and forum topic. A message can be replied in the same chat and forum topic only if messageProperties.can_be_replied
+	MessageId int64 `json:"message_id"`
+	// Quote from the message to be replied; pass null if none. Must always be null for replies in secret chats
+	Quote *InputTextQuote `json:"quote,omitempty"`
+}
+
+func (*InputMessageReplyToMessage) GetType() string {
+	return TypeInputMessageReplyTo
+}
+
+func (*InputMessageReplyToMessage) GetConstructor() string {
+	return ConstructorInputMessageReplyToMessage
+}
+
+func (*InputMessageReplyToMessage) InputMessageReplyToConstructor() string {
+	return ConstructorInputMessageReplyToMessage
+}
+
+func (entity *InputMessageReplyToMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageReplyToMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageReplyToMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageReplyToMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	if entity.Quote != nil {
+		encoder.writeRaw(`,"quote":`)
+		entity.Quote.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageReplyToMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "quote":
+			entity.Quote, err = decodeEntity[InputTextQuote](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageReplyToMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a message to be replied that is from a different chat or a forum topic; not supported in secret chats
+type InputMessageReplyToExternalMessage struct {
+	meta
+	// The identifier of the chat to which the message to be replied belongs
+	ChatId int64 `json:"chat_id"`
+	// The identifier of the message to be replied in the specified chat. A message can be replied in another chat or forum topic only if messageProperties.can_be_replied_in_another_chat
+	MessageId int64 `json:"message_id"`
+	// Quote from the message to be replied; pass null if none
+	Quote *InputTextQuote `json:"quote,omitempty"`
+}
+
+func (*InputMessageReplyToExternalMessage) GetType() string {
+	return TypeInputMessageReplyTo
+}
+
+func (*InputMessageReplyToExternalMessage) GetConstructor() string {
+	return ConstructorInputMessageReplyToExternalMessage
+}
+
+func (*InputMessageReplyToExternalMessage) InputMessageReplyToConstructor() string {
+	return ConstructorInputMessageReplyToExternalMessage
+}
+
+func (entity *InputMessageReplyToExternalMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageReplyToExternalMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageReplyToExternalMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageReplyToExternalMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	if entity.Quote != nil {
+		encoder.writeRaw(`,"quote":`)
+		entity.Quote.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageReplyToExternalMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "quote":
+			entity.Quote, err = decodeEntity[InputTextQuote](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageReplyToExternalMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a story to be replied
+type InputMessageReplyToStory struct {
+	meta
+	// The identifier of the sender of the story. Currently, stories can be replied only in the sender's chat and channel stories can't be replied
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// The identifier of the story
+	StoryId int32 `json:"story_id"`
+}
+
+func (*InputMessageReplyToStory) GetType() string {
+	return TypeInputMessageReplyTo
+}
+
+func (*InputMessageReplyToStory) GetConstructor() string {
+	return ConstructorInputMessageReplyToStory
+}
+
+func (*InputMessageReplyToStory) InputMessageReplyToConstructor() string {
+	return ConstructorInputMessageReplyToStory
+}
+
+func (entity *InputMessageReplyToStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageReplyToStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageReplyToStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageReplyToStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(entity.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageReplyToStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_sender_chat_id":
+			entity.StorySenderChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageReplyToStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a message
+type Message struct {
+	meta
+	// Message identifier; unique for the chat to which the message belongs
+	Id int64 `json:"id"`
+	// Identifier of the sender of the message
+	SenderId MessageSender `json:"sender_id"`
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The sending state of the message; may be null if the message isn't being sent and didn't fail to be sent
+	SendingState MessageSendingState `json:"sending_state,omitempty"`
+	// The scheduling state of the message; may be null if the message isn't scheduled
+	SchedulingState MessageSchedulingState `json:"scheduling_state,omitempty"`
+	// True, if the message is outgoing
+	IsOutgoing bool `json:"is_outgoing"`
+	// True, if the message is pinned
+	IsPinned bool `json:"is_pinned"`
+	// True, if the message was sent because of a scheduled action by the message sender, for example, as away, or greeting service message
+	IsFromOffline bool `json:"is_from_offline"`
+	// True, if content of the message can be saved locally or copied using inputMessageForwarded or forwardMessages with copy options
+	CanBeSaved bool `json:"can_be_saved"`
+	// True, if media timestamp entities refers to a media in this message as opposed to a media in the replied message
+	HasTimestampedMedia bool `json:"has_timestamped_media"`
+	// True, if the message is a channel post. All messages to channels are channel posts, all other messages are not channel posts
+	IsChannelPost bool `json:"is_channel_post"`
+	// True, if the message is a forum topic message
+	IsTopicMessage bool `json:"is_topic_message"`
+	// True, if the message contains an unread mention for the current user
+	ContainsUnreadMention bool `json:"contains_unread_mention"`
+	// Point in time (Unix timestamp) when the message was sent; 0 for scheduled messages
+	Date int32 `json:"date"`
+	// Point in time (Unix timestamp) when the message was last edited; 0 for scheduled messages
+	EditDate int32 `json:"edit_date"`
+	// Information about the initial message sender; may be null if none or unknown
+	ForwardInfo *MessageForwardInfo `json:"forward_info,omitempty"`
+	// Information about the initial message for messages created with importMessages; may be null if the message isn't imported
+	ImportInfo *MessageImportInfo `json:"import_info,omitempty"`
+	// Information about interactions with the message; may be null if none
+	InteractionInfo *MessageInteractionInfo `json:"interaction_info,omitempty"`
+	// Information about unread reactions added to the message
+	UnreadReactions []*UnreadReaction `json:"unread_reactions"`
+	// Information about fact-check added to the message; may be null if none
+	FactCheck *FactCheck `json:"fact_check,omitempty"`
+	// Information about the message or the story this message is replying to; may be null if none
+	ReplyTo MessageReplyTo `json:"reply_to,omitempty"`
+	// If non-zero, the identifier of the message thread the message belongs to; unique within the chat to which the message belongs
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Identifier of the Saved Messages topic for the message; 0 for messages not from Saved Messages
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// The message's self-destruct type; may be null if none
+	SelfDestructType MessageSelfDestructType `json:"self_destruct_type,omitempty"`
+	// Time left before the message self-destruct timer expires, in seconds; 0 if self-destruction isn't scheduled yet
+	SelfDestructIn float64 `json:"self_destruct_in"`
+	// Time left before the message will be automatically deleted by message_auto_delete_time setting of the chat, in seconds; 0 if never
+	AutoDeleteIn float64 `json:"auto_delete_in"`
+	// If non-zero, the user identifier of the inline bot through which this message was sent
+	ViaBotUserId int64 `json:"via_bot_user_id"`
+	// If non-zero, the user identifier of the business bot that sent this message
+	SenderBusinessBotUserId int64 `json:"sender_business_bot_user_id"`
+	// Number of times the sender of the message boosted the supergroup at the time the message was sent; 0 if none or unknown. For messages sent by the current user, supergroupFullInfo.my_boost_count must be used instead
+	SenderBoostCount int32 `json:"sender_boost_count"`
+	// The number of Telegram Stars the sender paid to send the message
+	PaidMessageStarCount int64 `json:"paid_message_star_count"`
+	// For channel posts and anonymous group messages, optional author signature
+	AuthorSignature string `json:"author_signature"`
+	// Unique identifier of an album this message belongs to; 0 if none. Only audios, documents, photos and videos can be grouped together in albums
+	MediaAlbumId JsonInt64 `json:"media_album_id"`
+	// Unique identifier of the effect added to the message; 0 if none
+	EffectId JsonInt64 `json:"effect_id"`
+	// True, if media content of the message must be hidden with 18+ spoiler
+	HasSensitiveContent bool `json:"has_sensitive_content"`
+	// If non-empty, contains a human-readable description of the reason why access to this message must be restricted
+	RestrictionReason string `json:"restriction_reason"`
+	// Content of the message
+	Content MessageContent `json:"content"`
+	// Reply markup for the message; may be null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (*Message) GetType() string {
+	return TypeMessage
+}
+
+func (*Message) GetConstructor() string {
+	return ConstructorMessage
+}
+
+func (entity *Message) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Message) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Message) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"message"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.SendingState != nil {
+		encoder.writeRaw(`,"sending_state":`)
+		encoder.writeType(entity.SendingState)
+	}
+	if entity.SchedulingState != nil {
+		encoder.writeRaw(`,"scheduling_state":`)
+		encoder.writeType(entity.SchedulingState)
+	}
+	encoder.writeRaw(`,"is_outgoing":`)
+	encoder.writeBool(entity.IsOutgoing)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw(`,"is_from_offline":`)
+	encoder.writeBool(entity.IsFromOffline)
+	encoder.writeRaw(`,"can_be_saved":`)
+	encoder.writeBool(entity.CanBeSaved)
+	encoder.writeRaw(`,"has_timestamped_media":`)
+	encoder.writeBool(entity.HasTimestampedMedia)
+	encoder.writeRaw(`,"is_channel_post":`)
+	encoder.writeBool(entity.IsChannelPost)
+	encoder.writeRaw(`,"is_topic_message":`)
+	encoder.writeBool(entity.IsTopicMessage)
+	encoder.writeRaw(`,"contains_unread_mention":`)
+	encoder.writeBool(entity.ContainsUnreadMention)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"edit_date":`)
+	encoder.writeInt32(entity.EditDate)
+	if entity.ForwardInfo != nil {
+		encoder.writeRaw(`,"forward_info":`)
+		entity.ForwardInfo.encodeJSON(encoder)
+	}
+	if entity.ImportInfo != nil {
+		encoder.writeRaw(`,"import_info":`)
+		entity.ImportInfo.encodeJSON(encoder)
+	}
+	if entity.InteractionInfo != nil {
+		encoder.writeRaw(`,"interaction_info":`)
+		entity.InteractionInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"unread_reactions":`)
+	writeList(encoder, entity.UnreadReactions, writeTypeValue[*UnreadReaction])
+	if entity.FactCheck != nil {
+		encoder.writeRaw(`,"fact_check":`)
+		entity.FactCheck.encodeJSON(encoder)
+	}
+	if entity.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(entity.ReplyTo)
+	}
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(entity.SavedMessagesTopicId)
+	if entity.SelfDestructType != nil {
+		encoder.writeRaw(`,"self_destruct_type":`)
+		encoder.writeType(entity.SelfDestructType)
+	}
+	encoder.writeRaw(`,"self_destruct_in":`)
+	encoder.writeFloat64(entity.SelfDestructIn)
+	encoder.writeRaw(`,"auto_delete_in":`)
+	encoder.writeFloat64(entity.AutoDeleteIn)
+	encoder.writeRaw(`,"via_bot_user_id":`)
+	encoder.writeInt64(entity.ViaBotUserId)
+	encoder.writeRaw(`,"sender_business_bot_user_id":`)
+	encoder.writeInt64(entity.SenderBusinessBotUserId)
+	encoder.writeRaw(`,"sender_boost_count":`)
+	encoder.writeInt32(entity.SenderBoostCount)
+	encoder.writeRaw(`,"paid_message_star_count":`)
+	encoder.writeInt64(entity.PaidMessageStarCount)
+	encoder.writeRaw(`,"author_signature":`)
+	encoder.writeString(entity.AuthorSignature)
+	encoder.writeRaw(`,"media_album_id":`)
+	encoder.writeJsonInt64(entity.MediaAlbumId)
+	encoder.writeRaw(`,"effect_id":`)
+	encoder.writeJsonInt64(entity.EffectId)
+	encoder.writeRaw(`,"has_sensitive_content":`)
+	encoder.writeBool(entity.HasSensitiveContent)
+	encoder.writeRaw(`,"restriction_reason":`)
+	encoder.writeString(entity.RestrictionReason)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(entity.Content)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *Message) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "sending_state":
+			entity.SendingState, err = decodeMessageSendingState(decoder)
+
+		case "scheduling_state":
+			entity.SchedulingState, err = decodeMessageSchedulingState(decoder)
+
+		case "is_outgoing":
+			entity.IsOutgoing, err = decoder.readBool()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		case "is_from_offline":
+			entity.IsFromOffline, err = decoder.readBool()
+
+		case "can_be_saved":
+			entity.CanBeSaved, err = decoder.readBool()
+
+		case "has_timestamped_media":
+			entity.HasTimestampedMedia, err = decoder.readBool()
+
+		case "is_channel_post":
+			entity.IsChannelPost, err = decoder.readBool()
+
+		case "is_topic_message":
+			entity.IsTopicMessage, err = decoder.readBool()
+
+		case "contains_unread_mention":
+			entity.ContainsUnreadMention, err = decoder.readBool()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "edit_date":
+			entity.EditDate, err = decoder.readInt32()
+
+		case "forward_info":
+			entity.ForwardInfo, err = decodeEntity[MessageForwardInfo](decoder)
+
+		case "import_info":
+			entity.ImportInfo, err = decodeEntity[MessageImportInfo](decoder)
+
+		case "interaction_info":
+			entity.InteractionInfo, err = decodeEntity[MessageInteractionInfo](decoder)
+
+		case "unread_reactions":
+			entity.UnreadReactions, err = readList(decoder, decodeEntity[UnreadReaction])
+
+		case "fact_check":
+			entity.FactCheck, err = decodeEntity[FactCheck](decoder)
+
+		case "reply_to":
+			entity.ReplyTo, err = decodeMessageReplyTo(decoder)
+
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		case "saved_messages_topic_id":
+			entity.SavedMessagesTopicId, err = decoder.readInt64()
+
+		case "self_destruct_type":
+			entity.SelfDestructType, err = decodeMessageSelfDestructType(decoder)
+
+		case "self_destruct_in":
+			entity.SelfDestructIn, err = decoder.readFloat64()
+
+		case "auto_delete_in":
+			entity.AutoDeleteIn, err = decoder.readFloat64()
+
+		case "via_bot_user_id":
+			entity.ViaBotUserId, err = decoder.readInt64()
+
+		case "sender_business_bot_user_id":
+			entity.SenderBusinessBotUserId, err = decoder.readInt64()
+
+		case "sender_boost_count":
+			entity.SenderBoostCount, err = decoder.readInt32()
+
+		case "paid_message_star_count":
+			entity.PaidMessageStarCount, err = decoder.readInt64()
+
+		case "author_signature":
+			entity.AuthorSignature, err = decoder.readString()
+
+		case "media_album_id":
+			entity.MediaAlbumId, err = decoder.readJsonInt64()
+
+		case "effect_id":
+			entity.EffectId, err = decoder.readJsonInt64()
+
+		case "has_sensitive_content":
+			entity.HasSensitiveContent, err = decoder.readBool()
+
+		case "restriction_reason":
+			entity.RestrictionReason, err = decoder.readString()
+
+		case "content":
+			entity.Content, err = decodeMessageContent(decoder)
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Message) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_to", entity.ReplyTo); err != nil {
+		return err
+	}
+
+	if err := validateNested("self_destruct_type", entity.SelfDestructType); err != nil {
+		return err
+	}
+
+	if err := validateNested("content", entity.Content); err != nil {
+		return err
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of messages
+type Messages struct {
+	meta
+	// Approximate total number of messages found
+	TotalCount int32 `json:"total_count"`
+	// List of messages; messages may be null
+	Messages []*Message `json:"messages,omitempty"`
+}
+
+func (*Messages) GetType() string {
+	return TypeMessages
+}
+
+func (*Messages) GetConstructor() string {
+	return ConstructorMessages
+}
+
+func (entity *Messages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Messages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Messages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	if len(entity.Messages) > 0 {
+		encoder.writeRaw(`,"messages":`)
+		writeList(encoder, entity.Messages, writeTypeValue[*Message])
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *Messages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[Message])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Messages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains a list of messages found by a search
+type FoundMessages struct {
+	meta
+	// Approximate total number of messages found; -1 if unknown
+	TotalCount int32 `json:"total_count"`
+	// List of messages
+	Messages []*Message `json:"messages"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*FoundMessages) GetType() string {
+	return TypeFoundMessages
+}
+
+func (*FoundMessages) GetConstructor() string {
+	return ConstructorFoundMessages
+}
+
+func (entity *FoundMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"messages":`)
+	writeList(encoder, entity.Messages, writeTypeValue[*Message])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[Message])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *FoundMessages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains a list of messages found by a search in a given chat
+type FoundChatMessages struct {
+	meta
+	// Approximate total number of messages found; -1 if unknown
+	TotalCount int32 `json:"total_count"`
+	// List of messages
+	Messages []*Message `json:"messages"`
+	// The offset for the next request. If 0, there are no more results
+	NextFromMessageId int64 `json:"next_from_message_id"`
+}
+
+func (*FoundChatMessages) GetType() string {
+	return TypeFoundChatMessages
+}
+
+func (*FoundChatMessages) GetConstructor() string {
+	return ConstructorFoundChatMessages
+}
+
+func (entity *FoundChatMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundChatMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundChatMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundChatMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"messages":`)
+	writeList(encoder, entity.Messages, writeTypeValue[*Message])
+	encoder.writeRaw(`,"next_from_message_id":`)
+	encoder.writeInt64(entity.NextFromMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundChatMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[Message])
+
+		case "next_from_message_id":
+			entity.NextFromMessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundChatMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *FoundChatMessages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains information about a message in a specific position
+type MessagePosition struct {
+	meta
+	// 0-based message position in the full list of suitable messages
+	Position int32 `json:"position"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Point in time (Unix timestamp) when the message was sent
+	Date int32 `json:"date"`
+}
+
+func (*MessagePosition) GetType() string {
+	return TypeMessagePosition
+}
+
+func (*MessagePosition) GetConstructor() string {
+	return ConstructorMessagePosition
+}
+
+func (entity *MessagePosition) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePosition) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePosition) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePosition"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"position":`)
+	encoder.writeInt32(entity.Position)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePosition) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "position":
+			entity.Position, err = decoder.readInt32()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePosition)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of message positions
+type MessagePositions struct {
+	meta
+	// Total number of messages found
+	TotalCount int32 `json:"total_count"`
+	// List of message positions
+	Positions []*MessagePosition `json:"positions"`
+}
+
+func (*MessagePositions) GetType() string {
+	return TypeMessagePositions
+}
+
+func (*MessagePositions) GetConstructor() string {
+	return ConstructorMessagePositions
+}
+
+func (entity *MessagePositions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePositions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePositions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePositions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"positions":`)
+	writeList(encoder, entity.Positions, writeTypeValue[*MessagePosition])
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePositions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "positions":
+			entity.Positions, err = readList(decoder, decodeEntity[MessagePosition])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePositions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about found messages sent on a specific day
+type MessageCalendarDay struct {
+	meta
+	// Total number of found messages sent on the day
+	TotalCount int32 `json:"total_count"`
+	// First message sent on the day
+	Message *Message `json:"message"`
+}
+
+func (*MessageCalendarDay) GetType() string {
+	return TypeMessageCalendarDay
+}
+
+func (*MessageCalendarDay) GetConstructor() string {
+	return ConstructorMessageCalendarDay
+}
+
+func (entity *MessageCalendarDay) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageCalendarDay) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageCalendarDay) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageCalendarDay"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageCalendarDay) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageCalendarDay)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageCalendarDay) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains information about found messages, split by days according to the option "utc_time_offset"
+type MessageCalendar struct {
+	meta
+	// Total number of found messages
+	TotalCount int32 `json:"total_count"`
+	// Information about messages sent
+	Days []*MessageCalendarDay `json:"days"`
+}
+
+func (*MessageCalendar) GetType() string {
+	return TypeMessageCalendar
+}
+
+func (*MessageCalendar) GetConstructor() string {
+	return ConstructorMessageCalendar
+}
+
+func (entity *MessageCalendar) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageCalendar) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageCalendar) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageCalendar"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"days":`)
+	writeList(encoder, entity.Days, writeTypeValue[*MessageCalendarDay])
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageCalendar) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "days":
+			entity.Days, err = readList(decoder, decodeEntity[MessageCalendarDay])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageCalendar)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageCalendar) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Days {
+		if err := validateNested(indexField("days", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The message is from a chat history
+type MessageSourceChatHistory struct {
+	meta
+}
+
+func (*MessageSourceChatHistory) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceChatHistory) GetConstructor() string {
+	return ConstructorMessageSourceChatHistory
+}
+
+func (*MessageSourceChatHistory) MessageSourceConstructor() string {
+	return ConstructorMessageSourceChatHistory
+}
+
+func (entity *MessageSourceChatHistory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceChatHistory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceChatHistory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceChatHistory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceChatHistory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceChatHistory)
+	})
+}
+
+// The message is from a message thread history
+type MessageSourceMessageThreadHistory struct {
+	meta
+}
+
+func (*MessageSourceMessageThreadHistory) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceMessageThreadHistory) GetConstructor() string {
+	return ConstructorMessageSourceMessageThreadHistory
+}
+
+func (*MessageSourceMessageThreadHistory) MessageSourceConstructor() string {
+	return ConstructorMessageSourceMessageThreadHistory
+}
+
+func (entity *MessageSourceMessageThreadHistory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceMessageThreadHistory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceMessageThreadHistory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceMessageThreadHistory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceMessageThreadHistory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceMessageThreadHistory)
+	})
+}
+
+// The message is from a forum topic history
+type MessageSourceForumTopicHistory struct {
+	meta
+}
+
+func (*MessageSourceForumTopicHistory) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceForumTopicHistory) GetConstructor() string {
+	return ConstructorMessageSourceForumTopicHistory
+}
+
+func (*MessageSourceForumTopicHistory) MessageSourceConstructor() string {
+	return ConstructorMessageSourceForumTopicHistory
+}
+
+func (entity *MessageSourceForumTopicHistory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceForumTopicHistory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceForumTopicHistory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceForumTopicHistory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceForumTopicHistory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceForumTopicHistory)
+	})
+}
+
+// The message is from chat, message thread or forum topic history preview
+type MessageSourceHistoryPreview struct {
+	meta
+}
+
+func (*MessageSourceHistoryPreview) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceHistoryPreview) GetConstructor() string {
+	return ConstructorMessageSourceHistoryPreview
+}
+
+func (*MessageSourceHistoryPreview) MessageSourceConstructor() string {
+	return ConstructorMessageSourceHistoryPreview
+}
+
+func (entity *MessageSourceHistoryPreview) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceHistoryPreview) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceHistoryPreview) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceHistoryPreview"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceHistoryPreview) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceHistoryPreview)
+	})
+}
+
+// The message is from a chat list or a forum topic list
+type MessageSourceChatList struct {
+	meta
+}
+
+func (*MessageSourceChatList) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceChatList) GetConstructor() string {
+	return ConstructorMessageSourceChatList
+}
+
+func (*MessageSourceChatList) MessageSourceConstructor() string {
+	return ConstructorMessageSourceChatList
+}
+
+func (entity *MessageSourceChatList) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceChatList) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceChatList) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceChatList"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceChatList) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceChatList)
+	})
+}
+
+// The message is from search results, including file downloads, local file list, outgoing document messages, calendar
+type MessageSourceSearch struct {
+	meta
+}
+
+func (*MessageSourceSearch) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceSearch) GetConstructor() string {
+	return ConstructorMessageSourceSearch
+}
+
+func (*MessageSourceSearch) MessageSourceConstructor() string {
+	return ConstructorMessageSourceSearch
+}
+
+func (entity *MessageSourceSearch) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceSearch) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceSearch) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceSearch"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceSearch) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceSearch)
+	})
+}
+
+// The message is from a chat event log
+type MessageSourceChatEventLog struct {
+	meta
+}
+
+func (*MessageSourceChatEventLog) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceChatEventLog) GetConstructor() string {
+	return ConstructorMessageSourceChatEventLog
+}
+
+func (*MessageSourceChatEventLog) MessageSourceConstructor() string {
+	return ConstructorMessageSourceChatEventLog
+}
+
+func (entity *MessageSourceChatEventLog) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceChatEventLog) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceChatEventLog) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceChatEventLog"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceChatEventLog) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceChatEventLog)
+	})
+}
+
+// The message is from a notification
+type MessageSourceNotification struct {
+	meta
+}
+
+func (*MessageSourceNotification) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceNotification) GetConstructor() string {
+	return ConstructorMessageSourceNotification
+}
+
+func (*MessageSourceNotification) MessageSourceConstructor() string {
+	return ConstructorMessageSourceNotification
+}
+
+func (entity *MessageSourceNotification) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceNotification) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceNotification) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceNotification"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceNotification) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceNotification)
+	})
+}
+
+// The message was screenshotted; the source must be used only if the message content was visible during the screenshot
+type MessageSourceScreenshot struct {
+	meta
+}
+
+func (*MessageSourceScreenshot) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceScreenshot) GetConstructor() string {
+	return ConstructorMessageSourceScreenshot
+}
+
+func (*MessageSourceScreenshot) MessageSourceConstructor() string {
+	return ConstructorMessageSourceScreenshot
+}
+
+func (entity *MessageSourceScreenshot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceScreenshot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceScreenshot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceScreenshot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceScreenshot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceScreenshot)
+	})
+}
+
+// The message is from some other source
+type MessageSourceOther struct {
+	meta
+}
+
+func (*MessageSourceOther) GetType() string {
+	return TypeMessageSource
+}
+
+func (*MessageSourceOther) GetConstructor() string {
+	return ConstructorMessageSourceOther
+}
+
+func (*MessageSourceOther) MessageSourceConstructor() string {
+	return ConstructorMessageSourceOther
+}
+
+func (entity *MessageSourceOther) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSourceOther) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSourceOther) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSourceOther"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSourceOther) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSourceOther)
+	})
+}
+
+// Information about the sponsor of a message
+type MessageSponsor struct {
+	meta
+	// URL of the sponsor to be opened when the message is clicked
+	Url string `json:"url"`
+	// Photo of the sponsor; may be null if must not be shown
+	Photo *Photo `json:"photo,omitempty"`
+	// Additional optional information about the sponsor to be shown along with the message
+	Info string `json:"info"`
+}
+
+func (*MessageSponsor) GetType() string {
+	return TypeMessageSponsor
+}
+
+func (*MessageSponsor) GetConstructor() string {
+	return ConstructorMessageSponsor
+}
+
+func (entity *MessageSponsor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSponsor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSponsor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSponsor"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"info":`)
+	encoder.writeString(entity.Info)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSponsor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "info":
+			entity.Info, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSponsor)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a sponsored message
+type SponsoredMessage struct {
+	meta
+	// Message identifier; unique for the chat to which the sponsored message belongs among both ordinary and sponsored messages
+	MessageId int64 `json:"message_id"`
+	// True, if the message needs to be labeled as "recommended" instead of "sponsored"
+	IsRecommended bool `json:"is_recommended"`
+	// True, if the message can be reported to Telegram moderators through reportChatSponsoredMessage
+	CanBeReported bool `json:"can_be_reported"`
+	// Content of the message. Currently, can be only of the types messageText, messageAnimation, messagePhoto, or messageVideo. Video messages can be viewed fullscreen
+	Content MessageContent `json:"content"`
+	// Information about the sponsor of the message
+	Sponsor *MessageSponsor `json:"sponsor"`
+	// Title of the sponsored message
+	Title string `json:"title"`
+	// Text for the message action button
+	ButtonText string `json:"button_text"`
+	// Identifier of the accent color for title, button text and message background
+	AccentColorId int32 `json:"accent_color_id"`
+	// Identifier of a custom emoji to be shown on the message background; 0 if none
+	BackgroundCustomEmojiId JsonInt64 `json:"background_custom_emoji_id"`
+	// If non-empty, additional information about the sponsored message to be shown along with the message
+	AdditionalInfo string `json:"additional_info"`
+}
+
+func (*SponsoredMessage) GetType() string {
+	return TypeSponsoredMessage
+}
+
+func (*SponsoredMessage) GetConstructor() string {
+	return ConstructorSponsoredMessage
+}
+
+func (entity *SponsoredMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SponsoredMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SponsoredMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sponsoredMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"is_recommended":`)
+	encoder.writeBool(entity.IsRecommended)
+	encoder.writeRaw(`,"can_be_reported":`)
+	encoder.writeBool(entity.CanBeReported)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(entity.Content)
+	encoder.writeRaw(`,"sponsor":`)
+	entity.Sponsor.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"button_text":`)
+	encoder.writeString(entity.ButtonText)
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(entity.AccentColorId)
+	encoder.writeRaw(`,"background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.BackgroundCustomEmojiId)
+	encoder.writeRaw(`,"additional_info":`)
+	encoder.writeString(entity.AdditionalInfo)
+	encoder.writeRaw("}")
+}
+
+func (entity *SponsoredMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "is_recommended":
+			entity.IsRecommended, err = decoder.readBool()
+
+		case "can_be_reported":
+			entity.CanBeReported, err = decoder.readBool()
+
+		case "content":
+			entity.Content, err = decodeMessageContent(decoder)
+
+		case "sponsor":
+			entity.Sponsor, err = decodeEntity[MessageSponsor](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "button_text":
+			entity.ButtonText, err = decoder.readString()
+
+		case "accent_color_id":
+			entity.AccentColorId, err = decoder.readInt32()
+
+		case "background_custom_emoji_id":
+			entity.BackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "additional_info":
+			entity.AdditionalInfo, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSponsoredMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *SponsoredMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("content", entity.Content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of sponsored messages
+type SponsoredMessages struct {
+	meta
+	// List of sponsored messages
+	Messages []*SponsoredMessage `json:"messages"`
+	// The minimum number of messages between shown sponsored messages, or 0 if only one sponsored message must be shown after all ordinary messages
+	MessagesBetween int32 `json:"messages_between"`
+}
+
+func (*SponsoredMessages) GetType() string {
+	return TypeSponsoredMessages
+}
+
+func (*SponsoredMessages) GetConstructor() string {
+	return ConstructorSponsoredMessages
+}
+
+func (entity *SponsoredMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SponsoredMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SponsoredMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sponsoredMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"messages":`)
+	writeList(encoder, entity.Messages, writeTypeValue[*SponsoredMessage])
+	encoder.writeRaw(`,"messages_between":`)
+	encoder.writeInt32(entity.MessagesBetween)
+	encoder.writeRaw("}")
+}
+
+func (entity *SponsoredMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[SponsoredMessage])
+
+		case "messages_between":
+			entity.MessagesBetween, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSponsoredMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *SponsoredMessages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains information about a message draft
+type DraftMessage struct {
+	meta
+	// Information about the message to be replied; must be of the type inputMessageReplyToMessage; may be null if none
+	ReplyTo InputMessageReplyTo `json:"reply_to,omitempty"`
+	// Point in time (Unix timestamp) when the draft was created
+	Date int32 `json:"date"`
+	// Content of the message draft; must be of the type inputMessageText, inputMessageVideoNote, or inputMessageVoiceNote
+	InputMessageText InputMessageContent `json:"input_message_text"`
+	// Identifier of the effect to apply to the message when it is sent; 0 if none
+	EffectId JsonInt64 `json:"effect_id"`
+}
+
+func (*DraftMessage) GetType() string {
+	return TypeDraftMessage
+}
+
+func (*DraftMessage) GetConstructor() string {
+	return ConstructorDraftMessage
+}
+
+func (entity *DraftMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DraftMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DraftMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"draftMessage"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.ReplyTo != nil {
+		encoder.writeRaw(`,"reply_to":`)
+		encoder.writeType(entity.ReplyTo)
+	}
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"input_message_text":`)
+	encoder.writeType(entity.InputMessageText)
+	encoder.writeRaw(`,"effect_id":`)
+	encoder.writeJsonInt64(entity.EffectId)
+	encoder.writeRaw("}")
+}
+
+func (entity *DraftMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reply_to":
+			entity.ReplyTo, err = decodeInputMessageReplyTo(decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "input_message_text":
+			entity.InputMessageText, err = decodeInputMessageContent(decoder)
+
+		case "effect_id":
+			entity.EffectId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDraftMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *DraftMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("input_message_text", entity.InputMessageText); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a tag used in Saved Messages or a Saved Messages topic
+type SavedMessagesTag struct {
+	meta
+	// The tag
+	Tag ReactionType `json:"tag"`
+	// Label of the tag; 0-12 characters. Always empty if the tag is returned for a Saved Messages topic
+	Label string `json:"label"`
+	// Number of times the tag was used; may be 0 if the tag has non-empty label
+	Count int32 `json:"count"`
+}
+
+func (*SavedMessagesTag) GetType() string {
+	return TypeSavedMessagesTag
+}
+
+func (*SavedMessagesTag) GetConstructor() string {
+	return ConstructorSavedMessagesTag
+}
+
+func (entity *SavedMessagesTag) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SavedMessagesTag) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SavedMessagesTag) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savedMessagesTag"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"tag":`)
+	encoder.writeType(entity.Tag)
+	encoder.writeRaw(`,"label":`)
+	encoder.writeString(entity.Label)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt32(entity.Count)
+	encoder.writeRaw("}")
+}
+
+func (entity *SavedMessagesTag) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "tag":
+			entity.Tag, err = decodeReactionType(decoder)
+
+		case "label":
+			entity.Label, err = decoder.readString()
+
+		case "count":
+			entity.Count, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSavedMessagesTag)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *SavedMessagesTag) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("label", entity.Label, 0, 12); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of tags used in Saved Messages
+type SavedMessagesTags struct {
+	meta
+	// List of tags
+	Tags []*SavedMessagesTag `json:"tags"`
+}
+
+func (*SavedMessagesTags) GetType() string {
+	return TypeSavedMessagesTags
+}
+
+func (*SavedMessagesTags) GetConstructor() string {
+	return ConstructorSavedMessagesTags
+}
+
+func (entity *SavedMessagesTags) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SavedMessagesTags) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SavedMessagesTags) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savedMessagesTags"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"tags":`)
+	writeList(encoder, entity.Tags, writeTypeValue[*SavedMessagesTag])
+	encoder.writeRaw("}")
+}
+
+func (entity *SavedMessagesTags) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "tags":
+			entity.Tags, err = readList(decoder, decodeEntity[SavedMessagesTag])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSavedMessagesTags)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *SavedMessagesTags) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Tags {
+		if err := validateNested(indexField("tags", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A simple button, with text that must be sent when the button is pressed
+type KeyboardButtonTypeText struct {
+	meta
+}
+
+func (*KeyboardButtonTypeText) GetType() string {
+	return TypeKeyboardButtonType
+}
+
+func (*KeyboardButtonTypeText) GetConstructor() string {
+	return ConstructorKeyboardButtonTypeText
+}
+
+func (*KeyboardButtonTypeText) KeyboardButtonTypeConstructor() string {
+	return ConstructorKeyboardButtonTypeText
+}
+
+func (entity *KeyboardButtonTypeText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButtonTypeText) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButtonTypeText) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButtonTypeText"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButtonTypeText) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorKeyboardButtonTypeText)
+	})
+}
+
+// A button that sends the user's phone number when pressed; available only in private chats
+type KeyboardButtonTypeRequestPhoneNumber struct {
+	meta
+}
+
+func (*KeyboardButtonTypeRequestPhoneNumber) GetType() string {
+	return TypeKeyboardButtonType
+}
+
+func (*KeyboardButtonTypeRequestPhoneNumber) GetConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestPhoneNumber
+}
+
+func (*KeyboardButtonTypeRequestPhoneNumber) KeyboardButtonTypeConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestPhoneNumber
+}
+
+func (entity *KeyboardButtonTypeRequestPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButtonTypeRequestPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButtonTypeRequestPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButtonTypeRequestPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButtonTypeRequestPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorKeyboardButtonTypeRequestPhoneNumber)
+	})
+}
+
+// A button that sends the user's location when pressed; available only in private chats
+type KeyboardButtonTypeRequestLocation struct {
+	meta
+}
+
+func (*KeyboardButtonTypeRequestLocation) GetType() string {
+	return TypeKeyboardButtonType
+}
+
+func (*KeyboardButtonTypeRequestLocation) GetConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestLocation
+}
+
+func (*KeyboardButtonTypeRequestLocation) KeyboardButtonTypeConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestLocation
+}
+
+func (entity *KeyboardButtonTypeRequestLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButtonTypeRequestLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButtonTypeRequestLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButtonTypeRequestLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButtonTypeRequestLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorKeyboardButtonTypeRequestLocation)
+	})
+}
+
+// A button that allows the user to create and send a poll when pressed; available only in private chats
+type KeyboardButtonTypeRequestPoll struct {
+	meta
+	// If true, only regular polls must be allowed to create
+	ForceRegular bool `json:"force_regular"`
+	// If true, only polls in quiz mode must be allowed to create
+	ForceQuiz bool `json:"force_quiz"`
+}
+
+func (*KeyboardButtonTypeRequestPoll) GetType() string {
+	return TypeKeyboardButtonType
+}
+
+func (*KeyboardButtonTypeRequestPoll) GetConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestPoll
+}
+
+func (*KeyboardButtonTypeRequestPoll) KeyboardButtonTypeConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestPoll
+}
+
+func (entity *KeyboardButtonTypeRequestPoll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButtonTypeRequestPoll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButtonTypeRequestPoll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButtonTypeRequestPoll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"force_regular":`)
+	encoder.writeBool(entity.ForceRegular)
+	encoder.writeRaw(`,"force_quiz":`)
+	encoder.writeBool(entity.ForceQuiz)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButtonTypeRequestPoll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "force_regular":
+			entity.ForceRegular, err = decoder.readBool()
+
+		case "force_quiz":
+			entity.ForceQuiz, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorKeyboardButtonTypeRequestPoll)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that requests users to be shared by the current user; available only in private chats. Use the method shareUsersWithBot to complete the request
+type KeyboardButtonTypeRequestUsers struct {
+	meta
+	// Unique button identifier
+	Id int32 `json:"id"`
+	// True, if the shared users must or must not be bots
+	RestrictUserIsBot bool `json:"restrict_user_is_bot"`
+	// True, if the shared users must be bots; otherwise, the shared users must not be bots. Ignored if restrict_user_is_bot is false
+	UserIsBot bool `json:"user_is_bot"`
+	// True, if the shared users must or must not be Telegram Premium users
+	RestrictUserIsPremium bool `json:"restrict_user_is_premium"`
+	// True, if the shared users must be Telegram Premium users; otherwise, the shared users must not be Telegram Premium users. Ignored if restrict_user_is_premium is false
+	UserIsPremium bool `json:"user_is_premium"`
+	// The maximum number of users to share
+	MaxQuantity int32 `json:"max_quantity"`
+	// Pass true to request name of the users; bots only
+	RequestName bool `json:"request_name"`
+	// Pass true to request username of the users; bots only
+	RequestUsername bool `json:"request_username"`
+	// Pass true to request photo of the users; bots only
+	RequestPhoto bool `json:"request_photo"`
+}
+
+func (*KeyboardButtonTypeRequestUsers) GetType() string {
+	return TypeKeyboardButtonType
+}
+
+func (*KeyboardButtonTypeRequestUsers) GetConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestUsers
+}
+
+func (*KeyboardButtonTypeRequestUsers) KeyboardButtonTypeConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestUsers
+}
+
+func (entity *KeyboardButtonTypeRequestUsers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButtonTypeRequestUsers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButtonTypeRequestUsers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButtonTypeRequestUsers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"restrict_user_is_bot":`)
+	encoder.writeBool(entity.RestrictUserIsBot)
+	encoder.writeRaw(`,"user_is_bot":`)
+	encoder.writeBool(entity.UserIsBot)
+	encoder.writeRaw(`,"restrict_user_is_premium":`)
+	encoder.writeBool(entity.RestrictUserIsPremium)
+	encoder.writeRaw(`,"user_is_premium":`)
+	encoder.writeBool(entity.UserIsPremium)
+	encoder.writeRaw(`,"max_quantity":`)
+	encoder.writeInt32(entity.MaxQuantity)
+	encoder.writeRaw(`,"request_name":`)
+	encoder.writeBool(entity.RequestName)
+	encoder.writeRaw(`,"request_username":`)
+	encoder.writeBool(entity.RequestUsername)
+	encoder.writeRaw(`,"request_photo":`)
+	encoder.writeBool(entity.RequestPhoto)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButtonTypeRequestUsers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "restrict_user_is_bot":
+			entity.RestrictUserIsBot, err = decoder.readBool()
+
+		case "user_is_bot":
+			entity.UserIsBot, err = decoder.readBool()
+
+		case "restrict_user_is_premium":
+			entity.RestrictUserIsPremium, err = decoder.readBool()
+
+		case "user_is_premium":
+			entity.UserIsPremium, err = decoder.readBool()
+
+		case "max_quantity":
+			entity.MaxQuantity, err = decoder.readInt32()
+
+		case "request_name":
+			entity.RequestName, err = decoder.readBool()
+
+		case "request_username":
+			entity.RequestUsername, err = decoder.readBool()
+
+		case "request_photo":
+			entity.RequestPhoto, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorKeyboardButtonTypeRequestUsers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that requests a chat to be shared by the current user; available only in private chats. Use the method shareChatWithBot to complete the request
+type KeyboardButtonTypeRequestChat struct {
+	meta
+	// Unique button identifier
+	Id int32 `json:"id"`
+	// True, if the chat must be a channel; otherwise, a basic group or a supergroup chat is shared
+	ChatIsChannel bool `json:"chat_is_channel"`
+	// True, if the chat must or must not be a forum supergroup
+	RestrictChatIsForum bool `json:"restrict_chat_is_forum"`
+	// True, if the chat must be a forum supergroup; otherwise, the chat must not be a forum supergroup. Ignored if restrict_chat_is_forum is false
+	ChatIsForum bool `json:"chat_is_forum"`
+	// True, if the chat must or must not have a username
+	RestrictChatHasUsername bool `json:"restrict_chat_has_username"`
+	// True, if the chat must have a username; otherwise, the chat must not have a username. Ignored if restrict_chat_has_username is false
+	ChatHasUsername bool `json:"chat_has_username"`
+	// True, if the chat must be created by the current user
+	ChatIsCreated bool `json:"chat_is_created"`
+	// Expected user administrator rights in the chat; may be null if they aren't restricted
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	// Expected bot administrator rights in the chat; may be null if they aren't restricted
+	BotAdministratorRights *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+	// True, if the bot must be a member of the chat; for basic group and supergroup chats only
+	BotIsMember bool `json:"bot_is_member"`
+	// Pass true to request title of the chat; bots only
+	RequestTitle bool `json:"request_title"`
+	// Pass true to request username of the chat; bots only
+	RequestUsername bool `json:"request_username"`
+	// Pass true to request photo of the chat; bots only
+	RequestPhoto bool `json:"request_photo"`
+}
+
+func (*KeyboardButtonTypeRequestChat) GetType() string {
+	return TypeKeyboardButtonType
+}
+
+func (*KeyboardButtonTypeRequestChat) GetConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestChat
+}
+
+func (*KeyboardButtonTypeRequestChat) KeyboardButtonTypeConstructor() string {
+	return ConstructorKeyboardButtonTypeRequestChat
+}
+
+func (entity *KeyboardButtonTypeRequestChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButtonTypeRequestChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButtonTypeRequestChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButtonTypeRequestChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"chat_is_channel":`)
+	encoder.writeBool(entity.ChatIsChannel)
+	encoder.writeRaw(`,"restrict_chat_is_forum":`)
+	encoder.writeBool(entity.RestrictChatIsForum)
+	encoder.writeRaw(`,"chat_is_forum":`)
+	encoder.writeBool(entity.ChatIsForum)
+	encoder.writeRaw(`,"restrict_chat_has_username":`)
+	encoder.writeBool(entity.RestrictChatHasUsername)
+	encoder.writeRaw(`,"chat_has_username":`)
+	encoder.writeBool(entity.ChatHasUsername)
+	encoder.writeRaw(`,"chat_is_created":`)
+	encoder.writeBool(entity.ChatIsCreated)
+	if entity.UserAdministratorRights != nil {
+		encoder.writeRaw(`,"user_administrator_rights":`)
+		entity.UserAdministratorRights.encodeJSON(encoder)
+	}
+	if entity.BotAdministratorRights != nil {
+		encoder.writeRaw(`,"bot_administrator_rights":`)
+		entity.BotAdministratorRights.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"bot_is_member":`)
+	encoder.writeBool(entity.BotIsMember)
+	encoder.writeRaw(`,"request_title":`)
+	encoder.writeBool(entity.RequestTitle)
+	encoder.writeRaw(`,"request_username":`)
+	encoder.writeBool(entity.RequestUsername)
+	encoder.writeRaw(`,"request_photo":`)
+	encoder.writeBool(entity.RequestPhoto)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButtonTypeRequestChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "chat_is_channel":
+			entity.ChatIsChannel, err = decoder.readBool()
+
+		case "restrict_chat_is_forum":
+			entity.RestrictChatIsForum, err = decoder.readBool()
+
+		case "chat_is_forum":
+			entity.ChatIsForum, err = decoder.readBool()
+
+		case "restrict_chat_has_username":
+			entity.RestrictChatHasUsername, err = decoder.readBool()
+
+		case "chat_has_username":
+			entity.ChatHasUsername, err = decoder.readBool()
+
+		case "chat_is_created":
+			entity.ChatIsCreated, err = decoder.readBool()
+
+		case "user_administrator_rights":
+			entity.UserAdministratorRights, err = decodeEntity[ChatAdministratorRights](decoder)
+
+		case "bot_administrator_rights":
+			entity.BotAdministratorRights, err = decodeEntity[ChatAdministratorRights](decoder)
+
+		case "bot_is_member":
+			entity.BotIsMember, err = decoder.readBool()
+
+		case "request_title":
+			entity.RequestTitle, err = decoder.readBool()
+
+		case "request_username":
+			entity.RequestUsername, err = decoder.readBool()
+
+		case "request_photo":
+			entity.RequestPhoto, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorKeyboardButtonTypeRequestChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that opens a Web App by calling getWebAppUrl
+type KeyboardButtonTypeWebApp struct {
+	meta
+	// An HTTP URL to pass to getWebAppUrl
+	Url string `json:"url"`
+}
+
+func (*KeyboardButtonTypeWebApp) GetType() string {
+	return TypeKeyboardButtonType
+}
+
+func (*KeyboardButtonTypeWebApp) GetConstructor() string {
+	return ConstructorKeyboardButtonTypeWebApp
+}
+
+func (*KeyboardButtonTypeWebApp) KeyboardButtonTypeConstructor() string {
+	return ConstructorKeyboardButtonTypeWebApp
+}
+
+func (entity *KeyboardButtonTypeWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButtonTypeWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButtonTypeWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButtonTypeWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButtonTypeWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorKeyboardButtonTypeWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a single button in a bot keyboard
+type KeyboardButton struct {
+	meta
+	// Text of the button
+	Text string `json:"text"`
+	// Type of the button
+	Type KeyboardButtonType `json:"type"`
+}
+
+func (*KeyboardButton) GetType() string {
+	return TypeKeyboardButton
+}
+
+func (*KeyboardButton) GetConstructor() string {
+	return ConstructorKeyboardButton
+}
+
+func (entity *KeyboardButton) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *KeyboardButton) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *KeyboardButton) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"keyboardButton"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *KeyboardButton) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "type":
+			entity.Type, err = decodeKeyboardButtonType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorKeyboardButton)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that opens a specified URL
+type InlineKeyboardButtonTypeUrl struct {
+	meta
+	// HTTP or tg:// URL to open. If the link is of the type internalLinkTypeWebApp, then the button must be marked as a Web App button
+	Url string `json:"url"`
+}
+
+func (*InlineKeyboardButtonTypeUrl) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeUrl) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeUrl
+}
+
+func (*InlineKeyboardButtonTypeUrl) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeUrl
+}
+
+func (entity *InlineKeyboardButtonTypeUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that opens a specified URL and automatically authorize the current user by calling getLoginUrlInfo
+type InlineKeyboardButtonTypeLoginUrl struct {
+	meta
+	// An HTTP URL to pass to getLoginUrlInfo
+	Url string `json:"url"`
+	// Unique button identifier
+	Id int64 `json:"id"`
+	// If non-empty, new text of the button in forwarded messages
+	ForwardText string `json:"forward_text"`
+}
+
+func (*InlineKeyboardButtonTypeLoginUrl) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeLoginUrl) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeLoginUrl
+}
+
+func (*InlineKeyboardButtonTypeLoginUrl) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeLoginUrl
+}
+
+func (entity *InlineKeyboardButtonTypeLoginUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeLoginUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeLoginUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeLoginUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	encoder.writeRaw(`,"forward_text":`)
+	encoder.writeString(entity.ForwardText)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeLoginUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "forward_text":
+			entity.ForwardText, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeLoginUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that opens a Web App by calling openWebApp
+type InlineKeyboardButtonTypeWebApp struct {
+	meta
+	// An HTTP URL to pass to openWebApp
+	Url string `json:"url"`
+}
+
+func (*InlineKeyboardButtonTypeWebApp) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeWebApp) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeWebApp
+}
+
+func (*InlineKeyboardButtonTypeWebApp) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeWebApp
+}
+
+func (entity *InlineKeyboardButtonTypeWebApp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeWebApp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeWebApp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeWebApp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeWebApp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeWebApp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that sends a callback query to a bot
+type InlineKeyboardButtonTypeCallback struct {
+	meta
+	// Data to be sent to the bot via a callback query
+	Data []byte `json:"data"`
+}
+
+func (*InlineKeyboardButtonTypeCallback) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeCallback) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCallback
+}
+
+func (*InlineKeyboardButtonTypeCallback) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCallback
+}
+
+func (entity *InlineKeyboardButtonTypeCallback) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeCallback) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeCallback) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeCallback"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeCallback) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeCallback)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that asks for the 2-step verification password of the current user and then sends a callback query to a bot
+type InlineKeyboardButtonTypeCallbackWithPassword struct {
+	meta
+	// Data to be sent to the bot via a callback query
+	Data []byte `json:"data"`
+}
+
+func (*InlineKeyboardButtonTypeCallbackWithPassword) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeCallbackWithPassword) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCallbackWithPassword
+}
+
+func (*InlineKeyboardButtonTypeCallbackWithPassword) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCallbackWithPassword
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackWithPassword) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackWithPassword) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackWithPassword) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeCallbackWithPassword"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackWithPassword) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeCallbackWithPassword)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button with a game that sends a callback query to a bot. This button must be in the first column and row of the keyboard and can be attached only to a message with content of the type messageGame
+type InlineKeyboardButtonTypeCallbackGame struct {
+	meta
+}
+
+func (*InlineKeyboardButtonTypeCallbackGame) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeCallbackGame) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCallbackGame
+}
+
+func (*InlineKeyboardButtonTypeCallbackGame) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCallbackGame
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeCallbackGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeCallbackGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeCallbackGame)
+	})
+}
+
+// A button that forces an inline query to the bot to be inserted in the input field
+type InlineKeyboardButtonTypeSwitchInline struct {
+	meta
+	// Inline query to be sent to the bot
+	Query string `json:"query"`
+	// Target chat from which to send the inline query
+	TargetChat TargetChat `json:"target_chat"`
+}
+
+func (*InlineKeyboardButtonTypeSwitchInline) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeSwitchInline) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeSwitchInline
+}
+
+func (*InlineKeyboardButtonTypeSwitchInline) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeSwitchInline
+}
+
+func (entity *InlineKeyboardButtonTypeSwitchInline) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeSwitchInline) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeSwitchInline) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeSwitchInline"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw(`,"target_chat":`)
+	encoder.writeType(entity.TargetChat)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeSwitchInline) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		case "target_chat":
+			entity.TargetChat, err = decodeTargetChat(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeSwitchInline)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button to buy something. This button must be in the first column and row of the keyboard and can be attached only to a message with content of the type messageInvoice
+type InlineKeyboardButtonTypeBuy struct {
+	meta
+}
+
+func (*InlineKeyboardButtonTypeBuy) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeBuy) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeBuy
+}
+
+func (*InlineKeyboardButtonTypeBuy) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeBuy
+}
+
+func (entity *InlineKeyboardButtonTypeBuy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeBuy) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeBuy) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeBuy"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeBuy) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeBuy)
+	})
+}
+
+// A button with a user reference to be handled in the same way as textEntityTypeMentionName entities
+type InlineKeyboardButtonTypeUser struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+}
+
+func (*InlineKeyboardButtonTypeUser) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeUser) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeUser
+}
+
+func (*InlineKeyboardButtonTypeUser) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeUser
+}
+
+func (entity *InlineKeyboardButtonTypeUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A button that copies specified text to clipboard
+type InlineKeyboardButtonTypeCopyText struct {
+	meta
+	// The text to copy to clipboard
+	Text string `json:"text"`
+}
+
+func (*InlineKeyboardButtonTypeCopyText) GetType() string {
+	return TypeInlineKeyboardButtonType
+}
+
+func (*InlineKeyboardButtonTypeCopyText) GetConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCopyText
+}
+
+func (*InlineKeyboardButtonTypeCopyText) InlineKeyboardButtonTypeConstructor() string {
+	return ConstructorInlineKeyboardButtonTypeCopyText
+}
+
+func (entity *InlineKeyboardButtonTypeCopyText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButtonTypeCopyText) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButtonTypeCopyText) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButtonTypeCopyText"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButtonTypeCopyText) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButtonTypeCopyText)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a single button in an inline keyboard
+type InlineKeyboardButton struct {
+	meta
+	// Text of the button
+	Text string `json:"text"`
+	// Type of the button
+	Type InlineKeyboardButtonType `json:"type"`
+}
+
+func (*InlineKeyboardButton) GetType() string {
+	return TypeInlineKeyboardButton
+}
+
+func (*InlineKeyboardButton) GetConstructor() string {
+	return ConstructorInlineKeyboardButton
+}
+
+func (entity *InlineKeyboardButton) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InlineKeyboardButton) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InlineKeyboardButton) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inlineKeyboardButton"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *InlineKeyboardButton) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "type":
+			entity.Type, err = decodeInlineKeyboardButtonType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInlineKeyboardButton)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Instructs application to remove the keyboard once this message has been received. This kind of keyboard can't be received in an incoming message; instead, updateChatReplyMarkup with message_id == 0 will be sent
+type ReplyMarkupRemoveKeyboard struct {
+	meta
+	// True, if the keyboard is removed only for the mentioned users or the target user of a reply
+	IsPersonal bool `json:"is_personal"`
+}
+
+func (*ReplyMarkupRemoveKeyboard) GetType() string {
+	return TypeReplyMarkup
+}
+
+func (*ReplyMarkupRemoveKeyboard) GetConstructor() string {
+	return ConstructorReplyMarkupRemoveKeyboard
+}
+
+func (*ReplyMarkupRemoveKeyboard) ReplyMarkupConstructor() string {
+	return ConstructorReplyMarkupRemoveKeyboard
+}
+
+func (entity *ReplyMarkupRemoveKeyboard) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReplyMarkupRemoveKeyboard) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReplyMarkupRemoveKeyboard) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"replyMarkupRemoveKeyboard"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_personal":`)
+	encoder.writeBool(entity.IsPersonal)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReplyMarkupRemoveKeyboard) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_personal":
+			entity.IsPersonal, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReplyMarkupRemoveKeyboard)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Instructs application to force a reply to this message
+type ReplyMarkupForceReply struct {
+	meta
+	// True, if a forced reply must automatically be shown to the current user. For outgoing messages, specify true to show the forced reply only for the mentioned users and for the target user of a reply
+	IsPersonal bool `json:"is_personal"`
+	// If non-empty, the placeholder to be shown in the input field when the reply is active; 0-64 characters
+	InputFieldPlaceholder string `json:"input_field_placeholder"`
+}
+
+func (*ReplyMarkupForceReply) GetType() string {
+	return TypeReplyMarkup
+}
+
+func (*ReplyMarkupForceReply) GetConstructor() string {
+	return ConstructorReplyMarkupForceReply
+}
+
+func (*ReplyMarkupForceReply) ReplyMarkupConstructor() string {
+	return ConstructorReplyMarkupForceReply
+}
+
+func (entity *ReplyMarkupForceReply) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReplyMarkupForceReply) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReplyMarkupForceReply) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"replyMarkupForceReply"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_personal":`)
+	encoder.writeBool(entity.IsPersonal)
+	encoder.writeRaw(`,"input_field_placeholder":`)
+	encoder.writeString(entity.InputFieldPlaceholder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReplyMarkupForceReply) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_personal":
+			entity.IsPersonal, err = decoder.readBool()
+
+		case "input_field_placeholder":
+			entity.InputFieldPlaceholder, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReplyMarkupForceReply)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ReplyMarkupForceReply) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("input_field_placeholder", entity.InputFieldPlaceholder, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a custom keyboard layout to quickly reply to bots
+type ReplyMarkupShowKeyboard struct {
+	meta
+	// A list of rows of bot keyboard buttons
+	Rows [][]*KeyboardButton `json:"rows"`
+	// True, if the keyboard is expected to always be shown when the ordinary keyboard is hidden
+	IsPersistent bool `json:"is_persistent"`
+	// True, if the application needs to resize the keyboard vertically
+	ResizeKeyboard bool `json:"resize_keyboard"`
+	// True, if the application needs to hide the keyboard after use
+	OneTime bool `json:"one_time"`
+	// True, if the keyboard must automatically be shown to the current user. For outgoing messages, specify true to show the keyboard only for the mentioned users and for the target user of a reply
+	IsPersonal bool `json:"is_personal"`
+	// If non-empty, the placeholder to be shown in the input field when the keyboard is active; 0-64 characters
+	InputFieldPlaceholder string `json:"input_field_placeholder"`
+}
+
+func (*ReplyMarkupShowKeyboard) GetType() string {
+	return TypeReplyMarkup
+}
+
+func (*ReplyMarkupShowKeyboard) GetConstructor() string {
+	return ConstructorReplyMarkupShowKeyboard
+}
+
+func (*ReplyMarkupShowKeyboard) ReplyMarkupConstructor() string {
+	return ConstructorReplyMarkupShowKeyboard
+}
+
+func (entity *ReplyMarkupShowKeyboard) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReplyMarkupShowKeyboard) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReplyMarkupShowKeyboard) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"replyMarkupShowKeyboard"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"rows":`)
+	writeList(encoder, entity.Rows, listWriter(writeTypeValue[*KeyboardButton]))
+	encoder.writeRaw(`,"is_persistent":`)
+	encoder.writeBool(entity.IsPersistent)
+	encoder.writeRaw(`,"resize_keyboard":`)
+	encoder.writeBool(entity.ResizeKeyboard)
+	encoder.writeRaw(`,"one_time":`)
+	encoder.writeBool(entity.OneTime)
+	encoder.writeRaw(`,"is_personal":`)
+	encoder.writeBool(entity.IsPersonal)
+	encoder.writeRaw(`,"input_field_placeholder":`)
+	encoder.writeString(entity.InputFieldPlaceholder)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReplyMarkupShowKeyboard) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "rows":
+			entity.Rows, err = readList(decoder, listReader(decodeEntity[KeyboardButton]))
+
+		case "is_persistent":
+			entity.IsPersistent, err = decoder.readBool()
+
+		case "resize_keyboard":
+			entity.ResizeKeyboard, err = decoder.readBool()
+
+		case "one_time":
+			entity.OneTime, err = decoder.readBool()
+
+		case "is_personal":
+			entity.IsPersonal, err = decoder.readBool()
+
+		case "input_field_placeholder":
+			entity.InputFieldPlaceholder, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReplyMarkupShowKeyboard)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *ReplyMarkupShowKeyboard) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("input_field_placeholder", entity.InputFieldPlaceholder, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains an inline keyboard layout
+type ReplyMarkupInlineKeyboard struct {
+	meta
+	// A list of rows of inline keyboard buttons
+	Rows [][]*InlineKeyboardButton `json:"rows"`
+}
+
+func (*ReplyMarkupInlineKeyboard) GetType() string {
+	return TypeReplyMarkup
+}
+
+func (*ReplyMarkupInlineKeyboard) GetConstructor() string {
+	return ConstructorReplyMarkupInlineKeyboard
+}
+
+func (*ReplyMarkupInlineKeyboard) ReplyMarkupConstructor() string {
+	return ConstructorReplyMarkupInlineKeyboard
+}
+
+func (entity *ReplyMarkupInlineKeyboard) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReplyMarkupInlineKeyboard) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReplyMarkupInlineKeyboard) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"replyMarkupInlineKeyboard"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"rows":`)
+	writeList(encoder, entity.Rows, listWriter(writeTypeValue[*InlineKeyboardButton]))
+	encoder.writeRaw("}")
+}
+
+func (entity *ReplyMarkupInlineKeyboard) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "rows":
+			entity.Rows, err = readList(decoder, listReader(decodeEntity[InlineKeyboardButton]))
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReplyMarkupInlineKeyboard)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a message thread
+type MessageThreadInfo struct {
+	meta
+	// Identifier of the chat to which the message thread belongs
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier, unique within the chat
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Information about the message thread; may be null for forum topic threads
+	ReplyInfo *MessageReplyInfo `json:"reply_info,omitempty"`
+	// Approximate number of unread messages in the message thread
+	UnreadMessageCount int32 `json:"unread_message_count"`
+	// The messages from which the thread starts. The messages are returned in reverse chronological order (i.e., in order of decreasing message_id)
+	Messages []*Message `json:"messages"`
+	// A draft of a message in the message thread; may be null if none
+	DraftMessage *DraftMessage `json:"draft_message,omitempty"`
+}
+
+func (*MessageThreadInfo) GetType() string {
+	return TypeMessageThreadInfo
+}
+
+func (*MessageThreadInfo) GetConstructor() string {
+	return ConstructorMessageThreadInfo
+}
+
+func (entity *MessageThreadInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageThreadInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageThreadInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageThreadInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	if entity.ReplyInfo != nil {
+		encoder.writeRaw(`,"reply_info":`)
+		entity.ReplyInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"unread_message_count":`)
+	encoder.writeInt32(entity.UnreadMessageCount)
+	encoder.writeRaw(`,"messages":`)
+	writeList(encoder, entity.Messages, writeTypeValue[*Message])
+	if entity.DraftMessage != nil {
+		encoder.writeRaw(`,"draft_message":`)
+		entity.DraftMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageThreadInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		case "reply_info":
+			entity.ReplyInfo, err = decodeEntity[MessageReplyInfo](decoder)
+
+		case "unread_message_count":
+			entity.UnreadMessageCount, err = decoder.readInt32()
+
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[Message])
+
+		case "draft_message":
+			entity.DraftMessage, err = decodeEntity[DraftMessage](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageThreadInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageThreadInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	if err := validateNested("draft_message", entity.DraftMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Topic containing messages sent by the current user of forwarded from an unknown chat
+type SavedMessagesTopicTypeMyNotes struct {
+	meta
+}
+
+func (*SavedMessagesTopicTypeMyNotes) GetType() string {
+	return TypeSavedMessagesTopicType
+}
+
+func (*SavedMessagesTopicTypeMyNotes) GetConstructor() string {
+	return ConstructorSavedMessagesTopicTypeMyNotes
+}
+
+func (*SavedMessagesTopicTypeMyNotes) SavedMessagesTopicTypeConstructor() string {
+	return ConstructorSavedMessagesTopicTypeMyNotes
+}
+
+func (entity *SavedMessagesTopicTypeMyNotes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SavedMessagesTopicTypeMyNotes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SavedMessagesTopicTypeMyNotes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savedMessagesTopicTypeMyNotes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SavedMessagesTopicTypeMyNotes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSavedMessagesTopicTypeMyNotes)
+	})
+}
+
+// Topic containing messages forwarded from a user with hidden privacy
+type SavedMessagesTopicTypeAuthorHidden struct {
+	meta
+}
+
+func (*SavedMessagesTopicTypeAuthorHidden) GetType() string {
+	return TypeSavedMessagesTopicType
+}
+
+func (*SavedMessagesTopicTypeAuthorHidden) GetConstructor() string {
+	return ConstructorSavedMessagesTopicTypeAuthorHidden
+}
+
+func (*SavedMessagesTopicTypeAuthorHidden) SavedMessagesTopicTypeConstructor() string {
+	return ConstructorSavedMessagesTopicTypeAuthorHidden
+}
+
+func (entity *SavedMessagesTopicTypeAuthorHidden) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SavedMessagesTopicTypeAuthorHidden) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SavedMessagesTopicTypeAuthorHidden) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savedMessagesTopicTypeAuthorHidden"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SavedMessagesTopicTypeAuthorHidden) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSavedMessagesTopicTypeAuthorHidden)
+	})
+}
+
+// Topic containing messages forwarded from a specific chat
+type SavedMessagesTopicTypeSavedFromChat struct {
+	meta
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*SavedMessagesTopicTypeSavedFromChat) GetType() string {
+	return TypeSavedMessagesTopicType
+}
+
+func (*SavedMessagesTopicTypeSavedFromChat) GetConstructor() string {
+	return ConstructorSavedMessagesTopicTypeSavedFromChat
+}
+
+func (*SavedMessagesTopicTypeSavedFromChat) SavedMessagesTopicTypeConstructor() string {
+	return ConstructorSavedMessagesTopicTypeSavedFromChat
+}
+
+func (entity *SavedMessagesTopicTypeSavedFromChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SavedMessagesTopicTypeSavedFromChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SavedMessagesTopicTypeSavedFromChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savedMessagesTopicTypeSavedFromChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *SavedMessagesTopicTypeSavedFromChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSavedMessagesTopicTypeSavedFromChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a Saved Messages topic
+type SavedMessagesTopic struct {
+	meta
+	// Unique topic identifier
+	Id int64 `json:"id"`
+	// Type of the topic
+	Type SavedMessagesTopicType `json:"type"`
+	// True, if the topic is pinned
+	IsPinned bool `json:"is_pinned"`
+	// A parameter used to determine order of the topic in the topic list. Topics must be sorted by the order in descending order
+	Order JsonInt64 `json:"order"`
+	// Last message in the topic; may be null if none or unknown
+	LastMessage *Message `json:"last_message,omitempty"`
+	// A draft of a message in the topic; may be null if none
+	DraftMessage *DraftMessage `json:"draft_message,omitempty"`
+}
+
+func (*SavedMessagesTopic) GetType() string {
+	return TypeSavedMessagesTopic
+}
+
+func (*SavedMessagesTopic) GetConstructor() string {
+	return ConstructorSavedMessagesTopic
+}
+
+func (entity *SavedMessagesTopic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SavedMessagesTopic) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SavedMessagesTopic) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"savedMessagesTopic"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw(`,"order":`)
+	encoder.writeJsonInt64(entity.Order)
+	if entity.LastMessage != nil {
+		encoder.writeRaw(`,"last_message":`)
+		entity.LastMessage.encodeJSON(encoder)
+	}
+	if entity.DraftMessage != nil {
+		encoder.writeRaw(`,"draft_message":`)
+		entity.DraftMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *SavedMessagesTopic) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "type":
+			entity.Type, err = decodeSavedMessagesTopicType(decoder)
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		case "order":
+			entity.Order, err = decoder.readJsonInt64()
+
+		case "last_message":
+			entity.LastMessage, err = decodeEntity[Message](decoder)
+
+		case "draft_message":
+			entity.DraftMessage, err = decodeEntity[DraftMessage](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSavedMessagesTopic)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *SavedMessagesTopic) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("last_message", entity.LastMessage); err != nil {
+		return err
+	}
+
+	if err := validateNested("draft_message", entity.DraftMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A plain text
+type RichTextPlain struct {
+	meta
+	// Text
+	Text string `json:"text"`
+}
+
+func (*RichTextPlain) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextPlain) GetConstructor() string {
+	return ConstructorRichTextPlain
+}
+
+func (*RichTextPlain) RichTextConstructor() string {
+	return ConstructorRichTextPlain
+}
+
+func (entity *RichTextPlain) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextPlain) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextPlain) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextPlain"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextPlain) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextPlain)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A bold rich text
+type RichTextBold struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextBold) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextBold) GetConstructor() string {
+	return ConstructorRichTextBold
+}
+
+func (*RichTextBold) RichTextConstructor() string {
+	return ConstructorRichTextBold
+}
+
+func (entity *RichTextBold) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextBold) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextBold) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextBold"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextBold) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextBold)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An italicized rich text
+type RichTextItalic struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextItalic) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextItalic) GetConstructor() string {
+	return ConstructorRichTextItalic
+}
+
+func (*RichTextItalic) RichTextConstructor() string {
+	return ConstructorRichTextItalic
+}
+
+func (entity *RichTextItalic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextItalic) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextItalic) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextItalic"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextItalic) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextItalic)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An underlined rich text
+type RichTextUnderline struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextUnderline) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextUnderline) GetConstructor() string {
+	return ConstructorRichTextUnderline
+}
+
+func (*RichTextUnderline) RichTextConstructor() string {
+	return ConstructorRichTextUnderline
+}
+
+func (entity *RichTextUnderline) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextUnderline) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextUnderline) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextUnderline"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextUnderline) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextUnderline)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A strikethrough rich text
+type RichTextStrikethrough struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextStrikethrough) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextStrikethrough) GetConstructor() string {
+	return ConstructorRichTextStrikethrough
+}
+
+func (*RichTextStrikethrough) RichTextConstructor() string {
+	return ConstructorRichTextStrikethrough
+}
+
+func (entity *RichTextStrikethrough) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextStrikethrough) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextStrikethrough) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextStrikethrough"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextStrikethrough) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextStrikethrough)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A fixed-width rich text
+type RichTextFixed struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextFixed) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextFixed) GetConstructor() string {
+	return ConstructorRichTextFixed
+}
+
+func (*RichTextFixed) RichTextConstructor() string {
+	return ConstructorRichTextFixed
+}
+
+func (entity *RichTextFixed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextFixed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextFixed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextFixed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextFixed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextFixed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A rich text URL link
+type RichTextUrl struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+	// URL
+	Url string `json:"url"`
+	// True, if the URL has cached instant view server-side
+	IsCached bool `json:"is_cached"`
+}
+
+func (*RichTextUrl) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextUrl) GetConstructor() string {
+	return ConstructorRichTextUrl
+}
+
+func (*RichTextUrl) RichTextConstructor() string {
+	return ConstructorRichTextUrl
+}
+
+func (entity *RichTextUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"is_cached":`)
+	encoder.writeBool(entity.IsCached)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "is_cached":
+			entity.IsCached, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A rich text email link
+type RichTextEmailAddress struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+	// Email address
+	EmailAddress string `json:"email_address"`
+}
+
+func (*RichTextEmailAddress) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextEmailAddress) GetConstructor() string {
+	return ConstructorRichTextEmailAddress
+}
+
+func (*RichTextEmailAddress) RichTextConstructor() string {
+	return ConstructorRichTextEmailAddress
+}
+
+func (entity *RichTextEmailAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextEmailAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextEmailAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextEmailAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"email_address":`)
+	encoder.writeString(entity.EmailAddress)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextEmailAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "email_address":
+			entity.EmailAddress, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextEmailAddress)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A subscript rich text
+type RichTextSubscript struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextSubscript) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextSubscript) GetConstructor() string {
+	return ConstructorRichTextSubscript
+}
+
+func (*RichTextSubscript) RichTextConstructor() string {
+	return ConstructorRichTextSubscript
+}
+
+func (entity *RichTextSubscript) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextSubscript) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextSubscript) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextSubscript"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextSubscript) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextSubscript)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A superscript rich text
+type RichTextSuperscript struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextSuperscript) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextSuperscript) GetConstructor() string {
+	return ConstructorRichTextSuperscript
+}
+
+func (*RichTextSuperscript) RichTextConstructor() string {
+	return ConstructorRichTextSuperscript
+}
+
+func (entity *RichTextSuperscript) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextSuperscript) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextSuperscript) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextSuperscript"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextSuperscript) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextSuperscript)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A marked rich text
+type RichTextMarked struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+}
+
+func (*RichTextMarked) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextMarked) GetConstructor() string {
+	return ConstructorRichTextMarked
+}
+
+func (*RichTextMarked) RichTextConstructor() string {
+	return ConstructorRichTextMarked
+}
+
+func (entity *RichTextMarked) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextMarked) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextMarked) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextMarked"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextMarked) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextMarked)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A rich text phone number
+type RichTextPhoneNumber struct {
+	meta
+	// Text
+	Text RichText `json:"text"`
+	// Phone number
+	PhoneNumber string `json:"phone_number"`
+}
+
+func (*RichTextPhoneNumber) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextPhoneNumber) GetConstructor() string {
+	return ConstructorRichTextPhoneNumber
+}
+
+func (*RichTextPhoneNumber) RichTextConstructor() string {
+	return ConstructorRichTextPhoneNumber
+}
+
+func (entity *RichTextPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextPhoneNumber)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A small image inside the text
+type RichTextIcon struct {
+	meta
+	// The image represented as a document. The image can be in GIF, JPEG or PNG format
+	Document *Document `json:"document"`
+	// Width of a bounding box in which the image must be shown; 0 if unknown
+	Width int32 `json:"width"`
+	// Height of a bounding box in which the image must be shown; 0 if unknown
+	Height int32 `json:"height"`
+}
+
+func (*RichTextIcon) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextIcon) GetConstructor() string {
+	return ConstructorRichTextIcon
+}
+
+func (*RichTextIcon) RichTextConstructor() string {
+	return ConstructorRichTextIcon
+}
+
+func (entity *RichTextIcon) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextIcon) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextIcon) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextIcon"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"document":`)
+	entity.Document.encodeJSON(encoder)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextIcon) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "document":
+			entity.Document, err = decodeEntity[Document](decoder)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextIcon)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A reference to a richTexts object on the same page
+type RichTextReference struct {
+	meta
+	// The text
+	Text RichText `json:"text"`
+	// The name of a richTextAnchor object, which is the first element of the target richTexts object
+	AnchorName string `json:"anchor_name"`
+	// An HTTP URL, opening the reference
+	Url string `json:"url"`
+}
+
+func (*RichTextReference) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextReference) GetConstructor() string {
+	return ConstructorRichTextReference
+}
+
+func (*RichTextReference) RichTextConstructor() string {
+	return ConstructorRichTextReference
+}
+
+func (entity *RichTextReference) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextReference) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextReference) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextReference"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"anchor_name":`)
+	encoder.writeString(entity.AnchorName)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextReference) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "anchor_name":
+			entity.AnchorName, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextReference)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An anchor
+type RichTextAnchor struct {
+	meta
+	// Anchor name
+	Name string `json:"name"`
+}
+
+func (*RichTextAnchor) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextAnchor) GetConstructor() string {
+	return ConstructorRichTextAnchor
+}
+
+func (*RichTextAnchor) RichTextConstructor() string {
+	return ConstructorRichTextAnchor
+}
+
+func (entity *RichTextAnchor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextAnchor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextAnchor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextAnchor"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextAnchor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextAnchor)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A link to an anchor on the same page
+type RichTextAnchorLink struct {
+	meta
+	// The link text
+	Text RichText `json:"text"`
+	// The anchor name. If the name is empty, the link must bring back to top
+	AnchorName string `json:"anchor_name"`
+	// An HTTP URL, opening the anchor
+	Url string `json:"url"`
+}
+
+func (*RichTextAnchorLink) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTextAnchorLink) GetConstructor() string {
+	return ConstructorRichTextAnchorLink
+}
+
+func (*RichTextAnchorLink) RichTextConstructor() string {
+	return ConstructorRichTextAnchorLink
+}
+
+func (entity *RichTextAnchorLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTextAnchorLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTextAnchorLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTextAnchorLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeType(entity.Text)
+	encoder.writeRaw(`,"anchor_name":`)
+	encoder.writeString(entity.AnchorName)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTextAnchorLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeRichText(decoder)
+
+		case "anchor_name":
+			entity.AnchorName, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTextAnchorLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A concatenation of rich texts
+type RichTexts struct {
+	meta
+	// Texts
+	Texts []RichText `json:"texts"`
+}
+
+func (*RichTexts) GetType() string {
+	return TypeRichText
+}
+
+func (*RichTexts) GetConstructor() string {
+	return ConstructorRichTexts
+}
+
+func (*RichTexts) RichTextConstructor() string {
+	return ConstructorRichTexts
+}
+
+func (entity *RichTexts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RichTexts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RichTexts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"richTexts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"texts":`)
+	writeList(encoder, entity.Texts, writeTypeValue[RichText])
+	encoder.writeRaw("}")
+}
+
+func (entity *RichTexts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "texts":
+			entity.Texts, err = readList(decoder, decodeRichText)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRichTexts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A text message
+type MessageText struct {
+	meta
+	// Text of the message
+	Text *FormattedText `json:"text"`
+	// A link preview attached to the message; may be null
+	LinkPreview *LinkPreview `json:"link_preview,omitempty"`
+	// Options which were used for generation of the link preview; may be null if default options were used
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+}
+
+func (*MessageText) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageText) GetConstructor() string {
+	return ConstructorMessageText
+}
+
+func (*MessageText) MessageContentConstructor() string {
+	return ConstructorMessageText
+}
+
+func (entity *MessageText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageText) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageText) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageText"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	if entity.LinkPreview != nil {
+		encoder.writeRaw(`,"link_preview":`)
+		entity.LinkPreview.encodeJSON(encoder)
+	}
+	if entity.LinkPreviewOptions != nil {
+		encoder.writeRaw(`,"link_preview_options":`)
+		entity.LinkPreviewOptions.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageText) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "link_preview":
+			entity.LinkPreview, err = decodeEntity[LinkPreview](decoder)
+
+		case "link_preview_options":
+			entity.LinkPreviewOptions, err = decodeEntity[LinkPreviewOptions](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageText)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An animation message (GIF-style).
+type MessageAnimation struct {
+	meta
+	// The animation description
+	Animation *Animation `json:"animation"`
+	// Animation caption
+	Caption *FormattedText `json:"caption"`
+	// True, if the caption must be shown above the animation; otherwise, the caption must be shown below the animation
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// True, if the animation preview must be covered by a spoiler animation
+	HasSpoiler bool `json:"has_spoiler"`
+	// True, if the animation thumbnail must be blurred and the animation must be shown only while tapped
+	IsSecret bool `json:"is_secret"`
+}
+
+func (*MessageAnimation) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageAnimation) GetConstructor() string {
+	return ConstructorMessageAnimation
+}
+
+func (*MessageAnimation) MessageContentConstructor() string {
+	return ConstructorMessageAnimation
+}
+
+func (entity *MessageAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"animation":`)
+	entity.Animation.encodeJSON(encoder)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	encoder.writeRaw(`,"has_spoiler":`)
+	encoder.writeBool(entity.HasSpoiler)
+	encoder.writeRaw(`,"is_secret":`)
+	encoder.writeBool(entity.IsSecret)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		case "has_spoiler":
+			entity.HasSpoiler, err = decoder.readBool()
+
+		case "is_secret":
+			entity.IsSecret, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An audio message
+type MessageAudio struct {
+	meta
+	// The audio description
+	Audio *Audio `json:"audio"`
+	// Audio caption
+	Caption *FormattedText `json:"caption"`
+}
+
+func (*MessageAudio) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageAudio) GetConstructor() string {
+	return ConstructorMessageAudio
+}
+
+func (*MessageAudio) MessageContentConstructor() string {
+	return ConstructorMessageAudio
+}
+
+func (entity *MessageAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"audio":`)
+	entity.Audio.encodeJSON(encoder)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "audio":
+			entity.Audio, err = decodeEntity[Audio](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A document message (general file)
+type MessageDocument struct {
+	meta
+	// The document description
+	Document *Document `json:"document"`
+	// Document caption
+	Caption *FormattedText `json:"caption"`
+}
+
+func (*MessageDocument) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageDocument) GetConstructor() string {
+	return ConstructorMessageDocument
+}
+
+func (*MessageDocument) MessageContentConstructor() string {
+	return ConstructorMessageDocument
+}
+
+func (entity *MessageDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"document":`)
+	entity.Document.encodeJSON(encoder)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "document":
+			entity.Document, err = decodeEntity[Document](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with paid media
+type MessagePaidMedia struct {
+	meta
+	// Number of Telegram Stars needed to buy access to the media in the message
+	StarCount int64 `json:"star_count"`
+	// Information about the media
+	Media []PaidMedia `json:"media"`
+	// Media caption
+	Caption *FormattedText `json:"caption"`
+	// True, if the caption must be shown above the media; otherwise, the caption must be shown below the media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+}
+
+func (*MessagePaidMedia) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePaidMedia) GetConstructor() string {
+	return ConstructorMessagePaidMedia
+}
+
+func (*MessagePaidMedia) MessageContentConstructor() string {
+	return ConstructorMessagePaidMedia
+}
+
+func (entity *MessagePaidMedia) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePaidMedia) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePaidMedia) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePaidMedia"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"media":`)
+	writeList(encoder, entity.Media, writeTypeValue[PaidMedia])
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePaidMedia) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "media":
+			entity.Media, err = readList(decoder, decodePaidMedia)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePaidMedia)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A photo message
+type MessagePhoto struct {
+	meta
+	// The photo
+	Photo *Photo `json:"photo"`
+	// Photo caption
+	Caption *FormattedText `json:"caption"`
+	// True, if the caption must be shown above the photo; otherwise, the caption must be shown below the photo
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// True, if the photo preview must be covered by a spoiler animation
+	HasSpoiler bool `json:"has_spoiler"`
+	// True, if the photo must be blurred and must be shown only while tapped
+	IsSecret bool `json:"is_secret"`
+}
+
+func (*MessagePhoto) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePhoto) GetConstructor() string {
+	return ConstructorMessagePhoto
+}
+
+func (*MessagePhoto) MessageContentConstructor() string {
+	return ConstructorMessagePhoto
+}
+
+func (entity *MessagePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	encoder.writeRaw(`,"has_spoiler":`)
+	encoder.writeBool(entity.HasSpoiler)
+	encoder.writeRaw(`,"is_secret":`)
+	encoder.writeBool(entity.IsSecret)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		case "has_spoiler":
+			entity.HasSpoiler, err = decoder.readBool()
+
+		case "is_secret":
+			entity.IsSecret, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A sticker message
+type MessageSticker struct {
+	meta
+	// The sticker description
+	Sticker *Sticker `json:"sticker"`
+	// True, if premium animation of the sticker must be played
+	IsPremium bool `json:"is_premium"`
+}
+
+func (*MessageSticker) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageSticker) GetConstructor() string {
+	return ConstructorMessageSticker
+}
+
+func (*MessageSticker) MessageContentConstructor() string {
+	return ConstructorMessageSticker
+}
+
+func (entity *MessageSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_premium":`)
+	encoder.writeBool(entity.IsPremium)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		case "is_premium":
+			entity.IsPremium, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video message
+type MessageVideo struct {
+	meta
+	// The video description
+	Video *Video `json:"video"`
+	// Alternative qualities of the video
+	AlternativeVideos []*AlternativeVideo `json:"alternative_videos"`
+	// Cover of the video; may be null if none
+	Cover *Photo `json:"cover,omitempty"`
+	// Timestamp from which the video playing must start, in seconds
+	StartTimestamp int32 `json:"start_timestamp"`
+	// Video caption
+	Caption *FormattedText `json:"caption"`
+	// True, if the caption must be shown above the video; otherwise, the caption must be shown below the video
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// True, if the video preview must be covered by a spoiler animation
+	HasSpoiler bool `json:"has_spoiler"`
+	// True, if the video thumbnail must be blurred and the video must be shown only while tapped
+	IsSecret bool `json:"is_secret"`
+}
+
+func (*MessageVideo) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageVideo) GetConstructor() string {
+	return ConstructorMessageVideo
+}
+
+func (*MessageVideo) MessageContentConstructor() string {
+	return ConstructorMessageVideo
+}
+
+func (entity *MessageVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	encoder.writeRaw(`,"alternative_videos":`)
+	writeList(encoder, entity.AlternativeVideos, writeTypeValue[*AlternativeVideo])
+	if entity.Cover != nil {
+		encoder.writeRaw(`,"cover":`)
+		entity.Cover.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"start_timestamp":`)
+	encoder.writeInt32(entity.StartTimestamp)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	encoder.writeRaw(`,"has_spoiler":`)
+	encoder.writeBool(entity.HasSpoiler)
+	encoder.writeRaw(`,"is_secret":`)
+	encoder.writeBool(entity.IsSecret)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeEntity[Video](decoder)
+
+		case "alternative_videos":
+			entity.AlternativeVideos, err = readList(decoder, decodeEntity[AlternativeVideo])
+
+		case "cover":
+			entity.Cover, err = decodeEntity[Photo](decoder)
+
+		case "start_timestamp":
+			entity.StartTimestamp, err = decoder.readInt32()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		case "has_spoiler":
+			entity.HasSpoiler, err = decoder.readBool()
+
+		case "is_secret":
+			entity.IsSecret, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video note message
+type MessageVideoNote struct {
+	meta
+	// The video note description
+	VideoNote *VideoNote `json:"video_note"`
+	// True, if at least one of the recipients has viewed the video note
+	IsViewed bool `json:"is_viewed"`
+	// True, if the video note thumbnail must be blurred and the video note must be shown only while tapped
+	IsSecret bool `json:"is_secret"`
+}
+
+func (*MessageVideoNote) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageVideoNote) GetConstructor() string {
+	return ConstructorMessageVideoNote
+}
+
+func (*MessageVideoNote) MessageContentConstructor() string {
+	return ConstructorMessageVideoNote
+}
+
+func (entity *MessageVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video_note":`)
+	entity.VideoNote.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_viewed":`)
+	encoder.writeBool(entity.IsViewed)
+	encoder.writeRaw(`,"is_secret":`)
+	encoder.writeBool(entity.IsSecret)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video_note":
+			entity.VideoNote, err = decodeEntity[VideoNote](decoder)
+
+		case "is_viewed":
+			entity.IsViewed, err = decoder.readBool()
+
+		case "is_secret":
+			entity.IsSecret, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageVideoNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A voice note message
+type MessageVoiceNote struct {
+	meta
+	// The voice note description
+	VoiceNote *VoiceNote `json:"voice_note"`
+	// Voice note caption
+	Caption *FormattedText `json:"caption"`
+	// True, if at least one of the recipients has listened to the voice note
+	IsListened bool `json:"is_listened"`
+}
+
+func (*MessageVoiceNote) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageVoiceNote) GetConstructor() string {
+	return ConstructorMessageVoiceNote
+}
+
+func (*MessageVoiceNote) MessageContentConstructor() string {
+	return ConstructorMessageVoiceNote
+}
+
+func (entity *MessageVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"voice_note":`)
+	entity.VoiceNote.encodeJSON(encoder)
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_listened":`)
+	encoder.writeBool(entity.IsListened)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "voice_note":
+			entity.VoiceNote, err = decodeEntity[VoiceNote](decoder)
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "is_listened":
+			entity.IsListened, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A self-destructed photo message
+type MessageExpiredPhoto struct {
+	meta
+}
+
+func (*MessageExpiredPhoto) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageExpiredPhoto) GetConstructor() string {
+	return ConstructorMessageExpiredPhoto
+}
+
+func (*MessageExpiredPhoto) MessageContentConstructor() string {
+	return ConstructorMessageExpiredPhoto
+}
+
+func (entity *MessageExpiredPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageExpiredPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageExpiredPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageExpiredPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageExpiredPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageExpiredPhoto)
+	})
+}
+
+// A self-destructed video message
+type MessageExpiredVideo struct {
+	meta
+}
+
+func (*MessageExpiredVideo) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageExpiredVideo) GetConstructor() string {
+	return ConstructorMessageExpiredVideo
+}
+
+func (*MessageExpiredVideo) MessageContentConstructor() string {
+	return ConstructorMessageExpiredVideo
+}
+
+func (entity *MessageExpiredVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageExpiredVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageExpiredVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageExpiredVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageExpiredVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageExpiredVideo)
+	})
+}
+
+// A self-destructed video note message
+type MessageExpiredVideoNote struct {
+	meta
+}
+
+func (*MessageExpiredVideoNote) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageExpiredVideoNote) GetConstructor() string {
+	return ConstructorMessageExpiredVideoNote
+}
+
+func (*MessageExpiredVideoNote) MessageContentConstructor() string {
+	return ConstructorMessageExpiredVideoNote
+}
+
+func (entity *MessageExpiredVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageExpiredVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageExpiredVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageExpiredVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageExpiredVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageExpiredVideoNote)
+	})
+}
+
+// A self-destructed voice note message
+type MessageExpiredVoiceNote struct {
+	meta
+}
+
+func (*MessageExpiredVoiceNote) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageExpiredVoiceNote) GetConstructor() string {
+	return ConstructorMessageExpiredVoiceNote
+}
+
+func (*MessageExpiredVoiceNote) MessageContentConstructor() string {
+	return ConstructorMessageExpiredVoiceNote
+}
+
+func (entity *MessageExpiredVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageExpiredVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageExpiredVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageExpiredVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageExpiredVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageExpiredVoiceNote)
+	})
+}
+
+// A message with a location
+type MessageLocation struct {
+	meta
+	// The location description
+	Location *Location `json:"location"`
+	// Time relative to the message send date, for which the location can be updated, in seconds; if 0x7FFFFFFF, then location can be updated forever
+	LivePeriod int32 `json:"live_period"`
+	// Left time for which the location can be updated, in seconds. If 0, then the location can't be updated anymore. The update updateMessageContent is not sent when this field changes
+	ExpiresIn int32 `json:"expires_in"`
+	// For live locations, a direction in which the location moves, in degrees; 1-360. If 0 the direction is unknown
+	Heading int32 `json:"heading"`
+	// For live locations, a maximum distance to another chat member for proximity alerts, in meters (0-100000). 0 if the notification is disabled. Available only to the message sender
+	ProximityAlertRadius int32 `json:"proximity_alert_radius"`
+}
+
+func (*MessageLocation) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageLocation) GetConstructor() string {
+	return ConstructorMessageLocation
+}
+
+func (*MessageLocation) MessageContentConstructor() string {
+	return ConstructorMessageLocation
+}
+
+func (entity *MessageLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"live_period":`)
+	encoder.writeInt32(entity.LivePeriod)
+	encoder.writeRaw(`,"expires_in":`)
+	encoder.writeInt32(entity.ExpiresIn)
+	encoder.writeRaw(`,"heading":`)
+	encoder.writeInt32(entity.Heading)
+	encoder.writeRaw(`,"proximity_alert_radius":`)
+	encoder.writeInt32(entity.ProximityAlertRadius)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "live_period":
+			entity.LivePeriod, err = decoder.readInt32()
+
+		case "expires_in":
+			entity.ExpiresIn, err = decoder.readInt32()
+
+		case "heading":
+			entity.Heading, err = decoder.readInt32()
+
+		case "proximity_alert_radius":
+			entity.ProximityAlertRadius, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with information about a venue
+type MessageVenue struct {
+	meta
+	// The venue description
+	Venue *Venue `json:"venue"`
+}
+
+func (*MessageVenue) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageVenue) GetConstructor() string {
+	return ConstructorMessageVenue
+}
+
+func (*MessageVenue) MessageContentConstructor() string {
+	return ConstructorMessageVenue
+}
+
+func (entity *MessageVenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageVenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageVenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageVenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"venue":`)
+	entity.Venue.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageVenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "venue":
+			entity.Venue, err = decodeEntity[Venue](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a user contact
+type MessageContact struct {
+	meta
+	// The contact description
+	Contact *Contact `json:"contact"`
+}
+
+func (*MessageContact) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageContact) GetConstructor() string {
+	return ConstructorMessageContact
+}
+
+func (*MessageContact) MessageContentConstructor() string {
+	return ConstructorMessageContact
+}
+
+func (entity *MessageContact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageContact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageContact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageContact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"contact":`)
+	entity.Contact.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageContact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "contact":
+			entity.Contact, err = decodeEntity[Contact](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageContact)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageContact) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("contact", entity.Contact); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message with an animated emoji
+type MessageAnimatedEmoji struct {
+	meta
+	// The animated emoji
+	AnimatedEmoji *AnimatedEmoji `json:"animated_emoji"`
+	// The corresponding emoji
+	Emoji string `json:"emoji"`
+}
+
+func (*MessageAnimatedEmoji) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageAnimatedEmoji) GetConstructor() string {
+	return ConstructorMessageAnimatedEmoji
+}
+
+func (*MessageAnimatedEmoji) MessageContentConstructor() string {
+	return ConstructorMessageAnimatedEmoji
+}
+
+func (entity *MessageAnimatedEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageAnimatedEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageAnimatedEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageAnimatedEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"animated_emoji":`)
+	entity.AnimatedEmoji.encodeJSON(encoder)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageAnimatedEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animated_emoji":
+			entity.AnimatedEmoji, err = decodeEntity[AnimatedEmoji](decoder)
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageAnimatedEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A dice message. The dice value is randomly generated by the server
+type MessageDice struct {
+	meta
+	// The animated stickers with the initial dice animation; may be null if unknown. The update updateMessageContent will be sent when the sticker became known
+	InitialState DiceStickers `json:"initial_state,omitempty"`
+	// The animated stickers with the final dice animation; may be null if unknown. The update updateMessageContent will be sent when the sticker became known
+	FinalState DiceStickers `json:"final_state,omitempty"`
+	// Emoji on which the dice throw animation is based
+	Emoji string `json:"emoji"`
+	// The dice value. If the value is 0, the dice don't have final state yet
+	Value int32 `json:"value"`
+	// Number of frame after which a success animation like a shower of confetti needs to be shown on updateMessageSendSucceeded
+	SuccessAnimationFrameNumber int32 `json:"success_animation_frame_number"`
+}
+
+func (*MessageDice) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageDice) GetConstructor() string {
+	return ConstructorMessageDice
+}
+
+func (*MessageDice) MessageContentConstructor() string {
+	return ConstructorMessageDice
+}
+
+func (entity *MessageDice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageDice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageDice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageDice"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.InitialState != nil {
+		encoder.writeRaw(`,"initial_state":`)
+		encoder.writeType(entity.InitialState)
+	}
+	if entity.FinalState != nil {
+		encoder.writeRaw(`,"final_state":`)
+		encoder.writeType(entity.FinalState)
+	}
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeInt32(entity.Value)
+	encoder.writeRaw(`,"success_animation_frame_number":`)
+	encoder.writeInt32(entity.SuccessAnimationFrameNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageDice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "initial_state":
+			entity.InitialState, err = decodeDiceStickers(decoder)
+
+		case "final_state":
+			entity.FinalState, err = decodeDiceStickers(decoder)
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "value":
+			entity.Value, err = decoder.readInt32()
+
+		case "success_animation_frame_number":
+			entity.SuccessAnimationFrameNumber, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageDice)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a game
+type MessageGame struct {
+	meta
+	// The game description
+	Game *Game `json:"game"`
+}
+
+func (*MessageGame) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGame) GetConstructor() string {
+	return ConstructorMessageGame
+}
+
+func (*MessageGame) MessageContentConstructor() string {
+	return ConstructorMessageGame
+}
+
+func (entity *MessageGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"game":`)
+	entity.Game.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "game":
+			entity.Game, err = decodeEntity[Game](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a poll
+type MessagePoll struct {
+	meta
+	// The poll description
+	Poll *Poll `json:"poll"`
+}
+
+func (*MessagePoll) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePoll) GetConstructor() string {
+	return ConstructorMessagePoll
+}
+
+func (*MessagePoll) MessageContentConstructor() string {
+	return ConstructorMessagePoll
+}
+
+func (entity *MessagePoll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePoll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePoll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePoll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"poll":`)
+	entity.Poll.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePoll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "poll":
+			entity.Poll, err = decodeEntity[Poll](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePoll)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessagePoll) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("poll", entity.Poll); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message with a forwarded story
+type MessageStory struct {
+	meta
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+	// True, if the story was automatically forwarded because of a mention of the user
+	ViaMention bool `json:"via_mention"`
+}
+
+func (*MessageStory) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageStory) GetConstructor() string {
+	return ConstructorMessageStory
+}
+
+func (*MessageStory) MessageContentConstructor() string {
+	return ConstructorMessageStory
+}
+
+func (entity *MessageStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(entity.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw(`,"via_mention":`)
+	encoder.writeBool(entity.ViaMention)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_sender_chat_id":
+			entity.StorySenderChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		case "via_mention":
+			entity.ViaMention, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with an invoice from a bot. Use getInternalLink with internalLinkTypeBotStart to share the invoice
+type MessageInvoice struct {
+	meta
+	// Information about the product
+	ProductInfo *ProductInfo `json:"product_info"`
+	// Currency for the product price
+	Currency string `json:"currency"`
+	// Product total price in the smallest units of the currency
+	TotalAmount int64 `json:"total_amount"`
+	// Unique invoice bot start_parameter to be passed to getInternalLink
+	StartParameter string `json:"start_parameter"`
+	// True, if the invoice is a test invoice
+	IsTest bool `json:"is_test"`
+	// True, if the shipping address must be specified
+	NeedShippingAddress bool `json:"need_shipping_address"`
+	// The identifier of the message with the receipt, after the product has been purchased
+	ReceiptMessageId int64 `json:"receipt_message_id"`
+	// Extended media attached to the invoice; may be null if none
+	PaidMedia PaidMedia `json:"paid_media,omitempty"`
+	// Extended media caption; may be null if none
+	PaidMediaCaption *FormattedText `json:"paid_media_caption,omitempty"`
+}
+
+func (*MessageInvoice) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageInvoice) GetConstructor() string {
+	return ConstructorMessageInvoice
+}
+
+func (*MessageInvoice) MessageContentConstructor() string {
+	return ConstructorMessageInvoice
+}
+
+func (entity *MessageInvoice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageInvoice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageInvoice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageInvoice"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"product_info":`)
+	entity.ProductInfo.encodeJSON(encoder)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"total_amount":`)
+	encoder.writeInt64(entity.TotalAmount)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(entity.StartParameter)
+	encoder.writeRaw(`,"is_test":`)
+	encoder.writeBool(entity.IsTest)
+	encoder.writeRaw(`,"need_shipping_address":`)
+	encoder.writeBool(entity.NeedShippingAddress)
+	encoder.writeRaw(`,"receipt_message_id":`)
+	encoder.writeInt64(entity.ReceiptMessageId)
+	if entity.PaidMedia != nil {
+		encoder.writeRaw(`,"paid_media":`)
+		encoder.writeType(entity.PaidMedia)
+	}
+	if entity.PaidMediaCaption != nil {
+		encoder.writeRaw(`,"paid_media_caption":`)
+		entity.PaidMediaCaption.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageInvoice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "product_info":
+			entity.ProductInfo, err = decodeEntity[ProductInfo](decoder)
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "total_amount":
+			entity.TotalAmount, err = decoder.readInt64()
+
+		case "start_parameter":
+			entity.StartParameter, err = decoder.readString()
+
+		case "is_test":
+			entity.IsTest, err = decoder.readBool()
+
+		case "need_shipping_address":
+			entity.NeedShippingAddress, err = decoder.readBool()
+
+		case "receipt_message_id":
+			entity.ReceiptMessageId, err = decoder.readInt64()
+
+		case "paid_media":
+			entity.PaidMedia, err = decodePaidMedia(decoder)
+
+		case "paid_media_caption":
+			entity.PaidMediaCaption, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageInvoice)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with information about an ended call
+type MessageCall struct {
+	meta
+	// True, if the call was a video call
+	IsVideo bool `json:"is_video"`
+	// Reason why the call was discarded
+	DiscardReason CallDiscardReason `json:"discard_reason"`
+	// Call duration, in seconds
+	Duration int32 `json:"duration"`
+}
+
+func (*MessageCall) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageCall) GetConstructor() string {
+	return ConstructorMessageCall
+}
+
+func (*MessageCall) MessageContentConstructor() string {
+	return ConstructorMessageCall
+}
+
+func (entity *MessageCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_video":`)
+	encoder.writeBool(entity.IsVideo)
+	encoder.writeRaw(`,"discard_reason":`)
+	encoder.writeType(entity.DiscardReason)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_video":
+			entity.IsVideo, err = decoder.readBool()
+
+		case "discard_reason":
+			entity.DiscardReason, err = decodeCallDiscardReason(decoder)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new video chat was scheduled
+type MessageVideoChatScheduled struct {
+	meta
+	// Identifier of the video chat. The video chat can be received through the method getGroupCall
+	GroupCallId int32 `json:"group_call_id"`
+	// Point in time (Unix timestamp) when the group call is expected to be started by an administrator
+	StartDate int32 `json:"start_date"`
+}
+
+func (*MessageVideoChatScheduled) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageVideoChatScheduled) GetConstructor() string {
+	return ConstructorMessageVideoChatScheduled
+}
+
+func (*MessageVideoChatScheduled) MessageContentConstructor() string {
+	return ConstructorMessageVideoChatScheduled
+}
+
+func (entity *MessageVideoChatScheduled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageVideoChatScheduled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageVideoChatScheduled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageVideoChatScheduled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw(`,"start_date":`)
+	encoder.writeInt32(entity.StartDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageVideoChatScheduled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		case "start_date":
+			entity.StartDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageVideoChatScheduled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A newly created video chat
+type MessageVideoChatStarted struct {
+	meta
+	// Identifier of the video chat. The video chat can be received through the method getGroupCall
+	GroupCallId int32 `json:"group_call_id"`
+}
+
+func (*MessageVideoChatStarted) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageVideoChatStarted) GetConstructor() string {
+	return ConstructorMessageVideoChatStarted
+}
+
+func (*MessageVideoChatStarted) MessageContentConstructor() string {
+	return ConstructorMessageVideoChatStarted
+}
+
+func (entity *MessageVideoChatStarted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageVideoChatStarted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageVideoChatStarted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageVideoChatStarted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageVideoChatStarted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageVideoChatStarted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with information about an ended video chat
+type MessageVideoChatEnded struct {
+	meta
+	// Call duration, in seconds
+	Duration int32 `json:"duration"`
+}
+
+func (*MessageVideoChatEnded) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageVideoChatEnded) GetConstructor() string {
+	return ConstructorMessageVideoChatEnded
+}
+
+func (*MessageVideoChatEnded) MessageContentConstructor() string {
+	return ConstructorMessageVideoChatEnded
+}
+
+func (entity *MessageVideoChatEnded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageVideoChatEnded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageVideoChatEnded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageVideoChatEnded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageVideoChatEnded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageVideoChatEnded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with information about an invitation to a video chat
+type MessageInviteVideoChatParticipants struct {
+	meta
+	// Identifier of the video chat. The video chat can be received through the method getGroupCall
+	GroupCallId int32 `json:"group_call_id"`
+	// Invited user identifiers
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (*MessageInviteVideoChatParticipants) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageInviteVideoChatParticipants) GetConstructor() string {
+	return ConstructorMessageInviteVideoChatParticipants
+}
+
+func (*MessageInviteVideoChatParticipants) MessageContentConstructor() string {
+	return ConstructorMessageInviteVideoChatParticipants
+}
+
+func (entity *MessageInviteVideoChatParticipants) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageInviteVideoChatParticipants) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageInviteVideoChatParticipants) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageInviteVideoChatParticipants"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageInviteVideoChatParticipants) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageInviteVideoChatParticipants)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A newly created basic group
+type MessageBasicGroupChatCreate struct {
+	meta
+	// Title of the basic group
+	Title string `json:"title"`
+	// User identifiers of members in the basic group
+	MemberUserIds []int64 `json:"member_user_ids"`
+}
+
+func (*MessageBasicGroupChatCreate) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageBasicGroupChatCreate) GetConstructor() string {
+	return ConstructorMessageBasicGroupChatCreate
+}
+
+func (*MessageBasicGroupChatCreate) MessageContentConstructor() string {
+	return ConstructorMessageBasicGroupChatCreate
+}
+
+func (entity *MessageBasicGroupChatCreate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageBasicGroupChatCreate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageBasicGroupChatCreate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageBasicGroupChatCreate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"member_user_ids":`)
+	writeList(encoder, entity.MemberUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageBasicGroupChatCreate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "member_user_ids":
+			entity.MemberUserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageBasicGroupChatCreate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A newly created supergroup or channel
+type MessageSupergroupChatCreate struct {
+	meta
+	// Title of the supergroup or channel
+	Title string `json:"title"`
+}
+
+func (*MessageSupergroupChatCreate) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageSupergroupChatCreate) GetConstructor() string {
+	return ConstructorMessageSupergroupChatCreate
+}
+
+func (*MessageSupergroupChatCreate) MessageContentConstructor() string {
+	return ConstructorMessageSupergroupChatCreate
+}
+
+func (entity *MessageSupergroupChatCreate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSupergroupChatCreate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSupergroupChatCreate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSupergroupChatCreate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSupergroupChatCreate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSupergroupChatCreate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An updated chat title
+type MessageChatChangeTitle struct {
+	meta
+	// New chat title
+	Title string `json:"title"`
+}
+
+func (*MessageChatChangeTitle) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatChangeTitle) GetConstructor() string {
+	return ConstructorMessageChatChangeTitle
+}
+
+func (*MessageChatChangeTitle) MessageContentConstructor() string {
+	return ConstructorMessageChatChangeTitle
+}
+
+func (entity *MessageChatChangeTitle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatChangeTitle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatChangeTitle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatChangeTitle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatChangeTitle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatChangeTitle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An updated chat photo
+type MessageChatChangePhoto struct {
+	meta
+	// New chat photo
+	Photo *ChatPhoto `json:"photo"`
+}
+
+func (*MessageChatChangePhoto) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatChangePhoto) GetConstructor() string {
+	return ConstructorMessageChatChangePhoto
+}
+
+func (*MessageChatChangePhoto) MessageContentConstructor() string {
+	return ConstructorMessageChatChangePhoto
+}
+
+func (entity *MessageChatChangePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatChangePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatChangePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatChangePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatChangePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatChangePhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A deleted chat photo
+type MessageChatDeletePhoto struct {
+	meta
+}
+
+func (*MessageChatDeletePhoto) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatDeletePhoto) GetConstructor() string {
+	return ConstructorMessageChatDeletePhoto
+}
+
+func (*MessageChatDeletePhoto) MessageContentConstructor() string {
+	return ConstructorMessageChatDeletePhoto
+}
+
+func (entity *MessageChatDeletePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatDeletePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatDeletePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatDeletePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatDeletePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageChatDeletePhoto)
+	})
+}
+
+// New chat members were added
+type MessageChatAddMembers struct {
+	meta
+	// User identifiers of the new members
+	MemberUserIds []int64 `json:"member_user_ids"`
+}
+
+func (*MessageChatAddMembers) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatAddMembers) GetConstructor() string {
+	return ConstructorMessageChatAddMembers
+}
+
+func (*MessageChatAddMembers) MessageContentConstructor() string {
+	return ConstructorMessageChatAddMembers
+}
+
+func (entity *MessageChatAddMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatAddMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatAddMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatAddMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"member_user_ids":`)
+	writeList(encoder, entity.MemberUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatAddMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "member_user_ids":
+			entity.MemberUserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatAddMembers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new member joined the chat via an invite link
+type MessageChatJoinByLink struct {
+	meta
+}
+
+func (*MessageChatJoinByLink) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatJoinByLink) GetConstructor() string {
+	return ConstructorMessageChatJoinByLink
+}
+
+func (*MessageChatJoinByLink) MessageContentConstructor() string {
+	return ConstructorMessageChatJoinByLink
+}
+
+func (entity *MessageChatJoinByLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatJoinByLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatJoinByLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatJoinByLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatJoinByLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageChatJoinByLink)
+	})
+}
+
+// A new member was accepted to the chat by an administrator
+type MessageChatJoinByRequest struct {
+	meta
+}
+
+func (*MessageChatJoinByRequest) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatJoinByRequest) GetConstructor() string {
+	return ConstructorMessageChatJoinByRequest
+}
+
+func (*MessageChatJoinByRequest) MessageContentConstructor() string {
+	return ConstructorMessageChatJoinByRequest
+}
+
+func (entity *MessageChatJoinByRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatJoinByRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatJoinByRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatJoinByRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatJoinByRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageChatJoinByRequest)
+	})
+}
+
+// A chat member was deleted
+type MessageChatDeleteMember struct {
+	meta
+	// User identifier of the deleted chat member
+	UserId int64 `json:"user_id"`
+}
+
+func (*MessageChatDeleteMember) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatDeleteMember) GetConstructor() string {
+	return ConstructorMessageChatDeleteMember
+}
+
+func (*MessageChatDeleteMember) MessageContentConstructor() string {
+	return ConstructorMessageChatDeleteMember
+}
+
+func (entity *MessageChatDeleteMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatDeleteMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatDeleteMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatDeleteMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatDeleteMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatDeleteMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A basic group was upgraded to a supergroup and was deactivated as the result
+type MessageChatUpgradeTo struct {
+	meta
+	// Identifier of the supergroup to which the basic group was upgraded
+	SupergroupId int64 `json:"supergroup_id"`
+}
+
+func (*MessageChatUpgradeTo) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatUpgradeTo) GetConstructor() string {
+	return ConstructorMessageChatUpgradeTo
+}
+
+func (*MessageChatUpgradeTo) MessageContentConstructor() string {
+	return ConstructorMessageChatUpgradeTo
+}
+
+func (entity *MessageChatUpgradeTo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatUpgradeTo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatUpgradeTo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatUpgradeTo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(entity.SupergroupId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatUpgradeTo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "supergroup_id":
+			entity.SupergroupId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatUpgradeTo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A supergroup has been created from a basic group
+type MessageChatUpgradeFrom struct {
+	meta
+	// Title of the newly created supergroup
+	Title string `json:"title"`
+	// The identifier of the original basic group
+	BasicGroupId int64 `json:"basic_group_id"`
+}
+
+func (*MessageChatUpgradeFrom) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatUpgradeFrom) GetConstructor() string {
+	return ConstructorMessageChatUpgradeFrom
+}
+
+func (*MessageChatUpgradeFrom) MessageContentConstructor() string {
+	return ConstructorMessageChatUpgradeFrom
+}
+
+func (entity *MessageChatUpgradeFrom) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatUpgradeFrom) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatUpgradeFrom) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatUpgradeFrom"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"basic_group_id":`)
+	encoder.writeInt64(entity.BasicGroupId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatUpgradeFrom) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "basic_group_id":
+			entity.BasicGroupId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatUpgradeFrom)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message has been pinned
+type MessagePinMessage struct {
+	meta
+	// Identifier of the pinned message, can be an identifier of a deleted message or 0
+	MessageId int64 `json:"message_id"`
+}
+
+func (*MessagePinMessage) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePinMessage) GetConstructor() string {
+	return ConstructorMessagePinMessage
+}
+
+func (*MessagePinMessage) MessageContentConstructor() string {
+	return ConstructorMessagePinMessage
+}
+
+func (entity *MessagePinMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePinMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePinMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePinMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePinMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePinMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A screenshot of a message in the chat has been taken
+type MessageScreenshotTaken struct {
+	meta
+}
+
+func (*MessageScreenshotTaken) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageScreenshotTaken) GetConstructor() string {
+	return ConstructorMessageScreenshotTaken
+}
+
+func (*MessageScreenshotTaken) MessageContentConstructor() string {
+	return ConstructorMessageScreenshotTaken
+}
+
+func (entity *MessageScreenshotTaken) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageScreenshotTaken) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageScreenshotTaken) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageScreenshotTaken"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageScreenshotTaken) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageScreenshotTaken)
+	})
+}
+
+// A new background was set in the chat
+type MessageChatSetBackground struct {
+	meta
+	// Identifier of the message with a previously set same background; 0 if none. Can be an identifier of a deleted message
+	OldBackgroundMessageId int64 `json:"old_background_message_id"`
+	// The new background
+	Background *ChatBackground `json:"background"`
+	// True, if the background was set only for self
+	OnlyForSelf bool `json:"only_for_self"`
+}
+
+func (*MessageChatSetBackground) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatSetBackground) GetConstructor() string {
+	return ConstructorMessageChatSetBackground
+}
+
+func (*MessageChatSetBackground) MessageContentConstructor() string {
+	return ConstructorMessageChatSetBackground
+}
+
+func (entity *MessageChatSetBackground) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatSetBackground) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatSetBackground) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatSetBackground"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"old_background_message_id":`)
+	encoder.writeInt64(entity.OldBackgroundMessageId)
+	encoder.writeRaw(`,"background":`)
+	entity.Background.encodeJSON(encoder)
+	encoder.writeRaw(`,"only_for_self":`)
+	encoder.writeBool(entity.OnlyForSelf)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatSetBackground) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "old_background_message_id":
+			entity.OldBackgroundMessageId, err = decoder.readInt64()
+
+		case "background":
+			entity.Background, err = decodeEntity[ChatBackground](decoder)
+
+		case "only_for_self":
+			entity.OnlyForSelf, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatSetBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A theme in the chat has been changed
+type MessageChatSetTheme struct {
+	meta
+	// If non-empty, name of a new theme, set for the chat. Otherwise, chat theme was reset to the default one
+	ThemeName string `json:"theme_name"`
+}
+
+func (*MessageChatSetTheme) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatSetTheme) GetConstructor() string {
+	return ConstructorMessageChatSetTheme
+}
+
+func (*MessageChatSetTheme) MessageContentConstructor() string {
+	return ConstructorMessageChatSetTheme
+}
+
+func (entity *MessageChatSetTheme) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatSetTheme) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatSetTheme) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatSetTheme"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"theme_name":`)
+	encoder.writeString(entity.ThemeName)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatSetTheme) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "theme_name":
+			entity.ThemeName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatSetTheme)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The auto-delete or self-destruct timer for messages in the chat has been changed
+type MessageChatSetMessageAutoDeleteTime struct {
+	meta
+	// New value auto-delete or self-destruct time, in seconds; 0 if disabled
+	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
+	// If not 0, a user identifier, which default setting was automatically applied
+	FromUserId int64 `json:"from_user_id"`
+}
+
+func (*MessageChatSetMessageAutoDeleteTime) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatSetMessageAutoDeleteTime) GetConstructor() string {
+	return ConstructorMessageChatSetMessageAutoDeleteTime
+}
+
+func (*MessageChatSetMessageAutoDeleteTime) MessageContentConstructor() string {
+	return ConstructorMessageChatSetMessageAutoDeleteTime
+}
+
+func (entity *MessageChatSetMessageAutoDeleteTime) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatSetMessageAutoDeleteTime) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatSetMessageAutoDeleteTime) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatSetMessageAutoDeleteTime"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_auto_delete_time":`)
+	encoder.writeInt32(entity.MessageAutoDeleteTime)
+	encoder.writeRaw(`,"from_user_id":`)
+	encoder.writeInt64(entity.FromUserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatSetMessageAutoDeleteTime) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_auto_delete_time":
+			entity.MessageAutoDeleteTime, err = decoder.readInt32()
+
+		case "from_user_id":
+			entity.FromUserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatSetMessageAutoDeleteTime)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat was boosted by the sender of the message
+type MessageChatBoost struct {
+	meta
+	// Number of times the chat was boosted
+	BoostCount int32 `json:"boost_count"`
+}
+
+func (*MessageChatBoost) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatBoost) GetConstructor() string {
+	return ConstructorMessageChatBoost
+}
+
+func (*MessageChatBoost) MessageContentConstructor() string {
+	return ConstructorMessageChatBoost
+}
+
+func (entity *MessageChatBoost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatBoost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatBoost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatBoost"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"boost_count":`)
+	encoder.writeInt32(entity.BoostCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatBoost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "boost_count":
+			entity.BoostCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatBoost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forum topic has been created
+type MessageForumTopicCreated struct {
+	meta
+	// Name of the topic
+	Name string `json:"name"`
+	// Icon of the topic
+	Icon *ForumTopicIcon `json:"icon"`
+}
+
+func (*MessageForumTopicCreated) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageForumTopicCreated) GetConstructor() string {
+	return ConstructorMessageForumTopicCreated
+}
+
+func (*MessageForumTopicCreated) MessageContentConstructor() string {
+	return ConstructorMessageForumTopicCreated
+}
+
+func (entity *MessageForumTopicCreated) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageForumTopicCreated) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageForumTopicCreated) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageForumTopicCreated"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"icon":`)
+	entity.Icon.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageForumTopicCreated) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "icon":
+			entity.Icon, err = decodeEntity[ForumTopicIcon](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageForumTopicCreated)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forum topic has been edited
+type MessageForumTopicEdited struct {
+	meta
+	// If non-empty, the new name of the topic
+	Name string `json:"name"`
+	// True, if icon's custom_emoji_id is changed
+	EditIconCustomEmojiId bool `json:"edit_icon_custom_emoji_id"`
+	// New unique identifier of the custom emoji shown on the topic icon; 0 if none. Must be ignored if edit_icon_custom_emoji_id is false
+	IconCustomEmojiId JsonInt64 `json:"icon_custom_emoji_id"`
+}
+
+func (*MessageForumTopicEdited) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageForumTopicEdited) GetConstructor() string {
+	return ConstructorMessageForumTopicEdited
+}
+
+func (*MessageForumTopicEdited) MessageContentConstructor() string {
+	return ConstructorMessageForumTopicEdited
+}
+
+func (entity *MessageForumTopicEdited) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageForumTopicEdited) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageForumTopicEdited) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageForumTopicEdited"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"edit_icon_custom_emoji_id":`)
+	encoder.writeBool(entity.EditIconCustomEmojiId)
+	encoder.writeRaw(`,"icon_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.IconCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageForumTopicEdited) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "edit_icon_custom_emoji_id":
+			entity.EditIconCustomEmojiId, err = decoder.readBool()
+
+		case "icon_custom_emoji_id":
+			entity.IconCustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageForumTopicEdited)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forum topic has been closed or opened
+type MessageForumTopicIsClosedToggled struct {
+	meta
+	// True, if the topic was closed; otherwise, the topic was reopened
+	IsClosed bool `json:"is_closed"`
+}
+
+func (*MessageForumTopicIsClosedToggled) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageForumTopicIsClosedToggled) GetConstructor() string {
+	return ConstructorMessageForumTopicIsClosedToggled
+}
+
+func (*MessageForumTopicIsClosedToggled) MessageContentConstructor() string {
+	return ConstructorMessageForumTopicIsClosedToggled
+}
+
+func (entity *MessageForumTopicIsClosedToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageForumTopicIsClosedToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageForumTopicIsClosedToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageForumTopicIsClosedToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_closed":`)
+	encoder.writeBool(entity.IsClosed)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageForumTopicIsClosedToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_closed":
+			entity.IsClosed, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageForumTopicIsClosedToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A General forum topic has been hidden or unhidden
+type MessageForumTopicIsHiddenToggled struct {
+	meta
+	// True, if the topic was hidden; otherwise, the topic was unhidden
+	IsHidden bool `json:"is_hidden"`
+}
+
+func (*MessageForumTopicIsHiddenToggled) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageForumTopicIsHiddenToggled) GetConstructor() string {
+	return ConstructorMessageForumTopicIsHiddenToggled
+}
+
+func (*MessageForumTopicIsHiddenToggled) MessageContentConstructor() string {
+	return ConstructorMessageForumTopicIsHiddenToggled
+}
+
+func (entity *MessageForumTopicIsHiddenToggled) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageForumTopicIsHiddenToggled) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageForumTopicIsHiddenToggled) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageForumTopicIsHiddenToggled"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_hidden":`)
+	encoder.writeBool(entity.IsHidden)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageForumTopicIsHiddenToggled) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_hidden":
+			entity.IsHidden, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageForumTopicIsHiddenToggled)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A profile photo was suggested to a user in a private chat
+type MessageSuggestProfilePhoto struct {
+	meta
+	// The suggested chat photo. Use the method setProfilePhoto with inputChatPhotoPrevious to apply the photo
+	Photo *ChatPhoto `json:"photo"`
+}
+
+func (*MessageSuggestProfilePhoto) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageSuggestProfilePhoto) GetConstructor() string {
+	return ConstructorMessageSuggestProfilePhoto
+}
+
+func (*MessageSuggestProfilePhoto) MessageContentConstructor() string {
+	return ConstructorMessageSuggestProfilePhoto
+}
+
+func (entity *MessageSuggestProfilePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSuggestProfilePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSuggestProfilePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSuggestProfilePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSuggestProfilePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSuggestProfilePhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A non-standard action has happened in the chat
+type MessageCustomServiceAction struct {
+	meta
+	// Message text to be shown in the chat
+	Text string `json:"text"`
+}
+
+func (*MessageCustomServiceAction) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageCustomServiceAction) GetConstructor() string {
+	return ConstructorMessageCustomServiceAction
+}
+
+func (*MessageCustomServiceAction) MessageContentConstructor() string {
+	return ConstructorMessageCustomServiceAction
+}
+
+func (entity *MessageCustomServiceAction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageCustomServiceAction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageCustomServiceAction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageCustomServiceAction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageCustomServiceAction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageCustomServiceAction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new high score was achieved in a game
+type MessageGameScore struct {
+	meta
+	// Identifier of the message with the game, can be an identifier of a deleted message
+	GameMessageId int64 `json:"game_message_id"`
+	// Identifier of the game; may be different from the games presented in the message with the game
+	GameId JsonInt64 `json:"game_id"`
+	// New score
+	Score int32 `json:"score"`
+}
+
+func (*MessageGameScore) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGameScore) GetConstructor() string {
+	return ConstructorMessageGameScore
+}
+
+func (*MessageGameScore) MessageContentConstructor() string {
+	return ConstructorMessageGameScore
+}
+
+func (entity *MessageGameScore) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGameScore) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGameScore) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGameScore"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"game_message_id":`)
+	encoder.writeInt64(entity.GameMessageId)
+	encoder.writeRaw(`,"game_id":`)
+	encoder.writeJsonInt64(entity.GameId)
+	encoder.writeRaw(`,"score":`)
+	encoder.writeInt32(entity.Score)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGameScore) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "game_message_id":
+			entity.GameMessageId, err = decoder.readInt64()
+
+		case "game_id":
+			entity.GameId, err = decoder.readJsonInt64()
+
+		case "score":
+			entity.Score, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGameScore)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A payment has been sent to a bot or a business account
+type MessagePaymentSuccessful struct {
+	meta
+	// Identifier of the chat, containing the corresponding invoice message
+	InvoiceChatId int64 `json:"invoice_chat_id"`
+	// Identifier of the message with the corresponding invoice; can be 0 or an identifier of a deleted message
+	InvoiceMessageId int64 `json:"invoice_message_id"`
+	// Currency for the price of the product
+	Currency string `json:"currency"`
+	// Total price for the product, in the smallest units of the currency
+	TotalAmount int64 `json:"total_amount"`
+	// Point in time (Unix timestamp) when the subscription will expire; 0 if unknown or the payment isn't recurring
+	SubscriptionUntilDate int32 `json:"subscription_until_date"`
+	// True, if this is a recurring payment
+	IsRecurring bool `json:"is_recurring"`
+	// True, if this is the first recurring payment
+	IsFirstRecurring bool `json:"is_first_recurring"`
+	// Name of the invoice; may be empty if unknown
+	InvoiceName string `json:"invoice_name"`
+}
+
+func (*MessagePaymentSuccessful) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePaymentSuccessful) GetConstructor() string {
+	return ConstructorMessagePaymentSuccessful
+}
+
+func (*MessagePaymentSuccessful) MessageContentConstructor() string {
+	return ConstructorMessagePaymentSuccessful
+}
+
+func (entity *MessagePaymentSuccessful) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePaymentSuccessful) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePaymentSuccessful) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePaymentSuccessful"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invoice_chat_id":`)
+	encoder.writeInt64(entity.InvoiceChatId)
+	encoder.writeRaw(`,"invoice_message_id":`)
+	encoder.writeInt64(entity.InvoiceMessageId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"total_amount":`)
+	encoder.writeInt64(entity.TotalAmount)
+	encoder.writeRaw(`,"subscription_until_date":`)
+	encoder.writeInt32(entity.SubscriptionUntilDate)
+	encoder.writeRaw(`,"is_recurring":`)
+	encoder.writeBool(entity.IsRecurring)
+	encoder.writeRaw(`,"is_first_recurring":`)
+	encoder.writeBool(entity.IsFirstRecurring)
+	encoder.writeRaw(`,"invoice_name":`)
+	encoder.writeString(entity.InvoiceName)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePaymentSuccessful) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invoice_chat_id":
+			entity.InvoiceChatId, err = decoder.readInt64()
+
+		case "invoice_message_id":
+			entity.InvoiceMessageId, err = decoder.readInt64()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "total_amount":
+			entity.TotalAmount, err = decoder.readInt64()
+
+		case "subscription_until_date":
+			entity.SubscriptionUntilDate, err = decoder.readInt32()
+
+		case "is_recurring":
+			entity.IsRecurring, err = decoder.readBool()
+
+		case "is_first_recurring":
+			entity.IsFirstRecurring, err = decoder.readBool()
+
+		case "invoice_name":
+			entity.InvoiceName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePaymentSuccessful)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A payment has been received by the bot or the business account
+type MessagePaymentSuccessfulBot struct {
+	meta
+	// Currency for price of the product
+	Currency string `json:"currency"`
+	// Total price for the product, in the smallest units of the currency
+	TotalAmount int64 `json:"total_amount"`
+	// Point in time (Unix timestamp) when the subscription will expire; 0 if unknown or the payment isn't recurring
+	SubscriptionUntilDate int32 `json:"subscription_until_date"`
+	// True, if this is a recurring payment
+	IsRecurring bool `json:"is_recurring"`
+	// True, if this is the first recurring payment
+	IsFirstRecurring bool `json:"is_first_recurring"`
+	// Invoice payload
+	InvoicePayload []byte `json:"invoice_payload"`
+	// Identifier of the shipping option chosen by the user; may be empty if not applicable; for bots only
+	ShippingOptionId string `json:"shipping_option_id"`
+	// Information about the order; may be null; for bots only
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+	// Telegram payment identifier
+	TelegramPaymentChargeId string `json:"telegram_payment_charge_id"`
+	// Provider payment identifier
+	ProviderPaymentChargeId string `json:"provider_payment_charge_id"`
+}
+
+func (*MessagePaymentSuccessfulBot) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePaymentSuccessfulBot) GetConstructor() string {
+	return ConstructorMessagePaymentSuccessfulBot
+}
+
+func (*MessagePaymentSuccessfulBot) MessageContentConstructor() string {
+	return ConstructorMessagePaymentSuccessfulBot
+}
+
+func (entity *MessagePaymentSuccessfulBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePaymentSuccessfulBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePaymentSuccessfulBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePaymentSuccessfulBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"total_amount":`)
+	encoder.writeInt64(entity.TotalAmount)
+	encoder.writeRaw(`,"subscription_until_date":`)
+	encoder.writeInt32(entity.SubscriptionUntilDate)
+	encoder.writeRaw(`,"is_recurring":`)
+	encoder.writeBool(entity.IsRecurring)
+	encoder.writeRaw(`,"is_first_recurring":`)
+	encoder.writeBool(entity.IsFirstRecurring)
+	encoder.writeRaw(`,"invoice_payload":`)
+	encoder.writeBytes(entity.InvoicePayload)
+	encoder.writeRaw(`,"shipping_option_id":`)
+	encoder.writeString(entity.ShippingOptionId)
+	if entity.OrderInfo != nil {
+		encoder.writeRaw(`,"order_info":`)
+		entity.OrderInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"telegram_payment_charge_id":`)
+	encoder.writeString(entity.TelegramPaymentChargeId)
+	encoder.writeRaw(`,"provider_payment_charge_id":`)
+	encoder.writeString(entity.ProviderPaymentChargeId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePaymentSuccessfulBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "total_amount":
+			entity.TotalAmount, err = decoder.readInt64()
+
+		case "subscription_until_date":
+			entity.SubscriptionUntilDate, err = decoder.readInt32()
+
+		case "is_recurring":
+			entity.IsRecurring, err = decoder.readBool()
+
+		case "is_first_recurring":
+			entity.IsFirstRecurring, err = decoder.readBool()
+
+		case "invoice_payload":
+			entity.InvoicePayload, err = decoder.readBytes()
+
+		case "shipping_option_id":
+			entity.ShippingOptionId, err = decoder.readString()
+
+		case "order_info":
+			entity.OrderInfo, err = decodeEntity[OrderInfo](decoder)
+
+		case "telegram_payment_charge_id":
+			entity.TelegramPaymentChargeId, err = decoder.readString()
+
+		case "provider_payment_charge_id":
+			entity.ProviderPaymentChargeId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePaymentSuccessfulBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A payment has been refunded
+type MessagePaymentRefunded struct {
+	meta
+	// Identifier of the previous owner of the Telegram Stars that refunds them
+	OwnerId MessageSender `json:"owner_id"`
+	// Currency for the price of the product
+	Currency string `json:"currency"`
+	// Total price for the product, in the smallest units of the currency
+	TotalAmount int64 `json:"total_amount"`
+	// Invoice payload; only for bots
+	InvoicePayload []byte `json:"invoice_payload"`
+	// Telegram payment identifier
+	TelegramPaymentChargeId string `json:"telegram_payment_charge_id"`
+	// Provider payment identifier
+	ProviderPaymentChargeId string `json:"provider_payment_charge_id"`
+}
+
+func (*MessagePaymentRefunded) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePaymentRefunded) GetConstructor() string {
+	return ConstructorMessagePaymentRefunded
+}
+
+func (*MessagePaymentRefunded) MessageContentConstructor() string {
+	return ConstructorMessagePaymentRefunded
+}
+
+func (entity *MessagePaymentRefunded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePaymentRefunded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePaymentRefunded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePaymentRefunded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(entity.OwnerId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"total_amount":`)
+	encoder.writeInt64(entity.TotalAmount)
+	encoder.writeRaw(`,"invoice_payload":`)
+	encoder.writeBytes(entity.InvoicePayload)
+	encoder.writeRaw(`,"telegram_payment_charge_id":`)
+	encoder.writeString(entity.TelegramPaymentChargeId)
+	encoder.writeRaw(`,"provider_payment_charge_id":`)
+	encoder.writeString(entity.ProviderPaymentChargeId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePaymentRefunded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "owner_id":
+			entity.OwnerId, err = decodeMessageSender(decoder)
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "total_amount":
+			entity.TotalAmount, err = decoder.readInt64()
+
+		case "invoice_payload":
+			entity.InvoicePayload, err = decoder.readBytes()
+
+		case "telegram_payment_charge_id":
+			entity.TelegramPaymentChargeId, err = decoder.readString()
+
+		case "provider_payment_charge_id":
+			entity.ProviderPaymentChargeId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePaymentRefunded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Telegram Premium was gifted to a user
+type MessageGiftedPremium struct {
+	meta
+	// The identifier of a user that gifted Telegram Premium; 0 if the gift was anonymous or is outgoing
+	GifterUserId int64 `json:"gifter_user_id"`
+	// The identifier of a user that received Telegram Premium; 0 if the gift is incoming
+	ReceiverUserId int64 `json:"receiver_user_id"`
+	// Message added to the gifted Telegram Premium by the sender
+	Text *FormattedText `json:"text"`
+	// Currency for the paid amount
+	Currency string `json:"currency"`
+	// The paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Cryptocurrency used to pay for the gift; may be empty if none
+	Cryptocurrency string `json:"cryptocurrency"`
+	// The paid amount, in the smallest units of the cryptocurrency; 0 if none
+	CryptocurrencyAmount JsonInt64 `json:"cryptocurrency_amount"`
+	// Number of months the Telegram Premium subscription will be active
+	MonthCount int32 `json:"month_count"`
+	// A sticker to be shown in the message; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*MessageGiftedPremium) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGiftedPremium) GetConstructor() string {
+	return ConstructorMessageGiftedPremium
+}
+
+func (*MessageGiftedPremium) MessageContentConstructor() string {
+	return ConstructorMessageGiftedPremium
+}
+
+func (entity *MessageGiftedPremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGiftedPremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGiftedPremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGiftedPremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gifter_user_id":`)
+	encoder.writeInt64(entity.GifterUserId)
+	encoder.writeRaw(`,"receiver_user_id":`)
+	encoder.writeInt64(entity.ReceiverUserId)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"cryptocurrency":`)
+	encoder.writeString(entity.Cryptocurrency)
+	encoder.writeRaw(`,"cryptocurrency_amount":`)
+	encoder.writeJsonInt64(entity.CryptocurrencyAmount)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGiftedPremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gifter_user_id":
+			entity.GifterUserId, err = decoder.readInt64()
+
+		case "receiver_user_id":
+			entity.ReceiverUserId, err = decoder.readInt64()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "cryptocurrency":
+			entity.Cryptocurrency, err = decoder.readString()
+
+		case "cryptocurrency_amount":
+			entity.CryptocurrencyAmount, err = decoder.readJsonInt64()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGiftedPremium)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Premium gift code was created for the user
+type MessagePremiumGiftCode struct {
+	meta
+	// Identifier of a chat or a user that created the gift code; may be null if unknown
+	CreatorId MessageSender `json:"creator_id,omitempty"`
+	// Message added to the gift
+	Text *FormattedText `json:"text"`
+	// True, if the gift code was created for a giveaway
+	IsFromGiveaway bool `json:"is_from_giveaway"`
+	// True, if the winner for the corresponding Telegram Premium subscription wasn't chosen
+	IsUnclaimed bool `json:"is_unclaimed"`
+	// Currency for the paid amount; empty if unknown
+	Currency string `json:"currency"`
+	// The paid amount, in the smallest units of the currency; 0 if unknown
+	Amount int64 `json:"amount"`
+	// Cryptocurrency used to pay for the gift; may be empty if none or unknown
+	Cryptocurrency string `json:"cryptocurrency"`
+	// The paid amount, in the smallest units of the cryptocurrency; 0 if unknown
+	CryptocurrencyAmount JsonInt64 `json:"cryptocurrency_amount"`
+	// Number of months the Telegram Premium subscription will be active after code activation
+	MonthCount int32 `json:"month_count"`
+	// A sticker to be shown in the message; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+	// The gift code
+	Code string `json:"code"`
+}
+
+func (*MessagePremiumGiftCode) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePremiumGiftCode) GetConstructor() string {
+	return ConstructorMessagePremiumGiftCode
+}
+
+func (*MessagePremiumGiftCode) MessageContentConstructor() string {
+	return ConstructorMessagePremiumGiftCode
+}
+
+func (entity *MessagePremiumGiftCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePremiumGiftCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePremiumGiftCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePremiumGiftCode"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.CreatorId != nil {
+		encoder.writeRaw(`,"creator_id":`)
+		encoder.writeType(entity.CreatorId)
+	}
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_from_giveaway":`)
+	encoder.writeBool(entity.IsFromGiveaway)
+	encoder.writeRaw(`,"is_unclaimed":`)
+	encoder.writeBool(entity.IsUnclaimed)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"cryptocurrency":`)
+	encoder.writeString(entity.Cryptocurrency)
+	encoder.writeRaw(`,"cryptocurrency_amount":`)
+	encoder.writeJsonInt64(entity.CryptocurrencyAmount)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"code":`)
+	encoder.writeString(entity.Code)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePremiumGiftCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "creator_id":
+			entity.CreatorId, err = decodeMessageSender(decoder)
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "is_from_giveaway":
+			entity.IsFromGiveaway, err = decoder.readBool()
+
+		case "is_unclaimed":
+			entity.IsUnclaimed, err = decoder.readBool()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "cryptocurrency":
+			entity.Cryptocurrency, err = decoder.readString()
+
+		case "cryptocurrency_amount":
+			entity.CryptocurrencyAmount, err = decoder.readJsonInt64()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		case "code":
+			entity.Code, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePremiumGiftCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A giveaway was created for the chat. Use telegramPaymentPurposePremiumGiveaway, storePaymentPurposePremiumGiveaway, telegramPaymentPurposeStarGiveaway, or storePaymentPurposeStarGiveaway to create a giveaway
+type MessageGiveawayCreated struct {
+	meta
+	// Number of Telegram Stars that will be shared by winners of the giveaway; 0 for Telegram Premium giveaways
+	StarCount int64 `json:"star_count"`
+}
+
+func (*MessageGiveawayCreated) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGiveawayCreated) GetConstructor() string {
+	return ConstructorMessageGiveawayCreated
+}
+
+func (*MessageGiveawayCreated) MessageContentConstructor() string {
+	return ConstructorMessageGiveawayCreated
+}
+
+func (entity *MessageGiveawayCreated) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGiveawayCreated) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGiveawayCreated) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGiveawayCreated"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGiveawayCreated) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGiveawayCreated)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A giveaway
+type MessageGiveaway struct {
+	meta
+	// Giveaway parameters
+	Parameters *GiveawayParameters `json:"parameters"`
+	// Number of users which will receive Telegram Premium subscription gift codes
+	WinnerCount int32 `json:"winner_count"`
+	// Prize of the giveaway
+	Prize GiveawayPrize `json:"prize"`
+	// A sticker to be shown in the message; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*MessageGiveaway) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGiveaway) GetConstructor() string {
+	return ConstructorMessageGiveaway
+}
+
+func (*MessageGiveaway) MessageContentConstructor() string {
+	return ConstructorMessageGiveaway
+}
+
+func (entity *MessageGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"parameters":`)
+	entity.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"prize":`)
+	encoder.writeType(entity.Prize)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "parameters":
+			entity.Parameters, err = decodeEntity[GiveawayParameters](decoder)
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "prize":
+			entity.Prize, err = decodeGiveawayPrize(decoder)
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageGiveaway) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("parameters", entity.Parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A giveaway without public winners has been completed for the chat
+type MessageGiveawayCompleted struct {
+	meta
+	// Identifier of the message with the giveaway; can be 0 if the message was deleted
+	GiveawayMessageId int64 `json:"giveaway_message_id"`
+	// Number of winners in the giveaway
+	WinnerCount int32 `json:"winner_count"`
+	// True, if the giveaway is a Telegram Star giveaway
+	IsStarGiveaway bool `json:"is_star_giveaway"`
+	// Number of undistributed prizes; for Telegram Premium giveaways only
+	UnclaimedPrizeCount int32 `json:"unclaimed_prize_count"`
+}
+
+func (*MessageGiveawayCompleted) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGiveawayCompleted) GetConstructor() string {
+	return ConstructorMessageGiveawayCompleted
+}
+
+func (*MessageGiveawayCompleted) MessageContentConstructor() string {
+	return ConstructorMessageGiveawayCompleted
+}
+
+func (entity *MessageGiveawayCompleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGiveawayCompleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGiveawayCompleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGiveawayCompleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"giveaway_message_id":`)
+	encoder.writeInt64(entity.GiveawayMessageId)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"is_star_giveaway":`)
+	encoder.writeBool(entity.IsStarGiveaway)
+	encoder.writeRaw(`,"unclaimed_prize_count":`)
+	encoder.writeInt32(entity.UnclaimedPrizeCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGiveawayCompleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "giveaway_message_id":
+			entity.GiveawayMessageId, err = decoder.readInt64()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "is_star_giveaway":
+			entity.IsStarGiveaway, err = decoder.readBool()
+
+		case "unclaimed_prize_count":
+			entity.UnclaimedPrizeCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGiveawayCompleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A giveaway with public winners has been completed for the chat
+type MessageGiveawayWinners struct {
+	meta
+	// Identifier of the supergroup or channel chat, which was automatically boosted by the winners of the giveaway
+	BoostedChatId int64 `json:"boosted_chat_id"`
+	// Identifier of the message with the giveaway in the boosted chat
+	GiveawayMessageId int64 `json:"giveaway_message_id"`
+	// Number of other chats that participated in the giveaway
+	AdditionalChatCount int32 `json:"additional_chat_count"`
+	// Point in time (Unix timestamp) when the winners were selected. May be bigger than winners selection date specified in parameters of the giveaway
+	ActualWinnersSelectionDate int32 `json:"actual_winners_selection_date"`
+	// True, if only new members of the chats were eligible for the giveaway
+	OnlyNewMembers bool `json:"only_new_members"`
+	// True, if the giveaway was canceled and was fully refunded
+	WasRefunded bool `json:"was_refunded"`
+	// Prize of the giveaway
+	Prize GiveawayPrize `json:"prize"`
+	// Additional description of the giveaway prize
+	PrizeDescription string `json:"prize_description"`
+	// Total number of winners in the giveaway
+	WinnerCount int32 `json:"winner_count"`
+	// Up to 100 user identifiers of the winners of the giveaway
+	WinnerUserIds []int64 `json:"winner_user_ids"`
+	// Number of undistributed prizes; for Telegram Premium giveaways only
+	UnclaimedPrizeCount int32 `json:"unclaimed_prize_count"`
+}
+
+func (*MessageGiveawayWinners) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGiveawayWinners) GetConstructor() string {
+	return ConstructorMessageGiveawayWinners
+}
+
+func (*MessageGiveawayWinners) MessageContentConstructor() string {
+	return ConstructorMessageGiveawayWinners
+}
+
+func (entity *MessageGiveawayWinners) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGiveawayWinners) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGiveawayWinners) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGiveawayWinners"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"boosted_chat_id":`)
+	encoder.writeInt64(entity.BoostedChatId)
+	encoder.writeRaw(`,"giveaway_message_id":`)
+	encoder.writeInt64(entity.GiveawayMessageId)
+	encoder.writeRaw(`,"additional_chat_count":`)
+	encoder.writeInt32(entity.AdditionalChatCount)
+	encoder.writeRaw(`,"actual_winners_selection_date":`)
+	encoder.writeInt32(entity.ActualWinnersSelectionDate)
+	encoder.writeRaw(`,"only_new_members":`)
+	encoder.writeBool(entity.OnlyNewMembers)
+	encoder.writeRaw(`,"was_refunded":`)
+	encoder.writeBool(entity.WasRefunded)
+	encoder.writeRaw(`,"prize":`)
+	encoder.writeType(entity.Prize)
+	encoder.writeRaw(`,"prize_description":`)
+	encoder.writeString(entity.PrizeDescription)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"winner_user_ids":`)
+	writeList(encoder, entity.WinnerUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"unclaimed_prize_count":`)
+	encoder.writeInt32(entity.UnclaimedPrizeCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGiveawayWinners) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "boosted_chat_id":
+			entity.BoostedChatId, err = decoder.readInt64()
+
+		case "giveaway_message_id":
+			entity.GiveawayMessageId, err = decoder.readInt64()
+
+		case "additional_chat_count":
+			entity.AdditionalChatCount, err = decoder.readInt32()
+
+		case "actual_winners_selection_date":
+			entity.ActualWinnersSelectionDate, err = decoder.readInt32()
+
+		case "only_new_members":
+			entity.OnlyNewMembers, err = decoder.readBool()
+
+		case "was_refunded":
+			entity.WasRefunded, err = decoder.readBool()
+
+		case "prize":
+			entity.Prize, err = decodeGiveawayPrize(decoder)
+
+		case "prize_description":
+			entity.PrizeDescription, err = decoder.readString()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "winner_user_ids":
+			entity.WinnerUserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "unclaimed_prize_count":
+			entity.UnclaimedPrizeCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGiveawayWinners)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Telegram Stars were gifted to a user
+type MessageGiftedStars struct {
+	meta
+	// The identifier of a user that gifted Telegram Stars; 0 if the gift was anonymous or is outgoing
+	GifterUserId int64 `json:"gifter_user_id"`
+	// The identifier of a user that received Telegram Stars; 0 if the gift is incoming
+	ReceiverUserId int64 `json:"receiver_user_id"`
+	// Currency for the paid amount
+	Currency string `json:"currency"`
+	// The paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Cryptocurrency used to pay for the gift; may be empty if none
+	Cryptocurrency string `json:"cryptocurrency"`
+	// The paid amount, in the smallest units of the cryptocurrency; 0 if none
+	CryptocurrencyAmount JsonInt64 `json:"cryptocurrency_amount"`
+	// Number of Telegram Stars that were gifted
+	StarCount int64 `json:"star_count"`
+	// Identifier of the transaction for Telegram Stars purchase; for receiver only
+	TransactionId string `json:"transaction_id"`
+	// A sticker to be shown in the message; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*MessageGiftedStars) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGiftedStars) GetConstructor() string {
+	return ConstructorMessageGiftedStars
+}
+
+func (*MessageGiftedStars) MessageContentConstructor() string {
+	return ConstructorMessageGiftedStars
+}
+
+func (entity *MessageGiftedStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGiftedStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGiftedStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGiftedStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gifter_user_id":`)
+	encoder.writeInt64(entity.GifterUserId)
+	encoder.writeRaw(`,"receiver_user_id":`)
+	encoder.writeInt64(entity.ReceiverUserId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"cryptocurrency":`)
+	encoder.writeString(entity.Cryptocurrency)
+	encoder.writeRaw(`,"cryptocurrency_amount":`)
+	encoder.writeJsonInt64(entity.CryptocurrencyAmount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"transaction_id":`)
+	encoder.writeString(entity.TransactionId)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGiftedStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gifter_user_id":
+			entity.GifterUserId, err = decoder.readInt64()
+
+		case "receiver_user_id":
+			entity.ReceiverUserId, err = decoder.readInt64()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "cryptocurrency":
+			entity.Cryptocurrency, err = decoder.readString()
+
+		case "cryptocurrency_amount":
+			entity.CryptocurrencyAmount, err = decoder.readJsonInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "transaction_id":
+			entity.TransactionId, err = decoder.readString()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGiftedStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A Telegram Stars were received by the current user from a giveaway
+type MessageGiveawayPrizeStars struct {
+	meta
+	// Number of Telegram Stars that were received
+	StarCount int64 `json:"star_count"`
+	// Identifier of the transaction for Telegram Stars credit
+	TransactionId string `json:"transaction_id"`
+	// Identifier of the supergroup or channel chat, which was automatically boosted by the winners of the giveaway
+	BoostedChatId int64 `json:"boosted_chat_id"`
+	// Identifier of the message with the giveaway in the boosted chat; can be 0 if the message was deleted
+	GiveawayMessageId int64 `json:"giveaway_message_id"`
+	// True, if the corresponding winner wasn't chosen and the Telegram Stars were received by the owner of the boosted chat
+	IsUnclaimed bool `json:"is_unclaimed"`
+	// A sticker to be shown in the message; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*MessageGiveawayPrizeStars) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGiveawayPrizeStars) GetConstructor() string {
+	return ConstructorMessageGiveawayPrizeStars
+}
+
+func (*MessageGiveawayPrizeStars) MessageContentConstructor() string {
+	return ConstructorMessageGiveawayPrizeStars
+}
+
+func (entity *MessageGiveawayPrizeStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGiveawayPrizeStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGiveawayPrizeStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGiveawayPrizeStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"transaction_id":`)
+	encoder.writeString(entity.TransactionId)
+	encoder.writeRaw(`,"boosted_chat_id":`)
+	encoder.writeInt64(entity.BoostedChatId)
+	encoder.writeRaw(`,"giveaway_message_id":`)
+	encoder.writeInt64(entity.GiveawayMessageId)
+	encoder.writeRaw(`,"is_unclaimed":`)
+	encoder.writeBool(entity.IsUnclaimed)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGiveawayPrizeStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "transaction_id":
+			entity.TransactionId, err = decoder.readString()
+
+		case "boosted_chat_id":
+			entity.BoostedChatId, err = decoder.readInt64()
+
+		case "giveaway_message_id":
+			entity.GiveawayMessageId, err = decoder.readInt64()
+
+		case "is_unclaimed":
+			entity.IsUnclaimed, err = decoder.readBool()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGiveawayPrizeStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A regular gift was received or sent by the current user, or the current user was notified about a channel gift
+type MessageGift struct {
+	meta
+	// The gift
+	Gift *Gift `json:"gift"`
+	// Sender of the gift
+	SenderId MessageSender `json:"sender_id"`
+	// Unique identifier of the received gift for the current user; only for the receiver of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+	// Message added to the gift
+	Text *FormattedText `json:"text"`
+	// Number of Telegram Stars that can be claimed by the receiver instead of the regular gift; 0 if the gift can't be sold by the receiver
+	SellStarCount int64 `json:"sell_star_count"`
+	// Number of Telegram Stars that were paid by the sender for the ability to upgrade the gift
+	PrepaidUpgradeStarCount int64 `json:"prepaid_upgrade_star_count"`
+	// True, if the sender and gift text are shown only to the gift receiver; otherwise, everyone will be able to see them
+	IsPrivate bool `json:"is_private"`
+	// True, if the gift is displayed on the user's or the channel's profile page; only for the receiver of the gift
+	IsSaved bool `json:"is_saved"`
+	// True, if the gift can be upgraded to a unique gift; only for the receiver of the gift
+	CanBeUpgraded bool `json:"can_be_upgraded"`
+	// True, if the gift was converted to Telegram Stars; only for the receiver of the gift
+	WasConverted bool `json:"was_converted"`
+	// True, if the gift was upgraded to a unique gift
+	WasUpgraded bool `json:"was_upgraded"`
+	// True, if the gift was refunded and isn't available anymore
+	WasRefunded bool `json:"was_refunded"`
+	// Identifier of the corresponding upgraded gift; may be empty if unknown. Use getReceivedGift to get information about the gift
+	UpgradedReceivedGiftId string `json:"upgraded_received_gift_id"`
+}
+
+func (*MessageGift) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageGift) GetConstructor() string {
+	return ConstructorMessageGift
+}
+
+func (*MessageGift) MessageContentConstructor() string {
+	return ConstructorMessageGift
+}
+
+func (entity *MessageGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(entity.ReceivedGiftId)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"sell_star_count":`)
+	encoder.writeInt64(entity.SellStarCount)
+	encoder.writeRaw(`,"prepaid_upgrade_star_count":`)
+	encoder.writeInt64(entity.PrepaidUpgradeStarCount)
+	encoder.writeRaw(`,"is_private":`)
+	encoder.writeBool(entity.IsPrivate)
+	encoder.writeRaw(`,"is_saved":`)
+	encoder.writeBool(entity.IsSaved)
+	encoder.writeRaw(`,"can_be_upgraded":`)
+	encoder.writeBool(entity.CanBeUpgraded)
+	encoder.writeRaw(`,"was_converted":`)
+	encoder.writeBool(entity.WasConverted)
+	encoder.writeRaw(`,"was_upgraded":`)
+	encoder.writeBool(entity.WasUpgraded)
+	encoder.writeRaw(`,"was_refunded":`)
+	encoder.writeBool(entity.WasRefunded)
+	encoder.writeRaw(`,"upgraded_received_gift_id":`)
+	encoder.writeString(entity.UpgradedReceivedGiftId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift":
+			entity.Gift, err = decodeEntity[Gift](decoder)
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "received_gift_id":
+			entity.ReceivedGiftId, err = decoder.readString()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "sell_star_count":
+			entity.SellStarCount, err = decoder.readInt64()
+
+		case "prepaid_upgrade_star_count":
+			entity.PrepaidUpgradeStarCount, err = decoder.readInt64()
+
+		case "is_private":
+			entity.IsPrivate, err = decoder.readBool()
+
+		case "is_saved":
+			entity.IsSaved, err = decoder.readBool()
+
+		case "can_be_upgraded":
+			entity.CanBeUpgraded, err = decoder.readBool()
+
+		case "was_converted":
+			entity.WasConverted, err = decoder.readBool()
+
+		case "was_upgraded":
+			entity.WasUpgraded, err = decoder.readBool()
+
+		case "was_refunded":
+			entity.WasRefunded, err = decoder.readBool()
+
+		case "upgraded_received_gift_id":
+			entity.UpgradedReceivedGiftId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An upgraded gift was received or sent by the current user, or the current user was notified about a channel gift
+type MessageUpgradedGift struct {
+	meta
+	// The gift
+	Gift *UpgradedGift `json:"gift"`
+	// Sender of the gift; may be null for anonymous gifts
+	SenderId MessageSender `json:"sender_id,omitempty"`
+	// Unique identifier of the received gift for the current user; only for the receiver of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+	// True, if the gift was obtained by upgrading of a previously received gift; otherwise, this is a transferred gift
+	IsUpgrade bool `json:"is_upgrade"`
+	// True, if the gift is displayed on the user's or the channel's profile page; only for the receiver of the gift
+	IsSaved bool `json:"is_saved"`
+	// True, if the gift can be transferred to another owner; only for the receiver of the gift
+	CanBeTransferred bool `json:"can_be_transferred"`
+	// True, if the gift was transferred to another owner; only for the receiver of the gift
+	WasTransferred bool `json:"was_transferred"`
+	// Number of Telegram Stars that must be paid to transfer the upgraded gift; only for the receiver of the gift
+	TransferStarCount int64 `json:"transfer_star_count"`
+	// Point in time (Unix timestamp) when the gift can be transferred to the TON blockchain as an NFT; 0 if NFT export isn't possible; only for the receiver of the gift
+	ExportDate int32 `json:"export_date"`
+}
+
+func (*MessageUpgradedGift) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageUpgradedGift) GetConstructor() string {
+	return ConstructorMessageUpgradedGift
+}
+
+func (*MessageUpgradedGift) MessageContentConstructor() string {
+	return ConstructorMessageUpgradedGift
+}
+
+func (entity *MessageUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	if entity.SenderId != nil {
+		encoder.writeRaw(`,"sender_id":`)
+		encoder.writeType(entity.SenderId)
+	}
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(entity.ReceivedGiftId)
+	encoder.writeRaw(`,"is_upgrade":`)
+	encoder.writeBool(entity.IsUpgrade)
+	encoder.writeRaw(`,"is_saved":`)
+	encoder.writeBool(entity.IsSaved)
+	encoder.writeRaw(`,"can_be_transferred":`)
+	encoder.writeBool(entity.CanBeTransferred)
+	encoder.writeRaw(`,"was_transferred":`)
+	encoder.writeBool(entity.WasTransferred)
+	encoder.writeRaw(`,"transfer_star_count":`)
+	encoder.writeInt64(entity.TransferStarCount)
+	encoder.writeRaw(`,"export_date":`)
+	encoder.writeInt32(entity.ExportDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift":
+			entity.Gift, err = decodeEntity[UpgradedGift](decoder)
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "received_gift_id":
+			entity.ReceivedGiftId, err = decoder.readString()
+
+		case "is_upgrade":
+			entity.IsUpgrade, err = decoder.readBool()
+
+		case "is_saved":
+			entity.IsSaved, err = decoder.readBool()
+
+		case "can_be_transferred":
+			entity.CanBeTransferred, err = decoder.readBool()
+
+		case "was_transferred":
+			entity.WasTransferred, err = decoder.readBool()
+
+		case "transfer_star_count":
+			entity.TransferStarCount, err = decoder.readInt64()
+
+		case "export_date":
+			entity.ExportDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A gift which purchase, upgrade or transfer were refunded
+type MessageRefundedUpgradedGift struct {
+	meta
+	// The gift
+	Gift *Gift `json:"gift"`
+	// Sender of the gift
+	SenderId MessageSender `json:"sender_id"`
+	// True, if the gift was obtained by upgrading of a previously received gift
+	IsUpgrade bool `json:"is_upgrade"`
+}
+
+func (*MessageRefundedUpgradedGift) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageRefundedUpgradedGift) GetConstructor() string {
+	return ConstructorMessageRefundedUpgradedGift
+}
+
+func (*MessageRefundedUpgradedGift) MessageContentConstructor() string {
+	return ConstructorMessageRefundedUpgradedGift
+}
+
+func (entity *MessageRefundedUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageRefundedUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageRefundedUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageRefundedUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"is_upgrade":`)
+	encoder.writeBool(entity.IsUpgrade)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageRefundedUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift":
+			entity.Gift, err = decodeEntity[Gift](decoder)
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "is_upgrade":
+			entity.IsUpgrade, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageRefundedUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Paid messages were refunded
+type MessagePaidMessagesRefunded struct {
+	meta
+	// The number of refunded messages
+	MessageCount int32 `json:"message_count"`
+	// The number of refunded Telegram Stars
+	StarCount int64 `json:"star_count"`
+}
+
+func (*MessagePaidMessagesRefunded) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePaidMessagesRefunded) GetConstructor() string {
+	return ConstructorMessagePaidMessagesRefunded
+}
+
+func (*MessagePaidMessagesRefunded) MessageContentConstructor() string {
+	return ConstructorMessagePaidMessagesRefunded
+}
+
+func (entity *MessagePaidMessagesRefunded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePaidMessagesRefunded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePaidMessagesRefunded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePaidMessagesRefunded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_count":`)
+	encoder.writeInt32(entity.MessageCount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePaidMessagesRefunded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_count":
+			entity.MessageCount, err = decoder.readInt32()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePaidMessagesRefunded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A price for paid messages was changed in the supergroup chat
+type MessagePaidMessagePriceChanged struct {
+	meta
+	// The new number of Telegram Stars that must be paid by non-administrator users of the supergroup chat for each sent message
+	PaidMessageStarCount int64 `json:"paid_message_star_count"`
+}
+
+func (*MessagePaidMessagePriceChanged) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePaidMessagePriceChanged) GetConstructor() string {
+	return ConstructorMessagePaidMessagePriceChanged
+}
+
+func (*MessagePaidMessagePriceChanged) MessageContentConstructor() string {
+	return ConstructorMessagePaidMessagePriceChanged
+}
+
+func (entity *MessagePaidMessagePriceChanged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePaidMessagePriceChanged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePaidMessagePriceChanged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePaidMessagePriceChanged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"paid_message_star_count":`)
+	encoder.writeInt64(entity.PaidMessageStarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePaidMessagePriceChanged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "paid_message_star_count":
+			entity.PaidMessageStarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePaidMessagePriceChanged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A contact has registered with Telegram
+type MessageContactRegistered struct {
+	meta
+}
+
+func (*MessageContactRegistered) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageContactRegistered) GetConstructor() string {
+	return ConstructorMessageContactRegistered
+}
+
+func (*MessageContactRegistered) MessageContentConstructor() string {
+	return ConstructorMessageContactRegistered
+}
+
+func (entity *MessageContactRegistered) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageContactRegistered) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageContactRegistered) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageContactRegistered"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageContactRegistered) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageContactRegistered)
+	})
+}
+
+// The current user shared users, which were requested by the bot
+type MessageUsersShared struct {
+	meta
+	// The shared users
+	Users []*SharedUser `json:"users"`
+	// Identifier of the keyboard button with the request
+	ButtonId int32 `json:"button_id"`
+}
+
+func (*MessageUsersShared) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageUsersShared) GetConstructor() string {
+	return ConstructorMessageUsersShared
+}
+
+func (*MessageUsersShared) MessageContentConstructor() string {
+	return ConstructorMessageUsersShared
+}
+
+func (entity *MessageUsersShared) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageUsersShared) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageUsersShared) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageUsersShared"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"users":`)
+	writeList(encoder, entity.Users, writeTypeValue[*SharedUser])
+	encoder.writeRaw(`,"button_id":`)
+	encoder.writeInt32(entity.ButtonId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageUsersShared) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "users":
+			entity.Users, err = readList(decoder, decodeEntity[SharedUser])
+
+		case "button_id":
+			entity.ButtonId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageUsersShared)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The current user shared a chat, which was requested by the bot
+type MessageChatShared struct {
+	meta
+	// The shared chat
+	Chat *SharedChat `json:"chat"`
+	// Identifier of the keyboard button with the request
+	ButtonId int32 `json:"button_id"`
+}
+
+func (*MessageChatShared) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageChatShared) GetConstructor() string {
+	return ConstructorMessageChatShared
+}
+
+func (*MessageChatShared) MessageContentConstructor() string {
+	return ConstructorMessageChatShared
+}
+
+func (entity *MessageChatShared) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageChatShared) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageChatShared) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageChatShared"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat":`)
+	entity.Chat.encodeJSON(encoder)
+	encoder.writeRaw(`,"button_id":`)
+	encoder.writeInt32(entity.ButtonId)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageChatShared) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat":
+			entity.Chat, err = decodeEntity[SharedChat](decoder)
+
+		case "button_id":
+			entity.ButtonId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageChatShared)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user allowed the bot to send messages
+type MessageBotWriteAccessAllowed struct {
+	meta
+	// The reason why the bot was allowed to write messages
+	Reason BotWriteAccessAllowReason `json:"reason"`
+}
+
+func (*MessageBotWriteAccessAllowed) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageBotWriteAccessAllowed) GetConstructor() string {
+	return ConstructorMessageBotWriteAccessAllowed
+}
+
+func (*MessageBotWriteAccessAllowed) MessageContentConstructor() string {
+	return ConstructorMessageBotWriteAccessAllowed
+}
+
+func (entity *MessageBotWriteAccessAllowed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageBotWriteAccessAllowed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageBotWriteAccessAllowed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageBotWriteAccessAllowed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reason":`)
+	encoder.writeType(entity.Reason)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageBotWriteAccessAllowed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reason":
+			entity.Reason, err = decodeBotWriteAccessAllowReason(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageBotWriteAccessAllowed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Data from a Web App has been sent to a bot
+type MessageWebAppDataSent struct {
+	meta
+	// Text of the keyboardButtonTypeWebApp button, which opened the Web App
+	ButtonText string `json:"button_text"`
+}
+
+func (*MessageWebAppDataSent) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageWebAppDataSent) GetConstructor() string {
+	return ConstructorMessageWebAppDataSent
+}
+
+func (*MessageWebAppDataSent) MessageContentConstructor() string {
+	return ConstructorMessageWebAppDataSent
+}
+
+func (entity *MessageWebAppDataSent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageWebAppDataSent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageWebAppDataSent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageWebAppDataSent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"button_text":`)
+	encoder.writeString(entity.ButtonText)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageWebAppDataSent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "button_text":
+			entity.ButtonText, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageWebAppDataSent)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Data from a Web App has been received; for bots only
+type MessageWebAppDataReceived struct {
+	meta
+	// Text of the keyboardButtonTypeWebApp button, which opened the Web App
+	ButtonText string `json:"button_text"`
+	// The data
+	Data string `json:"data"`
+}
+
+func (*MessageWebAppDataReceived) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageWebAppDataReceived) GetConstructor() string {
+	return ConstructorMessageWebAppDataReceived
+}
+
+func (*MessageWebAppDataReceived) MessageContentConstructor() string {
+	return ConstructorMessageWebAppDataReceived
+}
+
+func (entity *MessageWebAppDataReceived) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageWebAppDataReceived) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageWebAppDataReceived) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageWebAppDataReceived"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"button_text":`)
+	encoder.writeString(entity.ButtonText)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageWebAppDataReceived) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "button_text":
+			entity.ButtonText, err = decoder.readString()
+
+		case "data":
+			entity.Data, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageWebAppDataReceived)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Telegram Passport data has been sent to a bot
+type MessagePassportDataSent struct {
+	meta
+	// List of Telegram Passport element types sent
+	Types []PassportElementType `json:"types"`
+}
+
+func (*MessagePassportDataSent) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePassportDataSent) GetConstructor() string {
+	return ConstructorMessagePassportDataSent
+}
+
+func (*MessagePassportDataSent) MessageContentConstructor() string {
+	return ConstructorMessagePassportDataSent
+}
+
+func (entity *MessagePassportDataSent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePassportDataSent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePassportDataSent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePassportDataSent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"types":`)
+	writeList(encoder, entity.Types, writeTypeValue[PassportElementType])
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePassportDataSent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "types":
+			entity.Types, err = readList(decoder, decodePassportElementType)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePassportDataSent)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Telegram Passport data has been received; for bots only
+type MessagePassportDataReceived struct {
+	meta
+	// List of received Telegram Passport elements
+	Elements []*EncryptedPassportElement `json:"elements"`
+	// Encrypted data credentials
+	Credentials *EncryptedCredentials `json:"credentials"`
+}
+
+func (*MessagePassportDataReceived) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessagePassportDataReceived) GetConstructor() string {
+	return ConstructorMessagePassportDataReceived
+}
+
+func (*MessagePassportDataReceived) MessageContentConstructor() string {
+	return ConstructorMessagePassportDataReceived
+}
+
+func (entity *MessagePassportDataReceived) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessagePassportDataReceived) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessagePassportDataReceived) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messagePassportDataReceived"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"elements":`)
+	writeList(encoder, entity.Elements, writeTypeValue[*EncryptedPassportElement])
+	encoder.writeRaw(`,"credentials":`)
+	entity.Credentials.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessagePassportDataReceived) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "elements":
+			entity.Elements, err = readList(decoder, decodeEntity[EncryptedPassportElement])
+
+		case "credentials":
+			entity.Credentials, err = decodeEntity[EncryptedCredentials](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessagePassportDataReceived)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A user in the chat came within proximity alert range
+type MessageProximityAlertTriggered struct {
+	meta
+	// The identifier of a user or chat that triggered the proximity alert
+	TravelerId MessageSender `json:"traveler_id"`
+	// The identifier of a user or chat that subscribed for the proximity alert
+	WatcherId MessageSender `json:"watcher_id"`
+	// The distance between the users
+	Distance int32 `json:"distance"`
+}
+
+func (*MessageProximityAlertTriggered) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageProximityAlertTriggered) GetConstructor() string {
+	return ConstructorMessageProximityAlertTriggered
+}
+
+func (*MessageProximityAlertTriggered) MessageContentConstructor() string {
+	return ConstructorMessageProximityAlertTriggered
+}
+
+func (entity *MessageProximityAlertTriggered) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageProximityAlertTriggered) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageProximityAlertTriggered) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageProximityAlertTriggered"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"traveler_id":`)
+	encoder.writeType(entity.TravelerId)
+	encoder.writeRaw(`,"watcher_id":`)
+	encoder.writeType(entity.WatcherId)
+	encoder.writeRaw(`,"distance":`)
+	encoder.writeInt32(entity.Distance)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageProximityAlertTriggered) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "traveler_id":
+			entity.TravelerId, err = decodeMessageSender(decoder)
+
+		case "watcher_id":
+			entity.WatcherId, err = decodeMessageSender(decoder)
+
+		case "distance":
+			entity.Distance, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageProximityAlertTriggered)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message content that is not supported in the current TDLib version
+type MessageUnsupported struct {
+	meta
+}
+
+func (*MessageUnsupported) GetType() string {
+	return TypeMessageContent
+}
+
+func (*MessageUnsupported) GetConstructor() string {
+	return ConstructorMessageUnsupported
+}
+
+func (*MessageUnsupported) MessageContentConstructor() string {
+	return ConstructorMessageUnsupported
+}
+
+func (entity *MessageUnsupported) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageUnsupported) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageUnsupported) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageUnsupported"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageUnsupported) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageUnsupported)
+	})
+}
+
+// A mention of a user, a supergroup, or a channel by their username
+type TextEntityTypeMention struct {
+	meta
+}
+
+func (*TextEntityTypeMention) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeMention) GetConstructor() string {
+	return ConstructorTextEntityTypeMention
+}
+
+func (*TextEntityTypeMention) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeMention
+}
+
+func (entity *TextEntityTypeMention) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeMention) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeMention) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeMention"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeMention) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeMention)
+	})
+}
+
+// A hashtag text, beginning with "#" and optionally containing a chat username at the end
+type TextEntityTypeHashtag struct {
+	meta
+}
+
+func (*TextEntityTypeHashtag) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeHashtag) GetConstructor() string {
+	return ConstructorTextEntityTypeHashtag
+}
+
+func (*TextEntityTypeHashtag) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeHashtag
+}
+
+func (entity *TextEntityTypeHashtag) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeHashtag) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeHashtag) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeHashtag"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeHashtag) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeHashtag)
+	})
+}
+
+// A cashtag text, beginning with "$", consisting of capital English letters (e.g., "$USD"), and optionally containing a chat username at the end
+type TextEntityTypeCashtag struct {
+	meta
+}
+
+func (*TextEntityTypeCashtag) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeCashtag) GetConstructor() string {
+	return ConstructorTextEntityTypeCashtag
+}
+
+func (*TextEntityTypeCashtag) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeCashtag
+}
+
+func (entity *TextEntityTypeCashtag) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeCashtag) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeCashtag) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeCashtag"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeCashtag) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeCashtag)
+	})
+}
+
+// A bot command, beginning with "/"
+type TextEntityTypeBotCommand struct {
+	meta
+}
+
+func (*TextEntityTypeBotCommand) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeBotCommand) GetConstructor() string {
+	return ConstructorTextEntityTypeBotCommand
+}
+
+func (*TextEntityTypeBotCommand) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeBotCommand
+}
+
+func (entity *TextEntityTypeBotCommand) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeBotCommand) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeBotCommand) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeBotCommand"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeBotCommand) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeBotCommand)
+	})
+}
+
+// An HTTP URL
+type TextEntityTypeUrl struct {
+	meta
+}
+
+func (*TextEntityTypeUrl) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeUrl) GetConstructor() string {
+	return ConstructorTextEntityTypeUrl
+}
+
+func (*TextEntityTypeUrl) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeUrl
+}
+
+func (entity *TextEntityTypeUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeUrl)
+	})
+}
+
+// An email address
+type TextEntityTypeEmailAddress struct {
+	meta
+}
+
+func (*TextEntityTypeEmailAddress) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeEmailAddress) GetConstructor() string {
+	return ConstructorTextEntityTypeEmailAddress
+}
+
+func (*TextEntityTypeEmailAddress) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeEmailAddress
+}
+
+func (entity *TextEntityTypeEmailAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeEmailAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeEmailAddress) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeEmailAddress"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeEmailAddress) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeEmailAddress)
+	})
+}
+
+// A phone number
+type TextEntityTypePhoneNumber struct {
+	meta
+}
+
+func (*TextEntityTypePhoneNumber) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypePhoneNumber) GetConstructor() string {
+	return ConstructorTextEntityTypePhoneNumber
+}
+
+func (*TextEntityTypePhoneNumber) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypePhoneNumber
+}
+
+func (entity *TextEntityTypePhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypePhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypePhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypePhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypePhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypePhoneNumber)
+	})
+}
+
+// A bank card number. The getBankCardInfo method can be used to get information about the bank card
+type TextEntityTypeBankCardNumber struct {
+	meta
+}
+
+func (*TextEntityTypeBankCardNumber) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeBankCardNumber) GetConstructor() string {
+	return ConstructorTextEntityTypeBankCardNumber
+}
+
+func (*TextEntityTypeBankCardNumber) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeBankCardNumber
+}
+
+func (entity *TextEntityTypeBankCardNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeBankCardNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeBankCardNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeBankCardNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeBankCardNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeBankCardNumber)
+	})
+}
+
+// A bold text
+type TextEntityTypeBold struct {
+	meta
+}
+
+func (*TextEntityTypeBold) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeBold) GetConstructor() string {
+	return ConstructorTextEntityTypeBold
+}
+
+func (*TextEntityTypeBold) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeBold
+}
+
+func (entity *TextEntityTypeBold) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeBold) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeBold) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeBold"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeBold) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeBold)
+	})
+}
+
+// An italic text
+type TextEntityTypeItalic struct {
+	meta
+}
+
+func (*TextEntityTypeItalic) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeItalic) GetConstructor() string {
+	return ConstructorTextEntityTypeItalic
+}
+
+func (*TextEntityTypeItalic) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeItalic
+}
+
+func (entity *TextEntityTypeItalic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeItalic) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeItalic) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeItalic"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeItalic) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeItalic)
+	})
+}
+
+// An underlined text
+type TextEntityTypeUnderline struct {
+	meta
+}
+
+func (*TextEntityTypeUnderline) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeUnderline) GetConstructor() string {
+	return ConstructorTextEntityTypeUnderline
+}
+
+func (*TextEntityTypeUnderline) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeUnderline
+}
+
+func (entity *TextEntityTypeUnderline) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeUnderline) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeUnderline) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeUnderline"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeUnderline) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeUnderline)
+	})
+}
+
+// A strikethrough text
+type TextEntityTypeStrikethrough struct {
+	meta
+}
+
+func (*TextEntityTypeStrikethrough) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeStrikethrough) GetConstructor() string {
+	return ConstructorTextEntityTypeStrikethrough
+}
+
+func (*TextEntityTypeStrikethrough) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeStrikethrough
+}
+
+func (entity *TextEntityTypeStrikethrough) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeStrikethrough) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeStrikethrough) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeStrikethrough"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeStrikethrough) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeStrikethrough)
+	})
+}
+
+// A spoiler text
+type TextEntityTypeSpoiler struct {
+	meta
+}
+
+func (*TextEntityTypeSpoiler) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeSpoiler) GetConstructor() string {
+	return ConstructorTextEntityTypeSpoiler
+}
+
+func (*TextEntityTypeSpoiler) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeSpoiler
+}
+
+func (entity *TextEntityTypeSpoiler) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeSpoiler) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeSpoiler) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeSpoiler"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeSpoiler) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeSpoiler)
+	})
+}
+
+// Text that must be formatted as if inside a code HTML tag
+type TextEntityTypeCode struct {
+	meta
+}
+
+func (*TextEntityTypeCode) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeCode) GetConstructor() string {
+	return ConstructorTextEntityTypeCode
+}
+
+func (*TextEntityTypeCode) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeCode
+}
+
+func (entity *TextEntityTypeCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeCode)
+	})
+}
+
+// Text that must be formatted as if inside a pre HTML tag
+type TextEntityTypePre struct {
+	meta
+}
+
+func (*TextEntityTypePre) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypePre) GetConstructor() string {
+	return ConstructorTextEntityTypePre
+}
+
+func (*TextEntityTypePre) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypePre
+}
+
+func (entity *TextEntityTypePre) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypePre) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypePre) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypePre"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypePre) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypePre)
+	})
+}
+
+// Text that must be formatted as if inside pre, and code HTML tags
+type TextEntityTypePreCode struct {
+	meta
+	// Programming language of the code; as defined by the sender
+	Language string `json:"language"`
+}
+
+func (*TextEntityTypePreCode) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypePreCode) GetConstructor() string {
+	return ConstructorTextEntityTypePreCode
+}
+
+func (*TextEntityTypePreCode) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypePreCode
+}
+
+func (entity *TextEntityTypePreCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypePreCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypePreCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypePreCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"language":`)
+	encoder.writeString(entity.Language)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypePreCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "language":
+			entity.Language, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypePreCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Text that must be formatted as if inside a blockquote HTML tag; not supported in secret chats
+type TextEntityTypeBlockQuote struct {
+	meta
+}
+
+func (*TextEntityTypeBlockQuote) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeBlockQuote) GetConstructor() string {
+	return ConstructorTextEntityTypeBlockQuote
+}
+
+func (*TextEntityTypeBlockQuote) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeBlockQuote
+}
+
+func (entity *TextEntityTypeBlockQuote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeBlockQuote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeBlockQuote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeBlockQuote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeBlockQuote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeBlockQuote)
+	})
+}
+
+// Text that must be formatted as if inside a blockquote HTML tag and collapsed by default to 3 lines with the ability to show full text; not supported in secret chats
+type TextEntityTypeExpandableBlockQuote struct {
+	meta
+}
+
+func (*TextEntityTypeExpandableBlockQuote) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeExpandableBlockQuote) GetConstructor() string {
+	return ConstructorTextEntityTypeExpandableBlockQuote
+}
+
+func (*TextEntityTypeExpandableBlockQuote) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeExpandableBlockQuote
+}
+
+func (entity *TextEntityTypeExpandableBlockQuote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeExpandableBlockQuote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeExpandableBlockQuote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeExpandableBlockQuote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeExpandableBlockQuote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeExpandableBlockQuote)
+	})
+}
+
+// A text description shown instead of a raw URL
+type TextEntityTypeTextUrl struct {
+	meta
+	// HTTP or tg:// URL to be opened when the link is clicked
+	Url string `json:"url"`
+}
+
+func (*TextEntityTypeTextUrl) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeTextUrl) GetConstructor() string {
+	return ConstructorTextEntityTypeTextUrl
+}
+
+func (*TextEntityTypeTextUrl) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeTextUrl
+}
+
+func (entity *TextEntityTypeTextUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeTextUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeTextUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeTextUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeTextUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeTextUrl)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A text shows instead of a raw mention of the user (e.g., when the user has no username)
+type TextEntityTypeMentionName struct {
+	meta
+	// Identifier of the mentioned user
+	UserId int64 `json:"user_id"`
+}
+
+func (*TextEntityTypeMentionName) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeMentionName) GetConstructor() string {
+	return ConstructorTextEntityTypeMentionName
+}
+
+func (*TextEntityTypeMentionName) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeMentionName
+}
+
+func (entity *TextEntityTypeMentionName) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeMentionName) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeMentionName) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeMentionName"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeMentionName) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeMentionName)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A custom emoji. The text behind a custom emoji must be an emoji. Only premium users can use premium custom emoji
+type TextEntityTypeCustomEmoji struct {
+	meta
+	// Unique identifier of the custom emoji
+	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
+}
+
+func (*TextEntityTypeCustomEmoji) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeCustomEmoji) GetConstructor() string {
+	return ConstructorTextEntityTypeCustomEmoji
+}
+
+func (*TextEntityTypeCustomEmoji) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeCustomEmoji
+}
+
+func (entity *TextEntityTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeCustomEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeCustomEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeCustomEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.CustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeCustomEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_emoji_id":
+			entity.CustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeCustomEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A media timestamp
+type TextEntityTypeMediaTimestamp struct {
+	meta
+	// Timestamp from which a video/audio/video note/voice note/story playing must start, in seconds. The media can be in the content or the link preview of the current message, or in the same places in the replied message
+	MediaTimestamp int32 `json:"media_timestamp"`
+}
+
+func (*TextEntityTypeMediaTimestamp) GetType() string {
+	return TypeTextEntityType
+}
+
+func (*TextEntityTypeMediaTimestamp) GetConstructor() string {
+	return ConstructorTextEntityTypeMediaTimestamp
+}
+
+func (*TextEntityTypeMediaTimestamp) TextEntityTypeConstructor() string {
+	return ConstructorTextEntityTypeMediaTimestamp
+}
+
+func (entity *TextEntityTypeMediaTimestamp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextEntityTypeMediaTimestamp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextEntityTypeMediaTimestamp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textEntityTypeMediaTimestamp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"media_timestamp":`)
+	encoder.writeInt32(entity.MediaTimestamp)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextEntityTypeMediaTimestamp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "media_timestamp":
+			entity.MediaTimestamp, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextEntityTypeMediaTimestamp)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message will be sent at the specified date
+type MessageSchedulingStateSendAtDate struct {
+	meta
+	// Point in time (Unix timestamp) when the message will be sent. The date must be within 367 days in the future
+	SendDate int32 `json:"send_date"`
+}
+
+func (*MessageSchedulingStateSendAtDate) GetType() string {
+	return TypeMessageSchedulingState
+}
+
+func (*MessageSchedulingStateSendAtDate) GetConstructor() string {
+	return ConstructorMessageSchedulingStateSendAtDate
+}
+
+func (*MessageSchedulingStateSendAtDate) MessageSchedulingStateConstructor() string {
+	return ConstructorMessageSchedulingStateSendAtDate
+}
+
+func (entity *MessageSchedulingStateSendAtDate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSchedulingStateSendAtDate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSchedulingStateSendAtDate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSchedulingStateSendAtDate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"send_date":`)
+	encoder.writeInt32(entity.SendDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSchedulingStateSendAtDate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "send_date":
+			entity.SendDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSchedulingStateSendAtDate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message will be sent when the other user is online. Applicable to private chats only and when the exact online status of the other user is known
+type MessageSchedulingStateSendWhenOnline struct {
+	meta
+}
+
+func (*MessageSchedulingStateSendWhenOnline) GetType() string {
+	return TypeMessageSchedulingState
+}
+
+func (*MessageSchedulingStateSendWhenOnline) GetConstructor() string {
+	return ConstructorMessageSchedulingStateSendWhenOnline
+}
+
+func (*MessageSchedulingStateSendWhenOnline) MessageSchedulingStateConstructor() string {
+	return ConstructorMessageSchedulingStateSendWhenOnline
+}
+
+func (entity *MessageSchedulingStateSendWhenOnline) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSchedulingStateSendWhenOnline) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSchedulingStateSendWhenOnline) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSchedulingStateSendWhenOnline"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSchedulingStateSendWhenOnline) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSchedulingStateSendWhenOnline)
+	})
+}
+
+// The message will be sent when the video in the message is converted and optimized; can be used only by the server
+type MessageSchedulingStateSendWhenVideoProcessed struct {
+	meta
+	// Approximate point in time (Unix timestamp) when the message is expected to be sent
+	SendDate int32 `json:"send_date"`
+}
+
+func (*MessageSchedulingStateSendWhenVideoProcessed) GetType() string {
+	return TypeMessageSchedulingState
+}
+
+func (*MessageSchedulingStateSendWhenVideoProcessed) GetConstructor() string {
+	return ConstructorMessageSchedulingStateSendWhenVideoProcessed
+}
+
+func (*MessageSchedulingStateSendWhenVideoProcessed) MessageSchedulingStateConstructor() string {
+	return ConstructorMessageSchedulingStateSendWhenVideoProcessed
+}
+
+func (entity *MessageSchedulingStateSendWhenVideoProcessed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSchedulingStateSendWhenVideoProcessed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSchedulingStateSendWhenVideoProcessed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSchedulingStateSendWhenVideoProcessed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"send_date":`)
+	encoder.writeInt32(entity.SendDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSchedulingStateSendWhenVideoProcessed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "send_date":
+			entity.SendDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSchedulingStateSendWhenVideoProcessed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message will be self-destructed in the specified time after its content was opened
+type MessageSelfDestructTypeTimer struct {
+	meta
+	// The message's self-destruct time, in seconds; must be between 0 and 60 in private chats
+	SelfDestructTime int32 `json:"self_destruct_time"`
+}
+
+func (*MessageSelfDestructTypeTimer) GetType() string {
+	return TypeMessageSelfDestructType
+}
+
+func (*MessageSelfDestructTypeTimer) GetConstructor() string {
+	return ConstructorMessageSelfDestructTypeTimer
+}
+
+func (*MessageSelfDestructTypeTimer) MessageSelfDestructTypeConstructor() string {
+	return ConstructorMessageSelfDestructTypeTimer
+}
+
+func (entity *MessageSelfDestructTypeTimer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSelfDestructTypeTimer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSelfDestructTypeTimer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSelfDestructTypeTimer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"self_destruct_time":`)
+	encoder.writeInt32(entity.SelfDestructTime)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSelfDestructTypeTimer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "self_destruct_time":
+			entity.SelfDestructTime, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSelfDestructTypeTimer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageSelfDestructTypeTimer) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateMin("self_destruct_time", int64(entity.SelfDestructTime), 0); err != nil {
+		return err
+	}
+	if err := validateMax("self_destruct_time", int64(entity.SelfDestructTime), 60); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The message can be opened only once and will be self-destructed once closed
+type MessageSelfDestructTypeImmediately struct {
+	meta
+}
+
+func (*MessageSelfDestructTypeImmediately) GetType() string {
+	return TypeMessageSelfDestructType
+}
+
+func (*MessageSelfDestructTypeImmediately) GetConstructor() string {
+	return ConstructorMessageSelfDestructTypeImmediately
+}
+
+func (*MessageSelfDestructTypeImmediately) MessageSelfDestructTypeConstructor() string {
+	return ConstructorMessageSelfDestructTypeImmediately
+}
+
+func (entity *MessageSelfDestructTypeImmediately) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSelfDestructTypeImmediately) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSelfDestructTypeImmediately) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSelfDestructTypeImmediately"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSelfDestructTypeImmediately) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageSelfDestructTypeImmediately)
+	})
+}
+
+// Options to be used when a message is sent
+type MessageSendOptions struct {
+	meta
+	// Pass true to disable notification for the message
+	DisableNotification bool `json:"disable_notification"`
+	// Pass true if the message is sent from the background
+	FromBackground bool `json:"from_background"`
+	// Pass true if the content of the message must be protected from forwarding and saving; for bots only
+	ProtectContent bool `json:"protect_content"`
+	// Pass true to allow the message to ignore regular broadcast limits for a small fee; for bots only
+	AllowPaidBroadcast bool `json:"allow_paid_broadcast"`
+	// The number of Telegram Stars the user agreed to pay to send the messages
+	PaidMessageStarCount int64 `json:"paid_message_star_count"`
+	// Pass true if the user explicitly chosen a sticker or a custom emoji from an installed sticker set; applicable only to sendMessage and sendMessageAlbum
+	UpdateOrderOfInstalledStickerSets bool `json:"update_order_of_installed_sticker_sets"`
+	// Message scheduling state; pass null to send message immediately. Messages sent to a secret chat, to a chat with paid messages, live location messages and self-destructing messages can't be scheduled
+	SchedulingState MessageSchedulingState `json:"scheduling_state,omitempty"`
+	// Identifier of the effect to apply to the message; pass 0 if none; applicable only to sendMessage and sendMessageAlbum in private chats
+	EffectId JsonInt64 `json:"effect_id"`
+	// Non-persistent identifier, which will be returned back in messageSendingStatePending object and can be used to match sent messages and corresponding updateNewMessage updates
+	SendingId int32 `json:"sending_id"`
+	// Pass true to get a fake message instead of actually sending them
+	OnlyPreview bool `json:"only_preview"`
+}
+
+func (*MessageSendOptions) GetType() string {
+	return TypeMessageSendOptions
+}
+
+func (*MessageSendOptions) GetConstructor() string {
+	return ConstructorMessageSendOptions
+}
+
+func (entity *MessageSendOptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageSendOptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageSendOptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageSendOptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"disable_notification":`)
+	encoder.writeBool(entity.DisableNotification)
+	encoder.writeRaw(`,"from_background":`)
+	encoder.writeBool(entity.FromBackground)
+	encoder.writeRaw(`,"protect_content":`)
+	encoder.writeBool(entity.ProtectContent)
+	encoder.writeRaw(`,"allow_paid_broadcast":`)
+	encoder.writeBool(entity.AllowPaidBroadcast)
+	encoder.writeRaw(`,"paid_message_star_count":`)
+	encoder.writeInt64(entity.PaidMessageStarCount)
+	encoder.writeRaw(`,"update_order_of_installed_sticker_sets":`)
+	encoder.writeBool(entity.UpdateOrderOfInstalledStickerSets)
+	if entity.SchedulingState != nil {
+		encoder.writeRaw(`,"scheduling_state":`)
+		encoder.writeType(entity.SchedulingState)
+	}
+	encoder.writeRaw(`,"effect_id":`)
+	encoder.writeJsonInt64(entity.EffectId)
+	encoder.writeRaw(`,"sending_id":`)
+	encoder.writeInt32(entity.SendingId)
+	encoder.writeRaw(`,"only_preview":`)
+	encoder.writeBool(entity.OnlyPreview)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageSendOptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "disable_notification":
+			entity.DisableNotification, err = decoder.readBool()
+
+		case "from_background":
+			entity.FromBackground, err = decoder.readBool()
+
+		case "protect_content":
+			entity.ProtectContent, err = decoder.readBool()
+
+		case "allow_paid_broadcast":
+			entity.AllowPaidBroadcast, err = decoder.readBool()
+
+		case "paid_message_star_count":
+			entity.PaidMessageStarCount, err = decoder.readInt64()
+
+		case "update_order_of_installed_sticker_sets":
+			entity.UpdateOrderOfInstalledStickerSets, err = decoder.readBool()
+
+		case "scheduling_state":
+			entity.SchedulingState, err = decodeMessageSchedulingState(decoder)
+
+		case "effect_id":
+			entity.EffectId, err = decoder.readJsonInt64()
+
+		case "sending_id":
+			entity.SendingId, err = decoder.readInt32()
+
+		case "only_preview":
+			entity.OnlyPreview, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageSendOptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Options to be used when a message content is copied without reference to the original sender. Service messages, messages with messageInvoice, messagePaidMedia, messageGiveaway, or messageGiveawayWinners content can't be copied
+type MessageCopyOptions struct {
+	meta
+	// True, if content of the message needs to be copied without reference to the original sender. Always true if the message is forwarded to a secret chat or is local. Use messageProperties.can_be_saved and messageProperties.can_be_copied_to_secret_chat to check whether the message is suitable
+	SendCopy bool `json:"send_copy"`
+	// True, if media caption of the message copy needs to be replaced. Ignored if send_copy is false
+	ReplaceCaption bool `json:"replace_caption"`
+	// New message caption; pass null to copy message without caption. Ignored if replace_caption is false
+	NewCaption *FormattedText `json:"new_caption,omitempty"`
+	// True, if new caption must be shown above the media; otherwise, new caption must be shown below the media; not supported in secret chats. Ignored if replace_caption is false
+	NewShowCaptionAboveMedia bool `json:"new_show_caption_above_media"`
+}
+
+func (*MessageCopyOptions) GetType() string {
+	return TypeMessageCopyOptions
+}
+
+func (*MessageCopyOptions) GetConstructor() string {
+	return ConstructorMessageCopyOptions
+}
+
+func (entity *MessageCopyOptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageCopyOptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageCopyOptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageCopyOptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"send_copy":`)
+	encoder.writeBool(entity.SendCopy)
+	encoder.writeRaw(`,"replace_caption":`)
+	encoder.writeBool(entity.ReplaceCaption)
+	if entity.NewCaption != nil {
+		encoder.writeRaw(`,"new_caption":`)
+		entity.NewCaption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"new_show_caption_above_media":`)
+	encoder.writeBool(entity.NewShowCaptionAboveMedia)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageCopyOptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "send_copy":
+			entity.SendCopy, err = decoder.readBool()
+
+		case "replace_caption":
+			entity.ReplaceCaption, err = decoder.readBool()
+
+		case "new_caption":
+			entity.NewCaption, err = decodeEntity[FormattedText](decoder)
+
+		case "new_show_caption_above_media":
+			entity.NewShowCaptionAboveMedia, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageCopyOptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A text message
+type InputMessageText struct {
+	meta
+	// Formatted text to be sent; 0-getOption("message_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, CustomEmoji, BlockQuote, ExpandableBlockQuote, Code, Pre, PreCode, TextUrl and MentionName entities are allowed to be specified manually
+	Text *FormattedText `json:"text"`
+	// Options to be used for generation of a link preview; may be null if none; pass null to use default link preview options
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// True, if a chat message draft must be deleted
+	ClearDraft bool `json:"clear_draft"`
+}
+
+func (*InputMessageText) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageText) GetConstructor() string {
+	return ConstructorInputMessageText
+}
+
+func (*InputMessageText) InputMessageContentConstructor() string {
+	return ConstructorInputMessageText
+}
+
+func (entity *InputMessageText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageText) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageText) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageText"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	if entity.LinkPreviewOptions != nil {
+		encoder.writeRaw(`,"link_preview_options":`)
+		entity.LinkPreviewOptions.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"clear_draft":`)
+	encoder.writeBool(entity.ClearDraft)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageText) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "link_preview_options":
+			entity.LinkPreviewOptions, err = decodeEntity[LinkPreviewOptions](decoder)
+
+		case "clear_draft":
+			entity.ClearDraft, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageText)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An animation message (GIF-style).
+type InputMessageAnimation struct {
+	meta
+	// Animation file to be sent
+	Animation InputFile `json:"animation"`
+	// Animation thumbnail; pass null to skip thumbnail uploading
+	Thumbnail *InputThumbnail `json:"thumbnail,omitempty"`
+	// File identifiers of the stickers added to the animation, if applicable
+	AddedStickerFileIds []int32 `json:"added_sticker_file_ids"`
+	// Duration of the animation, in seconds
+	Duration int32 `json:"duration"`
+	// Width of the animation; may be replaced by the server
+	Width int32 `json:"width"`
+	// Height of the animation; may be replaced by the server
+	Height int32 `json:"height"`
+	// Animation caption; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+	// True, if the caption must be shown above the animation; otherwise, the caption must be shown below the animation; not supported in secret chats
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// True, if the animation preview must be covered by a spoiler animation; not supported in secret chats
+	HasSpoiler bool `json:"has_spoiler"`
+}
+
+func (*InputMessageAnimation) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageAnimation) GetConstructor() string {
+	return ConstructorInputMessageAnimation
+}
+
+func (*InputMessageAnimation) InputMessageContentConstructor() string {
+	return ConstructorInputMessageAnimation
+}
+
+func (entity *InputMessageAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"animation":`)
+	encoder.writeType(entity.Animation)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"added_sticker_file_ids":`)
+	writeList(encoder, entity.AddedStickerFileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	if entity.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		entity.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	encoder.writeRaw(`,"has_spoiler":`)
+	encoder.writeBool(entity.HasSpoiler)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animation":
+			entity.Animation, err = decodeInputFile(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "added_sticker_file_ids":
+			entity.AddedStickerFileIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		case "has_spoiler":
+			entity.HasSpoiler, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An audio message
+type InputMessageAudio struct {
+	meta
+	// Audio file to be sent
+	Audio InputFile `json:"audio"`
+	// Thumbnail of the cover for the album; pass null to skip thumbnail uploading
+	AlbumCoverThumbnail *InputThumbnail `json:"album_cover_thumbnail,omitempty"`
+	// Duration of the audio, in seconds; may be replaced by the server
+	Duration int32 `json:"duration"`
+	// Title of the audio; 0-64 characters; may be replaced by the server
+	Title string `json:"title"`
+	// Performer of the audio; 0-64 characters, may be replaced by the server
+	Performer string `json:"performer"`
+	// Audio caption; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+}
+
+func (*InputMessageAudio) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageAudio) GetConstructor() string {
+	return ConstructorInputMessageAudio
+}
+
+func (*InputMessageAudio) InputMessageContentConstructor() string {
+	return ConstructorInputMessageAudio
+}
+
+func (entity *InputMessageAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"audio":`)
+	encoder.writeType(entity.Audio)
+	if entity.AlbumCoverThumbnail != nil {
+		encoder.writeRaw(`,"album_cover_thumbnail":`)
+		entity.AlbumCoverThumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"performer":`)
+	encoder.writeString(entity.Performer)
+	if entity.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		entity.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "audio":
+			entity.Audio, err = decodeInputFile(decoder)
+
+		case "album_cover_thumbnail":
+			entity.AlbumCoverThumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "performer":
+			entity.Performer, err = decoder.readString()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessageAudio) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("title", entity.Title, 0, 64); err != nil {
+		return err
+	}
+
+	if err := validateLength("performer", entity.Performer, 0, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A document message (general file)
+type InputMessageDocument struct {
+	meta
+	// Document to be sent
+	Document InputFile `json:"document"`
+	// Document thumbnail; pass null to skip thumbnail uploading
+	Thumbnail *InputThumbnail `json:"thumbnail,omitempty"`
+	// Pass true to disable automatic file type detection and send the document as a file. Always true for files sent to secret chats
+	DisableContentTypeDetection bool `json:"disable_content_type_detection"`
+	// Document caption; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+}
+
+func (*InputMessageDocument) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageDocument) GetConstructor() string {
+	return ConstructorInputMessageDocument
+}
+
+func (*InputMessageDocument) InputMessageContentConstructor() string {
+	return ConstructorInputMessageDocument
+}
+
+func (entity *InputMessageDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"document":`)
+	encoder.writeType(entity.Document)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"disable_content_type_detection":`)
+	encoder.writeBool(entity.DisableContentTypeDetection)
+	if entity.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		entity.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "document":
+			entity.Document, err = decodeInputFile(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "disable_content_type_detection":
+			entity.DisableContentTypeDetection, err = decoder.readBool()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with paid media; can be used only in channel chats with supergroupFullInfo.has_paid_media_allowed
+type InputMessagePaidMedia struct {
+	meta
+	// The number of Telegram Stars that must be paid to see the media; 1-getOption("paid_media_message_star_count_max")
+	StarCount int64 `json:"star_count"`
+	// The content of the paid media
+	PaidMedia []*InputPaidMedia `json:"paid_media"`
+	// Message caption; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+	// True, if the caption must be shown above the media; otherwise, the caption must be shown below the media; not supported in secret chats
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// Bot-provided data for the paid media; bots only
+	Payload string `json:"payload"`
+}
+
+func (*InputMessagePaidMedia) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessagePaidMedia) GetConstructor() string {
+	return ConstructorInputMessagePaidMedia
+}
+
+func (*InputMessagePaidMedia) InputMessageContentConstructor() string {
+	return ConstructorInputMessagePaidMedia
+}
+
+func (entity *InputMessagePaidMedia) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessagePaidMedia) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessagePaidMedia) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessagePaidMedia"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"paid_media":`)
+	writeList(encoder, entity.PaidMedia, writeTypeValue[*InputPaidMedia])
+	if entity.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		entity.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeString(entity.Payload)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessagePaidMedia) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "paid_media":
+			entity.PaidMedia, err = readList(decoder, decodeEntity[InputPaidMedia])
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		case "payload":
+			entity.Payload, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessagePaidMedia)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A photo message
+type InputMessagePhoto struct {
+	meta
+	// Photo to send. The photo must be at most 10 MB in size. The photo's width and height must not exceed 10000 in total. Width and height ratio must be at most 20
+	Photo InputFile `json:"photo"`
+	// Photo thumbnail to be sent; pass null to skip thumbnail uploading. The thumbnail is sent to the other party only in secret chats
+	Thumbnail *InputThumbnail `json:"thumbnail,omitempty"`
+	// File identifiers of the stickers added to the photo, if applicable
+	AddedStickerFileIds []int32 `json:"added_sticker_file_ids"`
+	// Photo width
+	Width int32 `json:"width"`
+	// Photo height
+	Height int32 `json:"height"`
+	// Photo caption; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+	// True, if the caption must be shown above the photo; otherwise, the caption must be shown below the photo; not supported in secret chats
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// Photo self-destruct type; pass null if none; private chats only
+	SelfDestructType MessageSelfDestructType `json:"self_destruct_type,omitempty"`
+	// True, if the photo preview must be covered by a spoiler animation; not supported in secret chats
+	HasSpoiler bool `json:"has_spoiler"`
+}
+
+func (*InputMessagePhoto) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessagePhoto) GetConstructor() string {
+	return ConstructorInputMessagePhoto
+}
+
+func (*InputMessagePhoto) InputMessageContentConstructor() string {
+	return ConstructorInputMessagePhoto
+}
+
+func (entity *InputMessagePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessagePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessagePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessagePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	encoder.writeType(entity.Photo)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"added_sticker_file_ids":`)
+	writeList(encoder, entity.AddedStickerFileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	if entity.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		entity.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	if entity.SelfDestructType != nil {
+		encoder.writeRaw(`,"self_destruct_type":`)
+		encoder.writeType(entity.SelfDestructType)
+	}
+	encoder.writeRaw(`,"has_spoiler":`)
+	encoder.writeBool(entity.HasSpoiler)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessagePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeInputFile(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "added_sticker_file_ids":
+			entity.AddedStickerFileIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		case "self_destruct_type":
+			entity.SelfDestructType, err = decodeMessageSelfDestructType(decoder)
+
+		case "has_spoiler":
+			entity.HasSpoiler, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessagePhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessagePhoto) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("self_destruct_type", entity.SelfDestructType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A sticker message
+type InputMessageSticker struct {
+	meta
+	// Sticker to be sent
+	Sticker InputFile `json:"sticker"`
+	// Sticker thumbnail; pass null to skip thumbnail uploading
+	Thumbnail *InputThumbnail `json:"thumbnail,omitempty"`
+	// Sticker width
+	Width int32 `json:"width"`
+	// Sticker height
+	Height int32 `json:"height"`
+	// Emoji used to choose the sticker
+	Emoji string `json:"emoji"`
+}
+
+func (*InputMessageSticker) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageSticker) GetConstructor() string {
+	return ConstructorInputMessageSticker
+}
+
+func (*InputMessageSticker) InputMessageContentConstructor() string {
+	return ConstructorInputMessageSticker
+}
+
+func (entity *InputMessageSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(entity.Sticker)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeInputFile(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video message
+type InputMessageVideo struct {
+	meta
+	// Video to be sent. The video is expected to be re-encoded to MPEG4 format with H.264 codec by the sender
+	Video InputFile `json:"video"`
+	// Video thumbnail; pass null to skip thumbnail uploading
+	Thumbnail *InputThumbnail `json:"thumbnail,omitempty"`
+	// Cover of the video; pass null to skip cover uploading; not supported in secret chats and for self-destructing messages
+	Cover InputFile `json:"cover,omitempty"`
+	// Timestamp from which the video playing must start, in seconds
+	StartTimestamp int32 `json:"start_timestamp"`
+	// File identifiers of the stickers added to the video, if applicable
+	AddedStickerFileIds []int32 `json:"added_sticker_file_ids"`
+	// Duration of the video, in seconds
+	Duration int32 `json:"duration"`
+	// Video width
+	Width int32 `json:"width"`
+	// Video height
+	Height int32 `json:"height"`
+	// True, if the video is expected to be streamed
+	SupportsStreaming bool `json:"supports_streaming"`
+	// Video caption; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+	// True, if the caption must be shown above the video; otherwise, the caption must be shown below the video; not supported in secret chats
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// Video self-destruct type; pass null if none; private chats only
+	SelfDestructType MessageSelfDestructType `json:"self_destruct_type,omitempty"`
+	// True, if the video preview must be covered by a spoiler animation; not supported in secret chats
+	HasSpoiler bool `json:"has_spoiler"`
+}
+
+func (*InputMessageVideo) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageVideo) GetConstructor() string {
+	return ConstructorInputMessageVideo
+}
+
+func (*InputMessageVideo) InputMessageContentConstructor() string {
+	return ConstructorInputMessageVideo
+}
+
+func (entity *InputMessageVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video":`)
+	encoder.writeType(entity.Video)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	if entity.Cover != nil {
+		encoder.writeRaw(`,"cover":`)
+		encoder.writeType(entity.Cover)
+	}
+	encoder.writeRaw(`,"start_timestamp":`)
+	encoder.writeInt32(entity.StartTimestamp)
+	encoder.writeRaw(`,"added_sticker_file_ids":`)
+	writeList(encoder, entity.AddedStickerFileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"supports_streaming":`)
+	encoder.writeBool(entity.SupportsStreaming)
+	if entity.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		entity.Caption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"show_caption_above_media":`)
+	encoder.writeBool(entity.ShowCaptionAboveMedia)
+	if entity.SelfDestructType != nil {
+		encoder.writeRaw(`,"self_destruct_type":`)
+		encoder.writeType(entity.SelfDestructType)
+	}
+	encoder.writeRaw(`,"has_spoiler":`)
+	encoder.writeBool(entity.HasSpoiler)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeInputFile(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "cover":
+			entity.Cover, err = decodeInputFile(decoder)
+
+		case "start_timestamp":
+			entity.StartTimestamp, err = decoder.readInt32()
+
+		case "added_sticker_file_ids":
+			entity.AddedStickerFileIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "supports_streaming":
+			entity.SupportsStreaming, err = decoder.readBool()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "show_caption_above_media":
+			entity.ShowCaptionAboveMedia, err = decoder.readBool()
+
+		case "self_destruct_type":
+			entity.SelfDestructType, err = decodeMessageSelfDestructType(decoder)
+
+		case "has_spoiler":
+			entity.HasSpoiler, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessageVideo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("self_destruct_type", entity.SelfDestructType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A video note message
+type InputMessageVideoNote struct {
+	meta
+	// Video note to be sent. The video is expected to be encoded to MPEG4 format with H.264 codec and have no data outside of the visible circle
+	VideoNote InputFile `json:"video_note"`
+	// Video thumbnail; may be null if empty; pass null to skip thumbnail uploading
+	Thumbnail *InputThumbnail `json:"thumbnail,omitempty"`
+	// Duration of the video, in seconds; 0-60
+	Duration int32 `json:"duration"`
+	// Video width and height; must be positive and not greater than 640
+	Length int32 `json:"length"`
+	// Video note self-destruct type; may be null if none; pass null if none; private chats only
+	SelfDestructType MessageSelfDestructType `json:"self_destruct_type,omitempty"`
+}
+
+func (*InputMessageVideoNote) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageVideoNote) GetConstructor() string {
+	return ConstructorInputMessageVideoNote
+}
+
+func (*InputMessageVideoNote) InputMessageContentConstructor() string {
+	return ConstructorInputMessageVideoNote
+}
+
+func (entity *InputMessageVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video_note":`)
+	encoder.writeType(entity.VideoNote)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"length":`)
+	encoder.writeInt32(entity.Length)
+	if entity.SelfDestructType != nil {
+		encoder.writeRaw(`,"self_destruct_type":`)
+		encoder.writeType(entity.SelfDestructType)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video_note":
+			entity.VideoNote, err = decodeInputFile(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "length":
+			entity.Length, err = decoder.readInt32()
+
+		case "self_destruct_type":
+			entity.SelfDestructType, err = decodeMessageSelfDestructType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageVideoNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessageVideoNote) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateMin("length", int64(entity.Length), 1); err != nil {
+		return err
+	}
+
+	if err := validateNested("self_destruct_type", entity.SelfDestructType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A voice note message
+type InputMessageVoiceNote struct {
+	meta
+	// Voice note to be sent. The voice note must be encoded with the Opus codec and stored inside an OGG container with a single audio channel, or be in MP3 or M4A format as regular audio
+	VoiceNote InputFile `json:"voice_note"`
+	// Duration of the voice note, in seconds
+	Duration int32 `json:"duration"`
+	// Waveform representation of the voice note in 5-bit format
+	Waveform []byte `json:"waveform"`
+	// Voice note caption; may be null if empty; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	Caption *FormattedText `json:"caption,omitempty"`
+	// Voice note self-destruct type; may be null if none; pass null if none; private chats only
+	SelfDestructType MessageSelfDestructType `json:"self_destruct_type,omitempty"`
+}
+
+func (*InputMessageVoiceNote) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageVoiceNote) GetConstructor() string {
+	return ConstructorInputMessageVoiceNote
+}
+
+func (*InputMessageVoiceNote) InputMessageContentConstructor() string {
+	return ConstructorInputMessageVoiceNote
+}
+
+func (entity *InputMessageVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"voice_note":`)
+	encoder.writeType(entity.VoiceNote)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"waveform":`)
+	encoder.writeBytes(entity.Waveform)
+	if entity.Caption != nil {
+		encoder.writeRaw(`,"caption":`)
+		entity.Caption.encodeJSON(encoder)
+	}
+	if entity.SelfDestructType != nil {
+		encoder.writeRaw(`,"self_destruct_type":`)
+		encoder.writeType(entity.SelfDestructType)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "voice_note":
+			entity.VoiceNote, err = decodeInputFile(decoder)
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "waveform":
+			entity.Waveform, err = decoder.readBytes()
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		case "self_destruct_type":
+			entity.SelfDestructType, err = decodeMessageSelfDestructType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessageVoiceNote) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("self_destruct_type", entity.SelfDestructType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message with a location
+type InputMessageLocation struct {
+	meta
+	// Location to be sent
+	Location *Location `json:"location"`
+	// Period for which the location can be updated, in seconds; must be between 60 and 86400 for a temporary live location, 0x7FFFFFFF for permanent live location, and 0 otherwise
+	LivePeriod int32 `json:"live_period"`
+	// For live locations, a direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+	Heading int32 `json:"heading"`
+	// For live locations, a maximum distance to another chat member for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled. Can't be enabled in channels and Saved Messages
+	ProximityAlertRadius int32 `json:"proximity_alert_radius"`
+}
+
+func (*InputMessageLocation) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageLocation) GetConstructor() string {
+	return ConstructorInputMessageLocation
+}
+
+func (*InputMessageLocation) InputMessageContentConstructor() string {
+	return ConstructorInputMessageLocation
+}
+
+func (entity *InputMessageLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	encoder.writeRaw(`,"live_period":`)
+	encoder.writeInt32(entity.LivePeriod)
+	encoder.writeRaw(`,"heading":`)
+	encoder.writeInt32(entity.Heading)
+	encoder.writeRaw(`,"proximity_alert_radius":`)
+	encoder.writeInt32(entity.ProximityAlertRadius)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "live_period":
+			entity.LivePeriod, err = decoder.readInt32()
+
+		case "heading":
+			entity.Heading, err = decoder.readInt32()
+
+		case "proximity_alert_radius":
+			entity.ProximityAlertRadius, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with information about a venue
+type InputMessageVenue struct {
+	meta
+	// Venue to send
+	Venue *Venue `json:"venue"`
+}
+
+func (*InputMessageVenue) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageVenue) GetConstructor() string {
+	return ConstructorInputMessageVenue
+}
+
+func (*InputMessageVenue) InputMessageContentConstructor() string {
+	return ConstructorInputMessageVenue
+}
+
+func (entity *InputMessageVenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageVenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageVenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageVenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"venue":`)
+	entity.Venue.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageVenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "venue":
+			entity.Venue, err = decodeEntity[Venue](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message containing a user contact
+type InputMessageContact struct {
+	meta
+	// Contact to send
+	Contact *Contact `json:"contact"`
+}
+
+func (*InputMessageContact) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageContact) GetConstructor() string {
+	return ConstructorInputMessageContact
+}
+
+func (*InputMessageContact) InputMessageContentConstructor() string {
+	return ConstructorInputMessageContact
+}
+
+func (entity *InputMessageContact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageContact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageContact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageContact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"contact":`)
+	entity.Contact.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageContact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "contact":
+			entity.Contact, err = decodeEntity[Contact](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageContact)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessageContact) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("contact", entity.Contact); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A dice message
+type InputMessageDice struct {
+	meta
+	// Emoji on which the dice throw animation is based
+	Emoji string `json:"emoji"`
+	// True, if the chat message draft must be deleted
+	ClearDraft bool `json:"clear_draft"`
+}
+
+func (*InputMessageDice) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageDice) GetConstructor() string {
+	return ConstructorInputMessageDice
+}
+
+func (*InputMessageDice) InputMessageContentConstructor() string {
+	return ConstructorInputMessageDice
+}
+
+func (entity *InputMessageDice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageDice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageDice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageDice"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"clear_draft":`)
+	encoder.writeBool(entity.ClearDraft)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageDice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "clear_draft":
+			entity.ClearDraft, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageDice)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a game; not supported for channels or secret chats
+type InputMessageGame struct {
+	meta
+	// User identifier of the bot that owns the game
+	BotUserId int64 `json:"bot_user_id"`
+	// Short name of the game
+	GameShortName string `json:"game_short_name"`
+}
+
+func (*InputMessageGame) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageGame) GetConstructor() string {
+	return ConstructorInputMessageGame
+}
+
+func (*InputMessageGame) InputMessageContentConstructor() string {
+	return ConstructorInputMessageGame
+}
+
+func (entity *InputMessageGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"game_short_name":`)
+	encoder.writeString(entity.GameShortName)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "game_short_name":
+			entity.GameShortName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with an invoice; can be used only by bots
+type InputMessageInvoice struct {
+	meta
+	// Invoice
+	Invoice *Invoice `json:"invoice"`
+	// Product title; 1-32 characters
+	Title string `json:"title"`
+	// Product description; 0-255 characters
+	Description string `json:"description"`
+	// Product photo URL; optional
+	PhotoUrl string `json:"photo_url"`
+	// Product photo size
+	PhotoSize int32 `json:"photo_size"`
+	// Product photo width
+	PhotoWidth int32 `json:"photo_width"`
+	// Product photo height
+	PhotoHeight int32 `json:"photo_height"`
+	// The invoice payload
+	Payload []byte `json:"payload"`
+	// Payment provider token; may be empty for payments in Telegram Stars
+	ProviderToken string `json:"provider_token"`
+	// JSON-encoded data about the invoice, which will be shared with the payment provider
+	ProviderData string `json:"provider_data"`
+	// Unique invoice bot deep link parameter for the generation of this invoice. If empty, it would be possible to pay directly from forwards of the invoice message
+	StartParameter string `json:"start_parameter"`
+	// The content of paid media attached to the invoice; pass null if none
+	PaidMedia *InputPaidMedia `json:"paid_media,omitempty"`
+	// Paid media caption; pass null to use an empty caption; 0-getOption("message_caption_length_max") characters
+	PaidMediaCaption *FormattedText `json:"paid_media_caption,omitempty"`
+}
+
+func (*InputMessageInvoice) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageInvoice) GetConstructor() string {
+	return ConstructorInputMessageInvoice
+}
+
+func (*InputMessageInvoice) InputMessageContentConstructor() string {
+	return ConstructorInputMessageInvoice
+}
+
+func (entity *InputMessageInvoice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageInvoice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageInvoice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageInvoice"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invoice":`)
+	entity.Invoice.encodeJSON(encoder)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"description":`)
+	encoder.writeString(entity.Description)
+	encoder.writeRaw(`,"photo_url":`)
+	encoder.writeString(entity.PhotoUrl)
+	encoder.writeRaw(`,"photo_size":`)
+	encoder.writeInt32(entity.PhotoSize)
+	encoder.writeRaw(`,"photo_width":`)
+	encoder.writeInt32(entity.PhotoWidth)
+	encoder.writeRaw(`,"photo_height":`)
+	encoder.writeInt32(entity.PhotoHeight)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeBytes(entity.Payload)
+	encoder.writeRaw(`,"provider_token":`)
+	encoder.writeString(entity.ProviderToken)
+	encoder.writeRaw(`,"provider_data":`)
+	encoder.writeString(entity.ProviderData)
+	encoder.writeRaw(`,"start_parameter":`)
+	encoder.writeString(entity.StartParameter)
+	if entity.PaidMedia != nil {
+		encoder.writeRaw(`,"paid_media":`)
+		entity.PaidMedia.encodeJSON(encoder)
+	}
+	if entity.PaidMediaCaption != nil {
+		encoder.writeRaw(`,"paid_media_caption":`)
+		entity.PaidMediaCaption.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageInvoice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invoice":
+			entity.Invoice, err = decodeEntity[Invoice](decoder)
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "description":
+			entity.Description, err = decoder.readString()
+
+		case "photo_url":
+			entity.PhotoUrl, err = decoder.readString()
+
+		case "photo_size":
+			entity.PhotoSize, err = decoder.readInt32()
+
+		case "photo_width":
+			entity.PhotoWidth, err = decoder.readInt32()
+
+		case "photo_height":
+			entity.PhotoHeight, err = decoder.readInt32()
+
+		case "payload":
+			entity.Payload, err = decoder.readBytes()
+
+		case "provider_token":
+			entity.ProviderToken, err = decoder.readString()
+
+		case "provider_data":
+			entity.ProviderData, err = decoder.readString()
+
+		case "start_parameter":
+			entity.StartParameter, err = decoder.readString()
+
+		case "paid_media":
+			entity.PaidMedia, err = decodeEntity[InputPaidMedia](decoder)
+
+		case "paid_media_caption":
+			entity.PaidMediaCaption, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageInvoice)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessageInvoice) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("title", entity.Title, 1, 32); err != nil {
+		return err
+	}
+
+	if err := validateLength("description", entity.Description, 0, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message with a poll. Polls can't be sent to secret chats. Polls can be sent only to a private chat with a bot
+type InputMessagePoll struct {
+	meta
+	// Poll question; 1-255 characters (up to 300 characters for bots). Only custom emoji entities are allowed to be added and only by Premium users
+	Question *FormattedText `json:"question"`
+	// List of poll answer options, 2-10 strings 1-100 characters each. Only custom emoji entities are allowed to be added and only by Premium users
+	Options []*FormattedText `json:"options"`
+	// True, if the poll voters are anonymous. Non-anonymous polls can't be sent or forwarded to channels
+	IsAnonymous bool `json:"is_anonymous"`
+	// Type of the poll
+	Type PollType `json:"type"`
+	// Amount of time the poll will be active after creation, in seconds; for bots only
+	OpenPeriod int32 `json:"open_period"`
+	// Point in time (Unix timestamp) when the poll will automatically be closed; for bots only
+	CloseDate int32 `json:"close_date"`
+	// True, if the poll needs to be sent already closed; for bots only
+	IsClosed bool `json:"is_closed"`
+}
+
+func (*InputMessagePoll) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessagePoll) GetConstructor() string {
+	return ConstructorInputMessagePoll
+}
+
+func (*InputMessagePoll) InputMessageContentConstructor() string {
+	return ConstructorInputMessagePoll
+}
+
+func (entity *InputMessagePoll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessagePoll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessagePoll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessagePoll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"question":`)
+	entity.Question.encodeJSON(encoder)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*FormattedText])
+	encoder.writeRaw(`,"is_anonymous":`)
+	encoder.writeBool(entity.IsAnonymous)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"open_period":`)
+	encoder.writeInt32(entity.OpenPeriod)
+	encoder.writeRaw(`,"close_date":`)
+	encoder.writeInt32(entity.CloseDate)
+	encoder.writeRaw(`,"is_closed":`)
+	encoder.writeBool(entity.IsClosed)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessagePoll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "question":
+			entity.Question, err = decodeEntity[FormattedText](decoder)
+
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[FormattedText])
+
+		case "is_anonymous":
+			entity.IsAnonymous, err = decoder.readBool()
+
+		case "type":
+			entity.Type, err = decodePollType(decoder)
+
+		case "open_period":
+			entity.OpenPeriod, err = decoder.readInt32()
+
+		case "close_date":
+			entity.CloseDate, err = decoder.readInt32()
+
+		case "is_closed":
+			entity.IsClosed, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessagePoll)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputMessagePoll) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateFormattedTextLength("question", entity.Question, 1, 255); err != nil {
+		return err
+	}
+
+	for i, item := range entity.Options {
+		if err := validateFormattedTextLength(indexField("options", i), item, 1, 100); err != nil {
+			return err
+		}
+	}
+
+	if err := validateNested("type", entity.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message with a forwarded story. Stories can't be sent to secret chats. A story can be forwarded only if story.can_be_forwarded
+type InputMessageStory struct {
+	meta
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+}
+
+func (*InputMessageStory) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageStory) GetConstructor() string {
+	return ConstructorInputMessageStory
+}
+
+func (*InputMessageStory) InputMessageContentConstructor() string {
+	return ConstructorInputMessageStory
+}
+
+func (entity *InputMessageStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(entity.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_sender_chat_id":
+			entity.StorySenderChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forwarded message
+type InputMessageForwarded struct {
+	meta
+	// Identifier for the chat this forwarded message came from
+	FromChatId int64 `json:"from_chat_id"`
+	// Identifier of the message to forward. A message can be forwarded only if messageProperties.can_be_forwarded
+	MessageId int64 `json:"message_id"`
+	// Pass true if a game message is being shared from a launched game; applies only to game messages
+	InGameShare bool `json:"in_game_share"`
+	// Pass true to replace video start timestamp in the forwarded message
+	ReplaceVideoStartTimestamp bool `json:"replace_video_start_timestamp"`
+	// The new video start timestamp; ignored if replace_video_start_timestamp == false
+	NewVideoStartTimestamp int32 `json:"new_video_start_timestamp"`
+	// Options to be used to copy content of the message without reference to the original sender; pass null to forward the message as usual
+	CopyOptions *MessageCopyOptions `json:"copy_options,omitempty"`
+}
+
+func (*InputMessageForwarded) GetType() string {
+	return TypeInputMessageContent
+}
+
+func (*InputMessageForwarded) GetConstructor() string {
+	return ConstructorInputMessageForwarded
+}
+
+func (*InputMessageForwarded) InputMessageContentConstructor() string {
+	return ConstructorInputMessageForwarded
+}
+
+func (entity *InputMessageForwarded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputMessageForwarded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputMessageForwarded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputMessageForwarded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"from_chat_id":`)
+	encoder.writeInt64(entity.FromChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"in_game_share":`)
+	encoder.writeBool(entity.InGameShare)
+	encoder.writeRaw(`,"replace_video_start_timestamp":`)
+	encoder.writeBool(entity.ReplaceVideoStartTimestamp)
+	encoder.writeRaw(`,"new_video_start_timestamp":`)
+	encoder.writeInt32(entity.NewVideoStartTimestamp)
+	if entity.CopyOptions != nil {
+		encoder.writeRaw(`,"copy_options":`)
+		entity.CopyOptions.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputMessageForwarded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "from_chat_id":
+			entity.FromChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "in_game_share":
+			entity.InGameShare, err = decoder.readBool()
+
+		case "replace_video_start_timestamp":
+			entity.ReplaceVideoStartTimestamp, err = decoder.readBool()
+
+		case "new_video_start_timestamp":
+			entity.NewVideoStartTimestamp, err = decoder.readInt32()
+
+		case "copy_options":
+			entity.CopyOptions, err = decodeEntity[MessageCopyOptions](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputMessageForwarded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains properties of a message and describes actions that can be done with the message right now
+type MessageProperties struct {
+	meta
+	// True, if content of the message can be copied to a secret chat using inputMessageForwarded or forwardMessages with copy options
+	CanBeCopiedToSecretChat bool `json:"can_be_copied_to_secret_chat"`
+	// True, if the message can be deleted only for the current user while other users will continue to see it using the method deleteMessages with revoke == false
+	CanBeDeletedOnlyForSelf bool `json:"can_be_deleted_only_for_self"`
+	// True, if the message can be deleted for all users using the method deleteMessages with revoke == true
+	CanBeDeletedForAllUsers bool `json:"can_be_deleted_for_all_users"`
+	// True, if the message can be edited using the methods editMessageText, editMessageCaption, or editMessageReplyMarkup. For live location and poll messages this fields shows whether editMessageLiveLocation or stopPoll can be used with this message
+	CanBeEdited bool `json:"can_be_edited"`
+	// True, if the message can be forwarded using inputMessageForwarded or forwardMessages
+	CanBeForwarded bool `json:"can_be_forwarded"`
+	// True, if the message can be paid using inputInvoiceMessage
+	CanBePaid bool `json:"can_be_paid"`
+	// True, if the message can be pinned or unpinned in the chat using pinChatMessage or unpinChatMessage
+	CanBePinned bool `json:"can_be_pinned"`
+	// True, if the message can be replied in the same chat and forum topic using inputMessageReplyToMessage
+	CanBeReplied bool `json:"can_be_replied"`
+	// True, if the message can be replied in another chat or forum topic using inputMessageReplyToExternalMessage
+	CanBeRepliedInAnotherChat bool `json:"can_be_replied_in_another_chat"`
+	// True, if content of the message can be saved locally or copied using inputMessageForwarded or forwardMessages with copy options
+	CanBeSaved bool `json:"can_be_saved"`
+	// True, if the message can be shared in a story using inputStoryAreaTypeMessage
+	CanBeSharedInStory bool `json:"can_be_shared_in_story"`
+	// True, if the message can be edited using the method editMessageMedia
+	CanEditMedia bool `json:"can_edit_media"`
+	// True, if scheduling state of the message can be edited
+	CanEditSchedulingState bool `json:"can_edit_scheduling_state"`
+	// True, if code for message embedding can be received using getMessageEmbeddingCode
+	CanGetEmbeddingCode bool `json:"can_get_embedding_code"`
+	// True, if a link can be generated for the message using getMessageLink
+	CanGetLink bool `json:"can_get_link"`
+	// True, if media timestamp links can be generated for media timestamp entities in the message text, caption or link preview description using getMessageLink
+	CanGetMediaTimestampLinks bool `json:"can_get_media_timestamp_links"`
+	// True, if information about the message thread is available through getMessageThread and getMessageThreadHistory
+	CanGetMessageThread bool `json:"can_get_message_thread"`
+	// True, if read date of the message can be received through getMessageReadDate
+	CanGetReadDate bool `json:"can_get_read_date"`
+	// True, if message statistics are available through getMessageStatistics and message forwards can be received using getMessagePublicForwards
+	CanGetStatistics bool `json:"can_get_statistics"`
+	// True, if chat members already viewed the message can be received through getMessageViewers
+	CanGetViewers bool `json:"can_get_viewers"`
+	// True, if speech can be recognized for the message through recognizeSpeech
+	CanRecognizeSpeech bool `json:"can_recognize_speech"`
+	// True, if the message can be reported using reportChat
+	CanReportChat bool `json:"can_report_chat"`
+	// True, if reactions on the message can be reported through reportMessageReactions
+	CanReportReactions bool `json:"can_report_reactions"`
+	// True, if the message can be reported using reportSupergroupSpam
+	CanReportSupergroupSpam bool `json:"can_report_supergroup_spam"`
+	// True, if fact check for the message can be changed through setMessageFactCheck
+	CanSetFactCheck bool `json:"can_set_fact_check"`
+	// True, if message statistics must be available from context menu of the message
+	NeedShowStatistics bool `json:"need_show_statistics"`
+}
+
+func (*MessageProperties) GetType() string {
+	return TypeMessageProperties
+}
+
+func (*MessageProperties) GetConstructor() string {
+	return ConstructorMessageProperties
+}
+
+func (entity *MessageProperties) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageProperties) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageProperties) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageProperties"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_be_copied_to_secret_chat":`)
+	encoder.writeBool(entity.CanBeCopiedToSecretChat)
+	encoder.writeRaw(`,"can_be_deleted_only_for_self":`)
+	encoder.writeBool(entity.CanBeDeletedOnlyForSelf)
+	encoder.writeRaw(`,"can_be_deleted_for_all_users":`)
+	encoder.writeBool(entity.CanBeDeletedForAllUsers)
+	encoder.writeRaw(`,"can_be_edited":`)
+	encoder.writeBool(entity.CanBeEdited)
+	encoder.writeRaw(`,"can_be_forwarded":`)
+	encoder.writeBool(entity.CanBeForwarded)
+	encoder.writeRaw(`,"can_be_paid":`)
+	encoder.writeBool(entity.CanBePaid)
+	encoder.writeRaw(`,"can_be_pinned":`)
+	encoder.writeBool(entity.CanBePinned)
+	encoder.writeRaw(`,"can_be_replied":`)
+	encoder.writeBool(entity.CanBeReplied)
+	encoder.writeRaw(`,"can_be_replied_in_another_chat":`)
+	encoder.writeBool(entity.CanBeRepliedInAnotherChat)
+	encoder.writeRaw(`,"can_be_saved":`)
+	encoder.writeBool(entity.CanBeSaved)
+	encoder.writeRaw(`,"can_be_shared_in_story":`)
+	encoder.writeBool(entity.CanBeSharedInStory)
+	encoder.writeRaw(`,"can_edit_media":`)
+	encoder.writeBool(entity.CanEditMedia)
+	encoder.writeRaw(`,"can_edit_scheduling_state":`)
+	encoder.writeBool(entity.CanEditSchedulingState)
+	encoder.writeRaw(`,"can_get_embedding_code":`)
+	encoder.writeBool(entity.CanGetEmbeddingCode)
+	encoder.writeRaw(`,"can_get_link":`)
+	encoder.writeBool(entity.CanGetLink)
+	encoder.writeRaw(`,"can_get_media_timestamp_links":`)
+	encoder.writeBool(entity.CanGetMediaTimestampLinks)
+	encoder.writeRaw(`,"can_get_message_thread":`)
+	encoder.writeBool(entity.CanGetMessageThread)
+	encoder.writeRaw(`,"can_get_read_date":`)
+	encoder.writeBool(entity.CanGetReadDate)
+	encoder.writeRaw(`,"can_get_statistics":`)
+	encoder.writeBool(entity.CanGetStatistics)
+	encoder.writeRaw(`,"can_get_viewers":`)
+	encoder.writeBool(entity.CanGetViewers)
+	encoder.writeRaw(`,"can_recognize_speech":`)
+	encoder.writeBool(entity.CanRecognizeSpeech)
+	encoder.writeRaw(`,"can_report_chat":`)
+	encoder.writeBool(entity.CanReportChat)
+	encoder.writeRaw(`,"can_report_reactions":`)
+	encoder.writeBool(entity.CanReportReactions)
+	encoder.writeRaw(`,"can_report_supergroup_spam":`)
+	encoder.writeBool(entity.CanReportSupergroupSpam)
+	encoder.writeRaw(`,"can_set_fact_check":`)
+	encoder.writeBool(entity.CanSetFactCheck)
+	encoder.writeRaw(`,"need_show_statistics":`)
+	encoder.writeBool(entity.NeedShowStatistics)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageProperties) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_be_copied_to_secret_chat":
+			entity.CanBeCopiedToSecretChat, err = decoder.readBool()
+
+		case "can_be_deleted_only_for_self":
+			entity.CanBeDeletedOnlyForSelf, err = decoder.readBool()
+
+		case "can_be_deleted_for_all_users":
+			entity.CanBeDeletedForAllUsers, err = decoder.readBool()
+
+		case "can_be_edited":
+			entity.CanBeEdited, err = decoder.readBool()
+
+		case "can_be_forwarded":
+			entity.CanBeForwarded, err = decoder.readBool()
+
+		case "can_be_paid":
+			entity.CanBePaid, err = decoder.readBool()
+
+		case "can_be_pinned":
+			entity.CanBePinned, err = decoder.readBool()
+
+		case "can_be_replied":
+			entity.CanBeReplied, err = decoder.readBool()
+
+		case "can_be_replied_in_another_chat":
+			entity.CanBeRepliedInAnotherChat, err = decoder.readBool()
+
+		case "can_be_saved":
+			entity.CanBeSaved, err = decoder.readBool()
+
+		case "can_be_shared_in_story":
+			entity.CanBeSharedInStory, err = decoder.readBool()
+
+		case "can_edit_media":
+			entity.CanEditMedia, err = decoder.readBool()
+
+		case "can_edit_scheduling_state":
+			entity.CanEditSchedulingState, err = decoder.readBool()
+
+		case "can_get_embedding_code":
+			entity.CanGetEmbeddingCode, err = decoder.readBool()
+
+		case "can_get_link":
+			entity.CanGetLink, err = decoder.readBool()
+
+		case "can_get_media_timestamp_links":
+			entity.CanGetMediaTimestampLinks, err = decoder.readBool()
+
+		case "can_get_message_thread":
+			entity.CanGetMessageThread, err = decoder.readBool()
+
+		case "can_get_read_date":
+			entity.CanGetReadDate, err = decoder.readBool()
+
+		case "can_get_statistics":
+			entity.CanGetStatistics, err = decoder.readBool()
+
+		case "can_get_viewers":
+			entity.CanGetViewers, err = decoder.readBool()
+
+		case "can_recognize_speech":
+			entity.CanRecognizeSpeech, err = decoder.readBool()
+
+		case "can_report_chat":
+			entity.CanReportChat, err = decoder.readBool()
+
+		case "can_report_reactions":
+			entity.CanReportReactions, err = decoder.readBool()
+
+		case "can_report_supergroup_spam":
+			entity.CanReportSupergroupSpam, err = decoder.readBool()
+
+		case "can_set_fact_check":
+			entity.CanSetFactCheck, err = decoder.readBool()
+
+		case "need_show_statistics":
+			entity.NeedShowStatistics, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageProperties)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Returns all found messages, no filter is applied
+type SearchMessagesFilterEmpty struct {
+	meta
+}
+
+func (*SearchMessagesFilterEmpty) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterEmpty) GetConstructor() string {
+	return ConstructorSearchMessagesFilterEmpty
+}
+
+func (*SearchMessagesFilterEmpty) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterEmpty
+}
+
+func (entity *SearchMessagesFilterEmpty) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterEmpty) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterEmpty) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterEmpty"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterEmpty) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterEmpty)
+	})
+}
+
+// Returns only animation messages
+type SearchMessagesFilterAnimation struct {
+	meta
+}
+
+func (*SearchMessagesFilterAnimation) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterAnimation) GetConstructor() string {
+	return ConstructorSearchMessagesFilterAnimation
+}
+
+func (*SearchMessagesFilterAnimation) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterAnimation
+}
+
+func (entity *SearchMessagesFilterAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterAnimation)
+	})
+}
+
+// Returns only audio messages
+type SearchMessagesFilterAudio struct {
+	meta
+}
+
+func (*SearchMessagesFilterAudio) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterAudio) GetConstructor() string {
+	return ConstructorSearchMessagesFilterAudio
+}
+
+func (*SearchMessagesFilterAudio) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterAudio
+}
+
+func (entity *SearchMessagesFilterAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterAudio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterAudio)
+	})
+}
+
+// Returns only document messages
+type SearchMessagesFilterDocument struct {
+	meta
+}
+
+func (*SearchMessagesFilterDocument) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterDocument) GetConstructor() string {
+	return ConstructorSearchMessagesFilterDocument
+}
+
+func (*SearchMessagesFilterDocument) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterDocument
+}
+
+func (entity *SearchMessagesFilterDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterDocument"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterDocument)
+	})
+}
+
+// Returns only photo messages
+type SearchMessagesFilterPhoto struct {
+	meta
+}
+
+func (*SearchMessagesFilterPhoto) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterPhoto) GetConstructor() string {
+	return ConstructorSearchMessagesFilterPhoto
+}
+
+func (*SearchMessagesFilterPhoto) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterPhoto
+}
+
+func (entity *SearchMessagesFilterPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterPhoto)
+	})
+}
+
+// Returns only video messages
+type SearchMessagesFilterVideo struct {
+	meta
+}
+
+func (*SearchMessagesFilterVideo) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterVideo) GetConstructor() string {
+	return ConstructorSearchMessagesFilterVideo
+}
+
+func (*SearchMessagesFilterVideo) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterVideo
+}
+
+func (entity *SearchMessagesFilterVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterVideo)
+	})
+}
+
+// Returns only voice note messages
+type SearchMessagesFilterVoiceNote struct {
+	meta
+}
+
+func (*SearchMessagesFilterVoiceNote) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterVoiceNote) GetConstructor() string {
+	return ConstructorSearchMessagesFilterVoiceNote
+}
+
+func (*SearchMessagesFilterVoiceNote) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterVoiceNote
+}
+
+func (entity *SearchMessagesFilterVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterVoiceNote)
+	})
+}
+
+// Returns only photo and video messages
+type SearchMessagesFilterPhotoAndVideo struct {
+	meta
+}
+
+func (*SearchMessagesFilterPhotoAndVideo) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterPhotoAndVideo) GetConstructor() string {
+	return ConstructorSearchMessagesFilterPhotoAndVideo
+}
+
+func (*SearchMessagesFilterPhotoAndVideo) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterPhotoAndVideo
+}
+
+func (entity *SearchMessagesFilterPhotoAndVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterPhotoAndVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterPhotoAndVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterPhotoAndVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterPhotoAndVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterPhotoAndVideo)
+	})
+}
+
+// Returns only messages containing URLs
+type SearchMessagesFilterUrl struct {
+	meta
+}
+
+func (*SearchMessagesFilterUrl) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterUrl) GetConstructor() string {
+	return ConstructorSearchMessagesFilterUrl
+}
+
+func (*SearchMessagesFilterUrl) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterUrl
+}
+
+func (entity *SearchMessagesFilterUrl) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterUrl) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterUrl) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterUrl"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterUrl) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterUrl)
+	})
+}
+
+// Returns only messages containing chat photos
+type SearchMessagesFilterChatPhoto struct {
+	meta
+}
+
+func (*SearchMessagesFilterChatPhoto) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterChatPhoto) GetConstructor() string {
+	return ConstructorSearchMessagesFilterChatPhoto
+}
+
+func (*SearchMessagesFilterChatPhoto) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterChatPhoto
+}
+
+func (entity *SearchMessagesFilterChatPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterChatPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterChatPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterChatPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterChatPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterChatPhoto)
+	})
+}
+
+// Returns only video note messages
+type SearchMessagesFilterVideoNote struct {
+	meta
+}
+
+func (*SearchMessagesFilterVideoNote) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterVideoNote) GetConstructor() string {
+	return ConstructorSearchMessagesFilterVideoNote
+}
+
+func (*SearchMessagesFilterVideoNote) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterVideoNote
+}
+
+func (entity *SearchMessagesFilterVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterVideoNote)
+	})
+}
+
+// Returns only voice and video note messages
+type SearchMessagesFilterVoiceAndVideoNote struct {
+	meta
+}
+
+func (*SearchMessagesFilterVoiceAndVideoNote) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterVoiceAndVideoNote) GetConstructor() string {
+	return ConstructorSearchMessagesFilterVoiceAndVideoNote
+}
+
+func (*SearchMessagesFilterVoiceAndVideoNote) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterVoiceAndVideoNote
+}
+
+func (entity *SearchMessagesFilterVoiceAndVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterVoiceAndVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterVoiceAndVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterVoiceAndVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterVoiceAndVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterVoiceAndVideoNote)
+	})
+}
+
+// Returns only messages with mentions of the current user, or messages that are replies to their messages
+type SearchMessagesFilterMention struct {
+	meta
+}
+
+func (*SearchMessagesFilterMention) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterMention) GetConstructor() string {
+	return ConstructorSearchMessagesFilterMention
+}
+
+func (*SearchMessagesFilterMention) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterMention
+}
+
+func (entity *SearchMessagesFilterMention) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterMention) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterMention) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterMention"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterMention) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterMention)
+	})
+}
+
+// Returns only messages with unread mentions of the current user, or messages that are replies to their messages. When using this filter the results can't be additionally filtered by a query, a message thread or by the sending user
+type SearchMessagesFilterUnreadMention struct {
+	meta
+}
+
+func (*SearchMessagesFilterUnreadMention) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterUnreadMention) GetConstructor() string {
+	return ConstructorSearchMessagesFilterUnreadMention
+}
+
+func (*SearchMessagesFilterUnreadMention) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterUnreadMention
+}
+
+func (entity *SearchMessagesFilterUnreadMention) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterUnreadMention) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterUnreadMention) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterUnreadMention"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterUnreadMention) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterUnreadMention)
+	})
+}
+
+// Returns only messages with unread reactions for the current user. When using this filter the results can't be additionally filtered by a query, a message thread or by the sending user
+type SearchMessagesFilterUnreadReaction struct {
+	meta
+}
+
+func (*SearchMessagesFilterUnreadReaction) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterUnreadReaction) GetConstructor() string {
+	return ConstructorSearchMessagesFilterUnreadReaction
+}
+
+func (*SearchMessagesFilterUnreadReaction) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterUnreadReaction
+}
+
+func (entity *SearchMessagesFilterUnreadReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterUnreadReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterUnreadReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterUnreadReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterUnreadReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterUnreadReaction)
+	})
+}
+
+// Returns only failed to send messages. This filter can be used only if the message database is used
+type SearchMessagesFilterFailedToSend struct {
+	meta
+}
+
+func (*SearchMessagesFilterFailedToSend) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterFailedToSend) GetConstructor() string {
+	return ConstructorSearchMessagesFilterFailedToSend
+}
+
+func (*SearchMessagesFilterFailedToSend) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterFailedToSend
+}
+
+func (entity *SearchMessagesFilterFailedToSend) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterFailedToSend) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterFailedToSend) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterFailedToSend"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterFailedToSend) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterFailedToSend)
+	})
+}
+
+// Returns only pinned messages
+type SearchMessagesFilterPinned struct {
+	meta
+}
+
+func (*SearchMessagesFilterPinned) GetType() string {
+	return TypeSearchMessagesFilter
+}
+
+func (*SearchMessagesFilterPinned) GetConstructor() string {
+	return ConstructorSearchMessagesFilterPinned
+}
+
+func (*SearchMessagesFilterPinned) SearchMessagesFilterConstructor() string {
+	return ConstructorSearchMessagesFilterPinned
+}
+
+func (entity *SearchMessagesFilterPinned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesFilterPinned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesFilterPinned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesFilterPinned"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesFilterPinned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesFilterPinned)
+	})
+}
+
+// Returns only messages in private chats
+type SearchMessagesChatTypeFilterPrivate struct {
+	meta
+}
+
+func (*SearchMessagesChatTypeFilterPrivate) GetType() string {
+	return TypeSearchMessagesChatTypeFilter
+}
+
+func (*SearchMessagesChatTypeFilterPrivate) GetConstructor() string {
+	return ConstructorSearchMessagesChatTypeFilterPrivate
+}
+
+func (*SearchMessagesChatTypeFilterPrivate) SearchMessagesChatTypeFilterConstructor() string {
+	return ConstructorSearchMessagesChatTypeFilterPrivate
+}
+
+func (entity *SearchMessagesChatTypeFilterPrivate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesChatTypeFilterPrivate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesChatTypeFilterPrivate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesChatTypeFilterPrivate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesChatTypeFilterPrivate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesChatTypeFilterPrivate)
+	})
+}
+
+// Returns only messages in basic group and supergroup chats
+type SearchMessagesChatTypeFilterGroup struct {
+	meta
+}
+
+func (*SearchMessagesChatTypeFilterGroup) GetType() string {
+	return TypeSearchMessagesChatTypeFilter
+}
+
+func (*SearchMessagesChatTypeFilterGroup) GetConstructor() string {
+	return ConstructorSearchMessagesChatTypeFilterGroup
+}
+
+func (*SearchMessagesChatTypeFilterGroup) SearchMessagesChatTypeFilterConstructor() string {
+	return ConstructorSearchMessagesChatTypeFilterGroup
+}
+
+func (entity *SearchMessagesChatTypeFilterGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesChatTypeFilterGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesChatTypeFilterGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesChatTypeFilterGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesChatTypeFilterGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesChatTypeFilterGroup)
+	})
+}
+
+// Returns only messages in channel chats
+type SearchMessagesChatTypeFilterChannel struct {
+	meta
+}
+
+func (*SearchMessagesChatTypeFilterChannel) GetType() string {
+	return TypeSearchMessagesChatTypeFilter
+}
+
+func (*SearchMessagesChatTypeFilterChannel) GetConstructor() string {
+	return ConstructorSearchMessagesChatTypeFilterChannel
+}
+
+func (*SearchMessagesChatTypeFilterChannel) SearchMessagesChatTypeFilterConstructor() string {
+	return ConstructorSearchMessagesChatTypeFilterChannel
+}
+
+func (entity *SearchMessagesChatTypeFilterChannel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SearchMessagesChatTypeFilterChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SearchMessagesChatTypeFilterChannel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"searchMessagesChatTypeFilterChannel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *SearchMessagesChatTypeFilterChannel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorSearchMessagesChatTypeFilterChannel)
+	})
+}
+
+// Describes a message that can be used for quick reply
+type QuickReplyMessage struct {
+	meta
+	// Unique message identifier among all quick replies
+	Id int64 `json:"id"`
+	// The sending state of the message; may be null if the message isn't being sent and didn't fail to be sent
+	SendingState MessageSendingState `json:"sending_state,omitempty"`
+	// True, if the message can be edited
+	CanBeEdited bool `json:"can_be_edited"`
+	// The identifier of the quick reply message to which the message replies; 0 if none
+	ReplyToMessageId int64 `json:"reply_to_message_id"`
+	// If non-zero, the user identifier of the bot through which this message was sent
+	ViaBotUserId int64 `json:"via_bot_user_id"`
+	// Unique identifier of an album this message belongs to; 0 if none. Only audios, documents, photos and videos can be grouped together in albums
+	MediaAlbumId JsonInt64 `json:"media_album_id"`
+	// Content of the message
+	Content MessageContent `json:"content"`
+	// Inline keyboard reply markup for the message; may be null if none
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (*QuickReplyMessage) GetType() string {
+	return TypeQuickReplyMessage
+}
+
+func (*QuickReplyMessage) GetConstructor() string {
+	return ConstructorQuickReplyMessage
+}
+
+func (entity *QuickReplyMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *QuickReplyMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *QuickReplyMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"quickReplyMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	if entity.SendingState != nil {
+		encoder.writeRaw(`,"sending_state":`)
+		encoder.writeType(entity.SendingState)
+	}
+	encoder.writeRaw(`,"can_be_edited":`)
+	encoder.writeBool(entity.CanBeEdited)
+	encoder.writeRaw(`,"reply_to_message_id":`)
+	encoder.writeInt64(entity.ReplyToMessageId)
+	encoder.writeRaw(`,"via_bot_user_id":`)
+	encoder.writeInt64(entity.ViaBotUserId)
+	encoder.writeRaw(`,"media_album_id":`)
+	encoder.writeJsonInt64(entity.MediaAlbumId)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(entity.Content)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *QuickReplyMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "sending_state":
+			entity.SendingState, err = decodeMessageSendingState(decoder)
+
+		case "can_be_edited":
+			entity.CanBeEdited, err = decoder.readBool()
+
+		case "reply_to_message_id":
+			entity.ReplyToMessageId, err = decoder.readInt64()
+
+		case "via_bot_user_id":
+			entity.ViaBotUserId, err = decoder.readInt64()
+
+		case "media_album_id":
+			entity.MediaAlbumId, err = decoder.readJsonInt64()
+
+		case "content":
+			entity.Content, err = decodeMessageContent(decoder)
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorQuickReplyMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *QuickReplyMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("content", entity.Content); err != nil {
+		return err
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a list of quick reply messages
+type QuickReplyMessages struct {
+	meta
+	// List of quick reply messages; messages may be null
+	Messages []*QuickReplyMessage `json:"messages,omitempty"`
+}
+
+func (*QuickReplyMessages) GetType() string {
+	return TypeQuickReplyMessages
+}
+
+func (*QuickReplyMessages) GetConstructor() string {
+	return ConstructorQuickReplyMessages
+}
+
+func (entity *QuickReplyMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *QuickReplyMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *QuickReplyMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"quickReplyMessages"`)
+	encoder.writeMeta(&entity.meta)
+	if len(entity.Messages) > 0 {
+		encoder.writeRaw(`,"messages":`)
+		writeList(encoder, entity.Messages, writeTypeValue[*QuickReplyMessage])
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *QuickReplyMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[QuickReplyMessage])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorQuickReplyMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *QuickReplyMessages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Describes a shortcut that can be used for a quick reply
+type QuickReplyShortcut struct {
+	meta
+	// Unique shortcut identifier
+	Id int32 `json:"id"`
+	// The name of the shortcut that can be used to use the shortcut
+	Name string `json:"name"`
+	// The first shortcut message
+	FirstMessage *QuickReplyMessage `json:"first_message"`
+	// The total number of messages in the shortcut
+	MessageCount int32 `json:"message_count"`
+}
+
+func (*QuickReplyShortcut) GetType() string {
+	return TypeQuickReplyShortcut
+}
+
+func (*QuickReplyShortcut) GetConstructor() string {
+	return ConstructorQuickReplyShortcut
+}
+
+func (entity *QuickReplyShortcut) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *QuickReplyShortcut) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *QuickReplyShortcut) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"quickReplyShortcut"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"first_message":`)
+	entity.FirstMessage.encodeJSON(encoder)
+	encoder.writeRaw(`,"message_count":`)
+	encoder.writeInt32(entity.MessageCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *QuickReplyShortcut) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "first_message":
+			entity.FirstMessage, err = decodeEntity[QuickReplyMessage](decoder)
+
+		case "message_count":
+			entity.MessageCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorQuickReplyShortcut)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *QuickReplyShortcut) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("first_message", entity.FirstMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a public forward as a message
+type PublicForwardMessage struct {
+	meta
+	// Information about the message
+	Message *Message `json:"message"`
+}
+
+func (*PublicForwardMessage) GetType() string {
+	return TypePublicForward
+}
+
+func (*PublicForwardMessage) GetConstructor() string {
+	return ConstructorPublicForwardMessage
+}
+
+func (*PublicForwardMessage) PublicForwardConstructor() string {
+	return ConstructorPublicForwardMessage
+}
+
+func (entity *PublicForwardMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PublicForwardMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PublicForwardMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"publicForwardMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PublicForwardMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPublicForwardMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PublicForwardMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains a public repost to a story
+type PublicForwardStory struct {
+	meta
+	// Information about the story
+	Story *Story `json:"story"`
+}
+
+func (*PublicForwardStory) GetType() string {
+	return TypePublicForward
+}
+
+func (*PublicForwardStory) GetConstructor() string {
+	return ConstructorPublicForwardStory
+}
+
+func (*PublicForwardStory) PublicForwardConstructor() string {
+	return ConstructorPublicForwardStory
+}
+
+func (entity *PublicForwardStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PublicForwardStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PublicForwardStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"publicForwardStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story":`)
+	entity.Story.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PublicForwardStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story":
+			entity.Story, err = decodeEntity[Story](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPublicForwardStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about the message sent by answerWebAppQuery
+type SentWebAppMessage struct {
+	meta
+	// Identifier of the sent inline message, if known
+	InlineMessageId string `json:"inline_message_id"`
+}
+
+func (*SentWebAppMessage) GetType() string {
+	return TypeSentWebAppMessage
+}
+
+func (*SentWebAppMessage) GetConstructor() string {
+	return ConstructorSentWebAppMessage
+}
+
+func (entity *SentWebAppMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SentWebAppMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SentWebAppMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sentWebAppMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(entity.InlineMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *SentWebAppMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "inline_message_id":
+			entity.InlineMessageId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSentWebAppMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an inline message that can be sent via the bot
+type PreparedInlineMessageId struct {
+	meta
+	// Unique identifier for the message
+	Id string `json:"id"`
+	// Point in time (Unix timestamp) when the message can't be used anymore
+	ExpirationDate int32 `json:"expiration_date"`
+}
+
+func (*PreparedInlineMessageId) GetType() string {
+	return TypePreparedInlineMessageId
+}
+
+func (*PreparedInlineMessageId) GetConstructor() string {
+	return ConstructorPreparedInlineMessageId
+}
+
+func (entity *PreparedInlineMessageId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PreparedInlineMessageId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PreparedInlineMessageId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"preparedInlineMessageId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(entity.ExpirationDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *PreparedInlineMessageId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPreparedInlineMessageId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a ready to send inline message. Use sendInlineQueryResultMessage to send the message
+type PreparedInlineMessage struct {
+	meta
+	// Unique identifier of the inline query to pass to sendInlineQueryResultMessage
+	InlineQueryId JsonInt64 `json:"inline_query_id"`
+	// Resulted inline message of the query
+	Result InlineQueryResult `json:"result"`
+	// Types of the chats to which the message can be sent
+	ChatTypes *TargetChatTypes `json:"chat_types"`
+}
+
+func (*PreparedInlineMessage) GetType() string {
+	return TypePreparedInlineMessage
+}
+
+func (*PreparedInlineMessage) GetConstructor() string {
+	return ConstructorPreparedInlineMessage
+}
+
+func (entity *PreparedInlineMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PreparedInlineMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PreparedInlineMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"preparedInlineMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"inline_query_id":`)
+	encoder.writeJsonInt64(entity.InlineQueryId)
+	encoder.writeRaw(`,"result":`)
+	encoder.writeType(entity.Result)
+	encoder.writeRaw(`,"chat_types":`)
+	entity.ChatTypes.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PreparedInlineMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "inline_query_id":
+			entity.InlineQueryId, err = decoder.readJsonInt64()
+
+		case "result":
+			entity.Result, err = decodeInlineQueryResult(decoder)
+
+		case "chat_types":
+			entity.ChatTypes, err = decodeEntity[TargetChatTypes](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPreparedInlineMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *PreparedInlineMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("result", entity.Result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The messages were exported from a private chat
+type MessageFileTypePrivate struct {
+	meta
+	// Name of the other party; may be empty if unrecognized
+	Name string `json:"name"`
+}
+
+func (*MessageFileTypePrivate) GetType() string {
+	return TypeMessageFileType
+}
+
+func (*MessageFileTypePrivate) GetConstructor() string {
+	return ConstructorMessageFileTypePrivate
+}
+
+func (*MessageFileTypePrivate) MessageFileTypeConstructor() string {
+	return ConstructorMessageFileTypePrivate
+}
+
+func (entity *MessageFileTypePrivate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageFileTypePrivate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageFileTypePrivate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageFileTypePrivate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageFileTypePrivate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageFileTypePrivate)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The messages were exported from a group chat
+type MessageFileTypeGroup struct {
+	meta
+	// Title of the group chat; may be empty if unrecognized
+	Title string `json:"title"`
+}
+
+func (*MessageFileTypeGroup) GetType() string {
+	return TypeMessageFileType
+}
+
+func (*MessageFileTypeGroup) GetConstructor() string {
+	return ConstructorMessageFileTypeGroup
+}
+
+func (*MessageFileTypeGroup) MessageFileTypeConstructor() string {
+	return ConstructorMessageFileTypeGroup
+}
+
+func (entity *MessageFileTypeGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageFileTypeGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageFileTypeGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageFileTypeGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageFileTypeGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageFileTypeGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The messages were exported from a chat of unknown type
+type MessageFileTypeUnknown struct {
+	meta
+}
+
+func (*MessageFileTypeUnknown) GetType() string {
+	return TypeMessageFileType
+}
+
+func (*MessageFileTypeUnknown) GetConstructor() string {
+	return ConstructorMessageFileTypeUnknown
+}
+
+func (*MessageFileTypeUnknown) MessageFileTypeConstructor() string {
+	return ConstructorMessageFileTypeUnknown
+}
+
+func (entity *MessageFileTypeUnknown) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageFileTypeUnknown) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageFileTypeUnknown) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageFileTypeUnknown"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageFileTypeUnknown) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorMessageFileTypeUnknown)
+	})
+}
+
+// A general message with hidden content
+type PushMessageContentHidden struct {
+	meta
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentHidden) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentHidden) GetConstructor() string {
+	return ConstructorPushMessageContentHidden
+}
+
+func (*PushMessageContentHidden) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentHidden
+}
+
+func (entity *PushMessageContentHidden) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentHidden) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentHidden) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentHidden"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentHidden) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentHidden)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An animation message (GIF-style).
+type PushMessageContentAnimation struct {
+	meta
+	// Message content; may be null
+	Animation *Animation `json:"animation,omitempty"`
+	// Animation caption
+	Caption string `json:"caption"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentAnimation) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentAnimation) GetConstructor() string {
+	return ConstructorPushMessageContentAnimation
+}
+
+func (*PushMessageContentAnimation) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentAnimation
+}
+
+func (entity *PushMessageContentAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Animation != nil {
+		encoder.writeRaw(`,"animation":`)
+		entity.Animation.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	encoder.writeString(entity.Caption)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		case "caption":
+			entity.Caption, err = decoder.readString()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An audio message
+type PushMessageContentAudio struct {
+	meta
+	// Message content; may be null
+	Audio *Audio `json:"audio,omitempty"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentAudio) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentAudio) GetConstructor() string {
+	return ConstructorPushMessageContentAudio
+}
+
+func (*PushMessageContentAudio) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentAudio
+}
+
+func (entity *PushMessageContentAudio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentAudio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentAudio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentAudio"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Audio != nil {
+		encoder.writeRaw(`,"audio":`)
+		entity.Audio.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentAudio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "audio":
+			entity.Audio, err = decodeEntity[Audio](decoder)
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentAudio)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a user contact
+type PushMessageContentContact struct {
+	meta
+	// Contact's name
+	Name string `json:"name"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentContact) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentContact) GetConstructor() string {
+	return ConstructorPushMessageContentContact
+}
+
+func (*PushMessageContentContact) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentContact
+}
+
+func (entity *PushMessageContentContact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentContact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentContact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentContact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentContact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentContact)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A contact has registered with Telegram
+type PushMessageContentContactRegistered struct {
+	meta
+}
+
+func (*PushMessageContentContactRegistered) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentContactRegistered) GetConstructor() string {
+	return ConstructorPushMessageContentContactRegistered
+}
+
+func (*PushMessageContentContactRegistered) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentContactRegistered
+}
+
+func (entity *PushMessageContentContactRegistered) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentContactRegistered) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentContactRegistered) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentContactRegistered"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentContactRegistered) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentContactRegistered)
+	})
+}
+
+// A document message (a general file)
+type PushMessageContentDocument struct {
+	meta
+	// Message content; may be null
+	Document *Document `json:"document,omitempty"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentDocument) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentDocument) GetConstructor() string {
+	return ConstructorPushMessageContentDocument
+}
+
+func (*PushMessageContentDocument) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentDocument
+}
+
+func (entity *PushMessageContentDocument) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentDocument) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentDocument) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentDocument"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Document != nil {
+		encoder.writeRaw(`,"document":`)
+		entity.Document.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentDocument) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "document":
+			entity.Document, err = decodeEntity[Document](decoder)
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentDocument)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a game
+type PushMessageContentGame struct {
+	meta
+	// Game title, empty for pinned game message
+	Title string `json:"title"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentGame) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentGame) GetConstructor() string {
+	return ConstructorPushMessageContentGame
+}
+
+func (*PushMessageContentGame) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentGame
+}
+
+func (entity *PushMessageContentGame) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentGame) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentGame) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentGame"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentGame) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentGame)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new high score was achieved in a game
+type PushMessageContentGameScore struct {
+	meta
+	// Game title, empty for pinned message
+	Title string `json:"title"`
+	// New score, 0 for pinned message
+	Score int32 `json:"score"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentGameScore) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentGameScore) GetConstructor() string {
+	return ConstructorPushMessageContentGameScore
+}
+
+func (*PushMessageContentGameScore) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentGameScore
+}
+
+func (entity *PushMessageContentGameScore) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentGameScore) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentGameScore) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentGameScore"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"score":`)
+	encoder.writeInt32(entity.Score)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentGameScore) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "score":
+			entity.Score, err = decoder.readInt32()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentGameScore)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with an invoice from a bot
+type PushMessageContentInvoice struct {
+	meta
+	// Product price
+	Price string `json:"price"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentInvoice) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentInvoice) GetConstructor() string {
+	return ConstructorPushMessageContentInvoice
+}
+
+func (*PushMessageContentInvoice) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentInvoice
+}
+
+func (entity *PushMessageContentInvoice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentInvoice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentInvoice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentInvoice"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"price":`)
+	encoder.writeString(entity.Price)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentInvoice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "price":
+			entity.Price, err = decoder.readString()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentInvoice)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a location
+type PushMessageContentLocation struct {
+	meta
+	// True, if the location is live
+	IsLive bool `json:"is_live"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentLocation) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentLocation) GetConstructor() string {
+	return ConstructorPushMessageContentLocation
+}
+
+func (*PushMessageContentLocation) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentLocation
+}
+
+func (entity *PushMessageContentLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_live":`)
+	encoder.writeBool(entity.IsLive)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_live":
+			entity.IsLive, err = decoder.readBool()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with paid media
+type PushMessageContentPaidMedia struct {
+	meta
+	// Number of Telegram Stars needed to buy access to the media in the message; 0 for pinned message
+	StarCount int64 `json:"star_count"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentPaidMedia) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentPaidMedia) GetConstructor() string {
+	return ConstructorPushMessageContentPaidMedia
+}
+
+func (*PushMessageContentPaidMedia) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentPaidMedia
+}
+
+func (entity *PushMessageContentPaidMedia) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentPaidMedia) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentPaidMedia) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentPaidMedia"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentPaidMedia) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentPaidMedia)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A photo message
+type PushMessageContentPhoto struct {
+	meta
+	// Message content; may be null
+	Photo *Photo `json:"photo,omitempty"`
+	// Photo caption
+	Caption string `json:"caption"`
+	// True, if the photo is secret
+	IsSecret bool `json:"is_secret"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentPhoto) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentPhoto) GetConstructor() string {
+	return ConstructorPushMessageContentPhoto
+}
+
+func (*PushMessageContentPhoto) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentPhoto
+}
+
+func (entity *PushMessageContentPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	encoder.writeString(entity.Caption)
+	encoder.writeRaw(`,"is_secret":`)
+	encoder.writeBool(entity.IsSecret)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "caption":
+			entity.Caption, err = decoder.readString()
+
+		case "is_secret":
+			entity.IsSecret, err = decoder.readBool()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a poll
+type PushMessageContentPoll struct {
+	meta
+	// Poll question
+	Question string `json:"question"`
+	// True, if the poll is regular and not in quiz mode
+	IsRegular bool `json:"is_regular"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentPoll) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentPoll) GetConstructor() string {
+	return ConstructorPushMessageContentPoll
+}
+
+func (*PushMessageContentPoll) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentPoll
+}
+
+func (entity *PushMessageContentPoll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentPoll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentPoll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentPoll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"question":`)
+	encoder.writeString(entity.Question)
+	encoder.writeRaw(`,"is_regular":`)
+	encoder.writeBool(entity.IsRegular)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentPoll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "question":
+			entity.Question, err = decoder.readString()
+
+		case "is_regular":
+			entity.IsRegular, err = decoder.readBool()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentPoll)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a Telegram Premium gift code created for the user
+type PushMessageContentPremiumGiftCode struct {
+	meta
+	// Number of months the Telegram Premium subscription will be active after code activation
+	MonthCount int32 `json:"month_count"`
+}
+
+func (*PushMessageContentPremiumGiftCode) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentPremiumGiftCode) GetConstructor() string {
+	return ConstructorPushMessageContentPremiumGiftCode
+}
+
+func (*PushMessageContentPremiumGiftCode) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentPremiumGiftCode
+}
+
+func (entity *PushMessageContentPremiumGiftCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentPremiumGiftCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentPremiumGiftCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentPremiumGiftCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentPremiumGiftCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentPremiumGiftCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a giveaway
+type PushMessageContentGiveaway struct {
+	meta
+	// Number of users which will receive giveaway prizes; 0 for pinned message
+	WinnerCount int32 `json:"winner_count"`
+	// Prize of the giveaway; may be null for pinned message
+	Prize GiveawayPrize `json:"prize,omitempty"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentGiveaway) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentGiveaway) GetConstructor() string {
+	return ConstructorPushMessageContentGiveaway
+}
+
+func (*PushMessageContentGiveaway) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentGiveaway
+}
+
+func (entity *PushMessageContentGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	if entity.Prize != nil {
+		encoder.writeRaw(`,"prize":`)
+		encoder.writeType(entity.Prize)
+	}
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "prize":
+			entity.Prize, err = decodeGiveawayPrize(decoder)
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a gift
+type PushMessageContentGift struct {
+	meta
+	// Number of Telegram Stars that sender paid for the gift
+	StarCount int64 `json:"star_count"`
+}
+
+func (*PushMessageContentGift) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentGift) GetConstructor() string {
+	return ConstructorPushMessageContentGift
+}
+
+func (*PushMessageContentGift) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentGift
+}
+
+func (entity *PushMessageContentGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with an upgraded gift
+type PushMessageContentUpgradedGift struct {
+	meta
+	// True, if the gift was obtained by upgrading of a previously received gift; otherwise, this is a transferred gift
+	IsUpgrade bool `json:"is_upgrade"`
+}
+
+func (*PushMessageContentUpgradedGift) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentUpgradedGift) GetConstructor() string {
+	return ConstructorPushMessageContentUpgradedGift
+}
+
+func (*PushMessageContentUpgradedGift) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentUpgradedGift
+}
+
+func (entity *PushMessageContentUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_upgrade":`)
+	encoder.writeBool(entity.IsUpgrade)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_upgrade":
+			entity.IsUpgrade, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A screenshot of a message in the chat has been taken
+type PushMessageContentScreenshotTaken struct {
+	meta
+}
+
+func (*PushMessageContentScreenshotTaken) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentScreenshotTaken) GetConstructor() string {
+	return ConstructorPushMessageContentScreenshotTaken
+}
+
+func (*PushMessageContentScreenshotTaken) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentScreenshotTaken
+}
+
+func (entity *PushMessageContentScreenshotTaken) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentScreenshotTaken) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentScreenshotTaken) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentScreenshotTaken"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentScreenshotTaken) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentScreenshotTaken)
+	})
+}
+
+// A message with a sticker
+type PushMessageContentSticker struct {
+	meta
+	// Message content; may be null
+	Sticker *Sticker `json:"sticker,omitempty"`
+	// Emoji corresponding to the sticker; may be empty
+	Emoji string `json:"emoji"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentSticker) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentSticker) GetConstructor() string {
+	return ConstructorPushMessageContentSticker
+}
+
+func (*PushMessageContentSticker) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentSticker
+}
+
+func (entity *PushMessageContentSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentSticker"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a story
+type PushMessageContentStory struct {
+	meta
+	// True, if the user was mentioned in the story
+	IsMention bool `json:"is_mention"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentStory) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentStory) GetConstructor() string {
+	return ConstructorPushMessageContentStory
+}
+
+func (*PushMessageContentStory) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentStory
+}
+
+func (entity *PushMessageContentStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_mention":`)
+	encoder.writeBool(entity.IsMention)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_mention":
+			entity.IsMention, err = decoder.readBool()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A text message
+type PushMessageContentText struct {
+	meta
+	// Message text
+	Text string `json:"text"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentText) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentText) GetConstructor() string {
+	return ConstructorPushMessageContentText
+}
+
+func (*PushMessageContentText) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentText
+}
+
+func (entity *PushMessageContentText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentText) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentText) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentText"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentText) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentText)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video message
+type PushMessageContentVideo struct {
+	meta
+	// Message content; may be null
+	Video *Video `json:"video,omitempty"`
+	// Video caption
+	Caption string `json:"caption"`
+	// True, if the video is secret
+	IsSecret bool `json:"is_secret"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentVideo) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentVideo) GetConstructor() string {
+	return ConstructorPushMessageContentVideo
+}
+
+func (*PushMessageContentVideo) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentVideo
+}
+
+func (entity *PushMessageContentVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentVideo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Video != nil {
+		encoder.writeRaw(`,"video":`)
+		entity.Video.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"caption":`)
+	encoder.writeString(entity.Caption)
+	encoder.writeRaw(`,"is_secret":`)
+	encoder.writeBool(entity.IsSecret)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeEntity[Video](decoder)
+
+		case "caption":
+			entity.Caption, err = decoder.readString()
+
+		case "is_secret":
+			entity.IsSecret, err = decoder.readBool()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video note message
+type PushMessageContentVideoNote struct {
+	meta
+	// Message content; may be null
+	VideoNote *VideoNote `json:"video_note,omitempty"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentVideoNote) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentVideoNote) GetConstructor() string {
+	return ConstructorPushMessageContentVideoNote
+}
+
+func (*PushMessageContentVideoNote) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentVideoNote
+}
+
+func (entity *PushMessageContentVideoNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentVideoNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentVideoNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentVideoNote"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.VideoNote != nil {
+		encoder.writeRaw(`,"video_note":`)
+		entity.VideoNote.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentVideoNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video_note":
+			entity.VideoNote, err = decodeEntity[VideoNote](decoder)
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentVideoNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A voice note message
+type PushMessageContentVoiceNote struct {
+	meta
+	// Message content; may be null
+	VoiceNote *VoiceNote `json:"voice_note,omitempty"`
+	// True, if the message is a pinned message with the specified content
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*PushMessageContentVoiceNote) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentVoiceNote) GetConstructor() string {
+	return ConstructorPushMessageContentVoiceNote
+}
+
+func (*PushMessageContentVoiceNote) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentVoiceNote
+}
+
+func (entity *PushMessageContentVoiceNote) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentVoiceNote) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentVoiceNote) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentVoiceNote"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.VoiceNote != nil {
+		encoder.writeRaw(`,"voice_note":`)
+		entity.VoiceNote.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentVoiceNote) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "voice_note":
+			entity.VoiceNote, err = decodeEntity[VoiceNote](decoder)
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentVoiceNote)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A newly created basic group
+type PushMessageContentBasicGroupChatCreate struct {
+	meta
+}
+
+func (*PushMessageContentBasicGroupChatCreate) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentBasicGroupChatCreate) GetConstructor() string {
+	return ConstructorPushMessageContentBasicGroupChatCreate
+}
+
+func (*PushMessageContentBasicGroupChatCreate) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentBasicGroupChatCreate
+}
+
+func (entity *PushMessageContentBasicGroupChatCreate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentBasicGroupChatCreate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentBasicGroupChatCreate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentBasicGroupChatCreate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentBasicGroupChatCreate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentBasicGroupChatCreate)
+	})
+}
+
+// A video chat or live stream was started
+type PushMessageContentVideoChatStarted struct {
+	meta
+}
+
+func (*PushMessageContentVideoChatStarted) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentVideoChatStarted) GetConstructor() string {
+	return ConstructorPushMessageContentVideoChatStarted
+}
+
+func (*PushMessageContentVideoChatStarted) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentVideoChatStarted
+}
+
+func (entity *PushMessageContentVideoChatStarted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentVideoChatStarted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentVideoChatStarted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentVideoChatStarted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentVideoChatStarted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentVideoChatStarted)
+	})
+}
+
+// A video chat or live stream has ended
+type PushMessageContentVideoChatEnded struct {
+	meta
+}
+
+func (*PushMessageContentVideoChatEnded) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentVideoChatEnded) GetConstructor() string {
+	return ConstructorPushMessageContentVideoChatEnded
+}
+
+func (*PushMessageContentVideoChatEnded) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentVideoChatEnded
+}
+
+func (entity *PushMessageContentVideoChatEnded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentVideoChatEnded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentVideoChatEnded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentVideoChatEnded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentVideoChatEnded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentVideoChatEnded)
+	})
+}
+
+// An invitation of participants to a video chat or live stream
+type PushMessageContentInviteVideoChatParticipants struct {
+	meta
+	// True, if the current user was invited to the video chat or the live stream
+	IsCurrentUser bool `json:"is_current_user"`
+}
+
+func (*PushMessageContentInviteVideoChatParticipants) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentInviteVideoChatParticipants) GetConstructor() string {
+	return ConstructorPushMessageContentInviteVideoChatParticipants
+}
+
+func (*PushMessageContentInviteVideoChatParticipants) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentInviteVideoChatParticipants
+}
+
+func (entity *PushMessageContentInviteVideoChatParticipants) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentInviteVideoChatParticipants) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentInviteVideoChatParticipants) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentInviteVideoChatParticipants"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_current_user":`)
+	encoder.writeBool(entity.IsCurrentUser)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentInviteVideoChatParticipants) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_current_user":
+			entity.IsCurrentUser, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentInviteVideoChatParticipants)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// New chat members were invited to a group
+type PushMessageContentChatAddMembers struct {
+	meta
+	// Name of the added member
+	MemberName string `json:"member_name"`
+	// True, if the current user was added to the group
+	IsCurrentUser bool `json:"is_current_user"`
+	// True, if the user has returned to the group themselves
+	IsReturned bool `json:"is_returned"`
+}
+
+func (*PushMessageContentChatAddMembers) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatAddMembers) GetConstructor() string {
+	return ConstructorPushMessageContentChatAddMembers
+}
+
+func (*PushMessageContentChatAddMembers) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatAddMembers
+}
+
+func (entity *PushMessageContentChatAddMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatAddMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatAddMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatAddMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"member_name":`)
+	encoder.writeString(entity.MemberName)
+	encoder.writeRaw(`,"is_current_user":`)
+	encoder.writeBool(entity.IsCurrentUser)
+	encoder.writeRaw(`,"is_returned":`)
+	encoder.writeBool(entity.IsReturned)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatAddMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "member_name":
+			entity.MemberName, err = decoder.readString()
+
+		case "is_current_user":
+			entity.IsCurrentUser, err = decoder.readBool()
+
+		case "is_returned":
+			entity.IsReturned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatAddMembers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat photo was edited
+type PushMessageContentChatChangePhoto struct {
+	meta
+}
+
+func (*PushMessageContentChatChangePhoto) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatChangePhoto) GetConstructor() string {
+	return ConstructorPushMessageContentChatChangePhoto
+}
+
+func (*PushMessageContentChatChangePhoto) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatChangePhoto
+}
+
+func (entity *PushMessageContentChatChangePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatChangePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatChangePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatChangePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatChangePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatChangePhoto)
+	})
+}
+
+// A chat title was edited
+type PushMessageContentChatChangeTitle struct {
+	meta
+	// New chat title
+	Title string `json:"title"`
+}
+
+func (*PushMessageContentChatChangeTitle) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatChangeTitle) GetConstructor() string {
+	return ConstructorPushMessageContentChatChangeTitle
+}
+
+func (*PushMessageContentChatChangeTitle) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatChangeTitle
+}
+
+func (entity *PushMessageContentChatChangeTitle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatChangeTitle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatChangeTitle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatChangeTitle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatChangeTitle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatChangeTitle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat background was edited
+type PushMessageContentChatSetBackground struct {
+	meta
+	// True, if the set background is the same as the background of the current user
+	IsSame bool `json:"is_same"`
+}
+
+func (*PushMessageContentChatSetBackground) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatSetBackground) GetConstructor() string {
+	return ConstructorPushMessageContentChatSetBackground
+}
+
+func (*PushMessageContentChatSetBackground) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatSetBackground
+}
+
+func (entity *PushMessageContentChatSetBackground) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatSetBackground) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatSetBackground) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatSetBackground"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_same":`)
+	encoder.writeBool(entity.IsSame)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatSetBackground) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_same":
+			entity.IsSame, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatSetBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat theme was edited
+type PushMessageContentChatSetTheme struct {
+	meta
+	// If non-empty, name of a new theme, set for the chat. Otherwise, the chat theme was reset to the default one
+	ThemeName string `json:"theme_name"`
+}
+
+func (*PushMessageContentChatSetTheme) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatSetTheme) GetConstructor() string {
+	return ConstructorPushMessageContentChatSetTheme
+}
+
+func (*PushMessageContentChatSetTheme) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatSetTheme
+}
+
+func (entity *PushMessageContentChatSetTheme) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatSetTheme) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatSetTheme) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatSetTheme"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"theme_name":`)
+	encoder.writeString(entity.ThemeName)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatSetTheme) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "theme_name":
+			entity.ThemeName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatSetTheme)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat member was deleted
+type PushMessageContentChatDeleteMember struct {
+	meta
+	// Name of the deleted member
+	MemberName string `json:"member_name"`
+	// True, if the current user was deleted from the group
+	IsCurrentUser bool `json:"is_current_user"`
+	// True, if the user has left the group themselves
+	IsLeft bool `json:"is_left"`
+}
+
+func (*PushMessageContentChatDeleteMember) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatDeleteMember) GetConstructor() string {
+	return ConstructorPushMessageContentChatDeleteMember
+}
+
+func (*PushMessageContentChatDeleteMember) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatDeleteMember
+}
+
+func (entity *PushMessageContentChatDeleteMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatDeleteMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatDeleteMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatDeleteMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"member_name":`)
+	encoder.writeString(entity.MemberName)
+	encoder.writeRaw(`,"is_current_user":`)
+	encoder.writeBool(entity.IsCurrentUser)
+	encoder.writeRaw(`,"is_left":`)
+	encoder.writeBool(entity.IsLeft)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatDeleteMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "member_name":
+			entity.MemberName, err = decoder.readString()
+
+		case "is_current_user":
+			entity.IsCurrentUser, err = decoder.readBool()
+
+		case "is_left":
+			entity.IsLeft, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatDeleteMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new member joined the chat via an invite link
+type PushMessageContentChatJoinByLink struct {
+	meta
+}
+
+func (*PushMessageContentChatJoinByLink) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatJoinByLink) GetConstructor() string {
+	return ConstructorPushMessageContentChatJoinByLink
+}
+
+func (*PushMessageContentChatJoinByLink) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatJoinByLink
+}
+
+func (entity *PushMessageContentChatJoinByLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatJoinByLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatJoinByLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatJoinByLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatJoinByLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatJoinByLink)
+	})
+}
+
+// A new member was accepted to the chat by an administrator
+type PushMessageContentChatJoinByRequest struct {
+	meta
+}
+
+func (*PushMessageContentChatJoinByRequest) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentChatJoinByRequest) GetConstructor() string {
+	return ConstructorPushMessageContentChatJoinByRequest
+}
+
+func (*PushMessageContentChatJoinByRequest) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentChatJoinByRequest
+}
+
+func (entity *PushMessageContentChatJoinByRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentChatJoinByRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentChatJoinByRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentChatJoinByRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentChatJoinByRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentChatJoinByRequest)
+	})
+}
+
+// A new recurring payment was made by the current user
+type PushMessageContentRecurringPayment struct {
+	meta
+	// The paid amount
+	Amount string `json:"amount"`
+}
+
+func (*PushMessageContentRecurringPayment) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentRecurringPayment) GetConstructor() string {
+	return ConstructorPushMessageContentRecurringPayment
+}
+
+func (*PushMessageContentRecurringPayment) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentRecurringPayment
+}
+
+func (entity *PushMessageContentRecurringPayment) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentRecurringPayment) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentRecurringPayment) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentRecurringPayment"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeString(entity.Amount)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentRecurringPayment) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "amount":
+			entity.Amount, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentRecurringPayment)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A profile photo was suggested to the user
+type PushMessageContentSuggestProfilePhoto struct {
+	meta
+}
+
+func (*PushMessageContentSuggestProfilePhoto) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentSuggestProfilePhoto) GetConstructor() string {
+	return ConstructorPushMessageContentSuggestProfilePhoto
+}
+
+func (*PushMessageContentSuggestProfilePhoto) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentSuggestProfilePhoto
+}
+
+func (entity *PushMessageContentSuggestProfilePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentSuggestProfilePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentSuggestProfilePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentSuggestProfilePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentSuggestProfilePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentSuggestProfilePhoto)
+	})
+}
+
+// A user in the chat came within proximity alert range from the current user
+type PushMessageContentProximityAlertTriggered struct {
+	meta
+	// The distance to the user
+	Distance int32 `json:"distance"`
+}
+
+func (*PushMessageContentProximityAlertTriggered) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentProximityAlertTriggered) GetConstructor() string {
+	return ConstructorPushMessageContentProximityAlertTriggered
+}
+
+func (*PushMessageContentProximityAlertTriggered) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentProximityAlertTriggered
+}
+
+func (entity *PushMessageContentProximityAlertTriggered) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentProximityAlertTriggered) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentProximityAlertTriggered) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentProximityAlertTriggered"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"distance":`)
+	encoder.writeInt32(entity.Distance)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentProximityAlertTriggered) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "distance":
+			entity.Distance, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentProximityAlertTriggered)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forwarded messages
+type PushMessageContentMessageForwards struct {
+	meta
+	// Number of forwarded messages
+	TotalCount int32 `json:"total_count"`
+}
+
+func (*PushMessageContentMessageForwards) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentMessageForwards) GetConstructor() string {
+	return ConstructorPushMessageContentMessageForwards
+}
+
+func (*PushMessageContentMessageForwards) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentMessageForwards
+}
+
+func (entity *PushMessageContentMessageForwards) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentMessageForwards) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentMessageForwards) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentMessageForwards"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentMessageForwards) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentMessageForwards)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A media album
+type PushMessageContentMediaAlbum struct {
+	meta
+	// Number of messages in the album
+	TotalCount int32 `json:"total_count"`
+	// True, if the album has at least one photo
+	HasPhotos bool `json:"has_photos"`
+	// True, if the album has at least one video file
+	HasVideos bool `json:"has_videos"`
+	// True, if the album has at least one audio file
+	HasAudios bool `json:"has_audios"`
+	// True, if the album has at least one document
+	HasDocuments bool `json:"has_documents"`
+}
+
+func (*PushMessageContentMediaAlbum) GetType() string {
+	return TypePushMessageContent
+}
+
+func (*PushMessageContentMediaAlbum) GetConstructor() string {
+	return ConstructorPushMessageContentMediaAlbum
+}
+
+func (*PushMessageContentMediaAlbum) PushMessageContentConstructor() string {
+	return ConstructorPushMessageContentMediaAlbum
+}
+
+func (entity *PushMessageContentMediaAlbum) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PushMessageContentMediaAlbum) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PushMessageContentMediaAlbum) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"pushMessageContentMediaAlbum"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"has_photos":`)
+	encoder.writeBool(entity.HasPhotos)
+	encoder.writeRaw(`,"has_videos":`)
+	encoder.writeBool(entity.HasVideos)
+	encoder.writeRaw(`,"has_audios":`)
+	encoder.writeBool(entity.HasAudios)
+	encoder.writeRaw(`,"has_documents":`)
+	encoder.writeBool(entity.HasDocuments)
+	encoder.writeRaw("}")
+}
+
+func (entity *PushMessageContentMediaAlbum) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "has_photos":
+			entity.HasPhotos, err = decoder.readBool()
+
+		case "has_videos":
+			entity.HasVideos, err = decoder.readBool()
+
+		case "has_audios":
+			entity.HasAudios, err = decoder.readBool()
+
+		case "has_documents":
+			entity.HasDocuments, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPushMessageContentMediaAlbum)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user can be messaged
+type CanSendMessageToUserResultOk struct {
+	meta
+}
+
+func (*CanSendMessageToUserResultOk) GetType() string {
+	return TypeCanSendMessageToUserResult
+}
+
+func (*CanSendMessageToUserResultOk) GetConstructor() string {
+	return ConstructorCanSendMessageToUserResultOk
+}
+
+func (*CanSendMessageToUserResultOk) CanSendMessageToUserResultConstructor() string {
+	return ConstructorCanSendMessageToUserResultOk
+}
+
+func (entity *CanSendMessageToUserResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendMessageToUserResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendMessageToUserResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendMessageToUserResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendMessageToUserResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanSendMessageToUserResultOk)
+	})
+}
+
+// The user can be messaged, but the messages are paid
+type CanSendMessageToUserResultUserHasPaidMessages struct {
+	meta
+	// Number of Telegram Stars that must be paid by the current user for each sent message to the user
+	OutgoingPaidMessageStarCount int64 `json:"outgoing_paid_message_star_count"`
+}
+
+func (*CanSendMessageToUserResultUserHasPaidMessages) GetType() string {
+	return TypeCanSendMessageToUserResult
+}
+
+func (*CanSendMessageToUserResultUserHasPaidMessages) GetConstructor() string {
+	return ConstructorCanSendMessageToUserResultUserHasPaidMessages
+}
+
+func (*CanSendMessageToUserResultUserHasPaidMessages) CanSendMessageToUserResultConstructor() string {
+	return ConstructorCanSendMessageToUserResultUserHasPaidMessages
+}
+
+func (entity *CanSendMessageToUserResultUserHasPaidMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendMessageToUserResultUserHasPaidMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendMessageToUserResultUserHasPaidMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendMessageToUserResultUserHasPaidMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"outgoing_paid_message_star_count":`)
+	encoder.writeInt64(entity.OutgoingPaidMessageStarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendMessageToUserResultUserHasPaidMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "outgoing_paid_message_star_count":
+			entity.OutgoingPaidMessageStarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCanSendMessageToUserResultUserHasPaidMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user can't be messaged, because they are deleted or unknown
+type CanSendMessageToUserResultUserIsDeleted struct {
+	meta
+}
+
+func (*CanSendMessageToUserResultUserIsDeleted) GetType() string {
+	return TypeCanSendMessageToUserResult
+}
+
+func (*CanSendMessageToUserResultUserIsDeleted) GetConstructor() string {
+	return ConstructorCanSendMessageToUserResultUserIsDeleted
+}
+
+func (*CanSendMessageToUserResultUserIsDeleted) CanSendMessageToUserResultConstructor() string {
+	return ConstructorCanSendMessageToUserResultUserIsDeleted
+}
+
+func (entity *CanSendMessageToUserResultUserIsDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendMessageToUserResultUserIsDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendMessageToUserResultUserIsDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendMessageToUserResultUserIsDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendMessageToUserResultUserIsDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanSendMessageToUserResultUserIsDeleted)
+	})
+}
+
+// The user can't be messaged, because they restrict new chats with non-contacts
+type CanSendMessageToUserResultUserRestrictsNewChats struct {
+	meta
+}
+
+func (*CanSendMessageToUserResultUserRestrictsNewChats) GetType() string {
+	return TypeCanSendMessageToUserResult
+}
+
+func (*CanSendMessageToUserResultUserRestrictsNewChats) GetConstructor() string {
+	return ConstructorCanSendMessageToUserResultUserRestrictsNewChats
+}
+
+func (*CanSendMessageToUserResultUserRestrictsNewChats) CanSendMessageToUserResultConstructor() string {
+	return ConstructorCanSendMessageToUserResultUserRestrictsNewChats
+}
+
+func (entity *CanSendMessageToUserResultUserRestrictsNewChats) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendMessageToUserResultUserRestrictsNewChats) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendMessageToUserResultUserRestrictsNewChats) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendMessageToUserResultUserRestrictsNewChats"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendMessageToUserResultUserRestrictsNewChats) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanSendMessageToUserResultUserRestrictsNewChats)
+	})
+}
+
+// Contains default auto-delete timer setting for new chats
+type MessageAutoDeleteTime struct {
+	meta
+	// Message auto-delete time, in seconds. If 0, then messages aren't deleted automatically
+	Time int32 `json:"time"`
+}
+
+func (*MessageAutoDeleteTime) GetType() string {
+	return TypeMessageAutoDeleteTime
+}
+
+func (*MessageAutoDeleteTime) GetConstructor() string {
+	return ConstructorMessageAutoDeleteTime
+}
+
+func (entity *MessageAutoDeleteTime) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageAutoDeleteTime) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageAutoDeleteTime) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageAutoDeleteTime"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"time":`)
+	encoder.writeInt32(entity.Time)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageAutoDeleteTime) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "time":
+			entity.Time, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageAutoDeleteTime)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains an HTTPS link to a message in a supergroup or channel, or a forum topic
+type MessageLink struct {
+	meta
+	// The link
+	Link string `json:"link"`
+	// True, if the link will work for non-members of the chat
+	IsPublic bool `json:"is_public"`
+}
+
+func (*MessageLink) GetType() string {
+	return TypeMessageLink
+}
+
+func (*MessageLink) GetConstructor() string {
+	return ConstructorMessageLink
+}
+
+func (entity *MessageLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(entity.Link)
+	encoder.writeRaw(`,"is_public":`)
+	encoder.writeBool(entity.IsPublic)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "link":
+			entity.Link, err = decoder.readString()
+
+		case "is_public":
+			entity.IsPublic, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a link to a message or a forum topic in a chat
+type MessageLinkInfo struct {
+	meta
+	// True, if the link is a public link for a message or a forum topic in a chat
+	IsPublic bool `json:"is_public"`
+	// If found, identifier of the chat to which the link points, 0 otherwise
+	ChatId int64 `json:"chat_id"`
+	// If found, identifier of the message thread in which to open the message, or a forum topic to open if the message is missing
+	MessageThreadId int64 `json:"message_thread_id"`
+	// If found, the linked message; may be null
+	Message *Message `json:"message,omitempty"`
+	// Timestamp from which the video/audio/video note/voice note/story playing must start, in seconds; 0 if not specified. The media can be in the message content or in its link preview
+	MediaTimestamp int32 `json:"media_timestamp"`
+	// True, if the whole media album to which the message belongs is linked
+	ForAlbum bool `json:"for_album"`
+}
+
+func (*MessageLinkInfo) GetType() string {
+	return TypeMessageLinkInfo
+}
+
+func (*MessageLinkInfo) GetConstructor() string {
+	return ConstructorMessageLinkInfo
+}
+
+func (entity *MessageLinkInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageLinkInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageLinkInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageLinkInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_public":`)
+	encoder.writeBool(entity.IsPublic)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	if entity.Message != nil {
+		encoder.writeRaw(`,"message":`)
+		entity.Message.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"media_timestamp":`)
+	encoder.writeInt32(entity.MediaTimestamp)
+	encoder.writeRaw(`,"for_album":`)
+	encoder.writeBool(entity.ForAlbum)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageLinkInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_public":
+			entity.IsPublic, err = decoder.readBool()
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		case "media_timestamp":
+			entity.MediaTimestamp, err = decoder.readInt32()
+
+		case "for_album":
+			entity.ForAlbum, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageLinkInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *MessageLinkInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Contains some text
+type Text struct {
+	meta
+	// Text
+	Text string `json:"text"`
+}
+
+func (*Text) GetType() string {
+	return TypeText
+}
+
+func (*Text) GetConstructor() string {
+	return ConstructorText
+}
+
+func (entity *Text) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Text) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Text) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"text"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"text":`)
+	encoder.writeString(entity.Text)
+	encoder.writeRaw("}")
+}
+
+func (entity *Text) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "text":
+			entity.Text, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorText)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The text uses Markdown-style formatting
+type TextParseModeMarkdown struct {
+	meta
+	// Version of the parser: 0 or 1 - Telegram Bot API "Markdown" parse mode, 2 - Telegram Bot API "MarkdownV2" parse mode
+	Version int32 `json:"version"`
+}
+
+func (*TextParseModeMarkdown) GetType() string {
+	return TypeTextParseMode
+}
+
+func (*TextParseModeMarkdown) GetConstructor() string {
+	return ConstructorTextParseModeMarkdown
+}
+
+func (*TextParseModeMarkdown) TextParseModeConstructor() string {
+	return ConstructorTextParseModeMarkdown
+}
+
+func (entity *TextParseModeMarkdown) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextParseModeMarkdown) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextParseModeMarkdown) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textParseModeMarkdown"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"version":`)
+	encoder.writeInt32(entity.Version)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextParseModeMarkdown) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "version":
+			entity.Version, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTextParseModeMarkdown)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The text uses HTML-style formatting. The same as Telegram Bot API "HTML" parse mode
+type TextParseModeHTML struct {
+	meta
+}
+
+func (*TextParseModeHTML) GetType() string {
+	return TypeTextParseMode
+}
+
+func (*TextParseModeHTML) GetConstructor() string {
+	return ConstructorTextParseModeHTML
+}
+
+func (*TextParseModeHTML) TextParseModeConstructor() string {
+	return ConstructorTextParseModeHTML
+}
+
+func (entity *TextParseModeHTML) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TextParseModeHTML) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TextParseModeHTML) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"textParseModeHTML"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *TextParseModeHTML) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorTextParseModeHTML)
+	})
+}
+
+// Contains statistics about messages sent by a user
+type ChatStatisticsMessageSenderInfo struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Number of sent messages
+	SentMessageCount int32 `json:"sent_message_count"`
+	// Average number of characters in sent messages; 0 if unknown
+	AverageCharacterCount int32 `json:"average_character_count"`
+}
+
+func (*ChatStatisticsMessageSenderInfo) GetType() string {
+	return TypeChatStatisticsMessageSenderInfo
+}
+
+func (*ChatStatisticsMessageSenderInfo) GetConstructor() string {
+	return ConstructorChatStatisticsMessageSenderInfo
+}
+
+func (entity *ChatStatisticsMessageSenderInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatStatisticsMessageSenderInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatStatisticsMessageSenderInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatStatisticsMessageSenderInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"sent_message_count":`)
+	encoder.writeInt32(entity.SentMessageCount)
+	encoder.writeRaw(`,"average_character_count":`)
+	encoder.writeInt32(entity.AverageCharacterCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatStatisticsMessageSenderInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "sent_message_count":
+			entity.SentMessageCount, err = decoder.readInt32()
+
+		case "average_character_count":
+			entity.AverageCharacterCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatStatisticsMessageSenderInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A detailed statistics about a message
+type MessageStatistics struct {
+	meta
+	// A graph containing number of message views and shares
+	MessageInteractionGraph StatisticalGraph `json:"message_interaction_graph"`
+	// A graph containing number of message reactions
+	MessageReactionGraph StatisticalGraph `json:"message_reaction_graph"`
+}
+
+func (*MessageStatistics) GetType() string {
+	return TypeMessageStatistics
+}
+
+func (*MessageStatistics) GetConstructor() string {
+	return ConstructorMessageStatistics
+}
+
+func (entity *MessageStatistics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageStatistics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageStatistics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageStatistics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_interaction_graph":`)
+	encoder.writeType(entity.MessageInteractionGraph)
+	encoder.writeRaw(`,"message_reaction_graph":`)
+	encoder.writeType(entity.MessageReactionGraph)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageStatistics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_interaction_graph":
+			entity.MessageInteractionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "message_reaction_graph":
+			entity.MessageReactionGraph, err = decodeStatisticalGraph(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageStatistics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
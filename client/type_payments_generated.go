@@ -0,0 +1,14783 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeStarSubscriptionType             = "StarSubscriptionType"
+	TypeAffiliateType                    = "AffiliateType"
+	TypeAffiliateProgramSortOrder        = "AffiliateProgramSortOrder"
+	TypeSentGift                         = "SentGift"
+	TypeStarTransactionDirection         = "StarTransactionDirection"
+	TypeStarTransactionType              = "StarTransactionType"
+	TypeGiveawayParticipantStatus        = "GiveawayParticipantStatus"
+	TypeGiveawayInfo                     = "GiveawayInfo"
+	TypeGiveawayPrize                    = "GiveawayPrize"
+	TypePaidReactionType                 = "PaidReactionType"
+	TypePaymentProvider                  = "PaymentProvider"
+	TypePaymentFormType                  = "PaymentFormType"
+	TypePaymentReceiptType               = "PaymentReceiptType"
+	TypeInputInvoice                     = "InputInvoice"
+	TypePaidMedia                        = "PaidMedia"
+	TypeInputPaidMediaType               = "InputPaidMediaType"
+	TypeChatBoostSource                  = "ChatBoostSource"
+	TypePremiumLimitType                 = "PremiumLimitType"
+	TypePremiumFeature                   = "PremiumFeature"
+	TypePremiumStoryFeature              = "PremiumStoryFeature"
+	TypePremiumSource                    = "PremiumSource"
+	TypeStorePaymentPurpose              = "StorePaymentPurpose"
+	TypeStoreTransaction                 = "StoreTransaction"
+	TypeTelegramPaymentPurpose           = "TelegramPaymentPurpose"
+	TypeRevenueWithdrawalState           = "RevenueWithdrawalState"
+	TypeChatRevenueTransactionType       = "ChatRevenueTransactionType"
+	TypeStarAmount                       = "StarAmount"
+	TypeStarSubscriptionPricing          = "StarSubscriptionPricing"
+	TypeStarSubscription                 = "StarSubscription"
+	TypeStarSubscriptions                = "StarSubscriptions"
+	TypeAffiliateProgramParameters       = "AffiliateProgramParameters"
+	TypeAffiliateProgramInfo             = "AffiliateProgramInfo"
+	TypeAffiliateInfo                    = "AffiliateInfo"
+	TypeFoundAffiliateProgram            = "FoundAffiliateProgram"
+	TypeFoundAffiliatePrograms           = "FoundAffiliatePrograms"
+	TypeConnectedAffiliateProgram        = "ConnectedAffiliateProgram"
+	TypeConnectedAffiliatePrograms       = "ConnectedAffiliatePrograms"
+	TypePremiumPaymentOption             = "PremiumPaymentOption"
+	TypePremiumStatePaymentOption        = "PremiumStatePaymentOption"
+	TypePremiumGiftPaymentOption         = "PremiumGiftPaymentOption"
+	TypePremiumGiftPaymentOptions        = "PremiumGiftPaymentOptions"
+	TypePremiumGiveawayPaymentOption     = "PremiumGiveawayPaymentOption"
+	TypePremiumGiveawayPaymentOptions    = "PremiumGiveawayPaymentOptions"
+	TypePremiumGiftCodeInfo              = "PremiumGiftCodeInfo"
+	TypeStarPaymentOption                = "StarPaymentOption"
+	TypeStarPaymentOptions               = "StarPaymentOptions"
+	TypeStarGiveawayWinnerOption         = "StarGiveawayWinnerOption"
+	TypeStarGiveawayPaymentOption        = "StarGiveawayPaymentOption"
+	TypeStarGiveawayPaymentOptions       = "StarGiveawayPaymentOptions"
+	TypeAcceptedGiftTypes                = "AcceptedGiftTypes"
+	TypeGiftSettings                     = "GiftSettings"
+	TypeUpgradedGiftModel                = "UpgradedGiftModel"
+	TypeUpgradedGiftSymbol               = "UpgradedGiftSymbol"
+	TypeUpgradedGiftBackdropColors       = "UpgradedGiftBackdropColors"
+	TypeUpgradedGiftBackdrop             = "UpgradedGiftBackdrop"
+	TypeUpgradedGiftOriginalDetails      = "UpgradedGiftOriginalDetails"
+	TypeGift                             = "Gift"
+	TypeGifts                            = "Gifts"
+	TypeUpgradedGift                     = "UpgradedGift"
+	TypeUpgradeGiftResult                = "UpgradeGiftResult"
+	TypeReceivedGift                     = "ReceivedGift"
+	TypeReceivedGifts                    = "ReceivedGifts"
+	TypeGiftUpgradePreview               = "GiftUpgradePreview"
+	TypeStarTransaction                  = "StarTransaction"
+	TypeStarTransactions                 = "StarTransactions"
+	TypeChatInviteLinkSubscriptionInfo   = "ChatInviteLinkSubscriptionInfo"
+	TypePaidReactor                      = "PaidReactor"
+	TypeInvoice                          = "Invoice"
+	TypePaymentOption                    = "PaymentOption"
+	TypePaymentForm                      = "PaymentForm"
+	TypePaymentResult                    = "PaymentResult"
+	TypePaymentReceipt                   = "PaymentReceipt"
+	TypeGiveawayParameters               = "GiveawayParameters"
+	TypeInputPaidMedia                   = "InputPaidMedia"
+	TypeChatBoostLevelFeatures           = "ChatBoostLevelFeatures"
+	TypeChatBoostFeatures                = "ChatBoostFeatures"
+	TypePrepaidGiveaway                  = "PrepaidGiveaway"
+	TypeChatBoostStatus                  = "ChatBoostStatus"
+	TypeChatBoost                        = "ChatBoost"
+	TypeFoundChatBoosts                  = "FoundChatBoosts"
+	TypeChatBoostSlot                    = "ChatBoostSlot"
+	TypeChatBoostSlots                   = "ChatBoostSlots"
+	TypePremiumLimit                     = "PremiumLimit"
+	TypePremiumFeatures                  = "PremiumFeatures"
+	TypePremiumFeaturePromotionAnimation = "PremiumFeaturePromotionAnimation"
+	TypePremiumState                     = "PremiumState"
+	TypeChatBoostLink                    = "ChatBoostLink"
+	TypeChatBoostLinkInfo                = "ChatBoostLinkInfo"
+	TypeStarCount                        = "StarCount"
+	TypeChatRevenueAmount                = "ChatRevenueAmount"
+	TypeChatRevenueStatistics            = "ChatRevenueStatistics"
+	TypeChatRevenueTransaction           = "ChatRevenueTransaction"
+	TypeChatRevenueTransactions          = "ChatRevenueTransactions"
+	TypeStarRevenueStatus                = "StarRevenueStatus"
+	TypeStarRevenueStatistics            = "StarRevenueStatistics"
+)
+
+const (
+	ConstructorStarAmount                                      = "starAmount"
+	ConstructorStarSubscriptionTypeChannel                     = "starSubscriptionTypeChannel"
+	ConstructorStarSubscriptionTypeBot                         = "starSubscriptionTypeBot"
+	ConstructorStarSubscriptionPricing                         = "starSubscriptionPricing"
+	ConstructorStarSubscription                                = "starSubscription"
+	ConstructorStarSubscriptions                               = "starSubscriptions"
+	ConstructorAffiliateTypeCurrentUser                        = "affiliateTypeCurrentUser"
+	ConstructorAffiliateTypeBot                                = "affiliateTypeBot"
+	ConstructorAffiliateTypeChannel                            = "affiliateTypeChannel"
+	ConstructorAffiliateProgramSortOrderProfitability          = "affiliateProgramSortOrderProfitability"
+	ConstructorAffiliateProgramSortOrderCreationDate           = "affiliateProgramSortOrderCreationDate"
+	ConstructorAffiliateProgramSortOrderRevenue                = "affiliateProgramSortOrderRevenue"
+	ConstructorAffiliateProgramParameters                      = "affiliateProgramParameters"
+	ConstructorAffiliateProgramInfo                            = "affiliateProgramInfo"
+	ConstructorAffiliateInfo                                   = "affiliateInfo"
+	ConstructorFoundAffiliateProgram                           = "foundAffiliateProgram"
+	ConstructorFoundAffiliatePrograms                          = "foundAffiliatePrograms"
+	ConstructorConnectedAffiliateProgram                       = "connectedAffiliateProgram"
+	ConstructorConnectedAffiliatePrograms                      = "connectedAffiliatePrograms"
+	ConstructorPremiumPaymentOption                            = "premiumPaymentOption"
+	ConstructorPremiumStatePaymentOption                       = "premiumStatePaymentOption"
+	ConstructorPremiumGiftPaymentOption                        = "premiumGiftPaymentOption"
+	ConstructorPremiumGiftPaymentOptions                       = "premiumGiftPaymentOptions"
+	ConstructorPremiumGiveawayPaymentOption                    = "premiumGiveawayPaymentOption"
+	ConstructorPremiumGiveawayPaymentOptions                   = "premiumGiveawayPaymentOptions"
+	ConstructorPremiumGiftCodeInfo                             = "premiumGiftCodeInfo"
+	ConstructorStarPaymentOption                               = "starPaymentOption"
+	ConstructorStarPaymentOptions                              = "starPaymentOptions"
+	ConstructorStarGiveawayWinnerOption                        = "starGiveawayWinnerOption"
+	ConstructorStarGiveawayPaymentOption                       = "starGiveawayPaymentOption"
+	ConstructorStarGiveawayPaymentOptions                      = "starGiveawayPaymentOptions"
+	ConstructorAcceptedGiftTypes                               = "acceptedGiftTypes"
+	ConstructorGiftSettings                                    = "giftSettings"
+	ConstructorUpgradedGiftModel                               = "upgradedGiftModel"
+	ConstructorUpgradedGiftSymbol                              = "upgradedGiftSymbol"
+	ConstructorUpgradedGiftBackdropColors                      = "upgradedGiftBackdropColors"
+	ConstructorUpgradedGiftBackdrop                            = "upgradedGiftBackdrop"
+	ConstructorUpgradedGiftOriginalDetails                     = "upgradedGiftOriginalDetails"
+	ConstructorGift                                            = "gift"
+	ConstructorGifts                                           = "gifts"
+	ConstructorUpgradedGift                                    = "upgradedGift"
+	ConstructorUpgradeGiftResult                               = "upgradeGiftResult"
+	ConstructorSentGiftRegular                                 = "sentGiftRegular"
+	ConstructorSentGiftUpgraded                                = "sentGiftUpgraded"
+	ConstructorReceivedGift                                    = "receivedGift"
+	ConstructorReceivedGifts                                   = "receivedGifts"
+	ConstructorGiftUpgradePreview                              = "giftUpgradePreview"
+	ConstructorStarTransactionDirectionIncoming                = "starTransactionDirectionIncoming"
+	ConstructorStarTransactionDirectionOutgoing                = "starTransactionDirectionOutgoing"
+	ConstructorStarTransactionTypePremiumBotDeposit            = "starTransactionTypePremiumBotDeposit"
+	ConstructorStarTransactionTypeAppStoreDeposit              = "starTransactionTypeAppStoreDeposit"
+	ConstructorStarTransactionTypeGooglePlayDeposit            = "starTransactionTypeGooglePlayDeposit"
+	ConstructorStarTransactionTypeFragmentDeposit              = "starTransactionTypeFragmentDeposit"
+	ConstructorStarTransactionTypeUserDeposit                  = "starTransactionTypeUserDeposit"
+	ConstructorStarTransactionTypeGiveawayDeposit              = "starTransactionTypeGiveawayDeposit"
+	ConstructorStarTransactionTypeFragmentWithdrawal           = "starTransactionTypeFragmentWithdrawal"
+	ConstructorStarTransactionTypeTelegramAdsWithdrawal        = "starTransactionTypeTelegramAdsWithdrawal"
+	ConstructorStarTransactionTypeTelegramApiUsage             = "starTransactionTypeTelegramApiUsage"
+	ConstructorStarTransactionTypeBotPaidMediaPurchase         = "starTransactionTypeBotPaidMediaPurchase"
+	ConstructorStarTransactionTypeBotPaidMediaSale             = "starTransactionTypeBotPaidMediaSale"
+	ConstructorStarTransactionTypeChannelPaidMediaPurchase     = "starTransactionTypeChannelPaidMediaPurchase"
+	ConstructorStarTransactionTypeChannelPaidMediaSale         = "starTransactionTypeChannelPaidMediaSale"
+	ConstructorStarTransactionTypeBotInvoicePurchase           = "starTransactionTypeBotInvoicePurchase"
+	ConstructorStarTransactionTypeBotInvoiceSale               = "starTransactionTypeBotInvoiceSale"
+	ConstructorStarTransactionTypeBotSubscriptionPurchase      = "starTransactionTypeBotSubscriptionPurchase"
+	ConstructorStarTransactionTypeBotSubscriptionSale          = "starTransactionTypeBotSubscriptionSale"
+	ConstructorStarTransactionTypeChannelSubscriptionPurchase  = "starTransactionTypeChannelSubscriptionPurchase"
+	ConstructorStarTransactionTypeChannelSubscriptionSale      = "starTransactionTypeChannelSubscriptionSale"
+	ConstructorStarTransactionTypeGiftPurchase                 = "starTransactionTypeGiftPurchase"
+	ConstructorStarTransactionTypeGiftTransfer                 = "starTransactionTypeGiftTransfer"
+	ConstructorStarTransactionTypeGiftSale                     = "starTransactionTypeGiftSale"
+	ConstructorStarTransactionTypeGiftUpgrade                  = "starTransactionTypeGiftUpgrade"
+	ConstructorStarTransactionTypeChannelPaidReactionSend      = "starTransactionTypeChannelPaidReactionSend"
+	ConstructorStarTransactionTypeChannelPaidReactionReceive   = "starTransactionTypeChannelPaidReactionReceive"
+	ConstructorStarTransactionTypeAffiliateProgramCommission   = "starTransactionTypeAffiliateProgramCommission"
+	ConstructorStarTransactionTypePaidMessageSend              = "starTransactionTypePaidMessageSend"
+	ConstructorStarTransactionTypePaidMessageReceive           = "starTransactionTypePaidMessageReceive"
+	ConstructorStarTransactionTypePremiumPurchase              = "starTransactionTypePremiumPurchase"
+	ConstructorStarTransactionTypeBusinessBotTransferSend      = "starTransactionTypeBusinessBotTransferSend"
+	ConstructorStarTransactionTypeBusinessBotTransferReceive   = "starTransactionTypeBusinessBotTransferReceive"
+	ConstructorStarTransactionTypeUnsupported                  = "starTransactionTypeUnsupported"
+	ConstructorStarTransaction                                 = "starTransaction"
+	ConstructorStarTransactions                                = "starTransactions"
+	ConstructorGiveawayParticipantStatusEligible               = "giveawayParticipantStatusEligible"
+	ConstructorGiveawayParticipantStatusParticipating          = "giveawayParticipantStatusParticipating"
+	ConstructorGiveawayParticipantStatusAlreadyWasMember       = "giveawayParticipantStatusAlreadyWasMember"
+	ConstructorGiveawayParticipantStatusAdministrator          = "giveawayParticipantStatusAdministrator"
+	ConstructorGiveawayParticipantStatusDisallowedCountry      = "giveawayParticipantStatusDisallowedCountry"
+	ConstructorGiveawayInfoOngoing                             = "giveawayInfoOngoing"
+	ConstructorGiveawayInfoCompleted                           = "giveawayInfoCompleted"
+	ConstructorGiveawayPrizePremium                            = "giveawayPrizePremium"
+	ConstructorGiveawayPrizeStars                              = "giveawayPrizeStars"
+	ConstructorChatInviteLinkSubscriptionInfo                  = "chatInviteLinkSubscriptionInfo"
+	ConstructorPaidReactionTypeRegular                         = "paidReactionTypeRegular"
+	ConstructorPaidReactionTypeAnonymous                       = "paidReactionTypeAnonymous"
+	ConstructorPaidReactionTypeChat                            = "paidReactionTypeChat"
+	ConstructorPaidReactor                                     = "paidReactor"
+	ConstructorInvoice                                         = "invoice"
+	ConstructorPaymentProviderSmartGlocal                      = "paymentProviderSmartGlocal"
+	ConstructorPaymentProviderStripe                           = "paymentProviderStripe"
+	ConstructorPaymentProviderOther                            = "paymentProviderOther"
+	ConstructorPaymentOption                                   = "paymentOption"
+	ConstructorPaymentFormTypeRegular                          = "paymentFormTypeRegular"
+	ConstructorPaymentFormTypeStars                            = "paymentFormTypeStars"
+	ConstructorPaymentFormTypeStarSubscription                 = "paymentFormTypeStarSubscription"
+	ConstructorPaymentForm                                     = "paymentForm"
+	ConstructorPaymentResult                                   = "paymentResult"
+	ConstructorPaymentReceiptTypeRegular                       = "paymentReceiptTypeRegular"
+	ConstructorPaymentReceiptTypeStars                         = "paymentReceiptTypeStars"
+	ConstructorPaymentReceipt                                  = "paymentReceipt"
+	ConstructorInputInvoiceMessage                             = "inputInvoiceMessage"
+	ConstructorInputInvoiceName                                = "inputInvoiceName"
+	ConstructorInputInvoiceTelegram                            = "inputInvoiceTelegram"
+	ConstructorPaidMediaPreview                                = "paidMediaPreview"
+	ConstructorPaidMediaPhoto                                  = "paidMediaPhoto"
+	ConstructorPaidMediaVideo                                  = "paidMediaVideo"
+	ConstructorPaidMediaUnsupported                            = "paidMediaUnsupported"
+	ConstructorGiveawayParameters                              = "giveawayParameters"
+	ConstructorInputPaidMediaTypePhoto                         = "inputPaidMediaTypePhoto"
+	ConstructorInputPaidMediaTypeVideo                         = "inputPaidMediaTypeVideo"
+	ConstructorInputPaidMedia                                  = "inputPaidMedia"
+	ConstructorChatBoostLevelFeatures                          = "chatBoostLevelFeatures"
+	ConstructorChatBoostFeatures                               = "chatBoostFeatures"
+	ConstructorChatBoostSourceGiftCode                         = "chatBoostSourceGiftCode"
+	ConstructorChatBoostSourceGiveaway                         = "chatBoostSourceGiveaway"
+	ConstructorChatBoostSourcePremium                          = "chatBoostSourcePremium"
+	ConstructorPrepaidGiveaway                                 = "prepaidGiveaway"
+	ConstructorChatBoostStatus                                 = "chatBoostStatus"
+	ConstructorChatBoost                                       = "chatBoost"
+	ConstructorFoundChatBoosts                                 = "foundChatBoosts"
+	ConstructorChatBoostSlot                                   = "chatBoostSlot"
+	ConstructorChatBoostSlots                                  = "chatBoostSlots"
+	ConstructorPremiumLimitTypeSupergroupCount                 = "premiumLimitTypeSupergroupCount"
+	ConstructorPremiumLimitTypePinnedChatCount                 = "premiumLimitTypePinnedChatCount"
+	ConstructorPremiumLimitTypeCreatedPublicChatCount          = "premiumLimitTypeCreatedPublicChatCount"
+	ConstructorPremiumLimitTypeSavedAnimationCount             = "premiumLimitTypeSavedAnimationCount"
+	ConstructorPremiumLimitTypeFavoriteStickerCount            = "premiumLimitTypeFavoriteStickerCount"
+	ConstructorPremiumLimitTypeChatFolderCount                 = "premiumLimitTypeChatFolderCount"
+	ConstructorPremiumLimitTypeChatFolderChosenChatCount       = "premiumLimitTypeChatFolderChosenChatCount"
+	ConstructorPremiumLimitTypePinnedArchivedChatCount         = "premiumLimitTypePinnedArchivedChatCount"
+	ConstructorPremiumLimitTypePinnedSavedMessagesTopicCount   = "premiumLimitTypePinnedSavedMessagesTopicCount"
+	ConstructorPremiumLimitTypeCaptionLength                   = "premiumLimitTypeCaptionLength"
+	ConstructorPremiumLimitTypeBioLength                       = "premiumLimitTypeBioLength"
+	ConstructorPremiumLimitTypeChatFolderInviteLinkCount       = "premiumLimitTypeChatFolderInviteLinkCount"
+	ConstructorPremiumLimitTypeShareableChatFolderCount        = "premiumLimitTypeShareableChatFolderCount"
+	ConstructorPremiumLimitTypeActiveStoryCount                = "premiumLimitTypeActiveStoryCount"
+	ConstructorPremiumLimitTypeWeeklySentStoryCount            = "premiumLimitTypeWeeklySentStoryCount"
+	ConstructorPremiumLimitTypeMonthlySentStoryCount           = "premiumLimitTypeMonthlySentStoryCount"
+	ConstructorPremiumLimitTypeStoryCaptionLength              = "premiumLimitTypeStoryCaptionLength"
+	ConstructorPremiumLimitTypeStorySuggestedReactionAreaCount = "premiumLimitTypeStorySuggestedReactionAreaCount"
+	ConstructorPremiumLimitTypeSimilarChatCount                = "premiumLimitTypeSimilarChatCount"
+	ConstructorPremiumFeatureIncreasedLimits                   = "premiumFeatureIncreasedLimits"
+	ConstructorPremiumFeatureIncreasedUploadFileSize           = "premiumFeatureIncreasedUploadFileSize"
+	ConstructorPremiumFeatureImprovedDownloadSpeed             = "premiumFeatureImprovedDownloadSpeed"
+	ConstructorPremiumFeatureVoiceRecognition                  = "premiumFeatureVoiceRecognition"
+	ConstructorPremiumFeatureDisabledAds                       = "premiumFeatureDisabledAds"
+	ConstructorPremiumFeatureUniqueReactions                   = "premiumFeatureUniqueReactions"
+	ConstructorPremiumFeatureUniqueStickers                    = "premiumFeatureUniqueStickers"
+	ConstructorPremiumFeatureCustomEmoji                       = "premiumFeatureCustomEmoji"
+	ConstructorPremiumFeatureAdvancedChatManagement            = "premiumFeatureAdvancedChatManagement"
+	ConstructorPremiumFeatureProfileBadge                      = "premiumFeatureProfileBadge"
+	ConstructorPremiumFeatureEmojiStatus                       = "premiumFeatureEmojiStatus"
+	ConstructorPremiumFeatureAnimatedProfilePhoto              = "premiumFeatureAnimatedProfilePhoto"
+	ConstructorPremiumFeatureForumTopicIcon                    = "premiumFeatureForumTopicIcon"
+	ConstructorPremiumFeatureAppIcons                          = "premiumFeatureAppIcons"
+	ConstructorPremiumFeatureRealTimeChatTranslation           = "premiumFeatureRealTimeChatTranslation"
+	ConstructorPremiumFeatureUpgradedStories                   = "premiumFeatureUpgradedStories"
+	ConstructorPremiumFeatureChatBoost                         = "premiumFeatureChatBoost"
+	ConstructorPremiumFeatureAccentColor                       = "premiumFeatureAccentColor"
+	ConstructorPremiumFeatureBackgroundForBoth                 = "premiumFeatureBackgroundForBoth"
+	ConstructorPremiumFeatureSavedMessagesTags                 = "premiumFeatureSavedMessagesTags"
+	ConstructorPremiumFeatureMessagePrivacy                    = "premiumFeatureMessagePrivacy"
+	ConstructorPremiumFeatureLastSeenTimes                     = "premiumFeatureLastSeenTimes"
+	ConstructorPremiumFeatureBusiness                          = "premiumFeatureBusiness"
+	ConstructorPremiumFeatureMessageEffects                    = "premiumFeatureMessageEffects"
+	ConstructorPremiumStoryFeaturePriorityOrder                = "premiumStoryFeaturePriorityOrder"
+	ConstructorPremiumStoryFeatureStealthMode                  = "premiumStoryFeatureStealthMode"
+	ConstructorPremiumStoryFeaturePermanentViewsHistory        = "premiumStoryFeaturePermanentViewsHistory"
+	ConstructorPremiumStoryFeatureCustomExpirationDuration     = "premiumStoryFeatureCustomExpirationDuration"
+	ConstructorPremiumStoryFeatureSaveStories                  = "premiumStoryFeatureSaveStories"
+	ConstructorPremiumStoryFeatureLinksAndFormatting           = "premiumStoryFeatureLinksAndFormatting"
+	ConstructorPremiumStoryFeatureVideoQuality                 = "premiumStoryFeatureVideoQuality"
+	ConstructorPremiumLimit                                    = "premiumLimit"
+	ConstructorPremiumFeatures                                 = "premiumFeatures"
+	ConstructorPremiumSourceLimitExceeded                      = "premiumSourceLimitExceeded"
+	ConstructorPremiumSourceFeature                            = "premiumSourceFeature"
+	ConstructorPremiumSourceBusinessFeature                    = "premiumSourceBusinessFeature"
+	ConstructorPremiumSourceStoryFeature                       = "premiumSourceStoryFeature"
+	ConstructorPremiumSourceLink                               = "premiumSourceLink"
+	ConstructorPremiumSourceSettings                           = "premiumSourceSettings"
+	ConstructorPremiumFeaturePromotionAnimation                = "premiumFeaturePromotionAnimation"
+	ConstructorPremiumState                                    = "premiumState"
+	ConstructorStorePaymentPurposePremiumSubscription          = "storePaymentPurposePremiumSubscription"
+	ConstructorStorePaymentPurposePremiumGift                  = "storePaymentPurposePremiumGift"
+	ConstructorStorePaymentPurposePremiumGiftCodes             = "storePaymentPurposePremiumGiftCodes"
+	ConstructorStorePaymentPurposePremiumGiveaway              = "storePaymentPurposePremiumGiveaway"
+	ConstructorStorePaymentPurposeStarGiveaway                 = "storePaymentPurposeStarGiveaway"
+	ConstructorStorePaymentPurposeStars                        = "storePaymentPurposeStars"
+	ConstructorStorePaymentPurposeGiftedStars                  = "storePaymentPurposeGiftedStars"
+	ConstructorStoreTransactionAppStore                        = "storeTransactionAppStore"
+	ConstructorStoreTransactionGooglePlay                      = "storeTransactionGooglePlay"
+	ConstructorTelegramPaymentPurposePremiumGift               = "telegramPaymentPurposePremiumGift"
+	ConstructorTelegramPaymentPurposePremiumGiftCodes          = "telegramPaymentPurposePremiumGiftCodes"
+	ConstructorTelegramPaymentPurposePremiumGiveaway           = "telegramPaymentPurposePremiumGiveaway"
+	ConstructorTelegramPaymentPurposeStars                     = "telegramPaymentPurposeStars"
+	ConstructorTelegramPaymentPurposeGiftedStars               = "telegramPaymentPurposeGiftedStars"
+	ConstructorTelegramPaymentPurposeStarGiveaway              = "telegramPaymentPurposeStarGiveaway"
+	ConstructorTelegramPaymentPurposeJoinChat                  = "telegramPaymentPurposeJoinChat"
+	ConstructorChatBoostLink                                   = "chatBoostLink"
+	ConstructorChatBoostLinkInfo                               = "chatBoostLinkInfo"
+	ConstructorStarCount                                       = "starCount"
+	ConstructorChatRevenueAmount                               = "chatRevenueAmount"
+	ConstructorChatRevenueStatistics                           = "chatRevenueStatistics"
+	ConstructorRevenueWithdrawalStatePending                   = "revenueWithdrawalStatePending"
+	ConstructorRevenueWithdrawalStateSucceeded                 = "revenueWithdrawalStateSucceeded"
+	ConstructorRevenueWithdrawalStateFailed                    = "revenueWithdrawalStateFailed"
+	ConstructorChatRevenueTransactionTypeEarnings              = "chatRevenueTransactionTypeEarnings"
+	ConstructorChatRevenueTransactionTypeWithdrawal            = "chatRevenueTransactionTypeWithdrawal"
+	ConstructorChatRevenueTransactionTypeRefund                = "chatRevenueTransactionTypeRefund"
+	ConstructorChatRevenueTransaction                          = "chatRevenueTransaction"
+	ConstructorChatRevenueTransactions                         = "chatRevenueTransactions"
+	ConstructorStarRevenueStatus                               = "starRevenueStatus"
+	ConstructorStarRevenueStatistics                           = "starRevenueStatistics"
+)
+
+// Describes type of subscription paid in Telegram Stars
+type StarSubscriptionType interface {
+	StarSubscriptionTypeConstructor() string
+}
+
+// Describes type of affiliate for an affiliate program
+type AffiliateType interface {
+	AffiliateTypeConstructor() string
+}
+
+// Describes the order of the found affiliate programs
+type AffiliateProgramSortOrder interface {
+	AffiliateProgramSortOrderConstructor() string
+}
+
+// Represents content of a gift received by a user or a channel chat
+type SentGift interface {
+	SentGiftConstructor() string
+}
+
+// Describes direction of a transaction with Telegram Stars
+type StarTransactionDirection interface {
+	StarTransactionDirectionConstructor() string
+}
+
+// Describes type of transaction with Telegram Stars
+type StarTransactionType interface {
+	StarTransactionTypeConstructor() string
+}
+
+// Contains information about status of a user in a giveaway
+type GiveawayParticipantStatus interface {
+	GiveawayParticipantStatusConstructor() string
+}
+
+// Contains information about a giveaway
+type GiveawayInfo interface {
+	GiveawayInfoConstructor() string
+}
+
+// Contains information about a giveaway prize
+type GiveawayPrize interface {
+	GiveawayPrizeConstructor() string
+}
+
+// Describes type of paid message reaction
+type PaidReactionType interface {
+	PaidReactionTypeConstructor() string
+}
+
+// Contains information about a payment provider
+type PaymentProvider interface {
+	PaymentProviderConstructor() string
+}
+
+// Describes type of payment form
+type PaymentFormType interface {
+	PaymentFormTypeConstructor() string
+}
+
+// Describes type of successful payment
+type PaymentReceiptType interface {
+	PaymentReceiptTypeConstructor() string
+}
+
+// Describes an invoice to process
+type InputInvoice interface {
+	InputInvoiceConstructor() string
+}
+
+// Describes a paid media
+type PaidMedia interface {
+	PaidMediaConstructor() string
+}
+
+// Describes type of paid media to sent
+type InputPaidMediaType interface {
+	InputPaidMediaTypeConstructor() string
+}
+
+// Describes source of a chat boost
+type ChatBoostSource interface {
+	ChatBoostSourceConstructor() string
+}
+
+// Describes type of limit, increased for Premium users
+type PremiumLimitType interface {
+	PremiumLimitTypeConstructor() string
+}
+
+// Describes a feature available to Premium users
+type PremiumFeature interface {
+	PremiumFeatureConstructor() string
+}
+
+// Describes a story feature available to Premium users
+type PremiumStoryFeature interface {
+	PremiumStoryFeatureConstructor() string
+}
+
+// Describes a source from which the Premium features screen is opened
+type PremiumSource interface {
+	PremiumSourceConstructor() string
+}
+
+// Describes a purpose of an in-store payment
+type StorePaymentPurpose interface {
+	StorePaymentPurposeConstructor() string
+}
+
+// Describes an in-store transaction
+type StoreTransaction interface {
+	StoreTransactionConstructor() string
+}
+
+// Describes a purpose of a payment toward Telegram
+type TelegramPaymentPurpose interface {
+	TelegramPaymentPurposeConstructor() string
+}
+
+// Describes state of a revenue withdrawal
+type RevenueWithdrawalState interface {
+	RevenueWithdrawalStateConstructor() string
+}
+
+// Describes type of transaction for revenue earned from sponsored messages in a chat
+type ChatRevenueTransactionType interface {
+	ChatRevenueTransactionTypeConstructor() string
+}
+
+func (entity *UnknownType) StarSubscriptionTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) AffiliateTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) AffiliateProgramSortOrderConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) SentGiftConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StarTransactionDirectionConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StarTransactionTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) GiveawayParticipantStatusConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) GiveawayInfoConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) GiveawayPrizeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PaidReactionTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PaymentProviderConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PaymentFormTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PaymentReceiptTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputInvoiceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PaidMediaConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputPaidMediaTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatBoostSourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PremiumLimitTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PremiumFeatureConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PremiumStoryFeatureConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) PremiumSourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StorePaymentPurposeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StoreTransactionConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) TelegramPaymentPurposeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) RevenueWithdrawalStateConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatRevenueTransactionTypeConstructor() string {
+	return entity.Constructor
+}
+
+// Describes a possibly non-integer amount of Telegram Stars
+type StarAmount struct {
+	meta
+	// The integer amount of Telegram Stars rounded to 0
+	StarCount int64 `json:"star_count"`
+	// The number of 1/1000000000 shares of Telegram Stars; from -999999999 to 999999999
+	NanostarCount int32 `json:"nanostar_count"`
+}
+
+func (*StarAmount) GetType() string {
+	return TypeStarAmount
+}
+
+func (*StarAmount) GetConstructor() string {
+	return ConstructorStarAmount
+}
+
+func (entity *StarAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarAmount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starAmount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"nanostar_count":`)
+	encoder.writeInt32(entity.NanostarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarAmount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "nanostar_count":
+			entity.NanostarCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarAmount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a subscription to a channel chat
+type StarSubscriptionTypeChannel struct {
+	meta
+	// True, if the subscription is active and the user can use the method reuseStarSubscription to join the subscribed chat again
+	CanReuse bool `json:"can_reuse"`
+	// The invite link that can be used to renew the subscription if it has been expired; may be empty, if the link isn't available anymore
+	InviteLink string `json:"invite_link"`
+}
+
+func (*StarSubscriptionTypeChannel) GetType() string {
+	return TypeStarSubscriptionType
+}
+
+func (*StarSubscriptionTypeChannel) GetConstructor() string {
+	return ConstructorStarSubscriptionTypeChannel
+}
+
+func (*StarSubscriptionTypeChannel) StarSubscriptionTypeConstructor() string {
+	return ConstructorStarSubscriptionTypeChannel
+}
+
+func (entity *StarSubscriptionTypeChannel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarSubscriptionTypeChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarSubscriptionTypeChannel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starSubscriptionTypeChannel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_reuse":`)
+	encoder.writeBool(entity.CanReuse)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(entity.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarSubscriptionTypeChannel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_reuse":
+			entity.CanReuse, err = decoder.readBool()
+
+		case "invite_link":
+			entity.InviteLink, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarSubscriptionTypeChannel)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a subscription in a bot or a business account
+type StarSubscriptionTypeBot struct {
+	meta
+	// True, if the subscription was canceled by the bot and can't be extended
+	IsCanceledByBot bool `json:"is_canceled_by_bot"`
+	// Subscription invoice title
+	Title string `json:"title"`
+	// Subscription invoice photo
+	Photo *Photo `json:"photo"`
+	// The link to the subscription invoice
+	InvoiceLink string `json:"invoice_link"`
+}
+
+func (*StarSubscriptionTypeBot) GetType() string {
+	return TypeStarSubscriptionType
+}
+
+func (*StarSubscriptionTypeBot) GetConstructor() string {
+	return ConstructorStarSubscriptionTypeBot
+}
+
+func (*StarSubscriptionTypeBot) StarSubscriptionTypeConstructor() string {
+	return ConstructorStarSubscriptionTypeBot
+}
+
+func (entity *StarSubscriptionTypeBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarSubscriptionTypeBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarSubscriptionTypeBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starSubscriptionTypeBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_canceled_by_bot":`)
+	encoder.writeBool(entity.IsCanceledByBot)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw(`,"invoice_link":`)
+	encoder.writeString(entity.InvoiceLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarSubscriptionTypeBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_canceled_by_bot":
+			entity.IsCanceledByBot, err = decoder.readBool()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		case "invoice_link":
+			entity.InvoiceLink, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarSubscriptionTypeBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes subscription plan paid in Telegram Stars
+type StarSubscriptionPricing struct {
+	meta
+	// The number of seconds between consecutive Telegram Star debiting
+	Period int32 `json:"period"`
+	// The amount of Telegram Stars that must be paid for each period
+	StarCount int64 `json:"star_count"`
+}
+
+func (*StarSubscriptionPricing) GetType() string {
+	return TypeStarSubscriptionPricing
+}
+
+func (*StarSubscriptionPricing) GetConstructor() string {
+	return ConstructorStarSubscriptionPricing
+}
+
+func (entity *StarSubscriptionPricing) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarSubscriptionPricing) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarSubscriptionPricing) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starSubscriptionPricing"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"period":`)
+	encoder.writeInt32(entity.Period)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarSubscriptionPricing) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "period":
+			entity.Period, err = decoder.readInt32()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarSubscriptionPricing)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about subscription to a channel chat, a bot, or a business account that was paid in Telegram Stars
+type StarSubscription struct {
+	meta
+	// Unique identifier of the subscription
+	Id string `json:"id"`
+	// Identifier of the chat that is subscribed
+	ChatId int64 `json:"chat_id"`
+	// Point in time (Unix timestamp) when the subscription will expire or expired
+	ExpirationDate int32 `json:"expiration_date"`
+	// True, if the subscription was canceled
+	IsCanceled bool `json:"is_canceled"`
+	// True, if the subscription expires soon and there are no enough Telegram Stars on the user's balance to extend it
+	IsExpiring bool `json:"is_expiring"`
+	// The subscription plan
+	Pricing *StarSubscriptionPricing `json:"pricing"`
+	// Type of the subscription
+	Type StarSubscriptionType `json:"type"`
+}
+
+func (*StarSubscription) GetType() string {
+	return TypeStarSubscription
+}
+
+func (*StarSubscription) GetConstructor() string {
+	return ConstructorStarSubscription
+}
+
+func (entity *StarSubscription) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarSubscription) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarSubscription) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starSubscription"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(entity.ExpirationDate)
+	encoder.writeRaw(`,"is_canceled":`)
+	encoder.writeBool(entity.IsCanceled)
+	encoder.writeRaw(`,"is_expiring":`)
+	encoder.writeBool(entity.IsExpiring)
+	encoder.writeRaw(`,"pricing":`)
+	entity.Pricing.encodeJSON(encoder)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarSubscription) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decoder.readInt32()
+
+		case "is_canceled":
+			entity.IsCanceled, err = decoder.readBool()
+
+		case "is_expiring":
+			entity.IsExpiring, err = decoder.readBool()
+
+		case "pricing":
+			entity.Pricing, err = decodeEntity[StarSubscriptionPricing](decoder)
+
+		case "type":
+			entity.Type, err = decodeStarSubscriptionType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarSubscription)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of Telegram Star subscriptions
+type StarSubscriptions struct {
+	meta
+	// The amount of owned Telegram Stars
+	StarAmount *StarAmount `json:"star_amount"`
+	// List of subscriptions for Telegram Stars
+	Subscriptions []*StarSubscription `json:"subscriptions"`
+	// The number of Telegram Stars required to buy to extend subscriptions expiring soon
+	RequiredStarCount int64 `json:"required_star_count"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*StarSubscriptions) GetType() string {
+	return TypeStarSubscriptions
+}
+
+func (*StarSubscriptions) GetConstructor() string {
+	return ConstructorStarSubscriptions
+}
+
+func (entity *StarSubscriptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarSubscriptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarSubscriptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starSubscriptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_amount":`)
+	entity.StarAmount.encodeJSON(encoder)
+	encoder.writeRaw(`,"subscriptions":`)
+	writeList(encoder, entity.Subscriptions, writeTypeValue[*StarSubscription])
+	encoder.writeRaw(`,"required_star_count":`)
+	encoder.writeInt64(entity.RequiredStarCount)
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarSubscriptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_amount":
+			entity.StarAmount, err = decodeEntity[StarAmount](decoder)
+
+		case "subscriptions":
+			entity.Subscriptions, err = readList(decoder, decodeEntity[StarSubscription])
+
+		case "required_star_count":
+			entity.RequiredStarCount, err = decoder.readInt64()
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarSubscriptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The affiliate is the current user
+type AffiliateTypeCurrentUser struct {
+	meta
+}
+
+func (*AffiliateTypeCurrentUser) GetType() string {
+	return TypeAffiliateType
+}
+
+func (*AffiliateTypeCurrentUser) GetConstructor() string {
+	return ConstructorAffiliateTypeCurrentUser
+}
+
+func (*AffiliateTypeCurrentUser) AffiliateTypeConstructor() string {
+	return ConstructorAffiliateTypeCurrentUser
+}
+
+func (entity *AffiliateTypeCurrentUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateTypeCurrentUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateTypeCurrentUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateTypeCurrentUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateTypeCurrentUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAffiliateTypeCurrentUser)
+	})
+}
+
+// The affiliate is a bot owned by the current user
+type AffiliateTypeBot struct {
+	meta
+	// User identifier of the bot
+	UserId int64 `json:"user_id"`
+}
+
+func (*AffiliateTypeBot) GetType() string {
+	return TypeAffiliateType
+}
+
+func (*AffiliateTypeBot) GetConstructor() string {
+	return ConstructorAffiliateTypeBot
+}
+
+func (*AffiliateTypeBot) AffiliateTypeConstructor() string {
+	return ConstructorAffiliateTypeBot
+}
+
+func (entity *AffiliateTypeBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateTypeBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateTypeBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateTypeBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateTypeBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAffiliateTypeBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The affiliate is a channel chat where the current user has can_post_messages administrator right
+type AffiliateTypeChannel struct {
+	meta
+	// Identifier of the channel chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*AffiliateTypeChannel) GetType() string {
+	return TypeAffiliateType
+}
+
+func (*AffiliateTypeChannel) GetConstructor() string {
+	return ConstructorAffiliateTypeChannel
+}
+
+func (*AffiliateTypeChannel) AffiliateTypeConstructor() string {
+	return ConstructorAffiliateTypeChannel
+}
+
+func (entity *AffiliateTypeChannel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateTypeChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateTypeChannel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateTypeChannel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateTypeChannel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAffiliateTypeChannel)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The affiliate programs must be sorted by the profitability
+type AffiliateProgramSortOrderProfitability struct {
+	meta
+}
+
+func (*AffiliateProgramSortOrderProfitability) GetType() string {
+	return TypeAffiliateProgramSortOrder
+}
+
+func (*AffiliateProgramSortOrderProfitability) GetConstructor() string {
+	return ConstructorAffiliateProgramSortOrderProfitability
+}
+
+func (*AffiliateProgramSortOrderProfitability) AffiliateProgramSortOrderConstructor() string {
+	return ConstructorAffiliateProgramSortOrderProfitability
+}
+
+func (entity *AffiliateProgramSortOrderProfitability) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateProgramSortOrderProfitability) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateProgramSortOrderProfitability) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateProgramSortOrderProfitability"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateProgramSortOrderProfitability) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAffiliateProgramSortOrderProfitability)
+	})
+}
+
+// The affiliate programs must be sorted by creation date
+type AffiliateProgramSortOrderCreationDate struct {
+	meta
+}
+
+func (*AffiliateProgramSortOrderCreationDate) GetType() string {
+	return TypeAffiliateProgramSortOrder
+}
+
+func (*AffiliateProgramSortOrderCreationDate) GetConstructor() string {
+	return ConstructorAffiliateProgramSortOrderCreationDate
+}
+
+func (*AffiliateProgramSortOrderCreationDate) AffiliateProgramSortOrderConstructor() string {
+	return ConstructorAffiliateProgramSortOrderCreationDate
+}
+
+func (entity *AffiliateProgramSortOrderCreationDate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateProgramSortOrderCreationDate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateProgramSortOrderCreationDate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateProgramSortOrderCreationDate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateProgramSortOrderCreationDate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAffiliateProgramSortOrderCreationDate)
+	})
+}
+
+// The affiliate programs must be sorted by the expected revenue
+type AffiliateProgramSortOrderRevenue struct {
+	meta
+}
+
+func (*AffiliateProgramSortOrderRevenue) GetType() string {
+	return TypeAffiliateProgramSortOrder
+}
+
+func (*AffiliateProgramSortOrderRevenue) GetConstructor() string {
+	return ConstructorAffiliateProgramSortOrderRevenue
+}
+
+func (*AffiliateProgramSortOrderRevenue) AffiliateProgramSortOrderConstructor() string {
+	return ConstructorAffiliateProgramSortOrderRevenue
+}
+
+func (entity *AffiliateProgramSortOrderRevenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateProgramSortOrderRevenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateProgramSortOrderRevenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateProgramSortOrderRevenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateProgramSortOrderRevenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorAffiliateProgramSortOrderRevenue)
+	})
+}
+
+// Describes parameters of an affiliate program
+type AffiliateProgramParameters struct {
+	meta
+	// The number of Telegram Stars received by the affiliate for each 1000 Telegram Stars received by the program owner; getOption("affiliate_program_commission_per_mille_min")-getOption("affiliate_program_commission_per_mille_max")
+	CommissionPerMille int32 `json:"commission_per_mille"`
+	// Number of months the program will be active; 0-36. If 0, then the program is eternal
+	MonthCount int32 `json:"month_count"`
+}
+
+func (*AffiliateProgramParameters) GetType() string {
+	return TypeAffiliateProgramParameters
+}
+
+func (*AffiliateProgramParameters) GetConstructor() string {
+	return ConstructorAffiliateProgramParameters
+}
+
+func (entity *AffiliateProgramParameters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateProgramParameters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateProgramParameters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateProgramParameters"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"commission_per_mille":`)
+	encoder.writeInt32(entity.CommissionPerMille)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateProgramParameters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "commission_per_mille":
+			entity.CommissionPerMille, err = decoder.readInt32()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAffiliateProgramParameters)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an active affiliate program
+type AffiliateProgramInfo struct {
+	meta
+	// Parameters of the affiliate program
+	Parameters *AffiliateProgramParameters `json:"parameters"`
+	// Point in time (Unix timestamp) when the affiliate program will be closed; 0 if the affiliate program isn't scheduled to be closed. If positive, then the program can't be connected using connectAffiliateProgram, but active connections will work until the date
+	EndDate int32 `json:"end_date"`
+	// The amount of daily revenue per user in Telegram Stars of the bot that created the affiliate program
+	DailyRevenuePerUserAmount *StarAmount `json:"daily_revenue_per_user_amount"`
+}
+
+func (*AffiliateProgramInfo) GetType() string {
+	return TypeAffiliateProgramInfo
+}
+
+func (*AffiliateProgramInfo) GetConstructor() string {
+	return ConstructorAffiliateProgramInfo
+}
+
+func (entity *AffiliateProgramInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateProgramInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateProgramInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateProgramInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"parameters":`)
+	entity.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"end_date":`)
+	encoder.writeInt32(entity.EndDate)
+	encoder.writeRaw(`,"daily_revenue_per_user_amount":`)
+	entity.DailyRevenuePerUserAmount.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateProgramInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "parameters":
+			entity.Parameters, err = decodeEntity[AffiliateProgramParameters](decoder)
+
+		case "end_date":
+			entity.EndDate, err = decoder.readInt32()
+
+		case "daily_revenue_per_user_amount":
+			entity.DailyRevenuePerUserAmount, err = decodeEntity[StarAmount](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAffiliateProgramInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an affiliate that received commission from a Telegram Star transaction
+type AffiliateInfo struct {
+	meta
+	// The number of Telegram Stars received by the affiliate for each 1000 Telegram Stars received by the program owner
+	CommissionPerMille int32 `json:"commission_per_mille"`
+	// Identifier of the chat which received the commission
+	AffiliateChatId int64 `json:"affiliate_chat_id"`
+	// The amount of Telegram Stars that were received by the affiliate; can be negative for refunds
+	StarAmount *StarAmount `json:"star_amount"`
+}
+
+func (*AffiliateInfo) GetType() string {
+	return TypeAffiliateInfo
+}
+
+func (*AffiliateInfo) GetConstructor() string {
+	return ConstructorAffiliateInfo
+}
+
+func (entity *AffiliateInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AffiliateInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AffiliateInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"affiliateInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"commission_per_mille":`)
+	encoder.writeInt32(entity.CommissionPerMille)
+	encoder.writeRaw(`,"affiliate_chat_id":`)
+	encoder.writeInt64(entity.AffiliateChatId)
+	encoder.writeRaw(`,"star_amount":`)
+	entity.StarAmount.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *AffiliateInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "commission_per_mille":
+			entity.CommissionPerMille, err = decoder.readInt32()
+
+		case "affiliate_chat_id":
+			entity.AffiliateChatId, err = decoder.readInt64()
+
+		case "star_amount":
+			entity.StarAmount, err = decodeEntity[StarAmount](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAffiliateInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a found affiliate program
+type FoundAffiliateProgram struct {
+	meta
+	// User identifier of the bot created the program
+	BotUserId int64 `json:"bot_user_id"`
+	// Information about the affiliate program
+	Info *AffiliateProgramInfo `json:"info"`
+}
+
+func (*FoundAffiliateProgram) GetType() string {
+	return TypeFoundAffiliateProgram
+}
+
+func (*FoundAffiliateProgram) GetConstructor() string {
+	return ConstructorFoundAffiliateProgram
+}
+
+func (entity *FoundAffiliateProgram) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundAffiliateProgram) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundAffiliateProgram) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundAffiliateProgram"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"info":`)
+	entity.Info.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundAffiliateProgram) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "info":
+			entity.Info, err = decodeEntity[AffiliateProgramInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundAffiliateProgram)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of found affiliate programs
+type FoundAffiliatePrograms struct {
+	meta
+	// The total number of found affiliate programs
+	TotalCount int32 `json:"total_count"`
+	// The list of affiliate programs
+	Programs []*FoundAffiliateProgram `json:"programs"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*FoundAffiliatePrograms) GetType() string {
+	return TypeFoundAffiliatePrograms
+}
+
+func (*FoundAffiliatePrograms) GetConstructor() string {
+	return ConstructorFoundAffiliatePrograms
+}
+
+func (entity *FoundAffiliatePrograms) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundAffiliatePrograms) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundAffiliatePrograms) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundAffiliatePrograms"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"programs":`)
+	writeList(encoder, entity.Programs, writeTypeValue[*FoundAffiliateProgram])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundAffiliatePrograms) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "programs":
+			entity.Programs, err = readList(decoder, decodeEntity[FoundAffiliateProgram])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundAffiliatePrograms)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an affiliate program that was connected to an affiliate
+type ConnectedAffiliateProgram struct {
+	meta
+	// The link that can be used to refer users if the program is still active
+	Url string `json:"url"`
+	// User identifier of the bot created the program
+	BotUserId int64 `json:"bot_user_id"`
+	// The parameters of the affiliate program
+	Parameters *AffiliateProgramParameters `json:"parameters"`
+	// Point in time (Unix timestamp) when the affiliate program was connected
+	ConnectionDate int32 `json:"connection_date"`
+	// True, if the program was canceled by the bot, or disconnected by the chat owner and isn't available anymore
+	IsDisconnected bool `json:"is_disconnected"`
+	// The number of users that used the affiliate program
+	UserCount JsonInt64 `json:"user_count"`
+	// The number of Telegram Stars that were earned by the affiliate program
+	RevenueStarCount JsonInt64 `json:"revenue_star_count"`
+}
+
+func (*ConnectedAffiliateProgram) GetType() string {
+	return TypeConnectedAffiliateProgram
+}
+
+func (*ConnectedAffiliateProgram) GetConstructor() string {
+	return ConstructorConnectedAffiliateProgram
+}
+
+func (entity *ConnectedAffiliateProgram) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectedAffiliateProgram) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectedAffiliateProgram) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectedAffiliateProgram"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"bot_user_id":`)
+	encoder.writeInt64(entity.BotUserId)
+	encoder.writeRaw(`,"parameters":`)
+	entity.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"connection_date":`)
+	encoder.writeInt32(entity.ConnectionDate)
+	encoder.writeRaw(`,"is_disconnected":`)
+	encoder.writeBool(entity.IsDisconnected)
+	encoder.writeRaw(`,"user_count":`)
+	encoder.writeJsonInt64(entity.UserCount)
+	encoder.writeRaw(`,"revenue_star_count":`)
+	encoder.writeJsonInt64(entity.RevenueStarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectedAffiliateProgram) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "bot_user_id":
+			entity.BotUserId, err = decoder.readInt64()
+
+		case "parameters":
+			entity.Parameters, err = decodeEntity[AffiliateProgramParameters](decoder)
+
+		case "connection_date":
+			entity.ConnectionDate, err = decoder.readInt32()
+
+		case "is_disconnected":
+			entity.IsDisconnected, err = decoder.readBool()
+
+		case "user_count":
+			entity.UserCount, err = decoder.readJsonInt64()
+
+		case "revenue_star_count":
+			entity.RevenueStarCount, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorConnectedAffiliateProgram)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of affiliate programs that were connected to an affiliate
+type ConnectedAffiliatePrograms struct {
+	meta
+	// The total number of affiliate programs that were connected to the affiliate
+	TotalCount int32 `json:"total_count"`
+	// The list of connected affiliate programs
+	Programs []*ConnectedAffiliateProgram `json:"programs"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*ConnectedAffiliatePrograms) GetType() string {
+	return TypeConnectedAffiliatePrograms
+}
+
+func (*ConnectedAffiliatePrograms) GetConstructor() string {
+	return ConstructorConnectedAffiliatePrograms
+}
+
+func (entity *ConnectedAffiliatePrograms) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ConnectedAffiliatePrograms) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ConnectedAffiliatePrograms) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"connectedAffiliatePrograms"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"programs":`)
+	writeList(encoder, entity.Programs, writeTypeValue[*ConnectedAffiliateProgram])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *ConnectedAffiliatePrograms) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "programs":
+			entity.Programs, err = readList(decoder, decodeEntity[ConnectedAffiliateProgram])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorConnectedAffiliatePrograms)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option for buying Telegram Premium to a user
+type PremiumPaymentOption struct {
+	meta
+	// ISO 4217 currency code for Telegram Premium subscription payment
+	Currency string `json:"currency"`
+	// The amount to pay, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// The discount associated with this option, as a percentage
+	DiscountPercentage int32 `json:"discount_percentage"`
+	// Number of months the Telegram Premium subscription will be active. Use getPremiumInfoSticker to get the sticker to be used as representation of the Telegram Premium subscription
+	MonthCount int32 `json:"month_count"`
+	// Identifier of the store product associated with the option
+	StoreProductId string `json:"store_product_id"`
+	// An internal link to be opened for buying Telegram Premium to the user if store payment isn't possible; may be null if direct payment isn't available
+	PaymentLink InternalLinkType `json:"payment_link,omitempty"`
+}
+
+func (*PremiumPaymentOption) GetType() string {
+	return TypePremiumPaymentOption
+}
+
+func (*PremiumPaymentOption) GetConstructor() string {
+	return ConstructorPremiumPaymentOption
+}
+
+func (entity *PremiumPaymentOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumPaymentOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumPaymentOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumPaymentOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"discount_percentage":`)
+	encoder.writeInt32(entity.DiscountPercentage)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw(`,"store_product_id":`)
+	encoder.writeString(entity.StoreProductId)
+	if entity.PaymentLink != nil {
+		encoder.writeRaw(`,"payment_link":`)
+		encoder.writeType(entity.PaymentLink)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumPaymentOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "discount_percentage":
+			entity.DiscountPercentage, err = decoder.readInt32()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "store_product_id":
+			entity.StoreProductId, err = decoder.readString()
+
+		case "payment_link":
+			entity.PaymentLink, err = decodeInternalLinkType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumPaymentOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option for buying or upgrading Telegram Premium for self
+type PremiumStatePaymentOption struct {
+	meta
+	// Information about the payment option
+	PaymentOption *PremiumPaymentOption `json:"payment_option"`
+	// True, if this is the currently used Telegram Premium subscription option
+	IsCurrent bool `json:"is_current"`
+	// True, if the payment option can be used to upgrade the existing Telegram Premium subscription
+	IsUpgrade bool `json:"is_upgrade"`
+	// Identifier of the last in-store transaction for the currently used option
+	LastTransactionId string `json:"last_transaction_id"`
+}
+
+func (*PremiumStatePaymentOption) GetType() string {
+	return TypePremiumStatePaymentOption
+}
+
+func (*PremiumStatePaymentOption) GetConstructor() string {
+	return ConstructorPremiumStatePaymentOption
+}
+
+func (entity *PremiumStatePaymentOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStatePaymentOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStatePaymentOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStatePaymentOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"payment_option":`)
+	entity.PaymentOption.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_current":`)
+	encoder.writeBool(entity.IsCurrent)
+	encoder.writeRaw(`,"is_upgrade":`)
+	encoder.writeBool(entity.IsUpgrade)
+	encoder.writeRaw(`,"last_transaction_id":`)
+	encoder.writeString(entity.LastTransactionId)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStatePaymentOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "payment_option":
+			entity.PaymentOption, err = decodeEntity[PremiumPaymentOption](decoder)
+
+		case "is_current":
+			entity.IsCurrent, err = decoder.readBool()
+
+		case "is_upgrade":
+			entity.IsUpgrade, err = decoder.readBool()
+
+		case "last_transaction_id":
+			entity.LastTransactionId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumStatePaymentOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option for gifting Telegram Premium to a user. Use telegramPaymentPurposePremiumGift for out-of-store payments or payments in Telegram Stars
+type PremiumGiftPaymentOption struct {
+	meta
+	// ISO 4217 currency code for the payment
+	Currency string `json:"currency"`
+	// The amount to pay, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// The alternative amount of Telegram Stars to pay; 0 if payment in Telegram Stars is not possible
+	StarCount int64 `json:"star_count"`
+	// The discount associated with this option, as a percentage
+	DiscountPercentage int32 `json:"discount_percentage"`
+	// Number of months the Telegram Premium subscription will be active
+	MonthCount int32 `json:"month_count"`
+	// Identifier of the store product associated with the option
+	StoreProductId string `json:"store_product_id"`
+	// A sticker to be shown along with the option; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*PremiumGiftPaymentOption) GetType() string {
+	return TypePremiumGiftPaymentOption
+}
+
+func (*PremiumGiftPaymentOption) GetConstructor() string {
+	return ConstructorPremiumGiftPaymentOption
+}
+
+func (entity *PremiumGiftPaymentOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumGiftPaymentOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumGiftPaymentOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumGiftPaymentOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"discount_percentage":`)
+	encoder.writeInt32(entity.DiscountPercentage)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw(`,"store_product_id":`)
+	encoder.writeString(entity.StoreProductId)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumGiftPaymentOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "discount_percentage":
+			entity.DiscountPercentage, err = decoder.readInt32()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "store_product_id":
+			entity.StoreProductId, err = decoder.readString()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumGiftPaymentOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of options for gifting Telegram Premium to a user
+type PremiumGiftPaymentOptions struct {
+	meta
+	// The list of options sorted by Telegram Premium subscription duration
+	Options []*PremiumGiftPaymentOption `json:"options"`
+}
+
+func (*PremiumGiftPaymentOptions) GetType() string {
+	return TypePremiumGiftPaymentOptions
+}
+
+func (*PremiumGiftPaymentOptions) GetConstructor() string {
+	return ConstructorPremiumGiftPaymentOptions
+}
+
+func (entity *PremiumGiftPaymentOptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumGiftPaymentOptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumGiftPaymentOptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumGiftPaymentOptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*PremiumGiftPaymentOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumGiftPaymentOptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[PremiumGiftPaymentOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumGiftPaymentOptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option for creating of Telegram Premium giveaway or manual distribution of Telegram Premium among chat members. Use telegramPaymentPurposePremiumGiftCodes or telegramPaymentPurposePremiumGiveaway for out-of-store payments
+type PremiumGiveawayPaymentOption struct {
+	meta
+	// ISO 4217 currency code for Telegram Premium gift code payment
+	Currency string `json:"currency"`
+	// The amount to pay, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of users which will be able to activate the gift codes
+	WinnerCount int32 `json:"winner_count"`
+	// Number of months the Telegram Premium subscription will be active
+	MonthCount int32 `json:"month_count"`
+	// Identifier of the store product associated with the option; may be empty if none
+	StoreProductId string `json:"store_product_id"`
+	// Number of times the store product must be paid
+	StoreProductQuantity int32 `json:"store_product_quantity"`
+}
+
+func (*PremiumGiveawayPaymentOption) GetType() string {
+	return TypePremiumGiveawayPaymentOption
+}
+
+func (*PremiumGiveawayPaymentOption) GetConstructor() string {
+	return ConstructorPremiumGiveawayPaymentOption
+}
+
+func (entity *PremiumGiveawayPaymentOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumGiveawayPaymentOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumGiveawayPaymentOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumGiveawayPaymentOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw(`,"store_product_id":`)
+	encoder.writeString(entity.StoreProductId)
+	encoder.writeRaw(`,"store_product_quantity":`)
+	encoder.writeInt32(entity.StoreProductQuantity)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumGiveawayPaymentOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "store_product_id":
+			entity.StoreProductId, err = decoder.readString()
+
+		case "store_product_quantity":
+			entity.StoreProductQuantity, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumGiveawayPaymentOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of options for creating of Telegram Premium giveaway or manual distribution of Telegram Premium among chat members
+type PremiumGiveawayPaymentOptions struct {
+	meta
+	// The list of options
+	Options []*PremiumGiveawayPaymentOption `json:"options"`
+}
+
+func (*PremiumGiveawayPaymentOptions) GetType() string {
+	return TypePremiumGiveawayPaymentOptions
+}
+
+func (*PremiumGiveawayPaymentOptions) GetConstructor() string {
+	return ConstructorPremiumGiveawayPaymentOptions
+}
+
+func (entity *PremiumGiveawayPaymentOptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumGiveawayPaymentOptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumGiveawayPaymentOptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumGiveawayPaymentOptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*PremiumGiveawayPaymentOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumGiveawayPaymentOptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[PremiumGiveawayPaymentOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumGiveawayPaymentOptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a Telegram Premium gift code
+type PremiumGiftCodeInfo struct {
+	meta
+	// Identifier of a chat or a user that created the gift code; may be null if unknown. If null and the code is from messagePremiumGiftCode message, then creator_id from the message can be used
+	CreatorId MessageSender `json:"creator_id,omitempty"`
+	// Point in time (Unix timestamp) when the code was created
+	CreationDate int32 `json:"creation_date"`
+	// True, if the gift code was created for a giveaway
+	IsFromGiveaway bool `json:"is_from_giveaway"`
+	// Identifier of the corresponding giveaway message in the creator_id chat; can be 0 or an identifier of a deleted message
+	GiveawayMessageId int64 `json:"giveaway_message_id"`
+	// Number of months the Telegram Premium subscription will be active after code activation
+	MonthCount int32 `json:"month_count"`
+	// Identifier of a user for which the code was created; 0 if none
+	UserId int64 `json:"user_id"`
+	// Point in time (Unix timestamp) when the code was activated; 0 if none
+	UseDate int32 `json:"use_date"`
+}
+
+func (*PremiumGiftCodeInfo) GetType() string {
+	return TypePremiumGiftCodeInfo
+}
+
+func (*PremiumGiftCodeInfo) GetConstructor() string {
+	return ConstructorPremiumGiftCodeInfo
+}
+
+func (entity *PremiumGiftCodeInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumGiftCodeInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumGiftCodeInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumGiftCodeInfo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.CreatorId != nil {
+		encoder.writeRaw(`,"creator_id":`)
+		encoder.writeType(entity.CreatorId)
+	}
+	encoder.writeRaw(`,"creation_date":`)
+	encoder.writeInt32(entity.CreationDate)
+	encoder.writeRaw(`,"is_from_giveaway":`)
+	encoder.writeBool(entity.IsFromGiveaway)
+	encoder.writeRaw(`,"giveaway_message_id":`)
+	encoder.writeInt64(entity.GiveawayMessageId)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"use_date":`)
+	encoder.writeInt32(entity.UseDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumGiftCodeInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "creator_id":
+			entity.CreatorId, err = decodeMessageSender(decoder)
+
+		case "creation_date":
+			entity.CreationDate, err = decoder.readInt32()
+
+		case "is_from_giveaway":
+			entity.IsFromGiveaway, err = decoder.readBool()
+
+		case "giveaway_message_id":
+			entity.GiveawayMessageId, err = decoder.readInt64()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "use_date":
+			entity.UseDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumGiftCodeInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option for buying Telegram Stars. Use telegramPaymentPurposeStars for out-of-store payments
+type StarPaymentOption struct {
+	meta
+	// ISO 4217 currency code for the payment
+	Currency string `json:"currency"`
+	// The amount to pay, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of Telegram Stars that will be purchased
+	StarCount int64 `json:"star_count"`
+	// Identifier of the store product associated with the option; may be empty if none
+	StoreProductId string `json:"store_product_id"`
+	// True, if the option must be shown only in the full list of payment options
+	IsAdditional bool `json:"is_additional"`
+}
+
+func (*StarPaymentOption) GetType() string {
+	return TypeStarPaymentOption
+}
+
+func (*StarPaymentOption) GetConstructor() string {
+	return ConstructorStarPaymentOption
+}
+
+func (entity *StarPaymentOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarPaymentOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarPaymentOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starPaymentOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"store_product_id":`)
+	encoder.writeString(entity.StoreProductId)
+	encoder.writeRaw(`,"is_additional":`)
+	encoder.writeBool(entity.IsAdditional)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarPaymentOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "store_product_id":
+			entity.StoreProductId, err = decoder.readString()
+
+		case "is_additional":
+			entity.IsAdditional, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarPaymentOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of options for buying Telegram Stars
+type StarPaymentOptions struct {
+	meta
+	// The list of options
+	Options []*StarPaymentOption `json:"options"`
+}
+
+func (*StarPaymentOptions) GetType() string {
+	return TypeStarPaymentOptions
+}
+
+func (*StarPaymentOptions) GetConstructor() string {
+	return ConstructorStarPaymentOptions
+}
+
+func (entity *StarPaymentOptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarPaymentOptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarPaymentOptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starPaymentOptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*StarPaymentOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *StarPaymentOptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[StarPaymentOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarPaymentOptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option for the number of winners of a Telegram Star giveaway
+type StarGiveawayWinnerOption struct {
+	meta
+	// The number of users that will be chosen as winners
+	WinnerCount int32 `json:"winner_count"`
+	// The number of Telegram Stars that will be won by the winners of the giveaway
+	WonStarCount int64 `json:"won_star_count"`
+	// True, if the option must be chosen by default
+	IsDefault bool `json:"is_default"`
+}
+
+func (*StarGiveawayWinnerOption) GetType() string {
+	return TypeStarGiveawayWinnerOption
+}
+
+func (*StarGiveawayWinnerOption) GetConstructor() string {
+	return ConstructorStarGiveawayWinnerOption
+}
+
+func (entity *StarGiveawayWinnerOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarGiveawayWinnerOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarGiveawayWinnerOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starGiveawayWinnerOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"won_star_count":`)
+	encoder.writeInt64(entity.WonStarCount)
+	encoder.writeRaw(`,"is_default":`)
+	encoder.writeBool(entity.IsDefault)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarGiveawayWinnerOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "won_star_count":
+			entity.WonStarCount, err = decoder.readInt64()
+
+		case "is_default":
+			entity.IsDefault, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarGiveawayWinnerOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an option for creating of Telegram Star giveaway. Use telegramPaymentPurposeStarGiveaway for out-of-store payments
+type StarGiveawayPaymentOption struct {
+	meta
+	// ISO 4217 currency code for the payment
+	Currency string `json:"currency"`
+	// The amount to pay, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of Telegram Stars that will be distributed among winners
+	StarCount int64 `json:"star_count"`
+	// Identifier of the store product associated with the option; may be empty if none
+	StoreProductId string `json:"store_product_id"`
+	// Number of times the chat will be boosted for one year if the option is chosen
+	YearlyBoostCount int32 `json:"yearly_boost_count"`
+	// Allowed options for the number of giveaway winners
+	WinnerOptions []*StarGiveawayWinnerOption `json:"winner_options"`
+	// True, if the option must be chosen by default
+	IsDefault bool `json:"is_default"`
+	// True, if the option must be shown only in the full list of payment options
+	IsAdditional bool `json:"is_additional"`
+}
+
+func (*StarGiveawayPaymentOption) GetType() string {
+	return TypeStarGiveawayPaymentOption
+}
+
+func (*StarGiveawayPaymentOption) GetConstructor() string {
+	return ConstructorStarGiveawayPaymentOption
+}
+
+func (entity *StarGiveawayPaymentOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarGiveawayPaymentOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarGiveawayPaymentOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starGiveawayPaymentOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"store_product_id":`)
+	encoder.writeString(entity.StoreProductId)
+	encoder.writeRaw(`,"yearly_boost_count":`)
+	encoder.writeInt32(entity.YearlyBoostCount)
+	encoder.writeRaw(`,"winner_options":`)
+	writeList(encoder, entity.WinnerOptions, writeTypeValue[*StarGiveawayWinnerOption])
+	encoder.writeRaw(`,"is_default":`)
+	encoder.writeBool(entity.IsDefault)
+	encoder.writeRaw(`,"is_additional":`)
+	encoder.writeBool(entity.IsAdditional)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarGiveawayPaymentOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "store_product_id":
+			entity.StoreProductId, err = decoder.readString()
+
+		case "yearly_boost_count":
+			entity.YearlyBoostCount, err = decoder.readInt32()
+
+		case "winner_options":
+			entity.WinnerOptions, err = readList(decoder, decodeEntity[StarGiveawayWinnerOption])
+
+		case "is_default":
+			entity.IsDefault, err = decoder.readBool()
+
+		case "is_additional":
+			entity.IsAdditional, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarGiveawayPaymentOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of options for creating of Telegram Star giveaway
+type StarGiveawayPaymentOptions struct {
+	meta
+	// The list of options
+	Options []*StarGiveawayPaymentOption `json:"options"`
+}
+
+func (*StarGiveawayPaymentOptions) GetType() string {
+	return TypeStarGiveawayPaymentOptions
+}
+
+func (*StarGiveawayPaymentOptions) GetConstructor() string {
+	return ConstructorStarGiveawayPaymentOptions
+}
+
+func (entity *StarGiveawayPaymentOptions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarGiveawayPaymentOptions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarGiveawayPaymentOptions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starGiveawayPaymentOptions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*StarGiveawayPaymentOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *StarGiveawayPaymentOptions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[StarGiveawayPaymentOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarGiveawayPaymentOptions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes gift types that are accepted by a user
+type AcceptedGiftTypes struct {
+	meta
+	// True, if unlimited regular gifts are accepted
+	UnlimitedGifts bool `json:"unlimited_gifts"`
+	// True, if limited regular gifts are accepted
+	LimitedGifts bool `json:"limited_gifts"`
+	// True, if upgraded gifts and regular gifts that can be upgraded for free are accepted
+	UpgradedGifts bool `json:"upgraded_gifts"`
+	// True, if Telegram Premium subscription is accepted
+	PremiumSubscription bool `json:"premium_subscription"`
+}
+
+func (*AcceptedGiftTypes) GetType() string {
+	return TypeAcceptedGiftTypes
+}
+
+func (*AcceptedGiftTypes) GetConstructor() string {
+	return ConstructorAcceptedGiftTypes
+}
+
+func (entity *AcceptedGiftTypes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AcceptedGiftTypes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AcceptedGiftTypes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"acceptedGiftTypes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"unlimited_gifts":`)
+	encoder.writeBool(entity.UnlimitedGifts)
+	encoder.writeRaw(`,"limited_gifts":`)
+	encoder.writeBool(entity.LimitedGifts)
+	encoder.writeRaw(`,"upgraded_gifts":`)
+	encoder.writeBool(entity.UpgradedGifts)
+	encoder.writeRaw(`,"premium_subscription":`)
+	encoder.writeBool(entity.PremiumSubscription)
+	encoder.writeRaw("}")
+}
+
+func (entity *AcceptedGiftTypes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "unlimited_gifts":
+			entity.UnlimitedGifts, err = decoder.readBool()
+
+		case "limited_gifts":
+			entity.LimitedGifts, err = decoder.readBool()
+
+		case "upgraded_gifts":
+			entity.UpgradedGifts, err = decoder.readBool()
+
+		case "premium_subscription":
+			entity.PremiumSubscription, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAcceptedGiftTypes)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains settings for gift receiving for a user
+type GiftSettings struct {
+	meta
+	// True, if a button for sending a gift to the user or by the user must always be shown in the input field
+	ShowGiftButton bool `json:"show_gift_button"`
+	// Types of gifts accepted by the user; for Telegram Premium users only
+	AcceptedGiftTypes *AcceptedGiftTypes `json:"accepted_gift_types"`
+}
+
+func (*GiftSettings) GetType() string {
+	return TypeGiftSettings
+}
+
+func (*GiftSettings) GetConstructor() string {
+	return ConstructorGiftSettings
+}
+
+func (entity *GiftSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiftSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiftSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giftSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"show_gift_button":`)
+	encoder.writeBool(entity.ShowGiftButton)
+	encoder.writeRaw(`,"accepted_gift_types":`)
+	entity.AcceptedGiftTypes.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiftSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "show_gift_button":
+			entity.ShowGiftButton, err = decoder.readBool()
+
+		case "accepted_gift_types":
+			entity.AcceptedGiftTypes, err = decodeEntity[AcceptedGiftTypes](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiftSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a model of an upgraded gift
+type UpgradedGiftModel struct {
+	meta
+	// Name of the model
+	Name string `json:"name"`
+	// The sticker representing the upgraded gift
+	Sticker *Sticker `json:"sticker"`
+	// The number of upgraded gifts that receive this model for each 1000 gifts upgraded
+	RarityPerMille int32 `json:"rarity_per_mille"`
+}
+
+func (*UpgradedGiftModel) GetType() string {
+	return TypeUpgradedGiftModel
+}
+
+func (*UpgradedGiftModel) GetConstructor() string {
+	return ConstructorUpgradedGiftModel
+}
+
+func (entity *UpgradedGiftModel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpgradedGiftModel) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpgradedGiftModel) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradedGiftModel"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw(`,"rarity_per_mille":`)
+	encoder.writeInt32(entity.RarityPerMille)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpgradedGiftModel) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		case "rarity_per_mille":
+			entity.RarityPerMille, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpgradedGiftModel)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a symbol shown on the pattern of an upgraded gift
+type UpgradedGiftSymbol struct {
+	meta
+	// Name of the symbol
+	Name string `json:"name"`
+	// The sticker representing the symbol
+	Sticker *Sticker `json:"sticker"`
+	// The number of upgraded gifts that receive this symbol for each 1000 gifts upgraded
+	RarityPerMille int32 `json:"rarity_per_mille"`
+}
+
+func (*UpgradedGiftSymbol) GetType() string {
+	return TypeUpgradedGiftSymbol
+}
+
+func (*UpgradedGiftSymbol) GetConstructor() string {
+	return ConstructorUpgradedGiftSymbol
+}
+
+func (entity *UpgradedGiftSymbol) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpgradedGiftSymbol) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpgradedGiftSymbol) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradedGiftSymbol"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw(`,"rarity_per_mille":`)
+	encoder.writeInt32(entity.RarityPerMille)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpgradedGiftSymbol) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		case "rarity_per_mille":
+			entity.RarityPerMille, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpgradedGiftSymbol)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes colors of a backdrop of an upgraded gift
+type UpgradedGiftBackdropColors struct {
+	meta
+	// A color in the center of the backdrop in the RGB format
+	CenterColor int32 `json:"center_color"`
+	// A color on the edges of the backdrop in the RGB format
+	EdgeColor int32 `json:"edge_color"`
+	// A color to be applied for the symbol in the RGB format
+	SymbolColor int32 `json:"symbol_color"`
+	// A color for the text on the backdrop in the RGB format
+	TextColor int32 `json:"text_color"`
+}
+
+func (*UpgradedGiftBackdropColors) GetType() string {
+	return TypeUpgradedGiftBackdropColors
+}
+
+func (*UpgradedGiftBackdropColors) GetConstructor() string {
+	return ConstructorUpgradedGiftBackdropColors
+}
+
+func (entity *UpgradedGiftBackdropColors) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpgradedGiftBackdropColors) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpgradedGiftBackdropColors) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradedGiftBackdropColors"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"center_color":`)
+	encoder.writeInt32(entity.CenterColor)
+	encoder.writeRaw(`,"edge_color":`)
+	encoder.writeInt32(entity.EdgeColor)
+	encoder.writeRaw(`,"symbol_color":`)
+	encoder.writeInt32(entity.SymbolColor)
+	encoder.writeRaw(`,"text_color":`)
+	encoder.writeInt32(entity.TextColor)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpgradedGiftBackdropColors) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "center_color":
+			entity.CenterColor, err = decoder.readInt32()
+
+		case "edge_color":
+			entity.EdgeColor, err = decoder.readInt32()
+
+		case "symbol_color":
+			entity.SymbolColor, err = decoder.readInt32()
+
+		case "text_color":
+			entity.TextColor, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpgradedGiftBackdropColors)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a backdrop of an upgraded gift
+type UpgradedGiftBackdrop struct {
+	meta
+	// Name of the backdrop
+	Name string `json:"name"`
+	// Colors of the backdrop
+	Colors *UpgradedGiftBackdropColors `json:"colors"`
+	// The number of upgraded gifts that receive this backdrop for each 1000 gifts upgraded
+	RarityPerMille int32 `json:"rarity_per_mille"`
+}
+
+func (*UpgradedGiftBackdrop) GetType() string {
+	return TypeUpgradedGiftBackdrop
+}
+
+func (*UpgradedGiftBackdrop) GetConstructor() string {
+	return ConstructorUpgradedGiftBackdrop
+}
+
+func (entity *UpgradedGiftBackdrop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpgradedGiftBackdrop) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpgradedGiftBackdrop) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradedGiftBackdrop"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"colors":`)
+	entity.Colors.encodeJSON(encoder)
+	encoder.writeRaw(`,"rarity_per_mille":`)
+	encoder.writeInt32(entity.RarityPerMille)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpgradedGiftBackdrop) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "colors":
+			entity.Colors, err = decodeEntity[UpgradedGiftBackdropColors](decoder)
+
+		case "rarity_per_mille":
+			entity.RarityPerMille, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpgradedGiftBackdrop)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes the original details about the gift
+type UpgradedGiftOriginalDetails struct {
+	meta
+	// Identifier of the user or the chat that sent the gift; may be null if the gift was private
+	SenderId MessageSender `json:"sender_id,omitempty"`
+	// Identifier of the user or the chat that received the gift
+	ReceiverId MessageSender `json:"receiver_id"`
+	// Message added to the gift
+	Text *FormattedText `json:"text"`
+	// Point in time (Unix timestamp) when the gift was sent
+	Date int32 `json:"date"`
+}
+
+func (*UpgradedGiftOriginalDetails) GetType() string {
+	return TypeUpgradedGiftOriginalDetails
+}
+
+func (*UpgradedGiftOriginalDetails) GetConstructor() string {
+	return ConstructorUpgradedGiftOriginalDetails
+}
+
+func (entity *UpgradedGiftOriginalDetails) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpgradedGiftOriginalDetails) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpgradedGiftOriginalDetails) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradedGiftOriginalDetails"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.SenderId != nil {
+		encoder.writeRaw(`,"sender_id":`)
+		encoder.writeType(entity.SenderId)
+	}
+	encoder.writeRaw(`,"receiver_id":`)
+	encoder.writeType(entity.ReceiverId)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpgradedGiftOriginalDetails) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "receiver_id":
+			entity.ReceiverId, err = decodeMessageSender(decoder)
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpgradedGiftOriginalDetails)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a gift that can be sent to another user or channel chat
+type Gift struct {
+	meta
+	// Unique identifier of the gift
+	Id JsonInt64 `json:"id"`
+	// The sticker representing the gift
+	Sticker *Sticker `json:"sticker"`
+	// Number of Telegram Stars that must be paid for the gift
+	StarCount int64 `json:"star_count"`
+	// Number of Telegram Stars that can be claimed by the receiver instead of the regular gift by default. If the gift was paid with just bought Telegram Stars, then full value can be claimed
+	DefaultSellStarCount int64 `json:"default_sell_star_count"`
+	// Number of Telegram Stars that must be paid to upgrade the gift; 0 if upgrade isn't possible
+	UpgradeStarCount int64 `json:"upgrade_star_count"`
+	// True, if the gift is a birthday gift
+	IsForBirthday bool `json:"is_for_birthday"`
+	// Number of remaining times the gift can be purchased; 0 if not limited or the gift was sold out
+	RemainingCount int32 `json:"remaining_count"`
+	// Number of total times the gift can be purchased; 0 if not limited
+	TotalCount int32 `json:"total_count"`
+	// Point in time (Unix timestamp) when the gift was send for the first time; for sold out gifts only
+	FirstSendDate int32 `json:"first_send_date"`
+	// Point in time (Unix timestamp) when the gift was send for the last time; for sold out gifts only
+	LastSendDate int32 `json:"last_send_date"`
+}
+
+func (*Gift) GetType() string {
+	return TypeGift
+}
+
+func (*Gift) GetConstructor() string {
+	return ConstructorGift
+}
+
+func (entity *Gift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Gift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Gift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"gift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"default_sell_star_count":`)
+	encoder.writeInt64(entity.DefaultSellStarCount)
+	encoder.writeRaw(`,"upgrade_star_count":`)
+	encoder.writeInt64(entity.UpgradeStarCount)
+	encoder.writeRaw(`,"is_for_birthday":`)
+	encoder.writeBool(entity.IsForBirthday)
+	encoder.writeRaw(`,"remaining_count":`)
+	encoder.writeInt32(entity.RemainingCount)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"first_send_date":`)
+	encoder.writeInt32(entity.FirstSendDate)
+	encoder.writeRaw(`,"last_send_date":`)
+	encoder.writeInt32(entity.LastSendDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *Gift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "default_sell_star_count":
+			entity.DefaultSellStarCount, err = decoder.readInt64()
+
+		case "upgrade_star_count":
+			entity.UpgradeStarCount, err = decoder.readInt64()
+
+		case "is_for_birthday":
+			entity.IsForBirthday, err = decoder.readBool()
+
+		case "remaining_count":
+			entity.RemainingCount, err = decoder.readInt32()
+
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "first_send_date":
+			entity.FirstSendDate, err = decoder.readInt32()
+
+		case "last_send_date":
+			entity.LastSendDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of gifts that can be sent to another user or channel chat
+type Gifts struct {
+	meta
+	// The list of gifts
+	Gifts []*Gift `json:"gifts"`
+}
+
+func (*Gifts) GetType() string {
+	return TypeGifts
+}
+
+func (*Gifts) GetConstructor() string {
+	return ConstructorGifts
+}
+
+func (entity *Gifts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Gifts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Gifts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"gifts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gifts":`)
+	writeList(encoder, entity.Gifts, writeTypeValue[*Gift])
+	encoder.writeRaw("}")
+}
+
+func (entity *Gifts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gifts":
+			entity.Gifts, err = readList(decoder, decodeEntity[Gift])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGifts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an upgraded gift that can be transferred to another owner or transferred to the TON blockchain as an NFT
+type UpgradedGift struct {
+	meta
+	// Unique identifier of the gift
+	Id JsonInt64 `json:"id"`
+	// The title of the upgraded gift
+	Title string `json:"title"`
+	// Unique name of the upgraded gift that can be used with internalLinkTypeUpgradedGift
+	Name string `json:"name"`
+	// Unique number of the upgraded gift among gifts upgraded from the same gift
+	Number int32 `json:"number"`
+	// Total number of gifts that were upgraded from the same gift
+	TotalUpgradedCount int32 `json:"total_upgraded_count"`
+	// The maximum number of gifts that can be upgraded from the same gift
+	MaxUpgradedCount int32 `json:"max_upgraded_count"`
+	// Identifier of the user or the chat that owns the upgraded gift; may be null if none or unknown
+	OwnerId MessageSender `json:"owner_id,omitempty"`
+	// Address of the gift NFT owner in TON blockchain; may be empty if none. Append the address to getOption("ton_blockchain_explorer_url") to get a link with information about the address
+	OwnerAddress string `json:"owner_address"`
+	// Name of the owner for the case when owner identifier and address aren't known
+	OwnerName string `json:"owner_name"`
+	// Address of the gift NFT in TON blockchain; may be empty if none. Append the address to getOption("ton_blockchain_explorer_url") to get a link with information about the address
+	GiftAddress string `json:"gift_address"`
+	// Model of the upgraded gift
+	Model *UpgradedGiftModel `json:"model"`
+	// Symbol of the upgraded gift
+	Symbol *UpgradedGiftSymbol `json:"symbol"`
+	// Backdrop of the upgraded gift
+	Backdrop *UpgradedGiftBackdrop `json:"backdrop"`
+	// Information about the originally sent gift; may be null if unknown
+	OriginalDetails *UpgradedGiftOriginalDetails `json:"original_details,omitempty"`
+}
+
+func (*UpgradedGift) GetType() string {
+	return TypeUpgradedGift
+}
+
+func (*UpgradedGift) GetConstructor() string {
+	return ConstructorUpgradedGift
+}
+
+func (entity *UpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"number":`)
+	encoder.writeInt32(entity.Number)
+	encoder.writeRaw(`,"total_upgraded_count":`)
+	encoder.writeInt32(entity.TotalUpgradedCount)
+	encoder.writeRaw(`,"max_upgraded_count":`)
+	encoder.writeInt32(entity.MaxUpgradedCount)
+	if entity.OwnerId != nil {
+		encoder.writeRaw(`,"owner_id":`)
+		encoder.writeType(entity.OwnerId)
+	}
+	encoder.writeRaw(`,"owner_address":`)
+	encoder.writeString(entity.OwnerAddress)
+	encoder.writeRaw(`,"owner_name":`)
+	encoder.writeString(entity.OwnerName)
+	encoder.writeRaw(`,"gift_address":`)
+	encoder.writeString(entity.GiftAddress)
+	encoder.writeRaw(`,"model":`)
+	entity.Model.encodeJSON(encoder)
+	encoder.writeRaw(`,"symbol":`)
+	entity.Symbol.encodeJSON(encoder)
+	encoder.writeRaw(`,"backdrop":`)
+	entity.Backdrop.encodeJSON(encoder)
+	if entity.OriginalDetails != nil {
+		encoder.writeRaw(`,"original_details":`)
+		entity.OriginalDetails.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "number":
+			entity.Number, err = decoder.readInt32()
+
+		case "total_upgraded_count":
+			entity.TotalUpgradedCount, err = decoder.readInt32()
+
+		case "max_upgraded_count":
+			entity.MaxUpgradedCount, err = decoder.readInt32()
+
+		case "owner_id":
+			entity.OwnerId, err = decodeMessageSender(decoder)
+
+		case "owner_address":
+			entity.OwnerAddress, err = decoder.readString()
+
+		case "owner_name":
+			entity.OwnerName, err = decoder.readString()
+
+		case "gift_address":
+			entity.GiftAddress, err = decoder.readString()
+
+		case "model":
+			entity.Model, err = decodeEntity[UpgradedGiftModel](decoder)
+
+		case "symbol":
+			entity.Symbol, err = decodeEntity[UpgradedGiftSymbol](decoder)
+
+		case "backdrop":
+			entity.Backdrop, err = decodeEntity[UpgradedGiftBackdrop](decoder)
+
+		case "original_details":
+			entity.OriginalDetails, err = decodeEntity[UpgradedGiftOriginalDetails](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains result of gift upgrading
+type UpgradeGiftResult struct {
+	meta
+	// The upgraded gift
+	Gift *UpgradedGift `json:"gift"`
+	// Unique identifier of the received gift for the current user
+	ReceivedGiftId string `json:"received_gift_id"`
+	// True, if the gift is displayed on the user's or the channel's profile page
+	IsSaved bool `json:"is_saved"`
+	// True, if the gift can be transferred to another owner
+	CanBeTransferred bool `json:"can_be_transferred"`
+	// Number of Telegram Stars that must be paid to transfer the upgraded gift
+	TransferStarCount int64 `json:"transfer_star_count"`
+	// Point in time (Unix timestamp) when the gift can be transferred to the TON blockchain as an NFT
+	ExportDate int32 `json:"export_date"`
+}
+
+func (*UpgradeGiftResult) GetType() string {
+	return TypeUpgradeGiftResult
+}
+
+func (*UpgradeGiftResult) GetConstructor() string {
+	return ConstructorUpgradeGiftResult
+}
+
+func (entity *UpgradeGiftResult) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpgradeGiftResult) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpgradeGiftResult) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"upgradeGiftResult"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(entity.ReceivedGiftId)
+	encoder.writeRaw(`,"is_saved":`)
+	encoder.writeBool(entity.IsSaved)
+	encoder.writeRaw(`,"can_be_transferred":`)
+	encoder.writeBool(entity.CanBeTransferred)
+	encoder.writeRaw(`,"transfer_star_count":`)
+	encoder.writeInt64(entity.TransferStarCount)
+	encoder.writeRaw(`,"export_date":`)
+	encoder.writeInt32(entity.ExportDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpgradeGiftResult) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift":
+			entity.Gift, err = decodeEntity[UpgradedGift](decoder)
+
+		case "received_gift_id":
+			entity.ReceivedGiftId, err = decoder.readString()
+
+		case "is_saved":
+			entity.IsSaved, err = decoder.readBool()
+
+		case "can_be_transferred":
+			entity.CanBeTransferred, err = decoder.readBool()
+
+		case "transfer_star_count":
+			entity.TransferStarCount, err = decoder.readInt64()
+
+		case "export_date":
+			entity.ExportDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpgradeGiftResult)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Regular gift
+type SentGiftRegular struct {
+	meta
+	// The gift
+	Gift *Gift `json:"gift"`
+}
+
+func (*SentGiftRegular) GetType() string {
+	return TypeSentGift
+}
+
+func (*SentGiftRegular) GetConstructor() string {
+	return ConstructorSentGiftRegular
+}
+
+func (*SentGiftRegular) SentGiftConstructor() string {
+	return ConstructorSentGiftRegular
+}
+
+func (entity *SentGiftRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SentGiftRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SentGiftRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sentGiftRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *SentGiftRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift":
+			entity.Gift, err = decodeEntity[Gift](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSentGiftRegular)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Upgraded gift
+type SentGiftUpgraded struct {
+	meta
+	// The gift
+	Gift *UpgradedGift `json:"gift"`
+}
+
+func (*SentGiftUpgraded) GetType() string {
+	return TypeSentGift
+}
+
+func (*SentGiftUpgraded) GetConstructor() string {
+	return ConstructorSentGiftUpgraded
+}
+
+func (*SentGiftUpgraded) SentGiftConstructor() string {
+	return ConstructorSentGiftUpgraded
+}
+
+func (entity *SentGiftUpgraded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SentGiftUpgraded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SentGiftUpgraded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sentGiftUpgraded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *SentGiftUpgraded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift":
+			entity.Gift, err = decodeEntity[UpgradedGift](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSentGiftUpgraded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a gift received by a user or a chat
+type ReceivedGift struct {
+	meta
+	// Unique identifier of the received gift for the current user; only for the receiver of the gift
+	ReceivedGiftId string `json:"received_gift_id"`
+	// Identifier of a user or a chat that sent the gift; may be null if unknown
+	SenderId MessageSender `json:"sender_id,omitempty"`
+	// Message added to the gift
+	Text *FormattedText `json:"text"`
+	// True, if the sender and gift text are shown only to the gift receiver; otherwise, everyone are able to see them
+	IsPrivate bool `json:"is_private"`
+	// True, if the gift is displayed on the chat's profile page; only for the receiver of the gift
+	IsSaved bool `json:"is_saved"`
+	// True, if the gift is pinned to the top of the chat's profile page
+	IsPinned bool `json:"is_pinned"`
+	// True, if the gift is a regular gift that can be upgraded to a unique gift; only for the receiver of the gift
+	CanBeUpgraded bool `json:"can_be_upgraded"`
+	// True, if the gift is an upgraded gift that can be transferred to another owner; only for the receiver of the gift
+	CanBeTransferred bool `json:"can_be_transferred"`
+	// True, if the gift was refunded and isn't available anymore
+	WasRefunded bool `json:"was_refunded"`
+	// Point in time (Unix timestamp) when the gift was sent
+	Date int32 `json:"date"`
+	// The gift
+	Gift SentGift `json:"gift"`
+	// Number of Telegram Stars that can be claimed by the receiver instead of the regular gift; 0 if the gift can't be sold by the current user
+	SellStarCount int64 `json:"sell_star_count"`
+	// Number of Telegram Stars that were paid by the sender for the ability to upgrade the gift
+	PrepaidUpgradeStarCount int64 `json:"prepaid_upgrade_star_count"`
+	// Number of Telegram Stars that must be paid to transfer the upgraded gift; only for the receiver of the gift
+	TransferStarCount int64 `json:"transfer_star_count"`
+	// Point in time (Unix timestamp) when the upgraded gift can be transferred to the TON blockchain as an NFT; 0 if NFT export isn't possible; only for the receiver of the gift
+	ExportDate int32 `json:"export_date"`
+}
+
+func (*ReceivedGift) GetType() string {
+	return TypeReceivedGift
+}
+
+func (*ReceivedGift) GetConstructor() string {
+	return ConstructorReceivedGift
+}
+
+func (entity *ReceivedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReceivedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReceivedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"receivedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"received_gift_id":`)
+	encoder.writeString(entity.ReceivedGiftId)
+	if entity.SenderId != nil {
+		encoder.writeRaw(`,"sender_id":`)
+		encoder.writeType(entity.SenderId)
+	}
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_private":`)
+	encoder.writeBool(entity.IsPrivate)
+	encoder.writeRaw(`,"is_saved":`)
+	encoder.writeBool(entity.IsSaved)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw(`,"can_be_upgraded":`)
+	encoder.writeBool(entity.CanBeUpgraded)
+	encoder.writeRaw(`,"can_be_transferred":`)
+	encoder.writeBool(entity.CanBeTransferred)
+	encoder.writeRaw(`,"was_refunded":`)
+	encoder.writeBool(entity.WasRefunded)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"gift":`)
+	encoder.writeType(entity.Gift)
+	encoder.writeRaw(`,"sell_star_count":`)
+	encoder.writeInt64(entity.SellStarCount)
+	encoder.writeRaw(`,"prepaid_upgrade_star_count":`)
+	encoder.writeInt64(entity.PrepaidUpgradeStarCount)
+	encoder.writeRaw(`,"transfer_star_count":`)
+	encoder.writeInt64(entity.TransferStarCount)
+	encoder.writeRaw(`,"export_date":`)
+	encoder.writeInt32(entity.ExportDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReceivedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "received_gift_id":
+			entity.ReceivedGiftId, err = decoder.readString()
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		case "is_private":
+			entity.IsPrivate, err = decoder.readBool()
+
+		case "is_saved":
+			entity.IsSaved, err = decoder.readBool()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		case "can_be_upgraded":
+			entity.CanBeUpgraded, err = decoder.readBool()
+
+		case "can_be_transferred":
+			entity.CanBeTransferred, err = decoder.readBool()
+
+		case "was_refunded":
+			entity.WasRefunded, err = decoder.readBool()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "gift":
+			entity.Gift, err = decodeSentGift(decoder)
+
+		case "sell_star_count":
+			entity.SellStarCount, err = decoder.readInt64()
+
+		case "prepaid_upgrade_star_count":
+			entity.PrepaidUpgradeStarCount, err = decoder.readInt64()
+
+		case "transfer_star_count":
+			entity.TransferStarCount, err = decoder.readInt64()
+
+		case "export_date":
+			entity.ExportDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReceivedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of gifts received by a user or a chat
+type ReceivedGifts struct {
+	meta
+	// The total number of received gifts
+	TotalCount int32 `json:"total_count"`
+	// The list of gifts
+	Gifts []*ReceivedGift `json:"gifts"`
+	// True, if notifications about new gifts of the owner are enabled
+	AreNotificationsEnabled bool `json:"are_notifications_enabled"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*ReceivedGifts) GetType() string {
+	return TypeReceivedGifts
+}
+
+func (*ReceivedGifts) GetConstructor() string {
+	return ConstructorReceivedGifts
+}
+
+func (entity *ReceivedGifts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReceivedGifts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReceivedGifts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"receivedGifts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"gifts":`)
+	writeList(encoder, entity.Gifts, writeTypeValue[*ReceivedGift])
+	encoder.writeRaw(`,"are_notifications_enabled":`)
+	encoder.writeBool(entity.AreNotificationsEnabled)
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReceivedGifts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "gifts":
+			entity.Gifts, err = readList(decoder, decodeEntity[ReceivedGift])
+
+		case "are_notifications_enabled":
+			entity.AreNotificationsEnabled, err = decoder.readBool()
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReceivedGifts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains examples of possible upgraded gifts for the given regular gift
+type GiftUpgradePreview struct {
+	meta
+	// Examples of possible models that can be chosen for the gift after upgrade
+	Models []*UpgradedGiftModel `json:"models"`
+	// Examples of possible symbols that can be chosen for the gift after upgrade
+	Symbols []*UpgradedGiftSymbol `json:"symbols"`
+	// Examples of possible backdrops that can be chosen for the gift after upgrade
+	Backdrops []*UpgradedGiftBackdrop `json:"backdrops"`
+}
+
+func (*GiftUpgradePreview) GetType() string {
+	return TypeGiftUpgradePreview
+}
+
+func (*GiftUpgradePreview) GetConstructor() string {
+	return ConstructorGiftUpgradePreview
+}
+
+func (entity *GiftUpgradePreview) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiftUpgradePreview) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiftUpgradePreview) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giftUpgradePreview"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"models":`)
+	writeList(encoder, entity.Models, writeTypeValue[*UpgradedGiftModel])
+	encoder.writeRaw(`,"symbols":`)
+	writeList(encoder, entity.Symbols, writeTypeValue[*UpgradedGiftSymbol])
+	encoder.writeRaw(`,"backdrops":`)
+	writeList(encoder, entity.Backdrops, writeTypeValue[*UpgradedGiftBackdrop])
+	encoder.writeRaw("}")
+}
+
+func (entity *GiftUpgradePreview) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "models":
+			entity.Models, err = readList(decoder, decodeEntity[UpgradedGiftModel])
+
+		case "symbols":
+			entity.Symbols, err = readList(decoder, decodeEntity[UpgradedGiftSymbol])
+
+		case "backdrops":
+			entity.Backdrops, err = readList(decoder, decodeEntity[UpgradedGiftBackdrop])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiftUpgradePreview)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is incoming and increases the number of owned Telegram Stars
+type StarTransactionDirectionIncoming struct {
+	meta
+}
+
+func (*StarTransactionDirectionIncoming) GetType() string {
+	return TypeStarTransactionDirection
+}
+
+func (*StarTransactionDirectionIncoming) GetConstructor() string {
+	return ConstructorStarTransactionDirectionIncoming
+}
+
+func (*StarTransactionDirectionIncoming) StarTransactionDirectionConstructor() string {
+	return ConstructorStarTransactionDirectionIncoming
+}
+
+func (entity *StarTransactionDirectionIncoming) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionDirectionIncoming) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionDirectionIncoming) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionDirectionIncoming"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionDirectionIncoming) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionDirectionIncoming)
+	})
+}
+
+// The transaction is outgoing and decreases the number of owned Telegram Stars
+type StarTransactionDirectionOutgoing struct {
+	meta
+}
+
+func (*StarTransactionDirectionOutgoing) GetType() string {
+	return TypeStarTransactionDirection
+}
+
+func (*StarTransactionDirectionOutgoing) GetConstructor() string {
+	return ConstructorStarTransactionDirectionOutgoing
+}
+
+func (*StarTransactionDirectionOutgoing) StarTransactionDirectionConstructor() string {
+	return ConstructorStarTransactionDirectionOutgoing
+}
+
+func (entity *StarTransactionDirectionOutgoing) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionDirectionOutgoing) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionDirectionOutgoing) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionDirectionOutgoing"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionDirectionOutgoing) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionDirectionOutgoing)
+	})
+}
+
+// The transaction is a deposit of Telegram Stars from the Premium bot; for regular users only
+type StarTransactionTypePremiumBotDeposit struct {
+	meta
+}
+
+func (*StarTransactionTypePremiumBotDeposit) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypePremiumBotDeposit) GetConstructor() string {
+	return ConstructorStarTransactionTypePremiumBotDeposit
+}
+
+func (*StarTransactionTypePremiumBotDeposit) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypePremiumBotDeposit
+}
+
+func (entity *StarTransactionTypePremiumBotDeposit) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypePremiumBotDeposit) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypePremiumBotDeposit) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypePremiumBotDeposit"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypePremiumBotDeposit) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypePremiumBotDeposit)
+	})
+}
+
+// The transaction is a deposit of Telegram Stars from App Store; for regular users only
+type StarTransactionTypeAppStoreDeposit struct {
+	meta
+}
+
+func (*StarTransactionTypeAppStoreDeposit) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeAppStoreDeposit) GetConstructor() string {
+	return ConstructorStarTransactionTypeAppStoreDeposit
+}
+
+func (*StarTransactionTypeAppStoreDeposit) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeAppStoreDeposit
+}
+
+func (entity *StarTransactionTypeAppStoreDeposit) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeAppStoreDeposit) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeAppStoreDeposit) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeAppStoreDeposit"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeAppStoreDeposit) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeAppStoreDeposit)
+	})
+}
+
+// The transaction is a deposit of Telegram Stars from Google Play; for regular users only
+type StarTransactionTypeGooglePlayDeposit struct {
+	meta
+}
+
+func (*StarTransactionTypeGooglePlayDeposit) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeGooglePlayDeposit) GetConstructor() string {
+	return ConstructorStarTransactionTypeGooglePlayDeposit
+}
+
+func (*StarTransactionTypeGooglePlayDeposit) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeGooglePlayDeposit
+}
+
+func (entity *StarTransactionTypeGooglePlayDeposit) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeGooglePlayDeposit) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeGooglePlayDeposit) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeGooglePlayDeposit"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeGooglePlayDeposit) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeGooglePlayDeposit)
+	})
+}
+
+// The transaction is a deposit of Telegram Stars from Fragment; for regular users and bots only
+type StarTransactionTypeFragmentDeposit struct {
+	meta
+}
+
+func (*StarTransactionTypeFragmentDeposit) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeFragmentDeposit) GetConstructor() string {
+	return ConstructorStarTransactionTypeFragmentDeposit
+}
+
+func (*StarTransactionTypeFragmentDeposit) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeFragmentDeposit
+}
+
+func (entity *StarTransactionTypeFragmentDeposit) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeFragmentDeposit) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeFragmentDeposit) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeFragmentDeposit"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeFragmentDeposit) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeFragmentDeposit)
+	})
+}
+
+// The transaction is a deposit of Telegram Stars by another user; for regular users only
+type StarTransactionTypeUserDeposit struct {
+	meta
+	// Identifier of the user that gifted Telegram Stars; 0 if the user was anonymous
+	UserId int64 `json:"user_id"`
+	// The sticker to be shown in the transaction information; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*StarTransactionTypeUserDeposit) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeUserDeposit) GetConstructor() string {
+	return ConstructorStarTransactionTypeUserDeposit
+}
+
+func (*StarTransactionTypeUserDeposit) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeUserDeposit
+}
+
+func (entity *StarTransactionTypeUserDeposit) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeUserDeposit) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeUserDeposit) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeUserDeposit"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeUserDeposit) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeUserDeposit)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a deposit of Telegram Stars from a giveaway; for regular users only
+type StarTransactionTypeGiveawayDeposit struct {
+	meta
+	// Identifier of a supergroup or a channel chat that created the giveaway
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message with the giveaway; can be 0 or an identifier of a deleted message
+	GiveawayMessageId int64 `json:"giveaway_message_id"`
+}
+
+func (*StarTransactionTypeGiveawayDeposit) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeGiveawayDeposit) GetConstructor() string {
+	return ConstructorStarTransactionTypeGiveawayDeposit
+}
+
+func (*StarTransactionTypeGiveawayDeposit) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeGiveawayDeposit
+}
+
+func (entity *StarTransactionTypeGiveawayDeposit) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeGiveawayDeposit) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeGiveawayDeposit) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeGiveawayDeposit"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"giveaway_message_id":`)
+	encoder.writeInt64(entity.GiveawayMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeGiveawayDeposit) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "giveaway_message_id":
+			entity.GiveawayMessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeGiveawayDeposit)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a withdrawal of earned Telegram Stars to Fragment; for regular users, bots, supergroup and channel chats only
+type StarTransactionTypeFragmentWithdrawal struct {
+	meta
+	// State of the withdrawal; may be null for refunds from Fragment
+	WithdrawalState RevenueWithdrawalState `json:"withdrawal_state,omitempty"`
+}
+
+func (*StarTransactionTypeFragmentWithdrawal) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeFragmentWithdrawal) GetConstructor() string {
+	return ConstructorStarTransactionTypeFragmentWithdrawal
+}
+
+func (*StarTransactionTypeFragmentWithdrawal) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeFragmentWithdrawal
+}
+
+func (entity *StarTransactionTypeFragmentWithdrawal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeFragmentWithdrawal) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeFragmentWithdrawal) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeFragmentWithdrawal"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.WithdrawalState != nil {
+		encoder.writeRaw(`,"withdrawal_state":`)
+		encoder.writeType(entity.WithdrawalState)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeFragmentWithdrawal) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "withdrawal_state":
+			entity.WithdrawalState, err = decodeRevenueWithdrawalState(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeFragmentWithdrawal)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a withdrawal of earned Telegram Stars to Telegram Ad platform; for bots and channel chats only
+type StarTransactionTypeTelegramAdsWithdrawal struct {
+	meta
+}
+
+func (*StarTransactionTypeTelegramAdsWithdrawal) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeTelegramAdsWithdrawal) GetConstructor() string {
+	return ConstructorStarTransactionTypeTelegramAdsWithdrawal
+}
+
+func (*StarTransactionTypeTelegramAdsWithdrawal) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeTelegramAdsWithdrawal
+}
+
+func (entity *StarTransactionTypeTelegramAdsWithdrawal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeTelegramAdsWithdrawal) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeTelegramAdsWithdrawal) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeTelegramAdsWithdrawal"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeTelegramAdsWithdrawal) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeTelegramAdsWithdrawal)
+	})
+}
+
+// The transaction is a payment for Telegram API usage; for bots only
+type StarTransactionTypeTelegramApiUsage struct {
+	meta
+	// The number of billed requests
+	RequestCount int32 `json:"request_count"`
+}
+
+func (*StarTransactionTypeTelegramApiUsage) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeTelegramApiUsage) GetConstructor() string {
+	return ConstructorStarTransactionTypeTelegramApiUsage
+}
+
+func (*StarTransactionTypeTelegramApiUsage) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeTelegramApiUsage
+}
+
+func (entity *StarTransactionTypeTelegramApiUsage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeTelegramApiUsage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeTelegramApiUsage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeTelegramApiUsage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"request_count":`)
+	encoder.writeInt32(entity.RequestCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeTelegramApiUsage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "request_count":
+			entity.RequestCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeTelegramApiUsage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a purchase of paid media from a bot or a business account by the current user; for regular users only
+type StarTransactionTypeBotPaidMediaPurchase struct {
+	meta
+	// Identifier of the bot or the business account user that sent the paid media
+	UserId int64 `json:"user_id"`
+	// The bought media if the transaction wasn't refunded
+	Media []PaidMedia `json:"media"`
+}
+
+func (*StarTransactionTypeBotPaidMediaPurchase) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBotPaidMediaPurchase) GetConstructor() string {
+	return ConstructorStarTransactionTypeBotPaidMediaPurchase
+}
+
+func (*StarTransactionTypeBotPaidMediaPurchase) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBotPaidMediaPurchase
+}
+
+func (entity *StarTransactionTypeBotPaidMediaPurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBotPaidMediaPurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBotPaidMediaPurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBotPaidMediaPurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"media":`)
+	writeList(encoder, entity.Media, writeTypeValue[PaidMedia])
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBotPaidMediaPurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "media":
+			entity.Media, err = readList(decoder, decodePaidMedia)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBotPaidMediaPurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sale of paid media by the bot or a business account managed by the bot; for bots only
+type StarTransactionTypeBotPaidMediaSale struct {
+	meta
+	// Identifier of the user that bought the media
+	UserId int64 `json:"user_id"`
+	// The bought media
+	Media []PaidMedia `json:"media"`
+	// Bot-provided payload
+	Payload string `json:"payload"`
+	// Information about the affiliate which received commission from the transaction; may be null if none
+	Affiliate *AffiliateInfo `json:"affiliate,omitempty"`
+}
+
+func (*StarTransactionTypeBotPaidMediaSale) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBotPaidMediaSale) GetConstructor() string {
+	return ConstructorStarTransactionTypeBotPaidMediaSale
+}
+
+func (*StarTransactionTypeBotPaidMediaSale) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBotPaidMediaSale
+}
+
+func (entity *StarTransactionTypeBotPaidMediaSale) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBotPaidMediaSale) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBotPaidMediaSale) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBotPaidMediaSale"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"media":`)
+	writeList(encoder, entity.Media, writeTypeValue[PaidMedia])
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeString(entity.Payload)
+	if entity.Affiliate != nil {
+		encoder.writeRaw(`,"affiliate":`)
+		entity.Affiliate.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBotPaidMediaSale) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "media":
+			entity.Media, err = readList(decoder, decodePaidMedia)
+
+		case "payload":
+			entity.Payload, err = decoder.readString()
+
+		case "affiliate":
+			entity.Affiliate, err = decodeEntity[AffiliateInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBotPaidMediaSale)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a purchase of paid media from a channel by the current user; for regular users only
+type StarTransactionTypeChannelPaidMediaPurchase struct {
+	meta
+	// Identifier of the channel chat that sent the paid media
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the corresponding message with paid media; can be 0 or an identifier of a deleted message
+	MessageId int64 `json:"message_id"`
+	// The bought media if the transaction wasn't refunded
+	Media []PaidMedia `json:"media"`
+}
+
+func (*StarTransactionTypeChannelPaidMediaPurchase) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeChannelPaidMediaPurchase) GetConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidMediaPurchase
+}
+
+func (*StarTransactionTypeChannelPaidMediaPurchase) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidMediaPurchase
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaPurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaPurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaPurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeChannelPaidMediaPurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"media":`)
+	writeList(encoder, entity.Media, writeTypeValue[PaidMedia])
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaPurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "media":
+			entity.Media, err = readList(decoder, decodePaidMedia)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeChannelPaidMediaPurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sale of paid media by the channel chat; for channel chats only
+type StarTransactionTypeChannelPaidMediaSale struct {
+	meta
+	// Identifier of the user that bought the media
+	UserId int64 `json:"user_id"`
+	// Identifier of the corresponding message with paid media; can be 0 or an identifier of a deleted message
+	MessageId int64 `json:"message_id"`
+	// The bought media
+	Media []PaidMedia `json:"media"`
+}
+
+func (*StarTransactionTypeChannelPaidMediaSale) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeChannelPaidMediaSale) GetConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidMediaSale
+}
+
+func (*StarTransactionTypeChannelPaidMediaSale) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidMediaSale
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaSale) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaSale) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaSale) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeChannelPaidMediaSale"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"media":`)
+	writeList(encoder, entity.Media, writeTypeValue[PaidMedia])
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeChannelPaidMediaSale) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "media":
+			entity.Media, err = readList(decoder, decodePaidMedia)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeChannelPaidMediaSale)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a purchase of a product from a bot or a business account by the current user; for regular users only
+type StarTransactionTypeBotInvoicePurchase struct {
+	meta
+	// Identifier of the bot or the business account user that created the invoice
+	UserId int64 `json:"user_id"`
+	// Information about the bought product
+	ProductInfo *ProductInfo `json:"product_info"`
+}
+
+func (*StarTransactionTypeBotInvoicePurchase) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBotInvoicePurchase) GetConstructor() string {
+	return ConstructorStarTransactionTypeBotInvoicePurchase
+}
+
+func (*StarTransactionTypeBotInvoicePurchase) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBotInvoicePurchase
+}
+
+func (entity *StarTransactionTypeBotInvoicePurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBotInvoicePurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBotInvoicePurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBotInvoicePurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"product_info":`)
+	entity.ProductInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBotInvoicePurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "product_info":
+			entity.ProductInfo, err = decodeEntity[ProductInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBotInvoicePurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sale of a product by the bot; for bots only
+type StarTransactionTypeBotInvoiceSale struct {
+	meta
+	// Identifier of the user that bought the product
+	UserId int64 `json:"user_id"`
+	// Information about the bought product
+	ProductInfo *ProductInfo `json:"product_info"`
+	// Invoice payload
+	InvoicePayload []byte `json:"invoice_payload"`
+	// Information about the affiliate which received commission from the transaction; may be null if none
+	Affiliate *AffiliateInfo `json:"affiliate,omitempty"`
+}
+
+func (*StarTransactionTypeBotInvoiceSale) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBotInvoiceSale) GetConstructor() string {
+	return ConstructorStarTransactionTypeBotInvoiceSale
+}
+
+func (*StarTransactionTypeBotInvoiceSale) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBotInvoiceSale
+}
+
+func (entity *StarTransactionTypeBotInvoiceSale) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBotInvoiceSale) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBotInvoiceSale) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBotInvoiceSale"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"product_info":`)
+	entity.ProductInfo.encodeJSON(encoder)
+	encoder.writeRaw(`,"invoice_payload":`)
+	encoder.writeBytes(entity.InvoicePayload)
+	if entity.Affiliate != nil {
+		encoder.writeRaw(`,"affiliate":`)
+		entity.Affiliate.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBotInvoiceSale) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "product_info":
+			entity.ProductInfo, err = decodeEntity[ProductInfo](decoder)
+
+		case "invoice_payload":
+			entity.InvoicePayload, err = decoder.readBytes()
+
+		case "affiliate":
+			entity.Affiliate, err = decodeEntity[AffiliateInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBotInvoiceSale)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a purchase of a subscription from a bot or a business account by the current user; for regular users only
+type StarTransactionTypeBotSubscriptionPurchase struct {
+	meta
+	// Identifier of the bot or the business account user that created the subscription link
+	UserId int64 `json:"user_id"`
+	// The number of seconds between consecutive Telegram Star debitings
+	SubscriptionPeriod int32 `json:"subscription_period"`
+	// Information about the bought subscription
+	ProductInfo *ProductInfo `json:"product_info"`
+}
+
+func (*StarTransactionTypeBotSubscriptionPurchase) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBotSubscriptionPurchase) GetConstructor() string {
+	return ConstructorStarTransactionTypeBotSubscriptionPurchase
+}
+
+func (*StarTransactionTypeBotSubscriptionPurchase) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBotSubscriptionPurchase
+}
+
+func (entity *StarTransactionTypeBotSubscriptionPurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBotSubscriptionPurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBotSubscriptionPurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBotSubscriptionPurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"subscription_period":`)
+	encoder.writeInt32(entity.SubscriptionPeriod)
+	encoder.writeRaw(`,"product_info":`)
+	entity.ProductInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBotSubscriptionPurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "subscription_period":
+			entity.SubscriptionPeriod, err = decoder.readInt32()
+
+		case "product_info":
+			entity.ProductInfo, err = decodeEntity[ProductInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBotSubscriptionPurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sale of a subscription by the bot; for bots only
+type StarTransactionTypeBotSubscriptionSale struct {
+	meta
+	// Identifier of the user that bought the subscription
+	UserId int64 `json:"user_id"`
+	// The number of seconds between consecutive Telegram Star debitings
+	SubscriptionPeriod int32 `json:"subscription_period"`
+	// Information about the bought subscription
+	ProductInfo *ProductInfo `json:"product_info"`
+	// Invoice payload
+	InvoicePayload []byte `json:"invoice_payload"`
+	// Information about the affiliate which received commission from the transaction; may be null if none
+	Affiliate *AffiliateInfo `json:"affiliate,omitempty"`
+}
+
+func (*StarTransactionTypeBotSubscriptionSale) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBotSubscriptionSale) GetConstructor() string {
+	return ConstructorStarTransactionTypeBotSubscriptionSale
+}
+
+func (*StarTransactionTypeBotSubscriptionSale) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBotSubscriptionSale
+}
+
+func (entity *StarTransactionTypeBotSubscriptionSale) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBotSubscriptionSale) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBotSubscriptionSale) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBotSubscriptionSale"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"subscription_period":`)
+	encoder.writeInt32(entity.SubscriptionPeriod)
+	encoder.writeRaw(`,"product_info":`)
+	entity.ProductInfo.encodeJSON(encoder)
+	encoder.writeRaw(`,"invoice_payload":`)
+	encoder.writeBytes(entity.InvoicePayload)
+	if entity.Affiliate != nil {
+		encoder.writeRaw(`,"affiliate":`)
+		entity.Affiliate.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBotSubscriptionSale) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "subscription_period":
+			entity.SubscriptionPeriod, err = decoder.readInt32()
+
+		case "product_info":
+			entity.ProductInfo, err = decodeEntity[ProductInfo](decoder)
+
+		case "invoice_payload":
+			entity.InvoicePayload, err = decoder.readBytes()
+
+		case "affiliate":
+			entity.Affiliate, err = decodeEntity[AffiliateInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBotSubscriptionSale)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a purchase of a subscription to a channel chat by the current user; for regular users only
+type StarTransactionTypeChannelSubscriptionPurchase struct {
+	meta
+	// Identifier of the channel chat that created the subscription
+	ChatId int64 `json:"chat_id"`
+	// The number of seconds between consecutive Telegram Star debitings
+	SubscriptionPeriod int32 `json:"subscription_period"`
+}
+
+func (*StarTransactionTypeChannelSubscriptionPurchase) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeChannelSubscriptionPurchase) GetConstructor() string {
+	return ConstructorStarTransactionTypeChannelSubscriptionPurchase
+}
+
+func (*StarTransactionTypeChannelSubscriptionPurchase) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeChannelSubscriptionPurchase
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionPurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionPurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionPurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeChannelSubscriptionPurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"subscription_period":`)
+	encoder.writeInt32(entity.SubscriptionPeriod)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionPurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "subscription_period":
+			entity.SubscriptionPeriod, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeChannelSubscriptionPurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sale of a subscription by the channel chat; for channel chats only
+type StarTransactionTypeChannelSubscriptionSale struct {
+	meta
+	// Identifier of the user that bought the subscription
+	UserId int64 `json:"user_id"`
+	// The number of seconds between consecutive Telegram Star debitings
+	SubscriptionPeriod int32 `json:"subscription_period"`
+}
+
+func (*StarTransactionTypeChannelSubscriptionSale) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeChannelSubscriptionSale) GetConstructor() string {
+	return ConstructorStarTransactionTypeChannelSubscriptionSale
+}
+
+func (*StarTransactionTypeChannelSubscriptionSale) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeChannelSubscriptionSale
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionSale) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionSale) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionSale) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeChannelSubscriptionSale"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"subscription_period":`)
+	encoder.writeInt32(entity.SubscriptionPeriod)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeChannelSubscriptionSale) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "subscription_period":
+			entity.SubscriptionPeriod, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeChannelSubscriptionSale)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a purchase of a regular gift; for regular users and bots only
+type StarTransactionTypeGiftPurchase struct {
+	meta
+	// Identifier of the user or the channel that received the gift
+	OwnerId MessageSender `json:"owner_id"`
+	// The gift
+	Gift *Gift `json:"gift"`
+}
+
+func (*StarTransactionTypeGiftPurchase) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeGiftPurchase) GetConstructor() string {
+	return ConstructorStarTransactionTypeGiftPurchase
+}
+
+func (*StarTransactionTypeGiftPurchase) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeGiftPurchase
+}
+
+func (entity *StarTransactionTypeGiftPurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeGiftPurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeGiftPurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeGiftPurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(entity.OwnerId)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeGiftPurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "owner_id":
+			entity.OwnerId, err = decodeMessageSender(decoder)
+
+		case "gift":
+			entity.Gift, err = decodeEntity[Gift](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeGiftPurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a transfer of an upgraded gift; for regular users only
+type StarTransactionTypeGiftTransfer struct {
+	meta
+	// Identifier of the user or the channel that received the gift
+	OwnerId MessageSender `json:"owner_id"`
+	// The gift
+	Gift *UpgradedGift `json:"gift"`
+}
+
+func (*StarTransactionTypeGiftTransfer) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeGiftTransfer) GetConstructor() string {
+	return ConstructorStarTransactionTypeGiftTransfer
+}
+
+func (*StarTransactionTypeGiftTransfer) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeGiftTransfer
+}
+
+func (entity *StarTransactionTypeGiftTransfer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeGiftTransfer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeGiftTransfer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeGiftTransfer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(entity.OwnerId)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeGiftTransfer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "owner_id":
+			entity.OwnerId, err = decodeMessageSender(decoder)
+
+		case "gift":
+			entity.Gift, err = decodeEntity[UpgradedGift](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeGiftTransfer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sale of a received gift; for regular users and channel chats only
+type StarTransactionTypeGiftSale struct {
+	meta
+	// Identifier of the user that sent the gift
+	UserId int64 `json:"user_id"`
+	// The gift
+	Gift *Gift `json:"gift"`
+}
+
+func (*StarTransactionTypeGiftSale) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeGiftSale) GetConstructor() string {
+	return ConstructorStarTransactionTypeGiftSale
+}
+
+func (*StarTransactionTypeGiftSale) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeGiftSale
+}
+
+func (entity *StarTransactionTypeGiftSale) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeGiftSale) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeGiftSale) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeGiftSale"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeGiftSale) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "gift":
+			entity.Gift, err = decodeEntity[Gift](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeGiftSale)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is an upgrade of a gift; for regular users only
+type StarTransactionTypeGiftUpgrade struct {
+	meta
+	// Identifier of the user that initially sent the gift
+	UserId int64 `json:"user_id"`
+	// The upgraded gift
+	Gift *UpgradedGift `json:"gift"`
+}
+
+func (*StarTransactionTypeGiftUpgrade) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeGiftUpgrade) GetConstructor() string {
+	return ConstructorStarTransactionTypeGiftUpgrade
+}
+
+func (*StarTransactionTypeGiftUpgrade) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeGiftUpgrade
+}
+
+func (entity *StarTransactionTypeGiftUpgrade) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeGiftUpgrade) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeGiftUpgrade) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeGiftUpgrade"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"gift":`)
+	entity.Gift.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeGiftUpgrade) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "gift":
+			entity.Gift, err = decodeEntity[UpgradedGift](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeGiftUpgrade)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sending of a paid reaction to a message in a channel chat by the current user; for regular users only
+type StarTransactionTypeChannelPaidReactionSend struct {
+	meta
+	// Identifier of the channel chat
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the reacted message; can be 0 or an identifier of a deleted message
+	MessageId int64 `json:"message_id"`
+}
+
+func (*StarTransactionTypeChannelPaidReactionSend) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeChannelPaidReactionSend) GetConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidReactionSend
+}
+
+func (*StarTransactionTypeChannelPaidReactionSend) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidReactionSend
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionSend) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionSend) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionSend) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeChannelPaidReactionSend"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionSend) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeChannelPaidReactionSend)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a receiving of a paid reaction to a message by the channel chat; for channel chats only
+type StarTransactionTypeChannelPaidReactionReceive struct {
+	meta
+	// Identifier of the user that added the paid reaction
+	UserId int64 `json:"user_id"`
+	// Identifier of the reacted message; can be 0 or an identifier of a deleted message
+	MessageId int64 `json:"message_id"`
+}
+
+func (*StarTransactionTypeChannelPaidReactionReceive) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeChannelPaidReactionReceive) GetConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidReactionReceive
+}
+
+func (*StarTransactionTypeChannelPaidReactionReceive) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeChannelPaidReactionReceive
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionReceive) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionReceive) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionReceive) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeChannelPaidReactionReceive"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeChannelPaidReactionReceive) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeChannelPaidReactionReceive)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a receiving of a commission from an affiliate program; for regular users, bots and channel chats only
+type StarTransactionTypeAffiliateProgramCommission struct {
+	meta
+	// Identifier of the chat that created the affiliate program
+	ChatId int64 `json:"chat_id"`
+	// The number of Telegram Stars received by the affiliate for each 1000 Telegram Stars received by the program owner
+	CommissionPerMille int32 `json:"commission_per_mille"`
+}
+
+func (*StarTransactionTypeAffiliateProgramCommission) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeAffiliateProgramCommission) GetConstructor() string {
+	return ConstructorStarTransactionTypeAffiliateProgramCommission
+}
+
+func (*StarTransactionTypeAffiliateProgramCommission) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeAffiliateProgramCommission
+}
+
+func (entity *StarTransactionTypeAffiliateProgramCommission) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeAffiliateProgramCommission) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeAffiliateProgramCommission) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeAffiliateProgramCommission"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"commission_per_mille":`)
+	encoder.writeInt32(entity.CommissionPerMille)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeAffiliateProgramCommission) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "commission_per_mille":
+			entity.CommissionPerMille, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeAffiliateProgramCommission)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a sending of a paid message; for regular users only
+type StarTransactionTypePaidMessageSend struct {
+	meta
+	// Identifier of the chat that received the payment
+	ChatId int64 `json:"chat_id"`
+	// Number of sent paid messages
+	MessageCount int32 `json:"message_count"`
+}
+
+func (*StarTransactionTypePaidMessageSend) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypePaidMessageSend) GetConstructor() string {
+	return ConstructorStarTransactionTypePaidMessageSend
+}
+
+func (*StarTransactionTypePaidMessageSend) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypePaidMessageSend
+}
+
+func (entity *StarTransactionTypePaidMessageSend) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypePaidMessageSend) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypePaidMessageSend) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypePaidMessageSend"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_count":`)
+	encoder.writeInt32(entity.MessageCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypePaidMessageSend) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_count":
+			entity.MessageCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypePaidMessageSend)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a receiving of a paid message; for regular users and supergroup chats only
+type StarTransactionTypePaidMessageReceive struct {
+	meta
+	// Identifier of the sender of the message
+	SenderId MessageSender `json:"sender_id"`
+	// Number of received paid messages
+	MessageCount int32 `json:"message_count"`
+	// The number of Telegram Stars received by the Telegram for each 1000 Telegram Stars paid for message sending
+	CommissionPerMille int32 `json:"commission_per_mille"`
+	// The amount of Telegram Stars that were received by Telegram; can be negative for refunds
+	CommissionStarAmount *StarAmount `json:"commission_star_amount"`
+}
+
+func (*StarTransactionTypePaidMessageReceive) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypePaidMessageReceive) GetConstructor() string {
+	return ConstructorStarTransactionTypePaidMessageReceive
+}
+
+func (*StarTransactionTypePaidMessageReceive) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypePaidMessageReceive
+}
+
+func (entity *StarTransactionTypePaidMessageReceive) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypePaidMessageReceive) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypePaidMessageReceive) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypePaidMessageReceive"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"message_count":`)
+	encoder.writeInt32(entity.MessageCount)
+	encoder.writeRaw(`,"commission_per_mille":`)
+	encoder.writeInt32(entity.CommissionPerMille)
+	encoder.writeRaw(`,"commission_star_amount":`)
+	entity.CommissionStarAmount.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypePaidMessageReceive) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "message_count":
+			entity.MessageCount, err = decoder.readInt32()
+
+		case "commission_per_mille":
+			entity.CommissionPerMille, err = decoder.readInt32()
+
+		case "commission_star_amount":
+			entity.CommissionStarAmount, err = decodeEntity[StarAmount](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypePaidMessageReceive)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a purchase of Telegram Premium subscription; for regular users and bots only
+type StarTransactionTypePremiumPurchase struct {
+	meta
+	// Identifier of the user that received the Telegram Premium subscription
+	UserId int64 `json:"user_id"`
+	// Number of months the Telegram Premium subscription will be active
+	MonthCount int32 `json:"month_count"`
+	// A sticker to be shown in the transaction information; may be null if unknown
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+func (*StarTransactionTypePremiumPurchase) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypePremiumPurchase) GetConstructor() string {
+	return ConstructorStarTransactionTypePremiumPurchase
+}
+
+func (*StarTransactionTypePremiumPurchase) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypePremiumPurchase
+}
+
+func (entity *StarTransactionTypePremiumPurchase) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypePremiumPurchase) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypePremiumPurchase) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypePremiumPurchase"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypePremiumPurchase) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypePremiumPurchase)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a transfer of Telegram Stars to a business bot; for regular users only
+type StarTransactionTypeBusinessBotTransferSend struct {
+	meta
+	// Identifier of the bot that received Telegram Stars
+	UserId int64 `json:"user_id"`
+}
+
+func (*StarTransactionTypeBusinessBotTransferSend) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBusinessBotTransferSend) GetConstructor() string {
+	return ConstructorStarTransactionTypeBusinessBotTransferSend
+}
+
+func (*StarTransactionTypeBusinessBotTransferSend) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBusinessBotTransferSend
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferSend) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferSend) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferSend) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBusinessBotTransferSend"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferSend) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBusinessBotTransferSend)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a transfer of Telegram Stars from a business account; for bots only
+type StarTransactionTypeBusinessBotTransferReceive struct {
+	meta
+	// Identifier of the user that sent Telegram Stars
+	UserId int64 `json:"user_id"`
+}
+
+func (*StarTransactionTypeBusinessBotTransferReceive) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeBusinessBotTransferReceive) GetConstructor() string {
+	return ConstructorStarTransactionTypeBusinessBotTransferReceive
+}
+
+func (*StarTransactionTypeBusinessBotTransferReceive) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeBusinessBotTransferReceive
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferReceive) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferReceive) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferReceive) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeBusinessBotTransferReceive"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeBusinessBotTransferReceive) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeBusinessBotTransferReceive)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The transaction is a transaction of an unsupported type
+type StarTransactionTypeUnsupported struct {
+	meta
+}
+
+func (*StarTransactionTypeUnsupported) GetType() string {
+	return TypeStarTransactionType
+}
+
+func (*StarTransactionTypeUnsupported) GetConstructor() string {
+	return ConstructorStarTransactionTypeUnsupported
+}
+
+func (*StarTransactionTypeUnsupported) StarTransactionTypeConstructor() string {
+	return ConstructorStarTransactionTypeUnsupported
+}
+
+func (entity *StarTransactionTypeUnsupported) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactionTypeUnsupported) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactionTypeUnsupported) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactionTypeUnsupported"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactionTypeUnsupported) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStarTransactionTypeUnsupported)
+	})
+}
+
+// Represents a transaction changing the amount of owned Telegram Stars
+type StarTransaction struct {
+	meta
+	// Unique identifier of the transaction
+	Id string `json:"id"`
+	// The amount of added owned Telegram Stars; negative for outgoing transactions
+	StarAmount *StarAmount `json:"star_amount"`
+	// True, if the transaction is a refund of a previous transaction
+	IsRefund bool `json:"is_refund"`
+	// Point in time (Unix timestamp) when the transaction was completed
+	Date int32 `json:"date"`
+	// Type of the transaction
+	Type StarTransactionType `json:"type"`
+}
+
+func (*StarTransaction) GetType() string {
+	return TypeStarTransaction
+}
+
+func (*StarTransaction) GetConstructor() string {
+	return ConstructorStarTransaction
+}
+
+func (entity *StarTransaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"star_amount":`)
+	entity.StarAmount.encodeJSON(encoder)
+	encoder.writeRaw(`,"is_refund":`)
+	encoder.writeBool(entity.IsRefund)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "star_amount":
+			entity.StarAmount, err = decodeEntity[StarAmount](decoder)
+
+		case "is_refund":
+			entity.IsRefund, err = decoder.readBool()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "type":
+			entity.Type, err = decodeStarTransactionType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of Telegram Star transactions
+type StarTransactions struct {
+	meta
+	// The amount of owned Telegram Stars
+	StarAmount *StarAmount `json:"star_amount"`
+	// List of transactions with Telegram Stars
+	Transactions []*StarTransaction `json:"transactions"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*StarTransactions) GetType() string {
+	return TypeStarTransactions
+}
+
+func (*StarTransactions) GetConstructor() string {
+	return ConstructorStarTransactions
+}
+
+func (entity *StarTransactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarTransactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarTransactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starTransactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_amount":`)
+	entity.StarAmount.encodeJSON(encoder)
+	encoder.writeRaw(`,"transactions":`)
+	writeList(encoder, entity.Transactions, writeTypeValue[*StarTransaction])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarTransactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_amount":
+			entity.StarAmount, err = decodeEntity[StarAmount](decoder)
+
+		case "transactions":
+			entity.Transactions, err = readList(decoder, decodeEntity[StarTransaction])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarTransactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is eligible for the giveaway
+type GiveawayParticipantStatusEligible struct {
+	meta
+}
+
+func (*GiveawayParticipantStatusEligible) GetType() string {
+	return TypeGiveawayParticipantStatus
+}
+
+func (*GiveawayParticipantStatusEligible) GetConstructor() string {
+	return ConstructorGiveawayParticipantStatusEligible
+}
+
+func (*GiveawayParticipantStatusEligible) GiveawayParticipantStatusConstructor() string {
+	return ConstructorGiveawayParticipantStatusEligible
+}
+
+func (entity *GiveawayParticipantStatusEligible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayParticipantStatusEligible) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayParticipantStatusEligible) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayParticipantStatusEligible"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayParticipantStatusEligible) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorGiveawayParticipantStatusEligible)
+	})
+}
+
+// The user participates in the giveaway
+type GiveawayParticipantStatusParticipating struct {
+	meta
+}
+
+func (*GiveawayParticipantStatusParticipating) GetType() string {
+	return TypeGiveawayParticipantStatus
+}
+
+func (*GiveawayParticipantStatusParticipating) GetConstructor() string {
+	return ConstructorGiveawayParticipantStatusParticipating
+}
+
+func (*GiveawayParticipantStatusParticipating) GiveawayParticipantStatusConstructor() string {
+	return ConstructorGiveawayParticipantStatusParticipating
+}
+
+func (entity *GiveawayParticipantStatusParticipating) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayParticipantStatusParticipating) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayParticipantStatusParticipating) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayParticipantStatusParticipating"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayParticipantStatusParticipating) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorGiveawayParticipantStatusParticipating)
+	})
+}
+
+// The user can't participate in the giveaway, because they have already been member of the chat
+type GiveawayParticipantStatusAlreadyWasMember struct {
+	meta
+	// Point in time (Unix timestamp) when the user joined the chat
+	JoinedChatDate int32 `json:"joined_chat_date"`
+}
+
+func (*GiveawayParticipantStatusAlreadyWasMember) GetType() string {
+	return TypeGiveawayParticipantStatus
+}
+
+func (*GiveawayParticipantStatusAlreadyWasMember) GetConstructor() string {
+	return ConstructorGiveawayParticipantStatusAlreadyWasMember
+}
+
+func (*GiveawayParticipantStatusAlreadyWasMember) GiveawayParticipantStatusConstructor() string {
+	return ConstructorGiveawayParticipantStatusAlreadyWasMember
+}
+
+func (entity *GiveawayParticipantStatusAlreadyWasMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayParticipantStatusAlreadyWasMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayParticipantStatusAlreadyWasMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayParticipantStatusAlreadyWasMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"joined_chat_date":`)
+	encoder.writeInt32(entity.JoinedChatDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayParticipantStatusAlreadyWasMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "joined_chat_date":
+			entity.JoinedChatDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayParticipantStatusAlreadyWasMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user can't participate in the giveaway, because they are an administrator in one of the chats that created the giveaway
+type GiveawayParticipantStatusAdministrator struct {
+	meta
+	// Identifier of the chat administered by the user
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*GiveawayParticipantStatusAdministrator) GetType() string {
+	return TypeGiveawayParticipantStatus
+}
+
+func (*GiveawayParticipantStatusAdministrator) GetConstructor() string {
+	return ConstructorGiveawayParticipantStatusAdministrator
+}
+
+func (*GiveawayParticipantStatusAdministrator) GiveawayParticipantStatusConstructor() string {
+	return ConstructorGiveawayParticipantStatusAdministrator
+}
+
+func (entity *GiveawayParticipantStatusAdministrator) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayParticipantStatusAdministrator) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayParticipantStatusAdministrator) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayParticipantStatusAdministrator"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayParticipantStatusAdministrator) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayParticipantStatusAdministrator)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user can't participate in the giveaway, because they phone number is from a disallowed country
+type GiveawayParticipantStatusDisallowedCountry struct {
+	meta
+	// A two-letter ISO 3166-1 alpha-2 country code of the user's country
+	UserCountryCode string `json:"user_country_code"`
+}
+
+func (*GiveawayParticipantStatusDisallowedCountry) GetType() string {
+	return TypeGiveawayParticipantStatus
+}
+
+func (*GiveawayParticipantStatusDisallowedCountry) GetConstructor() string {
+	return ConstructorGiveawayParticipantStatusDisallowedCountry
+}
+
+func (*GiveawayParticipantStatusDisallowedCountry) GiveawayParticipantStatusConstructor() string {
+	return ConstructorGiveawayParticipantStatusDisallowedCountry
+}
+
+func (entity *GiveawayParticipantStatusDisallowedCountry) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayParticipantStatusDisallowedCountry) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayParticipantStatusDisallowedCountry) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayParticipantStatusDisallowedCountry"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_country_code":`)
+	encoder.writeString(entity.UserCountryCode)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayParticipantStatusDisallowedCountry) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_country_code":
+			entity.UserCountryCode, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayParticipantStatusDisallowedCountry)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an ongoing giveaway
+type GiveawayInfoOngoing struct {
+	meta
+	// Point in time (Unix timestamp) when the giveaway was created
+	CreationDate int32 `json:"creation_date"`
+	// Status of the current user in the giveaway
+	Status GiveawayParticipantStatus `json:"status"`
+	// True, if the giveaway has ended and results are being prepared
+	IsEnded bool `json:"is_ended"`
+}
+
+func (*GiveawayInfoOngoing) GetType() string {
+	return TypeGiveawayInfo
+}
+
+func (*GiveawayInfoOngoing) GetConstructor() string {
+	return ConstructorGiveawayInfoOngoing
+}
+
+func (*GiveawayInfoOngoing) GiveawayInfoConstructor() string {
+	return ConstructorGiveawayInfoOngoing
+}
+
+func (entity *GiveawayInfoOngoing) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayInfoOngoing) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayInfoOngoing) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayInfoOngoing"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"creation_date":`)
+	encoder.writeInt32(entity.CreationDate)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(entity.Status)
+	encoder.writeRaw(`,"is_ended":`)
+	encoder.writeBool(entity.IsEnded)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayInfoOngoing) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "creation_date":
+			entity.CreationDate, err = decoder.readInt32()
+
+		case "status":
+			entity.Status, err = decodeGiveawayParticipantStatus(decoder)
+
+		case "is_ended":
+			entity.IsEnded, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayInfoOngoing)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a completed giveaway
+type GiveawayInfoCompleted struct {
+	meta
+	// Point in time (Unix timestamp) when the giveaway was created
+	CreationDate int32 `json:"creation_date"`
+	// Point in time (Unix timestamp) when the winners were selected. May be bigger than winners selection date specified in parameters of the giveaway
+	ActualWinnersSelectionDate int32 `json:"actual_winners_selection_date"`
+	// True, if the giveaway was canceled and was fully refunded
+	WasRefunded bool `json:"was_refunded"`
+	// True, if the current user is a winner of the giveaway
+	IsWinner bool `json:"is_winner"`
+	// Number of winners in the giveaway
+	WinnerCount int32 `json:"winner_count"`
+	// Number of winners, which activated their gift codes; for Telegram Premium giveaways only
+	ActivationCount int32 `json:"activation_count"`
+	// Telegram Premium gift code that was received by the current user; empty if the user isn't a winner in the giveaway or the giveaway isn't a Telegram Premium giveaway
+	GiftCode string `json:"gift_code"`
+	// The amount of Telegram Stars won by the current user; 0 if the user isn't a winner in the giveaway or the giveaway isn't a Telegram Star giveaway
+	WonStarCount int64 `json:"won_star_count"`
+}
+
+func (*GiveawayInfoCompleted) GetType() string {
+	return TypeGiveawayInfo
+}
+
+func (*GiveawayInfoCompleted) GetConstructor() string {
+	return ConstructorGiveawayInfoCompleted
+}
+
+func (*GiveawayInfoCompleted) GiveawayInfoConstructor() string {
+	return ConstructorGiveawayInfoCompleted
+}
+
+func (entity *GiveawayInfoCompleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayInfoCompleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayInfoCompleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayInfoCompleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"creation_date":`)
+	encoder.writeInt32(entity.CreationDate)
+	encoder.writeRaw(`,"actual_winners_selection_date":`)
+	encoder.writeInt32(entity.ActualWinnersSelectionDate)
+	encoder.writeRaw(`,"was_refunded":`)
+	encoder.writeBool(entity.WasRefunded)
+	encoder.writeRaw(`,"is_winner":`)
+	encoder.writeBool(entity.IsWinner)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"activation_count":`)
+	encoder.writeInt32(entity.ActivationCount)
+	encoder.writeRaw(`,"gift_code":`)
+	encoder.writeString(entity.GiftCode)
+	encoder.writeRaw(`,"won_star_count":`)
+	encoder.writeInt64(entity.WonStarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayInfoCompleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "creation_date":
+			entity.CreationDate, err = decoder.readInt32()
+
+		case "actual_winners_selection_date":
+			entity.ActualWinnersSelectionDate, err = decoder.readInt32()
+
+		case "was_refunded":
+			entity.WasRefunded, err = decoder.readBool()
+
+		case "is_winner":
+			entity.IsWinner, err = decoder.readBool()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "activation_count":
+			entity.ActivationCount, err = decoder.readInt32()
+
+		case "gift_code":
+			entity.GiftCode, err = decoder.readString()
+
+		case "won_star_count":
+			entity.WonStarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayInfoCompleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The giveaway sends Telegram Premium subscriptions to the winners
+type GiveawayPrizePremium struct {
+	meta
+	// Number of months the Telegram Premium subscription will be active after code activation
+	MonthCount int32 `json:"month_count"`
+}
+
+func (*GiveawayPrizePremium) GetType() string {
+	return TypeGiveawayPrize
+}
+
+func (*GiveawayPrizePremium) GetConstructor() string {
+	return ConstructorGiveawayPrizePremium
+}
+
+func (*GiveawayPrizePremium) GiveawayPrizeConstructor() string {
+	return ConstructorGiveawayPrizePremium
+}
+
+func (entity *GiveawayPrizePremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayPrizePremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayPrizePremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayPrizePremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayPrizePremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayPrizePremium)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The giveaway sends Telegram Stars to the winners
+type GiveawayPrizeStars struct {
+	meta
+	// Number of Telegram Stars that will be shared by all winners
+	StarCount int64 `json:"star_count"`
+}
+
+func (*GiveawayPrizeStars) GetType() string {
+	return TypeGiveawayPrize
+}
+
+func (*GiveawayPrizeStars) GetConstructor() string {
+	return ConstructorGiveawayPrizeStars
+}
+
+func (*GiveawayPrizeStars) GiveawayPrizeConstructor() string {
+	return ConstructorGiveawayPrizeStars
+}
+
+func (entity *GiveawayPrizeStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayPrizeStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayPrizeStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayPrizeStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayPrizeStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayPrizeStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about subscription plan that must be paid by the user to use a chat invite link
+type ChatInviteLinkSubscriptionInfo struct {
+	meta
+	// Information about subscription plan that must be paid by the user to use the link
+	Pricing *StarSubscriptionPricing `json:"pricing"`
+	// True, if the user has already paid for the subscription and can use joinChatByInviteLink to join the subscribed chat again
+	CanReuse bool `json:"can_reuse"`
+	// Identifier of the payment form to use for subscription payment; 0 if the subscription can't be paid
+	FormId JsonInt64 `json:"form_id"`
+}
+
+func (*ChatInviteLinkSubscriptionInfo) GetType() string {
+	return TypeChatInviteLinkSubscriptionInfo
+}
+
+func (*ChatInviteLinkSubscriptionInfo) GetConstructor() string {
+	return ConstructorChatInviteLinkSubscriptionInfo
+}
+
+func (entity *ChatInviteLinkSubscriptionInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatInviteLinkSubscriptionInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatInviteLinkSubscriptionInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatInviteLinkSubscriptionInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"pricing":`)
+	entity.Pricing.encodeJSON(encoder)
+	encoder.writeRaw(`,"can_reuse":`)
+	encoder.writeBool(entity.CanReuse)
+	encoder.writeRaw(`,"form_id":`)
+	encoder.writeJsonInt64(entity.FormId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatInviteLinkSubscriptionInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "pricing":
+			entity.Pricing, err = decodeEntity[StarSubscriptionPricing](decoder)
+
+		case "can_reuse":
+			entity.CanReuse, err = decoder.readBool()
+
+		case "form_id":
+			entity.FormId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatInviteLinkSubscriptionInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A paid reaction on behalf of the current user
+type PaidReactionTypeRegular struct {
+	meta
+}
+
+func (*PaidReactionTypeRegular) GetType() string {
+	return TypePaidReactionType
+}
+
+func (*PaidReactionTypeRegular) GetConstructor() string {
+	return ConstructorPaidReactionTypeRegular
+}
+
+func (*PaidReactionTypeRegular) PaidReactionTypeConstructor() string {
+	return ConstructorPaidReactionTypeRegular
+}
+
+func (entity *PaidReactionTypeRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidReactionTypeRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidReactionTypeRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidReactionTypeRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidReactionTypeRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPaidReactionTypeRegular)
+	})
+}
+
+// An anonymous paid reaction
+type PaidReactionTypeAnonymous struct {
+	meta
+}
+
+func (*PaidReactionTypeAnonymous) GetType() string {
+	return TypePaidReactionType
+}
+
+func (*PaidReactionTypeAnonymous) GetConstructor() string {
+	return ConstructorPaidReactionTypeAnonymous
+}
+
+func (*PaidReactionTypeAnonymous) PaidReactionTypeConstructor() string {
+	return ConstructorPaidReactionTypeAnonymous
+}
+
+func (entity *PaidReactionTypeAnonymous) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidReactionTypeAnonymous) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidReactionTypeAnonymous) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidReactionTypeAnonymous"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidReactionTypeAnonymous) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPaidReactionTypeAnonymous)
+	})
+}
+
+// A paid reaction on behalf of an owned chat
+type PaidReactionTypeChat struct {
+	meta
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*PaidReactionTypeChat) GetType() string {
+	return TypePaidReactionType
+}
+
+func (*PaidReactionTypeChat) GetConstructor() string {
+	return ConstructorPaidReactionTypeChat
+}
+
+func (*PaidReactionTypeChat) PaidReactionTypeConstructor() string {
+	return ConstructorPaidReactionTypeChat
+}
+
+func (entity *PaidReactionTypeChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidReactionTypeChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidReactionTypeChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidReactionTypeChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidReactionTypeChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaidReactionTypeChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a user that added paid reactions
+type PaidReactor struct {
+	meta
+	// Identifier of the user or chat that added the reactions; may be null for anonymous reactors that aren't the current user
+	SenderId MessageSender `json:"sender_id,omitempty"`
+	// Number of Telegram Stars added
+	StarCount int32 `json:"star_count"`
+	// True, if the reactor is one of the most active reactors; may be false if the reactor is the current user
+	IsTop bool `json:"is_top"`
+	// True, if the paid reaction was added by the current user
+	IsMe bool `json:"is_me"`
+	// True, if the reactor is anonymous
+	IsAnonymous bool `json:"is_anonymous"`
+}
+
+func (*PaidReactor) GetType() string {
+	return TypePaidReactor
+}
+
+func (*PaidReactor) GetConstructor() string {
+	return ConstructorPaidReactor
+}
+
+func (entity *PaidReactor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidReactor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidReactor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidReactor"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.SenderId != nil {
+		encoder.writeRaw(`,"sender_id":`)
+		encoder.writeType(entity.SenderId)
+	}
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt32(entity.StarCount)
+	encoder.writeRaw(`,"is_top":`)
+	encoder.writeBool(entity.IsTop)
+	encoder.writeRaw(`,"is_me":`)
+	encoder.writeBool(entity.IsMe)
+	encoder.writeRaw(`,"is_anonymous":`)
+	encoder.writeBool(entity.IsAnonymous)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidReactor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt32()
+
+		case "is_top":
+			entity.IsTop, err = decoder.readBool()
+
+		case "is_me":
+			entity.IsMe, err = decoder.readBool()
+
+		case "is_anonymous":
+			entity.IsAnonymous, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaidReactor)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Product invoice
+type Invoice struct {
+	meta
+	// ISO 4217 currency code
+	Currency string `json:"currency"`
+	// A list of objects used to calculate the total price of the product
+	PriceParts []*LabeledPricePart `json:"price_parts"`
+	// The number of seconds between consecutive Telegram Star debiting for subscription invoices; 0 if the invoice doesn't create subscription
+	SubscriptionPeriod int32 `json:"subscription_period"`
+	// The maximum allowed amount of tip in the smallest units of the currency
+	MaxTipAmount int64 `json:"max_tip_amount"`
+	// Suggested amounts of tip in the smallest units of the currency
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts"`
+	// An HTTP URL with terms of service for recurring payments. If non-empty, the invoice payment will result in recurring payments and the user must accept the terms of service before allowed to pay
+	RecurringPaymentTermsOfServiceUrl string `json:"recurring_payment_terms_of_service_url"`
+	// An HTTP URL with terms of service for non-recurring payments. If non-empty, then the user must accept the terms of service before allowed to pay
+	TermsOfServiceUrl string `json:"terms_of_service_url"`
+	// True, if the payment is a test payment
+	IsTest bool `json:"is_test"`
+	// True, if the user's name is needed for payment
+	NeedName bool `json:"need_name"`
+	// True, if the user's phone number is needed for payment
+	NeedPhoneNumber bool `json:"need_phone_number"`
+	// True, if the user's email address is needed for payment
+	NeedEmailAddress bool `json:"need_email_address"`
+	// True, if the user's shipping address is needed for payment
+	NeedShippingAddress bool `json:"need_shipping_address"`
+	// True, if the user's phone number will be sent to the provider
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider"`
+	// True, if the user's email address will be sent to the provider
+	SendEmailAddressToProvider bool `json:"send_email_address_to_provider"`
+	// True, if the total price depends on the shipping method
+	IsFlexible bool `json:"is_flexible"`
+}
+
+func (*Invoice) GetType() string {
+	return TypeInvoice
+}
+
+func (*Invoice) GetConstructor() string {
+	return ConstructorInvoice
+}
+
+func (entity *Invoice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Invoice) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Invoice) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"invoice"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"price_parts":`)
+	writeList(encoder, entity.PriceParts, writeTypeValue[*LabeledPricePart])
+	encoder.writeRaw(`,"subscription_period":`)
+	encoder.writeInt32(entity.SubscriptionPeriod)
+	encoder.writeRaw(`,"max_tip_amount":`)
+	encoder.writeInt64(entity.MaxTipAmount)
+	encoder.writeRaw(`,"suggested_tip_amounts":`)
+	writeList(encoder, entity.SuggestedTipAmounts, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"recurring_payment_terms_of_service_url":`)
+	encoder.writeString(entity.RecurringPaymentTermsOfServiceUrl)
+	encoder.writeRaw(`,"terms_of_service_url":`)
+	encoder.writeString(entity.TermsOfServiceUrl)
+	encoder.writeRaw(`,"is_test":`)
+	encoder.writeBool(entity.IsTest)
+	encoder.writeRaw(`,"need_name":`)
+	encoder.writeBool(entity.NeedName)
+	encoder.writeRaw(`,"need_phone_number":`)
+	encoder.writeBool(entity.NeedPhoneNumber)
+	encoder.writeRaw(`,"need_email_address":`)
+	encoder.writeBool(entity.NeedEmailAddress)
+	encoder.writeRaw(`,"need_shipping_address":`)
+	encoder.writeBool(entity.NeedShippingAddress)
+	encoder.writeRaw(`,"send_phone_number_to_provider":`)
+	encoder.writeBool(entity.SendPhoneNumberToProvider)
+	encoder.writeRaw(`,"send_email_address_to_provider":`)
+	encoder.writeBool(entity.SendEmailAddressToProvider)
+	encoder.writeRaw(`,"is_flexible":`)
+	encoder.writeBool(entity.IsFlexible)
+	encoder.writeRaw("}")
+}
+
+func (entity *Invoice) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "price_parts":
+			entity.PriceParts, err = readList(decoder, decodeEntity[LabeledPricePart])
+
+		case "subscription_period":
+			entity.SubscriptionPeriod, err = decoder.readInt32()
+
+		case "max_tip_amount":
+			entity.MaxTipAmount, err = decoder.readInt64()
+
+		case "suggested_tip_amounts":
+			entity.SuggestedTipAmounts, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "recurring_payment_terms_of_service_url":
+			entity.RecurringPaymentTermsOfServiceUrl, err = decoder.readString()
+
+		case "terms_of_service_url":
+			entity.TermsOfServiceUrl, err = decoder.readString()
+
+		case "is_test":
+			entity.IsTest, err = decoder.readBool()
+
+		case "need_name":
+			entity.NeedName, err = decoder.readBool()
+
+		case "need_phone_number":
+			entity.NeedPhoneNumber, err = decoder.readBool()
+
+		case "need_email_address":
+			entity.NeedEmailAddress, err = decoder.readBool()
+
+		case "need_shipping_address":
+			entity.NeedShippingAddress, err = decoder.readBool()
+
+		case "send_phone_number_to_provider":
+			entity.SendPhoneNumberToProvider, err = decoder.readBool()
+
+		case "send_email_address_to_provider":
+			entity.SendEmailAddressToProvider, err = decoder.readBool()
+
+		case "is_flexible":
+			entity.IsFlexible, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInvoice)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Smart Glocal payment provider
+type PaymentProviderSmartGlocal struct {
+	meta
+	// Public payment token
+	PublicToken string `json:"public_token"`
+	// URL for sending card tokenization requests
+	TokenizeUrl string `json:"tokenize_url"`
+}
+
+func (*PaymentProviderSmartGlocal) GetType() string {
+	return TypePaymentProvider
+}
+
+func (*PaymentProviderSmartGlocal) GetConstructor() string {
+	return ConstructorPaymentProviderSmartGlocal
+}
+
+func (*PaymentProviderSmartGlocal) PaymentProviderConstructor() string {
+	return ConstructorPaymentProviderSmartGlocal
+}
+
+func (entity *PaymentProviderSmartGlocal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentProviderSmartGlocal) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentProviderSmartGlocal) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentProviderSmartGlocal"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"public_token":`)
+	encoder.writeString(entity.PublicToken)
+	encoder.writeRaw(`,"tokenize_url":`)
+	encoder.writeString(entity.TokenizeUrl)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentProviderSmartGlocal) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "public_token":
+			entity.PublicToken, err = decoder.readString()
+
+		case "tokenize_url":
+			entity.TokenizeUrl, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentProviderSmartGlocal)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Stripe payment provider
+type PaymentProviderStripe struct {
+	meta
+	// Stripe API publishable key
+	PublishableKey string `json:"publishable_key"`
+	// True, if the user country must be provided
+	NeedCountry bool `json:"need_country"`
+	// True, if the user ZIP/postal code must be provided
+	NeedPostalCode bool `json:"need_postal_code"`
+	// True, if the cardholder name must be provided
+	NeedCardholderName bool `json:"need_cardholder_name"`
+}
+
+func (*PaymentProviderStripe) GetType() string {
+	return TypePaymentProvider
+}
+
+func (*PaymentProviderStripe) GetConstructor() string {
+	return ConstructorPaymentProviderStripe
+}
+
+func (*PaymentProviderStripe) PaymentProviderConstructor() string {
+	return ConstructorPaymentProviderStripe
+}
+
+func (entity *PaymentProviderStripe) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentProviderStripe) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentProviderStripe) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentProviderStripe"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"publishable_key":`)
+	encoder.writeString(entity.PublishableKey)
+	encoder.writeRaw(`,"need_country":`)
+	encoder.writeBool(entity.NeedCountry)
+	encoder.writeRaw(`,"need_postal_code":`)
+	encoder.writeBool(entity.NeedPostalCode)
+	encoder.writeRaw(`,"need_cardholder_name":`)
+	encoder.writeBool(entity.NeedCardholderName)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentProviderStripe) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "publishable_key":
+			entity.PublishableKey, err = decoder.readString()
+
+		case "need_country":
+			entity.NeedCountry, err = decoder.readBool()
+
+		case "need_postal_code":
+			entity.NeedPostalCode, err = decoder.readBool()
+
+		case "need_cardholder_name":
+			entity.NeedCardholderName, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentProviderStripe)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some other payment provider, for which a web payment form must be shown
+type PaymentProviderOther struct {
+	meta
+	// Payment form URL
+	Url string `json:"url"`
+}
+
+func (*PaymentProviderOther) GetType() string {
+	return TypePaymentProvider
+}
+
+func (*PaymentProviderOther) GetConstructor() string {
+	return ConstructorPaymentProviderOther
+}
+
+func (*PaymentProviderOther) PaymentProviderConstructor() string {
+	return ConstructorPaymentProviderOther
+}
+
+func (entity *PaymentProviderOther) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentProviderOther) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentProviderOther) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentProviderOther"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentProviderOther) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentProviderOther)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an additional payment option
+type PaymentOption struct {
+	meta
+	// Title for the payment option
+	Title string `json:"title"`
+	// Payment form URL to be opened in a web view
+	Url string `json:"url"`
+}
+
+func (*PaymentOption) GetType() string {
+	return TypePaymentOption
+}
+
+func (*PaymentOption) GetConstructor() string {
+	return ConstructorPaymentOption
+}
+
+func (entity *PaymentOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The payment form is for a regular payment
+type PaymentFormTypeRegular struct {
+	meta
+	// Full information about the invoice
+	Invoice *Invoice `json:"invoice"`
+	// User identifier of the payment provider bot
+	PaymentProviderUserId int64 `json:"payment_provider_user_id"`
+	// Information about the payment provider
+	PaymentProvider PaymentProvider `json:"payment_provider"`
+	// The list of additional payment options
+	AdditionalPaymentOptions []*PaymentOption `json:"additional_payment_options"`
+	// Saved server-side order information; may be null
+	SavedOrderInfo *OrderInfo `json:"saved_order_info,omitempty"`
+	// The list of saved payment credentials
+	SavedCredentials []*SavedCredentials `json:"saved_credentials"`
+	// True, if the user can choose to save credentials
+	CanSaveCredentials bool `json:"can_save_credentials"`
+	// True, if the user will be able to save credentials, if sets up a 2-step verification password
+	NeedPassword bool `json:"need_password"`
+}
+
+func (*PaymentFormTypeRegular) GetType() string {
+	return TypePaymentFormType
+}
+
+func (*PaymentFormTypeRegular) GetConstructor() string {
+	return ConstructorPaymentFormTypeRegular
+}
+
+func (*PaymentFormTypeRegular) PaymentFormTypeConstructor() string {
+	return ConstructorPaymentFormTypeRegular
+}
+
+func (entity *PaymentFormTypeRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentFormTypeRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentFormTypeRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentFormTypeRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invoice":`)
+	entity.Invoice.encodeJSON(encoder)
+	encoder.writeRaw(`,"payment_provider_user_id":`)
+	encoder.writeInt64(entity.PaymentProviderUserId)
+	encoder.writeRaw(`,"payment_provider":`)
+	encoder.writeType(entity.PaymentProvider)
+	encoder.writeRaw(`,"additional_payment_options":`)
+	writeList(encoder, entity.AdditionalPaymentOptions, writeTypeValue[*PaymentOption])
+	if entity.SavedOrderInfo != nil {
+		encoder.writeRaw(`,"saved_order_info":`)
+		entity.SavedOrderInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"saved_credentials":`)
+	writeList(encoder, entity.SavedCredentials, writeTypeValue[*SavedCredentials])
+	encoder.writeRaw(`,"can_save_credentials":`)
+	encoder.writeBool(entity.CanSaveCredentials)
+	encoder.writeRaw(`,"need_password":`)
+	encoder.writeBool(entity.NeedPassword)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentFormTypeRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invoice":
+			entity.Invoice, err = decodeEntity[Invoice](decoder)
+
+		case "payment_provider_user_id":
+			entity.PaymentProviderUserId, err = decoder.readInt64()
+
+		case "payment_provider":
+			entity.PaymentProvider, err = decodePaymentProvider(decoder)
+
+		case "additional_payment_options":
+			entity.AdditionalPaymentOptions, err = readList(decoder, decodeEntity[PaymentOption])
+
+		case "saved_order_info":
+			entity.SavedOrderInfo, err = decodeEntity[OrderInfo](decoder)
+
+		case "saved_credentials":
+			entity.SavedCredentials, err = readList(decoder, decodeEntity[SavedCredentials])
+
+		case "can_save_credentials":
+			entity.CanSaveCredentials, err = decoder.readBool()
+
+		case "need_password":
+			entity.NeedPassword, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentFormTypeRegular)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The payment form is for a payment in Telegram Stars
+type PaymentFormTypeStars struct {
+	meta
+	// Number of Telegram Stars that will be paid
+	StarCount int64 `json:"star_count"`
+}
+
+func (*PaymentFormTypeStars) GetType() string {
+	return TypePaymentFormType
+}
+
+func (*PaymentFormTypeStars) GetConstructor() string {
+	return ConstructorPaymentFormTypeStars
+}
+
+func (*PaymentFormTypeStars) PaymentFormTypeConstructor() string {
+	return ConstructorPaymentFormTypeStars
+}
+
+func (entity *PaymentFormTypeStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentFormTypeStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentFormTypeStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentFormTypeStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentFormTypeStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentFormTypeStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The payment form is for a payment in Telegram Stars for subscription
+type PaymentFormTypeStarSubscription struct {
+	meta
+	// Information about subscription plan
+	Pricing *StarSubscriptionPricing `json:"pricing"`
+}
+
+func (*PaymentFormTypeStarSubscription) GetType() string {
+	return TypePaymentFormType
+}
+
+func (*PaymentFormTypeStarSubscription) GetConstructor() string {
+	return ConstructorPaymentFormTypeStarSubscription
+}
+
+func (*PaymentFormTypeStarSubscription) PaymentFormTypeConstructor() string {
+	return ConstructorPaymentFormTypeStarSubscription
+}
+
+func (entity *PaymentFormTypeStarSubscription) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentFormTypeStarSubscription) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentFormTypeStarSubscription) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentFormTypeStarSubscription"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"pricing":`)
+	entity.Pricing.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentFormTypeStarSubscription) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "pricing":
+			entity.Pricing, err = decodeEntity[StarSubscriptionPricing](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentFormTypeStarSubscription)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an invoice payment form
+type PaymentForm struct {
+	meta
+	// The payment form identifier
+	Id JsonInt64 `json:"id"`
+	// Type of the payment form
+	Type PaymentFormType `json:"type"`
+	// User identifier of the seller bot
+	SellerBotUserId int64 `json:"seller_bot_user_id"`
+	// Information about the product
+	ProductInfo *ProductInfo `json:"product_info"`
+}
+
+func (*PaymentForm) GetType() string {
+	return TypePaymentForm
+}
+
+func (*PaymentForm) GetConstructor() string {
+	return ConstructorPaymentForm
+}
+
+func (entity *PaymentForm) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentForm) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentForm) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentForm"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"seller_bot_user_id":`)
+	encoder.writeInt64(entity.SellerBotUserId)
+	encoder.writeRaw(`,"product_info":`)
+	entity.ProductInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentForm) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "type":
+			entity.Type, err = decodePaymentFormType(decoder)
+
+		case "seller_bot_user_id":
+			entity.SellerBotUserId, err = decoder.readInt64()
+
+		case "product_info":
+			entity.ProductInfo, err = decodeEntity[ProductInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentForm)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains the result of a payment request
+type PaymentResult struct {
+	meta
+	// True, if the payment request was successful; otherwise, the verification_url will be non-empty
+	Success bool `json:"success"`
+	// URL for additional payment credentials verification
+	VerificationUrl string `json:"verification_url"`
+}
+
+func (*PaymentResult) GetType() string {
+	return TypePaymentResult
+}
+
+func (*PaymentResult) GetConstructor() string {
+	return ConstructorPaymentResult
+}
+
+func (entity *PaymentResult) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentResult) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentResult) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentResult"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"success":`)
+	encoder.writeBool(entity.Success)
+	encoder.writeRaw(`,"verification_url":`)
+	encoder.writeString(entity.VerificationUrl)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentResult) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "success":
+			entity.Success, err = decoder.readBool()
+
+		case "verification_url":
+			entity.VerificationUrl, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentResult)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The payment was done using a third-party payment provider
+type PaymentReceiptTypeRegular struct {
+	meta
+	// User identifier of the payment provider bot
+	PaymentProviderUserId int64 `json:"payment_provider_user_id"`
+	// Information about the invoice
+	Invoice *Invoice `json:"invoice"`
+	// Order information; may be null
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+	// Chosen shipping option; may be null
+	ShippingOption *ShippingOption `json:"shipping_option,omitempty"`
+	// Title of the saved credentials chosen by the buyer
+	CredentialsTitle string `json:"credentials_title"`
+	// The amount of tip chosen by the buyer in the smallest units of the currency
+	TipAmount int64 `json:"tip_amount"`
+}
+
+func (*PaymentReceiptTypeRegular) GetType() string {
+	return TypePaymentReceiptType
+}
+
+func (*PaymentReceiptTypeRegular) GetConstructor() string {
+	return ConstructorPaymentReceiptTypeRegular
+}
+
+func (*PaymentReceiptTypeRegular) PaymentReceiptTypeConstructor() string {
+	return ConstructorPaymentReceiptTypeRegular
+}
+
+func (entity *PaymentReceiptTypeRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentReceiptTypeRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentReceiptTypeRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentReceiptTypeRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"payment_provider_user_id":`)
+	encoder.writeInt64(entity.PaymentProviderUserId)
+	encoder.writeRaw(`,"invoice":`)
+	entity.Invoice.encodeJSON(encoder)
+	if entity.OrderInfo != nil {
+		encoder.writeRaw(`,"order_info":`)
+		entity.OrderInfo.encodeJSON(encoder)
+	}
+	if entity.ShippingOption != nil {
+		encoder.writeRaw(`,"shipping_option":`)
+		entity.ShippingOption.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"credentials_title":`)
+	encoder.writeString(entity.CredentialsTitle)
+	encoder.writeRaw(`,"tip_amount":`)
+	encoder.writeInt64(entity.TipAmount)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentReceiptTypeRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "payment_provider_user_id":
+			entity.PaymentProviderUserId, err = decoder.readInt64()
+
+		case "invoice":
+			entity.Invoice, err = decodeEntity[Invoice](decoder)
+
+		case "order_info":
+			entity.OrderInfo, err = decodeEntity[OrderInfo](decoder)
+
+		case "shipping_option":
+			entity.ShippingOption, err = decodeEntity[ShippingOption](decoder)
+
+		case "credentials_title":
+			entity.CredentialsTitle, err = decoder.readString()
+
+		case "tip_amount":
+			entity.TipAmount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentReceiptTypeRegular)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The payment was done using Telegram Stars
+type PaymentReceiptTypeStars struct {
+	meta
+	// Number of Telegram Stars that were paid
+	StarCount int64 `json:"star_count"`
+	// Unique identifier of the transaction that can be used to dispute it
+	TransactionId string `json:"transaction_id"`
+}
+
+func (*PaymentReceiptTypeStars) GetType() string {
+	return TypePaymentReceiptType
+}
+
+func (*PaymentReceiptTypeStars) GetConstructor() string {
+	return ConstructorPaymentReceiptTypeStars
+}
+
+func (*PaymentReceiptTypeStars) PaymentReceiptTypeConstructor() string {
+	return ConstructorPaymentReceiptTypeStars
+}
+
+func (entity *PaymentReceiptTypeStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentReceiptTypeStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentReceiptTypeStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentReceiptTypeStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"transaction_id":`)
+	encoder.writeString(entity.TransactionId)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentReceiptTypeStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "transaction_id":
+			entity.TransactionId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentReceiptTypeStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a successful payment
+type PaymentReceipt struct {
+	meta
+	// Information about the product
+	ProductInfo *ProductInfo `json:"product_info"`
+	// Point in time (Unix timestamp) when the payment was made
+	Date int32 `json:"date"`
+	// User identifier of the seller bot
+	SellerBotUserId int64 `json:"seller_bot_user_id"`
+	// Type of the payment receipt
+	Type PaymentReceiptType `json:"type"`
+}
+
+func (*PaymentReceipt) GetType() string {
+	return TypePaymentReceipt
+}
+
+func (*PaymentReceipt) GetConstructor() string {
+	return ConstructorPaymentReceipt
+}
+
+func (entity *PaymentReceipt) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaymentReceipt) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaymentReceipt) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paymentReceipt"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"product_info":`)
+	entity.ProductInfo.encodeJSON(encoder)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"seller_bot_user_id":`)
+	encoder.writeInt64(entity.SellerBotUserId)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaymentReceipt) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "product_info":
+			entity.ProductInfo, err = decodeEntity[ProductInfo](decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "seller_bot_user_id":
+			entity.SellerBotUserId, err = decoder.readInt64()
+
+		case "type":
+			entity.Type, err = decodePaymentReceiptType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaymentReceipt)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An invoice from a message of the type messageInvoice or paid media purchase from messagePaidMedia
+type InputInvoiceMessage struct {
+	meta
+	// Chat identifier of the message
+	ChatId int64 `json:"chat_id"`
+	// Message identifier. Use messageProperties.can_be_paid to check whether the message can be used in the method
+	MessageId int64 `json:"message_id"`
+}
+
+func (*InputInvoiceMessage) GetType() string {
+	return TypeInputInvoice
+}
+
+func (*InputInvoiceMessage) GetConstructor() string {
+	return ConstructorInputInvoiceMessage
+}
+
+func (*InputInvoiceMessage) InputInvoiceConstructor() string {
+	return ConstructorInputInvoiceMessage
+}
+
+func (entity *InputInvoiceMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInvoiceMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInvoiceMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInvoiceMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInvoiceMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInvoiceMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An invoice from a link of the type internalLinkTypeInvoice
+type InputInvoiceName struct {
+	meta
+	// Name of the invoice
+	Name string `json:"name"`
+}
+
+func (*InputInvoiceName) GetType() string {
+	return TypeInputInvoice
+}
+
+func (*InputInvoiceName) GetConstructor() string {
+	return ConstructorInputInvoiceName
+}
+
+func (*InputInvoiceName) InputInvoiceConstructor() string {
+	return ConstructorInputInvoiceName
+}
+
+func (entity *InputInvoiceName) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInvoiceName) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInvoiceName) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInvoiceName"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInvoiceName) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInvoiceName)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An invoice for a payment toward Telegram; must not be used in the in-store apps
+type InputInvoiceTelegram struct {
+	meta
+	// Transaction purpose
+	Purpose TelegramPaymentPurpose `json:"purpose"`
+}
+
+func (*InputInvoiceTelegram) GetType() string {
+	return TypeInputInvoice
+}
+
+func (*InputInvoiceTelegram) GetConstructor() string {
+	return ConstructorInputInvoiceTelegram
+}
+
+func (*InputInvoiceTelegram) InputInvoiceConstructor() string {
+	return ConstructorInputInvoiceTelegram
+}
+
+func (entity *InputInvoiceTelegram) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputInvoiceTelegram) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputInvoiceTelegram) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputInvoiceTelegram"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"purpose":`)
+	encoder.writeType(entity.Purpose)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputInvoiceTelegram) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "purpose":
+			entity.Purpose, err = decodeTelegramPaymentPurpose(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputInvoiceTelegram)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *InputInvoiceTelegram) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("purpose", entity.Purpose); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The media is hidden until the invoice is paid
+type PaidMediaPreview struct {
+	meta
+	// Media width; 0 if unknown
+	Width int32 `json:"width"`
+	// Media height; 0 if unknown
+	Height int32 `json:"height"`
+	// Media duration, in seconds; 0 if unknown
+	Duration int32 `json:"duration"`
+	// Media minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+}
+
+func (*PaidMediaPreview) GetType() string {
+	return TypePaidMedia
+}
+
+func (*PaidMediaPreview) GetConstructor() string {
+	return ConstructorPaidMediaPreview
+}
+
+func (*PaidMediaPreview) PaidMediaConstructor() string {
+	return ConstructorPaidMediaPreview
+}
+
+func (entity *PaidMediaPreview) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidMediaPreview) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidMediaPreview) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidMediaPreview"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidMediaPreview) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaidMediaPreview)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The media is a photo
+type PaidMediaPhoto struct {
+	meta
+	// The photo
+	Photo *Photo `json:"photo"`
+}
+
+func (*PaidMediaPhoto) GetType() string {
+	return TypePaidMedia
+}
+
+func (*PaidMediaPhoto) GetConstructor() string {
+	return ConstructorPaidMediaPhoto
+}
+
+func (*PaidMediaPhoto) PaidMediaConstructor() string {
+	return ConstructorPaidMediaPhoto
+}
+
+func (entity *PaidMediaPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidMediaPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidMediaPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidMediaPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidMediaPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaidMediaPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The media is a video
+type PaidMediaVideo struct {
+	meta
+	// The video
+	Video *Video `json:"video"`
+	// Cover of the video; may be null if none
+	Cover *Photo `json:"cover,omitempty"`
+	// Timestamp from which the video playing must start, in seconds
+	StartTimestamp int32 `json:"start_timestamp"`
+}
+
+func (*PaidMediaVideo) GetType() string {
+	return TypePaidMedia
+}
+
+func (*PaidMediaVideo) GetConstructor() string {
+	return ConstructorPaidMediaVideo
+}
+
+func (*PaidMediaVideo) PaidMediaConstructor() string {
+	return ConstructorPaidMediaVideo
+}
+
+func (entity *PaidMediaVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidMediaVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidMediaVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidMediaVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	if entity.Cover != nil {
+		encoder.writeRaw(`,"cover":`)
+		entity.Cover.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"start_timestamp":`)
+	encoder.writeInt32(entity.StartTimestamp)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidMediaVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeEntity[Video](decoder)
+
+		case "cover":
+			entity.Cover, err = decodeEntity[Photo](decoder)
+
+		case "start_timestamp":
+			entity.StartTimestamp, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPaidMediaVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The media is unsupported
+type PaidMediaUnsupported struct {
+	meta
+}
+
+func (*PaidMediaUnsupported) GetType() string {
+	return TypePaidMedia
+}
+
+func (*PaidMediaUnsupported) GetConstructor() string {
+	return ConstructorPaidMediaUnsupported
+}
+
+func (*PaidMediaUnsupported) PaidMediaConstructor() string {
+	return ConstructorPaidMediaUnsupported
+}
+
+func (entity *PaidMediaUnsupported) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PaidMediaUnsupported) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PaidMediaUnsupported) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"paidMediaUnsupported"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PaidMediaUnsupported) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPaidMediaUnsupported)
+	})
+}
+
+// Describes parameters of a giveaway
+type GiveawayParameters struct {
+	meta
+	// Identifier of the supergroup or channel chat, which will be automatically boosted by the winners of the giveaway for duration of the Telegram Premium subscription, or for the specified time. If the chat is a channel, then can_post_messages right is required in the channel, otherwise, the user must be an administrator in the supergroup
+	BoostedChatId int64 `json:"boosted_chat_id"`
+	// Identifiers of other supergroup or channel chats that must be subscribed by the users to be eligible for the giveaway. There can be up to getOption("giveaway_additional_chat_count_max") additional chats
+	AdditionalChatIds []int64 `json:"additional_chat_ids"`
+	// Point in time (Unix timestamp) when the giveaway is expected to be performed; must be 60-getOption("giveaway_duration_max") seconds in the future in scheduled giveaways
+	WinnersSelectionDate int32 `json:"winners_selection_date"`
+	// True, if only new members of the chats will be eligible for the giveaway
+	OnlyNewMembers bool `json:"only_new_members"`
+	// True, if the list of winners of the giveaway will be available to everyone
+	HasPublicWinners bool `json:"has_public_winners"`
+	// The list of two-letter ISO 3166-1 alpha-2 codes of countries, users from which will be eligible for the giveaway. If empty, then all users can participate in the giveaway. There can be up to getOption("giveaway_country_count_max") chosen countries. Users with phone number that was bought at https://fragment.com can participate in any giveaway and the country code "FT" must not be specified in the list
+	CountryCodes []string `json:"country_codes"`
+	// Additional description of the giveaway prize; 0-128 characters
+	PrizeDescription string `json:"prize_description"`
+}
+
+func (*GiveawayParameters) GetType() string {
+	return TypeGiveawayParameters
+}
+
+func (*GiveawayParameters) GetConstructor() string {
+	return ConstructorGiveawayParameters
+}
+
+func (entity *GiveawayParameters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *GiveawayParameters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *GiveawayParameters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"giveawayParameters"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"boosted_chat_id":`)
+	encoder.writeInt64(entity.BoostedChatId)
+	encoder.writeRaw(`,"additional_chat_ids":`)
+	writeList(encoder, entity.AdditionalChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"winners_selection_date":`)
+	encoder.writeInt32(entity.WinnersSelectionDate)
+	encoder.writeRaw(`,"only_new_members":`)
+	encoder.writeBool(entity.OnlyNewMembers)
+	encoder.writeRaw(`,"has_public_winners":`)
+	encoder.writeBool(entity.HasPublicWinners)
+	encoder.writeRaw(`,"country_codes":`)
+	writeList(encoder, entity.CountryCodes, (*jsonEncoder).writeString)
+	encoder.writeRaw(`,"prize_description":`)
+	encoder.writeString(entity.PrizeDescription)
+	encoder.writeRaw("}")
+}
+
+func (entity *GiveawayParameters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "boosted_chat_id":
+			entity.BoostedChatId, err = decoder.readInt64()
+
+		case "additional_chat_ids":
+			entity.AdditionalChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "winners_selection_date":
+			entity.WinnersSelectionDate, err = decoder.readInt32()
+
+		case "only_new_members":
+			entity.OnlyNewMembers, err = decoder.readBool()
+
+		case "has_public_winners":
+			entity.HasPublicWinners, err = decoder.readBool()
+
+		case "country_codes":
+			entity.CountryCodes, err = readList(decoder, (*jsonDecoder).readString)
+
+		case "prize_description":
+			entity.PrizeDescription, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorGiveawayParameters)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *GiveawayParameters) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("prize_description", entity.PrizeDescription, 0, 128); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The media is a photo. The photo must be at most 10 MB in size. The photo's width and height must not exceed 10000 in total. Width and height ratio must be at most 20
+type InputPaidMediaTypePhoto struct {
+	meta
+}
+
+func (*InputPaidMediaTypePhoto) GetType() string {
+	return TypeInputPaidMediaType
+}
+
+func (*InputPaidMediaTypePhoto) GetConstructor() string {
+	return ConstructorInputPaidMediaTypePhoto
+}
+
+func (*InputPaidMediaTypePhoto) InputPaidMediaTypeConstructor() string {
+	return ConstructorInputPaidMediaTypePhoto
+}
+
+func (entity *InputPaidMediaTypePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPaidMediaTypePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPaidMediaTypePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPaidMediaTypePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPaidMediaTypePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorInputPaidMediaTypePhoto)
+	})
+}
+
+// The media is a video
+type InputPaidMediaTypeVideo struct {
+	meta
+	// Cover of the video; pass null to skip cover uploading
+	Cover InputFile `json:"cover,omitempty"`
+	// Timestamp from which the video playing must start, in seconds
+	StartTimestamp int32 `json:"start_timestamp"`
+	// Duration of the video, in seconds
+	Duration int32 `json:"duration"`
+	// True, if the video is expected to be streamed
+	SupportsStreaming bool `json:"supports_streaming"`
+}
+
+func (*InputPaidMediaTypeVideo) GetType() string {
+	return TypeInputPaidMediaType
+}
+
+func (*InputPaidMediaTypeVideo) GetConstructor() string {
+	return ConstructorInputPaidMediaTypeVideo
+}
+
+func (*InputPaidMediaTypeVideo) InputPaidMediaTypeConstructor() string {
+	return ConstructorInputPaidMediaTypeVideo
+}
+
+func (entity *InputPaidMediaTypeVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPaidMediaTypeVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPaidMediaTypeVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPaidMediaTypeVideo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Cover != nil {
+		encoder.writeRaw(`,"cover":`)
+		encoder.writeType(entity.Cover)
+	}
+	encoder.writeRaw(`,"start_timestamp":`)
+	encoder.writeInt32(entity.StartTimestamp)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeInt32(entity.Duration)
+	encoder.writeRaw(`,"supports_streaming":`)
+	encoder.writeBool(entity.SupportsStreaming)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPaidMediaTypeVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "cover":
+			entity.Cover, err = decodeInputFile(decoder)
+
+		case "start_timestamp":
+			entity.StartTimestamp, err = decoder.readInt32()
+
+		case "duration":
+			entity.Duration, err = decoder.readInt32()
+
+		case "supports_streaming":
+			entity.SupportsStreaming, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPaidMediaTypeVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a paid media to be sent
+type InputPaidMedia struct {
+	meta
+	// Type of the media
+	Type InputPaidMediaType `json:"type"`
+	// Photo or video to be sent
+	Media InputFile `json:"media"`
+	// Media thumbnail; pass null to skip thumbnail uploading
+	Thumbnail *InputThumbnail `json:"thumbnail,omitempty"`
+	// File identifiers of the stickers added to the media, if applicable
+	AddedStickerFileIds []int32 `json:"added_sticker_file_ids"`
+	// Media width
+	Width int32 `json:"width"`
+	// Media height
+	Height int32 `json:"height"`
+}
+
+func (*InputPaidMedia) GetType() string {
+	return TypeInputPaidMedia
+}
+
+func (*InputPaidMedia) GetConstructor() string {
+	return ConstructorInputPaidMedia
+}
+
+func (entity *InputPaidMedia) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputPaidMedia) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputPaidMedia) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputPaidMedia"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"media":`)
+	encoder.writeType(entity.Media)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"added_sticker_file_ids":`)
+	writeList(encoder, entity.AddedStickerFileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputPaidMedia) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeInputPaidMediaType(decoder)
+
+		case "media":
+			entity.Media, err = decodeInputFile(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[InputThumbnail](decoder)
+
+		case "added_sticker_file_ids":
+			entity.AddedStickerFileIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputPaidMedia)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of features available on a specific chat boost level
+type ChatBoostLevelFeatures struct {
+	meta
+	// Target chat boost level
+	Level int32 `json:"level"`
+	// Number of stories that the chat can publish daily
+	StoryPerDayCount int32 `json:"story_per_day_count"`
+	// Number of custom emoji reactions that can be added to the list of available reactions
+	CustomEmojiReactionCount int32 `json:"custom_emoji_reaction_count"`
+	// Number of custom colors for chat title
+	TitleColorCount int32 `json:"title_color_count"`
+	// Number of custom colors for profile photo background
+	ProfileAccentColorCount int32 `json:"profile_accent_color_count"`
+	// True, if custom emoji for profile background can be set
+	CanSetProfileBackgroundCustomEmoji bool `json:"can_set_profile_background_custom_emoji"`
+	// Number of custom colors for background of empty chat photo, replies to messages and link previews
+	AccentColorCount int32 `json:"accent_color_count"`
+	// True, if custom emoji for reply header and link preview background can be set
+	CanSetBackgroundCustomEmoji bool `json:"can_set_background_custom_emoji"`
+	// True, if emoji status can be set
+	CanSetEmojiStatus bool `json:"can_set_emoji_status"`
+	// Number of chat theme backgrounds that can be set as chat background
+	ChatThemeBackgroundCount int32 `json:"chat_theme_background_count"`
+	// True, if custom background can be set in the chat for all users
+	CanSetCustomBackground bool `json:"can_set_custom_background"`
+	// True, if custom emoji sticker set can be set for the chat
+	CanSetCustomEmojiStickerSet bool `json:"can_set_custom_emoji_sticker_set"`
+	// True, if speech recognition can be used for video note and voice note messages by all users
+	CanRecognizeSpeech bool `json:"can_recognize_speech"`
+	// True, if sponsored messages can be disabled in the chat
+	CanDisableSponsoredMessages bool `json:"can_disable_sponsored_messages"`
+}
+
+func (*ChatBoostLevelFeatures) GetType() string {
+	return TypeChatBoostLevelFeatures
+}
+
+func (*ChatBoostLevelFeatures) GetConstructor() string {
+	return ConstructorChatBoostLevelFeatures
+}
+
+func (entity *ChatBoostLevelFeatures) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostLevelFeatures) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostLevelFeatures) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostLevelFeatures"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"level":`)
+	encoder.writeInt32(entity.Level)
+	encoder.writeRaw(`,"story_per_day_count":`)
+	encoder.writeInt32(entity.StoryPerDayCount)
+	encoder.writeRaw(`,"custom_emoji_reaction_count":`)
+	encoder.writeInt32(entity.CustomEmojiReactionCount)
+	encoder.writeRaw(`,"title_color_count":`)
+	encoder.writeInt32(entity.TitleColorCount)
+	encoder.writeRaw(`,"profile_accent_color_count":`)
+	encoder.writeInt32(entity.ProfileAccentColorCount)
+	encoder.writeRaw(`,"can_set_profile_background_custom_emoji":`)
+	encoder.writeBool(entity.CanSetProfileBackgroundCustomEmoji)
+	encoder.writeRaw(`,"accent_color_count":`)
+	encoder.writeInt32(entity.AccentColorCount)
+	encoder.writeRaw(`,"can_set_background_custom_emoji":`)
+	encoder.writeBool(entity.CanSetBackgroundCustomEmoji)
+	encoder.writeRaw(`,"can_set_emoji_status":`)
+	encoder.writeBool(entity.CanSetEmojiStatus)
+	encoder.writeRaw(`,"chat_theme_background_count":`)
+	encoder.writeInt32(entity.ChatThemeBackgroundCount)
+	encoder.writeRaw(`,"can_set_custom_background":`)
+	encoder.writeBool(entity.CanSetCustomBackground)
+	encoder.writeRaw(`,"can_set_custom_emoji_sticker_set":`)
+	encoder.writeBool(entity.CanSetCustomEmojiStickerSet)
+	encoder.writeRaw(`,"can_recognize_speech":`)
+	encoder.writeBool(entity.CanRecognizeSpeech)
+	encoder.writeRaw(`,"can_disable_sponsored_messages":`)
+	encoder.writeBool(entity.CanDisableSponsoredMessages)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostLevelFeatures) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "level":
+			entity.Level, err = decoder.readInt32()
+
+		case "story_per_day_count":
+			entity.StoryPerDayCount, err = decoder.readInt32()
+
+		case "custom_emoji_reaction_count":
+			entity.CustomEmojiReactionCount, err = decoder.readInt32()
+
+		case "title_color_count":
+			entity.TitleColorCount, err = decoder.readInt32()
+
+		case "profile_accent_color_count":
+			entity.ProfileAccentColorCount, err = decoder.readInt32()
+
+		case "can_set_profile_background_custom_emoji":
+			entity.CanSetProfileBackgroundCustomEmoji, err = decoder.readBool()
+
+		case "accent_color_count":
+			entity.AccentColorCount, err = decoder.readInt32()
+
+		case "can_set_background_custom_emoji":
+			entity.CanSetBackgroundCustomEmoji, err = decoder.readBool()
+
+		case "can_set_emoji_status":
+			entity.CanSetEmojiStatus, err = decoder.readBool()
+
+		case "chat_theme_background_count":
+			entity.ChatThemeBackgroundCount, err = decoder.readInt32()
+
+		case "can_set_custom_background":
+			entity.CanSetCustomBackground, err = decoder.readBool()
+
+		case "can_set_custom_emoji_sticker_set":
+			entity.CanSetCustomEmojiStickerSet, err = decoder.readBool()
+
+		case "can_recognize_speech":
+			entity.CanRecognizeSpeech, err = decoder.readBool()
+
+		case "can_disable_sponsored_messages":
+			entity.CanDisableSponsoredMessages, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostLevelFeatures)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of features available on the first chat boost levels
+type ChatBoostFeatures struct {
+	meta
+	// The list of features
+	Features []*ChatBoostLevelFeatures `json:"features"`
+	// The minimum boost level required to set custom emoji for profile background
+	MinProfileBackgroundCustomEmojiBoostLevel int32 `json:"min_profile_background_custom_emoji_boost_level"`
+	// The minimum boost level required to set custom emoji for reply header and link preview background; for channel chats only
+	MinBackgroundCustomEmojiBoostLevel int32 `json:"min_background_custom_emoji_boost_level"`
+	// The minimum boost level required to set emoji status
+	MinEmojiStatusBoostLevel int32 `json:"min_emoji_status_boost_level"`
+	// The minimum boost level required to set a chat theme background as chat background
+	MinChatThemeBackgroundBoostLevel int32 `json:"min_chat_theme_background_boost_level"`
+	// The minimum boost level required to set custom chat background
+	MinCustomBackgroundBoostLevel int32 `json:"min_custom_background_boost_level"`
+	// The minimum boost level required to set custom emoji sticker set for the chat; for supergroup chats only
+	MinCustomEmojiStickerSetBoostLevel int32 `json:"min_custom_emoji_sticker_set_boost_level"`
+	// The minimum boost level allowing to recognize speech in video note and voice note messages for non-Premium users; for supergroup chats only
+	MinSpeechRecognitionBoostLevel int32 `json:"min_speech_recognition_boost_level"`
+	// The minimum boost level allowing to disable sponsored messages in the chat; for channel chats only
+	MinSponsoredMessageDisableBoostLevel int32 `json:"min_sponsored_message_disable_boost_level"`
+}
+
+func (*ChatBoostFeatures) GetType() string {
+	return TypeChatBoostFeatures
+}
+
+func (*ChatBoostFeatures) GetConstructor() string {
+	return ConstructorChatBoostFeatures
+}
+
+func (entity *ChatBoostFeatures) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostFeatures) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostFeatures) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostFeatures"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"features":`)
+	writeList(encoder, entity.Features, writeTypeValue[*ChatBoostLevelFeatures])
+	encoder.writeRaw(`,"min_profile_background_custom_emoji_boost_level":`)
+	encoder.writeInt32(entity.MinProfileBackgroundCustomEmojiBoostLevel)
+	encoder.writeRaw(`,"min_background_custom_emoji_boost_level":`)
+	encoder.writeInt32(entity.MinBackgroundCustomEmojiBoostLevel)
+	encoder.writeRaw(`,"min_emoji_status_boost_level":`)
+	encoder.writeInt32(entity.MinEmojiStatusBoostLevel)
+	encoder.writeRaw(`,"min_chat_theme_background_boost_level":`)
+	encoder.writeInt32(entity.MinChatThemeBackgroundBoostLevel)
+	encoder.writeRaw(`,"min_custom_background_boost_level":`)
+	encoder.writeInt32(entity.MinCustomBackgroundBoostLevel)
+	encoder.writeRaw(`,"min_custom_emoji_sticker_set_boost_level":`)
+	encoder.writeInt32(entity.MinCustomEmojiStickerSetBoostLevel)
+	encoder.writeRaw(`,"min_speech_recognition_boost_level":`)
+	encoder.writeInt32(entity.MinSpeechRecognitionBoostLevel)
+	encoder.writeRaw(`,"min_sponsored_message_disable_boost_level":`)
+	encoder.writeInt32(entity.MinSponsoredMessageDisableBoostLevel)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostFeatures) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "features":
+			entity.Features, err = readList(decoder, decodeEntity[ChatBoostLevelFeatures])
+
+		case "min_profile_background_custom_emoji_boost_level":
+			entity.MinProfileBackgroundCustomEmojiBoostLevel, err = decoder.readInt32()
+
+		case "min_background_custom_emoji_boost_level":
+			entity.MinBackgroundCustomEmojiBoostLevel, err = decoder.readInt32()
+
+		case "min_emoji_status_boost_level":
+			entity.MinEmojiStatusBoostLevel, err = decoder.readInt32()
+
+		case "min_chat_theme_background_boost_level":
+			entity.MinChatThemeBackgroundBoostLevel, err = decoder.readInt32()
+
+		case "min_custom_background_boost_level":
+			entity.MinCustomBackgroundBoostLevel, err = decoder.readInt32()
+
+		case "min_custom_emoji_sticker_set_boost_level":
+			entity.MinCustomEmojiStickerSetBoostLevel, err = decoder.readInt32()
+
+		case "min_speech_recognition_boost_level":
+			entity.MinSpeechRecognitionBoostLevel, err = decoder.readInt32()
+
+		case "min_sponsored_message_disable_boost_level":
+			entity.MinSponsoredMessageDisableBoostLevel, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostFeatures)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat created a Telegram Premium gift code for a user
+type ChatBoostSourceGiftCode struct {
+	meta
+	// Identifier of a user, for which the gift code was created
+	UserId int64 `json:"user_id"`
+	// The created Telegram Premium gift code, which is known only if this is a gift code for the current user, or it has already been claimed
+	GiftCode string `json:"gift_code"`
+}
+
+func (*ChatBoostSourceGiftCode) GetType() string {
+	return TypeChatBoostSource
+}
+
+func (*ChatBoostSourceGiftCode) GetConstructor() string {
+	return ConstructorChatBoostSourceGiftCode
+}
+
+func (*ChatBoostSourceGiftCode) ChatBoostSourceConstructor() string {
+	return ConstructorChatBoostSourceGiftCode
+}
+
+func (entity *ChatBoostSourceGiftCode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostSourceGiftCode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostSourceGiftCode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostSourceGiftCode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"gift_code":`)
+	encoder.writeString(entity.GiftCode)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostSourceGiftCode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "gift_code":
+			entity.GiftCode, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostSourceGiftCode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat created a giveaway
+type ChatBoostSourceGiveaway struct {
+	meta
+	// Identifier of a user that won in the giveaway; 0 if none
+	UserId int64 `json:"user_id"`
+	// The created Telegram Premium gift code if it was used by the user or can be claimed by the current user; an empty string otherwise; for Telegram Premium giveways only
+	GiftCode string `json:"gift_code"`
+	// Number of Telegram Stars distributed among winners of the giveaway
+	StarCount int64 `json:"star_count"`
+	// Identifier of the corresponding giveaway message; can be an identifier of a deleted message
+	GiveawayMessageId int64 `json:"giveaway_message_id"`
+	// True, if the winner for the corresponding giveaway prize wasn't chosen, because there were not enough participants
+	IsUnclaimed bool `json:"is_unclaimed"`
+}
+
+func (*ChatBoostSourceGiveaway) GetType() string {
+	return TypeChatBoostSource
+}
+
+func (*ChatBoostSourceGiveaway) GetConstructor() string {
+	return ConstructorChatBoostSourceGiveaway
+}
+
+func (*ChatBoostSourceGiveaway) ChatBoostSourceConstructor() string {
+	return ConstructorChatBoostSourceGiveaway
+}
+
+func (entity *ChatBoostSourceGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostSourceGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostSourceGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostSourceGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"gift_code":`)
+	encoder.writeString(entity.GiftCode)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw(`,"giveaway_message_id":`)
+	encoder.writeInt64(entity.GiveawayMessageId)
+	encoder.writeRaw(`,"is_unclaimed":`)
+	encoder.writeBool(entity.IsUnclaimed)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostSourceGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "gift_code":
+			entity.GiftCode, err = decoder.readString()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		case "giveaway_message_id":
+			entity.GiveawayMessageId, err = decoder.readInt64()
+
+		case "is_unclaimed":
+			entity.IsUnclaimed, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostSourceGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A user with Telegram Premium subscription or gifted Telegram Premium boosted the chat
+type ChatBoostSourcePremium struct {
+	meta
+	// Identifier of the user
+	UserId int64 `json:"user_id"`
+}
+
+func (*ChatBoostSourcePremium) GetType() string {
+	return TypeChatBoostSource
+}
+
+func (*ChatBoostSourcePremium) GetConstructor() string {
+	return ConstructorChatBoostSourcePremium
+}
+
+func (*ChatBoostSourcePremium) ChatBoostSourceConstructor() string {
+	return ConstructorChatBoostSourcePremium
+}
+
+func (entity *ChatBoostSourcePremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostSourcePremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostSourcePremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostSourcePremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostSourcePremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostSourcePremium)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a prepaid giveaway
+type PrepaidGiveaway struct {
+	meta
+	// Unique identifier of the prepaid giveaway
+	Id JsonInt64 `json:"id"`
+	// Number of users which will receive giveaway prize
+	WinnerCount int32 `json:"winner_count"`
+	// Prize of the giveaway
+	Prize GiveawayPrize `json:"prize"`
+	// The number of boosts received by the chat from the giveaway; for Telegram Star giveaways only
+	BoostCount int32 `json:"boost_count"`
+	// Point in time (Unix timestamp) when the giveaway was paid
+	PaymentDate int32 `json:"payment_date"`
+}
+
+func (*PrepaidGiveaway) GetType() string {
+	return TypePrepaidGiveaway
+}
+
+func (*PrepaidGiveaway) GetConstructor() string {
+	return ConstructorPrepaidGiveaway
+}
+
+func (entity *PrepaidGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PrepaidGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PrepaidGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"prepaidGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"prize":`)
+	encoder.writeType(entity.Prize)
+	encoder.writeRaw(`,"boost_count":`)
+	encoder.writeInt32(entity.BoostCount)
+	encoder.writeRaw(`,"payment_date":`)
+	encoder.writeInt32(entity.PaymentDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *PrepaidGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "prize":
+			entity.Prize, err = decodeGiveawayPrize(decoder)
+
+		case "boost_count":
+			entity.BoostCount, err = decoder.readInt32()
+
+		case "payment_date":
+			entity.PaymentDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPrepaidGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes current boost status of a chat
+type ChatBoostStatus struct {
+	meta
+	// An HTTP URL, which can be used to boost the chat
+	BoostUrl string `json:"boost_url"`
+	// Identifiers of boost slots of the current user applied to the chat
+	AppliedSlotIds []int32 `json:"applied_slot_ids"`
+	// Current boost level of the chat
+	Level int32 `json:"level"`
+	// The number of boosts received by the chat from created Telegram Premium gift codes and giveaways; always 0 if the current user isn't an administrator in the chat
+	GiftCodeBoostCount int32 `json:"gift_code_boost_count"`
+	// The number of boosts received by the chat
+	BoostCount int32 `json:"boost_count"`
+	// The number of boosts added to reach the current level
+	CurrentLevelBoostCount int32 `json:"current_level_boost_count"`
+	// The number of boosts needed to reach the next level; 0 if the next level isn't available
+	NextLevelBoostCount int32 `json:"next_level_boost_count"`
+	// Approximate number of Telegram Premium subscribers joined the chat; always 0 if the current user isn't an administrator in the chat
+	PremiumMemberCount int32 `json:"premium_member_count"`
+	// A percentage of Telegram Premium subscribers joined the chat; always 0 if the current user isn't an administrator in the chat
+	PremiumMemberPercentage float64 `json:"premium_member_percentage"`
+	// The list of prepaid giveaways available for the chat; only for chat administrators
+	PrepaidGiveaways []*PrepaidGiveaway `json:"prepaid_giveaways"`
+}
+
+func (*ChatBoostStatus) GetType() string {
+	return TypeChatBoostStatus
+}
+
+func (*ChatBoostStatus) GetConstructor() string {
+	return ConstructorChatBoostStatus
+}
+
+func (entity *ChatBoostStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"boost_url":`)
+	encoder.writeString(entity.BoostUrl)
+	encoder.writeRaw(`,"applied_slot_ids":`)
+	writeList(encoder, entity.AppliedSlotIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"level":`)
+	encoder.writeInt32(entity.Level)
+	encoder.writeRaw(`,"gift_code_boost_count":`)
+	encoder.writeInt32(entity.GiftCodeBoostCount)
+	encoder.writeRaw(`,"boost_count":`)
+	encoder.writeInt32(entity.BoostCount)
+	encoder.writeRaw(`,"current_level_boost_count":`)
+	encoder.writeInt32(entity.CurrentLevelBoostCount)
+	encoder.writeRaw(`,"next_level_boost_count":`)
+	encoder.writeInt32(entity.NextLevelBoostCount)
+	encoder.writeRaw(`,"premium_member_count":`)
+	encoder.writeInt32(entity.PremiumMemberCount)
+	encoder.writeRaw(`,"premium_member_percentage":`)
+	encoder.writeFloat64(entity.PremiumMemberPercentage)
+	encoder.writeRaw(`,"prepaid_giveaways":`)
+	writeList(encoder, entity.PrepaidGiveaways, writeTypeValue[*PrepaidGiveaway])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "boost_url":
+			entity.BoostUrl, err = decoder.readString()
+
+		case "applied_slot_ids":
+			entity.AppliedSlotIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "level":
+			entity.Level, err = decoder.readInt32()
+
+		case "gift_code_boost_count":
+			entity.GiftCodeBoostCount, err = decoder.readInt32()
+
+		case "boost_count":
+			entity.BoostCount, err = decoder.readInt32()
+
+		case "current_level_boost_count":
+			entity.CurrentLevelBoostCount, err = decoder.readInt32()
+
+		case "next_level_boost_count":
+			entity.NextLevelBoostCount, err = decoder.readInt32()
+
+		case "premium_member_count":
+			entity.PremiumMemberCount, err = decoder.readInt32()
+
+		case "premium_member_percentage":
+			entity.PremiumMemberPercentage, err = decoder.readFloat64()
+
+		case "prepaid_giveaways":
+			entity.PrepaidGiveaways, err = readList(decoder, decodeEntity[PrepaidGiveaway])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostStatus)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a boost applied to a chat
+type ChatBoost struct {
+	meta
+	// Unique identifier of the boost
+	Id string `json:"id"`
+	// The number of identical boosts applied
+	Count int32 `json:"count"`
+	// Source of the boost
+	Source ChatBoostSource `json:"source"`
+	// Point in time (Unix timestamp) when the chat was boosted
+	StartDate int32 `json:"start_date"`
+	// Point in time (Unix timestamp) when the boost will expire
+	ExpirationDate int32 `json:"expiration_date"`
+}
+
+func (*ChatBoost) GetType() string {
+	return TypeChatBoost
+}
+
+func (*ChatBoost) GetConstructor() string {
+	return ConstructorChatBoost
+}
+
+func (entity *ChatBoost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoost"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeString(entity.Id)
+	encoder.writeRaw(`,"count":`)
+	encoder.writeInt32(entity.Count)
+	encoder.writeRaw(`,"source":`)
+	encoder.writeType(entity.Source)
+	encoder.writeRaw(`,"start_date":`)
+	encoder.writeInt32(entity.StartDate)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(entity.ExpirationDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readString()
+
+		case "count":
+			entity.Count, err = decoder.readInt32()
+
+		case "source":
+			entity.Source, err = decodeChatBoostSource(decoder)
+
+		case "start_date":
+			entity.StartDate, err = decoder.readInt32()
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of boosts applied to a chat
+type FoundChatBoosts struct {
+	meta
+	// Total number of boosts applied to the chat
+	TotalCount int32 `json:"total_count"`
+	// List of boosts
+	Boosts []*ChatBoost `json:"boosts"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*FoundChatBoosts) GetType() string {
+	return TypeFoundChatBoosts
+}
+
+func (*FoundChatBoosts) GetConstructor() string {
+	return ConstructorFoundChatBoosts
+}
+
+func (entity *FoundChatBoosts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundChatBoosts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundChatBoosts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundChatBoosts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"boosts":`)
+	writeList(encoder, entity.Boosts, writeTypeValue[*ChatBoost])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundChatBoosts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "boosts":
+			entity.Boosts, err = readList(decoder, decodeEntity[ChatBoost])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundChatBoosts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a slot for chat boost
+type ChatBoostSlot struct {
+	meta
+	// Unique identifier of the slot
+	SlotId int32 `json:"slot_id"`
+	// Identifier of the currently boosted chat; 0 if none
+	CurrentlyBoostedChatId int64 `json:"currently_boosted_chat_id"`
+	// Point in time (Unix timestamp) when the chat was boosted; 0 if none
+	StartDate int32 `json:"start_date"`
+	// Point in time (Unix timestamp) when the boost will expire
+	ExpirationDate int32 `json:"expiration_date"`
+	// Point in time (Unix timestamp) after which the boost can be used for another chat
+	CooldownUntilDate int32 `json:"cooldown_until_date"`
+}
+
+func (*ChatBoostSlot) GetType() string {
+	return TypeChatBoostSlot
+}
+
+func (*ChatBoostSlot) GetConstructor() string {
+	return ConstructorChatBoostSlot
+}
+
+func (entity *ChatBoostSlot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostSlot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostSlot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostSlot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"slot_id":`)
+	encoder.writeInt32(entity.SlotId)
+	encoder.writeRaw(`,"currently_boosted_chat_id":`)
+	encoder.writeInt64(entity.CurrentlyBoostedChatId)
+	encoder.writeRaw(`,"start_date":`)
+	encoder.writeInt32(entity.StartDate)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(entity.ExpirationDate)
+	encoder.writeRaw(`,"cooldown_until_date":`)
+	encoder.writeInt32(entity.CooldownUntilDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostSlot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "slot_id":
+			entity.SlotId, err = decoder.readInt32()
+
+		case "currently_boosted_chat_id":
+			entity.CurrentlyBoostedChatId, err = decoder.readInt64()
+
+		case "start_date":
+			entity.StartDate, err = decoder.readInt32()
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decoder.readInt32()
+
+		case "cooldown_until_date":
+			entity.CooldownUntilDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostSlot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of chat boost slots
+type ChatBoostSlots struct {
+	meta
+	// List of boost slots
+	Slots []*ChatBoostSlot `json:"slots"`
+}
+
+func (*ChatBoostSlots) GetType() string {
+	return TypeChatBoostSlots
+}
+
+func (*ChatBoostSlots) GetConstructor() string {
+	return ConstructorChatBoostSlots
+}
+
+func (entity *ChatBoostSlots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostSlots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostSlots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostSlots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"slots":`)
+	writeList(encoder, entity.Slots, writeTypeValue[*ChatBoostSlot])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostSlots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "slots":
+			entity.Slots, err = readList(decoder, decodeEntity[ChatBoostSlot])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostSlots)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The maximum number of joined supergroups and channels
+type PremiumLimitTypeSupergroupCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeSupergroupCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeSupergroupCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeSupergroupCount
+}
+
+func (*PremiumLimitTypeSupergroupCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeSupergroupCount
+}
+
+func (entity *PremiumLimitTypeSupergroupCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeSupergroupCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeSupergroupCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeSupergroupCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeSupergroupCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeSupergroupCount)
+	})
+}
+
+// The maximum number of pinned chats in the main chat list
+type PremiumLimitTypePinnedChatCount struct {
+	meta
+}
+
+func (*PremiumLimitTypePinnedChatCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypePinnedChatCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypePinnedChatCount
+}
+
+func (*PremiumLimitTypePinnedChatCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypePinnedChatCount
+}
+
+func (entity *PremiumLimitTypePinnedChatCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypePinnedChatCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypePinnedChatCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypePinnedChatCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypePinnedChatCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypePinnedChatCount)
+	})
+}
+
+// The maximum number of created public chats
+type PremiumLimitTypeCreatedPublicChatCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeCreatedPublicChatCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeCreatedPublicChatCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeCreatedPublicChatCount
+}
+
+func (*PremiumLimitTypeCreatedPublicChatCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeCreatedPublicChatCount
+}
+
+func (entity *PremiumLimitTypeCreatedPublicChatCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeCreatedPublicChatCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeCreatedPublicChatCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeCreatedPublicChatCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeCreatedPublicChatCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeCreatedPublicChatCount)
+	})
+}
+
+// The maximum number of saved animations
+type PremiumLimitTypeSavedAnimationCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeSavedAnimationCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeSavedAnimationCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeSavedAnimationCount
+}
+
+func (*PremiumLimitTypeSavedAnimationCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeSavedAnimationCount
+}
+
+func (entity *PremiumLimitTypeSavedAnimationCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeSavedAnimationCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeSavedAnimationCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeSavedAnimationCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeSavedAnimationCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeSavedAnimationCount)
+	})
+}
+
+// The maximum number of favorite stickers
+type PremiumLimitTypeFavoriteStickerCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeFavoriteStickerCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeFavoriteStickerCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeFavoriteStickerCount
+}
+
+func (*PremiumLimitTypeFavoriteStickerCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeFavoriteStickerCount
+}
+
+func (entity *PremiumLimitTypeFavoriteStickerCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeFavoriteStickerCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeFavoriteStickerCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeFavoriteStickerCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeFavoriteStickerCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeFavoriteStickerCount)
+	})
+}
+
+// The maximum number of chat folders
+type PremiumLimitTypeChatFolderCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeChatFolderCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeChatFolderCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeChatFolderCount
+}
+
+func (*PremiumLimitTypeChatFolderCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeChatFolderCount
+}
+
+func (entity *PremiumLimitTypeChatFolderCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeChatFolderCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeChatFolderCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeChatFolderCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeChatFolderCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeChatFolderCount)
+	})
+}
+
+// The maximum number of pinned and always included, or always excluded chats in a chat folder
+type PremiumLimitTypeChatFolderChosenChatCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeChatFolderChosenChatCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeChatFolderChosenChatCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeChatFolderChosenChatCount
+}
+
+func (*PremiumLimitTypeChatFolderChosenChatCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeChatFolderChosenChatCount
+}
+
+func (entity *PremiumLimitTypeChatFolderChosenChatCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeChatFolderChosenChatCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeChatFolderChosenChatCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeChatFolderChosenChatCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeChatFolderChosenChatCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeChatFolderChosenChatCount)
+	})
+}
+
+// The maximum number of pinned chats in the archive chat list
+type PremiumLimitTypePinnedArchivedChatCount struct {
+	meta
+}
+
+func (*PremiumLimitTypePinnedArchivedChatCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypePinnedArchivedChatCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypePinnedArchivedChatCount
+}
+
+func (*PremiumLimitTypePinnedArchivedChatCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypePinnedArchivedChatCount
+}
+
+func (entity *PremiumLimitTypePinnedArchivedChatCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypePinnedArchivedChatCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypePinnedArchivedChatCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypePinnedArchivedChatCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypePinnedArchivedChatCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypePinnedArchivedChatCount)
+	})
+}
+
+// The maximum number of pinned Saved Messages topics
+type PremiumLimitTypePinnedSavedMessagesTopicCount struct {
+	meta
+}
+
+func (*PremiumLimitTypePinnedSavedMessagesTopicCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypePinnedSavedMessagesTopicCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypePinnedSavedMessagesTopicCount
+}
+
+func (*PremiumLimitTypePinnedSavedMessagesTopicCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypePinnedSavedMessagesTopicCount
+}
+
+func (entity *PremiumLimitTypePinnedSavedMessagesTopicCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypePinnedSavedMessagesTopicCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypePinnedSavedMessagesTopicCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypePinnedSavedMessagesTopicCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypePinnedSavedMessagesTopicCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypePinnedSavedMessagesTopicCount)
+	})
+}
+
+// The maximum length of sent media caption
+type PremiumLimitTypeCaptionLength struct {
+	meta
+}
+
+func (*PremiumLimitTypeCaptionLength) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeCaptionLength) GetConstructor() string {
+	return ConstructorPremiumLimitTypeCaptionLength
+}
+
+func (*PremiumLimitTypeCaptionLength) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeCaptionLength
+}
+
+func (entity *PremiumLimitTypeCaptionLength) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeCaptionLength) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeCaptionLength) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeCaptionLength"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeCaptionLength) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeCaptionLength)
+	})
+}
+
+// The maximum length of the user's bio
+type PremiumLimitTypeBioLength struct {
+	meta
+}
+
+func (*PremiumLimitTypeBioLength) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeBioLength) GetConstructor() string {
+	return ConstructorPremiumLimitTypeBioLength
+}
+
+func (*PremiumLimitTypeBioLength) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeBioLength
+}
+
+func (entity *PremiumLimitTypeBioLength) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeBioLength) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeBioLength) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeBioLength"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeBioLength) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeBioLength)
+	})
+}
+
+// The maximum number of invite links for a chat folder
+type PremiumLimitTypeChatFolderInviteLinkCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeChatFolderInviteLinkCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeChatFolderInviteLinkCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeChatFolderInviteLinkCount
+}
+
+func (*PremiumLimitTypeChatFolderInviteLinkCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeChatFolderInviteLinkCount
+}
+
+func (entity *PremiumLimitTypeChatFolderInviteLinkCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeChatFolderInviteLinkCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeChatFolderInviteLinkCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeChatFolderInviteLinkCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeChatFolderInviteLinkCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeChatFolderInviteLinkCount)
+	})
+}
+
+// The maximum number of added shareable chat folders
+type PremiumLimitTypeShareableChatFolderCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeShareableChatFolderCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeShareableChatFolderCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeShareableChatFolderCount
+}
+
+func (*PremiumLimitTypeShareableChatFolderCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeShareableChatFolderCount
+}
+
+func (entity *PremiumLimitTypeShareableChatFolderCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeShareableChatFolderCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeShareableChatFolderCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeShareableChatFolderCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeShareableChatFolderCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeShareableChatFolderCount)
+	})
+}
+
+// The maximum number of active stories
+type PremiumLimitTypeActiveStoryCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeActiveStoryCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeActiveStoryCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeActiveStoryCount
+}
+
+func (*PremiumLimitTypeActiveStoryCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeActiveStoryCount
+}
+
+func (entity *PremiumLimitTypeActiveStoryCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeActiveStoryCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeActiveStoryCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeActiveStoryCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeActiveStoryCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeActiveStoryCount)
+	})
+}
+
+// The maximum number of stories sent per week
+type PremiumLimitTypeWeeklySentStoryCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeWeeklySentStoryCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeWeeklySentStoryCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeWeeklySentStoryCount
+}
+
+func (*PremiumLimitTypeWeeklySentStoryCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeWeeklySentStoryCount
+}
+
+func (entity *PremiumLimitTypeWeeklySentStoryCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeWeeklySentStoryCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeWeeklySentStoryCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeWeeklySentStoryCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeWeeklySentStoryCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeWeeklySentStoryCount)
+	})
+}
+
+// The maximum number of stories sent per month
+type PremiumLimitTypeMonthlySentStoryCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeMonthlySentStoryCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeMonthlySentStoryCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeMonthlySentStoryCount
+}
+
+func (*PremiumLimitTypeMonthlySentStoryCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeMonthlySentStoryCount
+}
+
+func (entity *PremiumLimitTypeMonthlySentStoryCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeMonthlySentStoryCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeMonthlySentStoryCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeMonthlySentStoryCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeMonthlySentStoryCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeMonthlySentStoryCount)
+	})
+}
+
+// The maximum length of captions of sent stories
+type PremiumLimitTypeStoryCaptionLength struct {
+	meta
+}
+
+func (*PremiumLimitTypeStoryCaptionLength) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeStoryCaptionLength) GetConstructor() string {
+	return ConstructorPremiumLimitTypeStoryCaptionLength
+}
+
+func (*PremiumLimitTypeStoryCaptionLength) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeStoryCaptionLength
+}
+
+func (entity *PremiumLimitTypeStoryCaptionLength) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeStoryCaptionLength) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeStoryCaptionLength) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeStoryCaptionLength"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeStoryCaptionLength) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeStoryCaptionLength)
+	})
+}
+
+// The maximum number of suggested reaction areas on a story
+type PremiumLimitTypeStorySuggestedReactionAreaCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeStorySuggestedReactionAreaCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeStorySuggestedReactionAreaCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeStorySuggestedReactionAreaCount
+}
+
+func (*PremiumLimitTypeStorySuggestedReactionAreaCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeStorySuggestedReactionAreaCount
+}
+
+func (entity *PremiumLimitTypeStorySuggestedReactionAreaCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeStorySuggestedReactionAreaCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeStorySuggestedReactionAreaCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeStorySuggestedReactionAreaCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeStorySuggestedReactionAreaCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeStorySuggestedReactionAreaCount)
+	})
+}
+
+// The maximum number of received similar chats
+type PremiumLimitTypeSimilarChatCount struct {
+	meta
+}
+
+func (*PremiumLimitTypeSimilarChatCount) GetType() string {
+	return TypePremiumLimitType
+}
+
+func (*PremiumLimitTypeSimilarChatCount) GetConstructor() string {
+	return ConstructorPremiumLimitTypeSimilarChatCount
+}
+
+func (*PremiumLimitTypeSimilarChatCount) PremiumLimitTypeConstructor() string {
+	return ConstructorPremiumLimitTypeSimilarChatCount
+}
+
+func (entity *PremiumLimitTypeSimilarChatCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimitTypeSimilarChatCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimitTypeSimilarChatCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimitTypeSimilarChatCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimitTypeSimilarChatCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumLimitTypeSimilarChatCount)
+	})
+}
+
+// Increased limits
+type PremiumFeatureIncreasedLimits struct {
+	meta
+}
+
+func (*PremiumFeatureIncreasedLimits) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureIncreasedLimits) GetConstructor() string {
+	return ConstructorPremiumFeatureIncreasedLimits
+}
+
+func (*PremiumFeatureIncreasedLimits) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureIncreasedLimits
+}
+
+func (entity *PremiumFeatureIncreasedLimits) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureIncreasedLimits) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureIncreasedLimits) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureIncreasedLimits"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureIncreasedLimits) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureIncreasedLimits)
+	})
+}
+
+// Increased maximum upload file size
+type PremiumFeatureIncreasedUploadFileSize struct {
+	meta
+}
+
+func (*PremiumFeatureIncreasedUploadFileSize) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureIncreasedUploadFileSize) GetConstructor() string {
+	return ConstructorPremiumFeatureIncreasedUploadFileSize
+}
+
+func (*PremiumFeatureIncreasedUploadFileSize) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureIncreasedUploadFileSize
+}
+
+func (entity *PremiumFeatureIncreasedUploadFileSize) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureIncreasedUploadFileSize) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureIncreasedUploadFileSize) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureIncreasedUploadFileSize"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureIncreasedUploadFileSize) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureIncreasedUploadFileSize)
+	})
+}
+
+// Improved download speed
+type PremiumFeatureImprovedDownloadSpeed struct {
+	meta
+}
+
+func (*PremiumFeatureImprovedDownloadSpeed) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureImprovedDownloadSpeed) GetConstructor() string {
+	return ConstructorPremiumFeatureImprovedDownloadSpeed
+}
+
+func (*PremiumFeatureImprovedDownloadSpeed) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureImprovedDownloadSpeed
+}
+
+func (entity *PremiumFeatureImprovedDownloadSpeed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureImprovedDownloadSpeed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureImprovedDownloadSpeed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureImprovedDownloadSpeed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureImprovedDownloadSpeed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureImprovedDownloadSpeed)
+	})
+}
+
+// The ability to convert voice notes to text
+type PremiumFeatureVoiceRecognition struct {
+	meta
+}
+
+func (*PremiumFeatureVoiceRecognition) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureVoiceRecognition) GetConstructor() string {
+	return ConstructorPremiumFeatureVoiceRecognition
+}
+
+func (*PremiumFeatureVoiceRecognition) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureVoiceRecognition
+}
+
+func (entity *PremiumFeatureVoiceRecognition) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureVoiceRecognition) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureVoiceRecognition) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureVoiceRecognition"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureVoiceRecognition) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureVoiceRecognition)
+	})
+}
+
+// Disabled ads
+type PremiumFeatureDisabledAds struct {
+	meta
+}
+
+func (*PremiumFeatureDisabledAds) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureDisabledAds) GetConstructor() string {
+	return ConstructorPremiumFeatureDisabledAds
+}
+
+func (*PremiumFeatureDisabledAds) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureDisabledAds
+}
+
+func (entity *PremiumFeatureDisabledAds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureDisabledAds) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureDisabledAds) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureDisabledAds"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureDisabledAds) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureDisabledAds)
+	})
+}
+
+// Allowed to use more reactions
+type PremiumFeatureUniqueReactions struct {
+	meta
+}
+
+func (*PremiumFeatureUniqueReactions) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureUniqueReactions) GetConstructor() string {
+	return ConstructorPremiumFeatureUniqueReactions
+}
+
+func (*PremiumFeatureUniqueReactions) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureUniqueReactions
+}
+
+func (entity *PremiumFeatureUniqueReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureUniqueReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureUniqueReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureUniqueReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureUniqueReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureUniqueReactions)
+	})
+}
+
+// Allowed to use premium stickers with unique effects
+type PremiumFeatureUniqueStickers struct {
+	meta
+}
+
+func (*PremiumFeatureUniqueStickers) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureUniqueStickers) GetConstructor() string {
+	return ConstructorPremiumFeatureUniqueStickers
+}
+
+func (*PremiumFeatureUniqueStickers) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureUniqueStickers
+}
+
+func (entity *PremiumFeatureUniqueStickers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureUniqueStickers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureUniqueStickers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureUniqueStickers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureUniqueStickers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureUniqueStickers)
+	})
+}
+
+// Allowed to use custom emoji stickers in message texts and captions
+type PremiumFeatureCustomEmoji struct {
+	meta
+}
+
+func (*PremiumFeatureCustomEmoji) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureCustomEmoji) GetConstructor() string {
+	return ConstructorPremiumFeatureCustomEmoji
+}
+
+func (*PremiumFeatureCustomEmoji) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureCustomEmoji
+}
+
+func (entity *PremiumFeatureCustomEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureCustomEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureCustomEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureCustomEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureCustomEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureCustomEmoji)
+	})
+}
+
+// Ability to change position of the main chat list, archive and mute all new chats from non-contacts, and completely disable notifications about the user's contacts joined Telegram
+type PremiumFeatureAdvancedChatManagement struct {
+	meta
+}
+
+func (*PremiumFeatureAdvancedChatManagement) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureAdvancedChatManagement) GetConstructor() string {
+	return ConstructorPremiumFeatureAdvancedChatManagement
+}
+
+func (*PremiumFeatureAdvancedChatManagement) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureAdvancedChatManagement
+}
+
+func (entity *PremiumFeatureAdvancedChatManagement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureAdvancedChatManagement) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureAdvancedChatManagement) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureAdvancedChatManagement"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureAdvancedChatManagement) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureAdvancedChatManagement)
+	})
+}
+
+// A badge in the user's profile
+type PremiumFeatureProfileBadge struct {
+	meta
+}
+
+func (*PremiumFeatureProfileBadge) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureProfileBadge) GetConstructor() string {
+	return ConstructorPremiumFeatureProfileBadge
+}
+
+func (*PremiumFeatureProfileBadge) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureProfileBadge
+}
+
+func (entity *PremiumFeatureProfileBadge) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureProfileBadge) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureProfileBadge) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureProfileBadge"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureProfileBadge) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureProfileBadge)
+	})
+}
+
+// The ability to show an emoji status along with the user's name
+type PremiumFeatureEmojiStatus struct {
+	meta
+}
+
+func (*PremiumFeatureEmojiStatus) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureEmojiStatus) GetConstructor() string {
+	return ConstructorPremiumFeatureEmojiStatus
+}
+
+func (*PremiumFeatureEmojiStatus) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureEmojiStatus
+}
+
+func (entity *PremiumFeatureEmojiStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureEmojiStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureEmojiStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureEmojiStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureEmojiStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureEmojiStatus)
+	})
+}
+
+// Profile photo animation on message and chat screens
+type PremiumFeatureAnimatedProfilePhoto struct {
+	meta
+}
+
+func (*PremiumFeatureAnimatedProfilePhoto) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureAnimatedProfilePhoto) GetConstructor() string {
+	return ConstructorPremiumFeatureAnimatedProfilePhoto
+}
+
+func (*PremiumFeatureAnimatedProfilePhoto) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureAnimatedProfilePhoto
+}
+
+func (entity *PremiumFeatureAnimatedProfilePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureAnimatedProfilePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureAnimatedProfilePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureAnimatedProfilePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureAnimatedProfilePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureAnimatedProfilePhoto)
+	})
+}
+
+// The ability to set a custom emoji as a forum topic icon
+type PremiumFeatureForumTopicIcon struct {
+	meta
+}
+
+func (*PremiumFeatureForumTopicIcon) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureForumTopicIcon) GetConstructor() string {
+	return ConstructorPremiumFeatureForumTopicIcon
+}
+
+func (*PremiumFeatureForumTopicIcon) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureForumTopicIcon
+}
+
+func (entity *PremiumFeatureForumTopicIcon) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureForumTopicIcon) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureForumTopicIcon) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureForumTopicIcon"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureForumTopicIcon) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureForumTopicIcon)
+	})
+}
+
+// Allowed to set a premium application icons
+type PremiumFeatureAppIcons struct {
+	meta
+}
+
+func (*PremiumFeatureAppIcons) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureAppIcons) GetConstructor() string {
+	return ConstructorPremiumFeatureAppIcons
+}
+
+func (*PremiumFeatureAppIcons) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureAppIcons
+}
+
+func (entity *PremiumFeatureAppIcons) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureAppIcons) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureAppIcons) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureAppIcons"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureAppIcons) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureAppIcons)
+	})
+}
+
+// Allowed to translate chat messages real-time
+type PremiumFeatureRealTimeChatTranslation struct {
+	meta
+}
+
+func (*PremiumFeatureRealTimeChatTranslation) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureRealTimeChatTranslation) GetConstructor() string {
+	return ConstructorPremiumFeatureRealTimeChatTranslation
+}
+
+func (*PremiumFeatureRealTimeChatTranslation) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureRealTimeChatTranslation
+}
+
+func (entity *PremiumFeatureRealTimeChatTranslation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureRealTimeChatTranslation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureRealTimeChatTranslation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureRealTimeChatTranslation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureRealTimeChatTranslation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureRealTimeChatTranslation)
+	})
+}
+
+// Allowed to use many additional features for stories
+type PremiumFeatureUpgradedStories struct {
+	meta
+}
+
+func (*PremiumFeatureUpgradedStories) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureUpgradedStories) GetConstructor() string {
+	return ConstructorPremiumFeatureUpgradedStories
+}
+
+func (*PremiumFeatureUpgradedStories) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureUpgradedStories
+}
+
+func (entity *PremiumFeatureUpgradedStories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureUpgradedStories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureUpgradedStories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureUpgradedStories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureUpgradedStories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureUpgradedStories)
+	})
+}
+
+// The ability to boost chats
+type PremiumFeatureChatBoost struct {
+	meta
+}
+
+func (*PremiumFeatureChatBoost) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureChatBoost) GetConstructor() string {
+	return ConstructorPremiumFeatureChatBoost
+}
+
+func (*PremiumFeatureChatBoost) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureChatBoost
+}
+
+func (entity *PremiumFeatureChatBoost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureChatBoost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureChatBoost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureChatBoost"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureChatBoost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureChatBoost)
+	})
+}
+
+// The ability to choose accent color for replies and user profile
+type PremiumFeatureAccentColor struct {
+	meta
+}
+
+func (*PremiumFeatureAccentColor) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureAccentColor) GetConstructor() string {
+	return ConstructorPremiumFeatureAccentColor
+}
+
+func (*PremiumFeatureAccentColor) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureAccentColor
+}
+
+func (entity *PremiumFeatureAccentColor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureAccentColor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureAccentColor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureAccentColor"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureAccentColor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureAccentColor)
+	})
+}
+
+// The ability to set private chat background for both users
+type PremiumFeatureBackgroundForBoth struct {
+	meta
+}
+
+func (*PremiumFeatureBackgroundForBoth) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureBackgroundForBoth) GetConstructor() string {
+	return ConstructorPremiumFeatureBackgroundForBoth
+}
+
+func (*PremiumFeatureBackgroundForBoth) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureBackgroundForBoth
+}
+
+func (entity *PremiumFeatureBackgroundForBoth) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureBackgroundForBoth) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureBackgroundForBoth) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureBackgroundForBoth"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureBackgroundForBoth) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureBackgroundForBoth)
+	})
+}
+
+// The ability to use tags in Saved Messages
+type PremiumFeatureSavedMessagesTags struct {
+	meta
+}
+
+func (*PremiumFeatureSavedMessagesTags) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureSavedMessagesTags) GetConstructor() string {
+	return ConstructorPremiumFeatureSavedMessagesTags
+}
+
+func (*PremiumFeatureSavedMessagesTags) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureSavedMessagesTags
+}
+
+func (entity *PremiumFeatureSavedMessagesTags) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureSavedMessagesTags) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureSavedMessagesTags) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureSavedMessagesTags"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureSavedMessagesTags) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureSavedMessagesTags)
+	})
+}
+
+// The ability to disallow incoming voice and video note messages in private chats using setUserPrivacySettingRules with userPrivacySettingAllowPrivateVoiceAndVideoNoteMessages and to restrict incoming messages from non-contacts using setNewChatPrivacySettings
+type PremiumFeatureMessagePrivacy struct {
+	meta
+}
+
+func (*PremiumFeatureMessagePrivacy) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureMessagePrivacy) GetConstructor() string {
+	return ConstructorPremiumFeatureMessagePrivacy
+}
+
+func (*PremiumFeatureMessagePrivacy) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureMessagePrivacy
+}
+
+func (entity *PremiumFeatureMessagePrivacy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureMessagePrivacy) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureMessagePrivacy) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureMessagePrivacy"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureMessagePrivacy) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureMessagePrivacy)
+	})
+}
+
+// The ability to view last seen and read times of other users even they can't view last seen or read time for the current user
+type PremiumFeatureLastSeenTimes struct {
+	meta
+}
+
+func (*PremiumFeatureLastSeenTimes) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureLastSeenTimes) GetConstructor() string {
+	return ConstructorPremiumFeatureLastSeenTimes
+}
+
+func (*PremiumFeatureLastSeenTimes) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureLastSeenTimes
+}
+
+func (entity *PremiumFeatureLastSeenTimes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureLastSeenTimes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureLastSeenTimes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureLastSeenTimes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureLastSeenTimes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureLastSeenTimes)
+	})
+}
+
+// The ability to use Business features
+type PremiumFeatureBusiness struct {
+	meta
+}
+
+func (*PremiumFeatureBusiness) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureBusiness) GetConstructor() string {
+	return ConstructorPremiumFeatureBusiness
+}
+
+func (*PremiumFeatureBusiness) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureBusiness
+}
+
+func (entity *PremiumFeatureBusiness) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureBusiness) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureBusiness) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureBusiness"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureBusiness) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureBusiness)
+	})
+}
+
+// The ability to use all available message effects
+type PremiumFeatureMessageEffects struct {
+	meta
+}
+
+func (*PremiumFeatureMessageEffects) GetType() string {
+	return TypePremiumFeature
+}
+
+func (*PremiumFeatureMessageEffects) GetConstructor() string {
+	return ConstructorPremiumFeatureMessageEffects
+}
+
+func (*PremiumFeatureMessageEffects) PremiumFeatureConstructor() string {
+	return ConstructorPremiumFeatureMessageEffects
+}
+
+func (entity *PremiumFeatureMessageEffects) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatureMessageEffects) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatureMessageEffects) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatureMessageEffects"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatureMessageEffects) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatureMessageEffects)
+	})
+}
+
+// Stories of the current user are displayed before stories of non-Premium contacts, supergroups, and channels
+type PremiumStoryFeaturePriorityOrder struct {
+	meta
+}
+
+func (*PremiumStoryFeaturePriorityOrder) GetType() string {
+	return TypePremiumStoryFeature
+}
+
+func (*PremiumStoryFeaturePriorityOrder) GetConstructor() string {
+	return ConstructorPremiumStoryFeaturePriorityOrder
+}
+
+func (*PremiumStoryFeaturePriorityOrder) PremiumStoryFeatureConstructor() string {
+	return ConstructorPremiumStoryFeaturePriorityOrder
+}
+
+func (entity *PremiumStoryFeaturePriorityOrder) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStoryFeaturePriorityOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStoryFeaturePriorityOrder) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStoryFeaturePriorityOrder"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStoryFeaturePriorityOrder) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumStoryFeaturePriorityOrder)
+	})
+}
+
+// The ability to hide the fact that the user viewed other's stories
+type PremiumStoryFeatureStealthMode struct {
+	meta
+}
+
+func (*PremiumStoryFeatureStealthMode) GetType() string {
+	return TypePremiumStoryFeature
+}
+
+func (*PremiumStoryFeatureStealthMode) GetConstructor() string {
+	return ConstructorPremiumStoryFeatureStealthMode
+}
+
+func (*PremiumStoryFeatureStealthMode) PremiumStoryFeatureConstructor() string {
+	return ConstructorPremiumStoryFeatureStealthMode
+}
+
+func (entity *PremiumStoryFeatureStealthMode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStoryFeatureStealthMode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStoryFeatureStealthMode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStoryFeatureStealthMode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStoryFeatureStealthMode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumStoryFeatureStealthMode)
+	})
+}
+
+// The ability to check who opened the current user's stories after they expire
+type PremiumStoryFeaturePermanentViewsHistory struct {
+	meta
+}
+
+func (*PremiumStoryFeaturePermanentViewsHistory) GetType() string {
+	return TypePremiumStoryFeature
+}
+
+func (*PremiumStoryFeaturePermanentViewsHistory) GetConstructor() string {
+	return ConstructorPremiumStoryFeaturePermanentViewsHistory
+}
+
+func (*PremiumStoryFeaturePermanentViewsHistory) PremiumStoryFeatureConstructor() string {
+	return ConstructorPremiumStoryFeaturePermanentViewsHistory
+}
+
+func (entity *PremiumStoryFeaturePermanentViewsHistory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStoryFeaturePermanentViewsHistory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStoryFeaturePermanentViewsHistory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStoryFeaturePermanentViewsHistory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStoryFeaturePermanentViewsHistory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumStoryFeaturePermanentViewsHistory)
+	})
+}
+
+// The ability to set custom expiration duration for stories
+type PremiumStoryFeatureCustomExpirationDuration struct {
+	meta
+}
+
+func (*PremiumStoryFeatureCustomExpirationDuration) GetType() string {
+	return TypePremiumStoryFeature
+}
+
+func (*PremiumStoryFeatureCustomExpirationDuration) GetConstructor() string {
+	return ConstructorPremiumStoryFeatureCustomExpirationDuration
+}
+
+func (*PremiumStoryFeatureCustomExpirationDuration) PremiumStoryFeatureConstructor() string {
+	return ConstructorPremiumStoryFeatureCustomExpirationDuration
+}
+
+func (entity *PremiumStoryFeatureCustomExpirationDuration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStoryFeatureCustomExpirationDuration) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStoryFeatureCustomExpirationDuration) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStoryFeatureCustomExpirationDuration"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStoryFeatureCustomExpirationDuration) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumStoryFeatureCustomExpirationDuration)
+	})
+}
+
+// The ability to save other's unprotected stories
+type PremiumStoryFeatureSaveStories struct {
+	meta
+}
+
+func (*PremiumStoryFeatureSaveStories) GetType() string {
+	return TypePremiumStoryFeature
+}
+
+func (*PremiumStoryFeatureSaveStories) GetConstructor() string {
+	return ConstructorPremiumStoryFeatureSaveStories
+}
+
+func (*PremiumStoryFeatureSaveStories) PremiumStoryFeatureConstructor() string {
+	return ConstructorPremiumStoryFeatureSaveStories
+}
+
+func (entity *PremiumStoryFeatureSaveStories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStoryFeatureSaveStories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStoryFeatureSaveStories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStoryFeatureSaveStories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStoryFeatureSaveStories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumStoryFeatureSaveStories)
+	})
+}
+
+// The ability to use links and formatting in story caption, and use inputStoryAreaTypeLink areas
+type PremiumStoryFeatureLinksAndFormatting struct {
+	meta
+}
+
+func (*PremiumStoryFeatureLinksAndFormatting) GetType() string {
+	return TypePremiumStoryFeature
+}
+
+func (*PremiumStoryFeatureLinksAndFormatting) GetConstructor() string {
+	return ConstructorPremiumStoryFeatureLinksAndFormatting
+}
+
+func (*PremiumStoryFeatureLinksAndFormatting) PremiumStoryFeatureConstructor() string {
+	return ConstructorPremiumStoryFeatureLinksAndFormatting
+}
+
+func (entity *PremiumStoryFeatureLinksAndFormatting) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStoryFeatureLinksAndFormatting) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStoryFeatureLinksAndFormatting) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStoryFeatureLinksAndFormatting"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStoryFeatureLinksAndFormatting) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumStoryFeatureLinksAndFormatting)
+	})
+}
+
+// The ability to choose better quality for viewed stories
+type PremiumStoryFeatureVideoQuality struct {
+	meta
+}
+
+func (*PremiumStoryFeatureVideoQuality) GetType() string {
+	return TypePremiumStoryFeature
+}
+
+func (*PremiumStoryFeatureVideoQuality) GetConstructor() string {
+	return ConstructorPremiumStoryFeatureVideoQuality
+}
+
+func (*PremiumStoryFeatureVideoQuality) PremiumStoryFeatureConstructor() string {
+	return ConstructorPremiumStoryFeatureVideoQuality
+}
+
+func (entity *PremiumStoryFeatureVideoQuality) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumStoryFeatureVideoQuality) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumStoryFeatureVideoQuality) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumStoryFeatureVideoQuality"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumStoryFeatureVideoQuality) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumStoryFeatureVideoQuality)
+	})
+}
+
+// Contains information about a limit, increased for Premium users
+type PremiumLimit struct {
+	meta
+	// The type of the limit
+	Type PremiumLimitType `json:"type"`
+	// Default value of the limit
+	DefaultValue int32 `json:"default_value"`
+	// Value of the limit for Premium users
+	PremiumValue int32 `json:"premium_value"`
+}
+
+func (*PremiumLimit) GetType() string {
+	return TypePremiumLimit
+}
+
+func (*PremiumLimit) GetConstructor() string {
+	return ConstructorPremiumLimit
+}
+
+func (entity *PremiumLimit) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumLimit) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumLimit) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumLimit"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"default_value":`)
+	encoder.writeInt32(entity.DefaultValue)
+	encoder.writeRaw(`,"premium_value":`)
+	encoder.writeInt32(entity.PremiumValue)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumLimit) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodePremiumLimitType(decoder)
+
+		case "default_value":
+			entity.DefaultValue, err = decoder.readInt32()
+
+		case "premium_value":
+			entity.PremiumValue, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumLimit)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about features, available to Premium users
+type PremiumFeatures struct {
+	meta
+	// The list of available features
+	Features []PremiumFeature `json:"features"`
+	// The list of limits, increased for Premium users
+	Limits []*PremiumLimit `json:"limits"`
+	// An internal link to be opened to pay for Telegram Premium if store payment isn't possible; may be null if direct payment isn't available
+	PaymentLink InternalLinkType `json:"payment_link,omitempty"`
+}
+
+func (*PremiumFeatures) GetType() string {
+	return TypePremiumFeatures
+}
+
+func (*PremiumFeatures) GetConstructor() string {
+	return ConstructorPremiumFeatures
+}
+
+func (entity *PremiumFeatures) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeatures) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeatures) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeatures"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"features":`)
+	writeList(encoder, entity.Features, writeTypeValue[PremiumFeature])
+	encoder.writeRaw(`,"limits":`)
+	writeList(encoder, entity.Limits, writeTypeValue[*PremiumLimit])
+	if entity.PaymentLink != nil {
+		encoder.writeRaw(`,"payment_link":`)
+		encoder.writeType(entity.PaymentLink)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeatures) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "features":
+			entity.Features, err = readList(decoder, decodePremiumFeature)
+
+		case "limits":
+			entity.Limits, err = readList(decoder, decodeEntity[PremiumLimit])
+
+		case "payment_link":
+			entity.PaymentLink, err = decodeInternalLinkType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumFeatures)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A limit was exceeded
+type PremiumSourceLimitExceeded struct {
+	meta
+	// Type of the exceeded limit
+	LimitType PremiumLimitType `json:"limit_type"`
+}
+
+func (*PremiumSourceLimitExceeded) GetType() string {
+	return TypePremiumSource
+}
+
+func (*PremiumSourceLimitExceeded) GetConstructor() string {
+	return ConstructorPremiumSourceLimitExceeded
+}
+
+func (*PremiumSourceLimitExceeded) PremiumSourceConstructor() string {
+	return ConstructorPremiumSourceLimitExceeded
+}
+
+func (entity *PremiumSourceLimitExceeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumSourceLimitExceeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumSourceLimitExceeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumSourceLimitExceeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"limit_type":`)
+	encoder.writeType(entity.LimitType)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumSourceLimitExceeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "limit_type":
+			entity.LimitType, err = decodePremiumLimitType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumSourceLimitExceeded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A user tried to use a Premium feature
+type PremiumSourceFeature struct {
+	meta
+	// The used feature
+	Feature PremiumFeature `json:"feature"`
+}
+
+func (*PremiumSourceFeature) GetType() string {
+	return TypePremiumSource
+}
+
+func (*PremiumSourceFeature) GetConstructor() string {
+	return ConstructorPremiumSourceFeature
+}
+
+func (*PremiumSourceFeature) PremiumSourceConstructor() string {
+	return ConstructorPremiumSourceFeature
+}
+
+func (entity *PremiumSourceFeature) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumSourceFeature) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumSourceFeature) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumSourceFeature"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"feature":`)
+	encoder.writeType(entity.Feature)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumSourceFeature) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "feature":
+			entity.Feature, err = decodePremiumFeature(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumSourceFeature)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A user tried to use a Business feature
+type PremiumSourceBusinessFeature struct {
+	meta
+	// The used feature; pass null if none specific feature was used
+	Feature BusinessFeature `json:"feature,omitempty"`
+}
+
+func (*PremiumSourceBusinessFeature) GetType() string {
+	return TypePremiumSource
+}
+
+func (*PremiumSourceBusinessFeature) GetConstructor() string {
+	return ConstructorPremiumSourceBusinessFeature
+}
+
+func (*PremiumSourceBusinessFeature) PremiumSourceConstructor() string {
+	return ConstructorPremiumSourceBusinessFeature
+}
+
+func (entity *PremiumSourceBusinessFeature) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumSourceBusinessFeature) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumSourceBusinessFeature) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumSourceBusinessFeature"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Feature != nil {
+		encoder.writeRaw(`,"feature":`)
+		encoder.writeType(entity.Feature)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumSourceBusinessFeature) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "feature":
+			entity.Feature, err = decodeBusinessFeature(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumSourceBusinessFeature)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A user tried to use a Premium story feature
+type PremiumSourceStoryFeature struct {
+	meta
+	// The used feature
+	Feature PremiumStoryFeature `json:"feature"`
+}
+
+func (*PremiumSourceStoryFeature) GetType() string {
+	return TypePremiumSource
+}
+
+func (*PremiumSourceStoryFeature) GetConstructor() string {
+	return ConstructorPremiumSourceStoryFeature
+}
+
+func (*PremiumSourceStoryFeature) PremiumSourceConstructor() string {
+	return ConstructorPremiumSourceStoryFeature
+}
+
+func (entity *PremiumSourceStoryFeature) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumSourceStoryFeature) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumSourceStoryFeature) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumSourceStoryFeature"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"feature":`)
+	encoder.writeType(entity.Feature)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumSourceStoryFeature) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "feature":
+			entity.Feature, err = decodePremiumStoryFeature(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumSourceStoryFeature)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A user opened an internal link of the type internalLinkTypePremiumFeatures
+type PremiumSourceLink struct {
+	meta
+	// The referrer from the link
+	Referrer string `json:"referrer"`
+}
+
+func (*PremiumSourceLink) GetType() string {
+	return TypePremiumSource
+}
+
+func (*PremiumSourceLink) GetConstructor() string {
+	return ConstructorPremiumSourceLink
+}
+
+func (*PremiumSourceLink) PremiumSourceConstructor() string {
+	return ConstructorPremiumSourceLink
+}
+
+func (entity *PremiumSourceLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumSourceLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumSourceLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumSourceLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"referrer":`)
+	encoder.writeString(entity.Referrer)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumSourceLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "referrer":
+			entity.Referrer, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumSourceLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A user opened the Premium features screen from settings
+type PremiumSourceSettings struct {
+	meta
+}
+
+func (*PremiumSourceSettings) GetType() string {
+	return TypePremiumSource
+}
+
+func (*PremiumSourceSettings) GetConstructor() string {
+	return ConstructorPremiumSourceSettings
+}
+
+func (*PremiumSourceSettings) PremiumSourceConstructor() string {
+	return ConstructorPremiumSourceSettings
+}
+
+func (entity *PremiumSourceSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumSourceSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumSourceSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumSourceSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumSourceSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorPremiumSourceSettings)
+	})
+}
+
+// Describes a promotion animation for a Premium feature
+type PremiumFeaturePromotionAnimation struct {
+	meta
+	// Premium feature
+	Feature PremiumFeature `json:"feature"`
+	// Promotion animation for the feature
+	Animation *Animation `json:"animation"`
+}
+
+func (*PremiumFeaturePromotionAnimation) GetType() string {
+	return TypePremiumFeaturePromotionAnimation
+}
+
+func (*PremiumFeaturePromotionAnimation) GetConstructor() string {
+	return ConstructorPremiumFeaturePromotionAnimation
+}
+
+func (entity *PremiumFeaturePromotionAnimation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumFeaturePromotionAnimation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumFeaturePromotionAnimation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumFeaturePromotionAnimation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"feature":`)
+	encoder.writeType(entity.Feature)
+	encoder.writeRaw(`,"animation":`)
+	entity.Animation.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumFeaturePromotionAnimation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "feature":
+			entity.Feature, err = decodePremiumFeature(decoder)
+
+		case "animation":
+			entity.Animation, err = decodeEntity[Animation](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumFeaturePromotionAnimation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains state of Telegram Premium subscription and promotion videos for Premium features
+type PremiumState struct {
+	meta
+	// Text description of the state of the current Premium subscription; may be empty if the current user has no Telegram Premium subscription
+	State *FormattedText `json:"state"`
+	// The list of available options for buying Telegram Premium
+	PaymentOptions []*PremiumStatePaymentOption `json:"payment_options"`
+	// The list of available promotion animations for Premium features
+	Animations []*PremiumFeaturePromotionAnimation `json:"animations"`
+	// The list of available promotion animations for Business features
+	BusinessAnimations []*BusinessFeaturePromotionAnimation `json:"business_animations"`
+}
+
+func (*PremiumState) GetType() string {
+	return TypePremiumState
+}
+
+func (*PremiumState) GetConstructor() string {
+	return ConstructorPremiumState
+}
+
+func (entity *PremiumState) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *PremiumState) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *PremiumState) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"premiumState"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"state":`)
+	entity.State.encodeJSON(encoder)
+	encoder.writeRaw(`,"payment_options":`)
+	writeList(encoder, entity.PaymentOptions, writeTypeValue[*PremiumStatePaymentOption])
+	encoder.writeRaw(`,"animations":`)
+	writeList(encoder, entity.Animations, writeTypeValue[*PremiumFeaturePromotionAnimation])
+	encoder.writeRaw(`,"business_animations":`)
+	writeList(encoder, entity.BusinessAnimations, writeTypeValue[*BusinessFeaturePromotionAnimation])
+	encoder.writeRaw("}")
+}
+
+func (entity *PremiumState) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "state":
+			entity.State, err = decodeEntity[FormattedText](decoder)
+
+		case "payment_options":
+			entity.PaymentOptions, err = readList(decoder, decodeEntity[PremiumStatePaymentOption])
+
+		case "animations":
+			entity.Animations, err = readList(decoder, decodeEntity[PremiumFeaturePromotionAnimation])
+
+		case "business_animations":
+			entity.BusinessAnimations, err = readList(decoder, decodeEntity[BusinessFeaturePromotionAnimation])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorPremiumState)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user subscribing to Telegram Premium
+type StorePaymentPurposePremiumSubscription struct {
+	meta
+	// Pass true if this is a restore of a Telegram Premium purchase; only for App Store
+	IsRestore bool `json:"is_restore"`
+	// Pass true if this is an upgrade from a monthly subscription to early subscription; only for App Store
+	IsUpgrade bool `json:"is_upgrade"`
+}
+
+func (*StorePaymentPurposePremiumSubscription) GetType() string {
+	return TypeStorePaymentPurpose
+}
+
+func (*StorePaymentPurposePremiumSubscription) GetConstructor() string {
+	return ConstructorStorePaymentPurposePremiumSubscription
+}
+
+func (*StorePaymentPurposePremiumSubscription) StorePaymentPurposeConstructor() string {
+	return ConstructorStorePaymentPurposePremiumSubscription
+}
+
+func (entity *StorePaymentPurposePremiumSubscription) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorePaymentPurposePremiumSubscription) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorePaymentPurposePremiumSubscription) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storePaymentPurposePremiumSubscription"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_restore":`)
+	encoder.writeBool(entity.IsRestore)
+	encoder.writeRaw(`,"is_upgrade":`)
+	encoder.writeBool(entity.IsUpgrade)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorePaymentPurposePremiumSubscription) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_restore":
+			entity.IsRestore, err = decoder.readBool()
+
+		case "is_upgrade":
+			entity.IsUpgrade, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorePaymentPurposePremiumSubscription)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user gifting Telegram Premium to another user
+type StorePaymentPurposePremiumGift struct {
+	meta
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Identifiers of the user which will receive Telegram Premium
+	UserId int64 `json:"user_id"`
+	// Text to show along with the gift codes; 0-getOption("gift_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities are allowed
+	Text *FormattedText `json:"text"`
+}
+
+func (*StorePaymentPurposePremiumGift) GetType() string {
+	return TypeStorePaymentPurpose
+}
+
+func (*StorePaymentPurposePremiumGift) GetConstructor() string {
+	return ConstructorStorePaymentPurposePremiumGift
+}
+
+func (*StorePaymentPurposePremiumGift) StorePaymentPurposeConstructor() string {
+	return ConstructorStorePaymentPurposePremiumGift
+}
+
+func (entity *StorePaymentPurposePremiumGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorePaymentPurposePremiumGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorePaymentPurposePremiumGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storePaymentPurposePremiumGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorePaymentPurposePremiumGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorePaymentPurposePremiumGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user boosting a chat by creating Telegram Premium gift codes for other users
+type StorePaymentPurposePremiumGiftCodes struct {
+	meta
+	// Identifier of the supergroup or channel chat, which will be automatically boosted by the users for duration of the Premium subscription and which is administered by the user
+	BoostedChatId int64 `json:"boosted_chat_id"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Identifiers of the users which can activate the gift codes
+	UserIds []int64 `json:"user_ids"`
+	// Text to show along with the gift codes; 0-getOption("gift_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities are allowed
+	Text *FormattedText `json:"text"`
+}
+
+func (*StorePaymentPurposePremiumGiftCodes) GetType() string {
+	return TypeStorePaymentPurpose
+}
+
+func (*StorePaymentPurposePremiumGiftCodes) GetConstructor() string {
+	return ConstructorStorePaymentPurposePremiumGiftCodes
+}
+
+func (*StorePaymentPurposePremiumGiftCodes) StorePaymentPurposeConstructor() string {
+	return ConstructorStorePaymentPurposePremiumGiftCodes
+}
+
+func (entity *StorePaymentPurposePremiumGiftCodes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorePaymentPurposePremiumGiftCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorePaymentPurposePremiumGiftCodes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storePaymentPurposePremiumGiftCodes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"boosted_chat_id":`)
+	encoder.writeInt64(entity.BoostedChatId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorePaymentPurposePremiumGiftCodes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "boosted_chat_id":
+			entity.BoostedChatId, err = decoder.readInt64()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorePaymentPurposePremiumGiftCodes)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user creating a Telegram Premium giveaway
+type StorePaymentPurposePremiumGiveaway struct {
+	meta
+	// Giveaway parameters
+	Parameters *GiveawayParameters `json:"parameters"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+}
+
+func (*StorePaymentPurposePremiumGiveaway) GetType() string {
+	return TypeStorePaymentPurpose
+}
+
+func (*StorePaymentPurposePremiumGiveaway) GetConstructor() string {
+	return ConstructorStorePaymentPurposePremiumGiveaway
+}
+
+func (*StorePaymentPurposePremiumGiveaway) StorePaymentPurposeConstructor() string {
+	return ConstructorStorePaymentPurposePremiumGiveaway
+}
+
+func (entity *StorePaymentPurposePremiumGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorePaymentPurposePremiumGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorePaymentPurposePremiumGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storePaymentPurposePremiumGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"parameters":`)
+	entity.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorePaymentPurposePremiumGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "parameters":
+			entity.Parameters, err = decodeEntity[GiveawayParameters](decoder)
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorePaymentPurposePremiumGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *StorePaymentPurposePremiumGiveaway) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("parameters", entity.Parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The user creating a Telegram Star giveaway
+type StorePaymentPurposeStarGiveaway struct {
+	meta
+	// Giveaway parameters
+	Parameters *GiveawayParameters `json:"parameters"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// The number of users to receive Telegram Stars
+	WinnerCount int32 `json:"winner_count"`
+	// The number of Telegram Stars to be distributed through the giveaway
+	StarCount int64 `json:"star_count"`
+}
+
+func (*StorePaymentPurposeStarGiveaway) GetType() string {
+	return TypeStorePaymentPurpose
+}
+
+func (*StorePaymentPurposeStarGiveaway) GetConstructor() string {
+	return ConstructorStorePaymentPurposeStarGiveaway
+}
+
+func (*StorePaymentPurposeStarGiveaway) StorePaymentPurposeConstructor() string {
+	return ConstructorStorePaymentPurposeStarGiveaway
+}
+
+func (entity *StorePaymentPurposeStarGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorePaymentPurposeStarGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorePaymentPurposeStarGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storePaymentPurposeStarGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"parameters":`)
+	entity.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorePaymentPurposeStarGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "parameters":
+			entity.Parameters, err = decodeEntity[GiveawayParameters](decoder)
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorePaymentPurposeStarGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *StorePaymentPurposeStarGiveaway) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("parameters", entity.Parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The user buying Telegram Stars
+type StorePaymentPurposeStars struct {
+	meta
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of bought Telegram Stars
+	StarCount int64 `json:"star_count"`
+}
+
+func (*StorePaymentPurposeStars) GetType() string {
+	return TypeStorePaymentPurpose
+}
+
+func (*StorePaymentPurposeStars) GetConstructor() string {
+	return ConstructorStorePaymentPurposeStars
+}
+
+func (*StorePaymentPurposeStars) StorePaymentPurposeConstructor() string {
+	return ConstructorStorePaymentPurposeStars
+}
+
+func (entity *StorePaymentPurposeStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorePaymentPurposeStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorePaymentPurposeStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storePaymentPurposeStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorePaymentPurposeStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorePaymentPurposeStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user buying Telegram Stars for other users
+type StorePaymentPurposeGiftedStars struct {
+	meta
+	// Identifier of the user to which Telegram Stars are gifted
+	UserId int64 `json:"user_id"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of bought Telegram Stars
+	StarCount int64 `json:"star_count"`
+}
+
+func (*StorePaymentPurposeGiftedStars) GetType() string {
+	return TypeStorePaymentPurpose
+}
+
+func (*StorePaymentPurposeGiftedStars) GetConstructor() string {
+	return ConstructorStorePaymentPurposeGiftedStars
+}
+
+func (*StorePaymentPurposeGiftedStars) StorePaymentPurposeConstructor() string {
+	return ConstructorStorePaymentPurposeGiftedStars
+}
+
+func (entity *StorePaymentPurposeGiftedStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StorePaymentPurposeGiftedStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StorePaymentPurposeGiftedStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storePaymentPurposeGiftedStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StorePaymentPurposeGiftedStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStorePaymentPurposeGiftedStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A purchase through App Store
+type StoreTransactionAppStore struct {
+	meta
+	// App Store receipt
+	Receipt []byte `json:"receipt"`
+}
+
+func (*StoreTransactionAppStore) GetType() string {
+	return TypeStoreTransaction
+}
+
+func (*StoreTransactionAppStore) GetConstructor() string {
+	return ConstructorStoreTransactionAppStore
+}
+
+func (*StoreTransactionAppStore) StoreTransactionConstructor() string {
+	return ConstructorStoreTransactionAppStore
+}
+
+func (entity *StoreTransactionAppStore) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoreTransactionAppStore) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoreTransactionAppStore) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storeTransactionAppStore"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"receipt":`)
+	encoder.writeBytes(entity.Receipt)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoreTransactionAppStore) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "receipt":
+			entity.Receipt, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoreTransactionAppStore)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A purchase through Google Play
+type StoreTransactionGooglePlay struct {
+	meta
+	// Application package name
+	PackageName string `json:"package_name"`
+	// Identifier of the purchased store product
+	StoreProductId string `json:"store_product_id"`
+	// Google Play purchase token
+	PurchaseToken string `json:"purchase_token"`
+}
+
+func (*StoreTransactionGooglePlay) GetType() string {
+	return TypeStoreTransaction
+}
+
+func (*StoreTransactionGooglePlay) GetConstructor() string {
+	return ConstructorStoreTransactionGooglePlay
+}
+
+func (*StoreTransactionGooglePlay) StoreTransactionConstructor() string {
+	return ConstructorStoreTransactionGooglePlay
+}
+
+func (entity *StoreTransactionGooglePlay) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoreTransactionGooglePlay) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoreTransactionGooglePlay) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storeTransactionGooglePlay"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"package_name":`)
+	encoder.writeString(entity.PackageName)
+	encoder.writeRaw(`,"store_product_id":`)
+	encoder.writeString(entity.StoreProductId)
+	encoder.writeRaw(`,"purchase_token":`)
+	encoder.writeString(entity.PurchaseToken)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoreTransactionGooglePlay) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "package_name":
+			entity.PackageName, err = decoder.readString()
+
+		case "store_product_id":
+			entity.StoreProductId, err = decoder.readString()
+
+		case "purchase_token":
+			entity.PurchaseToken, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoreTransactionGooglePlay)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user gifting Telegram Premium to another user
+type TelegramPaymentPurposePremiumGift struct {
+	meta
+	// ISO 4217 currency code of the payment currency, or "XTR" for payments in Telegram Stars
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Identifier of the user which will receive Telegram Premium
+	UserId int64 `json:"user_id"`
+	// Number of months the Telegram Premium subscription will be active for the user
+	MonthCount int32 `json:"month_count"`
+	// Text to show to the user receiving Telegram Premium; 0-getOption("gift_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities are allowed
+	Text *FormattedText `json:"text"`
+}
+
+func (*TelegramPaymentPurposePremiumGift) GetType() string {
+	return TypeTelegramPaymentPurpose
+}
+
+func (*TelegramPaymentPurposePremiumGift) GetConstructor() string {
+	return ConstructorTelegramPaymentPurposePremiumGift
+}
+
+func (*TelegramPaymentPurposePremiumGift) TelegramPaymentPurposeConstructor() string {
+	return ConstructorTelegramPaymentPurposePremiumGift
+}
+
+func (entity *TelegramPaymentPurposePremiumGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TelegramPaymentPurposePremiumGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TelegramPaymentPurposePremiumGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"telegramPaymentPurposePremiumGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *TelegramPaymentPurposePremiumGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTelegramPaymentPurposePremiumGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user boosting a chat by creating Telegram Premium gift codes for other users
+type TelegramPaymentPurposePremiumGiftCodes struct {
+	meta
+	// Identifier of the supergroup or channel chat, which will be automatically boosted by the users for duration of the Premium subscription and which is administered by the user
+	BoostedChatId int64 `json:"boosted_chat_id"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Identifiers of the users which can activate the gift codes
+	UserIds []int64 `json:"user_ids"`
+	// Number of months the Telegram Premium subscription will be active for the users
+	MonthCount int32 `json:"month_count"`
+	// Text to show along with the gift codes; 0-getOption("gift_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, and CustomEmoji entities are allowed
+	Text *FormattedText `json:"text"`
+}
+
+func (*TelegramPaymentPurposePremiumGiftCodes) GetType() string {
+	return TypeTelegramPaymentPurpose
+}
+
+func (*TelegramPaymentPurposePremiumGiftCodes) GetConstructor() string {
+	return ConstructorTelegramPaymentPurposePremiumGiftCodes
+}
+
+func (*TelegramPaymentPurposePremiumGiftCodes) TelegramPaymentPurposeConstructor() string {
+	return ConstructorTelegramPaymentPurposePremiumGiftCodes
+}
+
+func (entity *TelegramPaymentPurposePremiumGiftCodes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TelegramPaymentPurposePremiumGiftCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TelegramPaymentPurposePremiumGiftCodes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"telegramPaymentPurposePremiumGiftCodes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"boosted_chat_id":`)
+	encoder.writeInt64(entity.BoostedChatId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw(`,"text":`)
+	entity.Text.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *TelegramPaymentPurposePremiumGiftCodes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "boosted_chat_id":
+			entity.BoostedChatId, err = decoder.readInt64()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		case "text":
+			entity.Text, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTelegramPaymentPurposePremiumGiftCodes)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user creating a Telegram Premium giveaway
+type TelegramPaymentPurposePremiumGiveaway struct {
+	meta
+	// Giveaway parameters
+	Parameters *GiveawayParameters `json:"parameters"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of users which will be able to activate the gift codes
+	WinnerCount int32 `json:"winner_count"`
+	// Number of months the Telegram Premium subscription will be active for the users
+	MonthCount int32 `json:"month_count"`
+}
+
+func (*TelegramPaymentPurposePremiumGiveaway) GetType() string {
+	return TypeTelegramPaymentPurpose
+}
+
+func (*TelegramPaymentPurposePremiumGiveaway) GetConstructor() string {
+	return ConstructorTelegramPaymentPurposePremiumGiveaway
+}
+
+func (*TelegramPaymentPurposePremiumGiveaway) TelegramPaymentPurposeConstructor() string {
+	return ConstructorTelegramPaymentPurposePremiumGiveaway
+}
+
+func (entity *TelegramPaymentPurposePremiumGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TelegramPaymentPurposePremiumGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TelegramPaymentPurposePremiumGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"telegramPaymentPurposePremiumGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"parameters":`)
+	entity.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"month_count":`)
+	encoder.writeInt32(entity.MonthCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *TelegramPaymentPurposePremiumGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "parameters":
+			entity.Parameters, err = decodeEntity[GiveawayParameters](decoder)
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "month_count":
+			entity.MonthCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTelegramPaymentPurposePremiumGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *TelegramPaymentPurposePremiumGiveaway) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("parameters", entity.Parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The user buying Telegram Stars
+type TelegramPaymentPurposeStars struct {
+	meta
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of bought Telegram Stars
+	StarCount int64 `json:"star_count"`
+}
+
+func (*TelegramPaymentPurposeStars) GetType() string {
+	return TypeTelegramPaymentPurpose
+}
+
+func (*TelegramPaymentPurposeStars) GetConstructor() string {
+	return ConstructorTelegramPaymentPurposeStars
+}
+
+func (*TelegramPaymentPurposeStars) TelegramPaymentPurposeConstructor() string {
+	return ConstructorTelegramPaymentPurposeStars
+}
+
+func (entity *TelegramPaymentPurposeStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TelegramPaymentPurposeStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TelegramPaymentPurposeStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"telegramPaymentPurposeStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *TelegramPaymentPurposeStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTelegramPaymentPurposeStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user buying Telegram Stars for other users
+type TelegramPaymentPurposeGiftedStars struct {
+	meta
+	// Identifier of the user to which Telegram Stars are gifted
+	UserId int64 `json:"user_id"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// Number of bought Telegram Stars
+	StarCount int64 `json:"star_count"`
+}
+
+func (*TelegramPaymentPurposeGiftedStars) GetType() string {
+	return TypeTelegramPaymentPurpose
+}
+
+func (*TelegramPaymentPurposeGiftedStars) GetConstructor() string {
+	return ConstructorTelegramPaymentPurposeGiftedStars
+}
+
+func (*TelegramPaymentPurposeGiftedStars) TelegramPaymentPurposeConstructor() string {
+	return ConstructorTelegramPaymentPurposeGiftedStars
+}
+
+func (entity *TelegramPaymentPurposeGiftedStars) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TelegramPaymentPurposeGiftedStars) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TelegramPaymentPurposeGiftedStars) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"telegramPaymentPurposeGiftedStars"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *TelegramPaymentPurposeGiftedStars) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTelegramPaymentPurposeGiftedStars)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user creating a Telegram Star giveaway
+type TelegramPaymentPurposeStarGiveaway struct {
+	meta
+	// Giveaway parameters
+	Parameters *GiveawayParameters `json:"parameters"`
+	// ISO 4217 currency code of the payment currency
+	Currency string `json:"currency"`
+	// Paid amount, in the smallest units of the currency
+	Amount int64 `json:"amount"`
+	// The number of users to receive Telegram Stars
+	WinnerCount int32 `json:"winner_count"`
+	// The number of Telegram Stars to be distributed through the giveaway
+	StarCount int64 `json:"star_count"`
+}
+
+func (*TelegramPaymentPurposeStarGiveaway) GetType() string {
+	return TypeTelegramPaymentPurpose
+}
+
+func (*TelegramPaymentPurposeStarGiveaway) GetConstructor() string {
+	return ConstructorTelegramPaymentPurposeStarGiveaway
+}
+
+func (*TelegramPaymentPurposeStarGiveaway) TelegramPaymentPurposeConstructor() string {
+	return ConstructorTelegramPaymentPurposeStarGiveaway
+}
+
+func (entity *TelegramPaymentPurposeStarGiveaway) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TelegramPaymentPurposeStarGiveaway) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TelegramPaymentPurposeStarGiveaway) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"telegramPaymentPurposeStarGiveaway"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"parameters":`)
+	entity.Parameters.encodeJSON(encoder)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"amount":`)
+	encoder.writeInt64(entity.Amount)
+	encoder.writeRaw(`,"winner_count":`)
+	encoder.writeInt32(entity.WinnerCount)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *TelegramPaymentPurposeStarGiveaway) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "parameters":
+			entity.Parameters, err = decodeEntity[GiveawayParameters](decoder)
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "amount":
+			entity.Amount, err = decoder.readInt64()
+
+		case "winner_count":
+			entity.WinnerCount, err = decoder.readInt32()
+
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTelegramPaymentPurposeStarGiveaway)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *TelegramPaymentPurposeStarGiveaway) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("parameters", entity.Parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The user joins a chat and subscribes to regular payments in Telegram Stars
+type TelegramPaymentPurposeJoinChat struct {
+	meta
+	// Invite link to use
+	InviteLink string `json:"invite_link"`
+}
+
+func (*TelegramPaymentPurposeJoinChat) GetType() string {
+	return TypeTelegramPaymentPurpose
+}
+
+func (*TelegramPaymentPurposeJoinChat) GetConstructor() string {
+	return ConstructorTelegramPaymentPurposeJoinChat
+}
+
+func (*TelegramPaymentPurposeJoinChat) TelegramPaymentPurposeConstructor() string {
+	return ConstructorTelegramPaymentPurposeJoinChat
+}
+
+func (entity *TelegramPaymentPurposeJoinChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TelegramPaymentPurposeJoinChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TelegramPaymentPurposeJoinChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"telegramPaymentPurposeJoinChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"invite_link":`)
+	encoder.writeString(entity.InviteLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *TelegramPaymentPurposeJoinChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "invite_link":
+			entity.InviteLink, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTelegramPaymentPurposeJoinChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains an HTTPS link to boost a chat
+type ChatBoostLink struct {
+	meta
+	// The link
+	Link string `json:"link"`
+	// True, if the link will work for non-members of the chat
+	IsPublic bool `json:"is_public"`
+}
+
+func (*ChatBoostLink) GetType() string {
+	return TypeChatBoostLink
+}
+
+func (*ChatBoostLink) GetConstructor() string {
+	return ConstructorChatBoostLink
+}
+
+func (entity *ChatBoostLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"link":`)
+	encoder.writeString(entity.Link)
+	encoder.writeRaw(`,"is_public":`)
+	encoder.writeBool(entity.IsPublic)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "link":
+			entity.Link, err = decoder.readString()
+
+		case "is_public":
+			entity.IsPublic, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a link to boost a chat
+type ChatBoostLinkInfo struct {
+	meta
+	// True, if the link will work for non-members of the chat
+	IsPublic bool `json:"is_public"`
+	// Identifier of the chat to which the link points; 0 if the chat isn't found
+	ChatId int64 `json:"chat_id"`
+}
+
+func (*ChatBoostLinkInfo) GetType() string {
+	return TypeChatBoostLinkInfo
+}
+
+func (*ChatBoostLinkInfo) GetConstructor() string {
+	return ConstructorChatBoostLinkInfo
+}
+
+func (entity *ChatBoostLinkInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatBoostLinkInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatBoostLinkInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatBoostLinkInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_public":`)
+	encoder.writeBool(entity.IsPublic)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatBoostLinkInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_public":
+			entity.IsPublic, err = decoder.readBool()
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatBoostLinkInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a number of Telegram Stars
+type StarCount struct {
+	meta
+	// Number of Telegram Stars
+	StarCount int64 `json:"star_count"`
+}
+
+func (*StarCount) GetType() string {
+	return TypeStarCount
+}
+
+func (*StarCount) GetConstructor() string {
+	return ConstructorStarCount
+}
+
+func (entity *StarCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_count":`)
+	encoder.writeInt64(entity.StarCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_count":
+			entity.StarCount, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about revenue earned from sponsored messages in a chat
+type ChatRevenueAmount struct {
+	meta
+	// Cryptocurrency in which revenue is calculated
+	Cryptocurrency string `json:"cryptocurrency"`
+	// Total amount of the cryptocurrency earned, in the smallest units of the cryptocurrency
+	TotalAmount JsonInt64 `json:"total_amount"`
+	// Amount of the cryptocurrency that isn't withdrawn yet, in the smallest units of the cryptocurrency
+	BalanceAmount JsonInt64 `json:"balance_amount"`
+	// Amount of the cryptocurrency available for withdrawal, in the smallest units of the cryptocurrency
+	AvailableAmount JsonInt64 `json:"available_amount"`
+	// True, if Telegram Stars can be withdrawn now or later
+	WithdrawalEnabled bool `json:"withdrawal_enabled"`
+}
+
+func (*ChatRevenueAmount) GetType() string {
+	return TypeChatRevenueAmount
+}
+
+func (*ChatRevenueAmount) GetConstructor() string {
+	return ConstructorChatRevenueAmount
+}
+
+func (entity *ChatRevenueAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatRevenueAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatRevenueAmount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatRevenueAmount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"cryptocurrency":`)
+	encoder.writeString(entity.Cryptocurrency)
+	encoder.writeRaw(`,"total_amount":`)
+	encoder.writeJsonInt64(entity.TotalAmount)
+	encoder.writeRaw(`,"balance_amount":`)
+	encoder.writeJsonInt64(entity.BalanceAmount)
+	encoder.writeRaw(`,"available_amount":`)
+	encoder.writeJsonInt64(entity.AvailableAmount)
+	encoder.writeRaw(`,"withdrawal_enabled":`)
+	encoder.writeBool(entity.WithdrawalEnabled)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatRevenueAmount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "cryptocurrency":
+			entity.Cryptocurrency, err = decoder.readString()
+
+		case "total_amount":
+			entity.TotalAmount, err = decoder.readJsonInt64()
+
+		case "balance_amount":
+			entity.BalanceAmount, err = decoder.readJsonInt64()
+
+		case "available_amount":
+			entity.AvailableAmount, err = decoder.readJsonInt64()
+
+		case "withdrawal_enabled":
+			entity.WithdrawalEnabled, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatRevenueAmount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A detailed statistics about revenue earned from sponsored messages in a chat
+type ChatRevenueStatistics struct {
+	meta
+	// A graph containing amount of revenue in a given hour
+	RevenueByHourGraph StatisticalGraph `json:"revenue_by_hour_graph"`
+	// A graph containing amount of revenue
+	RevenueGraph StatisticalGraph `json:"revenue_graph"`
+	// Amount of earned revenue
+	RevenueAmount *ChatRevenueAmount `json:"revenue_amount"`
+	// Current conversion rate of the cryptocurrency in which revenue is calculated to USD
+	UsdRate float64 `json:"usd_rate"`
+}
+
+func (*ChatRevenueStatistics) GetType() string {
+	return TypeChatRevenueStatistics
+}
+
+func (*ChatRevenueStatistics) GetConstructor() string {
+	return ConstructorChatRevenueStatistics
+}
+
+func (entity *ChatRevenueStatistics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatRevenueStatistics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatRevenueStatistics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatRevenueStatistics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"revenue_by_hour_graph":`)
+	encoder.writeType(entity.RevenueByHourGraph)
+	encoder.writeRaw(`,"revenue_graph":`)
+	encoder.writeType(entity.RevenueGraph)
+	encoder.writeRaw(`,"revenue_amount":`)
+	entity.RevenueAmount.encodeJSON(encoder)
+	encoder.writeRaw(`,"usd_rate":`)
+	encoder.writeFloat64(entity.UsdRate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatRevenueStatistics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "revenue_by_hour_graph":
+			entity.RevenueByHourGraph, err = decodeStatisticalGraph(decoder)
+
+		case "revenue_graph":
+			entity.RevenueGraph, err = decodeStatisticalGraph(decoder)
+
+		case "revenue_amount":
+			entity.RevenueAmount, err = decodeEntity[ChatRevenueAmount](decoder)
+
+		case "usd_rate":
+			entity.UsdRate, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatRevenueStatistics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Withdrawal is pending
+type RevenueWithdrawalStatePending struct {
+	meta
+}
+
+func (*RevenueWithdrawalStatePending) GetType() string {
+	return TypeRevenueWithdrawalState
+}
+
+func (*RevenueWithdrawalStatePending) GetConstructor() string {
+	return ConstructorRevenueWithdrawalStatePending
+}
+
+func (*RevenueWithdrawalStatePending) RevenueWithdrawalStateConstructor() string {
+	return ConstructorRevenueWithdrawalStatePending
+}
+
+func (entity *RevenueWithdrawalStatePending) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RevenueWithdrawalStatePending) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RevenueWithdrawalStatePending) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"revenueWithdrawalStatePending"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *RevenueWithdrawalStatePending) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorRevenueWithdrawalStatePending)
+	})
+}
+
+// Withdrawal succeeded
+type RevenueWithdrawalStateSucceeded struct {
+	meta
+	// Point in time (Unix timestamp) when the withdrawal was completed
+	Date int32 `json:"date"`
+	// The URL where the withdrawal transaction can be viewed
+	Url string `json:"url"`
+}
+
+func (*RevenueWithdrawalStateSucceeded) GetType() string {
+	return TypeRevenueWithdrawalState
+}
+
+func (*RevenueWithdrawalStateSucceeded) GetConstructor() string {
+	return ConstructorRevenueWithdrawalStateSucceeded
+}
+
+func (*RevenueWithdrawalStateSucceeded) RevenueWithdrawalStateConstructor() string {
+	return ConstructorRevenueWithdrawalStateSucceeded
+}
+
+func (entity *RevenueWithdrawalStateSucceeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RevenueWithdrawalStateSucceeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RevenueWithdrawalStateSucceeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"revenueWithdrawalStateSucceeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *RevenueWithdrawalStateSucceeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorRevenueWithdrawalStateSucceeded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Withdrawal failed
+type RevenueWithdrawalStateFailed struct {
+	meta
+}
+
+func (*RevenueWithdrawalStateFailed) GetType() string {
+	return TypeRevenueWithdrawalState
+}
+
+func (*RevenueWithdrawalStateFailed) GetConstructor() string {
+	return ConstructorRevenueWithdrawalStateFailed
+}
+
+func (*RevenueWithdrawalStateFailed) RevenueWithdrawalStateConstructor() string {
+	return ConstructorRevenueWithdrawalStateFailed
+}
+
+func (entity *RevenueWithdrawalStateFailed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *RevenueWithdrawalStateFailed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *RevenueWithdrawalStateFailed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"revenueWithdrawalStateFailed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *RevenueWithdrawalStateFailed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorRevenueWithdrawalStateFailed)
+	})
+}
+
+// Describes earnings from sponsored messages in a chat in some time frame
+type ChatRevenueTransactionTypeEarnings struct {
+	meta
+	// Point in time (Unix timestamp) when the earnings started
+	StartDate int32 `json:"start_date"`
+	// Point in time (Unix timestamp) when the earnings ended
+	EndDate int32 `json:"end_date"`
+}
+
+func (*ChatRevenueTransactionTypeEarnings) GetType() string {
+	return TypeChatRevenueTransactionType
+}
+
+func (*ChatRevenueTransactionTypeEarnings) GetConstructor() string {
+	return ConstructorChatRevenueTransactionTypeEarnings
+}
+
+func (*ChatRevenueTransactionTypeEarnings) ChatRevenueTransactionTypeConstructor() string {
+	return ConstructorChatRevenueTransactionTypeEarnings
+}
+
+func (entity *ChatRevenueTransactionTypeEarnings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatRevenueTransactionTypeEarnings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatRevenueTransactionTypeEarnings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatRevenueTransactionTypeEarnings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"start_date":`)
+	encoder.writeInt32(entity.StartDate)
+	encoder.writeRaw(`,"end_date":`)
+	encoder.writeInt32(entity.EndDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatRevenueTransactionTypeEarnings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "start_date":
+			entity.StartDate, err = decoder.readInt32()
+
+		case "end_date":
+			entity.EndDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatRevenueTransactionTypeEarnings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a withdrawal of earnings
+type ChatRevenueTransactionTypeWithdrawal struct {
+	meta
+	// Point in time (Unix timestamp) when the earnings withdrawal started
+	WithdrawalDate int32 `json:"withdrawal_date"`
+	// Name of the payment provider
+	Provider string `json:"provider"`
+	// State of the withdrawal
+	State RevenueWithdrawalState `json:"state"`
+}
+
+func (*ChatRevenueTransactionTypeWithdrawal) GetType() string {
+	return TypeChatRevenueTransactionType
+}
+
+func (*ChatRevenueTransactionTypeWithdrawal) GetConstructor() string {
+	return ConstructorChatRevenueTransactionTypeWithdrawal
+}
+
+func (*ChatRevenueTransactionTypeWithdrawal) ChatRevenueTransactionTypeConstructor() string {
+	return ConstructorChatRevenueTransactionTypeWithdrawal
+}
+
+func (entity *ChatRevenueTransactionTypeWithdrawal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatRevenueTransactionTypeWithdrawal) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatRevenueTransactionTypeWithdrawal) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatRevenueTransactionTypeWithdrawal"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"withdrawal_date":`)
+	encoder.writeInt32(entity.WithdrawalDate)
+	encoder.writeRaw(`,"provider":`)
+	encoder.writeString(entity.Provider)
+	encoder.writeRaw(`,"state":`)
+	encoder.writeType(entity.State)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatRevenueTransactionTypeWithdrawal) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "withdrawal_date":
+			entity.WithdrawalDate, err = decoder.readInt32()
+
+		case "provider":
+			entity.Provider, err = decoder.readString()
+
+		case "state":
+			entity.State, err = decodeRevenueWithdrawalState(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatRevenueTransactionTypeWithdrawal)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a refund for failed withdrawal of earnings
+type ChatRevenueTransactionTypeRefund struct {
+	meta
+	// Point in time (Unix timestamp) when the transaction was refunded
+	RefundDate int32 `json:"refund_date"`
+	// Name of the payment provider
+	Provider string `json:"provider"`
+}
+
+func (*ChatRevenueTransactionTypeRefund) GetType() string {
+	return TypeChatRevenueTransactionType
+}
+
+func (*ChatRevenueTransactionTypeRefund) GetConstructor() string {
+	return ConstructorChatRevenueTransactionTypeRefund
+}
+
+func (*ChatRevenueTransactionTypeRefund) ChatRevenueTransactionTypeConstructor() string {
+	return ConstructorChatRevenueTransactionTypeRefund
+}
+
+func (entity *ChatRevenueTransactionTypeRefund) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatRevenueTransactionTypeRefund) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatRevenueTransactionTypeRefund) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatRevenueTransactionTypeRefund"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"refund_date":`)
+	encoder.writeInt32(entity.RefundDate)
+	encoder.writeRaw(`,"provider":`)
+	encoder.writeString(entity.Provider)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatRevenueTransactionTypeRefund) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "refund_date":
+			entity.RefundDate, err = decoder.readInt32()
+
+		case "provider":
+			entity.Provider, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatRevenueTransactionTypeRefund)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a chat revenue transactions
+type ChatRevenueTransaction struct {
+	meta
+	// Cryptocurrency in which revenue is calculated
+	Cryptocurrency string `json:"cryptocurrency"`
+	// The withdrawn amount, in the smallest units of the cryptocurrency
+	CryptocurrencyAmount JsonInt64 `json:"cryptocurrency_amount"`
+	// Type of the transaction
+	Type ChatRevenueTransactionType `json:"type"`
+}
+
+func (*ChatRevenueTransaction) GetType() string {
+	return TypeChatRevenueTransaction
+}
+
+func (*ChatRevenueTransaction) GetConstructor() string {
+	return ConstructorChatRevenueTransaction
+}
+
+func (entity *ChatRevenueTransaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatRevenueTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatRevenueTransaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatRevenueTransaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"cryptocurrency":`)
+	encoder.writeString(entity.Cryptocurrency)
+	encoder.writeRaw(`,"cryptocurrency_amount":`)
+	encoder.writeJsonInt64(entity.CryptocurrencyAmount)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatRevenueTransaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "cryptocurrency":
+			entity.Cryptocurrency, err = decoder.readString()
+
+		case "cryptocurrency_amount":
+			entity.CryptocurrencyAmount, err = decoder.readJsonInt64()
+
+		case "type":
+			entity.Type, err = decodeChatRevenueTransactionType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatRevenueTransaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of chat revenue transactions
+type ChatRevenueTransactions struct {
+	meta
+	// Total number of transactions
+	TotalCount int32 `json:"total_count"`
+	// List of transactions
+	Transactions []*ChatRevenueTransaction `json:"transactions"`
+}
+
+func (*ChatRevenueTransactions) GetType() string {
+	return TypeChatRevenueTransactions
+}
+
+func (*ChatRevenueTransactions) GetConstructor() string {
+	return ConstructorChatRevenueTransactions
+}
+
+func (entity *ChatRevenueTransactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatRevenueTransactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatRevenueTransactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatRevenueTransactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"transactions":`)
+	writeList(encoder, entity.Transactions, writeTypeValue[*ChatRevenueTransaction])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatRevenueTransactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "transactions":
+			entity.Transactions, err = readList(decoder, decodeEntity[ChatRevenueTransaction])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatRevenueTransactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about Telegram Stars earned by a bot or a chat
+type StarRevenueStatus struct {
+	meta
+	// Total amount of Telegram Stars earned
+	TotalAmount *StarAmount `json:"total_amount"`
+	// The amount of Telegram Stars that aren't withdrawn yet
+	CurrentAmount *StarAmount `json:"current_amount"`
+	// The amount of Telegram Stars that are available for withdrawal
+	AvailableAmount *StarAmount `json:"available_amount"`
+	// True, if Telegram Stars can be withdrawn now or later
+	WithdrawalEnabled bool `json:"withdrawal_enabled"`
+	// Time left before the next withdrawal can be started, in seconds; 0 if withdrawal can be started now
+	NextWithdrawalIn int32 `json:"next_withdrawal_in"`
+}
+
+func (*StarRevenueStatus) GetType() string {
+	return TypeStarRevenueStatus
+}
+
+func (*StarRevenueStatus) GetConstructor() string {
+	return ConstructorStarRevenueStatus
+}
+
+func (entity *StarRevenueStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarRevenueStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarRevenueStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starRevenueStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_amount":`)
+	entity.TotalAmount.encodeJSON(encoder)
+	encoder.writeRaw(`,"current_amount":`)
+	entity.CurrentAmount.encodeJSON(encoder)
+	encoder.writeRaw(`,"available_amount":`)
+	entity.AvailableAmount.encodeJSON(encoder)
+	encoder.writeRaw(`,"withdrawal_enabled":`)
+	encoder.writeBool(entity.WithdrawalEnabled)
+	encoder.writeRaw(`,"next_withdrawal_in":`)
+	encoder.writeInt32(entity.NextWithdrawalIn)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarRevenueStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_amount":
+			entity.TotalAmount, err = decodeEntity[StarAmount](decoder)
+
+		case "current_amount":
+			entity.CurrentAmount, err = decodeEntity[StarAmount](decoder)
+
+		case "available_amount":
+			entity.AvailableAmount, err = decodeEntity[StarAmount](decoder)
+
+		case "withdrawal_enabled":
+			entity.WithdrawalEnabled, err = decoder.readBool()
+
+		case "next_withdrawal_in":
+			entity.NextWithdrawalIn, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarRevenueStatus)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A detailed statistics about Telegram Stars earned by a bot or a chat
+type StarRevenueStatistics struct {
+	meta
+	// A graph containing amount of revenue in a given day
+	RevenueByDayGraph StatisticalGraph `json:"revenue_by_day_graph"`
+	// Telegram Star revenue status
+	Status *StarRevenueStatus `json:"status"`
+	// Current conversion rate of a Telegram Star to USD
+	UsdRate float64 `json:"usd_rate"`
+}
+
+func (*StarRevenueStatistics) GetType() string {
+	return TypeStarRevenueStatistics
+}
+
+func (*StarRevenueStatistics) GetConstructor() string {
+	return ConstructorStarRevenueStatistics
+}
+
+func (entity *StarRevenueStatistics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StarRevenueStatistics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StarRevenueStatistics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"starRevenueStatistics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"revenue_by_day_graph":`)
+	encoder.writeType(entity.RevenueByDayGraph)
+	encoder.writeRaw(`,"status":`)
+	entity.Status.encodeJSON(encoder)
+	encoder.writeRaw(`,"usd_rate":`)
+	encoder.writeFloat64(entity.UsdRate)
+	encoder.writeRaw("}")
+}
+
+func (entity *StarRevenueStatistics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "revenue_by_day_graph":
+			entity.RevenueByDayGraph, err = decodeStatisticalGraph(decoder)
+
+		case "status":
+			entity.Status, err = decodeEntity[StarRevenueStatus](decoder)
+
+		case "usd_rate":
+			entity.UsdRate, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStarRevenueStatistics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,3987 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeStickerFormat                = "StickerFormat"
+	TypeStickerType                  = "StickerType"
+	TypeStickerFullType              = "StickerFullType"
+	TypeChatPhotoStickerType         = "ChatPhotoStickerType"
+	TypeEmojiStatusType              = "EmojiStatusType"
+	TypeReactionType                 = "ReactionType"
+	TypeReactionNotificationSource   = "ReactionNotificationSource"
+	TypeChatAvailableReactions       = "ChatAvailableReactions"
+	TypeEmojiCategorySource          = "EmojiCategorySource"
+	TypeEmojiCategoryType            = "EmojiCategoryType"
+	TypeReactionUnavailabilityReason = "ReactionUnavailabilityReason"
+	TypeDiceStickers                 = "DiceStickers"
+	TypeCheckStickerSetNameResult    = "CheckStickerSetNameResult"
+	TypeSticker                      = "Sticker"
+	TypeAnimatedEmoji                = "AnimatedEmoji"
+	TypeChatPhotoSticker             = "ChatPhotoSticker"
+	TypeEmojiStatus                  = "EmojiStatus"
+	TypeEmojiStatuses                = "EmojiStatuses"
+	TypeEmojiStatusCustomEmojis      = "EmojiStatusCustomEmojis"
+	TypeMessageReaction              = "MessageReaction"
+	TypeMessageReactions             = "MessageReactions"
+	TypeUnreadReaction               = "UnreadReaction"
+	TypeReactionNotificationSettings = "ReactionNotificationSettings"
+	TypeEmojiKeyword                 = "EmojiKeyword"
+	TypeEmojiKeywords                = "EmojiKeywords"
+	TypeStickers                     = "Stickers"
+	TypeEmojis                       = "Emojis"
+	TypeStickerSet                   = "StickerSet"
+	TypeStickerSetInfo               = "StickerSetInfo"
+	TypeStickerSets                  = "StickerSets"
+	TypeTrendingStickerSets          = "TrendingStickerSets"
+	TypeEmojiCategory                = "EmojiCategory"
+	TypeEmojiCategories              = "EmojiCategories"
+	TypeAddedReaction                = "AddedReaction"
+	TypeAddedReactions               = "AddedReactions"
+	TypeAvailableReaction            = "AvailableReaction"
+	TypeAvailableReactions           = "AvailableReactions"
+	TypeEmojiReaction                = "EmojiReaction"
+	TypeInputSticker                 = "InputSticker"
+)
+
+const (
+	ConstructorStickerFormatWebp                                  = "stickerFormatWebp"
+	ConstructorStickerFormatTgs                                   = "stickerFormatTgs"
+	ConstructorStickerFormatWebm                                  = "stickerFormatWebm"
+	ConstructorStickerTypeRegular                                 = "stickerTypeRegular"
+	ConstructorStickerTypeMask                                    = "stickerTypeMask"
+	ConstructorStickerTypeCustomEmoji                             = "stickerTypeCustomEmoji"
+	ConstructorStickerFullTypeRegular                             = "stickerFullTypeRegular"
+	ConstructorStickerFullTypeMask                                = "stickerFullTypeMask"
+	ConstructorStickerFullTypeCustomEmoji                         = "stickerFullTypeCustomEmoji"
+	ConstructorSticker                                            = "sticker"
+	ConstructorAnimatedEmoji                                      = "animatedEmoji"
+	ConstructorChatPhotoStickerTypeRegularOrMask                  = "chatPhotoStickerTypeRegularOrMask"
+	ConstructorChatPhotoStickerTypeCustomEmoji                    = "chatPhotoStickerTypeCustomEmoji"
+	ConstructorChatPhotoSticker                                   = "chatPhotoSticker"
+	ConstructorEmojiStatusTypeCustomEmoji                         = "emojiStatusTypeCustomEmoji"
+	ConstructorEmojiStatusTypeUpgradedGift                        = "emojiStatusTypeUpgradedGift"
+	ConstructorEmojiStatus                                        = "emojiStatus"
+	ConstructorEmojiStatuses                                      = "emojiStatuses"
+	ConstructorEmojiStatusCustomEmojis                            = "emojiStatusCustomEmojis"
+	ConstructorReactionTypeEmoji                                  = "reactionTypeEmoji"
+	ConstructorReactionTypeCustomEmoji                            = "reactionTypeCustomEmoji"
+	ConstructorReactionTypePaid                                   = "reactionTypePaid"
+	ConstructorMessageReaction                                    = "messageReaction"
+	ConstructorMessageReactions                                   = "messageReactions"
+	ConstructorUnreadReaction                                     = "unreadReaction"
+	ConstructorReactionNotificationSourceNone                     = "reactionNotificationSourceNone"
+	ConstructorReactionNotificationSourceContacts                 = "reactionNotificationSourceContacts"
+	ConstructorReactionNotificationSourceAll                      = "reactionNotificationSourceAll"
+	ConstructorReactionNotificationSettings                       = "reactionNotificationSettings"
+	ConstructorChatAvailableReactionsAll                          = "chatAvailableReactionsAll"
+	ConstructorChatAvailableReactionsSome                         = "chatAvailableReactionsSome"
+	ConstructorEmojiKeyword                                       = "emojiKeyword"
+	ConstructorEmojiKeywords                                      = "emojiKeywords"
+	ConstructorStickers                                           = "stickers"
+	ConstructorEmojis                                             = "emojis"
+	ConstructorStickerSet                                         = "stickerSet"
+	ConstructorStickerSetInfo                                     = "stickerSetInfo"
+	ConstructorStickerSets                                        = "stickerSets"
+	ConstructorTrendingStickerSets                                = "trendingStickerSets"
+	ConstructorEmojiCategorySourceSearch                          = "emojiCategorySourceSearch"
+	ConstructorEmojiCategorySourcePremium                         = "emojiCategorySourcePremium"
+	ConstructorEmojiCategory                                      = "emojiCategory"
+	ConstructorEmojiCategories                                    = "emojiCategories"
+	ConstructorEmojiCategoryTypeDefault                           = "emojiCategoryTypeDefault"
+	ConstructorEmojiCategoryTypeRegularStickers                   = "emojiCategoryTypeRegularStickers"
+	ConstructorEmojiCategoryTypeEmojiStatus                       = "emojiCategoryTypeEmojiStatus"
+	ConstructorEmojiCategoryTypeChatPhoto                         = "emojiCategoryTypeChatPhoto"
+	ConstructorAddedReaction                                      = "addedReaction"
+	ConstructorAddedReactions                                     = "addedReactions"
+	ConstructorAvailableReaction                                  = "availableReaction"
+	ConstructorAvailableReactions                                 = "availableReactions"
+	ConstructorEmojiReaction                                      = "emojiReaction"
+	ConstructorReactionUnavailabilityReasonAnonymousAdministrator = "reactionUnavailabilityReasonAnonymousAdministrator"
+	ConstructorReactionUnavailabilityReasonGuest                  = "reactionUnavailabilityReasonGuest"
+	ConstructorDiceStickersRegular                                = "diceStickersRegular"
+	ConstructorDiceStickersSlotMachine                            = "diceStickersSlotMachine"
+	ConstructorCheckStickerSetNameResultOk                        = "checkStickerSetNameResultOk"
+	ConstructorCheckStickerSetNameResultNameInvalid               = "checkStickerSetNameResultNameInvalid"
+	ConstructorCheckStickerSetNameResultNameOccupied              = "checkStickerSetNameResultNameOccupied"
+	ConstructorInputSticker                                       = "inputSticker"
+)
+
+// Describes format of a sticker
+type StickerFormat interface {
+	StickerFormatConstructor() string
+}
+
+// Describes type of sticker
+type StickerType interface {
+	StickerTypeConstructor() string
+}
+
+// Contains full information about sticker type
+type StickerFullType interface {
+	StickerFullTypeConstructor() string
+}
+
+// Describes type of sticker, which was used to create a chat photo
+type ChatPhotoStickerType interface {
+	ChatPhotoStickerTypeConstructor() string
+}
+
+// Describes type of emoji status
+type EmojiStatusType interface {
+	EmojiStatusTypeConstructor() string
+}
+
+// Describes type of message reaction
+type ReactionType interface {
+	ReactionTypeConstructor() string
+}
+
+// Describes sources of reactions for which notifications will be shown
+type ReactionNotificationSource interface {
+	ReactionNotificationSourceConstructor() string
+}
+
+// Describes reactions available in the chat
+type ChatAvailableReactions interface {
+	ChatAvailableReactionsConstructor() string
+}
+
+// Describes source of stickers for an emoji category
+type EmojiCategorySource interface {
+	EmojiCategorySourceConstructor() string
+}
+
+// Describes type of emoji category
+type EmojiCategoryType interface {
+	EmojiCategoryTypeConstructor() string
+}
+
+// Describes why the current user can't add reactions to the message, despite some other users can
+type ReactionUnavailabilityReason interface {
+	ReactionUnavailabilityReasonConstructor() string
+}
+
+// Contains animated stickers which must be used for dice animation rendering
+type DiceStickers interface {
+	DiceStickersConstructor() string
+}
+
+// Represents result of checking whether a name can be used for a new sticker set
+type CheckStickerSetNameResult interface {
+	CheckStickerSetNameResultConstructor() string
+}
+
+func (entity *UnknownType) StickerFormatConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StickerTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StickerFullTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatPhotoStickerTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) EmojiStatusTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReactionTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReactionNotificationSourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ChatAvailableReactionsConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) EmojiCategorySourceConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) EmojiCategoryTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReactionUnavailabilityReasonConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) DiceStickersConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CheckStickerSetNameResultConstructor() string {
+	return entity.Constructor
+}
+
+// The sticker is an image in WEBP format
+type StickerFormatWebp struct {
+	meta
+}
+
+func (*StickerFormatWebp) GetType() string {
+	return TypeStickerFormat
+}
+
+func (*StickerFormatWebp) GetConstructor() string {
+	return ConstructorStickerFormatWebp
+}
+
+func (*StickerFormatWebp) StickerFormatConstructor() string {
+	return ConstructorStickerFormatWebp
+}
+
+func (entity *StickerFormatWebp) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerFormatWebp) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerFormatWebp) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerFormatWebp"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerFormatWebp) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStickerFormatWebp)
+	})
+}
+
+// The sticker is an animation in TGS format
+type StickerFormatTgs struct {
+	meta
+}
+
+func (*StickerFormatTgs) GetType() string {
+	return TypeStickerFormat
+}
+
+func (*StickerFormatTgs) GetConstructor() string {
+	return ConstructorStickerFormatTgs
+}
+
+func (*StickerFormatTgs) StickerFormatConstructor() string {
+	return ConstructorStickerFormatTgs
+}
+
+func (entity *StickerFormatTgs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerFormatTgs) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerFormatTgs) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerFormatTgs"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerFormatTgs) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStickerFormatTgs)
+	})
+}
+
+// The sticker is a video in WEBM format
+type StickerFormatWebm struct {
+	meta
+}
+
+func (*StickerFormatWebm) GetType() string {
+	return TypeStickerFormat
+}
+
+func (*StickerFormatWebm) GetConstructor() string {
+	return ConstructorStickerFormatWebm
+}
+
+func (*StickerFormatWebm) StickerFormatConstructor() string {
+	return ConstructorStickerFormatWebm
+}
+
+func (entity *StickerFormatWebm) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerFormatWebm) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerFormatWebm) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerFormatWebm"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerFormatWebm) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStickerFormatWebm)
+	})
+}
+
+// The sticker is a regular sticker
+type StickerTypeRegular struct {
+	meta
+}
+
+func (*StickerTypeRegular) GetType() string {
+	return TypeStickerType
+}
+
+func (*StickerTypeRegular) GetConstructor() string {
+	return ConstructorStickerTypeRegular
+}
+
+func (*StickerTypeRegular) StickerTypeConstructor() string {
+	return ConstructorStickerTypeRegular
+}
+
+func (entity *StickerTypeRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerTypeRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerTypeRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerTypeRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerTypeRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStickerTypeRegular)
+	})
+}
+
+// The sticker is a mask in WEBP format to be placed on photos or videos
+type StickerTypeMask struct {
+	meta
+}
+
+func (*StickerTypeMask) GetType() string {
+	return TypeStickerType
+}
+
+func (*StickerTypeMask) GetConstructor() string {
+	return ConstructorStickerTypeMask
+}
+
+func (*StickerTypeMask) StickerTypeConstructor() string {
+	return ConstructorStickerTypeMask
+}
+
+func (entity *StickerTypeMask) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerTypeMask) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerTypeMask) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerTypeMask"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerTypeMask) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStickerTypeMask)
+	})
+}
+
+// The sticker is a custom emoji to be used inside message text and caption
+type StickerTypeCustomEmoji struct {
+	meta
+}
+
+func (*StickerTypeCustomEmoji) GetType() string {
+	return TypeStickerType
+}
+
+func (*StickerTypeCustomEmoji) GetConstructor() string {
+	return ConstructorStickerTypeCustomEmoji
+}
+
+func (*StickerTypeCustomEmoji) StickerTypeConstructor() string {
+	return ConstructorStickerTypeCustomEmoji
+}
+
+func (entity *StickerTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerTypeCustomEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerTypeCustomEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerTypeCustomEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerTypeCustomEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStickerTypeCustomEmoji)
+	})
+}
+
+// The sticker is a regular sticker
+type StickerFullTypeRegular struct {
+	meta
+	// Premium animation of the sticker; may be null. If present, only Telegram Premium users can use the sticker
+	PremiumAnimation *File `json:"premium_animation,omitempty"`
+}
+
+func (*StickerFullTypeRegular) GetType() string {
+	return TypeStickerFullType
+}
+
+func (*StickerFullTypeRegular) GetConstructor() string {
+	return ConstructorStickerFullTypeRegular
+}
+
+func (*StickerFullTypeRegular) StickerFullTypeConstructor() string {
+	return ConstructorStickerFullTypeRegular
+}
+
+func (entity *StickerFullTypeRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerFullTypeRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerFullTypeRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerFullTypeRegular"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.PremiumAnimation != nil {
+		encoder.writeRaw(`,"premium_animation":`)
+		entity.PremiumAnimation.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerFullTypeRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "premium_animation":
+			entity.PremiumAnimation, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStickerFullTypeRegular)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The sticker is a mask in WEBP format to be placed on photos or videos
+type StickerFullTypeMask struct {
+	meta
+	// Position where the mask is placed; may be null
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+}
+
+func (*StickerFullTypeMask) GetType() string {
+	return TypeStickerFullType
+}
+
+func (*StickerFullTypeMask) GetConstructor() string {
+	return ConstructorStickerFullTypeMask
+}
+
+func (*StickerFullTypeMask) StickerFullTypeConstructor() string {
+	return ConstructorStickerFullTypeMask
+}
+
+func (entity *StickerFullTypeMask) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerFullTypeMask) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerFullTypeMask) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerFullTypeMask"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.MaskPosition != nil {
+		encoder.writeRaw(`,"mask_position":`)
+		entity.MaskPosition.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerFullTypeMask) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "mask_position":
+			entity.MaskPosition, err = decodeEntity[MaskPosition](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStickerFullTypeMask)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The sticker is a custom emoji to be used inside message text and caption. Currently, only Telegram Premium users can use custom emoji
+type StickerFullTypeCustomEmoji struct {
+	meta
+	// Identifier of the custom emoji
+	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
+	// True, if the sticker must be repainted to a text color in messages, the color of the Telegram Premium badge in emoji status, white color on chat photos, or another appropriate color in other places
+	NeedsRepainting bool `json:"needs_repainting"`
+}
+
+func (*StickerFullTypeCustomEmoji) GetType() string {
+	return TypeStickerFullType
+}
+
+func (*StickerFullTypeCustomEmoji) GetConstructor() string {
+	return ConstructorStickerFullTypeCustomEmoji
+}
+
+func (*StickerFullTypeCustomEmoji) StickerFullTypeConstructor() string {
+	return ConstructorStickerFullTypeCustomEmoji
+}
+
+func (entity *StickerFullTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerFullTypeCustomEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerFullTypeCustomEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerFullTypeCustomEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.CustomEmojiId)
+	encoder.writeRaw(`,"needs_repainting":`)
+	encoder.writeBool(entity.NeedsRepainting)
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerFullTypeCustomEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_emoji_id":
+			entity.CustomEmojiId, err = decoder.readJsonInt64()
+
+		case "needs_repainting":
+			entity.NeedsRepainting, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStickerFullTypeCustomEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a sticker
+type Sticker struct {
+	meta
+	// Unique sticker identifier within the set; 0 if none
+	Id JsonInt64 `json:"id"`
+	// Identifier of the sticker set to which the sticker belongs; 0 if none
+	SetId JsonInt64 `json:"set_id"`
+	// Sticker width; as defined by the sender
+	Width int32 `json:"width"`
+	// Sticker height; as defined by the sender
+	Height int32 `json:"height"`
+	// Emoji corresponding to the sticker
+	Emoji string `json:"emoji"`
+	// Sticker format
+	Format StickerFormat `json:"format"`
+	// Sticker's full type
+	FullType StickerFullType `json:"full_type"`
+	// Sticker thumbnail in WEBP or JPEG format; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// File containing the sticker
+	Sticker *File `json:"sticker"`
+}
+
+func (*Sticker) GetType() string {
+	return TypeSticker
+}
+
+func (*Sticker) GetConstructor() string {
+	return ConstructorSticker
+}
+
+func (entity *Sticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Sticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Sticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"set_id":`)
+	encoder.writeJsonInt64(entity.SetId)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"format":`)
+	encoder.writeType(entity.Format)
+	encoder.writeRaw(`,"full_type":`)
+	encoder.writeType(entity.FullType)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *Sticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "set_id":
+			entity.SetId, err = decoder.readJsonInt64()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "format":
+			entity.Format, err = decodeStickerFormat(decoder)
+
+		case "full_type":
+			entity.FullType, err = decodeStickerFullType(decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an animated or custom representation of an emoji
+type AnimatedEmoji struct {
+	meta
+	// Sticker for the emoji; may be null if yet unknown for a custom emoji. If the sticker is a custom emoji, then it can have arbitrary format
+	Sticker *Sticker `json:"sticker,omitempty"`
+	// Expected width of the sticker, which can be used if the sticker is null
+	StickerWidth int32 `json:"sticker_width"`
+	// Expected height of the sticker, which can be used if the sticker is null
+	StickerHeight int32 `json:"sticker_height"`
+	// Emoji modifier fitzpatrick type; 0-6; 0 if none
+	FitzpatrickType int32 `json:"fitzpatrick_type"`
+	// File containing the sound to be played when the sticker is clicked; may be null. The sound is encoded with the Opus codec, and stored inside an OGG container
+	Sound *File `json:"sound,omitempty"`
+}
+
+func (*AnimatedEmoji) GetType() string {
+	return TypeAnimatedEmoji
+}
+
+func (*AnimatedEmoji) GetConstructor() string {
+	return ConstructorAnimatedEmoji
+}
+
+func (entity *AnimatedEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AnimatedEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AnimatedEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"animatedEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Sticker != nil {
+		encoder.writeRaw(`,"sticker":`)
+		entity.Sticker.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"sticker_width":`)
+	encoder.writeInt32(entity.StickerWidth)
+	encoder.writeRaw(`,"sticker_height":`)
+	encoder.writeInt32(entity.StickerHeight)
+	encoder.writeRaw(`,"fitzpatrick_type":`)
+	encoder.writeInt32(entity.FitzpatrickType)
+	if entity.Sound != nil {
+		encoder.writeRaw(`,"sound":`)
+		entity.Sound.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *AnimatedEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		case "sticker_width":
+			entity.StickerWidth, err = decoder.readInt32()
+
+		case "sticker_height":
+			entity.StickerHeight, err = decoder.readInt32()
+
+		case "fitzpatrick_type":
+			entity.FitzpatrickType, err = decoder.readInt32()
+
+		case "sound":
+			entity.Sound, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAnimatedEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about the sticker, which was used to create the chat photo
+type ChatPhotoStickerTypeRegularOrMask struct {
+	meta
+	// Sticker set identifier
+	StickerSetId JsonInt64 `json:"sticker_set_id"`
+	// Identifier of the sticker in the set
+	StickerId JsonInt64 `json:"sticker_id"`
+}
+
+func (*ChatPhotoStickerTypeRegularOrMask) GetType() string {
+	return TypeChatPhotoStickerType
+}
+
+func (*ChatPhotoStickerTypeRegularOrMask) GetConstructor() string {
+	return ConstructorChatPhotoStickerTypeRegularOrMask
+}
+
+func (*ChatPhotoStickerTypeRegularOrMask) ChatPhotoStickerTypeConstructor() string {
+	return ConstructorChatPhotoStickerTypeRegularOrMask
+}
+
+func (entity *ChatPhotoStickerTypeRegularOrMask) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPhotoStickerTypeRegularOrMask) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPhotoStickerTypeRegularOrMask) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPhotoStickerTypeRegularOrMask"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker_set_id":`)
+	encoder.writeJsonInt64(entity.StickerSetId)
+	encoder.writeRaw(`,"sticker_id":`)
+	encoder.writeJsonInt64(entity.StickerId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPhotoStickerTypeRegularOrMask) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker_set_id":
+			entity.StickerSetId, err = decoder.readJsonInt64()
+
+		case "sticker_id":
+			entity.StickerId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPhotoStickerTypeRegularOrMask)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about the custom emoji, which was used to create the chat photo
+type ChatPhotoStickerTypeCustomEmoji struct {
+	meta
+	// Identifier of the custom emoji
+	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
+}
+
+func (*ChatPhotoStickerTypeCustomEmoji) GetType() string {
+	return TypeChatPhotoStickerType
+}
+
+func (*ChatPhotoStickerTypeCustomEmoji) GetConstructor() string {
+	return ConstructorChatPhotoStickerTypeCustomEmoji
+}
+
+func (*ChatPhotoStickerTypeCustomEmoji) ChatPhotoStickerTypeConstructor() string {
+	return ConstructorChatPhotoStickerTypeCustomEmoji
+}
+
+func (entity *ChatPhotoStickerTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPhotoStickerTypeCustomEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPhotoStickerTypeCustomEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPhotoStickerTypeCustomEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.CustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPhotoStickerTypeCustomEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_emoji_id":
+			entity.CustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPhotoStickerTypeCustomEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about the sticker, which was used to create the chat photo. The sticker is shown at the center of the photo and occupies at most 67% of it
+type ChatPhotoSticker struct {
+	meta
+	// Type of the sticker
+	Type ChatPhotoStickerType `json:"type"`
+	// The fill to be used as background for the sticker; rotation angle in backgroundFillGradient isn't supported
+	BackgroundFill BackgroundFill `json:"background_fill"`
+}
+
+func (*ChatPhotoSticker) GetType() string {
+	return TypeChatPhotoSticker
+}
+
+func (*ChatPhotoSticker) GetConstructor() string {
+	return ConstructorChatPhotoSticker
+}
+
+func (entity *ChatPhotoSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatPhotoSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatPhotoSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatPhotoSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"background_fill":`)
+	encoder.writeType(entity.BackgroundFill)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatPhotoSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeChatPhotoStickerType(decoder)
+
+		case "background_fill":
+			entity.BackgroundFill, err = decodeBackgroundFill(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatPhotoSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A custom emoji set as emoji status
+type EmojiStatusTypeCustomEmoji struct {
+	meta
+	// Identifier of the custom emoji in stickerFormatTgs format
+	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
+}
+
+func (*EmojiStatusTypeCustomEmoji) GetType() string {
+	return TypeEmojiStatusType
+}
+
+func (*EmojiStatusTypeCustomEmoji) GetConstructor() string {
+	return ConstructorEmojiStatusTypeCustomEmoji
+}
+
+func (*EmojiStatusTypeCustomEmoji) EmojiStatusTypeConstructor() string {
+	return ConstructorEmojiStatusTypeCustomEmoji
+}
+
+func (entity *EmojiStatusTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiStatusTypeCustomEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiStatusTypeCustomEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiStatusTypeCustomEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.CustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiStatusTypeCustomEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_emoji_id":
+			entity.CustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiStatusTypeCustomEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An upgraded gift set as emoji status
+type EmojiStatusTypeUpgradedGift struct {
+	meta
+	// Identifier of the upgraded gift
+	UpgradedGiftId JsonInt64 `json:"upgraded_gift_id"`
+	// The title of the upgraded gift
+	GiftTitle string `json:"gift_title"`
+	// Unique name of the upgraded gift that can be used with internalLinkTypeUpgradedGift
+	GiftName string `json:"gift_name"`
+	// Custom emoji identifier of the model of the upgraded gift
+	ModelCustomEmojiId JsonInt64 `json:"model_custom_emoji_id"`
+	// Custom emoji identifier of the symbol of the upgraded gift
+	SymbolCustomEmojiId JsonInt64 `json:"symbol_custom_emoji_id"`
+	// Colors of the backdrop of the upgraded gift
+	BackdropColors *UpgradedGiftBackdropColors `json:"backdrop_colors"`
+}
+
+func (*EmojiStatusTypeUpgradedGift) GetType() string {
+	return TypeEmojiStatusType
+}
+
+func (*EmojiStatusTypeUpgradedGift) GetConstructor() string {
+	return ConstructorEmojiStatusTypeUpgradedGift
+}
+
+func (*EmojiStatusTypeUpgradedGift) EmojiStatusTypeConstructor() string {
+	return ConstructorEmojiStatusTypeUpgradedGift
+}
+
+func (entity *EmojiStatusTypeUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiStatusTypeUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiStatusTypeUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiStatusTypeUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"upgraded_gift_id":`)
+	encoder.writeJsonInt64(entity.UpgradedGiftId)
+	encoder.writeRaw(`,"gift_title":`)
+	encoder.writeString(entity.GiftTitle)
+	encoder.writeRaw(`,"gift_name":`)
+	encoder.writeString(entity.GiftName)
+	encoder.writeRaw(`,"model_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.ModelCustomEmojiId)
+	encoder.writeRaw(`,"symbol_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.SymbolCustomEmojiId)
+	encoder.writeRaw(`,"backdrop_colors":`)
+	entity.BackdropColors.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiStatusTypeUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "upgraded_gift_id":
+			entity.UpgradedGiftId, err = decoder.readJsonInt64()
+
+		case "gift_title":
+			entity.GiftTitle, err = decoder.readString()
+
+		case "gift_name":
+			entity.GiftName, err = decoder.readString()
+
+		case "model_custom_emoji_id":
+			entity.ModelCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "symbol_custom_emoji_id":
+			entity.SymbolCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "backdrop_colors":
+			entity.BackdropColors, err = decodeEntity[UpgradedGiftBackdropColors](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiStatusTypeUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes an emoji to be shown instead of the Telegram Premium badge
+type EmojiStatus struct {
+	meta
+	// Type of the emoji status
+	Type EmojiStatusType `json:"type"`
+	// Point in time (Unix timestamp) when the status will expire; 0 if never
+	ExpirationDate int32 `json:"expiration_date"`
+}
+
+func (*EmojiStatus) GetType() string {
+	return TypeEmojiStatus
+}
+
+func (*EmojiStatus) GetConstructor() string {
+	return ConstructorEmojiStatus
+}
+
+func (entity *EmojiStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"expiration_date":`)
+	encoder.writeInt32(entity.ExpirationDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeEmojiStatusType(decoder)
+
+		case "expiration_date":
+			entity.ExpirationDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiStatus)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of emoji statuses
+type EmojiStatuses struct {
+	meta
+	// The list of emoji statuses identifiers
+	EmojiStatuses []*EmojiStatus `json:"emoji_statuses"`
+}
+
+func (*EmojiStatuses) GetType() string {
+	return TypeEmojiStatuses
+}
+
+func (*EmojiStatuses) GetConstructor() string {
+	return ConstructorEmojiStatuses
+}
+
+func (entity *EmojiStatuses) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiStatuses) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiStatuses) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiStatuses"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emoji_statuses":`)
+	writeList(encoder, entity.EmojiStatuses, writeTypeValue[*EmojiStatus])
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiStatuses) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emoji_statuses":
+			entity.EmojiStatuses, err = readList(decoder, decodeEntity[EmojiStatus])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiStatuses)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of custom emoji identifiers for emoji statuses
+type EmojiStatusCustomEmojis struct {
+	meta
+	// The list of custom emoji identifiers
+	CustomEmojiIds []JsonInt64 `json:"custom_emoji_ids"`
+}
+
+func (*EmojiStatusCustomEmojis) GetType() string {
+	return TypeEmojiStatusCustomEmojis
+}
+
+func (*EmojiStatusCustomEmojis) GetConstructor() string {
+	return ConstructorEmojiStatusCustomEmojis
+}
+
+func (entity *EmojiStatusCustomEmojis) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiStatusCustomEmojis) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiStatusCustomEmojis) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiStatusCustomEmojis"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_emoji_ids":`)
+	writeList(encoder, entity.CustomEmojiIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiStatusCustomEmojis) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_emoji_ids":
+			entity.CustomEmojiIds, err = readList(decoder, (*jsonDecoder).readJsonInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiStatusCustomEmojis)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A reaction with an emoji
+type ReactionTypeEmoji struct {
+	meta
+	// Text representation of the reaction
+	Emoji string `json:"emoji"`
+}
+
+func (*ReactionTypeEmoji) GetType() string {
+	return TypeReactionType
+}
+
+func (*ReactionTypeEmoji) GetConstructor() string {
+	return ConstructorReactionTypeEmoji
+}
+
+func (*ReactionTypeEmoji) ReactionTypeConstructor() string {
+	return ConstructorReactionTypeEmoji
+}
+
+func (entity *ReactionTypeEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionTypeEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionTypeEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionTypeEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionTypeEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReactionTypeEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A reaction with a custom emoji
+type ReactionTypeCustomEmoji struct {
+	meta
+	// Unique identifier of the custom emoji
+	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
+}
+
+func (*ReactionTypeCustomEmoji) GetType() string {
+	return TypeReactionType
+}
+
+func (*ReactionTypeCustomEmoji) GetConstructor() string {
+	return ConstructorReactionTypeCustomEmoji
+}
+
+func (*ReactionTypeCustomEmoji) ReactionTypeConstructor() string {
+	return ConstructorReactionTypeCustomEmoji
+}
+
+func (entity *ReactionTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionTypeCustomEmoji) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionTypeCustomEmoji) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionTypeCustomEmoji"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.CustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionTypeCustomEmoji) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "custom_emoji_id":
+			entity.CustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReactionTypeCustomEmoji)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The paid reaction in a channel chat
+type ReactionTypePaid struct {
+	meta
+}
+
+func (*ReactionTypePaid) GetType() string {
+	return TypeReactionType
+}
+
+func (*ReactionTypePaid) GetConstructor() string {
+	return ConstructorReactionTypePaid
+}
+
+func (*ReactionTypePaid) ReactionTypeConstructor() string {
+	return ConstructorReactionTypePaid
+}
+
+func (entity *ReactionTypePaid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionTypePaid) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionTypePaid) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionTypePaid"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionTypePaid) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReactionTypePaid)
+	})
+}
+
+// Contains information about a reaction to a message
+type MessageReaction struct {
+	meta
+	// Type of the reaction
+	Type ReactionType `json:"type"`
+	// Number of times the reaction was added
+	TotalCount int32 `json:"total_count"`
+	// True, if the reaction is chosen by the current user
+	IsChosen bool `json:"is_chosen"`
+	// Identifier of the message sender used by the current user to add the reaction; may be null if unknown or the reaction isn't chosen
+	UsedSenderId MessageSender `json:"used_sender_id,omitempty"`
+	// Identifiers of at most 3 recent message senders, added the reaction; available in private, basic group and supergroup chats
+	RecentSenderIds []MessageSender `json:"recent_sender_ids"`
+}
+
+func (*MessageReaction) GetType() string {
+	return TypeMessageReaction
+}
+
+func (*MessageReaction) GetConstructor() string {
+	return ConstructorMessageReaction
+}
+
+func (entity *MessageReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"is_chosen":`)
+	encoder.writeBool(entity.IsChosen)
+	if entity.UsedSenderId != nil {
+		encoder.writeRaw(`,"used_sender_id":`)
+		encoder.writeType(entity.UsedSenderId)
+	}
+	encoder.writeRaw(`,"recent_sender_ids":`)
+	writeList(encoder, entity.RecentSenderIds, writeTypeValue[MessageSender])
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeReactionType(decoder)
+
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "is_chosen":
+			entity.IsChosen, err = decoder.readBool()
+
+		case "used_sender_id":
+			entity.UsedSenderId, err = decodeMessageSender(decoder)
+
+		case "recent_sender_ids":
+			entity.RecentSenderIds, err = readList(decoder, decodeMessageSender)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of reactions added to a message
+type MessageReactions struct {
+	meta
+	// List of added reactions
+	Reactions []*MessageReaction `json:"reactions"`
+	// True, if the reactions are tags and Telegram Premium users can filter messages by them
+	AreTags bool `json:"are_tags"`
+	// Information about top users that added the paid reaction
+	PaidReactors []*PaidReactor `json:"paid_reactors"`
+	// True, if the list of added reactions is available using getMessageAddedReactions
+	CanGetAddedReactions bool `json:"can_get_added_reactions"`
+}
+
+func (*MessageReactions) GetType() string {
+	return TypeMessageReactions
+}
+
+func (*MessageReactions) GetConstructor() string {
+	return ConstructorMessageReactions
+}
+
+func (entity *MessageReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *MessageReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *MessageReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"messageReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reactions":`)
+	writeList(encoder, entity.Reactions, writeTypeValue[*MessageReaction])
+	encoder.writeRaw(`,"are_tags":`)
+	encoder.writeBool(entity.AreTags)
+	encoder.writeRaw(`,"paid_reactors":`)
+	writeList(encoder, entity.PaidReactors, writeTypeValue[*PaidReactor])
+	encoder.writeRaw(`,"can_get_added_reactions":`)
+	encoder.writeBool(entity.CanGetAddedReactions)
+	encoder.writeRaw("}")
+}
+
+func (entity *MessageReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reactions":
+			entity.Reactions, err = readList(decoder, decodeEntity[MessageReaction])
+
+		case "are_tags":
+			entity.AreTags, err = decoder.readBool()
+
+		case "paid_reactors":
+			entity.PaidReactors, err = readList(decoder, decodeEntity[PaidReactor])
+
+		case "can_get_added_reactions":
+			entity.CanGetAddedReactions, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorMessageReactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an unread reaction to a message
+type UnreadReaction struct {
+	meta
+	// Type of the reaction
+	Type ReactionType `json:"type"`
+	// Identifier of the sender, added the reaction
+	SenderId MessageSender `json:"sender_id"`
+	// True, if the reaction was added with a big animation
+	IsBig bool `json:"is_big"`
+}
+
+func (*UnreadReaction) GetType() string {
+	return TypeUnreadReaction
+}
+
+func (*UnreadReaction) GetConstructor() string {
+	return ConstructorUnreadReaction
+}
+
+func (entity *UnreadReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UnreadReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UnreadReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"unreadReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"is_big":`)
+	encoder.writeBool(entity.IsBig)
+	encoder.writeRaw("}")
+}
+
+func (entity *UnreadReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeReactionType(decoder)
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "is_big":
+			entity.IsBig, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUnreadReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Notifications for reactions are disabled
+type ReactionNotificationSourceNone struct {
+	meta
+}
+
+func (*ReactionNotificationSourceNone) GetType() string {
+	return TypeReactionNotificationSource
+}
+
+func (*ReactionNotificationSourceNone) GetConstructor() string {
+	return ConstructorReactionNotificationSourceNone
+}
+
+func (*ReactionNotificationSourceNone) ReactionNotificationSourceConstructor() string {
+	return ConstructorReactionNotificationSourceNone
+}
+
+func (entity *ReactionNotificationSourceNone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionNotificationSourceNone) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionNotificationSourceNone) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionNotificationSourceNone"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionNotificationSourceNone) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReactionNotificationSourceNone)
+	})
+}
+
+// Notifications for reactions are shown only for reactions from contacts
+type ReactionNotificationSourceContacts struct {
+	meta
+}
+
+func (*ReactionNotificationSourceContacts) GetType() string {
+	return TypeReactionNotificationSource
+}
+
+func (*ReactionNotificationSourceContacts) GetConstructor() string {
+	return ConstructorReactionNotificationSourceContacts
+}
+
+func (*ReactionNotificationSourceContacts) ReactionNotificationSourceConstructor() string {
+	return ConstructorReactionNotificationSourceContacts
+}
+
+func (entity *ReactionNotificationSourceContacts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionNotificationSourceContacts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionNotificationSourceContacts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionNotificationSourceContacts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionNotificationSourceContacts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReactionNotificationSourceContacts)
+	})
+}
+
+// Notifications for reactions are shown for all reactions
+type ReactionNotificationSourceAll struct {
+	meta
+}
+
+func (*ReactionNotificationSourceAll) GetType() string {
+	return TypeReactionNotificationSource
+}
+
+func (*ReactionNotificationSourceAll) GetConstructor() string {
+	return ConstructorReactionNotificationSourceAll
+}
+
+func (*ReactionNotificationSourceAll) ReactionNotificationSourceConstructor() string {
+	return ConstructorReactionNotificationSourceAll
+}
+
+func (entity *ReactionNotificationSourceAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionNotificationSourceAll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionNotificationSourceAll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionNotificationSourceAll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionNotificationSourceAll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReactionNotificationSourceAll)
+	})
+}
+
+// Contains information about notification settings for reactions
+type ReactionNotificationSettings struct {
+	meta
+	// Source of message reactions for which notifications are shown
+	MessageReactionSource ReactionNotificationSource `json:"message_reaction_source"`
+	// Source of story reactions for which notifications are shown
+	StoryReactionSource ReactionNotificationSource `json:"story_reaction_source"`
+	// Identifier of the notification sound to be played; 0 if sound is disabled
+	SoundId JsonInt64 `json:"sound_id"`
+	// True, if reaction sender and emoji must be displayed in notifications
+	ShowPreview bool `json:"show_preview"`
+}
+
+func (*ReactionNotificationSettings) GetType() string {
+	return TypeReactionNotificationSettings
+}
+
+func (*ReactionNotificationSettings) GetConstructor() string {
+	return ConstructorReactionNotificationSettings
+}
+
+func (entity *ReactionNotificationSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionNotificationSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionNotificationSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionNotificationSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message_reaction_source":`)
+	encoder.writeType(entity.MessageReactionSource)
+	encoder.writeRaw(`,"story_reaction_source":`)
+	encoder.writeType(entity.StoryReactionSource)
+	encoder.writeRaw(`,"sound_id":`)
+	encoder.writeJsonInt64(entity.SoundId)
+	encoder.writeRaw(`,"show_preview":`)
+	encoder.writeBool(entity.ShowPreview)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionNotificationSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message_reaction_source":
+			entity.MessageReactionSource, err = decodeReactionNotificationSource(decoder)
+
+		case "story_reaction_source":
+			entity.StoryReactionSource, err = decodeReactionNotificationSource(decoder)
+
+		case "sound_id":
+			entity.SoundId, err = decoder.readJsonInt64()
+
+		case "show_preview":
+			entity.ShowPreview, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReactionNotificationSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// All reactions are available in the chat, excluding the paid reaction and custom reactions in channel chats
+type ChatAvailableReactionsAll struct {
+	meta
+	// The maximum allowed number of reactions per message; 1-11
+	MaxReactionCount int32 `json:"max_reaction_count"`
+}
+
+func (*ChatAvailableReactionsAll) GetType() string {
+	return TypeChatAvailableReactions
+}
+
+func (*ChatAvailableReactionsAll) GetConstructor() string {
+	return ConstructorChatAvailableReactionsAll
+}
+
+func (*ChatAvailableReactionsAll) ChatAvailableReactionsConstructor() string {
+	return ConstructorChatAvailableReactionsAll
+}
+
+func (entity *ChatAvailableReactionsAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatAvailableReactionsAll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatAvailableReactionsAll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatAvailableReactionsAll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"max_reaction_count":`)
+	encoder.writeInt32(entity.MaxReactionCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatAvailableReactionsAll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "max_reaction_count":
+			entity.MaxReactionCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatAvailableReactionsAll)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Only specific reactions are available in the chat
+type ChatAvailableReactionsSome struct {
+	meta
+	// The list of reactions
+	Reactions []ReactionType `json:"reactions"`
+	// The maximum allowed number of reactions per message; 1-11
+	MaxReactionCount int32 `json:"max_reaction_count"`
+}
+
+func (*ChatAvailableReactionsSome) GetType() string {
+	return TypeChatAvailableReactions
+}
+
+func (*ChatAvailableReactionsSome) GetConstructor() string {
+	return ConstructorChatAvailableReactionsSome
+}
+
+func (*ChatAvailableReactionsSome) ChatAvailableReactionsConstructor() string {
+	return ConstructorChatAvailableReactionsSome
+}
+
+func (entity *ChatAvailableReactionsSome) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatAvailableReactionsSome) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatAvailableReactionsSome) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatAvailableReactionsSome"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reactions":`)
+	writeList(encoder, entity.Reactions, writeTypeValue[ReactionType])
+	encoder.writeRaw(`,"max_reaction_count":`)
+	encoder.writeInt32(entity.MaxReactionCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatAvailableReactionsSome) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reactions":
+			entity.Reactions, err = readList(decoder, decodeReactionType)
+
+		case "max_reaction_count":
+			entity.MaxReactionCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatAvailableReactionsSome)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an emoji with its keyword
+type EmojiKeyword struct {
+	meta
+	// The emoji
+	Emoji string `json:"emoji"`
+	// The keyword
+	Keyword string `json:"keyword"`
+}
+
+func (*EmojiKeyword) GetType() string {
+	return TypeEmojiKeyword
+}
+
+func (*EmojiKeyword) GetConstructor() string {
+	return ConstructorEmojiKeyword
+}
+
+func (entity *EmojiKeyword) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiKeyword) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiKeyword) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiKeyword"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"keyword":`)
+	encoder.writeString(entity.Keyword)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiKeyword) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "keyword":
+			entity.Keyword, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiKeyword)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of emojis with their keywords
+type EmojiKeywords struct {
+	meta
+	// List of emojis with their keywords
+	EmojiKeywords []*EmojiKeyword `json:"emoji_keywords"`
+}
+
+func (*EmojiKeywords) GetType() string {
+	return TypeEmojiKeywords
+}
+
+func (*EmojiKeywords) GetConstructor() string {
+	return ConstructorEmojiKeywords
+}
+
+func (entity *EmojiKeywords) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiKeywords) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiKeywords) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiKeywords"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emoji_keywords":`)
+	writeList(encoder, entity.EmojiKeywords, writeTypeValue[*EmojiKeyword])
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiKeywords) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emoji_keywords":
+			entity.EmojiKeywords, err = readList(decoder, decodeEntity[EmojiKeyword])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiKeywords)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of stickers
+type Stickers struct {
+	meta
+	// List of stickers
+	Stickers []*Sticker `json:"stickers"`
+}
+
+func (*Stickers) GetType() string {
+	return TypeStickers
+}
+
+func (*Stickers) GetConstructor() string {
+	return ConstructorStickers
+}
+
+func (entity *Stickers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Stickers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Stickers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"stickers":`)
+	writeList(encoder, entity.Stickers, writeTypeValue[*Sticker])
+	encoder.writeRaw("}")
+}
+
+func (entity *Stickers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "stickers":
+			entity.Stickers, err = readList(decoder, decodeEntity[Sticker])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStickers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of emojis
+type Emojis struct {
+	meta
+	// List of emojis
+	Emojis []string `json:"emojis"`
+}
+
+func (*Emojis) GetType() string {
+	return TypeEmojis
+}
+
+func (*Emojis) GetConstructor() string {
+	return ConstructorEmojis
+}
+
+func (entity *Emojis) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Emojis) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Emojis) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojis"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emojis":`)
+	writeList(encoder, entity.Emojis, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *Emojis) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emojis":
+			entity.Emojis, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojis)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a sticker set
+type StickerSet struct {
+	meta
+	// Identifier of the sticker set
+	Id JsonInt64 `json:"id"`
+	// Title of the sticker set
+	Title string `json:"title"`
+	// Name of the sticker set
+	Name string `json:"name"`
+	// Sticker set thumbnail in WEBP, TGS, or WEBM format with width and height 100; may be null. The file can be downloaded only before the thumbnail is changed
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// Sticker set thumbnail's outline; may be null if unknown
+	ThumbnailOutline *Outline `json:"thumbnail_outline,omitempty"`
+	// True, if the sticker set is owned by the current user
+	IsOwned bool `json:"is_owned"`
+	// True, if the sticker set has been installed by the current user
+	IsInstalled bool `json:"is_installed"`
+	// True, if the sticker set has been archived. A sticker set can't be installed and archived simultaneously
+	IsArchived bool `json:"is_archived"`
+	// True, if the sticker set is official
+	IsOfficial bool `json:"is_official"`
+	// Type of the stickers in the set
+	StickerType StickerType `json:"sticker_type"`
+	// True, if stickers in the sticker set are custom emoji that must be repainted; for custom emoji sticker sets only
+	NeedsRepainting bool `json:"needs_repainting"`
+	// True, if stickers in the sticker set are custom emoji that can be used as chat emoji status; for custom emoji sticker sets only
+	IsAllowedAsChatEmojiStatus bool `json:"is_allowed_as_chat_emoji_status"`
+	// True for already viewed trending sticker sets
+	IsViewed bool `json:"is_viewed"`
+	// List of stickers in this set
+	Stickers []*Sticker `json:"stickers"`
+	// A list of emojis corresponding to the stickers in the same order. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object
+	Emojis []*Emojis `json:"emojis"`
+}
+
+func (*StickerSet) GetType() string {
+	return TypeStickerSet
+}
+
+func (*StickerSet) GetConstructor() string {
+	return ConstructorStickerSet
+}
+
+func (entity *StickerSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerSet) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerSet) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerSet"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	if entity.ThumbnailOutline != nil {
+		encoder.writeRaw(`,"thumbnail_outline":`)
+		entity.ThumbnailOutline.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_owned":`)
+	encoder.writeBool(entity.IsOwned)
+	encoder.writeRaw(`,"is_installed":`)
+	encoder.writeBool(entity.IsInstalled)
+	encoder.writeRaw(`,"is_archived":`)
+	encoder.writeBool(entity.IsArchived)
+	encoder.writeRaw(`,"is_official":`)
+	encoder.writeBool(entity.IsOfficial)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(entity.StickerType)
+	encoder.writeRaw(`,"needs_repainting":`)
+	encoder.writeBool(entity.NeedsRepainting)
+	encoder.writeRaw(`,"is_allowed_as_chat_emoji_status":`)
+	encoder.writeBool(entity.IsAllowedAsChatEmojiStatus)
+	encoder.writeRaw(`,"is_viewed":`)
+	encoder.writeBool(entity.IsViewed)
+	encoder.writeRaw(`,"stickers":`)
+	writeList(encoder, entity.Stickers, writeTypeValue[*Sticker])
+	encoder.writeRaw(`,"emojis":`)
+	writeList(encoder, entity.Emojis, writeTypeValue[*Emojis])
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerSet) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "thumbnail_outline":
+			entity.ThumbnailOutline, err = decodeEntity[Outline](decoder)
+
+		case "is_owned":
+			entity.IsOwned, err = decoder.readBool()
+
+		case "is_installed":
+			entity.IsInstalled, err = decoder.readBool()
+
+		case "is_archived":
+			entity.IsArchived, err = decoder.readBool()
+
+		case "is_official":
+			entity.IsOfficial, err = decoder.readBool()
+
+		case "sticker_type":
+			entity.StickerType, err = decodeStickerType(decoder)
+
+		case "needs_repainting":
+			entity.NeedsRepainting, err = decoder.readBool()
+
+		case "is_allowed_as_chat_emoji_status":
+			entity.IsAllowedAsChatEmojiStatus, err = decoder.readBool()
+
+		case "is_viewed":
+			entity.IsViewed, err = decoder.readBool()
+
+		case "stickers":
+			entity.Stickers, err = readList(decoder, decodeEntity[Sticker])
+
+		case "emojis":
+			entity.Emojis, err = readList(decoder, decodeEntity[Emojis])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStickerSet)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents short information about a sticker set
+type StickerSetInfo struct {
+	meta
+	// Identifier of the sticker set
+	Id JsonInt64 `json:"id"`
+	// Title of the sticker set
+	Title string `json:"title"`
+	// Name of the sticker set
+	Name string `json:"name"`
+	// Sticker set thumbnail in WEBP, TGS, or WEBM format with width and height 100; may be null. The file can be downloaded only before the thumbnail is changed
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// Sticker set thumbnail's outline; may be null if unknown
+	ThumbnailOutline *Outline `json:"thumbnail_outline,omitempty"`
+	// True, if the sticker set is owned by the current user
+	IsOwned bool `json:"is_owned"`
+	// True, if the sticker set has been installed by the current user
+	IsInstalled bool `json:"is_installed"`
+	// True, if the sticker set has been archived. A sticker set can't be installed and archived simultaneously
+	IsArchived bool `json:"is_archived"`
+	// True, if the sticker set is official
+	IsOfficial bool `json:"is_official"`
+	// Type of the stickers in the set
+	StickerType StickerType `json:"sticker_type"`
+	// True, if stickers in the sticker set are custom emoji that must be repainted; for custom emoji sticker sets only
+	NeedsRepainting bool `json:"needs_repainting"`
+	// True, if stickers in the sticker set are custom emoji that can be used as chat emoji status; for custom emoji sticker sets only
+	IsAllowedAsChatEmojiStatus bool `json:"is_allowed_as_chat_emoji_status"`
+	// True for already viewed trending sticker sets
+	IsViewed bool `json:"is_viewed"`
+	// Total number of stickers in the set
+	Size int32 `json:"size"`
+	// Up to the first 5 stickers from the set, depending on the context. If the application needs more stickers the full sticker set needs to be requested
+	Covers []*Sticker `json:"covers"`
+}
+
+func (*StickerSetInfo) GetType() string {
+	return TypeStickerSetInfo
+}
+
+func (*StickerSetInfo) GetConstructor() string {
+	return ConstructorStickerSetInfo
+}
+
+func (entity *StickerSetInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerSetInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerSetInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerSetInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	if entity.ThumbnailOutline != nil {
+		encoder.writeRaw(`,"thumbnail_outline":`)
+		entity.ThumbnailOutline.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_owned":`)
+	encoder.writeBool(entity.IsOwned)
+	encoder.writeRaw(`,"is_installed":`)
+	encoder.writeBool(entity.IsInstalled)
+	encoder.writeRaw(`,"is_archived":`)
+	encoder.writeBool(entity.IsArchived)
+	encoder.writeRaw(`,"is_official":`)
+	encoder.writeBool(entity.IsOfficial)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(entity.StickerType)
+	encoder.writeRaw(`,"needs_repainting":`)
+	encoder.writeBool(entity.NeedsRepainting)
+	encoder.writeRaw(`,"is_allowed_as_chat_emoji_status":`)
+	encoder.writeBool(entity.IsAllowedAsChatEmojiStatus)
+	encoder.writeRaw(`,"is_viewed":`)
+	encoder.writeBool(entity.IsViewed)
+	encoder.writeRaw(`,"size":`)
+	encoder.writeInt32(entity.Size)
+	encoder.writeRaw(`,"covers":`)
+	writeList(encoder, entity.Covers, writeTypeValue[*Sticker])
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerSetInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "thumbnail_outline":
+			entity.ThumbnailOutline, err = decodeEntity[Outline](decoder)
+
+		case "is_owned":
+			entity.IsOwned, err = decoder.readBool()
+
+		case "is_installed":
+			entity.IsInstalled, err = decoder.readBool()
+
+		case "is_archived":
+			entity.IsArchived, err = decoder.readBool()
+
+		case "is_official":
+			entity.IsOfficial, err = decoder.readBool()
+
+		case "sticker_type":
+			entity.StickerType, err = decodeStickerType(decoder)
+
+		case "needs_repainting":
+			entity.NeedsRepainting, err = decoder.readBool()
+
+		case "is_allowed_as_chat_emoji_status":
+			entity.IsAllowedAsChatEmojiStatus, err = decoder.readBool()
+
+		case "is_viewed":
+			entity.IsViewed, err = decoder.readBool()
+
+		case "size":
+			entity.Size, err = decoder.readInt32()
+
+		case "covers":
+			entity.Covers, err = readList(decoder, decodeEntity[Sticker])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStickerSetInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of sticker sets
+type StickerSets struct {
+	meta
+	// Approximate total number of sticker sets found
+	TotalCount int32 `json:"total_count"`
+	// List of sticker sets
+	Sets []*StickerSetInfo `json:"sets"`
+}
+
+func (*StickerSets) GetType() string {
+	return TypeStickerSets
+}
+
+func (*StickerSets) GetConstructor() string {
+	return ConstructorStickerSets
+}
+
+func (entity *StickerSets) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StickerSets) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StickerSets) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stickerSets"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"sets":`)
+	writeList(encoder, entity.Sets, writeTypeValue[*StickerSetInfo])
+	encoder.writeRaw("}")
+}
+
+func (entity *StickerSets) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "sets":
+			entity.Sets, err = readList(decoder, decodeEntity[StickerSetInfo])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStickerSets)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of trending sticker sets
+type TrendingStickerSets struct {
+	meta
+	// Approximate total number of trending sticker sets
+	TotalCount int32 `json:"total_count"`
+	// List of trending sticker sets
+	Sets []*StickerSetInfo `json:"sets"`
+	// True, if the list contains sticker sets with premium stickers
+	IsPremium bool `json:"is_premium"`
+}
+
+func (*TrendingStickerSets) GetType() string {
+	return TypeTrendingStickerSets
+}
+
+func (*TrendingStickerSets) GetConstructor() string {
+	return ConstructorTrendingStickerSets
+}
+
+func (entity *TrendingStickerSets) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *TrendingStickerSets) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *TrendingStickerSets) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"trendingStickerSets"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"sets":`)
+	writeList(encoder, entity.Sets, writeTypeValue[*StickerSetInfo])
+	encoder.writeRaw(`,"is_premium":`)
+	encoder.writeBool(entity.IsPremium)
+	encoder.writeRaw("}")
+}
+
+func (entity *TrendingStickerSets) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "sets":
+			entity.Sets, err = readList(decoder, decodeEntity[StickerSetInfo])
+
+		case "is_premium":
+			entity.IsPremium, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorTrendingStickerSets)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The category contains a list of similar emoji to search for in getStickers and searchStickers for stickers, or getInlineQueryResults with the bot getOption("animation_search_bot_username") for animations
+type EmojiCategorySourceSearch struct {
+	meta
+	// List of emojis to search for
+	Emojis []string `json:"emojis"`
+}
+
+func (*EmojiCategorySourceSearch) GetType() string {
+	return TypeEmojiCategorySource
+}
+
+func (*EmojiCategorySourceSearch) GetConstructor() string {
+	return ConstructorEmojiCategorySourceSearch
+}
+
+func (*EmojiCategorySourceSearch) EmojiCategorySourceConstructor() string {
+	return ConstructorEmojiCategorySourceSearch
+}
+
+func (entity *EmojiCategorySourceSearch) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategorySourceSearch) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategorySourceSearch) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategorySourceSearch"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emojis":`)
+	writeList(encoder, entity.Emojis, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategorySourceSearch) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emojis":
+			entity.Emojis, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiCategorySourceSearch)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The category contains premium stickers that must be found by getPremiumStickers
+type EmojiCategorySourcePremium struct {
+	meta
+}
+
+func (*EmojiCategorySourcePremium) GetType() string {
+	return TypeEmojiCategorySource
+}
+
+func (*EmojiCategorySourcePremium) GetConstructor() string {
+	return ConstructorEmojiCategorySourcePremium
+}
+
+func (*EmojiCategorySourcePremium) EmojiCategorySourceConstructor() string {
+	return ConstructorEmojiCategorySourcePremium
+}
+
+func (entity *EmojiCategorySourcePremium) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategorySourcePremium) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategorySourcePremium) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategorySourcePremium"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategorySourcePremium) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorEmojiCategorySourcePremium)
+	})
+}
+
+// Describes an emoji category
+type EmojiCategory struct {
+	meta
+	// Name of the category
+	Name string `json:"name"`
+	// Custom emoji sticker, which represents icon of the category
+	Icon *Sticker `json:"icon"`
+	// Source of stickers for the emoji category
+	Source EmojiCategorySource `json:"source"`
+	// True, if the category must be shown first when choosing a sticker for the start page
+	IsGreeting bool `json:"is_greeting"`
+}
+
+func (*EmojiCategory) GetType() string {
+	return TypeEmojiCategory
+}
+
+func (*EmojiCategory) GetConstructor() string {
+	return ConstructorEmojiCategory
+}
+
+func (entity *EmojiCategory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"icon":`)
+	entity.Icon.encodeJSON(encoder)
+	encoder.writeRaw(`,"source":`)
+	encoder.writeType(entity.Source)
+	encoder.writeRaw(`,"is_greeting":`)
+	encoder.writeBool(entity.IsGreeting)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "icon":
+			entity.Icon, err = decodeEntity[Sticker](decoder)
+
+		case "source":
+			entity.Source, err = decodeEmojiCategorySource(decoder)
+
+		case "is_greeting":
+			entity.IsGreeting, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiCategory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of emoji categories
+type EmojiCategories struct {
+	meta
+	// List of categories
+	Categories []*EmojiCategory `json:"categories"`
+}
+
+func (*EmojiCategories) GetType() string {
+	return TypeEmojiCategories
+}
+
+func (*EmojiCategories) GetConstructor() string {
+	return ConstructorEmojiCategories
+}
+
+func (entity *EmojiCategories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"categories":`)
+	writeList(encoder, entity.Categories, writeTypeValue[*EmojiCategory])
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "categories":
+			entity.Categories, err = readList(decoder, decodeEntity[EmojiCategory])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiCategories)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The category must be used by default (e.g., for custom emoji or animation search)
+type EmojiCategoryTypeDefault struct {
+	meta
+}
+
+func (*EmojiCategoryTypeDefault) GetType() string {
+	return TypeEmojiCategoryType
+}
+
+func (*EmojiCategoryTypeDefault) GetConstructor() string {
+	return ConstructorEmojiCategoryTypeDefault
+}
+
+func (*EmojiCategoryTypeDefault) EmojiCategoryTypeConstructor() string {
+	return ConstructorEmojiCategoryTypeDefault
+}
+
+func (entity *EmojiCategoryTypeDefault) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategoryTypeDefault) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategoryTypeDefault) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategoryTypeDefault"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategoryTypeDefault) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorEmojiCategoryTypeDefault)
+	})
+}
+
+// The category must be used by default for regular sticker selection. It may contain greeting emoji category and premium stickers
+type EmojiCategoryTypeRegularStickers struct {
+	meta
+}
+
+func (*EmojiCategoryTypeRegularStickers) GetType() string {
+	return TypeEmojiCategoryType
+}
+
+func (*EmojiCategoryTypeRegularStickers) GetConstructor() string {
+	return ConstructorEmojiCategoryTypeRegularStickers
+}
+
+func (*EmojiCategoryTypeRegularStickers) EmojiCategoryTypeConstructor() string {
+	return ConstructorEmojiCategoryTypeRegularStickers
+}
+
+func (entity *EmojiCategoryTypeRegularStickers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategoryTypeRegularStickers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategoryTypeRegularStickers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategoryTypeRegularStickers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategoryTypeRegularStickers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorEmojiCategoryTypeRegularStickers)
+	})
+}
+
+// The category must be used for emoji status selection
+type EmojiCategoryTypeEmojiStatus struct {
+	meta
+}
+
+func (*EmojiCategoryTypeEmojiStatus) GetType() string {
+	return TypeEmojiCategoryType
+}
+
+func (*EmojiCategoryTypeEmojiStatus) GetConstructor() string {
+	return ConstructorEmojiCategoryTypeEmojiStatus
+}
+
+func (*EmojiCategoryTypeEmojiStatus) EmojiCategoryTypeConstructor() string {
+	return ConstructorEmojiCategoryTypeEmojiStatus
+}
+
+func (entity *EmojiCategoryTypeEmojiStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategoryTypeEmojiStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategoryTypeEmojiStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategoryTypeEmojiStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategoryTypeEmojiStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorEmojiCategoryTypeEmojiStatus)
+	})
+}
+
+// The category must be used for chat photo emoji selection
+type EmojiCategoryTypeChatPhoto struct {
+	meta
+}
+
+func (*EmojiCategoryTypeChatPhoto) GetType() string {
+	return TypeEmojiCategoryType
+}
+
+func (*EmojiCategoryTypeChatPhoto) GetConstructor() string {
+	return ConstructorEmojiCategoryTypeChatPhoto
+}
+
+func (*EmojiCategoryTypeChatPhoto) EmojiCategoryTypeConstructor() string {
+	return ConstructorEmojiCategoryTypeChatPhoto
+}
+
+func (entity *EmojiCategoryTypeChatPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiCategoryTypeChatPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiCategoryTypeChatPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiCategoryTypeChatPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiCategoryTypeChatPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorEmojiCategoryTypeChatPhoto)
+	})
+}
+
+// Represents a reaction applied to a message
+type AddedReaction struct {
+	meta
+	// Type of the reaction
+	Type ReactionType `json:"type"`
+	// Identifier of the chat member, applied the reaction
+	SenderId MessageSender `json:"sender_id"`
+	// True, if the reaction was added by the current user
+	IsOutgoing bool `json:"is_outgoing"`
+	// Point in time (Unix timestamp) when the reaction was added
+	Date int32 `json:"date"`
+}
+
+func (*AddedReaction) GetType() string {
+	return TypeAddedReaction
+}
+
+func (*AddedReaction) GetConstructor() string {
+	return ConstructorAddedReaction
+}
+
+func (entity *AddedReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AddedReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AddedReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addedReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"is_outgoing":`)
+	encoder.writeBool(entity.IsOutgoing)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw("}")
+}
+
+func (entity *AddedReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeReactionType(decoder)
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "is_outgoing":
+			entity.IsOutgoing, err = decoder.readBool()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAddedReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of reactions added to a message
+type AddedReactions struct {
+	meta
+	// The total number of found reactions
+	TotalCount int32 `json:"total_count"`
+	// The list of added reactions
+	Reactions []*AddedReaction `json:"reactions"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*AddedReactions) GetType() string {
+	return TypeAddedReactions
+}
+
+func (*AddedReactions) GetConstructor() string {
+	return ConstructorAddedReactions
+}
+
+func (entity *AddedReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AddedReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AddedReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"addedReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"reactions":`)
+	writeList(encoder, entity.Reactions, writeTypeValue[*AddedReaction])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *AddedReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "reactions":
+			entity.Reactions, err = readList(decoder, decodeEntity[AddedReaction])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAddedReactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents an available reaction
+type AvailableReaction struct {
+	meta
+	// Type of the reaction
+	Type ReactionType `json:"type"`
+	// True, if Telegram Premium is needed to send the reaction
+	NeedsPremium bool `json:"needs_premium"`
+}
+
+func (*AvailableReaction) GetType() string {
+	return TypeAvailableReaction
+}
+
+func (*AvailableReaction) GetConstructor() string {
+	return ConstructorAvailableReaction
+}
+
+func (entity *AvailableReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AvailableReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AvailableReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"availableReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"needs_premium":`)
+	encoder.writeBool(entity.NeedsPremium)
+	encoder.writeRaw("}")
+}
+
+func (entity *AvailableReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodeReactionType(decoder)
+
+		case "needs_premium":
+			entity.NeedsPremium, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAvailableReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of reactions that can be added to a message
+type AvailableReactions struct {
+	meta
+	// List of reactions to be shown at the top
+	TopReactions []*AvailableReaction `json:"top_reactions"`
+	// List of recently used reactions
+	RecentReactions []*AvailableReaction `json:"recent_reactions"`
+	// List of popular reactions
+	PopularReactions []*AvailableReaction `json:"popular_reactions"`
+	// True, if any custom emoji reaction can be added by Telegram Premium subscribers
+	AllowCustomEmoji bool `json:"allow_custom_emoji"`
+	// True, if the reactions will be tags and the message can be found by them
+	AreTags bool `json:"are_tags"`
+	// The reason why the current user can't add reactions to the message, despite some other users can; may be null if none
+	UnavailabilityReason ReactionUnavailabilityReason `json:"unavailability_reason,omitempty"`
+}
+
+func (*AvailableReactions) GetType() string {
+	return TypeAvailableReactions
+}
+
+func (*AvailableReactions) GetConstructor() string {
+	return ConstructorAvailableReactions
+}
+
+func (entity *AvailableReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *AvailableReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *AvailableReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"availableReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"top_reactions":`)
+	writeList(encoder, entity.TopReactions, writeTypeValue[*AvailableReaction])
+	encoder.writeRaw(`,"recent_reactions":`)
+	writeList(encoder, entity.RecentReactions, writeTypeValue[*AvailableReaction])
+	encoder.writeRaw(`,"popular_reactions":`)
+	writeList(encoder, entity.PopularReactions, writeTypeValue[*AvailableReaction])
+	encoder.writeRaw(`,"allow_custom_emoji":`)
+	encoder.writeBool(entity.AllowCustomEmoji)
+	encoder.writeRaw(`,"are_tags":`)
+	encoder.writeBool(entity.AreTags)
+	if entity.UnavailabilityReason != nil {
+		encoder.writeRaw(`,"unavailability_reason":`)
+		encoder.writeType(entity.UnavailabilityReason)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *AvailableReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "top_reactions":
+			entity.TopReactions, err = readList(decoder, decodeEntity[AvailableReaction])
+
+		case "recent_reactions":
+			entity.RecentReactions, err = readList(decoder, decodeEntity[AvailableReaction])
+
+		case "popular_reactions":
+			entity.PopularReactions, err = readList(decoder, decodeEntity[AvailableReaction])
+
+		case "allow_custom_emoji":
+			entity.AllowCustomEmoji, err = decoder.readBool()
+
+		case "are_tags":
+			entity.AreTags, err = decoder.readBool()
+
+		case "unavailability_reason":
+			entity.UnavailabilityReason, err = decodeReactionUnavailabilityReason(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorAvailableReactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about an emoji reaction
+type EmojiReaction struct {
+	meta
+	// Text representation of the reaction
+	Emoji string `json:"emoji"`
+	// Reaction title
+	Title string `json:"title"`
+	// True, if the reaction can be added to new messages and enabled in chats
+	IsActive bool `json:"is_active"`
+	// Static icon for the reaction
+	StaticIcon *Sticker `json:"static_icon"`
+	// Appear animation for the reaction
+	AppearAnimation *Sticker `json:"appear_animation"`
+	// Select animation for the reaction
+	SelectAnimation *Sticker `json:"select_animation"`
+	// Activate animation for the reaction
+	ActivateAnimation *Sticker `json:"activate_animation"`
+	// Effect animation for the reaction
+	EffectAnimation *Sticker `json:"effect_animation"`
+	// Around animation for the reaction; may be null
+	AroundAnimation *Sticker `json:"around_animation,omitempty"`
+	// Center animation for the reaction; may be null
+	CenterAnimation *Sticker `json:"center_animation,omitempty"`
+}
+
+func (*EmojiReaction) GetType() string {
+	return TypeEmojiReaction
+}
+
+func (*EmojiReaction) GetConstructor() string {
+	return ConstructorEmojiReaction
+}
+
+func (entity *EmojiReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *EmojiReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *EmojiReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"emojiReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"is_active":`)
+	encoder.writeBool(entity.IsActive)
+	encoder.writeRaw(`,"static_icon":`)
+	entity.StaticIcon.encodeJSON(encoder)
+	encoder.writeRaw(`,"appear_animation":`)
+	entity.AppearAnimation.encodeJSON(encoder)
+	encoder.writeRaw(`,"select_animation":`)
+	entity.SelectAnimation.encodeJSON(encoder)
+	encoder.writeRaw(`,"activate_animation":`)
+	entity.ActivateAnimation.encodeJSON(encoder)
+	encoder.writeRaw(`,"effect_animation":`)
+	entity.EffectAnimation.encodeJSON(encoder)
+	if entity.AroundAnimation != nil {
+		encoder.writeRaw(`,"around_animation":`)
+		entity.AroundAnimation.encodeJSON(encoder)
+	}
+	if entity.CenterAnimation != nil {
+		encoder.writeRaw(`,"center_animation":`)
+		entity.CenterAnimation.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *EmojiReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "is_active":
+			entity.IsActive, err = decoder.readBool()
+
+		case "static_icon":
+			entity.StaticIcon, err = decodeEntity[Sticker](decoder)
+
+		case "appear_animation":
+			entity.AppearAnimation, err = decodeEntity[Sticker](decoder)
+
+		case "select_animation":
+			entity.SelectAnimation, err = decodeEntity[Sticker](decoder)
+
+		case "activate_animation":
+			entity.ActivateAnimation, err = decodeEntity[Sticker](decoder)
+
+		case "effect_animation":
+			entity.EffectAnimation, err = decodeEntity[Sticker](decoder)
+
+		case "around_animation":
+			entity.AroundAnimation, err = decodeEntity[Sticker](decoder)
+
+		case "center_animation":
+			entity.CenterAnimation, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorEmojiReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is an anonymous administrator in the supergroup, but isn't a creator of it, so they can't vote on behalf of the supergroup
+type ReactionUnavailabilityReasonAnonymousAdministrator struct {
+	meta
+}
+
+func (*ReactionUnavailabilityReasonAnonymousAdministrator) GetType() string {
+	return TypeReactionUnavailabilityReason
+}
+
+func (*ReactionUnavailabilityReasonAnonymousAdministrator) GetConstructor() string {
+	return ConstructorReactionUnavailabilityReasonAnonymousAdministrator
+}
+
+func (*ReactionUnavailabilityReasonAnonymousAdministrator) ReactionUnavailabilityReasonConstructor() string {
+	return ConstructorReactionUnavailabilityReasonAnonymousAdministrator
+}
+
+func (entity *ReactionUnavailabilityReasonAnonymousAdministrator) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionUnavailabilityReasonAnonymousAdministrator) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionUnavailabilityReasonAnonymousAdministrator) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionUnavailabilityReasonAnonymousAdministrator"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionUnavailabilityReasonAnonymousAdministrator) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReactionUnavailabilityReasonAnonymousAdministrator)
+	})
+}
+
+// The user isn't a member of the supergroup and can't send messages and reactions there without joining
+type ReactionUnavailabilityReasonGuest struct {
+	meta
+}
+
+func (*ReactionUnavailabilityReasonGuest) GetType() string {
+	return TypeReactionUnavailabilityReason
+}
+
+func (*ReactionUnavailabilityReasonGuest) GetConstructor() string {
+	return ConstructorReactionUnavailabilityReasonGuest
+}
+
+func (*ReactionUnavailabilityReasonGuest) ReactionUnavailabilityReasonConstructor() string {
+	return ConstructorReactionUnavailabilityReasonGuest
+}
+
+func (entity *ReactionUnavailabilityReasonGuest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReactionUnavailabilityReasonGuest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReactionUnavailabilityReasonGuest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reactionUnavailabilityReasonGuest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReactionUnavailabilityReasonGuest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReactionUnavailabilityReasonGuest)
+	})
+}
+
+// A regular animated sticker
+type DiceStickersRegular struct {
+	meta
+	// The animated sticker with the dice animation
+	Sticker *Sticker `json:"sticker"`
+}
+
+func (*DiceStickersRegular) GetType() string {
+	return TypeDiceStickers
+}
+
+func (*DiceStickersRegular) GetConstructor() string {
+	return ConstructorDiceStickersRegular
+}
+
+func (*DiceStickersRegular) DiceStickersConstructor() string {
+	return ConstructorDiceStickersRegular
+}
+
+func (entity *DiceStickersRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DiceStickersRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DiceStickersRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"diceStickersRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *DiceStickersRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDiceStickersRegular)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Animated stickers to be combined into a slot machine
+type DiceStickersSlotMachine struct {
+	meta
+	// The animated sticker with the slot machine background. The background animation must start playing after all reel animations finish
+	Background *Sticker `json:"background"`
+	// The animated sticker with the lever animation. The lever animation must play once in the initial dice state
+	Lever *Sticker `json:"lever"`
+	// The animated sticker with the left reel
+	LeftReel *Sticker `json:"left_reel"`
+	// The animated sticker with the center reel
+	CenterReel *Sticker `json:"center_reel"`
+	// The animated sticker with the right reel
+	RightReel *Sticker `json:"right_reel"`
+}
+
+func (*DiceStickersSlotMachine) GetType() string {
+	return TypeDiceStickers
+}
+
+func (*DiceStickersSlotMachine) GetConstructor() string {
+	return ConstructorDiceStickersSlotMachine
+}
+
+func (*DiceStickersSlotMachine) DiceStickersConstructor() string {
+	return ConstructorDiceStickersSlotMachine
+}
+
+func (entity *DiceStickersSlotMachine) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *DiceStickersSlotMachine) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *DiceStickersSlotMachine) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"diceStickersSlotMachine"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"background":`)
+	entity.Background.encodeJSON(encoder)
+	encoder.writeRaw(`,"lever":`)
+	entity.Lever.encodeJSON(encoder)
+	encoder.writeRaw(`,"left_reel":`)
+	entity.LeftReel.encodeJSON(encoder)
+	encoder.writeRaw(`,"center_reel":`)
+	entity.CenterReel.encodeJSON(encoder)
+	encoder.writeRaw(`,"right_reel":`)
+	entity.RightReel.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *DiceStickersSlotMachine) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "background":
+			entity.Background, err = decodeEntity[Sticker](decoder)
+
+		case "lever":
+			entity.Lever, err = decodeEntity[Sticker](decoder)
+
+		case "left_reel":
+			entity.LeftReel, err = decodeEntity[Sticker](decoder)
+
+		case "center_reel":
+			entity.CenterReel, err = decodeEntity[Sticker](decoder)
+
+		case "right_reel":
+			entity.RightReel, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorDiceStickersSlotMachine)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The name can be set
+type CheckStickerSetNameResultOk struct {
+	meta
+}
+
+func (*CheckStickerSetNameResultOk) GetType() string {
+	return TypeCheckStickerSetNameResult
+}
+
+func (*CheckStickerSetNameResultOk) GetConstructor() string {
+	return ConstructorCheckStickerSetNameResultOk
+}
+
+func (*CheckStickerSetNameResultOk) CheckStickerSetNameResultConstructor() string {
+	return ConstructorCheckStickerSetNameResultOk
+}
+
+func (entity *CheckStickerSetNameResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckStickerSetNameResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckStickerSetNameResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkStickerSetNameResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckStickerSetNameResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckStickerSetNameResultOk)
+	})
+}
+
+// The name is invalid
+type CheckStickerSetNameResultNameInvalid struct {
+	meta
+}
+
+func (*CheckStickerSetNameResultNameInvalid) GetType() string {
+	return TypeCheckStickerSetNameResult
+}
+
+func (*CheckStickerSetNameResultNameInvalid) GetConstructor() string {
+	return ConstructorCheckStickerSetNameResultNameInvalid
+}
+
+func (*CheckStickerSetNameResultNameInvalid) CheckStickerSetNameResultConstructor() string {
+	return ConstructorCheckStickerSetNameResultNameInvalid
+}
+
+func (entity *CheckStickerSetNameResultNameInvalid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckStickerSetNameResultNameInvalid) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckStickerSetNameResultNameInvalid) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkStickerSetNameResultNameInvalid"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckStickerSetNameResultNameInvalid) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckStickerSetNameResultNameInvalid)
+	})
+}
+
+// The name is occupied
+type CheckStickerSetNameResultNameOccupied struct {
+	meta
+}
+
+func (*CheckStickerSetNameResultNameOccupied) GetType() string {
+	return TypeCheckStickerSetNameResult
+}
+
+func (*CheckStickerSetNameResultNameOccupied) GetConstructor() string {
+	return ConstructorCheckStickerSetNameResultNameOccupied
+}
+
+func (*CheckStickerSetNameResultNameOccupied) CheckStickerSetNameResultConstructor() string {
+	return ConstructorCheckStickerSetNameResultNameOccupied
+}
+
+func (entity *CheckStickerSetNameResultNameOccupied) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CheckStickerSetNameResultNameOccupied) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CheckStickerSetNameResultNameOccupied) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"checkStickerSetNameResultNameOccupied"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CheckStickerSetNameResultNameOccupied) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCheckStickerSetNameResultNameOccupied)
+	})
+}
+
+// A sticker to be added to a sticker set
+type InputSticker struct {
+	meta
+	// File with the sticker; must fit in a 512x512 square. For WEBP stickers the file must be in WEBP or PNG format, which will be converted to WEBP server-side. See https://core.telegram.org/animated_stickers#technical-requirements for technical requirements
+	Sticker InputFile `json:"sticker"`
+	// Format of the sticker
+	Format StickerFormat `json:"format"`
+	// String with 1-20 emoji corresponding to the sticker
+	Emojis string `json:"emojis"`
+	// Position where the mask is placed; pass null if not specified
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+	// List of up to 20 keywords with total length up to 64 characters, which can be used to find the sticker
+	Keywords []string `json:"keywords"`
+}
+
+func (*InputSticker) GetType() string {
+	return TypeInputSticker
+}
+
+func (*InputSticker) GetConstructor() string {
+	return ConstructorInputSticker
+}
+
+func (entity *InputSticker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputSticker) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputSticker) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputSticker"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker":`)
+	encoder.writeType(entity.Sticker)
+	encoder.writeRaw(`,"format":`)
+	encoder.writeType(entity.Format)
+	encoder.writeRaw(`,"emojis":`)
+	encoder.writeString(entity.Emojis)
+	if entity.MaskPosition != nil {
+		encoder.writeRaw(`,"mask_position":`)
+		entity.MaskPosition.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"keywords":`)
+	writeList(encoder, entity.Keywords, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputSticker) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker":
+			entity.Sticker, err = decodeInputFile(decoder)
+
+		case "format":
+			entity.Format, err = decodeStickerFormat(decoder)
+
+		case "emojis":
+			entity.Emojis, err = decoder.readString()
+
+		case "mask_position":
+			entity.MaskPosition, err = decodeEntity[MaskPosition](decoder)
+
+		case "keywords":
+			entity.Keywords, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputSticker)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
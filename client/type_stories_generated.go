@@ -0,0 +1,3884 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeStoryAreaType        = "StoryAreaType"
+	TypeInputStoryAreaType   = "InputStoryAreaType"
+	TypeStoryContent         = "StoryContent"
+	TypeInputStoryContent    = "InputStoryContent"
+	TypeStoryList            = "StoryList"
+	TypeStoryOrigin          = "StoryOrigin"
+	TypeStoryInteractionType = "StoryInteractionType"
+	TypeCanSendStoryResult   = "CanSendStoryResult"
+	TypeStoryPrivacySettings = "StoryPrivacySettings"
+	TypeReportStoryResult    = "ReportStoryResult"
+	TypeStoryAreaPosition    = "StoryAreaPosition"
+	TypeStoryArea            = "StoryArea"
+	TypeInputStoryArea       = "InputStoryArea"
+	TypeInputStoryAreas      = "InputStoryAreas"
+	TypeStoryVideo           = "StoryVideo"
+	TypeStoryRepostInfo      = "StoryRepostInfo"
+	TypeStoryInteractionInfo = "StoryInteractionInfo"
+	TypeStory                = "Story"
+	TypeStories              = "Stories"
+	TypeFoundStories         = "FoundStories"
+	TypeStoryFullId          = "StoryFullId"
+	TypeStoryInfo            = "StoryInfo"
+	TypeChatActiveStories    = "ChatActiveStories"
+	TypeStoryInteraction     = "StoryInteraction"
+	TypeStoryInteractions    = "StoryInteractions"
+	TypeStoryStatistics      = "StoryStatistics"
+)
+
+const (
+	ConstructorStoryAreaPosition                          = "storyAreaPosition"
+	ConstructorStoryAreaTypeLocation                      = "storyAreaTypeLocation"
+	ConstructorStoryAreaTypeVenue                         = "storyAreaTypeVenue"
+	ConstructorStoryAreaTypeSuggestedReaction             = "storyAreaTypeSuggestedReaction"
+	ConstructorStoryAreaTypeMessage                       = "storyAreaTypeMessage"
+	ConstructorStoryAreaTypeLink                          = "storyAreaTypeLink"
+	ConstructorStoryAreaTypeWeather                       = "storyAreaTypeWeather"
+	ConstructorStoryAreaTypeUpgradedGift                  = "storyAreaTypeUpgradedGift"
+	ConstructorStoryArea                                  = "storyArea"
+	ConstructorInputStoryAreaTypeLocation                 = "inputStoryAreaTypeLocation"
+	ConstructorInputStoryAreaTypeFoundVenue               = "inputStoryAreaTypeFoundVenue"
+	ConstructorInputStoryAreaTypePreviousVenue            = "inputStoryAreaTypePreviousVenue"
+	ConstructorInputStoryAreaTypeSuggestedReaction        = "inputStoryAreaTypeSuggestedReaction"
+	ConstructorInputStoryAreaTypeMessage                  = "inputStoryAreaTypeMessage"
+	ConstructorInputStoryAreaTypeLink                     = "inputStoryAreaTypeLink"
+	ConstructorInputStoryAreaTypeWeather                  = "inputStoryAreaTypeWeather"
+	ConstructorInputStoryAreaTypeUpgradedGift             = "inputStoryAreaTypeUpgradedGift"
+	ConstructorInputStoryArea                             = "inputStoryArea"
+	ConstructorInputStoryAreas                            = "inputStoryAreas"
+	ConstructorStoryVideo                                 = "storyVideo"
+	ConstructorStoryContentPhoto                          = "storyContentPhoto"
+	ConstructorStoryContentVideo                          = "storyContentVideo"
+	ConstructorStoryContentUnsupported                    = "storyContentUnsupported"
+	ConstructorInputStoryContentPhoto                     = "inputStoryContentPhoto"
+	ConstructorInputStoryContentVideo                     = "inputStoryContentVideo"
+	ConstructorStoryListMain                              = "storyListMain"
+	ConstructorStoryListArchive                           = "storyListArchive"
+	ConstructorStoryOriginPublicStory                     = "storyOriginPublicStory"
+	ConstructorStoryOriginHiddenUser                      = "storyOriginHiddenUser"
+	ConstructorStoryRepostInfo                            = "storyRepostInfo"
+	ConstructorStoryInteractionInfo                       = "storyInteractionInfo"
+	ConstructorStory                                      = "story"
+	ConstructorStories                                    = "stories"
+	ConstructorFoundStories                               = "foundStories"
+	ConstructorStoryFullId                                = "storyFullId"
+	ConstructorStoryInfo                                  = "storyInfo"
+	ConstructorChatActiveStories                          = "chatActiveStories"
+	ConstructorStoryInteractionTypeView                   = "storyInteractionTypeView"
+	ConstructorStoryInteractionTypeForward                = "storyInteractionTypeForward"
+	ConstructorStoryInteractionTypeRepost                 = "storyInteractionTypeRepost"
+	ConstructorStoryInteraction                           = "storyInteraction"
+	ConstructorStoryInteractions                          = "storyInteractions"
+	ConstructorCanSendStoryResultOk                       = "canSendStoryResultOk"
+	ConstructorCanSendStoryResultPremiumNeeded            = "canSendStoryResultPremiumNeeded"
+	ConstructorCanSendStoryResultBoostNeeded              = "canSendStoryResultBoostNeeded"
+	ConstructorCanSendStoryResultActiveStoryLimitExceeded = "canSendStoryResultActiveStoryLimitExceeded"
+	ConstructorCanSendStoryResultWeeklyLimitExceeded      = "canSendStoryResultWeeklyLimitExceeded"
+	ConstructorCanSendStoryResultMonthlyLimitExceeded     = "canSendStoryResultMonthlyLimitExceeded"
+	ConstructorStoryPrivacySettingsEveryone               = "storyPrivacySettingsEveryone"
+	ConstructorStoryPrivacySettingsContacts               = "storyPrivacySettingsContacts"
+	ConstructorStoryPrivacySettingsCloseFriends           = "storyPrivacySettingsCloseFriends"
+	ConstructorStoryPrivacySettingsSelectedUsers          = "storyPrivacySettingsSelectedUsers"
+	ConstructorReportStoryResultOk                        = "reportStoryResultOk"
+	ConstructorReportStoryResultOptionRequired            = "reportStoryResultOptionRequired"
+	ConstructorReportStoryResultTextRequired              = "reportStoryResultTextRequired"
+	ConstructorStoryStatistics                            = "storyStatistics"
+)
+
+// Describes type of clickable area on a story media
+type StoryAreaType interface {
+	StoryAreaTypeConstructor() string
+}
+
+// Describes type of clickable area on a story media to be added
+type InputStoryAreaType interface {
+	InputStoryAreaTypeConstructor() string
+}
+
+// Contains the content of a story
+type StoryContent interface {
+	StoryContentConstructor() string
+}
+
+// The content of a story to send
+type InputStoryContent interface {
+	InputStoryContentConstructor() string
+}
+
+// Describes a list of stories
+type StoryList interface {
+	StoryListConstructor() string
+}
+
+// Contains information about the origin of a story that was reposted
+type StoryOrigin interface {
+	StoryOriginConstructor() string
+}
+
+// Describes type of interaction with a story
+type StoryInteractionType interface {
+	StoryInteractionTypeConstructor() string
+}
+
+// Represents result of checking whether the current user can send a story in the specific chat
+type CanSendStoryResult interface {
+	CanSendStoryResultConstructor() string
+}
+
+// Describes privacy settings of a story
+type StoryPrivacySettings interface {
+	StoryPrivacySettingsConstructor() string
+}
+
+// Describes result of story report
+type ReportStoryResult interface {
+	ReportStoryResultConstructor() string
+}
+
+func (entity *UnknownType) StoryAreaTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputStoryAreaTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StoryContentConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) InputStoryContentConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StoryListConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StoryOriginConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StoryInteractionTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) CanSendStoryResultConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) StoryPrivacySettingsConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) ReportStoryResultConstructor() string {
+	return entity.Constructor
+}
+
+// Describes position of a clickable rectangle area on a story media
+type StoryAreaPosition struct {
+	meta
+	// The abscissa of the rectangle's center, as a percentage of the media width
+	XPercentage float64 `json:"x_percentage"`
+	// The ordinate of the rectangle's center, as a percentage of the media height
+	YPercentage float64 `json:"y_percentage"`
+	// The width of the rectangle, as a percentage of the media width
+	WidthPercentage float64 `json:"width_percentage"`
+	// The height of the rectangle, as a percentage of the media height
+	HeightPercentage float64 `json:"height_percentage"`
+	// Clockwise rotation angle of the rectangle, in degrees; 0-360
+	RotationAngle float64 `json:"rotation_angle"`
+	// The radius of the rectangle corner rounding, as a percentage of the media width
+	CornerRadiusPercentage float64 `json:"corner_radius_percentage"`
+}
+
+func (*StoryAreaPosition) GetType() string {
+	return TypeStoryAreaPosition
+}
+
+func (*StoryAreaPosition) GetConstructor() string {
+	return ConstructorStoryAreaPosition
+}
+
+func (entity *StoryAreaPosition) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaPosition) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaPosition"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"x_percentage":`)
+	encoder.writeFloat64(entity.XPercentage)
+	encoder.writeRaw(`,"y_percentage":`)
+	encoder.writeFloat64(entity.YPercentage)
+	encoder.writeRaw(`,"width_percentage":`)
+	encoder.writeFloat64(entity.WidthPercentage)
+	encoder.writeRaw(`,"height_percentage":`)
+	encoder.writeFloat64(entity.HeightPercentage)
+	encoder.writeRaw(`,"rotation_angle":`)
+	encoder.writeFloat64(entity.RotationAngle)
+	encoder.writeRaw(`,"corner_radius_percentage":`)
+	encoder.writeFloat64(entity.CornerRadiusPercentage)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaPosition) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "x_percentage":
+			entity.XPercentage, err = decoder.readFloat64()
+
+		case "y_percentage":
+			entity.YPercentage, err = decoder.readFloat64()
+
+		case "width_percentage":
+			entity.WidthPercentage, err = decoder.readFloat64()
+
+		case "height_percentage":
+			entity.HeightPercentage, err = decoder.readFloat64()
+
+		case "rotation_angle":
+			entity.RotationAngle, err = decoder.readFloat64()
+
+		case "corner_radius_percentage":
+			entity.CornerRadiusPercentage, err = decoder.readFloat64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaPosition)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a location
+type StoryAreaTypeLocation struct {
+	meta
+	// The location
+	Location *Location `json:"location"`
+	// Address of the location; may be null if unknown
+	Address *LocationAddress `json:"address,omitempty"`
+}
+
+func (*StoryAreaTypeLocation) GetType() string {
+	return TypeStoryAreaType
+}
+
+func (*StoryAreaTypeLocation) GetConstructor() string {
+	return ConstructorStoryAreaTypeLocation
+}
+
+func (*StoryAreaTypeLocation) StoryAreaTypeConstructor() string {
+	return ConstructorStoryAreaTypeLocation
+}
+
+func (entity *StoryAreaTypeLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaTypeLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaTypeLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaTypeLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	if entity.Address != nil {
+		encoder.writeRaw(`,"address":`)
+		entity.Address.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaTypeLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "address":
+			entity.Address, err = decodeEntity[LocationAddress](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaTypeLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a venue
+type StoryAreaTypeVenue struct {
+	meta
+	// Information about the venue
+	Venue *Venue `json:"venue"`
+}
+
+func (*StoryAreaTypeVenue) GetType() string {
+	return TypeStoryAreaType
+}
+
+func (*StoryAreaTypeVenue) GetConstructor() string {
+	return ConstructorStoryAreaTypeVenue
+}
+
+func (*StoryAreaTypeVenue) StoryAreaTypeConstructor() string {
+	return ConstructorStoryAreaTypeVenue
+}
+
+func (entity *StoryAreaTypeVenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaTypeVenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaTypeVenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaTypeVenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"venue":`)
+	entity.Venue.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaTypeVenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "venue":
+			entity.Venue, err = decodeEntity[Venue](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaTypeVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a suggested reaction. App needs to show a clickable reaction on the area and call setStoryReaction when the are is clicked
+type StoryAreaTypeSuggestedReaction struct {
+	meta
+	// Type of the reaction
+	ReactionType ReactionType `json:"reaction_type"`
+	// Number of times the reaction was added
+	TotalCount int32 `json:"total_count"`
+	// True, if reaction has a dark background
+	IsDark bool `json:"is_dark"`
+	// True, if reaction corner is flipped
+	IsFlipped bool `json:"is_flipped"`
+}
+
+func (*StoryAreaTypeSuggestedReaction) GetType() string {
+	return TypeStoryAreaType
+}
+
+func (*StoryAreaTypeSuggestedReaction) GetConstructor() string {
+	return ConstructorStoryAreaTypeSuggestedReaction
+}
+
+func (*StoryAreaTypeSuggestedReaction) StoryAreaTypeConstructor() string {
+	return ConstructorStoryAreaTypeSuggestedReaction
+}
+
+func (entity *StoryAreaTypeSuggestedReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaTypeSuggestedReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaTypeSuggestedReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaTypeSuggestedReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reaction_type":`)
+	encoder.writeType(entity.ReactionType)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(entity.IsDark)
+	encoder.writeRaw(`,"is_flipped":`)
+	encoder.writeBool(entity.IsFlipped)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaTypeSuggestedReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reaction_type":
+			entity.ReactionType, err = decodeReactionType(decoder)
+
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "is_dark":
+			entity.IsDark, err = decoder.readBool()
+
+		case "is_flipped":
+			entity.IsFlipped, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaTypeSuggestedReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a message
+type StoryAreaTypeMessage struct {
+	meta
+	// Identifier of the chat with the message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message
+	MessageId int64 `json:"message_id"`
+}
+
+func (*StoryAreaTypeMessage) GetType() string {
+	return TypeStoryAreaType
+}
+
+func (*StoryAreaTypeMessage) GetConstructor() string {
+	return ConstructorStoryAreaTypeMessage
+}
+
+func (*StoryAreaTypeMessage) StoryAreaTypeConstructor() string {
+	return ConstructorStoryAreaTypeMessage
+}
+
+func (entity *StoryAreaTypeMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaTypeMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaTypeMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaTypeMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaTypeMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaTypeMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a HTTP or tg:// link
+type StoryAreaTypeLink struct {
+	meta
+	// HTTP or tg:// URL to be opened when the area is clicked
+	Url string `json:"url"`
+}
+
+func (*StoryAreaTypeLink) GetType() string {
+	return TypeStoryAreaType
+}
+
+func (*StoryAreaTypeLink) GetConstructor() string {
+	return ConstructorStoryAreaTypeLink
+}
+
+func (*StoryAreaTypeLink) StoryAreaTypeConstructor() string {
+	return ConstructorStoryAreaTypeLink
+}
+
+func (entity *StoryAreaTypeLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaTypeLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaTypeLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaTypeLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaTypeLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaTypeLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area with information about weather
+type StoryAreaTypeWeather struct {
+	meta
+	// Temperature, in degree Celsius
+	Temperature float64 `json:"temperature"`
+	// Emoji representing the weather
+	Emoji string `json:"emoji"`
+	// A color of the area background in the ARGB format
+	BackgroundColor int32 `json:"background_color"`
+}
+
+func (*StoryAreaTypeWeather) GetType() string {
+	return TypeStoryAreaType
+}
+
+func (*StoryAreaTypeWeather) GetConstructor() string {
+	return ConstructorStoryAreaTypeWeather
+}
+
+func (*StoryAreaTypeWeather) StoryAreaTypeConstructor() string {
+	return ConstructorStoryAreaTypeWeather
+}
+
+func (entity *StoryAreaTypeWeather) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaTypeWeather) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaTypeWeather) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaTypeWeather"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"temperature":`)
+	encoder.writeFloat64(entity.Temperature)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"background_color":`)
+	encoder.writeInt32(entity.BackgroundColor)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaTypeWeather) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "temperature":
+			entity.Temperature, err = decoder.readFloat64()
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "background_color":
+			entity.BackgroundColor, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaTypeWeather)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area with an upgraded gift
+type StoryAreaTypeUpgradedGift struct {
+	meta
+	// Unique name of the upgraded gift
+	GiftName string `json:"gift_name"`
+}
+
+func (*StoryAreaTypeUpgradedGift) GetType() string {
+	return TypeStoryAreaType
+}
+
+func (*StoryAreaTypeUpgradedGift) GetConstructor() string {
+	return ConstructorStoryAreaTypeUpgradedGift
+}
+
+func (*StoryAreaTypeUpgradedGift) StoryAreaTypeConstructor() string {
+	return ConstructorStoryAreaTypeUpgradedGift
+}
+
+func (entity *StoryAreaTypeUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryAreaTypeUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryAreaTypeUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyAreaTypeUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift_name":`)
+	encoder.writeString(entity.GiftName)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryAreaTypeUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift_name":
+			entity.GiftName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryAreaTypeUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a clickable rectangle area on a story media
+type StoryArea struct {
+	meta
+	// Position of the area
+	Position *StoryAreaPosition `json:"position"`
+	// Type of the area
+	Type StoryAreaType `json:"type"`
+}
+
+func (*StoryArea) GetType() string {
+	return TypeStoryArea
+}
+
+func (*StoryArea) GetConstructor() string {
+	return ConstructorStoryArea
+}
+
+func (entity *StoryArea) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryArea) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryArea) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyArea"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"position":`)
+	entity.Position.encodeJSON(encoder)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryArea) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "position":
+			entity.Position, err = decodeEntity[StoryAreaPosition](decoder)
+
+		case "type":
+			entity.Type, err = decodeStoryAreaType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryArea)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a location
+type InputStoryAreaTypeLocation struct {
+	meta
+	// The location
+	Location *Location `json:"location"`
+	// Address of the location; pass null if unknown
+	Address *LocationAddress `json:"address,omitempty"`
+}
+
+func (*InputStoryAreaTypeLocation) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypeLocation) GetConstructor() string {
+	return ConstructorInputStoryAreaTypeLocation
+}
+
+func (*InputStoryAreaTypeLocation) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypeLocation
+}
+
+func (entity *InputStoryAreaTypeLocation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypeLocation) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypeLocation) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypeLocation"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"location":`)
+	entity.Location.encodeJSON(encoder)
+	if entity.Address != nil {
+		encoder.writeRaw(`,"address":`)
+		entity.Address.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypeLocation) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "location":
+			entity.Location, err = decodeEntity[Location](decoder)
+
+		case "address":
+			entity.Address, err = decodeEntity[LocationAddress](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypeLocation)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a venue found by the bot getOption("venue_search_bot_username")
+type InputStoryAreaTypeFoundVenue struct {
+	meta
+	// Identifier of the inline query, used to found the venue
+	QueryId JsonInt64 `json:"query_id"`
+	// Identifier of the inline query result
+	ResultId string `json:"result_id"`
+}
+
+func (*InputStoryAreaTypeFoundVenue) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypeFoundVenue) GetConstructor() string {
+	return ConstructorInputStoryAreaTypeFoundVenue
+}
+
+func (*InputStoryAreaTypeFoundVenue) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypeFoundVenue
+}
+
+func (entity *InputStoryAreaTypeFoundVenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypeFoundVenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypeFoundVenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypeFoundVenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"query_id":`)
+	encoder.writeJsonInt64(entity.QueryId)
+	encoder.writeRaw(`,"result_id":`)
+	encoder.writeString(entity.ResultId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypeFoundVenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "query_id":
+			entity.QueryId, err = decoder.readJsonInt64()
+
+		case "result_id":
+			entity.ResultId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypeFoundVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a venue already added to the story
+type InputStoryAreaTypePreviousVenue struct {
+	meta
+	// Provider of the venue
+	VenueProvider string `json:"venue_provider"`
+	// Identifier of the venue in the provider database
+	VenueId string `json:"venue_id"`
+}
+
+func (*InputStoryAreaTypePreviousVenue) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypePreviousVenue) GetConstructor() string {
+	return ConstructorInputStoryAreaTypePreviousVenue
+}
+
+func (*InputStoryAreaTypePreviousVenue) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypePreviousVenue
+}
+
+func (entity *InputStoryAreaTypePreviousVenue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypePreviousVenue) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypePreviousVenue) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypePreviousVenue"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"venue_provider":`)
+	encoder.writeString(entity.VenueProvider)
+	encoder.writeRaw(`,"venue_id":`)
+	encoder.writeString(entity.VenueId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypePreviousVenue) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "venue_provider":
+			entity.VenueProvider, err = decoder.readString()
+
+		case "venue_id":
+			entity.VenueId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypePreviousVenue)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a suggested reaction
+type InputStoryAreaTypeSuggestedReaction struct {
+	meta
+	// Type of the reaction
+	ReactionType ReactionType `json:"reaction_type"`
+	// True, if reaction has a dark background
+	IsDark bool `json:"is_dark"`
+	// True, if reaction corner is flipped
+	IsFlipped bool `json:"is_flipped"`
+}
+
+func (*InputStoryAreaTypeSuggestedReaction) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypeSuggestedReaction) GetConstructor() string {
+	return ConstructorInputStoryAreaTypeSuggestedReaction
+}
+
+func (*InputStoryAreaTypeSuggestedReaction) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypeSuggestedReaction
+}
+
+func (entity *InputStoryAreaTypeSuggestedReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypeSuggestedReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypeSuggestedReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypeSuggestedReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reaction_type":`)
+	encoder.writeType(entity.ReactionType)
+	encoder.writeRaw(`,"is_dark":`)
+	encoder.writeBool(entity.IsDark)
+	encoder.writeRaw(`,"is_flipped":`)
+	encoder.writeBool(entity.IsFlipped)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypeSuggestedReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reaction_type":
+			entity.ReactionType, err = decodeReactionType(decoder)
+
+		case "is_dark":
+			entity.IsDark, err = decoder.readBool()
+
+		case "is_flipped":
+			entity.IsFlipped, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypeSuggestedReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a message
+type InputStoryAreaTypeMessage struct {
+	meta
+	// Identifier of the chat with the message. Currently, the chat must be a supergroup or a channel chat
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message. Use messageProperties.can_be_shared_in_story to check whether the message is suitable
+	MessageId int64 `json:"message_id"`
+}
+
+func (*InputStoryAreaTypeMessage) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypeMessage) GetConstructor() string {
+	return ConstructorInputStoryAreaTypeMessage
+}
+
+func (*InputStoryAreaTypeMessage) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypeMessage
+}
+
+func (entity *InputStoryAreaTypeMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypeMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypeMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypeMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypeMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypeMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area pointing to a HTTP or tg:// link
+type InputStoryAreaTypeLink struct {
+	meta
+	// HTTP or tg:// URL to be opened when the area is clicked
+	Url string `json:"url"`
+}
+
+func (*InputStoryAreaTypeLink) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypeLink) GetConstructor() string {
+	return ConstructorInputStoryAreaTypeLink
+}
+
+func (*InputStoryAreaTypeLink) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypeLink
+}
+
+func (entity *InputStoryAreaTypeLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypeLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypeLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypeLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypeLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypeLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area with information about weather
+type InputStoryAreaTypeWeather struct {
+	meta
+	// Temperature, in degree Celsius
+	Temperature float64 `json:"temperature"`
+	// Emoji representing the weather
+	Emoji string `json:"emoji"`
+	// A color of the area background in the ARGB format
+	BackgroundColor int32 `json:"background_color"`
+}
+
+func (*InputStoryAreaTypeWeather) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypeWeather) GetConstructor() string {
+	return ConstructorInputStoryAreaTypeWeather
+}
+
+func (*InputStoryAreaTypeWeather) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypeWeather
+}
+
+func (entity *InputStoryAreaTypeWeather) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypeWeather) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypeWeather) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypeWeather"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"temperature":`)
+	encoder.writeFloat64(entity.Temperature)
+	encoder.writeRaw(`,"emoji":`)
+	encoder.writeString(entity.Emoji)
+	encoder.writeRaw(`,"background_color":`)
+	encoder.writeInt32(entity.BackgroundColor)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypeWeather) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "temperature":
+			entity.Temperature, err = decoder.readFloat64()
+
+		case "emoji":
+			entity.Emoji, err = decoder.readString()
+
+		case "background_color":
+			entity.BackgroundColor, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypeWeather)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An area with an upgraded gift
+type InputStoryAreaTypeUpgradedGift struct {
+	meta
+	// Unique name of the upgraded gift
+	GiftName string `json:"gift_name"`
+}
+
+func (*InputStoryAreaTypeUpgradedGift) GetType() string {
+	return TypeInputStoryAreaType
+}
+
+func (*InputStoryAreaTypeUpgradedGift) GetConstructor() string {
+	return ConstructorInputStoryAreaTypeUpgradedGift
+}
+
+func (*InputStoryAreaTypeUpgradedGift) InputStoryAreaTypeConstructor() string {
+	return ConstructorInputStoryAreaTypeUpgradedGift
+}
+
+func (entity *InputStoryAreaTypeUpgradedGift) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreaTypeUpgradedGift) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreaTypeUpgradedGift) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreaTypeUpgradedGift"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"gift_name":`)
+	encoder.writeString(entity.GiftName)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreaTypeUpgradedGift) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "gift_name":
+			entity.GiftName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreaTypeUpgradedGift)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a clickable rectangle area on a story media to be added
+type InputStoryArea struct {
+	meta
+	// Position of the area
+	Position *StoryAreaPosition `json:"position"`
+	// Type of the area
+	Type InputStoryAreaType `json:"type"`
+}
+
+func (*InputStoryArea) GetType() string {
+	return TypeInputStoryArea
+}
+
+func (*InputStoryArea) GetConstructor() string {
+	return ConstructorInputStoryArea
+}
+
+func (entity *InputStoryArea) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryArea) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryArea) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryArea"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"position":`)
+	entity.Position.encodeJSON(encoder)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryArea) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "position":
+			entity.Position, err = decodeEntity[StoryAreaPosition](decoder)
+
+		case "type":
+			entity.Type, err = decodeInputStoryAreaType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryArea)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of story areas to be added
+type InputStoryAreas struct {
+	meta
+	// List of input story areas. Currently, a story can have up to 10 inputStoryAreaTypeLocation, inputStoryAreaTypeFoundVenue, and inputStoryAreaTypePreviousVenue areas, up to getOption("story_suggested_reaction_area_count_max") inputStoryAreaTypeSuggestedReaction areas, up to 1 inputStoryAreaTypeMessage area, up to getOption("story_link_area_count_max") inputStoryAreaTypeLink areas if the current user is a Telegram Premium user, up to 3 inputStoryAreaTypeWeather areas, and up to 1 inputStoryAreaTypeUpgradedGift area
+	Areas []*InputStoryArea `json:"areas"`
+}
+
+func (*InputStoryAreas) GetType() string {
+	return TypeInputStoryAreas
+}
+
+func (*InputStoryAreas) GetConstructor() string {
+	return ConstructorInputStoryAreas
+}
+
+func (entity *InputStoryAreas) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryAreas) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryAreas) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryAreas"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"areas":`)
+	writeList(encoder, entity.Areas, writeTypeValue[*InputStoryArea])
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryAreas) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "areas":
+			entity.Areas, err = readList(decoder, decodeEntity[InputStoryArea])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryAreas)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes a video file sent in a story
+type StoryVideo struct {
+	meta
+	// Duration of the video, in seconds
+	Duration float64 `json:"duration"`
+	// Video width
+	Width int32 `json:"width"`
+	// Video height
+	Height int32 `json:"height"`
+	// True, if stickers were added to the video. The list of corresponding sticker sets can be received using getAttachedStickerSets
+	HasStickers bool `json:"has_stickers"`
+	// True, if the video has no sound
+	IsAnimation bool `json:"is_animation"`
+	// Video minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// Video thumbnail in JPEG or MPEG4 format; may be null
+	Thumbnail *Thumbnail `json:"thumbnail,omitempty"`
+	// Size of file prefix, which is expected to be preloaded, in bytes
+	PreloadPrefixSize int32 `json:"preload_prefix_size"`
+	// Timestamp of the frame used as video thumbnail
+	CoverFrameTimestamp float64 `json:"cover_frame_timestamp"`
+	// File containing the video
+	Video *File `json:"video"`
+}
+
+func (*StoryVideo) GetType() string {
+	return TypeStoryVideo
+}
+
+func (*StoryVideo) GetConstructor() string {
+	return ConstructorStoryVideo
+}
+
+func (entity *StoryVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeFloat64(entity.Duration)
+	encoder.writeRaw(`,"width":`)
+	encoder.writeInt32(entity.Width)
+	encoder.writeRaw(`,"height":`)
+	encoder.writeInt32(entity.Height)
+	encoder.writeRaw(`,"has_stickers":`)
+	encoder.writeBool(entity.HasStickers)
+	encoder.writeRaw(`,"is_animation":`)
+	encoder.writeBool(entity.IsAnimation)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	if entity.Thumbnail != nil {
+		encoder.writeRaw(`,"thumbnail":`)
+		entity.Thumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"preload_prefix_size":`)
+	encoder.writeInt32(entity.PreloadPrefixSize)
+	encoder.writeRaw(`,"cover_frame_timestamp":`)
+	encoder.writeFloat64(entity.CoverFrameTimestamp)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "duration":
+			entity.Duration, err = decoder.readFloat64()
+
+		case "width":
+			entity.Width, err = decoder.readInt32()
+
+		case "height":
+			entity.Height, err = decoder.readInt32()
+
+		case "has_stickers":
+			entity.HasStickers, err = decoder.readBool()
+
+		case "is_animation":
+			entity.IsAnimation, err = decoder.readBool()
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "thumbnail":
+			entity.Thumbnail, err = decodeEntity[Thumbnail](decoder)
+
+		case "preload_prefix_size":
+			entity.PreloadPrefixSize, err = decoder.readInt32()
+
+		case "cover_frame_timestamp":
+			entity.CoverFrameTimestamp, err = decoder.readFloat64()
+
+		case "video":
+			entity.Video, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A photo story
+type StoryContentPhoto struct {
+	meta
+	// The photo
+	Photo *Photo `json:"photo"`
+}
+
+func (*StoryContentPhoto) GetType() string {
+	return TypeStoryContent
+}
+
+func (*StoryContentPhoto) GetConstructor() string {
+	return ConstructorStoryContentPhoto
+}
+
+func (*StoryContentPhoto) StoryContentConstructor() string {
+	return ConstructorStoryContentPhoto
+}
+
+func (entity *StoryContentPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryContentPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryContentPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyContentPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	entity.Photo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryContentPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryContentPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video story
+type StoryContentVideo struct {
+	meta
+	// The video in MPEG4 format
+	Video *StoryVideo `json:"video"`
+	// Alternative version of the video in MPEG4 format, encoded with H.264 codec; may be null
+	AlternativeVideo *StoryVideo `json:"alternative_video,omitempty"`
+}
+
+func (*StoryContentVideo) GetType() string {
+	return TypeStoryContent
+}
+
+func (*StoryContentVideo) GetConstructor() string {
+	return ConstructorStoryContentVideo
+}
+
+func (*StoryContentVideo) StoryContentConstructor() string {
+	return ConstructorStoryContentVideo
+}
+
+func (entity *StoryContentVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryContentVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryContentVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyContentVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video":`)
+	entity.Video.encodeJSON(encoder)
+	if entity.AlternativeVideo != nil {
+		encoder.writeRaw(`,"alternative_video":`)
+		entity.AlternativeVideo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryContentVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeEntity[StoryVideo](decoder)
+
+		case "alternative_video":
+			entity.AlternativeVideo, err = decodeEntity[StoryVideo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryContentVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A story content that is not supported in the current TDLib version
+type StoryContentUnsupported struct {
+	meta
+}
+
+func (*StoryContentUnsupported) GetType() string {
+	return TypeStoryContent
+}
+
+func (*StoryContentUnsupported) GetConstructor() string {
+	return ConstructorStoryContentUnsupported
+}
+
+func (*StoryContentUnsupported) StoryContentConstructor() string {
+	return ConstructorStoryContentUnsupported
+}
+
+func (entity *StoryContentUnsupported) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryContentUnsupported) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryContentUnsupported) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyContentUnsupported"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryContentUnsupported) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStoryContentUnsupported)
+	})
+}
+
+// A photo story
+type InputStoryContentPhoto struct {
+	meta
+	// Photo to send. The photo must be at most 10 MB in size. The photo size must be 1080x1920
+	Photo InputFile `json:"photo"`
+	// File identifiers of the stickers added to the photo, if applicable
+	AddedStickerFileIds []int32 `json:"added_sticker_file_ids"`
+}
+
+func (*InputStoryContentPhoto) GetType() string {
+	return TypeInputStoryContent
+}
+
+func (*InputStoryContentPhoto) GetConstructor() string {
+	return ConstructorInputStoryContentPhoto
+}
+
+func (*InputStoryContentPhoto) InputStoryContentConstructor() string {
+	return ConstructorInputStoryContentPhoto
+}
+
+func (entity *InputStoryContentPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryContentPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryContentPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryContentPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"photo":`)
+	encoder.writeType(entity.Photo)
+	encoder.writeRaw(`,"added_sticker_file_ids":`)
+	writeList(encoder, entity.AddedStickerFileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryContentPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "photo":
+			entity.Photo, err = decodeInputFile(decoder)
+
+		case "added_sticker_file_ids":
+			entity.AddedStickerFileIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryContentPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A video story
+type InputStoryContentVideo struct {
+	meta
+	// Video to be sent. The video size must be 720x1280. The video must be streamable and stored in MPEG4 format, after encoding with H.265 codec and key frames added each second
+	Video InputFile `json:"video"`
+	// File identifiers of the stickers added to the video, if applicable
+	AddedStickerFileIds []int32 `json:"added_sticker_file_ids"`
+	// Precise duration of the video, in seconds; 0-60
+	Duration float64 `json:"duration"`
+	// Timestamp of the frame, which will be used as video thumbnail
+	CoverFrameTimestamp float64 `json:"cover_frame_timestamp"`
+	// True, if the video has no sound
+	IsAnimation bool `json:"is_animation"`
+}
+
+func (*InputStoryContentVideo) GetType() string {
+	return TypeInputStoryContent
+}
+
+func (*InputStoryContentVideo) GetConstructor() string {
+	return ConstructorInputStoryContentVideo
+}
+
+func (*InputStoryContentVideo) InputStoryContentConstructor() string {
+	return ConstructorInputStoryContentVideo
+}
+
+func (entity *InputStoryContentVideo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *InputStoryContentVideo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *InputStoryContentVideo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"inputStoryContentVideo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"video":`)
+	encoder.writeType(entity.Video)
+	encoder.writeRaw(`,"added_sticker_file_ids":`)
+	writeList(encoder, entity.AddedStickerFileIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"duration":`)
+	encoder.writeFloat64(entity.Duration)
+	encoder.writeRaw(`,"cover_frame_timestamp":`)
+	encoder.writeFloat64(entity.CoverFrameTimestamp)
+	encoder.writeRaw(`,"is_animation":`)
+	encoder.writeBool(entity.IsAnimation)
+	encoder.writeRaw("}")
+}
+
+func (entity *InputStoryContentVideo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "video":
+			entity.Video, err = decodeInputFile(decoder)
+
+		case "added_sticker_file_ids":
+			entity.AddedStickerFileIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "duration":
+			entity.Duration, err = decoder.readFloat64()
+
+		case "cover_frame_timestamp":
+			entity.CoverFrameTimestamp, err = decoder.readFloat64()
+
+		case "is_animation":
+			entity.IsAnimation, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorInputStoryContentVideo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of stories, shown in the main chat list and folder chat lists
+type StoryListMain struct {
+	meta
+}
+
+func (*StoryListMain) GetType() string {
+	return TypeStoryList
+}
+
+func (*StoryListMain) GetConstructor() string {
+	return ConstructorStoryListMain
+}
+
+func (*StoryListMain) StoryListConstructor() string {
+	return ConstructorStoryListMain
+}
+
+func (entity *StoryListMain) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryListMain) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryListMain) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyListMain"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryListMain) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStoryListMain)
+	})
+}
+
+// The list of stories, shown in the Arvhive chat list
+type StoryListArchive struct {
+	meta
+}
+
+func (*StoryListArchive) GetType() string {
+	return TypeStoryList
+}
+
+func (*StoryListArchive) GetConstructor() string {
+	return ConstructorStoryListArchive
+}
+
+func (*StoryListArchive) StoryListConstructor() string {
+	return ConstructorStoryListArchive
+}
+
+func (entity *StoryListArchive) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryListArchive) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryListArchive) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyListArchive"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryListArchive) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStoryListArchive)
+	})
+}
+
+// The original story was a public story with known sender
+type StoryOriginPublicStory struct {
+	meta
+	// Identifier of the chat that posted original story
+	ChatId int64 `json:"chat_id"`
+	// Story identifier of the original story
+	StoryId int32 `json:"story_id"`
+}
+
+func (*StoryOriginPublicStory) GetType() string {
+	return TypeStoryOrigin
+}
+
+func (*StoryOriginPublicStory) GetConstructor() string {
+	return ConstructorStoryOriginPublicStory
+}
+
+func (*StoryOriginPublicStory) StoryOriginConstructor() string {
+	return ConstructorStoryOriginPublicStory
+}
+
+func (entity *StoryOriginPublicStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryOriginPublicStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryOriginPublicStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyOriginPublicStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryOriginPublicStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryOriginPublicStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The original story was sent by an unknown user
+type StoryOriginHiddenUser struct {
+	meta
+	// Name of the story sender
+	SenderName string `json:"sender_name"`
+}
+
+func (*StoryOriginHiddenUser) GetType() string {
+	return TypeStoryOrigin
+}
+
+func (*StoryOriginHiddenUser) GetConstructor() string {
+	return ConstructorStoryOriginHiddenUser
+}
+
+func (*StoryOriginHiddenUser) StoryOriginConstructor() string {
+	return ConstructorStoryOriginHiddenUser
+}
+
+func (entity *StoryOriginHiddenUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryOriginHiddenUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryOriginHiddenUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyOriginHiddenUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_name":`)
+	encoder.writeString(entity.SenderName)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryOriginHiddenUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_name":
+			entity.SenderName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryOriginHiddenUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about original story that was reposted
+type StoryRepostInfo struct {
+	meta
+	// Origin of the story that was reposted
+	Origin StoryOrigin `json:"origin"`
+	// True, if story content was modified during reposting; otherwise, story wasn't modified
+	IsContentModified bool `json:"is_content_modified"`
+}
+
+func (*StoryRepostInfo) GetType() string {
+	return TypeStoryRepostInfo
+}
+
+func (*StoryRepostInfo) GetConstructor() string {
+	return ConstructorStoryRepostInfo
+}
+
+func (entity *StoryRepostInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryRepostInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryRepostInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyRepostInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"origin":`)
+	encoder.writeType(entity.Origin)
+	encoder.writeRaw(`,"is_content_modified":`)
+	encoder.writeBool(entity.IsContentModified)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryRepostInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "origin":
+			entity.Origin, err = decodeStoryOrigin(decoder)
+
+		case "is_content_modified":
+			entity.IsContentModified, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryRepostInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about interactions with a story
+type StoryInteractionInfo struct {
+	meta
+	// Number of times the story was viewed
+	ViewCount int32 `json:"view_count"`
+	// Number of times the story was forwarded; 0 if none or unknown
+	ForwardCount int32 `json:"forward_count"`
+	// Number of reactions added to the story; 0 if none or unknown
+	ReactionCount int32 `json:"reaction_count"`
+	// Identifiers of at most 3 recent viewers of the story
+	RecentViewerUserIds []int64 `json:"recent_viewer_user_ids"`
+}
+
+func (*StoryInteractionInfo) GetType() string {
+	return TypeStoryInteractionInfo
+}
+
+func (*StoryInteractionInfo) GetConstructor() string {
+	return ConstructorStoryInteractionInfo
+}
+
+func (entity *StoryInteractionInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryInteractionInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryInteractionInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyInteractionInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"view_count":`)
+	encoder.writeInt32(entity.ViewCount)
+	encoder.writeRaw(`,"forward_count":`)
+	encoder.writeInt32(entity.ForwardCount)
+	encoder.writeRaw(`,"reaction_count":`)
+	encoder.writeInt32(entity.ReactionCount)
+	encoder.writeRaw(`,"recent_viewer_user_ids":`)
+	writeList(encoder, entity.RecentViewerUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryInteractionInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "view_count":
+			entity.ViewCount, err = decoder.readInt32()
+
+		case "forward_count":
+			entity.ForwardCount, err = decoder.readInt32()
+
+		case "reaction_count":
+			entity.ReactionCount, err = decoder.readInt32()
+
+		case "recent_viewer_user_ids":
+			entity.RecentViewerUserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryInteractionInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a story
+type Story struct {
+	meta
+	// Unique story identifier among stories of the given sender
+	Id int32 `json:"id"`
+	// Identifier of the chat that posted the story
+	SenderChatId int64 `json:"sender_chat_id"`
+	// Identifier of the sender of the story; may be null if the story is posted on behalf of the sender_chat_id
+	SenderId MessageSender `json:"sender_id,omitempty"`
+	// Point in time (Unix timestamp) when the story was published
+	Date int32 `json:"date"`
+	// True, if the story is being sent by the current user
+	IsBeingSent bool `json:"is_being_sent"`
+	// True, if the story is being edited by the current user
+	IsBeingEdited bool `json:"is_being_edited"`
+	// True, if the story was edited
+	IsEdited bool `json:"is_edited"`
+	// True, if the story is saved in the sender's profile and will be available there after expiration
+	IsPostedToChatPage bool `json:"is_posted_to_chat_page"`
+	// True, if the story is visible only for the current user
+	IsVisibleOnlyForSelf bool `json:"is_visible_only_for_self"`
+	// True, if the story can be deleted
+	CanBeDeleted bool `json:"can_be_deleted"`
+	// True, if the story can be edited
+	CanBeEdited bool `json:"can_be_edited"`
+	// True, if the story can be forwarded as a message. Otherwise, screenshots and saving of the story content must be also forbidden
+	CanBeForwarded bool `json:"can_be_forwarded"`
+	// True, if the story can be replied in the chat with the story sender
+	CanBeReplied bool `json:"can_be_replied"`
+	// True, if the story's is_posted_to_chat_page value can be changed
+	CanToggleIsPostedToChatPage bool `json:"can_toggle_is_posted_to_chat_page"`
+	// True, if the story statistics are available through getStoryStatistics
+	CanGetStatistics bool `json:"can_get_statistics"`
+	// True, if interactions with the story can be received through getStoryInteractions
+	CanGetInteractions bool `json:"can_get_interactions"`
+	// True, if users viewed the story can't be received, because the story has expired more than getOption("story_viewers_expiration_delay") seconds ago
+	HasExpiredViewers bool `json:"has_expired_viewers"`
+	// Information about the original story; may be null if the story wasn't reposted
+	RepostInfo *StoryRepostInfo `json:"repost_info,omitempty"`
+	// Information about interactions with the story; may be null if the story isn't owned or there were no interactions
+	InteractionInfo *StoryInteractionInfo `json:"interaction_info,omitempty"`
+	// Type of the chosen reaction; may be null if none
+	ChosenReactionType ReactionType `json:"chosen_reaction_type,omitempty"`
+	// Privacy rules affecting story visibility; may be approximate for non-owned stories
+	PrivacySettings StoryPrivacySettings `json:"privacy_settings"`
+	// Content of the story
+	Content StoryContent `json:"content"`
+	// Clickable areas to be shown on the story content
+	Areas []*StoryArea `json:"areas"`
+	// Caption of the story
+	Caption *FormattedText `json:"caption"`
+}
+
+func (*Story) GetType() string {
+	return TypeStory
+}
+
+func (*Story) GetConstructor() string {
+	return ConstructorStory
+}
+
+func (entity *Story) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Story) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Story) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"story"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"sender_chat_id":`)
+	encoder.writeInt64(entity.SenderChatId)
+	if entity.SenderId != nil {
+		encoder.writeRaw(`,"sender_id":`)
+		encoder.writeType(entity.SenderId)
+	}
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"is_being_sent":`)
+	encoder.writeBool(entity.IsBeingSent)
+	encoder.writeRaw(`,"is_being_edited":`)
+	encoder.writeBool(entity.IsBeingEdited)
+	encoder.writeRaw(`,"is_edited":`)
+	encoder.writeBool(entity.IsEdited)
+	encoder.writeRaw(`,"is_posted_to_chat_page":`)
+	encoder.writeBool(entity.IsPostedToChatPage)
+	encoder.writeRaw(`,"is_visible_only_for_self":`)
+	encoder.writeBool(entity.IsVisibleOnlyForSelf)
+	encoder.writeRaw(`,"can_be_deleted":`)
+	encoder.writeBool(entity.CanBeDeleted)
+	encoder.writeRaw(`,"can_be_edited":`)
+	encoder.writeBool(entity.CanBeEdited)
+	encoder.writeRaw(`,"can_be_forwarded":`)
+	encoder.writeBool(entity.CanBeForwarded)
+	encoder.writeRaw(`,"can_be_replied":`)
+	encoder.writeBool(entity.CanBeReplied)
+	encoder.writeRaw(`,"can_toggle_is_posted_to_chat_page":`)
+	encoder.writeBool(entity.CanToggleIsPostedToChatPage)
+	encoder.writeRaw(`,"can_get_statistics":`)
+	encoder.writeBool(entity.CanGetStatistics)
+	encoder.writeRaw(`,"can_get_interactions":`)
+	encoder.writeBool(entity.CanGetInteractions)
+	encoder.writeRaw(`,"has_expired_viewers":`)
+	encoder.writeBool(entity.HasExpiredViewers)
+	if entity.RepostInfo != nil {
+		encoder.writeRaw(`,"repost_info":`)
+		entity.RepostInfo.encodeJSON(encoder)
+	}
+	if entity.InteractionInfo != nil {
+		encoder.writeRaw(`,"interaction_info":`)
+		entity.InteractionInfo.encodeJSON(encoder)
+	}
+	if entity.ChosenReactionType != nil {
+		encoder.writeRaw(`,"chosen_reaction_type":`)
+		encoder.writeType(entity.ChosenReactionType)
+	}
+	encoder.writeRaw(`,"privacy_settings":`)
+	encoder.writeType(entity.PrivacySettings)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(entity.Content)
+	encoder.writeRaw(`,"areas":`)
+	writeList(encoder, entity.Areas, writeTypeValue[*StoryArea])
+	encoder.writeRaw(`,"caption":`)
+	entity.Caption.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *Story) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "sender_chat_id":
+			entity.SenderChatId, err = decoder.readInt64()
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "is_being_sent":
+			entity.IsBeingSent, err = decoder.readBool()
+
+		case "is_being_edited":
+			entity.IsBeingEdited, err = decoder.readBool()
+
+		case "is_edited":
+			entity.IsEdited, err = decoder.readBool()
+
+		case "is_posted_to_chat_page":
+			entity.IsPostedToChatPage, err = decoder.readBool()
+
+		case "is_visible_only_for_self":
+			entity.IsVisibleOnlyForSelf, err = decoder.readBool()
+
+		case "can_be_deleted":
+			entity.CanBeDeleted, err = decoder.readBool()
+
+		case "can_be_edited":
+			entity.CanBeEdited, err = decoder.readBool()
+
+		case "can_be_forwarded":
+			entity.CanBeForwarded, err = decoder.readBool()
+
+		case "can_be_replied":
+			entity.CanBeReplied, err = decoder.readBool()
+
+		case "can_toggle_is_posted_to_chat_page":
+			entity.CanToggleIsPostedToChatPage, err = decoder.readBool()
+
+		case "can_get_statistics":
+			entity.CanGetStatistics, err = decoder.readBool()
+
+		case "can_get_interactions":
+			entity.CanGetInteractions, err = decoder.readBool()
+
+		case "has_expired_viewers":
+			entity.HasExpiredViewers, err = decoder.readBool()
+
+		case "repost_info":
+			entity.RepostInfo, err = decodeEntity[StoryRepostInfo](decoder)
+
+		case "interaction_info":
+			entity.InteractionInfo, err = decodeEntity[StoryInteractionInfo](decoder)
+
+		case "chosen_reaction_type":
+			entity.ChosenReactionType, err = decodeReactionType(decoder)
+
+		case "privacy_settings":
+			entity.PrivacySettings, err = decodeStoryPrivacySettings(decoder)
+
+		case "content":
+			entity.Content, err = decodeStoryContent(decoder)
+
+		case "areas":
+			entity.Areas, err = readList(decoder, decodeEntity[StoryArea])
+
+		case "caption":
+			entity.Caption, err = decodeEntity[FormattedText](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of stories
+type Stories struct {
+	meta
+	// Approximate total number of stories found
+	TotalCount int32 `json:"total_count"`
+	// The list of stories
+	Stories []*Story `json:"stories"`
+	// Identifiers of the pinned stories; returned only in getChatPostedToChatPageStories with from_story_id == 0
+	PinnedStoryIds []int32 `json:"pinned_story_ids"`
+}
+
+func (*Stories) GetType() string {
+	return TypeStories
+}
+
+func (*Stories) GetConstructor() string {
+	return ConstructorStories
+}
+
+func (entity *Stories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Stories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Stories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"stories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"stories":`)
+	writeList(encoder, entity.Stories, writeTypeValue[*Story])
+	encoder.writeRaw(`,"pinned_story_ids":`)
+	writeList(encoder, entity.PinnedStoryIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *Stories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "stories":
+			entity.Stories, err = readList(decoder, decodeEntity[Story])
+
+		case "pinned_story_ids":
+			entity.PinnedStoryIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStories)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of stories found by a search
+type FoundStories struct {
+	meta
+	// Approximate total number of stories found
+	TotalCount int32 `json:"total_count"`
+	// List of stories
+	Stories []*Story `json:"stories"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*FoundStories) GetType() string {
+	return TypeFoundStories
+}
+
+func (*FoundStories) GetConstructor() string {
+	return ConstructorFoundStories
+}
+
+func (entity *FoundStories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundStories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundStories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundStories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"stories":`)
+	writeList(encoder, entity.Stories, writeTypeValue[*Story])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundStories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "stories":
+			entity.Stories, err = readList(decoder, decodeEntity[Story])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundStories)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains identifier of a story along with identifier of its sender
+type StoryFullId struct {
+	meta
+	// Identifier of the chat that posted the story
+	SenderChatId int64 `json:"sender_chat_id"`
+	// Unique story identifier among stories of the given sender
+	StoryId int32 `json:"story_id"`
+}
+
+func (*StoryFullId) GetType() string {
+	return TypeStoryFullId
+}
+
+func (*StoryFullId) GetConstructor() string {
+	return ConstructorStoryFullId
+}
+
+func (entity *StoryFullId) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryFullId) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryFullId) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyFullId"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_chat_id":`)
+	encoder.writeInt64(entity.SenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryFullId) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_chat_id":
+			entity.SenderChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryFullId)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains basic information about a story
+type StoryInfo struct {
+	meta
+	// Unique story identifier among stories of the given sender
+	StoryId int32 `json:"story_id"`
+	// Point in time (Unix timestamp) when the story was published
+	Date int32 `json:"date"`
+	// True, if the story is available only to close friends
+	IsForCloseFriends bool `json:"is_for_close_friends"`
+}
+
+func (*StoryInfo) GetType() string {
+	return TypeStoryInfo
+}
+
+func (*StoryInfo) GetConstructor() string {
+	return ConstructorStoryInfo
+}
+
+func (entity *StoryInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"is_for_close_friends":`)
+	encoder.writeBool(entity.IsForCloseFriends)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "is_for_close_friends":
+			entity.IsForCloseFriends, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Describes active stories posted by a chat
+type ChatActiveStories struct {
+	meta
+	// Identifier of the chat that posted the stories
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the story list in which the stories are shown; may be null if the stories aren't shown in a story list
+	List StoryList `json:"list,omitempty"`
+	// A parameter used to determine order of the stories in the story list; 0 if the stories doesn't need to be shown in the story list. Stories must be sorted by the pair (order, story_sender_chat_id) in descending order
+	Order int64 `json:"order"`
+	// Identifier of the last read active story
+	MaxReadStoryId int32 `json:"max_read_story_id"`
+	// Basic information about the stories; use getStory to get full information about the stories. The stories are in chronological order (i.e., in order of increasing story identifiers)
+	Stories []*StoryInfo `json:"stories"`
+}
+
+func (*ChatActiveStories) GetType() string {
+	return TypeChatActiveStories
+}
+
+func (*ChatActiveStories) GetConstructor() string {
+	return ConstructorChatActiveStories
+}
+
+func (entity *ChatActiveStories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ChatActiveStories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ChatActiveStories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"chatActiveStories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.List != nil {
+		encoder.writeRaw(`,"list":`)
+		encoder.writeType(entity.List)
+	}
+	encoder.writeRaw(`,"order":`)
+	encoder.writeInt64(entity.Order)
+	encoder.writeRaw(`,"max_read_story_id":`)
+	encoder.writeInt32(entity.MaxReadStoryId)
+	encoder.writeRaw(`,"stories":`)
+	writeList(encoder, entity.Stories, writeTypeValue[*StoryInfo])
+	encoder.writeRaw("}")
+}
+
+func (entity *ChatActiveStories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "list":
+			entity.List, err = decodeStoryList(decoder)
+
+		case "order":
+			entity.Order, err = decoder.readInt64()
+
+		case "max_read_story_id":
+			entity.MaxReadStoryId, err = decoder.readInt32()
+
+		case "stories":
+			entity.Stories, err = readList(decoder, decodeEntity[StoryInfo])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorChatActiveStories)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A view of the story
+type StoryInteractionTypeView struct {
+	meta
+	// Type of the reaction that was chosen by the viewer; may be null if none
+	ChosenReactionType ReactionType `json:"chosen_reaction_type,omitempty"`
+}
+
+func (*StoryInteractionTypeView) GetType() string {
+	return TypeStoryInteractionType
+}
+
+func (*StoryInteractionTypeView) GetConstructor() string {
+	return ConstructorStoryInteractionTypeView
+}
+
+func (*StoryInteractionTypeView) StoryInteractionTypeConstructor() string {
+	return ConstructorStoryInteractionTypeView
+}
+
+func (entity *StoryInteractionTypeView) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryInteractionTypeView) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryInteractionTypeView) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyInteractionTypeView"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.ChosenReactionType != nil {
+		encoder.writeRaw(`,"chosen_reaction_type":`)
+		encoder.writeType(entity.ChosenReactionType)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryInteractionTypeView) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chosen_reaction_type":
+			entity.ChosenReactionType, err = decodeReactionType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryInteractionTypeView)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A forward of the story as a message
+type StoryInteractionTypeForward struct {
+	meta
+	// The message with story forward
+	Message *Message `json:"message"`
+}
+
+func (*StoryInteractionTypeForward) GetType() string {
+	return TypeStoryInteractionType
+}
+
+func (*StoryInteractionTypeForward) GetConstructor() string {
+	return ConstructorStoryInteractionTypeForward
+}
+
+func (*StoryInteractionTypeForward) StoryInteractionTypeConstructor() string {
+	return ConstructorStoryInteractionTypeForward
+}
+
+func (entity *StoryInteractionTypeForward) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryInteractionTypeForward) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryInteractionTypeForward) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyInteractionTypeForward"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryInteractionTypeForward) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryInteractionTypeForward)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *StoryInteractionTypeForward) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A repost of the story as a story
+type StoryInteractionTypeRepost struct {
+	meta
+	// The reposted story
+	Story *Story `json:"story"`
+}
+
+func (*StoryInteractionTypeRepost) GetType() string {
+	return TypeStoryInteractionType
+}
+
+func (*StoryInteractionTypeRepost) GetConstructor() string {
+	return ConstructorStoryInteractionTypeRepost
+}
+
+func (*StoryInteractionTypeRepost) StoryInteractionTypeConstructor() string {
+	return ConstructorStoryInteractionTypeRepost
+}
+
+func (entity *StoryInteractionTypeRepost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryInteractionTypeRepost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryInteractionTypeRepost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyInteractionTypeRepost"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story":`)
+	entity.Story.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryInteractionTypeRepost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story":
+			entity.Story, err = decodeEntity[Story](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryInteractionTypeRepost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents interaction with a story
+type StoryInteraction struct {
+	meta
+	// Identifier of the user or chat that made the interaction
+	ActorId MessageSender `json:"actor_id"`
+	// Approximate point in time (Unix timestamp) when the interaction happened
+	InteractionDate int32 `json:"interaction_date"`
+	// Block list to which the actor is added; may be null if none or for chat stories
+	BlockList BlockList `json:"block_list,omitempty"`
+	// Type of the interaction
+	Type StoryInteractionType `json:"type"`
+}
+
+func (*StoryInteraction) GetType() string {
+	return TypeStoryInteraction
+}
+
+func (*StoryInteraction) GetConstructor() string {
+	return ConstructorStoryInteraction
+}
+
+func (entity *StoryInteraction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryInteraction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryInteraction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyInteraction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"actor_id":`)
+	encoder.writeType(entity.ActorId)
+	encoder.writeRaw(`,"interaction_date":`)
+	encoder.writeInt32(entity.InteractionDate)
+	if entity.BlockList != nil {
+		encoder.writeRaw(`,"block_list":`)
+		encoder.writeType(entity.BlockList)
+	}
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryInteraction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "actor_id":
+			entity.ActorId, err = decodeMessageSender(decoder)
+
+		case "interaction_date":
+			entity.InteractionDate, err = decoder.readInt32()
+
+		case "block_list":
+			entity.BlockList, err = decodeBlockList(decoder)
+
+		case "type":
+			entity.Type, err = decodeStoryInteractionType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryInteraction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *StoryInteraction) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("type", entity.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a list of interactions with a story
+type StoryInteractions struct {
+	meta
+	// Approximate total number of interactions found
+	TotalCount int32 `json:"total_count"`
+	// Approximate total number of found forwards and reposts; always 0 for chat stories
+	TotalForwardCount int32 `json:"total_forward_count"`
+	// Approximate total number of found reactions; always 0 for chat stories
+	TotalReactionCount int32 `json:"total_reaction_count"`
+	// List of story interactions
+	Interactions []*StoryInteraction `json:"interactions"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*StoryInteractions) GetType() string {
+	return TypeStoryInteractions
+}
+
+func (*StoryInteractions) GetConstructor() string {
+	return ConstructorStoryInteractions
+}
+
+func (entity *StoryInteractions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryInteractions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryInteractions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyInteractions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"total_forward_count":`)
+	encoder.writeInt32(entity.TotalForwardCount)
+	encoder.writeRaw(`,"total_reaction_count":`)
+	encoder.writeInt32(entity.TotalReactionCount)
+	encoder.writeRaw(`,"interactions":`)
+	writeList(encoder, entity.Interactions, writeTypeValue[*StoryInteraction])
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryInteractions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "total_forward_count":
+			entity.TotalForwardCount, err = decoder.readInt32()
+
+		case "total_reaction_count":
+			entity.TotalReactionCount, err = decoder.readInt32()
+
+		case "interactions":
+			entity.Interactions, err = readList(decoder, decodeEntity[StoryInteraction])
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryInteractions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *StoryInteractions) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Interactions {
+		if err := validateNested(indexField("interactions", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A story can be sent
+type CanSendStoryResultOk struct {
+	meta
+}
+
+func (*CanSendStoryResultOk) GetType() string {
+	return TypeCanSendStoryResult
+}
+
+func (*CanSendStoryResultOk) GetConstructor() string {
+	return ConstructorCanSendStoryResultOk
+}
+
+func (*CanSendStoryResultOk) CanSendStoryResultConstructor() string {
+	return ConstructorCanSendStoryResultOk
+}
+
+func (entity *CanSendStoryResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendStoryResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendStoryResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendStoryResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendStoryResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanSendStoryResultOk)
+	})
+}
+
+// The user must subscribe to Telegram Premium to be able to post stories
+type CanSendStoryResultPremiumNeeded struct {
+	meta
+}
+
+func (*CanSendStoryResultPremiumNeeded) GetType() string {
+	return TypeCanSendStoryResult
+}
+
+func (*CanSendStoryResultPremiumNeeded) GetConstructor() string {
+	return ConstructorCanSendStoryResultPremiumNeeded
+}
+
+func (*CanSendStoryResultPremiumNeeded) CanSendStoryResultConstructor() string {
+	return ConstructorCanSendStoryResultPremiumNeeded
+}
+
+func (entity *CanSendStoryResultPremiumNeeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendStoryResultPremiumNeeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendStoryResultPremiumNeeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendStoryResultPremiumNeeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendStoryResultPremiumNeeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanSendStoryResultPremiumNeeded)
+	})
+}
+
+// The chat must be boosted first by Telegram Premium subscribers to post more stories. Call getChatBoostStatus to get current boost status of the chat
+type CanSendStoryResultBoostNeeded struct {
+	meta
+}
+
+func (*CanSendStoryResultBoostNeeded) GetType() string {
+	return TypeCanSendStoryResult
+}
+
+func (*CanSendStoryResultBoostNeeded) GetConstructor() string {
+	return ConstructorCanSendStoryResultBoostNeeded
+}
+
+func (*CanSendStoryResultBoostNeeded) CanSendStoryResultConstructor() string {
+	return ConstructorCanSendStoryResultBoostNeeded
+}
+
+func (entity *CanSendStoryResultBoostNeeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendStoryResultBoostNeeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendStoryResultBoostNeeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendStoryResultBoostNeeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendStoryResultBoostNeeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanSendStoryResultBoostNeeded)
+	})
+}
+
+// The limit for the number of active stories exceeded. The user can buy Telegram Premium, delete an active story, or wait for the oldest story to expire
+type CanSendStoryResultActiveStoryLimitExceeded struct {
+	meta
+}
+
+func (*CanSendStoryResultActiveStoryLimitExceeded) GetType() string {
+	return TypeCanSendStoryResult
+}
+
+func (*CanSendStoryResultActiveStoryLimitExceeded) GetConstructor() string {
+	return ConstructorCanSendStoryResultActiveStoryLimitExceeded
+}
+
+func (*CanSendStoryResultActiveStoryLimitExceeded) CanSendStoryResultConstructor() string {
+	return ConstructorCanSendStoryResultActiveStoryLimitExceeded
+}
+
+func (entity *CanSendStoryResultActiveStoryLimitExceeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendStoryResultActiveStoryLimitExceeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendStoryResultActiveStoryLimitExceeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendStoryResultActiveStoryLimitExceeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendStoryResultActiveStoryLimitExceeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorCanSendStoryResultActiveStoryLimitExceeded)
+	})
+}
+
+// The weekly limit for the number of posted stories exceeded. The user needs to buy Telegram Premium or wait specified time
+type CanSendStoryResultWeeklyLimitExceeded struct {
+	meta
+	// Time left before the user can send the next story
+	RetryAfter int32 `json:"retry_after"`
+}
+
+func (*CanSendStoryResultWeeklyLimitExceeded) GetType() string {
+	return TypeCanSendStoryResult
+}
+
+func (*CanSendStoryResultWeeklyLimitExceeded) GetConstructor() string {
+	return ConstructorCanSendStoryResultWeeklyLimitExceeded
+}
+
+func (*CanSendStoryResultWeeklyLimitExceeded) CanSendStoryResultConstructor() string {
+	return ConstructorCanSendStoryResultWeeklyLimitExceeded
+}
+
+func (entity *CanSendStoryResultWeeklyLimitExceeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendStoryResultWeeklyLimitExceeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendStoryResultWeeklyLimitExceeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendStoryResultWeeklyLimitExceeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"retry_after":`)
+	encoder.writeInt32(entity.RetryAfter)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendStoryResultWeeklyLimitExceeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "retry_after":
+			entity.RetryAfter, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCanSendStoryResultWeeklyLimitExceeded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The monthly limit for the number of posted stories exceeded. The user needs to buy Telegram Premium or wait specified time
+type CanSendStoryResultMonthlyLimitExceeded struct {
+	meta
+	// Time left before the user can send the next story
+	RetryAfter int32 `json:"retry_after"`
+}
+
+func (*CanSendStoryResultMonthlyLimitExceeded) GetType() string {
+	return TypeCanSendStoryResult
+}
+
+func (*CanSendStoryResultMonthlyLimitExceeded) GetConstructor() string {
+	return ConstructorCanSendStoryResultMonthlyLimitExceeded
+}
+
+func (*CanSendStoryResultMonthlyLimitExceeded) CanSendStoryResultConstructor() string {
+	return ConstructorCanSendStoryResultMonthlyLimitExceeded
+}
+
+func (entity *CanSendStoryResultMonthlyLimitExceeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CanSendStoryResultMonthlyLimitExceeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CanSendStoryResultMonthlyLimitExceeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"canSendStoryResultMonthlyLimitExceeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"retry_after":`)
+	encoder.writeInt32(entity.RetryAfter)
+	encoder.writeRaw("}")
+}
+
+func (entity *CanSendStoryResultMonthlyLimitExceeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "retry_after":
+			entity.RetryAfter, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCanSendStoryResultMonthlyLimitExceeded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The story can be viewed by everyone
+type StoryPrivacySettingsEveryone struct {
+	meta
+	// Identifiers of the users that can't see the story; always unknown and empty for non-owned stories
+	ExceptUserIds []int64 `json:"except_user_ids"`
+}
+
+func (*StoryPrivacySettingsEveryone) GetType() string {
+	return TypeStoryPrivacySettings
+}
+
+func (*StoryPrivacySettingsEveryone) GetConstructor() string {
+	return ConstructorStoryPrivacySettingsEveryone
+}
+
+func (*StoryPrivacySettingsEveryone) StoryPrivacySettingsConstructor() string {
+	return ConstructorStoryPrivacySettingsEveryone
+}
+
+func (entity *StoryPrivacySettingsEveryone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryPrivacySettingsEveryone) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryPrivacySettingsEveryone) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyPrivacySettingsEveryone"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"except_user_ids":`)
+	writeList(encoder, entity.ExceptUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryPrivacySettingsEveryone) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "except_user_ids":
+			entity.ExceptUserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryPrivacySettingsEveryone)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The story can be viewed by all contacts except chosen users
+type StoryPrivacySettingsContacts struct {
+	meta
+	// User identifiers of the contacts that can't see the story; always unknown and empty for non-owned stories
+	ExceptUserIds []int64 `json:"except_user_ids"`
+}
+
+func (*StoryPrivacySettingsContacts) GetType() string {
+	return TypeStoryPrivacySettings
+}
+
+func (*StoryPrivacySettingsContacts) GetConstructor() string {
+	return ConstructorStoryPrivacySettingsContacts
+}
+
+func (*StoryPrivacySettingsContacts) StoryPrivacySettingsConstructor() string {
+	return ConstructorStoryPrivacySettingsContacts
+}
+
+func (entity *StoryPrivacySettingsContacts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryPrivacySettingsContacts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryPrivacySettingsContacts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyPrivacySettingsContacts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"except_user_ids":`)
+	writeList(encoder, entity.ExceptUserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryPrivacySettingsContacts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "except_user_ids":
+			entity.ExceptUserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryPrivacySettingsContacts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The story can be viewed by all close friends
+type StoryPrivacySettingsCloseFriends struct {
+	meta
+}
+
+func (*StoryPrivacySettingsCloseFriends) GetType() string {
+	return TypeStoryPrivacySettings
+}
+
+func (*StoryPrivacySettingsCloseFriends) GetConstructor() string {
+	return ConstructorStoryPrivacySettingsCloseFriends
+}
+
+func (*StoryPrivacySettingsCloseFriends) StoryPrivacySettingsConstructor() string {
+	return ConstructorStoryPrivacySettingsCloseFriends
+}
+
+func (entity *StoryPrivacySettingsCloseFriends) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryPrivacySettingsCloseFriends) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryPrivacySettingsCloseFriends) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyPrivacySettingsCloseFriends"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryPrivacySettingsCloseFriends) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorStoryPrivacySettingsCloseFriends)
+	})
+}
+
+// The story can be viewed by certain specified users
+type StoryPrivacySettingsSelectedUsers struct {
+	meta
+	// Identifiers of the users; always unknown and empty for non-owned stories
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (*StoryPrivacySettingsSelectedUsers) GetType() string {
+	return TypeStoryPrivacySettings
+}
+
+func (*StoryPrivacySettingsSelectedUsers) GetConstructor() string {
+	return ConstructorStoryPrivacySettingsSelectedUsers
+}
+
+func (*StoryPrivacySettingsSelectedUsers) StoryPrivacySettingsConstructor() string {
+	return ConstructorStoryPrivacySettingsSelectedUsers
+}
+
+func (entity *StoryPrivacySettingsSelectedUsers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryPrivacySettingsSelectedUsers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryPrivacySettingsSelectedUsers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyPrivacySettingsSelectedUsers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryPrivacySettingsSelectedUsers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryPrivacySettingsSelectedUsers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The story was reported successfully
+type ReportStoryResultOk struct {
+	meta
+}
+
+func (*ReportStoryResultOk) GetType() string {
+	return TypeReportStoryResult
+}
+
+func (*ReportStoryResultOk) GetConstructor() string {
+	return ConstructorReportStoryResultOk
+}
+
+func (*ReportStoryResultOk) ReportStoryResultConstructor() string {
+	return ConstructorReportStoryResultOk
+}
+
+func (entity *ReportStoryResultOk) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportStoryResultOk) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportStoryResultOk) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportStoryResultOk"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportStoryResultOk) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorReportStoryResultOk)
+	})
+}
+
+// The user must choose an option to report the story and repeat request with the chosen option
+type ReportStoryResultOptionRequired struct {
+	meta
+	// Title for the option choice
+	Title string `json:"title"`
+	// List of available options
+	Options []*ReportOption `json:"options"`
+}
+
+func (*ReportStoryResultOptionRequired) GetType() string {
+	return TypeReportStoryResult
+}
+
+func (*ReportStoryResultOptionRequired) GetConstructor() string {
+	return ConstructorReportStoryResultOptionRequired
+}
+
+func (*ReportStoryResultOptionRequired) ReportStoryResultConstructor() string {
+	return ConstructorReportStoryResultOptionRequired
+}
+
+func (entity *ReportStoryResultOptionRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportStoryResultOptionRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportStoryResultOptionRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportStoryResultOptionRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw(`,"options":`)
+	writeList(encoder, entity.Options, writeTypeValue[*ReportOption])
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportStoryResultOptionRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		case "options":
+			entity.Options, err = readList(decoder, decodeEntity[ReportOption])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReportStoryResultOptionRequired)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user must add additional text details to the report
+type ReportStoryResultTextRequired struct {
+	meta
+	// Option identifier for the next reportStory request
+	OptionId []byte `json:"option_id"`
+	// True, if the user can skip text adding
+	IsOptional bool `json:"is_optional"`
+}
+
+func (*ReportStoryResultTextRequired) GetType() string {
+	return TypeReportStoryResult
+}
+
+func (*ReportStoryResultTextRequired) GetConstructor() string {
+	return ConstructorReportStoryResultTextRequired
+}
+
+func (*ReportStoryResultTextRequired) ReportStoryResultConstructor() string {
+	return ConstructorReportStoryResultTextRequired
+}
+
+func (entity *ReportStoryResultTextRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReportStoryResultTextRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReportStoryResultTextRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"reportStoryResultTextRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"option_id":`)
+	encoder.writeBytes(entity.OptionId)
+	encoder.writeRaw(`,"is_optional":`)
+	encoder.writeBool(entity.IsOptional)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReportStoryResultTextRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "option_id":
+			entity.OptionId, err = decoder.readBytes()
+
+		case "is_optional":
+			entity.IsOptional, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReportStoryResultTextRequired)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A detailed statistics about a story
+type StoryStatistics struct {
+	meta
+	// A graph containing number of story views and shares
+	StoryInteractionGraph StatisticalGraph `json:"story_interaction_graph"`
+	// A graph containing number of story reactions
+	StoryReactionGraph StatisticalGraph `json:"story_reaction_graph"`
+}
+
+func (*StoryStatistics) GetType() string {
+	return TypeStoryStatistics
+}
+
+func (*StoryStatistics) GetConstructor() string {
+	return ConstructorStoryStatistics
+}
+
+func (entity *StoryStatistics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *StoryStatistics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *StoryStatistics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"storyStatistics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_interaction_graph":`)
+	encoder.writeType(entity.StoryInteractionGraph)
+	encoder.writeRaw(`,"story_reaction_graph":`)
+	encoder.writeType(entity.StoryReactionGraph)
+	encoder.writeRaw("}")
+}
+
+func (entity *StoryStatistics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_interaction_graph":
+			entity.StoryInteractionGraph, err = decodeStatisticalGraph(decoder)
+
+		case "story_reaction_graph":
+			entity.StoryReactionGraph, err = decodeStatisticalGraph(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorStoryStatistics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
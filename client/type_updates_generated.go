@@ -0,0 +1,11074 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeUpdate  = "Update"
+	TypeUpdates = "Updates"
+)
+
+const (
+	ConstructorUpdateAuthorizationState                       = "updateAuthorizationState"
+	ConstructorUpdateNewMessage                               = "updateNewMessage"
+	ConstructorUpdateMessageSendAcknowledged                  = "updateMessageSendAcknowledged"
+	ConstructorUpdateMessageSendSucceeded                     = "updateMessageSendSucceeded"
+	ConstructorUpdateMessageSendFailed                        = "updateMessageSendFailed"
+	ConstructorUpdateMessageContent                           = "updateMessageContent"
+	ConstructorUpdateMessageEdited                            = "updateMessageEdited"
+	ConstructorUpdateMessageIsPinned                          = "updateMessageIsPinned"
+	ConstructorUpdateMessageInteractionInfo                   = "updateMessageInteractionInfo"
+	ConstructorUpdateMessageContentOpened                     = "updateMessageContentOpened"
+	ConstructorUpdateMessageMentionRead                       = "updateMessageMentionRead"
+	ConstructorUpdateMessageUnreadReactions                   = "updateMessageUnreadReactions"
+	ConstructorUpdateMessageFactCheck                         = "updateMessageFactCheck"
+	ConstructorUpdateMessageLiveLocationViewed                = "updateMessageLiveLocationViewed"
+	ConstructorUpdateVideoPublished                           = "updateVideoPublished"
+	ConstructorUpdateNewChat                                  = "updateNewChat"
+	ConstructorUpdateChatTitle                                = "updateChatTitle"
+	ConstructorUpdateChatPhoto                                = "updateChatPhoto"
+	ConstructorUpdateChatAccentColors                         = "updateChatAccentColors"
+	ConstructorUpdateChatPermissions                          = "updateChatPermissions"
+	ConstructorUpdateChatLastMessage                          = "updateChatLastMessage"
+	ConstructorUpdateChatPosition                             = "updateChatPosition"
+	ConstructorUpdateChatAddedToList                          = "updateChatAddedToList"
+	ConstructorUpdateChatRemovedFromList                      = "updateChatRemovedFromList"
+	ConstructorUpdateChatReadInbox                            = "updateChatReadInbox"
+	ConstructorUpdateChatReadOutbox                           = "updateChatReadOutbox"
+	ConstructorUpdateChatActionBar                            = "updateChatActionBar"
+	ConstructorUpdateChatBusinessBotManageBar                 = "updateChatBusinessBotManageBar"
+	ConstructorUpdateChatAvailableReactions                   = "updateChatAvailableReactions"
+	ConstructorUpdateChatDraftMessage                         = "updateChatDraftMessage"
+	ConstructorUpdateChatEmojiStatus                          = "updateChatEmojiStatus"
+	ConstructorUpdateChatMessageSender                        = "updateChatMessageSender"
+	ConstructorUpdateChatMessageAutoDeleteTime                = "updateChatMessageAutoDeleteTime"
+	ConstructorUpdateChatNotificationSettings                 = "updateChatNotificationSettings"
+	ConstructorUpdateChatPendingJoinRequests                  = "updateChatPendingJoinRequests"
+	ConstructorUpdateChatReplyMarkup                          = "updateChatReplyMarkup"
+	ConstructorUpdateChatBackground                           = "updateChatBackground"
+	ConstructorUpdateChatTheme                                = "updateChatTheme"
+	ConstructorUpdateChatUnreadMentionCount                   = "updateChatUnreadMentionCount"
+	ConstructorUpdateChatUnreadReactionCount                  = "updateChatUnreadReactionCount"
+	ConstructorUpdateChatVideoChat                            = "updateChatVideoChat"
+	ConstructorUpdateChatDefaultDisableNotification           = "updateChatDefaultDisableNotification"
+	ConstructorUpdateChatHasProtectedContent                  = "updateChatHasProtectedContent"
+	ConstructorUpdateChatIsTranslatable                       = "updateChatIsTranslatable"
+	ConstructorUpdateChatIsMarkedAsUnread                     = "updateChatIsMarkedAsUnread"
+	ConstructorUpdateChatViewAsTopics                         = "updateChatViewAsTopics"
+	ConstructorUpdateChatBlockList                            = "updateChatBlockList"
+	ConstructorUpdateChatHasScheduledMessages                 = "updateChatHasScheduledMessages"
+	ConstructorUpdateChatFolders                              = "updateChatFolders"
+	ConstructorUpdateChatOnlineMemberCount                    = "updateChatOnlineMemberCount"
+	ConstructorUpdateSavedMessagesTopic                       = "updateSavedMessagesTopic"
+	ConstructorUpdateSavedMessagesTopicCount                  = "updateSavedMessagesTopicCount"
+	ConstructorUpdateQuickReplyShortcut                       = "updateQuickReplyShortcut"
+	ConstructorUpdateQuickReplyShortcutDeleted                = "updateQuickReplyShortcutDeleted"
+	ConstructorUpdateQuickReplyShortcuts                      = "updateQuickReplyShortcuts"
+	ConstructorUpdateQuickReplyShortcutMessages               = "updateQuickReplyShortcutMessages"
+	ConstructorUpdateForumTopicInfo                           = "updateForumTopicInfo"
+	ConstructorUpdateForumTopic                               = "updateForumTopic"
+	ConstructorUpdateScopeNotificationSettings                = "updateScopeNotificationSettings"
+	ConstructorUpdateReactionNotificationSettings             = "updateReactionNotificationSettings"
+	ConstructorUpdateNotification                             = "updateNotification"
+	ConstructorUpdateNotificationGroup                        = "updateNotificationGroup"
+	ConstructorUpdateActiveNotifications                      = "updateActiveNotifications"
+	ConstructorUpdateHavePendingNotifications                 = "updateHavePendingNotifications"
+	ConstructorUpdateDeleteMessages                           = "updateDeleteMessages"
+	ConstructorUpdateChatAction                               = "updateChatAction"
+	ConstructorUpdateUserStatus                               = "updateUserStatus"
+	ConstructorUpdateUser                                     = "updateUser"
+	ConstructorUpdateBasicGroup                               = "updateBasicGroup"
+	ConstructorUpdateSupergroup                               = "updateSupergroup"
+	ConstructorUpdateSecretChat                               = "updateSecretChat"
+	ConstructorUpdateUserFullInfo                             = "updateUserFullInfo"
+	ConstructorUpdateBasicGroupFullInfo                       = "updateBasicGroupFullInfo"
+	ConstructorUpdateSupergroupFullInfo                       = "updateSupergroupFullInfo"
+	ConstructorUpdateServiceNotification                      = "updateServiceNotification"
+	ConstructorUpdateFile                                     = "updateFile"
+	ConstructorUpdateFileGenerationStart                      = "updateFileGenerationStart"
+	ConstructorUpdateFileGenerationStop                       = "updateFileGenerationStop"
+	ConstructorUpdateFileDownloads                            = "updateFileDownloads"
+	ConstructorUpdateFileAddedToDownloads                     = "updateFileAddedToDownloads"
+	ConstructorUpdateFileDownload                             = "updateFileDownload"
+	ConstructorUpdateFileRemovedFromDownloads                 = "updateFileRemovedFromDownloads"
+	ConstructorUpdateApplicationVerificationRequired          = "updateApplicationVerificationRequired"
+	ConstructorUpdateApplicationRecaptchaVerificationRequired = "updateApplicationRecaptchaVerificationRequired"
+	ConstructorUpdateCall                                     = "updateCall"
+	ConstructorUpdateGroupCall                                = "updateGroupCall"
+	ConstructorUpdateGroupCallParticipant                     = "updateGroupCallParticipant"
+	ConstructorUpdateNewCallSignalingData                     = "updateNewCallSignalingData"
+	ConstructorUpdateUserPrivacySettingRules                  = "updateUserPrivacySettingRules"
+	ConstructorUpdateUnreadMessageCount                       = "updateUnreadMessageCount"
+	ConstructorUpdateUnreadChatCount                          = "updateUnreadChatCount"
+	ConstructorUpdateStory                                    = "updateStory"
+	ConstructorUpdateStoryDeleted                             = "updateStoryDeleted"
+	ConstructorUpdateStorySendSucceeded                       = "updateStorySendSucceeded"
+	ConstructorUpdateStorySendFailed                          = "updateStorySendFailed"
+	ConstructorUpdateChatActiveStories                        = "updateChatActiveStories"
+	ConstructorUpdateStoryListChatCount                       = "updateStoryListChatCount"
+	ConstructorUpdateStoryStealthMode                         = "updateStoryStealthMode"
+	ConstructorUpdateOption                                   = "updateOption"
+	ConstructorUpdateStickerSet                               = "updateStickerSet"
+	ConstructorUpdateInstalledStickerSets                     = "updateInstalledStickerSets"
+	ConstructorUpdateTrendingStickerSets                      = "updateTrendingStickerSets"
+	ConstructorUpdateRecentStickers                           = "updateRecentStickers"
+	ConstructorUpdateFavoriteStickers                         = "updateFavoriteStickers"
+	ConstructorUpdateSavedAnimations                          = "updateSavedAnimations"
+	ConstructorUpdateSavedNotificationSounds                  = "updateSavedNotificationSounds"
+	ConstructorUpdateDefaultBackground                        = "updateDefaultBackground"
+	ConstructorUpdateChatThemes                               = "updateChatThemes"
+	ConstructorUpdateAccentColors                             = "updateAccentColors"
+	ConstructorUpdateProfileAccentColors                      = "updateProfileAccentColors"
+	ConstructorUpdateLanguagePackStrings                      = "updateLanguagePackStrings"
+	ConstructorUpdateConnectionState                          = "updateConnectionState"
+	ConstructorUpdateFreezeState                              = "updateFreezeState"
+	ConstructorUpdateTermsOfService                           = "updateTermsOfService"
+	ConstructorUpdateUnconfirmedSession                       = "updateUnconfirmedSession"
+	ConstructorUpdateAttachmentMenuBots                       = "updateAttachmentMenuBots"
+	ConstructorUpdateWebAppMessageSent                        = "updateWebAppMessageSent"
+	ConstructorUpdateActiveEmojiReactions                     = "updateActiveEmojiReactions"
+	ConstructorUpdateAvailableMessageEffects                  = "updateAvailableMessageEffects"
+	ConstructorUpdateDefaultReactionType                      = "updateDefaultReactionType"
+	ConstructorUpdateDefaultPaidReactionType                  = "updateDefaultPaidReactionType"
+	ConstructorUpdateSavedMessagesTags                        = "updateSavedMessagesTags"
+	ConstructorUpdateActiveLiveLocationMessages               = "updateActiveLiveLocationMessages"
+	ConstructorUpdateOwnedStarCount                           = "updateOwnedStarCount"
+	ConstructorUpdateChatRevenueAmount                        = "updateChatRevenueAmount"
+	ConstructorUpdateStarRevenueStatus                        = "updateStarRevenueStatus"
+	ConstructorUpdateSpeechRecognitionTrial                   = "updateSpeechRecognitionTrial"
+	ConstructorUpdateDiceEmojis                               = "updateDiceEmojis"
+	ConstructorUpdateAnimatedEmojiMessageClicked              = "updateAnimatedEmojiMessageClicked"
+	ConstructorUpdateAnimationSearchParameters                = "updateAnimationSearchParameters"
+	ConstructorUpdateSuggestedActions                         = "updateSuggestedActions"
+	ConstructorUpdateSpeedLimitNotification                   = "updateSpeedLimitNotification"
+	ConstructorUpdateContactCloseBirthdays                    = "updateContactCloseBirthdays"
+	ConstructorUpdateAutosaveSettings                         = "updateAutosaveSettings"
+	ConstructorUpdateBusinessConnection                       = "updateBusinessConnection"
+	ConstructorUpdateNewBusinessMessage                       = "updateNewBusinessMessage"
+	ConstructorUpdateBusinessMessageEdited                    = "updateBusinessMessageEdited"
+	ConstructorUpdateBusinessMessagesDeleted                  = "updateBusinessMessagesDeleted"
+	ConstructorUpdateNewInlineQuery                           = "updateNewInlineQuery"
+	ConstructorUpdateNewChosenInlineResult                    = "updateNewChosenInlineResult"
+	ConstructorUpdateNewCallbackQuery                         = "updateNewCallbackQuery"
+	ConstructorUpdateNewInlineCallbackQuery                   = "updateNewInlineCallbackQuery"
+	ConstructorUpdateNewBusinessCallbackQuery                 = "updateNewBusinessCallbackQuery"
+	ConstructorUpdateNewShippingQuery                         = "updateNewShippingQuery"
+	ConstructorUpdateNewPreCheckoutQuery                      = "updateNewPreCheckoutQuery"
+	ConstructorUpdateNewCustomEvent                           = "updateNewCustomEvent"
+	ConstructorUpdateNewCustomQuery                           = "updateNewCustomQuery"
+	ConstructorUpdatePoll                                     = "updatePoll"
+	ConstructorUpdatePollAnswer                               = "updatePollAnswer"
+	ConstructorUpdateChatMember                               = "updateChatMember"
+	ConstructorUpdateNewChatJoinRequest                       = "updateNewChatJoinRequest"
+	ConstructorUpdateChatBoost                                = "updateChatBoost"
+	ConstructorUpdateMessageReaction                          = "updateMessageReaction"
+	ConstructorUpdateMessageReactions                         = "updateMessageReactions"
+	ConstructorUpdatePaidMediaPurchased                       = "updatePaidMediaPurchased"
+	ConstructorUpdates                                        = "updates"
+)
+
+// Contains notifications about data changes
+type Update interface {
+	UpdateConstructor() string
+}
+
+func (entity *UnknownType) UpdateConstructor() string {
+	return entity.Constructor
+}
+
+// The user authorization state has changed
+type UpdateAuthorizationState struct {
+	meta
+	// New authorization state
+	AuthorizationState AuthorizationState `json:"authorization_state"`
+}
+
+func (*UpdateAuthorizationState) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateAuthorizationState) GetConstructor() string {
+	return ConstructorUpdateAuthorizationState
+}
+
+func (*UpdateAuthorizationState) UpdateConstructor() string {
+	return ConstructorUpdateAuthorizationState
+}
+
+func (entity *UpdateAuthorizationState) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateAuthorizationState) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateAuthorizationState) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateAuthorizationState"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"authorization_state":`)
+	encoder.writeType(entity.AuthorizationState)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateAuthorizationState) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "authorization_state":
+			entity.AuthorizationState, err = decodeAuthorizationState(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateAuthorizationState)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new message was received; can also be an outgoing message
+type UpdateNewMessage struct {
+	meta
+	// The new message
+	Message *Message `json:"message"`
+}
+
+func (*UpdateNewMessage) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewMessage) GetConstructor() string {
+	return ConstructorUpdateNewMessage
+}
+
+func (*UpdateNewMessage) UpdateConstructor() string {
+	return ConstructorUpdateNewMessage
+}
+
+func (entity *UpdateNewMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateNewMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A request to send a message has reached the Telegram server. This doesn't mean that the message will be sent successfully. This update is sent only if the option "use_quick_ack" is set to true. This update may be sent multiple times for the same message
+type UpdateMessageSendAcknowledged struct {
+	meta
+	// The chat identifier of the sent message
+	ChatId int64 `json:"chat_id"`
+	// A temporary message identifier
+	MessageId int64 `json:"message_id"`
+}
+
+func (*UpdateMessageSendAcknowledged) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageSendAcknowledged) GetConstructor() string {
+	return ConstructorUpdateMessageSendAcknowledged
+}
+
+func (*UpdateMessageSendAcknowledged) UpdateConstructor() string {
+	return ConstructorUpdateMessageSendAcknowledged
+}
+
+func (entity *UpdateMessageSendAcknowledged) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageSendAcknowledged) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageSendAcknowledged) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageSendAcknowledged"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageSendAcknowledged) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageSendAcknowledged)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message has been successfully sent
+type UpdateMessageSendSucceeded struct {
+	meta
+	// The sent message. Almost any field of the new message can be different from the corresponding field of the original message. For example, the field scheduling_state may change, making the message scheduled, or non-scheduled
+	Message *Message `json:"message"`
+	// The previous temporary message identifier
+	OldMessageId int64 `json:"old_message_id"`
+}
+
+func (*UpdateMessageSendSucceeded) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageSendSucceeded) GetConstructor() string {
+	return ConstructorUpdateMessageSendSucceeded
+}
+
+func (*UpdateMessageSendSucceeded) UpdateConstructor() string {
+	return ConstructorUpdateMessageSendSucceeded
+}
+
+func (entity *UpdateMessageSendSucceeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageSendSucceeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageSendSucceeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageSendSucceeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw(`,"old_message_id":`)
+	encoder.writeInt64(entity.OldMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageSendSucceeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		case "old_message_id":
+			entity.OldMessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageSendSucceeded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateMessageSendSucceeded) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message failed to send. Be aware that some messages being sent can be irrecoverably deleted, in which case updateDeleteMessages will be received instead of this update
+type UpdateMessageSendFailed struct {
+	meta
+	// The failed to send message
+	Message *Message `json:"message"`
+	// The previous temporary message identifier
+	OldMessageId int64 `json:"old_message_id"`
+	// The cause of the message sending failure
+	Error *Error `json:"error"`
+}
+
+func (*UpdateMessageSendFailed) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageSendFailed) GetConstructor() string {
+	return ConstructorUpdateMessageSendFailed
+}
+
+func (*UpdateMessageSendFailed) UpdateConstructor() string {
+	return ConstructorUpdateMessageSendFailed
+}
+
+func (entity *UpdateMessageSendFailed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageSendFailed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageSendFailed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageSendFailed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw(`,"old_message_id":`)
+	encoder.writeInt64(entity.OldMessageId)
+	encoder.writeRaw(`,"error":`)
+	entity.Error.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageSendFailed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[Message](decoder)
+
+		case "old_message_id":
+			entity.OldMessageId, err = decoder.readInt64()
+
+		case "error":
+			entity.Error, err = decodeEntity[Error](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageSendFailed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateMessageSendFailed) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The message content has changed
+type UpdateMessageContent struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// New message content
+	NewContent MessageContent `json:"new_content"`
+}
+
+func (*UpdateMessageContent) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageContent) GetConstructor() string {
+	return ConstructorUpdateMessageContent
+}
+
+func (*UpdateMessageContent) UpdateConstructor() string {
+	return ConstructorUpdateMessageContent
+}
+
+func (entity *UpdateMessageContent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageContent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageContent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageContent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"new_content":`)
+	encoder.writeType(entity.NewContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageContent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "new_content":
+			entity.NewContent, err = decodeMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageContent)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateMessageContent) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("new_content", entity.NewContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message was edited. Changes in the message content will come in a separate updateMessageContent
+type UpdateMessageEdited struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Point in time (Unix timestamp) when the message was edited
+	EditDate int32 `json:"edit_date"`
+	// New message reply markup; may be null
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (*UpdateMessageEdited) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageEdited) GetConstructor() string {
+	return ConstructorUpdateMessageEdited
+}
+
+func (*UpdateMessageEdited) UpdateConstructor() string {
+	return ConstructorUpdateMessageEdited
+}
+
+func (entity *UpdateMessageEdited) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageEdited) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageEdited) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageEdited"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"edit_date":`)
+	encoder.writeInt32(entity.EditDate)
+	if entity.ReplyMarkup != nil {
+		encoder.writeRaw(`,"reply_markup":`)
+		encoder.writeType(entity.ReplyMarkup)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageEdited) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "edit_date":
+			entity.EditDate, err = decoder.readInt32()
+
+		case "reply_markup":
+			entity.ReplyMarkup, err = decodeReplyMarkup(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageEdited)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateMessageEdited) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("reply_markup", entity.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The message pinned state was changed
+type UpdateMessageIsPinned struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The message identifier
+	MessageId int64 `json:"message_id"`
+	// True, if the message is pinned
+	IsPinned bool `json:"is_pinned"`
+}
+
+func (*UpdateMessageIsPinned) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageIsPinned) GetConstructor() string {
+	return ConstructorUpdateMessageIsPinned
+}
+
+func (*UpdateMessageIsPinned) UpdateConstructor() string {
+	return ConstructorUpdateMessageIsPinned
+}
+
+func (entity *UpdateMessageIsPinned) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageIsPinned) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageIsPinned) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageIsPinned"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageIsPinned) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageIsPinned)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The information about interactions with a message has changed
+type UpdateMessageInteractionInfo struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// New information about interactions with the message; may be null
+	InteractionInfo *MessageInteractionInfo `json:"interaction_info,omitempty"`
+}
+
+func (*UpdateMessageInteractionInfo) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageInteractionInfo) GetConstructor() string {
+	return ConstructorUpdateMessageInteractionInfo
+}
+
+func (*UpdateMessageInteractionInfo) UpdateConstructor() string {
+	return ConstructorUpdateMessageInteractionInfo
+}
+
+func (entity *UpdateMessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageInteractionInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageInteractionInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageInteractionInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	if entity.InteractionInfo != nil {
+		encoder.writeRaw(`,"interaction_info":`)
+		entity.InteractionInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageInteractionInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "interaction_info":
+			entity.InteractionInfo, err = decodeEntity[MessageInteractionInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageInteractionInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message content was opened. Updates voice note messages to "listened", video note messages to "viewed" and starts the self-destruct timer
+type UpdateMessageContentOpened struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+}
+
+func (*UpdateMessageContentOpened) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageContentOpened) GetConstructor() string {
+	return ConstructorUpdateMessageContentOpened
+}
+
+func (*UpdateMessageContentOpened) UpdateConstructor() string {
+	return ConstructorUpdateMessageContentOpened
+}
+
+func (entity *UpdateMessageContentOpened) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageContentOpened) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageContentOpened) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageContentOpened"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageContentOpened) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageContentOpened)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with an unread mention was read
+type UpdateMessageMentionRead struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// The new number of unread mention messages left in the chat
+	UnreadMentionCount int32 `json:"unread_mention_count"`
+}
+
+func (*UpdateMessageMentionRead) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageMentionRead) GetConstructor() string {
+	return ConstructorUpdateMessageMentionRead
+}
+
+func (*UpdateMessageMentionRead) UpdateConstructor() string {
+	return ConstructorUpdateMessageMentionRead
+}
+
+func (entity *UpdateMessageMentionRead) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageMentionRead) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageMentionRead) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageMentionRead"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"unread_mention_count":`)
+	encoder.writeInt32(entity.UnreadMentionCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageMentionRead) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "unread_mention_count":
+			entity.UnreadMentionCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageMentionRead)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of unread reactions added to a message was changed
+type UpdateMessageUnreadReactions struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// The new list of unread reactions
+	UnreadReactions []*UnreadReaction `json:"unread_reactions"`
+	// The new number of messages with unread reactions left in the chat
+	UnreadReactionCount int32 `json:"unread_reaction_count"`
+}
+
+func (*UpdateMessageUnreadReactions) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageUnreadReactions) GetConstructor() string {
+	return ConstructorUpdateMessageUnreadReactions
+}
+
+func (*UpdateMessageUnreadReactions) UpdateConstructor() string {
+	return ConstructorUpdateMessageUnreadReactions
+}
+
+func (entity *UpdateMessageUnreadReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageUnreadReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageUnreadReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageUnreadReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"unread_reactions":`)
+	writeList(encoder, entity.UnreadReactions, writeTypeValue[*UnreadReaction])
+	encoder.writeRaw(`,"unread_reaction_count":`)
+	encoder.writeInt32(entity.UnreadReactionCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageUnreadReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "unread_reactions":
+			entity.UnreadReactions, err = readList(decoder, decodeEntity[UnreadReaction])
+
+		case "unread_reaction_count":
+			entity.UnreadReactionCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageUnreadReactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A fact-check added to a message was changed
+type UpdateMessageFactCheck struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// The new fact-check
+	FactCheck *FactCheck `json:"fact_check"`
+}
+
+func (*UpdateMessageFactCheck) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageFactCheck) GetConstructor() string {
+	return ConstructorUpdateMessageFactCheck
+}
+
+func (*UpdateMessageFactCheck) UpdateConstructor() string {
+	return ConstructorUpdateMessageFactCheck
+}
+
+func (entity *UpdateMessageFactCheck) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageFactCheck) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageFactCheck) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageFactCheck"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"fact_check":`)
+	entity.FactCheck.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageFactCheck) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "fact_check":
+			entity.FactCheck, err = decodeEntity[FactCheck](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageFactCheck)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message with a live location was viewed. When the update is received, the application is expected to update the live location
+type UpdateMessageLiveLocationViewed struct {
+	meta
+	// Identifier of the chat with the live location message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message with live location
+	MessageId int64 `json:"message_id"`
+}
+
+func (*UpdateMessageLiveLocationViewed) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageLiveLocationViewed) GetConstructor() string {
+	return ConstructorUpdateMessageLiveLocationViewed
+}
+
+func (*UpdateMessageLiveLocationViewed) UpdateConstructor() string {
+	return ConstructorUpdateMessageLiveLocationViewed
+}
+
+func (entity *UpdateMessageLiveLocationViewed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageLiveLocationViewed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageLiveLocationViewed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageLiveLocationViewed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageLiveLocationViewed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageLiveLocationViewed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An automatically scheduled message with video has been successfully sent after conversion
+type UpdateVideoPublished struct {
+	meta
+	// Identifier of the chat with the message
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the sent message
+	MessageId int64 `json:"message_id"`
+}
+
+func (*UpdateVideoPublished) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateVideoPublished) GetConstructor() string {
+	return ConstructorUpdateVideoPublished
+}
+
+func (*UpdateVideoPublished) UpdateConstructor() string {
+	return ConstructorUpdateVideoPublished
+}
+
+func (entity *UpdateVideoPublished) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateVideoPublished) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateVideoPublished) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateVideoPublished"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateVideoPublished) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateVideoPublished)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new chat has been loaded/created. This update is guaranteed to come before the chat identifier is returned to the application. The chat field changes will be reported through separate updates
+type UpdateNewChat struct {
+	meta
+	// The chat
+	Chat *Chat `json:"chat"`
+}
+
+func (*UpdateNewChat) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewChat) GetConstructor() string {
+	return ConstructorUpdateNewChat
+}
+
+func (*UpdateNewChat) UpdateConstructor() string {
+	return ConstructorUpdateNewChat
+}
+
+func (entity *UpdateNewChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat":`)
+	entity.Chat.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat":
+			entity.Chat, err = decodeEntity[Chat](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateNewChat) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("chat", entity.Chat); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The title of a chat was changed
+type UpdateChatTitle struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new chat title
+	Title string `json:"title"`
+}
+
+func (*UpdateChatTitle) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatTitle) GetConstructor() string {
+	return ConstructorUpdateChatTitle
+}
+
+func (*UpdateChatTitle) UpdateConstructor() string {
+	return ConstructorUpdateChatTitle
+}
+
+func (entity *UpdateChatTitle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatTitle) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatTitle) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatTitle"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"title":`)
+	encoder.writeString(entity.Title)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatTitle) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "title":
+			entity.Title, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatTitle)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat photo was changed
+type UpdateChatPhoto struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new chat photo; may be null
+	Photo *ChatPhotoInfo `json:"photo,omitempty"`
+}
+
+func (*UpdateChatPhoto) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatPhoto) GetConstructor() string {
+	return ConstructorUpdateChatPhoto
+}
+
+func (*UpdateChatPhoto) UpdateConstructor() string {
+	return ConstructorUpdateChatPhoto
+}
+
+func (entity *UpdateChatPhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatPhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatPhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatPhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatPhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhotoInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatPhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Chat accent colors have changed
+type UpdateChatAccentColors struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new chat accent color identifier
+	AccentColorId int32 `json:"accent_color_id"`
+	// The new identifier of a custom emoji to be shown on the reply header and link preview background; 0 if none
+	BackgroundCustomEmojiId JsonInt64 `json:"background_custom_emoji_id"`
+	// The new chat profile accent color identifier; -1 if none
+	ProfileAccentColorId int32 `json:"profile_accent_color_id"`
+	// The new identifier of a custom emoji to be shown on the profile background; 0 if none
+	ProfileBackgroundCustomEmojiId JsonInt64 `json:"profile_background_custom_emoji_id"`
+}
+
+func (*UpdateChatAccentColors) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatAccentColors) GetConstructor() string {
+	return ConstructorUpdateChatAccentColors
+}
+
+func (*UpdateChatAccentColors) UpdateConstructor() string {
+	return ConstructorUpdateChatAccentColors
+}
+
+func (entity *UpdateChatAccentColors) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatAccentColors) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatAccentColors) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatAccentColors"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(entity.AccentColorId)
+	encoder.writeRaw(`,"background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.BackgroundCustomEmojiId)
+	encoder.writeRaw(`,"profile_accent_color_id":`)
+	encoder.writeInt32(entity.ProfileAccentColorId)
+	encoder.writeRaw(`,"profile_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.ProfileBackgroundCustomEmojiId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatAccentColors) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "accent_color_id":
+			entity.AccentColorId, err = decoder.readInt32()
+
+		case "background_custom_emoji_id":
+			entity.BackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "profile_accent_color_id":
+			entity.ProfileAccentColorId, err = decoder.readInt32()
+
+		case "profile_background_custom_emoji_id":
+			entity.ProfileBackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatAccentColors)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Chat permissions were changed
+type UpdateChatPermissions struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new chat permissions
+	Permissions *ChatPermissions `json:"permissions"`
+}
+
+func (*UpdateChatPermissions) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatPermissions) GetConstructor() string {
+	return ConstructorUpdateChatPermissions
+}
+
+func (*UpdateChatPermissions) UpdateConstructor() string {
+	return ConstructorUpdateChatPermissions
+}
+
+func (entity *UpdateChatPermissions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatPermissions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatPermissions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatPermissions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"permissions":`)
+	entity.Permissions.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatPermissions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "permissions":
+			entity.Permissions, err = decodeEntity[ChatPermissions](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatPermissions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The last message of a chat was changed
+type UpdateChatLastMessage struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new last message in the chat; may be null if the last message became unknown. While the last message is unknown, new messages can be added to the chat without corresponding updateNewMessage update
+	LastMessage *Message `json:"last_message,omitempty"`
+	// The new chat positions in the chat lists
+	Positions []*ChatPosition `json:"positions"`
+}
+
+func (*UpdateChatLastMessage) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatLastMessage) GetConstructor() string {
+	return ConstructorUpdateChatLastMessage
+}
+
+func (*UpdateChatLastMessage) UpdateConstructor() string {
+	return ConstructorUpdateChatLastMessage
+}
+
+func (entity *UpdateChatLastMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatLastMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatLastMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatLastMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.LastMessage != nil {
+		encoder.writeRaw(`,"last_message":`)
+		entity.LastMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"positions":`)
+	writeList(encoder, entity.Positions, writeTypeValue[*ChatPosition])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatLastMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "last_message":
+			entity.LastMessage, err = decodeEntity[Message](decoder)
+
+		case "positions":
+			entity.Positions, err = readList(decoder, decodeEntity[ChatPosition])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatLastMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateChatLastMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("last_message", entity.LastMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The position of a chat in a chat list has changed. An updateChatLastMessage or updateChatDraftMessage update might be sent instead of the update
+type UpdateChatPosition struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New chat position. If new order is 0, then the chat needs to be removed from the list
+	Position *ChatPosition `json:"position"`
+}
+
+func (*UpdateChatPosition) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatPosition) GetConstructor() string {
+	return ConstructorUpdateChatPosition
+}
+
+func (*UpdateChatPosition) UpdateConstructor() string {
+	return ConstructorUpdateChatPosition
+}
+
+func (entity *UpdateChatPosition) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatPosition) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatPosition"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"position":`)
+	entity.Position.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatPosition) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "position":
+			entity.Position, err = decodeEntity[ChatPosition](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatPosition)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat was added to a chat list
+type UpdateChatAddedToList struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The chat list to which the chat was added
+	ChatList ChatList `json:"chat_list"`
+}
+
+func (*UpdateChatAddedToList) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatAddedToList) GetConstructor() string {
+	return ConstructorUpdateChatAddedToList
+}
+
+func (*UpdateChatAddedToList) UpdateConstructor() string {
+	return ConstructorUpdateChatAddedToList
+}
+
+func (entity *UpdateChatAddedToList) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatAddedToList) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatAddedToList) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatAddedToList"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(entity.ChatList)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatAddedToList) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "chat_list":
+			entity.ChatList, err = decodeChatList(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatAddedToList)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat was removed from a chat list
+type UpdateChatRemovedFromList struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The chat list from which the chat was removed
+	ChatList ChatList `json:"chat_list"`
+}
+
+func (*UpdateChatRemovedFromList) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatRemovedFromList) GetConstructor() string {
+	return ConstructorUpdateChatRemovedFromList
+}
+
+func (*UpdateChatRemovedFromList) UpdateConstructor() string {
+	return ConstructorUpdateChatRemovedFromList
+}
+
+func (entity *UpdateChatRemovedFromList) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatRemovedFromList) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatRemovedFromList) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatRemovedFromList"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(entity.ChatList)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatRemovedFromList) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "chat_list":
+			entity.ChatList, err = decodeChatList(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatRemovedFromList)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Incoming messages were read or the number of unread messages has been changed
+type UpdateChatReadInbox struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the last read incoming message
+	LastReadInboxMessageId int64 `json:"last_read_inbox_message_id"`
+	// The number of unread messages left in the chat
+	UnreadCount int32 `json:"unread_count"`
+}
+
+func (*UpdateChatReadInbox) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatReadInbox) GetConstructor() string {
+	return ConstructorUpdateChatReadInbox
+}
+
+func (*UpdateChatReadInbox) UpdateConstructor() string {
+	return ConstructorUpdateChatReadInbox
+}
+
+func (entity *UpdateChatReadInbox) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatReadInbox) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatReadInbox) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatReadInbox"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"last_read_inbox_message_id":`)
+	encoder.writeInt64(entity.LastReadInboxMessageId)
+	encoder.writeRaw(`,"unread_count":`)
+	encoder.writeInt32(entity.UnreadCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatReadInbox) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "last_read_inbox_message_id":
+			entity.LastReadInboxMessageId, err = decoder.readInt64()
+
+		case "unread_count":
+			entity.UnreadCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatReadInbox)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Outgoing messages were read
+type UpdateChatReadOutbox struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of last read outgoing message
+	LastReadOutboxMessageId int64 `json:"last_read_outbox_message_id"`
+}
+
+func (*UpdateChatReadOutbox) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatReadOutbox) GetConstructor() string {
+	return ConstructorUpdateChatReadOutbox
+}
+
+func (*UpdateChatReadOutbox) UpdateConstructor() string {
+	return ConstructorUpdateChatReadOutbox
+}
+
+func (entity *UpdateChatReadOutbox) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatReadOutbox) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatReadOutbox) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatReadOutbox"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"last_read_outbox_message_id":`)
+	encoder.writeInt64(entity.LastReadOutboxMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatReadOutbox) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "last_read_outbox_message_id":
+			entity.LastReadOutboxMessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatReadOutbox)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat action bar was changed
+type UpdateChatActionBar struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new value of the action bar; may be null
+	ActionBar ChatActionBar `json:"action_bar,omitempty"`
+}
+
+func (*UpdateChatActionBar) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatActionBar) GetConstructor() string {
+	return ConstructorUpdateChatActionBar
+}
+
+func (*UpdateChatActionBar) UpdateConstructor() string {
+	return ConstructorUpdateChatActionBar
+}
+
+func (entity *UpdateChatActionBar) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatActionBar) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatActionBar) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatActionBar"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.ActionBar != nil {
+		encoder.writeRaw(`,"action_bar":`)
+		encoder.writeType(entity.ActionBar)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatActionBar) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "action_bar":
+			entity.ActionBar, err = decodeChatActionBar(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatActionBar)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The bar for managing business bot was changed in a chat
+type UpdateChatBusinessBotManageBar struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new value of the business bot manage bar; may be null
+	BusinessBotManageBar *BusinessBotManageBar `json:"business_bot_manage_bar,omitempty"`
+}
+
+func (*UpdateChatBusinessBotManageBar) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatBusinessBotManageBar) GetConstructor() string {
+	return ConstructorUpdateChatBusinessBotManageBar
+}
+
+func (*UpdateChatBusinessBotManageBar) UpdateConstructor() string {
+	return ConstructorUpdateChatBusinessBotManageBar
+}
+
+func (entity *UpdateChatBusinessBotManageBar) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatBusinessBotManageBar) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatBusinessBotManageBar) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatBusinessBotManageBar"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.BusinessBotManageBar != nil {
+		encoder.writeRaw(`,"business_bot_manage_bar":`)
+		entity.BusinessBotManageBar.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatBusinessBotManageBar) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "business_bot_manage_bar":
+			entity.BusinessBotManageBar, err = decodeEntity[BusinessBotManageBar](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatBusinessBotManageBar)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat available reactions were changed
+type UpdateChatAvailableReactions struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new reactions, available in the chat
+	AvailableReactions ChatAvailableReactions `json:"available_reactions"`
+}
+
+func (*UpdateChatAvailableReactions) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatAvailableReactions) GetConstructor() string {
+	return ConstructorUpdateChatAvailableReactions
+}
+
+func (*UpdateChatAvailableReactions) UpdateConstructor() string {
+	return ConstructorUpdateChatAvailableReactions
+}
+
+func (entity *UpdateChatAvailableReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatAvailableReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatAvailableReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatAvailableReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"available_reactions":`)
+	encoder.writeType(entity.AvailableReactions)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatAvailableReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "available_reactions":
+			entity.AvailableReactions, err = decodeChatAvailableReactions(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatAvailableReactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat draft has changed. Be aware that the update may come in the currently opened chat but with old content of the draft. If the user has changed the content of the draft, this update mustn't be applied
+type UpdateChatDraftMessage struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new draft message; may be null if none
+	DraftMessage *DraftMessage `json:"draft_message,omitempty"`
+	// The new chat positions in the chat lists
+	Positions []*ChatPosition `json:"positions"`
+}
+
+func (*UpdateChatDraftMessage) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatDraftMessage) GetConstructor() string {
+	return ConstructorUpdateChatDraftMessage
+}
+
+func (*UpdateChatDraftMessage) UpdateConstructor() string {
+	return ConstructorUpdateChatDraftMessage
+}
+
+func (entity *UpdateChatDraftMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatDraftMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatDraftMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatDraftMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.DraftMessage != nil {
+		encoder.writeRaw(`,"draft_message":`)
+		entity.DraftMessage.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"positions":`)
+	writeList(encoder, entity.Positions, writeTypeValue[*ChatPosition])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatDraftMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "draft_message":
+			entity.DraftMessage, err = decodeEntity[DraftMessage](decoder)
+
+		case "positions":
+			entity.Positions, err = readList(decoder, decodeEntity[ChatPosition])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatDraftMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateChatDraftMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("draft_message", entity.DraftMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Chat emoji status has changed
+type UpdateChatEmojiStatus struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new chat emoji status; may be null
+	EmojiStatus *EmojiStatus `json:"emoji_status,omitempty"`
+}
+
+func (*UpdateChatEmojiStatus) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatEmojiStatus) GetConstructor() string {
+	return ConstructorUpdateChatEmojiStatus
+}
+
+func (*UpdateChatEmojiStatus) UpdateConstructor() string {
+	return ConstructorUpdateChatEmojiStatus
+}
+
+func (entity *UpdateChatEmojiStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatEmojiStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatEmojiStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatEmojiStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.EmojiStatus != nil {
+		encoder.writeRaw(`,"emoji_status":`)
+		entity.EmojiStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatEmojiStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "emoji_status":
+			entity.EmojiStatus, err = decodeEntity[EmojiStatus](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatEmojiStatus)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message sender that is selected to send messages in a chat has changed
+type UpdateChatMessageSender struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of message_sender_id; may be null if the user can't change message sender
+	MessageSenderId MessageSender `json:"message_sender_id,omitempty"`
+}
+
+func (*UpdateChatMessageSender) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatMessageSender) GetConstructor() string {
+	return ConstructorUpdateChatMessageSender
+}
+
+func (*UpdateChatMessageSender) UpdateConstructor() string {
+	return ConstructorUpdateChatMessageSender
+}
+
+func (entity *UpdateChatMessageSender) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatMessageSender) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatMessageSender) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatMessageSender"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.MessageSenderId != nil {
+		encoder.writeRaw(`,"message_sender_id":`)
+		encoder.writeType(entity.MessageSenderId)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatMessageSender) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_sender_id":
+			entity.MessageSenderId, err = decodeMessageSender(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatMessageSender)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The message auto-delete or self-destruct timer setting for a chat was changed
+type UpdateChatMessageAutoDeleteTime struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of message_auto_delete_time
+	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
+}
+
+func (*UpdateChatMessageAutoDeleteTime) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatMessageAutoDeleteTime) GetConstructor() string {
+	return ConstructorUpdateChatMessageAutoDeleteTime
+}
+
+func (*UpdateChatMessageAutoDeleteTime) UpdateConstructor() string {
+	return ConstructorUpdateChatMessageAutoDeleteTime
+}
+
+func (entity *UpdateChatMessageAutoDeleteTime) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatMessageAutoDeleteTime) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatMessageAutoDeleteTime) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatMessageAutoDeleteTime"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_auto_delete_time":`)
+	encoder.writeInt32(entity.MessageAutoDeleteTime)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatMessageAutoDeleteTime) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_auto_delete_time":
+			entity.MessageAutoDeleteTime, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatMessageAutoDeleteTime)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Notification settings for a chat were changed
+type UpdateChatNotificationSettings struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new notification settings
+	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
+}
+
+func (*UpdateChatNotificationSettings) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatNotificationSettings) GetConstructor() string {
+	return ConstructorUpdateChatNotificationSettings
+}
+
+func (*UpdateChatNotificationSettings) UpdateConstructor() string {
+	return ConstructorUpdateChatNotificationSettings
+}
+
+func (entity *UpdateChatNotificationSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatNotificationSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatNotificationSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatNotificationSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"notification_settings":`)
+	entity.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatNotificationSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "notification_settings":
+			entity.NotificationSettings, err = decodeEntity[ChatNotificationSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatNotificationSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat pending join requests were changed
+type UpdateChatPendingJoinRequests struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new data about pending join requests; may be null
+	PendingJoinRequests *ChatJoinRequestsInfo `json:"pending_join_requests,omitempty"`
+}
+
+func (*UpdateChatPendingJoinRequests) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatPendingJoinRequests) GetConstructor() string {
+	return ConstructorUpdateChatPendingJoinRequests
+}
+
+func (*UpdateChatPendingJoinRequests) UpdateConstructor() string {
+	return ConstructorUpdateChatPendingJoinRequests
+}
+
+func (entity *UpdateChatPendingJoinRequests) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatPendingJoinRequests) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatPendingJoinRequests) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatPendingJoinRequests"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.PendingJoinRequests != nil {
+		encoder.writeRaw(`,"pending_join_requests":`)
+		entity.PendingJoinRequests.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatPendingJoinRequests) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "pending_join_requests":
+			entity.PendingJoinRequests, err = decodeEntity[ChatJoinRequestsInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatPendingJoinRequests)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The default chat reply markup was changed. Can occur because new messages with reply markup were received or because an old reply markup was hidden by the user
+type UpdateChatReplyMarkup struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
+	ReplyMarkupMessageId int64 `json:"reply_markup_message_id"`
+}
+
+func (*UpdateChatReplyMarkup) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatReplyMarkup) GetConstructor() string {
+	return ConstructorUpdateChatReplyMarkup
+}
+
+func (*UpdateChatReplyMarkup) UpdateConstructor() string {
+	return ConstructorUpdateChatReplyMarkup
+}
+
+func (entity *UpdateChatReplyMarkup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatReplyMarkup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatReplyMarkup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatReplyMarkup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"reply_markup_message_id":`)
+	encoder.writeInt64(entity.ReplyMarkupMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatReplyMarkup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "reply_markup_message_id":
+			entity.ReplyMarkupMessageId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatReplyMarkup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat background was changed
+type UpdateChatBackground struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new chat background; may be null if background was reset to default
+	Background *ChatBackground `json:"background,omitempty"`
+}
+
+func (*UpdateChatBackground) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatBackground) GetConstructor() string {
+	return ConstructorUpdateChatBackground
+}
+
+func (*UpdateChatBackground) UpdateConstructor() string {
+	return ConstructorUpdateChatBackground
+}
+
+func (entity *UpdateChatBackground) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatBackground) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatBackground) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatBackground"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.Background != nil {
+		encoder.writeRaw(`,"background":`)
+		entity.Background.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatBackground) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "background":
+			entity.Background, err = decodeEntity[ChatBackground](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat theme was changed
+type UpdateChatTheme struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new name of the chat theme; may be empty if theme was reset to default
+	ThemeName string `json:"theme_name"`
+}
+
+func (*UpdateChatTheme) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatTheme) GetConstructor() string {
+	return ConstructorUpdateChatTheme
+}
+
+func (*UpdateChatTheme) UpdateConstructor() string {
+	return ConstructorUpdateChatTheme
+}
+
+func (entity *UpdateChatTheme) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatTheme) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatTheme) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatTheme"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"theme_name":`)
+	encoder.writeString(entity.ThemeName)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatTheme) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "theme_name":
+			entity.ThemeName, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatTheme)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat unread_mention_count has changed
+type UpdateChatUnreadMentionCount struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The number of unread mention messages left in the chat
+	UnreadMentionCount int32 `json:"unread_mention_count"`
+}
+
+func (*UpdateChatUnreadMentionCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatUnreadMentionCount) GetConstructor() string {
+	return ConstructorUpdateChatUnreadMentionCount
+}
+
+func (*UpdateChatUnreadMentionCount) UpdateConstructor() string {
+	return ConstructorUpdateChatUnreadMentionCount
+}
+
+func (entity *UpdateChatUnreadMentionCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatUnreadMentionCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatUnreadMentionCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatUnreadMentionCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"unread_mention_count":`)
+	encoder.writeInt32(entity.UnreadMentionCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatUnreadMentionCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "unread_mention_count":
+			entity.UnreadMentionCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatUnreadMentionCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The chat unread_reaction_count has changed
+type UpdateChatUnreadReactionCount struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The number of messages with unread reactions left in the chat
+	UnreadReactionCount int32 `json:"unread_reaction_count"`
+}
+
+func (*UpdateChatUnreadReactionCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatUnreadReactionCount) GetConstructor() string {
+	return ConstructorUpdateChatUnreadReactionCount
+}
+
+func (*UpdateChatUnreadReactionCount) UpdateConstructor() string {
+	return ConstructorUpdateChatUnreadReactionCount
+}
+
+func (entity *UpdateChatUnreadReactionCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatUnreadReactionCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatUnreadReactionCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatUnreadReactionCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"unread_reaction_count":`)
+	encoder.writeInt32(entity.UnreadReactionCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatUnreadReactionCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "unread_reaction_count":
+			entity.UnreadReactionCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatUnreadReactionCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat video chat state has changed
+type UpdateChatVideoChat struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of video_chat
+	VideoChat *VideoChat `json:"video_chat"`
+}
+
+func (*UpdateChatVideoChat) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatVideoChat) GetConstructor() string {
+	return ConstructorUpdateChatVideoChat
+}
+
+func (*UpdateChatVideoChat) UpdateConstructor() string {
+	return ConstructorUpdateChatVideoChat
+}
+
+func (entity *UpdateChatVideoChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatVideoChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatVideoChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatVideoChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"video_chat":`)
+	entity.VideoChat.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatVideoChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "video_chat":
+			entity.VideoChat, err = decodeEntity[VideoChat](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatVideoChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The value of the default disable_notification parameter, used when a message is sent to the chat, was changed
+type UpdateChatDefaultDisableNotification struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// The new default_disable_notification value
+	DefaultDisableNotification bool `json:"default_disable_notification"`
+}
+
+func (*UpdateChatDefaultDisableNotification) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatDefaultDisableNotification) GetConstructor() string {
+	return ConstructorUpdateChatDefaultDisableNotification
+}
+
+func (*UpdateChatDefaultDisableNotification) UpdateConstructor() string {
+	return ConstructorUpdateChatDefaultDisableNotification
+}
+
+func (entity *UpdateChatDefaultDisableNotification) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatDefaultDisableNotification) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatDefaultDisableNotification) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatDefaultDisableNotification"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"default_disable_notification":`)
+	encoder.writeBool(entity.DefaultDisableNotification)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatDefaultDisableNotification) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "default_disable_notification":
+			entity.DefaultDisableNotification, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatDefaultDisableNotification)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat content was allowed or restricted for saving
+type UpdateChatHasProtectedContent struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of has_protected_content
+	HasProtectedContent bool `json:"has_protected_content"`
+}
+
+func (*UpdateChatHasProtectedContent) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatHasProtectedContent) GetConstructor() string {
+	return ConstructorUpdateChatHasProtectedContent
+}
+
+func (*UpdateChatHasProtectedContent) UpdateConstructor() string {
+	return ConstructorUpdateChatHasProtectedContent
+}
+
+func (entity *UpdateChatHasProtectedContent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatHasProtectedContent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatHasProtectedContent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatHasProtectedContent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"has_protected_content":`)
+	encoder.writeBool(entity.HasProtectedContent)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatHasProtectedContent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "has_protected_content":
+			entity.HasProtectedContent, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatHasProtectedContent)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Translation of chat messages was enabled or disabled
+type UpdateChatIsTranslatable struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of is_translatable
+	IsTranslatable bool `json:"is_translatable"`
+}
+
+func (*UpdateChatIsTranslatable) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatIsTranslatable) GetConstructor() string {
+	return ConstructorUpdateChatIsTranslatable
+}
+
+func (*UpdateChatIsTranslatable) UpdateConstructor() string {
+	return ConstructorUpdateChatIsTranslatable
+}
+
+func (entity *UpdateChatIsTranslatable) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatIsTranslatable) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatIsTranslatable) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatIsTranslatable"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"is_translatable":`)
+	encoder.writeBool(entity.IsTranslatable)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatIsTranslatable) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "is_translatable":
+			entity.IsTranslatable, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatIsTranslatable)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat was marked as unread or was read
+type UpdateChatIsMarkedAsUnread struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of is_marked_as_unread
+	IsMarkedAsUnread bool `json:"is_marked_as_unread"`
+}
+
+func (*UpdateChatIsMarkedAsUnread) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatIsMarkedAsUnread) GetConstructor() string {
+	return ConstructorUpdateChatIsMarkedAsUnread
+}
+
+func (*UpdateChatIsMarkedAsUnread) UpdateConstructor() string {
+	return ConstructorUpdateChatIsMarkedAsUnread
+}
+
+func (entity *UpdateChatIsMarkedAsUnread) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatIsMarkedAsUnread) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatIsMarkedAsUnread) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatIsMarkedAsUnread"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"is_marked_as_unread":`)
+	encoder.writeBool(entity.IsMarkedAsUnread)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatIsMarkedAsUnread) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "is_marked_as_unread":
+			entity.IsMarkedAsUnread, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatIsMarkedAsUnread)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat default appearance has changed
+type UpdateChatViewAsTopics struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of view_as_topics
+	ViewAsTopics bool `json:"view_as_topics"`
+}
+
+func (*UpdateChatViewAsTopics) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatViewAsTopics) GetConstructor() string {
+	return ConstructorUpdateChatViewAsTopics
+}
+
+func (*UpdateChatViewAsTopics) UpdateConstructor() string {
+	return ConstructorUpdateChatViewAsTopics
+}
+
+func (entity *UpdateChatViewAsTopics) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatViewAsTopics) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatViewAsTopics) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatViewAsTopics"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"view_as_topics":`)
+	encoder.writeBool(entity.ViewAsTopics)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatViewAsTopics) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "view_as_topics":
+			entity.ViewAsTopics, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatViewAsTopics)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat was blocked or unblocked
+type UpdateChatBlockList struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Block list to which the chat is added; may be null if none
+	BlockList BlockList `json:"block_list,omitempty"`
+}
+
+func (*UpdateChatBlockList) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatBlockList) GetConstructor() string {
+	return ConstructorUpdateChatBlockList
+}
+
+func (*UpdateChatBlockList) UpdateConstructor() string {
+	return ConstructorUpdateChatBlockList
+}
+
+func (entity *UpdateChatBlockList) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatBlockList) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatBlockList) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatBlockList"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	if entity.BlockList != nil {
+		encoder.writeRaw(`,"block_list":`)
+		encoder.writeType(entity.BlockList)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatBlockList) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "block_list":
+			entity.BlockList, err = decodeBlockList(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatBlockList)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat's has_scheduled_messages field has changed
+type UpdateChatHasScheduledMessages struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New value of has_scheduled_messages
+	HasScheduledMessages bool `json:"has_scheduled_messages"`
+}
+
+func (*UpdateChatHasScheduledMessages) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatHasScheduledMessages) GetConstructor() string {
+	return ConstructorUpdateChatHasScheduledMessages
+}
+
+func (*UpdateChatHasScheduledMessages) UpdateConstructor() string {
+	return ConstructorUpdateChatHasScheduledMessages
+}
+
+func (entity *UpdateChatHasScheduledMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatHasScheduledMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatHasScheduledMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatHasScheduledMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"has_scheduled_messages":`)
+	encoder.writeBool(entity.HasScheduledMessages)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatHasScheduledMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "has_scheduled_messages":
+			entity.HasScheduledMessages, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatHasScheduledMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of chat folders or a chat folder has changed
+type UpdateChatFolders struct {
+	meta
+	// The new list of chat folders
+	ChatFolders []*ChatFolderInfo `json:"chat_folders"`
+	// Position of the main chat list among chat folders, 0-based
+	MainChatListPosition int32 `json:"main_chat_list_position"`
+	// True, if folder tags are enabled
+	AreTagsEnabled bool `json:"are_tags_enabled"`
+}
+
+func (*UpdateChatFolders) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatFolders) GetConstructor() string {
+	return ConstructorUpdateChatFolders
+}
+
+func (*UpdateChatFolders) UpdateConstructor() string {
+	return ConstructorUpdateChatFolders
+}
+
+func (entity *UpdateChatFolders) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatFolders) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatFolders) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatFolders"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_folders":`)
+	writeList(encoder, entity.ChatFolders, writeTypeValue[*ChatFolderInfo])
+	encoder.writeRaw(`,"main_chat_list_position":`)
+	encoder.writeInt32(entity.MainChatListPosition)
+	encoder.writeRaw(`,"are_tags_enabled":`)
+	encoder.writeBool(entity.AreTagsEnabled)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatFolders) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_folders":
+			entity.ChatFolders, err = readList(decoder, decodeEntity[ChatFolderInfo])
+
+		case "main_chat_list_position":
+			entity.MainChatListPosition, err = decoder.readInt32()
+
+		case "are_tags_enabled":
+			entity.AreTagsEnabled, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatFolders)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateChatFolders) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.ChatFolders {
+		if err := validateNested(indexField("chat_folders", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The number of online group members has changed. This update with non-zero number of online group members is sent only for currently opened chats. There is no guarantee that it is sent just after the number of online users has changed
+type UpdateChatOnlineMemberCount struct {
+	meta
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// New number of online members in the chat, or 0 if unknown
+	OnlineMemberCount int32 `json:"online_member_count"`
+}
+
+func (*UpdateChatOnlineMemberCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatOnlineMemberCount) GetConstructor() string {
+	return ConstructorUpdateChatOnlineMemberCount
+}
+
+func (*UpdateChatOnlineMemberCount) UpdateConstructor() string {
+	return ConstructorUpdateChatOnlineMemberCount
+}
+
+func (entity *UpdateChatOnlineMemberCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatOnlineMemberCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatOnlineMemberCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatOnlineMemberCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"online_member_count":`)
+	encoder.writeInt32(entity.OnlineMemberCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatOnlineMemberCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "online_member_count":
+			entity.OnlineMemberCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatOnlineMemberCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Basic information about a Saved Messages topic has changed. This update is guaranteed to come before the topic identifier is returned to the application
+type UpdateSavedMessagesTopic struct {
+	meta
+	// New data about the topic
+	Topic *SavedMessagesTopic `json:"topic"`
+}
+
+func (*UpdateSavedMessagesTopic) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSavedMessagesTopic) GetConstructor() string {
+	return ConstructorUpdateSavedMessagesTopic
+}
+
+func (*UpdateSavedMessagesTopic) UpdateConstructor() string {
+	return ConstructorUpdateSavedMessagesTopic
+}
+
+func (entity *UpdateSavedMessagesTopic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSavedMessagesTopic) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSavedMessagesTopic) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSavedMessagesTopic"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"topic":`)
+	entity.Topic.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSavedMessagesTopic) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "topic":
+			entity.Topic, err = decodeEntity[SavedMessagesTopic](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSavedMessagesTopic)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateSavedMessagesTopic) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("topic", entity.Topic); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Number of Saved Messages topics has changed
+type UpdateSavedMessagesTopicCount struct {
+	meta
+	// Approximate total number of Saved Messages topics
+	TopicCount int32 `json:"topic_count"`
+}
+
+func (*UpdateSavedMessagesTopicCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSavedMessagesTopicCount) GetConstructor() string {
+	return ConstructorUpdateSavedMessagesTopicCount
+}
+
+func (*UpdateSavedMessagesTopicCount) UpdateConstructor() string {
+	return ConstructorUpdateSavedMessagesTopicCount
+}
+
+func (entity *UpdateSavedMessagesTopicCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSavedMessagesTopicCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSavedMessagesTopicCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSavedMessagesTopicCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"topic_count":`)
+	encoder.writeInt32(entity.TopicCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSavedMessagesTopicCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "topic_count":
+			entity.TopicCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSavedMessagesTopicCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Basic information about a quick reply shortcut has changed. This update is guaranteed to come before the quick shortcut name is returned to the application
+type UpdateQuickReplyShortcut struct {
+	meta
+	// New data about the shortcut
+	Shortcut *QuickReplyShortcut `json:"shortcut"`
+}
+
+func (*UpdateQuickReplyShortcut) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateQuickReplyShortcut) GetConstructor() string {
+	return ConstructorUpdateQuickReplyShortcut
+}
+
+func (*UpdateQuickReplyShortcut) UpdateConstructor() string {
+	return ConstructorUpdateQuickReplyShortcut
+}
+
+func (entity *UpdateQuickReplyShortcut) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateQuickReplyShortcut) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateQuickReplyShortcut) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateQuickReplyShortcut"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"shortcut":`)
+	entity.Shortcut.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateQuickReplyShortcut) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "shortcut":
+			entity.Shortcut, err = decodeEntity[QuickReplyShortcut](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateQuickReplyShortcut)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateQuickReplyShortcut) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("shortcut", entity.Shortcut); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A quick reply shortcut and all its messages were deleted
+type UpdateQuickReplyShortcutDeleted struct {
+	meta
+	// The identifier of the deleted shortcut
+	ShortcutId int32 `json:"shortcut_id"`
+}
+
+func (*UpdateQuickReplyShortcutDeleted) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateQuickReplyShortcutDeleted) GetConstructor() string {
+	return ConstructorUpdateQuickReplyShortcutDeleted
+}
+
+func (*UpdateQuickReplyShortcutDeleted) UpdateConstructor() string {
+	return ConstructorUpdateQuickReplyShortcutDeleted
+}
+
+func (entity *UpdateQuickReplyShortcutDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateQuickReplyShortcutDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateQuickReplyShortcutDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateQuickReplyShortcutDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(entity.ShortcutId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateQuickReplyShortcutDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "shortcut_id":
+			entity.ShortcutId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateQuickReplyShortcutDeleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of quick reply shortcuts has changed
+type UpdateQuickReplyShortcuts struct {
+	meta
+	// The new list of identifiers of quick reply shortcuts
+	ShortcutIds []int32 `json:"shortcut_ids"`
+}
+
+func (*UpdateQuickReplyShortcuts) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateQuickReplyShortcuts) GetConstructor() string {
+	return ConstructorUpdateQuickReplyShortcuts
+}
+
+func (*UpdateQuickReplyShortcuts) UpdateConstructor() string {
+	return ConstructorUpdateQuickReplyShortcuts
+}
+
+func (entity *UpdateQuickReplyShortcuts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateQuickReplyShortcuts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateQuickReplyShortcuts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateQuickReplyShortcuts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"shortcut_ids":`)
+	writeList(encoder, entity.ShortcutIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateQuickReplyShortcuts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "shortcut_ids":
+			entity.ShortcutIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateQuickReplyShortcuts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of quick reply shortcut messages has changed
+type UpdateQuickReplyShortcutMessages struct {
+	meta
+	// The identifier of the shortcut
+	ShortcutId int32 `json:"shortcut_id"`
+	// The new list of quick reply messages for the shortcut in order from the first to the last sent
+	Messages []*QuickReplyMessage `json:"messages"`
+}
+
+func (*UpdateQuickReplyShortcutMessages) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateQuickReplyShortcutMessages) GetConstructor() string {
+	return ConstructorUpdateQuickReplyShortcutMessages
+}
+
+func (*UpdateQuickReplyShortcutMessages) UpdateConstructor() string {
+	return ConstructorUpdateQuickReplyShortcutMessages
+}
+
+func (entity *UpdateQuickReplyShortcutMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateQuickReplyShortcutMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateQuickReplyShortcutMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateQuickReplyShortcutMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"shortcut_id":`)
+	encoder.writeInt32(entity.ShortcutId)
+	encoder.writeRaw(`,"messages":`)
+	writeList(encoder, entity.Messages, writeTypeValue[*QuickReplyMessage])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateQuickReplyShortcutMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "shortcut_id":
+			entity.ShortcutId, err = decoder.readInt32()
+
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[QuickReplyMessage])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateQuickReplyShortcutMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateQuickReplyShortcutMessages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Basic information about a topic in a forum chat was changed
+type UpdateForumTopicInfo struct {
+	meta
+	// New information about the topic
+	Info *ForumTopicInfo `json:"info"`
+}
+
+func (*UpdateForumTopicInfo) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateForumTopicInfo) GetConstructor() string {
+	return ConstructorUpdateForumTopicInfo
+}
+
+func (*UpdateForumTopicInfo) UpdateConstructor() string {
+	return ConstructorUpdateForumTopicInfo
+}
+
+func (entity *UpdateForumTopicInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateForumTopicInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateForumTopicInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateForumTopicInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"info":`)
+	entity.Info.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateForumTopicInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "info":
+			entity.Info, err = decodeEntity[ForumTopicInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateForumTopicInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about a topic in a forum chat was changed
+type UpdateForumTopic struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message thread identifier of the topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// True, if the topic is pinned in the topic list
+	IsPinned bool `json:"is_pinned"`
+	// Identifier of the last read outgoing message
+	LastReadOutboxMessageId int64 `json:"last_read_outbox_message_id"`
+	// Notification settings for the topic
+	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
+}
+
+func (*UpdateForumTopic) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateForumTopic) GetConstructor() string {
+	return ConstructorUpdateForumTopic
+}
+
+func (*UpdateForumTopic) UpdateConstructor() string {
+	return ConstructorUpdateForumTopic
+}
+
+func (entity *UpdateForumTopic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateForumTopic) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateForumTopic) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateForumTopic"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	encoder.writeRaw(`,"is_pinned":`)
+	encoder.writeBool(entity.IsPinned)
+	encoder.writeRaw(`,"last_read_outbox_message_id":`)
+	encoder.writeInt64(entity.LastReadOutboxMessageId)
+	encoder.writeRaw(`,"notification_settings":`)
+	entity.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateForumTopic) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		case "is_pinned":
+			entity.IsPinned, err = decoder.readBool()
+
+		case "last_read_outbox_message_id":
+			entity.LastReadOutboxMessageId, err = decoder.readInt64()
+
+		case "notification_settings":
+			entity.NotificationSettings, err = decodeEntity[ChatNotificationSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateForumTopic)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Notification settings for some type of chats were updated
+type UpdateScopeNotificationSettings struct {
+	meta
+	// Types of chats for which notification settings were updated
+	Scope NotificationSettingsScope `json:"scope"`
+	// The new notification settings
+	NotificationSettings *ScopeNotificationSettings `json:"notification_settings"`
+}
+
+func (*UpdateScopeNotificationSettings) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateScopeNotificationSettings) GetConstructor() string {
+	return ConstructorUpdateScopeNotificationSettings
+}
+
+func (*UpdateScopeNotificationSettings) UpdateConstructor() string {
+	return ConstructorUpdateScopeNotificationSettings
+}
+
+func (entity *UpdateScopeNotificationSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateScopeNotificationSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateScopeNotificationSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateScopeNotificationSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"scope":`)
+	encoder.writeType(entity.Scope)
+	encoder.writeRaw(`,"notification_settings":`)
+	entity.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateScopeNotificationSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "scope":
+			entity.Scope, err = decodeNotificationSettingsScope(decoder)
+
+		case "notification_settings":
+			entity.NotificationSettings, err = decodeEntity[ScopeNotificationSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateScopeNotificationSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Notification settings for reactions were updated
+type UpdateReactionNotificationSettings struct {
+	meta
+	// The new notification settings
+	NotificationSettings *ReactionNotificationSettings `json:"notification_settings"`
+}
+
+func (*UpdateReactionNotificationSettings) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateReactionNotificationSettings) GetConstructor() string {
+	return ConstructorUpdateReactionNotificationSettings
+}
+
+func (*UpdateReactionNotificationSettings) UpdateConstructor() string {
+	return ConstructorUpdateReactionNotificationSettings
+}
+
+func (entity *UpdateReactionNotificationSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateReactionNotificationSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateReactionNotificationSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateReactionNotificationSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"notification_settings":`)
+	entity.NotificationSettings.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateReactionNotificationSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "notification_settings":
+			entity.NotificationSettings, err = decodeEntity[ReactionNotificationSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateReactionNotificationSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A notification was changed
+type UpdateNotification struct {
+	meta
+	// Unique notification group identifier
+	NotificationGroupId int32 `json:"notification_group_id"`
+	// Changed notification
+	Notification *Notification `json:"notification"`
+}
+
+func (*UpdateNotification) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNotification) GetConstructor() string {
+	return ConstructorUpdateNotification
+}
+
+func (*UpdateNotification) UpdateConstructor() string {
+	return ConstructorUpdateNotification
+}
+
+func (entity *UpdateNotification) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNotification) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNotification) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNotification"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"notification_group_id":`)
+	encoder.writeInt32(entity.NotificationGroupId)
+	encoder.writeRaw(`,"notification":`)
+	entity.Notification.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNotification) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "notification_group_id":
+			entity.NotificationGroupId, err = decoder.readInt32()
+
+		case "notification":
+			entity.Notification, err = decodeEntity[Notification](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNotification)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateNotification) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("notification", entity.Notification); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A list of active notifications in a notification group has changed
+type UpdateNotificationGroup struct {
+	meta
+	// Unique notification group identifier
+	NotificationGroupId int32 `json:"notification_group_id"`
+	// New type of the notification group
+	Type NotificationGroupType `json:"type"`
+	// Identifier of a chat to which all notifications in the group belong
+	ChatId int64 `json:"chat_id"`
+	// Chat identifier, which notification settings must be applied to the added notifications
+	NotificationSettingsChatId int64 `json:"notification_settings_chat_id"`
+	// Identifier of the notification sound to be played; 0 if sound is disabled
+	NotificationSoundId JsonInt64 `json:"notification_sound_id"`
+	// Total number of unread notifications in the group, can be bigger than number of active notifications
+	TotalCount int32 `json:"total_count"`
+	// List of added group notifications, sorted by notification identifier
+	AddedNotifications []*Notification `json:"added_notifications"`
+	// Identifiers of removed group notifications, sorted by notification identifier
+	RemovedNotificationIds []int32 `json:"removed_notification_ids"`
+}
+
+func (*UpdateNotificationGroup) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNotificationGroup) GetConstructor() string {
+	return ConstructorUpdateNotificationGroup
+}
+
+func (*UpdateNotificationGroup) UpdateConstructor() string {
+	return ConstructorUpdateNotificationGroup
+}
+
+func (entity *UpdateNotificationGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNotificationGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNotificationGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNotificationGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"notification_group_id":`)
+	encoder.writeInt32(entity.NotificationGroupId)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"notification_settings_chat_id":`)
+	encoder.writeInt64(entity.NotificationSettingsChatId)
+	encoder.writeRaw(`,"notification_sound_id":`)
+	encoder.writeJsonInt64(entity.NotificationSoundId)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"added_notifications":`)
+	writeList(encoder, entity.AddedNotifications, writeTypeValue[*Notification])
+	encoder.writeRaw(`,"removed_notification_ids":`)
+	writeList(encoder, entity.RemovedNotificationIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNotificationGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "notification_group_id":
+			entity.NotificationGroupId, err = decoder.readInt32()
+
+		case "type":
+			entity.Type, err = decodeNotificationGroupType(decoder)
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "notification_settings_chat_id":
+			entity.NotificationSettingsChatId, err = decoder.readInt64()
+
+		case "notification_sound_id":
+			entity.NotificationSoundId, err = decoder.readJsonInt64()
+
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "added_notifications":
+			entity.AddedNotifications, err = readList(decoder, decodeEntity[Notification])
+
+		case "removed_notification_ids":
+			entity.RemovedNotificationIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNotificationGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateNotificationGroup) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.AddedNotifications {
+		if err := validateNested(indexField("added_notifications", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains active notifications that were shown on previous application launches. This update is sent only if the message database is used. In that case it comes once before any updateNotification and updateNotificationGroup update
+type UpdateActiveNotifications struct {
+	meta
+	// Lists of active notification groups
+	Groups []*NotificationGroup `json:"groups"`
+}
+
+func (*UpdateActiveNotifications) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateActiveNotifications) GetConstructor() string {
+	return ConstructorUpdateActiveNotifications
+}
+
+func (*UpdateActiveNotifications) UpdateConstructor() string {
+	return ConstructorUpdateActiveNotifications
+}
+
+func (entity *UpdateActiveNotifications) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateActiveNotifications) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateActiveNotifications) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateActiveNotifications"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"groups":`)
+	writeList(encoder, entity.Groups, writeTypeValue[*NotificationGroup])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateActiveNotifications) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "groups":
+			entity.Groups, err = readList(decoder, decodeEntity[NotificationGroup])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateActiveNotifications)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateActiveNotifications) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Groups {
+		if err := validateNested(indexField("groups", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Describes whether there are some pending notification updates. Can be used to prevent application from killing, while there are some pending notifications
+type UpdateHavePendingNotifications struct {
+	meta
+	// True, if there are some delayed notification updates, which will be sent soon
+	HaveDelayedNotifications bool `json:"have_delayed_notifications"`
+	// True, if there can be some yet unreceived notifications, which are being fetched from the server
+	HaveUnreceivedNotifications bool `json:"have_unreceived_notifications"`
+}
+
+func (*UpdateHavePendingNotifications) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateHavePendingNotifications) GetConstructor() string {
+	return ConstructorUpdateHavePendingNotifications
+}
+
+func (*UpdateHavePendingNotifications) UpdateConstructor() string {
+	return ConstructorUpdateHavePendingNotifications
+}
+
+func (entity *UpdateHavePendingNotifications) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateHavePendingNotifications) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateHavePendingNotifications) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateHavePendingNotifications"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"have_delayed_notifications":`)
+	encoder.writeBool(entity.HaveDelayedNotifications)
+	encoder.writeRaw(`,"have_unreceived_notifications":`)
+	encoder.writeBool(entity.HaveUnreceivedNotifications)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateHavePendingNotifications) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "have_delayed_notifications":
+			entity.HaveDelayedNotifications, err = decoder.readBool()
+
+		case "have_unreceived_notifications":
+			entity.HaveUnreceivedNotifications, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateHavePendingNotifications)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some messages were deleted
+type UpdateDeleteMessages struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifiers of the deleted messages
+	MessageIds []int64 `json:"message_ids"`
+	// True, if the messages are permanently deleted by a user (as opposed to just becoming inaccessible)
+	IsPermanent bool `json:"is_permanent"`
+	// True, if the messages are deleted only from the cache and can possibly be retrieved again in the future
+	FromCache bool `json:"from_cache"`
+}
+
+func (*UpdateDeleteMessages) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateDeleteMessages) GetConstructor() string {
+	return ConstructorUpdateDeleteMessages
+}
+
+func (*UpdateDeleteMessages) UpdateConstructor() string {
+	return ConstructorUpdateDeleteMessages
+}
+
+func (entity *UpdateDeleteMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateDeleteMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateDeleteMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateDeleteMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, entity.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"is_permanent":`)
+	encoder.writeBool(entity.IsPermanent)
+	encoder.writeRaw(`,"from_cache":`)
+	encoder.writeBool(entity.FromCache)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateDeleteMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_ids":
+			entity.MessageIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "is_permanent":
+			entity.IsPermanent, err = decoder.readBool()
+
+		case "from_cache":
+			entity.FromCache, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateDeleteMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message sender activity in the chat has changed
+type UpdateChatAction struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// If not 0, the message thread identifier in which the action was performed
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Identifier of a message sender performing the action
+	SenderId MessageSender `json:"sender_id"`
+	// The action
+	Action ChatAction `json:"action"`
+}
+
+func (*UpdateChatAction) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatAction) GetConstructor() string {
+	return ConstructorUpdateChatAction
+}
+
+func (*UpdateChatAction) UpdateConstructor() string {
+	return ConstructorUpdateChatAction
+}
+
+func (entity *UpdateChatAction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatAction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatAction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatAction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_thread_id":`)
+	encoder.writeInt64(entity.MessageThreadId)
+	encoder.writeRaw(`,"sender_id":`)
+	encoder.writeType(entity.SenderId)
+	encoder.writeRaw(`,"action":`)
+	encoder.writeType(entity.Action)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatAction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_thread_id":
+			entity.MessageThreadId, err = decoder.readInt64()
+
+		case "sender_id":
+			entity.SenderId, err = decodeMessageSender(decoder)
+
+		case "action":
+			entity.Action, err = decodeChatAction(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatAction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user went online or offline
+type UpdateUserStatus struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// New status of the user
+	Status UserStatus `json:"status"`
+}
+
+func (*UpdateUserStatus) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateUserStatus) GetConstructor() string {
+	return ConstructorUpdateUserStatus
+}
+
+func (*UpdateUserStatus) UpdateConstructor() string {
+	return ConstructorUpdateUserStatus
+}
+
+func (entity *UpdateUserStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateUserStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateUserStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateUserStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(entity.Status)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateUserStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "status":
+			entity.Status, err = decodeUserStatus(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateUserStatus)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some data of a user has changed. This update is guaranteed to come before the user identifier is returned to the application
+type UpdateUser struct {
+	meta
+	// New data about the user
+	User *User `json:"user"`
+}
+
+func (*UpdateUser) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateUser) GetConstructor() string {
+	return ConstructorUpdateUser
+}
+
+func (*UpdateUser) UpdateConstructor() string {
+	return ConstructorUpdateUser
+}
+
+func (entity *UpdateUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user":`)
+	entity.User.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user":
+			entity.User, err = decodeEntity[User](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some data of a basic group has changed. This update is guaranteed to come before the basic group identifier is returned to the application
+type UpdateBasicGroup struct {
+	meta
+	// New data about the group
+	BasicGroup *BasicGroup `json:"basic_group"`
+}
+
+func (*UpdateBasicGroup) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateBasicGroup) GetConstructor() string {
+	return ConstructorUpdateBasicGroup
+}
+
+func (*UpdateBasicGroup) UpdateConstructor() string {
+	return ConstructorUpdateBasicGroup
+}
+
+func (entity *UpdateBasicGroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateBasicGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateBasicGroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateBasicGroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"basic_group":`)
+	entity.BasicGroup.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateBasicGroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "basic_group":
+			entity.BasicGroup, err = decodeEntity[BasicGroup](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateBasicGroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateBasicGroup) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("basic_group", entity.BasicGroup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Some data of a supergroup or a channel has changed. This update is guaranteed to come before the supergroup identifier is returned to the application
+type UpdateSupergroup struct {
+	meta
+	// New data about the supergroup
+	Supergroup *Supergroup `json:"supergroup"`
+}
+
+func (*UpdateSupergroup) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSupergroup) GetConstructor() string {
+	return ConstructorUpdateSupergroup
+}
+
+func (*UpdateSupergroup) UpdateConstructor() string {
+	return ConstructorUpdateSupergroup
+}
+
+func (entity *UpdateSupergroup) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSupergroup) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSupergroup) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSupergroup"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"supergroup":`)
+	entity.Supergroup.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSupergroup) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "supergroup":
+			entity.Supergroup, err = decodeEntity[Supergroup](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSupergroup)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateSupergroup) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("supergroup", entity.Supergroup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Some data of a secret chat has changed. This update is guaranteed to come before the secret chat identifier is returned to the application
+type UpdateSecretChat struct {
+	meta
+	// New data about the secret chat
+	SecretChat *SecretChat `json:"secret_chat"`
+}
+
+func (*UpdateSecretChat) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSecretChat) GetConstructor() string {
+	return ConstructorUpdateSecretChat
+}
+
+func (*UpdateSecretChat) UpdateConstructor() string {
+	return ConstructorUpdateSecretChat
+}
+
+func (entity *UpdateSecretChat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSecretChat) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSecretChat) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSecretChat"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"secret_chat":`)
+	entity.SecretChat.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSecretChat) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "secret_chat":
+			entity.SecretChat, err = decodeEntity[SecretChat](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSecretChat)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some data in userFullInfo has been changed
+type UpdateUserFullInfo struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// New full information about the user
+	UserFullInfo *UserFullInfo `json:"user_full_info"`
+}
+
+func (*UpdateUserFullInfo) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateUserFullInfo) GetConstructor() string {
+	return ConstructorUpdateUserFullInfo
+}
+
+func (*UpdateUserFullInfo) UpdateConstructor() string {
+	return ConstructorUpdateUserFullInfo
+}
+
+func (entity *UpdateUserFullInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateUserFullInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateUserFullInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateUserFullInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"user_full_info":`)
+	entity.UserFullInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateUserFullInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "user_full_info":
+			entity.UserFullInfo, err = decodeEntity[UserFullInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateUserFullInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateUserFullInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("user_full_info", entity.UserFullInfo); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Some data in basicGroupFullInfo has been changed
+type UpdateBasicGroupFullInfo struct {
+	meta
+	// Identifier of a basic group
+	BasicGroupId int64 `json:"basic_group_id"`
+	// New full information about the group
+	BasicGroupFullInfo *BasicGroupFullInfo `json:"basic_group_full_info"`
+}
+
+func (*UpdateBasicGroupFullInfo) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateBasicGroupFullInfo) GetConstructor() string {
+	return ConstructorUpdateBasicGroupFullInfo
+}
+
+func (*UpdateBasicGroupFullInfo) UpdateConstructor() string {
+	return ConstructorUpdateBasicGroupFullInfo
+}
+
+func (entity *UpdateBasicGroupFullInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateBasicGroupFullInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateBasicGroupFullInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateBasicGroupFullInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"basic_group_id":`)
+	encoder.writeInt64(entity.BasicGroupId)
+	encoder.writeRaw(`,"basic_group_full_info":`)
+	entity.BasicGroupFullInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateBasicGroupFullInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "basic_group_id":
+			entity.BasicGroupId, err = decoder.readInt64()
+
+		case "basic_group_full_info":
+			entity.BasicGroupFullInfo, err = decodeEntity[BasicGroupFullInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateBasicGroupFullInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateBasicGroupFullInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("basic_group_full_info", entity.BasicGroupFullInfo); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Some data in supergroupFullInfo has been changed
+type UpdateSupergroupFullInfo struct {
+	meta
+	// Identifier of the supergroup or channel
+	SupergroupId int64 `json:"supergroup_id"`
+	// New full information about the supergroup
+	SupergroupFullInfo *SupergroupFullInfo `json:"supergroup_full_info"`
+}
+
+func (*UpdateSupergroupFullInfo) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSupergroupFullInfo) GetConstructor() string {
+	return ConstructorUpdateSupergroupFullInfo
+}
+
+func (*UpdateSupergroupFullInfo) UpdateConstructor() string {
+	return ConstructorUpdateSupergroupFullInfo
+}
+
+func (entity *UpdateSupergroupFullInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSupergroupFullInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSupergroupFullInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSupergroupFullInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"supergroup_id":`)
+	encoder.writeInt64(entity.SupergroupId)
+	encoder.writeRaw(`,"supergroup_full_info":`)
+	entity.SupergroupFullInfo.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSupergroupFullInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "supergroup_id":
+			entity.SupergroupId, err = decoder.readInt64()
+
+		case "supergroup_full_info":
+			entity.SupergroupFullInfo, err = decodeEntity[SupergroupFullInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSupergroupFullInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateSupergroupFullInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("supergroup_full_info", entity.SupergroupFullInfo); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A service notification from the server was received. Upon receiving this the application must show a popup with the content of the notification
+type UpdateServiceNotification struct {
+	meta
+	// Notification type. If type begins with "AUTH_KEY_DROP_", then two buttons "Cancel" and "Log out" must be shown under notification; if user presses the second, all local data must be destroyed using Destroy method
+	Type string `json:"type"`
+	// Notification content
+	Content MessageContent `json:"content"`
+}
+
+func (*UpdateServiceNotification) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateServiceNotification) GetConstructor() string {
+	return ConstructorUpdateServiceNotification
+}
+
+func (*UpdateServiceNotification) UpdateConstructor() string {
+	return ConstructorUpdateServiceNotification
+}
+
+func (entity *UpdateServiceNotification) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateServiceNotification) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateServiceNotification) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateServiceNotification"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeString(entity.Type)
+	encoder.writeRaw(`,"content":`)
+	encoder.writeType(entity.Content)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateServiceNotification) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decoder.readString()
+
+		case "content":
+			entity.Content, err = decodeMessageContent(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateServiceNotification)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateServiceNotification) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("content", entity.Content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Information about a file was updated
+type UpdateFile struct {
+	meta
+	// New data about the file
+	File *File `json:"file"`
+}
+
+func (*UpdateFile) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFile) GetConstructor() string {
+	return ConstructorUpdateFile
+}
+
+func (*UpdateFile) UpdateConstructor() string {
+	return ConstructorUpdateFile
+}
+
+func (entity *UpdateFile) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFile) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFile) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFile"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file":`)
+	entity.File.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFile) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file":
+			entity.File, err = decodeEntity[File](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFile)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The file generation process needs to be started by the application. Use setFileGenerationProgress and finishFileGeneration to generate the file
+type UpdateFileGenerationStart struct {
+	meta
+	// Unique identifier for the generation process
+	GenerationId JsonInt64 `json:"generation_id"`
+	// The original path specified by the application in inputFileGenerated
+	OriginalPath string `json:"original_path"`
+	// The path to a file that must be created and where the new file must be generated by the application. If the application has no access to the path, it can use writeGeneratedFilePart to generate the file
+	DestinationPath string `json:"destination_path"`
+	// If the conversion is "#url#" than original_path contains an HTTP/HTTPS URL of a file that must be downloaded by the application. Otherwise, this is the conversion specified by the application in inputFileGenerated
+	Conversion string `json:"conversion"`
+}
+
+func (*UpdateFileGenerationStart) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFileGenerationStart) GetConstructor() string {
+	return ConstructorUpdateFileGenerationStart
+}
+
+func (*UpdateFileGenerationStart) UpdateConstructor() string {
+	return ConstructorUpdateFileGenerationStart
+}
+
+func (entity *UpdateFileGenerationStart) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFileGenerationStart) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFileGenerationStart) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFileGenerationStart"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"generation_id":`)
+	encoder.writeJsonInt64(entity.GenerationId)
+	encoder.writeRaw(`,"original_path":`)
+	encoder.writeString(entity.OriginalPath)
+	encoder.writeRaw(`,"destination_path":`)
+	encoder.writeString(entity.DestinationPath)
+	encoder.writeRaw(`,"conversion":`)
+	encoder.writeString(entity.Conversion)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFileGenerationStart) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "generation_id":
+			entity.GenerationId, err = decoder.readJsonInt64()
+
+		case "original_path":
+			entity.OriginalPath, err = decoder.readString()
+
+		case "destination_path":
+			entity.DestinationPath, err = decoder.readString()
+
+		case "conversion":
+			entity.Conversion, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFileGenerationStart)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// File generation is no longer needed
+type UpdateFileGenerationStop struct {
+	meta
+	// Unique identifier for the generation process
+	GenerationId JsonInt64 `json:"generation_id"`
+}
+
+func (*UpdateFileGenerationStop) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFileGenerationStop) GetConstructor() string {
+	return ConstructorUpdateFileGenerationStop
+}
+
+func (*UpdateFileGenerationStop) UpdateConstructor() string {
+	return ConstructorUpdateFileGenerationStop
+}
+
+func (entity *UpdateFileGenerationStop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFileGenerationStop) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFileGenerationStop) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFileGenerationStop"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"generation_id":`)
+	encoder.writeJsonInt64(entity.GenerationId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFileGenerationStop) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "generation_id":
+			entity.GenerationId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFileGenerationStop)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The state of the file download list has changed
+type UpdateFileDownloads struct {
+	meta
+	// Total size of files in the file download list, in bytes
+	TotalSize int64 `json:"total_size"`
+	// Total number of files in the file download list
+	TotalCount int32 `json:"total_count"`
+	// Total downloaded size of files in the file download list, in bytes
+	DownloadedSize int64 `json:"downloaded_size"`
+}
+
+func (*UpdateFileDownloads) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFileDownloads) GetConstructor() string {
+	return ConstructorUpdateFileDownloads
+}
+
+func (*UpdateFileDownloads) UpdateConstructor() string {
+	return ConstructorUpdateFileDownloads
+}
+
+func (entity *UpdateFileDownloads) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFileDownloads) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFileDownloads) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFileDownloads"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_size":`)
+	encoder.writeInt64(entity.TotalSize)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"downloaded_size":`)
+	encoder.writeInt64(entity.DownloadedSize)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFileDownloads) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_size":
+			entity.TotalSize, err = decoder.readInt64()
+
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "downloaded_size":
+			entity.DownloadedSize, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFileDownloads)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A file was added to the file download list. This update is sent only after file download list is loaded for the first time
+type UpdateFileAddedToDownloads struct {
+	meta
+	// The added file download
+	FileDownload *FileDownload `json:"file_download"`
+	// New number of being downloaded and recently downloaded files found
+	Counts *DownloadedFileCounts `json:"counts"`
+}
+
+func (*UpdateFileAddedToDownloads) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFileAddedToDownloads) GetConstructor() string {
+	return ConstructorUpdateFileAddedToDownloads
+}
+
+func (*UpdateFileAddedToDownloads) UpdateConstructor() string {
+	return ConstructorUpdateFileAddedToDownloads
+}
+
+func (entity *UpdateFileAddedToDownloads) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFileAddedToDownloads) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFileAddedToDownloads) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFileAddedToDownloads"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_download":`)
+	entity.FileDownload.encodeJSON(encoder)
+	encoder.writeRaw(`,"counts":`)
+	entity.Counts.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFileAddedToDownloads) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_download":
+			entity.FileDownload, err = decodeEntity[FileDownload](decoder)
+
+		case "counts":
+			entity.Counts, err = decodeEntity[DownloadedFileCounts](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFileAddedToDownloads)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateFileAddedToDownloads) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("file_download", entity.FileDownload); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A file download was changed. This update is sent only after file download list is loaded for the first time
+type UpdateFileDownload struct {
+	meta
+	// File identifier
+	FileId int32 `json:"file_id"`
+	// Point in time (Unix timestamp) when the file downloading was completed; 0 if the file downloading isn't completed
+	CompleteDate int32 `json:"complete_date"`
+	// True, if downloading of the file is paused
+	IsPaused bool `json:"is_paused"`
+	// New number of being downloaded and recently downloaded files found
+	Counts *DownloadedFileCounts `json:"counts"`
+}
+
+func (*UpdateFileDownload) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFileDownload) GetConstructor() string {
+	return ConstructorUpdateFileDownload
+}
+
+func (*UpdateFileDownload) UpdateConstructor() string {
+	return ConstructorUpdateFileDownload
+}
+
+func (entity *UpdateFileDownload) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFileDownload) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFileDownload) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFileDownload"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(entity.FileId)
+	encoder.writeRaw(`,"complete_date":`)
+	encoder.writeInt32(entity.CompleteDate)
+	encoder.writeRaw(`,"is_paused":`)
+	encoder.writeBool(entity.IsPaused)
+	encoder.writeRaw(`,"counts":`)
+	entity.Counts.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFileDownload) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_id":
+			entity.FileId, err = decoder.readInt32()
+
+		case "complete_date":
+			entity.CompleteDate, err = decoder.readInt32()
+
+		case "is_paused":
+			entity.IsPaused, err = decoder.readBool()
+
+		case "counts":
+			entity.Counts, err = decodeEntity[DownloadedFileCounts](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFileDownload)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A file was removed from the file download list. This update is sent only after file download list is loaded for the first time
+type UpdateFileRemovedFromDownloads struct {
+	meta
+	// File identifier
+	FileId int32 `json:"file_id"`
+	// New number of being downloaded and recently downloaded files found
+	Counts *DownloadedFileCounts `json:"counts"`
+}
+
+func (*UpdateFileRemovedFromDownloads) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFileRemovedFromDownloads) GetConstructor() string {
+	return ConstructorUpdateFileRemovedFromDownloads
+}
+
+func (*UpdateFileRemovedFromDownloads) UpdateConstructor() string {
+	return ConstructorUpdateFileRemovedFromDownloads
+}
+
+func (entity *UpdateFileRemovedFromDownloads) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFileRemovedFromDownloads) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFileRemovedFromDownloads) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFileRemovedFromDownloads"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"file_id":`)
+	encoder.writeInt32(entity.FileId)
+	encoder.writeRaw(`,"counts":`)
+	entity.Counts.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFileRemovedFromDownloads) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "file_id":
+			entity.FileId, err = decoder.readInt32()
+
+		case "counts":
+			entity.Counts, err = decodeEntity[DownloadedFileCounts](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFileRemovedFromDownloads)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A request can't be completed unless application verification is performed; for official mobile applications only. The method setApplicationVerificationToken must be called once the verification is completed or failed
+type UpdateApplicationVerificationRequired struct {
+	meta
+	// Unique identifier for the verification process
+	VerificationId int64 `json:"verification_id"`
+	// Unique base64url-encoded nonce for the classic Play Integrity verification (https://developer.android.com/google/play/integrity/classic) for Android, or a unique string to compare with verify_nonce field from a push notification for iOS
+	Nonce string `json:"nonce"`
+	// Cloud project number to pass to the Play Integrity API on Android
+	CloudProjectNumber JsonInt64 `json:"cloud_project_number"`
+}
+
+func (*UpdateApplicationVerificationRequired) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateApplicationVerificationRequired) GetConstructor() string {
+	return ConstructorUpdateApplicationVerificationRequired
+}
+
+func (*UpdateApplicationVerificationRequired) UpdateConstructor() string {
+	return ConstructorUpdateApplicationVerificationRequired
+}
+
+func (entity *UpdateApplicationVerificationRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateApplicationVerificationRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateApplicationVerificationRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateApplicationVerificationRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"verification_id":`)
+	encoder.writeInt64(entity.VerificationId)
+	encoder.writeRaw(`,"nonce":`)
+	encoder.writeString(entity.Nonce)
+	encoder.writeRaw(`,"cloud_project_number":`)
+	encoder.writeJsonInt64(entity.CloudProjectNumber)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateApplicationVerificationRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "verification_id":
+			entity.VerificationId, err = decoder.readInt64()
+
+		case "nonce":
+			entity.Nonce, err = decoder.readString()
+
+		case "cloud_project_number":
+			entity.CloudProjectNumber, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateApplicationVerificationRequired)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A request can't be completed unless reCAPTCHA verification is performed; for official mobile applications only. The method setApplicationVerificationToken must be called once the verification is completed or failed
+type UpdateApplicationRecaptchaVerificationRequired struct {
+	meta
+	// Unique identifier for the verification process
+	VerificationId int64 `json:"verification_id"`
+	// The action for the check
+	Action string `json:"action"`
+	// Identifier of the reCAPTCHA key
+	RecaptchaKeyId string `json:"recaptcha_key_id"`
+}
+
+func (*UpdateApplicationRecaptchaVerificationRequired) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateApplicationRecaptchaVerificationRequired) GetConstructor() string {
+	return ConstructorUpdateApplicationRecaptchaVerificationRequired
+}
+
+func (*UpdateApplicationRecaptchaVerificationRequired) UpdateConstructor() string {
+	return ConstructorUpdateApplicationRecaptchaVerificationRequired
+}
+
+func (entity *UpdateApplicationRecaptchaVerificationRequired) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateApplicationRecaptchaVerificationRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateApplicationRecaptchaVerificationRequired) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateApplicationRecaptchaVerificationRequired"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"verification_id":`)
+	encoder.writeInt64(entity.VerificationId)
+	encoder.writeRaw(`,"action":`)
+	encoder.writeString(entity.Action)
+	encoder.writeRaw(`,"recaptcha_key_id":`)
+	encoder.writeString(entity.RecaptchaKeyId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateApplicationRecaptchaVerificationRequired) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "verification_id":
+			entity.VerificationId, err = decoder.readInt64()
+
+		case "action":
+			entity.Action, err = decoder.readString()
+
+		case "recaptcha_key_id":
+			entity.RecaptchaKeyId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateApplicationRecaptchaVerificationRequired)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// New call was created or information about a call was updated
+type UpdateCall struct {
+	meta
+	// New data about a call
+	Call *Call `json:"call"`
+}
+
+func (*UpdateCall) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateCall) GetConstructor() string {
+	return ConstructorUpdateCall
+}
+
+func (*UpdateCall) UpdateConstructor() string {
+	return ConstructorUpdateCall
+}
+
+func (entity *UpdateCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"call":`)
+	entity.Call.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "call":
+			entity.Call, err = decodeEntity[Call](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about a group call was updated
+type UpdateGroupCall struct {
+	meta
+	// New data about a group call
+	GroupCall *GroupCall `json:"group_call"`
+}
+
+func (*UpdateGroupCall) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateGroupCall) GetConstructor() string {
+	return ConstructorUpdateGroupCall
+}
+
+func (*UpdateGroupCall) UpdateConstructor() string {
+	return ConstructorUpdateGroupCall
+}
+
+func (entity *UpdateGroupCall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateGroupCall) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateGroupCall) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateGroupCall"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call":`)
+	entity.GroupCall.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateGroupCall) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call":
+			entity.GroupCall, err = decodeEntity[GroupCall](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateGroupCall)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Information about a group call participant was changed. The updates are sent only after the group call is received through getGroupCall and only if the call is joined or being joined
+type UpdateGroupCallParticipant struct {
+	meta
+	// Identifier of group call
+	GroupCallId int32 `json:"group_call_id"`
+	// New data about a participant
+	Participant *GroupCallParticipant `json:"participant"`
+}
+
+func (*UpdateGroupCallParticipant) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateGroupCallParticipant) GetConstructor() string {
+	return ConstructorUpdateGroupCallParticipant
+}
+
+func (*UpdateGroupCallParticipant) UpdateConstructor() string {
+	return ConstructorUpdateGroupCallParticipant
+}
+
+func (entity *UpdateGroupCallParticipant) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateGroupCallParticipant) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateGroupCallParticipant) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateGroupCallParticipant"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"group_call_id":`)
+	encoder.writeInt32(entity.GroupCallId)
+	encoder.writeRaw(`,"participant":`)
+	entity.Participant.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateGroupCallParticipant) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "group_call_id":
+			entity.GroupCallId, err = decoder.readInt32()
+
+		case "participant":
+			entity.Participant, err = decodeEntity[GroupCallParticipant](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateGroupCallParticipant)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// New call signaling data arrived
+type UpdateNewCallSignalingData struct {
+	meta
+	// The call identifier
+	CallId int32 `json:"call_id"`
+	// The data
+	Data []byte `json:"data"`
+}
+
+func (*UpdateNewCallSignalingData) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewCallSignalingData) GetConstructor() string {
+	return ConstructorUpdateNewCallSignalingData
+}
+
+func (*UpdateNewCallSignalingData) UpdateConstructor() string {
+	return ConstructorUpdateNewCallSignalingData
+}
+
+func (entity *UpdateNewCallSignalingData) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewCallSignalingData) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewCallSignalingData) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewCallSignalingData"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"call_id":`)
+	encoder.writeInt32(entity.CallId)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeBytes(entity.Data)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewCallSignalingData) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "call_id":
+			entity.CallId, err = decoder.readInt32()
+
+		case "data":
+			entity.Data, err = decoder.readBytes()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewCallSignalingData)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some privacy setting rules have been changed
+type UpdateUserPrivacySettingRules struct {
+	meta
+	// The privacy setting
+	Setting UserPrivacySetting `json:"setting"`
+	// New privacy rules
+	Rules *UserPrivacySettingRules `json:"rules"`
+}
+
+func (*UpdateUserPrivacySettingRules) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateUserPrivacySettingRules) GetConstructor() string {
+	return ConstructorUpdateUserPrivacySettingRules
+}
+
+func (*UpdateUserPrivacySettingRules) UpdateConstructor() string {
+	return ConstructorUpdateUserPrivacySettingRules
+}
+
+func (entity *UpdateUserPrivacySettingRules) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateUserPrivacySettingRules) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateUserPrivacySettingRules) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateUserPrivacySettingRules"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"setting":`)
+	encoder.writeType(entity.Setting)
+	encoder.writeRaw(`,"rules":`)
+	entity.Rules.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateUserPrivacySettingRules) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "setting":
+			entity.Setting, err = decodeUserPrivacySetting(decoder)
+
+		case "rules":
+			entity.Rules, err = decodeEntity[UserPrivacySettingRules](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateUserPrivacySettingRules)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Number of unread messages in a chat list has changed. This update is sent only if the message database is used
+type UpdateUnreadMessageCount struct {
+	meta
+	// The chat list with changed number of unread messages
+	ChatList ChatList `json:"chat_list"`
+	// Total number of unread messages
+	UnreadCount int32 `json:"unread_count"`
+	// Total number of unread messages in unmuted chats
+	UnreadUnmutedCount int32 `json:"unread_unmuted_count"`
+}
+
+func (*UpdateUnreadMessageCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateUnreadMessageCount) GetConstructor() string {
+	return ConstructorUpdateUnreadMessageCount
+}
+
+func (*UpdateUnreadMessageCount) UpdateConstructor() string {
+	return ConstructorUpdateUnreadMessageCount
+}
+
+func (entity *UpdateUnreadMessageCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateUnreadMessageCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateUnreadMessageCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateUnreadMessageCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(entity.ChatList)
+	encoder.writeRaw(`,"unread_count":`)
+	encoder.writeInt32(entity.UnreadCount)
+	encoder.writeRaw(`,"unread_unmuted_count":`)
+	encoder.writeInt32(entity.UnreadUnmutedCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateUnreadMessageCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_list":
+			entity.ChatList, err = decodeChatList(decoder)
+
+		case "unread_count":
+			entity.UnreadCount, err = decoder.readInt32()
+
+		case "unread_unmuted_count":
+			entity.UnreadUnmutedCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateUnreadMessageCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Number of unread chats, i.e. with unread messages or marked as unread, has changed. This update is sent only if the message database is used
+type UpdateUnreadChatCount struct {
+	meta
+	// The chat list with changed number of unread messages
+	ChatList ChatList `json:"chat_list"`
+	// Approximate total number of chats in the chat list
+	TotalCount int32 `json:"total_count"`
+	// Total number of unread chats
+	UnreadCount int32 `json:"unread_count"`
+	// Total number of unread unmuted chats
+	UnreadUnmutedCount int32 `json:"unread_unmuted_count"`
+	// Total number of chats marked as unread
+	MarkedAsUnreadCount int32 `json:"marked_as_unread_count"`
+	// Total number of unmuted chats marked as unread
+	MarkedAsUnreadUnmutedCount int32 `json:"marked_as_unread_unmuted_count"`
+}
+
+func (*UpdateUnreadChatCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateUnreadChatCount) GetConstructor() string {
+	return ConstructorUpdateUnreadChatCount
+}
+
+func (*UpdateUnreadChatCount) UpdateConstructor() string {
+	return ConstructorUpdateUnreadChatCount
+}
+
+func (entity *UpdateUnreadChatCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateUnreadChatCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateUnreadChatCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateUnreadChatCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_list":`)
+	encoder.writeType(entity.ChatList)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"unread_count":`)
+	encoder.writeInt32(entity.UnreadCount)
+	encoder.writeRaw(`,"unread_unmuted_count":`)
+	encoder.writeInt32(entity.UnreadUnmutedCount)
+	encoder.writeRaw(`,"marked_as_unread_count":`)
+	encoder.writeInt32(entity.MarkedAsUnreadCount)
+	encoder.writeRaw(`,"marked_as_unread_unmuted_count":`)
+	encoder.writeInt32(entity.MarkedAsUnreadUnmutedCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateUnreadChatCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_list":
+			entity.ChatList, err = decodeChatList(decoder)
+
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "unread_count":
+			entity.UnreadCount, err = decoder.readInt32()
+
+		case "unread_unmuted_count":
+			entity.UnreadUnmutedCount, err = decoder.readInt32()
+
+		case "marked_as_unread_count":
+			entity.MarkedAsUnreadCount, err = decoder.readInt32()
+
+		case "marked_as_unread_unmuted_count":
+			entity.MarkedAsUnreadUnmutedCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateUnreadChatCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A story was changed
+type UpdateStory struct {
+	meta
+	// The new information about the story
+	Story *Story `json:"story"`
+}
+
+func (*UpdateStory) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStory) GetConstructor() string {
+	return ConstructorUpdateStory
+}
+
+func (*UpdateStory) UpdateConstructor() string {
+	return ConstructorUpdateStory
+}
+
+func (entity *UpdateStory) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStory) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStory) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStory"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story":`)
+	entity.Story.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStory) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story":
+			entity.Story, err = decodeEntity[Story](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStory)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A story became inaccessible
+type UpdateStoryDeleted struct {
+	meta
+	// Identifier of the chat that posted the story
+	StorySenderChatId int64 `json:"story_sender_chat_id"`
+	// Story identifier
+	StoryId int32 `json:"story_id"`
+}
+
+func (*UpdateStoryDeleted) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStoryDeleted) GetConstructor() string {
+	return ConstructorUpdateStoryDeleted
+}
+
+func (*UpdateStoryDeleted) UpdateConstructor() string {
+	return ConstructorUpdateStoryDeleted
+}
+
+func (entity *UpdateStoryDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStoryDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStoryDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStoryDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_sender_chat_id":`)
+	encoder.writeInt64(entity.StorySenderChatId)
+	encoder.writeRaw(`,"story_id":`)
+	encoder.writeInt32(entity.StoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStoryDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_sender_chat_id":
+			entity.StorySenderChatId, err = decoder.readInt64()
+
+		case "story_id":
+			entity.StoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStoryDeleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A story has been successfully sent
+type UpdateStorySendSucceeded struct {
+	meta
+	// The sent story
+	Story *Story `json:"story"`
+	// The previous temporary story identifier
+	OldStoryId int32 `json:"old_story_id"`
+}
+
+func (*UpdateStorySendSucceeded) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStorySendSucceeded) GetConstructor() string {
+	return ConstructorUpdateStorySendSucceeded
+}
+
+func (*UpdateStorySendSucceeded) UpdateConstructor() string {
+	return ConstructorUpdateStorySendSucceeded
+}
+
+func (entity *UpdateStorySendSucceeded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStorySendSucceeded) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStorySendSucceeded) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStorySendSucceeded"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story":`)
+	entity.Story.encodeJSON(encoder)
+	encoder.writeRaw(`,"old_story_id":`)
+	encoder.writeInt32(entity.OldStoryId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStorySendSucceeded) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story":
+			entity.Story, err = decodeEntity[Story](decoder)
+
+		case "old_story_id":
+			entity.OldStoryId, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStorySendSucceeded)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A story failed to send. If the story sending is canceled, then updateStoryDeleted will be received instead of this update
+type UpdateStorySendFailed struct {
+	meta
+	// The failed to send story
+	Story *Story `json:"story"`
+	// The cause of the story sending failure
+	Error *Error `json:"error"`
+	// Type of the error; may be null if unknown
+	ErrorType CanSendStoryResult `json:"error_type,omitempty"`
+}
+
+func (*UpdateStorySendFailed) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStorySendFailed) GetConstructor() string {
+	return ConstructorUpdateStorySendFailed
+}
+
+func (*UpdateStorySendFailed) UpdateConstructor() string {
+	return ConstructorUpdateStorySendFailed
+}
+
+func (entity *UpdateStorySendFailed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStorySendFailed) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStorySendFailed) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStorySendFailed"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story":`)
+	entity.Story.encodeJSON(encoder)
+	encoder.writeRaw(`,"error":`)
+	entity.Error.encodeJSON(encoder)
+	if entity.ErrorType != nil {
+		encoder.writeRaw(`,"error_type":`)
+		encoder.writeType(entity.ErrorType)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStorySendFailed) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story":
+			entity.Story, err = decodeEntity[Story](decoder)
+
+		case "error":
+			entity.Error, err = decodeEntity[Error](decoder)
+
+		case "error_type":
+			entity.ErrorType, err = decodeCanSendStoryResult(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStorySendFailed)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of active stories posted by a specific chat has changed
+type UpdateChatActiveStories struct {
+	meta
+	// The new list of active stories
+	ActiveStories *ChatActiveStories `json:"active_stories"`
+}
+
+func (*UpdateChatActiveStories) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatActiveStories) GetConstructor() string {
+	return ConstructorUpdateChatActiveStories
+}
+
+func (*UpdateChatActiveStories) UpdateConstructor() string {
+	return ConstructorUpdateChatActiveStories
+}
+
+func (entity *UpdateChatActiveStories) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatActiveStories) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatActiveStories) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatActiveStories"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"active_stories":`)
+	entity.ActiveStories.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatActiveStories) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "active_stories":
+			entity.ActiveStories, err = decodeEntity[ChatActiveStories](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatActiveStories)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Number of chats in a story list has changed
+type UpdateStoryListChatCount struct {
+	meta
+	// The story list
+	StoryList StoryList `json:"story_list"`
+	// Approximate total number of chats with active stories in the list
+	ChatCount int32 `json:"chat_count"`
+}
+
+func (*UpdateStoryListChatCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStoryListChatCount) GetConstructor() string {
+	return ConstructorUpdateStoryListChatCount
+}
+
+func (*UpdateStoryListChatCount) UpdateConstructor() string {
+	return ConstructorUpdateStoryListChatCount
+}
+
+func (entity *UpdateStoryListChatCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStoryListChatCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStoryListChatCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStoryListChatCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"story_list":`)
+	encoder.writeType(entity.StoryList)
+	encoder.writeRaw(`,"chat_count":`)
+	encoder.writeInt32(entity.ChatCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStoryListChatCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "story_list":
+			entity.StoryList, err = decodeStoryList(decoder)
+
+		case "chat_count":
+			entity.ChatCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStoryListChatCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Story stealth mode settings have changed
+type UpdateStoryStealthMode struct {
+	meta
+	// Point in time (Unix timestamp) until stealth mode is active; 0 if it is disabled
+	ActiveUntilDate int32 `json:"active_until_date"`
+	// Point in time (Unix timestamp) when stealth mode can be enabled again; 0 if there is no active cooldown
+	CooldownUntilDate int32 `json:"cooldown_until_date"`
+}
+
+func (*UpdateStoryStealthMode) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStoryStealthMode) GetConstructor() string {
+	return ConstructorUpdateStoryStealthMode
+}
+
+func (*UpdateStoryStealthMode) UpdateConstructor() string {
+	return ConstructorUpdateStoryStealthMode
+}
+
+func (entity *UpdateStoryStealthMode) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStoryStealthMode) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStoryStealthMode) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStoryStealthMode"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"active_until_date":`)
+	encoder.writeInt32(entity.ActiveUntilDate)
+	encoder.writeRaw(`,"cooldown_until_date":`)
+	encoder.writeInt32(entity.CooldownUntilDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStoryStealthMode) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "active_until_date":
+			entity.ActiveUntilDate, err = decoder.readInt32()
+
+		case "cooldown_until_date":
+			entity.CooldownUntilDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStoryStealthMode)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// An option changed its value
+type UpdateOption struct {
+	meta
+	// The option name
+	Name string `json:"name"`
+	// The new option value
+	Value OptionValue `json:"value"`
+}
+
+func (*UpdateOption) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateOption) GetConstructor() string {
+	return ConstructorUpdateOption
+}
+
+func (*UpdateOption) UpdateConstructor() string {
+	return ConstructorUpdateOption
+}
+
+func (entity *UpdateOption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateOption) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateOption) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateOption"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"name":`)
+	encoder.writeString(entity.Name)
+	encoder.writeRaw(`,"value":`)
+	encoder.writeType(entity.Value)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateOption) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "name":
+			entity.Name, err = decoder.readString()
+
+		case "value":
+			entity.Value, err = decodeOptionValue(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateOption)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A sticker set has changed
+type UpdateStickerSet struct {
+	meta
+	// The sticker set
+	StickerSet *StickerSet `json:"sticker_set"`
+}
+
+func (*UpdateStickerSet) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStickerSet) GetConstructor() string {
+	return ConstructorUpdateStickerSet
+}
+
+func (*UpdateStickerSet) UpdateConstructor() string {
+	return ConstructorUpdateStickerSet
+}
+
+func (entity *UpdateStickerSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStickerSet) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStickerSet) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStickerSet"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker_set":`)
+	entity.StickerSet.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStickerSet) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker_set":
+			entity.StickerSet, err = decodeEntity[StickerSet](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStickerSet)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of installed sticker sets was updated
+type UpdateInstalledStickerSets struct {
+	meta
+	// Type of the affected stickers
+	StickerType StickerType `json:"sticker_type"`
+	// The new list of installed ordinary sticker sets
+	StickerSetIds []JsonInt64 `json:"sticker_set_ids"`
+}
+
+func (*UpdateInstalledStickerSets) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateInstalledStickerSets) GetConstructor() string {
+	return ConstructorUpdateInstalledStickerSets
+}
+
+func (*UpdateInstalledStickerSets) UpdateConstructor() string {
+	return ConstructorUpdateInstalledStickerSets
+}
+
+func (entity *UpdateInstalledStickerSets) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateInstalledStickerSets) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateInstalledStickerSets) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateInstalledStickerSets"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(entity.StickerType)
+	encoder.writeRaw(`,"sticker_set_ids":`)
+	writeList(encoder, entity.StickerSetIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateInstalledStickerSets) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker_type":
+			entity.StickerType, err = decodeStickerType(decoder)
+
+		case "sticker_set_ids":
+			entity.StickerSetIds, err = readList(decoder, (*jsonDecoder).readJsonInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateInstalledStickerSets)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of trending sticker sets was updated or some of them were viewed
+type UpdateTrendingStickerSets struct {
+	meta
+	// Type of the affected stickers
+	StickerType StickerType `json:"sticker_type"`
+	// The prefix of the list of trending sticker sets with the newest trending sticker sets
+	StickerSets *TrendingStickerSets `json:"sticker_sets"`
+}
+
+func (*UpdateTrendingStickerSets) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateTrendingStickerSets) GetConstructor() string {
+	return ConstructorUpdateTrendingStickerSets
+}
+
+func (*UpdateTrendingStickerSets) UpdateConstructor() string {
+	return ConstructorUpdateTrendingStickerSets
+}
+
+func (entity *UpdateTrendingStickerSets) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateTrendingStickerSets) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateTrendingStickerSets) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateTrendingStickerSets"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker_type":`)
+	encoder.writeType(entity.StickerType)
+	encoder.writeRaw(`,"sticker_sets":`)
+	entity.StickerSets.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateTrendingStickerSets) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker_type":
+			entity.StickerType, err = decodeStickerType(decoder)
+
+		case "sticker_sets":
+			entity.StickerSets, err = decodeEntity[TrendingStickerSets](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateTrendingStickerSets)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of recently used stickers was updated
+type UpdateRecentStickers struct {
+	meta
+	// True, if the list of stickers attached to photo or video files was updated; otherwise, the list of sent stickers is updated
+	IsAttached bool `json:"is_attached"`
+	// The new list of file identifiers of recently used stickers
+	StickerIds []int32 `json:"sticker_ids"`
+}
+
+func (*UpdateRecentStickers) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateRecentStickers) GetConstructor() string {
+	return ConstructorUpdateRecentStickers
+}
+
+func (*UpdateRecentStickers) UpdateConstructor() string {
+	return ConstructorUpdateRecentStickers
+}
+
+func (entity *UpdateRecentStickers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateRecentStickers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateRecentStickers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateRecentStickers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_attached":`)
+	encoder.writeBool(entity.IsAttached)
+	encoder.writeRaw(`,"sticker_ids":`)
+	writeList(encoder, entity.StickerIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateRecentStickers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_attached":
+			entity.IsAttached, err = decoder.readBool()
+
+		case "sticker_ids":
+			entity.StickerIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateRecentStickers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of favorite stickers was updated
+type UpdateFavoriteStickers struct {
+	meta
+	// The new list of file identifiers of favorite stickers
+	StickerIds []int32 `json:"sticker_ids"`
+}
+
+func (*UpdateFavoriteStickers) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFavoriteStickers) GetConstructor() string {
+	return ConstructorUpdateFavoriteStickers
+}
+
+func (*UpdateFavoriteStickers) UpdateConstructor() string {
+	return ConstructorUpdateFavoriteStickers
+}
+
+func (entity *UpdateFavoriteStickers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFavoriteStickers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFavoriteStickers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFavoriteStickers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sticker_ids":`)
+	writeList(encoder, entity.StickerIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFavoriteStickers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sticker_ids":
+			entity.StickerIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFavoriteStickers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of saved animations was updated
+type UpdateSavedAnimations struct {
+	meta
+	// The new list of file identifiers of saved animations
+	AnimationIds []int32 `json:"animation_ids"`
+}
+
+func (*UpdateSavedAnimations) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSavedAnimations) GetConstructor() string {
+	return ConstructorUpdateSavedAnimations
+}
+
+func (*UpdateSavedAnimations) UpdateConstructor() string {
+	return ConstructorUpdateSavedAnimations
+}
+
+func (entity *UpdateSavedAnimations) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSavedAnimations) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSavedAnimations) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSavedAnimations"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"animation_ids":`)
+	writeList(encoder, entity.AnimationIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSavedAnimations) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "animation_ids":
+			entity.AnimationIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSavedAnimations)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of saved notification sounds was updated. This update may not be sent until information about a notification sound was requested for the first time
+type UpdateSavedNotificationSounds struct {
+	meta
+	// The new list of identifiers of saved notification sounds
+	NotificationSoundIds []JsonInt64 `json:"notification_sound_ids"`
+}
+
+func (*UpdateSavedNotificationSounds) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSavedNotificationSounds) GetConstructor() string {
+	return ConstructorUpdateSavedNotificationSounds
+}
+
+func (*UpdateSavedNotificationSounds) UpdateConstructor() string {
+	return ConstructorUpdateSavedNotificationSounds
+}
+
+func (entity *UpdateSavedNotificationSounds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSavedNotificationSounds) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSavedNotificationSounds) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSavedNotificationSounds"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"notification_sound_ids":`)
+	writeList(encoder, entity.NotificationSoundIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSavedNotificationSounds) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "notification_sound_ids":
+			entity.NotificationSoundIds, err = readList(decoder, (*jsonDecoder).readJsonInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSavedNotificationSounds)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The default background has changed
+type UpdateDefaultBackground struct {
+	meta
+	// True, if default background for dark theme has changed
+	ForDarkTheme bool `json:"for_dark_theme"`
+	// The new default background; may be null
+	Background *Background `json:"background,omitempty"`
+}
+
+func (*UpdateDefaultBackground) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateDefaultBackground) GetConstructor() string {
+	return ConstructorUpdateDefaultBackground
+}
+
+func (*UpdateDefaultBackground) UpdateConstructor() string {
+	return ConstructorUpdateDefaultBackground
+}
+
+func (entity *UpdateDefaultBackground) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateDefaultBackground) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateDefaultBackground) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateDefaultBackground"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"for_dark_theme":`)
+	encoder.writeBool(entity.ForDarkTheme)
+	if entity.Background != nil {
+		encoder.writeRaw(`,"background":`)
+		entity.Background.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateDefaultBackground) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "for_dark_theme":
+			entity.ForDarkTheme, err = decoder.readBool()
+
+		case "background":
+			entity.Background, err = decodeEntity[Background](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateDefaultBackground)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of available chat themes has changed
+type UpdateChatThemes struct {
+	meta
+	// The new list of chat themes
+	ChatThemes []*ChatTheme `json:"chat_themes"`
+}
+
+func (*UpdateChatThemes) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatThemes) GetConstructor() string {
+	return ConstructorUpdateChatThemes
+}
+
+func (*UpdateChatThemes) UpdateConstructor() string {
+	return ConstructorUpdateChatThemes
+}
+
+func (entity *UpdateChatThemes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatThemes) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatThemes) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatThemes"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_themes":`)
+	writeList(encoder, entity.ChatThemes, writeTypeValue[*ChatTheme])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatThemes) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_themes":
+			entity.ChatThemes, err = readList(decoder, decodeEntity[ChatTheme])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatThemes)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of supported accent colors has changed
+type UpdateAccentColors struct {
+	meta
+	// Information about supported colors; colors with identifiers 0 (red), 1 (orange), 2 (purple/violet), 3 (green), 4 (cyan), 5 (blue), 6 (pink) must always be supported and aren't included in the list. The exact colors for the accent colors with identifiers 0-6 must be taken from the app theme
+	Colors []*AccentColor `json:"colors"`
+	// The list of accent color identifiers, which can be set through setAccentColor and setChatAccentColor. The colors must be shown in the specified order
+	AvailableAccentColorIds []int32 `json:"available_accent_color_ids"`
+}
+
+func (*UpdateAccentColors) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateAccentColors) GetConstructor() string {
+	return ConstructorUpdateAccentColors
+}
+
+func (*UpdateAccentColors) UpdateConstructor() string {
+	return ConstructorUpdateAccentColors
+}
+
+func (entity *UpdateAccentColors) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateAccentColors) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateAccentColors) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateAccentColors"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"colors":`)
+	writeList(encoder, entity.Colors, writeTypeValue[*AccentColor])
+	encoder.writeRaw(`,"available_accent_color_ids":`)
+	writeList(encoder, entity.AvailableAccentColorIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateAccentColors) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "colors":
+			entity.Colors, err = readList(decoder, decodeEntity[AccentColor])
+
+		case "available_accent_color_ids":
+			entity.AvailableAccentColorIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateAccentColors)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of supported accent colors for user profiles has changed
+type UpdateProfileAccentColors struct {
+	meta
+	// Information about supported colors
+	Colors []*ProfileAccentColor `json:"colors"`
+	// The list of accent color identifiers, which can be set through setProfileAccentColor and setChatProfileAccentColor. The colors must be shown in the specified order
+	AvailableAccentColorIds []int32 `json:"available_accent_color_ids"`
+}
+
+func (*UpdateProfileAccentColors) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateProfileAccentColors) GetConstructor() string {
+	return ConstructorUpdateProfileAccentColors
+}
+
+func (*UpdateProfileAccentColors) UpdateConstructor() string {
+	return ConstructorUpdateProfileAccentColors
+}
+
+func (entity *UpdateProfileAccentColors) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateProfileAccentColors) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateProfileAccentColors) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateProfileAccentColors"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"colors":`)
+	writeList(encoder, entity.Colors, writeTypeValue[*ProfileAccentColor])
+	encoder.writeRaw(`,"available_accent_color_ids":`)
+	writeList(encoder, entity.AvailableAccentColorIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateProfileAccentColors) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "colors":
+			entity.Colors, err = readList(decoder, decodeEntity[ProfileAccentColor])
+
+		case "available_accent_color_ids":
+			entity.AvailableAccentColorIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateProfileAccentColors)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some language pack strings have been updated
+type UpdateLanguagePackStrings struct {
+	meta
+	// Localization target to which the language pack belongs
+	LocalizationTarget string `json:"localization_target"`
+	// Identifier of the updated language pack
+	LanguagePackId string `json:"language_pack_id"`
+	// List of changed language pack strings; empty if all strings have changed
+	Strings []*LanguagePackString `json:"strings"`
+}
+
+func (*UpdateLanguagePackStrings) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateLanguagePackStrings) GetConstructor() string {
+	return ConstructorUpdateLanguagePackStrings
+}
+
+func (*UpdateLanguagePackStrings) UpdateConstructor() string {
+	return ConstructorUpdateLanguagePackStrings
+}
+
+func (entity *UpdateLanguagePackStrings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateLanguagePackStrings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateLanguagePackStrings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateLanguagePackStrings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"localization_target":`)
+	encoder.writeString(entity.LocalizationTarget)
+	encoder.writeRaw(`,"language_pack_id":`)
+	encoder.writeString(entity.LanguagePackId)
+	encoder.writeRaw(`,"strings":`)
+	writeList(encoder, entity.Strings, writeTypeValue[*LanguagePackString])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateLanguagePackStrings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "localization_target":
+			entity.LocalizationTarget, err = decoder.readString()
+
+		case "language_pack_id":
+			entity.LanguagePackId, err = decoder.readString()
+
+		case "strings":
+			entity.Strings, err = readList(decoder, decodeEntity[LanguagePackString])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateLanguagePackStrings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The connection state has changed. This update must be used only to show a human-readable description of the connection state
+type UpdateConnectionState struct {
+	meta
+	// The new connection state
+	State ConnectionState `json:"state"`
+}
+
+func (*UpdateConnectionState) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateConnectionState) GetConstructor() string {
+	return ConstructorUpdateConnectionState
+}
+
+func (*UpdateConnectionState) UpdateConstructor() string {
+	return ConstructorUpdateConnectionState
+}
+
+func (entity *UpdateConnectionState) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateConnectionState) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateConnectionState) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateConnectionState"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"state":`)
+	encoder.writeType(entity.State)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateConnectionState) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "state":
+			entity.State, err = decodeConnectionState(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateConnectionState)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The freeze state of the current user's account has changed
+type UpdateFreezeState struct {
+	meta
+	// True, if the account is frozen
+	IsFrozen bool `json:"is_frozen"`
+	// Point in time (Unix timestamp) when the account was frozen; 0 if the account isn't frozen
+	FreezingDate int32 `json:"freezing_date"`
+	// Point in time (Unix timestamp) when the account will be deleted and can't be unfrozen; 0 if the account isn't frozen
+	DeletionDate int32 `json:"deletion_date"`
+	// The link to open to send an appeal to unfreeze the account
+	AppealLink string `json:"appeal_link"`
+}
+
+func (*UpdateFreezeState) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateFreezeState) GetConstructor() string {
+	return ConstructorUpdateFreezeState
+}
+
+func (*UpdateFreezeState) UpdateConstructor() string {
+	return ConstructorUpdateFreezeState
+}
+
+func (entity *UpdateFreezeState) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateFreezeState) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateFreezeState) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateFreezeState"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_frozen":`)
+	encoder.writeBool(entity.IsFrozen)
+	encoder.writeRaw(`,"freezing_date":`)
+	encoder.writeInt32(entity.FreezingDate)
+	encoder.writeRaw(`,"deletion_date":`)
+	encoder.writeInt32(entity.DeletionDate)
+	encoder.writeRaw(`,"appeal_link":`)
+	encoder.writeString(entity.AppealLink)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateFreezeState) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_frozen":
+			entity.IsFrozen, err = decoder.readBool()
+
+		case "freezing_date":
+			entity.FreezingDate, err = decoder.readInt32()
+
+		case "deletion_date":
+			entity.DeletionDate, err = decoder.readInt32()
+
+		case "appeal_link":
+			entity.AppealLink, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateFreezeState)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// New terms of service must be accepted by the user. If the terms of service are declined, then the deleteAccount method must be called with the reason "Decline ToS update"
+type UpdateTermsOfService struct {
+	meta
+	// Identifier of the terms of service
+	TermsOfServiceId string `json:"terms_of_service_id"`
+	// The new terms of service
+	TermsOfService *TermsOfService `json:"terms_of_service"`
+}
+
+func (*UpdateTermsOfService) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateTermsOfService) GetConstructor() string {
+	return ConstructorUpdateTermsOfService
+}
+
+func (*UpdateTermsOfService) UpdateConstructor() string {
+	return ConstructorUpdateTermsOfService
+}
+
+func (entity *UpdateTermsOfService) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateTermsOfService) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateTermsOfService) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateTermsOfService"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"terms_of_service_id":`)
+	encoder.writeString(entity.TermsOfServiceId)
+	encoder.writeRaw(`,"terms_of_service":`)
+	entity.TermsOfService.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateTermsOfService) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "terms_of_service_id":
+			entity.TermsOfServiceId, err = decoder.readString()
+
+		case "terms_of_service":
+			entity.TermsOfService, err = decodeEntity[TermsOfService](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateTermsOfService)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The first unconfirmed session has changed
+type UpdateUnconfirmedSession struct {
+	meta
+	// The unconfirmed session; may be null if none
+	Session *UnconfirmedSession `json:"session,omitempty"`
+}
+
+func (*UpdateUnconfirmedSession) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateUnconfirmedSession) GetConstructor() string {
+	return ConstructorUpdateUnconfirmedSession
+}
+
+func (*UpdateUnconfirmedSession) UpdateConstructor() string {
+	return ConstructorUpdateUnconfirmedSession
+}
+
+func (entity *UpdateUnconfirmedSession) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateUnconfirmedSession) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateUnconfirmedSession) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateUnconfirmedSession"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.Session != nil {
+		encoder.writeRaw(`,"session":`)
+		entity.Session.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateUnconfirmedSession) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "session":
+			entity.Session, err = decodeEntity[UnconfirmedSession](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateUnconfirmedSession)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of bots added to attachment or side menu has changed
+type UpdateAttachmentMenuBots struct {
+	meta
+	// The new list of bots. The bots must not be shown on scheduled messages screen
+	Bots []*AttachmentMenuBot `json:"bots"`
+}
+
+func (*UpdateAttachmentMenuBots) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateAttachmentMenuBots) GetConstructor() string {
+	return ConstructorUpdateAttachmentMenuBots
+}
+
+func (*UpdateAttachmentMenuBots) UpdateConstructor() string {
+	return ConstructorUpdateAttachmentMenuBots
+}
+
+func (entity *UpdateAttachmentMenuBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateAttachmentMenuBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateAttachmentMenuBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateAttachmentMenuBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"bots":`)
+	writeList(encoder, entity.Bots, writeTypeValue[*AttachmentMenuBot])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateAttachmentMenuBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "bots":
+			entity.Bots, err = readList(decoder, decodeEntity[AttachmentMenuBot])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateAttachmentMenuBots)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A message was sent by an opened Web App, so the Web App needs to be closed
+type UpdateWebAppMessageSent struct {
+	meta
+	// Identifier of Web App launch
+	WebAppLaunchId JsonInt64 `json:"web_app_launch_id"`
+}
+
+func (*UpdateWebAppMessageSent) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateWebAppMessageSent) GetConstructor() string {
+	return ConstructorUpdateWebAppMessageSent
+}
+
+func (*UpdateWebAppMessageSent) UpdateConstructor() string {
+	return ConstructorUpdateWebAppMessageSent
+}
+
+func (entity *UpdateWebAppMessageSent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateWebAppMessageSent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateWebAppMessageSent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateWebAppMessageSent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"web_app_launch_id":`)
+	encoder.writeJsonInt64(entity.WebAppLaunchId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateWebAppMessageSent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "web_app_launch_id":
+			entity.WebAppLaunchId, err = decoder.readJsonInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateWebAppMessageSent)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of active emoji reactions has changed
+type UpdateActiveEmojiReactions struct {
+	meta
+	// The new list of active emoji reactions
+	Emojis []string `json:"emojis"`
+}
+
+func (*UpdateActiveEmojiReactions) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateActiveEmojiReactions) GetConstructor() string {
+	return ConstructorUpdateActiveEmojiReactions
+}
+
+func (*UpdateActiveEmojiReactions) UpdateConstructor() string {
+	return ConstructorUpdateActiveEmojiReactions
+}
+
+func (entity *UpdateActiveEmojiReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateActiveEmojiReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateActiveEmojiReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateActiveEmojiReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emojis":`)
+	writeList(encoder, entity.Emojis, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateActiveEmojiReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emojis":
+			entity.Emojis, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateActiveEmojiReactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of available message effects has changed
+type UpdateAvailableMessageEffects struct {
+	meta
+	// The new list of available message effects from emoji reactions
+	ReactionEffectIds []JsonInt64 `json:"reaction_effect_ids"`
+	// The new list of available message effects from Premium stickers
+	StickerEffectIds []JsonInt64 `json:"sticker_effect_ids"`
+}
+
+func (*UpdateAvailableMessageEffects) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateAvailableMessageEffects) GetConstructor() string {
+	return ConstructorUpdateAvailableMessageEffects
+}
+
+func (*UpdateAvailableMessageEffects) UpdateConstructor() string {
+	return ConstructorUpdateAvailableMessageEffects
+}
+
+func (entity *UpdateAvailableMessageEffects) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateAvailableMessageEffects) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateAvailableMessageEffects) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateAvailableMessageEffects"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reaction_effect_ids":`)
+	writeList(encoder, entity.ReactionEffectIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw(`,"sticker_effect_ids":`)
+	writeList(encoder, entity.StickerEffectIds, (*jsonEncoder).writeJsonInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateAvailableMessageEffects) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reaction_effect_ids":
+			entity.ReactionEffectIds, err = readList(decoder, (*jsonDecoder).readJsonInt64)
+
+		case "sticker_effect_ids":
+			entity.StickerEffectIds, err = readList(decoder, (*jsonDecoder).readJsonInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateAvailableMessageEffects)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The type of default reaction has changed
+type UpdateDefaultReactionType struct {
+	meta
+	// The new type of the default reaction
+	ReactionType ReactionType `json:"reaction_type"`
+}
+
+func (*UpdateDefaultReactionType) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateDefaultReactionType) GetConstructor() string {
+	return ConstructorUpdateDefaultReactionType
+}
+
+func (*UpdateDefaultReactionType) UpdateConstructor() string {
+	return ConstructorUpdateDefaultReactionType
+}
+
+func (entity *UpdateDefaultReactionType) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateDefaultReactionType) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateDefaultReactionType) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateDefaultReactionType"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"reaction_type":`)
+	encoder.writeType(entity.ReactionType)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateDefaultReactionType) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "reaction_type":
+			entity.ReactionType, err = decodeReactionType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateDefaultReactionType)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The type of default paid reaction has changed
+type UpdateDefaultPaidReactionType struct {
+	meta
+	// The new type of the default paid reaction
+	Type PaidReactionType `json:"type"`
+}
+
+func (*UpdateDefaultPaidReactionType) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateDefaultPaidReactionType) GetConstructor() string {
+	return ConstructorUpdateDefaultPaidReactionType
+}
+
+func (*UpdateDefaultPaidReactionType) UpdateConstructor() string {
+	return ConstructorUpdateDefaultPaidReactionType
+}
+
+func (entity *UpdateDefaultPaidReactionType) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateDefaultPaidReactionType) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateDefaultPaidReactionType) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateDefaultPaidReactionType"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateDefaultPaidReactionType) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "type":
+			entity.Type, err = decodePaidReactionType(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateDefaultPaidReactionType)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Tags used in Saved Messages or a Saved Messages topic have changed
+type UpdateSavedMessagesTags struct {
+	meta
+	// Identifier of Saved Messages topic which tags were changed; 0 if tags for the whole chat has changed
+	SavedMessagesTopicId int64 `json:"saved_messages_topic_id"`
+	// The new tags
+	Tags *SavedMessagesTags `json:"tags"`
+}
+
+func (*UpdateSavedMessagesTags) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSavedMessagesTags) GetConstructor() string {
+	return ConstructorUpdateSavedMessagesTags
+}
+
+func (*UpdateSavedMessagesTags) UpdateConstructor() string {
+	return ConstructorUpdateSavedMessagesTags
+}
+
+func (entity *UpdateSavedMessagesTags) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSavedMessagesTags) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSavedMessagesTags) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSavedMessagesTags"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"saved_messages_topic_id":`)
+	encoder.writeInt64(entity.SavedMessagesTopicId)
+	encoder.writeRaw(`,"tags":`)
+	entity.Tags.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSavedMessagesTags) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "saved_messages_topic_id":
+			entity.SavedMessagesTopicId, err = decoder.readInt64()
+
+		case "tags":
+			entity.Tags, err = decodeEntity[SavedMessagesTags](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSavedMessagesTags)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateSavedMessagesTags) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("tags", entity.Tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The list of messages with active live location that need to be updated by the application has changed. The list is persistent across application restarts only if the message database is used
+type UpdateActiveLiveLocationMessages struct {
+	meta
+	// The list of messages with active live locations
+	Messages []*Message `json:"messages"`
+}
+
+func (*UpdateActiveLiveLocationMessages) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateActiveLiveLocationMessages) GetConstructor() string {
+	return ConstructorUpdateActiveLiveLocationMessages
+}
+
+func (*UpdateActiveLiveLocationMessages) UpdateConstructor() string {
+	return ConstructorUpdateActiveLiveLocationMessages
+}
+
+func (entity *UpdateActiveLiveLocationMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateActiveLiveLocationMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateActiveLiveLocationMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateActiveLiveLocationMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"messages":`)
+	writeList(encoder, entity.Messages, writeTypeValue[*Message])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateActiveLiveLocationMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "messages":
+			entity.Messages, err = readList(decoder, decodeEntity[Message])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateActiveLiveLocationMessages)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateActiveLiveLocationMessages) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Messages {
+		if err := validateNested(indexField("messages", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The number of Telegram Stars owned by the current user has changed
+type UpdateOwnedStarCount struct {
+	meta
+	// The new amount of owned Telegram Stars
+	StarAmount *StarAmount `json:"star_amount"`
+}
+
+func (*UpdateOwnedStarCount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateOwnedStarCount) GetConstructor() string {
+	return ConstructorUpdateOwnedStarCount
+}
+
+func (*UpdateOwnedStarCount) UpdateConstructor() string {
+	return ConstructorUpdateOwnedStarCount
+}
+
+func (entity *UpdateOwnedStarCount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateOwnedStarCount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateOwnedStarCount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateOwnedStarCount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"star_amount":`)
+	entity.StarAmount.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateOwnedStarCount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "star_amount":
+			entity.StarAmount, err = decodeEntity[StarAmount](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateOwnedStarCount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The revenue earned from sponsored messages in a chat has changed. If chat revenue screen is opened, then getChatRevenueTransactions may be called to fetch new transactions
+type UpdateChatRevenueAmount struct {
+	meta
+	// Identifier of the chat
+	ChatId int64 `json:"chat_id"`
+	// New amount of earned revenue
+	RevenueAmount *ChatRevenueAmount `json:"revenue_amount"`
+}
+
+func (*UpdateChatRevenueAmount) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatRevenueAmount) GetConstructor() string {
+	return ConstructorUpdateChatRevenueAmount
+}
+
+func (*UpdateChatRevenueAmount) UpdateConstructor() string {
+	return ConstructorUpdateChatRevenueAmount
+}
+
+func (entity *UpdateChatRevenueAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatRevenueAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatRevenueAmount) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatRevenueAmount"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"revenue_amount":`)
+	entity.RevenueAmount.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatRevenueAmount) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "revenue_amount":
+			entity.RevenueAmount, err = decodeEntity[ChatRevenueAmount](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatRevenueAmount)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The Telegram Star revenue earned by a bot or a chat has changed. If Telegram Star transaction screen of the chat is opened, then getStarTransactions may be called to fetch new transactions
+type UpdateStarRevenueStatus struct {
+	meta
+	// Identifier of the owner of the Telegram Stars
+	OwnerId MessageSender `json:"owner_id"`
+	// New Telegram Star revenue status
+	Status *StarRevenueStatus `json:"status"`
+}
+
+func (*UpdateStarRevenueStatus) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateStarRevenueStatus) GetConstructor() string {
+	return ConstructorUpdateStarRevenueStatus
+}
+
+func (*UpdateStarRevenueStatus) UpdateConstructor() string {
+	return ConstructorUpdateStarRevenueStatus
+}
+
+func (entity *UpdateStarRevenueStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateStarRevenueStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateStarRevenueStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateStarRevenueStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"owner_id":`)
+	encoder.writeType(entity.OwnerId)
+	encoder.writeRaw(`,"status":`)
+	entity.Status.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateStarRevenueStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "owner_id":
+			entity.OwnerId, err = decodeMessageSender(decoder)
+
+		case "status":
+			entity.Status, err = decodeEntity[StarRevenueStatus](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateStarRevenueStatus)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The parameters of speech recognition without Telegram Premium subscription has changed
+type UpdateSpeechRecognitionTrial struct {
+	meta
+	// The maximum allowed duration of media for speech recognition without Telegram Premium subscription, in seconds
+	MaxMediaDuration int32 `json:"max_media_duration"`
+	// The total number of allowed speech recognitions per week; 0 if none
+	WeeklyCount int32 `json:"weekly_count"`
+	// Number of left speech recognition attempts this week
+	LeftCount int32 `json:"left_count"`
+	// Point in time (Unix timestamp) when the weekly number of tries will reset; 0 if unknown
+	NextResetDate int32 `json:"next_reset_date"`
+}
+
+func (*UpdateSpeechRecognitionTrial) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSpeechRecognitionTrial) GetConstructor() string {
+	return ConstructorUpdateSpeechRecognitionTrial
+}
+
+func (*UpdateSpeechRecognitionTrial) UpdateConstructor() string {
+	return ConstructorUpdateSpeechRecognitionTrial
+}
+
+func (entity *UpdateSpeechRecognitionTrial) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSpeechRecognitionTrial) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSpeechRecognitionTrial) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSpeechRecognitionTrial"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"max_media_duration":`)
+	encoder.writeInt32(entity.MaxMediaDuration)
+	encoder.writeRaw(`,"weekly_count":`)
+	encoder.writeInt32(entity.WeeklyCount)
+	encoder.writeRaw(`,"left_count":`)
+	encoder.writeInt32(entity.LeftCount)
+	encoder.writeRaw(`,"next_reset_date":`)
+	encoder.writeInt32(entity.NextResetDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSpeechRecognitionTrial) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "max_media_duration":
+			entity.MaxMediaDuration, err = decoder.readInt32()
+
+		case "weekly_count":
+			entity.WeeklyCount, err = decoder.readInt32()
+
+		case "left_count":
+			entity.LeftCount, err = decoder.readInt32()
+
+		case "next_reset_date":
+			entity.NextResetDate, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSpeechRecognitionTrial)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of supported dice emojis has changed
+type UpdateDiceEmojis struct {
+	meta
+	// The new list of supported dice emojis
+	Emojis []string `json:"emojis"`
+}
+
+func (*UpdateDiceEmojis) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateDiceEmojis) GetConstructor() string {
+	return ConstructorUpdateDiceEmojis
+}
+
+func (*UpdateDiceEmojis) UpdateConstructor() string {
+	return ConstructorUpdateDiceEmojis
+}
+
+func (entity *UpdateDiceEmojis) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateDiceEmojis) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateDiceEmojis) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateDiceEmojis"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"emojis":`)
+	writeList(encoder, entity.Emojis, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateDiceEmojis) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "emojis":
+			entity.Emojis, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateDiceEmojis)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Some animated emoji message was clicked and a big animated sticker must be played if the message is visible on the screen. chatActionWatchingAnimations with the text of the message needs to be sent if the sticker is played
+type UpdateAnimatedEmojiMessageClicked struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// The animated sticker to be played
+	Sticker *Sticker `json:"sticker"`
+}
+
+func (*UpdateAnimatedEmojiMessageClicked) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateAnimatedEmojiMessageClicked) GetConstructor() string {
+	return ConstructorUpdateAnimatedEmojiMessageClicked
+}
+
+func (*UpdateAnimatedEmojiMessageClicked) UpdateConstructor() string {
+	return ConstructorUpdateAnimatedEmojiMessageClicked
+}
+
+func (entity *UpdateAnimatedEmojiMessageClicked) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateAnimatedEmojiMessageClicked) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateAnimatedEmojiMessageClicked) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateAnimatedEmojiMessageClicked"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"sticker":`)
+	entity.Sticker.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateAnimatedEmojiMessageClicked) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "sticker":
+			entity.Sticker, err = decodeEntity[Sticker](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateAnimatedEmojiMessageClicked)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The parameters of animation search through getOption("animation_search_bot_username") bot has changed
+type UpdateAnimationSearchParameters struct {
+	meta
+	// Name of the animation search provider
+	Provider string `json:"provider"`
+	// The new list of emojis suggested for searching
+	Emojis []string `json:"emojis"`
+}
+
+func (*UpdateAnimationSearchParameters) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateAnimationSearchParameters) GetConstructor() string {
+	return ConstructorUpdateAnimationSearchParameters
+}
+
+func (*UpdateAnimationSearchParameters) UpdateConstructor() string {
+	return ConstructorUpdateAnimationSearchParameters
+}
+
+func (entity *UpdateAnimationSearchParameters) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateAnimationSearchParameters) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateAnimationSearchParameters) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateAnimationSearchParameters"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"provider":`)
+	encoder.writeString(entity.Provider)
+	encoder.writeRaw(`,"emojis":`)
+	writeList(encoder, entity.Emojis, (*jsonEncoder).writeString)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateAnimationSearchParameters) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "provider":
+			entity.Provider, err = decoder.readString()
+
+		case "emojis":
+			entity.Emojis, err = readList(decoder, (*jsonDecoder).readString)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateAnimationSearchParameters)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of suggested to the user actions has changed
+type UpdateSuggestedActions struct {
+	meta
+	// Added suggested actions
+	AddedActions []SuggestedAction `json:"added_actions"`
+	// Removed suggested actions
+	RemovedActions []SuggestedAction `json:"removed_actions"`
+}
+
+func (*UpdateSuggestedActions) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSuggestedActions) GetConstructor() string {
+	return ConstructorUpdateSuggestedActions
+}
+
+func (*UpdateSuggestedActions) UpdateConstructor() string {
+	return ConstructorUpdateSuggestedActions
+}
+
+func (entity *UpdateSuggestedActions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSuggestedActions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSuggestedActions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSuggestedActions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"added_actions":`)
+	writeList(encoder, entity.AddedActions, writeTypeValue[SuggestedAction])
+	encoder.writeRaw(`,"removed_actions":`)
+	writeList(encoder, entity.RemovedActions, writeTypeValue[SuggestedAction])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSuggestedActions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "added_actions":
+			entity.AddedActions, err = readList(decoder, decodeSuggestedAction)
+
+		case "removed_actions":
+			entity.RemovedActions, err = readList(decoder, decodeSuggestedAction)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSuggestedActions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Download or upload file speed for the user was limited, but it can be restored by subscription to Telegram Premium. The notification can be postponed until a being downloaded or uploaded file is visible to the user. Use getOption("premium_download_speedup") or getOption("premium_upload_speedup") to get expected speedup after subscription to Telegram Premium
+type UpdateSpeedLimitNotification struct {
+	meta
+	// True, if upload speed was limited; false, if download speed was limited
+	IsUpload bool `json:"is_upload"`
+}
+
+func (*UpdateSpeedLimitNotification) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateSpeedLimitNotification) GetConstructor() string {
+	return ConstructorUpdateSpeedLimitNotification
+}
+
+func (*UpdateSpeedLimitNotification) UpdateConstructor() string {
+	return ConstructorUpdateSpeedLimitNotification
+}
+
+func (entity *UpdateSpeedLimitNotification) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateSpeedLimitNotification) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateSpeedLimitNotification) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateSpeedLimitNotification"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"is_upload":`)
+	encoder.writeBool(entity.IsUpload)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateSpeedLimitNotification) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "is_upload":
+			entity.IsUpload, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateSpeedLimitNotification)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The list of contacts that had birthdays recently or will have birthday soon has changed
+type UpdateContactCloseBirthdays struct {
+	meta
+	// List of contact users with close birthday
+	CloseBirthdayUsers []*CloseBirthdayUser `json:"close_birthday_users"`
+}
+
+func (*UpdateContactCloseBirthdays) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateContactCloseBirthdays) GetConstructor() string {
+	return ConstructorUpdateContactCloseBirthdays
+}
+
+func (*UpdateContactCloseBirthdays) UpdateConstructor() string {
+	return ConstructorUpdateContactCloseBirthdays
+}
+
+func (entity *UpdateContactCloseBirthdays) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateContactCloseBirthdays) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateContactCloseBirthdays) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateContactCloseBirthdays"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"close_birthday_users":`)
+	writeList(encoder, entity.CloseBirthdayUsers, writeTypeValue[*CloseBirthdayUser])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateContactCloseBirthdays) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "close_birthday_users":
+			entity.CloseBirthdayUsers, err = readList(decoder, decodeEntity[CloseBirthdayUser])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateContactCloseBirthdays)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Autosave settings for some type of chats were updated
+type UpdateAutosaveSettings struct {
+	meta
+	// Type of chats for which autosave settings were updated
+	Scope AutosaveSettingsScope `json:"scope"`
+	// The new autosave settings; may be null if the settings are reset to default
+	Settings *ScopeAutosaveSettings `json:"settings,omitempty"`
+}
+
+func (*UpdateAutosaveSettings) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateAutosaveSettings) GetConstructor() string {
+	return ConstructorUpdateAutosaveSettings
+}
+
+func (*UpdateAutosaveSettings) UpdateConstructor() string {
+	return ConstructorUpdateAutosaveSettings
+}
+
+func (entity *UpdateAutosaveSettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateAutosaveSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateAutosaveSettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateAutosaveSettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"scope":`)
+	encoder.writeType(entity.Scope)
+	if entity.Settings != nil {
+		encoder.writeRaw(`,"settings":`)
+		entity.Settings.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateAutosaveSettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "scope":
+			entity.Scope, err = decodeAutosaveSettingsScope(decoder)
+
+		case "settings":
+			entity.Settings, err = decodeEntity[ScopeAutosaveSettings](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateAutosaveSettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A business connection has changed; for bots only
+type UpdateBusinessConnection struct {
+	meta
+	// New data about the connection
+	Connection *BusinessConnection `json:"connection"`
+}
+
+func (*UpdateBusinessConnection) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateBusinessConnection) GetConstructor() string {
+	return ConstructorUpdateBusinessConnection
+}
+
+func (*UpdateBusinessConnection) UpdateConstructor() string {
+	return ConstructorUpdateBusinessConnection
+}
+
+func (entity *UpdateBusinessConnection) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateBusinessConnection) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateBusinessConnection) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateBusinessConnection"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"connection":`)
+	entity.Connection.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateBusinessConnection) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "connection":
+			entity.Connection, err = decodeEntity[BusinessConnection](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateBusinessConnection)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new message was added to a business account; for bots only
+type UpdateNewBusinessMessage struct {
+	meta
+	// Unique identifier of the business connection
+	ConnectionId string `json:"connection_id"`
+	// The new message
+	Message *BusinessMessage `json:"message"`
+}
+
+func (*UpdateNewBusinessMessage) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewBusinessMessage) GetConstructor() string {
+	return ConstructorUpdateNewBusinessMessage
+}
+
+func (*UpdateNewBusinessMessage) UpdateConstructor() string {
+	return ConstructorUpdateNewBusinessMessage
+}
+
+func (entity *UpdateNewBusinessMessage) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewBusinessMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewBusinessMessage) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewBusinessMessage"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"connection_id":`)
+	encoder.writeString(entity.ConnectionId)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewBusinessMessage) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "connection_id":
+			entity.ConnectionId, err = decoder.readString()
+
+		case "message":
+			entity.Message, err = decodeEntity[BusinessMessage](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewBusinessMessage)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateNewBusinessMessage) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A message in a business account was edited; for bots only
+type UpdateBusinessMessageEdited struct {
+	meta
+	// Unique identifier of the business connection
+	ConnectionId string `json:"connection_id"`
+	// The edited message
+	Message *BusinessMessage `json:"message"`
+}
+
+func (*UpdateBusinessMessageEdited) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateBusinessMessageEdited) GetConstructor() string {
+	return ConstructorUpdateBusinessMessageEdited
+}
+
+func (*UpdateBusinessMessageEdited) UpdateConstructor() string {
+	return ConstructorUpdateBusinessMessageEdited
+}
+
+func (entity *UpdateBusinessMessageEdited) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateBusinessMessageEdited) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateBusinessMessageEdited) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateBusinessMessageEdited"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"connection_id":`)
+	encoder.writeString(entity.ConnectionId)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateBusinessMessageEdited) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "connection_id":
+			entity.ConnectionId, err = decoder.readString()
+
+		case "message":
+			entity.Message, err = decodeEntity[BusinessMessage](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateBusinessMessageEdited)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateBusinessMessageEdited) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Messages in a business account were deleted; for bots only
+type UpdateBusinessMessagesDeleted struct {
+	meta
+	// Unique identifier of the business connection
+	ConnectionId string `json:"connection_id"`
+	// Identifier of a chat in the business account in which messages were deleted
+	ChatId int64 `json:"chat_id"`
+	// Unique message identifiers of the deleted messages
+	MessageIds []int64 `json:"message_ids"`
+}
+
+func (*UpdateBusinessMessagesDeleted) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateBusinessMessagesDeleted) GetConstructor() string {
+	return ConstructorUpdateBusinessMessagesDeleted
+}
+
+func (*UpdateBusinessMessagesDeleted) UpdateConstructor() string {
+	return ConstructorUpdateBusinessMessagesDeleted
+}
+
+func (entity *UpdateBusinessMessagesDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateBusinessMessagesDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateBusinessMessagesDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateBusinessMessagesDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"connection_id":`)
+	encoder.writeString(entity.ConnectionId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_ids":`)
+	writeList(encoder, entity.MessageIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateBusinessMessagesDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "connection_id":
+			entity.ConnectionId, err = decoder.readString()
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_ids":
+			entity.MessageIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateBusinessMessagesDeleted)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new incoming inline query; for bots only
+type UpdateNewInlineQuery struct {
+	meta
+	// Unique query identifier
+	Id JsonInt64 `json:"id"`
+	// Identifier of the user who sent the query
+	SenderUserId int64 `json:"sender_user_id"`
+	// User location; may be null
+	UserLocation *Location `json:"user_location,omitempty"`
+	// The type of the chat from which the query originated; may be null if unknown
+	ChatType ChatType `json:"chat_type,omitempty"`
+	// Text of the query
+	Query string `json:"query"`
+	// Offset of the first entry to return
+	Offset string `json:"offset"`
+}
+
+func (*UpdateNewInlineQuery) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewInlineQuery) GetConstructor() string {
+	return ConstructorUpdateNewInlineQuery
+}
+
+func (*UpdateNewInlineQuery) UpdateConstructor() string {
+	return ConstructorUpdateNewInlineQuery
+}
+
+func (entity *UpdateNewInlineQuery) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewInlineQuery) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewInlineQuery) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewInlineQuery"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	if entity.UserLocation != nil {
+		encoder.writeRaw(`,"user_location":`)
+		entity.UserLocation.encodeJSON(encoder)
+	}
+	if entity.ChatType != nil {
+		encoder.writeRaw(`,"chat_type":`)
+		encoder.writeType(entity.ChatType)
+	}
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw(`,"offset":`)
+	encoder.writeString(entity.Offset)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewInlineQuery) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		case "user_location":
+			entity.UserLocation, err = decodeEntity[Location](decoder)
+
+		case "chat_type":
+			entity.ChatType, err = decodeChatType(decoder)
+
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		case "offset":
+			entity.Offset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewInlineQuery)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user has chosen a result of an inline query; for bots only
+type UpdateNewChosenInlineResult struct {
+	meta
+	// Identifier of the user who sent the query
+	SenderUserId int64 `json:"sender_user_id"`
+	// User location; may be null
+	UserLocation *Location `json:"user_location,omitempty"`
+	// Text of the query
+	Query string `json:"query"`
+	// Identifier of the chosen result
+	ResultId string `json:"result_id"`
+	// Identifier of the sent inline message, if known
+	InlineMessageId string `json:"inline_message_id"`
+}
+
+func (*UpdateNewChosenInlineResult) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewChosenInlineResult) GetConstructor() string {
+	return ConstructorUpdateNewChosenInlineResult
+}
+
+func (*UpdateNewChosenInlineResult) UpdateConstructor() string {
+	return ConstructorUpdateNewChosenInlineResult
+}
+
+func (entity *UpdateNewChosenInlineResult) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewChosenInlineResult) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewChosenInlineResult) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewChosenInlineResult"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	if entity.UserLocation != nil {
+		encoder.writeRaw(`,"user_location":`)
+		entity.UserLocation.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"query":`)
+	encoder.writeString(entity.Query)
+	encoder.writeRaw(`,"result_id":`)
+	encoder.writeString(entity.ResultId)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(entity.InlineMessageId)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewChosenInlineResult) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		case "user_location":
+			entity.UserLocation, err = decodeEntity[Location](decoder)
+
+		case "query":
+			entity.Query, err = decoder.readString()
+
+		case "result_id":
+			entity.ResultId, err = decoder.readString()
+
+		case "inline_message_id":
+			entity.InlineMessageId, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewChosenInlineResult)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new incoming callback query; for bots only
+type UpdateNewCallbackQuery struct {
+	meta
+	// Unique query identifier
+	Id JsonInt64 `json:"id"`
+	// Identifier of the user who sent the query
+	SenderUserId int64 `json:"sender_user_id"`
+	// Identifier of the chat where the query was sent
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message from which the query originated
+	MessageId int64 `json:"message_id"`
+	// Identifier that uniquely corresponds to the chat to which the message was sent
+	ChatInstance JsonInt64 `json:"chat_instance"`
+	// Query payload
+	Payload CallbackQueryPayload `json:"payload"`
+}
+
+func (*UpdateNewCallbackQuery) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewCallbackQuery) GetConstructor() string {
+	return ConstructorUpdateNewCallbackQuery
+}
+
+func (*UpdateNewCallbackQuery) UpdateConstructor() string {
+	return ConstructorUpdateNewCallbackQuery
+}
+
+func (entity *UpdateNewCallbackQuery) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewCallbackQuery) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewCallbackQuery) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewCallbackQuery"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"chat_instance":`)
+	encoder.writeJsonInt64(entity.ChatInstance)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeType(entity.Payload)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewCallbackQuery) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "chat_instance":
+			entity.ChatInstance, err = decoder.readJsonInt64()
+
+		case "payload":
+			entity.Payload, err = decodeCallbackQueryPayload(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewCallbackQuery)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new incoming callback query from a message sent via a bot; for bots only
+type UpdateNewInlineCallbackQuery struct {
+	meta
+	// Unique query identifier
+	Id JsonInt64 `json:"id"`
+	// Identifier of the user who sent the query
+	SenderUserId int64 `json:"sender_user_id"`
+	// Identifier of the inline message from which the query originated
+	InlineMessageId string `json:"inline_message_id"`
+	// An identifier uniquely corresponding to the chat a message was sent to
+	ChatInstance JsonInt64 `json:"chat_instance"`
+	// Query payload
+	Payload CallbackQueryPayload `json:"payload"`
+}
+
+func (*UpdateNewInlineCallbackQuery) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewInlineCallbackQuery) GetConstructor() string {
+	return ConstructorUpdateNewInlineCallbackQuery
+}
+
+func (*UpdateNewInlineCallbackQuery) UpdateConstructor() string {
+	return ConstructorUpdateNewInlineCallbackQuery
+}
+
+func (entity *UpdateNewInlineCallbackQuery) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewInlineCallbackQuery) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewInlineCallbackQuery) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewInlineCallbackQuery"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	encoder.writeRaw(`,"inline_message_id":`)
+	encoder.writeString(entity.InlineMessageId)
+	encoder.writeRaw(`,"chat_instance":`)
+	encoder.writeJsonInt64(entity.ChatInstance)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeType(entity.Payload)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewInlineCallbackQuery) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		case "inline_message_id":
+			entity.InlineMessageId, err = decoder.readString()
+
+		case "chat_instance":
+			entity.ChatInstance, err = decoder.readJsonInt64()
+
+		case "payload":
+			entity.Payload, err = decodeCallbackQueryPayload(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewInlineCallbackQuery)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new incoming callback query from a business message; for bots only
+type UpdateNewBusinessCallbackQuery struct {
+	meta
+	// Unique query identifier
+	Id JsonInt64 `json:"id"`
+	// Identifier of the user who sent the query
+	SenderUserId int64 `json:"sender_user_id"`
+	// Unique identifier of the business connection
+	ConnectionId string `json:"connection_id"`
+	// The message from the business account from which the query originated
+	Message *BusinessMessage `json:"message"`
+	// An identifier uniquely corresponding to the chat a message was sent to
+	ChatInstance JsonInt64 `json:"chat_instance"`
+	// Query payload
+	Payload CallbackQueryPayload `json:"payload"`
+}
+
+func (*UpdateNewBusinessCallbackQuery) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewBusinessCallbackQuery) GetConstructor() string {
+	return ConstructorUpdateNewBusinessCallbackQuery
+}
+
+func (*UpdateNewBusinessCallbackQuery) UpdateConstructor() string {
+	return ConstructorUpdateNewBusinessCallbackQuery
+}
+
+func (entity *UpdateNewBusinessCallbackQuery) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewBusinessCallbackQuery) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewBusinessCallbackQuery) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewBusinessCallbackQuery"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	encoder.writeRaw(`,"connection_id":`)
+	encoder.writeString(entity.ConnectionId)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw(`,"chat_instance":`)
+	encoder.writeJsonInt64(entity.ChatInstance)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeType(entity.Payload)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewBusinessCallbackQuery) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		case "connection_id":
+			entity.ConnectionId, err = decoder.readString()
+
+		case "message":
+			entity.Message, err = decodeEntity[BusinessMessage](decoder)
+
+		case "chat_instance":
+			entity.ChatInstance, err = decoder.readJsonInt64()
+
+		case "payload":
+			entity.Payload, err = decodeCallbackQueryPayload(decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewBusinessCallbackQuery)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateNewBusinessCallbackQuery) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("message", entity.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A new incoming shipping query; for bots only. Only for invoices with flexible price
+type UpdateNewShippingQuery struct {
+	meta
+	// Unique query identifier
+	Id JsonInt64 `json:"id"`
+	// Identifier of the user who sent the query
+	SenderUserId int64 `json:"sender_user_id"`
+	// Invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+	// User shipping address
+	ShippingAddress *Address `json:"shipping_address"`
+}
+
+func (*UpdateNewShippingQuery) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewShippingQuery) GetConstructor() string {
+	return ConstructorUpdateNewShippingQuery
+}
+
+func (*UpdateNewShippingQuery) UpdateConstructor() string {
+	return ConstructorUpdateNewShippingQuery
+}
+
+func (entity *UpdateNewShippingQuery) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewShippingQuery) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewShippingQuery) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewShippingQuery"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	encoder.writeRaw(`,"invoice_payload":`)
+	encoder.writeString(entity.InvoicePayload)
+	encoder.writeRaw(`,"shipping_address":`)
+	entity.ShippingAddress.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewShippingQuery) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		case "invoice_payload":
+			entity.InvoicePayload, err = decoder.readString()
+
+		case "shipping_address":
+			entity.ShippingAddress, err = decodeEntity[Address](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewShippingQuery)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new incoming pre-checkout query; for bots only. Contains full information about a checkout
+type UpdateNewPreCheckoutQuery struct {
+	meta
+	// Unique query identifier
+	Id JsonInt64 `json:"id"`
+	// Identifier of the user who sent the query
+	SenderUserId int64 `json:"sender_user_id"`
+	// Currency for the product price
+	Currency string `json:"currency"`
+	// Total price for the product, in the smallest units of the currency
+	TotalAmount int64 `json:"total_amount"`
+	// Invoice payload
+	InvoicePayload []byte `json:"invoice_payload"`
+	// Identifier of a shipping option chosen by the user; may be empty if not applicable
+	ShippingOptionId string `json:"shipping_option_id"`
+	// Information about the order; may be null
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+}
+
+func (*UpdateNewPreCheckoutQuery) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewPreCheckoutQuery) GetConstructor() string {
+	return ConstructorUpdateNewPreCheckoutQuery
+}
+
+func (*UpdateNewPreCheckoutQuery) UpdateConstructor() string {
+	return ConstructorUpdateNewPreCheckoutQuery
+}
+
+func (entity *UpdateNewPreCheckoutQuery) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewPreCheckoutQuery) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewPreCheckoutQuery) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewPreCheckoutQuery"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"sender_user_id":`)
+	encoder.writeInt64(entity.SenderUserId)
+	encoder.writeRaw(`,"currency":`)
+	encoder.writeString(entity.Currency)
+	encoder.writeRaw(`,"total_amount":`)
+	encoder.writeInt64(entity.TotalAmount)
+	encoder.writeRaw(`,"invoice_payload":`)
+	encoder.writeBytes(entity.InvoicePayload)
+	encoder.writeRaw(`,"shipping_option_id":`)
+	encoder.writeString(entity.ShippingOptionId)
+	if entity.OrderInfo != nil {
+		encoder.writeRaw(`,"order_info":`)
+		entity.OrderInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewPreCheckoutQuery) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "sender_user_id":
+			entity.SenderUserId, err = decoder.readInt64()
+
+		case "currency":
+			entity.Currency, err = decoder.readString()
+
+		case "total_amount":
+			entity.TotalAmount, err = decoder.readInt64()
+
+		case "invoice_payload":
+			entity.InvoicePayload, err = decoder.readBytes()
+
+		case "shipping_option_id":
+			entity.ShippingOptionId, err = decoder.readString()
+
+		case "order_info":
+			entity.OrderInfo, err = decodeEntity[OrderInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewPreCheckoutQuery)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new incoming event; for bots only
+type UpdateNewCustomEvent struct {
+	meta
+	// A JSON-serialized event
+	Event string `json:"event"`
+}
+
+func (*UpdateNewCustomEvent) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewCustomEvent) GetConstructor() string {
+	return ConstructorUpdateNewCustomEvent
+}
+
+func (*UpdateNewCustomEvent) UpdateConstructor() string {
+	return ConstructorUpdateNewCustomEvent
+}
+
+func (entity *UpdateNewCustomEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewCustomEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewCustomEvent) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewCustomEvent"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"event":`)
+	encoder.writeString(entity.Event)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewCustomEvent) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "event":
+			entity.Event, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewCustomEvent)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A new incoming query; for bots only
+type UpdateNewCustomQuery struct {
+	meta
+	// The query identifier
+	Id JsonInt64 `json:"id"`
+	// JSON-serialized query data
+	Data string `json:"data"`
+	// Query timeout
+	Timeout int32 `json:"timeout"`
+}
+
+func (*UpdateNewCustomQuery) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewCustomQuery) GetConstructor() string {
+	return ConstructorUpdateNewCustomQuery
+}
+
+func (*UpdateNewCustomQuery) UpdateConstructor() string {
+	return ConstructorUpdateNewCustomQuery
+}
+
+func (entity *UpdateNewCustomQuery) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewCustomQuery) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewCustomQuery) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewCustomQuery"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"data":`)
+	encoder.writeString(entity.Data)
+	encoder.writeRaw(`,"timeout":`)
+	encoder.writeInt32(entity.Timeout)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewCustomQuery) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "data":
+			entity.Data, err = decoder.readString()
+
+		case "timeout":
+			entity.Timeout, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewCustomQuery)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A poll was updated; for bots only
+type UpdatePoll struct {
+	meta
+	// New data about the poll
+	Poll *Poll `json:"poll"`
+}
+
+func (*UpdatePoll) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdatePoll) GetConstructor() string {
+	return ConstructorUpdatePoll
+}
+
+func (*UpdatePoll) UpdateConstructor() string {
+	return ConstructorUpdatePoll
+}
+
+func (entity *UpdatePoll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdatePoll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdatePoll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updatePoll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"poll":`)
+	entity.Poll.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdatePoll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "poll":
+			entity.Poll, err = decodeEntity[Poll](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdatePoll)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdatePoll) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("poll", entity.Poll); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A user changed the answer to a poll; for bots only
+type UpdatePollAnswer struct {
+	meta
+	// Unique poll identifier
+	PollId JsonInt64 `json:"poll_id"`
+	// Identifier of the message sender that changed the answer to the poll
+	VoterId MessageSender `json:"voter_id"`
+	// 0-based identifiers of answer options, chosen by the user
+	OptionIds []int32 `json:"option_ids"`
+}
+
+func (*UpdatePollAnswer) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdatePollAnswer) GetConstructor() string {
+	return ConstructorUpdatePollAnswer
+}
+
+func (*UpdatePollAnswer) UpdateConstructor() string {
+	return ConstructorUpdatePollAnswer
+}
+
+func (entity *UpdatePollAnswer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdatePollAnswer) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdatePollAnswer) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updatePollAnswer"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"poll_id":`)
+	encoder.writeJsonInt64(entity.PollId)
+	encoder.writeRaw(`,"voter_id":`)
+	encoder.writeType(entity.VoterId)
+	encoder.writeRaw(`,"option_ids":`)
+	writeList(encoder, entity.OptionIds, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdatePollAnswer) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "poll_id":
+			entity.PollId, err = decoder.readJsonInt64()
+
+		case "voter_id":
+			entity.VoterId, err = decodeMessageSender(decoder)
+
+		case "option_ids":
+			entity.OptionIds, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdatePollAnswer)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// User rights changed in a chat; for bots only
+type UpdateChatMember struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the user, changing the rights
+	ActorUserId int64 `json:"actor_user_id"`
+	// Point in time (Unix timestamp) when the user rights were changed
+	Date int32 `json:"date"`
+	// If user has joined the chat using an invite link, the invite link; may be null
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+	// True, if the user has joined the chat after sending a join request and being approved by an administrator
+	ViaJoinRequest bool `json:"via_join_request"`
+	// True, if the user has joined the chat using an invite link for a chat folder
+	ViaChatFolderInviteLink bool `json:"via_chat_folder_invite_link"`
+	// Previous chat member
+	OldChatMember *ChatMember `json:"old_chat_member"`
+	// New chat member
+	NewChatMember *ChatMember `json:"new_chat_member"`
+}
+
+func (*UpdateChatMember) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatMember) GetConstructor() string {
+	return ConstructorUpdateChatMember
+}
+
+func (*UpdateChatMember) UpdateConstructor() string {
+	return ConstructorUpdateChatMember
+}
+
+func (entity *UpdateChatMember) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatMember) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatMember) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatMember"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"actor_user_id":`)
+	encoder.writeInt64(entity.ActorUserId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	if entity.InviteLink != nil {
+		encoder.writeRaw(`,"invite_link":`)
+		entity.InviteLink.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"via_join_request":`)
+	encoder.writeBool(entity.ViaJoinRequest)
+	encoder.writeRaw(`,"via_chat_folder_invite_link":`)
+	encoder.writeBool(entity.ViaChatFolderInviteLink)
+	encoder.writeRaw(`,"old_chat_member":`)
+	entity.OldChatMember.encodeJSON(encoder)
+	encoder.writeRaw(`,"new_chat_member":`)
+	entity.NewChatMember.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatMember) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "actor_user_id":
+			entity.ActorUserId, err = decoder.readInt64()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		case "via_join_request":
+			entity.ViaJoinRequest, err = decoder.readBool()
+
+		case "via_chat_folder_invite_link":
+			entity.ViaChatFolderInviteLink, err = decoder.readBool()
+
+		case "old_chat_member":
+			entity.OldChatMember, err = decodeEntity[ChatMember](decoder)
+
+		case "new_chat_member":
+			entity.NewChatMember, err = decodeEntity[ChatMember](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatMember)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UpdateChatMember) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("old_chat_member", entity.OldChatMember); err != nil {
+		return err
+	}
+
+	if err := validateNested("new_chat_member", entity.NewChatMember); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A user sent a join request to a chat; for bots only
+type UpdateNewChatJoinRequest struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Join request
+	Request *ChatJoinRequest `json:"request"`
+	// Chat identifier of the private chat with the user
+	UserChatId int64 `json:"user_chat_id"`
+	// The invite link, which was used to send join request; may be null
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+func (*UpdateNewChatJoinRequest) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateNewChatJoinRequest) GetConstructor() string {
+	return ConstructorUpdateNewChatJoinRequest
+}
+
+func (*UpdateNewChatJoinRequest) UpdateConstructor() string {
+	return ConstructorUpdateNewChatJoinRequest
+}
+
+func (entity *UpdateNewChatJoinRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateNewChatJoinRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateNewChatJoinRequest) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateNewChatJoinRequest"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"request":`)
+	entity.Request.encodeJSON(encoder)
+	encoder.writeRaw(`,"user_chat_id":`)
+	encoder.writeInt64(entity.UserChatId)
+	if entity.InviteLink != nil {
+		encoder.writeRaw(`,"invite_link":`)
+		entity.InviteLink.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateNewChatJoinRequest) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "request":
+			entity.Request, err = decodeEntity[ChatJoinRequest](decoder)
+
+		case "user_chat_id":
+			entity.UserChatId, err = decoder.readInt64()
+
+		case "invite_link":
+			entity.InviteLink, err = decodeEntity[ChatInviteLink](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateNewChatJoinRequest)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A chat boost has changed; for bots only
+type UpdateChatBoost struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// New information about the boost
+	Boost *ChatBoost `json:"boost"`
+}
+
+func (*UpdateChatBoost) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateChatBoost) GetConstructor() string {
+	return ConstructorUpdateChatBoost
+}
+
+func (*UpdateChatBoost) UpdateConstructor() string {
+	return ConstructorUpdateChatBoost
+}
+
+func (entity *UpdateChatBoost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateChatBoost) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateChatBoost) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateChatBoost"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"boost":`)
+	entity.Boost.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateChatBoost) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "boost":
+			entity.Boost, err = decodeEntity[ChatBoost](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateChatBoost)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// User changed its reactions on a message with public reactions; for bots only
+type UpdateMessageReaction struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Identifier of the user or chat that changed reactions
+	ActorId MessageSender `json:"actor_id"`
+	// Point in time (Unix timestamp) when the reactions were changed
+	Date int32 `json:"date"`
+	// Old list of chosen reactions
+	OldReactionTypes []ReactionType `json:"old_reaction_types"`
+	// New list of chosen reactions
+	NewReactionTypes []ReactionType `json:"new_reaction_types"`
+}
+
+func (*UpdateMessageReaction) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageReaction) GetConstructor() string {
+	return ConstructorUpdateMessageReaction
+}
+
+func (*UpdateMessageReaction) UpdateConstructor() string {
+	return ConstructorUpdateMessageReaction
+}
+
+func (entity *UpdateMessageReaction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageReaction) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageReaction) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageReaction"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"actor_id":`)
+	encoder.writeType(entity.ActorId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"old_reaction_types":`)
+	writeList(encoder, entity.OldReactionTypes, writeTypeValue[ReactionType])
+	encoder.writeRaw(`,"new_reaction_types":`)
+	writeList(encoder, entity.NewReactionTypes, writeTypeValue[ReactionType])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageReaction) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "actor_id":
+			entity.ActorId, err = decodeMessageSender(decoder)
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "old_reaction_types":
+			entity.OldReactionTypes, err = readList(decoder, decodeReactionType)
+
+		case "new_reaction_types":
+			entity.NewReactionTypes, err = readList(decoder, decodeReactionType)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageReaction)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Reactions added to a message with anonymous reactions have changed; for bots only
+type UpdateMessageReactions struct {
+	meta
+	// Chat identifier
+	ChatId int64 `json:"chat_id"`
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Point in time (Unix timestamp) when the reactions were changed
+	Date int32 `json:"date"`
+	// The list of reactions added to the message
+	Reactions []*MessageReaction `json:"reactions"`
+}
+
+func (*UpdateMessageReactions) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdateMessageReactions) GetConstructor() string {
+	return ConstructorUpdateMessageReactions
+}
+
+func (*UpdateMessageReactions) UpdateConstructor() string {
+	return ConstructorUpdateMessageReactions
+}
+
+func (entity *UpdateMessageReactions) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdateMessageReactions) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdateMessageReactions) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updateMessageReactions"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_id":`)
+	encoder.writeInt64(entity.ChatId)
+	encoder.writeRaw(`,"message_id":`)
+	encoder.writeInt64(entity.MessageId)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw(`,"reactions":`)
+	writeList(encoder, entity.Reactions, writeTypeValue[*MessageReaction])
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdateMessageReactions) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_id":
+			entity.ChatId, err = decoder.readInt64()
+
+		case "message_id":
+			entity.MessageId, err = decoder.readInt64()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		case "reactions":
+			entity.Reactions, err = readList(decoder, decodeEntity[MessageReaction])
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdateMessageReactions)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Paid media were purchased by a user; for bots only
+type UpdatePaidMediaPurchased struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Bot-specified payload for the paid media
+	Payload string `json:"payload"`
+}
+
+func (*UpdatePaidMediaPurchased) GetType() string {
+	return TypeUpdate
+}
+
+func (*UpdatePaidMediaPurchased) GetConstructor() string {
+	return ConstructorUpdatePaidMediaPurchased
+}
+
+func (*UpdatePaidMediaPurchased) UpdateConstructor() string {
+	return ConstructorUpdatePaidMediaPurchased
+}
+
+func (entity *UpdatePaidMediaPurchased) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UpdatePaidMediaPurchased) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UpdatePaidMediaPurchased) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updatePaidMediaPurchased"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"payload":`)
+	encoder.writeString(entity.Payload)
+	encoder.writeRaw("}")
+}
+
+func (entity *UpdatePaidMediaPurchased) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "payload":
+			entity.Payload, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdatePaidMediaPurchased)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains a list of updates
+type Updates struct {
+	meta
+	// List of updates
+	Updates []Update `json:"updates"`
+}
+
+func (*Updates) GetType() string {
+	return TypeUpdates
+}
+
+func (*Updates) GetConstructor() string {
+	return ConstructorUpdates
+}
+
+func (entity *Updates) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Updates) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Updates) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"updates"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"updates":`)
+	writeList(encoder, entity.Updates, writeTypeValue[Update])
+	encoder.writeRaw("}")
+}
+
+func (entity *Updates) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "updates":
+			entity.Updates, err = readList(decoder, decodeUpdate)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUpdates)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Updates) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	for i, item := range entity.Updates {
+		if err := validateNested(indexField("updates", i), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,3152 @@
+// AUTOGENERATED
+package client
+
+const (
+	TypeUserType                = "UserType"
+	TypeUserStatus              = "UserStatus"
+	TypeUserPrivacySettingRule  = "UserPrivacySettingRule"
+	TypeUserPrivacySetting      = "UserPrivacySetting"
+	TypeContact                 = "Contact"
+	TypeProfilePhoto            = "ProfilePhoto"
+	TypeCloseBirthdayUser       = "CloseBirthdayUser"
+	TypeProfileAccentColors     = "ProfileAccentColors"
+	TypeProfileAccentColor      = "ProfileAccentColor"
+	TypeUser                    = "User"
+	TypeUserFullInfo            = "UserFullInfo"
+	TypeUsers                   = "Users"
+	TypeFoundUsers              = "FoundUsers"
+	TypeSharedUser              = "SharedUser"
+	TypeImportedContacts        = "ImportedContacts"
+	TypeUserLink                = "UserLink"
+	TypeUserPrivacySettingRules = "UserPrivacySettingRules"
+	TypeReadDatePrivacySettings = "ReadDatePrivacySettings"
+	TypeUserSupportInfo         = "UserSupportInfo"
+)
+
+const (
+	ConstructorContact                                                 = "contact"
+	ConstructorProfilePhoto                                            = "profilePhoto"
+	ConstructorUserTypeRegular                                         = "userTypeRegular"
+	ConstructorUserTypeDeleted                                         = "userTypeDeleted"
+	ConstructorUserTypeBot                                             = "userTypeBot"
+	ConstructorUserTypeUnknown                                         = "userTypeUnknown"
+	ConstructorCloseBirthdayUser                                       = "closeBirthdayUser"
+	ConstructorProfileAccentColors                                     = "profileAccentColors"
+	ConstructorProfileAccentColor                                      = "profileAccentColor"
+	ConstructorUser                                                    = "user"
+	ConstructorUserFullInfo                                            = "userFullInfo"
+	ConstructorUsers                                                   = "users"
+	ConstructorFoundUsers                                              = "foundUsers"
+	ConstructorSharedUser                                              = "sharedUser"
+	ConstructorUserStatusEmpty                                         = "userStatusEmpty"
+	ConstructorUserStatusOnline                                        = "userStatusOnline"
+	ConstructorUserStatusOffline                                       = "userStatusOffline"
+	ConstructorUserStatusRecently                                      = "userStatusRecently"
+	ConstructorUserStatusLastWeek                                      = "userStatusLastWeek"
+	ConstructorUserStatusLastMonth                                     = "userStatusLastMonth"
+	ConstructorImportedContacts                                        = "importedContacts"
+	ConstructorUserLink                                                = "userLink"
+	ConstructorUserPrivacySettingRuleAllowAll                          = "userPrivacySettingRuleAllowAll"
+	ConstructorUserPrivacySettingRuleAllowContacts                     = "userPrivacySettingRuleAllowContacts"
+	ConstructorUserPrivacySettingRuleAllowBots                         = "userPrivacySettingRuleAllowBots"
+	ConstructorUserPrivacySettingRuleAllowPremiumUsers                 = "userPrivacySettingRuleAllowPremiumUsers"
+	ConstructorUserPrivacySettingRuleAllowUsers                        = "userPrivacySettingRuleAllowUsers"
+	ConstructorUserPrivacySettingRuleAllowChatMembers                  = "userPrivacySettingRuleAllowChatMembers"
+	ConstructorUserPrivacySettingRuleRestrictAll                       = "userPrivacySettingRuleRestrictAll"
+	ConstructorUserPrivacySettingRuleRestrictContacts                  = "userPrivacySettingRuleRestrictContacts"
+	ConstructorUserPrivacySettingRuleRestrictBots                      = "userPrivacySettingRuleRestrictBots"
+	ConstructorUserPrivacySettingRuleRestrictUsers                     = "userPrivacySettingRuleRestrictUsers"
+	ConstructorUserPrivacySettingRuleRestrictChatMembers               = "userPrivacySettingRuleRestrictChatMembers"
+	ConstructorUserPrivacySettingRules                                 = "userPrivacySettingRules"
+	ConstructorUserPrivacySettingShowStatus                            = "userPrivacySettingShowStatus"
+	ConstructorUserPrivacySettingShowProfilePhoto                      = "userPrivacySettingShowProfilePhoto"
+	ConstructorUserPrivacySettingShowLinkInForwardedMessages           = "userPrivacySettingShowLinkInForwardedMessages"
+	ConstructorUserPrivacySettingShowPhoneNumber                       = "userPrivacySettingShowPhoneNumber"
+	ConstructorUserPrivacySettingShowBio                               = "userPrivacySettingShowBio"
+	ConstructorUserPrivacySettingShowBirthdate                         = "userPrivacySettingShowBirthdate"
+	ConstructorUserPrivacySettingAllowChatInvites                      = "userPrivacySettingAllowChatInvites"
+	ConstructorUserPrivacySettingAllowCalls                            = "userPrivacySettingAllowCalls"
+	ConstructorUserPrivacySettingAllowPeerToPeerCalls                  = "userPrivacySettingAllowPeerToPeerCalls"
+	ConstructorUserPrivacySettingAllowFindingByPhoneNumber             = "userPrivacySettingAllowFindingByPhoneNumber"
+	ConstructorUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages = "userPrivacySettingAllowPrivateVoiceAndVideoNoteMessages"
+	ConstructorUserPrivacySettingAutosaveGifts                         = "userPrivacySettingAutosaveGifts"
+	ConstructorUserPrivacySettingAllowUnpaidMessages                   = "userPrivacySettingAllowUnpaidMessages"
+	ConstructorReadDatePrivacySettings                                 = "readDatePrivacySettings"
+	ConstructorUserSupportInfo                                         = "userSupportInfo"
+)
+
+// Represents the type of user. The following types are possible: regular users, deleted users and bots
+type UserType interface {
+	UserTypeConstructor() string
+}
+
+// Describes the last time the user was online
+type UserStatus interface {
+	UserStatusConstructor() string
+}
+
+// Represents a single rule for managing user privacy settings
+type UserPrivacySettingRule interface {
+	UserPrivacySettingRuleConstructor() string
+}
+
+// Describes available user privacy settings
+type UserPrivacySetting interface {
+	UserPrivacySettingConstructor() string
+}
+
+func (entity *UnknownType) UserTypeConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) UserStatusConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) UserPrivacySettingRuleConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) UserPrivacySettingConstructor() string {
+	return entity.Constructor
+}
+
+// Describes a user contact
+type Contact struct {
+	meta
+	// Phone number of the user
+	PhoneNumber string `json:"phone_number"`
+	// First name of the user; 1-255 characters in length
+	FirstName string `json:"first_name"`
+	// Last name of the user
+	LastName string `json:"last_name"`
+	// Additional data about the user in a form of vCard; 0-2048 bytes in length
+	Vcard string `json:"vcard"`
+	// Identifier of the user, if known; 0 otherwise
+	UserId int64 `json:"user_id"`
+}
+
+func (*Contact) GetType() string {
+	return TypeContact
+}
+
+func (*Contact) GetConstructor() string {
+	return ConstructorContact
+}
+
+func (entity *Contact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Contact) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Contact) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"contact"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw(`,"first_name":`)
+	encoder.writeString(entity.FirstName)
+	encoder.writeRaw(`,"last_name":`)
+	encoder.writeString(entity.LastName)
+	encoder.writeRaw(`,"vcard":`)
+	encoder.writeString(entity.Vcard)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw("}")
+}
+
+func (entity *Contact) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		case "first_name":
+			entity.FirstName, err = decoder.readString()
+
+		case "last_name":
+			entity.LastName, err = decoder.readString()
+
+		case "vcard":
+			entity.Vcard, err = decoder.readString()
+
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorContact)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *Contact) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateLength("first_name", entity.FirstName, 1, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Describes a user profile photo
+type ProfilePhoto struct {
+	meta
+	// Photo identifier; 0 for an empty photo. Can be used to find a photo in a list of user profile photos
+	Id JsonInt64 `json:"id"`
+	// A small (160x160) user profile photo. The file can be downloaded only before the photo is changed
+	Small *File `json:"small"`
+	// A big (640x640) user profile photo. The file can be downloaded only before the photo is changed
+	Big *File `json:"big"`
+	// User profile photo minithumbnail; may be null
+	Minithumbnail *Minithumbnail `json:"minithumbnail,omitempty"`
+	// True, if the photo has animated variant
+	HasAnimation bool `json:"has_animation"`
+	// True, if the photo is visible only for the current user
+	IsPersonal bool `json:"is_personal"`
+}
+
+func (*ProfilePhoto) GetType() string {
+	return TypeProfilePhoto
+}
+
+func (*ProfilePhoto) GetConstructor() string {
+	return ConstructorProfilePhoto
+}
+
+func (entity *ProfilePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ProfilePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ProfilePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"profilePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeJsonInt64(entity.Id)
+	encoder.writeRaw(`,"small":`)
+	entity.Small.encodeJSON(encoder)
+	encoder.writeRaw(`,"big":`)
+	entity.Big.encodeJSON(encoder)
+	if entity.Minithumbnail != nil {
+		encoder.writeRaw(`,"minithumbnail":`)
+		entity.Minithumbnail.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"has_animation":`)
+	encoder.writeBool(entity.HasAnimation)
+	encoder.writeRaw(`,"is_personal":`)
+	encoder.writeBool(entity.IsPersonal)
+	encoder.writeRaw("}")
+}
+
+func (entity *ProfilePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readJsonInt64()
+
+		case "small":
+			entity.Small, err = decodeEntity[File](decoder)
+
+		case "big":
+			entity.Big, err = decodeEntity[File](decoder)
+
+		case "minithumbnail":
+			entity.Minithumbnail, err = decodeEntity[Minithumbnail](decoder)
+
+		case "has_animation":
+			entity.HasAnimation, err = decoder.readBool()
+
+		case "is_personal":
+			entity.IsPersonal, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProfilePhoto)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A regular user
+type UserTypeRegular struct {
+	meta
+}
+
+func (*UserTypeRegular) GetType() string {
+	return TypeUserType
+}
+
+func (*UserTypeRegular) GetConstructor() string {
+	return ConstructorUserTypeRegular
+}
+
+func (*UserTypeRegular) UserTypeConstructor() string {
+	return ConstructorUserTypeRegular
+}
+
+func (entity *UserTypeRegular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserTypeRegular) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserTypeRegular) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userTypeRegular"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserTypeRegular) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserTypeRegular)
+	})
+}
+
+// A deleted user or deleted bot. No information on the user besides the user identifier is available. It is not possible to perform any active actions on this type of user
+type UserTypeDeleted struct {
+	meta
+}
+
+func (*UserTypeDeleted) GetType() string {
+	return TypeUserType
+}
+
+func (*UserTypeDeleted) GetConstructor() string {
+	return ConstructorUserTypeDeleted
+}
+
+func (*UserTypeDeleted) UserTypeConstructor() string {
+	return ConstructorUserTypeDeleted
+}
+
+func (entity *UserTypeDeleted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserTypeDeleted) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserTypeDeleted) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userTypeDeleted"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserTypeDeleted) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserTypeDeleted)
+	})
+}
+
+// A bot (see https://core.telegram.org/bots)
+type UserTypeBot struct {
+	meta
+	// True, if the bot is owned by the current user and can be edited using the methods toggleBotUsernameIsActive, reorderBotActiveUsernames, setBotProfilePhoto, setBotName, setBotInfoDescription, and setBotInfoShortDescription
+	CanBeEdited bool `json:"can_be_edited"`
+	// True, if the bot can be invited to basic group and supergroup chats
+	CanJoinGroups bool `json:"can_join_groups"`
+	// True, if the bot can read all messages in basic group or supergroup chats and not just those addressed to the bot. In private and channel chats a bot can always read all messages
+	CanReadAllGroupMessages bool `json:"can_read_all_group_messages"`
+	// True, if the bot has the main Web App
+	HasMainWebApp bool `json:"has_main_web_app"`
+	// True, if the bot supports inline queries
+	IsInline bool `json:"is_inline"`
+	// Placeholder for inline queries (displayed on the application input field)
+	InlineQueryPlaceholder string `json:"inline_query_placeholder"`
+	// True, if the location of the user is expected to be sent with every inline query to this bot
+	NeedLocation bool `json:"need_location"`
+	// True, if the bot supports connection to Telegram Business accounts
+	CanConnectToBusiness bool `json:"can_connect_to_business"`
+	// True, if the bot can be added to attachment or side menu
+	CanBeAddedToAttachmentMenu bool `json:"can_be_added_to_attachment_menu"`
+	// The number of recently active users of the bot
+	ActiveUserCount int32 `json:"active_user_count"`
+}
+
+func (*UserTypeBot) GetType() string {
+	return TypeUserType
+}
+
+func (*UserTypeBot) GetConstructor() string {
+	return ConstructorUserTypeBot
+}
+
+func (*UserTypeBot) UserTypeConstructor() string {
+	return ConstructorUserTypeBot
+}
+
+func (entity *UserTypeBot) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserTypeBot) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserTypeBot) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userTypeBot"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"can_be_edited":`)
+	encoder.writeBool(entity.CanBeEdited)
+	encoder.writeRaw(`,"can_join_groups":`)
+	encoder.writeBool(entity.CanJoinGroups)
+	encoder.writeRaw(`,"can_read_all_group_messages":`)
+	encoder.writeBool(entity.CanReadAllGroupMessages)
+	encoder.writeRaw(`,"has_main_web_app":`)
+	encoder.writeBool(entity.HasMainWebApp)
+	encoder.writeRaw(`,"is_inline":`)
+	encoder.writeBool(entity.IsInline)
+	encoder.writeRaw(`,"inline_query_placeholder":`)
+	encoder.writeString(entity.InlineQueryPlaceholder)
+	encoder.writeRaw(`,"need_location":`)
+	encoder.writeBool(entity.NeedLocation)
+	encoder.writeRaw(`,"can_connect_to_business":`)
+	encoder.writeBool(entity.CanConnectToBusiness)
+	encoder.writeRaw(`,"can_be_added_to_attachment_menu":`)
+	encoder.writeBool(entity.CanBeAddedToAttachmentMenu)
+	encoder.writeRaw(`,"active_user_count":`)
+	encoder.writeInt32(entity.ActiveUserCount)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserTypeBot) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "can_be_edited":
+			entity.CanBeEdited, err = decoder.readBool()
+
+		case "can_join_groups":
+			entity.CanJoinGroups, err = decoder.readBool()
+
+		case "can_read_all_group_messages":
+			entity.CanReadAllGroupMessages, err = decoder.readBool()
+
+		case "has_main_web_app":
+			entity.HasMainWebApp, err = decoder.readBool()
+
+		case "is_inline":
+			entity.IsInline, err = decoder.readBool()
+
+		case "inline_query_placeholder":
+			entity.InlineQueryPlaceholder, err = decoder.readString()
+
+		case "need_location":
+			entity.NeedLocation, err = decoder.readBool()
+
+		case "can_connect_to_business":
+			entity.CanConnectToBusiness, err = decoder.readBool()
+
+		case "can_be_added_to_attachment_menu":
+			entity.CanBeAddedToAttachmentMenu, err = decoder.readBool()
+
+		case "active_user_count":
+			entity.ActiveUserCount, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserTypeBot)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// No information on the user besides the user identifier is available, yet this user has not been deleted. This object is extremely rare and must be handled like a deleted user. It is not possible to perform any actions on users of this type
+type UserTypeUnknown struct {
+	meta
+}
+
+func (*UserTypeUnknown) GetType() string {
+	return TypeUserType
+}
+
+func (*UserTypeUnknown) GetConstructor() string {
+	return ConstructorUserTypeUnknown
+}
+
+func (*UserTypeUnknown) UserTypeConstructor() string {
+	return ConstructorUserTypeUnknown
+}
+
+func (entity *UserTypeUnknown) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserTypeUnknown) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserTypeUnknown) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userTypeUnknown"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserTypeUnknown) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserTypeUnknown)
+	})
+}
+
+// Describes a user that had or will have a birthday soon
+type CloseBirthdayUser struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// Birthdate of the user
+	Birthdate *Birthdate `json:"birthdate"`
+}
+
+func (*CloseBirthdayUser) GetType() string {
+	return TypeCloseBirthdayUser
+}
+
+func (*CloseBirthdayUser) GetConstructor() string {
+	return ConstructorCloseBirthdayUser
+}
+
+func (entity *CloseBirthdayUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *CloseBirthdayUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *CloseBirthdayUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"closeBirthdayUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"birthdate":`)
+	entity.Birthdate.encodeJSON(encoder)
+	encoder.writeRaw("}")
+}
+
+func (entity *CloseBirthdayUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "birthdate":
+			entity.Birthdate, err = decodeEntity[Birthdate](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorCloseBirthdayUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about supported accent colors for user profile photo background in RGB format
+type ProfileAccentColors struct {
+	meta
+	// The list of 1-2 colors in RGB format, describing the colors, as expected to be shown in the color palette settings
+	PaletteColors []int32 `json:"palette_colors"`
+	// The list of 1-2 colors in RGB format, describing the colors, as expected to be used for the profile photo background
+	BackgroundColors []int32 `json:"background_colors"`
+	// The list of 2 colors in RGB format, describing the colors of the gradient to be used for the unread active story indicator around profile photo
+	StoryColors []int32 `json:"story_colors"`
+}
+
+func (*ProfileAccentColors) GetType() string {
+	return TypeProfileAccentColors
+}
+
+func (*ProfileAccentColors) GetConstructor() string {
+	return ConstructorProfileAccentColors
+}
+
+func (entity *ProfileAccentColors) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ProfileAccentColors) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ProfileAccentColors) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"profileAccentColors"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"palette_colors":`)
+	writeList(encoder, entity.PaletteColors, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"background_colors":`)
+	writeList(encoder, entity.BackgroundColors, (*jsonEncoder).writeInt32)
+	encoder.writeRaw(`,"story_colors":`)
+	writeList(encoder, entity.StoryColors, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *ProfileAccentColors) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "palette_colors":
+			entity.PaletteColors, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "background_colors":
+			entity.BackgroundColors, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		case "story_colors":
+			entity.StoryColors, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProfileAccentColors)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about supported accent color for user profile photo background
+type ProfileAccentColor struct {
+	meta
+	// Profile accent color identifier
+	Id int32 `json:"id"`
+	// Accent colors expected to be used in light themes
+	LightThemeColors *ProfileAccentColors `json:"light_theme_colors"`
+	// Accent colors expected to be used in dark themes
+	DarkThemeColors *ProfileAccentColors `json:"dark_theme_colors"`
+	// The minimum chat boost level required to use the color in a supergroup chat
+	MinSupergroupChatBoostLevel int32 `json:"min_supergroup_chat_boost_level"`
+	// The minimum chat boost level required to use the color in a channel chat
+	MinChannelChatBoostLevel int32 `json:"min_channel_chat_boost_level"`
+}
+
+func (*ProfileAccentColor) GetType() string {
+	return TypeProfileAccentColor
+}
+
+func (*ProfileAccentColor) GetConstructor() string {
+	return ConstructorProfileAccentColor
+}
+
+func (entity *ProfileAccentColor) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ProfileAccentColor) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ProfileAccentColor) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"profileAccentColor"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt32(entity.Id)
+	encoder.writeRaw(`,"light_theme_colors":`)
+	entity.LightThemeColors.encodeJSON(encoder)
+	encoder.writeRaw(`,"dark_theme_colors":`)
+	entity.DarkThemeColors.encodeJSON(encoder)
+	encoder.writeRaw(`,"min_supergroup_chat_boost_level":`)
+	encoder.writeInt32(entity.MinSupergroupChatBoostLevel)
+	encoder.writeRaw(`,"min_channel_chat_boost_level":`)
+	encoder.writeInt32(entity.MinChannelChatBoostLevel)
+	encoder.writeRaw("}")
+}
+
+func (entity *ProfileAccentColor) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt32()
+
+		case "light_theme_colors":
+			entity.LightThemeColors, err = decodeEntity[ProfileAccentColors](decoder)
+
+		case "dark_theme_colors":
+			entity.DarkThemeColors, err = decodeEntity[ProfileAccentColors](decoder)
+
+		case "min_supergroup_chat_boost_level":
+			entity.MinSupergroupChatBoostLevel, err = decoder.readInt32()
+
+		case "min_channel_chat_boost_level":
+			entity.MinChannelChatBoostLevel, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorProfileAccentColor)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a user
+type User struct {
+	meta
+	// User identifier
+	Id int64 `json:"id"`
+	// First name of the user
+	FirstName string `json:"first_name"`
+	// Last name of the user
+	LastName string `json:"last_name"`
+	// Usernames of the user; may be null
+	Usernames *Usernames `json:"usernames,omitempty"`
+	// Phone number of the user
+	PhoneNumber string `json:"phone_number"`
+	// Current online status of the user
+	Status UserStatus `json:"status"`
+	// Profile photo of the user; may be null
+	ProfilePhoto *ProfilePhoto `json:"profile_photo,omitempty"`
+	// Identifier of the accent color for name, and backgrounds of profile photo, reply header, and link preview
+	AccentColorId int32 `json:"accent_color_id"`
+	// Identifier of a custom emoji to be shown on the reply header and link preview background; 0 if none
+	BackgroundCustomEmojiId JsonInt64 `json:"background_custom_emoji_id"`
+	// Identifier of the accent color for the user's profile; -1 if none
+	ProfileAccentColorId int32 `json:"profile_accent_color_id"`
+	// Identifier of a custom emoji to be shown on the background of the user's profile; 0 if none
+	ProfileBackgroundCustomEmojiId JsonInt64 `json:"profile_background_custom_emoji_id"`
+	// Emoji status to be shown instead of the default Telegram Premium badge; may be null
+	EmojiStatus *EmojiStatus `json:"emoji_status,omitempty"`
+	// The user is a contact of the current user
+	IsContact bool `json:"is_contact"`
+	// The user is a contact of the current user and the current user is a contact of the user
+	IsMutualContact bool `json:"is_mutual_contact"`
+	// The user is a close friend of the current user; implies that the user is a contact
+	IsCloseFriend bool `json:"is_close_friend"`
+	// Information about verification status of the user; may be null if none
+	VerificationStatus *VerificationStatus `json:"verification_status,omitempty"`
+	// True, if the user is a Telegram Premium user
+	IsPremium bool `json:"is_premium"`
+	// True, if the user is Telegram support account
+	IsSupport bool `json:"is_support"`
+	// If non-empty, it contains a human-readable description of the reason why access to this user must be restricted
+	RestrictionReason string `json:"restriction_reason"`
+	// True, if the user has non-expired stories available to the current user
+	HasActiveStories bool `json:"has_active_stories"`
+	// True, if the user has unread non-expired stories available to the current user
+	HasUnreadActiveStories bool `json:"has_unread_active_stories"`
+	// True, if the user may restrict new chats with non-contacts. Use canSendMessageToUser to check whether the current user can message the user or try to create a chat with them
+	RestrictsNewChats bool `json:"restricts_new_chats"`
+	// Number of Telegram Stars that must be paid by general user for each sent message to the user. If positive and userFullInfo is unknown, use canSendMessageToUser to check whether the current user must pay
+	PaidMessageStarCount int64 `json:"paid_message_star_count"`
+	// If false, the user is inaccessible, and the only information known about the user is inside this class. Identifier of the user can't be passed to any method
+	HaveAccess bool `json:"have_access"`
+	// Type of the user
+	Type UserType `json:"type"`
+	// IETF language tag of the user's language; only available to bots
+	LanguageCode string `json:"language_code"`
+	// True, if the user added the current bot to attachment menu; only available to bots
+	AddedToAttachmentMenu bool `json:"added_to_attachment_menu"`
+}
+
+func (*User) GetType() string {
+	return TypeUser
+}
+
+func (*User) GetConstructor() string {
+	return ConstructorUser
+}
+
+func (entity *User) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *User) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *User) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"user"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"id":`)
+	encoder.writeInt64(entity.Id)
+	encoder.writeRaw(`,"first_name":`)
+	encoder.writeString(entity.FirstName)
+	encoder.writeRaw(`,"last_name":`)
+	encoder.writeString(entity.LastName)
+	if entity.Usernames != nil {
+		encoder.writeRaw(`,"usernames":`)
+		entity.Usernames.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"phone_number":`)
+	encoder.writeString(entity.PhoneNumber)
+	encoder.writeRaw(`,"status":`)
+	encoder.writeType(entity.Status)
+	if entity.ProfilePhoto != nil {
+		encoder.writeRaw(`,"profile_photo":`)
+		entity.ProfilePhoto.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"accent_color_id":`)
+	encoder.writeInt32(entity.AccentColorId)
+	encoder.writeRaw(`,"background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.BackgroundCustomEmojiId)
+	encoder.writeRaw(`,"profile_accent_color_id":`)
+	encoder.writeInt32(entity.ProfileAccentColorId)
+	encoder.writeRaw(`,"profile_background_custom_emoji_id":`)
+	encoder.writeJsonInt64(entity.ProfileBackgroundCustomEmojiId)
+	if entity.EmojiStatus != nil {
+		encoder.writeRaw(`,"emoji_status":`)
+		entity.EmojiStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_contact":`)
+	encoder.writeBool(entity.IsContact)
+	encoder.writeRaw(`,"is_mutual_contact":`)
+	encoder.writeBool(entity.IsMutualContact)
+	encoder.writeRaw(`,"is_close_friend":`)
+	encoder.writeBool(entity.IsCloseFriend)
+	if entity.VerificationStatus != nil {
+		encoder.writeRaw(`,"verification_status":`)
+		entity.VerificationStatus.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"is_premium":`)
+	encoder.writeBool(entity.IsPremium)
+	encoder.writeRaw(`,"is_support":`)
+	encoder.writeBool(entity.IsSupport)
+	encoder.writeRaw(`,"restriction_reason":`)
+	encoder.writeString(entity.RestrictionReason)
+	encoder.writeRaw(`,"has_active_stories":`)
+	encoder.writeBool(entity.HasActiveStories)
+	encoder.writeRaw(`,"has_unread_active_stories":`)
+	encoder.writeBool(entity.HasUnreadActiveStories)
+	encoder.writeRaw(`,"restricts_new_chats":`)
+	encoder.writeBool(entity.RestrictsNewChats)
+	encoder.writeRaw(`,"paid_message_star_count":`)
+	encoder.writeInt64(entity.PaidMessageStarCount)
+	encoder.writeRaw(`,"have_access":`)
+	encoder.writeBool(entity.HaveAccess)
+	encoder.writeRaw(`,"type":`)
+	encoder.writeType(entity.Type)
+	encoder.writeRaw(`,"language_code":`)
+	encoder.writeString(entity.LanguageCode)
+	encoder.writeRaw(`,"added_to_attachment_menu":`)
+	encoder.writeBool(entity.AddedToAttachmentMenu)
+	encoder.writeRaw("}")
+}
+
+func (entity *User) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "id":
+			entity.Id, err = decoder.readInt64()
+
+		case "first_name":
+			entity.FirstName, err = decoder.readString()
+
+		case "last_name":
+			entity.LastName, err = decoder.readString()
+
+		case "usernames":
+			entity.Usernames, err = decodeEntity[Usernames](decoder)
+
+		case "phone_number":
+			entity.PhoneNumber, err = decoder.readString()
+
+		case "status":
+			entity.Status, err = decodeUserStatus(decoder)
+
+		case "profile_photo":
+			entity.ProfilePhoto, err = decodeEntity[ProfilePhoto](decoder)
+
+		case "accent_color_id":
+			entity.AccentColorId, err = decoder.readInt32()
+
+		case "background_custom_emoji_id":
+			entity.BackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "profile_accent_color_id":
+			entity.ProfileAccentColorId, err = decoder.readInt32()
+
+		case "profile_background_custom_emoji_id":
+			entity.ProfileBackgroundCustomEmojiId, err = decoder.readJsonInt64()
+
+		case "emoji_status":
+			entity.EmojiStatus, err = decodeEntity[EmojiStatus](decoder)
+
+		case "is_contact":
+			entity.IsContact, err = decoder.readBool()
+
+		case "is_mutual_contact":
+			entity.IsMutualContact, err = decoder.readBool()
+
+		case "is_close_friend":
+			entity.IsCloseFriend, err = decoder.readBool()
+
+		case "verification_status":
+			entity.VerificationStatus, err = decodeEntity[VerificationStatus](decoder)
+
+		case "is_premium":
+			entity.IsPremium, err = decoder.readBool()
+
+		case "is_support":
+			entity.IsSupport, err = decoder.readBool()
+
+		case "restriction_reason":
+			entity.RestrictionReason, err = decoder.readString()
+
+		case "has_active_stories":
+			entity.HasActiveStories, err = decoder.readBool()
+
+		case "has_unread_active_stories":
+			entity.HasUnreadActiveStories, err = decoder.readBool()
+
+		case "restricts_new_chats":
+			entity.RestrictsNewChats, err = decoder.readBool()
+
+		case "paid_message_star_count":
+			entity.PaidMessageStarCount, err = decoder.readInt64()
+
+		case "have_access":
+			entity.HaveAccess, err = decoder.readBool()
+
+		case "type":
+			entity.Type, err = decodeUserType(decoder)
+
+		case "language_code":
+			entity.LanguageCode, err = decoder.readString()
+
+		case "added_to_attachment_menu":
+			entity.AddedToAttachmentMenu, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains full information about a user
+type UserFullInfo struct {
+	meta
+	// User profile photo set by the current user for the contact; may be null. If null and user.profile_photo is null, then the photo is empty; otherwise, it is unknown. If non-null, then it is the same photo as in user.profile_photo and chat.photo. This photo isn't returned in the list of user photos
+	PersonalPhoto *ChatPhoto `json:"personal_photo,omitempty"`
+	// User profile photo; may be null. If null and user.profile_photo is null, then the photo is empty; otherwise, it is unknown. If non-null and personal_photo is null, then it is the same photo as in user.profile_photo and chat.photo
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// User profile photo visible if the main photo is hidden by privacy settings; may be null. If null and user.profile_photo is null, then the photo is empty; otherwise, it is unknown. If non-null and both photo and personal_photo are null, then it is the same photo as in user.profile_photo and chat.photo. This photo isn't returned in the list of user photos
+	PublicPhoto *ChatPhoto `json:"public_photo,omitempty"`
+	// Block list to which the user is added; may be null if none
+	BlockList BlockList `json:"block_list,omitempty"`
+	// True, if the user can be called
+	CanBeCalled bool `json:"can_be_called"`
+	// True, if a video call can be created with the user
+	SupportsVideoCalls bool `json:"supports_video_calls"`
+	// True, if the user can't be called due to their privacy settings
+	HasPrivateCalls bool `json:"has_private_calls"`
+	// True, if the user can't be linked in forwarded messages due to their privacy settings
+	HasPrivateForwards bool `json:"has_private_forwards"`
+	// True, if voice and video notes can't be sent or forwarded to the user
+	HasRestrictedVoiceAndVideoNoteMessages bool `json:"has_restricted_voice_and_video_note_messages"`
+	// True, if the user has posted to profile stories
+	HasPostedToProfileStories bool `json:"has_posted_to_profile_stories"`
+	// True, if the user always enabled sponsored messages; known only for the current user
+	HasSponsoredMessagesEnabled bool `json:"has_sponsored_messages_enabled"`
+	// True, if the current user needs to explicitly allow to share their phone number with the user when the method addContact is used
+	NeedPhoneNumberPrivacyException bool `json:"need_phone_number_privacy_exception"`
+	// True, if the user set chat background for both chat users and it wasn't reverted yet
+	SetChatBackground bool `json:"set_chat_background"`
+	// A short user bio; may be null for bots
+	Bio *FormattedText `json:"bio,omitempty"`
+	// Birthdate of the user; may be null if unknown
+	Birthdate *Birthdate `json:"birthdate,omitempty"`
+	// Identifier of the personal chat of the user; 0 if none
+	PersonalChatId int64 `json:"personal_chat_id"`
+	// Number of saved to profile gifts for other users or the total number of received gifts for the current user
+	GiftCount int32 `json:"gift_count"`
+	// Number of group chats where both the other user and the current user are a member; 0 for the current user
+	GroupInCommonCount int32 `json:"group_in_common_count"`
+	// Number of Telegram Stars that must be paid by the user for each sent message to the current user
+	IncomingPaidMessageStarCount int64 `json:"incoming_paid_message_star_count"`
+	// Number of Telegram Stars that must be paid by the current user for each sent message to the user
+	OutgoingPaidMessageStarCount int64 `json:"outgoing_paid_message_star_count"`
+	// Settings for gift receiving for the user
+	GiftSettings *GiftSettings `json:"gift_settings"`
+	// Information about verification status of the user provided by a bot; may be null if none or unknown
+	BotVerification *BotVerification `json:"bot_verification,omitempty"`
+	// Information about business settings for Telegram Business accounts; may be null if none
+	BusinessInfo *BusinessInfo `json:"business_info,omitempty"`
+	// For bots, information about the bot; may be null if the user isn't a bot
+	BotInfo *BotInfo `json:"bot_info,omitempty"`
+}
+
+func (*UserFullInfo) GetType() string {
+	return TypeUserFullInfo
+}
+
+func (*UserFullInfo) GetConstructor() string {
+	return ConstructorUserFullInfo
+}
+
+func (entity *UserFullInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserFullInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserFullInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userFullInfo"`)
+	encoder.writeMeta(&entity.meta)
+	if entity.PersonalPhoto != nil {
+		encoder.writeRaw(`,"personal_photo":`)
+		entity.PersonalPhoto.encodeJSON(encoder)
+	}
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	if entity.PublicPhoto != nil {
+		encoder.writeRaw(`,"public_photo":`)
+		entity.PublicPhoto.encodeJSON(encoder)
+	}
+	if entity.BlockList != nil {
+		encoder.writeRaw(`,"block_list":`)
+		encoder.writeType(entity.BlockList)
+	}
+	encoder.writeRaw(`,"can_be_called":`)
+	encoder.writeBool(entity.CanBeCalled)
+	encoder.writeRaw(`,"supports_video_calls":`)
+	encoder.writeBool(entity.SupportsVideoCalls)
+	encoder.writeRaw(`,"has_private_calls":`)
+	encoder.writeBool(entity.HasPrivateCalls)
+	encoder.writeRaw(`,"has_private_forwards":`)
+	encoder.writeBool(entity.HasPrivateForwards)
+	encoder.writeRaw(`,"has_restricted_voice_and_video_note_messages":`)
+	encoder.writeBool(entity.HasRestrictedVoiceAndVideoNoteMessages)
+	encoder.writeRaw(`,"has_posted_to_profile_stories":`)
+	encoder.writeBool(entity.HasPostedToProfileStories)
+	encoder.writeRaw(`,"has_sponsored_messages_enabled":`)
+	encoder.writeBool(entity.HasSponsoredMessagesEnabled)
+	encoder.writeRaw(`,"need_phone_number_privacy_exception":`)
+	encoder.writeBool(entity.NeedPhoneNumberPrivacyException)
+	encoder.writeRaw(`,"set_chat_background":`)
+	encoder.writeBool(entity.SetChatBackground)
+	if entity.Bio != nil {
+		encoder.writeRaw(`,"bio":`)
+		entity.Bio.encodeJSON(encoder)
+	}
+	if entity.Birthdate != nil {
+		encoder.writeRaw(`,"birthdate":`)
+		entity.Birthdate.encodeJSON(encoder)
+	}
+	encoder.writeRaw(`,"personal_chat_id":`)
+	encoder.writeInt64(entity.PersonalChatId)
+	encoder.writeRaw(`,"gift_count":`)
+	encoder.writeInt32(entity.GiftCount)
+	encoder.writeRaw(`,"group_in_common_count":`)
+	encoder.writeInt32(entity.GroupInCommonCount)
+	encoder.writeRaw(`,"incoming_paid_message_star_count":`)
+	encoder.writeInt64(entity.IncomingPaidMessageStarCount)
+	encoder.writeRaw(`,"outgoing_paid_message_star_count":`)
+	encoder.writeInt64(entity.OutgoingPaidMessageStarCount)
+	encoder.writeRaw(`,"gift_settings":`)
+	entity.GiftSettings.encodeJSON(encoder)
+	if entity.BotVerification != nil {
+		encoder.writeRaw(`,"bot_verification":`)
+		entity.BotVerification.encodeJSON(encoder)
+	}
+	if entity.BusinessInfo != nil {
+		encoder.writeRaw(`,"business_info":`)
+		entity.BusinessInfo.encodeJSON(encoder)
+	}
+	if entity.BotInfo != nil {
+		encoder.writeRaw(`,"bot_info":`)
+		entity.BotInfo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *UserFullInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "personal_photo":
+			entity.PersonalPhoto, err = decodeEntity[ChatPhoto](decoder)
+
+		case "photo":
+			entity.Photo, err = decodeEntity[ChatPhoto](decoder)
+
+		case "public_photo":
+			entity.PublicPhoto, err = decodeEntity[ChatPhoto](decoder)
+
+		case "block_list":
+			entity.BlockList, err = decodeBlockList(decoder)
+
+		case "can_be_called":
+			entity.CanBeCalled, err = decoder.readBool()
+
+		case "supports_video_calls":
+			entity.SupportsVideoCalls, err = decoder.readBool()
+
+		case "has_private_calls":
+			entity.HasPrivateCalls, err = decoder.readBool()
+
+		case "has_private_forwards":
+			entity.HasPrivateForwards, err = decoder.readBool()
+
+		case "has_restricted_voice_and_video_note_messages":
+			entity.HasRestrictedVoiceAndVideoNoteMessages, err = decoder.readBool()
+
+		case "has_posted_to_profile_stories":
+			entity.HasPostedToProfileStories, err = decoder.readBool()
+
+		case "has_sponsored_messages_enabled":
+			entity.HasSponsoredMessagesEnabled, err = decoder.readBool()
+
+		case "need_phone_number_privacy_exception":
+			entity.NeedPhoneNumberPrivacyException, err = decoder.readBool()
+
+		case "set_chat_background":
+			entity.SetChatBackground, err = decoder.readBool()
+
+		case "bio":
+			entity.Bio, err = decodeEntity[FormattedText](decoder)
+
+		case "birthdate":
+			entity.Birthdate, err = decodeEntity[Birthdate](decoder)
+
+		case "personal_chat_id":
+			entity.PersonalChatId, err = decoder.readInt64()
+
+		case "gift_count":
+			entity.GiftCount, err = decoder.readInt32()
+
+		case "group_in_common_count":
+			entity.GroupInCommonCount, err = decoder.readInt32()
+
+		case "incoming_paid_message_star_count":
+			entity.IncomingPaidMessageStarCount, err = decoder.readInt64()
+
+		case "outgoing_paid_message_star_count":
+			entity.OutgoingPaidMessageStarCount, err = decoder.readInt64()
+
+		case "gift_settings":
+			entity.GiftSettings, err = decodeEntity[GiftSettings](decoder)
+
+		case "bot_verification":
+			entity.BotVerification, err = decodeEntity[BotVerification](decoder)
+
+		case "business_info":
+			entity.BusinessInfo, err = decodeEntity[BusinessInfo](decoder)
+
+		case "bot_info":
+			entity.BotInfo, err = decodeEntity[BotInfo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserFullInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+func (entity *UserFullInfo) Validate() error {
+	if entity == nil {
+		return nil
+	}
+
+	if err := validateNested("business_info", entity.BusinessInfo); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Represents a list of users
+type Users struct {
+	meta
+	// Approximate total number of users found
+	TotalCount int32 `json:"total_count"`
+	// A list of user identifiers
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (*Users) GetType() string {
+	return TypeUsers
+}
+
+func (*Users) GetConstructor() string {
+	return ConstructorUsers
+}
+
+func (entity *Users) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *Users) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *Users) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"users"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"total_count":`)
+	encoder.writeInt32(entity.TotalCount)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *Users) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "total_count":
+			entity.TotalCount, err = decoder.readInt32()
+
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUsers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents a list of found users
+type FoundUsers struct {
+	meta
+	// Identifiers of the found users
+	UserIds []int64 `json:"user_ids"`
+	// The offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset"`
+}
+
+func (*FoundUsers) GetType() string {
+	return TypeFoundUsers
+}
+
+func (*FoundUsers) GetConstructor() string {
+	return ConstructorFoundUsers
+}
+
+func (entity *FoundUsers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *FoundUsers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *FoundUsers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"foundUsers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"next_offset":`)
+	encoder.writeString(entity.NextOffset)
+	encoder.writeRaw("}")
+}
+
+func (entity *FoundUsers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "next_offset":
+			entity.NextOffset, err = decoder.readString()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorFoundUsers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains information about a user shared with a bot
+type SharedUser struct {
+	meta
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// First name of the user; for bots only
+	FirstName string `json:"first_name"`
+	// Last name of the user; for bots only
+	LastName string `json:"last_name"`
+	// Username of the user; for bots only
+	Username string `json:"username"`
+	// Profile photo of the user; for bots only; may be null
+	Photo *Photo `json:"photo,omitempty"`
+}
+
+func (*SharedUser) GetType() string {
+	return TypeSharedUser
+}
+
+func (*SharedUser) GetConstructor() string {
+	return ConstructorSharedUser
+}
+
+func (entity *SharedUser) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *SharedUser) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *SharedUser) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"sharedUser"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_id":`)
+	encoder.writeInt64(entity.UserId)
+	encoder.writeRaw(`,"first_name":`)
+	encoder.writeString(entity.FirstName)
+	encoder.writeRaw(`,"last_name":`)
+	encoder.writeString(entity.LastName)
+	encoder.writeRaw(`,"username":`)
+	encoder.writeString(entity.Username)
+	if entity.Photo != nil {
+		encoder.writeRaw(`,"photo":`)
+		entity.Photo.encodeJSON(encoder)
+	}
+	encoder.writeRaw("}")
+}
+
+func (entity *SharedUser) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_id":
+			entity.UserId, err = decoder.readInt64()
+
+		case "first_name":
+			entity.FirstName, err = decoder.readString()
+
+		case "last_name":
+			entity.LastName, err = decoder.readString()
+
+		case "username":
+			entity.Username, err = decoder.readString()
+
+		case "photo":
+			entity.Photo, err = decodeEntity[Photo](decoder)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorSharedUser)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user's status has never been changed
+type UserStatusEmpty struct {
+	meta
+}
+
+func (*UserStatusEmpty) GetType() string {
+	return TypeUserStatus
+}
+
+func (*UserStatusEmpty) GetConstructor() string {
+	return ConstructorUserStatusEmpty
+}
+
+func (*UserStatusEmpty) UserStatusConstructor() string {
+	return ConstructorUserStatusEmpty
+}
+
+func (entity *UserStatusEmpty) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserStatusEmpty) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserStatusEmpty) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userStatusEmpty"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserStatusEmpty) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserStatusEmpty)
+	})
+}
+
+// The user is online
+type UserStatusOnline struct {
+	meta
+	// Point in time (Unix timestamp) when the user's online status will expire
+	Expires int32 `json:"expires"`
+}
+
+func (*UserStatusOnline) GetType() string {
+	return TypeUserStatus
+}
+
+func (*UserStatusOnline) GetConstructor() string {
+	return ConstructorUserStatusOnline
+}
+
+func (*UserStatusOnline) UserStatusConstructor() string {
+	return ConstructorUserStatusOnline
+}
+
+func (entity *UserStatusOnline) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserStatusOnline) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserStatusOnline) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userStatusOnline"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"expires":`)
+	encoder.writeInt32(entity.Expires)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserStatusOnline) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "expires":
+			entity.Expires, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserStatusOnline)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is offline
+type UserStatusOffline struct {
+	meta
+	// Point in time (Unix timestamp) when the user was last online
+	WasOnline int32 `json:"was_online"`
+}
+
+func (*UserStatusOffline) GetType() string {
+	return TypeUserStatus
+}
+
+func (*UserStatusOffline) GetConstructor() string {
+	return ConstructorUserStatusOffline
+}
+
+func (*UserStatusOffline) UserStatusConstructor() string {
+	return ConstructorUserStatusOffline
+}
+
+func (entity *UserStatusOffline) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserStatusOffline) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserStatusOffline) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userStatusOffline"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"was_online":`)
+	encoder.writeInt32(entity.WasOnline)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserStatusOffline) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "was_online":
+			entity.WasOnline, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserStatusOffline)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user was online recently
+type UserStatusRecently struct {
+	meta
+	// Exact user's status is hidden because the current user enabled userPrivacySettingShowStatus privacy setting for the user and has no Telegram Premium
+	ByMyPrivacySettings bool `json:"by_my_privacy_settings"`
+}
+
+func (*UserStatusRecently) GetType() string {
+	return TypeUserStatus
+}
+
+func (*UserStatusRecently) GetConstructor() string {
+	return ConstructorUserStatusRecently
+}
+
+func (*UserStatusRecently) UserStatusConstructor() string {
+	return ConstructorUserStatusRecently
+}
+
+func (entity *UserStatusRecently) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserStatusRecently) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserStatusRecently) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userStatusRecently"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"by_my_privacy_settings":`)
+	encoder.writeBool(entity.ByMyPrivacySettings)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserStatusRecently) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "by_my_privacy_settings":
+			entity.ByMyPrivacySettings, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserStatusRecently)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is offline, but was online last week
+type UserStatusLastWeek struct {
+	meta
+	// Exact user's status is hidden because the current user enabled userPrivacySettingShowStatus privacy setting for the user and has no Telegram Premium
+	ByMyPrivacySettings bool `json:"by_my_privacy_settings"`
+}
+
+func (*UserStatusLastWeek) GetType() string {
+	return TypeUserStatus
+}
+
+func (*UserStatusLastWeek) GetConstructor() string {
+	return ConstructorUserStatusLastWeek
+}
+
+func (*UserStatusLastWeek) UserStatusConstructor() string {
+	return ConstructorUserStatusLastWeek
+}
+
+func (entity *UserStatusLastWeek) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserStatusLastWeek) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserStatusLastWeek) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userStatusLastWeek"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"by_my_privacy_settings":`)
+	encoder.writeBool(entity.ByMyPrivacySettings)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserStatusLastWeek) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "by_my_privacy_settings":
+			entity.ByMyPrivacySettings, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserStatusLastWeek)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// The user is offline, but was online last month
+type UserStatusLastMonth struct {
+	meta
+	// Exact user's status is hidden because the current user enabled userPrivacySettingShowStatus privacy setting for the user and has no Telegram Premium
+	ByMyPrivacySettings bool `json:"by_my_privacy_settings"`
+}
+
+func (*UserStatusLastMonth) GetType() string {
+	return TypeUserStatus
+}
+
+func (*UserStatusLastMonth) GetConstructor() string {
+	return ConstructorUserStatusLastMonth
+}
+
+func (*UserStatusLastMonth) UserStatusConstructor() string {
+	return ConstructorUserStatusLastMonth
+}
+
+func (entity *UserStatusLastMonth) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserStatusLastMonth) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserStatusLastMonth) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userStatusLastMonth"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"by_my_privacy_settings":`)
+	encoder.writeBool(entity.ByMyPrivacySettings)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserStatusLastMonth) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "by_my_privacy_settings":
+			entity.ByMyPrivacySettings, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserStatusLastMonth)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Represents the result of an importContacts request
+type ImportedContacts struct {
+	meta
+	// User identifiers of the imported contacts in the same order as they were specified in the request; 0 if the contact is not yet a registered user
+	UserIds []int64 `json:"user_ids"`
+	// The number of users that imported the corresponding contact; 0 for already registered users or if unavailable
+	ImporterCount []int32 `json:"importer_count"`
+}
+
+func (*ImportedContacts) GetType() string {
+	return TypeImportedContacts
+}
+
+func (*ImportedContacts) GetConstructor() string {
+	return ConstructorImportedContacts
+}
+
+func (entity *ImportedContacts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ImportedContacts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ImportedContacts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"importedContacts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw(`,"importer_count":`)
+	writeList(encoder, entity.ImporterCount, (*jsonEncoder).writeInt32)
+	encoder.writeRaw("}")
+}
+
+func (entity *ImportedContacts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		case "importer_count":
+			entity.ImporterCount, err = readList(decoder, (*jsonDecoder).readInt32)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorImportedContacts)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains an HTTPS URL, which can be used to get information about a user
+type UserLink struct {
+	meta
+	// The URL
+	Url string `json:"url"`
+	// Left time for which the link is valid, in seconds; 0 if the link is a public username link
+	ExpiresIn int32 `json:"expires_in"`
+}
+
+func (*UserLink) GetType() string {
+	return TypeUserLink
+}
+
+func (*UserLink) GetConstructor() string {
+	return ConstructorUserLink
+}
+
+func (entity *UserLink) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserLink) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserLink) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userLink"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"url":`)
+	encoder.writeString(entity.Url)
+	encoder.writeRaw(`,"expires_in":`)
+	encoder.writeInt32(entity.ExpiresIn)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserLink) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "url":
+			entity.Url, err = decoder.readString()
+
+		case "expires_in":
+			entity.ExpiresIn, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserLink)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A rule to allow all users to do something
+type UserPrivacySettingRuleAllowAll struct {
+	meta
+}
+
+func (*UserPrivacySettingRuleAllowAll) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleAllowAll) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowAll
+}
+
+func (*UserPrivacySettingRuleAllowAll) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowAll
+}
+
+func (entity *UserPrivacySettingRuleAllowAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleAllowAll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleAllowAll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleAllowAll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleAllowAll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleAllowAll)
+	})
+}
+
+// A rule to allow all contacts of the user to do something
+type UserPrivacySettingRuleAllowContacts struct {
+	meta
+}
+
+func (*UserPrivacySettingRuleAllowContacts) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleAllowContacts) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowContacts
+}
+
+func (*UserPrivacySettingRuleAllowContacts) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowContacts
+}
+
+func (entity *UserPrivacySettingRuleAllowContacts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleAllowContacts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleAllowContacts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleAllowContacts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleAllowContacts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleAllowContacts)
+	})
+}
+
+// A rule to allow all bots to do something
+type UserPrivacySettingRuleAllowBots struct {
+	meta
+}
+
+func (*UserPrivacySettingRuleAllowBots) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleAllowBots) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowBots
+}
+
+func (*UserPrivacySettingRuleAllowBots) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowBots
+}
+
+func (entity *UserPrivacySettingRuleAllowBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleAllowBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleAllowBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleAllowBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleAllowBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleAllowBots)
+	})
+}
+
+// A rule to allow all Premium Users to do something; currently, allowed only for userPrivacySettingAllowChatInvites
+type UserPrivacySettingRuleAllowPremiumUsers struct {
+	meta
+}
+
+func (*UserPrivacySettingRuleAllowPremiumUsers) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleAllowPremiumUsers) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowPremiumUsers
+}
+
+func (*UserPrivacySettingRuleAllowPremiumUsers) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowPremiumUsers
+}
+
+func (entity *UserPrivacySettingRuleAllowPremiumUsers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleAllowPremiumUsers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleAllowPremiumUsers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleAllowPremiumUsers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleAllowPremiumUsers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleAllowPremiumUsers)
+	})
+}
+
+// A rule to allow certain specified users to do something
+type UserPrivacySettingRuleAllowUsers struct {
+	meta
+	// The user identifiers, total number of users in all rules must not exceed 1000
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (*UserPrivacySettingRuleAllowUsers) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleAllowUsers) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowUsers
+}
+
+func (*UserPrivacySettingRuleAllowUsers) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowUsers
+}
+
+func (entity *UserPrivacySettingRuleAllowUsers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleAllowUsers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleAllowUsers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleAllowUsers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleAllowUsers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleAllowUsers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A rule to allow all members of certain specified basic groups and supergroups to doing something
+type UserPrivacySettingRuleAllowChatMembers struct {
+	meta
+	// The chat identifiers, total number of chats in all rules must not exceed 20
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (*UserPrivacySettingRuleAllowChatMembers) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleAllowChatMembers) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowChatMembers
+}
+
+func (*UserPrivacySettingRuleAllowChatMembers) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleAllowChatMembers
+}
+
+func (entity *UserPrivacySettingRuleAllowChatMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleAllowChatMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleAllowChatMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleAllowChatMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, entity.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleAllowChatMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_ids":
+			entity.ChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleAllowChatMembers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A rule to restrict all users from doing something
+type UserPrivacySettingRuleRestrictAll struct {
+	meta
+}
+
+func (*UserPrivacySettingRuleRestrictAll) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleRestrictAll) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictAll
+}
+
+func (*UserPrivacySettingRuleRestrictAll) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictAll
+}
+
+func (entity *UserPrivacySettingRuleRestrictAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleRestrictAll) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleRestrictAll) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleRestrictAll"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleRestrictAll) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleRestrictAll)
+	})
+}
+
+// A rule to restrict all contacts of the user from doing something
+type UserPrivacySettingRuleRestrictContacts struct {
+	meta
+}
+
+func (*UserPrivacySettingRuleRestrictContacts) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleRestrictContacts) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictContacts
+}
+
+func (*UserPrivacySettingRuleRestrictContacts) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictContacts
+}
+
+func (entity *UserPrivacySettingRuleRestrictContacts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleRestrictContacts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleRestrictContacts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleRestrictContacts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleRestrictContacts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleRestrictContacts)
+	})
+}
+
+// A rule to restrict all bots from doing something
+type UserPrivacySettingRuleRestrictBots struct {
+	meta
+}
+
+func (*UserPrivacySettingRuleRestrictBots) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleRestrictBots) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictBots
+}
+
+func (*UserPrivacySettingRuleRestrictBots) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictBots
+}
+
+func (entity *UserPrivacySettingRuleRestrictBots) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleRestrictBots) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleRestrictBots) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleRestrictBots"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleRestrictBots) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleRestrictBots)
+	})
+}
+
+// A rule to restrict all specified users from doing something
+type UserPrivacySettingRuleRestrictUsers struct {
+	meta
+	// The user identifiers, total number of users in all rules must not exceed 1000
+	UserIds []int64 `json:"user_ids"`
+}
+
+func (*UserPrivacySettingRuleRestrictUsers) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleRestrictUsers) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictUsers
+}
+
+func (*UserPrivacySettingRuleRestrictUsers) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictUsers
+}
+
+func (entity *UserPrivacySettingRuleRestrictUsers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleRestrictUsers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleRestrictUsers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleRestrictUsers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"user_ids":`)
+	writeList(encoder, entity.UserIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleRestrictUsers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "user_ids":
+			entity.UserIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleRestrictUsers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A rule to restrict all members of specified basic groups and supergroups from doing something
+type UserPrivacySettingRuleRestrictChatMembers struct {
+	meta
+	// The chat identifiers, total number of chats in all rules must not exceed 20
+	ChatIds []int64 `json:"chat_ids"`
+}
+
+func (*UserPrivacySettingRuleRestrictChatMembers) GetType() string {
+	return TypeUserPrivacySettingRule
+}
+
+func (*UserPrivacySettingRuleRestrictChatMembers) GetConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictChatMembers
+}
+
+func (*UserPrivacySettingRuleRestrictChatMembers) UserPrivacySettingRuleConstructor() string {
+	return ConstructorUserPrivacySettingRuleRestrictChatMembers
+}
+
+func (entity *UserPrivacySettingRuleRestrictChatMembers) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRuleRestrictChatMembers) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRuleRestrictChatMembers) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRuleRestrictChatMembers"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"chat_ids":`)
+	writeList(encoder, entity.ChatIds, (*jsonEncoder).writeInt64)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRuleRestrictChatMembers) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "chat_ids":
+			entity.ChatIds, err = readList(decoder, (*jsonDecoder).readInt64)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRuleRestrictChatMembers)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A list of privacy rules. Rules are matched in the specified order. The first matched rule defines the privacy setting for a given user. If no rule matches, the action is not allowed
+type UserPrivacySettingRules struct {
+	meta
+	// A list of rules
+	Rules []UserPrivacySettingRule `json:"rules"`
+}
+
+func (*UserPrivacySettingRules) GetType() string {
+	return TypeUserPrivacySettingRules
+}
+
+func (*UserPrivacySettingRules) GetConstructor() string {
+	return ConstructorUserPrivacySettingRules
+}
+
+func (entity *UserPrivacySettingRules) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingRules) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingRules) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingRules"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"rules":`)
+	writeList(encoder, entity.Rules, writeTypeValue[UserPrivacySettingRule])
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingRules) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "rules":
+			entity.Rules, err = readList(decoder, decodeUserPrivacySettingRule)
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingRules)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// A privacy setting for managing whether the user's online status is visible
+type UserPrivacySettingShowStatus struct {
+	meta
+}
+
+func (*UserPrivacySettingShowStatus) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingShowStatus) GetConstructor() string {
+	return ConstructorUserPrivacySettingShowStatus
+}
+
+func (*UserPrivacySettingShowStatus) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingShowStatus
+}
+
+func (entity *UserPrivacySettingShowStatus) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingShowStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingShowStatus) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingShowStatus"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingShowStatus) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingShowStatus)
+	})
+}
+
+// A privacy setting for managing whether the user's profile photo is visible
+type UserPrivacySettingShowProfilePhoto struct {
+	meta
+}
+
+func (*UserPrivacySettingShowProfilePhoto) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingShowProfilePhoto) GetConstructor() string {
+	return ConstructorUserPrivacySettingShowProfilePhoto
+}
+
+func (*UserPrivacySettingShowProfilePhoto) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingShowProfilePhoto
+}
+
+func (entity *UserPrivacySettingShowProfilePhoto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingShowProfilePhoto) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingShowProfilePhoto) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingShowProfilePhoto"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingShowProfilePhoto) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingShowProfilePhoto)
+	})
+}
+
+// A privacy setting for managing whether a link to the user's account is included in forwarded messages
+type UserPrivacySettingShowLinkInForwardedMessages struct {
+	meta
+}
+
+func (*UserPrivacySettingShowLinkInForwardedMessages) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingShowLinkInForwardedMessages) GetConstructor() string {
+	return ConstructorUserPrivacySettingShowLinkInForwardedMessages
+}
+
+func (*UserPrivacySettingShowLinkInForwardedMessages) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingShowLinkInForwardedMessages
+}
+
+func (entity *UserPrivacySettingShowLinkInForwardedMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingShowLinkInForwardedMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingShowLinkInForwardedMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingShowLinkInForwardedMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingShowLinkInForwardedMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingShowLinkInForwardedMessages)
+	})
+}
+
+// A privacy setting for managing whether the user's phone number is visible
+type UserPrivacySettingShowPhoneNumber struct {
+	meta
+}
+
+func (*UserPrivacySettingShowPhoneNumber) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingShowPhoneNumber) GetConstructor() string {
+	return ConstructorUserPrivacySettingShowPhoneNumber
+}
+
+func (*UserPrivacySettingShowPhoneNumber) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingShowPhoneNumber
+}
+
+func (entity *UserPrivacySettingShowPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingShowPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingShowPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingShowPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingShowPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingShowPhoneNumber)
+	})
+}
+
+// A privacy setting for managing whether the user's bio is visible
+type UserPrivacySettingShowBio struct {
+	meta
+}
+
+func (*UserPrivacySettingShowBio) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingShowBio) GetConstructor() string {
+	return ConstructorUserPrivacySettingShowBio
+}
+
+func (*UserPrivacySettingShowBio) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingShowBio
+}
+
+func (entity *UserPrivacySettingShowBio) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingShowBio) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingShowBio) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingShowBio"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingShowBio) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingShowBio)
+	})
+}
+
+// A privacy setting for managing whether the user's birthdate is visible
+type UserPrivacySettingShowBirthdate struct {
+	meta
+}
+
+func (*UserPrivacySettingShowBirthdate) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingShowBirthdate) GetConstructor() string {
+	return ConstructorUserPrivacySettingShowBirthdate
+}
+
+func (*UserPrivacySettingShowBirthdate) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingShowBirthdate
+}
+
+func (entity *UserPrivacySettingShowBirthdate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingShowBirthdate) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingShowBirthdate) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingShowBirthdate"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingShowBirthdate) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingShowBirthdate)
+	})
+}
+
+// A privacy setting for managing whether the user can be invited to chats
+type UserPrivacySettingAllowChatInvites struct {
+	meta
+}
+
+func (*UserPrivacySettingAllowChatInvites) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingAllowChatInvites) GetConstructor() string {
+	return ConstructorUserPrivacySettingAllowChatInvites
+}
+
+func (*UserPrivacySettingAllowChatInvites) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingAllowChatInvites
+}
+
+func (entity *UserPrivacySettingAllowChatInvites) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingAllowChatInvites) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingAllowChatInvites) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingAllowChatInvites"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingAllowChatInvites) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingAllowChatInvites)
+	})
+}
+
+// A privacy setting for managing whether the user can be called
+type UserPrivacySettingAllowCalls struct {
+	meta
+}
+
+func (*UserPrivacySettingAllowCalls) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingAllowCalls) GetConstructor() string {
+	return ConstructorUserPrivacySettingAllowCalls
+}
+
+func (*UserPrivacySettingAllowCalls) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingAllowCalls
+}
+
+func (entity *UserPrivacySettingAllowCalls) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingAllowCalls) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingAllowCalls) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingAllowCalls"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingAllowCalls) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingAllowCalls)
+	})
+}
+
+// A privacy setting for managing whether peer-to-peer connections can be used for calls
+type UserPrivacySettingAllowPeerToPeerCalls struct {
+	meta
+}
+
+func (*UserPrivacySettingAllowPeerToPeerCalls) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingAllowPeerToPeerCalls) GetConstructor() string {
+	return ConstructorUserPrivacySettingAllowPeerToPeerCalls
+}
+
+func (*UserPrivacySettingAllowPeerToPeerCalls) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingAllowPeerToPeerCalls
+}
+
+func (entity *UserPrivacySettingAllowPeerToPeerCalls) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingAllowPeerToPeerCalls) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingAllowPeerToPeerCalls) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingAllowPeerToPeerCalls"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingAllowPeerToPeerCalls) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingAllowPeerToPeerCalls)
+	})
+}
+
+// A privacy setting for managing whether the user can be found by their phone number. Checked only if the phone number is not known to the other user. Can be set only to "Allow contacts" or "Allow all"
+type UserPrivacySettingAllowFindingByPhoneNumber struct {
+	meta
+}
+
+func (*UserPrivacySettingAllowFindingByPhoneNumber) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingAllowFindingByPhoneNumber) GetConstructor() string {
+	return ConstructorUserPrivacySettingAllowFindingByPhoneNumber
+}
+
+func (*UserPrivacySettingAllowFindingByPhoneNumber) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingAllowFindingByPhoneNumber
+}
+
+func (entity *UserPrivacySettingAllowFindingByPhoneNumber) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingAllowFindingByPhoneNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingAllowFindingByPhoneNumber) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingAllowFindingByPhoneNumber"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingAllowFindingByPhoneNumber) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingAllowFindingByPhoneNumber)
+	})
+}
+
+// A privacy setting for managing whether the user can receive voice and video messages in private chats; for Telegram Premium users only
+type UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages struct {
+	meta
+}
+
+func (*UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) GetConstructor() string {
+	return ConstructorUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages
+}
+
+func (*UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages
+}
+
+func (entity *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingAllowPrivateVoiceAndVideoNoteMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages)
+	})
+}
+
+// A privacy setting for managing whether received gifts are automatically shown on the user's profile page
+type UserPrivacySettingAutosaveGifts struct {
+	meta
+}
+
+func (*UserPrivacySettingAutosaveGifts) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingAutosaveGifts) GetConstructor() string {
+	return ConstructorUserPrivacySettingAutosaveGifts
+}
+
+func (*UserPrivacySettingAutosaveGifts) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingAutosaveGifts
+}
+
+func (entity *UserPrivacySettingAutosaveGifts) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingAutosaveGifts) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingAutosaveGifts) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingAutosaveGifts"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingAutosaveGifts) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingAutosaveGifts)
+	})
+}
+
+// A privacy setting for managing whether the user can receive messages without additional payment
+type UserPrivacySettingAllowUnpaidMessages struct {
+	meta
+}
+
+func (*UserPrivacySettingAllowUnpaidMessages) GetType() string {
+	return TypeUserPrivacySetting
+}
+
+func (*UserPrivacySettingAllowUnpaidMessages) GetConstructor() string {
+	return ConstructorUserPrivacySettingAllowUnpaidMessages
+}
+
+func (*UserPrivacySettingAllowUnpaidMessages) UserPrivacySettingConstructor() string {
+	return ConstructorUserPrivacySettingAllowUnpaidMessages
+}
+
+func (entity *UserPrivacySettingAllowUnpaidMessages) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserPrivacySettingAllowUnpaidMessages) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserPrivacySettingAllowUnpaidMessages) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userPrivacySettingAllowUnpaidMessages"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserPrivacySettingAllowUnpaidMessages) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		return decoder.readMeta(&entity.meta, key, ConstructorUserPrivacySettingAllowUnpaidMessages)
+	})
+}
+
+// Contains privacy settings for message read date in private chats. Read dates are always shown to the users that can see online status of the current user regardless of this setting
+type ReadDatePrivacySettings struct {
+	meta
+	// True, if message read date is shown to other users in private chats. If false and the current user isn't a Telegram Premium user, then they will not be able to see other's message read date
+	ShowReadDate bool `json:"show_read_date"`
+}
+
+func (*ReadDatePrivacySettings) GetType() string {
+	return TypeReadDatePrivacySettings
+}
+
+func (*ReadDatePrivacySettings) GetConstructor() string {
+	return ConstructorReadDatePrivacySettings
+}
+
+func (entity *ReadDatePrivacySettings) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *ReadDatePrivacySettings) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *ReadDatePrivacySettings) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"readDatePrivacySettings"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"show_read_date":`)
+	encoder.writeBool(entity.ShowReadDate)
+	encoder.writeRaw("}")
+}
+
+func (entity *ReadDatePrivacySettings) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "show_read_date":
+			entity.ShowReadDate, err = decoder.readBool()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorReadDatePrivacySettings)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
+
+// Contains custom information about the user
+type UserSupportInfo struct {
+	meta
+	// Information message
+	Message *FormattedText `json:"message"`
+	// Information author
+	Author string `json:"author"`
+	// Information change date
+	Date int32 `json:"date"`
+}
+
+func (*UserSupportInfo) GetType() string {
+	return TypeUserSupportInfo
+}
+
+func (*UserSupportInfo) GetConstructor() string {
+	return ConstructorUserSupportInfo
+}
+
+func (entity *UserSupportInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(entity)
+}
+
+func (entity *UserSupportInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalObject(data, entity.decodeJSON)
+}
+
+func (entity *UserSupportInfo) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	encoder.writeRaw(`{"@type":"userSupportInfo"`)
+	encoder.writeMeta(&entity.meta)
+	encoder.writeRaw(`,"message":`)
+	entity.Message.encodeJSON(encoder)
+	encoder.writeRaw(`,"author":`)
+	encoder.writeString(entity.Author)
+	encoder.writeRaw(`,"date":`)
+	encoder.writeInt32(entity.Date)
+	encoder.writeRaw("}")
+}
+
+func (entity *UserSupportInfo) decodeJSON(decoder *jsonDecoder) error {
+	return decoder.readObject(func(key []byte) error {
+		var err error
+		switch string(key) {
+		case "message":
+			entity.Message, err = decodeEntity[FormattedText](decoder)
+
+		case "author":
+			entity.Author, err = decoder.readString()
+
+		case "date":
+			entity.Date, err = decoder.readInt32()
+
+		default:
+			err = decoder.readMeta(&entity.meta, key, ConstructorUserSupportInfo)
+		}
+		if err != nil {
+			return wrapUnmarshalError(string(key), err)
+		}
+
+		return nil
+	})
+}
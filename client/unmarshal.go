@@ -0,0 +1,225 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var ErrUnknownConstructor = errors.New("unknown constructor")
+
+// UnknownType holds an object of a constructor which is unknown to this version of the library, e.g. an update from a newer TDLib.
+// It implements every class interface, so it can be stored in any field.
+type UnknownType struct {
+	Constructor string
+	Data        json.RawMessage
+}
+
+// GetType returns an empty string: the class of an unknown constructor is not known
+func (*UnknownType) GetType() string {
+	return ""
+}
+
+func (entity *UnknownType) GetConstructor() string {
+	return entity.Constructor
+}
+
+func (entity *UnknownType) MarshalJSON() ([]byte, error) {
+	if len(entity.Data) == 0 {
+		return json.Marshal(map[string]string{"@type": entity.Constructor})
+	}
+
+	return entity.Data, nil
+}
+
+func (entity *UnknownType) encodeJSON(encoder *jsonEncoder) {
+	if entity == nil {
+		encoder.writeRaw("null")
+		return
+	}
+
+	if len(entity.Data) == 0 {
+		encoder.writeRaw(`{"@type":`)
+		encoder.writeString(entity.Constructor)
+		encoder.writeRaw("}")
+		return
+	}
+
+	encoder.writeJSON(entity.Data)
+}
+
+// UnmarshalPathError is an unmarshalling error with the JSON path of the failed field
+type UnmarshalPathError struct {
+	Path string
+	Err  error
+}
+
+func (err *UnmarshalPathError) Error() string {
+	if err.Path == "" {
+		return err.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", err.Path, err.Err)
+}
+
+func (err *UnmarshalPathError) Unwrap() error {
+	return err.Err
+}
+
+// WithStrictUnmarshal makes the client reject results with constructors unknown to this version of the library.
+// By default they are passed to the result handler as UnknownType.
+func WithStrictUnmarshal() Option {
+	return func(client *Client) {
+		client.strictUnmarshal = true
+	}
+}
+
+// WithUnmarshalErrorHandler sets the handler of results which can't be unmarshalled. By default the results are dropped
+func WithUnmarshalErrorHandler(handler func(data json.RawMessage, err error)) Option {
+	return func(client *Client) {
+		client.unmarshalErrorHandler = handler
+	}
+}
+
+func (client *Client) unmarshalType(response *Response) (Type, error) {
+	typ, err := unmarshalResult(response, UnmarshalType)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.strictUnmarshal {
+		err = findUnknownType(reflect.ValueOf(typ), "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return typ, nil
+}
+
+// unmarshalResult returns the value decoded by the receiver or unmarshals the data if the response wasn't decoded as T,
+// e.g. a response made by a test or a result with an unknown constructor
+func unmarshalResult[T any](result *Response, unmarshal func(data json.RawMessage) (T, error)) (T, error) {
+	value, ok := result.Type.(T)
+	if ok {
+		return value, nil
+	}
+
+	return unmarshal(result.Data)
+}
+
+// UnmarshalTypeStrict is UnmarshalType which fails on constructors unknown to this version of the library instead of returning UnknownType
+func UnmarshalTypeStrict(data json.RawMessage) (Type, error) {
+	typ, err := UnmarshalType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = findUnknownType(reflect.ValueOf(typ), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return typ, nil
+}
+
+func findUnknownType(value reflect.Value, path string) error {
+	switch value.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if value.IsNil() {
+			return nil
+		}
+
+		unknown, ok := value.Interface().(*UnknownType)
+		if ok {
+			return &UnmarshalPathError{Path: path, Err: fmt.Errorf("%w: %s", ErrUnknownConstructor, unknown.Constructor)}
+		}
+
+		return findUnknownType(value.Elem(), path)
+
+	case reflect.Struct:
+		typ := value.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() || field.Anonymous {
+				continue
+			}
+
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			err := findUnknownType(value.Field(i), joinUnmarshalPath(path, name))
+			if err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+
+		for i := 0; i < value.Len(); i++ {
+			err := findUnknownType(value.Index(i), joinUnmarshalPath(path, "["+strconv.Itoa(i)+"]"))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// wrapUnmarshalError prefixes the path of the error with the field name or the list index
+func wrapUnmarshalError(field string, err error) error {
+	var pathErr *UnmarshalPathError
+	if errors.As(err, &pathErr) {
+		return &UnmarshalPathError{Path: joinUnmarshalPath(field, pathErr.Path), Err: pathErr.Err}
+	}
+
+	return &UnmarshalPathError{Path: field, Err: err}
+}
+
+func joinUnmarshalPath(path string, field string) string {
+	if path == "" || strings.HasPrefix(field, "[") {
+		return path + field
+	}
+	if field == "" {
+		return path
+	}
+
+	return path + "." + field
+}
+
+func isNull(data json.RawMessage) bool {
+	return len(data) == 0 || string(data) == "null"
+}
+
+// unmarshalListOf makes an unmarshaler of a JSON array from the unmarshaler of its elements
+func unmarshalListOf[T any](unmarshal func(data json.RawMessage) (T, error)) func(data json.RawMessage) ([]T, error) {
+	return func(data json.RawMessage) ([]T, error) {
+		var dataList []json.RawMessage
+		err := json.Unmarshal(data, &dataList)
+		if err != nil {
+			return nil, err
+		}
+
+		list := make([]T, 0, len(dataList))
+		for i, data := range dataList {
+			entity, err := unmarshal(data)
+			if err != nil {
+				return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+			}
+			list = append(list, entity)
+		}
+
+		return list, nil
+	}
+}
+
+func unmarshalValue[T any](data json.RawMessage) (T, error) {
+	var value T
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
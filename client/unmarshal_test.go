@@ -0,0 +1,119 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestUnmarshalUnknownType(t *testing.T) {
+	data := json.RawMessage(`{"@type":"updateNewMessage","message":{"@type":"message","id":1,"content":{"@type":"messageFromTheFuture","value":1}}}`)
+
+	typ, err := UnmarshalType(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	content, ok := typ.(*UpdateNewMessage).Message.Content.(*UnknownType)
+	if !ok {
+		t.Fatalf("content = %T, want *UnknownType", typ.(*UpdateNewMessage).Message.Content)
+	}
+	if content.Constructor != "messageFromTheFuture" {
+		t.Errorf("constructor = %s", content.Constructor)
+	}
+
+	marshaled, err := json.Marshal(content)
+	if err != nil {
+		t.Fatalf("marshal error: %s", err)
+	}
+	if string(marshaled) != `{"@type":"messageFromTheFuture","value":1}` {
+		t.Errorf("marshaled = %s", marshaled)
+	}
+
+	_, err = UnmarshalTypeStrict(data)
+	var pathErr *UnmarshalPathError
+	if !errors.As(err, &pathErr) || !errors.Is(err, ErrUnknownConstructor) {
+		t.Fatalf("strict error = %v, want unknown constructor", err)
+	}
+	if pathErr.Path != "message.content" {
+		t.Errorf("path = %s, want message.content", pathErr.Path)
+	}
+}
+
+func TestUnmarshalNestedError(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		path string
+	}{
+		{
+			name: "field",
+			data: `{"@type":"message","content":{"@type":"messageText","text":{"@type":"formattedText","text":1}}}`,
+			path: "content.text.text",
+		},
+		{
+			name: "list item",
+			data: `{"@type":"chatAvailableReactionsSome","reactions":[{"@type":"reactionTypeEmoji","emoji":"👍"},{"@type":"reactionTypeEmoji","emoji":1}]}`,
+			path: "reactions[1].emoji",
+		},
+		{
+			name: "nested constructor",
+			data: `{"@type":"updateNewMessage","message":{"@type":"message","id":1,"content":{"@type":"messageText","text":{"@type":"formattedText","text":"a","entities":[{"@type":"textEntity","offset":"0"}]}}}}`,
+			path: "message.content.text.entities[0].offset",
+		},
+		{
+			name: "nested scalar",
+			data: `{"@type":"updateNewMessage","message":{"@type":"message","id":1,"content":{"@type":"messageText","text":{"@type":"formattedText","text":1}}}}`,
+			path: "message.content.text.text",
+		},
+		{
+			name: "unknown top level",
+			data: `{"@type":"updateFromTheFuture"}`,
+			path: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ, err := UnmarshalType(json.RawMessage(tt.data))
+			if tt.path == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s (%T)", err, typ)
+				}
+				return
+			}
+
+			_, strictErr := UnmarshalTypeStrict(json.RawMessage(tt.data))
+			for _, err := range []error{err, strictErr} {
+				var pathErr *UnmarshalPathError
+				if !errors.As(err, &pathErr) {
+					t.Fatalf("error = %v, want *UnmarshalPathError", err)
+				}
+				if pathErr.Path != tt.path {
+					t.Errorf("path = %s, want %s", pathErr.Path, tt.path)
+				}
+			}
+		})
+	}
+}
+
+func TestUnmarshalListOf(t *testing.T) {
+	numbers, err := unmarshalListOf(unmarshalValue[int32])(json.RawMessage(`[1,2,3]`))
+	if err != nil || len(numbers) != 3 || numbers[2] != 3 {
+		t.Errorf("numbers = %v, error = %v", numbers, err)
+	}
+
+	reactions, err := unmarshalListOf(unmarshalListOf(UnmarshalReactionType))(json.RawMessage(`[[{"@type":"reactionTypePaid"}],[]]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(reactions) != 2 || reactions[0][0].ReactionTypeConstructor() != ConstructorReactionTypePaid {
+		t.Errorf("reactions = %v", reactions)
+	}
+
+	_, err = unmarshalListOf(unmarshalListOf(unmarshalValue[int32]))(json.RawMessage(`[[1],[2,"3"]]`))
+	var pathErr *UnmarshalPathError
+	if !errors.As(err, &pathErr) || pathErr.Path != "[1][1]" {
+		t.Errorf("error = %v, want path [1][1]", err)
+	}
+}
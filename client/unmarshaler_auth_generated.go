@@ -0,0 +1,1510 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalAuthenticationCodeType(data json.RawMessage) (AuthenticationCodeType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeAuthenticationCodeType)
+}
+
+func decodeAuthenticationCodeType(decoder *jsonDecoder) (AuthenticationCodeType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorAuthenticationCodeTypeTelegramMessage:
+		return decodeEntity[AuthenticationCodeTypeTelegramMessage](decoder)
+
+	case ConstructorAuthenticationCodeTypeSms:
+		return decodeEntity[AuthenticationCodeTypeSms](decoder)
+
+	case ConstructorAuthenticationCodeTypeSmsWord:
+		return decodeEntity[AuthenticationCodeTypeSmsWord](decoder)
+
+	case ConstructorAuthenticationCodeTypeSmsPhrase:
+		return decodeEntity[AuthenticationCodeTypeSmsPhrase](decoder)
+
+	case ConstructorAuthenticationCodeTypeCall:
+		return decodeEntity[AuthenticationCodeTypeCall](decoder)
+
+	case ConstructorAuthenticationCodeTypeFlashCall:
+		return decodeEntity[AuthenticationCodeTypeFlashCall](decoder)
+
+	case ConstructorAuthenticationCodeTypeMissedCall:
+		return decodeEntity[AuthenticationCodeTypeMissedCall](decoder)
+
+	case ConstructorAuthenticationCodeTypeFragment:
+		return decodeEntity[AuthenticationCodeTypeFragment](decoder)
+
+	case ConstructorAuthenticationCodeTypeFirebaseAndroid:
+		return decodeEntity[AuthenticationCodeTypeFirebaseAndroid](decoder)
+
+	case ConstructorAuthenticationCodeTypeFirebaseIos:
+		return decodeEntity[AuthenticationCodeTypeFirebaseIos](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfAuthenticationCodeType(dataList []json.RawMessage) ([]AuthenticationCodeType, error) {
+	list := make([]AuthenticationCodeType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalAuthenticationCodeType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalEmailAddressAuthentication(data json.RawMessage) (EmailAddressAuthentication, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeEmailAddressAuthentication)
+}
+
+func decodeEmailAddressAuthentication(decoder *jsonDecoder) (EmailAddressAuthentication, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorEmailAddressAuthenticationCode:
+		return decodeEntity[EmailAddressAuthenticationCode](decoder)
+
+	case ConstructorEmailAddressAuthenticationAppleId:
+		return decodeEntity[EmailAddressAuthenticationAppleId](decoder)
+
+	case ConstructorEmailAddressAuthenticationGoogleId:
+		return decodeEntity[EmailAddressAuthenticationGoogleId](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfEmailAddressAuthentication(dataList []json.RawMessage) ([]EmailAddressAuthentication, error) {
+	list := make([]EmailAddressAuthentication, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalEmailAddressAuthentication(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalAuthorizationState(data json.RawMessage) (AuthorizationState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeAuthorizationState)
+}
+
+func decodeAuthorizationState(decoder *jsonDecoder) (AuthorizationState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorAuthorizationStateWaitTdlibParameters:
+		return decodeEntity[AuthorizationStateWaitTdlibParameters](decoder)
+
+	case ConstructorAuthorizationStateWaitPhoneNumber:
+		return decodeEntity[AuthorizationStateWaitPhoneNumber](decoder)
+
+	case ConstructorAuthorizationStateWaitPremiumPurchase:
+		return decodeEntity[AuthorizationStateWaitPremiumPurchase](decoder)
+
+	case ConstructorAuthorizationStateWaitEmailAddress:
+		return decodeEntity[AuthorizationStateWaitEmailAddress](decoder)
+
+	case ConstructorAuthorizationStateWaitEmailCode:
+		return decodeEntity[AuthorizationStateWaitEmailCode](decoder)
+
+	case ConstructorAuthorizationStateWaitCode:
+		return decodeEntity[AuthorizationStateWaitCode](decoder)
+
+	case ConstructorAuthorizationStateWaitOtherDeviceConfirmation:
+		return decodeEntity[AuthorizationStateWaitOtherDeviceConfirmation](decoder)
+
+	case ConstructorAuthorizationStateWaitRegistration:
+		return decodeEntity[AuthorizationStateWaitRegistration](decoder)
+
+	case ConstructorAuthorizationStateWaitPassword:
+		return decodeEntity[AuthorizationStateWaitPassword](decoder)
+
+	case ConstructorAuthorizationStateReady:
+		return decodeEntity[AuthorizationStateReady](decoder)
+
+	case ConstructorAuthorizationStateLoggingOut:
+		return decodeEntity[AuthorizationStateLoggingOut](decoder)
+
+	case ConstructorAuthorizationStateClosing:
+		return decodeEntity[AuthorizationStateClosing](decoder)
+
+	case ConstructorAuthorizationStateClosed:
+		return decodeEntity[AuthorizationStateClosed](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfAuthorizationState(dataList []json.RawMessage) ([]AuthorizationState, error) {
+	list := make([]AuthorizationState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalAuthorizationState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalLoginUrlInfo(data json.RawMessage) (LoginUrlInfo, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeLoginUrlInfo)
+}
+
+func decodeLoginUrlInfo(decoder *jsonDecoder) (LoginUrlInfo, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorLoginUrlInfoOpen:
+		return decodeEntity[LoginUrlInfoOpen](decoder)
+
+	case ConstructorLoginUrlInfoRequestConfirmation:
+		return decodeEntity[LoginUrlInfoRequestConfirmation](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfLoginUrlInfo(dataList []json.RawMessage) ([]LoginUrlInfo, error) {
+	list := make([]LoginUrlInfo, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalLoginUrlInfo(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPassportElementType(data json.RawMessage) (PassportElementType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePassportElementType)
+}
+
+func decodePassportElementType(decoder *jsonDecoder) (PassportElementType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPassportElementTypePersonalDetails:
+		return decodeEntity[PassportElementTypePersonalDetails](decoder)
+
+	case ConstructorPassportElementTypePassport:
+		return decodeEntity[PassportElementTypePassport](decoder)
+
+	case ConstructorPassportElementTypeDriverLicense:
+		return decodeEntity[PassportElementTypeDriverLicense](decoder)
+
+	case ConstructorPassportElementTypeIdentityCard:
+		return decodeEntity[PassportElementTypeIdentityCard](decoder)
+
+	case ConstructorPassportElementTypeInternalPassport:
+		return decodeEntity[PassportElementTypeInternalPassport](decoder)
+
+	case ConstructorPassportElementTypeAddress:
+		return decodeEntity[PassportElementTypeAddress](decoder)
+
+	case ConstructorPassportElementTypeUtilityBill:
+		return decodeEntity[PassportElementTypeUtilityBill](decoder)
+
+	case ConstructorPassportElementTypeBankStatement:
+		return decodeEntity[PassportElementTypeBankStatement](decoder)
+
+	case ConstructorPassportElementTypeRentalAgreement:
+		return decodeEntity[PassportElementTypeRentalAgreement](decoder)
+
+	case ConstructorPassportElementTypePassportRegistration:
+		return decodeEntity[PassportElementTypePassportRegistration](decoder)
+
+	case ConstructorPassportElementTypeTemporaryRegistration:
+		return decodeEntity[PassportElementTypeTemporaryRegistration](decoder)
+
+	case ConstructorPassportElementTypePhoneNumber:
+		return decodeEntity[PassportElementTypePhoneNumber](decoder)
+
+	case ConstructorPassportElementTypeEmailAddress:
+		return decodeEntity[PassportElementTypeEmailAddress](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPassportElementType(dataList []json.RawMessage) ([]PassportElementType, error) {
+	list := make([]PassportElementType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPassportElementType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPassportElement(data json.RawMessage) (PassportElement, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePassportElement)
+}
+
+func decodePassportElement(decoder *jsonDecoder) (PassportElement, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPassportElementPersonalDetails:
+		return decodeEntity[PassportElementPersonalDetails](decoder)
+
+	case ConstructorPassportElementPassport:
+		return decodeEntity[PassportElementPassport](decoder)
+
+	case ConstructorPassportElementDriverLicense:
+		return decodeEntity[PassportElementDriverLicense](decoder)
+
+	case ConstructorPassportElementIdentityCard:
+		return decodeEntity[PassportElementIdentityCard](decoder)
+
+	case ConstructorPassportElementInternalPassport:
+		return decodeEntity[PassportElementInternalPassport](decoder)
+
+	case ConstructorPassportElementAddress:
+		return decodeEntity[PassportElementAddress](decoder)
+
+	case ConstructorPassportElementUtilityBill:
+		return decodeEntity[PassportElementUtilityBill](decoder)
+
+	case ConstructorPassportElementBankStatement:
+		return decodeEntity[PassportElementBankStatement](decoder)
+
+	case ConstructorPassportElementRentalAgreement:
+		return decodeEntity[PassportElementRentalAgreement](decoder)
+
+	case ConstructorPassportElementPassportRegistration:
+		return decodeEntity[PassportElementPassportRegistration](decoder)
+
+	case ConstructorPassportElementTemporaryRegistration:
+		return decodeEntity[PassportElementTemporaryRegistration](decoder)
+
+	case ConstructorPassportElementPhoneNumber:
+		return decodeEntity[PassportElementPhoneNumber](decoder)
+
+	case ConstructorPassportElementEmailAddress:
+		return decodeEntity[PassportElementEmailAddress](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPassportElement(dataList []json.RawMessage) ([]PassportElement, error) {
+	list := make([]PassportElement, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPassportElement(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputPassportElement(data json.RawMessage) (InputPassportElement, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputPassportElement)
+}
+
+func decodeInputPassportElement(decoder *jsonDecoder) (InputPassportElement, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputPassportElementPersonalDetails:
+		return decodeEntity[InputPassportElementPersonalDetails](decoder)
+
+	case ConstructorInputPassportElementPassport:
+		return decodeEntity[InputPassportElementPassport](decoder)
+
+	case ConstructorInputPassportElementDriverLicense:
+		return decodeEntity[InputPassportElementDriverLicense](decoder)
+
+	case ConstructorInputPassportElementIdentityCard:
+		return decodeEntity[InputPassportElementIdentityCard](decoder)
+
+	case ConstructorInputPassportElementInternalPassport:
+		return decodeEntity[InputPassportElementInternalPassport](decoder)
+
+	case ConstructorInputPassportElementAddress:
+		return decodeEntity[InputPassportElementAddress](decoder)
+
+	case ConstructorInputPassportElementUtilityBill:
+		return decodeEntity[InputPassportElementUtilityBill](decoder)
+
+	case ConstructorInputPassportElementBankStatement:
+		return decodeEntity[InputPassportElementBankStatement](decoder)
+
+	case ConstructorInputPassportElementRentalAgreement:
+		return decodeEntity[InputPassportElementRentalAgreement](decoder)
+
+	case ConstructorInputPassportElementPassportRegistration:
+		return decodeEntity[InputPassportElementPassportRegistration](decoder)
+
+	case ConstructorInputPassportElementTemporaryRegistration:
+		return decodeEntity[InputPassportElementTemporaryRegistration](decoder)
+
+	case ConstructorInputPassportElementPhoneNumber:
+		return decodeEntity[InputPassportElementPhoneNumber](decoder)
+
+	case ConstructorInputPassportElementEmailAddress:
+		return decodeEntity[InputPassportElementEmailAddress](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputPassportElement(dataList []json.RawMessage) ([]InputPassportElement, error) {
+	list := make([]InputPassportElement, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputPassportElement(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPassportElementErrorSource(data json.RawMessage) (PassportElementErrorSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePassportElementErrorSource)
+}
+
+func decodePassportElementErrorSource(decoder *jsonDecoder) (PassportElementErrorSource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPassportElementErrorSourceUnspecified:
+		return decodeEntity[PassportElementErrorSourceUnspecified](decoder)
+
+	case ConstructorPassportElementErrorSourceDataField:
+		return decodeEntity[PassportElementErrorSourceDataField](decoder)
+
+	case ConstructorPassportElementErrorSourceFrontSide:
+		return decodeEntity[PassportElementErrorSourceFrontSide](decoder)
+
+	case ConstructorPassportElementErrorSourceReverseSide:
+		return decodeEntity[PassportElementErrorSourceReverseSide](decoder)
+
+	case ConstructorPassportElementErrorSourceSelfie:
+		return decodeEntity[PassportElementErrorSourceSelfie](decoder)
+
+	case ConstructorPassportElementErrorSourceTranslationFile:
+		return decodeEntity[PassportElementErrorSourceTranslationFile](decoder)
+
+	case ConstructorPassportElementErrorSourceTranslationFiles:
+		return decodeEntity[PassportElementErrorSourceTranslationFiles](decoder)
+
+	case ConstructorPassportElementErrorSourceFile:
+		return decodeEntity[PassportElementErrorSourceFile](decoder)
+
+	case ConstructorPassportElementErrorSourceFiles:
+		return decodeEntity[PassportElementErrorSourceFiles](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPassportElementErrorSource(dataList []json.RawMessage) ([]PassportElementErrorSource, error) {
+	list := make([]PassportElementErrorSource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPassportElementErrorSource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputPassportElementErrorSource(data json.RawMessage) (InputPassportElementErrorSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputPassportElementErrorSource)
+}
+
+func decodeInputPassportElementErrorSource(decoder *jsonDecoder) (InputPassportElementErrorSource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputPassportElementErrorSourceUnspecified:
+		return decodeEntity[InputPassportElementErrorSourceUnspecified](decoder)
+
+	case ConstructorInputPassportElementErrorSourceDataField:
+		return decodeEntity[InputPassportElementErrorSourceDataField](decoder)
+
+	case ConstructorInputPassportElementErrorSourceFrontSide:
+		return decodeEntity[InputPassportElementErrorSourceFrontSide](decoder)
+
+	case ConstructorInputPassportElementErrorSourceReverseSide:
+		return decodeEntity[InputPassportElementErrorSourceReverseSide](decoder)
+
+	case ConstructorInputPassportElementErrorSourceSelfie:
+		return decodeEntity[InputPassportElementErrorSourceSelfie](decoder)
+
+	case ConstructorInputPassportElementErrorSourceTranslationFile:
+		return decodeEntity[InputPassportElementErrorSourceTranslationFile](decoder)
+
+	case ConstructorInputPassportElementErrorSourceTranslationFiles:
+		return decodeEntity[InputPassportElementErrorSourceTranslationFiles](decoder)
+
+	case ConstructorInputPassportElementErrorSourceFile:
+		return decodeEntity[InputPassportElementErrorSourceFile](decoder)
+
+	case ConstructorInputPassportElementErrorSourceFiles:
+		return decodeEntity[InputPassportElementErrorSourceFiles](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputPassportElementErrorSource(dataList []json.RawMessage) ([]InputPassportElementErrorSource, error) {
+	list := make([]InputPassportElementErrorSource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputPassportElementErrorSource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalFirebaseAuthenticationSettings(data json.RawMessage) (FirebaseAuthenticationSettings, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeFirebaseAuthenticationSettings)
+}
+
+func decodeFirebaseAuthenticationSettings(decoder *jsonDecoder) (FirebaseAuthenticationSettings, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorFirebaseAuthenticationSettingsAndroid:
+		return decodeEntity[FirebaseAuthenticationSettingsAndroid](decoder)
+
+	case ConstructorFirebaseAuthenticationSettingsIos:
+		return decodeEntity[FirebaseAuthenticationSettingsIos](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfFirebaseAuthenticationSettings(dataList []json.RawMessage) ([]FirebaseAuthenticationSettings, error) {
+	list := make([]FirebaseAuthenticationSettings, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalFirebaseAuthenticationSettings(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalResetPasswordResult(data json.RawMessage) (ResetPasswordResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeResetPasswordResult)
+}
+
+func decodeResetPasswordResult(decoder *jsonDecoder) (ResetPasswordResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorResetPasswordResultOk:
+		return decodeEntity[ResetPasswordResultOk](decoder)
+
+	case ConstructorResetPasswordResultPending:
+		return decodeEntity[ResetPasswordResultPending](decoder)
+
+	case ConstructorResetPasswordResultDeclined:
+		return decodeEntity[ResetPasswordResultDeclined](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfResetPasswordResult(dataList []json.RawMessage) ([]ResetPasswordResult, error) {
+	list := make([]ResetPasswordResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalResetPasswordResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSessionType(data json.RawMessage) (SessionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSessionType)
+}
+
+func decodeSessionType(decoder *jsonDecoder) (SessionType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSessionTypeAndroid:
+		return decodeEntity[SessionTypeAndroid](decoder)
+
+	case ConstructorSessionTypeApple:
+		return decodeEntity[SessionTypeApple](decoder)
+
+	case ConstructorSessionTypeBrave:
+		return decodeEntity[SessionTypeBrave](decoder)
+
+	case ConstructorSessionTypeChrome:
+		return decodeEntity[SessionTypeChrome](decoder)
+
+	case ConstructorSessionTypeEdge:
+		return decodeEntity[SessionTypeEdge](decoder)
+
+	case ConstructorSessionTypeFirefox:
+		return decodeEntity[SessionTypeFirefox](decoder)
+
+	case ConstructorSessionTypeIpad:
+		return decodeEntity[SessionTypeIpad](decoder)
+
+	case ConstructorSessionTypeIphone:
+		return decodeEntity[SessionTypeIphone](decoder)
+
+	case ConstructorSessionTypeLinux:
+		return decodeEntity[SessionTypeLinux](decoder)
+
+	case ConstructorSessionTypeMac:
+		return decodeEntity[SessionTypeMac](decoder)
+
+	case ConstructorSessionTypeOpera:
+		return decodeEntity[SessionTypeOpera](decoder)
+
+	case ConstructorSessionTypeSafari:
+		return decodeEntity[SessionTypeSafari](decoder)
+
+	case ConstructorSessionTypeUbuntu:
+		return decodeEntity[SessionTypeUbuntu](decoder)
+
+	case ConstructorSessionTypeUnknown:
+		return decodeEntity[SessionTypeUnknown](decoder)
+
+	case ConstructorSessionTypeVivaldi:
+		return decodeEntity[SessionTypeVivaldi](decoder)
+
+	case ConstructorSessionTypeWindows:
+		return decodeEntity[SessionTypeWindows](decoder)
+
+	case ConstructorSessionTypeXbox:
+		return decodeEntity[SessionTypeXbox](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSessionType(dataList []json.RawMessage) ([]SessionType, error) {
+	list := make([]SessionType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSessionType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalAuthenticationCodeTypeTelegramMessage(data json.RawMessage) (*AuthenticationCodeTypeTelegramMessage, error) {
+	var resp AuthenticationCodeTypeTelegramMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeSms(data json.RawMessage) (*AuthenticationCodeTypeSms, error) {
+	var resp AuthenticationCodeTypeSms
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeSmsWord(data json.RawMessage) (*AuthenticationCodeTypeSmsWord, error) {
+	var resp AuthenticationCodeTypeSmsWord
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeSmsPhrase(data json.RawMessage) (*AuthenticationCodeTypeSmsPhrase, error) {
+	var resp AuthenticationCodeTypeSmsPhrase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeCall(data json.RawMessage) (*AuthenticationCodeTypeCall, error) {
+	var resp AuthenticationCodeTypeCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeFlashCall(data json.RawMessage) (*AuthenticationCodeTypeFlashCall, error) {
+	var resp AuthenticationCodeTypeFlashCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeMissedCall(data json.RawMessage) (*AuthenticationCodeTypeMissedCall, error) {
+	var resp AuthenticationCodeTypeMissedCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeFragment(data json.RawMessage) (*AuthenticationCodeTypeFragment, error) {
+	var resp AuthenticationCodeTypeFragment
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeFirebaseAndroid(data json.RawMessage) (*AuthenticationCodeTypeFirebaseAndroid, error) {
+	var resp AuthenticationCodeTypeFirebaseAndroid
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeTypeFirebaseIos(data json.RawMessage) (*AuthenticationCodeTypeFirebaseIos, error) {
+	var resp AuthenticationCodeTypeFirebaseIos
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthenticationCodeInfo(data json.RawMessage) (*AuthenticationCodeInfo, error) {
+	var resp AuthenticationCodeInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmailAddressAuthenticationCodeInfo(data json.RawMessage) (*EmailAddressAuthenticationCodeInfo, error) {
+	var resp EmailAddressAuthenticationCodeInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmailAddressAuthenticationCode(data json.RawMessage) (*EmailAddressAuthenticationCode, error) {
+	var resp EmailAddressAuthenticationCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmailAddressAuthenticationAppleId(data json.RawMessage) (*EmailAddressAuthenticationAppleId, error) {
+	var resp EmailAddressAuthenticationAppleId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmailAddressAuthenticationGoogleId(data json.RawMessage) (*EmailAddressAuthenticationGoogleId, error) {
+	var resp EmailAddressAuthenticationGoogleId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitTdlibParameters(data json.RawMessage) (*AuthorizationStateWaitTdlibParameters, error) {
+	var resp AuthorizationStateWaitTdlibParameters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitPhoneNumber(data json.RawMessage) (*AuthorizationStateWaitPhoneNumber, error) {
+	var resp AuthorizationStateWaitPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitPremiumPurchase(data json.RawMessage) (*AuthorizationStateWaitPremiumPurchase, error) {
+	var resp AuthorizationStateWaitPremiumPurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitEmailAddress(data json.RawMessage) (*AuthorizationStateWaitEmailAddress, error) {
+	var resp AuthorizationStateWaitEmailAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitEmailCode(data json.RawMessage) (*AuthorizationStateWaitEmailCode, error) {
+	var resp AuthorizationStateWaitEmailCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitCode(data json.RawMessage) (*AuthorizationStateWaitCode, error) {
+	var resp AuthorizationStateWaitCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitOtherDeviceConfirmation(data json.RawMessage) (*AuthorizationStateWaitOtherDeviceConfirmation, error) {
+	var resp AuthorizationStateWaitOtherDeviceConfirmation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitRegistration(data json.RawMessage) (*AuthorizationStateWaitRegistration, error) {
+	var resp AuthorizationStateWaitRegistration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateWaitPassword(data json.RawMessage) (*AuthorizationStateWaitPassword, error) {
+	var resp AuthorizationStateWaitPassword
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateReady(data json.RawMessage) (*AuthorizationStateReady, error) {
+	var resp AuthorizationStateReady
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateLoggingOut(data json.RawMessage) (*AuthorizationStateLoggingOut, error) {
+	var resp AuthorizationStateLoggingOut
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateClosing(data json.RawMessage) (*AuthorizationStateClosing, error) {
+	var resp AuthorizationStateClosing
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAuthorizationStateClosed(data json.RawMessage) (*AuthorizationStateClosed, error) {
+	var resp AuthorizationStateClosed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPasswordState(data json.RawMessage) (*PasswordState, error) {
+	var resp PasswordState
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTemporaryPasswordState(data json.RawMessage) (*TemporaryPasswordState, error) {
+	var resp TemporaryPasswordState
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLoginUrlInfoOpen(data json.RawMessage) (*LoginUrlInfoOpen, error) {
+	var resp LoginUrlInfoOpen
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLoginUrlInfoRequestConfirmation(data json.RawMessage) (*LoginUrlInfoRequestConfirmation, error) {
+	var resp LoginUrlInfoRequestConfirmation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypePersonalDetails(data json.RawMessage) (*PassportElementTypePersonalDetails, error) {
+	var resp PassportElementTypePersonalDetails
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypePassport(data json.RawMessage) (*PassportElementTypePassport, error) {
+	var resp PassportElementTypePassport
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeDriverLicense(data json.RawMessage) (*PassportElementTypeDriverLicense, error) {
+	var resp PassportElementTypeDriverLicense
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeIdentityCard(data json.RawMessage) (*PassportElementTypeIdentityCard, error) {
+	var resp PassportElementTypeIdentityCard
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeInternalPassport(data json.RawMessage) (*PassportElementTypeInternalPassport, error) {
+	var resp PassportElementTypeInternalPassport
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeAddress(data json.RawMessage) (*PassportElementTypeAddress, error) {
+	var resp PassportElementTypeAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeUtilityBill(data json.RawMessage) (*PassportElementTypeUtilityBill, error) {
+	var resp PassportElementTypeUtilityBill
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeBankStatement(data json.RawMessage) (*PassportElementTypeBankStatement, error) {
+	var resp PassportElementTypeBankStatement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeRentalAgreement(data json.RawMessage) (*PassportElementTypeRentalAgreement, error) {
+	var resp PassportElementTypeRentalAgreement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypePassportRegistration(data json.RawMessage) (*PassportElementTypePassportRegistration, error) {
+	var resp PassportElementTypePassportRegistration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeTemporaryRegistration(data json.RawMessage) (*PassportElementTypeTemporaryRegistration, error) {
+	var resp PassportElementTypeTemporaryRegistration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypePhoneNumber(data json.RawMessage) (*PassportElementTypePhoneNumber, error) {
+	var resp PassportElementTypePhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTypeEmailAddress(data json.RawMessage) (*PassportElementTypeEmailAddress, error) {
+	var resp PassportElementTypeEmailAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementPersonalDetails(data json.RawMessage) (*PassportElementPersonalDetails, error) {
+	var resp PassportElementPersonalDetails
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementPassport(data json.RawMessage) (*PassportElementPassport, error) {
+	var resp PassportElementPassport
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementDriverLicense(data json.RawMessage) (*PassportElementDriverLicense, error) {
+	var resp PassportElementDriverLicense
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementIdentityCard(data json.RawMessage) (*PassportElementIdentityCard, error) {
+	var resp PassportElementIdentityCard
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementInternalPassport(data json.RawMessage) (*PassportElementInternalPassport, error) {
+	var resp PassportElementInternalPassport
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementAddress(data json.RawMessage) (*PassportElementAddress, error) {
+	var resp PassportElementAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementUtilityBill(data json.RawMessage) (*PassportElementUtilityBill, error) {
+	var resp PassportElementUtilityBill
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementBankStatement(data json.RawMessage) (*PassportElementBankStatement, error) {
+	var resp PassportElementBankStatement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementRentalAgreement(data json.RawMessage) (*PassportElementRentalAgreement, error) {
+	var resp PassportElementRentalAgreement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementPassportRegistration(data json.RawMessage) (*PassportElementPassportRegistration, error) {
+	var resp PassportElementPassportRegistration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementTemporaryRegistration(data json.RawMessage) (*PassportElementTemporaryRegistration, error) {
+	var resp PassportElementTemporaryRegistration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementPhoneNumber(data json.RawMessage) (*PassportElementPhoneNumber, error) {
+	var resp PassportElementPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementEmailAddress(data json.RawMessage) (*PassportElementEmailAddress, error) {
+	var resp PassportElementEmailAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementPersonalDetails(data json.RawMessage) (*InputPassportElementPersonalDetails, error) {
+	var resp InputPassportElementPersonalDetails
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementPassport(data json.RawMessage) (*InputPassportElementPassport, error) {
+	var resp InputPassportElementPassport
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementDriverLicense(data json.RawMessage) (*InputPassportElementDriverLicense, error) {
+	var resp InputPassportElementDriverLicense
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementIdentityCard(data json.RawMessage) (*InputPassportElementIdentityCard, error) {
+	var resp InputPassportElementIdentityCard
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementInternalPassport(data json.RawMessage) (*InputPassportElementInternalPassport, error) {
+	var resp InputPassportElementInternalPassport
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementAddress(data json.RawMessage) (*InputPassportElementAddress, error) {
+	var resp InputPassportElementAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementUtilityBill(data json.RawMessage) (*InputPassportElementUtilityBill, error) {
+	var resp InputPassportElementUtilityBill
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementBankStatement(data json.RawMessage) (*InputPassportElementBankStatement, error) {
+	var resp InputPassportElementBankStatement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementRentalAgreement(data json.RawMessage) (*InputPassportElementRentalAgreement, error) {
+	var resp InputPassportElementRentalAgreement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementPassportRegistration(data json.RawMessage) (*InputPassportElementPassportRegistration, error) {
+	var resp InputPassportElementPassportRegistration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementTemporaryRegistration(data json.RawMessage) (*InputPassportElementTemporaryRegistration, error) {
+	var resp InputPassportElementTemporaryRegistration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementPhoneNumber(data json.RawMessage) (*InputPassportElementPhoneNumber, error) {
+	var resp InputPassportElementPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementEmailAddress(data json.RawMessage) (*InputPassportElementEmailAddress, error) {
+	var resp InputPassportElementEmailAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElements(data json.RawMessage) (*PassportElements, error) {
+	var resp PassportElements
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceUnspecified(data json.RawMessage) (*PassportElementErrorSourceUnspecified, error) {
+	var resp PassportElementErrorSourceUnspecified
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceDataField(data json.RawMessage) (*PassportElementErrorSourceDataField, error) {
+	var resp PassportElementErrorSourceDataField
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceFrontSide(data json.RawMessage) (*PassportElementErrorSourceFrontSide, error) {
+	var resp PassportElementErrorSourceFrontSide
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceReverseSide(data json.RawMessage) (*PassportElementErrorSourceReverseSide, error) {
+	var resp PassportElementErrorSourceReverseSide
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceSelfie(data json.RawMessage) (*PassportElementErrorSourceSelfie, error) {
+	var resp PassportElementErrorSourceSelfie
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceTranslationFile(data json.RawMessage) (*PassportElementErrorSourceTranslationFile, error) {
+	var resp PassportElementErrorSourceTranslationFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceTranslationFiles(data json.RawMessage) (*PassportElementErrorSourceTranslationFiles, error) {
+	var resp PassportElementErrorSourceTranslationFiles
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceFile(data json.RawMessage) (*PassportElementErrorSourceFile, error) {
+	var resp PassportElementErrorSourceFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementErrorSourceFiles(data json.RawMessage) (*PassportElementErrorSourceFiles, error) {
+	var resp PassportElementErrorSourceFiles
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementError(data json.RawMessage) (*PassportElementError, error) {
+	var resp PassportElementError
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportSuitableElement(data json.RawMessage) (*PassportSuitableElement, error) {
+	var resp PassportSuitableElement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportRequiredElement(data json.RawMessage) (*PassportRequiredElement, error) {
+	var resp PassportRequiredElement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportAuthorizationForm(data json.RawMessage) (*PassportAuthorizationForm, error) {
+	var resp PassportAuthorizationForm
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPassportElementsWithErrors(data json.RawMessage) (*PassportElementsWithErrors, error) {
+	var resp PassportElementsWithErrors
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEncryptedPassportElement(data json.RawMessage) (*EncryptedPassportElement, error) {
+	var resp EncryptedPassportElement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceUnspecified(data json.RawMessage) (*InputPassportElementErrorSourceUnspecified, error) {
+	var resp InputPassportElementErrorSourceUnspecified
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceDataField(data json.RawMessage) (*InputPassportElementErrorSourceDataField, error) {
+	var resp InputPassportElementErrorSourceDataField
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceFrontSide(data json.RawMessage) (*InputPassportElementErrorSourceFrontSide, error) {
+	var resp InputPassportElementErrorSourceFrontSide
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceReverseSide(data json.RawMessage) (*InputPassportElementErrorSourceReverseSide, error) {
+	var resp InputPassportElementErrorSourceReverseSide
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceSelfie(data json.RawMessage) (*InputPassportElementErrorSourceSelfie, error) {
+	var resp InputPassportElementErrorSourceSelfie
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceTranslationFile(data json.RawMessage) (*InputPassportElementErrorSourceTranslationFile, error) {
+	var resp InputPassportElementErrorSourceTranslationFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceTranslationFiles(data json.RawMessage) (*InputPassportElementErrorSourceTranslationFiles, error) {
+	var resp InputPassportElementErrorSourceTranslationFiles
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceFile(data json.RawMessage) (*InputPassportElementErrorSourceFile, error) {
+	var resp InputPassportElementErrorSourceFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementErrorSourceFiles(data json.RawMessage) (*InputPassportElementErrorSourceFiles, error) {
+	var resp InputPassportElementErrorSourceFiles
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPassportElementError(data json.RawMessage) (*InputPassportElementError, error) {
+	var resp InputPassportElementError
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFirebaseAuthenticationSettingsAndroid(data json.RawMessage) (*FirebaseAuthenticationSettingsAndroid, error) {
+	var resp FirebaseAuthenticationSettingsAndroid
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFirebaseAuthenticationSettingsIos(data json.RawMessage) (*FirebaseAuthenticationSettingsIos, error) {
+	var resp FirebaseAuthenticationSettingsIos
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPhoneNumberAuthenticationSettings(data json.RawMessage) (*PhoneNumberAuthenticationSettings, error) {
+	var resp PhoneNumberAuthenticationSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalResetPasswordResultOk(data json.RawMessage) (*ResetPasswordResultOk, error) {
+	var resp ResetPasswordResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalResetPasswordResultPending(data json.RawMessage) (*ResetPasswordResultPending, error) {
+	var resp ResetPasswordResultPending
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalResetPasswordResultDeclined(data json.RawMessage) (*ResetPasswordResultDeclined, error) {
+	var resp ResetPasswordResultDeclined
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeAndroid(data json.RawMessage) (*SessionTypeAndroid, error) {
+	var resp SessionTypeAndroid
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeApple(data json.RawMessage) (*SessionTypeApple, error) {
+	var resp SessionTypeApple
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeBrave(data json.RawMessage) (*SessionTypeBrave, error) {
+	var resp SessionTypeBrave
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeChrome(data json.RawMessage) (*SessionTypeChrome, error) {
+	var resp SessionTypeChrome
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeEdge(data json.RawMessage) (*SessionTypeEdge, error) {
+	var resp SessionTypeEdge
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeFirefox(data json.RawMessage) (*SessionTypeFirefox, error) {
+	var resp SessionTypeFirefox
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeIpad(data json.RawMessage) (*SessionTypeIpad, error) {
+	var resp SessionTypeIpad
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeIphone(data json.RawMessage) (*SessionTypeIphone, error) {
+	var resp SessionTypeIphone
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeLinux(data json.RawMessage) (*SessionTypeLinux, error) {
+	var resp SessionTypeLinux
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeMac(data json.RawMessage) (*SessionTypeMac, error) {
+	var resp SessionTypeMac
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeOpera(data json.RawMessage) (*SessionTypeOpera, error) {
+	var resp SessionTypeOpera
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeSafari(data json.RawMessage) (*SessionTypeSafari, error) {
+	var resp SessionTypeSafari
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeUbuntu(data json.RawMessage) (*SessionTypeUbuntu, error) {
+	var resp SessionTypeUbuntu
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeUnknown(data json.RawMessage) (*SessionTypeUnknown, error) {
+	var resp SessionTypeUnknown
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeVivaldi(data json.RawMessage) (*SessionTypeVivaldi, error) {
+	var resp SessionTypeVivaldi
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeWindows(data json.RawMessage) (*SessionTypeWindows, error) {
+	var resp SessionTypeWindows
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessionTypeXbox(data json.RawMessage) (*SessionTypeXbox, error) {
+	var resp SessionTypeXbox
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSession(data json.RawMessage) (*Session, error) {
+	var resp Session
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSessions(data json.RawMessage) (*Sessions, error) {
+	var resp Sessions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUnconfirmedSession(data json.RawMessage) (*UnconfirmedSession, error) {
+	var resp UnconfirmedSession
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
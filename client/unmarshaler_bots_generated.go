@@ -0,0 +1,791 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalWebAppOpenMode(data json.RawMessage) (WebAppOpenMode, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeWebAppOpenMode)
+}
+
+func decodeWebAppOpenMode(decoder *jsonDecoder) (WebAppOpenMode, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorWebAppOpenModeCompact:
+		return decodeEntity[WebAppOpenModeCompact](decoder)
+
+	case ConstructorWebAppOpenModeFullSize:
+		return decodeEntity[WebAppOpenModeFullSize](decoder)
+
+	case ConstructorWebAppOpenModeFullScreen:
+		return decodeEntity[WebAppOpenModeFullScreen](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfWebAppOpenMode(dataList []json.RawMessage) ([]WebAppOpenMode, error) {
+	list := make([]WebAppOpenMode, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalWebAppOpenMode(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalBotWriteAccessAllowReason(data json.RawMessage) (BotWriteAccessAllowReason, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeBotWriteAccessAllowReason)
+}
+
+func decodeBotWriteAccessAllowReason(decoder *jsonDecoder) (BotWriteAccessAllowReason, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorBotWriteAccessAllowReasonConnectedWebsite:
+		return decodeEntity[BotWriteAccessAllowReasonConnectedWebsite](decoder)
+
+	case ConstructorBotWriteAccessAllowReasonAddedToAttachmentMenu:
+		return decodeEntity[BotWriteAccessAllowReasonAddedToAttachmentMenu](decoder)
+
+	case ConstructorBotWriteAccessAllowReasonLaunchedWebApp:
+		return decodeEntity[BotWriteAccessAllowReasonLaunchedWebApp](decoder)
+
+	case ConstructorBotWriteAccessAllowReasonAcceptedRequest:
+		return decodeEntity[BotWriteAccessAllowReasonAcceptedRequest](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfBotWriteAccessAllowReason(dataList []json.RawMessage) ([]BotWriteAccessAllowReason, error) {
+	list := make([]BotWriteAccessAllowReason, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalBotWriteAccessAllowReason(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputInlineQueryResult(data json.RawMessage) (InputInlineQueryResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputInlineQueryResult)
+}
+
+func decodeInputInlineQueryResult(decoder *jsonDecoder) (InputInlineQueryResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputInlineQueryResultAnimation:
+		return decodeEntity[InputInlineQueryResultAnimation](decoder)
+
+	case ConstructorInputInlineQueryResultArticle:
+		return decodeEntity[InputInlineQueryResultArticle](decoder)
+
+	case ConstructorInputInlineQueryResultAudio:
+		return decodeEntity[InputInlineQueryResultAudio](decoder)
+
+	case ConstructorInputInlineQueryResultContact:
+		return decodeEntity[InputInlineQueryResultContact](decoder)
+
+	case ConstructorInputInlineQueryResultDocument:
+		return decodeEntity[InputInlineQueryResultDocument](decoder)
+
+	case ConstructorInputInlineQueryResultGame:
+		return decodeEntity[InputInlineQueryResultGame](decoder)
+
+	case ConstructorInputInlineQueryResultLocation:
+		return decodeEntity[InputInlineQueryResultLocation](decoder)
+
+	case ConstructorInputInlineQueryResultPhoto:
+		return decodeEntity[InputInlineQueryResultPhoto](decoder)
+
+	case ConstructorInputInlineQueryResultSticker:
+		return decodeEntity[InputInlineQueryResultSticker](decoder)
+
+	case ConstructorInputInlineQueryResultVenue:
+		return decodeEntity[InputInlineQueryResultVenue](decoder)
+
+	case ConstructorInputInlineQueryResultVideo:
+		return decodeEntity[InputInlineQueryResultVideo](decoder)
+
+	case ConstructorInputInlineQueryResultVoiceNote:
+		return decodeEntity[InputInlineQueryResultVoiceNote](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputInlineQueryResult(dataList []json.RawMessage) ([]InputInlineQueryResult, error) {
+	list := make([]InputInlineQueryResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputInlineQueryResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInlineQueryResult(data json.RawMessage) (InlineQueryResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInlineQueryResult)
+}
+
+func decodeInlineQueryResult(decoder *jsonDecoder) (InlineQueryResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInlineQueryResultArticle:
+		return decodeEntity[InlineQueryResultArticle](decoder)
+
+	case ConstructorInlineQueryResultContact:
+		return decodeEntity[InlineQueryResultContact](decoder)
+
+	case ConstructorInlineQueryResultLocation:
+		return decodeEntity[InlineQueryResultLocation](decoder)
+
+	case ConstructorInlineQueryResultVenue:
+		return decodeEntity[InlineQueryResultVenue](decoder)
+
+	case ConstructorInlineQueryResultGame:
+		return decodeEntity[InlineQueryResultGame](decoder)
+
+	case ConstructorInlineQueryResultAnimation:
+		return decodeEntity[InlineQueryResultAnimation](decoder)
+
+	case ConstructorInlineQueryResultAudio:
+		return decodeEntity[InlineQueryResultAudio](decoder)
+
+	case ConstructorInlineQueryResultDocument:
+		return decodeEntity[InlineQueryResultDocument](decoder)
+
+	case ConstructorInlineQueryResultPhoto:
+		return decodeEntity[InlineQueryResultPhoto](decoder)
+
+	case ConstructorInlineQueryResultSticker:
+		return decodeEntity[InlineQueryResultSticker](decoder)
+
+	case ConstructorInlineQueryResultVideo:
+		return decodeEntity[InlineQueryResultVideo](decoder)
+
+	case ConstructorInlineQueryResultVoiceNote:
+		return decodeEntity[InlineQueryResultVoiceNote](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInlineQueryResult(dataList []json.RawMessage) ([]InlineQueryResult, error) {
+	list := make([]InlineQueryResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInlineQueryResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInlineQueryResultsButtonType(data json.RawMessage) (InlineQueryResultsButtonType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInlineQueryResultsButtonType)
+}
+
+func decodeInlineQueryResultsButtonType(decoder *jsonDecoder) (InlineQueryResultsButtonType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInlineQueryResultsButtonTypeStartBot:
+		return decodeEntity[InlineQueryResultsButtonTypeStartBot](decoder)
+
+	case ConstructorInlineQueryResultsButtonTypeWebApp:
+		return decodeEntity[InlineQueryResultsButtonTypeWebApp](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInlineQueryResultsButtonType(dataList []json.RawMessage) ([]InlineQueryResultsButtonType, error) {
+	list := make([]InlineQueryResultsButtonType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInlineQueryResultsButtonType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCallbackQueryPayload(data json.RawMessage) (CallbackQueryPayload, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCallbackQueryPayload)
+}
+
+func decodeCallbackQueryPayload(decoder *jsonDecoder) (CallbackQueryPayload, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCallbackQueryPayloadData:
+		return decodeEntity[CallbackQueryPayloadData](decoder)
+
+	case ConstructorCallbackQueryPayloadDataWithPassword:
+		return decodeEntity[CallbackQueryPayloadDataWithPassword](decoder)
+
+	case ConstructorCallbackQueryPayloadGame:
+		return decodeEntity[CallbackQueryPayloadGame](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCallbackQueryPayload(dataList []json.RawMessage) ([]CallbackQueryPayload, error) {
+	list := make([]CallbackQueryPayload, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCallbackQueryPayload(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalBotCommandScope(data json.RawMessage) (BotCommandScope, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeBotCommandScope)
+}
+
+func decodeBotCommandScope(decoder *jsonDecoder) (BotCommandScope, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorBotCommandScopeDefault:
+		return decodeEntity[BotCommandScopeDefault](decoder)
+
+	case ConstructorBotCommandScopeAllPrivateChats:
+		return decodeEntity[BotCommandScopeAllPrivateChats](decoder)
+
+	case ConstructorBotCommandScopeAllGroupChats:
+		return decodeEntity[BotCommandScopeAllGroupChats](decoder)
+
+	case ConstructorBotCommandScopeAllChatAdministrators:
+		return decodeEntity[BotCommandScopeAllChatAdministrators](decoder)
+
+	case ConstructorBotCommandScopeChat:
+		return decodeEntity[BotCommandScopeChat](decoder)
+
+	case ConstructorBotCommandScopeChatAdministrators:
+		return decodeEntity[BotCommandScopeChatAdministrators](decoder)
+
+	case ConstructorBotCommandScopeChatMember:
+		return decodeEntity[BotCommandScopeChatMember](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfBotCommandScope(dataList []json.RawMessage) ([]BotCommandScope, error) {
+	list := make([]BotCommandScope, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalBotCommandScope(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalGame(data json.RawMessage) (*Game, error) {
+	var resp Game
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalWebApp(data json.RawMessage) (*WebApp, error) {
+	var resp WebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommand(data json.RawMessage) (*BotCommand, error) {
+	var resp BotCommand
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommands(data json.RawMessage) (*BotCommands, error) {
+	var resp BotCommands
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotMenuButton(data json.RawMessage) (*BotMenuButton, error) {
+	var resp BotMenuButton
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotVerificationParameters(data json.RawMessage) (*BotVerificationParameters, error) {
+	var resp BotVerificationParameters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotVerification(data json.RawMessage) (*BotVerification, error) {
+	var resp BotVerification
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotInfo(data json.RawMessage) (*BotInfo, error) {
+	var resp BotInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalWebAppOpenModeCompact(data json.RawMessage) (*WebAppOpenModeCompact, error) {
+	var resp WebAppOpenModeCompact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalWebAppOpenModeFullSize(data json.RawMessage) (*WebAppOpenModeFullSize, error) {
+	var resp WebAppOpenModeFullSize
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalWebAppOpenModeFullScreen(data json.RawMessage) (*WebAppOpenModeFullScreen, error) {
+	var resp WebAppOpenModeFullScreen
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundWebApp(data json.RawMessage) (*FoundWebApp, error) {
+	var resp FoundWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalWebAppInfo(data json.RawMessage) (*WebAppInfo, error) {
+	var resp WebAppInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMainWebApp(data json.RawMessage) (*MainWebApp, error) {
+	var resp MainWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalWebAppOpenParameters(data json.RawMessage) (*WebAppOpenParameters, error) {
+	var resp WebAppOpenParameters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalWebPageInstantView(data json.RawMessage) (*WebPageInstantView, error) {
+	var resp WebPageInstantView
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotMediaPreview(data json.RawMessage) (*BotMediaPreview, error) {
+	var resp BotMediaPreview
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotMediaPreviews(data json.RawMessage) (*BotMediaPreviews, error) {
+	var resp BotMediaPreviews
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotMediaPreviewInfo(data json.RawMessage) (*BotMediaPreviewInfo, error) {
+	var resp BotMediaPreviewInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAttachmentMenuBotColor(data json.RawMessage) (*AttachmentMenuBotColor, error) {
+	var resp AttachmentMenuBotColor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAttachmentMenuBot(data json.RawMessage) (*AttachmentMenuBot, error) {
+	var resp AttachmentMenuBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotWriteAccessAllowReasonConnectedWebsite(data json.RawMessage) (*BotWriteAccessAllowReasonConnectedWebsite, error) {
+	var resp BotWriteAccessAllowReasonConnectedWebsite
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotWriteAccessAllowReasonAddedToAttachmentMenu(data json.RawMessage) (*BotWriteAccessAllowReasonAddedToAttachmentMenu, error) {
+	var resp BotWriteAccessAllowReasonAddedToAttachmentMenu
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotWriteAccessAllowReasonLaunchedWebApp(data json.RawMessage) (*BotWriteAccessAllowReasonLaunchedWebApp, error) {
+	var resp BotWriteAccessAllowReasonLaunchedWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotWriteAccessAllowReasonAcceptedRequest(data json.RawMessage) (*BotWriteAccessAllowReasonAcceptedRequest, error) {
+	var resp BotWriteAccessAllowReasonAcceptedRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultAnimation(data json.RawMessage) (*InputInlineQueryResultAnimation, error) {
+	var resp InputInlineQueryResultAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultArticle(data json.RawMessage) (*InputInlineQueryResultArticle, error) {
+	var resp InputInlineQueryResultArticle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultAudio(data json.RawMessage) (*InputInlineQueryResultAudio, error) {
+	var resp InputInlineQueryResultAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultContact(data json.RawMessage) (*InputInlineQueryResultContact, error) {
+	var resp InputInlineQueryResultContact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultDocument(data json.RawMessage) (*InputInlineQueryResultDocument, error) {
+	var resp InputInlineQueryResultDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultGame(data json.RawMessage) (*InputInlineQueryResultGame, error) {
+	var resp InputInlineQueryResultGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultLocation(data json.RawMessage) (*InputInlineQueryResultLocation, error) {
+	var resp InputInlineQueryResultLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultPhoto(data json.RawMessage) (*InputInlineQueryResultPhoto, error) {
+	var resp InputInlineQueryResultPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultSticker(data json.RawMessage) (*InputInlineQueryResultSticker, error) {
+	var resp InputInlineQueryResultSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultVenue(data json.RawMessage) (*InputInlineQueryResultVenue, error) {
+	var resp InputInlineQueryResultVenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultVideo(data json.RawMessage) (*InputInlineQueryResultVideo, error) {
+	var resp InputInlineQueryResultVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInlineQueryResultVoiceNote(data json.RawMessage) (*InputInlineQueryResultVoiceNote, error) {
+	var resp InputInlineQueryResultVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultArticle(data json.RawMessage) (*InlineQueryResultArticle, error) {
+	var resp InlineQueryResultArticle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultContact(data json.RawMessage) (*InlineQueryResultContact, error) {
+	var resp InlineQueryResultContact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultLocation(data json.RawMessage) (*InlineQueryResultLocation, error) {
+	var resp InlineQueryResultLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultVenue(data json.RawMessage) (*InlineQueryResultVenue, error) {
+	var resp InlineQueryResultVenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultGame(data json.RawMessage) (*InlineQueryResultGame, error) {
+	var resp InlineQueryResultGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultAnimation(data json.RawMessage) (*InlineQueryResultAnimation, error) {
+	var resp InlineQueryResultAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultAudio(data json.RawMessage) (*InlineQueryResultAudio, error) {
+	var resp InlineQueryResultAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultDocument(data json.RawMessage) (*InlineQueryResultDocument, error) {
+	var resp InlineQueryResultDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultPhoto(data json.RawMessage) (*InlineQueryResultPhoto, error) {
+	var resp InlineQueryResultPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultSticker(data json.RawMessage) (*InlineQueryResultSticker, error) {
+	var resp InlineQueryResultSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultVideo(data json.RawMessage) (*InlineQueryResultVideo, error) {
+	var resp InlineQueryResultVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultVoiceNote(data json.RawMessage) (*InlineQueryResultVoiceNote, error) {
+	var resp InlineQueryResultVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultsButtonTypeStartBot(data json.RawMessage) (*InlineQueryResultsButtonTypeStartBot, error) {
+	var resp InlineQueryResultsButtonTypeStartBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultsButtonTypeWebApp(data json.RawMessage) (*InlineQueryResultsButtonTypeWebApp, error) {
+	var resp InlineQueryResultsButtonTypeWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResultsButton(data json.RawMessage) (*InlineQueryResultsButton, error) {
+	var resp InlineQueryResultsButton
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineQueryResults(data json.RawMessage) (*InlineQueryResults, error) {
+	var resp InlineQueryResults
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallbackQueryPayloadData(data json.RawMessage) (*CallbackQueryPayloadData, error) {
+	var resp CallbackQueryPayloadData
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallbackQueryPayloadDataWithPassword(data json.RawMessage) (*CallbackQueryPayloadDataWithPassword, error) {
+	var resp CallbackQueryPayloadDataWithPassword
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallbackQueryPayloadGame(data json.RawMessage) (*CallbackQueryPayloadGame, error) {
+	var resp CallbackQueryPayloadGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallbackQueryAnswer(data json.RawMessage) (*CallbackQueryAnswer, error) {
+	var resp CallbackQueryAnswer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGameHighScore(data json.RawMessage) (*GameHighScore, error) {
+	var resp GameHighScore
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGameHighScores(data json.RawMessage) (*GameHighScores, error) {
+	var resp GameHighScores
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommandScopeDefault(data json.RawMessage) (*BotCommandScopeDefault, error) {
+	var resp BotCommandScopeDefault
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommandScopeAllPrivateChats(data json.RawMessage) (*BotCommandScopeAllPrivateChats, error) {
+	var resp BotCommandScopeAllPrivateChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommandScopeAllGroupChats(data json.RawMessage) (*BotCommandScopeAllGroupChats, error) {
+	var resp BotCommandScopeAllGroupChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommandScopeAllChatAdministrators(data json.RawMessage) (*BotCommandScopeAllChatAdministrators, error) {
+	var resp BotCommandScopeAllChatAdministrators
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommandScopeChat(data json.RawMessage) (*BotCommandScopeChat, error) {
+	var resp BotCommandScopeChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommandScopeChatAdministrators(data json.RawMessage) (*BotCommandScopeChatAdministrators, error) {
+	var resp BotCommandScopeChatAdministrators
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBotCommandScopeChatMember(data json.RawMessage) (*BotCommandScopeChatMember, error) {
+	var resp BotCommandScopeChatMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
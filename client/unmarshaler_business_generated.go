@@ -0,0 +1,333 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalBusinessAwayMessageSchedule(data json.RawMessage) (BusinessAwayMessageSchedule, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeBusinessAwayMessageSchedule)
+}
+
+func decodeBusinessAwayMessageSchedule(decoder *jsonDecoder) (BusinessAwayMessageSchedule, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorBusinessAwayMessageScheduleAlways:
+		return decodeEntity[BusinessAwayMessageScheduleAlways](decoder)
+
+	case ConstructorBusinessAwayMessageScheduleOutsideOfOpeningHours:
+		return decodeEntity[BusinessAwayMessageScheduleOutsideOfOpeningHours](decoder)
+
+	case ConstructorBusinessAwayMessageScheduleCustom:
+		return decodeEntity[BusinessAwayMessageScheduleCustom](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfBusinessAwayMessageSchedule(dataList []json.RawMessage) ([]BusinessAwayMessageSchedule, error) {
+	list := make([]BusinessAwayMessageSchedule, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalBusinessAwayMessageSchedule(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalBusinessFeature(data json.RawMessage) (BusinessFeature, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeBusinessFeature)
+}
+
+func decodeBusinessFeature(decoder *jsonDecoder) (BusinessFeature, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorBusinessFeatureLocation:
+		return decodeEntity[BusinessFeatureLocation](decoder)
+
+	case ConstructorBusinessFeatureOpeningHours:
+		return decodeEntity[BusinessFeatureOpeningHours](decoder)
+
+	case ConstructorBusinessFeatureQuickReplies:
+		return decodeEntity[BusinessFeatureQuickReplies](decoder)
+
+	case ConstructorBusinessFeatureGreetingMessage:
+		return decodeEntity[BusinessFeatureGreetingMessage](decoder)
+
+	case ConstructorBusinessFeatureAwayMessage:
+		return decodeEntity[BusinessFeatureAwayMessage](decoder)
+
+	case ConstructorBusinessFeatureAccountLinks:
+		return decodeEntity[BusinessFeatureAccountLinks](decoder)
+
+	case ConstructorBusinessFeatureStartPage:
+		return decodeEntity[BusinessFeatureStartPage](decoder)
+
+	case ConstructorBusinessFeatureBots:
+		return decodeEntity[BusinessFeatureBots](decoder)
+
+	case ConstructorBusinessFeatureEmojiStatus:
+		return decodeEntity[BusinessFeatureEmojiStatus](decoder)
+
+	case ConstructorBusinessFeatureChatFolderTags:
+		return decodeEntity[BusinessFeatureChatFolderTags](decoder)
+
+	case ConstructorBusinessFeatureUpgradedStories:
+		return decodeEntity[BusinessFeatureUpgradedStories](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfBusinessFeature(dataList []json.RawMessage) ([]BusinessFeature, error) {
+	list := make([]BusinessFeature, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalBusinessFeature(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalBusinessAwayMessageScheduleAlways(data json.RawMessage) (*BusinessAwayMessageScheduleAlways, error) {
+	var resp BusinessAwayMessageScheduleAlways
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessAwayMessageScheduleOutsideOfOpeningHours(data json.RawMessage) (*BusinessAwayMessageScheduleOutsideOfOpeningHours, error) {
+	var resp BusinessAwayMessageScheduleOutsideOfOpeningHours
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessAwayMessageScheduleCustom(data json.RawMessage) (*BusinessAwayMessageScheduleCustom, error) {
+	var resp BusinessAwayMessageScheduleCustom
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessLocation(data json.RawMessage) (*BusinessLocation, error) {
+	var resp BusinessLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessRecipients(data json.RawMessage) (*BusinessRecipients, error) {
+	var resp BusinessRecipients
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessAwayMessageSettings(data json.RawMessage) (*BusinessAwayMessageSettings, error) {
+	var resp BusinessAwayMessageSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessGreetingMessageSettings(data json.RawMessage) (*BusinessGreetingMessageSettings, error) {
+	var resp BusinessGreetingMessageSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessBotRights(data json.RawMessage) (*BusinessBotRights, error) {
+	var resp BusinessBotRights
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessConnectedBot(data json.RawMessage) (*BusinessConnectedBot, error) {
+	var resp BusinessConnectedBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessStartPage(data json.RawMessage) (*BusinessStartPage, error) {
+	var resp BusinessStartPage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputBusinessStartPage(data json.RawMessage) (*InputBusinessStartPage, error) {
+	var resp InputBusinessStartPage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessOpeningHoursInterval(data json.RawMessage) (*BusinessOpeningHoursInterval, error) {
+	var resp BusinessOpeningHoursInterval
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessOpeningHours(data json.RawMessage) (*BusinessOpeningHours, error) {
+	var resp BusinessOpeningHours
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessInfo(data json.RawMessage) (*BusinessInfo, error) {
+	var resp BusinessInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessChatLink(data json.RawMessage) (*BusinessChatLink, error) {
+	var resp BusinessChatLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessChatLinks(data json.RawMessage) (*BusinessChatLinks, error) {
+	var resp BusinessChatLinks
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputBusinessChatLink(data json.RawMessage) (*InputBusinessChatLink, error) {
+	var resp InputBusinessChatLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessChatLinkInfo(data json.RawMessage) (*BusinessChatLinkInfo, error) {
+	var resp BusinessChatLinkInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessMessage(data json.RawMessage) (*BusinessMessage, error) {
+	var resp BusinessMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessMessages(data json.RawMessage) (*BusinessMessages, error) {
+	var resp BusinessMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessBotManageBar(data json.RawMessage) (*BusinessBotManageBar, error) {
+	var resp BusinessBotManageBar
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessConnection(data json.RawMessage) (*BusinessConnection, error) {
+	var resp BusinessConnection
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureLocation(data json.RawMessage) (*BusinessFeatureLocation, error) {
+	var resp BusinessFeatureLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureOpeningHours(data json.RawMessage) (*BusinessFeatureOpeningHours, error) {
+	var resp BusinessFeatureOpeningHours
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureQuickReplies(data json.RawMessage) (*BusinessFeatureQuickReplies, error) {
+	var resp BusinessFeatureQuickReplies
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureGreetingMessage(data json.RawMessage) (*BusinessFeatureGreetingMessage, error) {
+	var resp BusinessFeatureGreetingMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureAwayMessage(data json.RawMessage) (*BusinessFeatureAwayMessage, error) {
+	var resp BusinessFeatureAwayMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureAccountLinks(data json.RawMessage) (*BusinessFeatureAccountLinks, error) {
+	var resp BusinessFeatureAccountLinks
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureStartPage(data json.RawMessage) (*BusinessFeatureStartPage, error) {
+	var resp BusinessFeatureStartPage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureBots(data json.RawMessage) (*BusinessFeatureBots, error) {
+	var resp BusinessFeatureBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureEmojiStatus(data json.RawMessage) (*BusinessFeatureEmojiStatus, error) {
+	var resp BusinessFeatureEmojiStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureChatFolderTags(data json.RawMessage) (*BusinessFeatureChatFolderTags, error) {
+	var resp BusinessFeatureChatFolderTags
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatureUpgradedStories(data json.RawMessage) (*BusinessFeatureUpgradedStories, error) {
+	var resp BusinessFeatureUpgradedStories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeatures(data json.RawMessage) (*BusinessFeatures, error) {
+	var resp BusinessFeatures
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBusinessFeaturePromotionAnimation(data json.RawMessage) (*BusinessFeaturePromotionAnimation, error) {
+	var resp BusinessFeaturePromotionAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
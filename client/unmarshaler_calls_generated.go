@@ -0,0 +1,498 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalCallDiscardReason(data json.RawMessage) (CallDiscardReason, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCallDiscardReason)
+}
+
+func decodeCallDiscardReason(decoder *jsonDecoder) (CallDiscardReason, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCallDiscardReasonEmpty:
+		return decodeEntity[CallDiscardReasonEmpty](decoder)
+
+	case ConstructorCallDiscardReasonMissed:
+		return decodeEntity[CallDiscardReasonMissed](decoder)
+
+	case ConstructorCallDiscardReasonDeclined:
+		return decodeEntity[CallDiscardReasonDeclined](decoder)
+
+	case ConstructorCallDiscardReasonDisconnected:
+		return decodeEntity[CallDiscardReasonDisconnected](decoder)
+
+	case ConstructorCallDiscardReasonHungUp:
+		return decodeEntity[CallDiscardReasonHungUp](decoder)
+
+	case ConstructorCallDiscardReasonAllowGroupCall:
+		return decodeEntity[CallDiscardReasonAllowGroupCall](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCallDiscardReason(dataList []json.RawMessage) ([]CallDiscardReason, error) {
+	list := make([]CallDiscardReason, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCallDiscardReason(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCallServerType(data json.RawMessage) (CallServerType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCallServerType)
+}
+
+func decodeCallServerType(decoder *jsonDecoder) (CallServerType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCallServerTypeTelegramReflector:
+		return decodeEntity[CallServerTypeTelegramReflector](decoder)
+
+	case ConstructorCallServerTypeWebrtc:
+		return decodeEntity[CallServerTypeWebrtc](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCallServerType(dataList []json.RawMessage) ([]CallServerType, error) {
+	list := make([]CallServerType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCallServerType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCallState(data json.RawMessage) (CallState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCallState)
+}
+
+func decodeCallState(decoder *jsonDecoder) (CallState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCallStatePending:
+		return decodeEntity[CallStatePending](decoder)
+
+	case ConstructorCallStateExchangingKeys:
+		return decodeEntity[CallStateExchangingKeys](decoder)
+
+	case ConstructorCallStateReady:
+		return decodeEntity[CallStateReady](decoder)
+
+	case ConstructorCallStateHangingUp:
+		return decodeEntity[CallStateHangingUp](decoder)
+
+	case ConstructorCallStateDiscarded:
+		return decodeEntity[CallStateDiscarded](decoder)
+
+	case ConstructorCallStateError:
+		return decodeEntity[CallStateError](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCallState(dataList []json.RawMessage) ([]CallState, error) {
+	list := make([]CallState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCallState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalGroupCallVideoQuality(data json.RawMessage) (GroupCallVideoQuality, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeGroupCallVideoQuality)
+}
+
+func decodeGroupCallVideoQuality(decoder *jsonDecoder) (GroupCallVideoQuality, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorGroupCallVideoQualityThumbnail:
+		return decodeEntity[GroupCallVideoQualityThumbnail](decoder)
+
+	case ConstructorGroupCallVideoQualityMedium:
+		return decodeEntity[GroupCallVideoQualityMedium](decoder)
+
+	case ConstructorGroupCallVideoQualityFull:
+		return decodeEntity[GroupCallVideoQualityFull](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfGroupCallVideoQuality(dataList []json.RawMessage) ([]GroupCallVideoQuality, error) {
+	list := make([]GroupCallVideoQuality, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalGroupCallVideoQuality(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCallProblem(data json.RawMessage) (CallProblem, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCallProblem)
+}
+
+func decodeCallProblem(decoder *jsonDecoder) (CallProblem, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCallProblemEcho:
+		return decodeEntity[CallProblemEcho](decoder)
+
+	case ConstructorCallProblemNoise:
+		return decodeEntity[CallProblemNoise](decoder)
+
+	case ConstructorCallProblemInterruptions:
+		return decodeEntity[CallProblemInterruptions](decoder)
+
+	case ConstructorCallProblemDistortedSpeech:
+		return decodeEntity[CallProblemDistortedSpeech](decoder)
+
+	case ConstructorCallProblemSilentLocal:
+		return decodeEntity[CallProblemSilentLocal](decoder)
+
+	case ConstructorCallProblemSilentRemote:
+		return decodeEntity[CallProblemSilentRemote](decoder)
+
+	case ConstructorCallProblemDropped:
+		return decodeEntity[CallProblemDropped](decoder)
+
+	case ConstructorCallProblemDistortedVideo:
+		return decodeEntity[CallProblemDistortedVideo](decoder)
+
+	case ConstructorCallProblemPixelatedVideo:
+		return decodeEntity[CallProblemPixelatedVideo](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCallProblem(dataList []json.RawMessage) ([]CallProblem, error) {
+	list := make([]CallProblem, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCallProblem(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCallDiscardReasonEmpty(data json.RawMessage) (*CallDiscardReasonEmpty, error) {
+	var resp CallDiscardReasonEmpty
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallDiscardReasonMissed(data json.RawMessage) (*CallDiscardReasonMissed, error) {
+	var resp CallDiscardReasonMissed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallDiscardReasonDeclined(data json.RawMessage) (*CallDiscardReasonDeclined, error) {
+	var resp CallDiscardReasonDeclined
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallDiscardReasonDisconnected(data json.RawMessage) (*CallDiscardReasonDisconnected, error) {
+	var resp CallDiscardReasonDisconnected
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallDiscardReasonHungUp(data json.RawMessage) (*CallDiscardReasonHungUp, error) {
+	var resp CallDiscardReasonHungUp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallDiscardReasonAllowGroupCall(data json.RawMessage) (*CallDiscardReasonAllowGroupCall, error) {
+	var resp CallDiscardReasonAllowGroupCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProtocol(data json.RawMessage) (*CallProtocol, error) {
+	var resp CallProtocol
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallServerTypeTelegramReflector(data json.RawMessage) (*CallServerTypeTelegramReflector, error) {
+	var resp CallServerTypeTelegramReflector
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallServerTypeWebrtc(data json.RawMessage) (*CallServerTypeWebrtc, error) {
+	var resp CallServerTypeWebrtc
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallServer(data json.RawMessage) (*CallServer, error) {
+	var resp CallServer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallId(data json.RawMessage) (*CallId, error) {
+	var resp CallId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallId(data json.RawMessage) (*GroupCallId, error) {
+	var resp GroupCallId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallStatePending(data json.RawMessage) (*CallStatePending, error) {
+	var resp CallStatePending
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallStateExchangingKeys(data json.RawMessage) (*CallStateExchangingKeys, error) {
+	var resp CallStateExchangingKeys
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallStateReady(data json.RawMessage) (*CallStateReady, error) {
+	var resp CallStateReady
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallStateHangingUp(data json.RawMessage) (*CallStateHangingUp, error) {
+	var resp CallStateHangingUp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallStateDiscarded(data json.RawMessage) (*CallStateDiscarded, error) {
+	var resp CallStateDiscarded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallStateError(data json.RawMessage) (*CallStateError, error) {
+	var resp CallStateError
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallVideoQualityThumbnail(data json.RawMessage) (*GroupCallVideoQualityThumbnail, error) {
+	var resp GroupCallVideoQualityThumbnail
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallVideoQualityMedium(data json.RawMessage) (*GroupCallVideoQualityMedium, error) {
+	var resp GroupCallVideoQualityMedium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallVideoQualityFull(data json.RawMessage) (*GroupCallVideoQualityFull, error) {
+	var resp GroupCallVideoQualityFull
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallStream(data json.RawMessage) (*GroupCallStream, error) {
+	var resp GroupCallStream
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallStreams(data json.RawMessage) (*GroupCallStreams, error) {
+	var resp GroupCallStreams
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallRecentSpeaker(data json.RawMessage) (*GroupCallRecentSpeaker, error) {
+	var resp GroupCallRecentSpeaker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCall(data json.RawMessage) (*GroupCall, error) {
+	var resp GroupCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallVideoSourceGroup(data json.RawMessage) (*GroupCallVideoSourceGroup, error) {
+	var resp GroupCallVideoSourceGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallParticipantVideoInfo(data json.RawMessage) (*GroupCallParticipantVideoInfo, error) {
+	var resp GroupCallParticipantVideoInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGroupCallParticipant(data json.RawMessage) (*GroupCallParticipant, error) {
+	var resp GroupCallParticipant
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemEcho(data json.RawMessage) (*CallProblemEcho, error) {
+	var resp CallProblemEcho
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemNoise(data json.RawMessage) (*CallProblemNoise, error) {
+	var resp CallProblemNoise
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemInterruptions(data json.RawMessage) (*CallProblemInterruptions, error) {
+	var resp CallProblemInterruptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemDistortedSpeech(data json.RawMessage) (*CallProblemDistortedSpeech, error) {
+	var resp CallProblemDistortedSpeech
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemSilentLocal(data json.RawMessage) (*CallProblemSilentLocal, error) {
+	var resp CallProblemSilentLocal
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemSilentRemote(data json.RawMessage) (*CallProblemSilentRemote, error) {
+	var resp CallProblemSilentRemote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemDropped(data json.RawMessage) (*CallProblemDropped, error) {
+	var resp CallProblemDropped
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemDistortedVideo(data json.RawMessage) (*CallProblemDistortedVideo, error) {
+	var resp CallProblemDistortedVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCallProblemPixelatedVideo(data json.RawMessage) (*CallProblemPixelatedVideo, error) {
+	var resp CallProblemPixelatedVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCall(data json.RawMessage) (*Call, error) {
+	var resp Call
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
@@ -0,0 +1,2406 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalInputChatPhoto(data json.RawMessage) (InputChatPhoto, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputChatPhoto)
+}
+
+func decodeInputChatPhoto(decoder *jsonDecoder) (InputChatPhoto, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputChatPhotoPrevious:
+		return decodeEntity[InputChatPhotoPrevious](decoder)
+
+	case ConstructorInputChatPhotoStatic:
+		return decodeEntity[InputChatPhotoStatic](decoder)
+
+	case ConstructorInputChatPhotoAnimation:
+		return decodeEntity[InputChatPhotoAnimation](decoder)
+
+	case ConstructorInputChatPhotoSticker:
+		return decodeEntity[InputChatPhotoSticker](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputChatPhoto(dataList []json.RawMessage) ([]InputChatPhoto, error) {
+	list := make([]InputChatPhoto, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputChatPhoto(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatMemberStatus(data json.RawMessage) (ChatMemberStatus, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatMemberStatus)
+}
+
+func decodeChatMemberStatus(decoder *jsonDecoder) (ChatMemberStatus, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatMemberStatusCreator:
+		return decodeEntity[ChatMemberStatusCreator](decoder)
+
+	case ConstructorChatMemberStatusAdministrator:
+		return decodeEntity[ChatMemberStatusAdministrator](decoder)
+
+	case ConstructorChatMemberStatusMember:
+		return decodeEntity[ChatMemberStatusMember](decoder)
+
+	case ConstructorChatMemberStatusRestricted:
+		return decodeEntity[ChatMemberStatusRestricted](decoder)
+
+	case ConstructorChatMemberStatusLeft:
+		return decodeEntity[ChatMemberStatusLeft](decoder)
+
+	case ConstructorChatMemberStatusBanned:
+		return decodeEntity[ChatMemberStatusBanned](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatMemberStatus(dataList []json.RawMessage) ([]ChatMemberStatus, error) {
+	list := make([]ChatMemberStatus, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatMemberStatus(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatMembersFilter(data json.RawMessage) (ChatMembersFilter, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatMembersFilter)
+}
+
+func decodeChatMembersFilter(decoder *jsonDecoder) (ChatMembersFilter, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatMembersFilterContacts:
+		return decodeEntity[ChatMembersFilterContacts](decoder)
+
+	case ConstructorChatMembersFilterAdministrators:
+		return decodeEntity[ChatMembersFilterAdministrators](decoder)
+
+	case ConstructorChatMembersFilterMembers:
+		return decodeEntity[ChatMembersFilterMembers](decoder)
+
+	case ConstructorChatMembersFilterMention:
+		return decodeEntity[ChatMembersFilterMention](decoder)
+
+	case ConstructorChatMembersFilterRestricted:
+		return decodeEntity[ChatMembersFilterRestricted](decoder)
+
+	case ConstructorChatMembersFilterBanned:
+		return decodeEntity[ChatMembersFilterBanned](decoder)
+
+	case ConstructorChatMembersFilterBots:
+		return decodeEntity[ChatMembersFilterBots](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatMembersFilter(dataList []json.RawMessage) ([]ChatMembersFilter, error) {
+	list := make([]ChatMembersFilter, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatMembersFilter(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSupergroupMembersFilter(data json.RawMessage) (SupergroupMembersFilter, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSupergroupMembersFilter)
+}
+
+func decodeSupergroupMembersFilter(decoder *jsonDecoder) (SupergroupMembersFilter, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSupergroupMembersFilterRecent:
+		return decodeEntity[SupergroupMembersFilterRecent](decoder)
+
+	case ConstructorSupergroupMembersFilterContacts:
+		return decodeEntity[SupergroupMembersFilterContacts](decoder)
+
+	case ConstructorSupergroupMembersFilterAdministrators:
+		return decodeEntity[SupergroupMembersFilterAdministrators](decoder)
+
+	case ConstructorSupergroupMembersFilterSearch:
+		return decodeEntity[SupergroupMembersFilterSearch](decoder)
+
+	case ConstructorSupergroupMembersFilterRestricted:
+		return decodeEntity[SupergroupMembersFilterRestricted](decoder)
+
+	case ConstructorSupergroupMembersFilterBanned:
+		return decodeEntity[SupergroupMembersFilterBanned](decoder)
+
+	case ConstructorSupergroupMembersFilterMention:
+		return decodeEntity[SupergroupMembersFilterMention](decoder)
+
+	case ConstructorSupergroupMembersFilterBots:
+		return decodeEntity[SupergroupMembersFilterBots](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSupergroupMembersFilter(dataList []json.RawMessage) ([]SupergroupMembersFilter, error) {
+	list := make([]SupergroupMembersFilter, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSupergroupMembersFilter(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInviteLinkChatType(data json.RawMessage) (InviteLinkChatType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInviteLinkChatType)
+}
+
+func decodeInviteLinkChatType(decoder *jsonDecoder) (InviteLinkChatType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInviteLinkChatTypeBasicGroup:
+		return decodeEntity[InviteLinkChatTypeBasicGroup](decoder)
+
+	case ConstructorInviteLinkChatTypeSupergroup:
+		return decodeEntity[InviteLinkChatTypeSupergroup](decoder)
+
+	case ConstructorInviteLinkChatTypeChannel:
+		return decodeEntity[InviteLinkChatTypeChannel](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInviteLinkChatType(dataList []json.RawMessage) ([]InviteLinkChatType, error) {
+	list := make([]InviteLinkChatType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInviteLinkChatType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSecretChatState(data json.RawMessage) (SecretChatState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSecretChatState)
+}
+
+func decodeSecretChatState(decoder *jsonDecoder) (SecretChatState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSecretChatStatePending:
+		return decodeEntity[SecretChatStatePending](decoder)
+
+	case ConstructorSecretChatStateReady:
+		return decodeEntity[SecretChatStateReady](decoder)
+
+	case ConstructorSecretChatStateClosed:
+		return decodeEntity[SecretChatStateClosed](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSecretChatState(dataList []json.RawMessage) ([]SecretChatState, error) {
+	list := make([]SecretChatState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSecretChatState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatType(data json.RawMessage) (ChatType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatType)
+}
+
+func decodeChatType(decoder *jsonDecoder) (ChatType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatTypePrivate:
+		return decodeEntity[ChatTypePrivate](decoder)
+
+	case ConstructorChatTypeBasicGroup:
+		return decodeEntity[ChatTypeBasicGroup](decoder)
+
+	case ConstructorChatTypeSupergroup:
+		return decodeEntity[ChatTypeSupergroup](decoder)
+
+	case ConstructorChatTypeSecret:
+		return decodeEntity[ChatTypeSecret](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatType(dataList []json.RawMessage) ([]ChatType, error) {
+	list := make([]ChatType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatList(data json.RawMessage) (ChatList, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatList)
+}
+
+func decodeChatList(decoder *jsonDecoder) (ChatList, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatListMain:
+		return decodeEntity[ChatListMain](decoder)
+
+	case ConstructorChatListArchive:
+		return decodeEntity[ChatListArchive](decoder)
+
+	case ConstructorChatListFolder:
+		return decodeEntity[ChatListFolder](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatList(dataList []json.RawMessage) ([]ChatList, error) {
+	list := make([]ChatList, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatList(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatSource(data json.RawMessage) (ChatSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatSource)
+}
+
+func decodeChatSource(decoder *jsonDecoder) (ChatSource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatSourceMtprotoProxy:
+		return decodeEntity[ChatSourceMtprotoProxy](decoder)
+
+	case ConstructorChatSourcePublicServiceAnnouncement:
+		return decodeEntity[ChatSourcePublicServiceAnnouncement](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatSource(dataList []json.RawMessage) ([]ChatSource, error) {
+	list := make([]ChatSource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatSource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPublicChatType(data json.RawMessage) (PublicChatType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePublicChatType)
+}
+
+func decodePublicChatType(decoder *jsonDecoder) (PublicChatType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPublicChatTypeHasUsername:
+		return decodeEntity[PublicChatTypeHasUsername](decoder)
+
+	case ConstructorPublicChatTypeIsLocationBased:
+		return decodeEntity[PublicChatTypeIsLocationBased](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPublicChatType(dataList []json.RawMessage) ([]PublicChatType, error) {
+	list := make([]PublicChatType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPublicChatType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatActionBar(data json.RawMessage) (ChatActionBar, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatActionBar)
+}
+
+func decodeChatActionBar(decoder *jsonDecoder) (ChatActionBar, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatActionBarReportSpam:
+		return decodeEntity[ChatActionBarReportSpam](decoder)
+
+	case ConstructorChatActionBarInviteMembers:
+		return decodeEntity[ChatActionBarInviteMembers](decoder)
+
+	case ConstructorChatActionBarReportAddBlock:
+		return decodeEntity[ChatActionBarReportAddBlock](decoder)
+
+	case ConstructorChatActionBarAddContact:
+		return decodeEntity[ChatActionBarAddContact](decoder)
+
+	case ConstructorChatActionBarSharePhoneNumber:
+		return decodeEntity[ChatActionBarSharePhoneNumber](decoder)
+
+	case ConstructorChatActionBarJoinRequest:
+		return decodeEntity[ChatActionBarJoinRequest](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatActionBar(dataList []json.RawMessage) ([]ChatActionBar, error) {
+	list := make([]ChatActionBar, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatActionBar(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatAction(data json.RawMessage) (ChatAction, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatAction)
+}
+
+func decodeChatAction(decoder *jsonDecoder) (ChatAction, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatActionTyping:
+		return decodeEntity[ChatActionTyping](decoder)
+
+	case ConstructorChatActionRecordingVideo:
+		return decodeEntity[ChatActionRecordingVideo](decoder)
+
+	case ConstructorChatActionUploadingVideo:
+		return decodeEntity[ChatActionUploadingVideo](decoder)
+
+	case ConstructorChatActionRecordingVoiceNote:
+		return decodeEntity[ChatActionRecordingVoiceNote](decoder)
+
+	case ConstructorChatActionUploadingVoiceNote:
+		return decodeEntity[ChatActionUploadingVoiceNote](decoder)
+
+	case ConstructorChatActionUploadingPhoto:
+		return decodeEntity[ChatActionUploadingPhoto](decoder)
+
+	case ConstructorChatActionUploadingDocument:
+		return decodeEntity[ChatActionUploadingDocument](decoder)
+
+	case ConstructorChatActionChoosingSticker:
+		return decodeEntity[ChatActionChoosingSticker](decoder)
+
+	case ConstructorChatActionChoosingLocation:
+		return decodeEntity[ChatActionChoosingLocation](decoder)
+
+	case ConstructorChatActionChoosingContact:
+		return decodeEntity[ChatActionChoosingContact](decoder)
+
+	case ConstructorChatActionStartPlayingGame:
+		return decodeEntity[ChatActionStartPlayingGame](decoder)
+
+	case ConstructorChatActionRecordingVideoNote:
+		return decodeEntity[ChatActionRecordingVideoNote](decoder)
+
+	case ConstructorChatActionUploadingVideoNote:
+		return decodeEntity[ChatActionUploadingVideoNote](decoder)
+
+	case ConstructorChatActionWatchingAnimations:
+		return decodeEntity[ChatActionWatchingAnimations](decoder)
+
+	case ConstructorChatActionCancel:
+		return decodeEntity[ChatActionCancel](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatAction(dataList []json.RawMessage) ([]ChatAction, error) {
+	list := make([]ChatAction, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatAction(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalTargetChat(data json.RawMessage) (TargetChat, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeTargetChat)
+}
+
+func decodeTargetChat(decoder *jsonDecoder) (TargetChat, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorTargetChatCurrent:
+		return decodeEntity[TargetChatCurrent](decoder)
+
+	case ConstructorTargetChatChosen:
+		return decodeEntity[TargetChatChosen](decoder)
+
+	case ConstructorTargetChatInternalLink:
+		return decodeEntity[TargetChatInternalLink](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfTargetChat(dataList []json.RawMessage) ([]TargetChat, error) {
+	list := make([]TargetChat, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalTargetChat(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatEventAction(data json.RawMessage) (ChatEventAction, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatEventAction)
+}
+
+func decodeChatEventAction(decoder *jsonDecoder) (ChatEventAction, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatEventMessageEdited:
+		return decodeEntity[ChatEventMessageEdited](decoder)
+
+	case ConstructorChatEventMessageDeleted:
+		return decodeEntity[ChatEventMessageDeleted](decoder)
+
+	case ConstructorChatEventMessagePinned:
+		return decodeEntity[ChatEventMessagePinned](decoder)
+
+	case ConstructorChatEventMessageUnpinned:
+		return decodeEntity[ChatEventMessageUnpinned](decoder)
+
+	case ConstructorChatEventPollStopped:
+		return decodeEntity[ChatEventPollStopped](decoder)
+
+	case ConstructorChatEventMemberJoined:
+		return decodeEntity[ChatEventMemberJoined](decoder)
+
+	case ConstructorChatEventMemberJoinedByInviteLink:
+		return decodeEntity[ChatEventMemberJoinedByInviteLink](decoder)
+
+	case ConstructorChatEventMemberJoinedByRequest:
+		return decodeEntity[ChatEventMemberJoinedByRequest](decoder)
+
+	case ConstructorChatEventMemberInvited:
+		return decodeEntity[ChatEventMemberInvited](decoder)
+
+	case ConstructorChatEventMemberLeft:
+		return decodeEntity[ChatEventMemberLeft](decoder)
+
+	case ConstructorChatEventMemberPromoted:
+		return decodeEntity[ChatEventMemberPromoted](decoder)
+
+	case ConstructorChatEventMemberRestricted:
+		return decodeEntity[ChatEventMemberRestricted](decoder)
+
+	case ConstructorChatEventMemberSubscriptionExtended:
+		return decodeEntity[ChatEventMemberSubscriptionExtended](decoder)
+
+	case ConstructorChatEventAvailableReactionsChanged:
+		return decodeEntity[ChatEventAvailableReactionsChanged](decoder)
+
+	case ConstructorChatEventBackgroundChanged:
+		return decodeEntity[ChatEventBackgroundChanged](decoder)
+
+	case ConstructorChatEventDescriptionChanged:
+		return decodeEntity[ChatEventDescriptionChanged](decoder)
+
+	case ConstructorChatEventEmojiStatusChanged:
+		return decodeEntity[ChatEventEmojiStatusChanged](decoder)
+
+	case ConstructorChatEventLinkedChatChanged:
+		return decodeEntity[ChatEventLinkedChatChanged](decoder)
+
+	case ConstructorChatEventLocationChanged:
+		return decodeEntity[ChatEventLocationChanged](decoder)
+
+	case ConstructorChatEventMessageAutoDeleteTimeChanged:
+		return decodeEntity[ChatEventMessageAutoDeleteTimeChanged](decoder)
+
+	case ConstructorChatEventPermissionsChanged:
+		return decodeEntity[ChatEventPermissionsChanged](decoder)
+
+	case ConstructorChatEventPhotoChanged:
+		return decodeEntity[ChatEventPhotoChanged](decoder)
+
+	case ConstructorChatEventSlowModeDelayChanged:
+		return decodeEntity[ChatEventSlowModeDelayChanged](decoder)
+
+	case ConstructorChatEventStickerSetChanged:
+		return decodeEntity[ChatEventStickerSetChanged](decoder)
+
+	case ConstructorChatEventCustomEmojiStickerSetChanged:
+		return decodeEntity[ChatEventCustomEmojiStickerSetChanged](decoder)
+
+	case ConstructorChatEventTitleChanged:
+		return decodeEntity[ChatEventTitleChanged](decoder)
+
+	case ConstructorChatEventUsernameChanged:
+		return decodeEntity[ChatEventUsernameChanged](decoder)
+
+	case ConstructorChatEventActiveUsernamesChanged:
+		return decodeEntity[ChatEventActiveUsernamesChanged](decoder)
+
+	case ConstructorChatEventAccentColorChanged:
+		return decodeEntity[ChatEventAccentColorChanged](decoder)
+
+	case ConstructorChatEventProfileAccentColorChanged:
+		return decodeEntity[ChatEventProfileAccentColorChanged](decoder)
+
+	case ConstructorChatEventHasProtectedContentToggled:
+		return decodeEntity[ChatEventHasProtectedContentToggled](decoder)
+
+	case ConstructorChatEventInvitesToggled:
+		return decodeEntity[ChatEventInvitesToggled](decoder)
+
+	case ConstructorChatEventIsAllHistoryAvailableToggled:
+		return decodeEntity[ChatEventIsAllHistoryAvailableToggled](decoder)
+
+	case ConstructorChatEventHasAggressiveAntiSpamEnabledToggled:
+		return decodeEntity[ChatEventHasAggressiveAntiSpamEnabledToggled](decoder)
+
+	case ConstructorChatEventSignMessagesToggled:
+		return decodeEntity[ChatEventSignMessagesToggled](decoder)
+
+	case ConstructorChatEventShowMessageSenderToggled:
+		return decodeEntity[ChatEventShowMessageSenderToggled](decoder)
+
+	case ConstructorChatEventInviteLinkEdited:
+		return decodeEntity[ChatEventInviteLinkEdited](decoder)
+
+	case ConstructorChatEventInviteLinkRevoked:
+		return decodeEntity[ChatEventInviteLinkRevoked](decoder)
+
+	case ConstructorChatEventInviteLinkDeleted:
+		return decodeEntity[ChatEventInviteLinkDeleted](decoder)
+
+	case ConstructorChatEventVideoChatCreated:
+		return decodeEntity[ChatEventVideoChatCreated](decoder)
+
+	case ConstructorChatEventVideoChatEnded:
+		return decodeEntity[ChatEventVideoChatEnded](decoder)
+
+	case ConstructorChatEventVideoChatMuteNewParticipantsToggled:
+		return decodeEntity[ChatEventVideoChatMuteNewParticipantsToggled](decoder)
+
+	case ConstructorChatEventVideoChatParticipantIsMutedToggled:
+		return decodeEntity[ChatEventVideoChatParticipantIsMutedToggled](decoder)
+
+	case ConstructorChatEventVideoChatParticipantVolumeLevelChanged:
+		return decodeEntity[ChatEventVideoChatParticipantVolumeLevelChanged](decoder)
+
+	case ConstructorChatEventIsForumToggled:
+		return decodeEntity[ChatEventIsForumToggled](decoder)
+
+	case ConstructorChatEventForumTopicCreated:
+		return decodeEntity[ChatEventForumTopicCreated](decoder)
+
+	case ConstructorChatEventForumTopicEdited:
+		return decodeEntity[ChatEventForumTopicEdited](decoder)
+
+	case ConstructorChatEventForumTopicToggleIsClosed:
+		return decodeEntity[ChatEventForumTopicToggleIsClosed](decoder)
+
+	case ConstructorChatEventForumTopicToggleIsHidden:
+		return decodeEntity[ChatEventForumTopicToggleIsHidden](decoder)
+
+	case ConstructorChatEventForumTopicDeleted:
+		return decodeEntity[ChatEventForumTopicDeleted](decoder)
+
+	case ConstructorChatEventForumTopicPinned:
+		return decodeEntity[ChatEventForumTopicPinned](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatEventAction(dataList []json.RawMessage) ([]ChatEventAction, error) {
+	list := make([]ChatEventAction, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatEventAction(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCheckChatUsernameResult(data json.RawMessage) (CheckChatUsernameResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCheckChatUsernameResult)
+}
+
+func decodeCheckChatUsernameResult(decoder *jsonDecoder) (CheckChatUsernameResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCheckChatUsernameResultOk:
+		return decodeEntity[CheckChatUsernameResultOk](decoder)
+
+	case ConstructorCheckChatUsernameResultUsernameInvalid:
+		return decodeEntity[CheckChatUsernameResultUsernameInvalid](decoder)
+
+	case ConstructorCheckChatUsernameResultUsernameOccupied:
+		return decodeEntity[CheckChatUsernameResultUsernameOccupied](decoder)
+
+	case ConstructorCheckChatUsernameResultUsernamePurchasable:
+		return decodeEntity[CheckChatUsernameResultUsernamePurchasable](decoder)
+
+	case ConstructorCheckChatUsernameResultPublicChatsTooMany:
+		return decodeEntity[CheckChatUsernameResultPublicChatsTooMany](decoder)
+
+	case ConstructorCheckChatUsernameResultPublicGroupsUnavailable:
+		return decodeEntity[CheckChatUsernameResultPublicGroupsUnavailable](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCheckChatUsernameResult(dataList []json.RawMessage) ([]CheckChatUsernameResult, error) {
+	list := make([]CheckChatUsernameResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCheckChatUsernameResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalNotificationGroupType(data json.RawMessage) (NotificationGroupType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeNotificationGroupType)
+}
+
+func decodeNotificationGroupType(decoder *jsonDecoder) (NotificationGroupType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorNotificationGroupTypeMessages:
+		return decodeEntity[NotificationGroupTypeMessages](decoder)
+
+	case ConstructorNotificationGroupTypeMentions:
+		return decodeEntity[NotificationGroupTypeMentions](decoder)
+
+	case ConstructorNotificationGroupTypeSecretChat:
+		return decodeEntity[NotificationGroupTypeSecretChat](decoder)
+
+	case ConstructorNotificationGroupTypeCalls:
+		return decodeEntity[NotificationGroupTypeCalls](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfNotificationGroupType(dataList []json.RawMessage) ([]NotificationGroupType, error) {
+	list := make([]NotificationGroupType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalNotificationGroupType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReportChatResult(data json.RawMessage) (ReportChatResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReportChatResult)
+}
+
+func decodeReportChatResult(decoder *jsonDecoder) (ReportChatResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReportChatResultOk:
+		return decodeEntity[ReportChatResultOk](decoder)
+
+	case ConstructorReportChatResultOptionRequired:
+		return decodeEntity[ReportChatResultOptionRequired](decoder)
+
+	case ConstructorReportChatResultTextRequired:
+		return decodeEntity[ReportChatResultTextRequired](decoder)
+
+	case ConstructorReportChatResultMessagesRequired:
+		return decodeEntity[ReportChatResultMessagesRequired](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReportChatResult(dataList []json.RawMessage) ([]ReportChatResult, error) {
+	list := make([]ReportChatResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReportChatResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalTopChatCategory(data json.RawMessage) (TopChatCategory, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeTopChatCategory)
+}
+
+func decodeTopChatCategory(decoder *jsonDecoder) (TopChatCategory, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorTopChatCategoryUsers:
+		return decodeEntity[TopChatCategoryUsers](decoder)
+
+	case ConstructorTopChatCategoryBots:
+		return decodeEntity[TopChatCategoryBots](decoder)
+
+	case ConstructorTopChatCategoryGroups:
+		return decodeEntity[TopChatCategoryGroups](decoder)
+
+	case ConstructorTopChatCategoryChannels:
+		return decodeEntity[TopChatCategoryChannels](decoder)
+
+	case ConstructorTopChatCategoryInlineBots:
+		return decodeEntity[TopChatCategoryInlineBots](decoder)
+
+	case ConstructorTopChatCategoryWebAppBots:
+		return decodeEntity[TopChatCategoryWebAppBots](decoder)
+
+	case ConstructorTopChatCategoryCalls:
+		return decodeEntity[TopChatCategoryCalls](decoder)
+
+	case ConstructorTopChatCategoryForwardChats:
+		return decodeEntity[TopChatCategoryForwardChats](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfTopChatCategory(dataList []json.RawMessage) ([]TopChatCategory, error) {
+	list := make([]TopChatCategory, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalTopChatCategory(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatStatisticsObjectType(data json.RawMessage) (ChatStatisticsObjectType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatStatisticsObjectType)
+}
+
+func decodeChatStatisticsObjectType(decoder *jsonDecoder) (ChatStatisticsObjectType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatStatisticsObjectTypeMessage:
+		return decodeEntity[ChatStatisticsObjectTypeMessage](decoder)
+
+	case ConstructorChatStatisticsObjectTypeStory:
+		return decodeEntity[ChatStatisticsObjectTypeStory](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatStatisticsObjectType(dataList []json.RawMessage) ([]ChatStatisticsObjectType, error) {
+	list := make([]ChatStatisticsObjectType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatStatisticsObjectType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatStatistics(data json.RawMessage) (ChatStatistics, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatStatistics)
+}
+
+func decodeChatStatistics(decoder *jsonDecoder) (ChatStatistics, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatStatisticsSupergroup:
+		return decodeEntity[ChatStatisticsSupergroup](decoder)
+
+	case ConstructorChatStatisticsChannel:
+		return decodeEntity[ChatStatisticsChannel](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatStatistics(dataList []json.RawMessage) ([]ChatStatistics, error) {
+	list := make([]ChatStatistics, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatStatistics(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatBackground(data json.RawMessage) (*ChatBackground, error) {
+	var resp ChatBackground
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPhotoInfo(data json.RawMessage) (*ChatPhotoInfo, error) {
+	var resp ChatPhotoInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatLocation(data json.RawMessage) (*ChatLocation, error) {
+	var resp ChatLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAnimatedChatPhoto(data json.RawMessage) (*AnimatedChatPhoto, error) {
+	var resp AnimatedChatPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPhoto(data json.RawMessage) (*ChatPhoto, error) {
+	var resp ChatPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPhotos(data json.RawMessage) (*ChatPhotos, error) {
+	var resp ChatPhotos
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputChatPhotoPrevious(data json.RawMessage) (*InputChatPhotoPrevious, error) {
+	var resp InputChatPhotoPrevious
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputChatPhotoStatic(data json.RawMessage) (*InputChatPhotoStatic, error) {
+	var resp InputChatPhotoStatic
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputChatPhotoAnimation(data json.RawMessage) (*InputChatPhotoAnimation, error) {
+	var resp InputChatPhotoAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputChatPhotoSticker(data json.RawMessage) (*InputChatPhotoSticker, error) {
+	var resp InputChatPhotoSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPermissions(data json.RawMessage) (*ChatPermissions, error) {
+	var resp ChatPermissions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatAdministratorRights(data json.RawMessage) (*ChatAdministratorRights, error) {
+	var resp ChatAdministratorRights
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatAdministrator(data json.RawMessage) (*ChatAdministrator, error) {
+	var resp ChatAdministrator
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatAdministrators(data json.RawMessage) (*ChatAdministrators, error) {
+	var resp ChatAdministrators
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMemberStatusCreator(data json.RawMessage) (*ChatMemberStatusCreator, error) {
+	var resp ChatMemberStatusCreator
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMemberStatusAdministrator(data json.RawMessage) (*ChatMemberStatusAdministrator, error) {
+	var resp ChatMemberStatusAdministrator
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMemberStatusMember(data json.RawMessage) (*ChatMemberStatusMember, error) {
+	var resp ChatMemberStatusMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMemberStatusRestricted(data json.RawMessage) (*ChatMemberStatusRestricted, error) {
+	var resp ChatMemberStatusRestricted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMemberStatusLeft(data json.RawMessage) (*ChatMemberStatusLeft, error) {
+	var resp ChatMemberStatusLeft
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMemberStatusBanned(data json.RawMessage) (*ChatMemberStatusBanned, error) {
+	var resp ChatMemberStatusBanned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMember(data json.RawMessage) (*ChatMember, error) {
+	var resp ChatMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembers(data json.RawMessage) (*ChatMembers, error) {
+	var resp ChatMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembersFilterContacts(data json.RawMessage) (*ChatMembersFilterContacts, error) {
+	var resp ChatMembersFilterContacts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembersFilterAdministrators(data json.RawMessage) (*ChatMembersFilterAdministrators, error) {
+	var resp ChatMembersFilterAdministrators
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembersFilterMembers(data json.RawMessage) (*ChatMembersFilterMembers, error) {
+	var resp ChatMembersFilterMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembersFilterMention(data json.RawMessage) (*ChatMembersFilterMention, error) {
+	var resp ChatMembersFilterMention
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembersFilterRestricted(data json.RawMessage) (*ChatMembersFilterRestricted, error) {
+	var resp ChatMembersFilterRestricted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembersFilterBanned(data json.RawMessage) (*ChatMembersFilterBanned, error) {
+	var resp ChatMembersFilterBanned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMembersFilterBots(data json.RawMessage) (*ChatMembersFilterBots, error) {
+	var resp ChatMembersFilterBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterRecent(data json.RawMessage) (*SupergroupMembersFilterRecent, error) {
+	var resp SupergroupMembersFilterRecent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterContacts(data json.RawMessage) (*SupergroupMembersFilterContacts, error) {
+	var resp SupergroupMembersFilterContacts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterAdministrators(data json.RawMessage) (*SupergroupMembersFilterAdministrators, error) {
+	var resp SupergroupMembersFilterAdministrators
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterSearch(data json.RawMessage) (*SupergroupMembersFilterSearch, error) {
+	var resp SupergroupMembersFilterSearch
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterRestricted(data json.RawMessage) (*SupergroupMembersFilterRestricted, error) {
+	var resp SupergroupMembersFilterRestricted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterBanned(data json.RawMessage) (*SupergroupMembersFilterBanned, error) {
+	var resp SupergroupMembersFilterBanned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterMention(data json.RawMessage) (*SupergroupMembersFilterMention, error) {
+	var resp SupergroupMembersFilterMention
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupMembersFilterBots(data json.RawMessage) (*SupergroupMembersFilterBots, error) {
+	var resp SupergroupMembersFilterBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLink(data json.RawMessage) (*ChatInviteLink, error) {
+	var resp ChatInviteLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLinks(data json.RawMessage) (*ChatInviteLinks, error) {
+	var resp ChatInviteLinks
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLinkCount(data json.RawMessage) (*ChatInviteLinkCount, error) {
+	var resp ChatInviteLinkCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLinkCounts(data json.RawMessage) (*ChatInviteLinkCounts, error) {
+	var resp ChatInviteLinkCounts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLinkMember(data json.RawMessage) (*ChatInviteLinkMember, error) {
+	var resp ChatInviteLinkMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLinkMembers(data json.RawMessage) (*ChatInviteLinkMembers, error) {
+	var resp ChatInviteLinkMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInviteLinkChatTypeBasicGroup(data json.RawMessage) (*InviteLinkChatTypeBasicGroup, error) {
+	var resp InviteLinkChatTypeBasicGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInviteLinkChatTypeSupergroup(data json.RawMessage) (*InviteLinkChatTypeSupergroup, error) {
+	var resp InviteLinkChatTypeSupergroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInviteLinkChatTypeChannel(data json.RawMessage) (*InviteLinkChatTypeChannel, error) {
+	var resp InviteLinkChatTypeChannel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLinkInfo(data json.RawMessage) (*ChatInviteLinkInfo, error) {
+	var resp ChatInviteLinkInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatJoinRequest(data json.RawMessage) (*ChatJoinRequest, error) {
+	var resp ChatJoinRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatJoinRequests(data json.RawMessage) (*ChatJoinRequests, error) {
+	var resp ChatJoinRequests
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatJoinRequestsInfo(data json.RawMessage) (*ChatJoinRequestsInfo, error) {
+	var resp ChatJoinRequestsInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBasicGroup(data json.RawMessage) (*BasicGroup, error) {
+	var resp BasicGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBasicGroupFullInfo(data json.RawMessage) (*BasicGroupFullInfo, error) {
+	var resp BasicGroupFullInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroup(data json.RawMessage) (*Supergroup, error) {
+	var resp Supergroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSupergroupFullInfo(data json.RawMessage) (*SupergroupFullInfo, error) {
+	var resp SupergroupFullInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSecretChatStatePending(data json.RawMessage) (*SecretChatStatePending, error) {
+	var resp SecretChatStatePending
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSecretChatStateReady(data json.RawMessage) (*SecretChatStateReady, error) {
+	var resp SecretChatStateReady
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSecretChatStateClosed(data json.RawMessage) (*SecretChatStateClosed, error) {
+	var resp SecretChatStateClosed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSecretChat(data json.RawMessage) (*SecretChat, error) {
+	var resp SecretChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSponsoredChat(data json.RawMessage) (*SponsoredChat, error) {
+	var resp SponsoredChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSponsoredChats(data json.RawMessage) (*SponsoredChats, error) {
+	var resp SponsoredChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatNotificationSettings(data json.RawMessage) (*ChatNotificationSettings, error) {
+	var resp ChatNotificationSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatTypePrivate(data json.RawMessage) (*ChatTypePrivate, error) {
+	var resp ChatTypePrivate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatTypeBasicGroup(data json.RawMessage) (*ChatTypeBasicGroup, error) {
+	var resp ChatTypeBasicGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatTypeSupergroup(data json.RawMessage) (*ChatTypeSupergroup, error) {
+	var resp ChatTypeSupergroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatTypeSecret(data json.RawMessage) (*ChatTypeSecret, error) {
+	var resp ChatTypeSecret
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatFolderIcon(data json.RawMessage) (*ChatFolderIcon, error) {
+	var resp ChatFolderIcon
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatFolderName(data json.RawMessage) (*ChatFolderName, error) {
+	var resp ChatFolderName
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatFolder(data json.RawMessage) (*ChatFolder, error) {
+	var resp ChatFolder
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatFolderInfo(data json.RawMessage) (*ChatFolderInfo, error) {
+	var resp ChatFolderInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatFolderInviteLink(data json.RawMessage) (*ChatFolderInviteLink, error) {
+	var resp ChatFolderInviteLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatFolderInviteLinks(data json.RawMessage) (*ChatFolderInviteLinks, error) {
+	var resp ChatFolderInviteLinks
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatFolderInviteLinkInfo(data json.RawMessage) (*ChatFolderInviteLinkInfo, error) {
+	var resp ChatFolderInviteLinkInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRecommendedChatFolder(data json.RawMessage) (*RecommendedChatFolder, error) {
+	var resp RecommendedChatFolder
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRecommendedChatFolders(data json.RawMessage) (*RecommendedChatFolders, error) {
+	var resp RecommendedChatFolders
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalArchiveChatListSettings(data json.RawMessage) (*ArchiveChatListSettings, error) {
+	var resp ArchiveChatListSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatListMain(data json.RawMessage) (*ChatListMain, error) {
+	var resp ChatListMain
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatListArchive(data json.RawMessage) (*ChatListArchive, error) {
+	var resp ChatListArchive
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatListFolder(data json.RawMessage) (*ChatListFolder, error) {
+	var resp ChatListFolder
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatLists(data json.RawMessage) (*ChatLists, error) {
+	var resp ChatLists
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatSourceMtprotoProxy(data json.RawMessage) (*ChatSourceMtprotoProxy, error) {
+	var resp ChatSourceMtprotoProxy
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatSourcePublicServiceAnnouncement(data json.RawMessage) (*ChatSourcePublicServiceAnnouncement, error) {
+	var resp ChatSourcePublicServiceAnnouncement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPosition(data json.RawMessage) (*ChatPosition, error) {
+	var resp ChatPosition
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVideoChat(data json.RawMessage) (*VideoChat, error) {
+	var resp VideoChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChat(data json.RawMessage) (*Chat, error) {
+	var resp Chat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChats(data json.RawMessage) (*Chats, error) {
+	var resp Chats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCreatedBasicGroupChat(data json.RawMessage) (*CreatedBasicGroupChat, error) {
+	var resp CreatedBasicGroupChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPublicChatTypeHasUsername(data json.RawMessage) (*PublicChatTypeHasUsername, error) {
+	var resp PublicChatTypeHasUsername
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPublicChatTypeIsLocationBased(data json.RawMessage) (*PublicChatTypeIsLocationBased, error) {
+	var resp PublicChatTypeIsLocationBased
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionBarReportSpam(data json.RawMessage) (*ChatActionBarReportSpam, error) {
+	var resp ChatActionBarReportSpam
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionBarInviteMembers(data json.RawMessage) (*ChatActionBarInviteMembers, error) {
+	var resp ChatActionBarInviteMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionBarReportAddBlock(data json.RawMessage) (*ChatActionBarReportAddBlock, error) {
+	var resp ChatActionBarReportAddBlock
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionBarAddContact(data json.RawMessage) (*ChatActionBarAddContact, error) {
+	var resp ChatActionBarAddContact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionBarSharePhoneNumber(data json.RawMessage) (*ChatActionBarSharePhoneNumber, error) {
+	var resp ChatActionBarSharePhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionBarJoinRequest(data json.RawMessage) (*ChatActionBarJoinRequest, error) {
+	var resp ChatActionBarJoinRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalForumTopicIcon(data json.RawMessage) (*ForumTopicIcon, error) {
+	var resp ForumTopicIcon
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalForumTopicInfo(data json.RawMessage) (*ForumTopicInfo, error) {
+	var resp ForumTopicInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalForumTopic(data json.RawMessage) (*ForumTopic, error) {
+	var resp ForumTopic
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalForumTopics(data json.RawMessage) (*ForumTopics, error) {
+	var resp ForumTopics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSharedChat(data json.RawMessage) (*SharedChat, error) {
+	var resp SharedChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionTyping(data json.RawMessage) (*ChatActionTyping, error) {
+	var resp ChatActionTyping
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionRecordingVideo(data json.RawMessage) (*ChatActionRecordingVideo, error) {
+	var resp ChatActionRecordingVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionUploadingVideo(data json.RawMessage) (*ChatActionUploadingVideo, error) {
+	var resp ChatActionUploadingVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionRecordingVoiceNote(data json.RawMessage) (*ChatActionRecordingVoiceNote, error) {
+	var resp ChatActionRecordingVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionUploadingVoiceNote(data json.RawMessage) (*ChatActionUploadingVoiceNote, error) {
+	var resp ChatActionUploadingVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionUploadingPhoto(data json.RawMessage) (*ChatActionUploadingPhoto, error) {
+	var resp ChatActionUploadingPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionUploadingDocument(data json.RawMessage) (*ChatActionUploadingDocument, error) {
+	var resp ChatActionUploadingDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionChoosingSticker(data json.RawMessage) (*ChatActionChoosingSticker, error) {
+	var resp ChatActionChoosingSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionChoosingLocation(data json.RawMessage) (*ChatActionChoosingLocation, error) {
+	var resp ChatActionChoosingLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionChoosingContact(data json.RawMessage) (*ChatActionChoosingContact, error) {
+	var resp ChatActionChoosingContact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionStartPlayingGame(data json.RawMessage) (*ChatActionStartPlayingGame, error) {
+	var resp ChatActionStartPlayingGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionRecordingVideoNote(data json.RawMessage) (*ChatActionRecordingVideoNote, error) {
+	var resp ChatActionRecordingVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionUploadingVideoNote(data json.RawMessage) (*ChatActionUploadingVideoNote, error) {
+	var resp ChatActionUploadingVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionWatchingAnimations(data json.RawMessage) (*ChatActionWatchingAnimations, error) {
+	var resp ChatActionWatchingAnimations
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActionCancel(data json.RawMessage) (*ChatActionCancel, error) {
+	var resp ChatActionCancel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTargetChatTypes(data json.RawMessage) (*TargetChatTypes, error) {
+	var resp TargetChatTypes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTargetChatCurrent(data json.RawMessage) (*TargetChatCurrent, error) {
+	var resp TargetChatCurrent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTargetChatChosen(data json.RawMessage) (*TargetChatChosen, error) {
+	var resp TargetChatChosen
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTargetChatInternalLink(data json.RawMessage) (*TargetChatInternalLink, error) {
+	var resp TargetChatInternalLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMessageEdited(data json.RawMessage) (*ChatEventMessageEdited, error) {
+	var resp ChatEventMessageEdited
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMessageDeleted(data json.RawMessage) (*ChatEventMessageDeleted, error) {
+	var resp ChatEventMessageDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMessagePinned(data json.RawMessage) (*ChatEventMessagePinned, error) {
+	var resp ChatEventMessagePinned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMessageUnpinned(data json.RawMessage) (*ChatEventMessageUnpinned, error) {
+	var resp ChatEventMessageUnpinned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventPollStopped(data json.RawMessage) (*ChatEventPollStopped, error) {
+	var resp ChatEventPollStopped
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberJoined(data json.RawMessage) (*ChatEventMemberJoined, error) {
+	var resp ChatEventMemberJoined
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberJoinedByInviteLink(data json.RawMessage) (*ChatEventMemberJoinedByInviteLink, error) {
+	var resp ChatEventMemberJoinedByInviteLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberJoinedByRequest(data json.RawMessage) (*ChatEventMemberJoinedByRequest, error) {
+	var resp ChatEventMemberJoinedByRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberInvited(data json.RawMessage) (*ChatEventMemberInvited, error) {
+	var resp ChatEventMemberInvited
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberLeft(data json.RawMessage) (*ChatEventMemberLeft, error) {
+	var resp ChatEventMemberLeft
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberPromoted(data json.RawMessage) (*ChatEventMemberPromoted, error) {
+	var resp ChatEventMemberPromoted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberRestricted(data json.RawMessage) (*ChatEventMemberRestricted, error) {
+	var resp ChatEventMemberRestricted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMemberSubscriptionExtended(data json.RawMessage) (*ChatEventMemberSubscriptionExtended, error) {
+	var resp ChatEventMemberSubscriptionExtended
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventAvailableReactionsChanged(data json.RawMessage) (*ChatEventAvailableReactionsChanged, error) {
+	var resp ChatEventAvailableReactionsChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventBackgroundChanged(data json.RawMessage) (*ChatEventBackgroundChanged, error) {
+	var resp ChatEventBackgroundChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventDescriptionChanged(data json.RawMessage) (*ChatEventDescriptionChanged, error) {
+	var resp ChatEventDescriptionChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventEmojiStatusChanged(data json.RawMessage) (*ChatEventEmojiStatusChanged, error) {
+	var resp ChatEventEmojiStatusChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventLinkedChatChanged(data json.RawMessage) (*ChatEventLinkedChatChanged, error) {
+	var resp ChatEventLinkedChatChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventLocationChanged(data json.RawMessage) (*ChatEventLocationChanged, error) {
+	var resp ChatEventLocationChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventMessageAutoDeleteTimeChanged(data json.RawMessage) (*ChatEventMessageAutoDeleteTimeChanged, error) {
+	var resp ChatEventMessageAutoDeleteTimeChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventPermissionsChanged(data json.RawMessage) (*ChatEventPermissionsChanged, error) {
+	var resp ChatEventPermissionsChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventPhotoChanged(data json.RawMessage) (*ChatEventPhotoChanged, error) {
+	var resp ChatEventPhotoChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventSlowModeDelayChanged(data json.RawMessage) (*ChatEventSlowModeDelayChanged, error) {
+	var resp ChatEventSlowModeDelayChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventStickerSetChanged(data json.RawMessage) (*ChatEventStickerSetChanged, error) {
+	var resp ChatEventStickerSetChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventCustomEmojiStickerSetChanged(data json.RawMessage) (*ChatEventCustomEmojiStickerSetChanged, error) {
+	var resp ChatEventCustomEmojiStickerSetChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventTitleChanged(data json.RawMessage) (*ChatEventTitleChanged, error) {
+	var resp ChatEventTitleChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventUsernameChanged(data json.RawMessage) (*ChatEventUsernameChanged, error) {
+	var resp ChatEventUsernameChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventActiveUsernamesChanged(data json.RawMessage) (*ChatEventActiveUsernamesChanged, error) {
+	var resp ChatEventActiveUsernamesChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventAccentColorChanged(data json.RawMessage) (*ChatEventAccentColorChanged, error) {
+	var resp ChatEventAccentColorChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventProfileAccentColorChanged(data json.RawMessage) (*ChatEventProfileAccentColorChanged, error) {
+	var resp ChatEventProfileAccentColorChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventHasProtectedContentToggled(data json.RawMessage) (*ChatEventHasProtectedContentToggled, error) {
+	var resp ChatEventHasProtectedContentToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventInvitesToggled(data json.RawMessage) (*ChatEventInvitesToggled, error) {
+	var resp ChatEventInvitesToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventIsAllHistoryAvailableToggled(data json.RawMessage) (*ChatEventIsAllHistoryAvailableToggled, error) {
+	var resp ChatEventIsAllHistoryAvailableToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventHasAggressiveAntiSpamEnabledToggled(data json.RawMessage) (*ChatEventHasAggressiveAntiSpamEnabledToggled, error) {
+	var resp ChatEventHasAggressiveAntiSpamEnabledToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventSignMessagesToggled(data json.RawMessage) (*ChatEventSignMessagesToggled, error) {
+	var resp ChatEventSignMessagesToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventShowMessageSenderToggled(data json.RawMessage) (*ChatEventShowMessageSenderToggled, error) {
+	var resp ChatEventShowMessageSenderToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventInviteLinkEdited(data json.RawMessage) (*ChatEventInviteLinkEdited, error) {
+	var resp ChatEventInviteLinkEdited
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventInviteLinkRevoked(data json.RawMessage) (*ChatEventInviteLinkRevoked, error) {
+	var resp ChatEventInviteLinkRevoked
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventInviteLinkDeleted(data json.RawMessage) (*ChatEventInviteLinkDeleted, error) {
+	var resp ChatEventInviteLinkDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventVideoChatCreated(data json.RawMessage) (*ChatEventVideoChatCreated, error) {
+	var resp ChatEventVideoChatCreated
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventVideoChatEnded(data json.RawMessage) (*ChatEventVideoChatEnded, error) {
+	var resp ChatEventVideoChatEnded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventVideoChatMuteNewParticipantsToggled(data json.RawMessage) (*ChatEventVideoChatMuteNewParticipantsToggled, error) {
+	var resp ChatEventVideoChatMuteNewParticipantsToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventVideoChatParticipantIsMutedToggled(data json.RawMessage) (*ChatEventVideoChatParticipantIsMutedToggled, error) {
+	var resp ChatEventVideoChatParticipantIsMutedToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventVideoChatParticipantVolumeLevelChanged(data json.RawMessage) (*ChatEventVideoChatParticipantVolumeLevelChanged, error) {
+	var resp ChatEventVideoChatParticipantVolumeLevelChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventIsForumToggled(data json.RawMessage) (*ChatEventIsForumToggled, error) {
+	var resp ChatEventIsForumToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventForumTopicCreated(data json.RawMessage) (*ChatEventForumTopicCreated, error) {
+	var resp ChatEventForumTopicCreated
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventForumTopicEdited(data json.RawMessage) (*ChatEventForumTopicEdited, error) {
+	var resp ChatEventForumTopicEdited
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventForumTopicToggleIsClosed(data json.RawMessage) (*ChatEventForumTopicToggleIsClosed, error) {
+	var resp ChatEventForumTopicToggleIsClosed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventForumTopicToggleIsHidden(data json.RawMessage) (*ChatEventForumTopicToggleIsHidden, error) {
+	var resp ChatEventForumTopicToggleIsHidden
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventForumTopicDeleted(data json.RawMessage) (*ChatEventForumTopicDeleted, error) {
+	var resp ChatEventForumTopicDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventForumTopicPinned(data json.RawMessage) (*ChatEventForumTopicPinned, error) {
+	var resp ChatEventForumTopicPinned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEvent(data json.RawMessage) (*ChatEvent, error) {
+	var resp ChatEvent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEvents(data json.RawMessage) (*ChatEvents, error) {
+	var resp ChatEvents
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatEventLogFilters(data json.RawMessage) (*ChatEventLogFilters, error) {
+	var resp ChatEventLogFilters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatTheme(data json.RawMessage) (*ChatTheme, error) {
+	var resp ChatTheme
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckChatUsernameResultOk(data json.RawMessage) (*CheckChatUsernameResultOk, error) {
+	var resp CheckChatUsernameResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckChatUsernameResultUsernameInvalid(data json.RawMessage) (*CheckChatUsernameResultUsernameInvalid, error) {
+	var resp CheckChatUsernameResultUsernameInvalid
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckChatUsernameResultUsernameOccupied(data json.RawMessage) (*CheckChatUsernameResultUsernameOccupied, error) {
+	var resp CheckChatUsernameResultUsernameOccupied
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckChatUsernameResultUsernamePurchasable(data json.RawMessage) (*CheckChatUsernameResultUsernamePurchasable, error) {
+	var resp CheckChatUsernameResultUsernamePurchasable
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckChatUsernameResultPublicChatsTooMany(data json.RawMessage) (*CheckChatUsernameResultPublicChatsTooMany, error) {
+	var resp CheckChatUsernameResultPublicChatsTooMany
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckChatUsernameResultPublicGroupsUnavailable(data json.RawMessage) (*CheckChatUsernameResultPublicGroupsUnavailable, error) {
+	var resp CheckChatUsernameResultPublicGroupsUnavailable
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationGroupTypeMessages(data json.RawMessage) (*NotificationGroupTypeMessages, error) {
+	var resp NotificationGroupTypeMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationGroupTypeMentions(data json.RawMessage) (*NotificationGroupTypeMentions, error) {
+	var resp NotificationGroupTypeMentions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationGroupTypeSecretChat(data json.RawMessage) (*NotificationGroupTypeSecretChat, error) {
+	var resp NotificationGroupTypeSecretChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationGroupTypeCalls(data json.RawMessage) (*NotificationGroupTypeCalls, error) {
+	var resp NotificationGroupTypeCalls
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationGroup(data json.RawMessage) (*NotificationGroup, error) {
+	var resp NotificationGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNewChatPrivacySettings(data json.RawMessage) (*NewChatPrivacySettings, error) {
+	var resp NewChatPrivacySettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportChatResultOk(data json.RawMessage) (*ReportChatResultOk, error) {
+	var resp ReportChatResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportChatResultOptionRequired(data json.RawMessage) (*ReportChatResultOptionRequired, error) {
+	var resp ReportChatResultOptionRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportChatResultTextRequired(data json.RawMessage) (*ReportChatResultTextRequired, error) {
+	var resp ReportChatResultTextRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportChatResultMessagesRequired(data json.RawMessage) (*ReportChatResultMessagesRequired, error) {
+	var resp ReportChatResultMessagesRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorageStatisticsByChat(data json.RawMessage) (*StorageStatisticsByChat, error) {
+	var resp StorageStatisticsByChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryUsers(data json.RawMessage) (*TopChatCategoryUsers, error) {
+	var resp TopChatCategoryUsers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryBots(data json.RawMessage) (*TopChatCategoryBots, error) {
+	var resp TopChatCategoryBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryGroups(data json.RawMessage) (*TopChatCategoryGroups, error) {
+	var resp TopChatCategoryGroups
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryChannels(data json.RawMessage) (*TopChatCategoryChannels, error) {
+	var resp TopChatCategoryChannels
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryInlineBots(data json.RawMessage) (*TopChatCategoryInlineBots, error) {
+	var resp TopChatCategoryInlineBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryWebAppBots(data json.RawMessage) (*TopChatCategoryWebAppBots, error) {
+	var resp TopChatCategoryWebAppBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryCalls(data json.RawMessage) (*TopChatCategoryCalls, error) {
+	var resp TopChatCategoryCalls
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTopChatCategoryForwardChats(data json.RawMessage) (*TopChatCategoryForwardChats, error) {
+	var resp TopChatCategoryForwardChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsObjectTypeMessage(data json.RawMessage) (*ChatStatisticsObjectTypeMessage, error) {
+	var resp ChatStatisticsObjectTypeMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsObjectTypeStory(data json.RawMessage) (*ChatStatisticsObjectTypeStory, error) {
+	var resp ChatStatisticsObjectTypeStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsInteractionInfo(data json.RawMessage) (*ChatStatisticsInteractionInfo, error) {
+	var resp ChatStatisticsInteractionInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsAdministratorActionsInfo(data json.RawMessage) (*ChatStatisticsAdministratorActionsInfo, error) {
+	var resp ChatStatisticsAdministratorActionsInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsInviterInfo(data json.RawMessage) (*ChatStatisticsInviterInfo, error) {
+	var resp ChatStatisticsInviterInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsSupergroup(data json.RawMessage) (*ChatStatisticsSupergroup, error) {
+	var resp ChatStatisticsSupergroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsChannel(data json.RawMessage) (*ChatStatisticsChannel, error) {
+	var resp ChatStatisticsChannel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
@@ -0,0 +1,9165 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalEmailAddressResetState(data json.RawMessage) (EmailAddressResetState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeEmailAddressResetState)
+}
+
+func decodeEmailAddressResetState(decoder *jsonDecoder) (EmailAddressResetState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorEmailAddressResetStateAvailable:
+		return decodeEntity[EmailAddressResetStateAvailable](decoder)
+
+	case ConstructorEmailAddressResetStatePending:
+		return decodeEntity[EmailAddressResetStatePending](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfEmailAddressResetState(dataList []json.RawMessage) ([]EmailAddressResetState, error) {
+	list := make([]EmailAddressResetState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalEmailAddressResetState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalFirebaseDeviceVerificationParameters(data json.RawMessage) (FirebaseDeviceVerificationParameters, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeFirebaseDeviceVerificationParameters)
+}
+
+func decodeFirebaseDeviceVerificationParameters(decoder *jsonDecoder) (FirebaseDeviceVerificationParameters, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorFirebaseDeviceVerificationParametersSafetyNet:
+		return decodeEntity[FirebaseDeviceVerificationParametersSafetyNet](decoder)
+
+	case ConstructorFirebaseDeviceVerificationParametersPlayIntegrity:
+		return decodeEntity[FirebaseDeviceVerificationParametersPlayIntegrity](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfFirebaseDeviceVerificationParameters(dataList []json.RawMessage) ([]FirebaseDeviceVerificationParameters, error) {
+	list := make([]FirebaseDeviceVerificationParameters, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalFirebaseDeviceVerificationParameters(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMaskPoint(data json.RawMessage) (MaskPoint, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMaskPoint)
+}
+
+func decodeMaskPoint(decoder *jsonDecoder) (MaskPoint, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMaskPointForehead:
+		return decodeEntity[MaskPointForehead](decoder)
+
+	case ConstructorMaskPointEyes:
+		return decodeEntity[MaskPointEyes](decoder)
+
+	case ConstructorMaskPointMouth:
+		return decodeEntity[MaskPointMouth](decoder)
+
+	case ConstructorMaskPointChin:
+		return decodeEntity[MaskPointChin](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMaskPoint(dataList []json.RawMessage) ([]MaskPoint, error) {
+	list := make([]MaskPoint, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMaskPoint(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReportSponsoredResult(data json.RawMessage) (ReportSponsoredResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReportSponsoredResult)
+}
+
+func decodeReportSponsoredResult(decoder *jsonDecoder) (ReportSponsoredResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReportSponsoredResultOk:
+		return decodeEntity[ReportSponsoredResultOk](decoder)
+
+	case ConstructorReportSponsoredResultFailed:
+		return decodeEntity[ReportSponsoredResultFailed](decoder)
+
+	case ConstructorReportSponsoredResultOptionRequired:
+		return decodeEntity[ReportSponsoredResultOptionRequired](decoder)
+
+	case ConstructorReportSponsoredResultAdsHidden:
+		return decodeEntity[ReportSponsoredResultAdsHidden](decoder)
+
+	case ConstructorReportSponsoredResultPremiumRequired:
+		return decodeEntity[ReportSponsoredResultPremiumRequired](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReportSponsoredResult(dataList []json.RawMessage) ([]ReportSponsoredResult, error) {
+	list := make([]ReportSponsoredResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReportSponsoredResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalNotificationSettingsScope(data json.RawMessage) (NotificationSettingsScope, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeNotificationSettingsScope)
+}
+
+func decodeNotificationSettingsScope(decoder *jsonDecoder) (NotificationSettingsScope, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorNotificationSettingsScopePrivateChats:
+		return decodeEntity[NotificationSettingsScopePrivateChats](decoder)
+
+	case ConstructorNotificationSettingsScopeGroupChats:
+		return decodeEntity[NotificationSettingsScopeGroupChats](decoder)
+
+	case ConstructorNotificationSettingsScopeChannelChats:
+		return decodeEntity[NotificationSettingsScopeChannelChats](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfNotificationSettingsScope(dataList []json.RawMessage) ([]NotificationSettingsScope, error) {
+	list := make([]NotificationSettingsScope, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalNotificationSettingsScope(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPageBlockHorizontalAlignment(data json.RawMessage) (PageBlockHorizontalAlignment, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePageBlockHorizontalAlignment)
+}
+
+func decodePageBlockHorizontalAlignment(decoder *jsonDecoder) (PageBlockHorizontalAlignment, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPageBlockHorizontalAlignmentLeft:
+		return decodeEntity[PageBlockHorizontalAlignmentLeft](decoder)
+
+	case ConstructorPageBlockHorizontalAlignmentCenter:
+		return decodeEntity[PageBlockHorizontalAlignmentCenter](decoder)
+
+	case ConstructorPageBlockHorizontalAlignmentRight:
+		return decodeEntity[PageBlockHorizontalAlignmentRight](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPageBlockHorizontalAlignment(dataList []json.RawMessage) ([]PageBlockHorizontalAlignment, error) {
+	list := make([]PageBlockHorizontalAlignment, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPageBlockHorizontalAlignment(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPageBlockVerticalAlignment(data json.RawMessage) (PageBlockVerticalAlignment, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePageBlockVerticalAlignment)
+}
+
+func decodePageBlockVerticalAlignment(decoder *jsonDecoder) (PageBlockVerticalAlignment, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPageBlockVerticalAlignmentTop:
+		return decodeEntity[PageBlockVerticalAlignmentTop](decoder)
+
+	case ConstructorPageBlockVerticalAlignmentMiddle:
+		return decodeEntity[PageBlockVerticalAlignmentMiddle](decoder)
+
+	case ConstructorPageBlockVerticalAlignmentBottom:
+		return decodeEntity[PageBlockVerticalAlignmentBottom](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPageBlockVerticalAlignment(dataList []json.RawMessage) ([]PageBlockVerticalAlignment, error) {
+	list := make([]PageBlockVerticalAlignment, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPageBlockVerticalAlignment(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPageBlock(data json.RawMessage) (PageBlock, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePageBlock)
+}
+
+func decodePageBlock(decoder *jsonDecoder) (PageBlock, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPageBlockTitle:
+		return decodeEntity[PageBlockTitle](decoder)
+
+	case ConstructorPageBlockSubtitle:
+		return decodeEntity[PageBlockSubtitle](decoder)
+
+	case ConstructorPageBlockAuthorDate:
+		return decodeEntity[PageBlockAuthorDate](decoder)
+
+	case ConstructorPageBlockHeader:
+		return decodeEntity[PageBlockHeader](decoder)
+
+	case ConstructorPageBlockSubheader:
+		return decodeEntity[PageBlockSubheader](decoder)
+
+	case ConstructorPageBlockKicker:
+		return decodeEntity[PageBlockKicker](decoder)
+
+	case ConstructorPageBlockParagraph:
+		return decodeEntity[PageBlockParagraph](decoder)
+
+	case ConstructorPageBlockPreformatted:
+		return decodeEntity[PageBlockPreformatted](decoder)
+
+	case ConstructorPageBlockFooter:
+		return decodeEntity[PageBlockFooter](decoder)
+
+	case ConstructorPageBlockDivider:
+		return decodeEntity[PageBlockDivider](decoder)
+
+	case ConstructorPageBlockAnchor:
+		return decodeEntity[PageBlockAnchor](decoder)
+
+	case ConstructorPageBlockList:
+		return decodeEntity[PageBlockList](decoder)
+
+	case ConstructorPageBlockBlockQuote:
+		return decodeEntity[PageBlockBlockQuote](decoder)
+
+	case ConstructorPageBlockPullQuote:
+		return decodeEntity[PageBlockPullQuote](decoder)
+
+	case ConstructorPageBlockAnimation:
+		return decodeEntity[PageBlockAnimation](decoder)
+
+	case ConstructorPageBlockAudio:
+		return decodeEntity[PageBlockAudio](decoder)
+
+	case ConstructorPageBlockPhoto:
+		return decodeEntity[PageBlockPhoto](decoder)
+
+	case ConstructorPageBlockVideo:
+		return decodeEntity[PageBlockVideo](decoder)
+
+	case ConstructorPageBlockVoiceNote:
+		return decodeEntity[PageBlockVoiceNote](decoder)
+
+	case ConstructorPageBlockCover:
+		return decodeEntity[PageBlockCover](decoder)
+
+	case ConstructorPageBlockEmbedded:
+		return decodeEntity[PageBlockEmbedded](decoder)
+
+	case ConstructorPageBlockEmbeddedPost:
+		return decodeEntity[PageBlockEmbeddedPost](decoder)
+
+	case ConstructorPageBlockCollage:
+		return decodeEntity[PageBlockCollage](decoder)
+
+	case ConstructorPageBlockSlideshow:
+		return decodeEntity[PageBlockSlideshow](decoder)
+
+	case ConstructorPageBlockChatLink:
+		return decodeEntity[PageBlockChatLink](decoder)
+
+	case ConstructorPageBlockTable:
+		return decodeEntity[PageBlockTable](decoder)
+
+	case ConstructorPageBlockDetails:
+		return decodeEntity[PageBlockDetails](decoder)
+
+	case ConstructorPageBlockRelatedArticles:
+		return decodeEntity[PageBlockRelatedArticles](decoder)
+
+	case ConstructorPageBlockMap:
+		return decodeEntity[PageBlockMap](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPageBlock(dataList []json.RawMessage) ([]PageBlock, error) {
+	list := make([]PageBlock, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPageBlock(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalLinkPreviewAlbumMedia(data json.RawMessage) (LinkPreviewAlbumMedia, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeLinkPreviewAlbumMedia)
+}
+
+func decodeLinkPreviewAlbumMedia(decoder *jsonDecoder) (LinkPreviewAlbumMedia, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorLinkPreviewAlbumMediaPhoto:
+		return decodeEntity[LinkPreviewAlbumMediaPhoto](decoder)
+
+	case ConstructorLinkPreviewAlbumMediaVideo:
+		return decodeEntity[LinkPreviewAlbumMediaVideo](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfLinkPreviewAlbumMedia(dataList []json.RawMessage) ([]LinkPreviewAlbumMedia, error) {
+	list := make([]LinkPreviewAlbumMedia, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalLinkPreviewAlbumMedia(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalLinkPreviewType(data json.RawMessage) (LinkPreviewType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeLinkPreviewType)
+}
+
+func decodeLinkPreviewType(decoder *jsonDecoder) (LinkPreviewType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorLinkPreviewTypeAlbum:
+		return decodeEntity[LinkPreviewTypeAlbum](decoder)
+
+	case ConstructorLinkPreviewTypeAnimation:
+		return decodeEntity[LinkPreviewTypeAnimation](decoder)
+
+	case ConstructorLinkPreviewTypeApp:
+		return decodeEntity[LinkPreviewTypeApp](decoder)
+
+	case ConstructorLinkPreviewTypeArticle:
+		return decodeEntity[LinkPreviewTypeArticle](decoder)
+
+	case ConstructorLinkPreviewTypeAudio:
+		return decodeEntity[LinkPreviewTypeAudio](decoder)
+
+	case ConstructorLinkPreviewTypeBackground:
+		return decodeEntity[LinkPreviewTypeBackground](decoder)
+
+	case ConstructorLinkPreviewTypeChannelBoost:
+		return decodeEntity[LinkPreviewTypeChannelBoost](decoder)
+
+	case ConstructorLinkPreviewTypeChat:
+		return decodeEntity[LinkPreviewTypeChat](decoder)
+
+	case ConstructorLinkPreviewTypeDocument:
+		return decodeEntity[LinkPreviewTypeDocument](decoder)
+
+	case ConstructorLinkPreviewTypeEmbeddedAnimationPlayer:
+		return decodeEntity[LinkPreviewTypeEmbeddedAnimationPlayer](decoder)
+
+	case ConstructorLinkPreviewTypeEmbeddedAudioPlayer:
+		return decodeEntity[LinkPreviewTypeEmbeddedAudioPlayer](decoder)
+
+	case ConstructorLinkPreviewTypeEmbeddedVideoPlayer:
+		return decodeEntity[LinkPreviewTypeEmbeddedVideoPlayer](decoder)
+
+	case ConstructorLinkPreviewTypeExternalAudio:
+		return decodeEntity[LinkPreviewTypeExternalAudio](decoder)
+
+	case ConstructorLinkPreviewTypeExternalVideo:
+		return decodeEntity[LinkPreviewTypeExternalVideo](decoder)
+
+	case ConstructorLinkPreviewTypeInvoice:
+		return decodeEntity[LinkPreviewTypeInvoice](decoder)
+
+	case ConstructorLinkPreviewTypeMessage:
+		return decodeEntity[LinkPreviewTypeMessage](decoder)
+
+	case ConstructorLinkPreviewTypePhoto:
+		return decodeEntity[LinkPreviewTypePhoto](decoder)
+
+	case ConstructorLinkPreviewTypePremiumGiftCode:
+		return decodeEntity[LinkPreviewTypePremiumGiftCode](decoder)
+
+	case ConstructorLinkPreviewTypeShareableChatFolder:
+		return decodeEntity[LinkPreviewTypeShareableChatFolder](decoder)
+
+	case ConstructorLinkPreviewTypeSticker:
+		return decodeEntity[LinkPreviewTypeSticker](decoder)
+
+	case ConstructorLinkPreviewTypeStickerSet:
+		return decodeEntity[LinkPreviewTypeStickerSet](decoder)
+
+	case ConstructorLinkPreviewTypeStory:
+		return decodeEntity[LinkPreviewTypeStory](decoder)
+
+	case ConstructorLinkPreviewTypeSupergroupBoost:
+		return decodeEntity[LinkPreviewTypeSupergroupBoost](decoder)
+
+	case ConstructorLinkPreviewTypeTheme:
+		return decodeEntity[LinkPreviewTypeTheme](decoder)
+
+	case ConstructorLinkPreviewTypeUnsupported:
+		return decodeEntity[LinkPreviewTypeUnsupported](decoder)
+
+	case ConstructorLinkPreviewTypeUpgradedGift:
+		return decodeEntity[LinkPreviewTypeUpgradedGift](decoder)
+
+	case ConstructorLinkPreviewTypeUser:
+		return decodeEntity[LinkPreviewTypeUser](decoder)
+
+	case ConstructorLinkPreviewTypeVideo:
+		return decodeEntity[LinkPreviewTypeVideo](decoder)
+
+	case ConstructorLinkPreviewTypeVideoChat:
+		return decodeEntity[LinkPreviewTypeVideoChat](decoder)
+
+	case ConstructorLinkPreviewTypeVideoNote:
+		return decodeEntity[LinkPreviewTypeVideoNote](decoder)
+
+	case ConstructorLinkPreviewTypeVoiceNote:
+		return decodeEntity[LinkPreviewTypeVoiceNote](decoder)
+
+	case ConstructorLinkPreviewTypeWebApp:
+		return decodeEntity[LinkPreviewTypeWebApp](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfLinkPreviewType(dataList []json.RawMessage) ([]LinkPreviewType, error) {
+	list := make([]LinkPreviewType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalLinkPreviewType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCollectibleItemType(data json.RawMessage) (CollectibleItemType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCollectibleItemType)
+}
+
+func decodeCollectibleItemType(decoder *jsonDecoder) (CollectibleItemType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCollectibleItemTypeUsername:
+		return decodeEntity[CollectibleItemTypeUsername](decoder)
+
+	case ConstructorCollectibleItemTypePhoneNumber:
+		return decodeEntity[CollectibleItemTypePhoneNumber](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCollectibleItemType(dataList []json.RawMessage) ([]CollectibleItemType, error) {
+	list := make([]CollectibleItemType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCollectibleItemType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputCredentials(data json.RawMessage) (InputCredentials, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputCredentials)
+}
+
+func decodeInputCredentials(decoder *jsonDecoder) (InputCredentials, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputCredentialsSaved:
+		return decodeEntity[InputCredentialsSaved](decoder)
+
+	case ConstructorInputCredentialsNew:
+		return decodeEntity[InputCredentialsNew](decoder)
+
+	case ConstructorInputCredentialsApplePay:
+		return decodeEntity[InputCredentialsApplePay](decoder)
+
+	case ConstructorInputCredentialsGooglePay:
+		return decodeEntity[InputCredentialsGooglePay](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputCredentials(dataList []json.RawMessage) ([]InputCredentials, error) {
+	list := make([]InputCredentials, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputCredentials(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalResendCodeReason(data json.RawMessage) (ResendCodeReason, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeResendCodeReason)
+}
+
+func decodeResendCodeReason(decoder *jsonDecoder) (ResendCodeReason, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorResendCodeReasonUserRequest:
+		return decodeEntity[ResendCodeReasonUserRequest](decoder)
+
+	case ConstructorResendCodeReasonVerificationFailed:
+		return decodeEntity[ResendCodeReasonVerificationFailed](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfResendCodeReason(dataList []json.RawMessage) ([]ResendCodeReason, error) {
+	list := make([]ResendCodeReason, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalResendCodeReason(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSpeechRecognitionResult(data json.RawMessage) (SpeechRecognitionResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSpeechRecognitionResult)
+}
+
+func decodeSpeechRecognitionResult(decoder *jsonDecoder) (SpeechRecognitionResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSpeechRecognitionResultPending:
+		return decodeEntity[SpeechRecognitionResultPending](decoder)
+
+	case ConstructorSpeechRecognitionResultText:
+		return decodeEntity[SpeechRecognitionResultText](decoder)
+
+	case ConstructorSpeechRecognitionResultError:
+		return decodeEntity[SpeechRecognitionResultError](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSpeechRecognitionResult(dataList []json.RawMessage) ([]SpeechRecognitionResult, error) {
+	list := make([]SpeechRecognitionResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSpeechRecognitionResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalLanguagePackStringValue(data json.RawMessage) (LanguagePackStringValue, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeLanguagePackStringValue)
+}
+
+func decodeLanguagePackStringValue(decoder *jsonDecoder) (LanguagePackStringValue, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorLanguagePackStringValueOrdinary:
+		return decodeEntity[LanguagePackStringValueOrdinary](decoder)
+
+	case ConstructorLanguagePackStringValuePluralized:
+		return decodeEntity[LanguagePackStringValuePluralized](decoder)
+
+	case ConstructorLanguagePackStringValueDeleted:
+		return decodeEntity[LanguagePackStringValueDeleted](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfLanguagePackStringValue(dataList []json.RawMessage) ([]LanguagePackStringValue, error) {
+	list := make([]LanguagePackStringValue, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalLanguagePackStringValue(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalDeviceToken(data json.RawMessage) (DeviceToken, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeDeviceToken)
+}
+
+func decodeDeviceToken(decoder *jsonDecoder) (DeviceToken, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorDeviceTokenFirebaseCloudMessaging:
+		return decodeEntity[DeviceTokenFirebaseCloudMessaging](decoder)
+
+	case ConstructorDeviceTokenApplePush:
+		return decodeEntity[DeviceTokenApplePush](decoder)
+
+	case ConstructorDeviceTokenApplePushVoIP:
+		return decodeEntity[DeviceTokenApplePushVoIP](decoder)
+
+	case ConstructorDeviceTokenWindowsPush:
+		return decodeEntity[DeviceTokenWindowsPush](decoder)
+
+	case ConstructorDeviceTokenMicrosoftPush:
+		return decodeEntity[DeviceTokenMicrosoftPush](decoder)
+
+	case ConstructorDeviceTokenMicrosoftPushVoIP:
+		return decodeEntity[DeviceTokenMicrosoftPushVoIP](decoder)
+
+	case ConstructorDeviceTokenWebPush:
+		return decodeEntity[DeviceTokenWebPush](decoder)
+
+	case ConstructorDeviceTokenSimplePush:
+		return decodeEntity[DeviceTokenSimplePush](decoder)
+
+	case ConstructorDeviceTokenUbuntuPush:
+		return decodeEntity[DeviceTokenUbuntuPush](decoder)
+
+	case ConstructorDeviceTokenBlackBerryPush:
+		return decodeEntity[DeviceTokenBlackBerryPush](decoder)
+
+	case ConstructorDeviceTokenTizenPush:
+		return decodeEntity[DeviceTokenTizenPush](decoder)
+
+	case ConstructorDeviceTokenHuaweiPush:
+		return decodeEntity[DeviceTokenHuaweiPush](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfDeviceToken(dataList []json.RawMessage) ([]DeviceToken, error) {
+	list := make([]DeviceToken, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalDeviceToken(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCanTransferOwnershipResult(data json.RawMessage) (CanTransferOwnershipResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCanTransferOwnershipResult)
+}
+
+func decodeCanTransferOwnershipResult(decoder *jsonDecoder) (CanTransferOwnershipResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCanTransferOwnershipResultOk:
+		return decodeEntity[CanTransferOwnershipResultOk](decoder)
+
+	case ConstructorCanTransferOwnershipResultPasswordNeeded:
+		return decodeEntity[CanTransferOwnershipResultPasswordNeeded](decoder)
+
+	case ConstructorCanTransferOwnershipResultPasswordTooFresh:
+		return decodeEntity[CanTransferOwnershipResultPasswordTooFresh](decoder)
+
+	case ConstructorCanTransferOwnershipResultSessionTooFresh:
+		return decodeEntity[CanTransferOwnershipResultSessionTooFresh](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCanTransferOwnershipResult(dataList []json.RawMessage) ([]CanTransferOwnershipResult, error) {
+	list := make([]CanTransferOwnershipResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCanTransferOwnershipResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalNotificationType(data json.RawMessage) (NotificationType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeNotificationType)
+}
+
+func decodeNotificationType(decoder *jsonDecoder) (NotificationType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorNotificationTypeNewMessage:
+		return decodeEntity[NotificationTypeNewMessage](decoder)
+
+	case ConstructorNotificationTypeNewSecretChat:
+		return decodeEntity[NotificationTypeNewSecretChat](decoder)
+
+	case ConstructorNotificationTypeNewCall:
+		return decodeEntity[NotificationTypeNewCall](decoder)
+
+	case ConstructorNotificationTypeNewPushMessage:
+		return decodeEntity[NotificationTypeNewPushMessage](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfNotificationType(dataList []json.RawMessage) ([]NotificationType, error) {
+	list := make([]NotificationType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalNotificationType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalOptionValue(data json.RawMessage) (OptionValue, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeOptionValue)
+}
+
+func decodeOptionValue(decoder *jsonDecoder) (OptionValue, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorOptionValueBoolean:
+		return decodeEntity[OptionValueBoolean](decoder)
+
+	case ConstructorOptionValueEmpty:
+		return decodeEntity[OptionValueEmpty](decoder)
+
+	case ConstructorOptionValueInteger:
+		return decodeEntity[OptionValueInteger](decoder)
+
+	case ConstructorOptionValueString:
+		return decodeEntity[OptionValueString](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfOptionValue(dataList []json.RawMessage) ([]OptionValue, error) {
+	list := make([]OptionValue, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalOptionValue(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalJsonValue(data json.RawMessage) (JsonValue, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeJsonValue)
+}
+
+func decodeJsonValue(decoder *jsonDecoder) (JsonValue, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorJsonValueNull:
+		return decodeEntity[JsonValueNull](decoder)
+
+	case ConstructorJsonValueBoolean:
+		return decodeEntity[JsonValueBoolean](decoder)
+
+	case ConstructorJsonValueNumber:
+		return decodeEntity[JsonValueNumber](decoder)
+
+	case ConstructorJsonValueString:
+		return decodeEntity[JsonValueString](decoder)
+
+	case ConstructorJsonValueArray:
+		return decodeEntity[JsonValueArray](decoder)
+
+	case ConstructorJsonValueObject:
+		return decodeEntity[JsonValueObject](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfJsonValue(dataList []json.RawMessage) ([]JsonValue, error) {
+	list := make([]JsonValue, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalJsonValue(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReportReason(data json.RawMessage) (ReportReason, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReportReason)
+}
+
+func decodeReportReason(decoder *jsonDecoder) (ReportReason, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReportReasonSpam:
+		return decodeEntity[ReportReasonSpam](decoder)
+
+	case ConstructorReportReasonViolence:
+		return decodeEntity[ReportReasonViolence](decoder)
+
+	case ConstructorReportReasonPornography:
+		return decodeEntity[ReportReasonPornography](decoder)
+
+	case ConstructorReportReasonChildAbuse:
+		return decodeEntity[ReportReasonChildAbuse](decoder)
+
+	case ConstructorReportReasonCopyright:
+		return decodeEntity[ReportReasonCopyright](decoder)
+
+	case ConstructorReportReasonUnrelatedLocation:
+		return decodeEntity[ReportReasonUnrelatedLocation](decoder)
+
+	case ConstructorReportReasonFake:
+		return decodeEntity[ReportReasonFake](decoder)
+
+	case ConstructorReportReasonIllegalDrugs:
+		return decodeEntity[ReportReasonIllegalDrugs](decoder)
+
+	case ConstructorReportReasonPersonalDetails:
+		return decodeEntity[ReportReasonPersonalDetails](decoder)
+
+	case ConstructorReportReasonCustom:
+		return decodeEntity[ReportReasonCustom](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReportReason(dataList []json.RawMessage) ([]ReportReason, error) {
+	list := make([]ReportReason, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReportReason(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInternalLinkType(data json.RawMessage) (InternalLinkType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInternalLinkType)
+}
+
+func decodeInternalLinkType(decoder *jsonDecoder) (InternalLinkType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInternalLinkTypeActiveSessions:
+		return decodeEntity[InternalLinkTypeActiveSessions](decoder)
+
+	case ConstructorInternalLinkTypeAttachmentMenuBot:
+		return decodeEntity[InternalLinkTypeAttachmentMenuBot](decoder)
+
+	case ConstructorInternalLinkTypeAuthenticationCode:
+		return decodeEntity[InternalLinkTypeAuthenticationCode](decoder)
+
+	case ConstructorInternalLinkTypeBackground:
+		return decodeEntity[InternalLinkTypeBackground](decoder)
+
+	case ConstructorInternalLinkTypeBotAddToChannel:
+		return decodeEntity[InternalLinkTypeBotAddToChannel](decoder)
+
+	case ConstructorInternalLinkTypeBotStart:
+		return decodeEntity[InternalLinkTypeBotStart](decoder)
+
+	case ConstructorInternalLinkTypeBotStartInGroup:
+		return decodeEntity[InternalLinkTypeBotStartInGroup](decoder)
+
+	case ConstructorInternalLinkTypeBusinessChat:
+		return decodeEntity[InternalLinkTypeBusinessChat](decoder)
+
+	case ConstructorInternalLinkTypeBuyStars:
+		return decodeEntity[InternalLinkTypeBuyStars](decoder)
+
+	case ConstructorInternalLinkTypeChangePhoneNumber:
+		return decodeEntity[InternalLinkTypeChangePhoneNumber](decoder)
+
+	case ConstructorInternalLinkTypeChatAffiliateProgram:
+		return decodeEntity[InternalLinkTypeChatAffiliateProgram](decoder)
+
+	case ConstructorInternalLinkTypeChatBoost:
+		return decodeEntity[InternalLinkTypeChatBoost](decoder)
+
+	case ConstructorInternalLinkTypeChatFolderInvite:
+		return decodeEntity[InternalLinkTypeChatFolderInvite](decoder)
+
+	case ConstructorInternalLinkTypeChatFolderSettings:
+		return decodeEntity[InternalLinkTypeChatFolderSettings](decoder)
+
+	case ConstructorInternalLinkTypeChatInvite:
+		return decodeEntity[InternalLinkTypeChatInvite](decoder)
+
+	case ConstructorInternalLinkTypeDefaultMessageAutoDeleteTimerSettings:
+		return decodeEntity[InternalLinkTypeDefaultMessageAutoDeleteTimerSettings](decoder)
+
+	case ConstructorInternalLinkTypeEditProfileSettings:
+		return decodeEntity[InternalLinkTypeEditProfileSettings](decoder)
+
+	case ConstructorInternalLinkTypeGame:
+		return decodeEntity[InternalLinkTypeGame](decoder)
+
+	case ConstructorInternalLinkTypeInstantView:
+		return decodeEntity[InternalLinkTypeInstantView](decoder)
+
+	case ConstructorInternalLinkTypeInvoice:
+		return decodeEntity[InternalLinkTypeInvoice](decoder)
+
+	case ConstructorInternalLinkTypeLanguagePack:
+		return decodeEntity[InternalLinkTypeLanguagePack](decoder)
+
+	case ConstructorInternalLinkTypeLanguageSettings:
+		return decodeEntity[InternalLinkTypeLanguageSettings](decoder)
+
+	case ConstructorInternalLinkTypeMainWebApp:
+		return decodeEntity[InternalLinkTypeMainWebApp](decoder)
+
+	case ConstructorInternalLinkTypeMessage:
+		return decodeEntity[InternalLinkTypeMessage](decoder)
+
+	case ConstructorInternalLinkTypeMessageDraft:
+		return decodeEntity[InternalLinkTypeMessageDraft](decoder)
+
+	case ConstructorInternalLinkTypePassportDataRequest:
+		return decodeEntity[InternalLinkTypePassportDataRequest](decoder)
+
+	case ConstructorInternalLinkTypePhoneNumberConfirmation:
+		return decodeEntity[InternalLinkTypePhoneNumberConfirmation](decoder)
+
+	case ConstructorInternalLinkTypePremiumFeatures:
+		return decodeEntity[InternalLinkTypePremiumFeatures](decoder)
+
+	case ConstructorInternalLinkTypePremiumGift:
+		return decodeEntity[InternalLinkTypePremiumGift](decoder)
+
+	case ConstructorInternalLinkTypePremiumGiftCode:
+		return decodeEntity[InternalLinkTypePremiumGiftCode](decoder)
+
+	case ConstructorInternalLinkTypePrivacyAndSecuritySettings:
+		return decodeEntity[InternalLinkTypePrivacyAndSecuritySettings](decoder)
+
+	case ConstructorInternalLinkTypeProxy:
+		return decodeEntity[InternalLinkTypeProxy](decoder)
+
+	case ConstructorInternalLinkTypePublicChat:
+		return decodeEntity[InternalLinkTypePublicChat](decoder)
+
+	case ConstructorInternalLinkTypeQrCodeAuthentication:
+		return decodeEntity[InternalLinkTypeQrCodeAuthentication](decoder)
+
+	case ConstructorInternalLinkTypeRestorePurchases:
+		return decodeEntity[InternalLinkTypeRestorePurchases](decoder)
+
+	case ConstructorInternalLinkTypeSettings:
+		return decodeEntity[InternalLinkTypeSettings](decoder)
+
+	case ConstructorInternalLinkTypeStickerSet:
+		return decodeEntity[InternalLinkTypeStickerSet](decoder)
+
+	case ConstructorInternalLinkTypeStory:
+		return decodeEntity[InternalLinkTypeStory](decoder)
+
+	case ConstructorInternalLinkTypeTheme:
+		return decodeEntity[InternalLinkTypeTheme](decoder)
+
+	case ConstructorInternalLinkTypeThemeSettings:
+		return decodeEntity[InternalLinkTypeThemeSettings](decoder)
+
+	case ConstructorInternalLinkTypeUnknownDeepLink:
+		return decodeEntity[InternalLinkTypeUnknownDeepLink](decoder)
+
+	case ConstructorInternalLinkTypeUnsupportedProxy:
+		return decodeEntity[InternalLinkTypeUnsupportedProxy](decoder)
+
+	case ConstructorInternalLinkTypeUpgradedGift:
+		return decodeEntity[InternalLinkTypeUpgradedGift](decoder)
+
+	case ConstructorInternalLinkTypeUserPhoneNumber:
+		return decodeEntity[InternalLinkTypeUserPhoneNumber](decoder)
+
+	case ConstructorInternalLinkTypeUserToken:
+		return decodeEntity[InternalLinkTypeUserToken](decoder)
+
+	case ConstructorInternalLinkTypeVideoChat:
+		return decodeEntity[InternalLinkTypeVideoChat](decoder)
+
+	case ConstructorInternalLinkTypeWebApp:
+		return decodeEntity[InternalLinkTypeWebApp](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInternalLinkType(dataList []json.RawMessage) ([]InternalLinkType, error) {
+	list := make([]InternalLinkType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInternalLinkType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalBlockList(data json.RawMessage) (BlockList, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeBlockList)
+}
+
+func decodeBlockList(decoder *jsonDecoder) (BlockList, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorBlockListMain:
+		return decodeEntity[BlockListMain](decoder)
+
+	case ConstructorBlockListStories:
+		return decodeEntity[BlockListStories](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfBlockList(dataList []json.RawMessage) ([]BlockList, error) {
+	list := make([]BlockList, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalBlockList(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalNetworkType(data json.RawMessage) (NetworkType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeNetworkType)
+}
+
+func decodeNetworkType(decoder *jsonDecoder) (NetworkType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorNetworkTypeNone:
+		return decodeEntity[NetworkTypeNone](decoder)
+
+	case ConstructorNetworkTypeMobile:
+		return decodeEntity[NetworkTypeMobile](decoder)
+
+	case ConstructorNetworkTypeMobileRoaming:
+		return decodeEntity[NetworkTypeMobileRoaming](decoder)
+
+	case ConstructorNetworkTypeWiFi:
+		return decodeEntity[NetworkTypeWiFi](decoder)
+
+	case ConstructorNetworkTypeOther:
+		return decodeEntity[NetworkTypeOther](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfNetworkType(dataList []json.RawMessage) ([]NetworkType, error) {
+	list := make([]NetworkType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalNetworkType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalNetworkStatisticsEntry(data json.RawMessage) (NetworkStatisticsEntry, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeNetworkStatisticsEntry)
+}
+
+func decodeNetworkStatisticsEntry(decoder *jsonDecoder) (NetworkStatisticsEntry, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorNetworkStatisticsEntryFile:
+		return decodeEntity[NetworkStatisticsEntryFile](decoder)
+
+	case ConstructorNetworkStatisticsEntryCall:
+		return decodeEntity[NetworkStatisticsEntryCall](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfNetworkStatisticsEntry(dataList []json.RawMessage) ([]NetworkStatisticsEntry, error) {
+	list := make([]NetworkStatisticsEntry, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalNetworkStatisticsEntry(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalAutosaveSettingsScope(data json.RawMessage) (AutosaveSettingsScope, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeAutosaveSettingsScope)
+}
+
+func decodeAutosaveSettingsScope(decoder *jsonDecoder) (AutosaveSettingsScope, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorAutosaveSettingsScopePrivateChats:
+		return decodeEntity[AutosaveSettingsScopePrivateChats](decoder)
+
+	case ConstructorAutosaveSettingsScopeGroupChats:
+		return decodeEntity[AutosaveSettingsScopeGroupChats](decoder)
+
+	case ConstructorAutosaveSettingsScopeChannelChats:
+		return decodeEntity[AutosaveSettingsScopeChannelChats](decoder)
+
+	case ConstructorAutosaveSettingsScopeChat:
+		return decodeEntity[AutosaveSettingsScopeChat](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfAutosaveSettingsScope(dataList []json.RawMessage) ([]AutosaveSettingsScope, error) {
+	list := make([]AutosaveSettingsScope, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalAutosaveSettingsScope(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalConnectionState(data json.RawMessage) (ConnectionState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeConnectionState)
+}
+
+func decodeConnectionState(decoder *jsonDecoder) (ConnectionState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorConnectionStateWaitingForNetwork:
+		return decodeEntity[ConnectionStateWaitingForNetwork](decoder)
+
+	case ConstructorConnectionStateConnectingToProxy:
+		return decodeEntity[ConnectionStateConnectingToProxy](decoder)
+
+	case ConstructorConnectionStateConnecting:
+		return decodeEntity[ConnectionStateConnecting](decoder)
+
+	case ConstructorConnectionStateUpdating:
+		return decodeEntity[ConnectionStateUpdating](decoder)
+
+	case ConstructorConnectionStateReady:
+		return decodeEntity[ConnectionStateReady](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfConnectionState(dataList []json.RawMessage) ([]ConnectionState, error) {
+	list := make([]ConnectionState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalConnectionState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalTMeUrlType(data json.RawMessage) (TMeUrlType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeTMeUrlType)
+}
+
+func decodeTMeUrlType(decoder *jsonDecoder) (TMeUrlType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorTMeUrlTypeUser:
+		return decodeEntity[TMeUrlTypeUser](decoder)
+
+	case ConstructorTMeUrlTypeSupergroup:
+		return decodeEntity[TMeUrlTypeSupergroup](decoder)
+
+	case ConstructorTMeUrlTypeChatInvite:
+		return decodeEntity[TMeUrlTypeChatInvite](decoder)
+
+	case ConstructorTMeUrlTypeStickerSet:
+		return decodeEntity[TMeUrlTypeStickerSet](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfTMeUrlType(dataList []json.RawMessage) ([]TMeUrlType, error) {
+	list := make([]TMeUrlType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalTMeUrlType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSuggestedAction(data json.RawMessage) (SuggestedAction, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSuggestedAction)
+}
+
+func decodeSuggestedAction(decoder *jsonDecoder) (SuggestedAction, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSuggestedActionEnableArchiveAndMuteNewChats:
+		return decodeEntity[SuggestedActionEnableArchiveAndMuteNewChats](decoder)
+
+	case ConstructorSuggestedActionCheckPassword:
+		return decodeEntity[SuggestedActionCheckPassword](decoder)
+
+	case ConstructorSuggestedActionCheckPhoneNumber:
+		return decodeEntity[SuggestedActionCheckPhoneNumber](decoder)
+
+	case ConstructorSuggestedActionViewChecksHint:
+		return decodeEntity[SuggestedActionViewChecksHint](decoder)
+
+	case ConstructorSuggestedActionConvertToBroadcastGroup:
+		return decodeEntity[SuggestedActionConvertToBroadcastGroup](decoder)
+
+	case ConstructorSuggestedActionSetPassword:
+		return decodeEntity[SuggestedActionSetPassword](decoder)
+
+	case ConstructorSuggestedActionUpgradePremium:
+		return decodeEntity[SuggestedActionUpgradePremium](decoder)
+
+	case ConstructorSuggestedActionRestorePremium:
+		return decodeEntity[SuggestedActionRestorePremium](decoder)
+
+	case ConstructorSuggestedActionSubscribeToAnnualPremium:
+		return decodeEntity[SuggestedActionSubscribeToAnnualPremium](decoder)
+
+	case ConstructorSuggestedActionGiftPremiumForChristmas:
+		return decodeEntity[SuggestedActionGiftPremiumForChristmas](decoder)
+
+	case ConstructorSuggestedActionSetBirthdate:
+		return decodeEntity[SuggestedActionSetBirthdate](decoder)
+
+	case ConstructorSuggestedActionSetProfilePhoto:
+		return decodeEntity[SuggestedActionSetProfilePhoto](decoder)
+
+	case ConstructorSuggestedActionExtendPremium:
+		return decodeEntity[SuggestedActionExtendPremium](decoder)
+
+	case ConstructorSuggestedActionExtendStarSubscriptions:
+		return decodeEntity[SuggestedActionExtendStarSubscriptions](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSuggestedAction(dataList []json.RawMessage) ([]SuggestedAction, error) {
+	list := make([]SuggestedAction, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSuggestedAction(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalProxyType(data json.RawMessage) (ProxyType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeProxyType)
+}
+
+func decodeProxyType(decoder *jsonDecoder) (ProxyType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorProxyTypeSocks5:
+		return decodeEntity[ProxyTypeSocks5](decoder)
+
+	case ConstructorProxyTypeHttp:
+		return decodeEntity[ProxyTypeHttp](decoder)
+
+	case ConstructorProxyTypeMtproto:
+		return decodeEntity[ProxyTypeMtproto](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfProxyType(dataList []json.RawMessage) ([]ProxyType, error) {
+	list := make([]ProxyType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalProxyType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStatisticalGraph(data json.RawMessage) (StatisticalGraph, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStatisticalGraph)
+}
+
+func decodeStatisticalGraph(decoder *jsonDecoder) (StatisticalGraph, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStatisticalGraphData:
+		return decodeEntity[StatisticalGraphData](decoder)
+
+	case ConstructorStatisticalGraphAsync:
+		return decodeEntity[StatisticalGraphAsync](decoder)
+
+	case ConstructorStatisticalGraphError:
+		return decodeEntity[StatisticalGraphError](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStatisticalGraph(dataList []json.RawMessage) ([]StatisticalGraph, error) {
+	list := make([]StatisticalGraph, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStatisticalGraph(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalVectorPathCommand(data json.RawMessage) (VectorPathCommand, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeVectorPathCommand)
+}
+
+func decodeVectorPathCommand(decoder *jsonDecoder) (VectorPathCommand, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorVectorPathCommandLine:
+		return decodeEntity[VectorPathCommandLine](decoder)
+
+	case ConstructorVectorPathCommandCubicBezierCurve:
+		return decodeEntity[VectorPathCommandCubicBezierCurve](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfVectorPathCommand(dataList []json.RawMessage) ([]VectorPathCommand, error) {
+	list := make([]VectorPathCommand, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalVectorPathCommand(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPhoneNumberCodeType(data json.RawMessage) (PhoneNumberCodeType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePhoneNumberCodeType)
+}
+
+func decodePhoneNumberCodeType(decoder *jsonDecoder) (PhoneNumberCodeType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPhoneNumberCodeTypeChange:
+		return decodeEntity[PhoneNumberCodeTypeChange](decoder)
+
+	case ConstructorPhoneNumberCodeTypeVerify:
+		return decodeEntity[PhoneNumberCodeTypeVerify](decoder)
+
+	case ConstructorPhoneNumberCodeTypeConfirmOwnership:
+		return decodeEntity[PhoneNumberCodeTypeConfirmOwnership](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPhoneNumberCodeType(dataList []json.RawMessage) ([]PhoneNumberCodeType, error) {
+	list := make([]PhoneNumberCodeType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPhoneNumberCodeType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalLogStream(data json.RawMessage) (LogStream, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeLogStream)
+}
+
+func decodeLogStream(decoder *jsonDecoder) (LogStream, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorLogStreamDefault:
+		return decodeEntity[LogStreamDefault](decoder)
+
+	case ConstructorLogStreamFile:
+		return decodeEntity[LogStreamFile](decoder)
+
+	case ConstructorLogStreamEmpty:
+		return decodeEntity[LogStreamEmpty](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfLogStream(dataList []json.RawMessage) ([]LogStream, error) {
+	list := make([]LogStream, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalLogStream(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalError(data json.RawMessage) (*Error, error) {
+	var resp Error
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalOk(data json.RawMessage) (*Ok, error) {
+	var resp Ok
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmailAddressResetStateAvailable(data json.RawMessage) (*EmailAddressResetStateAvailable, error) {
+	var resp EmailAddressResetStateAvailable
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmailAddressResetStatePending(data json.RawMessage) (*EmailAddressResetStatePending, error) {
+	var resp EmailAddressResetStatePending
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTermsOfService(data json.RawMessage) (*TermsOfService, error) {
+	var resp TermsOfService
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFirebaseDeviceVerificationParametersSafetyNet(data json.RawMessage) (*FirebaseDeviceVerificationParametersSafetyNet, error) {
+	var resp FirebaseDeviceVerificationParametersSafetyNet
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFirebaseDeviceVerificationParametersPlayIntegrity(data json.RawMessage) (*FirebaseDeviceVerificationParametersPlayIntegrity, error) {
+	var resp FirebaseDeviceVerificationParametersPlayIntegrity
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRecoveryEmailAddress(data json.RawMessage) (*RecoveryEmailAddress, error) {
+	var resp RecoveryEmailAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMinithumbnail(data json.RawMessage) (*Minithumbnail, error) {
+	var resp Minithumbnail
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMaskPointForehead(data json.RawMessage) (*MaskPointForehead, error) {
+	var resp MaskPointForehead
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMaskPointEyes(data json.RawMessage) (*MaskPointEyes, error) {
+	var resp MaskPointEyes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMaskPointMouth(data json.RawMessage) (*MaskPointMouth, error) {
+	var resp MaskPointMouth
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMaskPointChin(data json.RawMessage) (*MaskPointChin, error) {
+	var resp MaskPointChin
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMaskPosition(data json.RawMessage) (*MaskPosition, error) {
+	var resp MaskPosition
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalClosedVectorPath(data json.RawMessage) (*ClosedVectorPath, error) {
+	var resp ClosedVectorPath
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalOutline(data json.RawMessage) (*Outline, error) {
+	var resp Outline
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLocation(data json.RawMessage) (*Location, error) {
+	var resp Location
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVenue(data json.RawMessage) (*Venue, error) {
+	var resp Venue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVerificationStatus(data json.RawMessage) (*VerificationStatus, error) {
+	var resp VerificationStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBirthdate(data json.RawMessage) (*Birthdate, error) {
+	var resp Birthdate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProductInfo(data json.RawMessage) (*ProductInfo, error) {
+	var resp ProductInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAccentColor(data json.RawMessage) (*AccentColor, error) {
+	var resp AccentColor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUsernames(data json.RawMessage) (*Usernames, error) {
+	var resp Usernames
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFactCheck(data json.RawMessage) (*FactCheck, error) {
+	var resp FactCheck
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportOption(data json.RawMessage) (*ReportOption, error) {
+	var resp ReportOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportSponsoredResultOk(data json.RawMessage) (*ReportSponsoredResultOk, error) {
+	var resp ReportSponsoredResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportSponsoredResultFailed(data json.RawMessage) (*ReportSponsoredResultFailed, error) {
+	var resp ReportSponsoredResultFailed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportSponsoredResultOptionRequired(data json.RawMessage) (*ReportSponsoredResultOptionRequired, error) {
+	var resp ReportSponsoredResultOptionRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportSponsoredResultAdsHidden(data json.RawMessage) (*ReportSponsoredResultAdsHidden, error) {
+	var resp ReportSponsoredResultAdsHidden
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportSponsoredResultPremiumRequired(data json.RawMessage) (*ReportSponsoredResultPremiumRequired, error) {
+	var resp ReportSponsoredResultPremiumRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationSettingsScopePrivateChats(data json.RawMessage) (*NotificationSettingsScopePrivateChats, error) {
+	var resp NotificationSettingsScopePrivateChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationSettingsScopeGroupChats(data json.RawMessage) (*NotificationSettingsScopeGroupChats, error) {
+	var resp NotificationSettingsScopeGroupChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationSettingsScopeChannelChats(data json.RawMessage) (*NotificationSettingsScopeChannelChats, error) {
+	var resp NotificationSettingsScopeChannelChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalScopeNotificationSettings(data json.RawMessage) (*ScopeNotificationSettings, error) {
+	var resp ScopeNotificationSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFailedToAddMember(data json.RawMessage) (*FailedToAddMember, error) {
+	var resp FailedToAddMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFailedToAddMembers(data json.RawMessage) (*FailedToAddMembers, error) {
+	var resp FailedToAddMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAccountInfo(data json.RawMessage) (*AccountInfo, error) {
+	var resp AccountInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThemeParameters(data json.RawMessage) (*ThemeParameters, error) {
+	var resp ThemeParameters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewOptions(data json.RawMessage) (*LinkPreviewOptions, error) {
+	var resp LinkPreviewOptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThemeSettings(data json.RawMessage) (*ThemeSettings, error) {
+	var resp ThemeSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockCaption(data json.RawMessage) (*PageBlockCaption, error) {
+	var resp PageBlockCaption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockListItem(data json.RawMessage) (*PageBlockListItem, error) {
+	var resp PageBlockListItem
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockHorizontalAlignmentLeft(data json.RawMessage) (*PageBlockHorizontalAlignmentLeft, error) {
+	var resp PageBlockHorizontalAlignmentLeft
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockHorizontalAlignmentCenter(data json.RawMessage) (*PageBlockHorizontalAlignmentCenter, error) {
+	var resp PageBlockHorizontalAlignmentCenter
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockHorizontalAlignmentRight(data json.RawMessage) (*PageBlockHorizontalAlignmentRight, error) {
+	var resp PageBlockHorizontalAlignmentRight
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockVerticalAlignmentTop(data json.RawMessage) (*PageBlockVerticalAlignmentTop, error) {
+	var resp PageBlockVerticalAlignmentTop
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockVerticalAlignmentMiddle(data json.RawMessage) (*PageBlockVerticalAlignmentMiddle, error) {
+	var resp PageBlockVerticalAlignmentMiddle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockVerticalAlignmentBottom(data json.RawMessage) (*PageBlockVerticalAlignmentBottom, error) {
+	var resp PageBlockVerticalAlignmentBottom
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockTableCell(data json.RawMessage) (*PageBlockTableCell, error) {
+	var resp PageBlockTableCell
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockRelatedArticle(data json.RawMessage) (*PageBlockRelatedArticle, error) {
+	var resp PageBlockRelatedArticle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockTitle(data json.RawMessage) (*PageBlockTitle, error) {
+	var resp PageBlockTitle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockSubtitle(data json.RawMessage) (*PageBlockSubtitle, error) {
+	var resp PageBlockSubtitle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockAuthorDate(data json.RawMessage) (*PageBlockAuthorDate, error) {
+	var resp PageBlockAuthorDate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockHeader(data json.RawMessage) (*PageBlockHeader, error) {
+	var resp PageBlockHeader
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockSubheader(data json.RawMessage) (*PageBlockSubheader, error) {
+	var resp PageBlockSubheader
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockKicker(data json.RawMessage) (*PageBlockKicker, error) {
+	var resp PageBlockKicker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockParagraph(data json.RawMessage) (*PageBlockParagraph, error) {
+	var resp PageBlockParagraph
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockPreformatted(data json.RawMessage) (*PageBlockPreformatted, error) {
+	var resp PageBlockPreformatted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockFooter(data json.RawMessage) (*PageBlockFooter, error) {
+	var resp PageBlockFooter
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockDivider(data json.RawMessage) (*PageBlockDivider, error) {
+	var resp PageBlockDivider
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockAnchor(data json.RawMessage) (*PageBlockAnchor, error) {
+	var resp PageBlockAnchor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockList(data json.RawMessage) (*PageBlockList, error) {
+	var resp PageBlockList
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockBlockQuote(data json.RawMessage) (*PageBlockBlockQuote, error) {
+	var resp PageBlockBlockQuote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockPullQuote(data json.RawMessage) (*PageBlockPullQuote, error) {
+	var resp PageBlockPullQuote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockAnimation(data json.RawMessage) (*PageBlockAnimation, error) {
+	var resp PageBlockAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockAudio(data json.RawMessage) (*PageBlockAudio, error) {
+	var resp PageBlockAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockPhoto(data json.RawMessage) (*PageBlockPhoto, error) {
+	var resp PageBlockPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockVideo(data json.RawMessage) (*PageBlockVideo, error) {
+	var resp PageBlockVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockVoiceNote(data json.RawMessage) (*PageBlockVoiceNote, error) {
+	var resp PageBlockVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockCover(data json.RawMessage) (*PageBlockCover, error) {
+	var resp PageBlockCover
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockEmbedded(data json.RawMessage) (*PageBlockEmbedded, error) {
+	var resp PageBlockEmbedded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockEmbeddedPost(data json.RawMessage) (*PageBlockEmbeddedPost, error) {
+	var resp PageBlockEmbeddedPost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockCollage(data json.RawMessage) (*PageBlockCollage, error) {
+	var resp PageBlockCollage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockSlideshow(data json.RawMessage) (*PageBlockSlideshow, error) {
+	var resp PageBlockSlideshow
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockChatLink(data json.RawMessage) (*PageBlockChatLink, error) {
+	var resp PageBlockChatLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockTable(data json.RawMessage) (*PageBlockTable, error) {
+	var resp PageBlockTable
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockDetails(data json.RawMessage) (*PageBlockDetails, error) {
+	var resp PageBlockDetails
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockRelatedArticles(data json.RawMessage) (*PageBlockRelatedArticles, error) {
+	var resp PageBlockRelatedArticles
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPageBlockMap(data json.RawMessage) (*PageBlockMap, error) {
+	var resp PageBlockMap
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewAlbumMediaPhoto(data json.RawMessage) (*LinkPreviewAlbumMediaPhoto, error) {
+	var resp LinkPreviewAlbumMediaPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewAlbumMediaVideo(data json.RawMessage) (*LinkPreviewAlbumMediaVideo, error) {
+	var resp LinkPreviewAlbumMediaVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeAlbum(data json.RawMessage) (*LinkPreviewTypeAlbum, error) {
+	var resp LinkPreviewTypeAlbum
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeAnimation(data json.RawMessage) (*LinkPreviewTypeAnimation, error) {
+	var resp LinkPreviewTypeAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeApp(data json.RawMessage) (*LinkPreviewTypeApp, error) {
+	var resp LinkPreviewTypeApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeArticle(data json.RawMessage) (*LinkPreviewTypeArticle, error) {
+	var resp LinkPreviewTypeArticle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeAudio(data json.RawMessage) (*LinkPreviewTypeAudio, error) {
+	var resp LinkPreviewTypeAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeBackground(data json.RawMessage) (*LinkPreviewTypeBackground, error) {
+	var resp LinkPreviewTypeBackground
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeChannelBoost(data json.RawMessage) (*LinkPreviewTypeChannelBoost, error) {
+	var resp LinkPreviewTypeChannelBoost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeChat(data json.RawMessage) (*LinkPreviewTypeChat, error) {
+	var resp LinkPreviewTypeChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeDocument(data json.RawMessage) (*LinkPreviewTypeDocument, error) {
+	var resp LinkPreviewTypeDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeEmbeddedAnimationPlayer(data json.RawMessage) (*LinkPreviewTypeEmbeddedAnimationPlayer, error) {
+	var resp LinkPreviewTypeEmbeddedAnimationPlayer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeEmbeddedAudioPlayer(data json.RawMessage) (*LinkPreviewTypeEmbeddedAudioPlayer, error) {
+	var resp LinkPreviewTypeEmbeddedAudioPlayer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeEmbeddedVideoPlayer(data json.RawMessage) (*LinkPreviewTypeEmbeddedVideoPlayer, error) {
+	var resp LinkPreviewTypeEmbeddedVideoPlayer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeExternalAudio(data json.RawMessage) (*LinkPreviewTypeExternalAudio, error) {
+	var resp LinkPreviewTypeExternalAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeExternalVideo(data json.RawMessage) (*LinkPreviewTypeExternalVideo, error) {
+	var resp LinkPreviewTypeExternalVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeInvoice(data json.RawMessage) (*LinkPreviewTypeInvoice, error) {
+	var resp LinkPreviewTypeInvoice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeMessage(data json.RawMessage) (*LinkPreviewTypeMessage, error) {
+	var resp LinkPreviewTypeMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypePhoto(data json.RawMessage) (*LinkPreviewTypePhoto, error) {
+	var resp LinkPreviewTypePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypePremiumGiftCode(data json.RawMessage) (*LinkPreviewTypePremiumGiftCode, error) {
+	var resp LinkPreviewTypePremiumGiftCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeShareableChatFolder(data json.RawMessage) (*LinkPreviewTypeShareableChatFolder, error) {
+	var resp LinkPreviewTypeShareableChatFolder
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeSticker(data json.RawMessage) (*LinkPreviewTypeSticker, error) {
+	var resp LinkPreviewTypeSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeStickerSet(data json.RawMessage) (*LinkPreviewTypeStickerSet, error) {
+	var resp LinkPreviewTypeStickerSet
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeStory(data json.RawMessage) (*LinkPreviewTypeStory, error) {
+	var resp LinkPreviewTypeStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeSupergroupBoost(data json.RawMessage) (*LinkPreviewTypeSupergroupBoost, error) {
+	var resp LinkPreviewTypeSupergroupBoost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeTheme(data json.RawMessage) (*LinkPreviewTypeTheme, error) {
+	var resp LinkPreviewTypeTheme
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeUnsupported(data json.RawMessage) (*LinkPreviewTypeUnsupported, error) {
+	var resp LinkPreviewTypeUnsupported
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeUpgradedGift(data json.RawMessage) (*LinkPreviewTypeUpgradedGift, error) {
+	var resp LinkPreviewTypeUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeUser(data json.RawMessage) (*LinkPreviewTypeUser, error) {
+	var resp LinkPreviewTypeUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeVideo(data json.RawMessage) (*LinkPreviewTypeVideo, error) {
+	var resp LinkPreviewTypeVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeVideoChat(data json.RawMessage) (*LinkPreviewTypeVideoChat, error) {
+	var resp LinkPreviewTypeVideoChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeVideoNote(data json.RawMessage) (*LinkPreviewTypeVideoNote, error) {
+	var resp LinkPreviewTypeVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeVoiceNote(data json.RawMessage) (*LinkPreviewTypeVoiceNote, error) {
+	var resp LinkPreviewTypeVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreviewTypeWebApp(data json.RawMessage) (*LinkPreviewTypeWebApp, error) {
+	var resp LinkPreviewTypeWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLinkPreview(data json.RawMessage) (*LinkPreview, error) {
+	var resp LinkPreview
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCountryInfo(data json.RawMessage) (*CountryInfo, error) {
+	var resp CountryInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCountries(data json.RawMessage) (*Countries, error) {
+	var resp Countries
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPhoneNumberInfo(data json.RawMessage) (*PhoneNumberInfo, error) {
+	var resp PhoneNumberInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCollectibleItemTypeUsername(data json.RawMessage) (*CollectibleItemTypeUsername, error) {
+	var resp CollectibleItemTypeUsername
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCollectibleItemTypePhoneNumber(data json.RawMessage) (*CollectibleItemTypePhoneNumber, error) {
+	var resp CollectibleItemTypePhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCollectibleItemInfo(data json.RawMessage) (*CollectibleItemInfo, error) {
+	var resp CollectibleItemInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBankCardActionOpenUrl(data json.RawMessage) (*BankCardActionOpenUrl, error) {
+	var resp BankCardActionOpenUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBankCardInfo(data json.RawMessage) (*BankCardInfo, error) {
+	var resp BankCardInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAddress(data json.RawMessage) (*Address, error) {
+	var resp Address
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLocationAddress(data json.RawMessage) (*LocationAddress, error) {
+	var resp LocationAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLabeledPricePart(data json.RawMessage) (*LabeledPricePart, error) {
+	var resp LabeledPricePart
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalOrderInfo(data json.RawMessage) (*OrderInfo, error) {
+	var resp OrderInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalShippingOption(data json.RawMessage) (*ShippingOption, error) {
+	var resp ShippingOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSavedCredentials(data json.RawMessage) (*SavedCredentials, error) {
+	var resp SavedCredentials
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputCredentialsSaved(data json.RawMessage) (*InputCredentialsSaved, error) {
+	var resp InputCredentialsSaved
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputCredentialsNew(data json.RawMessage) (*InputCredentialsNew, error) {
+	var resp InputCredentialsNew
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputCredentialsApplePay(data json.RawMessage) (*InputCredentialsApplePay, error) {
+	var resp InputCredentialsApplePay
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputCredentialsGooglePay(data json.RawMessage) (*InputCredentialsGooglePay, error) {
+	var resp InputCredentialsGooglePay
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalValidatedOrderInfo(data json.RawMessage) (*ValidatedOrderInfo, error) {
+	var resp ValidatedOrderInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDate(data json.RawMessage) (*Date, error) {
+	var resp Date
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPersonalDetails(data json.RawMessage) (*PersonalDetails, error) {
+	var resp PersonalDetails
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEncryptedCredentials(data json.RawMessage) (*EncryptedCredentials, error) {
+	var resp EncryptedCredentials
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCurrentWeather(data json.RawMessage) (*CurrentWeather, error) {
+	var resp CurrentWeather
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPublicForwards(data json.RawMessage) (*PublicForwards, error) {
+	var resp PublicForwards
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalResendCodeReasonUserRequest(data json.RawMessage) (*ResendCodeReasonUserRequest, error) {
+	var resp ResendCodeReasonUserRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalResendCodeReasonVerificationFailed(data json.RawMessage) (*ResendCodeReasonVerificationFailed, error) {
+	var resp ResendCodeReasonVerificationFailed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRtmpUrl(data json.RawMessage) (*RtmpUrl, error) {
+	var resp RtmpUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSpeechRecognitionResultPending(data json.RawMessage) (*SpeechRecognitionResultPending, error) {
+	var resp SpeechRecognitionResultPending
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSpeechRecognitionResultText(data json.RawMessage) (*SpeechRecognitionResultText, error) {
+	var resp SpeechRecognitionResultText
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSpeechRecognitionResultError(data json.RawMessage) (*SpeechRecognitionResultError, error) {
+	var resp SpeechRecognitionResultError
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalHttpUrl(data json.RawMessage) (*HttpUrl, error) {
+	var resp HttpUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCustomRequestResult(data json.RawMessage) (*CustomRequestResult, error) {
+	var resp CustomRequestResult
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLanguagePackStringValueOrdinary(data json.RawMessage) (*LanguagePackStringValueOrdinary, error) {
+	var resp LanguagePackStringValueOrdinary
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLanguagePackStringValuePluralized(data json.RawMessage) (*LanguagePackStringValuePluralized, error) {
+	var resp LanguagePackStringValuePluralized
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLanguagePackStringValueDeleted(data json.RawMessage) (*LanguagePackStringValueDeleted, error) {
+	var resp LanguagePackStringValueDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLanguagePackString(data json.RawMessage) (*LanguagePackString, error) {
+	var resp LanguagePackString
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLanguagePackStrings(data json.RawMessage) (*LanguagePackStrings, error) {
+	var resp LanguagePackStrings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLanguagePackInfo(data json.RawMessage) (*LanguagePackInfo, error) {
+	var resp LanguagePackInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLocalizationTargetInfo(data json.RawMessage) (*LocalizationTargetInfo, error) {
+	var resp LocalizationTargetInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenFirebaseCloudMessaging(data json.RawMessage) (*DeviceTokenFirebaseCloudMessaging, error) {
+	var resp DeviceTokenFirebaseCloudMessaging
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenApplePush(data json.RawMessage) (*DeviceTokenApplePush, error) {
+	var resp DeviceTokenApplePush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenApplePushVoIP(data json.RawMessage) (*DeviceTokenApplePushVoIP, error) {
+	var resp DeviceTokenApplePushVoIP
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenWindowsPush(data json.RawMessage) (*DeviceTokenWindowsPush, error) {
+	var resp DeviceTokenWindowsPush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenMicrosoftPush(data json.RawMessage) (*DeviceTokenMicrosoftPush, error) {
+	var resp DeviceTokenMicrosoftPush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenMicrosoftPushVoIP(data json.RawMessage) (*DeviceTokenMicrosoftPushVoIP, error) {
+	var resp DeviceTokenMicrosoftPushVoIP
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenWebPush(data json.RawMessage) (*DeviceTokenWebPush, error) {
+	var resp DeviceTokenWebPush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenSimplePush(data json.RawMessage) (*DeviceTokenSimplePush, error) {
+	var resp DeviceTokenSimplePush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenUbuntuPush(data json.RawMessage) (*DeviceTokenUbuntuPush, error) {
+	var resp DeviceTokenUbuntuPush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenBlackBerryPush(data json.RawMessage) (*DeviceTokenBlackBerryPush, error) {
+	var resp DeviceTokenBlackBerryPush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenTizenPush(data json.RawMessage) (*DeviceTokenTizenPush, error) {
+	var resp DeviceTokenTizenPush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeviceTokenHuaweiPush(data json.RawMessage) (*DeviceTokenHuaweiPush, error) {
+	var resp DeviceTokenHuaweiPush
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushReceiverId(data json.RawMessage) (*PushReceiverId, error) {
+	var resp PushReceiverId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTimeZone(data json.RawMessage) (*TimeZone, error) {
+	var resp TimeZone
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTimeZones(data json.RawMessage) (*TimeZones, error) {
+	var resp TimeZones
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalHashtags(data json.RawMessage) (*Hashtags, error) {
+	var resp Hashtags
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanTransferOwnershipResultOk(data json.RawMessage) (*CanTransferOwnershipResultOk, error) {
+	var resp CanTransferOwnershipResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanTransferOwnershipResultPasswordNeeded(data json.RawMessage) (*CanTransferOwnershipResultPasswordNeeded, error) {
+	var resp CanTransferOwnershipResultPasswordNeeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanTransferOwnershipResultPasswordTooFresh(data json.RawMessage) (*CanTransferOwnershipResultPasswordTooFresh, error) {
+	var resp CanTransferOwnershipResultPasswordTooFresh
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanTransferOwnershipResultSessionTooFresh(data json.RawMessage) (*CanTransferOwnershipResultSessionTooFresh, error) {
+	var resp CanTransferOwnershipResultSessionTooFresh
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationTypeNewMessage(data json.RawMessage) (*NotificationTypeNewMessage, error) {
+	var resp NotificationTypeNewMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationTypeNewSecretChat(data json.RawMessage) (*NotificationTypeNewSecretChat, error) {
+	var resp NotificationTypeNewSecretChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationTypeNewCall(data json.RawMessage) (*NotificationTypeNewCall, error) {
+	var resp NotificationTypeNewCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationTypeNewPushMessage(data json.RawMessage) (*NotificationTypeNewPushMessage, error) {
+	var resp NotificationTypeNewPushMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationSound(data json.RawMessage) (*NotificationSound, error) {
+	var resp NotificationSound
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotificationSounds(data json.RawMessage) (*NotificationSounds, error) {
+	var resp NotificationSounds
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNotification(data json.RawMessage) (*Notification, error) {
+	var resp Notification
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalOptionValueBoolean(data json.RawMessage) (*OptionValueBoolean, error) {
+	var resp OptionValueBoolean
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalOptionValueEmpty(data json.RawMessage) (*OptionValueEmpty, error) {
+	var resp OptionValueEmpty
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalOptionValueInteger(data json.RawMessage) (*OptionValueInteger, error) {
+	var resp OptionValueInteger
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalOptionValueString(data json.RawMessage) (*OptionValueString, error) {
+	var resp OptionValueString
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalJsonObjectMember(data json.RawMessage) (*JsonObjectMember, error) {
+	var resp JsonObjectMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalJsonValueNull(data json.RawMessage) (*JsonValueNull, error) {
+	var resp JsonValueNull
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalJsonValueBoolean(data json.RawMessage) (*JsonValueBoolean, error) {
+	var resp JsonValueBoolean
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalJsonValueNumber(data json.RawMessage) (*JsonValueNumber, error) {
+	var resp JsonValueNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalJsonValueString(data json.RawMessage) (*JsonValueString, error) {
+	var resp JsonValueString
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalJsonValueArray(data json.RawMessage) (*JsonValueArray, error) {
+	var resp JsonValueArray
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalJsonValueObject(data json.RawMessage) (*JsonValueObject, error) {
+	var resp JsonValueObject
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAccountTtl(data json.RawMessage) (*AccountTtl, error) {
+	var resp AccountTtl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectedWebsite(data json.RawMessage) (*ConnectedWebsite, error) {
+	var resp ConnectedWebsite
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectedWebsites(data json.RawMessage) (*ConnectedWebsites, error) {
+	var resp ConnectedWebsites
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonSpam(data json.RawMessage) (*ReportReasonSpam, error) {
+	var resp ReportReasonSpam
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonViolence(data json.RawMessage) (*ReportReasonViolence, error) {
+	var resp ReportReasonViolence
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonPornography(data json.RawMessage) (*ReportReasonPornography, error) {
+	var resp ReportReasonPornography
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonChildAbuse(data json.RawMessage) (*ReportReasonChildAbuse, error) {
+	var resp ReportReasonChildAbuse
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonCopyright(data json.RawMessage) (*ReportReasonCopyright, error) {
+	var resp ReportReasonCopyright
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonUnrelatedLocation(data json.RawMessage) (*ReportReasonUnrelatedLocation, error) {
+	var resp ReportReasonUnrelatedLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonFake(data json.RawMessage) (*ReportReasonFake, error) {
+	var resp ReportReasonFake
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonIllegalDrugs(data json.RawMessage) (*ReportReasonIllegalDrugs, error) {
+	var resp ReportReasonIllegalDrugs
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonPersonalDetails(data json.RawMessage) (*ReportReasonPersonalDetails, error) {
+	var resp ReportReasonPersonalDetails
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportReasonCustom(data json.RawMessage) (*ReportReasonCustom, error) {
+	var resp ReportReasonCustom
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeActiveSessions(data json.RawMessage) (*InternalLinkTypeActiveSessions, error) {
+	var resp InternalLinkTypeActiveSessions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeAttachmentMenuBot(data json.RawMessage) (*InternalLinkTypeAttachmentMenuBot, error) {
+	var resp InternalLinkTypeAttachmentMenuBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeAuthenticationCode(data json.RawMessage) (*InternalLinkTypeAuthenticationCode, error) {
+	var resp InternalLinkTypeAuthenticationCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeBackground(data json.RawMessage) (*InternalLinkTypeBackground, error) {
+	var resp InternalLinkTypeBackground
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeBotAddToChannel(data json.RawMessage) (*InternalLinkTypeBotAddToChannel, error) {
+	var resp InternalLinkTypeBotAddToChannel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeBotStart(data json.RawMessage) (*InternalLinkTypeBotStart, error) {
+	var resp InternalLinkTypeBotStart
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeBotStartInGroup(data json.RawMessage) (*InternalLinkTypeBotStartInGroup, error) {
+	var resp InternalLinkTypeBotStartInGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeBusinessChat(data json.RawMessage) (*InternalLinkTypeBusinessChat, error) {
+	var resp InternalLinkTypeBusinessChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeBuyStars(data json.RawMessage) (*InternalLinkTypeBuyStars, error) {
+	var resp InternalLinkTypeBuyStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeChangePhoneNumber(data json.RawMessage) (*InternalLinkTypeChangePhoneNumber, error) {
+	var resp InternalLinkTypeChangePhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeChatAffiliateProgram(data json.RawMessage) (*InternalLinkTypeChatAffiliateProgram, error) {
+	var resp InternalLinkTypeChatAffiliateProgram
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeChatBoost(data json.RawMessage) (*InternalLinkTypeChatBoost, error) {
+	var resp InternalLinkTypeChatBoost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeChatFolderInvite(data json.RawMessage) (*InternalLinkTypeChatFolderInvite, error) {
+	var resp InternalLinkTypeChatFolderInvite
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeChatFolderSettings(data json.RawMessage) (*InternalLinkTypeChatFolderSettings, error) {
+	var resp InternalLinkTypeChatFolderSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeChatInvite(data json.RawMessage) (*InternalLinkTypeChatInvite, error) {
+	var resp InternalLinkTypeChatInvite
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeDefaultMessageAutoDeleteTimerSettings(data json.RawMessage) (*InternalLinkTypeDefaultMessageAutoDeleteTimerSettings, error) {
+	var resp InternalLinkTypeDefaultMessageAutoDeleteTimerSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeEditProfileSettings(data json.RawMessage) (*InternalLinkTypeEditProfileSettings, error) {
+	var resp InternalLinkTypeEditProfileSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeGame(data json.RawMessage) (*InternalLinkTypeGame, error) {
+	var resp InternalLinkTypeGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeInstantView(data json.RawMessage) (*InternalLinkTypeInstantView, error) {
+	var resp InternalLinkTypeInstantView
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeInvoice(data json.RawMessage) (*InternalLinkTypeInvoice, error) {
+	var resp InternalLinkTypeInvoice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeLanguagePack(data json.RawMessage) (*InternalLinkTypeLanguagePack, error) {
+	var resp InternalLinkTypeLanguagePack
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeLanguageSettings(data json.RawMessage) (*InternalLinkTypeLanguageSettings, error) {
+	var resp InternalLinkTypeLanguageSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeMainWebApp(data json.RawMessage) (*InternalLinkTypeMainWebApp, error) {
+	var resp InternalLinkTypeMainWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeMessage(data json.RawMessage) (*InternalLinkTypeMessage, error) {
+	var resp InternalLinkTypeMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeMessageDraft(data json.RawMessage) (*InternalLinkTypeMessageDraft, error) {
+	var resp InternalLinkTypeMessageDraft
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypePassportDataRequest(data json.RawMessage) (*InternalLinkTypePassportDataRequest, error) {
+	var resp InternalLinkTypePassportDataRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypePhoneNumberConfirmation(data json.RawMessage) (*InternalLinkTypePhoneNumberConfirmation, error) {
+	var resp InternalLinkTypePhoneNumberConfirmation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypePremiumFeatures(data json.RawMessage) (*InternalLinkTypePremiumFeatures, error) {
+	var resp InternalLinkTypePremiumFeatures
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypePremiumGift(data json.RawMessage) (*InternalLinkTypePremiumGift, error) {
+	var resp InternalLinkTypePremiumGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypePremiumGiftCode(data json.RawMessage) (*InternalLinkTypePremiumGiftCode, error) {
+	var resp InternalLinkTypePremiumGiftCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypePrivacyAndSecuritySettings(data json.RawMessage) (*InternalLinkTypePrivacyAndSecuritySettings, error) {
+	var resp InternalLinkTypePrivacyAndSecuritySettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeProxy(data json.RawMessage) (*InternalLinkTypeProxy, error) {
+	var resp InternalLinkTypeProxy
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypePublicChat(data json.RawMessage) (*InternalLinkTypePublicChat, error) {
+	var resp InternalLinkTypePublicChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeQrCodeAuthentication(data json.RawMessage) (*InternalLinkTypeQrCodeAuthentication, error) {
+	var resp InternalLinkTypeQrCodeAuthentication
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeRestorePurchases(data json.RawMessage) (*InternalLinkTypeRestorePurchases, error) {
+	var resp InternalLinkTypeRestorePurchases
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeSettings(data json.RawMessage) (*InternalLinkTypeSettings, error) {
+	var resp InternalLinkTypeSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeStickerSet(data json.RawMessage) (*InternalLinkTypeStickerSet, error) {
+	var resp InternalLinkTypeStickerSet
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeStory(data json.RawMessage) (*InternalLinkTypeStory, error) {
+	var resp InternalLinkTypeStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeTheme(data json.RawMessage) (*InternalLinkTypeTheme, error) {
+	var resp InternalLinkTypeTheme
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeThemeSettings(data json.RawMessage) (*InternalLinkTypeThemeSettings, error) {
+	var resp InternalLinkTypeThemeSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeUnknownDeepLink(data json.RawMessage) (*InternalLinkTypeUnknownDeepLink, error) {
+	var resp InternalLinkTypeUnknownDeepLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeUnsupportedProxy(data json.RawMessage) (*InternalLinkTypeUnsupportedProxy, error) {
+	var resp InternalLinkTypeUnsupportedProxy
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeUpgradedGift(data json.RawMessage) (*InternalLinkTypeUpgradedGift, error) {
+	var resp InternalLinkTypeUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeUserPhoneNumber(data json.RawMessage) (*InternalLinkTypeUserPhoneNumber, error) {
+	var resp InternalLinkTypeUserPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeUserToken(data json.RawMessage) (*InternalLinkTypeUserToken, error) {
+	var resp InternalLinkTypeUserToken
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeVideoChat(data json.RawMessage) (*InternalLinkTypeVideoChat, error) {
+	var resp InternalLinkTypeVideoChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInternalLinkTypeWebApp(data json.RawMessage) (*InternalLinkTypeWebApp, error) {
+	var resp InternalLinkTypeWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBlockListMain(data json.RawMessage) (*BlockListMain, error) {
+	var resp BlockListMain
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBlockListStories(data json.RawMessage) (*BlockListStories, error) {
+	var resp BlockListStories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorageStatistics(data json.RawMessage) (*StorageStatistics, error) {
+	var resp StorageStatistics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorageStatisticsFast(data json.RawMessage) (*StorageStatisticsFast, error) {
+	var resp StorageStatisticsFast
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDatabaseStatistics(data json.RawMessage) (*DatabaseStatistics, error) {
+	var resp DatabaseStatistics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkTypeNone(data json.RawMessage) (*NetworkTypeNone, error) {
+	var resp NetworkTypeNone
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkTypeMobile(data json.RawMessage) (*NetworkTypeMobile, error) {
+	var resp NetworkTypeMobile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkTypeMobileRoaming(data json.RawMessage) (*NetworkTypeMobileRoaming, error) {
+	var resp NetworkTypeMobileRoaming
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkTypeWiFi(data json.RawMessage) (*NetworkTypeWiFi, error) {
+	var resp NetworkTypeWiFi
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkTypeOther(data json.RawMessage) (*NetworkTypeOther, error) {
+	var resp NetworkTypeOther
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkStatisticsEntryFile(data json.RawMessage) (*NetworkStatisticsEntryFile, error) {
+	var resp NetworkStatisticsEntryFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkStatisticsEntryCall(data json.RawMessage) (*NetworkStatisticsEntryCall, error) {
+	var resp NetworkStatisticsEntryCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalNetworkStatistics(data json.RawMessage) (*NetworkStatistics, error) {
+	var resp NetworkStatistics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutosaveSettingsScopePrivateChats(data json.RawMessage) (*AutosaveSettingsScopePrivateChats, error) {
+	var resp AutosaveSettingsScopePrivateChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutosaveSettingsScopeGroupChats(data json.RawMessage) (*AutosaveSettingsScopeGroupChats, error) {
+	var resp AutosaveSettingsScopeGroupChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutosaveSettingsScopeChannelChats(data json.RawMessage) (*AutosaveSettingsScopeChannelChats, error) {
+	var resp AutosaveSettingsScopeChannelChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutosaveSettingsScopeChat(data json.RawMessage) (*AutosaveSettingsScopeChat, error) {
+	var resp AutosaveSettingsScopeChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalScopeAutosaveSettings(data json.RawMessage) (*ScopeAutosaveSettings, error) {
+	var resp ScopeAutosaveSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutosaveSettingsException(data json.RawMessage) (*AutosaveSettingsException, error) {
+	var resp AutosaveSettingsException
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutosaveSettings(data json.RawMessage) (*AutosaveSettings, error) {
+	var resp AutosaveSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectionStateWaitingForNetwork(data json.RawMessage) (*ConnectionStateWaitingForNetwork, error) {
+	var resp ConnectionStateWaitingForNetwork
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectionStateConnectingToProxy(data json.RawMessage) (*ConnectionStateConnectingToProxy, error) {
+	var resp ConnectionStateConnectingToProxy
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectionStateConnecting(data json.RawMessage) (*ConnectionStateConnecting, error) {
+	var resp ConnectionStateConnecting
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectionStateUpdating(data json.RawMessage) (*ConnectionStateUpdating, error) {
+	var resp ConnectionStateUpdating
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectionStateReady(data json.RawMessage) (*ConnectionStateReady, error) {
+	var resp ConnectionStateReady
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundPosition(data json.RawMessage) (*FoundPosition, error) {
+	var resp FoundPosition
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundPositions(data json.RawMessage) (*FoundPositions, error) {
+	var resp FoundPositions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTMeUrlTypeUser(data json.RawMessage) (*TMeUrlTypeUser, error) {
+	var resp TMeUrlTypeUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTMeUrlTypeSupergroup(data json.RawMessage) (*TMeUrlTypeSupergroup, error) {
+	var resp TMeUrlTypeSupergroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTMeUrlTypeChatInvite(data json.RawMessage) (*TMeUrlTypeChatInvite, error) {
+	var resp TMeUrlTypeChatInvite
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTMeUrlTypeStickerSet(data json.RawMessage) (*TMeUrlTypeStickerSet, error) {
+	var resp TMeUrlTypeStickerSet
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTMeUrl(data json.RawMessage) (*TMeUrl, error) {
+	var resp TMeUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTMeUrls(data json.RawMessage) (*TMeUrls, error) {
+	var resp TMeUrls
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionEnableArchiveAndMuteNewChats(data json.RawMessage) (*SuggestedActionEnableArchiveAndMuteNewChats, error) {
+	var resp SuggestedActionEnableArchiveAndMuteNewChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionCheckPassword(data json.RawMessage) (*SuggestedActionCheckPassword, error) {
+	var resp SuggestedActionCheckPassword
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionCheckPhoneNumber(data json.RawMessage) (*SuggestedActionCheckPhoneNumber, error) {
+	var resp SuggestedActionCheckPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionViewChecksHint(data json.RawMessage) (*SuggestedActionViewChecksHint, error) {
+	var resp SuggestedActionViewChecksHint
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionConvertToBroadcastGroup(data json.RawMessage) (*SuggestedActionConvertToBroadcastGroup, error) {
+	var resp SuggestedActionConvertToBroadcastGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionSetPassword(data json.RawMessage) (*SuggestedActionSetPassword, error) {
+	var resp SuggestedActionSetPassword
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionUpgradePremium(data json.RawMessage) (*SuggestedActionUpgradePremium, error) {
+	var resp SuggestedActionUpgradePremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionRestorePremium(data json.RawMessage) (*SuggestedActionRestorePremium, error) {
+	var resp SuggestedActionRestorePremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionSubscribeToAnnualPremium(data json.RawMessage) (*SuggestedActionSubscribeToAnnualPremium, error) {
+	var resp SuggestedActionSubscribeToAnnualPremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionGiftPremiumForChristmas(data json.RawMessage) (*SuggestedActionGiftPremiumForChristmas, error) {
+	var resp SuggestedActionGiftPremiumForChristmas
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionSetBirthdate(data json.RawMessage) (*SuggestedActionSetBirthdate, error) {
+	var resp SuggestedActionSetBirthdate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionSetProfilePhoto(data json.RawMessage) (*SuggestedActionSetProfilePhoto, error) {
+	var resp SuggestedActionSetProfilePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionExtendPremium(data json.RawMessage) (*SuggestedActionExtendPremium, error) {
+	var resp SuggestedActionExtendPremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSuggestedActionExtendStarSubscriptions(data json.RawMessage) (*SuggestedActionExtendStarSubscriptions, error) {
+	var resp SuggestedActionExtendStarSubscriptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCount(data json.RawMessage) (*Count, error) {
+	var resp Count
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSeconds(data json.RawMessage) (*Seconds, error) {
+	var resp Seconds
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDeepLinkInfo(data json.RawMessage) (*DeepLinkInfo, error) {
+	var resp DeepLinkInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProxyTypeSocks5(data json.RawMessage) (*ProxyTypeSocks5, error) {
+	var resp ProxyTypeSocks5
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProxyTypeHttp(data json.RawMessage) (*ProxyTypeHttp, error) {
+	var resp ProxyTypeHttp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProxyTypeMtproto(data json.RawMessage) (*ProxyTypeMtproto, error) {
+	var resp ProxyTypeMtproto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProxy(data json.RawMessage) (*Proxy, error) {
+	var resp Proxy
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProxies(data json.RawMessage) (*Proxies, error) {
+	var resp Proxies
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDateRange(data json.RawMessage) (*DateRange, error) {
+	var resp DateRange
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStatisticalValue(data json.RawMessage) (*StatisticalValue, error) {
+	var resp StatisticalValue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStatisticalGraphData(data json.RawMessage) (*StatisticalGraphData, error) {
+	var resp StatisticalGraphData
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStatisticalGraphAsync(data json.RawMessage) (*StatisticalGraphAsync, error) {
+	var resp StatisticalGraphAsync
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStatisticalGraphError(data json.RawMessage) (*StatisticalGraphError, error) {
+	var resp StatisticalGraphError
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPoint(data json.RawMessage) (*Point, error) {
+	var resp Point
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVectorPathCommandLine(data json.RawMessage) (*VectorPathCommandLine, error) {
+	var resp VectorPathCommandLine
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVectorPathCommandCubicBezierCurve(data json.RawMessage) (*VectorPathCommandCubicBezierCurve, error) {
+	var resp VectorPathCommandCubicBezierCurve
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPhoneNumberCodeTypeChange(data json.RawMessage) (*PhoneNumberCodeTypeChange, error) {
+	var resp PhoneNumberCodeTypeChange
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPhoneNumberCodeTypeVerify(data json.RawMessage) (*PhoneNumberCodeTypeVerify, error) {
+	var resp PhoneNumberCodeTypeVerify
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPhoneNumberCodeTypeConfirmOwnership(data json.RawMessage) (*PhoneNumberCodeTypeConfirmOwnership, error) {
+	var resp PhoneNumberCodeTypeConfirmOwnership
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLogStreamDefault(data json.RawMessage) (*LogStreamDefault, error) {
+	var resp LogStreamDefault
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLogStreamFile(data json.RawMessage) (*LogStreamFile, error) {
+	var resp LogStreamFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLogStreamEmpty(data json.RawMessage) (*LogStreamEmpty, error) {
+	var resp LogStreamEmpty
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLogVerbosityLevel(data json.RawMessage) (*LogVerbosityLevel, error) {
+	var resp LogVerbosityLevel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalLogTags(data json.RawMessage) (*LogTags, error) {
+	var resp LogTags
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTestInt(data json.RawMessage) (*TestInt, error) {
+	var resp TestInt
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTestString(data json.RawMessage) (*TestString, error) {
+	var resp TestString
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTestBytes(data json.RawMessage) (*TestBytes, error) {
+	var resp TestBytes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTestVectorInt(data json.RawMessage) (*TestVectorInt, error) {
+	var resp TestVectorInt
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTestVectorIntObject(data json.RawMessage) (*TestVectorIntObject, error) {
+	var resp TestVectorIntObject
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTestVectorString(data json.RawMessage) (*TestVectorString, error) {
+	var resp TestVectorString
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTestVectorStringObject(data json.RawMessage) (*TestVectorStringObject, error) {
+	var resp TestVectorStringObject
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalType(data json.RawMessage) (Type, error) {
+	return unmarshalJSON(data, decodeType)
+}
+
+func decodeType(decoder *jsonDecoder) (Type, error) {
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorError:
+		return decodeEntity[Error](decoder)
+
+	case ConstructorOk:
+		return decodeEntity[Ok](decoder)
+
+	case ConstructorAuthenticationCodeTypeTelegramMessage:
+		return decodeEntity[AuthenticationCodeTypeTelegramMessage](decoder)
+
+	case ConstructorAuthenticationCodeTypeSms:
+		return decodeEntity[AuthenticationCodeTypeSms](decoder)
+
+	case ConstructorAuthenticationCodeTypeSmsWord:
+		return decodeEntity[AuthenticationCodeTypeSmsWord](decoder)
+
+	case ConstructorAuthenticationCodeTypeSmsPhrase:
+		return decodeEntity[AuthenticationCodeTypeSmsPhrase](decoder)
+
+	case ConstructorAuthenticationCodeTypeCall:
+		return decodeEntity[AuthenticationCodeTypeCall](decoder)
+
+	case ConstructorAuthenticationCodeTypeFlashCall:
+		return decodeEntity[AuthenticationCodeTypeFlashCall](decoder)
+
+	case ConstructorAuthenticationCodeTypeMissedCall:
+		return decodeEntity[AuthenticationCodeTypeMissedCall](decoder)
+
+	case ConstructorAuthenticationCodeTypeFragment:
+		return decodeEntity[AuthenticationCodeTypeFragment](decoder)
+
+	case ConstructorAuthenticationCodeTypeFirebaseAndroid:
+		return decodeEntity[AuthenticationCodeTypeFirebaseAndroid](decoder)
+
+	case ConstructorAuthenticationCodeTypeFirebaseIos:
+		return decodeEntity[AuthenticationCodeTypeFirebaseIos](decoder)
+
+	case ConstructorAuthenticationCodeInfo:
+		return decodeEntity[AuthenticationCodeInfo](decoder)
+
+	case ConstructorEmailAddressAuthenticationCodeInfo:
+		return decodeEntity[EmailAddressAuthenticationCodeInfo](decoder)
+
+	case ConstructorEmailAddressAuthenticationCode:
+		return decodeEntity[EmailAddressAuthenticationCode](decoder)
+
+	case ConstructorEmailAddressAuthenticationAppleId:
+		return decodeEntity[EmailAddressAuthenticationAppleId](decoder)
+
+	case ConstructorEmailAddressAuthenticationGoogleId:
+		return decodeEntity[EmailAddressAuthenticationGoogleId](decoder)
+
+	case ConstructorEmailAddressResetStateAvailable:
+		return decodeEntity[EmailAddressResetStateAvailable](decoder)
+
+	case ConstructorEmailAddressResetStatePending:
+		return decodeEntity[EmailAddressResetStatePending](decoder)
+
+	case ConstructorTextEntity:
+		return decodeEntity[TextEntity](decoder)
+
+	case ConstructorTextEntities:
+		return decodeEntity[TextEntities](decoder)
+
+	case ConstructorFormattedText:
+		return decodeEntity[FormattedText](decoder)
+
+	case ConstructorTermsOfService:
+		return decodeEntity[TermsOfService](decoder)
+
+	case ConstructorAuthorizationStateWaitTdlibParameters:
+		return decodeEntity[AuthorizationStateWaitTdlibParameters](decoder)
+
+	case ConstructorAuthorizationStateWaitPhoneNumber:
+		return decodeEntity[AuthorizationStateWaitPhoneNumber](decoder)
+
+	case ConstructorAuthorizationStateWaitPremiumPurchase:
+		return decodeEntity[AuthorizationStateWaitPremiumPurchase](decoder)
+
+	case ConstructorAuthorizationStateWaitEmailAddress:
+		return decodeEntity[AuthorizationStateWaitEmailAddress](decoder)
+
+	case ConstructorAuthorizationStateWaitEmailCode:
+		return decodeEntity[AuthorizationStateWaitEmailCode](decoder)
+
+	case ConstructorAuthorizationStateWaitCode:
+		return decodeEntity[AuthorizationStateWaitCode](decoder)
+
+	case ConstructorAuthorizationStateWaitOtherDeviceConfirmation:
+		return decodeEntity[AuthorizationStateWaitOtherDeviceConfirmation](decoder)
+
+	case ConstructorAuthorizationStateWaitRegistration:
+		return decodeEntity[AuthorizationStateWaitRegistration](decoder)
+
+	case ConstructorAuthorizationStateWaitPassword:
+		return decodeEntity[AuthorizationStateWaitPassword](decoder)
+
+	case ConstructorAuthorizationStateReady:
+		return decodeEntity[AuthorizationStateReady](decoder)
+
+	case ConstructorAuthorizationStateLoggingOut:
+		return decodeEntity[AuthorizationStateLoggingOut](decoder)
+
+	case ConstructorAuthorizationStateClosing:
+		return decodeEntity[AuthorizationStateClosing](decoder)
+
+	case ConstructorAuthorizationStateClosed:
+		return decodeEntity[AuthorizationStateClosed](decoder)
+
+	case ConstructorFirebaseDeviceVerificationParametersSafetyNet:
+		return decodeEntity[FirebaseDeviceVerificationParametersSafetyNet](decoder)
+
+	case ConstructorFirebaseDeviceVerificationParametersPlayIntegrity:
+		return decodeEntity[FirebaseDeviceVerificationParametersPlayIntegrity](decoder)
+
+	case ConstructorPasswordState:
+		return decodeEntity[PasswordState](decoder)
+
+	case ConstructorRecoveryEmailAddress:
+		return decodeEntity[RecoveryEmailAddress](decoder)
+
+	case ConstructorTemporaryPasswordState:
+		return decodeEntity[TemporaryPasswordState](decoder)
+
+	case ConstructorLocalFile:
+		return decodeEntity[LocalFile](decoder)
+
+	case ConstructorRemoteFile:
+		return decodeEntity[RemoteFile](decoder)
+
+	case ConstructorFile:
+		return decodeEntity[File](decoder)
+
+	case ConstructorInputFileId:
+		return decodeEntity[InputFileId](decoder)
+
+	case ConstructorInputFileRemote:
+		return decodeEntity[InputFileRemote](decoder)
+
+	case ConstructorInputFileLocal:
+		return decodeEntity[InputFileLocal](decoder)
+
+	case ConstructorInputFileGenerated:
+		return decodeEntity[InputFileGenerated](decoder)
+
+	case ConstructorPhotoSize:
+		return decodeEntity[PhotoSize](decoder)
+
+	case ConstructorMinithumbnail:
+		return decodeEntity[Minithumbnail](decoder)
+
+	case ConstructorThumbnailFormatJpeg:
+		return decodeEntity[ThumbnailFormatJpeg](decoder)
+
+	case ConstructorThumbnailFormatGif:
+		return decodeEntity[ThumbnailFormatGif](decoder)
+
+	case ConstructorThumbnailFormatMpeg4:
+		return decodeEntity[ThumbnailFormatMpeg4](decoder)
+
+	case ConstructorThumbnailFormatPng:
+		return decodeEntity[ThumbnailFormatPng](decoder)
+
+	case ConstructorThumbnailFormatTgs:
+		return decodeEntity[ThumbnailFormatTgs](decoder)
+
+	case ConstructorThumbnailFormatWebm:
+		return decodeEntity[ThumbnailFormatWebm](decoder)
+
+	case ConstructorThumbnailFormatWebp:
+		return decodeEntity[ThumbnailFormatWebp](decoder)
+
+	case ConstructorThumbnail:
+		return decodeEntity[Thumbnail](decoder)
+
+	case ConstructorMaskPointForehead:
+		return decodeEntity[MaskPointForehead](decoder)
+
+	case ConstructorMaskPointEyes:
+		return decodeEntity[MaskPointEyes](decoder)
+
+	case ConstructorMaskPointMouth:
+		return decodeEntity[MaskPointMouth](decoder)
+
+	case ConstructorMaskPointChin:
+		return decodeEntity[MaskPointChin](decoder)
+
+	case ConstructorMaskPosition:
+		return decodeEntity[MaskPosition](decoder)
+
+	case ConstructorStickerFormatWebp:
+		return decodeEntity[StickerFormatWebp](decoder)
+
+	case ConstructorStickerFormatTgs:
+		return decodeEntity[StickerFormatTgs](decoder)
+
+	case ConstructorStickerFormatWebm:
+		return decodeEntity[StickerFormatWebm](decoder)
+
+	case ConstructorStickerTypeRegular:
+		return decodeEntity[StickerTypeRegular](decoder)
+
+	case ConstructorStickerTypeMask:
+		return decodeEntity[StickerTypeMask](decoder)
+
+	case ConstructorStickerTypeCustomEmoji:
+		return decodeEntity[StickerTypeCustomEmoji](decoder)
+
+	case ConstructorStickerFullTypeRegular:
+		return decodeEntity[StickerFullTypeRegular](decoder)
+
+	case ConstructorStickerFullTypeMask:
+		return decodeEntity[StickerFullTypeMask](decoder)
+
+	case ConstructorStickerFullTypeCustomEmoji:
+		return decodeEntity[StickerFullTypeCustomEmoji](decoder)
+
+	case ConstructorClosedVectorPath:
+		return decodeEntity[ClosedVectorPath](decoder)
+
+	case ConstructorOutline:
+		return decodeEntity[Outline](decoder)
+
+	case ConstructorPollOption:
+		return decodeEntity[PollOption](decoder)
+
+	case ConstructorPollTypeRegular:
+		return decodeEntity[PollTypeRegular](decoder)
+
+	case ConstructorPollTypeQuiz:
+		return decodeEntity[PollTypeQuiz](decoder)
+
+	case ConstructorAnimation:
+		return decodeEntity[Animation](decoder)
+
+	case ConstructorAudio:
+		return decodeEntity[Audio](decoder)
+
+	case ConstructorDocument:
+		return decodeEntity[Document](decoder)
+
+	case ConstructorPhoto:
+		return decodeEntity[Photo](decoder)
+
+	case ConstructorSticker:
+		return decodeEntity[Sticker](decoder)
+
+	case ConstructorVideo:
+		return decodeEntity[Video](decoder)
+
+	case ConstructorVideoNote:
+		return decodeEntity[VideoNote](decoder)
+
+	case ConstructorVoiceNote:
+		return decodeEntity[VoiceNote](decoder)
+
+	case ConstructorAnimatedEmoji:
+		return decodeEntity[AnimatedEmoji](decoder)
+
+	case ConstructorContact:
+		return decodeEntity[Contact](decoder)
+
+	case ConstructorLocation:
+		return decodeEntity[Location](decoder)
+
+	case ConstructorVenue:
+		return decodeEntity[Venue](decoder)
+
+	case ConstructorGame:
+		return decodeEntity[Game](decoder)
+
+	case ConstructorWebApp:
+		return decodeEntity[WebApp](decoder)
+
+	case ConstructorPoll:
+		return decodeEntity[Poll](decoder)
+
+	case ConstructorAlternativeVideo:
+		return decodeEntity[AlternativeVideo](decoder)
+
+	case ConstructorBackground:
+		return decodeEntity[Background](decoder)
+
+	case ConstructorBackgrounds:
+		return decodeEntity[Backgrounds](decoder)
+
+	case ConstructorChatBackground:
+		return decodeEntity[ChatBackground](decoder)
+
+	case ConstructorProfilePhoto:
+		return decodeEntity[ProfilePhoto](decoder)
+
+	case ConstructorChatPhotoInfo:
+		return decodeEntity[ChatPhotoInfo](decoder)
+
+	case ConstructorUserTypeRegular:
+		return decodeEntity[UserTypeRegular](decoder)
+
+	case ConstructorUserTypeDeleted:
+		return decodeEntity[UserTypeDeleted](decoder)
+
+	case ConstructorUserTypeBot:
+		return decodeEntity[UserTypeBot](decoder)
+
+	case ConstructorUserTypeUnknown:
+		return decodeEntity[UserTypeUnknown](decoder)
+
+	case ConstructorBotCommand:
+		return decodeEntity[BotCommand](decoder)
+
+	case ConstructorBotCommands:
+		return decodeEntity[BotCommands](decoder)
+
+	case ConstructorBotMenuButton:
+		return decodeEntity[BotMenuButton](decoder)
+
+	case ConstructorBotVerificationParameters:
+		return decodeEntity[BotVerificationParameters](decoder)
+
+	case ConstructorBotVerification:
+		return decodeEntity[BotVerification](decoder)
+
+	case ConstructorVerificationStatus:
+		return decodeEntity[VerificationStatus](decoder)
+
+	case ConstructorChatLocation:
+		return decodeEntity[ChatLocation](decoder)
+
+	case ConstructorBirthdate:
+		return decodeEntity[Birthdate](decoder)
+
+	case ConstructorCloseBirthdayUser:
+		return decodeEntity[CloseBirthdayUser](decoder)
+
+	case ConstructorBusinessAwayMessageScheduleAlways:
+		return decodeEntity[BusinessAwayMessageScheduleAlways](decoder)
+
+	case ConstructorBusinessAwayMessageScheduleOutsideOfOpeningHours:
+		return decodeEntity[BusinessAwayMessageScheduleOutsideOfOpeningHours](decoder)
+
+	case ConstructorBusinessAwayMessageScheduleCustom:
+		return decodeEntity[BusinessAwayMessageScheduleCustom](decoder)
+
+	case ConstructorBusinessLocation:
+		return decodeEntity[BusinessLocation](decoder)
+
+	case ConstructorBusinessRecipients:
+		return decodeEntity[BusinessRecipients](decoder)
+
+	case ConstructorBusinessAwayMessageSettings:
+		return decodeEntity[BusinessAwayMessageSettings](decoder)
+
+	case ConstructorBusinessGreetingMessageSettings:
+		return decodeEntity[BusinessGreetingMessageSettings](decoder)
+
+	case ConstructorBusinessBotRights:
+		return decodeEntity[BusinessBotRights](decoder)
+
+	case ConstructorBusinessConnectedBot:
+		return decodeEntity[BusinessConnectedBot](decoder)
+
+	case ConstructorBusinessStartPage:
+		return decodeEntity[BusinessStartPage](decoder)
+
+	case ConstructorInputBusinessStartPage:
+		return decodeEntity[InputBusinessStartPage](decoder)
+
+	case ConstructorBusinessOpeningHoursInterval:
+		return decodeEntity[BusinessOpeningHoursInterval](decoder)
+
+	case ConstructorBusinessOpeningHours:
+		return decodeEntity[BusinessOpeningHours](decoder)
+
+	case ConstructorBusinessInfo:
+		return decodeEntity[BusinessInfo](decoder)
+
+	case ConstructorBusinessChatLink:
+		return decodeEntity[BusinessChatLink](decoder)
+
+	case ConstructorBusinessChatLinks:
+		return decodeEntity[BusinessChatLinks](decoder)
+
+	case ConstructorInputBusinessChatLink:
+		return decodeEntity[InputBusinessChatLink](decoder)
+
+	case ConstructorBusinessChatLinkInfo:
+		return decodeEntity[BusinessChatLinkInfo](decoder)
+
+	case ConstructorChatPhotoStickerTypeRegularOrMask:
+		return decodeEntity[ChatPhotoStickerTypeRegularOrMask](decoder)
+
+	case ConstructorChatPhotoStickerTypeCustomEmoji:
+		return decodeEntity[ChatPhotoStickerTypeCustomEmoji](decoder)
+
+	case ConstructorChatPhotoSticker:
+		return decodeEntity[ChatPhotoSticker](decoder)
+
+	case ConstructorAnimatedChatPhoto:
+		return decodeEntity[AnimatedChatPhoto](decoder)
+
+	case ConstructorChatPhoto:
+		return decodeEntity[ChatPhoto](decoder)
+
+	case ConstructorChatPhotos:
+		return decodeEntity[ChatPhotos](decoder)
+
+	case ConstructorInputChatPhotoPrevious:
+		return decodeEntity[InputChatPhotoPrevious](decoder)
+
+	case ConstructorInputChatPhotoStatic:
+		return decodeEntity[InputChatPhotoStatic](decoder)
+
+	case ConstructorInputChatPhotoAnimation:
+		return decodeEntity[InputChatPhotoAnimation](decoder)
+
+	case ConstructorInputChatPhotoSticker:
+		return decodeEntity[InputChatPhotoSticker](decoder)
+
+	case ConstructorChatPermissions:
+		return decodeEntity[ChatPermissions](decoder)
+
+	case ConstructorChatAdministratorRights:
+		return decodeEntity[ChatAdministratorRights](decoder)
+
+	case ConstructorStarAmount:
+		return decodeEntity[StarAmount](decoder)
+
+	case ConstructorStarSubscriptionTypeChannel:
+		return decodeEntity[StarSubscriptionTypeChannel](decoder)
+
+	case ConstructorStarSubscriptionTypeBot:
+		return decodeEntity[StarSubscriptionTypeBot](decoder)
+
+	case ConstructorStarSubscriptionPricing:
+		return decodeEntity[StarSubscriptionPricing](decoder)
+
+	case ConstructorStarSubscription:
+		return decodeEntity[StarSubscription](decoder)
+
+	case ConstructorStarSubscriptions:
+		return decodeEntity[StarSubscriptions](decoder)
+
+	case ConstructorAffiliateTypeCurrentUser:
+		return decodeEntity[AffiliateTypeCurrentUser](decoder)
+
+	case ConstructorAffiliateTypeBot:
+		return decodeEntity[AffiliateTypeBot](decoder)
+
+	case ConstructorAffiliateTypeChannel:
+		return decodeEntity[AffiliateTypeChannel](decoder)
+
+	case ConstructorAffiliateProgramSortOrderProfitability:
+		return decodeEntity[AffiliateProgramSortOrderProfitability](decoder)
+
+	case ConstructorAffiliateProgramSortOrderCreationDate:
+		return decodeEntity[AffiliateProgramSortOrderCreationDate](decoder)
+
+	case ConstructorAffiliateProgramSortOrderRevenue:
+		return decodeEntity[AffiliateProgramSortOrderRevenue](decoder)
+
+	case ConstructorAffiliateProgramParameters:
+		return decodeEntity[AffiliateProgramParameters](decoder)
+
+	case ConstructorAffiliateProgramInfo:
+		return decodeEntity[AffiliateProgramInfo](decoder)
+
+	case ConstructorAffiliateInfo:
+		return decodeEntity[AffiliateInfo](decoder)
+
+	case ConstructorFoundAffiliateProgram:
+		return decodeEntity[FoundAffiliateProgram](decoder)
+
+	case ConstructorFoundAffiliatePrograms:
+		return decodeEntity[FoundAffiliatePrograms](decoder)
+
+	case ConstructorConnectedAffiliateProgram:
+		return decodeEntity[ConnectedAffiliateProgram](decoder)
+
+	case ConstructorConnectedAffiliatePrograms:
+		return decodeEntity[ConnectedAffiliatePrograms](decoder)
+
+	case ConstructorProductInfo:
+		return decodeEntity[ProductInfo](decoder)
+
+	case ConstructorPremiumPaymentOption:
+		return decodeEntity[PremiumPaymentOption](decoder)
+
+	case ConstructorPremiumStatePaymentOption:
+		return decodeEntity[PremiumStatePaymentOption](decoder)
+
+	case ConstructorPremiumGiftPaymentOption:
+		return decodeEntity[PremiumGiftPaymentOption](decoder)
+
+	case ConstructorPremiumGiftPaymentOptions:
+		return decodeEntity[PremiumGiftPaymentOptions](decoder)
+
+	case ConstructorPremiumGiveawayPaymentOption:
+		return decodeEntity[PremiumGiveawayPaymentOption](decoder)
+
+	case ConstructorPremiumGiveawayPaymentOptions:
+		return decodeEntity[PremiumGiveawayPaymentOptions](decoder)
+
+	case ConstructorPremiumGiftCodeInfo:
+		return decodeEntity[PremiumGiftCodeInfo](decoder)
+
+	case ConstructorStarPaymentOption:
+		return decodeEntity[StarPaymentOption](decoder)
+
+	case ConstructorStarPaymentOptions:
+		return decodeEntity[StarPaymentOptions](decoder)
+
+	case ConstructorStarGiveawayWinnerOption:
+		return decodeEntity[StarGiveawayWinnerOption](decoder)
+
+	case ConstructorStarGiveawayPaymentOption:
+		return decodeEntity[StarGiveawayPaymentOption](decoder)
+
+	case ConstructorStarGiveawayPaymentOptions:
+		return decodeEntity[StarGiveawayPaymentOptions](decoder)
+
+	case ConstructorAcceptedGiftTypes:
+		return decodeEntity[AcceptedGiftTypes](decoder)
+
+	case ConstructorGiftSettings:
+		return decodeEntity[GiftSettings](decoder)
+
+	case ConstructorUpgradedGiftModel:
+		return decodeEntity[UpgradedGiftModel](decoder)
+
+	case ConstructorUpgradedGiftSymbol:
+		return decodeEntity[UpgradedGiftSymbol](decoder)
+
+	case ConstructorUpgradedGiftBackdropColors:
+		return decodeEntity[UpgradedGiftBackdropColors](decoder)
+
+	case ConstructorUpgradedGiftBackdrop:
+		return decodeEntity[UpgradedGiftBackdrop](decoder)
+
+	case ConstructorUpgradedGiftOriginalDetails:
+		return decodeEntity[UpgradedGiftOriginalDetails](decoder)
+
+	case ConstructorGift:
+		return decodeEntity[Gift](decoder)
+
+	case ConstructorGifts:
+		return decodeEntity[Gifts](decoder)
+
+	case ConstructorUpgradedGift:
+		return decodeEntity[UpgradedGift](decoder)
+
+	case ConstructorUpgradeGiftResult:
+		return decodeEntity[UpgradeGiftResult](decoder)
+
+	case ConstructorSentGiftRegular:
+		return decodeEntity[SentGiftRegular](decoder)
+
+	case ConstructorSentGiftUpgraded:
+		return decodeEntity[SentGiftUpgraded](decoder)
+
+	case ConstructorReceivedGift:
+		return decodeEntity[ReceivedGift](decoder)
+
+	case ConstructorReceivedGifts:
+		return decodeEntity[ReceivedGifts](decoder)
+
+	case ConstructorGiftUpgradePreview:
+		return decodeEntity[GiftUpgradePreview](decoder)
+
+	case ConstructorStarTransactionDirectionIncoming:
+		return decodeEntity[StarTransactionDirectionIncoming](decoder)
+
+	case ConstructorStarTransactionDirectionOutgoing:
+		return decodeEntity[StarTransactionDirectionOutgoing](decoder)
+
+	case ConstructorStarTransactionTypePremiumBotDeposit:
+		return decodeEntity[StarTransactionTypePremiumBotDeposit](decoder)
+
+	case ConstructorStarTransactionTypeAppStoreDeposit:
+		return decodeEntity[StarTransactionTypeAppStoreDeposit](decoder)
+
+	case ConstructorStarTransactionTypeGooglePlayDeposit:
+		return decodeEntity[StarTransactionTypeGooglePlayDeposit](decoder)
+
+	case ConstructorStarTransactionTypeFragmentDeposit:
+		return decodeEntity[StarTransactionTypeFragmentDeposit](decoder)
+
+	case ConstructorStarTransactionTypeUserDeposit:
+		return decodeEntity[StarTransactionTypeUserDeposit](decoder)
+
+	case ConstructorStarTransactionTypeGiveawayDeposit:
+		return decodeEntity[StarTransactionTypeGiveawayDeposit](decoder)
+
+	case ConstructorStarTransactionTypeFragmentWithdrawal:
+		return decodeEntity[StarTransactionTypeFragmentWithdrawal](decoder)
+
+	case ConstructorStarTransactionTypeTelegramAdsWithdrawal:
+		return decodeEntity[StarTransactionTypeTelegramAdsWithdrawal](decoder)
+
+	case ConstructorStarTransactionTypeTelegramApiUsage:
+		return decodeEntity[StarTransactionTypeTelegramApiUsage](decoder)
+
+	case ConstructorStarTransactionTypeBotPaidMediaPurchase:
+		return decodeEntity[StarTransactionTypeBotPaidMediaPurchase](decoder)
+
+	case ConstructorStarTransactionTypeBotPaidMediaSale:
+		return decodeEntity[StarTransactionTypeBotPaidMediaSale](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidMediaPurchase:
+		return decodeEntity[StarTransactionTypeChannelPaidMediaPurchase](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidMediaSale:
+		return decodeEntity[StarTransactionTypeChannelPaidMediaSale](decoder)
+
+	case ConstructorStarTransactionTypeBotInvoicePurchase:
+		return decodeEntity[StarTransactionTypeBotInvoicePurchase](decoder)
+
+	case ConstructorStarTransactionTypeBotInvoiceSale:
+		return decodeEntity[StarTransactionTypeBotInvoiceSale](decoder)
+
+	case ConstructorStarTransactionTypeBotSubscriptionPurchase:
+		return decodeEntity[StarTransactionTypeBotSubscriptionPurchase](decoder)
+
+	case ConstructorStarTransactionTypeBotSubscriptionSale:
+		return decodeEntity[StarTransactionTypeBotSubscriptionSale](decoder)
+
+	case ConstructorStarTransactionTypeChannelSubscriptionPurchase:
+		return decodeEntity[StarTransactionTypeChannelSubscriptionPurchase](decoder)
+
+	case ConstructorStarTransactionTypeChannelSubscriptionSale:
+		return decodeEntity[StarTransactionTypeChannelSubscriptionSale](decoder)
+
+	case ConstructorStarTransactionTypeGiftPurchase:
+		return decodeEntity[StarTransactionTypeGiftPurchase](decoder)
+
+	case ConstructorStarTransactionTypeGiftTransfer:
+		return decodeEntity[StarTransactionTypeGiftTransfer](decoder)
+
+	case ConstructorStarTransactionTypeGiftSale:
+		return decodeEntity[StarTransactionTypeGiftSale](decoder)
+
+	case ConstructorStarTransactionTypeGiftUpgrade:
+		return decodeEntity[StarTransactionTypeGiftUpgrade](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidReactionSend:
+		return decodeEntity[StarTransactionTypeChannelPaidReactionSend](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidReactionReceive:
+		return decodeEntity[StarTransactionTypeChannelPaidReactionReceive](decoder)
+
+	case ConstructorStarTransactionTypeAffiliateProgramCommission:
+		return decodeEntity[StarTransactionTypeAffiliateProgramCommission](decoder)
+
+	case ConstructorStarTransactionTypePaidMessageSend:
+		return decodeEntity[StarTransactionTypePaidMessageSend](decoder)
+
+	case ConstructorStarTransactionTypePaidMessageReceive:
+		return decodeEntity[StarTransactionTypePaidMessageReceive](decoder)
+
+	case ConstructorStarTransactionTypePremiumPurchase:
+		return decodeEntity[StarTransactionTypePremiumPurchase](decoder)
+
+	case ConstructorStarTransactionTypeBusinessBotTransferSend:
+		return decodeEntity[StarTransactionTypeBusinessBotTransferSend](decoder)
+
+	case ConstructorStarTransactionTypeBusinessBotTransferReceive:
+		return decodeEntity[StarTransactionTypeBusinessBotTransferReceive](decoder)
+
+	case ConstructorStarTransactionTypeUnsupported:
+		return decodeEntity[StarTransactionTypeUnsupported](decoder)
+
+	case ConstructorStarTransaction:
+		return decodeEntity[StarTransaction](decoder)
+
+	case ConstructorStarTransactions:
+		return decodeEntity[StarTransactions](decoder)
+
+	case ConstructorGiveawayParticipantStatusEligible:
+		return decodeEntity[GiveawayParticipantStatusEligible](decoder)
+
+	case ConstructorGiveawayParticipantStatusParticipating:
+		return decodeEntity[GiveawayParticipantStatusParticipating](decoder)
+
+	case ConstructorGiveawayParticipantStatusAlreadyWasMember:
+		return decodeEntity[GiveawayParticipantStatusAlreadyWasMember](decoder)
+
+	case ConstructorGiveawayParticipantStatusAdministrator:
+		return decodeEntity[GiveawayParticipantStatusAdministrator](decoder)
+
+	case ConstructorGiveawayParticipantStatusDisallowedCountry:
+		return decodeEntity[GiveawayParticipantStatusDisallowedCountry](decoder)
+
+	case ConstructorGiveawayInfoOngoing:
+		return decodeEntity[GiveawayInfoOngoing](decoder)
+
+	case ConstructorGiveawayInfoCompleted:
+		return decodeEntity[GiveawayInfoCompleted](decoder)
+
+	case ConstructorGiveawayPrizePremium:
+		return decodeEntity[GiveawayPrizePremium](decoder)
+
+	case ConstructorGiveawayPrizeStars:
+		return decodeEntity[GiveawayPrizeStars](decoder)
+
+	case ConstructorAccentColor:
+		return decodeEntity[AccentColor](decoder)
+
+	case ConstructorProfileAccentColors:
+		return decodeEntity[ProfileAccentColors](decoder)
+
+	case ConstructorProfileAccentColor:
+		return decodeEntity[ProfileAccentColor](decoder)
+
+	case ConstructorEmojiStatusTypeCustomEmoji:
+		return decodeEntity[EmojiStatusTypeCustomEmoji](decoder)
+
+	case ConstructorEmojiStatusTypeUpgradedGift:
+		return decodeEntity[EmojiStatusTypeUpgradedGift](decoder)
+
+	case ConstructorEmojiStatus:
+		return decodeEntity[EmojiStatus](decoder)
+
+	case ConstructorEmojiStatuses:
+		return decodeEntity[EmojiStatuses](decoder)
+
+	case ConstructorEmojiStatusCustomEmojis:
+		return decodeEntity[EmojiStatusCustomEmojis](decoder)
+
+	case ConstructorUsernames:
+		return decodeEntity[Usernames](decoder)
+
+	case ConstructorUser:
+		return decodeEntity[User](decoder)
+
+	case ConstructorBotInfo:
+		return decodeEntity[BotInfo](decoder)
+
+	case ConstructorUserFullInfo:
+		return decodeEntity[UserFullInfo](decoder)
+
+	case ConstructorUsers:
+		return decodeEntity[Users](decoder)
+
+	case ConstructorFoundUsers:
+		return decodeEntity[FoundUsers](decoder)
+
+	case ConstructorChatAdministrator:
+		return decodeEntity[ChatAdministrator](decoder)
+
+	case ConstructorChatAdministrators:
+		return decodeEntity[ChatAdministrators](decoder)
+
+	case ConstructorChatMemberStatusCreator:
+		return decodeEntity[ChatMemberStatusCreator](decoder)
+
+	case ConstructorChatMemberStatusAdministrator:
+		return decodeEntity[ChatMemberStatusAdministrator](decoder)
+
+	case ConstructorChatMemberStatusMember:
+		return decodeEntity[ChatMemberStatusMember](decoder)
+
+	case ConstructorChatMemberStatusRestricted:
+		return decodeEntity[ChatMemberStatusRestricted](decoder)
+
+	case ConstructorChatMemberStatusLeft:
+		return decodeEntity[ChatMemberStatusLeft](decoder)
+
+	case ConstructorChatMemberStatusBanned:
+		return decodeEntity[ChatMemberStatusBanned](decoder)
+
+	case ConstructorChatMember:
+		return decodeEntity[ChatMember](decoder)
+
+	case ConstructorChatMembers:
+		return decodeEntity[ChatMembers](decoder)
+
+	case ConstructorChatMembersFilterContacts:
+		return decodeEntity[ChatMembersFilterContacts](decoder)
+
+	case ConstructorChatMembersFilterAdministrators:
+		return decodeEntity[ChatMembersFilterAdministrators](decoder)
+
+	case ConstructorChatMembersFilterMembers:
+		return decodeEntity[ChatMembersFilterMembers](decoder)
+
+	case ConstructorChatMembersFilterMention:
+		return decodeEntity[ChatMembersFilterMention](decoder)
+
+	case ConstructorChatMembersFilterRestricted:
+		return decodeEntity[ChatMembersFilterRestricted](decoder)
+
+	case ConstructorChatMembersFilterBanned:
+		return decodeEntity[ChatMembersFilterBanned](decoder)
+
+	case ConstructorChatMembersFilterBots:
+		return decodeEntity[ChatMembersFilterBots](decoder)
+
+	case ConstructorSupergroupMembersFilterRecent:
+		return decodeEntity[SupergroupMembersFilterRecent](decoder)
+
+	case ConstructorSupergroupMembersFilterContacts:
+		return decodeEntity[SupergroupMembersFilterContacts](decoder)
+
+	case ConstructorSupergroupMembersFilterAdministrators:
+		return decodeEntity[SupergroupMembersFilterAdministrators](decoder)
+
+	case ConstructorSupergroupMembersFilterSearch:
+		return decodeEntity[SupergroupMembersFilterSearch](decoder)
+
+	case ConstructorSupergroupMembersFilterRestricted:
+		return decodeEntity[SupergroupMembersFilterRestricted](decoder)
+
+	case ConstructorSupergroupMembersFilterBanned:
+		return decodeEntity[SupergroupMembersFilterBanned](decoder)
+
+	case ConstructorSupergroupMembersFilterMention:
+		return decodeEntity[SupergroupMembersFilterMention](decoder)
+
+	case ConstructorSupergroupMembersFilterBots:
+		return decodeEntity[SupergroupMembersFilterBots](decoder)
+
+	case ConstructorChatInviteLink:
+		return decodeEntity[ChatInviteLink](decoder)
+
+	case ConstructorChatInviteLinks:
+		return decodeEntity[ChatInviteLinks](decoder)
+
+	case ConstructorChatInviteLinkCount:
+		return decodeEntity[ChatInviteLinkCount](decoder)
+
+	case ConstructorChatInviteLinkCounts:
+		return decodeEntity[ChatInviteLinkCounts](decoder)
+
+	case ConstructorChatInviteLinkMember:
+		return decodeEntity[ChatInviteLinkMember](decoder)
+
+	case ConstructorChatInviteLinkMembers:
+		return decodeEntity[ChatInviteLinkMembers](decoder)
+
+	case ConstructorInviteLinkChatTypeBasicGroup:
+		return decodeEntity[InviteLinkChatTypeBasicGroup](decoder)
+
+	case ConstructorInviteLinkChatTypeSupergroup:
+		return decodeEntity[InviteLinkChatTypeSupergroup](decoder)
+
+	case ConstructorInviteLinkChatTypeChannel:
+		return decodeEntity[InviteLinkChatTypeChannel](decoder)
+
+	case ConstructorChatInviteLinkSubscriptionInfo:
+		return decodeEntity[ChatInviteLinkSubscriptionInfo](decoder)
+
+	case ConstructorChatInviteLinkInfo:
+		return decodeEntity[ChatInviteLinkInfo](decoder)
+
+	case ConstructorChatJoinRequest:
+		return decodeEntity[ChatJoinRequest](decoder)
+
+	case ConstructorChatJoinRequests:
+		return decodeEntity[ChatJoinRequests](decoder)
+
+	case ConstructorChatJoinRequestsInfo:
+		return decodeEntity[ChatJoinRequestsInfo](decoder)
+
+	case ConstructorBasicGroup:
+		return decodeEntity[BasicGroup](decoder)
+
+	case ConstructorBasicGroupFullInfo:
+		return decodeEntity[BasicGroupFullInfo](decoder)
+
+	case ConstructorSupergroup:
+		return decodeEntity[Supergroup](decoder)
+
+	case ConstructorSupergroupFullInfo:
+		return decodeEntity[SupergroupFullInfo](decoder)
+
+	case ConstructorSecretChatStatePending:
+		return decodeEntity[SecretChatStatePending](decoder)
+
+	case ConstructorSecretChatStateReady:
+		return decodeEntity[SecretChatStateReady](decoder)
+
+	case ConstructorSecretChatStateClosed:
+		return decodeEntity[SecretChatStateClosed](decoder)
+
+	case ConstructorSecretChat:
+		return decodeEntity[SecretChat](decoder)
+
+	case ConstructorMessageSenderUser:
+		return decodeEntity[MessageSenderUser](decoder)
+
+	case ConstructorMessageSenderChat:
+		return decodeEntity[MessageSenderChat](decoder)
+
+	case ConstructorMessageSenders:
+		return decodeEntity[MessageSenders](decoder)
+
+	case ConstructorChatMessageSender:
+		return decodeEntity[ChatMessageSender](decoder)
+
+	case ConstructorChatMessageSenders:
+		return decodeEntity[ChatMessageSenders](decoder)
+
+	case ConstructorMessageReadDateRead:
+		return decodeEntity[MessageReadDateRead](decoder)
+
+	case ConstructorMessageReadDateUnread:
+		return decodeEntity[MessageReadDateUnread](decoder)
+
+	case ConstructorMessageReadDateTooOld:
+		return decodeEntity[MessageReadDateTooOld](decoder)
+
+	case ConstructorMessageReadDateUserPrivacyRestricted:
+		return decodeEntity[MessageReadDateUserPrivacyRestricted](decoder)
+
+	case ConstructorMessageReadDateMyPrivacyRestricted:
+		return decodeEntity[MessageReadDateMyPrivacyRestricted](decoder)
+
+	case ConstructorMessageViewer:
+		return decodeEntity[MessageViewer](decoder)
+
+	case ConstructorMessageViewers:
+		return decodeEntity[MessageViewers](decoder)
+
+	case ConstructorMessageOriginUser:
+		return decodeEntity[MessageOriginUser](decoder)
+
+	case ConstructorMessageOriginHiddenUser:
+		return decodeEntity[MessageOriginHiddenUser](decoder)
+
+	case ConstructorMessageOriginChat:
+		return decodeEntity[MessageOriginChat](decoder)
+
+	case ConstructorMessageOriginChannel:
+		return decodeEntity[MessageOriginChannel](decoder)
+
+	case ConstructorForwardSource:
+		return decodeEntity[ForwardSource](decoder)
+
+	case ConstructorReactionTypeEmoji:
+		return decodeEntity[ReactionTypeEmoji](decoder)
+
+	case ConstructorReactionTypeCustomEmoji:
+		return decodeEntity[ReactionTypeCustomEmoji](decoder)
+
+	case ConstructorReactionTypePaid:
+		return decodeEntity[ReactionTypePaid](decoder)
+
+	case ConstructorPaidReactionTypeRegular:
+		return decodeEntity[PaidReactionTypeRegular](decoder)
+
+	case ConstructorPaidReactionTypeAnonymous:
+		return decodeEntity[PaidReactionTypeAnonymous](decoder)
+
+	case ConstructorPaidReactionTypeChat:
+		return decodeEntity[PaidReactionTypeChat](decoder)
+
+	case ConstructorPaidReactor:
+		return decodeEntity[PaidReactor](decoder)
+
+	case ConstructorMessageForwardInfo:
+		return decodeEntity[MessageForwardInfo](decoder)
+
+	case ConstructorMessageImportInfo:
+		return decodeEntity[MessageImportInfo](decoder)
+
+	case ConstructorMessageReplyInfo:
+		return decodeEntity[MessageReplyInfo](decoder)
+
+	case ConstructorMessageReaction:
+		return decodeEntity[MessageReaction](decoder)
+
+	case ConstructorMessageReactions:
+		return decodeEntity[MessageReactions](decoder)
+
+	case ConstructorMessageInteractionInfo:
+		return decodeEntity[MessageInteractionInfo](decoder)
+
+	case ConstructorUnreadReaction:
+		return decodeEntity[UnreadReaction](decoder)
+
+	case ConstructorMessageEffectTypeEmojiReaction:
+		return decodeEntity[MessageEffectTypeEmojiReaction](decoder)
+
+	case ConstructorMessageEffectTypePremiumSticker:
+		return decodeEntity[MessageEffectTypePremiumSticker](decoder)
+
+	case ConstructorMessageEffect:
+		return decodeEntity[MessageEffect](decoder)
+
+	case ConstructorMessageSendingStatePending:
+		return decodeEntity[MessageSendingStatePending](decoder)
+
+	case ConstructorMessageSendingStateFailed:
+		return decodeEntity[MessageSendingStateFailed](decoder)
+
+	case ConstructorTextQuote:
+		return decodeEntity[TextQuote](decoder)
+
+	case ConstructorInputTextQuote:
+		return decodeEntity[InputTextQuote](decoder)
+
+	case ConstructorMessageReplyToMessage:
+		return decodeEntity[MessageReplyToMessage](decoder)
+
+	case ConstructorMessageReplyToStory:
+		return decodeEntity[MessageReplyToStory](decoder)
+
+	case ConstructorInputMessageReplyToMessage:
+		return decodeEntity[InputMessageReplyToMessage](decoder)
+
+	case ConstructorInputMessageReplyToExternalMessage:
+		return decodeEntity[InputMessageReplyToExternalMessage](decoder)
+
+	case ConstructorInputMessageReplyToStory:
+		return decodeEntity[InputMessageReplyToStory](decoder)
+
+	case ConstructorFactCheck:
+		return decodeEntity[FactCheck](decoder)
+
+	case ConstructorMessage:
+		return decodeEntity[Message](decoder)
+
+	case ConstructorMessages:
+		return decodeEntity[Messages](decoder)
+
+	case ConstructorFoundMessages:
+		return decodeEntity[FoundMessages](decoder)
+
+	case ConstructorFoundChatMessages:
+		return decodeEntity[FoundChatMessages](decoder)
+
+	case ConstructorMessagePosition:
+		return decodeEntity[MessagePosition](decoder)
+
+	case ConstructorMessagePositions:
+		return decodeEntity[MessagePositions](decoder)
+
+	case ConstructorMessageCalendarDay:
+		return decodeEntity[MessageCalendarDay](decoder)
+
+	case ConstructorMessageCalendar:
+		return decodeEntity[MessageCalendar](decoder)
+
+	case ConstructorBusinessMessage:
+		return decodeEntity[BusinessMessage](decoder)
+
+	case ConstructorBusinessMessages:
+		return decodeEntity[BusinessMessages](decoder)
+
+	case ConstructorMessageSourceChatHistory:
+		return decodeEntity[MessageSourceChatHistory](decoder)
+
+	case ConstructorMessageSourceMessageThreadHistory:
+		return decodeEntity[MessageSourceMessageThreadHistory](decoder)
+
+	case ConstructorMessageSourceForumTopicHistory:
+		return decodeEntity[MessageSourceForumTopicHistory](decoder)
+
+	case ConstructorMessageSourceHistoryPreview:
+		return decodeEntity[MessageSourceHistoryPreview](decoder)
+
+	case ConstructorMessageSourceChatList:
+		return decodeEntity[MessageSourceChatList](decoder)
+
+	case ConstructorMessageSourceSearch:
+		return decodeEntity[MessageSourceSearch](decoder)
+
+	case ConstructorMessageSourceChatEventLog:
+		return decodeEntity[MessageSourceChatEventLog](decoder)
+
+	case ConstructorMessageSourceNotification:
+		return decodeEntity[MessageSourceNotification](decoder)
+
+	case ConstructorMessageSourceScreenshot:
+		return decodeEntity[MessageSourceScreenshot](decoder)
+
+	case ConstructorMessageSourceOther:
+		return decodeEntity[MessageSourceOther](decoder)
+
+	case ConstructorMessageSponsor:
+		return decodeEntity[MessageSponsor](decoder)
+
+	case ConstructorSponsoredMessage:
+		return decodeEntity[SponsoredMessage](decoder)
+
+	case ConstructorSponsoredMessages:
+		return decodeEntity[SponsoredMessages](decoder)
+
+	case ConstructorSponsoredChat:
+		return decodeEntity[SponsoredChat](decoder)
+
+	case ConstructorSponsoredChats:
+		return decodeEntity[SponsoredChats](decoder)
+
+	case ConstructorReportOption:
+		return decodeEntity[ReportOption](decoder)
+
+	case ConstructorReportSponsoredResultOk:
+		return decodeEntity[ReportSponsoredResultOk](decoder)
+
+	case ConstructorReportSponsoredResultFailed:
+		return decodeEntity[ReportSponsoredResultFailed](decoder)
+
+	case ConstructorReportSponsoredResultOptionRequired:
+		return decodeEntity[ReportSponsoredResultOptionRequired](decoder)
+
+	case ConstructorReportSponsoredResultAdsHidden:
+		return decodeEntity[ReportSponsoredResultAdsHidden](decoder)
+
+	case ConstructorReportSponsoredResultPremiumRequired:
+		return decodeEntity[ReportSponsoredResultPremiumRequired](decoder)
+
+	case ConstructorFileDownload:
+		return decodeEntity[FileDownload](decoder)
+
+	case ConstructorDownloadedFileCounts:
+		return decodeEntity[DownloadedFileCounts](decoder)
+
+	case ConstructorFoundFileDownloads:
+		return decodeEntity[FoundFileDownloads](decoder)
+
+	case ConstructorNotificationSettingsScopePrivateChats:
+		return decodeEntity[NotificationSettingsScopePrivateChats](decoder)
+
+	case ConstructorNotificationSettingsScopeGroupChats:
+		return decodeEntity[NotificationSettingsScopeGroupChats](decoder)
+
+	case ConstructorNotificationSettingsScopeChannelChats:
+		return decodeEntity[NotificationSettingsScopeChannelChats](decoder)
+
+	case ConstructorChatNotificationSettings:
+		return decodeEntity[ChatNotificationSettings](decoder)
+
+	case ConstructorScopeNotificationSettings:
+		return decodeEntity[ScopeNotificationSettings](decoder)
+
+	case ConstructorReactionNotificationSourceNone:
+		return decodeEntity[ReactionNotificationSourceNone](decoder)
+
+	case ConstructorReactionNotificationSourceContacts:
+		return decodeEntity[ReactionNotificationSourceContacts](decoder)
+
+	case ConstructorReactionNotificationSourceAll:
+		return decodeEntity[ReactionNotificationSourceAll](decoder)
+
+	case ConstructorReactionNotificationSettings:
+		return decodeEntity[ReactionNotificationSettings](decoder)
+
+	case ConstructorDraftMessage:
+		return decodeEntity[DraftMessage](decoder)
+
+	case ConstructorChatTypePrivate:
+		return decodeEntity[ChatTypePrivate](decoder)
+
+	case ConstructorChatTypeBasicGroup:
+		return decodeEntity[ChatTypeBasicGroup](decoder)
+
+	case ConstructorChatTypeSupergroup:
+		return decodeEntity[ChatTypeSupergroup](decoder)
+
+	case ConstructorChatTypeSecret:
+		return decodeEntity[ChatTypeSecret](decoder)
+
+	case ConstructorChatFolderIcon:
+		return decodeEntity[ChatFolderIcon](decoder)
+
+	case ConstructorChatFolderName:
+		return decodeEntity[ChatFolderName](decoder)
+
+	case ConstructorChatFolder:
+		return decodeEntity[ChatFolder](decoder)
+
+	case ConstructorChatFolderInfo:
+		return decodeEntity[ChatFolderInfo](decoder)
+
+	case ConstructorChatFolderInviteLink:
+		return decodeEntity[ChatFolderInviteLink](decoder)
+
+	case ConstructorChatFolderInviteLinks:
+		return decodeEntity[ChatFolderInviteLinks](decoder)
+
+	case ConstructorChatFolderInviteLinkInfo:
+		return decodeEntity[ChatFolderInviteLinkInfo](decoder)
+
+	case ConstructorRecommendedChatFolder:
+		return decodeEntity[RecommendedChatFolder](decoder)
+
+	case ConstructorRecommendedChatFolders:
+		return decodeEntity[RecommendedChatFolders](decoder)
+
+	case ConstructorArchiveChatListSettings:
+		return decodeEntity[ArchiveChatListSettings](decoder)
+
+	case ConstructorChatListMain:
+		return decodeEntity[ChatListMain](decoder)
+
+	case ConstructorChatListArchive:
+		return decodeEntity[ChatListArchive](decoder)
+
+	case ConstructorChatListFolder:
+		return decodeEntity[ChatListFolder](decoder)
+
+	case ConstructorChatLists:
+		return decodeEntity[ChatLists](decoder)
+
+	case ConstructorChatSourceMtprotoProxy:
+		return decodeEntity[ChatSourceMtprotoProxy](decoder)
+
+	case ConstructorChatSourcePublicServiceAnnouncement:
+		return decodeEntity[ChatSourcePublicServiceAnnouncement](decoder)
+
+	case ConstructorChatPosition:
+		return decodeEntity[ChatPosition](decoder)
+
+	case ConstructorChatAvailableReactionsAll:
+		return decodeEntity[ChatAvailableReactionsAll](decoder)
+
+	case ConstructorChatAvailableReactionsSome:
+		return decodeEntity[ChatAvailableReactionsSome](decoder)
+
+	case ConstructorSavedMessagesTag:
+		return decodeEntity[SavedMessagesTag](decoder)
+
+	case ConstructorSavedMessagesTags:
+		return decodeEntity[SavedMessagesTags](decoder)
+
+	case ConstructorBusinessBotManageBar:
+		return decodeEntity[BusinessBotManageBar](decoder)
+
+	case ConstructorVideoChat:
+		return decodeEntity[VideoChat](decoder)
+
+	case ConstructorChat:
+		return decodeEntity[Chat](decoder)
+
+	case ConstructorChats:
+		return decodeEntity[Chats](decoder)
+
+	case ConstructorFailedToAddMember:
+		return decodeEntity[FailedToAddMember](decoder)
+
+	case ConstructorFailedToAddMembers:
+		return decodeEntity[FailedToAddMembers](decoder)
+
+	case ConstructorCreatedBasicGroupChat:
+		return decodeEntity[CreatedBasicGroupChat](decoder)
+
+	case ConstructorPublicChatTypeHasUsername:
+		return decodeEntity[PublicChatTypeHasUsername](decoder)
+
+	case ConstructorPublicChatTypeIsLocationBased:
+		return decodeEntity[PublicChatTypeIsLocationBased](decoder)
+
+	case ConstructorAccountInfo:
+		return decodeEntity[AccountInfo](decoder)
+
+	case ConstructorChatActionBarReportSpam:
+		return decodeEntity[ChatActionBarReportSpam](decoder)
+
+	case ConstructorChatActionBarInviteMembers:
+		return decodeEntity[ChatActionBarInviteMembers](decoder)
+
+	case ConstructorChatActionBarReportAddBlock:
+		return decodeEntity[ChatActionBarReportAddBlock](decoder)
+
+	case ConstructorChatActionBarAddContact:
+		return decodeEntity[ChatActionBarAddContact](decoder)
+
+	case ConstructorChatActionBarSharePhoneNumber:
+		return decodeEntity[ChatActionBarSharePhoneNumber](decoder)
+
+	case ConstructorChatActionBarJoinRequest:
+		return decodeEntity[ChatActionBarJoinRequest](decoder)
+
+	case ConstructorKeyboardButtonTypeText:
+		return decodeEntity[KeyboardButtonTypeText](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestPhoneNumber:
+		return decodeEntity[KeyboardButtonTypeRequestPhoneNumber](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestLocation:
+		return decodeEntity[KeyboardButtonTypeRequestLocation](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestPoll:
+		return decodeEntity[KeyboardButtonTypeRequestPoll](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestUsers:
+		return decodeEntity[KeyboardButtonTypeRequestUsers](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestChat:
+		return decodeEntity[KeyboardButtonTypeRequestChat](decoder)
+
+	case ConstructorKeyboardButtonTypeWebApp:
+		return decodeEntity[KeyboardButtonTypeWebApp](decoder)
+
+	case ConstructorKeyboardButton:
+		return decodeEntity[KeyboardButton](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeUrl:
+		return decodeEntity[InlineKeyboardButtonTypeUrl](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeLoginUrl:
+		return decodeEntity[InlineKeyboardButtonTypeLoginUrl](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeWebApp:
+		return decodeEntity[InlineKeyboardButtonTypeWebApp](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCallback:
+		return decodeEntity[InlineKeyboardButtonTypeCallback](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCallbackWithPassword:
+		return decodeEntity[InlineKeyboardButtonTypeCallbackWithPassword](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCallbackGame:
+		return decodeEntity[InlineKeyboardButtonTypeCallbackGame](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeSwitchInline:
+		return decodeEntity[InlineKeyboardButtonTypeSwitchInline](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeBuy:
+		return decodeEntity[InlineKeyboardButtonTypeBuy](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeUser:
+		return decodeEntity[InlineKeyboardButtonTypeUser](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCopyText:
+		return decodeEntity[InlineKeyboardButtonTypeCopyText](decoder)
+
+	case ConstructorInlineKeyboardButton:
+		return decodeEntity[InlineKeyboardButton](decoder)
+
+	case ConstructorReplyMarkupRemoveKeyboard:
+		return decodeEntity[ReplyMarkupRemoveKeyboard](decoder)
+
+	case ConstructorReplyMarkupForceReply:
+		return decodeEntity[ReplyMarkupForceReply](decoder)
+
+	case ConstructorReplyMarkupShowKeyboard:
+		return decodeEntity[ReplyMarkupShowKeyboard](decoder)
+
+	case ConstructorReplyMarkupInlineKeyboard:
+		return decodeEntity[ReplyMarkupInlineKeyboard](decoder)
+
+	case ConstructorLoginUrlInfoOpen:
+		return decodeEntity[LoginUrlInfoOpen](decoder)
+
+	case ConstructorLoginUrlInfoRequestConfirmation:
+		return decodeEntity[LoginUrlInfoRequestConfirmation](decoder)
+
+	case ConstructorThemeParameters:
+		return decodeEntity[ThemeParameters](decoder)
+
+	case ConstructorWebAppOpenModeCompact:
+		return decodeEntity[WebAppOpenModeCompact](decoder)
+
+	case ConstructorWebAppOpenModeFullSize:
+		return decodeEntity[WebAppOpenModeFullSize](decoder)
+
+	case ConstructorWebAppOpenModeFullScreen:
+		return decodeEntity[WebAppOpenModeFullScreen](decoder)
+
+	case ConstructorFoundWebApp:
+		return decodeEntity[FoundWebApp](decoder)
+
+	case ConstructorWebAppInfo:
+		return decodeEntity[WebAppInfo](decoder)
+
+	case ConstructorMainWebApp:
+		return decodeEntity[MainWebApp](decoder)
+
+	case ConstructorWebAppOpenParameters:
+		return decodeEntity[WebAppOpenParameters](decoder)
+
+	case ConstructorMessageThreadInfo:
+		return decodeEntity[MessageThreadInfo](decoder)
+
+	case ConstructorSavedMessagesTopicTypeMyNotes:
+		return decodeEntity[SavedMessagesTopicTypeMyNotes](decoder)
+
+	case ConstructorSavedMessagesTopicTypeAuthorHidden:
+		return decodeEntity[SavedMessagesTopicTypeAuthorHidden](decoder)
+
+	case ConstructorSavedMessagesTopicTypeSavedFromChat:
+		return decodeEntity[SavedMessagesTopicTypeSavedFromChat](decoder)
+
+	case ConstructorSavedMessagesTopic:
+		return decodeEntity[SavedMessagesTopic](decoder)
+
+	case ConstructorForumTopicIcon:
+		return decodeEntity[ForumTopicIcon](decoder)
+
+	case ConstructorForumTopicInfo:
+		return decodeEntity[ForumTopicInfo](decoder)
+
+	case ConstructorForumTopic:
+		return decodeEntity[ForumTopic](decoder)
+
+	case ConstructorForumTopics:
+		return decodeEntity[ForumTopics](decoder)
+
+	case ConstructorLinkPreviewOptions:
+		return decodeEntity[LinkPreviewOptions](decoder)
+
+	case ConstructorSharedUser:
+		return decodeEntity[SharedUser](decoder)
+
+	case ConstructorSharedChat:
+		return decodeEntity[SharedChat](decoder)
+
+	case ConstructorThemeSettings:
+		return decodeEntity[ThemeSettings](decoder)
+
+	case ConstructorRichTextPlain:
+		return decodeEntity[RichTextPlain](decoder)
+
+	case ConstructorRichTextBold:
+		return decodeEntity[RichTextBold](decoder)
+
+	case ConstructorRichTextItalic:
+		return decodeEntity[RichTextItalic](decoder)
+
+	case ConstructorRichTextUnderline:
+		return decodeEntity[RichTextUnderline](decoder)
+
+	case ConstructorRichTextStrikethrough:
+		return decodeEntity[RichTextStrikethrough](decoder)
+
+	case ConstructorRichTextFixed:
+		return decodeEntity[RichTextFixed](decoder)
+
+	case ConstructorRichTextUrl:
+		return decodeEntity[RichTextUrl](decoder)
+
+	case ConstructorRichTextEmailAddress:
+		return decodeEntity[RichTextEmailAddress](decoder)
+
+	case ConstructorRichTextSubscript:
+		return decodeEntity[RichTextSubscript](decoder)
+
+	case ConstructorRichTextSuperscript:
+		return decodeEntity[RichTextSuperscript](decoder)
+
+	case ConstructorRichTextMarked:
+		return decodeEntity[RichTextMarked](decoder)
+
+	case ConstructorRichTextPhoneNumber:
+		return decodeEntity[RichTextPhoneNumber](decoder)
+
+	case ConstructorRichTextIcon:
+		return decodeEntity[RichTextIcon](decoder)
+
+	case ConstructorRichTextReference:
+		return decodeEntity[RichTextReference](decoder)
+
+	case ConstructorRichTextAnchor:
+		return decodeEntity[RichTextAnchor](decoder)
+
+	case ConstructorRichTextAnchorLink:
+		return decodeEntity[RichTextAnchorLink](decoder)
+
+	case ConstructorRichTexts:
+		return decodeEntity[RichTexts](decoder)
+
+	case ConstructorPageBlockCaption:
+		return decodeEntity[PageBlockCaption](decoder)
+
+	case ConstructorPageBlockListItem:
+		return decodeEntity[PageBlockListItem](decoder)
+
+	case ConstructorPageBlockHorizontalAlignmentLeft:
+		return decodeEntity[PageBlockHorizontalAlignmentLeft](decoder)
+
+	case ConstructorPageBlockHorizontalAlignmentCenter:
+		return decodeEntity[PageBlockHorizontalAlignmentCenter](decoder)
+
+	case ConstructorPageBlockHorizontalAlignmentRight:
+		return decodeEntity[PageBlockHorizontalAlignmentRight](decoder)
+
+	case ConstructorPageBlockVerticalAlignmentTop:
+		return decodeEntity[PageBlockVerticalAlignmentTop](decoder)
+
+	case ConstructorPageBlockVerticalAlignmentMiddle:
+		return decodeEntity[PageBlockVerticalAlignmentMiddle](decoder)
+
+	case ConstructorPageBlockVerticalAlignmentBottom:
+		return decodeEntity[PageBlockVerticalAlignmentBottom](decoder)
+
+	case ConstructorPageBlockTableCell:
+		return decodeEntity[PageBlockTableCell](decoder)
+
+	case ConstructorPageBlockRelatedArticle:
+		return decodeEntity[PageBlockRelatedArticle](decoder)
+
+	case ConstructorPageBlockTitle:
+		return decodeEntity[PageBlockTitle](decoder)
+
+	case ConstructorPageBlockSubtitle:
+		return decodeEntity[PageBlockSubtitle](decoder)
+
+	case ConstructorPageBlockAuthorDate:
+		return decodeEntity[PageBlockAuthorDate](decoder)
+
+	case ConstructorPageBlockHeader:
+		return decodeEntity[PageBlockHeader](decoder)
+
+	case ConstructorPageBlockSubheader:
+		return decodeEntity[PageBlockSubheader](decoder)
+
+	case ConstructorPageBlockKicker:
+		return decodeEntity[PageBlockKicker](decoder)
+
+	case ConstructorPageBlockParagraph:
+		return decodeEntity[PageBlockParagraph](decoder)
+
+	case ConstructorPageBlockPreformatted:
+		return decodeEntity[PageBlockPreformatted](decoder)
+
+	case ConstructorPageBlockFooter:
+		return decodeEntity[PageBlockFooter](decoder)
+
+	case ConstructorPageBlockDivider:
+		return decodeEntity[PageBlockDivider](decoder)
+
+	case ConstructorPageBlockAnchor:
+		return decodeEntity[PageBlockAnchor](decoder)
+
+	case ConstructorPageBlockList:
+		return decodeEntity[PageBlockList](decoder)
+
+	case ConstructorPageBlockBlockQuote:
+		return decodeEntity[PageBlockBlockQuote](decoder)
+
+	case ConstructorPageBlockPullQuote:
+		return decodeEntity[PageBlockPullQuote](decoder)
+
+	case ConstructorPageBlockAnimation:
+		return decodeEntity[PageBlockAnimation](decoder)
+
+	case ConstructorPageBlockAudio:
+		return decodeEntity[PageBlockAudio](decoder)
+
+	case ConstructorPageBlockPhoto:
+		return decodeEntity[PageBlockPhoto](decoder)
+
+	case ConstructorPageBlockVideo:
+		return decodeEntity[PageBlockVideo](decoder)
+
+	case ConstructorPageBlockVoiceNote:
+		return decodeEntity[PageBlockVoiceNote](decoder)
+
+	case ConstructorPageBlockCover:
+		return decodeEntity[PageBlockCover](decoder)
+
+	case ConstructorPageBlockEmbedded:
+		return decodeEntity[PageBlockEmbedded](decoder)
+
+	case ConstructorPageBlockEmbeddedPost:
+		return decodeEntity[PageBlockEmbeddedPost](decoder)
+
+	case ConstructorPageBlockCollage:
+		return decodeEntity[PageBlockCollage](decoder)
+
+	case ConstructorPageBlockSlideshow:
+		return decodeEntity[PageBlockSlideshow](decoder)
+
+	case ConstructorPageBlockChatLink:
+		return decodeEntity[PageBlockChatLink](decoder)
+
+	case ConstructorPageBlockTable:
+		return decodeEntity[PageBlockTable](decoder)
+
+	case ConstructorPageBlockDetails:
+		return decodeEntity[PageBlockDetails](decoder)
+
+	case ConstructorPageBlockRelatedArticles:
+		return decodeEntity[PageBlockRelatedArticles](decoder)
+
+	case ConstructorPageBlockMap:
+		return decodeEntity[PageBlockMap](decoder)
+
+	case ConstructorWebPageInstantView:
+		return decodeEntity[WebPageInstantView](decoder)
+
+	case ConstructorLinkPreviewAlbumMediaPhoto:
+		return decodeEntity[LinkPreviewAlbumMediaPhoto](decoder)
+
+	case ConstructorLinkPreviewAlbumMediaVideo:
+		return decodeEntity[LinkPreviewAlbumMediaVideo](decoder)
+
+	case ConstructorLinkPreviewTypeAlbum:
+		return decodeEntity[LinkPreviewTypeAlbum](decoder)
+
+	case ConstructorLinkPreviewTypeAnimation:
+		return decodeEntity[LinkPreviewTypeAnimation](decoder)
+
+	case ConstructorLinkPreviewTypeApp:
+		return decodeEntity[LinkPreviewTypeApp](decoder)
+
+	case ConstructorLinkPreviewTypeArticle:
+		return decodeEntity[LinkPreviewTypeArticle](decoder)
+
+	case ConstructorLinkPreviewTypeAudio:
+		return decodeEntity[LinkPreviewTypeAudio](decoder)
+
+	case ConstructorLinkPreviewTypeBackground:
+		return decodeEntity[LinkPreviewTypeBackground](decoder)
+
+	case ConstructorLinkPreviewTypeChannelBoost:
+		return decodeEntity[LinkPreviewTypeChannelBoost](decoder)
+
+	case ConstructorLinkPreviewTypeChat:
+		return decodeEntity[LinkPreviewTypeChat](decoder)
+
+	case ConstructorLinkPreviewTypeDocument:
+		return decodeEntity[LinkPreviewTypeDocument](decoder)
+
+	case ConstructorLinkPreviewTypeEmbeddedAnimationPlayer:
+		return decodeEntity[LinkPreviewTypeEmbeddedAnimationPlayer](decoder)
+
+	case ConstructorLinkPreviewTypeEmbeddedAudioPlayer:
+		return decodeEntity[LinkPreviewTypeEmbeddedAudioPlayer](decoder)
+
+	case ConstructorLinkPreviewTypeEmbeddedVideoPlayer:
+		return decodeEntity[LinkPreviewTypeEmbeddedVideoPlayer](decoder)
+
+	case ConstructorLinkPreviewTypeExternalAudio:
+		return decodeEntity[LinkPreviewTypeExternalAudio](decoder)
+
+	case ConstructorLinkPreviewTypeExternalVideo:
+		return decodeEntity[LinkPreviewTypeExternalVideo](decoder)
+
+	case ConstructorLinkPreviewTypeInvoice:
+		return decodeEntity[LinkPreviewTypeInvoice](decoder)
+
+	case ConstructorLinkPreviewTypeMessage:
+		return decodeEntity[LinkPreviewTypeMessage](decoder)
+
+	case ConstructorLinkPreviewTypePhoto:
+		return decodeEntity[LinkPreviewTypePhoto](decoder)
+
+	case ConstructorLinkPreviewTypePremiumGiftCode:
+		return decodeEntity[LinkPreviewTypePremiumGiftCode](decoder)
+
+	case ConstructorLinkPreviewTypeShareableChatFolder:
+		return decodeEntity[LinkPreviewTypeShareableChatFolder](decoder)
+
+	case ConstructorLinkPreviewTypeSticker:
+		return decodeEntity[LinkPreviewTypeSticker](decoder)
+
+	case ConstructorLinkPreviewTypeStickerSet:
+		return decodeEntity[LinkPreviewTypeStickerSet](decoder)
+
+	case ConstructorLinkPreviewTypeStory:
+		return decodeEntity[LinkPreviewTypeStory](decoder)
+
+	case ConstructorLinkPreviewTypeSupergroupBoost:
+		return decodeEntity[LinkPreviewTypeSupergroupBoost](decoder)
+
+	case ConstructorLinkPreviewTypeTheme:
+		return decodeEntity[LinkPreviewTypeTheme](decoder)
+
+	case ConstructorLinkPreviewTypeUnsupported:
+		return decodeEntity[LinkPreviewTypeUnsupported](decoder)
+
+	case ConstructorLinkPreviewTypeUpgradedGift:
+		return decodeEntity[LinkPreviewTypeUpgradedGift](decoder)
+
+	case ConstructorLinkPreviewTypeUser:
+		return decodeEntity[LinkPreviewTypeUser](decoder)
+
+	case ConstructorLinkPreviewTypeVideo:
+		return decodeEntity[LinkPreviewTypeVideo](decoder)
+
+	case ConstructorLinkPreviewTypeVideoChat:
+		return decodeEntity[LinkPreviewTypeVideoChat](decoder)
+
+	case ConstructorLinkPreviewTypeVideoNote:
+		return decodeEntity[LinkPreviewTypeVideoNote](decoder)
+
+	case ConstructorLinkPreviewTypeVoiceNote:
+		return decodeEntity[LinkPreviewTypeVoiceNote](decoder)
+
+	case ConstructorLinkPreviewTypeWebApp:
+		return decodeEntity[LinkPreviewTypeWebApp](decoder)
+
+	case ConstructorLinkPreview:
+		return decodeEntity[LinkPreview](decoder)
+
+	case ConstructorCountryInfo:
+		return decodeEntity[CountryInfo](decoder)
+
+	case ConstructorCountries:
+		return decodeEntity[Countries](decoder)
+
+	case ConstructorPhoneNumberInfo:
+		return decodeEntity[PhoneNumberInfo](decoder)
+
+	case ConstructorCollectibleItemTypeUsername:
+		return decodeEntity[CollectibleItemTypeUsername](decoder)
+
+	case ConstructorCollectibleItemTypePhoneNumber:
+		return decodeEntity[CollectibleItemTypePhoneNumber](decoder)
+
+	case ConstructorCollectibleItemInfo:
+		return decodeEntity[CollectibleItemInfo](decoder)
+
+	case ConstructorBankCardActionOpenUrl:
+		return decodeEntity[BankCardActionOpenUrl](decoder)
+
+	case ConstructorBankCardInfo:
+		return decodeEntity[BankCardInfo](decoder)
+
+	case ConstructorAddress:
+		return decodeEntity[Address](decoder)
+
+	case ConstructorLocationAddress:
+		return decodeEntity[LocationAddress](decoder)
+
+	case ConstructorLabeledPricePart:
+		return decodeEntity[LabeledPricePart](decoder)
+
+	case ConstructorInvoice:
+		return decodeEntity[Invoice](decoder)
+
+	case ConstructorOrderInfo:
+		return decodeEntity[OrderInfo](decoder)
+
+	case ConstructorShippingOption:
+		return decodeEntity[ShippingOption](decoder)
+
+	case ConstructorSavedCredentials:
+		return decodeEntity[SavedCredentials](decoder)
+
+	case ConstructorInputCredentialsSaved:
+		return decodeEntity[InputCredentialsSaved](decoder)
+
+	case ConstructorInputCredentialsNew:
+		return decodeEntity[InputCredentialsNew](decoder)
+
+	case ConstructorInputCredentialsApplePay:
+		return decodeEntity[InputCredentialsApplePay](decoder)
+
+	case ConstructorInputCredentialsGooglePay:
+		return decodeEntity[InputCredentialsGooglePay](decoder)
+
+	case ConstructorPaymentProviderSmartGlocal:
+		return decodeEntity[PaymentProviderSmartGlocal](decoder)
+
+	case ConstructorPaymentProviderStripe:
+		return decodeEntity[PaymentProviderStripe](decoder)
+
+	case ConstructorPaymentProviderOther:
+		return decodeEntity[PaymentProviderOther](decoder)
+
+	case ConstructorPaymentOption:
+		return decodeEntity[PaymentOption](decoder)
+
+	case ConstructorPaymentFormTypeRegular:
+		return decodeEntity[PaymentFormTypeRegular](decoder)
+
+	case ConstructorPaymentFormTypeStars:
+		return decodeEntity[PaymentFormTypeStars](decoder)
+
+	case ConstructorPaymentFormTypeStarSubscription:
+		return decodeEntity[PaymentFormTypeStarSubscription](decoder)
+
+	case ConstructorPaymentForm:
+		return decodeEntity[PaymentForm](decoder)
+
+	case ConstructorValidatedOrderInfo:
+		return decodeEntity[ValidatedOrderInfo](decoder)
+
+	case ConstructorPaymentResult:
+		return decodeEntity[PaymentResult](decoder)
+
+	case ConstructorPaymentReceiptTypeRegular:
+		return decodeEntity[PaymentReceiptTypeRegular](decoder)
+
+	case ConstructorPaymentReceiptTypeStars:
+		return decodeEntity[PaymentReceiptTypeStars](decoder)
+
+	case ConstructorPaymentReceipt:
+		return decodeEntity[PaymentReceipt](decoder)
+
+	case ConstructorInputInvoiceMessage:
+		return decodeEntity[InputInvoiceMessage](decoder)
+
+	case ConstructorInputInvoiceName:
+		return decodeEntity[InputInvoiceName](decoder)
+
+	case ConstructorInputInvoiceTelegram:
+		return decodeEntity[InputInvoiceTelegram](decoder)
+
+	case ConstructorPaidMediaPreview:
+		return decodeEntity[PaidMediaPreview](decoder)
+
+	case ConstructorPaidMediaPhoto:
+		return decodeEntity[PaidMediaPhoto](decoder)
+
+	case ConstructorPaidMediaVideo:
+		return decodeEntity[PaidMediaVideo](decoder)
+
+	case ConstructorPaidMediaUnsupported:
+		return decodeEntity[PaidMediaUnsupported](decoder)
+
+	case ConstructorGiveawayParameters:
+		return decodeEntity[GiveawayParameters](decoder)
+
+	case ConstructorDatedFile:
+		return decodeEntity[DatedFile](decoder)
+
+	case ConstructorPassportElementTypePersonalDetails:
+		return decodeEntity[PassportElementTypePersonalDetails](decoder)
+
+	case ConstructorPassportElementTypePassport:
+		return decodeEntity[PassportElementTypePassport](decoder)
+
+	case ConstructorPassportElementTypeDriverLicense:
+		return decodeEntity[PassportElementTypeDriverLicense](decoder)
+
+	case ConstructorPassportElementTypeIdentityCard:
+		return decodeEntity[PassportElementTypeIdentityCard](decoder)
+
+	case ConstructorPassportElementTypeInternalPassport:
+		return decodeEntity[PassportElementTypeInternalPassport](decoder)
+
+	case ConstructorPassportElementTypeAddress:
+		return decodeEntity[PassportElementTypeAddress](decoder)
+
+	case ConstructorPassportElementTypeUtilityBill:
+		return decodeEntity[PassportElementTypeUtilityBill](decoder)
+
+	case ConstructorPassportElementTypeBankStatement:
+		return decodeEntity[PassportElementTypeBankStatement](decoder)
+
+	case ConstructorPassportElementTypeRentalAgreement:
+		return decodeEntity[PassportElementTypeRentalAgreement](decoder)
+
+	case ConstructorPassportElementTypePassportRegistration:
+		return decodeEntity[PassportElementTypePassportRegistration](decoder)
+
+	case ConstructorPassportElementTypeTemporaryRegistration:
+		return decodeEntity[PassportElementTypeTemporaryRegistration](decoder)
+
+	case ConstructorPassportElementTypePhoneNumber:
+		return decodeEntity[PassportElementTypePhoneNumber](decoder)
+
+	case ConstructorPassportElementTypeEmailAddress:
+		return decodeEntity[PassportElementTypeEmailAddress](decoder)
+
+	case ConstructorDate:
+		return decodeEntity[Date](decoder)
+
+	case ConstructorPersonalDetails:
+		return decodeEntity[PersonalDetails](decoder)
+
+	case ConstructorIdentityDocument:
+		return decodeEntity[IdentityDocument](decoder)
+
+	case ConstructorInputIdentityDocument:
+		return decodeEntity[InputIdentityDocument](decoder)
+
+	case ConstructorPersonalDocument:
+		return decodeEntity[PersonalDocument](decoder)
+
+	case ConstructorInputPersonalDocument:
+		return decodeEntity[InputPersonalDocument](decoder)
+
+	case ConstructorPassportElementPersonalDetails:
+		return decodeEntity[PassportElementPersonalDetails](decoder)
+
+	case ConstructorPassportElementPassport:
+		return decodeEntity[PassportElementPassport](decoder)
+
+	case ConstructorPassportElementDriverLicense:
+		return decodeEntity[PassportElementDriverLicense](decoder)
+
+	case ConstructorPassportElementIdentityCard:
+		return decodeEntity[PassportElementIdentityCard](decoder)
+
+	case ConstructorPassportElementInternalPassport:
+		return decodeEntity[PassportElementInternalPassport](decoder)
+
+	case ConstructorPassportElementAddress:
+		return decodeEntity[PassportElementAddress](decoder)
+
+	case ConstructorPassportElementUtilityBill:
+		return decodeEntity[PassportElementUtilityBill](decoder)
+
+	case ConstructorPassportElementBankStatement:
+		return decodeEntity[PassportElementBankStatement](decoder)
+
+	case ConstructorPassportElementRentalAgreement:
+		return decodeEntity[PassportElementRentalAgreement](decoder)
+
+	case ConstructorPassportElementPassportRegistration:
+		return decodeEntity[PassportElementPassportRegistration](decoder)
+
+	case ConstructorPassportElementTemporaryRegistration:
+		return decodeEntity[PassportElementTemporaryRegistration](decoder)
+
+	case ConstructorPassportElementPhoneNumber:
+		return decodeEntity[PassportElementPhoneNumber](decoder)
+
+	case ConstructorPassportElementEmailAddress:
+		return decodeEntity[PassportElementEmailAddress](decoder)
+
+	case ConstructorInputPassportElementPersonalDetails:
+		return decodeEntity[InputPassportElementPersonalDetails](decoder)
+
+	case ConstructorInputPassportElementPassport:
+		return decodeEntity[InputPassportElementPassport](decoder)
+
+	case ConstructorInputPassportElementDriverLicense:
+		return decodeEntity[InputPassportElementDriverLicense](decoder)
+
+	case ConstructorInputPassportElementIdentityCard:
+		return decodeEntity[InputPassportElementIdentityCard](decoder)
+
+	case ConstructorInputPassportElementInternalPassport:
+		return decodeEntity[InputPassportElementInternalPassport](decoder)
+
+	case ConstructorInputPassportElementAddress:
+		return decodeEntity[InputPassportElementAddress](decoder)
+
+	case ConstructorInputPassportElementUtilityBill:
+		return decodeEntity[InputPassportElementUtilityBill](decoder)
+
+	case ConstructorInputPassportElementBankStatement:
+		return decodeEntity[InputPassportElementBankStatement](decoder)
+
+	case ConstructorInputPassportElementRentalAgreement:
+		return decodeEntity[InputPassportElementRentalAgreement](decoder)
+
+	case ConstructorInputPassportElementPassportRegistration:
+		return decodeEntity[InputPassportElementPassportRegistration](decoder)
+
+	case ConstructorInputPassportElementTemporaryRegistration:
+		return decodeEntity[InputPassportElementTemporaryRegistration](decoder)
+
+	case ConstructorInputPassportElementPhoneNumber:
+		return decodeEntity[InputPassportElementPhoneNumber](decoder)
+
+	case ConstructorInputPassportElementEmailAddress:
+		return decodeEntity[InputPassportElementEmailAddress](decoder)
+
+	case ConstructorPassportElements:
+		return decodeEntity[PassportElements](decoder)
+
+	case ConstructorPassportElementErrorSourceUnspecified:
+		return decodeEntity[PassportElementErrorSourceUnspecified](decoder)
+
+	case ConstructorPassportElementErrorSourceDataField:
+		return decodeEntity[PassportElementErrorSourceDataField](decoder)
+
+	case ConstructorPassportElementErrorSourceFrontSide:
+		return decodeEntity[PassportElementErrorSourceFrontSide](decoder)
+
+	case ConstructorPassportElementErrorSourceReverseSide:
+		return decodeEntity[PassportElementErrorSourceReverseSide](decoder)
+
+	case ConstructorPassportElementErrorSourceSelfie:
+		return decodeEntity[PassportElementErrorSourceSelfie](decoder)
+
+	case ConstructorPassportElementErrorSourceTranslationFile:
+		return decodeEntity[PassportElementErrorSourceTranslationFile](decoder)
+
+	case ConstructorPassportElementErrorSourceTranslationFiles:
+		return decodeEntity[PassportElementErrorSourceTranslationFiles](decoder)
+
+	case ConstructorPassportElementErrorSourceFile:
+		return decodeEntity[PassportElementErrorSourceFile](decoder)
+
+	case ConstructorPassportElementErrorSourceFiles:
+		return decodeEntity[PassportElementErrorSourceFiles](decoder)
+
+	case ConstructorPassportElementError:
+		return decodeEntity[PassportElementError](decoder)
+
+	case ConstructorPassportSuitableElement:
+		return decodeEntity[PassportSuitableElement](decoder)
+
+	case ConstructorPassportRequiredElement:
+		return decodeEntity[PassportRequiredElement](decoder)
+
+	case ConstructorPassportAuthorizationForm:
+		return decodeEntity[PassportAuthorizationForm](decoder)
+
+	case ConstructorPassportElementsWithErrors:
+		return decodeEntity[PassportElementsWithErrors](decoder)
+
+	case ConstructorEncryptedCredentials:
+		return decodeEntity[EncryptedCredentials](decoder)
+
+	case ConstructorEncryptedPassportElement:
+		return decodeEntity[EncryptedPassportElement](decoder)
+
+	case ConstructorInputPassportElementErrorSourceUnspecified:
+		return decodeEntity[InputPassportElementErrorSourceUnspecified](decoder)
+
+	case ConstructorInputPassportElementErrorSourceDataField:
+		return decodeEntity[InputPassportElementErrorSourceDataField](decoder)
+
+	case ConstructorInputPassportElementErrorSourceFrontSide:
+		return decodeEntity[InputPassportElementErrorSourceFrontSide](decoder)
+
+	case ConstructorInputPassportElementErrorSourceReverseSide:
+		return decodeEntity[InputPassportElementErrorSourceReverseSide](decoder)
+
+	case ConstructorInputPassportElementErrorSourceSelfie:
+		return decodeEntity[InputPassportElementErrorSourceSelfie](decoder)
+
+	case ConstructorInputPassportElementErrorSourceTranslationFile:
+		return decodeEntity[InputPassportElementErrorSourceTranslationFile](decoder)
+
+	case ConstructorInputPassportElementErrorSourceTranslationFiles:
+		return decodeEntity[InputPassportElementErrorSourceTranslationFiles](decoder)
+
+	case ConstructorInputPassportElementErrorSourceFile:
+		return decodeEntity[InputPassportElementErrorSourceFile](decoder)
+
+	case ConstructorInputPassportElementErrorSourceFiles:
+		return decodeEntity[InputPassportElementErrorSourceFiles](decoder)
+
+	case ConstructorInputPassportElementError:
+		return decodeEntity[InputPassportElementError](decoder)
+
+	case ConstructorMessageText:
+		return decodeEntity[MessageText](decoder)
+
+	case ConstructorMessageAnimation:
+		return decodeEntity[MessageAnimation](decoder)
+
+	case ConstructorMessageAudio:
+		return decodeEntity[MessageAudio](decoder)
+
+	case ConstructorMessageDocument:
+		return decodeEntity[MessageDocument](decoder)
+
+	case ConstructorMessagePaidMedia:
+		return decodeEntity[MessagePaidMedia](decoder)
+
+	case ConstructorMessagePhoto:
+		return decodeEntity[MessagePhoto](decoder)
+
+	case ConstructorMessageSticker:
+		return decodeEntity[MessageSticker](decoder)
+
+	case ConstructorMessageVideo:
+		return decodeEntity[MessageVideo](decoder)
+
+	case ConstructorMessageVideoNote:
+		return decodeEntity[MessageVideoNote](decoder)
+
+	case ConstructorMessageVoiceNote:
+		return decodeEntity[MessageVoiceNote](decoder)
+
+	case ConstructorMessageExpiredPhoto:
+		return decodeEntity[MessageExpiredPhoto](decoder)
+
+	case ConstructorMessageExpiredVideo:
+		return decodeEntity[MessageExpiredVideo](decoder)
+
+	case ConstructorMessageExpiredVideoNote:
+		return decodeEntity[MessageExpiredVideoNote](decoder)
+
+	case ConstructorMessageExpiredVoiceNote:
+		return decodeEntity[MessageExpiredVoiceNote](decoder)
+
+	case ConstructorMessageLocation:
+		return decodeEntity[MessageLocation](decoder)
+
+	case ConstructorMessageVenue:
+		return decodeEntity[MessageVenue](decoder)
+
+	case ConstructorMessageContact:
+		return decodeEntity[MessageContact](decoder)
+
+	case ConstructorMessageAnimatedEmoji:
+		return decodeEntity[MessageAnimatedEmoji](decoder)
+
+	case ConstructorMessageDice:
+		return decodeEntity[MessageDice](decoder)
+
+	case ConstructorMessageGame:
+		return decodeEntity[MessageGame](decoder)
+
+	case ConstructorMessagePoll:
+		return decodeEntity[MessagePoll](decoder)
+
+	case ConstructorMessageStory:
+		return decodeEntity[MessageStory](decoder)
+
+	case ConstructorMessageInvoice:
+		return decodeEntity[MessageInvoice](decoder)
+
+	case ConstructorMessageCall:
+		return decodeEntity[MessageCall](decoder)
+
+	case ConstructorMessageVideoChatScheduled:
+		return decodeEntity[MessageVideoChatScheduled](decoder)
+
+	case ConstructorMessageVideoChatStarted:
+		return decodeEntity[MessageVideoChatStarted](decoder)
+
+	case ConstructorMessageVideoChatEnded:
+		return decodeEntity[MessageVideoChatEnded](decoder)
+
+	case ConstructorMessageInviteVideoChatParticipants:
+		return decodeEntity[MessageInviteVideoChatParticipants](decoder)
+
+	case ConstructorMessageBasicGroupChatCreate:
+		return decodeEntity[MessageBasicGroupChatCreate](decoder)
+
+	case ConstructorMessageSupergroupChatCreate:
+		return decodeEntity[MessageSupergroupChatCreate](decoder)
+
+	case ConstructorMessageChatChangeTitle:
+		return decodeEntity[MessageChatChangeTitle](decoder)
+
+	case ConstructorMessageChatChangePhoto:
+		return decodeEntity[MessageChatChangePhoto](decoder)
+
+	case ConstructorMessageChatDeletePhoto:
+		return decodeEntity[MessageChatDeletePhoto](decoder)
+
+	case ConstructorMessageChatAddMembers:
+		return decodeEntity[MessageChatAddMembers](decoder)
+
+	case ConstructorMessageChatJoinByLink:
+		return decodeEntity[MessageChatJoinByLink](decoder)
+
+	case ConstructorMessageChatJoinByRequest:
+		return decodeEntity[MessageChatJoinByRequest](decoder)
+
+	case ConstructorMessageChatDeleteMember:
+		return decodeEntity[MessageChatDeleteMember](decoder)
+
+	case ConstructorMessageChatUpgradeTo:
+		return decodeEntity[MessageChatUpgradeTo](decoder)
+
+	case ConstructorMessageChatUpgradeFrom:
+		return decodeEntity[MessageChatUpgradeFrom](decoder)
+
+	case ConstructorMessagePinMessage:
+		return decodeEntity[MessagePinMessage](decoder)
+
+	case ConstructorMessageScreenshotTaken:
+		return decodeEntity[MessageScreenshotTaken](decoder)
+
+	case ConstructorMessageChatSetBackground:
+		return decodeEntity[MessageChatSetBackground](decoder)
+
+	case ConstructorMessageChatSetTheme:
+		return decodeEntity[MessageChatSetTheme](decoder)
+
+	case ConstructorMessageChatSetMessageAutoDeleteTime:
+		return decodeEntity[MessageChatSetMessageAutoDeleteTime](decoder)
+
+	case ConstructorMessageChatBoost:
+		return decodeEntity[MessageChatBoost](decoder)
+
+	case ConstructorMessageForumTopicCreated:
+		return decodeEntity[MessageForumTopicCreated](decoder)
+
+	case ConstructorMessageForumTopicEdited:
+		return decodeEntity[MessageForumTopicEdited](decoder)
+
+	case ConstructorMessageForumTopicIsClosedToggled:
+		return decodeEntity[MessageForumTopicIsClosedToggled](decoder)
+
+	case ConstructorMessageForumTopicIsHiddenToggled:
+		return decodeEntity[MessageForumTopicIsHiddenToggled](decoder)
+
+	case ConstructorMessageSuggestProfilePhoto:
+		return decodeEntity[MessageSuggestProfilePhoto](decoder)
+
+	case ConstructorMessageCustomServiceAction:
+		return decodeEntity[MessageCustomServiceAction](decoder)
+
+	case ConstructorMessageGameScore:
+		return decodeEntity[MessageGameScore](decoder)
+
+	case ConstructorMessagePaymentSuccessful:
+		return decodeEntity[MessagePaymentSuccessful](decoder)
+
+	case ConstructorMessagePaymentSuccessfulBot:
+		return decodeEntity[MessagePaymentSuccessfulBot](decoder)
+
+	case ConstructorMessagePaymentRefunded:
+		return decodeEntity[MessagePaymentRefunded](decoder)
+
+	case ConstructorMessageGiftedPremium:
+		return decodeEntity[MessageGiftedPremium](decoder)
+
+	case ConstructorMessagePremiumGiftCode:
+		return decodeEntity[MessagePremiumGiftCode](decoder)
+
+	case ConstructorMessageGiveawayCreated:
+		return decodeEntity[MessageGiveawayCreated](decoder)
+
+	case ConstructorMessageGiveaway:
+		return decodeEntity[MessageGiveaway](decoder)
+
+	case ConstructorMessageGiveawayCompleted:
+		return decodeEntity[MessageGiveawayCompleted](decoder)
+
+	case ConstructorMessageGiveawayWinners:
+		return decodeEntity[MessageGiveawayWinners](decoder)
+
+	case ConstructorMessageGiftedStars:
+		return decodeEntity[MessageGiftedStars](decoder)
+
+	case ConstructorMessageGiveawayPrizeStars:
+		return decodeEntity[MessageGiveawayPrizeStars](decoder)
+
+	case ConstructorMessageGift:
+		return decodeEntity[MessageGift](decoder)
+
+	case ConstructorMessageUpgradedGift:
+		return decodeEntity[MessageUpgradedGift](decoder)
+
+	case ConstructorMessageRefundedUpgradedGift:
+		return decodeEntity[MessageRefundedUpgradedGift](decoder)
+
+	case ConstructorMessagePaidMessagesRefunded:
+		return decodeEntity[MessagePaidMessagesRefunded](decoder)
+
+	case ConstructorMessagePaidMessagePriceChanged:
+		return decodeEntity[MessagePaidMessagePriceChanged](decoder)
+
+	case ConstructorMessageContactRegistered:
+		return decodeEntity[MessageContactRegistered](decoder)
+
+	case ConstructorMessageUsersShared:
+		return decodeEntity[MessageUsersShared](decoder)
+
+	case ConstructorMessageChatShared:
+		return decodeEntity[MessageChatShared](decoder)
+
+	case ConstructorMessageBotWriteAccessAllowed:
+		return decodeEntity[MessageBotWriteAccessAllowed](decoder)
+
+	case ConstructorMessageWebAppDataSent:
+		return decodeEntity[MessageWebAppDataSent](decoder)
+
+	case ConstructorMessageWebAppDataReceived:
+		return decodeEntity[MessageWebAppDataReceived](decoder)
+
+	case ConstructorMessagePassportDataSent:
+		return decodeEntity[MessagePassportDataSent](decoder)
+
+	case ConstructorMessagePassportDataReceived:
+		return decodeEntity[MessagePassportDataReceived](decoder)
+
+	case ConstructorMessageProximityAlertTriggered:
+		return decodeEntity[MessageProximityAlertTriggered](decoder)
+
+	case ConstructorMessageUnsupported:
+		return decodeEntity[MessageUnsupported](decoder)
+
+	case ConstructorTextEntityTypeMention:
+		return decodeEntity[TextEntityTypeMention](decoder)
+
+	case ConstructorTextEntityTypeHashtag:
+		return decodeEntity[TextEntityTypeHashtag](decoder)
+
+	case ConstructorTextEntityTypeCashtag:
+		return decodeEntity[TextEntityTypeCashtag](decoder)
+
+	case ConstructorTextEntityTypeBotCommand:
+		return decodeEntity[TextEntityTypeBotCommand](decoder)
+
+	case ConstructorTextEntityTypeUrl:
+		return decodeEntity[TextEntityTypeUrl](decoder)
+
+	case ConstructorTextEntityTypeEmailAddress:
+		return decodeEntity[TextEntityTypeEmailAddress](decoder)
+
+	case ConstructorTextEntityTypePhoneNumber:
+		return decodeEntity[TextEntityTypePhoneNumber](decoder)
+
+	case ConstructorTextEntityTypeBankCardNumber:
+		return decodeEntity[TextEntityTypeBankCardNumber](decoder)
+
+	case ConstructorTextEntityTypeBold:
+		return decodeEntity[TextEntityTypeBold](decoder)
+
+	case ConstructorTextEntityTypeItalic:
+		return decodeEntity[TextEntityTypeItalic](decoder)
+
+	case ConstructorTextEntityTypeUnderline:
+		return decodeEntity[TextEntityTypeUnderline](decoder)
+
+	case ConstructorTextEntityTypeStrikethrough:
+		return decodeEntity[TextEntityTypeStrikethrough](decoder)
+
+	case ConstructorTextEntityTypeSpoiler:
+		return decodeEntity[TextEntityTypeSpoiler](decoder)
+
+	case ConstructorTextEntityTypeCode:
+		return decodeEntity[TextEntityTypeCode](decoder)
+
+	case ConstructorTextEntityTypePre:
+		return decodeEntity[TextEntityTypePre](decoder)
+
+	case ConstructorTextEntityTypePreCode:
+		return decodeEntity[TextEntityTypePreCode](decoder)
+
+	case ConstructorTextEntityTypeBlockQuote:
+		return decodeEntity[TextEntityTypeBlockQuote](decoder)
+
+	case ConstructorTextEntityTypeExpandableBlockQuote:
+		return decodeEntity[TextEntityTypeExpandableBlockQuote](decoder)
+
+	case ConstructorTextEntityTypeTextUrl:
+		return decodeEntity[TextEntityTypeTextUrl](decoder)
+
+	case ConstructorTextEntityTypeMentionName:
+		return decodeEntity[TextEntityTypeMentionName](decoder)
+
+	case ConstructorTextEntityTypeCustomEmoji:
+		return decodeEntity[TextEntityTypeCustomEmoji](decoder)
+
+	case ConstructorTextEntityTypeMediaTimestamp:
+		return decodeEntity[TextEntityTypeMediaTimestamp](decoder)
+
+	case ConstructorInputThumbnail:
+		return decodeEntity[InputThumbnail](decoder)
+
+	case ConstructorInputPaidMediaTypePhoto:
+		return decodeEntity[InputPaidMediaTypePhoto](decoder)
+
+	case ConstructorInputPaidMediaTypeVideo:
+		return decodeEntity[InputPaidMediaTypeVideo](decoder)
+
+	case ConstructorInputPaidMedia:
+		return decodeEntity[InputPaidMedia](decoder)
+
+	case ConstructorMessageSchedulingStateSendAtDate:
+		return decodeEntity[MessageSchedulingStateSendAtDate](decoder)
+
+	case ConstructorMessageSchedulingStateSendWhenOnline:
+		return decodeEntity[MessageSchedulingStateSendWhenOnline](decoder)
+
+	case ConstructorMessageSchedulingStateSendWhenVideoProcessed:
+		return decodeEntity[MessageSchedulingStateSendWhenVideoProcessed](decoder)
+
+	case ConstructorMessageSelfDestructTypeTimer:
+		return decodeEntity[MessageSelfDestructTypeTimer](decoder)
+
+	case ConstructorMessageSelfDestructTypeImmediately:
+		return decodeEntity[MessageSelfDestructTypeImmediately](decoder)
+
+	case ConstructorMessageSendOptions:
+		return decodeEntity[MessageSendOptions](decoder)
+
+	case ConstructorMessageCopyOptions:
+		return decodeEntity[MessageCopyOptions](decoder)
+
+	case ConstructorInputMessageText:
+		return decodeEntity[InputMessageText](decoder)
+
+	case ConstructorInputMessageAnimation:
+		return decodeEntity[InputMessageAnimation](decoder)
+
+	case ConstructorInputMessageAudio:
+		return decodeEntity[InputMessageAudio](decoder)
+
+	case ConstructorInputMessageDocument:
+		return decodeEntity[InputMessageDocument](decoder)
+
+	case ConstructorInputMessagePaidMedia:
+		return decodeEntity[InputMessagePaidMedia](decoder)
+
+	case ConstructorInputMessagePhoto:
+		return decodeEntity[InputMessagePhoto](decoder)
+
+	case ConstructorInputMessageSticker:
+		return decodeEntity[InputMessageSticker](decoder)
+
+	case ConstructorInputMessageVideo:
+		return decodeEntity[InputMessageVideo](decoder)
+
+	case ConstructorInputMessageVideoNote:
+		return decodeEntity[InputMessageVideoNote](decoder)
+
+	case ConstructorInputMessageVoiceNote:
+		return decodeEntity[InputMessageVoiceNote](decoder)
+
+	case ConstructorInputMessageLocation:
+		return decodeEntity[InputMessageLocation](decoder)
+
+	case ConstructorInputMessageVenue:
+		return decodeEntity[InputMessageVenue](decoder)
+
+	case ConstructorInputMessageContact:
+		return decodeEntity[InputMessageContact](decoder)
+
+	case ConstructorInputMessageDice:
+		return decodeEntity[InputMessageDice](decoder)
+
+	case ConstructorInputMessageGame:
+		return decodeEntity[InputMessageGame](decoder)
+
+	case ConstructorInputMessageInvoice:
+		return decodeEntity[InputMessageInvoice](decoder)
+
+	case ConstructorInputMessagePoll:
+		return decodeEntity[InputMessagePoll](decoder)
+
+	case ConstructorInputMessageStory:
+		return decodeEntity[InputMessageStory](decoder)
+
+	case ConstructorInputMessageForwarded:
+		return decodeEntity[InputMessageForwarded](decoder)
+
+	case ConstructorMessageProperties:
+		return decodeEntity[MessageProperties](decoder)
+
+	case ConstructorSearchMessagesFilterEmpty:
+		return decodeEntity[SearchMessagesFilterEmpty](decoder)
+
+	case ConstructorSearchMessagesFilterAnimation:
+		return decodeEntity[SearchMessagesFilterAnimation](decoder)
+
+	case ConstructorSearchMessagesFilterAudio:
+		return decodeEntity[SearchMessagesFilterAudio](decoder)
+
+	case ConstructorSearchMessagesFilterDocument:
+		return decodeEntity[SearchMessagesFilterDocument](decoder)
+
+	case ConstructorSearchMessagesFilterPhoto:
+		return decodeEntity[SearchMessagesFilterPhoto](decoder)
+
+	case ConstructorSearchMessagesFilterVideo:
+		return decodeEntity[SearchMessagesFilterVideo](decoder)
+
+	case ConstructorSearchMessagesFilterVoiceNote:
+		return decodeEntity[SearchMessagesFilterVoiceNote](decoder)
+
+	case ConstructorSearchMessagesFilterPhotoAndVideo:
+		return decodeEntity[SearchMessagesFilterPhotoAndVideo](decoder)
+
+	case ConstructorSearchMessagesFilterUrl:
+		return decodeEntity[SearchMessagesFilterUrl](decoder)
+
+	case ConstructorSearchMessagesFilterChatPhoto:
+		return decodeEntity[SearchMessagesFilterChatPhoto](decoder)
+
+	case ConstructorSearchMessagesFilterVideoNote:
+		return decodeEntity[SearchMessagesFilterVideoNote](decoder)
+
+	case ConstructorSearchMessagesFilterVoiceAndVideoNote:
+		return decodeEntity[SearchMessagesFilterVoiceAndVideoNote](decoder)
+
+	case ConstructorSearchMessagesFilterMention:
+		return decodeEntity[SearchMessagesFilterMention](decoder)
+
+	case ConstructorSearchMessagesFilterUnreadMention:
+		return decodeEntity[SearchMessagesFilterUnreadMention](decoder)
+
+	case ConstructorSearchMessagesFilterUnreadReaction:
+		return decodeEntity[SearchMessagesFilterUnreadReaction](decoder)
+
+	case ConstructorSearchMessagesFilterFailedToSend:
+		return decodeEntity[SearchMessagesFilterFailedToSend](decoder)
+
+	case ConstructorSearchMessagesFilterPinned:
+		return decodeEntity[SearchMessagesFilterPinned](decoder)
+
+	case ConstructorSearchMessagesChatTypeFilterPrivate:
+		return decodeEntity[SearchMessagesChatTypeFilterPrivate](decoder)
+
+	case ConstructorSearchMessagesChatTypeFilterGroup:
+		return decodeEntity[SearchMessagesChatTypeFilterGroup](decoder)
+
+	case ConstructorSearchMessagesChatTypeFilterChannel:
+		return decodeEntity[SearchMessagesChatTypeFilterChannel](decoder)
+
+	case ConstructorChatActionTyping:
+		return decodeEntity[ChatActionTyping](decoder)
+
+	case ConstructorChatActionRecordingVideo:
+		return decodeEntity[ChatActionRecordingVideo](decoder)
+
+	case ConstructorChatActionUploadingVideo:
+		return decodeEntity[ChatActionUploadingVideo](decoder)
+
+	case ConstructorChatActionRecordingVoiceNote:
+		return decodeEntity[ChatActionRecordingVoiceNote](decoder)
+
+	case ConstructorChatActionUploadingVoiceNote:
+		return decodeEntity[ChatActionUploadingVoiceNote](decoder)
+
+	case ConstructorChatActionUploadingPhoto:
+		return decodeEntity[ChatActionUploadingPhoto](decoder)
+
+	case ConstructorChatActionUploadingDocument:
+		return decodeEntity[ChatActionUploadingDocument](decoder)
+
+	case ConstructorChatActionChoosingSticker:
+		return decodeEntity[ChatActionChoosingSticker](decoder)
+
+	case ConstructorChatActionChoosingLocation:
+		return decodeEntity[ChatActionChoosingLocation](decoder)
+
+	case ConstructorChatActionChoosingContact:
+		return decodeEntity[ChatActionChoosingContact](decoder)
+
+	case ConstructorChatActionStartPlayingGame:
+		return decodeEntity[ChatActionStartPlayingGame](decoder)
+
+	case ConstructorChatActionRecordingVideoNote:
+		return decodeEntity[ChatActionRecordingVideoNote](decoder)
+
+	case ConstructorChatActionUploadingVideoNote:
+		return decodeEntity[ChatActionUploadingVideoNote](decoder)
+
+	case ConstructorChatActionWatchingAnimations:
+		return decodeEntity[ChatActionWatchingAnimations](decoder)
+
+	case ConstructorChatActionCancel:
+		return decodeEntity[ChatActionCancel](decoder)
+
+	case ConstructorUserStatusEmpty:
+		return decodeEntity[UserStatusEmpty](decoder)
+
+	case ConstructorUserStatusOnline:
+		return decodeEntity[UserStatusOnline](decoder)
+
+	case ConstructorUserStatusOffline:
+		return decodeEntity[UserStatusOffline](decoder)
+
+	case ConstructorUserStatusRecently:
+		return decodeEntity[UserStatusRecently](decoder)
+
+	case ConstructorUserStatusLastWeek:
+		return decodeEntity[UserStatusLastWeek](decoder)
+
+	case ConstructorUserStatusLastMonth:
+		return decodeEntity[UserStatusLastMonth](decoder)
+
+	case ConstructorEmojiKeyword:
+		return decodeEntity[EmojiKeyword](decoder)
+
+	case ConstructorEmojiKeywords:
+		return decodeEntity[EmojiKeywords](decoder)
+
+	case ConstructorStickers:
+		return decodeEntity[Stickers](decoder)
+
+	case ConstructorEmojis:
+		return decodeEntity[Emojis](decoder)
+
+	case ConstructorStickerSet:
+		return decodeEntity[StickerSet](decoder)
+
+	case ConstructorStickerSetInfo:
+		return decodeEntity[StickerSetInfo](decoder)
+
+	case ConstructorStickerSets:
+		return decodeEntity[StickerSets](decoder)
+
+	case ConstructorTrendingStickerSets:
+		return decodeEntity[TrendingStickerSets](decoder)
+
+	case ConstructorEmojiCategorySourceSearch:
+		return decodeEntity[EmojiCategorySourceSearch](decoder)
+
+	case ConstructorEmojiCategorySourcePremium:
+		return decodeEntity[EmojiCategorySourcePremium](decoder)
+
+	case ConstructorEmojiCategory:
+		return decodeEntity[EmojiCategory](decoder)
+
+	case ConstructorEmojiCategories:
+		return decodeEntity[EmojiCategories](decoder)
+
+	case ConstructorEmojiCategoryTypeDefault:
+		return decodeEntity[EmojiCategoryTypeDefault](decoder)
+
+	case ConstructorEmojiCategoryTypeRegularStickers:
+		return decodeEntity[EmojiCategoryTypeRegularStickers](decoder)
+
+	case ConstructorEmojiCategoryTypeEmojiStatus:
+		return decodeEntity[EmojiCategoryTypeEmojiStatus](decoder)
+
+	case ConstructorEmojiCategoryTypeChatPhoto:
+		return decodeEntity[EmojiCategoryTypeChatPhoto](decoder)
+
+	case ConstructorCurrentWeather:
+		return decodeEntity[CurrentWeather](decoder)
+
+	case ConstructorStoryAreaPosition:
+		return decodeEntity[StoryAreaPosition](decoder)
+
+	case ConstructorStoryAreaTypeLocation:
+		return decodeEntity[StoryAreaTypeLocation](decoder)
+
+	case ConstructorStoryAreaTypeVenue:
+		return decodeEntity[StoryAreaTypeVenue](decoder)
+
+	case ConstructorStoryAreaTypeSuggestedReaction:
+		return decodeEntity[StoryAreaTypeSuggestedReaction](decoder)
+
+	case ConstructorStoryAreaTypeMessage:
+		return decodeEntity[StoryAreaTypeMessage](decoder)
+
+	case ConstructorStoryAreaTypeLink:
+		return decodeEntity[StoryAreaTypeLink](decoder)
+
+	case ConstructorStoryAreaTypeWeather:
+		return decodeEntity[StoryAreaTypeWeather](decoder)
+
+	case ConstructorStoryAreaTypeUpgradedGift:
+		return decodeEntity[StoryAreaTypeUpgradedGift](decoder)
+
+	case ConstructorStoryArea:
+		return decodeEntity[StoryArea](decoder)
+
+	case ConstructorInputStoryAreaTypeLocation:
+		return decodeEntity[InputStoryAreaTypeLocation](decoder)
+
+	case ConstructorInputStoryAreaTypeFoundVenue:
+		return decodeEntity[InputStoryAreaTypeFoundVenue](decoder)
+
+	case ConstructorInputStoryAreaTypePreviousVenue:
+		return decodeEntity[InputStoryAreaTypePreviousVenue](decoder)
+
+	case ConstructorInputStoryAreaTypeSuggestedReaction:
+		return decodeEntity[InputStoryAreaTypeSuggestedReaction](decoder)
+
+	case ConstructorInputStoryAreaTypeMessage:
+		return decodeEntity[InputStoryAreaTypeMessage](decoder)
+
+	case ConstructorInputStoryAreaTypeLink:
+		return decodeEntity[InputStoryAreaTypeLink](decoder)
+
+	case ConstructorInputStoryAreaTypeWeather:
+		return decodeEntity[InputStoryAreaTypeWeather](decoder)
+
+	case ConstructorInputStoryAreaTypeUpgradedGift:
+		return decodeEntity[InputStoryAreaTypeUpgradedGift](decoder)
+
+	case ConstructorInputStoryArea:
+		return decodeEntity[InputStoryArea](decoder)
+
+	case ConstructorInputStoryAreas:
+		return decodeEntity[InputStoryAreas](decoder)
+
+	case ConstructorStoryVideo:
+		return decodeEntity[StoryVideo](decoder)
+
+	case ConstructorStoryContentPhoto:
+		return decodeEntity[StoryContentPhoto](decoder)
+
+	case ConstructorStoryContentVideo:
+		return decodeEntity[StoryContentVideo](decoder)
+
+	case ConstructorStoryContentUnsupported:
+		return decodeEntity[StoryContentUnsupported](decoder)
+
+	case ConstructorInputStoryContentPhoto:
+		return decodeEntity[InputStoryContentPhoto](decoder)
+
+	case ConstructorInputStoryContentVideo:
+		return decodeEntity[InputStoryContentVideo](decoder)
+
+	case ConstructorStoryListMain:
+		return decodeEntity[StoryListMain](decoder)
+
+	case ConstructorStoryListArchive:
+		return decodeEntity[StoryListArchive](decoder)
+
+	case ConstructorStoryOriginPublicStory:
+		return decodeEntity[StoryOriginPublicStory](decoder)
+
+	case ConstructorStoryOriginHiddenUser:
+		return decodeEntity[StoryOriginHiddenUser](decoder)
+
+	case ConstructorStoryRepostInfo:
+		return decodeEntity[StoryRepostInfo](decoder)
+
+	case ConstructorStoryInteractionInfo:
+		return decodeEntity[StoryInteractionInfo](decoder)
+
+	case ConstructorStory:
+		return decodeEntity[Story](decoder)
+
+	case ConstructorStories:
+		return decodeEntity[Stories](decoder)
+
+	case ConstructorFoundStories:
+		return decodeEntity[FoundStories](decoder)
+
+	case ConstructorStoryFullId:
+		return decodeEntity[StoryFullId](decoder)
+
+	case ConstructorStoryInfo:
+		return decodeEntity[StoryInfo](decoder)
+
+	case ConstructorChatActiveStories:
+		return decodeEntity[ChatActiveStories](decoder)
+
+	case ConstructorStoryInteractionTypeView:
+		return decodeEntity[StoryInteractionTypeView](decoder)
+
+	case ConstructorStoryInteractionTypeForward:
+		return decodeEntity[StoryInteractionTypeForward](decoder)
+
+	case ConstructorStoryInteractionTypeRepost:
+		return decodeEntity[StoryInteractionTypeRepost](decoder)
+
+	case ConstructorStoryInteraction:
+		return decodeEntity[StoryInteraction](decoder)
+
+	case ConstructorStoryInteractions:
+		return decodeEntity[StoryInteractions](decoder)
+
+	case ConstructorQuickReplyMessage:
+		return decodeEntity[QuickReplyMessage](decoder)
+
+	case ConstructorQuickReplyMessages:
+		return decodeEntity[QuickReplyMessages](decoder)
+
+	case ConstructorQuickReplyShortcut:
+		return decodeEntity[QuickReplyShortcut](decoder)
+
+	case ConstructorPublicForwardMessage:
+		return decodeEntity[PublicForwardMessage](decoder)
+
+	case ConstructorPublicForwardStory:
+		return decodeEntity[PublicForwardStory](decoder)
+
+	case ConstructorPublicForwards:
+		return decodeEntity[PublicForwards](decoder)
+
+	case ConstructorBotMediaPreview:
+		return decodeEntity[BotMediaPreview](decoder)
+
+	case ConstructorBotMediaPreviews:
+		return decodeEntity[BotMediaPreviews](decoder)
+
+	case ConstructorBotMediaPreviewInfo:
+		return decodeEntity[BotMediaPreviewInfo](decoder)
+
+	case ConstructorChatBoostLevelFeatures:
+		return decodeEntity[ChatBoostLevelFeatures](decoder)
+
+	case ConstructorChatBoostFeatures:
+		return decodeEntity[ChatBoostFeatures](decoder)
+
+	case ConstructorChatBoostSourceGiftCode:
+		return decodeEntity[ChatBoostSourceGiftCode](decoder)
+
+	case ConstructorChatBoostSourceGiveaway:
+		return decodeEntity[ChatBoostSourceGiveaway](decoder)
+
+	case ConstructorChatBoostSourcePremium:
+		return decodeEntity[ChatBoostSourcePremium](decoder)
+
+	case ConstructorPrepaidGiveaway:
+		return decodeEntity[PrepaidGiveaway](decoder)
+
+	case ConstructorChatBoostStatus:
+		return decodeEntity[ChatBoostStatus](decoder)
+
+	case ConstructorChatBoost:
+		return decodeEntity[ChatBoost](decoder)
+
+	case ConstructorFoundChatBoosts:
+		return decodeEntity[FoundChatBoosts](decoder)
+
+	case ConstructorChatBoostSlot:
+		return decodeEntity[ChatBoostSlot](decoder)
+
+	case ConstructorChatBoostSlots:
+		return decodeEntity[ChatBoostSlots](decoder)
+
+	case ConstructorResendCodeReasonUserRequest:
+		return decodeEntity[ResendCodeReasonUserRequest](decoder)
+
+	case ConstructorResendCodeReasonVerificationFailed:
+		return decodeEntity[ResendCodeReasonVerificationFailed](decoder)
+
+	case ConstructorCallDiscardReasonEmpty:
+		return decodeEntity[CallDiscardReasonEmpty](decoder)
+
+	case ConstructorCallDiscardReasonMissed:
+		return decodeEntity[CallDiscardReasonMissed](decoder)
+
+	case ConstructorCallDiscardReasonDeclined:
+		return decodeEntity[CallDiscardReasonDeclined](decoder)
+
+	case ConstructorCallDiscardReasonDisconnected:
+		return decodeEntity[CallDiscardReasonDisconnected](decoder)
+
+	case ConstructorCallDiscardReasonHungUp:
+		return decodeEntity[CallDiscardReasonHungUp](decoder)
+
+	case ConstructorCallDiscardReasonAllowGroupCall:
+		return decodeEntity[CallDiscardReasonAllowGroupCall](decoder)
+
+	case ConstructorCallProtocol:
+		return decodeEntity[CallProtocol](decoder)
+
+	case ConstructorCallServerTypeTelegramReflector:
+		return decodeEntity[CallServerTypeTelegramReflector](decoder)
+
+	case ConstructorCallServerTypeWebrtc:
+		return decodeEntity[CallServerTypeWebrtc](decoder)
+
+	case ConstructorCallServer:
+		return decodeEntity[CallServer](decoder)
+
+	case ConstructorCallId:
+		return decodeEntity[CallId](decoder)
+
+	case ConstructorGroupCallId:
+		return decodeEntity[GroupCallId](decoder)
+
+	case ConstructorCallStatePending:
+		return decodeEntity[CallStatePending](decoder)
+
+	case ConstructorCallStateExchangingKeys:
+		return decodeEntity[CallStateExchangingKeys](decoder)
+
+	case ConstructorCallStateReady:
+		return decodeEntity[CallStateReady](decoder)
+
+	case ConstructorCallStateHangingUp:
+		return decodeEntity[CallStateHangingUp](decoder)
+
+	case ConstructorCallStateDiscarded:
+		return decodeEntity[CallStateDiscarded](decoder)
+
+	case ConstructorCallStateError:
+		return decodeEntity[CallStateError](decoder)
+
+	case ConstructorGroupCallVideoQualityThumbnail:
+		return decodeEntity[GroupCallVideoQualityThumbnail](decoder)
+
+	case ConstructorGroupCallVideoQualityMedium:
+		return decodeEntity[GroupCallVideoQualityMedium](decoder)
+
+	case ConstructorGroupCallVideoQualityFull:
+		return decodeEntity[GroupCallVideoQualityFull](decoder)
+
+	case ConstructorGroupCallStream:
+		return decodeEntity[GroupCallStream](decoder)
+
+	case ConstructorGroupCallStreams:
+		return decodeEntity[GroupCallStreams](decoder)
+
+	case ConstructorRtmpUrl:
+		return decodeEntity[RtmpUrl](decoder)
+
+	case ConstructorGroupCallRecentSpeaker:
+		return decodeEntity[GroupCallRecentSpeaker](decoder)
+
+	case ConstructorGroupCall:
+		return decodeEntity[GroupCall](decoder)
+
+	case ConstructorGroupCallVideoSourceGroup:
+		return decodeEntity[GroupCallVideoSourceGroup](decoder)
+
+	case ConstructorGroupCallParticipantVideoInfo:
+		return decodeEntity[GroupCallParticipantVideoInfo](decoder)
+
+	case ConstructorGroupCallParticipant:
+		return decodeEntity[GroupCallParticipant](decoder)
+
+	case ConstructorCallProblemEcho:
+		return decodeEntity[CallProblemEcho](decoder)
+
+	case ConstructorCallProblemNoise:
+		return decodeEntity[CallProblemNoise](decoder)
+
+	case ConstructorCallProblemInterruptions:
+		return decodeEntity[CallProblemInterruptions](decoder)
+
+	case ConstructorCallProblemDistortedSpeech:
+		return decodeEntity[CallProblemDistortedSpeech](decoder)
+
+	case ConstructorCallProblemSilentLocal:
+		return decodeEntity[CallProblemSilentLocal](decoder)
+
+	case ConstructorCallProblemSilentRemote:
+		return decodeEntity[CallProblemSilentRemote](decoder)
+
+	case ConstructorCallProblemDropped:
+		return decodeEntity[CallProblemDropped](decoder)
+
+	case ConstructorCallProblemDistortedVideo:
+		return decodeEntity[CallProblemDistortedVideo](decoder)
+
+	case ConstructorCallProblemPixelatedVideo:
+		return decodeEntity[CallProblemPixelatedVideo](decoder)
+
+	case ConstructorCall:
+		return decodeEntity[Call](decoder)
+
+	case ConstructorFirebaseAuthenticationSettingsAndroid:
+		return decodeEntity[FirebaseAuthenticationSettingsAndroid](decoder)
+
+	case ConstructorFirebaseAuthenticationSettingsIos:
+		return decodeEntity[FirebaseAuthenticationSettingsIos](decoder)
+
+	case ConstructorPhoneNumberAuthenticationSettings:
+		return decodeEntity[PhoneNumberAuthenticationSettings](decoder)
+
+	case ConstructorAddedReaction:
+		return decodeEntity[AddedReaction](decoder)
+
+	case ConstructorAddedReactions:
+		return decodeEntity[AddedReactions](decoder)
+
+	case ConstructorAvailableReaction:
+		return decodeEntity[AvailableReaction](decoder)
+
+	case ConstructorAvailableReactions:
+		return decodeEntity[AvailableReactions](decoder)
+
+	case ConstructorEmojiReaction:
+		return decodeEntity[EmojiReaction](decoder)
+
+	case ConstructorReactionUnavailabilityReasonAnonymousAdministrator:
+		return decodeEntity[ReactionUnavailabilityReasonAnonymousAdministrator](decoder)
+
+	case ConstructorReactionUnavailabilityReasonGuest:
+		return decodeEntity[ReactionUnavailabilityReasonGuest](decoder)
+
+	case ConstructorAnimations:
+		return decodeEntity[Animations](decoder)
+
+	case ConstructorDiceStickersRegular:
+		return decodeEntity[DiceStickersRegular](decoder)
+
+	case ConstructorDiceStickersSlotMachine:
+		return decodeEntity[DiceStickersSlotMachine](decoder)
+
+	case ConstructorImportedContacts:
+		return decodeEntity[ImportedContacts](decoder)
+
+	case ConstructorSpeechRecognitionResultPending:
+		return decodeEntity[SpeechRecognitionResultPending](decoder)
+
+	case ConstructorSpeechRecognitionResultText:
+		return decodeEntity[SpeechRecognitionResultText](decoder)
+
+	case ConstructorSpeechRecognitionResultError:
+		return decodeEntity[SpeechRecognitionResultError](decoder)
+
+	case ConstructorBusinessConnection:
+		return decodeEntity[BusinessConnection](decoder)
+
+	case ConstructorAttachmentMenuBotColor:
+		return decodeEntity[AttachmentMenuBotColor](decoder)
+
+	case ConstructorAttachmentMenuBot:
+		return decodeEntity[AttachmentMenuBot](decoder)
+
+	case ConstructorSentWebAppMessage:
+		return decodeEntity[SentWebAppMessage](decoder)
+
+	case ConstructorBotWriteAccessAllowReasonConnectedWebsite:
+		return decodeEntity[BotWriteAccessAllowReasonConnectedWebsite](decoder)
+
+	case ConstructorBotWriteAccessAllowReasonAddedToAttachmentMenu:
+		return decodeEntity[BotWriteAccessAllowReasonAddedToAttachmentMenu](decoder)
+
+	case ConstructorBotWriteAccessAllowReasonLaunchedWebApp:
+		return decodeEntity[BotWriteAccessAllowReasonLaunchedWebApp](decoder)
+
+	case ConstructorBotWriteAccessAllowReasonAcceptedRequest:
+		return decodeEntity[BotWriteAccessAllowReasonAcceptedRequest](decoder)
+
+	case ConstructorHttpUrl:
+		return decodeEntity[HttpUrl](decoder)
+
+	case ConstructorUserLink:
+		return decodeEntity[UserLink](decoder)
+
+	case ConstructorTargetChatTypes:
+		return decodeEntity[TargetChatTypes](decoder)
+
+	case ConstructorTargetChatCurrent:
+		return decodeEntity[TargetChatCurrent](decoder)
+
+	case ConstructorTargetChatChosen:
+		return decodeEntity[TargetChatChosen](decoder)
+
+	case ConstructorTargetChatInternalLink:
+		return decodeEntity[TargetChatInternalLink](decoder)
+
+	case ConstructorInputInlineQueryResultAnimation:
+		return decodeEntity[InputInlineQueryResultAnimation](decoder)
+
+	case ConstructorInputInlineQueryResultArticle:
+		return decodeEntity[InputInlineQueryResultArticle](decoder)
+
+	case ConstructorInputInlineQueryResultAudio:
+		return decodeEntity[InputInlineQueryResultAudio](decoder)
+
+	case ConstructorInputInlineQueryResultContact:
+		return decodeEntity[InputInlineQueryResultContact](decoder)
+
+	case ConstructorInputInlineQueryResultDocument:
+		return decodeEntity[InputInlineQueryResultDocument](decoder)
+
+	case ConstructorInputInlineQueryResultGame:
+		return decodeEntity[InputInlineQueryResultGame](decoder)
+
+	case ConstructorInputInlineQueryResultLocation:
+		return decodeEntity[InputInlineQueryResultLocation](decoder)
+
+	case ConstructorInputInlineQueryResultPhoto:
+		return decodeEntity[InputInlineQueryResultPhoto](decoder)
+
+	case ConstructorInputInlineQueryResultSticker:
+		return decodeEntity[InputInlineQueryResultSticker](decoder)
+
+	case ConstructorInputInlineQueryResultVenue:
+		return decodeEntity[InputInlineQueryResultVenue](decoder)
+
+	case ConstructorInputInlineQueryResultVideo:
+		return decodeEntity[InputInlineQueryResultVideo](decoder)
+
+	case ConstructorInputInlineQueryResultVoiceNote:
+		return decodeEntity[InputInlineQueryResultVoiceNote](decoder)
+
+	case ConstructorInlineQueryResultArticle:
+		return decodeEntity[InlineQueryResultArticle](decoder)
+
+	case ConstructorInlineQueryResultContact:
+		return decodeEntity[InlineQueryResultContact](decoder)
+
+	case ConstructorInlineQueryResultLocation:
+		return decodeEntity[InlineQueryResultLocation](decoder)
+
+	case ConstructorInlineQueryResultVenue:
+		return decodeEntity[InlineQueryResultVenue](decoder)
+
+	case ConstructorInlineQueryResultGame:
+		return decodeEntity[InlineQueryResultGame](decoder)
+
+	case ConstructorInlineQueryResultAnimation:
+		return decodeEntity[InlineQueryResultAnimation](decoder)
+
+	case ConstructorInlineQueryResultAudio:
+		return decodeEntity[InlineQueryResultAudio](decoder)
+
+	case ConstructorInlineQueryResultDocument:
+		return decodeEntity[InlineQueryResultDocument](decoder)
+
+	case ConstructorInlineQueryResultPhoto:
+		return decodeEntity[InlineQueryResultPhoto](decoder)
+
+	case ConstructorInlineQueryResultSticker:
+		return decodeEntity[InlineQueryResultSticker](decoder)
+
+	case ConstructorInlineQueryResultVideo:
+		return decodeEntity[InlineQueryResultVideo](decoder)
+
+	case ConstructorInlineQueryResultVoiceNote:
+		return decodeEntity[InlineQueryResultVoiceNote](decoder)
+
+	case ConstructorInlineQueryResultsButtonTypeStartBot:
+		return decodeEntity[InlineQueryResultsButtonTypeStartBot](decoder)
+
+	case ConstructorInlineQueryResultsButtonTypeWebApp:
+		return decodeEntity[InlineQueryResultsButtonTypeWebApp](decoder)
+
+	case ConstructorInlineQueryResultsButton:
+		return decodeEntity[InlineQueryResultsButton](decoder)
+
+	case ConstructorInlineQueryResults:
+		return decodeEntity[InlineQueryResults](decoder)
+
+	case ConstructorPreparedInlineMessageId:
+		return decodeEntity[PreparedInlineMessageId](decoder)
+
+	case ConstructorPreparedInlineMessage:
+		return decodeEntity[PreparedInlineMessage](decoder)
+
+	case ConstructorCallbackQueryPayloadData:
+		return decodeEntity[CallbackQueryPayloadData](decoder)
+
+	case ConstructorCallbackQueryPayloadDataWithPassword:
+		return decodeEntity[CallbackQueryPayloadDataWithPassword](decoder)
+
+	case ConstructorCallbackQueryPayloadGame:
+		return decodeEntity[CallbackQueryPayloadGame](decoder)
+
+	case ConstructorCallbackQueryAnswer:
+		return decodeEntity[CallbackQueryAnswer](decoder)
+
+	case ConstructorCustomRequestResult:
+		return decodeEntity[CustomRequestResult](decoder)
+
+	case ConstructorGameHighScore:
+		return decodeEntity[GameHighScore](decoder)
+
+	case ConstructorGameHighScores:
+		return decodeEntity[GameHighScores](decoder)
+
+	case ConstructorChatEventMessageEdited:
+		return decodeEntity[ChatEventMessageEdited](decoder)
+
+	case ConstructorChatEventMessageDeleted:
+		return decodeEntity[ChatEventMessageDeleted](decoder)
+
+	case ConstructorChatEventMessagePinned:
+		return decodeEntity[ChatEventMessagePinned](decoder)
+
+	case ConstructorChatEventMessageUnpinned:
+		return decodeEntity[ChatEventMessageUnpinned](decoder)
+
+	case ConstructorChatEventPollStopped:
+		return decodeEntity[ChatEventPollStopped](decoder)
+
+	case ConstructorChatEventMemberJoined:
+		return decodeEntity[ChatEventMemberJoined](decoder)
+
+	case ConstructorChatEventMemberJoinedByInviteLink:
+		return decodeEntity[ChatEventMemberJoinedByInviteLink](decoder)
+
+	case ConstructorChatEventMemberJoinedByRequest:
+		return decodeEntity[ChatEventMemberJoinedByRequest](decoder)
+
+	case ConstructorChatEventMemberInvited:
+		return decodeEntity[ChatEventMemberInvited](decoder)
+
+	case ConstructorChatEventMemberLeft:
+		return decodeEntity[ChatEventMemberLeft](decoder)
+
+	case ConstructorChatEventMemberPromoted:
+		return decodeEntity[ChatEventMemberPromoted](decoder)
+
+	case ConstructorChatEventMemberRestricted:
+		return decodeEntity[ChatEventMemberRestricted](decoder)
+
+	case ConstructorChatEventMemberSubscriptionExtended:
+		return decodeEntity[ChatEventMemberSubscriptionExtended](decoder)
+
+	case ConstructorChatEventAvailableReactionsChanged:
+		return decodeEntity[ChatEventAvailableReactionsChanged](decoder)
+
+	case ConstructorChatEventBackgroundChanged:
+		return decodeEntity[ChatEventBackgroundChanged](decoder)
+
+	case ConstructorChatEventDescriptionChanged:
+		return decodeEntity[ChatEventDescriptionChanged](decoder)
+
+	case ConstructorChatEventEmojiStatusChanged:
+		return decodeEntity[ChatEventEmojiStatusChanged](decoder)
+
+	case ConstructorChatEventLinkedChatChanged:
+		return decodeEntity[ChatEventLinkedChatChanged](decoder)
+
+	case ConstructorChatEventLocationChanged:
+		return decodeEntity[ChatEventLocationChanged](decoder)
+
+	case ConstructorChatEventMessageAutoDeleteTimeChanged:
+		return decodeEntity[ChatEventMessageAutoDeleteTimeChanged](decoder)
+
+	case ConstructorChatEventPermissionsChanged:
+		return decodeEntity[ChatEventPermissionsChanged](decoder)
+
+	case ConstructorChatEventPhotoChanged:
+		return decodeEntity[ChatEventPhotoChanged](decoder)
+
+	case ConstructorChatEventSlowModeDelayChanged:
+		return decodeEntity[ChatEventSlowModeDelayChanged](decoder)
+
+	case ConstructorChatEventStickerSetChanged:
+		return decodeEntity[ChatEventStickerSetChanged](decoder)
+
+	case ConstructorChatEventCustomEmojiStickerSetChanged:
+		return decodeEntity[ChatEventCustomEmojiStickerSetChanged](decoder)
+
+	case ConstructorChatEventTitleChanged:
+		return decodeEntity[ChatEventTitleChanged](decoder)
+
+	case ConstructorChatEventUsernameChanged:
+		return decodeEntity[ChatEventUsernameChanged](decoder)
+
+	case ConstructorChatEventActiveUsernamesChanged:
+		return decodeEntity[ChatEventActiveUsernamesChanged](decoder)
+
+	case ConstructorChatEventAccentColorChanged:
+		return decodeEntity[ChatEventAccentColorChanged](decoder)
+
+	case ConstructorChatEventProfileAccentColorChanged:
+		return decodeEntity[ChatEventProfileAccentColorChanged](decoder)
+
+	case ConstructorChatEventHasProtectedContentToggled:
+		return decodeEntity[ChatEventHasProtectedContentToggled](decoder)
+
+	case ConstructorChatEventInvitesToggled:
+		return decodeEntity[ChatEventInvitesToggled](decoder)
+
+	case ConstructorChatEventIsAllHistoryAvailableToggled:
+		return decodeEntity[ChatEventIsAllHistoryAvailableToggled](decoder)
+
+	case ConstructorChatEventHasAggressiveAntiSpamEnabledToggled:
+		return decodeEntity[ChatEventHasAggressiveAntiSpamEnabledToggled](decoder)
+
+	case ConstructorChatEventSignMessagesToggled:
+		return decodeEntity[ChatEventSignMessagesToggled](decoder)
+
+	case ConstructorChatEventShowMessageSenderToggled:
+		return decodeEntity[ChatEventShowMessageSenderToggled](decoder)
+
+	case ConstructorChatEventInviteLinkEdited:
+		return decodeEntity[ChatEventInviteLinkEdited](decoder)
+
+	case ConstructorChatEventInviteLinkRevoked:
+		return decodeEntity[ChatEventInviteLinkRevoked](decoder)
+
+	case ConstructorChatEventInviteLinkDeleted:
+		return decodeEntity[ChatEventInviteLinkDeleted](decoder)
+
+	case ConstructorChatEventVideoChatCreated:
+		return decodeEntity[ChatEventVideoChatCreated](decoder)
+
+	case ConstructorChatEventVideoChatEnded:
+		return decodeEntity[ChatEventVideoChatEnded](decoder)
+
+	case ConstructorChatEventVideoChatMuteNewParticipantsToggled:
+		return decodeEntity[ChatEventVideoChatMuteNewParticipantsToggled](decoder)
+
+	case ConstructorChatEventVideoChatParticipantIsMutedToggled:
+		return decodeEntity[ChatEventVideoChatParticipantIsMutedToggled](decoder)
+
+	case ConstructorChatEventVideoChatParticipantVolumeLevelChanged:
+		return decodeEntity[ChatEventVideoChatParticipantVolumeLevelChanged](decoder)
+
+	case ConstructorChatEventIsForumToggled:
+		return decodeEntity[ChatEventIsForumToggled](decoder)
+
+	case ConstructorChatEventForumTopicCreated:
+		return decodeEntity[ChatEventForumTopicCreated](decoder)
+
+	case ConstructorChatEventForumTopicEdited:
+		return decodeEntity[ChatEventForumTopicEdited](decoder)
+
+	case ConstructorChatEventForumTopicToggleIsClosed:
+		return decodeEntity[ChatEventForumTopicToggleIsClosed](decoder)
+
+	case ConstructorChatEventForumTopicToggleIsHidden:
+		return decodeEntity[ChatEventForumTopicToggleIsHidden](decoder)
+
+	case ConstructorChatEventForumTopicDeleted:
+		return decodeEntity[ChatEventForumTopicDeleted](decoder)
+
+	case ConstructorChatEventForumTopicPinned:
+		return decodeEntity[ChatEventForumTopicPinned](decoder)
+
+	case ConstructorChatEvent:
+		return decodeEntity[ChatEvent](decoder)
+
+	case ConstructorChatEvents:
+		return decodeEntity[ChatEvents](decoder)
+
+	case ConstructorChatEventLogFilters:
+		return decodeEntity[ChatEventLogFilters](decoder)
+
+	case ConstructorLanguagePackStringValueOrdinary:
+		return decodeEntity[LanguagePackStringValueOrdinary](decoder)
+
+	case ConstructorLanguagePackStringValuePluralized:
+		return decodeEntity[LanguagePackStringValuePluralized](decoder)
+
+	case ConstructorLanguagePackStringValueDeleted:
+		return decodeEntity[LanguagePackStringValueDeleted](decoder)
+
+	case ConstructorLanguagePackString:
+		return decodeEntity[LanguagePackString](decoder)
+
+	case ConstructorLanguagePackStrings:
+		return decodeEntity[LanguagePackStrings](decoder)
+
+	case ConstructorLanguagePackInfo:
+		return decodeEntity[LanguagePackInfo](decoder)
+
+	case ConstructorLocalizationTargetInfo:
+		return decodeEntity[LocalizationTargetInfo](decoder)
+
+	case ConstructorPremiumLimitTypeSupergroupCount:
+		return decodeEntity[PremiumLimitTypeSupergroupCount](decoder)
+
+	case ConstructorPremiumLimitTypePinnedChatCount:
+		return decodeEntity[PremiumLimitTypePinnedChatCount](decoder)
+
+	case ConstructorPremiumLimitTypeCreatedPublicChatCount:
+		return decodeEntity[PremiumLimitTypeCreatedPublicChatCount](decoder)
+
+	case ConstructorPremiumLimitTypeSavedAnimationCount:
+		return decodeEntity[PremiumLimitTypeSavedAnimationCount](decoder)
+
+	case ConstructorPremiumLimitTypeFavoriteStickerCount:
+		return decodeEntity[PremiumLimitTypeFavoriteStickerCount](decoder)
+
+	case ConstructorPremiumLimitTypeChatFolderCount:
+		return decodeEntity[PremiumLimitTypeChatFolderCount](decoder)
+
+	case ConstructorPremiumLimitTypeChatFolderChosenChatCount:
+		return decodeEntity[PremiumLimitTypeChatFolderChosenChatCount](decoder)
+
+	case ConstructorPremiumLimitTypePinnedArchivedChatCount:
+		return decodeEntity[PremiumLimitTypePinnedArchivedChatCount](decoder)
+
+	case ConstructorPremiumLimitTypePinnedSavedMessagesTopicCount:
+		return decodeEntity[PremiumLimitTypePinnedSavedMessagesTopicCount](decoder)
+
+	case ConstructorPremiumLimitTypeCaptionLength:
+		return decodeEntity[PremiumLimitTypeCaptionLength](decoder)
+
+	case ConstructorPremiumLimitTypeBioLength:
+		return decodeEntity[PremiumLimitTypeBioLength](decoder)
+
+	case ConstructorPremiumLimitTypeChatFolderInviteLinkCount:
+		return decodeEntity[PremiumLimitTypeChatFolderInviteLinkCount](decoder)
+
+	case ConstructorPremiumLimitTypeShareableChatFolderCount:
+		return decodeEntity[PremiumLimitTypeShareableChatFolderCount](decoder)
+
+	case ConstructorPremiumLimitTypeActiveStoryCount:
+		return decodeEntity[PremiumLimitTypeActiveStoryCount](decoder)
+
+	case ConstructorPremiumLimitTypeWeeklySentStoryCount:
+		return decodeEntity[PremiumLimitTypeWeeklySentStoryCount](decoder)
+
+	case ConstructorPremiumLimitTypeMonthlySentStoryCount:
+		return decodeEntity[PremiumLimitTypeMonthlySentStoryCount](decoder)
+
+	case ConstructorPremiumLimitTypeStoryCaptionLength:
+		return decodeEntity[PremiumLimitTypeStoryCaptionLength](decoder)
+
+	case ConstructorPremiumLimitTypeStorySuggestedReactionAreaCount:
+		return decodeEntity[PremiumLimitTypeStorySuggestedReactionAreaCount](decoder)
+
+	case ConstructorPremiumLimitTypeSimilarChatCount:
+		return decodeEntity[PremiumLimitTypeSimilarChatCount](decoder)
+
+	case ConstructorPremiumFeatureIncreasedLimits:
+		return decodeEntity[PremiumFeatureIncreasedLimits](decoder)
+
+	case ConstructorPremiumFeatureIncreasedUploadFileSize:
+		return decodeEntity[PremiumFeatureIncreasedUploadFileSize](decoder)
+
+	case ConstructorPremiumFeatureImprovedDownloadSpeed:
+		return decodeEntity[PremiumFeatureImprovedDownloadSpeed](decoder)
+
+	case ConstructorPremiumFeatureVoiceRecognition:
+		return decodeEntity[PremiumFeatureVoiceRecognition](decoder)
+
+	case ConstructorPremiumFeatureDisabledAds:
+		return decodeEntity[PremiumFeatureDisabledAds](decoder)
+
+	case ConstructorPremiumFeatureUniqueReactions:
+		return decodeEntity[PremiumFeatureUniqueReactions](decoder)
+
+	case ConstructorPremiumFeatureUniqueStickers:
+		return decodeEntity[PremiumFeatureUniqueStickers](decoder)
+
+	case ConstructorPremiumFeatureCustomEmoji:
+		return decodeEntity[PremiumFeatureCustomEmoji](decoder)
+
+	case ConstructorPremiumFeatureAdvancedChatManagement:
+		return decodeEntity[PremiumFeatureAdvancedChatManagement](decoder)
+
+	case ConstructorPremiumFeatureProfileBadge:
+		return decodeEntity[PremiumFeatureProfileBadge](decoder)
+
+	case ConstructorPremiumFeatureEmojiStatus:
+		return decodeEntity[PremiumFeatureEmojiStatus](decoder)
+
+	case ConstructorPremiumFeatureAnimatedProfilePhoto:
+		return decodeEntity[PremiumFeatureAnimatedProfilePhoto](decoder)
+
+	case ConstructorPremiumFeatureForumTopicIcon:
+		return decodeEntity[PremiumFeatureForumTopicIcon](decoder)
+
+	case ConstructorPremiumFeatureAppIcons:
+		return decodeEntity[PremiumFeatureAppIcons](decoder)
+
+	case ConstructorPremiumFeatureRealTimeChatTranslation:
+		return decodeEntity[PremiumFeatureRealTimeChatTranslation](decoder)
+
+	case ConstructorPremiumFeatureUpgradedStories:
+		return decodeEntity[PremiumFeatureUpgradedStories](decoder)
+
+	case ConstructorPremiumFeatureChatBoost:
+		return decodeEntity[PremiumFeatureChatBoost](decoder)
+
+	case ConstructorPremiumFeatureAccentColor:
+		return decodeEntity[PremiumFeatureAccentColor](decoder)
+
+	case ConstructorPremiumFeatureBackgroundForBoth:
+		return decodeEntity[PremiumFeatureBackgroundForBoth](decoder)
+
+	case ConstructorPremiumFeatureSavedMessagesTags:
+		return decodeEntity[PremiumFeatureSavedMessagesTags](decoder)
+
+	case ConstructorPremiumFeatureMessagePrivacy:
+		return decodeEntity[PremiumFeatureMessagePrivacy](decoder)
+
+	case ConstructorPremiumFeatureLastSeenTimes:
+		return decodeEntity[PremiumFeatureLastSeenTimes](decoder)
+
+	case ConstructorPremiumFeatureBusiness:
+		return decodeEntity[PremiumFeatureBusiness](decoder)
+
+	case ConstructorPremiumFeatureMessageEffects:
+		return decodeEntity[PremiumFeatureMessageEffects](decoder)
+
+	case ConstructorBusinessFeatureLocation:
+		return decodeEntity[BusinessFeatureLocation](decoder)
+
+	case ConstructorBusinessFeatureOpeningHours:
+		return decodeEntity[BusinessFeatureOpeningHours](decoder)
+
+	case ConstructorBusinessFeatureQuickReplies:
+		return decodeEntity[BusinessFeatureQuickReplies](decoder)
+
+	case ConstructorBusinessFeatureGreetingMessage:
+		return decodeEntity[BusinessFeatureGreetingMessage](decoder)
+
+	case ConstructorBusinessFeatureAwayMessage:
+		return decodeEntity[BusinessFeatureAwayMessage](decoder)
+
+	case ConstructorBusinessFeatureAccountLinks:
+		return decodeEntity[BusinessFeatureAccountLinks](decoder)
+
+	case ConstructorBusinessFeatureStartPage:
+		return decodeEntity[BusinessFeatureStartPage](decoder)
+
+	case ConstructorBusinessFeatureBots:
+		return decodeEntity[BusinessFeatureBots](decoder)
+
+	case ConstructorBusinessFeatureEmojiStatus:
+		return decodeEntity[BusinessFeatureEmojiStatus](decoder)
+
+	case ConstructorBusinessFeatureChatFolderTags:
+		return decodeEntity[BusinessFeatureChatFolderTags](decoder)
+
+	case ConstructorBusinessFeatureUpgradedStories:
+		return decodeEntity[BusinessFeatureUpgradedStories](decoder)
+
+	case ConstructorPremiumStoryFeaturePriorityOrder:
+		return decodeEntity[PremiumStoryFeaturePriorityOrder](decoder)
+
+	case ConstructorPremiumStoryFeatureStealthMode:
+		return decodeEntity[PremiumStoryFeatureStealthMode](decoder)
+
+	case ConstructorPremiumStoryFeaturePermanentViewsHistory:
+		return decodeEntity[PremiumStoryFeaturePermanentViewsHistory](decoder)
+
+	case ConstructorPremiumStoryFeatureCustomExpirationDuration:
+		return decodeEntity[PremiumStoryFeatureCustomExpirationDuration](decoder)
+
+	case ConstructorPremiumStoryFeatureSaveStories:
+		return decodeEntity[PremiumStoryFeatureSaveStories](decoder)
+
+	case ConstructorPremiumStoryFeatureLinksAndFormatting:
+		return decodeEntity[PremiumStoryFeatureLinksAndFormatting](decoder)
+
+	case ConstructorPremiumStoryFeatureVideoQuality:
+		return decodeEntity[PremiumStoryFeatureVideoQuality](decoder)
+
+	case ConstructorPremiumLimit:
+		return decodeEntity[PremiumLimit](decoder)
+
+	case ConstructorPremiumFeatures:
+		return decodeEntity[PremiumFeatures](decoder)
+
+	case ConstructorBusinessFeatures:
+		return decodeEntity[BusinessFeatures](decoder)
+
+	case ConstructorPremiumSourceLimitExceeded:
+		return decodeEntity[PremiumSourceLimitExceeded](decoder)
+
+	case ConstructorPremiumSourceFeature:
+		return decodeEntity[PremiumSourceFeature](decoder)
+
+	case ConstructorPremiumSourceBusinessFeature:
+		return decodeEntity[PremiumSourceBusinessFeature](decoder)
+
+	case ConstructorPremiumSourceStoryFeature:
+		return decodeEntity[PremiumSourceStoryFeature](decoder)
+
+	case ConstructorPremiumSourceLink:
+		return decodeEntity[PremiumSourceLink](decoder)
+
+	case ConstructorPremiumSourceSettings:
+		return decodeEntity[PremiumSourceSettings](decoder)
+
+	case ConstructorPremiumFeaturePromotionAnimation:
+		return decodeEntity[PremiumFeaturePromotionAnimation](decoder)
+
+	case ConstructorBusinessFeaturePromotionAnimation:
+		return decodeEntity[BusinessFeaturePromotionAnimation](decoder)
+
+	case ConstructorPremiumState:
+		return decodeEntity[PremiumState](decoder)
+
+	case ConstructorStorePaymentPurposePremiumSubscription:
+		return decodeEntity[StorePaymentPurposePremiumSubscription](decoder)
+
+	case ConstructorStorePaymentPurposePremiumGift:
+		return decodeEntity[StorePaymentPurposePremiumGift](decoder)
+
+	case ConstructorStorePaymentPurposePremiumGiftCodes:
+		return decodeEntity[StorePaymentPurposePremiumGiftCodes](decoder)
+
+	case ConstructorStorePaymentPurposePremiumGiveaway:
+		return decodeEntity[StorePaymentPurposePremiumGiveaway](decoder)
+
+	case ConstructorStorePaymentPurposeStarGiveaway:
+		return decodeEntity[StorePaymentPurposeStarGiveaway](decoder)
+
+	case ConstructorStorePaymentPurposeStars:
+		return decodeEntity[StorePaymentPurposeStars](decoder)
+
+	case ConstructorStorePaymentPurposeGiftedStars:
+		return decodeEntity[StorePaymentPurposeGiftedStars](decoder)
+
+	case ConstructorStoreTransactionAppStore:
+		return decodeEntity[StoreTransactionAppStore](decoder)
+
+	case ConstructorStoreTransactionGooglePlay:
+		return decodeEntity[StoreTransactionGooglePlay](decoder)
+
+	case ConstructorTelegramPaymentPurposePremiumGift:
+		return decodeEntity[TelegramPaymentPurposePremiumGift](decoder)
+
+	case ConstructorTelegramPaymentPurposePremiumGiftCodes:
+		return decodeEntity[TelegramPaymentPurposePremiumGiftCodes](decoder)
+
+	case ConstructorTelegramPaymentPurposePremiumGiveaway:
+		return decodeEntity[TelegramPaymentPurposePremiumGiveaway](decoder)
+
+	case ConstructorTelegramPaymentPurposeStars:
+		return decodeEntity[TelegramPaymentPurposeStars](decoder)
+
+	case ConstructorTelegramPaymentPurposeGiftedStars:
+		return decodeEntity[TelegramPaymentPurposeGiftedStars](decoder)
+
+	case ConstructorTelegramPaymentPurposeStarGiveaway:
+		return decodeEntity[TelegramPaymentPurposeStarGiveaway](decoder)
+
+	case ConstructorTelegramPaymentPurposeJoinChat:
+		return decodeEntity[TelegramPaymentPurposeJoinChat](decoder)
+
+	case ConstructorDeviceTokenFirebaseCloudMessaging:
+		return decodeEntity[DeviceTokenFirebaseCloudMessaging](decoder)
+
+	case ConstructorDeviceTokenApplePush:
+		return decodeEntity[DeviceTokenApplePush](decoder)
+
+	case ConstructorDeviceTokenApplePushVoIP:
+		return decodeEntity[DeviceTokenApplePushVoIP](decoder)
+
+	case ConstructorDeviceTokenWindowsPush:
+		return decodeEntity[DeviceTokenWindowsPush](decoder)
+
+	case ConstructorDeviceTokenMicrosoftPush:
+		return decodeEntity[DeviceTokenMicrosoftPush](decoder)
+
+	case ConstructorDeviceTokenMicrosoftPushVoIP:
+		return decodeEntity[DeviceTokenMicrosoftPushVoIP](decoder)
+
+	case ConstructorDeviceTokenWebPush:
+		return decodeEntity[DeviceTokenWebPush](decoder)
+
+	case ConstructorDeviceTokenSimplePush:
+		return decodeEntity[DeviceTokenSimplePush](decoder)
+
+	case ConstructorDeviceTokenUbuntuPush:
+		return decodeEntity[DeviceTokenUbuntuPush](decoder)
+
+	case ConstructorDeviceTokenBlackBerryPush:
+		return decodeEntity[DeviceTokenBlackBerryPush](decoder)
+
+	case ConstructorDeviceTokenTizenPush:
+		return decodeEntity[DeviceTokenTizenPush](decoder)
+
+	case ConstructorDeviceTokenHuaweiPush:
+		return decodeEntity[DeviceTokenHuaweiPush](decoder)
+
+	case ConstructorPushReceiverId:
+		return decodeEntity[PushReceiverId](decoder)
+
+	case ConstructorBackgroundFillSolid:
+		return decodeEntity[BackgroundFillSolid](decoder)
+
+	case ConstructorBackgroundFillGradient:
+		return decodeEntity[BackgroundFillGradient](decoder)
+
+	case ConstructorBackgroundFillFreeformGradient:
+		return decodeEntity[BackgroundFillFreeformGradient](decoder)
+
+	case ConstructorBackgroundTypeWallpaper:
+		return decodeEntity[BackgroundTypeWallpaper](decoder)
+
+	case ConstructorBackgroundTypePattern:
+		return decodeEntity[BackgroundTypePattern](decoder)
+
+	case ConstructorBackgroundTypeFill:
+		return decodeEntity[BackgroundTypeFill](decoder)
+
+	case ConstructorBackgroundTypeChatTheme:
+		return decodeEntity[BackgroundTypeChatTheme](decoder)
+
+	case ConstructorInputBackgroundLocal:
+		return decodeEntity[InputBackgroundLocal](decoder)
+
+	case ConstructorInputBackgroundRemote:
+		return decodeEntity[InputBackgroundRemote](decoder)
+
+	case ConstructorInputBackgroundPrevious:
+		return decodeEntity[InputBackgroundPrevious](decoder)
+
+	case ConstructorChatTheme:
+		return decodeEntity[ChatTheme](decoder)
+
+	case ConstructorTimeZone:
+		return decodeEntity[TimeZone](decoder)
+
+	case ConstructorTimeZones:
+		return decodeEntity[TimeZones](decoder)
+
+	case ConstructorHashtags:
+		return decodeEntity[Hashtags](decoder)
+
+	case ConstructorCanSendStoryResultOk:
+		return decodeEntity[CanSendStoryResultOk](decoder)
+
+	case ConstructorCanSendStoryResultPremiumNeeded:
+		return decodeEntity[CanSendStoryResultPremiumNeeded](decoder)
+
+	case ConstructorCanSendStoryResultBoostNeeded:
+		return decodeEntity[CanSendStoryResultBoostNeeded](decoder)
+
+	case ConstructorCanSendStoryResultActiveStoryLimitExceeded:
+		return decodeEntity[CanSendStoryResultActiveStoryLimitExceeded](decoder)
+
+	case ConstructorCanSendStoryResultWeeklyLimitExceeded:
+		return decodeEntity[CanSendStoryResultWeeklyLimitExceeded](decoder)
+
+	case ConstructorCanSendStoryResultMonthlyLimitExceeded:
+		return decodeEntity[CanSendStoryResultMonthlyLimitExceeded](decoder)
+
+	case ConstructorCanTransferOwnershipResultOk:
+		return decodeEntity[CanTransferOwnershipResultOk](decoder)
+
+	case ConstructorCanTransferOwnershipResultPasswordNeeded:
+		return decodeEntity[CanTransferOwnershipResultPasswordNeeded](decoder)
+
+	case ConstructorCanTransferOwnershipResultPasswordTooFresh:
+		return decodeEntity[CanTransferOwnershipResultPasswordTooFresh](decoder)
+
+	case ConstructorCanTransferOwnershipResultSessionTooFresh:
+		return decodeEntity[CanTransferOwnershipResultSessionTooFresh](decoder)
+
+	case ConstructorCheckChatUsernameResultOk:
+		return decodeEntity[CheckChatUsernameResultOk](decoder)
+
+	case ConstructorCheckChatUsernameResultUsernameInvalid:
+		return decodeEntity[CheckChatUsernameResultUsernameInvalid](decoder)
+
+	case ConstructorCheckChatUsernameResultUsernameOccupied:
+		return decodeEntity[CheckChatUsernameResultUsernameOccupied](decoder)
+
+	case ConstructorCheckChatUsernameResultUsernamePurchasable:
+		return decodeEntity[CheckChatUsernameResultUsernamePurchasable](decoder)
+
+	case ConstructorCheckChatUsernameResultPublicChatsTooMany:
+		return decodeEntity[CheckChatUsernameResultPublicChatsTooMany](decoder)
+
+	case ConstructorCheckChatUsernameResultPublicGroupsUnavailable:
+		return decodeEntity[CheckChatUsernameResultPublicGroupsUnavailable](decoder)
+
+	case ConstructorCheckStickerSetNameResultOk:
+		return decodeEntity[CheckStickerSetNameResultOk](decoder)
+
+	case ConstructorCheckStickerSetNameResultNameInvalid:
+		return decodeEntity[CheckStickerSetNameResultNameInvalid](decoder)
+
+	case ConstructorCheckStickerSetNameResultNameOccupied:
+		return decodeEntity[CheckStickerSetNameResultNameOccupied](decoder)
+
+	case ConstructorResetPasswordResultOk:
+		return decodeEntity[ResetPasswordResultOk](decoder)
+
+	case ConstructorResetPasswordResultPending:
+		return decodeEntity[ResetPasswordResultPending](decoder)
+
+	case ConstructorResetPasswordResultDeclined:
+		return decodeEntity[ResetPasswordResultDeclined](decoder)
+
+	case ConstructorMessageFileTypePrivate:
+		return decodeEntity[MessageFileTypePrivate](decoder)
+
+	case ConstructorMessageFileTypeGroup:
+		return decodeEntity[MessageFileTypeGroup](decoder)
+
+	case ConstructorMessageFileTypeUnknown:
+		return decodeEntity[MessageFileTypeUnknown](decoder)
+
+	case ConstructorPushMessageContentHidden:
+		return decodeEntity[PushMessageContentHidden](decoder)
+
+	case ConstructorPushMessageContentAnimation:
+		return decodeEntity[PushMessageContentAnimation](decoder)
+
+	case ConstructorPushMessageContentAudio:
+		return decodeEntity[PushMessageContentAudio](decoder)
+
+	case ConstructorPushMessageContentContact:
+		return decodeEntity[PushMessageContentContact](decoder)
+
+	case ConstructorPushMessageContentContactRegistered:
+		return decodeEntity[PushMessageContentContactRegistered](decoder)
+
+	case ConstructorPushMessageContentDocument:
+		return decodeEntity[PushMessageContentDocument](decoder)
+
+	case ConstructorPushMessageContentGame:
+		return decodeEntity[PushMessageContentGame](decoder)
+
+	case ConstructorPushMessageContentGameScore:
+		return decodeEntity[PushMessageContentGameScore](decoder)
+
+	case ConstructorPushMessageContentInvoice:
+		return decodeEntity[PushMessageContentInvoice](decoder)
+
+	case ConstructorPushMessageContentLocation:
+		return decodeEntity[PushMessageContentLocation](decoder)
+
+	case ConstructorPushMessageContentPaidMedia:
+		return decodeEntity[PushMessageContentPaidMedia](decoder)
+
+	case ConstructorPushMessageContentPhoto:
+		return decodeEntity[PushMessageContentPhoto](decoder)
+
+	case ConstructorPushMessageContentPoll:
+		return decodeEntity[PushMessageContentPoll](decoder)
+
+	case ConstructorPushMessageContentPremiumGiftCode:
+		return decodeEntity[PushMessageContentPremiumGiftCode](decoder)
+
+	case ConstructorPushMessageContentGiveaway:
+		return decodeEntity[PushMessageContentGiveaway](decoder)
+
+	case ConstructorPushMessageContentGift:
+		return decodeEntity[PushMessageContentGift](decoder)
+
+	case ConstructorPushMessageContentUpgradedGift:
+		return decodeEntity[PushMessageContentUpgradedGift](decoder)
+
+	case ConstructorPushMessageContentScreenshotTaken:
+		return decodeEntity[PushMessageContentScreenshotTaken](decoder)
+
+	case ConstructorPushMessageContentSticker:
+		return decodeEntity[PushMessageContentSticker](decoder)
+
+	case ConstructorPushMessageContentStory:
+		return decodeEntity[PushMessageContentStory](decoder)
+
+	case ConstructorPushMessageContentText:
+		return decodeEntity[PushMessageContentText](decoder)
+
+	case ConstructorPushMessageContentVideo:
+		return decodeEntity[PushMessageContentVideo](decoder)
+
+	case ConstructorPushMessageContentVideoNote:
+		return decodeEntity[PushMessageContentVideoNote](decoder)
+
+	case ConstructorPushMessageContentVoiceNote:
+		return decodeEntity[PushMessageContentVoiceNote](decoder)
+
+	case ConstructorPushMessageContentBasicGroupChatCreate:
+		return decodeEntity[PushMessageContentBasicGroupChatCreate](decoder)
+
+	case ConstructorPushMessageContentVideoChatStarted:
+		return decodeEntity[PushMessageContentVideoChatStarted](decoder)
+
+	case ConstructorPushMessageContentVideoChatEnded:
+		return decodeEntity[PushMessageContentVideoChatEnded](decoder)
+
+	case ConstructorPushMessageContentInviteVideoChatParticipants:
+		return decodeEntity[PushMessageContentInviteVideoChatParticipants](decoder)
+
+	case ConstructorPushMessageContentChatAddMembers:
+		return decodeEntity[PushMessageContentChatAddMembers](decoder)
+
+	case ConstructorPushMessageContentChatChangePhoto:
+		return decodeEntity[PushMessageContentChatChangePhoto](decoder)
+
+	case ConstructorPushMessageContentChatChangeTitle:
+		return decodeEntity[PushMessageContentChatChangeTitle](decoder)
+
+	case ConstructorPushMessageContentChatSetBackground:
+		return decodeEntity[PushMessageContentChatSetBackground](decoder)
+
+	case ConstructorPushMessageContentChatSetTheme:
+		return decodeEntity[PushMessageContentChatSetTheme](decoder)
+
+	case ConstructorPushMessageContentChatDeleteMember:
+		return decodeEntity[PushMessageContentChatDeleteMember](decoder)
+
+	case ConstructorPushMessageContentChatJoinByLink:
+		return decodeEntity[PushMessageContentChatJoinByLink](decoder)
+
+	case ConstructorPushMessageContentChatJoinByRequest:
+		return decodeEntity[PushMessageContentChatJoinByRequest](decoder)
+
+	case ConstructorPushMessageContentRecurringPayment:
+		return decodeEntity[PushMessageContentRecurringPayment](decoder)
+
+	case ConstructorPushMessageContentSuggestProfilePhoto:
+		return decodeEntity[PushMessageContentSuggestProfilePhoto](decoder)
+
+	case ConstructorPushMessageContentProximityAlertTriggered:
+		return decodeEntity[PushMessageContentProximityAlertTriggered](decoder)
+
+	case ConstructorPushMessageContentMessageForwards:
+		return decodeEntity[PushMessageContentMessageForwards](decoder)
+
+	case ConstructorPushMessageContentMediaAlbum:
+		return decodeEntity[PushMessageContentMediaAlbum](decoder)
+
+	case ConstructorNotificationTypeNewMessage:
+		return decodeEntity[NotificationTypeNewMessage](decoder)
+
+	case ConstructorNotificationTypeNewSecretChat:
+		return decodeEntity[NotificationTypeNewSecretChat](decoder)
+
+	case ConstructorNotificationTypeNewCall:
+		return decodeEntity[NotificationTypeNewCall](decoder)
+
+	case ConstructorNotificationTypeNewPushMessage:
+		return decodeEntity[NotificationTypeNewPushMessage](decoder)
+
+	case ConstructorNotificationGroupTypeMessages:
+		return decodeEntity[NotificationGroupTypeMessages](decoder)
+
+	case ConstructorNotificationGroupTypeMentions:
+		return decodeEntity[NotificationGroupTypeMentions](decoder)
+
+	case ConstructorNotificationGroupTypeSecretChat:
+		return decodeEntity[NotificationGroupTypeSecretChat](decoder)
+
+	case ConstructorNotificationGroupTypeCalls:
+		return decodeEntity[NotificationGroupTypeCalls](decoder)
+
+	case ConstructorNotificationSound:
+		return decodeEntity[NotificationSound](decoder)
+
+	case ConstructorNotificationSounds:
+		return decodeEntity[NotificationSounds](decoder)
+
+	case ConstructorNotification:
+		return decodeEntity[Notification](decoder)
+
+	case ConstructorNotificationGroup:
+		return decodeEntity[NotificationGroup](decoder)
+
+	case ConstructorOptionValueBoolean:
+		return decodeEntity[OptionValueBoolean](decoder)
+
+	case ConstructorOptionValueEmpty:
+		return decodeEntity[OptionValueEmpty](decoder)
+
+	case ConstructorOptionValueInteger:
+		return decodeEntity[OptionValueInteger](decoder)
+
+	case ConstructorOptionValueString:
+		return decodeEntity[OptionValueString](decoder)
+
+	case ConstructorJsonObjectMember:
+		return decodeEntity[JsonObjectMember](decoder)
+
+	case ConstructorJsonValueNull:
+		return decodeEntity[JsonValueNull](decoder)
+
+	case ConstructorJsonValueBoolean:
+		return decodeEntity[JsonValueBoolean](decoder)
+
+	case ConstructorJsonValueNumber:
+		return decodeEntity[JsonValueNumber](decoder)
+
+	case ConstructorJsonValueString:
+		return decodeEntity[JsonValueString](decoder)
+
+	case ConstructorJsonValueArray:
+		return decodeEntity[JsonValueArray](decoder)
+
+	case ConstructorJsonValueObject:
+		return decodeEntity[JsonValueObject](decoder)
+
+	case ConstructorStoryPrivacySettingsEveryone:
+		return decodeEntity[StoryPrivacySettingsEveryone](decoder)
+
+	case ConstructorStoryPrivacySettingsContacts:
+		return decodeEntity[StoryPrivacySettingsContacts](decoder)
+
+	case ConstructorStoryPrivacySettingsCloseFriends:
+		return decodeEntity[StoryPrivacySettingsCloseFriends](decoder)
+
+	case ConstructorStoryPrivacySettingsSelectedUsers:
+		return decodeEntity[StoryPrivacySettingsSelectedUsers](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowAll:
+		return decodeEntity[UserPrivacySettingRuleAllowAll](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowContacts:
+		return decodeEntity[UserPrivacySettingRuleAllowContacts](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowBots:
+		return decodeEntity[UserPrivacySettingRuleAllowBots](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowPremiumUsers:
+		return decodeEntity[UserPrivacySettingRuleAllowPremiumUsers](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowUsers:
+		return decodeEntity[UserPrivacySettingRuleAllowUsers](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowChatMembers:
+		return decodeEntity[UserPrivacySettingRuleAllowChatMembers](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictAll:
+		return decodeEntity[UserPrivacySettingRuleRestrictAll](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictContacts:
+		return decodeEntity[UserPrivacySettingRuleRestrictContacts](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictBots:
+		return decodeEntity[UserPrivacySettingRuleRestrictBots](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictUsers:
+		return decodeEntity[UserPrivacySettingRuleRestrictUsers](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictChatMembers:
+		return decodeEntity[UserPrivacySettingRuleRestrictChatMembers](decoder)
+
+	case ConstructorUserPrivacySettingRules:
+		return decodeEntity[UserPrivacySettingRules](decoder)
+
+	case ConstructorUserPrivacySettingShowStatus:
+		return decodeEntity[UserPrivacySettingShowStatus](decoder)
+
+	case ConstructorUserPrivacySettingShowProfilePhoto:
+		return decodeEntity[UserPrivacySettingShowProfilePhoto](decoder)
+
+	case ConstructorUserPrivacySettingShowLinkInForwardedMessages:
+		return decodeEntity[UserPrivacySettingShowLinkInForwardedMessages](decoder)
+
+	case ConstructorUserPrivacySettingShowPhoneNumber:
+		return decodeEntity[UserPrivacySettingShowPhoneNumber](decoder)
+
+	case ConstructorUserPrivacySettingShowBio:
+		return decodeEntity[UserPrivacySettingShowBio](decoder)
+
+	case ConstructorUserPrivacySettingShowBirthdate:
+		return decodeEntity[UserPrivacySettingShowBirthdate](decoder)
+
+	case ConstructorUserPrivacySettingAllowChatInvites:
+		return decodeEntity[UserPrivacySettingAllowChatInvites](decoder)
+
+	case ConstructorUserPrivacySettingAllowCalls:
+		return decodeEntity[UserPrivacySettingAllowCalls](decoder)
+
+	case ConstructorUserPrivacySettingAllowPeerToPeerCalls:
+		return decodeEntity[UserPrivacySettingAllowPeerToPeerCalls](decoder)
+
+	case ConstructorUserPrivacySettingAllowFindingByPhoneNumber:
+		return decodeEntity[UserPrivacySettingAllowFindingByPhoneNumber](decoder)
+
+	case ConstructorUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages:
+		return decodeEntity[UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages](decoder)
+
+	case ConstructorUserPrivacySettingAutosaveGifts:
+		return decodeEntity[UserPrivacySettingAutosaveGifts](decoder)
+
+	case ConstructorUserPrivacySettingAllowUnpaidMessages:
+		return decodeEntity[UserPrivacySettingAllowUnpaidMessages](decoder)
+
+	case ConstructorReadDatePrivacySettings:
+		return decodeEntity[ReadDatePrivacySettings](decoder)
+
+	case ConstructorNewChatPrivacySettings:
+		return decodeEntity[NewChatPrivacySettings](decoder)
+
+	case ConstructorCanSendMessageToUserResultOk:
+		return decodeEntity[CanSendMessageToUserResultOk](decoder)
+
+	case ConstructorCanSendMessageToUserResultUserHasPaidMessages:
+		return decodeEntity[CanSendMessageToUserResultUserHasPaidMessages](decoder)
+
+	case ConstructorCanSendMessageToUserResultUserIsDeleted:
+		return decodeEntity[CanSendMessageToUserResultUserIsDeleted](decoder)
+
+	case ConstructorCanSendMessageToUserResultUserRestrictsNewChats:
+		return decodeEntity[CanSendMessageToUserResultUserRestrictsNewChats](decoder)
+
+	case ConstructorAccountTtl:
+		return decodeEntity[AccountTtl](decoder)
+
+	case ConstructorMessageAutoDeleteTime:
+		return decodeEntity[MessageAutoDeleteTime](decoder)
+
+	case ConstructorSessionTypeAndroid:
+		return decodeEntity[SessionTypeAndroid](decoder)
+
+	case ConstructorSessionTypeApple:
+		return decodeEntity[SessionTypeApple](decoder)
+
+	case ConstructorSessionTypeBrave:
+		return decodeEntity[SessionTypeBrave](decoder)
+
+	case ConstructorSessionTypeChrome:
+		return decodeEntity[SessionTypeChrome](decoder)
+
+	case ConstructorSessionTypeEdge:
+		return decodeEntity[SessionTypeEdge](decoder)
+
+	case ConstructorSessionTypeFirefox:
+		return decodeEntity[SessionTypeFirefox](decoder)
+
+	case ConstructorSessionTypeIpad:
+		return decodeEntity[SessionTypeIpad](decoder)
+
+	case ConstructorSessionTypeIphone:
+		return decodeEntity[SessionTypeIphone](decoder)
+
+	case ConstructorSessionTypeLinux:
+		return decodeEntity[SessionTypeLinux](decoder)
+
+	case ConstructorSessionTypeMac:
+		return decodeEntity[SessionTypeMac](decoder)
+
+	case ConstructorSessionTypeOpera:
+		return decodeEntity[SessionTypeOpera](decoder)
+
+	case ConstructorSessionTypeSafari:
+		return decodeEntity[SessionTypeSafari](decoder)
+
+	case ConstructorSessionTypeUbuntu:
+		return decodeEntity[SessionTypeUbuntu](decoder)
+
+	case ConstructorSessionTypeUnknown:
+		return decodeEntity[SessionTypeUnknown](decoder)
+
+	case ConstructorSessionTypeVivaldi:
+		return decodeEntity[SessionTypeVivaldi](decoder)
+
+	case ConstructorSessionTypeWindows:
+		return decodeEntity[SessionTypeWindows](decoder)
+
+	case ConstructorSessionTypeXbox:
+		return decodeEntity[SessionTypeXbox](decoder)
+
+	case ConstructorSession:
+		return decodeEntity[Session](decoder)
+
+	case ConstructorSessions:
+		return decodeEntity[Sessions](decoder)
+
+	case ConstructorUnconfirmedSession:
+		return decodeEntity[UnconfirmedSession](decoder)
+
+	case ConstructorConnectedWebsite:
+		return decodeEntity[ConnectedWebsite](decoder)
+
+	case ConstructorConnectedWebsites:
+		return decodeEntity[ConnectedWebsites](decoder)
+
+	case ConstructorReportReasonSpam:
+		return decodeEntity[ReportReasonSpam](decoder)
+
+	case ConstructorReportReasonViolence:
+		return decodeEntity[ReportReasonViolence](decoder)
+
+	case ConstructorReportReasonPornography:
+		return decodeEntity[ReportReasonPornography](decoder)
+
+	case ConstructorReportReasonChildAbuse:
+		return decodeEntity[ReportReasonChildAbuse](decoder)
+
+	case ConstructorReportReasonCopyright:
+		return decodeEntity[ReportReasonCopyright](decoder)
+
+	case ConstructorReportReasonUnrelatedLocation:
+		return decodeEntity[ReportReasonUnrelatedLocation](decoder)
+
+	case ConstructorReportReasonFake:
+		return decodeEntity[ReportReasonFake](decoder)
+
+	case ConstructorReportReasonIllegalDrugs:
+		return decodeEntity[ReportReasonIllegalDrugs](decoder)
+
+	case ConstructorReportReasonPersonalDetails:
+		return decodeEntity[ReportReasonPersonalDetails](decoder)
+
+	case ConstructorReportReasonCustom:
+		return decodeEntity[ReportReasonCustom](decoder)
+
+	case ConstructorReportChatResultOk:
+		return decodeEntity[ReportChatResultOk](decoder)
+
+	case ConstructorReportChatResultOptionRequired:
+		return decodeEntity[ReportChatResultOptionRequired](decoder)
+
+	case ConstructorReportChatResultTextRequired:
+		return decodeEntity[ReportChatResultTextRequired](decoder)
+
+	case ConstructorReportChatResultMessagesRequired:
+		return decodeEntity[ReportChatResultMessagesRequired](decoder)
+
+	case ConstructorReportStoryResultOk:
+		return decodeEntity[ReportStoryResultOk](decoder)
+
+	case ConstructorReportStoryResultOptionRequired:
+		return decodeEntity[ReportStoryResultOptionRequired](decoder)
+
+	case ConstructorReportStoryResultTextRequired:
+		return decodeEntity[ReportStoryResultTextRequired](decoder)
+
+	case ConstructorInternalLinkTypeActiveSessions:
+		return decodeEntity[InternalLinkTypeActiveSessions](decoder)
+
+	case ConstructorInternalLinkTypeAttachmentMenuBot:
+		return decodeEntity[InternalLinkTypeAttachmentMenuBot](decoder)
+
+	case ConstructorInternalLinkTypeAuthenticationCode:
+		return decodeEntity[InternalLinkTypeAuthenticationCode](decoder)
+
+	case ConstructorInternalLinkTypeBackground:
+		return decodeEntity[InternalLinkTypeBackground](decoder)
+
+	case ConstructorInternalLinkTypeBotAddToChannel:
+		return decodeEntity[InternalLinkTypeBotAddToChannel](decoder)
+
+	case ConstructorInternalLinkTypeBotStart:
+		return decodeEntity[InternalLinkTypeBotStart](decoder)
+
+	case ConstructorInternalLinkTypeBotStartInGroup:
+		return decodeEntity[InternalLinkTypeBotStartInGroup](decoder)
+
+	case ConstructorInternalLinkTypeBusinessChat:
+		return decodeEntity[InternalLinkTypeBusinessChat](decoder)
+
+	case ConstructorInternalLinkTypeBuyStars:
+		return decodeEntity[InternalLinkTypeBuyStars](decoder)
+
+	case ConstructorInternalLinkTypeChangePhoneNumber:
+		return decodeEntity[InternalLinkTypeChangePhoneNumber](decoder)
+
+	case ConstructorInternalLinkTypeChatAffiliateProgram:
+		return decodeEntity[InternalLinkTypeChatAffiliateProgram](decoder)
+
+	case ConstructorInternalLinkTypeChatBoost:
+		return decodeEntity[InternalLinkTypeChatBoost](decoder)
+
+	case ConstructorInternalLinkTypeChatFolderInvite:
+		return decodeEntity[InternalLinkTypeChatFolderInvite](decoder)
+
+	case ConstructorInternalLinkTypeChatFolderSettings:
+		return decodeEntity[InternalLinkTypeChatFolderSettings](decoder)
+
+	case ConstructorInternalLinkTypeChatInvite:
+		return decodeEntity[InternalLinkTypeChatInvite](decoder)
+
+	case ConstructorInternalLinkTypeDefaultMessageAutoDeleteTimerSettings:
+		return decodeEntity[InternalLinkTypeDefaultMessageAutoDeleteTimerSettings](decoder)
+
+	case ConstructorInternalLinkTypeEditProfileSettings:
+		return decodeEntity[InternalLinkTypeEditProfileSettings](decoder)
+
+	case ConstructorInternalLinkTypeGame:
+		return decodeEntity[InternalLinkTypeGame](decoder)
+
+	case ConstructorInternalLinkTypeInstantView:
+		return decodeEntity[InternalLinkTypeInstantView](decoder)
+
+	case ConstructorInternalLinkTypeInvoice:
+		return decodeEntity[InternalLinkTypeInvoice](decoder)
+
+	case ConstructorInternalLinkTypeLanguagePack:
+		return decodeEntity[InternalLinkTypeLanguagePack](decoder)
+
+	case ConstructorInternalLinkTypeLanguageSettings:
+		return decodeEntity[InternalLinkTypeLanguageSettings](decoder)
+
+	case ConstructorInternalLinkTypeMainWebApp:
+		return decodeEntity[InternalLinkTypeMainWebApp](decoder)
+
+	case ConstructorInternalLinkTypeMessage:
+		return decodeEntity[InternalLinkTypeMessage](decoder)
+
+	case ConstructorInternalLinkTypeMessageDraft:
+		return decodeEntity[InternalLinkTypeMessageDraft](decoder)
+
+	case ConstructorInternalLinkTypePassportDataRequest:
+		return decodeEntity[InternalLinkTypePassportDataRequest](decoder)
+
+	case ConstructorInternalLinkTypePhoneNumberConfirmation:
+		return decodeEntity[InternalLinkTypePhoneNumberConfirmation](decoder)
+
+	case ConstructorInternalLinkTypePremiumFeatures:
+		return decodeEntity[InternalLinkTypePremiumFeatures](decoder)
+
+	case ConstructorInternalLinkTypePremiumGift:
+		return decodeEntity[InternalLinkTypePremiumGift](decoder)
+
+	case ConstructorInternalLinkTypePremiumGiftCode:
+		return decodeEntity[InternalLinkTypePremiumGiftCode](decoder)
+
+	case ConstructorInternalLinkTypePrivacyAndSecuritySettings:
+		return decodeEntity[InternalLinkTypePrivacyAndSecuritySettings](decoder)
+
+	case ConstructorInternalLinkTypeProxy:
+		return decodeEntity[InternalLinkTypeProxy](decoder)
+
+	case ConstructorInternalLinkTypePublicChat:
+		return decodeEntity[InternalLinkTypePublicChat](decoder)
+
+	case ConstructorInternalLinkTypeQrCodeAuthentication:
+		return decodeEntity[InternalLinkTypeQrCodeAuthentication](decoder)
+
+	case ConstructorInternalLinkTypeRestorePurchases:
+		return decodeEntity[InternalLinkTypeRestorePurchases](decoder)
+
+	case ConstructorInternalLinkTypeSettings:
+		return decodeEntity[InternalLinkTypeSettings](decoder)
+
+	case ConstructorInternalLinkTypeStickerSet:
+		return decodeEntity[InternalLinkTypeStickerSet](decoder)
+
+	case ConstructorInternalLinkTypeStory:
+		return decodeEntity[InternalLinkTypeStory](decoder)
+
+	case ConstructorInternalLinkTypeTheme:
+		return decodeEntity[InternalLinkTypeTheme](decoder)
+
+	case ConstructorInternalLinkTypeThemeSettings:
+		return decodeEntity[InternalLinkTypeThemeSettings](decoder)
+
+	case ConstructorInternalLinkTypeUnknownDeepLink:
+		return decodeEntity[InternalLinkTypeUnknownDeepLink](decoder)
+
+	case ConstructorInternalLinkTypeUnsupportedProxy:
+		return decodeEntity[InternalLinkTypeUnsupportedProxy](decoder)
+
+	case ConstructorInternalLinkTypeUpgradedGift:
+		return decodeEntity[InternalLinkTypeUpgradedGift](decoder)
+
+	case ConstructorInternalLinkTypeUserPhoneNumber:
+		return decodeEntity[InternalLinkTypeUserPhoneNumber](decoder)
+
+	case ConstructorInternalLinkTypeUserToken:
+		return decodeEntity[InternalLinkTypeUserToken](decoder)
+
+	case ConstructorInternalLinkTypeVideoChat:
+		return decodeEntity[InternalLinkTypeVideoChat](decoder)
+
+	case ConstructorInternalLinkTypeWebApp:
+		return decodeEntity[InternalLinkTypeWebApp](decoder)
+
+	case ConstructorMessageLink:
+		return decodeEntity[MessageLink](decoder)
+
+	case ConstructorMessageLinkInfo:
+		return decodeEntity[MessageLinkInfo](decoder)
+
+	case ConstructorChatBoostLink:
+		return decodeEntity[ChatBoostLink](decoder)
+
+	case ConstructorChatBoostLinkInfo:
+		return decodeEntity[ChatBoostLinkInfo](decoder)
+
+	case ConstructorBlockListMain:
+		return decodeEntity[BlockListMain](decoder)
+
+	case ConstructorBlockListStories:
+		return decodeEntity[BlockListStories](decoder)
+
+	case ConstructorFilePart:
+		return decodeEntity[FilePart](decoder)
+
+	case ConstructorFileTypeNone:
+		return decodeEntity[FileTypeNone](decoder)
+
+	case ConstructorFileTypeAnimation:
+		return decodeEntity[FileTypeAnimation](decoder)
+
+	case ConstructorFileTypeAudio:
+		return decodeEntity[FileTypeAudio](decoder)
+
+	case ConstructorFileTypeDocument:
+		return decodeEntity[FileTypeDocument](decoder)
+
+	case ConstructorFileTypeNotificationSound:
+		return decodeEntity[FileTypeNotificationSound](decoder)
+
+	case ConstructorFileTypePhoto:
+		return decodeEntity[FileTypePhoto](decoder)
+
+	case ConstructorFileTypePhotoStory:
+		return decodeEntity[FileTypePhotoStory](decoder)
+
+	case ConstructorFileTypeProfilePhoto:
+		return decodeEntity[FileTypeProfilePhoto](decoder)
+
+	case ConstructorFileTypeSecret:
+		return decodeEntity[FileTypeSecret](decoder)
+
+	case ConstructorFileTypeSecretThumbnail:
+		return decodeEntity[FileTypeSecretThumbnail](decoder)
+
+	case ConstructorFileTypeSecure:
+		return decodeEntity[FileTypeSecure](decoder)
+
+	case ConstructorFileTypeSelfDestructingPhoto:
+		return decodeEntity[FileTypeSelfDestructingPhoto](decoder)
+
+	case ConstructorFileTypeSelfDestructingVideo:
+		return decodeEntity[FileTypeSelfDestructingVideo](decoder)
+
+	case ConstructorFileTypeSelfDestructingVideoNote:
+		return decodeEntity[FileTypeSelfDestructingVideoNote](decoder)
+
+	case ConstructorFileTypeSelfDestructingVoiceNote:
+		return decodeEntity[FileTypeSelfDestructingVoiceNote](decoder)
+
+	case ConstructorFileTypeSticker:
+		return decodeEntity[FileTypeSticker](decoder)
+
+	case ConstructorFileTypeThumbnail:
+		return decodeEntity[FileTypeThumbnail](decoder)
+
+	case ConstructorFileTypeUnknown:
+		return decodeEntity[FileTypeUnknown](decoder)
+
+	case ConstructorFileTypeVideo:
+		return decodeEntity[FileTypeVideo](decoder)
+
+	case ConstructorFileTypeVideoNote:
+		return decodeEntity[FileTypeVideoNote](decoder)
+
+	case ConstructorFileTypeVideoStory:
+		return decodeEntity[FileTypeVideoStory](decoder)
+
+	case ConstructorFileTypeVoiceNote:
+		return decodeEntity[FileTypeVoiceNote](decoder)
+
+	case ConstructorFileTypeWallpaper:
+		return decodeEntity[FileTypeWallpaper](decoder)
+
+	case ConstructorStorageStatisticsByFileType:
+		return decodeEntity[StorageStatisticsByFileType](decoder)
+
+	case ConstructorStorageStatisticsByChat:
+		return decodeEntity[StorageStatisticsByChat](decoder)
+
+	case ConstructorStorageStatistics:
+		return decodeEntity[StorageStatistics](decoder)
+
+	case ConstructorStorageStatisticsFast:
+		return decodeEntity[StorageStatisticsFast](decoder)
+
+	case ConstructorDatabaseStatistics:
+		return decodeEntity[DatabaseStatistics](decoder)
+
+	case ConstructorNetworkTypeNone:
+		return decodeEntity[NetworkTypeNone](decoder)
+
+	case ConstructorNetworkTypeMobile:
+		return decodeEntity[NetworkTypeMobile](decoder)
+
+	case ConstructorNetworkTypeMobileRoaming:
+		return decodeEntity[NetworkTypeMobileRoaming](decoder)
+
+	case ConstructorNetworkTypeWiFi:
+		return decodeEntity[NetworkTypeWiFi](decoder)
+
+	case ConstructorNetworkTypeOther:
+		return decodeEntity[NetworkTypeOther](decoder)
+
+	case ConstructorNetworkStatisticsEntryFile:
+		return decodeEntity[NetworkStatisticsEntryFile](decoder)
+
+	case ConstructorNetworkStatisticsEntryCall:
+		return decodeEntity[NetworkStatisticsEntryCall](decoder)
+
+	case ConstructorNetworkStatistics:
+		return decodeEntity[NetworkStatistics](decoder)
+
+	case ConstructorAutoDownloadSettings:
+		return decodeEntity[AutoDownloadSettings](decoder)
+
+	case ConstructorAutoDownloadSettingsPresets:
+		return decodeEntity[AutoDownloadSettingsPresets](decoder)
+
+	case ConstructorAutosaveSettingsScopePrivateChats:
+		return decodeEntity[AutosaveSettingsScopePrivateChats](decoder)
+
+	case ConstructorAutosaveSettingsScopeGroupChats:
+		return decodeEntity[AutosaveSettingsScopeGroupChats](decoder)
+
+	case ConstructorAutosaveSettingsScopeChannelChats:
+		return decodeEntity[AutosaveSettingsScopeChannelChats](decoder)
+
+	case ConstructorAutosaveSettingsScopeChat:
+		return decodeEntity[AutosaveSettingsScopeChat](decoder)
+
+	case ConstructorScopeAutosaveSettings:
+		return decodeEntity[ScopeAutosaveSettings](decoder)
+
+	case ConstructorAutosaveSettingsException:
+		return decodeEntity[AutosaveSettingsException](decoder)
+
+	case ConstructorAutosaveSettings:
+		return decodeEntity[AutosaveSettings](decoder)
+
+	case ConstructorConnectionStateWaitingForNetwork:
+		return decodeEntity[ConnectionStateWaitingForNetwork](decoder)
+
+	case ConstructorConnectionStateConnectingToProxy:
+		return decodeEntity[ConnectionStateConnectingToProxy](decoder)
+
+	case ConstructorConnectionStateConnecting:
+		return decodeEntity[ConnectionStateConnecting](decoder)
+
+	case ConstructorConnectionStateUpdating:
+		return decodeEntity[ConnectionStateUpdating](decoder)
+
+	case ConstructorConnectionStateReady:
+		return decodeEntity[ConnectionStateReady](decoder)
+
+	case ConstructorTopChatCategoryUsers:
+		return decodeEntity[TopChatCategoryUsers](decoder)
+
+	case ConstructorTopChatCategoryBots:
+		return decodeEntity[TopChatCategoryBots](decoder)
+
+	case ConstructorTopChatCategoryGroups:
+		return decodeEntity[TopChatCategoryGroups](decoder)
+
+	case ConstructorTopChatCategoryChannels:
+		return decodeEntity[TopChatCategoryChannels](decoder)
+
+	case ConstructorTopChatCategoryInlineBots:
+		return decodeEntity[TopChatCategoryInlineBots](decoder)
+
+	case ConstructorTopChatCategoryWebAppBots:
+		return decodeEntity[TopChatCategoryWebAppBots](decoder)
+
+	case ConstructorTopChatCategoryCalls:
+		return decodeEntity[TopChatCategoryCalls](decoder)
+
+	case ConstructorTopChatCategoryForwardChats:
+		return decodeEntity[TopChatCategoryForwardChats](decoder)
+
+	case ConstructorFoundPosition:
+		return decodeEntity[FoundPosition](decoder)
+
+	case ConstructorFoundPositions:
+		return decodeEntity[FoundPositions](decoder)
+
+	case ConstructorTMeUrlTypeUser:
+		return decodeEntity[TMeUrlTypeUser](decoder)
+
+	case ConstructorTMeUrlTypeSupergroup:
+		return decodeEntity[TMeUrlTypeSupergroup](decoder)
+
+	case ConstructorTMeUrlTypeChatInvite:
+		return decodeEntity[TMeUrlTypeChatInvite](decoder)
+
+	case ConstructorTMeUrlTypeStickerSet:
+		return decodeEntity[TMeUrlTypeStickerSet](decoder)
+
+	case ConstructorTMeUrl:
+		return decodeEntity[TMeUrl](decoder)
+
+	case ConstructorTMeUrls:
+		return decodeEntity[TMeUrls](decoder)
+
+	case ConstructorSuggestedActionEnableArchiveAndMuteNewChats:
+		return decodeEntity[SuggestedActionEnableArchiveAndMuteNewChats](decoder)
+
+	case ConstructorSuggestedActionCheckPassword:
+		return decodeEntity[SuggestedActionCheckPassword](decoder)
+
+	case ConstructorSuggestedActionCheckPhoneNumber:
+		return decodeEntity[SuggestedActionCheckPhoneNumber](decoder)
+
+	case ConstructorSuggestedActionViewChecksHint:
+		return decodeEntity[SuggestedActionViewChecksHint](decoder)
+
+	case ConstructorSuggestedActionConvertToBroadcastGroup:
+		return decodeEntity[SuggestedActionConvertToBroadcastGroup](decoder)
+
+	case ConstructorSuggestedActionSetPassword:
+		return decodeEntity[SuggestedActionSetPassword](decoder)
+
+	case ConstructorSuggestedActionUpgradePremium:
+		return decodeEntity[SuggestedActionUpgradePremium](decoder)
+
+	case ConstructorSuggestedActionRestorePremium:
+		return decodeEntity[SuggestedActionRestorePremium](decoder)
+
+	case ConstructorSuggestedActionSubscribeToAnnualPremium:
+		return decodeEntity[SuggestedActionSubscribeToAnnualPremium](decoder)
+
+	case ConstructorSuggestedActionGiftPremiumForChristmas:
+		return decodeEntity[SuggestedActionGiftPremiumForChristmas](decoder)
+
+	case ConstructorSuggestedActionSetBirthdate:
+		return decodeEntity[SuggestedActionSetBirthdate](decoder)
+
+	case ConstructorSuggestedActionSetProfilePhoto:
+		return decodeEntity[SuggestedActionSetProfilePhoto](decoder)
+
+	case ConstructorSuggestedActionExtendPremium:
+		return decodeEntity[SuggestedActionExtendPremium](decoder)
+
+	case ConstructorSuggestedActionExtendStarSubscriptions:
+		return decodeEntity[SuggestedActionExtendStarSubscriptions](decoder)
+
+	case ConstructorCount:
+		return decodeEntity[Count](decoder)
+
+	case ConstructorText:
+		return decodeEntity[Text](decoder)
+
+	case ConstructorSeconds:
+		return decodeEntity[Seconds](decoder)
+
+	case ConstructorFileDownloadedPrefixSize:
+		return decodeEntity[FileDownloadedPrefixSize](decoder)
+
+	case ConstructorStarCount:
+		return decodeEntity[StarCount](decoder)
+
+	case ConstructorDeepLinkInfo:
+		return decodeEntity[DeepLinkInfo](decoder)
+
+	case ConstructorTextParseModeMarkdown:
+		return decodeEntity[TextParseModeMarkdown](decoder)
+
+	case ConstructorTextParseModeHTML:
+		return decodeEntity[TextParseModeHTML](decoder)
+
+	case ConstructorProxyTypeSocks5:
+		return decodeEntity[ProxyTypeSocks5](decoder)
+
+	case ConstructorProxyTypeHttp:
+		return decodeEntity[ProxyTypeHttp](decoder)
+
+	case ConstructorProxyTypeMtproto:
+		return decodeEntity[ProxyTypeMtproto](decoder)
+
+	case ConstructorProxy:
+		return decodeEntity[Proxy](decoder)
+
+	case ConstructorProxies:
+		return decodeEntity[Proxies](decoder)
+
+	case ConstructorInputSticker:
+		return decodeEntity[InputSticker](decoder)
+
+	case ConstructorDateRange:
+		return decodeEntity[DateRange](decoder)
+
+	case ConstructorStatisticalValue:
+		return decodeEntity[StatisticalValue](decoder)
+
+	case ConstructorStatisticalGraphData:
+		return decodeEntity[StatisticalGraphData](decoder)
+
+	case ConstructorStatisticalGraphAsync:
+		return decodeEntity[StatisticalGraphAsync](decoder)
+
+	case ConstructorStatisticalGraphError:
+		return decodeEntity[StatisticalGraphError](decoder)
+
+	case ConstructorChatStatisticsObjectTypeMessage:
+		return decodeEntity[ChatStatisticsObjectTypeMessage](decoder)
+
+	case ConstructorChatStatisticsObjectTypeStory:
+		return decodeEntity[ChatStatisticsObjectTypeStory](decoder)
+
+	case ConstructorChatStatisticsInteractionInfo:
+		return decodeEntity[ChatStatisticsInteractionInfo](decoder)
+
+	case ConstructorChatStatisticsMessageSenderInfo:
+		return decodeEntity[ChatStatisticsMessageSenderInfo](decoder)
+
+	case ConstructorChatStatisticsAdministratorActionsInfo:
+		return decodeEntity[ChatStatisticsAdministratorActionsInfo](decoder)
+
+	case ConstructorChatStatisticsInviterInfo:
+		return decodeEntity[ChatStatisticsInviterInfo](decoder)
+
+	case ConstructorChatStatisticsSupergroup:
+		return decodeEntity[ChatStatisticsSupergroup](decoder)
+
+	case ConstructorChatStatisticsChannel:
+		return decodeEntity[ChatStatisticsChannel](decoder)
+
+	case ConstructorChatRevenueAmount:
+		return decodeEntity[ChatRevenueAmount](decoder)
+
+	case ConstructorChatRevenueStatistics:
+		return decodeEntity[ChatRevenueStatistics](decoder)
+
+	case ConstructorMessageStatistics:
+		return decodeEntity[MessageStatistics](decoder)
+
+	case ConstructorStoryStatistics:
+		return decodeEntity[StoryStatistics](decoder)
+
+	case ConstructorRevenueWithdrawalStatePending:
+		return decodeEntity[RevenueWithdrawalStatePending](decoder)
+
+	case ConstructorRevenueWithdrawalStateSucceeded:
+		return decodeEntity[RevenueWithdrawalStateSucceeded](decoder)
+
+	case ConstructorRevenueWithdrawalStateFailed:
+		return decodeEntity[RevenueWithdrawalStateFailed](decoder)
+
+	case ConstructorChatRevenueTransactionTypeEarnings:
+		return decodeEntity[ChatRevenueTransactionTypeEarnings](decoder)
+
+	case ConstructorChatRevenueTransactionTypeWithdrawal:
+		return decodeEntity[ChatRevenueTransactionTypeWithdrawal](decoder)
+
+	case ConstructorChatRevenueTransactionTypeRefund:
+		return decodeEntity[ChatRevenueTransactionTypeRefund](decoder)
+
+	case ConstructorChatRevenueTransaction:
+		return decodeEntity[ChatRevenueTransaction](decoder)
+
+	case ConstructorChatRevenueTransactions:
+		return decodeEntity[ChatRevenueTransactions](decoder)
+
+	case ConstructorStarRevenueStatus:
+		return decodeEntity[StarRevenueStatus](decoder)
+
+	case ConstructorStarRevenueStatistics:
+		return decodeEntity[StarRevenueStatistics](decoder)
+
+	case ConstructorPoint:
+		return decodeEntity[Point](decoder)
+
+	case ConstructorVectorPathCommandLine:
+		return decodeEntity[VectorPathCommandLine](decoder)
+
+	case ConstructorVectorPathCommandCubicBezierCurve:
+		return decodeEntity[VectorPathCommandCubicBezierCurve](decoder)
+
+	case ConstructorBotCommandScopeDefault:
+		return decodeEntity[BotCommandScopeDefault](decoder)
+
+	case ConstructorBotCommandScopeAllPrivateChats:
+		return decodeEntity[BotCommandScopeAllPrivateChats](decoder)
+
+	case ConstructorBotCommandScopeAllGroupChats:
+		return decodeEntity[BotCommandScopeAllGroupChats](decoder)
+
+	case ConstructorBotCommandScopeAllChatAdministrators:
+		return decodeEntity[BotCommandScopeAllChatAdministrators](decoder)
+
+	case ConstructorBotCommandScopeChat:
+		return decodeEntity[BotCommandScopeChat](decoder)
+
+	case ConstructorBotCommandScopeChatAdministrators:
+		return decodeEntity[BotCommandScopeChatAdministrators](decoder)
+
+	case ConstructorBotCommandScopeChatMember:
+		return decodeEntity[BotCommandScopeChatMember](decoder)
+
+	case ConstructorPhoneNumberCodeTypeChange:
+		return decodeEntity[PhoneNumberCodeTypeChange](decoder)
+
+	case ConstructorPhoneNumberCodeTypeVerify:
+		return decodeEntity[PhoneNumberCodeTypeVerify](decoder)
+
+	case ConstructorPhoneNumberCodeTypeConfirmOwnership:
+		return decodeEntity[PhoneNumberCodeTypeConfirmOwnership](decoder)
+
+	case ConstructorUpdateAuthorizationState:
+		return decodeEntity[UpdateAuthorizationState](decoder)
+
+	case ConstructorUpdateNewMessage:
+		return decodeEntity[UpdateNewMessage](decoder)
+
+	case ConstructorUpdateMessageSendAcknowledged:
+		return decodeEntity[UpdateMessageSendAcknowledged](decoder)
+
+	case ConstructorUpdateMessageSendSucceeded:
+		return decodeEntity[UpdateMessageSendSucceeded](decoder)
+
+	case ConstructorUpdateMessageSendFailed:
+		return decodeEntity[UpdateMessageSendFailed](decoder)
+
+	case ConstructorUpdateMessageContent:
+		return decodeEntity[UpdateMessageContent](decoder)
+
+	case ConstructorUpdateMessageEdited:
+		return decodeEntity[UpdateMessageEdited](decoder)
+
+	case ConstructorUpdateMessageIsPinned:
+		return decodeEntity[UpdateMessageIsPinned](decoder)
+
+	case ConstructorUpdateMessageInteractionInfo:
+		return decodeEntity[UpdateMessageInteractionInfo](decoder)
+
+	case ConstructorUpdateMessageContentOpened:
+		return decodeEntity[UpdateMessageContentOpened](decoder)
+
+	case ConstructorUpdateMessageMentionRead:
+		return decodeEntity[UpdateMessageMentionRead](decoder)
+
+	case ConstructorUpdateMessageUnreadReactions:
+		return decodeEntity[UpdateMessageUnreadReactions](decoder)
+
+	case ConstructorUpdateMessageFactCheck:
+		return decodeEntity[UpdateMessageFactCheck](decoder)
+
+	case ConstructorUpdateMessageLiveLocationViewed:
+		return decodeEntity[UpdateMessageLiveLocationViewed](decoder)
+
+	case ConstructorUpdateVideoPublished:
+		return decodeEntity[UpdateVideoPublished](decoder)
+
+	case ConstructorUpdateNewChat:
+		return decodeEntity[UpdateNewChat](decoder)
+
+	case ConstructorUpdateChatTitle:
+		return decodeEntity[UpdateChatTitle](decoder)
+
+	case ConstructorUpdateChatPhoto:
+		return decodeEntity[UpdateChatPhoto](decoder)
+
+	case ConstructorUpdateChatAccentColors:
+		return decodeEntity[UpdateChatAccentColors](decoder)
+
+	case ConstructorUpdateChatPermissions:
+		return decodeEntity[UpdateChatPermissions](decoder)
+
+	case ConstructorUpdateChatLastMessage:
+		return decodeEntity[UpdateChatLastMessage](decoder)
+
+	case ConstructorUpdateChatPosition:
+		return decodeEntity[UpdateChatPosition](decoder)
+
+	case ConstructorUpdateChatAddedToList:
+		return decodeEntity[UpdateChatAddedToList](decoder)
+
+	case ConstructorUpdateChatRemovedFromList:
+		return decodeEntity[UpdateChatRemovedFromList](decoder)
+
+	case ConstructorUpdateChatReadInbox:
+		return decodeEntity[UpdateChatReadInbox](decoder)
+
+	case ConstructorUpdateChatReadOutbox:
+		return decodeEntity[UpdateChatReadOutbox](decoder)
+
+	case ConstructorUpdateChatActionBar:
+		return decodeEntity[UpdateChatActionBar](decoder)
+
+	case ConstructorUpdateChatBusinessBotManageBar:
+		return decodeEntity[UpdateChatBusinessBotManageBar](decoder)
+
+	case ConstructorUpdateChatAvailableReactions:
+		return decodeEntity[UpdateChatAvailableReactions](decoder)
+
+	case ConstructorUpdateChatDraftMessage:
+		return decodeEntity[UpdateChatDraftMessage](decoder)
+
+	case ConstructorUpdateChatEmojiStatus:
+		return decodeEntity[UpdateChatEmojiStatus](decoder)
+
+	case ConstructorUpdateChatMessageSender:
+		return decodeEntity[UpdateChatMessageSender](decoder)
+
+	case ConstructorUpdateChatMessageAutoDeleteTime:
+		return decodeEntity[UpdateChatMessageAutoDeleteTime](decoder)
+
+	case ConstructorUpdateChatNotificationSettings:
+		return decodeEntity[UpdateChatNotificationSettings](decoder)
+
+	case ConstructorUpdateChatPendingJoinRequests:
+		return decodeEntity[UpdateChatPendingJoinRequests](decoder)
+
+	case ConstructorUpdateChatReplyMarkup:
+		return decodeEntity[UpdateChatReplyMarkup](decoder)
+
+	case ConstructorUpdateChatBackground:
+		return decodeEntity[UpdateChatBackground](decoder)
+
+	case ConstructorUpdateChatTheme:
+		return decodeEntity[UpdateChatTheme](decoder)
+
+	case ConstructorUpdateChatUnreadMentionCount:
+		return decodeEntity[UpdateChatUnreadMentionCount](decoder)
+
+	case ConstructorUpdateChatUnreadReactionCount:
+		return decodeEntity[UpdateChatUnreadReactionCount](decoder)
+
+	case ConstructorUpdateChatVideoChat:
+		return decodeEntity[UpdateChatVideoChat](decoder)
+
+	case ConstructorUpdateChatDefaultDisableNotification:
+		return decodeEntity[UpdateChatDefaultDisableNotification](decoder)
+
+	case ConstructorUpdateChatHasProtectedContent:
+		return decodeEntity[UpdateChatHasProtectedContent](decoder)
+
+	case ConstructorUpdateChatIsTranslatable:
+		return decodeEntity[UpdateChatIsTranslatable](decoder)
+
+	case ConstructorUpdateChatIsMarkedAsUnread:
+		return decodeEntity[UpdateChatIsMarkedAsUnread](decoder)
+
+	case ConstructorUpdateChatViewAsTopics:
+		return decodeEntity[UpdateChatViewAsTopics](decoder)
+
+	case ConstructorUpdateChatBlockList:
+		return decodeEntity[UpdateChatBlockList](decoder)
+
+	case ConstructorUpdateChatHasScheduledMessages:
+		return decodeEntity[UpdateChatHasScheduledMessages](decoder)
+
+	case ConstructorUpdateChatFolders:
+		return decodeEntity[UpdateChatFolders](decoder)
+
+	case ConstructorUpdateChatOnlineMemberCount:
+		return decodeEntity[UpdateChatOnlineMemberCount](decoder)
+
+	case ConstructorUpdateSavedMessagesTopic:
+		return decodeEntity[UpdateSavedMessagesTopic](decoder)
+
+	case ConstructorUpdateSavedMessagesTopicCount:
+		return decodeEntity[UpdateSavedMessagesTopicCount](decoder)
+
+	case ConstructorUpdateQuickReplyShortcut:
+		return decodeEntity[UpdateQuickReplyShortcut](decoder)
+
+	case ConstructorUpdateQuickReplyShortcutDeleted:
+		return decodeEntity[UpdateQuickReplyShortcutDeleted](decoder)
+
+	case ConstructorUpdateQuickReplyShortcuts:
+		return decodeEntity[UpdateQuickReplyShortcuts](decoder)
+
+	case ConstructorUpdateQuickReplyShortcutMessages:
+		return decodeEntity[UpdateQuickReplyShortcutMessages](decoder)
+
+	case ConstructorUpdateForumTopicInfo:
+		return decodeEntity[UpdateForumTopicInfo](decoder)
+
+	case ConstructorUpdateForumTopic:
+		return decodeEntity[UpdateForumTopic](decoder)
+
+	case ConstructorUpdateScopeNotificationSettings:
+		return decodeEntity[UpdateScopeNotificationSettings](decoder)
+
+	case ConstructorUpdateReactionNotificationSettings:
+		return decodeEntity[UpdateReactionNotificationSettings](decoder)
+
+	case ConstructorUpdateNotification:
+		return decodeEntity[UpdateNotification](decoder)
+
+	case ConstructorUpdateNotificationGroup:
+		return decodeEntity[UpdateNotificationGroup](decoder)
+
+	case ConstructorUpdateActiveNotifications:
+		return decodeEntity[UpdateActiveNotifications](decoder)
+
+	case ConstructorUpdateHavePendingNotifications:
+		return decodeEntity[UpdateHavePendingNotifications](decoder)
+
+	case ConstructorUpdateDeleteMessages:
+		return decodeEntity[UpdateDeleteMessages](decoder)
+
+	case ConstructorUpdateChatAction:
+		return decodeEntity[UpdateChatAction](decoder)
+
+	case ConstructorUpdateUserStatus:
+		return decodeEntity[UpdateUserStatus](decoder)
+
+	case ConstructorUpdateUser:
+		return decodeEntity[UpdateUser](decoder)
+
+	case ConstructorUpdateBasicGroup:
+		return decodeEntity[UpdateBasicGroup](decoder)
+
+	case ConstructorUpdateSupergroup:
+		return decodeEntity[UpdateSupergroup](decoder)
+
+	case ConstructorUpdateSecretChat:
+		return decodeEntity[UpdateSecretChat](decoder)
+
+	case ConstructorUpdateUserFullInfo:
+		return decodeEntity[UpdateUserFullInfo](decoder)
+
+	case ConstructorUpdateBasicGroupFullInfo:
+		return decodeEntity[UpdateBasicGroupFullInfo](decoder)
+
+	case ConstructorUpdateSupergroupFullInfo:
+		return decodeEntity[UpdateSupergroupFullInfo](decoder)
+
+	case ConstructorUpdateServiceNotification:
+		return decodeEntity[UpdateServiceNotification](decoder)
+
+	case ConstructorUpdateFile:
+		return decodeEntity[UpdateFile](decoder)
+
+	case ConstructorUpdateFileGenerationStart:
+		return decodeEntity[UpdateFileGenerationStart](decoder)
+
+	case ConstructorUpdateFileGenerationStop:
+		return decodeEntity[UpdateFileGenerationStop](decoder)
+
+	case ConstructorUpdateFileDownloads:
+		return decodeEntity[UpdateFileDownloads](decoder)
+
+	case ConstructorUpdateFileAddedToDownloads:
+		return decodeEntity[UpdateFileAddedToDownloads](decoder)
+
+	case ConstructorUpdateFileDownload:
+		return decodeEntity[UpdateFileDownload](decoder)
+
+	case ConstructorUpdateFileRemovedFromDownloads:
+		return decodeEntity[UpdateFileRemovedFromDownloads](decoder)
+
+	case ConstructorUpdateApplicationVerificationRequired:
+		return decodeEntity[UpdateApplicationVerificationRequired](decoder)
+
+	case ConstructorUpdateApplicationRecaptchaVerificationRequired:
+		return decodeEntity[UpdateApplicationRecaptchaVerificationRequired](decoder)
+
+	case ConstructorUpdateCall:
+		return decodeEntity[UpdateCall](decoder)
+
+	case ConstructorUpdateGroupCall:
+		return decodeEntity[UpdateGroupCall](decoder)
+
+	case ConstructorUpdateGroupCallParticipant:
+		return decodeEntity[UpdateGroupCallParticipant](decoder)
+
+	case ConstructorUpdateNewCallSignalingData:
+		return decodeEntity[UpdateNewCallSignalingData](decoder)
+
+	case ConstructorUpdateUserPrivacySettingRules:
+		return decodeEntity[UpdateUserPrivacySettingRules](decoder)
+
+	case ConstructorUpdateUnreadMessageCount:
+		return decodeEntity[UpdateUnreadMessageCount](decoder)
+
+	case ConstructorUpdateUnreadChatCount:
+		return decodeEntity[UpdateUnreadChatCount](decoder)
+
+	case ConstructorUpdateStory:
+		return decodeEntity[UpdateStory](decoder)
+
+	case ConstructorUpdateStoryDeleted:
+		return decodeEntity[UpdateStoryDeleted](decoder)
+
+	case ConstructorUpdateStorySendSucceeded:
+		return decodeEntity[UpdateStorySendSucceeded](decoder)
+
+	case ConstructorUpdateStorySendFailed:
+		return decodeEntity[UpdateStorySendFailed](decoder)
+
+	case ConstructorUpdateChatActiveStories:
+		return decodeEntity[UpdateChatActiveStories](decoder)
+
+	case ConstructorUpdateStoryListChatCount:
+		return decodeEntity[UpdateStoryListChatCount](decoder)
+
+	case ConstructorUpdateStoryStealthMode:
+		return decodeEntity[UpdateStoryStealthMode](decoder)
+
+	case ConstructorUpdateOption:
+		return decodeEntity[UpdateOption](decoder)
+
+	case ConstructorUpdateStickerSet:
+		return decodeEntity[UpdateStickerSet](decoder)
+
+	case ConstructorUpdateInstalledStickerSets:
+		return decodeEntity[UpdateInstalledStickerSets](decoder)
+
+	case ConstructorUpdateTrendingStickerSets:
+		return decodeEntity[UpdateTrendingStickerSets](decoder)
+
+	case ConstructorUpdateRecentStickers:
+		return decodeEntity[UpdateRecentStickers](decoder)
+
+	case ConstructorUpdateFavoriteStickers:
+		return decodeEntity[UpdateFavoriteStickers](decoder)
+
+	case ConstructorUpdateSavedAnimations:
+		return decodeEntity[UpdateSavedAnimations](decoder)
+
+	case ConstructorUpdateSavedNotificationSounds:
+		return decodeEntity[UpdateSavedNotificationSounds](decoder)
+
+	case ConstructorUpdateDefaultBackground:
+		return decodeEntity[UpdateDefaultBackground](decoder)
+
+	case ConstructorUpdateChatThemes:
+		return decodeEntity[UpdateChatThemes](decoder)
+
+	case ConstructorUpdateAccentColors:
+		return decodeEntity[UpdateAccentColors](decoder)
+
+	case ConstructorUpdateProfileAccentColors:
+		return decodeEntity[UpdateProfileAccentColors](decoder)
+
+	case ConstructorUpdateLanguagePackStrings:
+		return decodeEntity[UpdateLanguagePackStrings](decoder)
+
+	case ConstructorUpdateConnectionState:
+		return decodeEntity[UpdateConnectionState](decoder)
+
+	case ConstructorUpdateFreezeState:
+		return decodeEntity[UpdateFreezeState](decoder)
+
+	case ConstructorUpdateTermsOfService:
+		return decodeEntity[UpdateTermsOfService](decoder)
+
+	case ConstructorUpdateUnconfirmedSession:
+		return decodeEntity[UpdateUnconfirmedSession](decoder)
+
+	case ConstructorUpdateAttachmentMenuBots:
+		return decodeEntity[UpdateAttachmentMenuBots](decoder)
+
+	case ConstructorUpdateWebAppMessageSent:
+		return decodeEntity[UpdateWebAppMessageSent](decoder)
+
+	case ConstructorUpdateActiveEmojiReactions:
+		return decodeEntity[UpdateActiveEmojiReactions](decoder)
+
+	case ConstructorUpdateAvailableMessageEffects:
+		return decodeEntity[UpdateAvailableMessageEffects](decoder)
+
+	case ConstructorUpdateDefaultReactionType:
+		return decodeEntity[UpdateDefaultReactionType](decoder)
+
+	case ConstructorUpdateDefaultPaidReactionType:
+		return decodeEntity[UpdateDefaultPaidReactionType](decoder)
+
+	case ConstructorUpdateSavedMessagesTags:
+		return decodeEntity[UpdateSavedMessagesTags](decoder)
+
+	case ConstructorUpdateActiveLiveLocationMessages:
+		return decodeEntity[UpdateActiveLiveLocationMessages](decoder)
+
+	case ConstructorUpdateOwnedStarCount:
+		return decodeEntity[UpdateOwnedStarCount](decoder)
+
+	case ConstructorUpdateChatRevenueAmount:
+		return decodeEntity[UpdateChatRevenueAmount](decoder)
+
+	case ConstructorUpdateStarRevenueStatus:
+		return decodeEntity[UpdateStarRevenueStatus](decoder)
+
+	case ConstructorUpdateSpeechRecognitionTrial:
+		return decodeEntity[UpdateSpeechRecognitionTrial](decoder)
+
+	case ConstructorUpdateDiceEmojis:
+		return decodeEntity[UpdateDiceEmojis](decoder)
+
+	case ConstructorUpdateAnimatedEmojiMessageClicked:
+		return decodeEntity[UpdateAnimatedEmojiMessageClicked](decoder)
+
+	case ConstructorUpdateAnimationSearchParameters:
+		return decodeEntity[UpdateAnimationSearchParameters](decoder)
+
+	case ConstructorUpdateSuggestedActions:
+		return decodeEntity[UpdateSuggestedActions](decoder)
+
+	case ConstructorUpdateSpeedLimitNotification:
+		return decodeEntity[UpdateSpeedLimitNotification](decoder)
+
+	case ConstructorUpdateContactCloseBirthdays:
+		return decodeEntity[UpdateContactCloseBirthdays](decoder)
+
+	case ConstructorUpdateAutosaveSettings:
+		return decodeEntity[UpdateAutosaveSettings](decoder)
+
+	case ConstructorUpdateBusinessConnection:
+		return decodeEntity[UpdateBusinessConnection](decoder)
+
+	case ConstructorUpdateNewBusinessMessage:
+		return decodeEntity[UpdateNewBusinessMessage](decoder)
+
+	case ConstructorUpdateBusinessMessageEdited:
+		return decodeEntity[UpdateBusinessMessageEdited](decoder)
+
+	case ConstructorUpdateBusinessMessagesDeleted:
+		return decodeEntity[UpdateBusinessMessagesDeleted](decoder)
+
+	case ConstructorUpdateNewInlineQuery:
+		return decodeEntity[UpdateNewInlineQuery](decoder)
+
+	case ConstructorUpdateNewChosenInlineResult:
+		return decodeEntity[UpdateNewChosenInlineResult](decoder)
+
+	case ConstructorUpdateNewCallbackQuery:
+		return decodeEntity[UpdateNewCallbackQuery](decoder)
+
+	case ConstructorUpdateNewInlineCallbackQuery:
+		return decodeEntity[UpdateNewInlineCallbackQuery](decoder)
+
+	case ConstructorUpdateNewBusinessCallbackQuery:
+		return decodeEntity[UpdateNewBusinessCallbackQuery](decoder)
+
+	case ConstructorUpdateNewShippingQuery:
+		return decodeEntity[UpdateNewShippingQuery](decoder)
+
+	case ConstructorUpdateNewPreCheckoutQuery:
+		return decodeEntity[UpdateNewPreCheckoutQuery](decoder)
+
+	case ConstructorUpdateNewCustomEvent:
+		return decodeEntity[UpdateNewCustomEvent](decoder)
+
+	case ConstructorUpdateNewCustomQuery:
+		return decodeEntity[UpdateNewCustomQuery](decoder)
+
+	case ConstructorUpdatePoll:
+		return decodeEntity[UpdatePoll](decoder)
+
+	case ConstructorUpdatePollAnswer:
+		return decodeEntity[UpdatePollAnswer](decoder)
+
+	case ConstructorUpdateChatMember:
+		return decodeEntity[UpdateChatMember](decoder)
+
+	case ConstructorUpdateNewChatJoinRequest:
+		return decodeEntity[UpdateNewChatJoinRequest](decoder)
+
+	case ConstructorUpdateChatBoost:
+		return decodeEntity[UpdateChatBoost](decoder)
+
+	case ConstructorUpdateMessageReaction:
+		return decodeEntity[UpdateMessageReaction](decoder)
+
+	case ConstructorUpdateMessageReactions:
+		return decodeEntity[UpdateMessageReactions](decoder)
+
+	case ConstructorUpdatePaidMediaPurchased:
+		return decodeEntity[UpdatePaidMediaPurchased](decoder)
+
+	case ConstructorUpdates:
+		return decodeEntity[Updates](decoder)
+
+	case ConstructorLogStreamDefault:
+		return decodeEntity[LogStreamDefault](decoder)
+
+	case ConstructorLogStreamFile:
+		return decodeEntity[LogStreamFile](decoder)
+
+	case ConstructorLogStreamEmpty:
+		return decodeEntity[LogStreamEmpty](decoder)
+
+	case ConstructorLogVerbosityLevel:
+		return decodeEntity[LogVerbosityLevel](decoder)
+
+	case ConstructorLogTags:
+		return decodeEntity[LogTags](decoder)
+
+	case ConstructorUserSupportInfo:
+		return decodeEntity[UserSupportInfo](decoder)
+
+	case ConstructorTestInt:
+		return decodeEntity[TestInt](decoder)
+
+	case ConstructorTestString:
+		return decodeEntity[TestString](decoder)
+
+	case ConstructorTestBytes:
+		return decodeEntity[TestBytes](decoder)
+
+	case ConstructorTestVectorInt:
+		return decodeEntity[TestVectorInt](decoder)
+
+	case ConstructorTestVectorIntObject:
+		return decodeEntity[TestVectorIntObject](decoder)
+
+	case ConstructorTestVectorString:
+		return decodeEntity[TestVectorString](decoder)
+
+	case ConstructorTestVectorStringObject:
+		return decodeEntity[TestVectorStringObject](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
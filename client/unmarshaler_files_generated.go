@@ -0,0 +1,805 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalInputFile(data json.RawMessage) (InputFile, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputFile)
+}
+
+func decodeInputFile(decoder *jsonDecoder) (InputFile, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputFileId:
+		return decodeEntity[InputFileId](decoder)
+
+	case ConstructorInputFileRemote:
+		return decodeEntity[InputFileRemote](decoder)
+
+	case ConstructorInputFileLocal:
+		return decodeEntity[InputFileLocal](decoder)
+
+	case ConstructorInputFileGenerated:
+		return decodeEntity[InputFileGenerated](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputFile(dataList []json.RawMessage) ([]InputFile, error) {
+	list := make([]InputFile, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputFile(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalThumbnailFormat(data json.RawMessage) (ThumbnailFormat, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeThumbnailFormat)
+}
+
+func decodeThumbnailFormat(decoder *jsonDecoder) (ThumbnailFormat, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorThumbnailFormatJpeg:
+		return decodeEntity[ThumbnailFormatJpeg](decoder)
+
+	case ConstructorThumbnailFormatGif:
+		return decodeEntity[ThumbnailFormatGif](decoder)
+
+	case ConstructorThumbnailFormatMpeg4:
+		return decodeEntity[ThumbnailFormatMpeg4](decoder)
+
+	case ConstructorThumbnailFormatPng:
+		return decodeEntity[ThumbnailFormatPng](decoder)
+
+	case ConstructorThumbnailFormatTgs:
+		return decodeEntity[ThumbnailFormatTgs](decoder)
+
+	case ConstructorThumbnailFormatWebm:
+		return decodeEntity[ThumbnailFormatWebm](decoder)
+
+	case ConstructorThumbnailFormatWebp:
+		return decodeEntity[ThumbnailFormatWebp](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfThumbnailFormat(dataList []json.RawMessage) ([]ThumbnailFormat, error) {
+	list := make([]ThumbnailFormat, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalThumbnailFormat(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalBackgroundFill(data json.RawMessage) (BackgroundFill, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeBackgroundFill)
+}
+
+func decodeBackgroundFill(decoder *jsonDecoder) (BackgroundFill, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorBackgroundFillSolid:
+		return decodeEntity[BackgroundFillSolid](decoder)
+
+	case ConstructorBackgroundFillGradient:
+		return decodeEntity[BackgroundFillGradient](decoder)
+
+	case ConstructorBackgroundFillFreeformGradient:
+		return decodeEntity[BackgroundFillFreeformGradient](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfBackgroundFill(dataList []json.RawMessage) ([]BackgroundFill, error) {
+	list := make([]BackgroundFill, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalBackgroundFill(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalBackgroundType(data json.RawMessage) (BackgroundType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeBackgroundType)
+}
+
+func decodeBackgroundType(decoder *jsonDecoder) (BackgroundType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorBackgroundTypeWallpaper:
+		return decodeEntity[BackgroundTypeWallpaper](decoder)
+
+	case ConstructorBackgroundTypePattern:
+		return decodeEntity[BackgroundTypePattern](decoder)
+
+	case ConstructorBackgroundTypeFill:
+		return decodeEntity[BackgroundTypeFill](decoder)
+
+	case ConstructorBackgroundTypeChatTheme:
+		return decodeEntity[BackgroundTypeChatTheme](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfBackgroundType(dataList []json.RawMessage) ([]BackgroundType, error) {
+	list := make([]BackgroundType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalBackgroundType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputBackground(data json.RawMessage) (InputBackground, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputBackground)
+}
+
+func decodeInputBackground(decoder *jsonDecoder) (InputBackground, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputBackgroundLocal:
+		return decodeEntity[InputBackgroundLocal](decoder)
+
+	case ConstructorInputBackgroundRemote:
+		return decodeEntity[InputBackgroundRemote](decoder)
+
+	case ConstructorInputBackgroundPrevious:
+		return decodeEntity[InputBackgroundPrevious](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputBackground(dataList []json.RawMessage) ([]InputBackground, error) {
+	list := make([]InputBackground, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputBackground(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalFileType(data json.RawMessage) (FileType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeFileType)
+}
+
+func decodeFileType(decoder *jsonDecoder) (FileType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorFileTypeNone:
+		return decodeEntity[FileTypeNone](decoder)
+
+	case ConstructorFileTypeAnimation:
+		return decodeEntity[FileTypeAnimation](decoder)
+
+	case ConstructorFileTypeAudio:
+		return decodeEntity[FileTypeAudio](decoder)
+
+	case ConstructorFileTypeDocument:
+		return decodeEntity[FileTypeDocument](decoder)
+
+	case ConstructorFileTypeNotificationSound:
+		return decodeEntity[FileTypeNotificationSound](decoder)
+
+	case ConstructorFileTypePhoto:
+		return decodeEntity[FileTypePhoto](decoder)
+
+	case ConstructorFileTypePhotoStory:
+		return decodeEntity[FileTypePhotoStory](decoder)
+
+	case ConstructorFileTypeProfilePhoto:
+		return decodeEntity[FileTypeProfilePhoto](decoder)
+
+	case ConstructorFileTypeSecret:
+		return decodeEntity[FileTypeSecret](decoder)
+
+	case ConstructorFileTypeSecretThumbnail:
+		return decodeEntity[FileTypeSecretThumbnail](decoder)
+
+	case ConstructorFileTypeSecure:
+		return decodeEntity[FileTypeSecure](decoder)
+
+	case ConstructorFileTypeSelfDestructingPhoto:
+		return decodeEntity[FileTypeSelfDestructingPhoto](decoder)
+
+	case ConstructorFileTypeSelfDestructingVideo:
+		return decodeEntity[FileTypeSelfDestructingVideo](decoder)
+
+	case ConstructorFileTypeSelfDestructingVideoNote:
+		return decodeEntity[FileTypeSelfDestructingVideoNote](decoder)
+
+	case ConstructorFileTypeSelfDestructingVoiceNote:
+		return decodeEntity[FileTypeSelfDestructingVoiceNote](decoder)
+
+	case ConstructorFileTypeSticker:
+		return decodeEntity[FileTypeSticker](decoder)
+
+	case ConstructorFileTypeThumbnail:
+		return decodeEntity[FileTypeThumbnail](decoder)
+
+	case ConstructorFileTypeUnknown:
+		return decodeEntity[FileTypeUnknown](decoder)
+
+	case ConstructorFileTypeVideo:
+		return decodeEntity[FileTypeVideo](decoder)
+
+	case ConstructorFileTypeVideoNote:
+		return decodeEntity[FileTypeVideoNote](decoder)
+
+	case ConstructorFileTypeVideoStory:
+		return decodeEntity[FileTypeVideoStory](decoder)
+
+	case ConstructorFileTypeVoiceNote:
+		return decodeEntity[FileTypeVoiceNote](decoder)
+
+	case ConstructorFileTypeWallpaper:
+		return decodeEntity[FileTypeWallpaper](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfFileType(dataList []json.RawMessage) ([]FileType, error) {
+	list := make([]FileType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalFileType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalLocalFile(data json.RawMessage) (*LocalFile, error) {
+	var resp LocalFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRemoteFile(data json.RawMessage) (*RemoteFile, error) {
+	var resp RemoteFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFile(data json.RawMessage) (*File, error) {
+	var resp File
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputFileId(data json.RawMessage) (*InputFileId, error) {
+	var resp InputFileId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputFileRemote(data json.RawMessage) (*InputFileRemote, error) {
+	var resp InputFileRemote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputFileLocal(data json.RawMessage) (*InputFileLocal, error) {
+	var resp InputFileLocal
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputFileGenerated(data json.RawMessage) (*InputFileGenerated, error) {
+	var resp InputFileGenerated
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPhotoSize(data json.RawMessage) (*PhotoSize, error) {
+	var resp PhotoSize
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnailFormatJpeg(data json.RawMessage) (*ThumbnailFormatJpeg, error) {
+	var resp ThumbnailFormatJpeg
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnailFormatGif(data json.RawMessage) (*ThumbnailFormatGif, error) {
+	var resp ThumbnailFormatGif
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnailFormatMpeg4(data json.RawMessage) (*ThumbnailFormatMpeg4, error) {
+	var resp ThumbnailFormatMpeg4
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnailFormatPng(data json.RawMessage) (*ThumbnailFormatPng, error) {
+	var resp ThumbnailFormatPng
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnailFormatTgs(data json.RawMessage) (*ThumbnailFormatTgs, error) {
+	var resp ThumbnailFormatTgs
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnailFormatWebm(data json.RawMessage) (*ThumbnailFormatWebm, error) {
+	var resp ThumbnailFormatWebm
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnailFormatWebp(data json.RawMessage) (*ThumbnailFormatWebp, error) {
+	var resp ThumbnailFormatWebp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalThumbnail(data json.RawMessage) (*Thumbnail, error) {
+	var resp Thumbnail
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAnimation(data json.RawMessage) (*Animation, error) {
+	var resp Animation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAudio(data json.RawMessage) (*Audio, error) {
+	var resp Audio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDocument(data json.RawMessage) (*Document, error) {
+	var resp Document
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPhoto(data json.RawMessage) (*Photo, error) {
+	var resp Photo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVideo(data json.RawMessage) (*Video, error) {
+	var resp Video
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVideoNote(data json.RawMessage) (*VideoNote, error) {
+	var resp VideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalVoiceNote(data json.RawMessage) (*VoiceNote, error) {
+	var resp VoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAlternativeVideo(data json.RawMessage) (*AlternativeVideo, error) {
+	var resp AlternativeVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackground(data json.RawMessage) (*Background, error) {
+	var resp Background
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgrounds(data json.RawMessage) (*Backgrounds, error) {
+	var resp Backgrounds
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileDownload(data json.RawMessage) (*FileDownload, error) {
+	var resp FileDownload
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDownloadedFileCounts(data json.RawMessage) (*DownloadedFileCounts, error) {
+	var resp DownloadedFileCounts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundFileDownloads(data json.RawMessage) (*FoundFileDownloads, error) {
+	var resp FoundFileDownloads
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDatedFile(data json.RawMessage) (*DatedFile, error) {
+	var resp DatedFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalIdentityDocument(data json.RawMessage) (*IdentityDocument, error) {
+	var resp IdentityDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputIdentityDocument(data json.RawMessage) (*InputIdentityDocument, error) {
+	var resp InputIdentityDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPersonalDocument(data json.RawMessage) (*PersonalDocument, error) {
+	var resp PersonalDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPersonalDocument(data json.RawMessage) (*InputPersonalDocument, error) {
+	var resp InputPersonalDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputThumbnail(data json.RawMessage) (*InputThumbnail, error) {
+	var resp InputThumbnail
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAnimations(data json.RawMessage) (*Animations, error) {
+	var resp Animations
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgroundFillSolid(data json.RawMessage) (*BackgroundFillSolid, error) {
+	var resp BackgroundFillSolid
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgroundFillGradient(data json.RawMessage) (*BackgroundFillGradient, error) {
+	var resp BackgroundFillGradient
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgroundFillFreeformGradient(data json.RawMessage) (*BackgroundFillFreeformGradient, error) {
+	var resp BackgroundFillFreeformGradient
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgroundTypeWallpaper(data json.RawMessage) (*BackgroundTypeWallpaper, error) {
+	var resp BackgroundTypeWallpaper
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgroundTypePattern(data json.RawMessage) (*BackgroundTypePattern, error) {
+	var resp BackgroundTypePattern
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgroundTypeFill(data json.RawMessage) (*BackgroundTypeFill, error) {
+	var resp BackgroundTypeFill
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalBackgroundTypeChatTheme(data json.RawMessage) (*BackgroundTypeChatTheme, error) {
+	var resp BackgroundTypeChatTheme
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputBackgroundLocal(data json.RawMessage) (*InputBackgroundLocal, error) {
+	var resp InputBackgroundLocal
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputBackgroundRemote(data json.RawMessage) (*InputBackgroundRemote, error) {
+	var resp InputBackgroundRemote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputBackgroundPrevious(data json.RawMessage) (*InputBackgroundPrevious, error) {
+	var resp InputBackgroundPrevious
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFilePart(data json.RawMessage) (*FilePart, error) {
+	var resp FilePart
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeNone(data json.RawMessage) (*FileTypeNone, error) {
+	var resp FileTypeNone
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeAnimation(data json.RawMessage) (*FileTypeAnimation, error) {
+	var resp FileTypeAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeAudio(data json.RawMessage) (*FileTypeAudio, error) {
+	var resp FileTypeAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeDocument(data json.RawMessage) (*FileTypeDocument, error) {
+	var resp FileTypeDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeNotificationSound(data json.RawMessage) (*FileTypeNotificationSound, error) {
+	var resp FileTypeNotificationSound
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypePhoto(data json.RawMessage) (*FileTypePhoto, error) {
+	var resp FileTypePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypePhotoStory(data json.RawMessage) (*FileTypePhotoStory, error) {
+	var resp FileTypePhotoStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeProfilePhoto(data json.RawMessage) (*FileTypeProfilePhoto, error) {
+	var resp FileTypeProfilePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSecret(data json.RawMessage) (*FileTypeSecret, error) {
+	var resp FileTypeSecret
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSecretThumbnail(data json.RawMessage) (*FileTypeSecretThumbnail, error) {
+	var resp FileTypeSecretThumbnail
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSecure(data json.RawMessage) (*FileTypeSecure, error) {
+	var resp FileTypeSecure
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSelfDestructingPhoto(data json.RawMessage) (*FileTypeSelfDestructingPhoto, error) {
+	var resp FileTypeSelfDestructingPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSelfDestructingVideo(data json.RawMessage) (*FileTypeSelfDestructingVideo, error) {
+	var resp FileTypeSelfDestructingVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSelfDestructingVideoNote(data json.RawMessage) (*FileTypeSelfDestructingVideoNote, error) {
+	var resp FileTypeSelfDestructingVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSelfDestructingVoiceNote(data json.RawMessage) (*FileTypeSelfDestructingVoiceNote, error) {
+	var resp FileTypeSelfDestructingVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeSticker(data json.RawMessage) (*FileTypeSticker, error) {
+	var resp FileTypeSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeThumbnail(data json.RawMessage) (*FileTypeThumbnail, error) {
+	var resp FileTypeThumbnail
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeUnknown(data json.RawMessage) (*FileTypeUnknown, error) {
+	var resp FileTypeUnknown
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeVideo(data json.RawMessage) (*FileTypeVideo, error) {
+	var resp FileTypeVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeVideoNote(data json.RawMessage) (*FileTypeVideoNote, error) {
+	var resp FileTypeVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeVideoStory(data json.RawMessage) (*FileTypeVideoStory, error) {
+	var resp FileTypeVideoStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeVoiceNote(data json.RawMessage) (*FileTypeVoiceNote, error) {
+	var resp FileTypeVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileTypeWallpaper(data json.RawMessage) (*FileTypeWallpaper, error) {
+	var resp FileTypeWallpaper
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorageStatisticsByFileType(data json.RawMessage) (*StorageStatisticsByFileType, error) {
+	var resp StorageStatisticsByFileType
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutoDownloadSettings(data json.RawMessage) (*AutoDownloadSettings, error) {
+	var resp AutoDownloadSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAutoDownloadSettingsPresets(data json.RawMessage) (*AutoDownloadSettingsPresets, error) {
+	var resp AutoDownloadSettingsPresets
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFileDownloadedPrefixSize(data json.RawMessage) (*FileDownloadedPrefixSize, error) {
+	var resp FileDownloadedPrefixSize
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
@@ -0,0 +1,3696 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalPollType(data json.RawMessage) (PollType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePollType)
+}
+
+func decodePollType(decoder *jsonDecoder) (PollType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPollTypeRegular:
+		return decodeEntity[PollTypeRegular](decoder)
+
+	case ConstructorPollTypeQuiz:
+		return decodeEntity[PollTypeQuiz](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPollType(dataList []json.RawMessage) ([]PollType, error) {
+	list := make([]PollType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPollType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageSender(data json.RawMessage) (MessageSender, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageSender)
+}
+
+func decodeMessageSender(decoder *jsonDecoder) (MessageSender, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageSenderUser:
+		return decodeEntity[MessageSenderUser](decoder)
+
+	case ConstructorMessageSenderChat:
+		return decodeEntity[MessageSenderChat](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageSender(dataList []json.RawMessage) ([]MessageSender, error) {
+	list := make([]MessageSender, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageSender(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageReadDate(data json.RawMessage) (MessageReadDate, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageReadDate)
+}
+
+func decodeMessageReadDate(decoder *jsonDecoder) (MessageReadDate, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageReadDateRead:
+		return decodeEntity[MessageReadDateRead](decoder)
+
+	case ConstructorMessageReadDateUnread:
+		return decodeEntity[MessageReadDateUnread](decoder)
+
+	case ConstructorMessageReadDateTooOld:
+		return decodeEntity[MessageReadDateTooOld](decoder)
+
+	case ConstructorMessageReadDateUserPrivacyRestricted:
+		return decodeEntity[MessageReadDateUserPrivacyRestricted](decoder)
+
+	case ConstructorMessageReadDateMyPrivacyRestricted:
+		return decodeEntity[MessageReadDateMyPrivacyRestricted](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageReadDate(dataList []json.RawMessage) ([]MessageReadDate, error) {
+	list := make([]MessageReadDate, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageReadDate(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageOrigin(data json.RawMessage) (MessageOrigin, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageOrigin)
+}
+
+func decodeMessageOrigin(decoder *jsonDecoder) (MessageOrigin, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageOriginUser:
+		return decodeEntity[MessageOriginUser](decoder)
+
+	case ConstructorMessageOriginHiddenUser:
+		return decodeEntity[MessageOriginHiddenUser](decoder)
+
+	case ConstructorMessageOriginChat:
+		return decodeEntity[MessageOriginChat](decoder)
+
+	case ConstructorMessageOriginChannel:
+		return decodeEntity[MessageOriginChannel](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageOrigin(dataList []json.RawMessage) ([]MessageOrigin, error) {
+	list := make([]MessageOrigin, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageOrigin(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageEffectType(data json.RawMessage) (MessageEffectType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageEffectType)
+}
+
+func decodeMessageEffectType(decoder *jsonDecoder) (MessageEffectType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageEffectTypeEmojiReaction:
+		return decodeEntity[MessageEffectTypeEmojiReaction](decoder)
+
+	case ConstructorMessageEffectTypePremiumSticker:
+		return decodeEntity[MessageEffectTypePremiumSticker](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageEffectType(dataList []json.RawMessage) ([]MessageEffectType, error) {
+	list := make([]MessageEffectType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageEffectType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageSendingState(data json.RawMessage) (MessageSendingState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageSendingState)
+}
+
+func decodeMessageSendingState(decoder *jsonDecoder) (MessageSendingState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageSendingStatePending:
+		return decodeEntity[MessageSendingStatePending](decoder)
+
+	case ConstructorMessageSendingStateFailed:
+		return decodeEntity[MessageSendingStateFailed](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageSendingState(dataList []json.RawMessage) ([]MessageSendingState, error) {
+	list := make([]MessageSendingState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageSendingState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageReplyTo(data json.RawMessage) (MessageReplyTo, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageReplyTo)
+}
+
+func decodeMessageReplyTo(decoder *jsonDecoder) (MessageReplyTo, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageReplyToMessage:
+		return decodeEntity[MessageReplyToMessage](decoder)
+
+	case ConstructorMessageReplyToStory:
+		return decodeEntity[MessageReplyToStory](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageReplyTo(dataList []json.RawMessage) ([]MessageReplyTo, error) {
+	list := make([]MessageReplyTo, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageReplyTo(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputMessageReplyTo(data json.RawMessage) (InputMessageReplyTo, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputMessageReplyTo)
+}
+
+func decodeInputMessageReplyTo(decoder *jsonDecoder) (InputMessageReplyTo, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputMessageReplyToMessage:
+		return decodeEntity[InputMessageReplyToMessage](decoder)
+
+	case ConstructorInputMessageReplyToExternalMessage:
+		return decodeEntity[InputMessageReplyToExternalMessage](decoder)
+
+	case ConstructorInputMessageReplyToStory:
+		return decodeEntity[InputMessageReplyToStory](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputMessageReplyTo(dataList []json.RawMessage) ([]InputMessageReplyTo, error) {
+	list := make([]InputMessageReplyTo, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputMessageReplyTo(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageSource(data json.RawMessage) (MessageSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageSource)
+}
+
+func decodeMessageSource(decoder *jsonDecoder) (MessageSource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageSourceChatHistory:
+		return decodeEntity[MessageSourceChatHistory](decoder)
+
+	case ConstructorMessageSourceMessageThreadHistory:
+		return decodeEntity[MessageSourceMessageThreadHistory](decoder)
+
+	case ConstructorMessageSourceForumTopicHistory:
+		return decodeEntity[MessageSourceForumTopicHistory](decoder)
+
+	case ConstructorMessageSourceHistoryPreview:
+		return decodeEntity[MessageSourceHistoryPreview](decoder)
+
+	case ConstructorMessageSourceChatList:
+		return decodeEntity[MessageSourceChatList](decoder)
+
+	case ConstructorMessageSourceSearch:
+		return decodeEntity[MessageSourceSearch](decoder)
+
+	case ConstructorMessageSourceChatEventLog:
+		return decodeEntity[MessageSourceChatEventLog](decoder)
+
+	case ConstructorMessageSourceNotification:
+		return decodeEntity[MessageSourceNotification](decoder)
+
+	case ConstructorMessageSourceScreenshot:
+		return decodeEntity[MessageSourceScreenshot](decoder)
+
+	case ConstructorMessageSourceOther:
+		return decodeEntity[MessageSourceOther](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageSource(dataList []json.RawMessage) ([]MessageSource, error) {
+	list := make([]MessageSource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageSource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalKeyboardButtonType(data json.RawMessage) (KeyboardButtonType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeKeyboardButtonType)
+}
+
+func decodeKeyboardButtonType(decoder *jsonDecoder) (KeyboardButtonType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorKeyboardButtonTypeText:
+		return decodeEntity[KeyboardButtonTypeText](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestPhoneNumber:
+		return decodeEntity[KeyboardButtonTypeRequestPhoneNumber](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestLocation:
+		return decodeEntity[KeyboardButtonTypeRequestLocation](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestPoll:
+		return decodeEntity[KeyboardButtonTypeRequestPoll](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestUsers:
+		return decodeEntity[KeyboardButtonTypeRequestUsers](decoder)
+
+	case ConstructorKeyboardButtonTypeRequestChat:
+		return decodeEntity[KeyboardButtonTypeRequestChat](decoder)
+
+	case ConstructorKeyboardButtonTypeWebApp:
+		return decodeEntity[KeyboardButtonTypeWebApp](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfKeyboardButtonType(dataList []json.RawMessage) ([]KeyboardButtonType, error) {
+	list := make([]KeyboardButtonType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalKeyboardButtonType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInlineKeyboardButtonType(data json.RawMessage) (InlineKeyboardButtonType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInlineKeyboardButtonType)
+}
+
+func decodeInlineKeyboardButtonType(decoder *jsonDecoder) (InlineKeyboardButtonType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInlineKeyboardButtonTypeUrl:
+		return decodeEntity[InlineKeyboardButtonTypeUrl](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeLoginUrl:
+		return decodeEntity[InlineKeyboardButtonTypeLoginUrl](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeWebApp:
+		return decodeEntity[InlineKeyboardButtonTypeWebApp](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCallback:
+		return decodeEntity[InlineKeyboardButtonTypeCallback](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCallbackWithPassword:
+		return decodeEntity[InlineKeyboardButtonTypeCallbackWithPassword](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCallbackGame:
+		return decodeEntity[InlineKeyboardButtonTypeCallbackGame](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeSwitchInline:
+		return decodeEntity[InlineKeyboardButtonTypeSwitchInline](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeBuy:
+		return decodeEntity[InlineKeyboardButtonTypeBuy](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeUser:
+		return decodeEntity[InlineKeyboardButtonTypeUser](decoder)
+
+	case ConstructorInlineKeyboardButtonTypeCopyText:
+		return decodeEntity[InlineKeyboardButtonTypeCopyText](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInlineKeyboardButtonType(dataList []json.RawMessage) ([]InlineKeyboardButtonType, error) {
+	list := make([]InlineKeyboardButtonType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInlineKeyboardButtonType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReplyMarkup(data json.RawMessage) (ReplyMarkup, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReplyMarkup)
+}
+
+func decodeReplyMarkup(decoder *jsonDecoder) (ReplyMarkup, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReplyMarkupRemoveKeyboard:
+		return decodeEntity[ReplyMarkupRemoveKeyboard](decoder)
+
+	case ConstructorReplyMarkupForceReply:
+		return decodeEntity[ReplyMarkupForceReply](decoder)
+
+	case ConstructorReplyMarkupShowKeyboard:
+		return decodeEntity[ReplyMarkupShowKeyboard](decoder)
+
+	case ConstructorReplyMarkupInlineKeyboard:
+		return decodeEntity[ReplyMarkupInlineKeyboard](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReplyMarkup(dataList []json.RawMessage) ([]ReplyMarkup, error) {
+	list := make([]ReplyMarkup, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReplyMarkup(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSavedMessagesTopicType(data json.RawMessage) (SavedMessagesTopicType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSavedMessagesTopicType)
+}
+
+func decodeSavedMessagesTopicType(decoder *jsonDecoder) (SavedMessagesTopicType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSavedMessagesTopicTypeMyNotes:
+		return decodeEntity[SavedMessagesTopicTypeMyNotes](decoder)
+
+	case ConstructorSavedMessagesTopicTypeAuthorHidden:
+		return decodeEntity[SavedMessagesTopicTypeAuthorHidden](decoder)
+
+	case ConstructorSavedMessagesTopicTypeSavedFromChat:
+		return decodeEntity[SavedMessagesTopicTypeSavedFromChat](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSavedMessagesTopicType(dataList []json.RawMessage) ([]SavedMessagesTopicType, error) {
+	list := make([]SavedMessagesTopicType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSavedMessagesTopicType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalRichText(data json.RawMessage) (RichText, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeRichText)
+}
+
+func decodeRichText(decoder *jsonDecoder) (RichText, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorRichTextPlain:
+		return decodeEntity[RichTextPlain](decoder)
+
+	case ConstructorRichTextBold:
+		return decodeEntity[RichTextBold](decoder)
+
+	case ConstructorRichTextItalic:
+		return decodeEntity[RichTextItalic](decoder)
+
+	case ConstructorRichTextUnderline:
+		return decodeEntity[RichTextUnderline](decoder)
+
+	case ConstructorRichTextStrikethrough:
+		return decodeEntity[RichTextStrikethrough](decoder)
+
+	case ConstructorRichTextFixed:
+		return decodeEntity[RichTextFixed](decoder)
+
+	case ConstructorRichTextUrl:
+		return decodeEntity[RichTextUrl](decoder)
+
+	case ConstructorRichTextEmailAddress:
+		return decodeEntity[RichTextEmailAddress](decoder)
+
+	case ConstructorRichTextSubscript:
+		return decodeEntity[RichTextSubscript](decoder)
+
+	case ConstructorRichTextSuperscript:
+		return decodeEntity[RichTextSuperscript](decoder)
+
+	case ConstructorRichTextMarked:
+		return decodeEntity[RichTextMarked](decoder)
+
+	case ConstructorRichTextPhoneNumber:
+		return decodeEntity[RichTextPhoneNumber](decoder)
+
+	case ConstructorRichTextIcon:
+		return decodeEntity[RichTextIcon](decoder)
+
+	case ConstructorRichTextReference:
+		return decodeEntity[RichTextReference](decoder)
+
+	case ConstructorRichTextAnchor:
+		return decodeEntity[RichTextAnchor](decoder)
+
+	case ConstructorRichTextAnchorLink:
+		return decodeEntity[RichTextAnchorLink](decoder)
+
+	case ConstructorRichTexts:
+		return decodeEntity[RichTexts](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfRichText(dataList []json.RawMessage) ([]RichText, error) {
+	list := make([]RichText, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalRichText(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageContent(data json.RawMessage) (MessageContent, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageContent)
+}
+
+func decodeMessageContent(decoder *jsonDecoder) (MessageContent, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageText:
+		return decodeEntity[MessageText](decoder)
+
+	case ConstructorMessageAnimation:
+		return decodeEntity[MessageAnimation](decoder)
+
+	case ConstructorMessageAudio:
+		return decodeEntity[MessageAudio](decoder)
+
+	case ConstructorMessageDocument:
+		return decodeEntity[MessageDocument](decoder)
+
+	case ConstructorMessagePaidMedia:
+		return decodeEntity[MessagePaidMedia](decoder)
+
+	case ConstructorMessagePhoto:
+		return decodeEntity[MessagePhoto](decoder)
+
+	case ConstructorMessageSticker:
+		return decodeEntity[MessageSticker](decoder)
+
+	case ConstructorMessageVideo:
+		return decodeEntity[MessageVideo](decoder)
+
+	case ConstructorMessageVideoNote:
+		return decodeEntity[MessageVideoNote](decoder)
+
+	case ConstructorMessageVoiceNote:
+		return decodeEntity[MessageVoiceNote](decoder)
+
+	case ConstructorMessageExpiredPhoto:
+		return decodeEntity[MessageExpiredPhoto](decoder)
+
+	case ConstructorMessageExpiredVideo:
+		return decodeEntity[MessageExpiredVideo](decoder)
+
+	case ConstructorMessageExpiredVideoNote:
+		return decodeEntity[MessageExpiredVideoNote](decoder)
+
+	case ConstructorMessageExpiredVoiceNote:
+		return decodeEntity[MessageExpiredVoiceNote](decoder)
+
+	case ConstructorMessageLocation:
+		return decodeEntity[MessageLocation](decoder)
+
+	case ConstructorMessageVenue:
+		return decodeEntity[MessageVenue](decoder)
+
+	case ConstructorMessageContact:
+		return decodeEntity[MessageContact](decoder)
+
+	case ConstructorMessageAnimatedEmoji:
+		return decodeEntity[MessageAnimatedEmoji](decoder)
+
+	case ConstructorMessageDice:
+		return decodeEntity[MessageDice](decoder)
+
+	case ConstructorMessageGame:
+		return decodeEntity[MessageGame](decoder)
+
+	case ConstructorMessagePoll:
+		return decodeEntity[MessagePoll](decoder)
+
+	case ConstructorMessageStory:
+		return decodeEntity[MessageStory](decoder)
+
+	case ConstructorMessageInvoice:
+		return decodeEntity[MessageInvoice](decoder)
+
+	case ConstructorMessageCall:
+		return decodeEntity[MessageCall](decoder)
+
+	case ConstructorMessageVideoChatScheduled:
+		return decodeEntity[MessageVideoChatScheduled](decoder)
+
+	case ConstructorMessageVideoChatStarted:
+		return decodeEntity[MessageVideoChatStarted](decoder)
+
+	case ConstructorMessageVideoChatEnded:
+		return decodeEntity[MessageVideoChatEnded](decoder)
+
+	case ConstructorMessageInviteVideoChatParticipants:
+		return decodeEntity[MessageInviteVideoChatParticipants](decoder)
+
+	case ConstructorMessageBasicGroupChatCreate:
+		return decodeEntity[MessageBasicGroupChatCreate](decoder)
+
+	case ConstructorMessageSupergroupChatCreate:
+		return decodeEntity[MessageSupergroupChatCreate](decoder)
+
+	case ConstructorMessageChatChangeTitle:
+		return decodeEntity[MessageChatChangeTitle](decoder)
+
+	case ConstructorMessageChatChangePhoto:
+		return decodeEntity[MessageChatChangePhoto](decoder)
+
+	case ConstructorMessageChatDeletePhoto:
+		return decodeEntity[MessageChatDeletePhoto](decoder)
+
+	case ConstructorMessageChatAddMembers:
+		return decodeEntity[MessageChatAddMembers](decoder)
+
+	case ConstructorMessageChatJoinByLink:
+		return decodeEntity[MessageChatJoinByLink](decoder)
+
+	case ConstructorMessageChatJoinByRequest:
+		return decodeEntity[MessageChatJoinByRequest](decoder)
+
+	case ConstructorMessageChatDeleteMember:
+		return decodeEntity[MessageChatDeleteMember](decoder)
+
+	case ConstructorMessageChatUpgradeTo:
+		return decodeEntity[MessageChatUpgradeTo](decoder)
+
+	case ConstructorMessageChatUpgradeFrom:
+		return decodeEntity[MessageChatUpgradeFrom](decoder)
+
+	case ConstructorMessagePinMessage:
+		return decodeEntity[MessagePinMessage](decoder)
+
+	case ConstructorMessageScreenshotTaken:
+		return decodeEntity[MessageScreenshotTaken](decoder)
+
+	case ConstructorMessageChatSetBackground:
+		return decodeEntity[MessageChatSetBackground](decoder)
+
+	case ConstructorMessageChatSetTheme:
+		return decodeEntity[MessageChatSetTheme](decoder)
+
+	case ConstructorMessageChatSetMessageAutoDeleteTime:
+		return decodeEntity[MessageChatSetMessageAutoDeleteTime](decoder)
+
+	case ConstructorMessageChatBoost:
+		return decodeEntity[MessageChatBoost](decoder)
+
+	case ConstructorMessageForumTopicCreated:
+		return decodeEntity[MessageForumTopicCreated](decoder)
+
+	case ConstructorMessageForumTopicEdited:
+		return decodeEntity[MessageForumTopicEdited](decoder)
+
+	case ConstructorMessageForumTopicIsClosedToggled:
+		return decodeEntity[MessageForumTopicIsClosedToggled](decoder)
+
+	case ConstructorMessageForumTopicIsHiddenToggled:
+		return decodeEntity[MessageForumTopicIsHiddenToggled](decoder)
+
+	case ConstructorMessageSuggestProfilePhoto:
+		return decodeEntity[MessageSuggestProfilePhoto](decoder)
+
+	case ConstructorMessageCustomServiceAction:
+		return decodeEntity[MessageCustomServiceAction](decoder)
+
+	case ConstructorMessageGameScore:
+		return decodeEntity[MessageGameScore](decoder)
+
+	case ConstructorMessagePaymentSuccessful:
+		return decodeEntity[MessagePaymentSuccessful](decoder)
+
+	case ConstructorMessagePaymentSuccessfulBot:
+		return decodeEntity[MessagePaymentSuccessfulBot](decoder)
+
+	case ConstructorMessagePaymentRefunded:
+		return decodeEntity[MessagePaymentRefunded](decoder)
+
+	case ConstructorMessageGiftedPremium:
+		return decodeEntity[MessageGiftedPremium](decoder)
+
+	case ConstructorMessagePremiumGiftCode:
+		return decodeEntity[MessagePremiumGiftCode](decoder)
+
+	case ConstructorMessageGiveawayCreated:
+		return decodeEntity[MessageGiveawayCreated](decoder)
+
+	case ConstructorMessageGiveaway:
+		return decodeEntity[MessageGiveaway](decoder)
+
+	case ConstructorMessageGiveawayCompleted:
+		return decodeEntity[MessageGiveawayCompleted](decoder)
+
+	case ConstructorMessageGiveawayWinners:
+		return decodeEntity[MessageGiveawayWinners](decoder)
+
+	case ConstructorMessageGiftedStars:
+		return decodeEntity[MessageGiftedStars](decoder)
+
+	case ConstructorMessageGiveawayPrizeStars:
+		return decodeEntity[MessageGiveawayPrizeStars](decoder)
+
+	case ConstructorMessageGift:
+		return decodeEntity[MessageGift](decoder)
+
+	case ConstructorMessageUpgradedGift:
+		return decodeEntity[MessageUpgradedGift](decoder)
+
+	case ConstructorMessageRefundedUpgradedGift:
+		return decodeEntity[MessageRefundedUpgradedGift](decoder)
+
+	case ConstructorMessagePaidMessagesRefunded:
+		return decodeEntity[MessagePaidMessagesRefunded](decoder)
+
+	case ConstructorMessagePaidMessagePriceChanged:
+		return decodeEntity[MessagePaidMessagePriceChanged](decoder)
+
+	case ConstructorMessageContactRegistered:
+		return decodeEntity[MessageContactRegistered](decoder)
+
+	case ConstructorMessageUsersShared:
+		return decodeEntity[MessageUsersShared](decoder)
+
+	case ConstructorMessageChatShared:
+		return decodeEntity[MessageChatShared](decoder)
+
+	case ConstructorMessageBotWriteAccessAllowed:
+		return decodeEntity[MessageBotWriteAccessAllowed](decoder)
+
+	case ConstructorMessageWebAppDataSent:
+		return decodeEntity[MessageWebAppDataSent](decoder)
+
+	case ConstructorMessageWebAppDataReceived:
+		return decodeEntity[MessageWebAppDataReceived](decoder)
+
+	case ConstructorMessagePassportDataSent:
+		return decodeEntity[MessagePassportDataSent](decoder)
+
+	case ConstructorMessagePassportDataReceived:
+		return decodeEntity[MessagePassportDataReceived](decoder)
+
+	case ConstructorMessageProximityAlertTriggered:
+		return decodeEntity[MessageProximityAlertTriggered](decoder)
+
+	case ConstructorMessageUnsupported:
+		return decodeEntity[MessageUnsupported](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageContent(dataList []json.RawMessage) ([]MessageContent, error) {
+	list := make([]MessageContent, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageContent(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalTextEntityType(data json.RawMessage) (TextEntityType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeTextEntityType)
+}
+
+func decodeTextEntityType(decoder *jsonDecoder) (TextEntityType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorTextEntityTypeMention:
+		return decodeEntity[TextEntityTypeMention](decoder)
+
+	case ConstructorTextEntityTypeHashtag:
+		return decodeEntity[TextEntityTypeHashtag](decoder)
+
+	case ConstructorTextEntityTypeCashtag:
+		return decodeEntity[TextEntityTypeCashtag](decoder)
+
+	case ConstructorTextEntityTypeBotCommand:
+		return decodeEntity[TextEntityTypeBotCommand](decoder)
+
+	case ConstructorTextEntityTypeUrl:
+		return decodeEntity[TextEntityTypeUrl](decoder)
+
+	case ConstructorTextEntityTypeEmailAddress:
+		return decodeEntity[TextEntityTypeEmailAddress](decoder)
+
+	case ConstructorTextEntityTypePhoneNumber:
+		return decodeEntity[TextEntityTypePhoneNumber](decoder)
+
+	case ConstructorTextEntityTypeBankCardNumber:
+		return decodeEntity[TextEntityTypeBankCardNumber](decoder)
+
+	case ConstructorTextEntityTypeBold:
+		return decodeEntity[TextEntityTypeBold](decoder)
+
+	case ConstructorTextEntityTypeItalic:
+		return decodeEntity[TextEntityTypeItalic](decoder)
+
+	case ConstructorTextEntityTypeUnderline:
+		return decodeEntity[TextEntityTypeUnderline](decoder)
+
+	case ConstructorTextEntityTypeStrikethrough:
+		return decodeEntity[TextEntityTypeStrikethrough](decoder)
+
+	case ConstructorTextEntityTypeSpoiler:
+		return decodeEntity[TextEntityTypeSpoiler](decoder)
+
+	case ConstructorTextEntityTypeCode:
+		return decodeEntity[TextEntityTypeCode](decoder)
+
+	case ConstructorTextEntityTypePre:
+		return decodeEntity[TextEntityTypePre](decoder)
+
+	case ConstructorTextEntityTypePreCode:
+		return decodeEntity[TextEntityTypePreCode](decoder)
+
+	case ConstructorTextEntityTypeBlockQuote:
+		return decodeEntity[TextEntityTypeBlockQuote](decoder)
+
+	case ConstructorTextEntityTypeExpandableBlockQuote:
+		return decodeEntity[TextEntityTypeExpandableBlockQuote](decoder)
+
+	case ConstructorTextEntityTypeTextUrl:
+		return decodeEntity[TextEntityTypeTextUrl](decoder)
+
+	case ConstructorTextEntityTypeMentionName:
+		return decodeEntity[TextEntityTypeMentionName](decoder)
+
+	case ConstructorTextEntityTypeCustomEmoji:
+		return decodeEntity[TextEntityTypeCustomEmoji](decoder)
+
+	case ConstructorTextEntityTypeMediaTimestamp:
+		return decodeEntity[TextEntityTypeMediaTimestamp](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfTextEntityType(dataList []json.RawMessage) ([]TextEntityType, error) {
+	list := make([]TextEntityType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalTextEntityType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageSchedulingState(data json.RawMessage) (MessageSchedulingState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageSchedulingState)
+}
+
+func decodeMessageSchedulingState(decoder *jsonDecoder) (MessageSchedulingState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageSchedulingStateSendAtDate:
+		return decodeEntity[MessageSchedulingStateSendAtDate](decoder)
+
+	case ConstructorMessageSchedulingStateSendWhenOnline:
+		return decodeEntity[MessageSchedulingStateSendWhenOnline](decoder)
+
+	case ConstructorMessageSchedulingStateSendWhenVideoProcessed:
+		return decodeEntity[MessageSchedulingStateSendWhenVideoProcessed](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageSchedulingState(dataList []json.RawMessage) ([]MessageSchedulingState, error) {
+	list := make([]MessageSchedulingState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageSchedulingState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageSelfDestructType(data json.RawMessage) (MessageSelfDestructType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageSelfDestructType)
+}
+
+func decodeMessageSelfDestructType(decoder *jsonDecoder) (MessageSelfDestructType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageSelfDestructTypeTimer:
+		return decodeEntity[MessageSelfDestructTypeTimer](decoder)
+
+	case ConstructorMessageSelfDestructTypeImmediately:
+		return decodeEntity[MessageSelfDestructTypeImmediately](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageSelfDestructType(dataList []json.RawMessage) ([]MessageSelfDestructType, error) {
+	list := make([]MessageSelfDestructType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageSelfDestructType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputMessageContent(data json.RawMessage) (InputMessageContent, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputMessageContent)
+}
+
+func decodeInputMessageContent(decoder *jsonDecoder) (InputMessageContent, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputMessageText:
+		return decodeEntity[InputMessageText](decoder)
+
+	case ConstructorInputMessageAnimation:
+		return decodeEntity[InputMessageAnimation](decoder)
+
+	case ConstructorInputMessageAudio:
+		return decodeEntity[InputMessageAudio](decoder)
+
+	case ConstructorInputMessageDocument:
+		return decodeEntity[InputMessageDocument](decoder)
+
+	case ConstructorInputMessagePaidMedia:
+		return decodeEntity[InputMessagePaidMedia](decoder)
+
+	case ConstructorInputMessagePhoto:
+		return decodeEntity[InputMessagePhoto](decoder)
+
+	case ConstructorInputMessageSticker:
+		return decodeEntity[InputMessageSticker](decoder)
+
+	case ConstructorInputMessageVideo:
+		return decodeEntity[InputMessageVideo](decoder)
+
+	case ConstructorInputMessageVideoNote:
+		return decodeEntity[InputMessageVideoNote](decoder)
+
+	case ConstructorInputMessageVoiceNote:
+		return decodeEntity[InputMessageVoiceNote](decoder)
+
+	case ConstructorInputMessageLocation:
+		return decodeEntity[InputMessageLocation](decoder)
+
+	case ConstructorInputMessageVenue:
+		return decodeEntity[InputMessageVenue](decoder)
+
+	case ConstructorInputMessageContact:
+		return decodeEntity[InputMessageContact](decoder)
+
+	case ConstructorInputMessageDice:
+		return decodeEntity[InputMessageDice](decoder)
+
+	case ConstructorInputMessageGame:
+		return decodeEntity[InputMessageGame](decoder)
+
+	case ConstructorInputMessageInvoice:
+		return decodeEntity[InputMessageInvoice](decoder)
+
+	case ConstructorInputMessagePoll:
+		return decodeEntity[InputMessagePoll](decoder)
+
+	case ConstructorInputMessageStory:
+		return decodeEntity[InputMessageStory](decoder)
+
+	case ConstructorInputMessageForwarded:
+		return decodeEntity[InputMessageForwarded](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputMessageContent(dataList []json.RawMessage) ([]InputMessageContent, error) {
+	list := make([]InputMessageContent, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputMessageContent(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSearchMessagesFilter(data json.RawMessage) (SearchMessagesFilter, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSearchMessagesFilter)
+}
+
+func decodeSearchMessagesFilter(decoder *jsonDecoder) (SearchMessagesFilter, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSearchMessagesFilterEmpty:
+		return decodeEntity[SearchMessagesFilterEmpty](decoder)
+
+	case ConstructorSearchMessagesFilterAnimation:
+		return decodeEntity[SearchMessagesFilterAnimation](decoder)
+
+	case ConstructorSearchMessagesFilterAudio:
+		return decodeEntity[SearchMessagesFilterAudio](decoder)
+
+	case ConstructorSearchMessagesFilterDocument:
+		return decodeEntity[SearchMessagesFilterDocument](decoder)
+
+	case ConstructorSearchMessagesFilterPhoto:
+		return decodeEntity[SearchMessagesFilterPhoto](decoder)
+
+	case ConstructorSearchMessagesFilterVideo:
+		return decodeEntity[SearchMessagesFilterVideo](decoder)
+
+	case ConstructorSearchMessagesFilterVoiceNote:
+		return decodeEntity[SearchMessagesFilterVoiceNote](decoder)
+
+	case ConstructorSearchMessagesFilterPhotoAndVideo:
+		return decodeEntity[SearchMessagesFilterPhotoAndVideo](decoder)
+
+	case ConstructorSearchMessagesFilterUrl:
+		return decodeEntity[SearchMessagesFilterUrl](decoder)
+
+	case ConstructorSearchMessagesFilterChatPhoto:
+		return decodeEntity[SearchMessagesFilterChatPhoto](decoder)
+
+	case ConstructorSearchMessagesFilterVideoNote:
+		return decodeEntity[SearchMessagesFilterVideoNote](decoder)
+
+	case ConstructorSearchMessagesFilterVoiceAndVideoNote:
+		return decodeEntity[SearchMessagesFilterVoiceAndVideoNote](decoder)
+
+	case ConstructorSearchMessagesFilterMention:
+		return decodeEntity[SearchMessagesFilterMention](decoder)
+
+	case ConstructorSearchMessagesFilterUnreadMention:
+		return decodeEntity[SearchMessagesFilterUnreadMention](decoder)
+
+	case ConstructorSearchMessagesFilterUnreadReaction:
+		return decodeEntity[SearchMessagesFilterUnreadReaction](decoder)
+
+	case ConstructorSearchMessagesFilterFailedToSend:
+		return decodeEntity[SearchMessagesFilterFailedToSend](decoder)
+
+	case ConstructorSearchMessagesFilterPinned:
+		return decodeEntity[SearchMessagesFilterPinned](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSearchMessagesFilter(dataList []json.RawMessage) ([]SearchMessagesFilter, error) {
+	list := make([]SearchMessagesFilter, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSearchMessagesFilter(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSearchMessagesChatTypeFilter(data json.RawMessage) (SearchMessagesChatTypeFilter, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSearchMessagesChatTypeFilter)
+}
+
+func decodeSearchMessagesChatTypeFilter(decoder *jsonDecoder) (SearchMessagesChatTypeFilter, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSearchMessagesChatTypeFilterPrivate:
+		return decodeEntity[SearchMessagesChatTypeFilterPrivate](decoder)
+
+	case ConstructorSearchMessagesChatTypeFilterGroup:
+		return decodeEntity[SearchMessagesChatTypeFilterGroup](decoder)
+
+	case ConstructorSearchMessagesChatTypeFilterChannel:
+		return decodeEntity[SearchMessagesChatTypeFilterChannel](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSearchMessagesChatTypeFilter(dataList []json.RawMessage) ([]SearchMessagesChatTypeFilter, error) {
+	list := make([]SearchMessagesChatTypeFilter, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSearchMessagesChatTypeFilter(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPublicForward(data json.RawMessage) (PublicForward, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePublicForward)
+}
+
+func decodePublicForward(decoder *jsonDecoder) (PublicForward, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPublicForwardMessage:
+		return decodeEntity[PublicForwardMessage](decoder)
+
+	case ConstructorPublicForwardStory:
+		return decodeEntity[PublicForwardStory](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPublicForward(dataList []json.RawMessage) ([]PublicForward, error) {
+	list := make([]PublicForward, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPublicForward(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalMessageFileType(data json.RawMessage) (MessageFileType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeMessageFileType)
+}
+
+func decodeMessageFileType(decoder *jsonDecoder) (MessageFileType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorMessageFileTypePrivate:
+		return decodeEntity[MessageFileTypePrivate](decoder)
+
+	case ConstructorMessageFileTypeGroup:
+		return decodeEntity[MessageFileTypeGroup](decoder)
+
+	case ConstructorMessageFileTypeUnknown:
+		return decodeEntity[MessageFileTypeUnknown](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfMessageFileType(dataList []json.RawMessage) ([]MessageFileType, error) {
+	list := make([]MessageFileType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalMessageFileType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPushMessageContent(data json.RawMessage) (PushMessageContent, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePushMessageContent)
+}
+
+func decodePushMessageContent(decoder *jsonDecoder) (PushMessageContent, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPushMessageContentHidden:
+		return decodeEntity[PushMessageContentHidden](decoder)
+
+	case ConstructorPushMessageContentAnimation:
+		return decodeEntity[PushMessageContentAnimation](decoder)
+
+	case ConstructorPushMessageContentAudio:
+		return decodeEntity[PushMessageContentAudio](decoder)
+
+	case ConstructorPushMessageContentContact:
+		return decodeEntity[PushMessageContentContact](decoder)
+
+	case ConstructorPushMessageContentContactRegistered:
+		return decodeEntity[PushMessageContentContactRegistered](decoder)
+
+	case ConstructorPushMessageContentDocument:
+		return decodeEntity[PushMessageContentDocument](decoder)
+
+	case ConstructorPushMessageContentGame:
+		return decodeEntity[PushMessageContentGame](decoder)
+
+	case ConstructorPushMessageContentGameScore:
+		return decodeEntity[PushMessageContentGameScore](decoder)
+
+	case ConstructorPushMessageContentInvoice:
+		return decodeEntity[PushMessageContentInvoice](decoder)
+
+	case ConstructorPushMessageContentLocation:
+		return decodeEntity[PushMessageContentLocation](decoder)
+
+	case ConstructorPushMessageContentPaidMedia:
+		return decodeEntity[PushMessageContentPaidMedia](decoder)
+
+	case ConstructorPushMessageContentPhoto:
+		return decodeEntity[PushMessageContentPhoto](decoder)
+
+	case ConstructorPushMessageContentPoll:
+		return decodeEntity[PushMessageContentPoll](decoder)
+
+	case ConstructorPushMessageContentPremiumGiftCode:
+		return decodeEntity[PushMessageContentPremiumGiftCode](decoder)
+
+	case ConstructorPushMessageContentGiveaway:
+		return decodeEntity[PushMessageContentGiveaway](decoder)
+
+	case ConstructorPushMessageContentGift:
+		return decodeEntity[PushMessageContentGift](decoder)
+
+	case ConstructorPushMessageContentUpgradedGift:
+		return decodeEntity[PushMessageContentUpgradedGift](decoder)
+
+	case ConstructorPushMessageContentScreenshotTaken:
+		return decodeEntity[PushMessageContentScreenshotTaken](decoder)
+
+	case ConstructorPushMessageContentSticker:
+		return decodeEntity[PushMessageContentSticker](decoder)
+
+	case ConstructorPushMessageContentStory:
+		return decodeEntity[PushMessageContentStory](decoder)
+
+	case ConstructorPushMessageContentText:
+		return decodeEntity[PushMessageContentText](decoder)
+
+	case ConstructorPushMessageContentVideo:
+		return decodeEntity[PushMessageContentVideo](decoder)
+
+	case ConstructorPushMessageContentVideoNote:
+		return decodeEntity[PushMessageContentVideoNote](decoder)
+
+	case ConstructorPushMessageContentVoiceNote:
+		return decodeEntity[PushMessageContentVoiceNote](decoder)
+
+	case ConstructorPushMessageContentBasicGroupChatCreate:
+		return decodeEntity[PushMessageContentBasicGroupChatCreate](decoder)
+
+	case ConstructorPushMessageContentVideoChatStarted:
+		return decodeEntity[PushMessageContentVideoChatStarted](decoder)
+
+	case ConstructorPushMessageContentVideoChatEnded:
+		return decodeEntity[PushMessageContentVideoChatEnded](decoder)
+
+	case ConstructorPushMessageContentInviteVideoChatParticipants:
+		return decodeEntity[PushMessageContentInviteVideoChatParticipants](decoder)
+
+	case ConstructorPushMessageContentChatAddMembers:
+		return decodeEntity[PushMessageContentChatAddMembers](decoder)
+
+	case ConstructorPushMessageContentChatChangePhoto:
+		return decodeEntity[PushMessageContentChatChangePhoto](decoder)
+
+	case ConstructorPushMessageContentChatChangeTitle:
+		return decodeEntity[PushMessageContentChatChangeTitle](decoder)
+
+	case ConstructorPushMessageContentChatSetBackground:
+		return decodeEntity[PushMessageContentChatSetBackground](decoder)
+
+	case ConstructorPushMessageContentChatSetTheme:
+		return decodeEntity[PushMessageContentChatSetTheme](decoder)
+
+	case ConstructorPushMessageContentChatDeleteMember:
+		return decodeEntity[PushMessageContentChatDeleteMember](decoder)
+
+	case ConstructorPushMessageContentChatJoinByLink:
+		return decodeEntity[PushMessageContentChatJoinByLink](decoder)
+
+	case ConstructorPushMessageContentChatJoinByRequest:
+		return decodeEntity[PushMessageContentChatJoinByRequest](decoder)
+
+	case ConstructorPushMessageContentRecurringPayment:
+		return decodeEntity[PushMessageContentRecurringPayment](decoder)
+
+	case ConstructorPushMessageContentSuggestProfilePhoto:
+		return decodeEntity[PushMessageContentSuggestProfilePhoto](decoder)
+
+	case ConstructorPushMessageContentProximityAlertTriggered:
+		return decodeEntity[PushMessageContentProximityAlertTriggered](decoder)
+
+	case ConstructorPushMessageContentMessageForwards:
+		return decodeEntity[PushMessageContentMessageForwards](decoder)
+
+	case ConstructorPushMessageContentMediaAlbum:
+		return decodeEntity[PushMessageContentMediaAlbum](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPushMessageContent(dataList []json.RawMessage) ([]PushMessageContent, error) {
+	list := make([]PushMessageContent, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPushMessageContent(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCanSendMessageToUserResult(data json.RawMessage) (CanSendMessageToUserResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCanSendMessageToUserResult)
+}
+
+func decodeCanSendMessageToUserResult(decoder *jsonDecoder) (CanSendMessageToUserResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCanSendMessageToUserResultOk:
+		return decodeEntity[CanSendMessageToUserResultOk](decoder)
+
+	case ConstructorCanSendMessageToUserResultUserHasPaidMessages:
+		return decodeEntity[CanSendMessageToUserResultUserHasPaidMessages](decoder)
+
+	case ConstructorCanSendMessageToUserResultUserIsDeleted:
+		return decodeEntity[CanSendMessageToUserResultUserIsDeleted](decoder)
+
+	case ConstructorCanSendMessageToUserResultUserRestrictsNewChats:
+		return decodeEntity[CanSendMessageToUserResultUserRestrictsNewChats](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCanSendMessageToUserResult(dataList []json.RawMessage) ([]CanSendMessageToUserResult, error) {
+	list := make([]CanSendMessageToUserResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCanSendMessageToUserResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalTextParseMode(data json.RawMessage) (TextParseMode, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeTextParseMode)
+}
+
+func decodeTextParseMode(decoder *jsonDecoder) (TextParseMode, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorTextParseModeMarkdown:
+		return decodeEntity[TextParseModeMarkdown](decoder)
+
+	case ConstructorTextParseModeHTML:
+		return decodeEntity[TextParseModeHTML](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfTextParseMode(dataList []json.RawMessage) ([]TextParseMode, error) {
+	list := make([]TextParseMode, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalTextParseMode(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalTextEntity(data json.RawMessage) (*TextEntity, error) {
+	var resp TextEntity
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntities(data json.RawMessage) (*TextEntities, error) {
+	var resp TextEntities
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFormattedText(data json.RawMessage) (*FormattedText, error) {
+	var resp FormattedText
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPollOption(data json.RawMessage) (*PollOption, error) {
+	var resp PollOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPollTypeRegular(data json.RawMessage) (*PollTypeRegular, error) {
+	var resp PollTypeRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPollTypeQuiz(data json.RawMessage) (*PollTypeQuiz, error) {
+	var resp PollTypeQuiz
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPoll(data json.RawMessage) (*Poll, error) {
+	var resp Poll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSenderUser(data json.RawMessage) (*MessageSenderUser, error) {
+	var resp MessageSenderUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSenderChat(data json.RawMessage) (*MessageSenderChat, error) {
+	var resp MessageSenderChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSenders(data json.RawMessage) (*MessageSenders, error) {
+	var resp MessageSenders
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMessageSender(data json.RawMessage) (*ChatMessageSender, error) {
+	var resp ChatMessageSender
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatMessageSenders(data json.RawMessage) (*ChatMessageSenders, error) {
+	var resp ChatMessageSenders
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReadDateRead(data json.RawMessage) (*MessageReadDateRead, error) {
+	var resp MessageReadDateRead
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReadDateUnread(data json.RawMessage) (*MessageReadDateUnread, error) {
+	var resp MessageReadDateUnread
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReadDateTooOld(data json.RawMessage) (*MessageReadDateTooOld, error) {
+	var resp MessageReadDateTooOld
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReadDateUserPrivacyRestricted(data json.RawMessage) (*MessageReadDateUserPrivacyRestricted, error) {
+	var resp MessageReadDateUserPrivacyRestricted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReadDateMyPrivacyRestricted(data json.RawMessage) (*MessageReadDateMyPrivacyRestricted, error) {
+	var resp MessageReadDateMyPrivacyRestricted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageViewer(data json.RawMessage) (*MessageViewer, error) {
+	var resp MessageViewer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageViewers(data json.RawMessage) (*MessageViewers, error) {
+	var resp MessageViewers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageOriginUser(data json.RawMessage) (*MessageOriginUser, error) {
+	var resp MessageOriginUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageOriginHiddenUser(data json.RawMessage) (*MessageOriginHiddenUser, error) {
+	var resp MessageOriginHiddenUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageOriginChat(data json.RawMessage) (*MessageOriginChat, error) {
+	var resp MessageOriginChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageOriginChannel(data json.RawMessage) (*MessageOriginChannel, error) {
+	var resp MessageOriginChannel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalForwardSource(data json.RawMessage) (*ForwardSource, error) {
+	var resp ForwardSource
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageForwardInfo(data json.RawMessage) (*MessageForwardInfo, error) {
+	var resp MessageForwardInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageImportInfo(data json.RawMessage) (*MessageImportInfo, error) {
+	var resp MessageImportInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReplyInfo(data json.RawMessage) (*MessageReplyInfo, error) {
+	var resp MessageReplyInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageInteractionInfo(data json.RawMessage) (*MessageInteractionInfo, error) {
+	var resp MessageInteractionInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageEffectTypeEmojiReaction(data json.RawMessage) (*MessageEffectTypeEmojiReaction, error) {
+	var resp MessageEffectTypeEmojiReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageEffectTypePremiumSticker(data json.RawMessage) (*MessageEffectTypePremiumSticker, error) {
+	var resp MessageEffectTypePremiumSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageEffect(data json.RawMessage) (*MessageEffect, error) {
+	var resp MessageEffect
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSendingStatePending(data json.RawMessage) (*MessageSendingStatePending, error) {
+	var resp MessageSendingStatePending
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSendingStateFailed(data json.RawMessage) (*MessageSendingStateFailed, error) {
+	var resp MessageSendingStateFailed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextQuote(data json.RawMessage) (*TextQuote, error) {
+	var resp TextQuote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputTextQuote(data json.RawMessage) (*InputTextQuote, error) {
+	var resp InputTextQuote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReplyToMessage(data json.RawMessage) (*MessageReplyToMessage, error) {
+	var resp MessageReplyToMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReplyToStory(data json.RawMessage) (*MessageReplyToStory, error) {
+	var resp MessageReplyToStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageReplyToMessage(data json.RawMessage) (*InputMessageReplyToMessage, error) {
+	var resp InputMessageReplyToMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageReplyToExternalMessage(data json.RawMessage) (*InputMessageReplyToExternalMessage, error) {
+	var resp InputMessageReplyToExternalMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageReplyToStory(data json.RawMessage) (*InputMessageReplyToStory, error) {
+	var resp InputMessageReplyToStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessage(data json.RawMessage) (*Message, error) {
+	var resp Message
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessages(data json.RawMessage) (*Messages, error) {
+	var resp Messages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundMessages(data json.RawMessage) (*FoundMessages, error) {
+	var resp FoundMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundChatMessages(data json.RawMessage) (*FoundChatMessages, error) {
+	var resp FoundChatMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePosition(data json.RawMessage) (*MessagePosition, error) {
+	var resp MessagePosition
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePositions(data json.RawMessage) (*MessagePositions, error) {
+	var resp MessagePositions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageCalendarDay(data json.RawMessage) (*MessageCalendarDay, error) {
+	var resp MessageCalendarDay
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageCalendar(data json.RawMessage) (*MessageCalendar, error) {
+	var resp MessageCalendar
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceChatHistory(data json.RawMessage) (*MessageSourceChatHistory, error) {
+	var resp MessageSourceChatHistory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceMessageThreadHistory(data json.RawMessage) (*MessageSourceMessageThreadHistory, error) {
+	var resp MessageSourceMessageThreadHistory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceForumTopicHistory(data json.RawMessage) (*MessageSourceForumTopicHistory, error) {
+	var resp MessageSourceForumTopicHistory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceHistoryPreview(data json.RawMessage) (*MessageSourceHistoryPreview, error) {
+	var resp MessageSourceHistoryPreview
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceChatList(data json.RawMessage) (*MessageSourceChatList, error) {
+	var resp MessageSourceChatList
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceSearch(data json.RawMessage) (*MessageSourceSearch, error) {
+	var resp MessageSourceSearch
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceChatEventLog(data json.RawMessage) (*MessageSourceChatEventLog, error) {
+	var resp MessageSourceChatEventLog
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceNotification(data json.RawMessage) (*MessageSourceNotification, error) {
+	var resp MessageSourceNotification
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceScreenshot(data json.RawMessage) (*MessageSourceScreenshot, error) {
+	var resp MessageSourceScreenshot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSourceOther(data json.RawMessage) (*MessageSourceOther, error) {
+	var resp MessageSourceOther
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSponsor(data json.RawMessage) (*MessageSponsor, error) {
+	var resp MessageSponsor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSponsoredMessage(data json.RawMessage) (*SponsoredMessage, error) {
+	var resp SponsoredMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSponsoredMessages(data json.RawMessage) (*SponsoredMessages, error) {
+	var resp SponsoredMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDraftMessage(data json.RawMessage) (*DraftMessage, error) {
+	var resp DraftMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSavedMessagesTag(data json.RawMessage) (*SavedMessagesTag, error) {
+	var resp SavedMessagesTag
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSavedMessagesTags(data json.RawMessage) (*SavedMessagesTags, error) {
+	var resp SavedMessagesTags
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButtonTypeText(data json.RawMessage) (*KeyboardButtonTypeText, error) {
+	var resp KeyboardButtonTypeText
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButtonTypeRequestPhoneNumber(data json.RawMessage) (*KeyboardButtonTypeRequestPhoneNumber, error) {
+	var resp KeyboardButtonTypeRequestPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButtonTypeRequestLocation(data json.RawMessage) (*KeyboardButtonTypeRequestLocation, error) {
+	var resp KeyboardButtonTypeRequestLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButtonTypeRequestPoll(data json.RawMessage) (*KeyboardButtonTypeRequestPoll, error) {
+	var resp KeyboardButtonTypeRequestPoll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButtonTypeRequestUsers(data json.RawMessage) (*KeyboardButtonTypeRequestUsers, error) {
+	var resp KeyboardButtonTypeRequestUsers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButtonTypeRequestChat(data json.RawMessage) (*KeyboardButtonTypeRequestChat, error) {
+	var resp KeyboardButtonTypeRequestChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButtonTypeWebApp(data json.RawMessage) (*KeyboardButtonTypeWebApp, error) {
+	var resp KeyboardButtonTypeWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalKeyboardButton(data json.RawMessage) (*KeyboardButton, error) {
+	var resp KeyboardButton
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeUrl(data json.RawMessage) (*InlineKeyboardButtonTypeUrl, error) {
+	var resp InlineKeyboardButtonTypeUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeLoginUrl(data json.RawMessage) (*InlineKeyboardButtonTypeLoginUrl, error) {
+	var resp InlineKeyboardButtonTypeLoginUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeWebApp(data json.RawMessage) (*InlineKeyboardButtonTypeWebApp, error) {
+	var resp InlineKeyboardButtonTypeWebApp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeCallback(data json.RawMessage) (*InlineKeyboardButtonTypeCallback, error) {
+	var resp InlineKeyboardButtonTypeCallback
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeCallbackWithPassword(data json.RawMessage) (*InlineKeyboardButtonTypeCallbackWithPassword, error) {
+	var resp InlineKeyboardButtonTypeCallbackWithPassword
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeCallbackGame(data json.RawMessage) (*InlineKeyboardButtonTypeCallbackGame, error) {
+	var resp InlineKeyboardButtonTypeCallbackGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeSwitchInline(data json.RawMessage) (*InlineKeyboardButtonTypeSwitchInline, error) {
+	var resp InlineKeyboardButtonTypeSwitchInline
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeBuy(data json.RawMessage) (*InlineKeyboardButtonTypeBuy, error) {
+	var resp InlineKeyboardButtonTypeBuy
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeUser(data json.RawMessage) (*InlineKeyboardButtonTypeUser, error) {
+	var resp InlineKeyboardButtonTypeUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButtonTypeCopyText(data json.RawMessage) (*InlineKeyboardButtonTypeCopyText, error) {
+	var resp InlineKeyboardButtonTypeCopyText
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInlineKeyboardButton(data json.RawMessage) (*InlineKeyboardButton, error) {
+	var resp InlineKeyboardButton
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReplyMarkupRemoveKeyboard(data json.RawMessage) (*ReplyMarkupRemoveKeyboard, error) {
+	var resp ReplyMarkupRemoveKeyboard
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReplyMarkupForceReply(data json.RawMessage) (*ReplyMarkupForceReply, error) {
+	var resp ReplyMarkupForceReply
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReplyMarkupShowKeyboard(data json.RawMessage) (*ReplyMarkupShowKeyboard, error) {
+	var resp ReplyMarkupShowKeyboard
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReplyMarkupInlineKeyboard(data json.RawMessage) (*ReplyMarkupInlineKeyboard, error) {
+	var resp ReplyMarkupInlineKeyboard
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageThreadInfo(data json.RawMessage) (*MessageThreadInfo, error) {
+	var resp MessageThreadInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSavedMessagesTopicTypeMyNotes(data json.RawMessage) (*SavedMessagesTopicTypeMyNotes, error) {
+	var resp SavedMessagesTopicTypeMyNotes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSavedMessagesTopicTypeAuthorHidden(data json.RawMessage) (*SavedMessagesTopicTypeAuthorHidden, error) {
+	var resp SavedMessagesTopicTypeAuthorHidden
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSavedMessagesTopicTypeSavedFromChat(data json.RawMessage) (*SavedMessagesTopicTypeSavedFromChat, error) {
+	var resp SavedMessagesTopicTypeSavedFromChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSavedMessagesTopic(data json.RawMessage) (*SavedMessagesTopic, error) {
+	var resp SavedMessagesTopic
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextPlain(data json.RawMessage) (*RichTextPlain, error) {
+	var resp RichTextPlain
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextBold(data json.RawMessage) (*RichTextBold, error) {
+	var resp RichTextBold
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextItalic(data json.RawMessage) (*RichTextItalic, error) {
+	var resp RichTextItalic
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextUnderline(data json.RawMessage) (*RichTextUnderline, error) {
+	var resp RichTextUnderline
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextStrikethrough(data json.RawMessage) (*RichTextStrikethrough, error) {
+	var resp RichTextStrikethrough
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextFixed(data json.RawMessage) (*RichTextFixed, error) {
+	var resp RichTextFixed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextUrl(data json.RawMessage) (*RichTextUrl, error) {
+	var resp RichTextUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextEmailAddress(data json.RawMessage) (*RichTextEmailAddress, error) {
+	var resp RichTextEmailAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextSubscript(data json.RawMessage) (*RichTextSubscript, error) {
+	var resp RichTextSubscript
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextSuperscript(data json.RawMessage) (*RichTextSuperscript, error) {
+	var resp RichTextSuperscript
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextMarked(data json.RawMessage) (*RichTextMarked, error) {
+	var resp RichTextMarked
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextPhoneNumber(data json.RawMessage) (*RichTextPhoneNumber, error) {
+	var resp RichTextPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextIcon(data json.RawMessage) (*RichTextIcon, error) {
+	var resp RichTextIcon
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextReference(data json.RawMessage) (*RichTextReference, error) {
+	var resp RichTextReference
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextAnchor(data json.RawMessage) (*RichTextAnchor, error) {
+	var resp RichTextAnchor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTextAnchorLink(data json.RawMessage) (*RichTextAnchorLink, error) {
+	var resp RichTextAnchorLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRichTexts(data json.RawMessage) (*RichTexts, error) {
+	var resp RichTexts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageText(data json.RawMessage) (*MessageText, error) {
+	var resp MessageText
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageAnimation(data json.RawMessage) (*MessageAnimation, error) {
+	var resp MessageAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageAudio(data json.RawMessage) (*MessageAudio, error) {
+	var resp MessageAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageDocument(data json.RawMessage) (*MessageDocument, error) {
+	var resp MessageDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePaidMedia(data json.RawMessage) (*MessagePaidMedia, error) {
+	var resp MessagePaidMedia
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePhoto(data json.RawMessage) (*MessagePhoto, error) {
+	var resp MessagePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSticker(data json.RawMessage) (*MessageSticker, error) {
+	var resp MessageSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageVideo(data json.RawMessage) (*MessageVideo, error) {
+	var resp MessageVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageVideoNote(data json.RawMessage) (*MessageVideoNote, error) {
+	var resp MessageVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageVoiceNote(data json.RawMessage) (*MessageVoiceNote, error) {
+	var resp MessageVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageExpiredPhoto(data json.RawMessage) (*MessageExpiredPhoto, error) {
+	var resp MessageExpiredPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageExpiredVideo(data json.RawMessage) (*MessageExpiredVideo, error) {
+	var resp MessageExpiredVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageExpiredVideoNote(data json.RawMessage) (*MessageExpiredVideoNote, error) {
+	var resp MessageExpiredVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageExpiredVoiceNote(data json.RawMessage) (*MessageExpiredVoiceNote, error) {
+	var resp MessageExpiredVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageLocation(data json.RawMessage) (*MessageLocation, error) {
+	var resp MessageLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageVenue(data json.RawMessage) (*MessageVenue, error) {
+	var resp MessageVenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageContact(data json.RawMessage) (*MessageContact, error) {
+	var resp MessageContact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageAnimatedEmoji(data json.RawMessage) (*MessageAnimatedEmoji, error) {
+	var resp MessageAnimatedEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageDice(data json.RawMessage) (*MessageDice, error) {
+	var resp MessageDice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGame(data json.RawMessage) (*MessageGame, error) {
+	var resp MessageGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePoll(data json.RawMessage) (*MessagePoll, error) {
+	var resp MessagePoll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageStory(data json.RawMessage) (*MessageStory, error) {
+	var resp MessageStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageInvoice(data json.RawMessage) (*MessageInvoice, error) {
+	var resp MessageInvoice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageCall(data json.RawMessage) (*MessageCall, error) {
+	var resp MessageCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageVideoChatScheduled(data json.RawMessage) (*MessageVideoChatScheduled, error) {
+	var resp MessageVideoChatScheduled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageVideoChatStarted(data json.RawMessage) (*MessageVideoChatStarted, error) {
+	var resp MessageVideoChatStarted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageVideoChatEnded(data json.RawMessage) (*MessageVideoChatEnded, error) {
+	var resp MessageVideoChatEnded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageInviteVideoChatParticipants(data json.RawMessage) (*MessageInviteVideoChatParticipants, error) {
+	var resp MessageInviteVideoChatParticipants
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageBasicGroupChatCreate(data json.RawMessage) (*MessageBasicGroupChatCreate, error) {
+	var resp MessageBasicGroupChatCreate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSupergroupChatCreate(data json.RawMessage) (*MessageSupergroupChatCreate, error) {
+	var resp MessageSupergroupChatCreate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatChangeTitle(data json.RawMessage) (*MessageChatChangeTitle, error) {
+	var resp MessageChatChangeTitle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatChangePhoto(data json.RawMessage) (*MessageChatChangePhoto, error) {
+	var resp MessageChatChangePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatDeletePhoto(data json.RawMessage) (*MessageChatDeletePhoto, error) {
+	var resp MessageChatDeletePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatAddMembers(data json.RawMessage) (*MessageChatAddMembers, error) {
+	var resp MessageChatAddMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatJoinByLink(data json.RawMessage) (*MessageChatJoinByLink, error) {
+	var resp MessageChatJoinByLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatJoinByRequest(data json.RawMessage) (*MessageChatJoinByRequest, error) {
+	var resp MessageChatJoinByRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatDeleteMember(data json.RawMessage) (*MessageChatDeleteMember, error) {
+	var resp MessageChatDeleteMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatUpgradeTo(data json.RawMessage) (*MessageChatUpgradeTo, error) {
+	var resp MessageChatUpgradeTo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatUpgradeFrom(data json.RawMessage) (*MessageChatUpgradeFrom, error) {
+	var resp MessageChatUpgradeFrom
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePinMessage(data json.RawMessage) (*MessagePinMessage, error) {
+	var resp MessagePinMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageScreenshotTaken(data json.RawMessage) (*MessageScreenshotTaken, error) {
+	var resp MessageScreenshotTaken
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatSetBackground(data json.RawMessage) (*MessageChatSetBackground, error) {
+	var resp MessageChatSetBackground
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatSetTheme(data json.RawMessage) (*MessageChatSetTheme, error) {
+	var resp MessageChatSetTheme
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatSetMessageAutoDeleteTime(data json.RawMessage) (*MessageChatSetMessageAutoDeleteTime, error) {
+	var resp MessageChatSetMessageAutoDeleteTime
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatBoost(data json.RawMessage) (*MessageChatBoost, error) {
+	var resp MessageChatBoost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageForumTopicCreated(data json.RawMessage) (*MessageForumTopicCreated, error) {
+	var resp MessageForumTopicCreated
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageForumTopicEdited(data json.RawMessage) (*MessageForumTopicEdited, error) {
+	var resp MessageForumTopicEdited
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageForumTopicIsClosedToggled(data json.RawMessage) (*MessageForumTopicIsClosedToggled, error) {
+	var resp MessageForumTopicIsClosedToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageForumTopicIsHiddenToggled(data json.RawMessage) (*MessageForumTopicIsHiddenToggled, error) {
+	var resp MessageForumTopicIsHiddenToggled
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSuggestProfilePhoto(data json.RawMessage) (*MessageSuggestProfilePhoto, error) {
+	var resp MessageSuggestProfilePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageCustomServiceAction(data json.RawMessage) (*MessageCustomServiceAction, error) {
+	var resp MessageCustomServiceAction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGameScore(data json.RawMessage) (*MessageGameScore, error) {
+	var resp MessageGameScore
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePaymentSuccessful(data json.RawMessage) (*MessagePaymentSuccessful, error) {
+	var resp MessagePaymentSuccessful
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePaymentSuccessfulBot(data json.RawMessage) (*MessagePaymentSuccessfulBot, error) {
+	var resp MessagePaymentSuccessfulBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePaymentRefunded(data json.RawMessage) (*MessagePaymentRefunded, error) {
+	var resp MessagePaymentRefunded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGiftedPremium(data json.RawMessage) (*MessageGiftedPremium, error) {
+	var resp MessageGiftedPremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePremiumGiftCode(data json.RawMessage) (*MessagePremiumGiftCode, error) {
+	var resp MessagePremiumGiftCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGiveawayCreated(data json.RawMessage) (*MessageGiveawayCreated, error) {
+	var resp MessageGiveawayCreated
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGiveaway(data json.RawMessage) (*MessageGiveaway, error) {
+	var resp MessageGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGiveawayCompleted(data json.RawMessage) (*MessageGiveawayCompleted, error) {
+	var resp MessageGiveawayCompleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGiveawayWinners(data json.RawMessage) (*MessageGiveawayWinners, error) {
+	var resp MessageGiveawayWinners
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGiftedStars(data json.RawMessage) (*MessageGiftedStars, error) {
+	var resp MessageGiftedStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGiveawayPrizeStars(data json.RawMessage) (*MessageGiveawayPrizeStars, error) {
+	var resp MessageGiveawayPrizeStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageGift(data json.RawMessage) (*MessageGift, error) {
+	var resp MessageGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageUpgradedGift(data json.RawMessage) (*MessageUpgradedGift, error) {
+	var resp MessageUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageRefundedUpgradedGift(data json.RawMessage) (*MessageRefundedUpgradedGift, error) {
+	var resp MessageRefundedUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePaidMessagesRefunded(data json.RawMessage) (*MessagePaidMessagesRefunded, error) {
+	var resp MessagePaidMessagesRefunded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePaidMessagePriceChanged(data json.RawMessage) (*MessagePaidMessagePriceChanged, error) {
+	var resp MessagePaidMessagePriceChanged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageContactRegistered(data json.RawMessage) (*MessageContactRegistered, error) {
+	var resp MessageContactRegistered
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageUsersShared(data json.RawMessage) (*MessageUsersShared, error) {
+	var resp MessageUsersShared
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageChatShared(data json.RawMessage) (*MessageChatShared, error) {
+	var resp MessageChatShared
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageBotWriteAccessAllowed(data json.RawMessage) (*MessageBotWriteAccessAllowed, error) {
+	var resp MessageBotWriteAccessAllowed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageWebAppDataSent(data json.RawMessage) (*MessageWebAppDataSent, error) {
+	var resp MessageWebAppDataSent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageWebAppDataReceived(data json.RawMessage) (*MessageWebAppDataReceived, error) {
+	var resp MessageWebAppDataReceived
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePassportDataSent(data json.RawMessage) (*MessagePassportDataSent, error) {
+	var resp MessagePassportDataSent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessagePassportDataReceived(data json.RawMessage) (*MessagePassportDataReceived, error) {
+	var resp MessagePassportDataReceived
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageProximityAlertTriggered(data json.RawMessage) (*MessageProximityAlertTriggered, error) {
+	var resp MessageProximityAlertTriggered
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageUnsupported(data json.RawMessage) (*MessageUnsupported, error) {
+	var resp MessageUnsupported
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeMention(data json.RawMessage) (*TextEntityTypeMention, error) {
+	var resp TextEntityTypeMention
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeHashtag(data json.RawMessage) (*TextEntityTypeHashtag, error) {
+	var resp TextEntityTypeHashtag
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeCashtag(data json.RawMessage) (*TextEntityTypeCashtag, error) {
+	var resp TextEntityTypeCashtag
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeBotCommand(data json.RawMessage) (*TextEntityTypeBotCommand, error) {
+	var resp TextEntityTypeBotCommand
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeUrl(data json.RawMessage) (*TextEntityTypeUrl, error) {
+	var resp TextEntityTypeUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeEmailAddress(data json.RawMessage) (*TextEntityTypeEmailAddress, error) {
+	var resp TextEntityTypeEmailAddress
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypePhoneNumber(data json.RawMessage) (*TextEntityTypePhoneNumber, error) {
+	var resp TextEntityTypePhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeBankCardNumber(data json.RawMessage) (*TextEntityTypeBankCardNumber, error) {
+	var resp TextEntityTypeBankCardNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeBold(data json.RawMessage) (*TextEntityTypeBold, error) {
+	var resp TextEntityTypeBold
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeItalic(data json.RawMessage) (*TextEntityTypeItalic, error) {
+	var resp TextEntityTypeItalic
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeUnderline(data json.RawMessage) (*TextEntityTypeUnderline, error) {
+	var resp TextEntityTypeUnderline
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeStrikethrough(data json.RawMessage) (*TextEntityTypeStrikethrough, error) {
+	var resp TextEntityTypeStrikethrough
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeSpoiler(data json.RawMessage) (*TextEntityTypeSpoiler, error) {
+	var resp TextEntityTypeSpoiler
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeCode(data json.RawMessage) (*TextEntityTypeCode, error) {
+	var resp TextEntityTypeCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypePre(data json.RawMessage) (*TextEntityTypePre, error) {
+	var resp TextEntityTypePre
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypePreCode(data json.RawMessage) (*TextEntityTypePreCode, error) {
+	var resp TextEntityTypePreCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeBlockQuote(data json.RawMessage) (*TextEntityTypeBlockQuote, error) {
+	var resp TextEntityTypeBlockQuote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeExpandableBlockQuote(data json.RawMessage) (*TextEntityTypeExpandableBlockQuote, error) {
+	var resp TextEntityTypeExpandableBlockQuote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeTextUrl(data json.RawMessage) (*TextEntityTypeTextUrl, error) {
+	var resp TextEntityTypeTextUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeMentionName(data json.RawMessage) (*TextEntityTypeMentionName, error) {
+	var resp TextEntityTypeMentionName
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeCustomEmoji(data json.RawMessage) (*TextEntityTypeCustomEmoji, error) {
+	var resp TextEntityTypeCustomEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextEntityTypeMediaTimestamp(data json.RawMessage) (*TextEntityTypeMediaTimestamp, error) {
+	var resp TextEntityTypeMediaTimestamp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSchedulingStateSendAtDate(data json.RawMessage) (*MessageSchedulingStateSendAtDate, error) {
+	var resp MessageSchedulingStateSendAtDate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSchedulingStateSendWhenOnline(data json.RawMessage) (*MessageSchedulingStateSendWhenOnline, error) {
+	var resp MessageSchedulingStateSendWhenOnline
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSchedulingStateSendWhenVideoProcessed(data json.RawMessage) (*MessageSchedulingStateSendWhenVideoProcessed, error) {
+	var resp MessageSchedulingStateSendWhenVideoProcessed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSelfDestructTypeTimer(data json.RawMessage) (*MessageSelfDestructTypeTimer, error) {
+	var resp MessageSelfDestructTypeTimer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSelfDestructTypeImmediately(data json.RawMessage) (*MessageSelfDestructTypeImmediately, error) {
+	var resp MessageSelfDestructTypeImmediately
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageSendOptions(data json.RawMessage) (*MessageSendOptions, error) {
+	var resp MessageSendOptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageCopyOptions(data json.RawMessage) (*MessageCopyOptions, error) {
+	var resp MessageCopyOptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageText(data json.RawMessage) (*InputMessageText, error) {
+	var resp InputMessageText
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageAnimation(data json.RawMessage) (*InputMessageAnimation, error) {
+	var resp InputMessageAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageAudio(data json.RawMessage) (*InputMessageAudio, error) {
+	var resp InputMessageAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageDocument(data json.RawMessage) (*InputMessageDocument, error) {
+	var resp InputMessageDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessagePaidMedia(data json.RawMessage) (*InputMessagePaidMedia, error) {
+	var resp InputMessagePaidMedia
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessagePhoto(data json.RawMessage) (*InputMessagePhoto, error) {
+	var resp InputMessagePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageSticker(data json.RawMessage) (*InputMessageSticker, error) {
+	var resp InputMessageSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageVideo(data json.RawMessage) (*InputMessageVideo, error) {
+	var resp InputMessageVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageVideoNote(data json.RawMessage) (*InputMessageVideoNote, error) {
+	var resp InputMessageVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageVoiceNote(data json.RawMessage) (*InputMessageVoiceNote, error) {
+	var resp InputMessageVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageLocation(data json.RawMessage) (*InputMessageLocation, error) {
+	var resp InputMessageLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageVenue(data json.RawMessage) (*InputMessageVenue, error) {
+	var resp InputMessageVenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageContact(data json.RawMessage) (*InputMessageContact, error) {
+	var resp InputMessageContact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageDice(data json.RawMessage) (*InputMessageDice, error) {
+	var resp InputMessageDice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageGame(data json.RawMessage) (*InputMessageGame, error) {
+	var resp InputMessageGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageInvoice(data json.RawMessage) (*InputMessageInvoice, error) {
+	var resp InputMessageInvoice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessagePoll(data json.RawMessage) (*InputMessagePoll, error) {
+	var resp InputMessagePoll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageStory(data json.RawMessage) (*InputMessageStory, error) {
+	var resp InputMessageStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputMessageForwarded(data json.RawMessage) (*InputMessageForwarded, error) {
+	var resp InputMessageForwarded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageProperties(data json.RawMessage) (*MessageProperties, error) {
+	var resp MessageProperties
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterEmpty(data json.RawMessage) (*SearchMessagesFilterEmpty, error) {
+	var resp SearchMessagesFilterEmpty
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterAnimation(data json.RawMessage) (*SearchMessagesFilterAnimation, error) {
+	var resp SearchMessagesFilterAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterAudio(data json.RawMessage) (*SearchMessagesFilterAudio, error) {
+	var resp SearchMessagesFilterAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterDocument(data json.RawMessage) (*SearchMessagesFilterDocument, error) {
+	var resp SearchMessagesFilterDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterPhoto(data json.RawMessage) (*SearchMessagesFilterPhoto, error) {
+	var resp SearchMessagesFilterPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterVideo(data json.RawMessage) (*SearchMessagesFilterVideo, error) {
+	var resp SearchMessagesFilterVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterVoiceNote(data json.RawMessage) (*SearchMessagesFilterVoiceNote, error) {
+	var resp SearchMessagesFilterVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterPhotoAndVideo(data json.RawMessage) (*SearchMessagesFilterPhotoAndVideo, error) {
+	var resp SearchMessagesFilterPhotoAndVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterUrl(data json.RawMessage) (*SearchMessagesFilterUrl, error) {
+	var resp SearchMessagesFilterUrl
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterChatPhoto(data json.RawMessage) (*SearchMessagesFilterChatPhoto, error) {
+	var resp SearchMessagesFilterChatPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterVideoNote(data json.RawMessage) (*SearchMessagesFilterVideoNote, error) {
+	var resp SearchMessagesFilterVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterVoiceAndVideoNote(data json.RawMessage) (*SearchMessagesFilterVoiceAndVideoNote, error) {
+	var resp SearchMessagesFilterVoiceAndVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterMention(data json.RawMessage) (*SearchMessagesFilterMention, error) {
+	var resp SearchMessagesFilterMention
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterUnreadMention(data json.RawMessage) (*SearchMessagesFilterUnreadMention, error) {
+	var resp SearchMessagesFilterUnreadMention
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterUnreadReaction(data json.RawMessage) (*SearchMessagesFilterUnreadReaction, error) {
+	var resp SearchMessagesFilterUnreadReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterFailedToSend(data json.RawMessage) (*SearchMessagesFilterFailedToSend, error) {
+	var resp SearchMessagesFilterFailedToSend
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesFilterPinned(data json.RawMessage) (*SearchMessagesFilterPinned, error) {
+	var resp SearchMessagesFilterPinned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesChatTypeFilterPrivate(data json.RawMessage) (*SearchMessagesChatTypeFilterPrivate, error) {
+	var resp SearchMessagesChatTypeFilterPrivate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesChatTypeFilterGroup(data json.RawMessage) (*SearchMessagesChatTypeFilterGroup, error) {
+	var resp SearchMessagesChatTypeFilterGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSearchMessagesChatTypeFilterChannel(data json.RawMessage) (*SearchMessagesChatTypeFilterChannel, error) {
+	var resp SearchMessagesChatTypeFilterChannel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalQuickReplyMessage(data json.RawMessage) (*QuickReplyMessage, error) {
+	var resp QuickReplyMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalQuickReplyMessages(data json.RawMessage) (*QuickReplyMessages, error) {
+	var resp QuickReplyMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalQuickReplyShortcut(data json.RawMessage) (*QuickReplyShortcut, error) {
+	var resp QuickReplyShortcut
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPublicForwardMessage(data json.RawMessage) (*PublicForwardMessage, error) {
+	var resp PublicForwardMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPublicForwardStory(data json.RawMessage) (*PublicForwardStory, error) {
+	var resp PublicForwardStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSentWebAppMessage(data json.RawMessage) (*SentWebAppMessage, error) {
+	var resp SentWebAppMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPreparedInlineMessageId(data json.RawMessage) (*PreparedInlineMessageId, error) {
+	var resp PreparedInlineMessageId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPreparedInlineMessage(data json.RawMessage) (*PreparedInlineMessage, error) {
+	var resp PreparedInlineMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageFileTypePrivate(data json.RawMessage) (*MessageFileTypePrivate, error) {
+	var resp MessageFileTypePrivate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageFileTypeGroup(data json.RawMessage) (*MessageFileTypeGroup, error) {
+	var resp MessageFileTypeGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageFileTypeUnknown(data json.RawMessage) (*MessageFileTypeUnknown, error) {
+	var resp MessageFileTypeUnknown
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentHidden(data json.RawMessage) (*PushMessageContentHidden, error) {
+	var resp PushMessageContentHidden
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentAnimation(data json.RawMessage) (*PushMessageContentAnimation, error) {
+	var resp PushMessageContentAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentAudio(data json.RawMessage) (*PushMessageContentAudio, error) {
+	var resp PushMessageContentAudio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentContact(data json.RawMessage) (*PushMessageContentContact, error) {
+	var resp PushMessageContentContact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentContactRegistered(data json.RawMessage) (*PushMessageContentContactRegistered, error) {
+	var resp PushMessageContentContactRegistered
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentDocument(data json.RawMessage) (*PushMessageContentDocument, error) {
+	var resp PushMessageContentDocument
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentGame(data json.RawMessage) (*PushMessageContentGame, error) {
+	var resp PushMessageContentGame
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentGameScore(data json.RawMessage) (*PushMessageContentGameScore, error) {
+	var resp PushMessageContentGameScore
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentInvoice(data json.RawMessage) (*PushMessageContentInvoice, error) {
+	var resp PushMessageContentInvoice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentLocation(data json.RawMessage) (*PushMessageContentLocation, error) {
+	var resp PushMessageContentLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentPaidMedia(data json.RawMessage) (*PushMessageContentPaidMedia, error) {
+	var resp PushMessageContentPaidMedia
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentPhoto(data json.RawMessage) (*PushMessageContentPhoto, error) {
+	var resp PushMessageContentPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentPoll(data json.RawMessage) (*PushMessageContentPoll, error) {
+	var resp PushMessageContentPoll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentPremiumGiftCode(data json.RawMessage) (*PushMessageContentPremiumGiftCode, error) {
+	var resp PushMessageContentPremiumGiftCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentGiveaway(data json.RawMessage) (*PushMessageContentGiveaway, error) {
+	var resp PushMessageContentGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentGift(data json.RawMessage) (*PushMessageContentGift, error) {
+	var resp PushMessageContentGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentUpgradedGift(data json.RawMessage) (*PushMessageContentUpgradedGift, error) {
+	var resp PushMessageContentUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentScreenshotTaken(data json.RawMessage) (*PushMessageContentScreenshotTaken, error) {
+	var resp PushMessageContentScreenshotTaken
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentSticker(data json.RawMessage) (*PushMessageContentSticker, error) {
+	var resp PushMessageContentSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentStory(data json.RawMessage) (*PushMessageContentStory, error) {
+	var resp PushMessageContentStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentText(data json.RawMessage) (*PushMessageContentText, error) {
+	var resp PushMessageContentText
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentVideo(data json.RawMessage) (*PushMessageContentVideo, error) {
+	var resp PushMessageContentVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentVideoNote(data json.RawMessage) (*PushMessageContentVideoNote, error) {
+	var resp PushMessageContentVideoNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentVoiceNote(data json.RawMessage) (*PushMessageContentVoiceNote, error) {
+	var resp PushMessageContentVoiceNote
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentBasicGroupChatCreate(data json.RawMessage) (*PushMessageContentBasicGroupChatCreate, error) {
+	var resp PushMessageContentBasicGroupChatCreate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentVideoChatStarted(data json.RawMessage) (*PushMessageContentVideoChatStarted, error) {
+	var resp PushMessageContentVideoChatStarted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentVideoChatEnded(data json.RawMessage) (*PushMessageContentVideoChatEnded, error) {
+	var resp PushMessageContentVideoChatEnded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentInviteVideoChatParticipants(data json.RawMessage) (*PushMessageContentInviteVideoChatParticipants, error) {
+	var resp PushMessageContentInviteVideoChatParticipants
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatAddMembers(data json.RawMessage) (*PushMessageContentChatAddMembers, error) {
+	var resp PushMessageContentChatAddMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatChangePhoto(data json.RawMessage) (*PushMessageContentChatChangePhoto, error) {
+	var resp PushMessageContentChatChangePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatChangeTitle(data json.RawMessage) (*PushMessageContentChatChangeTitle, error) {
+	var resp PushMessageContentChatChangeTitle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatSetBackground(data json.RawMessage) (*PushMessageContentChatSetBackground, error) {
+	var resp PushMessageContentChatSetBackground
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatSetTheme(data json.RawMessage) (*PushMessageContentChatSetTheme, error) {
+	var resp PushMessageContentChatSetTheme
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatDeleteMember(data json.RawMessage) (*PushMessageContentChatDeleteMember, error) {
+	var resp PushMessageContentChatDeleteMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatJoinByLink(data json.RawMessage) (*PushMessageContentChatJoinByLink, error) {
+	var resp PushMessageContentChatJoinByLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentChatJoinByRequest(data json.RawMessage) (*PushMessageContentChatJoinByRequest, error) {
+	var resp PushMessageContentChatJoinByRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentRecurringPayment(data json.RawMessage) (*PushMessageContentRecurringPayment, error) {
+	var resp PushMessageContentRecurringPayment
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentSuggestProfilePhoto(data json.RawMessage) (*PushMessageContentSuggestProfilePhoto, error) {
+	var resp PushMessageContentSuggestProfilePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentProximityAlertTriggered(data json.RawMessage) (*PushMessageContentProximityAlertTriggered, error) {
+	var resp PushMessageContentProximityAlertTriggered
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentMessageForwards(data json.RawMessage) (*PushMessageContentMessageForwards, error) {
+	var resp PushMessageContentMessageForwards
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPushMessageContentMediaAlbum(data json.RawMessage) (*PushMessageContentMediaAlbum, error) {
+	var resp PushMessageContentMediaAlbum
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendMessageToUserResultOk(data json.RawMessage) (*CanSendMessageToUserResultOk, error) {
+	var resp CanSendMessageToUserResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendMessageToUserResultUserHasPaidMessages(data json.RawMessage) (*CanSendMessageToUserResultUserHasPaidMessages, error) {
+	var resp CanSendMessageToUserResultUserHasPaidMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendMessageToUserResultUserIsDeleted(data json.RawMessage) (*CanSendMessageToUserResultUserIsDeleted, error) {
+	var resp CanSendMessageToUserResultUserIsDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendMessageToUserResultUserRestrictsNewChats(data json.RawMessage) (*CanSendMessageToUserResultUserRestrictsNewChats, error) {
+	var resp CanSendMessageToUserResultUserRestrictsNewChats
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageAutoDeleteTime(data json.RawMessage) (*MessageAutoDeleteTime, error) {
+	var resp MessageAutoDeleteTime
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageLink(data json.RawMessage) (*MessageLink, error) {
+	var resp MessageLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageLinkInfo(data json.RawMessage) (*MessageLinkInfo, error) {
+	var resp MessageLinkInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalText(data json.RawMessage) (*Text, error) {
+	var resp Text
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextParseModeMarkdown(data json.RawMessage) (*TextParseModeMarkdown, error) {
+	var resp TextParseModeMarkdown
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTextParseModeHTML(data json.RawMessage) (*TextParseModeHTML, error) {
+	var resp TextParseModeHTML
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatStatisticsMessageSenderInfo(data json.RawMessage) (*ChatStatisticsMessageSenderInfo, error) {
+	var resp ChatStatisticsMessageSenderInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageStatistics(data json.RawMessage) (*MessageStatistics, error) {
+	var resp MessageStatistics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
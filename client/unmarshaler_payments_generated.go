@@ -0,0 +1,2769 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalStarSubscriptionType(data json.RawMessage) (StarSubscriptionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStarSubscriptionType)
+}
+
+func decodeStarSubscriptionType(decoder *jsonDecoder) (StarSubscriptionType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStarSubscriptionTypeChannel:
+		return decodeEntity[StarSubscriptionTypeChannel](decoder)
+
+	case ConstructorStarSubscriptionTypeBot:
+		return decodeEntity[StarSubscriptionTypeBot](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStarSubscriptionType(dataList []json.RawMessage) ([]StarSubscriptionType, error) {
+	list := make([]StarSubscriptionType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStarSubscriptionType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalAffiliateType(data json.RawMessage) (AffiliateType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeAffiliateType)
+}
+
+func decodeAffiliateType(decoder *jsonDecoder) (AffiliateType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorAffiliateTypeCurrentUser:
+		return decodeEntity[AffiliateTypeCurrentUser](decoder)
+
+	case ConstructorAffiliateTypeBot:
+		return decodeEntity[AffiliateTypeBot](decoder)
+
+	case ConstructorAffiliateTypeChannel:
+		return decodeEntity[AffiliateTypeChannel](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfAffiliateType(dataList []json.RawMessage) ([]AffiliateType, error) {
+	list := make([]AffiliateType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalAffiliateType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalAffiliateProgramSortOrder(data json.RawMessage) (AffiliateProgramSortOrder, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeAffiliateProgramSortOrder)
+}
+
+func decodeAffiliateProgramSortOrder(decoder *jsonDecoder) (AffiliateProgramSortOrder, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorAffiliateProgramSortOrderProfitability:
+		return decodeEntity[AffiliateProgramSortOrderProfitability](decoder)
+
+	case ConstructorAffiliateProgramSortOrderCreationDate:
+		return decodeEntity[AffiliateProgramSortOrderCreationDate](decoder)
+
+	case ConstructorAffiliateProgramSortOrderRevenue:
+		return decodeEntity[AffiliateProgramSortOrderRevenue](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfAffiliateProgramSortOrder(dataList []json.RawMessage) ([]AffiliateProgramSortOrder, error) {
+	list := make([]AffiliateProgramSortOrder, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalAffiliateProgramSortOrder(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalSentGift(data json.RawMessage) (SentGift, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeSentGift)
+}
+
+func decodeSentGift(decoder *jsonDecoder) (SentGift, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorSentGiftRegular:
+		return decodeEntity[SentGiftRegular](decoder)
+
+	case ConstructorSentGiftUpgraded:
+		return decodeEntity[SentGiftUpgraded](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfSentGift(dataList []json.RawMessage) ([]SentGift, error) {
+	list := make([]SentGift, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalSentGift(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStarTransactionDirection(data json.RawMessage) (StarTransactionDirection, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStarTransactionDirection)
+}
+
+func decodeStarTransactionDirection(decoder *jsonDecoder) (StarTransactionDirection, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStarTransactionDirectionIncoming:
+		return decodeEntity[StarTransactionDirectionIncoming](decoder)
+
+	case ConstructorStarTransactionDirectionOutgoing:
+		return decodeEntity[StarTransactionDirectionOutgoing](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStarTransactionDirection(dataList []json.RawMessage) ([]StarTransactionDirection, error) {
+	list := make([]StarTransactionDirection, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStarTransactionDirection(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStarTransactionType(data json.RawMessage) (StarTransactionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStarTransactionType)
+}
+
+func decodeStarTransactionType(decoder *jsonDecoder) (StarTransactionType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStarTransactionTypePremiumBotDeposit:
+		return decodeEntity[StarTransactionTypePremiumBotDeposit](decoder)
+
+	case ConstructorStarTransactionTypeAppStoreDeposit:
+		return decodeEntity[StarTransactionTypeAppStoreDeposit](decoder)
+
+	case ConstructorStarTransactionTypeGooglePlayDeposit:
+		return decodeEntity[StarTransactionTypeGooglePlayDeposit](decoder)
+
+	case ConstructorStarTransactionTypeFragmentDeposit:
+		return decodeEntity[StarTransactionTypeFragmentDeposit](decoder)
+
+	case ConstructorStarTransactionTypeUserDeposit:
+		return decodeEntity[StarTransactionTypeUserDeposit](decoder)
+
+	case ConstructorStarTransactionTypeGiveawayDeposit:
+		return decodeEntity[StarTransactionTypeGiveawayDeposit](decoder)
+
+	case ConstructorStarTransactionTypeFragmentWithdrawal:
+		return decodeEntity[StarTransactionTypeFragmentWithdrawal](decoder)
+
+	case ConstructorStarTransactionTypeTelegramAdsWithdrawal:
+		return decodeEntity[StarTransactionTypeTelegramAdsWithdrawal](decoder)
+
+	case ConstructorStarTransactionTypeTelegramApiUsage:
+		return decodeEntity[StarTransactionTypeTelegramApiUsage](decoder)
+
+	case ConstructorStarTransactionTypeBotPaidMediaPurchase:
+		return decodeEntity[StarTransactionTypeBotPaidMediaPurchase](decoder)
+
+	case ConstructorStarTransactionTypeBotPaidMediaSale:
+		return decodeEntity[StarTransactionTypeBotPaidMediaSale](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidMediaPurchase:
+		return decodeEntity[StarTransactionTypeChannelPaidMediaPurchase](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidMediaSale:
+		return decodeEntity[StarTransactionTypeChannelPaidMediaSale](decoder)
+
+	case ConstructorStarTransactionTypeBotInvoicePurchase:
+		return decodeEntity[StarTransactionTypeBotInvoicePurchase](decoder)
+
+	case ConstructorStarTransactionTypeBotInvoiceSale:
+		return decodeEntity[StarTransactionTypeBotInvoiceSale](decoder)
+
+	case ConstructorStarTransactionTypeBotSubscriptionPurchase:
+		return decodeEntity[StarTransactionTypeBotSubscriptionPurchase](decoder)
+
+	case ConstructorStarTransactionTypeBotSubscriptionSale:
+		return decodeEntity[StarTransactionTypeBotSubscriptionSale](decoder)
+
+	case ConstructorStarTransactionTypeChannelSubscriptionPurchase:
+		return decodeEntity[StarTransactionTypeChannelSubscriptionPurchase](decoder)
+
+	case ConstructorStarTransactionTypeChannelSubscriptionSale:
+		return decodeEntity[StarTransactionTypeChannelSubscriptionSale](decoder)
+
+	case ConstructorStarTransactionTypeGiftPurchase:
+		return decodeEntity[StarTransactionTypeGiftPurchase](decoder)
+
+	case ConstructorStarTransactionTypeGiftTransfer:
+		return decodeEntity[StarTransactionTypeGiftTransfer](decoder)
+
+	case ConstructorStarTransactionTypeGiftSale:
+		return decodeEntity[StarTransactionTypeGiftSale](decoder)
+
+	case ConstructorStarTransactionTypeGiftUpgrade:
+		return decodeEntity[StarTransactionTypeGiftUpgrade](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidReactionSend:
+		return decodeEntity[StarTransactionTypeChannelPaidReactionSend](decoder)
+
+	case ConstructorStarTransactionTypeChannelPaidReactionReceive:
+		return decodeEntity[StarTransactionTypeChannelPaidReactionReceive](decoder)
+
+	case ConstructorStarTransactionTypeAffiliateProgramCommission:
+		return decodeEntity[StarTransactionTypeAffiliateProgramCommission](decoder)
+
+	case ConstructorStarTransactionTypePaidMessageSend:
+		return decodeEntity[StarTransactionTypePaidMessageSend](decoder)
+
+	case ConstructorStarTransactionTypePaidMessageReceive:
+		return decodeEntity[StarTransactionTypePaidMessageReceive](decoder)
+
+	case ConstructorStarTransactionTypePremiumPurchase:
+		return decodeEntity[StarTransactionTypePremiumPurchase](decoder)
+
+	case ConstructorStarTransactionTypeBusinessBotTransferSend:
+		return decodeEntity[StarTransactionTypeBusinessBotTransferSend](decoder)
+
+	case ConstructorStarTransactionTypeBusinessBotTransferReceive:
+		return decodeEntity[StarTransactionTypeBusinessBotTransferReceive](decoder)
+
+	case ConstructorStarTransactionTypeUnsupported:
+		return decodeEntity[StarTransactionTypeUnsupported](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStarTransactionType(dataList []json.RawMessage) ([]StarTransactionType, error) {
+	list := make([]StarTransactionType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStarTransactionType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalGiveawayParticipantStatus(data json.RawMessage) (GiveawayParticipantStatus, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeGiveawayParticipantStatus)
+}
+
+func decodeGiveawayParticipantStatus(decoder *jsonDecoder) (GiveawayParticipantStatus, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorGiveawayParticipantStatusEligible:
+		return decodeEntity[GiveawayParticipantStatusEligible](decoder)
+
+	case ConstructorGiveawayParticipantStatusParticipating:
+		return decodeEntity[GiveawayParticipantStatusParticipating](decoder)
+
+	case ConstructorGiveawayParticipantStatusAlreadyWasMember:
+		return decodeEntity[GiveawayParticipantStatusAlreadyWasMember](decoder)
+
+	case ConstructorGiveawayParticipantStatusAdministrator:
+		return decodeEntity[GiveawayParticipantStatusAdministrator](decoder)
+
+	case ConstructorGiveawayParticipantStatusDisallowedCountry:
+		return decodeEntity[GiveawayParticipantStatusDisallowedCountry](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfGiveawayParticipantStatus(dataList []json.RawMessage) ([]GiveawayParticipantStatus, error) {
+	list := make([]GiveawayParticipantStatus, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalGiveawayParticipantStatus(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalGiveawayInfo(data json.RawMessage) (GiveawayInfo, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeGiveawayInfo)
+}
+
+func decodeGiveawayInfo(decoder *jsonDecoder) (GiveawayInfo, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorGiveawayInfoOngoing:
+		return decodeEntity[GiveawayInfoOngoing](decoder)
+
+	case ConstructorGiveawayInfoCompleted:
+		return decodeEntity[GiveawayInfoCompleted](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfGiveawayInfo(dataList []json.RawMessage) ([]GiveawayInfo, error) {
+	list := make([]GiveawayInfo, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalGiveawayInfo(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalGiveawayPrize(data json.RawMessage) (GiveawayPrize, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeGiveawayPrize)
+}
+
+func decodeGiveawayPrize(decoder *jsonDecoder) (GiveawayPrize, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorGiveawayPrizePremium:
+		return decodeEntity[GiveawayPrizePremium](decoder)
+
+	case ConstructorGiveawayPrizeStars:
+		return decodeEntity[GiveawayPrizeStars](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfGiveawayPrize(dataList []json.RawMessage) ([]GiveawayPrize, error) {
+	list := make([]GiveawayPrize, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalGiveawayPrize(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPaidReactionType(data json.RawMessage) (PaidReactionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePaidReactionType)
+}
+
+func decodePaidReactionType(decoder *jsonDecoder) (PaidReactionType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPaidReactionTypeRegular:
+		return decodeEntity[PaidReactionTypeRegular](decoder)
+
+	case ConstructorPaidReactionTypeAnonymous:
+		return decodeEntity[PaidReactionTypeAnonymous](decoder)
+
+	case ConstructorPaidReactionTypeChat:
+		return decodeEntity[PaidReactionTypeChat](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPaidReactionType(dataList []json.RawMessage) ([]PaidReactionType, error) {
+	list := make([]PaidReactionType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPaidReactionType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPaymentProvider(data json.RawMessage) (PaymentProvider, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePaymentProvider)
+}
+
+func decodePaymentProvider(decoder *jsonDecoder) (PaymentProvider, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPaymentProviderSmartGlocal:
+		return decodeEntity[PaymentProviderSmartGlocal](decoder)
+
+	case ConstructorPaymentProviderStripe:
+		return decodeEntity[PaymentProviderStripe](decoder)
+
+	case ConstructorPaymentProviderOther:
+		return decodeEntity[PaymentProviderOther](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPaymentProvider(dataList []json.RawMessage) ([]PaymentProvider, error) {
+	list := make([]PaymentProvider, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPaymentProvider(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPaymentFormType(data json.RawMessage) (PaymentFormType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePaymentFormType)
+}
+
+func decodePaymentFormType(decoder *jsonDecoder) (PaymentFormType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPaymentFormTypeRegular:
+		return decodeEntity[PaymentFormTypeRegular](decoder)
+
+	case ConstructorPaymentFormTypeStars:
+		return decodeEntity[PaymentFormTypeStars](decoder)
+
+	case ConstructorPaymentFormTypeStarSubscription:
+		return decodeEntity[PaymentFormTypeStarSubscription](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPaymentFormType(dataList []json.RawMessage) ([]PaymentFormType, error) {
+	list := make([]PaymentFormType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPaymentFormType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPaymentReceiptType(data json.RawMessage) (PaymentReceiptType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePaymentReceiptType)
+}
+
+func decodePaymentReceiptType(decoder *jsonDecoder) (PaymentReceiptType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPaymentReceiptTypeRegular:
+		return decodeEntity[PaymentReceiptTypeRegular](decoder)
+
+	case ConstructorPaymentReceiptTypeStars:
+		return decodeEntity[PaymentReceiptTypeStars](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPaymentReceiptType(dataList []json.RawMessage) ([]PaymentReceiptType, error) {
+	list := make([]PaymentReceiptType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPaymentReceiptType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputInvoice(data json.RawMessage) (InputInvoice, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputInvoice)
+}
+
+func decodeInputInvoice(decoder *jsonDecoder) (InputInvoice, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputInvoiceMessage:
+		return decodeEntity[InputInvoiceMessage](decoder)
+
+	case ConstructorInputInvoiceName:
+		return decodeEntity[InputInvoiceName](decoder)
+
+	case ConstructorInputInvoiceTelegram:
+		return decodeEntity[InputInvoiceTelegram](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputInvoice(dataList []json.RawMessage) ([]InputInvoice, error) {
+	list := make([]InputInvoice, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputInvoice(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPaidMedia(data json.RawMessage) (PaidMedia, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePaidMedia)
+}
+
+func decodePaidMedia(decoder *jsonDecoder) (PaidMedia, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPaidMediaPreview:
+		return decodeEntity[PaidMediaPreview](decoder)
+
+	case ConstructorPaidMediaPhoto:
+		return decodeEntity[PaidMediaPhoto](decoder)
+
+	case ConstructorPaidMediaVideo:
+		return decodeEntity[PaidMediaVideo](decoder)
+
+	case ConstructorPaidMediaUnsupported:
+		return decodeEntity[PaidMediaUnsupported](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPaidMedia(dataList []json.RawMessage) ([]PaidMedia, error) {
+	list := make([]PaidMedia, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPaidMedia(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputPaidMediaType(data json.RawMessage) (InputPaidMediaType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputPaidMediaType)
+}
+
+func decodeInputPaidMediaType(decoder *jsonDecoder) (InputPaidMediaType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputPaidMediaTypePhoto:
+		return decodeEntity[InputPaidMediaTypePhoto](decoder)
+
+	case ConstructorInputPaidMediaTypeVideo:
+		return decodeEntity[InputPaidMediaTypeVideo](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputPaidMediaType(dataList []json.RawMessage) ([]InputPaidMediaType, error) {
+	list := make([]InputPaidMediaType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputPaidMediaType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatBoostSource(data json.RawMessage) (ChatBoostSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatBoostSource)
+}
+
+func decodeChatBoostSource(decoder *jsonDecoder) (ChatBoostSource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatBoostSourceGiftCode:
+		return decodeEntity[ChatBoostSourceGiftCode](decoder)
+
+	case ConstructorChatBoostSourceGiveaway:
+		return decodeEntity[ChatBoostSourceGiveaway](decoder)
+
+	case ConstructorChatBoostSourcePremium:
+		return decodeEntity[ChatBoostSourcePremium](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatBoostSource(dataList []json.RawMessage) ([]ChatBoostSource, error) {
+	list := make([]ChatBoostSource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatBoostSource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPremiumLimitType(data json.RawMessage) (PremiumLimitType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePremiumLimitType)
+}
+
+func decodePremiumLimitType(decoder *jsonDecoder) (PremiumLimitType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPremiumLimitTypeSupergroupCount:
+		return decodeEntity[PremiumLimitTypeSupergroupCount](decoder)
+
+	case ConstructorPremiumLimitTypePinnedChatCount:
+		return decodeEntity[PremiumLimitTypePinnedChatCount](decoder)
+
+	case ConstructorPremiumLimitTypeCreatedPublicChatCount:
+		return decodeEntity[PremiumLimitTypeCreatedPublicChatCount](decoder)
+
+	case ConstructorPremiumLimitTypeSavedAnimationCount:
+		return decodeEntity[PremiumLimitTypeSavedAnimationCount](decoder)
+
+	case ConstructorPremiumLimitTypeFavoriteStickerCount:
+		return decodeEntity[PremiumLimitTypeFavoriteStickerCount](decoder)
+
+	case ConstructorPremiumLimitTypeChatFolderCount:
+		return decodeEntity[PremiumLimitTypeChatFolderCount](decoder)
+
+	case ConstructorPremiumLimitTypeChatFolderChosenChatCount:
+		return decodeEntity[PremiumLimitTypeChatFolderChosenChatCount](decoder)
+
+	case ConstructorPremiumLimitTypePinnedArchivedChatCount:
+		return decodeEntity[PremiumLimitTypePinnedArchivedChatCount](decoder)
+
+	case ConstructorPremiumLimitTypePinnedSavedMessagesTopicCount:
+		return decodeEntity[PremiumLimitTypePinnedSavedMessagesTopicCount](decoder)
+
+	case ConstructorPremiumLimitTypeCaptionLength:
+		return decodeEntity[PremiumLimitTypeCaptionLength](decoder)
+
+	case ConstructorPremiumLimitTypeBioLength:
+		return decodeEntity[PremiumLimitTypeBioLength](decoder)
+
+	case ConstructorPremiumLimitTypeChatFolderInviteLinkCount:
+		return decodeEntity[PremiumLimitTypeChatFolderInviteLinkCount](decoder)
+
+	case ConstructorPremiumLimitTypeShareableChatFolderCount:
+		return decodeEntity[PremiumLimitTypeShareableChatFolderCount](decoder)
+
+	case ConstructorPremiumLimitTypeActiveStoryCount:
+		return decodeEntity[PremiumLimitTypeActiveStoryCount](decoder)
+
+	case ConstructorPremiumLimitTypeWeeklySentStoryCount:
+		return decodeEntity[PremiumLimitTypeWeeklySentStoryCount](decoder)
+
+	case ConstructorPremiumLimitTypeMonthlySentStoryCount:
+		return decodeEntity[PremiumLimitTypeMonthlySentStoryCount](decoder)
+
+	case ConstructorPremiumLimitTypeStoryCaptionLength:
+		return decodeEntity[PremiumLimitTypeStoryCaptionLength](decoder)
+
+	case ConstructorPremiumLimitTypeStorySuggestedReactionAreaCount:
+		return decodeEntity[PremiumLimitTypeStorySuggestedReactionAreaCount](decoder)
+
+	case ConstructorPremiumLimitTypeSimilarChatCount:
+		return decodeEntity[PremiumLimitTypeSimilarChatCount](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPremiumLimitType(dataList []json.RawMessage) ([]PremiumLimitType, error) {
+	list := make([]PremiumLimitType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPremiumLimitType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPremiumFeature(data json.RawMessage) (PremiumFeature, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePremiumFeature)
+}
+
+func decodePremiumFeature(decoder *jsonDecoder) (PremiumFeature, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPremiumFeatureIncreasedLimits:
+		return decodeEntity[PremiumFeatureIncreasedLimits](decoder)
+
+	case ConstructorPremiumFeatureIncreasedUploadFileSize:
+		return decodeEntity[PremiumFeatureIncreasedUploadFileSize](decoder)
+
+	case ConstructorPremiumFeatureImprovedDownloadSpeed:
+		return decodeEntity[PremiumFeatureImprovedDownloadSpeed](decoder)
+
+	case ConstructorPremiumFeatureVoiceRecognition:
+		return decodeEntity[PremiumFeatureVoiceRecognition](decoder)
+
+	case ConstructorPremiumFeatureDisabledAds:
+		return decodeEntity[PremiumFeatureDisabledAds](decoder)
+
+	case ConstructorPremiumFeatureUniqueReactions:
+		return decodeEntity[PremiumFeatureUniqueReactions](decoder)
+
+	case ConstructorPremiumFeatureUniqueStickers:
+		return decodeEntity[PremiumFeatureUniqueStickers](decoder)
+
+	case ConstructorPremiumFeatureCustomEmoji:
+		return decodeEntity[PremiumFeatureCustomEmoji](decoder)
+
+	case ConstructorPremiumFeatureAdvancedChatManagement:
+		return decodeEntity[PremiumFeatureAdvancedChatManagement](decoder)
+
+	case ConstructorPremiumFeatureProfileBadge:
+		return decodeEntity[PremiumFeatureProfileBadge](decoder)
+
+	case ConstructorPremiumFeatureEmojiStatus:
+		return decodeEntity[PremiumFeatureEmojiStatus](decoder)
+
+	case ConstructorPremiumFeatureAnimatedProfilePhoto:
+		return decodeEntity[PremiumFeatureAnimatedProfilePhoto](decoder)
+
+	case ConstructorPremiumFeatureForumTopicIcon:
+		return decodeEntity[PremiumFeatureForumTopicIcon](decoder)
+
+	case ConstructorPremiumFeatureAppIcons:
+		return decodeEntity[PremiumFeatureAppIcons](decoder)
+
+	case ConstructorPremiumFeatureRealTimeChatTranslation:
+		return decodeEntity[PremiumFeatureRealTimeChatTranslation](decoder)
+
+	case ConstructorPremiumFeatureUpgradedStories:
+		return decodeEntity[PremiumFeatureUpgradedStories](decoder)
+
+	case ConstructorPremiumFeatureChatBoost:
+		return decodeEntity[PremiumFeatureChatBoost](decoder)
+
+	case ConstructorPremiumFeatureAccentColor:
+		return decodeEntity[PremiumFeatureAccentColor](decoder)
+
+	case ConstructorPremiumFeatureBackgroundForBoth:
+		return decodeEntity[PremiumFeatureBackgroundForBoth](decoder)
+
+	case ConstructorPremiumFeatureSavedMessagesTags:
+		return decodeEntity[PremiumFeatureSavedMessagesTags](decoder)
+
+	case ConstructorPremiumFeatureMessagePrivacy:
+		return decodeEntity[PremiumFeatureMessagePrivacy](decoder)
+
+	case ConstructorPremiumFeatureLastSeenTimes:
+		return decodeEntity[PremiumFeatureLastSeenTimes](decoder)
+
+	case ConstructorPremiumFeatureBusiness:
+		return decodeEntity[PremiumFeatureBusiness](decoder)
+
+	case ConstructorPremiumFeatureMessageEffects:
+		return decodeEntity[PremiumFeatureMessageEffects](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPremiumFeature(dataList []json.RawMessage) ([]PremiumFeature, error) {
+	list := make([]PremiumFeature, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPremiumFeature(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPremiumStoryFeature(data json.RawMessage) (PremiumStoryFeature, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePremiumStoryFeature)
+}
+
+func decodePremiumStoryFeature(decoder *jsonDecoder) (PremiumStoryFeature, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPremiumStoryFeaturePriorityOrder:
+		return decodeEntity[PremiumStoryFeaturePriorityOrder](decoder)
+
+	case ConstructorPremiumStoryFeatureStealthMode:
+		return decodeEntity[PremiumStoryFeatureStealthMode](decoder)
+
+	case ConstructorPremiumStoryFeaturePermanentViewsHistory:
+		return decodeEntity[PremiumStoryFeaturePermanentViewsHistory](decoder)
+
+	case ConstructorPremiumStoryFeatureCustomExpirationDuration:
+		return decodeEntity[PremiumStoryFeatureCustomExpirationDuration](decoder)
+
+	case ConstructorPremiumStoryFeatureSaveStories:
+		return decodeEntity[PremiumStoryFeatureSaveStories](decoder)
+
+	case ConstructorPremiumStoryFeatureLinksAndFormatting:
+		return decodeEntity[PremiumStoryFeatureLinksAndFormatting](decoder)
+
+	case ConstructorPremiumStoryFeatureVideoQuality:
+		return decodeEntity[PremiumStoryFeatureVideoQuality](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPremiumStoryFeature(dataList []json.RawMessage) ([]PremiumStoryFeature, error) {
+	list := make([]PremiumStoryFeature, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPremiumStoryFeature(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalPremiumSource(data json.RawMessage) (PremiumSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodePremiumSource)
+}
+
+func decodePremiumSource(decoder *jsonDecoder) (PremiumSource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorPremiumSourceLimitExceeded:
+		return decodeEntity[PremiumSourceLimitExceeded](decoder)
+
+	case ConstructorPremiumSourceFeature:
+		return decodeEntity[PremiumSourceFeature](decoder)
+
+	case ConstructorPremiumSourceBusinessFeature:
+		return decodeEntity[PremiumSourceBusinessFeature](decoder)
+
+	case ConstructorPremiumSourceStoryFeature:
+		return decodeEntity[PremiumSourceStoryFeature](decoder)
+
+	case ConstructorPremiumSourceLink:
+		return decodeEntity[PremiumSourceLink](decoder)
+
+	case ConstructorPremiumSourceSettings:
+		return decodeEntity[PremiumSourceSettings](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfPremiumSource(dataList []json.RawMessage) ([]PremiumSource, error) {
+	list := make([]PremiumSource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalPremiumSource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStorePaymentPurpose(data json.RawMessage) (StorePaymentPurpose, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStorePaymentPurpose)
+}
+
+func decodeStorePaymentPurpose(decoder *jsonDecoder) (StorePaymentPurpose, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStorePaymentPurposePremiumSubscription:
+		return decodeEntity[StorePaymentPurposePremiumSubscription](decoder)
+
+	case ConstructorStorePaymentPurposePremiumGift:
+		return decodeEntity[StorePaymentPurposePremiumGift](decoder)
+
+	case ConstructorStorePaymentPurposePremiumGiftCodes:
+		return decodeEntity[StorePaymentPurposePremiumGiftCodes](decoder)
+
+	case ConstructorStorePaymentPurposePremiumGiveaway:
+		return decodeEntity[StorePaymentPurposePremiumGiveaway](decoder)
+
+	case ConstructorStorePaymentPurposeStarGiveaway:
+		return decodeEntity[StorePaymentPurposeStarGiveaway](decoder)
+
+	case ConstructorStorePaymentPurposeStars:
+		return decodeEntity[StorePaymentPurposeStars](decoder)
+
+	case ConstructorStorePaymentPurposeGiftedStars:
+		return decodeEntity[StorePaymentPurposeGiftedStars](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStorePaymentPurpose(dataList []json.RawMessage) ([]StorePaymentPurpose, error) {
+	list := make([]StorePaymentPurpose, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStorePaymentPurpose(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStoreTransaction(data json.RawMessage) (StoreTransaction, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStoreTransaction)
+}
+
+func decodeStoreTransaction(decoder *jsonDecoder) (StoreTransaction, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStoreTransactionAppStore:
+		return decodeEntity[StoreTransactionAppStore](decoder)
+
+	case ConstructorStoreTransactionGooglePlay:
+		return decodeEntity[StoreTransactionGooglePlay](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStoreTransaction(dataList []json.RawMessage) ([]StoreTransaction, error) {
+	list := make([]StoreTransaction, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStoreTransaction(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalTelegramPaymentPurpose(data json.RawMessage) (TelegramPaymentPurpose, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeTelegramPaymentPurpose)
+}
+
+func decodeTelegramPaymentPurpose(decoder *jsonDecoder) (TelegramPaymentPurpose, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorTelegramPaymentPurposePremiumGift:
+		return decodeEntity[TelegramPaymentPurposePremiumGift](decoder)
+
+	case ConstructorTelegramPaymentPurposePremiumGiftCodes:
+		return decodeEntity[TelegramPaymentPurposePremiumGiftCodes](decoder)
+
+	case ConstructorTelegramPaymentPurposePremiumGiveaway:
+		return decodeEntity[TelegramPaymentPurposePremiumGiveaway](decoder)
+
+	case ConstructorTelegramPaymentPurposeStars:
+		return decodeEntity[TelegramPaymentPurposeStars](decoder)
+
+	case ConstructorTelegramPaymentPurposeGiftedStars:
+		return decodeEntity[TelegramPaymentPurposeGiftedStars](decoder)
+
+	case ConstructorTelegramPaymentPurposeStarGiveaway:
+		return decodeEntity[TelegramPaymentPurposeStarGiveaway](decoder)
+
+	case ConstructorTelegramPaymentPurposeJoinChat:
+		return decodeEntity[TelegramPaymentPurposeJoinChat](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfTelegramPaymentPurpose(dataList []json.RawMessage) ([]TelegramPaymentPurpose, error) {
+	list := make([]TelegramPaymentPurpose, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalTelegramPaymentPurpose(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalRevenueWithdrawalState(data json.RawMessage) (RevenueWithdrawalState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeRevenueWithdrawalState)
+}
+
+func decodeRevenueWithdrawalState(decoder *jsonDecoder) (RevenueWithdrawalState, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorRevenueWithdrawalStatePending:
+		return decodeEntity[RevenueWithdrawalStatePending](decoder)
+
+	case ConstructorRevenueWithdrawalStateSucceeded:
+		return decodeEntity[RevenueWithdrawalStateSucceeded](decoder)
+
+	case ConstructorRevenueWithdrawalStateFailed:
+		return decodeEntity[RevenueWithdrawalStateFailed](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfRevenueWithdrawalState(dataList []json.RawMessage) ([]RevenueWithdrawalState, error) {
+	list := make([]RevenueWithdrawalState, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalRevenueWithdrawalState(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatRevenueTransactionType(data json.RawMessage) (ChatRevenueTransactionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatRevenueTransactionType)
+}
+
+func decodeChatRevenueTransactionType(decoder *jsonDecoder) (ChatRevenueTransactionType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatRevenueTransactionTypeEarnings:
+		return decodeEntity[ChatRevenueTransactionTypeEarnings](decoder)
+
+	case ConstructorChatRevenueTransactionTypeWithdrawal:
+		return decodeEntity[ChatRevenueTransactionTypeWithdrawal](decoder)
+
+	case ConstructorChatRevenueTransactionTypeRefund:
+		return decodeEntity[ChatRevenueTransactionTypeRefund](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatRevenueTransactionType(dataList []json.RawMessage) ([]ChatRevenueTransactionType, error) {
+	list := make([]ChatRevenueTransactionType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatRevenueTransactionType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStarAmount(data json.RawMessage) (*StarAmount, error) {
+	var resp StarAmount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarSubscriptionTypeChannel(data json.RawMessage) (*StarSubscriptionTypeChannel, error) {
+	var resp StarSubscriptionTypeChannel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarSubscriptionTypeBot(data json.RawMessage) (*StarSubscriptionTypeBot, error) {
+	var resp StarSubscriptionTypeBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarSubscriptionPricing(data json.RawMessage) (*StarSubscriptionPricing, error) {
+	var resp StarSubscriptionPricing
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarSubscription(data json.RawMessage) (*StarSubscription, error) {
+	var resp StarSubscription
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarSubscriptions(data json.RawMessage) (*StarSubscriptions, error) {
+	var resp StarSubscriptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateTypeCurrentUser(data json.RawMessage) (*AffiliateTypeCurrentUser, error) {
+	var resp AffiliateTypeCurrentUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateTypeBot(data json.RawMessage) (*AffiliateTypeBot, error) {
+	var resp AffiliateTypeBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateTypeChannel(data json.RawMessage) (*AffiliateTypeChannel, error) {
+	var resp AffiliateTypeChannel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateProgramSortOrderProfitability(data json.RawMessage) (*AffiliateProgramSortOrderProfitability, error) {
+	var resp AffiliateProgramSortOrderProfitability
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateProgramSortOrderCreationDate(data json.RawMessage) (*AffiliateProgramSortOrderCreationDate, error) {
+	var resp AffiliateProgramSortOrderCreationDate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateProgramSortOrderRevenue(data json.RawMessage) (*AffiliateProgramSortOrderRevenue, error) {
+	var resp AffiliateProgramSortOrderRevenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateProgramParameters(data json.RawMessage) (*AffiliateProgramParameters, error) {
+	var resp AffiliateProgramParameters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateProgramInfo(data json.RawMessage) (*AffiliateProgramInfo, error) {
+	var resp AffiliateProgramInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAffiliateInfo(data json.RawMessage) (*AffiliateInfo, error) {
+	var resp AffiliateInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundAffiliateProgram(data json.RawMessage) (*FoundAffiliateProgram, error) {
+	var resp FoundAffiliateProgram
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundAffiliatePrograms(data json.RawMessage) (*FoundAffiliatePrograms, error) {
+	var resp FoundAffiliatePrograms
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectedAffiliateProgram(data json.RawMessage) (*ConnectedAffiliateProgram, error) {
+	var resp ConnectedAffiliateProgram
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalConnectedAffiliatePrograms(data json.RawMessage) (*ConnectedAffiliatePrograms, error) {
+	var resp ConnectedAffiliatePrograms
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumPaymentOption(data json.RawMessage) (*PremiumPaymentOption, error) {
+	var resp PremiumPaymentOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStatePaymentOption(data json.RawMessage) (*PremiumStatePaymentOption, error) {
+	var resp PremiumStatePaymentOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumGiftPaymentOption(data json.RawMessage) (*PremiumGiftPaymentOption, error) {
+	var resp PremiumGiftPaymentOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumGiftPaymentOptions(data json.RawMessage) (*PremiumGiftPaymentOptions, error) {
+	var resp PremiumGiftPaymentOptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumGiveawayPaymentOption(data json.RawMessage) (*PremiumGiveawayPaymentOption, error) {
+	var resp PremiumGiveawayPaymentOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumGiveawayPaymentOptions(data json.RawMessage) (*PremiumGiveawayPaymentOptions, error) {
+	var resp PremiumGiveawayPaymentOptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumGiftCodeInfo(data json.RawMessage) (*PremiumGiftCodeInfo, error) {
+	var resp PremiumGiftCodeInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarPaymentOption(data json.RawMessage) (*StarPaymentOption, error) {
+	var resp StarPaymentOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarPaymentOptions(data json.RawMessage) (*StarPaymentOptions, error) {
+	var resp StarPaymentOptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarGiveawayWinnerOption(data json.RawMessage) (*StarGiveawayWinnerOption, error) {
+	var resp StarGiveawayWinnerOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarGiveawayPaymentOption(data json.RawMessage) (*StarGiveawayPaymentOption, error) {
+	var resp StarGiveawayPaymentOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarGiveawayPaymentOptions(data json.RawMessage) (*StarGiveawayPaymentOptions, error) {
+	var resp StarGiveawayPaymentOptions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAcceptedGiftTypes(data json.RawMessage) (*AcceptedGiftTypes, error) {
+	var resp AcceptedGiftTypes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiftSettings(data json.RawMessage) (*GiftSettings, error) {
+	var resp GiftSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpgradedGiftModel(data json.RawMessage) (*UpgradedGiftModel, error) {
+	var resp UpgradedGiftModel
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpgradedGiftSymbol(data json.RawMessage) (*UpgradedGiftSymbol, error) {
+	var resp UpgradedGiftSymbol
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpgradedGiftBackdropColors(data json.RawMessage) (*UpgradedGiftBackdropColors, error) {
+	var resp UpgradedGiftBackdropColors
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpgradedGiftBackdrop(data json.RawMessage) (*UpgradedGiftBackdrop, error) {
+	var resp UpgradedGiftBackdrop
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpgradedGiftOriginalDetails(data json.RawMessage) (*UpgradedGiftOriginalDetails, error) {
+	var resp UpgradedGiftOriginalDetails
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGift(data json.RawMessage) (*Gift, error) {
+	var resp Gift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGifts(data json.RawMessage) (*Gifts, error) {
+	var resp Gifts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpgradedGift(data json.RawMessage) (*UpgradedGift, error) {
+	var resp UpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpgradeGiftResult(data json.RawMessage) (*UpgradeGiftResult, error) {
+	var resp UpgradeGiftResult
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSentGiftRegular(data json.RawMessage) (*SentGiftRegular, error) {
+	var resp SentGiftRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSentGiftUpgraded(data json.RawMessage) (*SentGiftUpgraded, error) {
+	var resp SentGiftUpgraded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReceivedGift(data json.RawMessage) (*ReceivedGift, error) {
+	var resp ReceivedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReceivedGifts(data json.RawMessage) (*ReceivedGifts, error) {
+	var resp ReceivedGifts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiftUpgradePreview(data json.RawMessage) (*GiftUpgradePreview, error) {
+	var resp GiftUpgradePreview
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionDirectionIncoming(data json.RawMessage) (*StarTransactionDirectionIncoming, error) {
+	var resp StarTransactionDirectionIncoming
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionDirectionOutgoing(data json.RawMessage) (*StarTransactionDirectionOutgoing, error) {
+	var resp StarTransactionDirectionOutgoing
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypePremiumBotDeposit(data json.RawMessage) (*StarTransactionTypePremiumBotDeposit, error) {
+	var resp StarTransactionTypePremiumBotDeposit
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeAppStoreDeposit(data json.RawMessage) (*StarTransactionTypeAppStoreDeposit, error) {
+	var resp StarTransactionTypeAppStoreDeposit
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeGooglePlayDeposit(data json.RawMessage) (*StarTransactionTypeGooglePlayDeposit, error) {
+	var resp StarTransactionTypeGooglePlayDeposit
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeFragmentDeposit(data json.RawMessage) (*StarTransactionTypeFragmentDeposit, error) {
+	var resp StarTransactionTypeFragmentDeposit
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeUserDeposit(data json.RawMessage) (*StarTransactionTypeUserDeposit, error) {
+	var resp StarTransactionTypeUserDeposit
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeGiveawayDeposit(data json.RawMessage) (*StarTransactionTypeGiveawayDeposit, error) {
+	var resp StarTransactionTypeGiveawayDeposit
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeFragmentWithdrawal(data json.RawMessage) (*StarTransactionTypeFragmentWithdrawal, error) {
+	var resp StarTransactionTypeFragmentWithdrawal
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeTelegramAdsWithdrawal(data json.RawMessage) (*StarTransactionTypeTelegramAdsWithdrawal, error) {
+	var resp StarTransactionTypeTelegramAdsWithdrawal
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeTelegramApiUsage(data json.RawMessage) (*StarTransactionTypeTelegramApiUsage, error) {
+	var resp StarTransactionTypeTelegramApiUsage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBotPaidMediaPurchase(data json.RawMessage) (*StarTransactionTypeBotPaidMediaPurchase, error) {
+	var resp StarTransactionTypeBotPaidMediaPurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBotPaidMediaSale(data json.RawMessage) (*StarTransactionTypeBotPaidMediaSale, error) {
+	var resp StarTransactionTypeBotPaidMediaSale
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeChannelPaidMediaPurchase(data json.RawMessage) (*StarTransactionTypeChannelPaidMediaPurchase, error) {
+	var resp StarTransactionTypeChannelPaidMediaPurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeChannelPaidMediaSale(data json.RawMessage) (*StarTransactionTypeChannelPaidMediaSale, error) {
+	var resp StarTransactionTypeChannelPaidMediaSale
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBotInvoicePurchase(data json.RawMessage) (*StarTransactionTypeBotInvoicePurchase, error) {
+	var resp StarTransactionTypeBotInvoicePurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBotInvoiceSale(data json.RawMessage) (*StarTransactionTypeBotInvoiceSale, error) {
+	var resp StarTransactionTypeBotInvoiceSale
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBotSubscriptionPurchase(data json.RawMessage) (*StarTransactionTypeBotSubscriptionPurchase, error) {
+	var resp StarTransactionTypeBotSubscriptionPurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBotSubscriptionSale(data json.RawMessage) (*StarTransactionTypeBotSubscriptionSale, error) {
+	var resp StarTransactionTypeBotSubscriptionSale
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeChannelSubscriptionPurchase(data json.RawMessage) (*StarTransactionTypeChannelSubscriptionPurchase, error) {
+	var resp StarTransactionTypeChannelSubscriptionPurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeChannelSubscriptionSale(data json.RawMessage) (*StarTransactionTypeChannelSubscriptionSale, error) {
+	var resp StarTransactionTypeChannelSubscriptionSale
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeGiftPurchase(data json.RawMessage) (*StarTransactionTypeGiftPurchase, error) {
+	var resp StarTransactionTypeGiftPurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeGiftTransfer(data json.RawMessage) (*StarTransactionTypeGiftTransfer, error) {
+	var resp StarTransactionTypeGiftTransfer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeGiftSale(data json.RawMessage) (*StarTransactionTypeGiftSale, error) {
+	var resp StarTransactionTypeGiftSale
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeGiftUpgrade(data json.RawMessage) (*StarTransactionTypeGiftUpgrade, error) {
+	var resp StarTransactionTypeGiftUpgrade
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeChannelPaidReactionSend(data json.RawMessage) (*StarTransactionTypeChannelPaidReactionSend, error) {
+	var resp StarTransactionTypeChannelPaidReactionSend
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeChannelPaidReactionReceive(data json.RawMessage) (*StarTransactionTypeChannelPaidReactionReceive, error) {
+	var resp StarTransactionTypeChannelPaidReactionReceive
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeAffiliateProgramCommission(data json.RawMessage) (*StarTransactionTypeAffiliateProgramCommission, error) {
+	var resp StarTransactionTypeAffiliateProgramCommission
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypePaidMessageSend(data json.RawMessage) (*StarTransactionTypePaidMessageSend, error) {
+	var resp StarTransactionTypePaidMessageSend
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypePaidMessageReceive(data json.RawMessage) (*StarTransactionTypePaidMessageReceive, error) {
+	var resp StarTransactionTypePaidMessageReceive
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypePremiumPurchase(data json.RawMessage) (*StarTransactionTypePremiumPurchase, error) {
+	var resp StarTransactionTypePremiumPurchase
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBusinessBotTransferSend(data json.RawMessage) (*StarTransactionTypeBusinessBotTransferSend, error) {
+	var resp StarTransactionTypeBusinessBotTransferSend
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeBusinessBotTransferReceive(data json.RawMessage) (*StarTransactionTypeBusinessBotTransferReceive, error) {
+	var resp StarTransactionTypeBusinessBotTransferReceive
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactionTypeUnsupported(data json.RawMessage) (*StarTransactionTypeUnsupported, error) {
+	var resp StarTransactionTypeUnsupported
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransaction(data json.RawMessage) (*StarTransaction, error) {
+	var resp StarTransaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarTransactions(data json.RawMessage) (*StarTransactions, error) {
+	var resp StarTransactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayParticipantStatusEligible(data json.RawMessage) (*GiveawayParticipantStatusEligible, error) {
+	var resp GiveawayParticipantStatusEligible
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayParticipantStatusParticipating(data json.RawMessage) (*GiveawayParticipantStatusParticipating, error) {
+	var resp GiveawayParticipantStatusParticipating
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayParticipantStatusAlreadyWasMember(data json.RawMessage) (*GiveawayParticipantStatusAlreadyWasMember, error) {
+	var resp GiveawayParticipantStatusAlreadyWasMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayParticipantStatusAdministrator(data json.RawMessage) (*GiveawayParticipantStatusAdministrator, error) {
+	var resp GiveawayParticipantStatusAdministrator
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayParticipantStatusDisallowedCountry(data json.RawMessage) (*GiveawayParticipantStatusDisallowedCountry, error) {
+	var resp GiveawayParticipantStatusDisallowedCountry
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayInfoOngoing(data json.RawMessage) (*GiveawayInfoOngoing, error) {
+	var resp GiveawayInfoOngoing
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayInfoCompleted(data json.RawMessage) (*GiveawayInfoCompleted, error) {
+	var resp GiveawayInfoCompleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayPrizePremium(data json.RawMessage) (*GiveawayPrizePremium, error) {
+	var resp GiveawayPrizePremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayPrizeStars(data json.RawMessage) (*GiveawayPrizeStars, error) {
+	var resp GiveawayPrizeStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatInviteLinkSubscriptionInfo(data json.RawMessage) (*ChatInviteLinkSubscriptionInfo, error) {
+	var resp ChatInviteLinkSubscriptionInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidReactionTypeRegular(data json.RawMessage) (*PaidReactionTypeRegular, error) {
+	var resp PaidReactionTypeRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidReactionTypeAnonymous(data json.RawMessage) (*PaidReactionTypeAnonymous, error) {
+	var resp PaidReactionTypeAnonymous
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidReactionTypeChat(data json.RawMessage) (*PaidReactionTypeChat, error) {
+	var resp PaidReactionTypeChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidReactor(data json.RawMessage) (*PaidReactor, error) {
+	var resp PaidReactor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInvoice(data json.RawMessage) (*Invoice, error) {
+	var resp Invoice
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentProviderSmartGlocal(data json.RawMessage) (*PaymentProviderSmartGlocal, error) {
+	var resp PaymentProviderSmartGlocal
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentProviderStripe(data json.RawMessage) (*PaymentProviderStripe, error) {
+	var resp PaymentProviderStripe
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentProviderOther(data json.RawMessage) (*PaymentProviderOther, error) {
+	var resp PaymentProviderOther
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentOption(data json.RawMessage) (*PaymentOption, error) {
+	var resp PaymentOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentFormTypeRegular(data json.RawMessage) (*PaymentFormTypeRegular, error) {
+	var resp PaymentFormTypeRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentFormTypeStars(data json.RawMessage) (*PaymentFormTypeStars, error) {
+	var resp PaymentFormTypeStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentFormTypeStarSubscription(data json.RawMessage) (*PaymentFormTypeStarSubscription, error) {
+	var resp PaymentFormTypeStarSubscription
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentForm(data json.RawMessage) (*PaymentForm, error) {
+	var resp PaymentForm
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentResult(data json.RawMessage) (*PaymentResult, error) {
+	var resp PaymentResult
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentReceiptTypeRegular(data json.RawMessage) (*PaymentReceiptTypeRegular, error) {
+	var resp PaymentReceiptTypeRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentReceiptTypeStars(data json.RawMessage) (*PaymentReceiptTypeStars, error) {
+	var resp PaymentReceiptTypeStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaymentReceipt(data json.RawMessage) (*PaymentReceipt, error) {
+	var resp PaymentReceipt
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInvoiceMessage(data json.RawMessage) (*InputInvoiceMessage, error) {
+	var resp InputInvoiceMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInvoiceName(data json.RawMessage) (*InputInvoiceName, error) {
+	var resp InputInvoiceName
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputInvoiceTelegram(data json.RawMessage) (*InputInvoiceTelegram, error) {
+	var resp InputInvoiceTelegram
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidMediaPreview(data json.RawMessage) (*PaidMediaPreview, error) {
+	var resp PaidMediaPreview
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidMediaPhoto(data json.RawMessage) (*PaidMediaPhoto, error) {
+	var resp PaidMediaPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidMediaVideo(data json.RawMessage) (*PaidMediaVideo, error) {
+	var resp PaidMediaVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPaidMediaUnsupported(data json.RawMessage) (*PaidMediaUnsupported, error) {
+	var resp PaidMediaUnsupported
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalGiveawayParameters(data json.RawMessage) (*GiveawayParameters, error) {
+	var resp GiveawayParameters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPaidMediaTypePhoto(data json.RawMessage) (*InputPaidMediaTypePhoto, error) {
+	var resp InputPaidMediaTypePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPaidMediaTypeVideo(data json.RawMessage) (*InputPaidMediaTypeVideo, error) {
+	var resp InputPaidMediaTypeVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputPaidMedia(data json.RawMessage) (*InputPaidMedia, error) {
+	var resp InputPaidMedia
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostLevelFeatures(data json.RawMessage) (*ChatBoostLevelFeatures, error) {
+	var resp ChatBoostLevelFeatures
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostFeatures(data json.RawMessage) (*ChatBoostFeatures, error) {
+	var resp ChatBoostFeatures
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostSourceGiftCode(data json.RawMessage) (*ChatBoostSourceGiftCode, error) {
+	var resp ChatBoostSourceGiftCode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostSourceGiveaway(data json.RawMessage) (*ChatBoostSourceGiveaway, error) {
+	var resp ChatBoostSourceGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostSourcePremium(data json.RawMessage) (*ChatBoostSourcePremium, error) {
+	var resp ChatBoostSourcePremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPrepaidGiveaway(data json.RawMessage) (*PrepaidGiveaway, error) {
+	var resp PrepaidGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostStatus(data json.RawMessage) (*ChatBoostStatus, error) {
+	var resp ChatBoostStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoost(data json.RawMessage) (*ChatBoost, error) {
+	var resp ChatBoost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundChatBoosts(data json.RawMessage) (*FoundChatBoosts, error) {
+	var resp FoundChatBoosts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostSlot(data json.RawMessage) (*ChatBoostSlot, error) {
+	var resp ChatBoostSlot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostSlots(data json.RawMessage) (*ChatBoostSlots, error) {
+	var resp ChatBoostSlots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeSupergroupCount(data json.RawMessage) (*PremiumLimitTypeSupergroupCount, error) {
+	var resp PremiumLimitTypeSupergroupCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypePinnedChatCount(data json.RawMessage) (*PremiumLimitTypePinnedChatCount, error) {
+	var resp PremiumLimitTypePinnedChatCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeCreatedPublicChatCount(data json.RawMessage) (*PremiumLimitTypeCreatedPublicChatCount, error) {
+	var resp PremiumLimitTypeCreatedPublicChatCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeSavedAnimationCount(data json.RawMessage) (*PremiumLimitTypeSavedAnimationCount, error) {
+	var resp PremiumLimitTypeSavedAnimationCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeFavoriteStickerCount(data json.RawMessage) (*PremiumLimitTypeFavoriteStickerCount, error) {
+	var resp PremiumLimitTypeFavoriteStickerCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeChatFolderCount(data json.RawMessage) (*PremiumLimitTypeChatFolderCount, error) {
+	var resp PremiumLimitTypeChatFolderCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeChatFolderChosenChatCount(data json.RawMessage) (*PremiumLimitTypeChatFolderChosenChatCount, error) {
+	var resp PremiumLimitTypeChatFolderChosenChatCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypePinnedArchivedChatCount(data json.RawMessage) (*PremiumLimitTypePinnedArchivedChatCount, error) {
+	var resp PremiumLimitTypePinnedArchivedChatCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypePinnedSavedMessagesTopicCount(data json.RawMessage) (*PremiumLimitTypePinnedSavedMessagesTopicCount, error) {
+	var resp PremiumLimitTypePinnedSavedMessagesTopicCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeCaptionLength(data json.RawMessage) (*PremiumLimitTypeCaptionLength, error) {
+	var resp PremiumLimitTypeCaptionLength
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeBioLength(data json.RawMessage) (*PremiumLimitTypeBioLength, error) {
+	var resp PremiumLimitTypeBioLength
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeChatFolderInviteLinkCount(data json.RawMessage) (*PremiumLimitTypeChatFolderInviteLinkCount, error) {
+	var resp PremiumLimitTypeChatFolderInviteLinkCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeShareableChatFolderCount(data json.RawMessage) (*PremiumLimitTypeShareableChatFolderCount, error) {
+	var resp PremiumLimitTypeShareableChatFolderCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeActiveStoryCount(data json.RawMessage) (*PremiumLimitTypeActiveStoryCount, error) {
+	var resp PremiumLimitTypeActiveStoryCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeWeeklySentStoryCount(data json.RawMessage) (*PremiumLimitTypeWeeklySentStoryCount, error) {
+	var resp PremiumLimitTypeWeeklySentStoryCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeMonthlySentStoryCount(data json.RawMessage) (*PremiumLimitTypeMonthlySentStoryCount, error) {
+	var resp PremiumLimitTypeMonthlySentStoryCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeStoryCaptionLength(data json.RawMessage) (*PremiumLimitTypeStoryCaptionLength, error) {
+	var resp PremiumLimitTypeStoryCaptionLength
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeStorySuggestedReactionAreaCount(data json.RawMessage) (*PremiumLimitTypeStorySuggestedReactionAreaCount, error) {
+	var resp PremiumLimitTypeStorySuggestedReactionAreaCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimitTypeSimilarChatCount(data json.RawMessage) (*PremiumLimitTypeSimilarChatCount, error) {
+	var resp PremiumLimitTypeSimilarChatCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureIncreasedLimits(data json.RawMessage) (*PremiumFeatureIncreasedLimits, error) {
+	var resp PremiumFeatureIncreasedLimits
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureIncreasedUploadFileSize(data json.RawMessage) (*PremiumFeatureIncreasedUploadFileSize, error) {
+	var resp PremiumFeatureIncreasedUploadFileSize
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureImprovedDownloadSpeed(data json.RawMessage) (*PremiumFeatureImprovedDownloadSpeed, error) {
+	var resp PremiumFeatureImprovedDownloadSpeed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureVoiceRecognition(data json.RawMessage) (*PremiumFeatureVoiceRecognition, error) {
+	var resp PremiumFeatureVoiceRecognition
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureDisabledAds(data json.RawMessage) (*PremiumFeatureDisabledAds, error) {
+	var resp PremiumFeatureDisabledAds
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureUniqueReactions(data json.RawMessage) (*PremiumFeatureUniqueReactions, error) {
+	var resp PremiumFeatureUniqueReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureUniqueStickers(data json.RawMessage) (*PremiumFeatureUniqueStickers, error) {
+	var resp PremiumFeatureUniqueStickers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureCustomEmoji(data json.RawMessage) (*PremiumFeatureCustomEmoji, error) {
+	var resp PremiumFeatureCustomEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureAdvancedChatManagement(data json.RawMessage) (*PremiumFeatureAdvancedChatManagement, error) {
+	var resp PremiumFeatureAdvancedChatManagement
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureProfileBadge(data json.RawMessage) (*PremiumFeatureProfileBadge, error) {
+	var resp PremiumFeatureProfileBadge
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureEmojiStatus(data json.RawMessage) (*PremiumFeatureEmojiStatus, error) {
+	var resp PremiumFeatureEmojiStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureAnimatedProfilePhoto(data json.RawMessage) (*PremiumFeatureAnimatedProfilePhoto, error) {
+	var resp PremiumFeatureAnimatedProfilePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureForumTopicIcon(data json.RawMessage) (*PremiumFeatureForumTopicIcon, error) {
+	var resp PremiumFeatureForumTopicIcon
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureAppIcons(data json.RawMessage) (*PremiumFeatureAppIcons, error) {
+	var resp PremiumFeatureAppIcons
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureRealTimeChatTranslation(data json.RawMessage) (*PremiumFeatureRealTimeChatTranslation, error) {
+	var resp PremiumFeatureRealTimeChatTranslation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureUpgradedStories(data json.RawMessage) (*PremiumFeatureUpgradedStories, error) {
+	var resp PremiumFeatureUpgradedStories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureChatBoost(data json.RawMessage) (*PremiumFeatureChatBoost, error) {
+	var resp PremiumFeatureChatBoost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureAccentColor(data json.RawMessage) (*PremiumFeatureAccentColor, error) {
+	var resp PremiumFeatureAccentColor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureBackgroundForBoth(data json.RawMessage) (*PremiumFeatureBackgroundForBoth, error) {
+	var resp PremiumFeatureBackgroundForBoth
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureSavedMessagesTags(data json.RawMessage) (*PremiumFeatureSavedMessagesTags, error) {
+	var resp PremiumFeatureSavedMessagesTags
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureMessagePrivacy(data json.RawMessage) (*PremiumFeatureMessagePrivacy, error) {
+	var resp PremiumFeatureMessagePrivacy
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureLastSeenTimes(data json.RawMessage) (*PremiumFeatureLastSeenTimes, error) {
+	var resp PremiumFeatureLastSeenTimes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureBusiness(data json.RawMessage) (*PremiumFeatureBusiness, error) {
+	var resp PremiumFeatureBusiness
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatureMessageEffects(data json.RawMessage) (*PremiumFeatureMessageEffects, error) {
+	var resp PremiumFeatureMessageEffects
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStoryFeaturePriorityOrder(data json.RawMessage) (*PremiumStoryFeaturePriorityOrder, error) {
+	var resp PremiumStoryFeaturePriorityOrder
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStoryFeatureStealthMode(data json.RawMessage) (*PremiumStoryFeatureStealthMode, error) {
+	var resp PremiumStoryFeatureStealthMode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStoryFeaturePermanentViewsHistory(data json.RawMessage) (*PremiumStoryFeaturePermanentViewsHistory, error) {
+	var resp PremiumStoryFeaturePermanentViewsHistory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStoryFeatureCustomExpirationDuration(data json.RawMessage) (*PremiumStoryFeatureCustomExpirationDuration, error) {
+	var resp PremiumStoryFeatureCustomExpirationDuration
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStoryFeatureSaveStories(data json.RawMessage) (*PremiumStoryFeatureSaveStories, error) {
+	var resp PremiumStoryFeatureSaveStories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStoryFeatureLinksAndFormatting(data json.RawMessage) (*PremiumStoryFeatureLinksAndFormatting, error) {
+	var resp PremiumStoryFeatureLinksAndFormatting
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumStoryFeatureVideoQuality(data json.RawMessage) (*PremiumStoryFeatureVideoQuality, error) {
+	var resp PremiumStoryFeatureVideoQuality
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumLimit(data json.RawMessage) (*PremiumLimit, error) {
+	var resp PremiumLimit
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeatures(data json.RawMessage) (*PremiumFeatures, error) {
+	var resp PremiumFeatures
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumSourceLimitExceeded(data json.RawMessage) (*PremiumSourceLimitExceeded, error) {
+	var resp PremiumSourceLimitExceeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumSourceFeature(data json.RawMessage) (*PremiumSourceFeature, error) {
+	var resp PremiumSourceFeature
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumSourceBusinessFeature(data json.RawMessage) (*PremiumSourceBusinessFeature, error) {
+	var resp PremiumSourceBusinessFeature
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumSourceStoryFeature(data json.RawMessage) (*PremiumSourceStoryFeature, error) {
+	var resp PremiumSourceStoryFeature
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumSourceLink(data json.RawMessage) (*PremiumSourceLink, error) {
+	var resp PremiumSourceLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumSourceSettings(data json.RawMessage) (*PremiumSourceSettings, error) {
+	var resp PremiumSourceSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumFeaturePromotionAnimation(data json.RawMessage) (*PremiumFeaturePromotionAnimation, error) {
+	var resp PremiumFeaturePromotionAnimation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalPremiumState(data json.RawMessage) (*PremiumState, error) {
+	var resp PremiumState
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorePaymentPurposePremiumSubscription(data json.RawMessage) (*StorePaymentPurposePremiumSubscription, error) {
+	var resp StorePaymentPurposePremiumSubscription
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorePaymentPurposePremiumGift(data json.RawMessage) (*StorePaymentPurposePremiumGift, error) {
+	var resp StorePaymentPurposePremiumGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorePaymentPurposePremiumGiftCodes(data json.RawMessage) (*StorePaymentPurposePremiumGiftCodes, error) {
+	var resp StorePaymentPurposePremiumGiftCodes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorePaymentPurposePremiumGiveaway(data json.RawMessage) (*StorePaymentPurposePremiumGiveaway, error) {
+	var resp StorePaymentPurposePremiumGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorePaymentPurposeStarGiveaway(data json.RawMessage) (*StorePaymentPurposeStarGiveaway, error) {
+	var resp StorePaymentPurposeStarGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorePaymentPurposeStars(data json.RawMessage) (*StorePaymentPurposeStars, error) {
+	var resp StorePaymentPurposeStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStorePaymentPurposeGiftedStars(data json.RawMessage) (*StorePaymentPurposeGiftedStars, error) {
+	var resp StorePaymentPurposeGiftedStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoreTransactionAppStore(data json.RawMessage) (*StoreTransactionAppStore, error) {
+	var resp StoreTransactionAppStore
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoreTransactionGooglePlay(data json.RawMessage) (*StoreTransactionGooglePlay, error) {
+	var resp StoreTransactionGooglePlay
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTelegramPaymentPurposePremiumGift(data json.RawMessage) (*TelegramPaymentPurposePremiumGift, error) {
+	var resp TelegramPaymentPurposePremiumGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTelegramPaymentPurposePremiumGiftCodes(data json.RawMessage) (*TelegramPaymentPurposePremiumGiftCodes, error) {
+	var resp TelegramPaymentPurposePremiumGiftCodes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTelegramPaymentPurposePremiumGiveaway(data json.RawMessage) (*TelegramPaymentPurposePremiumGiveaway, error) {
+	var resp TelegramPaymentPurposePremiumGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTelegramPaymentPurposeStars(data json.RawMessage) (*TelegramPaymentPurposeStars, error) {
+	var resp TelegramPaymentPurposeStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTelegramPaymentPurposeGiftedStars(data json.RawMessage) (*TelegramPaymentPurposeGiftedStars, error) {
+	var resp TelegramPaymentPurposeGiftedStars
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTelegramPaymentPurposeStarGiveaway(data json.RawMessage) (*TelegramPaymentPurposeStarGiveaway, error) {
+	var resp TelegramPaymentPurposeStarGiveaway
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTelegramPaymentPurposeJoinChat(data json.RawMessage) (*TelegramPaymentPurposeJoinChat, error) {
+	var resp TelegramPaymentPurposeJoinChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostLink(data json.RawMessage) (*ChatBoostLink, error) {
+	var resp ChatBoostLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatBoostLinkInfo(data json.RawMessage) (*ChatBoostLinkInfo, error) {
+	var resp ChatBoostLinkInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarCount(data json.RawMessage) (*StarCount, error) {
+	var resp StarCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatRevenueAmount(data json.RawMessage) (*ChatRevenueAmount, error) {
+	var resp ChatRevenueAmount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatRevenueStatistics(data json.RawMessage) (*ChatRevenueStatistics, error) {
+	var resp ChatRevenueStatistics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRevenueWithdrawalStatePending(data json.RawMessage) (*RevenueWithdrawalStatePending, error) {
+	var resp RevenueWithdrawalStatePending
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRevenueWithdrawalStateSucceeded(data json.RawMessage) (*RevenueWithdrawalStateSucceeded, error) {
+	var resp RevenueWithdrawalStateSucceeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalRevenueWithdrawalStateFailed(data json.RawMessage) (*RevenueWithdrawalStateFailed, error) {
+	var resp RevenueWithdrawalStateFailed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatRevenueTransactionTypeEarnings(data json.RawMessage) (*ChatRevenueTransactionTypeEarnings, error) {
+	var resp ChatRevenueTransactionTypeEarnings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatRevenueTransactionTypeWithdrawal(data json.RawMessage) (*ChatRevenueTransactionTypeWithdrawal, error) {
+	var resp ChatRevenueTransactionTypeWithdrawal
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatRevenueTransactionTypeRefund(data json.RawMessage) (*ChatRevenueTransactionTypeRefund, error) {
+	var resp ChatRevenueTransactionTypeRefund
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatRevenueTransaction(data json.RawMessage) (*ChatRevenueTransaction, error) {
+	var resp ChatRevenueTransaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatRevenueTransactions(data json.RawMessage) (*ChatRevenueTransactions, error) {
+	var resp ChatRevenueTransactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarRevenueStatus(data json.RawMessage) (*StarRevenueStatus, error) {
+	var resp StarRevenueStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStarRevenueStatistics(data json.RawMessage) (*StarRevenueStatistics, error) {
+	var resp StarRevenueStatistics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
@@ -0,0 +1,950 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalStickerFormat(data json.RawMessage) (StickerFormat, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStickerFormat)
+}
+
+func decodeStickerFormat(decoder *jsonDecoder) (StickerFormat, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStickerFormatWebp:
+		return decodeEntity[StickerFormatWebp](decoder)
+
+	case ConstructorStickerFormatTgs:
+		return decodeEntity[StickerFormatTgs](decoder)
+
+	case ConstructorStickerFormatWebm:
+		return decodeEntity[StickerFormatWebm](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStickerFormat(dataList []json.RawMessage) ([]StickerFormat, error) {
+	list := make([]StickerFormat, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStickerFormat(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStickerType(data json.RawMessage) (StickerType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStickerType)
+}
+
+func decodeStickerType(decoder *jsonDecoder) (StickerType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStickerTypeRegular:
+		return decodeEntity[StickerTypeRegular](decoder)
+
+	case ConstructorStickerTypeMask:
+		return decodeEntity[StickerTypeMask](decoder)
+
+	case ConstructorStickerTypeCustomEmoji:
+		return decodeEntity[StickerTypeCustomEmoji](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStickerType(dataList []json.RawMessage) ([]StickerType, error) {
+	list := make([]StickerType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStickerType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStickerFullType(data json.RawMessage) (StickerFullType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStickerFullType)
+}
+
+func decodeStickerFullType(decoder *jsonDecoder) (StickerFullType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStickerFullTypeRegular:
+		return decodeEntity[StickerFullTypeRegular](decoder)
+
+	case ConstructorStickerFullTypeMask:
+		return decodeEntity[StickerFullTypeMask](decoder)
+
+	case ConstructorStickerFullTypeCustomEmoji:
+		return decodeEntity[StickerFullTypeCustomEmoji](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStickerFullType(dataList []json.RawMessage) ([]StickerFullType, error) {
+	list := make([]StickerFullType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStickerFullType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatPhotoStickerType(data json.RawMessage) (ChatPhotoStickerType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatPhotoStickerType)
+}
+
+func decodeChatPhotoStickerType(decoder *jsonDecoder) (ChatPhotoStickerType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatPhotoStickerTypeRegularOrMask:
+		return decodeEntity[ChatPhotoStickerTypeRegularOrMask](decoder)
+
+	case ConstructorChatPhotoStickerTypeCustomEmoji:
+		return decodeEntity[ChatPhotoStickerTypeCustomEmoji](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatPhotoStickerType(dataList []json.RawMessage) ([]ChatPhotoStickerType, error) {
+	list := make([]ChatPhotoStickerType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatPhotoStickerType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalEmojiStatusType(data json.RawMessage) (EmojiStatusType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeEmojiStatusType)
+}
+
+func decodeEmojiStatusType(decoder *jsonDecoder) (EmojiStatusType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorEmojiStatusTypeCustomEmoji:
+		return decodeEntity[EmojiStatusTypeCustomEmoji](decoder)
+
+	case ConstructorEmojiStatusTypeUpgradedGift:
+		return decodeEntity[EmojiStatusTypeUpgradedGift](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfEmojiStatusType(dataList []json.RawMessage) ([]EmojiStatusType, error) {
+	list := make([]EmojiStatusType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalEmojiStatusType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReactionType(data json.RawMessage) (ReactionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReactionType)
+}
+
+func decodeReactionType(decoder *jsonDecoder) (ReactionType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReactionTypeEmoji:
+		return decodeEntity[ReactionTypeEmoji](decoder)
+
+	case ConstructorReactionTypeCustomEmoji:
+		return decodeEntity[ReactionTypeCustomEmoji](decoder)
+
+	case ConstructorReactionTypePaid:
+		return decodeEntity[ReactionTypePaid](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReactionType(dataList []json.RawMessage) ([]ReactionType, error) {
+	list := make([]ReactionType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReactionType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReactionNotificationSource(data json.RawMessage) (ReactionNotificationSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReactionNotificationSource)
+}
+
+func decodeReactionNotificationSource(decoder *jsonDecoder) (ReactionNotificationSource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReactionNotificationSourceNone:
+		return decodeEntity[ReactionNotificationSourceNone](decoder)
+
+	case ConstructorReactionNotificationSourceContacts:
+		return decodeEntity[ReactionNotificationSourceContacts](decoder)
+
+	case ConstructorReactionNotificationSourceAll:
+		return decodeEntity[ReactionNotificationSourceAll](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReactionNotificationSource(dataList []json.RawMessage) ([]ReactionNotificationSource, error) {
+	list := make([]ReactionNotificationSource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReactionNotificationSource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalChatAvailableReactions(data json.RawMessage) (ChatAvailableReactions, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeChatAvailableReactions)
+}
+
+func decodeChatAvailableReactions(decoder *jsonDecoder) (ChatAvailableReactions, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorChatAvailableReactionsAll:
+		return decodeEntity[ChatAvailableReactionsAll](decoder)
+
+	case ConstructorChatAvailableReactionsSome:
+		return decodeEntity[ChatAvailableReactionsSome](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfChatAvailableReactions(dataList []json.RawMessage) ([]ChatAvailableReactions, error) {
+	list := make([]ChatAvailableReactions, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalChatAvailableReactions(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalEmojiCategorySource(data json.RawMessage) (EmojiCategorySource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeEmojiCategorySource)
+}
+
+func decodeEmojiCategorySource(decoder *jsonDecoder) (EmojiCategorySource, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorEmojiCategorySourceSearch:
+		return decodeEntity[EmojiCategorySourceSearch](decoder)
+
+	case ConstructorEmojiCategorySourcePremium:
+		return decodeEntity[EmojiCategorySourcePremium](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfEmojiCategorySource(dataList []json.RawMessage) ([]EmojiCategorySource, error) {
+	list := make([]EmojiCategorySource, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalEmojiCategorySource(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalEmojiCategoryType(data json.RawMessage) (EmojiCategoryType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeEmojiCategoryType)
+}
+
+func decodeEmojiCategoryType(decoder *jsonDecoder) (EmojiCategoryType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorEmojiCategoryTypeDefault:
+		return decodeEntity[EmojiCategoryTypeDefault](decoder)
+
+	case ConstructorEmojiCategoryTypeRegularStickers:
+		return decodeEntity[EmojiCategoryTypeRegularStickers](decoder)
+
+	case ConstructorEmojiCategoryTypeEmojiStatus:
+		return decodeEntity[EmojiCategoryTypeEmojiStatus](decoder)
+
+	case ConstructorEmojiCategoryTypeChatPhoto:
+		return decodeEntity[EmojiCategoryTypeChatPhoto](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfEmojiCategoryType(dataList []json.RawMessage) ([]EmojiCategoryType, error) {
+	list := make([]EmojiCategoryType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalEmojiCategoryType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReactionUnavailabilityReason(data json.RawMessage) (ReactionUnavailabilityReason, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReactionUnavailabilityReason)
+}
+
+func decodeReactionUnavailabilityReason(decoder *jsonDecoder) (ReactionUnavailabilityReason, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReactionUnavailabilityReasonAnonymousAdministrator:
+		return decodeEntity[ReactionUnavailabilityReasonAnonymousAdministrator](decoder)
+
+	case ConstructorReactionUnavailabilityReasonGuest:
+		return decodeEntity[ReactionUnavailabilityReasonGuest](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReactionUnavailabilityReason(dataList []json.RawMessage) ([]ReactionUnavailabilityReason, error) {
+	list := make([]ReactionUnavailabilityReason, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReactionUnavailabilityReason(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalDiceStickers(data json.RawMessage) (DiceStickers, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeDiceStickers)
+}
+
+func decodeDiceStickers(decoder *jsonDecoder) (DiceStickers, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorDiceStickersRegular:
+		return decodeEntity[DiceStickersRegular](decoder)
+
+	case ConstructorDiceStickersSlotMachine:
+		return decodeEntity[DiceStickersSlotMachine](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfDiceStickers(dataList []json.RawMessage) ([]DiceStickers, error) {
+	list := make([]DiceStickers, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalDiceStickers(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCheckStickerSetNameResult(data json.RawMessage) (CheckStickerSetNameResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCheckStickerSetNameResult)
+}
+
+func decodeCheckStickerSetNameResult(decoder *jsonDecoder) (CheckStickerSetNameResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCheckStickerSetNameResultOk:
+		return decodeEntity[CheckStickerSetNameResultOk](decoder)
+
+	case ConstructorCheckStickerSetNameResultNameInvalid:
+		return decodeEntity[CheckStickerSetNameResultNameInvalid](decoder)
+
+	case ConstructorCheckStickerSetNameResultNameOccupied:
+		return decodeEntity[CheckStickerSetNameResultNameOccupied](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCheckStickerSetNameResult(dataList []json.RawMessage) ([]CheckStickerSetNameResult, error) {
+	list := make([]CheckStickerSetNameResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCheckStickerSetNameResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStickerFormatWebp(data json.RawMessage) (*StickerFormatWebp, error) {
+	var resp StickerFormatWebp
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerFormatTgs(data json.RawMessage) (*StickerFormatTgs, error) {
+	var resp StickerFormatTgs
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerFormatWebm(data json.RawMessage) (*StickerFormatWebm, error) {
+	var resp StickerFormatWebm
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerTypeRegular(data json.RawMessage) (*StickerTypeRegular, error) {
+	var resp StickerTypeRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerTypeMask(data json.RawMessage) (*StickerTypeMask, error) {
+	var resp StickerTypeMask
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerTypeCustomEmoji(data json.RawMessage) (*StickerTypeCustomEmoji, error) {
+	var resp StickerTypeCustomEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerFullTypeRegular(data json.RawMessage) (*StickerFullTypeRegular, error) {
+	var resp StickerFullTypeRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerFullTypeMask(data json.RawMessage) (*StickerFullTypeMask, error) {
+	var resp StickerFullTypeMask
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerFullTypeCustomEmoji(data json.RawMessage) (*StickerFullTypeCustomEmoji, error) {
+	var resp StickerFullTypeCustomEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSticker(data json.RawMessage) (*Sticker, error) {
+	var resp Sticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAnimatedEmoji(data json.RawMessage) (*AnimatedEmoji, error) {
+	var resp AnimatedEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPhotoStickerTypeRegularOrMask(data json.RawMessage) (*ChatPhotoStickerTypeRegularOrMask, error) {
+	var resp ChatPhotoStickerTypeRegularOrMask
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPhotoStickerTypeCustomEmoji(data json.RawMessage) (*ChatPhotoStickerTypeCustomEmoji, error) {
+	var resp ChatPhotoStickerTypeCustomEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatPhotoSticker(data json.RawMessage) (*ChatPhotoSticker, error) {
+	var resp ChatPhotoSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiStatusTypeCustomEmoji(data json.RawMessage) (*EmojiStatusTypeCustomEmoji, error) {
+	var resp EmojiStatusTypeCustomEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiStatusTypeUpgradedGift(data json.RawMessage) (*EmojiStatusTypeUpgradedGift, error) {
+	var resp EmojiStatusTypeUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiStatus(data json.RawMessage) (*EmojiStatus, error) {
+	var resp EmojiStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiStatuses(data json.RawMessage) (*EmojiStatuses, error) {
+	var resp EmojiStatuses
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiStatusCustomEmojis(data json.RawMessage) (*EmojiStatusCustomEmojis, error) {
+	var resp EmojiStatusCustomEmojis
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionTypeEmoji(data json.RawMessage) (*ReactionTypeEmoji, error) {
+	var resp ReactionTypeEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionTypeCustomEmoji(data json.RawMessage) (*ReactionTypeCustomEmoji, error) {
+	var resp ReactionTypeCustomEmoji
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionTypePaid(data json.RawMessage) (*ReactionTypePaid, error) {
+	var resp ReactionTypePaid
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReaction(data json.RawMessage) (*MessageReaction, error) {
+	var resp MessageReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalMessageReactions(data json.RawMessage) (*MessageReactions, error) {
+	var resp MessageReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUnreadReaction(data json.RawMessage) (*UnreadReaction, error) {
+	var resp UnreadReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionNotificationSourceNone(data json.RawMessage) (*ReactionNotificationSourceNone, error) {
+	var resp ReactionNotificationSourceNone
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionNotificationSourceContacts(data json.RawMessage) (*ReactionNotificationSourceContacts, error) {
+	var resp ReactionNotificationSourceContacts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionNotificationSourceAll(data json.RawMessage) (*ReactionNotificationSourceAll, error) {
+	var resp ReactionNotificationSourceAll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionNotificationSettings(data json.RawMessage) (*ReactionNotificationSettings, error) {
+	var resp ReactionNotificationSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatAvailableReactionsAll(data json.RawMessage) (*ChatAvailableReactionsAll, error) {
+	var resp ChatAvailableReactionsAll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatAvailableReactionsSome(data json.RawMessage) (*ChatAvailableReactionsSome, error) {
+	var resp ChatAvailableReactionsSome
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiKeyword(data json.RawMessage) (*EmojiKeyword, error) {
+	var resp EmojiKeyword
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiKeywords(data json.RawMessage) (*EmojiKeywords, error) {
+	var resp EmojiKeywords
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickers(data json.RawMessage) (*Stickers, error) {
+	var resp Stickers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojis(data json.RawMessage) (*Emojis, error) {
+	var resp Emojis
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerSet(data json.RawMessage) (*StickerSet, error) {
+	var resp StickerSet
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerSetInfo(data json.RawMessage) (*StickerSetInfo, error) {
+	var resp StickerSetInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStickerSets(data json.RawMessage) (*StickerSets, error) {
+	var resp StickerSets
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalTrendingStickerSets(data json.RawMessage) (*TrendingStickerSets, error) {
+	var resp TrendingStickerSets
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategorySourceSearch(data json.RawMessage) (*EmojiCategorySourceSearch, error) {
+	var resp EmojiCategorySourceSearch
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategorySourcePremium(data json.RawMessage) (*EmojiCategorySourcePremium, error) {
+	var resp EmojiCategorySourcePremium
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategory(data json.RawMessage) (*EmojiCategory, error) {
+	var resp EmojiCategory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategories(data json.RawMessage) (*EmojiCategories, error) {
+	var resp EmojiCategories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategoryTypeDefault(data json.RawMessage) (*EmojiCategoryTypeDefault, error) {
+	var resp EmojiCategoryTypeDefault
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategoryTypeRegularStickers(data json.RawMessage) (*EmojiCategoryTypeRegularStickers, error) {
+	var resp EmojiCategoryTypeRegularStickers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategoryTypeEmojiStatus(data json.RawMessage) (*EmojiCategoryTypeEmojiStatus, error) {
+	var resp EmojiCategoryTypeEmojiStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiCategoryTypeChatPhoto(data json.RawMessage) (*EmojiCategoryTypeChatPhoto, error) {
+	var resp EmojiCategoryTypeChatPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAddedReaction(data json.RawMessage) (*AddedReaction, error) {
+	var resp AddedReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAddedReactions(data json.RawMessage) (*AddedReactions, error) {
+	var resp AddedReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAvailableReaction(data json.RawMessage) (*AvailableReaction, error) {
+	var resp AvailableReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalAvailableReactions(data json.RawMessage) (*AvailableReactions, error) {
+	var resp AvailableReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalEmojiReaction(data json.RawMessage) (*EmojiReaction, error) {
+	var resp EmojiReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionUnavailabilityReasonAnonymousAdministrator(data json.RawMessage) (*ReactionUnavailabilityReasonAnonymousAdministrator, error) {
+	var resp ReactionUnavailabilityReasonAnonymousAdministrator
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReactionUnavailabilityReasonGuest(data json.RawMessage) (*ReactionUnavailabilityReasonGuest, error) {
+	var resp ReactionUnavailabilityReasonGuest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDiceStickersRegular(data json.RawMessage) (*DiceStickersRegular, error) {
+	var resp DiceStickersRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalDiceStickersSlotMachine(data json.RawMessage) (*DiceStickersSlotMachine, error) {
+	var resp DiceStickersSlotMachine
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckStickerSetNameResultOk(data json.RawMessage) (*CheckStickerSetNameResultOk, error) {
+	var resp CheckStickerSetNameResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckStickerSetNameResultNameInvalid(data json.RawMessage) (*CheckStickerSetNameResultNameInvalid, error) {
+	var resp CheckStickerSetNameResultNameInvalid
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCheckStickerSetNameResultNameOccupied(data json.RawMessage) (*CheckStickerSetNameResultNameOccupied, error) {
+	var resp CheckStickerSetNameResultNameOccupied
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputSticker(data json.RawMessage) (*InputSticker, error) {
+	var resp InputSticker
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
@@ -0,0 +1,833 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalStoryAreaType(data json.RawMessage) (StoryAreaType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStoryAreaType)
+}
+
+func decodeStoryAreaType(decoder *jsonDecoder) (StoryAreaType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStoryAreaTypeLocation:
+		return decodeEntity[StoryAreaTypeLocation](decoder)
+
+	case ConstructorStoryAreaTypeVenue:
+		return decodeEntity[StoryAreaTypeVenue](decoder)
+
+	case ConstructorStoryAreaTypeSuggestedReaction:
+		return decodeEntity[StoryAreaTypeSuggestedReaction](decoder)
+
+	case ConstructorStoryAreaTypeMessage:
+		return decodeEntity[StoryAreaTypeMessage](decoder)
+
+	case ConstructorStoryAreaTypeLink:
+		return decodeEntity[StoryAreaTypeLink](decoder)
+
+	case ConstructorStoryAreaTypeWeather:
+		return decodeEntity[StoryAreaTypeWeather](decoder)
+
+	case ConstructorStoryAreaTypeUpgradedGift:
+		return decodeEntity[StoryAreaTypeUpgradedGift](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStoryAreaType(dataList []json.RawMessage) ([]StoryAreaType, error) {
+	list := make([]StoryAreaType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStoryAreaType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputStoryAreaType(data json.RawMessage) (InputStoryAreaType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputStoryAreaType)
+}
+
+func decodeInputStoryAreaType(decoder *jsonDecoder) (InputStoryAreaType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputStoryAreaTypeLocation:
+		return decodeEntity[InputStoryAreaTypeLocation](decoder)
+
+	case ConstructorInputStoryAreaTypeFoundVenue:
+		return decodeEntity[InputStoryAreaTypeFoundVenue](decoder)
+
+	case ConstructorInputStoryAreaTypePreviousVenue:
+		return decodeEntity[InputStoryAreaTypePreviousVenue](decoder)
+
+	case ConstructorInputStoryAreaTypeSuggestedReaction:
+		return decodeEntity[InputStoryAreaTypeSuggestedReaction](decoder)
+
+	case ConstructorInputStoryAreaTypeMessage:
+		return decodeEntity[InputStoryAreaTypeMessage](decoder)
+
+	case ConstructorInputStoryAreaTypeLink:
+		return decodeEntity[InputStoryAreaTypeLink](decoder)
+
+	case ConstructorInputStoryAreaTypeWeather:
+		return decodeEntity[InputStoryAreaTypeWeather](decoder)
+
+	case ConstructorInputStoryAreaTypeUpgradedGift:
+		return decodeEntity[InputStoryAreaTypeUpgradedGift](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputStoryAreaType(dataList []json.RawMessage) ([]InputStoryAreaType, error) {
+	list := make([]InputStoryAreaType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputStoryAreaType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStoryContent(data json.RawMessage) (StoryContent, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStoryContent)
+}
+
+func decodeStoryContent(decoder *jsonDecoder) (StoryContent, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStoryContentPhoto:
+		return decodeEntity[StoryContentPhoto](decoder)
+
+	case ConstructorStoryContentVideo:
+		return decodeEntity[StoryContentVideo](decoder)
+
+	case ConstructorStoryContentUnsupported:
+		return decodeEntity[StoryContentUnsupported](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStoryContent(dataList []json.RawMessage) ([]StoryContent, error) {
+	list := make([]StoryContent, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStoryContent(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalInputStoryContent(data json.RawMessage) (InputStoryContent, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeInputStoryContent)
+}
+
+func decodeInputStoryContent(decoder *jsonDecoder) (InputStoryContent, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorInputStoryContentPhoto:
+		return decodeEntity[InputStoryContentPhoto](decoder)
+
+	case ConstructorInputStoryContentVideo:
+		return decodeEntity[InputStoryContentVideo](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfInputStoryContent(dataList []json.RawMessage) ([]InputStoryContent, error) {
+	list := make([]InputStoryContent, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalInputStoryContent(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStoryList(data json.RawMessage) (StoryList, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStoryList)
+}
+
+func decodeStoryList(decoder *jsonDecoder) (StoryList, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStoryListMain:
+		return decodeEntity[StoryListMain](decoder)
+
+	case ConstructorStoryListArchive:
+		return decodeEntity[StoryListArchive](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStoryList(dataList []json.RawMessage) ([]StoryList, error) {
+	list := make([]StoryList, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStoryList(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStoryOrigin(data json.RawMessage) (StoryOrigin, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStoryOrigin)
+}
+
+func decodeStoryOrigin(decoder *jsonDecoder) (StoryOrigin, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStoryOriginPublicStory:
+		return decodeEntity[StoryOriginPublicStory](decoder)
+
+	case ConstructorStoryOriginHiddenUser:
+		return decodeEntity[StoryOriginHiddenUser](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStoryOrigin(dataList []json.RawMessage) ([]StoryOrigin, error) {
+	list := make([]StoryOrigin, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStoryOrigin(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStoryInteractionType(data json.RawMessage) (StoryInteractionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStoryInteractionType)
+}
+
+func decodeStoryInteractionType(decoder *jsonDecoder) (StoryInteractionType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStoryInteractionTypeView:
+		return decodeEntity[StoryInteractionTypeView](decoder)
+
+	case ConstructorStoryInteractionTypeForward:
+		return decodeEntity[StoryInteractionTypeForward](decoder)
+
+	case ConstructorStoryInteractionTypeRepost:
+		return decodeEntity[StoryInteractionTypeRepost](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStoryInteractionType(dataList []json.RawMessage) ([]StoryInteractionType, error) {
+	list := make([]StoryInteractionType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStoryInteractionType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalCanSendStoryResult(data json.RawMessage) (CanSendStoryResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeCanSendStoryResult)
+}
+
+func decodeCanSendStoryResult(decoder *jsonDecoder) (CanSendStoryResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorCanSendStoryResultOk:
+		return decodeEntity[CanSendStoryResultOk](decoder)
+
+	case ConstructorCanSendStoryResultPremiumNeeded:
+		return decodeEntity[CanSendStoryResultPremiumNeeded](decoder)
+
+	case ConstructorCanSendStoryResultBoostNeeded:
+		return decodeEntity[CanSendStoryResultBoostNeeded](decoder)
+
+	case ConstructorCanSendStoryResultActiveStoryLimitExceeded:
+		return decodeEntity[CanSendStoryResultActiveStoryLimitExceeded](decoder)
+
+	case ConstructorCanSendStoryResultWeeklyLimitExceeded:
+		return decodeEntity[CanSendStoryResultWeeklyLimitExceeded](decoder)
+
+	case ConstructorCanSendStoryResultMonthlyLimitExceeded:
+		return decodeEntity[CanSendStoryResultMonthlyLimitExceeded](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfCanSendStoryResult(dataList []json.RawMessage) ([]CanSendStoryResult, error) {
+	list := make([]CanSendStoryResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalCanSendStoryResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStoryPrivacySettings(data json.RawMessage) (StoryPrivacySettings, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeStoryPrivacySettings)
+}
+
+func decodeStoryPrivacySettings(decoder *jsonDecoder) (StoryPrivacySettings, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorStoryPrivacySettingsEveryone:
+		return decodeEntity[StoryPrivacySettingsEveryone](decoder)
+
+	case ConstructorStoryPrivacySettingsContacts:
+		return decodeEntity[StoryPrivacySettingsContacts](decoder)
+
+	case ConstructorStoryPrivacySettingsCloseFriends:
+		return decodeEntity[StoryPrivacySettingsCloseFriends](decoder)
+
+	case ConstructorStoryPrivacySettingsSelectedUsers:
+		return decodeEntity[StoryPrivacySettingsSelectedUsers](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfStoryPrivacySettings(dataList []json.RawMessage) ([]StoryPrivacySettings, error) {
+	list := make([]StoryPrivacySettings, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalStoryPrivacySettings(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalReportStoryResult(data json.RawMessage) (ReportStoryResult, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeReportStoryResult)
+}
+
+func decodeReportStoryResult(decoder *jsonDecoder) (ReportStoryResult, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorReportStoryResultOk:
+		return decodeEntity[ReportStoryResultOk](decoder)
+
+	case ConstructorReportStoryResultOptionRequired:
+		return decodeEntity[ReportStoryResultOptionRequired](decoder)
+
+	case ConstructorReportStoryResultTextRequired:
+		return decodeEntity[ReportStoryResultTextRequired](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfReportStoryResult(dataList []json.RawMessage) ([]ReportStoryResult, error) {
+	list := make([]ReportStoryResult, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalReportStoryResult(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalStoryAreaPosition(data json.RawMessage) (*StoryAreaPosition, error) {
+	var resp StoryAreaPosition
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryAreaTypeLocation(data json.RawMessage) (*StoryAreaTypeLocation, error) {
+	var resp StoryAreaTypeLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryAreaTypeVenue(data json.RawMessage) (*StoryAreaTypeVenue, error) {
+	var resp StoryAreaTypeVenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryAreaTypeSuggestedReaction(data json.RawMessage) (*StoryAreaTypeSuggestedReaction, error) {
+	var resp StoryAreaTypeSuggestedReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryAreaTypeMessage(data json.RawMessage) (*StoryAreaTypeMessage, error) {
+	var resp StoryAreaTypeMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryAreaTypeLink(data json.RawMessage) (*StoryAreaTypeLink, error) {
+	var resp StoryAreaTypeLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryAreaTypeWeather(data json.RawMessage) (*StoryAreaTypeWeather, error) {
+	var resp StoryAreaTypeWeather
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryAreaTypeUpgradedGift(data json.RawMessage) (*StoryAreaTypeUpgradedGift, error) {
+	var resp StoryAreaTypeUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryArea(data json.RawMessage) (*StoryArea, error) {
+	var resp StoryArea
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypeLocation(data json.RawMessage) (*InputStoryAreaTypeLocation, error) {
+	var resp InputStoryAreaTypeLocation
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypeFoundVenue(data json.RawMessage) (*InputStoryAreaTypeFoundVenue, error) {
+	var resp InputStoryAreaTypeFoundVenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypePreviousVenue(data json.RawMessage) (*InputStoryAreaTypePreviousVenue, error) {
+	var resp InputStoryAreaTypePreviousVenue
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypeSuggestedReaction(data json.RawMessage) (*InputStoryAreaTypeSuggestedReaction, error) {
+	var resp InputStoryAreaTypeSuggestedReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypeMessage(data json.RawMessage) (*InputStoryAreaTypeMessage, error) {
+	var resp InputStoryAreaTypeMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypeLink(data json.RawMessage) (*InputStoryAreaTypeLink, error) {
+	var resp InputStoryAreaTypeLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypeWeather(data json.RawMessage) (*InputStoryAreaTypeWeather, error) {
+	var resp InputStoryAreaTypeWeather
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreaTypeUpgradedGift(data json.RawMessage) (*InputStoryAreaTypeUpgradedGift, error) {
+	var resp InputStoryAreaTypeUpgradedGift
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryArea(data json.RawMessage) (*InputStoryArea, error) {
+	var resp InputStoryArea
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryAreas(data json.RawMessage) (*InputStoryAreas, error) {
+	var resp InputStoryAreas
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryVideo(data json.RawMessage) (*StoryVideo, error) {
+	var resp StoryVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryContentPhoto(data json.RawMessage) (*StoryContentPhoto, error) {
+	var resp StoryContentPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryContentVideo(data json.RawMessage) (*StoryContentVideo, error) {
+	var resp StoryContentVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryContentUnsupported(data json.RawMessage) (*StoryContentUnsupported, error) {
+	var resp StoryContentUnsupported
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryContentPhoto(data json.RawMessage) (*InputStoryContentPhoto, error) {
+	var resp InputStoryContentPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalInputStoryContentVideo(data json.RawMessage) (*InputStoryContentVideo, error) {
+	var resp InputStoryContentVideo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryListMain(data json.RawMessage) (*StoryListMain, error) {
+	var resp StoryListMain
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryListArchive(data json.RawMessage) (*StoryListArchive, error) {
+	var resp StoryListArchive
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryOriginPublicStory(data json.RawMessage) (*StoryOriginPublicStory, error) {
+	var resp StoryOriginPublicStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryOriginHiddenUser(data json.RawMessage) (*StoryOriginHiddenUser, error) {
+	var resp StoryOriginHiddenUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryRepostInfo(data json.RawMessage) (*StoryRepostInfo, error) {
+	var resp StoryRepostInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryInteractionInfo(data json.RawMessage) (*StoryInteractionInfo, error) {
+	var resp StoryInteractionInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStory(data json.RawMessage) (*Story, error) {
+	var resp Story
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStories(data json.RawMessage) (*Stories, error) {
+	var resp Stories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundStories(data json.RawMessage) (*FoundStories, error) {
+	var resp FoundStories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryFullId(data json.RawMessage) (*StoryFullId, error) {
+	var resp StoryFullId
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryInfo(data json.RawMessage) (*StoryInfo, error) {
+	var resp StoryInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalChatActiveStories(data json.RawMessage) (*ChatActiveStories, error) {
+	var resp ChatActiveStories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryInteractionTypeView(data json.RawMessage) (*StoryInteractionTypeView, error) {
+	var resp StoryInteractionTypeView
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryInteractionTypeForward(data json.RawMessage) (*StoryInteractionTypeForward, error) {
+	var resp StoryInteractionTypeForward
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryInteractionTypeRepost(data json.RawMessage) (*StoryInteractionTypeRepost, error) {
+	var resp StoryInteractionTypeRepost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryInteraction(data json.RawMessage) (*StoryInteraction, error) {
+	var resp StoryInteraction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryInteractions(data json.RawMessage) (*StoryInteractions, error) {
+	var resp StoryInteractions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendStoryResultOk(data json.RawMessage) (*CanSendStoryResultOk, error) {
+	var resp CanSendStoryResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendStoryResultPremiumNeeded(data json.RawMessage) (*CanSendStoryResultPremiumNeeded, error) {
+	var resp CanSendStoryResultPremiumNeeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendStoryResultBoostNeeded(data json.RawMessage) (*CanSendStoryResultBoostNeeded, error) {
+	var resp CanSendStoryResultBoostNeeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendStoryResultActiveStoryLimitExceeded(data json.RawMessage) (*CanSendStoryResultActiveStoryLimitExceeded, error) {
+	var resp CanSendStoryResultActiveStoryLimitExceeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendStoryResultWeeklyLimitExceeded(data json.RawMessage) (*CanSendStoryResultWeeklyLimitExceeded, error) {
+	var resp CanSendStoryResultWeeklyLimitExceeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCanSendStoryResultMonthlyLimitExceeded(data json.RawMessage) (*CanSendStoryResultMonthlyLimitExceeded, error) {
+	var resp CanSendStoryResultMonthlyLimitExceeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryPrivacySettingsEveryone(data json.RawMessage) (*StoryPrivacySettingsEveryone, error) {
+	var resp StoryPrivacySettingsEveryone
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryPrivacySettingsContacts(data json.RawMessage) (*StoryPrivacySettingsContacts, error) {
+	var resp StoryPrivacySettingsContacts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryPrivacySettingsCloseFriends(data json.RawMessage) (*StoryPrivacySettingsCloseFriends, error) {
+	var resp StoryPrivacySettingsCloseFriends
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryPrivacySettingsSelectedUsers(data json.RawMessage) (*StoryPrivacySettingsSelectedUsers, error) {
+	var resp StoryPrivacySettingsSelectedUsers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportStoryResultOk(data json.RawMessage) (*ReportStoryResultOk, error) {
+	var resp ReportStoryResultOk
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportStoryResultOptionRequired(data json.RawMessage) (*ReportStoryResultOptionRequired, error) {
+	var resp ReportStoryResultOptionRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReportStoryResultTextRequired(data json.RawMessage) (*ReportStoryResultTextRequired, error) {
+	var resp ReportStoryResultTextRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalStoryStatistics(data json.RawMessage) (*StoryStatistics, error) {
+	var resp StoryStatistics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
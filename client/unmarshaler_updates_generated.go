@@ -0,0 +1,1445 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalUpdate(data json.RawMessage) (Update, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeUpdate)
+}
+
+func decodeUpdate(decoder *jsonDecoder) (Update, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorUpdateAuthorizationState:
+		return decodeEntity[UpdateAuthorizationState](decoder)
+
+	case ConstructorUpdateNewMessage:
+		return decodeEntity[UpdateNewMessage](decoder)
+
+	case ConstructorUpdateMessageSendAcknowledged:
+		return decodeEntity[UpdateMessageSendAcknowledged](decoder)
+
+	case ConstructorUpdateMessageSendSucceeded:
+		return decodeEntity[UpdateMessageSendSucceeded](decoder)
+
+	case ConstructorUpdateMessageSendFailed:
+		return decodeEntity[UpdateMessageSendFailed](decoder)
+
+	case ConstructorUpdateMessageContent:
+		return decodeEntity[UpdateMessageContent](decoder)
+
+	case ConstructorUpdateMessageEdited:
+		return decodeEntity[UpdateMessageEdited](decoder)
+
+	case ConstructorUpdateMessageIsPinned:
+		return decodeEntity[UpdateMessageIsPinned](decoder)
+
+	case ConstructorUpdateMessageInteractionInfo:
+		return decodeEntity[UpdateMessageInteractionInfo](decoder)
+
+	case ConstructorUpdateMessageContentOpened:
+		return decodeEntity[UpdateMessageContentOpened](decoder)
+
+	case ConstructorUpdateMessageMentionRead:
+		return decodeEntity[UpdateMessageMentionRead](decoder)
+
+	case ConstructorUpdateMessageUnreadReactions:
+		return decodeEntity[UpdateMessageUnreadReactions](decoder)
+
+	case ConstructorUpdateMessageFactCheck:
+		return decodeEntity[UpdateMessageFactCheck](decoder)
+
+	case ConstructorUpdateMessageLiveLocationViewed:
+		return decodeEntity[UpdateMessageLiveLocationViewed](decoder)
+
+	case ConstructorUpdateVideoPublished:
+		return decodeEntity[UpdateVideoPublished](decoder)
+
+	case ConstructorUpdateNewChat:
+		return decodeEntity[UpdateNewChat](decoder)
+
+	case ConstructorUpdateChatTitle:
+		return decodeEntity[UpdateChatTitle](decoder)
+
+	case ConstructorUpdateChatPhoto:
+		return decodeEntity[UpdateChatPhoto](decoder)
+
+	case ConstructorUpdateChatAccentColors:
+		return decodeEntity[UpdateChatAccentColors](decoder)
+
+	case ConstructorUpdateChatPermissions:
+		return decodeEntity[UpdateChatPermissions](decoder)
+
+	case ConstructorUpdateChatLastMessage:
+		return decodeEntity[UpdateChatLastMessage](decoder)
+
+	case ConstructorUpdateChatPosition:
+		return decodeEntity[UpdateChatPosition](decoder)
+
+	case ConstructorUpdateChatAddedToList:
+		return decodeEntity[UpdateChatAddedToList](decoder)
+
+	case ConstructorUpdateChatRemovedFromList:
+		return decodeEntity[UpdateChatRemovedFromList](decoder)
+
+	case ConstructorUpdateChatReadInbox:
+		return decodeEntity[UpdateChatReadInbox](decoder)
+
+	case ConstructorUpdateChatReadOutbox:
+		return decodeEntity[UpdateChatReadOutbox](decoder)
+
+	case ConstructorUpdateChatActionBar:
+		return decodeEntity[UpdateChatActionBar](decoder)
+
+	case ConstructorUpdateChatBusinessBotManageBar:
+		return decodeEntity[UpdateChatBusinessBotManageBar](decoder)
+
+	case ConstructorUpdateChatAvailableReactions:
+		return decodeEntity[UpdateChatAvailableReactions](decoder)
+
+	case ConstructorUpdateChatDraftMessage:
+		return decodeEntity[UpdateChatDraftMessage](decoder)
+
+	case ConstructorUpdateChatEmojiStatus:
+		return decodeEntity[UpdateChatEmojiStatus](decoder)
+
+	case ConstructorUpdateChatMessageSender:
+		return decodeEntity[UpdateChatMessageSender](decoder)
+
+	case ConstructorUpdateChatMessageAutoDeleteTime:
+		return decodeEntity[UpdateChatMessageAutoDeleteTime](decoder)
+
+	case ConstructorUpdateChatNotificationSettings:
+		return decodeEntity[UpdateChatNotificationSettings](decoder)
+
+	case ConstructorUpdateChatPendingJoinRequests:
+		return decodeEntity[UpdateChatPendingJoinRequests](decoder)
+
+	case ConstructorUpdateChatReplyMarkup:
+		return decodeEntity[UpdateChatReplyMarkup](decoder)
+
+	case ConstructorUpdateChatBackground:
+		return decodeEntity[UpdateChatBackground](decoder)
+
+	case ConstructorUpdateChatTheme:
+		return decodeEntity[UpdateChatTheme](decoder)
+
+	case ConstructorUpdateChatUnreadMentionCount:
+		return decodeEntity[UpdateChatUnreadMentionCount](decoder)
+
+	case ConstructorUpdateChatUnreadReactionCount:
+		return decodeEntity[UpdateChatUnreadReactionCount](decoder)
+
+	case ConstructorUpdateChatVideoChat:
+		return decodeEntity[UpdateChatVideoChat](decoder)
+
+	case ConstructorUpdateChatDefaultDisableNotification:
+		return decodeEntity[UpdateChatDefaultDisableNotification](decoder)
+
+	case ConstructorUpdateChatHasProtectedContent:
+		return decodeEntity[UpdateChatHasProtectedContent](decoder)
+
+	case ConstructorUpdateChatIsTranslatable:
+		return decodeEntity[UpdateChatIsTranslatable](decoder)
+
+	case ConstructorUpdateChatIsMarkedAsUnread:
+		return decodeEntity[UpdateChatIsMarkedAsUnread](decoder)
+
+	case ConstructorUpdateChatViewAsTopics:
+		return decodeEntity[UpdateChatViewAsTopics](decoder)
+
+	case ConstructorUpdateChatBlockList:
+		return decodeEntity[UpdateChatBlockList](decoder)
+
+	case ConstructorUpdateChatHasScheduledMessages:
+		return decodeEntity[UpdateChatHasScheduledMessages](decoder)
+
+	case ConstructorUpdateChatFolders:
+		return decodeEntity[UpdateChatFolders](decoder)
+
+	case ConstructorUpdateChatOnlineMemberCount:
+		return decodeEntity[UpdateChatOnlineMemberCount](decoder)
+
+	case ConstructorUpdateSavedMessagesTopic:
+		return decodeEntity[UpdateSavedMessagesTopic](decoder)
+
+	case ConstructorUpdateSavedMessagesTopicCount:
+		return decodeEntity[UpdateSavedMessagesTopicCount](decoder)
+
+	case ConstructorUpdateQuickReplyShortcut:
+		return decodeEntity[UpdateQuickReplyShortcut](decoder)
+
+	case ConstructorUpdateQuickReplyShortcutDeleted:
+		return decodeEntity[UpdateQuickReplyShortcutDeleted](decoder)
+
+	case ConstructorUpdateQuickReplyShortcuts:
+		return decodeEntity[UpdateQuickReplyShortcuts](decoder)
+
+	case ConstructorUpdateQuickReplyShortcutMessages:
+		return decodeEntity[UpdateQuickReplyShortcutMessages](decoder)
+
+	case ConstructorUpdateForumTopicInfo:
+		return decodeEntity[UpdateForumTopicInfo](decoder)
+
+	case ConstructorUpdateForumTopic:
+		return decodeEntity[UpdateForumTopic](decoder)
+
+	case ConstructorUpdateScopeNotificationSettings:
+		return decodeEntity[UpdateScopeNotificationSettings](decoder)
+
+	case ConstructorUpdateReactionNotificationSettings:
+		return decodeEntity[UpdateReactionNotificationSettings](decoder)
+
+	case ConstructorUpdateNotification:
+		return decodeEntity[UpdateNotification](decoder)
+
+	case ConstructorUpdateNotificationGroup:
+		return decodeEntity[UpdateNotificationGroup](decoder)
+
+	case ConstructorUpdateActiveNotifications:
+		return decodeEntity[UpdateActiveNotifications](decoder)
+
+	case ConstructorUpdateHavePendingNotifications:
+		return decodeEntity[UpdateHavePendingNotifications](decoder)
+
+	case ConstructorUpdateDeleteMessages:
+		return decodeEntity[UpdateDeleteMessages](decoder)
+
+	case ConstructorUpdateChatAction:
+		return decodeEntity[UpdateChatAction](decoder)
+
+	case ConstructorUpdateUserStatus:
+		return decodeEntity[UpdateUserStatus](decoder)
+
+	case ConstructorUpdateUser:
+		return decodeEntity[UpdateUser](decoder)
+
+	case ConstructorUpdateBasicGroup:
+		return decodeEntity[UpdateBasicGroup](decoder)
+
+	case ConstructorUpdateSupergroup:
+		return decodeEntity[UpdateSupergroup](decoder)
+
+	case ConstructorUpdateSecretChat:
+		return decodeEntity[UpdateSecretChat](decoder)
+
+	case ConstructorUpdateUserFullInfo:
+		return decodeEntity[UpdateUserFullInfo](decoder)
+
+	case ConstructorUpdateBasicGroupFullInfo:
+		return decodeEntity[UpdateBasicGroupFullInfo](decoder)
+
+	case ConstructorUpdateSupergroupFullInfo:
+		return decodeEntity[UpdateSupergroupFullInfo](decoder)
+
+	case ConstructorUpdateServiceNotification:
+		return decodeEntity[UpdateServiceNotification](decoder)
+
+	case ConstructorUpdateFile:
+		return decodeEntity[UpdateFile](decoder)
+
+	case ConstructorUpdateFileGenerationStart:
+		return decodeEntity[UpdateFileGenerationStart](decoder)
+
+	case ConstructorUpdateFileGenerationStop:
+		return decodeEntity[UpdateFileGenerationStop](decoder)
+
+	case ConstructorUpdateFileDownloads:
+		return decodeEntity[UpdateFileDownloads](decoder)
+
+	case ConstructorUpdateFileAddedToDownloads:
+		return decodeEntity[UpdateFileAddedToDownloads](decoder)
+
+	case ConstructorUpdateFileDownload:
+		return decodeEntity[UpdateFileDownload](decoder)
+
+	case ConstructorUpdateFileRemovedFromDownloads:
+		return decodeEntity[UpdateFileRemovedFromDownloads](decoder)
+
+	case ConstructorUpdateApplicationVerificationRequired:
+		return decodeEntity[UpdateApplicationVerificationRequired](decoder)
+
+	case ConstructorUpdateApplicationRecaptchaVerificationRequired:
+		return decodeEntity[UpdateApplicationRecaptchaVerificationRequired](decoder)
+
+	case ConstructorUpdateCall:
+		return decodeEntity[UpdateCall](decoder)
+
+	case ConstructorUpdateGroupCall:
+		return decodeEntity[UpdateGroupCall](decoder)
+
+	case ConstructorUpdateGroupCallParticipant:
+		return decodeEntity[UpdateGroupCallParticipant](decoder)
+
+	case ConstructorUpdateNewCallSignalingData:
+		return decodeEntity[UpdateNewCallSignalingData](decoder)
+
+	case ConstructorUpdateUserPrivacySettingRules:
+		return decodeEntity[UpdateUserPrivacySettingRules](decoder)
+
+	case ConstructorUpdateUnreadMessageCount:
+		return decodeEntity[UpdateUnreadMessageCount](decoder)
+
+	case ConstructorUpdateUnreadChatCount:
+		return decodeEntity[UpdateUnreadChatCount](decoder)
+
+	case ConstructorUpdateStory:
+		return decodeEntity[UpdateStory](decoder)
+
+	case ConstructorUpdateStoryDeleted:
+		return decodeEntity[UpdateStoryDeleted](decoder)
+
+	case ConstructorUpdateStorySendSucceeded:
+		return decodeEntity[UpdateStorySendSucceeded](decoder)
+
+	case ConstructorUpdateStorySendFailed:
+		return decodeEntity[UpdateStorySendFailed](decoder)
+
+	case ConstructorUpdateChatActiveStories:
+		return decodeEntity[UpdateChatActiveStories](decoder)
+
+	case ConstructorUpdateStoryListChatCount:
+		return decodeEntity[UpdateStoryListChatCount](decoder)
+
+	case ConstructorUpdateStoryStealthMode:
+		return decodeEntity[UpdateStoryStealthMode](decoder)
+
+	case ConstructorUpdateOption:
+		return decodeEntity[UpdateOption](decoder)
+
+	case ConstructorUpdateStickerSet:
+		return decodeEntity[UpdateStickerSet](decoder)
+
+	case ConstructorUpdateInstalledStickerSets:
+		return decodeEntity[UpdateInstalledStickerSets](decoder)
+
+	case ConstructorUpdateTrendingStickerSets:
+		return decodeEntity[UpdateTrendingStickerSets](decoder)
+
+	case ConstructorUpdateRecentStickers:
+		return decodeEntity[UpdateRecentStickers](decoder)
+
+	case ConstructorUpdateFavoriteStickers:
+		return decodeEntity[UpdateFavoriteStickers](decoder)
+
+	case ConstructorUpdateSavedAnimations:
+		return decodeEntity[UpdateSavedAnimations](decoder)
+
+	case ConstructorUpdateSavedNotificationSounds:
+		return decodeEntity[UpdateSavedNotificationSounds](decoder)
+
+	case ConstructorUpdateDefaultBackground:
+		return decodeEntity[UpdateDefaultBackground](decoder)
+
+	case ConstructorUpdateChatThemes:
+		return decodeEntity[UpdateChatThemes](decoder)
+
+	case ConstructorUpdateAccentColors:
+		return decodeEntity[UpdateAccentColors](decoder)
+
+	case ConstructorUpdateProfileAccentColors:
+		return decodeEntity[UpdateProfileAccentColors](decoder)
+
+	case ConstructorUpdateLanguagePackStrings:
+		return decodeEntity[UpdateLanguagePackStrings](decoder)
+
+	case ConstructorUpdateConnectionState:
+		return decodeEntity[UpdateConnectionState](decoder)
+
+	case ConstructorUpdateFreezeState:
+		return decodeEntity[UpdateFreezeState](decoder)
+
+	case ConstructorUpdateTermsOfService:
+		return decodeEntity[UpdateTermsOfService](decoder)
+
+	case ConstructorUpdateUnconfirmedSession:
+		return decodeEntity[UpdateUnconfirmedSession](decoder)
+
+	case ConstructorUpdateAttachmentMenuBots:
+		return decodeEntity[UpdateAttachmentMenuBots](decoder)
+
+	case ConstructorUpdateWebAppMessageSent:
+		return decodeEntity[UpdateWebAppMessageSent](decoder)
+
+	case ConstructorUpdateActiveEmojiReactions:
+		return decodeEntity[UpdateActiveEmojiReactions](decoder)
+
+	case ConstructorUpdateAvailableMessageEffects:
+		return decodeEntity[UpdateAvailableMessageEffects](decoder)
+
+	case ConstructorUpdateDefaultReactionType:
+		return decodeEntity[UpdateDefaultReactionType](decoder)
+
+	case ConstructorUpdateDefaultPaidReactionType:
+		return decodeEntity[UpdateDefaultPaidReactionType](decoder)
+
+	case ConstructorUpdateSavedMessagesTags:
+		return decodeEntity[UpdateSavedMessagesTags](decoder)
+
+	case ConstructorUpdateActiveLiveLocationMessages:
+		return decodeEntity[UpdateActiveLiveLocationMessages](decoder)
+
+	case ConstructorUpdateOwnedStarCount:
+		return decodeEntity[UpdateOwnedStarCount](decoder)
+
+	case ConstructorUpdateChatRevenueAmount:
+		return decodeEntity[UpdateChatRevenueAmount](decoder)
+
+	case ConstructorUpdateStarRevenueStatus:
+		return decodeEntity[UpdateStarRevenueStatus](decoder)
+
+	case ConstructorUpdateSpeechRecognitionTrial:
+		return decodeEntity[UpdateSpeechRecognitionTrial](decoder)
+
+	case ConstructorUpdateDiceEmojis:
+		return decodeEntity[UpdateDiceEmojis](decoder)
+
+	case ConstructorUpdateAnimatedEmojiMessageClicked:
+		return decodeEntity[UpdateAnimatedEmojiMessageClicked](decoder)
+
+	case ConstructorUpdateAnimationSearchParameters:
+		return decodeEntity[UpdateAnimationSearchParameters](decoder)
+
+	case ConstructorUpdateSuggestedActions:
+		return decodeEntity[UpdateSuggestedActions](decoder)
+
+	case ConstructorUpdateSpeedLimitNotification:
+		return decodeEntity[UpdateSpeedLimitNotification](decoder)
+
+	case ConstructorUpdateContactCloseBirthdays:
+		return decodeEntity[UpdateContactCloseBirthdays](decoder)
+
+	case ConstructorUpdateAutosaveSettings:
+		return decodeEntity[UpdateAutosaveSettings](decoder)
+
+	case ConstructorUpdateBusinessConnection:
+		return decodeEntity[UpdateBusinessConnection](decoder)
+
+	case ConstructorUpdateNewBusinessMessage:
+		return decodeEntity[UpdateNewBusinessMessage](decoder)
+
+	case ConstructorUpdateBusinessMessageEdited:
+		return decodeEntity[UpdateBusinessMessageEdited](decoder)
+
+	case ConstructorUpdateBusinessMessagesDeleted:
+		return decodeEntity[UpdateBusinessMessagesDeleted](decoder)
+
+	case ConstructorUpdateNewInlineQuery:
+		return decodeEntity[UpdateNewInlineQuery](decoder)
+
+	case ConstructorUpdateNewChosenInlineResult:
+		return decodeEntity[UpdateNewChosenInlineResult](decoder)
+
+	case ConstructorUpdateNewCallbackQuery:
+		return decodeEntity[UpdateNewCallbackQuery](decoder)
+
+	case ConstructorUpdateNewInlineCallbackQuery:
+		return decodeEntity[UpdateNewInlineCallbackQuery](decoder)
+
+	case ConstructorUpdateNewBusinessCallbackQuery:
+		return decodeEntity[UpdateNewBusinessCallbackQuery](decoder)
+
+	case ConstructorUpdateNewShippingQuery:
+		return decodeEntity[UpdateNewShippingQuery](decoder)
+
+	case ConstructorUpdateNewPreCheckoutQuery:
+		return decodeEntity[UpdateNewPreCheckoutQuery](decoder)
+
+	case ConstructorUpdateNewCustomEvent:
+		return decodeEntity[UpdateNewCustomEvent](decoder)
+
+	case ConstructorUpdateNewCustomQuery:
+		return decodeEntity[UpdateNewCustomQuery](decoder)
+
+	case ConstructorUpdatePoll:
+		return decodeEntity[UpdatePoll](decoder)
+
+	case ConstructorUpdatePollAnswer:
+		return decodeEntity[UpdatePollAnswer](decoder)
+
+	case ConstructorUpdateChatMember:
+		return decodeEntity[UpdateChatMember](decoder)
+
+	case ConstructorUpdateNewChatJoinRequest:
+		return decodeEntity[UpdateNewChatJoinRequest](decoder)
+
+	case ConstructorUpdateChatBoost:
+		return decodeEntity[UpdateChatBoost](decoder)
+
+	case ConstructorUpdateMessageReaction:
+		return decodeEntity[UpdateMessageReaction](decoder)
+
+	case ConstructorUpdateMessageReactions:
+		return decodeEntity[UpdateMessageReactions](decoder)
+
+	case ConstructorUpdatePaidMediaPurchased:
+		return decodeEntity[UpdatePaidMediaPurchased](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfUpdate(dataList []json.RawMessage) ([]Update, error) {
+	list := make([]Update, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalUpdate(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalUpdateAuthorizationState(data json.RawMessage) (*UpdateAuthorizationState, error) {
+	var resp UpdateAuthorizationState
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewMessage(data json.RawMessage) (*UpdateNewMessage, error) {
+	var resp UpdateNewMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageSendAcknowledged(data json.RawMessage) (*UpdateMessageSendAcknowledged, error) {
+	var resp UpdateMessageSendAcknowledged
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageSendSucceeded(data json.RawMessage) (*UpdateMessageSendSucceeded, error) {
+	var resp UpdateMessageSendSucceeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageSendFailed(data json.RawMessage) (*UpdateMessageSendFailed, error) {
+	var resp UpdateMessageSendFailed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageContent(data json.RawMessage) (*UpdateMessageContent, error) {
+	var resp UpdateMessageContent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageEdited(data json.RawMessage) (*UpdateMessageEdited, error) {
+	var resp UpdateMessageEdited
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageIsPinned(data json.RawMessage) (*UpdateMessageIsPinned, error) {
+	var resp UpdateMessageIsPinned
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageInteractionInfo(data json.RawMessage) (*UpdateMessageInteractionInfo, error) {
+	var resp UpdateMessageInteractionInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageContentOpened(data json.RawMessage) (*UpdateMessageContentOpened, error) {
+	var resp UpdateMessageContentOpened
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageMentionRead(data json.RawMessage) (*UpdateMessageMentionRead, error) {
+	var resp UpdateMessageMentionRead
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageUnreadReactions(data json.RawMessage) (*UpdateMessageUnreadReactions, error) {
+	var resp UpdateMessageUnreadReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageFactCheck(data json.RawMessage) (*UpdateMessageFactCheck, error) {
+	var resp UpdateMessageFactCheck
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageLiveLocationViewed(data json.RawMessage) (*UpdateMessageLiveLocationViewed, error) {
+	var resp UpdateMessageLiveLocationViewed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateVideoPublished(data json.RawMessage) (*UpdateVideoPublished, error) {
+	var resp UpdateVideoPublished
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewChat(data json.RawMessage) (*UpdateNewChat, error) {
+	var resp UpdateNewChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatTitle(data json.RawMessage) (*UpdateChatTitle, error) {
+	var resp UpdateChatTitle
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatPhoto(data json.RawMessage) (*UpdateChatPhoto, error) {
+	var resp UpdateChatPhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatAccentColors(data json.RawMessage) (*UpdateChatAccentColors, error) {
+	var resp UpdateChatAccentColors
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatPermissions(data json.RawMessage) (*UpdateChatPermissions, error) {
+	var resp UpdateChatPermissions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatLastMessage(data json.RawMessage) (*UpdateChatLastMessage, error) {
+	var resp UpdateChatLastMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatPosition(data json.RawMessage) (*UpdateChatPosition, error) {
+	var resp UpdateChatPosition
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatAddedToList(data json.RawMessage) (*UpdateChatAddedToList, error) {
+	var resp UpdateChatAddedToList
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatRemovedFromList(data json.RawMessage) (*UpdateChatRemovedFromList, error) {
+	var resp UpdateChatRemovedFromList
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatReadInbox(data json.RawMessage) (*UpdateChatReadInbox, error) {
+	var resp UpdateChatReadInbox
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatReadOutbox(data json.RawMessage) (*UpdateChatReadOutbox, error) {
+	var resp UpdateChatReadOutbox
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatActionBar(data json.RawMessage) (*UpdateChatActionBar, error) {
+	var resp UpdateChatActionBar
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatBusinessBotManageBar(data json.RawMessage) (*UpdateChatBusinessBotManageBar, error) {
+	var resp UpdateChatBusinessBotManageBar
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatAvailableReactions(data json.RawMessage) (*UpdateChatAvailableReactions, error) {
+	var resp UpdateChatAvailableReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatDraftMessage(data json.RawMessage) (*UpdateChatDraftMessage, error) {
+	var resp UpdateChatDraftMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatEmojiStatus(data json.RawMessage) (*UpdateChatEmojiStatus, error) {
+	var resp UpdateChatEmojiStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatMessageSender(data json.RawMessage) (*UpdateChatMessageSender, error) {
+	var resp UpdateChatMessageSender
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatMessageAutoDeleteTime(data json.RawMessage) (*UpdateChatMessageAutoDeleteTime, error) {
+	var resp UpdateChatMessageAutoDeleteTime
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatNotificationSettings(data json.RawMessage) (*UpdateChatNotificationSettings, error) {
+	var resp UpdateChatNotificationSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatPendingJoinRequests(data json.RawMessage) (*UpdateChatPendingJoinRequests, error) {
+	var resp UpdateChatPendingJoinRequests
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatReplyMarkup(data json.RawMessage) (*UpdateChatReplyMarkup, error) {
+	var resp UpdateChatReplyMarkup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatBackground(data json.RawMessage) (*UpdateChatBackground, error) {
+	var resp UpdateChatBackground
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatTheme(data json.RawMessage) (*UpdateChatTheme, error) {
+	var resp UpdateChatTheme
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatUnreadMentionCount(data json.RawMessage) (*UpdateChatUnreadMentionCount, error) {
+	var resp UpdateChatUnreadMentionCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatUnreadReactionCount(data json.RawMessage) (*UpdateChatUnreadReactionCount, error) {
+	var resp UpdateChatUnreadReactionCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatVideoChat(data json.RawMessage) (*UpdateChatVideoChat, error) {
+	var resp UpdateChatVideoChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatDefaultDisableNotification(data json.RawMessage) (*UpdateChatDefaultDisableNotification, error) {
+	var resp UpdateChatDefaultDisableNotification
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatHasProtectedContent(data json.RawMessage) (*UpdateChatHasProtectedContent, error) {
+	var resp UpdateChatHasProtectedContent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatIsTranslatable(data json.RawMessage) (*UpdateChatIsTranslatable, error) {
+	var resp UpdateChatIsTranslatable
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatIsMarkedAsUnread(data json.RawMessage) (*UpdateChatIsMarkedAsUnread, error) {
+	var resp UpdateChatIsMarkedAsUnread
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatViewAsTopics(data json.RawMessage) (*UpdateChatViewAsTopics, error) {
+	var resp UpdateChatViewAsTopics
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatBlockList(data json.RawMessage) (*UpdateChatBlockList, error) {
+	var resp UpdateChatBlockList
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatHasScheduledMessages(data json.RawMessage) (*UpdateChatHasScheduledMessages, error) {
+	var resp UpdateChatHasScheduledMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatFolders(data json.RawMessage) (*UpdateChatFolders, error) {
+	var resp UpdateChatFolders
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatOnlineMemberCount(data json.RawMessage) (*UpdateChatOnlineMemberCount, error) {
+	var resp UpdateChatOnlineMemberCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSavedMessagesTopic(data json.RawMessage) (*UpdateSavedMessagesTopic, error) {
+	var resp UpdateSavedMessagesTopic
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSavedMessagesTopicCount(data json.RawMessage) (*UpdateSavedMessagesTopicCount, error) {
+	var resp UpdateSavedMessagesTopicCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateQuickReplyShortcut(data json.RawMessage) (*UpdateQuickReplyShortcut, error) {
+	var resp UpdateQuickReplyShortcut
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateQuickReplyShortcutDeleted(data json.RawMessage) (*UpdateQuickReplyShortcutDeleted, error) {
+	var resp UpdateQuickReplyShortcutDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateQuickReplyShortcuts(data json.RawMessage) (*UpdateQuickReplyShortcuts, error) {
+	var resp UpdateQuickReplyShortcuts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateQuickReplyShortcutMessages(data json.RawMessage) (*UpdateQuickReplyShortcutMessages, error) {
+	var resp UpdateQuickReplyShortcutMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateForumTopicInfo(data json.RawMessage) (*UpdateForumTopicInfo, error) {
+	var resp UpdateForumTopicInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateForumTopic(data json.RawMessage) (*UpdateForumTopic, error) {
+	var resp UpdateForumTopic
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateScopeNotificationSettings(data json.RawMessage) (*UpdateScopeNotificationSettings, error) {
+	var resp UpdateScopeNotificationSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateReactionNotificationSettings(data json.RawMessage) (*UpdateReactionNotificationSettings, error) {
+	var resp UpdateReactionNotificationSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNotification(data json.RawMessage) (*UpdateNotification, error) {
+	var resp UpdateNotification
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNotificationGroup(data json.RawMessage) (*UpdateNotificationGroup, error) {
+	var resp UpdateNotificationGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateActiveNotifications(data json.RawMessage) (*UpdateActiveNotifications, error) {
+	var resp UpdateActiveNotifications
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateHavePendingNotifications(data json.RawMessage) (*UpdateHavePendingNotifications, error) {
+	var resp UpdateHavePendingNotifications
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateDeleteMessages(data json.RawMessage) (*UpdateDeleteMessages, error) {
+	var resp UpdateDeleteMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatAction(data json.RawMessage) (*UpdateChatAction, error) {
+	var resp UpdateChatAction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateUserStatus(data json.RawMessage) (*UpdateUserStatus, error) {
+	var resp UpdateUserStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateUser(data json.RawMessage) (*UpdateUser, error) {
+	var resp UpdateUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateBasicGroup(data json.RawMessage) (*UpdateBasicGroup, error) {
+	var resp UpdateBasicGroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSupergroup(data json.RawMessage) (*UpdateSupergroup, error) {
+	var resp UpdateSupergroup
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSecretChat(data json.RawMessage) (*UpdateSecretChat, error) {
+	var resp UpdateSecretChat
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateUserFullInfo(data json.RawMessage) (*UpdateUserFullInfo, error) {
+	var resp UpdateUserFullInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateBasicGroupFullInfo(data json.RawMessage) (*UpdateBasicGroupFullInfo, error) {
+	var resp UpdateBasicGroupFullInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSupergroupFullInfo(data json.RawMessage) (*UpdateSupergroupFullInfo, error) {
+	var resp UpdateSupergroupFullInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateServiceNotification(data json.RawMessage) (*UpdateServiceNotification, error) {
+	var resp UpdateServiceNotification
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFile(data json.RawMessage) (*UpdateFile, error) {
+	var resp UpdateFile
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFileGenerationStart(data json.RawMessage) (*UpdateFileGenerationStart, error) {
+	var resp UpdateFileGenerationStart
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFileGenerationStop(data json.RawMessage) (*UpdateFileGenerationStop, error) {
+	var resp UpdateFileGenerationStop
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFileDownloads(data json.RawMessage) (*UpdateFileDownloads, error) {
+	var resp UpdateFileDownloads
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFileAddedToDownloads(data json.RawMessage) (*UpdateFileAddedToDownloads, error) {
+	var resp UpdateFileAddedToDownloads
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFileDownload(data json.RawMessage) (*UpdateFileDownload, error) {
+	var resp UpdateFileDownload
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFileRemovedFromDownloads(data json.RawMessage) (*UpdateFileRemovedFromDownloads, error) {
+	var resp UpdateFileRemovedFromDownloads
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateApplicationVerificationRequired(data json.RawMessage) (*UpdateApplicationVerificationRequired, error) {
+	var resp UpdateApplicationVerificationRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateApplicationRecaptchaVerificationRequired(data json.RawMessage) (*UpdateApplicationRecaptchaVerificationRequired, error) {
+	var resp UpdateApplicationRecaptchaVerificationRequired
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateCall(data json.RawMessage) (*UpdateCall, error) {
+	var resp UpdateCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateGroupCall(data json.RawMessage) (*UpdateGroupCall, error) {
+	var resp UpdateGroupCall
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateGroupCallParticipant(data json.RawMessage) (*UpdateGroupCallParticipant, error) {
+	var resp UpdateGroupCallParticipant
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewCallSignalingData(data json.RawMessage) (*UpdateNewCallSignalingData, error) {
+	var resp UpdateNewCallSignalingData
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateUserPrivacySettingRules(data json.RawMessage) (*UpdateUserPrivacySettingRules, error) {
+	var resp UpdateUserPrivacySettingRules
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateUnreadMessageCount(data json.RawMessage) (*UpdateUnreadMessageCount, error) {
+	var resp UpdateUnreadMessageCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateUnreadChatCount(data json.RawMessage) (*UpdateUnreadChatCount, error) {
+	var resp UpdateUnreadChatCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStory(data json.RawMessage) (*UpdateStory, error) {
+	var resp UpdateStory
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStoryDeleted(data json.RawMessage) (*UpdateStoryDeleted, error) {
+	var resp UpdateStoryDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStorySendSucceeded(data json.RawMessage) (*UpdateStorySendSucceeded, error) {
+	var resp UpdateStorySendSucceeded
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStorySendFailed(data json.RawMessage) (*UpdateStorySendFailed, error) {
+	var resp UpdateStorySendFailed
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatActiveStories(data json.RawMessage) (*UpdateChatActiveStories, error) {
+	var resp UpdateChatActiveStories
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStoryListChatCount(data json.RawMessage) (*UpdateStoryListChatCount, error) {
+	var resp UpdateStoryListChatCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStoryStealthMode(data json.RawMessage) (*UpdateStoryStealthMode, error) {
+	var resp UpdateStoryStealthMode
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateOption(data json.RawMessage) (*UpdateOption, error) {
+	var resp UpdateOption
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStickerSet(data json.RawMessage) (*UpdateStickerSet, error) {
+	var resp UpdateStickerSet
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateInstalledStickerSets(data json.RawMessage) (*UpdateInstalledStickerSets, error) {
+	var resp UpdateInstalledStickerSets
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateTrendingStickerSets(data json.RawMessage) (*UpdateTrendingStickerSets, error) {
+	var resp UpdateTrendingStickerSets
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateRecentStickers(data json.RawMessage) (*UpdateRecentStickers, error) {
+	var resp UpdateRecentStickers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFavoriteStickers(data json.RawMessage) (*UpdateFavoriteStickers, error) {
+	var resp UpdateFavoriteStickers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSavedAnimations(data json.RawMessage) (*UpdateSavedAnimations, error) {
+	var resp UpdateSavedAnimations
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSavedNotificationSounds(data json.RawMessage) (*UpdateSavedNotificationSounds, error) {
+	var resp UpdateSavedNotificationSounds
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateDefaultBackground(data json.RawMessage) (*UpdateDefaultBackground, error) {
+	var resp UpdateDefaultBackground
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatThemes(data json.RawMessage) (*UpdateChatThemes, error) {
+	var resp UpdateChatThemes
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateAccentColors(data json.RawMessage) (*UpdateAccentColors, error) {
+	var resp UpdateAccentColors
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateProfileAccentColors(data json.RawMessage) (*UpdateProfileAccentColors, error) {
+	var resp UpdateProfileAccentColors
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateLanguagePackStrings(data json.RawMessage) (*UpdateLanguagePackStrings, error) {
+	var resp UpdateLanguagePackStrings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateConnectionState(data json.RawMessage) (*UpdateConnectionState, error) {
+	var resp UpdateConnectionState
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateFreezeState(data json.RawMessage) (*UpdateFreezeState, error) {
+	var resp UpdateFreezeState
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateTermsOfService(data json.RawMessage) (*UpdateTermsOfService, error) {
+	var resp UpdateTermsOfService
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateUnconfirmedSession(data json.RawMessage) (*UpdateUnconfirmedSession, error) {
+	var resp UpdateUnconfirmedSession
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateAttachmentMenuBots(data json.RawMessage) (*UpdateAttachmentMenuBots, error) {
+	var resp UpdateAttachmentMenuBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateWebAppMessageSent(data json.RawMessage) (*UpdateWebAppMessageSent, error) {
+	var resp UpdateWebAppMessageSent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateActiveEmojiReactions(data json.RawMessage) (*UpdateActiveEmojiReactions, error) {
+	var resp UpdateActiveEmojiReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateAvailableMessageEffects(data json.RawMessage) (*UpdateAvailableMessageEffects, error) {
+	var resp UpdateAvailableMessageEffects
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateDefaultReactionType(data json.RawMessage) (*UpdateDefaultReactionType, error) {
+	var resp UpdateDefaultReactionType
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateDefaultPaidReactionType(data json.RawMessage) (*UpdateDefaultPaidReactionType, error) {
+	var resp UpdateDefaultPaidReactionType
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSavedMessagesTags(data json.RawMessage) (*UpdateSavedMessagesTags, error) {
+	var resp UpdateSavedMessagesTags
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateActiveLiveLocationMessages(data json.RawMessage) (*UpdateActiveLiveLocationMessages, error) {
+	var resp UpdateActiveLiveLocationMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateOwnedStarCount(data json.RawMessage) (*UpdateOwnedStarCount, error) {
+	var resp UpdateOwnedStarCount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatRevenueAmount(data json.RawMessage) (*UpdateChatRevenueAmount, error) {
+	var resp UpdateChatRevenueAmount
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateStarRevenueStatus(data json.RawMessage) (*UpdateStarRevenueStatus, error) {
+	var resp UpdateStarRevenueStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSpeechRecognitionTrial(data json.RawMessage) (*UpdateSpeechRecognitionTrial, error) {
+	var resp UpdateSpeechRecognitionTrial
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateDiceEmojis(data json.RawMessage) (*UpdateDiceEmojis, error) {
+	var resp UpdateDiceEmojis
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateAnimatedEmojiMessageClicked(data json.RawMessage) (*UpdateAnimatedEmojiMessageClicked, error) {
+	var resp UpdateAnimatedEmojiMessageClicked
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateAnimationSearchParameters(data json.RawMessage) (*UpdateAnimationSearchParameters, error) {
+	var resp UpdateAnimationSearchParameters
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSuggestedActions(data json.RawMessage) (*UpdateSuggestedActions, error) {
+	var resp UpdateSuggestedActions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateSpeedLimitNotification(data json.RawMessage) (*UpdateSpeedLimitNotification, error) {
+	var resp UpdateSpeedLimitNotification
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateContactCloseBirthdays(data json.RawMessage) (*UpdateContactCloseBirthdays, error) {
+	var resp UpdateContactCloseBirthdays
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateAutosaveSettings(data json.RawMessage) (*UpdateAutosaveSettings, error) {
+	var resp UpdateAutosaveSettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateBusinessConnection(data json.RawMessage) (*UpdateBusinessConnection, error) {
+	var resp UpdateBusinessConnection
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewBusinessMessage(data json.RawMessage) (*UpdateNewBusinessMessage, error) {
+	var resp UpdateNewBusinessMessage
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateBusinessMessageEdited(data json.RawMessage) (*UpdateBusinessMessageEdited, error) {
+	var resp UpdateBusinessMessageEdited
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateBusinessMessagesDeleted(data json.RawMessage) (*UpdateBusinessMessagesDeleted, error) {
+	var resp UpdateBusinessMessagesDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewInlineQuery(data json.RawMessage) (*UpdateNewInlineQuery, error) {
+	var resp UpdateNewInlineQuery
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewChosenInlineResult(data json.RawMessage) (*UpdateNewChosenInlineResult, error) {
+	var resp UpdateNewChosenInlineResult
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewCallbackQuery(data json.RawMessage) (*UpdateNewCallbackQuery, error) {
+	var resp UpdateNewCallbackQuery
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewInlineCallbackQuery(data json.RawMessage) (*UpdateNewInlineCallbackQuery, error) {
+	var resp UpdateNewInlineCallbackQuery
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewBusinessCallbackQuery(data json.RawMessage) (*UpdateNewBusinessCallbackQuery, error) {
+	var resp UpdateNewBusinessCallbackQuery
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewShippingQuery(data json.RawMessage) (*UpdateNewShippingQuery, error) {
+	var resp UpdateNewShippingQuery
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewPreCheckoutQuery(data json.RawMessage) (*UpdateNewPreCheckoutQuery, error) {
+	var resp UpdateNewPreCheckoutQuery
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewCustomEvent(data json.RawMessage) (*UpdateNewCustomEvent, error) {
+	var resp UpdateNewCustomEvent
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewCustomQuery(data json.RawMessage) (*UpdateNewCustomQuery, error) {
+	var resp UpdateNewCustomQuery
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdatePoll(data json.RawMessage) (*UpdatePoll, error) {
+	var resp UpdatePoll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdatePollAnswer(data json.RawMessage) (*UpdatePollAnswer, error) {
+	var resp UpdatePollAnswer
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatMember(data json.RawMessage) (*UpdateChatMember, error) {
+	var resp UpdateChatMember
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateNewChatJoinRequest(data json.RawMessage) (*UpdateNewChatJoinRequest, error) {
+	var resp UpdateNewChatJoinRequest
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateChatBoost(data json.RawMessage) (*UpdateChatBoost, error) {
+	var resp UpdateChatBoost
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageReaction(data json.RawMessage) (*UpdateMessageReaction, error) {
+	var resp UpdateMessageReaction
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdateMessageReactions(data json.RawMessage) (*UpdateMessageReactions, error) {
+	var resp UpdateMessageReactions
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdatePaidMediaPurchased(data json.RawMessage) (*UpdatePaidMediaPurchased, error) {
+	var resp UpdatePaidMediaPurchased
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUpdates(data json.RawMessage) (*Updates, error) {
+	var resp Updates
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
@@ -0,0 +1,551 @@
+// AUTOGENERATED
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func UnmarshalUserType(data json.RawMessage) (UserType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeUserType)
+}
+
+func decodeUserType(decoder *jsonDecoder) (UserType, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorUserTypeRegular:
+		return decodeEntity[UserTypeRegular](decoder)
+
+	case ConstructorUserTypeDeleted:
+		return decodeEntity[UserTypeDeleted](decoder)
+
+	case ConstructorUserTypeBot:
+		return decodeEntity[UserTypeBot](decoder)
+
+	case ConstructorUserTypeUnknown:
+		return decodeEntity[UserTypeUnknown](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfUserType(dataList []json.RawMessage) ([]UserType, error) {
+	list := make([]UserType, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalUserType(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalUserStatus(data json.RawMessage) (UserStatus, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeUserStatus)
+}
+
+func decodeUserStatus(decoder *jsonDecoder) (UserStatus, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorUserStatusEmpty:
+		return decodeEntity[UserStatusEmpty](decoder)
+
+	case ConstructorUserStatusOnline:
+		return decodeEntity[UserStatusOnline](decoder)
+
+	case ConstructorUserStatusOffline:
+		return decodeEntity[UserStatusOffline](decoder)
+
+	case ConstructorUserStatusRecently:
+		return decodeEntity[UserStatusRecently](decoder)
+
+	case ConstructorUserStatusLastWeek:
+		return decodeEntity[UserStatusLastWeek](decoder)
+
+	case ConstructorUserStatusLastMonth:
+		return decodeEntity[UserStatusLastMonth](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfUserStatus(dataList []json.RawMessage) ([]UserStatus, error) {
+	list := make([]UserStatus, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalUserStatus(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalUserPrivacySettingRule(data json.RawMessage) (UserPrivacySettingRule, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeUserPrivacySettingRule)
+}
+
+func decodeUserPrivacySettingRule(decoder *jsonDecoder) (UserPrivacySettingRule, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorUserPrivacySettingRuleAllowAll:
+		return decodeEntity[UserPrivacySettingRuleAllowAll](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowContacts:
+		return decodeEntity[UserPrivacySettingRuleAllowContacts](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowBots:
+		return decodeEntity[UserPrivacySettingRuleAllowBots](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowPremiumUsers:
+		return decodeEntity[UserPrivacySettingRuleAllowPremiumUsers](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowUsers:
+		return decodeEntity[UserPrivacySettingRuleAllowUsers](decoder)
+
+	case ConstructorUserPrivacySettingRuleAllowChatMembers:
+		return decodeEntity[UserPrivacySettingRuleAllowChatMembers](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictAll:
+		return decodeEntity[UserPrivacySettingRuleRestrictAll](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictContacts:
+		return decodeEntity[UserPrivacySettingRuleRestrictContacts](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictBots:
+		return decodeEntity[UserPrivacySettingRuleRestrictBots](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictUsers:
+		return decodeEntity[UserPrivacySettingRuleRestrictUsers](decoder)
+
+	case ConstructorUserPrivacySettingRuleRestrictChatMembers:
+		return decodeEntity[UserPrivacySettingRuleRestrictChatMembers](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfUserPrivacySettingRule(dataList []json.RawMessage) ([]UserPrivacySettingRule, error) {
+	list := make([]UserPrivacySettingRule, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalUserPrivacySettingRule(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalUserPrivacySetting(data json.RawMessage) (UserPrivacySetting, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	return unmarshalJSON(data, decodeUserPrivacySetting)
+}
+
+func decodeUserPrivacySetting(decoder *jsonDecoder) (UserPrivacySetting, error) {
+	if decoder.readNull() {
+		return nil, nil
+	}
+
+	constructor, err := decoder.readConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(constructor) {
+	case ConstructorUserPrivacySettingShowStatus:
+		return decodeEntity[UserPrivacySettingShowStatus](decoder)
+
+	case ConstructorUserPrivacySettingShowProfilePhoto:
+		return decodeEntity[UserPrivacySettingShowProfilePhoto](decoder)
+
+	case ConstructorUserPrivacySettingShowLinkInForwardedMessages:
+		return decodeEntity[UserPrivacySettingShowLinkInForwardedMessages](decoder)
+
+	case ConstructorUserPrivacySettingShowPhoneNumber:
+		return decodeEntity[UserPrivacySettingShowPhoneNumber](decoder)
+
+	case ConstructorUserPrivacySettingShowBio:
+		return decodeEntity[UserPrivacySettingShowBio](decoder)
+
+	case ConstructorUserPrivacySettingShowBirthdate:
+		return decodeEntity[UserPrivacySettingShowBirthdate](decoder)
+
+	case ConstructorUserPrivacySettingAllowChatInvites:
+		return decodeEntity[UserPrivacySettingAllowChatInvites](decoder)
+
+	case ConstructorUserPrivacySettingAllowCalls:
+		return decodeEntity[UserPrivacySettingAllowCalls](decoder)
+
+	case ConstructorUserPrivacySettingAllowPeerToPeerCalls:
+		return decodeEntity[UserPrivacySettingAllowPeerToPeerCalls](decoder)
+
+	case ConstructorUserPrivacySettingAllowFindingByPhoneNumber:
+		return decodeEntity[UserPrivacySettingAllowFindingByPhoneNumber](decoder)
+
+	case ConstructorUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages:
+		return decodeEntity[UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages](decoder)
+
+	case ConstructorUserPrivacySettingAutosaveGifts:
+		return decodeEntity[UserPrivacySettingAutosaveGifts](decoder)
+
+	case ConstructorUserPrivacySettingAllowUnpaidMessages:
+		return decodeEntity[UserPrivacySettingAllowUnpaidMessages](decoder)
+
+	default:
+		return decoder.unknownType(constructor)
+	}
+}
+
+func UnmarshalListOfUserPrivacySetting(dataList []json.RawMessage) ([]UserPrivacySetting, error) {
+	list := make([]UserPrivacySetting, 0, len(dataList))
+	for i, data := range dataList {
+		entity, err := UnmarshalUserPrivacySetting(data)
+		if err != nil {
+			return nil, wrapUnmarshalError(fmt.Sprintf("[%d]", i), err)
+		}
+		list = append(list, entity)
+	}
+
+	return list, nil
+}
+
+func UnmarshalContact(data json.RawMessage) (*Contact, error) {
+	var resp Contact
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProfilePhoto(data json.RawMessage) (*ProfilePhoto, error) {
+	var resp ProfilePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserTypeRegular(data json.RawMessage) (*UserTypeRegular, error) {
+	var resp UserTypeRegular
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserTypeDeleted(data json.RawMessage) (*UserTypeDeleted, error) {
+	var resp UserTypeDeleted
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserTypeBot(data json.RawMessage) (*UserTypeBot, error) {
+	var resp UserTypeBot
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserTypeUnknown(data json.RawMessage) (*UserTypeUnknown, error) {
+	var resp UserTypeUnknown
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalCloseBirthdayUser(data json.RawMessage) (*CloseBirthdayUser, error) {
+	var resp CloseBirthdayUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProfileAccentColors(data json.RawMessage) (*ProfileAccentColors, error) {
+	var resp ProfileAccentColors
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalProfileAccentColor(data json.RawMessage) (*ProfileAccentColor, error) {
+	var resp ProfileAccentColor
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUser(data json.RawMessage) (*User, error) {
+	var resp User
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserFullInfo(data json.RawMessage) (*UserFullInfo, error) {
+	var resp UserFullInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUsers(data json.RawMessage) (*Users, error) {
+	var resp Users
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalFoundUsers(data json.RawMessage) (*FoundUsers, error) {
+	var resp FoundUsers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalSharedUser(data json.RawMessage) (*SharedUser, error) {
+	var resp SharedUser
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserStatusEmpty(data json.RawMessage) (*UserStatusEmpty, error) {
+	var resp UserStatusEmpty
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserStatusOnline(data json.RawMessage) (*UserStatusOnline, error) {
+	var resp UserStatusOnline
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserStatusOffline(data json.RawMessage) (*UserStatusOffline, error) {
+	var resp UserStatusOffline
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserStatusRecently(data json.RawMessage) (*UserStatusRecently, error) {
+	var resp UserStatusRecently
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserStatusLastWeek(data json.RawMessage) (*UserStatusLastWeek, error) {
+	var resp UserStatusLastWeek
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserStatusLastMonth(data json.RawMessage) (*UserStatusLastMonth, error) {
+	var resp UserStatusLastMonth
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalImportedContacts(data json.RawMessage) (*ImportedContacts, error) {
+	var resp ImportedContacts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserLink(data json.RawMessage) (*UserLink, error) {
+	var resp UserLink
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleAllowAll(data json.RawMessage) (*UserPrivacySettingRuleAllowAll, error) {
+	var resp UserPrivacySettingRuleAllowAll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleAllowContacts(data json.RawMessage) (*UserPrivacySettingRuleAllowContacts, error) {
+	var resp UserPrivacySettingRuleAllowContacts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleAllowBots(data json.RawMessage) (*UserPrivacySettingRuleAllowBots, error) {
+	var resp UserPrivacySettingRuleAllowBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleAllowPremiumUsers(data json.RawMessage) (*UserPrivacySettingRuleAllowPremiumUsers, error) {
+	var resp UserPrivacySettingRuleAllowPremiumUsers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleAllowUsers(data json.RawMessage) (*UserPrivacySettingRuleAllowUsers, error) {
+	var resp UserPrivacySettingRuleAllowUsers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleAllowChatMembers(data json.RawMessage) (*UserPrivacySettingRuleAllowChatMembers, error) {
+	var resp UserPrivacySettingRuleAllowChatMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleRestrictAll(data json.RawMessage) (*UserPrivacySettingRuleRestrictAll, error) {
+	var resp UserPrivacySettingRuleRestrictAll
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleRestrictContacts(data json.RawMessage) (*UserPrivacySettingRuleRestrictContacts, error) {
+	var resp UserPrivacySettingRuleRestrictContacts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleRestrictBots(data json.RawMessage) (*UserPrivacySettingRuleRestrictBots, error) {
+	var resp UserPrivacySettingRuleRestrictBots
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleRestrictUsers(data json.RawMessage) (*UserPrivacySettingRuleRestrictUsers, error) {
+	var resp UserPrivacySettingRuleRestrictUsers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRuleRestrictChatMembers(data json.RawMessage) (*UserPrivacySettingRuleRestrictChatMembers, error) {
+	var resp UserPrivacySettingRuleRestrictChatMembers
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingRules(data json.RawMessage) (*UserPrivacySettingRules, error) {
+	var resp UserPrivacySettingRules
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingShowStatus(data json.RawMessage) (*UserPrivacySettingShowStatus, error) {
+	var resp UserPrivacySettingShowStatus
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingShowProfilePhoto(data json.RawMessage) (*UserPrivacySettingShowProfilePhoto, error) {
+	var resp UserPrivacySettingShowProfilePhoto
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingShowLinkInForwardedMessages(data json.RawMessage) (*UserPrivacySettingShowLinkInForwardedMessages, error) {
+	var resp UserPrivacySettingShowLinkInForwardedMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingShowPhoneNumber(data json.RawMessage) (*UserPrivacySettingShowPhoneNumber, error) {
+	var resp UserPrivacySettingShowPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingShowBio(data json.RawMessage) (*UserPrivacySettingShowBio, error) {
+	var resp UserPrivacySettingShowBio
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingShowBirthdate(data json.RawMessage) (*UserPrivacySettingShowBirthdate, error) {
+	var resp UserPrivacySettingShowBirthdate
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingAllowChatInvites(data json.RawMessage) (*UserPrivacySettingAllowChatInvites, error) {
+	var resp UserPrivacySettingAllowChatInvites
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingAllowCalls(data json.RawMessage) (*UserPrivacySettingAllowCalls, error) {
+	var resp UserPrivacySettingAllowCalls
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingAllowPeerToPeerCalls(data json.RawMessage) (*UserPrivacySettingAllowPeerToPeerCalls, error) {
+	var resp UserPrivacySettingAllowPeerToPeerCalls
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingAllowFindingByPhoneNumber(data json.RawMessage) (*UserPrivacySettingAllowFindingByPhoneNumber, error) {
+	var resp UserPrivacySettingAllowFindingByPhoneNumber
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages(data json.RawMessage) (*UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages, error) {
+	var resp UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingAutosaveGifts(data json.RawMessage) (*UserPrivacySettingAutosaveGifts, error) {
+	var resp UserPrivacySettingAutosaveGifts
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserPrivacySettingAllowUnpaidMessages(data json.RawMessage) (*UserPrivacySettingAllowUnpaidMessages, error) {
+	var resp UserPrivacySettingAllowUnpaidMessages
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalReadDatePrivacySettings(data json.RawMessage) (*ReadDatePrivacySettings, error) {
+	var resp ReadDatePrivacySettings
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
+
+func UnmarshalUserSupportInfo(data json.RawMessage) (*UserSupportInfo, error) {
+	var resp UserSupportInfo
+	err := resp.UnmarshalJSON(data)
+	return &resp, err
+}
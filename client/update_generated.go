@@ -0,0 +1,1257 @@
+// AUTOGENERATED
+package client
+
+// UpdateHandler dispatches updates to typed callbacks. Nil callbacks are skipped.
+type UpdateHandler struct {
+	// The user authorization state has changed
+	OnAuthorizationState func(update *UpdateAuthorizationState)
+	// A new message was received; can also be an outgoing message
+	OnNewMessage func(update *UpdateNewMessage)
+	// A request to send a message has reached the Telegram server. This doesn't mean that the message will be sent successfully. This update is sent only if the option "use_quick_ack" is set to true. This update may be sent multiple times for the same message
+	OnMessageSendAcknowledged func(update *UpdateMessageSendAcknowledged)
+	// A message has been successfully sent
+	OnMessageSendSucceeded func(update *UpdateMessageSendSucceeded)
+	// A message failed to send. Be aware that some messages being sent can be irrecoverably deleted, in which case updateDeleteMessages will be received instead of this update
+	OnMessageSendFailed func(update *UpdateMessageSendFailed)
+	// The message content has changed
+	OnMessageContent func(update *UpdateMessageContent)
+	// A message was edited. Changes in the message content will come in a separate updateMessageContent
+	OnMessageEdited func(update *UpdateMessageEdited)
+	// The message pinned state was changed
+	OnMessageIsPinned func(update *UpdateMessageIsPinned)
+	// The information about interactions with a message has changed
+	OnMessageInteractionInfo func(update *UpdateMessageInteractionInfo)
+	// The message content was opened. Updates voice note messages to "listened", video note messages to "viewed" and starts the self-destruct timer
+	OnMessageContentOpened func(update *UpdateMessageContentOpened)
+	// A message with an unread mention was read
+	OnMessageMentionRead func(update *UpdateMessageMentionRead)
+	// The list of unread reactions added to a message was changed
+	OnMessageUnreadReactions func(update *UpdateMessageUnreadReactions)
+	// A fact-check added to a message was changed
+	OnMessageFactCheck func(update *UpdateMessageFactCheck)
+	// A message with a live location was viewed. When the update is received, the application is expected to update the live location
+	OnMessageLiveLocationViewed func(update *UpdateMessageLiveLocationViewed)
+	// An automatically scheduled message with video has been successfully sent after conversion
+	OnVideoPublished func(update *UpdateVideoPublished)
+	// A new chat has been loaded/created. This update is guaranteed to come before the chat identifier is returned to the application. The chat field changes will be reported through separate updates
+	OnNewChat func(update *UpdateNewChat)
+	// The title of a chat was changed
+	OnChatTitle func(update *UpdateChatTitle)
+	// A chat photo was changed
+	OnChatPhoto func(update *UpdateChatPhoto)
+	// Chat accent colors have changed
+	OnChatAccentColors func(update *UpdateChatAccentColors)
+	// Chat permissions were changed
+	OnChatPermissions func(update *UpdateChatPermissions)
+	// The last message of a chat was changed
+	OnChatLastMessage func(update *UpdateChatLastMessage)
+	// The position of a chat in a chat list has changed. An updateChatLastMessage or updateChatDraftMessage update might be sent instead of the update
+	OnChatPosition func(update *UpdateChatPosition)
+	// A chat was added to a chat list
+	OnChatAddedToList func(update *UpdateChatAddedToList)
+	// A chat was removed from a chat list
+	OnChatRemovedFromList func(update *UpdateChatRemovedFromList)
+	// Incoming messages were read or the number of unread messages has been changed
+	OnChatReadInbox func(update *UpdateChatReadInbox)
+	// Outgoing messages were read
+	OnChatReadOutbox func(update *UpdateChatReadOutbox)
+	// The chat action bar was changed
+	OnChatActionBar func(update *UpdateChatActionBar)
+	// The bar for managing business bot was changed in a chat
+	OnChatBusinessBotManageBar func(update *UpdateChatBusinessBotManageBar)
+	// The chat available reactions were changed
+	OnChatAvailableReactions func(update *UpdateChatAvailableReactions)
+	// A chat draft has changed. Be aware that the update may come in the currently opened chat but with old content of the draft. If the user has changed the content of the draft, this update mustn't be applied
+	OnChatDraftMessage func(update *UpdateChatDraftMessage)
+	// Chat emoji status has changed
+	OnChatEmojiStatus func(update *UpdateChatEmojiStatus)
+	// The message sender that is selected to send messages in a chat has changed
+	OnChatMessageSender func(update *UpdateChatMessageSender)
+	// The message auto-delete or self-destruct timer setting for a chat was changed
+	OnChatMessageAutoDeleteTime func(update *UpdateChatMessageAutoDeleteTime)
+	// Notification settings for a chat were changed
+	OnChatNotificationSettings func(update *UpdateChatNotificationSettings)
+	// The chat pending join requests were changed
+	OnChatPendingJoinRequests func(update *UpdateChatPendingJoinRequests)
+	// The default chat reply markup was changed. Can occur because new messages with reply markup were received or because an old reply markup was hidden by the user
+	OnChatReplyMarkup func(update *UpdateChatReplyMarkup)
+	// The chat background was changed
+	OnChatBackground func(update *UpdateChatBackground)
+	// The chat theme was changed
+	OnChatTheme func(update *UpdateChatTheme)
+	// The chat unread_mention_count has changed
+	OnChatUnreadMentionCount func(update *UpdateChatUnreadMentionCount)
+	// The chat unread_reaction_count has changed
+	OnChatUnreadReactionCount func(update *UpdateChatUnreadReactionCount)
+	// A chat video chat state has changed
+	OnChatVideoChat func(update *UpdateChatVideoChat)
+	// The value of the default disable_notification parameter, used when a message is sent to the chat, was changed
+	OnChatDefaultDisableNotification func(update *UpdateChatDefaultDisableNotification)
+	// A chat content was allowed or restricted for saving
+	OnChatHasProtectedContent func(update *UpdateChatHasProtectedContent)
+	// Translation of chat messages was enabled or disabled
+	OnChatIsTranslatable func(update *UpdateChatIsTranslatable)
+	// A chat was marked as unread or was read
+	OnChatIsMarkedAsUnread func(update *UpdateChatIsMarkedAsUnread)
+	// A chat default appearance has changed
+	OnChatViewAsTopics func(update *UpdateChatViewAsTopics)
+	// A chat was blocked or unblocked
+	OnChatBlockList func(update *UpdateChatBlockList)
+	// A chat's has_scheduled_messages field has changed
+	OnChatHasScheduledMessages func(update *UpdateChatHasScheduledMessages)
+	// The list of chat folders or a chat folder has changed
+	OnChatFolders func(update *UpdateChatFolders)
+	// The number of online group members has changed. This update with non-zero number of online group members is sent only for currently opened chats. There is no guarantee that it is sent just after the number of online users has changed
+	OnChatOnlineMemberCount func(update *UpdateChatOnlineMemberCount)
+	// Basic information about a Saved Messages topic has changed. This update is guaranteed to come before the topic identifier is returned to the application
+	OnSavedMessagesTopic func(update *UpdateSavedMessagesTopic)
+	// Number of Saved Messages topics has changed
+	OnSavedMessagesTopicCount func(update *UpdateSavedMessagesTopicCount)
+	// Basic information about a quick reply shortcut has changed. This update is guaranteed to come before the quick shortcut name is returned to the application
+	OnQuickReplyShortcut func(update *UpdateQuickReplyShortcut)
+	// A quick reply shortcut and all its messages were deleted
+	OnQuickReplyShortcutDeleted func(update *UpdateQuickReplyShortcutDeleted)
+	// The list of quick reply shortcuts has changed
+	OnQuickReplyShortcuts func(update *UpdateQuickReplyShortcuts)
+	// The list of quick reply shortcut messages has changed
+	OnQuickReplyShortcutMessages func(update *UpdateQuickReplyShortcutMessages)
+	// Basic information about a topic in a forum chat was changed
+	OnForumTopicInfo func(update *UpdateForumTopicInfo)
+	// Information about a topic in a forum chat was changed
+	OnForumTopic func(update *UpdateForumTopic)
+	// Notification settings for some type of chats were updated
+	OnScopeNotificationSettings func(update *UpdateScopeNotificationSettings)
+	// Notification settings for reactions were updated
+	OnReactionNotificationSettings func(update *UpdateReactionNotificationSettings)
+	// A notification was changed
+	OnNotification func(update *UpdateNotification)
+	// A list of active notifications in a notification group has changed
+	OnNotificationGroup func(update *UpdateNotificationGroup)
+	// Contains active notifications that were shown on previous application launches. This update is sent only if the message database is used. In that case it comes once before any updateNotification and updateNotificationGroup update
+	OnActiveNotifications func(update *UpdateActiveNotifications)
+	// Describes whether there are some pending notification updates. Can be used to prevent application from killing, while there are some pending notifications
+	OnHavePendingNotifications func(update *UpdateHavePendingNotifications)
+	// Some messages were deleted
+	OnDeleteMessages func(update *UpdateDeleteMessages)
+	// A message sender activity in the chat has changed
+	OnChatAction func(update *UpdateChatAction)
+	// The user went online or offline
+	OnUserStatus func(update *UpdateUserStatus)
+	// Some data of a user has changed. This update is guaranteed to come before the user identifier is returned to the application
+	OnUser func(update *UpdateUser)
+	// Some data of a basic group has changed. This update is guaranteed to come before the basic group identifier is returned to the application
+	OnBasicGroup func(update *UpdateBasicGroup)
+	// Some data of a supergroup or a channel has changed. This update is guaranteed to come before the supergroup identifier is returned to the application
+	OnSupergroup func(update *UpdateSupergroup)
+	// Some data of a secret chat has changed. This update is guaranteed to come before the secret chat identifier is returned to the application
+	OnSecretChat func(update *UpdateSecretChat)
+	// Some data in userFullInfo has been changed
+	OnUserFullInfo func(update *UpdateUserFullInfo)
+	// Some data in basicGroupFullInfo has been changed
+	OnBasicGroupFullInfo func(update *UpdateBasicGroupFullInfo)
+	// Some data in supergroupFullInfo has been changed
+	OnSupergroupFullInfo func(update *UpdateSupergroupFullInfo)
+	// A service notification from the server was received. Upon receiving this the application must show a popup with the content of the notification
+	OnServiceNotification func(update *UpdateServiceNotification)
+	// Information about a file was updated
+	OnFile func(update *UpdateFile)
+	// The file generation process needs to be started by the application. Use setFileGenerationProgress and finishFileGeneration to generate the file
+	OnFileGenerationStart func(update *UpdateFileGenerationStart)
+	// File generation is no longer needed
+	OnFileGenerationStop func(update *UpdateFileGenerationStop)
+	// The state of the file download list has changed
+	OnFileDownloads func(update *UpdateFileDownloads)
+	// A file was added to the file download list. This update is sent only after file download list is loaded for the first time
+	OnFileAddedToDownloads func(update *UpdateFileAddedToDownloads)
+	// A file download was changed. This update is sent only after file download list is loaded for the first time
+	OnFileDownload func(update *UpdateFileDownload)
+	// A file was removed from the file download list. This update is sent only after file download list is loaded for the first time
+	OnFileRemovedFromDownloads func(update *UpdateFileRemovedFromDownloads)
+	// A request can't be completed unless application verification is performed; for official mobile applications only. The method setApplicationVerificationToken must be called once the verification is completed or failed
+	OnApplicationVerificationRequired func(update *UpdateApplicationVerificationRequired)
+	// A request can't be completed unless reCAPTCHA verification is performed; for official mobile applications only. The method setApplicationVerificationToken must be called once the verification is completed or failed
+	OnApplicationRecaptchaVerificationRequired func(update *UpdateApplicationRecaptchaVerificationRequired)
+	// New call was created or information about a call was updated
+	OnCall func(update *UpdateCall)
+	// Information about a group call was updated
+	OnGroupCall func(update *UpdateGroupCall)
+	// Information about a group call participant was changed. The updates are sent only after the group call is received through getGroupCall and only if the call is joined or being joined
+	OnGroupCallParticipant func(update *UpdateGroupCallParticipant)
+	// New call signaling data arrived
+	OnNewCallSignalingData func(update *UpdateNewCallSignalingData)
+	// Some privacy setting rules have been changed
+	OnUserPrivacySettingRules func(update *UpdateUserPrivacySettingRules)
+	// Number of unread messages in a chat list has changed. This update is sent only if the message database is used
+	OnUnreadMessageCount func(update *UpdateUnreadMessageCount)
+	// Number of unread chats, i.e. with unread messages or marked as unread, has changed. This update is sent only if the message database is used
+	OnUnreadChatCount func(update *UpdateUnreadChatCount)
+	// A story was changed
+	OnStory func(update *UpdateStory)
+	// A story became inaccessible
+	OnStoryDeleted func(update *UpdateStoryDeleted)
+	// A story has been successfully sent
+	OnStorySendSucceeded func(update *UpdateStorySendSucceeded)
+	// A story failed to send. If the story sending is canceled, then updateStoryDeleted will be received instead of this update
+	OnStorySendFailed func(update *UpdateStorySendFailed)
+	// The list of active stories posted by a specific chat has changed
+	OnChatActiveStories func(update *UpdateChatActiveStories)
+	// Number of chats in a story list has changed
+	OnStoryListChatCount func(update *UpdateStoryListChatCount)
+	// Story stealth mode settings have changed
+	OnStoryStealthMode func(update *UpdateStoryStealthMode)
+	// An option changed its value
+	OnOption func(update *UpdateOption)
+	// A sticker set has changed
+	OnStickerSet func(update *UpdateStickerSet)
+	// The list of installed sticker sets was updated
+	OnInstalledStickerSets func(update *UpdateInstalledStickerSets)
+	// The list of trending sticker sets was updated or some of them were viewed
+	OnTrendingStickerSets func(update *UpdateTrendingStickerSets)
+	// The list of recently used stickers was updated
+	OnRecentStickers func(update *UpdateRecentStickers)
+	// The list of favorite stickers was updated
+	OnFavoriteStickers func(update *UpdateFavoriteStickers)
+	// The list of saved animations was updated
+	OnSavedAnimations func(update *UpdateSavedAnimations)
+	// The list of saved notification sounds was updated. This update may not be sent until information about a notification sound was requested for the first time
+	OnSavedNotificationSounds func(update *UpdateSavedNotificationSounds)
+	// The default background has changed
+	OnDefaultBackground func(update *UpdateDefaultBackground)
+	// The list of available chat themes has changed
+	OnChatThemes func(update *UpdateChatThemes)
+	// The list of supported accent colors has changed
+	OnAccentColors func(update *UpdateAccentColors)
+	// The list of supported accent colors for user profiles has changed
+	OnProfileAccentColors func(update *UpdateProfileAccentColors)
+	// Some language pack strings have been updated
+	OnLanguagePackStrings func(update *UpdateLanguagePackStrings)
+	// The connection state has changed. This update must be used only to show a human-readable description of the connection state
+	OnConnectionState func(update *UpdateConnectionState)
+	// The freeze state of the current user's account has changed
+	OnFreezeState func(update *UpdateFreezeState)
+	// New terms of service must be accepted by the user. If the terms of service are declined, then the deleteAccount method must be called with the reason "Decline ToS update"
+	OnTermsOfService func(update *UpdateTermsOfService)
+	// The first unconfirmed session has changed
+	OnUnconfirmedSession func(update *UpdateUnconfirmedSession)
+	// The list of bots added to attachment or side menu has changed
+	OnAttachmentMenuBots func(update *UpdateAttachmentMenuBots)
+	// A message was sent by an opened Web App, so the Web App needs to be closed
+	OnWebAppMessageSent func(update *UpdateWebAppMessageSent)
+	// The list of active emoji reactions has changed
+	OnActiveEmojiReactions func(update *UpdateActiveEmojiReactions)
+	// The list of available message effects has changed
+	OnAvailableMessageEffects func(update *UpdateAvailableMessageEffects)
+	// The type of default reaction has changed
+	OnDefaultReactionType func(update *UpdateDefaultReactionType)
+	// The type of default paid reaction has changed
+	OnDefaultPaidReactionType func(update *UpdateDefaultPaidReactionType)
+	// Tags used in Saved Messages or a Saved Messages topic have changed
+	OnSavedMessagesTags func(update *UpdateSavedMessagesTags)
+	// The list of messages with active live location that need to be updated by the application has changed. The list is persistent across application restarts only if the message database is used
+	OnActiveLiveLocationMessages func(update *UpdateActiveLiveLocationMessages)
+	// The number of Telegram Stars owned by the current user has changed
+	OnOwnedStarCount func(update *UpdateOwnedStarCount)
+	// The revenue earned from sponsored messages in a chat has changed. If chat revenue screen is opened, then getChatRevenueTransactions may be called to fetch new transactions
+	OnChatRevenueAmount func(update *UpdateChatRevenueAmount)
+	// The Telegram Star revenue earned by a bot or a chat has changed. If Telegram Star transaction screen of the chat is opened, then getStarTransactions may be called to fetch new transactions
+	OnStarRevenueStatus func(update *UpdateStarRevenueStatus)
+	// The parameters of speech recognition without Telegram Premium subscription has changed
+	OnSpeechRecognitionTrial func(update *UpdateSpeechRecognitionTrial)
+	// The list of supported dice emojis has changed
+	OnDiceEmojis func(update *UpdateDiceEmojis)
+	// Some animated emoji message was clicked and a big animated sticker must be played if the message is visible on the screen. chatActionWatchingAnimations with the text of the message needs to be sent if the sticker is played
+	OnAnimatedEmojiMessageClicked func(update *UpdateAnimatedEmojiMessageClicked)
+	// The parameters of animation search through getOption("animation_search_bot_username") bot has changed
+	OnAnimationSearchParameters func(update *UpdateAnimationSearchParameters)
+	// The list of suggested to the user actions has changed
+	OnSuggestedActions func(update *UpdateSuggestedActions)
+	// Download or upload file speed for the user was limited, but it can be restored by subscription to Telegram Premium. The notification can be postponed until a being downloaded or uploaded file is visible to the user. Use getOption("premium_download_speedup") or getOption("premium_upload_speedup") to get expected speedup after subscription to Telegram Premium
+	OnSpeedLimitNotification func(update *UpdateSpeedLimitNotification)
+	// The list of contacts that had birthdays recently or will have birthday soon has changed
+	OnContactCloseBirthdays func(update *UpdateContactCloseBirthdays)
+	// Autosave settings for some type of chats were updated
+	OnAutosaveSettings func(update *UpdateAutosaveSettings)
+	// A business connection has changed; for bots only
+	OnBusinessConnection func(update *UpdateBusinessConnection)
+	// A new message was added to a business account; for bots only
+	OnNewBusinessMessage func(update *UpdateNewBusinessMessage)
+	// A message in a business account was edited; for bots only
+	OnBusinessMessageEdited func(update *UpdateBusinessMessageEdited)
+	// Messages in a business account were deleted; for bots only
+	OnBusinessMessagesDeleted func(update *UpdateBusinessMessagesDeleted)
+	// A new incoming inline query; for bots only
+	OnNewInlineQuery func(update *UpdateNewInlineQuery)
+	// The user has chosen a result of an inline query; for bots only
+	OnNewChosenInlineResult func(update *UpdateNewChosenInlineResult)
+	// A new incoming callback query; for bots only
+	OnNewCallbackQuery func(update *UpdateNewCallbackQuery)
+	// A new incoming callback query from a message sent via a bot; for bots only
+	OnNewInlineCallbackQuery func(update *UpdateNewInlineCallbackQuery)
+	// A new incoming callback query from a business message; for bots only
+	OnNewBusinessCallbackQuery func(update *UpdateNewBusinessCallbackQuery)
+	// A new incoming shipping query; for bots only. Only for invoices with flexible price
+	OnNewShippingQuery func(update *UpdateNewShippingQuery)
+	// A new incoming pre-checkout query; for bots only. Contains full information about a checkout
+	OnNewPreCheckoutQuery func(update *UpdateNewPreCheckoutQuery)
+	// A new incoming event; for bots only
+	OnNewCustomEvent func(update *UpdateNewCustomEvent)
+	// A new incoming query; for bots only
+	OnNewCustomQuery func(update *UpdateNewCustomQuery)
+	// A poll was updated; for bots only
+	OnPoll func(update *UpdatePoll)
+	// A user changed the answer to a poll; for bots only
+	OnPollAnswer func(update *UpdatePollAnswer)
+	// User rights changed in a chat; for bots only
+	OnChatMember func(update *UpdateChatMember)
+	// A user sent a join request to a chat; for bots only
+	OnNewChatJoinRequest func(update *UpdateNewChatJoinRequest)
+	// A chat boost has changed; for bots only
+	OnChatBoost func(update *UpdateChatBoost)
+	// User changed its reactions on a message with public reactions; for bots only
+	OnMessageReaction func(update *UpdateMessageReaction)
+	// Reactions added to a message with anonymous reactions have changed; for bots only
+	OnMessageReactions func(update *UpdateMessageReactions)
+	// Paid media were purchased by a user; for bots only
+	OnPaidMediaPurchased func(update *UpdatePaidMediaPurchased)
+	// Called for results without a callback
+	OnOther func(result Type)
+}
+
+func (handler *UpdateHandler) OnResult(result Type) {
+	switch result.GetConstructor() {
+	case ConstructorUpdateAuthorizationState:
+		if handler.OnAuthorizationState != nil {
+			handler.OnAuthorizationState(result.(*UpdateAuthorizationState))
+			return
+		}
+
+	case ConstructorUpdateNewMessage:
+		if handler.OnNewMessage != nil {
+			handler.OnNewMessage(result.(*UpdateNewMessage))
+			return
+		}
+
+	case ConstructorUpdateMessageSendAcknowledged:
+		if handler.OnMessageSendAcknowledged != nil {
+			handler.OnMessageSendAcknowledged(result.(*UpdateMessageSendAcknowledged))
+			return
+		}
+
+	case ConstructorUpdateMessageSendSucceeded:
+		if handler.OnMessageSendSucceeded != nil {
+			handler.OnMessageSendSucceeded(result.(*UpdateMessageSendSucceeded))
+			return
+		}
+
+	case ConstructorUpdateMessageSendFailed:
+		if handler.OnMessageSendFailed != nil {
+			handler.OnMessageSendFailed(result.(*UpdateMessageSendFailed))
+			return
+		}
+
+	case ConstructorUpdateMessageContent:
+		if handler.OnMessageContent != nil {
+			handler.OnMessageContent(result.(*UpdateMessageContent))
+			return
+		}
+
+	case ConstructorUpdateMessageEdited:
+		if handler.OnMessageEdited != nil {
+			handler.OnMessageEdited(result.(*UpdateMessageEdited))
+			return
+		}
+
+	case ConstructorUpdateMessageIsPinned:
+		if handler.OnMessageIsPinned != nil {
+			handler.OnMessageIsPinned(result.(*UpdateMessageIsPinned))
+			return
+		}
+
+	case ConstructorUpdateMessageInteractionInfo:
+		if handler.OnMessageInteractionInfo != nil {
+			handler.OnMessageInteractionInfo(result.(*UpdateMessageInteractionInfo))
+			return
+		}
+
+	case ConstructorUpdateMessageContentOpened:
+		if handler.OnMessageContentOpened != nil {
+			handler.OnMessageContentOpened(result.(*UpdateMessageContentOpened))
+			return
+		}
+
+	case ConstructorUpdateMessageMentionRead:
+		if handler.OnMessageMentionRead != nil {
+			handler.OnMessageMentionRead(result.(*UpdateMessageMentionRead))
+			return
+		}
+
+	case ConstructorUpdateMessageUnreadReactions:
+		if handler.OnMessageUnreadReactions != nil {
+			handler.OnMessageUnreadReactions(result.(*UpdateMessageUnreadReactions))
+			return
+		}
+
+	case ConstructorUpdateMessageFactCheck:
+		if handler.OnMessageFactCheck != nil {
+			handler.OnMessageFactCheck(result.(*UpdateMessageFactCheck))
+			return
+		}
+
+	case ConstructorUpdateMessageLiveLocationViewed:
+		if handler.OnMessageLiveLocationViewed != nil {
+			handler.OnMessageLiveLocationViewed(result.(*UpdateMessageLiveLocationViewed))
+			return
+		}
+
+	case ConstructorUpdateVideoPublished:
+		if handler.OnVideoPublished != nil {
+			handler.OnVideoPublished(result.(*UpdateVideoPublished))
+			return
+		}
+
+	case ConstructorUpdateNewChat:
+		if handler.OnNewChat != nil {
+			handler.OnNewChat(result.(*UpdateNewChat))
+			return
+		}
+
+	case ConstructorUpdateChatTitle:
+		if handler.OnChatTitle != nil {
+			handler.OnChatTitle(result.(*UpdateChatTitle))
+			return
+		}
+
+	case ConstructorUpdateChatPhoto:
+		if handler.OnChatPhoto != nil {
+			handler.OnChatPhoto(result.(*UpdateChatPhoto))
+			return
+		}
+
+	case ConstructorUpdateChatAccentColors:
+		if handler.OnChatAccentColors != nil {
+			handler.OnChatAccentColors(result.(*UpdateChatAccentColors))
+			return
+		}
+
+	case ConstructorUpdateChatPermissions:
+		if handler.OnChatPermissions != nil {
+			handler.OnChatPermissions(result.(*UpdateChatPermissions))
+			return
+		}
+
+	case ConstructorUpdateChatLastMessage:
+		if handler.OnChatLastMessage != nil {
+			handler.OnChatLastMessage(result.(*UpdateChatLastMessage))
+			return
+		}
+
+	case ConstructorUpdateChatPosition:
+		if handler.OnChatPosition != nil {
+			handler.OnChatPosition(result.(*UpdateChatPosition))
+			return
+		}
+
+	case ConstructorUpdateChatAddedToList:
+		if handler.OnChatAddedToList != nil {
+			handler.OnChatAddedToList(result.(*UpdateChatAddedToList))
+			return
+		}
+
+	case ConstructorUpdateChatRemovedFromList:
+		if handler.OnChatRemovedFromList != nil {
+			handler.OnChatRemovedFromList(result.(*UpdateChatRemovedFromList))
+			return
+		}
+
+	case ConstructorUpdateChatReadInbox:
+		if handler.OnChatReadInbox != nil {
+			handler.OnChatReadInbox(result.(*UpdateChatReadInbox))
+			return
+		}
+
+	case ConstructorUpdateChatReadOutbox:
+		if handler.OnChatReadOutbox != nil {
+			handler.OnChatReadOutbox(result.(*UpdateChatReadOutbox))
+			return
+		}
+
+	case ConstructorUpdateChatActionBar:
+		if handler.OnChatActionBar != nil {
+			handler.OnChatActionBar(result.(*UpdateChatActionBar))
+			return
+		}
+
+	case ConstructorUpdateChatBusinessBotManageBar:
+		if handler.OnChatBusinessBotManageBar != nil {
+			handler.OnChatBusinessBotManageBar(result.(*UpdateChatBusinessBotManageBar))
+			return
+		}
+
+	case ConstructorUpdateChatAvailableReactions:
+		if handler.OnChatAvailableReactions != nil {
+			handler.OnChatAvailableReactions(result.(*UpdateChatAvailableReactions))
+			return
+		}
+
+	case ConstructorUpdateChatDraftMessage:
+		if handler.OnChatDraftMessage != nil {
+			handler.OnChatDraftMessage(result.(*UpdateChatDraftMessage))
+			return
+		}
+
+	case ConstructorUpdateChatEmojiStatus:
+		if handler.OnChatEmojiStatus != nil {
+			handler.OnChatEmojiStatus(result.(*UpdateChatEmojiStatus))
+			return
+		}
+
+	case ConstructorUpdateChatMessageSender:
+		if handler.OnChatMessageSender != nil {
+			handler.OnChatMessageSender(result.(*UpdateChatMessageSender))
+			return
+		}
+
+	case ConstructorUpdateChatMessageAutoDeleteTime:
+		if handler.OnChatMessageAutoDeleteTime != nil {
+			handler.OnChatMessageAutoDeleteTime(result.(*UpdateChatMessageAutoDeleteTime))
+			return
+		}
+
+	case ConstructorUpdateChatNotificationSettings:
+		if handler.OnChatNotificationSettings != nil {
+			handler.OnChatNotificationSettings(result.(*UpdateChatNotificationSettings))
+			return
+		}
+
+	case ConstructorUpdateChatPendingJoinRequests:
+		if handler.OnChatPendingJoinRequests != nil {
+			handler.OnChatPendingJoinRequests(result.(*UpdateChatPendingJoinRequests))
+			return
+		}
+
+	case ConstructorUpdateChatReplyMarkup:
+		if handler.OnChatReplyMarkup != nil {
+			handler.OnChatReplyMarkup(result.(*UpdateChatReplyMarkup))
+			return
+		}
+
+	case ConstructorUpdateChatBackground:
+		if handler.OnChatBackground != nil {
+			handler.OnChatBackground(result.(*UpdateChatBackground))
+			return
+		}
+
+	case ConstructorUpdateChatTheme:
+		if handler.OnChatTheme != nil {
+			handler.OnChatTheme(result.(*UpdateChatTheme))
+			return
+		}
+
+	case ConstructorUpdateChatUnreadMentionCount:
+		if handler.OnChatUnreadMentionCount != nil {
+			handler.OnChatUnreadMentionCount(result.(*UpdateChatUnreadMentionCount))
+			return
+		}
+
+	case ConstructorUpdateChatUnreadReactionCount:
+		if handler.OnChatUnreadReactionCount != nil {
+			handler.OnChatUnreadReactionCount(result.(*UpdateChatUnreadReactionCount))
+			return
+		}
+
+	case ConstructorUpdateChatVideoChat:
+		if handler.OnChatVideoChat != nil {
+			handler.OnChatVideoChat(result.(*UpdateChatVideoChat))
+			return
+		}
+
+	case ConstructorUpdateChatDefaultDisableNotification:
+		if handler.OnChatDefaultDisableNotification != nil {
+			handler.OnChatDefaultDisableNotification(result.(*UpdateChatDefaultDisableNotification))
+			return
+		}
+
+	case ConstructorUpdateChatHasProtectedContent:
+		if handler.OnChatHasProtectedContent != nil {
+			handler.OnChatHasProtectedContent(result.(*UpdateChatHasProtectedContent))
+			return
+		}
+
+	case ConstructorUpdateChatIsTranslatable:
+		if handler.OnChatIsTranslatable != nil {
+			handler.OnChatIsTranslatable(result.(*UpdateChatIsTranslatable))
+			return
+		}
+
+	case ConstructorUpdateChatIsMarkedAsUnread:
+		if handler.OnChatIsMarkedAsUnread != nil {
+			handler.OnChatIsMarkedAsUnread(result.(*UpdateChatIsMarkedAsUnread))
+			return
+		}
+
+	case ConstructorUpdateChatViewAsTopics:
+		if handler.OnChatViewAsTopics != nil {
+			handler.OnChatViewAsTopics(result.(*UpdateChatViewAsTopics))
+			return
+		}
+
+	case ConstructorUpdateChatBlockList:
+		if handler.OnChatBlockList != nil {
+			handler.OnChatBlockList(result.(*UpdateChatBlockList))
+			return
+		}
+
+	case ConstructorUpdateChatHasScheduledMessages:
+		if handler.OnChatHasScheduledMessages != nil {
+			handler.OnChatHasScheduledMessages(result.(*UpdateChatHasScheduledMessages))
+			return
+		}
+
+	case ConstructorUpdateChatFolders:
+		if handler.OnChatFolders != nil {
+			handler.OnChatFolders(result.(*UpdateChatFolders))
+			return
+		}
+
+	case ConstructorUpdateChatOnlineMemberCount:
+		if handler.OnChatOnlineMemberCount != nil {
+			handler.OnChatOnlineMemberCount(result.(*UpdateChatOnlineMemberCount))
+			return
+		}
+
+	case ConstructorUpdateSavedMessagesTopic:
+		if handler.OnSavedMessagesTopic != nil {
+			handler.OnSavedMessagesTopic(result.(*UpdateSavedMessagesTopic))
+			return
+		}
+
+	case ConstructorUpdateSavedMessagesTopicCount:
+		if handler.OnSavedMessagesTopicCount != nil {
+			handler.OnSavedMessagesTopicCount(result.(*UpdateSavedMessagesTopicCount))
+			return
+		}
+
+	case ConstructorUpdateQuickReplyShortcut:
+		if handler.OnQuickReplyShortcut != nil {
+			handler.OnQuickReplyShortcut(result.(*UpdateQuickReplyShortcut))
+			return
+		}
+
+	case ConstructorUpdateQuickReplyShortcutDeleted:
+		if handler.OnQuickReplyShortcutDeleted != nil {
+			handler.OnQuickReplyShortcutDeleted(result.(*UpdateQuickReplyShortcutDeleted))
+			return
+		}
+
+	case ConstructorUpdateQuickReplyShortcuts:
+		if handler.OnQuickReplyShortcuts != nil {
+			handler.OnQuickReplyShortcuts(result.(*UpdateQuickReplyShortcuts))
+			return
+		}
+
+	case ConstructorUpdateQuickReplyShortcutMessages:
+		if handler.OnQuickReplyShortcutMessages != nil {
+			handler.OnQuickReplyShortcutMessages(result.(*UpdateQuickReplyShortcutMessages))
+			return
+		}
+
+	case ConstructorUpdateForumTopicInfo:
+		if handler.OnForumTopicInfo != nil {
+			handler.OnForumTopicInfo(result.(*UpdateForumTopicInfo))
+			return
+		}
+
+	case ConstructorUpdateForumTopic:
+		if handler.OnForumTopic != nil {
+			handler.OnForumTopic(result.(*UpdateForumTopic))
+			return
+		}
+
+	case ConstructorUpdateScopeNotificationSettings:
+		if handler.OnScopeNotificationSettings != nil {
+			handler.OnScopeNotificationSettings(result.(*UpdateScopeNotificationSettings))
+			return
+		}
+
+	case ConstructorUpdateReactionNotificationSettings:
+		if handler.OnReactionNotificationSettings != nil {
+			handler.OnReactionNotificationSettings(result.(*UpdateReactionNotificationSettings))
+			return
+		}
+
+	case ConstructorUpdateNotification:
+		if handler.OnNotification != nil {
+			handler.OnNotification(result.(*UpdateNotification))
+			return
+		}
+
+	case ConstructorUpdateNotificationGroup:
+		if handler.OnNotificationGroup != nil {
+			handler.OnNotificationGroup(result.(*UpdateNotificationGroup))
+			return
+		}
+
+	case ConstructorUpdateActiveNotifications:
+		if handler.OnActiveNotifications != nil {
+			handler.OnActiveNotifications(result.(*UpdateActiveNotifications))
+			return
+		}
+
+	case ConstructorUpdateHavePendingNotifications:
+		if handler.OnHavePendingNotifications != nil {
+			handler.OnHavePendingNotifications(result.(*UpdateHavePendingNotifications))
+			return
+		}
+
+	case ConstructorUpdateDeleteMessages:
+		if handler.OnDeleteMessages != nil {
+			handler.OnDeleteMessages(result.(*UpdateDeleteMessages))
+			return
+		}
+
+	case ConstructorUpdateChatAction:
+		if handler.OnChatAction != nil {
+			handler.OnChatAction(result.(*UpdateChatAction))
+			return
+		}
+
+	case ConstructorUpdateUserStatus:
+		if handler.OnUserStatus != nil {
+			handler.OnUserStatus(result.(*UpdateUserStatus))
+			return
+		}
+
+	case ConstructorUpdateUser:
+		if handler.OnUser != nil {
+			handler.OnUser(result.(*UpdateUser))
+			return
+		}
+
+	case ConstructorUpdateBasicGroup:
+		if handler.OnBasicGroup != nil {
+			handler.OnBasicGroup(result.(*UpdateBasicGroup))
+			return
+		}
+
+	case ConstructorUpdateSupergroup:
+		if handler.OnSupergroup != nil {
+			handler.OnSupergroup(result.(*UpdateSupergroup))
+			return
+		}
+
+	case ConstructorUpdateSecretChat:
+		if handler.OnSecretChat != nil {
+			handler.OnSecretChat(result.(*UpdateSecretChat))
+			return
+		}
+
+	case ConstructorUpdateUserFullInfo:
+		if handler.OnUserFullInfo != nil {
+			handler.OnUserFullInfo(result.(*UpdateUserFullInfo))
+			return
+		}
+
+	case ConstructorUpdateBasicGroupFullInfo:
+		if handler.OnBasicGroupFullInfo != nil {
+			handler.OnBasicGroupFullInfo(result.(*UpdateBasicGroupFullInfo))
+			return
+		}
+
+	case ConstructorUpdateSupergroupFullInfo:
+		if handler.OnSupergroupFullInfo != nil {
+			handler.OnSupergroupFullInfo(result.(*UpdateSupergroupFullInfo))
+			return
+		}
+
+	case ConstructorUpdateServiceNotification:
+		if handler.OnServiceNotification != nil {
+			handler.OnServiceNotification(result.(*UpdateServiceNotification))
+			return
+		}
+
+	case ConstructorUpdateFile:
+		if handler.OnFile != nil {
+			handler.OnFile(result.(*UpdateFile))
+			return
+		}
+
+	case ConstructorUpdateFileGenerationStart:
+		if handler.OnFileGenerationStart != nil {
+			handler.OnFileGenerationStart(result.(*UpdateFileGenerationStart))
+			return
+		}
+
+	case ConstructorUpdateFileGenerationStop:
+		if handler.OnFileGenerationStop != nil {
+			handler.OnFileGenerationStop(result.(*UpdateFileGenerationStop))
+			return
+		}
+
+	case ConstructorUpdateFileDownloads:
+		if handler.OnFileDownloads != nil {
+			handler.OnFileDownloads(result.(*UpdateFileDownloads))
+			return
+		}
+
+	case ConstructorUpdateFileAddedToDownloads:
+		if handler.OnFileAddedToDownloads != nil {
+			handler.OnFileAddedToDownloads(result.(*UpdateFileAddedToDownloads))
+			return
+		}
+
+	case ConstructorUpdateFileDownload:
+		if handler.OnFileDownload != nil {
+			handler.OnFileDownload(result.(*UpdateFileDownload))
+			return
+		}
+
+	case ConstructorUpdateFileRemovedFromDownloads:
+		if handler.OnFileRemovedFromDownloads != nil {
+			handler.OnFileRemovedFromDownloads(result.(*UpdateFileRemovedFromDownloads))
+			return
+		}
+
+	case ConstructorUpdateApplicationVerificationRequired:
+		if handler.OnApplicationVerificationRequired != nil {
+			handler.OnApplicationVerificationRequired(result.(*UpdateApplicationVerificationRequired))
+			return
+		}
+
+	case ConstructorUpdateApplicationRecaptchaVerificationRequired:
+		if handler.OnApplicationRecaptchaVerificationRequired != nil {
+			handler.OnApplicationRecaptchaVerificationRequired(result.(*UpdateApplicationRecaptchaVerificationRequired))
+			return
+		}
+
+	case ConstructorUpdateCall:
+		if handler.OnCall != nil {
+			handler.OnCall(result.(*UpdateCall))
+			return
+		}
+
+	case ConstructorUpdateGroupCall:
+		if handler.OnGroupCall != nil {
+			handler.OnGroupCall(result.(*UpdateGroupCall))
+			return
+		}
+
+	case ConstructorUpdateGroupCallParticipant:
+		if handler.OnGroupCallParticipant != nil {
+			handler.OnGroupCallParticipant(result.(*UpdateGroupCallParticipant))
+			return
+		}
+
+	case ConstructorUpdateNewCallSignalingData:
+		if handler.OnNewCallSignalingData != nil {
+			handler.OnNewCallSignalingData(result.(*UpdateNewCallSignalingData))
+			return
+		}
+
+	case ConstructorUpdateUserPrivacySettingRules:
+		if handler.OnUserPrivacySettingRules != nil {
+			handler.OnUserPrivacySettingRules(result.(*UpdateUserPrivacySettingRules))
+			return
+		}
+
+	case ConstructorUpdateUnreadMessageCount:
+		if handler.OnUnreadMessageCount != nil {
+			handler.OnUnreadMessageCount(result.(*UpdateUnreadMessageCount))
+			return
+		}
+
+	case ConstructorUpdateUnreadChatCount:
+		if handler.OnUnreadChatCount != nil {
+			handler.OnUnreadChatCount(result.(*UpdateUnreadChatCount))
+			return
+		}
+
+	case ConstructorUpdateStory:
+		if handler.OnStory != nil {
+			handler.OnStory(result.(*UpdateStory))
+			return
+		}
+
+	case ConstructorUpdateStoryDeleted:
+		if handler.OnStoryDeleted != nil {
+			handler.OnStoryDeleted(result.(*UpdateStoryDeleted))
+			return
+		}
+
+	case ConstructorUpdateStorySendSucceeded:
+		if handler.OnStorySendSucceeded != nil {
+			handler.OnStorySendSucceeded(result.(*UpdateStorySendSucceeded))
+			return
+		}
+
+	case ConstructorUpdateStorySendFailed:
+		if handler.OnStorySendFailed != nil {
+			handler.OnStorySendFailed(result.(*UpdateStorySendFailed))
+			return
+		}
+
+	case ConstructorUpdateChatActiveStories:
+		if handler.OnChatActiveStories != nil {
+			handler.OnChatActiveStories(result.(*UpdateChatActiveStories))
+			return
+		}
+
+	case ConstructorUpdateStoryListChatCount:
+		if handler.OnStoryListChatCount != nil {
+			handler.OnStoryListChatCount(result.(*UpdateStoryListChatCount))
+			return
+		}
+
+	case ConstructorUpdateStoryStealthMode:
+		if handler.OnStoryStealthMode != nil {
+			handler.OnStoryStealthMode(result.(*UpdateStoryStealthMode))
+			return
+		}
+
+	case ConstructorUpdateOption:
+		if handler.OnOption != nil {
+			handler.OnOption(result.(*UpdateOption))
+			return
+		}
+
+	case ConstructorUpdateStickerSet:
+		if handler.OnStickerSet != nil {
+			handler.OnStickerSet(result.(*UpdateStickerSet))
+			return
+		}
+
+	case ConstructorUpdateInstalledStickerSets:
+		if handler.OnInstalledStickerSets != nil {
+			handler.OnInstalledStickerSets(result.(*UpdateInstalledStickerSets))
+			return
+		}
+
+	case ConstructorUpdateTrendingStickerSets:
+		if handler.OnTrendingStickerSets != nil {
+			handler.OnTrendingStickerSets(result.(*UpdateTrendingStickerSets))
+			return
+		}
+
+	case ConstructorUpdateRecentStickers:
+		if handler.OnRecentStickers != nil {
+			handler.OnRecentStickers(result.(*UpdateRecentStickers))
+			return
+		}
+
+	case ConstructorUpdateFavoriteStickers:
+		if handler.OnFavoriteStickers != nil {
+			handler.OnFavoriteStickers(result.(*UpdateFavoriteStickers))
+			return
+		}
+
+	case ConstructorUpdateSavedAnimations:
+		if handler.OnSavedAnimations != nil {
+			handler.OnSavedAnimations(result.(*UpdateSavedAnimations))
+			return
+		}
+
+	case ConstructorUpdateSavedNotificationSounds:
+		if handler.OnSavedNotificationSounds != nil {
+			handler.OnSavedNotificationSounds(result.(*UpdateSavedNotificationSounds))
+			return
+		}
+
+	case ConstructorUpdateDefaultBackground:
+		if handler.OnDefaultBackground != nil {
+			handler.OnDefaultBackground(result.(*UpdateDefaultBackground))
+			return
+		}
+
+	case ConstructorUpdateChatThemes:
+		if handler.OnChatThemes != nil {
+			handler.OnChatThemes(result.(*UpdateChatThemes))
+			return
+		}
+
+	case ConstructorUpdateAccentColors:
+		if handler.OnAccentColors != nil {
+			handler.OnAccentColors(result.(*UpdateAccentColors))
+			return
+		}
+
+	case ConstructorUpdateProfileAccentColors:
+		if handler.OnProfileAccentColors != nil {
+			handler.OnProfileAccentColors(result.(*UpdateProfileAccentColors))
+			return
+		}
+
+	case ConstructorUpdateLanguagePackStrings:
+		if handler.OnLanguagePackStrings != nil {
+			handler.OnLanguagePackStrings(result.(*UpdateLanguagePackStrings))
+			return
+		}
+
+	case ConstructorUpdateConnectionState:
+		if handler.OnConnectionState != nil {
+			handler.OnConnectionState(result.(*UpdateConnectionState))
+			return
+		}
+
+	case ConstructorUpdateFreezeState:
+		if handler.OnFreezeState != nil {
+			handler.OnFreezeState(result.(*UpdateFreezeState))
+			return
+		}
+
+	case ConstructorUpdateTermsOfService:
+		if handler.OnTermsOfService != nil {
+			handler.OnTermsOfService(result.(*UpdateTermsOfService))
+			return
+		}
+
+	case ConstructorUpdateUnconfirmedSession:
+		if handler.OnUnconfirmedSession != nil {
+			handler.OnUnconfirmedSession(result.(*UpdateUnconfirmedSession))
+			return
+		}
+
+	case ConstructorUpdateAttachmentMenuBots:
+		if handler.OnAttachmentMenuBots != nil {
+			handler.OnAttachmentMenuBots(result.(*UpdateAttachmentMenuBots))
+			return
+		}
+
+	case ConstructorUpdateWebAppMessageSent:
+		if handler.OnWebAppMessageSent != nil {
+			handler.OnWebAppMessageSent(result.(*UpdateWebAppMessageSent))
+			return
+		}
+
+	case ConstructorUpdateActiveEmojiReactions:
+		if handler.OnActiveEmojiReactions != nil {
+			handler.OnActiveEmojiReactions(result.(*UpdateActiveEmojiReactions))
+			return
+		}
+
+	case ConstructorUpdateAvailableMessageEffects:
+		if handler.OnAvailableMessageEffects != nil {
+			handler.OnAvailableMessageEffects(result.(*UpdateAvailableMessageEffects))
+			return
+		}
+
+	case ConstructorUpdateDefaultReactionType:
+		if handler.OnDefaultReactionType != nil {
+			handler.OnDefaultReactionType(result.(*UpdateDefaultReactionType))
+			return
+		}
+
+	case ConstructorUpdateDefaultPaidReactionType:
+		if handler.OnDefaultPaidReactionType != nil {
+			handler.OnDefaultPaidReactionType(result.(*UpdateDefaultPaidReactionType))
+			return
+		}
+
+	case ConstructorUpdateSavedMessagesTags:
+		if handler.OnSavedMessagesTags != nil {
+			handler.OnSavedMessagesTags(result.(*UpdateSavedMessagesTags))
+			return
+		}
+
+	case ConstructorUpdateActiveLiveLocationMessages:
+		if handler.OnActiveLiveLocationMessages != nil {
+			handler.OnActiveLiveLocationMessages(result.(*UpdateActiveLiveLocationMessages))
+			return
+		}
+
+	case ConstructorUpdateOwnedStarCount:
+		if handler.OnOwnedStarCount != nil {
+			handler.OnOwnedStarCount(result.(*UpdateOwnedStarCount))
+			return
+		}
+
+	case ConstructorUpdateChatRevenueAmount:
+		if handler.OnChatRevenueAmount != nil {
+			handler.OnChatRevenueAmount(result.(*UpdateChatRevenueAmount))
+			return
+		}
+
+	case ConstructorUpdateStarRevenueStatus:
+		if handler.OnStarRevenueStatus != nil {
+			handler.OnStarRevenueStatus(result.(*UpdateStarRevenueStatus))
+			return
+		}
+
+	case ConstructorUpdateSpeechRecognitionTrial:
+		if handler.OnSpeechRecognitionTrial != nil {
+			handler.OnSpeechRecognitionTrial(result.(*UpdateSpeechRecognitionTrial))
+			return
+		}
+
+	case ConstructorUpdateDiceEmojis:
+		if handler.OnDiceEmojis != nil {
+			handler.OnDiceEmojis(result.(*UpdateDiceEmojis))
+			return
+		}
+
+	case ConstructorUpdateAnimatedEmojiMessageClicked:
+		if handler.OnAnimatedEmojiMessageClicked != nil {
+			handler.OnAnimatedEmojiMessageClicked(result.(*UpdateAnimatedEmojiMessageClicked))
+			return
+		}
+
+	case ConstructorUpdateAnimationSearchParameters:
+		if handler.OnAnimationSearchParameters != nil {
+			handler.OnAnimationSearchParameters(result.(*UpdateAnimationSearchParameters))
+			return
+		}
+
+	case ConstructorUpdateSuggestedActions:
+		if handler.OnSuggestedActions != nil {
+			handler.OnSuggestedActions(result.(*UpdateSuggestedActions))
+			return
+		}
+
+	case ConstructorUpdateSpeedLimitNotification:
+		if handler.OnSpeedLimitNotification != nil {
+			handler.OnSpeedLimitNotification(result.(*UpdateSpeedLimitNotification))
+			return
+		}
+
+	case ConstructorUpdateContactCloseBirthdays:
+		if handler.OnContactCloseBirthdays != nil {
+			handler.OnContactCloseBirthdays(result.(*UpdateContactCloseBirthdays))
+			return
+		}
+
+	case ConstructorUpdateAutosaveSettings:
+		if handler.OnAutosaveSettings != nil {
+			handler.OnAutosaveSettings(result.(*UpdateAutosaveSettings))
+			return
+		}
+
+	case ConstructorUpdateBusinessConnection:
+		if handler.OnBusinessConnection != nil {
+			handler.OnBusinessConnection(result.(*UpdateBusinessConnection))
+			return
+		}
+
+	case ConstructorUpdateNewBusinessMessage:
+		if handler.OnNewBusinessMessage != nil {
+			handler.OnNewBusinessMessage(result.(*UpdateNewBusinessMessage))
+			return
+		}
+
+	case ConstructorUpdateBusinessMessageEdited:
+		if handler.OnBusinessMessageEdited != nil {
+			handler.OnBusinessMessageEdited(result.(*UpdateBusinessMessageEdited))
+			return
+		}
+
+	case ConstructorUpdateBusinessMessagesDeleted:
+		if handler.OnBusinessMessagesDeleted != nil {
+			handler.OnBusinessMessagesDeleted(result.(*UpdateBusinessMessagesDeleted))
+			return
+		}
+
+	case ConstructorUpdateNewInlineQuery:
+		if handler.OnNewInlineQuery != nil {
+			handler.OnNewInlineQuery(result.(*UpdateNewInlineQuery))
+			return
+		}
+
+	case ConstructorUpdateNewChosenInlineResult:
+		if handler.OnNewChosenInlineResult != nil {
+			handler.OnNewChosenInlineResult(result.(*UpdateNewChosenInlineResult))
+			return
+		}
+
+	case ConstructorUpdateNewCallbackQuery:
+		if handler.OnNewCallbackQuery != nil {
+			handler.OnNewCallbackQuery(result.(*UpdateNewCallbackQuery))
+			return
+		}
+
+	case ConstructorUpdateNewInlineCallbackQuery:
+		if handler.OnNewInlineCallbackQuery != nil {
+			handler.OnNewInlineCallbackQuery(result.(*UpdateNewInlineCallbackQuery))
+			return
+		}
+
+	case ConstructorUpdateNewBusinessCallbackQuery:
+		if handler.OnNewBusinessCallbackQuery != nil {
+			handler.OnNewBusinessCallbackQuery(result.(*UpdateNewBusinessCallbackQuery))
+			return
+		}
+
+	case ConstructorUpdateNewShippingQuery:
+		if handler.OnNewShippingQuery != nil {
+			handler.OnNewShippingQuery(result.(*UpdateNewShippingQuery))
+			return
+		}
+
+	case ConstructorUpdateNewPreCheckoutQuery:
+		if handler.OnNewPreCheckoutQuery != nil {
+			handler.OnNewPreCheckoutQuery(result.(*UpdateNewPreCheckoutQuery))
+			return
+		}
+
+	case ConstructorUpdateNewCustomEvent:
+		if handler.OnNewCustomEvent != nil {
+			handler.OnNewCustomEvent(result.(*UpdateNewCustomEvent))
+			return
+		}
+
+	case ConstructorUpdateNewCustomQuery:
+		if handler.OnNewCustomQuery != nil {
+			handler.OnNewCustomQuery(result.(*UpdateNewCustomQuery))
+			return
+		}
+
+	case ConstructorUpdatePoll:
+		if handler.OnPoll != nil {
+			handler.OnPoll(result.(*UpdatePoll))
+			return
+		}
+
+	case ConstructorUpdatePollAnswer:
+		if handler.OnPollAnswer != nil {
+			handler.OnPollAnswer(result.(*UpdatePollAnswer))
+			return
+		}
+
+	case ConstructorUpdateChatMember:
+		if handler.OnChatMember != nil {
+			handler.OnChatMember(result.(*UpdateChatMember))
+			return
+		}
+
+	case ConstructorUpdateNewChatJoinRequest:
+		if handler.OnNewChatJoinRequest != nil {
+			handler.OnNewChatJoinRequest(result.(*UpdateNewChatJoinRequest))
+			return
+		}
+
+	case ConstructorUpdateChatBoost:
+		if handler.OnChatBoost != nil {
+			handler.OnChatBoost(result.(*UpdateChatBoost))
+			return
+		}
+
+	case ConstructorUpdateMessageReaction:
+		if handler.OnMessageReaction != nil {
+			handler.OnMessageReaction(result.(*UpdateMessageReaction))
+			return
+		}
+
+	case ConstructorUpdateMessageReactions:
+		if handler.OnMessageReactions != nil {
+			handler.OnMessageReactions(result.(*UpdateMessageReactions))
+			return
+		}
+
+	case ConstructorUpdatePaidMediaPurchased:
+		if handler.OnPaidMediaPurchased != nil {
+			handler.OnPaidMediaPurchased(result.(*UpdatePaidMediaPurchased))
+			return
+		}
+
+	}
+
+	if handler.OnOther != nil {
+		handler.OnOther(result)
+	}
+}
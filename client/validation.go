@@ -0,0 +1,105 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ValidationError describes a request field which violates a constraint documented in the TDLib schema
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", err.Field, err.Message)
+}
+
+type validator interface {
+	Validate() error
+}
+
+// WithRequestValidation makes the client validate requests before sending them to TDLib
+func WithRequestValidation() Option {
+	return func(client *Client) {
+		client.validateRequests = true
+	}
+}
+
+func validateRequest(req Request) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+
+	err := v.Validate()
+	if err != nil {
+		return fmt.Errorf("%s: %w", req.GetFunctionName(), err)
+	}
+
+	return nil
+}
+
+func validateMin(field string, value int64, min int64) error {
+	if value < min {
+		return &ValidationError{Field: field, Message: fmt.Sprintf("must be greater than or equal to %d, got %d", min, value)}
+	}
+
+	return nil
+}
+
+func validateMax(field string, value int64, max int64) error {
+	if value > max {
+		return &ValidationError{Field: field, Message: fmt.Sprintf("must be less than or equal to %d, got %d", max, value)}
+	}
+
+	return nil
+}
+
+// validateLength checks the length of the string in characters. A negative max means no upper limit
+func validateLength(field string, value string, min int, max int) error {
+	length := utf8.RuneCountInString(value)
+	if length < min || (max >= 0 && length > max) {
+		if max < 0 {
+			return &ValidationError{Field: field, Message: fmt.Sprintf("must be at least %d characters long, got %d", min, length)}
+		}
+		return &ValidationError{Field: field, Message: fmt.Sprintf("must be %d-%d characters long, got %d", min, max, length)}
+	}
+
+	return nil
+}
+
+func validateFormattedTextLength(field string, value *FormattedText, min int, max int) error {
+	text := ""
+	if value != nil {
+		text = value.Text
+	}
+
+	return validateLength(field, text, min, max)
+}
+
+// validateNested validates an object field and prefixes the field path of its errors
+func validateNested(field string, value interface{}) error {
+	v, ok := value.(validator)
+	if !ok {
+		return nil
+	}
+
+	err := v.Validate()
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return &ValidationError{Field: field + "." + validationErr.Field, Message: validationErr.Message}
+	}
+
+	return err
+}
+
+func indexField(field string, i int) string {
+	return field + "[" + strconv.Itoa(i) + "]"
+}
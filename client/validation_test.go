@@ -0,0 +1,85 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   Request
+		field string
+	}{
+		{
+			name:  "valid",
+			req:   &GetChatHistoryRequest{Limit: 100},
+			field: "",
+		},
+		{
+			name:  "min",
+			req:   &GetChatHistoryRequest{Limit: 0},
+			field: "limit",
+		},
+		{
+			name:  "max",
+			req:   &GetChatHistoryRequest{Limit: 101},
+			field: "limit",
+		},
+		{
+			name:  "up to",
+			req:   &GetUserProfilePhotosRequest{Limit: 100},
+			field: "",
+		},
+		{
+			name:  "more than up to",
+			req:   &GetUserProfilePhotosRequest{Limit: 101},
+			field: "limit",
+		},
+		{
+			name:  "length in characters",
+			req:   &SetChatTitleRequest{Title: "заголовок"},
+			field: "",
+		},
+		{
+			name:  "empty string",
+			req:   &SetChatTitleRequest{Title: ""},
+			field: "title",
+		},
+		{
+			name: "nested list item",
+			req: &SendMessageRequest{
+				InputMessageContent: &InputMessagePoll{
+					Question: &FormattedText{Text: "?"},
+					Options:  []*FormattedText{{Text: "yes"}, {Text: ""}},
+				},
+			},
+			field: "input_message_content.options[1]",
+		},
+		{
+			name:  "nil nested object",
+			req:   &SendMessageRequest{InputMessageContent: (*InputMessagePoll)(nil)},
+			field: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRequest(tt.req)
+			if tt.field == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("error = %v, want *ValidationError", err)
+			}
+			if validationErr.Field != tt.field {
+				t.Errorf("field = %q, want %q", validationErr.Field, tt.field)
+			}
+		})
+	}
+}
@@ -1,50 +1,104 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
 	"flag"
 	"fmt"
 	"github.com/zelenin/go-tdlib/internal/codegen"
+	"github.com/zelenin/go-tdlib/internal/source"
 	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"go/format"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 )
 
 type config struct {
 	version             string
+	schemaPath          string
+	functionTypesPath   string
 	outputDirPath       string
 	packageName         string
 	functionFileName    string
 	typeFileName        string
 	unmarshalerFileName string
+	updateFileName      string
+	interfaceFileName   string
+	mockFileName        string
+	registryFileName    string
 	versionFileName     string
+	split               bool
+	include             string
+	exclude             string
+	runtimeDirPath      string
+	check               bool
+}
+
+type generatedFile struct {
+	name    string
+	content []byte
 }
 
 func main() {
 	var config config
 
 	flag.StringVar(&config.version, "version", "", "TDLib version")
+	flag.StringVar(&config.schemaPath, "schema", "", "td_api.tl file. If empty, the schema of the version is downloaded from GitHub")
+	flag.StringVar(&config.functionTypesPath, "functionTypes", "", "JSON schema made by generateJson with bot/user function types. If empty, all functions are common")
 	flag.StringVar(&config.outputDirPath, "outputDir", "./tdlib", "output directory")
 	flag.StringVar(&config.packageName, "package", "tdlib", "package name")
 	flag.StringVar(&config.functionFileName, "functionFile", "function.go", "functions filename")
 	flag.StringVar(&config.typeFileName, "typeFile", "type.go", "types filename")
 	flag.StringVar(&config.unmarshalerFileName, "unmarshalerFile", "unmarshaler.go", "unmarshalers filename")
+	flag.StringVar(&config.updateFileName, "updateFile", "update.go", "update handler filename")
+	flag.StringVar(&config.interfaceFileName, "interfaceFile", "interface.go", "TDLib interface filename")
+	flag.StringVar(&config.mockFileName, "mockFile", "mock.go", "mock filename")
+	flag.StringVar(&config.registryFileName, "registryFile", "registry.go", "schema registry filename")
 	flag.StringVar(&config.versionFileName, "versionFile", "version.go", "version filename")
+	flag.BoolVar(&config.split, "split", false, "split the functions, the types and the unmarshalers into files by schema area, e.g. function_messages.go")
+	flag.StringVar(&config.include, "include", "", "comma separated patterns of the functions and the constructors to generate, e.g. \"getMe,sendMessage,updateNewMessage\". The types they need are included automatically. If empty, everything is generated")
+	flag.StringVar(&config.exclude, "exclude", "", "comma separated patterns of the functions and the constructors not to generate, e.g. \"*Passport*\"")
+	flag.StringVar(&config.runtimeDirPath, "runtimeDir", "", "directory of the client package. If set, its non-generated files are copied to the output directory, so that the output is a complete package")
+	flag.BoolVar(&config.check, "check", false, "don't write files, fail if the files in the output directory differ from the generated code")
 
 	flag.Parse()
 
-	resp, err := http.Get("https://raw.githubusercontent.com/tdlib/td/" + config.version + "/td/generate/scheme/td_api.tl")
+	schema, err := loadSchema(config)
 	if err != nil {
-		log.Fatalf("http.Get error: %s", err)
-		return
+		log.Fatalf("schema load error: %s", err)
 	}
-	defer resp.Body.Close()
 
-	schema, err := tlparser.Parse(resp.Body)
+	files, err := generate(schema, config)
 	if err != nil {
-		log.Fatalf("schema parse error: %s", err)
+		log.Fatalf("generate error: %s", err)
+	}
+
+	if config.runtimeDirPath != "" {
+		runtime, err := runtimeFiles(config)
+		if err != nil {
+			log.Fatalf("runtime files error: %s", err)
+		}
+
+		for _, file := range runtime {
+			for _, generated := range files {
+				if file.name == generated.name {
+					log.Fatalf("runtime file %s conflicts with a generated file, set another file name", file.name)
+				}
+			}
+		}
+		files = append(files, runtime...)
+	}
+
+	if config.check {
+		outdated := check(files, config)
+		if len(outdated) > 0 {
+			for _, name := range outdated {
+				log.Printf("%s is not up to date with the schema", filepath.Join(config.outputDirPath, name))
+			}
+			os.Exit(1)
+		}
 		return
 	}
 
@@ -53,61 +107,213 @@ func main() {
 		log.Fatalf("error creating %s: %s", config.outputDirPath, err)
 	}
 
-	var writer *bufio.Writer
+	for _, name := range obsolete(files, config) {
+		filePath := filepath.Join(config.outputDirPath, name)
 
-	functionFilePath := filepath.Join(config.outputDirPath, config.functionFileName)
+		err = os.Remove(filePath)
+		if err != nil {
+			log.Fatalf("error removing %s: %s", filePath, err)
+		}
+	}
+
+	for _, file := range files {
+		filePath := filepath.Join(config.outputDirPath, file.name)
+
+		err = os.WriteFile(filePath, file.content, 0644)
+		if err != nil {
+			log.Fatalf("error writing %s: %s", filePath, err)
+		}
+	}
+}
 
-	os.Remove(functionFilePath)
-	functionFile, err := os.OpenFile(functionFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, os.ModePerm)
+func loadSchema(config config) (*tlparser.Schema, error) {
+	reader, err := source.Open(config.schemaPath, source.URL(config.version, "td/generate/scheme/td_api.tl"))
 	if err != nil {
-		log.Fatalf("functionFile open error: %s", err)
+		return nil, err
 	}
-	defer functionFile.Close()
+	defer reader.Close()
 
-	writer = bufio.NewWriter(functionFile)
-	writer.Write(codegen.GenerateFunctions(schema, config.packageName))
-	writer.Flush()
+	schema, err := tlparser.Parse(reader)
+	if err != nil {
+		return nil, err
+	}
 
-	typeFilePath := filepath.Join(config.outputDirPath, config.typeFileName)
+	if config.functionTypesPath != "" {
+		f, err := os.Open(config.functionTypesPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
 
-	os.Remove(typeFilePath)
-	typeFile, err := os.OpenFile(typeFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, os.ModePerm)
+		err = tlparser.ParseFunctionTypes(f, schema)
+		if err != nil {
+			return nil, fmt.Errorf("function types: %w", err)
+		}
+	}
+
+	return schema, nil
+}
+
+// generate returns the gofmt-ed content of the generated files
+func generate(schema *tlparser.Schema, config config) ([]generatedFile, error) {
+	if config.include != "" || config.exclude != "" {
+		var err error
+		schema, err = codegen.Subset(schema, codegen.ParsePatterns(config.include), codegen.ParsePatterns(config.exclude))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := codegen.Check(schema)
 	if err != nil {
-		log.Fatalf("typeFile open error: %s", err)
+		return nil, err
+	}
+
+	parts := []*codegen.Part{codegen.WholeSchema(schema)}
+	if config.split {
+		parts = codegen.Split(schema)
 	}
-	defer typeFile.Close()
 
-	writer = bufio.NewWriter(typeFile)
-	writer.Write(codegen.GenerateTypes(schema, config.packageName))
-	writer.Flush()
+	var files []generatedFile
+	for _, part := range parts {
+		for _, file := range []generatedFile{
+			{partFileName(config.functionFileName, part), codegen.GenerateFunctions(schema, part, config.packageName)},
+			{partFileName(config.typeFileName, part), codegen.GenerateTypes(schema, part, config.packageName)},
+			{partFileName(config.unmarshalerFileName, part), codegen.GenerateUnmarshalers(schema, part, config.packageName)},
+		} {
+			if file.content != nil {
+				files = append(files, file)
+			}
+		}
+	}
 
-	unmarshalerFilePath := filepath.Join(config.outputDirPath, config.unmarshalerFileName)
+	files = append(files, []generatedFile{
+		{config.updateFileName, codegen.GenerateUpdates(schema, config.packageName)},
+		{config.interfaceFileName, codegen.GenerateInterface(schema, config.packageName)},
+		{config.mockFileName, codegen.GenerateMock(schema, config.packageName)},
+		{config.registryFileName, codegen.GenerateRegistry(schema, config.packageName)},
+		{config.versionFileName, []byte(fmt.Sprintf(`// AUTOGENERATED
+package %s
+
+const TDLIB_VERSION = %q
+`, config.packageName, config.version))},
+	}...)
+
+	for i, file := range files {
+		content, err := format.Source(file.content)
+		if err != nil {
+			return nil, fmt.Errorf("format %s: %w", file.name, err)
+		}
+		files[i].content = content
+	}
+
+	return files, nil
+}
 
-	os.Remove(unmarshalerFilePath)
-	unmarshalerFile, err := os.OpenFile(unmarshalerFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, os.ModePerm)
+// partFileName returns the file name of the part, e.g. function_messages_generated.go for function_generated.go
+func partFileName(fileName string, part *codegen.Part) string {
+	if part.Name == "" {
+		return fileName
+	}
+
+	base := strings.TrimSuffix(fileName, ".go")
+	suffix := ".go"
+	if strings.HasSuffix(base, "_generated") {
+		base = strings.TrimSuffix(base, "_generated")
+		suffix = "_generated.go"
+	}
+
+	return base + "_" + part.Name + suffix
+}
+
+// isOutputFile reports whether the name of the file in the output directory is one of the configured files or a part of them
+func isOutputFile(name string, config config) bool {
+	if strings.HasSuffix(name, "_test.go") {
+		return false
+	}
+
+	for _, fileName := range []string{config.functionFileName, config.typeFileName, config.unmarshalerFileName} {
+		if name == fileName {
+			return true
+		}
+
+		part := partFileName(fileName, &codegen.Part{Name: "*"})
+		if ok, _ := filepath.Match(part, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+var packageClause = regexp.MustCompile(`(?m)^package client$`)
+
+// runtimeFiles returns the non-generated files of the client package with the package clause of the output package
+func runtimeFiles(config config) ([]generatedFile, error) {
+	entries, err := os.ReadDir(config.runtimeDirPath)
 	if err != nil {
-		log.Fatalf("unmarshalerFile open error: %s", err)
+		return nil, err
+	}
+
+	var files []generatedFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_generated.go") || name == "generate.go" {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(config.runtimeDirPath, name))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, generatedFile{name, packageClause.ReplaceAll(content, []byte("package "+config.packageName))})
 	}
-	defer unmarshalerFile.Close()
 
-	writer = bufio.NewWriter(unmarshalerFile)
-	writer.Write(codegen.GenerateUnmarshalers(schema, config.packageName))
-	writer.Flush()
+	return files, nil
+}
+
+// check returns the names of the files which are missing, differ from the generated ones or are obsolete
+func check(files []generatedFile, config config) []string {
+	var outdated []string
+
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(config.outputDirPath, file.name))
+		if err != nil || !bytes.Equal(content, file.content) {
+			outdated = append(outdated, file.name)
+		}
+	}
 
-	versionFilePath := filepath.Join(config.outputDirPath, config.versionFileName)
+	return append(outdated, obsolete(files, config)...)
+}
 
-	os.Remove(versionFilePath)
-	versionFile, err := os.OpenFile(versionFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, os.ModePerm)
+// obsolete returns the names of the files in the output directory which were generated before, but aren't generated now,
+// e.g. the files of the parts after switching off the splitting. Only the files with the header of the generated code are
+// returned, so that hand-written files matching the names of the parts (e.g. type_helpers.go) are never removed
+func obsolete(files []generatedFile, config config) []string {
+	entries, err := os.ReadDir(config.outputDirPath)
 	if err != nil {
-		log.Fatalf("unmarshalerFile open error: %s", err)
+		return nil
 	}
-	defer versionFile.Close()
 
-	writer = bufio.NewWriter(versionFile)
-	writer.Write([]byte(fmt.Sprintf(`// AUTOGENERATED
-package %s
+	generated := map[string]bool{}
+	for _, file := range files {
+		generated[file.name] = true
+	}
 
-const TDLIB_VERSION = %q
-`, config.packageName, config.version)))
-	writer.Flush()
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || generated[entry.Name()] || !isOutputFile(entry.Name(), config) {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(config.outputDirPath, entry.Name()))
+		if err != nil || !codegen.IsGenerated(content) {
+			continue
+		}
+
+		names = append(names, entry.Name())
+	}
+
+	return names
 }
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	makefile, err := os.ReadFile("../../Makefile")
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	match := regexp.MustCompile(`(?m)^TAG := (\S+)$`).FindSubmatch(makefile)
+	if match == nil {
+		t.Fatal("no TAG in the Makefile")
+	}
+
+	config := config{
+		version:             string(match[1]),
+		schemaPath:          "../../data/td_api.tl",
+		functionTypesPath:   "../../data/td_api.json",
+		packageName:         "client",
+		functionFileName:    "function_generated.go",
+		typeFileName:        "type_generated.go",
+		unmarshalerFileName: "unmarshaler_generated.go",
+		updateFileName:      "update_generated.go",
+		interfaceFileName:   "interface_generated.go",
+		mockFileName:        "mock_generated.go",
+		registryFileName:    "registry_generated.go",
+		versionFileName:     "version_generated.go",
+		split:               true,
+	}
+
+	schema, err := loadSchema(config)
+	if err != nil {
+		t.Fatalf("schema load error: %s", err)
+	}
+
+	files, err := generate(schema, config)
+	if err != nil {
+		t.Fatalf("generate error: %s", err)
+	}
+
+	config.outputDirPath = "../../client"
+	for _, name := range check(files, config) {
+		t.Errorf("client/%s is not up to date with data/td_api.tl and TAG of the Makefile, run `make generate-code`", name)
+	}
+}
+
+func TestGenerateSubsetPackage(t *testing.T) {
+	outputDirPath := t.TempDir()
+
+	config := config{
+		schemaPath:          "../../data/td_api.tl",
+		functionTypesPath:   "../../data/td_api.json",
+		outputDirPath:       outputDirPath,
+		packageName:         "tdlib",
+		functionFileName:    "function_generated.go",
+		typeFileName:        "type_generated.go",
+		unmarshalerFileName: "unmarshaler_generated.go",
+		updateFileName:      "update_generated.go",
+		interfaceFileName:   "interface_generated.go",
+		mockFileName:        "mock_generated.go",
+		registryFileName:    "registry_generated.go",
+		versionFileName:     "version_generated.go",
+		split:               true,
+		include:             "getChat,sendMessage,updateNewMessage",
+		exclude:             "messageGiveaway*",
+		runtimeDirPath:      "../../client",
+	}
+
+	schema, err := loadSchema(config)
+	if err != nil {
+		t.Fatalf("schema load error: %s", err)
+	}
+
+	files, err := generate(schema, config)
+	if err != nil {
+		t.Fatalf("generate error: %s", err)
+	}
+
+	runtime, err := runtimeFiles(config)
+	if err != nil {
+		t.Fatalf("runtime files error: %s", err)
+	}
+
+	typesPkg := writeAndTypeCheck(t, outputDirPath, "tdlib", append(files, runtime...))
+
+	for _, name := range []string{"Client", "GetChatRequest", "SendMessageRequest", "UpdateNewMessage", "MessageText"} {
+		if typesPkg.Scope().Lookup(name) == nil {
+			t.Errorf("%s is not generated", name)
+		}
+	}
+	for _, name := range []string{"GetStoryRequest", "MessageGiveaway", "UpdateNewChat"} {
+		if typesPkg.Scope().Lookup(name) != nil {
+			t.Errorf("%s is generated", name)
+		}
+	}
+}
+
+// vectorResultFunctions are appended to the schema to check the generators with vector results, which TDLib may add
+const vectorResultFunctions = `
+
+//@description Returns identifiers @chat_id Chat identifier
+getTestIds chat_id:int53 = vector<int53>;
+
+//@description Returns messages; for testing only @chat_id Chat identifier
+getTestMessages chat_id:int53 = vector<Message>;
+
+//@description Returns lists of chat lists; for testing only
+getTestChatLists = vector<vector<ChatList>>;
+`
+
+func TestGenerateVectorResults(t *testing.T) {
+	schemaData, err := os.ReadFile("../../data/td_api.tl")
+	if err != nil {
+		t.Fatalf("schema read error: %s", err)
+	}
+
+	outputDirPath := t.TempDir()
+	schemaPath := filepath.Join(t.TempDir(), "td_api.tl")
+	err = os.WriteFile(schemaPath, append(schemaData, vectorResultFunctions...), 0644)
+	if err != nil {
+		t.Fatalf("schema write error: %s", err)
+	}
+
+	config := config{
+		schemaPath:          schemaPath,
+		outputDirPath:       outputDirPath,
+		packageName:         "tdlib",
+		functionFileName:    "function_generated.go",
+		typeFileName:        "type_generated.go",
+		unmarshalerFileName: "unmarshaler_generated.go",
+		updateFileName:      "update_generated.go",
+		interfaceFileName:   "interface_generated.go",
+		mockFileName:        "mock_generated.go",
+		registryFileName:    "registry_generated.go",
+		versionFileName:     "version_generated.go",
+		include:             "getTest*,updateNewMessage",
+		runtimeDirPath:      "../../client",
+	}
+
+	schema, err := loadSchema(config)
+	if err != nil {
+		t.Fatalf("schema load error: %s", err)
+	}
+
+	files, err := generate(schema, config)
+	if err != nil {
+		t.Fatalf("generate error: %s", err)
+	}
+
+	runtime, err := runtimeFiles(config)
+	if err != nil {
+		t.Fatalf("runtime files error: %s", err)
+	}
+
+	typesPkg := writeAndTypeCheck(t, outputDirPath, "tdlib", append(files, runtime...))
+
+	for name, want := range map[string]string{
+		"GetTestIds":       "func(ctx context.Context, req *GetTestIdsRequest) ([]int64, error)",
+		"GetTestMessages":  "func(ctx context.Context, req *GetTestMessagesRequest) ([]*Message, error)",
+		"GetTestChatLists": "func(ctx context.Context) ([][]ChatList, error)",
+	} {
+		client := typesPkg.Scope().Lookup("Client")
+		method, _, _ := types.LookupFieldOrMethod(client.Type(), true, typesPkg, name)
+		if method == nil {
+			t.Errorf("Client.%s is not generated", name)
+			continue
+		}
+
+		signature := types.TypeString(method.Type(), types.RelativeTo(typesPkg))
+		if signature != want {
+			t.Errorf("Client.%s = %s, want %s", name, signature, want)
+		}
+	}
+
+	for _, name := range []string{"TDLib", "Mock"} {
+		if typesPkg.Scope().Lookup(name) == nil {
+			t.Errorf("%s is not generated", name)
+		}
+	}
+}
+
+// writeAndTypeCheck writes the files of a package to the directory and type-checks the package
+func writeAndTypeCheck(t *testing.T, dirPath string, packageName string, files []generatedFile) *types.Package {
+	t.Helper()
+
+	for _, file := range files {
+		err := os.WriteFile(filepath.Join(dirPath, file.name), file.content, 0644)
+		if err != nil {
+			t.Fatalf("write error: %s", err)
+		}
+	}
+
+	pkg, err := build.ImportDir(dirPath, 0)
+	if err != nil {
+		t.Fatalf("import error: %s", err)
+	}
+
+	fset := token.NewFileSet()
+	var astFiles []*ast.File
+	for _, name := range append(pkg.GoFiles, pkg.CgoFiles...) {
+		file, err := parser.ParseFile(fset, filepath.Join(dirPath, name), nil, 0)
+		if err != nil {
+			t.Fatalf("parse error: %s", err)
+		}
+		astFiles = append(astFiles, file)
+	}
+
+	typesConfig := types.Config{
+		FakeImportC: true,
+		Importer:    importer.ForCompiler(fset, "source", nil),
+	}
+	typesPkg, err := typesConfig.Check(packageName, fset, astFiles, nil)
+	if err != nil {
+		t.Fatalf("type check error: %s", err)
+	}
+
+	return typesPkg
+}
+
+func TestObsoleteKeepsHandWrittenFiles(t *testing.T) {
+	outputDirPath := t.TempDir()
+
+	config := config{
+		outputDirPath:       outputDirPath,
+		functionFileName:    "function.go",
+		typeFileName:        "type.go",
+		unmarshalerFileName: "unmarshaler.go",
+	}
+
+	for name, content := range map[string]string{
+		"type.go":              "// AUTOGENERATED\npackage tdlib\n",
+		"type_messages.go":     "// AUTOGENERATED\npackage tdlib\n",
+		"function_messages.go": "// AUTOGENERATED\npackage tdlib\n",
+		"type_extra.go":        "package tdlib\n\n// hand-written\n",
+		"function_helpers.go":  "// Package tdlib\npackage tdlib\n",
+		"type_test.go":         "// AUTOGENERATED\npackage tdlib\n",
+	} {
+		err := os.WriteFile(filepath.Join(outputDirPath, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatalf("write error: %s", err)
+		}
+	}
+
+	files := []generatedFile{{name: "type.go"}}
+
+	names := obsolete(files, config)
+	want := []string{"function_messages.go", "type_messages.go"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("obsolete = %v, want %v", names, want)
+	}
+}
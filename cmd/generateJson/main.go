@@ -1,11 +1,14 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/source"
 	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"io"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,32 +16,45 @@ import (
 
 func main() {
 	var version string
+	var schemaPath string
+	var requestsPath string
+	var functionTypesPath string
 	var outputPath string
 
 	flag.StringVar(&version, "version", "", "TDLib version")
+	flag.StringVar(&schemaPath, "schema", "", "td_api.tl file. If empty, the schema of the version is downloaded from GitHub")
+	flag.StringVar(&requestsPath, "requests", "", "Requests.cpp file. If empty, the file of the version is downloaded from GitHub")
+	flag.StringVar(&functionTypesPath, "functionTypes", "", "td_api.json file to take the function types from instead of Requests.cpp, e.g. the previous output")
 	flag.StringVar(&outputPath, "output", "./td_api.json", "json schema file")
 	flag.Parse()
 
-	res, err := http.Get("https://raw.githubusercontent.com/tdlib/td/" + version + "/td/generate/scheme/td_api.tl")
+	schemaReader, err := source.Open(schemaPath, source.URL(version, "td/generate/scheme/td_api.tl"))
 	if err != nil {
-		log.Fatalf("http.Get error: %s", err)
+		log.Fatalf("schema open error: %s", err)
 	}
-	defer res.Body.Close()
+	defer schemaReader.Close()
 
-	schema, err := tlparser.Parse(res.Body)
+	schema, err := tlparser.Parse(schemaReader)
 	if err != nil {
 		log.Fatalf("schema parse error: %s", err)
 	}
 
-	res, err = http.Get("https://raw.githubusercontent.com/tdlib/td/" + version + "/td/telegram/Requests.cpp")
-	if err != nil {
-		log.Fatalf("http.Get error: %s", err)
-	}
-	defer res.Body.Close()
+	if functionTypesPath != "" {
+		err = loadFunctionTypes(functionTypesPath, schema)
+		if err != nil {
+			log.Fatalf("function types error: %s", err)
+		}
+	} else {
+		requestsReader, err := source.Open(requestsPath, source.URL(version, "td/telegram/Requests.cpp"))
+		if err != nil {
+			log.Fatalf("requests open error: %s", err)
+		}
+		defer requestsReader.Close()
 
-	err = tlparser.ParseCode(res.Body, schema)
-	if err != nil {
-		log.Fatalf("parse code error: %s", err)
+		err = tlparser.ParseCode(requestsReader, schema)
+		if err != nil {
+			log.Fatalf("parse code error: %s", err)
+		}
 	}
 
 	err = os.MkdirAll(filepath.Dir(outputPath), os.ModePerm)
@@ -52,10 +68,48 @@ func main() {
 	}
 	defer f.Close()
 
-	enc := json.NewEncoder(f)
-	enc.SetIndent("", strings.Repeat(" ", 4))
-	err = enc.Encode(schema)
+	err = encode(f, schema)
 	if err != nil {
 		log.Fatalf("enc.Encode error: %s", err)
 	}
 }
+
+// loadFunctionTypes sets the function types from td_api.json. The types of functions which aren't in the file are unknown,
+// they are taken from Requests.cpp by make schema-update
+func loadFunctionTypes(path string, schema *tlparser.Schema) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var functionTypes tlparser.Schema
+	err = json.Unmarshal(data, &functionTypes)
+	if err != nil {
+		return err
+	}
+
+	known := map[string]bool{}
+	for _, fn := range functionTypes.Functions {
+		known[fn.Name] = true
+	}
+
+	var missing []string
+	for _, fn := range schema.Functions {
+		if !known[fn.Name] {
+			missing = append(missing, fn.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s has no types of %s, take them from Requests.cpp with -requests", path, strings.Join(missing, ", "))
+	}
+
+	return tlparser.ParseFunctionTypes(bytes.NewReader(data), schema)
+}
+
+func encode(w io.Writer, schema *tlparser.Schema) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", strings.Repeat(" ", 4))
+
+	return enc.Encode(schema)
+}
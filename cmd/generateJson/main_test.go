@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestJsonUpToDate checks that data/td_api.json is generated from data/td_api.tl. Function types can't be checked without Requests.cpp and are taken from the file.
+func TestJsonUpToDate(t *testing.T) {
+	schemaFile, err := os.Open("../../data/td_api.tl")
+	if err != nil {
+		t.Fatalf("open error: %s", err)
+	}
+	defer schemaFile.Close()
+
+	schema, err := tlparser.Parse(schemaFile)
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	err = loadFunctionTypes("../../data/td_api.json", schema)
+	if err != nil {
+		t.Fatalf("function types error: %s", err)
+	}
+
+	data, err := os.ReadFile("../../data/td_api.json")
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	err = encode(buf, schema)
+	if err != nil {
+		t.Fatalf("encode error: %s", err)
+	}
+
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("data/td_api.json is out of date, run make generate-json")
+	}
+}
+
+func TestLoadFunctionTypesMissing(t *testing.T) {
+	schema, err := tlparser.Parse(strings.NewReader(`ok = Ok;
+
+---functions---
+
+//@description Returns ok
+getOk = Ok;
+
+//@description Returns ok to a bot
+getBotOk = Ok;
+`))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "td_api.json")
+	err = os.WriteFile(path, []byte(`{"functions":[{"name":"getOk","type":"common"}]}`), 0644)
+	if err != nil {
+		t.Fatalf("write error: %s", err)
+	}
+
+	err = loadFunctionTypes(path, schema)
+	if err == nil || !strings.Contains(err.Error(), "getBotOk") {
+		t.Errorf("error = %v, want the missing getBotOk", err)
+	}
+}
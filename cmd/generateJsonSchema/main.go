@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"github.com/zelenin/go-tdlib/internal/jsonschema"
+	"github.com/zelenin/go-tdlib/internal/source"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	var version string
+	var schemaPath string
+	var id string
+	var outputPath string
+
+	flag.StringVar(&version, "version", "", "TDLib version")
+	flag.StringVar(&schemaPath, "schema", "", "td_api.tl file. If empty, the schema of the version is downloaded from GitHub")
+	flag.StringVar(&id, "id", "", "$id of the JSON Schema")
+	flag.StringVar(&outputPath, "output", "./td_api.schema.json", "JSON Schema file")
+	flag.Parse()
+
+	schemaReader, err := source.Open(schemaPath, source.URL(version, "td/generate/scheme/td_api.tl"))
+	if err != nil {
+		log.Fatalf("schema open error: %s", err)
+	}
+	defer schemaReader.Close()
+
+	schema, err := tlparser.Parse(schemaReader)
+	if err != nil {
+		log.Fatalf("schema parse error: %s", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(outputPath), os.ModePerm)
+	if err != nil {
+		log.Fatalf("make dir error: %s", filepath.Dir(outputPath))
+	}
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		log.Fatalf("open file error: %s", err)
+	}
+	defer f.Close()
+
+	err = encode(f, schema, id)
+	if err != nil {
+		log.Fatalf("enc.Encode error: %s", err)
+	}
+}
+
+func encode(w io.Writer, schema *tlparser.Schema, id string) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", strings.Repeat(" ", 4))
+
+	return enc.Encode(jsonschema.Generate(schema, id))
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"os"
+	"regexp"
+	"testing"
+)
+
+// TestJsonSchemaUpToDate checks that data/td_api.schema.json is generated from data/td_api.tl with the $id of the Makefile
+func TestJsonSchemaUpToDate(t *testing.T) {
+	makefile, err := os.ReadFile("../../Makefile")
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	match := regexp.MustCompile(`-id "([^"]+)"`).FindSubmatch(makefile)
+	if match == nil {
+		t.Fatal("no -id in the Makefile")
+	}
+
+	schemaFile, err := os.Open("../../data/td_api.tl")
+	if err != nil {
+		t.Fatalf("open error: %s", err)
+	}
+	defer schemaFile.Close()
+
+	schema, err := tlparser.Parse(schemaFile)
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	err = encode(buf, schema, string(match[1]))
+	if err != nil {
+		t.Fatalf("encode error: %s", err)
+	}
+
+	data, err := os.ReadFile("../../data/td_api.schema.json")
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("data/td_api.schema.json is out of date, run make generate-json-schema")
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"github.com/zelenin/go-tdlib/internal/codegen"
+	"github.com/zelenin/go-tdlib/internal/source"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	var version string
+	var schemaPath string
+	var protoPath string
+	var lockPath string
+	var protoPackage string
+	var goPackage string
+	var converterPath string
+	var converterPackage string
+
+	flag.StringVar(&version, "version", "", "TDLib version")
+	flag.StringVar(&schemaPath, "schema", "", "td_api.tl file. If empty, the schema of the version is downloaded from GitHub")
+	flag.StringVar(&protoPath, "output", "./td_api.proto", "proto file")
+	flag.StringVar(&lockPath, "lock", "./td_api.proto.lock", "lock file with the field numbers. It is created if it doesn't exist and updated with the new fields")
+	flag.StringVar(&protoPackage, "protoPackage", "tdlib", "proto package name")
+	flag.StringVar(&goPackage, "goPackage", "", "go_package option, the import path of the package generated by protoc-gen-go")
+	flag.StringVar(&converterPath, "converterFile", "", "file of the converters between the client types and the protobuf types. If empty, the converters are not generated")
+	flag.StringVar(&converterPackage, "converterPackage", "tdlibproto", "package name of the converters")
+	flag.Parse()
+
+	if converterPath != "" && goPackage == "" {
+		log.Fatal("converters require -goPackage")
+	}
+
+	schemaReader, err := source.Open(schemaPath, source.URL(version, "td/generate/scheme/td_api.tl"))
+	if err != nil {
+		log.Fatalf("schema open error: %s", err)
+	}
+	defer schemaReader.Close()
+
+	schema, err := tlparser.Parse(schemaReader)
+	if err != nil {
+		log.Fatalf("schema parse error: %s", err)
+	}
+
+	err = codegen.Check(schema)
+	if err != nil {
+		log.Fatalf("schema check error: %s", err)
+	}
+
+	lock, err := readLock(lockPath)
+	if err != nil {
+		log.Fatalf("lock read error: %s", err)
+	}
+
+	writeFile(protoPath, codegen.GenerateProto(schema, lock, protoPackage, goPackage))
+
+	lockData, err := lock.Marshal()
+	if err != nil {
+		log.Fatalf("lock marshal error: %s", err)
+	}
+	writeFile(lockPath, lockData)
+
+	if converterPath != "" {
+		converters, err := format.Source(codegen.GenerateProtoConverters(schema, converterPackage, goPackage))
+		if err != nil {
+			log.Fatalf("format converters error: %s", err)
+		}
+		writeFile(converterPath, converters)
+	}
+}
+
+func readLock(path string) (*codegen.ProtoLock, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return codegen.NewProtoLock(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return codegen.ReadProtoLock(f)
+}
+
+func writeFile(path string, data []byte) {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		log.Fatalf("make dir error: %s", filepath.Dir(path))
+	}
+
+	err = os.WriteFile(path, data, 0644)
+	if err != nil {
+		log.Fatalf("error writing %s: %s", path, err)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"github.com/zelenin/go-tdlib/internal/tldiff"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"log"
+	"os"
+)
+
+func main() {
+	var oldPath string
+	var newPath string
+	var format string
+	var failOnBreaking bool
+
+	flag.StringVar(&oldPath, "old", "", "old td_api.tl file")
+	flag.StringVar(&newPath, "new", "./data/td_api.tl", "new td_api.tl file")
+	flag.StringVar(&format, "format", "text", "output format: text, markdown or json")
+	flag.BoolVar(&failOnBreaking, "failOnBreaking", false, "exit with code 1 if there are breaking changes")
+	flag.Parse()
+
+	oldSchema, err := parseSchema(oldPath)
+	if err != nil {
+		log.Fatalf("old schema error: %s", err)
+	}
+
+	newSchema, err := parseSchema(newPath)
+	if err != nil {
+		log.Fatalf("new schema error: %s", err)
+	}
+
+	report := tldiff.Diff(oldSchema, newSchema)
+
+	var output []byte
+	switch format {
+	case "text":
+		output = tldiff.Text(report)
+	case "markdown":
+		output = tldiff.Markdown(report)
+	case "json":
+		output, err = tldiff.Json(report)
+		if err != nil {
+			log.Fatalf("json error: %s", err)
+		}
+	default:
+		log.Fatalf("unknown format: %s", format)
+	}
+
+	os.Stdout.Write(output)
+
+	if failOnBreaking && report.HasBreaking() {
+		os.Exit(1)
+	}
+}
+
+func parseSchema(path string) (*tlparser.Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return tlparser.Parse(f)
+}
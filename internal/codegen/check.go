@@ -0,0 +1,40 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+)
+
+// Check returns an error for every argument or result type which is neither a class nor a constructor of the schema
+func Check(schema *tlparser.Schema) error {
+	var errs []error
+
+	for _, constructor := range schema.Constructors {
+		for _, arg := range constructor.Args {
+			if !isKnownType(arg.Type, schema) {
+				errs = append(errs, fmt.Errorf("constructor %s: field %s: unknown type %s", constructor.Name, arg.Name, arg.Type))
+			}
+		}
+	}
+
+	for _, function := range schema.Functions {
+		for _, arg := range function.Args {
+			if !isKnownType(arg.Type, schema) {
+				errs = append(errs, fmt.Errorf("function %s: field %s: unknown type %s", function.Name, arg.Name, arg.Type))
+			}
+		}
+
+		if !isKnownType(function.ResultType, schema) {
+			errs = append(errs, fmt.Errorf("function %s: unknown result type %s", function.Name, function.ResultType))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func isKnownType(argType string, schema *tlparser.Schema) bool {
+	tdlibTypeArg := TdlibTypeArg("", argType, schema)
+
+	return tdlibTypeArg.IsType() || tdlibTypeArg.IsConstructor()
+}
@@ -0,0 +1,226 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+)
+
+// codecName returns the suffix of the reader and the writer of a primitive type, e.g. readInt32 and writeInt32.
+// Types without a codec are read and written by encoding/json
+func codecName(goType string) (string, bool) {
+	switch goType {
+	case "float64":
+		return "Float64", true
+
+	case "string":
+		return "String", true
+
+	case "int32":
+		return "Int32", true
+
+	case "int64":
+		return "Int64", true
+
+	case "JsonInt64":
+		return "JsonInt64", true
+
+	case "[]byte":
+		return "Bytes", true
+
+	case "bool":
+		return "Bool", true
+	}
+
+	return "", false
+}
+
+// element returns the element type of a vector
+func (entity *tdlibTypeArg) element() *tdlibTypeArg {
+	return TdlibTypeArg(entity.name, vectorElement(entity.argType), entity.schema)
+}
+
+// isPointer reports whether the struct field is a pointer to a nullable scalar
+func (entity *tdlibTypeArg) isPointer(nullable bool) bool {
+	return entity.ToGoFieldType(nullable) != entity.ToGoType()
+}
+
+// ToDecoder returns the expression of a function which reads a value of the type from jsonDecoder
+func (entity *tdlibTypeArg) ToDecoder() string {
+	if entity.IsList() {
+		return "listReader(" + entity.element().ToDecoder() + ")"
+	}
+
+	if entity.IsType() {
+		return "decode" + entity.GetType().ToGoType()
+	}
+
+	if entity.GetConstructor().IsInternal() {
+		goType := entity.GetConstructor().ToGoType()
+		if name, ok := codecName(goType); ok {
+			return "(*jsonDecoder).read" + name
+		}
+
+		return "readValue[" + goType + "]"
+	}
+
+	return "decodeEntity[" + entity.GetConstructor().ToGoType() + "]"
+}
+
+// ToDecodeExpression returns the expression which reads a value of the struct field from decoder
+func (entity *tdlibTypeArg) ToDecodeExpression(nullable bool) string {
+	if entity.IsList() {
+		return fmt.Sprintf("readList(decoder, %s)", entity.element().ToDecoder())
+	}
+
+	if entity.isPointer(nullable) {
+		return fmt.Sprintf("readNullable(decoder, %s)", entity.ToDecoder())
+	}
+
+	if !entity.IsType() && entity.GetConstructor().IsInternal() {
+		if name, ok := codecName(entity.GetConstructor().ToGoType()); ok {
+			return "decoder.read" + name + "()"
+		}
+	}
+
+	return entity.ToDecoder() + "(decoder)"
+}
+
+// ToEncoder returns the expression of a function which writes a value of the type to jsonEncoder
+func (entity *tdlibTypeArg) ToEncoder() string {
+	if entity.IsList() {
+		return "listWriter(" + entity.element().ToEncoder() + ")"
+	}
+
+	if !entity.IsType() && entity.GetConstructor().IsInternal() {
+		if name, ok := codecName(entity.GetConstructor().ToGoType()); ok {
+			return "(*jsonEncoder).write" + name
+		}
+	}
+
+	return "writeTypeValue[" + entity.ToGoType() + "]"
+}
+
+// ToEncodeStatement returns the statement which writes the value of the struct field to encoder
+func (entity *tdlibTypeArg) ToEncodeStatement(nullable bool, value string) string {
+	if entity.IsList() {
+		return fmt.Sprintf("writeList(encoder, %s, %s)", value, entity.element().ToEncoder())
+	}
+
+	if entity.IsType() {
+		return fmt.Sprintf("encoder.writeType(%s)", value)
+	}
+
+	if !entity.GetConstructor().IsInternal() {
+		return fmt.Sprintf("%s.encodeJSON(encoder)", value)
+	}
+
+	name, ok := codecName(entity.GetConstructor().ToGoType())
+	if !ok {
+		return fmt.Sprintf("encoder.writeType(%s)", value)
+	}
+
+	if entity.isPointer(nullable) {
+		value = "*" + value
+	}
+
+	return fmt.Sprintf("encoder.write%s(%s)", name, value)
+}
+
+// ToOmitCondition returns the condition of writing a nullable field, so that null values are omitted as with omitempty
+func (entity *tdlibTypeArg) ToOmitCondition(nullable bool, value string) string {
+	if !nullable {
+		return ""
+	}
+
+	if entity.IsList() || entity.ToGoType() == "[]byte" {
+		return fmt.Sprintf("len(%s) > 0", value)
+	}
+
+	return fmt.Sprintf("%s != nil", value)
+}
+
+// generateDecodeJSON returns the decoder of the fields of a constructor. Errors are prefixed with the name of the failed field
+func generateDecodeJSON(receiver string, goType string, constructorConst string, args []*tlparser.Arg, schema *tlparser.Schema) string {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf(`func (%s *%s) decodeJSON(decoder *jsonDecoder) error {
+    return decoder.readObject(func(key []byte) error {
+`, receiver, goType))
+
+	if len(args) == 0 {
+		buf.WriteString(fmt.Sprintf(`        return decoder.readMeta(&%s.meta, key, %s)
+    })
+}
+`, receiver, constructorConst))
+
+		return buf.String()
+	}
+
+	buf.WriteString(`        var err error
+        switch string(key) {
+`)
+
+	for _, arg := range args {
+		tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
+
+		buf.WriteString(fmt.Sprintf(`        case %q:
+            %s.%s, err = %s
+
+`, arg.Name, receiver, tdlibTypeArg.ToGoName(), tdlibTypeArg.ToDecodeExpression(arg.Nullable)))
+	}
+
+	buf.WriteString(fmt.Sprintf(`        default:
+            err = decoder.readMeta(&%s.meta, key, %s)
+        }
+        if err != nil {
+            return wrapUnmarshalError(string(key), err)
+        }
+
+        return nil
+    })
+}
+`, receiver, constructorConst))
+
+	return buf.String()
+}
+
+// generateEncodeJSON returns the encoder of a constructor or a request. meta writes @extra and @client_id
+func generateEncodeJSON(receiver string, goType string, constructor string, meta string, args []*tlparser.Arg, schema *tlparser.Schema) string {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("func (%s *%s) encodeJSON(encoder *jsonEncoder) {\n", receiver, goType))
+
+	buf.WriteString(fmt.Sprintf(`    if %s == nil {
+        encoder.writeRaw("null")
+        return
+    }
+
+`, receiver))
+
+	buf.WriteString(fmt.Sprintf("    encoder.writeRaw(`{\"@type\":%q`)\n", constructor))
+	buf.WriteString(fmt.Sprintf("    %s\n", meta))
+
+	for _, arg := range args {
+		tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
+		value := receiver + "." + tdlibTypeArg.ToGoName()
+
+		indent := "    "
+		condition := tdlibTypeArg.ToOmitCondition(arg.Nullable, value)
+		if condition != "" {
+			buf.WriteString(fmt.Sprintf("    if %s {\n", condition))
+			indent = "        "
+		}
+
+		buf.WriteString(fmt.Sprintf("%sencoder.writeRaw(`,%q:`)\n", indent, arg.Name))
+		buf.WriteString(fmt.Sprintf("%s%s\n", indent, tdlibTypeArg.ToEncodeStatement(arg.Nullable, value)))
+
+		if condition != "" {
+			buf.WriteString("    }\n")
+		}
+	}
+
+	buf.WriteString("    encoder.writeRaw(\"}\")\n}\n")
+
+	return buf.String()
+}
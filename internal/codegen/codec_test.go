@@ -0,0 +1,57 @@
+package codegen
+
+import (
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"strings"
+	"testing"
+)
+
+func TestCodecExpressions(t *testing.T) {
+	schema, err := tlparser.Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	tests := []struct {
+		arg       string
+		decode    string
+		encode    string
+		condition string
+	}{
+		{"chat_id", "decoder.readInt32()", "encoder.writeInt32(req.ChatId)", ""},
+		{"limit", "readNullable(decoder, (*jsonDecoder).readInt32)", "encoder.writeInt32(*req.Limit)", "req.Limit != nil"},
+		{"title", "readNullable(decoder, (*jsonDecoder).readString)", "encoder.writeString(*req.Title)", "req.Title != nil"},
+		{"content", "decodeEntity[Text](decoder)", "req.Content.encodeJSON(encoder)", "req.Content != nil"},
+	}
+
+	args := schema.Functions[0].Args
+	for i, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			arg := args[i]
+			tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
+			value := "req." + tdlibTypeArg.ToGoName()
+
+			if decode := tdlibTypeArg.ToDecodeExpression(arg.Nullable); decode != tt.decode {
+				t.Errorf("decode = %s, want %s", decode, tt.decode)
+			}
+			if encode := tdlibTypeArg.ToEncodeStatement(arg.Nullable, value); encode != tt.encode {
+				t.Errorf("encode = %s, want %s", encode, tt.encode)
+			}
+			if condition := tdlibTypeArg.ToOmitCondition(arg.Nullable, value); condition != tt.condition {
+				t.Errorf("condition = %s, want %s", condition, tt.condition)
+			}
+		})
+	}
+
+	if name, ok := codecName("Int128"); ok {
+		t.Errorf("codec of Int128 = %s", name)
+	}
+
+	list := TdlibTypeArg("texts", "vector<vector<text>>", schema)
+	if decode := list.ToDecodeExpression(false); decode != "readList(decoder, listReader(decodeEntity[Text]))" {
+		t.Errorf("list decode = %s", decode)
+	}
+	if encode := list.ToEncodeStatement(false, "v"); encode != "writeList(encoder, v, listWriter(writeTypeValue[*Text]))" {
+		t.Errorf("list encode = %s", encode)
+	}
+}
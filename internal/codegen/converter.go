@@ -0,0 +1,275 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+)
+
+// protoGoFieldName returns the name of the struct field generated by protoc-gen-go
+func protoGoFieldName(name string) string {
+	goName := goCamelCase(name)
+
+	switch goName {
+	case "Reset", "String", "ProtoMessage", "Marshal", "Unmarshal", "ExtensionRangeArray", "ExtensionMap", "Descriptor":
+		return goName + "_"
+	}
+
+	return goName
+}
+
+// clientGoType returns the type of a value of the TL type in the client package
+func (entity *protoSchema) clientGoType(argType string) string {
+	if isVector(argType) {
+		return "[]" + entity.clientGoType(vectorElement(argType))
+	}
+
+	switch argType {
+	case "double":
+		return "float64"
+
+	case "string":
+		return "string"
+
+	case "int32":
+		return "int32"
+
+	case "int53":
+		return "int64"
+
+	case "int64":
+		return "client.JsonInt64"
+
+	case "bytes":
+		return "[]byte"
+
+	case "Bool":
+		return "bool"
+	}
+
+	if entity.classes[argType] {
+		return "client." + argType
+	}
+
+	return "*client." + firstUpper(argType)
+}
+
+// protoGoType returns the type of a value of the TL type in the package generated by protoc-gen-go
+func (entity *protoSchema) protoGoType(argType string) string {
+	if isVector(argType) {
+		element := vectorElement(argType)
+		if isVector(element) {
+			return "[]*pb." + goCamelCase(entity.toProtoElementType(element))
+		}
+
+		return "[]" + entity.protoGoType(element)
+	}
+
+	protoType := entity.toProtoElementType(argType)
+	if isProtoScalar(protoType) {
+		if protoType == "bytes" {
+			return "[]byte"
+		}
+		if protoType == "double" {
+			return "float64"
+		}
+
+		return protoType
+	}
+
+	return "*pb." + goCamelCase(protoType)
+}
+
+// toProto returns the expression converting the client value to the protobuf value
+func (entity *protoSchema) toProto(argType string, expr string) string {
+	if isVector(argType) {
+		element := vectorElement(argType)
+		if entity.clientGoType(element) == entity.protoGoType(element) {
+			return expr
+		}
+
+		elementExpr := entity.toProto(element, "value")
+		elementType := entity.protoGoType(element)
+		if isVector(element) {
+			elementType = "*pb." + goCamelCase(entity.toProtoElementType(element))
+			elementExpr = fmt.Sprintf("&pb.%s{Values: %s}", goCamelCase(entity.toProtoElementType(element)), elementExpr)
+		}
+
+		return fmt.Sprintf("convertList(%s, func(value %s) %s {\nreturn %s\n})", expr, entity.clientGoType(element), elementType, elementExpr)
+	}
+
+	if argType == "int64" {
+		return fmt.Sprintf("int64(%s)", expr)
+	}
+
+	if isProtoScalar(entity.toProtoElementType(argType)) {
+		return expr
+	}
+
+	return fmt.Sprintf("%sToProto(%s)", firstUpper(argType), expr)
+}
+
+// fromProto returns the expression converting the protobuf value to the client value
+func (entity *protoSchema) fromProto(argType string, expr string) string {
+	if isVector(argType) {
+		element := vectorElement(argType)
+		if entity.clientGoType(element) == entity.protoGoType(element) {
+			return expr
+		}
+
+		elementExpr := entity.fromProto(element, "value")
+		elementType := entity.protoGoType(element)
+		if isVector(element) {
+			elementType = "*pb." + goCamelCase(entity.toProtoElementType(element))
+			elementExpr = entity.fromProto(element, "value.GetValues()")
+		}
+
+		return fmt.Sprintf("convertList(%s, func(value %s) %s {\nreturn %s\n})", expr, elementType, entity.clientGoType(element), elementExpr)
+	}
+
+	if argType == "int64" {
+		return fmt.Sprintf("client.JsonInt64(%s)", expr)
+	}
+
+	if isProtoScalar(entity.toProtoElementType(argType)) {
+		return expr
+	}
+
+	return fmt.Sprintf("%sFromProto(%s)", firstUpper(argType), expr)
+}
+
+// GenerateProtoConverters returns the functions converting the client types to the protobuf types generated by protoc-gen-go from
+// the GenerateProto output and back
+func GenerateProtoConverters(schema *tlparser.Schema, packageName string, protoGoPackage string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
+
+	buf.WriteString(fmt.Sprintf(`import (
+    "github.com/zelenin/go-tdlib/client"
+    pb %q
+)
+
+func convertList[T any, P any](values []T, convert func(T) P) []P {
+    if values == nil {
+        return nil
+    }
+
+    result := make([]P, len(values))
+    for i, value := range values {
+        result[i] = convert(value)
+    }
+
+    return result
+}
+
+func jsonInt64PointerToProto(value *client.JsonInt64) *int64 {
+    if value == nil {
+        return nil
+    }
+
+    result := int64(*value)
+
+    return &result
+}
+
+func jsonInt64PointerFromProto(value *int64) *client.JsonInt64 {
+    if value == nil {
+        return nil
+    }
+
+    result := client.JsonInt64(*value)
+
+    return &result
+}
+`, protoGoPackage))
+
+	protoSchema := newProtoSchema(schema)
+
+	for _, constructor := range protoSchema.constructors() {
+		clientType := firstUpper(constructor.Name)
+		protoType := goCamelCase(firstUpper(constructor.Name))
+
+		toProto := bytes.NewBufferString("")
+		fromProto := bytes.NewBufferString("")
+		for _, arg := range constructor.Args {
+			clientField := TdlibTypeArg(arg.Name, arg.Type, schema).ToGoName()
+			protoField := protoGoFieldName(arg.Name)
+			fieldType := protoSchema.ToProtoType(arg.Type)
+
+			toExpr := protoSchema.toProto(arg.Type, "value."+clientField)
+			fromExpr := protoSchema.fromProto(arg.Type, "value."+protoField)
+			if arg.Nullable && isProtoScalar(fieldType) && fieldType != "bytes" {
+				toExpr = "value." + clientField
+				fromExpr = "value." + protoField
+				if arg.Type == "int64" {
+					toExpr = fmt.Sprintf("jsonInt64PointerToProto(%s)", toExpr)
+					fromExpr = fmt.Sprintf("jsonInt64PointerFromProto(%s)", fromExpr)
+				}
+			}
+
+			toProto.WriteString(fmt.Sprintf("%s: %s,\n", protoField, toExpr))
+			fromProto.WriteString(fmt.Sprintf("%s: %s,\n", clientField, fromExpr))
+		}
+
+		buf.WriteString(fmt.Sprintf(`
+// %sToProto converts client.%s to pb.%s
+func %sToProto(value *client.%s) *pb.%s {
+    if value == nil {
+        return nil
+    }
+
+    return &pb.%s{
+%s    }
+}
+
+// %sFromProto converts pb.%s to client.%s
+func %sFromProto(value *pb.%s) *client.%s {
+    if value == nil {
+        return nil
+    }
+
+    return &client.%s{
+%s    }
+}
+`, clientType, clientType, protoType, clientType, clientType, protoType, protoType, toProto.String(), clientType, protoType, clientType, clientType, protoType, clientType, clientType, fromProto.String()))
+	}
+
+	for _, class := range schema.Types {
+		protoType := goCamelCase(class.Name)
+
+		toProto := bytes.NewBufferString("")
+		fromProto := bytes.NewBufferString("")
+		for _, constructor := range protoSchema.classConstructors(class.Name) {
+			wrapper := protoType + "_" + goCamelCase(camelCaseToUnderscore(constructor.Name))
+			field := goCamelCase(camelCaseToUnderscore(constructor.Name))
+
+			toProto.WriteString(fmt.Sprintf(`    case *client.%s:
+        return &pb.%s{Value: &pb.%s{%s: %sToProto(value)}}
+`, firstUpper(constructor.Name), protoType, wrapper, field, firstUpper(constructor.Name)))
+			fromProto.WriteString(fmt.Sprintf(`    case *pb.%s:
+        return %sFromProto(value.%s)
+`, wrapper, firstUpper(constructor.Name), field))
+		}
+
+		buf.WriteString(fmt.Sprintf(`
+// %sToProto converts client.%s to pb.%s. Unknown constructors are converted to nil
+func %sToProto(value client.%s) *pb.%s {
+    switch value := value.(type) {
+%s    }
+
+    return nil
+}
+
+// %sFromProto converts pb.%s to client.%s
+func %sFromProto(value *pb.%s) client.%s {
+    switch value := value.GetValue().(type) {
+%s    }
+
+    return nil
+}
+`, class.Name, class.Name, protoType, class.Name, class.Name, protoType, toProto.String(), class.Name, protoType, class.Name, class.Name, protoType, class.Name, fromProto.String()))
+	}
+
+	return buf.Bytes()
+}
@@ -7,19 +7,44 @@ import (
 	"strings"
 )
 
-func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
+// GenerateFunctions returns the functions of the part of the schema or nil if the part has no functions
+func GenerateFunctions(schema *tlparser.Schema, part *Part, packageName string) []byte {
+	if len(part.Functions) == 0 && !part.Main {
+		return nil
+	}
+
 	buf := bytes.NewBufferString("")
 
-	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
+	buf.WriteString(fmt.Sprintf("%s\npackage %s\n", header, packageName))
 
-	buf.WriteString(`import (
+	for _, function := range part.Functions {
+		if !function.IsSynchronous {
+			buf.WriteString(`
+import (
     "context"
-    "errors"
-)`)
+)
+`)
+			break
+		}
+	}
 
-	buf.WriteString("\n")
+	if part.Main {
+		buf.WriteString("\n// functionTypes are the bot or user restrictions of functions. Common functions are omitted\n")
+		buf.WriteString("var functionTypes = map[string]FunctionType{\n")
+		for _, function := range schema.Functions {
+			switch function.Type {
+			case tlparser.FUNCTION_TYPE_USER:
+				buf.WriteString(fmt.Sprintf("    %q: FunctionTypeUser,\n", function.Name))
+			case tlparser.FUNCTION_TYPE_BOT:
+				buf.WriteString(fmt.Sprintf("    %q: FunctionTypeBot,\n", function.Name))
+			}
+		}
+		buf.WriteString("}\n")
+	}
+
+	validated := validatedConstructors(schema)
 
-	for _, function := range schema.Functions {
+	for _, function := range part.Functions {
 		tdlibFunction := TdlibFunction(function.Name, schema)
 		tdlibFunctionReturn := TdlibFunctionReturn(function.ResultType, schema)
 
@@ -30,7 +55,7 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 			tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
 
 			buf.WriteString(fmt.Sprintf("    // %s\n", arg.Description))
-			buf.WriteString(fmt.Sprintf("    %s %s `json:\"%s\"`\n", tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoType(), arg.Name))
+			buf.WriteString(fmt.Sprintf("    %s %s %s\n", tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoFieldType(arg.Nullable), tdlibTypeArg.ToJsonTag(arg.Nullable)))
 		}
 		buf.WriteString("}\n\n")
 
@@ -39,6 +64,17 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 }
 `, tdlibFunction.ToGoName(), function.Name))
 
+		buf.WriteString(fmt.Sprintf(`
+func (req *%sRequest) MarshalJSON() ([]byte, error) {
+    return marshalJSON(req)
+}
+
+`, tdlibFunction.ToGoName()))
+		buf.WriteString(generateEncodeJSON("req", tdlibFunction.ToGoName()+"Request", function.Name, "encoder.writeExtra(req.MetaExtra)", function.Args, schema))
+
+		buf.WriteString("\n")
+		buf.WriteString(generateValidate(fmt.Sprintf("req %sRequest", tdlibFunction.ToGoName()), "req", function.Args, validated, schema))
+
 		if function.IsSynchronous {
 			buf.WriteString("\n")
 			buf.WriteString("// " + function.Description)
@@ -66,26 +102,8 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 
 `)
 
-			if tdlibFunctionReturn.IsType() {
-				buf.WriteString("    switch result.MetaType {\n")
-
-				for _, constructor := range tdlibFunctionReturn.GetType().GetConstructors() {
-					buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(result.Data)
-
-`, constructor.ToConstructorConst(), constructor.ToGoType()))
-
-				}
-
-				buf.WriteString(`    default:
-        return nil, errors.New("invalid type")
-`)
-
-				buf.WriteString("   }\n")
-			} else {
-				buf.WriteString(fmt.Sprintf(`    return Unmarshal%s(result.Data)
-`, tdlibFunctionReturn.ToGoType()))
-			}
+			buf.WriteString(fmt.Sprintf(`    return unmarshalResult(result, %s)
+`, tdlibFunctionReturn.ToUnmarshaler()))
 
 			buf.WriteString("}\n")
 		}
@@ -97,6 +115,12 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 		}
 		buf.WriteString("// " + function.Description)
 		buf.WriteString("\n")
+		switch function.Type {
+		case tlparser.FUNCTION_TYPE_USER:
+			buf.WriteString("//\n// Available to users only\n")
+		case tlparser.FUNCTION_TYPE_BOT:
+			buf.WriteString("//\n// Available to bots only\n")
+		}
 
 		funcArguments := []string{}
 		if !function.IsSynchronous {
@@ -131,26 +155,8 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 
 `)
 
-			if tdlibFunctionReturn.IsType() {
-				buf.WriteString("    switch result.MetaType {\n")
-
-				for _, constructor := range tdlibFunctionReturn.GetType().GetConstructors() {
-					buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(result.Data)
-
-`, constructor.ToConstructorConst(), constructor.ToGoType()))
-
-				}
-
-				buf.WriteString(`    default:
-        return nil, errors.New("invalid type")
-`)
-
-				buf.WriteString("   }\n")
-			} else {
-				buf.WriteString(fmt.Sprintf(`    return Unmarshal%s(result.Data)
-`, tdlibFunctionReturn.ToGoType()))
-			}
+			buf.WriteString(fmt.Sprintf(`    return unmarshalResult(result, %s)
+`, tdlibFunctionReturn.ToUnmarshaler()))
 		}
 
 		buf.WriteString("}\n")
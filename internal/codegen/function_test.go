@@ -0,0 +1,65 @@
+package codegen
+
+import (
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+const vectorSchema = `int32 = Int32;
+string = String;
+vector {t:Type} # [ t ] = Vector t;
+
+//@description A text @text Text
+text text:string = Text;
+
+//@class Color @description Color
+
+//@description Red color
+colorRed = Color;
+
+---functions---
+
+//@description Returns numbers
+getNumbers = vector<int32>;
+
+//@description Returns texts
+getTexts = vector<text>;
+
+//@description Returns colors
+getColors = vector<Color>;
+
+//@description Returns a palette
+getPalette = vector<vector<Color>>;
+`
+
+func TestGenerateFunctionsVectorResults(t *testing.T) {
+	schema, err := tlparser.Parse(strings.NewReader(vectorSchema))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	source := string(GenerateFunctions(schema, WholeSchema(schema), "client"))
+
+	_, err = parser.ParseFile(token.NewFileSet(), "function_generated.go", source, 0)
+	if err != nil {
+		t.Fatalf("generated code is invalid: %s\n%s", err, source)
+	}
+
+	for _, want := range []string{
+		"GetNumbers(ctx context.Context) ([]int32, error)",
+		"return unmarshalResult(result, unmarshalListOf(unmarshalValue[int32]))",
+		"GetTexts(ctx context.Context) ([]*Text, error)",
+		"return unmarshalResult(result, unmarshalListOf(UnmarshalText))",
+		"GetColors(ctx context.Context) ([]Color, error)",
+		"return unmarshalResult(result, unmarshalListOf(UnmarshalColor))",
+		"GetPalette(ctx context.Context) ([][]Color, error)",
+		"return unmarshalResult(result, unmarshalListOf(unmarshalListOf(UnmarshalColor)))",
+	} {
+		if !strings.Contains(source, want) {
+			t.Errorf("generated code doesn't contain %q", want)
+		}
+	}
+}
@@ -1,3 +1,12 @@
 package codegen
 
+import (
+	"bytes"
+)
+
 const header = "// AUTOGENERATED"
+
+// IsGenerated reports whether the content of a file starts with the header of the generated files
+func IsGenerated(content []byte) bool {
+	return bytes.HasPrefix(content, []byte(header+"\n"))
+}
@@ -2,7 +2,6 @@ package codegen
 
 import (
 	"github.com/zelenin/go-tdlib/internal/tlparser"
-	"log"
 	"strings"
 )
 
@@ -58,9 +57,13 @@ func (entity *tdlibFunctionReturn) GetType() *tdlibType {
 	}, entity.schema)
 }
 
+func (entity *tdlibFunctionReturn) IsList() bool {
+	return strings.HasPrefix(entity.name, "vector<")
+}
+
 func (entity *tdlibFunctionReturn) ToGoReturn() string {
-	if strings.HasPrefix(entity.name, "vector<") {
-		log.Fatal("vectors are not supported")
+	if entity.IsList() {
+		return TdlibTypeArg("", entity.name, entity.schema).ToGoType()
 	}
 
 	if entity.IsType() {
@@ -75,8 +78,8 @@ func (entity *tdlibFunctionReturn) ToGoReturn() string {
 }
 
 func (entity *tdlibFunctionReturn) ToGoType() string {
-	if strings.HasPrefix(entity.name, "vector<") {
-		log.Fatal("vectors are not supported")
+	if entity.IsList() {
+		return TdlibTypeArg("", entity.name, entity.schema).ToGoType()
 	}
 
 	if entity.IsType() {
@@ -86,6 +89,15 @@ func (entity *tdlibFunctionReturn) ToGoType() string {
 	return entity.GetConstructor().ToGoType()
 }
 
+// ToUnmarshaler returns the expression of a function which unmarshals the result
+func (entity *tdlibFunctionReturn) ToUnmarshaler() string {
+	if entity.IsList() {
+		return TdlibTypeArg("", entity.name, entity.schema).ToUnmarshaler()
+	}
+
+	return "Unmarshal" + entity.ToGoType()
+}
+
 type tdlibConstructor struct {
 	name   string
 	schema *tlparser.Schema
@@ -143,7 +155,7 @@ func (entity *tdlibConstructor) GetConstructor() *tlparser.Constructor {
 
 func (entity *tdlibConstructor) ToGoType() string {
 	if strings.HasPrefix(entity.name, "vector<") {
-		log.Fatal("vectors are not supported")
+		return TdlibTypeArg("", entity.name, entity.schema).ToGoType()
 	}
 
 	switch entity.name {
@@ -292,17 +304,22 @@ func (entity *tdlibTypeArg) GetPrimitive() string {
 }
 
 func (entity *tdlibTypeArg) IsConstructor() bool {
-	primitive := entity.GetPrimitive()
-	return isConstructor(primitive, func(entity *tlparser.Constructor) string {
-		return entity.Name
-	}, entity.schema)
+	return entity.GetConstructor() != nil
 }
 
+// GetConstructor returns the constructor of the primitive type. Function results refer to the type of a constructor, e.g. vector<Message>
 func (entity *tdlibTypeArg) GetConstructor() *tdlibConstructor {
 	primitive := entity.GetPrimitive()
-	return getConstructor(primitive, func(entity *tlparser.Constructor) string {
+	constructor := getConstructor(primitive, func(entity *tlparser.Constructor) string {
 		return entity.Name
 	}, entity.schema)
+	if constructor != nil {
+		return constructor
+	}
+
+	return getConstructor(primitive, func(entity *tlparser.Constructor) string {
+		return entity.ResultType
+	}, entity.schema)
 }
 
 func (entity *tdlibTypeArg) IsType() bool {
@@ -352,6 +369,46 @@ func (entity *tdlibTypeArg) ToGoType() string {
 	return goType + "*" + entity.GetConstructor().ToGoType()
 }
 
+// ToUnmarshaler returns the expression of a function which unmarshals a value of the type
+func (entity *tdlibTypeArg) ToUnmarshaler() string {
+	unmarshaler := ""
+	switch {
+	case entity.IsType():
+		unmarshaler = "Unmarshal" + entity.GetType().ToGoType()
+
+	case entity.GetConstructor().IsInternal():
+		unmarshaler = "unmarshalValue[" + entity.GetConstructor().ToGoType() + "]"
+
+	default:
+		unmarshaler = "Unmarshal" + entity.GetConstructor().ToGoType()
+	}
+
+	for range strings.Count(entity.argType, "vector<") {
+		unmarshaler = "unmarshalListOf(" + unmarshaler + ")"
+	}
+
+	return unmarshaler
+}
+
+// ToGoFieldType returns the type of a struct field. Nullable scalars are pointers, so that null differs from the zero value
+func (entity *tdlibTypeArg) ToGoFieldType(nullable bool) string {
+	goType := entity.ToGoType()
+	if nullable && !entity.IsList() && !entity.IsType() && entity.GetConstructor().IsInternal() && goType != "[]byte" {
+		return "*" + goType
+	}
+
+	return goType
+}
+
+// ToJsonTag returns the json struct tag of a field. Nullable fields are omitted instead of being sent as zero values
+func (entity *tdlibTypeArg) ToJsonTag(nullable bool) string {
+	if nullable {
+		return "`json:\"" + entity.name + ",omitempty\"`"
+	}
+
+	return "`json:\"" + entity.name + "\"`"
+}
+
 func isConstructor(name string, field func(entity *tlparser.Constructor) string, schema *tlparser.Schema) bool {
 	name = normalizeEntityName(name)
 	for _, entity := range schema.Constructors {
@@ -0,0 +1,84 @@
+package codegen
+
+import (
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"strings"
+	"testing"
+)
+
+const testSchema = `int32 = Int32;
+string = String;
+vector {t:Type} # [ t ] = Vector t;
+
+//@description A text @text Text
+text text:string = Text;
+
+---functions---
+
+//@description Sends a message @chat_id Chat identifier @limit Limit; may be null @title Title; pass null to keep the current title @content Message content; pass null if none
+sendText chat_id:int32 limit:int32 title:string content:text = Text;
+`
+
+func TestNullableFields(t *testing.T) {
+	schema, err := tlparser.Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	tests := []struct {
+		arg       string
+		fieldType string
+		tag       string
+	}{
+		{"chat_id", "int32", "`json:\"chat_id\"`"},
+		{"limit", "*int32", "`json:\"limit,omitempty\"`"},
+		{"title", "*string", "`json:\"title,omitempty\"`"},
+		{"content", "*Text", "`json:\"content,omitempty\"`"},
+	}
+
+	args := schema.Functions[0].Args
+	for i, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			arg := args[i]
+			tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
+
+			if fieldType := tdlibTypeArg.ToGoFieldType(arg.Nullable); fieldType != tt.fieldType {
+				t.Errorf("field type = %s, want %s", fieldType, tt.fieldType)
+			}
+			if tag := tdlibTypeArg.ToJsonTag(arg.Nullable); tag != tt.tag {
+				t.Errorf("tag = %s, want %s", tag, tt.tag)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	schema, err := tlparser.Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	err = Check(schema)
+	if err != nil {
+		t.Errorf("check error: %s", err)
+	}
+
+	schema, err = tlparser.Parse(strings.NewReader(testSchema + "getTexts ids:vector<int64> = vector<Texts>;\n"))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	err = Check(schema)
+	if err == nil {
+		t.Fatal("check error is nil")
+	}
+
+	for _, want := range []string{
+		"function getTexts: field ids: unknown type vector<int64>",
+		"function getTexts: unknown result type vector<Texts>",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("check error %q does not contain %q", err, want)
+		}
+	}
+}
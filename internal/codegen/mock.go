@@ -0,0 +1,113 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+)
+
+const interfaceName = "TDLib"
+
+// asyncFunctions returns the functions which are called via Client.Send
+func asyncFunctions(schema *tlparser.Schema) []*tlparser.Function {
+	functions := []*tlparser.Function{}
+	for _, function := range schema.Functions {
+		if !function.IsSynchronous {
+			functions = append(functions, function)
+		}
+	}
+
+	return functions
+}
+
+func methodArguments(function *tlparser.Function, schema *tlparser.Schema) (string, string) {
+	if len(function.Args) == 0 {
+		return "ctx context.Context", "ctx"
+	}
+
+	return fmt.Sprintf("ctx context.Context, req *%sRequest", TdlibFunction(function.Name, schema).ToGoName()), "ctx, req"
+}
+
+func GenerateInterface(schema *tlparser.Schema, packageName string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
+
+	buf.WriteString(`import (
+    "context"
+)
+
+`)
+
+	buf.WriteString(fmt.Sprintf("// %s is the set of asynchronous TDLib methods. It is implemented by Client and Mock\n", interfaceName))
+	buf.WriteString(fmt.Sprintf("type %s interface {\n", interfaceName))
+	for _, function := range asyncFunctions(schema) {
+		tdlibFunction := TdlibFunction(function.Name, schema)
+		tdlibFunctionReturn := TdlibFunctionReturn(function.ResultType, schema)
+		arguments, _ := methodArguments(function, schema)
+
+		buf.WriteString(fmt.Sprintf("    // %s\n", function.Description))
+		buf.WriteString(fmt.Sprintf("    %s(%s) (%s, error)\n", tdlibFunction.ToGoName(), arguments, tdlibFunctionReturn.ToGoReturn()))
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(fmt.Sprintf("var _ %s = (*Client)(nil)\n", interfaceName))
+
+	return buf.Bytes()
+}
+
+func GenerateMock(schema *tlparser.Schema, packageName string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
+
+	buf.WriteString(`import (
+    "context"
+)
+
+`)
+
+	functions := asyncFunctions(schema)
+
+	buf.WriteString(fmt.Sprintf(`// Mock is a programmable %s implementation for tests.
+// A method calls the function in the field with the Func suffix, e.g. GetMeFunc for GetMe, and records the call.
+// Methods without a function return ErrNotMocked.
+type Mock struct {
+    mockRecorder
+`, interfaceName))
+	for _, function := range functions {
+		tdlibFunction := TdlibFunction(function.Name, schema)
+		tdlibFunctionReturn := TdlibFunctionReturn(function.ResultType, schema)
+		arguments, _ := methodArguments(function, schema)
+
+		buf.WriteString(fmt.Sprintf("    %sFunc func(%s) (%s, error)\n", tdlibFunction.ToGoName(), arguments, tdlibFunctionReturn.ToGoReturn()))
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(fmt.Sprintf("var _ %s = (*Mock)(nil)\n", interfaceName))
+
+	for _, function := range functions {
+		tdlibFunction := TdlibFunction(function.Name, schema)
+		tdlibFunctionReturn := TdlibFunctionReturn(function.ResultType, schema)
+		arguments, callArguments := methodArguments(function, schema)
+
+		request := "req"
+		if len(function.Args) == 0 {
+			request = fmt.Sprintf("&%sRequest{}", tdlibFunction.ToGoName())
+		}
+
+		buf.WriteString("\n")
+		buf.WriteString(fmt.Sprintf(`func (mock *Mock) %s(%s) (%s, error) {
+    mock.record(%s)
+
+    if mock.%sFunc == nil {
+        return nil, mockNotImplemented(%q)
+    }
+
+    return mock.%sFunc(%s)
+}
+`, tdlibFunction.ToGoName(), arguments, tdlibFunctionReturn.ToGoReturn(), request, tdlibFunction.ToGoName(), function.Name, tdlibFunction.ToGoName(), callArguments))
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,373 @@
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ProtoLock records the field numbers of the protobuf messages, so that the numbers are stable across schema versions.
+// A field keeps its number while its name and type are the same. Numbers of removed fields are never reused
+type ProtoLock struct {
+	Messages map[string][]*ProtoLockField `json:"messages"`
+}
+
+type ProtoLockField struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Number int    `json:"number"`
+}
+
+func NewProtoLock() *ProtoLock {
+	return &ProtoLock{
+		Messages: map[string][]*ProtoLockField{},
+	}
+}
+
+func ReadProtoLock(reader io.Reader) (*ProtoLock, error) {
+	lock := NewProtoLock()
+
+	err := json.NewDecoder(reader).Decode(lock)
+	if err != nil {
+		return nil, err
+	}
+
+	if lock.Messages == nil {
+		lock.Messages = map[string][]*ProtoLockField{}
+	}
+
+	return lock, nil
+}
+
+func (lock *ProtoLock) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(lock, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// number returns the locked number of the field or assigns the next free number of the message
+func (lock *ProtoLock) number(message string, name string, fieldType string) int {
+	maxNumber := 0
+	for _, field := range lock.Messages[message] {
+		if field.Name == name && field.Type == fieldType {
+			return field.Number
+		}
+		maxNumber = max(maxNumber, field.Number)
+	}
+
+	lock.Messages[message] = append(lock.Messages[message], &ProtoLockField{
+		Name:   name,
+		Type:   fieldType,
+		Number: maxNumber + 1,
+	})
+
+	return maxNumber + 1
+}
+
+// reserved returns the numbers and the names of the locked fields which are not used by the message
+func (lock *ProtoLock) reserved(message string, fields []*protoField) ([]int, []string) {
+	usedNumbers := map[int]bool{}
+	usedNames := map[string]bool{}
+	for _, field := range fields {
+		usedNumbers[field.number] = true
+		usedNames[field.name] = true
+	}
+
+	var numbers []int
+	var names []string
+	for _, field := range lock.Messages[message] {
+		if !usedNumbers[field.Number] {
+			numbers = append(numbers, field.Number)
+		}
+		if !usedNames[field.Name] && !contains(names, field.Name) {
+			names = append(names, field.Name)
+		}
+	}
+
+	sort.Ints(numbers)
+	sort.Strings(names)
+
+	return numbers, names
+}
+
+type protoField struct {
+	name        string
+	description string
+	fieldType   string
+	optional    bool
+	number      int
+}
+
+// protoSchema maps the TL schema to protobuf: a message per constructor, a message with a oneof per class
+// and a wrapper message per nested vector
+type protoSchema struct {
+	schema   *tlparser.Schema
+	classes  map[string]bool
+	wrappers map[string]string
+}
+
+func newProtoSchema(schema *tlparser.Schema) *protoSchema {
+	classes := map[string]bool{}
+	for _, class := range schema.Types {
+		classes[class.Name] = true
+	}
+
+	return &protoSchema{
+		schema:   schema,
+		classes:  classes,
+		wrappers: map[string]string{},
+	}
+}
+
+func (entity *protoSchema) constructors() []*tlparser.Constructor {
+	var constructors []*tlparser.Constructor
+	for _, constructor := range entity.schema.Constructors {
+		if !TdlibConstructor(constructor.Name, entity.schema).IsInternal() {
+			constructors = append(constructors, constructor)
+		}
+	}
+
+	return constructors
+}
+
+func (entity *protoSchema) classConstructors(class string) []*tlparser.Constructor {
+	var constructors []*tlparser.Constructor
+	for _, constructor := range entity.schema.Constructors {
+		if constructor.ResultType == class {
+			constructors = append(constructors, constructor)
+		}
+	}
+
+	return constructors
+}
+
+// ToProtoType returns the protobuf type of a field of the TL type
+func (entity *protoSchema) ToProtoType(argType string) string {
+	if isVector(argType) {
+		return "repeated " + entity.toProtoElementType(vectorElement(argType))
+	}
+
+	return entity.toProtoElementType(argType)
+}
+
+func (entity *protoSchema) toProtoElementType(argType string) string {
+	if isVector(argType) {
+		values := entity.ToProtoType(argType)
+		name := firstUpper(strings.TrimPrefix(values, "repeated ")) + "Vector"
+		entity.wrappers[name] = values
+
+		return name
+	}
+
+	switch argType {
+	case "double":
+		return "double"
+
+	case "string":
+		return "string"
+
+	case "int32":
+		return "int32"
+
+	case "int53", "int64":
+		return "int64"
+
+	case "bytes":
+		return "bytes"
+
+	case "Bool":
+		return "bool"
+	}
+
+	return firstUpper(argType)
+}
+
+func (entity *protoSchema) fields(message string, args []*tlparser.Arg, lock *ProtoLock) []*protoField {
+	var fields []*protoField
+	for _, arg := range args {
+		fieldType := entity.ToProtoType(arg.Type)
+
+		fields = append(fields, &protoField{
+			name:        arg.Name,
+			description: arg.Description,
+			fieldType:   fieldType,
+			optional:    arg.Nullable && isProtoScalar(fieldType) && fieldType != "bytes",
+			number:      lock.number(message, arg.Name, fieldType),
+		})
+	}
+
+	return fields
+}
+
+// GenerateProto returns the proto3 definitions of the schema. New fields are added to the lock
+func GenerateProto(schema *tlparser.Schema, lock *ProtoLock, protoPackage string, goPackage string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\nsyntax = \"proto3\";\n\npackage %s;\n", header, protoPackage))
+	if goPackage != "" {
+		buf.WriteString(fmt.Sprintf("\noption go_package = %q;\n", goPackage))
+	}
+
+	protoSchema := newProtoSchema(schema)
+
+	for _, constructor := range protoSchema.constructors() {
+		message := firstUpper(constructor.Name)
+		fields := protoSchema.fields(message, constructor.Args, lock)
+
+		buf.WriteString("\n")
+		writeProtoComment(buf, "", constructor.Description)
+		buf.WriteString(fmt.Sprintf("message %s {\n", message))
+		for _, field := range fields {
+			writeProtoComment(buf, "    ", field.description)
+
+			label := ""
+			if field.optional {
+				label = "optional "
+			}
+			buf.WriteString(fmt.Sprintf("    %s%s %s = %d;\n", label, field.fieldType, field.name, field.number))
+		}
+		writeProtoReserved(buf, lock, message, fields)
+		buf.WriteString("}\n")
+	}
+
+	for _, class := range schema.Types {
+		var fields []*protoField
+		for _, constructor := range protoSchema.classConstructors(class.Name) {
+			fieldType := firstUpper(constructor.Name)
+			name := camelCaseToUnderscore(constructor.Name)
+
+			fields = append(fields, &protoField{
+				name:      name,
+				fieldType: fieldType,
+				number:    lock.number(class.Name, name, fieldType),
+			})
+		}
+
+		buf.WriteString("\n")
+		writeProtoComment(buf, "", class.Description)
+		buf.WriteString(fmt.Sprintf("message %s {\n    oneof value {\n", class.Name))
+		for _, field := range fields {
+			buf.WriteString(fmt.Sprintf("        %s %s = %d;\n", field.fieldType, field.name, field.number))
+		}
+		buf.WriteString("    }\n")
+		writeProtoReserved(buf, lock, class.Name, fields)
+		buf.WriteString("}\n")
+	}
+
+	var wrappers []string
+	for name := range protoSchema.wrappers {
+		wrappers = append(wrappers, name)
+	}
+	sort.Strings(wrappers)
+
+	for _, name := range wrappers {
+		buf.WriteString(fmt.Sprintf("\nmessage %s {\n    %s values = 1;\n}\n", name, protoSchema.wrappers[name]))
+	}
+
+	return buf.Bytes()
+}
+
+func writeProtoComment(buf *bytes.Buffer, indent string, text string) {
+	if text != "" {
+		buf.WriteString(fmt.Sprintf("%s// %s\n", indent, text))
+	}
+}
+
+func writeProtoReserved(buf *bytes.Buffer, lock *ProtoLock, message string, fields []*protoField) {
+	numbers, names := lock.reserved(message, fields)
+
+	if len(numbers) > 0 {
+		reserved := make([]string, len(numbers))
+		for i, number := range numbers {
+			reserved[i] = fmt.Sprint(number)
+		}
+		buf.WriteString(fmt.Sprintf("    reserved %s;\n", strings.Join(reserved, ", ")))
+	}
+
+	if len(names) > 0 {
+		reserved := make([]string, len(names))
+		for i, name := range names {
+			reserved[i] = fmt.Sprintf("%q", name)
+		}
+		buf.WriteString(fmt.Sprintf("    reserved %s;\n", strings.Join(reserved, ", ")))
+	}
+}
+
+func isVector(argType string) bool {
+	return strings.HasPrefix(argType, "vector<")
+}
+
+func vectorElement(argType string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(argType, "vector<"), ">")
+}
+
+func isProtoScalar(fieldType string) bool {
+	switch fieldType {
+	case "double", "string", "int32", "int64", "bytes", "bool":
+		return true
+	}
+
+	return false
+}
+
+func camelCaseToUnderscore(s string) string {
+	var result []rune
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				result = append(result, '_')
+			}
+			r = unicode.ToLower(r)
+		}
+		result = append(result, r)
+	}
+
+	return string(result)
+}
+
+// goCamelCase returns the Go name of a protobuf identifier, as protoc-gen-go does
+func goCamelCase(s string) string {
+	var result []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			result = append(result, 'X')
+		case c == '_' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case c >= '0' && c <= '9':
+			result = append(result, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			result = append(result, c)
+			for ; i+1 < len(s) && isASCIILower(s[i+1]); i++ {
+				result = append(result, s[i+1])
+			}
+		}
+	}
+
+	return string(result)
+}
+
+func isASCIILower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
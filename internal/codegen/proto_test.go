@@ -0,0 +1,335 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const protoTestSchema = `int32 = Int32;
+int64 = Int64;
+string = String;
+boolFalse = Bool;
+boolTrue = Bool;
+vector {t:Type} # [ t ] = Vector t;
+
+//@class Animal @description Describes an animal
+
+//@description A cat @id Identifier @name Name @rating Rating; may be null @rows Rows of friends
+cat id:int64 name:string rating:int32 rows:vector<vector<Animal>> = Animal;
+
+//@description A dog @is_good True, if the dog is good
+dog is_good:Bool = Animal;
+`
+
+const protoTestSchemaUpdated = `int32 = Int32;
+int64 = Int64;
+string = String;
+boolFalse = Bool;
+boolTrue = Bool;
+vector {t:Type} # [ t ] = Vector t;
+
+//@class Animal @description Describes an animal
+
+//@description A cat @id Identifier @rating Rating @age Age @rows Rows of friends
+cat id:int64 rating:string age:int32 rows:vector<vector<Animal>> = Animal;
+
+//@description A bird
+bird = Animal;
+`
+
+func parseSchema(t *testing.T, data string) *tlparser.Schema {
+	t.Helper()
+
+	schema, err := tlparser.Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	return schema
+}
+
+func TestGenerateProto(t *testing.T) {
+	lock := NewProtoLock()
+
+	proto := string(GenerateProto(parseSchema(t, protoTestSchema), lock, "tdlib", "example.com/tdlibpb"))
+
+	want := `// AUTOGENERATED
+syntax = "proto3";
+
+package tdlib;
+
+option go_package = "example.com/tdlibpb";
+
+// A cat
+message Cat {
+    // Identifier
+    int64 id = 1;
+    // Name
+    string name = 2;
+    // Rating; may be null
+    optional int32 rating = 3;
+    // Rows of friends
+    repeated AnimalVector rows = 4;
+}
+
+// A dog
+message Dog {
+    // True, if the dog is good
+    bool is_good = 1;
+}
+
+// Describes an animal
+message Animal {
+    oneof value {
+        Cat cat = 1;
+        Dog dog = 2;
+    }
+}
+
+message AnimalVector {
+    repeated Animal values = 1;
+}
+`
+	if proto != want {
+		t.Errorf("proto:\n%s\nwant:\n%s", proto, want)
+	}
+
+	proto = string(GenerateProto(parseSchema(t, protoTestSchemaUpdated), lock, "tdlib", ""))
+
+	for _, line := range []string{
+		"    int64 id = 1;\n",
+		"    string rating = 5;\n",
+		"    int32 age = 6;\n",
+		"    repeated AnimalVector rows = 4;\n",
+		"    reserved 2, 3;\n    reserved \"name\";\n}\n",
+		"        Cat cat = 1;\n        Bird bird = 3;\n    }\n    reserved 2;\n    reserved \"dog\";\n",
+	} {
+		if !strings.Contains(proto, line) {
+			t.Errorf("updated proto doesn't contain %q:\n%s", line, proto)
+		}
+	}
+
+	if strings.Contains(proto, "option go_package") {
+		t.Errorf("unexpected go_package option")
+	}
+}
+
+func TestProtoLockRoundTrip(t *testing.T) {
+	lock := NewProtoLock()
+	GenerateProto(parseSchema(t, protoTestSchema), lock, "tdlib", "")
+
+	data, err := lock.Marshal()
+	if err != nil {
+		t.Fatalf("marshal error: %s", err)
+	}
+
+	restored, err := ReadProtoLock(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	schema := parseSchema(t, protoTestSchema)
+	if string(GenerateProto(schema, restored, "tdlib", "")) != string(GenerateProto(schema, lock, "tdlib", "")) {
+		t.Errorf("restored lock produces different output")
+	}
+}
+
+func TestGenerateProtoConverters(t *testing.T) {
+	source, err := format.Source(GenerateProtoConverters(parseSchema(t, protoTestSchema), "tdlibproto", "example.com/tdlibpb"))
+	if err != nil {
+		t.Fatalf("format error: %s", err)
+	}
+
+	for _, line := range []string{
+		"Id:     int64(value.Id),",
+		"Rating: value.Rating,",
+		"Id:     client.JsonInt64(value.Id),",
+		"return &pb.AnimalVector{Values: convertList(value, func(value client.Animal) *pb.Animal {",
+		"return convertList(value.GetValues(), func(value *pb.Animal) client.Animal {",
+		"return &pb.Animal{Value: &pb.Animal_Dog{Dog: DogToProto(value)}}",
+		"case *pb.Animal_Cat:\n\t\treturn CatFromProto(value.Cat)",
+	} {
+		if !strings.Contains(string(source), line) {
+			t.Errorf("converters don't contain %q:\n%s", line, source)
+		}
+	}
+}
+
+// converterTestSchema adds the fields of the other TL types to protoTestSchema
+const converterTestSchema = protoTestSchema + `
+int53 = Int53;
+double = Double;
+bytes = Bytes;
+
+//@description An owner @id Identifier @weight Weight @avatar Avatar @friend_ids Friends @parent_id Parent; may be null @pet Pet @names Names
+owner id:int53 weight:double avatar:bytes friend_ids:vector<int64> parent_id:int64 pet:dog names:vector<string> = Owner;
+`
+
+// protoStubSource is the package generated by protoc-gen-go from GenerateProto(converterTestSchema) without the protobuf runtime
+const protoStubSource = `package tdlibpb
+
+type Cat struct {
+	Id     int64
+	Name   string
+	Rating *int32
+	Rows   []*AnimalVector
+}
+
+type Dog struct {
+	IsGood bool
+}
+
+type Owner struct {
+	Id        int64
+	Weight    float64
+	Avatar    []byte
+	FriendIds []int64
+	ParentId  *int64
+	Pet       *Dog
+	Names     []string
+}
+
+type Animal struct {
+	Value isAnimal_Value
+}
+
+func (x *Animal) GetValue() isAnimal_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type isAnimal_Value interface {
+	isAnimal_Value()
+}
+
+type Animal_Cat struct {
+	Cat *Cat
+}
+
+type Animal_Dog struct {
+	Dog *Dog
+}
+
+func (*Animal_Cat) isAnimal_Value() {}
+
+func (*Animal_Dog) isAnimal_Value() {}
+
+type AnimalVector struct {
+	Values []*Animal
+}
+
+func (x *AnimalVector) GetValues() []*Animal {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+`
+
+// clientStubSource returns the types of the client package with the field types of the generated types
+func clientStubSource(schema *tlparser.Schema) string {
+	buf := bytes.NewBufferString("package client\n\ntype JsonInt64 int64\n")
+
+	for _, class := range schema.Types {
+		buf.WriteString(fmt.Sprintf("\ntype %s interface {\n%sConstructor() string\n}\n", class.Name, class.Name))
+	}
+
+	for _, constructor := range newProtoSchema(schema).constructors() {
+		goType := firstUpper(constructor.Name)
+
+		buf.WriteString(fmt.Sprintf("\ntype %s struct {\n", goType))
+		for _, arg := range constructor.Args {
+			tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
+			buf.WriteString(fmt.Sprintf("%s %s\n", tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoFieldType(arg.Nullable)))
+		}
+		buf.WriteString("}\n")
+
+		if TdlibTypeArg("", constructor.ResultType, schema).IsType() {
+			buf.WriteString(fmt.Sprintf("\nfunc (*%s) %sConstructor() string {\nreturn %q\n}\n", goType, constructor.ResultType, constructor.Name))
+		}
+	}
+
+	return buf.String()
+}
+
+type packageImporter map[string]*types.Package
+
+func (importer packageImporter) Import(path string) (*types.Package, error) {
+	pkg, ok := importer[path]
+	if !ok {
+		return nil, fmt.Errorf("unknown package %s", path)
+	}
+
+	return pkg, nil
+}
+
+func typeCheck(t *testing.T, path string, source string, importer packageImporter) *types.Package {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path+".go", source, 0)
+	if err != nil {
+		t.Fatalf("parse error: %s\n%s", err, source)
+	}
+
+	typesConfig := types.Config{
+		Importer: importer,
+	}
+	pkg, err := typesConfig.Check(path, fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatalf("type check error of %s: %s\n%s", path, err, source)
+	}
+
+	return pkg
+}
+
+func TestGenerateProtoConvertersTypeCheck(t *testing.T) {
+	schema := parseSchema(t, converterTestSchema)
+
+	err := Check(schema)
+	if err != nil {
+		t.Fatalf("check error: %s", err)
+	}
+
+	importer := packageImporter{}
+	importer["github.com/zelenin/go-tdlib/client"] = typeCheck(t, "github.com/zelenin/go-tdlib/client", clientStubSource(schema), importer)
+	importer["example.com/tdlibpb"] = typeCheck(t, "example.com/tdlibpb", protoStubSource, importer)
+
+	pkg := typeCheck(t, "tdlibproto", string(GenerateProtoConverters(schema, "tdlibproto", "example.com/tdlibpb")), importer)
+
+	for _, name := range []string{"CatToProto", "CatFromProto", "AnimalToProto", "AnimalFromProto", "OwnerToProto", "OwnerFromProto"} {
+		if pkg.Scope().Lookup(name) == nil {
+			t.Errorf("converters don't declare %s", name)
+		}
+	}
+}
+
+func TestGoCamelCase(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"chat_id", "ChatId"},
+		{"message_text", "MessageText"},
+		{"thumbnail_format_mpeg4", "ThumbnailFormatMpeg4"},
+		{"x2y", "X2Y"},
+		{"_internal", "XInternal"},
+		{"PageBlockTableCell", "PageBlockTableCell"},
+	}
+
+	for _, tt := range tests {
+		if got := goCamelCase(tt.name); got != tt.want {
+			t.Errorf("goCamelCase(%q) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+)
+
+func generateFieldInfos(buf *bytes.Buffer, args []*tlparser.Arg, schema *tlparser.Schema) {
+	if len(args) == 0 {
+		return
+	}
+
+	buf.WriteString("        Fields: []FieldInfo{\n")
+	for _, arg := range args {
+		tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
+
+		nullable := ""
+		if arg.Nullable {
+			nullable = ", Nullable: true"
+		}
+
+		buf.WriteString(fmt.Sprintf("            {Name: %q, GoName: %q, Type: %q, Description: %q%s},\n", arg.Name, tdlibTypeArg.ToGoName(), arg.Type, arg.Description, nullable))
+	}
+	buf.WriteString("        },\n")
+}
+
+// GenerateRegistry returns the descriptions of the constructors and the functions used by the runtime registry
+func GenerateRegistry(schema *tlparser.Schema, packageName string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
+
+	buf.WriteString("var constructorInfos = []*ConstructorInfo{\n")
+	for _, constructor := range schema.Constructors {
+		tdlibConstructor := TdlibConstructor(constructor.Name, schema)
+		if tdlibConstructor.IsInternal() {
+			continue
+		}
+
+		buf.WriteString("    {\n")
+		buf.WriteString(fmt.Sprintf("        Name: %s,\n", tdlibConstructor.ToConstructorConst()))
+		buf.WriteString(fmt.Sprintf("        Type: %s,\n", tdlibConstructor.ToTypeConst()))
+		buf.WriteString(fmt.Sprintf("        Description: %q,\n", constructor.Description))
+		generateFieldInfos(buf, constructor.Args, schema)
+		buf.WriteString(fmt.Sprintf("        new: func() Type { return &%s{} },\n", tdlibConstructor.ToGoType()))
+		buf.WriteString("    },\n")
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("var functionInfos = []*FunctionInfo{\n")
+	for _, function := range schema.Functions {
+		tdlibFunction := TdlibFunction(function.Name, schema)
+
+		buf.WriteString("    {\n")
+		buf.WriteString(fmt.Sprintf("        Name: %q,\n", function.Name))
+		buf.WriteString(fmt.Sprintf("        Description: %q,\n", function.Description))
+		buf.WriteString(fmt.Sprintf("        ResultType: %q,\n", function.ResultType))
+		if function.IsSynchronous {
+			buf.WriteString("        IsSynchronous: true,\n")
+		}
+		generateFieldInfos(buf, function.Args, schema)
+		buf.WriteString(fmt.Sprintf("        new: func() Request { return &%sRequest{} },\n", tdlibFunction.ToGoName()))
+		buf.WriteString("    },\n")
+	}
+	buf.WriteString("}\n")
+
+	return buf.Bytes()
+}
@@ -0,0 +1,126 @@
+package codegen
+
+import (
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"strings"
+	"unicode"
+)
+
+// Part is a part of the schema which is generated into its own files
+type Part struct {
+	// Name of the schema area, e.g. "messages". Empty for the whole schema
+	Name         string
+	Types        []*tlparser.Type
+	Constructors []*tlparser.Constructor
+	Functions    []*tlparser.Function
+	// Main part has the declarations which can't be split: UnmarshalType and the function types
+	Main bool
+}
+
+// WholeSchema returns the single part with the whole schema
+func WholeSchema(schema *tlparser.Schema) *Part {
+	return &Part{
+		Types:        schema.Types,
+		Constructors: schema.Constructors,
+		Functions:    schema.Functions,
+		Main:         true,
+	}
+}
+
+const commonArea = "common"
+
+// areas are the schema areas with the words of the entity names which belong to them. The first matching area wins
+var areas = []struct {
+	name  string
+	words []string
+}{
+	{"updates", []string{"update", "updates"}},
+	{"auth", []string{"authorization", "authentication", "password", "passport", "session", "sessions", "login", "qr"}},
+	{"business", []string{"business"}},
+	{"payments", []string{"payment", "payments", "invoice", "star", "stars", "premium", "giveaway", "gift", "gifts", "affiliate", "subscription", "boost", "boosts", "revenue", "paid", "transaction", "transactions"}},
+	{"stories", []string{"story", "stories"}},
+	{"stickers", []string{"sticker", "stickers", "emoji", "emojis", "reaction", "reactions"}},
+	{"calls", []string{"call", "calls"}},
+	{"messages", []string{"message", "messages", "text", "entity", "entities", "poll", "reply", "forward", "draft", "keyboard"}},
+	{"chats", []string{"chat", "chats", "supergroup", "supergroups", "group", "groups", "channel", "invite", "forum", "topic", "topics"}},
+	{"users", []string{"user", "users", "contact", "contacts", "profile", "privacy"}},
+	{"bots", []string{"bot", "bots", "inline", "callback", "web", "game"}},
+	{"files", []string{"file", "files", "photo", "photos", "video", "videos", "audio", "document", "thumbnail", "download", "downloads", "upload", "voice", "animation", "animations", "background", "backgrounds"}},
+}
+
+// areaOf returns the area of an entity by the words of its name
+func areaOf(name string) string {
+	words := map[string]bool{}
+	for _, word := range camelCaseWords(name) {
+		words[word] = true
+	}
+
+	for _, area := range areas {
+		for _, word := range area.words {
+			if words[word] {
+				return area.name
+			}
+		}
+	}
+
+	return commonArea
+}
+
+func camelCaseWords(name string) []string {
+	var words []string
+	start := 0
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, strings.ToLower(name[start:i]))
+			start = i
+		}
+	}
+
+	return append(words, strings.ToLower(name[start:]))
+}
+
+// Split splits the schema into the parts by the schema areas. Constructors belong to the area of their class.
+// The common part is the first one and it is the main part
+func Split(schema *tlparser.Schema) []*Part {
+	parts := map[string]*Part{
+		commonArea: {Name: commonArea, Main: true},
+	}
+	names := []string{commonArea}
+	for _, area := range areas {
+		parts[area.name] = &Part{Name: area.name}
+		names = append(names, area.name)
+	}
+
+	classAreas := map[string]string{}
+	for _, typ := range schema.Types {
+		area := areaOf(typ.Name)
+		classAreas[typ.Name] = area
+		parts[area].Types = append(parts[area].Types, typ)
+	}
+
+	for _, constructor := range schema.Constructors {
+		area, ok := classAreas[constructor.ResultType]
+		if !ok {
+			area = commonArea
+			if !TdlibConstructor(constructor.Name, schema).IsInternal() {
+				area = areaOf(constructor.Name)
+			}
+		}
+		parts[area].Constructors = append(parts[area].Constructors, constructor)
+	}
+
+	for _, function := range schema.Functions {
+		area := areaOf(function.Name)
+		parts[area].Functions = append(parts[area].Functions, function)
+	}
+
+	result := []*Part{}
+	for _, name := range names {
+		part := parts[name]
+		if part.Main || len(part.Types) > 0 || len(part.Constructors) > 0 || len(part.Functions) > 0 {
+			result = append(result, part)
+		}
+	}
+
+	return result
+}
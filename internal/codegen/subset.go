@@ -0,0 +1,209 @@
+package codegen
+
+import (
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"path"
+	"strings"
+)
+
+// requiredFunctions are the functions used by the client package itself
+var requiredFunctions = []string{
+	"getAuthorizationState",
+	"setTdlibParameters",
+	"setAuthenticationPhoneNumber",
+	"checkAuthenticationCode",
+	"requestQrCodeAuthentication",
+	"checkAuthenticationPassword",
+	"checkAuthenticationBotToken",
+	"close",
+	"getMe",
+	"setOption",
+	"setLogVerbosityLevel",
+	"getProxies",
+	"addProxy",
+	"enableProxy",
+	"pingProxy",
+}
+
+// requiredConstructors are the constructors used by the client package itself
+var requiredConstructors = []string{
+	"error",
+	"ok",
+	"updateAuthorizationState",
+	"updateConnectionState",
+}
+
+// updateClass is the class whose constructors are included only if they are required or match the include patterns
+const updateClass = "Update"
+
+type subset struct {
+	schema       *tlparser.Schema
+	classes      map[string]bool
+	exclude      []string
+	types        map[string]bool
+	constructors map[string]bool
+}
+
+// Subset returns the part of the schema with the functions and the constructors which match the include patterns
+// and don't match the exclude patterns, with all the types they need. An empty include list matches everything.
+// Patterns are path.Match patterns, e.g. "sendMessage" or "get*".
+// The excluded constructors of the needed classes are unmarshalled as UnknownType. Updates are included only if they match
+// the include patterns. The functions and the constructors used by the client package are always included
+func Subset(schema *tlparser.Schema, include []string, exclude []string) (*tlparser.Schema, error) {
+	if len(include) == 0 {
+		include = []string{"*"}
+	}
+
+	for _, pattern := range append(append([]string{}, include...), exclude...) {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", pattern, err)
+		}
+	}
+
+	entity := &subset{
+		schema:       schema,
+		classes:      map[string]bool{},
+		exclude:      exclude,
+		types:        map[string]bool{},
+		constructors: map[string]bool{},
+	}
+	for _, class := range schema.Types {
+		entity.classes[class.Name] = true
+	}
+
+	for _, pattern := range include {
+		if !entity.matchesAny(pattern) {
+			return nil, fmt.Errorf("include pattern %q matches nothing", pattern)
+		}
+	}
+
+	functions := map[string]bool{}
+	for _, function := range schema.Functions {
+		if (matches(include, function.Name) && !matches(exclude, function.Name)) || contains(requiredFunctions, function.Name) {
+			functions[function.Name] = true
+
+			entity.addType(function.ResultType)
+			for _, arg := range function.Args {
+				entity.addType(arg.Type)
+			}
+		}
+	}
+
+	for _, constructor := range schema.Constructors {
+		if (matches(include, constructor.Name) && !matches(exclude, constructor.Name)) || contains(requiredConstructors, constructor.Name) || TdlibConstructor(constructor.Name, schema).IsInternal() {
+			entity.addConstructor(constructor)
+		}
+	}
+
+	result := &tlparser.Schema{
+		Constructors: []*tlparser.Constructor{},
+		Types:        []*tlparser.Type{},
+		Functions:    []*tlparser.Function{},
+	}
+	for _, class := range schema.Types {
+		if entity.types[class.Name] {
+			result.Types = append(result.Types, class)
+		}
+	}
+	for _, constructor := range schema.Constructors {
+		if entity.constructors[constructor.Name] {
+			result.Constructors = append(result.Constructors, constructor)
+		}
+	}
+	for _, function := range schema.Functions {
+		if functions[function.Name] {
+			result.Functions = append(result.Functions, function)
+		}
+	}
+
+	return result, nil
+}
+
+func (entity *subset) matchesAny(pattern string) bool {
+	for _, function := range entity.schema.Functions {
+		if ok, _ := path.Match(pattern, function.Name); ok {
+			return true
+		}
+	}
+
+	for _, constructor := range entity.schema.Constructors {
+		if ok, _ := path.Match(pattern, constructor.Name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addType adds the class or the constructors of the type used by a field or a result
+func (entity *subset) addType(argType string) {
+	for isVector(argType) {
+		argType = vectorElement(argType)
+	}
+
+	if entity.classes[argType] {
+		if entity.types[argType] {
+			return
+		}
+		entity.types[argType] = true
+
+		if argType == updateClass {
+			return
+		}
+
+		for _, constructor := range entity.schema.Constructors {
+			if constructor.ResultType == argType && !matches(entity.exclude, constructor.Name) {
+				entity.addConstructor(constructor)
+			}
+		}
+
+		return
+	}
+
+	name := normalizeEntityName(argType)
+	for _, constructor := range entity.schema.Constructors {
+		if constructor.Name == name || constructor.ResultType == argType {
+			entity.addConstructor(constructor)
+		}
+	}
+}
+
+func (entity *subset) addConstructor(constructor *tlparser.Constructor) {
+	if entity.constructors[constructor.Name] {
+		return
+	}
+	entity.constructors[constructor.Name] = true
+
+	if entity.classes[constructor.ResultType] {
+		entity.addType(constructor.ResultType)
+	}
+
+	for _, arg := range constructor.Args {
+		entity.addType(arg.Type)
+	}
+}
+
+func matches(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParsePatterns splits a comma separated list of patterns
+func ParsePatterns(list string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(list, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return patterns
+}
@@ -0,0 +1,183 @@
+package codegen
+
+import (
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"reflect"
+	"testing"
+)
+
+const subsetTestSchema = `int32 = Int32;
+string = String;
+vector {t:Type} # [ t ] = Vector t;
+
+//@description An error @code Code @message Message
+error code:int32 message:string = Error;
+
+//@description Ok
+ok = Ok;
+
+//@class Content @description Content of a message
+
+//@description A text @text Text
+contentText text:formattedText = Content;
+
+//@description A poll @question Question
+contentPoll question:string = Content;
+
+//@description A formatted text @text Text
+formattedText text:string = FormattedText;
+
+//@description A message @id Identifier @content Content
+message id:int32 content:Content = Message;
+
+//@description A user @name Name
+user name:string = User;
+
+//@class Update @description An update
+
+//@description A new message @message Message
+updateNewMessage message:message = Update;
+
+//@description A new user @user User
+updateUser user:user = Update;
+
+---functions---
+
+//@description Returns a message @id Identifier
+getMessage id:int32 = Message;
+
+//@description Returns a user @name Name
+getUser name:string = User;
+`
+
+func names(schema *tlparser.Schema) ([]string, []string, []string) {
+	var types, constructors, functions []string
+	for _, typ := range schema.Types {
+		types = append(types, typ.Name)
+	}
+	for _, constructor := range schema.Constructors {
+		if !TdlibConstructor(constructor.Name, schema).IsInternal() {
+			constructors = append(constructors, constructor.Name)
+		}
+	}
+	for _, function := range schema.Functions {
+		functions = append(functions, function.Name)
+	}
+
+	return types, constructors, functions
+}
+
+func TestSubset(t *testing.T) {
+	tests := []struct {
+		name         string
+		include      []string
+		exclude      []string
+		types        []string
+		constructors []string
+		functions    []string
+	}{
+		{
+			name:         "function closure",
+			include:      []string{"getMessage"},
+			types:        []string{"Content"},
+			constructors: []string{"error", "ok", "contentText", "contentPoll", "formattedText", "message"},
+			functions:    []string{"getMessage"},
+		},
+		{
+			name:         "excluded class constructor",
+			include:      []string{"getMessage"},
+			exclude:      []string{"contentPoll"},
+			types:        []string{"Content"},
+			constructors: []string{"error", "ok", "contentText", "formattedText", "message"},
+			functions:    []string{"getMessage"},
+		},
+		{
+			name:         "update",
+			include:      []string{"updateUser"},
+			types:        []string{"Update"},
+			constructors: []string{"error", "ok", "user", "updateUser"},
+		},
+		{
+			name:         "pattern",
+			include:      []string{"get*"},
+			exclude:      []string{"getMessage"},
+			constructors: []string{"error", "ok", "user"},
+			functions:    []string{"getUser"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subset, err := Subset(parseSchema(t, subsetTestSchema), tt.include, tt.exclude)
+			if err != nil {
+				t.Fatalf("subset error: %s", err)
+			}
+
+			types, constructors, functions := names(subset)
+			if !reflect.DeepEqual(types, tt.types) {
+				t.Errorf("types = %v, want %v", types, tt.types)
+			}
+			if !reflect.DeepEqual(constructors, tt.constructors) {
+				t.Errorf("constructors = %v, want %v", constructors, tt.constructors)
+			}
+			if !reflect.DeepEqual(functions, tt.functions) {
+				t.Errorf("functions = %v, want %v", functions, tt.functions)
+			}
+		})
+	}
+
+	_, err := Subset(parseSchema(t, subsetTestSchema), []string{"getMesage"}, nil)
+	if err == nil {
+		t.Errorf("expected an error for a pattern without matches")
+	}
+}
+
+func TestAreaOf(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"sendMessage", "messages"},
+		{"getChatHistory", "chats"},
+		{"chatPhoto", "chats"},
+		{"downloadFile", "files"},
+		{"updateNewMessage", "updates"},
+		{"getStarTransactions", "payments"},
+		{"startGroupCallScreenSharing", "calls"},
+		{"setTdlibParameters", "common"},
+	}
+
+	for _, tt := range tests {
+		if got := areaOf(tt.name); got != tt.want {
+			t.Errorf("areaOf(%q) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	schema := parseSchema(t, subsetTestSchema)
+
+	parts := map[string]*Part{}
+	count := 0
+	for _, part := range Split(schema) {
+		parts[part.Name] = part
+		count += len(part.Constructors)
+	}
+
+	if count != len(schema.Constructors) {
+		t.Errorf("split has %d constructors, want %d", count, len(schema.Constructors))
+	}
+	if !parts["common"].Main {
+		t.Errorf("common part is not the main part")
+	}
+
+	// constructors follow the area of their class, not their own name
+	_, constructors, _ := names(&tlparser.Schema{Constructors: parts["common"].Constructors})
+	if !reflect.DeepEqual(constructors, []string{"error", "ok", "contentText", "contentPoll"}) {
+		t.Errorf("common constructors = %v", constructors)
+	}
+	_, constructors, _ = names(&tlparser.Schema{Constructors: parts["messages"].Constructors})
+	if !reflect.DeepEqual(constructors, []string{"formattedText", "message"}) {
+		t.Errorf("messages constructors = %v", constructors)
+	}
+}
@@ -6,46 +6,57 @@ import (
 	"github.com/zelenin/go-tdlib/internal/tlparser"
 )
 
-func GenerateTypes(schema *tlparser.Schema, packageName string) []byte {
-	buf := bytes.NewBufferString("")
+// GenerateTypes returns the types of the part of the schema or nil if the part has no types
+func GenerateTypes(schema *tlparser.Schema, part *Part, packageName string) []byte {
+	var constructors []*tlparser.Constructor
+	for _, constructor := range part.Constructors {
+		if !TdlibConstructor(constructor.Name, schema).IsInternal() {
+			constructors = append(constructors, constructor)
+		}
+	}
 
-	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
+	if len(part.Types) == 0 && len(constructors) == 0 {
+		return nil
+	}
 
-	buf.WriteString(`import (
-    "encoding/json"
-)
+	buf := bytes.NewBufferString("")
 
-`)
+	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
 
-	buf.WriteString("const (\n")
-	for _, entity := range schema.Types {
+	typeConsts := bytes.NewBufferString("")
+	for _, entity := range part.Types {
 		tdlibType := TdlibType(entity.Name, schema)
-		buf.WriteString(fmt.Sprintf("    %s = %q\n", tdlibType.ToTypeConst(), entity.Name))
+		typeConsts.WriteString(fmt.Sprintf("    %s = %q\n", tdlibType.ToTypeConst(), entity.Name))
 	}
-	for _, entity := range schema.Constructors {
+	for _, entity := range constructors {
 		tdlibConstructor := TdlibConstructor(entity.Name, schema)
-		if tdlibConstructor.IsInternal() || tdlibConstructor.HasType() {
+		if tdlibConstructor.HasType() {
 			continue
 		}
-		buf.WriteString(fmt.Sprintf("    %s = %q\n", tdlibConstructor.ToTypeConst(), entity.ResultType))
+		typeConsts.WriteString(fmt.Sprintf("    %s = %q\n", tdlibConstructor.ToTypeConst(), entity.ResultType))
 	}
-	buf.WriteString(")")
+	if typeConsts.Len() > 0 {
+		buf.WriteString("const (\n")
+		buf.Write(typeConsts.Bytes())
+		buf.WriteString(")")
 
-	buf.WriteString("\n\n")
+		buf.WriteString("\n\n")
+	}
 
-	buf.WriteString("const (\n")
-	for _, entity := range schema.Constructors {
-		tdlibConstructor := TdlibConstructor(entity.Name, schema)
-		if tdlibConstructor.IsInternal() {
-			continue
+	if len(constructors) > 0 {
+		buf.WriteString("const (\n")
+		for _, entity := range constructors {
+			tdlibConstructor := TdlibConstructor(entity.Name, schema)
+			buf.WriteString(fmt.Sprintf("    %s = %q\n", tdlibConstructor.ToConstructorConst(), entity.Name))
 		}
-		buf.WriteString(fmt.Sprintf("    %s = %q\n", tdlibConstructor.ToConstructorConst(), entity.Name))
+		buf.WriteString(")")
+
+		buf.WriteString("\n\n")
 	}
-	buf.WriteString(")")
 
-	buf.WriteString("\n\n")
+	validated := validatedConstructors(schema)
 
-	for _, typ := range schema.Types {
+	for _, typ := range part.Types {
 		tdlibType := TdlibType(typ.Name, schema)
 
 		buf.WriteString(fmt.Sprintf(`// %s
@@ -56,11 +67,18 @@ type %s interface {
 `, typ.Description, tdlibType.ToGoType(), tdlibType.ToGoType()))
 	}
 
-	for _, constructor := range schema.Constructors {
+	for _, typ := range part.Types {
+		tdlibType := TdlibType(typ.Name, schema)
+
+		buf.WriteString(fmt.Sprintf(`func (entity *UnknownType) %sConstructor() string {
+    return entity.Constructor
+}
+
+`, tdlibType.ToGoType()))
+	}
+
+	for _, constructor := range constructors {
 		tdlibConstructor := TdlibConstructor(constructor.Name, schema)
-		if tdlibConstructor.IsInternal() {
-			continue
-		}
 
 		buf.WriteString("// " + constructor.Description + "\n")
 
@@ -72,7 +90,7 @@ type %s interface {
 				tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
 
 				buf.WriteString(fmt.Sprintf("    // %s\n", arg.Description))
-				buf.WriteString(fmt.Sprintf("    %s %s `json:\"%s\"`\n", tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoType(), arg.Name))
+				buf.WriteString(fmt.Sprintf("    %s %s %s\n", tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoFieldType(arg.Nullable), tdlibTypeArg.ToJsonTag(arg.Nullable)))
 			}
 
 			buf.WriteString("}\n\n")
@@ -105,79 +123,23 @@ func (*%s) GetConstructor() string {
 		}
 
 		buf.WriteString(fmt.Sprintf(`func (entity *%s) MarshalJSON() ([]byte, error) {
-    entity.meta.MetaType = entity.GetConstructor()
-
-    type stub %s
+    return marshalJSON(entity)
+}
 
-    return json.Marshal((*stub)(entity))
+func (entity *%s) UnmarshalJSON(data []byte) error {
+    return unmarshalObject(data, entity.decodeJSON)
 }
 
 `, tdlibConstructor.ToGoType(), tdlibConstructor.ToGoType()))
 
-		if tdlibConstructor.HasTypeArgs() {
-			buf.WriteString(fmt.Sprintf(`func (%s *%s) UnmarshalJSON(data []byte) error {
-    var tmp struct {
-`, constructor.Name, tdlibConstructor.ToGoType()))
-
-			var countSimpleProperties int
+		buf.WriteString(generateEncodeJSON("entity", tdlibConstructor.ToGoType(), constructor.Name, "encoder.writeMeta(&entity.meta)", constructor.Args, schema))
+		buf.WriteString("\n")
+		buf.WriteString(generateDecodeJSON("entity", tdlibConstructor.ToGoType(), tdlibConstructor.ToConstructorConst(), constructor.Args, schema))
+		buf.WriteString("\n")
 
-			for _, arg := range constructor.Args {
-				tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
-
-				if !tdlibTypeArg.IsType() {
-					buf.WriteString(fmt.Sprintf("        %s %s `json:\"%s\"`\n", tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoType(), arg.Name))
-					countSimpleProperties++
-				} else {
-					if tdlibTypeArg.IsList() {
-						buf.WriteString(fmt.Sprintf("        %s %s `json:\"%s\"`\n", tdlibTypeArg.ToGoName(), "[]json.RawMessage", arg.Name))
-					} else {
-						buf.WriteString(fmt.Sprintf("        %s %s `json:\"%s\"`\n", tdlibTypeArg.ToGoName(), "json.RawMessage", arg.Name))
-					}
-				}
-			}
-
-			buf.WriteString(`    }
-
-    err := json.Unmarshal(data, &tmp)
-    if err != nil {
-        return err
-    }
-
-`)
-
-			for _, arg := range constructor.Args {
-				tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
-
-				if !tdlibTypeArg.IsType() {
-					buf.WriteString(fmt.Sprintf("    %s.%s = tmp.%s\n", constructor.Name, tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoName()))
-				}
-			}
-
-			if countSimpleProperties > 0 {
-				buf.WriteString("\n")
-			}
-
-			for _, arg := range constructor.Args {
-				tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
-
-				if tdlibTypeArg.IsType() && !tdlibTypeArg.IsList() {
-					buf.WriteString(fmt.Sprintf(`    field%s, _ := Unmarshal%s(tmp.%s)
-    %s.%s = field%s
-
-`, tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoType(), tdlibTypeArg.ToGoName(), constructor.Name, tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoName()))
-				}
-				if tdlibTypeArg.IsType() && tdlibTypeArg.IsList() {
-					buf.WriteString(fmt.Sprintf(`    field%s, _ := UnmarshalListOf%s(tmp.%s)
-    %s.%s = field%s
-
-`, tdlibTypeArg.ToGoName(), tdlibTypeArg.GetType().ToGoType(), tdlibTypeArg.ToGoName(), constructor.Name, tdlibTypeArg.ToGoName(), tdlibTypeArg.ToGoName()))
-				}
-			}
-
-			buf.WriteString(`    return nil
-}
-
-`)
+		if validated[constructor.Name] {
+			buf.WriteString(generateValidate(fmt.Sprintf("entity *%s", tdlibConstructor.ToGoType()), "entity", constructor.Args, validated, schema))
+			buf.WriteString("\n")
 		}
 	}
 
@@ -6,41 +6,73 @@ import (
 	"github.com/zelenin/go-tdlib/internal/tlparser"
 )
 
-func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
+// GenerateUnmarshalers returns the unmarshalers of the part of the schema or nil if the part has no types
+func GenerateUnmarshalers(schema *tlparser.Schema, part *Part, packageName string) []byte {
+	var constructors []*tlparser.Constructor
+	for _, constructor := range part.Constructors {
+		tdlibConstructor := TdlibConstructor(constructor.Name, schema)
+		if !tdlibConstructor.IsList() && !tdlibConstructor.IsInternal() {
+			constructors = append(constructors, constructor)
+		}
+	}
+
+	if len(part.Types) == 0 && len(constructors) == 0 && !part.Main {
+		return nil
+	}
+
 	buf := bytes.NewBufferString("")
 
 	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
 
-	buf.WriteString(`import (
+	if len(part.Types) > 0 {
+		buf.WriteString(`import (
     "encoding/json"
     "fmt"
 )
 
 `)
+	} else {
+		buf.WriteString(`import (
+    "encoding/json"
+)
+
+`)
+	}
 
-	for _, typ := range schema.Types {
+	for _, typ := range part.Types {
 		tdlibtype := TdlibType(typ.Name, schema)
 
 		buf.WriteString(fmt.Sprintf(`func Unmarshal%s(data json.RawMessage) (%s, error) {
-    var meta meta
-    err := json.Unmarshal(data, &meta)
+    if isNull(data) {
+        return nil, nil
+    }
+
+    return unmarshalJSON(data, decode%s)
+}
+
+func decode%s(decoder *jsonDecoder) (%s, error) {
+    if decoder.readNull() {
+        return nil, nil
+    }
+
+    constructor, err := decoder.readConstructor()
     if err != nil {
         return nil, err
     }
 
-    switch meta.MetaType {
-`, tdlibtype.ToGoType(), tdlibtype.ToGoType()))
+    switch string(constructor) {
+`, tdlibtype.ToGoType(), tdlibtype.ToGoType(), tdlibtype.ToGoType(), tdlibtype.ToGoType(), tdlibtype.ToGoType()))
 
 		for _, constructor := range tdlibtype.GetConstructors() {
 			buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(data)
+        return decodeEntity[%s](decoder)
 
 `, constructor.ToConstructorConst(), constructor.ToGoType()))
 
 		}
 
 		buf.WriteString(`    default:
-        return nil, fmt.Errorf("Error unmarshaling. Unknown type: " +  meta.MetaType)
+        return decoder.unknownType(constructor)
     }
 }
 
@@ -48,10 +80,10 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 
 		buf.WriteString(fmt.Sprintf(`func UnmarshalListOf%s(dataList []json.RawMessage) ([]%s, error) {
     list := make([]%s, 0, len(dataList))
-    for _, data := range dataList {
+    for i, data := range dataList {
         entity, err := Unmarshal%s(data)
         if err != nil {
-            return nil, err
+            return nil, wrapUnmarshalError(fmt.Sprintf("[%%d]", i), err)
         }
         list = append(list, entity)
     }
@@ -63,16 +95,12 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 
 	}
 
-	for _, constructor := range schema.Constructors {
+	for _, constructor := range constructors {
 		tdlibConstructor := TdlibConstructor(constructor.Name, schema)
 
-		if tdlibConstructor.IsList() || tdlibConstructor.IsInternal() {
-			continue
-		}
-
 		buf.WriteString(fmt.Sprintf(`func Unmarshal%s(data json.RawMessage) (*%s, error) {
     var resp %s
-    err := json.Unmarshal(data, &resp)
+    err := resp.UnmarshalJSON(data)
     return &resp, err
 }
 
@@ -80,14 +108,21 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 
 	}
 
+	if !part.Main {
+		return buf.Bytes()
+	}
+
 	buf.WriteString(`func UnmarshalType(data json.RawMessage) (Type, error) {
-    var meta meta
-    err := json.Unmarshal(data, &meta)
+    return unmarshalJSON(data, decodeType)
+}
+
+func decodeType(decoder *jsonDecoder) (Type, error) {
+    constructor, err := decoder.readConstructor()
     if err != nil {
         return nil, err
     }
 
-    switch meta.MetaType {
+    switch string(constructor) {
 `)
 
 	for _, constructor := range schema.Constructors {
@@ -98,14 +133,14 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 		}
 
 		buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(data)
+        return decodeEntity[%s](decoder)
 
 `, tdlibConstructor.ToConstructorConst(), tdlibConstructor.ToGoType()))
 
 	}
 
 	buf.WriteString(`    default:
-        return nil, fmt.Errorf("Error unmarshaling. Unknown type: " +  meta.MetaType)
+        return decoder.unknownType(constructor)
     }
 }
 `)
@@ -0,0 +1,54 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"strings"
+)
+
+const updateTypeName = "Update"
+
+func GenerateUpdates(schema *tlparser.Schema, packageName string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\npackage %s\n\n", header, packageName))
+
+	updates := TdlibType(updateTypeName, schema).GetConstructors()
+
+	buf.WriteString("// UpdateHandler dispatches updates to typed callbacks. Nil callbacks are skipped.\n")
+	buf.WriteString("type UpdateHandler struct {\n")
+	for _, update := range updates {
+		buf.WriteString(fmt.Sprintf("    // %s\n", update.GetConstructor().Description))
+		buf.WriteString(fmt.Sprintf("    %s func(update *%s)\n", toUpdateCallbackName(update), update.ToGoType()))
+	}
+	buf.WriteString("    // Called for results without a callback\n")
+	buf.WriteString("    OnOther func(result Type)\n")
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(`func (handler *UpdateHandler) OnResult(result Type) {
+    switch result.GetConstructor() {
+`)
+	for _, update := range updates {
+		buf.WriteString(fmt.Sprintf(`    case %s:
+        if handler.%s != nil {
+            handler.%s(result.(*%s))
+            return
+        }
+
+`, update.ToConstructorConst(), toUpdateCallbackName(update), toUpdateCallbackName(update), update.ToGoType()))
+	}
+	buf.WriteString(`    }
+
+    if handler.OnOther != nil {
+        handler.OnOther(result)
+    }
+}
+`)
+
+	return buf.Bytes()
+}
+
+func toUpdateCallbackName(update *tdlibConstructor) string {
+	return "On" + strings.TrimPrefix(update.ToGoType(), updateTypeName)
+}
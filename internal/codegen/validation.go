@@ -0,0 +1,139 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"strings"
+)
+
+// validatedConstructors returns the constructors which have constrained fields directly or in nested objects
+func validatedConstructors(schema *tlparser.Schema) map[string]bool {
+	validated := map[string]bool{}
+
+	for changed := true; changed; {
+		changed = false
+
+		for _, constructor := range schema.Constructors {
+			if validated[constructor.Name] || TdlibConstructor(constructor.Name, schema).IsInternal() {
+				continue
+			}
+
+			if len(validationChecks("entity", constructor.Args, validated, schema)) > 0 {
+				validated[constructor.Name] = true
+				changed = true
+			}
+		}
+	}
+
+	return validated
+}
+
+func isValidatedArg(tdlibTypeArg *tdlibTypeArg, validated map[string]bool) bool {
+	if tdlibTypeArg.IsType() {
+		for _, constructor := range tdlibTypeArg.GetType().GetConstructors() {
+			if validated[constructor.GetConstructor().Name] {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return validated[normalizeEntityName(tdlibTypeArg.GetPrimitive())]
+}
+
+func validationChecks(receiver string, args []*tlparser.Arg, validated map[string]bool, schema *tlparser.Schema) []string {
+	checks := []string{}
+
+	for _, arg := range args {
+		tdlibTypeArg := TdlibTypeArg(arg.Name, arg.Type, schema)
+		if strings.Count(arg.Type, "vector<") > 1 {
+			continue
+		}
+
+		field := fmt.Sprintf("%q", arg.Name)
+		value := receiver + "." + tdlibTypeArg.ToGoName()
+		if tdlibTypeArg.IsList() {
+			field = fmt.Sprintf("indexField(%q, i)", arg.Name)
+			value = "item"
+		}
+
+		argChecks := []string{}
+
+		// nullable scalars are pointers, their constraints apply to the value only
+		if arg.Constraints != nil && !tdlibTypeArg.isPointer(arg.Nullable) {
+			constraints := arg.Constraints
+
+			if constraints.Min != nil {
+				argChecks = append(argChecks, fmt.Sprintf("validateMin(%s, int64(%s), %d)", field, value, *constraints.Min))
+			}
+			if constraints.Max != nil {
+				argChecks = append(argChecks, fmt.Sprintf("validateMax(%s, int64(%s), %d)", field, value, *constraints.Max))
+			}
+
+			if constraints.MinLength != nil || constraints.MaxLength != nil {
+				minLength := int64(0)
+				if constraints.MinLength != nil {
+					minLength = *constraints.MinLength
+				}
+				maxLength := int64(-1)
+				if constraints.MaxLength != nil {
+					maxLength = *constraints.MaxLength
+				}
+
+				switch tdlibTypeArg.GetPrimitive() {
+				case "string":
+					argChecks = append(argChecks, fmt.Sprintf("validateLength(%s, %s, %d, %d)", field, value, minLength, maxLength))
+				case "formattedText":
+					argChecks = append(argChecks, fmt.Sprintf("validateFormattedTextLength(%s, %s, %d, %d)", field, value, minLength, maxLength))
+				}
+			}
+		}
+
+		if isValidatedArg(tdlibTypeArg, validated) {
+			argChecks = append(argChecks, fmt.Sprintf("validateNested(%s, %s)", field, value))
+		}
+
+		if len(argChecks) == 0 {
+			continue
+		}
+
+		buf := bytes.NewBufferString("")
+		indent := "    "
+		if tdlibTypeArg.IsList() {
+			buf.WriteString(fmt.Sprintf("    for i, item := range %s.%s {\n", receiver, tdlibTypeArg.ToGoName()))
+			indent = "        "
+		}
+		for _, argCheck := range argChecks {
+			buf.WriteString(fmt.Sprintf(`%sif err := %s; err != nil {
+%s    return err
+%s}
+`, indent, argCheck, indent, indent))
+		}
+		if tdlibTypeArg.IsList() {
+			buf.WriteString("    }\n")
+		}
+
+		checks = append(checks, buf.String())
+	}
+
+	return checks
+}
+
+func generateValidate(receiverDeclaration string, receiver string, args []*tlparser.Arg, validated map[string]bool, schema *tlparser.Schema) string {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("func (%s) Validate() error {\n", receiverDeclaration))
+	if strings.Contains(receiverDeclaration, "*") {
+		buf.WriteString(fmt.Sprintf("    if %s == nil {\n        return nil\n    }\n\n", receiver))
+	}
+	for _, check := range validationChecks(receiver, args, validated, schema) {
+		buf.WriteString(check)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("    return nil\n")
+	buf.WriteString("}\n")
+
+	return buf.String()
+}
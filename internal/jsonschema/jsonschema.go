@@ -0,0 +1,208 @@
+package jsonschema
+
+import (
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"math"
+	"strings"
+)
+
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema (draft 2020-12) document or subschema
+type Schema struct {
+	Draft       string             `json:"$schema,omitempty"`
+	Id          string             `json:"$id,omitempty"`
+	Ref         string             `json:"$ref,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Const       string             `json:"const,omitempty"`
+	Pattern     string             `json:"pattern,omitempty"`
+	Encoding    string             `json:"contentEncoding,omitempty"`
+	Minimum     *int64             `json:"minimum,omitempty"`
+	Maximum     *int64             `json:"maximum,omitempty"`
+	MinLength   *int64             `json:"minLength,omitempty"`
+	MaxLength   *int64             `json:"maxLength,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	OneOf       []*Schema          `json:"oneOf,omitempty"`
+	AnyOf       []*Schema          `json:"anyOf,omitempty"`
+	Defs        map[string]*Schema `json:"$defs,omitempty"`
+	// Result is the schema of the result of a function
+	Result *Schema `json:"x-result,omitempty"`
+}
+
+// Generate converts the TL schema into a JSON Schema document.
+// Every constructor, class and function is a definition named as in the TL schema: a constructor is an object with the "@type" const,
+// a class is a oneOf of its constructors, a function is an object describing the request.
+// The root schema accepts any object of a constructor or a function.
+func Generate(schema *tlparser.Schema, id string) *Schema {
+	document := &Schema{
+		Draft:       Draft,
+		Id:          id,
+		Title:       "TDLib API",
+		Description: "Objects and requests of the TDLib JSON interface",
+		Defs:        map[string]*Schema{},
+	}
+
+	for _, constructor := range schema.Constructors {
+		if isInternal(constructor.Name) {
+			continue
+		}
+
+		document.Defs[constructor.Name] = object(constructor.Name, constructor.Description, constructor.Args)
+		document.OneOf = append(document.OneOf, ref(constructor.Name))
+	}
+
+	for _, class := range schema.Types {
+		definition := &Schema{
+			Description: class.Description,
+		}
+		for _, constructor := range schema.Constructors {
+			if constructor.ResultType == class.Name {
+				definition.OneOf = append(definition.OneOf, ref(constructor.Name))
+			}
+		}
+
+		document.Defs[class.Name] = definition
+	}
+
+	for _, function := range schema.Functions {
+		definition := object(function.Name, function.Description, function.Args)
+		definition.Properties["@extra"] = &Schema{
+			Description: "Arbitrary data which is returned in the response",
+		}
+		definition.Result = resultSchema(function.ResultType, schema)
+
+		document.Defs[function.Name] = definition
+		document.OneOf = append(document.OneOf, ref(function.Name))
+	}
+
+	return document
+}
+
+func object(name string, description string, args []*tlparser.Arg) *Schema {
+	properties := map[string]*Schema{
+		"@type": {
+			Const: name,
+		},
+	}
+
+	for _, arg := range args {
+		property := typeSchema(arg.Type)
+		applyConstraints(property, arg.Constraints)
+
+		if arg.Nullable {
+			property = &Schema{
+				AnyOf: []*Schema{property, {Type: "null"}},
+			}
+		}
+		property.Description = arg.Description
+
+		properties[arg.Name] = property
+	}
+
+	return &Schema{
+		Description: description,
+		Type:        "object",
+		Properties:  properties,
+		Required:    []string{"@type"},
+	}
+}
+
+// typeSchema returns the schema of a value of the TL type
+func typeSchema(argType string) *Schema {
+	if strings.HasPrefix(argType, "vector<") {
+		return &Schema{
+			Type:  "array",
+			Items: typeSchema(strings.TrimSuffix(strings.TrimPrefix(argType, "vector<"), ">")),
+		}
+	}
+
+	switch argType {
+	case "double":
+		return &Schema{Type: "number"}
+
+	case "string":
+		return &Schema{Type: "string"}
+
+	case "int32":
+		return &Schema{Type: "integer", Minimum: pointer[int64](math.MinInt32), Maximum: pointer[int64](math.MaxInt32)}
+
+	case "int53":
+		return &Schema{Type: "integer"}
+
+	case "int64":
+		// int64 values are strings in order to deal with json big number problem
+		return &Schema{Type: "string", Pattern: "^-?[0-9]+$"}
+
+	case "bytes":
+		return &Schema{Type: "string", Encoding: "base64"}
+
+	case "Bool":
+		return &Schema{Type: "boolean"}
+	}
+
+	return ref(argType)
+}
+
+// resultSchema returns the schema of the result type of a function: a class or the only constructor of the type
+func resultSchema(resultType string, schema *tlparser.Schema) *Schema {
+	if strings.HasPrefix(resultType, "vector<") {
+		return typeSchema(resultType)
+	}
+
+	for _, class := range schema.Types {
+		if class.Name == resultType {
+			return ref(class.Name)
+		}
+	}
+
+	for _, constructor := range schema.Constructors {
+		if constructor.ResultType == resultType && !isInternal(constructor.Name) {
+			return ref(constructor.Name)
+		}
+	}
+
+	return typeSchema(resultType)
+}
+
+func applyConstraints(property *Schema, constraints *tlparser.Constraints) {
+	if constraints == nil {
+		return
+	}
+
+	switch property.Type {
+	case "integer":
+		if constraints.Min != nil {
+			property.Minimum = constraints.Min
+		}
+		if constraints.Max != nil {
+			property.Maximum = constraints.Max
+		}
+
+	case "string":
+		property.MinLength = constraints.MinLength
+		property.MaxLength = constraints.MaxLength
+	}
+}
+
+func ref(name string) *Schema {
+	return &Schema{
+		Ref: "#/$defs/" + name,
+	}
+}
+
+func isInternal(name string) bool {
+	switch name {
+	case "double", "string", "int32", "int53", "int64", "bytes", "boolFalse", "boolTrue", "vector<t>":
+		return true
+	}
+
+	return false
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,132 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testSchema = `double ? = Double;
+string ? = String;
+
+int32 = Int32;
+int53 = Int53;
+int64 = Int64;
+bytes = Bytes;
+
+boolFalse = Bool;
+boolTrue = Bool;
+
+vector {t:Type} # [ t ] = Vector t;
+
+//@description An object of this type can be returned on every function call, in case of an error
+//@code Error code @message Error message
+error code:int32 message:string = Error;
+
+//@description An object of this type is returned on a successful function call for certain functions
+ok = Ok;
+
+//@class Animal @description Describes an animal
+
+//@description A cat @id Identifier @name Name; 1-64 characters @photo Photo; may be null @friends Friends
+cat id:int64 name:string photo:bytes friends:vector<Animal> = Animal;
+
+//@description A dog @age Age; must be positive @is_good True, if the dog is good
+dog age:int32 is_good:Bool = Animal;
+
+---functions---
+
+//@description Returns an animal @animal_id Animal identifier; pass null to return a random animal
+getAnimal animal_id:int53 = Animal;
+
+//@description Returns cats
+getCats = vector<cat>;
+`
+
+func generate(t *testing.T, data string) map[string]any {
+	t.Helper()
+
+	schema, err := tlparser.Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	output, err := json.Marshal(Generate(schema, "https://example.com/td_api.schema.json"))
+	if err != nil {
+		t.Fatalf("marshal error: %s", err)
+	}
+
+	var document map[string]any
+	err = json.Unmarshal(output, &document)
+	if err != nil {
+		t.Fatalf("unmarshal error: %s", err)
+	}
+
+	return document
+}
+
+func TestGenerate(t *testing.T) {
+	document := generate(t, testSchema)
+
+	if document["$schema"] != Draft {
+		t.Errorf("unexpected $schema: %v", document["$schema"])
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"cat", `{"description":"A cat","properties":{"@type":{"const":"cat"},"friends":{"description":"Friends","items":{"$ref":"#/$defs/Animal"},"type":"array"},"id":{"description":"Identifier","pattern":"^-?[0-9]+$","type":"string"},"name":{"description":"Name; 1-64 characters","maxLength":64,"minLength":1,"type":"string"},"photo":{"anyOf":[{"contentEncoding":"base64","type":"string"},{"type":"null"}],"description":"Photo; may be null"}},"required":["@type"],"type":"object"}`},
+		{"dog", `{"description":"A dog","properties":{"@type":{"const":"dog"},"age":{"description":"Age; must be positive","maximum":2147483647,"minimum":1,"type":"integer"},"is_good":{"description":"True, if the dog is good","type":"boolean"}},"required":["@type"],"type":"object"}`},
+		{"Animal", `{"description":"Describes an animal","oneOf":[{"$ref":"#/$defs/cat"},{"$ref":"#/$defs/dog"}]}`},
+		{"getAnimal", `{"description":"Returns an animal","properties":{"@extra":{"description":"Arbitrary data which is returned in the response"},"@type":{"const":"getAnimal"},"animal_id":{"anyOf":[{"type":"integer"},{"type":"null"}],"description":"Animal identifier; pass null to return a random animal"}},"required":["@type"],"type":"object","x-result":{"$ref":"#/$defs/Animal"}}`},
+		{"getCats", `{"description":"Returns cats","properties":{"@extra":{"description":"Arbitrary data which is returned in the response"},"@type":{"const":"getCats"}},"required":["@type"],"type":"object","x-result":{"items":{"$ref":"#/$defs/cat"},"type":"array"}}`},
+		{"ok", `{"description":"An object of this type is returned on a successful function call for certain functions","properties":{"@type":{"const":"ok"}},"required":["@type"],"type":"object"}`},
+	}
+
+	defs := document["$defs"].(map[string]any)
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, _ := json.Marshal(defs[tt.path])
+			if string(got) != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := defs["int64"]; ok {
+		t.Errorf("internal constructors must not be defined")
+	}
+}
+
+func TestGenerateRefs(t *testing.T) {
+	data, err := os.ReadFile("../../data/td_api.tl")
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	document := generate(t, string(data))
+	defs := document["$defs"].(map[string]any)
+
+	var walk func(value any)
+	walk = func(value any) {
+		switch value := value.(type) {
+		case map[string]any:
+			for key, item := range value {
+				if ref, ok := item.(string); ok && key == "$ref" {
+					if _, ok := defs[strings.TrimPrefix(ref, "#/$defs/")]; !ok {
+						t.Errorf("unresolved $ref: %s", ref)
+					}
+				}
+				walk(item)
+			}
+		case []any:
+			for _, item := range value {
+				walk(item)
+			}
+		}
+	}
+	walk(document)
+}
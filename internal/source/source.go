@@ -0,0 +1,32 @@
+package source
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// URL returns the URL of a file of the TDLib repository at the version, e.g. td/generate/scheme/td_api.tl
+func URL(version string, path string) string {
+	return "https://raw.githubusercontent.com/tdlib/td/" + version + "/" + path
+}
+
+// Open opens the file at path or downloads url if path is empty
+func Open(path string, url string) (io.ReadCloser, error) {
+	if path != "" {
+		return os.Open(path)
+	}
+
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("http.Get status: %s", res.Status)
+	}
+
+	return res.Body, nil
+}
@@ -0,0 +1,72 @@
+package source
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOpen(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/td_api.tl" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("remote"))
+	}))
+	defer server.Close()
+
+	filePath := filepath.Join(t.TempDir(), "td_api.tl")
+	err := os.WriteFile(filePath, []byte("local"), 0644)
+	if err != nil {
+		t.Fatalf("write error: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{"path", filePath, server.URL + "/td_api.tl", "local", false},
+		{"url", "", server.URL + "/td_api.tl", "remote", false},
+		{"missing path", filePath + ".missing", server.URL + "/td_api.tl", "", true},
+		{"not found", "", server.URL + "/missing.tl", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := Open(tt.path, tt.url)
+			if tt.wantErr {
+				if err == nil {
+					reader.Close()
+					t.Fatal("error is nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("open error: %s", err)
+			}
+			defer reader.Close()
+
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("read error: %s", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("data = %q, want %q", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestURL(t *testing.T) {
+	url := URL("v1.8.0", "td/generate/scheme/td_api.tl")
+	if url != "https://raw.githubusercontent.com/tdlib/td/v1.8.0/td/generate/scheme/td_api.tl" {
+		t.Errorf("url = %s", url)
+	}
+}
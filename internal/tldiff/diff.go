@@ -0,0 +1,297 @@
+package tldiff
+
+import (
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"sort"
+	"strings"
+)
+
+type Kind string
+
+const (
+	KindClass       Kind = "class"
+	KindConstructor Kind = "constructor"
+	KindFunction    Kind = "function"
+	KindArgument    Kind = "argument"
+)
+
+type Action string
+
+const (
+	ActionAdded             Action = "added"
+	ActionRemoved           Action = "removed"
+	ActionRenamed           Action = "renamed"
+	ActionTypeChanged       Action = "type_changed"
+	ActionResultTypeChanged Action = "result_type_changed"
+)
+
+// Change is a single difference between two schemas.
+// Name is the entity name, arguments are named as "entity.argument"
+type Change struct {
+	Kind     Kind   `json:"kind"`
+	Action   Action `json:"action"`
+	Name     string `json:"name"`
+	NewName  string `json:"new_name,omitempty"`
+	OldType  string `json:"old_type,omitempty"`
+	NewType  string `json:"new_type,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+type Report struct {
+	Changes []*Change `json:"changes"`
+}
+
+// HasBreaking reports whether the report contains breaking changes
+func (report *Report) HasBreaking() bool {
+	for _, change := range report.Changes {
+		if change.Breaking {
+			return true
+		}
+	}
+
+	return false
+}
+
+// entity is a common view of constructors and functions
+type entity struct {
+	name       string
+	args       []*tlparser.Arg
+	resultType string
+}
+
+// Diff compares two schemas. Changes which break code built on the generated Go API are marked as breaking:
+// removed and renamed entities and arguments, changed argument and result types.
+func Diff(oldSchema *tlparser.Schema, newSchema *tlparser.Schema) *Report {
+	report := &Report{
+		Changes: []*Change{},
+	}
+
+	oldClasses := map[string]bool{}
+	for _, typ := range oldSchema.Types {
+		oldClasses[typ.Name] = true
+	}
+	newClasses := map[string]bool{}
+	for _, typ := range newSchema.Types {
+		newClasses[typ.Name] = true
+		if !oldClasses[typ.Name] {
+			report.add(&Change{Kind: KindClass, Action: ActionAdded, Name: typ.Name})
+		}
+	}
+	for _, typ := range oldSchema.Types {
+		if !newClasses[typ.Name] {
+			report.add(&Change{Kind: KindClass, Action: ActionRemoved, Name: typ.Name, Breaking: true})
+		}
+	}
+
+	report.diffEntities(KindConstructor, constructorEntities(oldSchema), constructorEntities(newSchema))
+	report.diffEntities(KindFunction, functionEntities(oldSchema), functionEntities(newSchema))
+
+	sort.SliceStable(report.Changes, func(i, j int) bool {
+		if report.Changes[i].Breaking != report.Changes[j].Breaking {
+			return report.Changes[i].Breaking
+		}
+		if report.Changes[i].Kind != report.Changes[j].Kind {
+			return kindOrder(report.Changes[i].Kind) < kindOrder(report.Changes[j].Kind)
+		}
+		return report.Changes[i].Name < report.Changes[j].Name
+	})
+
+	return report
+}
+
+func (report *Report) add(change *Change) {
+	report.Changes = append(report.Changes, change)
+}
+
+func (report *Report) diffEntities(kind Kind, oldEntities []*entity, newEntities []*entity) {
+	oldByName := map[string]*entity{}
+	for _, oldEntity := range oldEntities {
+		oldByName[oldEntity.name] = oldEntity
+	}
+	newByName := map[string]*entity{}
+	for _, newEntity := range newEntities {
+		newByName[newEntity.name] = newEntity
+	}
+
+	var removed []*entity
+	for _, oldEntity := range oldEntities {
+		newEntity, ok := newByName[oldEntity.name]
+		if !ok {
+			removed = append(removed, oldEntity)
+			continue
+		}
+
+		report.diffEntity(kind, oldEntity, newEntity)
+	}
+
+	var added []*entity
+	for _, newEntity := range newEntities {
+		if _, ok := oldByName[newEntity.name]; !ok {
+			added = append(added, newEntity)
+		}
+	}
+
+	renamed := findRenames(removed, added)
+
+	for _, oldEntity := range removed {
+		newEntity, ok := renamed[oldEntity]
+		if ok {
+			report.add(&Change{Kind: kind, Action: ActionRenamed, Name: oldEntity.name, NewName: newEntity.name, Breaking: true})
+			continue
+		}
+		report.add(&Change{Kind: kind, Action: ActionRemoved, Name: oldEntity.name, Breaking: true})
+	}
+
+	renamedTo := map[*entity]bool{}
+	for _, newEntity := range renamed {
+		renamedTo[newEntity] = true
+	}
+	for _, newEntity := range added {
+		if !renamedTo[newEntity] {
+			report.add(&Change{Kind: kind, Action: ActionAdded, Name: newEntity.name})
+		}
+	}
+}
+
+func (report *Report) diffEntity(kind Kind, oldEntity *entity, newEntity *entity) {
+	if oldEntity.resultType != newEntity.resultType {
+		report.add(&Change{Kind: kind, Action: ActionResultTypeChanged, Name: oldEntity.name, OldType: oldEntity.resultType, NewType: newEntity.resultType, Breaking: true})
+	}
+
+	oldArgs := map[string]*tlparser.Arg{}
+	for _, arg := range oldEntity.args {
+		oldArgs[arg.Name] = arg
+	}
+	newArgs := map[string]*tlparser.Arg{}
+	for _, arg := range newEntity.args {
+		newArgs[arg.Name] = arg
+	}
+
+	var removed []*tlparser.Arg
+	for _, oldArg := range oldEntity.args {
+		newArg, ok := newArgs[oldArg.Name]
+		if !ok {
+			removed = append(removed, oldArg)
+			continue
+		}
+
+		if oldArg.Type != newArg.Type {
+			report.add(&Change{Kind: KindArgument, Action: ActionTypeChanged, Name: argName(oldEntity, oldArg), OldType: oldArg.Type, NewType: newArg.Type, Breaking: true})
+		}
+	}
+
+	var added []*tlparser.Arg
+	for _, newArg := range newEntity.args {
+		if _, ok := oldArgs[newArg.Name]; !ok {
+			added = append(added, newArg)
+		}
+	}
+
+	// an argument is renamed if it is the only removed and the only added argument of the same type
+	for _, oldArg := range removed {
+		renamedArg := uniqueArgOfType(added, oldArg.Type)
+		if renamedArg != nil && uniqueArgOfType(removed, oldArg.Type) == oldArg {
+			report.add(&Change{Kind: KindArgument, Action: ActionRenamed, Name: argName(oldEntity, oldArg), NewName: argName(newEntity, renamedArg), Breaking: true})
+			added = removeArg(added, renamedArg)
+			continue
+		}
+
+		report.add(&Change{Kind: KindArgument, Action: ActionRemoved, Name: argName(oldEntity, oldArg), OldType: oldArg.Type, Breaking: true})
+	}
+
+	for _, newArg := range added {
+		report.add(&Change{Kind: KindArgument, Action: ActionAdded, Name: argName(newEntity, newArg), NewType: newArg.Type})
+	}
+}
+
+// findRenames matches removed and added entities with the same signature. Ambiguous matches are not renames
+func findRenames(removed []*entity, added []*entity) map[*entity]*entity {
+	removedBySignature := map[string][]*entity{}
+	for _, oldEntity := range removed {
+		removedBySignature[oldEntity.signature()] = append(removedBySignature[oldEntity.signature()], oldEntity)
+	}
+	addedBySignature := map[string][]*entity{}
+	for _, newEntity := range added {
+		addedBySignature[newEntity.signature()] = append(addedBySignature[newEntity.signature()], newEntity)
+	}
+
+	renamed := map[*entity]*entity{}
+	for signature, oldEntities := range removedBySignature {
+		newEntities := addedBySignature[signature]
+		if len(oldEntities) == 1 && len(newEntities) == 1 {
+			renamed[oldEntities[0]] = newEntities[0]
+		}
+	}
+
+	return renamed
+}
+
+func (entity *entity) signature() string {
+	args := make([]string, 0, len(entity.args))
+	for _, arg := range entity.args {
+		args = append(args, arg.Name+":"+arg.Type)
+	}
+
+	return strings.Join(args, " ") + " = " + entity.resultType
+}
+
+func constructorEntities(schema *tlparser.Schema) []*entity {
+	entities := make([]*entity, 0, len(schema.Constructors))
+	for _, constructor := range schema.Constructors {
+		entities = append(entities, &entity{name: constructor.Name, args: constructor.Args, resultType: constructor.ResultType})
+	}
+
+	return entities
+}
+
+func functionEntities(schema *tlparser.Schema) []*entity {
+	entities := make([]*entity, 0, len(schema.Functions))
+	for _, function := range schema.Functions {
+		entities = append(entities, &entity{name: function.Name, args: function.Args, resultType: function.ResultType})
+	}
+
+	return entities
+}
+
+func uniqueArgOfType(args []*tlparser.Arg, typ string) *tlparser.Arg {
+	var found *tlparser.Arg
+	for _, arg := range args {
+		if arg.Type != typ {
+			continue
+		}
+		if found != nil {
+			return nil
+		}
+		found = arg
+	}
+
+	return found
+}
+
+func removeArg(args []*tlparser.Arg, removed *tlparser.Arg) []*tlparser.Arg {
+	result := make([]*tlparser.Arg, 0, len(args))
+	for _, arg := range args {
+		if arg != removed {
+			result = append(result, arg)
+		}
+	}
+
+	return result
+}
+
+func argName(entity *entity, arg *tlparser.Arg) string {
+	return entity.name + "." + arg.Name
+}
+
+func kindOrder(kind Kind) int {
+	switch kind {
+	case KindClass:
+		return 0
+	case KindConstructor:
+		return 1
+	case KindFunction:
+		return 2
+	}
+
+	return 3
+}
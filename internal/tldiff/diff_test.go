@@ -0,0 +1,93 @@
+package tldiff
+
+import (
+	"encoding/json"
+	"github.com/zelenin/go-tdlib/internal/tlparser"
+	"os"
+	"strings"
+	"testing"
+)
+
+func parseFile(t *testing.T, path string) *tlparser.Schema {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	return parseString(t, string(data))
+}
+
+func parseString(t *testing.T, data string) *tlparser.Schema {
+	t.Helper()
+
+	schema, err := tlparser.Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	return schema
+}
+
+func TestDiffFixtures(t *testing.T) {
+	report := Diff(parseFile(t, "testdata/old.tl"), parseFile(t, "testdata/new.tl"))
+
+	tests := []struct {
+		golden string
+		output []byte
+	}{
+		{"testdata/report.txt", Text(report)},
+		{"testdata/report.md", Markdown(report)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			want, err := os.ReadFile(tt.golden)
+			if err != nil {
+				t.Fatalf("read error: %s", err)
+			}
+			if string(tt.output) != string(want) {
+				t.Errorf("output:\n%s\nwant:\n%s", tt.output, want)
+			}
+		})
+	}
+
+	data, err := Json(report)
+	if err != nil {
+		t.Fatalf("json error: %s", err)
+	}
+
+	var decoded Report
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("json decode error: %s", err)
+	}
+	if len(decoded.Changes) != len(report.Changes) || !decoded.HasBreaking() {
+		t.Errorf("decoded report = %s", data)
+	}
+}
+
+func TestDiffTdApi(t *testing.T) {
+	data, err := os.ReadFile("../../data/td_api.tl")
+	if err != nil {
+		t.Fatalf("read error: %s", err)
+	}
+
+	oldSchema := parseString(t, string(data))
+
+	report := Diff(oldSchema, parseString(t, string(data)))
+	if len(report.Changes) != 0 {
+		t.Fatalf("same schema has changes: %s", Text(report))
+	}
+
+	upgraded := strings.Replace(string(data), "\ngetChatHistory ", "\ngetChatHistoryPage ", 1)
+	upgraded = strings.Replace(upgraded, "\nupdateNewMessage message:message = Update;", "\nupdateNewMessage message:message is_silent:Bool = Update;", 1)
+
+	report = Diff(oldSchema, parseString(t, upgraded))
+
+	want := "BREAKING function getChatHistory renamed to getChatHistoryPage\nadditive argument updateNewMessage.is_silent added\n"
+	if string(Text(report)) != want {
+		t.Errorf("report:\n%s\nwant:\n%s", Text(report), want)
+	}
+}
@@ -0,0 +1,97 @@
+package tldiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Text formats the report as plain text, one change per line
+func Text(report *Report) []byte {
+	buf := bytes.NewBufferString("")
+
+	for _, change := range report.Changes {
+		severity := "additive"
+		if change.Breaking {
+			severity = "BREAKING"
+		}
+
+		buf.WriteString(fmt.Sprintf("%-8s %s\n", severity, change.describe()))
+	}
+
+	if len(report.Changes) == 0 {
+		buf.WriteString("no changes\n")
+	}
+
+	return buf.Bytes()
+}
+
+// Markdown formats the report as a Markdown document with breaking and additive sections
+func Markdown(report *Report) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString("# Schema changes\n")
+
+	sections := []struct {
+		title    string
+		breaking bool
+	}{
+		{"Breaking changes", true},
+		{"Additive changes", false},
+	}
+
+	for _, section := range sections {
+		buf.WriteString(fmt.Sprintf("\n## %s\n\n", section.title))
+
+		count := 0
+		for _, change := range report.Changes {
+			if change.Breaking == section.breaking {
+				buf.WriteString(fmt.Sprintf("- %s\n", change.describeMarkdown()))
+				count++
+			}
+		}
+
+		if count == 0 {
+			buf.WriteString("None\n")
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// Json formats the report as an indented JSON document
+func Json(report *Report) ([]byte, error) {
+	data, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+func (change *Change) describe() string {
+	return change.format(func(name string) string {
+		return name
+	})
+}
+
+func (change *Change) describeMarkdown() string {
+	return change.format(func(name string) string {
+		return "`" + name + "`"
+	})
+}
+
+func (change *Change) format(quote func(name string) string) string {
+	switch change.Action {
+	case ActionRenamed:
+		return fmt.Sprintf("%s %s renamed to %s", change.Kind, quote(change.Name), quote(change.NewName))
+
+	case ActionTypeChanged:
+		return fmt.Sprintf("%s %s type changed from %s to %s", change.Kind, quote(change.Name), quote(change.OldType), quote(change.NewType))
+
+	case ActionResultTypeChanged:
+		return fmt.Sprintf("%s %s result type changed from %s to %s", change.Kind, quote(change.Name), quote(change.OldType), quote(change.NewType))
+	}
+
+	return fmt.Sprintf("%s %s %s", change.Kind, quote(change.Name), change.Action)
+}
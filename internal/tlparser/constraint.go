@@ -0,0 +1,112 @@
+package tlparser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	reLength      = regexp.MustCompile(`\b(\d+)-(\d+) characters\b`)
+	reBetween     = regexp.MustCompile(`must be between (-?\d+) and (-?\d+)`)
+	reGreaterThan = regexp.MustCompile(`can't be greater than (-?\d+)`)
+	reUpTo        = regexp.MustCompile(`^\s*up to (\d+)\s*$`)
+)
+
+// parseConstraints extracts value constraints from the argument description. Conditional clauses are ignored.
+func parseConstraints(arg *Arg) *Constraints {
+	constraints := &Constraints{}
+	found := false
+
+	for _, clause := range splitClauses(arg.Description) {
+		if isConditionalClause(clause) {
+			continue
+		}
+
+		switch getPrimitive(arg.Type) {
+		case "int32", "int53", "int64":
+			if strings.Contains(clause, "must be positive") {
+				constraints.Min = pointer(int64(1))
+				found = true
+			}
+
+			if strings.Contains(clause, "must be non-negative") {
+				constraints.Min = pointer(int64(0))
+				found = true
+			}
+
+			if match := reGreaterThan.FindStringSubmatch(clause); match != nil {
+				constraints.Max = pointer(parseInt(match[1]))
+				found = true
+			}
+
+			if match := reUpTo.FindStringSubmatch(clause); match != nil {
+				constraints.Max = pointer(parseInt(match[1]))
+				found = true
+			}
+
+			if match := reBetween.FindStringSubmatch(clause); match != nil {
+				constraints.Min = pointer(parseInt(match[1]))
+				constraints.Max = pointer(parseInt(match[2]))
+				found = true
+			}
+
+		case "string", "formattedText":
+			if strings.Contains(clause, "must be non-empty") {
+				constraints.MinLength = pointer(int64(1))
+				found = true
+			}
+
+			if match := reLength.FindStringSubmatch(clause); match != nil {
+				constraints.MinLength = pointer(parseInt(match[1]))
+				constraints.MaxLength = pointer(parseInt(match[2]))
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return constraints
+}
+
+// isNullable reports whether the argument description allows null ("may be null", "pass null to ...")
+func isNullable(description string) bool {
+	return strings.Contains(description, "may be null") || strings.Contains(description, "pass null")
+}
+
+func splitClauses(description string) []string {
+	return strings.FieldsFunc(description, func(r rune) bool {
+		return r == ';' || r == '.'
+	})
+}
+
+func isConditionalClause(clause string) bool {
+	clause = " " + strings.ToLower(clause) + " "
+	for _, word := range []string{" if ", " otherwise ", " unless ", " when "} {
+		if strings.Contains(clause, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getPrimitive(typ string) string {
+	for strings.HasPrefix(typ, "vector<") {
+		typ = strings.TrimSuffix(strings.TrimPrefix(typ, "vector<"), ">")
+	}
+
+	return typ
+}
+
+func parseInt(str string) int64 {
+	value, _ := strconv.ParseInt(str, 10, 64)
+	return value
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,82 @@
+package tlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConstraints(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  *Arg
+		want *Constraints
+	}{
+		{
+			name: "positive with maximum",
+			arg:  &Arg{Type: "int32", Description: "The maximum number of messages to be returned; must be positive and can't be greater than 100"},
+			want: &Constraints{Min: pointer(int64(1)), Max: pointer(int64(100))},
+		},
+		{
+			name: "between",
+			arg:  &Arg{Type: "int32", Description: "Time during which the password can be used; must be between 60 and 86400"},
+			want: &Constraints{Min: pointer(int64(60)), Max: pointer(int64(86400))},
+		},
+		{
+			name: "up to",
+			arg:  &Arg{Type: "int32", Description: "The maximum number of photos to be returned; up to 100"},
+			want: &Constraints{Max: pointer(int64(100))},
+		},
+		{
+			name: "up to in a sentence",
+			arg:  &Arg{Type: "int53", Description: "Specify 0 to get results from exactly the message from_message_id or a negative offset up to 99 to get additionally some newer messages"},
+			want: nil,
+		},
+		{
+			name: "conditional clause",
+			arg:  &Arg{Type: "int32", Description: "Period for which the location can be updated, in seconds; must be between 60 and 86400 for a live location and 0 otherwise"},
+			want: nil,
+		},
+		{
+			name: "string length",
+			arg:  &Arg{Type: "string", Description: "New title of the chat; 1-128 characters"},
+			want: &Constraints{MinLength: pointer(int64(1)), MaxLength: pointer(int64(128))},
+		},
+		{
+			name: "formatted text list length",
+			arg:  &Arg{Type: "vector<formattedText>", Description: "List of poll answer options, 2-10 strings 1-100 characters each"},
+			want: &Constraints{MinLength: pointer(int64(1)), MaxLength: pointer(int64(100))},
+		},
+		{
+			name: "length of a non-string",
+			arg:  &Arg{Type: "int53", Description: "Identifier; 1-128 characters"},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseConstraints(tt.arg)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseConstraints() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNullable(t *testing.T) {
+	tests := []struct {
+		description string
+		want        bool
+	}{
+		{"Information about the message or story to be replied; pass null if none", true},
+		{"Chat photo; may be null", true},
+		{"The content of the message to be sent", false},
+		{"Username for logging in; may be empty", false},
+	}
+
+	for _, tt := range tests {
+		if got := isNullable(tt.description); got != tt.want {
+			t.Errorf("isNullable(%q) = %v, want %v", tt.description, got, tt.want)
+		}
+	}
+}
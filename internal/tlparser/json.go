@@ -0,0 +1,32 @@
+package tlparser
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ParseFunctionTypes sets the types of the schema functions from a JSON schema made by ParseCode (e.g. data/td_api.json).
+// Functions missing in the JSON schema are common.
+func ParseFunctionTypes(reader io.Reader, schema *Schema) error {
+	var jsonSchema Schema
+	err := json.NewDecoder(reader).Decode(&jsonSchema)
+	if err != nil {
+		return err
+	}
+
+	functionTypes := map[string]FunctionType{}
+	for _, fn := range jsonSchema.Functions {
+		functionTypes[fn.Name] = fn.Type
+	}
+
+	for _, fn := range schema.Functions {
+		fn.Type = FUNCTION_TYPE_COMMON
+
+		functionType, ok := functionTypes[fn.Name]
+		if ok && functionType != "" {
+			fn.Type = functionType
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package tlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+type lineKind int
+
+const (
+	lineBlank lineKind = iota
+	// "//@name value ..." documentation line
+	lineDoc
+	// "//-..." continuation of the documentation
+	lineDocContinuation
+	// any other comment
+	lineComment
+	// "---functions---" or "---types---"
+	lineSection
+	lineDeclaration
+)
+
+// line is a classified line of the schema
+type line struct {
+	kind lineKind
+	text string
+	// number of the line, starting from 1
+	number int
+}
+
+func classifyLine(text string, number int) *line {
+	trimmed := strings.TrimSpace(text)
+
+	kind := lineDeclaration
+	switch {
+	case trimmed == "":
+		kind = lineBlank
+	case strings.HasPrefix(text, "//@"):
+		kind = lineDoc
+	case strings.HasPrefix(text, "//-"):
+		kind = lineDocContinuation
+	case strings.HasPrefix(trimmed, "//"):
+		kind = lineComment
+	case strings.HasPrefix(trimmed, "---") && strings.HasSuffix(trimmed, "---"):
+		kind = lineSection
+	}
+
+	return &line{
+		kind:   kind,
+		text:   text,
+		number: number,
+	}
+}
+
+// word is a whitespace separated word of a documentation line
+type word struct {
+	text   string
+	line   int
+	column int
+}
+
+// docWords splits the text of a documentation line into words. The comment prefix is skipped
+func (line *line) docWords() []word {
+	start := len("//")
+	if line.kind == lineDocContinuation {
+		start = len("//-")
+	}
+
+	var words []word
+	for i := start; i < len(line.text); {
+		if isSpace(line.text[i]) {
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(line.text) && !isSpace(line.text[end]) {
+			end++
+		}
+
+		words = append(words, word{text: line.text[i:end], line: line.number, column: i + 1})
+		i = end
+	}
+
+	return words
+}
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenPunct
+	tokenEOL
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	column int
+}
+
+func (token token) String() string {
+	if token.kind == tokenEOL {
+		return "end of line"
+	}
+
+	return fmt.Sprintf("%q", token.text)
+}
+
+// tokenize splits a declaration line into identifiers and punctuation
+func (line *line) tokenize() ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(line.text); {
+		c := line.text[i]
+
+		switch {
+		case isSpace(c):
+			i++
+
+		case strings.HasPrefix(line.text[i:], "//"):
+			i = len(line.text)
+
+		case isIdentChar(c):
+			end := i
+			for end < len(line.text) && isIdentChar(line.text[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: line.text[i:end], line: line.number, column: i + 1})
+			i = end
+
+		case strings.IndexByte(":=;{}#?[]<>", c) >= 0:
+			tokens = append(tokens, token{kind: tokenPunct, text: string(c), line: line.number, column: i + 1})
+			i++
+
+		default:
+			return nil, &SyntaxError{Line: line.number, Column: i + 1, Message: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOL, line: line.number, column: len(line.text) + 1})
+
+	return tokens, nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r'
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -2,171 +2,397 @@ package tlparser
 
 import (
 	"bufio"
+	"fmt"
 	"io"
 	"strings"
 )
 
+// SyntaxError is a schema error with the position of its cause
+type SyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", err.Line, err.Column, err.Message)
+}
+
+// Parse parses the TL schema in the format of td_api.tl: documented declarations of constructors and functions,
+// "//@class" declarations of classes and the "---functions---" section separator.
 func Parse(reader io.Reader) (*Schema, error) {
-	schema := &Schema{
-		Constructors: []*Constructor{},
-		Types:        []*Type{},
-		Functions:    []*Function{},
-	}
+	var lines []*line
 
 	scanner := bufio.NewScanner(reader)
+	for number := 1; scanner.Scan(); number++ {
+		lines = append(lines, classifyLine(scanner.Text(), number))
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
 
-	hitFunctions := false
+	parser := &parser{
+		lines: lines,
+		schema: &Schema{
+			Constructors: []*Constructor{},
+			Types:        []*Type{},
+			Functions:    []*Function{},
+		},
+	}
 
-	for scanner.Scan() {
-		line := scanner.Text()
+	err = parser.parse()
+	if err != nil {
+		return nil, err
+	}
 
-		switch {
-		case strings.HasPrefix(line, "//@description"):
-			if hitFunctions {
-				schema.Functions = append(schema.Functions, parseFunction(line, scanner))
-			} else {
-				schema.Constructors = append(schema.Constructors, parseConstructor(line, scanner))
-			}
+	return parser.schema, nil
+}
 
-		case strings.HasPrefix(line, "//@class"):
-			schema.Types = append(schema.Types, parseType(line, scanner))
+type parser struct {
+	lines       []*line
+	position    int
+	isFunctions bool
+	schema      *Schema
+}
 
-		case strings.Contains(line, "---functions---"):
-			hitFunctions = true
+// tag is a "@name value" pair of the documentation
+type tag struct {
+	name   string
+	value  []string
+	line   int
+	column int
+}
 
-		case line == "":
+func (tag *tag) text() string {
+	return strings.Join(tag.value, " ")
+}
 
-		default:
-			bodyFields := strings.Fields(line)
-			name := bodyFields[0]
-			resultType := strings.TrimRight(bodyFields[len(bodyFields)-1], ";")
-			if hitFunctions {
-				schema.Functions = append(schema.Functions, &Function{
-					Name:          name,
-					Description:   "",
-					Args:          []*Arg{},
-					ResultType:    resultType,
-					IsSynchronous: false,
-					Type:          FUNCTION_TYPE_COMMON,
-				})
-			} else {
-				if name == "vector" {
-					name = "vector<t>"
-					resultType = "Vector<T>"
+func (parser *parser) parse() error {
+	for parser.position < len(parser.lines) {
+		line := parser.lines[parser.position]
+
+		switch line.kind {
+		case lineBlank, lineComment:
+			parser.position++
+
+		case lineSection:
+			switch strings.Trim(strings.TrimSpace(line.text), "-") {
+			case "functions":
+				parser.isFunctions = true
+			case "types":
+				parser.isFunctions = false
+			default:
+				return &SyntaxError{Line: line.number, Column: 1, Message: fmt.Sprintf("unknown section %q", strings.TrimSpace(line.text))}
+			}
+			parser.position++
+
+		case lineDocContinuation:
+			return &SyntaxError{Line: line.number, Column: 1, Message: "documentation continuation without documentation"}
+
+		case lineDoc:
+			tags, err := parser.parseDoc()
+			if err != nil {
+				return err
+			}
+
+			if findTag(tags, "class") != nil {
+				err = parser.parseClass(tags)
+				if err != nil {
+					return err
 				}
+				continue
+			}
 
-				schema.Constructors = append(schema.Constructors, &Constructor{
-					Name:        name,
-					Description: "",
-					Args:        []*Arg{},
-					ResultType:  resultType,
-				})
+			if parser.position >= len(parser.lines) || parser.lines[parser.position].kind != lineDeclaration {
+				return &SyntaxError{Line: tags[0].line, Column: tags[0].column, Message: "documentation is not followed by a declaration"}
 			}
+
+			err = parser.parseDeclaration(parser.lines[parser.position], tags)
+			if err != nil {
+				return err
+			}
+			parser.position++
+
+		case lineDeclaration:
+			err := parser.parseDeclaration(line, nil)
+			if err != nil {
+				return err
+			}
+			parser.position++
 		}
 	}
 
-	return schema, nil
+	return nil
 }
 
-func parseConstructor(firstLine string, scanner *bufio.Scanner) *Constructor {
-	name, description, args, resultType, _ := parseEntity(firstLine, scanner)
-	return &Constructor{
-		Name:        name,
-		Description: description,
-		Args:        args,
-		ResultType:  resultType,
+// parseDoc parses the documentation lines starting at the current position into tags
+func (parser *parser) parseDoc() ([]*tag, error) {
+	var tags []*tag
+
+	for ; parser.position < len(parser.lines); parser.position++ {
+		line := parser.lines[parser.position]
+		if line.kind != lineDoc && line.kind != lineDocContinuation {
+			break
+		}
+
+		for _, word := range line.docWords() {
+			if strings.HasPrefix(word.text, "@") && len(word.text) > 1 {
+				tags = append(tags, &tag{name: word.text[1:], line: word.line, column: word.column})
+				continue
+			}
+
+			if len(tags) == 0 {
+				return nil, &SyntaxError{Line: word.line, Column: word.column, Message: fmt.Sprintf("expected documentation tag, got %q", word.text)}
+			}
+			tags[len(tags)-1].value = append(tags[len(tags)-1].value, word.text)
+		}
 	}
-}
 
-func parseFunction(firstLine string, scanner *bufio.Scanner) *Function {
-	name, description, args, resultType, isSynchronous := parseEntity(firstLine, scanner)
-	return &Function{
-		Name:          name,
-		Description:   description,
-		Args:          args,
-		ResultType:    resultType,
-		IsSynchronous: isSynchronous,
-		Type:          FUNCTION_TYPE_COMMON,
+	if len(tags) == 0 {
+		line := parser.lines[parser.position-1]
+		return nil, &SyntaxError{Line: line.number, Column: 3, Message: "empty documentation tag"}
 	}
-}
 
-func parseType(firstLine string, scanner *bufio.Scanner) *Type {
-	typeLineParts := strings.Split(firstLine, "@")
+	return tags, nil
+}
 
-	_, name := parseArg(typeLineParts[1])
-	_, description := parseArg(typeLineParts[2])
+func (parser *parser) parseClass(tags []*tag) error {
+	classTag := findTag(tags, "class")
+	if len(classTag.value) != 1 {
+		return &SyntaxError{Line: classTag.line, Column: classTag.column, Message: "expected a single class name"}
+	}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		if !strings.HasPrefix(line, "//-") {
-			break
+	description := ""
+	for _, tag := range tags {
+		switch tag.name {
+		case "class":
+		case "description":
+			description = tag.text()
+		default:
+			return &SyntaxError{Line: tag.line, Column: tag.column, Message: fmt.Sprintf("unexpected tag @%s of class %s", tag.name, classTag.value[0])}
 		}
-		description += " " + strings.TrimLeft(line, "//-")
 	}
 
-	return &Type{
-		Name:        name,
+	parser.schema.Types = append(parser.schema.Types, &Type{
+		Name:        classTag.value[0],
 		Description: description,
-	}
+	})
+
+	return nil
 }
 
-func parseEntity(firstLine string, scanner *bufio.Scanner) (string, string, []*Arg, string, bool) {
-	name := ""
-	description := ""
-	args := []*Arg{}
-	resultType := ""
+func (parser *parser) parseDeclaration(line *line, tags []*tag) error {
+	tokens, err := line.tokenize()
+	if err != nil {
+		return err
+	}
 
-	argsLine := strings.TrimLeft(firstLine, "//")
+	declaration := &declarationParser{tokens: tokens}
 
-Loop:
-	for scanner.Scan() {
-		line := scanner.Text()
+	name, args, resultType, err := declaration.parse()
+	if err != nil {
+		return err
+	}
 
-		switch {
-		case strings.HasPrefix(line, "//@"):
-			argsLine += " " + strings.TrimLeft(line, "//")
+	if name == "vector" {
+		name = "vector<t>"
+		resultType = "Vector<T>"
+	}
 
-		case strings.HasPrefix(line, "//-"):
-			argsLine += " " + strings.TrimLeft(line, "//-")
+	description := ""
+	for _, tag := range tags {
+		switch tag.name {
+		case "description":
+			description = tag.text()
 
 		default:
-			bodyFields := strings.Fields(line)
-			name = bodyFields[0]
-
-			for _, rawArg := range bodyFields[1 : len(bodyFields)-2] {
-				argParts := strings.Split(rawArg, ":")
-				arg := &Arg{
-					Name:        argParts[0],
-					Description: "",
-					Type:        argParts[1],
-				}
-				args = append(args, arg)
+			arg := getArg(args, strings.TrimPrefix(tag.name, "param_"))
+			if arg == nil {
+				return &SyntaxError{Line: tag.line, Column: tag.column, Message: fmt.Sprintf("unknown parameter %q of %s", tag.name, name)}
 			}
-			resultType = strings.TrimRight(bodyFields[len(bodyFields)-1], ";")
-			break Loop
+			arg.Description = tag.text()
 		}
 	}
 
-	rawArgs := strings.Split(argsLine, "@")
-	for _, rawArg := range rawArgs[1:] {
-		name, value := parseArg(rawArg)
-		switch {
-		case name == "description":
-			description = value
-		default:
-			name = strings.TrimPrefix(name, "param_")
-			arg := getArg(args, name)
-			arg.Description = value
+	for _, arg := range args {
+		arg.Nullable = isNullable(arg.Description)
+		arg.Constraints = parseConstraints(arg)
+	}
+
+	if parser.isFunctions {
+		parser.schema.Functions = append(parser.schema.Functions, &Function{
+			Name:          name,
+			Description:   description,
+			Args:          args,
+			ResultType:    resultType,
+			IsSynchronous: strings.Contains(description, "Can be called synchronously"),
+			Type:          FUNCTION_TYPE_COMMON,
+		})
+	} else {
+		parser.schema.Constructors = append(parser.schema.Constructors, &Constructor{
+			Name:        name,
+			Description: description,
+			Args:        args,
+			ResultType:  resultType,
+		})
+	}
+
+	return nil
+}
+
+// declarationParser parses "name {t:Type} # [ t ] arg:type ... = ResultType;"
+type declarationParser struct {
+	tokens   []token
+	position int
+}
+
+func (parser *declarationParser) parse() (string, []*Arg, string, error) {
+	name, err := parser.expectIdent("declaration name")
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	if parser.peek().text == "{" {
+		err = parser.skipUntil("}")
+		if err != nil {
+			return "", nil, "", err
+		}
+	}
+
+	if parser.peek().text == "?" {
+		parser.next()
+	}
+
+	if parser.peek().text == "#" {
+		parser.next()
+		if parser.peek().text != "[" {
+			return "", nil, "", parser.unexpected("\"[\"")
 		}
+		err = parser.skipUntil("]")
+		if err != nil {
+			return "", nil, "", err
+		}
+	}
+
+	args := []*Arg{}
+	for parser.peek().kind == tokenIdent {
+		argName := parser.next().text
+
+		if parser.peek().text != ":" {
+			return "", nil, "", parser.unexpected("\":\" after the argument name")
+		}
+		parser.next()
+
+		argType, err := parser.parseType()
+		if err != nil {
+			return "", nil, "", err
+		}
+
+		args = append(args, &Arg{
+			Name:        argName,
+			Description: "",
+			Type:        argType,
+		})
+	}
+
+	if parser.peek().text != "=" {
+		return "", nil, "", parser.unexpected("argument or \"=\"")
+	}
+	parser.next()
+
+	resultType, err := parser.parseType()
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	// type parameters of the result type
+	for parser.peek().kind == tokenIdent {
+		parser.next()
+	}
+
+	if parser.peek().text != ";" {
+		return "", nil, "", parser.unexpected("\";\"")
+	}
+	parser.next()
+
+	if parser.peek().kind != tokenEOL {
+		return "", nil, "", parser.unexpected("end of line")
 	}
 
-	return name, description, args, resultType, strings.Contains(description, "Can be called synchronously")
+	return name, args, resultType, nil
 }
 
-func parseArg(str string) (string, string) {
-	strParts := strings.Fields(str)
+// parseType parses "type" or "type<type>"
+func (parser *declarationParser) parseType() (string, error) {
+	name, err := parser.expectIdent("type")
+	if err != nil {
+		return "", err
+	}
+
+	if parser.peek().text != "<" {
+		return name, nil
+	}
+	parser.next()
+
+	argType, err := parser.parseType()
+	if err != nil {
+		return "", err
+	}
+
+	if parser.peek().text != ">" {
+		return "", parser.unexpected("\">\"")
+	}
+	parser.next()
+
+	return name + "<" + argType + ">", nil
+}
+
+func (parser *declarationParser) peek() token {
+	return parser.tokens[parser.position]
+}
+
+func (parser *declarationParser) next() token {
+	token := parser.tokens[parser.position]
+	if token.kind != tokenEOL {
+		parser.position++
+	}
+
+	return token
+}
 
-	return strParts[0], strings.Join(strParts[1:], " ")
+func (parser *declarationParser) expectIdent(what string) (string, error) {
+	if parser.peek().kind != tokenIdent {
+		return "", parser.unexpected(what)
+	}
+
+	return parser.next().text, nil
+}
+
+func (parser *declarationParser) skipUntil(text string) error {
+	for parser.peek().kind != tokenEOL {
+		if parser.next().text == text {
+			return nil
+		}
+	}
+
+	return parser.unexpected(fmt.Sprintf("%q", text))
+}
+
+func (parser *declarationParser) unexpected(expected string) error {
+	token := parser.peek()
+	return &SyntaxError{Line: token.line, Column: token.column, Message: fmt.Sprintf("expected %s, got %s", expected, token)}
+}
+
+func findTag(tags []*tag, name string) *tag {
+	for _, tag := range tags {
+		if tag.name == name {
+			return tag
+		}
+	}
+
+	return nil
 }
 
 func getArg(args []*Arg, name string) *Arg {
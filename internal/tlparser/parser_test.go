@@ -0,0 +1,159 @@
+package tlparser
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	schema, err := Parse(strings.NewReader(`int32 = Int32;
+vector {t:Type} # [ t ] = Vector t;
+
+//@class Color @description Describes a color
+//-of an object
+
+//@description Red color
+colorRed = Color;
+
+// a regular comment
+//@description A palette @colors List of colors
+//@param_name Name of the palette;
+//-may be empty
+palette colors:vector<vector<Color>> name:string = Palette;
+
+---functions---
+
+//@description Returns the palette. Can be called synchronously
+getPalette = Palette;
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(schema.Constructors) != 4 || len(schema.Types) != 1 || len(schema.Functions) != 1 {
+		t.Fatalf("schema = %+v", schema)
+	}
+
+	if schema.Constructors[1].Name != "vector<t>" || schema.Constructors[1].ResultType != "Vector<T>" {
+		t.Errorf("vector = %+v", schema.Constructors[1])
+	}
+
+	if schema.Types[0].Description != "Describes a color of an object" {
+		t.Errorf("class description = %q", schema.Types[0].Description)
+	}
+
+	palette := schema.Constructors[3]
+	if palette.Args[0].Type != "vector<vector<Color>>" || palette.Args[1].Description != "Name of the palette; may be empty" {
+		t.Errorf("palette args = %+v %+v", palette.Args[0], palette.Args[1])
+	}
+
+	if !schema.Functions[0].IsSynchronous || schema.Functions[0].ResultType != "Palette" {
+		t.Errorf("function = %+v", schema.Functions[0])
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{
+			name:   "unknown parameter",
+			schema: "//@description Color @value Value @alpha Alpha\ncolor value:int32 = Color;",
+			err:    `1:35: unknown parameter "alpha" of color`,
+		},
+		{
+			name:   "missing argument type",
+			schema: "//@description Color\ncolor value = Color;",
+			err:    `2:13: expected ":" after the argument name, got "="`,
+		},
+		{
+			name:   "missing semicolon",
+			schema: "//@description Color\ncolor = Color",
+			err:    `2:14: expected ";", got end of line`,
+		},
+		{
+			name:   "unclosed vector",
+			schema: "//@description Color\ncolor values:vector<int32 = Color;",
+			err:    `2:27: expected ">", got "="`,
+		},
+		{
+			name:   "unexpected character",
+			schema: "//@description Color\ncolor value:int32 = Color!;",
+			err:    `2:26: unexpected character '!'`,
+		},
+		{
+			name:   "documentation without declaration",
+			schema: "//@description Color\n\ncolor = Color;",
+			err:    `1:3: documentation is not followed by a declaration`,
+		},
+		{
+			name:   "text before the first tag",
+			schema: "//@ description Color\ncolor = Color;",
+			err:    `1:3: expected documentation tag, got "@"`,
+		},
+		{
+			name:   "class without name",
+			schema: "//@class @description Color",
+			err:    `1:3: expected a single class name`,
+		},
+		{
+			name:   "continuation without documentation",
+			schema: "//-Color\ncolor = Color;",
+			err:    `1:1: documentation continuation without documentation`,
+		},
+		{
+			name:   "unknown section",
+			schema: "---methods---",
+			err:    `1:1: unknown section "---methods---"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.schema))
+
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("error = %v, want *SyntaxError", err)
+			}
+			if err.Error() != tt.err {
+				t.Errorf("error = %q, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	data, err := os.ReadFile("../../data/td_api.tl")
+	if err != nil {
+		f.Fatalf("read error: %s", err)
+	}
+
+	// the whole schema and every declaration with its documentation
+	f.Add(string(data))
+	for _, block := range strings.Split(string(data), "\n\n") {
+		f.Add(block)
+	}
+
+	f.Fuzz(func(t *testing.T, schema string) {
+		result, err := Parse(strings.NewReader(schema))
+		if err != nil {
+			return
+		}
+
+		for _, constructor := range result.Constructors {
+			if constructor.Name == "" || constructor.ResultType == "" {
+				t.Errorf("constructor without name or result type: %+v", constructor)
+			}
+		}
+		for _, function := range result.Functions {
+			if function.Name == "" || function.ResultType == "" {
+				t.Errorf("function without name or result type: %+v", function)
+			}
+		}
+	})
+}
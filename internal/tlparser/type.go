@@ -36,7 +36,17 @@ type Function struct {
 }
 
 type Arg struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Type        string `json:"type"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Type        string       `json:"type"`
+	Nullable    bool         `json:"nullable,omitempty"`
+	Constraints *Constraints `json:"constraints,omitempty"`
+}
+
+// Constraints are the value limits documented in the argument description
+type Constraints struct {
+	Min       *int64 `json:"min,omitempty"`
+	Max       *int64 `json:"max,omitempty"`
+	MinLength *int64 `json:"min_length,omitempty"`
+	MaxLength *int64 `json:"max_length,omitempty"`
 }
@@ -0,0 +1,27 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+// ChatEventLog iterates over the event log of a supergroup or a channel from the latest event. FromEventId and Limit of req are ignored.
+func ChatEventLog(ctx context.Context, tdlibClient client.TDLib, req *client.GetChatEventLogRequest, options ...Option) iter.Seq2[*client.ChatEvent, error] {
+	return Paginate(ctx, Cursor(func(ctx context.Context, fromEventId client.JsonInt64, limit int32) ([]*client.ChatEvent, client.JsonInt64, error) {
+		pageReq := *req
+		pageReq.FromEventId = fromEventId
+		pageReq.Limit = limit
+
+		chatEvents, err := tdlibClient.GetChatEventLog(ctx, &pageReq)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if len(chatEvents.Events) == 0 {
+			return nil, 0, nil
+		}
+
+		return chatEvents.Events, chatEvents.Events[len(chatEvents.Events)-1].Id, nil
+	}), options...)
+}
@@ -0,0 +1,64 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+func TestChatEventLog(t *testing.T) {
+	events := []*client.ChatEvent{{Id: 50}, {Id: 40}, {Id: 30}, {Id: 20}, {Id: 10}}
+
+	mock := &client.Mock{
+		GetChatEventLogFunc: func(ctx context.Context, req *client.GetChatEventLogRequest) (*client.ChatEvents, error) {
+			// events older than from_event_id, starting from the latest event
+			var page []*client.ChatEvent
+			for _, event := range events {
+				if (req.FromEventId == 0 || event.Id < req.FromEventId) && int32(len(page)) < req.Limit {
+					page = append(page, event)
+				}
+			}
+
+			return &client.ChatEvents{Events: page}, nil
+		},
+	}
+
+	newSeq := func(options ...Option) func(yield func(*client.ChatEvent, error) bool) {
+		return ChatEventLog(context.Background(), mock, &client.GetChatEventLogRequest{ChatId: 1, Query: "query", FromEventId: 30, Limit: 1}, append(options, WithPageSize(2))...)
+	}
+
+	items, err := collect(t, newSeq())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	eventId := func(event *client.ChatEvent) client.JsonInt64 {
+		return event.Id
+	}
+
+	var ids []client.JsonInt64
+	for _, event := range items {
+		ids = append(ids, eventId(event))
+	}
+
+	want := []client.JsonInt64{50, 40, 30, 20, 10}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	var fromEventIds []client.JsonInt64
+	for _, call := range mock.CallsOf("getChatEventLog") {
+		req := call.Request.(*client.GetChatEventLogRequest)
+		if req.ChatId != 1 || req.Query != "query" || req.Limit != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		fromEventIds = append(fromEventIds, req.FromEventId)
+	}
+
+	if wantFromEventIds := []client.JsonInt64{0, 40, 20, 10}; !reflect.DeepEqual(fromEventIds, wantFromEventIds) {
+		t.Errorf("from event ids = %v, want %v", fromEventIds, wantFromEventIds)
+	}
+
+	testResume(t, newSeq, eventId, want)
+}
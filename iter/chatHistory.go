@@ -6,37 +6,23 @@ import (
 	"iter"
 )
 
-func ChatHistory(ctx context.Context, tdlibClient *client.Client, chatId int64) iter.Seq2[*client.Message, error] {
-	return func(yield func(*client.Message, error) bool) {
-		const offset int32 = 0
-		const limit int32 = 100
-		const onlyLocal = false
-
-		var fromMessageId int64 = 0
-
-		for {
-			messages, err := tdlibClient.GetChatHistory(ctx, &client.GetChatHistoryRequest{
-				ChatId:        chatId,
-				FromMessageId: fromMessageId,
-				Offset:        offset,
-				Limit:         limit,
-				OnlyLocal:     onlyLocal,
-			})
-			if err != nil {
-				yield(nil, err)
-				return
-			}
+func ChatHistory(ctx context.Context, tdlibClient client.TDLib, chatId int64, options ...Option) iter.Seq2[*client.Message, error] {
+	return Paginate(ctx, FromMessageId(func(ctx context.Context, fromMessageId int64, limit int32) ([]*client.Message, error) {
+		messages, err := tdlibClient.GetChatHistory(ctx, &client.GetChatHistoryRequest{
+			ChatId:        chatId,
+			FromMessageId: fromMessageId,
+			Offset:        0,
+			Limit:         limit,
+			OnlyLocal:     false,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-			for _, message := range messages.Messages {
-				fromMessageId = message.Id
-				if !yield(message, nil) {
-					return
-				}
-			}
+		return messages.Messages, nil
+	}, messageIdOf), options...)
+}
 
-			if len(messages.Messages) == 0 {
-				return
-			}
-		}
-	}
+func messageIdOf(message *client.Message) int64 {
+	return message.Id
 }
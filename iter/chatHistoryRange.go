@@ -0,0 +1,263 @@
+package iter
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+type Direction int
+
+const (
+	// Backward iterates from newer messages to older ones
+	Backward Direction = iota
+	// Forward iterates from older messages to newer ones
+	Forward
+)
+
+// HistoryRange bounds chat history. Bounds are inclusive, zero values mean no bound.
+type HistoryRange struct {
+	MinDate      int32
+	MaxDate      int32
+	MinMessageId int64
+	MaxMessageId int64
+}
+
+func (historyRange HistoryRange) isBelow(message *client.Message) bool {
+	return (historyRange.MinDate != 0 && message.Date < historyRange.MinDate) ||
+		(historyRange.MinMessageId != 0 && message.Id < historyRange.MinMessageId)
+}
+
+func (historyRange HistoryRange) isAbove(message *client.Message) bool {
+	return (historyRange.MaxDate != 0 && message.Date > historyRange.MaxDate) ||
+		(historyRange.MaxMessageId != 0 && message.Id > historyRange.MaxMessageId)
+}
+
+type historyFetcher func(ctx context.Context, fromMessageId int64, offset int32, limit int32) ([]*client.Message, error)
+
+type messageByDateFetcher func(ctx context.Context, date int32) (*client.Message, error)
+
+type historyRangePager struct {
+	fetch         historyFetcher
+	messageByDate messageByDateFetcher
+	historyRange  HistoryRange
+	direction     Direction
+	position      historyRangePosition
+}
+
+type historyRangePosition struct {
+	IsStarted     bool  `json:"is_started"`
+	FromMessageId int64 `json:"from_message_id"`
+	Offset        int32 `json:"offset"`
+	LastMessageId int64 `json:"last_message_id"`
+}
+
+// ChatHistoryRange iterates over chat history within the range in the specified direction.
+// The starting message is found with GetChatMessageByDate for date bounds, so the history before the range is not loaded.
+func ChatHistoryRange(ctx context.Context, tdlibClient client.TDLib, chatId int64, historyRange HistoryRange, direction Direction, options ...Option) iter.Seq2[*client.Message, error] {
+	fetch := func(ctx context.Context, fromMessageId int64, offset int32, limit int32) ([]*client.Message, error) {
+		messages, err := tdlibClient.GetChatHistory(ctx, &client.GetChatHistoryRequest{
+			ChatId:        chatId,
+			FromMessageId: fromMessageId,
+			Offset:        offset,
+			Limit:         limit,
+			OnlyLocal:     false,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return messages.Messages, nil
+	}
+
+	messageByDate := func(ctx context.Context, date int32) (*client.Message, error) {
+		message, err := tdlibClient.GetChatMessageByDate(ctx, &client.GetChatMessageByDateRequest{
+			ChatId: chatId,
+			Date:   date,
+		})
+		if isNotFound(err) {
+			return nil, nil
+		}
+
+		return message, err
+	}
+
+	return Paginate(ctx, func() Pager[*client.Message] {
+		return &historyRangePager{
+			fetch:         fetch,
+			messageByDate: messageByDate,
+			historyRange:  historyRange,
+			direction:     direction,
+		}
+	}, options...)
+}
+
+func (pager *historyRangePager) NextPage(ctx context.Context, limit int32) ([]*client.Message, bool, error) {
+	if !pager.position.IsStarted {
+		last, err := pager.start(ctx)
+		if err != nil || last {
+			return nil, last, err
+		}
+	}
+
+	for {
+		var page []*client.Message
+		var last bool
+		var err error
+
+		if pager.direction == Forward {
+			page, last, err = pager.nextForwardPage(ctx, limit)
+		} else {
+			page, last, err = pager.nextBackwardPage(ctx, limit)
+		}
+
+		// pages with messages outside the range only are skipped
+		if err != nil || last || len(page) > 0 {
+			return page, last, err
+		}
+	}
+}
+
+// start finds the message from which the iteration begins
+func (pager *historyRangePager) start(ctx context.Context) (bool, error) {
+	if pager.direction == Forward {
+		// the first message of the chat history
+		pager.position.FromMessageId = 1
+		pager.position.LastMessageId = 0
+
+		if pager.historyRange.MinDate != 0 {
+			message, err := pager.messageByDate(ctx, pager.historyRange.MinDate)
+			if err != nil {
+				return false, err
+			}
+			if message != nil {
+				pager.position.FromMessageId = message.Id
+			}
+		}
+
+		if pager.historyRange.MinMessageId > pager.position.FromMessageId {
+			pager.position.FromMessageId = pager.historyRange.MinMessageId
+		}
+	} else {
+		pager.position.FromMessageId = 0
+
+		if pager.historyRange.MaxDate != 0 {
+			message, err := pager.messageByDate(ctx, pager.historyRange.MaxDate)
+			if err != nil {
+				return false, err
+			}
+			if message == nil {
+				// there are no messages before the range
+				return true, nil
+			}
+			pager.position.FromMessageId = message.Id
+		}
+
+		if pager.historyRange.MaxMessageId != 0 && (pager.position.FromMessageId == 0 || pager.historyRange.MaxMessageId < pager.position.FromMessageId) {
+			pager.position.FromMessageId = pager.historyRange.MaxMessageId
+		}
+
+		// the starting message itself must be included
+		if pager.position.FromMessageId != 0 {
+			pager.position.Offset = -1
+		}
+	}
+
+	pager.position.IsStarted = true
+
+	return false, nil
+}
+
+func (pager *historyRangePager) nextBackwardPage(ctx context.Context, limit int32) ([]*client.Message, bool, error) {
+	messages, err := pager.fetch(ctx, pager.position.FromMessageId, pager.position.Offset, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	page := make([]*client.Message, 0, len(messages))
+	hasNewMessages := false
+
+	for _, message := range messages {
+		if pager.position.LastMessageId != 0 && message.Id >= pager.position.LastMessageId {
+			continue
+		}
+
+		hasNewMessages = true
+		pager.position.LastMessageId = message.Id
+
+		if pager.historyRange.isBelow(message) {
+			return page, true, nil
+		}
+
+		if pager.historyRange.isAbove(message) {
+			continue
+		}
+
+		page = append(page, message)
+	}
+
+	pager.position.FromMessageId = pager.position.LastMessageId
+	pager.position.Offset = 0
+
+	return page, !hasNewMessages, nil
+}
+
+func (pager *historyRangePager) nextForwardPage(ctx context.Context, limit int32) ([]*client.Message, bool, error) {
+	// a negative offset returns newer messages; TDLib returns them from newer to older
+	if limit < 2 {
+		limit = 2
+	}
+
+	messages, err := pager.fetch(ctx, pager.position.FromMessageId, -limit+1, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	page := make([]*client.Message, 0, len(messages))
+	hasNewMessages := false
+
+	for i := len(messages) - 1; i >= 0; i-- {
+		message := messages[i]
+
+		if message.Id <= pager.position.LastMessageId {
+			continue
+		}
+
+		hasNewMessages = true
+		pager.position.LastMessageId = message.Id
+
+		if pager.historyRange.isAbove(message) {
+			return page, true, nil
+		}
+
+		if pager.historyRange.isBelow(message) {
+			continue
+		}
+
+		page = append(page, message)
+	}
+
+	pager.position.FromMessageId = pager.position.LastMessageId
+
+	return page, !hasNewMessages, nil
+}
+
+func (pager *historyRangePager) Cursor() string {
+	data, err := json.Marshal(pager.position)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+func (pager *historyRangePager) SetCursor(cursor string) error {
+	pager.position = historyRangePosition{}
+
+	if cursor == "" {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(cursor), &pager.position)
+}
@@ -0,0 +1,97 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+// fakeHistory emulates GetChatHistory and GetChatMessageByDate over messages with ids 1..n sent at dates 10*id
+type fakeHistory struct {
+	n int64
+}
+
+func (history fakeHistory) message(id int64) *client.Message {
+	return &client.Message{Id: id, Date: int32(id * 10)}
+}
+
+func (history fakeHistory) fetch(ctx context.Context, fromMessageId int64, offset int32, limit int32) ([]*client.Message, error) {
+	if fromMessageId == 0 {
+		fromMessageId = history.n + 1
+	}
+
+	// messages from newer to older, starting -offset messages newer than fromMessageId
+	start := fromMessageId - int64(offset)
+	if offset == 0 {
+		start = fromMessageId - 1
+	}
+
+	var messages []*client.Message
+	for id := min(start, history.n); id >= 1 && int32(len(messages)) < limit; id-- {
+		messages = append(messages, history.message(id))
+	}
+
+	return messages, nil
+}
+
+func (history fakeHistory) messageByDate(ctx context.Context, date int32) (*client.Message, error) {
+	id := min(int64(date/10), history.n)
+	if id < 1 {
+		return nil, nil
+	}
+
+	return history.message(id), nil
+}
+
+func (history fakeHistory) collect(t *testing.T, historyRange HistoryRange, direction Direction) []int64 {
+	t.Helper()
+
+	seq := Paginate(context.Background(), func() Pager[*client.Message] {
+		return &historyRangePager{
+			fetch:         history.fetch,
+			messageByDate: history.messageByDate,
+			historyRange:  historyRange,
+			direction:     direction,
+		}
+	}, WithPageSize(3))
+
+	var ids []int64
+	for message, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		ids = append(ids, message.Id)
+	}
+
+	return ids
+}
+
+func TestChatHistoryRange(t *testing.T) {
+	history := fakeHistory{n: 20}
+
+	tests := []struct {
+		name         string
+		historyRange HistoryRange
+		direction    Direction
+		want         []int64
+	}{
+		{"backward_dates", HistoryRange{MinDate: 55, MaxDate: 100}, Backward, []int64{10, 9, 8, 7, 6}},
+		{"forward_dates", HistoryRange{MinDate: 55, MaxDate: 100}, Forward, []int64{6, 7, 8, 9, 10}},
+		{"backward_message_ids", HistoryRange{MinMessageId: 15, MaxMessageId: 17}, Backward, []int64{17, 16, 15}},
+		{"forward_message_ids", HistoryRange{MinMessageId: 15, MaxMessageId: 17}, Forward, []int64{15, 16, 17}},
+		{"forward_since", HistoryRange{MinDate: 170}, Forward, []int64{17, 18, 19, 20}},
+		{"backward_until", HistoryRange{MaxMessageId: 3}, Backward, []int64{3, 2, 1}},
+		{"backward_before_history", HistoryRange{MaxDate: 5}, Backward, nil},
+		{"forward_from_start", HistoryRange{MaxDate: 30}, Forward, []int64{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := history.collect(t, tt.historyRange, tt.direction)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("messages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
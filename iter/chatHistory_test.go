@@ -0,0 +1,41 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+func TestChatHistory(t *testing.T) {
+	history := fakeHistory{n: 5}
+
+	mock := &client.Mock{
+		GetChatHistoryFunc: func(ctx context.Context, req *client.GetChatHistoryRequest) (*client.Messages, error) {
+			messages, err := history.fetch(ctx, req.FromMessageId, req.Offset, req.Limit)
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.Messages{TotalCount: int32(len(messages)), Messages: messages}, nil
+		},
+	}
+
+	var ids []int64
+	for message, err := range ChatHistory(context.Background(), mock, 1, WithPageSize(2)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		ids = append(ids, message.Id)
+	}
+
+	if !reflect.DeepEqual(ids, []int64{5, 4, 3, 2, 1}) {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	for _, call := range mock.CallsOf("getChatHistory") {
+		if call.Request.(*client.GetChatHistoryRequest).ChatId != 1 {
+			t.Errorf("unexpected chat id in %+v", call.Request)
+		}
+	}
+}
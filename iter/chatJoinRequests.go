@@ -0,0 +1,27 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+// ChatJoinRequests iterates over pending join requests in a chat. OffsetRequest and Limit of req are ignored.
+func ChatJoinRequests(ctx context.Context, tdlibClient client.TDLib, req *client.GetChatJoinRequestsRequest, options ...Option) iter.Seq2[*client.ChatJoinRequest, error] {
+	return Paginate(ctx, Cursor(func(ctx context.Context, offsetRequest *client.ChatJoinRequest, limit int32) ([]*client.ChatJoinRequest, *client.ChatJoinRequest, error) {
+		pageReq := *req
+		pageReq.OffsetRequest = offsetRequest
+		pageReq.Limit = limit
+
+		chatJoinRequests, err := tdlibClient.GetChatJoinRequests(ctx, &pageReq)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(chatJoinRequests.Requests) == 0 {
+			return nil, nil, nil
+		}
+
+		return chatJoinRequests.Requests, chatJoinRequests.Requests[len(chatJoinRequests.Requests)-1], nil
+	}), options...)
+}
@@ -0,0 +1,72 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+func TestChatJoinRequests(t *testing.T) {
+	requests := []*client.ChatJoinRequest{{UserId: 7, Date: 50}, {UserId: 3, Date: 40}, {UserId: 9, Date: 30}, {UserId: 1, Date: 20}, {UserId: 5, Date: 10}}
+
+	mock := &client.Mock{
+		GetChatJoinRequestsFunc: func(ctx context.Context, req *client.GetChatJoinRequestsRequest) (*client.ChatJoinRequests, error) {
+			// requests after offset_request, which is a decoded copy after a resume
+			start := 0
+			if req.OffsetRequest != nil {
+				for i, request := range requests {
+					if request.UserId == req.OffsetRequest.UserId && request.Date == req.OffsetRequest.Date {
+						start = i + 1
+					}
+				}
+			}
+			end := min(start+int(req.Limit), len(requests))
+
+			return &client.ChatJoinRequests{TotalCount: int32(len(requests)), Requests: requests[start:end]}, nil
+		},
+	}
+
+	newSeq := func(options ...Option) func(yield func(*client.ChatJoinRequest, error) bool) {
+		return ChatJoinRequests(context.Background(), mock, &client.GetChatJoinRequestsRequest{ChatId: 1, InviteLink: "link", OffsetRequest: requests[2], Limit: 1}, append(options, WithPageSize(2))...)
+	}
+
+	items, err := collect(t, newSeq())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	userId := func(request *client.ChatJoinRequest) int64 {
+		return request.UserId
+	}
+
+	var userIds []int64
+	for _, request := range items {
+		userIds = append(userIds, userId(request))
+	}
+
+	want := []int64{7, 3, 9, 1, 5}
+	if !reflect.DeepEqual(userIds, want) {
+		t.Errorf("unexpected user ids: %v", userIds)
+	}
+
+	var offsetUserIds []int64
+	for _, call := range mock.CallsOf("getChatJoinRequests") {
+		req := call.Request.(*client.GetChatJoinRequestsRequest)
+		if req.ChatId != 1 || req.InviteLink != "link" || req.Limit != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+
+		var offsetUserId int64
+		if req.OffsetRequest != nil {
+			offsetUserId = req.OffsetRequest.UserId
+		}
+		offsetUserIds = append(offsetUserIds, offsetUserId)
+	}
+
+	if wantOffsetUserIds := []int64{0, 3, 1, 5}; !reflect.DeepEqual(offsetUserIds, wantOffsetUserIds) {
+		t.Errorf("offset user ids = %v, want %v", offsetUserIds, wantOffsetUserIds)
+	}
+
+	testResume(t, newSeq, userId, want)
+}
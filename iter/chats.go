@@ -0,0 +1,102 @@
+package iter
+
+import (
+	"context"
+	"errors"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+	"strconv"
+)
+
+type chatListPager struct {
+	tdlibClient client.TDLib
+	chatList    client.ChatList
+	loaded      int
+	isLoaded    bool
+}
+
+// Chats iterates over chats of a chat list in the order of the list. Chats are loaded with LoadChats until the whole list is loaded.
+// Pass nil chatList to iterate over the main chat list.
+func Chats(ctx context.Context, tdlibClient client.TDLib, chatList client.ChatList, options ...Option) iter.Seq2[*client.Chat, error] {
+	return Paginate(ctx, func() Pager[*client.Chat] {
+		return &chatListPager{
+			tdlibClient: tdlibClient,
+			chatList:    chatList,
+		}
+	}, options...)
+}
+
+func (pager *chatListPager) NextPage(ctx context.Context, limit int32) ([]*client.Chat, bool, error) {
+	for {
+		if !pager.isLoaded {
+			_, err := pager.tdlibClient.LoadChats(ctx, &client.LoadChatsRequest{
+				ChatList: pager.chatList,
+				Limit:    limit,
+			})
+			if err != nil && !isNotFound(err) {
+				return nil, false, err
+			}
+
+			// TDLib returns 404 error if all chats have been loaded
+			pager.isLoaded = err != nil
+		}
+
+		requested := pager.loaded + int(limit)
+
+		chats, err := pager.tdlibClient.GetChats(ctx, &client.GetChatsRequest{
+			ChatList: pager.chatList,
+			Limit:    int32(requested),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(chats.ChatIds) <= pager.loaded {
+			if pager.isLoaded {
+				return nil, true, nil
+			}
+			continue
+		}
+
+		page := make([]*client.Chat, 0, len(chats.ChatIds)-pager.loaded)
+		for _, chatId := range chats.ChatIds[pager.loaded:] {
+			chat, err := pager.tdlibClient.GetChat(ctx, &client.GetChatRequest{
+				ChatId: chatId,
+			})
+			if err != nil {
+				return nil, false, err
+			}
+
+			page = append(page, chat)
+		}
+
+		pager.loaded = len(chats.ChatIds)
+
+		return page, pager.isLoaded && pager.loaded < requested, nil
+	}
+}
+
+func (pager *chatListPager) Cursor() string {
+	return strconv.Itoa(pager.loaded)
+}
+
+func (pager *chatListPager) SetCursor(cursor string) error {
+	if cursor == "" {
+		cursor = "0"
+	}
+
+	loaded, err := strconv.Atoi(cursor)
+	if err != nil {
+		return err
+	}
+
+	pager.loaded = loaded
+	pager.isLoaded = false
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var responseError client.ResponseError
+	return errors.As(err, &responseError) && responseError.Err.Code == 404
+}
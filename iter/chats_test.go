@@ -0,0 +1,133 @@
+package iter
+
+import (
+	"context"
+	"errors"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+// fakeChatList emulates LoadChats, GetChats and GetChat over a chat list which TDLib loads from the server in portions
+type fakeChatList struct {
+	chatIds []int64
+	loaded  int
+}
+
+func (chatList *fakeChatList) mock() *client.Mock {
+	return &client.Mock{
+		LoadChatsFunc: func(ctx context.Context, req *client.LoadChatsRequest) (*client.Ok, error) {
+			if chatList.loaded == len(chatList.chatIds) {
+				return nil, client.ResponseError{Err: &client.Error{Code: 404, Message: "Not Found"}}
+			}
+
+			chatList.loaded = min(chatList.loaded+int(req.Limit), len(chatList.chatIds))
+
+			return &client.Ok{}, nil
+		},
+		GetChatsFunc: func(ctx context.Context, req *client.GetChatsRequest) (*client.Chats, error) {
+			chatIds := chatList.chatIds[:min(int(req.Limit), chatList.loaded)]
+
+			return &client.Chats{TotalCount: int32(len(chatList.chatIds)), ChatIds: chatIds}, nil
+		},
+		GetChatFunc: func(ctx context.Context, req *client.GetChatRequest) (*client.Chat, error) {
+			return &client.Chat{Id: req.ChatId}, nil
+		},
+	}
+}
+
+func chatIdOf(chat *client.Chat) int64 {
+	return chat.Id
+}
+
+func TestChats(t *testing.T) {
+	chatList := &fakeChatList{chatIds: []int64{30, 10, 50, 20, 40}}
+	mock := chatList.mock()
+
+	chats, err := collect(t, Chats(context.Background(), mock, &client.ChatListArchive{}, WithPageSize(2)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var ids []int64
+	for _, chat := range chats {
+		ids = append(ids, chatIdOf(chat))
+	}
+
+	if !reflect.DeepEqual(ids, chatList.chatIds) {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	var methods []string
+	for _, call := range mock.Calls() {
+		methods = append(methods, call.Method())
+
+		switch req := call.Request.(type) {
+		case *client.LoadChatsRequest:
+			if _, ok := req.ChatList.(*client.ChatListArchive); !ok || req.Limit != 2 {
+				t.Errorf("unexpected request %+v", req)
+			}
+		case *client.GetChatsRequest:
+			if _, ok := req.ChatList.(*client.ChatListArchive); !ok {
+				t.Errorf("unexpected request %+v", req)
+			}
+		}
+	}
+
+	// every chat is requested once, the iteration stops after 404 of LoadChats
+	wantMethods := []string{
+		"loadChats", "getChats", "getChat", "getChat",
+		"loadChats", "getChats", "getChat", "getChat",
+		"loadChats", "getChats", "getChat",
+		"loadChats", "getChats",
+	}
+	if !reflect.DeepEqual(methods, wantMethods) {
+		t.Errorf("methods = %v, want %v", methods, wantMethods)
+	}
+}
+
+func TestChatsLoadedList(t *testing.T) {
+	// all chats have been loaded before, e.g. by another iteration
+	chatList := &fakeChatList{chatIds: []int64{30, 10, 50}, loaded: 3}
+	mock := chatList.mock()
+
+	chats, err := collect(t, Chats(context.Background(), mock, nil, WithPageSize(2)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(chats) != 3 {
+		t.Errorf("chats = %d, want 3", len(chats))
+	}
+
+	if calls := len(mock.CallsOf("loadChats")); calls != 1 {
+		t.Errorf("loadChats calls = %d, want 1", calls)
+	}
+}
+
+func TestChatsError(t *testing.T) {
+	mock := &client.Mock{
+		LoadChatsFunc: func(ctx context.Context, req *client.LoadChatsRequest) (*client.Ok, error) {
+			return nil, client.ResponseError{Err: &client.Error{Code: 500, Message: "Internal"}}
+		},
+	}
+
+	_, err := collect(t, Chats(context.Background(), mock, nil))
+
+	var responseError client.ResponseError
+	if !errors.As(err, &responseError) || responseError.Err.Code != 500 {
+		t.Errorf("error = %v, want the error of LoadChats", err)
+	}
+}
+
+func TestChatsResume(t *testing.T) {
+	chatIds := []int64{30, 10, 50, 20, 40}
+
+	// every iteration starts with a new TDLib instance which hasn't loaded the chat list
+	newSeq := func(options ...Option) func(yield func(*client.Chat, error) bool) {
+		chatList := &fakeChatList{chatIds: chatIds}
+		return Chats(context.Background(), chatList.mock(), nil, append(options, WithPageSize(2))...)
+	}
+
+	testResume(t, newSeq, chatIdOf, chatIds)
+}
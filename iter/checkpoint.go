@@ -0,0 +1,58 @@
+package iter
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrNotResumable = errors.New("pager is not resumable")
+
+// ResumablePager is a pager whose position can be saved and restored
+type ResumablePager[T any] interface {
+	Pager[T]
+	// Cursor returns the position of the next page
+	Cursor() string
+	// SetCursor restores the position returned by Cursor
+	SetCursor(cursor string) error
+}
+
+// Checkpoint is a serializable position of an iteration: the cursor of the page being consumed
+// and the number of items of that page which have already been consumed
+type Checkpoint struct {
+	Cursor string `json:"cursor"`
+	Skip   int    `json:"skip"`
+}
+
+// Progress tracks the checkpoint of an iteration. It is updated after every consumed item.
+type Progress struct {
+	mu         sync.RWMutex
+	checkpoint Checkpoint
+}
+
+func (progress *Progress) Checkpoint() Checkpoint {
+	progress.mu.RLock()
+	defer progress.mu.RUnlock()
+
+	return progress.checkpoint
+}
+
+func (progress *Progress) set(checkpoint Checkpoint) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	progress.checkpoint = checkpoint
+}
+
+// WithProgress tracks the checkpoint of the iteration in progress
+func WithProgress(progress *Progress) Option {
+	return func(config *config) {
+		config.progress = progress
+	}
+}
+
+// Resume continues the iteration from the checkpoint
+func Resume(checkpoint Checkpoint) Option {
+	return func(config *config) {
+		config.checkpoint = &checkpoint
+	}
+}
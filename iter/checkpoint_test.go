@@ -0,0 +1,191 @@
+package iter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// collectUntil consumes n items and returns the serialized checkpoint
+func collectUntil[T any](t *testing.T, n int, newSeq func(options ...Option) func(yield func(T, error) bool)) ([]T, []byte) {
+	t.Helper()
+
+	progress := &Progress{}
+
+	var items []T
+	for item, err := range newSeq(WithProgress(progress)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		items = append(items, item)
+		if len(items) == n {
+			break
+		}
+	}
+
+	data, err := json.Marshal(progress.Checkpoint())
+	if err != nil {
+		t.Fatalf("checkpoint marshal error: %s", err)
+	}
+
+	return items, data
+}
+
+func resumeFrom[T any](t *testing.T, data []byte, newSeq func(options ...Option) func(yield func(T, error) bool)) []T {
+	t.Helper()
+
+	var checkpoint Checkpoint
+	err := json.Unmarshal(data, &checkpoint)
+	if err != nil {
+		t.Fatalf("checkpoint unmarshal error: %s", err)
+	}
+
+	items, err := collect(t, newSeq(Resume(checkpoint)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return items
+}
+
+func TestResume(t *testing.T) {
+	history := []int64{10, 9, 8, 7, 6, 5, 4}
+
+	fromMessageId := func(options ...Option) func(yield func(int64, error) bool) {
+		return Paginate(context.Background(), FromMessageId(func(ctx context.Context, fromMessageId int64, limit int32) ([]int64, error) {
+			var page []int64
+			for _, id := range history {
+				if (fromMessageId == 0 || id < fromMessageId) && int32(len(page)) < limit {
+					page = append(page, id)
+				}
+			}
+			return page, nil
+		}, func(id int64) int64 {
+			return id
+		}), append(options, WithPageSize(3))...)
+	}
+
+	nextOffset := func(options ...Option) func(yield func(int64, error) bool) {
+		return Paginate(context.Background(), NextOffset(func(ctx context.Context, offset string, limit int32) ([]int64, string, error) {
+			start, _ := strconv.Atoi(offset)
+			end := min(start+int(limit), len(history))
+			next := ""
+			if end < len(history) {
+				next = strconv.Itoa(end)
+			}
+			return history[start:end], next, nil
+		}), append(options, WithPageSize(3))...)
+	}
+
+	tests := []struct {
+		name   string
+		newSeq func(options ...Option) func(yield func(int64, error) bool)
+	}{
+		{"from_message_id", fromMessageId},
+		{"next_offset", nextOffset},
+	}
+
+	for _, tt := range tests {
+		for n := 1; n < len(history); n++ {
+			t.Run(tt.name+"_"+strconv.Itoa(n), func(t *testing.T) {
+				items, data := collectUntil(t, n, tt.newSeq)
+				items = append(items, resumeFrom(t, data, tt.newSeq)...)
+
+				if !reflect.DeepEqual(items, history) {
+					t.Errorf("items = %v, want %v", items, history)
+				}
+			})
+		}
+	}
+}
+
+func TestResumeChatHistoryRange(t *testing.T) {
+	history := fakeHistory{n: 20}
+
+	newSeq := func(options ...Option) func(yield func(*client.Message, error) bool) {
+		return Paginate(context.Background(), func() Pager[*client.Message] {
+			return &historyRangePager{
+				fetch:         history.fetch,
+				messageByDate: history.messageByDate,
+				historyRange:  HistoryRange{MinDate: 55, MaxDate: 150},
+				direction:     Forward,
+			}
+		}, append(options, WithPageSize(3))...)
+	}
+
+	messages, data := collectUntil(t, 4, newSeq)
+	messages = append(messages, resumeFrom(t, data, newSeq)...)
+
+	var ids []int64
+	for _, message := range messages {
+		ids = append(ids, message.Id)
+	}
+
+	want := []int64{6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("messages = %v, want %v", ids, want)
+	}
+}
+
+type plainPager struct{}
+
+func (pager plainPager) NextPage(ctx context.Context, limit int32) ([]int, bool, error) {
+	return []int{1}, true, nil
+}
+
+func TestResumeNotResumable(t *testing.T) {
+	seq := Paginate(context.Background(), func() Pager[int] {
+		return plainPager{}
+	}, Resume(Checkpoint{}))
+
+	_, err := collect(t, seq)
+	if !errors.Is(err, ErrNotResumable) {
+		t.Errorf("error = %v, want %v", err, ErrNotResumable)
+	}
+}
+
+func TestResumeEmptyCheckpoint(t *testing.T) {
+	seq := Paginate(context.Background(), Offset(func(ctx context.Context, offset int32, limit int32) ([]int32, error) {
+		if offset > 1 {
+			return nil, nil
+		}
+		return []int32{offset}, nil
+	}), Resume(Checkpoint{}))
+
+	items, err := collect(t, seq)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(items, []int32{0, 1}) {
+		t.Errorf("items = %v", items)
+	}
+}
+
+// testResume interrupts the iteration after every item and checks that the resumed iteration yields the rest of want
+func testResume[T any, K comparable](t *testing.T, newSeq func(options ...Option) func(yield func(T, error) bool), key func(item T) K, want []K) {
+	t.Helper()
+
+	keys := func(items []T) []K {
+		var keys []K
+		for _, item := range items {
+			keys = append(keys, key(item))
+		}
+		return keys
+	}
+
+	for n := 1; n < len(want); n++ {
+		t.Run("resume_"+strconv.Itoa(n), func(t *testing.T) {
+			items, data := collectUntil(t, n, newSeq)
+			items = append(items, resumeFrom(t, data, newSeq)...)
+
+			if got := keys(items); !reflect.DeepEqual(got, want) {
+				t.Errorf("items = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+	"sync"
+)
+
+// ChatItem is an item of a merged iteration over many chats
+type ChatItem[T any] struct {
+	ChatId int64
+	Item   T
+}
+
+// FanIn iterates over many chats concurrently with at most workers chats at a time and merges the items into one stream.
+// Errors are tagged with the chat identifier and don't stop the iteration over other chats.
+// The order of items is preserved within a chat only.
+func FanIn[T any](ctx context.Context, chatIds []int64, workers int, newSeq func(ctx context.Context, chatId int64) iter.Seq2[T, error]) iter.Seq2[ChatItem[T], error] {
+	type result struct {
+		item ChatItem[T]
+		err  error
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	return func(yield func(ChatItem[T], error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		queue := make(chan int64)
+		results := make(chan result)
+
+		var wg sync.WaitGroup
+
+		for range min(workers, len(chatIds)) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for chatId := range queue {
+					for item, err := range newSeq(ctx, chatId) {
+						select {
+						case results <- result{item: ChatItem[T]{ChatId: chatId, Item: item}, err: err}:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}
+			}()
+		}
+
+		go func() {
+			defer close(queue)
+
+			for _, chatId := range chatIds {
+				select {
+				case queue <- chatId:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		for result := range results {
+			if !yield(result.item, result.err) {
+				cancel()
+				break
+			}
+		}
+
+		// wait for the workers, so that no TDLib request is issued after the iteration is stopped
+		for range results {
+		}
+	}
+}
+
+// ChatHistories iterates over the history of many chats concurrently
+func ChatHistories(ctx context.Context, tdlibClient client.TDLib, chatIds []int64, workers int, options ...Option) iter.Seq2[ChatItem[*client.Message], error] {
+	return FanIn(ctx, chatIds, workers, func(ctx context.Context, chatId int64) iter.Seq2[*client.Message, error] {
+		return ChatHistory(ctx, tdlibClient, chatId, options...)
+	})
+}
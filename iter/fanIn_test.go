@@ -0,0 +1,102 @@
+package iter
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+)
+
+func countSeq(n int) func(ctx context.Context, chatId int64) iter.Seq2[int, error] {
+	return func(ctx context.Context, chatId int64) iter.Seq2[int, error] {
+		return Paginate(ctx, Offset(func(ctx context.Context, offset int32, limit int32) ([]int, error) {
+			if int(offset) >= n {
+				return nil, nil
+			}
+			return []int{int(offset)}, nil
+		}))
+	}
+}
+
+func TestFanIn(t *testing.T) {
+	chatIds := []int64{1, 2, 3, 4, 5}
+
+	items := map[int64][]int{}
+	for chatItem, err := range FanIn(context.Background(), chatIds, 2, countSeq(3)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		items[chatItem.ChatId] = append(items[chatItem.ChatId], chatItem.Item)
+	}
+
+	for _, chatId := range chatIds {
+		if !reflect.DeepEqual(items[chatId], []int{0, 1, 2}) {
+			t.Errorf("chat %d items = %v", chatId, items[chatId])
+		}
+	}
+}
+
+func TestFanInErrors(t *testing.T) {
+	chatErr := errors.New("chat error")
+
+	var failed []int64
+	var count int
+	for chatItem, err := range FanIn(context.Background(), []int64{1, 2, 3}, 3, func(ctx context.Context, chatId int64) iter.Seq2[int, error] {
+		if chatId == 2 {
+			return func(yield func(int, error) bool) {
+				yield(0, chatErr)
+			}
+		}
+		return countSeq(2)(ctx, chatId)
+	}) {
+		if err != nil {
+			if !errors.Is(err, chatErr) {
+				t.Errorf("error = %v, want %v", err, chatErr)
+			}
+			failed = append(failed, chatItem.ChatId)
+			continue
+		}
+		count++
+	}
+
+	sort.Slice(failed, func(i, j int) bool { return failed[i] < failed[j] })
+	if !reflect.DeepEqual(failed, []int64{2}) {
+		t.Errorf("failed chats = %v", failed)
+	}
+
+	if count != 4 {
+		t.Errorf("items = %d, want 4", count)
+	}
+}
+
+func TestFanInStop(t *testing.T) {
+	var running atomic.Int32
+
+	seq := FanIn(context.Background(), []int64{1, 2, 3, 4}, 2, func(ctx context.Context, chatId int64) iter.Seq2[int, error] {
+		return func(yield func(int, error) bool) {
+			running.Add(1)
+			defer running.Add(-1)
+
+			for i := 0; ; i++ {
+				if ctx.Err() != nil || !yield(i, nil) {
+					return
+				}
+			}
+		}
+	})
+
+	var count int
+	for range seq {
+		count++
+		if count == 10 {
+			break
+		}
+	}
+
+	if running.Load() != 0 {
+		t.Errorf("%d workers are still running after the iteration is stopped", running.Load())
+	}
+}
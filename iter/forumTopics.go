@@ -0,0 +1,35 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+type forumTopicsOffset struct {
+	Date            int32 `json:"date"`
+	MessageId       int64 `json:"message_id"`
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+// ForumTopics iterates over topics of a forum chat. Offset fields and Limit of req are ignored.
+func ForumTopics(ctx context.Context, tdlibClient client.TDLib, req *client.GetForumTopicsRequest, options ...Option) iter.Seq2[*client.ForumTopic, error] {
+	return Paginate(ctx, Cursor(func(ctx context.Context, offset forumTopicsOffset, limit int32) ([]*client.ForumTopic, forumTopicsOffset, error) {
+		pageReq := *req
+		pageReq.OffsetDate = offset.Date
+		pageReq.OffsetMessageId = offset.MessageId
+		pageReq.OffsetMessageThreadId = offset.MessageThreadId
+		pageReq.Limit = limit
+
+		forumTopics, err := tdlibClient.GetForumTopics(ctx, &pageReq)
+		if err != nil {
+			return nil, forumTopicsOffset{}, err
+		}
+
+		return forumTopics.Topics, forumTopicsOffset{
+			Date:            forumTopics.NextOffsetDate,
+			MessageId:       forumTopics.NextOffsetMessageId,
+			MessageThreadId: forumTopics.NextOffsetMessageThreadId,
+		}, nil
+	}), options...)
+}
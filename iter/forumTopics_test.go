@@ -0,0 +1,89 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+func TestForumTopics(t *testing.T) {
+	// topics ordered by the date and the identifier of the last message, two topics have the same date
+	topics := []*client.ForumTopic{
+		forumTopic(1, 50, 500),
+		forumTopic(2, 40, 420),
+		forumTopic(3, 40, 410),
+		forumTopic(4, 30, 300),
+		forumTopic(5, 20, 200),
+	}
+
+	mock := &client.Mock{
+		GetForumTopicsFunc: func(ctx context.Context, req *client.GetForumTopicsRequest) (*client.ForumTopics, error) {
+			start := 0
+			if req.OffsetDate != 0 {
+				for i, topic := range topics {
+					if topic.LastMessage.Date == req.OffsetDate && topic.LastMessage.Id == req.OffsetMessageId && topic.Info.MessageThreadId == req.OffsetMessageThreadId {
+						start = i + 1
+					}
+				}
+			}
+			end := min(start+int(req.Limit), len(topics))
+
+			forumTopics := &client.ForumTopics{TotalCount: int32(len(topics)), Topics: topics[start:end]}
+			if end < len(topics) {
+				last := topics[end-1]
+				forumTopics.NextOffsetDate = last.LastMessage.Date
+				forumTopics.NextOffsetMessageId = last.LastMessage.Id
+				forumTopics.NextOffsetMessageThreadId = last.Info.MessageThreadId
+			}
+
+			return forumTopics, nil
+		},
+	}
+
+	newSeq := func(options ...Option) func(yield func(*client.ForumTopic, error) bool) {
+		return ForumTopics(context.Background(), mock, &client.GetForumTopicsRequest{ChatId: 1, Query: "query", OffsetDate: 40, OffsetMessageId: 420, OffsetMessageThreadId: 2, Limit: 1}, append(options, WithPageSize(2))...)
+	}
+
+	items, err := collect(t, newSeq())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	threadId := func(topic *client.ForumTopic) int64 {
+		return topic.Info.MessageThreadId
+	}
+
+	var threadIds []int64
+	for _, topic := range items {
+		threadIds = append(threadIds, threadId(topic))
+	}
+
+	want := []int64{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(threadIds, want) {
+		t.Errorf("unexpected thread ids: %v", threadIds)
+	}
+
+	var offsets [][3]int64
+	for _, call := range mock.CallsOf("getForumTopics") {
+		req := call.Request.(*client.GetForumTopicsRequest)
+		if req.ChatId != 1 || req.Query != "query" || req.Limit != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		offsets = append(offsets, [3]int64{int64(req.OffsetDate), req.OffsetMessageId, req.OffsetMessageThreadId})
+	}
+
+	wantOffsets := [][3]int64{{0, 0, 0}, {40, 420, 2}, {30, 300, 4}}
+	if !reflect.DeepEqual(offsets, wantOffsets) {
+		t.Errorf("offsets = %v, want %v", offsets, wantOffsets)
+	}
+
+	testResume(t, newSeq, threadId, want)
+}
+
+func forumTopic(messageThreadId int64, date int32, lastMessageId int64) *client.ForumTopic {
+	return &client.ForumTopic{
+		Info:        &client.ForumTopicInfo{MessageThreadId: messageThreadId},
+		LastMessage: &client.Message{Id: lastMessageId, Date: date},
+	}
+}
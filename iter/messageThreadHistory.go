@@ -0,0 +1,24 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+func MessageThreadHistory(ctx context.Context, tdlibClient client.TDLib, chatId int64, messageId int64, options ...Option) iter.Seq2[*client.Message, error] {
+	return Paginate(ctx, FromMessageId(func(ctx context.Context, fromMessageId int64, limit int32) ([]*client.Message, error) {
+		messages, err := tdlibClient.GetMessageThreadHistory(ctx, &client.GetMessageThreadHistoryRequest{
+			ChatId:        chatId,
+			MessageId:     messageId,
+			FromMessageId: fromMessageId,
+			Offset:        0,
+			Limit:         limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return messages.Messages, nil
+	}, messageIdOf), options...)
+}
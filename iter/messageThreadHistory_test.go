@@ -0,0 +1,60 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+func TestMessageThreadHistory(t *testing.T) {
+	history := fakeHistory{n: 5}
+
+	mock := &client.Mock{
+		GetMessageThreadHistoryFunc: func(ctx context.Context, req *client.GetMessageThreadHistoryRequest) (*client.Messages, error) {
+			messages, err := history.fetch(ctx, req.FromMessageId, req.Offset, req.Limit)
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.Messages{TotalCount: int32(len(messages)), Messages: messages}, nil
+		},
+	}
+
+	newSeq := func(options ...Option) func(yield func(*client.Message, error) bool) {
+		return MessageThreadHistory(context.Background(), mock, 1, 100, append(options, WithPageSize(2))...)
+	}
+
+	messages, err := collect(t, newSeq())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ids := messageIds(messages); !reflect.DeepEqual(ids, []int64{5, 4, 3, 2, 1}) {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	var fromMessageIds []int64
+	for _, call := range mock.CallsOf("getMessageThreadHistory") {
+		req := call.Request.(*client.GetMessageThreadHistoryRequest)
+		if req.ChatId != 1 || req.MessageId != 100 {
+			t.Errorf("unexpected chat or message id in %+v", req)
+		}
+		fromMessageIds = append(fromMessageIds, req.FromMessageId)
+	}
+
+	if want := []int64{0, 4, 2, 1}; !reflect.DeepEqual(fromMessageIds, want) {
+		t.Errorf("from message ids = %v, want %v", fromMessageIds, want)
+	}
+
+	testResume(t, newSeq, messageIdOf, []int64{5, 4, 3, 2, 1})
+}
+
+func messageIds(messages []*client.Message) []int64 {
+	var ids []int64
+	for _, message := range messages {
+		ids = append(ids, message.Id)
+	}
+
+	return ids
+}
@@ -0,0 +1,372 @@
+package iter
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"strconv"
+)
+
+const defaultPageSize int32 = 100
+
+// Pager loads pages of a paged TDLib method one by one. A pager must not advance its cursor if the page can't be loaded.
+type Pager[T any] interface {
+	// NextPage returns the next page. last reports that there are no more pages.
+	NextPage(ctx context.Context, limit int32) (items []T, last bool, err error)
+}
+
+type config struct {
+	pageSize         int32
+	pageErrorHandler func(err error, attempt int) bool
+	progress         *Progress
+	checkpoint       *Checkpoint
+	prefetch         int
+}
+
+type Option func(*config)
+
+// WithPageSize sets the number of items requested per page
+func WithPageSize(pageSize int32) Option {
+	return func(config *config) {
+		config.pageSize = pageSize
+	}
+}
+
+// WithPageErrorHandler sets the callback that is called when a page can't be loaded.
+// The page is requested again if the callback returns true, otherwise the error is yielded and the iteration stops.
+func WithPageErrorHandler(pageErrorHandler func(err error, attempt int) bool) Option {
+	return func(config *config) {
+		config.pageErrorHandler = pageErrorHandler
+	}
+}
+
+// WithPrefetch loads up to pages next pages in the background while the current page is consumed.
+// A stopped iteration waits for the page being loaded
+func WithPrefetch(pages int) Option {
+	return func(config *config) {
+		config.prefetch = pages
+	}
+}
+
+func newConfig(options []Option) *config {
+	config := &config{
+		pageSize: defaultPageSize,
+		pageErrorHandler: func(err error, attempt int) bool {
+			return false
+		},
+	}
+
+	for _, option := range options {
+		option(config)
+	}
+
+	return config
+}
+
+// Paginate iterates over all items of a paged TDLib method. A new pager is created for every iteration.
+func Paginate[T any](ctx context.Context, newPager func() Pager[T], options ...Option) iter.Seq2[T, error] {
+	config := newConfig(options)
+
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		pager := newPager()
+		resumablePager, isResumable := pager.(ResumablePager[T])
+
+		if (config.checkpoint != nil || config.progress != nil) && !isResumable {
+			yield(zero, ErrNotResumable)
+			return
+		}
+
+		skip := 0
+		if config.checkpoint != nil {
+			err := resumablePager.SetCursor(config.checkpoint.Cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			skip = config.checkpoint.Skip
+		}
+
+		nextPage := func() page[T] {
+			return loadPage(ctx, pager, config)
+		}
+
+		if config.prefetch > 0 {
+			ctx, cancel := context.WithCancel(ctx)
+
+			pages := prefetch(ctx, pager, config)
+			defer func() {
+				cancel()
+				// wait for the prefetching goroutine, so that no TDLib request is issued after the iteration is stopped
+				for range pages {
+				}
+			}()
+
+			nextPage = func() page[T] {
+				page, ok := <-pages
+				if !ok {
+					page.err = ctx.Err()
+				}
+				return page
+			}
+		}
+
+		for {
+			page := nextPage()
+			if page.err != nil {
+				yield(zero, page.err)
+				return
+			}
+
+			for i := skip; i < len(page.items); i++ {
+				ok := yield(page.items[i], nil)
+
+				if config.progress != nil {
+					config.progress.set(Checkpoint{
+						Cursor: page.cursor,
+						Skip:   i + 1,
+					})
+				}
+
+				if !ok {
+					return
+				}
+			}
+			skip = 0
+
+			if page.isLast() {
+				return
+			}
+		}
+	}
+}
+
+type page[T any] struct {
+	// cursor of the pager before the page has been loaded
+	cursor string
+	items  []T
+	last   bool
+	err    error
+}
+
+func (page page[T]) isLast() bool {
+	return page.err != nil || page.last || len(page.items) == 0
+}
+
+func loadPage[T any](ctx context.Context, pager Pager[T], config *config) page[T] {
+	var cursor string
+	if resumablePager, ok := pager.(ResumablePager[T]); ok {
+		cursor = resumablePager.Cursor()
+	}
+
+	items, last, err := nextPage(ctx, pager, config)
+
+	return page[T]{
+		cursor: cursor,
+		items:  items,
+		last:   last,
+		err:    err,
+	}
+}
+
+// prefetch loads pages in the background until the last page is loaded or ctx is done
+func prefetch[T any](ctx context.Context, pager Pager[T], config *config) <-chan page[T] {
+	pages := make(chan page[T], config.prefetch)
+
+	go func() {
+		defer close(pages)
+
+		for {
+			page := loadPage(ctx, pager, config)
+
+			select {
+			case pages <- page:
+			case <-ctx.Done():
+				return
+			}
+
+			if page.isLast() {
+				return
+			}
+		}
+	}()
+
+	return pages
+}
+
+func nextPage[T any](ctx context.Context, pager Pager[T], config *config) ([]T, bool, error) {
+	for attempt := 1; ; attempt++ {
+		err := ctx.Err()
+		if err != nil {
+			return nil, false, err
+		}
+
+		items, last, err := pager.NextPage(ctx, config.pageSize)
+		if err == nil {
+			return items, last, nil
+		}
+
+		if ctx.Err() != nil || !config.pageErrorHandler(err, attempt) {
+			return nil, false, err
+		}
+	}
+}
+
+type fromMessageIdPager[T any] struct {
+	fetch         func(ctx context.Context, fromMessageId int64, limit int32) ([]T, error)
+	messageId     func(item T) int64
+	fromMessageId int64
+}
+
+// FromMessageId pages by the identifier of the last received message, e.g. GetChatHistory or SearchChatMessages.
+// The iteration starts from the last message and stops on an empty page.
+func FromMessageId[T any](fetch func(ctx context.Context, fromMessageId int64, limit int32) ([]T, error), messageId func(item T) int64) func() Pager[T] {
+	return func() Pager[T] {
+		return &fromMessageIdPager[T]{
+			fetch:     fetch,
+			messageId: messageId,
+		}
+	}
+}
+
+func (pager *fromMessageIdPager[T]) NextPage(ctx context.Context, limit int32) ([]T, bool, error) {
+	items, err := pager.fetch(ctx, pager.fromMessageId, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(items) > 0 {
+		pager.fromMessageId = pager.messageId(items[len(items)-1])
+	}
+
+	return items, len(items) == 0, nil
+}
+
+func (pager *fromMessageIdPager[T]) Cursor() string {
+	return strconv.FormatInt(pager.fromMessageId, 10)
+}
+
+func (pager *fromMessageIdPager[T]) SetCursor(cursor string) error {
+	if cursor == "" {
+		cursor = "0"
+	}
+
+	fromMessageId, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	pager.fromMessageId = fromMessageId
+
+	return nil
+}
+
+type offsetPager[T any] struct {
+	fetch  func(ctx context.Context, offset int32, limit int32) ([]T, int32, error)
+	offset int32
+}
+
+// Offset pages by a numeric offset, e.g. GetSupergroupMembers. The iteration stops on an empty page.
+func Offset[T any](fetch func(ctx context.Context, offset int32, limit int32) ([]T, error)) func() Pager[T] {
+	return func() Pager[T] {
+		return &offsetPager[T]{
+			fetch: func(ctx context.Context, offset int32, limit int32) ([]T, int32, error) {
+				items, err := fetch(ctx, offset, limit)
+				return items, -1, err
+			},
+		}
+	}
+}
+
+// OffsetTotalCount pages by a numeric offset and stops when total_count items have been received
+func OffsetTotalCount[T any](fetch func(ctx context.Context, offset int32, limit int32) (items []T, totalCount int32, err error)) func() Pager[T] {
+	return func() Pager[T] {
+		return &offsetPager[T]{
+			fetch: fetch,
+		}
+	}
+}
+
+func (pager *offsetPager[T]) NextPage(ctx context.Context, limit int32) ([]T, bool, error) {
+	items, totalCount, err := pager.fetch(ctx, pager.offset, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	pager.offset += int32(len(items))
+
+	return items, len(items) == 0 || (totalCount >= 0 && pager.offset >= totalCount), nil
+}
+
+func (pager *offsetPager[T]) Cursor() string {
+	return strconv.FormatInt(int64(pager.offset), 10)
+}
+
+func (pager *offsetPager[T]) SetCursor(cursor string) error {
+	if cursor == "" {
+		cursor = "0"
+	}
+
+	offset, err := strconv.ParseInt(cursor, 10, 32)
+	if err != nil {
+		return err
+	}
+
+	pager.offset = int32(offset)
+
+	return nil
+}
+
+type cursorPager[T any, C comparable] struct {
+	fetch  func(ctx context.Context, cursor C, limit int32) ([]T, C, error)
+	cursor C
+}
+
+// Cursor pages by a cursor returned with the page, e.g. next_from_message_id of SearchChatMessages.
+// The iteration stops when the returned cursor is the zero value or the page is empty.
+func Cursor[T any, C comparable](fetch func(ctx context.Context, cursor C, limit int32) (items []T, next C, err error)) func() Pager[T] {
+	return func() Pager[T] {
+		return &cursorPager[T, C]{
+			fetch: fetch,
+		}
+	}
+}
+
+func (pager *cursorPager[T, C]) NextPage(ctx context.Context, limit int32) ([]T, bool, error) {
+	items, next, err := pager.fetch(ctx, pager.cursor, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var zero C
+	pager.cursor = next
+
+	return items, next == zero, nil
+}
+
+func (pager *cursorPager[T, C]) Cursor() string {
+	data, err := json.Marshal(pager.cursor)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+func (pager *cursorPager[T, C]) SetCursor(cursor string) error {
+	var zero C
+	pager.cursor = zero
+
+	if cursor == "" {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(cursor), &pager.cursor)
+}
+
+// NextOffset pages by a string cursor returned in next_offset, e.g. SearchMessages.
+// The iteration stops when next_offset is empty.
+func NextOffset[T any](fetch func(ctx context.Context, offset string, limit int32) (items []T, nextOffset string, err error)) func() Pager[T] {
+	return Cursor(fetch)
+}
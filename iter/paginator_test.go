@@ -0,0 +1,262 @@
+package iter
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func collect[T any](t *testing.T, seq func(yield func(T, error) bool)) ([]T, error) {
+	t.Helper()
+
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func TestFromMessageId(t *testing.T) {
+	history := []int64{10, 9, 8, 7, 6}
+
+	var requests []int64
+	seq := Paginate(context.Background(), FromMessageId(func(ctx context.Context, fromMessageId int64, limit int32) ([]int64, error) {
+		requests = append(requests, fromMessageId)
+
+		var page []int64
+		for _, id := range history {
+			if (fromMessageId == 0 || id < fromMessageId) && int32(len(page)) < limit {
+				page = append(page, id)
+			}
+		}
+
+		return page, nil
+	}, func(id int64) int64 {
+		return id
+	}), WithPageSize(2))
+
+	items, err := collect(t, seq)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(items, history) {
+		t.Errorf("items = %v, want %v", items, history)
+	}
+
+	wantRequests := []int64{0, 9, 7, 6}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests = %v, want %v", requests, wantRequests)
+	}
+}
+
+func TestOffsetTotalCount(t *testing.T) {
+	var requests int
+	seq := Paginate(context.Background(), OffsetTotalCount(func(ctx context.Context, offset int32, limit int32) ([]int32, int32, error) {
+		requests++
+
+		var page []int32
+		for i := offset; i < offset+limit && i < 5; i++ {
+			page = append(page, i)
+		}
+
+		return page, 5, nil
+	}), WithPageSize(3))
+
+	items, err := collect(t, seq)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(items, []int32{0, 1, 2, 3, 4}) {
+		t.Errorf("items = %v", items)
+	}
+
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+}
+
+func TestNextOffset(t *testing.T) {
+	seq := Paginate(context.Background(), NextOffset(func(ctx context.Context, offset string, limit int32) ([]string, string, error) {
+		page, _ := strconv.Atoi(offset)
+		if page == 2 {
+			return []string{"c"}, "", nil
+		}
+
+		return []string{string(rune('a' + page))}, strconv.Itoa(page + 1), nil
+	}))
+
+	items, err := collect(t, seq)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(items, []string{"a", "b", "c"}) {
+		t.Errorf("items = %v", items)
+	}
+}
+
+func TestPageErrorHandler(t *testing.T) {
+	pageErr := errors.New("page error")
+
+	var fails int
+	pager := Offset(func(ctx context.Context, offset int32, limit int32) ([]int32, error) {
+		if offset == 1 && fails < 2 {
+			fails++
+			return nil, pageErr
+		}
+		if offset > 2 {
+			return nil, nil
+		}
+
+		return []int32{offset}, nil
+	})
+
+	items, err := collect(t, Paginate(context.Background(), pager, WithPageErrorHandler(func(err error, attempt int) bool {
+		return attempt < 3
+	})))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(items, []int32{0, 1, 2}) {
+		t.Errorf("items = %v", items)
+	}
+
+	fails = 0
+	items, err = collect(t, Paginate(context.Background(), pager))
+	if !errors.Is(err, pageErr) {
+		t.Errorf("error = %v, want %v", err, pageErr)
+	}
+
+	if !reflect.DeepEqual(items, []int32{0}) {
+		t.Errorf("items = %v", items)
+	}
+}
+
+func TestPaginateContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	seq := Paginate(ctx, Offset(func(ctx context.Context, offset int32, limit int32) ([]int32, error) {
+		return []int32{offset}, nil
+	}))
+
+	var items []int32
+	var err error
+	for item, itemErr := range seq {
+		if itemErr != nil {
+			err = itemErr
+			break
+		}
+		items = append(items, item)
+		if len(items) == 2 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+
+	if len(items) != 2 {
+		t.Errorf("items = %v", items)
+	}
+}
+
+func TestCursor(t *testing.T) {
+	pages := map[int64][]int64{
+		0:  {30, 29},
+		29: {20},
+		20: {10},
+	}
+	next := map[int64]int64{0: 29, 29: 20, 20: 0}
+
+	seq := Paginate(context.Background(), Cursor(func(ctx context.Context, cursor int64, limit int32) ([]int64, int64, error) {
+		return pages[cursor], next[cursor], nil
+	}))
+
+	items, err := collect(t, seq)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(items, []int64{30, 29, 20, 10}) {
+		t.Errorf("items = %v", items)
+	}
+}
+
+func TestPrefetch(t *testing.T) {
+	loaded := make(chan int32, 10)
+
+	seq := Paginate(context.Background(), Offset(func(ctx context.Context, offset int32, limit int32) ([]int32, error) {
+		loaded <- offset
+		if offset > 3 {
+			return nil, nil
+		}
+		return []int32{offset}, nil
+	}), WithPrefetch(1))
+
+	var items []int32
+	for item, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if item == 0 {
+			// the next page is loaded while the first one is consumed
+			<-loaded
+			if next := <-loaded; next != 1 {
+				t.Errorf("prefetched offset = %d, want 1", next)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	if !reflect.DeepEqual(items, []int32{0, 1, 2, 3}) {
+		t.Errorf("items = %v", items)
+	}
+}
+
+func TestPrefetchStop(t *testing.T) {
+	var mu sync.Mutex
+	loading := 0
+
+	seq := Paginate(context.Background(), Offset(func(ctx context.Context, offset int32, limit int32) ([]int32, error) {
+		mu.Lock()
+		loading++
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			loading--
+			mu.Unlock()
+		}()
+
+		// a slow request which ignores the cancellation
+		time.Sleep(20 * time.Millisecond)
+
+		return []int32{offset}, nil
+	}), WithPrefetch(2))
+
+	for range seq {
+		break
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if loading != 0 {
+		t.Errorf("%d pages are loading after the iteration is stopped", loading)
+	}
+}
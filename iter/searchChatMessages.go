@@ -0,0 +1,24 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+// SearchChatMessages iterates over messages found by SearchChatMessages. FromMessageId, Offset and Limit of req are ignored.
+func SearchChatMessages(ctx context.Context, tdlibClient client.TDLib, req *client.SearchChatMessagesRequest, options ...Option) iter.Seq2[*client.Message, error] {
+	return Paginate(ctx, Cursor(func(ctx context.Context, fromMessageId int64, limit int32) ([]*client.Message, int64, error) {
+		pageReq := *req
+		pageReq.FromMessageId = fromMessageId
+		pageReq.Offset = 0
+		pageReq.Limit = limit
+
+		foundChatMessages, err := tdlibClient.SearchChatMessages(ctx, &pageReq)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return foundChatMessages.Messages, foundChatMessages.NextFromMessageId, nil
+	}), options...)
+}
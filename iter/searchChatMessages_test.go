@@ -0,0 +1,57 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"testing"
+)
+
+func TestSearchChatMessages(t *testing.T) {
+	history := fakeHistory{n: 5}
+
+	mock := &client.Mock{
+		SearchChatMessagesFunc: func(ctx context.Context, req *client.SearchChatMessagesRequest) (*client.FoundChatMessages, error) {
+			messages, err := history.fetch(ctx, req.FromMessageId, req.Offset, req.Limit)
+			if err != nil {
+				return nil, err
+			}
+
+			var nextFromMessageId int64
+			if len(messages) > 0 && messages[len(messages)-1].Id > 1 {
+				nextFromMessageId = messages[len(messages)-1].Id
+			}
+
+			return &client.FoundChatMessages{TotalCount: int32(history.n), Messages: messages, NextFromMessageId: nextFromMessageId}, nil
+		},
+	}
+
+	newSeq := func(options ...Option) func(yield func(*client.Message, error) bool) {
+		return SearchChatMessages(context.Background(), mock, &client.SearchChatMessagesRequest{ChatId: 1, Query: "query", FromMessageId: 3, Offset: -1, Limit: 1}, append(options, WithPageSize(2))...)
+	}
+
+	messages, err := collect(t, newSeq())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ids := messageIds(messages); !reflect.DeepEqual(ids, []int64{5, 4, 3, 2, 1}) {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	var fromMessageIds []int64
+	for _, call := range mock.CallsOf("searchChatMessages") {
+		req := call.Request.(*client.SearchChatMessagesRequest)
+		if req.ChatId != 1 || req.Query != "query" || req.Offset != 0 || req.Limit != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		fromMessageIds = append(fromMessageIds, req.FromMessageId)
+	}
+
+	// the page which ends with the oldest message has no next_from_message_id
+	if want := []int64{0, 4, 2}; !reflect.DeepEqual(fromMessageIds, want) {
+		t.Errorf("from message ids = %v, want %v", fromMessageIds, want)
+	}
+
+	testResume(t, newSeq, messageIdOf, []int64{5, 4, 3, 2, 1})
+}
@@ -0,0 +1,23 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+// SearchMessages iterates over messages found by SearchMessages in all chats. Offset and Limit of req are ignored.
+func SearchMessages(ctx context.Context, tdlibClient client.TDLib, req *client.SearchMessagesRequest, options ...Option) iter.Seq2[*client.Message, error] {
+	return Paginate(ctx, NextOffset(func(ctx context.Context, offset string, limit int32) ([]*client.Message, string, error) {
+		pageReq := *req
+		pageReq.Offset = offset
+		pageReq.Limit = limit
+
+		foundMessages, err := tdlibClient.SearchMessages(ctx, &pageReq)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return foundMessages.Messages, foundMessages.NextOffset, nil
+	}), options...)
+}
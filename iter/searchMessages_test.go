@@ -0,0 +1,55 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestSearchMessages(t *testing.T) {
+	found := []*client.Message{{Id: 5, ChatId: 1}, {Id: 9, ChatId: 2}, {Id: 4, ChatId: 1}, {Id: 7, ChatId: 3}, {Id: 2, ChatId: 2}}
+
+	mock := &client.Mock{
+		SearchMessagesFunc: func(ctx context.Context, req *client.SearchMessagesRequest) (*client.FoundMessages, error) {
+			start, _ := strconv.Atoi(req.Offset)
+			end := min(start+int(req.Limit), len(found))
+
+			nextOffset := ""
+			if end < len(found) {
+				nextOffset = strconv.Itoa(end)
+			}
+
+			return &client.FoundMessages{TotalCount: int32(len(found)), Messages: found[start:end], NextOffset: nextOffset}, nil
+		},
+	}
+
+	newSeq := func(options ...Option) func(yield func(*client.Message, error) bool) {
+		return SearchMessages(context.Background(), mock, &client.SearchMessagesRequest{Query: "query", Offset: "3", Limit: 1}, append(options, WithPageSize(2))...)
+	}
+
+	messages, err := collect(t, newSeq())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ids := messageIds(messages); !reflect.DeepEqual(ids, []int64{5, 9, 4, 7, 2}) {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	var offsets []string
+	for _, call := range mock.CallsOf("searchMessages") {
+		req := call.Request.(*client.SearchMessagesRequest)
+		if req.Query != "query" || req.Limit != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		offsets = append(offsets, req.Offset)
+	}
+
+	if want := []string{"", "2", "4"}; !reflect.DeepEqual(offsets, want) {
+		t.Errorf("offsets = %v, want %v", offsets, want)
+	}
+
+	testResume(t, newSeq, messageIdOf, []int64{5, 9, 4, 7, 2})
+}
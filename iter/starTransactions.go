@@ -0,0 +1,23 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"iter"
+)
+
+// StarTransactions iterates over Telegram Star transactions. Offset and Limit of req are ignored.
+func StarTransactions(ctx context.Context, tdlibClient client.TDLib, req *client.GetStarTransactionsRequest, options ...Option) iter.Seq2[*client.StarTransaction, error] {
+	return Paginate(ctx, NextOffset(func(ctx context.Context, offset string, limit int32) ([]*client.StarTransaction, string, error) {
+		pageReq := *req
+		pageReq.Offset = offset
+		pageReq.Limit = limit
+
+		starTransactions, err := tdlibClient.GetStarTransactions(ctx, &pageReq)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return starTransactions.Transactions, starTransactions.NextOffset, nil
+	}), options...)
+}
@@ -0,0 +1,67 @@
+package iter
+
+import (
+	"context"
+	"github.com/zelenin/go-tdlib/client"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestStarTransactions(t *testing.T) {
+	transactions := []*client.StarTransaction{{Id: "a"}, {Id: "b"}, {Id: "c"}, {Id: "d"}, {Id: "e"}}
+
+	mock := &client.Mock{
+		GetStarTransactionsFunc: func(ctx context.Context, req *client.GetStarTransactionsRequest) (*client.StarTransactions, error) {
+			start, _ := strconv.Atoi(req.Offset)
+			end := min(start+int(req.Limit), len(transactions))
+
+			nextOffset := ""
+			if end < len(transactions) {
+				nextOffset = strconv.Itoa(end)
+			}
+
+			return &client.StarTransactions{Transactions: transactions[start:end], NextOffset: nextOffset}, nil
+		},
+	}
+
+	owner := &client.MessageSenderUser{UserId: 1}
+	newSeq := func(options ...Option) func(yield func(*client.StarTransaction, error) bool) {
+		return StarTransactions(context.Background(), mock, &client.GetStarTransactionsRequest{OwnerId: owner, Offset: "1", Limit: 1}, append(options, WithPageSize(2))...)
+	}
+
+	items, err := collect(t, newSeq())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ids := starTransactionIds(items); !reflect.DeepEqual(ids, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	var offsets []string
+	for _, call := range mock.CallsOf("getStarTransactions") {
+		req := call.Request.(*client.GetStarTransactionsRequest)
+		if req.OwnerId != owner || req.Limit != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		offsets = append(offsets, req.Offset)
+	}
+
+	if want := []string{"", "2", "4"}; !reflect.DeepEqual(offsets, want) {
+		t.Errorf("offsets = %v, want %v", offsets, want)
+	}
+
+	testResume(t, newSeq, func(transaction *client.StarTransaction) string {
+		return transaction.Id
+	}, []string{"a", "b", "c", "d", "e"})
+}
+
+func starTransactionIds(transactions []*client.StarTransaction) []string {
+	var ids []string
+	for _, transaction := range transactions {
+		ids = append(ids, transaction.Id)
+	}
+
+	return ids
+}
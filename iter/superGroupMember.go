@@ -6,34 +6,20 @@ import (
 	"iter"
 )
 
-func SuperGroupMemberIter(ctx context.Context, tdlibClient *client.Client, supergroupId int64) iter.Seq2[*client.ChatMember, error] {
-	return func(yield func(*client.ChatMember, error) bool) {
-		const limit = 200
-		var offset int32 = 0
+func SuperGroupMemberIter(ctx context.Context, tdlibClient client.TDLib, supergroupId int64, options ...Option) iter.Seq2[*client.ChatMember, error] {
+	options = append([]Option{WithPageSize(200)}, options...)
 
-		for {
-			chatMembers, err := tdlibClient.GetSupergroupMembers(context.Background(), &client.GetSupergroupMembersRequest{
-				SupergroupId: supergroupId,
-				Filter:       nil,
-				Offset:       offset,
-				Limit:        limit,
-			})
-			if err != nil {
-				yield(nil, err)
-				return
-			}
-
-			for _, chatMember := range chatMembers.Members {
-				if !yield(chatMember, nil) {
-					return
-				}
-			}
-
-			if chatMembers.TotalCount == 0 || len(chatMembers.Members) == 0 {
-				return
-			}
-
-			offset += int32(len(chatMembers.Members))
+	return Paginate(ctx, OffsetTotalCount(func(ctx context.Context, offset int32, limit int32) ([]*client.ChatMember, int32, error) {
+		chatMembers, err := tdlibClient.GetSupergroupMembers(ctx, &client.GetSupergroupMembersRequest{
+			SupergroupId: supergroupId,
+			Filter:       nil,
+			Offset:       offset,
+			Limit:        limit,
+		})
+		if err != nil {
+			return nil, 0, err
 		}
-	}
+
+		return chatMembers.Members, chatMembers.TotalCount, nil
+	}), options...)
 }